@@ -2,13 +2,17 @@ package main
 
 import (
 	"context"
+	"crypto/ed25519"
 	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
 	"database/sql"
 	"encoding/hex"
 	"fmt"
 	"log"
 	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"os/signal"
 	"sort"
@@ -24,11 +28,13 @@ import (
 	"google.golang.org/grpc/health"
 	healthv1 "google.golang.org/grpc/health/grpc_health_v1"
 
+	"github.com/jackc/pgx/v5"
 	_ "github.com/jackc/pgx/v5/stdlib"
 	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
-	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
 	platformauth "github.com/wizardbeardstudio/open-rgs-go/internal/platform/auth"
 	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/clock"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/evidence"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/money"
 	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/server"
 )
 
@@ -41,8 +47,29 @@ func main() {
 	version := envOr("RGS_VERSION", "dev")
 	grpcAddr := envOr("RGS_GRPC_ADDR", ":8081")
 	httpAddr := envOr("RGS_HTTP_ADDR", ":8080")
+	playerHTTPAddr := envOr("RGS_PLAYER_HTTP_ADDR", "")
 	trustedCIDRs := strings.Split(envOr("RGS_TRUSTED_CIDRS", "127.0.0.1/32,::1/128"), ",")
+	webauthnRPID := envOr("RGS_WEBAUTHN_RP_ID", "")
+	webauthnRPName := envOr("RGS_WEBAUTHN_RP_NAME", "open-rgs")
+	webauthnOrigins := envOr("RGS_WEBAUTHN_ORIGINS", "")
+	oidcIssuer := envOr("RGS_OIDC_ISSUER", "")
+	oidcAudience := envOr("RGS_OIDC_AUDIENCE", "")
+	oidcJWKSURL := envOr("RGS_OIDC_JWKS_URL", "")
+	oidcActorClaim := envOr("RGS_OIDC_ACTOR_CLAIM", "sub")
+	oidcRolesClaim := envOr("RGS_OIDC_ROLES_CLAIM", "groups")
+	oidcRoleMapSpec := envOr("RGS_OIDC_ROLE_MAP", "")
+	scimBearerToken := envOr("RGS_SCIM_BEARER_TOKEN", "")
+	g2sEnabled := envOr("RGS_G2S_ENABLED", "false") == "true"
+	g2sMeterCurrency := strings.ToUpper(envOr("RGS_G2S_METER_CURRENCY", "USD"))
+	lockoutWebhookURL := envOr("RGS_LOCKOUT_WEBHOOK_URL", "")
+	lockoutPagerDutyRoutingKey := envOr("RGS_LOCKOUT_PAGERDUTY_ROUTING_KEY", "")
+	lockoutSMTPAddr := envOr("RGS_LOCKOUT_SMTP_ADDR", "")
+	lockoutSMTPFrom := envOr("RGS_LOCKOUT_SMTP_FROM", "")
+	lockoutSMTPTo := envOr("RGS_LOCKOUT_SMTP_TO", "")
+	lockoutSMTPUsername := envOr("RGS_LOCKOUT_SMTP_USERNAME", "")
+	lockoutSMTPPassword := envOr("RGS_LOCKOUT_SMTP_PASSWORD", "")
 	databaseURL := envOr("RGS_DATABASE_URL", "")
+	reportingDatabaseURL := envOr("RGS_REPORTING_DATABASE_URL", "")
 	jwtSigningSecret := envOr("RGS_JWT_SIGNING_SECRET", "dev-insecure-change-me")
 	jwtKeysetSpec := envOr("RGS_JWT_KEYSET", "")
 	jwtActiveKID := envOr("RGS_JWT_ACTIVE_KID", "default")
@@ -50,6 +77,9 @@ func main() {
 	jwtKeysetCommand := envOr("RGS_JWT_KEYSET_COMMAND", "")
 	jwtKeysetRefreshInterval := mustParseDurationEnv("RGS_JWT_KEYSET_REFRESH_INTERVAL", "1m")
 	downloadSigningKeysSpec := envOr("RGS_DOWNLOAD_SIGNING_KEYS", "")
+	downloadPublicKeyRingFile := envOr("RGS_DOWNLOAD_PUBLIC_KEYRING_FILE", "")
+	configApprovalPolicySpec := envOr("RGS_CONFIG_APPROVAL_POLICY", "")
+	configScheduledApplyInterval := mustParseDurationEnv("RGS_CONFIG_SCHEDULED_APPLY_INTERVAL", "1m")
 	jwtAccessTTL := mustParseDurationEnv("RGS_JWT_ACCESS_TTL", "15m")
 	jwtRefreshTTL := mustParseDurationEnv("RGS_JWT_REFRESH_TTL", "24h")
 	identityLockoutTTL := mustParseDurationEnv("RGS_IDENTITY_LOCKOUT_TTL", "15m")
@@ -58,6 +88,29 @@ func main() {
 	identitySessionCleanupBatch := mustParseIntEnv("RGS_IDENTITY_SESSION_CLEANUP_BATCH", 500)
 	identityLoginRateLimitMaxAttempts := mustParseIntEnv("RGS_IDENTITY_LOGIN_RATE_LIMIT_MAX_ATTEMPTS", 60)
 	identityLoginRateLimitWindow := mustParseDurationEnv("RGS_IDENTITY_LOGIN_RATE_LIMIT_WINDOW", "1m")
+	identitySessionLimits := map[rgsv1.ActorType]int{
+		rgsv1.ActorType_ACTOR_TYPE_OPERATOR: mustParseIntEnv("RGS_IDENTITY_MAX_SESSIONS_OPERATOR", 0),
+		rgsv1.ActorType_ACTOR_TYPE_PLAYER:   mustParseIntEnv("RGS_IDENTITY_MAX_SESSIONS_PLAYER", 0),
+	}
+	identitySessionLimitPolicy, err := parseSessionLimitPolicy(envOr("RGS_IDENTITY_SESSION_LIMIT_POLICY", "deny"))
+	if err != nil {
+		log.Fatalf("invalid RGS_IDENTITY_SESSION_LIMIT_POLICY: %v", err)
+	}
+	passwordPolicy := server.PasswordPolicy{
+		MinLength:           mustParseIntEnv("RGS_PASSWORD_MIN_LENGTH", server.DefaultPasswordPolicy.MinLength),
+		MinCharacterClasses: mustParseIntEnv("RGS_PASSWORD_MIN_CHARACTER_CLASSES", server.DefaultPasswordPolicy.MinCharacterClasses),
+		HistorySize:         mustParseIntEnv("RGS_PASSWORD_HISTORY", server.DefaultPasswordPolicy.HistorySize),
+		MaxAge:              mustParseDurationEnv("RGS_PASSWORD_MAX_AGE", "0s"),
+	}
+	playerRateLimitMaxRequests := mustParseIntEnv("RGS_PLAYER_RATE_LIMIT_MAX_REQUESTS", 30)
+	playerRateLimitWindow := mustParseDurationEnv("RGS_PLAYER_RATE_LIMIT_WINDOW", "1m")
+	playerLimitCoolingOff := mustParseDurationEnv("RGS_PLAYER_LIMIT_COOLING_OFF", "24h")
+	sessionMaxDuration := mustParseDurationEnv("RGS_SESSION_MAX_DURATION", "0s")
+	sessionCoolOff := mustParseDurationEnv("RGS_SESSION_COOL_OFF", "0s")
+	sessionMaxDurationCheckInterval := mustParseDurationEnv("RGS_SESSION_MAX_DURATION_CHECK_INTERVAL", "1m")
+	sessionRealityCheckInterval := mustParseDurationEnv("RGS_SESSION_REALITY_CHECK_INTERVAL", "0s")
+	sessionRealityCheckSweepInterval := mustParseDurationEnv("RGS_SESSION_REALITY_CHECK_SWEEP_INTERVAL", "1m")
+	rbacCacheTTL := mustParseDurationEnv("RGS_RBAC_CACHE_TTL", "5s")
 	eftFraudMaxFailures := mustParseIntEnv("RGS_EFT_FRAUD_MAX_FAILURES", 5)
 	eftFraudLockoutTTL := mustParseDurationEnv("RGS_EFT_FRAUD_LOCKOUT_TTL", "15m")
 	idempotencyTTL := mustParseDurationEnv("RGS_LEDGER_IDEMPOTENCY_TTL", "24h")
@@ -65,10 +118,169 @@ func main() {
 	idempotencyCleanupBatch := mustParseIntEnv("RGS_LEDGER_IDEMPOTENCY_CLEANUP_BATCH", 500)
 	metricsRefreshInterval := mustParseDurationEnv("RGS_METRICS_REFRESH_INTERVAL", "1m")
 	remoteAccessActivityLogCap := mustParseIntEnv("RGS_REMOTE_ACCESS_ACTIVITY_LOG_CAP", 5000)
+	authFailureAuditMaxPerWindow := mustParseIntEnv("RGS_AUTH_FAILURE_AUDIT_MAX_PER_WINDOW", 20)
+	authFailureAuditWindow := mustParseDurationEnv("RGS_AUTH_FAILURE_AUDIT_WINDOW", "1m")
+	loadShedMaxInFlight := mustParseIntEnv("RGS_LOAD_SHED_MAX_IN_FLIGHT", 512)
+	loadShedStandardLimit := mustParseIntEnv("RGS_LOAD_SHED_STANDARD_LIMIT", 384)
+	loadShedLowLimit := mustParseIntEnv("RGS_LOAD_SHED_LOW_LIMIT", 256)
+	dailyPackCheckInterval := mustParseDurationEnv("RGS_DAILY_PACK_CHECK_INTERVAL", "15m")
+	dailyPackCloseOffset := mustParseDurationEnv("RGS_DAILY_PACK_CLOSE_OFFSET", "0s")
+	reportWorkers := mustParseIntEnv("RGS_REPORT_WORKERS", 2)
+	reportQueueSize := mustParseIntEnv("RGS_REPORT_QUEUE_SIZE", 64)
+	reportRunSignerKID := envOr("RGS_REPORT_RUN_SIGNER_KID", evidence.DefaultVerifyEvidenceAttestationKeyID)
+	reportRunVerifyKIDs := envOr("RGS_REPORT_RUN_VERIFY_KIDS", "")
+	reportOperatorScopesSpec := envOr("RGS_REPORT_OPERATOR_SCOPES", "")
+	reportRetentionDays := mustParseIntEnv("RGS_REPORT_RETENTION_DAYS", 0)
+	reportRetentionCheckInterval := mustParseDurationEnv("RGS_REPORT_RETENTION_CHECK_INTERVAL", "6h")
+	reportRetentionBatch := mustParseIntEnv("RGS_REPORT_RETENTION_BATCH", 500)
+	reportDeliveryInterval := mustParseDurationEnv("RGS_REPORT_DELIVERY_INTERVAL", "30s")
+	reportDeliveryMaxAttempts := mustParseIntEnv("RGS_REPORT_DELIVERY_MAX_ATTEMPTS", 5)
+	reportDeliveryReportsSpec := envOr("RGS_REPORT_DELIVERY_REPORTS", "")
+	reportDeliveryS3Bucket := envOr("RGS_REPORT_DELIVERY_S3_BUCKET", "")
+	reportDeliveryS3Region := envOr("RGS_REPORT_DELIVERY_S3_REGION", "")
+	reportDeliveryS3Endpoint := envOr("RGS_REPORT_DELIVERY_S3_ENDPOINT", "")
+	reportDeliveryS3Prefix := envOr("RGS_REPORT_DELIVERY_S3_PREFIX", "")
+	reportDeliverySFTPAddr := envOr("RGS_REPORT_DELIVERY_SFTP_ADDR", "")
+	reportDeliverySFTPDir := envOr("RGS_REPORT_DELIVERY_SFTP_DIR", "")
+	reportDeliverySFTPUser := envOr("RGS_REPORT_DELIVERY_SFTP_USER", "")
+	reportDeliverySFTPPassword := envOr("RGS_REPORT_DELIVERY_SFTP_PASSWORD", "")
+	reportDeliverySFTPKeyFile := envOr("RGS_REPORT_DELIVERY_SFTP_KEY_FILE", "")
+	reportDeliverySFTPHostKey := envOr("RGS_REPORT_DELIVERY_SFTP_HOST_KEY", "")
+	reportDeliverySMTPAddr := envOr("RGS_REPORT_DELIVERY_SMTP_ADDR", "")
+	reportDeliverySMTPFrom := envOr("RGS_REPORT_DELIVERY_SMTP_FROM", "")
+	reportDeliverySMTPTo := envOr("RGS_REPORT_DELIVERY_SMTP_TO", "")
+	reportDeliverySMTPUsername := envOr("RGS_REPORT_DELIVERY_SMTP_USERNAME", "")
+	reportDeliverySMTPPassword := envOr("RGS_REPORT_DELIVERY_SMTP_PASSWORD", "")
+	gamingTimeZone := envOr("RGS_GAMING_TIME_ZONE", "UTC")
+	gamingDayStart := envOr("RGS_GAMING_DAY_START", "")
+	tenantGamingCalendarsSpec := envOr("RGS_TENANT_GAMING_CALENDARS", "")
+	dailyPackReportsSpec := envOr("RGS_DAILY_PACK_REPORTS", "")
+	dailyPackFormatSpec := envOr("RGS_DAILY_PACK_FORMAT", "json")
+	dailyPackOperatorID := envOr("RGS_DAILY_PACK_OPERATOR_ID", "")
+	dailyPackSignerKID := envOr("RGS_DAILY_PACK_SIGNER_KID", "default")
+	dailyPackSigningKeysSpec := envOr("RGS_DAILY_PACK_SIGNING_KEYS", "")
+	dailyPackSinkDirs := envOr("RGS_DAILY_PACK_SINK_DIRS", "")
+	auditExportCheckInterval := mustParseDurationEnv("RGS_AUDIT_EXPORT_CHECK_INTERVAL", "1h")
+	auditExportSignerKID := envOr("RGS_AUDIT_EXPORT_SIGNER_KID", evidence.DefaultVerifyEvidenceAttestationKeyID)
+	auditExportSinkDirs := envOr("RGS_AUDIT_EXPORT_SINK_DIRS", "")
+	auditExportS3Bucket := envOr("RGS_AUDIT_EXPORT_S3_BUCKET", "")
+	auditExportS3Region := envOr("RGS_AUDIT_EXPORT_S3_REGION", "")
+	auditExportS3Endpoint := envOr("RGS_AUDIT_EXPORT_S3_ENDPOINT", "")
+	auditExportS3Prefix := envOr("RGS_AUDIT_EXPORT_S3_PREFIX", "")
+	auditExportS3RetainDays := mustParseIntEnv("RGS_AUDIT_EXPORT_S3_RETAIN_DAYS", 0)
+	auditAnchorTSAURL := envOr("RGS_AUDIT_ANCHOR_TSA_URL", "")
+	auditAnchorCheckInterval := mustParseDurationEnv("RGS_AUDIT_ANCHOR_CHECK_INTERVAL", "1h")
+	auditSealCheckInterval := mustParseDurationEnv("RGS_AUDIT_SEAL_CHECK_INTERVAL", "1h")
+	auditRetentionDays := mustParseIntEnv("RGS_AUDIT_RETENTION_DAYS", 0)
+	auditRetentionCheckInterval := mustParseDurationEnv("RGS_AUDIT_RETENTION_CHECK_INTERVAL", "6h")
+	auditChainVerifyInterval := mustParseDurationEnv("RGS_AUDIT_CHAIN_VERIFY_INTERVAL", "15m")
+	auditChainVerifyDays := mustParseIntEnv("RGS_AUDIT_CHAIN_VERIFY_DAYS", 2)
+	auditChainAlertWebhookURL := envOr("RGS_AUDIT_CHAIN_ALERT_WEBHOOK_URL", "")
+	auditEventSignerKID := envOr("RGS_AUDIT_EVENT_SIGNER_KID", "")
+	auditReportSignerKID := envOr("RGS_AUDIT_REPORT_SIGNER_KID", "")
+	auditSyslogAddr := envOr("RGS_AUDIT_SYSLOG_ADDR", "")
+	auditSyslogNetwork := envOr("RGS_AUDIT_SYSLOG_NETWORK", "udp")
+	auditSyslogFormat := envOr("RGS_AUDIT_SYSLOG_FORMAT", server.AuditSyslogFormatCEF)
+	auditSyslogFacility := envOr("RGS_AUDIT_SYSLOG_FACILITY", "local0")
+	auditSyslogSeverities := envOr("RGS_AUDIT_SYSLOG_SEVERITIES", "")
+	dailyPackRecipients, err := parseDailyPackRecipients(envOr("RGS_DAILY_PACK_SINK_RECIPIENTS", ""))
+	if err != nil {
+		log.Fatalf("invalid RGS_DAILY_PACK_SINK_RECIPIENTS: %v", err)
+	}
+	wagerSettlementSLA := mustParseDurationEnv("RGS_WAGER_SETTLEMENT_SLA", "30m")
+	wagerSettlementTimeout := mustParseDurationEnv("RGS_WAGER_SETTLEMENT_TIMEOUT", envOr("RGS_WAGER_AUTO_VOID_AFTER", "0s"))
+	wagerSettlementCheckInterval := mustParseDurationEnv("RGS_WAGER_SETTLEMENT_CHECK_INTERVAL", "1m")
+	wagerLedgerIntegration := mustParseBoolEnv("RGS_WAGER_LEDGER_INTEGRATION", false)
+	transferAckTimeout := mustParseDurationEnv("RGS_LEDGER_TRANSFER_ACK_TIMEOUT", "5m")
+	transferTimeoutCheckInterval := mustParseDurationEnv("RGS_LEDGER_TRANSFER_TIMEOUT_CHECK_INTERVAL", "30s")
+	eventsClockSkewThreshold := mustParseDurationEnv("RGS_EVENTS_CLOCK_SKEW_THRESHOLD", "30s")
+	eventsClockSkewChronicAfter := mustParseIntEnv("RGS_EVENTS_CLOCK_SKEW_CHRONIC_AFTER", 5)
+	ingestionBufferCapacity := mustParseIntEnv("RGS_INGESTION_BUFFER_CAPACITY", 1024)
+	ingestionBufferOverflow := envOr("RGS_INGESTION_BUFFER_OVERFLOW", string(server.IngestionOverflowDisable))
+	ingestionRetryMaxAttempts := mustParseIntEnv("RGS_INGESTION_RETRY_MAX_ATTEMPTS", server.DefaultIngestionRetryPolicy.MaxAttempts)
+	ingestionRetryBackoff := mustParseDurationEnv("RGS_INGESTION_RETRY_BACKOFF", "5s")
+	ingestionRetryMaxBackoff := mustParseDurationEnv("RGS_INGESTION_RETRY_MAX_BACKOFF", "5m")
+	ingestionRetryInterval := mustParseDurationEnv("RGS_INGESTION_RETRY_INTERVAL", "5s")
+	sasGatewayAddr := envOr("RGS_SAS_GATEWAY_ADDR", "")
+	sasMachinesSpec := envOr("RGS_SAS_MACHINES", "")
+	sasPollInterval := mustParseDurationEnv("RGS_SAS_POLL_INTERVAL", "10s")
+	sasMeterCurrency := strings.ToUpper(envOr("RGS_SAS_METER_CURRENCY", "USD"))
+	sasDenominationMinor := mustParseIntEnv("RGS_SAS_DENOMINATION_MINOR", 1)
+	alertSMTPAddr := envOr("RGS_ALERT_SMTP_ADDR", "")
+	alertSMTPFrom := envOr("RGS_ALERT_SMTP_FROM", "")
+	alertSMTPUsername := envOr("RGS_ALERT_SMTP_USERNAME", "")
+	alertSMTPPassword := envOr("RGS_ALERT_SMTP_PASSWORD", "")
+	enrollmentCACertFile := envOr("RGS_ENROLLMENT_CA_CERT_FILE", "")
+	enrollmentCAKeyFile := envOr("RGS_ENROLLMENT_CA_KEY_FILE", "")
+	enrollmentCertValidity := mustParseDurationEnv("RGS_ENROLLMENT_CERT_VALIDITY", "8760h")
+	outboxPublishURL := envOr("RGS_OUTBOX_PUBLISH_URL", "")
+	outboxDispatchInterval := mustParseDurationEnv("RGS_OUTBOX_DISPATCH_INTERVAL", "5s")
+	outboxDispatchBatch := mustParseIntEnv("RGS_OUTBOX_DISPATCH_BATCH", 100)
+	outboxMaxAttempts := mustParseIntEnv("RGS_OUTBOX_MAX_ATTEMPTS", 10)
+	kafkaBrokers := envOr("RGS_KAFKA_BROKERS", "")
+	kafkaTopicRoutes, err := server.ParseKafkaTopicRoutes(envOr("RGS_KAFKA_TOPICS", "audit_event=rgs.audit,significant_event=rgs.significant-events,*=rgs.domain-events"))
+	if err != nil {
+		log.Fatalf("invalid RGS_KAFKA_TOPICS: %v", err)
+	}
+	kafkaTLS := mustParseBoolEnv("RGS_KAFKA_TLS", false)
+	outboxAuditEvents := mustParseBoolEnv("RGS_OUTBOX_AUDIT_EVENTS", kafkaBrokers != "")
+	schedulerPollInterval := mustParseDurationEnv("RGS_SCHEDULER_POLL_INTERVAL", "1s")
+	schedulerLeaseTTL := mustParseDurationEnv("RGS_SCHEDULER_LEASE_TTL", "30s")
+	schedulerInstanceID := envOr("RGS_SCHEDULER_INSTANCE_ID", defaultSchedulerInstanceID())
+	schedulerRetryMaxAttempts := mustParseIntEnv("RGS_SCHEDULER_RETRY_MAX_ATTEMPTS", 3)
+	schedulerRetryBackoff := mustParseDurationEnv("RGS_SCHEDULER_RETRY_BACKOFF", "30s")
+	schedulerRetryMaxBackoff := mustParseDurationEnv("RGS_SCHEDULER_RETRY_MAX_BACKOFF", "10m")
+	jobSchedules, err := parseJobSchedules(envOr("RGS_SCHEDULER_JOB_SCHEDULES", ""))
+	if err != nil {
+		log.Fatalf("invalid RGS_SCHEDULER_JOB_SCHEDULES: %v", err)
+	}
+	rpcBudgetOverrides, err := parseLatencyBudgets(envOr("RGS_RPC_LATENCY_BUDGETS", ""))
+	if err != nil {
+		log.Fatalf("invalid RGS_RPC_LATENCY_BUDGETS: %v", err)
+	}
+	rpcBudgets, err := server.NewLatencyBudgets(rpcBudgetOverrides)
+	if err != nil {
+		log.Fatalf("invalid RGS_RPC_LATENCY_BUDGETS: %v", err)
+	}
+	stepUpMethods := server.DefaultStepUpMethods
+	if spec := envOr("RGS_STEP_UP_METHODS", ""); spec != "" {
+		stepUpMethods = nil
+		for _, m := range strings.Split(spec, ",") {
+			if m = strings.TrimSpace(m); m != "" {
+				stepUpMethods = append(stepUpMethods, "/"+strings.TrimPrefix(m, "/"))
+			}
+		}
+	}
+	stepUp, err := server.NewStepUpPolicy(clk, stepUpMethods, mustParseDurationEnv("RGS_STEP_UP_MAX_AGE", "5m"), envOr("RGS_STEP_UP_MIN_STRENGTH", platformauth.AuthStrengthPassword))
+	if err != nil {
+		log.Fatalf("invalid step-up policy: %v", err)
+	}
+	databaseURL, err = applyDatabaseStatementTimeout(databaseURL, mustParseDurationEnv("RGS_DATABASE_STATEMENT_TIMEOUT", "0s"), rpcBudgets)
+	if err != nil {
+		log.Fatalf("invalid database timeout: %v", err)
+	}
+	wagerEscalateOnTimeout, err := parseWagerTimeoutAction(envOr("RGS_WAGER_SETTLEMENT_TIMEOUT_ACTION", "void"))
+	if err != nil {
+		log.Fatalf("invalid RGS_WAGER_SETTLEMENT_TIMEOUT_ACTION: %v", err)
+	}
 	tlsEnabled := envOr("RGS_TLS_ENABLED", "false") == "true"
 	tlsRequireClientCert := envOr("RGS_TLS_REQUIRE_CLIENT_CERT", "false") == "true"
 	strictProductionMode := mustParseBoolEnv("RGS_STRICT_PRODUCTION_MODE", version != "dev")
 	strictExternalJWTKeyset := mustParseBoolEnv("RGS_STRICT_EXTERNAL_JWT_KEYSET", strictProductionMode)
+	defaultGamingCalendar, err := server.ParseGamingCalendar(gamingTimeZone, gamingDayStart)
+	if err != nil {
+		log.Fatalf("invalid gaming calendar: %v", err)
+	}
+	if gamingDayStart == "" {
+		// Deployments that predate gaming calendars set the day boundary as a
+		// UTC offset on the daily pack.
+		defaultGamingCalendar.DayStart = dailyPackCloseOffset
+	}
+	tenantGamingCalendars, err := server.ParseTenantGamingCalendars(tenantGamingCalendarsSpec)
+	if err != nil {
+		log.Fatalf("invalid RGS_TENANT_GAMING_CALENDARS: %v", err)
+	}
+	server.SetGamingCalendars(server.GamingCalendars{Default: defaultGamingCalendar, ByTenant: tenantGamingCalendars})
 	if err := validateProductionRuntime(strictProductionMode, strictExternalJWTKeyset, databaseURL, tlsEnabled, jwtSigningSecret, jwtKeysetSpec, jwtKeysetFile, jwtKeysetCommand); err != nil {
 		log.Fatalf("invalid production runtime configuration: %v", err)
 	}
@@ -91,15 +303,55 @@ func main() {
 	jwtSigner := platformauth.NewJWTSignerWithKeyset(jwtKeyset)
 	jwtVerifier := platformauth.NewJWTVerifierWithKeyset(jwtKeyset)
 	metrics := server.NewMetrics()
+	loadShedder := server.NewLoadShedder(loadShedMaxInFlight, loadShedStandardLimit, loadShedLowLimit)
+	loadShedder.SetShedObserver(metrics.ObserveLoadShed)
+	rpcBudgets.SetViolationObserver(metrics.ObserveLatencyBudgetViolation)
+	roleSvc := server.NewRoleService(clk)
+	roleSvc.SetCacheTTL(rbacCacheTTL)
+	// With mTLS required, a verified client certificate bound to a service
+	// actor in the registry authenticates calls that carry no bearer token.
+	// The registry is built further down, once the database is open.
+	var registrySvc *server.RegistryService
+	var certActors platformauth.CertificateActorResolver
+	if tlsRequireClientCert {
+		certActors = platformauth.CertificateActorResolverFunc(func(ctx context.Context, cert *x509.Certificate) (platformauth.Actor, bool, error) {
+			return registrySvc.ActorForCertificate(ctx, cert)
+		})
+	}
+	// Operator and service IP allowlists live in the identity service,
+	// which is built once the database is open.
+	var identitySvc *server.IdentityService
+	ipAllowlists := server.IPAllowlistCheckerFunc(func(ctx context.Context, ip, target string) error {
+		return identitySvc.CheckIPAllowlist(ctx, ip, target)
+	})
 	grpcOpts := []grpc.ServerOption{
 		grpc.ChainUnaryInterceptor(
 			server.UnaryMetricsInterceptor(metrics),
-			platformauth.UnaryJWTInterceptor(jwtVerifier, []string{
+			server.UnaryLoadSheddingInterceptor(loadShedder),
+			server.UnaryLatencyBudgetInterceptor(rpcBudgets),
+			platformauth.UnaryAuthInterceptor(jwtVerifier, certActors, []string{
 				"/rgs.v1.SystemService/GetSystemStatus",
+				"/rgs.v1.SystemService/GetStatusPage",
 				"/rgs.v1.IdentityService/Login",
 				"/rgs.v1.IdentityService/RefreshToken",
+				"/rgs.v1.IdentityService/ChangeCredential",
+				"/rgs.v1.IdentityService/BeginWebAuthnLogin",
+				"/rgs.v1.IdentityService/FinishWebAuthnLogin",
 				"/grpc.health.v1.Health/Check",
 			}),
+			server.UnaryIPAllowlistInterceptor(ipAllowlists),
+			server.UnaryImpersonationInterceptor(),
+			server.UnaryRBACInterceptor(roleSvc),
+			server.UnaryStepUpInterceptor(stepUp),
+		),
+		grpc.ChainStreamInterceptor(
+			server.StreamMetricsInterceptor(metrics),
+			server.StreamLoadSheddingInterceptor(loadShedder),
+			platformauth.StreamAuthInterceptor(jwtVerifier, certActors, nil),
+			server.StreamIPAllowlistInterceptor(ipAllowlists),
+			server.StreamImpersonationInterceptor(),
+			server.StreamRBACInterceptor(roleSvc),
+			server.StreamStepUpInterceptor(stepUp),
 		),
 	}
 	if tlsCfg != nil {
@@ -117,17 +369,75 @@ func main() {
 		}
 		defer db.Close()
 	}
+	var reportingDB *sql.DB
+	if reportingDatabaseURL != "" {
+		if db == nil {
+			log.Fatalf("RGS_REPORTING_DATABASE_URL requires RGS_DATABASE_URL")
+		}
+		var err error
+		reportingDB, err = sql.Open("pgx", reportingDatabaseURL)
+		if err != nil {
+			log.Fatalf("open reporting database: %v", err)
+		}
+		if err := reportingDB.PingContext(ctx); err != nil {
+			log.Fatalf("ping reporting database: %v", err)
+		}
+		defer reportingDB.Close()
+	}
+	scheduler := server.NewJobScheduler(clk, schedulerInstanceID, db)
+	scheduler.SetLeaseTTL(schedulerLeaseTTL)
+	scheduler.SetDefaultRetryPolicy(server.RetryPolicy{MaxAttempts: schedulerRetryMaxAttempts, Backoff: schedulerRetryBackoff, MaxBackoff: schedulerRetryMaxBackoff})
+	scheduler.SetLogger(log.Printf)
+	scheduler.SetRunObserver(metrics.ObserveSchedulerJobRun)
 	grpcServer := grpc.NewServer(grpcOpts...)
 	hs := health.NewServer()
 	hs.SetServingStatus("", healthv1.HealthCheckResponse_SERVING)
 	healthv1.RegisterHealthServer(grpcServer, hs)
-	systemSvc := server.SystemService{StartedAt: startedAt, Clock: clk, Version: version}
+	incidentBoard := server.NewIncidentBoard(clk, db)
+	incidentBoard.SetDisableInMemoryCache(strictProductionMode)
+	smokeChecker := server.NewSmokeChecker(clk, db)
+	smokeChecker.Signer = jwtSigner
+	smokeChecker.Verifier = jwtVerifier
+	systemSvc := server.SystemService{StartedAt: startedAt, Clock: clk, Version: version, Incidents: incidentBoard, Scheduler: scheduler, Smoke: smokeChecker}
 	rgsv1.RegisterSystemServiceServer(grpcServer, systemSvc)
-	identitySvc := server.NewIdentityService(clk, jwtSigningSecret, jwtAccessTTL, jwtRefreshTTL, db)
+	identitySvc = server.NewIdentityService(clk, jwtSigningSecret, jwtAccessTTL, jwtRefreshTTL, db)
 	identitySvc.SetJWTSigner(jwtSigner)
 	identitySvc.SetLockoutPolicy(identityLockoutMaxFailures, identityLockoutTTL)
 	identitySvc.SetLoginRateLimit(identityLoginRateLimitMaxAttempts, identityLoginRateLimitWindow)
-	identitySvc.StartSessionCleanupWorker(ctx, identitySessionCleanupInterval, identitySessionCleanupBatch, log.Printf)
+	identitySvc.SetSessionLimits(identitySessionLimits, identitySessionLimitPolicy)
+	identitySvc.SetPasswordPolicy(passwordPolicy)
+	if webauthnRPID != "" {
+		var origins []string
+		for _, origin := range strings.Split(webauthnOrigins, ",") {
+			if origin = strings.TrimSpace(origin); origin != "" {
+				origins = append(origins, origin)
+			}
+		}
+		if len(origins) == 0 {
+			log.Fatalf("RGS_WEBAUTHN_ORIGINS is required when RGS_WEBAUTHN_RP_ID is set")
+		}
+		identitySvc.SetWebAuthnRelyingParty(webauthnRPID, webauthnRPName, origins)
+	}
+	if oidcIssuer != "" {
+		oidcVerifier, err := platformauth.NewOIDCVerifier(platformauth.OIDCConfig{Issuer: oidcIssuer, Audience: oidcAudience, JWKSURL: oidcJWKSURL})
+		if err != nil {
+			log.Fatalf("configure oidc: %v", err)
+		}
+		oidcRoleMap, err := parseOIDCRoleMap(oidcRoleMapSpec)
+		if err != nil {
+			log.Fatalf("invalid RGS_OIDC_ROLE_MAP: %v", err)
+		}
+		identitySvc.SetOIDC(oidcVerifier, server.OIDCClaimMapping{ActorClaim: oidcActorClaim, RolesClaim: oidcRolesClaim, RoleMap: oidcRoleMap}, roleSvc)
+	}
+	if db != nil {
+		registerScheduledJob(scheduler, jobSchedules, "identity_session_cleanup", identitySessionCleanupInterval, identitySvc.SessionCleanupJob(identitySessionCleanupBatch))
+	}
+	keysetSource := jwtKeysetSource(jwtKeysetFile, jwtKeysetCommand)
+	// The startup record attests which keyset this instance booted with.
+	if _, err := identitySvc.RecordKeyRotation(ctx, keyRotationRecord(jwtKeyset, keysetFingerprint, keysetSource, "startup", schedulerInstanceID)); err != nil {
+		log.Fatalf("record startup jwt keyset attestation: %v", err)
+	}
+	log.Printf("jwt keyset in effect (active_kid=%s fingerprint=%s source=%s)", jwtKeyset.ActiveKID, keysetFingerprint, keysetSource)
 	if (strings.TrimSpace(jwtKeysetFile) != "" || strings.TrimSpace(jwtKeysetCommand) != "") && jwtKeysetRefreshInterval > 0 {
 		go func() {
 			ticker := time.NewTicker(jwtKeysetRefreshInterval)
@@ -155,12 +465,16 @@ func main() {
 						continue
 					}
 					currentFingerprint = fingerprint
-					log.Printf("jwt keyset reloaded (active_kid=%s)", loaded.ActiveKID)
+					log.Printf("jwt keyset reloaded (active_kid=%s fingerprint=%s)", loaded.ActiveKID, fingerprint)
+					if _, err := identitySvc.RecordKeyRotation(ctx, keyRotationRecord(loaded, fingerprint, keysetSource, "refresh", schedulerInstanceID)); err != nil {
+						log.Printf("jwt keyset rotation history write failed: %v", err)
+					}
 				}
 			}
 		}()
 	}
-	if db != nil {
+	// With OIDC configured operators can sign in without a local credential.
+	if db != nil && oidcIssuer == "" {
 		ok, err := identitySvc.HasActiveCredentials(ctx)
 		if err != nil {
 			log.Fatalf("verify bootstrap identity credentials: %v", err)
@@ -173,7 +487,39 @@ func main() {
 	ledgerSvc := server.NewLedgerService(clk, db)
 	ledgerSvc.SetEFTFraudPolicy(eftFraudMaxFailures, eftFraudLockoutTTL)
 	ledgerSvc.SetDisableInMemoryIdempotencyCache(strictProductionMode)
+	ledgerSvc.SetTransferAckTimeout(transferAckTimeout)
+	registerScheduledJob(scheduler, jobSchedules, "ledger_transfer_timeout", transferTimeoutCheckInterval, ledgerSvc.TransferTimeoutJob())
 	identitySvc.SetMetricsObservers(metrics.ObserveIdentityLogin, metrics.ObserveIdentityLockoutActivation)
+	var lockoutNotifiers server.MultiLockoutNotifier
+	if lockoutWebhookURL != "" {
+		lockoutNotifiers = append(lockoutNotifiers, server.WebhookLockoutNotifier{URL: lockoutWebhookURL})
+	}
+	if lockoutPagerDutyRoutingKey != "" {
+		lockoutNotifiers = append(lockoutNotifiers, server.PagerDutyLockoutNotifier{RoutingKey: lockoutPagerDutyRoutingKey})
+	}
+	if lockoutSMTPAddr != "" {
+		var to []string
+		for _, addr := range strings.Split(lockoutSMTPTo, ",") {
+			if addr = strings.TrimSpace(addr); addr != "" {
+				to = append(to, addr)
+			}
+		}
+		if lockoutSMTPFrom == "" || len(to) == 0 {
+			log.Fatalf("RGS_LOCKOUT_SMTP_FROM and RGS_LOCKOUT_SMTP_TO are required when RGS_LOCKOUT_SMTP_ADDR is set")
+		}
+		lockoutNotifiers = append(lockoutNotifiers, server.EmailLockoutNotifier{
+			Addr: lockoutSMTPAddr,
+			From: lockoutSMTPFrom,
+			To:   to,
+			Auth: server.NewSMTPPlainAuth(lockoutSMTPAddr, lockoutSMTPUsername, lockoutSMTPPassword),
+		})
+	}
+	if len(lockoutNotifiers) > 0 {
+		lockoutNotifier := server.NewAsyncLockoutNotifier(lockoutNotifiers, 10*time.Second, log.Printf)
+		identitySvc.SetLockoutNotifier(lockoutNotifier)
+		ledgerSvc.SetLockoutNotifier(lockoutNotifier)
+	}
+	identitySvc.SetRefreshReuseObserver(metrics.ObserveIdentityRefreshTokenReuse)
 	if db != nil {
 		metrics.RefreshLedgerIdempotencyCounts(ctx, db)
 		metrics.RefreshIdentitySessionCounts(ctx, db)
@@ -194,39 +540,288 @@ func main() {
 		}
 	}
 	ledgerSvc.SetIdempotencyTTL(idempotencyTTL)
-	ledgerSvc.StartIdempotencyCleanupWorker(ctx, idempotencyCleanupInterval, idempotencyCleanupBatch, log.Printf, func(deleted int64, err error) {
-		metrics.ObserveLedgerIdempotencyCleanup(deleted, err)
-		if db != nil {
+	if db != nil {
+		registerScheduledJob(scheduler, jobSchedules, "ledger_idempotency_cleanup", idempotencyCleanupInterval, ledgerSvc.IdempotencyCleanupJob(idempotencyCleanupBatch, func(deleted int64, err error) {
+			metrics.ObserveLedgerIdempotencyCleanup(deleted, err)
 			metrics.RefreshLedgerIdempotencyCounts(ctx, db)
 			metrics.RefreshIdentitySessionCounts(ctx, db)
-		}
-	})
+		}))
+	}
 	rgsv1.RegisterLedgerServiceServer(grpcServer, ledgerSvc)
 	wageringSvc := server.NewWageringService(clk, db)
 	wageringSvc.SetDisableInMemoryIdempotencyCache(strictProductionMode)
+	wageringSvc.SetSettlementPolicy(server.WagerSettlementPolicy{SLA: wagerSettlementSLA, AutoVoidAfter: wagerSettlementTimeout, EscalateOnTimeout: wagerEscalateOnTimeout})
+	wageringSvc.SetMetricsObservers(metrics.ObserveWagerSettlement, metrics.ObserveWagerSettlementSweep)
 	rgsv1.RegisterWageringServiceServer(grpcServer, wageringSvc)
-	registrySvc := server.NewRegistryService(clk, db)
+	var outboxPublisher server.OutboxPublisher
+	switch {
+	case kafkaBrokers != "" && outboxPublishURL != "":
+		log.Fatalf("set only one of RGS_KAFKA_BROKERS and RGS_OUTBOX_PUBLISH_URL")
+	case kafkaBrokers != "":
+		kafkaPublisher := &server.KafkaOutboxPublisher{Routes: kafkaTopicRoutes}
+		for _, broker := range strings.Split(kafkaBrokers, ",") {
+			if broker = strings.TrimSpace(broker); broker != "" {
+				kafkaPublisher.Brokers = append(kafkaPublisher.Brokers, broker)
+			}
+		}
+		if kafkaTLS {
+			kafkaPublisher.TLSConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+		}
+		defer kafkaPublisher.Close()
+		outboxPublisher = kafkaPublisher
+	case outboxPublishURL != "":
+		outboxPublisher = server.HTTPOutboxPublisher{URL: outboxPublishURL}
+	}
+	server.SetAuditOutbox(db != nil && outboxAuditEvents)
+	if auditEventSignerKID != "" {
+		auditEventKey, err := evidence.ResolveEd25519PrivateKey(auditEventSignerKID)
+		if err != nil {
+			log.Fatalf("resolve audit event signing key: %v", err)
+		}
+		server.SetAuditEventSigner(auditEventSignerKID, auditEventKey)
+	}
+	outboxDispatcher := server.NewOutboxDispatcher(db, outboxPublisher)
+	outboxDispatcher.SetMaxAttempts(outboxMaxAttempts)
+	if db != nil && outboxPublisher != nil {
+		registerScheduledJob(scheduler, jobSchedules, "outbox_dispatch", outboxDispatchInterval, outboxDispatcher.DispatchJob(outboxDispatchBatch))
+	}
+	registrySvc = server.NewRegistryService(clk, db)
 	registrySvc.SetDisableInMemoryCache(strictProductionMode)
+	if enrollmentCACertFile != "" {
+		enrollmentCA, err := server.LoadEnrollmentCA(enrollmentCACertFile, enrollmentCAKeyFile, enrollmentCertValidity)
+		if err != nil {
+			log.Fatalf("load enrollment ca: %v", err)
+		}
+		registrySvc.EnrollmentCA = enrollmentCA
+	}
 	rgsv1.RegisterRegistryServiceServer(grpcServer, registrySvc)
 	eventsSvc := server.NewEventsService(clk, db)
 	eventsSvc.SetDisableInMemoryCache(strictProductionMode)
+	eventsSvc.SetClockSkewThreshold(eventsClockSkewThreshold, eventsClockSkewChronicAfter)
+	if err := eventsSvc.SetIngestionBuffer(server.IngestionBufferConfig{
+		Capacity: ingestionBufferCapacity,
+		Overflow: server.IngestionOverflowPolicy(ingestionBufferOverflow),
+		Retry:    server.RetryPolicy{MaxAttempts: ingestionRetryMaxAttempts, Backoff: ingestionRetryBackoff, MaxBackoff: ingestionRetryMaxBackoff},
+	}); err != nil {
+		log.Fatalf("ingestion buffer: %v", err)
+	}
+	eventsSvc.SetIngestionBufferObservers(metrics.ObserveIngestionBufferDepth, metrics.ObserveIngestionBufferDrop)
+	registerScheduledJob(scheduler, jobSchedules, "ingestion_buffer_retry", ingestionRetryInterval, eventsSvc.IngestionRetryJob(100))
+	registrySvc.Events = eventsSvc
+	registrySvc.SetServiceActorDirectory(identitySvc)
+	eventsSvc.Registry = registrySvc
+	if alertSMTPAddr != "" && alertSMTPFrom == "" {
+		log.Fatalf("RGS_ALERT_SMTP_FROM is required when RGS_ALERT_SMTP_ADDR is set")
+	}
+	eventsSvc.SetAlertSender(server.EventAlertDispatcher{
+		SMTPAddr: alertSMTPAddr,
+		SMTPFrom: alertSMTPFrom,
+		SMTPAuth: server.NewSMTPPlainAuth(alertSMTPAddr, alertSMTPUsername, alertSMTPPassword),
+	}, 10*time.Second)
 	rgsv1.RegisterEventsServiceServer(grpcServer, eventsSvc)
 	reportingSvc := server.NewReportingService(clk, ledgerSvc, eventsSvc, db)
 	reportingSvc.SetDisableInMemoryCache(strictProductionMode)
+	reportingSvc.SetReadDB(reportingDB)
+	reportingSvc.Registry = registrySvc
+	reportRunKey, err := evidence.ResolveEd25519PrivateKey(reportRunSignerKID)
+	if err != nil {
+		log.Fatalf("resolve report run signing key: %v", err)
+	}
+	reportingSvc.SetReportRunSigner(reportRunSignerKID, reportRunKey)
+	if reportRunVerifyKIDs != "" {
+		verifyKeys := make(map[string]ed25519.PublicKey)
+		for _, kid := range strings.Split(reportRunVerifyKIDs, ",") {
+			kid = strings.TrimSpace(kid)
+			if kid == "" {
+				continue
+			}
+			pub, err := evidence.ResolveEd25519PublicKey(kid)
+			if err != nil {
+				log.Fatalf("resolve report run verification key %q: %v", kid, err)
+			}
+			verifyKeys[kid] = pub
+		}
+		reportingSvc.SetReportRunVerifyKeys(verifyKeys)
+	}
+	reportOperatorScopes, err := server.ParseReportOperatorScopes(reportOperatorScopesSpec)
+	if err != nil {
+		log.Fatalf("invalid RGS_REPORT_OPERATOR_SCOPES: %v", err)
+	}
+	reportingSvc.SetOperatorScopes(reportOperatorScopes)
+	reportingSvc.StartReportWorkers(ctx, reportWorkers, reportQueueSize)
+	dailyPackReports, err := parseDailyPackReportTypes(dailyPackReportsSpec)
+	if err != nil {
+		log.Fatalf("invalid RGS_DAILY_PACK_REPORTS: %v", err)
+	}
+	dailyPackFormat, err := parseDailyPackFormat(dailyPackFormatSpec)
+	if err != nil {
+		log.Fatalf("invalid RGS_DAILY_PACK_FORMAT: %v", err)
+	}
+	dailyPackSinks := make([]server.DailyPackSink, 0)
+	for _, dir := range strings.Split(dailyPackSinkDirs, ",") {
+		if dir = strings.TrimSpace(dir); dir == "" {
+			continue
+		}
+		keyFiles, encrypt := dailyPackRecipients[dir]
+		if !encrypt {
+			dailyPackSinks = append(dailyPackSinks, server.DirectoryDailyPackSink{Dir: dir})
+			continue
+		}
+		delete(dailyPackRecipients, dir)
+		keyrings := make([][]byte, 0, len(keyFiles))
+		for _, path := range keyFiles {
+			raw, err := os.ReadFile(path)
+			if err != nil {
+				log.Fatalf("read daily pack recipient key for %s: %v", dir, err)
+			}
+			keyrings = append(keyrings, raw)
+		}
+		sink, err := server.NewEncryptedDailyPackSink(server.DirectoryDailyPackSink{Dir: dir, Ext: ".json.gpg"}, keyrings...)
+		if err != nil {
+			log.Fatalf("daily pack sink %s: %v", dir, err)
+		}
+		dailyPackSinks = append(dailyPackSinks, sink)
+	}
+	for dir := range dailyPackRecipients {
+		log.Fatalf("RGS_DAILY_PACK_SINK_RECIPIENTS names %s, which is not in RGS_DAILY_PACK_SINK_DIRS", dir)
+	}
+	reportingSvc.SetDailyPackConfig(server.DailyPackConfig{
+		OperatorID:  dailyPackOperatorID,
+		ReportTypes: dailyPackReports,
+		Format:      dailyPackFormat,
+		SignerKID:   dailyPackSignerKID,
+		SigningKey:  parseKeyValueSecrets(dailyPackSigningKeysSpec)[dailyPackSignerKID],
+		Sinks:       dailyPackSinks,
+	})
+	reportDeliveryTargets := make([]server.ReportDeliveryTarget, 0)
+	if reportDeliveryS3Bucket != "" {
+		if reportDeliveryS3Region == "" {
+			log.Fatalf("RGS_REPORT_DELIVERY_S3_REGION is required when RGS_REPORT_DELIVERY_S3_BUCKET is set")
+		}
+		reportDeliveryTargets = append(reportDeliveryTargets, server.S3ReportDeliveryTarget{S3AuditExportSink: server.S3AuditExportSink{
+			Endpoint:        reportDeliveryS3Endpoint,
+			Region:          reportDeliveryS3Region,
+			Bucket:          reportDeliveryS3Bucket,
+			Prefix:          reportDeliveryS3Prefix,
+			AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+			SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+		}})
+	}
+	if reportDeliverySFTPAddr != "" {
+		var privateKey []byte
+		if reportDeliverySFTPKeyFile != "" {
+			privateKey, err = os.ReadFile(reportDeliverySFTPKeyFile)
+			if err != nil {
+				log.Fatalf("read RGS_REPORT_DELIVERY_SFTP_KEY_FILE: %v", err)
+			}
+		}
+		target, err := server.NewSFTPReportDeliveryTarget(reportDeliverySFTPAddr, reportDeliverySFTPDir, reportDeliverySFTPUser, reportDeliverySFTPPassword, privateKey, reportDeliverySFTPHostKey)
+		if err != nil {
+			log.Fatalf("report delivery sftp target: %v", err)
+		}
+		reportDeliveryTargets = append(reportDeliveryTargets, target)
+	}
+	if reportDeliverySMTPAddr != "" {
+		var to []string
+		for _, addr := range strings.Split(reportDeliverySMTPTo, ",") {
+			if addr = strings.TrimSpace(addr); addr != "" {
+				to = append(to, addr)
+			}
+		}
+		if reportDeliverySMTPFrom == "" || len(to) == 0 {
+			log.Fatalf("RGS_REPORT_DELIVERY_SMTP_FROM and RGS_REPORT_DELIVERY_SMTP_TO are required when RGS_REPORT_DELIVERY_SMTP_ADDR is set")
+		}
+		reportDeliveryTargets = append(reportDeliveryTargets, server.EmailReportDeliveryTarget{
+			Addr: reportDeliverySMTPAddr,
+			From: reportDeliverySMTPFrom,
+			To:   to,
+			Auth: server.NewSMTPPlainAuth(reportDeliverySMTPAddr, reportDeliverySMTPUsername, reportDeliverySMTPPassword),
+		})
+	}
+	if len(reportDeliveryTargets) > 0 {
+		reportDeliveryReports, err := parseDailyPackReportTypes(reportDeliveryReportsSpec)
+		if err != nil {
+			log.Fatalf("invalid RGS_REPORT_DELIVERY_REPORTS: %v", err)
+		}
+		reportingSvc.SetReportDeliveryConfig(server.ReportDeliveryConfig{
+			Targets:     reportDeliveryTargets,
+			ReportTypes: reportDeliveryReports,
+			MaxAttempts: reportDeliveryMaxAttempts,
+		})
+	}
 	rgsv1.RegisterReportingServiceServer(grpcServer, reportingSvc)
 	configSvc := server.NewConfigService(clk, db)
 	configSvc.SetDisableInMemoryCache(strictProductionMode)
 	configSvc.SetDownloadSignatureKeys(parseKeyValueSecrets(downloadSigningKeysSpec))
+	if downloadPublicKeyRingFile != "" {
+		raw, err := os.ReadFile(downloadPublicKeyRingFile)
+		if err != nil {
+			log.Fatalf("read RGS_DOWNLOAD_PUBLIC_KEYRING_FILE: %v", err)
+		}
+		downloadPublicKeys, err := server.ParseDownloadPublicKeyRing(raw)
+		if err != nil {
+			log.Fatalf("invalid RGS_DOWNLOAD_PUBLIC_KEYRING_FILE: %v", err)
+		}
+		configSvc.SetDownloadPublicKeys(downloadPublicKeys)
+	}
+	configApprovalPolicy, err := server.ParseConfigApprovalPolicy(configApprovalPolicySpec)
+	if err != nil {
+		log.Fatalf("invalid RGS_CONFIG_APPROVAL_POLICY: %v", err)
+	}
+	configSvc.SetApprovalPolicy(configApprovalPolicy)
+	ledgerSvc.SetFXRateSource(configSvc)
+	ledgerSvc.SetBalanceCapSource(configSvc)
+	wageringSvc.Settings = configSvc
+	reportingSvc.Config = configSvc
+	reportingSvc.Wagering = wageringSvc
 	rgsv1.RegisterConfigServiceServer(grpcServer, configSvc)
 	promotionsSvc := server.NewPromotionsService(clk, db)
 	promotionsSvc.SetDisableInMemoryCache(strictProductionMode)
 	rgsv1.RegisterPromotionsServiceServer(grpcServer, promotionsSvc)
+	reportingSvc.Promotions = promotionsSvc
 	uiOverlaySvc := server.NewUISystemOverlayService(clk, db)
 	uiOverlaySvc.SetDisableInMemoryCache(strictProductionMode)
+	uiOverlaySvc.Registry = registrySvc
 	rgsv1.RegisterUISystemOverlayServiceServer(grpcServer, uiOverlaySvc)
 	sessionsSvc := server.NewSessionsService(clk, db)
 	sessionsSvc.SetDisableInMemoryCache(strictProductionMode)
+	sessionsSvc.SetDurationPolicy(server.SessionDurationPolicy{MaxDuration: sessionMaxDuration, CoolOff: sessionCoolOff})
+	if sessionMaxDuration > 0 {
+		registerScheduledJob(scheduler, jobSchedules, "session_max_duration", sessionMaxDurationCheckInterval, sessionsSvc.MaxDurationJob(500))
+	}
+	sessionsSvc.Overlay = uiOverlaySvc
+	uiOverlaySvc.Sessions = sessionsSvc
+	sessionsSvc.SetRealityCheckInterval(sessionRealityCheckInterval)
+	if sessionRealityCheckInterval > 0 {
+		registerScheduledJob(scheduler, jobSchedules, "session_reality_check", sessionRealityCheckSweepInterval, sessionsSvc.RealityCheckJob(500))
+	}
 	rgsv1.RegisterSessionsServiceServer(grpcServer, sessionsSvc)
+	wageringSvc.Sessions = sessionsSvc
+	wageringSvc.Ledger = ledgerSvc
+	wageringSvc.Registry = registrySvc
+	wageringSvc.SetLedgerIntegration(wagerLedgerIntegration)
+	registerScheduledJob(scheduler, jobSchedules, "wager_settlement_monitor", wagerSettlementCheckInterval, wageringSvc.SettlementMonitorJob())
+	playerSvc := server.NewPlayerSelfService(clk, db)
+	playerSvc.Ledger = ledgerSvc
+	playerSvc.Wagering = wageringSvc
+	playerSvc.Sessions = sessionsSvc
+	playerSvc.SetRateLimit(playerRateLimitMaxRequests, playerRateLimitWindow)
+	rgsv1.RegisterPlayerSelfServiceServer(grpcServer, playerSvc)
+	selfExclusionSvc := server.NewSelfExclusionService(clk, db)
+	identitySvc.SetSelfExclusionChecker(selfExclusionSvc)
+	ledgerSvc.SetSelfExclusionChecker(selfExclusionSvc)
+	wageringSvc.SelfExclusion = selfExclusionSvc
+	rgsv1.RegisterSelfExclusionServiceServer(grpcServer, selfExclusionSvc)
+	playerLimitsSvc := server.NewPlayerLimitsService(clk, db)
+	playerLimitsSvc.SetCoolingOff(playerLimitCoolingOff)
+	ledgerSvc.SetPlayerLimitSource(playerLimitsSvc)
+	wageringSvc.PlayerLimits = playerLimitsSvc
+	rgsv1.RegisterPlayerLimitsServiceServer(grpcServer, playerLimitsSvc)
+	if db != nil {
+		roleSvc.SetDB(db)
+	}
+	rgsv1.RegisterRoleServiceServer(grpcServer, roleSvc)
 
 	grpcListener, err := net.Listen("tcp", grpcAddr)
 	if err != nil {
@@ -237,7 +832,10 @@ func main() {
 	h := server.SystemHandler{}
 	h.Register(mux)
 	mux.Handle("/metrics", promhttp.Handler())
-	gwMux := runtime.NewServeMux()
+	gwMux := runtime.NewServeMux(
+		runtime.WithMiddlewares(server.IPAllowlistGatewayMiddleware(ipAllowlists), roleSvc.GatewayMiddleware(), stepUp.GatewayMiddleware(), rpcBudgets.GatewayMiddleware()),
+		runtime.WithForwardResponseOption(server.ImpersonationForwardResponseOption),
+	)
 	if err := rgsv1.RegisterSystemServiceHandlerServer(ctx, gwMux, systemSvc); err != nil {
 		log.Fatalf("register gateway handlers: %v", err)
 	}
@@ -271,8 +869,19 @@ func main() {
 	if err := rgsv1.RegisterSessionsServiceHandlerServer(ctx, gwMux, sessionsSvc); err != nil {
 		log.Fatalf("register sessions gateway handlers: %v", err)
 	}
-	remoteAccessAuditStore := audit.NewInMemoryStore()
-	guard, err := server.NewRemoteAccessGuard(clk, remoteAccessAuditStore, trustedCIDRs)
+	if err := rgsv1.RegisterPlayerSelfServiceHandlerServer(ctx, gwMux, playerSvc); err != nil {
+		log.Fatalf("register player gateway handlers: %v", err)
+	}
+	if err := rgsv1.RegisterSelfExclusionServiceHandlerServer(ctx, gwMux, selfExclusionSvc); err != nil {
+		log.Fatalf("register self-exclusion gateway handlers: %v", err)
+	}
+	if err := rgsv1.RegisterPlayerLimitsServiceHandlerServer(ctx, gwMux, playerLimitsSvc); err != nil {
+		log.Fatalf("register player limits gateway handlers: %v", err)
+	}
+	if err := rgsv1.RegisterRoleServiceHandlerServer(ctx, gwMux, roleSvc); err != nil {
+		log.Fatalf("register rbac gateway handlers: %v", err)
+	}
+	guard, err := server.NewRemoteAccessGuard(clk, server.NewAuditStore(db), trustedCIDRs)
 	if err != nil {
 		log.Fatalf("configure remote access guard: %v", err)
 	}
@@ -284,6 +893,7 @@ func main() {
 	guard.SetInMemoryActivityLogCap(remoteAccessActivityLogCap)
 	guard.SetDecisionObserver(metrics.ObserveRemoteAccessDecision)
 	guard.SetLogStateObserver(metrics.ObserveRemoteAccessLogState)
+	guard.SetAuthFailureSampling(authFailureAuditMaxPerWindow, authFailureAuditWindow)
 	auditSvc := server.NewAuditService(
 		clk,
 		guard,
@@ -296,23 +906,190 @@ func main() {
 		promotionsSvc.AuditStore,
 		uiOverlaySvc.AuditStore,
 		sessionsSvc.AuditStore,
-		remoteAccessAuditStore,
+		playerSvc.AuditStore,
+		selfExclusionSvc.AuditStore,
+		playerLimitsSvc.AuditStore,
+		roleSvc.AuditStore,
+		incidentBoard.AuditStore,
+		smokeChecker.AuditStore,
+		outboxDispatcher.AuditStore,
+		guard.AuditStore,
 	)
 	if db != nil {
 		auditSvc.SetDB(db)
 	}
+	auditExportSinks := make([]server.AuditExportSink, 0)
+	for _, dir := range strings.Split(auditExportSinkDirs, ",") {
+		if dir = strings.TrimSpace(dir); dir != "" {
+			auditExportSinks = append(auditExportSinks, server.DirectoryAuditExportSink{Dir: dir})
+		}
+	}
+	if auditExportS3Bucket != "" {
+		if auditExportS3Region == "" {
+			log.Fatalf("RGS_AUDIT_EXPORT_S3_REGION is required when RGS_AUDIT_EXPORT_S3_BUCKET is set")
+		}
+		auditExportSinks = append(auditExportSinks, server.S3AuditExportSink{
+			Endpoint:        auditExportS3Endpoint,
+			Region:          auditExportS3Region,
+			Bucket:          auditExportS3Bucket,
+			Prefix:          auditExportS3Prefix,
+			AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+			SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+			RetainFor:       time.Duration(auditExportS3RetainDays) * 24 * time.Hour,
+		})
+	}
+	if len(auditExportSinks) > 0 {
+		auditExportKey, err := evidence.ResolveEd25519PrivateKey(auditExportSignerKID)
+		if err != nil {
+			log.Fatalf("resolve audit export signing key: %v", err)
+		}
+		auditSvc.SetExportConfig(server.AuditExportConfig{
+			SignerKID:  auditExportSignerKID,
+			SigningKey: auditExportKey,
+			Sinks:      auditExportSinks,
+		})
+		registerScheduledJob(scheduler, jobSchedules, "audit_partition_export", auditExportCheckInterval, auditSvc.ExportJob())
+	}
+	if auditReportSignerKID != "" {
+		auditReportKey, err := evidence.ResolveEd25519PrivateKey(auditReportSignerKID)
+		if err != nil {
+			log.Fatalf("resolve audit report signing key: %v", err)
+		}
+		auditSvc.SetReportSigner(auditReportSignerKID, auditReportKey)
+	}
+	var auditSyslog *server.SyslogAuditForwarder
+	if auditSyslogAddr != "" {
+		facility, err := server.ParseSyslogFacility(auditSyslogFacility)
+		if err != nil {
+			log.Fatalf("invalid RGS_AUDIT_SYSLOG_FACILITY: %v", err)
+		}
+		severities, err := server.ParseSyslogSeverityMap(auditSyslogSeverities)
+		if err != nil {
+			log.Fatalf("invalid RGS_AUDIT_SYSLOG_SEVERITIES: %v", err)
+		}
+		auditSyslog, err = server.NewSyslogAuditForwarder(server.SyslogAuditForwarderConfig{
+			Network:    auditSyslogNetwork,
+			Addr:       auditSyslogAddr,
+			Format:     auditSyslogFormat,
+			Facility:   facility,
+			Severities: severities,
+			Logf:       log.Printf,
+		})
+		if err != nil {
+			log.Fatalf("configure audit syslog forwarder: %v", err)
+		}
+		auditSvc.SetForwarder(auditSyslog)
+	}
+	if auditAnchorTSAURL != "" {
+		auditSvc.SetTimestamper(server.RFC3161Timestamper{URL: auditAnchorTSAURL})
+		registerScheduledJob(scheduler, jobSchedules, "audit_chain_anchor", auditAnchorCheckInterval, auditSvc.AnchorJob())
+	}
+	registerScheduledJob(scheduler, jobSchedules, "audit_partition_seal", auditSealCheckInterval, auditSvc.SealJob())
+	if db != nil && auditChainVerifyDays > 0 {
+		var notifier server.AuditCorruptionNotifier
+		if auditChainAlertWebhookURL != "" {
+			notifier = server.WebhookAuditCorruptionNotifier{URL: auditChainAlertWebhookURL}
+		}
+		auditSvc.SetChainMonitor(auditChainVerifyDays, metrics.ObserveAuditChainVerification, notifier)
+		registerScheduledJob(scheduler, jobSchedules, "audit_chain_verify", auditChainVerifyInterval, auditSvc.ChainVerifyJob())
+	}
+	if auditRetentionDays > 0 {
+		if db == nil {
+			log.Fatalf("RGS_AUDIT_RETENTION_DAYS requires RGS_DATABASE_URL")
+		}
+		if len(auditExportSinks) == 0 {
+			log.Fatalf("RGS_AUDIT_RETENTION_DAYS requires an audit export sink to archive to")
+		}
+		auditSvc.SetRetentionDays(auditRetentionDays)
+		registerScheduledJob(scheduler, jobSchedules, "audit_retention", auditRetentionCheckInterval, auditSvc.RetentionJob())
+	}
+	reportingSvc.Audit = auditSvc
+	smokeChecker.Ledger = ledgerSvc
+	smokeChecker.Audit = auditSvc
+	smokeChecker.Reporting = reportingSvc
+	registerScheduledJob(scheduler, jobSchedules, "reporting_daily_pack", dailyPackCheckInterval, reportingSvc.DailyPackJob())
+	registerScheduledJob(scheduler, jobSchedules, "config_scheduled_apply", configScheduledApplyInterval, configSvc.ScheduledApplyJob(100))
+	if sasGatewayAddr != "" {
+		sasMachines, err := server.ParseSASMachines(sasMachinesSpec)
+		if err != nil {
+			log.Fatalf("parse RGS_SAS_MACHINES: %v", err)
+		}
+		if len(sasMachines) == 0 {
+			log.Fatalf("RGS_SAS_GATEWAY_ADDR requires RGS_SAS_MACHINES")
+		}
+		if !money.ValidCurrency(sasMeterCurrency) || sasDenominationMinor <= 0 {
+			log.Fatalf("RGS_SAS_METER_CURRENCY must be a currency code and RGS_SAS_DENOMINATION_MINOR positive")
+		}
+		sasBridge := server.NewSASBridge(eventsSvc, sasGatewayAddr, sasMachines, sasMeterCurrency, int64(sasDenominationMinor))
+		registerScheduledJob(scheduler, jobSchedules, "sas_meter_poll", sasPollInterval, sasBridge.PollJob())
+	}
+	if len(reportDeliveryTargets) > 0 {
+		registerScheduledJob(scheduler, jobSchedules, "report_delivery", reportDeliveryInterval, reportingSvc.DeliveryJob(50))
+	}
+	if reportRetentionDays > 0 {
+		reportingSvc.SetReportRetention(time.Duration(reportRetentionDays) * 24 * time.Hour)
+		registerScheduledJob(scheduler, jobSchedules, "report_retention_purge", reportRetentionCheckInterval, reportingSvc.ReportRetentionJob(reportRetentionBatch, metrics.ObserveReportRetentionPurge))
+	}
+	scheduler.Start(ctx, schedulerPollInterval)
 	rgsv1.RegisterAuditServiceServer(grpcServer, auditSvc)
 	if err := rgsv1.RegisterAuditServiceHandlerServer(ctx, gwMux, auditSvc); err != nil {
 		log.Fatalf("register audit gateway handlers: %v", err)
 	}
-	authenticatedGateway := platformauth.HTTPJWTMiddlewareWithSkips(jwtVerifier, gwMux, []string{
+	authenticatedGateway := platformauth.HTTPAuthMiddleware(jwtVerifier, certActors, gwMux, []string{
 		"/v1/system/status",
+		"/v1/system/status-page",
 		"/v1/identity/login",
 		"/v1/identity/refresh",
-	})
-	mux.Handle("/", guard.Wrap(server.HTTPMetricsMiddleware(metrics, authenticatedGateway)))
+		"/v1/identity/credentials:change",
+		"/v1/identity/webauthn/login:begin",
+		"/v1/identity/webauthn/login:finish",
+	}, guard.RecordAuthFailure)
+	mux.Handle("/", guard.Wrap(server.HTTPMetricsMiddleware(metrics, server.HTTPLoadSheddingMiddleware(loadShedder, authenticatedGateway))))
+	mux.Handle("/.well-known/jwks.json", guard.Wrap(jwtSigner.JWKSHandler()))
+	if scimBearerToken != "" {
+		if db == nil {
+			log.Fatalf("RGS_SCIM_BEARER_TOKEN requires RGS_DATABASE_URL")
+		}
+		mux.Handle("/scim/v2/", guard.Wrap(identitySvc.SCIMHandler(scimBearerToken, roleSvc)))
+	}
+	if g2sEnabled {
+		if !money.ValidCurrency(g2sMeterCurrency) {
+			log.Fatalf("invalid RGS_G2S_METER_CURRENCY %q", g2sMeterCurrency)
+		}
+		mux.Handle(server.G2SMessagesPath, guard.Wrap(server.HTTPMetricsMiddleware(metrics, server.HTTPLoadSheddingMiddleware(loadShedder, platformauth.HTTPAuthMiddleware(jwtVerifier, certActors, eventsSvc.G2SHandler(g2sMeterCurrency), nil, guard.RecordAuthFailure)))))
+	}
+	mux.Handle(server.SignificantEventsNDJSONPath, guard.Wrap(server.HTTPMetricsMiddleware(metrics, server.HTTPLoadSheddingMiddleware(loadShedder, platformauth.HTTPAuthMiddleware(jwtVerifier, certActors, eventsSvc.SignificantEventsNDJSONHandler(), nil, guard.RecordAuthFailure)))))
+	mux.Handle(server.SignificantEventsExportPath, guard.Wrap(server.HTTPMetricsMiddleware(metrics, server.HTTPLoadSheddingMiddleware(loadShedder, platformauth.HTTPAuthMiddleware(jwtVerifier, certActors, eventsSvc.ExportSignificantEventsHandler(), nil, guard.RecordAuthFailure)))))
+	mux.Handle("/v1/system/monitoring/", guard.Wrap(platformauth.HTTPJWTMiddlewareWithFailureObserver(jwtVerifier, metrics.MonitoringHandler(), nil, guard.RecordAuthFailure)))
 	httpServer := &http.Server{Addr: httpAddr, Handler: mux, TLSConfig: tlsCfg}
 
+	// The player listener serves only the /v1/me, self-exclusion and player
+	// limit surfaces so player apps can be pointed at it without reaching
+	// operator endpoints.
+	var playerHTTPServer *http.Server
+	if playerHTTPAddr != "" {
+		playerGwMux := runtime.NewServeMux(
+			runtime.WithMiddlewares(roleSvc.GatewayMiddleware(), rpcBudgets.GatewayMiddleware()),
+			runtime.WithForwardResponseOption(server.ImpersonationForwardResponseOption),
+		)
+		if err := rgsv1.RegisterPlayerSelfServiceHandlerServer(ctx, playerGwMux, playerSvc); err != nil {
+			log.Fatalf("register player listener handlers: %v", err)
+		}
+		if err := rgsv1.RegisterSelfExclusionServiceHandlerServer(ctx, playerGwMux, selfExclusionSvc); err != nil {
+			log.Fatalf("register player listener handlers: %v", err)
+		}
+		if err := rgsv1.RegisterPlayerLimitsServiceHandlerServer(ctx, playerGwMux, playerLimitsSvc); err != nil {
+			log.Fatalf("register player listener handlers: %v", err)
+		}
+		playerGateway := platformauth.HTTPJWTMiddlewareWithFailureObserver(jwtVerifier, playerGwMux, nil, guard.RecordAuthFailure)
+		playerHTTPServer = &http.Server{
+			Addr:      playerHTTPAddr,
+			Handler:   server.HTTPMetricsMiddleware(metrics, server.HTTPLoadSheddingMiddleware(loadShedder, playerGateway)),
+			TLSConfig: tlsCfg,
+		}
+	}
+
 	go func() {
 		log.Printf("grpc listening on %s", grpcAddr)
 		if err := grpcServer.Serve(grpcListener); err != nil {
@@ -333,6 +1110,21 @@ func main() {
 		}
 	}()
 
+	if playerHTTPServer != nil {
+		go func() {
+			log.Printf("player http listening on %s", playerHTTPAddr)
+			var err error
+			if tlsCfg != nil {
+				err = playerHTTPServer.ListenAndServeTLS("", "")
+			} else {
+				err = playerHTTPServer.ListenAndServe()
+			}
+			if err != nil && err != http.ErrServerClosed {
+				log.Printf("player http server stopped: %v", err)
+			}
+		}()
+	}
+
 	<-ctx.Done()
 	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
@@ -340,6 +1132,15 @@ func main() {
 	if err := httpServer.Shutdown(shutdownCtx); err != nil {
 		log.Printf("http shutdown: %v", err)
 	}
+	if playerHTTPServer != nil {
+		if err := playerHTTPServer.Shutdown(shutdownCtx); err != nil {
+			log.Printf("player http shutdown: %v", err)
+		}
+	}
+	reportingSvc.WaitReportWorkers()
+	if auditSyslog != nil {
+		auditSyslog.Close()
+	}
 }
 
 func envOr(key, def string) string {
@@ -428,6 +1229,36 @@ func loadJWTKeyset(ctx context.Context, jwtSigningSecret string, jwtKeysetSpec s
 	return keyset, keysetFingerprint(keyset), nil
 }
 
+func jwtKeysetSource(jwtKeysetFile string, jwtKeysetCommand string) string {
+	switch {
+	case strings.TrimSpace(jwtKeysetFile) != "":
+		return "file"
+	case strings.TrimSpace(jwtKeysetCommand) != "":
+		return "command"
+	default:
+		return "env"
+	}
+}
+
+func keyRotationRecord(keyset platformauth.HMACKeyset, fingerprint, source, trigger, instanceID string) *rgsv1.KeyRotation {
+	kids := make([]string, 0, len(keyset.Keys)+len(keyset.PrivateKeys))
+	for kid := range keyset.Keys {
+		kids = append(kids, kid)
+	}
+	for kid := range keyset.PrivateKeys {
+		kids = append(kids, kid)
+	}
+	sort.Strings(kids)
+	return &rgsv1.KeyRotation{
+		Fingerprint: fingerprint,
+		ActiveKid:   keyset.ActiveKID,
+		KeyIds:      kids,
+		Source:      source,
+		Trigger:     trigger,
+		InstanceId:  instanceID,
+	}
+}
+
 func parseKeyValueSecrets(spec string) map[string][]byte {
 	out := make(map[string][]byte)
 	parts := strings.Split(spec, ",")
@@ -450,6 +1281,247 @@ func parseKeyValueSecrets(spec string) map[string][]byte {
 	return out
 }
 
+func defaultSchedulerInstanceID() string {
+	host, err := os.Hostname()
+	if err != nil || host == "" {
+		host = "rgsd"
+	}
+	return host + "-" + strconv.Itoa(os.Getpid())
+}
+
+// parseJobSchedules reads "job=schedule" entries separated by ";" since cron
+// expressions may contain commas.
+func parseJobSchedules(spec string) (map[string]string, error) {
+	out := make(map[string]string)
+	for _, part := range strings.Split(spec, ";") {
+		entry := strings.TrimSpace(part)
+		if entry == "" {
+			continue
+		}
+		name, schedule, ok := strings.Cut(entry, "=")
+		name = strings.TrimSpace(name)
+		schedule = strings.TrimSpace(schedule)
+		if !ok || name == "" || schedule == "" {
+			return nil, fmt.Errorf("invalid entry %q", entry)
+		}
+		out[name] = schedule
+	}
+	return out, nil
+}
+
+// parseDailyPackRecipients reads "dir=keyfile,keyfile" entries separated by
+// ";", naming the OpenPGP public keys each sink directory encrypts to.
+func parseDailyPackRecipients(spec string) (map[string][]string, error) {
+	out := make(map[string][]string)
+	for _, part := range strings.Split(spec, ";") {
+		entry := strings.TrimSpace(part)
+		if entry == "" {
+			continue
+		}
+		dir, files, ok := strings.Cut(entry, "=")
+		dir = strings.TrimSpace(dir)
+		if !ok || dir == "" {
+			return nil, fmt.Errorf("invalid entry %q", entry)
+		}
+		for _, f := range strings.Split(files, ",") {
+			if f = strings.TrimSpace(f); f != "" {
+				out[dir] = append(out[dir], f)
+			}
+		}
+		if len(out[dir]) == 0 {
+			return nil, fmt.Errorf("no key files for %s", dir)
+		}
+	}
+	return out, nil
+}
+
+// parseLatencyBudgets reads "method=duration" entries separated by ";".
+// Methods may be given with or without the leading "/".
+func parseLatencyBudgets(spec string) (map[string]time.Duration, error) {
+	out := make(map[string]time.Duration)
+	for _, part := range strings.Split(spec, ";") {
+		entry := strings.TrimSpace(part)
+		if entry == "" {
+			continue
+		}
+		method, raw, ok := strings.Cut(entry, "=")
+		method = strings.TrimSpace(method)
+		if !ok || method == "" {
+			return nil, fmt.Errorf("invalid entry %q", entry)
+		}
+		budget, err := time.ParseDuration(strings.TrimSpace(raw))
+		if err != nil {
+			return nil, fmt.Errorf("invalid budget for %s: %w", method, err)
+		}
+		out["/"+strings.TrimPrefix(method, "/")] = budget
+	}
+	return out, nil
+}
+
+// applyDatabaseStatementTimeout sets statement_timeout on the database
+// connection string when timeout is positive, and rejects a statement
+// timeout, set here or already in the connection string, that is longer
+// than the shortest RPC latency budget.
+func applyDatabaseStatementTimeout(databaseURL string, timeout time.Duration, budgets *server.LatencyBudgets) (string, error) {
+	if strings.TrimSpace(databaseURL) == "" {
+		return databaseURL, nil
+	}
+	name := "RGS_DATABASE_STATEMENT_TIMEOUT"
+	if timeout <= 0 {
+		cfg, err := pgx.ParseConfig(databaseURL)
+		if err != nil {
+			return "", fmt.Errorf("parse RGS_DATABASE_URL: %w", err)
+		}
+		raw, ok := cfg.RuntimeParams["statement_timeout"]
+		if !ok {
+			return databaseURL, nil
+		}
+		if timeout, err = parsePostgresDuration(raw); err != nil {
+			return "", fmt.Errorf("invalid statement_timeout in RGS_DATABASE_URL: %w", err)
+		}
+		return databaseURL, budgets.ValidateDatabaseTimeout("RGS_DATABASE_URL statement_timeout", timeout)
+	}
+	if err := budgets.ValidateDatabaseTimeout(name, timeout); err != nil {
+		return "", err
+	}
+	ms := strconv.FormatInt(timeout.Milliseconds(), 10)
+	if strings.HasPrefix(databaseURL, "postgres://") || strings.HasPrefix(databaseURL, "postgresql://") {
+		u, err := url.Parse(databaseURL)
+		if err != nil {
+			return "", fmt.Errorf("parse RGS_DATABASE_URL: %w", err)
+		}
+		q := u.Query()
+		q.Set("statement_timeout", ms)
+		u.RawQuery = q.Encode()
+		return u.String(), nil
+	}
+	return databaseURL + " statement_timeout=" + ms, nil
+}
+
+// parsePostgresDuration reads a PostgreSQL time setting: a bare number of
+// milliseconds or a number with a ms, s, min, h, or d unit.
+func parsePostgresDuration(raw string) (time.Duration, error) {
+	v := strings.TrimSpace(raw)
+	if ms, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return time.Duration(ms) * time.Millisecond, nil
+	}
+	for _, unit := range []struct {
+		suffix string
+		scale  time.Duration
+	}{{"ms", time.Millisecond}, {"min", time.Minute}, {"s", time.Second}, {"h", time.Hour}, {"d", 24 * time.Hour}} {
+		if n, ok := strings.CutSuffix(v, unit.suffix); ok {
+			parsed, err := strconv.ParseInt(strings.TrimSpace(n), 10, 64)
+			if err != nil {
+				return 0, fmt.Errorf("invalid duration %q", raw)
+			}
+			return time.Duration(parsed) * unit.scale, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid duration %q", raw)
+}
+
+// parseOIDCRoleMap reads "group=role" entries separated by ";". Groups may be
+// directory DNs containing "=" and ",", so the role is taken after the last
+// "=".
+func parseOIDCRoleMap(spec string) (map[string]string, error) {
+	out := make(map[string]string)
+	for _, part := range strings.Split(spec, ";") {
+		entry := strings.TrimSpace(part)
+		if entry == "" {
+			continue
+		}
+		i := strings.LastIndex(entry, "=")
+		if i < 0 {
+			return nil, fmt.Errorf("invalid entry %q", entry)
+		}
+		group := strings.TrimSpace(entry[:i])
+		role := strings.TrimSpace(entry[i+1:])
+		if group == "" || role == "" {
+			return nil, fmt.Errorf("invalid entry %q", entry)
+		}
+		out[group] = role
+	}
+	return out, nil
+}
+
+// registerScheduledJob runs a job every interval, or on the schedule given
+// for it in RGS_SCHEDULER_JOB_SCHEDULES. A non-positive interval without an
+// override disables the job.
+func registerScheduledJob(scheduler *server.JobScheduler, overrides map[string]string, name string, interval time.Duration, run server.JobFunc) {
+	schedule, ok := overrides[name]
+	if !ok {
+		if interval <= 0 {
+			return
+		}
+		schedule = server.Every(interval)
+	}
+	if err := scheduler.Register(server.JobSpec{Name: name, Schedule: schedule, Run: run}); err != nil {
+		log.Fatalf("register scheduled job: %v", err)
+	}
+}
+
+func parseDailyPackReportTypes(spec string) ([]rgsv1.ReportType, error) {
+	out := make([]rgsv1.ReportType, 0)
+	for _, part := range strings.Split(spec, ",") {
+		switch strings.ToLower(strings.TrimSpace(part)) {
+		case "":
+			continue
+		case "significant_events":
+			out = append(out, rgsv1.ReportType_REPORT_TYPE_SIGNIFICANT_EVENTS_ALTERATIONS)
+		case "cashless_liability":
+			out = append(out, rgsv1.ReportType_REPORT_TYPE_CASHLESS_LIABILITY_SUMMARY)
+		case "account_statement":
+			out = append(out, rgsv1.ReportType_REPORT_TYPE_ACCOUNT_TRANSACTION_STATEMENT)
+		case "rtp_summary":
+			out = append(out, rgsv1.ReportType_REPORT_TYPE_RTP_SUMMARY)
+		case "promo_liability":
+			out = append(out, rgsv1.ReportType_REPORT_TYPE_PROMO_LIABILITY)
+		case "eft_activity":
+			out = append(out, rgsv1.ReportType_REPORT_TYPE_EFT_ACTIVITY)
+		default:
+			return nil, fmt.Errorf("unknown report %q", strings.TrimSpace(part))
+		}
+	}
+	return out, nil
+}
+
+// parseWagerTimeoutAction reports whether wagers past the settlement timeout
+// are escalated rather than voided.
+func parseWagerTimeoutAction(spec string) (bool, error) {
+	switch strings.ToLower(strings.TrimSpace(spec)) {
+	case "", "void":
+		return false, nil
+	case "escalate":
+		return true, nil
+	default:
+		return false, fmt.Errorf("unknown action %q", spec)
+	}
+}
+
+func parseSessionLimitPolicy(spec string) (server.SessionLimitPolicy, error) {
+	switch strings.ToLower(strings.TrimSpace(spec)) {
+	case "", "deny":
+		return server.SessionLimitDeny, nil
+	case "revoke_oldest":
+		return server.SessionLimitRevokeOldest, nil
+	default:
+		return server.SessionLimitDeny, fmt.Errorf("unknown policy %q", spec)
+	}
+}
+
+func parseDailyPackFormat(spec string) (rgsv1.ReportFormat, error) {
+	switch strings.ToLower(strings.TrimSpace(spec)) {
+	case "", "json":
+		return rgsv1.ReportFormat_REPORT_FORMAT_JSON, nil
+	case "csv":
+		return rgsv1.ReportFormat_REPORT_FORMAT_CSV, nil
+	case "pdf":
+		return rgsv1.ReportFormat_REPORT_FORMAT_PDF, nil
+	default:
+		return rgsv1.ReportFormat_REPORT_FORMAT_UNSPECIFIED, fmt.Errorf("unknown format %q", spec)
+	}
+}
+
 func keysetFingerprint(keyset platformauth.HMACKeyset) string {
 	keys := make([]string, 0, len(keyset.Keys))
 	for kid := range keyset.Keys {
@@ -460,6 +1532,17 @@ func keysetFingerprint(keyset platformauth.HMACKeyset) string {
 	for _, kid := range keys {
 		joined += "|" + kid + ":" + string(keyset.Keys[kid])
 	}
+	// Asymmetric keys contribute their public half, which identifies them
+	// as well as the private key does.
+	privateKIDs := make([]string, 0, len(keyset.PrivateKeys))
+	for kid := range keyset.PrivateKeys {
+		privateKIDs = append(privateKIDs, kid)
+	}
+	sort.Strings(privateKIDs)
+	for _, kid := range privateKIDs {
+		der, _ := x509.MarshalPKIXPublicKey(keyset.PrivateKeys[kid].Public())
+		joined += "|" + kid + ":" + hex.EncodeToString(der)
+	}
 	sum := sha256.Sum256([]byte(joined))
 	return hex.EncodeToString(sum[:])
 }
@@ -2,9 +2,18 @@ package main
 
 import (
 	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	platformauth "github.com/wizardbeardstudio/open-rgs-go/internal/platform/auth"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/server"
 )
 
 func TestValidateProductionRuntimeStrictRequirements(t *testing.T) {
@@ -144,6 +153,34 @@ func TestLoadJWTKeysetFromFile(t *testing.T) {
 	}
 }
 
+func TestKeyRotationRecord(t *testing.T) {
+	keyset := platformauth.HMACKeyset{ActiveKID: "k2", Keys: map[string][]byte{"k2": []byte("secret2"), "k1": []byte("secret1")}}
+	rec := keyRotationRecord(keyset, keysetFingerprint(keyset), jwtKeysetSource("/etc/rgs/jwt.json", ""), "refresh", "rgsd-1")
+	if rec.ActiveKid != "k2" || len(rec.KeyIds) != 2 || rec.KeyIds[0] != "k1" || rec.KeyIds[1] != "k2" {
+		t.Fatalf("unexpected key ids in rotation record: %+v", rec)
+	}
+	if rec.Source != "file" || rec.Trigger != "refresh" || rec.InstanceId != "rgsd-1" || len(rec.Fingerprint) != 64 {
+		t.Fatalf("unexpected rotation record: %+v", rec)
+	}
+	if jwtKeysetSource("", "vault read") != "command" || jwtKeysetSource("", "") != "env" {
+		t.Fatalf("unexpected keyset source classification")
+	}
+}
+
+func TestKeyRotationRecordIncludesAsymmetricKeys(t *testing.T) {
+	_, edA, _ := ed25519.GenerateKey(rand.Reader)
+	_, edB, _ := ed25519.GenerateKey(rand.Reader)
+	keyset := platformauth.HMACKeyset{ActiveKID: "ed1", Keys: map[string][]byte{"hs1": []byte("secret1")}, PrivateKeys: map[string]crypto.Signer{"ed1": edA}}
+	rec := keyRotationRecord(keyset, keysetFingerprint(keyset), "file", "startup", "rgsd-1")
+	if rec.ActiveKid != "ed1" || len(rec.KeyIds) != 2 || rec.KeyIds[0] != "ed1" || rec.KeyIds[1] != "hs1" {
+		t.Fatalf("unexpected key ids in rotation record: %+v", rec)
+	}
+	rotated := platformauth.HMACKeyset{ActiveKID: "ed1", Keys: keyset.Keys, PrivateKeys: map[string]crypto.Signer{"ed1": edB}}
+	if keysetFingerprint(rotated) == rec.Fingerprint {
+		t.Fatalf("expected replacing a private key to change the keyset fingerprint")
+	}
+}
+
 func TestParseKeyValueSecrets(t *testing.T) {
 	keys := parseKeyValueSecrets("k1:secret1, k2:secret2, invalid, :missing")
 	if len(keys) != 2 {
@@ -166,3 +203,122 @@ func TestLoadJWTKeysetFromCommand(t *testing.T) {
 		t.Fatalf("expected non-empty fingerprint")
 	}
 }
+
+func TestParseDailyPackReportTypes(t *testing.T) {
+	types, err := parseDailyPackReportTypes("significant_events, account_statement")
+	if err != nil {
+		t.Fatalf("parse report types: %v", err)
+	}
+	if len(types) != 2 || types[1] != rgsv1.ReportType_REPORT_TYPE_ACCOUNT_TRANSACTION_STATEMENT {
+		t.Fatalf("unexpected report types: %v", types)
+	}
+	if _, err := parseDailyPackReportTypes("bogus"); err == nil {
+		t.Fatalf("expected unknown report type error")
+	}
+}
+
+func TestParseJobSchedules(t *testing.T) {
+	schedules, err := parseJobSchedules("reporting_daily_pack=0 7 * * 1,3,5; outbox_dispatch=@every 10s;")
+	if err != nil {
+		t.Fatalf("parse job schedules: %v", err)
+	}
+	if len(schedules) != 2 || schedules["reporting_daily_pack"] != "0 7 * * 1,3,5" || schedules["outbox_dispatch"] != "@every 10s" {
+		t.Fatalf("unexpected job schedules: %v", schedules)
+	}
+	if _, err := parseJobSchedules("missing-schedule"); err == nil {
+		t.Fatalf("expected invalid entry error")
+	}
+}
+
+func TestParseLatencyBudgets(t *testing.T) {
+	budgets, err := parseLatencyBudgets("rgs.v1.LedgerService/Deposit=750ms; /rgs.v1.ReportingService/GenerateReport=45s;")
+	if err != nil {
+		t.Fatalf("parse latency budgets: %v", err)
+	}
+	if len(budgets) != 2 || budgets["/rgs.v1.LedgerService/Deposit"] != 750*time.Millisecond || budgets["/rgs.v1.ReportingService/GenerateReport"] != 45*time.Second {
+		t.Fatalf("unexpected latency budgets: %v", budgets)
+	}
+	if _, err := parseLatencyBudgets("/rgs.v1.LedgerService/Deposit=soon"); err == nil {
+		t.Fatalf("expected invalid duration error")
+	}
+}
+
+func TestParseDailyPackRecipients(t *testing.T) {
+	recipients, err := parseDailyPackRecipients("/outbound/regulator=/keys/regulator.asc, /keys/auditor.asc; /outbound/lab=/keys/lab.asc;")
+	if err != nil {
+		t.Fatalf("parse daily pack recipients: %v", err)
+	}
+	if got := recipients["/outbound/regulator"]; len(got) != 2 || got[1] != "/keys/auditor.asc" {
+		t.Fatalf("unexpected regulator recipients: %v", got)
+	}
+	if got := recipients["/outbound/lab"]; len(got) != 1 || got[0] != "/keys/lab.asc" {
+		t.Fatalf("unexpected lab recipients: %v", got)
+	}
+	if _, err := parseDailyPackRecipients("/outbound/regulator="); err == nil {
+		t.Fatalf("expected entry without key files to be rejected")
+	}
+}
+
+func TestApplyDatabaseStatementTimeout(t *testing.T) {
+	budgets, err := server.NewLatencyBudgets(nil)
+	if err != nil {
+		t.Fatalf("new budgets: %v", err)
+	}
+	dsn, err := applyDatabaseStatementTimeout("postgres://rgs@db:5432/rgs?sslmode=disable", 400*time.Millisecond, budgets)
+	if err != nil || !strings.Contains(dsn, "statement_timeout=400") || !strings.Contains(dsn, "sslmode=disable") {
+		t.Fatalf("expected statement_timeout on url dsn, got %q err=%v", dsn, err)
+	}
+	dsn, err = applyDatabaseStatementTimeout("host=db user=rgs", 250*time.Millisecond, budgets)
+	if err != nil || dsn != "host=db user=rgs statement_timeout=250" {
+		t.Fatalf("expected statement_timeout on keyword dsn, got %q err=%v", dsn, err)
+	}
+	if _, err := applyDatabaseStatementTimeout("postgres://rgs@db/rgs", 2*time.Second, budgets); err == nil {
+		t.Fatalf("expected timeout past the shortest budget to be rejected")
+	}
+	if _, err := applyDatabaseStatementTimeout("postgres://rgs@db/rgs?statement_timeout=5s", 0, budgets); err == nil {
+		t.Fatalf("expected statement_timeout in the dsn to be checked")
+	}
+	if _, err := applyDatabaseStatementTimeout("postgres://rgs@db/rgs?statement_timeout=300", 0, budgets); err != nil {
+		t.Fatalf("expected dsn timeout within budget to pass: %v", err)
+	}
+	if dsn, err := applyDatabaseStatementTimeout("", 2*time.Second, budgets); err != nil || dsn != "" {
+		t.Fatalf("expected no database to skip the check, got %q err=%v", dsn, err)
+	}
+}
+
+func TestParseOIDCRoleMap(t *testing.T) {
+	roles, err := parseOIDCRoleMap("rgs-admins=admin; cn=floor,ou=groups,dc=example=floor_manager;")
+	if err != nil {
+		t.Fatalf("parse oidc role map: %v", err)
+	}
+	if len(roles) != 2 || roles["rgs-admins"] != "admin" || roles["cn=floor,ou=groups,dc=example"] != "floor_manager" {
+		t.Fatalf("unexpected oidc role map: %v", roles)
+	}
+	if _, err := parseOIDCRoleMap("missing-role"); err == nil {
+		t.Fatalf("expected invalid entry error")
+	}
+}
+
+func TestParseWagerTimeoutAction(t *testing.T) {
+	for spec, want := range map[string]bool{"": false, "void": false, " Escalate ": true} {
+		got, err := parseWagerTimeoutAction(spec)
+		if err != nil || got != want {
+			t.Fatalf("%q: got=%v err=%v", spec, got, err)
+		}
+	}
+	if _, err := parseWagerTimeoutAction("refund"); err == nil {
+		t.Fatalf("expected unknown action to be rejected")
+	}
+}
+
+func TestParseSessionLimitPolicy(t *testing.T) {
+	for spec, want := range map[string]server.SessionLimitPolicy{"": server.SessionLimitDeny, "deny": server.SessionLimitDeny, " Revoke_Oldest ": server.SessionLimitRevokeOldest} {
+		got, err := parseSessionLimitPolicy(spec)
+		if err != nil || got != want {
+			t.Fatalf("%q: got=%v err=%v", spec, got, err)
+		}
+	}
+	if _, err := parseSessionLimitPolicy("kick"); err == nil {
+		t.Fatalf("expected unknown policy to be rejected")
+	}
+}
@@ -214,6 +214,9 @@ namespace WizardBeardStudio.Rgs.Services
                 "SIGNIFICANT_EVENTS_ALTERATIONS" => 1,
                 "CASHLESS_LIABILITY_SUMMARY" => 2,
                 "ACCOUNT_TRANSACTION_STATEMENT" => 3,
+                "RTP_SUMMARY" => 4,
+                "PROMO_LIABILITY" => 5,
+                "EFT_ACTIVITY" => 6,
                 _ => 1,
             };
         }
@@ -226,6 +229,9 @@ namespace WizardBeardStudio.Rgs.Services
                 "SIGNIFICANT_EVENTS_ALTERATIONS" => "REPORT_TYPE_SIGNIFICANT_EVENTS_ALTERATIONS",
                 "CASHLESS_LIABILITY_SUMMARY" => "REPORT_TYPE_CASHLESS_LIABILITY_SUMMARY",
                 "ACCOUNT_TRANSACTION_STATEMENT" => "REPORT_TYPE_ACCOUNT_TRANSACTION_STATEMENT",
+                "RTP_SUMMARY" => "REPORT_TYPE_RTP_SUMMARY",
+                "PROMO_LIABILITY" => "REPORT_TYPE_PROMO_LIABILITY",
+                "EFT_ACTIVITY" => "REPORT_TYPE_EFT_ACTIVITY",
                 _ => "REPORT_TYPE_SIGNIFICANT_EVENTS_ALTERATIONS",
             };
         }
@@ -23,17 +23,21 @@ const (
 )
 
 type AuditEventRecord struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AuditId       string                 `protobuf:"bytes,1,opt,name=audit_id,json=auditId,proto3" json:"audit_id,omitempty"`
-	OccurredAt    string                 `protobuf:"bytes,2,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
-	RecordedAt    string                 `protobuf:"bytes,3,opt,name=recorded_at,json=recordedAt,proto3" json:"recorded_at,omitempty"`
-	ActorId       string                 `protobuf:"bytes,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
-	ActorType     string                 `protobuf:"bytes,5,opt,name=actor_type,json=actorType,proto3" json:"actor_type,omitempty"`
-	ObjectType    string                 `protobuf:"bytes,6,opt,name=object_type,json=objectType,proto3" json:"object_type,omitempty"`
-	ObjectId      string                 `protobuf:"bytes,7,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
-	Action        string                 `protobuf:"bytes,8,opt,name=action,proto3" json:"action,omitempty"`
-	Result        string                 `protobuf:"bytes,9,opt,name=result,proto3" json:"result,omitempty"`
-	Reason        string                 `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	AuditId    string                 `protobuf:"bytes,1,opt,name=audit_id,json=auditId,proto3" json:"audit_id,omitempty"`
+	OccurredAt string                 `protobuf:"bytes,2,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	RecordedAt string                 `protobuf:"bytes,3,opt,name=recorded_at,json=recordedAt,proto3" json:"recorded_at,omitempty"`
+	ActorId    string                 `protobuf:"bytes,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ActorType  string                 `protobuf:"bytes,5,opt,name=actor_type,json=actorType,proto3" json:"actor_type,omitempty"`
+	ObjectType string                 `protobuf:"bytes,6,opt,name=object_type,json=objectType,proto3" json:"object_type,omitempty"`
+	ObjectId   string                 `protobuf:"bytes,7,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	Action     string                 `protobuf:"bytes,8,opt,name=action,proto3" json:"action,omitempty"`
+	Result     string                 `protobuf:"bytes,9,opt,name=result,proto3" json:"result,omitempty"`
+	Reason     string                 `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Set when audit events are signed: hex ed25519 signature over the
+	// event's canonical form, and the key that made it.
+	SignerKid     string `protobuf:"bytes,11,opt,name=signer_kid,json=signerKid,proto3" json:"signer_kid,omitempty"`
+	Signature     string `protobuf:"bytes,12,opt,name=signature,proto3" json:"signature,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -138,35 +142,52 @@ func (x *AuditEventRecord) GetReason() string {
 	return ""
 }
 
-type RemoteAccessActivityRecord struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Timestamp       string                 `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	SourceIp        string                 `protobuf:"bytes,2,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty"`
-	SourcePort      string                 `protobuf:"bytes,3,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty"`
-	Destination     string                 `protobuf:"bytes,4,opt,name=destination,proto3" json:"destination,omitempty"`
-	DestinationPort string                 `protobuf:"bytes,5,opt,name=destination_port,json=destinationPort,proto3" json:"destination_port,omitempty"`
-	Path            string                 `protobuf:"bytes,6,opt,name=path,proto3" json:"path,omitempty"`
-	Method          string                 `protobuf:"bytes,7,opt,name=method,proto3" json:"method,omitempty"`
-	Allowed         bool                   `protobuf:"varint,8,opt,name=allowed,proto3" json:"allowed,omitempty"`
-	Reason          string                 `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *AuditEventRecord) GetSignerKid() string {
+	if x != nil {
+		return x.SignerKid
+	}
+	return ""
 }
 
-func (x *RemoteAccessActivityRecord) Reset() {
-	*x = RemoteAccessActivityRecord{}
+func (x *AuditEventRecord) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+// AuditPartitionExport records a partition day written to write-once
+// storage as NDJSON plus a signed manifest.
+type AuditPartitionExport struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PartitionDay   string                 `protobuf:"bytes,1,opt,name=partition_day,json=partitionDay,proto3" json:"partition_day,omitempty"`
+	EventCount     int64                  `protobuf:"varint,2,opt,name=event_count,json=eventCount,proto3" json:"event_count,omitempty"`
+	ChainVerified  bool                   `protobuf:"varint,3,opt,name=chain_verified,json=chainVerified,proto3" json:"chain_verified,omitempty"`
+	EventsSha256   string                 `protobuf:"bytes,4,opt,name=events_sha256,json=eventsSha256,proto3" json:"events_sha256,omitempty"`
+	ManifestSha256 string                 `protobuf:"bytes,5,opt,name=manifest_sha256,json=manifestSha256,proto3" json:"manifest_sha256,omitempty"`
+	SignerKid      string                 `protobuf:"bytes,6,opt,name=signer_kid,json=signerKid,proto3" json:"signer_kid,omitempty"`
+	SignatureAlg   string                 `protobuf:"bytes,7,opt,name=signature_alg,json=signatureAlg,proto3" json:"signature_alg,omitempty"`
+	Signature      string                 `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`
+	Sinks          []string               `protobuf:"bytes,9,rep,name=sinks,proto3" json:"sinks,omitempty"`
+	ExportedAt     string                 `protobuf:"bytes,10,opt,name=exported_at,json=exportedAt,proto3" json:"exported_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AuditPartitionExport) Reset() {
+	*x = AuditPartitionExport{}
 	mi := &file_rgs_v1_audit_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoteAccessActivityRecord) String() string {
+func (x *AuditPartitionExport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoteAccessActivityRecord) ProtoMessage() {}
+func (*AuditPartitionExport) ProtoMessage() {}
 
-func (x *RemoteAccessActivityRecord) ProtoReflect() protoreflect.Message {
+func (x *AuditPartitionExport) ProtoReflect() protoreflect.Message {
 	mi := &file_rgs_v1_audit_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -178,98 +199,114 @@ func (x *RemoteAccessActivityRecord) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoteAccessActivityRecord.ProtoReflect.Descriptor instead.
-func (*RemoteAccessActivityRecord) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuditPartitionExport.ProtoReflect.Descriptor instead.
+func (*AuditPartitionExport) Descriptor() ([]byte, []int) {
 	return file_rgs_v1_audit_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *RemoteAccessActivityRecord) GetTimestamp() string {
+func (x *AuditPartitionExport) GetPartitionDay() string {
 	if x != nil {
-		return x.Timestamp
+		return x.PartitionDay
 	}
 	return ""
 }
 
-func (x *RemoteAccessActivityRecord) GetSourceIp() string {
+func (x *AuditPartitionExport) GetEventCount() int64 {
 	if x != nil {
-		return x.SourceIp
+		return x.EventCount
 	}
-	return ""
+	return 0
 }
 
-func (x *RemoteAccessActivityRecord) GetSourcePort() string {
+func (x *AuditPartitionExport) GetChainVerified() bool {
 	if x != nil {
-		return x.SourcePort
+		return x.ChainVerified
+	}
+	return false
+}
+
+func (x *AuditPartitionExport) GetEventsSha256() string {
+	if x != nil {
+		return x.EventsSha256
 	}
 	return ""
 }
 
-func (x *RemoteAccessActivityRecord) GetDestination() string {
+func (x *AuditPartitionExport) GetManifestSha256() string {
 	if x != nil {
-		return x.Destination
+		return x.ManifestSha256
 	}
 	return ""
 }
 
-func (x *RemoteAccessActivityRecord) GetDestinationPort() string {
+func (x *AuditPartitionExport) GetSignerKid() string {
 	if x != nil {
-		return x.DestinationPort
+		return x.SignerKid
 	}
 	return ""
 }
 
-func (x *RemoteAccessActivityRecord) GetPath() string {
+func (x *AuditPartitionExport) GetSignatureAlg() string {
 	if x != nil {
-		return x.Path
+		return x.SignatureAlg
 	}
 	return ""
 }
 
-func (x *RemoteAccessActivityRecord) GetMethod() string {
+func (x *AuditPartitionExport) GetSignature() string {
 	if x != nil {
-		return x.Method
+		return x.Signature
 	}
 	return ""
 }
 
-func (x *RemoteAccessActivityRecord) GetAllowed() bool {
+func (x *AuditPartitionExport) GetSinks() []string {
 	if x != nil {
-		return x.Allowed
+		return x.Sinks
 	}
-	return false
+	return nil
 }
 
-func (x *RemoteAccessActivityRecord) GetReason() string {
+func (x *AuditPartitionExport) GetExportedAt() string {
 	if x != nil {
-		return x.Reason
+		return x.ExportedAt
 	}
 	return ""
 }
 
-type ListAuditEventsRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Meta             *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	PageSize         int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken        string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	ObjectTypeFilter string                 `protobuf:"bytes,4,opt,name=object_type_filter,json=objectTypeFilter,proto3" json:"object_type_filter,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// AuditChainAnchor is an RFC 3161 timestamp token over the final audit
+// chain hash of a closed partition day.
+type AuditChainAnchor struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	PartitionDay string                 `protobuf:"bytes,1,opt,name=partition_day,json=partitionDay,proto3" json:"partition_day,omitempty"`
+	EventCount   int64                  `protobuf:"varint,2,opt,name=event_count,json=eventCount,proto3" json:"event_count,omitempty"`
+	// chain_head is the hex SHA-256 submitted as the token's message imprint.
+	ChainHead string `protobuf:"bytes,3,opt,name=chain_head,json=chainHead,proto3" json:"chain_head,omitempty"`
+	HashAlg   string `protobuf:"bytes,4,opt,name=hash_alg,json=hashAlg,proto3" json:"hash_alg,omitempty"`
+	TsaUrl    string `protobuf:"bytes,5,opt,name=tsa_url,json=tsaUrl,proto3" json:"tsa_url,omitempty"`
+	// timestamp_token is the DER CMS SignedData returned by the TSA.
+	TimestampToken  []byte `protobuf:"bytes,6,opt,name=timestamp_token,json=timestampToken,proto3" json:"timestamp_token,omitempty"`
+	TsaGenTime      string `protobuf:"bytes,7,opt,name=tsa_gen_time,json=tsaGenTime,proto3" json:"tsa_gen_time,omitempty"`
+	TsaSerialNumber string `protobuf:"bytes,8,opt,name=tsa_serial_number,json=tsaSerialNumber,proto3" json:"tsa_serial_number,omitempty"`
+	AnchoredAt      string `protobuf:"bytes,9,opt,name=anchored_at,json=anchoredAt,proto3" json:"anchored_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ListAuditEventsRequest) Reset() {
-	*x = ListAuditEventsRequest{}
+func (x *AuditChainAnchor) Reset() {
+	*x = AuditChainAnchor{}
 	mi := &file_rgs_v1_audit_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAuditEventsRequest) String() string {
+func (x *AuditChainAnchor) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAuditEventsRequest) ProtoMessage() {}
+func (*AuditChainAnchor) ProtoMessage() {}
 
-func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+func (x *AuditChainAnchor) ProtoReflect() protoreflect.Message {
 	mi := &file_rgs_v1_audit_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -281,62 +318,102 @@ func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
-func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuditChainAnchor.ProtoReflect.Descriptor instead.
+func (*AuditChainAnchor) Descriptor() ([]byte, []int) {
 	return file_rgs_v1_audit_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *ListAuditEventsRequest) GetMeta() *RequestMeta {
+func (x *AuditChainAnchor) GetPartitionDay() string {
 	if x != nil {
-		return x.Meta
+		return x.PartitionDay
 	}
-	return nil
+	return ""
 }
 
-func (x *ListAuditEventsRequest) GetPageSize() int32 {
+func (x *AuditChainAnchor) GetEventCount() int64 {
 	if x != nil {
-		return x.PageSize
+		return x.EventCount
 	}
 	return 0
 }
 
-func (x *ListAuditEventsRequest) GetPageToken() string {
+func (x *AuditChainAnchor) GetChainHead() string {
 	if x != nil {
-		return x.PageToken
+		return x.ChainHead
 	}
 	return ""
 }
 
-func (x *ListAuditEventsRequest) GetObjectTypeFilter() string {
+func (x *AuditChainAnchor) GetHashAlg() string {
 	if x != nil {
-		return x.ObjectTypeFilter
+		return x.HashAlg
 	}
 	return ""
 }
 
-type ListAuditEventsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Events        []*AuditEventRecord    `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+func (x *AuditChainAnchor) GetTsaUrl() string {
+	if x != nil {
+		return x.TsaUrl
+	}
+	return ""
+}
+
+func (x *AuditChainAnchor) GetTimestampToken() []byte {
+	if x != nil {
+		return x.TimestampToken
+	}
+	return nil
+}
+
+func (x *AuditChainAnchor) GetTsaGenTime() string {
+	if x != nil {
+		return x.TsaGenTime
+	}
+	return ""
+}
+
+func (x *AuditChainAnchor) GetTsaSerialNumber() string {
+	if x != nil {
+		return x.TsaSerialNumber
+	}
+	return ""
+}
+
+func (x *AuditChainAnchor) GetAnchoredAt() string {
+	if x != nil {
+		return x.AnchoredAt
+	}
+	return ""
+}
+
+// AuditPartitionSeal records the Merkle root over a closed partition day's
+// event hashes, in chain order.
+type AuditPartitionSeal struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	PartitionDay string                 `protobuf:"bytes,1,opt,name=partition_day,json=partitionDay,proto3" json:"partition_day,omitempty"`
+	EventCount   int64                  `protobuf:"varint,2,opt,name=event_count,json=eventCount,proto3" json:"event_count,omitempty"`
+	// merkle_root is the hex RFC 6962 tree head over the events' hash_curr.
+	MerkleRoot    string `protobuf:"bytes,3,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	HashAlg       string `protobuf:"bytes,4,opt,name=hash_alg,json=hashAlg,proto3" json:"hash_alg,omitempty"`
+	SealedAt      string `protobuf:"bytes,5,opt,name=sealed_at,json=sealedAt,proto3" json:"sealed_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAuditEventsResponse) Reset() {
-	*x = ListAuditEventsResponse{}
+func (x *AuditPartitionSeal) Reset() {
+	*x = AuditPartitionSeal{}
 	mi := &file_rgs_v1_audit_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAuditEventsResponse) String() string {
+func (x *AuditPartitionSeal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAuditEventsResponse) ProtoMessage() {}
+func (*AuditPartitionSeal) ProtoMessage() {}
 
-func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+func (x *AuditPartitionSeal) ProtoReflect() protoreflect.Message {
 	mi := &file_rgs_v1_audit_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -348,55 +425,76 @@ func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
-func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuditPartitionSeal.ProtoReflect.Descriptor instead.
+func (*AuditPartitionSeal) Descriptor() ([]byte, []int) {
 	return file_rgs_v1_audit_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ListAuditEventsResponse) GetMeta() *ResponseMeta {
+func (x *AuditPartitionSeal) GetPartitionDay() string {
 	if x != nil {
-		return x.Meta
+		return x.PartitionDay
 	}
-	return nil
+	return ""
 }
 
-func (x *ListAuditEventsResponse) GetEvents() []*AuditEventRecord {
+func (x *AuditPartitionSeal) GetEventCount() int64 {
 	if x != nil {
-		return x.Events
+		return x.EventCount
 	}
-	return nil
+	return 0
 }
 
-func (x *ListAuditEventsResponse) GetNextPageToken() string {
+func (x *AuditPartitionSeal) GetMerkleRoot() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.MerkleRoot
 	}
 	return ""
 }
 
-type ListRemoteAccessActivitiesRequest struct {
+func (x *AuditPartitionSeal) GetHashAlg() string {
+	if x != nil {
+		return x.HashAlg
+	}
+	return ""
+}
+
+func (x *AuditPartitionSeal) GetSealedAt() string {
+	if x != nil {
+		return x.SealedAt
+	}
+	return ""
+}
+
+// AuditInclusionProof shows that one event is part of a sealed partition
+// day. The leaf is the event's hash_curr; folding in audit_path, leaf
+// first, as RFC 6962 describes must reach the seal's merkle_root.
+type AuditInclusionProof struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Event         *AuditEventRecord      `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	HashPrev      string                 `protobuf:"bytes,2,opt,name=hash_prev,json=hashPrev,proto3" json:"hash_prev,omitempty"`
+	LeafHash      string                 `protobuf:"bytes,3,opt,name=leaf_hash,json=leafHash,proto3" json:"leaf_hash,omitempty"`
+	LeafIndex     int64                  `protobuf:"varint,4,opt,name=leaf_index,json=leafIndex,proto3" json:"leaf_index,omitempty"`
+	TreeSize      int64                  `protobuf:"varint,5,opt,name=tree_size,json=treeSize,proto3" json:"tree_size,omitempty"`
+	AuditPath     []string               `protobuf:"bytes,6,rep,name=audit_path,json=auditPath,proto3" json:"audit_path,omitempty"`
+	Seal          *AuditPartitionSeal    `protobuf:"bytes,7,opt,name=seal,proto3" json:"seal,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRemoteAccessActivitiesRequest) Reset() {
-	*x = ListRemoteAccessActivitiesRequest{}
+func (x *AuditInclusionProof) Reset() {
+	*x = AuditInclusionProof{}
 	mi := &file_rgs_v1_audit_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRemoteAccessActivitiesRequest) String() string {
+func (x *AuditInclusionProof) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRemoteAccessActivitiesRequest) ProtoMessage() {}
+func (*AuditInclusionProof) ProtoMessage() {}
 
-func (x *ListRemoteAccessActivitiesRequest) ProtoReflect() protoreflect.Message {
+func (x *AuditInclusionProof) ProtoReflect() protoreflect.Message {
 	mi := &file_rgs_v1_audit_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -408,55 +506,91 @@ func (x *ListRemoteAccessActivitiesRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRemoteAccessActivitiesRequest.ProtoReflect.Descriptor instead.
-func (*ListRemoteAccessActivitiesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuditInclusionProof.ProtoReflect.Descriptor instead.
+func (*AuditInclusionProof) Descriptor() ([]byte, []int) {
 	return file_rgs_v1_audit_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ListRemoteAccessActivitiesRequest) GetMeta() *RequestMeta {
+func (x *AuditInclusionProof) GetEvent() *AuditEventRecord {
 	if x != nil {
-		return x.Meta
+		return x.Event
 	}
 	return nil
 }
 
-func (x *ListRemoteAccessActivitiesRequest) GetPageSize() int32 {
+func (x *AuditInclusionProof) GetHashPrev() string {
 	if x != nil {
-		return x.PageSize
+		return x.HashPrev
 	}
-	return 0
+	return ""
 }
 
-func (x *ListRemoteAccessActivitiesRequest) GetPageToken() string {
+func (x *AuditInclusionProof) GetLeafHash() string {
 	if x != nil {
-		return x.PageToken
+		return x.LeafHash
 	}
 	return ""
 }
 
-type ListRemoteAccessActivitiesResponse struct {
-	state         protoimpl.MessageState        `protogen:"open.v1"`
-	Meta          *ResponseMeta                 `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Activities    []*RemoteAccessActivityRecord `protobuf:"bytes,2,rep,name=activities,proto3" json:"activities,omitempty"`
-	NextPageToken string                        `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+func (x *AuditInclusionProof) GetLeafIndex() int64 {
+	if x != nil {
+		return x.LeafIndex
+	}
+	return 0
+}
+
+func (x *AuditInclusionProof) GetTreeSize() int64 {
+	if x != nil {
+		return x.TreeSize
+	}
+	return 0
+}
+
+func (x *AuditInclusionProof) GetAuditPath() []string {
+	if x != nil {
+		return x.AuditPath
+	}
+	return nil
+}
+
+func (x *AuditInclusionProof) GetSeal() *AuditPartitionSeal {
+	if x != nil {
+		return x.Seal
+	}
+	return nil
+}
+
+// TamperEvidencePartition is the verification result for one partition day
+// of a tamper-evidence report.
+type TamperEvidencePartition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PartitionDay  string                 `protobuf:"bytes,1,opt,name=partition_day,json=partitionDay,proto3" json:"partition_day,omitempty"`
+	EventCount    int64                  `protobuf:"varint,2,opt,name=event_count,json=eventCount,proto3" json:"event_count,omitempty"`
+	FirstHashPrev string                 `protobuf:"bytes,3,opt,name=first_hash_prev,json=firstHashPrev,proto3" json:"first_hash_prev,omitempty"`
+	LastHashCurr  string                 `protobuf:"bytes,4,opt,name=last_hash_curr,json=lastHashCurr,proto3" json:"last_hash_curr,omitempty"`
+	ChainVerified bool                   `protobuf:"varint,5,opt,name=chain_verified,json=chainVerified,proto3" json:"chain_verified,omitempty"`
+	Anchored      bool                   `protobuf:"varint,6,opt,name=anchored,proto3" json:"anchored,omitempty"`
+	// Archived days are checked against their archive record.
+	Archived      bool   `protobuf:"varint,7,opt,name=archived,proto3" json:"archived,omitempty"`
+	FailureReason string `protobuf:"bytes,8,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRemoteAccessActivitiesResponse) Reset() {
-	*x = ListRemoteAccessActivitiesResponse{}
+func (x *TamperEvidencePartition) Reset() {
+	*x = TamperEvidencePartition{}
 	mi := &file_rgs_v1_audit_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRemoteAccessActivitiesResponse) String() string {
+func (x *TamperEvidencePartition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRemoteAccessActivitiesResponse) ProtoMessage() {}
+func (*TamperEvidencePartition) ProtoMessage() {}
 
-func (x *ListRemoteAccessActivitiesResponse) ProtoReflect() protoreflect.Message {
+func (x *TamperEvidencePartition) ProtoReflect() protoreflect.Message {
 	mi := &file_rgs_v1_audit_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -468,54 +602,99 @@ func (x *ListRemoteAccessActivitiesResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRemoteAccessActivitiesResponse.ProtoReflect.Descriptor instead.
-func (*ListRemoteAccessActivitiesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TamperEvidencePartition.ProtoReflect.Descriptor instead.
+func (*TamperEvidencePartition) Descriptor() ([]byte, []int) {
 	return file_rgs_v1_audit_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ListRemoteAccessActivitiesResponse) GetMeta() *ResponseMeta {
+func (x *TamperEvidencePartition) GetPartitionDay() string {
 	if x != nil {
-		return x.Meta
+		return x.PartitionDay
 	}
-	return nil
+	return ""
 }
 
-func (x *ListRemoteAccessActivitiesResponse) GetActivities() []*RemoteAccessActivityRecord {
+func (x *TamperEvidencePartition) GetEventCount() int64 {
 	if x != nil {
-		return x.Activities
+		return x.EventCount
 	}
-	return nil
+	return 0
 }
 
-func (x *ListRemoteAccessActivitiesResponse) GetNextPageToken() string {
+func (x *TamperEvidencePartition) GetFirstHashPrev() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.FirstHashPrev
 	}
 	return ""
 }
 
-type VerifyAuditChainRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	PartitionDay  string                 `protobuf:"bytes,2,opt,name=partition_day,json=partitionDay,proto3" json:"partition_day,omitempty"`
+func (x *TamperEvidencePartition) GetLastHashCurr() string {
+	if x != nil {
+		return x.LastHashCurr
+	}
+	return ""
+}
+
+func (x *TamperEvidencePartition) GetChainVerified() bool {
+	if x != nil {
+		return x.ChainVerified
+	}
+	return false
+}
+
+func (x *TamperEvidencePartition) GetAnchored() bool {
+	if x != nil {
+		return x.Anchored
+	}
+	return false
+}
+
+func (x *TamperEvidencePartition) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *TamperEvidencePartition) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+// TamperEvidenceReport summarizes audit chain verification over a range of
+// partition days. report_json in the response is the signed form.
+type TamperEvidenceReport struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	FromDay       string                     `protobuf:"bytes,1,opt,name=from_day,json=fromDay,proto3" json:"from_day,omitempty"`
+	ToDay         string                     `protobuf:"bytes,2,opt,name=to_day,json=toDay,proto3" json:"to_day,omitempty"`
+	EventsChecked int64                      `protobuf:"varint,3,opt,name=events_checked,json=eventsChecked,proto3" json:"events_checked,omitempty"`
+	FirstHashPrev string                     `protobuf:"bytes,4,opt,name=first_hash_prev,json=firstHashPrev,proto3" json:"first_hash_prev,omitempty"`
+	LastHashCurr  string                     `protobuf:"bytes,5,opt,name=last_hash_curr,json=lastHashCurr,proto3" json:"last_hash_curr,omitempty"`
+	Verified      bool                       `protobuf:"varint,6,opt,name=verified,proto3" json:"verified,omitempty"`
+	Partitions    []*TamperEvidencePartition `protobuf:"bytes,7,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	GeneratedAt   string                     `protobuf:"bytes,8,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	SignerKid     string                     `protobuf:"bytes,9,opt,name=signer_kid,json=signerKid,proto3" json:"signer_kid,omitempty"`
+	SignatureAlg  string                     `protobuf:"bytes,10,opt,name=signature_alg,json=signatureAlg,proto3" json:"signature_alg,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VerifyAuditChainRequest) Reset() {
-	*x = VerifyAuditChainRequest{}
+func (x *TamperEvidenceReport) Reset() {
+	*x = TamperEvidenceReport{}
 	mi := &file_rgs_v1_audit_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VerifyAuditChainRequest) String() string {
+func (x *TamperEvidenceReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyAuditChainRequest) ProtoMessage() {}
+func (*TamperEvidenceReport) ProtoMessage() {}
 
-func (x *VerifyAuditChainRequest) ProtoReflect() protoreflect.Message {
+func (x *TamperEvidenceReport) ProtoReflect() protoreflect.Message {
 	mi := &file_rgs_v1_audit_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -527,48 +706,668 @@ func (x *VerifyAuditChainRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyAuditChainRequest.ProtoReflect.Descriptor instead.
-func (*VerifyAuditChainRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TamperEvidenceReport.ProtoReflect.Descriptor instead.
+func (*TamperEvidenceReport) Descriptor() ([]byte, []int) {
 	return file_rgs_v1_audit_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *VerifyAuditChainRequest) GetMeta() *RequestMeta {
+func (x *TamperEvidenceReport) GetFromDay() string {
 	if x != nil {
-		return x.Meta
+		return x.FromDay
 	}
-	return nil
+	return ""
 }
 
-func (x *VerifyAuditChainRequest) GetPartitionDay() string {
+func (x *TamperEvidenceReport) GetToDay() string {
 	if x != nil {
-		return x.PartitionDay
+		return x.ToDay
 	}
 	return ""
 }
 
-type VerifyAuditChainResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Valid         bool                   `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *TamperEvidenceReport) GetEventsChecked() int64 {
+	if x != nil {
+		return x.EventsChecked
+	}
+	return 0
 }
 
-func (x *VerifyAuditChainResponse) Reset() {
+func (x *TamperEvidenceReport) GetFirstHashPrev() string {
+	if x != nil {
+		return x.FirstHashPrev
+	}
+	return ""
+}
+
+func (x *TamperEvidenceReport) GetLastHashCurr() string {
+	if x != nil {
+		return x.LastHashCurr
+	}
+	return ""
+}
+
+func (x *TamperEvidenceReport) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *TamperEvidenceReport) GetPartitions() []*TamperEvidencePartition {
+	if x != nil {
+		return x.Partitions
+	}
+	return nil
+}
+
+func (x *TamperEvidenceReport) GetGeneratedAt() string {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return ""
+}
+
+func (x *TamperEvidenceReport) GetSignerKid() string {
+	if x != nil {
+		return x.SignerKid
+	}
+	return ""
+}
+
+func (x *TamperEvidenceReport) GetSignatureAlg() string {
+	if x != nil {
+		return x.SignatureAlg
+	}
+	return ""
+}
+
+type RemoteAccessActivityRecord struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp       string                 `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	SourceIp        string                 `protobuf:"bytes,2,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty"`
+	SourcePort      string                 `protobuf:"bytes,3,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty"`
+	Destination     string                 `protobuf:"bytes,4,opt,name=destination,proto3" json:"destination,omitempty"`
+	DestinationPort string                 `protobuf:"bytes,5,opt,name=destination_port,json=destinationPort,proto3" json:"destination_port,omitempty"`
+	Path            string                 `protobuf:"bytes,6,opt,name=path,proto3" json:"path,omitempty"`
+	Method          string                 `protobuf:"bytes,7,opt,name=method,proto3" json:"method,omitempty"`
+	Allowed         bool                   `protobuf:"varint,8,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Reason          string                 `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RemoteAccessActivityRecord) Reset() {
+	*x = RemoteAccessActivityRecord{}
+	mi := &file_rgs_v1_audit_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoteAccessActivityRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoteAccessActivityRecord) ProtoMessage() {}
+
+func (x *RemoteAccessActivityRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_audit_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoteAccessActivityRecord.ProtoReflect.Descriptor instead.
+func (*RemoteAccessActivityRecord) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_audit_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RemoteAccessActivityRecord) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *RemoteAccessActivityRecord) GetSourceIp() string {
+	if x != nil {
+		return x.SourceIp
+	}
+	return ""
+}
+
+func (x *RemoteAccessActivityRecord) GetSourcePort() string {
+	if x != nil {
+		return x.SourcePort
+	}
+	return ""
+}
+
+func (x *RemoteAccessActivityRecord) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *RemoteAccessActivityRecord) GetDestinationPort() string {
+	if x != nil {
+		return x.DestinationPort
+	}
+	return ""
+}
+
+func (x *RemoteAccessActivityRecord) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *RemoteAccessActivityRecord) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *RemoteAccessActivityRecord) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *RemoteAccessActivityRecord) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ListAuditEventsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Meta             *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	PageSize         int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken        string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	ObjectTypeFilter string                 `protobuf:"bytes,4,opt,name=object_type_filter,json=objectTypeFilter,proto3" json:"object_type_filter,omitempty"`
+	ActorIdFilter    string                 `protobuf:"bytes,5,opt,name=actor_id_filter,json=actorIdFilter,proto3" json:"actor_id_filter,omitempty"`
+	ActionFilter     string                 `protobuf:"bytes,6,opt,name=action_filter,json=actionFilter,proto3" json:"action_filter,omitempty"`
+	// One of success, denied, or error.
+	ResultFilter string `protobuf:"bytes,7,opt,name=result_filter,json=resultFilter,proto3" json:"result_filter,omitempty"`
+	// Case-insensitive substring of the reason.
+	ReasonContains string `protobuf:"bytes,8,opt,name=reason_contains,json=reasonContains,proto3" json:"reason_contains,omitempty"`
+	// Optional RFC 3339 bounds on recorded_at, both inclusive.
+	FromTime      string `protobuf:"bytes,9,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`
+	ToTime        string `protobuf:"bytes,10,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	mi := &file_rgs_v1_audit_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_audit_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_audit_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListAuditEventsRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetObjectTypeFilter() string {
+	if x != nil {
+		return x.ObjectTypeFilter
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetActorIdFilter() string {
+	if x != nil {
+		return x.ActorIdFilter
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetActionFilter() string {
+	if x != nil {
+		return x.ActionFilter
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetResultFilter() string {
+	if x != nil {
+		return x.ResultFilter
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetReasonContains() string {
+	if x != nil {
+		return x.ReasonContains
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetFromTime() string {
+	if x != nil {
+		return x.FromTime
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetToTime() string {
+	if x != nil {
+		return x.ToTime
+	}
+	return ""
+}
+
+type ListAuditEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Events        []*AuditEventRecord    `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	mi := &file_rgs_v1_audit_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsResponse) ProtoMessage() {}
+
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_audit_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_audit_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListAuditEventsResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListAuditEventsResponse) GetEvents() []*AuditEventRecord {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListAuditEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListRemoteAccessActivitiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRemoteAccessActivitiesRequest) Reset() {
+	*x = ListRemoteAccessActivitiesRequest{}
+	mi := &file_rgs_v1_audit_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRemoteAccessActivitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRemoteAccessActivitiesRequest) ProtoMessage() {}
+
+func (x *ListRemoteAccessActivitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_audit_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRemoteAccessActivitiesRequest.ProtoReflect.Descriptor instead.
+func (*ListRemoteAccessActivitiesRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_audit_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListRemoteAccessActivitiesRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListRemoteAccessActivitiesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRemoteAccessActivitiesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListRemoteAccessActivitiesResponse struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Meta          *ResponseMeta                 `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Activities    []*RemoteAccessActivityRecord `protobuf:"bytes,2,rep,name=activities,proto3" json:"activities,omitempty"`
+	NextPageToken string                        `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRemoteAccessActivitiesResponse) Reset() {
+	*x = ListRemoteAccessActivitiesResponse{}
+	mi := &file_rgs_v1_audit_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRemoteAccessActivitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRemoteAccessActivitiesResponse) ProtoMessage() {}
+
+func (x *ListRemoteAccessActivitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_audit_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRemoteAccessActivitiesResponse.ProtoReflect.Descriptor instead.
+func (*ListRemoteAccessActivitiesResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_audit_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListRemoteAccessActivitiesResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListRemoteAccessActivitiesResponse) GetActivities() []*RemoteAccessActivityRecord {
+	if x != nil {
+		return x.Activities
+	}
+	return nil
+}
+
+func (x *ListRemoteAccessActivitiesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type VerifyAuditChainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	PartitionDay  string                 `protobuf:"bytes,2,opt,name=partition_day,json=partitionDay,proto3" json:"partition_day,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyAuditChainRequest) Reset() {
+	*x = VerifyAuditChainRequest{}
+	mi := &file_rgs_v1_audit_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyAuditChainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAuditChainRequest) ProtoMessage() {}
+
+func (x *VerifyAuditChainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_audit_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyAuditChainRequest.ProtoReflect.Descriptor instead.
+func (*VerifyAuditChainRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_audit_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *VerifyAuditChainRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *VerifyAuditChainRequest) GetPartitionDay() string {
+	if x != nil {
+		return x.PartitionDay
+	}
+	return ""
+}
+
+type VerifyAuditChainResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Valid         bool                   `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyAuditChainResponse) Reset() {
 	*x = VerifyAuditChainResponse{}
-	mi := &file_rgs_v1_audit_proto_msgTypes[7]
+	mi := &file_rgs_v1_audit_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyAuditChainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAuditChainResponse) ProtoMessage() {}
+
+func (x *VerifyAuditChainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_audit_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyAuditChainResponse.ProtoReflect.Descriptor instead.
+func (*VerifyAuditChainResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_audit_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *VerifyAuditChainResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *VerifyAuditChainResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+type ExportAuditPartitionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Meta  *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// partition_day defaults to the last closed partition day.
+	PartitionDay  string `protobuf:"bytes,2,opt,name=partition_day,json=partitionDay,proto3" json:"partition_day,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportAuditPartitionRequest) Reset() {
+	*x = ExportAuditPartitionRequest{}
+	mi := &file_rgs_v1_audit_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportAuditPartitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAuditPartitionRequest) ProtoMessage() {}
+
+func (x *ExportAuditPartitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_audit_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAuditPartitionRequest.ProtoReflect.Descriptor instead.
+func (*ExportAuditPartitionRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_audit_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ExportAuditPartitionRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ExportAuditPartitionRequest) GetPartitionDay() string {
+	if x != nil {
+		return x.PartitionDay
+	}
+	return ""
+}
+
+type ExportAuditPartitionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Export        *AuditPartitionExport  `protobuf:"bytes,2,opt,name=export,proto3" json:"export,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportAuditPartitionResponse) Reset() {
+	*x = ExportAuditPartitionResponse{}
+	mi := &file_rgs_v1_audit_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VerifyAuditChainResponse) String() string {
+func (x *ExportAuditPartitionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyAuditChainResponse) ProtoMessage() {}
+func (*ExportAuditPartitionResponse) ProtoMessage() {}
 
-func (x *VerifyAuditChainResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_audit_proto_msgTypes[7]
+func (x *ExportAuditPartitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_audit_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -579,30 +1378,370 @@ func (x *VerifyAuditChainResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyAuditChainResponse.ProtoReflect.Descriptor instead.
-func (*VerifyAuditChainResponse) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_audit_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ExportAuditPartitionResponse.ProtoReflect.Descriptor instead.
+func (*ExportAuditPartitionResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_audit_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *VerifyAuditChainResponse) GetMeta() *ResponseMeta {
+func (x *ExportAuditPartitionResponse) GetMeta() *ResponseMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *VerifyAuditChainResponse) GetValid() bool {
+func (x *ExportAuditPartitionResponse) GetExport() *AuditPartitionExport {
 	if x != nil {
-		return x.Valid
+		return x.Export
 	}
-	return false
+	return nil
+}
+
+type AnchorAuditPartitionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Meta  *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// partition_day defaults to the last closed partition day.
+	PartitionDay  string `protobuf:"bytes,2,opt,name=partition_day,json=partitionDay,proto3" json:"partition_day,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnchorAuditPartitionRequest) Reset() {
+	*x = AnchorAuditPartitionRequest{}
+	mi := &file_rgs_v1_audit_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnchorAuditPartitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnchorAuditPartitionRequest) ProtoMessage() {}
+
+func (x *AnchorAuditPartitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_audit_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnchorAuditPartitionRequest.ProtoReflect.Descriptor instead.
+func (*AnchorAuditPartitionRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_audit_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AnchorAuditPartitionRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *AnchorAuditPartitionRequest) GetPartitionDay() string {
+	if x != nil {
+		return x.PartitionDay
+	}
+	return ""
+}
+
+type AnchorAuditPartitionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Anchor        *AuditChainAnchor      `protobuf:"bytes,2,opt,name=anchor,proto3" json:"anchor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnchorAuditPartitionResponse) Reset() {
+	*x = AnchorAuditPartitionResponse{}
+	mi := &file_rgs_v1_audit_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnchorAuditPartitionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnchorAuditPartitionResponse) ProtoMessage() {}
+
+func (x *AnchorAuditPartitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_audit_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnchorAuditPartitionResponse.ProtoReflect.Descriptor instead.
+func (*AnchorAuditPartitionResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_audit_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AnchorAuditPartitionResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *AnchorAuditPartitionResponse) GetAnchor() *AuditChainAnchor {
+	if x != nil {
+		return x.Anchor
+	}
+	return nil
+}
+
+type GenerateTamperEvidenceReportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Meta  *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// to_day defaults to the last closed partition day and from_day to to_day.
+	FromDay       string `protobuf:"bytes,2,opt,name=from_day,json=fromDay,proto3" json:"from_day,omitempty"`
+	ToDay         string `protobuf:"bytes,3,opt,name=to_day,json=toDay,proto3" json:"to_day,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateTamperEvidenceReportRequest) Reset() {
+	*x = GenerateTamperEvidenceReportRequest{}
+	mi := &file_rgs_v1_audit_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateTamperEvidenceReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateTamperEvidenceReportRequest) ProtoMessage() {}
+
+func (x *GenerateTamperEvidenceReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_audit_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateTamperEvidenceReportRequest.ProtoReflect.Descriptor instead.
+func (*GenerateTamperEvidenceReportRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_audit_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GenerateTamperEvidenceReportRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GenerateTamperEvidenceReportRequest) GetFromDay() string {
+	if x != nil {
+		return x.FromDay
+	}
+	return ""
+}
+
+func (x *GenerateTamperEvidenceReportRequest) GetToDay() string {
+	if x != nil {
+		return x.ToDay
+	}
+	return ""
+}
+
+type GenerateTamperEvidenceReportResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Meta   *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Report *TamperEvidenceReport  `protobuf:"bytes,2,opt,name=report,proto3" json:"report,omitempty"`
+	// report_json is the exact document signed; signature is its hex ed25519
+	// signature.
+	ReportJson    []byte `protobuf:"bytes,3,opt,name=report_json,json=reportJson,proto3" json:"report_json,omitempty"`
+	Signature     string `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateTamperEvidenceReportResponse) Reset() {
+	*x = GenerateTamperEvidenceReportResponse{}
+	mi := &file_rgs_v1_audit_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateTamperEvidenceReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateTamperEvidenceReportResponse) ProtoMessage() {}
+
+func (x *GenerateTamperEvidenceReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_audit_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateTamperEvidenceReportResponse.ProtoReflect.Descriptor instead.
+func (*GenerateTamperEvidenceReportResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_audit_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GenerateTamperEvidenceReportResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GenerateTamperEvidenceReportResponse) GetReport() *TamperEvidenceReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+func (x *GenerateTamperEvidenceReportResponse) GetReportJson() []byte {
+	if x != nil {
+		return x.ReportJson
+	}
+	return nil
+}
+
+func (x *GenerateTamperEvidenceReportResponse) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+type GetAuditProofRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	AuditId       string                 `protobuf:"bytes,2,opt,name=audit_id,json=auditId,proto3" json:"audit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuditProofRequest) Reset() {
+	*x = GetAuditProofRequest{}
+	mi := &file_rgs_v1_audit_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuditProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuditProofRequest) ProtoMessage() {}
+
+func (x *GetAuditProofRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_audit_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuditProofRequest.ProtoReflect.Descriptor instead.
+func (*GetAuditProofRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_audit_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetAuditProofRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GetAuditProofRequest) GetAuditId() string {
+	if x != nil {
+		return x.AuditId
+	}
+	return ""
+}
+
+type GetAuditProofResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Proof         *AuditInclusionProof   `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuditProofResponse) Reset() {
+	*x = GetAuditProofResponse{}
+	mi := &file_rgs_v1_audit_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuditProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuditProofResponse) ProtoMessage() {}
+
+func (x *GetAuditProofResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_audit_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuditProofResponse.ProtoReflect.Descriptor instead.
+func (*GetAuditProofResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_audit_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetAuditProofResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GetAuditProofResponse) GetProof() *AuditInclusionProof {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
 }
 
 var File_rgs_v1_audit_proto protoreflect.FileDescriptor
 
 const file_rgs_v1_audit_proto_rawDesc = "" +
 	"\n" +
-	"\x12rgs/v1/audit.proto\x12\x06rgs.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x13rgs/v1/common.proto\"\xaf\x02\n" +
+	"\x12rgs/v1/audit.proto\x12\x06rgs.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x13rgs/v1/common.proto\"\xec\x02\n" +
 	"\x10AuditEventRecord\x12\x19\n" +
 	"\baudit_id\x18\x01 \x01(\tR\aauditId\x12\x1f\n" +
 	"\voccurred_at\x18\x02 \x01(\tR\n" +
@@ -618,7 +1757,82 @@ const file_rgs_v1_audit_proto_rawDesc = "" +
 	"\x06action\x18\b \x01(\tR\x06action\x12\x16\n" +
 	"\x06result\x18\t \x01(\tR\x06result\x12\x16\n" +
 	"\x06reason\x18\n" +
-	" \x01(\tR\x06reason\"\xa3\x02\n" +
+	" \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"signer_kid\x18\v \x01(\tR\tsignerKid\x12\x1c\n" +
+	"\tsignature\x18\f \x01(\tR\tsignature\"\xea\x02\n" +
+	"\x14AuditPartitionExport\x12#\n" +
+	"\rpartition_day\x18\x01 \x01(\tR\fpartitionDay\x12\x1f\n" +
+	"\vevent_count\x18\x02 \x01(\x03R\n" +
+	"eventCount\x12%\n" +
+	"\x0echain_verified\x18\x03 \x01(\bR\rchainVerified\x12#\n" +
+	"\revents_sha256\x18\x04 \x01(\tR\feventsSha256\x12'\n" +
+	"\x0fmanifest_sha256\x18\x05 \x01(\tR\x0emanifestSha256\x12\x1d\n" +
+	"\n" +
+	"signer_kid\x18\x06 \x01(\tR\tsignerKid\x12#\n" +
+	"\rsignature_alg\x18\a \x01(\tR\fsignatureAlg\x12\x1c\n" +
+	"\tsignature\x18\b \x01(\tR\tsignature\x12\x14\n" +
+	"\x05sinks\x18\t \x03(\tR\x05sinks\x12\x1f\n" +
+	"\vexported_at\x18\n" +
+	" \x01(\tR\n" +
+	"exportedAt\"\xc3\x02\n" +
+	"\x10AuditChainAnchor\x12#\n" +
+	"\rpartition_day\x18\x01 \x01(\tR\fpartitionDay\x12\x1f\n" +
+	"\vevent_count\x18\x02 \x01(\x03R\n" +
+	"eventCount\x12\x1d\n" +
+	"\n" +
+	"chain_head\x18\x03 \x01(\tR\tchainHead\x12\x19\n" +
+	"\bhash_alg\x18\x04 \x01(\tR\ahashAlg\x12\x17\n" +
+	"\atsa_url\x18\x05 \x01(\tR\x06tsaUrl\x12'\n" +
+	"\x0ftimestamp_token\x18\x06 \x01(\fR\x0etimestampToken\x12 \n" +
+	"\ftsa_gen_time\x18\a \x01(\tR\n" +
+	"tsaGenTime\x12*\n" +
+	"\x11tsa_serial_number\x18\b \x01(\tR\x0ftsaSerialNumber\x12\x1f\n" +
+	"\vanchored_at\x18\t \x01(\tR\n" +
+	"anchoredAt\"\xb3\x01\n" +
+	"\x12AuditPartitionSeal\x12#\n" +
+	"\rpartition_day\x18\x01 \x01(\tR\fpartitionDay\x12\x1f\n" +
+	"\vevent_count\x18\x02 \x01(\x03R\n" +
+	"eventCount\x12\x1f\n" +
+	"\vmerkle_root\x18\x03 \x01(\tR\n" +
+	"merkleRoot\x12\x19\n" +
+	"\bhash_alg\x18\x04 \x01(\tR\ahashAlg\x12\x1b\n" +
+	"\tsealed_at\x18\x05 \x01(\tR\bsealedAt\"\x8a\x02\n" +
+	"\x13AuditInclusionProof\x12.\n" +
+	"\x05event\x18\x01 \x01(\v2\x18.rgs.v1.AuditEventRecordR\x05event\x12\x1b\n" +
+	"\thash_prev\x18\x02 \x01(\tR\bhashPrev\x12\x1b\n" +
+	"\tleaf_hash\x18\x03 \x01(\tR\bleafHash\x12\x1d\n" +
+	"\n" +
+	"leaf_index\x18\x04 \x01(\x03R\tleafIndex\x12\x1b\n" +
+	"\ttree_size\x18\x05 \x01(\x03R\btreeSize\x12\x1d\n" +
+	"\n" +
+	"audit_path\x18\x06 \x03(\tR\tauditPath\x12.\n" +
+	"\x04seal\x18\a \x01(\v2\x1a.rgs.v1.AuditPartitionSealR\x04seal\"\xb3\x02\n" +
+	"\x17TamperEvidencePartition\x12#\n" +
+	"\rpartition_day\x18\x01 \x01(\tR\fpartitionDay\x12\x1f\n" +
+	"\vevent_count\x18\x02 \x01(\x03R\n" +
+	"eventCount\x12&\n" +
+	"\x0ffirst_hash_prev\x18\x03 \x01(\tR\rfirstHashPrev\x12$\n" +
+	"\x0elast_hash_curr\x18\x04 \x01(\tR\flastHashCurr\x12%\n" +
+	"\x0echain_verified\x18\x05 \x01(\bR\rchainVerified\x12\x1a\n" +
+	"\banchored\x18\x06 \x01(\bR\banchored\x12\x1a\n" +
+	"\barchived\x18\a \x01(\bR\barchived\x12%\n" +
+	"\x0efailure_reason\x18\b \x01(\tR\rfailureReason\"\x81\x03\n" +
+	"\x14TamperEvidenceReport\x12\x19\n" +
+	"\bfrom_day\x18\x01 \x01(\tR\afromDay\x12\x15\n" +
+	"\x06to_day\x18\x02 \x01(\tR\x05toDay\x12%\n" +
+	"\x0eevents_checked\x18\x03 \x01(\x03R\reventsChecked\x12&\n" +
+	"\x0ffirst_hash_prev\x18\x04 \x01(\tR\rfirstHashPrev\x12$\n" +
+	"\x0elast_hash_curr\x18\x05 \x01(\tR\flastHashCurr\x12\x1a\n" +
+	"\bverified\x18\x06 \x01(\bR\bverified\x12?\n" +
+	"\n" +
+	"partitions\x18\a \x03(\v2\x1f.rgs.v1.TamperEvidencePartitionR\n" +
+	"partitions\x12!\n" +
+	"\fgenerated_at\x18\b \x01(\tR\vgeneratedAt\x12\x1d\n" +
+	"\n" +
+	"signer_kid\x18\t \x01(\tR\tsignerKid\x12#\n" +
+	"\rsignature_alg\x18\n" +
+	" \x01(\tR\fsignatureAlg\"\xa3\x02\n" +
 	"\x1aRemoteAccessActivityRecord\x12\x1c\n" +
 	"\ttimestamp\x18\x01 \x01(\tR\ttimestamp\x12\x1b\n" +
 	"\tsource_ip\x18\x02 \x01(\tR\bsourceIp\x12\x1f\n" +
@@ -629,13 +1843,20 @@ const file_rgs_v1_audit_proto_rawDesc = "" +
 	"\x04path\x18\x06 \x01(\tR\x04path\x12\x16\n" +
 	"\x06method\x18\a \x01(\tR\x06method\x12\x18\n" +
 	"\aallowed\x18\b \x01(\bR\aallowed\x12\x16\n" +
-	"\x06reason\x18\t \x01(\tR\x06reason\"\xab\x01\n" +
+	"\x06reason\x18\t \x01(\tR\x06reason\"\xfc\x02\n" +
 	"\x16ListAuditEventsRequest\x12'\n" +
 	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
 	"page_token\x18\x03 \x01(\tR\tpageToken\x12,\n" +
-	"\x12object_type_filter\x18\x04 \x01(\tR\x10objectTypeFilter\"\x9d\x01\n" +
+	"\x12object_type_filter\x18\x04 \x01(\tR\x10objectTypeFilter\x12&\n" +
+	"\x0factor_id_filter\x18\x05 \x01(\tR\ractorIdFilter\x12#\n" +
+	"\raction_filter\x18\x06 \x01(\tR\factionFilter\x12#\n" +
+	"\rresult_filter\x18\a \x01(\tR\fresultFilter\x12'\n" +
+	"\x0freason_contains\x18\b \x01(\tR\x0ereasonContains\x12\x1b\n" +
+	"\tfrom_time\x18\t \x01(\tR\bfromTime\x12\x17\n" +
+	"\ato_time\x18\n" +
+	" \x01(\tR\x06toTime\"\x9d\x01\n" +
 	"\x17ListAuditEventsResponse\x12(\n" +
 	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x120\n" +
 	"\x06events\x18\x02 \x03(\v2\x18.rgs.v1.AuditEventRecordR\x06events\x12&\n" +
@@ -656,11 +1877,43 @@ const file_rgs_v1_audit_proto_rawDesc = "" +
 	"\rpartition_day\x18\x02 \x01(\tR\fpartitionDay\"Z\n" +
 	"\x18VerifyAuditChainResponse\x12(\n" +
 	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12\x14\n" +
-	"\x05valid\x18\x02 \x01(\bR\x05valid2\x8d\x03\n" +
+	"\x05valid\x18\x02 \x01(\bR\x05valid\"k\n" +
+	"\x1bExportAuditPartitionRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12#\n" +
+	"\rpartition_day\x18\x02 \x01(\tR\fpartitionDay\"~\n" +
+	"\x1cExportAuditPartitionResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x124\n" +
+	"\x06export\x18\x02 \x01(\v2\x1c.rgs.v1.AuditPartitionExportR\x06export\"k\n" +
+	"\x1bAnchorAuditPartitionRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12#\n" +
+	"\rpartition_day\x18\x02 \x01(\tR\fpartitionDay\"z\n" +
+	"\x1cAnchorAuditPartitionResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x120\n" +
+	"\x06anchor\x18\x02 \x01(\v2\x18.rgs.v1.AuditChainAnchorR\x06anchor\"\x80\x01\n" +
+	"#GenerateTamperEvidenceReportRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x19\n" +
+	"\bfrom_day\x18\x02 \x01(\tR\afromDay\x12\x15\n" +
+	"\x06to_day\x18\x03 \x01(\tR\x05toDay\"\xc5\x01\n" +
+	"$GenerateTamperEvidenceReportResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x124\n" +
+	"\x06report\x18\x02 \x01(\v2\x1c.rgs.v1.TamperEvidenceReportR\x06report\x12\x1f\n" +
+	"\vreport_json\x18\x03 \x01(\fR\n" +
+	"reportJson\x12\x1c\n" +
+	"\tsignature\x18\x04 \x01(\tR\tsignature\"Z\n" +
+	"\x14GetAuditProofRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x19\n" +
+	"\baudit_id\x18\x02 \x01(\tR\aauditId\"t\n" +
+	"\x15GetAuditProofResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x121\n" +
+	"\x05proof\x18\x02 \x01(\v2\x1b.rgs.v1.AuditInclusionProofR\x05proof2\xc7\a\n" +
 	"\fAuditService\x12l\n" +
 	"\x0fListAuditEvents\x12\x1e.rgs.v1.ListAuditEventsRequest\x1a\x1f.rgs.v1.ListAuditEventsResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/audit/events\x12\x94\x01\n" +
 	"\x1aListRemoteAccessActivities\x12).rgs.v1.ListRemoteAccessActivitiesRequest\x1a*.rgs.v1.ListRemoteAccessActivitiesResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/audit/remote-access\x12x\n" +
-	"\x10VerifyAuditChain\x12\x1f.rgs.v1.VerifyAuditChainRequest\x1a .rgs.v1.VerifyAuditChainResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/audit/chain:verifyB\x8c\x01\n" +
+	"\x10VerifyAuditChain\x12\x1f.rgs.v1.VerifyAuditChainRequest\x1a .rgs.v1.VerifyAuditChainResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/audit/chain:verify\x12\x89\x01\n" +
+	"\x14ExportAuditPartition\x12#.rgs.v1.ExportAuditPartitionRequest\x1a$.rgs.v1.ExportAuditPartitionResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/audit/partitions:export\x12\x89\x01\n" +
+	"\x14AnchorAuditPartition\x12#.rgs.v1.AnchorAuditPartitionRequest\x1a$.rgs.v1.AnchorAuditPartitionResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/audit/partitions:anchor\x12w\n" +
+	"\rGetAuditProof\x12\x1c.rgs.v1.GetAuditProofRequest\x1a\x1d.rgs.v1.GetAuditProofResponse\")\x82\xd3\xe4\x93\x02#\x12!/v1/audit/events/{audit_id}/proof\x12\xa6\x01\n" +
+	"\x1cGenerateTamperEvidenceReport\x12+.rgs.v1.GenerateTamperEvidenceReportRequest\x1a,.rgs.v1.GenerateTamperEvidenceReportResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/audit/tamper-evidence:reportB\x8c\x01\n" +
 	"\n" +
 	"com.rgs.v1B\n" +
 	"AuditProtoP\x01Z9github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1;rgsv1\xa2\x02\x03RXX\xaa\x02\x06Rgs.V1\xca\x02\x06Rgs\\V1\xe2\x02\x12Rgs\\V1\\GPBMetadata\xea\x02\aRgs::V1b\x06proto3"
@@ -677,39 +1930,76 @@ func file_rgs_v1_audit_proto_rawDescGZIP() []byte {
 	return file_rgs_v1_audit_proto_rawDescData
 }
 
-var file_rgs_v1_audit_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_rgs_v1_audit_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_rgs_v1_audit_proto_goTypes = []any{
-	(*AuditEventRecord)(nil),                   // 0: rgs.v1.AuditEventRecord
-	(*RemoteAccessActivityRecord)(nil),         // 1: rgs.v1.RemoteAccessActivityRecord
-	(*ListAuditEventsRequest)(nil),             // 2: rgs.v1.ListAuditEventsRequest
-	(*ListAuditEventsResponse)(nil),            // 3: rgs.v1.ListAuditEventsResponse
-	(*ListRemoteAccessActivitiesRequest)(nil),  // 4: rgs.v1.ListRemoteAccessActivitiesRequest
-	(*ListRemoteAccessActivitiesResponse)(nil), // 5: rgs.v1.ListRemoteAccessActivitiesResponse
-	(*VerifyAuditChainRequest)(nil),            // 6: rgs.v1.VerifyAuditChainRequest
-	(*VerifyAuditChainResponse)(nil),           // 7: rgs.v1.VerifyAuditChainResponse
-	(*RequestMeta)(nil),                        // 8: rgs.v1.RequestMeta
-	(*ResponseMeta)(nil),                       // 9: rgs.v1.ResponseMeta
+	(*AuditEventRecord)(nil),                     // 0: rgs.v1.AuditEventRecord
+	(*AuditPartitionExport)(nil),                 // 1: rgs.v1.AuditPartitionExport
+	(*AuditChainAnchor)(nil),                     // 2: rgs.v1.AuditChainAnchor
+	(*AuditPartitionSeal)(nil),                   // 3: rgs.v1.AuditPartitionSeal
+	(*AuditInclusionProof)(nil),                  // 4: rgs.v1.AuditInclusionProof
+	(*TamperEvidencePartition)(nil),              // 5: rgs.v1.TamperEvidencePartition
+	(*TamperEvidenceReport)(nil),                 // 6: rgs.v1.TamperEvidenceReport
+	(*RemoteAccessActivityRecord)(nil),           // 7: rgs.v1.RemoteAccessActivityRecord
+	(*ListAuditEventsRequest)(nil),               // 8: rgs.v1.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil),              // 9: rgs.v1.ListAuditEventsResponse
+	(*ListRemoteAccessActivitiesRequest)(nil),    // 10: rgs.v1.ListRemoteAccessActivitiesRequest
+	(*ListRemoteAccessActivitiesResponse)(nil),   // 11: rgs.v1.ListRemoteAccessActivitiesResponse
+	(*VerifyAuditChainRequest)(nil),              // 12: rgs.v1.VerifyAuditChainRequest
+	(*VerifyAuditChainResponse)(nil),             // 13: rgs.v1.VerifyAuditChainResponse
+	(*ExportAuditPartitionRequest)(nil),          // 14: rgs.v1.ExportAuditPartitionRequest
+	(*ExportAuditPartitionResponse)(nil),         // 15: rgs.v1.ExportAuditPartitionResponse
+	(*AnchorAuditPartitionRequest)(nil),          // 16: rgs.v1.AnchorAuditPartitionRequest
+	(*AnchorAuditPartitionResponse)(nil),         // 17: rgs.v1.AnchorAuditPartitionResponse
+	(*GenerateTamperEvidenceReportRequest)(nil),  // 18: rgs.v1.GenerateTamperEvidenceReportRequest
+	(*GenerateTamperEvidenceReportResponse)(nil), // 19: rgs.v1.GenerateTamperEvidenceReportResponse
+	(*GetAuditProofRequest)(nil),                 // 20: rgs.v1.GetAuditProofRequest
+	(*GetAuditProofResponse)(nil),                // 21: rgs.v1.GetAuditProofResponse
+	(*RequestMeta)(nil),                          // 22: rgs.v1.RequestMeta
+	(*ResponseMeta)(nil),                         // 23: rgs.v1.ResponseMeta
 }
 var file_rgs_v1_audit_proto_depIdxs = []int32{
-	8,  // 0: rgs.v1.ListAuditEventsRequest.meta:type_name -> rgs.v1.RequestMeta
-	9,  // 1: rgs.v1.ListAuditEventsResponse.meta:type_name -> rgs.v1.ResponseMeta
-	0,  // 2: rgs.v1.ListAuditEventsResponse.events:type_name -> rgs.v1.AuditEventRecord
-	8,  // 3: rgs.v1.ListRemoteAccessActivitiesRequest.meta:type_name -> rgs.v1.RequestMeta
-	9,  // 4: rgs.v1.ListRemoteAccessActivitiesResponse.meta:type_name -> rgs.v1.ResponseMeta
-	1,  // 5: rgs.v1.ListRemoteAccessActivitiesResponse.activities:type_name -> rgs.v1.RemoteAccessActivityRecord
-	8,  // 6: rgs.v1.VerifyAuditChainRequest.meta:type_name -> rgs.v1.RequestMeta
-	9,  // 7: rgs.v1.VerifyAuditChainResponse.meta:type_name -> rgs.v1.ResponseMeta
-	2,  // 8: rgs.v1.AuditService.ListAuditEvents:input_type -> rgs.v1.ListAuditEventsRequest
-	4,  // 9: rgs.v1.AuditService.ListRemoteAccessActivities:input_type -> rgs.v1.ListRemoteAccessActivitiesRequest
-	6,  // 10: rgs.v1.AuditService.VerifyAuditChain:input_type -> rgs.v1.VerifyAuditChainRequest
-	3,  // 11: rgs.v1.AuditService.ListAuditEvents:output_type -> rgs.v1.ListAuditEventsResponse
-	5,  // 12: rgs.v1.AuditService.ListRemoteAccessActivities:output_type -> rgs.v1.ListRemoteAccessActivitiesResponse
-	7,  // 13: rgs.v1.AuditService.VerifyAuditChain:output_type -> rgs.v1.VerifyAuditChainResponse
-	11, // [11:14] is the sub-list for method output_type
-	8,  // [8:11] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	0,  // 0: rgs.v1.AuditInclusionProof.event:type_name -> rgs.v1.AuditEventRecord
+	3,  // 1: rgs.v1.AuditInclusionProof.seal:type_name -> rgs.v1.AuditPartitionSeal
+	5,  // 2: rgs.v1.TamperEvidenceReport.partitions:type_name -> rgs.v1.TamperEvidencePartition
+	22, // 3: rgs.v1.ListAuditEventsRequest.meta:type_name -> rgs.v1.RequestMeta
+	23, // 4: rgs.v1.ListAuditEventsResponse.meta:type_name -> rgs.v1.ResponseMeta
+	0,  // 5: rgs.v1.ListAuditEventsResponse.events:type_name -> rgs.v1.AuditEventRecord
+	22, // 6: rgs.v1.ListRemoteAccessActivitiesRequest.meta:type_name -> rgs.v1.RequestMeta
+	23, // 7: rgs.v1.ListRemoteAccessActivitiesResponse.meta:type_name -> rgs.v1.ResponseMeta
+	7,  // 8: rgs.v1.ListRemoteAccessActivitiesResponse.activities:type_name -> rgs.v1.RemoteAccessActivityRecord
+	22, // 9: rgs.v1.VerifyAuditChainRequest.meta:type_name -> rgs.v1.RequestMeta
+	23, // 10: rgs.v1.VerifyAuditChainResponse.meta:type_name -> rgs.v1.ResponseMeta
+	22, // 11: rgs.v1.ExportAuditPartitionRequest.meta:type_name -> rgs.v1.RequestMeta
+	23, // 12: rgs.v1.ExportAuditPartitionResponse.meta:type_name -> rgs.v1.ResponseMeta
+	1,  // 13: rgs.v1.ExportAuditPartitionResponse.export:type_name -> rgs.v1.AuditPartitionExport
+	22, // 14: rgs.v1.AnchorAuditPartitionRequest.meta:type_name -> rgs.v1.RequestMeta
+	23, // 15: rgs.v1.AnchorAuditPartitionResponse.meta:type_name -> rgs.v1.ResponseMeta
+	2,  // 16: rgs.v1.AnchorAuditPartitionResponse.anchor:type_name -> rgs.v1.AuditChainAnchor
+	22, // 17: rgs.v1.GenerateTamperEvidenceReportRequest.meta:type_name -> rgs.v1.RequestMeta
+	23, // 18: rgs.v1.GenerateTamperEvidenceReportResponse.meta:type_name -> rgs.v1.ResponseMeta
+	6,  // 19: rgs.v1.GenerateTamperEvidenceReportResponse.report:type_name -> rgs.v1.TamperEvidenceReport
+	22, // 20: rgs.v1.GetAuditProofRequest.meta:type_name -> rgs.v1.RequestMeta
+	23, // 21: rgs.v1.GetAuditProofResponse.meta:type_name -> rgs.v1.ResponseMeta
+	4,  // 22: rgs.v1.GetAuditProofResponse.proof:type_name -> rgs.v1.AuditInclusionProof
+	8,  // 23: rgs.v1.AuditService.ListAuditEvents:input_type -> rgs.v1.ListAuditEventsRequest
+	10, // 24: rgs.v1.AuditService.ListRemoteAccessActivities:input_type -> rgs.v1.ListRemoteAccessActivitiesRequest
+	12, // 25: rgs.v1.AuditService.VerifyAuditChain:input_type -> rgs.v1.VerifyAuditChainRequest
+	14, // 26: rgs.v1.AuditService.ExportAuditPartition:input_type -> rgs.v1.ExportAuditPartitionRequest
+	16, // 27: rgs.v1.AuditService.AnchorAuditPartition:input_type -> rgs.v1.AnchorAuditPartitionRequest
+	20, // 28: rgs.v1.AuditService.GetAuditProof:input_type -> rgs.v1.GetAuditProofRequest
+	18, // 29: rgs.v1.AuditService.GenerateTamperEvidenceReport:input_type -> rgs.v1.GenerateTamperEvidenceReportRequest
+	9,  // 30: rgs.v1.AuditService.ListAuditEvents:output_type -> rgs.v1.ListAuditEventsResponse
+	11, // 31: rgs.v1.AuditService.ListRemoteAccessActivities:output_type -> rgs.v1.ListRemoteAccessActivitiesResponse
+	13, // 32: rgs.v1.AuditService.VerifyAuditChain:output_type -> rgs.v1.VerifyAuditChainResponse
+	15, // 33: rgs.v1.AuditService.ExportAuditPartition:output_type -> rgs.v1.ExportAuditPartitionResponse
+	17, // 34: rgs.v1.AuditService.AnchorAuditPartition:output_type -> rgs.v1.AnchorAuditPartitionResponse
+	21, // 35: rgs.v1.AuditService.GetAuditProof:output_type -> rgs.v1.GetAuditProofResponse
+	19, // 36: rgs.v1.AuditService.GenerateTamperEvidenceReport:output_type -> rgs.v1.GenerateTamperEvidenceReportResponse
+	30, // [30:37] is the sub-list for method output_type
+	23, // [23:30] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_rgs_v1_audit_proto_init() }
@@ -724,7 +2014,7 @@ func file_rgs_v1_audit_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rgs_v1_audit_proto_rawDesc), len(file_rgs_v1_audit_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
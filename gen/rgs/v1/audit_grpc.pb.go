@@ -19,9 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AuditService_ListAuditEvents_FullMethodName            = "/rgs.v1.AuditService/ListAuditEvents"
-	AuditService_ListRemoteAccessActivities_FullMethodName = "/rgs.v1.AuditService/ListRemoteAccessActivities"
-	AuditService_VerifyAuditChain_FullMethodName           = "/rgs.v1.AuditService/VerifyAuditChain"
+	AuditService_ListAuditEvents_FullMethodName              = "/rgs.v1.AuditService/ListAuditEvents"
+	AuditService_ListRemoteAccessActivities_FullMethodName   = "/rgs.v1.AuditService/ListRemoteAccessActivities"
+	AuditService_VerifyAuditChain_FullMethodName             = "/rgs.v1.AuditService/VerifyAuditChain"
+	AuditService_ExportAuditPartition_FullMethodName         = "/rgs.v1.AuditService/ExportAuditPartition"
+	AuditService_AnchorAuditPartition_FullMethodName         = "/rgs.v1.AuditService/AnchorAuditPartition"
+	AuditService_GetAuditProof_FullMethodName                = "/rgs.v1.AuditService/GetAuditProof"
+	AuditService_GenerateTamperEvidenceReport_FullMethodName = "/rgs.v1.AuditService/GenerateTamperEvidenceReport"
 )
 
 // AuditServiceClient is the client API for AuditService service.
@@ -31,6 +35,10 @@ type AuditServiceClient interface {
 	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
 	ListRemoteAccessActivities(ctx context.Context, in *ListRemoteAccessActivitiesRequest, opts ...grpc.CallOption) (*ListRemoteAccessActivitiesResponse, error)
 	VerifyAuditChain(ctx context.Context, in *VerifyAuditChainRequest, opts ...grpc.CallOption) (*VerifyAuditChainResponse, error)
+	ExportAuditPartition(ctx context.Context, in *ExportAuditPartitionRequest, opts ...grpc.CallOption) (*ExportAuditPartitionResponse, error)
+	AnchorAuditPartition(ctx context.Context, in *AnchorAuditPartitionRequest, opts ...grpc.CallOption) (*AnchorAuditPartitionResponse, error)
+	GetAuditProof(ctx context.Context, in *GetAuditProofRequest, opts ...grpc.CallOption) (*GetAuditProofResponse, error)
+	GenerateTamperEvidenceReport(ctx context.Context, in *GenerateTamperEvidenceReportRequest, opts ...grpc.CallOption) (*GenerateTamperEvidenceReportResponse, error)
 }
 
 type auditServiceClient struct {
@@ -71,6 +79,46 @@ func (c *auditServiceClient) VerifyAuditChain(ctx context.Context, in *VerifyAud
 	return out, nil
 }
 
+func (c *auditServiceClient) ExportAuditPartition(ctx context.Context, in *ExportAuditPartitionRequest, opts ...grpc.CallOption) (*ExportAuditPartitionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportAuditPartitionResponse)
+	err := c.cc.Invoke(ctx, AuditService_ExportAuditPartition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auditServiceClient) AnchorAuditPartition(ctx context.Context, in *AnchorAuditPartitionRequest, opts ...grpc.CallOption) (*AnchorAuditPartitionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnchorAuditPartitionResponse)
+	err := c.cc.Invoke(ctx, AuditService_AnchorAuditPartition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auditServiceClient) GetAuditProof(ctx context.Context, in *GetAuditProofRequest, opts ...grpc.CallOption) (*GetAuditProofResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAuditProofResponse)
+	err := c.cc.Invoke(ctx, AuditService_GetAuditProof_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auditServiceClient) GenerateTamperEvidenceReport(ctx context.Context, in *GenerateTamperEvidenceReportRequest, opts ...grpc.CallOption) (*GenerateTamperEvidenceReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateTamperEvidenceReportResponse)
+	err := c.cc.Invoke(ctx, AuditService_GenerateTamperEvidenceReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuditServiceServer is the server API for AuditService service.
 // All implementations must embed UnimplementedAuditServiceServer
 // for forward compatibility.
@@ -78,6 +126,10 @@ type AuditServiceServer interface {
 	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
 	ListRemoteAccessActivities(context.Context, *ListRemoteAccessActivitiesRequest) (*ListRemoteAccessActivitiesResponse, error)
 	VerifyAuditChain(context.Context, *VerifyAuditChainRequest) (*VerifyAuditChainResponse, error)
+	ExportAuditPartition(context.Context, *ExportAuditPartitionRequest) (*ExportAuditPartitionResponse, error)
+	AnchorAuditPartition(context.Context, *AnchorAuditPartitionRequest) (*AnchorAuditPartitionResponse, error)
+	GetAuditProof(context.Context, *GetAuditProofRequest) (*GetAuditProofResponse, error)
+	GenerateTamperEvidenceReport(context.Context, *GenerateTamperEvidenceReportRequest) (*GenerateTamperEvidenceReportResponse, error)
 	mustEmbedUnimplementedAuditServiceServer()
 }
 
@@ -97,6 +149,18 @@ func (UnimplementedAuditServiceServer) ListRemoteAccessActivities(context.Contex
 func (UnimplementedAuditServiceServer) VerifyAuditChain(context.Context, *VerifyAuditChainRequest) (*VerifyAuditChainResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method VerifyAuditChain not implemented")
 }
+func (UnimplementedAuditServiceServer) ExportAuditPartition(context.Context, *ExportAuditPartitionRequest) (*ExportAuditPartitionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportAuditPartition not implemented")
+}
+func (UnimplementedAuditServiceServer) AnchorAuditPartition(context.Context, *AnchorAuditPartitionRequest) (*AnchorAuditPartitionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AnchorAuditPartition not implemented")
+}
+func (UnimplementedAuditServiceServer) GetAuditProof(context.Context, *GetAuditProofRequest) (*GetAuditProofResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAuditProof not implemented")
+}
+func (UnimplementedAuditServiceServer) GenerateTamperEvidenceReport(context.Context, *GenerateTamperEvidenceReportRequest) (*GenerateTamperEvidenceReportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GenerateTamperEvidenceReport not implemented")
+}
 func (UnimplementedAuditServiceServer) mustEmbedUnimplementedAuditServiceServer() {}
 func (UnimplementedAuditServiceServer) testEmbeddedByValue()                      {}
 
@@ -172,6 +236,78 @@ func _AuditService_VerifyAuditChain_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuditService_ExportAuditPartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportAuditPartitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServiceServer).ExportAuditPartition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditService_ExportAuditPartition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServiceServer).ExportAuditPartition(ctx, req.(*ExportAuditPartitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuditService_AnchorAuditPartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnchorAuditPartitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServiceServer).AnchorAuditPartition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditService_AnchorAuditPartition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServiceServer).AnchorAuditPartition(ctx, req.(*AnchorAuditPartitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuditService_GetAuditProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServiceServer).GetAuditProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditService_GetAuditProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServiceServer).GetAuditProof(ctx, req.(*GetAuditProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuditService_GenerateTamperEvidenceReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateTamperEvidenceReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServiceServer).GenerateTamperEvidenceReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditService_GenerateTamperEvidenceReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServiceServer).GenerateTamperEvidenceReport(ctx, req.(*GenerateTamperEvidenceReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuditService_ServiceDesc is the grpc.ServiceDesc for AuditService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -191,6 +327,22 @@ var AuditService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "VerifyAuditChain",
 			Handler:    _AuditService_VerifyAuditChain_Handler,
 		},
+		{
+			MethodName: "ExportAuditPartition",
+			Handler:    _AuditService_ExportAuditPartition_Handler,
+		},
+		{
+			MethodName: "AnchorAuditPartition",
+			Handler:    _AuditService_AnchorAuditPartition_Handler,
+		},
+		{
+			MethodName: "GetAuditProof",
+			Handler:    _AuditService_GetAuditProof_Handler,
+		},
+		{
+			MethodName: "GenerateTamperEvidenceReport",
+			Handler:    _AuditService_GenerateTamperEvidenceReport_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rgs/v1/audit.proto",
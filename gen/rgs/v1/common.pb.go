@@ -134,8 +134,11 @@ type RequestMeta struct {
 	IdempotencyKey string                 `protobuf:"bytes,2,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 	Actor          *Actor                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
 	Source         *Source                `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Set by the server, never the client, when the caller's token was issued
+	// by IdentityService/AssumeActor: the operator acting as actor.
+	Impersonator  *Actor `protobuf:"bytes,5,opt,name=impersonator,proto3" json:"impersonator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RequestMeta) Reset() {
@@ -196,12 +199,22 @@ func (x *RequestMeta) GetSource() *Source {
 	return nil
 }
 
+func (x *RequestMeta) GetImpersonator() *Actor {
+	if x != nil {
+		return x.Impersonator
+	}
+	return nil
+}
+
 type ResponseMeta struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	ResultCode    ResultCode             `protobuf:"varint,2,opt,name=result_code,json=resultCode,proto3,enum=rgs.v1.ResultCode" json:"result_code,omitempty"`
-	DenialReason  string                 `protobuf:"bytes,3,opt,name=denial_reason,json=denialReason,proto3" json:"denial_reason,omitempty"`
-	ServerTime    string                 `protobuf:"bytes,4,opt,name=server_time,json=serverTime,proto3" json:"server_time,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	RequestId    string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	ResultCode   ResultCode             `protobuf:"varint,2,opt,name=result_code,json=resultCode,proto3,enum=rgs.v1.ResultCode" json:"result_code,omitempty"`
+	DenialReason string                 `protobuf:"bytes,3,opt,name=denial_reason,json=denialReason,proto3" json:"denial_reason,omitempty"`
+	ServerTime   string                 `protobuf:"bytes,4,opt,name=server_time,json=serverTime,proto3" json:"server_time,omitempty"`
+	// The operator acting as the caller, when the call was made with an
+	// AssumeActor token.
+	Impersonator  *Actor `protobuf:"bytes,5,opt,name=impersonator,proto3" json:"impersonator,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -264,6 +277,13 @@ func (x *ResponseMeta) GetServerTime() string {
 	return ""
 }
 
+func (x *ResponseMeta) GetImpersonator() *Actor {
+	if x != nil {
+		return x.Impersonator
+	}
+	return nil
+}
+
 type Actor struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ActorId       string                 `protobuf:"bytes,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
@@ -388,13 +408,14 @@ var File_rgs_v1_common_proto protoreflect.FileDescriptor
 
 const file_rgs_v1_common_proto_rawDesc = "" +
 	"\n" +
-	"\x13rgs/v1/common.proto\x12\x06rgs.v1\"\xa2\x01\n" +
+	"\x13rgs/v1/common.proto\x12\x06rgs.v1\"\xd5\x01\n" +
 	"\vRequestMeta\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\tR\trequestId\x12'\n" +
 	"\x0fidempotency_key\x18\x02 \x01(\tR\x0eidempotencyKey\x12#\n" +
 	"\x05actor\x18\x03 \x01(\v2\r.rgs.v1.ActorR\x05actor\x12&\n" +
-	"\x06source\x18\x04 \x01(\v2\x0e.rgs.v1.SourceR\x06source\"\xa8\x01\n" +
+	"\x06source\x18\x04 \x01(\v2\x0e.rgs.v1.SourceR\x06source\x121\n" +
+	"\fimpersonator\x18\x05 \x01(\v2\r.rgs.v1.ActorR\fimpersonator\"\xdb\x01\n" +
 	"\fResponseMeta\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\tR\trequestId\x123\n" +
@@ -402,7 +423,8 @@ const file_rgs_v1_common_proto_rawDesc = "" +
 	"resultCode\x12#\n" +
 	"\rdenial_reason\x18\x03 \x01(\tR\fdenialReason\x12\x1f\n" +
 	"\vserver_time\x18\x04 \x01(\tR\n" +
-	"serverTime\"T\n" +
+	"serverTime\x121\n" +
+	"\fimpersonator\x18\x05 \x01(\v2\r.rgs.v1.ActorR\fimpersonator\"T\n" +
 	"\x05Actor\x12\x19\n" +
 	"\bactor_id\x18\x01 \x01(\tR\aactorId\x120\n" +
 	"\n" +
@@ -453,13 +475,15 @@ var file_rgs_v1_common_proto_goTypes = []any{
 var file_rgs_v1_common_proto_depIdxs = []int32{
 	4, // 0: rgs.v1.RequestMeta.actor:type_name -> rgs.v1.Actor
 	5, // 1: rgs.v1.RequestMeta.source:type_name -> rgs.v1.Source
-	1, // 2: rgs.v1.ResponseMeta.result_code:type_name -> rgs.v1.ResultCode
-	0, // 3: rgs.v1.Actor.actor_type:type_name -> rgs.v1.ActorType
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	4, // 2: rgs.v1.RequestMeta.impersonator:type_name -> rgs.v1.Actor
+	1, // 3: rgs.v1.ResponseMeta.result_code:type_name -> rgs.v1.ResultCode
+	4, // 4: rgs.v1.ResponseMeta.impersonator:type_name -> rgs.v1.Actor
+	0, // 5: rgs.v1.Actor.actor_type:type_name -> rgs.v1.ActorType
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_rgs_v1_common_proto_init() }
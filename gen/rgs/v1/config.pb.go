@@ -77,6 +77,61 @@ func (ConfigChangeStatus) EnumDescriptor() ([]byte, []int) {
 	return file_rgs_v1_config_proto_rawDescGZIP(), []int{0}
 }
 
+type ConfigValueType int32
+
+const (
+	ConfigValueType_CONFIG_VALUE_TYPE_UNSPECIFIED ConfigValueType = 0
+	ConfigValueType_CONFIG_VALUE_TYPE_STRING      ConfigValueType = 1
+	ConfigValueType_CONFIG_VALUE_TYPE_INTEGER     ConfigValueType = 2
+	ConfigValueType_CONFIG_VALUE_TYPE_DECIMAL     ConfigValueType = 3
+	ConfigValueType_CONFIG_VALUE_TYPE_BOOLEAN     ConfigValueType = 4
+)
+
+// Enum value maps for ConfigValueType.
+var (
+	ConfigValueType_name = map[int32]string{
+		0: "CONFIG_VALUE_TYPE_UNSPECIFIED",
+		1: "CONFIG_VALUE_TYPE_STRING",
+		2: "CONFIG_VALUE_TYPE_INTEGER",
+		3: "CONFIG_VALUE_TYPE_DECIMAL",
+		4: "CONFIG_VALUE_TYPE_BOOLEAN",
+	}
+	ConfigValueType_value = map[string]int32{
+		"CONFIG_VALUE_TYPE_UNSPECIFIED": 0,
+		"CONFIG_VALUE_TYPE_STRING":      1,
+		"CONFIG_VALUE_TYPE_INTEGER":     2,
+		"CONFIG_VALUE_TYPE_DECIMAL":     3,
+		"CONFIG_VALUE_TYPE_BOOLEAN":     4,
+	}
+)
+
+func (x ConfigValueType) Enum() *ConfigValueType {
+	p := new(ConfigValueType)
+	*p = x
+	return p
+}
+
+func (x ConfigValueType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConfigValueType) Descriptor() protoreflect.EnumDescriptor {
+	return file_rgs_v1_config_proto_enumTypes[1].Descriptor()
+}
+
+func (ConfigValueType) Type() protoreflect.EnumType {
+	return &file_rgs_v1_config_proto_enumTypes[1]
+}
+
+func (x ConfigValueType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConfigValueType.Descriptor instead.
+func (ConfigValueType) EnumDescriptor() ([]byte, []int) {
+	return file_rgs_v1_config_proto_rawDescGZIP(), []int{1}
+}
+
 type DownloadAction int32
 
 const (
@@ -116,11 +171,11 @@ func (x DownloadAction) String() string {
 }
 
 func (DownloadAction) Descriptor() protoreflect.EnumDescriptor {
-	return file_rgs_v1_config_proto_enumTypes[1].Descriptor()
+	return file_rgs_v1_config_proto_enumTypes[2].Descriptor()
 }
 
 func (DownloadAction) Type() protoreflect.EnumType {
-	return &file_rgs_v1_config_proto_enumTypes[1]
+	return &file_rgs_v1_config_proto_enumTypes[2]
 }
 
 func (x DownloadAction) Number() protoreflect.EnumNumber {
@@ -129,7 +184,7 @@ func (x DownloadAction) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DownloadAction.Descriptor instead.
 func (DownloadAction) EnumDescriptor() ([]byte, []int) {
-	return file_rgs_v1_config_proto_rawDescGZIP(), []int{1}
+	return file_rgs_v1_config_proto_rawDescGZIP(), []int{2}
 }
 
 type ConfigChange struct {
@@ -147,8 +202,19 @@ type ConfigChange struct {
 	CreatedAt       string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	ApprovedAt      string                 `protobuf:"bytes,12,opt,name=approved_at,json=approvedAt,proto3" json:"approved_at,omitempty"`
 	AppliedAt       string                 `protobuf:"bytes,13,opt,name=applied_at,json=appliedAt,proto3" json:"applied_at,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Set on a rollback: the applied change whose previous value it restores.
+	RollbackOfChangeId string `protobuf:"bytes,14,opt,name=rollback_of_change_id,json=rollbackOfChangeId,proto3" json:"rollback_of_change_id,omitempty"`
+	// Approvals recorded so far, in order. The change becomes APPROVED once
+	// required_approvals distinct operators have approved it; approver_id and
+	// approved_at then name the approval that met the threshold.
+	Approvals         []*ConfigChangeApproval `protobuf:"bytes,15,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	RequiredApprovals int32                   `protobuf:"varint,16,opt,name=required_approvals,json=requiredApprovals,proto3" json:"required_approvals,omitempty"`
+	// When set, the config_scheduled_apply job applies the approved change at
+	// or after this time; applied_at records when it actually took effect.
+	ScheduledApplyAt string `protobuf:"bytes,17,opt,name=scheduled_apply_at,json=scheduledApplyAt,proto3" json:"scheduled_apply_at,omitempty"`
+	ScheduledBy      string `protobuf:"bytes,18,opt,name=scheduled_by,json=scheduledBy,proto3" json:"scheduled_by,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *ConfigChange) Reset() {
@@ -272,26 +338,312 @@ func (x *ConfigChange) GetAppliedAt() string {
 	return ""
 }
 
-type DownloadLibraryEntry struct {
+func (x *ConfigChange) GetRollbackOfChangeId() string {
+	if x != nil {
+		return x.RollbackOfChangeId
+	}
+	return ""
+}
+
+func (x *ConfigChange) GetApprovals() []*ConfigChangeApproval {
+	if x != nil {
+		return x.Approvals
+	}
+	return nil
+}
+
+func (x *ConfigChange) GetRequiredApprovals() int32 {
+	if x != nil {
+		return x.RequiredApprovals
+	}
+	return 0
+}
+
+func (x *ConfigChange) GetScheduledApplyAt() string {
+	if x != nil {
+		return x.ScheduledApplyAt
+	}
+	return ""
+}
+
+func (x *ConfigChange) GetScheduledBy() string {
+	if x != nil {
+		return x.ScheduledBy
+	}
+	return ""
+}
+
+type ConfigChangeApproval struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EntryId       string                 `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
-	LibraryPath   string                 `protobuf:"bytes,2,opt,name=library_path,json=libraryPath,proto3" json:"library_path,omitempty"`
-	Checksum      string                 `protobuf:"bytes,3,opt,name=checksum,proto3" json:"checksum,omitempty"`
-	Version       string                 `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
-	Action        DownloadAction         `protobuf:"varint,5,opt,name=action,proto3,enum=rgs.v1.DownloadAction" json:"action,omitempty"`
-	ChangedBy     string                 `protobuf:"bytes,6,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
-	Reason        string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
-	OccurredAt    string                 `protobuf:"bytes,8,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
-	SignerKid     string                 `protobuf:"bytes,9,opt,name=signer_kid,json=signerKid,proto3" json:"signer_kid,omitempty"`
-	Signature     string                 `protobuf:"bytes,10,opt,name=signature,proto3" json:"signature,omitempty"`
-	SignatureAlg  string                 `protobuf:"bytes,11,opt,name=signature_alg,json=signatureAlg,proto3" json:"signature_alg,omitempty"`
+	ApproverId    string                 `protobuf:"bytes,1,opt,name=approver_id,json=approverId,proto3" json:"approver_id,omitempty"`
+	ApprovedAt    string                 `protobuf:"bytes,2,opt,name=approved_at,json=approvedAt,proto3" json:"approved_at,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *ConfigChangeApproval) Reset() {
+	*x = ConfigChangeApproval{}
+	mi := &file_rgs_v1_config_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigChangeApproval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigChangeApproval) ProtoMessage() {}
+
+func (x *ConfigChangeApproval) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_config_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigChangeApproval.ProtoReflect.Descriptor instead.
+func (*ConfigChangeApproval) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_config_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ConfigChangeApproval) GetApproverId() string {
+	if x != nil {
+		return x.ApproverId
+	}
+	return ""
+}
+
+func (x *ConfigChangeApproval) GetApprovedAt() string {
+	if x != nil {
+		return x.ApprovedAt
+	}
+	return ""
+}
+
+func (x *ConfigChangeApproval) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// ConfigSchema constrains the values ProposeConfigChange accepts for one
+// key. Every rule that is set must hold: min_value and max_value are
+// inclusive bounds for integer and decimal values, pattern is an RE2 regular
+// expression the whole value must match, and allowed_values, when non-empty,
+// lists every acceptable value.
+type ConfigSchema struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ConfigNamespace string                 `protobuf:"bytes,1,opt,name=config_namespace,json=configNamespace,proto3" json:"config_namespace,omitempty"`
+	ConfigKey       string                 `protobuf:"bytes,2,opt,name=config_key,json=configKey,proto3" json:"config_key,omitempty"`
+	ValueType       ConfigValueType        `protobuf:"varint,3,opt,name=value_type,json=valueType,proto3,enum=rgs.v1.ConfigValueType" json:"value_type,omitempty"`
+	MinValue        string                 `protobuf:"bytes,4,opt,name=min_value,json=minValue,proto3" json:"min_value,omitempty"`
+	MaxValue        string                 `protobuf:"bytes,5,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"`
+	Pattern         string                 `protobuf:"bytes,6,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	AllowedValues   []string               `protobuf:"bytes,7,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty"`
+	UpdatedBy       string                 `protobuf:"bytes,8,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	UpdatedAt       string                 `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ConfigSchema) Reset() {
+	*x = ConfigSchema{}
+	mi := &file_rgs_v1_config_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigSchema) ProtoMessage() {}
+
+func (x *ConfigSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_config_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigSchema.ProtoReflect.Descriptor instead.
+func (*ConfigSchema) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_config_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ConfigSchema) GetConfigNamespace() string {
+	if x != nil {
+		return x.ConfigNamespace
+	}
+	return ""
+}
+
+func (x *ConfigSchema) GetConfigKey() string {
+	if x != nil {
+		return x.ConfigKey
+	}
+	return ""
+}
+
+func (x *ConfigSchema) GetValueType() ConfigValueType {
+	if x != nil {
+		return x.ValueType
+	}
+	return ConfigValueType_CONFIG_VALUE_TYPE_UNSPECIFIED
+}
+
+func (x *ConfigSchema) GetMinValue() string {
+	if x != nil {
+		return x.MinValue
+	}
+	return ""
+}
+
+func (x *ConfigSchema) GetMaxValue() string {
+	if x != nil {
+		return x.MaxValue
+	}
+	return ""
+}
+
+func (x *ConfigSchema) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *ConfigSchema) GetAllowedValues() []string {
+	if x != nil {
+		return x.AllowedValues
+	}
+	return nil
+}
+
+func (x *ConfigSchema) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *ConfigSchema) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// FeatureFlag is the value of a key in the "flags" config namespace, stored
+// as its JSON encoding, e.g. {"enabled":true,"rollout_percent":25}. A
+// disabled flag is off everywhere. Otherwise it is on for the listed
+// equipment and operators and for rollout_percent of the remaining subjects.
+type FeatureFlag struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Enabled        bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	RolloutPercent int32                  `protobuf:"varint,2,opt,name=rollout_percent,json=rolloutPercent,proto3" json:"rollout_percent,omitempty"`
+	EquipmentIds   []string               `protobuf:"bytes,3,rep,name=equipment_ids,json=equipmentIds,proto3" json:"equipment_ids,omitempty"`
+	OperatorIds    []string               `protobuf:"bytes,4,rep,name=operator_ids,json=operatorIds,proto3" json:"operator_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *FeatureFlag) Reset() {
+	*x = FeatureFlag{}
+	mi := &file_rgs_v1_config_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeatureFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeatureFlag) ProtoMessage() {}
+
+func (x *FeatureFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_config_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeatureFlag.ProtoReflect.Descriptor instead.
+func (*FeatureFlag) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_config_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FeatureFlag) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *FeatureFlag) GetRolloutPercent() int32 {
+	if x != nil {
+		return x.RolloutPercent
+	}
+	return 0
+}
+
+func (x *FeatureFlag) GetEquipmentIds() []string {
+	if x != nil {
+		return x.EquipmentIds
+	}
+	return nil
+}
+
+func (x *FeatureFlag) GetOperatorIds() []string {
+	if x != nil {
+		return x.OperatorIds
+	}
+	return nil
+}
+
+type DownloadLibraryEntry struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	EntryId     string                 `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	LibraryPath string                 `protobuf:"bytes,2,opt,name=library_path,json=libraryPath,proto3" json:"library_path,omitempty"`
+	Checksum    string                 `protobuf:"bytes,3,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	Version     string                 `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	Action      DownloadAction         `protobuf:"varint,5,opt,name=action,proto3,enum=rgs.v1.DownloadAction" json:"action,omitempty"`
+	ChangedBy   string                 `protobuf:"bytes,6,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	Reason      string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	OccurredAt  string                 `protobuf:"bytes,8,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	SignerKid   string                 `protobuf:"bytes,9,opt,name=signer_kid,json=signerKid,proto3" json:"signer_kid,omitempty"`
+	Signature   string                 `protobuf:"bytes,10,opt,name=signature,proto3" json:"signature,omitempty"`
+	// HMAC-SHA256, ED25519, ECDSA-P256-SHA256 or ECDSA-P384-SHA384. When
+	// empty the algorithm of signer_kid's key is used.
+	SignatureAlg string `protobuf:"bytes,11,opt,name=signature_alg,json=signatureAlg,proto3" json:"signature_alg,omitempty"`
+	// Set by the server: verified, failed or unsigned. Activation requires a
+	// verified signature; other actions are recorded whatever the result.
+	VerificationResult  string `protobuf:"bytes,12,opt,name=verification_result,json=verificationResult,proto3" json:"verification_result,omitempty"`
+	VerificationFailure string `protobuf:"bytes,13,opt,name=verification_failure,json=verificationFailure,proto3" json:"verification_failure,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
 func (x *DownloadLibraryEntry) Reset() {
 	*x = DownloadLibraryEntry{}
-	mi := &file_rgs_v1_config_proto_msgTypes[1]
+	mi := &file_rgs_v1_config_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -303,7 +655,7 @@ func (x *DownloadLibraryEntry) String() string {
 func (*DownloadLibraryEntry) ProtoMessage() {}
 
 func (x *DownloadLibraryEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_config_proto_msgTypes[1]
+	mi := &file_rgs_v1_config_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -316,7 +668,7 @@ func (x *DownloadLibraryEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownloadLibraryEntry.ProtoReflect.Descriptor instead.
 func (*DownloadLibraryEntry) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_config_proto_rawDescGZIP(), []int{1}
+	return file_rgs_v1_config_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *DownloadLibraryEntry) GetEntryId() string {
@@ -396,6 +748,20 @@ func (x *DownloadLibraryEntry) GetSignatureAlg() string {
 	return ""
 }
 
+func (x *DownloadLibraryEntry) GetVerificationResult() string {
+	if x != nil {
+		return x.VerificationResult
+	}
+	return ""
+}
+
+func (x *DownloadLibraryEntry) GetVerificationFailure() string {
+	if x != nil {
+		return x.VerificationFailure
+	}
+	return ""
+}
+
 type ProposeConfigChangeRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Meta            *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
@@ -409,19 +775,1154 @@ type ProposeConfigChangeRequest struct {
 
 func (x *ProposeConfigChangeRequest) Reset() {
 	*x = ProposeConfigChangeRequest{}
-	mi := &file_rgs_v1_config_proto_msgTypes[2]
+	mi := &file_rgs_v1_config_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProposeConfigChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposeConfigChangeRequest) ProtoMessage() {}
+
+func (x *ProposeConfigChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_config_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposeConfigChangeRequest.ProtoReflect.Descriptor instead.
+func (*ProposeConfigChangeRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_config_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ProposeConfigChangeRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ProposeConfigChangeRequest) GetConfigNamespace() string {
+	if x != nil {
+		return x.ConfigNamespace
+	}
+	return ""
+}
+
+func (x *ProposeConfigChangeRequest) GetConfigKey() string {
+	if x != nil {
+		return x.ConfigKey
+	}
+	return ""
+}
+
+func (x *ProposeConfigChangeRequest) GetProposedValue() string {
+	if x != nil {
+		return x.ProposedValue
+	}
+	return ""
+}
+
+func (x *ProposeConfigChangeRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ProposeConfigChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Change        *ConfigChange          `protobuf:"bytes,2,opt,name=change,proto3" json:"change,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProposeConfigChangeResponse) Reset() {
+	*x = ProposeConfigChangeResponse{}
+	mi := &file_rgs_v1_config_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProposeConfigChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposeConfigChangeResponse) ProtoMessage() {}
+
+func (x *ProposeConfigChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_config_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposeConfigChangeResponse.ProtoReflect.Descriptor instead.
+func (*ProposeConfigChangeResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_config_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ProposeConfigChangeResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ProposeConfigChangeResponse) GetChange() *ConfigChange {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+// PreviewConfigChange takes the same fields as ProposeConfigChange and
+// applies the same validation.
+type PreviewConfigChangeRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Meta            *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	ConfigNamespace string                 `protobuf:"bytes,2,opt,name=config_namespace,json=configNamespace,proto3" json:"config_namespace,omitempty"`
+	ConfigKey       string                 `protobuf:"bytes,3,opt,name=config_key,json=configKey,proto3" json:"config_key,omitempty"`
+	ProposedValue   string                 `protobuf:"bytes,4,opt,name=proposed_value,json=proposedValue,proto3" json:"proposed_value,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PreviewConfigChangeRequest) Reset() {
+	*x = PreviewConfigChangeRequest{}
+	mi := &file_rgs_v1_config_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewConfigChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewConfigChangeRequest) ProtoMessage() {}
+
+func (x *PreviewConfigChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_config_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewConfigChangeRequest.ProtoReflect.Descriptor instead.
+func (*PreviewConfigChangeRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_config_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PreviewConfigChangeRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *PreviewConfigChangeRequest) GetConfigNamespace() string {
+	if x != nil {
+		return x.ConfigNamespace
+	}
+	return ""
+}
+
+func (x *PreviewConfigChangeRequest) GetConfigKey() string {
+	if x != nil {
+		return x.ConfigKey
+	}
+	return ""
+}
+
+func (x *PreviewConfigChangeRequest) GetProposedValue() string {
+	if x != nil {
+		return x.ProposedValue
+	}
+	return ""
+}
+
+// ConfigOverridePreview is an equipment-scoped key that takes precedence
+// over the previewed key, so the change has no effect on that equipment.
+type ConfigOverridePreview struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EquipmentId   string                 `protobuf:"bytes,1,opt,name=equipment_id,json=equipmentId,proto3" json:"equipment_id,omitempty"`
+	ConfigKey     string                 `protobuf:"bytes,2,opt,name=config_key,json=configKey,proto3" json:"config_key,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigOverridePreview) Reset() {
+	*x = ConfigOverridePreview{}
+	mi := &file_rgs_v1_config_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigOverridePreview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigOverridePreview) ProtoMessage() {}
+
+func (x *ConfigOverridePreview) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_config_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigOverridePreview.ProtoReflect.Descriptor instead.
+func (*ConfigOverridePreview) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_config_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ConfigOverridePreview) GetEquipmentId() string {
+	if x != nil {
+		return x.EquipmentId
+	}
+	return ""
+}
+
+func (x *ConfigOverridePreview) GetConfigKey() string {
+	if x != nil {
+		return x.ConfigKey
+	}
+	return ""
+}
+
+func (x *ConfigOverridePreview) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type PreviewConfigChangeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Meta  *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// The key's applied value now; before_found is false if it has none.
+	BeforeValue string `protobuf:"bytes,2,opt,name=before_value,json=beforeValue,proto3" json:"before_value,omitempty"`
+	BeforeFound bool   `protobuf:"varint,3,opt,name=before_found,json=beforeFound,proto3" json:"before_found,omitempty"`
+	AfterValue  string `protobuf:"bytes,4,opt,name=after_value,json=afterValue,proto3" json:"after_value,omitempty"`
+	// False when proposed_value equals the applied value.
+	Changed bool `protobuf:"varint,5,opt,name=changed,proto3" json:"changed,omitempty"`
+	// Set for unscoped and game-scoped wagering stake limits.
+	EquipmentOverrides []*ConfigOverridePreview `protobuf:"bytes,6,rep,name=equipment_overrides,json=equipmentOverrides,proto3" json:"equipment_overrides,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *PreviewConfigChangeResponse) Reset() {
+	*x = PreviewConfigChangeResponse{}
+	mi := &file_rgs_v1_config_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewConfigChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewConfigChangeResponse) ProtoMessage() {}
+
+func (x *PreviewConfigChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_config_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewConfigChangeResponse.ProtoReflect.Descriptor instead.
+func (*PreviewConfigChangeResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_config_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PreviewConfigChangeResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *PreviewConfigChangeResponse) GetBeforeValue() string {
+	if x != nil {
+		return x.BeforeValue
+	}
+	return ""
+}
+
+func (x *PreviewConfigChangeResponse) GetBeforeFound() bool {
+	if x != nil {
+		return x.BeforeFound
+	}
+	return false
+}
+
+func (x *PreviewConfigChangeResponse) GetAfterValue() string {
+	if x != nil {
+		return x.AfterValue
+	}
+	return ""
+}
+
+func (x *PreviewConfigChangeResponse) GetChanged() bool {
+	if x != nil {
+		return x.Changed
+	}
+	return false
+}
+
+func (x *PreviewConfigChangeResponse) GetEquipmentOverrides() []*ConfigOverridePreview {
+	if x != nil {
+		return x.EquipmentOverrides
+	}
+	return nil
+}
+
+type ApproveConfigChangeRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Meta     *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	ChangeId string                 `protobuf:"bytes,2,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	Reason   string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Optional RFC3339 time, in the future, at which to apply the change once
+	// approved, e.g. the start of a maintenance window.
+	ApplyAt       string `protobuf:"bytes,4,opt,name=apply_at,json=applyAt,proto3" json:"apply_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveConfigChangeRequest) Reset() {
+	*x = ApproveConfigChangeRequest{}
+	mi := &file_rgs_v1_config_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveConfigChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveConfigChangeRequest) ProtoMessage() {}
+
+func (x *ApproveConfigChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_config_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveConfigChangeRequest.ProtoReflect.Descriptor instead.
+func (*ApproveConfigChangeRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_config_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ApproveConfigChangeRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ApproveConfigChangeRequest) GetChangeId() string {
+	if x != nil {
+		return x.ChangeId
+	}
+	return ""
+}
+
+func (x *ApproveConfigChangeRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ApproveConfigChangeRequest) GetApplyAt() string {
+	if x != nil {
+		return x.ApplyAt
+	}
+	return ""
+}
+
+type ApproveConfigChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Change        *ConfigChange          `protobuf:"bytes,2,opt,name=change,proto3" json:"change,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveConfigChangeResponse) Reset() {
+	*x = ApproveConfigChangeResponse{}
+	mi := &file_rgs_v1_config_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveConfigChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveConfigChangeResponse) ProtoMessage() {}
+
+func (x *ApproveConfigChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_config_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveConfigChangeResponse.ProtoReflect.Descriptor instead.
+func (*ApproveConfigChangeResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_config_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ApproveConfigChangeResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ApproveConfigChangeResponse) GetChange() *ConfigChange {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+type ApplyConfigChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	ChangeId      string                 `protobuf:"bytes,2,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyConfigChangeRequest) Reset() {
+	*x = ApplyConfigChangeRequest{}
+	mi := &file_rgs_v1_config_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyConfigChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyConfigChangeRequest) ProtoMessage() {}
+
+func (x *ApplyConfigChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_config_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyConfigChangeRequest.ProtoReflect.Descriptor instead.
+func (*ApplyConfigChangeRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_config_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ApplyConfigChangeRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ApplyConfigChangeRequest) GetChangeId() string {
+	if x != nil {
+		return x.ChangeId
+	}
+	return ""
+}
+
+func (x *ApplyConfigChangeRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ApplyConfigChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Change        *ConfigChange          `protobuf:"bytes,2,opt,name=change,proto3" json:"change,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyConfigChangeResponse) Reset() {
+	*x = ApplyConfigChangeResponse{}
+	mi := &file_rgs_v1_config_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyConfigChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyConfigChangeResponse) ProtoMessage() {}
+
+func (x *ApplyConfigChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_config_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyConfigChangeResponse.ProtoReflect.Descriptor instead.
+func (*ApplyConfigChangeResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_config_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ApplyConfigChangeResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ApplyConfigChangeResponse) GetChange() *ConfigChange {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+// RollbackConfigChange proposes restoring an applied change's previous
+// value. The returned change must be approved by a second operator and
+// applied like any other change.
+type RollbackConfigChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	ChangeId      string                 `protobuf:"bytes,2,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackConfigChangeRequest) Reset() {
+	*x = RollbackConfigChangeRequest{}
+	mi := &file_rgs_v1_config_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackConfigChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackConfigChangeRequest) ProtoMessage() {}
+
+func (x *RollbackConfigChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_config_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackConfigChangeRequest.ProtoReflect.Descriptor instead.
+func (*RollbackConfigChangeRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_config_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RollbackConfigChangeRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *RollbackConfigChangeRequest) GetChangeId() string {
+	if x != nil {
+		return x.ChangeId
+	}
+	return ""
+}
+
+func (x *RollbackConfigChangeRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RollbackConfigChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Change        *ConfigChange          `protobuf:"bytes,2,opt,name=change,proto3" json:"change,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackConfigChangeResponse) Reset() {
+	*x = RollbackConfigChangeResponse{}
+	mi := &file_rgs_v1_config_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackConfigChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackConfigChangeResponse) ProtoMessage() {}
+
+func (x *RollbackConfigChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_config_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackConfigChangeResponse.ProtoReflect.Descriptor instead.
+func (*RollbackConfigChangeResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_config_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RollbackConfigChangeResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *RollbackConfigChangeResponse) GetChange() *ConfigChange {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+// CancelScheduledChange clears a change's scheduled_apply_at. An approved
+// change stays approved and can then be applied with ApplyConfigChange.
+type CancelScheduledChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	ChangeId      string                 `protobuf:"bytes,2,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduledChangeRequest) Reset() {
+	*x = CancelScheduledChangeRequest{}
+	mi := &file_rgs_v1_config_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledChangeRequest) ProtoMessage() {}
+
+func (x *CancelScheduledChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_config_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledChangeRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledChangeRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_config_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CancelScheduledChangeRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *CancelScheduledChangeRequest) GetChangeId() string {
+	if x != nil {
+		return x.ChangeId
+	}
+	return ""
+}
+
+func (x *CancelScheduledChangeRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CancelScheduledChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Change        *ConfigChange          `protobuf:"bytes,2,opt,name=change,proto3" json:"change,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduledChangeResponse) Reset() {
+	*x = CancelScheduledChangeResponse{}
+	mi := &file_rgs_v1_config_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledChangeResponse) ProtoMessage() {}
+
+func (x *CancelScheduledChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_config_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledChangeResponse.ProtoReflect.Descriptor instead.
+func (*CancelScheduledChangeResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_config_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CancelScheduledChangeResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *CancelScheduledChangeResponse) GetChange() *ConfigChange {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+type ListConfigHistoryRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Meta                  *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	ConfigNamespaceFilter string                 `protobuf:"bytes,2,opt,name=config_namespace_filter,json=configNamespaceFilter,proto3" json:"config_namespace_filter,omitempty"`
+	PageSize              int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken             string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ListConfigHistoryRequest) Reset() {
+	*x = ListConfigHistoryRequest{}
+	mi := &file_rgs_v1_config_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConfigHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConfigHistoryRequest) ProtoMessage() {}
+
+func (x *ListConfigHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_config_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConfigHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListConfigHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_config_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListConfigHistoryRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListConfigHistoryRequest) GetConfigNamespaceFilter() string {
+	if x != nil {
+		return x.ConfigNamespaceFilter
+	}
+	return ""
+}
+
+func (x *ListConfigHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListConfigHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListConfigHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Changes       []*ConfigChange        `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConfigHistoryResponse) Reset() {
+	*x = ListConfigHistoryResponse{}
+	mi := &file_rgs_v1_config_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConfigHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConfigHistoryResponse) ProtoMessage() {}
+
+func (x *ListConfigHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_config_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConfigHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListConfigHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_config_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListConfigHistoryResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListConfigHistoryResponse) GetChanges() []*ConfigChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *ListConfigHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetConfigValueAsOfRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Meta            *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	ConfigNamespace string                 `protobuf:"bytes,2,opt,name=config_namespace,json=configNamespace,proto3" json:"config_namespace,omitempty"`
+	ConfigKey       string                 `protobuf:"bytes,3,opt,name=config_key,json=configKey,proto3" json:"config_key,omitempty"`
+	// RFC3339 timestamp; empty means now.
+	AsOf          string `protobuf:"bytes,4,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConfigValueAsOfRequest) Reset() {
+	*x = GetConfigValueAsOfRequest{}
+	mi := &file_rgs_v1_config_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConfigValueAsOfRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigValueAsOfRequest) ProtoMessage() {}
+
+func (x *GetConfigValueAsOfRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_config_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigValueAsOfRequest.ProtoReflect.Descriptor instead.
+func (*GetConfigValueAsOfRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_config_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetConfigValueAsOfRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GetConfigValueAsOfRequest) GetConfigNamespace() string {
+	if x != nil {
+		return x.ConfigNamespace
+	}
+	return ""
+}
+
+func (x *GetConfigValueAsOfRequest) GetConfigKey() string {
+	if x != nil {
+		return x.ConfigKey
+	}
+	return ""
+}
+
+func (x *GetConfigValueAsOfRequest) GetAsOf() string {
+	if x != nil {
+		return x.AsOf
+	}
+	return ""
+}
+
+type GetConfigValueAsOfResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Meta  *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Value string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Found bool                   `protobuf:"varint,3,opt,name=found,proto3" json:"found,omitempty"`
+	// The applied change that set value, if found.
+	Change        *ConfigChange `protobuf:"bytes,4,opt,name=change,proto3" json:"change,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConfigValueAsOfResponse) Reset() {
+	*x = GetConfigValueAsOfResponse{}
+	mi := &file_rgs_v1_config_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConfigValueAsOfResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigValueAsOfResponse) ProtoMessage() {}
+
+func (x *GetConfigValueAsOfResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_config_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigValueAsOfResponse.ProtoReflect.Descriptor instead.
+func (*GetConfigValueAsOfResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_config_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetConfigValueAsOfResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GetConfigValueAsOfResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *GetConfigValueAsOfResponse) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+func (x *GetConfigValueAsOfResponse) GetChange() *ConfigChange {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+// SetConfigSchema registers or replaces the schema for a key. It applies to
+// changes proposed afterwards, not to values already proposed or applied.
+type SetConfigSchemaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Schema        *ConfigSchema          `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetConfigSchemaRequest) Reset() {
+	*x = SetConfigSchemaRequest{}
+	mi := &file_rgs_v1_config_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetConfigSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetConfigSchemaRequest) ProtoMessage() {}
+
+func (x *SetConfigSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_config_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetConfigSchemaRequest.ProtoReflect.Descriptor instead.
+func (*SetConfigSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_config_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SetConfigSchemaRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *SetConfigSchemaRequest) GetSchema() *ConfigSchema {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
+func (x *SetConfigSchemaRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SetConfigSchemaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Schema        *ConfigSchema          `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetConfigSchemaResponse) Reset() {
+	*x = SetConfigSchemaResponse{}
+	mi := &file_rgs_v1_config_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProposeConfigChangeRequest) String() string {
+func (x *SetConfigSchemaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProposeConfigChangeRequest) ProtoMessage() {}
+func (*SetConfigSchemaResponse) ProtoMessage() {}
 
-func (x *ProposeConfigChangeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_config_proto_msgTypes[2]
+func (x *SetConfigSchemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_config_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -432,69 +1933,100 @@ func (x *ProposeConfigChangeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProposeConfigChangeRequest.ProtoReflect.Descriptor instead.
-func (*ProposeConfigChangeRequest) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_config_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use SetConfigSchemaResponse.ProtoReflect.Descriptor instead.
+func (*SetConfigSchemaResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_config_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *ProposeConfigChangeRequest) GetMeta() *RequestMeta {
+func (x *SetConfigSchemaResponse) GetMeta() *ResponseMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *ProposeConfigChangeRequest) GetConfigNamespace() string {
+func (x *SetConfigSchemaResponse) GetSchema() *ConfigSchema {
 	if x != nil {
-		return x.ConfigNamespace
+		return x.Schema
 	}
-	return ""
+	return nil
 }
 
-func (x *ProposeConfigChangeRequest) GetConfigKey() string {
+type ListConfigSchemasRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Meta                  *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	ConfigNamespaceFilter string                 `protobuf:"bytes,2,opt,name=config_namespace_filter,json=configNamespaceFilter,proto3" json:"config_namespace_filter,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ListConfigSchemasRequest) Reset() {
+	*x = ListConfigSchemasRequest{}
+	mi := &file_rgs_v1_config_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConfigSchemasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConfigSchemasRequest) ProtoMessage() {}
+
+func (x *ListConfigSchemasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_config_proto_msgTypes[24]
 	if x != nil {
-		return x.ConfigKey
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ProposeConfigChangeRequest) GetProposedValue() string {
+// Deprecated: Use ListConfigSchemasRequest.ProtoReflect.Descriptor instead.
+func (*ListConfigSchemasRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_config_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListConfigSchemasRequest) GetMeta() *RequestMeta {
 	if x != nil {
-		return x.ProposedValue
+		return x.Meta
 	}
-	return ""
+	return nil
 }
 
-func (x *ProposeConfigChangeRequest) GetReason() string {
+func (x *ListConfigSchemasRequest) GetConfigNamespaceFilter() string {
 	if x != nil {
-		return x.Reason
+		return x.ConfigNamespaceFilter
 	}
 	return ""
 }
 
-type ProposeConfigChangeResponse struct {
+type ListConfigSchemasResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Change        *ConfigChange          `protobuf:"bytes,2,opt,name=change,proto3" json:"change,omitempty"`
+	Schemas       []*ConfigSchema        `protobuf:"bytes,2,rep,name=schemas,proto3" json:"schemas,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProposeConfigChangeResponse) Reset() {
-	*x = ProposeConfigChangeResponse{}
-	mi := &file_rgs_v1_config_proto_msgTypes[3]
+func (x *ListConfigSchemasResponse) Reset() {
+	*x = ListConfigSchemasResponse{}
+	mi := &file_rgs_v1_config_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProposeConfigChangeResponse) String() string {
+func (x *ListConfigSchemasResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProposeConfigChangeResponse) ProtoMessage() {}
+func (*ListConfigSchemasResponse) ProtoMessage() {}
 
-func (x *ProposeConfigChangeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_config_proto_msgTypes[3]
+func (x *ListConfigSchemasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_config_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -505,49 +2037,48 @@ func (x *ProposeConfigChangeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProposeConfigChangeResponse.ProtoReflect.Descriptor instead.
-func (*ProposeConfigChangeResponse) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_config_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ListConfigSchemasResponse.ProtoReflect.Descriptor instead.
+func (*ListConfigSchemasResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_config_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ProposeConfigChangeResponse) GetMeta() *ResponseMeta {
+func (x *ListConfigSchemasResponse) GetMeta() *ResponseMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *ProposeConfigChangeResponse) GetChange() *ConfigChange {
+func (x *ListConfigSchemasResponse) GetSchemas() []*ConfigSchema {
 	if x != nil {
-		return x.Change
+		return x.Schemas
 	}
 	return nil
 }
 
-type ApproveConfigChangeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	ChangeId      string                 `protobuf:"bytes,2,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
-	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type WatchConfigRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Meta            *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	ConfigNamespace string                 `protobuf:"bytes,2,opt,name=config_namespace,json=configNamespace,proto3" json:"config_namespace,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ApproveConfigChangeRequest) Reset() {
-	*x = ApproveConfigChangeRequest{}
-	mi := &file_rgs_v1_config_proto_msgTypes[4]
+func (x *WatchConfigRequest) Reset() {
+	*x = WatchConfigRequest{}
+	mi := &file_rgs_v1_config_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApproveConfigChangeRequest) String() string {
+func (x *WatchConfigRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApproveConfigChangeRequest) ProtoMessage() {}
+func (*WatchConfigRequest) ProtoMessage() {}
 
-func (x *ApproveConfigChangeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_config_proto_msgTypes[4]
+func (x *WatchConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_config_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -558,33 +2089,27 @@ func (x *ApproveConfigChangeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApproveConfigChangeRequest.ProtoReflect.Descriptor instead.
-func (*ApproveConfigChangeRequest) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_config_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use WatchConfigRequest.ProtoReflect.Descriptor instead.
+func (*WatchConfigRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_config_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *ApproveConfigChangeRequest) GetMeta() *RequestMeta {
+func (x *WatchConfigRequest) GetMeta() *RequestMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *ApproveConfigChangeRequest) GetChangeId() string {
-	if x != nil {
-		return x.ChangeId
-	}
-	return ""
-}
-
-func (x *ApproveConfigChangeRequest) GetReason() string {
+func (x *WatchConfigRequest) GetConfigNamespace() string {
 	if x != nil {
-		return x.Reason
+		return x.ConfigNamespace
 	}
 	return ""
 }
 
-type ApproveConfigChangeResponse struct {
+// The first message acknowledges the subscription and carries no change.
+type WatchConfigResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
 	Change        *ConfigChange          `protobuf:"bytes,2,opt,name=change,proto3" json:"change,omitempty"`
@@ -592,21 +2117,21 @@ type ApproveConfigChangeResponse struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApproveConfigChangeResponse) Reset() {
-	*x = ApproveConfigChangeResponse{}
-	mi := &file_rgs_v1_config_proto_msgTypes[5]
+func (x *WatchConfigResponse) Reset() {
+	*x = WatchConfigResponse{}
+	mi := &file_rgs_v1_config_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApproveConfigChangeResponse) String() string {
+func (x *WatchConfigResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApproveConfigChangeResponse) ProtoMessage() {}
+func (*WatchConfigResponse) ProtoMessage() {}
 
-func (x *ApproveConfigChangeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_config_proto_msgTypes[5]
+func (x *WatchConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_config_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -617,49 +2142,53 @@ func (x *ApproveConfigChangeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApproveConfigChangeResponse.ProtoReflect.Descriptor instead.
-func (*ApproveConfigChangeResponse) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_config_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use WatchConfigResponse.ProtoReflect.Descriptor instead.
+func (*WatchConfigResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_config_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *ApproveConfigChangeResponse) GetMeta() *ResponseMeta {
+func (x *WatchConfigResponse) GetMeta() *ResponseMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *ApproveConfigChangeResponse) GetChange() *ConfigChange {
+func (x *WatchConfigResponse) GetChange() *ConfigChange {
 	if x != nil {
 		return x.Change
 	}
 	return nil
 }
 
-type ApplyConfigChangeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	ChangeId      string                 `protobuf:"bytes,2,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
-	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+type PollConfigChangesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Meta            *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	ConfigNamespace string                 `protobuf:"bytes,2,opt,name=config_namespace,json=configNamespace,proto3" json:"config_namespace,omitempty"`
+	// RFC3339 cursor from a previous response's watermark; empty waits for the
+	// next change only.
+	Since string `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"`
+	// How long to wait when nothing has changed yet; default 30, at most 60.
+	WaitSeconds   int32 `protobuf:"varint,4,opt,name=wait_seconds,json=waitSeconds,proto3" json:"wait_seconds,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApplyConfigChangeRequest) Reset() {
-	*x = ApplyConfigChangeRequest{}
-	mi := &file_rgs_v1_config_proto_msgTypes[6]
+func (x *PollConfigChangesRequest) Reset() {
+	*x = PollConfigChangesRequest{}
+	mi := &file_rgs_v1_config_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApplyConfigChangeRequest) String() string {
+func (x *PollConfigChangesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplyConfigChangeRequest) ProtoMessage() {}
+func (*PollConfigChangesRequest) ProtoMessage() {}
 
-func (x *ApplyConfigChangeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_config_proto_msgTypes[6]
+func (x *PollConfigChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_config_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -670,55 +2199,65 @@ func (x *ApplyConfigChangeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplyConfigChangeRequest.ProtoReflect.Descriptor instead.
-func (*ApplyConfigChangeRequest) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_config_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use PollConfigChangesRequest.ProtoReflect.Descriptor instead.
+func (*PollConfigChangesRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_config_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *ApplyConfigChangeRequest) GetMeta() *RequestMeta {
+func (x *PollConfigChangesRequest) GetMeta() *RequestMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *ApplyConfigChangeRequest) GetChangeId() string {
+func (x *PollConfigChangesRequest) GetConfigNamespace() string {
 	if x != nil {
-		return x.ChangeId
+		return x.ConfigNamespace
 	}
 	return ""
 }
 
-func (x *ApplyConfigChangeRequest) GetReason() string {
+func (x *PollConfigChangesRequest) GetSince() string {
 	if x != nil {
-		return x.Reason
+		return x.Since
 	}
 	return ""
 }
 
-type ApplyConfigChangeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Change        *ConfigChange          `protobuf:"bytes,2,opt,name=change,proto3" json:"change,omitempty"`
+func (x *PollConfigChangesRequest) GetWaitSeconds() int32 {
+	if x != nil {
+		return x.WaitSeconds
+	}
+	return 0
+}
+
+type PollConfigChangesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Meta  *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// Changes applied after since, oldest first; empty if the wait timed out.
+	Changes []*ConfigChange `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
+	// Pass as since on the next poll.
+	Watermark     string `protobuf:"bytes,3,opt,name=watermark,proto3" json:"watermark,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApplyConfigChangeResponse) Reset() {
-	*x = ApplyConfigChangeResponse{}
-	mi := &file_rgs_v1_config_proto_msgTypes[7]
+func (x *PollConfigChangesResponse) Reset() {
+	*x = PollConfigChangesResponse{}
+	mi := &file_rgs_v1_config_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApplyConfigChangeResponse) String() string {
+func (x *PollConfigChangesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplyConfigChangeResponse) ProtoMessage() {}
+func (*PollConfigChangesResponse) ProtoMessage() {}
 
-func (x *ApplyConfigChangeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_config_proto_msgTypes[7]
+func (x *PollConfigChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_config_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -729,50 +2268,60 @@ func (x *ApplyConfigChangeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplyConfigChangeResponse.ProtoReflect.Descriptor instead.
-func (*ApplyConfigChangeResponse) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_config_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use PollConfigChangesResponse.ProtoReflect.Descriptor instead.
+func (*PollConfigChangesResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_config_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ApplyConfigChangeResponse) GetMeta() *ResponseMeta {
+func (x *PollConfigChangesResponse) GetMeta() *ResponseMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *ApplyConfigChangeResponse) GetChange() *ConfigChange {
+func (x *PollConfigChangesResponse) GetChanges() []*ConfigChange {
 	if x != nil {
-		return x.Change
+		return x.Changes
 	}
 	return nil
 }
 
-type ListConfigHistoryRequest struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	Meta                  *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	ConfigNamespaceFilter string                 `protobuf:"bytes,2,opt,name=config_namespace_filter,json=configNamespaceFilter,proto3" json:"config_namespace_filter,omitempty"`
-	PageSize              int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken             string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+func (x *PollConfigChangesResponse) GetWatermark() string {
+	if x != nil {
+		return x.Watermark
+	}
+	return ""
 }
 
-func (x *ListConfigHistoryRequest) Reset() {
-	*x = ListConfigHistoryRequest{}
-	mi := &file_rgs_v1_config_proto_msgTypes[8]
+// EvaluateFlag reports whether a feature flag is on for the given equipment
+// and operator. Rollout buckets on equipment_id, then operator_id, then the
+// caller's actor_id, so a subject keeps its result as the percentage grows.
+type EvaluateFlagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	FlagKey       string                 `protobuf:"bytes,2,opt,name=flag_key,json=flagKey,proto3" json:"flag_key,omitempty"`
+	EquipmentId   string                 `protobuf:"bytes,3,opt,name=equipment_id,json=equipmentId,proto3" json:"equipment_id,omitempty"`
+	OperatorId    string                 `protobuf:"bytes,4,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluateFlagRequest) Reset() {
+	*x = EvaluateFlagRequest{}
+	mi := &file_rgs_v1_config_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListConfigHistoryRequest) String() string {
+func (x *EvaluateFlagRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListConfigHistoryRequest) ProtoMessage() {}
+func (*EvaluateFlagRequest) ProtoMessage() {}
 
-func (x *ListConfigHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_config_proto_msgTypes[8]
+func (x *EvaluateFlagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_config_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -783,63 +2332,66 @@ func (x *ListConfigHistoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListConfigHistoryRequest.ProtoReflect.Descriptor instead.
-func (*ListConfigHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_config_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use EvaluateFlagRequest.ProtoReflect.Descriptor instead.
+func (*EvaluateFlagRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_config_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *ListConfigHistoryRequest) GetMeta() *RequestMeta {
+func (x *EvaluateFlagRequest) GetMeta() *RequestMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *ListConfigHistoryRequest) GetConfigNamespaceFilter() string {
+func (x *EvaluateFlagRequest) GetFlagKey() string {
 	if x != nil {
-		return x.ConfigNamespaceFilter
+		return x.FlagKey
 	}
 	return ""
 }
 
-func (x *ListConfigHistoryRequest) GetPageSize() int32 {
+func (x *EvaluateFlagRequest) GetEquipmentId() string {
 	if x != nil {
-		return x.PageSize
+		return x.EquipmentId
 	}
-	return 0
+	return ""
 }
 
-func (x *ListConfigHistoryRequest) GetPageToken() string {
+func (x *EvaluateFlagRequest) GetOperatorId() string {
 	if x != nil {
-		return x.PageToken
+		return x.OperatorId
 	}
 	return ""
 }
 
-type ListConfigHistoryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Changes       []*ConfigChange        `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+type EvaluateFlagResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Meta    *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Enabled bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// One of not_defined, disabled, equipment_target, operator_target,
+	// rollout or not_in_rollout.
+	Reason        string       `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Flag          *FeatureFlag `protobuf:"bytes,4,opt,name=flag,proto3" json:"flag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListConfigHistoryResponse) Reset() {
-	*x = ListConfigHistoryResponse{}
-	mi := &file_rgs_v1_config_proto_msgTypes[9]
+func (x *EvaluateFlagResponse) Reset() {
+	*x = EvaluateFlagResponse{}
+	mi := &file_rgs_v1_config_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListConfigHistoryResponse) String() string {
+func (x *EvaluateFlagResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListConfigHistoryResponse) ProtoMessage() {}
+func (*EvaluateFlagResponse) ProtoMessage() {}
 
-func (x *ListConfigHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_config_proto_msgTypes[9]
+func (x *EvaluateFlagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_config_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -850,32 +2402,39 @@ func (x *ListConfigHistoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListConfigHistoryResponse.ProtoReflect.Descriptor instead.
-func (*ListConfigHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_config_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use EvaluateFlagResponse.ProtoReflect.Descriptor instead.
+func (*EvaluateFlagResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_config_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *ListConfigHistoryResponse) GetMeta() *ResponseMeta {
+func (x *EvaluateFlagResponse) GetMeta() *ResponseMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *ListConfigHistoryResponse) GetChanges() []*ConfigChange {
+func (x *EvaluateFlagResponse) GetEnabled() bool {
 	if x != nil {
-		return x.Changes
+		return x.Enabled
 	}
-	return nil
+	return false
 }
 
-func (x *ListConfigHistoryResponse) GetNextPageToken() string {
+func (x *EvaluateFlagResponse) GetReason() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.Reason
 	}
 	return ""
 }
 
+func (x *EvaluateFlagResponse) GetFlag() *FeatureFlag {
+	if x != nil {
+		return x.Flag
+	}
+	return nil
+}
+
 type RecordDownloadLibraryChangeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
@@ -886,7 +2445,7 @@ type RecordDownloadLibraryChangeRequest struct {
 
 func (x *RecordDownloadLibraryChangeRequest) Reset() {
 	*x = RecordDownloadLibraryChangeRequest{}
-	mi := &file_rgs_v1_config_proto_msgTypes[10]
+	mi := &file_rgs_v1_config_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -898,7 +2457,7 @@ func (x *RecordDownloadLibraryChangeRequest) String() string {
 func (*RecordDownloadLibraryChangeRequest) ProtoMessage() {}
 
 func (x *RecordDownloadLibraryChangeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_config_proto_msgTypes[10]
+	mi := &file_rgs_v1_config_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -911,7 +2470,7 @@ func (x *RecordDownloadLibraryChangeRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use RecordDownloadLibraryChangeRequest.ProtoReflect.Descriptor instead.
 func (*RecordDownloadLibraryChangeRequest) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_config_proto_rawDescGZIP(), []int{10}
+	return file_rgs_v1_config_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *RecordDownloadLibraryChangeRequest) GetMeta() *RequestMeta {
@@ -938,7 +2497,7 @@ type RecordDownloadLibraryChangeResponse struct {
 
 func (x *RecordDownloadLibraryChangeResponse) Reset() {
 	*x = RecordDownloadLibraryChangeResponse{}
-	mi := &file_rgs_v1_config_proto_msgTypes[11]
+	mi := &file_rgs_v1_config_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -950,7 +2509,7 @@ func (x *RecordDownloadLibraryChangeResponse) String() string {
 func (*RecordDownloadLibraryChangeResponse) ProtoMessage() {}
 
 func (x *RecordDownloadLibraryChangeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_config_proto_msgTypes[11]
+	mi := &file_rgs_v1_config_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -963,7 +2522,7 @@ func (x *RecordDownloadLibraryChangeResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use RecordDownloadLibraryChangeResponse.ProtoReflect.Descriptor instead.
 func (*RecordDownloadLibraryChangeResponse) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_config_proto_rawDescGZIP(), []int{11}
+	return file_rgs_v1_config_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *RecordDownloadLibraryChangeResponse) GetMeta() *ResponseMeta {
@@ -991,7 +2550,7 @@ type ListDownloadLibraryChangesRequest struct {
 
 func (x *ListDownloadLibraryChangesRequest) Reset() {
 	*x = ListDownloadLibraryChangesRequest{}
-	mi := &file_rgs_v1_config_proto_msgTypes[12]
+	mi := &file_rgs_v1_config_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1003,7 +2562,7 @@ func (x *ListDownloadLibraryChangesRequest) String() string {
 func (*ListDownloadLibraryChangesRequest) ProtoMessage() {}
 
 func (x *ListDownloadLibraryChangesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_config_proto_msgTypes[12]
+	mi := &file_rgs_v1_config_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1016,7 +2575,7 @@ func (x *ListDownloadLibraryChangesRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ListDownloadLibraryChangesRequest.ProtoReflect.Descriptor instead.
 func (*ListDownloadLibraryChangesRequest) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_config_proto_rawDescGZIP(), []int{12}
+	return file_rgs_v1_config_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ListDownloadLibraryChangesRequest) GetMeta() *RequestMeta {
@@ -1051,7 +2610,7 @@ type ListDownloadLibraryChangesResponse struct {
 
 func (x *ListDownloadLibraryChangesResponse) Reset() {
 	*x = ListDownloadLibraryChangesResponse{}
-	mi := &file_rgs_v1_config_proto_msgTypes[13]
+	mi := &file_rgs_v1_config_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1063,7 +2622,7 @@ func (x *ListDownloadLibraryChangesResponse) String() string {
 func (*ListDownloadLibraryChangesResponse) ProtoMessage() {}
 
 func (x *ListDownloadLibraryChangesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_config_proto_msgTypes[13]
+	mi := &file_rgs_v1_config_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1076,7 +2635,7 @@ func (x *ListDownloadLibraryChangesResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ListDownloadLibraryChangesResponse.ProtoReflect.Descriptor instead.
 func (*ListDownloadLibraryChangesResponse) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_config_proto_rawDescGZIP(), []int{13}
+	return file_rgs_v1_config_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *ListDownloadLibraryChangesResponse) GetMeta() *ResponseMeta {
@@ -1104,7 +2663,7 @@ var File_rgs_v1_config_proto protoreflect.FileDescriptor
 
 const file_rgs_v1_config_proto_rawDesc = "" +
 	"\n" +
-	"\x13rgs/v1/config.proto\x12\x06rgs.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x13rgs/v1/common.proto\"\xcf\x03\n" +
+	"\x13rgs/v1/config.proto\x12\x06rgs.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x13rgs/v1/common.proto\"\xbe\x05\n" +
 	"\fConfigChange\x12\x1b\n" +
 	"\tchange_id\x18\x01 \x01(\tR\bchangeId\x12)\n" +
 	"\x10config_namespace\x18\x02 \x01(\tR\x0fconfigNamespace\x12\x1d\n" +
@@ -1126,7 +2685,37 @@ const file_rgs_v1_config_proto_rawDesc = "" +
 	"\vapproved_at\x18\f \x01(\tR\n" +
 	"approvedAt\x12\x1d\n" +
 	"\n" +
-	"applied_at\x18\r \x01(\tR\tappliedAt\"\xf4\x02\n" +
+	"applied_at\x18\r \x01(\tR\tappliedAt\x121\n" +
+	"\x15rollback_of_change_id\x18\x0e \x01(\tR\x12rollbackOfChangeId\x12:\n" +
+	"\tapprovals\x18\x0f \x03(\v2\x1c.rgs.v1.ConfigChangeApprovalR\tapprovals\x12-\n" +
+	"\x12required_approvals\x18\x10 \x01(\x05R\x11requiredApprovals\x12,\n" +
+	"\x12scheduled_apply_at\x18\x11 \x01(\tR\x10scheduledApplyAt\x12!\n" +
+	"\fscheduled_by\x18\x12 \x01(\tR\vscheduledBy\"p\n" +
+	"\x14ConfigChangeApproval\x12\x1f\n" +
+	"\vapprover_id\x18\x01 \x01(\tR\n" +
+	"approverId\x12\x1f\n" +
+	"\vapproved_at\x18\x02 \x01(\tR\n" +
+	"approvedAt\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xc9\x02\n" +
+	"\fConfigSchema\x12)\n" +
+	"\x10config_namespace\x18\x01 \x01(\tR\x0fconfigNamespace\x12\x1d\n" +
+	"\n" +
+	"config_key\x18\x02 \x01(\tR\tconfigKey\x126\n" +
+	"\n" +
+	"value_type\x18\x03 \x01(\x0e2\x17.rgs.v1.ConfigValueTypeR\tvalueType\x12\x1b\n" +
+	"\tmin_value\x18\x04 \x01(\tR\bminValue\x12\x1b\n" +
+	"\tmax_value\x18\x05 \x01(\tR\bmaxValue\x12\x18\n" +
+	"\apattern\x18\x06 \x01(\tR\apattern\x12%\n" +
+	"\x0eallowed_values\x18\a \x03(\tR\rallowedValues\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\b \x01(\tR\tupdatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\tR\tupdatedAt\"\x98\x01\n" +
+	"\vFeatureFlag\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12'\n" +
+	"\x0frollout_percent\x18\x02 \x01(\x05R\x0erolloutPercent\x12#\n" +
+	"\requipment_ids\x18\x03 \x03(\tR\fequipmentIds\x12!\n" +
+	"\foperator_ids\x18\x04 \x03(\tR\voperatorIds\"\xd8\x03\n" +
 	"\x14DownloadLibraryEntry\x12\x19\n" +
 	"\bentry_id\x18\x01 \x01(\tR\aentryId\x12!\n" +
 	"\flibrary_path\x18\x02 \x01(\tR\vlibraryPath\x12\x1a\n" +
@@ -1142,7 +2731,9 @@ const file_rgs_v1_config_proto_rawDesc = "" +
 	"signer_kid\x18\t \x01(\tR\tsignerKid\x12\x1c\n" +
 	"\tsignature\x18\n" +
 	" \x01(\tR\tsignature\x12#\n" +
-	"\rsignature_alg\x18\v \x01(\tR\fsignatureAlg\"\xce\x01\n" +
+	"\rsignature_alg\x18\v \x01(\tR\fsignatureAlg\x12/\n" +
+	"\x13verification_result\x18\f \x01(\tR\x12verificationResult\x121\n" +
+	"\x14verification_failure\x18\r \x01(\tR\x13verificationFailure\"\xce\x01\n" +
 	"\x1aProposeConfigChangeRequest\x12'\n" +
 	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12)\n" +
 	"\x10config_namespace\x18\x02 \x01(\tR\x0fconfigNamespace\x12\x1d\n" +
@@ -1152,11 +2743,31 @@ const file_rgs_v1_config_proto_rawDesc = "" +
 	"\x06reason\x18\x05 \x01(\tR\x06reason\"u\n" +
 	"\x1bProposeConfigChangeResponse\x12(\n" +
 	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12,\n" +
-	"\x06change\x18\x02 \x01(\v2\x14.rgs.v1.ConfigChangeR\x06change\"z\n" +
+	"\x06change\x18\x02 \x01(\v2\x14.rgs.v1.ConfigChangeR\x06change\"\xb6\x01\n" +
+	"\x1aPreviewConfigChangeRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12)\n" +
+	"\x10config_namespace\x18\x02 \x01(\tR\x0fconfigNamespace\x12\x1d\n" +
+	"\n" +
+	"config_key\x18\x03 \x01(\tR\tconfigKey\x12%\n" +
+	"\x0eproposed_value\x18\x04 \x01(\tR\rproposedValue\"o\n" +
+	"\x15ConfigOverridePreview\x12!\n" +
+	"\fequipment_id\x18\x01 \x01(\tR\vequipmentId\x12\x1d\n" +
+	"\n" +
+	"config_key\x18\x02 \x01(\tR\tconfigKey\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\"\x98\x02\n" +
+	"\x1bPreviewConfigChangeResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12!\n" +
+	"\fbefore_value\x18\x02 \x01(\tR\vbeforeValue\x12!\n" +
+	"\fbefore_found\x18\x03 \x01(\bR\vbeforeFound\x12\x1f\n" +
+	"\vafter_value\x18\x04 \x01(\tR\n" +
+	"afterValue\x12\x18\n" +
+	"\achanged\x18\x05 \x01(\bR\achanged\x12N\n" +
+	"\x13equipment_overrides\x18\x06 \x03(\v2\x1d.rgs.v1.ConfigOverridePreviewR\x12equipmentOverrides\"\x95\x01\n" +
 	"\x1aApproveConfigChangeRequest\x12'\n" +
 	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x1b\n" +
 	"\tchange_id\x18\x02 \x01(\tR\bchangeId\x12\x16\n" +
-	"\x06reason\x18\x03 \x01(\tR\x06reason\"u\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x19\n" +
+	"\bapply_at\x18\x04 \x01(\tR\aapplyAt\"u\n" +
 	"\x1bApproveConfigChangeResponse\x12(\n" +
 	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12,\n" +
 	"\x06change\x18\x02 \x01(\v2\x14.rgs.v1.ConfigChangeR\x06change\"x\n" +
@@ -1166,6 +2777,20 @@ const file_rgs_v1_config_proto_rawDesc = "" +
 	"\x06reason\x18\x03 \x01(\tR\x06reason\"s\n" +
 	"\x19ApplyConfigChangeResponse\x12(\n" +
 	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12,\n" +
+	"\x06change\x18\x02 \x01(\v2\x14.rgs.v1.ConfigChangeR\x06change\"{\n" +
+	"\x1bRollbackConfigChangeRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x1b\n" +
+	"\tchange_id\x18\x02 \x01(\tR\bchangeId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"v\n" +
+	"\x1cRollbackConfigChangeResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12,\n" +
+	"\x06change\x18\x02 \x01(\v2\x14.rgs.v1.ConfigChangeR\x06change\"|\n" +
+	"\x1cCancelScheduledChangeRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x1b\n" +
+	"\tchange_id\x18\x02 \x01(\tR\bchangeId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"w\n" +
+	"\x1dCancelScheduledChangeResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12,\n" +
 	"\x06change\x18\x02 \x01(\v2\x14.rgs.v1.ConfigChangeR\x06change\"\xb7\x01\n" +
 	"\x18ListConfigHistoryRequest\x12'\n" +
 	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x126\n" +
@@ -1176,7 +2801,57 @@ const file_rgs_v1_config_proto_rawDesc = "" +
 	"\x19ListConfigHistoryResponse\x12(\n" +
 	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12.\n" +
 	"\achanges\x18\x02 \x03(\v2\x14.rgs.v1.ConfigChangeR\achanges\x12&\n" +
-	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\x81\x01\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\xa3\x01\n" +
+	"\x19GetConfigValueAsOfRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12)\n" +
+	"\x10config_namespace\x18\x02 \x01(\tR\x0fconfigNamespace\x12\x1d\n" +
+	"\n" +
+	"config_key\x18\x03 \x01(\tR\tconfigKey\x12\x13\n" +
+	"\x05as_of\x18\x04 \x01(\tR\x04asOf\"\xa0\x01\n" +
+	"\x1aGetConfigValueAsOfResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x14\n" +
+	"\x05found\x18\x03 \x01(\bR\x05found\x12,\n" +
+	"\x06change\x18\x04 \x01(\v2\x14.rgs.v1.ConfigChangeR\x06change\"\x87\x01\n" +
+	"\x16SetConfigSchemaRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12,\n" +
+	"\x06schema\x18\x02 \x01(\v2\x14.rgs.v1.ConfigSchemaR\x06schema\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"q\n" +
+	"\x17SetConfigSchemaResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12,\n" +
+	"\x06schema\x18\x02 \x01(\v2\x14.rgs.v1.ConfigSchemaR\x06schema\"{\n" +
+	"\x18ListConfigSchemasRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x126\n" +
+	"\x17config_namespace_filter\x18\x02 \x01(\tR\x15configNamespaceFilter\"u\n" +
+	"\x19ListConfigSchemasResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12.\n" +
+	"\aschemas\x18\x02 \x03(\v2\x14.rgs.v1.ConfigSchemaR\aschemas\"h\n" +
+	"\x12WatchConfigRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12)\n" +
+	"\x10config_namespace\x18\x02 \x01(\tR\x0fconfigNamespace\"m\n" +
+	"\x13WatchConfigResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12,\n" +
+	"\x06change\x18\x02 \x01(\v2\x14.rgs.v1.ConfigChangeR\x06change\"\xa7\x01\n" +
+	"\x18PollConfigChangesRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12)\n" +
+	"\x10config_namespace\x18\x02 \x01(\tR\x0fconfigNamespace\x12\x14\n" +
+	"\x05since\x18\x03 \x01(\tR\x05since\x12!\n" +
+	"\fwait_seconds\x18\x04 \x01(\x05R\vwaitSeconds\"\x93\x01\n" +
+	"\x19PollConfigChangesResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12.\n" +
+	"\achanges\x18\x02 \x03(\v2\x14.rgs.v1.ConfigChangeR\achanges\x12\x1c\n" +
+	"\twatermark\x18\x03 \x01(\tR\twatermark\"\x9d\x01\n" +
+	"\x13EvaluateFlagRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x19\n" +
+	"\bflag_key\x18\x02 \x01(\tR\aflagKey\x12!\n" +
+	"\fequipment_id\x18\x03 \x01(\tR\vequipmentId\x12\x1f\n" +
+	"\voperator_id\x18\x04 \x01(\tR\n" +
+	"operatorId\"\x9b\x01\n" +
+	"\x14EvaluateFlagResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12'\n" +
+	"\x04flag\x18\x04 \x01(\v2\x13.rgs.v1.FeatureFlagR\x04flag\"\x81\x01\n" +
 	"\"RecordDownloadLibraryChangeRequest\x12'\n" +
 	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x122\n" +
 	"\x05entry\x18\x02 \x01(\v2\x1c.rgs.v1.DownloadLibraryEntryR\x05entry\"\x83\x01\n" +
@@ -1197,18 +2872,33 @@ const file_rgs_v1_config_proto_rawDesc = "" +
 	"\x1dCONFIG_CHANGE_STATUS_PROPOSED\x10\x01\x12!\n" +
 	"\x1dCONFIG_CHANGE_STATUS_APPROVED\x10\x02\x12 \n" +
 	"\x1cCONFIG_CHANGE_STATUS_APPLIED\x10\x03\x12!\n" +
-	"\x1dCONFIG_CHANGE_STATUS_REJECTED\x10\x04*\xa0\x01\n" +
+	"\x1dCONFIG_CHANGE_STATUS_REJECTED\x10\x04*\xaf\x01\n" +
+	"\x0fConfigValueType\x12!\n" +
+	"\x1dCONFIG_VALUE_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18CONFIG_VALUE_TYPE_STRING\x10\x01\x12\x1d\n" +
+	"\x19CONFIG_VALUE_TYPE_INTEGER\x10\x02\x12\x1d\n" +
+	"\x19CONFIG_VALUE_TYPE_DECIMAL\x10\x03\x12\x1d\n" +
+	"\x19CONFIG_VALUE_TYPE_BOOLEAN\x10\x04*\xa0\x01\n" +
 	"\x0eDownloadAction\x12\x1f\n" +
 	"\x1bDOWNLOAD_ACTION_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13DOWNLOAD_ACTION_ADD\x10\x01\x12\x1a\n" +
 	"\x16DOWNLOAD_ACTION_UPDATE\x10\x02\x12\x1a\n" +
 	"\x16DOWNLOAD_ACTION_DELETE\x10\x03\x12\x1c\n" +
-	"\x18DOWNLOAD_ACTION_ACTIVATE\x10\x042\xf0\x06\n" +
+	"\x18DOWNLOAD_ACTION_ACTIVATE\x10\x042\xce\x0f\n" +
 	"\rConfigService\x12\x85\x01\n" +
-	"\x13ProposeConfigChange\x12\".rgs.v1.ProposeConfigChangeRequest\x1a#.rgs.v1.ProposeConfigChangeResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/config/changes:propose\x12\x91\x01\n" +
+	"\x13ProposeConfigChange\x12\".rgs.v1.ProposeConfigChangeRequest\x1a#.rgs.v1.ProposeConfigChangeResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/config/changes:propose\x12\x85\x01\n" +
+	"\x13PreviewConfigChange\x12\".rgs.v1.PreviewConfigChangeRequest\x1a#.rgs.v1.PreviewConfigChangeResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/config/changes:preview\x12\x91\x01\n" +
 	"\x13ApproveConfigChange\x12\".rgs.v1.ApproveConfigChangeRequest\x1a#.rgs.v1.ApproveConfigChangeResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/v1/config/changes/{change_id}:approve\x12\x89\x01\n" +
-	"\x11ApplyConfigChange\x12 .rgs.v1.ApplyConfigChangeRequest\x1a!.rgs.v1.ApplyConfigChangeResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/config/changes/{change_id}:apply\x12t\n" +
-	"\x11ListConfigHistory\x12 .rgs.v1.ListConfigHistoryRequest\x1a!.rgs.v1.ListConfigHistoryResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/config/history\x12\xa5\x01\n" +
+	"\x11ApplyConfigChange\x12 .rgs.v1.ApplyConfigChangeRequest\x1a!.rgs.v1.ApplyConfigChangeResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/config/changes/{change_id}:apply\x12\x95\x01\n" +
+	"\x14RollbackConfigChange\x12#.rgs.v1.RollbackConfigChangeRequest\x1a$.rgs.v1.RollbackConfigChangeResponse\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/v1/config/changes/{change_id}:rollback\x12\x9e\x01\n" +
+	"\x15CancelScheduledChange\x12$.rgs.v1.CancelScheduledChangeRequest\x1a%.rgs.v1.CancelScheduledChangeResponse\"8\x82\xd3\xe4\x93\x022:\x01*\"-/v1/config/changes/{change_id}:cancelSchedule\x12t\n" +
+	"\x11ListConfigHistory\x12 .rgs.v1.ListConfigHistoryRequest\x1a!.rgs.v1.ListConfigHistoryResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/config/history\x12{\n" +
+	"\x12GetConfigValueAsOf\x12!.rgs.v1.GetConfigValueAsOfRequest\x1a\".rgs.v1.GetConfigValueAsOfResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/config/value-as-of\x12q\n" +
+	"\x0fSetConfigSchema\x12\x1e.rgs.v1.SetConfigSchemaRequest\x1a\x1f.rgs.v1.SetConfigSchemaResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/config/schemas\x12t\n" +
+	"\x11ListConfigSchemas\x12 .rgs.v1.ListConfigSchemasRequest\x1a!.rgs.v1.ListConfigSchemasResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/config/schemas\x12r\n" +
+	"\x11PollConfigChanges\x12 .rgs.v1.PollConfigChangesRequest\x1a!.rgs.v1.PollConfigChangesResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/config/watch\x12H\n" +
+	"\vWatchConfig\x12\x1a.rgs.v1.WatchConfigRequest\x1a\x1b.rgs.v1.WatchConfigResponse0\x01\x12w\n" +
+	"\fEvaluateFlag\x12\x1b.rgs.v1.EvaluateFlagRequest\x1a\x1c.rgs.v1.EvaluateFlagResponse\",\x82\xd3\xe4\x93\x02&\x12$/v1/config/flags/{flag_key}:evaluate\x12\xa5\x01\n" +
 	"\x1bRecordDownloadLibraryChange\x12*.rgs.v1.RecordDownloadLibraryChangeRequest\x1a+.rgs.v1.RecordDownloadLibraryChangeResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/config/download-library:record\x12\x98\x01\n" +
 	"\x1aListDownloadLibraryChanges\x12).rgs.v1.ListDownloadLibraryChangesRequest\x1a*.rgs.v1.ListDownloadLibraryChangesResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/config/download-libraryB\x8d\x01\n" +
 	"\n" +
@@ -1226,67 +2916,138 @@ func file_rgs_v1_config_proto_rawDescGZIP() []byte {
 	return file_rgs_v1_config_proto_rawDescData
 }
 
-var file_rgs_v1_config_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_rgs_v1_config_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_rgs_v1_config_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_rgs_v1_config_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
 var file_rgs_v1_config_proto_goTypes = []any{
 	(ConfigChangeStatus)(0),                     // 0: rgs.v1.ConfigChangeStatus
-	(DownloadAction)(0),                         // 1: rgs.v1.DownloadAction
-	(*ConfigChange)(nil),                        // 2: rgs.v1.ConfigChange
-	(*DownloadLibraryEntry)(nil),                // 3: rgs.v1.DownloadLibraryEntry
-	(*ProposeConfigChangeRequest)(nil),          // 4: rgs.v1.ProposeConfigChangeRequest
-	(*ProposeConfigChangeResponse)(nil),         // 5: rgs.v1.ProposeConfigChangeResponse
-	(*ApproveConfigChangeRequest)(nil),          // 6: rgs.v1.ApproveConfigChangeRequest
-	(*ApproveConfigChangeResponse)(nil),         // 7: rgs.v1.ApproveConfigChangeResponse
-	(*ApplyConfigChangeRequest)(nil),            // 8: rgs.v1.ApplyConfigChangeRequest
-	(*ApplyConfigChangeResponse)(nil),           // 9: rgs.v1.ApplyConfigChangeResponse
-	(*ListConfigHistoryRequest)(nil),            // 10: rgs.v1.ListConfigHistoryRequest
-	(*ListConfigHistoryResponse)(nil),           // 11: rgs.v1.ListConfigHistoryResponse
-	(*RecordDownloadLibraryChangeRequest)(nil),  // 12: rgs.v1.RecordDownloadLibraryChangeRequest
-	(*RecordDownloadLibraryChangeResponse)(nil), // 13: rgs.v1.RecordDownloadLibraryChangeResponse
-	(*ListDownloadLibraryChangesRequest)(nil),   // 14: rgs.v1.ListDownloadLibraryChangesRequest
-	(*ListDownloadLibraryChangesResponse)(nil),  // 15: rgs.v1.ListDownloadLibraryChangesResponse
-	(*RequestMeta)(nil),                         // 16: rgs.v1.RequestMeta
-	(*ResponseMeta)(nil),                        // 17: rgs.v1.ResponseMeta
+	(ConfigValueType)(0),                        // 1: rgs.v1.ConfigValueType
+	(DownloadAction)(0),                         // 2: rgs.v1.DownloadAction
+	(*ConfigChange)(nil),                        // 3: rgs.v1.ConfigChange
+	(*ConfigChangeApproval)(nil),                // 4: rgs.v1.ConfigChangeApproval
+	(*ConfigSchema)(nil),                        // 5: rgs.v1.ConfigSchema
+	(*FeatureFlag)(nil),                         // 6: rgs.v1.FeatureFlag
+	(*DownloadLibraryEntry)(nil),                // 7: rgs.v1.DownloadLibraryEntry
+	(*ProposeConfigChangeRequest)(nil),          // 8: rgs.v1.ProposeConfigChangeRequest
+	(*ProposeConfigChangeResponse)(nil),         // 9: rgs.v1.ProposeConfigChangeResponse
+	(*PreviewConfigChangeRequest)(nil),          // 10: rgs.v1.PreviewConfigChangeRequest
+	(*ConfigOverridePreview)(nil),               // 11: rgs.v1.ConfigOverridePreview
+	(*PreviewConfigChangeResponse)(nil),         // 12: rgs.v1.PreviewConfigChangeResponse
+	(*ApproveConfigChangeRequest)(nil),          // 13: rgs.v1.ApproveConfigChangeRequest
+	(*ApproveConfigChangeResponse)(nil),         // 14: rgs.v1.ApproveConfigChangeResponse
+	(*ApplyConfigChangeRequest)(nil),            // 15: rgs.v1.ApplyConfigChangeRequest
+	(*ApplyConfigChangeResponse)(nil),           // 16: rgs.v1.ApplyConfigChangeResponse
+	(*RollbackConfigChangeRequest)(nil),         // 17: rgs.v1.RollbackConfigChangeRequest
+	(*RollbackConfigChangeResponse)(nil),        // 18: rgs.v1.RollbackConfigChangeResponse
+	(*CancelScheduledChangeRequest)(nil),        // 19: rgs.v1.CancelScheduledChangeRequest
+	(*CancelScheduledChangeResponse)(nil),       // 20: rgs.v1.CancelScheduledChangeResponse
+	(*ListConfigHistoryRequest)(nil),            // 21: rgs.v1.ListConfigHistoryRequest
+	(*ListConfigHistoryResponse)(nil),           // 22: rgs.v1.ListConfigHistoryResponse
+	(*GetConfigValueAsOfRequest)(nil),           // 23: rgs.v1.GetConfigValueAsOfRequest
+	(*GetConfigValueAsOfResponse)(nil),          // 24: rgs.v1.GetConfigValueAsOfResponse
+	(*SetConfigSchemaRequest)(nil),              // 25: rgs.v1.SetConfigSchemaRequest
+	(*SetConfigSchemaResponse)(nil),             // 26: rgs.v1.SetConfigSchemaResponse
+	(*ListConfigSchemasRequest)(nil),            // 27: rgs.v1.ListConfigSchemasRequest
+	(*ListConfigSchemasResponse)(nil),           // 28: rgs.v1.ListConfigSchemasResponse
+	(*WatchConfigRequest)(nil),                  // 29: rgs.v1.WatchConfigRequest
+	(*WatchConfigResponse)(nil),                 // 30: rgs.v1.WatchConfigResponse
+	(*PollConfigChangesRequest)(nil),            // 31: rgs.v1.PollConfigChangesRequest
+	(*PollConfigChangesResponse)(nil),           // 32: rgs.v1.PollConfigChangesResponse
+	(*EvaluateFlagRequest)(nil),                 // 33: rgs.v1.EvaluateFlagRequest
+	(*EvaluateFlagResponse)(nil),                // 34: rgs.v1.EvaluateFlagResponse
+	(*RecordDownloadLibraryChangeRequest)(nil),  // 35: rgs.v1.RecordDownloadLibraryChangeRequest
+	(*RecordDownloadLibraryChangeResponse)(nil), // 36: rgs.v1.RecordDownloadLibraryChangeResponse
+	(*ListDownloadLibraryChangesRequest)(nil),   // 37: rgs.v1.ListDownloadLibraryChangesRequest
+	(*ListDownloadLibraryChangesResponse)(nil),  // 38: rgs.v1.ListDownloadLibraryChangesResponse
+	(*RequestMeta)(nil),                         // 39: rgs.v1.RequestMeta
+	(*ResponseMeta)(nil),                        // 40: rgs.v1.ResponseMeta
 }
 var file_rgs_v1_config_proto_depIdxs = []int32{
 	0,  // 0: rgs.v1.ConfigChange.status:type_name -> rgs.v1.ConfigChangeStatus
-	1,  // 1: rgs.v1.DownloadLibraryEntry.action:type_name -> rgs.v1.DownloadAction
-	16, // 2: rgs.v1.ProposeConfigChangeRequest.meta:type_name -> rgs.v1.RequestMeta
-	17, // 3: rgs.v1.ProposeConfigChangeResponse.meta:type_name -> rgs.v1.ResponseMeta
-	2,  // 4: rgs.v1.ProposeConfigChangeResponse.change:type_name -> rgs.v1.ConfigChange
-	16, // 5: rgs.v1.ApproveConfigChangeRequest.meta:type_name -> rgs.v1.RequestMeta
-	17, // 6: rgs.v1.ApproveConfigChangeResponse.meta:type_name -> rgs.v1.ResponseMeta
-	2,  // 7: rgs.v1.ApproveConfigChangeResponse.change:type_name -> rgs.v1.ConfigChange
-	16, // 8: rgs.v1.ApplyConfigChangeRequest.meta:type_name -> rgs.v1.RequestMeta
-	17, // 9: rgs.v1.ApplyConfigChangeResponse.meta:type_name -> rgs.v1.ResponseMeta
-	2,  // 10: rgs.v1.ApplyConfigChangeResponse.change:type_name -> rgs.v1.ConfigChange
-	16, // 11: rgs.v1.ListConfigHistoryRequest.meta:type_name -> rgs.v1.RequestMeta
-	17, // 12: rgs.v1.ListConfigHistoryResponse.meta:type_name -> rgs.v1.ResponseMeta
-	2,  // 13: rgs.v1.ListConfigHistoryResponse.changes:type_name -> rgs.v1.ConfigChange
-	16, // 14: rgs.v1.RecordDownloadLibraryChangeRequest.meta:type_name -> rgs.v1.RequestMeta
-	3,  // 15: rgs.v1.RecordDownloadLibraryChangeRequest.entry:type_name -> rgs.v1.DownloadLibraryEntry
-	17, // 16: rgs.v1.RecordDownloadLibraryChangeResponse.meta:type_name -> rgs.v1.ResponseMeta
-	3,  // 17: rgs.v1.RecordDownloadLibraryChangeResponse.entry:type_name -> rgs.v1.DownloadLibraryEntry
-	16, // 18: rgs.v1.ListDownloadLibraryChangesRequest.meta:type_name -> rgs.v1.RequestMeta
-	17, // 19: rgs.v1.ListDownloadLibraryChangesResponse.meta:type_name -> rgs.v1.ResponseMeta
-	3,  // 20: rgs.v1.ListDownloadLibraryChangesResponse.entries:type_name -> rgs.v1.DownloadLibraryEntry
-	4,  // 21: rgs.v1.ConfigService.ProposeConfigChange:input_type -> rgs.v1.ProposeConfigChangeRequest
-	6,  // 22: rgs.v1.ConfigService.ApproveConfigChange:input_type -> rgs.v1.ApproveConfigChangeRequest
-	8,  // 23: rgs.v1.ConfigService.ApplyConfigChange:input_type -> rgs.v1.ApplyConfigChangeRequest
-	10, // 24: rgs.v1.ConfigService.ListConfigHistory:input_type -> rgs.v1.ListConfigHistoryRequest
-	12, // 25: rgs.v1.ConfigService.RecordDownloadLibraryChange:input_type -> rgs.v1.RecordDownloadLibraryChangeRequest
-	14, // 26: rgs.v1.ConfigService.ListDownloadLibraryChanges:input_type -> rgs.v1.ListDownloadLibraryChangesRequest
-	5,  // 27: rgs.v1.ConfigService.ProposeConfigChange:output_type -> rgs.v1.ProposeConfigChangeResponse
-	7,  // 28: rgs.v1.ConfigService.ApproveConfigChange:output_type -> rgs.v1.ApproveConfigChangeResponse
-	9,  // 29: rgs.v1.ConfigService.ApplyConfigChange:output_type -> rgs.v1.ApplyConfigChangeResponse
-	11, // 30: rgs.v1.ConfigService.ListConfigHistory:output_type -> rgs.v1.ListConfigHistoryResponse
-	13, // 31: rgs.v1.ConfigService.RecordDownloadLibraryChange:output_type -> rgs.v1.RecordDownloadLibraryChangeResponse
-	15, // 32: rgs.v1.ConfigService.ListDownloadLibraryChanges:output_type -> rgs.v1.ListDownloadLibraryChangesResponse
-	27, // [27:33] is the sub-list for method output_type
-	21, // [21:27] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	4,  // 1: rgs.v1.ConfigChange.approvals:type_name -> rgs.v1.ConfigChangeApproval
+	1,  // 2: rgs.v1.ConfigSchema.value_type:type_name -> rgs.v1.ConfigValueType
+	2,  // 3: rgs.v1.DownloadLibraryEntry.action:type_name -> rgs.v1.DownloadAction
+	39, // 4: rgs.v1.ProposeConfigChangeRequest.meta:type_name -> rgs.v1.RequestMeta
+	40, // 5: rgs.v1.ProposeConfigChangeResponse.meta:type_name -> rgs.v1.ResponseMeta
+	3,  // 6: rgs.v1.ProposeConfigChangeResponse.change:type_name -> rgs.v1.ConfigChange
+	39, // 7: rgs.v1.PreviewConfigChangeRequest.meta:type_name -> rgs.v1.RequestMeta
+	40, // 8: rgs.v1.PreviewConfigChangeResponse.meta:type_name -> rgs.v1.ResponseMeta
+	11, // 9: rgs.v1.PreviewConfigChangeResponse.equipment_overrides:type_name -> rgs.v1.ConfigOverridePreview
+	39, // 10: rgs.v1.ApproveConfigChangeRequest.meta:type_name -> rgs.v1.RequestMeta
+	40, // 11: rgs.v1.ApproveConfigChangeResponse.meta:type_name -> rgs.v1.ResponseMeta
+	3,  // 12: rgs.v1.ApproveConfigChangeResponse.change:type_name -> rgs.v1.ConfigChange
+	39, // 13: rgs.v1.ApplyConfigChangeRequest.meta:type_name -> rgs.v1.RequestMeta
+	40, // 14: rgs.v1.ApplyConfigChangeResponse.meta:type_name -> rgs.v1.ResponseMeta
+	3,  // 15: rgs.v1.ApplyConfigChangeResponse.change:type_name -> rgs.v1.ConfigChange
+	39, // 16: rgs.v1.RollbackConfigChangeRequest.meta:type_name -> rgs.v1.RequestMeta
+	40, // 17: rgs.v1.RollbackConfigChangeResponse.meta:type_name -> rgs.v1.ResponseMeta
+	3,  // 18: rgs.v1.RollbackConfigChangeResponse.change:type_name -> rgs.v1.ConfigChange
+	39, // 19: rgs.v1.CancelScheduledChangeRequest.meta:type_name -> rgs.v1.RequestMeta
+	40, // 20: rgs.v1.CancelScheduledChangeResponse.meta:type_name -> rgs.v1.ResponseMeta
+	3,  // 21: rgs.v1.CancelScheduledChangeResponse.change:type_name -> rgs.v1.ConfigChange
+	39, // 22: rgs.v1.ListConfigHistoryRequest.meta:type_name -> rgs.v1.RequestMeta
+	40, // 23: rgs.v1.ListConfigHistoryResponse.meta:type_name -> rgs.v1.ResponseMeta
+	3,  // 24: rgs.v1.ListConfigHistoryResponse.changes:type_name -> rgs.v1.ConfigChange
+	39, // 25: rgs.v1.GetConfigValueAsOfRequest.meta:type_name -> rgs.v1.RequestMeta
+	40, // 26: rgs.v1.GetConfigValueAsOfResponse.meta:type_name -> rgs.v1.ResponseMeta
+	3,  // 27: rgs.v1.GetConfigValueAsOfResponse.change:type_name -> rgs.v1.ConfigChange
+	39, // 28: rgs.v1.SetConfigSchemaRequest.meta:type_name -> rgs.v1.RequestMeta
+	5,  // 29: rgs.v1.SetConfigSchemaRequest.schema:type_name -> rgs.v1.ConfigSchema
+	40, // 30: rgs.v1.SetConfigSchemaResponse.meta:type_name -> rgs.v1.ResponseMeta
+	5,  // 31: rgs.v1.SetConfigSchemaResponse.schema:type_name -> rgs.v1.ConfigSchema
+	39, // 32: rgs.v1.ListConfigSchemasRequest.meta:type_name -> rgs.v1.RequestMeta
+	40, // 33: rgs.v1.ListConfigSchemasResponse.meta:type_name -> rgs.v1.ResponseMeta
+	5,  // 34: rgs.v1.ListConfigSchemasResponse.schemas:type_name -> rgs.v1.ConfigSchema
+	39, // 35: rgs.v1.WatchConfigRequest.meta:type_name -> rgs.v1.RequestMeta
+	40, // 36: rgs.v1.WatchConfigResponse.meta:type_name -> rgs.v1.ResponseMeta
+	3,  // 37: rgs.v1.WatchConfigResponse.change:type_name -> rgs.v1.ConfigChange
+	39, // 38: rgs.v1.PollConfigChangesRequest.meta:type_name -> rgs.v1.RequestMeta
+	40, // 39: rgs.v1.PollConfigChangesResponse.meta:type_name -> rgs.v1.ResponseMeta
+	3,  // 40: rgs.v1.PollConfigChangesResponse.changes:type_name -> rgs.v1.ConfigChange
+	39, // 41: rgs.v1.EvaluateFlagRequest.meta:type_name -> rgs.v1.RequestMeta
+	40, // 42: rgs.v1.EvaluateFlagResponse.meta:type_name -> rgs.v1.ResponseMeta
+	6,  // 43: rgs.v1.EvaluateFlagResponse.flag:type_name -> rgs.v1.FeatureFlag
+	39, // 44: rgs.v1.RecordDownloadLibraryChangeRequest.meta:type_name -> rgs.v1.RequestMeta
+	7,  // 45: rgs.v1.RecordDownloadLibraryChangeRequest.entry:type_name -> rgs.v1.DownloadLibraryEntry
+	40, // 46: rgs.v1.RecordDownloadLibraryChangeResponse.meta:type_name -> rgs.v1.ResponseMeta
+	7,  // 47: rgs.v1.RecordDownloadLibraryChangeResponse.entry:type_name -> rgs.v1.DownloadLibraryEntry
+	39, // 48: rgs.v1.ListDownloadLibraryChangesRequest.meta:type_name -> rgs.v1.RequestMeta
+	40, // 49: rgs.v1.ListDownloadLibraryChangesResponse.meta:type_name -> rgs.v1.ResponseMeta
+	7,  // 50: rgs.v1.ListDownloadLibraryChangesResponse.entries:type_name -> rgs.v1.DownloadLibraryEntry
+	8,  // 51: rgs.v1.ConfigService.ProposeConfigChange:input_type -> rgs.v1.ProposeConfigChangeRequest
+	10, // 52: rgs.v1.ConfigService.PreviewConfigChange:input_type -> rgs.v1.PreviewConfigChangeRequest
+	13, // 53: rgs.v1.ConfigService.ApproveConfigChange:input_type -> rgs.v1.ApproveConfigChangeRequest
+	15, // 54: rgs.v1.ConfigService.ApplyConfigChange:input_type -> rgs.v1.ApplyConfigChangeRequest
+	17, // 55: rgs.v1.ConfigService.RollbackConfigChange:input_type -> rgs.v1.RollbackConfigChangeRequest
+	19, // 56: rgs.v1.ConfigService.CancelScheduledChange:input_type -> rgs.v1.CancelScheduledChangeRequest
+	21, // 57: rgs.v1.ConfigService.ListConfigHistory:input_type -> rgs.v1.ListConfigHistoryRequest
+	23, // 58: rgs.v1.ConfigService.GetConfigValueAsOf:input_type -> rgs.v1.GetConfigValueAsOfRequest
+	25, // 59: rgs.v1.ConfigService.SetConfigSchema:input_type -> rgs.v1.SetConfigSchemaRequest
+	27, // 60: rgs.v1.ConfigService.ListConfigSchemas:input_type -> rgs.v1.ListConfigSchemasRequest
+	31, // 61: rgs.v1.ConfigService.PollConfigChanges:input_type -> rgs.v1.PollConfigChangesRequest
+	29, // 62: rgs.v1.ConfigService.WatchConfig:input_type -> rgs.v1.WatchConfigRequest
+	33, // 63: rgs.v1.ConfigService.EvaluateFlag:input_type -> rgs.v1.EvaluateFlagRequest
+	35, // 64: rgs.v1.ConfigService.RecordDownloadLibraryChange:input_type -> rgs.v1.RecordDownloadLibraryChangeRequest
+	37, // 65: rgs.v1.ConfigService.ListDownloadLibraryChanges:input_type -> rgs.v1.ListDownloadLibraryChangesRequest
+	9,  // 66: rgs.v1.ConfigService.ProposeConfigChange:output_type -> rgs.v1.ProposeConfigChangeResponse
+	12, // 67: rgs.v1.ConfigService.PreviewConfigChange:output_type -> rgs.v1.PreviewConfigChangeResponse
+	14, // 68: rgs.v1.ConfigService.ApproveConfigChange:output_type -> rgs.v1.ApproveConfigChangeResponse
+	16, // 69: rgs.v1.ConfigService.ApplyConfigChange:output_type -> rgs.v1.ApplyConfigChangeResponse
+	18, // 70: rgs.v1.ConfigService.RollbackConfigChange:output_type -> rgs.v1.RollbackConfigChangeResponse
+	20, // 71: rgs.v1.ConfigService.CancelScheduledChange:output_type -> rgs.v1.CancelScheduledChangeResponse
+	22, // 72: rgs.v1.ConfigService.ListConfigHistory:output_type -> rgs.v1.ListConfigHistoryResponse
+	24, // 73: rgs.v1.ConfigService.GetConfigValueAsOf:output_type -> rgs.v1.GetConfigValueAsOfResponse
+	26, // 74: rgs.v1.ConfigService.SetConfigSchema:output_type -> rgs.v1.SetConfigSchemaResponse
+	28, // 75: rgs.v1.ConfigService.ListConfigSchemas:output_type -> rgs.v1.ListConfigSchemasResponse
+	32, // 76: rgs.v1.ConfigService.PollConfigChanges:output_type -> rgs.v1.PollConfigChangesResponse
+	30, // 77: rgs.v1.ConfigService.WatchConfig:output_type -> rgs.v1.WatchConfigResponse
+	34, // 78: rgs.v1.ConfigService.EvaluateFlag:output_type -> rgs.v1.EvaluateFlagResponse
+	36, // 79: rgs.v1.ConfigService.RecordDownloadLibraryChange:output_type -> rgs.v1.RecordDownloadLibraryChangeResponse
+	38, // 80: rgs.v1.ConfigService.ListDownloadLibraryChanges:output_type -> rgs.v1.ListDownloadLibraryChangesResponse
+	66, // [66:81] is the sub-list for method output_type
+	51, // [51:66] is the sub-list for method input_type
+	51, // [51:51] is the sub-list for extension type_name
+	51, // [51:51] is the sub-list for extension extendee
+	0,  // [0:51] is the sub-list for field type_name
 }
 
 func init() { file_rgs_v1_config_proto_init() }
@@ -1300,8 +3061,8 @@ func file_rgs_v1_config_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rgs_v1_config_proto_rawDesc), len(file_rgs_v1_config_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   14,
+			NumEnums:      3,
+			NumMessages:   36,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,9 +20,18 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	ConfigService_ProposeConfigChange_FullMethodName         = "/rgs.v1.ConfigService/ProposeConfigChange"
+	ConfigService_PreviewConfigChange_FullMethodName         = "/rgs.v1.ConfigService/PreviewConfigChange"
 	ConfigService_ApproveConfigChange_FullMethodName         = "/rgs.v1.ConfigService/ApproveConfigChange"
 	ConfigService_ApplyConfigChange_FullMethodName           = "/rgs.v1.ConfigService/ApplyConfigChange"
+	ConfigService_RollbackConfigChange_FullMethodName        = "/rgs.v1.ConfigService/RollbackConfigChange"
+	ConfigService_CancelScheduledChange_FullMethodName       = "/rgs.v1.ConfigService/CancelScheduledChange"
 	ConfigService_ListConfigHistory_FullMethodName           = "/rgs.v1.ConfigService/ListConfigHistory"
+	ConfigService_GetConfigValueAsOf_FullMethodName          = "/rgs.v1.ConfigService/GetConfigValueAsOf"
+	ConfigService_SetConfigSchema_FullMethodName             = "/rgs.v1.ConfigService/SetConfigSchema"
+	ConfigService_ListConfigSchemas_FullMethodName           = "/rgs.v1.ConfigService/ListConfigSchemas"
+	ConfigService_PollConfigChanges_FullMethodName           = "/rgs.v1.ConfigService/PollConfigChanges"
+	ConfigService_WatchConfig_FullMethodName                 = "/rgs.v1.ConfigService/WatchConfig"
+	ConfigService_EvaluateFlag_FullMethodName                = "/rgs.v1.ConfigService/EvaluateFlag"
 	ConfigService_RecordDownloadLibraryChange_FullMethodName = "/rgs.v1.ConfigService/RecordDownloadLibraryChange"
 	ConfigService_ListDownloadLibraryChanges_FullMethodName  = "/rgs.v1.ConfigService/ListDownloadLibraryChanges"
 )
@@ -32,9 +41,23 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ConfigServiceClient interface {
 	ProposeConfigChange(ctx context.Context, in *ProposeConfigChangeRequest, opts ...grpc.CallOption) (*ProposeConfigChangeResponse, error)
+	// Validates a value and shows what proposing it would change, without
+	// recording a change.
+	PreviewConfigChange(ctx context.Context, in *PreviewConfigChangeRequest, opts ...grpc.CallOption) (*PreviewConfigChangeResponse, error)
 	ApproveConfigChange(ctx context.Context, in *ApproveConfigChangeRequest, opts ...grpc.CallOption) (*ApproveConfigChangeResponse, error)
 	ApplyConfigChange(ctx context.Context, in *ApplyConfigChangeRequest, opts ...grpc.CallOption) (*ApplyConfigChangeResponse, error)
+	RollbackConfigChange(ctx context.Context, in *RollbackConfigChangeRequest, opts ...grpc.CallOption) (*RollbackConfigChangeResponse, error)
+	CancelScheduledChange(ctx context.Context, in *CancelScheduledChangeRequest, opts ...grpc.CallOption) (*CancelScheduledChangeResponse, error)
 	ListConfigHistory(ctx context.Context, in *ListConfigHistoryRequest, opts ...grpc.CallOption) (*ListConfigHistoryResponse, error)
+	GetConfigValueAsOf(ctx context.Context, in *GetConfigValueAsOfRequest, opts ...grpc.CallOption) (*GetConfigValueAsOfResponse, error)
+	SetConfigSchema(ctx context.Context, in *SetConfigSchemaRequest, opts ...grpc.CallOption) (*SetConfigSchemaResponse, error)
+	ListConfigSchemas(ctx context.Context, in *ListConfigSchemasRequest, opts ...grpc.CallOption) (*ListConfigSchemasResponse, error)
+	// Waits up to wait_seconds for changes applied in a namespace after since;
+	// the long-poll counterpart of WatchConfig for REST clients.
+	PollConfigChanges(ctx context.Context, in *PollConfigChangesRequest, opts ...grpc.CallOption) (*PollConfigChangesResponse, error)
+	// gRPC only: pushes changes as they are applied in a namespace.
+	WatchConfig(ctx context.Context, in *WatchConfigRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchConfigResponse], error)
+	EvaluateFlag(ctx context.Context, in *EvaluateFlagRequest, opts ...grpc.CallOption) (*EvaluateFlagResponse, error)
 	RecordDownloadLibraryChange(ctx context.Context, in *RecordDownloadLibraryChangeRequest, opts ...grpc.CallOption) (*RecordDownloadLibraryChangeResponse, error)
 	ListDownloadLibraryChanges(ctx context.Context, in *ListDownloadLibraryChangesRequest, opts ...grpc.CallOption) (*ListDownloadLibraryChangesResponse, error)
 }
@@ -57,6 +80,16 @@ func (c *configServiceClient) ProposeConfigChange(ctx context.Context, in *Propo
 	return out, nil
 }
 
+func (c *configServiceClient) PreviewConfigChange(ctx context.Context, in *PreviewConfigChangeRequest, opts ...grpc.CallOption) (*PreviewConfigChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewConfigChangeResponse)
+	err := c.cc.Invoke(ctx, ConfigService_PreviewConfigChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *configServiceClient) ApproveConfigChange(ctx context.Context, in *ApproveConfigChangeRequest, opts ...grpc.CallOption) (*ApproveConfigChangeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ApproveConfigChangeResponse)
@@ -77,6 +110,26 @@ func (c *configServiceClient) ApplyConfigChange(ctx context.Context, in *ApplyCo
 	return out, nil
 }
 
+func (c *configServiceClient) RollbackConfigChange(ctx context.Context, in *RollbackConfigChangeRequest, opts ...grpc.CallOption) (*RollbackConfigChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RollbackConfigChangeResponse)
+	err := c.cc.Invoke(ctx, ConfigService_RollbackConfigChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) CancelScheduledChange(ctx context.Context, in *CancelScheduledChangeRequest, opts ...grpc.CallOption) (*CancelScheduledChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelScheduledChangeResponse)
+	err := c.cc.Invoke(ctx, ConfigService_CancelScheduledChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *configServiceClient) ListConfigHistory(ctx context.Context, in *ListConfigHistoryRequest, opts ...grpc.CallOption) (*ListConfigHistoryResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListConfigHistoryResponse)
@@ -87,6 +140,75 @@ func (c *configServiceClient) ListConfigHistory(ctx context.Context, in *ListCon
 	return out, nil
 }
 
+func (c *configServiceClient) GetConfigValueAsOf(ctx context.Context, in *GetConfigValueAsOfRequest, opts ...grpc.CallOption) (*GetConfigValueAsOfResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConfigValueAsOfResponse)
+	err := c.cc.Invoke(ctx, ConfigService_GetConfigValueAsOf_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) SetConfigSchema(ctx context.Context, in *SetConfigSchemaRequest, opts ...grpc.CallOption) (*SetConfigSchemaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetConfigSchemaResponse)
+	err := c.cc.Invoke(ctx, ConfigService_SetConfigSchema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) ListConfigSchemas(ctx context.Context, in *ListConfigSchemasRequest, opts ...grpc.CallOption) (*ListConfigSchemasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListConfigSchemasResponse)
+	err := c.cc.Invoke(ctx, ConfigService_ListConfigSchemas_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) PollConfigChanges(ctx context.Context, in *PollConfigChangesRequest, opts ...grpc.CallOption) (*PollConfigChangesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PollConfigChangesResponse)
+	err := c.cc.Invoke(ctx, ConfigService_PollConfigChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) WatchConfig(ctx context.Context, in *WatchConfigRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchConfigResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ConfigService_ServiceDesc.Streams[0], ConfigService_WatchConfig_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchConfigRequest, WatchConfigResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ConfigService_WatchConfigClient = grpc.ServerStreamingClient[WatchConfigResponse]
+
+func (c *configServiceClient) EvaluateFlag(ctx context.Context, in *EvaluateFlagRequest, opts ...grpc.CallOption) (*EvaluateFlagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EvaluateFlagResponse)
+	err := c.cc.Invoke(ctx, ConfigService_EvaluateFlag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *configServiceClient) RecordDownloadLibraryChange(ctx context.Context, in *RecordDownloadLibraryChangeRequest, opts ...grpc.CallOption) (*RecordDownloadLibraryChangeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RecordDownloadLibraryChangeResponse)
@@ -112,9 +234,23 @@ func (c *configServiceClient) ListDownloadLibraryChanges(ctx context.Context, in
 // for forward compatibility.
 type ConfigServiceServer interface {
 	ProposeConfigChange(context.Context, *ProposeConfigChangeRequest) (*ProposeConfigChangeResponse, error)
+	// Validates a value and shows what proposing it would change, without
+	// recording a change.
+	PreviewConfigChange(context.Context, *PreviewConfigChangeRequest) (*PreviewConfigChangeResponse, error)
 	ApproveConfigChange(context.Context, *ApproveConfigChangeRequest) (*ApproveConfigChangeResponse, error)
 	ApplyConfigChange(context.Context, *ApplyConfigChangeRequest) (*ApplyConfigChangeResponse, error)
+	RollbackConfigChange(context.Context, *RollbackConfigChangeRequest) (*RollbackConfigChangeResponse, error)
+	CancelScheduledChange(context.Context, *CancelScheduledChangeRequest) (*CancelScheduledChangeResponse, error)
 	ListConfigHistory(context.Context, *ListConfigHistoryRequest) (*ListConfigHistoryResponse, error)
+	GetConfigValueAsOf(context.Context, *GetConfigValueAsOfRequest) (*GetConfigValueAsOfResponse, error)
+	SetConfigSchema(context.Context, *SetConfigSchemaRequest) (*SetConfigSchemaResponse, error)
+	ListConfigSchemas(context.Context, *ListConfigSchemasRequest) (*ListConfigSchemasResponse, error)
+	// Waits up to wait_seconds for changes applied in a namespace after since;
+	// the long-poll counterpart of WatchConfig for REST clients.
+	PollConfigChanges(context.Context, *PollConfigChangesRequest) (*PollConfigChangesResponse, error)
+	// gRPC only: pushes changes as they are applied in a namespace.
+	WatchConfig(*WatchConfigRequest, grpc.ServerStreamingServer[WatchConfigResponse]) error
+	EvaluateFlag(context.Context, *EvaluateFlagRequest) (*EvaluateFlagResponse, error)
 	RecordDownloadLibraryChange(context.Context, *RecordDownloadLibraryChangeRequest) (*RecordDownloadLibraryChangeResponse, error)
 	ListDownloadLibraryChanges(context.Context, *ListDownloadLibraryChangesRequest) (*ListDownloadLibraryChangesResponse, error)
 	mustEmbedUnimplementedConfigServiceServer()
@@ -130,15 +266,42 @@ type UnimplementedConfigServiceServer struct{}
 func (UnimplementedConfigServiceServer) ProposeConfigChange(context.Context, *ProposeConfigChangeRequest) (*ProposeConfigChangeResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ProposeConfigChange not implemented")
 }
+func (UnimplementedConfigServiceServer) PreviewConfigChange(context.Context, *PreviewConfigChangeRequest) (*PreviewConfigChangeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PreviewConfigChange not implemented")
+}
 func (UnimplementedConfigServiceServer) ApproveConfigChange(context.Context, *ApproveConfigChangeRequest) (*ApproveConfigChangeResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ApproveConfigChange not implemented")
 }
 func (UnimplementedConfigServiceServer) ApplyConfigChange(context.Context, *ApplyConfigChangeRequest) (*ApplyConfigChangeResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ApplyConfigChange not implemented")
 }
+func (UnimplementedConfigServiceServer) RollbackConfigChange(context.Context, *RollbackConfigChangeRequest) (*RollbackConfigChangeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RollbackConfigChange not implemented")
+}
+func (UnimplementedConfigServiceServer) CancelScheduledChange(context.Context, *CancelScheduledChangeRequest) (*CancelScheduledChangeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelScheduledChange not implemented")
+}
 func (UnimplementedConfigServiceServer) ListConfigHistory(context.Context, *ListConfigHistoryRequest) (*ListConfigHistoryResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListConfigHistory not implemented")
 }
+func (UnimplementedConfigServiceServer) GetConfigValueAsOf(context.Context, *GetConfigValueAsOfRequest) (*GetConfigValueAsOfResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetConfigValueAsOf not implemented")
+}
+func (UnimplementedConfigServiceServer) SetConfigSchema(context.Context, *SetConfigSchemaRequest) (*SetConfigSchemaResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetConfigSchema not implemented")
+}
+func (UnimplementedConfigServiceServer) ListConfigSchemas(context.Context, *ListConfigSchemasRequest) (*ListConfigSchemasResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListConfigSchemas not implemented")
+}
+func (UnimplementedConfigServiceServer) PollConfigChanges(context.Context, *PollConfigChangesRequest) (*PollConfigChangesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PollConfigChanges not implemented")
+}
+func (UnimplementedConfigServiceServer) WatchConfig(*WatchConfigRequest, grpc.ServerStreamingServer[WatchConfigResponse]) error {
+	return status.Error(codes.Unimplemented, "method WatchConfig not implemented")
+}
+func (UnimplementedConfigServiceServer) EvaluateFlag(context.Context, *EvaluateFlagRequest) (*EvaluateFlagResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method EvaluateFlag not implemented")
+}
 func (UnimplementedConfigServiceServer) RecordDownloadLibraryChange(context.Context, *RecordDownloadLibraryChangeRequest) (*RecordDownloadLibraryChangeResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RecordDownloadLibraryChange not implemented")
 }
@@ -184,6 +347,24 @@ func _ConfigService_ProposeConfigChange_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ConfigService_PreviewConfigChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewConfigChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).PreviewConfigChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_PreviewConfigChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).PreviewConfigChange(ctx, req.(*PreviewConfigChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ConfigService_ApproveConfigChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ApproveConfigChangeRequest)
 	if err := dec(in); err != nil {
@@ -220,6 +401,42 @@ func _ConfigService_ApplyConfigChange_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ConfigService_RollbackConfigChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackConfigChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).RollbackConfigChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_RollbackConfigChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).RollbackConfigChange(ctx, req.(*RollbackConfigChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_CancelScheduledChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).CancelScheduledChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_CancelScheduledChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).CancelScheduledChange(ctx, req.(*CancelScheduledChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ConfigService_ListConfigHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListConfigHistoryRequest)
 	if err := dec(in); err != nil {
@@ -238,6 +455,107 @@ func _ConfigService_ListConfigHistory_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ConfigService_GetConfigValueAsOf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigValueAsOfRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).GetConfigValueAsOf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_GetConfigValueAsOf_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).GetConfigValueAsOf(ctx, req.(*GetConfigValueAsOfRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_SetConfigSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetConfigSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).SetConfigSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_SetConfigSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).SetConfigSchema(ctx, req.(*SetConfigSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_ListConfigSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConfigSchemasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).ListConfigSchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_ListConfigSchemas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).ListConfigSchemas(ctx, req.(*ListConfigSchemasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_PollConfigChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PollConfigChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).PollConfigChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_PollConfigChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).PollConfigChanges(ctx, req.(*PollConfigChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_WatchConfig_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchConfigRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ConfigServiceServer).WatchConfig(m, &grpc.GenericServerStream[WatchConfigRequest, WatchConfigResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ConfigService_WatchConfigServer = grpc.ServerStreamingServer[WatchConfigResponse]
+
+func _ConfigService_EvaluateFlag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateFlagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).EvaluateFlag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_EvaluateFlag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).EvaluateFlag(ctx, req.(*EvaluateFlagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ConfigService_RecordDownloadLibraryChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RecordDownloadLibraryChangeRequest)
 	if err := dec(in); err != nil {
@@ -285,6 +603,10 @@ var ConfigService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ProposeConfigChange",
 			Handler:    _ConfigService_ProposeConfigChange_Handler,
 		},
+		{
+			MethodName: "PreviewConfigChange",
+			Handler:    _ConfigService_PreviewConfigChange_Handler,
+		},
 		{
 			MethodName: "ApproveConfigChange",
 			Handler:    _ConfigService_ApproveConfigChange_Handler,
@@ -293,10 +615,38 @@ var ConfigService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ApplyConfigChange",
 			Handler:    _ConfigService_ApplyConfigChange_Handler,
 		},
+		{
+			MethodName: "RollbackConfigChange",
+			Handler:    _ConfigService_RollbackConfigChange_Handler,
+		},
+		{
+			MethodName: "CancelScheduledChange",
+			Handler:    _ConfigService_CancelScheduledChange_Handler,
+		},
 		{
 			MethodName: "ListConfigHistory",
 			Handler:    _ConfigService_ListConfigHistory_Handler,
 		},
+		{
+			MethodName: "GetConfigValueAsOf",
+			Handler:    _ConfigService_GetConfigValueAsOf_Handler,
+		},
+		{
+			MethodName: "SetConfigSchema",
+			Handler:    _ConfigService_SetConfigSchema_Handler,
+		},
+		{
+			MethodName: "ListConfigSchemas",
+			Handler:    _ConfigService_ListConfigSchemas_Handler,
+		},
+		{
+			MethodName: "PollConfigChanges",
+			Handler:    _ConfigService_PollConfigChanges_Handler,
+		},
+		{
+			MethodName: "EvaluateFlag",
+			Handler:    _ConfigService_EvaluateFlag_Handler,
+		},
 		{
 			MethodName: "RecordDownloadLibraryChange",
 			Handler:    _ConfigService_RecordDownloadLibraryChange_Handler,
@@ -306,6 +656,12 @@ var ConfigService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _ConfigService_ListDownloadLibraryChanges_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchConfig",
+			Handler:       _ConfigService_WatchConfig_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "rgs/v1/config.proto",
 }
@@ -123,6 +123,108 @@ func (MeterRecordType) EnumDescriptor() ([]byte, []int) {
 	return file_rgs_v1_events_proto_rawDescGZIP(), []int{1}
 }
 
+// A meter value that cannot follow from the previous one. Anomalous records
+// are kept but must not be used in reconciliation.
+type MeterAnomaly int32
+
+const (
+	MeterAnomaly_METER_ANOMALY_UNSPECIFIED MeterAnomaly = 0
+	// The counter wrapped past its maximum back to zero.
+	MeterAnomaly_METER_ANOMALY_ROLLOVER MeterAnomaly = 1
+	// The counter went backwards, or a delta was negative.
+	MeterAnomaly_METER_ANOMALY_REGRESSION MeterAnomaly = 2
+)
+
+// Enum value maps for MeterAnomaly.
+var (
+	MeterAnomaly_name = map[int32]string{
+		0: "METER_ANOMALY_UNSPECIFIED",
+		1: "METER_ANOMALY_ROLLOVER",
+		2: "METER_ANOMALY_REGRESSION",
+	}
+	MeterAnomaly_value = map[string]int32{
+		"METER_ANOMALY_UNSPECIFIED": 0,
+		"METER_ANOMALY_ROLLOVER":    1,
+		"METER_ANOMALY_REGRESSION":  2,
+	}
+)
+
+func (x MeterAnomaly) Enum() *MeterAnomaly {
+	p := new(MeterAnomaly)
+	*p = x
+	return p
+}
+
+func (x MeterAnomaly) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MeterAnomaly) Descriptor() protoreflect.EnumDescriptor {
+	return file_rgs_v1_events_proto_enumTypes[2].Descriptor()
+}
+
+func (MeterAnomaly) Type() protoreflect.EnumType {
+	return &file_rgs_v1_events_proto_enumTypes[2]
+}
+
+func (x MeterAnomaly) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MeterAnomaly.Descriptor instead.
+func (MeterAnomaly) EnumDescriptor() ([]byte, []int) {
+	return file_rgs_v1_events_proto_rawDescGZIP(), []int{2}
+}
+
+type AlertChannelType int32
+
+const (
+	AlertChannelType_ALERT_CHANNEL_TYPE_UNSPECIFIED AlertChannelType = 0
+	AlertChannelType_ALERT_CHANNEL_TYPE_WEBHOOK     AlertChannelType = 1
+	AlertChannelType_ALERT_CHANNEL_TYPE_EMAIL       AlertChannelType = 2
+)
+
+// Enum value maps for AlertChannelType.
+var (
+	AlertChannelType_name = map[int32]string{
+		0: "ALERT_CHANNEL_TYPE_UNSPECIFIED",
+		1: "ALERT_CHANNEL_TYPE_WEBHOOK",
+		2: "ALERT_CHANNEL_TYPE_EMAIL",
+	}
+	AlertChannelType_value = map[string]int32{
+		"ALERT_CHANNEL_TYPE_UNSPECIFIED": 0,
+		"ALERT_CHANNEL_TYPE_WEBHOOK":     1,
+		"ALERT_CHANNEL_TYPE_EMAIL":       2,
+	}
+)
+
+func (x AlertChannelType) Enum() *AlertChannelType {
+	p := new(AlertChannelType)
+	*p = x
+	return p
+}
+
+func (x AlertChannelType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AlertChannelType) Descriptor() protoreflect.EnumDescriptor {
+	return file_rgs_v1_events_proto_enumTypes[3].Descriptor()
+}
+
+func (AlertChannelType) Type() protoreflect.EnumType {
+	return &file_rgs_v1_events_proto_enumTypes[3]
+}
+
+func (x AlertChannelType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AlertChannelType.Descriptor instead.
+func (AlertChannelType) EnumDescriptor() ([]byte, []int) {
+	return file_rgs_v1_events_proto_rawDescGZIP(), []int{3}
+}
+
 type SignificantEvent struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
 	EventId              string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
@@ -134,8 +236,11 @@ type SignificantEvent struct {
 	ReceivedAt           string                 `protobuf:"bytes,7,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
 	RecordedAt           string                 `protobuf:"bytes,8,opt,name=recorded_at,json=recordedAt,proto3" json:"recorded_at,omitempty"`
 	Tags                 map[string]string      `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// Server receipt time minus device occurred_at, in milliseconds. Positive
+	// values mean the device clock is behind the server.
+	ClockSkewMs   int64 `protobuf:"varint,10,opt,name=clock_skew_ms,json=clockSkewMs,proto3" json:"clock_skew_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SignificantEvent) Reset() {
@@ -231,21 +336,33 @@ func (x *SignificantEvent) GetTags() map[string]string {
 	return nil
 }
 
+func (x *SignificantEvent) GetClockSkewMs() int64 {
+	if x != nil {
+		return x.ClockSkewMs
+	}
+	return 0
+}
+
 type MeterRecord struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MeterId       string                 `protobuf:"bytes,1,opt,name=meter_id,json=meterId,proto3" json:"meter_id,omitempty"`
-	EquipmentId   string                 `protobuf:"bytes,2,opt,name=equipment_id,json=equipmentId,proto3" json:"equipment_id,omitempty"`
-	MeterLabel    string                 `protobuf:"bytes,3,opt,name=meter_label,json=meterLabel,proto3" json:"meter_label,omitempty"`
-	MonetaryUnit  string                 `protobuf:"bytes,4,opt,name=monetary_unit,json=monetaryUnit,proto3" json:"monetary_unit,omitempty"`
-	RecordType    MeterRecordType        `protobuf:"varint,5,opt,name=record_type,json=recordType,proto3,enum=rgs.v1.MeterRecordType" json:"record_type,omitempty"`
-	ValueMinor    int64                  `protobuf:"varint,6,opt,name=value_minor,json=valueMinor,proto3" json:"value_minor,omitempty"`
-	DeltaMinor    int64                  `protobuf:"varint,7,opt,name=delta_minor,json=deltaMinor,proto3" json:"delta_minor,omitempty"`
-	OccurredAt    string                 `protobuf:"bytes,8,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
-	ReceivedAt    string                 `protobuf:"bytes,9,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
-	RecordedAt    string                 `protobuf:"bytes,10,opt,name=recorded_at,json=recordedAt,proto3" json:"recorded_at,omitempty"`
-	Tags          map[string]string      `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	MeterId      string                 `protobuf:"bytes,1,opt,name=meter_id,json=meterId,proto3" json:"meter_id,omitempty"`
+	EquipmentId  string                 `protobuf:"bytes,2,opt,name=equipment_id,json=equipmentId,proto3" json:"equipment_id,omitempty"`
+	MeterLabel   string                 `protobuf:"bytes,3,opt,name=meter_label,json=meterLabel,proto3" json:"meter_label,omitempty"`
+	MonetaryUnit string                 `protobuf:"bytes,4,opt,name=monetary_unit,json=monetaryUnit,proto3" json:"monetary_unit,omitempty"`
+	RecordType   MeterRecordType        `protobuf:"varint,5,opt,name=record_type,json=recordType,proto3,enum=rgs.v1.MeterRecordType" json:"record_type,omitempty"`
+	ValueMinor   int64                  `protobuf:"varint,6,opt,name=value_minor,json=valueMinor,proto3" json:"value_minor,omitempty"`
+	DeltaMinor   int64                  `protobuf:"varint,7,opt,name=delta_minor,json=deltaMinor,proto3" json:"delta_minor,omitempty"`
+	OccurredAt   string                 `protobuf:"bytes,8,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	ReceivedAt   string                 `protobuf:"bytes,9,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+	RecordedAt   string                 `protobuf:"bytes,10,opt,name=recorded_at,json=recordedAt,proto3" json:"recorded_at,omitempty"`
+	Tags         map[string]string      `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ClockSkewMs  int64                  `protobuf:"varint,12,opt,name=clock_skew_ms,json=clockSkewMs,proto3" json:"clock_skew_ms,omitempty"`
+	// Set by the server when the record is a rollover or regression.
+	Anomaly MeterAnomaly `protobuf:"varint,13,opt,name=anomaly,proto3,enum=rgs.v1.MeterAnomaly" json:"anomaly,omitempty"`
+	// The snapshot value the anomaly was measured against.
+	PreviousValueMinor int64 `protobuf:"varint,14,opt,name=previous_value_minor,json=previousValueMinor,proto3" json:"previous_value_minor,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *MeterRecord) Reset() {
@@ -355,28 +472,56 @@ func (x *MeterRecord) GetTags() map[string]string {
 	return nil
 }
 
-type SubmitSignificantEventRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Event         *SignificantEvent      `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *MeterRecord) GetClockSkewMs() int64 {
+	if x != nil {
+		return x.ClockSkewMs
+	}
+	return 0
 }
 
-func (x *SubmitSignificantEventRequest) Reset() {
-	*x = SubmitSignificantEventRequest{}
+func (x *MeterRecord) GetAnomaly() MeterAnomaly {
+	if x != nil {
+		return x.Anomaly
+	}
+	return MeterAnomaly_METER_ANOMALY_UNSPECIFIED
+}
+
+func (x *MeterRecord) GetPreviousValueMinor() int64 {
+	if x != nil {
+		return x.PreviousValueMinor
+	}
+	return 0
+}
+
+type DeviceClockSkew struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	EquipmentId        string                 `protobuf:"bytes,1,opt,name=equipment_id,json=equipmentId,proto3" json:"equipment_id,omitempty"`
+	Samples            int64                  `protobuf:"varint,2,opt,name=samples,proto3" json:"samples,omitempty"`
+	FlaggedSamples     int64                  `protobuf:"varint,3,opt,name=flagged_samples,json=flaggedSamples,proto3" json:"flagged_samples,omitempty"`
+	ConsecutiveFlagged int32                  `protobuf:"varint,4,opt,name=consecutive_flagged,json=consecutiveFlagged,proto3" json:"consecutive_flagged,omitempty"`
+	LastSkewMs         int64                  `protobuf:"varint,5,opt,name=last_skew_ms,json=lastSkewMs,proto3" json:"last_skew_ms,omitempty"`
+	MaxAbsSkewMs       int64                  `protobuf:"varint,6,opt,name=max_abs_skew_ms,json=maxAbsSkewMs,proto3" json:"max_abs_skew_ms,omitempty"`
+	Flagged            bool                   `protobuf:"varint,7,opt,name=flagged,proto3" json:"flagged,omitempty"`
+	Chronic            bool                   `protobuf:"varint,8,opt,name=chronic,proto3" json:"chronic,omitempty"`
+	LastObservedAt     string                 `protobuf:"bytes,9,opt,name=last_observed_at,json=lastObservedAt,proto3" json:"last_observed_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DeviceClockSkew) Reset() {
+	*x = DeviceClockSkew{}
 	mi := &file_rgs_v1_events_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitSignificantEventRequest) String() string {
+func (x *DeviceClockSkew) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitSignificantEventRequest) ProtoMessage() {}
+func (*DeviceClockSkew) ProtoMessage() {}
 
-func (x *SubmitSignificantEventRequest) ProtoReflect() protoreflect.Message {
+func (x *DeviceClockSkew) ProtoReflect() protoreflect.Message {
 	mi := &file_rgs_v1_events_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -388,48 +533,1609 @@ func (x *SubmitSignificantEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitSignificantEventRequest.ProtoReflect.Descriptor instead.
-func (*SubmitSignificantEventRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeviceClockSkew.ProtoReflect.Descriptor instead.
+func (*DeviceClockSkew) Descriptor() ([]byte, []int) {
 	return file_rgs_v1_events_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *SubmitSignificantEventRequest) GetMeta() *RequestMeta {
+func (x *DeviceClockSkew) GetEquipmentId() string {
+	if x != nil {
+		return x.EquipmentId
+	}
+	return ""
+}
+
+func (x *DeviceClockSkew) GetSamples() int64 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *DeviceClockSkew) GetFlaggedSamples() int64 {
+	if x != nil {
+		return x.FlaggedSamples
+	}
+	return 0
+}
+
+func (x *DeviceClockSkew) GetConsecutiveFlagged() int32 {
+	if x != nil {
+		return x.ConsecutiveFlagged
+	}
+	return 0
+}
+
+func (x *DeviceClockSkew) GetLastSkewMs() int64 {
+	if x != nil {
+		return x.LastSkewMs
+	}
+	return 0
+}
+
+func (x *DeviceClockSkew) GetMaxAbsSkewMs() int64 {
+	if x != nil {
+		return x.MaxAbsSkewMs
+	}
+	return 0
+}
+
+func (x *DeviceClockSkew) GetFlagged() bool {
+	if x != nil {
+		return x.Flagged
+	}
+	return false
+}
+
+func (x *DeviceClockSkew) GetChronic() bool {
+	if x != nil {
+		return x.Chronic
+	}
+	return false
+}
+
+func (x *DeviceClockSkew) GetLastObservedAt() string {
+	if x != nil {
+		return x.LastObservedAt
+	}
+	return ""
+}
+
+// AlertChannel is where an alert is sent: a webhook URL that receives the
+// event as a JSON POST, or an email address.
+type AlertChannel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          AlertChannelType       `protobuf:"varint,1,opt,name=type,proto3,enum=rgs.v1.AlertChannelType" json:"type,omitempty"`
+	Target        string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlertChannel) Reset() {
+	*x = AlertChannel{}
+	mi := &file_rgs_v1_events_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertChannel) ProtoMessage() {}
+
+func (x *AlertChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_events_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertChannel.ProtoReflect.Descriptor instead.
+func (*AlertChannel) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AlertChannel) GetType() AlertChannelType {
+	if x != nil {
+		return x.Type
+	}
+	return AlertChannelType_ALERT_CHANNEL_TYPE_UNSPECIFIED
+}
+
+func (x *AlertChannel) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+// AlertRule matches significant events by event_code, by severity at or
+// above min_severity, or both; at least one must be set.
+type AlertRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        string                 `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	EventCode     string                 `protobuf:"bytes,3,opt,name=event_code,json=eventCode,proto3" json:"event_code,omitempty"`
+	MinSeverity   EventSeverity          `protobuf:"varint,4,opt,name=min_severity,json=minSeverity,proto3,enum=rgs.v1.EventSeverity" json:"min_severity,omitempty"`
+	Channels      []*AlertChannel        `protobuf:"bytes,5,rep,name=channels,proto3" json:"channels,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlertRule) Reset() {
+	*x = AlertRule{}
+	mi := &file_rgs_v1_events_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertRule) ProtoMessage() {}
+
+func (x *AlertRule) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_events_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertRule.ProtoReflect.Descriptor instead.
+func (*AlertRule) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AlertRule) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *AlertRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AlertRule) GetEventCode() string {
+	if x != nil {
+		return x.EventCode
+	}
+	return ""
+}
+
+func (x *AlertRule) GetMinSeverity() EventSeverity {
+	if x != nil {
+		return x.MinSeverity
+	}
+	return EventSeverity_EVENT_SEVERITY_UNSPECIFIED
+}
+
+func (x *AlertRule) GetChannels() []*AlertChannel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *AlertRule) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *AlertRule) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// MaintenanceWindow marks equipment as under scheduled maintenance from
+// starts_at until ends_at. Events are still recorded during the window, but
+// alert deliveries for the equipment are suppressed.
+type MaintenanceWindow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WindowId      string                 `protobuf:"bytes,1,opt,name=window_id,json=windowId,proto3" json:"window_id,omitempty"`
+	EquipmentId   string                 `protobuf:"bytes,2,opt,name=equipment_id,json=equipmentId,proto3" json:"equipment_id,omitempty"`
+	StartsAt      string                 `protobuf:"bytes,3,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        string                 `protobuf:"bytes,4,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MaintenanceWindow) Reset() {
+	*x = MaintenanceWindow{}
+	mi := &file_rgs_v1_events_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaintenanceWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceWindow) ProtoMessage() {}
+
+func (x *MaintenanceWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_events_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceWindow.ProtoReflect.Descriptor instead.
+func (*MaintenanceWindow) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MaintenanceWindow) GetWindowId() string {
+	if x != nil {
+		return x.WindowId
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetEquipmentId() string {
+	if x != nil {
+		return x.EquipmentId
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// RamClearIncident is opened when a RAM_CLEAR significant event is recorded
+// and stays pending until an operator acknowledges it.
+type RamClearIncident struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	EventId     string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EquipmentId string                 `protobuf:"bytes,2,opt,name=equipment_id,json=equipmentId,proto3" json:"equipment_id,omitempty"`
+	OccurredAt  string                 `protobuf:"bytes,3,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// Meter snapshots that occurred before this time are not compared with
+	// later ones.
+	BaselineResetAt      string `protobuf:"bytes,4,opt,name=baseline_reset_at,json=baselineResetAt,proto3" json:"baseline_reset_at,omitempty"`
+	AcknowledgedBy       string `protobuf:"bytes,5,opt,name=acknowledged_by,json=acknowledgedBy,proto3" json:"acknowledged_by,omitempty"`
+	AcknowledgedAt       string `protobuf:"bytes,6,opt,name=acknowledged_at,json=acknowledgedAt,proto3" json:"acknowledged_at,omitempty"`
+	AcknowledgmentReason string `protobuf:"bytes,7,opt,name=acknowledgment_reason,json=acknowledgmentReason,proto3" json:"acknowledgment_reason,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *RamClearIncident) Reset() {
+	*x = RamClearIncident{}
+	mi := &file_rgs_v1_events_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RamClearIncident) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RamClearIncident) ProtoMessage() {}
+
+func (x *RamClearIncident) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_events_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RamClearIncident.ProtoReflect.Descriptor instead.
+func (*RamClearIncident) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RamClearIncident) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *RamClearIncident) GetEquipmentId() string {
+	if x != nil {
+		return x.EquipmentId
+	}
+	return ""
+}
+
+func (x *RamClearIncident) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+func (x *RamClearIncident) GetBaselineResetAt() string {
+	if x != nil {
+		return x.BaselineResetAt
+	}
+	return ""
+}
+
+func (x *RamClearIncident) GetAcknowledgedBy() string {
+	if x != nil {
+		return x.AcknowledgedBy
+	}
+	return ""
+}
+
+func (x *RamClearIncident) GetAcknowledgedAt() string {
+	if x != nil {
+		return x.AcknowledgedAt
+	}
+	return ""
+}
+
+func (x *RamClearIncident) GetAcknowledgmentReason() string {
+	if x != nil {
+		return x.AcknowledgmentReason
+	}
+	return ""
+}
+
+type SubmitSignificantEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Event         *SignificantEvent      `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitSignificantEventRequest) Reset() {
+	*x = SubmitSignificantEventRequest{}
+	mi := &file_rgs_v1_events_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitSignificantEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitSignificantEventRequest) ProtoMessage() {}
+
+func (x *SubmitSignificantEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_events_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitSignificantEventRequest.ProtoReflect.Descriptor instead.
+func (*SubmitSignificantEventRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_events_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SubmitSignificantEventRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *SubmitSignificantEventRequest) GetEvent() *SignificantEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type SubmitSignificantEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Event         *SignificantEvent      `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitSignificantEventResponse) Reset() {
+	*x = SubmitSignificantEventResponse{}
+	mi := &file_rgs_v1_events_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitSignificantEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitSignificantEventResponse) ProtoMessage() {}
+
+func (x *SubmitSignificantEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_events_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitSignificantEventResponse.ProtoReflect.Descriptor instead.
+func (*SubmitSignificantEventResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_events_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SubmitSignificantEventResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *SubmitSignificantEventResponse) GetEvent() *SignificantEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type SubmitMeterSnapshotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Meter         *MeterRecord           `protobuf:"bytes,2,opt,name=meter,proto3" json:"meter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitMeterSnapshotRequest) Reset() {
+	*x = SubmitMeterSnapshotRequest{}
+	mi := &file_rgs_v1_events_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitMeterSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitMeterSnapshotRequest) ProtoMessage() {}
+
+func (x *SubmitMeterSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_events_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitMeterSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*SubmitMeterSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_events_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SubmitMeterSnapshotRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *SubmitMeterSnapshotRequest) GetMeter() *MeterRecord {
+	if x != nil {
+		return x.Meter
+	}
+	return nil
+}
+
+type SubmitMeterSnapshotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Meter         *MeterRecord           `protobuf:"bytes,2,opt,name=meter,proto3" json:"meter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitMeterSnapshotResponse) Reset() {
+	*x = SubmitMeterSnapshotResponse{}
+	mi := &file_rgs_v1_events_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitMeterSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitMeterSnapshotResponse) ProtoMessage() {}
+
+func (x *SubmitMeterSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_events_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitMeterSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*SubmitMeterSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_events_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SubmitMeterSnapshotResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *SubmitMeterSnapshotResponse) GetMeter() *MeterRecord {
+	if x != nil {
+		return x.Meter
+	}
+	return nil
+}
+
+type SubmitMeterDeltaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Meter         *MeterRecord           `protobuf:"bytes,2,opt,name=meter,proto3" json:"meter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitMeterDeltaRequest) Reset() {
+	*x = SubmitMeterDeltaRequest{}
+	mi := &file_rgs_v1_events_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitMeterDeltaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitMeterDeltaRequest) ProtoMessage() {}
+
+func (x *SubmitMeterDeltaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_events_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitMeterDeltaRequest.ProtoReflect.Descriptor instead.
+func (*SubmitMeterDeltaRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_events_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SubmitMeterDeltaRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *SubmitMeterDeltaRequest) GetMeter() *MeterRecord {
+	if x != nil {
+		return x.Meter
+	}
+	return nil
+}
+
+type SubmitMeterDeltaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Meter         *MeterRecord           `protobuf:"bytes,2,opt,name=meter,proto3" json:"meter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitMeterDeltaResponse) Reset() {
+	*x = SubmitMeterDeltaResponse{}
+	mi := &file_rgs_v1_events_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitMeterDeltaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitMeterDeltaResponse) ProtoMessage() {}
+
+func (x *SubmitMeterDeltaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_events_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitMeterDeltaResponse.ProtoReflect.Descriptor instead.
+func (*SubmitMeterDeltaResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_events_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SubmitMeterDeltaResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *SubmitMeterDeltaResponse) GetMeter() *MeterRecord {
+	if x != nil {
+		return x.Meter
+	}
+	return nil
+}
+
+type ListEventsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Meta        *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	EquipmentId string                 `protobuf:"bytes,2,opt,name=equipment_id,json=equipmentId,proto3" json:"equipment_id,omitempty"`
+	FromTime    string                 `protobuf:"bytes,3,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`
+	ToTime      string                 `protobuf:"bytes,4,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
+	PageSize    int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken   string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Lists only events from equipment in this registry zone or bank.
+	GroupId       string `protobuf:"bytes,7,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventsRequest) Reset() {
+	*x = ListEventsRequest{}
+	mi := &file_rgs_v1_events_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsRequest) ProtoMessage() {}
+
+func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_events_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListEventsRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_events_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListEventsRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListEventsRequest) GetEquipmentId() string {
+	if x != nil {
+		return x.EquipmentId
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetFromTime() string {
+	if x != nil {
+		return x.FromTime
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetToTime() string {
+	if x != nil {
+		return x.ToTime
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type ListEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Events        []*SignificantEvent    `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventsResponse) Reset() {
+	*x = ListEventsResponse{}
+	mi := &file_rgs_v1_events_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsResponse) ProtoMessage() {}
+
+func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_events_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListEventsResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_events_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListEventsResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListEventsResponse) GetEvents() []*SignificantEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListMetersRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Meta        *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	EquipmentId string                 `protobuf:"bytes,2,opt,name=equipment_id,json=equipmentId,proto3" json:"equipment_id,omitempty"`
+	MeterLabel  string                 `protobuf:"bytes,3,opt,name=meter_label,json=meterLabel,proto3" json:"meter_label,omitempty"`
+	FromTime    string                 `protobuf:"bytes,4,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`
+	ToTime      string                 `protobuf:"bytes,5,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
+	PageSize    int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken   string                 `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Leaves out rollover and regression records, for reconciliation.
+	ExcludeAnomalies bool `protobuf:"varint,8,opt,name=exclude_anomalies,json=excludeAnomalies,proto3" json:"exclude_anomalies,omitempty"`
+	// Lists only meters of equipment in this registry zone or bank.
+	GroupId       string `protobuf:"bytes,9,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMetersRequest) Reset() {
+	*x = ListMetersRequest{}
+	mi := &file_rgs_v1_events_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMetersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMetersRequest) ProtoMessage() {}
+
+func (x *ListMetersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_events_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMetersRequest.ProtoReflect.Descriptor instead.
+func (*ListMetersRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_events_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListMetersRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListMetersRequest) GetEquipmentId() string {
+	if x != nil {
+		return x.EquipmentId
+	}
+	return ""
+}
+
+func (x *ListMetersRequest) GetMeterLabel() string {
+	if x != nil {
+		return x.MeterLabel
+	}
+	return ""
+}
+
+func (x *ListMetersRequest) GetFromTime() string {
+	if x != nil {
+		return x.FromTime
+	}
+	return ""
+}
+
+func (x *ListMetersRequest) GetToTime() string {
+	if x != nil {
+		return x.ToTime
+	}
+	return ""
+}
+
+func (x *ListMetersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMetersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListMetersRequest) GetExcludeAnomalies() bool {
+	if x != nil {
+		return x.ExcludeAnomalies
+	}
+	return false
+}
+
+func (x *ListMetersRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type ListMetersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Meters        []*MeterRecord         `protobuf:"bytes,2,rep,name=meters,proto3" json:"meters,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMetersResponse) Reset() {
+	*x = ListMetersResponse{}
+	mi := &file_rgs_v1_events_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMetersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMetersResponse) ProtoMessage() {}
+
+func (x *ListMetersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_events_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMetersResponse.ProtoReflect.Descriptor instead.
+func (*ListMetersResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_events_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListMetersResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListMetersResponse) GetMeters() []*MeterRecord {
+	if x != nil {
+		return x.Meters
+	}
+	return nil
+}
+
+func (x *ListMetersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetClockSkewReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	FlaggedOnly   bool                   `protobuf:"varint,2,opt,name=flagged_only,json=flaggedOnly,proto3" json:"flagged_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClockSkewReportRequest) Reset() {
+	*x = GetClockSkewReportRequest{}
+	mi := &file_rgs_v1_events_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClockSkewReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClockSkewReportRequest) ProtoMessage() {}
+
+func (x *GetClockSkewReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_events_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClockSkewReportRequest.ProtoReflect.Descriptor instead.
+func (*GetClockSkewReportRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_events_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetClockSkewReportRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GetClockSkewReportRequest) GetFlaggedOnly() bool {
+	if x != nil {
+		return x.FlaggedOnly
+	}
+	return false
+}
+
+type GetClockSkewReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Devices       []*DeviceClockSkew     `protobuf:"bytes,2,rep,name=devices,proto3" json:"devices,omitempty"`
+	ThresholdMs   int64                  `protobuf:"varint,3,opt,name=threshold_ms,json=thresholdMs,proto3" json:"threshold_ms,omitempty"`
+	ChronicAfter  int32                  `protobuf:"varint,4,opt,name=chronic_after,json=chronicAfter,proto3" json:"chronic_after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClockSkewReportResponse) Reset() {
+	*x = GetClockSkewReportResponse{}
+	mi := &file_rgs_v1_events_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClockSkewReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClockSkewReportResponse) ProtoMessage() {}
+
+func (x *GetClockSkewReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_events_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClockSkewReportResponse.ProtoReflect.Descriptor instead.
+func (*GetClockSkewReportResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_events_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetClockSkewReportResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GetClockSkewReportResponse) GetDevices() []*DeviceClockSkew {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+func (x *GetClockSkewReportResponse) GetThresholdMs() int64 {
+	if x != nil {
+		return x.ThresholdMs
+	}
+	return 0
+}
+
+func (x *GetClockSkewReportResponse) GetChronicAfter() int32 {
+	if x != nil {
+		return x.ChronicAfter
+	}
+	return 0
+}
+
+type CreateAlertRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Rule          *AlertRule             `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAlertRuleRequest) Reset() {
+	*x = CreateAlertRuleRequest{}
+	mi := &file_rgs_v1_events_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAlertRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAlertRuleRequest) ProtoMessage() {}
+
+func (x *CreateAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_events_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_events_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateAlertRuleRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *CreateAlertRuleRequest) GetRule() *AlertRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type CreateAlertRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Rule          *AlertRule             `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAlertRuleResponse) Reset() {
+	*x = CreateAlertRuleResponse{}
+	mi := &file_rgs_v1_events_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAlertRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAlertRuleResponse) ProtoMessage() {}
+
+func (x *CreateAlertRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_events_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAlertRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateAlertRuleResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_events_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateAlertRuleResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *CreateAlertRuleResponse) GetRule() *AlertRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type ListAlertRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertRulesRequest) Reset() {
+	*x = ListAlertRulesRequest{}
+	mi := &file_rgs_v1_events_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertRulesRequest) ProtoMessage() {}
+
+func (x *ListAlertRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_events_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListAlertRulesRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_events_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListAlertRulesRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type ListAlertRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Rules         []*AlertRule           `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertRulesResponse) Reset() {
+	*x = ListAlertRulesResponse{}
+	mi := &file_rgs_v1_events_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertRulesResponse) ProtoMessage() {}
+
+func (x *ListAlertRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_events_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListAlertRulesResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_events_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListAlertRulesResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListAlertRulesResponse) GetRules() []*AlertRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type CreateMaintenanceWindowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Window        *MaintenanceWindow     `protobuf:"bytes,2,opt,name=window,proto3" json:"window,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMaintenanceWindowRequest) Reset() {
+	*x = CreateMaintenanceWindowRequest{}
+	mi := &file_rgs_v1_events_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMaintenanceWindowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMaintenanceWindowRequest) ProtoMessage() {}
+
+func (x *CreateMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_events_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMaintenanceWindowRequest.ProtoReflect.Descriptor instead.
+func (*CreateMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_events_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateMaintenanceWindowRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *CreateMaintenanceWindowRequest) GetWindow() *MaintenanceWindow {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+type CreateMaintenanceWindowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Window        *MaintenanceWindow     `protobuf:"bytes,2,opt,name=window,proto3" json:"window,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMaintenanceWindowResponse) Reset() {
+	*x = CreateMaintenanceWindowResponse{}
+	mi := &file_rgs_v1_events_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMaintenanceWindowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMaintenanceWindowResponse) ProtoMessage() {}
+
+func (x *CreateMaintenanceWindowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_events_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMaintenanceWindowResponse.ProtoReflect.Descriptor instead.
+func (*CreateMaintenanceWindowResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_events_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateMaintenanceWindowResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *CreateMaintenanceWindowResponse) GetWindow() *MaintenanceWindow {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+type ListMaintenanceWindowsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Meta        *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	EquipmentId string                 `protobuf:"bytes,2,opt,name=equipment_id,json=equipmentId,proto3" json:"equipment_id,omitempty"`
+	// RFC3339 time; when set, only windows covering it are returned.
+	ActiveAt      string `protobuf:"bytes,3,opt,name=active_at,json=activeAt,proto3" json:"active_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMaintenanceWindowsRequest) Reset() {
+	*x = ListMaintenanceWindowsRequest{}
+	mi := &file_rgs_v1_events_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMaintenanceWindowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMaintenanceWindowsRequest) ProtoMessage() {}
+
+func (x *ListMaintenanceWindowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_events_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMaintenanceWindowsRequest.ProtoReflect.Descriptor instead.
+func (*ListMaintenanceWindowsRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_events_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListMaintenanceWindowsRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListMaintenanceWindowsRequest) GetEquipmentId() string {
+	if x != nil {
+		return x.EquipmentId
+	}
+	return ""
+}
+
+func (x *ListMaintenanceWindowsRequest) GetActiveAt() string {
+	if x != nil {
+		return x.ActiveAt
+	}
+	return ""
+}
+
+type ListMaintenanceWindowsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Windows       []*MaintenanceWindow   `protobuf:"bytes,2,rep,name=windows,proto3" json:"windows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMaintenanceWindowsResponse) Reset() {
+	*x = ListMaintenanceWindowsResponse{}
+	mi := &file_rgs_v1_events_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMaintenanceWindowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMaintenanceWindowsResponse) ProtoMessage() {}
+
+func (x *ListMaintenanceWindowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_events_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMaintenanceWindowsResponse.ProtoReflect.Descriptor instead.
+func (*ListMaintenanceWindowsResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_events_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListMaintenanceWindowsResponse) GetMeta() *ResponseMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *SubmitSignificantEventRequest) GetEvent() *SignificantEvent {
+func (x *ListMaintenanceWindowsResponse) GetWindows() []*MaintenanceWindow {
 	if x != nil {
-		return x.Event
+		return x.Windows
 	}
 	return nil
 }
 
-type SubmitSignificantEventResponse struct {
+type AcknowledgeRamClearRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Event         *SignificantEvent      `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitSignificantEventResponse) Reset() {
-	*x = SubmitSignificantEventResponse{}
-	mi := &file_rgs_v1_events_proto_msgTypes[3]
+func (x *AcknowledgeRamClearRequest) Reset() {
+	*x = AcknowledgeRamClearRequest{}
+	mi := &file_rgs_v1_events_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitSignificantEventResponse) String() string {
+func (x *AcknowledgeRamClearRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitSignificantEventResponse) ProtoMessage() {}
+func (*AcknowledgeRamClearRequest) ProtoMessage() {}
 
-func (x *SubmitSignificantEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_events_proto_msgTypes[3]
+func (x *AcknowledgeRamClearRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_events_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -440,48 +2146,55 @@ func (x *SubmitSignificantEventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitSignificantEventResponse.ProtoReflect.Descriptor instead.
-func (*SubmitSignificantEventResponse) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_events_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use AcknowledgeRamClearRequest.ProtoReflect.Descriptor instead.
+func (*AcknowledgeRamClearRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_events_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *SubmitSignificantEventResponse) GetMeta() *ResponseMeta {
+func (x *AcknowledgeRamClearRequest) GetMeta() *RequestMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *SubmitSignificantEventResponse) GetEvent() *SignificantEvent {
+func (x *AcknowledgeRamClearRequest) GetEventId() string {
 	if x != nil {
-		return x.Event
+		return x.EventId
 	}
-	return nil
+	return ""
 }
 
-type SubmitMeterSnapshotRequest struct {
+func (x *AcknowledgeRamClearRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AcknowledgeRamClearResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Meter         *MeterRecord           `protobuf:"bytes,2,opt,name=meter,proto3" json:"meter,omitempty"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Incident      *RamClearIncident      `protobuf:"bytes,2,opt,name=incident,proto3" json:"incident,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitMeterSnapshotRequest) Reset() {
-	*x = SubmitMeterSnapshotRequest{}
-	mi := &file_rgs_v1_events_proto_msgTypes[4]
+func (x *AcknowledgeRamClearResponse) Reset() {
+	*x = AcknowledgeRamClearResponse{}
+	mi := &file_rgs_v1_events_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitMeterSnapshotRequest) String() string {
+func (x *AcknowledgeRamClearResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitMeterSnapshotRequest) ProtoMessage() {}
+func (*AcknowledgeRamClearResponse) ProtoMessage() {}
 
-func (x *SubmitMeterSnapshotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_events_proto_msgTypes[4]
+func (x *AcknowledgeRamClearResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_events_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -492,48 +2205,49 @@ func (x *SubmitMeterSnapshotRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitMeterSnapshotRequest.ProtoReflect.Descriptor instead.
-func (*SubmitMeterSnapshotRequest) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_events_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use AcknowledgeRamClearResponse.ProtoReflect.Descriptor instead.
+func (*AcknowledgeRamClearResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_events_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *SubmitMeterSnapshotRequest) GetMeta() *RequestMeta {
+func (x *AcknowledgeRamClearResponse) GetMeta() *ResponseMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *SubmitMeterSnapshotRequest) GetMeter() *MeterRecord {
+func (x *AcknowledgeRamClearResponse) GetIncident() *RamClearIncident {
 	if x != nil {
-		return x.Meter
+		return x.Incident
 	}
 	return nil
 }
 
-type SubmitMeterSnapshotResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Meter         *MeterRecord           `protobuf:"bytes,2,opt,name=meter,proto3" json:"meter,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ListRamClearIncidentsRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Meta               *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	EquipmentId        string                 `protobuf:"bytes,2,opt,name=equipment_id,json=equipmentId,proto3" json:"equipment_id,omitempty"`
+	UnacknowledgedOnly bool                   `protobuf:"varint,3,opt,name=unacknowledged_only,json=unacknowledgedOnly,proto3" json:"unacknowledged_only,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *SubmitMeterSnapshotResponse) Reset() {
-	*x = SubmitMeterSnapshotResponse{}
-	mi := &file_rgs_v1_events_proto_msgTypes[5]
+func (x *ListRamClearIncidentsRequest) Reset() {
+	*x = ListRamClearIncidentsRequest{}
+	mi := &file_rgs_v1_events_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitMeterSnapshotResponse) String() string {
+func (x *ListRamClearIncidentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitMeterSnapshotResponse) ProtoMessage() {}
+func (*ListRamClearIncidentsRequest) ProtoMessage() {}
 
-func (x *SubmitMeterSnapshotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_events_proto_msgTypes[5]
+func (x *ListRamClearIncidentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_events_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -544,48 +2258,55 @@ func (x *SubmitMeterSnapshotResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitMeterSnapshotResponse.ProtoReflect.Descriptor instead.
-func (*SubmitMeterSnapshotResponse) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_events_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ListRamClearIncidentsRequest.ProtoReflect.Descriptor instead.
+func (*ListRamClearIncidentsRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_events_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *SubmitMeterSnapshotResponse) GetMeta() *ResponseMeta {
+func (x *ListRamClearIncidentsRequest) GetMeta() *RequestMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *SubmitMeterSnapshotResponse) GetMeter() *MeterRecord {
+func (x *ListRamClearIncidentsRequest) GetEquipmentId() string {
 	if x != nil {
-		return x.Meter
+		return x.EquipmentId
 	}
-	return nil
+	return ""
 }
 
-type SubmitMeterDeltaRequest struct {
+func (x *ListRamClearIncidentsRequest) GetUnacknowledgedOnly() bool {
+	if x != nil {
+		return x.UnacknowledgedOnly
+	}
+	return false
+}
+
+type ListRamClearIncidentsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Meter         *MeterRecord           `protobuf:"bytes,2,opt,name=meter,proto3" json:"meter,omitempty"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Incidents     []*RamClearIncident    `protobuf:"bytes,2,rep,name=incidents,proto3" json:"incidents,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitMeterDeltaRequest) Reset() {
-	*x = SubmitMeterDeltaRequest{}
-	mi := &file_rgs_v1_events_proto_msgTypes[6]
+func (x *ListRamClearIncidentsResponse) Reset() {
+	*x = ListRamClearIncidentsResponse{}
+	mi := &file_rgs_v1_events_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitMeterDeltaRequest) String() string {
+func (x *ListRamClearIncidentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitMeterDeltaRequest) ProtoMessage() {}
+func (*ListRamClearIncidentsResponse) ProtoMessage() {}
 
-func (x *SubmitMeterDeltaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_events_proto_msgTypes[6]
+func (x *ListRamClearIncidentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_events_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -596,48 +2317,48 @@ func (x *SubmitMeterDeltaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitMeterDeltaRequest.ProtoReflect.Descriptor instead.
-func (*SubmitMeterDeltaRequest) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_events_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ListRamClearIncidentsResponse.ProtoReflect.Descriptor instead.
+func (*ListRamClearIncidentsResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_events_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *SubmitMeterDeltaRequest) GetMeta() *RequestMeta {
+func (x *ListRamClearIncidentsResponse) GetMeta() *ResponseMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *SubmitMeterDeltaRequest) GetMeter() *MeterRecord {
+func (x *ListRamClearIncidentsResponse) GetIncidents() []*RamClearIncident {
 	if x != nil {
-		return x.Meter
+		return x.Incidents
 	}
 	return nil
 }
 
-type SubmitMeterDeltaResponse struct {
+type SubmitSignificantEventsBatchRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Meter         *MeterRecord           `protobuf:"bytes,2,opt,name=meter,proto3" json:"meter,omitempty"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Events        []*SignificantEvent    `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitMeterDeltaResponse) Reset() {
-	*x = SubmitMeterDeltaResponse{}
-	mi := &file_rgs_v1_events_proto_msgTypes[7]
+func (x *SubmitSignificantEventsBatchRequest) Reset() {
+	*x = SubmitSignificantEventsBatchRequest{}
+	mi := &file_rgs_v1_events_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitMeterDeltaResponse) String() string {
+func (x *SubmitSignificantEventsBatchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitMeterDeltaResponse) ProtoMessage() {}
+func (*SubmitSignificantEventsBatchRequest) ProtoMessage() {}
 
-func (x *SubmitMeterDeltaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_events_proto_msgTypes[7]
+func (x *SubmitSignificantEventsBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_events_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -648,52 +2369,51 @@ func (x *SubmitMeterDeltaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitMeterDeltaResponse.ProtoReflect.Descriptor instead.
-func (*SubmitMeterDeltaResponse) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_events_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use SubmitSignificantEventsBatchRequest.ProtoReflect.Descriptor instead.
+func (*SubmitSignificantEventsBatchRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_events_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *SubmitMeterDeltaResponse) GetMeta() *ResponseMeta {
+func (x *SubmitSignificantEventsBatchRequest) GetMeta() *RequestMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *SubmitMeterDeltaResponse) GetMeter() *MeterRecord {
+func (x *SubmitSignificantEventsBatchRequest) GetEvents() []*SignificantEvent {
 	if x != nil {
-		return x.Meter
+		return x.Events
 	}
 	return nil
 }
 
-type ListEventsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	EquipmentId   string                 `protobuf:"bytes,2,opt,name=equipment_id,json=equipmentId,proto3" json:"equipment_id,omitempty"`
-	FromTime      string                 `protobuf:"bytes,3,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`
-	ToTime        string                 `protobuf:"bytes,4,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
-	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+type SignificantEventBatchResult struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	EventId      string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	ResultCode   ResultCode             `protobuf:"varint,2,opt,name=result_code,json=resultCode,proto3,enum=rgs.v1.ResultCode" json:"result_code,omitempty"`
+	DenialReason string                 `protobuf:"bytes,3,opt,name=denial_reason,json=denialReason,proto3" json:"denial_reason,omitempty"`
+	// The recorded event, when result_code is OK.
+	Event         *SignificantEvent `protobuf:"bytes,4,opt,name=event,proto3" json:"event,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEventsRequest) Reset() {
-	*x = ListEventsRequest{}
-	mi := &file_rgs_v1_events_proto_msgTypes[8]
+func (x *SignificantEventBatchResult) Reset() {
+	*x = SignificantEventBatchResult{}
+	mi := &file_rgs_v1_events_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEventsRequest) String() string {
+func (x *SignificantEventBatchResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEventsRequest) ProtoMessage() {}
+func (*SignificantEventBatchResult) ProtoMessage() {}
 
-func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_events_proto_msgTypes[8]
+func (x *SignificantEventBatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_events_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -704,77 +2424,64 @@ func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
-func (*ListEventsRequest) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_events_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *ListEventsRequest) GetMeta() *RequestMeta {
-	if x != nil {
-		return x.Meta
-	}
-	return nil
+// Deprecated: Use SignificantEventBatchResult.ProtoReflect.Descriptor instead.
+func (*SignificantEventBatchResult) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_events_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *ListEventsRequest) GetEquipmentId() string {
+func (x *SignificantEventBatchResult) GetEventId() string {
 	if x != nil {
-		return x.EquipmentId
+		return x.EventId
 	}
 	return ""
 }
 
-func (x *ListEventsRequest) GetFromTime() string {
+func (x *SignificantEventBatchResult) GetResultCode() ResultCode {
 	if x != nil {
-		return x.FromTime
+		return x.ResultCode
 	}
-	return ""
+	return ResultCode_RESULT_CODE_UNSPECIFIED
 }
 
-func (x *ListEventsRequest) GetToTime() string {
+func (x *SignificantEventBatchResult) GetDenialReason() string {
 	if x != nil {
-		return x.ToTime
+		return x.DenialReason
 	}
 	return ""
 }
 
-func (x *ListEventsRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *ListEventsRequest) GetPageToken() string {
+func (x *SignificantEventBatchResult) GetEvent() *SignificantEvent {
 	if x != nil {
-		return x.PageToken
+		return x.Event
 	}
-	return ""
+	return nil
 }
 
-type ListEventsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Events        []*SignificantEvent    `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+type SubmitSignificantEventsBatchResponse struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Meta          *ResponseMeta                  `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Results       []*SignificantEventBatchResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	AcceptedCount int32                          `protobuf:"varint,3,opt,name=accepted_count,json=acceptedCount,proto3" json:"accepted_count,omitempty"`
+	FailedCount   int32                          `protobuf:"varint,4,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEventsResponse) Reset() {
-	*x = ListEventsResponse{}
-	mi := &file_rgs_v1_events_proto_msgTypes[9]
+func (x *SubmitSignificantEventsBatchResponse) Reset() {
+	*x = SubmitSignificantEventsBatchResponse{}
+	mi := &file_rgs_v1_events_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEventsResponse) String() string {
+func (x *SubmitSignificantEventsBatchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEventsResponse) ProtoMessage() {}
+func (*SubmitSignificantEventsBatchResponse) ProtoMessage() {}
 
-func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_events_proto_msgTypes[9]
+func (x *SubmitSignificantEventsBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_events_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -785,60 +2492,68 @@ func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEventsResponse.ProtoReflect.Descriptor instead.
-func (*ListEventsResponse) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_events_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use SubmitSignificantEventsBatchResponse.ProtoReflect.Descriptor instead.
+func (*SubmitSignificantEventsBatchResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_events_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *ListEventsResponse) GetMeta() *ResponseMeta {
+func (x *SubmitSignificantEventsBatchResponse) GetMeta() *ResponseMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *ListEventsResponse) GetEvents() []*SignificantEvent {
+func (x *SubmitSignificantEventsBatchResponse) GetResults() []*SignificantEventBatchResult {
 	if x != nil {
-		return x.Events
+		return x.Results
 	}
 	return nil
 }
 
-func (x *ListEventsResponse) GetNextPageToken() string {
+func (x *SubmitSignificantEventsBatchResponse) GetAcceptedCount() int32 {
 	if x != nil {
-		return x.NextPageToken
+		return x.AcceptedCount
 	}
-	return ""
+	return 0
 }
 
-type ListMetersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	EquipmentId   string                 `protobuf:"bytes,2,opt,name=equipment_id,json=equipmentId,proto3" json:"equipment_id,omitempty"`
-	MeterLabel    string                 `protobuf:"bytes,3,opt,name=meter_label,json=meterLabel,proto3" json:"meter_label,omitempty"`
-	FromTime      string                 `protobuf:"bytes,4,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`
-	ToTime        string                 `protobuf:"bytes,5,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
-	PageSize      int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+func (x *SubmitSignificantEventsBatchResponse) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+type WatchSignificantEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Meta  *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// Empty watches every equipment.
+	EquipmentId string `protobuf:"bytes,2,opt,name=equipment_id,json=equipmentId,proto3" json:"equipment_id,omitempty"`
+	// Unspecified watches every severity.
+	MinSeverity EventSeverity `protobuf:"varint,3,opt,name=min_severity,json=minSeverity,proto3,enum=rgs.v1.EventSeverity" json:"min_severity,omitempty"`
+	// Watches equipment in this registry zone or bank, as assigned when the
+	// watch starts.
+	GroupId       string `protobuf:"bytes,4,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMetersRequest) Reset() {
-	*x = ListMetersRequest{}
-	mi := &file_rgs_v1_events_proto_msgTypes[10]
+func (x *WatchSignificantEventsRequest) Reset() {
+	*x = WatchSignificantEventsRequest{}
+	mi := &file_rgs_v1_events_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMetersRequest) String() string {
+func (x *WatchSignificantEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMetersRequest) ProtoMessage() {}
+func (*WatchSignificantEventsRequest) ProtoMessage() {}
 
-func (x *ListMetersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_events_proto_msgTypes[10]
+func (x *WatchSignificantEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_events_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -849,84 +2564,63 @@ func (x *ListMetersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMetersRequest.ProtoReflect.Descriptor instead.
-func (*ListMetersRequest) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_events_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use WatchSignificantEventsRequest.ProtoReflect.Descriptor instead.
+func (*WatchSignificantEventsRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_events_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *ListMetersRequest) GetMeta() *RequestMeta {
+func (x *WatchSignificantEventsRequest) GetMeta() *RequestMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *ListMetersRequest) GetEquipmentId() string {
+func (x *WatchSignificantEventsRequest) GetEquipmentId() string {
 	if x != nil {
 		return x.EquipmentId
 	}
 	return ""
 }
 
-func (x *ListMetersRequest) GetMeterLabel() string {
-	if x != nil {
-		return x.MeterLabel
-	}
-	return ""
-}
-
-func (x *ListMetersRequest) GetFromTime() string {
-	if x != nil {
-		return x.FromTime
-	}
-	return ""
-}
-
-func (x *ListMetersRequest) GetToTime() string {
-	if x != nil {
-		return x.ToTime
-	}
-	return ""
-}
-
-func (x *ListMetersRequest) GetPageSize() int32 {
+func (x *WatchSignificantEventsRequest) GetMinSeverity() EventSeverity {
 	if x != nil {
-		return x.PageSize
+		return x.MinSeverity
 	}
-	return 0
+	return EventSeverity_EVENT_SEVERITY_UNSPECIFIED
 }
 
-func (x *ListMetersRequest) GetPageToken() string {
+func (x *WatchSignificantEventsRequest) GetGroupId() string {
 	if x != nil {
-		return x.PageToken
+		return x.GroupId
 	}
 	return ""
 }
 
-type ListMetersResponse struct {
+// The first message acknowledges the subscription and carries no event.
+type WatchSignificantEventsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Meters        []*MeterRecord         `protobuf:"bytes,2,rep,name=meters,proto3" json:"meters,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Event         *SignificantEvent      `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMetersResponse) Reset() {
-	*x = ListMetersResponse{}
-	mi := &file_rgs_v1_events_proto_msgTypes[11]
+func (x *WatchSignificantEventsResponse) Reset() {
+	*x = WatchSignificantEventsResponse{}
+	mi := &file_rgs_v1_events_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMetersResponse) String() string {
+func (x *WatchSignificantEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMetersResponse) ProtoMessage() {}
+func (*WatchSignificantEventsResponse) ProtoMessage() {}
 
-func (x *ListMetersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_events_proto_msgTypes[11]
+func (x *WatchSignificantEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_events_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -937,37 +2631,30 @@ func (x *ListMetersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMetersResponse.ProtoReflect.Descriptor instead.
-func (*ListMetersResponse) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_events_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use WatchSignificantEventsResponse.ProtoReflect.Descriptor instead.
+func (*WatchSignificantEventsResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_events_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *ListMetersResponse) GetMeta() *ResponseMeta {
+func (x *WatchSignificantEventsResponse) GetMeta() *ResponseMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *ListMetersResponse) GetMeters() []*MeterRecord {
+func (x *WatchSignificantEventsResponse) GetEvent() *SignificantEvent {
 	if x != nil {
-		return x.Meters
+		return x.Event
 	}
 	return nil
 }
 
-func (x *ListMetersResponse) GetNextPageToken() string {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return ""
-}
-
 var File_rgs_v1_events_proto protoreflect.FileDescriptor
 
 const file_rgs_v1_events_proto_rawDesc = "" +
 	"\n" +
-	"\x13rgs/v1/events.proto\x12\x06rgs.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x13rgs/v1/common.proto\"\xab\x03\n" +
+	"\x13rgs/v1/events.proto\x12\x06rgs.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x13rgs/v1/common.proto\"\xcf\x03\n" +
 	"\x10SignificantEvent\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12!\n" +
 	"\fequipment_id\x18\x02 \x01(\tR\vequipmentId\x12\x1d\n" +
@@ -981,10 +2668,12 @@ const file_rgs_v1_events_proto_rawDesc = "" +
 	"receivedAt\x12\x1f\n" +
 	"\vrecorded_at\x18\b \x01(\tR\n" +
 	"recordedAt\x126\n" +
-	"\x04tags\x18\t \x03(\v2\".rgs.v1.SignificantEvent.TagsEntryR\x04tags\x1a7\n" +
+	"\x04tags\x18\t \x03(\v2\".rgs.v1.SignificantEvent.TagsEntryR\x04tags\x12\"\n" +
+	"\rclock_skew_ms\x18\n" +
+	" \x01(\x03R\vclockSkewMs\x1a7\n" +
 	"\tTagsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xdc\x03\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe2\x04\n" +
 	"\vMeterRecord\x12\x19\n" +
 	"\bmeter_id\x18\x01 \x01(\tR\ameterId\x12!\n" +
 	"\fequipment_id\x18\x02 \x01(\tR\vequipmentId\x12\x1f\n" +
@@ -1004,10 +2693,57 @@ const file_rgs_v1_events_proto_rawDesc = "" +
 	"\vrecorded_at\x18\n" +
 	" \x01(\tR\n" +
 	"recordedAt\x121\n" +
-	"\x04tags\x18\v \x03(\v2\x1d.rgs.v1.MeterRecord.TagsEntryR\x04tags\x1a7\n" +
+	"\x04tags\x18\v \x03(\v2\x1d.rgs.v1.MeterRecord.TagsEntryR\x04tags\x12\"\n" +
+	"\rclock_skew_ms\x18\f \x01(\x03R\vclockSkewMs\x12.\n" +
+	"\aanomaly\x18\r \x01(\x0e2\x14.rgs.v1.MeterAnomalyR\aanomaly\x120\n" +
+	"\x14previous_value_minor\x18\x0e \x01(\x03R\x12previousValueMinor\x1a7\n" +
 	"\tTagsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"x\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xcf\x02\n" +
+	"\x0fDeviceClockSkew\x12!\n" +
+	"\fequipment_id\x18\x01 \x01(\tR\vequipmentId\x12\x18\n" +
+	"\asamples\x18\x02 \x01(\x03R\asamples\x12'\n" +
+	"\x0fflagged_samples\x18\x03 \x01(\x03R\x0eflaggedSamples\x12/\n" +
+	"\x13consecutive_flagged\x18\x04 \x01(\x05R\x12consecutiveFlagged\x12 \n" +
+	"\flast_skew_ms\x18\x05 \x01(\x03R\n" +
+	"lastSkewMs\x12%\n" +
+	"\x0fmax_abs_skew_ms\x18\x06 \x01(\x03R\fmaxAbsSkewMs\x12\x18\n" +
+	"\aflagged\x18\a \x01(\bR\aflagged\x12\x18\n" +
+	"\achronic\x18\b \x01(\bR\achronic\x12(\n" +
+	"\x10last_observed_at\x18\t \x01(\tR\x0elastObservedAt\"T\n" +
+	"\fAlertChannel\x12,\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x18.rgs.v1.AlertChannelTypeR\x04type\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\"\x81\x02\n" +
+	"\tAlertRule\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\tR\x06ruleId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"event_code\x18\x03 \x01(\tR\teventCode\x128\n" +
+	"\fmin_severity\x18\x04 \x01(\x0e2\x15.rgs.v1.EventSeverityR\vminSeverity\x120\n" +
+	"\bchannels\x18\x05 \x03(\v2\x14.rgs.v1.AlertChannelR\bchannels\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x06 \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\"\xdf\x01\n" +
+	"\x11MaintenanceWindow\x12\x1b\n" +
+	"\twindow_id\x18\x01 \x01(\tR\bwindowId\x12!\n" +
+	"\fequipment_id\x18\x02 \x01(\tR\vequipmentId\x12\x1b\n" +
+	"\tstarts_at\x18\x03 \x01(\tR\bstartsAt\x12\x17\n" +
+	"\aends_at\x18\x04 \x01(\tR\x06endsAt\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x06 \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\"\xa4\x02\n" +
+	"\x10RamClearIncident\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12!\n" +
+	"\fequipment_id\x18\x02 \x01(\tR\vequipmentId\x12\x1f\n" +
+	"\voccurred_at\x18\x03 \x01(\tR\n" +
+	"occurredAt\x12*\n" +
+	"\x11baseline_reset_at\x18\x04 \x01(\tR\x0fbaselineResetAt\x12'\n" +
+	"\x0facknowledged_by\x18\x05 \x01(\tR\x0eacknowledgedBy\x12'\n" +
+	"\x0facknowledged_at\x18\x06 \x01(\tR\x0eacknowledgedAt\x123\n" +
+	"\x15acknowledgment_reason\x18\a \x01(\tR\x14acknowledgmentReason\"x\n" +
 	"\x1dSubmitSignificantEventRequest\x12'\n" +
 	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12.\n" +
 	"\x05event\x18\x02 \x01(\v2\x18.rgs.v1.SignificantEventR\x05event\"z\n" +
@@ -1025,7 +2761,7 @@ const file_rgs_v1_events_proto_rawDesc = "" +
 	"\x05meter\x18\x02 \x01(\v2\x13.rgs.v1.MeterRecordR\x05meter\"o\n" +
 	"\x18SubmitMeterDeltaResponse\x12(\n" +
 	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12)\n" +
-	"\x05meter\x18\x02 \x01(\v2\x13.rgs.v1.MeterRecordR\x05meter\"\xd1\x01\n" +
+	"\x05meter\x18\x02 \x01(\v2\x13.rgs.v1.MeterRecordR\x05meter\"\xec\x01\n" +
 	"\x11ListEventsRequest\x12'\n" +
 	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12!\n" +
 	"\fequipment_id\x18\x02 \x01(\tR\vequipmentId\x12\x1b\n" +
@@ -1033,11 +2769,12 @@ const file_rgs_v1_events_proto_rawDesc = "" +
 	"\ato_time\x18\x04 \x01(\tR\x06toTime\x12\x1b\n" +
 	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x06 \x01(\tR\tpageToken\"\x98\x01\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\x12\x19\n" +
+	"\bgroup_id\x18\a \x01(\tR\agroupId\"\x98\x01\n" +
 	"\x12ListEventsResponse\x12(\n" +
 	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x120\n" +
 	"\x06events\x18\x02 \x03(\v2\x18.rgs.v1.SignificantEventR\x06events\x12&\n" +
-	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\xf2\x01\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\xba\x02\n" +
 	"\x11ListMetersRequest\x12'\n" +
 	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12!\n" +
 	"\fequipment_id\x18\x02 \x01(\tR\vequipmentId\x12\x1f\n" +
@@ -1047,11 +2784,81 @@ const file_rgs_v1_events_proto_rawDesc = "" +
 	"\ato_time\x18\x05 \x01(\tR\x06toTime\x12\x1b\n" +
 	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\a \x01(\tR\tpageToken\"\x93\x01\n" +
+	"page_token\x18\a \x01(\tR\tpageToken\x12+\n" +
+	"\x11exclude_anomalies\x18\b \x01(\bR\x10excludeAnomalies\x12\x19\n" +
+	"\bgroup_id\x18\t \x01(\tR\agroupId\"\x93\x01\n" +
 	"\x12ListMetersResponse\x12(\n" +
 	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12+\n" +
 	"\x06meters\x18\x02 \x03(\v2\x13.rgs.v1.MeterRecordR\x06meters\x12&\n" +
-	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken*~\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"g\n" +
+	"\x19GetClockSkewReportRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12!\n" +
+	"\fflagged_only\x18\x02 \x01(\bR\vflaggedOnly\"\xc1\x01\n" +
+	"\x1aGetClockSkewReportResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x121\n" +
+	"\adevices\x18\x02 \x03(\v2\x17.rgs.v1.DeviceClockSkewR\adevices\x12!\n" +
+	"\fthreshold_ms\x18\x03 \x01(\x03R\vthresholdMs\x12#\n" +
+	"\rchronic_after\x18\x04 \x01(\x05R\fchronicAfter\"h\n" +
+	"\x16CreateAlertRuleRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12%\n" +
+	"\x04rule\x18\x02 \x01(\v2\x11.rgs.v1.AlertRuleR\x04rule\"j\n" +
+	"\x17CreateAlertRuleResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12%\n" +
+	"\x04rule\x18\x02 \x01(\v2\x11.rgs.v1.AlertRuleR\x04rule\"@\n" +
+	"\x15ListAlertRulesRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\"k\n" +
+	"\x16ListAlertRulesResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12'\n" +
+	"\x05rules\x18\x02 \x03(\v2\x11.rgs.v1.AlertRuleR\x05rules\"|\n" +
+	"\x1eCreateMaintenanceWindowRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x121\n" +
+	"\x06window\x18\x02 \x01(\v2\x19.rgs.v1.MaintenanceWindowR\x06window\"~\n" +
+	"\x1fCreateMaintenanceWindowResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x121\n" +
+	"\x06window\x18\x02 \x01(\v2\x19.rgs.v1.MaintenanceWindowR\x06window\"\x88\x01\n" +
+	"\x1dListMaintenanceWindowsRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12!\n" +
+	"\fequipment_id\x18\x02 \x01(\tR\vequipmentId\x12\x1b\n" +
+	"\tactive_at\x18\x03 \x01(\tR\bactiveAt\"\x7f\n" +
+	"\x1eListMaintenanceWindowsResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x123\n" +
+	"\awindows\x18\x02 \x03(\v2\x19.rgs.v1.MaintenanceWindowR\awindows\"x\n" +
+	"\x1aAcknowledgeRamClearRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"}\n" +
+	"\x1bAcknowledgeRamClearResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x124\n" +
+	"\bincident\x18\x02 \x01(\v2\x18.rgs.v1.RamClearIncidentR\bincident\"\x9b\x01\n" +
+	"\x1cListRamClearIncidentsRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12!\n" +
+	"\fequipment_id\x18\x02 \x01(\tR\vequipmentId\x12/\n" +
+	"\x13unacknowledged_only\x18\x03 \x01(\bR\x12unacknowledgedOnly\"\x81\x01\n" +
+	"\x1dListRamClearIncidentsResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x126\n" +
+	"\tincidents\x18\x02 \x03(\v2\x18.rgs.v1.RamClearIncidentR\tincidents\"\x80\x01\n" +
+	"#SubmitSignificantEventsBatchRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x120\n" +
+	"\x06events\x18\x02 \x03(\v2\x18.rgs.v1.SignificantEventR\x06events\"\xc2\x01\n" +
+	"\x1bSignificantEventBatchResult\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x123\n" +
+	"\vresult_code\x18\x02 \x01(\x0e2\x12.rgs.v1.ResultCodeR\n" +
+	"resultCode\x12#\n" +
+	"\rdenial_reason\x18\x03 \x01(\tR\fdenialReason\x12.\n" +
+	"\x05event\x18\x04 \x01(\v2\x18.rgs.v1.SignificantEventR\x05event\"\xd9\x01\n" +
+	"$SubmitSignificantEventsBatchResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12=\n" +
+	"\aresults\x18\x02 \x03(\v2#.rgs.v1.SignificantEventBatchResultR\aresults\x12%\n" +
+	"\x0eaccepted_count\x18\x03 \x01(\x05R\racceptedCount\x12!\n" +
+	"\ffailed_count\x18\x04 \x01(\x05R\vfailedCount\"\xc0\x01\n" +
+	"\x1dWatchSignificantEventsRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12!\n" +
+	"\fequipment_id\x18\x02 \x01(\tR\vequipmentId\x128\n" +
+	"\fmin_severity\x18\x03 \x01(\x0e2\x15.rgs.v1.EventSeverityR\vminSeverity\x12\x19\n" +
+	"\bgroup_id\x18\x04 \x01(\tR\agroupId\"z\n" +
+	"\x1eWatchSignificantEventsResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12.\n" +
+	"\x05event\x18\x02 \x01(\v2\x18.rgs.v1.SignificantEventR\x05event*~\n" +
 	"\rEventSeverity\x12\x1e\n" +
 	"\x1aEVENT_SEVERITY_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13EVENT_SEVERITY_INFO\x10\x01\x12\x17\n" +
@@ -1060,15 +2867,32 @@ const file_rgs_v1_events_proto_rawDesc = "" +
 	"\x0fMeterRecordType\x12!\n" +
 	"\x1dMETER_RECORD_TYPE_UNSPECIFIED\x10\x00\x12\x1e\n" +
 	"\x1aMETER_RECORD_TYPE_SNAPSHOT\x10\x01\x12\x1b\n" +
-	"\x17METER_RECORD_TYPE_DELTA\x10\x022\xe4\x04\n" +
+	"\x17METER_RECORD_TYPE_DELTA\x10\x02*g\n" +
+	"\fMeterAnomaly\x12\x1d\n" +
+	"\x19METER_ANOMALY_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16METER_ANOMALY_ROLLOVER\x10\x01\x12\x1c\n" +
+	"\x18METER_ANOMALY_REGRESSION\x10\x02*t\n" +
+	"\x10AlertChannelType\x12\"\n" +
+	"\x1eALERT_CHANNEL_TYPE_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aALERT_CHANNEL_TYPE_WEBHOOK\x10\x01\x12\x1c\n" +
+	"\x18ALERT_CHANNEL_TYPE_EMAIL\x10\x022\xa2\x0e\n" +
 	"\rEventsService\x12\x8a\x01\n" +
-	"\x16SubmitSignificantEvent\x12%.rgs.v1.SubmitSignificantEventRequest\x1a&.rgs.v1.SubmitSignificantEventResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/events/significant\x12\x85\x01\n" +
+	"\x16SubmitSignificantEvent\x12%.rgs.v1.SubmitSignificantEventRequest\x1a&.rgs.v1.SubmitSignificantEventResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/events/significant\x12\xa2\x01\n" +
+	"\x1cSubmitSignificantEventsBatch\x12+.rgs.v1.SubmitSignificantEventsBatchRequest\x1a,.rgs.v1.SubmitSignificantEventsBatchResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/events/significant:batch\x12\x85\x01\n" +
 	"\x13SubmitMeterSnapshot\x12\".rgs.v1.SubmitMeterSnapshotRequest\x1a#.rgs.v1.SubmitMeterSnapshotResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/events/meters/snapshot\x12y\n" +
 	"\x10SubmitMeterDelta\x12\x1f.rgs.v1.SubmitMeterDeltaRequest\x1a .rgs.v1.SubmitMeterDeltaResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/events/meters/delta\x12c\n" +
 	"\n" +
 	"ListEvents\x12\x19.rgs.v1.ListEventsRequest\x1a\x1a.rgs.v1.ListEventsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/events/significant\x12^\n" +
 	"\n" +
-	"ListMeters\x12\x19.rgs.v1.ListMetersRequest\x1a\x1a.rgs.v1.ListMetersResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/events/metersB\x8d\x01\n" +
+	"ListMeters\x12\x19.rgs.v1.ListMetersRequest\x1a\x1a.rgs.v1.ListMetersResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/events/meters\x12u\n" +
+	"\x0fCreateAlertRule\x12\x1e.rgs.v1.CreateAlertRuleRequest\x1a\x1f.rgs.v1.CreateAlertRuleResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/events/alert-rules\x12o\n" +
+	"\x0eListAlertRules\x12\x1d.rgs.v1.ListAlertRulesRequest\x1a\x1e.rgs.v1.ListAlertRulesResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/events/alert-rules\x12\x95\x01\n" +
+	"\x17CreateMaintenanceWindow\x12&.rgs.v1.CreateMaintenanceWindowRequest\x1a'.rgs.v1.CreateMaintenanceWindowResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/events/maintenance-windows\x12\x8f\x01\n" +
+	"\x16ListMaintenanceWindows\x12%.rgs.v1.ListMaintenanceWindowsRequest\x1a&.rgs.v1.ListMaintenanceWindowsResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/events/maintenance-windows\x12\x97\x01\n" +
+	"\x13AcknowledgeRamClear\x12\".rgs.v1.AcknowledgeRamClearRequest\x1a#.rgs.v1.AcknowledgeRamClearResponse\"7\x82\xd3\xe4\x93\x021:\x01*\",/v1/events/ram-clears/{event_id}:acknowledge\x12\x83\x01\n" +
+	"\x15ListRamClearIncidents\x12$.rgs.v1.ListRamClearIncidentsRequest\x1a%.rgs.v1.ListRamClearIncidentsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/events/ram-clears\x12i\n" +
+	"\x16WatchSignificantEvents\x12%.rgs.v1.WatchSignificantEventsRequest\x1a&.rgs.v1.WatchSignificantEventsResponse0\x01\x12z\n" +
+	"\x12GetClockSkewReport\x12!.rgs.v1.GetClockSkewReportRequest\x1a\".rgs.v1.GetClockSkewReportResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/events/clock-skewB\x8d\x01\n" +
 	"\n" +
 	"com.rgs.v1B\vEventsProtoP\x01Z9github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1;rgsv1\xa2\x02\x03RXX\xaa\x02\x06Rgs.V1\xca\x02\x06Rgs\\V1\xe2\x02\x12Rgs\\V1\\GPBMetadata\xea\x02\aRgs::V1b\x06proto3"
 
@@ -1084,66 +2908,148 @@ func file_rgs_v1_events_proto_rawDescGZIP() []byte {
 	return file_rgs_v1_events_proto_rawDescData
 }
 
-var file_rgs_v1_events_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_rgs_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_rgs_v1_events_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_rgs_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
 var file_rgs_v1_events_proto_goTypes = []any{
-	(EventSeverity)(0),                     // 0: rgs.v1.EventSeverity
-	(MeterRecordType)(0),                   // 1: rgs.v1.MeterRecordType
-	(*SignificantEvent)(nil),               // 2: rgs.v1.SignificantEvent
-	(*MeterRecord)(nil),                    // 3: rgs.v1.MeterRecord
-	(*SubmitSignificantEventRequest)(nil),  // 4: rgs.v1.SubmitSignificantEventRequest
-	(*SubmitSignificantEventResponse)(nil), // 5: rgs.v1.SubmitSignificantEventResponse
-	(*SubmitMeterSnapshotRequest)(nil),     // 6: rgs.v1.SubmitMeterSnapshotRequest
-	(*SubmitMeterSnapshotResponse)(nil),    // 7: rgs.v1.SubmitMeterSnapshotResponse
-	(*SubmitMeterDeltaRequest)(nil),        // 8: rgs.v1.SubmitMeterDeltaRequest
-	(*SubmitMeterDeltaResponse)(nil),       // 9: rgs.v1.SubmitMeterDeltaResponse
-	(*ListEventsRequest)(nil),              // 10: rgs.v1.ListEventsRequest
-	(*ListEventsResponse)(nil),             // 11: rgs.v1.ListEventsResponse
-	(*ListMetersRequest)(nil),              // 12: rgs.v1.ListMetersRequest
-	(*ListMetersResponse)(nil),             // 13: rgs.v1.ListMetersResponse
-	nil,                                    // 14: rgs.v1.SignificantEvent.TagsEntry
-	nil,                                    // 15: rgs.v1.MeterRecord.TagsEntry
-	(*RequestMeta)(nil),                    // 16: rgs.v1.RequestMeta
-	(*ResponseMeta)(nil),                   // 17: rgs.v1.ResponseMeta
+	(EventSeverity)(0),                           // 0: rgs.v1.EventSeverity
+	(MeterRecordType)(0),                         // 1: rgs.v1.MeterRecordType
+	(MeterAnomaly)(0),                            // 2: rgs.v1.MeterAnomaly
+	(AlertChannelType)(0),                        // 3: rgs.v1.AlertChannelType
+	(*SignificantEvent)(nil),                     // 4: rgs.v1.SignificantEvent
+	(*MeterRecord)(nil),                          // 5: rgs.v1.MeterRecord
+	(*DeviceClockSkew)(nil),                      // 6: rgs.v1.DeviceClockSkew
+	(*AlertChannel)(nil),                         // 7: rgs.v1.AlertChannel
+	(*AlertRule)(nil),                            // 8: rgs.v1.AlertRule
+	(*MaintenanceWindow)(nil),                    // 9: rgs.v1.MaintenanceWindow
+	(*RamClearIncident)(nil),                     // 10: rgs.v1.RamClearIncident
+	(*SubmitSignificantEventRequest)(nil),        // 11: rgs.v1.SubmitSignificantEventRequest
+	(*SubmitSignificantEventResponse)(nil),       // 12: rgs.v1.SubmitSignificantEventResponse
+	(*SubmitMeterSnapshotRequest)(nil),           // 13: rgs.v1.SubmitMeterSnapshotRequest
+	(*SubmitMeterSnapshotResponse)(nil),          // 14: rgs.v1.SubmitMeterSnapshotResponse
+	(*SubmitMeterDeltaRequest)(nil),              // 15: rgs.v1.SubmitMeterDeltaRequest
+	(*SubmitMeterDeltaResponse)(nil),             // 16: rgs.v1.SubmitMeterDeltaResponse
+	(*ListEventsRequest)(nil),                    // 17: rgs.v1.ListEventsRequest
+	(*ListEventsResponse)(nil),                   // 18: rgs.v1.ListEventsResponse
+	(*ListMetersRequest)(nil),                    // 19: rgs.v1.ListMetersRequest
+	(*ListMetersResponse)(nil),                   // 20: rgs.v1.ListMetersResponse
+	(*GetClockSkewReportRequest)(nil),            // 21: rgs.v1.GetClockSkewReportRequest
+	(*GetClockSkewReportResponse)(nil),           // 22: rgs.v1.GetClockSkewReportResponse
+	(*CreateAlertRuleRequest)(nil),               // 23: rgs.v1.CreateAlertRuleRequest
+	(*CreateAlertRuleResponse)(nil),              // 24: rgs.v1.CreateAlertRuleResponse
+	(*ListAlertRulesRequest)(nil),                // 25: rgs.v1.ListAlertRulesRequest
+	(*ListAlertRulesResponse)(nil),               // 26: rgs.v1.ListAlertRulesResponse
+	(*CreateMaintenanceWindowRequest)(nil),       // 27: rgs.v1.CreateMaintenanceWindowRequest
+	(*CreateMaintenanceWindowResponse)(nil),      // 28: rgs.v1.CreateMaintenanceWindowResponse
+	(*ListMaintenanceWindowsRequest)(nil),        // 29: rgs.v1.ListMaintenanceWindowsRequest
+	(*ListMaintenanceWindowsResponse)(nil),       // 30: rgs.v1.ListMaintenanceWindowsResponse
+	(*AcknowledgeRamClearRequest)(nil),           // 31: rgs.v1.AcknowledgeRamClearRequest
+	(*AcknowledgeRamClearResponse)(nil),          // 32: rgs.v1.AcknowledgeRamClearResponse
+	(*ListRamClearIncidentsRequest)(nil),         // 33: rgs.v1.ListRamClearIncidentsRequest
+	(*ListRamClearIncidentsResponse)(nil),        // 34: rgs.v1.ListRamClearIncidentsResponse
+	(*SubmitSignificantEventsBatchRequest)(nil),  // 35: rgs.v1.SubmitSignificantEventsBatchRequest
+	(*SignificantEventBatchResult)(nil),          // 36: rgs.v1.SignificantEventBatchResult
+	(*SubmitSignificantEventsBatchResponse)(nil), // 37: rgs.v1.SubmitSignificantEventsBatchResponse
+	(*WatchSignificantEventsRequest)(nil),        // 38: rgs.v1.WatchSignificantEventsRequest
+	(*WatchSignificantEventsResponse)(nil),       // 39: rgs.v1.WatchSignificantEventsResponse
+	nil,                                          // 40: rgs.v1.SignificantEvent.TagsEntry
+	nil,                                          // 41: rgs.v1.MeterRecord.TagsEntry
+	(*RequestMeta)(nil),                          // 42: rgs.v1.RequestMeta
+	(*ResponseMeta)(nil),                         // 43: rgs.v1.ResponseMeta
+	(ResultCode)(0),                              // 44: rgs.v1.ResultCode
 }
 var file_rgs_v1_events_proto_depIdxs = []int32{
 	0,  // 0: rgs.v1.SignificantEvent.severity:type_name -> rgs.v1.EventSeverity
-	14, // 1: rgs.v1.SignificantEvent.tags:type_name -> rgs.v1.SignificantEvent.TagsEntry
+	40, // 1: rgs.v1.SignificantEvent.tags:type_name -> rgs.v1.SignificantEvent.TagsEntry
 	1,  // 2: rgs.v1.MeterRecord.record_type:type_name -> rgs.v1.MeterRecordType
-	15, // 3: rgs.v1.MeterRecord.tags:type_name -> rgs.v1.MeterRecord.TagsEntry
-	16, // 4: rgs.v1.SubmitSignificantEventRequest.meta:type_name -> rgs.v1.RequestMeta
-	2,  // 5: rgs.v1.SubmitSignificantEventRequest.event:type_name -> rgs.v1.SignificantEvent
-	17, // 6: rgs.v1.SubmitSignificantEventResponse.meta:type_name -> rgs.v1.ResponseMeta
-	2,  // 7: rgs.v1.SubmitSignificantEventResponse.event:type_name -> rgs.v1.SignificantEvent
-	16, // 8: rgs.v1.SubmitMeterSnapshotRequest.meta:type_name -> rgs.v1.RequestMeta
-	3,  // 9: rgs.v1.SubmitMeterSnapshotRequest.meter:type_name -> rgs.v1.MeterRecord
-	17, // 10: rgs.v1.SubmitMeterSnapshotResponse.meta:type_name -> rgs.v1.ResponseMeta
-	3,  // 11: rgs.v1.SubmitMeterSnapshotResponse.meter:type_name -> rgs.v1.MeterRecord
-	16, // 12: rgs.v1.SubmitMeterDeltaRequest.meta:type_name -> rgs.v1.RequestMeta
-	3,  // 13: rgs.v1.SubmitMeterDeltaRequest.meter:type_name -> rgs.v1.MeterRecord
-	17, // 14: rgs.v1.SubmitMeterDeltaResponse.meta:type_name -> rgs.v1.ResponseMeta
-	3,  // 15: rgs.v1.SubmitMeterDeltaResponse.meter:type_name -> rgs.v1.MeterRecord
-	16, // 16: rgs.v1.ListEventsRequest.meta:type_name -> rgs.v1.RequestMeta
-	17, // 17: rgs.v1.ListEventsResponse.meta:type_name -> rgs.v1.ResponseMeta
-	2,  // 18: rgs.v1.ListEventsResponse.events:type_name -> rgs.v1.SignificantEvent
-	16, // 19: rgs.v1.ListMetersRequest.meta:type_name -> rgs.v1.RequestMeta
-	17, // 20: rgs.v1.ListMetersResponse.meta:type_name -> rgs.v1.ResponseMeta
-	3,  // 21: rgs.v1.ListMetersResponse.meters:type_name -> rgs.v1.MeterRecord
-	4,  // 22: rgs.v1.EventsService.SubmitSignificantEvent:input_type -> rgs.v1.SubmitSignificantEventRequest
-	6,  // 23: rgs.v1.EventsService.SubmitMeterSnapshot:input_type -> rgs.v1.SubmitMeterSnapshotRequest
-	8,  // 24: rgs.v1.EventsService.SubmitMeterDelta:input_type -> rgs.v1.SubmitMeterDeltaRequest
-	10, // 25: rgs.v1.EventsService.ListEvents:input_type -> rgs.v1.ListEventsRequest
-	12, // 26: rgs.v1.EventsService.ListMeters:input_type -> rgs.v1.ListMetersRequest
-	5,  // 27: rgs.v1.EventsService.SubmitSignificantEvent:output_type -> rgs.v1.SubmitSignificantEventResponse
-	7,  // 28: rgs.v1.EventsService.SubmitMeterSnapshot:output_type -> rgs.v1.SubmitMeterSnapshotResponse
-	9,  // 29: rgs.v1.EventsService.SubmitMeterDelta:output_type -> rgs.v1.SubmitMeterDeltaResponse
-	11, // 30: rgs.v1.EventsService.ListEvents:output_type -> rgs.v1.ListEventsResponse
-	13, // 31: rgs.v1.EventsService.ListMeters:output_type -> rgs.v1.ListMetersResponse
-	27, // [27:32] is the sub-list for method output_type
-	22, // [22:27] is the sub-list for method input_type
-	22, // [22:22] is the sub-list for extension type_name
-	22, // [22:22] is the sub-list for extension extendee
-	0,  // [0:22] is the sub-list for field type_name
+	41, // 3: rgs.v1.MeterRecord.tags:type_name -> rgs.v1.MeterRecord.TagsEntry
+	2,  // 4: rgs.v1.MeterRecord.anomaly:type_name -> rgs.v1.MeterAnomaly
+	3,  // 5: rgs.v1.AlertChannel.type:type_name -> rgs.v1.AlertChannelType
+	0,  // 6: rgs.v1.AlertRule.min_severity:type_name -> rgs.v1.EventSeverity
+	7,  // 7: rgs.v1.AlertRule.channels:type_name -> rgs.v1.AlertChannel
+	42, // 8: rgs.v1.SubmitSignificantEventRequest.meta:type_name -> rgs.v1.RequestMeta
+	4,  // 9: rgs.v1.SubmitSignificantEventRequest.event:type_name -> rgs.v1.SignificantEvent
+	43, // 10: rgs.v1.SubmitSignificantEventResponse.meta:type_name -> rgs.v1.ResponseMeta
+	4,  // 11: rgs.v1.SubmitSignificantEventResponse.event:type_name -> rgs.v1.SignificantEvent
+	42, // 12: rgs.v1.SubmitMeterSnapshotRequest.meta:type_name -> rgs.v1.RequestMeta
+	5,  // 13: rgs.v1.SubmitMeterSnapshotRequest.meter:type_name -> rgs.v1.MeterRecord
+	43, // 14: rgs.v1.SubmitMeterSnapshotResponse.meta:type_name -> rgs.v1.ResponseMeta
+	5,  // 15: rgs.v1.SubmitMeterSnapshotResponse.meter:type_name -> rgs.v1.MeterRecord
+	42, // 16: rgs.v1.SubmitMeterDeltaRequest.meta:type_name -> rgs.v1.RequestMeta
+	5,  // 17: rgs.v1.SubmitMeterDeltaRequest.meter:type_name -> rgs.v1.MeterRecord
+	43, // 18: rgs.v1.SubmitMeterDeltaResponse.meta:type_name -> rgs.v1.ResponseMeta
+	5,  // 19: rgs.v1.SubmitMeterDeltaResponse.meter:type_name -> rgs.v1.MeterRecord
+	42, // 20: rgs.v1.ListEventsRequest.meta:type_name -> rgs.v1.RequestMeta
+	43, // 21: rgs.v1.ListEventsResponse.meta:type_name -> rgs.v1.ResponseMeta
+	4,  // 22: rgs.v1.ListEventsResponse.events:type_name -> rgs.v1.SignificantEvent
+	42, // 23: rgs.v1.ListMetersRequest.meta:type_name -> rgs.v1.RequestMeta
+	43, // 24: rgs.v1.ListMetersResponse.meta:type_name -> rgs.v1.ResponseMeta
+	5,  // 25: rgs.v1.ListMetersResponse.meters:type_name -> rgs.v1.MeterRecord
+	42, // 26: rgs.v1.GetClockSkewReportRequest.meta:type_name -> rgs.v1.RequestMeta
+	43, // 27: rgs.v1.GetClockSkewReportResponse.meta:type_name -> rgs.v1.ResponseMeta
+	6,  // 28: rgs.v1.GetClockSkewReportResponse.devices:type_name -> rgs.v1.DeviceClockSkew
+	42, // 29: rgs.v1.CreateAlertRuleRequest.meta:type_name -> rgs.v1.RequestMeta
+	8,  // 30: rgs.v1.CreateAlertRuleRequest.rule:type_name -> rgs.v1.AlertRule
+	43, // 31: rgs.v1.CreateAlertRuleResponse.meta:type_name -> rgs.v1.ResponseMeta
+	8,  // 32: rgs.v1.CreateAlertRuleResponse.rule:type_name -> rgs.v1.AlertRule
+	42, // 33: rgs.v1.ListAlertRulesRequest.meta:type_name -> rgs.v1.RequestMeta
+	43, // 34: rgs.v1.ListAlertRulesResponse.meta:type_name -> rgs.v1.ResponseMeta
+	8,  // 35: rgs.v1.ListAlertRulesResponse.rules:type_name -> rgs.v1.AlertRule
+	42, // 36: rgs.v1.CreateMaintenanceWindowRequest.meta:type_name -> rgs.v1.RequestMeta
+	9,  // 37: rgs.v1.CreateMaintenanceWindowRequest.window:type_name -> rgs.v1.MaintenanceWindow
+	43, // 38: rgs.v1.CreateMaintenanceWindowResponse.meta:type_name -> rgs.v1.ResponseMeta
+	9,  // 39: rgs.v1.CreateMaintenanceWindowResponse.window:type_name -> rgs.v1.MaintenanceWindow
+	42, // 40: rgs.v1.ListMaintenanceWindowsRequest.meta:type_name -> rgs.v1.RequestMeta
+	43, // 41: rgs.v1.ListMaintenanceWindowsResponse.meta:type_name -> rgs.v1.ResponseMeta
+	9,  // 42: rgs.v1.ListMaintenanceWindowsResponse.windows:type_name -> rgs.v1.MaintenanceWindow
+	42, // 43: rgs.v1.AcknowledgeRamClearRequest.meta:type_name -> rgs.v1.RequestMeta
+	43, // 44: rgs.v1.AcknowledgeRamClearResponse.meta:type_name -> rgs.v1.ResponseMeta
+	10, // 45: rgs.v1.AcknowledgeRamClearResponse.incident:type_name -> rgs.v1.RamClearIncident
+	42, // 46: rgs.v1.ListRamClearIncidentsRequest.meta:type_name -> rgs.v1.RequestMeta
+	43, // 47: rgs.v1.ListRamClearIncidentsResponse.meta:type_name -> rgs.v1.ResponseMeta
+	10, // 48: rgs.v1.ListRamClearIncidentsResponse.incidents:type_name -> rgs.v1.RamClearIncident
+	42, // 49: rgs.v1.SubmitSignificantEventsBatchRequest.meta:type_name -> rgs.v1.RequestMeta
+	4,  // 50: rgs.v1.SubmitSignificantEventsBatchRequest.events:type_name -> rgs.v1.SignificantEvent
+	44, // 51: rgs.v1.SignificantEventBatchResult.result_code:type_name -> rgs.v1.ResultCode
+	4,  // 52: rgs.v1.SignificantEventBatchResult.event:type_name -> rgs.v1.SignificantEvent
+	43, // 53: rgs.v1.SubmitSignificantEventsBatchResponse.meta:type_name -> rgs.v1.ResponseMeta
+	36, // 54: rgs.v1.SubmitSignificantEventsBatchResponse.results:type_name -> rgs.v1.SignificantEventBatchResult
+	42, // 55: rgs.v1.WatchSignificantEventsRequest.meta:type_name -> rgs.v1.RequestMeta
+	0,  // 56: rgs.v1.WatchSignificantEventsRequest.min_severity:type_name -> rgs.v1.EventSeverity
+	43, // 57: rgs.v1.WatchSignificantEventsResponse.meta:type_name -> rgs.v1.ResponseMeta
+	4,  // 58: rgs.v1.WatchSignificantEventsResponse.event:type_name -> rgs.v1.SignificantEvent
+	11, // 59: rgs.v1.EventsService.SubmitSignificantEvent:input_type -> rgs.v1.SubmitSignificantEventRequest
+	35, // 60: rgs.v1.EventsService.SubmitSignificantEventsBatch:input_type -> rgs.v1.SubmitSignificantEventsBatchRequest
+	13, // 61: rgs.v1.EventsService.SubmitMeterSnapshot:input_type -> rgs.v1.SubmitMeterSnapshotRequest
+	15, // 62: rgs.v1.EventsService.SubmitMeterDelta:input_type -> rgs.v1.SubmitMeterDeltaRequest
+	17, // 63: rgs.v1.EventsService.ListEvents:input_type -> rgs.v1.ListEventsRequest
+	19, // 64: rgs.v1.EventsService.ListMeters:input_type -> rgs.v1.ListMetersRequest
+	23, // 65: rgs.v1.EventsService.CreateAlertRule:input_type -> rgs.v1.CreateAlertRuleRequest
+	25, // 66: rgs.v1.EventsService.ListAlertRules:input_type -> rgs.v1.ListAlertRulesRequest
+	27, // 67: rgs.v1.EventsService.CreateMaintenanceWindow:input_type -> rgs.v1.CreateMaintenanceWindowRequest
+	29, // 68: rgs.v1.EventsService.ListMaintenanceWindows:input_type -> rgs.v1.ListMaintenanceWindowsRequest
+	31, // 69: rgs.v1.EventsService.AcknowledgeRamClear:input_type -> rgs.v1.AcknowledgeRamClearRequest
+	33, // 70: rgs.v1.EventsService.ListRamClearIncidents:input_type -> rgs.v1.ListRamClearIncidentsRequest
+	38, // 71: rgs.v1.EventsService.WatchSignificantEvents:input_type -> rgs.v1.WatchSignificantEventsRequest
+	21, // 72: rgs.v1.EventsService.GetClockSkewReport:input_type -> rgs.v1.GetClockSkewReportRequest
+	12, // 73: rgs.v1.EventsService.SubmitSignificantEvent:output_type -> rgs.v1.SubmitSignificantEventResponse
+	37, // 74: rgs.v1.EventsService.SubmitSignificantEventsBatch:output_type -> rgs.v1.SubmitSignificantEventsBatchResponse
+	14, // 75: rgs.v1.EventsService.SubmitMeterSnapshot:output_type -> rgs.v1.SubmitMeterSnapshotResponse
+	16, // 76: rgs.v1.EventsService.SubmitMeterDelta:output_type -> rgs.v1.SubmitMeterDeltaResponse
+	18, // 77: rgs.v1.EventsService.ListEvents:output_type -> rgs.v1.ListEventsResponse
+	20, // 78: rgs.v1.EventsService.ListMeters:output_type -> rgs.v1.ListMetersResponse
+	24, // 79: rgs.v1.EventsService.CreateAlertRule:output_type -> rgs.v1.CreateAlertRuleResponse
+	26, // 80: rgs.v1.EventsService.ListAlertRules:output_type -> rgs.v1.ListAlertRulesResponse
+	28, // 81: rgs.v1.EventsService.CreateMaintenanceWindow:output_type -> rgs.v1.CreateMaintenanceWindowResponse
+	30, // 82: rgs.v1.EventsService.ListMaintenanceWindows:output_type -> rgs.v1.ListMaintenanceWindowsResponse
+	32, // 83: rgs.v1.EventsService.AcknowledgeRamClear:output_type -> rgs.v1.AcknowledgeRamClearResponse
+	34, // 84: rgs.v1.EventsService.ListRamClearIncidents:output_type -> rgs.v1.ListRamClearIncidentsResponse
+	39, // 85: rgs.v1.EventsService.WatchSignificantEvents:output_type -> rgs.v1.WatchSignificantEventsResponse
+	22, // 86: rgs.v1.EventsService.GetClockSkewReport:output_type -> rgs.v1.GetClockSkewReportResponse
+	73, // [73:87] is the sub-list for method output_type
+	59, // [59:73] is the sub-list for method input_type
+	59, // [59:59] is the sub-list for extension type_name
+	59, // [59:59] is the sub-list for extension extendee
+	0,  // [0:59] is the sub-list for field type_name
 }
 
 func init() { file_rgs_v1_events_proto_init() }
@@ -1157,8 +3063,8 @@ func file_rgs_v1_events_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rgs_v1_events_proto_rawDesc), len(file_rgs_v1_events_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   14,
+			NumEnums:      4,
+			NumMessages:   38,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,11 +19,20 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	EventsService_SubmitSignificantEvent_FullMethodName = "/rgs.v1.EventsService/SubmitSignificantEvent"
-	EventsService_SubmitMeterSnapshot_FullMethodName    = "/rgs.v1.EventsService/SubmitMeterSnapshot"
-	EventsService_SubmitMeterDelta_FullMethodName       = "/rgs.v1.EventsService/SubmitMeterDelta"
-	EventsService_ListEvents_FullMethodName             = "/rgs.v1.EventsService/ListEvents"
-	EventsService_ListMeters_FullMethodName             = "/rgs.v1.EventsService/ListMeters"
+	EventsService_SubmitSignificantEvent_FullMethodName       = "/rgs.v1.EventsService/SubmitSignificantEvent"
+	EventsService_SubmitSignificantEventsBatch_FullMethodName = "/rgs.v1.EventsService/SubmitSignificantEventsBatch"
+	EventsService_SubmitMeterSnapshot_FullMethodName          = "/rgs.v1.EventsService/SubmitMeterSnapshot"
+	EventsService_SubmitMeterDelta_FullMethodName             = "/rgs.v1.EventsService/SubmitMeterDelta"
+	EventsService_ListEvents_FullMethodName                   = "/rgs.v1.EventsService/ListEvents"
+	EventsService_ListMeters_FullMethodName                   = "/rgs.v1.EventsService/ListMeters"
+	EventsService_CreateAlertRule_FullMethodName              = "/rgs.v1.EventsService/CreateAlertRule"
+	EventsService_ListAlertRules_FullMethodName               = "/rgs.v1.EventsService/ListAlertRules"
+	EventsService_CreateMaintenanceWindow_FullMethodName      = "/rgs.v1.EventsService/CreateMaintenanceWindow"
+	EventsService_ListMaintenanceWindows_FullMethodName       = "/rgs.v1.EventsService/ListMaintenanceWindows"
+	EventsService_AcknowledgeRamClear_FullMethodName          = "/rgs.v1.EventsService/AcknowledgeRamClear"
+	EventsService_ListRamClearIncidents_FullMethodName        = "/rgs.v1.EventsService/ListRamClearIncidents"
+	EventsService_WatchSignificantEvents_FullMethodName       = "/rgs.v1.EventsService/WatchSignificantEvents"
+	EventsService_GetClockSkewReport_FullMethodName           = "/rgs.v1.EventsService/GetClockSkewReport"
 )
 
 // EventsServiceClient is the client API for EventsService service.
@@ -31,10 +40,22 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type EventsServiceClient interface {
 	SubmitSignificantEvent(ctx context.Context, in *SubmitSignificantEventRequest, opts ...grpc.CallOption) (*SubmitSignificantEventResponse, error)
+	// Records up to 1000 events in one call. Each event succeeds or fails on
+	// its own; the response carries a result per event in request order.
+	SubmitSignificantEventsBatch(ctx context.Context, in *SubmitSignificantEventsBatchRequest, opts ...grpc.CallOption) (*SubmitSignificantEventsBatchResponse, error)
 	SubmitMeterSnapshot(ctx context.Context, in *SubmitMeterSnapshotRequest, opts ...grpc.CallOption) (*SubmitMeterSnapshotResponse, error)
 	SubmitMeterDelta(ctx context.Context, in *SubmitMeterDeltaRequest, opts ...grpc.CallOption) (*SubmitMeterDeltaResponse, error)
 	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
 	ListMeters(ctx context.Context, in *ListMetersRequest, opts ...grpc.CallOption) (*ListMetersResponse, error)
+	CreateAlertRule(ctx context.Context, in *CreateAlertRuleRequest, opts ...grpc.CallOption) (*CreateAlertRuleResponse, error)
+	ListAlertRules(ctx context.Context, in *ListAlertRulesRequest, opts ...grpc.CallOption) (*ListAlertRulesResponse, error)
+	CreateMaintenanceWindow(ctx context.Context, in *CreateMaintenanceWindowRequest, opts ...grpc.CallOption) (*CreateMaintenanceWindowResponse, error)
+	ListMaintenanceWindows(ctx context.Context, in *ListMaintenanceWindowsRequest, opts ...grpc.CallOption) (*ListMaintenanceWindowsResponse, error)
+	AcknowledgeRamClear(ctx context.Context, in *AcknowledgeRamClearRequest, opts ...grpc.CallOption) (*AcknowledgeRamClearResponse, error)
+	ListRamClearIncidents(ctx context.Context, in *ListRamClearIncidentsRequest, opts ...grpc.CallOption) (*ListRamClearIncidentsResponse, error)
+	// gRPC only: pushes significant events as they are recorded.
+	WatchSignificantEvents(ctx context.Context, in *WatchSignificantEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchSignificantEventsResponse], error)
+	GetClockSkewReport(ctx context.Context, in *GetClockSkewReportRequest, opts ...grpc.CallOption) (*GetClockSkewReportResponse, error)
 }
 
 type eventsServiceClient struct {
@@ -55,6 +76,16 @@ func (c *eventsServiceClient) SubmitSignificantEvent(ctx context.Context, in *Su
 	return out, nil
 }
 
+func (c *eventsServiceClient) SubmitSignificantEventsBatch(ctx context.Context, in *SubmitSignificantEventsBatchRequest, opts ...grpc.CallOption) (*SubmitSignificantEventsBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitSignificantEventsBatchResponse)
+	err := c.cc.Invoke(ctx, EventsService_SubmitSignificantEventsBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *eventsServiceClient) SubmitMeterSnapshot(ctx context.Context, in *SubmitMeterSnapshotRequest, opts ...grpc.CallOption) (*SubmitMeterSnapshotResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SubmitMeterSnapshotResponse)
@@ -95,15 +126,116 @@ func (c *eventsServiceClient) ListMeters(ctx context.Context, in *ListMetersRequ
 	return out, nil
 }
 
+func (c *eventsServiceClient) CreateAlertRule(ctx context.Context, in *CreateAlertRuleRequest, opts ...grpc.CallOption) (*CreateAlertRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAlertRuleResponse)
+	err := c.cc.Invoke(ctx, EventsService_CreateAlertRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsServiceClient) ListAlertRules(ctx context.Context, in *ListAlertRulesRequest, opts ...grpc.CallOption) (*ListAlertRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAlertRulesResponse)
+	err := c.cc.Invoke(ctx, EventsService_ListAlertRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsServiceClient) CreateMaintenanceWindow(ctx context.Context, in *CreateMaintenanceWindowRequest, opts ...grpc.CallOption) (*CreateMaintenanceWindowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateMaintenanceWindowResponse)
+	err := c.cc.Invoke(ctx, EventsService_CreateMaintenanceWindow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsServiceClient) ListMaintenanceWindows(ctx context.Context, in *ListMaintenanceWindowsRequest, opts ...grpc.CallOption) (*ListMaintenanceWindowsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMaintenanceWindowsResponse)
+	err := c.cc.Invoke(ctx, EventsService_ListMaintenanceWindows_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsServiceClient) AcknowledgeRamClear(ctx context.Context, in *AcknowledgeRamClearRequest, opts ...grpc.CallOption) (*AcknowledgeRamClearResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcknowledgeRamClearResponse)
+	err := c.cc.Invoke(ctx, EventsService_AcknowledgeRamClear_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsServiceClient) ListRamClearIncidents(ctx context.Context, in *ListRamClearIncidentsRequest, opts ...grpc.CallOption) (*ListRamClearIncidentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRamClearIncidentsResponse)
+	err := c.cc.Invoke(ctx, EventsService_ListRamClearIncidents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsServiceClient) WatchSignificantEvents(ctx context.Context, in *WatchSignificantEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchSignificantEventsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &EventsService_ServiceDesc.Streams[0], EventsService_WatchSignificantEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchSignificantEventsRequest, WatchSignificantEventsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EventsService_WatchSignificantEventsClient = grpc.ServerStreamingClient[WatchSignificantEventsResponse]
+
+func (c *eventsServiceClient) GetClockSkewReport(ctx context.Context, in *GetClockSkewReportRequest, opts ...grpc.CallOption) (*GetClockSkewReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetClockSkewReportResponse)
+	err := c.cc.Invoke(ctx, EventsService_GetClockSkewReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EventsServiceServer is the server API for EventsService service.
 // All implementations must embed UnimplementedEventsServiceServer
 // for forward compatibility.
 type EventsServiceServer interface {
 	SubmitSignificantEvent(context.Context, *SubmitSignificantEventRequest) (*SubmitSignificantEventResponse, error)
+	// Records up to 1000 events in one call. Each event succeeds or fails on
+	// its own; the response carries a result per event in request order.
+	SubmitSignificantEventsBatch(context.Context, *SubmitSignificantEventsBatchRequest) (*SubmitSignificantEventsBatchResponse, error)
 	SubmitMeterSnapshot(context.Context, *SubmitMeterSnapshotRequest) (*SubmitMeterSnapshotResponse, error)
 	SubmitMeterDelta(context.Context, *SubmitMeterDeltaRequest) (*SubmitMeterDeltaResponse, error)
 	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
 	ListMeters(context.Context, *ListMetersRequest) (*ListMetersResponse, error)
+	CreateAlertRule(context.Context, *CreateAlertRuleRequest) (*CreateAlertRuleResponse, error)
+	ListAlertRules(context.Context, *ListAlertRulesRequest) (*ListAlertRulesResponse, error)
+	CreateMaintenanceWindow(context.Context, *CreateMaintenanceWindowRequest) (*CreateMaintenanceWindowResponse, error)
+	ListMaintenanceWindows(context.Context, *ListMaintenanceWindowsRequest) (*ListMaintenanceWindowsResponse, error)
+	AcknowledgeRamClear(context.Context, *AcknowledgeRamClearRequest) (*AcknowledgeRamClearResponse, error)
+	ListRamClearIncidents(context.Context, *ListRamClearIncidentsRequest) (*ListRamClearIncidentsResponse, error)
+	// gRPC only: pushes significant events as they are recorded.
+	WatchSignificantEvents(*WatchSignificantEventsRequest, grpc.ServerStreamingServer[WatchSignificantEventsResponse]) error
+	GetClockSkewReport(context.Context, *GetClockSkewReportRequest) (*GetClockSkewReportResponse, error)
 	mustEmbedUnimplementedEventsServiceServer()
 }
 
@@ -117,6 +249,9 @@ type UnimplementedEventsServiceServer struct{}
 func (UnimplementedEventsServiceServer) SubmitSignificantEvent(context.Context, *SubmitSignificantEventRequest) (*SubmitSignificantEventResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SubmitSignificantEvent not implemented")
 }
+func (UnimplementedEventsServiceServer) SubmitSignificantEventsBatch(context.Context, *SubmitSignificantEventsBatchRequest) (*SubmitSignificantEventsBatchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SubmitSignificantEventsBatch not implemented")
+}
 func (UnimplementedEventsServiceServer) SubmitMeterSnapshot(context.Context, *SubmitMeterSnapshotRequest) (*SubmitMeterSnapshotResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SubmitMeterSnapshot not implemented")
 }
@@ -129,6 +264,30 @@ func (UnimplementedEventsServiceServer) ListEvents(context.Context, *ListEventsR
 func (UnimplementedEventsServiceServer) ListMeters(context.Context, *ListMetersRequest) (*ListMetersResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListMeters not implemented")
 }
+func (UnimplementedEventsServiceServer) CreateAlertRule(context.Context, *CreateAlertRuleRequest) (*CreateAlertRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAlertRule not implemented")
+}
+func (UnimplementedEventsServiceServer) ListAlertRules(context.Context, *ListAlertRulesRequest) (*ListAlertRulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAlertRules not implemented")
+}
+func (UnimplementedEventsServiceServer) CreateMaintenanceWindow(context.Context, *CreateMaintenanceWindowRequest) (*CreateMaintenanceWindowResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateMaintenanceWindow not implemented")
+}
+func (UnimplementedEventsServiceServer) ListMaintenanceWindows(context.Context, *ListMaintenanceWindowsRequest) (*ListMaintenanceWindowsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMaintenanceWindows not implemented")
+}
+func (UnimplementedEventsServiceServer) AcknowledgeRamClear(context.Context, *AcknowledgeRamClearRequest) (*AcknowledgeRamClearResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AcknowledgeRamClear not implemented")
+}
+func (UnimplementedEventsServiceServer) ListRamClearIncidents(context.Context, *ListRamClearIncidentsRequest) (*ListRamClearIncidentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRamClearIncidents not implemented")
+}
+func (UnimplementedEventsServiceServer) WatchSignificantEvents(*WatchSignificantEventsRequest, grpc.ServerStreamingServer[WatchSignificantEventsResponse]) error {
+	return status.Error(codes.Unimplemented, "method WatchSignificantEvents not implemented")
+}
+func (UnimplementedEventsServiceServer) GetClockSkewReport(context.Context, *GetClockSkewReportRequest) (*GetClockSkewReportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetClockSkewReport not implemented")
+}
 func (UnimplementedEventsServiceServer) mustEmbedUnimplementedEventsServiceServer() {}
 func (UnimplementedEventsServiceServer) testEmbeddedByValue()                       {}
 
@@ -168,6 +327,24 @@ func _EventsService_SubmitSignificantEvent_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventsService_SubmitSignificantEventsBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitSignificantEventsBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).SubmitSignificantEventsBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventsService_SubmitSignificantEventsBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).SubmitSignificantEventsBatch(ctx, req.(*SubmitSignificantEventsBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EventsService_SubmitMeterSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SubmitMeterSnapshotRequest)
 	if err := dec(in); err != nil {
@@ -240,6 +417,143 @@ func _EventsService_ListMeters_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventsService_CreateAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).CreateAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventsService_CreateAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).CreateAlertRule(ctx, req.(*CreateAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventsService_ListAlertRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlertRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).ListAlertRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventsService_ListAlertRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).ListAlertRules(ctx, req.(*ListAlertRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventsService_CreateMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMaintenanceWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).CreateMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventsService_CreateMaintenanceWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).CreateMaintenanceWindow(ctx, req.(*CreateMaintenanceWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventsService_ListMaintenanceWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMaintenanceWindowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).ListMaintenanceWindows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventsService_ListMaintenanceWindows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).ListMaintenanceWindows(ctx, req.(*ListMaintenanceWindowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventsService_AcknowledgeRamClear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcknowledgeRamClearRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).AcknowledgeRamClear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventsService_AcknowledgeRamClear_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).AcknowledgeRamClear(ctx, req.(*AcknowledgeRamClearRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventsService_ListRamClearIncidents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRamClearIncidentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).ListRamClearIncidents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventsService_ListRamClearIncidents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).ListRamClearIncidents(ctx, req.(*ListRamClearIncidentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventsService_WatchSignificantEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchSignificantEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EventsServiceServer).WatchSignificantEvents(m, &grpc.GenericServerStream[WatchSignificantEventsRequest, WatchSignificantEventsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EventsService_WatchSignificantEventsServer = grpc.ServerStreamingServer[WatchSignificantEventsResponse]
+
+func _EventsService_GetClockSkewReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClockSkewReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).GetClockSkewReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventsService_GetClockSkewReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).GetClockSkewReport(ctx, req.(*GetClockSkewReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EventsService_ServiceDesc is the grpc.ServiceDesc for EventsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -251,6 +565,10 @@ var EventsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitSignificantEvent",
 			Handler:    _EventsService_SubmitSignificantEvent_Handler,
 		},
+		{
+			MethodName: "SubmitSignificantEventsBatch",
+			Handler:    _EventsService_SubmitSignificantEventsBatch_Handler,
+		},
 		{
 			MethodName: "SubmitMeterSnapshot",
 			Handler:    _EventsService_SubmitMeterSnapshot_Handler,
@@ -267,7 +585,41 @@ var EventsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListMeters",
 			Handler:    _EventsService_ListMeters_Handler,
 		},
+		{
+			MethodName: "CreateAlertRule",
+			Handler:    _EventsService_CreateAlertRule_Handler,
+		},
+		{
+			MethodName: "ListAlertRules",
+			Handler:    _EventsService_ListAlertRules_Handler,
+		},
+		{
+			MethodName: "CreateMaintenanceWindow",
+			Handler:    _EventsService_CreateMaintenanceWindow_Handler,
+		},
+		{
+			MethodName: "ListMaintenanceWindows",
+			Handler:    _EventsService_ListMaintenanceWindows_Handler,
+		},
+		{
+			MethodName: "AcknowledgeRamClear",
+			Handler:    _EventsService_AcknowledgeRamClear_Handler,
+		},
+		{
+			MethodName: "ListRamClearIncidents",
+			Handler:    _EventsService_ListRamClearIncidents_Handler,
+		},
+		{
+			MethodName: "GetClockSkewReport",
+			Handler:    _EventsService_GetClockSkewReport_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchSignificantEvents",
+			Handler:       _EventsService_WatchSignificantEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "rgs/v1/events.proto",
 }
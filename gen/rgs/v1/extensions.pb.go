@@ -961,13 +961,15 @@ func (x *SubmitSystemWindowEventResponse) GetEvent() *SystemWindowEvent {
 }
 
 type ListSystemWindowEventsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	EquipmentId   string                 `protobuf:"bytes,2,opt,name=equipment_id,json=equipmentId,proto3" json:"equipment_id,omitempty"`
-	FromTime      string                 `protobuf:"bytes,3,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`
-	ToTime        string                 `protobuf:"bytes,4,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
-	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Meta        *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	EquipmentId string                 `protobuf:"bytes,2,opt,name=equipment_id,json=equipmentId,proto3" json:"equipment_id,omitempty"`
+	FromTime    string                 `protobuf:"bytes,3,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`
+	ToTime      string                 `protobuf:"bytes,4,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
+	PageSize    int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken   string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Lists only events from equipment in this registry zone or bank.
+	GroupId       string `protobuf:"bytes,7,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1044,6 +1046,13 @@ func (x *ListSystemWindowEventsRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *ListSystemWindowEventsRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
 type ListSystemWindowEventsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
@@ -1104,6 +1113,111 @@ func (x *ListSystemWindowEventsResponse) GetNextPageToken() string {
 	return ""
 }
 
+type WatchSystemWindowEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	EquipmentId   string                 `protobuf:"bytes,2,opt,name=equipment_id,json=equipmentId,proto3" json:"equipment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchSystemWindowEventsRequest) Reset() {
+	*x = WatchSystemWindowEventsRequest{}
+	mi := &file_rgs_v1_extensions_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchSystemWindowEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchSystemWindowEventsRequest) ProtoMessage() {}
+
+func (x *WatchSystemWindowEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_extensions_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchSystemWindowEventsRequest.ProtoReflect.Descriptor instead.
+func (*WatchSystemWindowEventsRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_extensions_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *WatchSystemWindowEventsRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *WatchSystemWindowEventsRequest) GetEquipmentId() string {
+	if x != nil {
+		return x.EquipmentId
+	}
+	return ""
+}
+
+// The first message acknowledges the subscription and carries no event.
+type WatchSystemWindowEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Event         *SystemWindowEvent     `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchSystemWindowEventsResponse) Reset() {
+	*x = WatchSystemWindowEventsResponse{}
+	mi := &file_rgs_v1_extensions_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchSystemWindowEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchSystemWindowEventsResponse) ProtoMessage() {}
+
+func (x *WatchSystemWindowEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_extensions_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchSystemWindowEventsResponse.ProtoReflect.Descriptor instead.
+func (*WatchSystemWindowEventsResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_extensions_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *WatchSystemWindowEventsResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *WatchSystemWindowEventsResponse) GetEvent() *SystemWindowEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
 var File_rgs_v1_extensions_proto protoreflect.FileDescriptor
 
 const file_rgs_v1_extensions_proto_rawDesc = "" +
@@ -1176,7 +1290,7 @@ const file_rgs_v1_extensions_proto_rawDesc = "" +
 	"\x05event\x18\x02 \x01(\v2\x19.rgs.v1.SystemWindowEventR\x05event\"|\n" +
 	"\x1fSubmitSystemWindowEventResponse\x12(\n" +
 	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12/\n" +
-	"\x05event\x18\x02 \x01(\v2\x19.rgs.v1.SystemWindowEventR\x05event\"\xdd\x01\n" +
+	"\x05event\x18\x02 \x01(\v2\x19.rgs.v1.SystemWindowEventR\x05event\"\xf8\x01\n" +
 	"\x1dListSystemWindowEventsRequest\x12'\n" +
 	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12!\n" +
 	"\fequipment_id\x18\x02 \x01(\tR\vequipmentId\x12\x1b\n" +
@@ -1184,11 +1298,18 @@ const file_rgs_v1_extensions_proto_rawDesc = "" +
 	"\ato_time\x18\x04 \x01(\tR\x06toTime\x12\x1b\n" +
 	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x06 \x01(\tR\tpageToken\"\xa5\x01\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\x12\x19\n" +
+	"\bgroup_id\x18\a \x01(\tR\agroupId\"\xa5\x01\n" +
 	"\x1eListSystemWindowEventsResponse\x12(\n" +
 	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x121\n" +
 	"\x06events\x18\x02 \x03(\v2\x19.rgs.v1.SystemWindowEventR\x06events\x12&\n" +
-	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken*\xe6\x01\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"l\n" +
+	"\x1eWatchSystemWindowEventsRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12!\n" +
+	"\fequipment_id\x18\x02 \x01(\tR\vequipmentId\"|\n" +
+	"\x1fWatchSystemWindowEventsResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12/\n" +
+	"\x05event\x18\x02 \x01(\v2\x19.rgs.v1.SystemWindowEventR\x05event*\xe6\x01\n" +
 	"\x14PromotionalAwardType\x12&\n" +
 	"\"PROMOTIONAL_AWARD_TYPE_UNSPECIFIED\x10\x00\x12#\n" +
 	"\x1fPROMOTIONAL_AWARD_TYPE_FREEPLAY\x10\x01\x12&\n" +
@@ -1205,10 +1326,11 @@ const file_rgs_v1_extensions_proto_rawDesc = "" +
 	"\x16RecordBonusTransaction\x12%.rgs.v1.RecordBonusTransactionRequest\x1a&.rgs.v1.RecordBonusTransactionResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/promotions/bonus-transactions\x12\xa1\x01\n" +
 	"\x1bListRecentBonusTransactions\x12*.rgs.v1.ListRecentBonusTransactionsRequest\x1a+.rgs.v1.ListRecentBonusTransactionsResponse\")\x82\xd3\xe4\x93\x02#\x12!/v1/promotions/bonus-transactions\x12\x89\x01\n" +
 	"\x16RecordPromotionalAward\x12%.rgs.v1.RecordPromotionalAwardRequest\x1a&.rgs.v1.RecordPromotionalAwardResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/promotions/awards\x12\x83\x01\n" +
-	"\x15ListPromotionalAwards\x12$.rgs.v1.ListPromotionalAwardsRequest\x1a%.rgs.v1.ListPromotionalAwardsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/promotions/awards2\xbc\x02\n" +
+	"\x15ListPromotionalAwards\x12$.rgs.v1.ListPromotionalAwardsRequest\x1a%.rgs.v1.ListPromotionalAwardsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/promotions/awards2\xaa\x03\n" +
 	"\x16UISystemOverlayService\x12\x92\x01\n" +
 	"\x17SubmitSystemWindowEvent\x12&.rgs.v1.SubmitSystemWindowEventRequest\x1a'.rgs.v1.SubmitSystemWindowEventResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/ui/system-window-events\x12\x8c\x01\n" +
-	"\x16ListSystemWindowEvents\x12%.rgs.v1.ListSystemWindowEventsRequest\x1a&.rgs.v1.ListSystemWindowEventsResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/ui/system-window-eventsB\x91\x01\n" +
+	"\x16ListSystemWindowEvents\x12%.rgs.v1.ListSystemWindowEventsRequest\x1a&.rgs.v1.ListSystemWindowEventsResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/ui/system-window-events\x12l\n" +
+	"\x17WatchSystemWindowEvents\x12&.rgs.v1.WatchSystemWindowEventsRequest\x1a'.rgs.v1.WatchSystemWindowEventsResponse0\x01B\x91\x01\n" +
 	"\n" +
 	"com.rgs.v1B\x0fExtensionsProtoP\x01Z9github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1;rgsv1\xa2\x02\x03RXX\xaa\x02\x06Rgs.V1\xca\x02\x06Rgs\\V1\xe2\x02\x12Rgs\\V1\\GPBMetadata\xea\x02\aRgs::V1b\x06proto3"
 
@@ -1225,7 +1347,7 @@ func file_rgs_v1_extensions_proto_rawDescGZIP() []byte {
 }
 
 var file_rgs_v1_extensions_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_rgs_v1_extensions_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_rgs_v1_extensions_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_rgs_v1_extensions_proto_goTypes = []any{
 	(PromotionalAwardType)(0),                   // 0: rgs.v1.PromotionalAwardType
 	(SystemWindowEventType)(0),                  // 1: rgs.v1.SystemWindowEventType
@@ -1244,53 +1366,60 @@ var file_rgs_v1_extensions_proto_goTypes = []any{
 	(*SubmitSystemWindowEventResponse)(nil),     // 14: rgs.v1.SubmitSystemWindowEventResponse
 	(*ListSystemWindowEventsRequest)(nil),       // 15: rgs.v1.ListSystemWindowEventsRequest
 	(*ListSystemWindowEventsResponse)(nil),      // 16: rgs.v1.ListSystemWindowEventsResponse
-	(*Money)(nil),                               // 17: rgs.v1.Money
-	(*RequestMeta)(nil),                         // 18: rgs.v1.RequestMeta
-	(*ResponseMeta)(nil),                        // 19: rgs.v1.ResponseMeta
+	(*WatchSystemWindowEventsRequest)(nil),      // 17: rgs.v1.WatchSystemWindowEventsRequest
+	(*WatchSystemWindowEventsResponse)(nil),     // 18: rgs.v1.WatchSystemWindowEventsResponse
+	(*Money)(nil),                               // 19: rgs.v1.Money
+	(*RequestMeta)(nil),                         // 20: rgs.v1.RequestMeta
+	(*ResponseMeta)(nil),                        // 21: rgs.v1.ResponseMeta
 }
 var file_rgs_v1_extensions_proto_depIdxs = []int32{
-	17, // 0: rgs.v1.BonusTransaction.amount:type_name -> rgs.v1.Money
+	19, // 0: rgs.v1.BonusTransaction.amount:type_name -> rgs.v1.Money
 	0,  // 1: rgs.v1.PromotionalAward.award_type:type_name -> rgs.v1.PromotionalAwardType
-	17, // 2: rgs.v1.PromotionalAward.amount:type_name -> rgs.v1.Money
+	19, // 2: rgs.v1.PromotionalAward.amount:type_name -> rgs.v1.Money
 	1,  // 3: rgs.v1.SystemWindowEvent.event_type:type_name -> rgs.v1.SystemWindowEventType
-	18, // 4: rgs.v1.RecordBonusTransactionRequest.meta:type_name -> rgs.v1.RequestMeta
+	20, // 4: rgs.v1.RecordBonusTransactionRequest.meta:type_name -> rgs.v1.RequestMeta
 	2,  // 5: rgs.v1.RecordBonusTransactionRequest.transaction:type_name -> rgs.v1.BonusTransaction
-	19, // 6: rgs.v1.RecordBonusTransactionResponse.meta:type_name -> rgs.v1.ResponseMeta
+	21, // 6: rgs.v1.RecordBonusTransactionResponse.meta:type_name -> rgs.v1.ResponseMeta
 	2,  // 7: rgs.v1.RecordBonusTransactionResponse.transaction:type_name -> rgs.v1.BonusTransaction
-	18, // 8: rgs.v1.ListRecentBonusTransactionsRequest.meta:type_name -> rgs.v1.RequestMeta
-	19, // 9: rgs.v1.ListRecentBonusTransactionsResponse.meta:type_name -> rgs.v1.ResponseMeta
+	20, // 8: rgs.v1.ListRecentBonusTransactionsRequest.meta:type_name -> rgs.v1.RequestMeta
+	21, // 9: rgs.v1.ListRecentBonusTransactionsResponse.meta:type_name -> rgs.v1.ResponseMeta
 	2,  // 10: rgs.v1.ListRecentBonusTransactionsResponse.transactions:type_name -> rgs.v1.BonusTransaction
-	18, // 11: rgs.v1.RecordPromotionalAwardRequest.meta:type_name -> rgs.v1.RequestMeta
+	20, // 11: rgs.v1.RecordPromotionalAwardRequest.meta:type_name -> rgs.v1.RequestMeta
 	3,  // 12: rgs.v1.RecordPromotionalAwardRequest.award:type_name -> rgs.v1.PromotionalAward
-	19, // 13: rgs.v1.RecordPromotionalAwardResponse.meta:type_name -> rgs.v1.ResponseMeta
+	21, // 13: rgs.v1.RecordPromotionalAwardResponse.meta:type_name -> rgs.v1.ResponseMeta
 	3,  // 14: rgs.v1.RecordPromotionalAwardResponse.award:type_name -> rgs.v1.PromotionalAward
-	18, // 15: rgs.v1.ListPromotionalAwardsRequest.meta:type_name -> rgs.v1.RequestMeta
-	19, // 16: rgs.v1.ListPromotionalAwardsResponse.meta:type_name -> rgs.v1.ResponseMeta
+	20, // 15: rgs.v1.ListPromotionalAwardsRequest.meta:type_name -> rgs.v1.RequestMeta
+	21, // 16: rgs.v1.ListPromotionalAwardsResponse.meta:type_name -> rgs.v1.ResponseMeta
 	3,  // 17: rgs.v1.ListPromotionalAwardsResponse.awards:type_name -> rgs.v1.PromotionalAward
-	18, // 18: rgs.v1.SubmitSystemWindowEventRequest.meta:type_name -> rgs.v1.RequestMeta
+	20, // 18: rgs.v1.SubmitSystemWindowEventRequest.meta:type_name -> rgs.v1.RequestMeta
 	4,  // 19: rgs.v1.SubmitSystemWindowEventRequest.event:type_name -> rgs.v1.SystemWindowEvent
-	19, // 20: rgs.v1.SubmitSystemWindowEventResponse.meta:type_name -> rgs.v1.ResponseMeta
+	21, // 20: rgs.v1.SubmitSystemWindowEventResponse.meta:type_name -> rgs.v1.ResponseMeta
 	4,  // 21: rgs.v1.SubmitSystemWindowEventResponse.event:type_name -> rgs.v1.SystemWindowEvent
-	18, // 22: rgs.v1.ListSystemWindowEventsRequest.meta:type_name -> rgs.v1.RequestMeta
-	19, // 23: rgs.v1.ListSystemWindowEventsResponse.meta:type_name -> rgs.v1.ResponseMeta
+	20, // 22: rgs.v1.ListSystemWindowEventsRequest.meta:type_name -> rgs.v1.RequestMeta
+	21, // 23: rgs.v1.ListSystemWindowEventsResponse.meta:type_name -> rgs.v1.ResponseMeta
 	4,  // 24: rgs.v1.ListSystemWindowEventsResponse.events:type_name -> rgs.v1.SystemWindowEvent
-	5,  // 25: rgs.v1.PromotionsService.RecordBonusTransaction:input_type -> rgs.v1.RecordBonusTransactionRequest
-	7,  // 26: rgs.v1.PromotionsService.ListRecentBonusTransactions:input_type -> rgs.v1.ListRecentBonusTransactionsRequest
-	9,  // 27: rgs.v1.PromotionsService.RecordPromotionalAward:input_type -> rgs.v1.RecordPromotionalAwardRequest
-	11, // 28: rgs.v1.PromotionsService.ListPromotionalAwards:input_type -> rgs.v1.ListPromotionalAwardsRequest
-	13, // 29: rgs.v1.UISystemOverlayService.SubmitSystemWindowEvent:input_type -> rgs.v1.SubmitSystemWindowEventRequest
-	15, // 30: rgs.v1.UISystemOverlayService.ListSystemWindowEvents:input_type -> rgs.v1.ListSystemWindowEventsRequest
-	6,  // 31: rgs.v1.PromotionsService.RecordBonusTransaction:output_type -> rgs.v1.RecordBonusTransactionResponse
-	8,  // 32: rgs.v1.PromotionsService.ListRecentBonusTransactions:output_type -> rgs.v1.ListRecentBonusTransactionsResponse
-	10, // 33: rgs.v1.PromotionsService.RecordPromotionalAward:output_type -> rgs.v1.RecordPromotionalAwardResponse
-	12, // 34: rgs.v1.PromotionsService.ListPromotionalAwards:output_type -> rgs.v1.ListPromotionalAwardsResponse
-	14, // 35: rgs.v1.UISystemOverlayService.SubmitSystemWindowEvent:output_type -> rgs.v1.SubmitSystemWindowEventResponse
-	16, // 36: rgs.v1.UISystemOverlayService.ListSystemWindowEvents:output_type -> rgs.v1.ListSystemWindowEventsResponse
-	31, // [31:37] is the sub-list for method output_type
-	25, // [25:31] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+	20, // 25: rgs.v1.WatchSystemWindowEventsRequest.meta:type_name -> rgs.v1.RequestMeta
+	21, // 26: rgs.v1.WatchSystemWindowEventsResponse.meta:type_name -> rgs.v1.ResponseMeta
+	4,  // 27: rgs.v1.WatchSystemWindowEventsResponse.event:type_name -> rgs.v1.SystemWindowEvent
+	5,  // 28: rgs.v1.PromotionsService.RecordBonusTransaction:input_type -> rgs.v1.RecordBonusTransactionRequest
+	7,  // 29: rgs.v1.PromotionsService.ListRecentBonusTransactions:input_type -> rgs.v1.ListRecentBonusTransactionsRequest
+	9,  // 30: rgs.v1.PromotionsService.RecordPromotionalAward:input_type -> rgs.v1.RecordPromotionalAwardRequest
+	11, // 31: rgs.v1.PromotionsService.ListPromotionalAwards:input_type -> rgs.v1.ListPromotionalAwardsRequest
+	13, // 32: rgs.v1.UISystemOverlayService.SubmitSystemWindowEvent:input_type -> rgs.v1.SubmitSystemWindowEventRequest
+	15, // 33: rgs.v1.UISystemOverlayService.ListSystemWindowEvents:input_type -> rgs.v1.ListSystemWindowEventsRequest
+	17, // 34: rgs.v1.UISystemOverlayService.WatchSystemWindowEvents:input_type -> rgs.v1.WatchSystemWindowEventsRequest
+	6,  // 35: rgs.v1.PromotionsService.RecordBonusTransaction:output_type -> rgs.v1.RecordBonusTransactionResponse
+	8,  // 36: rgs.v1.PromotionsService.ListRecentBonusTransactions:output_type -> rgs.v1.ListRecentBonusTransactionsResponse
+	10, // 37: rgs.v1.PromotionsService.RecordPromotionalAward:output_type -> rgs.v1.RecordPromotionalAwardResponse
+	12, // 38: rgs.v1.PromotionsService.ListPromotionalAwards:output_type -> rgs.v1.ListPromotionalAwardsResponse
+	14, // 39: rgs.v1.UISystemOverlayService.SubmitSystemWindowEvent:output_type -> rgs.v1.SubmitSystemWindowEventResponse
+	16, // 40: rgs.v1.UISystemOverlayService.ListSystemWindowEvents:output_type -> rgs.v1.ListSystemWindowEventsResponse
+	18, // 41: rgs.v1.UISystemOverlayService.WatchSystemWindowEvents:output_type -> rgs.v1.WatchSystemWindowEventsResponse
+	35, // [35:42] is the sub-list for method output_type
+	28, // [28:35] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_rgs_v1_extensions_proto_init() }
@@ -1306,7 +1435,7 @@ func file_rgs_v1_extensions_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rgs_v1_extensions_proto_rawDesc), len(file_rgs_v1_extensions_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   15,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
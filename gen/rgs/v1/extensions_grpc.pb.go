@@ -237,6 +237,7 @@ var PromotionsService_ServiceDesc = grpc.ServiceDesc{
 const (
 	UISystemOverlayService_SubmitSystemWindowEvent_FullMethodName = "/rgs.v1.UISystemOverlayService/SubmitSystemWindowEvent"
 	UISystemOverlayService_ListSystemWindowEvents_FullMethodName  = "/rgs.v1.UISystemOverlayService/ListSystemWindowEvents"
+	UISystemOverlayService_WatchSystemWindowEvents_FullMethodName = "/rgs.v1.UISystemOverlayService/WatchSystemWindowEvents"
 )
 
 // UISystemOverlayServiceClient is the client API for UISystemOverlayService service.
@@ -245,6 +246,8 @@ const (
 type UISystemOverlayServiceClient interface {
 	SubmitSystemWindowEvent(ctx context.Context, in *SubmitSystemWindowEventRequest, opts ...grpc.CallOption) (*SubmitSystemWindowEventResponse, error)
 	ListSystemWindowEvents(ctx context.Context, in *ListSystemWindowEventsRequest, opts ...grpc.CallOption) (*ListSystemWindowEventsResponse, error)
+	// gRPC only: pushes the system windows the RGS opens on a device.
+	WatchSystemWindowEvents(ctx context.Context, in *WatchSystemWindowEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchSystemWindowEventsResponse], error)
 }
 
 type uISystemOverlayServiceClient struct {
@@ -275,12 +278,33 @@ func (c *uISystemOverlayServiceClient) ListSystemWindowEvents(ctx context.Contex
 	return out, nil
 }
 
+func (c *uISystemOverlayServiceClient) WatchSystemWindowEvents(ctx context.Context, in *WatchSystemWindowEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchSystemWindowEventsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UISystemOverlayService_ServiceDesc.Streams[0], UISystemOverlayService_WatchSystemWindowEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchSystemWindowEventsRequest, WatchSystemWindowEventsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UISystemOverlayService_WatchSystemWindowEventsClient = grpc.ServerStreamingClient[WatchSystemWindowEventsResponse]
+
 // UISystemOverlayServiceServer is the server API for UISystemOverlayService service.
 // All implementations must embed UnimplementedUISystemOverlayServiceServer
 // for forward compatibility.
 type UISystemOverlayServiceServer interface {
 	SubmitSystemWindowEvent(context.Context, *SubmitSystemWindowEventRequest) (*SubmitSystemWindowEventResponse, error)
 	ListSystemWindowEvents(context.Context, *ListSystemWindowEventsRequest) (*ListSystemWindowEventsResponse, error)
+	// gRPC only: pushes the system windows the RGS opens on a device.
+	WatchSystemWindowEvents(*WatchSystemWindowEventsRequest, grpc.ServerStreamingServer[WatchSystemWindowEventsResponse]) error
 	mustEmbedUnimplementedUISystemOverlayServiceServer()
 }
 
@@ -297,6 +321,9 @@ func (UnimplementedUISystemOverlayServiceServer) SubmitSystemWindowEvent(context
 func (UnimplementedUISystemOverlayServiceServer) ListSystemWindowEvents(context.Context, *ListSystemWindowEventsRequest) (*ListSystemWindowEventsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListSystemWindowEvents not implemented")
 }
+func (UnimplementedUISystemOverlayServiceServer) WatchSystemWindowEvents(*WatchSystemWindowEventsRequest, grpc.ServerStreamingServer[WatchSystemWindowEventsResponse]) error {
+	return status.Error(codes.Unimplemented, "method WatchSystemWindowEvents not implemented")
+}
 func (UnimplementedUISystemOverlayServiceServer) mustEmbedUnimplementedUISystemOverlayServiceServer() {
 }
 func (UnimplementedUISystemOverlayServiceServer) testEmbeddedByValue() {}
@@ -355,6 +382,17 @@ func _UISystemOverlayService_ListSystemWindowEvents_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UISystemOverlayService_WatchSystemWindowEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchSystemWindowEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UISystemOverlayServiceServer).WatchSystemWindowEvents(m, &grpc.GenericServerStream[WatchSystemWindowEventsRequest, WatchSystemWindowEventsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UISystemOverlayService_WatchSystemWindowEventsServer = grpc.ServerStreamingServer[WatchSystemWindowEventsResponse]
+
 // UISystemOverlayService_ServiceDesc is the grpc.ServiceDesc for UISystemOverlayService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -371,6 +409,12 @@ var UISystemOverlayService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _UISystemOverlayService_ListSystemWindowEvents_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchSystemWindowEvents",
+			Handler:       _UISystemOverlayService_WatchSystemWindowEvents_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "rgs/v1/extensions.proto",
 }
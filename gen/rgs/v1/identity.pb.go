@@ -22,6 +22,63 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type PlayerIdentityStatus int32
+
+const (
+	PlayerIdentityStatus_PLAYER_IDENTITY_STATUS_UNSPECIFIED PlayerIdentityStatus = 0
+	// No other player shares the identity.
+	PlayerIdentityStatus_PLAYER_IDENTITY_STATUS_CLEAR PlayerIdentityStatus = 1
+	// Possible duplicate; player login is blocked until an operator decides.
+	PlayerIdentityStatus_PLAYER_IDENTITY_STATUS_PENDING_REVIEW PlayerIdentityStatus = 2
+	PlayerIdentityStatus_PLAYER_IDENTITY_STATUS_APPROVED       PlayerIdentityStatus = 3
+	PlayerIdentityStatus_PLAYER_IDENTITY_STATUS_REJECTED       PlayerIdentityStatus = 4
+)
+
+// Enum value maps for PlayerIdentityStatus.
+var (
+	PlayerIdentityStatus_name = map[int32]string{
+		0: "PLAYER_IDENTITY_STATUS_UNSPECIFIED",
+		1: "PLAYER_IDENTITY_STATUS_CLEAR",
+		2: "PLAYER_IDENTITY_STATUS_PENDING_REVIEW",
+		3: "PLAYER_IDENTITY_STATUS_APPROVED",
+		4: "PLAYER_IDENTITY_STATUS_REJECTED",
+	}
+	PlayerIdentityStatus_value = map[string]int32{
+		"PLAYER_IDENTITY_STATUS_UNSPECIFIED":    0,
+		"PLAYER_IDENTITY_STATUS_CLEAR":          1,
+		"PLAYER_IDENTITY_STATUS_PENDING_REVIEW": 2,
+		"PLAYER_IDENTITY_STATUS_APPROVED":       3,
+		"PLAYER_IDENTITY_STATUS_REJECTED":       4,
+	}
+)
+
+func (x PlayerIdentityStatus) Enum() *PlayerIdentityStatus {
+	p := new(PlayerIdentityStatus)
+	*p = x
+	return p
+}
+
+func (x PlayerIdentityStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlayerIdentityStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_rgs_v1_identity_proto_enumTypes[0].Descriptor()
+}
+
+func (PlayerIdentityStatus) Type() protoreflect.EnumType {
+	return &file_rgs_v1_identity_proto_enumTypes[0]
+}
+
+func (x PlayerIdentityStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlayerIdentityStatus.Descriptor instead.
+func (PlayerIdentityStatus) EnumDescriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{0}
+}
+
 type PlayerCredentials struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
@@ -126,6 +183,52 @@ func (x *OperatorCredentials) GetPassword() string {
 	return ""
 }
 
+// OIDCCredentials carry an ID token from the operator's external identity
+// provider. The operator actor and roles are taken from its claims.
+type OIDCCredentials struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IdToken       string                 `protobuf:"bytes,1,opt,name=id_token,json=idToken,proto3" json:"id_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OIDCCredentials) Reset() {
+	*x = OIDCCredentials{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OIDCCredentials) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCCredentials) ProtoMessage() {}
+
+func (x *OIDCCredentials) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCCredentials.ProtoReflect.Descriptor instead.
+func (*OIDCCredentials) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *OIDCCredentials) GetIdToken() string {
+	if x != nil {
+		return x.IdToken
+	}
+	return ""
+}
+
 type SessionToken struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
@@ -139,7 +242,7 @@ type SessionToken struct {
 
 func (x *SessionToken) Reset() {
 	*x = SessionToken{}
-	mi := &file_rgs_v1_identity_proto_msgTypes[2]
+	mi := &file_rgs_v1_identity_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -151,7 +254,7 @@ func (x *SessionToken) String() string {
 func (*SessionToken) ProtoMessage() {}
 
 func (x *SessionToken) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_identity_proto_msgTypes[2]
+	mi := &file_rgs_v1_identity_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -164,7 +267,7 @@ func (x *SessionToken) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SessionToken.ProtoReflect.Descriptor instead.
 func (*SessionToken) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{2}
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *SessionToken) GetAccessToken() string {
@@ -202,33 +305,34 @@ func (x *SessionToken) GetActor() *Actor {
 	return nil
 }
 
-type LoginRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	Meta  *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	// Types that are valid to be assigned to Credentials:
-	//
-	//	*LoginRequest_Player
-	//	*LoginRequest_Operator
-	Credentials   isLoginRequest_Credentials `protobuf_oneof:"credentials"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// PlayerIdentityDetails are the KYC attributes used for duplicate detection.
+// They are only used to derive hashes and are never stored.
+type PlayerIdentityDetails struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	FullName string                 `protobuf:"bytes,1,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	// YYYY-MM-DD.
+	DateOfBirth    string `protobuf:"bytes,2,opt,name=date_of_birth,json=dateOfBirth,proto3" json:"date_of_birth,omitempty"`
+	DocumentType   string `protobuf:"bytes,3,opt,name=document_type,json=documentType,proto3" json:"document_type,omitempty"`
+	DocumentNumber string `protobuf:"bytes,4,opt,name=document_number,json=documentNumber,proto3" json:"document_number,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *LoginRequest) Reset() {
-	*x = LoginRequest{}
-	mi := &file_rgs_v1_identity_proto_msgTypes[3]
+func (x *PlayerIdentityDetails) Reset() {
+	*x = PlayerIdentityDetails{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoginRequest) String() string {
+func (x *PlayerIdentityDetails) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginRequest) ProtoMessage() {}
+func (*PlayerIdentityDetails) ProtoMessage() {}
 
-func (x *LoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_identity_proto_msgTypes[3]
+func (x *PlayerIdentityDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -239,82 +343,63 @@ func (x *LoginRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
-func (*LoginRequest) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use PlayerIdentityDetails.ProtoReflect.Descriptor instead.
+func (*PlayerIdentityDetails) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *LoginRequest) GetMeta() *RequestMeta {
+func (x *PlayerIdentityDetails) GetFullName() string {
 	if x != nil {
-		return x.Meta
+		return x.FullName
 	}
-	return nil
+	return ""
 }
 
-func (x *LoginRequest) GetCredentials() isLoginRequest_Credentials {
+func (x *PlayerIdentityDetails) GetDateOfBirth() string {
 	if x != nil {
-		return x.Credentials
+		return x.DateOfBirth
 	}
-	return nil
+	return ""
 }
 
-func (x *LoginRequest) GetPlayer() *PlayerCredentials {
+func (x *PlayerIdentityDetails) GetDocumentType() string {
 	if x != nil {
-		if x, ok := x.Credentials.(*LoginRequest_Player); ok {
-			return x.Player
-		}
+		return x.DocumentType
 	}
-	return nil
+	return ""
 }
 
-func (x *LoginRequest) GetOperator() *OperatorCredentials {
+func (x *PlayerIdentityDetails) GetDocumentNumber() string {
 	if x != nil {
-		if x, ok := x.Credentials.(*LoginRequest_Operator); ok {
-			return x.Operator
-		}
+		return x.DocumentNumber
 	}
-	return nil
-}
-
-type isLoginRequest_Credentials interface {
-	isLoginRequest_Credentials()
-}
-
-type LoginRequest_Player struct {
-	Player *PlayerCredentials `protobuf:"bytes,2,opt,name=player,proto3,oneof"`
-}
-
-type LoginRequest_Operator struct {
-	Operator *OperatorCredentials `protobuf:"bytes,3,opt,name=operator,proto3,oneof"`
+	return ""
 }
 
-func (*LoginRequest_Player) isLoginRequest_Credentials() {}
-
-func (*LoginRequest_Operator) isLoginRequest_Credentials() {}
-
-type LoginResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Token         *SessionToken          `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+type PlayerIdentityMatch struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// "document", "name_dob", or "name_dob_transposed" (day and month swapped).
+	Reasons       []string `protobuf:"bytes,2,rep,name=reasons,proto3" json:"reasons,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LoginResponse) Reset() {
-	*x = LoginResponse{}
-	mi := &file_rgs_v1_identity_proto_msgTypes[4]
+func (x *PlayerIdentityMatch) Reset() {
+	*x = PlayerIdentityMatch{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoginResponse) String() string {
+func (x *PlayerIdentityMatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginResponse) ProtoMessage() {}
+func (*PlayerIdentityMatch) ProtoMessage() {}
 
-func (x *LoginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_identity_proto_msgTypes[4]
+func (x *PlayerIdentityMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -325,48 +410,53 @@ func (x *LoginResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
-func (*LoginResponse) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use PlayerIdentityMatch.ProtoReflect.Descriptor instead.
+func (*PlayerIdentityMatch) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *LoginResponse) GetMeta() *ResponseMeta {
+func (x *PlayerIdentityMatch) GetPlayerId() string {
 	if x != nil {
-		return x.Meta
+		return x.PlayerId
 	}
-	return nil
+	return ""
 }
 
-func (x *LoginResponse) GetToken() *SessionToken {
+func (x *PlayerIdentityMatch) GetReasons() []string {
 	if x != nil {
-		return x.Token
+		return x.Reasons
 	}
 	return nil
 }
 
-type LogoutRequest struct {
+type PlayerIdentityRecord struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Status        PlayerIdentityStatus   `protobuf:"varint,2,opt,name=status,proto3,enum=rgs.v1.PlayerIdentityStatus" json:"status,omitempty"`
+	Matches       []*PlayerIdentityMatch `protobuf:"bytes,3,rep,name=matches,proto3" json:"matches,omitempty"`
+	RegisteredAt  string                 `protobuf:"bytes,4,opt,name=registered_at,json=registeredAt,proto3" json:"registered_at,omitempty"`
+	ReviewedAt    string                 `protobuf:"bytes,5,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	ReviewedBy    string                 `protobuf:"bytes,6,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"`
+	ReviewNote    string                 `protobuf:"bytes,7,opt,name=review_note,json=reviewNote,proto3" json:"review_note,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LogoutRequest) Reset() {
-	*x = LogoutRequest{}
-	mi := &file_rgs_v1_identity_proto_msgTypes[5]
+func (x *PlayerIdentityRecord) Reset() {
+	*x = PlayerIdentityRecord{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogoutRequest) String() string {
+func (x *PlayerIdentityRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogoutRequest) ProtoMessage() {}
+func (*PlayerIdentityRecord) ProtoMessage() {}
 
-func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_identity_proto_msgTypes[5]
+func (x *PlayerIdentityRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -377,91 +467,87 @@ func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
-func (*LogoutRequest) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use PlayerIdentityRecord.ProtoReflect.Descriptor instead.
+func (*PlayerIdentityRecord) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *LogoutRequest) GetMeta() *RequestMeta {
+func (x *PlayerIdentityRecord) GetPlayerId() string {
 	if x != nil {
-		return x.Meta
+		return x.PlayerId
 	}
-	return nil
+	return ""
 }
 
-func (x *LogoutRequest) GetRefreshToken() string {
+func (x *PlayerIdentityRecord) GetStatus() PlayerIdentityStatus {
 	if x != nil {
-		return x.RefreshToken
+		return x.Status
 	}
-	return ""
-}
-
-type LogoutResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return PlayerIdentityStatus_PLAYER_IDENTITY_STATUS_UNSPECIFIED
 }
 
-func (x *LogoutResponse) Reset() {
-	*x = LogoutResponse{}
-	mi := &file_rgs_v1_identity_proto_msgTypes[6]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *PlayerIdentityRecord) GetMatches() []*PlayerIdentityMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
 }
 
-func (x *LogoutResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PlayerIdentityRecord) GetRegisteredAt() string {
+	if x != nil {
+		return x.RegisteredAt
+	}
+	return ""
 }
 
-func (*LogoutResponse) ProtoMessage() {}
-
-func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_identity_proto_msgTypes[6]
+func (x *PlayerIdentityRecord) GetReviewedAt() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ReviewedAt
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
-func (*LogoutResponse) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{6}
+func (x *PlayerIdentityRecord) GetReviewedBy() string {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return ""
 }
 
-func (x *LogoutResponse) GetMeta() *ResponseMeta {
+func (x *PlayerIdentityRecord) GetReviewNote() string {
 	if x != nil {
-		return x.Meta
+		return x.ReviewNote
 	}
-	return nil
+	return ""
 }
 
-type RefreshTokenRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+// WebAuthnCredential is a registered operator hardware key. The public key
+// is never returned.
+type WebAuthnCredential struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Base64url credential id as reported by the authenticator.
+	CredentialId  string `protobuf:"bytes,1,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
+	Label         string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	CreatedAt     string `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUsedAt    string `protobuf:"bytes,4,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RefreshTokenRequest) Reset() {
-	*x = RefreshTokenRequest{}
+func (x *WebAuthnCredential) Reset() {
+	*x = WebAuthnCredential{}
 	mi := &file_rgs_v1_identity_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RefreshTokenRequest) String() string {
+func (x *WebAuthnCredential) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshTokenRequest) ProtoMessage() {}
+func (*WebAuthnCredential) ProtoMessage() {}
 
-func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
+func (x *WebAuthnCredential) ProtoReflect() protoreflect.Message {
 	mi := &file_rgs_v1_identity_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -473,47 +559,76 @@ func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
-func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebAuthnCredential.ProtoReflect.Descriptor instead.
+func (*WebAuthnCredential) Descriptor() ([]byte, []int) {
 	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *RefreshTokenRequest) GetMeta() *RequestMeta {
+func (x *WebAuthnCredential) GetCredentialId() string {
 	if x != nil {
-		return x.Meta
+		return x.CredentialId
 	}
-	return nil
+	return ""
 }
 
-func (x *RefreshTokenRequest) GetRefreshToken() string {
+func (x *WebAuthnCredential) GetLabel() string {
 	if x != nil {
-		return x.RefreshToken
+		return x.Label
 	}
 	return ""
 }
 
-type RefreshTokenResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Token         *SessionToken          `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+func (x *WebAuthnCredential) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *WebAuthnCredential) GetLastUsedAt() string {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return ""
+}
+
+// KeyRotation records a JWT signing keyset taking effect on an instance,
+// either when it starts or when a refresh picks up a changed keyset. Key
+// material is never recorded.
+type KeyRotation struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	RotationId string                 `protobuf:"bytes,1,opt,name=rotation_id,json=rotationId,proto3" json:"rotation_id,omitempty"`
+	// SHA-256 over the active kid and every kid:secret pair, hex encoded.
+	Fingerprint string `protobuf:"bytes,2,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	// Fingerprint of the keyset in effect before; empty for the first record.
+	PreviousFingerprint string   `protobuf:"bytes,3,opt,name=previous_fingerprint,json=previousFingerprint,proto3" json:"previous_fingerprint,omitempty"`
+	ActiveKid           string   `protobuf:"bytes,4,opt,name=active_kid,json=activeKid,proto3" json:"active_kid,omitempty"`
+	KeyIds              []string `protobuf:"bytes,5,rep,name=key_ids,json=keyIds,proto3" json:"key_ids,omitempty"`
+	// "env", "file", or "command".
+	Source string `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`
+	// "startup" or "refresh".
+	Trigger       string `protobuf:"bytes,7,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	TriggeredBy   *Actor `protobuf:"bytes,8,opt,name=triggered_by,json=triggeredBy,proto3" json:"triggered_by,omitempty"`
+	InstanceId    string `protobuf:"bytes,9,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	RotatedAt     string `protobuf:"bytes,10,opt,name=rotated_at,json=rotatedAt,proto3" json:"rotated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RefreshTokenResponse) Reset() {
-	*x = RefreshTokenResponse{}
+func (x *KeyRotation) Reset() {
+	*x = KeyRotation{}
 	mi := &file_rgs_v1_identity_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RefreshTokenResponse) String() string {
+func (x *KeyRotation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshTokenResponse) ProtoMessage() {}
+func (*KeyRotation) ProtoMessage() {}
 
-func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
+func (x *KeyRotation) ProtoReflect() protoreflect.Message {
 	mi := &file_rgs_v1_identity_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -525,50 +640,2349 @@ func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshTokenResponse.ProtoReflect.Descriptor instead.
-func (*RefreshTokenResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use KeyRotation.ProtoReflect.Descriptor instead.
+func (*KeyRotation) Descriptor() ([]byte, []int) {
 	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *RefreshTokenResponse) GetMeta() *ResponseMeta {
+func (x *KeyRotation) GetRotationId() string {
 	if x != nil {
-		return x.Meta
+		return x.RotationId
 	}
-	return nil
+	return ""
+}
+
+func (x *KeyRotation) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *KeyRotation) GetPreviousFingerprint() string {
+	if x != nil {
+		return x.PreviousFingerprint
+	}
+	return ""
+}
+
+func (x *KeyRotation) GetActiveKid() string {
+	if x != nil {
+		return x.ActiveKid
+	}
+	return ""
+}
+
+func (x *KeyRotation) GetKeyIds() []string {
+	if x != nil {
+		return x.KeyIds
+	}
+	return nil
+}
+
+func (x *KeyRotation) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *KeyRotation) GetTrigger() string {
+	if x != nil {
+		return x.Trigger
+	}
+	return ""
+}
+
+func (x *KeyRotation) GetTriggeredBy() *Actor {
+	if x != nil {
+		return x.TriggeredBy
+	}
+	return nil
+}
+
+func (x *KeyRotation) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *KeyRotation) GetRotatedAt() string {
+	if x != nil {
+		return x.RotatedAt
+	}
+	return ""
+}
+
+// IdentitySession is an active refresh session. The refresh token itself is
+// never returned; session_id is the hex SHA-256 of it.
+type IdentitySession struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SessionId string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Actor     *Actor                 `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	// Refresh token first issued at the login this session descends from,
+	// hashed like session_id.
+	FamilyId      string `protobuf:"bytes,3,opt,name=family_id,json=familyId,proto3" json:"family_id,omitempty"`
+	CreatedAt     string `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     string `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IdentitySession) Reset() {
+	*x = IdentitySession{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdentitySession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentitySession) ProtoMessage() {}
+
+func (x *IdentitySession) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentitySession.ProtoReflect.Descriptor instead.
+func (*IdentitySession) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *IdentitySession) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *IdentitySession) GetActor() *Actor {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+func (x *IdentitySession) GetFamilyId() string {
+	if x != nil {
+		return x.FamilyId
+	}
+	return ""
+}
+
+func (x *IdentitySession) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *IdentitySession) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+// LoginAttempt is one Login or WebAuthn login, successful or not. ip and
+// user_agent are those observed by the server, falling back to
+// meta.source when the transport reports none.
+type LoginAttempt struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AttemptId string                 `protobuf:"bytes,1,opt,name=attempt_id,json=attemptId,proto3" json:"attempt_id,omitempty"`
+	Actor     *Actor                 `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	// "pin", "password", "oidc", or "webauthn".
+	Method        string     `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	Result        ResultCode `protobuf:"varint,4,opt,name=result,proto3,enum=rgs.v1.ResultCode" json:"result,omitempty"`
+	DenialReason  string     `protobuf:"bytes,5,opt,name=denial_reason,json=denialReason,proto3" json:"denial_reason,omitempty"`
+	Ip            string     `protobuf:"bytes,6,opt,name=ip,proto3" json:"ip,omitempty"`
+	UserAgent     string     `protobuf:"bytes,7,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	OccurredAt    string     `protobuf:"bytes,8,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginAttempt) Reset() {
+	*x = LoginAttempt{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginAttempt) ProtoMessage() {}
+
+func (x *LoginAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginAttempt.ProtoReflect.Descriptor instead.
+func (*LoginAttempt) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *LoginAttempt) GetAttemptId() string {
+	if x != nil {
+		return x.AttemptId
+	}
+	return ""
+}
+
+func (x *LoginAttempt) GetActor() *Actor {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+func (x *LoginAttempt) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *LoginAttempt) GetResult() ResultCode {
+	if x != nil {
+		return x.Result
+	}
+	return ResultCode_RESULT_CODE_UNSPECIFIED
+}
+
+func (x *LoginAttempt) GetDenialReason() string {
+	if x != nil {
+		return x.DenialReason
+	}
+	return ""
+}
+
+func (x *LoginAttempt) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *LoginAttempt) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *LoginAttempt) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+// IPAllowlist binds an operator or service actor to the client networks it
+// may log in and call RPCs from. An actor without entries is unrestricted.
+type IPAllowlist struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Actor *Actor                 `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+	// CIDR prefixes such as "10.0.0.0/8" or "2001:db8::/32".
+	Cidrs         []string `protobuf:"bytes,2,rep,name=cidrs,proto3" json:"cidrs,omitempty"`
+	UpdatedAt     string   `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IPAllowlist) Reset() {
+	*x = IPAllowlist{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IPAllowlist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IPAllowlist) ProtoMessage() {}
+
+func (x *IPAllowlist) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IPAllowlist.ProtoReflect.Descriptor instead.
+func (*IPAllowlist) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *IPAllowlist) GetActor() *Actor {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+func (x *IPAllowlist) GetCidrs() []string {
+	if x != nil {
+		return x.Cidrs
+	}
+	return nil
+}
+
+func (x *IPAllowlist) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type LoginRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Meta  *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// Types that are valid to be assigned to Credentials:
+	//
+	//	*LoginRequest_Player
+	//	*LoginRequest_Operator
+	//	*LoginRequest_Oidc
+	Credentials   isLoginRequest_Credentials `protobuf_oneof:"credentials"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginRequest) Reset() {
+	*x = LoginRequest{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginRequest) ProtoMessage() {}
+
+func (x *LoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
+func (*LoginRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *LoginRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *LoginRequest) GetCredentials() isLoginRequest_Credentials {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+func (x *LoginRequest) GetPlayer() *PlayerCredentials {
+	if x != nil {
+		if x, ok := x.Credentials.(*LoginRequest_Player); ok {
+			return x.Player
+		}
+	}
+	return nil
+}
+
+func (x *LoginRequest) GetOperator() *OperatorCredentials {
+	if x != nil {
+		if x, ok := x.Credentials.(*LoginRequest_Operator); ok {
+			return x.Operator
+		}
+	}
+	return nil
+}
+
+func (x *LoginRequest) GetOidc() *OIDCCredentials {
+	if x != nil {
+		if x, ok := x.Credentials.(*LoginRequest_Oidc); ok {
+			return x.Oidc
+		}
+	}
+	return nil
+}
+
+type isLoginRequest_Credentials interface {
+	isLoginRequest_Credentials()
+}
+
+type LoginRequest_Player struct {
+	Player *PlayerCredentials `protobuf:"bytes,2,opt,name=player,proto3,oneof"`
+}
+
+type LoginRequest_Operator struct {
+	Operator *OperatorCredentials `protobuf:"bytes,3,opt,name=operator,proto3,oneof"`
+}
+
+type LoginRequest_Oidc struct {
+	Oidc *OIDCCredentials `protobuf:"bytes,4,opt,name=oidc,proto3,oneof"`
+}
+
+func (*LoginRequest_Player) isLoginRequest_Credentials() {}
+
+func (*LoginRequest_Operator) isLoginRequest_Credentials() {}
+
+func (*LoginRequest_Oidc) isLoginRequest_Credentials() {}
+
+type LoginResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Token         *SessionToken          `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginResponse) Reset() {
+	*x = LoginResponse{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginResponse) ProtoMessage() {}
+
+func (x *LoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
+func (*LoginResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *LoginResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *LoginResponse) GetToken() *SessionToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+type LogoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutRequest) Reset() {
+	*x = LogoutRequest{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutRequest) ProtoMessage() {}
+
+func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *LogoutRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *LogoutRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type LogoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutResponse) Reset() {
+	*x = LogoutResponse{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutResponse) ProtoMessage() {}
+
+func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
+func (*LogoutResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *LogoutResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type RefreshTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshTokenRequest) Reset() {
+	*x = RefreshTokenRequest{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenRequest) ProtoMessage() {}
+
+func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RefreshTokenRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *RefreshTokenRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type RefreshTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Token         *SessionToken          `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshTokenResponse) Reset() {
+	*x = RefreshTokenResponse{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenResponse) ProtoMessage() {}
+
+func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenResponse.ProtoReflect.Descriptor instead.
+func (*RefreshTokenResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RefreshTokenResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
 }
 
 func (x *RefreshTokenResponse) GetToken() *SessionToken {
 	if x != nil {
-		return x.Token
+		return x.Token
+	}
+	return nil
+}
+
+type SetCredentialRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Meta           *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Actor          *Actor                 `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	CredentialHash string                 `protobuf:"bytes,3,opt,name=credential_hash,json=credentialHash,proto3" json:"credential_hash,omitempty"`
+	Reason         string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetCredentialRequest) Reset() {
+	*x = SetCredentialRequest{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCredentialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCredentialRequest) ProtoMessage() {}
+
+func (x *SetCredentialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCredentialRequest.ProtoReflect.Descriptor instead.
+func (*SetCredentialRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SetCredentialRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *SetCredentialRequest) GetActor() *Actor {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+func (x *SetCredentialRequest) GetCredentialHash() string {
+	if x != nil {
+		return x.CredentialHash
+	}
+	return ""
+}
+
+func (x *SetCredentialRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SetCredentialResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCredentialResponse) Reset() {
+	*x = SetCredentialResponse{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCredentialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCredentialResponse) ProtoMessage() {}
+
+func (x *SetCredentialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCredentialResponse.ProtoReflect.Descriptor instead.
+func (*SetCredentialResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SetCredentialResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+// ChangeCredentialRequest changes the password (or, for a player, the PIN)
+// of meta.actor.
+type ChangeCredentialRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Meta            *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	CurrentPassword string                 `protobuf:"bytes,2,opt,name=current_password,json=currentPassword,proto3" json:"current_password,omitempty"`
+	NewPassword     string                 `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ChangeCredentialRequest) Reset() {
+	*x = ChangeCredentialRequest{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeCredentialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeCredentialRequest) ProtoMessage() {}
+
+func (x *ChangeCredentialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeCredentialRequest.ProtoReflect.Descriptor instead.
+func (*ChangeCredentialRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ChangeCredentialRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ChangeCredentialRequest) GetCurrentPassword() string {
+	if x != nil {
+		return x.CurrentPassword
+	}
+	return ""
+}
+
+func (x *ChangeCredentialRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ChangeCredentialResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Meta  *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// When the new password expires under the password policy; empty when
+	// passwords do not expire.
+	ExpiresAt     string `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeCredentialResponse) Reset() {
+	*x = ChangeCredentialResponse{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeCredentialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeCredentialResponse) ProtoMessage() {}
+
+func (x *ChangeCredentialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeCredentialResponse.ProtoReflect.Descriptor instead.
+func (*ChangeCredentialResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ChangeCredentialResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ChangeCredentialResponse) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type DisableCredentialRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Actor         *Actor                 `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableCredentialRequest) Reset() {
+	*x = DisableCredentialRequest{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableCredentialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableCredentialRequest) ProtoMessage() {}
+
+func (x *DisableCredentialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableCredentialRequest.ProtoReflect.Descriptor instead.
+func (*DisableCredentialRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DisableCredentialRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *DisableCredentialRequest) GetActor() *Actor {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+func (x *DisableCredentialRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type DisableCredentialResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableCredentialResponse) Reset() {
+	*x = DisableCredentialResponse{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableCredentialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableCredentialResponse) ProtoMessage() {}
+
+func (x *DisableCredentialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableCredentialResponse.ProtoReflect.Descriptor instead.
+func (*DisableCredentialResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DisableCredentialResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type EnableCredentialRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Actor         *Actor                 `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnableCredentialRequest) Reset() {
+	*x = EnableCredentialRequest{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnableCredentialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableCredentialRequest) ProtoMessage() {}
+
+func (x *EnableCredentialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableCredentialRequest.ProtoReflect.Descriptor instead.
+func (*EnableCredentialRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *EnableCredentialRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *EnableCredentialRequest) GetActor() *Actor {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+func (x *EnableCredentialRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type EnableCredentialResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnableCredentialResponse) Reset() {
+	*x = EnableCredentialResponse{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnableCredentialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableCredentialResponse) ProtoMessage() {}
+
+func (x *EnableCredentialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableCredentialResponse.ProtoReflect.Descriptor instead.
+func (*EnableCredentialResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *EnableCredentialResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type LockoutStatus struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Actor          *Actor                 `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+	FailedAttempts int32                  `protobuf:"varint,2,opt,name=failed_attempts,json=failedAttempts,proto3" json:"failed_attempts,omitempty"`
+	Locked         bool                   `protobuf:"varint,3,opt,name=locked,proto3" json:"locked,omitempty"`
+	LockedUntil    string                 `protobuf:"bytes,4,opt,name=locked_until,json=lockedUntil,proto3" json:"locked_until,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LockoutStatus) Reset() {
+	*x = LockoutStatus{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LockoutStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockoutStatus) ProtoMessage() {}
+
+func (x *LockoutStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockoutStatus.ProtoReflect.Descriptor instead.
+func (*LockoutStatus) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *LockoutStatus) GetActor() *Actor {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+func (x *LockoutStatus) GetFailedAttempts() int32 {
+	if x != nil {
+		return x.FailedAttempts
+	}
+	return 0
+}
+
+func (x *LockoutStatus) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+func (x *LockoutStatus) GetLockedUntil() string {
+	if x != nil {
+		return x.LockedUntil
+	}
+	return ""
+}
+
+type GetLockoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Actor         *Actor                 `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLockoutRequest) Reset() {
+	*x = GetLockoutRequest{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLockoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLockoutRequest) ProtoMessage() {}
+
+func (x *GetLockoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLockoutRequest.ProtoReflect.Descriptor instead.
+func (*GetLockoutRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetLockoutRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GetLockoutRequest) GetActor() *Actor {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+type GetLockoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Status        *LockoutStatus         `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLockoutResponse) Reset() {
+	*x = GetLockoutResponse{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLockoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLockoutResponse) ProtoMessage() {}
+
+func (x *GetLockoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLockoutResponse.ProtoReflect.Descriptor instead.
+func (*GetLockoutResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetLockoutResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GetLockoutResponse) GetStatus() *LockoutStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type ResetLockoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Actor         *Actor                 `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetLockoutRequest) Reset() {
+	*x = ResetLockoutRequest{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetLockoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetLockoutRequest) ProtoMessage() {}
+
+func (x *ResetLockoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetLockoutRequest.ProtoReflect.Descriptor instead.
+func (*ResetLockoutRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ResetLockoutRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ResetLockoutRequest) GetActor() *Actor {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+func (x *ResetLockoutRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ResetLockoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Status        *LockoutStatus         `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetLockoutResponse) Reset() {
+	*x = ResetLockoutResponse{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetLockoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetLockoutResponse) ProtoMessage() {}
+
+func (x *ResetLockoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetLockoutResponse.ProtoReflect.Descriptor instead.
+func (*ResetLockoutResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ResetLockoutResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ResetLockoutResponse) GetStatus() *LockoutStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type RegisterPlayerIdentityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Details       *PlayerIdentityDetails `protobuf:"bytes,3,opt,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterPlayerIdentityRequest) Reset() {
+	*x = RegisterPlayerIdentityRequest{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterPlayerIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterPlayerIdentityRequest) ProtoMessage() {}
+
+func (x *RegisterPlayerIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterPlayerIdentityRequest.ProtoReflect.Descriptor instead.
+func (*RegisterPlayerIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RegisterPlayerIdentityRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *RegisterPlayerIdentityRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *RegisterPlayerIdentityRequest) GetDetails() *PlayerIdentityDetails {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type RegisterPlayerIdentityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Record        *PlayerIdentityRecord  `protobuf:"bytes,2,opt,name=record,proto3" json:"record,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterPlayerIdentityResponse) Reset() {
+	*x = RegisterPlayerIdentityResponse{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterPlayerIdentityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterPlayerIdentityResponse) ProtoMessage() {}
+
+func (x *RegisterPlayerIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterPlayerIdentityResponse.ProtoReflect.Descriptor instead.
+func (*RegisterPlayerIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RegisterPlayerIdentityResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *RegisterPlayerIdentityResponse) GetRecord() *PlayerIdentityRecord {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+type ListPlayerIdentityReviewsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Meta  *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// Defaults to PLAYER_IDENTITY_STATUS_PENDING_REVIEW.
+	Status        PlayerIdentityStatus `protobuf:"varint,2,opt,name=status,proto3,enum=rgs.v1.PlayerIdentityStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlayerIdentityReviewsRequest) Reset() {
+	*x = ListPlayerIdentityReviewsRequest{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlayerIdentityReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlayerIdentityReviewsRequest) ProtoMessage() {}
+
+func (x *ListPlayerIdentityReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlayerIdentityReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListPlayerIdentityReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListPlayerIdentityReviewsRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListPlayerIdentityReviewsRequest) GetStatus() PlayerIdentityStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PlayerIdentityStatus_PLAYER_IDENTITY_STATUS_UNSPECIFIED
+}
+
+type ListPlayerIdentityReviewsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Meta          *ResponseMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Records       []*PlayerIdentityRecord `protobuf:"bytes,2,rep,name=records,proto3" json:"records,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlayerIdentityReviewsResponse) Reset() {
+	*x = ListPlayerIdentityReviewsResponse{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlayerIdentityReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlayerIdentityReviewsResponse) ProtoMessage() {}
+
+func (x *ListPlayerIdentityReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlayerIdentityReviewsResponse.ProtoReflect.Descriptor instead.
+func (*ListPlayerIdentityReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListPlayerIdentityReviewsResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListPlayerIdentityReviewsResponse) GetRecords() []*PlayerIdentityRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type ResolvePlayerIdentityReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Approve       bool                   `protobuf:"varint,3,opt,name=approve,proto3" json:"approve,omitempty"`
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolvePlayerIdentityReviewRequest) Reset() {
+	*x = ResolvePlayerIdentityReviewRequest{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolvePlayerIdentityReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolvePlayerIdentityReviewRequest) ProtoMessage() {}
+
+func (x *ResolvePlayerIdentityReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolvePlayerIdentityReviewRequest.ProtoReflect.Descriptor instead.
+func (*ResolvePlayerIdentityReviewRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ResolvePlayerIdentityReviewRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ResolvePlayerIdentityReviewRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *ResolvePlayerIdentityReviewRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *ResolvePlayerIdentityReviewRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ResolvePlayerIdentityReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Record        *PlayerIdentityRecord  `protobuf:"bytes,2,opt,name=record,proto3" json:"record,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolvePlayerIdentityReviewResponse) Reset() {
+	*x = ResolvePlayerIdentityReviewResponse{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolvePlayerIdentityReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolvePlayerIdentityReviewResponse) ProtoMessage() {}
+
+func (x *ResolvePlayerIdentityReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolvePlayerIdentityReviewResponse.ProtoReflect.Descriptor instead.
+func (*ResolvePlayerIdentityReviewResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ResolvePlayerIdentityReviewResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ResolvePlayerIdentityReviewResponse) GetRecord() *PlayerIdentityRecord {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+// BeginWebAuthnRegistrationRequest starts registering a key for the calling
+// operator.
+type BeginWebAuthnRegistrationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginWebAuthnRegistrationRequest) Reset() {
+	*x = BeginWebAuthnRegistrationRequest{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginWebAuthnRegistrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginWebAuthnRegistrationRequest) ProtoMessage() {}
+
+func (x *BeginWebAuthnRegistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginWebAuthnRegistrationRequest.ProtoReflect.Descriptor instead.
+func (*BeginWebAuthnRegistrationRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *BeginWebAuthnRegistrationRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+// BeginWebAuthnRegistrationResponse carries the values for
+// navigator.credentials.create. Attestation is "none", user verification is
+// required, and the supported algorithms are ES256 (-7) and EdDSA (-8).
+type BeginWebAuthnRegistrationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Meta  *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// Base64url challenge, valid until expires_at.
+	Challenge string `protobuf:"bytes,2,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	RpId      string `protobuf:"bytes,3,opt,name=rp_id,json=rpId,proto3" json:"rp_id,omitempty"`
+	RpName    string `protobuf:"bytes,4,opt,name=rp_name,json=rpName,proto3" json:"rp_name,omitempty"`
+	// Base64url user handle derived from the operator id.
+	UserId               string   `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ExcludeCredentialIds []string `protobuf:"bytes,6,rep,name=exclude_credential_ids,json=excludeCredentialIds,proto3" json:"exclude_credential_ids,omitempty"`
+	Algorithms           []int32  `protobuf:"varint,7,rep,packed,name=algorithms,proto3" json:"algorithms,omitempty"`
+	ExpiresAt            string   `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *BeginWebAuthnRegistrationResponse) Reset() {
+	*x = BeginWebAuthnRegistrationResponse{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginWebAuthnRegistrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginWebAuthnRegistrationResponse) ProtoMessage() {}
+
+func (x *BeginWebAuthnRegistrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginWebAuthnRegistrationResponse.ProtoReflect.Descriptor instead.
+func (*BeginWebAuthnRegistrationResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *BeginWebAuthnRegistrationResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *BeginWebAuthnRegistrationResponse) GetChallenge() string {
+	if x != nil {
+		return x.Challenge
+	}
+	return ""
+}
+
+func (x *BeginWebAuthnRegistrationResponse) GetRpId() string {
+	if x != nil {
+		return x.RpId
+	}
+	return ""
+}
+
+func (x *BeginWebAuthnRegistrationResponse) GetRpName() string {
+	if x != nil {
+		return x.RpName
+	}
+	return ""
+}
+
+func (x *BeginWebAuthnRegistrationResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BeginWebAuthnRegistrationResponse) GetExcludeCredentialIds() []string {
+	if x != nil {
+		return x.ExcludeCredentialIds
+	}
+	return nil
+}
+
+func (x *BeginWebAuthnRegistrationResponse) GetAlgorithms() []int32 {
+	if x != nil {
+		return x.Algorithms
+	}
+	return nil
+}
+
+func (x *BeginWebAuthnRegistrationResponse) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type FinishWebAuthnRegistrationRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Meta              *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	CredentialId      string                 `protobuf:"bytes,2,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
+	ClientDataJson    []byte                 `protobuf:"bytes,3,opt,name=client_data_json,json=clientDataJson,proto3" json:"client_data_json,omitempty"`
+	AttestationObject []byte                 `protobuf:"bytes,4,opt,name=attestation_object,json=attestationObject,proto3" json:"attestation_object,omitempty"`
+	Label             string                 `protobuf:"bytes,5,opt,name=label,proto3" json:"label,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *FinishWebAuthnRegistrationRequest) Reset() {
+	*x = FinishWebAuthnRegistrationRequest{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishWebAuthnRegistrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishWebAuthnRegistrationRequest) ProtoMessage() {}
+
+func (x *FinishWebAuthnRegistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishWebAuthnRegistrationRequest.ProtoReflect.Descriptor instead.
+func (*FinishWebAuthnRegistrationRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *FinishWebAuthnRegistrationRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *FinishWebAuthnRegistrationRequest) GetCredentialId() string {
+	if x != nil {
+		return x.CredentialId
+	}
+	return ""
+}
+
+func (x *FinishWebAuthnRegistrationRequest) GetClientDataJson() []byte {
+	if x != nil {
+		return x.ClientDataJson
+	}
+	return nil
+}
+
+func (x *FinishWebAuthnRegistrationRequest) GetAttestationObject() []byte {
+	if x != nil {
+		return x.AttestationObject
+	}
+	return nil
+}
+
+func (x *FinishWebAuthnRegistrationRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+type FinishWebAuthnRegistrationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Credential    *WebAuthnCredential    `protobuf:"bytes,2,opt,name=credential,proto3" json:"credential,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinishWebAuthnRegistrationResponse) Reset() {
+	*x = FinishWebAuthnRegistrationResponse{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishWebAuthnRegistrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishWebAuthnRegistrationResponse) ProtoMessage() {}
+
+func (x *FinishWebAuthnRegistrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishWebAuthnRegistrationResponse.ProtoReflect.Descriptor instead.
+func (*FinishWebAuthnRegistrationResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *FinishWebAuthnRegistrationResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *FinishWebAuthnRegistrationResponse) GetCredential() *WebAuthnCredential {
+	if x != nil {
+		return x.Credential
+	}
+	return nil
+}
+
+// BeginWebAuthnLoginRequest names the operator in meta.actor.
+type BeginWebAuthnLoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginWebAuthnLoginRequest) Reset() {
+	*x = BeginWebAuthnLoginRequest{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginWebAuthnLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginWebAuthnLoginRequest) ProtoMessage() {}
+
+func (x *BeginWebAuthnLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginWebAuthnLoginRequest.ProtoReflect.Descriptor instead.
+func (*BeginWebAuthnLoginRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *BeginWebAuthnLoginRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+// BeginWebAuthnLoginResponse carries the values for
+// navigator.credentials.get.
+type BeginWebAuthnLoginResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Meta               *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Challenge          string                 `protobuf:"bytes,2,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	RpId               string                 `protobuf:"bytes,3,opt,name=rp_id,json=rpId,proto3" json:"rp_id,omitempty"`
+	AllowCredentialIds []string               `protobuf:"bytes,4,rep,name=allow_credential_ids,json=allowCredentialIds,proto3" json:"allow_credential_ids,omitempty"`
+	ExpiresAt          string                 `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *BeginWebAuthnLoginResponse) Reset() {
+	*x = BeginWebAuthnLoginResponse{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginWebAuthnLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginWebAuthnLoginResponse) ProtoMessage() {}
+
+func (x *BeginWebAuthnLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginWebAuthnLoginResponse.ProtoReflect.Descriptor instead.
+func (*BeginWebAuthnLoginResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *BeginWebAuthnLoginResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *BeginWebAuthnLoginResponse) GetChallenge() string {
+	if x != nil {
+		return x.Challenge
+	}
+	return ""
+}
+
+func (x *BeginWebAuthnLoginResponse) GetRpId() string {
+	if x != nil {
+		return x.RpId
+	}
+	return ""
+}
+
+func (x *BeginWebAuthnLoginResponse) GetAllowCredentialIds() []string {
+	if x != nil {
+		return x.AllowCredentialIds
+	}
+	return nil
+}
+
+func (x *BeginWebAuthnLoginResponse) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type FinishWebAuthnLoginRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Meta              *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	CredentialId      string                 `protobuf:"bytes,2,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
+	ClientDataJson    []byte                 `protobuf:"bytes,3,opt,name=client_data_json,json=clientDataJson,proto3" json:"client_data_json,omitempty"`
+	AuthenticatorData []byte                 `protobuf:"bytes,4,opt,name=authenticator_data,json=authenticatorData,proto3" json:"authenticator_data,omitempty"`
+	Signature         []byte                 `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *FinishWebAuthnLoginRequest) Reset() {
+	*x = FinishWebAuthnLoginRequest{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishWebAuthnLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishWebAuthnLoginRequest) ProtoMessage() {}
+
+func (x *FinishWebAuthnLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishWebAuthnLoginRequest.ProtoReflect.Descriptor instead.
+func (*FinishWebAuthnLoginRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *FinishWebAuthnLoginRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *FinishWebAuthnLoginRequest) GetCredentialId() string {
+	if x != nil {
+		return x.CredentialId
+	}
+	return ""
+}
+
+func (x *FinishWebAuthnLoginRequest) GetClientDataJson() []byte {
+	if x != nil {
+		return x.ClientDataJson
+	}
+	return nil
+}
+
+func (x *FinishWebAuthnLoginRequest) GetAuthenticatorData() []byte {
+	if x != nil {
+		return x.AuthenticatorData
+	}
+	return nil
+}
+
+func (x *FinishWebAuthnLoginRequest) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type FinishWebAuthnLoginResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Token         *SessionToken          `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinishWebAuthnLoginResponse) Reset() {
+	*x = FinishWebAuthnLoginResponse{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishWebAuthnLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishWebAuthnLoginResponse) ProtoMessage() {}
+
+func (x *FinishWebAuthnLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishWebAuthnLoginResponse.ProtoReflect.Descriptor instead.
+func (*FinishWebAuthnLoginResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *FinishWebAuthnLoginResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *FinishWebAuthnLoginResponse) GetToken() *SessionToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+type ListKeyRotationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Meta  *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// Newest first; defaults to 50, capped at 500.
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListKeyRotationsRequest) Reset() {
+	*x = ListKeyRotationsRequest{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKeyRotationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKeyRotationsRequest) ProtoMessage() {}
+
+func (x *ListKeyRotationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKeyRotationsRequest.ProtoReflect.Descriptor instead.
+func (*ListKeyRotationsRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListKeyRotationsRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
 	}
 	return nil
 }
 
-type SetCredentialRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Meta           *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Actor          *Actor                 `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
-	CredentialHash string                 `protobuf:"bytes,3,opt,name=credential_hash,json=credentialHash,proto3" json:"credential_hash,omitempty"`
-	Reason         string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *ListKeyRotationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
 }
 
-func (x *SetCredentialRequest) Reset() {
-	*x = SetCredentialRequest{}
-	mi := &file_rgs_v1_identity_proto_msgTypes[9]
+type ListKeyRotationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Rotations     []*KeyRotation         `protobuf:"bytes,2,rep,name=rotations,proto3" json:"rotations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListKeyRotationsResponse) Reset() {
+	*x = ListKeyRotationsResponse{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetCredentialRequest) String() string {
+func (x *ListKeyRotationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetCredentialRequest) ProtoMessage() {}
+func (*ListKeyRotationsResponse) ProtoMessage() {}
 
-func (x *SetCredentialRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_identity_proto_msgTypes[9]
+func (x *ListKeyRotationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -579,61 +2993,213 @@ func (x *SetCredentialRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetCredentialRequest.ProtoReflect.Descriptor instead.
-func (*SetCredentialRequest) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ListKeyRotationsResponse.ProtoReflect.Descriptor instead.
+func (*ListKeyRotationsResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *SetCredentialRequest) GetMeta() *RequestMeta {
+func (x *ListKeyRotationsResponse) GetMeta() *ResponseMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *SetCredentialRequest) GetActor() *Actor {
+func (x *ListKeyRotationsResponse) GetRotations() []*KeyRotation {
+	if x != nil {
+		return x.Rotations
+	}
+	return nil
+}
+
+type ListSessionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Meta  *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// Optional; lists every actor's sessions when unset.
+	Actor         *Actor `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsRequest) ProtoMessage() {}
+
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListSessionsRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListSessionsRequest) GetActor() *Actor {
 	if x != nil {
 		return x.Actor
 	}
 	return nil
 }
 
-func (x *SetCredentialRequest) GetCredentialHash() string {
+type ListSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Sessions      []*IdentitySession     `protobuf:"bytes,2,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsResponse) ProtoMessage() {}
+
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[48]
 	if x != nil {
-		return x.CredentialHash
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListSessionsResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListSessionsResponse) GetSessions() []*IdentitySession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type RevokeSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionRequest) Reset() {
+	*x = RevokeSessionRequest{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionRequest) ProtoMessage() {}
+
+func (x *RevokeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RevokeSessionRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *RevokeSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
 	}
 	return ""
 }
 
-func (x *SetCredentialRequest) GetReason() string {
+func (x *RevokeSessionRequest) GetReason() string {
 	if x != nil {
 		return x.Reason
 	}
 	return ""
 }
 
-type SetCredentialResponse struct {
+type RevokeSessionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Session       *IdentitySession       `protobuf:"bytes,2,opt,name=session,proto3" json:"session,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetCredentialResponse) Reset() {
-	*x = SetCredentialResponse{}
-	mi := &file_rgs_v1_identity_proto_msgTypes[10]
+func (x *RevokeSessionResponse) Reset() {
+	*x = RevokeSessionResponse{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetCredentialResponse) String() string {
+func (x *RevokeSessionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetCredentialResponse) ProtoMessage() {}
+func (*RevokeSessionResponse) ProtoMessage() {}
 
-func (x *SetCredentialResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_identity_proto_msgTypes[10]
+func (x *RevokeSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -644,19 +3210,26 @@ func (x *SetCredentialResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetCredentialResponse.ProtoReflect.Descriptor instead.
-func (*SetCredentialResponse) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use RevokeSessionResponse.ProtoReflect.Descriptor instead.
+func (*RevokeSessionResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *SetCredentialResponse) GetMeta() *ResponseMeta {
+func (x *RevokeSessionResponse) GetMeta() *ResponseMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-type DisableCredentialRequest struct {
+func (x *RevokeSessionResponse) GetSession() *IdentitySession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+type RevokeAllSessionsForActorRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
 	Actor         *Actor                 `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
@@ -665,21 +3238,139 @@ type DisableCredentialRequest struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DisableCredentialRequest) Reset() {
-	*x = DisableCredentialRequest{}
-	mi := &file_rgs_v1_identity_proto_msgTypes[11]
+func (x *RevokeAllSessionsForActorRequest) Reset() {
+	*x = RevokeAllSessionsForActorRequest{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAllSessionsForActorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAllSessionsForActorRequest) ProtoMessage() {}
+
+func (x *RevokeAllSessionsForActorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAllSessionsForActorRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAllSessionsForActorRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RevokeAllSessionsForActorRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *RevokeAllSessionsForActorRequest) GetActor() *Actor {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+func (x *RevokeAllSessionsForActorRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RevokeAllSessionsForActorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	RevokedCount  int32                  `protobuf:"varint,2,opt,name=revoked_count,json=revokedCount,proto3" json:"revoked_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAllSessionsForActorResponse) Reset() {
+	*x = RevokeAllSessionsForActorResponse{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAllSessionsForActorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAllSessionsForActorResponse) ProtoMessage() {}
+
+func (x *RevokeAllSessionsForActorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAllSessionsForActorResponse.ProtoReflect.Descriptor instead.
+func (*RevokeAllSessionsForActorResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RevokeAllSessionsForActorResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *RevokeAllSessionsForActorResponse) GetRevokedCount() int32 {
+	if x != nil {
+		return x.RevokedCount
+	}
+	return 0
+}
+
+type ListLoginHistoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Meta  *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// Optional; lists every actor's attempts when unset.
+	Actor *Actor `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	// Optional RFC 3339 bounds on occurred_at; since is inclusive, until
+	// exclusive.
+	Since         string `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"`
+	Until         string `protobuf:"bytes,4,opt,name=until,proto3" json:"until,omitempty"`
+	PageSize      int32  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLoginHistoryRequest) Reset() {
+	*x = ListLoginHistoryRequest{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DisableCredentialRequest) String() string {
+func (x *ListLoginHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DisableCredentialRequest) ProtoMessage() {}
+func (*ListLoginHistoryRequest) ProtoMessage() {}
 
-func (x *DisableCredentialRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_identity_proto_msgTypes[11]
+func (x *ListLoginHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -690,100 +3381,78 @@ func (x *DisableCredentialRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DisableCredentialRequest.ProtoReflect.Descriptor instead.
-func (*DisableCredentialRequest) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ListLoginHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListLoginHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *DisableCredentialRequest) GetMeta() *RequestMeta {
+func (x *ListLoginHistoryRequest) GetMeta() *RequestMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *DisableCredentialRequest) GetActor() *Actor {
+func (x *ListLoginHistoryRequest) GetActor() *Actor {
 	if x != nil {
 		return x.Actor
 	}
 	return nil
 }
 
-func (x *DisableCredentialRequest) GetReason() string {
+func (x *ListLoginHistoryRequest) GetSince() string {
 	if x != nil {
-		return x.Reason
+		return x.Since
 	}
 	return ""
 }
 
-type DisableCredentialResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *DisableCredentialResponse) Reset() {
-	*x = DisableCredentialResponse{}
-	mi := &file_rgs_v1_identity_proto_msgTypes[12]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *DisableCredentialResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DisableCredentialResponse) ProtoMessage() {}
-
-func (x *DisableCredentialResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_identity_proto_msgTypes[12]
+func (x *ListLoginHistoryRequest) GetUntil() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Until
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use DisableCredentialResponse.ProtoReflect.Descriptor instead.
-func (*DisableCredentialResponse) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{12}
+func (x *ListLoginHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
 }
 
-func (x *DisableCredentialResponse) GetMeta() *ResponseMeta {
+func (x *ListLoginHistoryRequest) GetPageToken() string {
 	if x != nil {
-		return x.Meta
+		return x.PageToken
 	}
-	return nil
+	return ""
 }
 
-type EnableCredentialRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Actor         *Actor                 `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
-	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+type ListLoginHistoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Meta  *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// Newest first.
+	Attempts      []*LoginAttempt `protobuf:"bytes,2,rep,name=attempts,proto3" json:"attempts,omitempty"`
+	NextPageToken string          `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EnableCredentialRequest) Reset() {
-	*x = EnableCredentialRequest{}
-	mi := &file_rgs_v1_identity_proto_msgTypes[13]
+func (x *ListLoginHistoryResponse) Reset() {
+	*x = ListLoginHistoryResponse{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EnableCredentialRequest) String() string {
+func (x *ListLoginHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnableCredentialRequest) ProtoMessage() {}
+func (*ListLoginHistoryResponse) ProtoMessage() {}
 
-func (x *EnableCredentialRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_identity_proto_msgTypes[13]
+func (x *ListLoginHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -794,54 +3463,55 @@ func (x *EnableCredentialRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnableCredentialRequest.ProtoReflect.Descriptor instead.
-func (*EnableCredentialRequest) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ListLoginHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListLoginHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *EnableCredentialRequest) GetMeta() *RequestMeta {
+func (x *ListLoginHistoryResponse) GetMeta() *ResponseMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *EnableCredentialRequest) GetActor() *Actor {
+func (x *ListLoginHistoryResponse) GetAttempts() []*LoginAttempt {
 	if x != nil {
-		return x.Actor
+		return x.Attempts
 	}
 	return nil
 }
 
-func (x *EnableCredentialRequest) GetReason() string {
+func (x *ListLoginHistoryResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.Reason
+		return x.NextPageToken
 	}
 	return ""
 }
 
-type EnableCredentialResponse struct {
+type GetIPAllowlistRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Actor         *Actor                 `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EnableCredentialResponse) Reset() {
-	*x = EnableCredentialResponse{}
-	mi := &file_rgs_v1_identity_proto_msgTypes[14]
+func (x *GetIPAllowlistRequest) Reset() {
+	*x = GetIPAllowlistRequest{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EnableCredentialResponse) String() string {
+func (x *GetIPAllowlistRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnableCredentialResponse) ProtoMessage() {}
+func (*GetIPAllowlistRequest) ProtoMessage() {}
 
-func (x *EnableCredentialResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_identity_proto_msgTypes[14]
+func (x *GetIPAllowlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -852,43 +3522,48 @@ func (x *EnableCredentialResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnableCredentialResponse.ProtoReflect.Descriptor instead.
-func (*EnableCredentialResponse) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use GetIPAllowlistRequest.ProtoReflect.Descriptor instead.
+func (*GetIPAllowlistRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *EnableCredentialResponse) GetMeta() *ResponseMeta {
+func (x *GetIPAllowlistRequest) GetMeta() *RequestMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-type LockoutStatus struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Actor          *Actor                 `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
-	FailedAttempts int32                  `protobuf:"varint,2,opt,name=failed_attempts,json=failedAttempts,proto3" json:"failed_attempts,omitempty"`
-	Locked         bool                   `protobuf:"varint,3,opt,name=locked,proto3" json:"locked,omitempty"`
-	LockedUntil    string                 `protobuf:"bytes,4,opt,name=locked_until,json=lockedUntil,proto3" json:"locked_until,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *GetIPAllowlistRequest) GetActor() *Actor {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
 }
 
-func (x *LockoutStatus) Reset() {
-	*x = LockoutStatus{}
-	mi := &file_rgs_v1_identity_proto_msgTypes[15]
+type GetIPAllowlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Allowlist     *IPAllowlist           `protobuf:"bytes,2,opt,name=allowlist,proto3" json:"allowlist,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIPAllowlistResponse) Reset() {
+	*x = GetIPAllowlistResponse{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LockoutStatus) String() string {
+func (x *GetIPAllowlistResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LockoutStatus) ProtoMessage() {}
+func (*GetIPAllowlistResponse) ProtoMessage() {}
 
-func (x *LockoutStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_identity_proto_msgTypes[15]
+func (x *GetIPAllowlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -899,62 +3574,50 @@ func (x *LockoutStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LockoutStatus.ProtoReflect.Descriptor instead.
-func (*LockoutStatus) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GetIPAllowlistResponse.ProtoReflect.Descriptor instead.
+func (*GetIPAllowlistResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *LockoutStatus) GetActor() *Actor {
+func (x *GetIPAllowlistResponse) GetMeta() *ResponseMeta {
 	if x != nil {
-		return x.Actor
+		return x.Meta
 	}
 	return nil
 }
 
-func (x *LockoutStatus) GetFailedAttempts() int32 {
-	if x != nil {
-		return x.FailedAttempts
-	}
-	return 0
-}
-
-func (x *LockoutStatus) GetLocked() bool {
-	if x != nil {
-		return x.Locked
-	}
-	return false
-}
-
-func (x *LockoutStatus) GetLockedUntil() string {
+func (x *GetIPAllowlistResponse) GetAllowlist() *IPAllowlist {
 	if x != nil {
-		return x.LockedUntil
+		return x.Allowlist
 	}
-	return ""
+	return nil
 }
 
-type GetLockoutRequest struct {
+type SetIPAllowlistRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
 	Actor         *Actor                 `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	Cidrs         []string               `protobuf:"bytes,3,rep,name=cidrs,proto3" json:"cidrs,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLockoutRequest) Reset() {
-	*x = GetLockoutRequest{}
-	mi := &file_rgs_v1_identity_proto_msgTypes[16]
+func (x *SetIPAllowlistRequest) Reset() {
+	*x = SetIPAllowlistRequest{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLockoutRequest) String() string {
+func (x *SetIPAllowlistRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLockoutRequest) ProtoMessage() {}
+func (*SetIPAllowlistRequest) ProtoMessage() {}
 
-func (x *GetLockoutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_identity_proto_msgTypes[16]
+func (x *SetIPAllowlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -965,48 +3628,62 @@ func (x *GetLockoutRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLockoutRequest.ProtoReflect.Descriptor instead.
-func (*GetLockoutRequest) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use SetIPAllowlistRequest.ProtoReflect.Descriptor instead.
+func (*SetIPAllowlistRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *GetLockoutRequest) GetMeta() *RequestMeta {
+func (x *SetIPAllowlistRequest) GetMeta() *RequestMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *GetLockoutRequest) GetActor() *Actor {
+func (x *SetIPAllowlistRequest) GetActor() *Actor {
 	if x != nil {
 		return x.Actor
 	}
 	return nil
 }
 
-type GetLockoutResponse struct {
+func (x *SetIPAllowlistRequest) GetCidrs() []string {
+	if x != nil {
+		return x.Cidrs
+	}
+	return nil
+}
+
+func (x *SetIPAllowlistRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SetIPAllowlistResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Status        *LockoutStatus         `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Allowlist     *IPAllowlist           `protobuf:"bytes,2,opt,name=allowlist,proto3" json:"allowlist,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLockoutResponse) Reset() {
-	*x = GetLockoutResponse{}
-	mi := &file_rgs_v1_identity_proto_msgTypes[17]
+func (x *SetIPAllowlistResponse) Reset() {
+	*x = SetIPAllowlistResponse{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLockoutResponse) String() string {
+func (x *SetIPAllowlistResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLockoutResponse) ProtoMessage() {}
+func (*SetIPAllowlistResponse) ProtoMessage() {}
 
-func (x *GetLockoutResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_identity_proto_msgTypes[17]
+func (x *SetIPAllowlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1017,49 +3694,52 @@ func (x *GetLockoutResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLockoutResponse.ProtoReflect.Descriptor instead.
-func (*GetLockoutResponse) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use SetIPAllowlistResponse.ProtoReflect.Descriptor instead.
+func (*SetIPAllowlistResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *GetLockoutResponse) GetMeta() *ResponseMeta {
+func (x *SetIPAllowlistResponse) GetMeta() *ResponseMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *GetLockoutResponse) GetStatus() *LockoutStatus {
+func (x *SetIPAllowlistResponse) GetAllowlist() *IPAllowlist {
 	if x != nil {
-		return x.Status
+		return x.Allowlist
 	}
 	return nil
 }
 
-type ResetLockoutRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Actor         *Actor                 `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
-	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+type AssumeActorRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Meta  *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// The player to act as.
+	Actor  *Actor `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Token lifetime; 0 means the maximum of 15 minutes.
+	TtlSeconds    int32 `protobuf:"varint,4,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResetLockoutRequest) Reset() {
-	*x = ResetLockoutRequest{}
-	mi := &file_rgs_v1_identity_proto_msgTypes[18]
+func (x *AssumeActorRequest) Reset() {
+	*x = AssumeActorRequest{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResetLockoutRequest) String() string {
+func (x *AssumeActorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetLockoutRequest) ProtoMessage() {}
+func (*AssumeActorRequest) ProtoMessage() {}
 
-func (x *ResetLockoutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_identity_proto_msgTypes[18]
+func (x *AssumeActorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1070,55 +3750,63 @@ func (x *ResetLockoutRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetLockoutRequest.ProtoReflect.Descriptor instead.
-func (*ResetLockoutRequest) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use AssumeActorRequest.ProtoReflect.Descriptor instead.
+func (*AssumeActorRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *ResetLockoutRequest) GetMeta() *RequestMeta {
+func (x *AssumeActorRequest) GetMeta() *RequestMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *ResetLockoutRequest) GetActor() *Actor {
+func (x *AssumeActorRequest) GetActor() *Actor {
 	if x != nil {
 		return x.Actor
 	}
 	return nil
 }
 
-func (x *ResetLockoutRequest) GetReason() string {
+func (x *AssumeActorRequest) GetReason() string {
 	if x != nil {
 		return x.Reason
 	}
 	return ""
 }
 
-type ResetLockoutResponse struct {
+func (x *AssumeActorRequest) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type AssumeActorResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Status        *LockoutStatus         `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	AccessToken   string                 `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResetLockoutResponse) Reset() {
-	*x = ResetLockoutResponse{}
-	mi := &file_rgs_v1_identity_proto_msgTypes[19]
+func (x *AssumeActorResponse) Reset() {
+	*x = AssumeActorResponse{}
+	mi := &file_rgs_v1_identity_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResetLockoutResponse) String() string {
+func (x *AssumeActorResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetLockoutResponse) ProtoMessage() {}
+func (*AssumeActorResponse) ProtoMessage() {}
 
-func (x *ResetLockoutResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_identity_proto_msgTypes[19]
+func (x *AssumeActorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_identity_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1129,23 +3817,30 @@ func (x *ResetLockoutResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetLockoutResponse.ProtoReflect.Descriptor instead.
-func (*ResetLockoutResponse) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use AssumeActorResponse.ProtoReflect.Descriptor instead.
+func (*AssumeActorResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_identity_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *ResetLockoutResponse) GetMeta() *ResponseMeta {
+func (x *AssumeActorResponse) GetMeta() *ResponseMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *ResetLockoutResponse) GetStatus() *LockoutStatus {
+func (x *AssumeActorResponse) GetAccessToken() string {
 	if x != nil {
-		return x.Status
+		return x.AccessToken
 	}
-	return nil
+	return ""
+}
+
+func (x *AssumeActorResponse) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
 }
 
 var File_rgs_v1_identity_proto protoreflect.FileDescriptor
@@ -1159,7 +3854,9 @@ const file_rgs_v1_identity_proto_rawDesc = "" +
 	"\x13OperatorCredentials\x12\x1f\n" +
 	"\voperator_id\x18\x01 \x01(\tR\n" +
 	"operatorId\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"\xb9\x01\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\",\n" +
+	"\x0fOIDCCredentials\x12\x19\n" +
+	"\bid_token\x18\x01 \x01(\tR\aidToken\"\xb9\x01\n" +
 	"\fSessionToken\x12!\n" +
 	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
 	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12\x1d\n" +
@@ -1167,11 +3864,80 @@ const file_rgs_v1_identity_proto_rawDesc = "" +
 	"token_type\x18\x03 \x01(\tR\ttokenType\x12\x1d\n" +
 	"\n" +
 	"expires_at\x18\x04 \x01(\tR\texpiresAt\x12#\n" +
-	"\x05actor\x18\x05 \x01(\v2\r.rgs.v1.ActorR\x05actor\"\xb6\x01\n" +
+	"\x05actor\x18\x05 \x01(\v2\r.rgs.v1.ActorR\x05actor\"\xa6\x01\n" +
+	"\x15PlayerIdentityDetails\x12\x1b\n" +
+	"\tfull_name\x18\x01 \x01(\tR\bfullName\x12\"\n" +
+	"\rdate_of_birth\x18\x02 \x01(\tR\vdateOfBirth\x12#\n" +
+	"\rdocument_type\x18\x03 \x01(\tR\fdocumentType\x12'\n" +
+	"\x0fdocument_number\x18\x04 \x01(\tR\x0edocumentNumber\"L\n" +
+	"\x13PlayerIdentityMatch\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x18\n" +
+	"\areasons\x18\x02 \x03(\tR\areasons\"\xa8\x02\n" +
+	"\x14PlayerIdentityRecord\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x124\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1c.rgs.v1.PlayerIdentityStatusR\x06status\x125\n" +
+	"\amatches\x18\x03 \x03(\v2\x1b.rgs.v1.PlayerIdentityMatchR\amatches\x12#\n" +
+	"\rregistered_at\x18\x04 \x01(\tR\fregisteredAt\x12\x1f\n" +
+	"\vreviewed_at\x18\x05 \x01(\tR\n" +
+	"reviewedAt\x12\x1f\n" +
+	"\vreviewed_by\x18\x06 \x01(\tR\n" +
+	"reviewedBy\x12\x1f\n" +
+	"\vreview_note\x18\a \x01(\tR\n" +
+	"reviewNote\"\x90\x01\n" +
+	"\x12WebAuthnCredential\x12#\n" +
+	"\rcredential_id\x18\x01 \x01(\tR\fcredentialId\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\tR\tcreatedAt\x12 \n" +
+	"\flast_used_at\x18\x04 \x01(\tR\n" +
+	"lastUsedAt\"\xdf\x02\n" +
+	"\vKeyRotation\x12\x1f\n" +
+	"\vrotation_id\x18\x01 \x01(\tR\n" +
+	"rotationId\x12 \n" +
+	"\vfingerprint\x18\x02 \x01(\tR\vfingerprint\x121\n" +
+	"\x14previous_fingerprint\x18\x03 \x01(\tR\x13previousFingerprint\x12\x1d\n" +
+	"\n" +
+	"active_kid\x18\x04 \x01(\tR\tactiveKid\x12\x17\n" +
+	"\akey_ids\x18\x05 \x03(\tR\x06keyIds\x12\x16\n" +
+	"\x06source\x18\x06 \x01(\tR\x06source\x12\x18\n" +
+	"\atrigger\x18\a \x01(\tR\atrigger\x120\n" +
+	"\ftriggered_by\x18\b \x01(\v2\r.rgs.v1.ActorR\vtriggeredBy\x12\x1f\n" +
+	"\vinstance_id\x18\t \x01(\tR\n" +
+	"instanceId\x12\x1d\n" +
+	"\n" +
+	"rotated_at\x18\n" +
+	" \x01(\tR\trotatedAt\"\xb0\x01\n" +
+	"\x0fIdentitySession\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12#\n" +
+	"\x05actor\x18\x02 \x01(\v2\r.rgs.v1.ActorR\x05actor\x12\x1b\n" +
+	"\tfamily_id\x18\x03 \x01(\tR\bfamilyId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\tR\texpiresAt\"\x8b\x02\n" +
+	"\fLoginAttempt\x12\x1d\n" +
+	"\n" +
+	"attempt_id\x18\x01 \x01(\tR\tattemptId\x12#\n" +
+	"\x05actor\x18\x02 \x01(\v2\r.rgs.v1.ActorR\x05actor\x12\x16\n" +
+	"\x06method\x18\x03 \x01(\tR\x06method\x12*\n" +
+	"\x06result\x18\x04 \x01(\x0e2\x12.rgs.v1.ResultCodeR\x06result\x12#\n" +
+	"\rdenial_reason\x18\x05 \x01(\tR\fdenialReason\x12\x0e\n" +
+	"\x02ip\x18\x06 \x01(\tR\x02ip\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\a \x01(\tR\tuserAgent\x12\x1f\n" +
+	"\voccurred_at\x18\b \x01(\tR\n" +
+	"occurredAt\"g\n" +
+	"\vIPAllowlist\x12#\n" +
+	"\x05actor\x18\x01 \x01(\v2\r.rgs.v1.ActorR\x05actor\x12\x14\n" +
+	"\x05cidrs\x18\x02 \x03(\tR\x05cidrs\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\tR\tupdatedAt\"\xe5\x01\n" +
 	"\fLoginRequest\x12'\n" +
 	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x123\n" +
 	"\x06player\x18\x02 \x01(\v2\x19.rgs.v1.PlayerCredentialsH\x00R\x06player\x129\n" +
-	"\boperator\x18\x03 \x01(\v2\x1b.rgs.v1.OperatorCredentialsH\x00R\boperatorB\r\n" +
+	"\boperator\x18\x03 \x01(\v2\x1b.rgs.v1.OperatorCredentialsH\x00R\boperator\x12-\n" +
+	"\x04oidc\x18\x04 \x01(\v2\x17.rgs.v1.OIDCCredentialsH\x00R\x04oidcB\r\n" +
 	"\vcredentials\"e\n" +
 	"\rLoginResponse\x12(\n" +
 	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12*\n" +
@@ -1193,7 +3959,15 @@ const file_rgs_v1_identity_proto_rawDesc = "" +
 	"\x0fcredential_hash\x18\x03 \x01(\tR\x0ecredentialHash\x12\x16\n" +
 	"\x06reason\x18\x04 \x01(\tR\x06reason\"A\n" +
 	"\x15SetCredentialResponse\x12(\n" +
-	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\"\x80\x01\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\"\x90\x01\n" +
+	"\x17ChangeCredentialRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12)\n" +
+	"\x10current_password\x18\x02 \x01(\tR\x0fcurrentPassword\x12!\n" +
+	"\fnew_password\x18\x03 \x01(\tR\vnewPassword\"c\n" +
+	"\x18ChangeCredentialResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\tR\texpiresAt\"\x80\x01\n" +
 	"\x18DisableCredentialRequest\x12'\n" +
 	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12#\n" +
 	"\x05actor\x18\x02 \x01(\v2\r.rgs.v1.ActorR\x05actor\x12\x16\n" +
@@ -1223,17 +3997,167 @@ const file_rgs_v1_identity_proto_rawDesc = "" +
 	"\x06reason\x18\x03 \x01(\tR\x06reason\"o\n" +
 	"\x14ResetLockoutResponse\x12(\n" +
 	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12-\n" +
-	"\x06status\x18\x02 \x01(\v2\x15.rgs.v1.LockoutStatusR\x06status2\x85\a\n" +
+	"\x06status\x18\x02 \x01(\v2\x15.rgs.v1.LockoutStatusR\x06status\"\x9e\x01\n" +
+	"\x1dRegisterPlayerIdentityRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\x127\n" +
+	"\adetails\x18\x03 \x01(\v2\x1d.rgs.v1.PlayerIdentityDetailsR\adetails\"\x80\x01\n" +
+	"\x1eRegisterPlayerIdentityResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x124\n" +
+	"\x06record\x18\x02 \x01(\v2\x1c.rgs.v1.PlayerIdentityRecordR\x06record\"\x81\x01\n" +
+	" ListPlayerIdentityReviewsRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x124\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1c.rgs.v1.PlayerIdentityStatusR\x06status\"\x85\x01\n" +
+	"!ListPlayerIdentityReviewsResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x126\n" +
+	"\arecords\x18\x02 \x03(\v2\x1c.rgs.v1.PlayerIdentityRecordR\arecords\"\x98\x01\n" +
+	"\"ResolvePlayerIdentityReviewRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\x12\x18\n" +
+	"\aapprove\x18\x03 \x01(\bR\aapprove\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\"\x85\x01\n" +
+	"#ResolvePlayerIdentityReviewResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x124\n" +
+	"\x06record\x18\x02 \x01(\v2\x1c.rgs.v1.PlayerIdentityRecordR\x06record\"K\n" +
+	" BeginWebAuthnRegistrationRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\"\xa7\x02\n" +
+	"!BeginWebAuthnRegistrationResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12\x1c\n" +
+	"\tchallenge\x18\x02 \x01(\tR\tchallenge\x12\x13\n" +
+	"\x05rp_id\x18\x03 \x01(\tR\x04rpId\x12\x17\n" +
+	"\arp_name\x18\x04 \x01(\tR\x06rpName\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\tR\x06userId\x124\n" +
+	"\x16exclude_credential_ids\x18\x06 \x03(\tR\x14excludeCredentialIds\x12\x1e\n" +
+	"\n" +
+	"algorithms\x18\a \x03(\x05R\n" +
+	"algorithms\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\tR\texpiresAt\"\xe0\x01\n" +
+	"!FinishWebAuthnRegistrationRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12#\n" +
+	"\rcredential_id\x18\x02 \x01(\tR\fcredentialId\x12(\n" +
+	"\x10client_data_json\x18\x03 \x01(\fR\x0eclientDataJson\x12-\n" +
+	"\x12attestation_object\x18\x04 \x01(\fR\x11attestationObject\x12\x14\n" +
+	"\x05label\x18\x05 \x01(\tR\x05label\"\x8a\x01\n" +
+	"\"FinishWebAuthnRegistrationResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12:\n" +
+	"\n" +
+	"credential\x18\x02 \x01(\v2\x1a.rgs.v1.WebAuthnCredentialR\n" +
+	"credential\"D\n" +
+	"\x19BeginWebAuthnLoginRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\"\xca\x01\n" +
+	"\x1aBeginWebAuthnLoginResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12\x1c\n" +
+	"\tchallenge\x18\x02 \x01(\tR\tchallenge\x12\x13\n" +
+	"\x05rp_id\x18\x03 \x01(\tR\x04rpId\x120\n" +
+	"\x14allow_credential_ids\x18\x04 \x03(\tR\x12allowCredentialIds\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\tR\texpiresAt\"\xe1\x01\n" +
+	"\x1aFinishWebAuthnLoginRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12#\n" +
+	"\rcredential_id\x18\x02 \x01(\tR\fcredentialId\x12(\n" +
+	"\x10client_data_json\x18\x03 \x01(\fR\x0eclientDataJson\x12-\n" +
+	"\x12authenticator_data\x18\x04 \x01(\fR\x11authenticatorData\x12\x1c\n" +
+	"\tsignature\x18\x05 \x01(\fR\tsignature\"s\n" +
+	"\x1bFinishWebAuthnLoginResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12*\n" +
+	"\x05token\x18\x02 \x01(\v2\x14.rgs.v1.SessionTokenR\x05token\"X\n" +
+	"\x17ListKeyRotationsRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"w\n" +
+	"\x18ListKeyRotationsResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x121\n" +
+	"\trotations\x18\x02 \x03(\v2\x13.rgs.v1.KeyRotationR\trotations\"c\n" +
+	"\x13ListSessionsRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12#\n" +
+	"\x05actor\x18\x02 \x01(\v2\r.rgs.v1.ActorR\x05actor\"u\n" +
+	"\x14ListSessionsResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x123\n" +
+	"\bsessions\x18\x02 \x03(\v2\x17.rgs.v1.IdentitySessionR\bsessions\"v\n" +
+	"\x14RevokeSessionRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"t\n" +
+	"\x15RevokeSessionResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x121\n" +
+	"\asession\x18\x02 \x01(\v2\x17.rgs.v1.IdentitySessionR\asession\"\x88\x01\n" +
+	" RevokeAllSessionsForActorRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12#\n" +
+	"\x05actor\x18\x02 \x01(\v2\r.rgs.v1.ActorR\x05actor\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"r\n" +
+	"!RevokeAllSessionsForActorResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12#\n" +
+	"\rrevoked_count\x18\x02 \x01(\x05R\frevokedCount\"\xcf\x01\n" +
+	"\x17ListLoginHistoryRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12#\n" +
+	"\x05actor\x18\x02 \x01(\v2\r.rgs.v1.ActorR\x05actor\x12\x14\n" +
+	"\x05since\x18\x03 \x01(\tR\x05since\x12\x14\n" +
+	"\x05until\x18\x04 \x01(\tR\x05until\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\"\x9e\x01\n" +
+	"\x18ListLoginHistoryResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x120\n" +
+	"\battempts\x18\x02 \x03(\v2\x14.rgs.v1.LoginAttemptR\battempts\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"e\n" +
+	"\x15GetIPAllowlistRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12#\n" +
+	"\x05actor\x18\x02 \x01(\v2\r.rgs.v1.ActorR\x05actor\"u\n" +
+	"\x16GetIPAllowlistResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x121\n" +
+	"\tallowlist\x18\x02 \x01(\v2\x13.rgs.v1.IPAllowlistR\tallowlist\"\x93\x01\n" +
+	"\x15SetIPAllowlistRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12#\n" +
+	"\x05actor\x18\x02 \x01(\v2\r.rgs.v1.ActorR\x05actor\x12\x14\n" +
+	"\x05cidrs\x18\x03 \x03(\tR\x05cidrs\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"u\n" +
+	"\x16SetIPAllowlistResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x121\n" +
+	"\tallowlist\x18\x02 \x01(\v2\x13.rgs.v1.IPAllowlistR\tallowlist\"\x9b\x01\n" +
+	"\x12AssumeActorRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12#\n" +
+	"\x05actor\x18\x02 \x01(\v2\r.rgs.v1.ActorR\x05actor\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1f\n" +
+	"\vttl_seconds\x18\x04 \x01(\x05R\n" +
+	"ttlSeconds\"\x81\x01\n" +
+	"\x13AssumeActorResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12!\n" +
+	"\faccess_token\x18\x02 \x01(\tR\vaccessToken\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\tR\texpiresAt*\xd5\x01\n" +
+	"\x14PlayerIdentityStatus\x12&\n" +
+	"\"PLAYER_IDENTITY_STATUS_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cPLAYER_IDENTITY_STATUS_CLEAR\x10\x01\x12)\n" +
+	"%PLAYER_IDENTITY_STATUS_PENDING_REVIEW\x10\x02\x12#\n" +
+	"\x1fPLAYER_IDENTITY_STATUS_APPROVED\x10\x03\x12#\n" +
+	"\x1fPLAYER_IDENTITY_STATUS_REJECTED\x10\x042\xbc\x18\n" +
 	"\x0fIdentityService\x12S\n" +
 	"\x05Login\x12\x14.rgs.v1.LoginRequest\x1a\x15.rgs.v1.LoginResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/identity/login\x12W\n" +
 	"\x06Logout\x12\x15.rgs.v1.LogoutRequest\x1a\x16.rgs.v1.LogoutResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/identity/logout\x12j\n" +
 	"\fRefreshToken\x12\x1b.rgs.v1.RefreshTokenRequest\x1a\x1c.rgs.v1.RefreshTokenResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/identity/refresh\x12u\n" +
-	"\rSetCredential\x12\x1c.rgs.v1.SetCredentialRequest\x1a\x1d.rgs.v1.SetCredentialResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/identity/credentials:set\x12\x85\x01\n" +
+	"\rSetCredential\x12\x1c.rgs.v1.SetCredentialRequest\x1a\x1d.rgs.v1.SetCredentialResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/identity/credentials:set\x12\x81\x01\n" +
+	"\x10ChangeCredential\x12\x1f.rgs.v1.ChangeCredentialRequest\x1a .rgs.v1.ChangeCredentialResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/identity/credentials:change\x12\x85\x01\n" +
 	"\x11DisableCredential\x12 .rgs.v1.DisableCredentialRequest\x1a!.rgs.v1.DisableCredentialResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/identity/credentials:disable\x12\x81\x01\n" +
 	"\x10EnableCredential\x12\x1f.rgs.v1.EnableCredentialRequest\x1a .rgs.v1.EnableCredentialResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/identity/credentials:enable\x12b\n" +
 	"\n" +
 	"GetLockout\x12\x19.rgs.v1.GetLockoutRequest\x1a\x1a.rgs.v1.GetLockoutResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/identity/lockouts\x12q\n" +
-	"\fResetLockout\x12\x1b.rgs.v1.ResetLockoutRequest\x1a\x1c.rgs.v1.ResetLockoutResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/identity/lockouts:resetB\x8f\x01\n" +
+	"\fResetLockout\x12\x1b.rgs.v1.ResetLockoutRequest\x1a\x1c.rgs.v1.ResetLockoutResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/identity/lockouts:reset\x12\x91\x01\n" +
+	"\x16RegisterPlayerIdentity\x12%.rgs.v1.RegisterPlayerIdentityRequest\x1a&.rgs.v1.RegisterPlayerIdentityResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/identity/players:register\x12\x96\x01\n" +
+	"\x19ListPlayerIdentityReviews\x12(.rgs.v1.ListPlayerIdentityReviewsRequest\x1a).rgs.v1.ListPlayerIdentityReviewsResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/identity/players/reviews\x12\xb2\x01\n" +
+	"\x1bResolvePlayerIdentityReview\x12*.rgs.v1.ResolvePlayerIdentityReviewRequest\x1a+.rgs.v1.ResolvePlayerIdentityReviewResponse\":\x82\xd3\xe4\x93\x024:\x01*\"//v1/identity/players/{player_id}/review:resolve\x12\xa6\x01\n" +
+	"\x19BeginWebAuthnRegistration\x12(.rgs.v1.BeginWebAuthnRegistrationRequest\x1a).rgs.v1.BeginWebAuthnRegistrationResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\")/v1/identity/webauthn/registrations:begin\x12\xaa\x01\n" +
+	"\x1aFinishWebAuthnRegistration\x12).rgs.v1.FinishWebAuthnRegistrationRequest\x1a*.rgs.v1.FinishWebAuthnRegistrationResponse\"5\x82\xd3\xe4\x93\x02/:\x01*\"*/v1/identity/webauthn/registrations:finish\x12\x89\x01\n" +
+	"\x12BeginWebAuthnLogin\x12!.rgs.v1.BeginWebAuthnLoginRequest\x1a\".rgs.v1.BeginWebAuthnLoginResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/identity/webauthn/login:begin\x12\x8d\x01\n" +
+	"\x13FinishWebAuthnLogin\x12\".rgs.v1.FinishWebAuthnLoginRequest\x1a#.rgs.v1.FinishWebAuthnLoginResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/identity/webauthn/login:finish\x12y\n" +
+	"\x10ListKeyRotations\x12\x1f.rgs.v1.ListKeyRotationsRequest\x1a .rgs.v1.ListKeyRotationsResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/identity/key-rotations\x12y\n" +
+	"\x10ListLoginHistory\x12\x1f.rgs.v1.ListLoginHistoryRequest\x1a .rgs.v1.ListLoginHistoryResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/identity/login-history\x12l\n" +
+	"\vAssumeActor\x12\x1a.rgs.v1.AssumeActorRequest\x1a\x1b.rgs.v1.AssumeActorResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/identity/assume-actor\x12s\n" +
+	"\x0eGetIPAllowlist\x12\x1d.rgs.v1.GetIPAllowlistRequest\x1a\x1e.rgs.v1.GetIPAllowlistResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/identity/ip-allowlists\x12v\n" +
+	"\x0eSetIPAllowlist\x12\x1d.rgs.v1.SetIPAllowlistRequest\x1a\x1e.rgs.v1.SetIPAllowlistResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\x1a\x1a/v1/identity/ip-allowlists\x12h\n" +
+	"\fListSessions\x12\x1b.rgs.v1.ListSessionsRequest\x1a\x1c.rgs.v1.ListSessionsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/identity/sessions\x12\x82\x01\n" +
+	"\rRevokeSession\x12\x1c.rgs.v1.RevokeSessionRequest\x1a\x1d.rgs.v1.RevokeSessionResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\")/v1/identity/sessions/{session_id}:revoke\x12\x9c\x01\n" +
+	"\x19RevokeAllSessionsForActor\x12(.rgs.v1.RevokeAllSessionsForActorRequest\x1a).rgs.v1.RevokeAllSessionsForActorResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/identity/sessions:revokeAllB\x8f\x01\n" +
 	"\n" +
 	"com.rgs.v1B\rIdentityProtoP\x01Z9github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1;rgsv1\xa2\x02\x03RXX\xaa\x02\x06Rgs.V1\xca\x02\x06Rgs\\V1\xe2\x02\x12Rgs\\V1\\GPBMetadata\xea\x02\aRgs::V1b\x06proto3"
 
@@ -1249,83 +4173,218 @@ func file_rgs_v1_identity_proto_rawDescGZIP() []byte {
 	return file_rgs_v1_identity_proto_rawDescData
 }
 
-var file_rgs_v1_identity_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_rgs_v1_identity_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_rgs_v1_identity_proto_msgTypes = make([]protoimpl.MessageInfo, 61)
 var file_rgs_v1_identity_proto_goTypes = []any{
-	(*PlayerCredentials)(nil),         // 0: rgs.v1.PlayerCredentials
-	(*OperatorCredentials)(nil),       // 1: rgs.v1.OperatorCredentials
-	(*SessionToken)(nil),              // 2: rgs.v1.SessionToken
-	(*LoginRequest)(nil),              // 3: rgs.v1.LoginRequest
-	(*LoginResponse)(nil),             // 4: rgs.v1.LoginResponse
-	(*LogoutRequest)(nil),             // 5: rgs.v1.LogoutRequest
-	(*LogoutResponse)(nil),            // 6: rgs.v1.LogoutResponse
-	(*RefreshTokenRequest)(nil),       // 7: rgs.v1.RefreshTokenRequest
-	(*RefreshTokenResponse)(nil),      // 8: rgs.v1.RefreshTokenResponse
-	(*SetCredentialRequest)(nil),      // 9: rgs.v1.SetCredentialRequest
-	(*SetCredentialResponse)(nil),     // 10: rgs.v1.SetCredentialResponse
-	(*DisableCredentialRequest)(nil),  // 11: rgs.v1.DisableCredentialRequest
-	(*DisableCredentialResponse)(nil), // 12: rgs.v1.DisableCredentialResponse
-	(*EnableCredentialRequest)(nil),   // 13: rgs.v1.EnableCredentialRequest
-	(*EnableCredentialResponse)(nil),  // 14: rgs.v1.EnableCredentialResponse
-	(*LockoutStatus)(nil),             // 15: rgs.v1.LockoutStatus
-	(*GetLockoutRequest)(nil),         // 16: rgs.v1.GetLockoutRequest
-	(*GetLockoutResponse)(nil),        // 17: rgs.v1.GetLockoutResponse
-	(*ResetLockoutRequest)(nil),       // 18: rgs.v1.ResetLockoutRequest
-	(*ResetLockoutResponse)(nil),      // 19: rgs.v1.ResetLockoutResponse
-	(*Actor)(nil),                     // 20: rgs.v1.Actor
-	(*RequestMeta)(nil),               // 21: rgs.v1.RequestMeta
-	(*ResponseMeta)(nil),              // 22: rgs.v1.ResponseMeta
+	(PlayerIdentityStatus)(0),                   // 0: rgs.v1.PlayerIdentityStatus
+	(*PlayerCredentials)(nil),                   // 1: rgs.v1.PlayerCredentials
+	(*OperatorCredentials)(nil),                 // 2: rgs.v1.OperatorCredentials
+	(*OIDCCredentials)(nil),                     // 3: rgs.v1.OIDCCredentials
+	(*SessionToken)(nil),                        // 4: rgs.v1.SessionToken
+	(*PlayerIdentityDetails)(nil),               // 5: rgs.v1.PlayerIdentityDetails
+	(*PlayerIdentityMatch)(nil),                 // 6: rgs.v1.PlayerIdentityMatch
+	(*PlayerIdentityRecord)(nil),                // 7: rgs.v1.PlayerIdentityRecord
+	(*WebAuthnCredential)(nil),                  // 8: rgs.v1.WebAuthnCredential
+	(*KeyRotation)(nil),                         // 9: rgs.v1.KeyRotation
+	(*IdentitySession)(nil),                     // 10: rgs.v1.IdentitySession
+	(*LoginAttempt)(nil),                        // 11: rgs.v1.LoginAttempt
+	(*IPAllowlist)(nil),                         // 12: rgs.v1.IPAllowlist
+	(*LoginRequest)(nil),                        // 13: rgs.v1.LoginRequest
+	(*LoginResponse)(nil),                       // 14: rgs.v1.LoginResponse
+	(*LogoutRequest)(nil),                       // 15: rgs.v1.LogoutRequest
+	(*LogoutResponse)(nil),                      // 16: rgs.v1.LogoutResponse
+	(*RefreshTokenRequest)(nil),                 // 17: rgs.v1.RefreshTokenRequest
+	(*RefreshTokenResponse)(nil),                // 18: rgs.v1.RefreshTokenResponse
+	(*SetCredentialRequest)(nil),                // 19: rgs.v1.SetCredentialRequest
+	(*SetCredentialResponse)(nil),               // 20: rgs.v1.SetCredentialResponse
+	(*ChangeCredentialRequest)(nil),             // 21: rgs.v1.ChangeCredentialRequest
+	(*ChangeCredentialResponse)(nil),            // 22: rgs.v1.ChangeCredentialResponse
+	(*DisableCredentialRequest)(nil),            // 23: rgs.v1.DisableCredentialRequest
+	(*DisableCredentialResponse)(nil),           // 24: rgs.v1.DisableCredentialResponse
+	(*EnableCredentialRequest)(nil),             // 25: rgs.v1.EnableCredentialRequest
+	(*EnableCredentialResponse)(nil),            // 26: rgs.v1.EnableCredentialResponse
+	(*LockoutStatus)(nil),                       // 27: rgs.v1.LockoutStatus
+	(*GetLockoutRequest)(nil),                   // 28: rgs.v1.GetLockoutRequest
+	(*GetLockoutResponse)(nil),                  // 29: rgs.v1.GetLockoutResponse
+	(*ResetLockoutRequest)(nil),                 // 30: rgs.v1.ResetLockoutRequest
+	(*ResetLockoutResponse)(nil),                // 31: rgs.v1.ResetLockoutResponse
+	(*RegisterPlayerIdentityRequest)(nil),       // 32: rgs.v1.RegisterPlayerIdentityRequest
+	(*RegisterPlayerIdentityResponse)(nil),      // 33: rgs.v1.RegisterPlayerIdentityResponse
+	(*ListPlayerIdentityReviewsRequest)(nil),    // 34: rgs.v1.ListPlayerIdentityReviewsRequest
+	(*ListPlayerIdentityReviewsResponse)(nil),   // 35: rgs.v1.ListPlayerIdentityReviewsResponse
+	(*ResolvePlayerIdentityReviewRequest)(nil),  // 36: rgs.v1.ResolvePlayerIdentityReviewRequest
+	(*ResolvePlayerIdentityReviewResponse)(nil), // 37: rgs.v1.ResolvePlayerIdentityReviewResponse
+	(*BeginWebAuthnRegistrationRequest)(nil),    // 38: rgs.v1.BeginWebAuthnRegistrationRequest
+	(*BeginWebAuthnRegistrationResponse)(nil),   // 39: rgs.v1.BeginWebAuthnRegistrationResponse
+	(*FinishWebAuthnRegistrationRequest)(nil),   // 40: rgs.v1.FinishWebAuthnRegistrationRequest
+	(*FinishWebAuthnRegistrationResponse)(nil),  // 41: rgs.v1.FinishWebAuthnRegistrationResponse
+	(*BeginWebAuthnLoginRequest)(nil),           // 42: rgs.v1.BeginWebAuthnLoginRequest
+	(*BeginWebAuthnLoginResponse)(nil),          // 43: rgs.v1.BeginWebAuthnLoginResponse
+	(*FinishWebAuthnLoginRequest)(nil),          // 44: rgs.v1.FinishWebAuthnLoginRequest
+	(*FinishWebAuthnLoginResponse)(nil),         // 45: rgs.v1.FinishWebAuthnLoginResponse
+	(*ListKeyRotationsRequest)(nil),             // 46: rgs.v1.ListKeyRotationsRequest
+	(*ListKeyRotationsResponse)(nil),            // 47: rgs.v1.ListKeyRotationsResponse
+	(*ListSessionsRequest)(nil),                 // 48: rgs.v1.ListSessionsRequest
+	(*ListSessionsResponse)(nil),                // 49: rgs.v1.ListSessionsResponse
+	(*RevokeSessionRequest)(nil),                // 50: rgs.v1.RevokeSessionRequest
+	(*RevokeSessionResponse)(nil),               // 51: rgs.v1.RevokeSessionResponse
+	(*RevokeAllSessionsForActorRequest)(nil),    // 52: rgs.v1.RevokeAllSessionsForActorRequest
+	(*RevokeAllSessionsForActorResponse)(nil),   // 53: rgs.v1.RevokeAllSessionsForActorResponse
+	(*ListLoginHistoryRequest)(nil),             // 54: rgs.v1.ListLoginHistoryRequest
+	(*ListLoginHistoryResponse)(nil),            // 55: rgs.v1.ListLoginHistoryResponse
+	(*GetIPAllowlistRequest)(nil),               // 56: rgs.v1.GetIPAllowlistRequest
+	(*GetIPAllowlistResponse)(nil),              // 57: rgs.v1.GetIPAllowlistResponse
+	(*SetIPAllowlistRequest)(nil),               // 58: rgs.v1.SetIPAllowlistRequest
+	(*SetIPAllowlistResponse)(nil),              // 59: rgs.v1.SetIPAllowlistResponse
+	(*AssumeActorRequest)(nil),                  // 60: rgs.v1.AssumeActorRequest
+	(*AssumeActorResponse)(nil),                 // 61: rgs.v1.AssumeActorResponse
+	(*Actor)(nil),                               // 62: rgs.v1.Actor
+	(ResultCode)(0),                             // 63: rgs.v1.ResultCode
+	(*RequestMeta)(nil),                         // 64: rgs.v1.RequestMeta
+	(*ResponseMeta)(nil),                        // 65: rgs.v1.ResponseMeta
 }
 var file_rgs_v1_identity_proto_depIdxs = []int32{
-	20, // 0: rgs.v1.SessionToken.actor:type_name -> rgs.v1.Actor
-	21, // 1: rgs.v1.LoginRequest.meta:type_name -> rgs.v1.RequestMeta
-	0,  // 2: rgs.v1.LoginRequest.player:type_name -> rgs.v1.PlayerCredentials
-	1,  // 3: rgs.v1.LoginRequest.operator:type_name -> rgs.v1.OperatorCredentials
-	22, // 4: rgs.v1.LoginResponse.meta:type_name -> rgs.v1.ResponseMeta
-	2,  // 5: rgs.v1.LoginResponse.token:type_name -> rgs.v1.SessionToken
-	21, // 6: rgs.v1.LogoutRequest.meta:type_name -> rgs.v1.RequestMeta
-	22, // 7: rgs.v1.LogoutResponse.meta:type_name -> rgs.v1.ResponseMeta
-	21, // 8: rgs.v1.RefreshTokenRequest.meta:type_name -> rgs.v1.RequestMeta
-	22, // 9: rgs.v1.RefreshTokenResponse.meta:type_name -> rgs.v1.ResponseMeta
-	2,  // 10: rgs.v1.RefreshTokenResponse.token:type_name -> rgs.v1.SessionToken
-	21, // 11: rgs.v1.SetCredentialRequest.meta:type_name -> rgs.v1.RequestMeta
-	20, // 12: rgs.v1.SetCredentialRequest.actor:type_name -> rgs.v1.Actor
-	22, // 13: rgs.v1.SetCredentialResponse.meta:type_name -> rgs.v1.ResponseMeta
-	21, // 14: rgs.v1.DisableCredentialRequest.meta:type_name -> rgs.v1.RequestMeta
-	20, // 15: rgs.v1.DisableCredentialRequest.actor:type_name -> rgs.v1.Actor
-	22, // 16: rgs.v1.DisableCredentialResponse.meta:type_name -> rgs.v1.ResponseMeta
-	21, // 17: rgs.v1.EnableCredentialRequest.meta:type_name -> rgs.v1.RequestMeta
-	20, // 18: rgs.v1.EnableCredentialRequest.actor:type_name -> rgs.v1.Actor
-	22, // 19: rgs.v1.EnableCredentialResponse.meta:type_name -> rgs.v1.ResponseMeta
-	20, // 20: rgs.v1.LockoutStatus.actor:type_name -> rgs.v1.Actor
-	21, // 21: rgs.v1.GetLockoutRequest.meta:type_name -> rgs.v1.RequestMeta
-	20, // 22: rgs.v1.GetLockoutRequest.actor:type_name -> rgs.v1.Actor
-	22, // 23: rgs.v1.GetLockoutResponse.meta:type_name -> rgs.v1.ResponseMeta
-	15, // 24: rgs.v1.GetLockoutResponse.status:type_name -> rgs.v1.LockoutStatus
-	21, // 25: rgs.v1.ResetLockoutRequest.meta:type_name -> rgs.v1.RequestMeta
-	20, // 26: rgs.v1.ResetLockoutRequest.actor:type_name -> rgs.v1.Actor
-	22, // 27: rgs.v1.ResetLockoutResponse.meta:type_name -> rgs.v1.ResponseMeta
-	15, // 28: rgs.v1.ResetLockoutResponse.status:type_name -> rgs.v1.LockoutStatus
-	3,  // 29: rgs.v1.IdentityService.Login:input_type -> rgs.v1.LoginRequest
-	5,  // 30: rgs.v1.IdentityService.Logout:input_type -> rgs.v1.LogoutRequest
-	7,  // 31: rgs.v1.IdentityService.RefreshToken:input_type -> rgs.v1.RefreshTokenRequest
-	9,  // 32: rgs.v1.IdentityService.SetCredential:input_type -> rgs.v1.SetCredentialRequest
-	11, // 33: rgs.v1.IdentityService.DisableCredential:input_type -> rgs.v1.DisableCredentialRequest
-	13, // 34: rgs.v1.IdentityService.EnableCredential:input_type -> rgs.v1.EnableCredentialRequest
-	16, // 35: rgs.v1.IdentityService.GetLockout:input_type -> rgs.v1.GetLockoutRequest
-	18, // 36: rgs.v1.IdentityService.ResetLockout:input_type -> rgs.v1.ResetLockoutRequest
-	4,  // 37: rgs.v1.IdentityService.Login:output_type -> rgs.v1.LoginResponse
-	6,  // 38: rgs.v1.IdentityService.Logout:output_type -> rgs.v1.LogoutResponse
-	8,  // 39: rgs.v1.IdentityService.RefreshToken:output_type -> rgs.v1.RefreshTokenResponse
-	10, // 40: rgs.v1.IdentityService.SetCredential:output_type -> rgs.v1.SetCredentialResponse
-	12, // 41: rgs.v1.IdentityService.DisableCredential:output_type -> rgs.v1.DisableCredentialResponse
-	14, // 42: rgs.v1.IdentityService.EnableCredential:output_type -> rgs.v1.EnableCredentialResponse
-	17, // 43: rgs.v1.IdentityService.GetLockout:output_type -> rgs.v1.GetLockoutResponse
-	19, // 44: rgs.v1.IdentityService.ResetLockout:output_type -> rgs.v1.ResetLockoutResponse
-	37, // [37:45] is the sub-list for method output_type
-	29, // [29:37] is the sub-list for method input_type
-	29, // [29:29] is the sub-list for extension type_name
-	29, // [29:29] is the sub-list for extension extendee
-	0,  // [0:29] is the sub-list for field type_name
+	62,  // 0: rgs.v1.SessionToken.actor:type_name -> rgs.v1.Actor
+	0,   // 1: rgs.v1.PlayerIdentityRecord.status:type_name -> rgs.v1.PlayerIdentityStatus
+	6,   // 2: rgs.v1.PlayerIdentityRecord.matches:type_name -> rgs.v1.PlayerIdentityMatch
+	62,  // 3: rgs.v1.KeyRotation.triggered_by:type_name -> rgs.v1.Actor
+	62,  // 4: rgs.v1.IdentitySession.actor:type_name -> rgs.v1.Actor
+	62,  // 5: rgs.v1.LoginAttempt.actor:type_name -> rgs.v1.Actor
+	63,  // 6: rgs.v1.LoginAttempt.result:type_name -> rgs.v1.ResultCode
+	62,  // 7: rgs.v1.IPAllowlist.actor:type_name -> rgs.v1.Actor
+	64,  // 8: rgs.v1.LoginRequest.meta:type_name -> rgs.v1.RequestMeta
+	1,   // 9: rgs.v1.LoginRequest.player:type_name -> rgs.v1.PlayerCredentials
+	2,   // 10: rgs.v1.LoginRequest.operator:type_name -> rgs.v1.OperatorCredentials
+	3,   // 11: rgs.v1.LoginRequest.oidc:type_name -> rgs.v1.OIDCCredentials
+	65,  // 12: rgs.v1.LoginResponse.meta:type_name -> rgs.v1.ResponseMeta
+	4,   // 13: rgs.v1.LoginResponse.token:type_name -> rgs.v1.SessionToken
+	64,  // 14: rgs.v1.LogoutRequest.meta:type_name -> rgs.v1.RequestMeta
+	65,  // 15: rgs.v1.LogoutResponse.meta:type_name -> rgs.v1.ResponseMeta
+	64,  // 16: rgs.v1.RefreshTokenRequest.meta:type_name -> rgs.v1.RequestMeta
+	65,  // 17: rgs.v1.RefreshTokenResponse.meta:type_name -> rgs.v1.ResponseMeta
+	4,   // 18: rgs.v1.RefreshTokenResponse.token:type_name -> rgs.v1.SessionToken
+	64,  // 19: rgs.v1.SetCredentialRequest.meta:type_name -> rgs.v1.RequestMeta
+	62,  // 20: rgs.v1.SetCredentialRequest.actor:type_name -> rgs.v1.Actor
+	65,  // 21: rgs.v1.SetCredentialResponse.meta:type_name -> rgs.v1.ResponseMeta
+	64,  // 22: rgs.v1.ChangeCredentialRequest.meta:type_name -> rgs.v1.RequestMeta
+	65,  // 23: rgs.v1.ChangeCredentialResponse.meta:type_name -> rgs.v1.ResponseMeta
+	64,  // 24: rgs.v1.DisableCredentialRequest.meta:type_name -> rgs.v1.RequestMeta
+	62,  // 25: rgs.v1.DisableCredentialRequest.actor:type_name -> rgs.v1.Actor
+	65,  // 26: rgs.v1.DisableCredentialResponse.meta:type_name -> rgs.v1.ResponseMeta
+	64,  // 27: rgs.v1.EnableCredentialRequest.meta:type_name -> rgs.v1.RequestMeta
+	62,  // 28: rgs.v1.EnableCredentialRequest.actor:type_name -> rgs.v1.Actor
+	65,  // 29: rgs.v1.EnableCredentialResponse.meta:type_name -> rgs.v1.ResponseMeta
+	62,  // 30: rgs.v1.LockoutStatus.actor:type_name -> rgs.v1.Actor
+	64,  // 31: rgs.v1.GetLockoutRequest.meta:type_name -> rgs.v1.RequestMeta
+	62,  // 32: rgs.v1.GetLockoutRequest.actor:type_name -> rgs.v1.Actor
+	65,  // 33: rgs.v1.GetLockoutResponse.meta:type_name -> rgs.v1.ResponseMeta
+	27,  // 34: rgs.v1.GetLockoutResponse.status:type_name -> rgs.v1.LockoutStatus
+	64,  // 35: rgs.v1.ResetLockoutRequest.meta:type_name -> rgs.v1.RequestMeta
+	62,  // 36: rgs.v1.ResetLockoutRequest.actor:type_name -> rgs.v1.Actor
+	65,  // 37: rgs.v1.ResetLockoutResponse.meta:type_name -> rgs.v1.ResponseMeta
+	27,  // 38: rgs.v1.ResetLockoutResponse.status:type_name -> rgs.v1.LockoutStatus
+	64,  // 39: rgs.v1.RegisterPlayerIdentityRequest.meta:type_name -> rgs.v1.RequestMeta
+	5,   // 40: rgs.v1.RegisterPlayerIdentityRequest.details:type_name -> rgs.v1.PlayerIdentityDetails
+	65,  // 41: rgs.v1.RegisterPlayerIdentityResponse.meta:type_name -> rgs.v1.ResponseMeta
+	7,   // 42: rgs.v1.RegisterPlayerIdentityResponse.record:type_name -> rgs.v1.PlayerIdentityRecord
+	64,  // 43: rgs.v1.ListPlayerIdentityReviewsRequest.meta:type_name -> rgs.v1.RequestMeta
+	0,   // 44: rgs.v1.ListPlayerIdentityReviewsRequest.status:type_name -> rgs.v1.PlayerIdentityStatus
+	65,  // 45: rgs.v1.ListPlayerIdentityReviewsResponse.meta:type_name -> rgs.v1.ResponseMeta
+	7,   // 46: rgs.v1.ListPlayerIdentityReviewsResponse.records:type_name -> rgs.v1.PlayerIdentityRecord
+	64,  // 47: rgs.v1.ResolvePlayerIdentityReviewRequest.meta:type_name -> rgs.v1.RequestMeta
+	65,  // 48: rgs.v1.ResolvePlayerIdentityReviewResponse.meta:type_name -> rgs.v1.ResponseMeta
+	7,   // 49: rgs.v1.ResolvePlayerIdentityReviewResponse.record:type_name -> rgs.v1.PlayerIdentityRecord
+	64,  // 50: rgs.v1.BeginWebAuthnRegistrationRequest.meta:type_name -> rgs.v1.RequestMeta
+	65,  // 51: rgs.v1.BeginWebAuthnRegistrationResponse.meta:type_name -> rgs.v1.ResponseMeta
+	64,  // 52: rgs.v1.FinishWebAuthnRegistrationRequest.meta:type_name -> rgs.v1.RequestMeta
+	65,  // 53: rgs.v1.FinishWebAuthnRegistrationResponse.meta:type_name -> rgs.v1.ResponseMeta
+	8,   // 54: rgs.v1.FinishWebAuthnRegistrationResponse.credential:type_name -> rgs.v1.WebAuthnCredential
+	64,  // 55: rgs.v1.BeginWebAuthnLoginRequest.meta:type_name -> rgs.v1.RequestMeta
+	65,  // 56: rgs.v1.BeginWebAuthnLoginResponse.meta:type_name -> rgs.v1.ResponseMeta
+	64,  // 57: rgs.v1.FinishWebAuthnLoginRequest.meta:type_name -> rgs.v1.RequestMeta
+	65,  // 58: rgs.v1.FinishWebAuthnLoginResponse.meta:type_name -> rgs.v1.ResponseMeta
+	4,   // 59: rgs.v1.FinishWebAuthnLoginResponse.token:type_name -> rgs.v1.SessionToken
+	64,  // 60: rgs.v1.ListKeyRotationsRequest.meta:type_name -> rgs.v1.RequestMeta
+	65,  // 61: rgs.v1.ListKeyRotationsResponse.meta:type_name -> rgs.v1.ResponseMeta
+	9,   // 62: rgs.v1.ListKeyRotationsResponse.rotations:type_name -> rgs.v1.KeyRotation
+	64,  // 63: rgs.v1.ListSessionsRequest.meta:type_name -> rgs.v1.RequestMeta
+	62,  // 64: rgs.v1.ListSessionsRequest.actor:type_name -> rgs.v1.Actor
+	65,  // 65: rgs.v1.ListSessionsResponse.meta:type_name -> rgs.v1.ResponseMeta
+	10,  // 66: rgs.v1.ListSessionsResponse.sessions:type_name -> rgs.v1.IdentitySession
+	64,  // 67: rgs.v1.RevokeSessionRequest.meta:type_name -> rgs.v1.RequestMeta
+	65,  // 68: rgs.v1.RevokeSessionResponse.meta:type_name -> rgs.v1.ResponseMeta
+	10,  // 69: rgs.v1.RevokeSessionResponse.session:type_name -> rgs.v1.IdentitySession
+	64,  // 70: rgs.v1.RevokeAllSessionsForActorRequest.meta:type_name -> rgs.v1.RequestMeta
+	62,  // 71: rgs.v1.RevokeAllSessionsForActorRequest.actor:type_name -> rgs.v1.Actor
+	65,  // 72: rgs.v1.RevokeAllSessionsForActorResponse.meta:type_name -> rgs.v1.ResponseMeta
+	64,  // 73: rgs.v1.ListLoginHistoryRequest.meta:type_name -> rgs.v1.RequestMeta
+	62,  // 74: rgs.v1.ListLoginHistoryRequest.actor:type_name -> rgs.v1.Actor
+	65,  // 75: rgs.v1.ListLoginHistoryResponse.meta:type_name -> rgs.v1.ResponseMeta
+	11,  // 76: rgs.v1.ListLoginHistoryResponse.attempts:type_name -> rgs.v1.LoginAttempt
+	64,  // 77: rgs.v1.GetIPAllowlistRequest.meta:type_name -> rgs.v1.RequestMeta
+	62,  // 78: rgs.v1.GetIPAllowlistRequest.actor:type_name -> rgs.v1.Actor
+	65,  // 79: rgs.v1.GetIPAllowlistResponse.meta:type_name -> rgs.v1.ResponseMeta
+	12,  // 80: rgs.v1.GetIPAllowlistResponse.allowlist:type_name -> rgs.v1.IPAllowlist
+	64,  // 81: rgs.v1.SetIPAllowlistRequest.meta:type_name -> rgs.v1.RequestMeta
+	62,  // 82: rgs.v1.SetIPAllowlistRequest.actor:type_name -> rgs.v1.Actor
+	65,  // 83: rgs.v1.SetIPAllowlistResponse.meta:type_name -> rgs.v1.ResponseMeta
+	12,  // 84: rgs.v1.SetIPAllowlistResponse.allowlist:type_name -> rgs.v1.IPAllowlist
+	64,  // 85: rgs.v1.AssumeActorRequest.meta:type_name -> rgs.v1.RequestMeta
+	62,  // 86: rgs.v1.AssumeActorRequest.actor:type_name -> rgs.v1.Actor
+	65,  // 87: rgs.v1.AssumeActorResponse.meta:type_name -> rgs.v1.ResponseMeta
+	13,  // 88: rgs.v1.IdentityService.Login:input_type -> rgs.v1.LoginRequest
+	15,  // 89: rgs.v1.IdentityService.Logout:input_type -> rgs.v1.LogoutRequest
+	17,  // 90: rgs.v1.IdentityService.RefreshToken:input_type -> rgs.v1.RefreshTokenRequest
+	19,  // 91: rgs.v1.IdentityService.SetCredential:input_type -> rgs.v1.SetCredentialRequest
+	21,  // 92: rgs.v1.IdentityService.ChangeCredential:input_type -> rgs.v1.ChangeCredentialRequest
+	23,  // 93: rgs.v1.IdentityService.DisableCredential:input_type -> rgs.v1.DisableCredentialRequest
+	25,  // 94: rgs.v1.IdentityService.EnableCredential:input_type -> rgs.v1.EnableCredentialRequest
+	28,  // 95: rgs.v1.IdentityService.GetLockout:input_type -> rgs.v1.GetLockoutRequest
+	30,  // 96: rgs.v1.IdentityService.ResetLockout:input_type -> rgs.v1.ResetLockoutRequest
+	32,  // 97: rgs.v1.IdentityService.RegisterPlayerIdentity:input_type -> rgs.v1.RegisterPlayerIdentityRequest
+	34,  // 98: rgs.v1.IdentityService.ListPlayerIdentityReviews:input_type -> rgs.v1.ListPlayerIdentityReviewsRequest
+	36,  // 99: rgs.v1.IdentityService.ResolvePlayerIdentityReview:input_type -> rgs.v1.ResolvePlayerIdentityReviewRequest
+	38,  // 100: rgs.v1.IdentityService.BeginWebAuthnRegistration:input_type -> rgs.v1.BeginWebAuthnRegistrationRequest
+	40,  // 101: rgs.v1.IdentityService.FinishWebAuthnRegistration:input_type -> rgs.v1.FinishWebAuthnRegistrationRequest
+	42,  // 102: rgs.v1.IdentityService.BeginWebAuthnLogin:input_type -> rgs.v1.BeginWebAuthnLoginRequest
+	44,  // 103: rgs.v1.IdentityService.FinishWebAuthnLogin:input_type -> rgs.v1.FinishWebAuthnLoginRequest
+	46,  // 104: rgs.v1.IdentityService.ListKeyRotations:input_type -> rgs.v1.ListKeyRotationsRequest
+	54,  // 105: rgs.v1.IdentityService.ListLoginHistory:input_type -> rgs.v1.ListLoginHistoryRequest
+	60,  // 106: rgs.v1.IdentityService.AssumeActor:input_type -> rgs.v1.AssumeActorRequest
+	56,  // 107: rgs.v1.IdentityService.GetIPAllowlist:input_type -> rgs.v1.GetIPAllowlistRequest
+	58,  // 108: rgs.v1.IdentityService.SetIPAllowlist:input_type -> rgs.v1.SetIPAllowlistRequest
+	48,  // 109: rgs.v1.IdentityService.ListSessions:input_type -> rgs.v1.ListSessionsRequest
+	50,  // 110: rgs.v1.IdentityService.RevokeSession:input_type -> rgs.v1.RevokeSessionRequest
+	52,  // 111: rgs.v1.IdentityService.RevokeAllSessionsForActor:input_type -> rgs.v1.RevokeAllSessionsForActorRequest
+	14,  // 112: rgs.v1.IdentityService.Login:output_type -> rgs.v1.LoginResponse
+	16,  // 113: rgs.v1.IdentityService.Logout:output_type -> rgs.v1.LogoutResponse
+	18,  // 114: rgs.v1.IdentityService.RefreshToken:output_type -> rgs.v1.RefreshTokenResponse
+	20,  // 115: rgs.v1.IdentityService.SetCredential:output_type -> rgs.v1.SetCredentialResponse
+	22,  // 116: rgs.v1.IdentityService.ChangeCredential:output_type -> rgs.v1.ChangeCredentialResponse
+	24,  // 117: rgs.v1.IdentityService.DisableCredential:output_type -> rgs.v1.DisableCredentialResponse
+	26,  // 118: rgs.v1.IdentityService.EnableCredential:output_type -> rgs.v1.EnableCredentialResponse
+	29,  // 119: rgs.v1.IdentityService.GetLockout:output_type -> rgs.v1.GetLockoutResponse
+	31,  // 120: rgs.v1.IdentityService.ResetLockout:output_type -> rgs.v1.ResetLockoutResponse
+	33,  // 121: rgs.v1.IdentityService.RegisterPlayerIdentity:output_type -> rgs.v1.RegisterPlayerIdentityResponse
+	35,  // 122: rgs.v1.IdentityService.ListPlayerIdentityReviews:output_type -> rgs.v1.ListPlayerIdentityReviewsResponse
+	37,  // 123: rgs.v1.IdentityService.ResolvePlayerIdentityReview:output_type -> rgs.v1.ResolvePlayerIdentityReviewResponse
+	39,  // 124: rgs.v1.IdentityService.BeginWebAuthnRegistration:output_type -> rgs.v1.BeginWebAuthnRegistrationResponse
+	41,  // 125: rgs.v1.IdentityService.FinishWebAuthnRegistration:output_type -> rgs.v1.FinishWebAuthnRegistrationResponse
+	43,  // 126: rgs.v1.IdentityService.BeginWebAuthnLogin:output_type -> rgs.v1.BeginWebAuthnLoginResponse
+	45,  // 127: rgs.v1.IdentityService.FinishWebAuthnLogin:output_type -> rgs.v1.FinishWebAuthnLoginResponse
+	47,  // 128: rgs.v1.IdentityService.ListKeyRotations:output_type -> rgs.v1.ListKeyRotationsResponse
+	55,  // 129: rgs.v1.IdentityService.ListLoginHistory:output_type -> rgs.v1.ListLoginHistoryResponse
+	61,  // 130: rgs.v1.IdentityService.AssumeActor:output_type -> rgs.v1.AssumeActorResponse
+	57,  // 131: rgs.v1.IdentityService.GetIPAllowlist:output_type -> rgs.v1.GetIPAllowlistResponse
+	59,  // 132: rgs.v1.IdentityService.SetIPAllowlist:output_type -> rgs.v1.SetIPAllowlistResponse
+	49,  // 133: rgs.v1.IdentityService.ListSessions:output_type -> rgs.v1.ListSessionsResponse
+	51,  // 134: rgs.v1.IdentityService.RevokeSession:output_type -> rgs.v1.RevokeSessionResponse
+	53,  // 135: rgs.v1.IdentityService.RevokeAllSessionsForActor:output_type -> rgs.v1.RevokeAllSessionsForActorResponse
+	112, // [112:136] is the sub-list for method output_type
+	88,  // [88:112] is the sub-list for method input_type
+	88,  // [88:88] is the sub-list for extension type_name
+	88,  // [88:88] is the sub-list for extension extendee
+	0,   // [0:88] is the sub-list for field type_name
 }
 
 func init() { file_rgs_v1_identity_proto_init() }
@@ -1334,22 +4393,24 @@ func file_rgs_v1_identity_proto_init() {
 		return
 	}
 	file_rgs_v1_common_proto_init()
-	file_rgs_v1_identity_proto_msgTypes[3].OneofWrappers = []any{
+	file_rgs_v1_identity_proto_msgTypes[12].OneofWrappers = []any{
 		(*LoginRequest_Player)(nil),
 		(*LoginRequest_Operator)(nil),
+		(*LoginRequest_Oidc)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rgs_v1_identity_proto_rawDesc), len(file_rgs_v1_identity_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   20,
+			NumEnums:      1,
+			NumMessages:   61,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_rgs_v1_identity_proto_goTypes,
 		DependencyIndexes: file_rgs_v1_identity_proto_depIdxs,
+		EnumInfos:         file_rgs_v1_identity_proto_enumTypes,
 		MessageInfos:      file_rgs_v1_identity_proto_msgTypes,
 	}.Build()
 	File_rgs_v1_identity_proto = out.File
@@ -19,14 +19,30 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	IdentityService_Login_FullMethodName             = "/rgs.v1.IdentityService/Login"
-	IdentityService_Logout_FullMethodName            = "/rgs.v1.IdentityService/Logout"
-	IdentityService_RefreshToken_FullMethodName      = "/rgs.v1.IdentityService/RefreshToken"
-	IdentityService_SetCredential_FullMethodName     = "/rgs.v1.IdentityService/SetCredential"
-	IdentityService_DisableCredential_FullMethodName = "/rgs.v1.IdentityService/DisableCredential"
-	IdentityService_EnableCredential_FullMethodName  = "/rgs.v1.IdentityService/EnableCredential"
-	IdentityService_GetLockout_FullMethodName        = "/rgs.v1.IdentityService/GetLockout"
-	IdentityService_ResetLockout_FullMethodName      = "/rgs.v1.IdentityService/ResetLockout"
+	IdentityService_Login_FullMethodName                       = "/rgs.v1.IdentityService/Login"
+	IdentityService_Logout_FullMethodName                      = "/rgs.v1.IdentityService/Logout"
+	IdentityService_RefreshToken_FullMethodName                = "/rgs.v1.IdentityService/RefreshToken"
+	IdentityService_SetCredential_FullMethodName               = "/rgs.v1.IdentityService/SetCredential"
+	IdentityService_ChangeCredential_FullMethodName            = "/rgs.v1.IdentityService/ChangeCredential"
+	IdentityService_DisableCredential_FullMethodName           = "/rgs.v1.IdentityService/DisableCredential"
+	IdentityService_EnableCredential_FullMethodName            = "/rgs.v1.IdentityService/EnableCredential"
+	IdentityService_GetLockout_FullMethodName                  = "/rgs.v1.IdentityService/GetLockout"
+	IdentityService_ResetLockout_FullMethodName                = "/rgs.v1.IdentityService/ResetLockout"
+	IdentityService_RegisterPlayerIdentity_FullMethodName      = "/rgs.v1.IdentityService/RegisterPlayerIdentity"
+	IdentityService_ListPlayerIdentityReviews_FullMethodName   = "/rgs.v1.IdentityService/ListPlayerIdentityReviews"
+	IdentityService_ResolvePlayerIdentityReview_FullMethodName = "/rgs.v1.IdentityService/ResolvePlayerIdentityReview"
+	IdentityService_BeginWebAuthnRegistration_FullMethodName   = "/rgs.v1.IdentityService/BeginWebAuthnRegistration"
+	IdentityService_FinishWebAuthnRegistration_FullMethodName  = "/rgs.v1.IdentityService/FinishWebAuthnRegistration"
+	IdentityService_BeginWebAuthnLogin_FullMethodName          = "/rgs.v1.IdentityService/BeginWebAuthnLogin"
+	IdentityService_FinishWebAuthnLogin_FullMethodName         = "/rgs.v1.IdentityService/FinishWebAuthnLogin"
+	IdentityService_ListKeyRotations_FullMethodName            = "/rgs.v1.IdentityService/ListKeyRotations"
+	IdentityService_ListLoginHistory_FullMethodName            = "/rgs.v1.IdentityService/ListLoginHistory"
+	IdentityService_AssumeActor_FullMethodName                 = "/rgs.v1.IdentityService/AssumeActor"
+	IdentityService_GetIPAllowlist_FullMethodName              = "/rgs.v1.IdentityService/GetIPAllowlist"
+	IdentityService_SetIPAllowlist_FullMethodName              = "/rgs.v1.IdentityService/SetIPAllowlist"
+	IdentityService_ListSessions_FullMethodName                = "/rgs.v1.IdentityService/ListSessions"
+	IdentityService_RevokeSession_FullMethodName               = "/rgs.v1.IdentityService/RevokeSession"
+	IdentityService_RevokeAllSessionsForActor_FullMethodName   = "/rgs.v1.IdentityService/RevokeAllSessionsForActor"
 )
 
 // IdentityServiceClient is the client API for IdentityService service.
@@ -37,10 +53,35 @@ type IdentityServiceClient interface {
 	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error)
 	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error)
 	SetCredential(ctx context.Context, in *SetCredentialRequest, opts ...grpc.CallOption) (*SetCredentialResponse, error)
+	// ChangeCredential lets an operator replace their own password, or a
+	// player their own PIN. It is authenticated by the current secret rather
+	// than a token, so an operator whose password has expired can still
+	// change it.
+	ChangeCredential(ctx context.Context, in *ChangeCredentialRequest, opts ...grpc.CallOption) (*ChangeCredentialResponse, error)
 	DisableCredential(ctx context.Context, in *DisableCredentialRequest, opts ...grpc.CallOption) (*DisableCredentialResponse, error)
 	EnableCredential(ctx context.Context, in *EnableCredentialRequest, opts ...grpc.CallOption) (*EnableCredentialResponse, error)
 	GetLockout(ctx context.Context, in *GetLockoutRequest, opts ...grpc.CallOption) (*GetLockoutResponse, error)
 	ResetLockout(ctx context.Context, in *ResetLockoutRequest, opts ...grpc.CallOption) (*ResetLockoutResponse, error)
+	RegisterPlayerIdentity(ctx context.Context, in *RegisterPlayerIdentityRequest, opts ...grpc.CallOption) (*RegisterPlayerIdentityResponse, error)
+	ListPlayerIdentityReviews(ctx context.Context, in *ListPlayerIdentityReviewsRequest, opts ...grpc.CallOption) (*ListPlayerIdentityReviewsResponse, error)
+	ResolvePlayerIdentityReview(ctx context.Context, in *ResolvePlayerIdentityReviewRequest, opts ...grpc.CallOption) (*ResolvePlayerIdentityReviewResponse, error)
+	BeginWebAuthnRegistration(ctx context.Context, in *BeginWebAuthnRegistrationRequest, opts ...grpc.CallOption) (*BeginWebAuthnRegistrationResponse, error)
+	FinishWebAuthnRegistration(ctx context.Context, in *FinishWebAuthnRegistrationRequest, opts ...grpc.CallOption) (*FinishWebAuthnRegistrationResponse, error)
+	BeginWebAuthnLogin(ctx context.Context, in *BeginWebAuthnLoginRequest, opts ...grpc.CallOption) (*BeginWebAuthnLoginResponse, error)
+	FinishWebAuthnLogin(ctx context.Context, in *FinishWebAuthnLoginRequest, opts ...grpc.CallOption) (*FinishWebAuthnLoginResponse, error)
+	ListKeyRotations(ctx context.Context, in *ListKeyRotationsRequest, opts ...grpc.CallOption) (*ListKeyRotationsResponse, error)
+	ListLoginHistory(ctx context.Context, in *ListLoginHistoryRequest, opts ...grpc.CallOption) (*ListLoginHistoryResponse, error)
+	// AssumeActor issues an operator a short-lived access token acting as a
+	// player for support. The token cannot be refreshed; calls made with it
+	// carry the operator in ResponseMeta.impersonator and in the audit trail.
+	AssumeActor(ctx context.Context, in *AssumeActorRequest, opts ...grpc.CallOption) (*AssumeActorResponse, error)
+	GetIPAllowlist(ctx context.Context, in *GetIPAllowlistRequest, opts ...grpc.CallOption) (*GetIPAllowlistResponse, error)
+	// SetIPAllowlist replaces the actor's allowlist; an empty list removes
+	// the restriction.
+	SetIPAllowlist(ctx context.Context, in *SetIPAllowlistRequest, opts ...grpc.CallOption) (*SetIPAllowlistResponse, error)
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error)
+	RevokeAllSessionsForActor(ctx context.Context, in *RevokeAllSessionsForActorRequest, opts ...grpc.CallOption) (*RevokeAllSessionsForActorResponse, error)
 }
 
 type identityServiceClient struct {
@@ -91,6 +132,16 @@ func (c *identityServiceClient) SetCredential(ctx context.Context, in *SetCreden
 	return out, nil
 }
 
+func (c *identityServiceClient) ChangeCredential(ctx context.Context, in *ChangeCredentialRequest, opts ...grpc.CallOption) (*ChangeCredentialResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeCredentialResponse)
+	err := c.cc.Invoke(ctx, IdentityService_ChangeCredential_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *identityServiceClient) DisableCredential(ctx context.Context, in *DisableCredentialRequest, opts ...grpc.CallOption) (*DisableCredentialResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DisableCredentialResponse)
@@ -131,6 +182,156 @@ func (c *identityServiceClient) ResetLockout(ctx context.Context, in *ResetLocko
 	return out, nil
 }
 
+func (c *identityServiceClient) RegisterPlayerIdentity(ctx context.Context, in *RegisterPlayerIdentityRequest, opts ...grpc.CallOption) (*RegisterPlayerIdentityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterPlayerIdentityResponse)
+	err := c.cc.Invoke(ctx, IdentityService_RegisterPlayerIdentity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityServiceClient) ListPlayerIdentityReviews(ctx context.Context, in *ListPlayerIdentityReviewsRequest, opts ...grpc.CallOption) (*ListPlayerIdentityReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPlayerIdentityReviewsResponse)
+	err := c.cc.Invoke(ctx, IdentityService_ListPlayerIdentityReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityServiceClient) ResolvePlayerIdentityReview(ctx context.Context, in *ResolvePlayerIdentityReviewRequest, opts ...grpc.CallOption) (*ResolvePlayerIdentityReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolvePlayerIdentityReviewResponse)
+	err := c.cc.Invoke(ctx, IdentityService_ResolvePlayerIdentityReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityServiceClient) BeginWebAuthnRegistration(ctx context.Context, in *BeginWebAuthnRegistrationRequest, opts ...grpc.CallOption) (*BeginWebAuthnRegistrationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BeginWebAuthnRegistrationResponse)
+	err := c.cc.Invoke(ctx, IdentityService_BeginWebAuthnRegistration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityServiceClient) FinishWebAuthnRegistration(ctx context.Context, in *FinishWebAuthnRegistrationRequest, opts ...grpc.CallOption) (*FinishWebAuthnRegistrationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FinishWebAuthnRegistrationResponse)
+	err := c.cc.Invoke(ctx, IdentityService_FinishWebAuthnRegistration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityServiceClient) BeginWebAuthnLogin(ctx context.Context, in *BeginWebAuthnLoginRequest, opts ...grpc.CallOption) (*BeginWebAuthnLoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BeginWebAuthnLoginResponse)
+	err := c.cc.Invoke(ctx, IdentityService_BeginWebAuthnLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityServiceClient) FinishWebAuthnLogin(ctx context.Context, in *FinishWebAuthnLoginRequest, opts ...grpc.CallOption) (*FinishWebAuthnLoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FinishWebAuthnLoginResponse)
+	err := c.cc.Invoke(ctx, IdentityService_FinishWebAuthnLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityServiceClient) ListKeyRotations(ctx context.Context, in *ListKeyRotationsRequest, opts ...grpc.CallOption) (*ListKeyRotationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListKeyRotationsResponse)
+	err := c.cc.Invoke(ctx, IdentityService_ListKeyRotations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityServiceClient) ListLoginHistory(ctx context.Context, in *ListLoginHistoryRequest, opts ...grpc.CallOption) (*ListLoginHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLoginHistoryResponse)
+	err := c.cc.Invoke(ctx, IdentityService_ListLoginHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityServiceClient) AssumeActor(ctx context.Context, in *AssumeActorRequest, opts ...grpc.CallOption) (*AssumeActorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssumeActorResponse)
+	err := c.cc.Invoke(ctx, IdentityService_AssumeActor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityServiceClient) GetIPAllowlist(ctx context.Context, in *GetIPAllowlistRequest, opts ...grpc.CallOption) (*GetIPAllowlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetIPAllowlistResponse)
+	err := c.cc.Invoke(ctx, IdentityService_GetIPAllowlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityServiceClient) SetIPAllowlist(ctx context.Context, in *SetIPAllowlistRequest, opts ...grpc.CallOption) (*SetIPAllowlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetIPAllowlistResponse)
+	err := c.cc.Invoke(ctx, IdentityService_SetIPAllowlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityServiceClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, IdentityService_ListSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityServiceClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeSessionResponse)
+	err := c.cc.Invoke(ctx, IdentityService_RevokeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityServiceClient) RevokeAllSessionsForActor(ctx context.Context, in *RevokeAllSessionsForActorRequest, opts ...grpc.CallOption) (*RevokeAllSessionsForActorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeAllSessionsForActorResponse)
+	err := c.cc.Invoke(ctx, IdentityService_RevokeAllSessionsForActor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IdentityServiceServer is the server API for IdentityService service.
 // All implementations must embed UnimplementedIdentityServiceServer
 // for forward compatibility.
@@ -139,10 +340,35 @@ type IdentityServiceServer interface {
 	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
 	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
 	SetCredential(context.Context, *SetCredentialRequest) (*SetCredentialResponse, error)
+	// ChangeCredential lets an operator replace their own password, or a
+	// player their own PIN. It is authenticated by the current secret rather
+	// than a token, so an operator whose password has expired can still
+	// change it.
+	ChangeCredential(context.Context, *ChangeCredentialRequest) (*ChangeCredentialResponse, error)
 	DisableCredential(context.Context, *DisableCredentialRequest) (*DisableCredentialResponse, error)
 	EnableCredential(context.Context, *EnableCredentialRequest) (*EnableCredentialResponse, error)
 	GetLockout(context.Context, *GetLockoutRequest) (*GetLockoutResponse, error)
 	ResetLockout(context.Context, *ResetLockoutRequest) (*ResetLockoutResponse, error)
+	RegisterPlayerIdentity(context.Context, *RegisterPlayerIdentityRequest) (*RegisterPlayerIdentityResponse, error)
+	ListPlayerIdentityReviews(context.Context, *ListPlayerIdentityReviewsRequest) (*ListPlayerIdentityReviewsResponse, error)
+	ResolvePlayerIdentityReview(context.Context, *ResolvePlayerIdentityReviewRequest) (*ResolvePlayerIdentityReviewResponse, error)
+	BeginWebAuthnRegistration(context.Context, *BeginWebAuthnRegistrationRequest) (*BeginWebAuthnRegistrationResponse, error)
+	FinishWebAuthnRegistration(context.Context, *FinishWebAuthnRegistrationRequest) (*FinishWebAuthnRegistrationResponse, error)
+	BeginWebAuthnLogin(context.Context, *BeginWebAuthnLoginRequest) (*BeginWebAuthnLoginResponse, error)
+	FinishWebAuthnLogin(context.Context, *FinishWebAuthnLoginRequest) (*FinishWebAuthnLoginResponse, error)
+	ListKeyRotations(context.Context, *ListKeyRotationsRequest) (*ListKeyRotationsResponse, error)
+	ListLoginHistory(context.Context, *ListLoginHistoryRequest) (*ListLoginHistoryResponse, error)
+	// AssumeActor issues an operator a short-lived access token acting as a
+	// player for support. The token cannot be refreshed; calls made with it
+	// carry the operator in ResponseMeta.impersonator and in the audit trail.
+	AssumeActor(context.Context, *AssumeActorRequest) (*AssumeActorResponse, error)
+	GetIPAllowlist(context.Context, *GetIPAllowlistRequest) (*GetIPAllowlistResponse, error)
+	// SetIPAllowlist replaces the actor's allowlist; an empty list removes
+	// the restriction.
+	SetIPAllowlist(context.Context, *SetIPAllowlistRequest) (*SetIPAllowlistResponse, error)
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error)
+	RevokeAllSessionsForActor(context.Context, *RevokeAllSessionsForActorRequest) (*RevokeAllSessionsForActorResponse, error)
 	mustEmbedUnimplementedIdentityServiceServer()
 }
 
@@ -165,6 +391,9 @@ func (UnimplementedIdentityServiceServer) RefreshToken(context.Context, *Refresh
 func (UnimplementedIdentityServiceServer) SetCredential(context.Context, *SetCredentialRequest) (*SetCredentialResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SetCredential not implemented")
 }
+func (UnimplementedIdentityServiceServer) ChangeCredential(context.Context, *ChangeCredentialRequest) (*ChangeCredentialResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ChangeCredential not implemented")
+}
 func (UnimplementedIdentityServiceServer) DisableCredential(context.Context, *DisableCredentialRequest) (*DisableCredentialResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DisableCredential not implemented")
 }
@@ -177,6 +406,51 @@ func (UnimplementedIdentityServiceServer) GetLockout(context.Context, *GetLockou
 func (UnimplementedIdentityServiceServer) ResetLockout(context.Context, *ResetLockoutRequest) (*ResetLockoutResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ResetLockout not implemented")
 }
+func (UnimplementedIdentityServiceServer) RegisterPlayerIdentity(context.Context, *RegisterPlayerIdentityRequest) (*RegisterPlayerIdentityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RegisterPlayerIdentity not implemented")
+}
+func (UnimplementedIdentityServiceServer) ListPlayerIdentityReviews(context.Context, *ListPlayerIdentityReviewsRequest) (*ListPlayerIdentityReviewsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPlayerIdentityReviews not implemented")
+}
+func (UnimplementedIdentityServiceServer) ResolvePlayerIdentityReview(context.Context, *ResolvePlayerIdentityReviewRequest) (*ResolvePlayerIdentityReviewResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResolvePlayerIdentityReview not implemented")
+}
+func (UnimplementedIdentityServiceServer) BeginWebAuthnRegistration(context.Context, *BeginWebAuthnRegistrationRequest) (*BeginWebAuthnRegistrationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BeginWebAuthnRegistration not implemented")
+}
+func (UnimplementedIdentityServiceServer) FinishWebAuthnRegistration(context.Context, *FinishWebAuthnRegistrationRequest) (*FinishWebAuthnRegistrationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FinishWebAuthnRegistration not implemented")
+}
+func (UnimplementedIdentityServiceServer) BeginWebAuthnLogin(context.Context, *BeginWebAuthnLoginRequest) (*BeginWebAuthnLoginResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BeginWebAuthnLogin not implemented")
+}
+func (UnimplementedIdentityServiceServer) FinishWebAuthnLogin(context.Context, *FinishWebAuthnLoginRequest) (*FinishWebAuthnLoginResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FinishWebAuthnLogin not implemented")
+}
+func (UnimplementedIdentityServiceServer) ListKeyRotations(context.Context, *ListKeyRotationsRequest) (*ListKeyRotationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListKeyRotations not implemented")
+}
+func (UnimplementedIdentityServiceServer) ListLoginHistory(context.Context, *ListLoginHistoryRequest) (*ListLoginHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListLoginHistory not implemented")
+}
+func (UnimplementedIdentityServiceServer) AssumeActor(context.Context, *AssumeActorRequest) (*AssumeActorResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AssumeActor not implemented")
+}
+func (UnimplementedIdentityServiceServer) GetIPAllowlist(context.Context, *GetIPAllowlistRequest) (*GetIPAllowlistResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetIPAllowlist not implemented")
+}
+func (UnimplementedIdentityServiceServer) SetIPAllowlist(context.Context, *SetIPAllowlistRequest) (*SetIPAllowlistResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetIPAllowlist not implemented")
+}
+func (UnimplementedIdentityServiceServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedIdentityServiceServer) RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedIdentityServiceServer) RevokeAllSessionsForActor(context.Context, *RevokeAllSessionsForActorRequest) (*RevokeAllSessionsForActorResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeAllSessionsForActor not implemented")
+}
 func (UnimplementedIdentityServiceServer) mustEmbedUnimplementedIdentityServiceServer() {}
 func (UnimplementedIdentityServiceServer) testEmbeddedByValue()                         {}
 
@@ -270,6 +544,24 @@ func _IdentityService_SetCredential_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IdentityService_ChangeCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).ChangeCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdentityService_ChangeCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).ChangeCredential(ctx, req.(*ChangeCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IdentityService_DisableCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DisableCredentialRequest)
 	if err := dec(in); err != nil {
@@ -342,6 +634,276 @@ func _IdentityService_ResetLockout_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IdentityService_RegisterPlayerIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterPlayerIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).RegisterPlayerIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdentityService_RegisterPlayerIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).RegisterPlayerIdentity(ctx, req.(*RegisterPlayerIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityService_ListPlayerIdentityReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPlayerIdentityReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).ListPlayerIdentityReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdentityService_ListPlayerIdentityReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).ListPlayerIdentityReviews(ctx, req.(*ListPlayerIdentityReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityService_ResolvePlayerIdentityReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolvePlayerIdentityReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).ResolvePlayerIdentityReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdentityService_ResolvePlayerIdentityReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).ResolvePlayerIdentityReview(ctx, req.(*ResolvePlayerIdentityReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityService_BeginWebAuthnRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeginWebAuthnRegistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).BeginWebAuthnRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdentityService_BeginWebAuthnRegistration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).BeginWebAuthnRegistration(ctx, req.(*BeginWebAuthnRegistrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityService_FinishWebAuthnRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinishWebAuthnRegistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).FinishWebAuthnRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdentityService_FinishWebAuthnRegistration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).FinishWebAuthnRegistration(ctx, req.(*FinishWebAuthnRegistrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityService_BeginWebAuthnLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeginWebAuthnLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).BeginWebAuthnLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdentityService_BeginWebAuthnLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).BeginWebAuthnLogin(ctx, req.(*BeginWebAuthnLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityService_FinishWebAuthnLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinishWebAuthnLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).FinishWebAuthnLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdentityService_FinishWebAuthnLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).FinishWebAuthnLogin(ctx, req.(*FinishWebAuthnLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityService_ListKeyRotations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKeyRotationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).ListKeyRotations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdentityService_ListKeyRotations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).ListKeyRotations(ctx, req.(*ListKeyRotationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityService_ListLoginHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLoginHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).ListLoginHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdentityService_ListLoginHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).ListLoginHistory(ctx, req.(*ListLoginHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityService_AssumeActor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssumeActorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).AssumeActor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdentityService_AssumeActor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).AssumeActor(ctx, req.(*AssumeActorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityService_GetIPAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIPAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).GetIPAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdentityService_GetIPAllowlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).GetIPAllowlist(ctx, req.(*GetIPAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityService_SetIPAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetIPAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).SetIPAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdentityService_SetIPAllowlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).SetIPAllowlist(ctx, req.(*SetIPAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdentityService_ListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdentityService_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityService_RevokeAllSessionsForActor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAllSessionsForActorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).RevokeAllSessionsForActor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdentityService_RevokeAllSessionsForActor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).RevokeAllSessionsForActor(ctx, req.(*RevokeAllSessionsForActorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // IdentityService_ServiceDesc is the grpc.ServiceDesc for IdentityService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -365,6 +927,10 @@ var IdentityService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetCredential",
 			Handler:    _IdentityService_SetCredential_Handler,
 		},
+		{
+			MethodName: "ChangeCredential",
+			Handler:    _IdentityService_ChangeCredential_Handler,
+		},
 		{
 			MethodName: "DisableCredential",
 			Handler:    _IdentityService_DisableCredential_Handler,
@@ -381,6 +947,66 @@ var IdentityService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResetLockout",
 			Handler:    _IdentityService_ResetLockout_Handler,
 		},
+		{
+			MethodName: "RegisterPlayerIdentity",
+			Handler:    _IdentityService_RegisterPlayerIdentity_Handler,
+		},
+		{
+			MethodName: "ListPlayerIdentityReviews",
+			Handler:    _IdentityService_ListPlayerIdentityReviews_Handler,
+		},
+		{
+			MethodName: "ResolvePlayerIdentityReview",
+			Handler:    _IdentityService_ResolvePlayerIdentityReview_Handler,
+		},
+		{
+			MethodName: "BeginWebAuthnRegistration",
+			Handler:    _IdentityService_BeginWebAuthnRegistration_Handler,
+		},
+		{
+			MethodName: "FinishWebAuthnRegistration",
+			Handler:    _IdentityService_FinishWebAuthnRegistration_Handler,
+		},
+		{
+			MethodName: "BeginWebAuthnLogin",
+			Handler:    _IdentityService_BeginWebAuthnLogin_Handler,
+		},
+		{
+			MethodName: "FinishWebAuthnLogin",
+			Handler:    _IdentityService_FinishWebAuthnLogin_Handler,
+		},
+		{
+			MethodName: "ListKeyRotations",
+			Handler:    _IdentityService_ListKeyRotations_Handler,
+		},
+		{
+			MethodName: "ListLoginHistory",
+			Handler:    _IdentityService_ListLoginHistory_Handler,
+		},
+		{
+			MethodName: "AssumeActor",
+			Handler:    _IdentityService_AssumeActor_Handler,
+		},
+		{
+			MethodName: "GetIPAllowlist",
+			Handler:    _IdentityService_GetIPAllowlist_Handler,
+		},
+		{
+			MethodName: "SetIPAllowlist",
+			Handler:    _IdentityService_SetIPAllowlist_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _IdentityService_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _IdentityService_RevokeSession_Handler,
+		},
+		{
+			MethodName: "RevokeAllSessionsForActor",
+			Handler:    _IdentityService_RevokeAllSessionsForActor_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rgs/v1/identity.proto",
@@ -33,19 +33,25 @@ const (
 	LedgerTransactionType_LEDGER_TRANSACTION_TYPE_GAMEPLAY_DEBIT      LedgerTransactionType = 5
 	LedgerTransactionType_LEDGER_TRANSACTION_TYPE_GAMEPLAY_CREDIT     LedgerTransactionType = 6
 	LedgerTransactionType_LEDGER_TRANSACTION_TYPE_MANUAL_ADJUSTMENT   LedgerTransactionType = 7
+	LedgerTransactionType_LEDGER_TRANSACTION_TYPE_VOID                LedgerTransactionType = 8
+	LedgerTransactionType_LEDGER_TRANSACTION_TYPE_CURRENCY_EXCHANGE   LedgerTransactionType = 9
+	LedgerTransactionType_LEDGER_TRANSACTION_TYPE_VOUCHER_REDEMPTION  LedgerTransactionType = 10
 )
 
 // Enum value maps for LedgerTransactionType.
 var (
 	LedgerTransactionType_name = map[int32]string{
-		0: "LEDGER_TRANSACTION_TYPE_UNSPECIFIED",
-		1: "LEDGER_TRANSACTION_TYPE_DEPOSIT",
-		2: "LEDGER_TRANSACTION_TYPE_WITHDRAWAL",
-		3: "LEDGER_TRANSACTION_TYPE_TRANSFER_TO_DEVICE",
-		4: "LEDGER_TRANSACTION_TYPE_TRANSFER_TO_ACCOUNT",
-		5: "LEDGER_TRANSACTION_TYPE_GAMEPLAY_DEBIT",
-		6: "LEDGER_TRANSACTION_TYPE_GAMEPLAY_CREDIT",
-		7: "LEDGER_TRANSACTION_TYPE_MANUAL_ADJUSTMENT",
+		0:  "LEDGER_TRANSACTION_TYPE_UNSPECIFIED",
+		1:  "LEDGER_TRANSACTION_TYPE_DEPOSIT",
+		2:  "LEDGER_TRANSACTION_TYPE_WITHDRAWAL",
+		3:  "LEDGER_TRANSACTION_TYPE_TRANSFER_TO_DEVICE",
+		4:  "LEDGER_TRANSACTION_TYPE_TRANSFER_TO_ACCOUNT",
+		5:  "LEDGER_TRANSACTION_TYPE_GAMEPLAY_DEBIT",
+		6:  "LEDGER_TRANSACTION_TYPE_GAMEPLAY_CREDIT",
+		7:  "LEDGER_TRANSACTION_TYPE_MANUAL_ADJUSTMENT",
+		8:  "LEDGER_TRANSACTION_TYPE_VOID",
+		9:  "LEDGER_TRANSACTION_TYPE_CURRENCY_EXCHANGE",
+		10: "LEDGER_TRANSACTION_TYPE_VOUCHER_REDEMPTION",
 	}
 	LedgerTransactionType_value = map[string]int32{
 		"LEDGER_TRANSACTION_TYPE_UNSPECIFIED":         0,
@@ -56,6 +62,9 @@ var (
 		"LEDGER_TRANSACTION_TYPE_GAMEPLAY_DEBIT":      5,
 		"LEDGER_TRANSACTION_TYPE_GAMEPLAY_CREDIT":     6,
 		"LEDGER_TRANSACTION_TYPE_MANUAL_ADJUSTMENT":   7,
+		"LEDGER_TRANSACTION_TYPE_VOID":                8,
+		"LEDGER_TRANSACTION_TYPE_CURRENCY_EXCHANGE":   9,
+		"LEDGER_TRANSACTION_TYPE_VOUCHER_REDEMPTION":  10,
 	}
 )
 
@@ -141,6 +150,422 @@ func (TransferStatus) EnumDescriptor() ([]byte, []int) {
 	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{1}
 }
 
+type UnresolvedTransferStatus int32
+
+const (
+	UnresolvedTransferStatus_UNRESOLVED_TRANSFER_STATUS_UNSPECIFIED UnresolvedTransferStatus = 0
+	UnresolvedTransferStatus_UNRESOLVED_TRANSFER_STATUS_OPEN        UnresolvedTransferStatus = 1
+	UnresolvedTransferStatus_UNRESOLVED_TRANSFER_STATUS_RESOLVED    UnresolvedTransferStatus = 2
+	UnresolvedTransferStatus_UNRESOLVED_TRANSFER_STATUS_CANCELLED   UnresolvedTransferStatus = 3
+)
+
+// Enum value maps for UnresolvedTransferStatus.
+var (
+	UnresolvedTransferStatus_name = map[int32]string{
+		0: "UNRESOLVED_TRANSFER_STATUS_UNSPECIFIED",
+		1: "UNRESOLVED_TRANSFER_STATUS_OPEN",
+		2: "UNRESOLVED_TRANSFER_STATUS_RESOLVED",
+		3: "UNRESOLVED_TRANSFER_STATUS_CANCELLED",
+	}
+	UnresolvedTransferStatus_value = map[string]int32{
+		"UNRESOLVED_TRANSFER_STATUS_UNSPECIFIED": 0,
+		"UNRESOLVED_TRANSFER_STATUS_OPEN":        1,
+		"UNRESOLVED_TRANSFER_STATUS_RESOLVED":    2,
+		"UNRESOLVED_TRANSFER_STATUS_CANCELLED":   3,
+	}
+)
+
+func (x UnresolvedTransferStatus) Enum() *UnresolvedTransferStatus {
+	p := new(UnresolvedTransferStatus)
+	*p = x
+	return p
+}
+
+func (x UnresolvedTransferStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UnresolvedTransferStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_rgs_v1_ledger_proto_enumTypes[2].Descriptor()
+}
+
+func (UnresolvedTransferStatus) Type() protoreflect.EnumType {
+	return &file_rgs_v1_ledger_proto_enumTypes[2]
+}
+
+func (x UnresolvedTransferStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UnresolvedTransferStatus.Descriptor instead.
+func (UnresolvedTransferStatus) EnumDescriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{2}
+}
+
+type TransferResolutionAction int32
+
+const (
+	TransferResolutionAction_TRANSFER_RESOLUTION_ACTION_UNSPECIFIED TransferResolutionAction = 0
+	// Device confirmed the credit landed; escrowed funds stay on the device.
+	TransferResolutionAction_TRANSFER_RESOLUTION_ACTION_ACKNOWLEDGE TransferResolutionAction = 1
+	// Transfer is being re-sent to the device; restarts the acknowledgment timer.
+	TransferResolutionAction_TRANSFER_RESOLUTION_ACTION_RETRY TransferResolutionAction = 2
+	// Device never received the credit; escrowed funds return to the account.
+	TransferResolutionAction_TRANSFER_RESOLUTION_ACTION_REVERSE TransferResolutionAction = 3
+)
+
+// Enum value maps for TransferResolutionAction.
+var (
+	TransferResolutionAction_name = map[int32]string{
+		0: "TRANSFER_RESOLUTION_ACTION_UNSPECIFIED",
+		1: "TRANSFER_RESOLUTION_ACTION_ACKNOWLEDGE",
+		2: "TRANSFER_RESOLUTION_ACTION_RETRY",
+		3: "TRANSFER_RESOLUTION_ACTION_REVERSE",
+	}
+	TransferResolutionAction_value = map[string]int32{
+		"TRANSFER_RESOLUTION_ACTION_UNSPECIFIED": 0,
+		"TRANSFER_RESOLUTION_ACTION_ACKNOWLEDGE": 1,
+		"TRANSFER_RESOLUTION_ACTION_RETRY":       2,
+		"TRANSFER_RESOLUTION_ACTION_REVERSE":     3,
+	}
+)
+
+func (x TransferResolutionAction) Enum() *TransferResolutionAction {
+	p := new(TransferResolutionAction)
+	*p = x
+	return p
+}
+
+func (x TransferResolutionAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransferResolutionAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_rgs_v1_ledger_proto_enumTypes[3].Descriptor()
+}
+
+func (TransferResolutionAction) Type() protoreflect.EnumType {
+	return &file_rgs_v1_ledger_proto_enumTypes[3]
+}
+
+func (x TransferResolutionAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransferResolutionAction.Descriptor instead.
+func (TransferResolutionAction) EnumDescriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{3}
+}
+
+type BankStatementFormat int32
+
+const (
+	BankStatementFormat_BANK_STATEMENT_FORMAT_UNSPECIFIED BankStatementFormat = 0
+	// ISO 20022 camt.053 BankToCustomerStatement XML.
+	BankStatementFormat_BANK_STATEMENT_FORMAT_CAMT053 BankStatementFormat = 1
+	// CSV with a header row of booking_date, amount, currency, reference and
+	// optional direction and description columns.
+	BankStatementFormat_BANK_STATEMENT_FORMAT_CSV BankStatementFormat = 2
+)
+
+// Enum value maps for BankStatementFormat.
+var (
+	BankStatementFormat_name = map[int32]string{
+		0: "BANK_STATEMENT_FORMAT_UNSPECIFIED",
+		1: "BANK_STATEMENT_FORMAT_CAMT053",
+		2: "BANK_STATEMENT_FORMAT_CSV",
+	}
+	BankStatementFormat_value = map[string]int32{
+		"BANK_STATEMENT_FORMAT_UNSPECIFIED": 0,
+		"BANK_STATEMENT_FORMAT_CAMT053":     1,
+		"BANK_STATEMENT_FORMAT_CSV":         2,
+	}
+)
+
+func (x BankStatementFormat) Enum() *BankStatementFormat {
+	p := new(BankStatementFormat)
+	*p = x
+	return p
+}
+
+func (x BankStatementFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BankStatementFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_rgs_v1_ledger_proto_enumTypes[4].Descriptor()
+}
+
+func (BankStatementFormat) Type() protoreflect.EnumType {
+	return &file_rgs_v1_ledger_proto_enumTypes[4]
+}
+
+func (x BankStatementFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BankStatementFormat.Descriptor instead.
+func (BankStatementFormat) EnumDescriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{4}
+}
+
+type BankEntryDirection int32
+
+const (
+	BankEntryDirection_BANK_ENTRY_DIRECTION_UNSPECIFIED BankEntryDirection = 0
+	// Money into the operator account; matched against deposits.
+	BankEntryDirection_BANK_ENTRY_DIRECTION_CREDIT BankEntryDirection = 1
+	// Money out of the operator account; matched against withdrawals.
+	BankEntryDirection_BANK_ENTRY_DIRECTION_DEBIT BankEntryDirection = 2
+)
+
+// Enum value maps for BankEntryDirection.
+var (
+	BankEntryDirection_name = map[int32]string{
+		0: "BANK_ENTRY_DIRECTION_UNSPECIFIED",
+		1: "BANK_ENTRY_DIRECTION_CREDIT",
+		2: "BANK_ENTRY_DIRECTION_DEBIT",
+	}
+	BankEntryDirection_value = map[string]int32{
+		"BANK_ENTRY_DIRECTION_UNSPECIFIED": 0,
+		"BANK_ENTRY_DIRECTION_CREDIT":      1,
+		"BANK_ENTRY_DIRECTION_DEBIT":       2,
+	}
+)
+
+func (x BankEntryDirection) Enum() *BankEntryDirection {
+	p := new(BankEntryDirection)
+	*p = x
+	return p
+}
+
+func (x BankEntryDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BankEntryDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_rgs_v1_ledger_proto_enumTypes[5].Descriptor()
+}
+
+func (BankEntryDirection) Type() protoreflect.EnumType {
+	return &file_rgs_v1_ledger_proto_enumTypes[5]
+}
+
+func (x BankEntryDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BankEntryDirection.Descriptor instead.
+func (BankEntryDirection) EnumDescriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{5}
+}
+
+type ReconciliationExceptionKind int32
+
+const (
+	ReconciliationExceptionKind_RECONCILIATION_EXCEPTION_KIND_UNSPECIFIED                  ReconciliationExceptionKind = 0
+	ReconciliationExceptionKind_RECONCILIATION_EXCEPTION_KIND_UNMATCHED_BANK_ENTRY         ReconciliationExceptionKind = 1
+	ReconciliationExceptionKind_RECONCILIATION_EXCEPTION_KIND_UNMATCHED_LEDGER_TRANSACTION ReconciliationExceptionKind = 2
+)
+
+// Enum value maps for ReconciliationExceptionKind.
+var (
+	ReconciliationExceptionKind_name = map[int32]string{
+		0: "RECONCILIATION_EXCEPTION_KIND_UNSPECIFIED",
+		1: "RECONCILIATION_EXCEPTION_KIND_UNMATCHED_BANK_ENTRY",
+		2: "RECONCILIATION_EXCEPTION_KIND_UNMATCHED_LEDGER_TRANSACTION",
+	}
+	ReconciliationExceptionKind_value = map[string]int32{
+		"RECONCILIATION_EXCEPTION_KIND_UNSPECIFIED":                  0,
+		"RECONCILIATION_EXCEPTION_KIND_UNMATCHED_BANK_ENTRY":         1,
+		"RECONCILIATION_EXCEPTION_KIND_UNMATCHED_LEDGER_TRANSACTION": 2,
+	}
+)
+
+func (x ReconciliationExceptionKind) Enum() *ReconciliationExceptionKind {
+	p := new(ReconciliationExceptionKind)
+	*p = x
+	return p
+}
+
+func (x ReconciliationExceptionKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReconciliationExceptionKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_rgs_v1_ledger_proto_enumTypes[6].Descriptor()
+}
+
+func (ReconciliationExceptionKind) Type() protoreflect.EnumType {
+	return &file_rgs_v1_ledger_proto_enumTypes[6]
+}
+
+func (x ReconciliationExceptionKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReconciliationExceptionKind.Descriptor instead.
+func (ReconciliationExceptionKind) EnumDescriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{6}
+}
+
+type ReconciliationExceptionStatus int32
+
+const (
+	ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_STATUS_UNSPECIFIED ReconciliationExceptionStatus = 0
+	ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_STATUS_OPEN        ReconciliationExceptionStatus = 1
+	ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_STATUS_RESOLVED    ReconciliationExceptionStatus = 2
+	// Matched automatically by a later statement import.
+	ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_STATUS_CLEARED ReconciliationExceptionStatus = 3
+)
+
+// Enum value maps for ReconciliationExceptionStatus.
+var (
+	ReconciliationExceptionStatus_name = map[int32]string{
+		0: "RECONCILIATION_EXCEPTION_STATUS_UNSPECIFIED",
+		1: "RECONCILIATION_EXCEPTION_STATUS_OPEN",
+		2: "RECONCILIATION_EXCEPTION_STATUS_RESOLVED",
+		3: "RECONCILIATION_EXCEPTION_STATUS_CLEARED",
+	}
+	ReconciliationExceptionStatus_value = map[string]int32{
+		"RECONCILIATION_EXCEPTION_STATUS_UNSPECIFIED": 0,
+		"RECONCILIATION_EXCEPTION_STATUS_OPEN":        1,
+		"RECONCILIATION_EXCEPTION_STATUS_RESOLVED":    2,
+		"RECONCILIATION_EXCEPTION_STATUS_CLEARED":     3,
+	}
+)
+
+func (x ReconciliationExceptionStatus) Enum() *ReconciliationExceptionStatus {
+	p := new(ReconciliationExceptionStatus)
+	*p = x
+	return p
+}
+
+func (x ReconciliationExceptionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReconciliationExceptionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_rgs_v1_ledger_proto_enumTypes[7].Descriptor()
+}
+
+func (ReconciliationExceptionStatus) Type() protoreflect.EnumType {
+	return &file_rgs_v1_ledger_proto_enumTypes[7]
+}
+
+func (x ReconciliationExceptionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReconciliationExceptionStatus.Descriptor instead.
+func (ReconciliationExceptionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{7}
+}
+
+type ReconciliationDayStatus int32
+
+const (
+	ReconciliationDayStatus_RECONCILIATION_DAY_STATUS_UNSPECIFIED  ReconciliationDayStatus = 0
+	ReconciliationDayStatus_RECONCILIATION_DAY_STATUS_NOT_IMPORTED ReconciliationDayStatus = 1
+	ReconciliationDayStatus_RECONCILIATION_DAY_STATUS_RECONCILED   ReconciliationDayStatus = 2
+	ReconciliationDayStatus_RECONCILIATION_DAY_STATUS_EXCEPTIONS   ReconciliationDayStatus = 3
+)
+
+// Enum value maps for ReconciliationDayStatus.
+var (
+	ReconciliationDayStatus_name = map[int32]string{
+		0: "RECONCILIATION_DAY_STATUS_UNSPECIFIED",
+		1: "RECONCILIATION_DAY_STATUS_NOT_IMPORTED",
+		2: "RECONCILIATION_DAY_STATUS_RECONCILED",
+		3: "RECONCILIATION_DAY_STATUS_EXCEPTIONS",
+	}
+	ReconciliationDayStatus_value = map[string]int32{
+		"RECONCILIATION_DAY_STATUS_UNSPECIFIED":  0,
+		"RECONCILIATION_DAY_STATUS_NOT_IMPORTED": 1,
+		"RECONCILIATION_DAY_STATUS_RECONCILED":   2,
+		"RECONCILIATION_DAY_STATUS_EXCEPTIONS":   3,
+	}
+)
+
+func (x ReconciliationDayStatus) Enum() *ReconciliationDayStatus {
+	p := new(ReconciliationDayStatus)
+	*p = x
+	return p
+}
+
+func (x ReconciliationDayStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReconciliationDayStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_rgs_v1_ledger_proto_enumTypes[8].Descriptor()
+}
+
+func (ReconciliationDayStatus) Type() protoreflect.EnumType {
+	return &file_rgs_v1_ledger_proto_enumTypes[8]
+}
+
+func (x ReconciliationDayStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReconciliationDayStatus.Descriptor instead.
+func (ReconciliationDayStatus) EnumDescriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{8}
+}
+
+type VoucherStatus int32
+
+const (
+	VoucherStatus_VOUCHER_STATUS_UNSPECIFIED VoucherStatus = 0
+	VoucherStatus_VOUCHER_STATUS_ISSUED      VoucherStatus = 1
+	VoucherStatus_VOUCHER_STATUS_REDEEMED    VoucherStatus = 2
+	VoucherStatus_VOUCHER_STATUS_EXPIRED     VoucherStatus = 3
+)
+
+// Enum value maps for VoucherStatus.
+var (
+	VoucherStatus_name = map[int32]string{
+		0: "VOUCHER_STATUS_UNSPECIFIED",
+		1: "VOUCHER_STATUS_ISSUED",
+		2: "VOUCHER_STATUS_REDEEMED",
+		3: "VOUCHER_STATUS_EXPIRED",
+	}
+	VoucherStatus_value = map[string]int32{
+		"VOUCHER_STATUS_UNSPECIFIED": 0,
+		"VOUCHER_STATUS_ISSUED":      1,
+		"VOUCHER_STATUS_REDEEMED":    2,
+		"VOUCHER_STATUS_EXPIRED":     3,
+	}
+)
+
+func (x VoucherStatus) Enum() *VoucherStatus {
+	p := new(VoucherStatus)
+	*p = x
+	return p
+}
+
+func (x VoucherStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VoucherStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_rgs_v1_ledger_proto_enumTypes[9].Descriptor()
+}
+
+func (VoucherStatus) Type() protoreflect.EnumType {
+	return &file_rgs_v1_ledger_proto_enumTypes[9]
+}
+
+func (x VoucherStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VoucherStatus.Descriptor instead.
+func (VoucherStatus) EnumDescriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{9}
+}
+
 type Money struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AmountMinor   int64                  `protobuf:"varint,1,opt,name=amount_minor,json=amountMinor,proto3" json:"amount_minor,omitempty"`
@@ -193,34 +618,2902 @@ func (x *Money) GetCurrency() string {
 	return ""
 }
 
-type LedgerTransaction struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	TransactionId   string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	AccountId       string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	TransactionType LedgerTransactionType  `protobuf:"varint,3,opt,name=transaction_type,json=transactionType,proto3,enum=rgs.v1.LedgerTransactionType" json:"transaction_type,omitempty"`
-	Amount          *Money                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	OccurredAt      string                 `protobuf:"bytes,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
-	AuthorizationId string                 `protobuf:"bytes,6,opt,name=authorization_id,json=authorizationId,proto3" json:"authorization_id,omitempty"`
-	Description     string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// UnresolvedTransfer tracks a transfer to device until the device
+// acknowledges it or the escrowed funds are reversed.
+type UnresolvedTransfer struct {
+	state                 protoimpl.MessageState   `protogen:"open.v1"`
+	TransferId            string                   `protobuf:"bytes,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	AccountId             string                   `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	DeviceId              string                   `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	RequestedAmount       *Money                   `protobuf:"bytes,4,opt,name=requested_amount,json=requestedAmount,proto3" json:"requested_amount,omitempty"`
+	TransferredAmount     *Money                   `protobuf:"bytes,5,opt,name=transferred_amount,json=transferredAmount,proto3" json:"transferred_amount,omitempty"`
+	Status                UnresolvedTransferStatus `protobuf:"varint,6,opt,name=status,proto3,enum=rgs.v1.UnresolvedTransferStatus" json:"status,omitempty"`
+	Reason                string                   `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	TransactionId         string                   `protobuf:"bytes,8,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	CreatedAt             string                   `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	AckDeadlineAt         string                   `protobuf:"bytes,10,opt,name=ack_deadline_at,json=ackDeadlineAt,proto3" json:"ack_deadline_at,omitempty"`
+	Attempts              int32                    `protobuf:"varint,11,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	ResolvedAt            string                   `protobuf:"bytes,12,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	Resolution            string                   `protobuf:"bytes,13,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	ResolutionNote        string                   `protobuf:"bytes,14,opt,name=resolution_note,json=resolutionNote,proto3" json:"resolution_note,omitempty"`
+	ReversalTransactionId string                   `protobuf:"bytes,15,opt,name=reversal_transaction_id,json=reversalTransactionId,proto3" json:"reversal_transaction_id,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *UnresolvedTransfer) Reset() {
+	*x = UnresolvedTransfer{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *LedgerTransaction) Reset() {
-	*x = LedgerTransaction{}
+func (x *UnresolvedTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnresolvedTransfer) ProtoMessage() {}
+
+func (x *UnresolvedTransfer) ProtoReflect() protoreflect.Message {
 	mi := &file_rgs_v1_ledger_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnresolvedTransfer.ProtoReflect.Descriptor instead.
+func (*UnresolvedTransfer) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UnresolvedTransfer) GetTransferId() string {
+	if x != nil {
+		return x.TransferId
+	}
+	return ""
+}
+
+func (x *UnresolvedTransfer) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *UnresolvedTransfer) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *UnresolvedTransfer) GetRequestedAmount() *Money {
+	if x != nil {
+		return x.RequestedAmount
+	}
+	return nil
+}
+
+func (x *UnresolvedTransfer) GetTransferredAmount() *Money {
+	if x != nil {
+		return x.TransferredAmount
+	}
+	return nil
+}
+
+func (x *UnresolvedTransfer) GetStatus() UnresolvedTransferStatus {
+	if x != nil {
+		return x.Status
+	}
+	return UnresolvedTransferStatus_UNRESOLVED_TRANSFER_STATUS_UNSPECIFIED
+}
+
+func (x *UnresolvedTransfer) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *UnresolvedTransfer) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *UnresolvedTransfer) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *UnresolvedTransfer) GetAckDeadlineAt() string {
+	if x != nil {
+		return x.AckDeadlineAt
+	}
+	return ""
+}
+
+func (x *UnresolvedTransfer) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *UnresolvedTransfer) GetResolvedAt() string {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return ""
+}
+
+func (x *UnresolvedTransfer) GetResolution() string {
+	if x != nil {
+		return x.Resolution
+	}
+	return ""
+}
+
+func (x *UnresolvedTransfer) GetResolutionNote() string {
+	if x != nil {
+		return x.ResolutionNote
+	}
+	return ""
+}
+
+func (x *UnresolvedTransfer) GetReversalTransactionId() string {
+	if x != nil {
+		return x.ReversalTransactionId
+	}
+	return ""
+}
+
+type LedgerTransaction struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId   string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	AccountId       string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	TransactionType LedgerTransactionType  `protobuf:"varint,3,opt,name=transaction_type,json=transactionType,proto3,enum=rgs.v1.LedgerTransactionType" json:"transaction_type,omitempty"`
+	Amount          *Money                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	OccurredAt      string                 `protobuf:"bytes,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	AuthorizationId string                 `protobuf:"bytes,6,opt,name=authorization_id,json=authorizationId,proto3" json:"authorization_id,omitempty"`
+	Description     string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	// Set on VOID transactions to the transaction they compensate.
+	VoidsTransactionId string `protobuf:"bytes,8,opt,name=voids_transaction_id,json=voidsTransactionId,proto3" json:"voids_transaction_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *LedgerTransaction) Reset() {
+	*x = LedgerTransaction{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LedgerTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerTransaction) ProtoMessage() {}
+
+func (x *LedgerTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerTransaction.ProtoReflect.Descriptor instead.
+func (*LedgerTransaction) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LedgerTransaction) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *LedgerTransaction) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *LedgerTransaction) GetTransactionType() LedgerTransactionType {
+	if x != nil {
+		return x.TransactionType
+	}
+	return LedgerTransactionType_LEDGER_TRANSACTION_TYPE_UNSPECIFIED
+}
+
+func (x *LedgerTransaction) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *LedgerTransaction) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+func (x *LedgerTransaction) GetAuthorizationId() string {
+	if x != nil {
+		return x.AuthorizationId
+	}
+	return ""
+}
+
+func (x *LedgerTransaction) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *LedgerTransaction) GetVoidsTransactionId() string {
+	if x != nil {
+		return x.VoidsTransactionId
+	}
+	return ""
+}
+
+type GetBalanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBalanceRequest) Reset() {
+	*x = GetBalanceRequest{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceRequest) ProtoMessage() {}
+
+func (x *GetBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetBalanceRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GetBalanceRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+type GetBalanceResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Meta             *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	AccountId        string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AvailableBalance *Money                 `protobuf:"bytes,3,opt,name=available_balance,json=availableBalance,proto3" json:"available_balance,omitempty"`
+	PendingBalance   *Money                 `protobuf:"bytes,4,opt,name=pending_balance,json=pendingBalance,proto3" json:"pending_balance,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetBalanceResponse) Reset() {
+	*x = GetBalanceResponse{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceResponse) ProtoMessage() {}
+
+func (x *GetBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceResponse.ProtoReflect.Descriptor instead.
+func (*GetBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetBalanceResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GetBalanceResponse) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *GetBalanceResponse) GetAvailableBalance() *Money {
+	if x != nil {
+		return x.AvailableBalance
+	}
+	return nil
+}
+
+func (x *GetBalanceResponse) GetPendingBalance() *Money {
+	if x != nil {
+		return x.PendingBalance
+	}
+	return nil
+}
+
+type DepositRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Meta            *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	AccountId       string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Amount          *Money                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	AuthorizationId string                 `protobuf:"bytes,4,opt,name=authorization_id,json=authorizationId,proto3" json:"authorization_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DepositRequest) Reset() {
+	*x = DepositRequest{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepositRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositRequest) ProtoMessage() {}
+
+func (x *DepositRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositRequest.ProtoReflect.Descriptor instead.
+func (*DepositRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DepositRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *DepositRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *DepositRequest) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *DepositRequest) GetAuthorizationId() string {
+	if x != nil {
+		return x.AuthorizationId
+	}
+	return ""
+}
+
+type DepositResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Meta             *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Transaction      *LedgerTransaction     `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	AvailableBalance *Money                 `protobuf:"bytes,3,opt,name=available_balance,json=availableBalance,proto3" json:"available_balance,omitempty"`
+	// Set when the account's balance cap accepted only part of the amount;
+	// the transaction carries the credited part.
+	RefusedAmount *Money `protobuf:"bytes,4,opt,name=refused_amount,json=refusedAmount,proto3" json:"refused_amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DepositResponse) Reset() {
+	*x = DepositResponse{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepositResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositResponse) ProtoMessage() {}
+
+func (x *DepositResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositResponse.ProtoReflect.Descriptor instead.
+func (*DepositResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DepositResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *DepositResponse) GetTransaction() *LedgerTransaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *DepositResponse) GetAvailableBalance() *Money {
+	if x != nil {
+		return x.AvailableBalance
+	}
+	return nil
+}
+
+func (x *DepositResponse) GetRefusedAmount() *Money {
+	if x != nil {
+		return x.RefusedAmount
+	}
+	return nil
+}
+
+type WithdrawRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawRequest) Reset() {
+	*x = WithdrawRequest{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawRequest) ProtoMessage() {}
+
+func (x *WithdrawRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *WithdrawRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *WithdrawRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *WithdrawRequest) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type WithdrawResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Meta             *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Transaction      *LedgerTransaction     `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	AvailableBalance *Money                 `protobuf:"bytes,3,opt,name=available_balance,json=availableBalance,proto3" json:"available_balance,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *WithdrawResponse) Reset() {
+	*x = WithdrawResponse{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawResponse) ProtoMessage() {}
+
+func (x *WithdrawResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawResponse.ProtoReflect.Descriptor instead.
+func (*WithdrawResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *WithdrawResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *WithdrawResponse) GetTransaction() *LedgerTransaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *WithdrawResponse) GetAvailableBalance() *Money {
+	if x != nil {
+		return x.AvailableBalance
+	}
+	return nil
+}
+
+type TransferToDeviceRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Meta            *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	AccountId       string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	DeviceId        string                 `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	RequestedAmount *Money                 `protobuf:"bytes,4,opt,name=requested_amount,json=requestedAmount,proto3" json:"requested_amount,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TransferToDeviceRequest) Reset() {
+	*x = TransferToDeviceRequest{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferToDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferToDeviceRequest) ProtoMessage() {}
+
+func (x *TransferToDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferToDeviceRequest.ProtoReflect.Descriptor instead.
+func (*TransferToDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TransferToDeviceRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *TransferToDeviceRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *TransferToDeviceRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *TransferToDeviceRequest) GetRequestedAmount() *Money {
+	if x != nil {
+		return x.RequestedAmount
+	}
+	return nil
+}
+
+type TransferToDeviceResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Meta              *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	TransferId        string                 `protobuf:"bytes,2,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	TransferStatus    TransferStatus         `protobuf:"varint,3,opt,name=transfer_status,json=transferStatus,proto3,enum=rgs.v1.TransferStatus" json:"transfer_status,omitempty"`
+	TransferredAmount *Money                 `protobuf:"bytes,4,opt,name=transferred_amount,json=transferredAmount,proto3" json:"transferred_amount,omitempty"`
+	AvailableBalance  *Money                 `protobuf:"bytes,5,opt,name=available_balance,json=availableBalance,proto3" json:"available_balance,omitempty"`
+	UnresolvedReason  string                 `protobuf:"bytes,6,opt,name=unresolved_reason,json=unresolvedReason,proto3" json:"unresolved_reason,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TransferToDeviceResponse) Reset() {
+	*x = TransferToDeviceResponse{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferToDeviceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferToDeviceResponse) ProtoMessage() {}
+
+func (x *TransferToDeviceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferToDeviceResponse.ProtoReflect.Descriptor instead.
+func (*TransferToDeviceResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TransferToDeviceResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *TransferToDeviceResponse) GetTransferId() string {
+	if x != nil {
+		return x.TransferId
+	}
+	return ""
+}
+
+func (x *TransferToDeviceResponse) GetTransferStatus() TransferStatus {
+	if x != nil {
+		return x.TransferStatus
+	}
+	return TransferStatus_TRANSFER_STATUS_UNSPECIFIED
+}
+
+func (x *TransferToDeviceResponse) GetTransferredAmount() *Money {
+	if x != nil {
+		return x.TransferredAmount
+	}
+	return nil
+}
+
+func (x *TransferToDeviceResponse) GetAvailableBalance() *Money {
+	if x != nil {
+		return x.AvailableBalance
+	}
+	return nil
+}
+
+func (x *TransferToDeviceResponse) GetUnresolvedReason() string {
+	if x != nil {
+		return x.UnresolvedReason
+	}
+	return ""
+}
+
+type TransferToAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferToAccountRequest) Reset() {
+	*x = TransferToAccountRequest{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferToAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferToAccountRequest) ProtoMessage() {}
+
+func (x *TransferToAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferToAccountRequest.ProtoReflect.Descriptor instead.
+func (*TransferToAccountRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TransferToAccountRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *TransferToAccountRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *TransferToAccountRequest) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type TransferToAccountResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Meta             *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Transaction      *LedgerTransaction     `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	AvailableBalance *Money                 `protobuf:"bytes,3,opt,name=available_balance,json=availableBalance,proto3" json:"available_balance,omitempty"`
+	// Set when the account's balance cap accepted only part of the amount;
+	// the transaction carries the credited part.
+	RefusedAmount *Money `protobuf:"bytes,4,opt,name=refused_amount,json=refusedAmount,proto3" json:"refused_amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferToAccountResponse) Reset() {
+	*x = TransferToAccountResponse{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferToAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferToAccountResponse) ProtoMessage() {}
+
+func (x *TransferToAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferToAccountResponse.ProtoReflect.Descriptor instead.
+func (*TransferToAccountResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TransferToAccountResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *TransferToAccountResponse) GetTransaction() *LedgerTransaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *TransferToAccountResponse) GetAvailableBalance() *Money {
+	if x != nil {
+		return x.AvailableBalance
+	}
+	return nil
+}
+
+func (x *TransferToAccountResponse) GetRefusedAmount() *Money {
+	if x != nil {
+		return x.RefusedAmount
+	}
+	return nil
+}
+
+type ListTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	FromTime      string                 `protobuf:"bytes,5,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`
+	ToTime        string                 `protobuf:"bytes,6,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransactionsRequest) Reset() {
+	*x = ListTransactionsRequest{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionsRequest) ProtoMessage() {}
+
+func (x *ListTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListTransactionsRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListTransactionsRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTransactionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetFromTime() string {
+	if x != nil {
+		return x.FromTime
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetToTime() string {
+	if x != nil {
+		return x.ToTime
+	}
+	return ""
+}
+
+type ListTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Transactions  []*LedgerTransaction   `protobuf:"bytes,2,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransactionsResponse) Reset() {
+	*x = ListTransactionsResponse{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionsResponse) ProtoMessage() {}
+
+func (x *ListTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListTransactionsResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListTransactionsResponse) GetTransactions() []*LedgerTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *ListTransactionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListUnresolvedTransfersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	DeviceId      string                 `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	IncludeClosed bool                   `protobuf:"varint,4,opt,name=include_closed,json=includeClosed,proto3" json:"include_closed,omitempty"`
+	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUnresolvedTransfersRequest) Reset() {
+	*x = ListUnresolvedTransfersRequest{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUnresolvedTransfersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUnresolvedTransfersRequest) ProtoMessage() {}
+
+func (x *ListUnresolvedTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUnresolvedTransfersRequest.ProtoReflect.Descriptor instead.
+func (*ListUnresolvedTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListUnresolvedTransfersRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListUnresolvedTransfersRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ListUnresolvedTransfersRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *ListUnresolvedTransfersRequest) GetIncludeClosed() bool {
+	if x != nil {
+		return x.IncludeClosed
+	}
+	return false
+}
+
+func (x *ListUnresolvedTransfersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListUnresolvedTransfersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListUnresolvedTransfersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Transfers     []*UnresolvedTransfer  `protobuf:"bytes,2,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUnresolvedTransfersResponse) Reset() {
+	*x = ListUnresolvedTransfersResponse{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUnresolvedTransfersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUnresolvedTransfersResponse) ProtoMessage() {}
+
+func (x *ListUnresolvedTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUnresolvedTransfersResponse.ProtoReflect.Descriptor instead.
+func (*ListUnresolvedTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListUnresolvedTransfersResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListUnresolvedTransfersResponse) GetTransfers() []*UnresolvedTransfer {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+func (x *ListUnresolvedTransfersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ResolveTransferRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Meta          *RequestMeta             `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	TransferId    string                   `protobuf:"bytes,2,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	Action        TransferResolutionAction `protobuf:"varint,3,opt,name=action,proto3,enum=rgs.v1.TransferResolutionAction" json:"action,omitempty"`
+	Note          string                   `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveTransferRequest) Reset() {
+	*x = ResolveTransferRequest{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveTransferRequest) ProtoMessage() {}
+
+func (x *ResolveTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveTransferRequest.ProtoReflect.Descriptor instead.
+func (*ResolveTransferRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ResolveTransferRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ResolveTransferRequest) GetTransferId() string {
+	if x != nil {
+		return x.TransferId
+	}
+	return ""
+}
+
+func (x *ResolveTransferRequest) GetAction() TransferResolutionAction {
+	if x != nil {
+		return x.Action
+	}
+	return TransferResolutionAction_TRANSFER_RESOLUTION_ACTION_UNSPECIFIED
+}
+
+func (x *ResolveTransferRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ResolveTransferResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Meta                *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Transfer            *UnresolvedTransfer    `protobuf:"bytes,2,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	ReversalTransaction *LedgerTransaction     `protobuf:"bytes,3,opt,name=reversal_transaction,json=reversalTransaction,proto3" json:"reversal_transaction,omitempty"`
+	AvailableBalance    *Money                 `protobuf:"bytes,4,opt,name=available_balance,json=availableBalance,proto3" json:"available_balance,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ResolveTransferResponse) Reset() {
+	*x = ResolveTransferResponse{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveTransferResponse) ProtoMessage() {}
+
+func (x *ResolveTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveTransferResponse.ProtoReflect.Descriptor instead.
+func (*ResolveTransferResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ResolveTransferResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ResolveTransferResponse) GetTransfer() *UnresolvedTransfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+func (x *ResolveTransferResponse) GetReversalTransaction() *LedgerTransaction {
+	if x != nil {
+		return x.ReversalTransaction
+	}
+	return nil
+}
+
+func (x *ResolveTransferResponse) GetAvailableBalance() *Money {
+	if x != nil {
+		return x.AvailableBalance
+	}
+	return nil
+}
+
+// EFTLockout is the fraud-control state for an account's EFT failures.
+type EFTLockout struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AccountId      string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	FailedAttempts int32                  `protobuf:"varint,2,opt,name=failed_attempts,json=failedAttempts,proto3" json:"failed_attempts,omitempty"`
+	Locked         bool                   `protobuf:"varint,3,opt,name=locked,proto3" json:"locked,omitempty"`
+	LockedUntil    string                 `protobuf:"bytes,4,opt,name=locked_until,json=lockedUntil,proto3" json:"locked_until,omitempty"`
+	UpdatedAt      string                 `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *EFTLockout) Reset() {
+	*x = EFTLockout{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EFTLockout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EFTLockout) ProtoMessage() {}
+
+func (x *EFTLockout) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EFTLockout.ProtoReflect.Descriptor instead.
+func (*EFTLockout) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *EFTLockout) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *EFTLockout) GetFailedAttempts() int32 {
+	if x != nil {
+		return x.FailedAttempts
+	}
+	return 0
+}
+
+func (x *EFTLockout) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+func (x *EFTLockout) GetLockedUntil() string {
+	if x != nil {
+		return x.LockedUntil
+	}
+	return ""
+}
+
+func (x *EFTLockout) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type GetEFTLockoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEFTLockoutRequest) Reset() {
+	*x = GetEFTLockoutRequest{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEFTLockoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEFTLockoutRequest) ProtoMessage() {}
+
+func (x *GetEFTLockoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEFTLockoutRequest.ProtoReflect.Descriptor instead.
+func (*GetEFTLockoutRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetEFTLockoutRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GetEFTLockoutRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+type GetEFTLockoutResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Meta              *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Lockout           *EFTLockout            `protobuf:"bytes,2,opt,name=lockout,proto3" json:"lockout,omitempty"`
+	MaxFailures       int32                  `protobuf:"varint,3,opt,name=max_failures,json=maxFailures,proto3" json:"max_failures,omitempty"`
+	LockoutTtlSeconds int64                  `protobuf:"varint,4,opt,name=lockout_ttl_seconds,json=lockoutTtlSeconds,proto3" json:"lockout_ttl_seconds,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetEFTLockoutResponse) Reset() {
+	*x = GetEFTLockoutResponse{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEFTLockoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEFTLockoutResponse) ProtoMessage() {}
+
+func (x *GetEFTLockoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEFTLockoutResponse.ProtoReflect.Descriptor instead.
+func (*GetEFTLockoutResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetEFTLockoutResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GetEFTLockoutResponse) GetLockout() *EFTLockout {
+	if x != nil {
+		return x.Lockout
+	}
+	return nil
+}
+
+func (x *GetEFTLockoutResponse) GetMaxFailures() int32 {
+	if x != nil {
+		return x.MaxFailures
+	}
+	return 0
+}
+
+func (x *GetEFTLockoutResponse) GetLockoutTtlSeconds() int64 {
+	if x != nil {
+		return x.LockoutTtlSeconds
+	}
+	return 0
+}
+
+type ListEFTLockoutsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	LockedOnly    bool                   `protobuf:"varint,2,opt,name=locked_only,json=lockedOnly,proto3" json:"locked_only,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEFTLockoutsRequest) Reset() {
+	*x = ListEFTLockoutsRequest{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEFTLockoutsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEFTLockoutsRequest) ProtoMessage() {}
+
+func (x *ListEFTLockoutsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEFTLockoutsRequest.ProtoReflect.Descriptor instead.
+func (*ListEFTLockoutsRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListEFTLockoutsRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListEFTLockoutsRequest) GetLockedOnly() bool {
+	if x != nil {
+		return x.LockedOnly
+	}
+	return false
+}
+
+func (x *ListEFTLockoutsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListEFTLockoutsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListEFTLockoutsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Lockouts      []*EFTLockout          `protobuf:"bytes,2,rep,name=lockouts,proto3" json:"lockouts,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEFTLockoutsResponse) Reset() {
+	*x = ListEFTLockoutsResponse{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEFTLockoutsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEFTLockoutsResponse) ProtoMessage() {}
+
+func (x *ListEFTLockoutsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEFTLockoutsResponse.ProtoReflect.Descriptor instead.
+func (*ListEFTLockoutsResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListEFTLockoutsResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListEFTLockoutsResponse) GetLockouts() []*EFTLockout {
+	if x != nil {
+		return x.Lockouts
+	}
+	return nil
+}
+
+func (x *ListEFTLockoutsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ResetEFTLockoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetEFTLockoutRequest) Reset() {
+	*x = ResetEFTLockoutRequest{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetEFTLockoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetEFTLockoutRequest) ProtoMessage() {}
+
+func (x *ResetEFTLockoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetEFTLockoutRequest.ProtoReflect.Descriptor instead.
+func (*ResetEFTLockoutRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ResetEFTLockoutRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ResetEFTLockoutRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ResetEFTLockoutRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ResetEFTLockoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Lockout       *EFTLockout            `protobuf:"bytes,2,opt,name=lockout,proto3" json:"lockout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetEFTLockoutResponse) Reset() {
+	*x = ResetEFTLockoutResponse{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetEFTLockoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetEFTLockoutResponse) ProtoMessage() {}
+
+func (x *ResetEFTLockoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetEFTLockoutResponse.ProtoReflect.Descriptor instead.
+func (*ResetEFTLockoutResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ResetEFTLockoutResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ResetEFTLockoutResponse) GetLockout() *EFTLockout {
+	if x != nil {
+		return x.Lockout
+	}
+	return nil
+}
+
+type VoidTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	TransactionId string                 `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoidTransactionRequest) Reset() {
+	*x = VoidTransactionRequest{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoidTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidTransactionRequest) ProtoMessage() {}
+
+func (x *VoidTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidTransactionRequest.ProtoReflect.Descriptor instead.
+func (*VoidTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *VoidTransactionRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *VoidTransactionRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *VoidTransactionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type VoidTransactionResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Meta                *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	OriginalTransaction *LedgerTransaction     `protobuf:"bytes,2,opt,name=original_transaction,json=originalTransaction,proto3" json:"original_transaction,omitempty"`
+	VoidTransaction     *LedgerTransaction     `protobuf:"bytes,3,opt,name=void_transaction,json=voidTransaction,proto3" json:"void_transaction,omitempty"`
+	AvailableBalance    *Money                 `protobuf:"bytes,4,opt,name=available_balance,json=availableBalance,proto3" json:"available_balance,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *VoidTransactionResponse) Reset() {
+	*x = VoidTransactionResponse{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoidTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidTransactionResponse) ProtoMessage() {}
+
+func (x *VoidTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidTransactionResponse.ProtoReflect.Descriptor instead.
+func (*VoidTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *VoidTransactionResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *VoidTransactionResponse) GetOriginalTransaction() *LedgerTransaction {
+	if x != nil {
+		return x.OriginalTransaction
+	}
+	return nil
+}
+
+func (x *VoidTransactionResponse) GetVoidTransaction() *LedgerTransaction {
+	if x != nil {
+		return x.VoidTransaction
+	}
+	return nil
+}
+
+func (x *VoidTransactionResponse) GetAvailableBalance() *Money {
+	if x != nil {
+		return x.AvailableBalance
+	}
+	return nil
+}
+
+// ExchangeCurrencyRequest converts part of an account's balance in one
+// currency into another. Balances in currencies other than the account's
+// primary currency are held in sub-accounts named "<account_id>:<CURRENCY>".
+type ExchangeCurrencyRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Meta      *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	AccountId string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// Amount to debit, in the source currency.
+	Amount        *Money `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	ToCurrency    string `protobuf:"bytes,4,opt,name=to_currency,json=toCurrency,proto3" json:"to_currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExchangeCurrencyRequest) Reset() {
+	*x = ExchangeCurrencyRequest{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeCurrencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeCurrencyRequest) ProtoMessage() {}
+
+func (x *ExchangeCurrencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeCurrencyRequest.ProtoReflect.Descriptor instead.
+func (*ExchangeCurrencyRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ExchangeCurrencyRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ExchangeCurrencyRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ExchangeCurrencyRequest) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *ExchangeCurrencyRequest) GetToCurrency() string {
+	if x != nil {
+		return x.ToCurrency
+	}
+	return ""
+}
+
+type ExchangeCurrencyResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Meta        *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Transaction *LedgerTransaction     `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	// Amount credited in to_currency, rounded down to whole minor units.
+	ExchangedAmount *Money `protobuf:"bytes,3,opt,name=exchanged_amount,json=exchangedAmount,proto3" json:"exchanged_amount,omitempty"`
+	// Decimal rate applied: units of to_currency per unit of the source currency.
+	Rate          string `protobuf:"bytes,4,opt,name=rate,proto3" json:"rate,omitempty"`
+	FromBalance   *Money `protobuf:"bytes,5,opt,name=from_balance,json=fromBalance,proto3" json:"from_balance,omitempty"`
+	ToBalance     *Money `protobuf:"bytes,6,opt,name=to_balance,json=toBalance,proto3" json:"to_balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExchangeCurrencyResponse) Reset() {
+	*x = ExchangeCurrencyResponse{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeCurrencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeCurrencyResponse) ProtoMessage() {}
+
+func (x *ExchangeCurrencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeCurrencyResponse.ProtoReflect.Descriptor instead.
+func (*ExchangeCurrencyResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ExchangeCurrencyResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ExchangeCurrencyResponse) GetTransaction() *LedgerTransaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *ExchangeCurrencyResponse) GetExchangedAmount() *Money {
+	if x != nil {
+		return x.ExchangedAmount
+	}
+	return nil
+}
+
+func (x *ExchangeCurrencyResponse) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+func (x *ExchangeCurrencyResponse) GetFromBalance() *Money {
+	if x != nil {
+		return x.FromBalance
+	}
+	return nil
+}
+
+func (x *ExchangeCurrencyResponse) GetToBalance() *Money {
+	if x != nil {
+		return x.ToBalance
+	}
+	return nil
+}
+
+type BankStatementEntry struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	EntryId     string                 `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	StatementId string                 `protobuf:"bytes,2,opt,name=statement_id,json=statementId,proto3" json:"statement_id,omitempty"`
+	// Booking date, YYYY-MM-DD.
+	BankingDay string             `protobuf:"bytes,3,opt,name=banking_day,json=bankingDay,proto3" json:"banking_day,omitempty"`
+	Direction  BankEntryDirection `protobuf:"varint,4,opt,name=direction,proto3,enum=rgs.v1.BankEntryDirection" json:"direction,omitempty"`
+	// Always positive; direction carries the sign.
+	Amount               *Money `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reference            string `protobuf:"bytes,6,opt,name=reference,proto3" json:"reference,omitempty"`
+	Description          string `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	MatchedTransactionId string `protobuf:"bytes,8,opt,name=matched_transaction_id,json=matchedTransactionId,proto3" json:"matched_transaction_id,omitempty"`
+	ManuallyMatched      bool   `protobuf:"varint,9,opt,name=manually_matched,json=manuallyMatched,proto3" json:"manually_matched,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *BankStatementEntry) Reset() {
+	*x = BankStatementEntry{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BankStatementEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BankStatementEntry) ProtoMessage() {}
+
+func (x *BankStatementEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BankStatementEntry.ProtoReflect.Descriptor instead.
+func (*BankStatementEntry) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *BankStatementEntry) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+func (x *BankStatementEntry) GetStatementId() string {
+	if x != nil {
+		return x.StatementId
+	}
+	return ""
+}
+
+func (x *BankStatementEntry) GetBankingDay() string {
+	if x != nil {
+		return x.BankingDay
+	}
+	return ""
+}
+
+func (x *BankStatementEntry) GetDirection() BankEntryDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return BankEntryDirection_BANK_ENTRY_DIRECTION_UNSPECIFIED
+}
+
+func (x *BankStatementEntry) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *BankStatementEntry) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *BankStatementEntry) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *BankStatementEntry) GetMatchedTransactionId() string {
+	if x != nil {
+		return x.MatchedTransactionId
+	}
+	return ""
+}
+
+func (x *BankStatementEntry) GetManuallyMatched() bool {
+	if x != nil {
+		return x.ManuallyMatched
+	}
+	return false
+}
+
+type ReconciliationException struct {
+	state       protoimpl.MessageState        `protogen:"open.v1"`
+	ExceptionId string                        `protobuf:"bytes,1,opt,name=exception_id,json=exceptionId,proto3" json:"exception_id,omitempty"`
+	BankingDay  string                        `protobuf:"bytes,2,opt,name=banking_day,json=bankingDay,proto3" json:"banking_day,omitempty"`
+	Kind        ReconciliationExceptionKind   `protobuf:"varint,3,opt,name=kind,proto3,enum=rgs.v1.ReconciliationExceptionKind" json:"kind,omitempty"`
+	Status      ReconciliationExceptionStatus `protobuf:"varint,4,opt,name=status,proto3,enum=rgs.v1.ReconciliationExceptionStatus" json:"status,omitempty"`
+	// Set for UNMATCHED_BANK_ENTRY.
+	Entry *BankStatementEntry `protobuf:"bytes,5,opt,name=entry,proto3" json:"entry,omitempty"`
+	// Set for UNMATCHED_LEDGER_TRANSACTION.
+	Transaction    *LedgerTransaction `protobuf:"bytes,6,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	CreatedAt      string             `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ResolvedAt     string             `protobuf:"bytes,8,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	ResolvedBy     string             `protobuf:"bytes,9,opt,name=resolved_by,json=resolvedBy,proto3" json:"resolved_by,omitempty"`
+	ResolutionNote string             `protobuf:"bytes,10,opt,name=resolution_note,json=resolutionNote,proto3" json:"resolution_note,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReconciliationException) Reset() {
+	*x = ReconciliationException{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconciliationException) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconciliationException) ProtoMessage() {}
+
+func (x *ReconciliationException) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconciliationException.ProtoReflect.Descriptor instead.
+func (*ReconciliationException) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ReconciliationException) GetExceptionId() string {
+	if x != nil {
+		return x.ExceptionId
+	}
+	return ""
+}
+
+func (x *ReconciliationException) GetBankingDay() string {
+	if x != nil {
+		return x.BankingDay
+	}
+	return ""
+}
+
+func (x *ReconciliationException) GetKind() ReconciliationExceptionKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ReconciliationExceptionKind_RECONCILIATION_EXCEPTION_KIND_UNSPECIFIED
+}
+
+func (x *ReconciliationException) GetStatus() ReconciliationExceptionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_STATUS_UNSPECIFIED
+}
+
+func (x *ReconciliationException) GetEntry() *BankStatementEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *ReconciliationException) GetTransaction() *LedgerTransaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *ReconciliationException) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ReconciliationException) GetResolvedAt() string {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return ""
+}
+
+func (x *ReconciliationException) GetResolvedBy() string {
+	if x != nil {
+		return x.ResolvedBy
+	}
+	return ""
+}
+
+func (x *ReconciliationException) GetResolutionNote() string {
+	if x != nil {
+		return x.ResolutionNote
+	}
+	return ""
+}
+
+type ReconciliationTotals struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Currency               string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	BankCreditsMinor       int64                  `protobuf:"varint,2,opt,name=bank_credits_minor,json=bankCreditsMinor,proto3" json:"bank_credits_minor,omitempty"`
+	BankDebitsMinor        int64                  `protobuf:"varint,3,opt,name=bank_debits_minor,json=bankDebitsMinor,proto3" json:"bank_debits_minor,omitempty"`
+	LedgerDepositsMinor    int64                  `protobuf:"varint,4,opt,name=ledger_deposits_minor,json=ledgerDepositsMinor,proto3" json:"ledger_deposits_minor,omitempty"`
+	LedgerWithdrawalsMinor int64                  `protobuf:"varint,5,opt,name=ledger_withdrawals_minor,json=ledgerWithdrawalsMinor,proto3" json:"ledger_withdrawals_minor,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ReconciliationTotals) Reset() {
+	*x = ReconciliationTotals{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconciliationTotals) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconciliationTotals) ProtoMessage() {}
+
+func (x *ReconciliationTotals) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconciliationTotals.ProtoReflect.Descriptor instead.
+func (*ReconciliationTotals) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ReconciliationTotals) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ReconciliationTotals) GetBankCreditsMinor() int64 {
+	if x != nil {
+		return x.BankCreditsMinor
+	}
+	return 0
+}
+
+func (x *ReconciliationTotals) GetBankDebitsMinor() int64 {
+	if x != nil {
+		return x.BankDebitsMinor
+	}
+	return 0
+}
+
+func (x *ReconciliationTotals) GetLedgerDepositsMinor() int64 {
+	if x != nil {
+		return x.LedgerDepositsMinor
+	}
+	return 0
+}
+
+func (x *ReconciliationTotals) GetLedgerWithdrawalsMinor() int64 {
+	if x != nil {
+		return x.LedgerWithdrawalsMinor
+	}
+	return 0
+}
+
+type ReconciliationDayReport struct {
+	state              protoimpl.MessageState  `protogen:"open.v1"`
+	BankingDay         string                  `protobuf:"bytes,1,opt,name=banking_day,json=bankingDay,proto3" json:"banking_day,omitempty"`
+	Status             ReconciliationDayStatus `protobuf:"varint,2,opt,name=status,proto3,enum=rgs.v1.ReconciliationDayStatus" json:"status,omitempty"`
+	StatementEntries   int32                   `protobuf:"varint,3,opt,name=statement_entries,json=statementEntries,proto3" json:"statement_entries,omitempty"`
+	MatchedEntries     int32                   `protobuf:"varint,4,opt,name=matched_entries,json=matchedEntries,proto3" json:"matched_entries,omitempty"`
+	LedgerTransactions int32                   `protobuf:"varint,5,opt,name=ledger_transactions,json=ledgerTransactions,proto3" json:"ledger_transactions,omitempty"`
+	OpenExceptions     int32                   `protobuf:"varint,6,opt,name=open_exceptions,json=openExceptions,proto3" json:"open_exceptions,omitempty"`
+	ResolvedExceptions int32                   `protobuf:"varint,7,opt,name=resolved_exceptions,json=resolvedExceptions,proto3" json:"resolved_exceptions,omitempty"`
+	Totals             []*ReconciliationTotals `protobuf:"bytes,8,rep,name=totals,proto3" json:"totals,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ReconciliationDayReport) Reset() {
+	*x = ReconciliationDayReport{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconciliationDayReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconciliationDayReport) ProtoMessage() {}
+
+func (x *ReconciliationDayReport) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconciliationDayReport.ProtoReflect.Descriptor instead.
+func (*ReconciliationDayReport) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ReconciliationDayReport) GetBankingDay() string {
+	if x != nil {
+		return x.BankingDay
+	}
+	return ""
+}
+
+func (x *ReconciliationDayReport) GetStatus() ReconciliationDayStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReconciliationDayStatus_RECONCILIATION_DAY_STATUS_UNSPECIFIED
+}
+
+func (x *ReconciliationDayReport) GetStatementEntries() int32 {
+	if x != nil {
+		return x.StatementEntries
+	}
+	return 0
+}
+
+func (x *ReconciliationDayReport) GetMatchedEntries() int32 {
+	if x != nil {
+		return x.MatchedEntries
+	}
+	return 0
+}
+
+func (x *ReconciliationDayReport) GetLedgerTransactions() int32 {
+	if x != nil {
+		return x.LedgerTransactions
+	}
+	return 0
+}
+
+func (x *ReconciliationDayReport) GetOpenExceptions() int32 {
+	if x != nil {
+		return x.OpenExceptions
+	}
+	return 0
+}
+
+func (x *ReconciliationDayReport) GetResolvedExceptions() int32 {
+	if x != nil {
+		return x.ResolvedExceptions
+	}
+	return 0
+}
+
+func (x *ReconciliationDayReport) GetTotals() []*ReconciliationTotals {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+type ImportBankStatementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Format        BankStatementFormat    `protobuf:"varint,2,opt,name=format,proto3,enum=rgs.v1.BankStatementFormat" json:"format,omitempty"`
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportBankStatementRequest) Reset() {
+	*x = ImportBankStatementRequest{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportBankStatementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportBankStatementRequest) ProtoMessage() {}
+
+func (x *ImportBankStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportBankStatementRequest.ProtoReflect.Descriptor instead.
+func (*ImportBankStatementRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ImportBankStatementRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ImportBankStatementRequest) GetFormat() BankStatementFormat {
+	if x != nil {
+		return x.Format
+	}
+	return BankStatementFormat_BANK_STATEMENT_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportBankStatementRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type ImportBankStatementResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Meta            *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	StatementId     string                 `protobuf:"bytes,2,opt,name=statement_id,json=statementId,proto3" json:"statement_id,omitempty"`
+	EntriesImported int32                  `protobuf:"varint,3,opt,name=entries_imported,json=entriesImported,proto3" json:"entries_imported,omitempty"`
+	EntriesMatched  int32                  `protobuf:"varint,4,opt,name=entries_matched,json=entriesMatched,proto3" json:"entries_matched,omitempty"`
+	// Booking dates covered by the statement; each is re-reconciled.
+	BankingDays      []string `protobuf:"bytes,5,rep,name=banking_days,json=bankingDays,proto3" json:"banking_days,omitempty"`
+	ExceptionsOpened int32    `protobuf:"varint,6,opt,name=exceptions_opened,json=exceptionsOpened,proto3" json:"exceptions_opened,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ImportBankStatementResponse) Reset() {
+	*x = ImportBankStatementResponse{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportBankStatementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportBankStatementResponse) ProtoMessage() {}
+
+func (x *ImportBankStatementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportBankStatementResponse.ProtoReflect.Descriptor instead.
+func (*ImportBankStatementResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ImportBankStatementResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ImportBankStatementResponse) GetStatementId() string {
+	if x != nil {
+		return x.StatementId
+	}
+	return ""
+}
+
+func (x *ImportBankStatementResponse) GetEntriesImported() int32 {
+	if x != nil {
+		return x.EntriesImported
+	}
+	return 0
+}
+
+func (x *ImportBankStatementResponse) GetEntriesMatched() int32 {
+	if x != nil {
+		return x.EntriesMatched
+	}
+	return 0
+}
+
+func (x *ImportBankStatementResponse) GetBankingDays() []string {
+	if x != nil {
+		return x.BankingDays
+	}
+	return nil
+}
+
+func (x *ImportBankStatementResponse) GetExceptionsOpened() int32 {
+	if x != nil {
+		return x.ExceptionsOpened
+	}
+	return 0
+}
+
+type ListReconciliationExceptionsRequest struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Meta          *RequestMeta                  `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	BankingDay    string                        `protobuf:"bytes,2,opt,name=banking_day,json=bankingDay,proto3" json:"banking_day,omitempty"`
+	Status        ReconciliationExceptionStatus `protobuf:"varint,3,opt,name=status,proto3,enum=rgs.v1.ReconciliationExceptionStatus" json:"status,omitempty"`
+	PageSize      int32                         `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                        `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReconciliationExceptionsRequest) Reset() {
+	*x = ListReconciliationExceptionsRequest{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReconciliationExceptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReconciliationExceptionsRequest) ProtoMessage() {}
+
+func (x *ListReconciliationExceptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReconciliationExceptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListReconciliationExceptionsRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListReconciliationExceptionsRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListReconciliationExceptionsRequest) GetBankingDay() string {
+	if x != nil {
+		return x.BankingDay
+	}
+	return ""
+}
+
+func (x *ListReconciliationExceptionsRequest) GetStatus() ReconciliationExceptionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_STATUS_UNSPECIFIED
+}
+
+func (x *ListReconciliationExceptionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListReconciliationExceptionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListReconciliationExceptionsResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Meta          *ResponseMeta              `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Exceptions    []*ReconciliationException `protobuf:"bytes,2,rep,name=exceptions,proto3" json:"exceptions,omitempty"`
+	NextPageToken string                     `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReconciliationExceptionsResponse) Reset() {
+	*x = ListReconciliationExceptionsResponse{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReconciliationExceptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReconciliationExceptionsResponse) ProtoMessage() {}
+
+func (x *ListReconciliationExceptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReconciliationExceptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListReconciliationExceptionsResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListReconciliationExceptionsResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListReconciliationExceptionsResponse) GetExceptions() []*ReconciliationException {
+	if x != nil {
+		return x.Exceptions
+	}
+	return nil
+}
+
+func (x *ListReconciliationExceptionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ResolveReconciliationExceptionRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Meta        *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	ExceptionId string                 `protobuf:"bytes,2,opt,name=exception_id,json=exceptionId,proto3" json:"exception_id,omitempty"`
+	Note        string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	// Optional for unmatched bank entries: the deposit or withdrawal the entry
+	// settles when its reference did not match automatically.
+	TransactionId string `protobuf:"bytes,4,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveReconciliationExceptionRequest) Reset() {
+	*x = ResolveReconciliationExceptionRequest{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveReconciliationExceptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveReconciliationExceptionRequest) ProtoMessage() {}
+
+func (x *ResolveReconciliationExceptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveReconciliationExceptionRequest.ProtoReflect.Descriptor instead.
+func (*ResolveReconciliationExceptionRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ResolveReconciliationExceptionRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ResolveReconciliationExceptionRequest) GetExceptionId() string {
+	if x != nil {
+		return x.ExceptionId
+	}
+	return ""
+}
+
+func (x *ResolveReconciliationExceptionRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ResolveReconciliationExceptionRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+type ResolveReconciliationExceptionResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Meta          *ResponseMeta            `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Exception     *ReconciliationException `protobuf:"bytes,2,opt,name=exception,proto3" json:"exception,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveReconciliationExceptionResponse) Reset() {
+	*x = ResolveReconciliationExceptionResponse{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveReconciliationExceptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveReconciliationExceptionResponse) ProtoMessage() {}
+
+func (x *ResolveReconciliationExceptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveReconciliationExceptionResponse.ProtoReflect.Descriptor instead.
+func (*ResolveReconciliationExceptionResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ResolveReconciliationExceptionResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ResolveReconciliationExceptionResponse) GetException() *ReconciliationException {
+	if x != nil {
+		return x.Exception
+	}
+	return nil
+}
+
+type GetReconciliationReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	BankingDay    string                 `protobuf:"bytes,2,opt,name=banking_day,json=bankingDay,proto3" json:"banking_day,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReconciliationReportRequest) Reset() {
+	*x = GetReconciliationReportRequest{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LedgerTransaction) String() string {
+func (x *GetReconciliationReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LedgerTransaction) ProtoMessage() {}
+func (*GetReconciliationReportRequest) ProtoMessage() {}
 
-func (x *LedgerTransaction) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_ledger_proto_msgTypes[1]
+func (x *GetReconciliationReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -231,83 +3524,48 @@ func (x *LedgerTransaction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LedgerTransaction.ProtoReflect.Descriptor instead.
-func (*LedgerTransaction) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{1}
-}
-
-func (x *LedgerTransaction) GetTransactionId() string {
-	if x != nil {
-		return x.TransactionId
-	}
-	return ""
-}
-
-func (x *LedgerTransaction) GetAccountId() string {
-	if x != nil {
-		return x.AccountId
-	}
-	return ""
-}
-
-func (x *LedgerTransaction) GetTransactionType() LedgerTransactionType {
-	if x != nil {
-		return x.TransactionType
-	}
-	return LedgerTransactionType_LEDGER_TRANSACTION_TYPE_UNSPECIFIED
+// Deprecated: Use GetReconciliationReportRequest.ProtoReflect.Descriptor instead.
+func (*GetReconciliationReportRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *LedgerTransaction) GetAmount() *Money {
+func (x *GetReconciliationReportRequest) GetMeta() *RequestMeta {
 	if x != nil {
-		return x.Amount
+		return x.Meta
 	}
 	return nil
 }
 
-func (x *LedgerTransaction) GetOccurredAt() string {
-	if x != nil {
-		return x.OccurredAt
-	}
-	return ""
-}
-
-func (x *LedgerTransaction) GetAuthorizationId() string {
-	if x != nil {
-		return x.AuthorizationId
-	}
-	return ""
-}
-
-func (x *LedgerTransaction) GetDescription() string {
+func (x *GetReconciliationReportRequest) GetBankingDay() string {
 	if x != nil {
-		return x.Description
+		return x.BankingDay
 	}
 	return ""
 }
 
-type GetBalanceRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+type GetReconciliationReportResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Meta          *ResponseMeta            `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Report        *ReconciliationDayReport `protobuf:"bytes,2,opt,name=report,proto3" json:"report,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBalanceRequest) Reset() {
-	*x = GetBalanceRequest{}
-	mi := &file_rgs_v1_ledger_proto_msgTypes[2]
+func (x *GetReconciliationReportResponse) Reset() {
+	*x = GetReconciliationReportResponse{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBalanceRequest) String() string {
+func (x *GetReconciliationReportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBalanceRequest) ProtoMessage() {}
+func (*GetReconciliationReportResponse) ProtoMessage() {}
 
-func (x *GetBalanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_ledger_proto_msgTypes[2]
+func (x *GetReconciliationReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -318,50 +3576,55 @@ func (x *GetBalanceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBalanceRequest.ProtoReflect.Descriptor instead.
-func (*GetBalanceRequest) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use GetReconciliationReportResponse.ProtoReflect.Descriptor instead.
+func (*GetReconciliationReportResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *GetBalanceRequest) GetMeta() *RequestMeta {
+func (x *GetReconciliationReportResponse) GetMeta() *ResponseMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *GetBalanceRequest) GetAccountId() string {
+func (x *GetReconciliationReportResponse) GetReport() *ReconciliationDayReport {
 	if x != nil {
-		return x.AccountId
+		return x.Report
 	}
-	return ""
+	return nil
 }
 
-type GetBalanceResponse struct {
+// AccountCapStatus is an account's balance measured against its cap.
+type AccountCapStatus struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
-	Meta             *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	AccountId        string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AccountId        string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AccountType      string                 `protobuf:"bytes,2,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"`
 	AvailableBalance *Money                 `protobuf:"bytes,3,opt,name=available_balance,json=availableBalance,proto3" json:"available_balance,omitempty"`
-	PendingBalance   *Money                 `protobuf:"bytes,4,opt,name=pending_balance,json=pendingBalance,proto3" json:"pending_balance,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	MaxBalance       *Money                 `protobuf:"bytes,4,opt,name=max_balance,json=maxBalance,proto3" json:"max_balance,omitempty"`
+	// available_balance as basis points of max_balance.
+	UtilizationBps int32 `protobuf:"varint,5,opt,name=utilization_bps,json=utilizationBps,proto3" json:"utilization_bps,omitempty"`
+	// True when the cap is an account-specific override.
+	Override      bool `protobuf:"varint,6,opt,name=override,proto3" json:"override,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBalanceResponse) Reset() {
-	*x = GetBalanceResponse{}
-	mi := &file_rgs_v1_ledger_proto_msgTypes[3]
+func (x *AccountCapStatus) Reset() {
+	*x = AccountCapStatus{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBalanceResponse) String() string {
+func (x *AccountCapStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBalanceResponse) ProtoMessage() {}
+func (*AccountCapStatus) ProtoMessage() {}
 
-func (x *GetBalanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_ledger_proto_msgTypes[3]
+func (x *AccountCapStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -372,64 +3635,79 @@ func (x *GetBalanceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBalanceResponse.ProtoReflect.Descriptor instead.
-func (*GetBalanceResponse) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use AccountCapStatus.ProtoReflect.Descriptor instead.
+func (*AccountCapStatus) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *GetBalanceResponse) GetMeta() *ResponseMeta {
+func (x *AccountCapStatus) GetAccountId() string {
 	if x != nil {
-		return x.Meta
+		return x.AccountId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetBalanceResponse) GetAccountId() string {
+func (x *AccountCapStatus) GetAccountType() string {
 	if x != nil {
-		return x.AccountId
+		return x.AccountType
 	}
 	return ""
 }
 
-func (x *GetBalanceResponse) GetAvailableBalance() *Money {
+func (x *AccountCapStatus) GetAvailableBalance() *Money {
 	if x != nil {
 		return x.AvailableBalance
 	}
 	return nil
 }
 
-func (x *GetBalanceResponse) GetPendingBalance() *Money {
+func (x *AccountCapStatus) GetMaxBalance() *Money {
 	if x != nil {
-		return x.PendingBalance
+		return x.MaxBalance
 	}
 	return nil
 }
 
-type DepositRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Meta            *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	AccountId       string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	Amount          *Money                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	AuthorizationId string                 `protobuf:"bytes,4,opt,name=authorization_id,json=authorizationId,proto3" json:"authorization_id,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *AccountCapStatus) GetUtilizationBps() int32 {
+	if x != nil {
+		return x.UtilizationBps
+	}
+	return 0
 }
 
-func (x *DepositRequest) Reset() {
-	*x = DepositRequest{}
-	mi := &file_rgs_v1_ledger_proto_msgTypes[4]
+func (x *AccountCapStatus) GetOverride() bool {
+	if x != nil {
+		return x.Override
+	}
+	return false
+}
+
+type ListAccountsNearCapRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Meta  *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// Minimum utilization to report, in basis points. Default: 9000.
+	ThresholdBps  int32  `protobuf:"varint,2,opt,name=threshold_bps,json=thresholdBps,proto3" json:"threshold_bps,omitempty"`
+	PageSize      int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountsNearCapRequest) Reset() {
+	*x = ListAccountsNearCapRequest{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DepositRequest) String() string {
+func (x *ListAccountsNearCapRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepositRequest) ProtoMessage() {}
+func (*ListAccountsNearCapRequest) ProtoMessage() {}
 
-func (x *DepositRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_ledger_proto_msgTypes[4]
+func (x *ListAccountsNearCapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -440,63 +3718,63 @@ func (x *DepositRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepositRequest.ProtoReflect.Descriptor instead.
-func (*DepositRequest) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ListAccountsNearCapRequest.ProtoReflect.Descriptor instead.
+func (*ListAccountsNearCapRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *DepositRequest) GetMeta() *RequestMeta {
+func (x *ListAccountsNearCapRequest) GetMeta() *RequestMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *DepositRequest) GetAccountId() string {
+func (x *ListAccountsNearCapRequest) GetThresholdBps() int32 {
 	if x != nil {
-		return x.AccountId
+		return x.ThresholdBps
 	}
-	return ""
+	return 0
 }
 
-func (x *DepositRequest) GetAmount() *Money {
+func (x *ListAccountsNearCapRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Amount
+		return x.PageSize
 	}
-	return nil
+	return 0
 }
 
-func (x *DepositRequest) GetAuthorizationId() string {
+func (x *ListAccountsNearCapRequest) GetPageToken() string {
 	if x != nil {
-		return x.AuthorizationId
+		return x.PageToken
 	}
 	return ""
 }
 
-type DepositResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Meta             *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Transaction      *LedgerTransaction     `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
-	AvailableBalance *Money                 `protobuf:"bytes,3,opt,name=available_balance,json=availableBalance,proto3" json:"available_balance,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type ListAccountsNearCapResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Accounts      []*AccountCapStatus    `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DepositResponse) Reset() {
-	*x = DepositResponse{}
-	mi := &file_rgs_v1_ledger_proto_msgTypes[5]
+func (x *ListAccountsNearCapResponse) Reset() {
+	*x = ListAccountsNearCapResponse{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DepositResponse) String() string {
+func (x *ListAccountsNearCapResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepositResponse) ProtoMessage() {}
+func (*ListAccountsNearCapResponse) ProtoMessage() {}
 
-func (x *DepositResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_ledger_proto_msgTypes[5]
+func (x *ListAccountsNearCapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -507,56 +3785,66 @@ func (x *DepositResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepositResponse.ProtoReflect.Descriptor instead.
-func (*DepositResponse) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ListAccountsNearCapResponse.ProtoReflect.Descriptor instead.
+func (*ListAccountsNearCapResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *DepositResponse) GetMeta() *ResponseMeta {
+func (x *ListAccountsNearCapResponse) GetMeta() *ResponseMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *DepositResponse) GetTransaction() *LedgerTransaction {
+func (x *ListAccountsNearCapResponse) GetAccounts() []*AccountCapStatus {
 	if x != nil {
-		return x.Transaction
+		return x.Accounts
 	}
 	return nil
 }
 
-func (x *DepositResponse) GetAvailableBalance() *Money {
+func (x *ListAccountsNearCapResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.AvailableBalance
+		return x.NextPageToken
 	}
-	return nil
-}
-
-type WithdrawRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	Amount        *Money                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return ""
 }
 
-func (x *WithdrawRequest) Reset() {
-	*x = WithdrawRequest{}
-	mi := &file_rgs_v1_ledger_proto_msgTypes[6]
+// Voucher is a single-use code worth a fixed amount, redeemed as a deposit
+// funded from the promotional_funding house account.
+type Voucher struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	VoucherId               string                 `protobuf:"bytes,1,opt,name=voucher_id,json=voucherId,proto3" json:"voucher_id,omitempty"`
+	Code                    string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Value                   *Money                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	ExpiresAt               string                 `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Status                  VoucherStatus          `protobuf:"varint,5,opt,name=status,proto3,enum=rgs.v1.VoucherStatus" json:"status,omitempty"`
+	IssuedBy                string                 `protobuf:"bytes,6,opt,name=issued_by,json=issuedBy,proto3" json:"issued_by,omitempty"`
+	IssuedAt                string                 `protobuf:"bytes,7,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	RedeemedAccountId       string                 `protobuf:"bytes,8,opt,name=redeemed_account_id,json=redeemedAccountId,proto3" json:"redeemed_account_id,omitempty"`
+	RedeemedAt              string                 `protobuf:"bytes,9,opt,name=redeemed_at,json=redeemedAt,proto3" json:"redeemed_at,omitempty"`
+	RedemptionTransactionId string                 `protobuf:"bytes,10,opt,name=redemption_transaction_id,json=redemptionTransactionId,proto3" json:"redemption_transaction_id,omitempty"`
+	Reference               string                 `protobuf:"bytes,11,opt,name=reference,proto3" json:"reference,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *Voucher) Reset() {
+	*x = Voucher{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WithdrawRequest) String() string {
+func (x *Voucher) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawRequest) ProtoMessage() {}
+func (*Voucher) ProtoMessage() {}
 
-func (x *WithdrawRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_ledger_proto_msgTypes[6]
+func (x *Voucher) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -567,117 +3855,117 @@ func (x *WithdrawRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawRequest.ProtoReflect.Descriptor instead.
-func (*WithdrawRequest) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use Voucher.ProtoReflect.Descriptor instead.
+func (*Voucher) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *WithdrawRequest) GetMeta() *RequestMeta {
+func (x *Voucher) GetVoucherId() string {
 	if x != nil {
-		return x.Meta
+		return x.VoucherId
 	}
-	return nil
+	return ""
 }
 
-func (x *WithdrawRequest) GetAccountId() string {
+func (x *Voucher) GetCode() string {
 	if x != nil {
-		return x.AccountId
+		return x.Code
 	}
 	return ""
 }
 
-func (x *WithdrawRequest) GetAmount() *Money {
+func (x *Voucher) GetValue() *Money {
 	if x != nil {
-		return x.Amount
+		return x.Value
 	}
 	return nil
 }
 
-type WithdrawResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Meta             *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Transaction      *LedgerTransaction     `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
-	AvailableBalance *Money                 `protobuf:"bytes,3,opt,name=available_balance,json=availableBalance,proto3" json:"available_balance,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
-}
-
-func (x *WithdrawResponse) Reset() {
-	*x = WithdrawResponse{}
-	mi := &file_rgs_v1_ledger_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Voucher) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
 }
 
-func (x *WithdrawResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Voucher) GetStatus() VoucherStatus {
+	if x != nil {
+		return x.Status
+	}
+	return VoucherStatus_VOUCHER_STATUS_UNSPECIFIED
 }
 
-func (*WithdrawResponse) ProtoMessage() {}
-
-func (x *WithdrawResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_ledger_proto_msgTypes[7]
+func (x *Voucher) GetIssuedBy() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.IssuedBy
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use WithdrawResponse.ProtoReflect.Descriptor instead.
-func (*WithdrawResponse) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{7}
+func (x *Voucher) GetIssuedAt() string {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return ""
 }
 
-func (x *WithdrawResponse) GetMeta() *ResponseMeta {
+func (x *Voucher) GetRedeemedAccountId() string {
 	if x != nil {
-		return x.Meta
+		return x.RedeemedAccountId
 	}
-	return nil
+	return ""
 }
 
-func (x *WithdrawResponse) GetTransaction() *LedgerTransaction {
+func (x *Voucher) GetRedeemedAt() string {
 	if x != nil {
-		return x.Transaction
+		return x.RedeemedAt
 	}
-	return nil
+	return ""
 }
 
-func (x *WithdrawResponse) GetAvailableBalance() *Money {
+func (x *Voucher) GetRedemptionTransactionId() string {
 	if x != nil {
-		return x.AvailableBalance
+		return x.RedemptionTransactionId
 	}
-	return nil
+	return ""
 }
 
-type TransferToDeviceRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Meta            *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	AccountId       string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	DeviceId        string                 `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
-	RequestedAmount *Money                 `protobuf:"bytes,4,opt,name=requested_amount,json=requestedAmount,proto3" json:"requested_amount,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *Voucher) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
 }
 
-func (x *TransferToDeviceRequest) Reset() {
-	*x = TransferToDeviceRequest{}
-	mi := &file_rgs_v1_ledger_proto_msgTypes[8]
+type IssueVoucherRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Meta  *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Value *Money                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// RFC 3339; must be in the future.
+	ExpiresAt string `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Optional pre-printed code; a random code is generated when empty.
+	Code string `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
+	// Free-form campaign or batch reference.
+	Reference     string `protobuf:"bytes,5,opt,name=reference,proto3" json:"reference,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueVoucherRequest) Reset() {
+	*x = IssueVoucherRequest{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransferToDeviceRequest) String() string {
+func (x *IssueVoucherRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransferToDeviceRequest) ProtoMessage() {}
+func (*IssueVoucherRequest) ProtoMessage() {}
 
-func (x *TransferToDeviceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_ledger_proto_msgTypes[8]
+func (x *IssueVoucherRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -688,66 +3976,69 @@ func (x *TransferToDeviceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransferToDeviceRequest.ProtoReflect.Descriptor instead.
-func (*TransferToDeviceRequest) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use IssueVoucherRequest.ProtoReflect.Descriptor instead.
+func (*IssueVoucherRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *TransferToDeviceRequest) GetMeta() *RequestMeta {
+func (x *IssueVoucherRequest) GetMeta() *RequestMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *TransferToDeviceRequest) GetAccountId() string {
+func (x *IssueVoucherRequest) GetValue() *Money {
 	if x != nil {
-		return x.AccountId
+		return x.Value
+	}
+	return nil
+}
+
+func (x *IssueVoucherRequest) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
 	}
 	return ""
 }
 
-func (x *TransferToDeviceRequest) GetDeviceId() string {
+func (x *IssueVoucherRequest) GetCode() string {
 	if x != nil {
-		return x.DeviceId
+		return x.Code
 	}
 	return ""
 }
 
-func (x *TransferToDeviceRequest) GetRequestedAmount() *Money {
+func (x *IssueVoucherRequest) GetReference() string {
 	if x != nil {
-		return x.RequestedAmount
+		return x.Reference
 	}
-	return nil
+	return ""
 }
 
-type TransferToDeviceResponse struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Meta              *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	TransferId        string                 `protobuf:"bytes,2,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
-	TransferStatus    TransferStatus         `protobuf:"varint,3,opt,name=transfer_status,json=transferStatus,proto3,enum=rgs.v1.TransferStatus" json:"transfer_status,omitempty"`
-	TransferredAmount *Money                 `protobuf:"bytes,4,opt,name=transferred_amount,json=transferredAmount,proto3" json:"transferred_amount,omitempty"`
-	AvailableBalance  *Money                 `protobuf:"bytes,5,opt,name=available_balance,json=availableBalance,proto3" json:"available_balance,omitempty"`
-	UnresolvedReason  string                 `protobuf:"bytes,6,opt,name=unresolved_reason,json=unresolvedReason,proto3" json:"unresolved_reason,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type IssueVoucherResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Voucher       *Voucher               `protobuf:"bytes,2,opt,name=voucher,proto3" json:"voucher,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TransferToDeviceResponse) Reset() {
-	*x = TransferToDeviceResponse{}
-	mi := &file_rgs_v1_ledger_proto_msgTypes[9]
+func (x *IssueVoucherResponse) Reset() {
+	*x = IssueVoucherResponse{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransferToDeviceResponse) String() string {
+func (x *IssueVoucherResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransferToDeviceResponse) ProtoMessage() {}
+func (*IssueVoucherResponse) ProtoMessage() {}
 
-func (x *TransferToDeviceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_ledger_proto_msgTypes[9]
+func (x *IssueVoucherResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -758,77 +4049,49 @@ func (x *TransferToDeviceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransferToDeviceResponse.ProtoReflect.Descriptor instead.
-func (*TransferToDeviceResponse) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use IssueVoucherResponse.ProtoReflect.Descriptor instead.
+func (*IssueVoucherResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *TransferToDeviceResponse) GetMeta() *ResponseMeta {
+func (x *IssueVoucherResponse) GetMeta() *ResponseMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *TransferToDeviceResponse) GetTransferId() string {
-	if x != nil {
-		return x.TransferId
-	}
-	return ""
-}
-
-func (x *TransferToDeviceResponse) GetTransferStatus() TransferStatus {
-	if x != nil {
-		return x.TransferStatus
-	}
-	return TransferStatus_TRANSFER_STATUS_UNSPECIFIED
-}
-
-func (x *TransferToDeviceResponse) GetTransferredAmount() *Money {
-	if x != nil {
-		return x.TransferredAmount
-	}
-	return nil
-}
-
-func (x *TransferToDeviceResponse) GetAvailableBalance() *Money {
+func (x *IssueVoucherResponse) GetVoucher() *Voucher {
 	if x != nil {
-		return x.AvailableBalance
+		return x.Voucher
 	}
 	return nil
 }
 
-func (x *TransferToDeviceResponse) GetUnresolvedReason() string {
-	if x != nil {
-		return x.UnresolvedReason
-	}
-	return ""
-}
-
-type TransferToAccountRequest struct {
+type RedeemVoucherRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
 	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	Amount        *Money                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TransferToAccountRequest) Reset() {
-	*x = TransferToAccountRequest{}
-	mi := &file_rgs_v1_ledger_proto_msgTypes[10]
+func (x *RedeemVoucherRequest) Reset() {
+	*x = RedeemVoucherRequest{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransferToAccountRequest) String() string {
+func (x *RedeemVoucherRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransferToAccountRequest) ProtoMessage() {}
+func (*RedeemVoucherRequest) ProtoMessage() {}
 
-func (x *TransferToAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_ledger_proto_msgTypes[10]
+func (x *RedeemVoucherRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -839,56 +4102,57 @@ func (x *TransferToAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransferToAccountRequest.ProtoReflect.Descriptor instead.
-func (*TransferToAccountRequest) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use RedeemVoucherRequest.ProtoReflect.Descriptor instead.
+func (*RedeemVoucherRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *TransferToAccountRequest) GetMeta() *RequestMeta {
+func (x *RedeemVoucherRequest) GetMeta() *RequestMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *TransferToAccountRequest) GetAccountId() string {
+func (x *RedeemVoucherRequest) GetAccountId() string {
 	if x != nil {
 		return x.AccountId
 	}
 	return ""
 }
 
-func (x *TransferToAccountRequest) GetAmount() *Money {
+func (x *RedeemVoucherRequest) GetCode() string {
 	if x != nil {
-		return x.Amount
+		return x.Code
 	}
-	return nil
+	return ""
 }
 
-type TransferToAccountResponse struct {
+type RedeemVoucherResponse struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	Meta             *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Transaction      *LedgerTransaction     `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
-	AvailableBalance *Money                 `protobuf:"bytes,3,opt,name=available_balance,json=availableBalance,proto3" json:"available_balance,omitempty"`
+	Voucher          *Voucher               `protobuf:"bytes,2,opt,name=voucher,proto3" json:"voucher,omitempty"`
+	Transaction      *LedgerTransaction     `protobuf:"bytes,3,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	AvailableBalance *Money                 `protobuf:"bytes,4,opt,name=available_balance,json=availableBalance,proto3" json:"available_balance,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
-func (x *TransferToAccountResponse) Reset() {
-	*x = TransferToAccountResponse{}
-	mi := &file_rgs_v1_ledger_proto_msgTypes[11]
+func (x *RedeemVoucherResponse) Reset() {
+	*x = RedeemVoucherResponse{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransferToAccountResponse) String() string {
+func (x *RedeemVoucherResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransferToAccountResponse) ProtoMessage() {}
+func (*RedeemVoucherResponse) ProtoMessage() {}
 
-func (x *TransferToAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_ledger_proto_msgTypes[11]
+func (x *RedeemVoucherResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -899,59 +4163,70 @@ func (x *TransferToAccountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransferToAccountResponse.ProtoReflect.Descriptor instead.
-func (*TransferToAccountResponse) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use RedeemVoucherResponse.ProtoReflect.Descriptor instead.
+func (*RedeemVoucherResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *TransferToAccountResponse) GetMeta() *ResponseMeta {
+func (x *RedeemVoucherResponse) GetMeta() *ResponseMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *TransferToAccountResponse) GetTransaction() *LedgerTransaction {
+func (x *RedeemVoucherResponse) GetVoucher() *Voucher {
 	if x != nil {
-		return x.Transaction
+		return x.Voucher
 	}
 	return nil
 }
 
-func (x *TransferToAccountResponse) GetAvailableBalance() *Money {
+func (x *RedeemVoucherResponse) GetTransaction() *LedgerTransaction {
 	if x != nil {
-		return x.AvailableBalance
+		return x.Transaction
 	}
 	return nil
 }
 
-type ListTransactionsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	FromTime      string                 `protobuf:"bytes,5,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`
-	ToTime        string                 `protobuf:"bytes,6,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *RedeemVoucherResponse) GetAvailableBalance() *Money {
+	if x != nil {
+		return x.AvailableBalance
+	}
+	return nil
 }
 
-func (x *ListTransactionsRequest) Reset() {
-	*x = ListTransactionsRequest{}
-	mi := &file_rgs_v1_ledger_proto_msgTypes[12]
+// VoucherLiability totals vouchers in one currency.
+type VoucherLiability struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Currency string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Issued, unredeemed, and unexpired: still owed to bearers.
+	OutstandingCount  int64  `protobuf:"varint,2,opt,name=outstanding_count,json=outstandingCount,proto3" json:"outstanding_count,omitempty"`
+	OutstandingAmount *Money `protobuf:"bytes,3,opt,name=outstanding_amount,json=outstandingAmount,proto3" json:"outstanding_amount,omitempty"`
+	// Expired without redemption.
+	ExpiredCount   int64  `protobuf:"varint,4,opt,name=expired_count,json=expiredCount,proto3" json:"expired_count,omitempty"`
+	ExpiredAmount  *Money `protobuf:"bytes,5,opt,name=expired_amount,json=expiredAmount,proto3" json:"expired_amount,omitempty"`
+	RedeemedCount  int64  `protobuf:"varint,6,opt,name=redeemed_count,json=redeemedCount,proto3" json:"redeemed_count,omitempty"`
+	RedeemedAmount *Money `protobuf:"bytes,7,opt,name=redeemed_amount,json=redeemedAmount,proto3" json:"redeemed_amount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *VoucherLiability) Reset() {
+	*x = VoucherLiability{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTransactionsRequest) String() string {
+func (x *VoucherLiability) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTransactionsRequest) ProtoMessage() {}
+func (*VoucherLiability) ProtoMessage() {}
 
-func (x *ListTransactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_ledger_proto_msgTypes[12]
+func (x *VoucherLiability) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -962,77 +4237,128 @@ func (x *ListTransactionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTransactionsRequest.ProtoReflect.Descriptor instead.
-func (*ListTransactionsRequest) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use VoucherLiability.ProtoReflect.Descriptor instead.
+func (*VoucherLiability) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *ListTransactionsRequest) GetMeta() *RequestMeta {
+func (x *VoucherLiability) GetCurrency() string {
 	if x != nil {
-		return x.Meta
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *VoucherLiability) GetOutstandingCount() int64 {
+	if x != nil {
+		return x.OutstandingCount
+	}
+	return 0
+}
+
+func (x *VoucherLiability) GetOutstandingAmount() *Money {
+	if x != nil {
+		return x.OutstandingAmount
 	}
 	return nil
 }
 
-func (x *ListTransactionsRequest) GetAccountId() string {
+func (x *VoucherLiability) GetExpiredCount() int64 {
 	if x != nil {
-		return x.AccountId
+		return x.ExpiredCount
 	}
-	return ""
+	return 0
 }
 
-func (x *ListTransactionsRequest) GetPageSize() int32 {
+func (x *VoucherLiability) GetExpiredAmount() *Money {
 	if x != nil {
-		return x.PageSize
+		return x.ExpiredAmount
+	}
+	return nil
+}
+
+func (x *VoucherLiability) GetRedeemedCount() int64 {
+	if x != nil {
+		return x.RedeemedCount
 	}
 	return 0
 }
 
-func (x *ListTransactionsRequest) GetPageToken() string {
+func (x *VoucherLiability) GetRedeemedAmount() *Money {
 	if x != nil {
-		return x.PageToken
+		return x.RedeemedAmount
 	}
-	return ""
+	return nil
 }
 
-func (x *ListTransactionsRequest) GetFromTime() string {
+type GetVoucherLiabilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVoucherLiabilityRequest) Reset() {
+	*x = GetVoucherLiabilityRequest{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVoucherLiabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVoucherLiabilityRequest) ProtoMessage() {}
+
+func (x *GetVoucherLiabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[51]
 	if x != nil {
-		return x.FromTime
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ListTransactionsRequest) GetToTime() string {
+// Deprecated: Use GetVoucherLiabilityRequest.ProtoReflect.Descriptor instead.
+func (*GetVoucherLiabilityRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetVoucherLiabilityRequest) GetMeta() *RequestMeta {
 	if x != nil {
-		return x.ToTime
+		return x.Meta
 	}
-	return ""
+	return nil
 }
 
-type ListTransactionsResponse struct {
+type GetVoucherLiabilityResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Transactions  []*LedgerTransaction   `protobuf:"bytes,2,rep,name=transactions,proto3" json:"transactions,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Liabilities   []*VoucherLiability    `protobuf:"bytes,2,rep,name=liabilities,proto3" json:"liabilities,omitempty"`
+	AsOf          string                 `protobuf:"bytes,3,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTransactionsResponse) Reset() {
-	*x = ListTransactionsResponse{}
-	mi := &file_rgs_v1_ledger_proto_msgTypes[13]
+func (x *GetVoucherLiabilityResponse) Reset() {
+	*x = GetVoucherLiabilityResponse{}
+	mi := &file_rgs_v1_ledger_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTransactionsResponse) String() string {
+func (x *GetVoucherLiabilityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTransactionsResponse) ProtoMessage() {}
+func (*GetVoucherLiabilityResponse) ProtoMessage() {}
 
-func (x *ListTransactionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_ledger_proto_msgTypes[13]
+func (x *GetVoucherLiabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_ledger_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1043,28 +4369,28 @@ func (x *ListTransactionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTransactionsResponse.ProtoReflect.Descriptor instead.
-func (*ListTransactionsResponse) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use GetVoucherLiabilityResponse.ProtoReflect.Descriptor instead.
+func (*GetVoucherLiabilityResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_ledger_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *ListTransactionsResponse) GetMeta() *ResponseMeta {
+func (x *GetVoucherLiabilityResponse) GetMeta() *ResponseMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *ListTransactionsResponse) GetTransactions() []*LedgerTransaction {
+func (x *GetVoucherLiabilityResponse) GetLiabilities() []*VoucherLiability {
 	if x != nil {
-		return x.Transactions
+		return x.Liabilities
 	}
 	return nil
 }
 
-func (x *ListTransactionsResponse) GetNextPageToken() string {
+func (x *GetVoucherLiabilityResponse) GetAsOf() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.AsOf
 	}
 	return ""
 }
@@ -1076,7 +4402,30 @@ const file_rgs_v1_ledger_proto_rawDesc = "" +
 	"\x13rgs/v1/ledger.proto\x12\x06rgs.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x13rgs/v1/common.proto\"F\n" +
 	"\x05Money\x12!\n" +
 	"\famount_minor\x18\x01 \x01(\x03R\vamountMinor\x12\x1a\n" +
-	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"\xb8\x02\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"\xe7\x04\n" +
+	"\x12UnresolvedTransfer\x12\x1f\n" +
+	"\vtransfer_id\x18\x01 \x01(\tR\n" +
+	"transferId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12\x1b\n" +
+	"\tdevice_id\x18\x03 \x01(\tR\bdeviceId\x128\n" +
+	"\x10requested_amount\x18\x04 \x01(\v2\r.rgs.v1.MoneyR\x0frequestedAmount\x12<\n" +
+	"\x12transferred_amount\x18\x05 \x01(\v2\r.rgs.v1.MoneyR\x11transferredAmount\x128\n" +
+	"\x06status\x18\x06 \x01(\x0e2 .rgs.v1.UnresolvedTransferStatusR\x06status\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x12%\n" +
+	"\x0etransaction_id\x18\b \x01(\tR\rtransactionId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\x12&\n" +
+	"\x0fack_deadline_at\x18\n" +
+	" \x01(\tR\rackDeadlineAt\x12\x1a\n" +
+	"\battempts\x18\v \x01(\x05R\battempts\x12\x1f\n" +
+	"\vresolved_at\x18\f \x01(\tR\n" +
+	"resolvedAt\x12\x1e\n" +
+	"\n" +
+	"resolution\x18\r \x01(\tR\n" +
+	"resolution\x12'\n" +
+	"\x0fresolution_note\x18\x0e \x01(\tR\x0eresolutionNote\x126\n" +
+	"\x17reversal_transaction_id\x18\x0f \x01(\tR\x15reversalTransactionId\"\xea\x02\n" +
 	"\x11LedgerTransaction\x12%\n" +
 	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12\x1d\n" +
 	"\n" +
@@ -1086,7 +4435,8 @@ const file_rgs_v1_ledger_proto_rawDesc = "" +
 	"\voccurred_at\x18\x05 \x01(\tR\n" +
 	"occurredAt\x12)\n" +
 	"\x10authorization_id\x18\x06 \x01(\tR\x0fauthorizationId\x12 \n" +
-	"\vdescription\x18\a \x01(\tR\vdescription\"[\n" +
+	"\vdescription\x18\a \x01(\tR\vdescription\x120\n" +
+	"\x14voids_transaction_id\x18\b \x01(\tR\x12voidsTransactionId\"[\n" +
 	"\x11GetBalanceRequest\x12'\n" +
 	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x1d\n" +
 	"\n" +
@@ -1102,11 +4452,12 @@ const file_rgs_v1_ledger_proto_rawDesc = "" +
 	"\n" +
 	"account_id\x18\x02 \x01(\tR\taccountId\x12%\n" +
 	"\x06amount\x18\x03 \x01(\v2\r.rgs.v1.MoneyR\x06amount\x12)\n" +
-	"\x10authorization_id\x18\x04 \x01(\tR\x0fauthorizationId\"\xb4\x01\n" +
+	"\x10authorization_id\x18\x04 \x01(\tR\x0fauthorizationId\"\xea\x01\n" +
 	"\x0fDepositResponse\x12(\n" +
 	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12;\n" +
 	"\vtransaction\x18\x02 \x01(\v2\x19.rgs.v1.LedgerTransactionR\vtransaction\x12:\n" +
-	"\x11available_balance\x18\x03 \x01(\v2\r.rgs.v1.MoneyR\x10availableBalance\"\x80\x01\n" +
+	"\x11available_balance\x18\x03 \x01(\v2\r.rgs.v1.MoneyR\x10availableBalance\x124\n" +
+	"\x0erefused_amount\x18\x04 \x01(\v2\r.rgs.v1.MoneyR\rrefusedAmount\"\x80\x01\n" +
 	"\x0fWithdrawRequest\x12'\n" +
 	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x1d\n" +
 	"\n" +
@@ -1134,11 +4485,12 @@ const file_rgs_v1_ledger_proto_rawDesc = "" +
 	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x1d\n" +
 	"\n" +
 	"account_id\x18\x02 \x01(\tR\taccountId\x12%\n" +
-	"\x06amount\x18\x03 \x01(\v2\r.rgs.v1.MoneyR\x06amount\"\xbe\x01\n" +
+	"\x06amount\x18\x03 \x01(\v2\r.rgs.v1.MoneyR\x06amount\"\xf4\x01\n" +
 	"\x19TransferToAccountResponse\x12(\n" +
 	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12;\n" +
 	"\vtransaction\x18\x02 \x01(\v2\x19.rgs.v1.LedgerTransactionR\vtransaction\x12:\n" +
-	"\x11available_balance\x18\x03 \x01(\v2\r.rgs.v1.MoneyR\x10availableBalance\"\xd3\x01\n" +
+	"\x11available_balance\x18\x03 \x01(\v2\r.rgs.v1.MoneyR\x10availableBalance\x124\n" +
+	"\x0erefused_amount\x18\x04 \x01(\v2\r.rgs.v1.MoneyR\rrefusedAmount\"\xd3\x01\n" +
 	"\x17ListTransactionsRequest\x12'\n" +
 	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x1d\n" +
 	"\n" +
@@ -1151,7 +4503,244 @@ const file_rgs_v1_ledger_proto_rawDesc = "" +
 	"\x18ListTransactionsResponse\x12(\n" +
 	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12=\n" +
 	"\ftransactions\x18\x02 \x03(\v2\x19.rgs.v1.LedgerTransactionR\ftransactions\x12&\n" +
-	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken*\xf6\x02\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\xe8\x01\n" +
+	"\x1eListUnresolvedTransfersRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12\x1b\n" +
+	"\tdevice_id\x18\x03 \x01(\tR\bdeviceId\x12%\n" +
+	"\x0einclude_closed\x18\x04 \x01(\bR\rincludeClosed\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\"\xad\x01\n" +
+	"\x1fListUnresolvedTransfersResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x128\n" +
+	"\ttransfers\x18\x02 \x03(\v2\x1a.rgs.v1.UnresolvedTransferR\ttransfers\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\xb0\x01\n" +
+	"\x16ResolveTransferRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x1f\n" +
+	"\vtransfer_id\x18\x02 \x01(\tR\n" +
+	"transferId\x128\n" +
+	"\x06action\x18\x03 \x01(\x0e2 .rgs.v1.TransferResolutionActionR\x06action\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\"\x85\x02\n" +
+	"\x17ResolveTransferResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x126\n" +
+	"\btransfer\x18\x02 \x01(\v2\x1a.rgs.v1.UnresolvedTransferR\btransfer\x12L\n" +
+	"\x14reversal_transaction\x18\x03 \x01(\v2\x19.rgs.v1.LedgerTransactionR\x13reversalTransaction\x12:\n" +
+	"\x11available_balance\x18\x04 \x01(\v2\r.rgs.v1.MoneyR\x10availableBalance\"\xae\x01\n" +
+	"\n" +
+	"EFTLockout\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12'\n" +
+	"\x0ffailed_attempts\x18\x02 \x01(\x05R\x0efailedAttempts\x12\x16\n" +
+	"\x06locked\x18\x03 \x01(\bR\x06locked\x12!\n" +
+	"\flocked_until\x18\x04 \x01(\tR\vlockedUntil\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\tR\tupdatedAt\"^\n" +
+	"\x14GetEFTLockoutRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\"\xc2\x01\n" +
+	"\x15GetEFTLockoutResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12,\n" +
+	"\alockout\x18\x02 \x01(\v2\x12.rgs.v1.EFTLockoutR\alockout\x12!\n" +
+	"\fmax_failures\x18\x03 \x01(\x05R\vmaxFailures\x12.\n" +
+	"\x13lockout_ttl_seconds\x18\x04 \x01(\x03R\x11lockoutTtlSeconds\"\x9e\x01\n" +
+	"\x16ListEFTLockoutsRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x1f\n" +
+	"\vlocked_only\x18\x02 \x01(\bR\n" +
+	"lockedOnly\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"\x9b\x01\n" +
+	"\x17ListEFTLockoutsResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12.\n" +
+	"\blockouts\x18\x02 \x03(\v2\x12.rgs.v1.EFTLockoutR\blockouts\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"x\n" +
+	"\x16ResetEFTLockoutRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"q\n" +
+	"\x17ResetEFTLockoutResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12,\n" +
+	"\alockout\x18\x02 \x01(\v2\x12.rgs.v1.EFTLockoutR\alockout\"\x80\x01\n" +
+	"\x16VoidTransactionRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12%\n" +
+	"\x0etransaction_id\x18\x02 \x01(\tR\rtransactionId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x93\x02\n" +
+	"\x17VoidTransactionResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12L\n" +
+	"\x14original_transaction\x18\x02 \x01(\v2\x19.rgs.v1.LedgerTransactionR\x13originalTransaction\x12D\n" +
+	"\x10void_transaction\x18\x03 \x01(\v2\x19.rgs.v1.LedgerTransactionR\x0fvoidTransaction\x12:\n" +
+	"\x11available_balance\x18\x04 \x01(\v2\r.rgs.v1.MoneyR\x10availableBalance\"\xa9\x01\n" +
+	"\x17ExchangeCurrencyRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12%\n" +
+	"\x06amount\x18\x03 \x01(\v2\r.rgs.v1.MoneyR\x06amount\x12\x1f\n" +
+	"\vto_currency\x18\x04 \x01(\tR\n" +
+	"toCurrency\"\xaf\x02\n" +
+	"\x18ExchangeCurrencyResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12;\n" +
+	"\vtransaction\x18\x02 \x01(\v2\x19.rgs.v1.LedgerTransactionR\vtransaction\x128\n" +
+	"\x10exchanged_amount\x18\x03 \x01(\v2\r.rgs.v1.MoneyR\x0fexchangedAmount\x12\x12\n" +
+	"\x04rate\x18\x04 \x01(\tR\x04rate\x120\n" +
+	"\ffrom_balance\x18\x05 \x01(\v2\r.rgs.v1.MoneyR\vfromBalance\x12,\n" +
+	"\n" +
+	"to_balance\x18\x06 \x01(\v2\r.rgs.v1.MoneyR\ttoBalance\"\xf5\x02\n" +
+	"\x12BankStatementEntry\x12\x19\n" +
+	"\bentry_id\x18\x01 \x01(\tR\aentryId\x12!\n" +
+	"\fstatement_id\x18\x02 \x01(\tR\vstatementId\x12\x1f\n" +
+	"\vbanking_day\x18\x03 \x01(\tR\n" +
+	"bankingDay\x128\n" +
+	"\tdirection\x18\x04 \x01(\x0e2\x1a.rgs.v1.BankEntryDirectionR\tdirection\x12%\n" +
+	"\x06amount\x18\x05 \x01(\v2\r.rgs.v1.MoneyR\x06amount\x12\x1c\n" +
+	"\treference\x18\x06 \x01(\tR\treference\x12 \n" +
+	"\vdescription\x18\a \x01(\tR\vdescription\x124\n" +
+	"\x16matched_transaction_id\x18\b \x01(\tR\x14matchedTransactionId\x12)\n" +
+	"\x10manually_matched\x18\t \x01(\bR\x0fmanuallyMatched\"\xce\x03\n" +
+	"\x17ReconciliationException\x12!\n" +
+	"\fexception_id\x18\x01 \x01(\tR\vexceptionId\x12\x1f\n" +
+	"\vbanking_day\x18\x02 \x01(\tR\n" +
+	"bankingDay\x127\n" +
+	"\x04kind\x18\x03 \x01(\x0e2#.rgs.v1.ReconciliationExceptionKindR\x04kind\x12=\n" +
+	"\x06status\x18\x04 \x01(\x0e2%.rgs.v1.ReconciliationExceptionStatusR\x06status\x120\n" +
+	"\x05entry\x18\x05 \x01(\v2\x1a.rgs.v1.BankStatementEntryR\x05entry\x12;\n" +
+	"\vtransaction\x18\x06 \x01(\v2\x19.rgs.v1.LedgerTransactionR\vtransaction\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1f\n" +
+	"\vresolved_at\x18\b \x01(\tR\n" +
+	"resolvedAt\x12\x1f\n" +
+	"\vresolved_by\x18\t \x01(\tR\n" +
+	"resolvedBy\x12'\n" +
+	"\x0fresolution_note\x18\n" +
+	" \x01(\tR\x0eresolutionNote\"\xfa\x01\n" +
+	"\x14ReconciliationTotals\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12,\n" +
+	"\x12bank_credits_minor\x18\x02 \x01(\x03R\x10bankCreditsMinor\x12*\n" +
+	"\x11bank_debits_minor\x18\x03 \x01(\x03R\x0fbankDebitsMinor\x122\n" +
+	"\x15ledger_deposits_minor\x18\x04 \x01(\x03R\x13ledgerDepositsMinor\x128\n" +
+	"\x18ledger_withdrawals_minor\x18\x05 \x01(\x03R\x16ledgerWithdrawalsMinor\"\x8a\x03\n" +
+	"\x17ReconciliationDayReport\x12\x1f\n" +
+	"\vbanking_day\x18\x01 \x01(\tR\n" +
+	"bankingDay\x127\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1f.rgs.v1.ReconciliationDayStatusR\x06status\x12+\n" +
+	"\x11statement_entries\x18\x03 \x01(\x05R\x10statementEntries\x12'\n" +
+	"\x0fmatched_entries\x18\x04 \x01(\x05R\x0ematchedEntries\x12/\n" +
+	"\x13ledger_transactions\x18\x05 \x01(\x05R\x12ledgerTransactions\x12'\n" +
+	"\x0fopen_exceptions\x18\x06 \x01(\x05R\x0eopenExceptions\x12/\n" +
+	"\x13resolved_exceptions\x18\a \x01(\x05R\x12resolvedExceptions\x124\n" +
+	"\x06totals\x18\b \x03(\v2\x1c.rgs.v1.ReconciliationTotalsR\x06totals\"\x94\x01\n" +
+	"\x1aImportBankStatementRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x123\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x1b.rgs.v1.BankStatementFormatR\x06format\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent\"\x8e\x02\n" +
+	"\x1bImportBankStatementResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12!\n" +
+	"\fstatement_id\x18\x02 \x01(\tR\vstatementId\x12)\n" +
+	"\x10entries_imported\x18\x03 \x01(\x05R\x0fentriesImported\x12'\n" +
+	"\x0fentries_matched\x18\x04 \x01(\x05R\x0eentriesMatched\x12!\n" +
+	"\fbanking_days\x18\x05 \x03(\tR\vbankingDays\x12+\n" +
+	"\x11exceptions_opened\x18\x06 \x01(\x05R\x10exceptionsOpened\"\xea\x01\n" +
+	"#ListReconciliationExceptionsRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x1f\n" +
+	"\vbanking_day\x18\x02 \x01(\tR\n" +
+	"bankingDay\x12=\n" +
+	"\x06status\x18\x03 \x01(\x0e2%.rgs.v1.ReconciliationExceptionStatusR\x06status\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\"\xb9\x01\n" +
+	"$ListReconciliationExceptionsResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12?\n" +
+	"\n" +
+	"exceptions\x18\x02 \x03(\v2\x1f.rgs.v1.ReconciliationExceptionR\n" +
+	"exceptions\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\xae\x01\n" +
+	"%ResolveReconciliationExceptionRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12!\n" +
+	"\fexception_id\x18\x02 \x01(\tR\vexceptionId\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\x12%\n" +
+	"\x0etransaction_id\x18\x04 \x01(\tR\rtransactionId\"\x91\x01\n" +
+	"&ResolveReconciliationExceptionResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12=\n" +
+	"\texception\x18\x02 \x01(\v2\x1f.rgs.v1.ReconciliationExceptionR\texception\"j\n" +
+	"\x1eGetReconciliationReportRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x1f\n" +
+	"\vbanking_day\x18\x02 \x01(\tR\n" +
+	"bankingDay\"\x84\x01\n" +
+	"\x1fGetReconciliationReportResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x127\n" +
+	"\x06report\x18\x02 \x01(\v2\x1f.rgs.v1.ReconciliationDayReportR\x06report\"\x85\x02\n" +
+	"\x10AccountCapStatus\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12!\n" +
+	"\faccount_type\x18\x02 \x01(\tR\vaccountType\x12:\n" +
+	"\x11available_balance\x18\x03 \x01(\v2\r.rgs.v1.MoneyR\x10availableBalance\x12.\n" +
+	"\vmax_balance\x18\x04 \x01(\v2\r.rgs.v1.MoneyR\n" +
+	"maxBalance\x12'\n" +
+	"\x0futilization_bps\x18\x05 \x01(\x05R\x0eutilizationBps\x12\x1a\n" +
+	"\boverride\x18\x06 \x01(\bR\boverride\"\xa6\x01\n" +
+	"\x1aListAccountsNearCapRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12#\n" +
+	"\rthreshold_bps\x18\x02 \x01(\x05R\fthresholdBps\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"\xa5\x01\n" +
+	"\x1bListAccountsNearCapResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x124\n" +
+	"\baccounts\x18\x02 \x03(\v2\x18.rgs.v1.AccountCapStatusR\baccounts\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\x94\x03\n" +
+	"\aVoucher\x12\x1d\n" +
+	"\n" +
+	"voucher_id\x18\x01 \x01(\tR\tvoucherId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12#\n" +
+	"\x05value\x18\x03 \x01(\v2\r.rgs.v1.MoneyR\x05value\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\tR\texpiresAt\x12-\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x15.rgs.v1.VoucherStatusR\x06status\x12\x1b\n" +
+	"\tissued_by\x18\x06 \x01(\tR\bissuedBy\x12\x1b\n" +
+	"\tissued_at\x18\a \x01(\tR\bissuedAt\x12.\n" +
+	"\x13redeemed_account_id\x18\b \x01(\tR\x11redeemedAccountId\x12\x1f\n" +
+	"\vredeemed_at\x18\t \x01(\tR\n" +
+	"redeemedAt\x12:\n" +
+	"\x19redemption_transaction_id\x18\n" +
+	" \x01(\tR\x17redemptionTransactionId\x12\x1c\n" +
+	"\treference\x18\v \x01(\tR\treference\"\xb4\x01\n" +
+	"\x13IssueVoucherRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12#\n" +
+	"\x05value\x18\x02 \x01(\v2\r.rgs.v1.MoneyR\x05value\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\tR\texpiresAt\x12\x12\n" +
+	"\x04code\x18\x04 \x01(\tR\x04code\x12\x1c\n" +
+	"\treference\x18\x05 \x01(\tR\treference\"k\n" +
+	"\x14IssueVoucherResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12)\n" +
+	"\avoucher\x18\x02 \x01(\v2\x0f.rgs.v1.VoucherR\avoucher\"r\n" +
+	"\x14RedeemVoucherRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\"\xe5\x01\n" +
+	"\x15RedeemVoucherResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12)\n" +
+	"\avoucher\x18\x02 \x01(\v2\x0f.rgs.v1.VoucherR\avoucher\x12;\n" +
+	"\vtransaction\x18\x03 \x01(\v2\x19.rgs.v1.LedgerTransactionR\vtransaction\x12:\n" +
+	"\x11available_balance\x18\x04 \x01(\v2\r.rgs.v1.MoneyR\x10availableBalance\"\xd3\x02\n" +
+	"\x10VoucherLiability\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12+\n" +
+	"\x11outstanding_count\x18\x02 \x01(\x03R\x10outstandingCount\x12<\n" +
+	"\x12outstanding_amount\x18\x03 \x01(\v2\r.rgs.v1.MoneyR\x11outstandingAmount\x12#\n" +
+	"\rexpired_count\x18\x04 \x01(\x03R\fexpiredCount\x124\n" +
+	"\x0eexpired_amount\x18\x05 \x01(\v2\r.rgs.v1.MoneyR\rexpiredAmount\x12%\n" +
+	"\x0eredeemed_count\x18\x06 \x01(\x03R\rredeemedCount\x126\n" +
+	"\x0fredeemed_amount\x18\a \x01(\v2\r.rgs.v1.MoneyR\x0eredeemedAmount\"E\n" +
+	"\x1aGetVoucherLiabilityRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\"\x98\x01\n" +
+	"\x1bGetVoucherLiabilityResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12:\n" +
+	"\vliabilities\x18\x02 \x03(\v2\x18.rgs.v1.VoucherLiabilityR\vliabilities\x12\x13\n" +
+	"\x05as_of\x18\x03 \x01(\tR\x04asOf*\xf7\x03\n" +
 	"\x15LedgerTransactionType\x12'\n" +
 	"#LEDGER_TRANSACTION_TYPE_UNSPECIFIED\x10\x00\x12#\n" +
 	"\x1fLEDGER_TRANSACTION_TYPE_DEPOSIT\x10\x01\x12&\n" +
@@ -1160,13 +4749,54 @@ const file_rgs_v1_ledger_proto_rawDesc = "" +
 	"+LEDGER_TRANSACTION_TYPE_TRANSFER_TO_ACCOUNT\x10\x04\x12*\n" +
 	"&LEDGER_TRANSACTION_TYPE_GAMEPLAY_DEBIT\x10\x05\x12+\n" +
 	"'LEDGER_TRANSACTION_TYPE_GAMEPLAY_CREDIT\x10\x06\x12-\n" +
-	")LEDGER_TRANSACTION_TYPE_MANUAL_ADJUSTMENT\x10\a*\xa8\x01\n" +
+	")LEDGER_TRANSACTION_TYPE_MANUAL_ADJUSTMENT\x10\a\x12 \n" +
+	"\x1cLEDGER_TRANSACTION_TYPE_VOID\x10\b\x12-\n" +
+	")LEDGER_TRANSACTION_TYPE_CURRENCY_EXCHANGE\x10\t\x12.\n" +
+	"*LEDGER_TRANSACTION_TYPE_VOUCHER_REDEMPTION\x10\n" +
+	"*\xa8\x01\n" +
 	"\x0eTransferStatus\x12\x1f\n" +
 	"\x1bTRANSFER_STATUS_UNSPECIFIED\x10\x00\x12\x1c\n" +
 	"\x18TRANSFER_STATUS_ACCEPTED\x10\x01\x12\x1b\n" +
 	"\x17TRANSFER_STATUS_PARTIAL\x10\x02\x12\x1e\n" +
 	"\x1aTRANSFER_STATUS_UNRESOLVED\x10\x03\x12\x1a\n" +
-	"\x16TRANSFER_STATUS_DENIED\x10\x042\xd6\x05\n" +
+	"\x16TRANSFER_STATUS_DENIED\x10\x04*\xbe\x01\n" +
+	"\x18UnresolvedTransferStatus\x12*\n" +
+	"&UNRESOLVED_TRANSFER_STATUS_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fUNRESOLVED_TRANSFER_STATUS_OPEN\x10\x01\x12'\n" +
+	"#UNRESOLVED_TRANSFER_STATUS_RESOLVED\x10\x02\x12(\n" +
+	"$UNRESOLVED_TRANSFER_STATUS_CANCELLED\x10\x03*\xc0\x01\n" +
+	"\x18TransferResolutionAction\x12*\n" +
+	"&TRANSFER_RESOLUTION_ACTION_UNSPECIFIED\x10\x00\x12*\n" +
+	"&TRANSFER_RESOLUTION_ACTION_ACKNOWLEDGE\x10\x01\x12$\n" +
+	" TRANSFER_RESOLUTION_ACTION_RETRY\x10\x02\x12&\n" +
+	"\"TRANSFER_RESOLUTION_ACTION_REVERSE\x10\x03*~\n" +
+	"\x13BankStatementFormat\x12%\n" +
+	"!BANK_STATEMENT_FORMAT_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dBANK_STATEMENT_FORMAT_CAMT053\x10\x01\x12\x1d\n" +
+	"\x19BANK_STATEMENT_FORMAT_CSV\x10\x02*{\n" +
+	"\x12BankEntryDirection\x12$\n" +
+	" BANK_ENTRY_DIRECTION_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bBANK_ENTRY_DIRECTION_CREDIT\x10\x01\x12\x1e\n" +
+	"\x1aBANK_ENTRY_DIRECTION_DEBIT\x10\x02*\xc4\x01\n" +
+	"\x1bReconciliationExceptionKind\x12-\n" +
+	")RECONCILIATION_EXCEPTION_KIND_UNSPECIFIED\x10\x00\x126\n" +
+	"2RECONCILIATION_EXCEPTION_KIND_UNMATCHED_BANK_ENTRY\x10\x01\x12>\n" +
+	":RECONCILIATION_EXCEPTION_KIND_UNMATCHED_LEDGER_TRANSACTION\x10\x02*\xd5\x01\n" +
+	"\x1dReconciliationExceptionStatus\x12/\n" +
+	"+RECONCILIATION_EXCEPTION_STATUS_UNSPECIFIED\x10\x00\x12(\n" +
+	"$RECONCILIATION_EXCEPTION_STATUS_OPEN\x10\x01\x12,\n" +
+	"(RECONCILIATION_EXCEPTION_STATUS_RESOLVED\x10\x02\x12+\n" +
+	"'RECONCILIATION_EXCEPTION_STATUS_CLEARED\x10\x03*\xc4\x01\n" +
+	"\x17ReconciliationDayStatus\x12)\n" +
+	"%RECONCILIATION_DAY_STATUS_UNSPECIFIED\x10\x00\x12*\n" +
+	"&RECONCILIATION_DAY_STATUS_NOT_IMPORTED\x10\x01\x12(\n" +
+	"$RECONCILIATION_DAY_STATUS_RECONCILED\x10\x02\x12(\n" +
+	"$RECONCILIATION_DAY_STATUS_EXCEPTIONS\x10\x03*\x83\x01\n" +
+	"\rVoucherStatus\x12\x1e\n" +
+	"\x1aVOUCHER_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15VOUCHER_STATUS_ISSUED\x10\x01\x12\x1b\n" +
+	"\x17VOUCHER_STATUS_REDEEMED\x10\x02\x12\x1a\n" +
+	"\x16VOUCHER_STATUS_EXPIRED\x10\x032\x9b\x16\n" +
 	"\rLedgerService\x12u\n" +
 	"\n" +
 	"GetBalance\x12\x19.rgs.v1.GetBalanceRequest\x1a\x1a.rgs.v1.GetBalanceResponse\"0\x82\xd3\xe4\x93\x02*\x12(/v1/ledger/accounts/{account_id}/balance\x12Z\n" +
@@ -1174,7 +4804,22 @@ const file_rgs_v1_ledger_proto_rawDesc = "" +
 	"\bWithdraw\x12\x17.rgs.v1.WithdrawRequest\x1a\x18.rgs.v1.WithdrawResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/ledger/withdrawals\x12}\n" +
 	"\x10TransferToDevice\x12\x1f.rgs.v1.TransferToDeviceRequest\x1a .rgs.v1.TransferToDeviceResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/ledger/transfers/device\x12\x81\x01\n" +
 	"\x11TransferToAccount\x12 .rgs.v1.TransferToAccountRequest\x1a!.rgs.v1.TransferToAccountResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/ledger/transfers/account\x12\x8c\x01\n" +
-	"\x10ListTransactions\x12\x1f.rgs.v1.ListTransactionsRequest\x1a .rgs.v1.ListTransactionsResponse\"5\x82\xd3\xe4\x93\x02/\x12-/v1/ledger/accounts/{account_id}/transactionsB\x8d\x01\n" +
+	"\x10ListTransactions\x12\x1f.rgs.v1.ListTransactionsRequest\x1a .rgs.v1.ListTransactionsResponse\"5\x82\xd3\xe4\x93\x02/\x12-/v1/ledger/accounts/{account_id}/transactions\x12\x93\x01\n" +
+	"\x17ListUnresolvedTransfers\x12&.rgs.v1.ListUnresolvedTransfersRequest\x1a'.rgs.v1.ListUnresolvedTransfersResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/ledger/transfers/unresolved\x12\x89\x01\n" +
+	"\x0fResolveTransfer\x12\x1e.rgs.v1.ResolveTransferRequest\x1a\x1f.rgs.v1.ResolveTransferResponse\"5\x82\xd3\xe4\x93\x02/:\x01*\"*/v1/ledger/transfers/{transfer_id}/resolve\x12\x82\x01\n" +
+	"\rGetEFTLockout\x12\x1c.rgs.v1.GetEFTLockoutRequest\x1a\x1d.rgs.v1.GetEFTLockoutResponse\"4\x82\xd3\xe4\x93\x02.\x12,/v1/ledger/accounts/{account_id}/eft-lockout\x12s\n" +
+	"\x0fListEFTLockouts\x12\x1e.rgs.v1.ListEFTLockoutsRequest\x1a\x1f.rgs.v1.ListEFTLockoutsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/ledger/eft-lockouts\x12\x91\x01\n" +
+	"\x0fResetEFTLockout\x12\x1e.rgs.v1.ResetEFTLockoutRequest\x1a\x1f.rgs.v1.ResetEFTLockoutResponse\"=\x82\xd3\xe4\x93\x027:\x01*\"2/v1/ledger/accounts/{account_id}/eft-lockout/reset\x12\x8c\x01\n" +
+	"\x0fVoidTransaction\x12\x1e.rgs.v1.VoidTransactionRequest\x1a\x1f.rgs.v1.VoidTransactionResponse\"8\x82\xd3\xe4\x93\x022:\x01*\"-/v1/ledger/transactions/{transaction_id}/void\x12v\n" +
+	"\x10ExchangeCurrency\x12\x1f.rgs.v1.ExchangeCurrencyRequest\x1a .rgs.v1.ExchangeCurrencyResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/ledger/exchanges\x12\x85\x01\n" +
+	"\x13ImportBankStatement\x12\".rgs.v1.ImportBankStatementRequest\x1a#.rgs.v1.ImportBankStatementResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/ledger/bank-statements\x12\xa7\x01\n" +
+	"\x1cListReconciliationExceptions\x12+.rgs.v1.ListReconciliationExceptionsRequest\x1a,.rgs.v1.ListReconciliationExceptionsResponse\",\x82\xd3\xe4\x93\x02&\x12$/v1/ledger/reconciliation/exceptions\x12\xc7\x01\n" +
+	"\x1eResolveReconciliationException\x12-.rgs.v1.ResolveReconciliationExceptionRequest\x1a..rgs.v1.ResolveReconciliationExceptionResponse\"F\x82\xd3\xe4\x93\x02@:\x01*\";/v1/ledger/reconciliation/exceptions/{exception_id}/resolve\x12\xa0\x01\n" +
+	"\x17GetReconciliationReport\x12&.rgs.v1.GetReconciliationReportRequest\x1a'.rgs.v1.GetReconciliationReportResponse\"4\x82\xd3\xe4\x93\x02.\x12,/v1/ledger/reconciliation/days/{banking_day}\x12\x84\x01\n" +
+	"\x13ListAccountsNearCap\x12\".rgs.v1.ListAccountsNearCapRequest\x1a#.rgs.v1.ListAccountsNearCapResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/ledger/balance-caps/near\x12i\n" +
+	"\fIssueVoucher\x12\x1b.rgs.v1.IssueVoucherRequest\x1a\x1c.rgs.v1.IssueVoucherResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/ledger/vouchers\x12s\n" +
+	"\rRedeemVoucher\x12\x1c.rgs.v1.RedeemVoucherRequest\x1a\x1d.rgs.v1.RedeemVoucherResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/ledger/vouchers/redeem\x12\x85\x01\n" +
+	"\x13GetVoucherLiability\x12\".rgs.v1.GetVoucherLiabilityRequest\x1a#.rgs.v1.GetVoucherLiabilityResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/ledger/vouchers/liabilityB\x8d\x01\n" +
 	"\n" +
 	"com.rgs.v1B\vLedgerProtoP\x01Z9github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1;rgsv1\xa2\x02\x03RXX\xaa\x02\x06Rgs.V1\xca\x02\x06Rgs\\V1\xe2\x02\x12Rgs\\V1\\GPBMetadata\xea\x02\aRgs::V1b\x06proto3"
 
@@ -1190,76 +4835,231 @@ func file_rgs_v1_ledger_proto_rawDescGZIP() []byte {
 	return file_rgs_v1_ledger_proto_rawDescData
 }
 
-var file_rgs_v1_ledger_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_rgs_v1_ledger_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_rgs_v1_ledger_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_rgs_v1_ledger_proto_msgTypes = make([]protoimpl.MessageInfo, 53)
 var file_rgs_v1_ledger_proto_goTypes = []any{
-	(LedgerTransactionType)(0),        // 0: rgs.v1.LedgerTransactionType
-	(TransferStatus)(0),               // 1: rgs.v1.TransferStatus
-	(*Money)(nil),                     // 2: rgs.v1.Money
-	(*LedgerTransaction)(nil),         // 3: rgs.v1.LedgerTransaction
-	(*GetBalanceRequest)(nil),         // 4: rgs.v1.GetBalanceRequest
-	(*GetBalanceResponse)(nil),        // 5: rgs.v1.GetBalanceResponse
-	(*DepositRequest)(nil),            // 6: rgs.v1.DepositRequest
-	(*DepositResponse)(nil),           // 7: rgs.v1.DepositResponse
-	(*WithdrawRequest)(nil),           // 8: rgs.v1.WithdrawRequest
-	(*WithdrawResponse)(nil),          // 9: rgs.v1.WithdrawResponse
-	(*TransferToDeviceRequest)(nil),   // 10: rgs.v1.TransferToDeviceRequest
-	(*TransferToDeviceResponse)(nil),  // 11: rgs.v1.TransferToDeviceResponse
-	(*TransferToAccountRequest)(nil),  // 12: rgs.v1.TransferToAccountRequest
-	(*TransferToAccountResponse)(nil), // 13: rgs.v1.TransferToAccountResponse
-	(*ListTransactionsRequest)(nil),   // 14: rgs.v1.ListTransactionsRequest
-	(*ListTransactionsResponse)(nil),  // 15: rgs.v1.ListTransactionsResponse
-	(*RequestMeta)(nil),               // 16: rgs.v1.RequestMeta
-	(*ResponseMeta)(nil),              // 17: rgs.v1.ResponseMeta
+	(LedgerTransactionType)(0),                     // 0: rgs.v1.LedgerTransactionType
+	(TransferStatus)(0),                            // 1: rgs.v1.TransferStatus
+	(UnresolvedTransferStatus)(0),                  // 2: rgs.v1.UnresolvedTransferStatus
+	(TransferResolutionAction)(0),                  // 3: rgs.v1.TransferResolutionAction
+	(BankStatementFormat)(0),                       // 4: rgs.v1.BankStatementFormat
+	(BankEntryDirection)(0),                        // 5: rgs.v1.BankEntryDirection
+	(ReconciliationExceptionKind)(0),               // 6: rgs.v1.ReconciliationExceptionKind
+	(ReconciliationExceptionStatus)(0),             // 7: rgs.v1.ReconciliationExceptionStatus
+	(ReconciliationDayStatus)(0),                   // 8: rgs.v1.ReconciliationDayStatus
+	(VoucherStatus)(0),                             // 9: rgs.v1.VoucherStatus
+	(*Money)(nil),                                  // 10: rgs.v1.Money
+	(*UnresolvedTransfer)(nil),                     // 11: rgs.v1.UnresolvedTransfer
+	(*LedgerTransaction)(nil),                      // 12: rgs.v1.LedgerTransaction
+	(*GetBalanceRequest)(nil),                      // 13: rgs.v1.GetBalanceRequest
+	(*GetBalanceResponse)(nil),                     // 14: rgs.v1.GetBalanceResponse
+	(*DepositRequest)(nil),                         // 15: rgs.v1.DepositRequest
+	(*DepositResponse)(nil),                        // 16: rgs.v1.DepositResponse
+	(*WithdrawRequest)(nil),                        // 17: rgs.v1.WithdrawRequest
+	(*WithdrawResponse)(nil),                       // 18: rgs.v1.WithdrawResponse
+	(*TransferToDeviceRequest)(nil),                // 19: rgs.v1.TransferToDeviceRequest
+	(*TransferToDeviceResponse)(nil),               // 20: rgs.v1.TransferToDeviceResponse
+	(*TransferToAccountRequest)(nil),               // 21: rgs.v1.TransferToAccountRequest
+	(*TransferToAccountResponse)(nil),              // 22: rgs.v1.TransferToAccountResponse
+	(*ListTransactionsRequest)(nil),                // 23: rgs.v1.ListTransactionsRequest
+	(*ListTransactionsResponse)(nil),               // 24: rgs.v1.ListTransactionsResponse
+	(*ListUnresolvedTransfersRequest)(nil),         // 25: rgs.v1.ListUnresolvedTransfersRequest
+	(*ListUnresolvedTransfersResponse)(nil),        // 26: rgs.v1.ListUnresolvedTransfersResponse
+	(*ResolveTransferRequest)(nil),                 // 27: rgs.v1.ResolveTransferRequest
+	(*ResolveTransferResponse)(nil),                // 28: rgs.v1.ResolveTransferResponse
+	(*EFTLockout)(nil),                             // 29: rgs.v1.EFTLockout
+	(*GetEFTLockoutRequest)(nil),                   // 30: rgs.v1.GetEFTLockoutRequest
+	(*GetEFTLockoutResponse)(nil),                  // 31: rgs.v1.GetEFTLockoutResponse
+	(*ListEFTLockoutsRequest)(nil),                 // 32: rgs.v1.ListEFTLockoutsRequest
+	(*ListEFTLockoutsResponse)(nil),                // 33: rgs.v1.ListEFTLockoutsResponse
+	(*ResetEFTLockoutRequest)(nil),                 // 34: rgs.v1.ResetEFTLockoutRequest
+	(*ResetEFTLockoutResponse)(nil),                // 35: rgs.v1.ResetEFTLockoutResponse
+	(*VoidTransactionRequest)(nil),                 // 36: rgs.v1.VoidTransactionRequest
+	(*VoidTransactionResponse)(nil),                // 37: rgs.v1.VoidTransactionResponse
+	(*ExchangeCurrencyRequest)(nil),                // 38: rgs.v1.ExchangeCurrencyRequest
+	(*ExchangeCurrencyResponse)(nil),               // 39: rgs.v1.ExchangeCurrencyResponse
+	(*BankStatementEntry)(nil),                     // 40: rgs.v1.BankStatementEntry
+	(*ReconciliationException)(nil),                // 41: rgs.v1.ReconciliationException
+	(*ReconciliationTotals)(nil),                   // 42: rgs.v1.ReconciliationTotals
+	(*ReconciliationDayReport)(nil),                // 43: rgs.v1.ReconciliationDayReport
+	(*ImportBankStatementRequest)(nil),             // 44: rgs.v1.ImportBankStatementRequest
+	(*ImportBankStatementResponse)(nil),            // 45: rgs.v1.ImportBankStatementResponse
+	(*ListReconciliationExceptionsRequest)(nil),    // 46: rgs.v1.ListReconciliationExceptionsRequest
+	(*ListReconciliationExceptionsResponse)(nil),   // 47: rgs.v1.ListReconciliationExceptionsResponse
+	(*ResolveReconciliationExceptionRequest)(nil),  // 48: rgs.v1.ResolveReconciliationExceptionRequest
+	(*ResolveReconciliationExceptionResponse)(nil), // 49: rgs.v1.ResolveReconciliationExceptionResponse
+	(*GetReconciliationReportRequest)(nil),         // 50: rgs.v1.GetReconciliationReportRequest
+	(*GetReconciliationReportResponse)(nil),        // 51: rgs.v1.GetReconciliationReportResponse
+	(*AccountCapStatus)(nil),                       // 52: rgs.v1.AccountCapStatus
+	(*ListAccountsNearCapRequest)(nil),             // 53: rgs.v1.ListAccountsNearCapRequest
+	(*ListAccountsNearCapResponse)(nil),            // 54: rgs.v1.ListAccountsNearCapResponse
+	(*Voucher)(nil),                                // 55: rgs.v1.Voucher
+	(*IssueVoucherRequest)(nil),                    // 56: rgs.v1.IssueVoucherRequest
+	(*IssueVoucherResponse)(nil),                   // 57: rgs.v1.IssueVoucherResponse
+	(*RedeemVoucherRequest)(nil),                   // 58: rgs.v1.RedeemVoucherRequest
+	(*RedeemVoucherResponse)(nil),                  // 59: rgs.v1.RedeemVoucherResponse
+	(*VoucherLiability)(nil),                       // 60: rgs.v1.VoucherLiability
+	(*GetVoucherLiabilityRequest)(nil),             // 61: rgs.v1.GetVoucherLiabilityRequest
+	(*GetVoucherLiabilityResponse)(nil),            // 62: rgs.v1.GetVoucherLiabilityResponse
+	(*RequestMeta)(nil),                            // 63: rgs.v1.RequestMeta
+	(*ResponseMeta)(nil),                           // 64: rgs.v1.ResponseMeta
 }
 var file_rgs_v1_ledger_proto_depIdxs = []int32{
-	0,  // 0: rgs.v1.LedgerTransaction.transaction_type:type_name -> rgs.v1.LedgerTransactionType
-	2,  // 1: rgs.v1.LedgerTransaction.amount:type_name -> rgs.v1.Money
-	16, // 2: rgs.v1.GetBalanceRequest.meta:type_name -> rgs.v1.RequestMeta
-	17, // 3: rgs.v1.GetBalanceResponse.meta:type_name -> rgs.v1.ResponseMeta
-	2,  // 4: rgs.v1.GetBalanceResponse.available_balance:type_name -> rgs.v1.Money
-	2,  // 5: rgs.v1.GetBalanceResponse.pending_balance:type_name -> rgs.v1.Money
-	16, // 6: rgs.v1.DepositRequest.meta:type_name -> rgs.v1.RequestMeta
-	2,  // 7: rgs.v1.DepositRequest.amount:type_name -> rgs.v1.Money
-	17, // 8: rgs.v1.DepositResponse.meta:type_name -> rgs.v1.ResponseMeta
-	3,  // 9: rgs.v1.DepositResponse.transaction:type_name -> rgs.v1.LedgerTransaction
-	2,  // 10: rgs.v1.DepositResponse.available_balance:type_name -> rgs.v1.Money
-	16, // 11: rgs.v1.WithdrawRequest.meta:type_name -> rgs.v1.RequestMeta
-	2,  // 12: rgs.v1.WithdrawRequest.amount:type_name -> rgs.v1.Money
-	17, // 13: rgs.v1.WithdrawResponse.meta:type_name -> rgs.v1.ResponseMeta
-	3,  // 14: rgs.v1.WithdrawResponse.transaction:type_name -> rgs.v1.LedgerTransaction
-	2,  // 15: rgs.v1.WithdrawResponse.available_balance:type_name -> rgs.v1.Money
-	16, // 16: rgs.v1.TransferToDeviceRequest.meta:type_name -> rgs.v1.RequestMeta
-	2,  // 17: rgs.v1.TransferToDeviceRequest.requested_amount:type_name -> rgs.v1.Money
-	17, // 18: rgs.v1.TransferToDeviceResponse.meta:type_name -> rgs.v1.ResponseMeta
-	1,  // 19: rgs.v1.TransferToDeviceResponse.transfer_status:type_name -> rgs.v1.TransferStatus
-	2,  // 20: rgs.v1.TransferToDeviceResponse.transferred_amount:type_name -> rgs.v1.Money
-	2,  // 21: rgs.v1.TransferToDeviceResponse.available_balance:type_name -> rgs.v1.Money
-	16, // 22: rgs.v1.TransferToAccountRequest.meta:type_name -> rgs.v1.RequestMeta
-	2,  // 23: rgs.v1.TransferToAccountRequest.amount:type_name -> rgs.v1.Money
-	17, // 24: rgs.v1.TransferToAccountResponse.meta:type_name -> rgs.v1.ResponseMeta
-	3,  // 25: rgs.v1.TransferToAccountResponse.transaction:type_name -> rgs.v1.LedgerTransaction
-	2,  // 26: rgs.v1.TransferToAccountResponse.available_balance:type_name -> rgs.v1.Money
-	16, // 27: rgs.v1.ListTransactionsRequest.meta:type_name -> rgs.v1.RequestMeta
-	17, // 28: rgs.v1.ListTransactionsResponse.meta:type_name -> rgs.v1.ResponseMeta
-	3,  // 29: rgs.v1.ListTransactionsResponse.transactions:type_name -> rgs.v1.LedgerTransaction
-	4,  // 30: rgs.v1.LedgerService.GetBalance:input_type -> rgs.v1.GetBalanceRequest
-	6,  // 31: rgs.v1.LedgerService.Deposit:input_type -> rgs.v1.DepositRequest
-	8,  // 32: rgs.v1.LedgerService.Withdraw:input_type -> rgs.v1.WithdrawRequest
-	10, // 33: rgs.v1.LedgerService.TransferToDevice:input_type -> rgs.v1.TransferToDeviceRequest
-	12, // 34: rgs.v1.LedgerService.TransferToAccount:input_type -> rgs.v1.TransferToAccountRequest
-	14, // 35: rgs.v1.LedgerService.ListTransactions:input_type -> rgs.v1.ListTransactionsRequest
-	5,  // 36: rgs.v1.LedgerService.GetBalance:output_type -> rgs.v1.GetBalanceResponse
-	7,  // 37: rgs.v1.LedgerService.Deposit:output_type -> rgs.v1.DepositResponse
-	9,  // 38: rgs.v1.LedgerService.Withdraw:output_type -> rgs.v1.WithdrawResponse
-	11, // 39: rgs.v1.LedgerService.TransferToDevice:output_type -> rgs.v1.TransferToDeviceResponse
-	13, // 40: rgs.v1.LedgerService.TransferToAccount:output_type -> rgs.v1.TransferToAccountResponse
-	15, // 41: rgs.v1.LedgerService.ListTransactions:output_type -> rgs.v1.ListTransactionsResponse
-	36, // [36:42] is the sub-list for method output_type
-	30, // [30:36] is the sub-list for method input_type
-	30, // [30:30] is the sub-list for extension type_name
-	30, // [30:30] is the sub-list for extension extendee
-	0,  // [0:30] is the sub-list for field type_name
+	10,  // 0: rgs.v1.UnresolvedTransfer.requested_amount:type_name -> rgs.v1.Money
+	10,  // 1: rgs.v1.UnresolvedTransfer.transferred_amount:type_name -> rgs.v1.Money
+	2,   // 2: rgs.v1.UnresolvedTransfer.status:type_name -> rgs.v1.UnresolvedTransferStatus
+	0,   // 3: rgs.v1.LedgerTransaction.transaction_type:type_name -> rgs.v1.LedgerTransactionType
+	10,  // 4: rgs.v1.LedgerTransaction.amount:type_name -> rgs.v1.Money
+	63,  // 5: rgs.v1.GetBalanceRequest.meta:type_name -> rgs.v1.RequestMeta
+	64,  // 6: rgs.v1.GetBalanceResponse.meta:type_name -> rgs.v1.ResponseMeta
+	10,  // 7: rgs.v1.GetBalanceResponse.available_balance:type_name -> rgs.v1.Money
+	10,  // 8: rgs.v1.GetBalanceResponse.pending_balance:type_name -> rgs.v1.Money
+	63,  // 9: rgs.v1.DepositRequest.meta:type_name -> rgs.v1.RequestMeta
+	10,  // 10: rgs.v1.DepositRequest.amount:type_name -> rgs.v1.Money
+	64,  // 11: rgs.v1.DepositResponse.meta:type_name -> rgs.v1.ResponseMeta
+	12,  // 12: rgs.v1.DepositResponse.transaction:type_name -> rgs.v1.LedgerTransaction
+	10,  // 13: rgs.v1.DepositResponse.available_balance:type_name -> rgs.v1.Money
+	10,  // 14: rgs.v1.DepositResponse.refused_amount:type_name -> rgs.v1.Money
+	63,  // 15: rgs.v1.WithdrawRequest.meta:type_name -> rgs.v1.RequestMeta
+	10,  // 16: rgs.v1.WithdrawRequest.amount:type_name -> rgs.v1.Money
+	64,  // 17: rgs.v1.WithdrawResponse.meta:type_name -> rgs.v1.ResponseMeta
+	12,  // 18: rgs.v1.WithdrawResponse.transaction:type_name -> rgs.v1.LedgerTransaction
+	10,  // 19: rgs.v1.WithdrawResponse.available_balance:type_name -> rgs.v1.Money
+	63,  // 20: rgs.v1.TransferToDeviceRequest.meta:type_name -> rgs.v1.RequestMeta
+	10,  // 21: rgs.v1.TransferToDeviceRequest.requested_amount:type_name -> rgs.v1.Money
+	64,  // 22: rgs.v1.TransferToDeviceResponse.meta:type_name -> rgs.v1.ResponseMeta
+	1,   // 23: rgs.v1.TransferToDeviceResponse.transfer_status:type_name -> rgs.v1.TransferStatus
+	10,  // 24: rgs.v1.TransferToDeviceResponse.transferred_amount:type_name -> rgs.v1.Money
+	10,  // 25: rgs.v1.TransferToDeviceResponse.available_balance:type_name -> rgs.v1.Money
+	63,  // 26: rgs.v1.TransferToAccountRequest.meta:type_name -> rgs.v1.RequestMeta
+	10,  // 27: rgs.v1.TransferToAccountRequest.amount:type_name -> rgs.v1.Money
+	64,  // 28: rgs.v1.TransferToAccountResponse.meta:type_name -> rgs.v1.ResponseMeta
+	12,  // 29: rgs.v1.TransferToAccountResponse.transaction:type_name -> rgs.v1.LedgerTransaction
+	10,  // 30: rgs.v1.TransferToAccountResponse.available_balance:type_name -> rgs.v1.Money
+	10,  // 31: rgs.v1.TransferToAccountResponse.refused_amount:type_name -> rgs.v1.Money
+	63,  // 32: rgs.v1.ListTransactionsRequest.meta:type_name -> rgs.v1.RequestMeta
+	64,  // 33: rgs.v1.ListTransactionsResponse.meta:type_name -> rgs.v1.ResponseMeta
+	12,  // 34: rgs.v1.ListTransactionsResponse.transactions:type_name -> rgs.v1.LedgerTransaction
+	63,  // 35: rgs.v1.ListUnresolvedTransfersRequest.meta:type_name -> rgs.v1.RequestMeta
+	64,  // 36: rgs.v1.ListUnresolvedTransfersResponse.meta:type_name -> rgs.v1.ResponseMeta
+	11,  // 37: rgs.v1.ListUnresolvedTransfersResponse.transfers:type_name -> rgs.v1.UnresolvedTransfer
+	63,  // 38: rgs.v1.ResolveTransferRequest.meta:type_name -> rgs.v1.RequestMeta
+	3,   // 39: rgs.v1.ResolveTransferRequest.action:type_name -> rgs.v1.TransferResolutionAction
+	64,  // 40: rgs.v1.ResolveTransferResponse.meta:type_name -> rgs.v1.ResponseMeta
+	11,  // 41: rgs.v1.ResolveTransferResponse.transfer:type_name -> rgs.v1.UnresolvedTransfer
+	12,  // 42: rgs.v1.ResolveTransferResponse.reversal_transaction:type_name -> rgs.v1.LedgerTransaction
+	10,  // 43: rgs.v1.ResolveTransferResponse.available_balance:type_name -> rgs.v1.Money
+	63,  // 44: rgs.v1.GetEFTLockoutRequest.meta:type_name -> rgs.v1.RequestMeta
+	64,  // 45: rgs.v1.GetEFTLockoutResponse.meta:type_name -> rgs.v1.ResponseMeta
+	29,  // 46: rgs.v1.GetEFTLockoutResponse.lockout:type_name -> rgs.v1.EFTLockout
+	63,  // 47: rgs.v1.ListEFTLockoutsRequest.meta:type_name -> rgs.v1.RequestMeta
+	64,  // 48: rgs.v1.ListEFTLockoutsResponse.meta:type_name -> rgs.v1.ResponseMeta
+	29,  // 49: rgs.v1.ListEFTLockoutsResponse.lockouts:type_name -> rgs.v1.EFTLockout
+	63,  // 50: rgs.v1.ResetEFTLockoutRequest.meta:type_name -> rgs.v1.RequestMeta
+	64,  // 51: rgs.v1.ResetEFTLockoutResponse.meta:type_name -> rgs.v1.ResponseMeta
+	29,  // 52: rgs.v1.ResetEFTLockoutResponse.lockout:type_name -> rgs.v1.EFTLockout
+	63,  // 53: rgs.v1.VoidTransactionRequest.meta:type_name -> rgs.v1.RequestMeta
+	64,  // 54: rgs.v1.VoidTransactionResponse.meta:type_name -> rgs.v1.ResponseMeta
+	12,  // 55: rgs.v1.VoidTransactionResponse.original_transaction:type_name -> rgs.v1.LedgerTransaction
+	12,  // 56: rgs.v1.VoidTransactionResponse.void_transaction:type_name -> rgs.v1.LedgerTransaction
+	10,  // 57: rgs.v1.VoidTransactionResponse.available_balance:type_name -> rgs.v1.Money
+	63,  // 58: rgs.v1.ExchangeCurrencyRequest.meta:type_name -> rgs.v1.RequestMeta
+	10,  // 59: rgs.v1.ExchangeCurrencyRequest.amount:type_name -> rgs.v1.Money
+	64,  // 60: rgs.v1.ExchangeCurrencyResponse.meta:type_name -> rgs.v1.ResponseMeta
+	12,  // 61: rgs.v1.ExchangeCurrencyResponse.transaction:type_name -> rgs.v1.LedgerTransaction
+	10,  // 62: rgs.v1.ExchangeCurrencyResponse.exchanged_amount:type_name -> rgs.v1.Money
+	10,  // 63: rgs.v1.ExchangeCurrencyResponse.from_balance:type_name -> rgs.v1.Money
+	10,  // 64: rgs.v1.ExchangeCurrencyResponse.to_balance:type_name -> rgs.v1.Money
+	5,   // 65: rgs.v1.BankStatementEntry.direction:type_name -> rgs.v1.BankEntryDirection
+	10,  // 66: rgs.v1.BankStatementEntry.amount:type_name -> rgs.v1.Money
+	6,   // 67: rgs.v1.ReconciliationException.kind:type_name -> rgs.v1.ReconciliationExceptionKind
+	7,   // 68: rgs.v1.ReconciliationException.status:type_name -> rgs.v1.ReconciliationExceptionStatus
+	40,  // 69: rgs.v1.ReconciliationException.entry:type_name -> rgs.v1.BankStatementEntry
+	12,  // 70: rgs.v1.ReconciliationException.transaction:type_name -> rgs.v1.LedgerTransaction
+	8,   // 71: rgs.v1.ReconciliationDayReport.status:type_name -> rgs.v1.ReconciliationDayStatus
+	42,  // 72: rgs.v1.ReconciliationDayReport.totals:type_name -> rgs.v1.ReconciliationTotals
+	63,  // 73: rgs.v1.ImportBankStatementRequest.meta:type_name -> rgs.v1.RequestMeta
+	4,   // 74: rgs.v1.ImportBankStatementRequest.format:type_name -> rgs.v1.BankStatementFormat
+	64,  // 75: rgs.v1.ImportBankStatementResponse.meta:type_name -> rgs.v1.ResponseMeta
+	63,  // 76: rgs.v1.ListReconciliationExceptionsRequest.meta:type_name -> rgs.v1.RequestMeta
+	7,   // 77: rgs.v1.ListReconciliationExceptionsRequest.status:type_name -> rgs.v1.ReconciliationExceptionStatus
+	64,  // 78: rgs.v1.ListReconciliationExceptionsResponse.meta:type_name -> rgs.v1.ResponseMeta
+	41,  // 79: rgs.v1.ListReconciliationExceptionsResponse.exceptions:type_name -> rgs.v1.ReconciliationException
+	63,  // 80: rgs.v1.ResolveReconciliationExceptionRequest.meta:type_name -> rgs.v1.RequestMeta
+	64,  // 81: rgs.v1.ResolveReconciliationExceptionResponse.meta:type_name -> rgs.v1.ResponseMeta
+	41,  // 82: rgs.v1.ResolveReconciliationExceptionResponse.exception:type_name -> rgs.v1.ReconciliationException
+	63,  // 83: rgs.v1.GetReconciliationReportRequest.meta:type_name -> rgs.v1.RequestMeta
+	64,  // 84: rgs.v1.GetReconciliationReportResponse.meta:type_name -> rgs.v1.ResponseMeta
+	43,  // 85: rgs.v1.GetReconciliationReportResponse.report:type_name -> rgs.v1.ReconciliationDayReport
+	10,  // 86: rgs.v1.AccountCapStatus.available_balance:type_name -> rgs.v1.Money
+	10,  // 87: rgs.v1.AccountCapStatus.max_balance:type_name -> rgs.v1.Money
+	63,  // 88: rgs.v1.ListAccountsNearCapRequest.meta:type_name -> rgs.v1.RequestMeta
+	64,  // 89: rgs.v1.ListAccountsNearCapResponse.meta:type_name -> rgs.v1.ResponseMeta
+	52,  // 90: rgs.v1.ListAccountsNearCapResponse.accounts:type_name -> rgs.v1.AccountCapStatus
+	10,  // 91: rgs.v1.Voucher.value:type_name -> rgs.v1.Money
+	9,   // 92: rgs.v1.Voucher.status:type_name -> rgs.v1.VoucherStatus
+	63,  // 93: rgs.v1.IssueVoucherRequest.meta:type_name -> rgs.v1.RequestMeta
+	10,  // 94: rgs.v1.IssueVoucherRequest.value:type_name -> rgs.v1.Money
+	64,  // 95: rgs.v1.IssueVoucherResponse.meta:type_name -> rgs.v1.ResponseMeta
+	55,  // 96: rgs.v1.IssueVoucherResponse.voucher:type_name -> rgs.v1.Voucher
+	63,  // 97: rgs.v1.RedeemVoucherRequest.meta:type_name -> rgs.v1.RequestMeta
+	64,  // 98: rgs.v1.RedeemVoucherResponse.meta:type_name -> rgs.v1.ResponseMeta
+	55,  // 99: rgs.v1.RedeemVoucherResponse.voucher:type_name -> rgs.v1.Voucher
+	12,  // 100: rgs.v1.RedeemVoucherResponse.transaction:type_name -> rgs.v1.LedgerTransaction
+	10,  // 101: rgs.v1.RedeemVoucherResponse.available_balance:type_name -> rgs.v1.Money
+	10,  // 102: rgs.v1.VoucherLiability.outstanding_amount:type_name -> rgs.v1.Money
+	10,  // 103: rgs.v1.VoucherLiability.expired_amount:type_name -> rgs.v1.Money
+	10,  // 104: rgs.v1.VoucherLiability.redeemed_amount:type_name -> rgs.v1.Money
+	63,  // 105: rgs.v1.GetVoucherLiabilityRequest.meta:type_name -> rgs.v1.RequestMeta
+	64,  // 106: rgs.v1.GetVoucherLiabilityResponse.meta:type_name -> rgs.v1.ResponseMeta
+	60,  // 107: rgs.v1.GetVoucherLiabilityResponse.liabilities:type_name -> rgs.v1.VoucherLiability
+	13,  // 108: rgs.v1.LedgerService.GetBalance:input_type -> rgs.v1.GetBalanceRequest
+	15,  // 109: rgs.v1.LedgerService.Deposit:input_type -> rgs.v1.DepositRequest
+	17,  // 110: rgs.v1.LedgerService.Withdraw:input_type -> rgs.v1.WithdrawRequest
+	19,  // 111: rgs.v1.LedgerService.TransferToDevice:input_type -> rgs.v1.TransferToDeviceRequest
+	21,  // 112: rgs.v1.LedgerService.TransferToAccount:input_type -> rgs.v1.TransferToAccountRequest
+	23,  // 113: rgs.v1.LedgerService.ListTransactions:input_type -> rgs.v1.ListTransactionsRequest
+	25,  // 114: rgs.v1.LedgerService.ListUnresolvedTransfers:input_type -> rgs.v1.ListUnresolvedTransfersRequest
+	27,  // 115: rgs.v1.LedgerService.ResolveTransfer:input_type -> rgs.v1.ResolveTransferRequest
+	30,  // 116: rgs.v1.LedgerService.GetEFTLockout:input_type -> rgs.v1.GetEFTLockoutRequest
+	32,  // 117: rgs.v1.LedgerService.ListEFTLockouts:input_type -> rgs.v1.ListEFTLockoutsRequest
+	34,  // 118: rgs.v1.LedgerService.ResetEFTLockout:input_type -> rgs.v1.ResetEFTLockoutRequest
+	36,  // 119: rgs.v1.LedgerService.VoidTransaction:input_type -> rgs.v1.VoidTransactionRequest
+	38,  // 120: rgs.v1.LedgerService.ExchangeCurrency:input_type -> rgs.v1.ExchangeCurrencyRequest
+	44,  // 121: rgs.v1.LedgerService.ImportBankStatement:input_type -> rgs.v1.ImportBankStatementRequest
+	46,  // 122: rgs.v1.LedgerService.ListReconciliationExceptions:input_type -> rgs.v1.ListReconciliationExceptionsRequest
+	48,  // 123: rgs.v1.LedgerService.ResolveReconciliationException:input_type -> rgs.v1.ResolveReconciliationExceptionRequest
+	50,  // 124: rgs.v1.LedgerService.GetReconciliationReport:input_type -> rgs.v1.GetReconciliationReportRequest
+	53,  // 125: rgs.v1.LedgerService.ListAccountsNearCap:input_type -> rgs.v1.ListAccountsNearCapRequest
+	56,  // 126: rgs.v1.LedgerService.IssueVoucher:input_type -> rgs.v1.IssueVoucherRequest
+	58,  // 127: rgs.v1.LedgerService.RedeemVoucher:input_type -> rgs.v1.RedeemVoucherRequest
+	61,  // 128: rgs.v1.LedgerService.GetVoucherLiability:input_type -> rgs.v1.GetVoucherLiabilityRequest
+	14,  // 129: rgs.v1.LedgerService.GetBalance:output_type -> rgs.v1.GetBalanceResponse
+	16,  // 130: rgs.v1.LedgerService.Deposit:output_type -> rgs.v1.DepositResponse
+	18,  // 131: rgs.v1.LedgerService.Withdraw:output_type -> rgs.v1.WithdrawResponse
+	20,  // 132: rgs.v1.LedgerService.TransferToDevice:output_type -> rgs.v1.TransferToDeviceResponse
+	22,  // 133: rgs.v1.LedgerService.TransferToAccount:output_type -> rgs.v1.TransferToAccountResponse
+	24,  // 134: rgs.v1.LedgerService.ListTransactions:output_type -> rgs.v1.ListTransactionsResponse
+	26,  // 135: rgs.v1.LedgerService.ListUnresolvedTransfers:output_type -> rgs.v1.ListUnresolvedTransfersResponse
+	28,  // 136: rgs.v1.LedgerService.ResolveTransfer:output_type -> rgs.v1.ResolveTransferResponse
+	31,  // 137: rgs.v1.LedgerService.GetEFTLockout:output_type -> rgs.v1.GetEFTLockoutResponse
+	33,  // 138: rgs.v1.LedgerService.ListEFTLockouts:output_type -> rgs.v1.ListEFTLockoutsResponse
+	35,  // 139: rgs.v1.LedgerService.ResetEFTLockout:output_type -> rgs.v1.ResetEFTLockoutResponse
+	37,  // 140: rgs.v1.LedgerService.VoidTransaction:output_type -> rgs.v1.VoidTransactionResponse
+	39,  // 141: rgs.v1.LedgerService.ExchangeCurrency:output_type -> rgs.v1.ExchangeCurrencyResponse
+	45,  // 142: rgs.v1.LedgerService.ImportBankStatement:output_type -> rgs.v1.ImportBankStatementResponse
+	47,  // 143: rgs.v1.LedgerService.ListReconciliationExceptions:output_type -> rgs.v1.ListReconciliationExceptionsResponse
+	49,  // 144: rgs.v1.LedgerService.ResolveReconciliationException:output_type -> rgs.v1.ResolveReconciliationExceptionResponse
+	51,  // 145: rgs.v1.LedgerService.GetReconciliationReport:output_type -> rgs.v1.GetReconciliationReportResponse
+	54,  // 146: rgs.v1.LedgerService.ListAccountsNearCap:output_type -> rgs.v1.ListAccountsNearCapResponse
+	57,  // 147: rgs.v1.LedgerService.IssueVoucher:output_type -> rgs.v1.IssueVoucherResponse
+	59,  // 148: rgs.v1.LedgerService.RedeemVoucher:output_type -> rgs.v1.RedeemVoucherResponse
+	62,  // 149: rgs.v1.LedgerService.GetVoucherLiability:output_type -> rgs.v1.GetVoucherLiabilityResponse
+	129, // [129:150] is the sub-list for method output_type
+	108, // [108:129] is the sub-list for method input_type
+	108, // [108:108] is the sub-list for extension type_name
+	108, // [108:108] is the sub-list for extension extendee
+	0,   // [0:108] is the sub-list for field type_name
 }
 
 func init() { file_rgs_v1_ledger_proto_init() }
@@ -1273,8 +5073,8 @@ func file_rgs_v1_ledger_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rgs_v1_ledger_proto_rawDesc), len(file_rgs_v1_ledger_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   14,
+			NumEnums:      10,
+			NumMessages:   53,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,12 +19,27 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	LedgerService_GetBalance_FullMethodName        = "/rgs.v1.LedgerService/GetBalance"
-	LedgerService_Deposit_FullMethodName           = "/rgs.v1.LedgerService/Deposit"
-	LedgerService_Withdraw_FullMethodName          = "/rgs.v1.LedgerService/Withdraw"
-	LedgerService_TransferToDevice_FullMethodName  = "/rgs.v1.LedgerService/TransferToDevice"
-	LedgerService_TransferToAccount_FullMethodName = "/rgs.v1.LedgerService/TransferToAccount"
-	LedgerService_ListTransactions_FullMethodName  = "/rgs.v1.LedgerService/ListTransactions"
+	LedgerService_GetBalance_FullMethodName                     = "/rgs.v1.LedgerService/GetBalance"
+	LedgerService_Deposit_FullMethodName                        = "/rgs.v1.LedgerService/Deposit"
+	LedgerService_Withdraw_FullMethodName                       = "/rgs.v1.LedgerService/Withdraw"
+	LedgerService_TransferToDevice_FullMethodName               = "/rgs.v1.LedgerService/TransferToDevice"
+	LedgerService_TransferToAccount_FullMethodName              = "/rgs.v1.LedgerService/TransferToAccount"
+	LedgerService_ListTransactions_FullMethodName               = "/rgs.v1.LedgerService/ListTransactions"
+	LedgerService_ListUnresolvedTransfers_FullMethodName        = "/rgs.v1.LedgerService/ListUnresolvedTransfers"
+	LedgerService_ResolveTransfer_FullMethodName                = "/rgs.v1.LedgerService/ResolveTransfer"
+	LedgerService_GetEFTLockout_FullMethodName                  = "/rgs.v1.LedgerService/GetEFTLockout"
+	LedgerService_ListEFTLockouts_FullMethodName                = "/rgs.v1.LedgerService/ListEFTLockouts"
+	LedgerService_ResetEFTLockout_FullMethodName                = "/rgs.v1.LedgerService/ResetEFTLockout"
+	LedgerService_VoidTransaction_FullMethodName                = "/rgs.v1.LedgerService/VoidTransaction"
+	LedgerService_ExchangeCurrency_FullMethodName               = "/rgs.v1.LedgerService/ExchangeCurrency"
+	LedgerService_ImportBankStatement_FullMethodName            = "/rgs.v1.LedgerService/ImportBankStatement"
+	LedgerService_ListReconciliationExceptions_FullMethodName   = "/rgs.v1.LedgerService/ListReconciliationExceptions"
+	LedgerService_ResolveReconciliationException_FullMethodName = "/rgs.v1.LedgerService/ResolveReconciliationException"
+	LedgerService_GetReconciliationReport_FullMethodName        = "/rgs.v1.LedgerService/GetReconciliationReport"
+	LedgerService_ListAccountsNearCap_FullMethodName            = "/rgs.v1.LedgerService/ListAccountsNearCap"
+	LedgerService_IssueVoucher_FullMethodName                   = "/rgs.v1.LedgerService/IssueVoucher"
+	LedgerService_RedeemVoucher_FullMethodName                  = "/rgs.v1.LedgerService/RedeemVoucher"
+	LedgerService_GetVoucherLiability_FullMethodName            = "/rgs.v1.LedgerService/GetVoucherLiability"
 )
 
 // LedgerServiceClient is the client API for LedgerService service.
@@ -37,6 +52,21 @@ type LedgerServiceClient interface {
 	TransferToDevice(ctx context.Context, in *TransferToDeviceRequest, opts ...grpc.CallOption) (*TransferToDeviceResponse, error)
 	TransferToAccount(ctx context.Context, in *TransferToAccountRequest, opts ...grpc.CallOption) (*TransferToAccountResponse, error)
 	ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error)
+	ListUnresolvedTransfers(ctx context.Context, in *ListUnresolvedTransfersRequest, opts ...grpc.CallOption) (*ListUnresolvedTransfersResponse, error)
+	ResolveTransfer(ctx context.Context, in *ResolveTransferRequest, opts ...grpc.CallOption) (*ResolveTransferResponse, error)
+	GetEFTLockout(ctx context.Context, in *GetEFTLockoutRequest, opts ...grpc.CallOption) (*GetEFTLockoutResponse, error)
+	ListEFTLockouts(ctx context.Context, in *ListEFTLockoutsRequest, opts ...grpc.CallOption) (*ListEFTLockoutsResponse, error)
+	ResetEFTLockout(ctx context.Context, in *ResetEFTLockoutRequest, opts ...grpc.CallOption) (*ResetEFTLockoutResponse, error)
+	VoidTransaction(ctx context.Context, in *VoidTransactionRequest, opts ...grpc.CallOption) (*VoidTransactionResponse, error)
+	ExchangeCurrency(ctx context.Context, in *ExchangeCurrencyRequest, opts ...grpc.CallOption) (*ExchangeCurrencyResponse, error)
+	ImportBankStatement(ctx context.Context, in *ImportBankStatementRequest, opts ...grpc.CallOption) (*ImportBankStatementResponse, error)
+	ListReconciliationExceptions(ctx context.Context, in *ListReconciliationExceptionsRequest, opts ...grpc.CallOption) (*ListReconciliationExceptionsResponse, error)
+	ResolveReconciliationException(ctx context.Context, in *ResolveReconciliationExceptionRequest, opts ...grpc.CallOption) (*ResolveReconciliationExceptionResponse, error)
+	GetReconciliationReport(ctx context.Context, in *GetReconciliationReportRequest, opts ...grpc.CallOption) (*GetReconciliationReportResponse, error)
+	ListAccountsNearCap(ctx context.Context, in *ListAccountsNearCapRequest, opts ...grpc.CallOption) (*ListAccountsNearCapResponse, error)
+	IssueVoucher(ctx context.Context, in *IssueVoucherRequest, opts ...grpc.CallOption) (*IssueVoucherResponse, error)
+	RedeemVoucher(ctx context.Context, in *RedeemVoucherRequest, opts ...grpc.CallOption) (*RedeemVoucherResponse, error)
+	GetVoucherLiability(ctx context.Context, in *GetVoucherLiabilityRequest, opts ...grpc.CallOption) (*GetVoucherLiabilityResponse, error)
 }
 
 type ledgerServiceClient struct {
@@ -107,6 +137,156 @@ func (c *ledgerServiceClient) ListTransactions(ctx context.Context, in *ListTran
 	return out, nil
 }
 
+func (c *ledgerServiceClient) ListUnresolvedTransfers(ctx context.Context, in *ListUnresolvedTransfersRequest, opts ...grpc.CallOption) (*ListUnresolvedTransfersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUnresolvedTransfersResponse)
+	err := c.cc.Invoke(ctx, LedgerService_ListUnresolvedTransfers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ResolveTransfer(ctx context.Context, in *ResolveTransferRequest, opts ...grpc.CallOption) (*ResolveTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveTransferResponse)
+	err := c.cc.Invoke(ctx, LedgerService_ResolveTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetEFTLockout(ctx context.Context, in *GetEFTLockoutRequest, opts ...grpc.CallOption) (*GetEFTLockoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEFTLockoutResponse)
+	err := c.cc.Invoke(ctx, LedgerService_GetEFTLockout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ListEFTLockouts(ctx context.Context, in *ListEFTLockoutsRequest, opts ...grpc.CallOption) (*ListEFTLockoutsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEFTLockoutsResponse)
+	err := c.cc.Invoke(ctx, LedgerService_ListEFTLockouts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ResetEFTLockout(ctx context.Context, in *ResetEFTLockoutRequest, opts ...grpc.CallOption) (*ResetEFTLockoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetEFTLockoutResponse)
+	err := c.cc.Invoke(ctx, LedgerService_ResetEFTLockout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) VoidTransaction(ctx context.Context, in *VoidTransactionRequest, opts ...grpc.CallOption) (*VoidTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VoidTransactionResponse)
+	err := c.cc.Invoke(ctx, LedgerService_VoidTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ExchangeCurrency(ctx context.Context, in *ExchangeCurrencyRequest, opts ...grpc.CallOption) (*ExchangeCurrencyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExchangeCurrencyResponse)
+	err := c.cc.Invoke(ctx, LedgerService_ExchangeCurrency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ImportBankStatement(ctx context.Context, in *ImportBankStatementRequest, opts ...grpc.CallOption) (*ImportBankStatementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportBankStatementResponse)
+	err := c.cc.Invoke(ctx, LedgerService_ImportBankStatement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ListReconciliationExceptions(ctx context.Context, in *ListReconciliationExceptionsRequest, opts ...grpc.CallOption) (*ListReconciliationExceptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReconciliationExceptionsResponse)
+	err := c.cc.Invoke(ctx, LedgerService_ListReconciliationExceptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ResolveReconciliationException(ctx context.Context, in *ResolveReconciliationExceptionRequest, opts ...grpc.CallOption) (*ResolveReconciliationExceptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveReconciliationExceptionResponse)
+	err := c.cc.Invoke(ctx, LedgerService_ResolveReconciliationException_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetReconciliationReport(ctx context.Context, in *GetReconciliationReportRequest, opts ...grpc.CallOption) (*GetReconciliationReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReconciliationReportResponse)
+	err := c.cc.Invoke(ctx, LedgerService_GetReconciliationReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ListAccountsNearCap(ctx context.Context, in *ListAccountsNearCapRequest, opts ...grpc.CallOption) (*ListAccountsNearCapResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccountsNearCapResponse)
+	err := c.cc.Invoke(ctx, LedgerService_ListAccountsNearCap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) IssueVoucher(ctx context.Context, in *IssueVoucherRequest, opts ...grpc.CallOption) (*IssueVoucherResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IssueVoucherResponse)
+	err := c.cc.Invoke(ctx, LedgerService_IssueVoucher_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) RedeemVoucher(ctx context.Context, in *RedeemVoucherRequest, opts ...grpc.CallOption) (*RedeemVoucherResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RedeemVoucherResponse)
+	err := c.cc.Invoke(ctx, LedgerService_RedeemVoucher_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetVoucherLiability(ctx context.Context, in *GetVoucherLiabilityRequest, opts ...grpc.CallOption) (*GetVoucherLiabilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVoucherLiabilityResponse)
+	err := c.cc.Invoke(ctx, LedgerService_GetVoucherLiability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LedgerServiceServer is the server API for LedgerService service.
 // All implementations must embed UnimplementedLedgerServiceServer
 // for forward compatibility.
@@ -117,6 +297,21 @@ type LedgerServiceServer interface {
 	TransferToDevice(context.Context, *TransferToDeviceRequest) (*TransferToDeviceResponse, error)
 	TransferToAccount(context.Context, *TransferToAccountRequest) (*TransferToAccountResponse, error)
 	ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error)
+	ListUnresolvedTransfers(context.Context, *ListUnresolvedTransfersRequest) (*ListUnresolvedTransfersResponse, error)
+	ResolveTransfer(context.Context, *ResolveTransferRequest) (*ResolveTransferResponse, error)
+	GetEFTLockout(context.Context, *GetEFTLockoutRequest) (*GetEFTLockoutResponse, error)
+	ListEFTLockouts(context.Context, *ListEFTLockoutsRequest) (*ListEFTLockoutsResponse, error)
+	ResetEFTLockout(context.Context, *ResetEFTLockoutRequest) (*ResetEFTLockoutResponse, error)
+	VoidTransaction(context.Context, *VoidTransactionRequest) (*VoidTransactionResponse, error)
+	ExchangeCurrency(context.Context, *ExchangeCurrencyRequest) (*ExchangeCurrencyResponse, error)
+	ImportBankStatement(context.Context, *ImportBankStatementRequest) (*ImportBankStatementResponse, error)
+	ListReconciliationExceptions(context.Context, *ListReconciliationExceptionsRequest) (*ListReconciliationExceptionsResponse, error)
+	ResolveReconciliationException(context.Context, *ResolveReconciliationExceptionRequest) (*ResolveReconciliationExceptionResponse, error)
+	GetReconciliationReport(context.Context, *GetReconciliationReportRequest) (*GetReconciliationReportResponse, error)
+	ListAccountsNearCap(context.Context, *ListAccountsNearCapRequest) (*ListAccountsNearCapResponse, error)
+	IssueVoucher(context.Context, *IssueVoucherRequest) (*IssueVoucherResponse, error)
+	RedeemVoucher(context.Context, *RedeemVoucherRequest) (*RedeemVoucherResponse, error)
+	GetVoucherLiability(context.Context, *GetVoucherLiabilityRequest) (*GetVoucherLiabilityResponse, error)
 	mustEmbedUnimplementedLedgerServiceServer()
 }
 
@@ -145,6 +340,51 @@ func (UnimplementedLedgerServiceServer) TransferToAccount(context.Context, *Tran
 func (UnimplementedLedgerServiceServer) ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListTransactions not implemented")
 }
+func (UnimplementedLedgerServiceServer) ListUnresolvedTransfers(context.Context, *ListUnresolvedTransfersRequest) (*ListUnresolvedTransfersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListUnresolvedTransfers not implemented")
+}
+func (UnimplementedLedgerServiceServer) ResolveTransfer(context.Context, *ResolveTransferRequest) (*ResolveTransferResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResolveTransfer not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetEFTLockout(context.Context, *GetEFTLockoutRequest) (*GetEFTLockoutResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEFTLockout not implemented")
+}
+func (UnimplementedLedgerServiceServer) ListEFTLockouts(context.Context, *ListEFTLockoutsRequest) (*ListEFTLockoutsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListEFTLockouts not implemented")
+}
+func (UnimplementedLedgerServiceServer) ResetEFTLockout(context.Context, *ResetEFTLockoutRequest) (*ResetEFTLockoutResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResetEFTLockout not implemented")
+}
+func (UnimplementedLedgerServiceServer) VoidTransaction(context.Context, *VoidTransactionRequest) (*VoidTransactionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method VoidTransaction not implemented")
+}
+func (UnimplementedLedgerServiceServer) ExchangeCurrency(context.Context, *ExchangeCurrencyRequest) (*ExchangeCurrencyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExchangeCurrency not implemented")
+}
+func (UnimplementedLedgerServiceServer) ImportBankStatement(context.Context, *ImportBankStatementRequest) (*ImportBankStatementResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportBankStatement not implemented")
+}
+func (UnimplementedLedgerServiceServer) ListReconciliationExceptions(context.Context, *ListReconciliationExceptionsRequest) (*ListReconciliationExceptionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListReconciliationExceptions not implemented")
+}
+func (UnimplementedLedgerServiceServer) ResolveReconciliationException(context.Context, *ResolveReconciliationExceptionRequest) (*ResolveReconciliationExceptionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResolveReconciliationException not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetReconciliationReport(context.Context, *GetReconciliationReportRequest) (*GetReconciliationReportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetReconciliationReport not implemented")
+}
+func (UnimplementedLedgerServiceServer) ListAccountsNearCap(context.Context, *ListAccountsNearCapRequest) (*ListAccountsNearCapResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAccountsNearCap not implemented")
+}
+func (UnimplementedLedgerServiceServer) IssueVoucher(context.Context, *IssueVoucherRequest) (*IssueVoucherResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method IssueVoucher not implemented")
+}
+func (UnimplementedLedgerServiceServer) RedeemVoucher(context.Context, *RedeemVoucherRequest) (*RedeemVoucherResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RedeemVoucher not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetVoucherLiability(context.Context, *GetVoucherLiabilityRequest) (*GetVoucherLiabilityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetVoucherLiability not implemented")
+}
 func (UnimplementedLedgerServiceServer) mustEmbedUnimplementedLedgerServiceServer() {}
 func (UnimplementedLedgerServiceServer) testEmbeddedByValue()                       {}
 
@@ -274,6 +514,276 @@ func _LedgerService_ListTransactions_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LedgerService_ListUnresolvedTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUnresolvedTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ListUnresolvedTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ListUnresolvedTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ListUnresolvedTransfers(ctx, req.(*ListUnresolvedTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ResolveTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ResolveTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ResolveTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ResolveTransfer(ctx, req.(*ResolveTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetEFTLockout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEFTLockoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetEFTLockout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetEFTLockout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetEFTLockout(ctx, req.(*GetEFTLockoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ListEFTLockouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEFTLockoutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ListEFTLockouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ListEFTLockouts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ListEFTLockouts(ctx, req.(*ListEFTLockoutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ResetEFTLockout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetEFTLockoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ResetEFTLockout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ResetEFTLockout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ResetEFTLockout(ctx, req.(*ResetEFTLockoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_VoidTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoidTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).VoidTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_VoidTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).VoidTransaction(ctx, req.(*VoidTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ExchangeCurrency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeCurrencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ExchangeCurrency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ExchangeCurrency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ExchangeCurrency(ctx, req.(*ExchangeCurrencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ImportBankStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportBankStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ImportBankStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ImportBankStatement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ImportBankStatement(ctx, req.(*ImportBankStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ListReconciliationExceptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReconciliationExceptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ListReconciliationExceptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ListReconciliationExceptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ListReconciliationExceptions(ctx, req.(*ListReconciliationExceptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ResolveReconciliationException_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveReconciliationExceptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ResolveReconciliationException(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ResolveReconciliationException_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ResolveReconciliationException(ctx, req.(*ResolveReconciliationExceptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetReconciliationReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReconciliationReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetReconciliationReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetReconciliationReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetReconciliationReport(ctx, req.(*GetReconciliationReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ListAccountsNearCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountsNearCapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ListAccountsNearCap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ListAccountsNearCap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ListAccountsNearCap(ctx, req.(*ListAccountsNearCapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_IssueVoucher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueVoucherRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).IssueVoucher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_IssueVoucher_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).IssueVoucher(ctx, req.(*IssueVoucherRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_RedeemVoucher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeemVoucherRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).RedeemVoucher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_RedeemVoucher_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).RedeemVoucher(ctx, req.(*RedeemVoucherRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetVoucherLiability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVoucherLiabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetVoucherLiability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetVoucherLiability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetVoucherLiability(ctx, req.(*GetVoucherLiabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LedgerService_ServiceDesc is the grpc.ServiceDesc for LedgerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -305,6 +815,66 @@ var LedgerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListTransactions",
 			Handler:    _LedgerService_ListTransactions_Handler,
 		},
+		{
+			MethodName: "ListUnresolvedTransfers",
+			Handler:    _LedgerService_ListUnresolvedTransfers_Handler,
+		},
+		{
+			MethodName: "ResolveTransfer",
+			Handler:    _LedgerService_ResolveTransfer_Handler,
+		},
+		{
+			MethodName: "GetEFTLockout",
+			Handler:    _LedgerService_GetEFTLockout_Handler,
+		},
+		{
+			MethodName: "ListEFTLockouts",
+			Handler:    _LedgerService_ListEFTLockouts_Handler,
+		},
+		{
+			MethodName: "ResetEFTLockout",
+			Handler:    _LedgerService_ResetEFTLockout_Handler,
+		},
+		{
+			MethodName: "VoidTransaction",
+			Handler:    _LedgerService_VoidTransaction_Handler,
+		},
+		{
+			MethodName: "ExchangeCurrency",
+			Handler:    _LedgerService_ExchangeCurrency_Handler,
+		},
+		{
+			MethodName: "ImportBankStatement",
+			Handler:    _LedgerService_ImportBankStatement_Handler,
+		},
+		{
+			MethodName: "ListReconciliationExceptions",
+			Handler:    _LedgerService_ListReconciliationExceptions_Handler,
+		},
+		{
+			MethodName: "ResolveReconciliationException",
+			Handler:    _LedgerService_ResolveReconciliationException_Handler,
+		},
+		{
+			MethodName: "GetReconciliationReport",
+			Handler:    _LedgerService_GetReconciliationReport_Handler,
+		},
+		{
+			MethodName: "ListAccountsNearCap",
+			Handler:    _LedgerService_ListAccountsNearCap_Handler,
+		},
+		{
+			MethodName: "IssueVoucher",
+			Handler:    _LedgerService_IssueVoucher_Handler,
+		},
+		{
+			MethodName: "RedeemVoucher",
+			Handler:    _LedgerService_RedeemVoucher_Handler,
+		},
+		{
+			MethodName: "GetVoucherLiability",
+			Handler:    _LedgerService_GetVoucherLiability_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rgs/v1/ledger.proto",
@@ -0,0 +1,1719 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: rgs/v1/player.proto
+
+package rgsv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PlayerLimitType int32
+
+const (
+	PlayerLimitType_PLAYER_LIMIT_TYPE_UNSPECIFIED PlayerLimitType = 0
+	// Sum of deposits to the player's account.
+	PlayerLimitType_PLAYER_LIMIT_TYPE_DEPOSIT PlayerLimitType = 1
+	// Stakes less winnings.
+	PlayerLimitType_PLAYER_LIMIT_TYPE_LOSS PlayerLimitType = 2
+	// Sum of stakes.
+	PlayerLimitType_PLAYER_LIMIT_TYPE_WAGER PlayerLimitType = 3
+)
+
+// Enum value maps for PlayerLimitType.
+var (
+	PlayerLimitType_name = map[int32]string{
+		0: "PLAYER_LIMIT_TYPE_UNSPECIFIED",
+		1: "PLAYER_LIMIT_TYPE_DEPOSIT",
+		2: "PLAYER_LIMIT_TYPE_LOSS",
+		3: "PLAYER_LIMIT_TYPE_WAGER",
+	}
+	PlayerLimitType_value = map[string]int32{
+		"PLAYER_LIMIT_TYPE_UNSPECIFIED": 0,
+		"PLAYER_LIMIT_TYPE_DEPOSIT":     1,
+		"PLAYER_LIMIT_TYPE_LOSS":        2,
+		"PLAYER_LIMIT_TYPE_WAGER":       3,
+	}
+)
+
+func (x PlayerLimitType) Enum() *PlayerLimitType {
+	p := new(PlayerLimitType)
+	*p = x
+	return p
+}
+
+func (x PlayerLimitType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlayerLimitType) Descriptor() protoreflect.EnumDescriptor {
+	return file_rgs_v1_player_proto_enumTypes[0].Descriptor()
+}
+
+func (PlayerLimitType) Type() protoreflect.EnumType {
+	return &file_rgs_v1_player_proto_enumTypes[0]
+}
+
+func (x PlayerLimitType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlayerLimitType.Descriptor instead.
+func (PlayerLimitType) EnumDescriptor() ([]byte, []int) {
+	return file_rgs_v1_player_proto_rawDescGZIP(), []int{0}
+}
+
+// PlayerLimitPeriod is the trailing window of gaming days a limit covers,
+// including the current one.
+type PlayerLimitPeriod int32
+
+const (
+	PlayerLimitPeriod_PLAYER_LIMIT_PERIOD_UNSPECIFIED PlayerLimitPeriod = 0
+	PlayerLimitPeriod_PLAYER_LIMIT_PERIOD_DAILY       PlayerLimitPeriod = 1
+	PlayerLimitPeriod_PLAYER_LIMIT_PERIOD_WEEKLY      PlayerLimitPeriod = 2
+	PlayerLimitPeriod_PLAYER_LIMIT_PERIOD_MONTHLY     PlayerLimitPeriod = 3
+)
+
+// Enum value maps for PlayerLimitPeriod.
+var (
+	PlayerLimitPeriod_name = map[int32]string{
+		0: "PLAYER_LIMIT_PERIOD_UNSPECIFIED",
+		1: "PLAYER_LIMIT_PERIOD_DAILY",
+		2: "PLAYER_LIMIT_PERIOD_WEEKLY",
+		3: "PLAYER_LIMIT_PERIOD_MONTHLY",
+	}
+	PlayerLimitPeriod_value = map[string]int32{
+		"PLAYER_LIMIT_PERIOD_UNSPECIFIED": 0,
+		"PLAYER_LIMIT_PERIOD_DAILY":       1,
+		"PLAYER_LIMIT_PERIOD_WEEKLY":      2,
+		"PLAYER_LIMIT_PERIOD_MONTHLY":     3,
+	}
+)
+
+func (x PlayerLimitPeriod) Enum() *PlayerLimitPeriod {
+	p := new(PlayerLimitPeriod)
+	*p = x
+	return p
+}
+
+func (x PlayerLimitPeriod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlayerLimitPeriod) Descriptor() protoreflect.EnumDescriptor {
+	return file_rgs_v1_player_proto_enumTypes[1].Descriptor()
+}
+
+func (PlayerLimitPeriod) Type() protoreflect.EnumType {
+	return &file_rgs_v1_player_proto_enumTypes[1]
+}
+
+func (x PlayerLimitPeriod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlayerLimitPeriod.Descriptor instead.
+func (PlayerLimitPeriod) EnumDescriptor() ([]byte, []int) {
+	return file_rgs_v1_player_proto_rawDescGZIP(), []int{1}
+}
+
+// PlayerTransaction is a LedgerTransaction without the account,
+// authorization and free-text fields written by operators and services.
+type PlayerTransaction struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId   string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	TransactionType LedgerTransactionType  `protobuf:"varint,2,opt,name=transaction_type,json=transactionType,proto3,enum=rgs.v1.LedgerTransactionType" json:"transaction_type,omitempty"`
+	Amount          *Money                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	OccurredAt      string                 `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PlayerTransaction) Reset() {
+	*x = PlayerTransaction{}
+	mi := &file_rgs_v1_player_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerTransaction) ProtoMessage() {}
+
+func (x *PlayerTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_player_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerTransaction.ProtoReflect.Descriptor instead.
+func (*PlayerTransaction) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_player_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PlayerTransaction) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *PlayerTransaction) GetTransactionType() LedgerTransactionType {
+	if x != nil {
+		return x.TransactionType
+	}
+	return LedgerTransactionType_LEDGER_TRANSACTION_TYPE_UNSPECIFIED
+}
+
+func (x *PlayerTransaction) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *PlayerTransaction) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+// PlayerSessionHistoryItem is a PlayerSession without device identifiers,
+// with the session's activity totals.
+type PlayerSessionHistoryItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	State         SessionState           `protobuf:"varint,2,opt,name=state,proto3,enum=rgs.v1.SessionState" json:"state,omitempty"`
+	StartedAt     string                 `protobuf:"bytes,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndedAt       string                 `protobuf:"bytes,4,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	EndReason     string                 `protobuf:"bytes,5,opt,name=end_reason,json=endReason,proto3" json:"end_reason,omitempty"`
+	Totals        *SessionActivityTotals `protobuf:"bytes,6,opt,name=totals,proto3" json:"totals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerSessionHistoryItem) Reset() {
+	*x = PlayerSessionHistoryItem{}
+	mi := &file_rgs_v1_player_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerSessionHistoryItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerSessionHistoryItem) ProtoMessage() {}
+
+func (x *PlayerSessionHistoryItem) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_player_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerSessionHistoryItem.ProtoReflect.Descriptor instead.
+func (*PlayerSessionHistoryItem) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_player_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PlayerSessionHistoryItem) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *PlayerSessionHistoryItem) GetState() SessionState {
+	if x != nil {
+		return x.State
+	}
+	return SessionState_SESSION_STATE_UNSPECIFIED
+}
+
+func (x *PlayerSessionHistoryItem) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *PlayerSessionHistoryItem) GetEndedAt() string {
+	if x != nil {
+		return x.EndedAt
+	}
+	return ""
+}
+
+func (x *PlayerSessionHistoryItem) GetEndReason() string {
+	if x != nil {
+		return x.EndReason
+	}
+	return ""
+}
+
+func (x *PlayerSessionHistoryItem) GetTotals() *SessionActivityTotals {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+type GetMyBalanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyBalanceRequest) Reset() {
+	*x = GetMyBalanceRequest{}
+	mi := &file_rgs_v1_player_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyBalanceRequest) ProtoMessage() {}
+
+func (x *GetMyBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_player_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetMyBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_player_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetMyBalanceRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type GetMyBalanceResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Meta             *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	AvailableBalance *Money                 `protobuf:"bytes,2,opt,name=available_balance,json=availableBalance,proto3" json:"available_balance,omitempty"`
+	PendingBalance   *Money                 `protobuf:"bytes,3,opt,name=pending_balance,json=pendingBalance,proto3" json:"pending_balance,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetMyBalanceResponse) Reset() {
+	*x = GetMyBalanceResponse{}
+	mi := &file_rgs_v1_player_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyBalanceResponse) ProtoMessage() {}
+
+func (x *GetMyBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_player_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyBalanceResponse.ProtoReflect.Descriptor instead.
+func (*GetMyBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_player_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetMyBalanceResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GetMyBalanceResponse) GetAvailableBalance() *Money {
+	if x != nil {
+		return x.AvailableBalance
+	}
+	return nil
+}
+
+func (x *GetMyBalanceResponse) GetPendingBalance() *Money {
+	if x != nil {
+		return x.PendingBalance
+	}
+	return nil
+}
+
+type ListMyTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyTransactionsRequest) Reset() {
+	*x = ListMyTransactionsRequest{}
+	mi := &file_rgs_v1_player_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyTransactionsRequest) ProtoMessage() {}
+
+func (x *ListMyTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_player_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_player_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListMyTransactionsRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListMyTransactionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMyTransactionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListMyTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Transactions  []*PlayerTransaction   `protobuf:"bytes,2,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyTransactionsResponse) Reset() {
+	*x = ListMyTransactionsResponse{}
+	mi := &file_rgs_v1_player_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyTransactionsResponse) ProtoMessage() {}
+
+func (x *ListMyTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_player_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListMyTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_player_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListMyTransactionsResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListMyTransactionsResponse) GetTransactions() []*PlayerTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *ListMyTransactionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetMyLimitsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Meta  *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// Optional; when set, stake limits configured for the game apply.
+	GameId        string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyLimitsRequest) Reset() {
+	*x = GetMyLimitsRequest{}
+	mi := &file_rgs_v1_player_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyLimitsRequest) ProtoMessage() {}
+
+func (x *GetMyLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_player_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyLimitsRequest.ProtoReflect.Descriptor instead.
+func (*GetMyLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_player_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetMyLimitsRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GetMyLimitsRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type GetMyLimitsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Meta  *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// Unset when no limit is configured.
+	MinStake             *Money `protobuf:"bytes,2,opt,name=min_stake,json=minStake,proto3" json:"min_stake,omitempty"`
+	MaxStake             *Money `protobuf:"bytes,3,opt,name=max_stake,json=maxStake,proto3" json:"max_stake,omitempty"`
+	TransfersLocked      bool   `protobuf:"varint,4,opt,name=transfers_locked,json=transfersLocked,proto3" json:"transfers_locked,omitempty"`
+	TransfersLockedUntil string `protobuf:"bytes,5,opt,name=transfers_locked_until,json=transfersLockedUntil,proto3" json:"transfers_locked_until,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetMyLimitsResponse) Reset() {
+	*x = GetMyLimitsResponse{}
+	mi := &file_rgs_v1_player_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyLimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyLimitsResponse) ProtoMessage() {}
+
+func (x *GetMyLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_player_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyLimitsResponse.ProtoReflect.Descriptor instead.
+func (*GetMyLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_player_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetMyLimitsResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GetMyLimitsResponse) GetMinStake() *Money {
+	if x != nil {
+		return x.MinStake
+	}
+	return nil
+}
+
+func (x *GetMyLimitsResponse) GetMaxStake() *Money {
+	if x != nil {
+		return x.MaxStake
+	}
+	return nil
+}
+
+func (x *GetMyLimitsResponse) GetTransfersLocked() bool {
+	if x != nil {
+		return x.TransfersLocked
+	}
+	return false
+}
+
+func (x *GetMyLimitsResponse) GetTransfersLockedUntil() string {
+	if x != nil {
+		return x.TransfersLockedUntil
+	}
+	return ""
+}
+
+type ListMySessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMySessionsRequest) Reset() {
+	*x = ListMySessionsRequest{}
+	mi := &file_rgs_v1_player_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMySessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMySessionsRequest) ProtoMessage() {}
+
+func (x *ListMySessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_player_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMySessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListMySessionsRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_player_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListMySessionsRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListMySessionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMySessionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListMySessionsResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Meta          *ResponseMeta               `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Sessions      []*PlayerSessionHistoryItem `protobuf:"bytes,2,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	NextPageToken string                      `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMySessionsResponse) Reset() {
+	*x = ListMySessionsResponse{}
+	mi := &file_rgs_v1_player_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMySessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMySessionsResponse) ProtoMessage() {}
+
+func (x *ListMySessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_player_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMySessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListMySessionsResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_player_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListMySessionsResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListMySessionsResponse) GetSessions() []*PlayerSessionHistoryItem {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+func (x *ListMySessionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// SelfExclusion is one registered exclusion period.
+type SelfExclusion struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ExclusionId string                 `protobuf:"bytes,1,opt,name=exclusion_id,json=exclusionId,proto3" json:"exclusion_id,omitempty"`
+	PlayerId    string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	StartsAt    string                 `protobuf:"bytes,3,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	// Empty for a permanent exclusion.
+	EndsAt           string    `protobuf:"bytes,4,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	Reason           string    `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	RegisteredBy     string    `protobuf:"bytes,6,opt,name=registered_by,json=registeredBy,proto3" json:"registered_by,omitempty"`
+	RegisteredByType ActorType `protobuf:"varint,7,opt,name=registered_by_type,json=registeredByType,proto3,enum=rgs.v1.ActorType" json:"registered_by_type,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SelfExclusion) Reset() {
+	*x = SelfExclusion{}
+	mi := &file_rgs_v1_player_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelfExclusion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelfExclusion) ProtoMessage() {}
+
+func (x *SelfExclusion) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_player_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelfExclusion.ProtoReflect.Descriptor instead.
+func (*SelfExclusion) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_player_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SelfExclusion) GetExclusionId() string {
+	if x != nil {
+		return x.ExclusionId
+	}
+	return ""
+}
+
+func (x *SelfExclusion) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *SelfExclusion) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *SelfExclusion) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
+func (x *SelfExclusion) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SelfExclusion) GetRegisteredBy() string {
+	if x != nil {
+		return x.RegisteredBy
+	}
+	return ""
+}
+
+func (x *SelfExclusion) GetRegisteredByType() ActorType {
+	if x != nil {
+		return x.RegisteredByType
+	}
+	return ActorType_ACTOR_TYPE_UNSPECIFIED
+}
+
+type RegisterSelfExclusionRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Meta     *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	PlayerId string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// Length of the exclusion from now; ignored when permanent is set.
+	DurationDays int32 `protobuf:"varint,3,opt,name=duration_days,json=durationDays,proto3" json:"duration_days,omitempty"`
+	Permanent    bool  `protobuf:"varint,4,opt,name=permanent,proto3" json:"permanent,omitempty"`
+	// Required when an operator registers on the player's behalf.
+	Reason        string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterSelfExclusionRequest) Reset() {
+	*x = RegisterSelfExclusionRequest{}
+	mi := &file_rgs_v1_player_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterSelfExclusionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterSelfExclusionRequest) ProtoMessage() {}
+
+func (x *RegisterSelfExclusionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_player_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterSelfExclusionRequest.ProtoReflect.Descriptor instead.
+func (*RegisterSelfExclusionRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_player_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RegisterSelfExclusionRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *RegisterSelfExclusionRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *RegisterSelfExclusionRequest) GetDurationDays() int32 {
+	if x != nil {
+		return x.DurationDays
+	}
+	return 0
+}
+
+func (x *RegisterSelfExclusionRequest) GetPermanent() bool {
+	if x != nil {
+		return x.Permanent
+	}
+	return false
+}
+
+func (x *RegisterSelfExclusionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RegisterSelfExclusionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Exclusion     *SelfExclusion         `protobuf:"bytes,2,opt,name=exclusion,proto3" json:"exclusion,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterSelfExclusionResponse) Reset() {
+	*x = RegisterSelfExclusionResponse{}
+	mi := &file_rgs_v1_player_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterSelfExclusionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterSelfExclusionResponse) ProtoMessage() {}
+
+func (x *RegisterSelfExclusionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_player_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterSelfExclusionResponse.ProtoReflect.Descriptor instead.
+func (*RegisterSelfExclusionResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_player_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RegisterSelfExclusionResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *RegisterSelfExclusionResponse) GetExclusion() *SelfExclusion {
+	if x != nil {
+		return x.Exclusion
+	}
+	return nil
+}
+
+type GetSelfExclusionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSelfExclusionRequest) Reset() {
+	*x = GetSelfExclusionRequest{}
+	mi := &file_rgs_v1_player_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSelfExclusionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSelfExclusionRequest) ProtoMessage() {}
+
+func (x *GetSelfExclusionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_player_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSelfExclusionRequest.ProtoReflect.Descriptor instead.
+func (*GetSelfExclusionRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_player_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetSelfExclusionRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GetSelfExclusionRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type GetSelfExclusionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Meta  *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// The exclusion in effect, unset when the player is not excluded.
+	Exclusion     *SelfExclusion `protobuf:"bytes,2,opt,name=exclusion,proto3" json:"exclusion,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSelfExclusionResponse) Reset() {
+	*x = GetSelfExclusionResponse{}
+	mi := &file_rgs_v1_player_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSelfExclusionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSelfExclusionResponse) ProtoMessage() {}
+
+func (x *GetSelfExclusionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_player_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSelfExclusionResponse.ProtoReflect.Descriptor instead.
+func (*GetSelfExclusionResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_player_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetSelfExclusionResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GetSelfExclusionResponse) GetExclusion() *SelfExclusion {
+	if x != nil {
+		return x.Exclusion
+	}
+	return nil
+}
+
+type PlayerLimit struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId  string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	LimitType PlayerLimitType        `protobuf:"varint,2,opt,name=limit_type,json=limitType,proto3,enum=rgs.v1.PlayerLimitType" json:"limit_type,omitempty"`
+	Period    PlayerLimitPeriod      `protobuf:"varint,3,opt,name=period,proto3,enum=rgs.v1.PlayerLimitPeriod" json:"period,omitempty"`
+	// The limit in force; unset when none applies.
+	Amount *Money `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// A raise waiting out the cooling-off period, applied at
+	// pending_effective_at. pending_removal marks a pending removal instead.
+	PendingAmount      *Money `protobuf:"bytes,5,opt,name=pending_amount,json=pendingAmount,proto3" json:"pending_amount,omitempty"`
+	PendingRemoval     bool   `protobuf:"varint,6,opt,name=pending_removal,json=pendingRemoval,proto3" json:"pending_removal,omitempty"`
+	PendingEffectiveAt string `protobuf:"bytes,7,opt,name=pending_effective_at,json=pendingEffectiveAt,proto3" json:"pending_effective_at,omitempty"`
+	UpdatedAt          string `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedBy          string `protobuf:"bytes,9,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *PlayerLimit) Reset() {
+	*x = PlayerLimit{}
+	mi := &file_rgs_v1_player_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerLimit) ProtoMessage() {}
+
+func (x *PlayerLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_player_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerLimit.ProtoReflect.Descriptor instead.
+func (*PlayerLimit) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_player_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PlayerLimit) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *PlayerLimit) GetLimitType() PlayerLimitType {
+	if x != nil {
+		return x.LimitType
+	}
+	return PlayerLimitType_PLAYER_LIMIT_TYPE_UNSPECIFIED
+}
+
+func (x *PlayerLimit) GetPeriod() PlayerLimitPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return PlayerLimitPeriod_PLAYER_LIMIT_PERIOD_UNSPECIFIED
+}
+
+func (x *PlayerLimit) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *PlayerLimit) GetPendingAmount() *Money {
+	if x != nil {
+		return x.PendingAmount
+	}
+	return nil
+}
+
+func (x *PlayerLimit) GetPendingRemoval() bool {
+	if x != nil {
+		return x.PendingRemoval
+	}
+	return false
+}
+
+func (x *PlayerLimit) GetPendingEffectiveAt() string {
+	if x != nil {
+		return x.PendingEffectiveAt
+	}
+	return ""
+}
+
+func (x *PlayerLimit) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *PlayerLimit) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+type SetPlayerLimitRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Meta      *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	PlayerId  string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	LimitType PlayerLimitType        `protobuf:"varint,3,opt,name=limit_type,json=limitType,proto3,enum=rgs.v1.PlayerLimitType" json:"limit_type,omitempty"`
+	Period    PlayerLimitPeriod      `protobuf:"varint,4,opt,name=period,proto3,enum=rgs.v1.PlayerLimitPeriod" json:"period,omitempty"`
+	// Required unless remove is set.
+	Amount *Money `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Remove bool   `protobuf:"varint,6,opt,name=remove,proto3" json:"remove,omitempty"`
+	// Required when an operator sets a limit on the player's behalf.
+	Reason        string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPlayerLimitRequest) Reset() {
+	*x = SetPlayerLimitRequest{}
+	mi := &file_rgs_v1_player_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPlayerLimitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPlayerLimitRequest) ProtoMessage() {}
+
+func (x *SetPlayerLimitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_player_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPlayerLimitRequest.ProtoReflect.Descriptor instead.
+func (*SetPlayerLimitRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_player_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SetPlayerLimitRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *SetPlayerLimitRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *SetPlayerLimitRequest) GetLimitType() PlayerLimitType {
+	if x != nil {
+		return x.LimitType
+	}
+	return PlayerLimitType_PLAYER_LIMIT_TYPE_UNSPECIFIED
+}
+
+func (x *SetPlayerLimitRequest) GetPeriod() PlayerLimitPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return PlayerLimitPeriod_PLAYER_LIMIT_PERIOD_UNSPECIFIED
+}
+
+func (x *SetPlayerLimitRequest) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *SetPlayerLimitRequest) GetRemove() bool {
+	if x != nil {
+		return x.Remove
+	}
+	return false
+}
+
+func (x *SetPlayerLimitRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SetPlayerLimitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Limit         *PlayerLimit           `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPlayerLimitResponse) Reset() {
+	*x = SetPlayerLimitResponse{}
+	mi := &file_rgs_v1_player_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPlayerLimitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPlayerLimitResponse) ProtoMessage() {}
+
+func (x *SetPlayerLimitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_player_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPlayerLimitResponse.ProtoReflect.Descriptor instead.
+func (*SetPlayerLimitResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_player_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SetPlayerLimitResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *SetPlayerLimitResponse) GetLimit() *PlayerLimit {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+type ListPlayerLimitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlayerLimitsRequest) Reset() {
+	*x = ListPlayerLimitsRequest{}
+	mi := &file_rgs_v1_player_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlayerLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlayerLimitsRequest) ProtoMessage() {}
+
+func (x *ListPlayerLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_player_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlayerLimitsRequest.ProtoReflect.Descriptor instead.
+func (*ListPlayerLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_player_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListPlayerLimitsRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListPlayerLimitsRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type ListPlayerLimitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Limits        []*PlayerLimit         `protobuf:"bytes,2,rep,name=limits,proto3" json:"limits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlayerLimitsResponse) Reset() {
+	*x = ListPlayerLimitsResponse{}
+	mi := &file_rgs_v1_player_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlayerLimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlayerLimitsResponse) ProtoMessage() {}
+
+func (x *ListPlayerLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_player_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlayerLimitsResponse.ProtoReflect.Descriptor instead.
+func (*ListPlayerLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_player_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListPlayerLimitsResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListPlayerLimitsResponse) GetLimits() []*PlayerLimit {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+var File_rgs_v1_player_proto protoreflect.FileDescriptor
+
+const file_rgs_v1_player_proto_rawDesc = "" +
+	"\n" +
+	"\x13rgs/v1/player.proto\x12\x06rgs.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x13rgs/v1/common.proto\x1a\x13rgs/v1/ledger.proto\x1a\x15rgs/v1/sessions.proto\"\xcc\x01\n" +
+	"\x11PlayerTransaction\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12H\n" +
+	"\x10transaction_type\x18\x02 \x01(\x0e2\x1d.rgs.v1.LedgerTransactionTypeR\x0ftransactionType\x12%\n" +
+	"\x06amount\x18\x03 \x01(\v2\r.rgs.v1.MoneyR\x06amount\x12\x1f\n" +
+	"\voccurred_at\x18\x04 \x01(\tR\n" +
+	"occurredAt\"\xf5\x01\n" +
+	"\x18PlayerSessionHistoryItem\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12*\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x14.rgs.v1.SessionStateR\x05state\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x03 \x01(\tR\tstartedAt\x12\x19\n" +
+	"\bended_at\x18\x04 \x01(\tR\aendedAt\x12\x1d\n" +
+	"\n" +
+	"end_reason\x18\x05 \x01(\tR\tendReason\x125\n" +
+	"\x06totals\x18\x06 \x01(\v2\x1d.rgs.v1.SessionActivityTotalsR\x06totals\">\n" +
+	"\x13GetMyBalanceRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\"\xb4\x01\n" +
+	"\x14GetMyBalanceResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12:\n" +
+	"\x11available_balance\x18\x02 \x01(\v2\r.rgs.v1.MoneyR\x10availableBalance\x126\n" +
+	"\x0fpending_balance\x18\x03 \x01(\v2\r.rgs.v1.MoneyR\x0ependingBalance\"\x80\x01\n" +
+	"\x19ListMyTransactionsRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\xad\x01\n" +
+	"\x1aListMyTransactionsResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12=\n" +
+	"\ftransactions\x18\x02 \x03(\v2\x19.rgs.v1.PlayerTransactionR\ftransactions\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"V\n" +
+	"\x12GetMyLimitsRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x17\n" +
+	"\agame_id\x18\x02 \x01(\tR\x06gameId\"\xf8\x01\n" +
+	"\x13GetMyLimitsResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12*\n" +
+	"\tmin_stake\x18\x02 \x01(\v2\r.rgs.v1.MoneyR\bminStake\x12*\n" +
+	"\tmax_stake\x18\x03 \x01(\v2\r.rgs.v1.MoneyR\bmaxStake\x12)\n" +
+	"\x10transfers_locked\x18\x04 \x01(\bR\x0ftransfersLocked\x124\n" +
+	"\x16transfers_locked_until\x18\x05 \x01(\tR\x14transfersLockedUntil\"|\n" +
+	"\x15ListMySessionsRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\xa8\x01\n" +
+	"\x16ListMySessionsResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12<\n" +
+	"\bsessions\x18\x02 \x03(\v2 .rgs.v1.PlayerSessionHistoryItemR\bsessions\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\x83\x02\n" +
+	"\rSelfExclusion\x12!\n" +
+	"\fexclusion_id\x18\x01 \x01(\tR\vexclusionId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\x12\x1b\n" +
+	"\tstarts_at\x18\x03 \x01(\tR\bstartsAt\x12\x17\n" +
+	"\aends_at\x18\x04 \x01(\tR\x06endsAt\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12#\n" +
+	"\rregistered_by\x18\x06 \x01(\tR\fregisteredBy\x12?\n" +
+	"\x12registered_by_type\x18\a \x01(\x0e2\x11.rgs.v1.ActorTypeR\x10registeredByType\"\xbf\x01\n" +
+	"\x1cRegisterSelfExclusionRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\x12#\n" +
+	"\rduration_days\x18\x03 \x01(\x05R\fdurationDays\x12\x1c\n" +
+	"\tpermanent\x18\x04 \x01(\bR\tpermanent\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"~\n" +
+	"\x1dRegisterSelfExclusionResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x123\n" +
+	"\texclusion\x18\x02 \x01(\v2\x15.rgs.v1.SelfExclusionR\texclusion\"_\n" +
+	"\x17GetSelfExclusionRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\"y\n" +
+	"\x18GetSelfExclusionResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x123\n" +
+	"\texclusion\x18\x02 \x01(\v2\x15.rgs.v1.SelfExclusionR\texclusion\"\x8b\x03\n" +
+	"\vPlayerLimit\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x126\n" +
+	"\n" +
+	"limit_type\x18\x02 \x01(\x0e2\x17.rgs.v1.PlayerLimitTypeR\tlimitType\x121\n" +
+	"\x06period\x18\x03 \x01(\x0e2\x19.rgs.v1.PlayerLimitPeriodR\x06period\x12%\n" +
+	"\x06amount\x18\x04 \x01(\v2\r.rgs.v1.MoneyR\x06amount\x124\n" +
+	"\x0epending_amount\x18\x05 \x01(\v2\r.rgs.v1.MoneyR\rpendingAmount\x12'\n" +
+	"\x0fpending_removal\x18\x06 \x01(\bR\x0ependingRemoval\x120\n" +
+	"\x14pending_effective_at\x18\a \x01(\tR\x12pendingEffectiveAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\t \x01(\tR\tupdatedBy\"\x9f\x02\n" +
+	"\x15SetPlayerLimitRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\x126\n" +
+	"\n" +
+	"limit_type\x18\x03 \x01(\x0e2\x17.rgs.v1.PlayerLimitTypeR\tlimitType\x121\n" +
+	"\x06period\x18\x04 \x01(\x0e2\x19.rgs.v1.PlayerLimitPeriodR\x06period\x12%\n" +
+	"\x06amount\x18\x05 \x01(\v2\r.rgs.v1.MoneyR\x06amount\x12\x16\n" +
+	"\x06remove\x18\x06 \x01(\bR\x06remove\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\"m\n" +
+	"\x16SetPlayerLimitResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12)\n" +
+	"\x05limit\x18\x02 \x01(\v2\x13.rgs.v1.PlayerLimitR\x05limit\"_\n" +
+	"\x17ListPlayerLimitsRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\"q\n" +
+	"\x18ListPlayerLimitsResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12+\n" +
+	"\x06limits\x18\x02 \x03(\v2\x13.rgs.v1.PlayerLimitR\x06limits*\x8c\x01\n" +
+	"\x0fPlayerLimitType\x12!\n" +
+	"\x1dPLAYER_LIMIT_TYPE_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19PLAYER_LIMIT_TYPE_DEPOSIT\x10\x01\x12\x1a\n" +
+	"\x16PLAYER_LIMIT_TYPE_LOSS\x10\x02\x12\x1b\n" +
+	"\x17PLAYER_LIMIT_TYPE_WAGER\x10\x03*\x98\x01\n" +
+	"\x11PlayerLimitPeriod\x12#\n" +
+	"\x1fPLAYER_LIMIT_PERIOD_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19PLAYER_LIMIT_PERIOD_DAILY\x10\x01\x12\x1e\n" +
+	"\x1aPLAYER_LIMIT_PERIOD_WEEKLY\x10\x02\x12\x1f\n" +
+	"\x1bPLAYER_LIMIT_PERIOD_MONTHLY\x10\x032\xb9\x03\n" +
+	"\x11PlayerSelfService\x12a\n" +
+	"\fGetMyBalance\x12\x1b.rgs.v1.GetMyBalanceRequest\x1a\x1c.rgs.v1.GetMyBalanceResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/me/balance\x12x\n" +
+	"\x12ListMyTransactions\x12!.rgs.v1.ListMyTransactionsRequest\x1a\".rgs.v1.ListMyTransactionsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/me/transactions\x12]\n" +
+	"\vGetMyLimits\x12\x1a.rgs.v1.GetMyLimitsRequest\x1a\x1b.rgs.v1.GetMyLimitsResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/me/limits\x12h\n" +
+	"\x0eListMySessions\x12\x1d.rgs.v1.ListMySessionsRequest\x1a\x1e.rgs.v1.ListMySessionsResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/me/sessions2\xb9\x02\n" +
+	"\x14SelfExclusionService\x12\x98\x01\n" +
+	"\x15RegisterSelfExclusion\x12$.rgs.v1.RegisterSelfExclusionRequest\x1a%.rgs.v1.RegisterSelfExclusionResponse\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/v1/players/{player_id}/self-exclusions\x12\x85\x01\n" +
+	"\x10GetSelfExclusion\x12\x1f.rgs.v1.GetSelfExclusionRequest\x1a .rgs.v1.GetSelfExclusionResponse\".\x82\xd3\xe4\x93\x02(\x12&/v1/players/{player_id}/self-exclusion2\x90\x02\n" +
+	"\x13PlayerLimitsService\x12z\n" +
+	"\x0eSetPlayerLimit\x12\x1d.rgs.v1.SetPlayerLimitRequest\x1a\x1e.rgs.v1.SetPlayerLimitResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/players/{player_id}/limits\x12}\n" +
+	"\x10ListPlayerLimits\x12\x1f.rgs.v1.ListPlayerLimitsRequest\x1a .rgs.v1.ListPlayerLimitsResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/players/{player_id}/limitsB\x8d\x01\n" +
+	"\n" +
+	"com.rgs.v1B\vPlayerProtoP\x01Z9github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1;rgsv1\xa2\x02\x03RXX\xaa\x02\x06Rgs.V1\xca\x02\x06Rgs\\V1\xe2\x02\x12Rgs\\V1\\GPBMetadata\xea\x02\aRgs::V1b\x06proto3"
+
+var (
+	file_rgs_v1_player_proto_rawDescOnce sync.Once
+	file_rgs_v1_player_proto_rawDescData []byte
+)
+
+func file_rgs_v1_player_proto_rawDescGZIP() []byte {
+	file_rgs_v1_player_proto_rawDescOnce.Do(func() {
+		file_rgs_v1_player_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rgs_v1_player_proto_rawDesc), len(file_rgs_v1_player_proto_rawDesc)))
+	})
+	return file_rgs_v1_player_proto_rawDescData
+}
+
+var file_rgs_v1_player_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_rgs_v1_player_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_rgs_v1_player_proto_goTypes = []any{
+	(PlayerLimitType)(0),                  // 0: rgs.v1.PlayerLimitType
+	(PlayerLimitPeriod)(0),                // 1: rgs.v1.PlayerLimitPeriod
+	(*PlayerTransaction)(nil),             // 2: rgs.v1.PlayerTransaction
+	(*PlayerSessionHistoryItem)(nil),      // 3: rgs.v1.PlayerSessionHistoryItem
+	(*GetMyBalanceRequest)(nil),           // 4: rgs.v1.GetMyBalanceRequest
+	(*GetMyBalanceResponse)(nil),          // 5: rgs.v1.GetMyBalanceResponse
+	(*ListMyTransactionsRequest)(nil),     // 6: rgs.v1.ListMyTransactionsRequest
+	(*ListMyTransactionsResponse)(nil),    // 7: rgs.v1.ListMyTransactionsResponse
+	(*GetMyLimitsRequest)(nil),            // 8: rgs.v1.GetMyLimitsRequest
+	(*GetMyLimitsResponse)(nil),           // 9: rgs.v1.GetMyLimitsResponse
+	(*ListMySessionsRequest)(nil),         // 10: rgs.v1.ListMySessionsRequest
+	(*ListMySessionsResponse)(nil),        // 11: rgs.v1.ListMySessionsResponse
+	(*SelfExclusion)(nil),                 // 12: rgs.v1.SelfExclusion
+	(*RegisterSelfExclusionRequest)(nil),  // 13: rgs.v1.RegisterSelfExclusionRequest
+	(*RegisterSelfExclusionResponse)(nil), // 14: rgs.v1.RegisterSelfExclusionResponse
+	(*GetSelfExclusionRequest)(nil),       // 15: rgs.v1.GetSelfExclusionRequest
+	(*GetSelfExclusionResponse)(nil),      // 16: rgs.v1.GetSelfExclusionResponse
+	(*PlayerLimit)(nil),                   // 17: rgs.v1.PlayerLimit
+	(*SetPlayerLimitRequest)(nil),         // 18: rgs.v1.SetPlayerLimitRequest
+	(*SetPlayerLimitResponse)(nil),        // 19: rgs.v1.SetPlayerLimitResponse
+	(*ListPlayerLimitsRequest)(nil),       // 20: rgs.v1.ListPlayerLimitsRequest
+	(*ListPlayerLimitsResponse)(nil),      // 21: rgs.v1.ListPlayerLimitsResponse
+	(LedgerTransactionType)(0),            // 22: rgs.v1.LedgerTransactionType
+	(*Money)(nil),                         // 23: rgs.v1.Money
+	(SessionState)(0),                     // 24: rgs.v1.SessionState
+	(*SessionActivityTotals)(nil),         // 25: rgs.v1.SessionActivityTotals
+	(*RequestMeta)(nil),                   // 26: rgs.v1.RequestMeta
+	(*ResponseMeta)(nil),                  // 27: rgs.v1.ResponseMeta
+	(ActorType)(0),                        // 28: rgs.v1.ActorType
+}
+var file_rgs_v1_player_proto_depIdxs = []int32{
+	22, // 0: rgs.v1.PlayerTransaction.transaction_type:type_name -> rgs.v1.LedgerTransactionType
+	23, // 1: rgs.v1.PlayerTransaction.amount:type_name -> rgs.v1.Money
+	24, // 2: rgs.v1.PlayerSessionHistoryItem.state:type_name -> rgs.v1.SessionState
+	25, // 3: rgs.v1.PlayerSessionHistoryItem.totals:type_name -> rgs.v1.SessionActivityTotals
+	26, // 4: rgs.v1.GetMyBalanceRequest.meta:type_name -> rgs.v1.RequestMeta
+	27, // 5: rgs.v1.GetMyBalanceResponse.meta:type_name -> rgs.v1.ResponseMeta
+	23, // 6: rgs.v1.GetMyBalanceResponse.available_balance:type_name -> rgs.v1.Money
+	23, // 7: rgs.v1.GetMyBalanceResponse.pending_balance:type_name -> rgs.v1.Money
+	26, // 8: rgs.v1.ListMyTransactionsRequest.meta:type_name -> rgs.v1.RequestMeta
+	27, // 9: rgs.v1.ListMyTransactionsResponse.meta:type_name -> rgs.v1.ResponseMeta
+	2,  // 10: rgs.v1.ListMyTransactionsResponse.transactions:type_name -> rgs.v1.PlayerTransaction
+	26, // 11: rgs.v1.GetMyLimitsRequest.meta:type_name -> rgs.v1.RequestMeta
+	27, // 12: rgs.v1.GetMyLimitsResponse.meta:type_name -> rgs.v1.ResponseMeta
+	23, // 13: rgs.v1.GetMyLimitsResponse.min_stake:type_name -> rgs.v1.Money
+	23, // 14: rgs.v1.GetMyLimitsResponse.max_stake:type_name -> rgs.v1.Money
+	26, // 15: rgs.v1.ListMySessionsRequest.meta:type_name -> rgs.v1.RequestMeta
+	27, // 16: rgs.v1.ListMySessionsResponse.meta:type_name -> rgs.v1.ResponseMeta
+	3,  // 17: rgs.v1.ListMySessionsResponse.sessions:type_name -> rgs.v1.PlayerSessionHistoryItem
+	28, // 18: rgs.v1.SelfExclusion.registered_by_type:type_name -> rgs.v1.ActorType
+	26, // 19: rgs.v1.RegisterSelfExclusionRequest.meta:type_name -> rgs.v1.RequestMeta
+	27, // 20: rgs.v1.RegisterSelfExclusionResponse.meta:type_name -> rgs.v1.ResponseMeta
+	12, // 21: rgs.v1.RegisterSelfExclusionResponse.exclusion:type_name -> rgs.v1.SelfExclusion
+	26, // 22: rgs.v1.GetSelfExclusionRequest.meta:type_name -> rgs.v1.RequestMeta
+	27, // 23: rgs.v1.GetSelfExclusionResponse.meta:type_name -> rgs.v1.ResponseMeta
+	12, // 24: rgs.v1.GetSelfExclusionResponse.exclusion:type_name -> rgs.v1.SelfExclusion
+	0,  // 25: rgs.v1.PlayerLimit.limit_type:type_name -> rgs.v1.PlayerLimitType
+	1,  // 26: rgs.v1.PlayerLimit.period:type_name -> rgs.v1.PlayerLimitPeriod
+	23, // 27: rgs.v1.PlayerLimit.amount:type_name -> rgs.v1.Money
+	23, // 28: rgs.v1.PlayerLimit.pending_amount:type_name -> rgs.v1.Money
+	26, // 29: rgs.v1.SetPlayerLimitRequest.meta:type_name -> rgs.v1.RequestMeta
+	0,  // 30: rgs.v1.SetPlayerLimitRequest.limit_type:type_name -> rgs.v1.PlayerLimitType
+	1,  // 31: rgs.v1.SetPlayerLimitRequest.period:type_name -> rgs.v1.PlayerLimitPeriod
+	23, // 32: rgs.v1.SetPlayerLimitRequest.amount:type_name -> rgs.v1.Money
+	27, // 33: rgs.v1.SetPlayerLimitResponse.meta:type_name -> rgs.v1.ResponseMeta
+	17, // 34: rgs.v1.SetPlayerLimitResponse.limit:type_name -> rgs.v1.PlayerLimit
+	26, // 35: rgs.v1.ListPlayerLimitsRequest.meta:type_name -> rgs.v1.RequestMeta
+	27, // 36: rgs.v1.ListPlayerLimitsResponse.meta:type_name -> rgs.v1.ResponseMeta
+	17, // 37: rgs.v1.ListPlayerLimitsResponse.limits:type_name -> rgs.v1.PlayerLimit
+	4,  // 38: rgs.v1.PlayerSelfService.GetMyBalance:input_type -> rgs.v1.GetMyBalanceRequest
+	6,  // 39: rgs.v1.PlayerSelfService.ListMyTransactions:input_type -> rgs.v1.ListMyTransactionsRequest
+	8,  // 40: rgs.v1.PlayerSelfService.GetMyLimits:input_type -> rgs.v1.GetMyLimitsRequest
+	10, // 41: rgs.v1.PlayerSelfService.ListMySessions:input_type -> rgs.v1.ListMySessionsRequest
+	13, // 42: rgs.v1.SelfExclusionService.RegisterSelfExclusion:input_type -> rgs.v1.RegisterSelfExclusionRequest
+	15, // 43: rgs.v1.SelfExclusionService.GetSelfExclusion:input_type -> rgs.v1.GetSelfExclusionRequest
+	18, // 44: rgs.v1.PlayerLimitsService.SetPlayerLimit:input_type -> rgs.v1.SetPlayerLimitRequest
+	20, // 45: rgs.v1.PlayerLimitsService.ListPlayerLimits:input_type -> rgs.v1.ListPlayerLimitsRequest
+	5,  // 46: rgs.v1.PlayerSelfService.GetMyBalance:output_type -> rgs.v1.GetMyBalanceResponse
+	7,  // 47: rgs.v1.PlayerSelfService.ListMyTransactions:output_type -> rgs.v1.ListMyTransactionsResponse
+	9,  // 48: rgs.v1.PlayerSelfService.GetMyLimits:output_type -> rgs.v1.GetMyLimitsResponse
+	11, // 49: rgs.v1.PlayerSelfService.ListMySessions:output_type -> rgs.v1.ListMySessionsResponse
+	14, // 50: rgs.v1.SelfExclusionService.RegisterSelfExclusion:output_type -> rgs.v1.RegisterSelfExclusionResponse
+	16, // 51: rgs.v1.SelfExclusionService.GetSelfExclusion:output_type -> rgs.v1.GetSelfExclusionResponse
+	19, // 52: rgs.v1.PlayerLimitsService.SetPlayerLimit:output_type -> rgs.v1.SetPlayerLimitResponse
+	21, // 53: rgs.v1.PlayerLimitsService.ListPlayerLimits:output_type -> rgs.v1.ListPlayerLimitsResponse
+	46, // [46:54] is the sub-list for method output_type
+	38, // [38:46] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
+}
+
+func init() { file_rgs_v1_player_proto_init() }
+func file_rgs_v1_player_proto_init() {
+	if File_rgs_v1_player_proto != nil {
+		return
+	}
+	file_rgs_v1_common_proto_init()
+	file_rgs_v1_ledger_proto_init()
+	file_rgs_v1_sessions_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rgs_v1_player_proto_rawDesc), len(file_rgs_v1_player_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   20,
+			NumExtensions: 0,
+			NumServices:   3,
+		},
+		GoTypes:           file_rgs_v1_player_proto_goTypes,
+		DependencyIndexes: file_rgs_v1_player_proto_depIdxs,
+		EnumInfos:         file_rgs_v1_player_proto_enumTypes,
+		MessageInfos:      file_rgs_v1_player_proto_msgTypes,
+	}.Build()
+	File_rgs_v1_player_proto = out.File
+	file_rgs_v1_player_proto_goTypes = nil
+	file_rgs_v1_player_proto_depIdxs = nil
+}
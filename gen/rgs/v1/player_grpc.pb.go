@@ -0,0 +1,545 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.1
+// - protoc             (unknown)
+// source: rgs/v1/player.proto
+
+package rgsv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PlayerSelfService_GetMyBalance_FullMethodName       = "/rgs.v1.PlayerSelfService/GetMyBalance"
+	PlayerSelfService_ListMyTransactions_FullMethodName = "/rgs.v1.PlayerSelfService/ListMyTransactions"
+	PlayerSelfService_GetMyLimits_FullMethodName        = "/rgs.v1.PlayerSelfService/GetMyLimits"
+	PlayerSelfService_ListMySessions_FullMethodName     = "/rgs.v1.PlayerSelfService/ListMySessions"
+)
+
+// PlayerSelfServiceClient is the client API for PlayerSelfService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PlayerSelfService is the player-facing surface. Every call is scoped to the
+// authenticated player actor; operator and service actors are denied, and
+// responses carry only the fields a player may see about themselves.
+type PlayerSelfServiceClient interface {
+	GetMyBalance(ctx context.Context, in *GetMyBalanceRequest, opts ...grpc.CallOption) (*GetMyBalanceResponse, error)
+	ListMyTransactions(ctx context.Context, in *ListMyTransactionsRequest, opts ...grpc.CallOption) (*ListMyTransactionsResponse, error)
+	GetMyLimits(ctx context.Context, in *GetMyLimitsRequest, opts ...grpc.CallOption) (*GetMyLimitsResponse, error)
+	ListMySessions(ctx context.Context, in *ListMySessionsRequest, opts ...grpc.CallOption) (*ListMySessionsResponse, error)
+}
+
+type playerSelfServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPlayerSelfServiceClient(cc grpc.ClientConnInterface) PlayerSelfServiceClient {
+	return &playerSelfServiceClient{cc}
+}
+
+func (c *playerSelfServiceClient) GetMyBalance(ctx context.Context, in *GetMyBalanceRequest, opts ...grpc.CallOption) (*GetMyBalanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMyBalanceResponse)
+	err := c.cc.Invoke(ctx, PlayerSelfService_GetMyBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerSelfServiceClient) ListMyTransactions(ctx context.Context, in *ListMyTransactionsRequest, opts ...grpc.CallOption) (*ListMyTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMyTransactionsResponse)
+	err := c.cc.Invoke(ctx, PlayerSelfService_ListMyTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerSelfServiceClient) GetMyLimits(ctx context.Context, in *GetMyLimitsRequest, opts ...grpc.CallOption) (*GetMyLimitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMyLimitsResponse)
+	err := c.cc.Invoke(ctx, PlayerSelfService_GetMyLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerSelfServiceClient) ListMySessions(ctx context.Context, in *ListMySessionsRequest, opts ...grpc.CallOption) (*ListMySessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMySessionsResponse)
+	err := c.cc.Invoke(ctx, PlayerSelfService_ListMySessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PlayerSelfServiceServer is the server API for PlayerSelfService service.
+// All implementations must embed UnimplementedPlayerSelfServiceServer
+// for forward compatibility.
+//
+// PlayerSelfService is the player-facing surface. Every call is scoped to the
+// authenticated player actor; operator and service actors are denied, and
+// responses carry only the fields a player may see about themselves.
+type PlayerSelfServiceServer interface {
+	GetMyBalance(context.Context, *GetMyBalanceRequest) (*GetMyBalanceResponse, error)
+	ListMyTransactions(context.Context, *ListMyTransactionsRequest) (*ListMyTransactionsResponse, error)
+	GetMyLimits(context.Context, *GetMyLimitsRequest) (*GetMyLimitsResponse, error)
+	ListMySessions(context.Context, *ListMySessionsRequest) (*ListMySessionsResponse, error)
+	mustEmbedUnimplementedPlayerSelfServiceServer()
+}
+
+// UnimplementedPlayerSelfServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPlayerSelfServiceServer struct{}
+
+func (UnimplementedPlayerSelfServiceServer) GetMyBalance(context.Context, *GetMyBalanceRequest) (*GetMyBalanceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMyBalance not implemented")
+}
+func (UnimplementedPlayerSelfServiceServer) ListMyTransactions(context.Context, *ListMyTransactionsRequest) (*ListMyTransactionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMyTransactions not implemented")
+}
+func (UnimplementedPlayerSelfServiceServer) GetMyLimits(context.Context, *GetMyLimitsRequest) (*GetMyLimitsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMyLimits not implemented")
+}
+func (UnimplementedPlayerSelfServiceServer) ListMySessions(context.Context, *ListMySessionsRequest) (*ListMySessionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMySessions not implemented")
+}
+func (UnimplementedPlayerSelfServiceServer) mustEmbedUnimplementedPlayerSelfServiceServer() {}
+func (UnimplementedPlayerSelfServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafePlayerSelfServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PlayerSelfServiceServer will
+// result in compilation errors.
+type UnsafePlayerSelfServiceServer interface {
+	mustEmbedUnimplementedPlayerSelfServiceServer()
+}
+
+func RegisterPlayerSelfServiceServer(s grpc.ServiceRegistrar, srv PlayerSelfServiceServer) {
+	// If the following call panics, it indicates UnimplementedPlayerSelfServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PlayerSelfService_ServiceDesc, srv)
+}
+
+func _PlayerSelfService_GetMyBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerSelfServiceServer).GetMyBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerSelfService_GetMyBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerSelfServiceServer).GetMyBalance(ctx, req.(*GetMyBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlayerSelfService_ListMyTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerSelfServiceServer).ListMyTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerSelfService_ListMyTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerSelfServiceServer).ListMyTransactions(ctx, req.(*ListMyTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlayerSelfService_GetMyLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerSelfServiceServer).GetMyLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerSelfService_GetMyLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerSelfServiceServer).GetMyLimits(ctx, req.(*GetMyLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlayerSelfService_ListMySessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMySessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerSelfServiceServer).ListMySessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerSelfService_ListMySessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerSelfServiceServer).ListMySessions(ctx, req.(*ListMySessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PlayerSelfService_ServiceDesc is the grpc.ServiceDesc for PlayerSelfService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PlayerSelfService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rgs.v1.PlayerSelfService",
+	HandlerType: (*PlayerSelfServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMyBalance",
+			Handler:    _PlayerSelfService_GetMyBalance_Handler,
+		},
+		{
+			MethodName: "ListMyTransactions",
+			Handler:    _PlayerSelfService_ListMyTransactions_Handler,
+		},
+		{
+			MethodName: "GetMyLimits",
+			Handler:    _PlayerSelfService_GetMyLimits_Handler,
+		},
+		{
+			MethodName: "ListMySessions",
+			Handler:    _PlayerSelfService_ListMySessions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rgs/v1/player.proto",
+}
+
+const (
+	SelfExclusionService_RegisterSelfExclusion_FullMethodName = "/rgs.v1.SelfExclusionService/RegisterSelfExclusion"
+	SelfExclusionService_GetSelfExclusion_FullMethodName      = "/rgs.v1.SelfExclusionService/GetSelfExclusion"
+)
+
+// SelfExclusionServiceClient is the client API for SelfExclusionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SelfExclusionService registers player self-exclusions. Players exclude
+// themselves, or operators do so on their behalf; while an exclusion is in
+// effect, Login, PlaceWager and deposits to the player's account are denied
+// with "player self-excluded".
+type SelfExclusionServiceClient interface {
+	RegisterSelfExclusion(ctx context.Context, in *RegisterSelfExclusionRequest, opts ...grpc.CallOption) (*RegisterSelfExclusionResponse, error)
+	GetSelfExclusion(ctx context.Context, in *GetSelfExclusionRequest, opts ...grpc.CallOption) (*GetSelfExclusionResponse, error)
+}
+
+type selfExclusionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSelfExclusionServiceClient(cc grpc.ClientConnInterface) SelfExclusionServiceClient {
+	return &selfExclusionServiceClient{cc}
+}
+
+func (c *selfExclusionServiceClient) RegisterSelfExclusion(ctx context.Context, in *RegisterSelfExclusionRequest, opts ...grpc.CallOption) (*RegisterSelfExclusionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterSelfExclusionResponse)
+	err := c.cc.Invoke(ctx, SelfExclusionService_RegisterSelfExclusion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *selfExclusionServiceClient) GetSelfExclusion(ctx context.Context, in *GetSelfExclusionRequest, opts ...grpc.CallOption) (*GetSelfExclusionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSelfExclusionResponse)
+	err := c.cc.Invoke(ctx, SelfExclusionService_GetSelfExclusion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SelfExclusionServiceServer is the server API for SelfExclusionService service.
+// All implementations must embed UnimplementedSelfExclusionServiceServer
+// for forward compatibility.
+//
+// SelfExclusionService registers player self-exclusions. Players exclude
+// themselves, or operators do so on their behalf; while an exclusion is in
+// effect, Login, PlaceWager and deposits to the player's account are denied
+// with "player self-excluded".
+type SelfExclusionServiceServer interface {
+	RegisterSelfExclusion(context.Context, *RegisterSelfExclusionRequest) (*RegisterSelfExclusionResponse, error)
+	GetSelfExclusion(context.Context, *GetSelfExclusionRequest) (*GetSelfExclusionResponse, error)
+	mustEmbedUnimplementedSelfExclusionServiceServer()
+}
+
+// UnimplementedSelfExclusionServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSelfExclusionServiceServer struct{}
+
+func (UnimplementedSelfExclusionServiceServer) RegisterSelfExclusion(context.Context, *RegisterSelfExclusionRequest) (*RegisterSelfExclusionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RegisterSelfExclusion not implemented")
+}
+func (UnimplementedSelfExclusionServiceServer) GetSelfExclusion(context.Context, *GetSelfExclusionRequest) (*GetSelfExclusionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSelfExclusion not implemented")
+}
+func (UnimplementedSelfExclusionServiceServer) mustEmbedUnimplementedSelfExclusionServiceServer() {}
+func (UnimplementedSelfExclusionServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafeSelfExclusionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SelfExclusionServiceServer will
+// result in compilation errors.
+type UnsafeSelfExclusionServiceServer interface {
+	mustEmbedUnimplementedSelfExclusionServiceServer()
+}
+
+func RegisterSelfExclusionServiceServer(s grpc.ServiceRegistrar, srv SelfExclusionServiceServer) {
+	// If the following call panics, it indicates UnimplementedSelfExclusionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SelfExclusionService_ServiceDesc, srv)
+}
+
+func _SelfExclusionService_RegisterSelfExclusion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterSelfExclusionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SelfExclusionServiceServer).RegisterSelfExclusion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SelfExclusionService_RegisterSelfExclusion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SelfExclusionServiceServer).RegisterSelfExclusion(ctx, req.(*RegisterSelfExclusionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SelfExclusionService_GetSelfExclusion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSelfExclusionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SelfExclusionServiceServer).GetSelfExclusion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SelfExclusionService_GetSelfExclusion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SelfExclusionServiceServer).GetSelfExclusion(ctx, req.(*GetSelfExclusionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SelfExclusionService_ServiceDesc is the grpc.ServiceDesc for SelfExclusionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SelfExclusionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rgs.v1.SelfExclusionService",
+	HandlerType: (*SelfExclusionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterSelfExclusion",
+			Handler:    _SelfExclusionService_RegisterSelfExclusion_Handler,
+		},
+		{
+			MethodName: "GetSelfExclusion",
+			Handler:    _SelfExclusionService_GetSelfExclusion_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rgs/v1/player.proto",
+}
+
+const (
+	PlayerLimitsService_SetPlayerLimit_FullMethodName   = "/rgs.v1.PlayerLimitsService/SetPlayerLimit"
+	PlayerLimitsService_ListPlayerLimits_FullMethodName = "/rgs.v1.PlayerLimitsService/ListPlayerLimits"
+)
+
+// PlayerLimitsServiceClient is the client API for PlayerLimitsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PlayerLimitsService manages the deposit, loss and wager limits players
+// set on themselves, or operators set on their behalf. Adding or lowering a
+// limit applies at once; raising or removing one only applies after the
+// cooling-off period. LedgerService.Deposit and WageringService.PlaceWager
+// deny activity that would exceed a limit in force.
+type PlayerLimitsServiceClient interface {
+	SetPlayerLimit(ctx context.Context, in *SetPlayerLimitRequest, opts ...grpc.CallOption) (*SetPlayerLimitResponse, error)
+	ListPlayerLimits(ctx context.Context, in *ListPlayerLimitsRequest, opts ...grpc.CallOption) (*ListPlayerLimitsResponse, error)
+}
+
+type playerLimitsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPlayerLimitsServiceClient(cc grpc.ClientConnInterface) PlayerLimitsServiceClient {
+	return &playerLimitsServiceClient{cc}
+}
+
+func (c *playerLimitsServiceClient) SetPlayerLimit(ctx context.Context, in *SetPlayerLimitRequest, opts ...grpc.CallOption) (*SetPlayerLimitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPlayerLimitResponse)
+	err := c.cc.Invoke(ctx, PlayerLimitsService_SetPlayerLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerLimitsServiceClient) ListPlayerLimits(ctx context.Context, in *ListPlayerLimitsRequest, opts ...grpc.CallOption) (*ListPlayerLimitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPlayerLimitsResponse)
+	err := c.cc.Invoke(ctx, PlayerLimitsService_ListPlayerLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PlayerLimitsServiceServer is the server API for PlayerLimitsService service.
+// All implementations must embed UnimplementedPlayerLimitsServiceServer
+// for forward compatibility.
+//
+// PlayerLimitsService manages the deposit, loss and wager limits players
+// set on themselves, or operators set on their behalf. Adding or lowering a
+// limit applies at once; raising or removing one only applies after the
+// cooling-off period. LedgerService.Deposit and WageringService.PlaceWager
+// deny activity that would exceed a limit in force.
+type PlayerLimitsServiceServer interface {
+	SetPlayerLimit(context.Context, *SetPlayerLimitRequest) (*SetPlayerLimitResponse, error)
+	ListPlayerLimits(context.Context, *ListPlayerLimitsRequest) (*ListPlayerLimitsResponse, error)
+	mustEmbedUnimplementedPlayerLimitsServiceServer()
+}
+
+// UnimplementedPlayerLimitsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPlayerLimitsServiceServer struct{}
+
+func (UnimplementedPlayerLimitsServiceServer) SetPlayerLimit(context.Context, *SetPlayerLimitRequest) (*SetPlayerLimitResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetPlayerLimit not implemented")
+}
+func (UnimplementedPlayerLimitsServiceServer) ListPlayerLimits(context.Context, *ListPlayerLimitsRequest) (*ListPlayerLimitsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPlayerLimits not implemented")
+}
+func (UnimplementedPlayerLimitsServiceServer) mustEmbedUnimplementedPlayerLimitsServiceServer() {}
+func (UnimplementedPlayerLimitsServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafePlayerLimitsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PlayerLimitsServiceServer will
+// result in compilation errors.
+type UnsafePlayerLimitsServiceServer interface {
+	mustEmbedUnimplementedPlayerLimitsServiceServer()
+}
+
+func RegisterPlayerLimitsServiceServer(s grpc.ServiceRegistrar, srv PlayerLimitsServiceServer) {
+	// If the following call panics, it indicates UnimplementedPlayerLimitsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PlayerLimitsService_ServiceDesc, srv)
+}
+
+func _PlayerLimitsService_SetPlayerLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPlayerLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerLimitsServiceServer).SetPlayerLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerLimitsService_SetPlayerLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerLimitsServiceServer).SetPlayerLimit(ctx, req.(*SetPlayerLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlayerLimitsService_ListPlayerLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPlayerLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerLimitsServiceServer).ListPlayerLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerLimitsService_ListPlayerLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerLimitsServiceServer).ListPlayerLimits(ctx, req.(*ListPlayerLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PlayerLimitsService_ServiceDesc is the grpc.ServiceDesc for PlayerLimitsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PlayerLimitsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rgs.v1.PlayerLimitsService",
+	HandlerType: (*PlayerLimitsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetPlayerLimit",
+			Handler:    _PlayerLimitsService_SetPlayerLimit_Handler,
+		},
+		{
+			MethodName: "ListPlayerLimits",
+			Handler:    _PlayerLimitsService_ListPlayerLimits_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rgs/v1/player.proto",
+}
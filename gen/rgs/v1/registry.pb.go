@@ -22,15 +22,21 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Equipment moves through registered, commissioned, active, maintenance,
+// and decommissioned with the transition RPCs. INACTIVE, DISABLED, and
+// RETIRED predate the lifecycle and are kept for existing records.
 type EquipmentStatus int32
 
 const (
-	EquipmentStatus_EQUIPMENT_STATUS_UNSPECIFIED EquipmentStatus = 0
-	EquipmentStatus_EQUIPMENT_STATUS_ACTIVE      EquipmentStatus = 1
-	EquipmentStatus_EQUIPMENT_STATUS_INACTIVE    EquipmentStatus = 2
-	EquipmentStatus_EQUIPMENT_STATUS_MAINTENANCE EquipmentStatus = 3
-	EquipmentStatus_EQUIPMENT_STATUS_DISABLED    EquipmentStatus = 4
-	EquipmentStatus_EQUIPMENT_STATUS_RETIRED     EquipmentStatus = 5
+	EquipmentStatus_EQUIPMENT_STATUS_UNSPECIFIED    EquipmentStatus = 0
+	EquipmentStatus_EQUIPMENT_STATUS_ACTIVE         EquipmentStatus = 1
+	EquipmentStatus_EQUIPMENT_STATUS_INACTIVE       EquipmentStatus = 2
+	EquipmentStatus_EQUIPMENT_STATUS_MAINTENANCE    EquipmentStatus = 3
+	EquipmentStatus_EQUIPMENT_STATUS_DISABLED       EquipmentStatus = 4
+	EquipmentStatus_EQUIPMENT_STATUS_RETIRED        EquipmentStatus = 5
+	EquipmentStatus_EQUIPMENT_STATUS_REGISTERED     EquipmentStatus = 6
+	EquipmentStatus_EQUIPMENT_STATUS_COMMISSIONED   EquipmentStatus = 7
+	EquipmentStatus_EQUIPMENT_STATUS_DECOMMISSIONED EquipmentStatus = 8
 )
 
 // Enum value maps for EquipmentStatus.
@@ -42,14 +48,20 @@ var (
 		3: "EQUIPMENT_STATUS_MAINTENANCE",
 		4: "EQUIPMENT_STATUS_DISABLED",
 		5: "EQUIPMENT_STATUS_RETIRED",
+		6: "EQUIPMENT_STATUS_REGISTERED",
+		7: "EQUIPMENT_STATUS_COMMISSIONED",
+		8: "EQUIPMENT_STATUS_DECOMMISSIONED",
 	}
 	EquipmentStatus_value = map[string]int32{
-		"EQUIPMENT_STATUS_UNSPECIFIED": 0,
-		"EQUIPMENT_STATUS_ACTIVE":      1,
-		"EQUIPMENT_STATUS_INACTIVE":    2,
-		"EQUIPMENT_STATUS_MAINTENANCE": 3,
-		"EQUIPMENT_STATUS_DISABLED":    4,
-		"EQUIPMENT_STATUS_RETIRED":     5,
+		"EQUIPMENT_STATUS_UNSPECIFIED":    0,
+		"EQUIPMENT_STATUS_ACTIVE":         1,
+		"EQUIPMENT_STATUS_INACTIVE":       2,
+		"EQUIPMENT_STATUS_MAINTENANCE":    3,
+		"EQUIPMENT_STATUS_DISABLED":       4,
+		"EQUIPMENT_STATUS_RETIRED":        5,
+		"EQUIPMENT_STATUS_REGISTERED":     6,
+		"EQUIPMENT_STATUS_COMMISSIONED":   7,
+		"EQUIPMENT_STATUS_DECOMMISSIONED": 8,
 	}
 )
 
@@ -80,6 +92,153 @@ func (EquipmentStatus) EnumDescriptor() ([]byte, []int) {
 	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{0}
 }
 
+type EquipmentGroupKind int32
+
+const (
+	EquipmentGroupKind_EQUIPMENT_GROUP_KIND_UNSPECIFIED EquipmentGroupKind = 0
+	EquipmentGroupKind_EQUIPMENT_GROUP_KIND_ZONE        EquipmentGroupKind = 1
+	EquipmentGroupKind_EQUIPMENT_GROUP_KIND_BANK        EquipmentGroupKind = 2
+)
+
+// Enum value maps for EquipmentGroupKind.
+var (
+	EquipmentGroupKind_name = map[int32]string{
+		0: "EQUIPMENT_GROUP_KIND_UNSPECIFIED",
+		1: "EQUIPMENT_GROUP_KIND_ZONE",
+		2: "EQUIPMENT_GROUP_KIND_BANK",
+	}
+	EquipmentGroupKind_value = map[string]int32{
+		"EQUIPMENT_GROUP_KIND_UNSPECIFIED": 0,
+		"EQUIPMENT_GROUP_KIND_ZONE":        1,
+		"EQUIPMENT_GROUP_KIND_BANK":        2,
+	}
+)
+
+func (x EquipmentGroupKind) Enum() *EquipmentGroupKind {
+	p := new(EquipmentGroupKind)
+	*p = x
+	return p
+}
+
+func (x EquipmentGroupKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EquipmentGroupKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_rgs_v1_registry_proto_enumTypes[1].Descriptor()
+}
+
+func (EquipmentGroupKind) Type() protoreflect.EnumType {
+	return &file_rgs_v1_registry_proto_enumTypes[1]
+}
+
+func (x EquipmentGroupKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EquipmentGroupKind.Descriptor instead.
+func (EquipmentGroupKind) EnumDescriptor() ([]byte, []int) {
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{1}
+}
+
+type SoftwareVerificationStatus int32
+
+const (
+	SoftwareVerificationStatus_SOFTWARE_VERIFICATION_STATUS_UNSPECIFIED SoftwareVerificationStatus = 0
+	SoftwareVerificationStatus_SOFTWARE_VERIFICATION_STATUS_VERIFIED    SoftwareVerificationStatus = 1
+	SoftwareVerificationStatus_SOFTWARE_VERIFICATION_STATUS_MISMATCH    SoftwareVerificationStatus = 2
+)
+
+// Enum value maps for SoftwareVerificationStatus.
+var (
+	SoftwareVerificationStatus_name = map[int32]string{
+		0: "SOFTWARE_VERIFICATION_STATUS_UNSPECIFIED",
+		1: "SOFTWARE_VERIFICATION_STATUS_VERIFIED",
+		2: "SOFTWARE_VERIFICATION_STATUS_MISMATCH",
+	}
+	SoftwareVerificationStatus_value = map[string]int32{
+		"SOFTWARE_VERIFICATION_STATUS_UNSPECIFIED": 0,
+		"SOFTWARE_VERIFICATION_STATUS_VERIFIED":    1,
+		"SOFTWARE_VERIFICATION_STATUS_MISMATCH":    2,
+	}
+)
+
+func (x SoftwareVerificationStatus) Enum() *SoftwareVerificationStatus {
+	p := new(SoftwareVerificationStatus)
+	*p = x
+	return p
+}
+
+func (x SoftwareVerificationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SoftwareVerificationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_rgs_v1_registry_proto_enumTypes[2].Descriptor()
+}
+
+func (SoftwareVerificationStatus) Type() protoreflect.EnumType {
+	return &file_rgs_v1_registry_proto_enumTypes[2]
+}
+
+func (x SoftwareVerificationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SoftwareVerificationStatus.Descriptor instead.
+func (SoftwareVerificationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{2}
+}
+
+type ClientCertificateBindingStatus int32
+
+const (
+	ClientCertificateBindingStatus_CLIENT_CERTIFICATE_BINDING_STATUS_UNSPECIFIED ClientCertificateBindingStatus = 0
+	ClientCertificateBindingStatus_CLIENT_CERTIFICATE_BINDING_STATUS_ACTIVE      ClientCertificateBindingStatus = 1
+	ClientCertificateBindingStatus_CLIENT_CERTIFICATE_BINDING_STATUS_REVOKED     ClientCertificateBindingStatus = 2
+)
+
+// Enum value maps for ClientCertificateBindingStatus.
+var (
+	ClientCertificateBindingStatus_name = map[int32]string{
+		0: "CLIENT_CERTIFICATE_BINDING_STATUS_UNSPECIFIED",
+		1: "CLIENT_CERTIFICATE_BINDING_STATUS_ACTIVE",
+		2: "CLIENT_CERTIFICATE_BINDING_STATUS_REVOKED",
+	}
+	ClientCertificateBindingStatus_value = map[string]int32{
+		"CLIENT_CERTIFICATE_BINDING_STATUS_UNSPECIFIED": 0,
+		"CLIENT_CERTIFICATE_BINDING_STATUS_ACTIVE":      1,
+		"CLIENT_CERTIFICATE_BINDING_STATUS_REVOKED":     2,
+	}
+)
+
+func (x ClientCertificateBindingStatus) Enum() *ClientCertificateBindingStatus {
+	p := new(ClientCertificateBindingStatus)
+	*p = x
+	return p
+}
+
+func (x ClientCertificateBindingStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ClientCertificateBindingStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_rgs_v1_registry_proto_enumTypes[3].Descriptor()
+}
+
+func (ClientCertificateBindingStatus) Type() protoreflect.EnumType {
+	return &file_rgs_v1_registry_proto_enumTypes[3]
+}
+
+func (x ClientCertificateBindingStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ClientCertificateBindingStatus.Descriptor instead.
+func (ClientCertificateBindingStatus) EnumDescriptor() ([]byte, []int) {
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{3}
+}
+
 type Equipment struct {
 	state                 protoimpl.MessageState `protogen:"open.v1"`
 	EquipmentId           string                 `protobuf:"bytes,1,opt,name=equipment_id,json=equipmentId,proto3" json:"equipment_id,omitempty"`
@@ -92,8 +251,15 @@ type Equipment struct {
 	CreatedAt             string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt             string                 `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	Attributes            map[string]string      `protobuf:"bytes,10,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+	// Selects the approved software manifests the equipment is verified
+	// against.
+	Model string `protobuf:"bytes,11,opt,name=model,proto3" json:"model,omitempty"`
+	// Set with AssignEquipmentGroup. Equipment in a bank is also in the
+	// bank's zone.
+	ZoneId        string `protobuf:"bytes,12,opt,name=zone_id,json=zoneId,proto3" json:"zone_id,omitempty"`
+	BankId        string `protobuf:"bytes,13,opt,name=bank_id,json=bankId,proto3" json:"bank_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Equipment) Reset() {
@@ -196,6 +362,114 @@ func (x *Equipment) GetAttributes() map[string]string {
 	return nil
 }
 
+func (x *Equipment) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *Equipment) GetZoneId() string {
+	if x != nil {
+		return x.ZoneId
+	}
+	return ""
+}
+
+func (x *Equipment) GetBankId() string {
+	if x != nil {
+		return x.BankId
+	}
+	return ""
+}
+
+// EquipmentGroup is a zone of the floor or a bank of equipment within a
+// zone. Kind and zone_id are fixed once the group is created.
+type EquipmentGroup struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GroupId string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Kind    EquipmentGroupKind     `protobuf:"varint,2,opt,name=kind,proto3,enum=rgs.v1.EquipmentGroupKind" json:"kind,omitempty"`
+	Name    string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// The zone a bank belongs to. Empty for zones.
+	ZoneId        string `protobuf:"bytes,4,opt,name=zone_id,json=zoneId,proto3" json:"zone_id,omitempty"`
+	CreatedAt     string `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EquipmentGroup) Reset() {
+	*x = EquipmentGroup{}
+	mi := &file_rgs_v1_registry_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EquipmentGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EquipmentGroup) ProtoMessage() {}
+
+func (x *EquipmentGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_registry_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EquipmentGroup.ProtoReflect.Descriptor instead.
+func (*EquipmentGroup) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EquipmentGroup) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *EquipmentGroup) GetKind() EquipmentGroupKind {
+	if x != nil {
+		return x.Kind
+	}
+	return EquipmentGroupKind_EQUIPMENT_GROUP_KIND_UNSPECIFIED
+}
+
+func (x *EquipmentGroup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EquipmentGroup) GetZoneId() string {
+	if x != nil {
+		return x.ZoneId
+	}
+	return ""
+}
+
+func (x *EquipmentGroup) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *EquipmentGroup) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
 type UpsertEquipmentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
@@ -207,7 +481,7 @@ type UpsertEquipmentRequest struct {
 
 func (x *UpsertEquipmentRequest) Reset() {
 	*x = UpsertEquipmentRequest{}
-	mi := &file_rgs_v1_registry_proto_msgTypes[1]
+	mi := &file_rgs_v1_registry_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -219,7 +493,7 @@ func (x *UpsertEquipmentRequest) String() string {
 func (*UpsertEquipmentRequest) ProtoMessage() {}
 
 func (x *UpsertEquipmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_registry_proto_msgTypes[1]
+	mi := &file_rgs_v1_registry_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -232,7 +506,7 @@ func (x *UpsertEquipmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpsertEquipmentRequest.ProtoReflect.Descriptor instead.
 func (*UpsertEquipmentRequest) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{1}
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *UpsertEquipmentRequest) GetMeta() *RequestMeta {
@@ -266,7 +540,7 @@ type UpsertEquipmentResponse struct {
 
 func (x *UpsertEquipmentResponse) Reset() {
 	*x = UpsertEquipmentResponse{}
-	mi := &file_rgs_v1_registry_proto_msgTypes[2]
+	mi := &file_rgs_v1_registry_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -278,7 +552,7 @@ func (x *UpsertEquipmentResponse) String() string {
 func (*UpsertEquipmentResponse) ProtoMessage() {}
 
 func (x *UpsertEquipmentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_registry_proto_msgTypes[2]
+	mi := &file_rgs_v1_registry_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -291,7 +565,7 @@ func (x *UpsertEquipmentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpsertEquipmentResponse.ProtoReflect.Descriptor instead.
 func (*UpsertEquipmentResponse) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{2}
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *UpsertEquipmentResponse) GetMeta() *ResponseMeta {
@@ -318,7 +592,7 @@ type GetEquipmentRequest struct {
 
 func (x *GetEquipmentRequest) Reset() {
 	*x = GetEquipmentRequest{}
-	mi := &file_rgs_v1_registry_proto_msgTypes[3]
+	mi := &file_rgs_v1_registry_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -330,7 +604,7 @@ func (x *GetEquipmentRequest) String() string {
 func (*GetEquipmentRequest) ProtoMessage() {}
 
 func (x *GetEquipmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_registry_proto_msgTypes[3]
+	mi := &file_rgs_v1_registry_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -343,7 +617,7 @@ func (x *GetEquipmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEquipmentRequest.ProtoReflect.Descriptor instead.
 func (*GetEquipmentRequest) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{3}
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetEquipmentRequest) GetMeta() *RequestMeta {
@@ -370,7 +644,7 @@ type GetEquipmentResponse struct {
 
 func (x *GetEquipmentResponse) Reset() {
 	*x = GetEquipmentResponse{}
-	mi := &file_rgs_v1_registry_proto_msgTypes[4]
+	mi := &file_rgs_v1_registry_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -382,7 +656,7 @@ func (x *GetEquipmentResponse) String() string {
 func (*GetEquipmentResponse) ProtoMessage() {}
 
 func (x *GetEquipmentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_registry_proto_msgTypes[4]
+	mi := &file_rgs_v1_registry_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -395,7 +669,7 @@ func (x *GetEquipmentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEquipmentResponse.ProtoReflect.Descriptor instead.
 func (*GetEquipmentResponse) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{4}
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetEquipmentResponse) GetMeta() *ResponseMeta {
@@ -413,18 +687,28 @@ func (x *GetEquipmentResponse) GetEquipment() *Equipment {
 }
 
 type ListEquipmentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	StatusFilter  EquipmentStatus        `protobuf:"varint,4,opt,name=status_filter,json=statusFilter,proto3,enum=rgs.v1.EquipmentStatus" json:"status_filter,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Meta         *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	PageSize     int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken    string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	StatusFilter EquipmentStatus        `protobuf:"varint,4,opt,name=status_filter,json=statusFilter,proto3,enum=rgs.v1.EquipmentStatus" json:"status_filter,omitempty"`
+	// Lists only equipment in this zone or bank.
+	GroupId string `protobuf:"bytes,5,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// Exact matches on the equipment's fields; empty matches everything.
+	// control_program_version is the installed firmware version.
+	Location              string `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	Model                 string `protobuf:"bytes,7,opt,name=model,proto3" json:"model,omitempty"`
+	ControlProgramVersion string `protobuf:"bytes,8,opt,name=control_program_version,json=controlProgramVersion,proto3" json:"control_program_version,omitempty"`
+	// Case-insensitive substring of equipment_id, external_reference,
+	// location, model, or control_program_version.
+	Search        string `protobuf:"bytes,9,opt,name=search,proto3" json:"search,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListEquipmentRequest) Reset() {
 	*x = ListEquipmentRequest{}
-	mi := &file_rgs_v1_registry_proto_msgTypes[5]
+	mi := &file_rgs_v1_registry_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -436,7 +720,7 @@ func (x *ListEquipmentRequest) String() string {
 func (*ListEquipmentRequest) ProtoMessage() {}
 
 func (x *ListEquipmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_registry_proto_msgTypes[5]
+	mi := &file_rgs_v1_registry_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -449,7 +733,7 @@ func (x *ListEquipmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEquipmentRequest.ProtoReflect.Descriptor instead.
 func (*ListEquipmentRequest) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{5}
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ListEquipmentRequest) GetMeta() *RequestMeta {
@@ -480,6 +764,41 @@ func (x *ListEquipmentRequest) GetStatusFilter() EquipmentStatus {
 	return EquipmentStatus_EQUIPMENT_STATUS_UNSPECIFIED
 }
 
+func (x *ListEquipmentRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *ListEquipmentRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *ListEquipmentRequest) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *ListEquipmentRequest) GetControlProgramVersion() string {
+	if x != nil {
+		return x.ControlProgramVersion
+	}
+	return ""
+}
+
+func (x *ListEquipmentRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
 type ListEquipmentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
@@ -491,7 +810,7 @@ type ListEquipmentResponse struct {
 
 func (x *ListEquipmentResponse) Reset() {
 	*x = ListEquipmentResponse{}
-	mi := &file_rgs_v1_registry_proto_msgTypes[6]
+	mi := &file_rgs_v1_registry_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -503,7 +822,7 @@ func (x *ListEquipmentResponse) String() string {
 func (*ListEquipmentResponse) ProtoMessage() {}
 
 func (x *ListEquipmentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_registry_proto_msgTypes[6]
+	mi := &file_rgs_v1_registry_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -516,7 +835,7 @@ func (x *ListEquipmentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEquipmentResponse.ProtoReflect.Descriptor instead.
 func (*ListEquipmentResponse) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{6}
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListEquipmentResponse) GetMeta() *ResponseMeta {
@@ -540,64 +859,2260 @@ func (x *ListEquipmentResponse) GetNextPageToken() string {
 	return ""
 }
 
-var File_rgs_v1_registry_proto protoreflect.FileDescriptor
+type CommissionEquipmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	EquipmentId   string                 `protobuf:"bytes,2,opt,name=equipment_id,json=equipmentId,proto3" json:"equipment_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_rgs_v1_registry_proto_rawDesc = "" +
-	"\n" +
-	"\x15rgs/v1/registry.proto\x12\x06rgs.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x13rgs/v1/common.proto\"\xf9\x03\n" +
-	"\tEquipment\x12!\n" +
-	"\fequipment_id\x18\x01 \x01(\tR\vequipmentId\x12-\n" +
-	"\x12external_reference\x18\x02 \x01(\tR\x11externalReference\x12\x1a\n" +
-	"\blocation\x18\x03 \x01(\tR\blocation\x12/\n" +
-	"\x06status\x18\x04 \x01(\x0e2\x17.rgs.v1.EquipmentStatusR\x06status\x12.\n" +
-	"\x13theoretical_rtp_bps\x18\x05 \x01(\tR\x11theoreticalRtpBps\x126\n" +
-	"\x17control_program_version\x18\x06 \x01(\tR\x15controlProgramVersion\x12%\n" +
-	"\x0econfig_version\x18\a \x01(\tR\rconfigVersion\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\b \x01(\tR\tcreatedAt\x12\x1d\n" +
-	"\n" +
-	"updated_at\x18\t \x01(\tR\tupdatedAt\x12A\n" +
-	"\n" +
-	"attributes\x18\n" +
-	" \x03(\v2!.rgs.v1.Equipment.AttributesEntryR\n" +
-	"attributes\x1a=\n" +
-	"\x0fAttributesEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x8a\x01\n" +
-	"\x16UpsertEquipmentRequest\x12'\n" +
-	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12/\n" +
-	"\tequipment\x18\x02 \x01(\v2\x11.rgs.v1.EquipmentR\tequipment\x12\x16\n" +
-	"\x06reason\x18\x03 \x01(\tR\x06reason\"t\n" +
-	"\x17UpsertEquipmentResponse\x12(\n" +
-	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12/\n" +
-	"\tequipment\x18\x02 \x01(\v2\x11.rgs.v1.EquipmentR\tequipment\"a\n" +
-	"\x13GetEquipmentRequest\x12'\n" +
-	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12!\n" +
-	"\fequipment_id\x18\x02 \x01(\tR\vequipmentId\"q\n" +
-	"\x14GetEquipmentResponse\x12(\n" +
-	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12/\n" +
-	"\tequipment\x18\x02 \x01(\v2\x11.rgs.v1.EquipmentR\tequipment\"\xb9\x01\n" +
-	"\x14ListEquipmentRequest\x12'\n" +
-	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x1b\n" +
+func (x *CommissionEquipmentRequest) Reset() {
+	*x = CommissionEquipmentRequest{}
+	mi := &file_rgs_v1_registry_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommissionEquipmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommissionEquipmentRequest) ProtoMessage() {}
+
+func (x *CommissionEquipmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_registry_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommissionEquipmentRequest.ProtoReflect.Descriptor instead.
+func (*CommissionEquipmentRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CommissionEquipmentRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *CommissionEquipmentRequest) GetEquipmentId() string {
+	if x != nil {
+		return x.EquipmentId
+	}
+	return ""
+}
+
+func (x *CommissionEquipmentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CommissionEquipmentResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Meta           *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Equipment      *Equipment             `protobuf:"bytes,2,opt,name=equipment,proto3" json:"equipment,omitempty"`
+	PreviousStatus EquipmentStatus        `protobuf:"varint,3,opt,name=previous_status,json=previousStatus,proto3,enum=rgs.v1.EquipmentStatus" json:"previous_status,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CommissionEquipmentResponse) Reset() {
+	*x = CommissionEquipmentResponse{}
+	mi := &file_rgs_v1_registry_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommissionEquipmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommissionEquipmentResponse) ProtoMessage() {}
+
+func (x *CommissionEquipmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_registry_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommissionEquipmentResponse.ProtoReflect.Descriptor instead.
+func (*CommissionEquipmentResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CommissionEquipmentResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *CommissionEquipmentResponse) GetEquipment() *Equipment {
+	if x != nil {
+		return x.Equipment
+	}
+	return nil
+}
+
+func (x *CommissionEquipmentResponse) GetPreviousStatus() EquipmentStatus {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return EquipmentStatus_EQUIPMENT_STATUS_UNSPECIFIED
+}
+
+type ActivateEquipmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	EquipmentId   string                 `protobuf:"bytes,2,opt,name=equipment_id,json=equipmentId,proto3" json:"equipment_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateEquipmentRequest) Reset() {
+	*x = ActivateEquipmentRequest{}
+	mi := &file_rgs_v1_registry_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateEquipmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateEquipmentRequest) ProtoMessage() {}
+
+func (x *ActivateEquipmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_registry_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateEquipmentRequest.ProtoReflect.Descriptor instead.
+func (*ActivateEquipmentRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ActivateEquipmentRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ActivateEquipmentRequest) GetEquipmentId() string {
+	if x != nil {
+		return x.EquipmentId
+	}
+	return ""
+}
+
+func (x *ActivateEquipmentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ActivateEquipmentResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Meta           *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Equipment      *Equipment             `protobuf:"bytes,2,opt,name=equipment,proto3" json:"equipment,omitempty"`
+	PreviousStatus EquipmentStatus        `protobuf:"varint,3,opt,name=previous_status,json=previousStatus,proto3,enum=rgs.v1.EquipmentStatus" json:"previous_status,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ActivateEquipmentResponse) Reset() {
+	*x = ActivateEquipmentResponse{}
+	mi := &file_rgs_v1_registry_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateEquipmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateEquipmentResponse) ProtoMessage() {}
+
+func (x *ActivateEquipmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_registry_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateEquipmentResponse.ProtoReflect.Descriptor instead.
+func (*ActivateEquipmentResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ActivateEquipmentResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ActivateEquipmentResponse) GetEquipment() *Equipment {
+	if x != nil {
+		return x.Equipment
+	}
+	return nil
+}
+
+func (x *ActivateEquipmentResponse) GetPreviousStatus() EquipmentStatus {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return EquipmentStatus_EQUIPMENT_STATUS_UNSPECIFIED
+}
+
+type StartEquipmentMaintenanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	EquipmentId   string                 `protobuf:"bytes,2,opt,name=equipment_id,json=equipmentId,proto3" json:"equipment_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartEquipmentMaintenanceRequest) Reset() {
+	*x = StartEquipmentMaintenanceRequest{}
+	mi := &file_rgs_v1_registry_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartEquipmentMaintenanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartEquipmentMaintenanceRequest) ProtoMessage() {}
+
+func (x *StartEquipmentMaintenanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_registry_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartEquipmentMaintenanceRequest.ProtoReflect.Descriptor instead.
+func (*StartEquipmentMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *StartEquipmentMaintenanceRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *StartEquipmentMaintenanceRequest) GetEquipmentId() string {
+	if x != nil {
+		return x.EquipmentId
+	}
+	return ""
+}
+
+func (x *StartEquipmentMaintenanceRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type StartEquipmentMaintenanceResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Meta           *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Equipment      *Equipment             `protobuf:"bytes,2,opt,name=equipment,proto3" json:"equipment,omitempty"`
+	PreviousStatus EquipmentStatus        `protobuf:"varint,3,opt,name=previous_status,json=previousStatus,proto3,enum=rgs.v1.EquipmentStatus" json:"previous_status,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StartEquipmentMaintenanceResponse) Reset() {
+	*x = StartEquipmentMaintenanceResponse{}
+	mi := &file_rgs_v1_registry_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartEquipmentMaintenanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartEquipmentMaintenanceResponse) ProtoMessage() {}
+
+func (x *StartEquipmentMaintenanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_registry_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartEquipmentMaintenanceResponse.ProtoReflect.Descriptor instead.
+func (*StartEquipmentMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *StartEquipmentMaintenanceResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *StartEquipmentMaintenanceResponse) GetEquipment() *Equipment {
+	if x != nil {
+		return x.Equipment
+	}
+	return nil
+}
+
+func (x *StartEquipmentMaintenanceResponse) GetPreviousStatus() EquipmentStatus {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return EquipmentStatus_EQUIPMENT_STATUS_UNSPECIFIED
+}
+
+type DecommissionEquipmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	EquipmentId   string                 `protobuf:"bytes,2,opt,name=equipment_id,json=equipmentId,proto3" json:"equipment_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecommissionEquipmentRequest) Reset() {
+	*x = DecommissionEquipmentRequest{}
+	mi := &file_rgs_v1_registry_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecommissionEquipmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecommissionEquipmentRequest) ProtoMessage() {}
+
+func (x *DecommissionEquipmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_registry_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecommissionEquipmentRequest.ProtoReflect.Descriptor instead.
+func (*DecommissionEquipmentRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DecommissionEquipmentRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *DecommissionEquipmentRequest) GetEquipmentId() string {
+	if x != nil {
+		return x.EquipmentId
+	}
+	return ""
+}
+
+func (x *DecommissionEquipmentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type DecommissionEquipmentResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Meta           *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Equipment      *Equipment             `protobuf:"bytes,2,opt,name=equipment,proto3" json:"equipment,omitempty"`
+	PreviousStatus EquipmentStatus        `protobuf:"varint,3,opt,name=previous_status,json=previousStatus,proto3,enum=rgs.v1.EquipmentStatus" json:"previous_status,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DecommissionEquipmentResponse) Reset() {
+	*x = DecommissionEquipmentResponse{}
+	mi := &file_rgs_v1_registry_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecommissionEquipmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecommissionEquipmentResponse) ProtoMessage() {}
+
+func (x *DecommissionEquipmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_registry_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecommissionEquipmentResponse.ProtoReflect.Descriptor instead.
+func (*DecommissionEquipmentResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DecommissionEquipmentResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *DecommissionEquipmentResponse) GetEquipment() *Equipment {
+	if x != nil {
+		return x.Equipment
+	}
+	return nil
+}
+
+func (x *DecommissionEquipmentResponse) GetPreviousStatus() EquipmentStatus {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return EquipmentStatus_EQUIPMENT_STATUS_UNSPECIFIED
+}
+
+type SoftwareComponent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Hex SHA-256 of the component image.
+	Sha256        string `protobuf:"bytes,2,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SoftwareComponent) Reset() {
+	*x = SoftwareComponent{}
+	mi := &file_rgs_v1_registry_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SoftwareComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SoftwareComponent) ProtoMessage() {}
+
+func (x *SoftwareComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_registry_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SoftwareComponent.ProtoReflect.Descriptor instead.
+func (*SoftwareComponent) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SoftwareComponent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SoftwareComponent) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+type SoftwareManifest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Model         string                 `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Components    []*SoftwareComponent   `protobuf:"bytes,3,rep,name=components,proto3" json:"components,omitempty"`
+	ApprovedBy    string                 `protobuf:"bytes,4,opt,name=approved_by,json=approvedBy,proto3" json:"approved_by,omitempty"`
+	ApprovedAt    string                 `protobuf:"bytes,5,opt,name=approved_at,json=approvedAt,proto3" json:"approved_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SoftwareManifest) Reset() {
+	*x = SoftwareManifest{}
+	mi := &file_rgs_v1_registry_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SoftwareManifest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SoftwareManifest) ProtoMessage() {}
+
+func (x *SoftwareManifest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_registry_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SoftwareManifest.ProtoReflect.Descriptor instead.
+func (*SoftwareManifest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SoftwareManifest) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *SoftwareManifest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *SoftwareManifest) GetComponents() []*SoftwareComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *SoftwareManifest) GetApprovedBy() string {
+	if x != nil {
+		return x.ApprovedBy
+	}
+	return ""
+}
+
+func (x *SoftwareManifest) GetApprovedAt() string {
+	if x != nil {
+		return x.ApprovedAt
+	}
+	return ""
+}
+
+type SoftwareVerification struct {
+	state       protoimpl.MessageState     `protogen:"open.v1"`
+	EquipmentId string                     `protobuf:"bytes,1,opt,name=equipment_id,json=equipmentId,proto3" json:"equipment_id,omitempty"`
+	Model       string                     `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	Version     string                     `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Status      SoftwareVerificationStatus `protobuf:"varint,4,opt,name=status,proto3,enum=rgs.v1.SoftwareVerificationStatus" json:"status,omitempty"`
+	// Components whose hash differs from the manifest, that the manifest
+	// lists but the device did not report, or that the manifest does not
+	// list.
+	MismatchedComponents []string `protobuf:"bytes,5,rep,name=mismatched_components,json=mismatchedComponents,proto3" json:"mismatched_components,omitempty"`
+	VerifiedAt           string   `protobuf:"bytes,6,opt,name=verified_at,json=verifiedAt,proto3" json:"verified_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *SoftwareVerification) Reset() {
+	*x = SoftwareVerification{}
+	mi := &file_rgs_v1_registry_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SoftwareVerification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SoftwareVerification) ProtoMessage() {}
+
+func (x *SoftwareVerification) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_registry_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SoftwareVerification.ProtoReflect.Descriptor instead.
+func (*SoftwareVerification) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SoftwareVerification) GetEquipmentId() string {
+	if x != nil {
+		return x.EquipmentId
+	}
+	return ""
+}
+
+func (x *SoftwareVerification) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *SoftwareVerification) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *SoftwareVerification) GetStatus() SoftwareVerificationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SoftwareVerificationStatus_SOFTWARE_VERIFICATION_STATUS_UNSPECIFIED
+}
+
+func (x *SoftwareVerification) GetMismatchedComponents() []string {
+	if x != nil {
+		return x.MismatchedComponents
+	}
+	return nil
+}
+
+func (x *SoftwareVerification) GetVerifiedAt() string {
+	if x != nil {
+		return x.VerifiedAt
+	}
+	return ""
+}
+
+type ApproveSoftwareManifestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Manifest      *SoftwareManifest      `protobuf:"bytes,2,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveSoftwareManifestRequest) Reset() {
+	*x = ApproveSoftwareManifestRequest{}
+	mi := &file_rgs_v1_registry_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveSoftwareManifestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveSoftwareManifestRequest) ProtoMessage() {}
+
+func (x *ApproveSoftwareManifestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_registry_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveSoftwareManifestRequest.ProtoReflect.Descriptor instead.
+func (*ApproveSoftwareManifestRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ApproveSoftwareManifestRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ApproveSoftwareManifestRequest) GetManifest() *SoftwareManifest {
+	if x != nil {
+		return x.Manifest
+	}
+	return nil
+}
+
+func (x *ApproveSoftwareManifestRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ApproveSoftwareManifestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Manifest      *SoftwareManifest      `protobuf:"bytes,2,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveSoftwareManifestResponse) Reset() {
+	*x = ApproveSoftwareManifestResponse{}
+	mi := &file_rgs_v1_registry_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveSoftwareManifestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveSoftwareManifestResponse) ProtoMessage() {}
+
+func (x *ApproveSoftwareManifestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_registry_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveSoftwareManifestResponse.ProtoReflect.Descriptor instead.
+func (*ApproveSoftwareManifestResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ApproveSoftwareManifestResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ApproveSoftwareManifestResponse) GetManifest() *SoftwareManifest {
+	if x != nil {
+		return x.Manifest
+	}
+	return nil
+}
+
+type ListSoftwareManifestsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Meta  *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// Empty lists every model.
+	Model         string `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSoftwareManifestsRequest) Reset() {
+	*x = ListSoftwareManifestsRequest{}
+	mi := &file_rgs_v1_registry_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSoftwareManifestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSoftwareManifestsRequest) ProtoMessage() {}
+
+func (x *ListSoftwareManifestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_registry_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSoftwareManifestsRequest.ProtoReflect.Descriptor instead.
+func (*ListSoftwareManifestsRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListSoftwareManifestsRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListSoftwareManifestsRequest) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+type ListSoftwareManifestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Manifests     []*SoftwareManifest    `protobuf:"bytes,2,rep,name=manifests,proto3" json:"manifests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSoftwareManifestsResponse) Reset() {
+	*x = ListSoftwareManifestsResponse{}
+	mi := &file_rgs_v1_registry_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSoftwareManifestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSoftwareManifestsResponse) ProtoMessage() {}
+
+func (x *ListSoftwareManifestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_registry_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSoftwareManifestsResponse.ProtoReflect.Descriptor instead.
+func (*ListSoftwareManifestsResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListSoftwareManifestsResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListSoftwareManifestsResponse) GetManifests() []*SoftwareManifest {
+	if x != nil {
+		return x.Manifests
+	}
+	return nil
+}
+
+type VerifySoftwareRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	EquipmentId   string                 `protobuf:"bytes,2,opt,name=equipment_id,json=equipmentId,proto3" json:"equipment_id,omitempty"`
+	Version       string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Components    []*SoftwareComponent   `protobuf:"bytes,4,rep,name=components,proto3" json:"components,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifySoftwareRequest) Reset() {
+	*x = VerifySoftwareRequest{}
+	mi := &file_rgs_v1_registry_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifySoftwareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifySoftwareRequest) ProtoMessage() {}
+
+func (x *VerifySoftwareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_registry_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifySoftwareRequest.ProtoReflect.Descriptor instead.
+func (*VerifySoftwareRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *VerifySoftwareRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *VerifySoftwareRequest) GetEquipmentId() string {
+	if x != nil {
+		return x.EquipmentId
+	}
+	return ""
+}
+
+func (x *VerifySoftwareRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *VerifySoftwareRequest) GetComponents() []*SoftwareComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+type VerifySoftwareResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Verification  *SoftwareVerification  `protobuf:"bytes,2,opt,name=verification,proto3" json:"verification,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifySoftwareResponse) Reset() {
+	*x = VerifySoftwareResponse{}
+	mi := &file_rgs_v1_registry_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifySoftwareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifySoftwareResponse) ProtoMessage() {}
+
+func (x *VerifySoftwareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_registry_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifySoftwareResponse.ProtoReflect.Descriptor instead.
+func (*VerifySoftwareResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *VerifySoftwareResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *VerifySoftwareResponse) GetVerification() *SoftwareVerification {
+	if x != nil {
+		return x.Verification
+	}
+	return nil
+}
+
+// ClientCertificateBinding maps an mTLS client certificate to the service
+// actor it authenticates as. Exactly one of fingerprint_sha256 and san is
+// set.
+type ClientCertificateBinding struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	BindingId string                 `protobuf:"bytes,1,opt,name=binding_id,json=bindingId,proto3" json:"binding_id,omitempty"`
+	// Hex SHA-256 of the certificate's DER encoding.
+	FingerprintSha256 string `protobuf:"bytes,2,opt,name=fingerprint_sha256,json=fingerprintSha256,proto3" json:"fingerprint_sha256,omitempty"`
+	// A DNS name, URI, or email address subject alternative name.
+	San           string                         `protobuf:"bytes,3,opt,name=san,proto3" json:"san,omitempty"`
+	ActorId       string                         `protobuf:"bytes,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Status        ClientCertificateBindingStatus `protobuf:"varint,5,opt,name=status,proto3,enum=rgs.v1.ClientCertificateBindingStatus" json:"status,omitempty"`
+	CreatedBy     string                         `protobuf:"bytes,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     string                         `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RevokedAt     string                         `protobuf:"bytes,8,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	RevokeReason  string                         `protobuf:"bytes,9,opt,name=revoke_reason,json=revokeReason,proto3" json:"revoke_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClientCertificateBinding) Reset() {
+	*x = ClientCertificateBinding{}
+	mi := &file_rgs_v1_registry_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientCertificateBinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientCertificateBinding) ProtoMessage() {}
+
+func (x *ClientCertificateBinding) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_registry_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientCertificateBinding.ProtoReflect.Descriptor instead.
+func (*ClientCertificateBinding) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ClientCertificateBinding) GetBindingId() string {
+	if x != nil {
+		return x.BindingId
+	}
+	return ""
+}
+
+func (x *ClientCertificateBinding) GetFingerprintSha256() string {
+	if x != nil {
+		return x.FingerprintSha256
+	}
+	return ""
+}
+
+func (x *ClientCertificateBinding) GetSan() string {
+	if x != nil {
+		return x.San
+	}
+	return ""
+}
+
+func (x *ClientCertificateBinding) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ClientCertificateBinding) GetStatus() ClientCertificateBindingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ClientCertificateBindingStatus_CLIENT_CERTIFICATE_BINDING_STATUS_UNSPECIFIED
+}
+
+func (x *ClientCertificateBinding) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ClientCertificateBinding) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ClientCertificateBinding) GetRevokedAt() string {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return ""
+}
+
+func (x *ClientCertificateBinding) GetRevokeReason() string {
+	if x != nil {
+		return x.RevokeReason
+	}
+	return ""
+}
+
+type UpsertEquipmentGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Group         *EquipmentGroup        `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertEquipmentGroupRequest) Reset() {
+	*x = UpsertEquipmentGroupRequest{}
+	mi := &file_rgs_v1_registry_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertEquipmentGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertEquipmentGroupRequest) ProtoMessage() {}
+
+func (x *UpsertEquipmentGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_registry_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertEquipmentGroupRequest.ProtoReflect.Descriptor instead.
+func (*UpsertEquipmentGroupRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UpsertEquipmentGroupRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *UpsertEquipmentGroupRequest) GetGroup() *EquipmentGroup {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *UpsertEquipmentGroupRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UpsertEquipmentGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Group         *EquipmentGroup        `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertEquipmentGroupResponse) Reset() {
+	*x = UpsertEquipmentGroupResponse{}
+	mi := &file_rgs_v1_registry_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertEquipmentGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertEquipmentGroupResponse) ProtoMessage() {}
+
+func (x *UpsertEquipmentGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_registry_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertEquipmentGroupResponse.ProtoReflect.Descriptor instead.
+func (*UpsertEquipmentGroupResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UpsertEquipmentGroupResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *UpsertEquipmentGroupResponse) GetGroup() *EquipmentGroup {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type ListEquipmentGroupsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Meta  *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// Unspecified lists zones and banks.
+	Kind EquipmentGroupKind `protobuf:"varint,2,opt,name=kind,proto3,enum=rgs.v1.EquipmentGroupKind" json:"kind,omitempty"`
+	// Lists only the banks of this zone.
+	ZoneId        string `protobuf:"bytes,3,opt,name=zone_id,json=zoneId,proto3" json:"zone_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEquipmentGroupsRequest) Reset() {
+	*x = ListEquipmentGroupsRequest{}
+	mi := &file_rgs_v1_registry_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEquipmentGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEquipmentGroupsRequest) ProtoMessage() {}
+
+func (x *ListEquipmentGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_registry_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEquipmentGroupsRequest.ProtoReflect.Descriptor instead.
+func (*ListEquipmentGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListEquipmentGroupsRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListEquipmentGroupsRequest) GetKind() EquipmentGroupKind {
+	if x != nil {
+		return x.Kind
+	}
+	return EquipmentGroupKind_EQUIPMENT_GROUP_KIND_UNSPECIFIED
+}
+
+func (x *ListEquipmentGroupsRequest) GetZoneId() string {
+	if x != nil {
+		return x.ZoneId
+	}
+	return ""
+}
+
+type ListEquipmentGroupsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Groups        []*EquipmentGroup      `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEquipmentGroupsResponse) Reset() {
+	*x = ListEquipmentGroupsResponse{}
+	mi := &file_rgs_v1_registry_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEquipmentGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEquipmentGroupsResponse) ProtoMessage() {}
+
+func (x *ListEquipmentGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_registry_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEquipmentGroupsResponse.ProtoReflect.Descriptor instead.
+func (*ListEquipmentGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListEquipmentGroupsResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListEquipmentGroupsResponse) GetGroups() []*EquipmentGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type AssignEquipmentGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	EquipmentId   string                 `protobuf:"bytes,2,opt,name=equipment_id,json=equipmentId,proto3" json:"equipment_id,omitempty"`
+	GroupId       string                 `protobuf:"bytes,3,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignEquipmentGroupRequest) Reset() {
+	*x = AssignEquipmentGroupRequest{}
+	mi := &file_rgs_v1_registry_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignEquipmentGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignEquipmentGroupRequest) ProtoMessage() {}
+
+func (x *AssignEquipmentGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_registry_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignEquipmentGroupRequest.ProtoReflect.Descriptor instead.
+func (*AssignEquipmentGroupRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AssignEquipmentGroupRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *AssignEquipmentGroupRequest) GetEquipmentId() string {
+	if x != nil {
+		return x.EquipmentId
+	}
+	return ""
+}
+
+func (x *AssignEquipmentGroupRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *AssignEquipmentGroupRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AssignEquipmentGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Equipment     *Equipment             `protobuf:"bytes,2,opt,name=equipment,proto3" json:"equipment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignEquipmentGroupResponse) Reset() {
+	*x = AssignEquipmentGroupResponse{}
+	mi := &file_rgs_v1_registry_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignEquipmentGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignEquipmentGroupResponse) ProtoMessage() {}
+
+func (x *AssignEquipmentGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_registry_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignEquipmentGroupResponse.ProtoReflect.Descriptor instead.
+func (*AssignEquipmentGroupResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AssignEquipmentGroupResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *AssignEquipmentGroupResponse) GetEquipment() *Equipment {
+	if x != nil {
+		return x.Equipment
+	}
+	return nil
+}
+
+type EnrollEquipmentRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Meta        *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	EquipmentId string                 `protobuf:"bytes,2,opt,name=equipment_id,json=equipmentId,proto3" json:"equipment_id,omitempty"`
+	// PEM certificate signing request. Exactly one of csr_pem and
+	// certificate_pem is set.
+	CsrPem string `protobuf:"bytes,3,opt,name=csr_pem,json=csrPem,proto3" json:"csr_pem,omitempty"`
+	// PEM client certificate the device already holds.
+	CertificatePem string `protobuf:"bytes,4,opt,name=certificate_pem,json=certificatePem,proto3" json:"certificate_pem,omitempty"`
+	Reason         string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *EnrollEquipmentRequest) Reset() {
+	*x = EnrollEquipmentRequest{}
+	mi := &file_rgs_v1_registry_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollEquipmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollEquipmentRequest) ProtoMessage() {}
+
+func (x *EnrollEquipmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_registry_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollEquipmentRequest.ProtoReflect.Descriptor instead.
+func (*EnrollEquipmentRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *EnrollEquipmentRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *EnrollEquipmentRequest) GetEquipmentId() string {
+	if x != nil {
+		return x.EquipmentId
+	}
+	return ""
+}
+
+func (x *EnrollEquipmentRequest) GetCsrPem() string {
+	if x != nil {
+		return x.CsrPem
+	}
+	return ""
+}
+
+func (x *EnrollEquipmentRequest) GetCertificatePem() string {
+	if x != nil {
+		return x.CertificatePem
+	}
+	return ""
+}
+
+func (x *EnrollEquipmentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type EnrollEquipmentResponse struct {
+	state   protoimpl.MessageState    `protogen:"open.v1"`
+	Meta    *ResponseMeta             `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Binding *ClientCertificateBinding `protobuf:"bytes,2,opt,name=binding,proto3" json:"binding,omitempty"`
+	// The issued certificate, or the registered one.
+	CertificatePem string `protobuf:"bytes,3,opt,name=certificate_pem,json=certificatePem,proto3" json:"certificate_pem,omitempty"`
+	// The enrollment CA certificate when the registry issued the certificate.
+	CaCertificatePem string `protobuf:"bytes,4,opt,name=ca_certificate_pem,json=caCertificatePem,proto3" json:"ca_certificate_pem,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *EnrollEquipmentResponse) Reset() {
+	*x = EnrollEquipmentResponse{}
+	mi := &file_rgs_v1_registry_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollEquipmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollEquipmentResponse) ProtoMessage() {}
+
+func (x *EnrollEquipmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_registry_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollEquipmentResponse.ProtoReflect.Descriptor instead.
+func (*EnrollEquipmentResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *EnrollEquipmentResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *EnrollEquipmentResponse) GetBinding() *ClientCertificateBinding {
+	if x != nil {
+		return x.Binding
+	}
+	return nil
+}
+
+func (x *EnrollEquipmentResponse) GetCertificatePem() string {
+	if x != nil {
+		return x.CertificatePem
+	}
+	return ""
+}
+
+func (x *EnrollEquipmentResponse) GetCaCertificatePem() string {
+	if x != nil {
+		return x.CaCertificatePem
+	}
+	return ""
+}
+
+type RegisterClientCertificateRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Meta          *RequestMeta              `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Binding       *ClientCertificateBinding `protobuf:"bytes,2,opt,name=binding,proto3" json:"binding,omitempty"`
+	Reason        string                    `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterClientCertificateRequest) Reset() {
+	*x = RegisterClientCertificateRequest{}
+	mi := &file_rgs_v1_registry_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterClientCertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterClientCertificateRequest) ProtoMessage() {}
+
+func (x *RegisterClientCertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_registry_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterClientCertificateRequest.ProtoReflect.Descriptor instead.
+func (*RegisterClientCertificateRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RegisterClientCertificateRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *RegisterClientCertificateRequest) GetBinding() *ClientCertificateBinding {
+	if x != nil {
+		return x.Binding
+	}
+	return nil
+}
+
+func (x *RegisterClientCertificateRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RegisterClientCertificateResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Meta          *ResponseMeta             `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Binding       *ClientCertificateBinding `protobuf:"bytes,2,opt,name=binding,proto3" json:"binding,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterClientCertificateResponse) Reset() {
+	*x = RegisterClientCertificateResponse{}
+	mi := &file_rgs_v1_registry_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterClientCertificateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterClientCertificateResponse) ProtoMessage() {}
+
+func (x *RegisterClientCertificateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_registry_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterClientCertificateResponse.ProtoReflect.Descriptor instead.
+func (*RegisterClientCertificateResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RegisterClientCertificateResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *RegisterClientCertificateResponse) GetBinding() *ClientCertificateBinding {
+	if x != nil {
+		return x.Binding
+	}
+	return nil
+}
+
+type ListClientCertificatesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Meta           *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	ActorId        string                 `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	IncludeRevoked bool                   `protobuf:"varint,3,opt,name=include_revoked,json=includeRevoked,proto3" json:"include_revoked,omitempty"`
+	PageSize       int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken      string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListClientCertificatesRequest) Reset() {
+	*x = ListClientCertificatesRequest{}
+	mi := &file_rgs_v1_registry_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClientCertificatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClientCertificatesRequest) ProtoMessage() {}
+
+func (x *ListClientCertificatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_registry_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClientCertificatesRequest.ProtoReflect.Descriptor instead.
+func (*ListClientCertificatesRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListClientCertificatesRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListClientCertificatesRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ListClientCertificatesRequest) GetIncludeRevoked() bool {
+	if x != nil {
+		return x.IncludeRevoked
+	}
+	return false
+}
+
+func (x *ListClientCertificatesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListClientCertificatesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListClientCertificatesResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Meta          *ResponseMeta               `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Bindings      []*ClientCertificateBinding `protobuf:"bytes,2,rep,name=bindings,proto3" json:"bindings,omitempty"`
+	NextPageToken string                      `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClientCertificatesResponse) Reset() {
+	*x = ListClientCertificatesResponse{}
+	mi := &file_rgs_v1_registry_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClientCertificatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClientCertificatesResponse) ProtoMessage() {}
+
+func (x *ListClientCertificatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_registry_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClientCertificatesResponse.ProtoReflect.Descriptor instead.
+func (*ListClientCertificatesResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListClientCertificatesResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListClientCertificatesResponse) GetBindings() []*ClientCertificateBinding {
+	if x != nil {
+		return x.Bindings
+	}
+	return nil
+}
+
+func (x *ListClientCertificatesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RevokeClientCertificateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	BindingId     string                 `protobuf:"bytes,2,opt,name=binding_id,json=bindingId,proto3" json:"binding_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeClientCertificateRequest) Reset() {
+	*x = RevokeClientCertificateRequest{}
+	mi := &file_rgs_v1_registry_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeClientCertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeClientCertificateRequest) ProtoMessage() {}
+
+func (x *RevokeClientCertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_registry_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeClientCertificateRequest.ProtoReflect.Descriptor instead.
+func (*RevokeClientCertificateRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RevokeClientCertificateRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *RevokeClientCertificateRequest) GetBindingId() string {
+	if x != nil {
+		return x.BindingId
+	}
+	return ""
+}
+
+func (x *RevokeClientCertificateRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RevokeClientCertificateResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Meta          *ResponseMeta             `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Binding       *ClientCertificateBinding `protobuf:"bytes,2,opt,name=binding,proto3" json:"binding,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeClientCertificateResponse) Reset() {
+	*x = RevokeClientCertificateResponse{}
+	mi := &file_rgs_v1_registry_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeClientCertificateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeClientCertificateResponse) ProtoMessage() {}
+
+func (x *RevokeClientCertificateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_registry_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeClientCertificateResponse.ProtoReflect.Descriptor instead.
+func (*RevokeClientCertificateResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_registry_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RevokeClientCertificateResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *RevokeClientCertificateResponse) GetBinding() *ClientCertificateBinding {
+	if x != nil {
+		return x.Binding
+	}
+	return nil
+}
+
+var File_rgs_v1_registry_proto protoreflect.FileDescriptor
+
+const file_rgs_v1_registry_proto_rawDesc = "" +
+	"\n" +
+	"\x15rgs/v1/registry.proto\x12\x06rgs.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x13rgs/v1/common.proto\"\xc1\x04\n" +
+	"\tEquipment\x12!\n" +
+	"\fequipment_id\x18\x01 \x01(\tR\vequipmentId\x12-\n" +
+	"\x12external_reference\x18\x02 \x01(\tR\x11externalReference\x12\x1a\n" +
+	"\blocation\x18\x03 \x01(\tR\blocation\x12/\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x17.rgs.v1.EquipmentStatusR\x06status\x12.\n" +
+	"\x13theoretical_rtp_bps\x18\x05 \x01(\tR\x11theoreticalRtpBps\x126\n" +
+	"\x17control_program_version\x18\x06 \x01(\tR\x15controlProgramVersion\x12%\n" +
+	"\x0econfig_version\x18\a \x01(\tR\rconfigVersion\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\tR\tupdatedAt\x12A\n" +
+	"\n" +
+	"attributes\x18\n" +
+	" \x03(\v2!.rgs.v1.Equipment.AttributesEntryR\n" +
+	"attributes\x12\x14\n" +
+	"\x05model\x18\v \x01(\tR\x05model\x12\x17\n" +
+	"\azone_id\x18\f \x01(\tR\x06zoneId\x12\x17\n" +
+	"\abank_id\x18\r \x01(\tR\x06bankId\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc6\x01\n" +
+	"\x0eEquipmentGroup\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12.\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x1a.rgs.v1.EquipmentGroupKindR\x04kind\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x17\n" +
+	"\azone_id\x18\x04 \x01(\tR\x06zoneId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\tR\tupdatedAt\"\x8a\x01\n" +
+	"\x16UpsertEquipmentRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12/\n" +
+	"\tequipment\x18\x02 \x01(\v2\x11.rgs.v1.EquipmentR\tequipment\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"t\n" +
+	"\x17UpsertEquipmentResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12/\n" +
+	"\tequipment\x18\x02 \x01(\v2\x11.rgs.v1.EquipmentR\tequipment\"a\n" +
+	"\x13GetEquipmentRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12!\n" +
+	"\fequipment_id\x18\x02 \x01(\tR\vequipmentId\"q\n" +
+	"\x14GetEquipmentResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12/\n" +
+	"\tequipment\x18\x02 \x01(\v2\x11.rgs.v1.EquipmentR\tequipment\"\xd6\x02\n" +
+	"\x14ListEquipmentRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
 	"page_token\x18\x03 \x01(\tR\tpageToken\x12<\n" +
-	"\rstatus_filter\x18\x04 \x01(\x0e2\x17.rgs.v1.EquipmentStatusR\fstatusFilter\"\x9a\x01\n" +
+	"\rstatus_filter\x18\x04 \x01(\x0e2\x17.rgs.v1.EquipmentStatusR\fstatusFilter\x12\x19\n" +
+	"\bgroup_id\x18\x05 \x01(\tR\agroupId\x12\x1a\n" +
+	"\blocation\x18\x06 \x01(\tR\blocation\x12\x14\n" +
+	"\x05model\x18\a \x01(\tR\x05model\x126\n" +
+	"\x17control_program_version\x18\b \x01(\tR\x15controlProgramVersion\x12\x16\n" +
+	"\x06search\x18\t \x01(\tR\x06search\"\x9a\x01\n" +
 	"\x15ListEquipmentResponse\x12(\n" +
 	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12/\n" +
 	"\tequipment\x18\x02 \x03(\v2\x11.rgs.v1.EquipmentR\tequipment\x12&\n" +
-	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken*\xce\x01\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\x80\x01\n" +
+	"\x1aCommissionEquipmentRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12!\n" +
+	"\fequipment_id\x18\x02 \x01(\tR\vequipmentId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xba\x01\n" +
+	"\x1bCommissionEquipmentResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12/\n" +
+	"\tequipment\x18\x02 \x01(\v2\x11.rgs.v1.EquipmentR\tequipment\x12@\n" +
+	"\x0fprevious_status\x18\x03 \x01(\x0e2\x17.rgs.v1.EquipmentStatusR\x0epreviousStatus\"~\n" +
+	"\x18ActivateEquipmentRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12!\n" +
+	"\fequipment_id\x18\x02 \x01(\tR\vequipmentId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xb8\x01\n" +
+	"\x19ActivateEquipmentResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12/\n" +
+	"\tequipment\x18\x02 \x01(\v2\x11.rgs.v1.EquipmentR\tequipment\x12@\n" +
+	"\x0fprevious_status\x18\x03 \x01(\x0e2\x17.rgs.v1.EquipmentStatusR\x0epreviousStatus\"\x86\x01\n" +
+	" StartEquipmentMaintenanceRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12!\n" +
+	"\fequipment_id\x18\x02 \x01(\tR\vequipmentId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xc0\x01\n" +
+	"!StartEquipmentMaintenanceResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12/\n" +
+	"\tequipment\x18\x02 \x01(\v2\x11.rgs.v1.EquipmentR\tequipment\x12@\n" +
+	"\x0fprevious_status\x18\x03 \x01(\x0e2\x17.rgs.v1.EquipmentStatusR\x0epreviousStatus\"\x82\x01\n" +
+	"\x1cDecommissionEquipmentRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12!\n" +
+	"\fequipment_id\x18\x02 \x01(\tR\vequipmentId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xbc\x01\n" +
+	"\x1dDecommissionEquipmentResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12/\n" +
+	"\tequipment\x18\x02 \x01(\v2\x11.rgs.v1.EquipmentR\tequipment\x12@\n" +
+	"\x0fprevious_status\x18\x03 \x01(\x0e2\x17.rgs.v1.EquipmentStatusR\x0epreviousStatus\"?\n" +
+	"\x11SoftwareComponent\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06sha256\x18\x02 \x01(\tR\x06sha256\"\xbf\x01\n" +
+	"\x10SoftwareManifest\x12\x14\n" +
+	"\x05model\x18\x01 \x01(\tR\x05model\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x129\n" +
+	"\n" +
+	"components\x18\x03 \x03(\v2\x19.rgs.v1.SoftwareComponentR\n" +
+	"components\x12\x1f\n" +
+	"\vapproved_by\x18\x04 \x01(\tR\n" +
+	"approvedBy\x12\x1f\n" +
+	"\vapproved_at\x18\x05 \x01(\tR\n" +
+	"approvedAt\"\xfb\x01\n" +
+	"\x14SoftwareVerification\x12!\n" +
+	"\fequipment_id\x18\x01 \x01(\tR\vequipmentId\x12\x14\n" +
+	"\x05model\x18\x02 \x01(\tR\x05model\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\x12:\n" +
+	"\x06status\x18\x04 \x01(\x0e2\".rgs.v1.SoftwareVerificationStatusR\x06status\x123\n" +
+	"\x15mismatched_components\x18\x05 \x03(\tR\x14mismatchedComponents\x12\x1f\n" +
+	"\vverified_at\x18\x06 \x01(\tR\n" +
+	"verifiedAt\"\x97\x01\n" +
+	"\x1eApproveSoftwareManifestRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x124\n" +
+	"\bmanifest\x18\x02 \x01(\v2\x18.rgs.v1.SoftwareManifestR\bmanifest\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x81\x01\n" +
+	"\x1fApproveSoftwareManifestResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x124\n" +
+	"\bmanifest\x18\x02 \x01(\v2\x18.rgs.v1.SoftwareManifestR\bmanifest\"]\n" +
+	"\x1cListSoftwareManifestsRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x14\n" +
+	"\x05model\x18\x02 \x01(\tR\x05model\"\x81\x01\n" +
+	"\x1dListSoftwareManifestsResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x126\n" +
+	"\tmanifests\x18\x02 \x03(\v2\x18.rgs.v1.SoftwareManifestR\tmanifests\"\xb8\x01\n" +
+	"\x15VerifySoftwareRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12!\n" +
+	"\fequipment_id\x18\x02 \x01(\tR\vequipmentId\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\x129\n" +
+	"\n" +
+	"components\x18\x04 \x03(\v2\x19.rgs.v1.SoftwareComponentR\n" +
+	"components\"\x84\x01\n" +
+	"\x16VerifySoftwareResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12@\n" +
+	"\fverification\x18\x02 \x01(\v2\x1c.rgs.v1.SoftwareVerificationR\fverification\"\xd7\x02\n" +
+	"\x18ClientCertificateBinding\x12\x1d\n" +
+	"\n" +
+	"binding_id\x18\x01 \x01(\tR\tbindingId\x12-\n" +
+	"\x12fingerprint_sha256\x18\x02 \x01(\tR\x11fingerprintSha256\x12\x10\n" +
+	"\x03san\x18\x03 \x01(\tR\x03san\x12\x19\n" +
+	"\bactor_id\x18\x04 \x01(\tR\aactorId\x12>\n" +
+	"\x06status\x18\x05 \x01(\x0e2&.rgs.v1.ClientCertificateBindingStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x06 \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"revoked_at\x18\b \x01(\tR\trevokedAt\x12#\n" +
+	"\rrevoke_reason\x18\t \x01(\tR\frevokeReason\"\x8c\x01\n" +
+	"\x1bUpsertEquipmentGroupRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12,\n" +
+	"\x05group\x18\x02 \x01(\v2\x16.rgs.v1.EquipmentGroupR\x05group\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"v\n" +
+	"\x1cUpsertEquipmentGroupResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12,\n" +
+	"\x05group\x18\x02 \x01(\v2\x16.rgs.v1.EquipmentGroupR\x05group\"\x8e\x01\n" +
+	"\x1aListEquipmentGroupsRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12.\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x1a.rgs.v1.EquipmentGroupKindR\x04kind\x12\x17\n" +
+	"\azone_id\x18\x03 \x01(\tR\x06zoneId\"w\n" +
+	"\x1bListEquipmentGroupsResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12.\n" +
+	"\x06groups\x18\x02 \x03(\v2\x16.rgs.v1.EquipmentGroupR\x06groups\"\x9c\x01\n" +
+	"\x1bAssignEquipmentGroupRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12!\n" +
+	"\fequipment_id\x18\x02 \x01(\tR\vequipmentId\x12\x19\n" +
+	"\bgroup_id\x18\x03 \x01(\tR\agroupId\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"y\n" +
+	"\x1cAssignEquipmentGroupResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12/\n" +
+	"\tequipment\x18\x02 \x01(\v2\x11.rgs.v1.EquipmentR\tequipment\"\xbe\x01\n" +
+	"\x16EnrollEquipmentRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12!\n" +
+	"\fequipment_id\x18\x02 \x01(\tR\vequipmentId\x12\x17\n" +
+	"\acsr_pem\x18\x03 \x01(\tR\x06csrPem\x12'\n" +
+	"\x0fcertificate_pem\x18\x04 \x01(\tR\x0ecertificatePem\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"\xd6\x01\n" +
+	"\x17EnrollEquipmentResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12:\n" +
+	"\abinding\x18\x02 \x01(\v2 .rgs.v1.ClientCertificateBindingR\abinding\x12'\n" +
+	"\x0fcertificate_pem\x18\x03 \x01(\tR\x0ecertificatePem\x12,\n" +
+	"\x12ca_certificate_pem\x18\x04 \x01(\tR\x10caCertificatePem\"\x9f\x01\n" +
+	" RegisterClientCertificateRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12:\n" +
+	"\abinding\x18\x02 \x01(\v2 .rgs.v1.ClientCertificateBindingR\abinding\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x89\x01\n" +
+	"!RegisterClientCertificateResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12:\n" +
+	"\abinding\x18\x02 \x01(\v2 .rgs.v1.ClientCertificateBindingR\abinding\"\xc8\x01\n" +
+	"\x1dListClientCertificatesRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\tR\aactorId\x12'\n" +
+	"\x0finclude_revoked\x18\x03 \x01(\bR\x0eincludeRevoked\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\"\xb0\x01\n" +
+	"\x1eListClientCertificatesResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12<\n" +
+	"\bbindings\x18\x02 \x03(\v2 .rgs.v1.ClientCertificateBindingR\bbindings\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\x80\x01\n" +
+	"\x1eRevokeClientCertificateRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x1d\n" +
+	"\n" +
+	"binding_id\x18\x02 \x01(\tR\tbindingId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x87\x01\n" +
+	"\x1fRevokeClientCertificateResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12:\n" +
+	"\abinding\x18\x02 \x01(\v2 .rgs.v1.ClientCertificateBindingR\abinding*\xb7\x02\n" +
 	"\x0fEquipmentStatus\x12 \n" +
 	"\x1cEQUIPMENT_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
 	"\x17EQUIPMENT_STATUS_ACTIVE\x10\x01\x12\x1d\n" +
 	"\x19EQUIPMENT_STATUS_INACTIVE\x10\x02\x12 \n" +
 	"\x1cEQUIPMENT_STATUS_MAINTENANCE\x10\x03\x12\x1d\n" +
 	"\x19EQUIPMENT_STATUS_DISABLED\x10\x04\x12\x1c\n" +
-	"\x18EQUIPMENT_STATUS_RETIRED\x10\x052\x8a\x03\n" +
+	"\x18EQUIPMENT_STATUS_RETIRED\x10\x05\x12\x1f\n" +
+	"\x1bEQUIPMENT_STATUS_REGISTERED\x10\x06\x12!\n" +
+	"\x1dEQUIPMENT_STATUS_COMMISSIONED\x10\a\x12#\n" +
+	"\x1fEQUIPMENT_STATUS_DECOMMISSIONED\x10\b*x\n" +
+	"\x12EquipmentGroupKind\x12$\n" +
+	" EQUIPMENT_GROUP_KIND_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19EQUIPMENT_GROUP_KIND_ZONE\x10\x01\x12\x1d\n" +
+	"\x19EQUIPMENT_GROUP_KIND_BANK\x10\x02*\xa0\x01\n" +
+	"\x1aSoftwareVerificationStatus\x12,\n" +
+	"(SOFTWARE_VERIFICATION_STATUS_UNSPECIFIED\x10\x00\x12)\n" +
+	"%SOFTWARE_VERIFICATION_STATUS_VERIFIED\x10\x01\x12)\n" +
+	"%SOFTWARE_VERIFICATION_STATUS_MISMATCH\x10\x02*\xb0\x01\n" +
+	"\x1eClientCertificateBindingStatus\x121\n" +
+	"-CLIENT_CERTIFICATE_BINDING_STATUS_UNSPECIFIED\x10\x00\x12,\n" +
+	"(CLIENT_CERTIFICATE_BINDING_STATUS_ACTIVE\x10\x01\x12-\n" +
+	")CLIENT_CERTIFICATE_BINDING_STATUS_REVOKED\x10\x022\xe4\x13\n" +
 	"\x0fRegistryService\x12\x8e\x01\n" +
 	"\x0fUpsertEquipment\x12\x1e.rgs.v1.UpsertEquipmentRequest\x1a\x1f.rgs.v1.UpsertEquipmentResponse\":\x82\xd3\xe4\x93\x024:\x01*\x1a//v1/registry/equipment/{equipment.equipment_id}\x12x\n" +
 	"\fGetEquipment\x12\x1b.rgs.v1.GetEquipmentRequest\x1a\x1c.rgs.v1.GetEquipmentResponse\"-\x82\xd3\xe4\x93\x02'\x12%/v1/registry/equipment/{equipment_id}\x12l\n" +
-	"\rListEquipment\x12\x1c.rgs.v1.ListEquipmentRequest\x1a\x1d.rgs.v1.ListEquipmentResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/registry/equipmentB\x8f\x01\n" +
+	"\rListEquipment\x12\x1c.rgs.v1.ListEquipmentRequest\x1a\x1d.rgs.v1.ListEquipmentResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/registry/equipment\x12\x9b\x01\n" +
+	"\x13CommissionEquipment\x12\".rgs.v1.CommissionEquipmentRequest\x1a#.rgs.v1.CommissionEquipmentResponse\";\x82\xd3\xe4\x93\x025:\x01*\"0/v1/registry/equipment/{equipment_id}:commission\x12\x93\x01\n" +
+	"\x11ActivateEquipment\x12 .rgs.v1.ActivateEquipmentRequest\x1a!.rgs.v1.ActivateEquipmentResponse\"9\x82\xd3\xe4\x93\x023:\x01*\"./v1/registry/equipment/{equipment_id}:activate\x12\xae\x01\n" +
+	"\x19StartEquipmentMaintenance\x12(.rgs.v1.StartEquipmentMaintenanceRequest\x1a).rgs.v1.StartEquipmentMaintenanceResponse\"<\x82\xd3\xe4\x93\x026:\x01*\"1/v1/registry/equipment/{equipment_id}:maintenance\x12\xa3\x01\n" +
+	"\x15DecommissionEquipment\x12$.rgs.v1.DecommissionEquipmentRequest\x1a%.rgs.v1.DecommissionEquipmentResponse\"=\x82\xd3\xe4\x93\x027:\x01*\"2/v1/registry/equipment/{equipment_id}:decommission\x12\x96\x01\n" +
+	"\x17ApproveSoftwareManifest\x12&.rgs.v1.ApproveSoftwareManifestRequest\x1a'.rgs.v1.ApproveSoftwareManifestResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/registry/software-manifests\x12\x8d\x01\n" +
+	"\x15ListSoftwareManifests\x12$.rgs.v1.ListSoftwareManifestsRequest\x1a%.rgs.v1.ListSoftwareManifestsResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/registry/software-manifests\x12\x90\x01\n" +
+	"\x0eVerifySoftware\x12\x1d.rgs.v1.VerifySoftwareRequest\x1a\x1e.rgs.v1.VerifySoftwareResponse\"?\x82\xd3\xe4\x93\x029:\x01*\"4/v1/registry/equipment/{equipment_id}:verifySoftware\x12\x8b\x01\n" +
+	"\x0fEnrollEquipment\x12\x1e.rgs.v1.EnrollEquipmentRequest\x1a\x1f.rgs.v1.EnrollEquipmentResponse\"7\x82\xd3\xe4\x93\x021:\x01*\",/v1/registry/equipment/{equipment_id}:enroll\x12\x81\x01\n" +
+	"\x14UpsertEquipmentGroup\x12#.rgs.v1.UpsertEquipmentGroupRequest\x1a$.rgs.v1.UpsertEquipmentGroupResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/registry/groups\x12{\n" +
+	"\x13ListEquipmentGroups\x12\".rgs.v1.ListEquipmentGroupsRequest\x1a#.rgs.v1.ListEquipmentGroupsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/registry/groups\x12\x9f\x01\n" +
+	"\x14AssignEquipmentGroup\x12#.rgs.v1.AssignEquipmentGroupRequest\x1a$.rgs.v1.AssignEquipmentGroupResponse\"<\x82\xd3\xe4\x93\x026:\x01*\"1/v1/registry/equipment/{equipment_id}:assignGroup\x12\x9d\x01\n" +
+	"\x19RegisterClientCertificate\x12(.rgs.v1.RegisterClientCertificateRequest\x1a).rgs.v1.RegisterClientCertificateResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/registry/client-certificates\x12\x91\x01\n" +
+	"\x16ListClientCertificates\x12%.rgs.v1.ListClientCertificatesRequest\x1a&.rgs.v1.ListClientCertificatesResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /v1/registry/client-certificates\x12\xab\x01\n" +
+	"\x17RevokeClientCertificate\x12&.rgs.v1.RevokeClientCertificateRequest\x1a'.rgs.v1.RevokeClientCertificateResponse\"?\x82\xd3\xe4\x93\x029:\x01*\"4/v1/registry/client-certificates/{binding_id}/revokeB\x8f\x01\n" +
 	"\n" +
 	"com.rgs.v1B\rRegistryProtoP\x01Z9github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1;rgsv1\xa2\x02\x03RXX\xaa\x02\x06Rgs.V1\xca\x02\x06Rgs\\V1\xe2\x02\x12Rgs\\V1\\GPBMetadata\xea\x02\aRgs::V1b\x06proto3"
 
@@ -613,46 +3128,165 @@ func file_rgs_v1_registry_proto_rawDescGZIP() []byte {
 	return file_rgs_v1_registry_proto_rawDescData
 }
 
-var file_rgs_v1_registry_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_rgs_v1_registry_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_rgs_v1_registry_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_rgs_v1_registry_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
 var file_rgs_v1_registry_proto_goTypes = []any{
-	(EquipmentStatus)(0),            // 0: rgs.v1.EquipmentStatus
-	(*Equipment)(nil),               // 1: rgs.v1.Equipment
-	(*UpsertEquipmentRequest)(nil),  // 2: rgs.v1.UpsertEquipmentRequest
-	(*UpsertEquipmentResponse)(nil), // 3: rgs.v1.UpsertEquipmentResponse
-	(*GetEquipmentRequest)(nil),     // 4: rgs.v1.GetEquipmentRequest
-	(*GetEquipmentResponse)(nil),    // 5: rgs.v1.GetEquipmentResponse
-	(*ListEquipmentRequest)(nil),    // 6: rgs.v1.ListEquipmentRequest
-	(*ListEquipmentResponse)(nil),   // 7: rgs.v1.ListEquipmentResponse
-	nil,                             // 8: rgs.v1.Equipment.AttributesEntry
-	(*RequestMeta)(nil),             // 9: rgs.v1.RequestMeta
-	(*ResponseMeta)(nil),            // 10: rgs.v1.ResponseMeta
+	(EquipmentStatus)(0),                      // 0: rgs.v1.EquipmentStatus
+	(EquipmentGroupKind)(0),                   // 1: rgs.v1.EquipmentGroupKind
+	(SoftwareVerificationStatus)(0),           // 2: rgs.v1.SoftwareVerificationStatus
+	(ClientCertificateBindingStatus)(0),       // 3: rgs.v1.ClientCertificateBindingStatus
+	(*Equipment)(nil),                         // 4: rgs.v1.Equipment
+	(*EquipmentGroup)(nil),                    // 5: rgs.v1.EquipmentGroup
+	(*UpsertEquipmentRequest)(nil),            // 6: rgs.v1.UpsertEquipmentRequest
+	(*UpsertEquipmentResponse)(nil),           // 7: rgs.v1.UpsertEquipmentResponse
+	(*GetEquipmentRequest)(nil),               // 8: rgs.v1.GetEquipmentRequest
+	(*GetEquipmentResponse)(nil),              // 9: rgs.v1.GetEquipmentResponse
+	(*ListEquipmentRequest)(nil),              // 10: rgs.v1.ListEquipmentRequest
+	(*ListEquipmentResponse)(nil),             // 11: rgs.v1.ListEquipmentResponse
+	(*CommissionEquipmentRequest)(nil),        // 12: rgs.v1.CommissionEquipmentRequest
+	(*CommissionEquipmentResponse)(nil),       // 13: rgs.v1.CommissionEquipmentResponse
+	(*ActivateEquipmentRequest)(nil),          // 14: rgs.v1.ActivateEquipmentRequest
+	(*ActivateEquipmentResponse)(nil),         // 15: rgs.v1.ActivateEquipmentResponse
+	(*StartEquipmentMaintenanceRequest)(nil),  // 16: rgs.v1.StartEquipmentMaintenanceRequest
+	(*StartEquipmentMaintenanceResponse)(nil), // 17: rgs.v1.StartEquipmentMaintenanceResponse
+	(*DecommissionEquipmentRequest)(nil),      // 18: rgs.v1.DecommissionEquipmentRequest
+	(*DecommissionEquipmentResponse)(nil),     // 19: rgs.v1.DecommissionEquipmentResponse
+	(*SoftwareComponent)(nil),                 // 20: rgs.v1.SoftwareComponent
+	(*SoftwareManifest)(nil),                  // 21: rgs.v1.SoftwareManifest
+	(*SoftwareVerification)(nil),              // 22: rgs.v1.SoftwareVerification
+	(*ApproveSoftwareManifestRequest)(nil),    // 23: rgs.v1.ApproveSoftwareManifestRequest
+	(*ApproveSoftwareManifestResponse)(nil),   // 24: rgs.v1.ApproveSoftwareManifestResponse
+	(*ListSoftwareManifestsRequest)(nil),      // 25: rgs.v1.ListSoftwareManifestsRequest
+	(*ListSoftwareManifestsResponse)(nil),     // 26: rgs.v1.ListSoftwareManifestsResponse
+	(*VerifySoftwareRequest)(nil),             // 27: rgs.v1.VerifySoftwareRequest
+	(*VerifySoftwareResponse)(nil),            // 28: rgs.v1.VerifySoftwareResponse
+	(*ClientCertificateBinding)(nil),          // 29: rgs.v1.ClientCertificateBinding
+	(*UpsertEquipmentGroupRequest)(nil),       // 30: rgs.v1.UpsertEquipmentGroupRequest
+	(*UpsertEquipmentGroupResponse)(nil),      // 31: rgs.v1.UpsertEquipmentGroupResponse
+	(*ListEquipmentGroupsRequest)(nil),        // 32: rgs.v1.ListEquipmentGroupsRequest
+	(*ListEquipmentGroupsResponse)(nil),       // 33: rgs.v1.ListEquipmentGroupsResponse
+	(*AssignEquipmentGroupRequest)(nil),       // 34: rgs.v1.AssignEquipmentGroupRequest
+	(*AssignEquipmentGroupResponse)(nil),      // 35: rgs.v1.AssignEquipmentGroupResponse
+	(*EnrollEquipmentRequest)(nil),            // 36: rgs.v1.EnrollEquipmentRequest
+	(*EnrollEquipmentResponse)(nil),           // 37: rgs.v1.EnrollEquipmentResponse
+	(*RegisterClientCertificateRequest)(nil),  // 38: rgs.v1.RegisterClientCertificateRequest
+	(*RegisterClientCertificateResponse)(nil), // 39: rgs.v1.RegisterClientCertificateResponse
+	(*ListClientCertificatesRequest)(nil),     // 40: rgs.v1.ListClientCertificatesRequest
+	(*ListClientCertificatesResponse)(nil),    // 41: rgs.v1.ListClientCertificatesResponse
+	(*RevokeClientCertificateRequest)(nil),    // 42: rgs.v1.RevokeClientCertificateRequest
+	(*RevokeClientCertificateResponse)(nil),   // 43: rgs.v1.RevokeClientCertificateResponse
+	nil,                                       // 44: rgs.v1.Equipment.AttributesEntry
+	(*RequestMeta)(nil),                       // 45: rgs.v1.RequestMeta
+	(*ResponseMeta)(nil),                      // 46: rgs.v1.ResponseMeta
 }
 var file_rgs_v1_registry_proto_depIdxs = []int32{
 	0,  // 0: rgs.v1.Equipment.status:type_name -> rgs.v1.EquipmentStatus
-	8,  // 1: rgs.v1.Equipment.attributes:type_name -> rgs.v1.Equipment.AttributesEntry
-	9,  // 2: rgs.v1.UpsertEquipmentRequest.meta:type_name -> rgs.v1.RequestMeta
-	1,  // 3: rgs.v1.UpsertEquipmentRequest.equipment:type_name -> rgs.v1.Equipment
-	10, // 4: rgs.v1.UpsertEquipmentResponse.meta:type_name -> rgs.v1.ResponseMeta
-	1,  // 5: rgs.v1.UpsertEquipmentResponse.equipment:type_name -> rgs.v1.Equipment
-	9,  // 6: rgs.v1.GetEquipmentRequest.meta:type_name -> rgs.v1.RequestMeta
-	10, // 7: rgs.v1.GetEquipmentResponse.meta:type_name -> rgs.v1.ResponseMeta
-	1,  // 8: rgs.v1.GetEquipmentResponse.equipment:type_name -> rgs.v1.Equipment
-	9,  // 9: rgs.v1.ListEquipmentRequest.meta:type_name -> rgs.v1.RequestMeta
-	0,  // 10: rgs.v1.ListEquipmentRequest.status_filter:type_name -> rgs.v1.EquipmentStatus
-	10, // 11: rgs.v1.ListEquipmentResponse.meta:type_name -> rgs.v1.ResponseMeta
-	1,  // 12: rgs.v1.ListEquipmentResponse.equipment:type_name -> rgs.v1.Equipment
-	2,  // 13: rgs.v1.RegistryService.UpsertEquipment:input_type -> rgs.v1.UpsertEquipmentRequest
-	4,  // 14: rgs.v1.RegistryService.GetEquipment:input_type -> rgs.v1.GetEquipmentRequest
-	6,  // 15: rgs.v1.RegistryService.ListEquipment:input_type -> rgs.v1.ListEquipmentRequest
-	3,  // 16: rgs.v1.RegistryService.UpsertEquipment:output_type -> rgs.v1.UpsertEquipmentResponse
-	5,  // 17: rgs.v1.RegistryService.GetEquipment:output_type -> rgs.v1.GetEquipmentResponse
-	7,  // 18: rgs.v1.RegistryService.ListEquipment:output_type -> rgs.v1.ListEquipmentResponse
-	16, // [16:19] is the sub-list for method output_type
-	13, // [13:16] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	44, // 1: rgs.v1.Equipment.attributes:type_name -> rgs.v1.Equipment.AttributesEntry
+	1,  // 2: rgs.v1.EquipmentGroup.kind:type_name -> rgs.v1.EquipmentGroupKind
+	45, // 3: rgs.v1.UpsertEquipmentRequest.meta:type_name -> rgs.v1.RequestMeta
+	4,  // 4: rgs.v1.UpsertEquipmentRequest.equipment:type_name -> rgs.v1.Equipment
+	46, // 5: rgs.v1.UpsertEquipmentResponse.meta:type_name -> rgs.v1.ResponseMeta
+	4,  // 6: rgs.v1.UpsertEquipmentResponse.equipment:type_name -> rgs.v1.Equipment
+	45, // 7: rgs.v1.GetEquipmentRequest.meta:type_name -> rgs.v1.RequestMeta
+	46, // 8: rgs.v1.GetEquipmentResponse.meta:type_name -> rgs.v1.ResponseMeta
+	4,  // 9: rgs.v1.GetEquipmentResponse.equipment:type_name -> rgs.v1.Equipment
+	45, // 10: rgs.v1.ListEquipmentRequest.meta:type_name -> rgs.v1.RequestMeta
+	0,  // 11: rgs.v1.ListEquipmentRequest.status_filter:type_name -> rgs.v1.EquipmentStatus
+	46, // 12: rgs.v1.ListEquipmentResponse.meta:type_name -> rgs.v1.ResponseMeta
+	4,  // 13: rgs.v1.ListEquipmentResponse.equipment:type_name -> rgs.v1.Equipment
+	45, // 14: rgs.v1.CommissionEquipmentRequest.meta:type_name -> rgs.v1.RequestMeta
+	46, // 15: rgs.v1.CommissionEquipmentResponse.meta:type_name -> rgs.v1.ResponseMeta
+	4,  // 16: rgs.v1.CommissionEquipmentResponse.equipment:type_name -> rgs.v1.Equipment
+	0,  // 17: rgs.v1.CommissionEquipmentResponse.previous_status:type_name -> rgs.v1.EquipmentStatus
+	45, // 18: rgs.v1.ActivateEquipmentRequest.meta:type_name -> rgs.v1.RequestMeta
+	46, // 19: rgs.v1.ActivateEquipmentResponse.meta:type_name -> rgs.v1.ResponseMeta
+	4,  // 20: rgs.v1.ActivateEquipmentResponse.equipment:type_name -> rgs.v1.Equipment
+	0,  // 21: rgs.v1.ActivateEquipmentResponse.previous_status:type_name -> rgs.v1.EquipmentStatus
+	45, // 22: rgs.v1.StartEquipmentMaintenanceRequest.meta:type_name -> rgs.v1.RequestMeta
+	46, // 23: rgs.v1.StartEquipmentMaintenanceResponse.meta:type_name -> rgs.v1.ResponseMeta
+	4,  // 24: rgs.v1.StartEquipmentMaintenanceResponse.equipment:type_name -> rgs.v1.Equipment
+	0,  // 25: rgs.v1.StartEquipmentMaintenanceResponse.previous_status:type_name -> rgs.v1.EquipmentStatus
+	45, // 26: rgs.v1.DecommissionEquipmentRequest.meta:type_name -> rgs.v1.RequestMeta
+	46, // 27: rgs.v1.DecommissionEquipmentResponse.meta:type_name -> rgs.v1.ResponseMeta
+	4,  // 28: rgs.v1.DecommissionEquipmentResponse.equipment:type_name -> rgs.v1.Equipment
+	0,  // 29: rgs.v1.DecommissionEquipmentResponse.previous_status:type_name -> rgs.v1.EquipmentStatus
+	20, // 30: rgs.v1.SoftwareManifest.components:type_name -> rgs.v1.SoftwareComponent
+	2,  // 31: rgs.v1.SoftwareVerification.status:type_name -> rgs.v1.SoftwareVerificationStatus
+	45, // 32: rgs.v1.ApproveSoftwareManifestRequest.meta:type_name -> rgs.v1.RequestMeta
+	21, // 33: rgs.v1.ApproveSoftwareManifestRequest.manifest:type_name -> rgs.v1.SoftwareManifest
+	46, // 34: rgs.v1.ApproveSoftwareManifestResponse.meta:type_name -> rgs.v1.ResponseMeta
+	21, // 35: rgs.v1.ApproveSoftwareManifestResponse.manifest:type_name -> rgs.v1.SoftwareManifest
+	45, // 36: rgs.v1.ListSoftwareManifestsRequest.meta:type_name -> rgs.v1.RequestMeta
+	46, // 37: rgs.v1.ListSoftwareManifestsResponse.meta:type_name -> rgs.v1.ResponseMeta
+	21, // 38: rgs.v1.ListSoftwareManifestsResponse.manifests:type_name -> rgs.v1.SoftwareManifest
+	45, // 39: rgs.v1.VerifySoftwareRequest.meta:type_name -> rgs.v1.RequestMeta
+	20, // 40: rgs.v1.VerifySoftwareRequest.components:type_name -> rgs.v1.SoftwareComponent
+	46, // 41: rgs.v1.VerifySoftwareResponse.meta:type_name -> rgs.v1.ResponseMeta
+	22, // 42: rgs.v1.VerifySoftwareResponse.verification:type_name -> rgs.v1.SoftwareVerification
+	3,  // 43: rgs.v1.ClientCertificateBinding.status:type_name -> rgs.v1.ClientCertificateBindingStatus
+	45, // 44: rgs.v1.UpsertEquipmentGroupRequest.meta:type_name -> rgs.v1.RequestMeta
+	5,  // 45: rgs.v1.UpsertEquipmentGroupRequest.group:type_name -> rgs.v1.EquipmentGroup
+	46, // 46: rgs.v1.UpsertEquipmentGroupResponse.meta:type_name -> rgs.v1.ResponseMeta
+	5,  // 47: rgs.v1.UpsertEquipmentGroupResponse.group:type_name -> rgs.v1.EquipmentGroup
+	45, // 48: rgs.v1.ListEquipmentGroupsRequest.meta:type_name -> rgs.v1.RequestMeta
+	1,  // 49: rgs.v1.ListEquipmentGroupsRequest.kind:type_name -> rgs.v1.EquipmentGroupKind
+	46, // 50: rgs.v1.ListEquipmentGroupsResponse.meta:type_name -> rgs.v1.ResponseMeta
+	5,  // 51: rgs.v1.ListEquipmentGroupsResponse.groups:type_name -> rgs.v1.EquipmentGroup
+	45, // 52: rgs.v1.AssignEquipmentGroupRequest.meta:type_name -> rgs.v1.RequestMeta
+	46, // 53: rgs.v1.AssignEquipmentGroupResponse.meta:type_name -> rgs.v1.ResponseMeta
+	4,  // 54: rgs.v1.AssignEquipmentGroupResponse.equipment:type_name -> rgs.v1.Equipment
+	45, // 55: rgs.v1.EnrollEquipmentRequest.meta:type_name -> rgs.v1.RequestMeta
+	46, // 56: rgs.v1.EnrollEquipmentResponse.meta:type_name -> rgs.v1.ResponseMeta
+	29, // 57: rgs.v1.EnrollEquipmentResponse.binding:type_name -> rgs.v1.ClientCertificateBinding
+	45, // 58: rgs.v1.RegisterClientCertificateRequest.meta:type_name -> rgs.v1.RequestMeta
+	29, // 59: rgs.v1.RegisterClientCertificateRequest.binding:type_name -> rgs.v1.ClientCertificateBinding
+	46, // 60: rgs.v1.RegisterClientCertificateResponse.meta:type_name -> rgs.v1.ResponseMeta
+	29, // 61: rgs.v1.RegisterClientCertificateResponse.binding:type_name -> rgs.v1.ClientCertificateBinding
+	45, // 62: rgs.v1.ListClientCertificatesRequest.meta:type_name -> rgs.v1.RequestMeta
+	46, // 63: rgs.v1.ListClientCertificatesResponse.meta:type_name -> rgs.v1.ResponseMeta
+	29, // 64: rgs.v1.ListClientCertificatesResponse.bindings:type_name -> rgs.v1.ClientCertificateBinding
+	45, // 65: rgs.v1.RevokeClientCertificateRequest.meta:type_name -> rgs.v1.RequestMeta
+	46, // 66: rgs.v1.RevokeClientCertificateResponse.meta:type_name -> rgs.v1.ResponseMeta
+	29, // 67: rgs.v1.RevokeClientCertificateResponse.binding:type_name -> rgs.v1.ClientCertificateBinding
+	6,  // 68: rgs.v1.RegistryService.UpsertEquipment:input_type -> rgs.v1.UpsertEquipmentRequest
+	8,  // 69: rgs.v1.RegistryService.GetEquipment:input_type -> rgs.v1.GetEquipmentRequest
+	10, // 70: rgs.v1.RegistryService.ListEquipment:input_type -> rgs.v1.ListEquipmentRequest
+	12, // 71: rgs.v1.RegistryService.CommissionEquipment:input_type -> rgs.v1.CommissionEquipmentRequest
+	14, // 72: rgs.v1.RegistryService.ActivateEquipment:input_type -> rgs.v1.ActivateEquipmentRequest
+	16, // 73: rgs.v1.RegistryService.StartEquipmentMaintenance:input_type -> rgs.v1.StartEquipmentMaintenanceRequest
+	18, // 74: rgs.v1.RegistryService.DecommissionEquipment:input_type -> rgs.v1.DecommissionEquipmentRequest
+	23, // 75: rgs.v1.RegistryService.ApproveSoftwareManifest:input_type -> rgs.v1.ApproveSoftwareManifestRequest
+	25, // 76: rgs.v1.RegistryService.ListSoftwareManifests:input_type -> rgs.v1.ListSoftwareManifestsRequest
+	27, // 77: rgs.v1.RegistryService.VerifySoftware:input_type -> rgs.v1.VerifySoftwareRequest
+	36, // 78: rgs.v1.RegistryService.EnrollEquipment:input_type -> rgs.v1.EnrollEquipmentRequest
+	30, // 79: rgs.v1.RegistryService.UpsertEquipmentGroup:input_type -> rgs.v1.UpsertEquipmentGroupRequest
+	32, // 80: rgs.v1.RegistryService.ListEquipmentGroups:input_type -> rgs.v1.ListEquipmentGroupsRequest
+	34, // 81: rgs.v1.RegistryService.AssignEquipmentGroup:input_type -> rgs.v1.AssignEquipmentGroupRequest
+	38, // 82: rgs.v1.RegistryService.RegisterClientCertificate:input_type -> rgs.v1.RegisterClientCertificateRequest
+	40, // 83: rgs.v1.RegistryService.ListClientCertificates:input_type -> rgs.v1.ListClientCertificatesRequest
+	42, // 84: rgs.v1.RegistryService.RevokeClientCertificate:input_type -> rgs.v1.RevokeClientCertificateRequest
+	7,  // 85: rgs.v1.RegistryService.UpsertEquipment:output_type -> rgs.v1.UpsertEquipmentResponse
+	9,  // 86: rgs.v1.RegistryService.GetEquipment:output_type -> rgs.v1.GetEquipmentResponse
+	11, // 87: rgs.v1.RegistryService.ListEquipment:output_type -> rgs.v1.ListEquipmentResponse
+	13, // 88: rgs.v1.RegistryService.CommissionEquipment:output_type -> rgs.v1.CommissionEquipmentResponse
+	15, // 89: rgs.v1.RegistryService.ActivateEquipment:output_type -> rgs.v1.ActivateEquipmentResponse
+	17, // 90: rgs.v1.RegistryService.StartEquipmentMaintenance:output_type -> rgs.v1.StartEquipmentMaintenanceResponse
+	19, // 91: rgs.v1.RegistryService.DecommissionEquipment:output_type -> rgs.v1.DecommissionEquipmentResponse
+	24, // 92: rgs.v1.RegistryService.ApproveSoftwareManifest:output_type -> rgs.v1.ApproveSoftwareManifestResponse
+	26, // 93: rgs.v1.RegistryService.ListSoftwareManifests:output_type -> rgs.v1.ListSoftwareManifestsResponse
+	28, // 94: rgs.v1.RegistryService.VerifySoftware:output_type -> rgs.v1.VerifySoftwareResponse
+	37, // 95: rgs.v1.RegistryService.EnrollEquipment:output_type -> rgs.v1.EnrollEquipmentResponse
+	31, // 96: rgs.v1.RegistryService.UpsertEquipmentGroup:output_type -> rgs.v1.UpsertEquipmentGroupResponse
+	33, // 97: rgs.v1.RegistryService.ListEquipmentGroups:output_type -> rgs.v1.ListEquipmentGroupsResponse
+	35, // 98: rgs.v1.RegistryService.AssignEquipmentGroup:output_type -> rgs.v1.AssignEquipmentGroupResponse
+	39, // 99: rgs.v1.RegistryService.RegisterClientCertificate:output_type -> rgs.v1.RegisterClientCertificateResponse
+	41, // 100: rgs.v1.RegistryService.ListClientCertificates:output_type -> rgs.v1.ListClientCertificatesResponse
+	43, // 101: rgs.v1.RegistryService.RevokeClientCertificate:output_type -> rgs.v1.RevokeClientCertificateResponse
+	85, // [85:102] is the sub-list for method output_type
+	68, // [68:85] is the sub-list for method input_type
+	68, // [68:68] is the sub-list for extension type_name
+	68, // [68:68] is the sub-list for extension extendee
+	0,  // [0:68] is the sub-list for field type_name
 }
 
 func init() { file_rgs_v1_registry_proto_init() }
@@ -666,8 +3300,8 @@ func file_rgs_v1_registry_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rgs_v1_registry_proto_rawDesc), len(file_rgs_v1_registry_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   8,
+			NumEnums:      4,
+			NumMessages:   41,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,9 +19,23 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	RegistryService_UpsertEquipment_FullMethodName = "/rgs.v1.RegistryService/UpsertEquipment"
-	RegistryService_GetEquipment_FullMethodName    = "/rgs.v1.RegistryService/GetEquipment"
-	RegistryService_ListEquipment_FullMethodName   = "/rgs.v1.RegistryService/ListEquipment"
+	RegistryService_UpsertEquipment_FullMethodName           = "/rgs.v1.RegistryService/UpsertEquipment"
+	RegistryService_GetEquipment_FullMethodName              = "/rgs.v1.RegistryService/GetEquipment"
+	RegistryService_ListEquipment_FullMethodName             = "/rgs.v1.RegistryService/ListEquipment"
+	RegistryService_CommissionEquipment_FullMethodName       = "/rgs.v1.RegistryService/CommissionEquipment"
+	RegistryService_ActivateEquipment_FullMethodName         = "/rgs.v1.RegistryService/ActivateEquipment"
+	RegistryService_StartEquipmentMaintenance_FullMethodName = "/rgs.v1.RegistryService/StartEquipmentMaintenance"
+	RegistryService_DecommissionEquipment_FullMethodName     = "/rgs.v1.RegistryService/DecommissionEquipment"
+	RegistryService_ApproveSoftwareManifest_FullMethodName   = "/rgs.v1.RegistryService/ApproveSoftwareManifest"
+	RegistryService_ListSoftwareManifests_FullMethodName     = "/rgs.v1.RegistryService/ListSoftwareManifests"
+	RegistryService_VerifySoftware_FullMethodName            = "/rgs.v1.RegistryService/VerifySoftware"
+	RegistryService_EnrollEquipment_FullMethodName           = "/rgs.v1.RegistryService/EnrollEquipment"
+	RegistryService_UpsertEquipmentGroup_FullMethodName      = "/rgs.v1.RegistryService/UpsertEquipmentGroup"
+	RegistryService_ListEquipmentGroups_FullMethodName       = "/rgs.v1.RegistryService/ListEquipmentGroups"
+	RegistryService_AssignEquipmentGroup_FullMethodName      = "/rgs.v1.RegistryService/AssignEquipmentGroup"
+	RegistryService_RegisterClientCertificate_FullMethodName = "/rgs.v1.RegistryService/RegisterClientCertificate"
+	RegistryService_ListClientCertificates_FullMethodName    = "/rgs.v1.RegistryService/ListClientCertificates"
+	RegistryService_RevokeClientCertificate_FullMethodName   = "/rgs.v1.RegistryService/RevokeClientCertificate"
 )
 
 // RegistryServiceClient is the client API for RegistryService service.
@@ -31,6 +45,35 @@ type RegistryServiceClient interface {
 	UpsertEquipment(ctx context.Context, in *UpsertEquipmentRequest, opts ...grpc.CallOption) (*UpsertEquipmentResponse, error)
 	GetEquipment(ctx context.Context, in *GetEquipmentRequest, opts ...grpc.CallOption) (*GetEquipmentResponse, error)
 	ListEquipment(ctx context.Context, in *ListEquipmentRequest, opts ...grpc.CallOption) (*ListEquipmentResponse, error)
+	// Registered to commissioned, once the equipment is installed and
+	// verified on the floor.
+	CommissionEquipment(ctx context.Context, in *CommissionEquipmentRequest, opts ...grpc.CallOption) (*CommissionEquipmentResponse, error)
+	// Commissioned or maintenance to active.
+	ActivateEquipment(ctx context.Context, in *ActivateEquipmentRequest, opts ...grpc.CallOption) (*ActivateEquipmentResponse, error)
+	// Active to maintenance.
+	StartEquipmentMaintenance(ctx context.Context, in *StartEquipmentMaintenanceRequest, opts ...grpc.CallOption) (*StartEquipmentMaintenanceResponse, error)
+	// Any state except decommissioned to decommissioned, which is final.
+	DecommissionEquipment(ctx context.Context, in *DecommissionEquipmentRequest, opts ...grpc.CallOption) (*DecommissionEquipmentResponse, error)
+	// Approves the software/firmware hashes of one version of a model,
+	// replacing any earlier approval of the same version.
+	ApproveSoftwareManifest(ctx context.Context, in *ApproveSoftwareManifestRequest, opts ...grpc.CallOption) (*ApproveSoftwareManifestResponse, error)
+	ListSoftwareManifests(ctx context.Context, in *ListSoftwareManifestsRequest, opts ...grpc.CallOption) (*ListSoftwareManifestsResponse, error)
+	// Called by a device with the hashes it computed over its installed
+	// software. A mismatch raises a critical significant event and blocks
+	// activation until a later verification passes.
+	VerifySoftware(ctx context.Context, in *VerifySoftwareRequest, opts ...grpc.CallOption) (*VerifySoftwareResponse, error)
+	// Enrolls registered equipment for mTLS. With csr_pem the registry signs
+	// a client certificate from its enrollment CA; with certificate_pem it
+	// registers the device's own certificate. Either way the certificate's
+	// fingerprint is bound to the equipment_id as a service actor.
+	EnrollEquipment(ctx context.Context, in *EnrollEquipmentRequest, opts ...grpc.CallOption) (*EnrollEquipmentResponse, error)
+	UpsertEquipmentGroup(ctx context.Context, in *UpsertEquipmentGroupRequest, opts ...grpc.CallOption) (*UpsertEquipmentGroupResponse, error)
+	ListEquipmentGroups(ctx context.Context, in *ListEquipmentGroupsRequest, opts ...grpc.CallOption) (*ListEquipmentGroupsResponse, error)
+	// Moves equipment into a zone or bank. An empty group_id clears both.
+	AssignEquipmentGroup(ctx context.Context, in *AssignEquipmentGroupRequest, opts ...grpc.CallOption) (*AssignEquipmentGroupResponse, error)
+	RegisterClientCertificate(ctx context.Context, in *RegisterClientCertificateRequest, opts ...grpc.CallOption) (*RegisterClientCertificateResponse, error)
+	ListClientCertificates(ctx context.Context, in *ListClientCertificatesRequest, opts ...grpc.CallOption) (*ListClientCertificatesResponse, error)
+	RevokeClientCertificate(ctx context.Context, in *RevokeClientCertificateRequest, opts ...grpc.CallOption) (*RevokeClientCertificateResponse, error)
 }
 
 type registryServiceClient struct {
@@ -71,6 +114,146 @@ func (c *registryServiceClient) ListEquipment(ctx context.Context, in *ListEquip
 	return out, nil
 }
 
+func (c *registryServiceClient) CommissionEquipment(ctx context.Context, in *CommissionEquipmentRequest, opts ...grpc.CallOption) (*CommissionEquipmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommissionEquipmentResponse)
+	err := c.cc.Invoke(ctx, RegistryService_CommissionEquipment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryServiceClient) ActivateEquipment(ctx context.Context, in *ActivateEquipmentRequest, opts ...grpc.CallOption) (*ActivateEquipmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ActivateEquipmentResponse)
+	err := c.cc.Invoke(ctx, RegistryService_ActivateEquipment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryServiceClient) StartEquipmentMaintenance(ctx context.Context, in *StartEquipmentMaintenanceRequest, opts ...grpc.CallOption) (*StartEquipmentMaintenanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartEquipmentMaintenanceResponse)
+	err := c.cc.Invoke(ctx, RegistryService_StartEquipmentMaintenance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryServiceClient) DecommissionEquipment(ctx context.Context, in *DecommissionEquipmentRequest, opts ...grpc.CallOption) (*DecommissionEquipmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DecommissionEquipmentResponse)
+	err := c.cc.Invoke(ctx, RegistryService_DecommissionEquipment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryServiceClient) ApproveSoftwareManifest(ctx context.Context, in *ApproveSoftwareManifestRequest, opts ...grpc.CallOption) (*ApproveSoftwareManifestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveSoftwareManifestResponse)
+	err := c.cc.Invoke(ctx, RegistryService_ApproveSoftwareManifest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryServiceClient) ListSoftwareManifests(ctx context.Context, in *ListSoftwareManifestsRequest, opts ...grpc.CallOption) (*ListSoftwareManifestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSoftwareManifestsResponse)
+	err := c.cc.Invoke(ctx, RegistryService_ListSoftwareManifests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryServiceClient) VerifySoftware(ctx context.Context, in *VerifySoftwareRequest, opts ...grpc.CallOption) (*VerifySoftwareResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifySoftwareResponse)
+	err := c.cc.Invoke(ctx, RegistryService_VerifySoftware_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryServiceClient) EnrollEquipment(ctx context.Context, in *EnrollEquipmentRequest, opts ...grpc.CallOption) (*EnrollEquipmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnrollEquipmentResponse)
+	err := c.cc.Invoke(ctx, RegistryService_EnrollEquipment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryServiceClient) UpsertEquipmentGroup(ctx context.Context, in *UpsertEquipmentGroupRequest, opts ...grpc.CallOption) (*UpsertEquipmentGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpsertEquipmentGroupResponse)
+	err := c.cc.Invoke(ctx, RegistryService_UpsertEquipmentGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryServiceClient) ListEquipmentGroups(ctx context.Context, in *ListEquipmentGroupsRequest, opts ...grpc.CallOption) (*ListEquipmentGroupsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEquipmentGroupsResponse)
+	err := c.cc.Invoke(ctx, RegistryService_ListEquipmentGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryServiceClient) AssignEquipmentGroup(ctx context.Context, in *AssignEquipmentGroupRequest, opts ...grpc.CallOption) (*AssignEquipmentGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignEquipmentGroupResponse)
+	err := c.cc.Invoke(ctx, RegistryService_AssignEquipmentGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryServiceClient) RegisterClientCertificate(ctx context.Context, in *RegisterClientCertificateRequest, opts ...grpc.CallOption) (*RegisterClientCertificateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterClientCertificateResponse)
+	err := c.cc.Invoke(ctx, RegistryService_RegisterClientCertificate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryServiceClient) ListClientCertificates(ctx context.Context, in *ListClientCertificatesRequest, opts ...grpc.CallOption) (*ListClientCertificatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListClientCertificatesResponse)
+	err := c.cc.Invoke(ctx, RegistryService_ListClientCertificates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryServiceClient) RevokeClientCertificate(ctx context.Context, in *RevokeClientCertificateRequest, opts ...grpc.CallOption) (*RevokeClientCertificateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeClientCertificateResponse)
+	err := c.cc.Invoke(ctx, RegistryService_RevokeClientCertificate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RegistryServiceServer is the server API for RegistryService service.
 // All implementations must embed UnimplementedRegistryServiceServer
 // for forward compatibility.
@@ -78,6 +261,35 @@ type RegistryServiceServer interface {
 	UpsertEquipment(context.Context, *UpsertEquipmentRequest) (*UpsertEquipmentResponse, error)
 	GetEquipment(context.Context, *GetEquipmentRequest) (*GetEquipmentResponse, error)
 	ListEquipment(context.Context, *ListEquipmentRequest) (*ListEquipmentResponse, error)
+	// Registered to commissioned, once the equipment is installed and
+	// verified on the floor.
+	CommissionEquipment(context.Context, *CommissionEquipmentRequest) (*CommissionEquipmentResponse, error)
+	// Commissioned or maintenance to active.
+	ActivateEquipment(context.Context, *ActivateEquipmentRequest) (*ActivateEquipmentResponse, error)
+	// Active to maintenance.
+	StartEquipmentMaintenance(context.Context, *StartEquipmentMaintenanceRequest) (*StartEquipmentMaintenanceResponse, error)
+	// Any state except decommissioned to decommissioned, which is final.
+	DecommissionEquipment(context.Context, *DecommissionEquipmentRequest) (*DecommissionEquipmentResponse, error)
+	// Approves the software/firmware hashes of one version of a model,
+	// replacing any earlier approval of the same version.
+	ApproveSoftwareManifest(context.Context, *ApproveSoftwareManifestRequest) (*ApproveSoftwareManifestResponse, error)
+	ListSoftwareManifests(context.Context, *ListSoftwareManifestsRequest) (*ListSoftwareManifestsResponse, error)
+	// Called by a device with the hashes it computed over its installed
+	// software. A mismatch raises a critical significant event and blocks
+	// activation until a later verification passes.
+	VerifySoftware(context.Context, *VerifySoftwareRequest) (*VerifySoftwareResponse, error)
+	// Enrolls registered equipment for mTLS. With csr_pem the registry signs
+	// a client certificate from its enrollment CA; with certificate_pem it
+	// registers the device's own certificate. Either way the certificate's
+	// fingerprint is bound to the equipment_id as a service actor.
+	EnrollEquipment(context.Context, *EnrollEquipmentRequest) (*EnrollEquipmentResponse, error)
+	UpsertEquipmentGroup(context.Context, *UpsertEquipmentGroupRequest) (*UpsertEquipmentGroupResponse, error)
+	ListEquipmentGroups(context.Context, *ListEquipmentGroupsRequest) (*ListEquipmentGroupsResponse, error)
+	// Moves equipment into a zone or bank. An empty group_id clears both.
+	AssignEquipmentGroup(context.Context, *AssignEquipmentGroupRequest) (*AssignEquipmentGroupResponse, error)
+	RegisterClientCertificate(context.Context, *RegisterClientCertificateRequest) (*RegisterClientCertificateResponse, error)
+	ListClientCertificates(context.Context, *ListClientCertificatesRequest) (*ListClientCertificatesResponse, error)
+	RevokeClientCertificate(context.Context, *RevokeClientCertificateRequest) (*RevokeClientCertificateResponse, error)
 	mustEmbedUnimplementedRegistryServiceServer()
 }
 
@@ -97,6 +309,48 @@ func (UnimplementedRegistryServiceServer) GetEquipment(context.Context, *GetEqui
 func (UnimplementedRegistryServiceServer) ListEquipment(context.Context, *ListEquipmentRequest) (*ListEquipmentResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListEquipment not implemented")
 }
+func (UnimplementedRegistryServiceServer) CommissionEquipment(context.Context, *CommissionEquipmentRequest) (*CommissionEquipmentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CommissionEquipment not implemented")
+}
+func (UnimplementedRegistryServiceServer) ActivateEquipment(context.Context, *ActivateEquipmentRequest) (*ActivateEquipmentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ActivateEquipment not implemented")
+}
+func (UnimplementedRegistryServiceServer) StartEquipmentMaintenance(context.Context, *StartEquipmentMaintenanceRequest) (*StartEquipmentMaintenanceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartEquipmentMaintenance not implemented")
+}
+func (UnimplementedRegistryServiceServer) DecommissionEquipment(context.Context, *DecommissionEquipmentRequest) (*DecommissionEquipmentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DecommissionEquipment not implemented")
+}
+func (UnimplementedRegistryServiceServer) ApproveSoftwareManifest(context.Context, *ApproveSoftwareManifestRequest) (*ApproveSoftwareManifestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApproveSoftwareManifest not implemented")
+}
+func (UnimplementedRegistryServiceServer) ListSoftwareManifests(context.Context, *ListSoftwareManifestsRequest) (*ListSoftwareManifestsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSoftwareManifests not implemented")
+}
+func (UnimplementedRegistryServiceServer) VerifySoftware(context.Context, *VerifySoftwareRequest) (*VerifySoftwareResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method VerifySoftware not implemented")
+}
+func (UnimplementedRegistryServiceServer) EnrollEquipment(context.Context, *EnrollEquipmentRequest) (*EnrollEquipmentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method EnrollEquipment not implemented")
+}
+func (UnimplementedRegistryServiceServer) UpsertEquipmentGroup(context.Context, *UpsertEquipmentGroupRequest) (*UpsertEquipmentGroupResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpsertEquipmentGroup not implemented")
+}
+func (UnimplementedRegistryServiceServer) ListEquipmentGroups(context.Context, *ListEquipmentGroupsRequest) (*ListEquipmentGroupsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListEquipmentGroups not implemented")
+}
+func (UnimplementedRegistryServiceServer) AssignEquipmentGroup(context.Context, *AssignEquipmentGroupRequest) (*AssignEquipmentGroupResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AssignEquipmentGroup not implemented")
+}
+func (UnimplementedRegistryServiceServer) RegisterClientCertificate(context.Context, *RegisterClientCertificateRequest) (*RegisterClientCertificateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RegisterClientCertificate not implemented")
+}
+func (UnimplementedRegistryServiceServer) ListClientCertificates(context.Context, *ListClientCertificatesRequest) (*ListClientCertificatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListClientCertificates not implemented")
+}
+func (UnimplementedRegistryServiceServer) RevokeClientCertificate(context.Context, *RevokeClientCertificateRequest) (*RevokeClientCertificateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeClientCertificate not implemented")
+}
 func (UnimplementedRegistryServiceServer) mustEmbedUnimplementedRegistryServiceServer() {}
 func (UnimplementedRegistryServiceServer) testEmbeddedByValue()                         {}
 
@@ -172,6 +426,258 @@ func _RegistryService_ListEquipment_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RegistryService_CommissionEquipment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommissionEquipmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServiceServer).CommissionEquipment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistryService_CommissionEquipment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServiceServer).CommissionEquipment(ctx, req.(*CommissionEquipmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistryService_ActivateEquipment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivateEquipmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServiceServer).ActivateEquipment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistryService_ActivateEquipment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServiceServer).ActivateEquipment(ctx, req.(*ActivateEquipmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistryService_StartEquipmentMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartEquipmentMaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServiceServer).StartEquipmentMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistryService_StartEquipmentMaintenance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServiceServer).StartEquipmentMaintenance(ctx, req.(*StartEquipmentMaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistryService_DecommissionEquipment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecommissionEquipmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServiceServer).DecommissionEquipment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistryService_DecommissionEquipment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServiceServer).DecommissionEquipment(ctx, req.(*DecommissionEquipmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistryService_ApproveSoftwareManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveSoftwareManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServiceServer).ApproveSoftwareManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistryService_ApproveSoftwareManifest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServiceServer).ApproveSoftwareManifest(ctx, req.(*ApproveSoftwareManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistryService_ListSoftwareManifests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSoftwareManifestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServiceServer).ListSoftwareManifests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistryService_ListSoftwareManifests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServiceServer).ListSoftwareManifests(ctx, req.(*ListSoftwareManifestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistryService_VerifySoftware_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifySoftwareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServiceServer).VerifySoftware(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistryService_VerifySoftware_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServiceServer).VerifySoftware(ctx, req.(*VerifySoftwareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistryService_EnrollEquipment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollEquipmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServiceServer).EnrollEquipment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistryService_EnrollEquipment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServiceServer).EnrollEquipment(ctx, req.(*EnrollEquipmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistryService_UpsertEquipmentGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertEquipmentGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServiceServer).UpsertEquipmentGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistryService_UpsertEquipmentGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServiceServer).UpsertEquipmentGroup(ctx, req.(*UpsertEquipmentGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistryService_ListEquipmentGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEquipmentGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServiceServer).ListEquipmentGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistryService_ListEquipmentGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServiceServer).ListEquipmentGroups(ctx, req.(*ListEquipmentGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistryService_AssignEquipmentGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignEquipmentGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServiceServer).AssignEquipmentGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistryService_AssignEquipmentGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServiceServer).AssignEquipmentGroup(ctx, req.(*AssignEquipmentGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistryService_RegisterClientCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterClientCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServiceServer).RegisterClientCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistryService_RegisterClientCertificate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServiceServer).RegisterClientCertificate(ctx, req.(*RegisterClientCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistryService_ListClientCertificates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClientCertificatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServiceServer).ListClientCertificates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistryService_ListClientCertificates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServiceServer).ListClientCertificates(ctx, req.(*ListClientCertificatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistryService_RevokeClientCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeClientCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServiceServer).RevokeClientCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistryService_RevokeClientCertificate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServiceServer).RevokeClientCertificate(ctx, req.(*RevokeClientCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RegistryService_ServiceDesc is the grpc.ServiceDesc for RegistryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -191,6 +697,62 @@ var RegistryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListEquipment",
 			Handler:    _RegistryService_ListEquipment_Handler,
 		},
+		{
+			MethodName: "CommissionEquipment",
+			Handler:    _RegistryService_CommissionEquipment_Handler,
+		},
+		{
+			MethodName: "ActivateEquipment",
+			Handler:    _RegistryService_ActivateEquipment_Handler,
+		},
+		{
+			MethodName: "StartEquipmentMaintenance",
+			Handler:    _RegistryService_StartEquipmentMaintenance_Handler,
+		},
+		{
+			MethodName: "DecommissionEquipment",
+			Handler:    _RegistryService_DecommissionEquipment_Handler,
+		},
+		{
+			MethodName: "ApproveSoftwareManifest",
+			Handler:    _RegistryService_ApproveSoftwareManifest_Handler,
+		},
+		{
+			MethodName: "ListSoftwareManifests",
+			Handler:    _RegistryService_ListSoftwareManifests_Handler,
+		},
+		{
+			MethodName: "VerifySoftware",
+			Handler:    _RegistryService_VerifySoftware_Handler,
+		},
+		{
+			MethodName: "EnrollEquipment",
+			Handler:    _RegistryService_EnrollEquipment_Handler,
+		},
+		{
+			MethodName: "UpsertEquipmentGroup",
+			Handler:    _RegistryService_UpsertEquipmentGroup_Handler,
+		},
+		{
+			MethodName: "ListEquipmentGroups",
+			Handler:    _RegistryService_ListEquipmentGroups_Handler,
+		},
+		{
+			MethodName: "AssignEquipmentGroup",
+			Handler:    _RegistryService_AssignEquipmentGroup_Handler,
+		},
+		{
+			MethodName: "RegisterClientCertificate",
+			Handler:    _RegistryService_RegisterClientCertificate_Handler,
+		},
+		{
+			MethodName: "ListClientCertificates",
+			Handler:    _RegistryService_ListClientCertificates_Handler,
+		},
+		{
+			MethodName: "RevokeClientCertificate",
+			Handler:    _RegistryService_RevokeClientCertificate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rgs/v1/registry.proto",
@@ -29,6 +29,9 @@ const (
 	ReportType_REPORT_TYPE_SIGNIFICANT_EVENTS_ALTERATIONS ReportType = 1
 	ReportType_REPORT_TYPE_CASHLESS_LIABILITY_SUMMARY     ReportType = 2
 	ReportType_REPORT_TYPE_ACCOUNT_TRANSACTION_STATEMENT  ReportType = 3
+	ReportType_REPORT_TYPE_RTP_SUMMARY                    ReportType = 4
+	ReportType_REPORT_TYPE_PROMO_LIABILITY                ReportType = 5
+	ReportType_REPORT_TYPE_EFT_ACTIVITY                   ReportType = 6
 )
 
 // Enum value maps for ReportType.
@@ -38,12 +41,18 @@ var (
 		1: "REPORT_TYPE_SIGNIFICANT_EVENTS_ALTERATIONS",
 		2: "REPORT_TYPE_CASHLESS_LIABILITY_SUMMARY",
 		3: "REPORT_TYPE_ACCOUNT_TRANSACTION_STATEMENT",
+		4: "REPORT_TYPE_RTP_SUMMARY",
+		5: "REPORT_TYPE_PROMO_LIABILITY",
+		6: "REPORT_TYPE_EFT_ACTIVITY",
 	}
 	ReportType_value = map[string]int32{
 		"REPORT_TYPE_UNSPECIFIED":                    0,
 		"REPORT_TYPE_SIGNIFICANT_EVENTS_ALTERATIONS": 1,
 		"REPORT_TYPE_CASHLESS_LIABILITY_SUMMARY":     2,
 		"REPORT_TYPE_ACCOUNT_TRANSACTION_STATEMENT":  3,
+		"REPORT_TYPE_RTP_SUMMARY":                    4,
+		"REPORT_TYPE_PROMO_LIABILITY":                5,
+		"REPORT_TYPE_EFT_ACTIVITY":                   6,
 	}
 )
 
@@ -135,6 +144,7 @@ const (
 	ReportFormat_REPORT_FORMAT_UNSPECIFIED ReportFormat = 0
 	ReportFormat_REPORT_FORMAT_JSON        ReportFormat = 1
 	ReportFormat_REPORT_FORMAT_CSV         ReportFormat = 2
+	ReportFormat_REPORT_FORMAT_PDF         ReportFormat = 3
 )
 
 // Enum value maps for ReportFormat.
@@ -143,11 +153,13 @@ var (
 		0: "REPORT_FORMAT_UNSPECIFIED",
 		1: "REPORT_FORMAT_JSON",
 		2: "REPORT_FORMAT_CSV",
+		3: "REPORT_FORMAT_PDF",
 	}
 	ReportFormat_value = map[string]int32{
 		"REPORT_FORMAT_UNSPECIFIED": 0,
 		"REPORT_FORMAT_JSON":        1,
 		"REPORT_FORMAT_CSV":         2,
+		"REPORT_FORMAT_PDF":         3,
 	}
 )
 
@@ -184,6 +196,9 @@ const (
 	ReportRunStatus_REPORT_RUN_STATUS_UNSPECIFIED ReportRunStatus = 0
 	ReportRunStatus_REPORT_RUN_STATUS_COMPLETED   ReportRunStatus = 1
 	ReportRunStatus_REPORT_RUN_STATUS_FAILED      ReportRunStatus = 2
+	// Async runs are PENDING until a report worker picks them up.
+	ReportRunStatus_REPORT_RUN_STATUS_PENDING ReportRunStatus = 3
+	ReportRunStatus_REPORT_RUN_STATUS_RUNNING ReportRunStatus = 4
 )
 
 // Enum value maps for ReportRunStatus.
@@ -192,11 +207,15 @@ var (
 		0: "REPORT_RUN_STATUS_UNSPECIFIED",
 		1: "REPORT_RUN_STATUS_COMPLETED",
 		2: "REPORT_RUN_STATUS_FAILED",
+		3: "REPORT_RUN_STATUS_PENDING",
+		4: "REPORT_RUN_STATUS_RUNNING",
 	}
 	ReportRunStatus_value = map[string]int32{
 		"REPORT_RUN_STATUS_UNSPECIFIED": 0,
 		"REPORT_RUN_STATUS_COMPLETED":   1,
 		"REPORT_RUN_STATUS_FAILED":      2,
+		"REPORT_RUN_STATUS_PENDING":     3,
+		"REPORT_RUN_STATUS_RUNNING":     4,
 	}
 )
 
@@ -227,6 +246,58 @@ func (ReportRunStatus) EnumDescriptor() ([]byte, []int) {
 	return file_rgs_v1_reporting_proto_rawDescGZIP(), []int{3}
 }
 
+type DailyPackStatus int32
+
+const (
+	DailyPackStatus_DAILY_PACK_STATUS_UNSPECIFIED DailyPackStatus = 0
+	DailyPackStatus_DAILY_PACK_STATUS_COMPLETED   DailyPackStatus = 1
+	DailyPackStatus_DAILY_PACK_STATUS_PARTIAL     DailyPackStatus = 2
+	DailyPackStatus_DAILY_PACK_STATUS_FAILED      DailyPackStatus = 3
+)
+
+// Enum value maps for DailyPackStatus.
+var (
+	DailyPackStatus_name = map[int32]string{
+		0: "DAILY_PACK_STATUS_UNSPECIFIED",
+		1: "DAILY_PACK_STATUS_COMPLETED",
+		2: "DAILY_PACK_STATUS_PARTIAL",
+		3: "DAILY_PACK_STATUS_FAILED",
+	}
+	DailyPackStatus_value = map[string]int32{
+		"DAILY_PACK_STATUS_UNSPECIFIED": 0,
+		"DAILY_PACK_STATUS_COMPLETED":   1,
+		"DAILY_PACK_STATUS_PARTIAL":     2,
+		"DAILY_PACK_STATUS_FAILED":      3,
+	}
+)
+
+func (x DailyPackStatus) Enum() *DailyPackStatus {
+	p := new(DailyPackStatus)
+	*p = x
+	return p
+}
+
+func (x DailyPackStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DailyPackStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_rgs_v1_reporting_proto_enumTypes[4].Descriptor()
+}
+
+func (DailyPackStatus) Type() protoreflect.EnumType {
+	return &file_rgs_v1_reporting_proto_enumTypes[4]
+}
+
+func (x DailyPackStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DailyPackStatus.Descriptor instead.
+func (DailyPackStatus) EnumDescriptor() ([]byte, []int) {
+	return file_rgs_v1_reporting_proto_rawDescGZIP(), []int{4}
+}
+
 type ReportRun struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ReportRunId   string                 `protobuf:"bytes,1,opt,name=report_run_id,json=reportRunId,proto3" json:"report_run_id,omitempty"`
@@ -240,25 +311,1321 @@ type ReportRun struct {
 	NoActivity    bool                   `protobuf:"varint,9,opt,name=no_activity,json=noActivity,proto3" json:"no_activity,omitempty"`
 	ContentType   string                 `protobuf:"bytes,10,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
 	Content       []byte                 `protobuf:"bytes,11,opt,name=content,proto3" json:"content,omitempty"`
+	FailureReason string                 `protobuf:"bytes,12,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	// Push deliveries of a completed run, one per configured target.
+	Deliveries []*ReportRunDelivery `protobuf:"bytes,13,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	// The window the run covers, RFC 3339 UTC. period_start is empty for LTD;
+	// DTD, MTD, and YTD start at a gaming-day open in the operator's time zone.
+	PeriodStart string `protobuf:"bytes,14,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	PeriodEnd   string `protobuf:"bytes,15,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	// Set on completed runs: hex SHA-256 of content, and the hex ed25519
+	// signature over the run's signed document (report_run_id, operator_id,
+	// content_sha256). Check with VerifyReportRun.
+	ContentSha256 string `protobuf:"bytes,16,opt,name=content_sha256,json=contentSha256,proto3" json:"content_sha256,omitempty"`
+	SignerKid     string `protobuf:"bytes,17,opt,name=signer_kid,json=signerKid,proto3" json:"signer_kid,omitempty"`
+	Signature     string `protobuf:"bytes,18,opt,name=signature,proto3" json:"signature,omitempty"`
+	SignatureAlg  string `protobuf:"bytes,19,opt,name=signature_alg,json=signatureAlg,proto3" json:"signature_alg,omitempty"`
+	// Set when the retention job purged content; the run's metadata, hash, and
+	// signature are kept.
+	ContentPurgedAt string `protobuf:"bytes,20,opt,name=content_purged_at,json=contentPurgedAt,proto3" json:"content_purged_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReportRun) Reset() {
+	*x = ReportRun{}
+	mi := &file_rgs_v1_reporting_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportRun) ProtoMessage() {}
+
+func (x *ReportRun) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_reporting_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportRun.ProtoReflect.Descriptor instead.
+func (*ReportRun) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_reporting_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReportRun) GetReportRunId() string {
+	if x != nil {
+		return x.ReportRunId
+	}
+	return ""
+}
+
+func (x *ReportRun) GetReportType() ReportType {
+	if x != nil {
+		return x.ReportType
+	}
+	return ReportType_REPORT_TYPE_UNSPECIFIED
+}
+
+func (x *ReportRun) GetInterval() ReportInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return ReportInterval_REPORT_INTERVAL_UNSPECIFIED
+}
+
+func (x *ReportRun) GetFormat() ReportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ReportFormat_REPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *ReportRun) GetStatus() ReportRunStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReportRunStatus_REPORT_RUN_STATUS_UNSPECIFIED
+}
+
+func (x *ReportRun) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *ReportRun) GetReportTitle() string {
+	if x != nil {
+		return x.ReportTitle
+	}
+	return ""
+}
+
+func (x *ReportRun) GetGeneratedAt() string {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return ""
+}
+
+func (x *ReportRun) GetNoActivity() bool {
+	if x != nil {
+		return x.NoActivity
+	}
+	return false
+}
+
+func (x *ReportRun) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ReportRun) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ReportRun) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *ReportRun) GetDeliveries() []*ReportRunDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+func (x *ReportRun) GetPeriodStart() string {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return ""
+}
+
+func (x *ReportRun) GetPeriodEnd() string {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return ""
+}
+
+func (x *ReportRun) GetContentSha256() string {
+	if x != nil {
+		return x.ContentSha256
+	}
+	return ""
+}
+
+func (x *ReportRun) GetSignerKid() string {
+	if x != nil {
+		return x.SignerKid
+	}
+	return ""
+}
+
+func (x *ReportRun) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *ReportRun) GetSignatureAlg() string {
+	if x != nil {
+		return x.SignatureAlg
+	}
+	return ""
+}
+
+func (x *ReportRun) GetContentPurgedAt() string {
+	if x != nil {
+		return x.ContentPurgedAt
+	}
+	return ""
+}
+
+type ReportRunDelivery struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Target          string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Delivered       bool                   `protobuf:"varint,2,opt,name=delivered,proto3" json:"delivered,omitempty"`
+	Attempts        int32                  `protobuf:"varint,3,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastAttemptedAt string                 `protobuf:"bytes,4,opt,name=last_attempted_at,json=lastAttemptedAt,proto3" json:"last_attempted_at,omitempty"`
+	LastError       string                 `protobuf:"bytes,5,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	DeliveredAt     string                 `protobuf:"bytes,6,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReportRunDelivery) Reset() {
+	*x = ReportRunDelivery{}
+	mi := &file_rgs_v1_reporting_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportRunDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportRunDelivery) ProtoMessage() {}
+
+func (x *ReportRunDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_reporting_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportRunDelivery.ProtoReflect.Descriptor instead.
+func (*ReportRunDelivery) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_reporting_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReportRunDelivery) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *ReportRunDelivery) GetDelivered() bool {
+	if x != nil {
+		return x.Delivered
+	}
+	return false
+}
+
+func (x *ReportRunDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *ReportRunDelivery) GetLastAttemptedAt() string {
+	if x != nil {
+		return x.LastAttemptedAt
+	}
+	return ""
+}
+
+func (x *ReportRunDelivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *ReportRunDelivery) GetDeliveredAt() string {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return ""
+}
+
+type DailyPackArtifact struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Sha256        string                 `protobuf:"bytes,3,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	SizeBytes     int64                  `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	ReportRunId   string                 `protobuf:"bytes,5,opt,name=report_run_id,json=reportRunId,proto3" json:"report_run_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DailyPackArtifact) Reset() {
+	*x = DailyPackArtifact{}
+	mi := &file_rgs_v1_reporting_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyPackArtifact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyPackArtifact) ProtoMessage() {}
+
+func (x *DailyPackArtifact) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_reporting_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyPackArtifact.ProtoReflect.Descriptor instead.
+func (*DailyPackArtifact) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_reporting_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DailyPackArtifact) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DailyPackArtifact) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *DailyPackArtifact) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *DailyPackArtifact) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *DailyPackArtifact) GetReportRunId() string {
+	if x != nil {
+		return x.ReportRunId
+	}
+	return ""
+}
+
+type DailyPackCurrencyLiability struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Currency       string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	AvailableMinor int64                  `protobuf:"varint,2,opt,name=available_minor,json=availableMinor,proto3" json:"available_minor,omitempty"`
+	PendingMinor   int64                  `protobuf:"varint,3,opt,name=pending_minor,json=pendingMinor,proto3" json:"pending_minor,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DailyPackCurrencyLiability) Reset() {
+	*x = DailyPackCurrencyLiability{}
+	mi := &file_rgs_v1_reporting_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyPackCurrencyLiability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyPackCurrencyLiability) ProtoMessage() {}
+
+func (x *DailyPackCurrencyLiability) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_reporting_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyPackCurrencyLiability.ProtoReflect.Descriptor instead.
+func (*DailyPackCurrencyLiability) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_reporting_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DailyPackCurrencyLiability) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *DailyPackCurrencyLiability) GetAvailableMinor() int64 {
+	if x != nil {
+		return x.AvailableMinor
+	}
+	return 0
+}
+
+func (x *DailyPackCurrencyLiability) GetPendingMinor() int64 {
+	if x != nil {
+		return x.PendingMinor
+	}
+	return 0
+}
+
+type DailyPackReconciliation struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Balanced          bool                   `protobuf:"varint,1,opt,name=balanced,proto3" json:"balanced,omitempty"`
+	TransactionCount  int64                  `protobuf:"varint,2,opt,name=transaction_count,json=transactionCount,proto3" json:"transaction_count,omitempty"`
+	TotalCreditsMinor int64                  `protobuf:"varint,3,opt,name=total_credits_minor,json=totalCreditsMinor,proto3" json:"total_credits_minor,omitempty"`
+	TotalDebitsMinor  int64                  `protobuf:"varint,4,opt,name=total_debits_minor,json=totalDebitsMinor,proto3" json:"total_debits_minor,omitempty"`
+	// Flat liability totals are only set when every account shares one
+	// currency; see liability_by_currency.
+	LiabilityAvailableMinor int64    `protobuf:"varint,5,opt,name=liability_available_minor,json=liabilityAvailableMinor,proto3" json:"liability_available_minor,omitempty"`
+	LiabilityPendingMinor   int64    `protobuf:"varint,6,opt,name=liability_pending_minor,json=liabilityPendingMinor,proto3" json:"liability_pending_minor,omitempty"`
+	Discrepancies           []string `protobuf:"bytes,7,rep,name=discrepancies,proto3" json:"discrepancies,omitempty"`
+	// Outstanding account balances per currency, sorted by currency.
+	LiabilityByCurrency []*DailyPackCurrencyLiability `protobuf:"bytes,8,rep,name=liability_by_currency,json=liabilityByCurrency,proto3" json:"liability_by_currency,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *DailyPackReconciliation) Reset() {
+	*x = DailyPackReconciliation{}
+	mi := &file_rgs_v1_reporting_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyPackReconciliation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyPackReconciliation) ProtoMessage() {}
+
+func (x *DailyPackReconciliation) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_reporting_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyPackReconciliation.ProtoReflect.Descriptor instead.
+func (*DailyPackReconciliation) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_reporting_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DailyPackReconciliation) GetBalanced() bool {
+	if x != nil {
+		return x.Balanced
+	}
+	return false
+}
+
+func (x *DailyPackReconciliation) GetTransactionCount() int64 {
+	if x != nil {
+		return x.TransactionCount
+	}
+	return 0
+}
+
+func (x *DailyPackReconciliation) GetTotalCreditsMinor() int64 {
+	if x != nil {
+		return x.TotalCreditsMinor
+	}
+	return 0
+}
+
+func (x *DailyPackReconciliation) GetTotalDebitsMinor() int64 {
+	if x != nil {
+		return x.TotalDebitsMinor
+	}
+	return 0
+}
+
+func (x *DailyPackReconciliation) GetLiabilityAvailableMinor() int64 {
+	if x != nil {
+		return x.LiabilityAvailableMinor
+	}
+	return 0
+}
+
+func (x *DailyPackReconciliation) GetLiabilityPendingMinor() int64 {
+	if x != nil {
+		return x.LiabilityPendingMinor
+	}
+	return 0
+}
+
+func (x *DailyPackReconciliation) GetDiscrepancies() []string {
+	if x != nil {
+		return x.Discrepancies
+	}
+	return nil
+}
+
+func (x *DailyPackReconciliation) GetLiabilityByCurrency() []*DailyPackCurrencyLiability {
+	if x != nil {
+		return x.LiabilityByCurrency
+	}
+	return nil
+}
+
+type DailyPackDelivery struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Sink                  string                 `protobuf:"bytes,1,opt,name=sink,proto3" json:"sink,omitempty"`
+	Delivered             bool                   `protobuf:"varint,2,opt,name=delivered,proto3" json:"delivered,omitempty"`
+	Error                 string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	AttemptedAt           string                 `protobuf:"bytes,4,opt,name=attempted_at,json=attemptedAt,proto3" json:"attempted_at,omitempty"`
+	Encrypted             bool                   `protobuf:"varint,5,opt,name=encrypted,proto3" json:"encrypted,omitempty"`
+	RecipientFingerprints []string               `protobuf:"bytes,6,rep,name=recipient_fingerprints,json=recipientFingerprints,proto3" json:"recipient_fingerprints,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *DailyPackDelivery) Reset() {
+	*x = DailyPackDelivery{}
+	mi := &file_rgs_v1_reporting_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyPackDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyPackDelivery) ProtoMessage() {}
+
+func (x *DailyPackDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_reporting_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyPackDelivery.ProtoReflect.Descriptor instead.
+func (*DailyPackDelivery) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_reporting_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DailyPackDelivery) GetSink() string {
+	if x != nil {
+		return x.Sink
+	}
+	return ""
+}
+
+func (x *DailyPackDelivery) GetDelivered() bool {
+	if x != nil {
+		return x.Delivered
+	}
+	return false
+}
+
+func (x *DailyPackDelivery) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DailyPackDelivery) GetAttemptedAt() string {
+	if x != nil {
+		return x.AttemptedAt
+	}
+	return ""
+}
+
+func (x *DailyPackDelivery) GetEncrypted() bool {
+	if x != nil {
+		return x.Encrypted
+	}
+	return false
+}
+
+func (x *DailyPackDelivery) GetRecipientFingerprints() []string {
+	if x != nil {
+		return x.RecipientFingerprints
+	}
+	return nil
+}
+
+type DailyPack struct {
+	state              protoimpl.MessageState   `protogen:"open.v1"`
+	PackId             string                   `protobuf:"bytes,1,opt,name=pack_id,json=packId,proto3" json:"pack_id,omitempty"`
+	GamingDay          string                   `protobuf:"bytes,2,opt,name=gaming_day,json=gamingDay,proto3" json:"gaming_day,omitempty"`
+	Status             DailyPackStatus          `protobuf:"varint,3,opt,name=status,proto3,enum=rgs.v1.DailyPackStatus" json:"status,omitempty"`
+	OperatorId         string                   `protobuf:"bytes,4,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	ReportRunIds       []string                 `protobuf:"bytes,5,rep,name=report_run_ids,json=reportRunIds,proto3" json:"report_run_ids,omitempty"`
+	Artifacts          []*DailyPackArtifact     `protobuf:"bytes,6,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
+	AuditEventCount    int64                    `protobuf:"varint,7,opt,name=audit_event_count,json=auditEventCount,proto3" json:"audit_event_count,omitempty"`
+	AuditChainVerified bool                     `protobuf:"varint,8,opt,name=audit_chain_verified,json=auditChainVerified,proto3" json:"audit_chain_verified,omitempty"`
+	Reconciliation     *DailyPackReconciliation `protobuf:"bytes,9,opt,name=reconciliation,proto3" json:"reconciliation,omitempty"`
+	ManifestSha256     string                   `protobuf:"bytes,10,opt,name=manifest_sha256,json=manifestSha256,proto3" json:"manifest_sha256,omitempty"`
+	SignerKid          string                   `protobuf:"bytes,11,opt,name=signer_kid,json=signerKid,proto3" json:"signer_kid,omitempty"`
+	Signature          string                   `protobuf:"bytes,12,opt,name=signature,proto3" json:"signature,omitempty"`
+	SignatureAlg       string                   `protobuf:"bytes,13,opt,name=signature_alg,json=signatureAlg,proto3" json:"signature_alg,omitempty"`
+	Deliveries         []*DailyPackDelivery     `protobuf:"bytes,14,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	GeneratedAt        string                   `protobuf:"bytes,15,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	FailureReason      string                   `protobuf:"bytes,16,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DailyPack) Reset() {
+	*x = DailyPack{}
+	mi := &file_rgs_v1_reporting_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyPack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyPack) ProtoMessage() {}
+
+func (x *DailyPack) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_reporting_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyPack.ProtoReflect.Descriptor instead.
+func (*DailyPack) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_reporting_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DailyPack) GetPackId() string {
+	if x != nil {
+		return x.PackId
+	}
+	return ""
+}
+
+func (x *DailyPack) GetGamingDay() string {
+	if x != nil {
+		return x.GamingDay
+	}
+	return ""
+}
+
+func (x *DailyPack) GetStatus() DailyPackStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DailyPackStatus_DAILY_PACK_STATUS_UNSPECIFIED
+}
+
+func (x *DailyPack) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *DailyPack) GetReportRunIds() []string {
+	if x != nil {
+		return x.ReportRunIds
+	}
+	return nil
+}
+
+func (x *DailyPack) GetArtifacts() []*DailyPackArtifact {
+	if x != nil {
+		return x.Artifacts
+	}
+	return nil
+}
+
+func (x *DailyPack) GetAuditEventCount() int64 {
+	if x != nil {
+		return x.AuditEventCount
+	}
+	return 0
+}
+
+func (x *DailyPack) GetAuditChainVerified() bool {
+	if x != nil {
+		return x.AuditChainVerified
+	}
+	return false
+}
+
+func (x *DailyPack) GetReconciliation() *DailyPackReconciliation {
+	if x != nil {
+		return x.Reconciliation
+	}
+	return nil
+}
+
+func (x *DailyPack) GetManifestSha256() string {
+	if x != nil {
+		return x.ManifestSha256
+	}
+	return ""
+}
+
+func (x *DailyPack) GetSignerKid() string {
+	if x != nil {
+		return x.SignerKid
+	}
+	return ""
+}
+
+func (x *DailyPack) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *DailyPack) GetSignatureAlg() string {
+	if x != nil {
+		return x.SignatureAlg
+	}
+	return ""
+}
+
+func (x *DailyPack) GetDeliveries() []*DailyPackDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+func (x *DailyPack) GetGeneratedAt() string {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return ""
+}
+
+func (x *DailyPack) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+type GenerateReportRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Meta       *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	ReportType ReportType             `protobuf:"varint,2,opt,name=report_type,json=reportType,proto3,enum=rgs.v1.ReportType" json:"report_type,omitempty"`
+	Interval   ReportInterval         `protobuf:"varint,3,opt,name=interval,proto3,enum=rgs.v1.ReportInterval" json:"interval,omitempty"`
+	Format     ReportFormat           `protobuf:"varint,4,opt,name=format,proto3,enum=rgs.v1.ReportFormat" json:"format,omitempty"`
+	OperatorId string                 `protobuf:"bytes,5,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	// Limits the significant events report to equipment in this registry
+	// zone or bank.
+	GroupId       string `protobuf:"bytes,6,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateReportRequest) Reset() {
+	*x = GenerateReportRequest{}
+	mi := &file_rgs_v1_reporting_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateReportRequest) ProtoMessage() {}
+
+func (x *GenerateReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_reporting_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateReportRequest.ProtoReflect.Descriptor instead.
+func (*GenerateReportRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_reporting_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GenerateReportRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GenerateReportRequest) GetReportType() ReportType {
+	if x != nil {
+		return x.ReportType
+	}
+	return ReportType_REPORT_TYPE_UNSPECIFIED
+}
+
+func (x *GenerateReportRequest) GetInterval() ReportInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return ReportInterval_REPORT_INTERVAL_UNSPECIFIED
+}
+
+func (x *GenerateReportRequest) GetFormat() ReportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ReportFormat_REPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *GenerateReportRequest) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *GenerateReportRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type GenerateReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	ReportRun     *ReportRun             `protobuf:"bytes,2,opt,name=report_run,json=reportRun,proto3" json:"report_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateReportResponse) Reset() {
+	*x = GenerateReportResponse{}
+	mi := &file_rgs_v1_reporting_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateReportResponse) ProtoMessage() {}
+
+func (x *GenerateReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_reporting_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateReportResponse.ProtoReflect.Descriptor instead.
+func (*GenerateReportResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_reporting_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GenerateReportResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GenerateReportResponse) GetReportRun() *ReportRun {
+	if x != nil {
+		return x.ReportRun
+	}
+	return nil
+}
+
+type GenerateReportAsyncRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Meta       *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	ReportType ReportType             `protobuf:"varint,2,opt,name=report_type,json=reportType,proto3,enum=rgs.v1.ReportType" json:"report_type,omitempty"`
+	Interval   ReportInterval         `protobuf:"varint,3,opt,name=interval,proto3,enum=rgs.v1.ReportInterval" json:"interval,omitempty"`
+	Format     ReportFormat           `protobuf:"varint,4,opt,name=format,proto3,enum=rgs.v1.ReportFormat" json:"format,omitempty"`
+	OperatorId string                 `protobuf:"bytes,5,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	// Limits the significant events report to equipment in this registry
+	// zone or bank.
+	GroupId       string `protobuf:"bytes,6,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateReportAsyncRequest) Reset() {
+	*x = GenerateReportAsyncRequest{}
+	mi := &file_rgs_v1_reporting_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateReportAsyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateReportAsyncRequest) ProtoMessage() {}
+
+func (x *GenerateReportAsyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_reporting_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateReportAsyncRequest.ProtoReflect.Descriptor instead.
+func (*GenerateReportAsyncRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_reporting_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GenerateReportAsyncRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GenerateReportAsyncRequest) GetReportType() ReportType {
+	if x != nil {
+		return x.ReportType
+	}
+	return ReportType_REPORT_TYPE_UNSPECIFIED
+}
+
+func (x *GenerateReportAsyncRequest) GetInterval() ReportInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return ReportInterval_REPORT_INTERVAL_UNSPECIFIED
+}
+
+func (x *GenerateReportAsyncRequest) GetFormat() ReportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ReportFormat_REPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *GenerateReportAsyncRequest) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *GenerateReportAsyncRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type GenerateReportAsyncResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Meta  *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// Poll GetReportRun with report_run_id until the run completes or fails.
+	ReportRunId   string     `protobuf:"bytes,2,opt,name=report_run_id,json=reportRunId,proto3" json:"report_run_id,omitempty"`
+	ReportRun     *ReportRun `protobuf:"bytes,3,opt,name=report_run,json=reportRun,proto3" json:"report_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateReportAsyncResponse) Reset() {
+	*x = GenerateReportAsyncResponse{}
+	mi := &file_rgs_v1_reporting_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateReportAsyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateReportAsyncResponse) ProtoMessage() {}
+
+func (x *GenerateReportAsyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_reporting_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateReportAsyncResponse.ProtoReflect.Descriptor instead.
+func (*GenerateReportAsyncResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_reporting_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GenerateReportAsyncResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GenerateReportAsyncResponse) GetReportRunId() string {
+	if x != nil {
+		return x.ReportRunId
+	}
+	return ""
+}
+
+func (x *GenerateReportAsyncResponse) GetReportRun() *ReportRun {
+	if x != nil {
+		return x.ReportRun
+	}
+	return nil
+}
+
+type ListReportRunsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Meta             *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	ReportTypeFilter ReportType             `protobuf:"varint,2,opt,name=report_type_filter,json=reportTypeFilter,proto3,enum=rgs.v1.ReportType" json:"report_type_filter,omitempty"`
+	PageSize         int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken        string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Lists only runs for this operator. Callers limited to certain operators
+	// see only their operators' runs either way.
+	OperatorId    string `protobuf:"bytes,5,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReportRunsRequest) Reset() {
+	*x = ListReportRunsRequest{}
+	mi := &file_rgs_v1_reporting_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReportRunsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReportRunsRequest) ProtoMessage() {}
+
+func (x *ListReportRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_reporting_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReportRunsRequest.ProtoReflect.Descriptor instead.
+func (*ListReportRunsRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_reporting_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListReportRunsRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListReportRunsRequest) GetReportTypeFilter() ReportType {
+	if x != nil {
+		return x.ReportTypeFilter
+	}
+	return ReportType_REPORT_TYPE_UNSPECIFIED
+}
+
+func (x *ListReportRunsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListReportRunsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListReportRunsRequest) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+type ListReportRunsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	ReportRuns    []*ReportRun           `protobuf:"bytes,2,rep,name=report_runs,json=reportRuns,proto3" json:"report_runs,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReportRunsResponse) Reset() {
+	*x = ListReportRunsResponse{}
+	mi := &file_rgs_v1_reporting_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReportRunsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReportRunsResponse) ProtoMessage() {}
+
+func (x *ListReportRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_reporting_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReportRunsResponse.ProtoReflect.Descriptor instead.
+func (*ListReportRunsResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_reporting_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListReportRunsResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListReportRunsResponse) GetReportRuns() []*ReportRun {
+	if x != nil {
+		return x.ReportRuns
+	}
+	return nil
+}
+
+func (x *ListReportRunsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetReportRunRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	ReportRunId   string                 `protobuf:"bytes,2,opt,name=report_run_id,json=reportRunId,proto3" json:"report_run_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReportRunRequest) Reset() {
+	*x = GetReportRunRequest{}
+	mi := &file_rgs_v1_reporting_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReportRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReportRunRequest) ProtoMessage() {}
+
+func (x *GetReportRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_reporting_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReportRunRequest.ProtoReflect.Descriptor instead.
+func (*GetReportRunRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_reporting_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetReportRunRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GetReportRunRequest) GetReportRunId() string {
+	if x != nil {
+		return x.ReportRunId
+	}
+	return ""
+}
+
+type GetReportRunResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	ReportRun     *ReportRun             `protobuf:"bytes,2,opt,name=report_run,json=reportRun,proto3" json:"report_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReportRunResponse) Reset() {
+	*x = GetReportRunResponse{}
+	mi := &file_rgs_v1_reporting_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReportRunResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReportRunResponse) ProtoMessage() {}
+
+func (x *GetReportRunResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_reporting_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReportRunResponse.ProtoReflect.Descriptor instead.
+func (*GetReportRunResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_reporting_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetReportRunResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GetReportRunResponse) GetReportRun() *ReportRun {
+	if x != nil {
+		return x.ReportRun
+	}
+	return nil
+}
+
+type VerifyReportRunRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Meta        *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	ReportRunId string                 `protobuf:"bytes,2,opt,name=report_run_id,json=reportRunId,proto3" json:"report_run_id,omitempty"`
+	// An exported copy of the report to check. When empty, the stored content
+	// of the run is checked.
+	Content       []byte `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReportRun) Reset() {
-	*x = ReportRun{}
-	mi := &file_rgs_v1_reporting_proto_msgTypes[0]
+func (x *VerifyReportRunRequest) Reset() {
+	*x = VerifyReportRunRequest{}
+	mi := &file_rgs_v1_reporting_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReportRun) String() string {
+func (x *VerifyReportRunRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReportRun) ProtoMessage() {}
+func (*VerifyReportRunRequest) ProtoMessage() {}
 
-func (x *ReportRun) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_reporting_proto_msgTypes[0]
+func (x *VerifyReportRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_reporting_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -269,114 +1636,150 @@ func (x *ReportRun) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReportRun.ProtoReflect.Descriptor instead.
-func (*ReportRun) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_reporting_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use VerifyReportRunRequest.ProtoReflect.Descriptor instead.
+func (*VerifyReportRunRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_reporting_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ReportRun) GetReportRunId() string {
+func (x *VerifyReportRunRequest) GetMeta() *RequestMeta {
 	if x != nil {
-		return x.ReportRunId
+		return x.Meta
 	}
-	return ""
+	return nil
 }
 
-func (x *ReportRun) GetReportType() ReportType {
+func (x *VerifyReportRunRequest) GetReportRunId() string {
 	if x != nil {
-		return x.ReportType
+		return x.ReportRunId
 	}
-	return ReportType_REPORT_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *ReportRun) GetInterval() ReportInterval {
+func (x *VerifyReportRunRequest) GetContent() []byte {
 	if x != nil {
-		return x.Interval
+		return x.Content
 	}
-	return ReportInterval_REPORT_INTERVAL_UNSPECIFIED
+	return nil
 }
 
-func (x *ReportRun) GetFormat() ReportFormat {
+type VerifyReportRunResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Meta        *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	ReportRunId string                 `protobuf:"bytes,2,opt,name=report_run_id,json=reportRunId,proto3" json:"report_run_id,omitempty"`
+	Verified    bool                   `protobuf:"varint,3,opt,name=verified,proto3" json:"verified,omitempty"`
+	// Hex SHA-256 of the content that was checked.
+	ContentSha256 string `protobuf:"bytes,4,opt,name=content_sha256,json=contentSha256,proto3" json:"content_sha256,omitempty"`
+	SignerKid     string `protobuf:"bytes,5,opt,name=signer_kid,json=signerKid,proto3" json:"signer_kid,omitempty"`
+	SignatureAlg  string `protobuf:"bytes,6,opt,name=signature_alg,json=signatureAlg,proto3" json:"signature_alg,omitempty"`
+	// Why verification failed; empty when verified.
+	FailureReason string `protobuf:"bytes,7,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyReportRunResponse) Reset() {
+	*x = VerifyReportRunResponse{}
+	mi := &file_rgs_v1_reporting_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyReportRunResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyReportRunResponse) ProtoMessage() {}
+
+func (x *VerifyReportRunResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_reporting_proto_msgTypes[16]
 	if x != nil {
-		return x.Format
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ReportFormat_REPORT_FORMAT_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *ReportRun) GetStatus() ReportRunStatus {
+// Deprecated: Use VerifyReportRunResponse.ProtoReflect.Descriptor instead.
+func (*VerifyReportRunResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_reporting_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *VerifyReportRunResponse) GetMeta() *ResponseMeta {
 	if x != nil {
-		return x.Status
+		return x.Meta
 	}
-	return ReportRunStatus_REPORT_RUN_STATUS_UNSPECIFIED
+	return nil
 }
 
-func (x *ReportRun) GetOperatorId() string {
+func (x *VerifyReportRunResponse) GetReportRunId() string {
 	if x != nil {
-		return x.OperatorId
+		return x.ReportRunId
 	}
 	return ""
 }
 
-func (x *ReportRun) GetReportTitle() string {
+func (x *VerifyReportRunResponse) GetVerified() bool {
 	if x != nil {
-		return x.ReportTitle
+		return x.Verified
 	}
-	return ""
+	return false
 }
 
-func (x *ReportRun) GetGeneratedAt() string {
+func (x *VerifyReportRunResponse) GetContentSha256() string {
 	if x != nil {
-		return x.GeneratedAt
+		return x.ContentSha256
 	}
 	return ""
 }
 
-func (x *ReportRun) GetNoActivity() bool {
+func (x *VerifyReportRunResponse) GetSignerKid() string {
 	if x != nil {
-		return x.NoActivity
+		return x.SignerKid
 	}
-	return false
+	return ""
 }
 
-func (x *ReportRun) GetContentType() string {
+func (x *VerifyReportRunResponse) GetSignatureAlg() string {
 	if x != nil {
-		return x.ContentType
+		return x.SignatureAlg
 	}
 	return ""
 }
 
-func (x *ReportRun) GetContent() []byte {
+func (x *VerifyReportRunResponse) GetFailureReason() string {
 	if x != nil {
-		return x.Content
+		return x.FailureReason
 	}
-	return nil
+	return ""
 }
 
-type GenerateReportRequest struct {
+type GenerateDailyPackRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	ReportType    ReportType             `protobuf:"varint,2,opt,name=report_type,json=reportType,proto3,enum=rgs.v1.ReportType" json:"report_type,omitempty"`
-	Interval      ReportInterval         `protobuf:"varint,3,opt,name=interval,proto3,enum=rgs.v1.ReportInterval" json:"interval,omitempty"`
-	Format        ReportFormat           `protobuf:"varint,4,opt,name=format,proto3,enum=rgs.v1.ReportFormat" json:"format,omitempty"`
-	OperatorId    string                 `protobuf:"bytes,5,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	GamingDay     string                 `protobuf:"bytes,2,opt,name=gaming_day,json=gamingDay,proto3" json:"gaming_day,omitempty"`
+	Force         bool                   `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GenerateReportRequest) Reset() {
-	*x = GenerateReportRequest{}
-	mi := &file_rgs_v1_reporting_proto_msgTypes[1]
+func (x *GenerateDailyPackRequest) Reset() {
+	*x = GenerateDailyPackRequest{}
+	mi := &file_rgs_v1_reporting_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GenerateReportRequest) String() string {
+func (x *GenerateDailyPackRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateReportRequest) ProtoMessage() {}
+func (*GenerateDailyPackRequest) ProtoMessage() {}
 
-func (x *GenerateReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_reporting_proto_msgTypes[1]
+func (x *GenerateDailyPackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_reporting_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -387,69 +1790,55 @@ func (x *GenerateReportRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateReportRequest.ProtoReflect.Descriptor instead.
-func (*GenerateReportRequest) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_reporting_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use GenerateDailyPackRequest.ProtoReflect.Descriptor instead.
+func (*GenerateDailyPackRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_reporting_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GenerateReportRequest) GetMeta() *RequestMeta {
+func (x *GenerateDailyPackRequest) GetMeta() *RequestMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *GenerateReportRequest) GetReportType() ReportType {
-	if x != nil {
-		return x.ReportType
-	}
-	return ReportType_REPORT_TYPE_UNSPECIFIED
-}
-
-func (x *GenerateReportRequest) GetInterval() ReportInterval {
-	if x != nil {
-		return x.Interval
-	}
-	return ReportInterval_REPORT_INTERVAL_UNSPECIFIED
-}
-
-func (x *GenerateReportRequest) GetFormat() ReportFormat {
+func (x *GenerateDailyPackRequest) GetGamingDay() string {
 	if x != nil {
-		return x.Format
+		return x.GamingDay
 	}
-	return ReportFormat_REPORT_FORMAT_UNSPECIFIED
+	return ""
 }
 
-func (x *GenerateReportRequest) GetOperatorId() string {
+func (x *GenerateDailyPackRequest) GetForce() bool {
 	if x != nil {
-		return x.OperatorId
+		return x.Force
 	}
-	return ""
+	return false
 }
 
-type GenerateReportResponse struct {
+type GenerateDailyPackResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	ReportRun     *ReportRun             `protobuf:"bytes,2,opt,name=report_run,json=reportRun,proto3" json:"report_run,omitempty"`
+	DailyPack     *DailyPack             `protobuf:"bytes,2,opt,name=daily_pack,json=dailyPack,proto3" json:"daily_pack,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GenerateReportResponse) Reset() {
-	*x = GenerateReportResponse{}
-	mi := &file_rgs_v1_reporting_proto_msgTypes[2]
+func (x *GenerateDailyPackResponse) Reset() {
+	*x = GenerateDailyPackResponse{}
+	mi := &file_rgs_v1_reporting_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GenerateReportResponse) String() string {
+func (x *GenerateDailyPackResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateReportResponse) ProtoMessage() {}
+func (*GenerateDailyPackResponse) ProtoMessage() {}
 
-func (x *GenerateReportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_reporting_proto_msgTypes[2]
+func (x *GenerateDailyPackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_reporting_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -460,50 +1849,49 @@ func (x *GenerateReportResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateReportResponse.ProtoReflect.Descriptor instead.
-func (*GenerateReportResponse) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_reporting_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use GenerateDailyPackResponse.ProtoReflect.Descriptor instead.
+func (*GenerateDailyPackResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_reporting_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GenerateReportResponse) GetMeta() *ResponseMeta {
+func (x *GenerateDailyPackResponse) GetMeta() *ResponseMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *GenerateReportResponse) GetReportRun() *ReportRun {
+func (x *GenerateDailyPackResponse) GetDailyPack() *DailyPack {
 	if x != nil {
-		return x.ReportRun
+		return x.DailyPack
 	}
 	return nil
 }
 
-type ListReportRunsRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Meta             *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	ReportTypeFilter ReportType             `protobuf:"varint,2,opt,name=report_type_filter,json=reportTypeFilter,proto3,enum=rgs.v1.ReportType" json:"report_type_filter,omitempty"`
-	PageSize         int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken        string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type ListDailyPacksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListReportRunsRequest) Reset() {
-	*x = ListReportRunsRequest{}
-	mi := &file_rgs_v1_reporting_proto_msgTypes[3]
+func (x *ListDailyPacksRequest) Reset() {
+	*x = ListDailyPacksRequest{}
+	mi := &file_rgs_v1_reporting_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListReportRunsRequest) String() string {
+func (x *ListDailyPacksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListReportRunsRequest) ProtoMessage() {}
+func (*ListDailyPacksRequest) ProtoMessage() {}
 
-func (x *ListReportRunsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_reporting_proto_msgTypes[3]
+func (x *ListDailyPacksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_reporting_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -514,63 +1902,56 @@ func (x *ListReportRunsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListReportRunsRequest.ProtoReflect.Descriptor instead.
-func (*ListReportRunsRequest) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_reporting_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ListDailyPacksRequest.ProtoReflect.Descriptor instead.
+func (*ListDailyPacksRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_reporting_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ListReportRunsRequest) GetMeta() *RequestMeta {
+func (x *ListDailyPacksRequest) GetMeta() *RequestMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *ListReportRunsRequest) GetReportTypeFilter() ReportType {
-	if x != nil {
-		return x.ReportTypeFilter
-	}
-	return ReportType_REPORT_TYPE_UNSPECIFIED
-}
-
-func (x *ListReportRunsRequest) GetPageSize() int32 {
+func (x *ListDailyPacksRequest) GetPageSize() int32 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-func (x *ListReportRunsRequest) GetPageToken() string {
+func (x *ListDailyPacksRequest) GetPageToken() string {
 	if x != nil {
 		return x.PageToken
 	}
 	return ""
 }
 
-type ListReportRunsResponse struct {
+type ListDailyPacksResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	ReportRuns    []*ReportRun           `protobuf:"bytes,2,rep,name=report_runs,json=reportRuns,proto3" json:"report_runs,omitempty"`
+	DailyPacks    []*DailyPack           `protobuf:"bytes,2,rep,name=daily_packs,json=dailyPacks,proto3" json:"daily_packs,omitempty"`
 	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListReportRunsResponse) Reset() {
-	*x = ListReportRunsResponse{}
-	mi := &file_rgs_v1_reporting_proto_msgTypes[4]
+func (x *ListDailyPacksResponse) Reset() {
+	*x = ListDailyPacksResponse{}
+	mi := &file_rgs_v1_reporting_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListReportRunsResponse) String() string {
+func (x *ListDailyPacksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListReportRunsResponse) ProtoMessage() {}
+func (*ListDailyPacksResponse) ProtoMessage() {}
 
-func (x *ListReportRunsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_reporting_proto_msgTypes[4]
+func (x *ListDailyPacksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_reporting_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -581,55 +1962,55 @@ func (x *ListReportRunsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListReportRunsResponse.ProtoReflect.Descriptor instead.
-func (*ListReportRunsResponse) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_reporting_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ListDailyPacksResponse.ProtoReflect.Descriptor instead.
+func (*ListDailyPacksResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_reporting_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ListReportRunsResponse) GetMeta() *ResponseMeta {
+func (x *ListDailyPacksResponse) GetMeta() *ResponseMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *ListReportRunsResponse) GetReportRuns() []*ReportRun {
+func (x *ListDailyPacksResponse) GetDailyPacks() []*DailyPack {
 	if x != nil {
-		return x.ReportRuns
+		return x.DailyPacks
 	}
 	return nil
 }
 
-func (x *ListReportRunsResponse) GetNextPageToken() string {
+func (x *ListDailyPacksResponse) GetNextPageToken() string {
 	if x != nil {
 		return x.NextPageToken
 	}
 	return ""
 }
 
-type GetReportRunRequest struct {
+type GetDailyPackRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	ReportRunId   string                 `protobuf:"bytes,2,opt,name=report_run_id,json=reportRunId,proto3" json:"report_run_id,omitempty"`
+	GamingDay     string                 `protobuf:"bytes,2,opt,name=gaming_day,json=gamingDay,proto3" json:"gaming_day,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetReportRunRequest) Reset() {
-	*x = GetReportRunRequest{}
-	mi := &file_rgs_v1_reporting_proto_msgTypes[5]
+func (x *GetDailyPackRequest) Reset() {
+	*x = GetDailyPackRequest{}
+	mi := &file_rgs_v1_reporting_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetReportRunRequest) String() string {
+func (x *GetDailyPackRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReportRunRequest) ProtoMessage() {}
+func (*GetDailyPackRequest) ProtoMessage() {}
 
-func (x *GetReportRunRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_reporting_proto_msgTypes[5]
+func (x *GetDailyPackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_reporting_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -640,48 +2021,48 @@ func (x *GetReportRunRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReportRunRequest.ProtoReflect.Descriptor instead.
-func (*GetReportRunRequest) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_reporting_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use GetDailyPackRequest.ProtoReflect.Descriptor instead.
+func (*GetDailyPackRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_reporting_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GetReportRunRequest) GetMeta() *RequestMeta {
+func (x *GetDailyPackRequest) GetMeta() *RequestMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *GetReportRunRequest) GetReportRunId() string {
+func (x *GetDailyPackRequest) GetGamingDay() string {
 	if x != nil {
-		return x.ReportRunId
+		return x.GamingDay
 	}
 	return ""
 }
 
-type GetReportRunResponse struct {
+type GetDailyPackResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	ReportRun     *ReportRun             `protobuf:"bytes,2,opt,name=report_run,json=reportRun,proto3" json:"report_run,omitempty"`
+	DailyPack     *DailyPack             `protobuf:"bytes,2,opt,name=daily_pack,json=dailyPack,proto3" json:"daily_pack,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetReportRunResponse) Reset() {
-	*x = GetReportRunResponse{}
-	mi := &file_rgs_v1_reporting_proto_msgTypes[6]
+func (x *GetDailyPackResponse) Reset() {
+	*x = GetDailyPackResponse{}
+	mi := &file_rgs_v1_reporting_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetReportRunResponse) String() string {
+func (x *GetDailyPackResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReportRunResponse) ProtoMessage() {}
+func (*GetDailyPackResponse) ProtoMessage() {}
 
-func (x *GetReportRunResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_reporting_proto_msgTypes[6]
+func (x *GetDailyPackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_reporting_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -692,21 +2073,21 @@ func (x *GetReportRunResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReportRunResponse.ProtoReflect.Descriptor instead.
-func (*GetReportRunResponse) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_reporting_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use GetDailyPackResponse.ProtoReflect.Descriptor instead.
+func (*GetDailyPackResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_reporting_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *GetReportRunResponse) GetMeta() *ResponseMeta {
+func (x *GetDailyPackResponse) GetMeta() *ResponseMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *GetReportRunResponse) GetReportRun() *ReportRun {
+func (x *GetDailyPackResponse) GetDailyPack() *DailyPack {
 	if x != nil {
-		return x.ReportRun
+		return x.DailyPack
 	}
 	return nil
 }
@@ -715,7 +2096,7 @@ var File_rgs_v1_reporting_proto protoreflect.FileDescriptor
 
 const file_rgs_v1_reporting_proto_rawDesc = "" +
 	"\n" +
-	"\x16rgs/v1/reporting.proto\x12\x06rgs.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x13rgs/v1/common.proto\"\xbc\x03\n" +
+	"\x16rgs/v1/reporting.proto\x12\x06rgs.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x13rgs/v1/common.proto\"\x95\x06\n" +
 	"\tReportRun\x12\"\n" +
 	"\rreport_run_id\x18\x01 \x01(\tR\vreportRunId\x123\n" +
 	"\vreport_type\x18\x02 \x01(\x0e2\x12.rgs.v1.ReportTypeR\n" +
@@ -731,7 +2112,78 @@ const file_rgs_v1_reporting_proto_rawDesc = "" +
 	"noActivity\x12!\n" +
 	"\fcontent_type\x18\n" +
 	" \x01(\tR\vcontentType\x12\x18\n" +
-	"\acontent\x18\v \x01(\fR\acontent\"\xf8\x01\n" +
+	"\acontent\x18\v \x01(\fR\acontent\x12%\n" +
+	"\x0efailure_reason\x18\f \x01(\tR\rfailureReason\x129\n" +
+	"\n" +
+	"deliveries\x18\r \x03(\v2\x19.rgs.v1.ReportRunDeliveryR\n" +
+	"deliveries\x12!\n" +
+	"\fperiod_start\x18\x0e \x01(\tR\vperiodStart\x12\x1d\n" +
+	"\n" +
+	"period_end\x18\x0f \x01(\tR\tperiodEnd\x12%\n" +
+	"\x0econtent_sha256\x18\x10 \x01(\tR\rcontentSha256\x12\x1d\n" +
+	"\n" +
+	"signer_kid\x18\x11 \x01(\tR\tsignerKid\x12\x1c\n" +
+	"\tsignature\x18\x12 \x01(\tR\tsignature\x12#\n" +
+	"\rsignature_alg\x18\x13 \x01(\tR\fsignatureAlg\x12*\n" +
+	"\x11content_purged_at\x18\x14 \x01(\tR\x0fcontentPurgedAt\"\xd3\x01\n" +
+	"\x11ReportRunDelivery\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\tR\x06target\x12\x1c\n" +
+	"\tdelivered\x18\x02 \x01(\bR\tdelivered\x12\x1a\n" +
+	"\battempts\x18\x03 \x01(\x05R\battempts\x12*\n" +
+	"\x11last_attempted_at\x18\x04 \x01(\tR\x0flastAttemptedAt\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x05 \x01(\tR\tlastError\x12!\n" +
+	"\fdelivered_at\x18\x06 \x01(\tR\vdeliveredAt\"\xa5\x01\n" +
+	"\x11DailyPackArtifact\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x16\n" +
+	"\x06sha256\x18\x03 \x01(\tR\x06sha256\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x04 \x01(\x03R\tsizeBytes\x12\"\n" +
+	"\rreport_run_id\x18\x05 \x01(\tR\vreportRunId\"\x86\x01\n" +
+	"\x1aDailyPackCurrencyLiability\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12'\n" +
+	"\x0favailable_minor\x18\x02 \x01(\x03R\x0eavailableMinor\x12#\n" +
+	"\rpending_minor\x18\x03 \x01(\x03R\fpendingMinor\"\xb2\x03\n" +
+	"\x17DailyPackReconciliation\x12\x1a\n" +
+	"\bbalanced\x18\x01 \x01(\bR\bbalanced\x12+\n" +
+	"\x11transaction_count\x18\x02 \x01(\x03R\x10transactionCount\x12.\n" +
+	"\x13total_credits_minor\x18\x03 \x01(\x03R\x11totalCreditsMinor\x12,\n" +
+	"\x12total_debits_minor\x18\x04 \x01(\x03R\x10totalDebitsMinor\x12:\n" +
+	"\x19liability_available_minor\x18\x05 \x01(\x03R\x17liabilityAvailableMinor\x126\n" +
+	"\x17liability_pending_minor\x18\x06 \x01(\x03R\x15liabilityPendingMinor\x12$\n" +
+	"\rdiscrepancies\x18\a \x03(\tR\rdiscrepancies\x12V\n" +
+	"\x15liability_by_currency\x18\b \x03(\v2\".rgs.v1.DailyPackCurrencyLiabilityR\x13liabilityByCurrency\"\xd3\x01\n" +
+	"\x11DailyPackDelivery\x12\x12\n" +
+	"\x04sink\x18\x01 \x01(\tR\x04sink\x12\x1c\n" +
+	"\tdelivered\x18\x02 \x01(\bR\tdelivered\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12!\n" +
+	"\fattempted_at\x18\x04 \x01(\tR\vattemptedAt\x12\x1c\n" +
+	"\tencrypted\x18\x05 \x01(\bR\tencrypted\x125\n" +
+	"\x16recipient_fingerprints\x18\x06 \x03(\tR\x15recipientFingerprints\"\xab\x05\n" +
+	"\tDailyPack\x12\x17\n" +
+	"\apack_id\x18\x01 \x01(\tR\x06packId\x12\x1d\n" +
+	"\n" +
+	"gaming_day\x18\x02 \x01(\tR\tgamingDay\x12/\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x17.rgs.v1.DailyPackStatusR\x06status\x12\x1f\n" +
+	"\voperator_id\x18\x04 \x01(\tR\n" +
+	"operatorId\x12$\n" +
+	"\x0ereport_run_ids\x18\x05 \x03(\tR\freportRunIds\x127\n" +
+	"\tartifacts\x18\x06 \x03(\v2\x19.rgs.v1.DailyPackArtifactR\tartifacts\x12*\n" +
+	"\x11audit_event_count\x18\a \x01(\x03R\x0fauditEventCount\x120\n" +
+	"\x14audit_chain_verified\x18\b \x01(\bR\x12auditChainVerified\x12G\n" +
+	"\x0ereconciliation\x18\t \x01(\v2\x1f.rgs.v1.DailyPackReconciliationR\x0ereconciliation\x12'\n" +
+	"\x0fmanifest_sha256\x18\n" +
+	" \x01(\tR\x0emanifestSha256\x12\x1d\n" +
+	"\n" +
+	"signer_kid\x18\v \x01(\tR\tsignerKid\x12\x1c\n" +
+	"\tsignature\x18\f \x01(\tR\tsignature\x12#\n" +
+	"\rsignature_alg\x18\r \x01(\tR\fsignatureAlg\x129\n" +
+	"\n" +
+	"deliveries\x18\x0e \x03(\v2\x19.rgs.v1.DailyPackDeliveryR\n" +
+	"deliveries\x12!\n" +
+	"\fgenerated_at\x18\x0f \x01(\tR\vgeneratedAt\x12%\n" +
+	"\x0efailure_reason\x18\x10 \x01(\tR\rfailureReason\"\x93\x02\n" +
 	"\x15GenerateReportRequest\x12'\n" +
 	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x123\n" +
 	"\vreport_type\x18\x02 \x01(\x0e2\x12.rgs.v1.ReportTypeR\n" +
@@ -739,17 +2191,34 @@ const file_rgs_v1_reporting_proto_rawDesc = "" +
 	"\binterval\x18\x03 \x01(\x0e2\x16.rgs.v1.ReportIntervalR\binterval\x12,\n" +
 	"\x06format\x18\x04 \x01(\x0e2\x14.rgs.v1.ReportFormatR\x06format\x12\x1f\n" +
 	"\voperator_id\x18\x05 \x01(\tR\n" +
-	"operatorId\"t\n" +
+	"operatorId\x12\x19\n" +
+	"\bgroup_id\x18\x06 \x01(\tR\agroupId\"t\n" +
 	"\x16GenerateReportResponse\x12(\n" +
 	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x120\n" +
 	"\n" +
-	"report_run\x18\x02 \x01(\v2\x11.rgs.v1.ReportRunR\treportRun\"\xbe\x01\n" +
+	"report_run\x18\x02 \x01(\v2\x11.rgs.v1.ReportRunR\treportRun\"\x98\x02\n" +
+	"\x1aGenerateReportAsyncRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x123\n" +
+	"\vreport_type\x18\x02 \x01(\x0e2\x12.rgs.v1.ReportTypeR\n" +
+	"reportType\x122\n" +
+	"\binterval\x18\x03 \x01(\x0e2\x16.rgs.v1.ReportIntervalR\binterval\x12,\n" +
+	"\x06format\x18\x04 \x01(\x0e2\x14.rgs.v1.ReportFormatR\x06format\x12\x1f\n" +
+	"\voperator_id\x18\x05 \x01(\tR\n" +
+	"operatorId\x12\x19\n" +
+	"\bgroup_id\x18\x06 \x01(\tR\agroupId\"\x9d\x01\n" +
+	"\x1bGenerateReportAsyncResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12\"\n" +
+	"\rreport_run_id\x18\x02 \x01(\tR\vreportRunId\x120\n" +
+	"\n" +
+	"report_run\x18\x03 \x01(\v2\x11.rgs.v1.ReportRunR\treportRun\"\xdf\x01\n" +
 	"\x15ListReportRunsRequest\x12'\n" +
 	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12@\n" +
 	"\x12report_type_filter\x18\x02 \x01(\x0e2\x12.rgs.v1.ReportTypeR\x10reportTypeFilter\x12\x1b\n" +
 	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x04 \x01(\tR\tpageToken\"\x9e\x01\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\x12\x1f\n" +
+	"\voperator_id\x18\x05 \x01(\tR\n" +
+	"operatorId\"\x9e\x01\n" +
 	"\x16ListReportRunsResponse\x12(\n" +
 	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x122\n" +
 	"\vreport_runs\x18\x02 \x03(\v2\x11.rgs.v1.ReportRunR\n" +
@@ -761,31 +2230,87 @@ const file_rgs_v1_reporting_proto_rawDesc = "" +
 	"\x14GetReportRunResponse\x12(\n" +
 	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x120\n" +
 	"\n" +
-	"report_run\x18\x02 \x01(\v2\x11.rgs.v1.ReportRunR\treportRun*\xb4\x01\n" +
+	"report_run\x18\x02 \x01(\v2\x11.rgs.v1.ReportRunR\treportRun\"\x7f\n" +
+	"\x16VerifyReportRunRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\"\n" +
+	"\rreport_run_id\x18\x02 \x01(\tR\vreportRunId\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent\"\x95\x02\n" +
+	"\x17VerifyReportRunResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12\"\n" +
+	"\rreport_run_id\x18\x02 \x01(\tR\vreportRunId\x12\x1a\n" +
+	"\bverified\x18\x03 \x01(\bR\bverified\x12%\n" +
+	"\x0econtent_sha256\x18\x04 \x01(\tR\rcontentSha256\x12\x1d\n" +
+	"\n" +
+	"signer_kid\x18\x05 \x01(\tR\tsignerKid\x12#\n" +
+	"\rsignature_alg\x18\x06 \x01(\tR\fsignatureAlg\x12%\n" +
+	"\x0efailure_reason\x18\a \x01(\tR\rfailureReason\"x\n" +
+	"\x18GenerateDailyPackRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x1d\n" +
+	"\n" +
+	"gaming_day\x18\x02 \x01(\tR\tgamingDay\x12\x14\n" +
+	"\x05force\x18\x03 \x01(\bR\x05force\"w\n" +
+	"\x19GenerateDailyPackResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x120\n" +
+	"\n" +
+	"daily_pack\x18\x02 \x01(\v2\x11.rgs.v1.DailyPackR\tdailyPack\"|\n" +
+	"\x15ListDailyPacksRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x9e\x01\n" +
+	"\x16ListDailyPacksResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x122\n" +
+	"\vdaily_packs\x18\x02 \x03(\v2\x11.rgs.v1.DailyPackR\n" +
+	"dailyPacks\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"]\n" +
+	"\x13GetDailyPackRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x1d\n" +
+	"\n" +
+	"gaming_day\x18\x02 \x01(\tR\tgamingDay\"r\n" +
+	"\x14GetDailyPackResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x120\n" +
+	"\n" +
+	"daily_pack\x18\x02 \x01(\v2\x11.rgs.v1.DailyPackR\tdailyPack*\x90\x02\n" +
 	"\n" +
 	"ReportType\x12\x1b\n" +
 	"\x17REPORT_TYPE_UNSPECIFIED\x10\x00\x12.\n" +
 	"*REPORT_TYPE_SIGNIFICANT_EVENTS_ALTERATIONS\x10\x01\x12*\n" +
 	"&REPORT_TYPE_CASHLESS_LIABILITY_SUMMARY\x10\x02\x12-\n" +
-	")REPORT_TYPE_ACCOUNT_TRANSACTION_STATEMENT\x10\x03*\x95\x01\n" +
+	")REPORT_TYPE_ACCOUNT_TRANSACTION_STATEMENT\x10\x03\x12\x1b\n" +
+	"\x17REPORT_TYPE_RTP_SUMMARY\x10\x04\x12\x1f\n" +
+	"\x1bREPORT_TYPE_PROMO_LIABILITY\x10\x05\x12\x1c\n" +
+	"\x18REPORT_TYPE_EFT_ACTIVITY\x10\x06*\x95\x01\n" +
 	"\x0eReportInterval\x12\x1f\n" +
 	"\x1bREPORT_INTERVAL_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13REPORT_INTERVAL_DTD\x10\x01\x12\x17\n" +
 	"\x13REPORT_INTERVAL_MTD\x10\x02\x12\x17\n" +
 	"\x13REPORT_INTERVAL_YTD\x10\x03\x12\x17\n" +
-	"\x13REPORT_INTERVAL_LTD\x10\x04*\\\n" +
+	"\x13REPORT_INTERVAL_LTD\x10\x04*s\n" +
 	"\fReportFormat\x12\x1d\n" +
 	"\x19REPORT_FORMAT_UNSPECIFIED\x10\x00\x12\x16\n" +
 	"\x12REPORT_FORMAT_JSON\x10\x01\x12\x15\n" +
-	"\x11REPORT_FORMAT_CSV\x10\x02*s\n" +
+	"\x11REPORT_FORMAT_CSV\x10\x02\x12\x15\n" +
+	"\x11REPORT_FORMAT_PDF\x10\x03*\xb1\x01\n" +
 	"\x0fReportRunStatus\x12!\n" +
 	"\x1dREPORT_RUN_STATUS_UNSPECIFIED\x10\x00\x12\x1f\n" +
 	"\x1bREPORT_RUN_STATUS_COMPLETED\x10\x01\x12\x1c\n" +
-	"\x18REPORT_RUN_STATUS_FAILED\x10\x022\xe6\x02\n" +
+	"\x18REPORT_RUN_STATUS_FAILED\x10\x02\x12\x1d\n" +
+	"\x19REPORT_RUN_STATUS_PENDING\x10\x03\x12\x1d\n" +
+	"\x19REPORT_RUN_STATUS_RUNNING\x10\x04*\x92\x01\n" +
+	"\x0fDailyPackStatus\x12!\n" +
+	"\x1dDAILY_PACK_STATUS_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bDAILY_PACK_STATUS_COMPLETED\x10\x01\x12\x1d\n" +
+	"\x19DAILY_PACK_STATUS_PARTIAL\x10\x02\x12\x1c\n" +
+	"\x18DAILY_PACK_STATUS_FAILED\x10\x032\xe6\a\n" +
 	"\x10ReportingService\x12n\n" +
-	"\x0eGenerateReport\x12\x1d.rgs.v1.GenerateReportRequest\x1a\x1e.rgs.v1.GenerateReportResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/reporting/runs\x12k\n" +
+	"\x0eGenerateReport\x12\x1d.rgs.v1.GenerateReportRequest\x1a\x1e.rgs.v1.GenerateReportResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/reporting/runs\x12\x83\x01\n" +
+	"\x13GenerateReportAsync\x12\".rgs.v1.GenerateReportAsyncRequest\x1a#.rgs.v1.GenerateReportAsyncResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/reporting/runs:async\x12k\n" +
 	"\x0eListReportRuns\x12\x1d.rgs.v1.ListReportRunsRequest\x1a\x1e.rgs.v1.ListReportRunsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/reporting/runs\x12u\n" +
-	"\fGetReportRun\x12\x1b.rgs.v1.GetReportRunRequest\x1a\x1c.rgs.v1.GetReportRunResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/reporting/runs/{report_run_id}B\x90\x01\n" +
+	"\fGetReportRun\x12\x1b.rgs.v1.GetReportRunRequest\x1a\x1c.rgs.v1.GetReportRunResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/reporting/runs/{report_run_id}\x12\x88\x01\n" +
+	"\x0fVerifyReportRun\x12\x1e.rgs.v1.VerifyReportRunRequest\x1a\x1f.rgs.v1.VerifyReportRunResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\")/v1/reporting/runs/{report_run_id}:verify\x12~\n" +
+	"\x11GenerateDailyPack\x12 .rgs.v1.GenerateDailyPackRequest\x1a!.rgs.v1.GenerateDailyPackResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/reporting/daily-packs\x12r\n" +
+	"\x0eListDailyPacks\x12\x1d.rgs.v1.ListDailyPacksRequest\x1a\x1e.rgs.v1.ListDailyPacksResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/reporting/daily-packs\x12y\n" +
+	"\fGetDailyPack\x12\x1b.rgs.v1.GetDailyPackRequest\x1a\x1c.rgs.v1.GetDailyPackResponse\".\x82\xd3\xe4\x93\x02(\x12&/v1/reporting/daily-packs/{gaming_day}B\x90\x01\n" +
 	"\n" +
 	"com.rgs.v1B\x0eReportingProtoP\x01Z9github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1;rgsv1\xa2\x02\x03RXX\xaa\x02\x06Rgs.V1\xca\x02\x06Rgs\\V1\xe2\x02\x12Rgs\\V1\\GPBMetadata\xea\x02\aRgs::V1b\x06proto3"
 
@@ -801,52 +2326,102 @@ func file_rgs_v1_reporting_proto_rawDescGZIP() []byte {
 	return file_rgs_v1_reporting_proto_rawDescData
 }
 
-var file_rgs_v1_reporting_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_rgs_v1_reporting_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_rgs_v1_reporting_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_rgs_v1_reporting_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_rgs_v1_reporting_proto_goTypes = []any{
-	(ReportType)(0),                // 0: rgs.v1.ReportType
-	(ReportInterval)(0),            // 1: rgs.v1.ReportInterval
-	(ReportFormat)(0),              // 2: rgs.v1.ReportFormat
-	(ReportRunStatus)(0),           // 3: rgs.v1.ReportRunStatus
-	(*ReportRun)(nil),              // 4: rgs.v1.ReportRun
-	(*GenerateReportRequest)(nil),  // 5: rgs.v1.GenerateReportRequest
-	(*GenerateReportResponse)(nil), // 6: rgs.v1.GenerateReportResponse
-	(*ListReportRunsRequest)(nil),  // 7: rgs.v1.ListReportRunsRequest
-	(*ListReportRunsResponse)(nil), // 8: rgs.v1.ListReportRunsResponse
-	(*GetReportRunRequest)(nil),    // 9: rgs.v1.GetReportRunRequest
-	(*GetReportRunResponse)(nil),   // 10: rgs.v1.GetReportRunResponse
-	(*RequestMeta)(nil),            // 11: rgs.v1.RequestMeta
-	(*ResponseMeta)(nil),           // 12: rgs.v1.ResponseMeta
+	(ReportType)(0),                     // 0: rgs.v1.ReportType
+	(ReportInterval)(0),                 // 1: rgs.v1.ReportInterval
+	(ReportFormat)(0),                   // 2: rgs.v1.ReportFormat
+	(ReportRunStatus)(0),                // 3: rgs.v1.ReportRunStatus
+	(DailyPackStatus)(0),                // 4: rgs.v1.DailyPackStatus
+	(*ReportRun)(nil),                   // 5: rgs.v1.ReportRun
+	(*ReportRunDelivery)(nil),           // 6: rgs.v1.ReportRunDelivery
+	(*DailyPackArtifact)(nil),           // 7: rgs.v1.DailyPackArtifact
+	(*DailyPackCurrencyLiability)(nil),  // 8: rgs.v1.DailyPackCurrencyLiability
+	(*DailyPackReconciliation)(nil),     // 9: rgs.v1.DailyPackReconciliation
+	(*DailyPackDelivery)(nil),           // 10: rgs.v1.DailyPackDelivery
+	(*DailyPack)(nil),                   // 11: rgs.v1.DailyPack
+	(*GenerateReportRequest)(nil),       // 12: rgs.v1.GenerateReportRequest
+	(*GenerateReportResponse)(nil),      // 13: rgs.v1.GenerateReportResponse
+	(*GenerateReportAsyncRequest)(nil),  // 14: rgs.v1.GenerateReportAsyncRequest
+	(*GenerateReportAsyncResponse)(nil), // 15: rgs.v1.GenerateReportAsyncResponse
+	(*ListReportRunsRequest)(nil),       // 16: rgs.v1.ListReportRunsRequest
+	(*ListReportRunsResponse)(nil),      // 17: rgs.v1.ListReportRunsResponse
+	(*GetReportRunRequest)(nil),         // 18: rgs.v1.GetReportRunRequest
+	(*GetReportRunResponse)(nil),        // 19: rgs.v1.GetReportRunResponse
+	(*VerifyReportRunRequest)(nil),      // 20: rgs.v1.VerifyReportRunRequest
+	(*VerifyReportRunResponse)(nil),     // 21: rgs.v1.VerifyReportRunResponse
+	(*GenerateDailyPackRequest)(nil),    // 22: rgs.v1.GenerateDailyPackRequest
+	(*GenerateDailyPackResponse)(nil),   // 23: rgs.v1.GenerateDailyPackResponse
+	(*ListDailyPacksRequest)(nil),       // 24: rgs.v1.ListDailyPacksRequest
+	(*ListDailyPacksResponse)(nil),      // 25: rgs.v1.ListDailyPacksResponse
+	(*GetDailyPackRequest)(nil),         // 26: rgs.v1.GetDailyPackRequest
+	(*GetDailyPackResponse)(nil),        // 27: rgs.v1.GetDailyPackResponse
+	(*RequestMeta)(nil),                 // 28: rgs.v1.RequestMeta
+	(*ResponseMeta)(nil),                // 29: rgs.v1.ResponseMeta
 }
 var file_rgs_v1_reporting_proto_depIdxs = []int32{
 	0,  // 0: rgs.v1.ReportRun.report_type:type_name -> rgs.v1.ReportType
 	1,  // 1: rgs.v1.ReportRun.interval:type_name -> rgs.v1.ReportInterval
 	2,  // 2: rgs.v1.ReportRun.format:type_name -> rgs.v1.ReportFormat
 	3,  // 3: rgs.v1.ReportRun.status:type_name -> rgs.v1.ReportRunStatus
-	11, // 4: rgs.v1.GenerateReportRequest.meta:type_name -> rgs.v1.RequestMeta
-	0,  // 5: rgs.v1.GenerateReportRequest.report_type:type_name -> rgs.v1.ReportType
-	1,  // 6: rgs.v1.GenerateReportRequest.interval:type_name -> rgs.v1.ReportInterval
-	2,  // 7: rgs.v1.GenerateReportRequest.format:type_name -> rgs.v1.ReportFormat
-	12, // 8: rgs.v1.GenerateReportResponse.meta:type_name -> rgs.v1.ResponseMeta
-	4,  // 9: rgs.v1.GenerateReportResponse.report_run:type_name -> rgs.v1.ReportRun
-	11, // 10: rgs.v1.ListReportRunsRequest.meta:type_name -> rgs.v1.RequestMeta
-	0,  // 11: rgs.v1.ListReportRunsRequest.report_type_filter:type_name -> rgs.v1.ReportType
-	12, // 12: rgs.v1.ListReportRunsResponse.meta:type_name -> rgs.v1.ResponseMeta
-	4,  // 13: rgs.v1.ListReportRunsResponse.report_runs:type_name -> rgs.v1.ReportRun
-	11, // 14: rgs.v1.GetReportRunRequest.meta:type_name -> rgs.v1.RequestMeta
-	12, // 15: rgs.v1.GetReportRunResponse.meta:type_name -> rgs.v1.ResponseMeta
-	4,  // 16: rgs.v1.GetReportRunResponse.report_run:type_name -> rgs.v1.ReportRun
-	5,  // 17: rgs.v1.ReportingService.GenerateReport:input_type -> rgs.v1.GenerateReportRequest
-	7,  // 18: rgs.v1.ReportingService.ListReportRuns:input_type -> rgs.v1.ListReportRunsRequest
-	9,  // 19: rgs.v1.ReportingService.GetReportRun:input_type -> rgs.v1.GetReportRunRequest
-	6,  // 20: rgs.v1.ReportingService.GenerateReport:output_type -> rgs.v1.GenerateReportResponse
-	8,  // 21: rgs.v1.ReportingService.ListReportRuns:output_type -> rgs.v1.ListReportRunsResponse
-	10, // 22: rgs.v1.ReportingService.GetReportRun:output_type -> rgs.v1.GetReportRunResponse
-	20, // [20:23] is the sub-list for method output_type
-	17, // [17:20] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	6,  // 4: rgs.v1.ReportRun.deliveries:type_name -> rgs.v1.ReportRunDelivery
+	8,  // 5: rgs.v1.DailyPackReconciliation.liability_by_currency:type_name -> rgs.v1.DailyPackCurrencyLiability
+	4,  // 6: rgs.v1.DailyPack.status:type_name -> rgs.v1.DailyPackStatus
+	7,  // 7: rgs.v1.DailyPack.artifacts:type_name -> rgs.v1.DailyPackArtifact
+	9,  // 8: rgs.v1.DailyPack.reconciliation:type_name -> rgs.v1.DailyPackReconciliation
+	10, // 9: rgs.v1.DailyPack.deliveries:type_name -> rgs.v1.DailyPackDelivery
+	28, // 10: rgs.v1.GenerateReportRequest.meta:type_name -> rgs.v1.RequestMeta
+	0,  // 11: rgs.v1.GenerateReportRequest.report_type:type_name -> rgs.v1.ReportType
+	1,  // 12: rgs.v1.GenerateReportRequest.interval:type_name -> rgs.v1.ReportInterval
+	2,  // 13: rgs.v1.GenerateReportRequest.format:type_name -> rgs.v1.ReportFormat
+	29, // 14: rgs.v1.GenerateReportResponse.meta:type_name -> rgs.v1.ResponseMeta
+	5,  // 15: rgs.v1.GenerateReportResponse.report_run:type_name -> rgs.v1.ReportRun
+	28, // 16: rgs.v1.GenerateReportAsyncRequest.meta:type_name -> rgs.v1.RequestMeta
+	0,  // 17: rgs.v1.GenerateReportAsyncRequest.report_type:type_name -> rgs.v1.ReportType
+	1,  // 18: rgs.v1.GenerateReportAsyncRequest.interval:type_name -> rgs.v1.ReportInterval
+	2,  // 19: rgs.v1.GenerateReportAsyncRequest.format:type_name -> rgs.v1.ReportFormat
+	29, // 20: rgs.v1.GenerateReportAsyncResponse.meta:type_name -> rgs.v1.ResponseMeta
+	5,  // 21: rgs.v1.GenerateReportAsyncResponse.report_run:type_name -> rgs.v1.ReportRun
+	28, // 22: rgs.v1.ListReportRunsRequest.meta:type_name -> rgs.v1.RequestMeta
+	0,  // 23: rgs.v1.ListReportRunsRequest.report_type_filter:type_name -> rgs.v1.ReportType
+	29, // 24: rgs.v1.ListReportRunsResponse.meta:type_name -> rgs.v1.ResponseMeta
+	5,  // 25: rgs.v1.ListReportRunsResponse.report_runs:type_name -> rgs.v1.ReportRun
+	28, // 26: rgs.v1.GetReportRunRequest.meta:type_name -> rgs.v1.RequestMeta
+	29, // 27: rgs.v1.GetReportRunResponse.meta:type_name -> rgs.v1.ResponseMeta
+	5,  // 28: rgs.v1.GetReportRunResponse.report_run:type_name -> rgs.v1.ReportRun
+	28, // 29: rgs.v1.VerifyReportRunRequest.meta:type_name -> rgs.v1.RequestMeta
+	29, // 30: rgs.v1.VerifyReportRunResponse.meta:type_name -> rgs.v1.ResponseMeta
+	28, // 31: rgs.v1.GenerateDailyPackRequest.meta:type_name -> rgs.v1.RequestMeta
+	29, // 32: rgs.v1.GenerateDailyPackResponse.meta:type_name -> rgs.v1.ResponseMeta
+	11, // 33: rgs.v1.GenerateDailyPackResponse.daily_pack:type_name -> rgs.v1.DailyPack
+	28, // 34: rgs.v1.ListDailyPacksRequest.meta:type_name -> rgs.v1.RequestMeta
+	29, // 35: rgs.v1.ListDailyPacksResponse.meta:type_name -> rgs.v1.ResponseMeta
+	11, // 36: rgs.v1.ListDailyPacksResponse.daily_packs:type_name -> rgs.v1.DailyPack
+	28, // 37: rgs.v1.GetDailyPackRequest.meta:type_name -> rgs.v1.RequestMeta
+	29, // 38: rgs.v1.GetDailyPackResponse.meta:type_name -> rgs.v1.ResponseMeta
+	11, // 39: rgs.v1.GetDailyPackResponse.daily_pack:type_name -> rgs.v1.DailyPack
+	12, // 40: rgs.v1.ReportingService.GenerateReport:input_type -> rgs.v1.GenerateReportRequest
+	14, // 41: rgs.v1.ReportingService.GenerateReportAsync:input_type -> rgs.v1.GenerateReportAsyncRequest
+	16, // 42: rgs.v1.ReportingService.ListReportRuns:input_type -> rgs.v1.ListReportRunsRequest
+	18, // 43: rgs.v1.ReportingService.GetReportRun:input_type -> rgs.v1.GetReportRunRequest
+	20, // 44: rgs.v1.ReportingService.VerifyReportRun:input_type -> rgs.v1.VerifyReportRunRequest
+	22, // 45: rgs.v1.ReportingService.GenerateDailyPack:input_type -> rgs.v1.GenerateDailyPackRequest
+	24, // 46: rgs.v1.ReportingService.ListDailyPacks:input_type -> rgs.v1.ListDailyPacksRequest
+	26, // 47: rgs.v1.ReportingService.GetDailyPack:input_type -> rgs.v1.GetDailyPackRequest
+	13, // 48: rgs.v1.ReportingService.GenerateReport:output_type -> rgs.v1.GenerateReportResponse
+	15, // 49: rgs.v1.ReportingService.GenerateReportAsync:output_type -> rgs.v1.GenerateReportAsyncResponse
+	17, // 50: rgs.v1.ReportingService.ListReportRuns:output_type -> rgs.v1.ListReportRunsResponse
+	19, // 51: rgs.v1.ReportingService.GetReportRun:output_type -> rgs.v1.GetReportRunResponse
+	21, // 52: rgs.v1.ReportingService.VerifyReportRun:output_type -> rgs.v1.VerifyReportRunResponse
+	23, // 53: rgs.v1.ReportingService.GenerateDailyPack:output_type -> rgs.v1.GenerateDailyPackResponse
+	25, // 54: rgs.v1.ReportingService.ListDailyPacks:output_type -> rgs.v1.ListDailyPacksResponse
+	27, // 55: rgs.v1.ReportingService.GetDailyPack:output_type -> rgs.v1.GetDailyPackResponse
+	48, // [48:56] is the sub-list for method output_type
+	40, // [40:48] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
 }
 
 func init() { file_rgs_v1_reporting_proto_init() }
@@ -860,8 +2435,8 @@ func file_rgs_v1_reporting_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rgs_v1_reporting_proto_rawDesc), len(file_rgs_v1_reporting_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   7,
+			NumEnums:      5,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
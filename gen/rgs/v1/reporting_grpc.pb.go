@@ -19,9 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ReportingService_GenerateReport_FullMethodName = "/rgs.v1.ReportingService/GenerateReport"
-	ReportingService_ListReportRuns_FullMethodName = "/rgs.v1.ReportingService/ListReportRuns"
-	ReportingService_GetReportRun_FullMethodName   = "/rgs.v1.ReportingService/GetReportRun"
+	ReportingService_GenerateReport_FullMethodName      = "/rgs.v1.ReportingService/GenerateReport"
+	ReportingService_GenerateReportAsync_FullMethodName = "/rgs.v1.ReportingService/GenerateReportAsync"
+	ReportingService_ListReportRuns_FullMethodName      = "/rgs.v1.ReportingService/ListReportRuns"
+	ReportingService_GetReportRun_FullMethodName        = "/rgs.v1.ReportingService/GetReportRun"
+	ReportingService_VerifyReportRun_FullMethodName     = "/rgs.v1.ReportingService/VerifyReportRun"
+	ReportingService_GenerateDailyPack_FullMethodName   = "/rgs.v1.ReportingService/GenerateDailyPack"
+	ReportingService_ListDailyPacks_FullMethodName      = "/rgs.v1.ReportingService/ListDailyPacks"
+	ReportingService_GetDailyPack_FullMethodName        = "/rgs.v1.ReportingService/GetDailyPack"
 )
 
 // ReportingServiceClient is the client API for ReportingService service.
@@ -29,8 +34,13 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ReportingServiceClient interface {
 	GenerateReport(ctx context.Context, in *GenerateReportRequest, opts ...grpc.CallOption) (*GenerateReportResponse, error)
+	GenerateReportAsync(ctx context.Context, in *GenerateReportAsyncRequest, opts ...grpc.CallOption) (*GenerateReportAsyncResponse, error)
 	ListReportRuns(ctx context.Context, in *ListReportRunsRequest, opts ...grpc.CallOption) (*ListReportRunsResponse, error)
 	GetReportRun(ctx context.Context, in *GetReportRunRequest, opts ...grpc.CallOption) (*GetReportRunResponse, error)
+	VerifyReportRun(ctx context.Context, in *VerifyReportRunRequest, opts ...grpc.CallOption) (*VerifyReportRunResponse, error)
+	GenerateDailyPack(ctx context.Context, in *GenerateDailyPackRequest, opts ...grpc.CallOption) (*GenerateDailyPackResponse, error)
+	ListDailyPacks(ctx context.Context, in *ListDailyPacksRequest, opts ...grpc.CallOption) (*ListDailyPacksResponse, error)
+	GetDailyPack(ctx context.Context, in *GetDailyPackRequest, opts ...grpc.CallOption) (*GetDailyPackResponse, error)
 }
 
 type reportingServiceClient struct {
@@ -51,6 +61,16 @@ func (c *reportingServiceClient) GenerateReport(ctx context.Context, in *Generat
 	return out, nil
 }
 
+func (c *reportingServiceClient) GenerateReportAsync(ctx context.Context, in *GenerateReportAsyncRequest, opts ...grpc.CallOption) (*GenerateReportAsyncResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateReportAsyncResponse)
+	err := c.cc.Invoke(ctx, ReportingService_GenerateReportAsync_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *reportingServiceClient) ListReportRuns(ctx context.Context, in *ListReportRunsRequest, opts ...grpc.CallOption) (*ListReportRunsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListReportRunsResponse)
@@ -71,13 +91,58 @@ func (c *reportingServiceClient) GetReportRun(ctx context.Context, in *GetReport
 	return out, nil
 }
 
+func (c *reportingServiceClient) VerifyReportRun(ctx context.Context, in *VerifyReportRunRequest, opts ...grpc.CallOption) (*VerifyReportRunResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyReportRunResponse)
+	err := c.cc.Invoke(ctx, ReportingService_VerifyReportRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reportingServiceClient) GenerateDailyPack(ctx context.Context, in *GenerateDailyPackRequest, opts ...grpc.CallOption) (*GenerateDailyPackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateDailyPackResponse)
+	err := c.cc.Invoke(ctx, ReportingService_GenerateDailyPack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reportingServiceClient) ListDailyPacks(ctx context.Context, in *ListDailyPacksRequest, opts ...grpc.CallOption) (*ListDailyPacksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDailyPacksResponse)
+	err := c.cc.Invoke(ctx, ReportingService_ListDailyPacks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reportingServiceClient) GetDailyPack(ctx context.Context, in *GetDailyPackRequest, opts ...grpc.CallOption) (*GetDailyPackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDailyPackResponse)
+	err := c.cc.Invoke(ctx, ReportingService_GetDailyPack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ReportingServiceServer is the server API for ReportingService service.
 // All implementations must embed UnimplementedReportingServiceServer
 // for forward compatibility.
 type ReportingServiceServer interface {
 	GenerateReport(context.Context, *GenerateReportRequest) (*GenerateReportResponse, error)
+	GenerateReportAsync(context.Context, *GenerateReportAsyncRequest) (*GenerateReportAsyncResponse, error)
 	ListReportRuns(context.Context, *ListReportRunsRequest) (*ListReportRunsResponse, error)
 	GetReportRun(context.Context, *GetReportRunRequest) (*GetReportRunResponse, error)
+	VerifyReportRun(context.Context, *VerifyReportRunRequest) (*VerifyReportRunResponse, error)
+	GenerateDailyPack(context.Context, *GenerateDailyPackRequest) (*GenerateDailyPackResponse, error)
+	ListDailyPacks(context.Context, *ListDailyPacksRequest) (*ListDailyPacksResponse, error)
+	GetDailyPack(context.Context, *GetDailyPackRequest) (*GetDailyPackResponse, error)
 	mustEmbedUnimplementedReportingServiceServer()
 }
 
@@ -91,12 +156,27 @@ type UnimplementedReportingServiceServer struct{}
 func (UnimplementedReportingServiceServer) GenerateReport(context.Context, *GenerateReportRequest) (*GenerateReportResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GenerateReport not implemented")
 }
+func (UnimplementedReportingServiceServer) GenerateReportAsync(context.Context, *GenerateReportAsyncRequest) (*GenerateReportAsyncResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GenerateReportAsync not implemented")
+}
 func (UnimplementedReportingServiceServer) ListReportRuns(context.Context, *ListReportRunsRequest) (*ListReportRunsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListReportRuns not implemented")
 }
 func (UnimplementedReportingServiceServer) GetReportRun(context.Context, *GetReportRunRequest) (*GetReportRunResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetReportRun not implemented")
 }
+func (UnimplementedReportingServiceServer) VerifyReportRun(context.Context, *VerifyReportRunRequest) (*VerifyReportRunResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method VerifyReportRun not implemented")
+}
+func (UnimplementedReportingServiceServer) GenerateDailyPack(context.Context, *GenerateDailyPackRequest) (*GenerateDailyPackResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GenerateDailyPack not implemented")
+}
+func (UnimplementedReportingServiceServer) ListDailyPacks(context.Context, *ListDailyPacksRequest) (*ListDailyPacksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDailyPacks not implemented")
+}
+func (UnimplementedReportingServiceServer) GetDailyPack(context.Context, *GetDailyPackRequest) (*GetDailyPackResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDailyPack not implemented")
+}
 func (UnimplementedReportingServiceServer) mustEmbedUnimplementedReportingServiceServer() {}
 func (UnimplementedReportingServiceServer) testEmbeddedByValue()                          {}
 
@@ -136,6 +216,24 @@ func _ReportingService_GenerateReport_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReportingService_GenerateReportAsync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateReportAsyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportingServiceServer).GenerateReportAsync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReportingService_GenerateReportAsync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportingServiceServer).GenerateReportAsync(ctx, req.(*GenerateReportAsyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ReportingService_ListReportRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListReportRunsRequest)
 	if err := dec(in); err != nil {
@@ -172,6 +270,78 @@ func _ReportingService_GetReportRun_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReportingService_VerifyReportRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyReportRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportingServiceServer).VerifyReportRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReportingService_VerifyReportRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportingServiceServer).VerifyReportRun(ctx, req.(*VerifyReportRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReportingService_GenerateDailyPack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateDailyPackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportingServiceServer).GenerateDailyPack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReportingService_GenerateDailyPack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportingServiceServer).GenerateDailyPack(ctx, req.(*GenerateDailyPackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReportingService_ListDailyPacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDailyPacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportingServiceServer).ListDailyPacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReportingService_ListDailyPacks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportingServiceServer).ListDailyPacks(ctx, req.(*ListDailyPacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReportingService_GetDailyPack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDailyPackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportingServiceServer).GetDailyPack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReportingService_GetDailyPack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportingServiceServer).GetDailyPack(ctx, req.(*GetDailyPackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ReportingService_ServiceDesc is the grpc.ServiceDesc for ReportingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +353,10 @@ var ReportingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GenerateReport",
 			Handler:    _ReportingService_GenerateReport_Handler,
 		},
+		{
+			MethodName: "GenerateReportAsync",
+			Handler:    _ReportingService_GenerateReportAsync_Handler,
+		},
 		{
 			MethodName: "ListReportRuns",
 			Handler:    _ReportingService_ListReportRuns_Handler,
@@ -191,6 +365,22 @@ var ReportingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetReportRun",
 			Handler:    _ReportingService_GetReportRun_Handler,
 		},
+		{
+			MethodName: "VerifyReportRun",
+			Handler:    _ReportingService_VerifyReportRun_Handler,
+		},
+		{
+			MethodName: "GenerateDailyPack",
+			Handler:    _ReportingService_GenerateDailyPack_Handler,
+		},
+		{
+			MethodName: "ListDailyPacks",
+			Handler:    _ReportingService_ListDailyPacks_Handler,
+		},
+		{
+			MethodName: "GetDailyPack",
+			Handler:    _ReportingService_GetDailyPack_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rgs/v1/reporting.proto",
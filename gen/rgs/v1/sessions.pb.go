@@ -75,18 +75,24 @@ func (SessionState) EnumDescriptor() ([]byte, []int) {
 }
 
 type PlayerSession struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	DeviceId      string                 `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
-	State         SessionState           `protobuf:"varint,4,opt,name=state,proto3,enum=rgs.v1.SessionState" json:"state,omitempty"`
-	StartedAt     string                 `protobuf:"bytes,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
-	LastSeenAt    string                 `protobuf:"bytes,6,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
-	EndedAt       string                 `protobuf:"bytes,7,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
-	ExpiresAt     string                 `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
-	EndReason     string                 `protobuf:"bytes,9,opt,name=end_reason,json=endReason,proto3" json:"end_reason,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	SessionId  string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	PlayerId   string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	DeviceId   string                 `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	State      SessionState           `protobuf:"varint,4,opt,name=state,proto3,enum=rgs.v1.SessionState" json:"state,omitempty"`
+	StartedAt  string                 `protobuf:"bytes,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	LastSeenAt string                 `protobuf:"bytes,6,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	EndedAt    string                 `protobuf:"bytes,7,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	ExpiresAt  string                 `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	EndReason  string                 `protobuf:"bytes,9,opt,name=end_reason,json=endReason,proto3" json:"end_reason,omitempty"`
+	// Set while a reality check pushed to the device awaits acknowledgment;
+	// wagers are denied until the device closes this system window.
+	RealityCheckWindowId string `protobuf:"bytes,10,opt,name=reality_check_window_id,json=realityCheckWindowId,proto3" json:"reality_check_window_id,omitempty"`
+	// When the player last acknowledged a reality check. Play time for the
+	// next one counts from here, or from started_at.
+	RealityCheckAcknowledgedAt string `protobuf:"bytes,11,opt,name=reality_check_acknowledged_at,json=realityCheckAcknowledgedAt,proto3" json:"reality_check_acknowledged_at,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
 }
 
 func (x *PlayerSession) Reset() {
@@ -182,6 +188,158 @@ func (x *PlayerSession) GetEndReason() string {
 	return ""
 }
 
+func (x *PlayerSession) GetRealityCheckWindowId() string {
+	if x != nil {
+		return x.RealityCheckWindowId
+	}
+	return ""
+}
+
+func (x *PlayerSession) GetRealityCheckAcknowledgedAt() string {
+	if x != nil {
+		return x.RealityCheckAcknowledgedAt
+	}
+	return ""
+}
+
+// SessionActivityTotals are player-perspective totals; net_win_loss is
+// total_won minus total_wagered.
+type SessionActivityTotals struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TimePlayedSeconds int64                  `protobuf:"varint,1,opt,name=time_played_seconds,json=timePlayedSeconds,proto3" json:"time_played_seconds,omitempty"`
+	WagerCount        int64                  `protobuf:"varint,2,opt,name=wager_count,json=wagerCount,proto3" json:"wager_count,omitempty"`
+	TotalWagered      *Money                 `protobuf:"bytes,3,opt,name=total_wagered,json=totalWagered,proto3" json:"total_wagered,omitempty"`
+	TotalWon          *Money                 `protobuf:"bytes,4,opt,name=total_won,json=totalWon,proto3" json:"total_won,omitempty"`
+	NetWinLoss        *Money                 `protobuf:"bytes,5,opt,name=net_win_loss,json=netWinLoss,proto3" json:"net_win_loss,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SessionActivityTotals) Reset() {
+	*x = SessionActivityTotals{}
+	mi := &file_rgs_v1_sessions_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionActivityTotals) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionActivityTotals) ProtoMessage() {}
+
+func (x *SessionActivityTotals) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_sessions_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionActivityTotals.ProtoReflect.Descriptor instead.
+func (*SessionActivityTotals) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_sessions_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SessionActivityTotals) GetTimePlayedSeconds() int64 {
+	if x != nil {
+		return x.TimePlayedSeconds
+	}
+	return 0
+}
+
+func (x *SessionActivityTotals) GetWagerCount() int64 {
+	if x != nil {
+		return x.WagerCount
+	}
+	return 0
+}
+
+func (x *SessionActivityTotals) GetTotalWagered() *Money {
+	if x != nil {
+		return x.TotalWagered
+	}
+	return nil
+}
+
+func (x *SessionActivityTotals) GetTotalWon() *Money {
+	if x != nil {
+		return x.TotalWon
+	}
+	return nil
+}
+
+func (x *SessionActivityTotals) GetNetWinLoss() *Money {
+	if x != nil {
+		return x.NetWinLoss
+	}
+	return nil
+}
+
+type SessionSummaryPeriod struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Period        string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	WindowStart   string                 `protobuf:"bytes,2,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	Totals        *SessionActivityTotals `protobuf:"bytes,3,opt,name=totals,proto3" json:"totals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionSummaryPeriod) Reset() {
+	*x = SessionSummaryPeriod{}
+	mi := &file_rgs_v1_sessions_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionSummaryPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionSummaryPeriod) ProtoMessage() {}
+
+func (x *SessionSummaryPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_sessions_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionSummaryPeriod.ProtoReflect.Descriptor instead.
+func (*SessionSummaryPeriod) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_sessions_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SessionSummaryPeriod) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *SessionSummaryPeriod) GetWindowStart() string {
+	if x != nil {
+		return x.WindowStart
+	}
+	return ""
+}
+
+func (x *SessionSummaryPeriod) GetTotals() *SessionActivityTotals {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
 type StartSessionRequest struct {
 	state                 protoimpl.MessageState `protogen:"open.v1"`
 	Meta                  *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
@@ -194,7 +352,7 @@ type StartSessionRequest struct {
 
 func (x *StartSessionRequest) Reset() {
 	*x = StartSessionRequest{}
-	mi := &file_rgs_v1_sessions_proto_msgTypes[1]
+	mi := &file_rgs_v1_sessions_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -206,7 +364,7 @@ func (x *StartSessionRequest) String() string {
 func (*StartSessionRequest) ProtoMessage() {}
 
 func (x *StartSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_sessions_proto_msgTypes[1]
+	mi := &file_rgs_v1_sessions_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -219,7 +377,7 @@ func (x *StartSessionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartSessionRequest.ProtoReflect.Descriptor instead.
 func (*StartSessionRequest) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_sessions_proto_rawDescGZIP(), []int{1}
+	return file_rgs_v1_sessions_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *StartSessionRequest) GetMeta() *RequestMeta {
@@ -260,7 +418,7 @@ type StartSessionResponse struct {
 
 func (x *StartSessionResponse) Reset() {
 	*x = StartSessionResponse{}
-	mi := &file_rgs_v1_sessions_proto_msgTypes[2]
+	mi := &file_rgs_v1_sessions_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -272,7 +430,7 @@ func (x *StartSessionResponse) String() string {
 func (*StartSessionResponse) ProtoMessage() {}
 
 func (x *StartSessionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_sessions_proto_msgTypes[2]
+	mi := &file_rgs_v1_sessions_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -285,7 +443,7 @@ func (x *StartSessionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartSessionResponse.ProtoReflect.Descriptor instead.
 func (*StartSessionResponse) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_sessions_proto_rawDescGZIP(), []int{2}
+	return file_rgs_v1_sessions_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *StartSessionResponse) GetMeta() *ResponseMeta {
@@ -313,7 +471,7 @@ type EndSessionRequest struct {
 
 func (x *EndSessionRequest) Reset() {
 	*x = EndSessionRequest{}
-	mi := &file_rgs_v1_sessions_proto_msgTypes[3]
+	mi := &file_rgs_v1_sessions_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -325,7 +483,7 @@ func (x *EndSessionRequest) String() string {
 func (*EndSessionRequest) ProtoMessage() {}
 
 func (x *EndSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_sessions_proto_msgTypes[3]
+	mi := &file_rgs_v1_sessions_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -338,7 +496,7 @@ func (x *EndSessionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EndSessionRequest.ProtoReflect.Descriptor instead.
 func (*EndSessionRequest) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_sessions_proto_rawDescGZIP(), []int{3}
+	return file_rgs_v1_sessions_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *EndSessionRequest) GetMeta() *RequestMeta {
@@ -372,7 +530,7 @@ type EndSessionResponse struct {
 
 func (x *EndSessionResponse) Reset() {
 	*x = EndSessionResponse{}
-	mi := &file_rgs_v1_sessions_proto_msgTypes[4]
+	mi := &file_rgs_v1_sessions_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -384,7 +542,7 @@ func (x *EndSessionResponse) String() string {
 func (*EndSessionResponse) ProtoMessage() {}
 
 func (x *EndSessionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_sessions_proto_msgTypes[4]
+	mi := &file_rgs_v1_sessions_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -397,7 +555,7 @@ func (x *EndSessionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EndSessionResponse.ProtoReflect.Descriptor instead.
 func (*EndSessionResponse) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_sessions_proto_rawDescGZIP(), []int{4}
+	return file_rgs_v1_sessions_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *EndSessionResponse) GetMeta() *ResponseMeta {
@@ -424,7 +582,7 @@ type GetSessionRequest struct {
 
 func (x *GetSessionRequest) Reset() {
 	*x = GetSessionRequest{}
-	mi := &file_rgs_v1_sessions_proto_msgTypes[5]
+	mi := &file_rgs_v1_sessions_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -436,7 +594,7 @@ func (x *GetSessionRequest) String() string {
 func (*GetSessionRequest) ProtoMessage() {}
 
 func (x *GetSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_sessions_proto_msgTypes[5]
+	mi := &file_rgs_v1_sessions_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -449,7 +607,7 @@ func (x *GetSessionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSessionRequest.ProtoReflect.Descriptor instead.
 func (*GetSessionRequest) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_sessions_proto_rawDescGZIP(), []int{5}
+	return file_rgs_v1_sessions_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetSessionRequest) GetMeta() *RequestMeta {
@@ -476,7 +634,7 @@ type GetSessionResponse struct {
 
 func (x *GetSessionResponse) Reset() {
 	*x = GetSessionResponse{}
-	mi := &file_rgs_v1_sessions_proto_msgTypes[6]
+	mi := &file_rgs_v1_sessions_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -488,7 +646,7 @@ func (x *GetSessionResponse) String() string {
 func (*GetSessionResponse) ProtoMessage() {}
 
 func (x *GetSessionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_sessions_proto_msgTypes[6]
+	mi := &file_rgs_v1_sessions_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -501,7 +659,7 @@ func (x *GetSessionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSessionResponse.ProtoReflect.Descriptor instead.
 func (*GetSessionResponse) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_sessions_proto_rawDescGZIP(), []int{6}
+	return file_rgs_v1_sessions_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetSessionResponse) GetMeta() *ResponseMeta {
@@ -518,11 +676,131 @@ func (x *GetSessionResponse) GetSession() *PlayerSession {
 	return nil
 }
 
+type GetSessionSummaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSessionSummaryRequest) Reset() {
+	*x = GetSessionSummaryRequest{}
+	mi := &file_rgs_v1_sessions_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSessionSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionSummaryRequest) ProtoMessage() {}
+
+func (x *GetSessionSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_sessions_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetSessionSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_sessions_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetSessionSummaryRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GetSessionSummaryRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type GetSessionSummaryResponse struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	Meta            *ResponseMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Session         *PlayerSession          `protobuf:"bytes,2,opt,name=session,proto3" json:"session,omitempty"`
+	CurrentSession  *SessionActivityTotals  `protobuf:"bytes,3,opt,name=current_session,json=currentSession,proto3" json:"current_session,omitempty"`
+	TrailingPeriods []*SessionSummaryPeriod `protobuf:"bytes,4,rep,name=trailing_periods,json=trailingPeriods,proto3" json:"trailing_periods,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetSessionSummaryResponse) Reset() {
+	*x = GetSessionSummaryResponse{}
+	mi := &file_rgs_v1_sessions_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSessionSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionSummaryResponse) ProtoMessage() {}
+
+func (x *GetSessionSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_sessions_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetSessionSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_sessions_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetSessionSummaryResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GetSessionSummaryResponse) GetSession() *PlayerSession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+func (x *GetSessionSummaryResponse) GetCurrentSession() *SessionActivityTotals {
+	if x != nil {
+		return x.CurrentSession
+	}
+	return nil
+}
+
+func (x *GetSessionSummaryResponse) GetTrailingPeriods() []*SessionSummaryPeriod {
+	if x != nil {
+		return x.TrailingPeriods
+	}
+	return nil
+}
+
 var File_rgs_v1_sessions_proto protoreflect.FileDescriptor
 
 const file_rgs_v1_sessions_proto_rawDesc = "" +
 	"\n" +
-	"\x15rgs/v1/sessions.proto\x12\x06rgs.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x13rgs/v1/common.proto\"\xae\x02\n" +
+	"\x15rgs/v1/sessions.proto\x12\x06rgs.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x13rgs/v1/common.proto\x1a\x13rgs/v1/ledger.proto\"\xa8\x03\n" +
 	"\rPlayerSession\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1b\n" +
@@ -537,7 +815,22 @@ const file_rgs_v1_sessions_proto_rawDesc = "" +
 	"\n" +
 	"expires_at\x18\b \x01(\tR\texpiresAt\x12\x1d\n" +
 	"\n" +
-	"end_reason\x18\t \x01(\tR\tendReason\"\xb0\x01\n" +
+	"end_reason\x18\t \x01(\tR\tendReason\x125\n" +
+	"\x17reality_check_window_id\x18\n" +
+	" \x01(\tR\x14realityCheckWindowId\x12A\n" +
+	"\x1dreality_check_acknowledged_at\x18\v \x01(\tR\x1arealityCheckAcknowledgedAt\"\xf9\x01\n" +
+	"\x15SessionActivityTotals\x12.\n" +
+	"\x13time_played_seconds\x18\x01 \x01(\x03R\x11timePlayedSeconds\x12\x1f\n" +
+	"\vwager_count\x18\x02 \x01(\x03R\n" +
+	"wagerCount\x122\n" +
+	"\rtotal_wagered\x18\x03 \x01(\v2\r.rgs.v1.MoneyR\ftotalWagered\x12*\n" +
+	"\ttotal_won\x18\x04 \x01(\v2\r.rgs.v1.MoneyR\btotalWon\x12/\n" +
+	"\fnet_win_loss\x18\x05 \x01(\v2\r.rgs.v1.MoneyR\n" +
+	"netWinLoss\"\x88\x01\n" +
+	"\x14SessionSummaryPeriod\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\x12!\n" +
+	"\fwindow_start\x18\x02 \x01(\tR\vwindowStart\x125\n" +
+	"\x06totals\x18\x03 \x01(\v2\x1d.rgs.v1.SessionActivityTotalsR\x06totals\"\xb0\x01\n" +
 	"\x13StartSessionRequest\x12'\n" +
 	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x1b\n" +
 	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\x12\x1b\n" +
@@ -560,18 +853,28 @@ const file_rgs_v1_sessions_proto_rawDesc = "" +
 	"session_id\x18\x02 \x01(\tR\tsessionId\"o\n" +
 	"\x12GetSessionResponse\x12(\n" +
 	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12/\n" +
-	"\asession\x18\x02 \x01(\v2\x15.rgs.v1.PlayerSessionR\asession*{\n" +
+	"\asession\x18\x02 \x01(\v2\x15.rgs.v1.PlayerSessionR\asession\"b\n" +
+	"\x18GetSessionSummaryRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\"\x87\x02\n" +
+	"\x19GetSessionSummaryResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12/\n" +
+	"\asession\x18\x02 \x01(\v2\x15.rgs.v1.PlayerSessionR\asession\x12F\n" +
+	"\x0fcurrent_session\x18\x03 \x01(\v2\x1d.rgs.v1.SessionActivityTotalsR\x0ecurrentSession\x12G\n" +
+	"\x10trailing_periods\x18\x04 \x03(\v2\x1c.rgs.v1.SessionSummaryPeriodR\x0ftrailingPeriods*{\n" +
 	"\fSessionState\x12\x1d\n" +
 	"\x19SESSION_STATE_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14SESSION_STATE_ACTIVE\x10\x01\x12\x17\n" +
 	"\x13SESSION_STATE_ENDED\x10\x02\x12\x19\n" +
-	"\x15SESSION_STATE_EXPIRED\x10\x032\xc5\x02\n" +
+	"\x15SESSION_STATE_EXPIRED\x10\x032\xcb\x03\n" +
 	"\x0fSessionsService\x12h\n" +
 	"\fStartSession\x12\x1b.rgs.v1.StartSessionRequest\x1a\x1c.rgs.v1.StartSessionResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/sessions:start\x12`\n" +
 	"\n" +
 	"EndSession\x12\x19.rgs.v1.EndSessionRequest\x1a\x1a.rgs.v1.EndSessionResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/sessions:end\x12f\n" +
 	"\n" +
-	"GetSession\x12\x19.rgs.v1.GetSessionRequest\x1a\x1a.rgs.v1.GetSessionResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/sessions/{session_id}B\x8f\x01\n" +
+	"GetSession\x12\x19.rgs.v1.GetSessionRequest\x1a\x1a.rgs.v1.GetSessionResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/sessions/{session_id}\x12\x83\x01\n" +
+	"\x11GetSessionSummary\x12 .rgs.v1.GetSessionSummaryRequest\x1a!.rgs.v1.GetSessionSummaryResponse\")\x82\xd3\xe4\x93\x02#\x12!/v1/sessions/{session_id}/summaryB\x8f\x01\n" +
 	"\n" +
 	"com.rgs.v1B\rSessionsProtoP\x01Z9github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1;rgsv1\xa2\x02\x03RXX\xaa\x02\x06Rgs.V1\xca\x02\x06Rgs\\V1\xe2\x02\x12Rgs\\V1\\GPBMetadata\xea\x02\aRgs::V1b\x06proto3"
 
@@ -588,41 +891,57 @@ func file_rgs_v1_sessions_proto_rawDescGZIP() []byte {
 }
 
 var file_rgs_v1_sessions_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_rgs_v1_sessions_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_rgs_v1_sessions_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_rgs_v1_sessions_proto_goTypes = []any{
-	(SessionState)(0),            // 0: rgs.v1.SessionState
-	(*PlayerSession)(nil),        // 1: rgs.v1.PlayerSession
-	(*StartSessionRequest)(nil),  // 2: rgs.v1.StartSessionRequest
-	(*StartSessionResponse)(nil), // 3: rgs.v1.StartSessionResponse
-	(*EndSessionRequest)(nil),    // 4: rgs.v1.EndSessionRequest
-	(*EndSessionResponse)(nil),   // 5: rgs.v1.EndSessionResponse
-	(*GetSessionRequest)(nil),    // 6: rgs.v1.GetSessionRequest
-	(*GetSessionResponse)(nil),   // 7: rgs.v1.GetSessionResponse
-	(*RequestMeta)(nil),          // 8: rgs.v1.RequestMeta
-	(*ResponseMeta)(nil),         // 9: rgs.v1.ResponseMeta
+	(SessionState)(0),                 // 0: rgs.v1.SessionState
+	(*PlayerSession)(nil),             // 1: rgs.v1.PlayerSession
+	(*SessionActivityTotals)(nil),     // 2: rgs.v1.SessionActivityTotals
+	(*SessionSummaryPeriod)(nil),      // 3: rgs.v1.SessionSummaryPeriod
+	(*StartSessionRequest)(nil),       // 4: rgs.v1.StartSessionRequest
+	(*StartSessionResponse)(nil),      // 5: rgs.v1.StartSessionResponse
+	(*EndSessionRequest)(nil),         // 6: rgs.v1.EndSessionRequest
+	(*EndSessionResponse)(nil),        // 7: rgs.v1.EndSessionResponse
+	(*GetSessionRequest)(nil),         // 8: rgs.v1.GetSessionRequest
+	(*GetSessionResponse)(nil),        // 9: rgs.v1.GetSessionResponse
+	(*GetSessionSummaryRequest)(nil),  // 10: rgs.v1.GetSessionSummaryRequest
+	(*GetSessionSummaryResponse)(nil), // 11: rgs.v1.GetSessionSummaryResponse
+	(*Money)(nil),                     // 12: rgs.v1.Money
+	(*RequestMeta)(nil),               // 13: rgs.v1.RequestMeta
+	(*ResponseMeta)(nil),              // 14: rgs.v1.ResponseMeta
 }
 var file_rgs_v1_sessions_proto_depIdxs = []int32{
 	0,  // 0: rgs.v1.PlayerSession.state:type_name -> rgs.v1.SessionState
-	8,  // 1: rgs.v1.StartSessionRequest.meta:type_name -> rgs.v1.RequestMeta
-	9,  // 2: rgs.v1.StartSessionResponse.meta:type_name -> rgs.v1.ResponseMeta
-	1,  // 3: rgs.v1.StartSessionResponse.session:type_name -> rgs.v1.PlayerSession
-	8,  // 4: rgs.v1.EndSessionRequest.meta:type_name -> rgs.v1.RequestMeta
-	9,  // 5: rgs.v1.EndSessionResponse.meta:type_name -> rgs.v1.ResponseMeta
-	1,  // 6: rgs.v1.EndSessionResponse.session:type_name -> rgs.v1.PlayerSession
-	8,  // 7: rgs.v1.GetSessionRequest.meta:type_name -> rgs.v1.RequestMeta
-	9,  // 8: rgs.v1.GetSessionResponse.meta:type_name -> rgs.v1.ResponseMeta
-	1,  // 9: rgs.v1.GetSessionResponse.session:type_name -> rgs.v1.PlayerSession
-	2,  // 10: rgs.v1.SessionsService.StartSession:input_type -> rgs.v1.StartSessionRequest
-	4,  // 11: rgs.v1.SessionsService.EndSession:input_type -> rgs.v1.EndSessionRequest
-	6,  // 12: rgs.v1.SessionsService.GetSession:input_type -> rgs.v1.GetSessionRequest
-	3,  // 13: rgs.v1.SessionsService.StartSession:output_type -> rgs.v1.StartSessionResponse
-	5,  // 14: rgs.v1.SessionsService.EndSession:output_type -> rgs.v1.EndSessionResponse
-	7,  // 15: rgs.v1.SessionsService.GetSession:output_type -> rgs.v1.GetSessionResponse
-	13, // [13:16] is the sub-list for method output_type
-	10, // [10:13] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	12, // 1: rgs.v1.SessionActivityTotals.total_wagered:type_name -> rgs.v1.Money
+	12, // 2: rgs.v1.SessionActivityTotals.total_won:type_name -> rgs.v1.Money
+	12, // 3: rgs.v1.SessionActivityTotals.net_win_loss:type_name -> rgs.v1.Money
+	2,  // 4: rgs.v1.SessionSummaryPeriod.totals:type_name -> rgs.v1.SessionActivityTotals
+	13, // 5: rgs.v1.StartSessionRequest.meta:type_name -> rgs.v1.RequestMeta
+	14, // 6: rgs.v1.StartSessionResponse.meta:type_name -> rgs.v1.ResponseMeta
+	1,  // 7: rgs.v1.StartSessionResponse.session:type_name -> rgs.v1.PlayerSession
+	13, // 8: rgs.v1.EndSessionRequest.meta:type_name -> rgs.v1.RequestMeta
+	14, // 9: rgs.v1.EndSessionResponse.meta:type_name -> rgs.v1.ResponseMeta
+	1,  // 10: rgs.v1.EndSessionResponse.session:type_name -> rgs.v1.PlayerSession
+	13, // 11: rgs.v1.GetSessionRequest.meta:type_name -> rgs.v1.RequestMeta
+	14, // 12: rgs.v1.GetSessionResponse.meta:type_name -> rgs.v1.ResponseMeta
+	1,  // 13: rgs.v1.GetSessionResponse.session:type_name -> rgs.v1.PlayerSession
+	13, // 14: rgs.v1.GetSessionSummaryRequest.meta:type_name -> rgs.v1.RequestMeta
+	14, // 15: rgs.v1.GetSessionSummaryResponse.meta:type_name -> rgs.v1.ResponseMeta
+	1,  // 16: rgs.v1.GetSessionSummaryResponse.session:type_name -> rgs.v1.PlayerSession
+	2,  // 17: rgs.v1.GetSessionSummaryResponse.current_session:type_name -> rgs.v1.SessionActivityTotals
+	3,  // 18: rgs.v1.GetSessionSummaryResponse.trailing_periods:type_name -> rgs.v1.SessionSummaryPeriod
+	4,  // 19: rgs.v1.SessionsService.StartSession:input_type -> rgs.v1.StartSessionRequest
+	6,  // 20: rgs.v1.SessionsService.EndSession:input_type -> rgs.v1.EndSessionRequest
+	8,  // 21: rgs.v1.SessionsService.GetSession:input_type -> rgs.v1.GetSessionRequest
+	10, // 22: rgs.v1.SessionsService.GetSessionSummary:input_type -> rgs.v1.GetSessionSummaryRequest
+	5,  // 23: rgs.v1.SessionsService.StartSession:output_type -> rgs.v1.StartSessionResponse
+	7,  // 24: rgs.v1.SessionsService.EndSession:output_type -> rgs.v1.EndSessionResponse
+	9,  // 25: rgs.v1.SessionsService.GetSession:output_type -> rgs.v1.GetSessionResponse
+	11, // 26: rgs.v1.SessionsService.GetSessionSummary:output_type -> rgs.v1.GetSessionSummaryResponse
+	23, // [23:27] is the sub-list for method output_type
+	19, // [19:23] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_rgs_v1_sessions_proto_init() }
@@ -631,13 +950,14 @@ func file_rgs_v1_sessions_proto_init() {
 		return
 	}
 	file_rgs_v1_common_proto_init()
+	file_rgs_v1_ledger_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rgs_v1_sessions_proto_rawDesc), len(file_rgs_v1_sessions_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   7,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
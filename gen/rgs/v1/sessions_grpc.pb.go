@@ -19,9 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	SessionsService_StartSession_FullMethodName = "/rgs.v1.SessionsService/StartSession"
-	SessionsService_EndSession_FullMethodName   = "/rgs.v1.SessionsService/EndSession"
-	SessionsService_GetSession_FullMethodName   = "/rgs.v1.SessionsService/GetSession"
+	SessionsService_StartSession_FullMethodName      = "/rgs.v1.SessionsService/StartSession"
+	SessionsService_EndSession_FullMethodName        = "/rgs.v1.SessionsService/EndSession"
+	SessionsService_GetSession_FullMethodName        = "/rgs.v1.SessionsService/GetSession"
+	SessionsService_GetSessionSummary_FullMethodName = "/rgs.v1.SessionsService/GetSessionSummary"
 )
 
 // SessionsServiceClient is the client API for SessionsService service.
@@ -31,6 +32,7 @@ type SessionsServiceClient interface {
 	StartSession(ctx context.Context, in *StartSessionRequest, opts ...grpc.CallOption) (*StartSessionResponse, error)
 	EndSession(ctx context.Context, in *EndSessionRequest, opts ...grpc.CallOption) (*EndSessionResponse, error)
 	GetSession(ctx context.Context, in *GetSessionRequest, opts ...grpc.CallOption) (*GetSessionResponse, error)
+	GetSessionSummary(ctx context.Context, in *GetSessionSummaryRequest, opts ...grpc.CallOption) (*GetSessionSummaryResponse, error)
 }
 
 type sessionsServiceClient struct {
@@ -71,6 +73,16 @@ func (c *sessionsServiceClient) GetSession(ctx context.Context, in *GetSessionRe
 	return out, nil
 }
 
+func (c *sessionsServiceClient) GetSessionSummary(ctx context.Context, in *GetSessionSummaryRequest, opts ...grpc.CallOption) (*GetSessionSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSessionSummaryResponse)
+	err := c.cc.Invoke(ctx, SessionsService_GetSessionSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SessionsServiceServer is the server API for SessionsService service.
 // All implementations must embed UnimplementedSessionsServiceServer
 // for forward compatibility.
@@ -78,6 +90,7 @@ type SessionsServiceServer interface {
 	StartSession(context.Context, *StartSessionRequest) (*StartSessionResponse, error)
 	EndSession(context.Context, *EndSessionRequest) (*EndSessionResponse, error)
 	GetSession(context.Context, *GetSessionRequest) (*GetSessionResponse, error)
+	GetSessionSummary(context.Context, *GetSessionSummaryRequest) (*GetSessionSummaryResponse, error)
 	mustEmbedUnimplementedSessionsServiceServer()
 }
 
@@ -97,6 +110,9 @@ func (UnimplementedSessionsServiceServer) EndSession(context.Context, *EndSessio
 func (UnimplementedSessionsServiceServer) GetSession(context.Context, *GetSessionRequest) (*GetSessionResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetSession not implemented")
 }
+func (UnimplementedSessionsServiceServer) GetSessionSummary(context.Context, *GetSessionSummaryRequest) (*GetSessionSummaryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSessionSummary not implemented")
+}
 func (UnimplementedSessionsServiceServer) mustEmbedUnimplementedSessionsServiceServer() {}
 func (UnimplementedSessionsServiceServer) testEmbeddedByValue()                         {}
 
@@ -172,6 +188,24 @@ func _SessionsService_GetSession_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SessionsService_GetSessionSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServiceServer).GetSessionSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionsService_GetSessionSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServiceServer).GetSessionSummary(ctx, req.(*GetSessionSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SessionsService_ServiceDesc is the grpc.ServiceDesc for SessionsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -191,6 +225,10 @@ var SessionsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSession",
 			Handler:    _SessionsService_GetSession_Handler,
 		},
+		{
+			MethodName: "GetSessionSummary",
+			Handler:    _SessionsService_GetSessionSummary_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rgs/v1/sessions.proto",
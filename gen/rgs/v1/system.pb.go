@@ -22,28 +22,1552 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type IncidentSeverity int32
+
+const (
+	IncidentSeverity_INCIDENT_SEVERITY_UNSPECIFIED IncidentSeverity = 0
+	IncidentSeverity_INCIDENT_SEVERITY_INFO        IncidentSeverity = 1
+	IncidentSeverity_INCIDENT_SEVERITY_MINOR       IncidentSeverity = 2
+	IncidentSeverity_INCIDENT_SEVERITY_MAJOR       IncidentSeverity = 3
+	IncidentSeverity_INCIDENT_SEVERITY_CRITICAL    IncidentSeverity = 4
+)
+
+// Enum value maps for IncidentSeverity.
+var (
+	IncidentSeverity_name = map[int32]string{
+		0: "INCIDENT_SEVERITY_UNSPECIFIED",
+		1: "INCIDENT_SEVERITY_INFO",
+		2: "INCIDENT_SEVERITY_MINOR",
+		3: "INCIDENT_SEVERITY_MAJOR",
+		4: "INCIDENT_SEVERITY_CRITICAL",
+	}
+	IncidentSeverity_value = map[string]int32{
+		"INCIDENT_SEVERITY_UNSPECIFIED": 0,
+		"INCIDENT_SEVERITY_INFO":        1,
+		"INCIDENT_SEVERITY_MINOR":       2,
+		"INCIDENT_SEVERITY_MAJOR":       3,
+		"INCIDENT_SEVERITY_CRITICAL":    4,
+	}
+)
+
+func (x IncidentSeverity) Enum() *IncidentSeverity {
+	p := new(IncidentSeverity)
+	*p = x
+	return p
+}
+
+func (x IncidentSeverity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IncidentSeverity) Descriptor() protoreflect.EnumDescriptor {
+	return file_rgs_v1_system_proto_enumTypes[0].Descriptor()
+}
+
+func (IncidentSeverity) Type() protoreflect.EnumType {
+	return &file_rgs_v1_system_proto_enumTypes[0]
+}
+
+func (x IncidentSeverity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IncidentSeverity.Descriptor instead.
+func (IncidentSeverity) EnumDescriptor() ([]byte, []int) {
+	return file_rgs_v1_system_proto_rawDescGZIP(), []int{0}
+}
+
+type IncidentStatus int32
+
+const (
+	IncidentStatus_INCIDENT_STATUS_UNSPECIFIED   IncidentStatus = 0
+	IncidentStatus_INCIDENT_STATUS_INVESTIGATING IncidentStatus = 1
+	IncidentStatus_INCIDENT_STATUS_IDENTIFIED    IncidentStatus = 2
+	IncidentStatus_INCIDENT_STATUS_MONITORING    IncidentStatus = 3
+	IncidentStatus_INCIDENT_STATUS_RESOLVED      IncidentStatus = 4
+)
+
+// Enum value maps for IncidentStatus.
+var (
+	IncidentStatus_name = map[int32]string{
+		0: "INCIDENT_STATUS_UNSPECIFIED",
+		1: "INCIDENT_STATUS_INVESTIGATING",
+		2: "INCIDENT_STATUS_IDENTIFIED",
+		3: "INCIDENT_STATUS_MONITORING",
+		4: "INCIDENT_STATUS_RESOLVED",
+	}
+	IncidentStatus_value = map[string]int32{
+		"INCIDENT_STATUS_UNSPECIFIED":   0,
+		"INCIDENT_STATUS_INVESTIGATING": 1,
+		"INCIDENT_STATUS_IDENTIFIED":    2,
+		"INCIDENT_STATUS_MONITORING":    3,
+		"INCIDENT_STATUS_RESOLVED":      4,
+	}
+)
+
+func (x IncidentStatus) Enum() *IncidentStatus {
+	p := new(IncidentStatus)
+	*p = x
+	return p
+}
+
+func (x IncidentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IncidentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_rgs_v1_system_proto_enumTypes[1].Descriptor()
+}
+
+func (IncidentStatus) Type() protoreflect.EnumType {
+	return &file_rgs_v1_system_proto_enumTypes[1]
+}
+
+func (x IncidentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IncidentStatus.Descriptor instead.
+func (IncidentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_rgs_v1_system_proto_rawDescGZIP(), []int{1}
+}
+
+type JobRunStatus int32
+
+const (
+	JobRunStatus_JOB_RUN_STATUS_UNSPECIFIED JobRunStatus = 0
+	JobRunStatus_JOB_RUN_STATUS_SUCCEEDED   JobRunStatus = 1
+	JobRunStatus_JOB_RUN_STATUS_FAILED      JobRunStatus = 2
+)
+
+// Enum value maps for JobRunStatus.
+var (
+	JobRunStatus_name = map[int32]string{
+		0: "JOB_RUN_STATUS_UNSPECIFIED",
+		1: "JOB_RUN_STATUS_SUCCEEDED",
+		2: "JOB_RUN_STATUS_FAILED",
+	}
+	JobRunStatus_value = map[string]int32{
+		"JOB_RUN_STATUS_UNSPECIFIED": 0,
+		"JOB_RUN_STATUS_SUCCEEDED":   1,
+		"JOB_RUN_STATUS_FAILED":      2,
+	}
+)
+
+func (x JobRunStatus) Enum() *JobRunStatus {
+	p := new(JobRunStatus)
+	*p = x
+	return p
+}
+
+func (x JobRunStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JobRunStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_rgs_v1_system_proto_enumTypes[2].Descriptor()
+}
+
+func (JobRunStatus) Type() protoreflect.EnumType {
+	return &file_rgs_v1_system_proto_enumTypes[2]
+}
+
+func (x JobRunStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JobRunStatus.Descriptor instead.
+func (JobRunStatus) EnumDescriptor() ([]byte, []int) {
+	return file_rgs_v1_system_proto_rawDescGZIP(), []int{2}
+}
+
+type SmokeCheckStatus int32
+
+const (
+	SmokeCheckStatus_SMOKE_CHECK_STATUS_UNSPECIFIED SmokeCheckStatus = 0
+	SmokeCheckStatus_SMOKE_CHECK_STATUS_PASSED      SmokeCheckStatus = 1
+	SmokeCheckStatus_SMOKE_CHECK_STATUS_FAILED      SmokeCheckStatus = 2
+	// The dependency the check exercises is not configured in this deployment.
+	SmokeCheckStatus_SMOKE_CHECK_STATUS_SKIPPED SmokeCheckStatus = 3
+)
+
+// Enum value maps for SmokeCheckStatus.
+var (
+	SmokeCheckStatus_name = map[int32]string{
+		0: "SMOKE_CHECK_STATUS_UNSPECIFIED",
+		1: "SMOKE_CHECK_STATUS_PASSED",
+		2: "SMOKE_CHECK_STATUS_FAILED",
+		3: "SMOKE_CHECK_STATUS_SKIPPED",
+	}
+	SmokeCheckStatus_value = map[string]int32{
+		"SMOKE_CHECK_STATUS_UNSPECIFIED": 0,
+		"SMOKE_CHECK_STATUS_PASSED":      1,
+		"SMOKE_CHECK_STATUS_FAILED":      2,
+		"SMOKE_CHECK_STATUS_SKIPPED":     3,
+	}
+)
+
+func (x SmokeCheckStatus) Enum() *SmokeCheckStatus {
+	p := new(SmokeCheckStatus)
+	*p = x
+	return p
+}
+
+func (x SmokeCheckStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SmokeCheckStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_rgs_v1_system_proto_enumTypes[3].Descriptor()
+}
+
+func (SmokeCheckStatus) Type() protoreflect.EnumType {
+	return &file_rgs_v1_system_proto_enumTypes[3]
+}
+
+func (x SmokeCheckStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SmokeCheckStatus.Descriptor instead.
+func (SmokeCheckStatus) EnumDescriptor() ([]byte, []int) {
+	return file_rgs_v1_system_proto_rawDescGZIP(), []int{3}
+}
+
+type IncidentUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        IncidentStatus         `protobuf:"varint,1,opt,name=status,proto3,enum=rgs.v1.IncidentStatus" json:"status,omitempty"`
+	Severity      IncidentSeverity       `protobuf:"varint,2,opt,name=severity,proto3,enum=rgs.v1.IncidentSeverity" json:"severity,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	ActorId       string                 `protobuf:"bytes,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	RecordedAt    string                 `protobuf:"bytes,5,opt,name=recorded_at,json=recordedAt,proto3" json:"recorded_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncidentUpdate) Reset() {
+	*x = IncidentUpdate{}
+	mi := &file_rgs_v1_system_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncidentUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncidentUpdate) ProtoMessage() {}
+
+func (x *IncidentUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_system_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncidentUpdate.ProtoReflect.Descriptor instead.
+func (*IncidentUpdate) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_system_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *IncidentUpdate) GetStatus() IncidentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return IncidentStatus_INCIDENT_STATUS_UNSPECIFIED
+}
+
+func (x *IncidentUpdate) GetSeverity() IncidentSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return IncidentSeverity_INCIDENT_SEVERITY_UNSPECIFIED
+}
+
+func (x *IncidentUpdate) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *IncidentUpdate) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *IncidentUpdate) GetRecordedAt() string {
+	if x != nil {
+		return x.RecordedAt
+	}
+	return ""
+}
+
+type Incident struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	IncidentId       string                 `protobuf:"bytes,1,opt,name=incident_id,json=incidentId,proto3" json:"incident_id,omitempty"`
+	Title            string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Message          string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Severity         IncidentSeverity       `protobuf:"varint,4,opt,name=severity,proto3,enum=rgs.v1.IncidentSeverity" json:"severity,omitempty"`
+	Status           IncidentStatus         `protobuf:"varint,5,opt,name=status,proto3,enum=rgs.v1.IncidentStatus" json:"status,omitempty"`
+	AffectedServices []string               `protobuf:"bytes,6,rep,name=affected_services,json=affectedServices,proto3" json:"affected_services,omitempty"`
+	StartedAt        string                 `protobuf:"bytes,7,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	UpdatedAt        string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ResolvedAt       string                 `protobuf:"bytes,9,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	Updates          []*IncidentUpdate      `protobuf:"bytes,10,rep,name=updates,proto3" json:"updates,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Incident) Reset() {
+	*x = Incident{}
+	mi := &file_rgs_v1_system_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Incident) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Incident) ProtoMessage() {}
+
+func (x *Incident) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_system_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Incident.ProtoReflect.Descriptor instead.
+func (*Incident) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_system_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Incident) GetIncidentId() string {
+	if x != nil {
+		return x.IncidentId
+	}
+	return ""
+}
+
+func (x *Incident) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Incident) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Incident) GetSeverity() IncidentSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return IncidentSeverity_INCIDENT_SEVERITY_UNSPECIFIED
+}
+
+func (x *Incident) GetStatus() IncidentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return IncidentStatus_INCIDENT_STATUS_UNSPECIFIED
+}
+
+func (x *Incident) GetAffectedServices() []string {
+	if x != nil {
+		return x.AffectedServices
+	}
+	return nil
+}
+
+func (x *Incident) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *Incident) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Incident) GetResolvedAt() string {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return ""
+}
+
+func (x *Incident) GetUpdates() []*IncidentUpdate {
+	if x != nil {
+		return x.Updates
+	}
+	return nil
+}
+
+// ScheduledJob is a recurring job registered with the background scheduler.
+type ScheduledJob struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	JobName    string                 `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	Schedule   string                 `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	NextRunAt  string                 `protobuf:"bytes,3,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at,omitempty"`
+	LastRunAt  string                 `protobuf:"bytes,4,opt,name=last_run_at,json=lastRunAt,proto3" json:"last_run_at,omitempty"`
+	LastStatus JobRunStatus           `protobuf:"varint,5,opt,name=last_status,json=lastStatus,proto3,enum=rgs.v1.JobRunStatus" json:"last_status,omitempty"`
+	// Failed attempts of the current run; reset once it succeeds or the retry
+	// policy is exhausted.
+	FailedAttempts int32 `protobuf:"varint,6,opt,name=failed_attempts,json=failedAttempts,proto3" json:"failed_attempts,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ScheduledJob) Reset() {
+	*x = ScheduledJob{}
+	mi := &file_rgs_v1_system_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledJob) ProtoMessage() {}
+
+func (x *ScheduledJob) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_system_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledJob.ProtoReflect.Descriptor instead.
+func (*ScheduledJob) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_system_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ScheduledJob) GetJobName() string {
+	if x != nil {
+		return x.JobName
+	}
+	return ""
+}
+
+func (x *ScheduledJob) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *ScheduledJob) GetNextRunAt() string {
+	if x != nil {
+		return x.NextRunAt
+	}
+	return ""
+}
+
+func (x *ScheduledJob) GetLastRunAt() string {
+	if x != nil {
+		return x.LastRunAt
+	}
+	return ""
+}
+
+func (x *ScheduledJob) GetLastStatus() JobRunStatus {
+	if x != nil {
+		return x.LastStatus
+	}
+	return JobRunStatus_JOB_RUN_STATUS_UNSPECIFIED
+}
+
+func (x *ScheduledJob) GetFailedAttempts() int32 {
+	if x != nil {
+		return x.FailedAttempts
+	}
+	return 0
+}
+
+// JobRun is one execution of a recurring or one-shot job.
+type JobRun struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	RunId   string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	JobName string                 `protobuf:"bytes,2,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	// Set for one-shot jobs.
+	JobId          string       `protobuf:"bytes,3,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Attempt        int32        `protobuf:"varint,4,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	Status         JobRunStatus `protobuf:"varint,5,opt,name=status,proto3,enum=rgs.v1.JobRunStatus" json:"status,omitempty"`
+	StartedAt      string       `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt     string       `protobuf:"bytes,7,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	Summary        string       `protobuf:"bytes,8,opt,name=summary,proto3" json:"summary,omitempty"`
+	Error          string       `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	InstanceId     string       `protobuf:"bytes,10,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	RetryScheduled bool         `protobuf:"varint,11,opt,name=retry_scheduled,json=retryScheduled,proto3" json:"retry_scheduled,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *JobRun) Reset() {
+	*x = JobRun{}
+	mi := &file_rgs_v1_system_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobRun) ProtoMessage() {}
+
+func (x *JobRun) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_system_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobRun.ProtoReflect.Descriptor instead.
+func (*JobRun) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_system_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *JobRun) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *JobRun) GetJobName() string {
+	if x != nil {
+		return x.JobName
+	}
+	return ""
+}
+
+func (x *JobRun) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *JobRun) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *JobRun) GetStatus() JobRunStatus {
+	if x != nil {
+		return x.Status
+	}
+	return JobRunStatus_JOB_RUN_STATUS_UNSPECIFIED
+}
+
+func (x *JobRun) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *JobRun) GetFinishedAt() string {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return ""
+}
+
+func (x *JobRun) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *JobRun) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *JobRun) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *JobRun) GetRetryScheduled() bool {
+	if x != nil {
+		return x.RetryScheduled
+	}
+	return false
+}
+
+type SmokeCheckResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Status        SmokeCheckStatus       `protobuf:"varint,2,opt,name=status,proto3,enum=rgs.v1.SmokeCheckStatus" json:"status,omitempty"`
+	Detail        string                 `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+	DurationMs    int64                  `protobuf:"varint,4,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SmokeCheckResult) Reset() {
+	*x = SmokeCheckResult{}
+	mi := &file_rgs_v1_system_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SmokeCheckResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SmokeCheckResult) ProtoMessage() {}
+
+func (x *SmokeCheckResult) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_system_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SmokeCheckResult.ProtoReflect.Descriptor instead.
+func (*SmokeCheckResult) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_system_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SmokeCheckResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SmokeCheckResult) GetStatus() SmokeCheckStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SmokeCheckStatus_SMOKE_CHECK_STATUS_UNSPECIFIED
+}
+
+func (x *SmokeCheckResult) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *SmokeCheckResult) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
 type GetSystemStatusRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Meta  *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// Selects a tenant's gaming calendar; empty uses the property default.
+	OperatorId    string `protobuf:"bytes,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSystemStatusRequest) Reset() {
+	*x = GetSystemStatusRequest{}
+	mi := &file_rgs_v1_system_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSystemStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSystemStatusRequest) ProtoMessage() {}
+
+func (x *GetSystemStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_system_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSystemStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetSystemStatusRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_system_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetSystemStatusRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GetSystemStatusRequest) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+// GamingCalendarInfo tells devices how to display local time and which
+// gaming day is open.
+type GamingCalendarInfo struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TimeZone         string                 `protobuf:"bytes,1,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	UtcOffsetSeconds int32                  `protobuf:"varint,2,opt,name=utc_offset_seconds,json=utcOffsetSeconds,proto3" json:"utc_offset_seconds,omitempty"`
+	GamingDayStart   string                 `protobuf:"bytes,3,opt,name=gaming_day_start,json=gamingDayStart,proto3" json:"gaming_day_start,omitempty"`
+	GamingDay        string                 `protobuf:"bytes,4,opt,name=gaming_day,json=gamingDay,proto3" json:"gaming_day,omitempty"`
+	LocalTime        string                 `protobuf:"bytes,5,opt,name=local_time,json=localTime,proto3" json:"local_time,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GamingCalendarInfo) Reset() {
+	*x = GamingCalendarInfo{}
+	mi := &file_rgs_v1_system_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GamingCalendarInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GamingCalendarInfo) ProtoMessage() {}
+
+func (x *GamingCalendarInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_system_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GamingCalendarInfo.ProtoReflect.Descriptor instead.
+func (*GamingCalendarInfo) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_system_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GamingCalendarInfo) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *GamingCalendarInfo) GetUtcOffsetSeconds() int32 {
+	if x != nil {
+		return x.UtcOffsetSeconds
+	}
+	return 0
+}
+
+func (x *GamingCalendarInfo) GetGamingDayStart() string {
+	if x != nil {
+		return x.GamingDayStart
+	}
+	return ""
+}
+
+func (x *GamingCalendarInfo) GetGamingDay() string {
+	if x != nil {
+		return x.GamingDay
+	}
+	return ""
+}
+
+func (x *GamingCalendarInfo) GetLocalTime() string {
+	if x != nil {
+		return x.LocalTime
+	}
+	return ""
+}
+
+type GetSystemStatusResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Meta            *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	ServiceName     string                 `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	Version         string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Uptime          string                 `protobuf:"bytes,4,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	ActiveIncidents []*Incident            `protobuf:"bytes,5,rep,name=active_incidents,json=activeIncidents,proto3" json:"active_incidents,omitempty"`
+	GamingCalendar  *GamingCalendarInfo    `protobuf:"bytes,6,opt,name=gaming_calendar,json=gamingCalendar,proto3" json:"gaming_calendar,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetSystemStatusResponse) Reset() {
+	*x = GetSystemStatusResponse{}
+	mi := &file_rgs_v1_system_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSystemStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSystemStatusResponse) ProtoMessage() {}
+
+func (x *GetSystemStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_system_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSystemStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetSystemStatusResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_system_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetSystemStatusResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GetSystemStatusResponse) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *GetSystemStatusResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *GetSystemStatusResponse) GetUptime() string {
+	if x != nil {
+		return x.Uptime
+	}
+	return ""
+}
+
+func (x *GetSystemStatusResponse) GetActiveIncidents() []*Incident {
+	if x != nil {
+		return x.ActiveIncidents
+	}
+	return nil
+}
+
+func (x *GetSystemStatusResponse) GetGamingCalendar() *GamingCalendarInfo {
+	if x != nil {
+		return x.GamingCalendar
+	}
+	return nil
+}
+
+type GetStatusPageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	ResolvedLimit int32                  `protobuf:"varint,2,opt,name=resolved_limit,json=resolvedLimit,proto3" json:"resolved_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatusPageRequest) Reset() {
+	*x = GetStatusPageRequest{}
+	mi := &file_rgs_v1_system_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatusPageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusPageRequest) ProtoMessage() {}
+
+func (x *GetStatusPageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_system_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusPageRequest.ProtoReflect.Descriptor instead.
+func (*GetStatusPageRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_system_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetStatusPageRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GetStatusPageRequest) GetResolvedLimit() int32 {
+	if x != nil {
+		return x.ResolvedLimit
+	}
+	return 0
+}
+
+type GetStatusPageResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Meta             *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	OverallSeverity  IncidentSeverity       `protobuf:"varint,2,opt,name=overall_severity,json=overallSeverity,proto3,enum=rgs.v1.IncidentSeverity" json:"overall_severity,omitempty"`
+	ActiveIncidents  []*Incident            `protobuf:"bytes,3,rep,name=active_incidents,json=activeIncidents,proto3" json:"active_incidents,omitempty"`
+	RecentlyResolved []*Incident            `protobuf:"bytes,4,rep,name=recently_resolved,json=recentlyResolved,proto3" json:"recently_resolved,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetStatusPageResponse) Reset() {
+	*x = GetStatusPageResponse{}
+	mi := &file_rgs_v1_system_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatusPageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusPageResponse) ProtoMessage() {}
+
+func (x *GetStatusPageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_system_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusPageResponse.ProtoReflect.Descriptor instead.
+func (*GetStatusPageResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_system_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetStatusPageResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GetStatusPageResponse) GetOverallSeverity() IncidentSeverity {
+	if x != nil {
+		return x.OverallSeverity
+	}
+	return IncidentSeverity_INCIDENT_SEVERITY_UNSPECIFIED
+}
+
+func (x *GetStatusPageResponse) GetActiveIncidents() []*Incident {
+	if x != nil {
+		return x.ActiveIncidents
+	}
+	return nil
+}
+
+func (x *GetStatusPageResponse) GetRecentlyResolved() []*Incident {
+	if x != nil {
+		return x.RecentlyResolved
+	}
+	return nil
+}
+
+type CreateIncidentRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Meta             *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Title            string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Message          string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Severity         IncidentSeverity       `protobuf:"varint,4,opt,name=severity,proto3,enum=rgs.v1.IncidentSeverity" json:"severity,omitempty"`
+	AffectedServices []string               `protobuf:"bytes,5,rep,name=affected_services,json=affectedServices,proto3" json:"affected_services,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateIncidentRequest) Reset() {
+	*x = CreateIncidentRequest{}
+	mi := &file_rgs_v1_system_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateIncidentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIncidentRequest) ProtoMessage() {}
+
+func (x *CreateIncidentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_system_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateIncidentRequest.ProtoReflect.Descriptor instead.
+func (*CreateIncidentRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_system_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateIncidentRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *CreateIncidentRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateIncidentRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateIncidentRequest) GetSeverity() IncidentSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return IncidentSeverity_INCIDENT_SEVERITY_UNSPECIFIED
+}
+
+func (x *CreateIncidentRequest) GetAffectedServices() []string {
+	if x != nil {
+		return x.AffectedServices
+	}
+	return nil
+}
+
+type CreateIncidentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Incident      *Incident              `protobuf:"bytes,2,opt,name=incident,proto3" json:"incident,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateIncidentResponse) Reset() {
+	*x = CreateIncidentResponse{}
+	mi := &file_rgs_v1_system_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateIncidentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIncidentResponse) ProtoMessage() {}
+
+func (x *CreateIncidentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_system_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateIncidentResponse.ProtoReflect.Descriptor instead.
+func (*CreateIncidentResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_system_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreateIncidentResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *CreateIncidentResponse) GetIncident() *Incident {
+	if x != nil {
+		return x.Incident
+	}
+	return nil
+}
+
+type UpdateIncidentRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Meta             *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	IncidentId       string                 `protobuf:"bytes,2,opt,name=incident_id,json=incidentId,proto3" json:"incident_id,omitempty"`
+	Message          string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Severity         IncidentSeverity       `protobuf:"varint,4,opt,name=severity,proto3,enum=rgs.v1.IncidentSeverity" json:"severity,omitempty"`
+	Status           IncidentStatus         `protobuf:"varint,5,opt,name=status,proto3,enum=rgs.v1.IncidentStatus" json:"status,omitempty"`
+	AffectedServices []string               `protobuf:"bytes,6,rep,name=affected_services,json=affectedServices,proto3" json:"affected_services,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateIncidentRequest) Reset() {
+	*x = UpdateIncidentRequest{}
+	mi := &file_rgs_v1_system_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateIncidentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateIncidentRequest) ProtoMessage() {}
+
+func (x *UpdateIncidentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_system_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateIncidentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateIncidentRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_system_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateIncidentRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *UpdateIncidentRequest) GetIncidentId() string {
+	if x != nil {
+		return x.IncidentId
+	}
+	return ""
+}
+
+func (x *UpdateIncidentRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateIncidentRequest) GetSeverity() IncidentSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return IncidentSeverity_INCIDENT_SEVERITY_UNSPECIFIED
+}
+
+func (x *UpdateIncidentRequest) GetStatus() IncidentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return IncidentStatus_INCIDENT_STATUS_UNSPECIFIED
+}
+
+func (x *UpdateIncidentRequest) GetAffectedServices() []string {
+	if x != nil {
+		return x.AffectedServices
+	}
+	return nil
+}
+
+type UpdateIncidentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Incident      *Incident              `protobuf:"bytes,2,opt,name=incident,proto3" json:"incident,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateIncidentResponse) Reset() {
+	*x = UpdateIncidentResponse{}
+	mi := &file_rgs_v1_system_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateIncidentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateIncidentResponse) ProtoMessage() {}
+
+func (x *UpdateIncidentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_system_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateIncidentResponse.ProtoReflect.Descriptor instead.
+func (*UpdateIncidentResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_system_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateIncidentResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *UpdateIncidentResponse) GetIncident() *Incident {
+	if x != nil {
+		return x.Incident
+	}
+	return nil
+}
+
+type ResolveIncidentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	IncidentId    string                 `protobuf:"bytes,2,opt,name=incident_id,json=incidentId,proto3" json:"incident_id,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveIncidentRequest) Reset() {
+	*x = ResolveIncidentRequest{}
+	mi := &file_rgs_v1_system_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveIncidentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveIncidentRequest) ProtoMessage() {}
+
+func (x *ResolveIncidentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_system_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveIncidentRequest.ProtoReflect.Descriptor instead.
+func (*ResolveIncidentRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_system_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ResolveIncidentRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ResolveIncidentRequest) GetIncidentId() string {
+	if x != nil {
+		return x.IncidentId
+	}
+	return ""
+}
+
+func (x *ResolveIncidentRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ResolveIncidentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Incident      *Incident              `protobuf:"bytes,2,opt,name=incident,proto3" json:"incident,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveIncidentResponse) Reset() {
+	*x = ResolveIncidentResponse{}
+	mi := &file_rgs_v1_system_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveIncidentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveIncidentResponse) ProtoMessage() {}
+
+func (x *ResolveIncidentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_system_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveIncidentResponse.ProtoReflect.Descriptor instead.
+func (*ResolveIncidentResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_system_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ResolveIncidentResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ResolveIncidentResponse) GetIncident() *Incident {
+	if x != nil {
+		return x.Incident
+	}
+	return nil
+}
+
+type ListIncidentsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Meta            *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	IncludeResolved bool                   `protobuf:"varint,2,opt,name=include_resolved,json=includeResolved,proto3" json:"include_resolved,omitempty"`
+	PageSize        int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken       string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListIncidentsRequest) Reset() {
+	*x = ListIncidentsRequest{}
+	mi := &file_rgs_v1_system_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIncidentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIncidentsRequest) ProtoMessage() {}
+
+func (x *ListIncidentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_system_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIncidentsRequest.ProtoReflect.Descriptor instead.
+func (*ListIncidentsRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_system_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListIncidentsRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListIncidentsRequest) GetIncludeResolved() bool {
+	if x != nil {
+		return x.IncludeResolved
+	}
+	return false
+}
+
+func (x *ListIncidentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListIncidentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListIncidentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Incidents     []*Incident            `protobuf:"bytes,2,rep,name=incidents,proto3" json:"incidents,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIncidentsResponse) Reset() {
+	*x = ListIncidentsResponse{}
+	mi := &file_rgs_v1_system_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIncidentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIncidentsResponse) ProtoMessage() {}
+
+func (x *ListIncidentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_system_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIncidentsResponse.ProtoReflect.Descriptor instead.
+func (*ListIncidentsResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_system_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListIncidentsResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListIncidentsResponse) GetIncidents() []*Incident {
+	if x != nil {
+		return x.Incidents
+	}
+	return nil
+}
+
+func (x *ListIncidentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListScheduledJobsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSystemStatusRequest) Reset() {
-	*x = GetSystemStatusRequest{}
-	mi := &file_rgs_v1_system_proto_msgTypes[0]
+func (x *ListScheduledJobsRequest) Reset() {
+	*x = ListScheduledJobsRequest{}
+	mi := &file_rgs_v1_system_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSystemStatusRequest) String() string {
+func (x *ListScheduledJobsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSystemStatusRequest) ProtoMessage() {}
+func (*ListScheduledJobsRequest) ProtoMessage() {}
 
-func (x *GetSystemStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_system_proto_msgTypes[0]
+func (x *ListScheduledJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_system_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -54,43 +1578,41 @@ func (x *GetSystemStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSystemStatusRequest.ProtoReflect.Descriptor instead.
-func (*GetSystemStatusRequest) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_system_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use ListScheduledJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduledJobsRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_system_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GetSystemStatusRequest) GetMeta() *RequestMeta {
+func (x *ListScheduledJobsRequest) GetMeta() *RequestMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-type GetSystemStatusResponse struct {
+type ListScheduledJobsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	ServiceName   string                 `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
-	Version       string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
-	Uptime        string                 `protobuf:"bytes,4,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	Jobs          []*ScheduledJob        `protobuf:"bytes,2,rep,name=jobs,proto3" json:"jobs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSystemStatusResponse) Reset() {
-	*x = GetSystemStatusResponse{}
-	mi := &file_rgs_v1_system_proto_msgTypes[1]
+func (x *ListScheduledJobsResponse) Reset() {
+	*x = ListScheduledJobsResponse{}
+	mi := &file_rgs_v1_system_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSystemStatusResponse) String() string {
+func (x *ListScheduledJobsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSystemStatusResponse) ProtoMessage() {}
+func (*ListScheduledJobsResponse) ProtoMessage() {}
 
-func (x *GetSystemStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_system_proto_msgTypes[1]
+func (x *ListScheduledJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_system_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -101,53 +1623,452 @@ func (x *GetSystemStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSystemStatusResponse.ProtoReflect.Descriptor instead.
-func (*GetSystemStatusResponse) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_system_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use ListScheduledJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduledJobsResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_system_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *GetSystemStatusResponse) GetMeta() *ResponseMeta {
+func (x *ListScheduledJobsResponse) GetMeta() *ResponseMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *GetSystemStatusResponse) GetServiceName() string {
+func (x *ListScheduledJobsResponse) GetJobs() []*ScheduledJob {
 	if x != nil {
-		return x.ServiceName
+		return x.Jobs
+	}
+	return nil
+}
+
+type ListJobRunsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	JobName       string                 `protobuf:"bytes,2,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobRunsRequest) Reset() {
+	*x = ListJobRunsRequest{}
+	mi := &file_rgs_v1_system_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobRunsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobRunsRequest) ProtoMessage() {}
+
+func (x *ListJobRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_system_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobRunsRequest.ProtoReflect.Descriptor instead.
+func (*ListJobRunsRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_system_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListJobRunsRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListJobRunsRequest) GetJobName() string {
+	if x != nil {
+		return x.JobName
 	}
 	return ""
 }
 
-func (x *GetSystemStatusResponse) GetVersion() string {
+func (x *ListJobRunsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Version
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListJobRunsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
 	}
 	return ""
 }
 
-func (x *GetSystemStatusResponse) GetUptime() string {
+type ListJobRunsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Runs          []*JobRun              `protobuf:"bytes,2,rep,name=runs,proto3" json:"runs,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobRunsResponse) Reset() {
+	*x = ListJobRunsResponse{}
+	mi := &file_rgs_v1_system_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobRunsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobRunsResponse) ProtoMessage() {}
+
+func (x *ListJobRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_system_proto_msgTypes[21]
 	if x != nil {
-		return x.Uptime
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobRunsResponse.ProtoReflect.Descriptor instead.
+func (*ListJobRunsResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_system_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListJobRunsResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListJobRunsResponse) GetRuns() []*JobRun {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+func (x *ListJobRunsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RunSmokeChecksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Meta  *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// Names of the checks to run; empty runs the full battery.
+	Checks        []string `protobuf:"bytes,2,rep,name=checks,proto3" json:"checks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunSmokeChecksRequest) Reset() {
+	*x = RunSmokeChecksRequest{}
+	mi := &file_rgs_v1_system_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunSmokeChecksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunSmokeChecksRequest) ProtoMessage() {}
+
+func (x *RunSmokeChecksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_system_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunSmokeChecksRequest.ProtoReflect.Descriptor instead.
+func (*RunSmokeChecksRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_system_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RunSmokeChecksRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *RunSmokeChecksRequest) GetChecks() []string {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+type RunSmokeChecksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Meta  *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	RunId string                 `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// True when no check failed; skipped checks do not fail the run.
+	Passed        bool                `protobuf:"varint,3,opt,name=passed,proto3" json:"passed,omitempty"`
+	Results       []*SmokeCheckResult `protobuf:"bytes,4,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunSmokeChecksResponse) Reset() {
+	*x = RunSmokeChecksResponse{}
+	mi := &file_rgs_v1_system_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunSmokeChecksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunSmokeChecksResponse) ProtoMessage() {}
+
+func (x *RunSmokeChecksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_system_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunSmokeChecksResponse.ProtoReflect.Descriptor instead.
+func (*RunSmokeChecksResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_system_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RunSmokeChecksResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *RunSmokeChecksResponse) GetRunId() string {
+	if x != nil {
+		return x.RunId
 	}
 	return ""
 }
 
+func (x *RunSmokeChecksResponse) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *RunSmokeChecksResponse) GetResults() []*SmokeCheckResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
 var File_rgs_v1_system_proto protoreflect.FileDescriptor
 
 const file_rgs_v1_system_proto_rawDesc = "" +
 	"\n" +
-	"\x13rgs/v1/system.proto\x12\x06rgs.v1\x1a\x13rgs/v1/common.proto\x1a\x1cgoogle/api/annotations.proto\"A\n" +
+	"\x13rgs/v1/system.proto\x12\x06rgs.v1\x1a\x13rgs/v1/common.proto\x1a\x1cgoogle/api/annotations.proto\"\xcc\x01\n" +
+	"\x0eIncidentUpdate\x12.\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x16.rgs.v1.IncidentStatusR\x06status\x124\n" +
+	"\bseverity\x18\x02 \x01(\x0e2\x18.rgs.v1.IncidentSeverityR\bseverity\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x19\n" +
+	"\bactor_id\x18\x04 \x01(\tR\aactorId\x12\x1f\n" +
+	"\vrecorded_at\x18\x05 \x01(\tR\n" +
+	"recordedAt\"\xff\x02\n" +
+	"\bIncident\x12\x1f\n" +
+	"\vincident_id\x18\x01 \x01(\tR\n" +
+	"incidentId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x124\n" +
+	"\bseverity\x18\x04 \x01(\x0e2\x18.rgs.v1.IncidentSeverityR\bseverity\x12.\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x16.rgs.v1.IncidentStatusR\x06status\x12+\n" +
+	"\x11affected_services\x18\x06 \x03(\tR\x10affectedServices\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\a \x01(\tR\tstartedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAt\x12\x1f\n" +
+	"\vresolved_at\x18\t \x01(\tR\n" +
+	"resolvedAt\x120\n" +
+	"\aupdates\x18\n" +
+	" \x03(\v2\x16.rgs.v1.IncidentUpdateR\aupdates\"\xe5\x01\n" +
+	"\fScheduledJob\x12\x19\n" +
+	"\bjob_name\x18\x01 \x01(\tR\ajobName\x12\x1a\n" +
+	"\bschedule\x18\x02 \x01(\tR\bschedule\x12\x1e\n" +
+	"\vnext_run_at\x18\x03 \x01(\tR\tnextRunAt\x12\x1e\n" +
+	"\vlast_run_at\x18\x04 \x01(\tR\tlastRunAt\x125\n" +
+	"\vlast_status\x18\x05 \x01(\x0e2\x14.rgs.v1.JobRunStatusR\n" +
+	"lastStatus\x12'\n" +
+	"\x0ffailed_attempts\x18\x06 \x01(\x05R\x0efailedAttempts\"\xd3\x02\n" +
+	"\x06JobRun\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\x12\x19\n" +
+	"\bjob_name\x18\x02 \x01(\tR\ajobName\x12\x15\n" +
+	"\x06job_id\x18\x03 \x01(\tR\x05jobId\x12\x18\n" +
+	"\aattempt\x18\x04 \x01(\x05R\aattempt\x12,\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x14.rgs.v1.JobRunStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x06 \x01(\tR\tstartedAt\x12\x1f\n" +
+	"\vfinished_at\x18\a \x01(\tR\n" +
+	"finishedAt\x12\x18\n" +
+	"\asummary\x18\b \x01(\tR\asummary\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\x12\x1f\n" +
+	"\vinstance_id\x18\n" +
+	" \x01(\tR\n" +
+	"instanceId\x12'\n" +
+	"\x0fretry_scheduled\x18\v \x01(\bR\x0eretryScheduled\"\x91\x01\n" +
+	"\x10SmokeCheckResult\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x120\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x18.rgs.v1.SmokeCheckStatusR\x06status\x12\x16\n" +
+	"\x06detail\x18\x03 \x01(\tR\x06detail\x12\x1f\n" +
+	"\vduration_ms\x18\x04 \x01(\x03R\n" +
+	"durationMs\"b\n" +
 	"\x16GetSystemStatusRequest\x12'\n" +
-	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\"\x98\x01\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\tR\n" +
+	"operatorId\"\xc7\x01\n" +
+	"\x12GamingCalendarInfo\x12\x1b\n" +
+	"\ttime_zone\x18\x01 \x01(\tR\btimeZone\x12,\n" +
+	"\x12utc_offset_seconds\x18\x02 \x01(\x05R\x10utcOffsetSeconds\x12(\n" +
+	"\x10gaming_day_start\x18\x03 \x01(\tR\x0egamingDayStart\x12\x1d\n" +
+	"\n" +
+	"gaming_day\x18\x04 \x01(\tR\tgamingDay\x12\x1d\n" +
+	"\n" +
+	"local_time\x18\x05 \x01(\tR\tlocalTime\"\x9a\x02\n" +
 	"\x17GetSystemStatusResponse\x12(\n" +
 	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12!\n" +
 	"\fservice_name\x18\x02 \x01(\tR\vserviceName\x12\x18\n" +
 	"\aversion\x18\x03 \x01(\tR\aversion\x12\x16\n" +
-	"\x06uptime\x18\x04 \x01(\tR\x06uptime2~\n" +
+	"\x06uptime\x18\x04 \x01(\tR\x06uptime\x12;\n" +
+	"\x10active_incidents\x18\x05 \x03(\v2\x10.rgs.v1.IncidentR\x0factiveIncidents\x12C\n" +
+	"\x0fgaming_calendar\x18\x06 \x01(\v2\x1a.rgs.v1.GamingCalendarInfoR\x0egamingCalendar\"f\n" +
+	"\x14GetStatusPageRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12%\n" +
+	"\x0eresolved_limit\x18\x02 \x01(\x05R\rresolvedLimit\"\x82\x02\n" +
+	"\x15GetStatusPageResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12C\n" +
+	"\x10overall_severity\x18\x02 \x01(\x0e2\x18.rgs.v1.IncidentSeverityR\x0foverallSeverity\x12;\n" +
+	"\x10active_incidents\x18\x03 \x03(\v2\x10.rgs.v1.IncidentR\x0factiveIncidents\x12=\n" +
+	"\x11recently_resolved\x18\x04 \x03(\v2\x10.rgs.v1.IncidentR\x10recentlyResolved\"\xd3\x01\n" +
+	"\x15CreateIncidentRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x124\n" +
+	"\bseverity\x18\x04 \x01(\x0e2\x18.rgs.v1.IncidentSeverityR\bseverity\x12+\n" +
+	"\x11affected_services\x18\x05 \x03(\tR\x10affectedServices\"p\n" +
+	"\x16CreateIncidentResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12,\n" +
+	"\bincident\x18\x02 \x01(\v2\x10.rgs.v1.IncidentR\bincident\"\x8e\x02\n" +
+	"\x15UpdateIncidentRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x1f\n" +
+	"\vincident_id\x18\x02 \x01(\tR\n" +
+	"incidentId\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x124\n" +
+	"\bseverity\x18\x04 \x01(\x0e2\x18.rgs.v1.IncidentSeverityR\bseverity\x12.\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x16.rgs.v1.IncidentStatusR\x06status\x12+\n" +
+	"\x11affected_services\x18\x06 \x03(\tR\x10affectedServices\"p\n" +
+	"\x16UpdateIncidentResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12,\n" +
+	"\bincident\x18\x02 \x01(\v2\x10.rgs.v1.IncidentR\bincident\"|\n" +
+	"\x16ResolveIncidentRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x1f\n" +
+	"\vincident_id\x18\x02 \x01(\tR\n" +
+	"incidentId\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"q\n" +
+	"\x17ResolveIncidentResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12,\n" +
+	"\bincident\x18\x02 \x01(\v2\x10.rgs.v1.IncidentR\bincident\"\xa6\x01\n" +
+	"\x14ListIncidentsRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12)\n" +
+	"\x10include_resolved\x18\x02 \x01(\bR\x0fincludeResolved\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"\x99\x01\n" +
+	"\x15ListIncidentsResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12.\n" +
+	"\tincidents\x18\x02 \x03(\v2\x10.rgs.v1.IncidentR\tincidents\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"C\n" +
+	"\x18ListScheduledJobsRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\"o\n" +
+	"\x19ListScheduledJobsResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12(\n" +
+	"\x04jobs\x18\x02 \x03(\v2\x14.rgs.v1.ScheduledJobR\x04jobs\"\x94\x01\n" +
+	"\x12ListJobRunsRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x19\n" +
+	"\bjob_name\x18\x02 \x01(\tR\ajobName\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"\x8b\x01\n" +
+	"\x13ListJobRunsResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12\"\n" +
+	"\x04runs\x18\x02 \x03(\v2\x0e.rgs.v1.JobRunR\x04runs\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"X\n" +
+	"\x15RunSmokeChecksRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x16\n" +
+	"\x06checks\x18\x02 \x03(\tR\x06checks\"\xa5\x01\n" +
+	"\x16RunSmokeChecksResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12\x15\n" +
+	"\x06run_id\x18\x02 \x01(\tR\x05runId\x12\x16\n" +
+	"\x06passed\x18\x03 \x01(\bR\x06passed\x122\n" +
+	"\aresults\x18\x04 \x03(\v2\x18.rgs.v1.SmokeCheckResultR\aresults*\xab\x01\n" +
+	"\x10IncidentSeverity\x12!\n" +
+	"\x1dINCIDENT_SEVERITY_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16INCIDENT_SEVERITY_INFO\x10\x01\x12\x1b\n" +
+	"\x17INCIDENT_SEVERITY_MINOR\x10\x02\x12\x1b\n" +
+	"\x17INCIDENT_SEVERITY_MAJOR\x10\x03\x12\x1e\n" +
+	"\x1aINCIDENT_SEVERITY_CRITICAL\x10\x04*\xb2\x01\n" +
+	"\x0eIncidentStatus\x12\x1f\n" +
+	"\x1bINCIDENT_STATUS_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dINCIDENT_STATUS_INVESTIGATING\x10\x01\x12\x1e\n" +
+	"\x1aINCIDENT_STATUS_IDENTIFIED\x10\x02\x12\x1e\n" +
+	"\x1aINCIDENT_STATUS_MONITORING\x10\x03\x12\x1c\n" +
+	"\x18INCIDENT_STATUS_RESOLVED\x10\x04*g\n" +
+	"\fJobRunStatus\x12\x1e\n" +
+	"\x1aJOB_RUN_STATUS_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18JOB_RUN_STATUS_SUCCEEDED\x10\x01\x12\x19\n" +
+	"\x15JOB_RUN_STATUS_FAILED\x10\x02*\x94\x01\n" +
+	"\x10SmokeCheckStatus\x12\"\n" +
+	"\x1eSMOKE_CHECK_STATUS_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19SMOKE_CHECK_STATUS_PASSED\x10\x01\x12\x1d\n" +
+	"\x19SMOKE_CHECK_STATUS_FAILED\x10\x02\x12\x1e\n" +
+	"\x1aSMOKE_CHECK_STATUS_SKIPPED\x10\x032\xac\b\n" +
 	"\rSystemService\x12m\n" +
-	"\x0fGetSystemStatus\x12\x1e.rgs.v1.GetSystemStatusRequest\x1a\x1f.rgs.v1.GetSystemStatusResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/system/statusB\x8d\x01\n" +
+	"\x0fGetSystemStatus\x12\x1e.rgs.v1.GetSystemStatusRequest\x1a\x1f.rgs.v1.GetSystemStatusResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/system/status\x12l\n" +
+	"\rGetStatusPage\x12\x1c.rgs.v1.GetStatusPageRequest\x1a\x1d.rgs.v1.GetStatusPageResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/system/status-page\x12p\n" +
+	"\x0eCreateIncident\x12\x1d.rgs.v1.CreateIncidentRequest\x1a\x1e.rgs.v1.CreateIncidentResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/system/incidents\x12\x85\x01\n" +
+	"\x0eUpdateIncident\x12\x1d.rgs.v1.UpdateIncidentRequest\x1a\x1e.rgs.v1.UpdateIncidentResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\")/v1/system/incidents/{incident_id}:update\x12\x89\x01\n" +
+	"\x0fResolveIncident\x12\x1e.rgs.v1.ResolveIncidentRequest\x1a\x1f.rgs.v1.ResolveIncidentResponse\"5\x82\xd3\xe4\x93\x02/:\x01*\"*/v1/system/incidents/{incident_id}:resolve\x12j\n" +
+	"\rListIncidents\x12\x1c.rgs.v1.ListIncidentsRequest\x1a\x1d.rgs.v1.ListIncidentsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/system/incidents\x12q\n" +
+	"\x11ListScheduledJobs\x12 .rgs.v1.ListScheduledJobsRequest\x1a!.rgs.v1.ListScheduledJobsResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/system/jobs\x12d\n" +
+	"\vListJobRuns\x12\x1a.rgs.v1.ListJobRunsRequest\x1a\x1b.rgs.v1.ListJobRunsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/system/jobs/runs\x12s\n" +
+	"\x0eRunSmokeChecks\x12\x1d.rgs.v1.RunSmokeChecksRequest\x1a\x1e.rgs.v1.RunSmokeChecksResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/system/smoke-checksB\x8d\x01\n" +
 	"\n" +
 	"com.rgs.v1B\vSystemProtoP\x01Z9github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1;rgsv1\xa2\x02\x03RXX\xaa\x02\x06Rgs.V1\xca\x02\x06Rgs\\V1\xe2\x02\x12Rgs\\V1\\GPBMetadata\xea\x02\aRgs::V1b\x06proto3"
 
@@ -163,23 +2084,105 @@ func file_rgs_v1_system_proto_rawDescGZIP() []byte {
 	return file_rgs_v1_system_proto_rawDescData
 }
 
-var file_rgs_v1_system_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rgs_v1_system_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_rgs_v1_system_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_rgs_v1_system_proto_goTypes = []any{
-	(*GetSystemStatusRequest)(nil),  // 0: rgs.v1.GetSystemStatusRequest
-	(*GetSystemStatusResponse)(nil), // 1: rgs.v1.GetSystemStatusResponse
-	(*RequestMeta)(nil),             // 2: rgs.v1.RequestMeta
-	(*ResponseMeta)(nil),            // 3: rgs.v1.ResponseMeta
+	(IncidentSeverity)(0),             // 0: rgs.v1.IncidentSeverity
+	(IncidentStatus)(0),               // 1: rgs.v1.IncidentStatus
+	(JobRunStatus)(0),                 // 2: rgs.v1.JobRunStatus
+	(SmokeCheckStatus)(0),             // 3: rgs.v1.SmokeCheckStatus
+	(*IncidentUpdate)(nil),            // 4: rgs.v1.IncidentUpdate
+	(*Incident)(nil),                  // 5: rgs.v1.Incident
+	(*ScheduledJob)(nil),              // 6: rgs.v1.ScheduledJob
+	(*JobRun)(nil),                    // 7: rgs.v1.JobRun
+	(*SmokeCheckResult)(nil),          // 8: rgs.v1.SmokeCheckResult
+	(*GetSystemStatusRequest)(nil),    // 9: rgs.v1.GetSystemStatusRequest
+	(*GamingCalendarInfo)(nil),        // 10: rgs.v1.GamingCalendarInfo
+	(*GetSystemStatusResponse)(nil),   // 11: rgs.v1.GetSystemStatusResponse
+	(*GetStatusPageRequest)(nil),      // 12: rgs.v1.GetStatusPageRequest
+	(*GetStatusPageResponse)(nil),     // 13: rgs.v1.GetStatusPageResponse
+	(*CreateIncidentRequest)(nil),     // 14: rgs.v1.CreateIncidentRequest
+	(*CreateIncidentResponse)(nil),    // 15: rgs.v1.CreateIncidentResponse
+	(*UpdateIncidentRequest)(nil),     // 16: rgs.v1.UpdateIncidentRequest
+	(*UpdateIncidentResponse)(nil),    // 17: rgs.v1.UpdateIncidentResponse
+	(*ResolveIncidentRequest)(nil),    // 18: rgs.v1.ResolveIncidentRequest
+	(*ResolveIncidentResponse)(nil),   // 19: rgs.v1.ResolveIncidentResponse
+	(*ListIncidentsRequest)(nil),      // 20: rgs.v1.ListIncidentsRequest
+	(*ListIncidentsResponse)(nil),     // 21: rgs.v1.ListIncidentsResponse
+	(*ListScheduledJobsRequest)(nil),  // 22: rgs.v1.ListScheduledJobsRequest
+	(*ListScheduledJobsResponse)(nil), // 23: rgs.v1.ListScheduledJobsResponse
+	(*ListJobRunsRequest)(nil),        // 24: rgs.v1.ListJobRunsRequest
+	(*ListJobRunsResponse)(nil),       // 25: rgs.v1.ListJobRunsResponse
+	(*RunSmokeChecksRequest)(nil),     // 26: rgs.v1.RunSmokeChecksRequest
+	(*RunSmokeChecksResponse)(nil),    // 27: rgs.v1.RunSmokeChecksResponse
+	(*RequestMeta)(nil),               // 28: rgs.v1.RequestMeta
+	(*ResponseMeta)(nil),              // 29: rgs.v1.ResponseMeta
 }
 var file_rgs_v1_system_proto_depIdxs = []int32{
-	2, // 0: rgs.v1.GetSystemStatusRequest.meta:type_name -> rgs.v1.RequestMeta
-	3, // 1: rgs.v1.GetSystemStatusResponse.meta:type_name -> rgs.v1.ResponseMeta
-	0, // 2: rgs.v1.SystemService.GetSystemStatus:input_type -> rgs.v1.GetSystemStatusRequest
-	1, // 3: rgs.v1.SystemService.GetSystemStatus:output_type -> rgs.v1.GetSystemStatusResponse
-	3, // [3:4] is the sub-list for method output_type
-	2, // [2:3] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	1,  // 0: rgs.v1.IncidentUpdate.status:type_name -> rgs.v1.IncidentStatus
+	0,  // 1: rgs.v1.IncidentUpdate.severity:type_name -> rgs.v1.IncidentSeverity
+	0,  // 2: rgs.v1.Incident.severity:type_name -> rgs.v1.IncidentSeverity
+	1,  // 3: rgs.v1.Incident.status:type_name -> rgs.v1.IncidentStatus
+	4,  // 4: rgs.v1.Incident.updates:type_name -> rgs.v1.IncidentUpdate
+	2,  // 5: rgs.v1.ScheduledJob.last_status:type_name -> rgs.v1.JobRunStatus
+	2,  // 6: rgs.v1.JobRun.status:type_name -> rgs.v1.JobRunStatus
+	3,  // 7: rgs.v1.SmokeCheckResult.status:type_name -> rgs.v1.SmokeCheckStatus
+	28, // 8: rgs.v1.GetSystemStatusRequest.meta:type_name -> rgs.v1.RequestMeta
+	29, // 9: rgs.v1.GetSystemStatusResponse.meta:type_name -> rgs.v1.ResponseMeta
+	5,  // 10: rgs.v1.GetSystemStatusResponse.active_incidents:type_name -> rgs.v1.Incident
+	10, // 11: rgs.v1.GetSystemStatusResponse.gaming_calendar:type_name -> rgs.v1.GamingCalendarInfo
+	28, // 12: rgs.v1.GetStatusPageRequest.meta:type_name -> rgs.v1.RequestMeta
+	29, // 13: rgs.v1.GetStatusPageResponse.meta:type_name -> rgs.v1.ResponseMeta
+	0,  // 14: rgs.v1.GetStatusPageResponse.overall_severity:type_name -> rgs.v1.IncidentSeverity
+	5,  // 15: rgs.v1.GetStatusPageResponse.active_incidents:type_name -> rgs.v1.Incident
+	5,  // 16: rgs.v1.GetStatusPageResponse.recently_resolved:type_name -> rgs.v1.Incident
+	28, // 17: rgs.v1.CreateIncidentRequest.meta:type_name -> rgs.v1.RequestMeta
+	0,  // 18: rgs.v1.CreateIncidentRequest.severity:type_name -> rgs.v1.IncidentSeverity
+	29, // 19: rgs.v1.CreateIncidentResponse.meta:type_name -> rgs.v1.ResponseMeta
+	5,  // 20: rgs.v1.CreateIncidentResponse.incident:type_name -> rgs.v1.Incident
+	28, // 21: rgs.v1.UpdateIncidentRequest.meta:type_name -> rgs.v1.RequestMeta
+	0,  // 22: rgs.v1.UpdateIncidentRequest.severity:type_name -> rgs.v1.IncidentSeverity
+	1,  // 23: rgs.v1.UpdateIncidentRequest.status:type_name -> rgs.v1.IncidentStatus
+	29, // 24: rgs.v1.UpdateIncidentResponse.meta:type_name -> rgs.v1.ResponseMeta
+	5,  // 25: rgs.v1.UpdateIncidentResponse.incident:type_name -> rgs.v1.Incident
+	28, // 26: rgs.v1.ResolveIncidentRequest.meta:type_name -> rgs.v1.RequestMeta
+	29, // 27: rgs.v1.ResolveIncidentResponse.meta:type_name -> rgs.v1.ResponseMeta
+	5,  // 28: rgs.v1.ResolveIncidentResponse.incident:type_name -> rgs.v1.Incident
+	28, // 29: rgs.v1.ListIncidentsRequest.meta:type_name -> rgs.v1.RequestMeta
+	29, // 30: rgs.v1.ListIncidentsResponse.meta:type_name -> rgs.v1.ResponseMeta
+	5,  // 31: rgs.v1.ListIncidentsResponse.incidents:type_name -> rgs.v1.Incident
+	28, // 32: rgs.v1.ListScheduledJobsRequest.meta:type_name -> rgs.v1.RequestMeta
+	29, // 33: rgs.v1.ListScheduledJobsResponse.meta:type_name -> rgs.v1.ResponseMeta
+	6,  // 34: rgs.v1.ListScheduledJobsResponse.jobs:type_name -> rgs.v1.ScheduledJob
+	28, // 35: rgs.v1.ListJobRunsRequest.meta:type_name -> rgs.v1.RequestMeta
+	29, // 36: rgs.v1.ListJobRunsResponse.meta:type_name -> rgs.v1.ResponseMeta
+	7,  // 37: rgs.v1.ListJobRunsResponse.runs:type_name -> rgs.v1.JobRun
+	28, // 38: rgs.v1.RunSmokeChecksRequest.meta:type_name -> rgs.v1.RequestMeta
+	29, // 39: rgs.v1.RunSmokeChecksResponse.meta:type_name -> rgs.v1.ResponseMeta
+	8,  // 40: rgs.v1.RunSmokeChecksResponse.results:type_name -> rgs.v1.SmokeCheckResult
+	9,  // 41: rgs.v1.SystemService.GetSystemStatus:input_type -> rgs.v1.GetSystemStatusRequest
+	12, // 42: rgs.v1.SystemService.GetStatusPage:input_type -> rgs.v1.GetStatusPageRequest
+	14, // 43: rgs.v1.SystemService.CreateIncident:input_type -> rgs.v1.CreateIncidentRequest
+	16, // 44: rgs.v1.SystemService.UpdateIncident:input_type -> rgs.v1.UpdateIncidentRequest
+	18, // 45: rgs.v1.SystemService.ResolveIncident:input_type -> rgs.v1.ResolveIncidentRequest
+	20, // 46: rgs.v1.SystemService.ListIncidents:input_type -> rgs.v1.ListIncidentsRequest
+	22, // 47: rgs.v1.SystemService.ListScheduledJobs:input_type -> rgs.v1.ListScheduledJobsRequest
+	24, // 48: rgs.v1.SystemService.ListJobRuns:input_type -> rgs.v1.ListJobRunsRequest
+	26, // 49: rgs.v1.SystemService.RunSmokeChecks:input_type -> rgs.v1.RunSmokeChecksRequest
+	11, // 50: rgs.v1.SystemService.GetSystemStatus:output_type -> rgs.v1.GetSystemStatusResponse
+	13, // 51: rgs.v1.SystemService.GetStatusPage:output_type -> rgs.v1.GetStatusPageResponse
+	15, // 52: rgs.v1.SystemService.CreateIncident:output_type -> rgs.v1.CreateIncidentResponse
+	17, // 53: rgs.v1.SystemService.UpdateIncident:output_type -> rgs.v1.UpdateIncidentResponse
+	19, // 54: rgs.v1.SystemService.ResolveIncident:output_type -> rgs.v1.ResolveIncidentResponse
+	21, // 55: rgs.v1.SystemService.ListIncidents:output_type -> rgs.v1.ListIncidentsResponse
+	23, // 56: rgs.v1.SystemService.ListScheduledJobs:output_type -> rgs.v1.ListScheduledJobsResponse
+	25, // 57: rgs.v1.SystemService.ListJobRuns:output_type -> rgs.v1.ListJobRunsResponse
+	27, // 58: rgs.v1.SystemService.RunSmokeChecks:output_type -> rgs.v1.RunSmokeChecksResponse
+	50, // [50:59] is the sub-list for method output_type
+	41, // [41:50] is the sub-list for method input_type
+	41, // [41:41] is the sub-list for extension type_name
+	41, // [41:41] is the sub-list for extension extendee
+	0,  // [0:41] is the sub-list for field type_name
 }
 
 func init() { file_rgs_v1_system_proto_init() }
@@ -193,13 +2196,14 @@ func file_rgs_v1_system_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rgs_v1_system_proto_rawDesc), len(file_rgs_v1_system_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      4,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_rgs_v1_system_proto_goTypes,
 		DependencyIndexes: file_rgs_v1_system_proto_depIdxs,
+		EnumInfos:         file_rgs_v1_system_proto_enumTypes,
 		MessageInfos:      file_rgs_v1_system_proto_msgTypes,
 	}.Build()
 	File_rgs_v1_system_proto = out.File
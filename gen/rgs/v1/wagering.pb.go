@@ -29,6 +29,7 @@ const (
 	WagerStatus_WAGER_STATUS_PENDING     WagerStatus = 1
 	WagerStatus_WAGER_STATUS_SETTLED     WagerStatus = 2
 	WagerStatus_WAGER_STATUS_CANCELED    WagerStatus = 3
+	WagerStatus_WAGER_STATUS_VOIDED      WagerStatus = 4
 )
 
 // Enum value maps for WagerStatus.
@@ -38,12 +39,14 @@ var (
 		1: "WAGER_STATUS_PENDING",
 		2: "WAGER_STATUS_SETTLED",
 		3: "WAGER_STATUS_CANCELED",
+		4: "WAGER_STATUS_VOIDED",
 	}
 	WagerStatus_value = map[string]int32{
 		"WAGER_STATUS_UNSPECIFIED": 0,
 		"WAGER_STATUS_PENDING":     1,
 		"WAGER_STATUS_SETTLED":     2,
 		"WAGER_STATUS_CANCELED":    3,
+		"WAGER_STATUS_VOIDED":      4,
 	}
 )
 
@@ -75,18 +78,26 @@ func (WagerStatus) EnumDescriptor() ([]byte, []int) {
 }
 
 type Wager struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	WagerId       string                 `protobuf:"bytes,1,opt,name=wager_id,json=wagerId,proto3" json:"wager_id,omitempty"`
-	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	GameId        string                 `protobuf:"bytes,3,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	Stake         *Money                 `protobuf:"bytes,4,opt,name=stake,proto3" json:"stake,omitempty"`
-	Status        WagerStatus            `protobuf:"varint,5,opt,name=status,proto3,enum=rgs.v1.WagerStatus" json:"status,omitempty"`
-	Payout        *Money                 `protobuf:"bytes,6,opt,name=payout,proto3" json:"payout,omitempty"`
-	OutcomeRef    string                 `protobuf:"bytes,7,opt,name=outcome_ref,json=outcomeRef,proto3" json:"outcome_ref,omitempty"`
-	PlacedAt      string                 `protobuf:"bytes,8,opt,name=placed_at,json=placedAt,proto3" json:"placed_at,omitempty"`
-	SettledAt     string                 `protobuf:"bytes,9,opt,name=settled_at,json=settledAt,proto3" json:"settled_at,omitempty"`
-	CanceledAt    string                 `protobuf:"bytes,10,opt,name=canceled_at,json=canceledAt,proto3" json:"canceled_at,omitempty"`
-	CancelReason  string                 `protobuf:"bytes,11,opt,name=cancel_reason,json=cancelReason,proto3" json:"cancel_reason,omitempty"`
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	WagerId               string                 `protobuf:"bytes,1,opt,name=wager_id,json=wagerId,proto3" json:"wager_id,omitempty"`
+	PlayerId              string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	GameId                string                 `protobuf:"bytes,3,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Stake                 *Money                 `protobuf:"bytes,4,opt,name=stake,proto3" json:"stake,omitempty"`
+	Status                WagerStatus            `protobuf:"varint,5,opt,name=status,proto3,enum=rgs.v1.WagerStatus" json:"status,omitempty"`
+	Payout                *Money                 `protobuf:"bytes,6,opt,name=payout,proto3" json:"payout,omitempty"`
+	OutcomeRef            string                 `protobuf:"bytes,7,opt,name=outcome_ref,json=outcomeRef,proto3" json:"outcome_ref,omitempty"`
+	PlacedAt              string                 `protobuf:"bytes,8,opt,name=placed_at,json=placedAt,proto3" json:"placed_at,omitempty"`
+	SettledAt             string                 `protobuf:"bytes,9,opt,name=settled_at,json=settledAt,proto3" json:"settled_at,omitempty"`
+	CanceledAt            string                 `protobuf:"bytes,10,opt,name=canceled_at,json=canceledAt,proto3" json:"canceled_at,omitempty"`
+	CancelReason          string                 `protobuf:"bytes,11,opt,name=cancel_reason,json=cancelReason,proto3" json:"cancel_reason,omitempty"`
+	SettlementEscalatedAt string                 `protobuf:"bytes,12,opt,name=settlement_escalated_at,json=settlementEscalatedAt,proto3" json:"settlement_escalated_at,omitempty"`
+	VoidedAt              string                 `protobuf:"bytes,13,opt,name=voided_at,json=voidedAt,proto3" json:"voided_at,omitempty"`
+	VoidReason            string                 `protobuf:"bytes,14,opt,name=void_reason,json=voidReason,proto3" json:"void_reason,omitempty"`
+	RefundTransactionId   string                 `protobuf:"bytes,15,opt,name=refund_transaction_id,json=refundTransactionId,proto3" json:"refund_transaction_id,omitempty"`
+	StakeTransactionId    string                 `protobuf:"bytes,16,opt,name=stake_transaction_id,json=stakeTransactionId,proto3" json:"stake_transaction_id,omitempty"`
+	PayoutTransactionId   string                 `protobuf:"bytes,17,opt,name=payout_transaction_id,json=payoutTransactionId,proto3" json:"payout_transaction_id,omitempty"`
+	// Source device of the PlaceWager request; outcomes are streamed to it.
+	DeviceId      string `protobuf:"bytes,18,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -198,6 +209,306 @@ func (x *Wager) GetCancelReason() string {
 	return ""
 }
 
+func (x *Wager) GetSettlementEscalatedAt() string {
+	if x != nil {
+		return x.SettlementEscalatedAt
+	}
+	return ""
+}
+
+func (x *Wager) GetVoidedAt() string {
+	if x != nil {
+		return x.VoidedAt
+	}
+	return ""
+}
+
+func (x *Wager) GetVoidReason() string {
+	if x != nil {
+		return x.VoidReason
+	}
+	return ""
+}
+
+func (x *Wager) GetRefundTransactionId() string {
+	if x != nil {
+		return x.RefundTransactionId
+	}
+	return ""
+}
+
+func (x *Wager) GetStakeTransactionId() string {
+	if x != nil {
+		return x.StakeTransactionId
+	}
+	return ""
+}
+
+func (x *Wager) GetPayoutTransactionId() string {
+	if x != nil {
+		return x.PayoutTransactionId
+	}
+	return ""
+}
+
+func (x *Wager) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+// GamePerformance aggregates one game's wagers in one currency. Canceled and
+// voided wagers are excluded.
+type GamePerformance struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	GameId       string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Currency     string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	SettledCount int64                  `protobuf:"varint,3,opt,name=settled_count,json=settledCount,proto3" json:"settled_count,omitempty"`
+	SettledStake *Money                 `protobuf:"bytes,4,opt,name=settled_stake,json=settledStake,proto3" json:"settled_stake,omitempty"`
+	Payout       *Money                 `protobuf:"bytes,5,opt,name=payout,proto3" json:"payout,omitempty"`
+	// Payout over settled stake in basis points; empty with no settled stake.
+	ActualRtpBps string `protobuf:"bytes,6,opt,name=actual_rtp_bps,json=actualRtpBps,proto3" json:"actual_rtp_bps,omitempty"`
+	// The game's configured theoretical RTP; empty when not configured.
+	TheoreticalRtpBps string `protobuf:"bytes,7,opt,name=theoretical_rtp_bps,json=theoreticalRtpBps,proto3" json:"theoretical_rtp_bps,omitempty"`
+	// Settled stake at the theoretical RTP; unset when it is not configured.
+	ExpectedPayout *Money `protobuf:"bytes,8,opt,name=expected_payout,json=expectedPayout,proto3" json:"expected_payout,omitempty"`
+	// Pending wagers: stake at risk that has not settled yet.
+	OpenCount     int64  `protobuf:"varint,9,opt,name=open_count,json=openCount,proto3" json:"open_count,omitempty"`
+	OpenStake     *Money `protobuf:"bytes,10,opt,name=open_stake,json=openStake,proto3" json:"open_stake,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GamePerformance) Reset() {
+	*x = GamePerformance{}
+	mi := &file_rgs_v1_wagering_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GamePerformance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GamePerformance) ProtoMessage() {}
+
+func (x *GamePerformance) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_wagering_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GamePerformance.ProtoReflect.Descriptor instead.
+func (*GamePerformance) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_wagering_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GamePerformance) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GamePerformance) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GamePerformance) GetSettledCount() int64 {
+	if x != nil {
+		return x.SettledCount
+	}
+	return 0
+}
+
+func (x *GamePerformance) GetSettledStake() *Money {
+	if x != nil {
+		return x.SettledStake
+	}
+	return nil
+}
+
+func (x *GamePerformance) GetPayout() *Money {
+	if x != nil {
+		return x.Payout
+	}
+	return nil
+}
+
+func (x *GamePerformance) GetActualRtpBps() string {
+	if x != nil {
+		return x.ActualRtpBps
+	}
+	return ""
+}
+
+func (x *GamePerformance) GetTheoreticalRtpBps() string {
+	if x != nil {
+		return x.TheoreticalRtpBps
+	}
+	return ""
+}
+
+func (x *GamePerformance) GetExpectedPayout() *Money {
+	if x != nil {
+		return x.ExpectedPayout
+	}
+	return nil
+}
+
+func (x *GamePerformance) GetOpenCount() int64 {
+	if x != nil {
+		return x.OpenCount
+	}
+	return 0
+}
+
+func (x *GamePerformance) GetOpenStake() *Money {
+	if x != nil {
+		return x.OpenStake
+	}
+	return nil
+}
+
+type OverdueWager struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Wager          *Wager                 `protobuf:"bytes,1,opt,name=wager,proto3" json:"wager,omitempty"`
+	PendingSeconds int64                  `protobuf:"varint,2,opt,name=pending_seconds,json=pendingSeconds,proto3" json:"pending_seconds,omitempty"`
+	Escalated      bool                   `protobuf:"varint,3,opt,name=escalated,proto3" json:"escalated,omitempty"`
+	AutoVoidAt     string                 `protobuf:"bytes,4,opt,name=auto_void_at,json=autoVoidAt,proto3" json:"auto_void_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OverdueWager) Reset() {
+	*x = OverdueWager{}
+	mi := &file_rgs_v1_wagering_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OverdueWager) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OverdueWager) ProtoMessage() {}
+
+func (x *OverdueWager) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_wagering_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OverdueWager.ProtoReflect.Descriptor instead.
+func (*OverdueWager) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_wagering_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *OverdueWager) GetWager() *Wager {
+	if x != nil {
+		return x.Wager
+	}
+	return nil
+}
+
+func (x *OverdueWager) GetPendingSeconds() int64 {
+	if x != nil {
+		return x.PendingSeconds
+	}
+	return 0
+}
+
+func (x *OverdueWager) GetEscalated() bool {
+	if x != nil {
+		return x.Escalated
+	}
+	return false
+}
+
+func (x *OverdueWager) GetAutoVoidAt() string {
+	if x != nil {
+		return x.AutoVoidAt
+	}
+	return ""
+}
+
+type WagerResultUpdate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Wager *Wager                 `protobuf:"bytes,1,opt,name=wager,proto3" json:"wager,omitempty"`
+	// Player's available cashless balance after the outcome; unset without a ledger.
+	AvailableBalance *Money `protobuf:"bytes,2,opt,name=available_balance,json=availableBalance,proto3" json:"available_balance,omitempty"`
+	PublishedAt      string `protobuf:"bytes,3,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *WagerResultUpdate) Reset() {
+	*x = WagerResultUpdate{}
+	mi := &file_rgs_v1_wagering_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WagerResultUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WagerResultUpdate) ProtoMessage() {}
+
+func (x *WagerResultUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_wagering_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WagerResultUpdate.ProtoReflect.Descriptor instead.
+func (*WagerResultUpdate) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_wagering_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WagerResultUpdate) GetWager() *Wager {
+	if x != nil {
+		return x.Wager
+	}
+	return nil
+}
+
+func (x *WagerResultUpdate) GetAvailableBalance() *Money {
+	if x != nil {
+		return x.AvailableBalance
+	}
+	return nil
+}
+
+func (x *WagerResultUpdate) GetPublishedAt() string {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return ""
+}
+
 type PlaceWagerRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
@@ -210,7 +521,7 @@ type PlaceWagerRequest struct {
 
 func (x *PlaceWagerRequest) Reset() {
 	*x = PlaceWagerRequest{}
-	mi := &file_rgs_v1_wagering_proto_msgTypes[1]
+	mi := &file_rgs_v1_wagering_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -222,7 +533,7 @@ func (x *PlaceWagerRequest) String() string {
 func (*PlaceWagerRequest) ProtoMessage() {}
 
 func (x *PlaceWagerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_wagering_proto_msgTypes[1]
+	mi := &file_rgs_v1_wagering_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -235,7 +546,7 @@ func (x *PlaceWagerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlaceWagerRequest.ProtoReflect.Descriptor instead.
 func (*PlaceWagerRequest) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_wagering_proto_rawDescGZIP(), []int{1}
+	return file_rgs_v1_wagering_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *PlaceWagerRequest) GetMeta() *RequestMeta {
@@ -276,7 +587,7 @@ type PlaceWagerResponse struct {
 
 func (x *PlaceWagerResponse) Reset() {
 	*x = PlaceWagerResponse{}
-	mi := &file_rgs_v1_wagering_proto_msgTypes[2]
+	mi := &file_rgs_v1_wagering_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -288,7 +599,7 @@ func (x *PlaceWagerResponse) String() string {
 func (*PlaceWagerResponse) ProtoMessage() {}
 
 func (x *PlaceWagerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_wagering_proto_msgTypes[2]
+	mi := &file_rgs_v1_wagering_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -301,7 +612,7 @@ func (x *PlaceWagerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlaceWagerResponse.ProtoReflect.Descriptor instead.
 func (*PlaceWagerResponse) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_wagering_proto_rawDescGZIP(), []int{2}
+	return file_rgs_v1_wagering_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *PlaceWagerResponse) GetMeta() *ResponseMeta {
@@ -330,7 +641,7 @@ type SettleWagerRequest struct {
 
 func (x *SettleWagerRequest) Reset() {
 	*x = SettleWagerRequest{}
-	mi := &file_rgs_v1_wagering_proto_msgTypes[3]
+	mi := &file_rgs_v1_wagering_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -342,7 +653,7 @@ func (x *SettleWagerRequest) String() string {
 func (*SettleWagerRequest) ProtoMessage() {}
 
 func (x *SettleWagerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_wagering_proto_msgTypes[3]
+	mi := &file_rgs_v1_wagering_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -355,7 +666,7 @@ func (x *SettleWagerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SettleWagerRequest.ProtoReflect.Descriptor instead.
 func (*SettleWagerRequest) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_wagering_proto_rawDescGZIP(), []int{3}
+	return file_rgs_v1_wagering_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SettleWagerRequest) GetMeta() *RequestMeta {
@@ -387,16 +698,21 @@ func (x *SettleWagerRequest) GetOutcomeRef() string {
 }
 
 type SettleWagerResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Wager         *Wager                 `protobuf:"bytes,2,opt,name=wager,proto3" json:"wager,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Meta  *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Wager *Wager                 `protobuf:"bytes,2,opt,name=wager,proto3" json:"wager,omitempty"`
+	// Share of the stake added to the game's progressive pool; unset when the
+	// game has no progressive contribution configured.
+	JackpotContribution *Money `protobuf:"bytes,3,opt,name=jackpot_contribution,json=jackpotContribution,proto3" json:"jackpot_contribution,omitempty"`
+	// The game's progressive pool in the stake currency after this settlement.
+	JackpotPool   *Money `protobuf:"bytes,4,opt,name=jackpot_pool,json=jackpotPool,proto3" json:"jackpot_pool,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SettleWagerResponse) Reset() {
 	*x = SettleWagerResponse{}
-	mi := &file_rgs_v1_wagering_proto_msgTypes[4]
+	mi := &file_rgs_v1_wagering_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -408,7 +724,7 @@ func (x *SettleWagerResponse) String() string {
 func (*SettleWagerResponse) ProtoMessage() {}
 
 func (x *SettleWagerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_wagering_proto_msgTypes[4]
+	mi := &file_rgs_v1_wagering_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -421,7 +737,7 @@ func (x *SettleWagerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SettleWagerResponse.ProtoReflect.Descriptor instead.
 func (*SettleWagerResponse) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_wagering_proto_rawDescGZIP(), []int{4}
+	return file_rgs_v1_wagering_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SettleWagerResponse) GetMeta() *ResponseMeta {
@@ -438,6 +754,20 @@ func (x *SettleWagerResponse) GetWager() *Wager {
 	return nil
 }
 
+func (x *SettleWagerResponse) GetJackpotContribution() *Money {
+	if x != nil {
+		return x.JackpotContribution
+	}
+	return nil
+}
+
+func (x *SettleWagerResponse) GetJackpotPool() *Money {
+	if x != nil {
+		return x.JackpotPool
+	}
+	return nil
+}
+
 type CancelWagerRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
@@ -449,7 +779,7 @@ type CancelWagerRequest struct {
 
 func (x *CancelWagerRequest) Reset() {
 	*x = CancelWagerRequest{}
-	mi := &file_rgs_v1_wagering_proto_msgTypes[5]
+	mi := &file_rgs_v1_wagering_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -461,7 +791,7 @@ func (x *CancelWagerRequest) String() string {
 func (*CancelWagerRequest) ProtoMessage() {}
 
 func (x *CancelWagerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_wagering_proto_msgTypes[5]
+	mi := &file_rgs_v1_wagering_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -474,7 +804,7 @@ func (x *CancelWagerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelWagerRequest.ProtoReflect.Descriptor instead.
 func (*CancelWagerRequest) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_wagering_proto_rawDescGZIP(), []int{5}
+	return file_rgs_v1_wagering_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CancelWagerRequest) GetMeta() *RequestMeta {
@@ -508,7 +838,7 @@ type CancelWagerResponse struct {
 
 func (x *CancelWagerResponse) Reset() {
 	*x = CancelWagerResponse{}
-	mi := &file_rgs_v1_wagering_proto_msgTypes[6]
+	mi := &file_rgs_v1_wagering_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -520,7 +850,7 @@ func (x *CancelWagerResponse) String() string {
 func (*CancelWagerResponse) ProtoMessage() {}
 
 func (x *CancelWagerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rgs_v1_wagering_proto_msgTypes[6]
+	mi := &file_rgs_v1_wagering_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -533,7 +863,7 @@ func (x *CancelWagerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelWagerResponse.ProtoReflect.Descriptor instead.
 func (*CancelWagerResponse) Descriptor() ([]byte, []int) {
-	return file_rgs_v1_wagering_proto_rawDescGZIP(), []int{6}
+	return file_rgs_v1_wagering_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *CancelWagerResponse) GetMeta() *ResponseMeta {
@@ -550,16 +880,650 @@ func (x *CancelWagerResponse) GetWager() *Wager {
 	return nil
 }
 
-var File_rgs_v1_wagering_proto protoreflect.FileDescriptor
+type VoidWagerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	WagerId       string                 `protobuf:"bytes,2,opt,name=wager_id,json=wagerId,proto3" json:"wager_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_rgs_v1_wagering_proto_rawDesc = "" +
-	"\n" +
-	"\x15rgs/v1/wagering.proto\x12\x06rgs.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x13rgs/v1/common.proto\x1a\x13rgs/v1/ledger.proto\"\xf4\x02\n" +
-	"\x05Wager\x12\x19\n" +
-	"\bwager_id\x18\x01 \x01(\tR\awagerId\x12\x1b\n" +
-	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\x12\x17\n" +
-	"\agame_id\x18\x03 \x01(\tR\x06gameId\x12#\n" +
-	"\x05stake\x18\x04 \x01(\v2\r.rgs.v1.MoneyR\x05stake\x12+\n" +
+func (x *VoidWagerRequest) Reset() {
+	*x = VoidWagerRequest{}
+	mi := &file_rgs_v1_wagering_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoidWagerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidWagerRequest) ProtoMessage() {}
+
+func (x *VoidWagerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_wagering_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidWagerRequest.ProtoReflect.Descriptor instead.
+func (*VoidWagerRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_wagering_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *VoidWagerRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *VoidWagerRequest) GetWagerId() string {
+	if x != nil {
+		return x.WagerId
+	}
+	return ""
+}
+
+func (x *VoidWagerRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type VoidWagerResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Meta              *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Wager             *Wager                 `protobuf:"bytes,2,opt,name=wager,proto3" json:"wager,omitempty"`
+	RefundTransaction *LedgerTransaction     `protobuf:"bytes,3,opt,name=refund_transaction,json=refundTransaction,proto3" json:"refund_transaction,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *VoidWagerResponse) Reset() {
+	*x = VoidWagerResponse{}
+	mi := &file_rgs_v1_wagering_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoidWagerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidWagerResponse) ProtoMessage() {}
+
+func (x *VoidWagerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_wagering_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidWagerResponse.ProtoReflect.Descriptor instead.
+func (*VoidWagerResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_wagering_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *VoidWagerResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *VoidWagerResponse) GetWager() *Wager {
+	if x != nil {
+		return x.Wager
+	}
+	return nil
+}
+
+func (x *VoidWagerResponse) GetRefundTransaction() *LedgerTransaction {
+	if x != nil {
+		return x.RefundTransaction
+	}
+	return nil
+}
+
+type ListWagersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	GameId        string                 `protobuf:"bytes,3,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Status        WagerStatus            `protobuf:"varint,4,opt,name=status,proto3,enum=rgs.v1.WagerStatus" json:"status,omitempty"`
+	FromTime      string                 `protobuf:"bytes,5,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`
+	ToTime        string                 `protobuf:"bytes,6,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
+	PageSize      int32                  `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,8,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWagersRequest) Reset() {
+	*x = ListWagersRequest{}
+	mi := &file_rgs_v1_wagering_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWagersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWagersRequest) ProtoMessage() {}
+
+func (x *ListWagersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_wagering_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWagersRequest.ProtoReflect.Descriptor instead.
+func (*ListWagersRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_wagering_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListWagersRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListWagersRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *ListWagersRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ListWagersRequest) GetStatus() WagerStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WagerStatus_WAGER_STATUS_UNSPECIFIED
+}
+
+func (x *ListWagersRequest) GetFromTime() string {
+	if x != nil {
+		return x.FromTime
+	}
+	return ""
+}
+
+func (x *ListWagersRequest) GetToTime() string {
+	if x != nil {
+		return x.ToTime
+	}
+	return ""
+}
+
+func (x *ListWagersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListWagersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListWagersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Wagers        []*Wager               `protobuf:"bytes,2,rep,name=wagers,proto3" json:"wagers,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWagersResponse) Reset() {
+	*x = ListWagersResponse{}
+	mi := &file_rgs_v1_wagering_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWagersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWagersResponse) ProtoMessage() {}
+
+func (x *ListWagersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_wagering_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWagersResponse.ProtoReflect.Descriptor instead.
+func (*ListWagersResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_wagering_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListWagersResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListWagersResponse) GetWagers() []*Wager {
+	if x != nil {
+		return x.Wagers
+	}
+	return nil
+}
+
+func (x *ListWagersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListOverdueWagersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOverdueWagersRequest) Reset() {
+	*x = ListOverdueWagersRequest{}
+	mi := &file_rgs_v1_wagering_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOverdueWagersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOverdueWagersRequest) ProtoMessage() {}
+
+func (x *ListOverdueWagersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_wagering_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOverdueWagersRequest.ProtoReflect.Descriptor instead.
+func (*ListOverdueWagersRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_wagering_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListOverdueWagersRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListOverdueWagersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListOverdueWagersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListOverdueWagersResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Meta                 *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Wagers               []*OverdueWager        `protobuf:"bytes,2,rep,name=wagers,proto3" json:"wagers,omitempty"`
+	NextPageToken        string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	SettlementSlaSeconds int64                  `protobuf:"varint,4,opt,name=settlement_sla_seconds,json=settlementSlaSeconds,proto3" json:"settlement_sla_seconds,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ListOverdueWagersResponse) Reset() {
+	*x = ListOverdueWagersResponse{}
+	mi := &file_rgs_v1_wagering_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOverdueWagersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOverdueWagersResponse) ProtoMessage() {}
+
+func (x *ListOverdueWagersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_wagering_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOverdueWagersResponse.ProtoReflect.Descriptor instead.
+func (*ListOverdueWagersResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_wagering_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListOverdueWagersResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListOverdueWagersResponse) GetWagers() []*OverdueWager {
+	if x != nil {
+		return x.Wagers
+	}
+	return nil
+}
+
+func (x *ListOverdueWagersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListOverdueWagersResponse) GetSettlementSlaSeconds() int64 {
+	if x != nil {
+		return x.SettlementSlaSeconds
+	}
+	return 0
+}
+
+type GetGamePerformanceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Meta  *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// Empty for all games.
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// Optional RFC3339 bounds on placed_at.
+	FromTime      string `protobuf:"bytes,3,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`
+	ToTime        string `protobuf:"bytes,4,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGamePerformanceRequest) Reset() {
+	*x = GetGamePerformanceRequest{}
+	mi := &file_rgs_v1_wagering_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGamePerformanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGamePerformanceRequest) ProtoMessage() {}
+
+func (x *GetGamePerformanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_wagering_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGamePerformanceRequest.ProtoReflect.Descriptor instead.
+func (*GetGamePerformanceRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_wagering_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetGamePerformanceRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GetGamePerformanceRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GetGamePerformanceRequest) GetFromTime() string {
+	if x != nil {
+		return x.FromTime
+	}
+	return ""
+}
+
+func (x *GetGamePerformanceRequest) GetToTime() string {
+	if x != nil {
+		return x.ToTime
+	}
+	return ""
+}
+
+type GetGamePerformanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Games         []*GamePerformance     `protobuf:"bytes,2,rep,name=games,proto3" json:"games,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGamePerformanceResponse) Reset() {
+	*x = GetGamePerformanceResponse{}
+	mi := &file_rgs_v1_wagering_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGamePerformanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGamePerformanceResponse) ProtoMessage() {}
+
+func (x *GetGamePerformanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_wagering_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGamePerformanceResponse.ProtoReflect.Descriptor instead.
+func (*GetGamePerformanceResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_wagering_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetGamePerformanceResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GetGamePerformanceResponse) GetGames() []*GamePerformance {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+type StreamWagerResultsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	DeviceId      string                 `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamWagerResultsRequest) Reset() {
+	*x = StreamWagerResultsRequest{}
+	mi := &file_rgs_v1_wagering_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamWagerResultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamWagerResultsRequest) ProtoMessage() {}
+
+func (x *StreamWagerResultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_wagering_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamWagerResultsRequest.ProtoReflect.Descriptor instead.
+func (*StreamWagerResultsRequest) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_wagering_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *StreamWagerResultsRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *StreamWagerResultsRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+type StreamWagerResultsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ResponseMeta          `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Update        *WagerResultUpdate     `protobuf:"bytes,2,opt,name=update,proto3" json:"update,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamWagerResultsResponse) Reset() {
+	*x = StreamWagerResultsResponse{}
+	mi := &file_rgs_v1_wagering_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamWagerResultsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamWagerResultsResponse) ProtoMessage() {}
+
+func (x *StreamWagerResultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgs_v1_wagering_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamWagerResultsResponse.ProtoReflect.Descriptor instead.
+func (*StreamWagerResultsResponse) Descriptor() ([]byte, []int) {
+	return file_rgs_v1_wagering_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *StreamWagerResultsResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *StreamWagerResultsResponse) GetUpdate() *WagerResultUpdate {
+	if x != nil {
+		return x.Update
+	}
+	return nil
+}
+
+var File_rgs_v1_wagering_proto protoreflect.FileDescriptor
+
+const file_rgs_v1_wagering_proto_rawDesc = "" +
+	"\n" +
+	"\x15rgs/v1/wagering.proto\x12\x06rgs.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x13rgs/v1/common.proto\x1a\x13rgs/v1/ledger.proto\"\xa1\x05\n" +
+	"\x05Wager\x12\x19\n" +
+	"\bwager_id\x18\x01 \x01(\tR\awagerId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\x12\x17\n" +
+	"\agame_id\x18\x03 \x01(\tR\x06gameId\x12#\n" +
+	"\x05stake\x18\x04 \x01(\v2\r.rgs.v1.MoneyR\x05stake\x12+\n" +
 	"\x06status\x18\x05 \x01(\x0e2\x13.rgs.v1.WagerStatusR\x06status\x12%\n" +
 	"\x06payout\x18\x06 \x01(\v2\r.rgs.v1.MoneyR\x06payout\x12\x1f\n" +
 	"\voutcome_ref\x18\a \x01(\tR\n" +
@@ -570,7 +1534,39 @@ const file_rgs_v1_wagering_proto_rawDesc = "" +
 	"\vcanceled_at\x18\n" +
 	" \x01(\tR\n" +
 	"canceledAt\x12#\n" +
-	"\rcancel_reason\x18\v \x01(\tR\fcancelReason\"\x97\x01\n" +
+	"\rcancel_reason\x18\v \x01(\tR\fcancelReason\x126\n" +
+	"\x17settlement_escalated_at\x18\f \x01(\tR\x15settlementEscalatedAt\x12\x1b\n" +
+	"\tvoided_at\x18\r \x01(\tR\bvoidedAt\x12\x1f\n" +
+	"\vvoid_reason\x18\x0e \x01(\tR\n" +
+	"voidReason\x122\n" +
+	"\x15refund_transaction_id\x18\x0f \x01(\tR\x13refundTransactionId\x120\n" +
+	"\x14stake_transaction_id\x18\x10 \x01(\tR\x12stakeTransactionId\x122\n" +
+	"\x15payout_transaction_id\x18\x11 \x01(\tR\x13payoutTransactionId\x12\x1b\n" +
+	"\tdevice_id\x18\x12 \x01(\tR\bdeviceId\"\xa1\x03\n" +
+	"\x0fGamePerformance\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12#\n" +
+	"\rsettled_count\x18\x03 \x01(\x03R\fsettledCount\x122\n" +
+	"\rsettled_stake\x18\x04 \x01(\v2\r.rgs.v1.MoneyR\fsettledStake\x12%\n" +
+	"\x06payout\x18\x05 \x01(\v2\r.rgs.v1.MoneyR\x06payout\x12$\n" +
+	"\x0eactual_rtp_bps\x18\x06 \x01(\tR\factualRtpBps\x12.\n" +
+	"\x13theoretical_rtp_bps\x18\a \x01(\tR\x11theoreticalRtpBps\x126\n" +
+	"\x0fexpected_payout\x18\b \x01(\v2\r.rgs.v1.MoneyR\x0eexpectedPayout\x12\x1d\n" +
+	"\n" +
+	"open_count\x18\t \x01(\x03R\topenCount\x12,\n" +
+	"\n" +
+	"open_stake\x18\n" +
+	" \x01(\v2\r.rgs.v1.MoneyR\topenStake\"\x9c\x01\n" +
+	"\fOverdueWager\x12#\n" +
+	"\x05wager\x18\x01 \x01(\v2\r.rgs.v1.WagerR\x05wager\x12'\n" +
+	"\x0fpending_seconds\x18\x02 \x01(\x03R\x0ependingSeconds\x12\x1c\n" +
+	"\tescalated\x18\x03 \x01(\bR\tescalated\x12 \n" +
+	"\fauto_void_at\x18\x04 \x01(\tR\n" +
+	"autoVoidAt\"\x97\x01\n" +
+	"\x11WagerResultUpdate\x12#\n" +
+	"\x05wager\x18\x01 \x01(\v2\r.rgs.v1.WagerR\x05wager\x12:\n" +
+	"\x11available_balance\x18\x02 \x01(\v2\r.rgs.v1.MoneyR\x10availableBalance\x12!\n" +
+	"\fpublished_at\x18\x03 \x01(\tR\vpublishedAt\"\x97\x01\n" +
 	"\x11PlaceWagerRequest\x12'\n" +
 	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x1b\n" +
 	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\x12\x17\n" +
@@ -584,27 +1580,82 @@ const file_rgs_v1_wagering_proto_rawDesc = "" +
 	"\bwager_id\x18\x02 \x01(\tR\awagerId\x12%\n" +
 	"\x06payout\x18\x03 \x01(\v2\r.rgs.v1.MoneyR\x06payout\x12\x1f\n" +
 	"\voutcome_ref\x18\x04 \x01(\tR\n" +
-	"outcomeRef\"d\n" +
+	"outcomeRef\"\xd8\x01\n" +
 	"\x13SettleWagerResponse\x12(\n" +
 	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12#\n" +
-	"\x05wager\x18\x02 \x01(\v2\r.rgs.v1.WagerR\x05wager\"p\n" +
+	"\x05wager\x18\x02 \x01(\v2\r.rgs.v1.WagerR\x05wager\x12@\n" +
+	"\x14jackpot_contribution\x18\x03 \x01(\v2\r.rgs.v1.MoneyR\x13jackpotContribution\x120\n" +
+	"\fjackpot_pool\x18\x04 \x01(\v2\r.rgs.v1.MoneyR\vjackpotPool\"p\n" +
 	"\x12CancelWagerRequest\x12'\n" +
 	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x19\n" +
 	"\bwager_id\x18\x02 \x01(\tR\awagerId\x12\x16\n" +
 	"\x06reason\x18\x03 \x01(\tR\x06reason\"d\n" +
 	"\x13CancelWagerResponse\x12(\n" +
 	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12#\n" +
-	"\x05wager\x18\x02 \x01(\v2\r.rgs.v1.WagerR\x05wager*z\n" +
+	"\x05wager\x18\x02 \x01(\v2\r.rgs.v1.WagerR\x05wager\"n\n" +
+	"\x10VoidWagerRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x19\n" +
+	"\bwager_id\x18\x02 \x01(\tR\awagerId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xac\x01\n" +
+	"\x11VoidWagerResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12#\n" +
+	"\x05wager\x18\x02 \x01(\v2\r.rgs.v1.WagerR\x05wager\x12H\n" +
+	"\x12refund_transaction\x18\x03 \x01(\v2\x19.rgs.v1.LedgerTransactionR\x11refundTransaction\"\x91\x02\n" +
+	"\x11ListWagersRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\x12\x17\n" +
+	"\agame_id\x18\x03 \x01(\tR\x06gameId\x12+\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x13.rgs.v1.WagerStatusR\x06status\x12\x1b\n" +
+	"\tfrom_time\x18\x05 \x01(\tR\bfromTime\x12\x17\n" +
+	"\ato_time\x18\x06 \x01(\tR\x06toTime\x12\x1b\n" +
+	"\tpage_size\x18\a \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\b \x01(\tR\tpageToken\"\x8d\x01\n" +
+	"\x12ListWagersResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12%\n" +
+	"\x06wagers\x18\x02 \x03(\v2\r.rgs.v1.WagerR\x06wagers\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\x7f\n" +
+	"\x18ListOverdueWagersRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\xd1\x01\n" +
+	"\x19ListOverdueWagersResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12,\n" +
+	"\x06wagers\x18\x02 \x03(\v2\x14.rgs.v1.OverdueWagerR\x06wagers\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\x124\n" +
+	"\x16settlement_sla_seconds\x18\x04 \x01(\x03R\x14settlementSlaSeconds\"\x93\x01\n" +
+	"\x19GetGamePerformanceRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x17\n" +
+	"\agame_id\x18\x02 \x01(\tR\x06gameId\x12\x1b\n" +
+	"\tfrom_time\x18\x03 \x01(\tR\bfromTime\x12\x17\n" +
+	"\ato_time\x18\x04 \x01(\tR\x06toTime\"u\n" +
+	"\x1aGetGamePerformanceResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x12-\n" +
+	"\x05games\x18\x02 \x03(\v2\x17.rgs.v1.GamePerformanceR\x05games\"a\n" +
+	"\x19StreamWagerResultsRequest\x12'\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.rgs.v1.RequestMetaR\x04meta\x12\x1b\n" +
+	"\tdevice_id\x18\x02 \x01(\tR\bdeviceId\"y\n" +
+	"\x1aStreamWagerResultsResponse\x12(\n" +
+	"\x04meta\x18\x01 \x01(\v2\x14.rgs.v1.ResponseMetaR\x04meta\x121\n" +
+	"\x06update\x18\x02 \x01(\v2\x19.rgs.v1.WagerResultUpdateR\x06update*\x93\x01\n" +
 	"\vWagerStatus\x12\x1c\n" +
 	"\x18WAGER_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14WAGER_STATUS_PENDING\x10\x01\x12\x18\n" +
 	"\x14WAGER_STATUS_SETTLED\x10\x02\x12\x19\n" +
-	"\x15WAGER_STATUS_CANCELED\x10\x032\xea\x02\n" +
+	"\x15WAGER_STATUS_CANCELED\x10\x03\x12\x17\n" +
+	"\x13WAGER_STATUS_VOIDED\x10\x042\xa1\a\n" +
 	"\x0fWageringService\x12c\n" +
 	"\n" +
 	"PlaceWager\x12\x19.rgs.v1.PlaceWagerRequest\x1a\x1a.rgs.v1.PlaceWagerResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/wagering/wagers\x12x\n" +
 	"\vSettleWager\x12\x1a.rgs.v1.SettleWagerRequest\x1a\x1b.rgs.v1.SettleWagerResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/v1/wagering/wagers/{wager_id}:settle\x12x\n" +
-	"\vCancelWager\x12\x1a.rgs.v1.CancelWagerRequest\x1a\x1b.rgs.v1.CancelWagerResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/v1/wagering/wagers/{wager_id}:cancelB\x8f\x01\n" +
+	"\vCancelWager\x12\x1a.rgs.v1.CancelWagerRequest\x1a\x1b.rgs.v1.CancelWagerResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/v1/wagering/wagers/{wager_id}:cancel\x12p\n" +
+	"\tVoidWager\x12\x18.rgs.v1.VoidWagerRequest\x1a\x19.rgs.v1.VoidWagerResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/wagering/wagers/{wager_id}:void\x12`\n" +
+	"\n" +
+	"ListWagers\x12\x19.rgs.v1.ListWagersRequest\x1a\x1a.rgs.v1.ListWagersResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/wagering/wagers\x12}\n" +
+	"\x11ListOverdueWagers\x12 .rgs.v1.ListOverdueWagersRequest\x1a!.rgs.v1.ListOverdueWagersResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/wagering/overdue-wagers\x12\x82\x01\n" +
+	"\x12GetGamePerformance\x12!.rgs.v1.GetGamePerformanceRequest\x1a\".rgs.v1.GetGamePerformanceResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/wagering/game-performance\x12]\n" +
+	"\x12StreamWagerResults\x12!.rgs.v1.StreamWagerResultsRequest\x1a\".rgs.v1.StreamWagerResultsResponse0\x01B\x8f\x01\n" +
 	"\n" +
 	"com.rgs.v1B\rWageringProtoP\x01Z9github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1;rgsv1\xa2\x02\x03RXX\xaa\x02\x06Rgs.V1\xca\x02\x06Rgs\\V1\xe2\x02\x12Rgs\\V1\\GPBMetadata\xea\x02\aRgs::V1b\x06proto3"
 
@@ -621,46 +1672,96 @@ func file_rgs_v1_wagering_proto_rawDescGZIP() []byte {
 }
 
 var file_rgs_v1_wagering_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_rgs_v1_wagering_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_rgs_v1_wagering_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_rgs_v1_wagering_proto_goTypes = []any{
-	(WagerStatus)(0),            // 0: rgs.v1.WagerStatus
-	(*Wager)(nil),               // 1: rgs.v1.Wager
-	(*PlaceWagerRequest)(nil),   // 2: rgs.v1.PlaceWagerRequest
-	(*PlaceWagerResponse)(nil),  // 3: rgs.v1.PlaceWagerResponse
-	(*SettleWagerRequest)(nil),  // 4: rgs.v1.SettleWagerRequest
-	(*SettleWagerResponse)(nil), // 5: rgs.v1.SettleWagerResponse
-	(*CancelWagerRequest)(nil),  // 6: rgs.v1.CancelWagerRequest
-	(*CancelWagerResponse)(nil), // 7: rgs.v1.CancelWagerResponse
-	(*Money)(nil),               // 8: rgs.v1.Money
-	(*RequestMeta)(nil),         // 9: rgs.v1.RequestMeta
-	(*ResponseMeta)(nil),        // 10: rgs.v1.ResponseMeta
+	(WagerStatus)(0),                   // 0: rgs.v1.WagerStatus
+	(*Wager)(nil),                      // 1: rgs.v1.Wager
+	(*GamePerformance)(nil),            // 2: rgs.v1.GamePerformance
+	(*OverdueWager)(nil),               // 3: rgs.v1.OverdueWager
+	(*WagerResultUpdate)(nil),          // 4: rgs.v1.WagerResultUpdate
+	(*PlaceWagerRequest)(nil),          // 5: rgs.v1.PlaceWagerRequest
+	(*PlaceWagerResponse)(nil),         // 6: rgs.v1.PlaceWagerResponse
+	(*SettleWagerRequest)(nil),         // 7: rgs.v1.SettleWagerRequest
+	(*SettleWagerResponse)(nil),        // 8: rgs.v1.SettleWagerResponse
+	(*CancelWagerRequest)(nil),         // 9: rgs.v1.CancelWagerRequest
+	(*CancelWagerResponse)(nil),        // 10: rgs.v1.CancelWagerResponse
+	(*VoidWagerRequest)(nil),           // 11: rgs.v1.VoidWagerRequest
+	(*VoidWagerResponse)(nil),          // 12: rgs.v1.VoidWagerResponse
+	(*ListWagersRequest)(nil),          // 13: rgs.v1.ListWagersRequest
+	(*ListWagersResponse)(nil),         // 14: rgs.v1.ListWagersResponse
+	(*ListOverdueWagersRequest)(nil),   // 15: rgs.v1.ListOverdueWagersRequest
+	(*ListOverdueWagersResponse)(nil),  // 16: rgs.v1.ListOverdueWagersResponse
+	(*GetGamePerformanceRequest)(nil),  // 17: rgs.v1.GetGamePerformanceRequest
+	(*GetGamePerformanceResponse)(nil), // 18: rgs.v1.GetGamePerformanceResponse
+	(*StreamWagerResultsRequest)(nil),  // 19: rgs.v1.StreamWagerResultsRequest
+	(*StreamWagerResultsResponse)(nil), // 20: rgs.v1.StreamWagerResultsResponse
+	(*Money)(nil),                      // 21: rgs.v1.Money
+	(*RequestMeta)(nil),                // 22: rgs.v1.RequestMeta
+	(*ResponseMeta)(nil),               // 23: rgs.v1.ResponseMeta
+	(*LedgerTransaction)(nil),          // 24: rgs.v1.LedgerTransaction
 }
 var file_rgs_v1_wagering_proto_depIdxs = []int32{
-	8,  // 0: rgs.v1.Wager.stake:type_name -> rgs.v1.Money
+	21, // 0: rgs.v1.Wager.stake:type_name -> rgs.v1.Money
 	0,  // 1: rgs.v1.Wager.status:type_name -> rgs.v1.WagerStatus
-	8,  // 2: rgs.v1.Wager.payout:type_name -> rgs.v1.Money
-	9,  // 3: rgs.v1.PlaceWagerRequest.meta:type_name -> rgs.v1.RequestMeta
-	8,  // 4: rgs.v1.PlaceWagerRequest.stake:type_name -> rgs.v1.Money
-	10, // 5: rgs.v1.PlaceWagerResponse.meta:type_name -> rgs.v1.ResponseMeta
-	1,  // 6: rgs.v1.PlaceWagerResponse.wager:type_name -> rgs.v1.Wager
-	9,  // 7: rgs.v1.SettleWagerRequest.meta:type_name -> rgs.v1.RequestMeta
-	8,  // 8: rgs.v1.SettleWagerRequest.payout:type_name -> rgs.v1.Money
-	10, // 9: rgs.v1.SettleWagerResponse.meta:type_name -> rgs.v1.ResponseMeta
-	1,  // 10: rgs.v1.SettleWagerResponse.wager:type_name -> rgs.v1.Wager
-	9,  // 11: rgs.v1.CancelWagerRequest.meta:type_name -> rgs.v1.RequestMeta
-	10, // 12: rgs.v1.CancelWagerResponse.meta:type_name -> rgs.v1.ResponseMeta
-	1,  // 13: rgs.v1.CancelWagerResponse.wager:type_name -> rgs.v1.Wager
-	2,  // 14: rgs.v1.WageringService.PlaceWager:input_type -> rgs.v1.PlaceWagerRequest
-	4,  // 15: rgs.v1.WageringService.SettleWager:input_type -> rgs.v1.SettleWagerRequest
-	6,  // 16: rgs.v1.WageringService.CancelWager:input_type -> rgs.v1.CancelWagerRequest
-	3,  // 17: rgs.v1.WageringService.PlaceWager:output_type -> rgs.v1.PlaceWagerResponse
-	5,  // 18: rgs.v1.WageringService.SettleWager:output_type -> rgs.v1.SettleWagerResponse
-	7,  // 19: rgs.v1.WageringService.CancelWager:output_type -> rgs.v1.CancelWagerResponse
-	17, // [17:20] is the sub-list for method output_type
-	14, // [14:17] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	21, // 2: rgs.v1.Wager.payout:type_name -> rgs.v1.Money
+	21, // 3: rgs.v1.GamePerformance.settled_stake:type_name -> rgs.v1.Money
+	21, // 4: rgs.v1.GamePerformance.payout:type_name -> rgs.v1.Money
+	21, // 5: rgs.v1.GamePerformance.expected_payout:type_name -> rgs.v1.Money
+	21, // 6: rgs.v1.GamePerformance.open_stake:type_name -> rgs.v1.Money
+	1,  // 7: rgs.v1.OverdueWager.wager:type_name -> rgs.v1.Wager
+	1,  // 8: rgs.v1.WagerResultUpdate.wager:type_name -> rgs.v1.Wager
+	21, // 9: rgs.v1.WagerResultUpdate.available_balance:type_name -> rgs.v1.Money
+	22, // 10: rgs.v1.PlaceWagerRequest.meta:type_name -> rgs.v1.RequestMeta
+	21, // 11: rgs.v1.PlaceWagerRequest.stake:type_name -> rgs.v1.Money
+	23, // 12: rgs.v1.PlaceWagerResponse.meta:type_name -> rgs.v1.ResponseMeta
+	1,  // 13: rgs.v1.PlaceWagerResponse.wager:type_name -> rgs.v1.Wager
+	22, // 14: rgs.v1.SettleWagerRequest.meta:type_name -> rgs.v1.RequestMeta
+	21, // 15: rgs.v1.SettleWagerRequest.payout:type_name -> rgs.v1.Money
+	23, // 16: rgs.v1.SettleWagerResponse.meta:type_name -> rgs.v1.ResponseMeta
+	1,  // 17: rgs.v1.SettleWagerResponse.wager:type_name -> rgs.v1.Wager
+	21, // 18: rgs.v1.SettleWagerResponse.jackpot_contribution:type_name -> rgs.v1.Money
+	21, // 19: rgs.v1.SettleWagerResponse.jackpot_pool:type_name -> rgs.v1.Money
+	22, // 20: rgs.v1.CancelWagerRequest.meta:type_name -> rgs.v1.RequestMeta
+	23, // 21: rgs.v1.CancelWagerResponse.meta:type_name -> rgs.v1.ResponseMeta
+	1,  // 22: rgs.v1.CancelWagerResponse.wager:type_name -> rgs.v1.Wager
+	22, // 23: rgs.v1.VoidWagerRequest.meta:type_name -> rgs.v1.RequestMeta
+	23, // 24: rgs.v1.VoidWagerResponse.meta:type_name -> rgs.v1.ResponseMeta
+	1,  // 25: rgs.v1.VoidWagerResponse.wager:type_name -> rgs.v1.Wager
+	24, // 26: rgs.v1.VoidWagerResponse.refund_transaction:type_name -> rgs.v1.LedgerTransaction
+	22, // 27: rgs.v1.ListWagersRequest.meta:type_name -> rgs.v1.RequestMeta
+	0,  // 28: rgs.v1.ListWagersRequest.status:type_name -> rgs.v1.WagerStatus
+	23, // 29: rgs.v1.ListWagersResponse.meta:type_name -> rgs.v1.ResponseMeta
+	1,  // 30: rgs.v1.ListWagersResponse.wagers:type_name -> rgs.v1.Wager
+	22, // 31: rgs.v1.ListOverdueWagersRequest.meta:type_name -> rgs.v1.RequestMeta
+	23, // 32: rgs.v1.ListOverdueWagersResponse.meta:type_name -> rgs.v1.ResponseMeta
+	3,  // 33: rgs.v1.ListOverdueWagersResponse.wagers:type_name -> rgs.v1.OverdueWager
+	22, // 34: rgs.v1.GetGamePerformanceRequest.meta:type_name -> rgs.v1.RequestMeta
+	23, // 35: rgs.v1.GetGamePerformanceResponse.meta:type_name -> rgs.v1.ResponseMeta
+	2,  // 36: rgs.v1.GetGamePerformanceResponse.games:type_name -> rgs.v1.GamePerformance
+	22, // 37: rgs.v1.StreamWagerResultsRequest.meta:type_name -> rgs.v1.RequestMeta
+	23, // 38: rgs.v1.StreamWagerResultsResponse.meta:type_name -> rgs.v1.ResponseMeta
+	4,  // 39: rgs.v1.StreamWagerResultsResponse.update:type_name -> rgs.v1.WagerResultUpdate
+	5,  // 40: rgs.v1.WageringService.PlaceWager:input_type -> rgs.v1.PlaceWagerRequest
+	7,  // 41: rgs.v1.WageringService.SettleWager:input_type -> rgs.v1.SettleWagerRequest
+	9,  // 42: rgs.v1.WageringService.CancelWager:input_type -> rgs.v1.CancelWagerRequest
+	11, // 43: rgs.v1.WageringService.VoidWager:input_type -> rgs.v1.VoidWagerRequest
+	13, // 44: rgs.v1.WageringService.ListWagers:input_type -> rgs.v1.ListWagersRequest
+	15, // 45: rgs.v1.WageringService.ListOverdueWagers:input_type -> rgs.v1.ListOverdueWagersRequest
+	17, // 46: rgs.v1.WageringService.GetGamePerformance:input_type -> rgs.v1.GetGamePerformanceRequest
+	19, // 47: rgs.v1.WageringService.StreamWagerResults:input_type -> rgs.v1.StreamWagerResultsRequest
+	6,  // 48: rgs.v1.WageringService.PlaceWager:output_type -> rgs.v1.PlaceWagerResponse
+	8,  // 49: rgs.v1.WageringService.SettleWager:output_type -> rgs.v1.SettleWagerResponse
+	10, // 50: rgs.v1.WageringService.CancelWager:output_type -> rgs.v1.CancelWagerResponse
+	12, // 51: rgs.v1.WageringService.VoidWager:output_type -> rgs.v1.VoidWagerResponse
+	14, // 52: rgs.v1.WageringService.ListWagers:output_type -> rgs.v1.ListWagersResponse
+	16, // 53: rgs.v1.WageringService.ListOverdueWagers:output_type -> rgs.v1.ListOverdueWagersResponse
+	18, // 54: rgs.v1.WageringService.GetGamePerformance:output_type -> rgs.v1.GetGamePerformanceResponse
+	20, // 55: rgs.v1.WageringService.StreamWagerResults:output_type -> rgs.v1.StreamWagerResultsResponse
+	48, // [48:56] is the sub-list for method output_type
+	40, // [40:48] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
 }
 
 func init() { file_rgs_v1_wagering_proto_init() }
@@ -676,7 +1777,7 @@ func file_rgs_v1_wagering_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rgs_v1_wagering_proto_rawDesc), len(file_rgs_v1_wagering_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   7,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
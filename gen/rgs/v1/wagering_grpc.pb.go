@@ -19,9 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	WageringService_PlaceWager_FullMethodName  = "/rgs.v1.WageringService/PlaceWager"
-	WageringService_SettleWager_FullMethodName = "/rgs.v1.WageringService/SettleWager"
-	WageringService_CancelWager_FullMethodName = "/rgs.v1.WageringService/CancelWager"
+	WageringService_PlaceWager_FullMethodName         = "/rgs.v1.WageringService/PlaceWager"
+	WageringService_SettleWager_FullMethodName        = "/rgs.v1.WageringService/SettleWager"
+	WageringService_CancelWager_FullMethodName        = "/rgs.v1.WageringService/CancelWager"
+	WageringService_VoidWager_FullMethodName          = "/rgs.v1.WageringService/VoidWager"
+	WageringService_ListWagers_FullMethodName         = "/rgs.v1.WageringService/ListWagers"
+	WageringService_ListOverdueWagers_FullMethodName  = "/rgs.v1.WageringService/ListOverdueWagers"
+	WageringService_GetGamePerformance_FullMethodName = "/rgs.v1.WageringService/GetGamePerformance"
+	WageringService_StreamWagerResults_FullMethodName = "/rgs.v1.WageringService/StreamWagerResults"
 )
 
 // WageringServiceClient is the client API for WageringService service.
@@ -31,6 +36,12 @@ type WageringServiceClient interface {
 	PlaceWager(ctx context.Context, in *PlaceWagerRequest, opts ...grpc.CallOption) (*PlaceWagerResponse, error)
 	SettleWager(ctx context.Context, in *SettleWagerRequest, opts ...grpc.CallOption) (*SettleWagerResponse, error)
 	CancelWager(ctx context.Context, in *CancelWagerRequest, opts ...grpc.CallOption) (*CancelWagerResponse, error)
+	VoidWager(ctx context.Context, in *VoidWagerRequest, opts ...grpc.CallOption) (*VoidWagerResponse, error)
+	ListWagers(ctx context.Context, in *ListWagersRequest, opts ...grpc.CallOption) (*ListWagersResponse, error)
+	ListOverdueWagers(ctx context.Context, in *ListOverdueWagersRequest, opts ...grpc.CallOption) (*ListOverdueWagersResponse, error)
+	GetGamePerformance(ctx context.Context, in *GetGamePerformanceRequest, opts ...grpc.CallOption) (*GetGamePerformanceResponse, error)
+	// gRPC only: pushes outcomes of wagers placed from the device.
+	StreamWagerResults(ctx context.Context, in *StreamWagerResultsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamWagerResultsResponse], error)
 }
 
 type wageringServiceClient struct {
@@ -71,6 +82,65 @@ func (c *wageringServiceClient) CancelWager(ctx context.Context, in *CancelWager
 	return out, nil
 }
 
+func (c *wageringServiceClient) VoidWager(ctx context.Context, in *VoidWagerRequest, opts ...grpc.CallOption) (*VoidWagerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VoidWagerResponse)
+	err := c.cc.Invoke(ctx, WageringService_VoidWager_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wageringServiceClient) ListWagers(ctx context.Context, in *ListWagersRequest, opts ...grpc.CallOption) (*ListWagersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWagersResponse)
+	err := c.cc.Invoke(ctx, WageringService_ListWagers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wageringServiceClient) ListOverdueWagers(ctx context.Context, in *ListOverdueWagersRequest, opts ...grpc.CallOption) (*ListOverdueWagersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOverdueWagersResponse)
+	err := c.cc.Invoke(ctx, WageringService_ListOverdueWagers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wageringServiceClient) GetGamePerformance(ctx context.Context, in *GetGamePerformanceRequest, opts ...grpc.CallOption) (*GetGamePerformanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGamePerformanceResponse)
+	err := c.cc.Invoke(ctx, WageringService_GetGamePerformance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wageringServiceClient) StreamWagerResults(ctx context.Context, in *StreamWagerResultsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamWagerResultsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &WageringService_ServiceDesc.Streams[0], WageringService_StreamWagerResults_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamWagerResultsRequest, StreamWagerResultsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WageringService_StreamWagerResultsClient = grpc.ServerStreamingClient[StreamWagerResultsResponse]
+
 // WageringServiceServer is the server API for WageringService service.
 // All implementations must embed UnimplementedWageringServiceServer
 // for forward compatibility.
@@ -78,6 +148,12 @@ type WageringServiceServer interface {
 	PlaceWager(context.Context, *PlaceWagerRequest) (*PlaceWagerResponse, error)
 	SettleWager(context.Context, *SettleWagerRequest) (*SettleWagerResponse, error)
 	CancelWager(context.Context, *CancelWagerRequest) (*CancelWagerResponse, error)
+	VoidWager(context.Context, *VoidWagerRequest) (*VoidWagerResponse, error)
+	ListWagers(context.Context, *ListWagersRequest) (*ListWagersResponse, error)
+	ListOverdueWagers(context.Context, *ListOverdueWagersRequest) (*ListOverdueWagersResponse, error)
+	GetGamePerformance(context.Context, *GetGamePerformanceRequest) (*GetGamePerformanceResponse, error)
+	// gRPC only: pushes outcomes of wagers placed from the device.
+	StreamWagerResults(*StreamWagerResultsRequest, grpc.ServerStreamingServer[StreamWagerResultsResponse]) error
 	mustEmbedUnimplementedWageringServiceServer()
 }
 
@@ -97,6 +173,21 @@ func (UnimplementedWageringServiceServer) SettleWager(context.Context, *SettleWa
 func (UnimplementedWageringServiceServer) CancelWager(context.Context, *CancelWagerRequest) (*CancelWagerResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CancelWager not implemented")
 }
+func (UnimplementedWageringServiceServer) VoidWager(context.Context, *VoidWagerRequest) (*VoidWagerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method VoidWager not implemented")
+}
+func (UnimplementedWageringServiceServer) ListWagers(context.Context, *ListWagersRequest) (*ListWagersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListWagers not implemented")
+}
+func (UnimplementedWageringServiceServer) ListOverdueWagers(context.Context, *ListOverdueWagersRequest) (*ListOverdueWagersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListOverdueWagers not implemented")
+}
+func (UnimplementedWageringServiceServer) GetGamePerformance(context.Context, *GetGamePerformanceRequest) (*GetGamePerformanceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGamePerformance not implemented")
+}
+func (UnimplementedWageringServiceServer) StreamWagerResults(*StreamWagerResultsRequest, grpc.ServerStreamingServer[StreamWagerResultsResponse]) error {
+	return status.Error(codes.Unimplemented, "method StreamWagerResults not implemented")
+}
 func (UnimplementedWageringServiceServer) mustEmbedUnimplementedWageringServiceServer() {}
 func (UnimplementedWageringServiceServer) testEmbeddedByValue()                         {}
 
@@ -172,6 +263,89 @@ func _WageringService_CancelWager_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WageringService_VoidWager_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoidWagerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WageringServiceServer).VoidWager(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WageringService_VoidWager_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WageringServiceServer).VoidWager(ctx, req.(*VoidWagerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WageringService_ListWagers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWagersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WageringServiceServer).ListWagers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WageringService_ListWagers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WageringServiceServer).ListWagers(ctx, req.(*ListWagersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WageringService_ListOverdueWagers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOverdueWagersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WageringServiceServer).ListOverdueWagers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WageringService_ListOverdueWagers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WageringServiceServer).ListOverdueWagers(ctx, req.(*ListOverdueWagersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WageringService_GetGamePerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGamePerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WageringServiceServer).GetGamePerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WageringService_GetGamePerformance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WageringServiceServer).GetGamePerformance(ctx, req.(*GetGamePerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WageringService_StreamWagerResults_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamWagerResultsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WageringServiceServer).StreamWagerResults(m, &grpc.GenericServerStream[StreamWagerResultsRequest, StreamWagerResultsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WageringService_StreamWagerResultsServer = grpc.ServerStreamingServer[StreamWagerResultsResponse]
+
 // WageringService_ServiceDesc is the grpc.ServiceDesc for WageringService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -191,7 +365,29 @@ var WageringService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelWager",
 			Handler:    _WageringService_CancelWager_Handler,
 		},
+		{
+			MethodName: "VoidWager",
+			Handler:    _WageringService_VoidWager_Handler,
+		},
+		{
+			MethodName: "ListWagers",
+			Handler:    _WageringService_ListWagers_Handler,
+		},
+		{
+			MethodName: "ListOverdueWagers",
+			Handler:    _WageringService_ListOverdueWagers_Handler,
+		},
+		{
+			MethodName: "GetGamePerformance",
+			Handler:    _WageringService_GetGamePerformance_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamWagerResults",
+			Handler:       _WageringService_StreamWagerResults_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "rgs/v1/wagering.proto",
 }
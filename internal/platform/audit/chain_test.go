@@ -37,3 +37,31 @@ func TestAppendChainsEvents(t *testing.T) {
 		t.Fatalf("expected chain link, got prev=%s want=%s", second.HashPrev, first.HashCurr)
 	}
 }
+
+type recordingForwarder struct {
+	events []Event
+}
+
+func (r *recordingForwarder) Forward(e Event) {
+	r.events = append(r.events, e)
+}
+
+func TestAppendForwardsChainedEvents(t *testing.T) {
+	s := NewInMemoryStore()
+	fwd := &recordingForwarder{}
+	s.SetForwarder(fwd)
+	appended, err := s.Append(Event{AuditID: "a1", RecordedAt: time.Date(2026, 2, 11, 0, 0, 0, 0, time.UTC), Action: "login", Result: ResultSuccess})
+	if err != nil {
+		t.Fatalf("append: %v", err)
+	}
+	if len(fwd.events) != 1 || fwd.events[0].HashCurr != appended.HashCurr {
+		t.Fatalf("expected chained event to be forwarded, got %+v", fwd.events)
+	}
+	s.SetForwarder(nil)
+	if _, err := s.Append(Event{AuditID: "a2", Action: "logout", Result: ResultSuccess}); err != nil {
+		t.Fatalf("append: %v", err)
+	}
+	if len(fwd.events) != 1 {
+		t.Fatalf("expected forwarding to stop, got %d events", len(fwd.events))
+	}
+}
@@ -0,0 +1,102 @@
+package audit
+
+import (
+	"bytes"
+	"crypto/sha256"
+)
+
+// Merkle trees over audit events follow RFC 6962 section 2.1: leaves and
+// interior nodes are hashed with distinct 0x00 and 0x01 prefixes, and a
+// tree of n leaves splits at the largest power of two below n, so any
+// number of events forms a tree without padding.
+
+func merkleLeafHash(leaf []byte) []byte {
+	h := sha256.New()
+	_, _ = h.Write([]byte{0x00})
+	_, _ = h.Write(leaf)
+	return h.Sum(nil)
+}
+
+func merkleNodeHash(left, right []byte) []byte {
+	h := sha256.New()
+	_, _ = h.Write([]byte{0x01})
+	_, _ = h.Write(left)
+	_, _ = h.Write(right)
+	return h.Sum(nil)
+}
+
+// merkleSplit returns the largest power of two smaller than n, for n > 1.
+func merkleSplit(n int) int {
+	k := 1
+	for k<<1 < n {
+		k <<= 1
+	}
+	return k
+}
+
+// MerkleRoot returns the root hash of the tree over leaves, or the SHA-256
+// of nothing for an empty tree.
+func MerkleRoot(leaves [][]byte) []byte {
+	switch len(leaves) {
+	case 0:
+		sum := sha256.Sum256(nil)
+		return sum[:]
+	case 1:
+		return merkleLeafHash(leaves[0])
+	}
+	k := merkleSplit(len(leaves))
+	return merkleNodeHash(MerkleRoot(leaves[:k]), MerkleRoot(leaves[k:]))
+}
+
+// MerkleProof returns the audit path for leaves[index], ordered from the
+// leaf up. It returns nil when index is out of range.
+func MerkleProof(leaves [][]byte, index int) [][]byte {
+	if index < 0 || index >= len(leaves) {
+		return nil
+	}
+	path := make([][]byte, 0)
+	for len(leaves) > 1 {
+		k := merkleSplit(len(leaves))
+		if index < k {
+			path = append(path, MerkleRoot(leaves[k:]))
+			leaves = leaves[:k]
+		} else {
+			path = append(path, MerkleRoot(leaves[:k]))
+			leaves, index = leaves[k:], index-k
+		}
+	}
+	// Collected root-first while descending; proofs are read leaf-first.
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+// VerifyMerkleProof reports whether leaf sits at index in a tree of size
+// leaves with the given root, using the audit path from MerkleProof.
+func VerifyMerkleProof(leaf []byte, index, size int, path [][]byte, root []byte) bool {
+	if index < 0 || index >= size {
+		return false
+	}
+	got, ok := merkleRootFromPath(merkleLeafHash(leaf), index, size, path)
+	return ok && bytes.Equal(got, root)
+}
+
+// merkleRootFromPath rebuilds the root of a tree of size leaves from the
+// hash at index and its audit path, consuming the path from the top down.
+func merkleRootFromPath(hash []byte, index, size int, path [][]byte) ([]byte, bool) {
+	if size == 1 {
+		return hash, len(path) == 0
+	}
+	if len(path) == 0 {
+		return nil, false
+	}
+	sibling, rest := path[len(path)-1], path[:len(path)-1]
+	k := merkleSplit(size)
+	if index < k {
+		left, ok := merkleRootFromPath(hash, index, k, rest)
+		return merkleNodeHash(left, sibling), ok
+	}
+	right, ok := merkleRootFromPath(hash, index-k, size-k, rest)
+	return merkleNodeHash(sibling, right), ok
+}
@@ -0,0 +1,44 @@
+package audit
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"testing"
+)
+
+func TestMerkleProofsVerifyForEveryLeaf(t *testing.T) {
+	for size := 1; size <= 9; size++ {
+		leaves := make([][]byte, size)
+		for i := range leaves {
+			sum := sha256.Sum256([]byte("event-" + strconv.Itoa(i)))
+			leaves[i] = sum[:]
+		}
+		root := MerkleRoot(leaves)
+		for i := range leaves {
+			path := MerkleProof(leaves, i)
+			if !VerifyMerkleProof(leaves[i], i, size, path, root) {
+				t.Fatalf("size=%d index=%d: proof does not verify", size, i)
+			}
+			if VerifyMerkleProof([]byte("other"), i, size, path, root) {
+				t.Fatalf("size=%d index=%d: proof verified the wrong leaf", size, i)
+			}
+			if size > 1 && VerifyMerkleProof(leaves[i], (i+1)%size, size, path, root) {
+				t.Fatalf("size=%d index=%d: proof verified at the wrong index", size, i)
+			}
+		}
+	}
+	if MerkleProof([][]byte{[]byte("a")}, 1) != nil {
+		t.Fatalf("expected no proof for an out-of-range index")
+	}
+}
+
+func TestMerkleRootMatchesRFC6962(t *testing.T) {
+	// Two empty leaves, hashed as RFC 6962 section 2.1 defines.
+	leaf := sha256.Sum256([]byte{0x00})
+	want := sha256.Sum256(append(append([]byte{0x01}, leaf[:]...), leaf[:]...))
+	if got := MerkleRoot([][]byte{{}, {}}); !bytes.Equal(got, want[:]) {
+		t.Fatalf("root=%s want=%s", hex.EncodeToString(got), hex.EncodeToString(want[:]))
+	}
+}
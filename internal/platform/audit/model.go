@@ -27,4 +27,7 @@ type Event struct {
 	PartitionDay string
 	HashPrev     string
 	HashCurr     string
+	// SignerKID and Signature are set when events are signed; see Sign.
+	SignerKID string
+	Signature string
 }
@@ -0,0 +1,63 @@
+package audit
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+	"time"
+)
+
+func TestSignVerifiesAfterStorageNormalization(t *testing.T) {
+	pub, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	recorded := time.Date(2026, 2, 11, 10, 30, 0, 123456789, time.FixedZone("PST", -8*3600))
+	signed := Sign(Event{
+		AuditID:      "a1",
+		OccurredAt:   recorded,
+		RecordedAt:   recorded,
+		ActorID:      "operator-1",
+		ActorType:    "operator",
+		ObjectType:   "ledger_account",
+		ObjectID:     "acct-1",
+		Action:       "deposit",
+		Before:       []byte(`{"balance":100,"currency":"USD"}`),
+		After:        []byte(`{"currency":"USD","balance":150}`),
+		Result:       ResultSuccess,
+		PartitionDay: "2026-02-11",
+	}, "audit-key-1", key)
+	if signed.SignerKID != "audit-key-1" || signed.Signature == "" {
+		t.Fatalf("expected signer and signature, got %+v", signed)
+	}
+	if !VerifySignature(signed, pub) {
+		t.Fatalf("expected signature to verify")
+	}
+
+	// The event as read back from Postgres: UTC at microsecond precision and
+	// JSONB spacing and key order.
+	stored := signed
+	stored.OccurredAt = recorded.UTC().Truncate(time.Microsecond)
+	stored.RecordedAt = recorded.UTC().Truncate(time.Microsecond)
+	stored.Before = []byte(`{"balance": 100, "currency": "USD"}`)
+	stored.After = []byte(`{"balance": 150, "currency": "USD"}`)
+	stored.HashPrev, stored.HashCurr = "GENESIS", "recomputed"
+	if !VerifySignature(stored, pub) {
+		t.Fatalf("expected signature to survive storage normalization")
+	}
+
+	tampered := stored
+	tampered.After = []byte(`{"balance": 1500, "currency": "USD"}`)
+	if VerifySignature(tampered, pub) {
+		t.Fatalf("expected tampered state to fail verification")
+	}
+	tampered = stored
+	tampered.SignerKID = "other-key"
+	if VerifySignature(tampered, pub) {
+		t.Fatalf("expected changed signer to fail verification")
+	}
+	otherPub, _, _ := ed25519.GenerateKey(rand.Reader)
+	if VerifySignature(stored, otherPub) {
+		t.Fatalf("expected verification with another key to fail")
+	}
+}
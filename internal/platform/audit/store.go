@@ -7,10 +7,26 @@ import (
 
 var ErrCorruptChain = errors.New("audit chain corruption detected")
 
+// Forwarder receives every event once it has been chained. Stores call it
+// while holding their lock, so Forward must not block.
+type Forwarder interface {
+	Forward(Event)
+}
+
+// Store records chained audit events. InMemoryStore keeps the chain in
+// process; persistent stores wrap one so in-process readers still see every
+// event.
+type Store interface {
+	Append(Event) (Event, error)
+}
+
+var _ Store = (*InMemoryStore)(nil)
+
 type InMemoryStore struct {
-	mu     sync.Mutex
-	events []Event
-	last   string
+	mu        sync.Mutex
+	events    []Event
+	last      string
+	forwarder Forwarder
 }
 
 func NewInMemoryStore() *InMemoryStore {
@@ -34,9 +50,20 @@ func (s *InMemoryStore) Append(e Event) (Event, error) {
 
 	s.events = append(s.events, e)
 	s.last = e.HashCurr
+	if s.forwarder != nil {
+		s.forwarder.Forward(e)
+	}
 	return e, nil
 }
 
+// SetForwarder mirrors events appended from now on to f; nil stops
+// forwarding.
+func (s *InMemoryStore) SetForwarder(f Forwarder) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.forwarder = f
+}
+
 func (s *InMemoryStore) Events() []Event {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// CertificateActorResolver maps a verified mTLS client certificate to the
+// actor it authenticates as. ok is false for certificates with no mapping.
+type CertificateActorResolver interface {
+	ActorForCertificate(ctx context.Context, cert *x509.Certificate) (actor Actor, ok bool, err error)
+}
+
+// CertificateActorResolverFunc adapts a function to CertificateActorResolver.
+type CertificateActorResolverFunc func(ctx context.Context, cert *x509.Certificate) (Actor, bool, error)
+
+func (f CertificateActorResolverFunc) ActorForCertificate(ctx context.Context, cert *x509.Certificate) (Actor, bool, error) {
+	return f(ctx, cert)
+}
+
+// CertificateFingerprint returns the hex SHA-256 of the certificate's DER
+// encoding.
+func CertificateFingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
+
+// CertificateSANs returns the certificate's DNS, URI, and email subject
+// alternative names.
+func CertificateSANs(cert *x509.Certificate) []string {
+	sans := make([]string, 0, len(cert.DNSNames)+len(cert.URIs)+len(cert.EmailAddresses))
+	sans = append(sans, cert.DNSNames...)
+	for _, u := range cert.URIs {
+		sans = append(sans, u.String())
+	}
+	return append(sans, cert.EmailAddresses...)
+}
+
+// verifiedLeaf returns the client certificate of a connection whose chain
+// was verified against the configured client CAs.
+func verifiedLeaf(state *tls.ConnectionState) *x509.Certificate {
+	if state == nil || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return nil
+	}
+	return state.VerifiedChains[0][0]
+}
+
+func peerCertificate(ctx context.Context) *x509.Certificate {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.AuthInfo == nil {
+		return nil
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return nil
+	}
+	return verifiedLeaf(&info.State)
+}
+
+// actorFromCertificate resolves cert through certs. ok is false when there is
+// no resolver, no certificate, or no mapping.
+func actorFromCertificate(ctx context.Context, certs CertificateActorResolver, cert *x509.Certificate) (Actor, bool, error) {
+	if certs == nil || cert == nil {
+		return Actor{}, false, nil
+	}
+	return certs.ActorForCertificate(ctx, cert)
+}
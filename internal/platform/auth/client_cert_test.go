@@ -0,0 +1,131 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func testClientCertificate(t *testing.T, dnsName string) *x509.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: dnsName},
+		DNSNames:     []string{dnsName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("parse certificate: %v", err)
+	}
+	return cert
+}
+
+func tlsPeerContext(cert *x509.Certificate) context.Context {
+	state := tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}, VerifiedChains: [][]*x509.Certificate{{cert}}}
+	return peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{State: state}})
+}
+
+func TestUnaryAuthInterceptorMapsClientCertificate(t *testing.T) {
+	cert := testClientCertificate(t, "cabinet-bridge.internal")
+	if fp := CertificateFingerprint(cert); len(fp) != 64 {
+		t.Fatalf("unexpected fingerprint: %q", fp)
+	}
+	certs := CertificateActorResolverFunc(func(_ context.Context, c *x509.Certificate) (Actor, bool, error) {
+		for _, san := range CertificateSANs(c) {
+			if san == "cabinet-bridge.internal" {
+				return Actor{ID: "cabinet-bridge", Type: "ACTOR_TYPE_SERVICE"}, true, nil
+			}
+		}
+		return Actor{}, false, nil
+	})
+	signer := NewJWTSigner("test-secret")
+	token, _, err := signer.SignActor(Actor{ID: "op-1", Type: "ACTOR_TYPE_OPERATOR"}, time.Now(), time.Minute)
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+	interceptor := UnaryAuthInterceptor(NewJWTVerifier("test-secret"), certs, nil)
+	info := &grpc.UnaryServerInfo{FullMethod: "/rgs.v1.RegistryService/ListEquipment"}
+	var got Actor
+	handler := func(ctx context.Context, _ any) (any, error) {
+		got, _ = ActorFromContext(ctx)
+		return nil, nil
+	}
+
+	if _, err := interceptor(tlsPeerContext(cert), nil, info, handler); err != nil {
+		t.Fatalf("expected certificate to authenticate, got %v", err)
+	}
+	if got.ID != "cabinet-bridge" || got.Type != "ACTOR_TYPE_SERVICE" {
+		t.Fatalf("unexpected certificate actor: %+v", got)
+	}
+
+	withToken := metadata.NewIncomingContext(tlsPeerContext(cert), metadata.Pairs("authorization", "Bearer "+token))
+	if _, err := interceptor(withToken, nil, info, handler); err != nil || got.ID != "op-1" {
+		t.Fatalf("expected bearer token to take precedence, got actor=%+v err=%v", got, err)
+	}
+
+	unmapped := tlsPeerContext(testClientCertificate(t, "unknown.internal"))
+	if _, err := interceptor(unmapped, nil, info, handler); err == nil {
+		t.Fatalf("expected unmapped certificate to be rejected")
+	}
+	if _, err := UnaryJWTInterceptor(NewJWTVerifier("test-secret"), nil)(tlsPeerContext(cert), nil, info, handler); err == nil {
+		t.Fatalf("expected certificate ignored without a resolver")
+	}
+}
+
+func TestHTTPAuthMiddlewareMapsClientCertificate(t *testing.T) {
+	cert := testClientCertificate(t, "reporting-export.internal")
+	fingerprint := CertificateFingerprint(cert)
+	certs := CertificateActorResolverFunc(func(_ context.Context, c *x509.Certificate) (Actor, bool, error) {
+		if CertificateFingerprint(c) == fingerprint {
+			return Actor{ID: "reporting-export", Type: "ACTOR_TYPE_SERVICE"}, true, nil
+		}
+		return Actor{}, false, nil
+	})
+	var got Actor
+	h := HTTPAuthMiddleware(NewJWTVerifier("test-secret"), certs, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got, _ = ActorFromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	}), nil, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/reporting/runs", nil)
+	req.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}, VerifiedChains: [][]*x509.Certificate{{cert}}}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK || got.ID != "reporting-export" {
+		t.Fatalf("expected certificate to authenticate, code=%d actor=%+v", rec.Code, got)
+	}
+
+	// Presented but unverified certificates never authenticate.
+	req = httptest.NewRequest(http.MethodGet, "/v1/reporting/runs", nil)
+	req.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected unverified certificate to be rejected, got %d", rec.Code)
+	}
+}
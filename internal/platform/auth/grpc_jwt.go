@@ -11,31 +11,90 @@ import (
 )
 
 func UnaryJWTInterceptor(verifier *JWTVerifier, allowUnauthenticatedMethods []string) grpc.UnaryServerInterceptor {
-	allow := make(map[string]struct{}, len(allowUnauthenticatedMethods))
-	for _, m := range allowUnauthenticatedMethods {
-		allow[m] = struct{}{}
-	}
+	return UnaryAuthInterceptor(verifier, nil, allowUnauthenticatedMethods)
+}
+
+// UnaryAuthInterceptor authenticates with a bearer token or, when the call
+// carries none, with the peer's verified client certificate mapped through
+// certs. certs may be nil to require tokens.
+func UnaryAuthInterceptor(verifier *JWTVerifier, certs CertificateActorResolver, allowUnauthenticatedMethods []string) grpc.UnaryServerInterceptor {
+	allow := allowedMethods(allowUnauthenticatedMethods)
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
 		if _, ok := allow[info.FullMethod]; ok {
 			return handler(ctx, req)
 		}
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, status.Error(codes.Unauthenticated, "missing metadata")
+		actor, err := actorFromIncomingContext(ctx, verifier, certs)
+		if err != nil {
+			return nil, err
 		}
-		authz := md.Get("authorization")
-		if len(authz) == 0 {
-			return nil, status.Error(codes.Unauthenticated, "missing bearer token")
+		return handler(WithActor(ctx, actor), req)
+	}
+}
+
+// StreamJWTInterceptor authenticates streaming RPCs the same way
+// UnaryJWTInterceptor does, exposing the actor through the stream context.
+func StreamJWTInterceptor(verifier *JWTVerifier, allowUnauthenticatedMethods []string) grpc.StreamServerInterceptor {
+	return StreamAuthInterceptor(verifier, nil, allowUnauthenticatedMethods)
+}
+
+// StreamAuthInterceptor is the streaming counterpart of UnaryAuthInterceptor.
+func StreamAuthInterceptor(verifier *JWTVerifier, certs CertificateActorResolver, allowUnauthenticatedMethods []string) grpc.StreamServerInterceptor {
+	allow := allowedMethods(allowUnauthenticatedMethods)
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if _, ok := allow[info.FullMethod]; ok {
+			return handler(srv, ss)
 		}
-		h := authz[0]
-		if !strings.HasPrefix(h, "Bearer ") {
-			return nil, status.Error(codes.Unauthenticated, "missing bearer token")
+		actor, err := actorFromIncomingContext(ss.Context(), verifier, certs)
+		if err != nil {
+			return err
 		}
-		token := strings.TrimPrefix(h, "Bearer ")
-		actor, err := verifier.ParseActor(token)
+		return handler(srv, &actorServerStream{ServerStream: ss, ctx: WithActor(ss.Context(), actor)})
+	}
+}
+
+type actorServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *actorServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func allowedMethods(methods []string) map[string]struct{} {
+	allow := make(map[string]struct{}, len(methods))
+	for _, m := range methods {
+		allow[m] = struct{}{}
+	}
+	return allow
+}
+
+func actorFromIncomingContext(ctx context.Context, verifier *JWTVerifier, certs CertificateActorResolver) (Actor, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	authz := md.Get("authorization")
+	if len(authz) == 0 {
+		// A bearer token always wins; the client certificate only
+		// authenticates calls that carry none.
+		actor, ok, err := actorFromCertificate(ctx, certs, peerCertificate(ctx))
 		if err != nil {
-			return nil, status.Error(codes.Unauthenticated, "invalid token")
+			return Actor{}, status.Error(codes.Unavailable, "client certificate lookup unavailable")
 		}
-		return handler(WithActor(ctx, actor), req)
+		if ok {
+			return actor, nil
+		}
+		if md == nil {
+			return Actor{}, status.Error(codes.Unauthenticated, "missing metadata")
+		}
+		return Actor{}, status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+	h := authz[0]
+	if !strings.HasPrefix(h, "Bearer ") {
+		return Actor{}, status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+	token := strings.TrimPrefix(h, "Bearer ")
+	actor, err := verifier.ParseActor(token)
+	if err != nil {
+		return Actor{}, status.Error(codes.Unauthenticated, "invalid token")
 	}
+	return actor, nil
 }
@@ -0,0 +1,134 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sort"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// ParseSigningKeyPEM reads an RSA (PKCS#1 or PKCS#8, at least 2048 bits) or
+// Ed25519 (PKCS#8) private key used to sign access tokens with RS256 or
+// EdDSA.
+func ParseSigningKeyPEM(raw []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(raw)
+	if block == nil {
+		return nil, errors.New("no pem block found")
+	}
+	var key any
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported pem block %q", block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		if k.N.BitLen() < 2048 {
+			return nil, errors.New("rsa signing keys must be at least 2048 bits")
+		}
+		return k, nil
+	case ed25519.PrivateKey:
+		return k, nil
+	default:
+		return nil, errors.New("signing key must be rsa or ed25519")
+	}
+}
+
+func signingMethodFor(key crypto.Signer) (jwt.SigningMethod, error) {
+	switch key.(type) {
+	case *rsa.PrivateKey:
+		return jwt.SigningMethodRS256, nil
+	case ed25519.PrivateKey:
+		return jwt.SigningMethodEdDSA, nil
+	default:
+		return nil, errors.New("unsupported signing key")
+	}
+}
+
+func publicJWK(kid string, key crypto.Signer) (jsonWebKey, bool) {
+	switch pub := key.Public().(type) {
+	case *rsa.PublicKey:
+		return jsonWebKey{
+			Kty: "RSA",
+			Kid: kid,
+			Use: "sig",
+			Alg: jwt.SigningMethodRS256.Alg(),
+			N:   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+		}, true
+	case ed25519.PublicKey:
+		return jsonWebKey{
+			Kty: "OKP",
+			Kid: kid,
+			Use: "sig",
+			Alg: jwt.SigningMethodEdDSA.Alg(),
+			Crv: "Ed25519",
+			X:   base64.RawURLEncoding.EncodeToString(pub),
+		}, true
+	default:
+		return jsonWebKey{}, false
+	}
+}
+
+// JWKS returns the public halves of the signer's asymmetric keys as a JSON
+// Web Key Set. Retired keys stay in the set while they remain in the keyset
+// so tokens they signed can still be verified; HMAC secrets are never
+// published.
+func (s *JWTSigner) JWKS() ([]byte, error) {
+	if s == nil {
+		return nil, errors.New("signer is nil")
+	}
+	s.mu.RLock()
+	kids := make([]string, 0, len(s.privateKeys))
+	for kid := range s.privateKeys {
+		kids = append(kids, kid)
+	}
+	sort.Strings(kids)
+	set := struct {
+		Keys []jsonWebKey `json:"keys"`
+	}{Keys: make([]jsonWebKey, 0, len(kids))}
+	for _, kid := range kids {
+		if jwk, ok := publicJWK(kid, s.privateKeys[kid]); ok {
+			set.Keys = append(set.Keys, jwk)
+		}
+	}
+	s.mu.RUnlock()
+	return json.Marshal(set)
+}
+
+// JWKSHandler serves JWKS for /.well-known/jwks.json. It reflects keyset
+// reloads on every request.
+func (s *JWTSigner) JWKSHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		body, err := s.JWKS()
+		if err != nil {
+			http.Error(w, "jwks unavailable", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/jwk-set+json")
+		w.Header().Set("Cache-Control", "public, max-age=300")
+		_, _ = w.Write(body)
+	})
+}
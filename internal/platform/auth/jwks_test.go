@@ -0,0 +1,176 @@
+package auth
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func testSigningKeysPEM(t *testing.T) (rsaPEM, edPEM string) {
+	t.Helper()
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate rsa key: %v", err)
+	}
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("generate ed25519 key: %v", err)
+	}
+	edDER, err := x509.MarshalPKCS8PrivateKey(edKey)
+	if err != nil {
+		t.Fatalf("marshal ed25519 key: %v", err)
+	}
+	rsaPEM = string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)}))
+	edPEM = string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: edDER}))
+	return rsaPEM, edPEM
+}
+
+func testAsymmetricKeyset(t *testing.T, active string) HMACKeyset {
+	t.Helper()
+	rsaPEM, edPEM := testSigningKeysPEM(t)
+	raw, _ := json.Marshal(map[string]any{
+		"active_kid":   active,
+		"keys":         map[string]string{"hs1": "hmac-secret"},
+		"private_keys": map[string]string{"rs1": rsaPEM, "ed1": edPEM},
+	})
+	keyset, err := LoadHMACKeysetJSON(raw)
+	if err != nil {
+		t.Fatalf("load asymmetric keyset: %v", err)
+	}
+	return keyset
+}
+
+func TestAsymmetricSigningRoundTrip(t *testing.T) {
+	for _, tc := range []struct {
+		kid string
+		alg string
+	}{
+		{"rs1", "RS256"},
+		{"ed1", "EdDSA"},
+		{"hs1", "HS256"},
+	} {
+		keyset := testAsymmetricKeyset(t, tc.kid)
+		signer := NewJWTSignerWithKeyset(keyset)
+		verifier := NewJWTVerifierWithKeyset(keyset)
+		signed, _, err := signer.SignActor(Actor{ID: "op-1", Type: "ACTOR_TYPE_OPERATOR"}, time.Now(), time.Minute)
+		if err != nil {
+			t.Fatalf("%s: sign: %v", tc.kid, err)
+		}
+		tok, _, err := jwt.NewParser().ParseUnverified(signed, jwt.MapClaims{})
+		if err != nil || tok.Method.Alg() != tc.alg || tok.Header["kid"] != tc.kid {
+			t.Fatalf("%s: expected %s token, got header=%v err=%v", tc.kid, tc.alg, tok.Header, err)
+		}
+		actor, err := verifier.ParseActor(signed)
+		if err != nil || actor.ID != "op-1" {
+			t.Fatalf("%s: verify: actor=%+v err=%v", tc.kid, actor, err)
+		}
+	}
+}
+
+func TestParseActorRejectsAlgorithmConfusion(t *testing.T) {
+	keyset := testAsymmetricKeyset(t, "rs1")
+	verifier := NewJWTVerifierWithKeyset(keyset)
+	pubDER, err := x509.MarshalPKIXPublicKey(keyset.PrivateKeys["rs1"].Public())
+	if err != nil {
+		t.Fatalf("marshal public key: %v", err)
+	}
+	claims := jwt.MapClaims{"sub": "op-1", "actor_type": "ACTOR_TYPE_OPERATOR", "exp": time.Now().Add(time.Hour).Unix()}
+	for _, key := range [][]byte{pubDER, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubDER})} {
+		forged := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+		forged.Header["kid"] = "rs1"
+		signed, err := forged.SignedString(key)
+		if err != nil {
+			t.Fatalf("sign forged token: %v", err)
+		}
+		if _, err := verifier.ParseActor(signed); err == nil {
+			t.Fatalf("expected hs256 token keyed by an rsa kid to be rejected")
+		}
+	}
+
+	mislabeled := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
+	mislabeled.Header["kid"] = "rs1"
+	signed, err := mislabeled.SignedString(keyset.PrivateKeys["ed1"])
+	if err != nil {
+		t.Fatalf("sign mislabeled token: %v", err)
+	}
+	if _, err := verifier.ParseActor(signed); err == nil {
+		t.Fatalf("expected eddsa token naming an rsa kid to be rejected")
+	}
+}
+
+func TestJWKSHandlerPublishesPublicKeysOnly(t *testing.T) {
+	keyset := testAsymmetricKeyset(t, "rs1")
+	signer := NewJWTSignerWithKeyset(keyset)
+
+	rec := httptest.NewRecorder()
+	signer.JWKSHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil))
+	if rec.Code != http.StatusOK || rec.Header().Get("Content-Type") != "application/jwk-set+json" {
+		t.Fatalf("unexpected jwks response: code=%d headers=%v", rec.Code, rec.Header())
+	}
+	var set struct {
+		Keys []map[string]string `json:"keys"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &set); err != nil {
+		t.Fatalf("decode jwks: %v", err)
+	}
+	if len(set.Keys) != 2 || set.Keys[0]["kid"] != "ed1" || set.Keys[1]["kid"] != "rs1" {
+		t.Fatalf("expected ed1 and rs1 in jwks, got=%v", set.Keys)
+	}
+	for _, k := range set.Keys {
+		if k["d"] != "" || k["p"] != "" || k["use"] != "sig" {
+			t.Fatalf("unexpected jwk fields: %v", k)
+		}
+	}
+	if set.Keys[0]["kty"] != "OKP" || set.Keys[0]["crv"] != "Ed25519" || set.Keys[0]["alg"] != "EdDSA" {
+		t.Fatalf("unexpected ed25519 jwk: %v", set.Keys[0])
+	}
+
+	// A relying service rebuilds the RSA key from the published set and
+	// verifies a token without access to any secret.
+	var rsaJWK jsonWebKey
+	raw, _ := json.Marshal(set.Keys[1])
+	_ = json.Unmarshal(raw, &rsaJWK)
+	pub, err := rsaJWK.publicKey()
+	if err != nil {
+		t.Fatalf("rebuild rsa key from jwks: %v", err)
+	}
+	signed, _, _ := signer.SignActor(Actor{ID: "op-1", Type: "ACTOR_TYPE_OPERATOR"}, time.Now(), time.Minute)
+	if _, err := jwt.Parse(signed, func(*jwt.Token) (any, error) { return pub, nil }, jwt.WithValidMethods([]string{"RS256"})); err != nil {
+		t.Fatalf("verify token with published key: %v", err)
+	}
+
+	post := httptest.NewRecorder()
+	signer.JWKSHandler().ServeHTTP(post, httptest.NewRequest(http.MethodPost, "/.well-known/jwks.json", nil))
+	if post.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("expected post rejected, got=%d", post.Code)
+	}
+}
+
+func TestLoadHMACKeysetJSONRejectsBadPrivateKeys(t *testing.T) {
+	weak, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("generate weak key: %v", err)
+	}
+	weakPEM := string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(weak)}))
+	rsaPEM, _ := testSigningKeysPEM(t)
+	for name, payload := range map[string]map[string]any{
+		"weak rsa":    {"active_kid": "rs1", "private_keys": map[string]string{"rs1": weakPEM}},
+		"not pem":     {"active_kid": "rs1", "private_keys": map[string]string{"rs1": "garbage"}},
+		"kid clash":   {"active_kid": "k1", "keys": map[string]string{"k1": "secret"}, "private_keys": map[string]string{"k1": rsaPEM}},
+		"active miss": {"active_kid": "rs2", "private_keys": map[string]string{"rs1": rsaPEM}},
+	} {
+		raw, _ := json.Marshal(payload)
+		if _, err := LoadHMACKeysetJSON(raw); err == nil {
+			t.Fatalf("%s: expected keyset to be rejected", name)
+		}
+	}
+}
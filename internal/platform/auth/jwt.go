@@ -2,6 +2,9 @@ package auth
 
 import (
 	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
 	"errors"
 	"fmt"
 	"net/http"
@@ -16,14 +19,56 @@ type contextKey string
 
 const actorContextKey contextKey = "actor"
 
+// Actor is an authenticated caller. AuthStrength and AuthTime come from
+// the access token and describe the login it descends from; they are empty
+// for actors authenticated by client certificate. ImpersonatorID and
+// ImpersonatorType name the operator acting on the caller's behalf, from
+// the token's RFC 8693 act claim; they are empty unless the token was
+// issued by AssumeActor.
 type Actor struct {
-	ID   string
-	Type string
+	ID               string
+	Type             string
+	AuthStrength     string
+	AuthTime         time.Time
+	ImpersonatorID   string
+	ImpersonatorType string
 }
 
+// Impersonated reports whether another actor is acting as a.
+func (a Actor) Impersonated() bool {
+	return a.ImpersonatorID != ""
+}
+
+// Authentication strengths carried in the auth_strength claim, weakest
+// first.
+const (
+	AuthStrengthPassword = "password"
+	AuthStrengthMFA      = "mfa"
+)
+
+// AuthStrengthSatisfies reports whether a login of strength have meets a
+// requirement of strength want. Unknown strengths satisfy nothing.
+func AuthStrengthSatisfies(have, want string) bool {
+	rank := map[string]int{AuthStrengthPassword: 1, AuthStrengthMFA: 2}
+	return rank[have] > 0 && rank[have] >= rank[want]
+}
+
+// HMACKeyset is the set of access token signing keys. Keys holds HS256
+// secrets; PrivateKeys holds RS256 (RSA) and EdDSA (Ed25519) keys, whose
+// public halves are published as a JWKS. ActiveKID names the key used to
+// sign new tokens and may refer to either map.
 type HMACKeyset struct {
-	ActiveKID string
-	Keys      map[string][]byte
+	ActiveKID   string
+	Keys        map[string][]byte
+	PrivateKeys map[string]crypto.Signer
+}
+
+func (k HMACKeyset) hasKID(kid string) bool {
+	if _, ok := k.Keys[kid]; ok {
+		return true
+	}
+	_, ok := k.PrivateKeys[kid]
+	return ok
 }
 
 func ParseHMACKeyset(fallbackSecret, keysetSpec, activeKID string) (HMACKeyset, error) {
@@ -68,9 +113,10 @@ func ParseHMACKeyset(fallbackSecret, keysetSpec, activeKID string) (HMACKeyset,
 }
 
 type JWTSigner struct {
-	mu        sync.RWMutex
-	activeKID string
-	keys      map[string][]byte
+	mu          sync.RWMutex
+	activeKID   string
+	keys        map[string][]byte
+	privateKeys map[string]crypto.Signer
 }
 
 func NewJWTSigner(secret string) *JWTSigner {
@@ -83,8 +129,9 @@ func NewJWTSigner(secret string) *JWTSigner {
 
 func NewJWTSignerWithKeyset(keyset HMACKeyset) *JWTSigner {
 	return &JWTSigner{
-		activeKID: keyset.ActiveKID,
-		keys:      copyKeyMap(keyset.Keys),
+		activeKID:   keyset.ActiveKID,
+		keys:        copyKeyMap(keyset.Keys),
+		privateKeys: copySignerMap(keyset.PrivateKeys),
 	}
 }
 
@@ -94,9 +141,18 @@ func (s *JWTSigner) SignActor(actor Actor, now time.Time, ttl time.Duration) (st
 	}
 	s.mu.RLock()
 	activeKID := s.activeKID
-	secret := s.keys[activeKID]
+	var method jwt.SigningMethod = jwt.SigningMethodHS256
+	var key any = s.keys[activeKID]
+	if pk, ok := s.privateKeys[activeKID]; ok {
+		m, err := signingMethodFor(pk)
+		if err != nil {
+			s.mu.RUnlock()
+			return "", time.Time{}, err
+		}
+		method, key = m, pk
+	}
 	s.mu.RUnlock()
-	if len(secret) == 0 {
+	if secret, ok := key.([]byte); ok && len(secret) == 0 {
 		return "", time.Time{}, errors.New("active jwt key is missing")
 	}
 	expiresAt := now.UTC().Add(ttl)
@@ -106,9 +162,18 @@ func (s *JWTSigner) SignActor(actor Actor, now time.Time, ttl time.Duration) (st
 		"iat":        now.UTC().Unix(),
 		"exp":        expiresAt.Unix(),
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	if actor.AuthStrength != "" {
+		claims["auth_strength"] = actor.AuthStrength
+	}
+	if !actor.AuthTime.IsZero() {
+		claims["auth_time"] = actor.AuthTime.UTC().Unix()
+	}
+	if actor.Impersonated() {
+		claims["act"] = map[string]any{"sub": actor.ImpersonatorID, "actor_type": actor.ImpersonatorType}
+	}
+	token := jwt.NewWithClaims(method, claims)
 	token.Header["kid"] = activeKID
-	signed, err := token.SignedString(secret)
+	signed, err := token.SignedString(key)
 	if err != nil {
 		return "", time.Time{}, err
 	}
@@ -119,20 +184,22 @@ func (s *JWTSigner) SetKeyset(keyset HMACKeyset) error {
 	if s == nil {
 		return errors.New("signer is nil")
 	}
-	if _, ok := keyset.Keys[keyset.ActiveKID]; !ok {
+	if !keyset.hasKID(keyset.ActiveKID) {
 		return errors.New("active key not present in keyset")
 	}
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	s.activeKID = keyset.ActiveKID
 	s.keys = copyKeyMap(keyset.Keys)
+	s.privateKeys = copySignerMap(keyset.PrivateKeys)
 	return nil
 }
 
 type JWTVerifier struct {
-	mu        sync.RWMutex
-	activeKID string
-	keys      map[string][]byte
+	mu         sync.RWMutex
+	activeKID  string
+	keys       map[string][]byte
+	publicKeys map[string]crypto.PublicKey
 }
 
 func NewJWTVerifier(secret string) *JWTVerifier {
@@ -144,29 +211,41 @@ func NewJWTVerifier(secret string) *JWTVerifier {
 }
 
 func NewJWTVerifierWithKeyset(keyset HMACKeyset) *JWTVerifier {
-	return &JWTVerifier{activeKID: keyset.ActiveKID, keys: copyKeyMap(keyset.Keys)}
+	return &JWTVerifier{activeKID: keyset.ActiveKID, keys: copyKeyMap(keyset.Keys), publicKeys: publicKeyMap(keyset.PrivateKeys)}
 }
 
 func (v *JWTVerifier) ParseActor(tokenString string) (Actor, error) {
 	v.mu.RLock()
 	activeKID := v.activeKID
 	keys := copyKeyMap(v.keys)
+	publicKeys := v.publicKeys
 	v.mu.RUnlock()
 	claims := jwt.MapClaims{}
 	tok, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (any, error) {
-		if token.Method != jwt.SigningMethodHS256 {
-			return nil, errors.New("unexpected signing method")
-		}
 		kid, _ := token.Header["kid"].(string)
 		if strings.TrimSpace(kid) == "" {
 			kid = activeKID
 		}
-		secret := keys[kid]
-		if len(secret) == 0 {
-			return nil, errors.New("unknown key id")
+		// The key is chosen by kid and must match the token's algorithm, so
+		// a token cannot pass off a public key as an HMAC secret.
+		switch token.Method {
+		case jwt.SigningMethodHS256:
+			if secret := keys[kid]; len(secret) > 0 {
+				return secret, nil
+			}
+		case jwt.SigningMethodRS256:
+			if pub, ok := publicKeys[kid].(*rsa.PublicKey); ok {
+				return pub, nil
+			}
+		case jwt.SigningMethodEdDSA:
+			if pub, ok := publicKeys[kid].(ed25519.PublicKey); ok {
+				return pub, nil
+			}
+		default:
+			return nil, errors.New("unexpected signing method")
 		}
-		return secret, nil
-	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithLeeway(5*time.Second))
+		return nil, errors.New("unknown key id")
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg(), jwt.SigningMethodRS256.Alg(), jwt.SigningMethodEdDSA.Alg()}), jwt.WithLeeway(5*time.Second))
 	if err != nil || !tok.Valid {
 		return Actor{}, errors.New("invalid token")
 	}
@@ -176,20 +255,33 @@ func (v *JWTVerifier) ParseActor(tokenString string) (Actor, error) {
 	if sub == "" || actorType == "" {
 		return Actor{}, errors.New("missing actor claims")
 	}
-	return Actor{ID: sub, Type: actorType}, nil
+	actor := Actor{ID: sub, Type: actorType}
+	actor.AuthStrength, _ = claims["auth_strength"].(string)
+	if authTime, ok := claims["auth_time"].(float64); ok {
+		actor.AuthTime = time.Unix(int64(authTime), 0).UTC()
+	}
+	if act, ok := claims["act"].(map[string]any); ok {
+		actor.ImpersonatorID, _ = act["sub"].(string)
+		actor.ImpersonatorType, _ = act["actor_type"].(string)
+		if actor.ImpersonatorID == "" || actor.ImpersonatorType == "" {
+			return Actor{}, errors.New("invalid act claim")
+		}
+	}
+	return actor, nil
 }
 
 func (v *JWTVerifier) SetKeyset(keyset HMACKeyset) error {
 	if v == nil {
 		return errors.New("verifier is nil")
 	}
-	if _, ok := keyset.Keys[keyset.ActiveKID]; !ok {
+	if !keyset.hasKID(keyset.ActiveKID) {
 		return errors.New("active key not present in keyset")
 	}
 	v.mu.Lock()
 	defer v.mu.Unlock()
 	v.activeKID = keyset.ActiveKID
 	v.keys = copyKeyMap(keyset.Keys)
+	v.publicKeys = publicKeyMap(keyset.PrivateKeys)
 	return nil
 }
 
@@ -206,6 +298,26 @@ func copyKeyMap(in map[string][]byte) map[string][]byte {
 	return out
 }
 
+func copySignerMap(in map[string]crypto.Signer) map[string]crypto.Signer {
+	out := make(map[string]crypto.Signer, len(in))
+	for k, v := range in {
+		if v != nil {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+func publicKeyMap(in map[string]crypto.Signer) map[string]crypto.PublicKey {
+	out := make(map[string]crypto.PublicKey, len(in))
+	for k, v := range in {
+		if v != nil {
+			out[k] = v.Public()
+		}
+	}
+	return out
+}
+
 func WithActor(ctx context.Context, actor Actor) context.Context {
 	return context.WithValue(ctx, actorContextKey, actor)
 }
@@ -220,30 +332,73 @@ func HTTPJWTMiddleware(verifier *JWTVerifier, next http.Handler) http.Handler {
 }
 
 func HTTPJWTMiddlewareWithSkips(verifier *JWTVerifier, next http.Handler, skipPaths []string) http.Handler {
+	return HTTPJWTMiddlewareWithFailureObserver(verifier, next, skipPaths, nil)
+}
+
+// AuthFailureObserver is told about every request the HTTP middleware
+// rejects. kid is the token's key id when the header is parseable.
+type AuthFailureObserver func(r *http.Request, reason, kid string)
+
+func HTTPJWTMiddlewareWithFailureObserver(verifier *JWTVerifier, next http.Handler, skipPaths []string, onFailure AuthFailureObserver) http.Handler {
+	return HTTPAuthMiddleware(verifier, nil, next, skipPaths, onFailure)
+}
+
+// HTTPAuthMiddleware authenticates with a bearer token or, when the request
+// carries no Authorization header, with the verified client certificate
+// mapped through certs. certs may be nil to require tokens.
+func HTTPAuthMiddleware(verifier *JWTVerifier, certs CertificateActorResolver, next http.Handler, skipPaths []string, onFailure AuthFailureObserver) http.Handler {
 	skip := make(map[string]struct{}, len(skipPaths))
 	for _, p := range skipPaths {
 		skip[p] = struct{}{}
 	}
+	reject := func(w http.ResponseWriter, r *http.Request, reason, kid string) {
+		if onFailure != nil {
+			onFailure(r, reason, kid)
+		}
+		http.Error(w, reason, http.StatusUnauthorized)
+	}
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if _, ok := skip[r.URL.Path]; ok {
 			next.ServeHTTP(w, r)
 			return
 		}
 		h := r.Header.Get("Authorization")
+		if h == "" {
+			actor, ok, err := actorFromCertificate(r.Context(), certs, verifiedLeaf(r.TLS))
+			if err != nil {
+				http.Error(w, "client certificate lookup unavailable", http.StatusServiceUnavailable)
+				return
+			}
+			if ok {
+				next.ServeHTTP(w, r.WithContext(WithActor(r.Context(), actor)))
+				return
+			}
+		}
 		if !strings.HasPrefix(h, "Bearer ") {
-			http.Error(w, "missing bearer token", http.StatusUnauthorized)
+			reject(w, r, "missing bearer token", "")
 			return
 		}
 		tok := strings.TrimPrefix(h, "Bearer ")
 		actor, err := verifier.ParseActor(tok)
 		if err != nil {
-			http.Error(w, "invalid token", http.StatusUnauthorized)
+			reject(w, r, "invalid token", TokenKID(tok))
 			return
 		}
 		next.ServeHTTP(w, r.WithContext(WithActor(r.Context(), actor)))
 	})
 }
 
+// TokenKID returns the kid header of a JWT without verifying it, or "" if
+// the token cannot be decoded. Only use it for diagnostics.
+func TokenKID(tokenString string) string {
+	tok, _, err := jwt.NewParser().ParseUnverified(tokenString, jwt.MapClaims{})
+	if err != nil {
+		return ""
+	}
+	kid, _ := tok.Header["kid"].(string)
+	return strings.TrimSpace(kid)
+}
+
 type RefreshTokenAllowlist struct {
 	mu     sync.RWMutex
 	tokens map[string]struct{}
@@ -1,10 +1,15 @@
 package auth
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 func TestParseActor(t *testing.T) {
@@ -31,6 +36,59 @@ func TestParseActor(t *testing.T) {
 	}
 }
 
+func TestSignActorCarriesAuthStrength(t *testing.T) {
+	signer := NewJWTSigner("test-secret")
+	verifier := NewJWTVerifier("test-secret")
+	authTime := time.Now().Add(-3 * time.Minute).UTC().Truncate(time.Second)
+
+	signed, _, err := signer.SignActor(Actor{ID: "op-1", Type: "ACTOR_TYPE_OPERATOR", AuthStrength: AuthStrengthMFA, AuthTime: authTime}, time.Now(), time.Minute)
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+	actor, err := verifier.ParseActor(signed)
+	if err != nil || actor.AuthStrength != AuthStrengthMFA || !actor.AuthTime.Equal(authTime) {
+		t.Fatalf("expected auth claims round-trip, actor=%+v err=%v", actor, err)
+	}
+
+	plain, _, _ := signer.SignActor(Actor{ID: "svc-1", Type: "ACTOR_TYPE_SERVICE"}, time.Now(), time.Minute)
+	if actor, _ := verifier.ParseActor(plain); actor.AuthStrength != "" || !actor.AuthTime.IsZero() {
+		t.Fatalf("expected no auth claims, got=%+v", actor)
+	}
+
+	for _, tc := range []struct {
+		have, want string
+		ok         bool
+	}{
+		{AuthStrengthMFA, AuthStrengthPassword, true},
+		{AuthStrengthPassword, AuthStrengthPassword, true},
+		{AuthStrengthPassword, AuthStrengthMFA, false},
+		{"", AuthStrengthPassword, false},
+	} {
+		if got := AuthStrengthSatisfies(tc.have, tc.want); got != tc.ok {
+			t.Fatalf("AuthStrengthSatisfies(%q, %q)=%v", tc.have, tc.want, got)
+		}
+	}
+}
+
+func TestSignActorCarriesImpersonator(t *testing.T) {
+	signer := NewJWTSigner("test-secret")
+	verifier := NewJWTVerifier("test-secret")
+
+	signed, _, err := signer.SignActor(Actor{ID: "player-1", Type: "ACTOR_TYPE_PLAYER", ImpersonatorID: "op-1", ImpersonatorType: "ACTOR_TYPE_OPERATOR"}, time.Now(), time.Minute)
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+	actor, err := verifier.ParseActor(signed)
+	if err != nil || !actor.Impersonated() || actor.ID != "player-1" || actor.ImpersonatorID != "op-1" || actor.ImpersonatorType != "ACTOR_TYPE_OPERATOR" {
+		t.Fatalf("expected act claim round-trip, actor=%+v err=%v", actor, err)
+	}
+
+	plain, _, _ := signer.SignActor(Actor{ID: "player-1", Type: "ACTOR_TYPE_PLAYER"}, time.Now(), time.Minute)
+	if actor, _ := verifier.ParseActor(plain); actor.Impersonated() {
+		t.Fatalf("expected no impersonator, got=%+v", actor)
+	}
+}
+
 func TestParseActorWithKeyRotation(t *testing.T) {
 	keyset, err := ParseHMACKeyset("", "old:old-secret,new:new-secret", "new")
 	if err != nil {
@@ -107,3 +165,85 @@ func TestJWTLiveKeysetReload(t *testing.T) {
 		t.Fatalf("verify new token after reload: %v", err)
 	}
 }
+
+func TestHTTPJWTMiddlewareReportsFailures(t *testing.T) {
+	keyset, err := ParseHMACKeyset("", "k1:right-secret", "k1")
+	if err != nil {
+		t.Fatalf("parse keyset: %v", err)
+	}
+	forged := NewJWTSignerWithKeyset(HMACKeyset{ActiveKID: "k9", Keys: map[string][]byte{"k9": []byte("wrong-secret")}})
+	token, _, err := forged.SignActor(Actor{ID: "player-1", Type: "ACTOR_TYPE_PLAYER"}, time.Now().UTC(), time.Hour)
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+
+	type failure struct{ path, reason, kid string }
+	var got []failure
+	h := HTTPJWTMiddlewareWithFailureObserver(NewJWTVerifierWithKeyset(keyset), http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}), []string{"/v1/system/status"}, func(r *http.Request, reason, kid string) {
+		got = append(got, failure{r.URL.Path, reason, kid})
+	})
+
+	for _, tc := range []struct{ path, authz string }{
+		{"/v1/ledger/deposits", ""},
+		{"/v1/ledger/deposits", "Bearer " + token},
+		{"/v1/ledger/deposits", "Bearer not-a-jwt"},
+		{"/v1/system/status", ""},
+	} {
+		req := httptest.NewRequest(http.MethodPost, tc.path, nil)
+		if tc.authz != "" {
+			req.Header.Set("Authorization", tc.authz)
+		}
+		h.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	want := []failure{
+		{"/v1/ledger/deposits", "missing bearer token", ""},
+		{"/v1/ledger/deposits", "invalid token", "k9"},
+		{"/v1/ledger/deposits", "invalid token", ""},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d failures, got=%+v", len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("failure %d: expected %+v got %+v", i, want[i], got[i])
+		}
+	}
+}
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s testServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestStreamJWTInterceptorAttachesActor(t *testing.T) {
+	signer := NewJWTSigner("test-secret")
+	interceptor := StreamJWTInterceptor(NewJWTVerifier("test-secret"), nil)
+	info := &grpc.StreamServerInfo{FullMethod: "/rgs.v1.WageringService/StreamWagerResults"}
+	token, _, err := signer.SignActor(Actor{ID: "cab-1", Type: "ACTOR_TYPE_SERVICE"}, time.Now(), time.Minute)
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+
+	var got Actor
+	handler := func(_ any, ss grpc.ServerStream) error {
+		got, _ = ActorFromContext(ss.Context())
+		return nil
+	}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+	if err := interceptor(nil, testServerStream{ctx: ctx}, info, handler); err != nil {
+		t.Fatalf("expected authenticated stream, got %v", err)
+	}
+	if got.ID != "cab-1" || got.Type != "ACTOR_TYPE_SERVICE" {
+		t.Fatalf("unexpected actor on stream context: %+v", got)
+	}
+	if err := interceptor(nil, testServerStream{ctx: context.Background()}, info, handler); err == nil {
+		t.Fatalf("expected stream without token to be rejected")
+	}
+}
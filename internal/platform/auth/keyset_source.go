@@ -2,6 +2,7 @@ package auth
 
 import (
 	"context"
+	"crypto"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -13,6 +14,8 @@ import (
 type hmacKeysetFile struct {
 	ActiveKID string            `json:"active_kid"`
 	Keys      map[string]string `json:"keys"`
+	// PrivateKeys maps kids to PEM encoded RSA or Ed25519 private keys.
+	PrivateKeys map[string]string `json:"private_keys"`
 }
 
 func LoadHMACKeysetFile(path string) (HMACKeyset, error) {
@@ -59,14 +62,31 @@ func LoadHMACKeysetJSON(raw []byte) (HMACKeyset, error) {
 		}
 		keys[kid] = []byte(secret)
 	}
-	if len(keys) == 0 {
+	privateKeys := make(map[string]crypto.Signer, len(f.PrivateKeys))
+	for kid, pemKey := range f.PrivateKeys {
+		kid = strings.TrimSpace(kid)
+		if kid == "" || strings.TrimSpace(pemKey) == "" {
+			continue
+		}
+		if _, ok := keys[kid]; ok {
+			return HMACKeyset{}, fmt.Errorf("kid %q is both an hmac and a private key", kid)
+		}
+		key, err := ParseSigningKeyPEM([]byte(pemKey))
+		if err != nil {
+			return HMACKeyset{}, fmt.Errorf("private key %q: %w", kid, err)
+		}
+		privateKeys[kid] = key
+	}
+	keyset := HMACKeyset{
+		ActiveKID:   active,
+		Keys:        keys,
+		PrivateKeys: privateKeys,
+	}
+	if len(keys) == 0 && len(privateKeys) == 0 {
 		return HMACKeyset{}, fmt.Errorf("jwt keyset payload contains no keys")
 	}
-	if _, ok := keys[active]; !ok {
+	if !keyset.hasKID(active) {
 		return HMACKeyset{}, fmt.Errorf("active kid %q not found in keyset payload", active)
 	}
-	return HMACKeyset{
-		ActiveKID: active,
-		Keys:      keys,
-	}, nil
+	return keyset, nil
 }
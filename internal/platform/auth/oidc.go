@@ -0,0 +1,227 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// OIDCConfig describes an external identity provider whose ID tokens are
+// accepted for operator login.
+type OIDCConfig struct {
+	Issuer   string
+	Audience string
+	// JWKSURL defaults to the jwks_uri advertised at
+	// <Issuer>/.well-known/openid-configuration.
+	JWKSURL    string
+	HTTPClient *http.Client
+	// MinRefreshInterval rate-limits JWKS refetches triggered by unknown key
+	// ids. Default: 1m.
+	MinRefreshInterval time.Duration
+}
+
+// OIDCVerifier validates ID tokens against the provider's published signing
+// keys. Keys are fetched on first use and refetched when a token names a key
+// id that is not cached.
+type OIDCVerifier struct {
+	cfg OIDCConfig
+
+	mu          sync.Mutex
+	jwksURL     string
+	keys        map[string]any
+	refreshedAt time.Time
+}
+
+func NewOIDCVerifier(cfg OIDCConfig) (*OIDCVerifier, error) {
+	cfg.Issuer = strings.TrimSpace(cfg.Issuer)
+	cfg.Audience = strings.TrimSpace(cfg.Audience)
+	if cfg.Issuer == "" || cfg.Audience == "" {
+		return nil, errors.New("oidc issuer and audience are required")
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	if cfg.MinRefreshInterval <= 0 {
+		cfg.MinRefreshInterval = time.Minute
+	}
+	return &OIDCVerifier{cfg: cfg, jwksURL: strings.TrimSpace(cfg.JWKSURL), keys: map[string]any{}}, nil
+}
+
+// Verify checks the token's signature, issuer, audience, and expiry and
+// returns its claims.
+func (v *OIDCVerifier) Verify(ctx context.Context, rawIDToken string) (jwt.MapClaims, error) {
+	if v == nil {
+		return nil, errors.New("oidc verifier is nil")
+	}
+	claims := jwt.MapClaims{}
+	tok, err := jwt.ParseWithClaims(rawIDToken, claims, func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+		return v.key(ctx, kid)
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodES256.Alg()}),
+		jwt.WithIssuer(v.cfg.Issuer),
+		jwt.WithAudience(v.cfg.Audience),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(30*time.Second),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid id token: %w", err)
+	}
+	if !tok.Valid {
+		return nil, errors.New("invalid id token")
+	}
+	return claims, nil
+}
+
+func (v *OIDCVerifier) key(ctx context.Context, kid string) (any, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if k, ok := v.lookupLocked(kid); ok {
+		return k, nil
+	}
+	if !v.refreshedAt.IsZero() && time.Since(v.refreshedAt) < v.cfg.MinRefreshInterval {
+		return nil, errors.New("unknown key id")
+	}
+	if err := v.refreshLocked(ctx); err != nil {
+		return nil, err
+	}
+	if k, ok := v.lookupLocked(kid); ok {
+		return k, nil
+	}
+	return nil, errors.New("unknown key id")
+}
+
+// lookupLocked finds the key for kid; a token without a kid is accepted only
+// when the provider publishes a single key.
+func (v *OIDCVerifier) lookupLocked(kid string) (any, bool) {
+	if kid == "" {
+		if len(v.keys) != 1 {
+			return nil, false
+		}
+		for _, k := range v.keys {
+			return k, true
+		}
+	}
+	k, ok := v.keys[kid]
+	return k, ok
+}
+
+func (v *OIDCVerifier) refreshLocked(ctx context.Context) error {
+	v.refreshedAt = time.Now()
+	if v.jwksURL == "" {
+		var doc struct {
+			JWKSURI string `json:"jwks_uri"`
+		}
+		if err := v.getJSON(ctx, strings.TrimRight(v.cfg.Issuer, "/")+"/.well-known/openid-configuration", &doc); err != nil {
+			return fmt.Errorf("oidc discovery: %w", err)
+		}
+		if doc.JWKSURI == "" {
+			return errors.New("oidc discovery: jwks_uri missing")
+		}
+		v.jwksURL = doc.JWKSURI
+	}
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := v.getJSON(ctx, v.jwksURL, &set); err != nil {
+		return fmt.Errorf("fetch jwks: %w", err)
+	}
+	keys := make(map[string]any, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		k, err := jwk.publicKey()
+		if err != nil {
+			continue
+		}
+		keys[jwk.Kid] = k
+	}
+	if len(keys) == 0 {
+		return errors.New("jwks contains no usable signing keys")
+	}
+	v.keys = keys
+	return nil
+}
+
+func (v *OIDCVerifier) getJSON(ctx context.Context, url string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := v.cfg.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned %d", url, resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use,omitempty"`
+	Alg string `json:"alg,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+func (k jsonWebKey) publicKey() (any, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+		exp := new(big.Int).SetBytes(e)
+		if len(n) < 256 || !exp.IsInt64() || exp.Int64() < 3 {
+			return nil, errors.New("unsupported rsa key")
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exp.Int64())}, nil
+	case "EC":
+		if k.Crv != "P-256" {
+			return nil, errors.New("unsupported curve")
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != 32 || len(y) != 32 {
+			return nil, errors.New("invalid ec point")
+		}
+		if _, err := ecdh.P256().NewPublicKey(append(append([]byte{4}, x...), y...)); err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+	default:
+		return nil, errors.New("unsupported key type")
+	}
+}
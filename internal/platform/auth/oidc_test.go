@@ -0,0 +1,152 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type testIdP struct {
+	server      *httptest.Server
+	rsaKey      *rsa.PrivateKey
+	ecKey       *ecdsa.PrivateKey
+	jwksFetches atomic.Int32
+}
+
+func newTestIdP(t *testing.T) *testIdP {
+	t.Helper()
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate rsa key: %v", err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate ec key: %v", err)
+	}
+	idp := &testIdP{rsaKey: rsaKey, ecKey: ecKey}
+	b64 := base64.RawURLEncoding.EncodeToString
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{"issuer": idp.server.URL, "jwks_uri": idp.server.URL + "/jwks"})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		idp.jwksFetches.Add(1)
+		_ = json.NewEncoder(w).Encode(map[string]any{"keys": []map[string]string{
+			{"kty": "RSA", "kid": "rsa-1", "use": "sig", "n": b64(rsaKey.N.Bytes()), "e": b64(big.NewInt(int64(rsaKey.E)).Bytes())},
+			{"kty": "EC", "kid": "ec-1", "crv": "P-256", "x": b64(ecKey.X.FillBytes(make([]byte, 32))), "y": b64(ecKey.Y.FillBytes(make([]byte, 32)))},
+		}})
+	})
+	idp.server = httptest.NewServer(mux)
+	t.Cleanup(idp.server.Close)
+	return idp
+}
+
+func (idp *testIdP) claims(aud string, exp time.Time) jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss": idp.server.URL,
+		"aud": aud,
+		"sub": "op-1",
+		"iat": time.Now().Add(-time.Minute).Unix(),
+		"exp": exp.Unix(),
+	}
+}
+
+func (idp *testIdP) sign(t *testing.T, method jwt.SigningMethod, kid string, claims jwt.MapClaims) string {
+	t.Helper()
+	tok := jwt.NewWithClaims(method, claims)
+	tok.Header["kid"] = kid
+	var key any = idp.rsaKey
+	if method == jwt.SigningMethodES256 {
+		key = idp.ecKey
+	}
+	signed, err := tok.SignedString(key)
+	if err != nil {
+		t.Fatalf("sign id token: %v", err)
+	}
+	return signed
+}
+
+func TestOIDCVerifierAcceptsRSAAndECTokensViaDiscovery(t *testing.T) {
+	idp := newTestIdP(t)
+	v, err := NewOIDCVerifier(OIDCConfig{Issuer: idp.server.URL, Audience: "rgs-console"})
+	if err != nil {
+		t.Fatalf("new verifier: %v", err)
+	}
+	exp := time.Now().Add(time.Hour)
+
+	claims, err := v.Verify(context.Background(), idp.sign(t, jwt.SigningMethodRS256, "rsa-1", idp.claims("rgs-console", exp)))
+	if err != nil {
+		t.Fatalf("verify rs256: %v", err)
+	}
+	if claims["sub"] != "op-1" {
+		t.Fatalf("unexpected claims: %v", claims)
+	}
+	if _, err := v.Verify(context.Background(), idp.sign(t, jwt.SigningMethodES256, "ec-1", idp.claims("rgs-console", exp))); err != nil {
+		t.Fatalf("verify es256: %v", err)
+	}
+	if n := idp.jwksFetches.Load(); n != 1 {
+		t.Fatalf("expected keys fetched once, got=%d", n)
+	}
+}
+
+func TestOIDCVerifierRejectsInvalidTokens(t *testing.T) {
+	idp := newTestIdP(t)
+	v, err := NewOIDCVerifier(OIDCConfig{Issuer: idp.server.URL, Audience: "rgs-console", JWKSURL: idp.server.URL + "/jwks"})
+	if err != nil {
+		t.Fatalf("new verifier: %v", err)
+	}
+	exp := time.Now().Add(time.Hour)
+	wrongIssuer := idp.claims("rgs-console", exp)
+	wrongIssuer["iss"] = "https://elsewhere.example"
+	hmac := jwt.NewWithClaims(jwt.SigningMethodHS256, idp.claims("rgs-console", exp))
+	hmacSigned, _ := hmac.SignedString([]byte("guess"))
+
+	cases := map[string]string{
+		"wrong audience": idp.sign(t, jwt.SigningMethodRS256, "rsa-1", idp.claims("other-app", exp)),
+		"wrong issuer":   idp.sign(t, jwt.SigningMethodRS256, "rsa-1", wrongIssuer),
+		"expired":        idp.sign(t, jwt.SigningMethodRS256, "rsa-1", idp.claims("rgs-console", time.Now().Add(-time.Hour))),
+		"key mismatch":   idp.sign(t, jwt.SigningMethodES256, "rsa-1", idp.claims("rgs-console", exp)),
+		"hmac":           hmacSigned,
+		"garbage":        "not-a-token",
+	}
+	for name, token := range cases {
+		if _, err := v.Verify(context.Background(), token); err == nil {
+			t.Fatalf("%s: expected verification failure", name)
+		}
+	}
+}
+
+func TestOIDCVerifierRateLimitsRefreshForUnknownKeys(t *testing.T) {
+	idp := newTestIdP(t)
+	v, err := NewOIDCVerifier(OIDCConfig{Issuer: idp.server.URL, Audience: "rgs-console", JWKSURL: idp.server.URL + "/jwks"})
+	if err != nil {
+		t.Fatalf("new verifier: %v", err)
+	}
+	exp := time.Now().Add(time.Hour)
+	for i := 0; i < 3; i++ {
+		if _, err := v.Verify(context.Background(), idp.sign(t, jwt.SigningMethodRS256, "rotated-away", idp.claims("rgs-console", exp))); err == nil {
+			t.Fatalf("expected unknown key id failure")
+		}
+	}
+	if n := idp.jwksFetches.Load(); n != 1 {
+		t.Fatalf("expected a single jwks fetch for repeated unknown kids, got=%d", n)
+	}
+}
+
+func TestNewOIDCVerifierRequiresIssuerAndAudience(t *testing.T) {
+	if _, err := NewOIDCVerifier(OIDCConfig{Issuer: "https://idp.example"}); err == nil {
+		t.Fatalf("expected missing audience error")
+	}
+}
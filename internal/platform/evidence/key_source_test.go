@@ -4,6 +4,7 @@ import (
 	"crypto/ed25519"
 	"encoding/base64"
 	"os"
+	"strings"
 	"testing"
 )
 
@@ -80,3 +81,56 @@ func TestResolveEd25519PublicKeyFromCommand(t *testing.T) {
 		t.Fatalf("unexpected public key length: %d", len(got))
 	}
 }
+
+func TestResolveValueSourceFileError(t *testing.T) {
+	t.Setenv("ERR_VAL_FILE", "/nonexistent/path/value.txt")
+	_, err := resolveValueSource("ERR_VAL", "ERR_VAL_FILE", "ERR_VAL_COMMAND")
+	if err == nil {
+		t.Fatalf("expected error for missing file source")
+	}
+}
+
+func TestResolveEd25519PrivateKeyStrictRejectsInline(t *testing.T) {
+	seed := make([]byte, ed25519.SeedSize)
+	priv := ed25519.NewKeyFromSeed(seed)
+	t.Setenv("RGS_VERIFY_EVIDENCE_ENFORCE_ATTESTATION_KEY", "true")
+	t.Setenv("RGS_VERIFY_EVIDENCE_ALLOW_INLINE_PRIVATE_KEY", "false")
+	t.Setenv("RGS_VERIFY_EVIDENCE_ATTESTATION_ED25519_PRIVATE_KEY", base64.StdEncoding.EncodeToString(priv))
+	t.Setenv("RGS_VERIFY_EVIDENCE_ATTESTATION_KEY_ID", DefaultVerifyEvidenceAttestationKeyID)
+
+	_, err := ResolveEd25519PrivateKey(DefaultVerifyEvidenceAttestationKeyID)
+	if err == nil || !strings.Contains(err.Error(), "inline ed25519 private-key env vars are disabled") {
+		t.Fatalf("expected strict inline rejection, got err=%v", err)
+	}
+}
+
+func TestResolveEd25519PrivateKeyFromFile(t *testing.T) {
+	seed := make([]byte, ed25519.SeedSize)
+	priv := ed25519.NewKeyFromSeed(seed)
+	path := t.TempDir() + "/priv.b64"
+	if err := os.WriteFile(path, []byte(base64.StdEncoding.EncodeToString(priv)), 0o600); err != nil {
+		t.Fatalf("write private key file: %v", err)
+	}
+	t.Setenv("RGS_VERIFY_EVIDENCE_ATTESTATION_ED25519_PRIVATE_KEY_FILE", path)
+	t.Setenv("RGS_VERIFY_EVIDENCE_ATTESTATION_KEY_ID", DefaultVerifyEvidenceAttestationKeyID)
+
+	got, err := ResolveEd25519PrivateKey(DefaultVerifyEvidenceAttestationKeyID)
+	if err != nil {
+		t.Fatalf("resolve from file: %v", err)
+	}
+	if len(got) != ed25519.PrivateKeySize {
+		t.Fatalf("unexpected key length: %d", len(got))
+	}
+}
+
+func TestDefaultDevKeysArePaired(t *testing.T) {
+	t.Setenv("GITHUB_ACTIONS", "")
+	t.Setenv("RGS_VERIFY_EVIDENCE_ENFORCE_ATTESTATION_KEY", "")
+	priv, err := ResolveEd25519PrivateKey(DefaultVerifyEvidenceAttestationKeyID)
+	if err != nil {
+		t.Fatalf("resolve dev private key: %v", err)
+	}
+	if !priv.Public().(ed25519.PublicKey).Equal(defaultDevEd25519PublicKey()) {
+		t.Fatalf("dev private key does not match dev public key")
+	}
+}
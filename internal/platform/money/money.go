@@ -0,0 +1,364 @@
+// Package money implements checked arithmetic on rgs.v1.Money values and raw
+// int64 minor-unit amounts. Services must route balance and total arithmetic
+// through it so int64 overflow and mixed-currency sums surface as errors
+// instead of silently wrapping or blending currencies.
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"math/bits"
+	"strconv"
+	"strings"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+var (
+	ErrOverflow         = errors.New("money amount overflows int64 minor units")
+	ErrCurrencyMismatch = errors.New("money currency mismatch")
+	ErrInvalidCurrency  = errors.New("money currency must be a three-letter ISO 4217 code")
+	ErrInvalidAmount    = errors.New("money amount is invalid")
+)
+
+// minorExponents lists ISO 4217 currencies whose minor unit is not 1/100.
+var minorExponents = map[string]int{
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0,
+	"PYG": 0, "RWF": 0, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+}
+
+// New returns a Money value. It performs no validation.
+func New(amountMinor int64, currency string) *rgsv1.Money {
+	return &rgsv1.Money{AmountMinor: amountMinor, Currency: currency}
+}
+
+// Exponent returns the number of decimal places in currency's minor unit.
+func Exponent(currency string) int {
+	if e, ok := minorExponents[currency]; ok {
+		return e
+	}
+	return 2
+}
+
+// ValidCurrency reports whether currency is shaped like an ISO 4217 code.
+func ValidCurrency(currency string) bool {
+	if len(currency) != 3 {
+		return false
+	}
+	for i := 0; i < len(currency); i++ {
+		if currency[i] < 'A' || currency[i] > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate checks that m is present, carries a valid currency, and is not
+// negative.
+func Validate(m *rgsv1.Money) error {
+	if m == nil {
+		return ErrInvalidAmount
+	}
+	if !ValidCurrency(m.Currency) {
+		return ErrInvalidCurrency
+	}
+	if m.AmountMinor < 0 {
+		return ErrInvalidAmount
+	}
+	return nil
+}
+
+// ValidatePositive is Validate that additionally rejects zero.
+func ValidatePositive(m *rgsv1.Money) error {
+	if err := Validate(m); err != nil {
+		return err
+	}
+	if m.AmountMinor == 0 {
+		return ErrInvalidAmount
+	}
+	return nil
+}
+
+// AddMinor returns a+b or ErrOverflow.
+func AddMinor(a, b int64) (int64, error) {
+	sum := a + b
+	if (b > 0 && sum < a) || (b < 0 && sum > a) {
+		return 0, ErrOverflow
+	}
+	return sum, nil
+}
+
+// SubMinor returns a-b or ErrOverflow.
+func SubMinor(a, b int64) (int64, error) {
+	if b == math.MinInt64 {
+		if a >= 0 {
+			return 0, ErrOverflow
+		}
+		return a - b, nil
+	}
+	return AddMinor(a, -b)
+}
+
+// SumMinor returns the checked sum of amounts.
+func SumMinor(amounts ...int64) (int64, error) {
+	var total int64
+	for _, v := range amounts {
+		var err error
+		if total, err = AddMinor(total, v); err != nil {
+			return 0, err
+		}
+	}
+	return total, nil
+}
+
+// SameCurrency returns ErrCurrencyMismatch unless a and b are both present
+// and share a currency.
+func SameCurrency(a, b *rgsv1.Money) error {
+	if a == nil || b == nil {
+		return ErrInvalidAmount
+	}
+	if a.Currency != b.Currency {
+		return fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, a.Currency, b.Currency)
+	}
+	return nil
+}
+
+// Add returns a+b. Both operands must share a currency.
+func Add(a, b *rgsv1.Money) (*rgsv1.Money, error) {
+	if err := SameCurrency(a, b); err != nil {
+		return nil, err
+	}
+	sum, err := AddMinor(a.AmountMinor, b.AmountMinor)
+	if err != nil {
+		return nil, err
+	}
+	return New(sum, a.Currency), nil
+}
+
+// Sub returns a-b. Both operands must share a currency.
+func Sub(a, b *rgsv1.Money) (*rgsv1.Money, error) {
+	if err := SameCurrency(a, b); err != nil {
+		return nil, err
+	}
+	diff, err := SubMinor(a.AmountMinor, b.AmountMinor)
+	if err != nil {
+		return nil, err
+	}
+	return New(diff, a.Currency), nil
+}
+
+// Compare returns -1, 0, or 1 as a is less than, equal to, or greater than b.
+// Amounts in different currencies are not comparable.
+func Compare(a, b *rgsv1.Money) (int, error) {
+	if err := SameCurrency(a, b); err != nil {
+		return 0, err
+	}
+	switch {
+	case a.AmountMinor < b.AmountMinor:
+		return -1, nil
+	case a.AmountMinor > b.AmountMinor:
+		return 1, nil
+	default:
+		return 0, nil
+	}
+}
+
+// Allocate splits m across ratios without losing minor units: each share is
+// rounded down and the remainder goes one unit at a time to the earliest
+// shares. Ratios must be non-negative with a positive total.
+func Allocate(m *rgsv1.Money, ratios ...int64) ([]*rgsv1.Money, error) {
+	if err := Validate(m); err != nil {
+		return nil, err
+	}
+	total, err := SumMinor(ratios...)
+	if err != nil {
+		return nil, err
+	}
+	if total <= 0 {
+		return nil, ErrInvalidAmount
+	}
+	out := make([]*rgsv1.Money, len(ratios))
+	remainder := m.AmountMinor
+	for i, r := range ratios {
+		if r < 0 {
+			return nil, ErrInvalidAmount
+		}
+		share, err := mulDiv(m.AmountMinor, r, total)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = New(share, m.Currency)
+		remainder -= share
+	}
+	for i := 0; remainder > 0; i = (i + 1) % len(out) {
+		if ratios[i] == 0 {
+			continue
+		}
+		out[i].AmountMinor++
+		remainder--
+	}
+	return out, nil
+}
+
+// Split divides m into n shares that differ by at most one minor unit.
+func Split(m *rgsv1.Money, n int) ([]*rgsv1.Money, error) {
+	if n <= 0 {
+		return nil, ErrInvalidAmount
+	}
+	ratios := make([]int64, n)
+	for i := range ratios {
+		ratios[i] = 1
+	}
+	return Allocate(m, ratios...)
+}
+
+// mulDiv returns floor(a*b/c) for non-negative operands with b <= c, using a
+// 128-bit intermediate product so large balances never overflow.
+func mulDiv(a, b, c int64) (int64, error) {
+	hi, lo := bits.Mul64(uint64(a), uint64(b))
+	if hi >= uint64(c) {
+		return 0, ErrOverflow
+	}
+	q, _ := bits.Div64(hi, lo, uint64(c))
+	if q > math.MaxInt64 {
+		return 0, ErrOverflow
+	}
+	return int64(q), nil
+}
+
+// Format renders m as a decimal string followed by its currency, e.g.
+// "-12.34 USD" or "500 JPY".
+func Format(m *rgsv1.Money) string {
+	if m == nil {
+		return ""
+	}
+	exp := Exponent(m.Currency)
+	neg := m.AmountMinor < 0
+	digits := strconv.FormatUint(absMinor(m.AmountMinor), 10)
+	if exp > 0 {
+		if len(digits) <= exp {
+			digits = strings.Repeat("0", exp-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-exp] + "." + digits[len(digits)-exp:]
+	}
+	if neg {
+		digits = "-" + digits
+	}
+	return digits + " " + m.Currency
+}
+
+func absMinor(v int64) uint64 {
+	if v < 0 {
+		return uint64(-(v + 1)) + 1
+	}
+	return uint64(v)
+}
+
+// Parse reads the Format representation. The fractional part may be shorter
+// than the currency exponent but not longer, so no rounding ever happens.
+func Parse(s string) (*rgsv1.Money, error) {
+	amount, currency, ok := strings.Cut(strings.TrimSpace(s), " ")
+	currency = strings.TrimSpace(currency)
+	if !ok || !ValidCurrency(currency) {
+		return nil, ErrInvalidCurrency
+	}
+	neg := strings.HasPrefix(amount, "-")
+	amount = strings.TrimPrefix(amount, "-")
+	whole, frac, _ := strings.Cut(amount, ".")
+	exp := Exponent(currency)
+	if whole == "" || len(frac) > exp {
+		return nil, ErrInvalidAmount
+	}
+	digits := whole + frac + strings.Repeat("0", exp-len(frac))
+	v, err := strconv.ParseUint(digits, 10, 64)
+	if err != nil {
+		if errors.Is(err, strconv.ErrRange) {
+			return nil, ErrOverflow
+		}
+		return nil, ErrInvalidAmount
+	}
+	switch {
+	case neg && v <= uint64(math.MaxInt64)+1:
+		return New(int64(-v), currency), nil
+	case !neg && v <= math.MaxInt64:
+		return New(int64(v), currency), nil
+	default:
+		return nil, ErrOverflow
+	}
+}
+
+// ParseRate reads a positive plain decimal exchange rate such as "0.9215".
+// Fractions, exponents, and signs are rejected so configured rates stay
+// human-auditable.
+func ParseRate(s string) (*big.Rat, error) {
+	s = strings.TrimSpace(s)
+	whole, frac, hasPoint := strings.Cut(s, ".")
+	if whole == "" || (hasPoint && frac == "") || !allDigits(whole) || !allDigits(frac) {
+		return nil, ErrInvalidAmount
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok || r.Sign() <= 0 {
+		return nil, ErrInvalidAmount
+	}
+	return r, nil
+}
+
+func allDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Convert returns m expressed in currency to at rate units of to per unit of
+// m's currency, adjusting for differing minor-unit exponents. The result is
+// rounded down to a whole minor unit.
+func Convert(m *rgsv1.Money, to string, rate *big.Rat) (*rgsv1.Money, error) {
+	if err := Validate(m); err != nil {
+		return nil, err
+	}
+	if !ValidCurrency(to) {
+		return nil, ErrInvalidCurrency
+	}
+	if rate == nil || rate.Sign() <= 0 {
+		return nil, ErrInvalidAmount
+	}
+	v := new(big.Rat).Mul(new(big.Rat).SetInt64(m.AmountMinor), rate)
+	shift := Exponent(to) - Exponent(m.Currency)
+	scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(shift))), nil))
+	if shift >= 0 {
+		v.Mul(v, scale)
+	} else {
+		v.Quo(v, scale)
+	}
+	q := new(big.Int).Quo(v.Num(), v.Denom())
+	if !q.IsInt64() {
+		return nil, ErrOverflow
+	}
+	return New(q.Int64(), to), nil
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// Totals accumulates per-currency sums with overflow checking.
+type Totals map[string]int64
+
+// Add adds amountMinor to currency's running total.
+func (t Totals) Add(currency string, amountMinor int64) error {
+	sum, err := AddMinor(t[currency], amountMinor)
+	if err != nil {
+		return fmt.Errorf("%s total: %w", currency, err)
+	}
+	t[currency] = sum
+	return nil
+}
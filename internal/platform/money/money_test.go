@@ -0,0 +1,182 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestAddSubMinorDetectOverflow(t *testing.T) {
+	if _, err := AddMinor(math.MaxInt64, 1); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("expected overflow on max+1, got=%v", err)
+	}
+	if _, err := AddMinor(math.MinInt64, -1); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("expected overflow on min-1, got=%v", err)
+	}
+	if _, err := SubMinor(0, math.MinInt64); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("expected overflow on 0-min, got=%v", err)
+	}
+	if got, err := SubMinor(-1, math.MinInt64); err != nil || got != math.MaxInt64 {
+		t.Fatalf("expected -1-min=max, got=%d err=%v", got, err)
+	}
+	if _, err := SumMinor(math.MaxInt64-1, 1, 1); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("expected overflow on sum, got=%v", err)
+	}
+	if got, err := SumMinor(5, -3, 10); err != nil || got != 12 {
+		t.Fatalf("unexpected sum=%d err=%v", got, err)
+	}
+}
+
+func TestMoneyArithmeticRejectsMixedCurrency(t *testing.T) {
+	if _, err := Add(New(100, "USD"), New(100, "EUR")); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Fatalf("expected currency mismatch on add, got=%v", err)
+	}
+	if _, err := Compare(New(100, "USD"), New(100, "EUR")); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Fatalf("expected currency mismatch on compare, got=%v", err)
+	}
+	diff, err := Sub(New(100, "USD"), New(250, "USD"))
+	if err != nil || diff.AmountMinor != -150 || diff.Currency != "USD" {
+		t.Fatalf("unexpected diff=%v err=%v", diff, err)
+	}
+	if c, _ := Compare(New(1, "USD"), New(2, "USD")); c != -1 {
+		t.Fatalf("expected -1, got=%d", c)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	if err := ValidatePositive(nil); !errors.Is(err, ErrInvalidAmount) {
+		t.Fatalf("expected invalid nil, got=%v", err)
+	}
+	if err := ValidatePositive(New(1, "usd")); !errors.Is(err, ErrInvalidCurrency) {
+		t.Fatalf("expected invalid currency, got=%v", err)
+	}
+	if err := ValidatePositive(New(0, "USD")); !errors.Is(err, ErrInvalidAmount) {
+		t.Fatalf("expected zero rejected, got=%v", err)
+	}
+	if err := Validate(New(0, "USD")); err != nil {
+		t.Fatalf("expected zero accepted by Validate, got=%v", err)
+	}
+	if err := Validate(New(-1, "USD")); !errors.Is(err, ErrInvalidAmount) {
+		t.Fatalf("expected negative rejected, got=%v", err)
+	}
+}
+
+func TestAllocateConservesMinorUnits(t *testing.T) {
+	shares, err := Allocate(New(100, "USD"), 1, 1, 1)
+	if err != nil {
+		t.Fatalf("allocate err: %v", err)
+	}
+	if shares[0].AmountMinor != 34 || shares[1].AmountMinor != 33 || shares[2].AmountMinor != 33 {
+		t.Fatalf("unexpected shares: %v", shares)
+	}
+
+	shares, err = Allocate(New(5, "USD"), 0, 3, 7)
+	if err != nil {
+		t.Fatalf("allocate err: %v", err)
+	}
+	if shares[0].AmountMinor != 0 || shares[1].AmountMinor+shares[2].AmountMinor != 5 {
+		t.Fatalf("expected zero ratio to get nothing, got=%v", shares)
+	}
+
+	big, err := Split(New(math.MaxInt64, "USD"), 3)
+	if err != nil {
+		t.Fatalf("split err: %v", err)
+	}
+	var total uint64
+	for _, s := range big {
+		total += uint64(s.AmountMinor)
+	}
+	if total != math.MaxInt64 {
+		t.Fatalf("expected split to conserve max amount, got=%d", total)
+	}
+
+	if _, err := Allocate(New(5, "USD"), 0, 0); !errors.Is(err, ErrInvalidAmount) {
+		t.Fatalf("expected zero ratio total rejected, got=%v", err)
+	}
+	if _, err := Allocate(New(5, "USD"), 2, -1); !errors.Is(err, ErrInvalidAmount) {
+		t.Fatalf("expected negative ratio rejected, got=%v", err)
+	}
+}
+
+func TestFormatParseRoundTrip(t *testing.T) {
+	cases := map[string]string{
+		"12.34 USD":                    "12.34 USD",
+		"-0.05 USD":                    "-0.05 USD",
+		"7 USD":                        "7.00 USD",
+		"1.5 USD":                      "1.50 USD",
+		"500 JPY":                      "500 JPY",
+		"1.234 KWD":                    "1.234 KWD",
+		"-92233720368547758.08 USD":    "-92233720368547758.08 USD",
+		"92233720368547758.07 USD":     "92233720368547758.07 USD",
+		"  0.1 EUR ":                   "0.10 EUR",
+		"000000000000000000000042 USD": "42.00 USD",
+	}
+	for in, want := range cases {
+		m, err := Parse(in)
+		if err != nil {
+			t.Fatalf("parse %q: %v", in, err)
+		}
+		if got := Format(m); got != want {
+			t.Fatalf("parse/format %q: expected %q got %q", in, want, got)
+		}
+	}
+
+	for _, bad := range []string{"12.345 USD", "1.5 JPY", "12.34", "12.34 usd", ".5 USD", "1e3 USD", "+1 USD"} {
+		if _, err := Parse(bad); err == nil {
+			t.Fatalf("expected error for %q", bad)
+		}
+	}
+	if _, err := Parse("92233720368547758.08 USD"); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("expected overflow, got=%v", err)
+	}
+}
+
+func TestTotalsTrackCurrenciesSeparately(t *testing.T) {
+	totals := Totals{}
+	_ = totals.Add("USD", 100)
+	_ = totals.Add("EUR", 50)
+	_ = totals.Add("USD", 25)
+	if totals["USD"] != 125 || totals["EUR"] != 50 {
+		t.Fatalf("unexpected totals: %v", totals)
+	}
+	if err := totals.Add("USD", math.MaxInt64); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("expected overflow, got=%v", err)
+	}
+	if totals["USD"] != 125 {
+		t.Fatalf("expected total unchanged after overflow, got=%d", totals["USD"])
+	}
+}
+
+func TestConvertRoundsDownAcrossExponents(t *testing.T) {
+	rate, err := ParseRate("0.9215")
+	if err != nil {
+		t.Fatalf("parse rate: %v", err)
+	}
+	got, err := Convert(New(1001, "USD"), "EUR", rate)
+	if err != nil || got.AmountMinor != 922 || got.Currency != "EUR" {
+		t.Fatalf("expected 9.22 EUR, got=%v err=%v", got, err)
+	}
+
+	jpy, _ := ParseRate("151.37")
+	if got, _ := Convert(New(250, "USD"), "JPY", jpy); got.AmountMinor != 378 {
+		t.Fatalf("expected 378 JPY, got=%d", got.AmountMinor)
+	}
+	usd, _ := ParseRate("0.0066")
+	if got, _ := Convert(New(1000, "JPY"), "USD", usd); got.AmountMinor != 660 {
+		t.Fatalf("expected 6.60 USD, got=%d", got.AmountMinor)
+	}
+	kwd, _ := ParseRate("0.307")
+	if got, _ := Convert(New(100, "USD"), "KWD", kwd); got.AmountMinor != 307 {
+		t.Fatalf("expected 0.307 KWD, got=%d", got.AmountMinor)
+	}
+
+	double, _ := ParseRate("2")
+	if _, err := Convert(New(math.MaxInt64, "USD"), "EUR", double); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("expected overflow, got=%v", err)
+	}
+	for _, bad := range []string{"", "0", "0.0", "-1", "1/3", "1e2", "1.", ".5", "abc"} {
+		if _, err := ParseRate(bad); err == nil {
+			t.Fatalf("expected rate %q rejected", bad)
+		}
+	}
+}
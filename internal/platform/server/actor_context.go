@@ -21,9 +21,19 @@ func actorTypeFromString(v string) rgsv1.ActorType {
 	}
 }
 
+// resolveActor returns the caller, preferring the authenticated actor on
+// ctx. It also records on meta the operator impersonating the caller, if
+// any, replacing whatever the client sent, so audit entries written from
+// meta name both identities.
 func resolveActor(ctx context.Context, meta *rgsv1.RequestMeta) (*rgsv1.Actor, string) {
+	if meta != nil {
+		meta.Impersonator = nil
+	}
 	if ctx != nil {
 		if a, ok := platformauth.ActorFromContext(ctx); ok {
+			if meta != nil && a.Impersonated() {
+				meta.Impersonator = &rgsv1.Actor{ActorId: a.ImpersonatorID, ActorType: actorTypeFromString(a.ImpersonatorType)}
+			}
 			ctxActor := &rgsv1.Actor{ActorId: a.ID, ActorType: actorTypeFromString(a.Type)}
 			if ctxActor.ActorId == "" || ctxActor.ActorType == rgsv1.ActorType_ACTOR_TYPE_UNSPECIFIED {
 				return nil, "actor context is invalid"
@@ -44,3 +54,12 @@ func resolveActor(ctx context.Context, meta *rgsv1.RequestMeta) (*rgsv1.Actor, s
 	}
 	return meta.Actor, ""
 }
+
+// impersonationAuthContext is the audit auth context of a call made under
+// impersonation, naming the operator behind the recorded actor.
+func impersonationAuthContext(meta *rgsv1.RequestMeta) string {
+	if meta.GetImpersonator() == nil {
+		return ""
+	}
+	return "impersonator=" + meta.Impersonator.ActorType.String() + ":" + meta.Impersonator.ActorId
+}
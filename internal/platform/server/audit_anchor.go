@@ -0,0 +1,214 @@
+package server
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	"google.golang.org/protobuf/proto"
+)
+
+// SetTimestamper configures the time-stamp authority that anchors closed
+// partition days.
+func (s *AuditService) SetTimestamper(t AuditTimestamper) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.timestamper = t
+}
+
+func (s *AuditService) auditTimestamper() AuditTimestamper {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.timestamper
+}
+
+func cloneAuditChainAnchor(in *rgsv1.AuditChainAnchor) *rgsv1.AuditChainAnchor {
+	if in == nil {
+		return nil
+	}
+	cp, _ := proto.Clone(in).(*rgsv1.AuditChainAnchor)
+	return cp
+}
+
+// partitionChainHead returns the final chain hash of a partition day and
+// its event count. In memory every store keeps its own chain, so with more
+// than one store holding events the head is the SHA-256 of their heads in
+// store order.
+func (s *AuditService) partitionChainHead(ctx context.Context, partitionDay string) (string, int64, error) {
+	if s.db != nil {
+		return auditPartitionChainHeadFromDB(ctx, s.db, partitionDay)
+	}
+	var (
+		heads []string
+		count int64
+	)
+	for _, st := range s.mirrors() {
+		head := ""
+		for _, e := range st.Events() {
+			if e.PartitionDay == partitionDay {
+				head = e.HashCurr
+				count++
+			}
+		}
+		if head != "" {
+			heads = append(heads, head)
+		}
+	}
+	switch len(heads) {
+	case 0:
+		return "", 0, nil
+	case 1:
+		return heads[0], count, nil
+	default:
+		return sha256Hex([]byte(strings.Join(heads, "\n"))), count, nil
+	}
+}
+
+func (s *AuditService) loadChainAnchor(ctx context.Context, partitionDay string) (*rgsv1.AuditChainAnchor, error) {
+	if s.db != nil {
+		return getAuditChainAnchorFromDB(ctx, s.db, partitionDay)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return cloneAuditChainAnchor(s.anchors[partitionDay]), nil
+}
+
+func (s *AuditService) storeChainAnchor(ctx context.Context, anchor *rgsv1.AuditChainAnchor) error {
+	if s.db != nil {
+		if err := insertAuditChainAnchorDB(ctx, s.db, anchor); err != nil {
+			return err
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.anchors[anchor.PartitionDay] = cloneAuditChainAnchor(anchor)
+	return nil
+}
+
+// anchorPartition timestamps the final chain hash of a closed partition day
+// with the configured TSA and records the token. A day is anchored once;
+// later calls return the recorded anchor.
+func (s *AuditService) anchorPartition(ctx context.Context, meta *rgsv1.RequestMeta, partitionDay string) (*rgsv1.AuditChainAnchor, rgsv1.ResultCode, string) {
+	day, err := time.Parse(gamingDayLayout, partitionDay)
+	if err != nil {
+		return nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "partition_day must be YYYY-MM-DD"
+	}
+	if !s.now().After(gamingCalendarFor("").Window(day).end) {
+		return nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "partition day is not closed"
+	}
+	tsa := s.auditTimestamper()
+	if tsa == nil {
+		return nil, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit anchoring not configured"
+	}
+
+	s.anchorMu.Lock()
+	defer s.anchorMu.Unlock()
+
+	existing, err := s.loadChainAnchor(ctx, partitionDay)
+	if err != nil {
+		return nil, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable"
+	}
+	if existing != nil {
+		return existing, rgsv1.ResultCode_RESULT_CODE_OK, ""
+	}
+
+	if archived, err := s.partitionArchived(ctx, partitionDay); err != nil {
+		return nil, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable"
+	} else if archived {
+		return nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "partition day is archived"
+	}
+
+	head, count, err := s.partitionChainHead(ctx, partitionDay)
+	if err != nil {
+		return nil, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable"
+	}
+	if head == "" {
+		return nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "partition day has no audit events"
+	}
+	digest, err := hex.DecodeString(head)
+	if err != nil || len(digest) != 32 {
+		return nil, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit chain head is malformed"
+	}
+	ts, err := tsa.Timestamp(ctx, digest)
+	if err != nil {
+		reason := "audit anchoring failed: " + tsa.Name()
+		after, _ := json.Marshal(map[string]string{"tsa_url": tsa.Name(), "chain_head": head, "error": err.Error()})
+		_ = s.appendAudit(meta, partitionDay, "anchor_audit_partition", []byte(`{}`), after, audit.ResultError, reason)
+		return nil, rgsv1.ResultCode_RESULT_CODE_ERROR, reason
+	}
+	anchor := &rgsv1.AuditChainAnchor{
+		PartitionDay:    partitionDay,
+		EventCount:      count,
+		ChainHead:       head,
+		HashAlg:         "sha256",
+		TsaUrl:          tsa.Name(),
+		TimestampToken:  ts.Token,
+		TsaGenTime:      ts.GenTime.Format(time.RFC3339Nano),
+		TsaSerialNumber: ts.SerialNumber,
+		AnchoredAt:      s.now().Format(time.RFC3339Nano),
+	}
+	if err := s.storeChainAnchor(ctx, anchor); err != nil {
+		return nil, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable"
+	}
+	after, _ := json.Marshal(map[string]any{
+		"chain_head":        anchor.ChainHead,
+		"event_count":       anchor.EventCount,
+		"tsa_url":           anchor.TsaUrl,
+		"tsa_gen_time":      anchor.TsaGenTime,
+		"tsa_serial_number": anchor.TsaSerialNumber,
+		"token_sha256":      sha256Hex(anchor.TimestampToken),
+	})
+	if err := s.appendAudit(meta, partitionDay, "anchor_audit_partition", []byte(`{}`), after, audit.ResultSuccess, ""); err != nil {
+		return nil, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable"
+	}
+	return cloneAuditChainAnchor(anchor), rgsv1.ResultCode_RESULT_CODE_OK, ""
+}
+
+// AnchorJob anchors the last closed partition day once it closes. Days
+// missed while the job was not running are anchored with
+// AnchorAuditPartition.
+func (s *AuditService) AnchorJob() JobFunc {
+	return func(ctx context.Context, _ string) (string, error) {
+		day := gamingCalendarFor("").LastClosedGamingDay(s.now())
+		existing, err := s.loadChainAnchor(ctx, day)
+		if err != nil {
+			return "", fmt.Errorf("audit anchor lookup failed partition_day=%s: %w", day, err)
+		}
+		if existing != nil {
+			return "", nil
+		}
+		anchor, code, reason := s.anchorPartition(ctx, nil, day)
+		if code == rgsv1.ResultCode_RESULT_CODE_INVALID {
+			// Nothing was audited that day.
+			return "", nil
+		}
+		if code != rgsv1.ResultCode_RESULT_CODE_OK {
+			return "", fmt.Errorf("audit anchor failed partition_day=%s: %s", day, reason)
+		}
+		return fmt.Sprintf("audit partition anchored partition_day=%s chain_head=%s", day, anchor.ChainHead), nil
+	}
+}
+
+func (s *AuditService) AnchorAuditPartition(ctx context.Context, req *rgsv1.AnchorAuditPartitionRequest) (*rgsv1.AnchorAuditPartitionResponse, error) {
+	if req == nil {
+		req = &rgsv1.AnchorAuditPartitionRequest{}
+	}
+	if ok, reason := s.authorize(ctx, req.Meta); !ok {
+		_ = s.appendAudit(req.Meta, req.PartitionDay, "anchor_audit_partition", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		return &rgsv1.AnchorAuditPartitionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	partitionDay := strings.TrimSpace(req.PartitionDay)
+	if partitionDay == "" {
+		partitionDay = gamingCalendarFor("").LastClosedGamingDay(s.now())
+	}
+	anchor, code, reason := s.anchorPartition(ctx, req.Meta, partitionDay)
+	return &rgsv1.AnchorAuditPartitionResponse{Meta: s.responseMeta(req.Meta, code, reason), Anchor: anchor}, nil
+}
@@ -0,0 +1,246 @@
+package server
+
+import (
+	"context"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+// fakeTSTInfo adds the optional trailing tsa name so parsing must tolerate
+// fields it does not model.
+type fakeTSTInfo struct {
+	Version        int
+	Policy         asn1.ObjectIdentifier
+	MessageImprint tsaMessageImprint
+	SerialNumber   *big.Int
+	GenTime        time.Time `asn1:"generalized"`
+	Nonce          *big.Int  `asn1:"optional"`
+	TSA            asn1.RawValue
+}
+
+type fakeSignedData struct {
+	Version          int
+	DigestAlgorithms asn1.RawValue
+	EncapContentInfo cmsEncapContentInfo
+	SignerInfos      asn1.RawValue
+}
+
+func fakeTimestampResponse(t *testing.T, imprint tsaMessageImprint, nonce *big.Int, genTime time.Time, status int) []byte {
+	t.Helper()
+	emptySet := asn1.RawValue{FullBytes: []byte{0x31, 0x00}}
+	info, err := asn1.Marshal(fakeTSTInfo{
+		Version:        1,
+		Policy:         asn1.ObjectIdentifier{1, 2, 3, 4},
+		MessageImprint: imprint,
+		SerialNumber:   big.NewInt(0x2a),
+		GenTime:        genTime,
+		Nonce:          nonce,
+		TSA:            asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: []byte{0x05, 0x00}},
+	})
+	if err != nil {
+		t.Fatalf("marshal tst info: %v", err)
+	}
+	sd, err := asn1.Marshal(fakeSignedData{
+		Version:          3,
+		DigestAlgorithms: emptySet,
+		EncapContentInfo: cmsEncapContentInfo{EContentType: oidTSTInfo, EContent: info},
+		SignerInfos:      emptySet,
+	})
+	if err != nil {
+		t.Fatalf("marshal signed data: %v", err)
+	}
+	token, err := asn1.Marshal(cmsContentInfo{ContentType: oidSignedData, Content: asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: sd}})
+	if err != nil {
+		t.Fatalf("marshal content info: %v", err)
+	}
+	resp := tsaResponse{Status: tsaStatusInfo{Status: status}}
+	if status <= 1 {
+		resp.TimeStampToken = asn1.RawValue{FullBytes: token}
+	}
+	out, err := asn1.Marshal(resp)
+	if err != nil {
+		t.Fatalf("marshal response: %v", err)
+	}
+	return out
+}
+
+// newFakeTSA answers timestamp queries, echoing the request's imprint and
+// nonce unless tamper rewrites them first.
+func newFakeTSA(t *testing.T, genTime time.Time, tamper func(*tsaRequest)) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if r.Header.Get("Content-Type") != "application/timestamp-query" {
+			http.Error(w, "bad content type", http.StatusBadRequest)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		var req tsaRequest
+		if _, err := asn1.Unmarshal(body, &req); err != nil || req.Version != 1 || !req.CertReq {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		if tamper != nil {
+			tamper(&req)
+		}
+		w.Header().Set("Content-Type", "application/timestamp-reply")
+		_, _ = w.Write(fakeTimestampResponse(t, req.MessageImprint, req.Nonce, genTime, 0))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &calls
+}
+
+func TestAuditAnchorPartitionWithTSA(t *testing.T) {
+	ledger := seedAuditPartition(t, time.Date(2026, 3, 10, 10, 0, 0, 0, time.UTC))
+	svc := NewAuditService(ledgerFixedClock{now: time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC)}, nil, ledger.AuditStore)
+	genTime := time.Date(2026, 3, 11, 9, 0, 1, 0, time.UTC)
+	tsa, calls := newFakeTSA(t, genTime, nil)
+	svc.SetTimestamper(RFC3161Timestamper{URL: tsa.URL})
+	ctx := context.Background()
+	opMeta := meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+
+	resp, err := svc.AnchorAuditPartition(ctx, &rgsv1.AnchorAuditPartitionRequest{Meta: opMeta})
+	if err != nil {
+		t.Fatalf("anchor err: %v", err)
+	}
+	anchor := resp.Anchor
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || anchor.PartitionDay != "2026-03-10" || anchor.EventCount != 2 {
+		t.Fatalf("unexpected anchor: meta=%+v anchor=%+v", resp.Meta, anchor)
+	}
+	events := auditEvents(ledger.AuditStore)
+	if anchor.ChainHead != events[len(events)-1].HashCurr || anchor.HashAlg != "sha256" || anchor.TsaUrl != tsa.URL {
+		t.Fatalf("anchor does not cover the chain head: %+v", anchor)
+	}
+	if anchor.TsaGenTime != genTime.Format(time.RFC3339Nano) || anchor.TsaSerialNumber != "2a" || len(anchor.TimestampToken) == 0 {
+		t.Fatalf("unexpected token details: %+v", anchor)
+	}
+
+	again, _ := svc.AnchorAuditPartition(ctx, &rgsv1.AnchorAuditPartitionRequest{Meta: opMeta, PartitionDay: "2026-03-10"})
+	if again.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || again.Anchor.AnchoredAt != anchor.AnchoredAt || calls.Load() != 1 {
+		t.Fatalf("expected recorded anchor without a second TSA call: calls=%d meta=%+v", calls.Load(), again.Meta)
+	}
+	var audited int
+	for _, ev := range auditEvents(svc.AuditStore) {
+		if ev.Action == "anchor_audit_partition" && ev.ObjectID == "2026-03-10" && ev.Result == "success" {
+			audited++
+		}
+	}
+	if audited != 1 {
+		t.Fatalf("expected one anchor audit event, got %d", audited)
+	}
+}
+
+func TestAuditAnchorPartitionValidation(t *testing.T) {
+	svc := NewAuditService(ledgerFixedClock{now: time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC)}, nil)
+	ctx := context.Background()
+	opMeta := meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+
+	resp, _ := svc.AnchorAuditPartition(ctx, &rgsv1.AnchorAuditPartitionRequest{Meta: opMeta, PartitionDay: "2026-03-10"})
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_ERROR || resp.Meta.GetDenialReason() != "audit anchoring not configured" {
+		t.Fatalf("expected unconfigured error, got %+v", resp.Meta)
+	}
+	tsa, _ := newFakeTSA(t, time.Now().UTC(), nil)
+	svc.SetTimestamper(RFC3161Timestamper{URL: tsa.URL})
+	cases := map[string]string{
+		"2026/03/10": "partition_day must be YYYY-MM-DD",
+		"2026-03-11": "partition day is not closed",
+		"2026-03-09": "partition day has no audit events",
+	}
+	for day, want := range cases {
+		resp, _ := svc.AnchorAuditPartition(ctx, &rgsv1.AnchorAuditPartitionRequest{Meta: opMeta, PartitionDay: day})
+		if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID || resp.Meta.GetDenialReason() != want {
+			t.Fatalf("day=%s expected %q, got %+v", day, want, resp.Meta)
+		}
+	}
+	player, _ := svc.AnchorAuditPartition(ctx, &rgsv1.AnchorAuditPartitionRequest{Meta: meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "")})
+	if player.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected player denial, got %+v", player.Meta)
+	}
+}
+
+func TestAuditAnchorRejectsMismatchedToken(t *testing.T) {
+	cases := map[string]func(*tsaRequest){
+		"imprint": func(r *tsaRequest) { r.MessageImprint.HashedMessage = make([]byte, 32) },
+		"nonce":   func(r *tsaRequest) { r.Nonce = big.NewInt(7) },
+	}
+	for name, tamper := range cases {
+		ledger := seedAuditPartition(t, time.Date(2026, 3, 10, 10, 0, 0, 0, time.UTC))
+		svc := NewAuditService(ledgerFixedClock{now: time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC)}, nil, ledger.AuditStore)
+		tsa, _ := newFakeTSA(t, time.Now().UTC(), tamper)
+		svc.SetTimestamper(RFC3161Timestamper{URL: tsa.URL})
+
+		resp, _ := svc.AnchorAuditPartition(context.Background(), &rgsv1.AnchorAuditPartitionRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")})
+		if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_ERROR || resp.Anchor != nil {
+			t.Fatalf("%s: expected rejected token, got %+v", name, resp.Meta)
+		}
+		if existing, _ := svc.loadChainAnchor(context.Background(), "2026-03-10"); existing != nil {
+			t.Fatalf("%s: rejected token was recorded", name)
+		}
+	}
+}
+
+func TestParseTimestampResponseRejectsRefusal(t *testing.T) {
+	digest, _ := hex.DecodeString(sha256Hex([]byte("head")))
+	imprint := tsaMessageImprint{HashAlgorithm: pkix.AlgorithmIdentifier{Algorithm: oidSHA256}, HashedMessage: digest}
+	raw := fakeTimestampResponse(t, imprint, nil, time.Now().UTC(), 2)
+	if _, err := parseTimestampResponse(raw, digest, nil); err == nil {
+		t.Fatalf("expected refusal error")
+	}
+}
+
+func TestAuditAnchorJobAnchorsLastClosedDayOnce(t *testing.T) {
+	ledger := seedAuditPartition(t, time.Date(2026, 3, 10, 10, 0, 0, 0, time.UTC))
+	svc := NewAuditService(ledgerFixedClock{now: time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC)}, nil, ledger.AuditStore)
+	tsa, calls := newFakeTSA(t, time.Now().UTC(), nil)
+	svc.SetTimestamper(RFC3161Timestamper{URL: tsa.URL})
+	job := svc.AnchorJob()
+
+	if msg, err := job(context.Background(), "run-1"); err != nil || msg == "" {
+		t.Fatalf("first run: msg=%q err=%v", msg, err)
+	}
+	if msg, err := job(context.Background(), "run-2"); err != nil || msg != "" || calls.Load() != 1 {
+		t.Fatalf("second run should be a no-op: msg=%q err=%v calls=%d", msg, err, calls.Load())
+	}
+
+	empty := NewAuditService(ledgerFixedClock{now: time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC)}, nil)
+	empty.SetTimestamper(RFC3161Timestamper{URL: tsa.URL})
+	if msg, err := empty.AnchorJob()(context.Background(), "run-3"); err != nil || msg != "" {
+		t.Fatalf("empty day should be skipped: msg=%q err=%v", msg, err)
+	}
+}
+
+func TestWebhookAuditCorruptionNotifierPostsEvent(t *testing.T) {
+	var got AuditCorruptionEvent
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("unexpected content type %q", r.Header.Get("Content-Type"))
+		}
+		_ = json.NewDecoder(r.Body).Decode(&got)
+	}))
+	defer srv.Close()
+	ev := AuditCorruptionEvent{PartitionDay: "2026-03-10", Reason: "audit chain verification failed", Detail: "audit chain mismatch", DetectedAt: time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC)}
+	if err := (WebhookAuditCorruptionNotifier{URL: srv.URL}).NotifyAuditCorruption(context.Background(), ev); err != nil {
+		t.Fatalf("notify: %v", err)
+	}
+	if got.PartitionDay != ev.PartitionDay || got.Reason != ev.Reason || !got.DetectedAt.Equal(ev.DetectedAt) {
+		t.Fatalf("unexpected webhook body: %+v", got)
+	}
+
+	svc := NewAuditService(ledgerFixedClock{now: ev.DetectedAt}, nil)
+	svc.SetChainMonitor(2, func(bool) { t.Fatalf("in-memory mode must not report chain validity") }, nil)
+	if summary, err := svc.ChainVerifyJob()(context.Background(), ""); err != nil || summary != "" {
+		t.Fatalf("expected in-memory chain monitor to be a no-op, summary=%q err=%v", summary, err)
+	}
+}
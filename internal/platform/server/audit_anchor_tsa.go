@@ -0,0 +1,201 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"time"
+)
+
+// AuditTimestamp is a trusted timestamp token over a digest.
+type AuditTimestamp struct {
+	// Token is the DER CMS SignedData, verifiable offline with
+	// `openssl ts -verify`.
+	Token        []byte
+	GenTime      time.Time
+	SerialNumber string
+}
+
+// AuditTimestamper obtains trusted timestamps for SHA-256 digests.
+type AuditTimestamper interface {
+	Name() string
+	Timestamp(ctx context.Context, digest []byte) (AuditTimestamp, error)
+}
+
+var (
+	oidSHA256     = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
+	oidSignedData = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}
+	oidTSTInfo    = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 16, 1, 4}
+)
+
+type tsaMessageImprint struct {
+	HashAlgorithm pkix.AlgorithmIdentifier
+	HashedMessage []byte
+}
+
+type tsaRequest struct {
+	Version        int
+	MessageImprint tsaMessageImprint
+	ReqPolicy      asn1.ObjectIdentifier `asn1:"optional"`
+	Nonce          *big.Int              `asn1:"optional"`
+	CertReq        bool                  `asn1:"optional,default:false"`
+}
+
+type tsaStatusInfo struct {
+	Status       int
+	StatusString asn1.RawValue  `asn1:"optional"`
+	FailInfo     asn1.BitString `asn1:"optional"`
+}
+
+type tsaResponse struct {
+	Status         tsaStatusInfo
+	TimeStampToken asn1.RawValue `asn1:"optional"`
+}
+
+type cmsContentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"explicit,tag:0"`
+}
+
+type cmsEncapContentInfo struct {
+	EContentType asn1.ObjectIdentifier
+	EContent     []byte `asn1:"explicit,optional,tag:0"`
+}
+
+// cmsSignedData stops after the encapsulated content; certificates and
+// signer infos are left for offline verification.
+type cmsSignedData struct {
+	Version          int
+	DigestAlgorithms asn1.RawValue
+	EncapContentInfo cmsEncapContentInfo
+}
+
+type tsaAccuracy struct {
+	Seconds int `asn1:"optional"`
+	Millis  int `asn1:"optional,tag:0"`
+	Micros  int `asn1:"optional,tag:1"`
+}
+
+type tsaTSTInfo struct {
+	Version        int
+	Policy         asn1.ObjectIdentifier
+	MessageImprint tsaMessageImprint
+	SerialNumber   *big.Int
+	GenTime        time.Time   `asn1:"generalized"`
+	Accuracy       tsaAccuracy `asn1:"optional"`
+	Ordering       bool        `asn1:"optional,default:false"`
+	Nonce          *big.Int    `asn1:"optional"`
+}
+
+// RFC3161Timestamper requests timestamps from a time-stamp authority over
+// HTTP (RFC 3161 section 3.4). The token must echo the submitted digest and
+// nonce; the TSA's signature is not checked here.
+type RFC3161Timestamper struct {
+	URL string
+	// Policy is sent as reqPolicy when set.
+	Policy asn1.ObjectIdentifier
+	Client *http.Client
+}
+
+func (t RFC3161Timestamper) Name() string {
+	return t.URL
+}
+
+func (t RFC3161Timestamper) Timestamp(ctx context.Context, digest []byte) (AuditTimestamp, error) {
+	if t.URL == "" {
+		return AuditTimestamp{}, errors.New("tsa url is not configured")
+	}
+	if len(digest) != 32 {
+		return AuditTimestamp{}, errors.New("tsa digest must be sha-256")
+	}
+	nonce, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 64))
+	if err != nil {
+		return AuditTimestamp{}, err
+	}
+	body, err := asn1.Marshal(tsaRequest{
+		Version: 1,
+		MessageImprint: tsaMessageImprint{
+			HashAlgorithm: pkix.AlgorithmIdentifier{Algorithm: oidSHA256, Parameters: asn1.NullRawValue},
+			HashedMessage: digest,
+		},
+		ReqPolicy: t.Policy,
+		Nonce:     nonce,
+		CertReq:   true,
+	})
+	if err != nil {
+		return AuditTimestamp{}, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.URL, bytes.NewReader(body))
+	if err != nil {
+		return AuditTimestamp{}, err
+	}
+	req.Header.Set("Content-Type", "application/timestamp-query")
+	req.Header.Set("Accept", "application/timestamp-reply")
+	client := t.Client
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return AuditTimestamp{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return AuditTimestamp{}, fmt.Errorf("tsa request rejected: status=%d", resp.StatusCode)
+	}
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return AuditTimestamp{}, err
+	}
+	return parseTimestampResponse(raw, digest, nonce)
+}
+
+// parseTimestampResponse extracts the token from a TimeStampResp and checks
+// that it covers digest and echoes nonce.
+func parseTimestampResponse(raw, digest []byte, nonce *big.Int) (AuditTimestamp, error) {
+	var resp tsaResponse
+	if rest, err := asn1.Unmarshal(raw, &resp); err != nil || len(rest) > 0 {
+		return AuditTimestamp{}, errors.New("tsa response is malformed")
+	}
+	// 0 is granted, 1 is grantedWithMods.
+	if resp.Status.Status != 0 && resp.Status.Status != 1 {
+		return AuditTimestamp{}, fmt.Errorf("tsa refused request: status=%d", resp.Status.Status)
+	}
+	if len(resp.TimeStampToken.FullBytes) == 0 {
+		return AuditTimestamp{}, errors.New("tsa response has no token")
+	}
+	var ci cmsContentInfo
+	if _, err := asn1.Unmarshal(resp.TimeStampToken.FullBytes, &ci); err != nil || !ci.ContentType.Equal(oidSignedData) {
+		return AuditTimestamp{}, errors.New("tsa token is not cms signed data")
+	}
+	var sd cmsSignedData
+	if _, err := asn1.Unmarshal(ci.Content.Bytes, &sd); err != nil || !sd.EncapContentInfo.EContentType.Equal(oidTSTInfo) {
+		return AuditTimestamp{}, errors.New("tsa token does not carry tst info")
+	}
+	var info tsaTSTInfo
+	if _, err := asn1.Unmarshal(sd.EncapContentInfo.EContent, &info); err != nil {
+		return AuditTimestamp{}, errors.New("tsa tst info is malformed")
+	}
+	if !info.MessageImprint.HashAlgorithm.Algorithm.Equal(oidSHA256) || !bytes.Equal(info.MessageImprint.HashedMessage, digest) {
+		return AuditTimestamp{}, errors.New("tsa token message imprint mismatch")
+	}
+	if nonce != nil && (info.Nonce == nil || info.Nonce.Cmp(nonce) != 0) {
+		return AuditTimestamp{}, errors.New("tsa token nonce mismatch")
+	}
+	serial := ""
+	if info.SerialNumber != nil {
+		serial = info.SerialNumber.Text(16)
+	}
+	return AuditTimestamp{
+		Token:        resp.TimeStampToken.FullBytes,
+		GenTime:      info.GenTime.UTC(),
+		SerialNumber: serial,
+	}, nil
+}
@@ -0,0 +1,138 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+// AuditCorruptionEvent describes a partition day that failed background
+// chain verification for the first time.
+type AuditCorruptionEvent struct {
+	PartitionDay string    `json:"partition_day"`
+	Reason       string    `json:"reason"`
+	Detail       string    `json:"detail"`
+	DetectedAt   time.Time `json:"detected_at"`
+}
+
+// AuditCorruptionNotifier tells security staff that the audit chain no
+// longer verifies.
+type AuditCorruptionNotifier interface {
+	NotifyAuditCorruption(ctx context.Context, ev AuditCorruptionEvent) error
+}
+
+// WebhookAuditCorruptionNotifier posts each event as JSON to URL.
+type WebhookAuditCorruptionNotifier struct {
+	URL    string
+	Client *http.Client
+}
+
+func (n WebhookAuditCorruptionNotifier) NotifyAuditCorruption(ctx context.Context, ev AuditCorruptionEvent) error {
+	body, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	return postNotificationJSON(ctx, n.Client, n.URL, body)
+}
+
+// SetChainMonitor configures ChainVerifyJob: how many partition days back
+// from the current one it verifies, a callback with the overall result of
+// each run, and an optional notifier for newly detected corruption.
+func (s *AuditService) SetChainMonitor(days int, onVerified func(valid bool), notifier AuditCorruptionNotifier) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.chainVerifyDays = days
+	s.onChainVerified = onVerified
+	s.corruptionNotifier = notifier
+}
+
+func (s *AuditService) chainMonitor() (int, func(bool), AuditCorruptionNotifier) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.chainVerifyDays, s.onChainVerified, s.corruptionNotifier
+}
+
+// markCorrupt records day as corrupt and reports whether it was not
+// already.
+func (s *AuditService) markCorrupt(day string, corrupt bool) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !corrupt {
+		delete(s.corruptDays, day)
+		return false
+	}
+	if s.corruptDays[day] {
+		return false
+	}
+	s.corruptDays[day] = true
+	return true
+}
+
+// ChainVerifyJob re-verifies the hash chain and anchored head of the most
+// recent partition days. The first failure seen for a day is audited as
+// audit_chain_corruption_detected and sent to the notifier; the job keeps
+// failing, without repeating the alert, until the day verifies again.
+func (s *AuditService) ChainVerifyJob() JobFunc {
+	return func(ctx context.Context, _ string) (string, error) {
+		days, onVerified, notifier := s.chainMonitor()
+		if s.db == nil || days <= 0 {
+			return "", nil
+		}
+		today, err := time.Parse(gamingDayLayout, auditPartitionDay(s.now()))
+		if err != nil {
+			return "", err
+		}
+		var (
+			corrupt []string
+			errs    []error
+		)
+		for i := 0; i < days; i++ {
+			day := today.AddDate(0, 0, -i).Format(gamingDayLayout)
+			reason := "audit chain verification failed"
+			err := verifyAuditChainFromDB(ctx, s.db, day)
+			if err == nil {
+				reason = "audit chain does not match anchored head"
+				err = verifyAuditChainAnchorsFromDB(ctx, s.db, day)
+			}
+			if err != nil && !errors.Is(err, errAuditChainMismatch) {
+				// The database, not the chain, failed; say nothing about
+				// validity.
+				return "", fmt.Errorf("audit chain verification unavailable partition_day=%s: %w", day, err)
+			}
+			if !s.markCorrupt(day, err != nil) {
+				if err != nil {
+					corrupt = append(corrupt, day)
+				}
+				continue
+			}
+			corrupt = append(corrupt, day)
+			ev := AuditCorruptionEvent{PartitionDay: day, Reason: reason, Detail: err.Error(), DetectedAt: s.now()}
+			after, _ := json.Marshal(ev)
+			if auditErr := s.appendAudit(nil, day, "audit_chain_corruption_detected", []byte(`{}`), after, audit.ResultError, reason); auditErr != nil {
+				errs = append(errs, fmt.Errorf("audit corruption event: %w", auditErr))
+			}
+			if notifier != nil {
+				if notifyErr := notifier.NotifyAuditCorruption(ctx, ev); notifyErr != nil {
+					errs = append(errs, fmt.Errorf("audit corruption notification: %w", notifyErr))
+				}
+			}
+		}
+		if onVerified != nil {
+			onVerified(len(corrupt) == 0)
+		}
+		if len(corrupt) > 0 {
+			errs = append([]error{fmt.Errorf("audit chain corrupt partition_days=%s", strings.Join(corrupt, ","))}, errs...)
+			return "", errors.Join(errs...)
+		}
+		return fmt.Sprintf("audit chain verified partition_days=%d", days), nil
+	}
+}
@@ -0,0 +1,295 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrAuditExportObjectConflict reports that a write-once sink already holds
+// an object under the key with different content.
+var ErrAuditExportObjectConflict = errors.New("audit export object exists with different content")
+
+// AuditExportSink stores the objects of a partition export. Sinks are
+// write-once: an existing object with identical content is accepted so a
+// failed export can be retried, and one with different content is an
+// ErrAuditExportObjectConflict.
+type AuditExportSink interface {
+	Name() string
+	PutObject(ctx context.Context, key, contentType string, body []byte) error
+}
+
+// DirectoryAuditExportSink writes objects under Dir, keyed by their slash
+// separated key, and leaves them read-only.
+type DirectoryAuditExportSink struct {
+	Dir string
+}
+
+func (d DirectoryAuditExportSink) Name() string {
+	return "dir:" + d.Dir
+}
+
+func (d DirectoryAuditExportSink) PutObject(_ context.Context, key, _ string, body []byte) error {
+	if strings.TrimSpace(d.Dir) == "" {
+		return errors.New("sink directory is not configured")
+	}
+	path := filepath.Join(d.Dir, filepath.FromSlash(key))
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, body, 0o440); err != nil {
+		return err
+	}
+	defer os.Remove(tmp)
+	// Link refuses to replace an existing file, unlike Rename.
+	err := os.Link(tmp, path)
+	if errors.Is(err, os.ErrExist) {
+		existing, readErr := os.ReadFile(path)
+		if readErr != nil {
+			return readErr
+		}
+		if !bytes.Equal(existing, body) {
+			return fmt.Errorf("%s: %w", key, ErrAuditExportObjectConflict)
+		}
+		return nil
+	}
+	return err
+}
+
+// AuditExportConfig controls where partition exports go and the ed25519
+// key that signs their manifests.
+type AuditExportConfig struct {
+	SignerKID  string
+	SigningKey ed25519.PrivateKey
+	Sinks      []AuditExportSink
+}
+
+func (s *AuditService) SetExportConfig(cfg AuditExportConfig) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.exportCfg = cfg
+}
+
+func (s *AuditService) exportConfig() AuditExportConfig {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.exportCfg
+}
+
+// auditExportManifest is signed as serialized. It carries no timestamps so
+// a retried export produces identical objects.
+type auditExportManifest struct {
+	PartitionDay    string `json:"partition_day"`
+	EventCount      int64  `json:"event_count"`
+	ChainVerified   bool   `json:"chain_verified"`
+	FirstHashPrev   string `json:"first_hash_prev"`
+	LastHashCurr    string `json:"last_hash_curr"`
+	EventsObject    string `json:"events_object"`
+	EventsSHA256    string `json:"events_sha256"`
+	EventsSizeBytes int64  `json:"events_size_bytes"`
+	SignerKID       string `json:"signer_kid"`
+	SignatureAlg    string `json:"signature_alg"`
+}
+
+func auditExportPrefix(partitionDay string) string {
+	return "audit/" + partitionDay + "/"
+}
+
+func cloneAuditPartitionExport(in *rgsv1.AuditPartitionExport) *rgsv1.AuditPartitionExport {
+	if in == nil {
+		return nil
+	}
+	cp, _ := proto.Clone(in).(*rgsv1.AuditPartitionExport)
+	return cp
+}
+
+func (s *AuditService) loadPartitionExport(ctx context.Context, partitionDay string) (*rgsv1.AuditPartitionExport, error) {
+	if s.db != nil {
+		return getAuditPartitionExportFromDB(ctx, s.db, partitionDay)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return cloneAuditPartitionExport(s.exports[partitionDay]), nil
+}
+
+func (s *AuditService) storePartitionExport(ctx context.Context, exp *rgsv1.AuditPartitionExport) error {
+	if s.db != nil {
+		if err := insertAuditPartitionExportDB(ctx, s.db, exp); err != nil {
+			return err
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.exports[exp.PartitionDay] = cloneAuditPartitionExport(exp)
+	return nil
+}
+
+// exportPartition writes a closed partition day to every sink as
+// events.ndjson, manifest.json, and manifest.json.sig, and records the
+// export. A day is exported once; later calls return the recorded export.
+func (s *AuditService) exportPartition(ctx context.Context, meta *rgsv1.RequestMeta, partitionDay string) (*rgsv1.AuditPartitionExport, rgsv1.ResultCode, string) {
+	day, err := time.Parse(gamingDayLayout, partitionDay)
+	if err != nil {
+		return nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "partition_day must be YYYY-MM-DD"
+	}
+	if !s.now().After(gamingCalendarFor("").Window(day).end) {
+		return nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "partition day is not closed"
+	}
+	cfg := s.exportConfig()
+	if len(cfg.Sinks) == 0 || len(cfg.SigningKey) == 0 {
+		return nil, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit export not configured"
+	}
+
+	s.exportMu.Lock()
+	defer s.exportMu.Unlock()
+
+	existing, err := s.loadPartitionExport(ctx, partitionDay)
+	if err != nil {
+		return nil, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable"
+	}
+	if existing != nil {
+		return existing, rgsv1.ResultCode_RESULT_CODE_OK, ""
+	}
+
+	if archived, err := s.partitionArchived(ctx, partitionDay); err != nil {
+		return nil, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable"
+	} else if archived {
+		return nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "partition day is archived"
+	}
+
+	rows, verified, err := s.exportPartitionDay(ctx, partitionDay)
+	if err != nil {
+		return nil, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit export unavailable"
+	}
+	var events bytes.Buffer
+	enc := json.NewEncoder(&events)
+	for _, row := range rows {
+		if err := enc.Encode(row); err != nil {
+			return nil, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit export unavailable"
+		}
+	}
+	prefix := auditExportPrefix(partitionDay)
+	manifest := auditExportManifest{
+		PartitionDay:    partitionDay,
+		EventCount:      int64(len(rows)),
+		ChainVerified:   verified,
+		EventsObject:    prefix + "events.ndjson",
+		EventsSHA256:    sha256Hex(events.Bytes()),
+		EventsSizeBytes: int64(events.Len()),
+		SignerKID:       cfg.SignerKID,
+		SignatureAlg:    "ed25519",
+	}
+	if len(rows) > 0 {
+		manifest.FirstHashPrev = rows[0].HashPrev
+		manifest.LastHashCurr = rows[len(rows)-1].HashCurr
+	}
+	manifestJSON, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit export unavailable"
+	}
+	signature := hex.EncodeToString(ed25519.Sign(cfg.SigningKey, manifestJSON))
+
+	// The manifest goes last so its presence implies a complete export.
+	objects := []struct {
+		key, contentType string
+		body             []byte
+	}{
+		{manifest.EventsObject, "application/x-ndjson", events.Bytes()},
+		{prefix + "manifest.json.sig", "text/plain", []byte(signature + "\n")},
+		{prefix + "manifest.json", "application/json", manifestJSON},
+	}
+	exp := &rgsv1.AuditPartitionExport{
+		PartitionDay:   partitionDay,
+		EventCount:     manifest.EventCount,
+		ChainVerified:  verified,
+		EventsSha256:   manifest.EventsSHA256,
+		ManifestSha256: sha256Hex(manifestJSON),
+		SignerKid:      cfg.SignerKID,
+		SignatureAlg:   manifest.SignatureAlg,
+		Signature:      signature,
+	}
+	for _, sink := range cfg.Sinks {
+		for _, obj := range objects {
+			if err := sink.PutObject(ctx, obj.key, obj.contentType, obj.body); err != nil {
+				reason := "audit export delivery failed: " + sink.Name()
+				after, _ := json.Marshal(map[string]string{"sink": sink.Name(), "object": obj.key, "error": err.Error()})
+				_ = s.appendAudit(meta, partitionDay, "export_audit_partition", []byte(`{}`), after, audit.ResultError, reason)
+				return nil, rgsv1.ResultCode_RESULT_CODE_ERROR, reason
+			}
+		}
+		exp.Sinks = append(exp.Sinks, sink.Name())
+	}
+	exp.ExportedAt = s.now().Format(time.RFC3339Nano)
+
+	if err := s.storePartitionExport(ctx, exp); err != nil {
+		return nil, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable"
+	}
+	after, _ := json.Marshal(exp)
+	if err := s.appendAudit(meta, partitionDay, "export_audit_partition", []byte(`{}`), after, audit.ResultSuccess, ""); err != nil {
+		return nil, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable"
+	}
+	return cloneAuditPartitionExport(exp), rgsv1.ResultCode_RESULT_CODE_OK, ""
+}
+
+func (s *AuditService) appendAudit(meta *rgsv1.RequestMeta, partitionDay, action string, before, after []byte, result audit.Result, reason string) error {
+	s.mu.Lock()
+	s.nextAuditID++
+	auditID := "audit-svc-audit-" + strconv.FormatInt(s.nextAuditID, 10)
+	s.mu.Unlock()
+	ev := newAuditEvent(meta, auditID, s.now(), "audit_partition", partitionDay, action, before, after, result, reason)
+	return appendAuditTo(context.Background(), s.AuditStore, ev)
+}
+
+// ExportJob exports the last closed partition day once it closes. Days
+// missed while the job was not running are exported with
+// ExportAuditPartition.
+func (s *AuditService) ExportJob() JobFunc {
+	return func(ctx context.Context, _ string) (string, error) {
+		day := gamingCalendarFor("").LastClosedGamingDay(s.now())
+		existing, err := s.loadPartitionExport(ctx, day)
+		if err != nil {
+			return "", fmt.Errorf("audit export lookup failed partition_day=%s: %w", day, err)
+		}
+		if existing != nil {
+			return "", nil
+		}
+		exp, code, reason := s.exportPartition(ctx, nil, day)
+		if code != rgsv1.ResultCode_RESULT_CODE_OK {
+			return "", fmt.Errorf("audit export failed partition_day=%s: %s", day, reason)
+		}
+		return fmt.Sprintf("audit partition exported partition_day=%s events=%d", day, exp.EventCount), nil
+	}
+}
+
+func (s *AuditService) ExportAuditPartition(ctx context.Context, req *rgsv1.ExportAuditPartitionRequest) (*rgsv1.ExportAuditPartitionResponse, error) {
+	if req == nil {
+		req = &rgsv1.ExportAuditPartitionRequest{}
+	}
+	if ok, reason := s.authorize(ctx, req.Meta); !ok {
+		_ = s.appendAudit(req.Meta, req.PartitionDay, "export_audit_partition", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		return &rgsv1.ExportAuditPartitionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	partitionDay := strings.TrimSpace(req.PartitionDay)
+	if partitionDay == "" {
+		partitionDay = gamingCalendarFor("").LastClosedGamingDay(s.now())
+	}
+	exp, code, reason := s.exportPartition(ctx, req.Meta, partitionDay)
+	return &rgsv1.ExportAuditPartitionResponse{Meta: s.responseMeta(req.Meta, code, reason), Export: exp}, nil
+}
@@ -0,0 +1,187 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// S3AuditExportSink puts objects into an S3 bucket (or an S3-compatible
+// store) with path-style requests signed by AWS Signature Version 4.
+// Objects are written with If-None-Match so existing keys are never
+// replaced; with RetainFor set they are also locked in COMPLIANCE mode,
+// which requires a bucket created with Object Lock enabled.
+type S3AuditExportSink struct {
+	// Endpoint defaults to https://s3.<Region>.amazonaws.com.
+	Endpoint        string
+	Region          string
+	Bucket          string
+	Prefix          string
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+	RetainFor       time.Duration
+	Client          *http.Client
+
+	now func() time.Time
+}
+
+func (s S3AuditExportSink) Name() string {
+	return "s3:" + s.Bucket + "/" + s.Prefix
+}
+
+func (s S3AuditExportSink) clock() time.Time {
+	if s.now != nil {
+		return s.now().UTC()
+	}
+	return time.Now().UTC()
+}
+
+func (s S3AuditExportSink) objectURL(key string) string {
+	endpoint := strings.TrimRight(s.Endpoint, "/")
+	if endpoint == "" {
+		endpoint = "https://s3." + s.Region + ".amazonaws.com"
+	}
+	return endpoint + "/" + s3URIEncode(s.Bucket) + "/" + s3URIEncode(s.Prefix+key)
+}
+
+func (s S3AuditExportSink) do(ctx context.Context, method, key string, header http.Header, body []byte) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, s.objectURL(key), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	signS3Request(req, sha256Hex(body), s.AccessKeyID, s.SecretAccessKey, s.SessionToken, s.Region, s.clock())
+	client := s.Client
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	return client.Do(req)
+}
+
+func (s S3AuditExportSink) PutObject(ctx context.Context, key, contentType string, body []byte) error {
+	if s.Bucket == "" || s.Region == "" {
+		return fmt.Errorf("s3 sink requires bucket and region")
+	}
+	digest := sha256Hex(body)
+	md5Sum := md5.Sum(body)
+	header := http.Header{}
+	header.Set("Content-Type", contentType)
+	header.Set("Content-MD5", base64.StdEncoding.EncodeToString(md5Sum[:]))
+	header.Set("If-None-Match", "*")
+	header.Set("X-Amz-Meta-Sha256", digest)
+	if s.RetainFor > 0 {
+		header.Set("X-Amz-Object-Lock-Mode", "COMPLIANCE")
+		header.Set("X-Amz-Object-Lock-Retain-Until-Date", s.clock().Add(s.RetainFor).Format(time.RFC3339))
+	}
+	resp, err := s.do(ctx, http.MethodPut, key, header, body)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode <= 299:
+		return nil
+	case resp.StatusCode == http.StatusPreconditionFailed || resp.StatusCode == http.StatusConflict:
+		return s.checkExisting(ctx, key, digest)
+	default:
+		return fmt.Errorf("s3 put %s rejected: status=%d", key, resp.StatusCode)
+	}
+}
+
+// checkExisting accepts an existing object whose recorded digest matches.
+func (s S3AuditExportSink) checkExisting(ctx context.Context, key, digest string) error {
+	resp, err := s.do(ctx, http.MethodHead, key, http.Header{}, nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("s3 head %s rejected: status=%d", key, resp.StatusCode)
+	}
+	if resp.Header.Get("X-Amz-Meta-Sha256") != digest {
+		return fmt.Errorf("%s: %w", key, ErrAuditExportObjectConflict)
+	}
+	return nil
+}
+
+// signS3Request adds SigV4 headers for the s3 service, signing every header
+// already on req plus host.
+func signS3Request(req *http.Request, payloadHash, accessKeyID, secretAccessKey, sessionToken, region string, now time.Time) {
+	amzDate := now.UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", sessionToken)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for k, v := range req.Header {
+		headers[strings.ToLower(k)] = strings.TrimSpace(strings.Join(v, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for k := range headers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, k := range names {
+		canonicalHeaders.WriteString(k + ":" + headers[k] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + region + "/s3/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + sha256Hex([]byte(canonicalRequest))
+	key := hmacSHA256([]byte("AWS4"+secretAccessKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+	req.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential="+accessKeyID+"/"+scope+", SignedHeaders="+signedHeaders+", Signature="+signature)
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// s3URIEncode percent-encodes everything but '/' and RFC 3986 unreserved
+// characters.
+func s3URIEncode(v string) string {
+	var b strings.Builder
+	for i := 0; i < len(v); i++ {
+		c := v[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9', c == '-', c == '_', c == '.', c == '~', c == '/':
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
@@ -2,10 +2,14 @@ package server
 
 import (
 	"context"
+	"crypto/ed25519"
 	"database/sql"
+	"encoding/base64"
 	"fmt"
 	"sort"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
@@ -16,24 +20,90 @@ import (
 type AuditService struct {
 	rgsv1.UnimplementedAuditServiceServer
 
-	Clock clock.Clock
+	Clock      clock.Clock
+	AuditStore audit.Store
 
-	stores      []*audit.InMemoryStore
+	stores      []audit.Store
 	remoteGuard *RemoteAccessGuard
 	db          *sql.DB
+
+	mu          sync.Mutex
+	exportCfg   AuditExportConfig
+	exports     map[string]*rgsv1.AuditPartitionExport
+	nextAuditID int64
+	// exportMu serializes partition exports so the job and manual triggers
+	// never race on the same day.
+	exportMu sync.Mutex
+
+	timestamper AuditTimestamper
+	anchors     map[string]*rgsv1.AuditChainAnchor
+	anchorMu    sync.Mutex
+
+	seals  map[string]*rgsv1.AuditPartitionSeal
+	sealMu sync.Mutex
+
+	retentionDays int
+
+	reportSignerKID  string
+	reportSigningKey ed25519.PrivateKey
+
+	chainVerifyDays    int
+	onChainVerified    func(valid bool)
+	corruptionNotifier AuditCorruptionNotifier
+	// corruptDays holds partition days already reported as corrupt, so each
+	// is raised once until it verifies again.
+	corruptDays map[string]bool
 }
 
 const maxAuditPageSize = 1000
 
-func NewAuditService(clk clock.Clock, remoteGuard *RemoteAccessGuard, stores ...*audit.InMemoryStore) *AuditService {
-	return &AuditService{Clock: clk, remoteGuard: remoteGuard, stores: stores}
+func NewAuditService(clk clock.Clock, remoteGuard *RemoteAccessGuard, stores ...audit.Store) *AuditService {
+	return &AuditService{
+		Clock:       clk,
+		AuditStore:  audit.NewInMemoryStore(),
+		remoteGuard: remoteGuard,
+		stores:      stores,
+		exports:     make(map[string]*rgsv1.AuditPartitionExport),
+		anchors:     make(map[string]*rgsv1.AuditChainAnchor),
+		seals:       make(map[string]*rgsv1.AuditPartitionSeal),
+		corruptDays: make(map[string]bool),
+	}
 }
 
+// SetDB reads audit history from db and records the service's own events
+// there too, replacing AuditStore.
 func (s *AuditService) SetDB(db *sql.DB) {
 	if s == nil {
 		return
 	}
 	s.db = db
+	s.AuditStore = NewAuditStore(db)
+}
+
+// mirrors returns the in-memory chains of the services' stores and of the
+// service's own.
+func (s *AuditService) mirrors() []*audit.InMemoryStore {
+	out := make([]*audit.InMemoryStore, 0, len(s.stores)+1)
+	for _, st := range s.stores {
+		if mem := auditMirror(st); mem != nil {
+			out = append(out, mem)
+		}
+	}
+	if mem := auditMirror(s.AuditStore); mem != nil {
+		out = append(out, mem)
+	}
+	return out
+}
+
+// SetForwarder mirrors every event appended to the service's stores to f,
+// e.g. a SyslogAuditForwarder feeding a SIEM.
+func (s *AuditService) SetForwarder(f audit.Forwarder) {
+	if s == nil {
+		return
+	}
+	for _, st := range s.mirrors() {
+		st.SetForwarder(f)
+	}
 }
 
 func (s *AuditService) now() time.Time {
@@ -103,6 +173,73 @@ func validatePageToken(pageToken string) error {
 	return nil
 }
 
+// auditListFilter holds the validated ListAuditEvents filters. Zero values
+// match everything.
+type auditListFilter struct {
+	objectType     string
+	actorID        string
+	action         string
+	result         audit.Result
+	reasonContains string
+	from           time.Time
+	to             time.Time
+}
+
+// auditLikeEscaper escapes ILIKE wildcards so reason_contains matches
+// literally.
+var auditLikeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func newAuditListFilter(req *rgsv1.ListAuditEventsRequest) (auditListFilter, string) {
+	f := auditListFilter{
+		objectType:     strings.TrimSpace(req.ObjectTypeFilter),
+		actorID:        strings.TrimSpace(req.ActorIdFilter),
+		action:         strings.TrimSpace(req.ActionFilter),
+		result:         audit.Result(strings.TrimSpace(req.ResultFilter)),
+		reasonContains: req.ReasonContains,
+	}
+	switch f.result {
+	case "", audit.ResultSuccess, audit.ResultDenied, audit.ResultError:
+	default:
+		return f, "invalid result_filter"
+	}
+	var ok bool
+	if f.from, ok = parseRFC3339Strict(req.FromTime); !ok {
+		return f, "invalid from_time"
+	}
+	if f.to, ok = parseRFC3339Strict(req.ToTime); !ok {
+		return f, "invalid to_time"
+	}
+	if !f.from.IsZero() && !f.to.IsZero() && f.from.After(f.to) {
+		return f, "from_time must not be after to_time"
+	}
+	return f, ""
+}
+
+func (f auditListFilter) matches(e audit.Event) bool {
+	if f.objectType != "" && e.ObjectType != f.objectType {
+		return false
+	}
+	if f.actorID != "" && e.ActorID != f.actorID {
+		return false
+	}
+	if f.action != "" && e.Action != f.action {
+		return false
+	}
+	if f.result != "" && e.Result != f.result {
+		return false
+	}
+	if f.reasonContains != "" && !strings.Contains(strings.ToLower(e.Reason), strings.ToLower(f.reasonContains)) {
+		return false
+	}
+	if !f.from.IsZero() && e.RecordedAt.Before(f.from) {
+		return false
+	}
+	if !f.to.IsZero() && e.RecordedAt.After(f.to) {
+		return false
+	}
+	return true
+}
+
 func (s *AuditService) ListAuditEvents(ctx context.Context, req *rgsv1.ListAuditEventsRequest) (*rgsv1.ListAuditEventsResponse, error) {
 	if req == nil {
 		req = &rgsv1.ListAuditEventsRequest{}
@@ -119,8 +256,12 @@ func (s *AuditService) ListAuditEvents(ctx context.Context, req *rgsv1.ListAudit
 	if req.PageSize > maxAuditPageSize {
 		return &rgsv1.ListAuditEventsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "page_size exceeds max allowed")}, nil
 	}
+	filter, reason := newAuditListFilter(req)
+	if reason != "" {
+		return &rgsv1.ListAuditEventsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, reason)}, nil
+	}
 	if s.db != nil {
-		rows, next, err := listAuditEventsFromDB(ctx, s.db, req.ObjectTypeFilter, req.PageToken, req.PageSize)
+		rows, next, err := listAuditEventsFromDB(ctx, s.db, filter, req.PageToken, req.PageSize)
 		if err != nil {
 			return &rgsv1.ListAuditEventsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
 		}
@@ -128,12 +269,9 @@ func (s *AuditService) ListAuditEvents(ctx context.Context, req *rgsv1.ListAudit
 	}
 
 	events := make([]*rgsv1.AuditEventRecord, 0)
-	for _, st := range s.stores {
-		if st == nil {
-			continue
-		}
+	for _, st := range s.mirrors() {
 		for _, e := range st.Events() {
-			if req.ObjectTypeFilter != "" && e.ObjectType != req.ObjectTypeFilter {
+			if !filter.matches(e) {
 				continue
 			}
 			events = append(events, &rgsv1.AuditEventRecord{
@@ -147,6 +285,8 @@ func (s *AuditService) ListAuditEvents(ctx context.Context, req *rgsv1.ListAudit
 				Action:     e.Action,
 				Result:     string(e.Result),
 				Reason:     e.Reason,
+				SignerKid:  e.SignerKID,
+				Signature:  e.Signature,
 			})
 		}
 	}
@@ -231,5 +371,95 @@ func (s *AuditService) VerifyAuditChain(ctx context.Context, req *rgsv1.VerifyAu
 	if err := verifyAuditChainFromDB(ctx, s.db, req.PartitionDay); err != nil {
 		return &rgsv1.VerifyAuditChainResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit chain verification failed"), Valid: false}, nil
 	}
+	if err := verifyAuditChainAnchorsFromDB(ctx, s.db, req.PartitionDay); err != nil {
+		return &rgsv1.VerifyAuditChainResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit chain does not match anchored head"), Valid: false}, nil
+	}
 	return &rgsv1.VerifyAuditChainResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Valid: true}, nil
 }
+
+// auditExportRow is the serialized form of an audit event in regulator
+// exports. Hash fields are included so the chain can be re-verified offline.
+type auditExportRow struct {
+	AuditID    string `json:"audit_id"`
+	OccurredAt string `json:"occurred_at"`
+	RecordedAt string `json:"recorded_at"`
+	ActorID    string `json:"actor_id"`
+	ActorType  string `json:"actor_type"`
+	ObjectType string `json:"object_type"`
+	ObjectID   string `json:"object_id"`
+	Action     string `json:"action"`
+	Result     string `json:"result"`
+	Reason     string `json:"reason"`
+	HashPrev   string `json:"hash_prev"`
+	HashCurr   string `json:"hash_curr"`
+	// Set for signed events. SignedPayload is the base64 canonical form the
+	// signature covers, so a third party can verify the row on its own.
+	SignerKID     string `json:"signer_kid,omitempty"`
+	Signature     string `json:"signature,omitempty"`
+	SignedPayload string `json:"signed_payload,omitempty"`
+}
+
+func auditSignedPayload(e audit.Event) string {
+	return base64.StdEncoding.EncodeToString(audit.CanonicalBytes(e))
+}
+
+func auditExportRowFromEvent(e audit.Event) auditExportRow {
+	row := auditExportRow{
+		AuditID:    e.AuditID,
+		OccurredAt: e.OccurredAt.UTC().Format(time.RFC3339Nano),
+		RecordedAt: e.RecordedAt.UTC().Format(time.RFC3339Nano),
+		ActorID:    e.ActorID,
+		ActorType:  e.ActorType,
+		ObjectType: e.ObjectType,
+		ObjectID:   e.ObjectID,
+		Action:     e.Action,
+		Result:     string(e.Result),
+		Reason:     e.Reason,
+		HashPrev:   e.HashPrev,
+		HashCurr:   e.HashCurr,
+		SignerKID:  e.SignerKID,
+		Signature:  e.Signature,
+	}
+	if e.Signature != "" {
+		row.SignedPayload = auditSignedPayload(e)
+	}
+	return row
+}
+
+// exportPartitionDay returns the audit events of one partition day, oldest
+// first, and whether the hash chains covering them verified.
+func (s *AuditService) exportPartitionDay(ctx context.Context, partitionDay string) ([]auditExportRow, bool, error) {
+	if s == nil {
+		return nil, false, nil
+	}
+	if s.db != nil {
+		rows, err := exportAuditPartitionFromDB(ctx, s.db, partitionDay)
+		if err != nil {
+			return nil, false, err
+		}
+		return rows, verifyAuditChainFromDB(ctx, s.db, partitionDay) == nil, nil
+	}
+
+	rows := make([]auditExportRow, 0)
+	verified := true
+	for _, st := range s.mirrors() {
+		prev := "GENESIS"
+		for _, e := range st.Events() {
+			if e.HashPrev != prev || audit.ComputeHash(e.HashPrev, e) != e.HashCurr {
+				verified = false
+			}
+			prev = e.HashCurr
+			if e.PartitionDay != partitionDay {
+				continue
+			}
+			rows = append(rows, auditExportRowFromEvent(e))
+		}
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		if rows[i].RecordedAt == rows[j].RecordedAt {
+			return rows[i].AuditID < rows[j].AuditID
+		}
+		return rows[i].RecordedAt < rows[j].RecordedAt
+	})
+	return rows, verified, nil
+}
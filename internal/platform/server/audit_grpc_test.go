@@ -363,3 +363,75 @@ func TestAuditGatewayActorMismatchDenied(t *testing.T) {
 		t.Fatalf("expected actor mismatch denial reason for gateway verify chain, got=%q", verifyOut.GetMeta().GetDenialReason())
 	}
 }
+
+// auditFilterFixture is shared by the in-memory and Postgres filter tests.
+func auditFilterFixture() []audit.Event {
+	base := time.Date(2026, 3, 10, 10, 0, 0, 0, time.UTC)
+	return []audit.Event{
+		{AuditID: "af-1", RecordedAt: base, ActorID: "op-1", ActorType: "operator", ObjectType: "ledger_account", ObjectID: "a1", Action: "deposit", Result: audit.ResultSuccess},
+		{AuditID: "af-2", RecordedAt: base.Add(time.Hour), ActorID: "op-2", ActorType: "operator", ObjectType: "ledger_account", ObjectID: "a1", Action: "withdraw", Result: audit.ResultDenied, Reason: "Insufficient 100%_funds"},
+		{AuditID: "af-3", RecordedAt: base.Add(2 * time.Hour), ActorID: "op-1", ActorType: "operator", ObjectType: "config_change", ObjectID: "c1", Action: "withdraw", Result: audit.ResultDenied, Reason: "insufficient balance"},
+		{AuditID: "af-4", RecordedAt: base.Add(3 * time.Hour), ActorID: "op-1", ActorType: "operator", ObjectType: "ledger_account", ObjectID: "a2", Action: "withdraw", Result: audit.ResultError, Reason: "persistence unavailable"},
+	}
+}
+
+func assertAuditFilterResults(t *testing.T, svc *AuditService) {
+	t.Helper()
+	opMeta := meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+	cases := []struct {
+		name string
+		req  *rgsv1.ListAuditEventsRequest
+		want []string
+	}{
+		{"actor", &rgsv1.ListAuditEventsRequest{ActorIdFilter: "op-1"}, []string{"af-4", "af-3", "af-1"}},
+		{"action and result", &rgsv1.ListAuditEventsRequest{ActionFilter: "withdraw", ResultFilter: "denied"}, []string{"af-3", "af-2"}},
+		{"reason substring", &rgsv1.ListAuditEventsRequest{ReasonContains: "INSUFFICIENT"}, []string{"af-3", "af-2"}},
+		{"reason wildcards are literal", &rgsv1.ListAuditEventsRequest{ReasonContains: "%_"}, []string{"af-2"}},
+		{"time range", &rgsv1.ListAuditEventsRequest{FromTime: "2026-03-10T11:00:00Z", ToTime: "2026-03-10T12:00:00Z"}, []string{"af-3", "af-2"}},
+		{"combined with object type", &rgsv1.ListAuditEventsRequest{ObjectTypeFilter: "ledger_account", ActorIdFilter: "op-1", FromTime: "2026-03-10T10:30:00Z"}, []string{"af-4"}},
+	}
+	for _, tc := range cases {
+		tc.req.Meta = opMeta
+		resp, err := svc.ListAuditEvents(context.Background(), tc.req)
+		if err != nil || resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("%s: err=%v meta=%+v", tc.name, err, resp.GetMeta())
+		}
+		var got []string
+		for _, e := range resp.Events {
+			if e.AuditId[:3] == "af-" {
+				got = append(got, e.AuditId)
+			}
+		}
+		if len(got) != len(tc.want) {
+			t.Fatalf("%s: got %v want %v", tc.name, got, tc.want)
+		}
+		for i := range got {
+			if got[i] != tc.want[i] {
+				t.Fatalf("%s: got %v want %v", tc.name, got, tc.want)
+			}
+		}
+	}
+}
+
+func TestListAuditEventsFilters(t *testing.T) {
+	store := audit.NewInMemoryStore()
+	for _, e := range auditFilterFixture() {
+		if _, err := store.Append(e); err != nil {
+			t.Fatalf("append: %v", err)
+		}
+	}
+	svc := NewAuditService(ledgerFixedClock{now: time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC)}, nil, store)
+	assertAuditFilterResults(t, svc)
+
+	opMeta := meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+	for _, bad := range []*rgsv1.ListAuditEventsRequest{
+		{Meta: opMeta, ResultFilter: "ok"},
+		{Meta: opMeta, FromTime: "yesterday"},
+		{Meta: opMeta, FromTime: "2026-03-11T00:00:00Z", ToTime: "2026-03-10T00:00:00Z"},
+	} {
+		resp, _ := svc.ListAuditEvents(context.Background(), bad)
+		if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+			t.Fatalf("expected invalid for %+v, got %+v", bad, resp.Meta)
+		}
+	}
+}
@@ -13,6 +13,10 @@ import (
 	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
 )
 
+// errAuditChainMismatch marks a verification failure caused by the stored
+// events themselves, as opposed to the database being unreachable.
+var errAuditChainMismatch = errors.New("audit chain mismatch")
+
 func normalizeAuditJSON(raw []byte) []byte {
 	if len(raw) == 0 {
 		return []byte(`{}`)
@@ -24,6 +28,15 @@ func normalizeAuditJSON(raw []byte) []byte {
 	return raw
 }
 
+// auditAuthContextFromJSON reverses auditAuthContextJSON.
+func auditAuthContextFromJSON(raw []byte) string {
+	var v struct {
+		Context string `json:"context"`
+	}
+	_ = json.Unmarshal(raw, &v)
+	return v.Context
+}
+
 func auditAuthContextJSON(v string) []byte {
 	if v == "" {
 		return []byte(`{}`)
@@ -39,6 +52,20 @@ func appendAuditEventToDB(ctx context.Context, db *sql.DB, ev audit.Event) error
 	if db == nil {
 		return nil
 	}
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+	if err := appendAuditEventTx(ctx, tx, ev); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// appendAuditEventTx chains ev onto its partition day inside the caller's
+// transaction, so the event commits or rolls back with the change it records.
+func appendAuditEventTx(ctx context.Context, tx *sql.Tx, ev audit.Event) error {
 	if ev.RecordedAt.IsZero() {
 		ev.RecordedAt = time.Now().UTC()
 	}
@@ -46,14 +73,8 @@ func appendAuditEventToDB(ctx context.Context, db *sql.DB, ev audit.Event) error
 		ev.OccurredAt = ev.RecordedAt
 	}
 	if ev.PartitionDay == "" {
-		ev.PartitionDay = ev.RecordedAt.UTC().Format("2006-01-02")
-	}
-
-	tx, err := db.BeginTx(ctx, nil)
-	if err != nil {
-		return err
+		ev.PartitionDay = auditPartitionDay(ev.RecordedAt)
 	}
-	defer func() { _ = tx.Rollback() }()
 
 	const lockQ = `
 SELECT hash_curr
@@ -80,7 +101,8 @@ INSERT INTO audit_events (
   before_state, after_state,
   result, reason,
   partition_day,
-  hash_prev, hash_curr
+  hash_prev, hash_curr,
+  signer_kid, signature
 )
 VALUES (
   $1, $2::timestamptz, $3::timestamptz,
@@ -89,11 +111,12 @@ VALUES (
   $10::jsonb, $11::jsonb,
   $12, $13,
   $14::date,
-  $15, $16
+  $15, $16,
+  $17, $18
 )
 ON CONFLICT (audit_id) DO NOTHING
 `
-	_, err = tx.ExecContext(ctx, insQ,
+	res, err := tx.ExecContext(ctx, insQ,
 		ev.AuditID,
 		ev.OccurredAt.UTC().Format(time.RFC3339Nano),
 		ev.RecordedAt.UTC().Format(time.RFC3339Nano),
@@ -110,14 +133,32 @@ ON CONFLICT (audit_id) DO NOTHING
 		ev.PartitionDay,
 		ev.HashPrev,
 		ev.HashCurr,
+		ev.SignerKID,
+		ev.Signature,
 	)
 	if err != nil {
 		return err
 	}
-	return tx.Commit()
+	if auditOutboxEnabled.Load() {
+		// A replayed audit id inserts nothing and is not queued again.
+		inserted, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if inserted > 0 {
+			body, err := json.Marshal(auditExportRowFromEvent(ev))
+			if err != nil {
+				return err
+			}
+			if err := insertOutboxJSONTx(ctx, tx, "audit_event", ev.AuditID, "audit."+ev.Action, body); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
-func listAuditEventsFromDB(ctx context.Context, db *sql.DB, objectTypeFilter string, pageToken string, pageSize int32) ([]*rgsv1.AuditEventRecord, string, error) {
+func listAuditEventsFromDB(ctx context.Context, db *sql.DB, filter auditListFilter, pageToken string, pageSize int32) ([]*rgsv1.AuditEventRecord, string, error) {
 	if db == nil {
 		return nil, "", nil
 	}
@@ -134,14 +175,32 @@ func listAuditEventsFromDB(ctx context.Context, db *sql.DB, objectTypeFilter str
 		start = n
 	}
 
+	var from, to sql.NullTime
+	if !filter.from.IsZero() {
+		from = sql.NullTime{Time: filter.from, Valid: true}
+	}
+	if !filter.to.IsZero() {
+		to = sql.NullTime{Time: filter.to, Valid: true}
+	}
+	reasonPattern := ""
+	if filter.reasonContains != "" {
+		reasonPattern = "%" + auditLikeEscaper.Replace(filter.reasonContains) + "%"
+	}
 	const q = `
-SELECT audit_id, occurred_at, recorded_at, actor_id, actor_type, object_type, object_id, action, result, reason
+SELECT audit_id, occurred_at, recorded_at, actor_id, actor_type, object_type, object_id, action, result, reason,
+       signer_kid, signature
 FROM audit_events
 WHERE ($1 = '' OR object_type = $1)
+  AND ($2 = '' OR actor_id = $2)
+  AND ($3 = '' OR action = $3)
+  AND ($4 = '' OR result = $4)
+  AND ($5 = '' OR reason ILIKE $5)
+  AND ($6::timestamptz IS NULL OR recorded_at >= $6::timestamptz)
+  AND ($7::timestamptz IS NULL OR recorded_at <= $7::timestamptz)
 ORDER BY recorded_at DESC, audit_id DESC
-LIMIT $2 OFFSET $3
+LIMIT $8 OFFSET $9
 `
-	rows, err := db.QueryContext(ctx, q, objectTypeFilter, limit, start)
+	rows, err := db.QueryContext(ctx, q, filter.objectType, filter.actorID, filter.action, string(filter.result), reasonPattern, from, to, limit, start)
 	if err != nil {
 		return nil, "", err
 	}
@@ -164,6 +223,8 @@ LIMIT $2 OFFSET $3
 			&ev.Action,
 			&ev.Result,
 			&ev.Reason,
+			&ev.SignerKid,
+			&ev.Signature,
 		); err != nil {
 			return nil, "", err
 		}
@@ -241,11 +302,11 @@ ORDER BY partition_day ASC, recorded_at ASC, audit_id ASC
 			expectedPrev = "GENESIS"
 		}
 		if ev.HashPrev != expectedPrev {
-			return fmt.Errorf("audit chain prev hash mismatch audit_id=%s expected=%s got=%s", ev.AuditID, expectedPrev, ev.HashPrev)
+			return fmt.Errorf("%w: prev hash audit_id=%s expected=%s got=%s", errAuditChainMismatch, ev.AuditID, expectedPrev, ev.HashPrev)
 		}
 		expectedCurr := audit.ComputeHash(expectedPrev, ev)
 		if ev.HashCurr != expectedCurr {
-			return fmt.Errorf("audit chain curr hash mismatch audit_id=%s", ev.AuditID)
+			return fmt.Errorf("%w: curr hash audit_id=%s", errAuditChainMismatch, ev.AuditID)
 		}
 		lastByPartition[partitionRaw] = ev.HashCurr
 	}
@@ -254,3 +315,470 @@ ORDER BY partition_day ASC, recorded_at ASC, audit_id ASC
 	}
 	return nil
 }
+
+// verifyAuditChainAnchorsFromDB checks that every anchored partition day in
+// scope still ends in the timestamped chain head. A rewritten chain can be
+// made internally consistent, but not consistent with the TSA token. Days
+// pruned by the retention worker are checked against their archive record.
+func verifyAuditChainAnchorsFromDB(ctx context.Context, db *sql.DB, partitionDay string) error {
+	if db == nil {
+		return nil
+	}
+	const q = `
+SELECT c.partition_day, c.chain_head, c.event_count,
+       a.partition_day IS NOT NULL, COALESCE(a.last_hash_curr, ''), COALESCE(a.event_count, 0)
+FROM audit_chain_anchors c
+LEFT JOIN audit_partition_archives a ON a.partition_day = c.partition_day
+WHERE ($1 = '' OR c.partition_day = $1::date)
+ORDER BY c.partition_day ASC
+`
+	rows, err := db.QueryContext(ctx, q, partitionDay)
+	if err != nil {
+		return err
+	}
+	type anchored struct {
+		day          string
+		head         string
+		count        int64
+		archived     bool
+		archiveHead  string
+		archiveCount int64
+	}
+	var anchors []anchored
+	for rows.Next() {
+		var (
+			a   anchored
+			day time.Time
+		)
+		if err := rows.Scan(&day, &a.head, &a.count, &a.archived, &a.archiveHead, &a.archiveCount); err != nil {
+			rows.Close()
+			return err
+		}
+		a.day = day.UTC().Format("2006-01-02")
+		anchors = append(anchors, a)
+	}
+	if err := rows.Err(); err != nil {
+		rows.Close()
+		return err
+	}
+	rows.Close()
+	for _, a := range anchors {
+		head, count := a.archiveHead, a.archiveCount
+		if !a.archived {
+			if head, count, err = auditPartitionChainHeadFromDB(ctx, db, a.day); err != nil {
+				return err
+			}
+		}
+		if head != a.head || count != a.count {
+			return fmt.Errorf("%w: anchored head partition_day=%s anchored=%s got=%s", errAuditChainMismatch, a.day, a.head, head)
+		}
+	}
+	return nil
+}
+
+func exportAuditPartitionFromDB(ctx context.Context, db *sql.DB, partitionDay string) ([]auditExportRow, error) {
+	if db == nil {
+		return nil, nil
+	}
+	rows, err := archiveAuditPartitionFromDB(ctx, db, partitionDay)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]auditExportRow, 0, len(rows))
+	for _, r := range rows {
+		out = append(out, r.auditExportRow)
+	}
+	return out, nil
+}
+
+func getAuditPartitionExportFromDB(ctx context.Context, db *sql.DB, partitionDay string) (*rgsv1.AuditPartitionExport, error) {
+	const q = `
+SELECT event_count, chain_verified, events_sha256, manifest_sha256, signer_kid, signature_alg, signature, sinks, exported_at
+FROM audit_partition_exports
+WHERE partition_day = $1::date
+`
+	var (
+		exp        = &rgsv1.AuditPartitionExport{PartitionDay: partitionDay}
+		sinks      []byte
+		exportedAt time.Time
+	)
+	err := db.QueryRowContext(ctx, q, partitionDay).Scan(
+		&exp.EventCount,
+		&exp.ChainVerified,
+		&exp.EventsSha256,
+		&exp.ManifestSha256,
+		&exp.SignerKid,
+		&exp.SignatureAlg,
+		&exp.Signature,
+		&sinks,
+		&exportedAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(sinks, &exp.Sinks); err != nil {
+		return nil, err
+	}
+	exp.ExportedAt = exportedAt.UTC().Format(time.RFC3339Nano)
+	return exp, nil
+}
+
+// insertAuditPartitionExportDB records an export; a day already recorded
+// keeps its first record.
+func insertAuditPartitionExportDB(ctx context.Context, db *sql.DB, exp *rgsv1.AuditPartitionExport) error {
+	sinks, err := json.Marshal(nonNilStrings(exp.Sinks))
+	if err != nil {
+		return err
+	}
+	const q = `
+INSERT INTO audit_partition_exports (
+  partition_day, event_count, chain_verified, events_sha256, manifest_sha256,
+  signer_kid, signature_alg, signature, sinks, exported_at
+)
+VALUES ($1::date, $2, $3, $4, $5, $6, $7, $8, $9::jsonb, $10::timestamptz)
+ON CONFLICT (partition_day) DO NOTHING
+`
+	_, err = db.ExecContext(ctx, q,
+		exp.PartitionDay,
+		exp.EventCount,
+		exp.ChainVerified,
+		exp.EventsSha256,
+		exp.ManifestSha256,
+		exp.SignerKid,
+		exp.SignatureAlg,
+		exp.Signature,
+		string(sinks),
+		exp.ExportedAt,
+	)
+	return err
+}
+
+// auditPartitionChainHeadFromDB returns the last hash of a partition day's
+// chain and its event count; the head is empty for a day with no events.
+func auditPartitionChainHeadFromDB(ctx context.Context, db *sql.DB, partitionDay string) (string, int64, error) {
+	const q = `
+SELECT COALESCE((
+         SELECT hash_curr FROM audit_events
+         WHERE partition_day = $1::date
+         ORDER BY recorded_at DESC, audit_id DESC
+         LIMIT 1
+       ), ''),
+       (SELECT COUNT(*) FROM audit_events WHERE partition_day = $1::date)
+`
+	var (
+		head  string
+		count int64
+	)
+	if err := db.QueryRowContext(ctx, q, partitionDay).Scan(&head, &count); err != nil {
+		return "", 0, err
+	}
+	return head, count, nil
+}
+
+func getAuditChainAnchorFromDB(ctx context.Context, db *sql.DB, partitionDay string) (*rgsv1.AuditChainAnchor, error) {
+	const q = `
+SELECT event_count, chain_head, hash_alg, tsa_url, timestamp_token, tsa_gen_time, tsa_serial_number, anchored_at
+FROM audit_chain_anchors
+WHERE partition_day = $1::date
+`
+	var (
+		anchor              = &rgsv1.AuditChainAnchor{PartitionDay: partitionDay}
+		genTime, anchoredAt time.Time
+	)
+	err := db.QueryRowContext(ctx, q, partitionDay).Scan(
+		&anchor.EventCount,
+		&anchor.ChainHead,
+		&anchor.HashAlg,
+		&anchor.TsaUrl,
+		&anchor.TimestampToken,
+		&genTime,
+		&anchor.TsaSerialNumber,
+		&anchoredAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	anchor.TsaGenTime = genTime.UTC().Format(time.RFC3339Nano)
+	anchor.AnchoredAt = anchoredAt.UTC().Format(time.RFC3339Nano)
+	return anchor, nil
+}
+
+// insertAuditChainAnchorDB records an anchor; a day already anchored keeps
+// its first token.
+func insertAuditChainAnchorDB(ctx context.Context, db *sql.DB, anchor *rgsv1.AuditChainAnchor) error {
+	const q = `
+INSERT INTO audit_chain_anchors (
+  partition_day, event_count, chain_head, hash_alg, tsa_url,
+  timestamp_token, tsa_gen_time, tsa_serial_number, anchored_at
+)
+VALUES ($1::date, $2, $3, $4, $5, $6, $7::timestamptz, $8, $9::timestamptz)
+ON CONFLICT (partition_day) DO NOTHING
+`
+	_, err := db.ExecContext(ctx, q,
+		anchor.PartitionDay,
+		anchor.EventCount,
+		anchor.ChainHead,
+		anchor.HashAlg,
+		anchor.TsaUrl,
+		anchor.TimestampToken,
+		anchor.TsaGenTime,
+		anchor.TsaSerialNumber,
+		anchor.AnchoredAt,
+	)
+	return err
+}
+
+func getAuditPartitionSealFromDB(ctx context.Context, db *sql.DB, partitionDay string) (*rgsv1.AuditPartitionSeal, error) {
+	const q = `
+SELECT event_count, merkle_root, hash_alg, sealed_at
+FROM audit_partition_seals
+WHERE partition_day = $1::date
+`
+	var (
+		seal     = &rgsv1.AuditPartitionSeal{PartitionDay: partitionDay}
+		sealedAt time.Time
+	)
+	err := db.QueryRowContext(ctx, q, partitionDay).Scan(&seal.EventCount, &seal.MerkleRoot, &seal.HashAlg, &sealedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	seal.SealedAt = sealedAt.UTC().Format(time.RFC3339Nano)
+	return seal, nil
+}
+
+// insertAuditPartitionSealDB records a seal; a day already sealed keeps its
+// first root.
+func insertAuditPartitionSealDB(ctx context.Context, db *sql.DB, seal *rgsv1.AuditPartitionSeal) error {
+	const q = `
+INSERT INTO audit_partition_seals (partition_day, event_count, merkle_root, hash_alg, sealed_at)
+VALUES ($1::date, $2, $3, $4, $5::timestamptz)
+ON CONFLICT (partition_day) DO NOTHING
+`
+	_, err := db.ExecContext(ctx, q, seal.PartitionDay, seal.EventCount, seal.MerkleRoot, seal.HashAlg, seal.SealedAt)
+	return err
+}
+
+func auditEventPartitionDayFromDB(ctx context.Context, db *sql.DB, auditID string) (string, error) {
+	var day time.Time
+	err := db.QueryRowContext(ctx, `SELECT partition_day FROM audit_events WHERE audit_id = $1`, auditID).Scan(&day)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return day.UTC().Format("2006-01-02"), nil
+}
+
+// auditArchiveRow is an audit event as written to cold storage by the
+// retention worker. Unlike auditExportRow it carries everything the row
+// held; before and after states are kept as the exact text that was hashed
+// so the chain can still be re-verified once the rows are pruned.
+type auditArchiveRow struct {
+	auditExportRow
+	AuthContext  string `json:"auth_context"`
+	BeforeState  string `json:"before_state"`
+	AfterState   string `json:"after_state"`
+	PartitionDay string `json:"partition_day"`
+}
+
+// auditPartitionArchive records a partition day archived and pruned by the
+// retention worker.
+type auditPartitionArchive struct {
+	PartitionDay   string   `json:"partition_day"`
+	EventCount     int64    `json:"event_count"`
+	FirstHashPrev  string   `json:"first_hash_prev"`
+	LastHashCurr   string   `json:"last_hash_curr"`
+	EventsSHA256   string   `json:"events_sha256"`
+	ManifestSHA256 string   `json:"manifest_sha256"`
+	SignerKID      string   `json:"signer_kid"`
+	Signature      string   `json:"signature"`
+	Sinks          []string `json:"sinks"`
+	ArchivedAt     string   `json:"archived_at"`
+}
+
+// listAuditPartitionsBeforeDB returns up to limit partition days older than
+// before that still hold audit rows, oldest first.
+func listAuditPartitionsBeforeDB(ctx context.Context, db *sql.DB, before string, limit int) ([]string, error) {
+	const q = `
+SELECT DISTINCT partition_day
+FROM audit_events
+WHERE partition_day < $1::date
+ORDER BY partition_day ASC
+LIMIT $2
+`
+	rows, err := db.QueryContext(ctx, q, before, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]string, 0)
+	for rows.Next() {
+		var day time.Time
+		if err := rows.Scan(&day); err != nil {
+			return nil, err
+		}
+		out = append(out, day.UTC().Format("2006-01-02"))
+	}
+	return out, rows.Err()
+}
+
+func archiveAuditPartitionFromDB(ctx context.Context, db *sql.DB, partitionDay string) ([]auditArchiveRow, error) {
+	const q = `
+SELECT audit_id, occurred_at, recorded_at, actor_id, actor_type, COALESCE(auth_context::text, ''), object_type, object_id, action,
+       COALESCE(before_state::text, ''), COALESCE(after_state::text, ''), result, reason, hash_prev, hash_curr,
+       signer_kid, signature
+FROM audit_events
+WHERE partition_day = $1::date
+ORDER BY recorded_at ASC, audit_id ASC
+`
+	rows, err := db.QueryContext(ctx, q, partitionDay)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]auditArchiveRow, 0)
+	for rows.Next() {
+		var (
+			r                      = auditArchiveRow{PartitionDay: partitionDay}
+			occurredAt, recordedAt time.Time
+		)
+		if err := rows.Scan(
+			&r.AuditID,
+			&occurredAt,
+			&recordedAt,
+			&r.ActorID,
+			&r.ActorType,
+			&r.AuthContext,
+			&r.ObjectType,
+			&r.ObjectID,
+			&r.Action,
+			&r.BeforeState,
+			&r.AfterState,
+			&r.Result,
+			&r.Reason,
+			&r.HashPrev,
+			&r.HashCurr,
+			&r.SignerKID,
+			&r.Signature,
+		); err != nil {
+			return nil, err
+		}
+		r.OccurredAt = occurredAt.UTC().Format(time.RFC3339Nano)
+		r.RecordedAt = recordedAt.UTC().Format(time.RFC3339Nano)
+		if r.Signature != "" {
+			r.SignedPayload = auditSignedPayload(audit.Event{
+				AuditID:      r.AuditID,
+				OccurredAt:   occurredAt,
+				RecordedAt:   recordedAt,
+				ActorID:      r.ActorID,
+				ActorType:    r.ActorType,
+				AuthContext:  auditAuthContextFromJSON([]byte(r.AuthContext)),
+				ObjectType:   r.ObjectType,
+				ObjectID:     r.ObjectID,
+				Action:       r.Action,
+				Before:       []byte(r.BeforeState),
+				After:        []byte(r.AfterState),
+				Result:       audit.Result(r.Result),
+				Reason:       r.Reason,
+				PartitionDay: partitionDay,
+				SignerKID:    r.SignerKID,
+			})
+		}
+		out = append(out, r)
+	}
+	return out, rows.Err()
+}
+
+func auditPartitionArchivedDB(ctx context.Context, db *sql.DB, partitionDay string) (bool, error) {
+	var archived bool
+	err := db.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM audit_partition_archives WHERE partition_day = $1::date)`, partitionDay).Scan(&archived)
+	return archived, err
+}
+
+func getAuditPartitionArchiveFromDB(ctx context.Context, db *sql.DB, partitionDay string) (*auditPartitionArchive, error) {
+	const q = `
+SELECT event_count, first_hash_prev, last_hash_curr, events_sha256, manifest_sha256, signer_kid, signature, sinks, archived_at
+FROM audit_partition_archives
+WHERE partition_day = $1::date
+`
+	var (
+		a          = auditPartitionArchive{PartitionDay: partitionDay}
+		sinks      []byte
+		archivedAt time.Time
+	)
+	err := db.QueryRowContext(ctx, q, partitionDay).Scan(&a.EventCount, &a.FirstHashPrev, &a.LastHashCurr, &a.EventsSHA256, &a.ManifestSHA256, &a.SignerKID, &a.Signature, &sinks, &archivedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(sinks, &a.Sinks); err != nil {
+		return nil, err
+	}
+	a.ArchivedAt = archivedAt.UTC().Format(time.RFC3339Nano)
+	return &a, nil
+}
+
+// pruneAuditPartitionDB records the archive and deletes the day's audit
+// rows in one transaction. The delete must remove exactly the archived
+// events, or nothing is pruned.
+func pruneAuditPartitionDB(ctx context.Context, db *sql.DB, archive auditPartitionArchive) error {
+	sinks, err := json.Marshal(nonNilStrings(archive.Sinks))
+	if err != nil {
+		return err
+	}
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	const ins = `
+INSERT INTO audit_partition_archives (
+  partition_day, event_count, first_hash_prev, last_hash_curr, events_sha256,
+  manifest_sha256, signer_kid, signature, sinks, archived_at
+)
+VALUES ($1::date, $2, $3, $4, $5, $6, $7, $8, $9::jsonb, $10::timestamptz)
+`
+	if _, err := tx.ExecContext(ctx, ins,
+		archive.PartitionDay,
+		archive.EventCount,
+		archive.FirstHashPrev,
+		archive.LastHashCurr,
+		archive.EventsSHA256,
+		archive.ManifestSHA256,
+		archive.SignerKID,
+		archive.Signature,
+		string(sinks),
+		archive.ArchivedAt,
+	); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `SELECT set_config('rgs.audit_prune_partition', $1, true)`, archive.PartitionDay); err != nil {
+		return err
+	}
+	res, err := tx.ExecContext(ctx, `DELETE FROM audit_events WHERE partition_day = $1::date`, archive.PartitionDay)
+	if err != nil {
+		return err
+	}
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if deleted != archive.EventCount {
+		return fmt.Errorf("audit prune partition_day=%s deleted %d rows, archived %d", archive.PartitionDay, deleted, archive.EventCount)
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,194 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+// maxAuditPartitionsPerRetentionRun bounds how many expired days one run of
+// the retention job archives, so a backlog drains over several runs.
+const maxAuditPartitionsPerRetentionRun = 7
+
+// SetRetentionDays sets how many closed gaming days of audit events stay in
+// Postgres. Older partition days are archived to the export sinks and
+// pruned by RetentionJob. Zero keeps everything.
+func (s *AuditService) SetRetentionDays(days int) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.retentionDays = days
+}
+
+func (s *AuditService) auditRetentionDays() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.retentionDays
+}
+
+// auditArchiveManifest is signed as serialized, like the export manifest.
+type auditArchiveManifest struct {
+	PartitionDay    string `json:"partition_day"`
+	EventCount      int64  `json:"event_count"`
+	FirstHashPrev   string `json:"first_hash_prev"`
+	LastHashCurr    string `json:"last_hash_curr"`
+	EventsObject    string `json:"events_object"`
+	EventsSHA256    string `json:"events_sha256"`
+	EventsSizeBytes int64  `json:"events_size_bytes"`
+	SignerKID       string `json:"signer_kid"`
+	SignatureAlg    string `json:"signature_alg"`
+}
+
+// partitionArchived reports whether the retention worker has already pruned
+// a partition day. In-memory mode never prunes.
+func (s *AuditService) partitionArchived(ctx context.Context, partitionDay string) (bool, error) {
+	if s.db == nil {
+		return false, nil
+	}
+	return auditPartitionArchivedDB(ctx, s.db, partitionDay)
+}
+
+// archivePartition writes every column of a partition day to the export
+// sinks under audit/<day>/archive/ with a signed manifest, then records the
+// archive and deletes the day's rows in one transaction. A day whose chain,
+// or anchored chain head, does not verify is left in place.
+func (s *AuditService) archivePartition(ctx context.Context, partitionDay string) (*auditPartitionArchive, error) {
+	cfg := s.exportConfig()
+	if len(cfg.Sinks) == 0 || len(cfg.SigningKey) == 0 {
+		return nil, fmt.Errorf("audit export not configured")
+	}
+
+	s.exportMu.Lock()
+	defer s.exportMu.Unlock()
+	s.anchorMu.Lock()
+	defer s.anchorMu.Unlock()
+
+	if err := verifyAuditChainFromDB(ctx, s.db, partitionDay); err != nil {
+		return nil, s.refuseArchive(partitionDay, "audit chain verification failed", err)
+	}
+	if err := verifyAuditChainAnchorsFromDB(ctx, s.db, partitionDay); err != nil {
+		return nil, s.refuseArchive(partitionDay, "audit chain does not match anchored head", err)
+	}
+
+	rows, err := archiveAuditPartitionFromDB(ctx, s.db, partitionDay)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	var events bytes.Buffer
+	enc := json.NewEncoder(&events)
+	for _, row := range rows {
+		if err := enc.Encode(row); err != nil {
+			return nil, err
+		}
+	}
+	prefix := auditExportPrefix(partitionDay) + "archive/"
+	manifest := auditArchiveManifest{
+		PartitionDay:    partitionDay,
+		EventCount:      int64(len(rows)),
+		FirstHashPrev:   rows[0].HashPrev,
+		LastHashCurr:    rows[len(rows)-1].HashCurr,
+		EventsObject:    prefix + "events.ndjson",
+		EventsSHA256:    sha256Hex(events.Bytes()),
+		EventsSizeBytes: int64(events.Len()),
+		SignerKID:       cfg.SignerKID,
+		SignatureAlg:    "ed25519",
+	}
+	manifestJSON, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, err
+	}
+	signature := hex.EncodeToString(ed25519.Sign(cfg.SigningKey, manifestJSON))
+	objects := []struct {
+		key, contentType string
+		body             []byte
+	}{
+		{manifest.EventsObject, "application/x-ndjson", events.Bytes()},
+		{prefix + "manifest.json.sig", "text/plain", []byte(signature + "\n")},
+		{prefix + "manifest.json", "application/json", manifestJSON},
+	}
+	archive := &auditPartitionArchive{
+		PartitionDay:   partitionDay,
+		EventCount:     manifest.EventCount,
+		FirstHashPrev:  manifest.FirstHashPrev,
+		LastHashCurr:   manifest.LastHashCurr,
+		EventsSHA256:   manifest.EventsSHA256,
+		ManifestSHA256: sha256Hex(manifestJSON),
+		SignerKID:      cfg.SignerKID,
+		Signature:      signature,
+	}
+	for _, sink := range cfg.Sinks {
+		for _, obj := range objects {
+			if err := sink.PutObject(ctx, obj.key, obj.contentType, obj.body); err != nil {
+				return nil, s.refuseArchive(partitionDay, "audit archive delivery failed: "+sink.Name(), err)
+			}
+		}
+		archive.Sinks = append(archive.Sinks, sink.Name())
+	}
+	archive.ArchivedAt = s.now().Format(time.RFC3339Nano)
+
+	if err := pruneAuditPartitionDB(ctx, s.db, *archive); err != nil {
+		return nil, s.refuseArchive(partitionDay, "audit partition prune failed", err)
+	}
+	after, _ := json.Marshal(archive)
+	if err := s.appendAudit(nil, partitionDay, "archive_audit_partition", []byte(`{}`), after, audit.ResultSuccess, ""); err != nil {
+		return nil, fmt.Errorf("audit unavailable: %w", err)
+	}
+	return archive, nil
+}
+
+// refuseArchive audits a partition day the retention worker did not prune
+// and returns the error the job reports.
+func (s *AuditService) refuseArchive(partitionDay, reason string, cause error) error {
+	after, _ := json.Marshal(map[string]string{"error": cause.Error()})
+	_ = s.appendAudit(nil, partitionDay, "archive_audit_partition", []byte(`{}`), after, audit.ResultError, reason)
+	return fmt.Errorf("%s partition_day=%s: %w", reason, partitionDay, cause)
+}
+
+// RetentionJob archives and prunes partition days older than the retention
+// window, oldest first. It stops at the first day it refuses so that gaps
+// never open in the retained history.
+func (s *AuditService) RetentionJob() JobFunc {
+	return func(ctx context.Context, _ string) (string, error) {
+		days := s.auditRetentionDays()
+		if s.db == nil || days <= 0 {
+			return "", nil
+		}
+		cal := gamingCalendarFor("")
+		cutoff, err := time.Parse(gamingDayLayout, cal.LastClosedGamingDay(s.now()))
+		if err != nil {
+			return "", err
+		}
+		// Partitions before the cutoff day fall outside the window.
+		before := cutoff.AddDate(0, 0, 1-days).Format(gamingDayLayout)
+		expired, err := listAuditPartitionsBeforeDB(ctx, s.db, before, maxAuditPartitionsPerRetentionRun)
+		if err != nil {
+			return "", fmt.Errorf("audit retention lookup failed: %w", err)
+		}
+		archived := make([]string, 0, len(expired))
+		for _, day := range expired {
+			archive, err := s.archivePartition(ctx, day)
+			if err != nil {
+				return strings.Join(archived, ","), err
+			}
+			if archive != nil {
+				archived = append(archived, fmt.Sprintf("%s(%d)", day, archive.EventCount))
+			}
+		}
+		if len(archived) == 0 {
+			return "", nil
+		}
+		return "audit partitions archived " + strings.Join(archived, ","), nil
+	}
+}
@@ -0,0 +1,233 @@
+package server
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	"google.golang.org/protobuf/proto"
+)
+
+func cloneAuditPartitionSeal(in *rgsv1.AuditPartitionSeal) *rgsv1.AuditPartitionSeal {
+	if in == nil {
+		return nil
+	}
+	cp, _ := proto.Clone(in).(*rgsv1.AuditPartitionSeal)
+	return cp
+}
+
+func (s *AuditService) loadPartitionSeal(ctx context.Context, partitionDay string) (*rgsv1.AuditPartitionSeal, error) {
+	if s.db != nil {
+		return getAuditPartitionSealFromDB(ctx, s.db, partitionDay)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return cloneAuditPartitionSeal(s.seals[partitionDay]), nil
+}
+
+func (s *AuditService) storePartitionSeal(ctx context.Context, seal *rgsv1.AuditPartitionSeal) error {
+	if s.db != nil {
+		if err := insertAuditPartitionSealDB(ctx, s.db, seal); err != nil {
+			return err
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.seals[seal.PartitionDay] = cloneAuditPartitionSeal(seal)
+	return nil
+}
+
+// auditMerkleLeaves returns the leaves of a partition day's Merkle tree: the
+// decoded hash_curr of each event, in chain order.
+func auditMerkleLeaves(rows []auditExportRow) ([][]byte, error) {
+	leaves := make([][]byte, 0, len(rows))
+	for _, row := range rows {
+		leaf, err := hex.DecodeString(row.HashCurr)
+		if err != nil {
+			return nil, fmt.Errorf("audit_id=%s: %w", row.AuditID, err)
+		}
+		leaves = append(leaves, leaf)
+	}
+	return leaves, nil
+}
+
+// sealPartition records the Merkle root over a closed partition day's event
+// hashes. Only a day whose chain verifies is sealed. A day is sealed once;
+// later calls return the recorded seal.
+func (s *AuditService) sealPartition(ctx context.Context, meta *rgsv1.RequestMeta, partitionDay string) (*rgsv1.AuditPartitionSeal, rgsv1.ResultCode, string) {
+	day, err := time.Parse(gamingDayLayout, partitionDay)
+	if err != nil {
+		return nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "partition_day must be YYYY-MM-DD"
+	}
+	if !s.now().After(gamingCalendarFor("").Window(day).end) {
+		return nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "partition day is not closed"
+	}
+
+	s.sealMu.Lock()
+	defer s.sealMu.Unlock()
+
+	existing, err := s.loadPartitionSeal(ctx, partitionDay)
+	if err != nil {
+		return nil, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable"
+	}
+	if existing != nil {
+		return existing, rgsv1.ResultCode_RESULT_CODE_OK, ""
+	}
+
+	if archived, err := s.partitionArchived(ctx, partitionDay); err != nil {
+		return nil, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable"
+	} else if archived {
+		return nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "partition day is archived"
+	}
+
+	rows, verified, err := s.exportPartitionDay(ctx, partitionDay)
+	if err != nil {
+		return nil, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable"
+	}
+	if len(rows) == 0 {
+		return nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "partition day has no audit events"
+	}
+	if !verified {
+		reason := "audit chain verification failed"
+		_ = s.appendAudit(meta, partitionDay, "seal_audit_partition", []byte(`{}`), []byte(`{}`), audit.ResultError, reason)
+		return nil, rgsv1.ResultCode_RESULT_CODE_ERROR, reason
+	}
+	leaves, err := auditMerkleLeaves(rows)
+	if err != nil {
+		return nil, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit chain hash is malformed"
+	}
+	seal := &rgsv1.AuditPartitionSeal{
+		PartitionDay: partitionDay,
+		EventCount:   int64(len(leaves)),
+		MerkleRoot:   hex.EncodeToString(audit.MerkleRoot(leaves)),
+		HashAlg:      "sha256",
+		SealedAt:     s.now().Format(time.RFC3339Nano),
+	}
+	if err := s.storePartitionSeal(ctx, seal); err != nil {
+		return nil, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable"
+	}
+	after, _ := json.Marshal(seal)
+	if err := s.appendAudit(meta, partitionDay, "seal_audit_partition", []byte(`{}`), after, audit.ResultSuccess, ""); err != nil {
+		return nil, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable"
+	}
+	return cloneAuditPartitionSeal(seal), rgsv1.ResultCode_RESULT_CODE_OK, ""
+}
+
+// SealJob seals the last closed partition day once it closes. Days missed
+// while the job was not running are sealed by the first GetAuditProof for
+// one of their events.
+func (s *AuditService) SealJob() JobFunc {
+	return func(ctx context.Context, _ string) (string, error) {
+		day := gamingCalendarFor("").LastClosedGamingDay(s.now())
+		existing, err := s.loadPartitionSeal(ctx, day)
+		if err != nil {
+			return "", fmt.Errorf("audit seal lookup failed partition_day=%s: %w", day, err)
+		}
+		if existing != nil {
+			return "", nil
+		}
+		seal, code, reason := s.sealPartition(ctx, nil, day)
+		if code == rgsv1.ResultCode_RESULT_CODE_INVALID {
+			// Nothing was audited that day.
+			return "", nil
+		}
+		if code != rgsv1.ResultCode_RESULT_CODE_OK {
+			return "", fmt.Errorf("audit seal failed partition_day=%s: %s", day, reason)
+		}
+		return fmt.Sprintf("audit partition sealed partition_day=%s merkle_root=%s", day, seal.MerkleRoot), nil
+	}
+}
+
+// auditEventPartitionDay returns the partition day holding an event, or ""
+// when no retained event has that id.
+func (s *AuditService) auditEventPartitionDay(ctx context.Context, auditID string) (string, error) {
+	if s.db != nil {
+		return auditEventPartitionDayFromDB(ctx, s.db, auditID)
+	}
+	for _, st := range s.mirrors() {
+		for _, e := range st.Events() {
+			if e.AuditID == auditID {
+				return e.PartitionDay, nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// GetAuditProof returns an inclusion proof for one event against the
+// Merkle root of its partition day, sealing the day first if needed. The
+// day's current events must still produce the sealed root.
+func (s *AuditService) GetAuditProof(ctx context.Context, req *rgsv1.GetAuditProofRequest) (*rgsv1.GetAuditProofResponse, error) {
+	if req == nil {
+		req = &rgsv1.GetAuditProofRequest{}
+	}
+	if ok, reason := s.authorize(ctx, req.Meta); !ok {
+		return &rgsv1.GetAuditProofResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	auditID := strings.TrimSpace(req.AuditId)
+	if auditID == "" {
+		return &rgsv1.GetAuditProofResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "audit_id is required")}, nil
+	}
+	partitionDay, err := s.auditEventPartitionDay(ctx, auditID)
+	if err != nil {
+		return &rgsv1.GetAuditProofResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if partitionDay == "" {
+		return &rgsv1.GetAuditProofResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "audit event not found")}, nil
+	}
+	seal, code, reason := s.sealPartition(ctx, req.Meta, partitionDay)
+	if code != rgsv1.ResultCode_RESULT_CODE_OK {
+		return &rgsv1.GetAuditProofResponse{Meta: s.responseMeta(req.Meta, code, reason)}, nil
+	}
+
+	rows, _, err := s.exportPartitionDay(ctx, partitionDay)
+	if err != nil {
+		return &rgsv1.GetAuditProofResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	leaves, err := auditMerkleLeaves(rows)
+	if err != nil || hex.EncodeToString(audit.MerkleRoot(leaves)) != seal.MerkleRoot {
+		return &rgsv1.GetAuditProofResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit partition does not match sealed root")}, nil
+	}
+	index := -1
+	for i, row := range rows {
+		if row.AuditID == auditID {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return &rgsv1.GetAuditProofResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "audit event not found")}, nil
+	}
+
+	row := rows[index]
+	proof := &rgsv1.AuditInclusionProof{
+		Event: &rgsv1.AuditEventRecord{
+			AuditId:    row.AuditID,
+			OccurredAt: row.OccurredAt,
+			RecordedAt: row.RecordedAt,
+			ActorId:    row.ActorID,
+			ActorType:  row.ActorType,
+			ObjectType: row.ObjectType,
+			ObjectId:   row.ObjectID,
+			Action:     row.Action,
+			Result:     row.Result,
+			Reason:     row.Reason,
+			SignerKid:  row.SignerKID,
+			Signature:  row.Signature,
+		},
+		HashPrev:  row.HashPrev,
+		LeafHash:  row.HashCurr,
+		LeafIndex: int64(index),
+		TreeSize:  int64(len(leaves)),
+		Seal:      seal,
+	}
+	for _, step := range audit.MerkleProof(leaves, index) {
+		proof.AuditPath = append(proof.AuditPath, hex.EncodeToString(step))
+	}
+	return &rgsv1.GetAuditProofResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Proof: proof}, nil
+}
@@ -0,0 +1,98 @@
+package server
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+func TestGetAuditProofVerifiesAgainstSealedRoot(t *testing.T) {
+	ledger := seedAuditPartition(t, time.Date(2026, 3, 10, 10, 0, 0, 0, time.UTC))
+	svc := NewAuditService(ledgerFixedClock{now: time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC)}, nil, ledger.AuditStore)
+	ctx := context.Background()
+	opMeta := meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+
+	events := auditEvents(ledger.AuditStore)
+	target := events[len(events)-1]
+	resp, err := svc.GetAuditProof(ctx, &rgsv1.GetAuditProofRequest{Meta: opMeta, AuditId: target.AuditID})
+	if err != nil {
+		t.Fatalf("proof err: %v", err)
+	}
+	proof := resp.Proof
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || proof.Event.GetAuditId() != target.AuditID || proof.LeafHash != target.HashCurr || proof.HashPrev != target.HashPrev || proof.TreeSize != int64(len(events)) {
+		t.Fatalf("unexpected proof: meta=%+v proof=%+v", resp.Meta, proof)
+	}
+	if proof.Seal.GetPartitionDay() != "2026-03-10" || proof.Seal.GetEventCount() != int64(len(events)) {
+		t.Fatalf("unexpected seal: %+v", proof.Seal)
+	}
+	leaf, _ := hex.DecodeString(proof.LeafHash)
+	root, _ := hex.DecodeString(proof.Seal.MerkleRoot)
+	path := make([][]byte, 0, len(proof.AuditPath))
+	for _, step := range proof.AuditPath {
+		b, _ := hex.DecodeString(step)
+		path = append(path, b)
+	}
+	if !audit.VerifyMerkleProof(leaf, int(proof.LeafIndex), int(proof.TreeSize), path, root) {
+		t.Fatalf("inclusion proof does not verify")
+	}
+
+	if summary, err := svc.SealJob()(ctx, ""); err != nil || summary != "" {
+		t.Fatalf("expected sealed day to be skipped, summary=%q err=%v", summary, err)
+	}
+	var sealed int
+	for _, ev := range auditEvents(svc.AuditStore) {
+		if ev.Action == "seal_audit_partition" && ev.ObjectID == "2026-03-10" {
+			sealed++
+		}
+	}
+	if sealed != 1 {
+		t.Fatalf("expected one seal audit event, got=%d", sealed)
+	}
+
+	svc.seals["2026-03-10"].MerkleRoot = hex.EncodeToString(make([]byte, 32))
+	forged, _ := svc.GetAuditProof(ctx, &rgsv1.GetAuditProofRequest{Meta: opMeta, AuditId: target.AuditID})
+	if forged.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_ERROR || forged.Proof != nil {
+		t.Fatalf("expected root mismatch error, got %+v", forged)
+	}
+}
+
+func TestGetAuditProofValidation(t *testing.T) {
+	ledger := seedAuditPartition(t, time.Date(2026, 3, 10, 10, 0, 0, 0, time.UTC))
+	svc := NewAuditService(ledgerFixedClock{now: time.Date(2026, 3, 10, 11, 0, 0, 0, time.UTC)}, nil, ledger.AuditStore)
+	ctx := context.Background()
+	opMeta := meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+
+	cases := map[string]string{
+		"missing id": "",
+		"unknown id": "no-such-audit-event",
+		"open day":   auditEvents(ledger.AuditStore)[0].AuditID,
+	}
+	for name, auditID := range cases {
+		resp, _ := svc.GetAuditProof(ctx, &rgsv1.GetAuditProofRequest{Meta: opMeta, AuditId: auditID})
+		if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+			t.Fatalf("%s: expected invalid, got %+v", name, resp.Meta)
+		}
+	}
+}
+
+func TestAuditSealJobSealsLastClosedDay(t *testing.T) {
+	ledger := seedAuditPartition(t, time.Date(2026, 3, 10, 10, 0, 0, 0, time.UTC))
+	svc := NewAuditService(ledgerFixedClock{now: time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC)}, nil, ledger.AuditStore)
+	summary, err := svc.SealJob()(context.Background(), "")
+	if err != nil || summary == "" {
+		t.Fatalf("expected seal, summary=%q err=%v", summary, err)
+	}
+	seal, _ := svc.loadPartitionSeal(context.Background(), "2026-03-10")
+	if seal == nil || seal.EventCount != 2 || seal.HashAlg != "sha256" {
+		t.Fatalf("unexpected seal: %+v", seal)
+	}
+
+	empty := NewAuditService(ledgerFixedClock{now: time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC)}, nil)
+	if summary, err := empty.SealJob()(context.Background(), ""); err != nil || summary != "" {
+		t.Fatalf("expected empty day to be skipped, summary=%q err=%v", summary, err)
+	}
+}
@@ -0,0 +1,127 @@
+package server
+
+import (
+	"context"
+	"crypto/ed25519"
+	"database/sql"
+	"sync/atomic"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+type auditEventSigner struct {
+	kid string
+	key ed25519.PrivateKey
+}
+
+var auditSigner atomic.Pointer[auditEventSigner]
+
+// SetAuditEventSigner signs every audit event recorded from now on with key,
+// under kid, in addition to chaining it. A nil key stops signing.
+func SetAuditEventSigner(kid string, key ed25519.PrivateKey) {
+	if len(key) == 0 {
+		auditSigner.Store(nil)
+		return
+	}
+	auditSigner.Store(&auditEventSigner{kid: kid, key: key})
+}
+
+// PostgresAuditStore appends events to audit_events, which chains them per
+// partition day, and then to Mirror so in-process readers see them too.
+type PostgresAuditStore struct {
+	DB     *sql.DB
+	Mirror *audit.InMemoryStore
+}
+
+var _ audit.Store = PostgresAuditStore{}
+
+func (p PostgresAuditStore) Append(ev audit.Event) (audit.Event, error) {
+	return p.AppendContext(context.Background(), ev)
+}
+
+func (p PostgresAuditStore) AppendContext(ctx context.Context, ev audit.Event) (audit.Event, error) {
+	if err := appendAuditEventToDB(ctx, p.DB, ev); err != nil {
+		return audit.Event{}, err
+	}
+	if p.Mirror == nil {
+		return ev, nil
+	}
+	return p.Mirror.Append(ev)
+}
+
+// NewAuditStore returns the store a service records to: an in-memory chain,
+// backed by Postgres when db is set.
+func NewAuditStore(db *sql.DB) audit.Store {
+	mem := audit.NewInMemoryStore()
+	if db == nil {
+		return mem
+	}
+	return PostgresAuditStore{DB: db, Mirror: mem}
+}
+
+// auditMirror returns the in-memory chain behind store, which in-process
+// readers list events from, or nil when it has none.
+func auditMirror(store audit.Store) *audit.InMemoryStore {
+	switch st := store.(type) {
+	case *audit.InMemoryStore:
+		return st
+	case PostgresAuditStore:
+		return st.Mirror
+	}
+	return nil
+}
+
+// newAuditEvent attributes an event to the request's actor, or to the
+// system when there is none.
+func newAuditEvent(meta *rgsv1.RequestMeta, auditID string, now time.Time, objectType, objectID, action string, before, after []byte, result audit.Result, reason string) audit.Event {
+	actorID := "system"
+	actorType := "service"
+	if meta != nil && meta.Actor != nil {
+		actorID = meta.Actor.ActorId
+		actorType = meta.Actor.ActorType.String()
+	}
+	return audit.Event{
+		AuditID:      auditID,
+		OccurredAt:   now,
+		RecordedAt:   now,
+		ActorID:      actorID,
+		ActorType:    actorType,
+		AuthContext:  impersonationAuthContext(meta),
+		ObjectType:   objectType,
+		ObjectID:     objectID,
+		Action:       action,
+		Before:       before,
+		After:        after,
+		Result:       result,
+		Reason:       reason,
+		PartitionDay: auditPartitionDay(now),
+	}
+}
+
+// signAuditEvent signs ev with the configured signer, if any, unless it is
+// already signed.
+func signAuditEvent(ev audit.Event) audit.Event {
+	if signer := auditSigner.Load(); signer != nil && ev.Signature == "" {
+		ev = audit.Sign(ev, signer.kid, signer.key)
+	}
+	return ev
+}
+
+// appendAuditTo signs ev when a signer is configured and appends it to
+// store, passing ctx on to stores that take one.
+func appendAuditTo(ctx context.Context, store audit.Store, ev audit.Event) error {
+	if store == nil {
+		return audit.ErrCorruptChain
+	}
+	ev = signAuditEvent(ev)
+	if cs, ok := store.(interface {
+		AppendContext(context.Context, audit.Event) (audit.Event, error)
+	}); ok {
+		_, err := cs.AppendContext(ctx, ev)
+		return err
+	}
+	_, err := store.Append(ev)
+	return err
+}
@@ -0,0 +1,118 @@
+package server
+
+import (
+	"context"
+	"crypto/ed25519"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+// auditEvents lists the events a service's store chained in process.
+func auditEvents(store audit.Store) []audit.Event {
+	mem := auditMirror(store)
+	if mem == nil {
+		return nil
+	}
+	return mem.Events()
+}
+
+func TestNewAuditStoreFallsBackToInMemoryChain(t *testing.T) {
+	if err := appendAuditTo(context.Background(), nil, audit.Event{AuditID: "a1"}); !errors.Is(err, audit.ErrCorruptChain) {
+		t.Fatalf("expected a service without a store to fail closed, got %v", err)
+	}
+	store := NewAuditStore(nil)
+	mem, ok := store.(*audit.InMemoryStore)
+	if !ok || auditMirror(store) != mem {
+		t.Fatalf("expected in-memory store without a database, got %T", store)
+	}
+	if pg := NewAuditStore(&sql.DB{}); auditMirror(pg) == nil {
+		t.Fatalf("expected a database-backed store to keep an in-memory mirror, got %T", pg)
+	}
+
+	now := time.Date(2026, 3, 10, 23, 30, 0, 0, time.UTC)
+	req := meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "")
+	req.Impersonator = &rgsv1.Actor{ActorId: "op-1", ActorType: rgsv1.ActorType_ACTOR_TYPE_OPERATOR}
+	ev := newAuditEvent(req, "a1", now, "ledger_account", "player-1", "deposit", []byte(`{}`), []byte(`{}`), audit.ResultSuccess, "")
+	if err := appendAuditTo(context.Background(), store, ev); err != nil {
+		t.Fatalf("append: %v", err)
+	}
+	got := mem.Events()
+	if len(got) != 1 || got[0].ActorID != "player-1" || got[0].ActorType != "ACTOR_TYPE_PLAYER" || got[0].AuthContext != "impersonator=ACTOR_TYPE_OPERATOR:op-1" || got[0].PartitionDay != auditPartitionDay(now) || got[0].HashCurr == "" {
+		t.Fatalf("unexpected chained event: %+v", got)
+	}
+
+	system := newAuditEvent(nil, "a2", now, "outbox_event", "outbox_events", "dispatch", nil, nil, audit.ResultSuccess, "")
+	if system.ActorID != "system" || system.ActorType != "service" || system.AuthContext != "" {
+		t.Fatalf("expected system attribution, got %+v", system)
+	}
+}
+
+func TestAuditEventSignerSignsAppendedEvents(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	SetAuditEventSigner("audit-event-k1", priv)
+	t.Cleanup(func() { SetAuditEventSigner("", nil) })
+
+	mem := audit.NewInMemoryStore()
+	now := time.Date(2026, 3, 10, 23, 30, 0, 0, time.UTC)
+	ev := newAuditEvent(meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), "a1", now, "config_change", "c1", "propose", []byte(`{}`), []byte(`{"v":1}`), audit.ResultSuccess, "")
+	if err := appendAuditTo(context.Background(), mem, ev); err != nil {
+		t.Fatalf("append: %v", err)
+	}
+	got := mem.Events()[0]
+	if got.SignerKID != "audit-event-k1" || !audit.VerifySignature(got, pub) {
+		t.Fatalf("expected signed event, got %+v", got)
+	}
+
+	row := auditExportRowFromEvent(got)
+	payload, err := base64.StdEncoding.DecodeString(row.SignedPayload)
+	if err != nil || row.Signature != got.Signature {
+		t.Fatalf("unexpected export row: %+v err=%v", row, err)
+	}
+	sig, _ := hex.DecodeString(row.Signature)
+	if !ed25519.Verify(pub, payload, sig) {
+		t.Fatalf("exported signed_payload does not verify")
+	}
+
+	svc := NewAuditService(ledgerFixedClock{now: now}, nil, mem)
+	resp, _ := svc.ListAuditEvents(context.Background(), &rgsv1.ListAuditEventsRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")})
+	if len(resp.GetEvents()) != 1 || resp.Events[0].GetSignature() != got.Signature || resp.Events[0].GetSignerKid() != "audit-event-k1" {
+		t.Fatalf("expected signature in listing, got %+v", resp)
+	}
+
+	SetAuditEventSigner("", nil)
+	if err := appendAuditTo(context.Background(), mem, newAuditEvent(nil, "a2", now, "outbox_event", "outbox_events", "dispatch", nil, nil, audit.ResultSuccess, "")); err != nil {
+		t.Fatalf("append unsigned: %v", err)
+	}
+	if last := mem.Events()[1]; last.Signature != "" || auditExportRowFromEvent(last).SignedPayload != "" {
+		t.Fatalf("expected unsigned event once signing is off, got %+v", last)
+	}
+}
+
+type recordingAuditStore struct {
+	events []audit.Event
+}
+
+func (r *recordingAuditStore) Append(ev audit.Event) (audit.Event, error) {
+	r.events = append(r.events, ev)
+	return ev, nil
+}
+
+func TestServicesRecordToInjectedAuditStore(t *testing.T) {
+	store := &recordingAuditStore{}
+	svc := NewLedgerService(ledgerFixedClock{now: time.Date(2026, 3, 10, 23, 30, 0, 0, time.UTC)})
+	svc.AuditStore = store
+	resp, _ := svc.Deposit(context.Background(), &rgsv1.DepositRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "dep-1"), AccountId: "player-1", Amount: &rgsv1.Money{AmountMinor: 100, Currency: "USD"}})
+	if resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK || len(store.events) != 1 || store.events[0].Action != "deposit" {
+		t.Fatalf("expected the deposit audited to the injected store, got %+v events=%+v", resp.Meta, store.events)
+	}
+	if got := svc.AuditEvents(); got != nil {
+		t.Fatalf("expected no in-process events from a store without a mirror, got %+v", got)
+	}
+}
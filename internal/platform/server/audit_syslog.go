@@ -0,0 +1,330 @@
+package server
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+const (
+	AuditSyslogFormatCEF  = "cef"
+	AuditSyslogFormatLEEF = "leef"
+)
+
+var syslogFacilities = map[string]int{
+	"kern": 0, "user": 1, "daemon": 3, "auth": 4, "syslog": 5, "authpriv": 10,
+	"audit": 13, "local0": 16, "local1": 17, "local2": 18, "local3": 19,
+	"local4": 20, "local5": 21, "local6": 22, "local7": 23,
+}
+
+var syslogSeverities = map[string]int{
+	"emerg": 0, "alert": 1, "crit": 2, "err": 3, "warning": 4, "notice": 5, "info": 6, "debug": 7,
+}
+
+// cefSeverity maps syslog severities onto the 0-10 scale CEF and LEEF use.
+var cefSeverity = [8]int{10, 9, 8, 7, 5, 4, 3, 1}
+
+// ParseSyslogFacility accepts a facility name such as local0 or a number
+// from 0 to 23.
+func ParseSyslogFacility(v string) (int, error) {
+	v = strings.ToLower(strings.TrimSpace(v))
+	if f, ok := syslogFacilities[v]; ok {
+		return f, nil
+	}
+	if f, err := strconv.Atoi(v); err == nil && f >= 0 && f <= 23 {
+		return f, nil
+	}
+	return 0, fmt.Errorf("unknown syslog facility %q", v)
+}
+
+// ParseSyslogSeverityMap parses result=severity pairs such as
+// "success=info,denied=warning,error=err". Results left out keep the
+// defaults.
+func ParseSyslogSeverityMap(v string) (map[audit.Result]int, error) {
+	out := defaultSyslogSeverityMap()
+	for _, pair := range strings.Split(v, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		result, sev, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid syslog severity mapping %q", pair)
+		}
+		level, ok := syslogSeverities[strings.ToLower(strings.TrimSpace(sev))]
+		if !ok {
+			return nil, fmt.Errorf("unknown syslog severity %q", sev)
+		}
+		out[audit.Result(strings.ToLower(strings.TrimSpace(result)))] = level
+	}
+	return out, nil
+}
+
+func defaultSyslogSeverityMap() map[audit.Result]int {
+	return map[audit.Result]int{
+		audit.ResultSuccess: syslogSeverities["info"],
+		audit.ResultDenied:  syslogSeverities["warning"],
+		audit.ResultError:   syslogSeverities["err"],
+	}
+}
+
+// SyslogAuditForwarderConfig describes the SIEM endpoint audit events are
+// mirrored to.
+type SyslogAuditForwarderConfig struct {
+	// Network is udp, tcp, or tls.
+	Network string
+	Addr    string
+	// Format is AuditSyslogFormatCEF or AuditSyslogFormatLEEF.
+	Format   string
+	Facility int
+	// Severities maps audit results to syslog severities; results missing
+	// from it are sent as notice.
+	Severities map[audit.Result]int
+	Hostname   string
+	TLSConfig  *tls.Config
+	// QueueSize bounds the events waiting for delivery (1024 when zero).
+	QueueSize int
+	Logf      func(string, ...any)
+}
+
+// SyslogAuditForwarder mirrors audit events to a syslog endpoint as RFC 5424
+// messages carrying a CEF or LEEF payload. Events are queued and sent in
+// order by one goroutine; when the queue is full or the endpoint is down
+// events are dropped and logged, since the audit chain itself stays
+// authoritative.
+type SyslogAuditForwarder struct {
+	cfg   SyslogAuditForwarderConfig
+	queue chan audit.Event
+	done  chan struct{}
+	dial  func() (net.Conn, error)
+
+	mu      sync.Mutex
+	closed  bool
+	dropped int64
+}
+
+func NewSyslogAuditForwarder(cfg SyslogAuditForwarderConfig) (*SyslogAuditForwarder, error) {
+	if cfg.Addr == "" {
+		return nil, fmt.Errorf("syslog address is required")
+	}
+	if cfg.Network == "" {
+		cfg.Network = "udp"
+	}
+	if cfg.Format == "" {
+		cfg.Format = AuditSyslogFormatCEF
+	}
+	if cfg.Format != AuditSyslogFormatCEF && cfg.Format != AuditSyslogFormatLEEF {
+		return nil, fmt.Errorf("unknown syslog audit format %q", cfg.Format)
+	}
+	if cfg.Facility < 0 || cfg.Facility > 23 {
+		return nil, fmt.Errorf("syslog facility must be between 0 and 23")
+	}
+	if cfg.Severities == nil {
+		cfg.Severities = defaultSyslogSeverityMap()
+	}
+	if cfg.Hostname == "" {
+		cfg.Hostname, _ = os.Hostname()
+	}
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = 1024
+	}
+	f := &SyslogAuditForwarder{
+		cfg:   cfg,
+		queue: make(chan audit.Event, cfg.QueueSize),
+		done:  make(chan struct{}),
+	}
+	switch cfg.Network {
+	case "udp", "tcp":
+		f.dial = func() (net.Conn, error) { return net.DialTimeout(cfg.Network, cfg.Addr, 10*time.Second) }
+	case "tls":
+		f.dial = func() (net.Conn, error) {
+			return tls.DialWithDialer(&net.Dialer{Timeout: 10 * time.Second}, "tcp", cfg.Addr, cfg.TLSConfig)
+		}
+	default:
+		return nil, fmt.Errorf("unknown syslog network %q", cfg.Network)
+	}
+	go f.run()
+	return f, nil
+}
+
+func (f *SyslogAuditForwarder) Forward(e audit.Event) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.closed {
+		return
+	}
+	select {
+	case f.queue <- e:
+	default:
+		f.dropped++
+		f.logf("audit syslog queue full, dropped audit_id=%s", e.AuditID)
+	}
+}
+
+// Dropped reports how many events were not delivered.
+func (f *SyslogAuditForwarder) Dropped() int64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.dropped
+}
+
+// Close stops accepting events and waits for queued ones to be sent.
+func (f *SyslogAuditForwarder) Close() {
+	f.mu.Lock()
+	if !f.closed {
+		f.closed = true
+		close(f.queue)
+	}
+	f.mu.Unlock()
+	<-f.done
+}
+
+func (f *SyslogAuditForwarder) logf(format string, args ...any) {
+	if f.cfg.Logf != nil {
+		f.cfg.Logf(format, args...)
+	}
+}
+
+func (f *SyslogAuditForwarder) run() {
+	defer close(f.done)
+	var conn net.Conn
+	defer func() {
+		if conn != nil {
+			conn.Close()
+		}
+	}()
+	for e := range f.queue {
+		msg := f.message(e)
+		if f.cfg.Network != "udp" {
+			// RFC 6587 octet counting.
+			msg = strconv.Itoa(len(msg)) + " " + msg
+		}
+		// One reconnect per event covers a stream the collector closed.
+		var err error
+		for attempt := 0; attempt < 2; attempt++ {
+			if conn == nil {
+				if conn, err = f.dial(); err != nil {
+					conn = nil
+					break
+				}
+			}
+			_ = conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
+			if _, err = conn.Write([]byte(msg)); err == nil {
+				break
+			}
+			conn.Close()
+			conn = nil
+		}
+		if err != nil {
+			f.mu.Lock()
+			f.dropped++
+			f.mu.Unlock()
+			f.logf("audit syslog delivery failed audit_id=%s: %v", e.AuditID, err)
+		}
+	}
+}
+
+func (f *SyslogAuditForwarder) severity(r audit.Result) int {
+	if sev, ok := f.cfg.Severities[r]; ok {
+		return sev
+	}
+	return syslogSeverities["notice"]
+}
+
+// message renders e as an RFC 5424 syslog message.
+func (f *SyslogAuditForwarder) message(e audit.Event) string {
+	sev := f.severity(e.Result)
+	var payload string
+	if f.cfg.Format == AuditSyslogFormatLEEF {
+		payload = formatAuditLEEF(e, cefSeverity[sev])
+	} else {
+		payload = formatAuditCEF(e, cefSeverity[sev])
+	}
+	ts := e.RecordedAt
+	if ts.IsZero() {
+		ts = time.Now()
+	}
+	hostname := f.cfg.Hostname
+	if hostname == "" {
+		hostname = "-"
+	}
+	return fmt.Sprintf("<%d>1 %s %s open-rgs - audit - %s", f.cfg.Facility*8+sev, ts.UTC().Format(time.RFC3339Nano), hostname, payload)
+}
+
+var (
+	cefHeaderEscaper    = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\n", " ", "\r", " ")
+	cefExtensionEscaper = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\n", `\n`, "\r", `\r`)
+	leefValueEscaper    = strings.NewReplacer("\t", " ", "\n", " ", "\r", " ")
+)
+
+func formatAuditCEF(e audit.Event, severity int) string {
+	ext := [][2]string{
+		{"rt", strconv.FormatInt(e.RecordedAt.UnixMilli(), 10)},
+		{"externalId", e.AuditID},
+		{"suser", e.ActorID},
+		{"act", e.Action},
+		{"outcome", string(e.Result)},
+		{"reason", e.Reason},
+		{"cs1Label", "actorType"}, {"cs1", e.ActorType},
+		{"cs2Label", "objectType"}, {"cs2", e.ObjectType},
+		{"cs3Label", "objectId"}, {"cs3", e.ObjectID},
+		{"cs4Label", "hashCurr"}, {"cs4", e.HashCurr},
+		{"cs5Label", "authContext"}, {"cs5", e.AuthContext},
+		{"cs6Label", "partitionDay"}, {"cs6", e.PartitionDay},
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "CEF:0|WizardBeardStudio|open-rgs|1.0|%s|%s|%d|",
+		cefHeaderEscaper.Replace(e.Action), cefHeaderEscaper.Replace(e.ObjectType+" "+e.Action), severity)
+	first := true
+	for _, kv := range ext {
+		if kv[1] == "" {
+			continue
+		}
+		if !first {
+			b.WriteByte(' ')
+		}
+		first = false
+		b.WriteString(kv[0] + "=" + cefExtensionEscaper.Replace(kv[1]))
+	}
+	return b.String()
+}
+
+func formatAuditLEEF(e audit.Event, severity int) string {
+	attrs := [][2]string{
+		{"devTime", e.RecordedAt.UTC().Format("Jan 02 2006 15:04:05.000 MST")},
+		{"devTimeFormat", "MMM dd yyyy HH:mm:ss.SSS z"},
+		{"sev", strconv.Itoa(severity)},
+		{"auditId", e.AuditID},
+		{"usrName", e.ActorID},
+		{"actorType", e.ActorType},
+		{"cat", e.ObjectType},
+		{"resource", e.ObjectID},
+		{"action", e.Action},
+		{"result", string(e.Result)},
+		{"reason", e.Reason},
+		{"authContext", e.AuthContext},
+		{"partitionDay", e.PartitionDay},
+		{"hashCurr", e.HashCurr},
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "LEEF:1.0|WizardBeardStudio|open-rgs|1.0|%s|", cefHeaderEscaper.Replace(e.Action))
+	first := true
+	for _, kv := range attrs {
+		if kv[1] == "" {
+			continue
+		}
+		if !first {
+			b.WriteByte('\t')
+		}
+		first = false
+		b.WriteString(kv[0] + "=" + leefValueEscaper.Replace(kv[1]))
+	}
+	return b.String()
+}
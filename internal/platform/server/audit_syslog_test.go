@@ -0,0 +1,167 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+func TestSyslogAuditForwarderSendsCEFOverUDP(t *testing.T) {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	defer pc.Close()
+	fwd, err := NewSyslogAuditForwarder(SyslogAuditForwarderConfig{Addr: pc.LocalAddr().String(), Facility: 16, Hostname: "rgs-1"})
+	if err != nil {
+		t.Fatalf("new forwarder: %v", err)
+	}
+	defer fwd.Close()
+
+	ledger := NewLedgerService(ledgerFixedClock{now: time.Date(2026, 3, 10, 10, 0, 0, 0, time.UTC)})
+	NewAuditService(ledgerFixedClock{now: time.Date(2026, 3, 10, 10, 0, 0, 0, time.UTC)}, nil, ledger.AuditStore).SetForwarder(fwd)
+	resp, _ := ledger.Deposit(context.Background(), &rgsv1.DepositRequest{
+		Meta:      meta("player-syslog-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "syslog-dep-1"),
+		AccountId: "player-syslog-1",
+		Amount:    &rgsv1.Money{AmountMinor: 100, Currency: "USD"},
+	})
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("deposit: %+v", resp.Meta)
+	}
+
+	buf := make([]byte, 4096)
+	_ = pc.SetReadDeadline(time.Now().Add(5 * time.Second))
+	n, _, err := pc.ReadFrom(buf)
+	if err != nil {
+		t.Fatalf("read datagram: %v", err)
+	}
+	msg := string(buf[:n])
+	ev := auditEvents(ledger.AuditStore)[0]
+	// local0 (16) * 8 + info (6)
+	if !strings.HasPrefix(msg, "<134>1 2026-03-10T10:00:00Z rgs-1 open-rgs - audit - CEF:0|WizardBeardStudio|open-rgs|1.0|"+ev.Action+"|") {
+		t.Fatalf("unexpected syslog header: %q", msg)
+	}
+	for _, want := range []string{"|3|", "externalId=" + ev.AuditID, "suser=player-syslog-1", "outcome=success", "cs4=" + ev.HashCurr} {
+		if !strings.Contains(msg, want) {
+			t.Fatalf("message missing %q: %q", want, msg)
+		}
+	}
+}
+
+func TestSyslogAuditForwarderFramesLEEFOverTCP(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	defer ln.Close()
+	severities, err := ParseSyslogSeverityMap("denied=alert")
+	if err != nil {
+		t.Fatalf("parse severities: %v", err)
+	}
+	fwd, err := NewSyslogAuditForwarder(SyslogAuditForwarderConfig{Network: "tcp", Addr: ln.Addr().String(), Format: AuditSyslogFormatLEEF, Facility: 13, Severities: severities, Hostname: "rgs-1"})
+	if err != nil {
+		t.Fatalf("new forwarder: %v", err)
+	}
+	store := audit.NewInMemoryStore()
+	store.SetForwarder(fwd)
+	recorded := time.Date(2026, 3, 10, 10, 0, 0, 0, time.UTC)
+	for _, id := range []string{"a1", "a2"} {
+		if _, err := store.Append(audit.Event{AuditID: id, RecordedAt: recorded, ActorID: "op-1", ObjectType: "ledger_account", Action: "withdraw", Result: audit.ResultDenied, Reason: "tab\there"}); err != nil {
+			t.Fatalf("append: %v", err)
+		}
+	}
+	fwd.Close()
+
+	conn, err := ln.Accept()
+	if err != nil {
+		t.Fatalf("accept: %v", err)
+	}
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	for _, id := range []string{"a1", "a2"} {
+		size, err := r.ReadString(' ')
+		if err != nil {
+			t.Fatalf("read frame length: %v", err)
+		}
+		n, _ := strconv.Atoi(strings.TrimSpace(size))
+		frame := make([]byte, n)
+		if _, err := io.ReadFull(r, frame); err != nil {
+			t.Fatalf("read frame: %v", err)
+		}
+		msg := string(frame)
+		// audit (13) * 8 + alert (1)
+		if !strings.HasPrefix(msg, "<105>1 ") || !strings.Contains(msg, "LEEF:1.0|WizardBeardStudio|open-rgs|1.0|withdraw|") {
+			t.Fatalf("unexpected frame: %q", msg)
+		}
+		for _, want := range []string{"\tsev=9\t", "\tauditId=" + id + "\t", "\tresult=denied\t", "\treason=tab here\t"} {
+			if !strings.Contains(msg, want) {
+				t.Fatalf("frame missing %q: %q", want, msg)
+			}
+		}
+	}
+}
+
+func TestFormatAuditCEFEscapes(t *testing.T) {
+	got := formatAuditCEF(audit.Event{AuditID: "a1", Action: "a|b", ObjectType: "obj", Reason: "x=y\\z\nnext", Result: audit.ResultError}, 7)
+	if !strings.HasPrefix(got, `CEF:0|WizardBeardStudio|open-rgs|1.0|a\|b|obj a\|b|7|`) {
+		t.Fatalf("unexpected header: %q", got)
+	}
+	if !strings.Contains(got, `reason=x\=y\\z\nnext`) {
+		t.Fatalf("unexpected extension escaping: %q", got)
+	}
+}
+
+func TestParseSyslogConfig(t *testing.T) {
+	if f, err := ParseSyslogFacility("LOCAL4"); err != nil || f != 20 {
+		t.Fatalf("local4: f=%d err=%v", f, err)
+	}
+	if f, err := ParseSyslogFacility("13"); err != nil || f != 13 {
+		t.Fatalf("numeric: f=%d err=%v", f, err)
+	}
+	if _, err := ParseSyslogFacility("local9"); err == nil {
+		t.Fatalf("expected unknown facility error")
+	}
+	m, err := ParseSyslogSeverityMap("success=notice, error=crit")
+	if err != nil || m[audit.ResultSuccess] != 5 || m[audit.ResultError] != 2 || m[audit.ResultDenied] != 4 {
+		t.Fatalf("unexpected severities: %+v err=%v", m, err)
+	}
+	for _, bad := range []string{"success", "success=loud"} {
+		if _, err := ParseSyslogSeverityMap(bad); err == nil {
+			t.Fatalf("expected error for %q", bad)
+		}
+	}
+	if _, err := NewSyslogAuditForwarder(SyslogAuditForwarderConfig{Addr: "127.0.0.1:514", Format: "json"}); err == nil {
+		t.Fatalf("expected unknown format error")
+	}
+}
+
+func TestSyslogAuditForwarderCountsUndeliveredEvents(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	addr := ln.Addr().String()
+	ln.Close()
+	var logged []string
+	fwd, err := NewSyslogAuditForwarder(SyslogAuditForwarderConfig{Network: "tcp", Addr: addr, Logf: func(f string, a ...any) { logged = append(logged, f) }})
+	if err != nil {
+		t.Fatalf("new forwarder: %v", err)
+	}
+	fwd.Forward(audit.Event{AuditID: "a1", Action: "login", Result: audit.ResultSuccess})
+	fwd.Close()
+	if fwd.Dropped() != 1 || len(logged) != 1 {
+		t.Fatalf("expected one dropped and logged event, dropped=%d logged=%v", fwd.Dropped(), logged)
+	}
+	fwd.Forward(audit.Event{AuditID: "a2"})
+	if fwd.Dropped() != 1 {
+		t.Fatalf("events after close should be ignored")
+	}
+}
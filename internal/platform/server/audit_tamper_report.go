@@ -0,0 +1,254 @@
+package server
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+// maxTamperEvidenceReportDays bounds one report, since every day in the
+// range is re-verified.
+const maxTamperEvidenceReportDays = 366
+
+// tamperEvidencePartition and tamperEvidenceReport are signed as
+// serialized, so field order and names are part of the report format.
+type tamperEvidencePartition struct {
+	PartitionDay  string `json:"partition_day"`
+	EventCount    int64  `json:"event_count"`
+	FirstHashPrev string `json:"first_hash_prev"`
+	LastHashCurr  string `json:"last_hash_curr"`
+	ChainVerified bool   `json:"chain_verified"`
+	Anchored      bool   `json:"anchored"`
+	Archived      bool   `json:"archived"`
+	FailureReason string `json:"failure_reason,omitempty"`
+}
+
+type tamperEvidenceReport struct {
+	FromDay       string                    `json:"from_day"`
+	ToDay         string                    `json:"to_day"`
+	EventsChecked int64                     `json:"events_checked"`
+	FirstHashPrev string                    `json:"first_hash_prev"`
+	LastHashCurr  string                    `json:"last_hash_curr"`
+	Verified      bool                      `json:"verified"`
+	Partitions    []tamperEvidencePartition `json:"partitions"`
+	GeneratedAt   string                    `json:"generated_at"`
+	SignerKID     string                    `json:"signer_kid"`
+	SignatureAlg  string                    `json:"signature_alg"`
+}
+
+func (r tamperEvidenceReport) proto() *rgsv1.TamperEvidenceReport {
+	out := &rgsv1.TamperEvidenceReport{
+		FromDay:       r.FromDay,
+		ToDay:         r.ToDay,
+		EventsChecked: r.EventsChecked,
+		FirstHashPrev: r.FirstHashPrev,
+		LastHashCurr:  r.LastHashCurr,
+		Verified:      r.Verified,
+		GeneratedAt:   r.GeneratedAt,
+		SignerKid:     r.SignerKID,
+		SignatureAlg:  r.SignatureAlg,
+	}
+	for _, p := range r.Partitions {
+		out.Partitions = append(out.Partitions, &rgsv1.TamperEvidencePartition{
+			PartitionDay:  p.PartitionDay,
+			EventCount:    p.EventCount,
+			FirstHashPrev: p.FirstHashPrev,
+			LastHashCurr:  p.LastHashCurr,
+			ChainVerified: p.ChainVerified,
+			Anchored:      p.Anchored,
+			Archived:      p.Archived,
+			FailureReason: p.FailureReason,
+		})
+	}
+	return out
+}
+
+// SetReportSigner sets the key that signs tamper-evidence reports. Without
+// one, reports are signed with the audit export key.
+func (s *AuditService) SetReportSigner(kid string, key ed25519.PrivateKey) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reportSignerKID = kid
+	s.reportSigningKey = key
+}
+
+func (s *AuditService) reportSigner() (string, ed25519.PrivateKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.reportSigningKey) > 0 {
+		return s.reportSignerKID, s.reportSigningKey
+	}
+	return s.exportCfg.SignerKID, s.exportCfg.SigningKey
+}
+
+// tamperEvidencePartition verifies one partition day. An archived day is
+// reported from its archive record, which was written only after the day
+// verified, and is checked against its anchor if it has one. A mismatch is
+// reported in the result; only an unreadable store is an error.
+func (s *AuditService) tamperEvidencePartition(ctx context.Context, partitionDay string) (tamperEvidencePartition, error) {
+	p := tamperEvidencePartition{PartitionDay: partitionDay, ChainVerified: true}
+	anchor, err := s.loadChainAnchor(ctx, partitionDay)
+	if err != nil {
+		return p, err
+	}
+	p.Anchored = anchor != nil
+
+	if s.db == nil {
+		rows, verified, _ := s.exportPartitionDay(ctx, partitionDay)
+		p.EventCount = int64(len(rows))
+		if len(rows) > 0 {
+			p.FirstHashPrev = rows[0].HashPrev
+			p.LastHashCurr = rows[len(rows)-1].HashCurr
+		}
+		if !verified {
+			p.ChainVerified, p.FailureReason = false, "audit chain verification failed"
+		} else if anchor != nil {
+			head, count, _ := s.partitionChainHead(ctx, partitionDay)
+			if head != anchor.ChainHead || count != anchor.EventCount {
+				p.ChainVerified, p.FailureReason = false, "audit chain does not match anchored head"
+			}
+		}
+		return p, nil
+	}
+
+	archive, err := getAuditPartitionArchiveFromDB(ctx, s.db, partitionDay)
+	if err != nil {
+		return p, err
+	}
+	if archive != nil {
+		p.Archived = true
+		p.EventCount = archive.EventCount
+		p.FirstHashPrev = archive.FirstHashPrev
+		p.LastHashCurr = archive.LastHashCurr
+	} else {
+		rows, err := exportAuditPartitionFromDB(ctx, s.db, partitionDay)
+		if err != nil {
+			return p, err
+		}
+		p.EventCount = int64(len(rows))
+		if len(rows) > 0 {
+			p.FirstHashPrev = rows[0].HashPrev
+			p.LastHashCurr = rows[len(rows)-1].HashCurr
+		}
+		if err := verifyAuditChainFromDB(ctx, s.db, partitionDay); err != nil {
+			if !errors.Is(err, errAuditChainMismatch) {
+				return p, err
+			}
+			p.ChainVerified, p.FailureReason = false, "audit chain verification failed"
+			return p, nil
+		}
+	}
+	if err := verifyAuditChainAnchorsFromDB(ctx, s.db, partitionDay); err != nil {
+		if !errors.Is(err, errAuditChainMismatch) {
+			return p, err
+		}
+		p.ChainVerified, p.FailureReason = false, "audit chain does not match anchored head"
+	}
+	return p, nil
+}
+
+// generateTamperEvidenceReport verifies every partition day from fromDay to
+// toDay and signs the summary. The report is returned even when a day fails
+// verification, since that is what it exists to show.
+func (s *AuditService) generateTamperEvidenceReport(ctx context.Context, meta *rgsv1.RequestMeta, fromDay, toDay string) (*tamperEvidenceReport, []byte, string, rgsv1.ResultCode, string) {
+	from, err := time.Parse(gamingDayLayout, fromDay)
+	if err != nil {
+		return nil, nil, "", rgsv1.ResultCode_RESULT_CODE_INVALID, "from_day must be YYYY-MM-DD"
+	}
+	to, err := time.Parse(gamingDayLayout, toDay)
+	if err != nil {
+		return nil, nil, "", rgsv1.ResultCode_RESULT_CODE_INVALID, "to_day must be YYYY-MM-DD"
+	}
+	if to.Before(from) {
+		return nil, nil, "", rgsv1.ResultCode_RESULT_CODE_INVALID, "from_day must not be after to_day"
+	}
+	if to.Sub(from) >= maxTamperEvidenceReportDays*24*time.Hour {
+		return nil, nil, "", rgsv1.ResultCode_RESULT_CODE_INVALID, "report range must not exceed 366 days"
+	}
+	if !s.now().After(gamingCalendarFor("").Window(to).end) {
+		return nil, nil, "", rgsv1.ResultCode_RESULT_CODE_INVALID, "to_day is not closed"
+	}
+	kid, key := s.reportSigner()
+	if len(key) == 0 {
+		return nil, nil, "", rgsv1.ResultCode_RESULT_CODE_ERROR, "tamper evidence report signing not configured"
+	}
+
+	report := &tamperEvidenceReport{
+		FromDay:      fromDay,
+		ToDay:        toDay,
+		Verified:     true,
+		Partitions:   make([]tamperEvidencePartition, 0),
+		GeneratedAt:  s.now().Format(time.RFC3339Nano),
+		SignerKID:    kid,
+		SignatureAlg: "ed25519",
+	}
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		p, err := s.tamperEvidencePartition(ctx, day.Format(gamingDayLayout))
+		if err != nil {
+			return nil, nil, "", rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable"
+		}
+		report.Partitions = append(report.Partitions, p)
+		report.EventsChecked += p.EventCount
+		report.Verified = report.Verified && p.ChainVerified
+		if p.EventCount > 0 {
+			if report.FirstHashPrev == "" {
+				report.FirstHashPrev = p.FirstHashPrev
+			}
+			report.LastHashCurr = p.LastHashCurr
+		}
+	}
+	reportJSON, err := json.Marshal(report)
+	if err != nil {
+		return nil, nil, "", rgsv1.ResultCode_RESULT_CODE_ERROR, "tamper evidence report unavailable"
+	}
+	signature := hex.EncodeToString(ed25519.Sign(key, reportJSON))
+
+	after, _ := json.Marshal(map[string]any{
+		"from_day":       fromDay,
+		"to_day":         toDay,
+		"events_checked": report.EventsChecked,
+		"verified":       report.Verified,
+		"report_sha256":  sha256Hex(reportJSON),
+		"signer_kid":     kid,
+	})
+	if err := s.appendAudit(meta, fromDay+".."+toDay, "generate_tamper_evidence_report", []byte(`{}`), after, audit.ResultSuccess, ""); err != nil {
+		return nil, nil, "", rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable"
+	}
+	return report, reportJSON, signature, rgsv1.ResultCode_RESULT_CODE_OK, ""
+}
+
+func (s *AuditService) GenerateTamperEvidenceReport(ctx context.Context, req *rgsv1.GenerateTamperEvidenceReportRequest) (*rgsv1.GenerateTamperEvidenceReportResponse, error) {
+	if req == nil {
+		req = &rgsv1.GenerateTamperEvidenceReportRequest{}
+	}
+	toDay := strings.TrimSpace(req.ToDay)
+	if toDay == "" {
+		toDay = gamingCalendarFor("").LastClosedGamingDay(s.now())
+	}
+	fromDay := strings.TrimSpace(req.FromDay)
+	if fromDay == "" {
+		fromDay = toDay
+	}
+	if ok, reason := s.authorize(ctx, req.Meta); !ok {
+		_ = s.appendAudit(req.Meta, fromDay+".."+toDay, "generate_tamper_evidence_report", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		return &rgsv1.GenerateTamperEvidenceReportResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	report, reportJSON, signature, code, reason := s.generateTamperEvidenceReport(ctx, req.Meta, fromDay, toDay)
+	resp := &rgsv1.GenerateTamperEvidenceReportResponse{Meta: s.responseMeta(req.Meta, code, reason)}
+	if report != nil {
+		resp.Report = report.proto()
+		resp.ReportJson = reportJSON
+		resp.Signature = signature
+	}
+	return resp, nil
+}
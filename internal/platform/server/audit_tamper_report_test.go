@@ -0,0 +1,90 @@
+package server
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func TestGenerateTamperEvidenceReportSignsVerifiedRange(t *testing.T) {
+	ledger := seedAuditPartition(t, time.Date(2026, 3, 10, 10, 0, 0, 0, time.UTC))
+	svc := NewAuditService(ledgerFixedClock{now: time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC)}, nil, ledger.AuditStore)
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	svc.SetReportSigner("report-k1", priv)
+	ctx := context.Background()
+	opMeta := meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+
+	resp, err := svc.GenerateTamperEvidenceReport(ctx, &rgsv1.GenerateTamperEvidenceReportRequest{Meta: opMeta, FromDay: "2026-03-09"})
+	if err != nil {
+		t.Fatalf("report err: %v", err)
+	}
+	report := resp.Report
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || report.ToDay != "2026-03-10" || report.EventsChecked != 2 || !report.Verified || len(report.Partitions) != 2 {
+		t.Fatalf("unexpected report: meta=%+v report=%+v", resp.Meta, report)
+	}
+	if report.Partitions[0].EventCount != 0 || report.Partitions[1].EventCount != 2 || report.FirstHashPrev != "GENESIS" || report.LastHashCurr != report.Partitions[1].LastHashCurr || report.SignerKid != "report-k1" {
+		t.Fatalf("unexpected report partitions: %+v", report)
+	}
+	sig, err := hex.DecodeString(resp.Signature)
+	if err != nil || !ed25519.Verify(pub, resp.ReportJson, sig) {
+		t.Fatalf("report signature does not verify: err=%v", err)
+	}
+	var signed tamperEvidenceReport
+	if err := json.Unmarshal(resp.ReportJson, &signed); err != nil || signed.LastHashCurr != report.LastHashCurr || signed.EventsChecked != report.EventsChecked {
+		t.Fatalf("signed document does not match report: %+v err=%v", signed, err)
+	}
+
+	// A day whose chain no longer ends in its anchored head fails, and the
+	// report says so rather than erroring.
+	svc.anchors["2026-03-10"] = &rgsv1.AuditChainAnchor{PartitionDay: "2026-03-10", ChainHead: "rewritten", EventCount: 2}
+	tampered, _ := svc.GenerateTamperEvidenceReport(ctx, &rgsv1.GenerateTamperEvidenceReportRequest{Meta: opMeta, FromDay: "2026-03-10", ToDay: "2026-03-10"})
+	if tampered.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || tampered.Report.Verified || !tampered.Report.Partitions[0].Anchored || tampered.Report.Partitions[0].FailureReason != "audit chain does not match anchored head" {
+		t.Fatalf("expected failed verification in report, got %+v", tampered.Report)
+	}
+
+	var audited int
+	for _, ev := range auditEvents(svc.AuditStore) {
+		if ev.Action == "generate_tamper_evidence_report" && ev.ActorID == "op-1" {
+			audited++
+		}
+	}
+	if audited != 2 {
+		t.Fatalf("expected two report audit events, got=%d", audited)
+	}
+}
+
+func TestGenerateTamperEvidenceReportValidation(t *testing.T) {
+	svc := NewAuditService(ledgerFixedClock{now: time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC)}, nil)
+	ctx := context.Background()
+	opMeta := meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+
+	unsigned, _ := svc.GenerateTamperEvidenceReport(ctx, &rgsv1.GenerateTamperEvidenceReportRequest{Meta: opMeta})
+	if unsigned.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_ERROR || unsigned.Report != nil {
+		t.Fatalf("expected error without a signing key, got %+v", unsigned)
+	}
+
+	_, priv, _ := ed25519.GenerateKey(nil)
+	svc.SetExportConfig(AuditExportConfig{SignerKID: "audit-k1", SigningKey: priv})
+	cases := map[string]*rgsv1.GenerateTamperEvidenceReportRequest{
+		"bad day":    {Meta: opMeta, FromDay: "03/10/2026"},
+		"reversed":   {Meta: opMeta, FromDay: "2026-03-10", ToDay: "2026-03-09"},
+		"too long":   {Meta: opMeta, FromDay: "2025-03-09", ToDay: "2026-03-10"},
+		"not closed": {Meta: opMeta, ToDay: "2026-03-11"},
+	}
+	for name, req := range cases {
+		resp, _ := svc.GenerateTamperEvidenceReport(ctx, req)
+		if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+			t.Fatalf("%s: expected invalid, got %+v", name, resp.Meta)
+		}
+	}
+
+	resp, _ := svc.GenerateTamperEvidenceReport(ctx, &rgsv1.GenerateTamperEvidenceReportRequest{Meta: opMeta})
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || resp.Report.SignerKid != "audit-k1" || resp.Report.EventsChecked != 0 || !resp.Report.Verified {
+		t.Fatalf("expected export key to sign an empty report, got %+v", resp)
+	}
+}
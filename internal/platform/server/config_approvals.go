@@ -0,0 +1,72 @@
+package server
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+// ParseConfigApprovalPolicy parses "namespace=count" entries separated by
+// commas, e.g. "ledger.balance_caps=2,wagering=3".
+func ParseConfigApprovalPolicy(spec string) (map[string]int, error) {
+	out := make(map[string]int)
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		namespace, count, ok := strings.Cut(entry, "=")
+		namespace = strings.TrimSpace(namespace)
+		if !ok || namespace == "" {
+			return nil, fmt.Errorf("invalid config approval policy %q: want namespace=count", entry)
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(count))
+		if err != nil || n < 1 {
+			return nil, fmt.Errorf("config approval count for %s must be a positive integer", namespace)
+		}
+		out[namespace] = n
+	}
+	return out, nil
+}
+
+// SetApprovalPolicy sets how many distinct operators must approve changes in
+// each namespace before they can be applied. Namespaces without an entry
+// need one approval. The count is fixed on a change when it is proposed.
+func (s *ConfigService) SetApprovalPolicy(policy map[string]int) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.approvalPolicy = policy
+}
+
+// requiredApprovalsLocked returns the approvals a new change in namespace
+// needs. s.mu must be held.
+func (s *ConfigService) requiredApprovalsLocked(namespace string) int32 {
+	if n := s.approvalPolicy[namespace]; n > 1 {
+		return int32(n)
+	}
+	return 1
+}
+
+// configApprovalsMet reports whether c has been approved by as many
+// distinct operators as it requires.
+func configApprovalsMet(c *rgsv1.ConfigChange) bool {
+	approvers := make(map[string]bool, len(c.Approvals))
+	for _, a := range c.Approvals {
+		approvers[a.ApproverId] = true
+	}
+	return len(approvers) >= int(max(c.RequiredApprovals, 1))
+}
+
+func hasApproved(c *rgsv1.ConfigChange, actorID string) bool {
+	for _, a := range c.Approvals {
+		if a.ApproverId == actorID {
+			return true
+		}
+	}
+	return false
+}
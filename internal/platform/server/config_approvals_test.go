@@ -0,0 +1,67 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func TestParseConfigApprovalPolicy(t *testing.T) {
+	policy, err := ParseConfigApprovalPolicy(" ledger.balance_caps=2, wagering=3 ,")
+	if err != nil {
+		t.Fatalf("parse policy: %v", err)
+	}
+	if len(policy) != 2 || policy["ledger.balance_caps"] != 2 || policy["wagering"] != 3 {
+		t.Fatalf("unexpected policy: %+v", policy)
+	}
+	for _, bad := range []string{"wagering", "=2", "wagering=0", "wagering=two"} {
+		if _, err := ParseConfigApprovalPolicy(bad); err == nil {
+			t.Fatalf("expected %q to be rejected", bad)
+		}
+	}
+}
+
+func TestConfigChangeNeedsDistinctApprovals(t *testing.T) {
+	svc := NewConfigService(ledgerFixedClock{now: time.Date(2026, 2, 12, 17, 0, 0, 0, time.UTC)})
+	svc.SetApprovalPolicy(map[string]int{"security": 2})
+	ctx := context.Background()
+
+	proposed, _ := svc.ProposeConfigChange(ctx, &rgsv1.ProposeConfigChangeRequest{
+		Meta:            meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		ConfigNamespace: "security",
+		ConfigKey:       "session_timeout",
+		ProposedValue:   "900",
+	})
+	changeID := proposed.Change.GetChangeId()
+	if proposed.Change.GetRequiredApprovals() != 2 {
+		t.Fatalf("expected 2 required approvals, got %d", proposed.Change.GetRequiredApprovals())
+	}
+	approve := func(actor string) *rgsv1.ApproveConfigChangeResponse {
+		resp, _ := svc.ApproveConfigChange(ctx, &rgsv1.ApproveConfigChangeRequest{Meta: meta(actor, rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), ChangeId: changeID, Reason: "reviewed"})
+		return resp
+	}
+	apply := func() *rgsv1.ApplyConfigChangeResponse {
+		resp, _ := svc.ApplyConfigChange(ctx, &rgsv1.ApplyConfigChangeRequest{Meta: meta("op-4", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), ChangeId: changeID})
+		return resp
+	}
+
+	first := approve("op-2")
+	if first.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || first.Change.GetStatus() != rgsv1.ConfigChangeStatus_CONFIG_CHANGE_STATUS_PROPOSED || len(first.Change.GetApprovals()) != 1 {
+		t.Fatalf("expected first approval to be recorded without approving: %+v", first)
+	}
+	if resp := apply(); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected apply below threshold to be denied: %+v", resp.Meta)
+	}
+	if resp := approve("op-2"); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED || resp.Meta.GetDenialReason() != "approver has already approved" {
+		t.Fatalf("expected repeat approval to be denied: %+v", resp.Meta)
+	}
+	second := approve("op-3")
+	if second.Change.GetStatus() != rgsv1.ConfigChangeStatus_CONFIG_CHANGE_STATUS_APPROVED || second.Change.GetApproverId() != "op-3" || len(second.Change.GetApprovals()) != 2 {
+		t.Fatalf("expected second distinct approval to approve: %+v", second.Change)
+	}
+	if resp := apply(); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected apply at threshold: %+v", resp.Meta)
+	}
+}
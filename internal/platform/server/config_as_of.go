@@ -0,0 +1,100 @@
+package server
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+// ValueAsOf returns the change that set namespace/key as of at: the latest
+// change applied at or before that instant. Proposed, approved and rejected
+// changes never took effect and are ignored. A nil change means the key had
+// no applied value yet.
+func (s *ConfigService) ValueAsOf(ctx context.Context, namespace, key string, at time.Time) (*rgsv1.ConfigChange, error) {
+	if s.db != nil {
+		return s.appliedChangeAsOfFromDB(ctx, namespace, key, at)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return cloneChange(s.effectiveChangesLocked(at)[keyFor(namespace, key)]), nil
+}
+
+// ValuesAsOf returns the change in effect at at for every key that had an
+// applied value by then, ordered by namespace and key.
+func (s *ConfigService) ValuesAsOf(ctx context.Context, at time.Time) ([]*rgsv1.ConfigChange, error) {
+	if s.db != nil {
+		return s.appliedChangesAsOfFromDB(ctx, at)
+	}
+	s.mu.Lock()
+	effective := s.effectiveChangesLocked(at)
+	out := make([]*rgsv1.ConfigChange, 0, len(effective))
+	for _, c := range effective {
+		out = append(out, cloneChange(c))
+	}
+	s.mu.Unlock()
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].ConfigNamespace != out[j].ConfigNamespace {
+			return out[i].ConfigNamespace < out[j].ConfigNamespace
+		}
+		return out[i].ConfigKey < out[j].ConfigKey
+	})
+	return out, nil
+}
+
+func (s *ConfigService) effectiveChangesLocked(at time.Time) map[string]*rgsv1.ConfigChange {
+	effective := make(map[string]*rgsv1.ConfigChange)
+	appliedAt := make(map[string]time.Time)
+	for _, id := range s.changeOrder {
+		c := s.changes[id]
+		if c == nil || c.Status != rgsv1.ConfigChangeStatus_CONFIG_CHANGE_STATUS_APPLIED {
+			continue
+		}
+		ts, err := time.Parse(time.RFC3339Nano, c.AppliedAt)
+		if err != nil || ts.After(at) {
+			continue
+		}
+		k := keyFor(c.ConfigNamespace, c.ConfigKey)
+		if prev, ok := appliedAt[k]; ok && ts.Before(prev) {
+			continue
+		}
+		effective[k] = c
+		appliedAt[k] = ts
+	}
+	return effective
+}
+
+func (s *ConfigService) GetConfigValueAsOf(ctx context.Context, req *rgsv1.GetConfigValueAsOfRequest) (*rgsv1.GetConfigValueAsOfResponse, error) {
+	if req == nil {
+		req = &rgsv1.GetConfigValueAsOfRequest{}
+	}
+	if ok, reason := s.authorize(ctx, req.Meta); !ok {
+		_ = s.appendAudit(req.Meta, "config_value", keyFor(req.ConfigNamespace, req.ConfigKey), "get_config_value_as_of", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		return &rgsv1.GetConfigValueAsOfResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	if strings.TrimSpace(req.ConfigNamespace) == "" || strings.TrimSpace(req.ConfigKey) == "" {
+		return &rgsv1.GetConfigValueAsOfResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "config_namespace and config_key are required")}, nil
+	}
+	at := s.now()
+	if req.AsOf != "" {
+		ts, err := time.Parse(time.RFC3339Nano, req.AsOf)
+		if err != nil {
+			return &rgsv1.GetConfigValueAsOfResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "as_of must be RFC3339")}, nil
+		}
+		at = ts
+	}
+	change, err := s.ValueAsOf(ctx, req.ConfigNamespace, req.ConfigKey, at)
+	if err != nil {
+		return &rgsv1.GetConfigValueAsOfResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	resp := &rgsv1.GetConfigValueAsOfResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, "")}
+	if change != nil {
+		resp.Value = change.ProposedValue
+		resp.Found = true
+		resp.Change = change
+	}
+	return resp, nil
+}
@@ -0,0 +1,145 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func TestConfigValueAsOfFollowsAppliedHistory(t *testing.T) {
+	cfg := NewConfigService(ledgerFixedClock{now: time.Date(2026, 2, 10, 9, 0, 0, 0, time.UTC)})
+	applyConfigValue(t, cfg, WageringConfigNamespace, "max_stake/USD", "100.00")
+	cfg.Clock = ledgerFixedClock{now: time.Date(2026, 2, 12, 9, 0, 0, 0, time.UTC)}
+	applyConfigValue(t, cfg, WageringConfigNamespace, "max_stake/USD", "250.00")
+
+	// A proposal that was never applied must not show up in history.
+	ctx := context.Background()
+	pending, _ := cfg.ProposeConfigChange(ctx, &rgsv1.ProposeConfigChangeRequest{
+		Meta:            meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		ConfigNamespace: WageringConfigNamespace,
+		ConfigKey:       "max_stake/USD",
+		ProposedValue:   "900.00",
+		Reason:          "pending",
+	})
+	if pending.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("propose failed: %+v", pending.Meta)
+	}
+
+	cases := []struct {
+		asOf  string
+		found bool
+		value string
+	}{
+		{"2026-02-09T00:00:00Z", false, ""},
+		{"2026-02-10T09:00:00Z", true, "100.00"},
+		{"2026-02-11T23:59:59Z", true, "100.00"},
+		{"2026-02-12T09:00:00Z", true, "250.00"},
+		{"", true, "250.00"},
+	}
+	for _, tc := range cases {
+		resp, err := cfg.GetConfigValueAsOf(ctx, &rgsv1.GetConfigValueAsOfRequest{
+			Meta:            meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+			ConfigNamespace: WageringConfigNamespace,
+			ConfigKey:       "max_stake/USD",
+			AsOf:            tc.asOf,
+		})
+		if err != nil {
+			t.Fatalf("get value as of %q err: %v", tc.asOf, err)
+		}
+		if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("as of %q: expected ok, got=%v reason=%q", tc.asOf, resp.Meta.GetResultCode(), resp.Meta.GetDenialReason())
+		}
+		if resp.Found != tc.found || resp.Value != tc.value {
+			t.Fatalf("as of %q: expected found=%v value=%q, got found=%v value=%q", tc.asOf, tc.found, tc.value, resp.Found, resp.Value)
+		}
+		if tc.found && resp.Change.GetStatus() != rgsv1.ConfigChangeStatus_CONFIG_CHANGE_STATUS_APPLIED {
+			t.Fatalf("as of %q: expected applied change, got=%+v", tc.asOf, resp.Change)
+		}
+	}
+}
+
+func TestConfigValueAsOfValidation(t *testing.T) {
+	cfg := NewConfigService(ledgerFixedClock{now: time.Date(2026, 2, 10, 9, 0, 0, 0, time.UTC)})
+	ctx := context.Background()
+
+	resp, _ := cfg.GetConfigValueAsOf(ctx, &rgsv1.GetConfigValueAsOfRequest{
+		Meta:            meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		ConfigNamespace: WageringConfigNamespace,
+		ConfigKey:       "max_stake/USD",
+		AsOf:            "yesterday",
+	})
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected invalid for bad as_of, got=%v", resp.Meta.GetResultCode())
+	}
+	resp, _ = cfg.GetConfigValueAsOf(ctx, &rgsv1.GetConfigValueAsOfRequest{
+		Meta:            meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		ConfigNamespace: WageringConfigNamespace,
+	})
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected invalid for missing key, got=%v", resp.Meta.GetResultCode())
+	}
+	resp, _ = cfg.GetConfigValueAsOf(ctx, &rgsv1.GetConfigValueAsOfRequest{
+		Meta:            meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""),
+		ConfigNamespace: WageringConfigNamespace,
+		ConfigKey:       "max_stake/USD",
+	})
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected denied for player, got=%v", resp.Meta.GetResultCode())
+	}
+}
+
+func TestDailyPackRegenerationSnapshotsConfigAtDayClose(t *testing.T) {
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 11, 12, 0, 0, 0, time.UTC)}
+	cfg := NewConfigService(clk)
+	applyConfigValue(t, cfg, WageringConfigNamespace, "max_stake/USD", "100.00")
+
+	eventsSvc := NewEventsService(clk)
+	reportingSvc := NewReportingService(clk, NewLedgerService(clk), eventsSvc)
+	reportingSvc.Audit = NewAuditService(clk, nil, reportingSvc.AuditStore, eventsSvc.AuditStore)
+	reportingSvc.Config = cfg
+	sink := &recordingPackSink{name: "memory"}
+	reportingSvc.SetDailyPackConfig(DailyPackConfig{Sinks: []DailyPackSink{sink}})
+
+	// The limit is raised after the day closed; a regenerated pack for that
+	// day must still record the limit that applied during it.
+	later := ledgerFixedClock{now: time.Date(2026, 2, 13, 12, 0, 0, 0, time.UTC)}
+	cfg.Clock = later
+	applyConfigValue(t, cfg, WageringConfigNamespace, "max_stake/USD", "500.00")
+	reportingSvc.Clock = later
+
+	resp, err := reportingSvc.GenerateDailyPack(context.Background(), &rgsv1.GenerateDailyPackRequest{
+		Meta:      meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		GamingDay: "2026-02-11",
+		Force:     true,
+	})
+	if err != nil {
+		t.Fatalf("generate daily pack err: %v", err)
+	}
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected ok, got=%v reason=%q", resp.Meta.GetResultCode(), resp.Meta.GetDenialReason())
+	}
+
+	var bundle struct {
+		Artifacts []struct {
+			Name    string `json:"name"`
+			Content []byte `json:"content"`
+		} `json:"artifacts"`
+	}
+	if err := json.Unmarshal(sink.bundles[len(sink.bundles)-1], &bundle); err != nil {
+		t.Fatalf("decode bundle: %v", err)
+	}
+	var values []dailyPackConfigValue
+	for _, a := range bundle.Artifacts {
+		if a.Name == "config_snapshot.json" {
+			if err := json.Unmarshal(a.Content, &values); err != nil {
+				t.Fatalf("decode config snapshot: %v", err)
+			}
+		}
+	}
+	if len(values) != 1 || values[0].Key != "max_stake/USD" || values[0].Value != "100.00" {
+		t.Fatalf("expected snapshot of the limit in effect on the day, got=%+v", values)
+	}
+}
@@ -0,0 +1,153 @@
+package server
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+const (
+	downloadSigAlgHMAC      = "HMAC-SHA256"
+	downloadSigAlgEd25519   = "ED25519"
+	downloadSigAlgECDSAP256 = "ECDSA-P256-SHA256"
+	downloadSigAlgECDSAP384 = "ECDSA-P384-SHA384"
+
+	downloadVerificationVerified = "verified"
+	downloadVerificationFailed   = "failed"
+	downloadVerificationUnsigned = "unsigned"
+)
+
+// ParseDownloadPublicKeyRing parses PEM "PUBLIC KEY" blocks, each naming its
+// key id in a Key-Id header, into ed25519 and ECDSA P-256/P-384 verification
+// keys.
+func ParseDownloadPublicKeyRing(data []byte) (map[string]crypto.PublicKey, error) {
+	out := make(map[string]crypto.PublicKey)
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "PUBLIC KEY" {
+			return nil, fmt.Errorf("unexpected PEM block %q in download key ring", block.Type)
+		}
+		kid := strings.TrimSpace(block.Headers["Key-Id"])
+		if kid == "" {
+			return nil, fmt.Errorf("download key ring entry is missing a Key-Id header")
+		}
+		if _, dup := out[kid]; dup {
+			return nil, fmt.Errorf("duplicate download key id %s", kid)
+		}
+		pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("parse download key %s: %w", kid, err)
+		}
+		if downloadKeyAlg(pub) == "" {
+			return nil, fmt.Errorf("download key %s must be ed25519 or ECDSA P-256/P-384", kid)
+		}
+		out[kid] = pub
+	}
+	if strings.TrimSpace(string(data)) != "" {
+		return nil, fmt.Errorf("download key ring has trailing data that is not PEM")
+	}
+	return out, nil
+}
+
+// SetDownloadPublicKeys sets the ed25519 and ECDSA keys that verify download
+// library signatures, alongside the HMAC secrets.
+func (s *ConfigService) SetDownloadPublicKeys(keys map[string]crypto.PublicKey) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.downloadPubKeys = make(map[string]crypto.PublicKey, len(keys))
+	for kid, pub := range keys {
+		kid = strings.TrimSpace(kid)
+		if kid == "" || downloadKeyAlg(pub) == "" {
+			continue
+		}
+		s.downloadPubKeys[kid] = pub
+	}
+}
+
+func downloadKeyAlg(pub crypto.PublicKey) string {
+	switch k := pub.(type) {
+	case ed25519.PublicKey:
+		return downloadSigAlgEd25519
+	case *ecdsa.PublicKey:
+		switch k.Curve {
+		case elliptic.P256():
+			return downloadSigAlgECDSAP256
+		case elliptic.P384():
+			return downloadSigAlgECDSAP384
+		}
+	}
+	return ""
+}
+
+func decodeDownloadSignature(sig string) ([]byte, bool) {
+	sig = strings.TrimSpace(sig)
+	if b, err := base64.StdEncoding.DecodeString(sig); err == nil {
+		return b, true
+	}
+	b, err := base64.RawStdEncoding.DecodeString(sig)
+	return b, err == nil
+}
+
+// verifyDownloadEntryLocked checks e's signature with the key named by
+// signer_kid, returning the algorithm used and why verification failed.
+// s.mu must be held.
+func (s *ConfigService) verifyDownloadEntryLocked(e *rgsv1.DownloadLibraryEntry) (string, string) {
+	if e.SignerKid == "" {
+		return "", "signer_kid is required"
+	}
+	want := strings.ToUpper(strings.TrimSpace(e.SignatureAlg))
+	if secret, ok := s.downloadSigKeys[e.SignerKid]; ok {
+		if want != "" && want != downloadSigAlgHMAC {
+			return "", "signature_alg does not match signer key"
+		}
+		if !verifyDownloadSignature(e, secret) {
+			return downloadSigAlgHMAC, "signature does not verify"
+		}
+		return downloadSigAlgHMAC, ""
+	}
+	pub, ok := s.downloadPubKeys[e.SignerKid]
+	if !ok {
+		return "", "unknown signer_kid"
+	}
+	alg := downloadKeyAlg(pub)
+	if want != "" && want != alg {
+		return "", "signature_alg does not match signer key"
+	}
+	sig, ok := decodeDownloadSignature(e.Signature)
+	if !ok {
+		return alg, "signature is not base64"
+	}
+	payload := []byte(downloadSignaturePayload(e))
+	var verified bool
+	switch alg {
+	case downloadSigAlgEd25519:
+		verified = ed25519.Verify(pub.(ed25519.PublicKey), payload, sig)
+	case downloadSigAlgECDSAP256:
+		digest := sha256.Sum256(payload)
+		verified = ecdsa.VerifyASN1(pub.(*ecdsa.PublicKey), digest[:], sig)
+	case downloadSigAlgECDSAP384:
+		digest := sha512.Sum384(payload)
+		verified = ecdsa.VerifyASN1(pub.(*ecdsa.PublicKey), digest[:], sig)
+	}
+	if !verified {
+		return alg, "signature does not verify"
+	}
+	return alg, ""
+}
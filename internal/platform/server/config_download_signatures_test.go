@@ -0,0 +1,114 @@
+package server
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func downloadKeyRingPEM(t *testing.T, keys map[string]crypto.PublicKey) []byte {
+	t.Helper()
+	var out []byte
+	for kid, pub := range keys {
+		der, err := x509.MarshalPKIXPublicKey(pub)
+		if err != nil {
+			t.Fatalf("marshal %s: %v", kid, err)
+		}
+		out = append(out, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Headers: map[string]string{"Key-Id": kid}, Bytes: der})...)
+	}
+	return out
+}
+
+func TestDownloadLibraryVerifiesEd25519AndECDSASignatures(t *testing.T) {
+	edPub, edPriv, _ := ed25519.GenerateKey(rand.Reader)
+	ecPriv, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	ring, err := ParseDownloadPublicKeyRing(downloadKeyRingPEM(t, map[string]crypto.PublicKey{"ed-1": edPub, "ec-1": &ecPriv.PublicKey}))
+	if err != nil {
+		t.Fatalf("parse key ring: %v", err)
+	}
+	svc := NewConfigService(ledgerFixedClock{now: time.Date(2026, 2, 12, 17, 30, 0, 0, time.UTC)})
+	svc.SetDownloadPublicKeys(ring)
+	ctx := context.Background()
+	record := func(entry *rgsv1.DownloadLibraryEntry) *rgsv1.RecordDownloadLibraryChangeResponse {
+		resp, err := svc.RecordDownloadLibraryChange(ctx, &rgsv1.RecordDownloadLibraryChangeRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), Entry: entry})
+		if err != nil {
+			t.Fatalf("record err: %v", err)
+		}
+		return resp
+	}
+	activation := func(kid string) *rgsv1.DownloadLibraryEntry {
+		return &rgsv1.DownloadLibraryEntry{
+			LibraryPath: "games/slot-a.pkg",
+			Checksum:    "abc123",
+			Version:     "2.0.0",
+			Action:      rgsv1.DownloadAction_DOWNLOAD_ACTION_ACTIVATE,
+			SignerKid:   kid,
+		}
+	}
+
+	edEntry := activation("ed-1")
+	edEntry.Signature = base64.StdEncoding.EncodeToString(ed25519.Sign(edPriv, []byte(downloadSignaturePayload(edEntry))))
+	resp := record(edEntry)
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || resp.Entry.SignatureAlg != "ED25519" || resp.Entry.VerificationResult != "verified" {
+		t.Fatalf("expected ed25519 activation to verify: %+v", resp)
+	}
+
+	ecEntry := activation("ec-1")
+	digest := sha256.Sum256([]byte(downloadSignaturePayload(ecEntry)))
+	ecSig, _ := ecdsa.SignASN1(rand.Reader, ecPriv, digest[:])
+	ecEntry.Signature = base64.StdEncoding.EncodeToString(ecSig)
+	resp = record(ecEntry)
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || resp.Entry.SignatureAlg != "ECDSA-P256-SHA256" || resp.Entry.VerificationResult != "verified" {
+		t.Fatalf("expected ECDSA activation to verify: %+v", resp)
+	}
+
+	wrongAlg := activation("ec-1")
+	wrongAlg.Signature = ecEntry.Signature
+	wrongAlg.SignatureAlg = "ED25519"
+	if resp := record(wrongAlg); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED || resp.Meta.GetDenialReason() != "invalid download signature: signature_alg does not match signer key" {
+		t.Fatalf("expected mismatched signature_alg to be denied: %+v", resp.Meta)
+	}
+	tampered := activation("ed-1")
+	tampered.Version = "2.0.1"
+	tampered.Signature = edEntry.Signature
+	if resp := record(tampered); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED || resp.Meta.GetDenialReason() != "invalid download signature: signature does not verify" {
+		t.Fatalf("expected tampered activation to be denied: %+v", resp.Meta)
+	}
+
+	update := activation("ed-9")
+	update.Action = rgsv1.DownloadAction_DOWNLOAD_ACTION_UPDATE
+	update.Signature = edEntry.Signature
+	resp = record(update)
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || resp.Entry.VerificationResult != "failed" || resp.Entry.VerificationFailure != "unknown signer_kid" {
+		t.Fatalf("expected update with unknown key to be recorded as failed: %+v", resp)
+	}
+	add := activation("")
+	add.Action = rgsv1.DownloadAction_DOWNLOAD_ACTION_ADD
+	if resp := record(add); resp.Entry.GetVerificationResult() != "unsigned" {
+		t.Fatalf("expected unsigned add: %+v", resp)
+	}
+}
+
+func TestParseDownloadPublicKeyRingRejectsBadEntries(t *testing.T) {
+	edPub, _, _ := ed25519.GenerateKey(rand.Reader)
+	der, _ := x509.MarshalPKIXPublicKey(edPub)
+	noKID := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	if _, err := ParseDownloadPublicKeyRing(noKID); err == nil {
+		t.Fatal("expected key without Key-Id to be rejected")
+	}
+	p224, _ := ecdsa.GenerateKey(elliptic.P224(), rand.Reader)
+	if _, err := ParseDownloadPublicKeyRing(downloadKeyRingPEM(t, map[string]crypto.PublicKey{"ec-224": &p224.PublicKey})); err == nil {
+		t.Fatal("expected P-224 key to be rejected")
+	}
+}
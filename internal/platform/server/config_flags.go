@@ -0,0 +1,98 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"slices"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// FeatureFlagConfigNamespace holds feature flags keyed by flag name. Each
+// value is the JSON encoding of an rgsv1.FeatureFlag, so flags are turned on,
+// rolled out and killed through the same propose/approve/apply flow as any
+// other setting.
+const FeatureFlagConfigNamespace = "flags"
+
+func parseFeatureFlag(value string) (*rgsv1.FeatureFlag, bool) {
+	var flag rgsv1.FeatureFlag
+	if err := protojson.Unmarshal([]byte(value), &flag); err != nil {
+		return nil, false
+	}
+	if flag.RolloutPercent < 0 || flag.RolloutPercent > 100 {
+		return nil, false
+	}
+	if slices.Contains(flag.EquipmentIds, "") || slices.Contains(flag.OperatorIds, "") {
+		return nil, false
+	}
+	return &flag, true
+}
+
+func validFeatureFlagChange(value string) bool {
+	_, ok := parseFeatureFlag(value)
+	return ok
+}
+
+// flagRolloutBucket places subject in one of 100 buckets for key. Hashing the
+// key in spreads each flag's rollout over different subjects.
+func flagRolloutBucket(key, subject string) int32 {
+	sum := sha256.Sum256([]byte(key + "|" + subject))
+	return int32(binary.BigEndian.Uint64(sum[:8]) % 100)
+}
+
+// evaluateFeatureFlag reports whether flag is on for the equipment and
+// operator, bucketing rollout on subject, and why.
+func evaluateFeatureFlag(key string, flag *rgsv1.FeatureFlag, equipmentID, operatorID, subject string) (bool, string) {
+	switch {
+	case !flag.Enabled:
+		return false, "disabled"
+	case equipmentID != "" && slices.Contains(flag.EquipmentIds, equipmentID):
+		return true, "equipment_target"
+	case operatorID != "" && slices.Contains(flag.OperatorIds, operatorID):
+		return true, "operator_target"
+	case flagRolloutBucket(key, subject) < flag.RolloutPercent:
+		return true, "rollout"
+	default:
+		return false, "not_in_rollout"
+	}
+}
+
+// FeatureFlagSetting returns the applied value of key in
+// FeatureFlagConfigNamespace.
+func (s *ConfigService) FeatureFlagSetting(ctx context.Context, key string) (string, bool, error) {
+	return s.currentSetting(ctx, FeatureFlagConfigNamespace, key)
+}
+
+func (s *ConfigService) EvaluateFlag(ctx context.Context, req *rgsv1.EvaluateFlagRequest) (*rgsv1.EvaluateFlagResponse, error) {
+	if req == nil || req.FlagKey == "" {
+		return &rgsv1.EvaluateFlagResponse{Meta: s.responseMeta(nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "flag_key is required")}, nil
+	}
+	if ok, reason := s.authorize(ctx, req.Meta); !ok {
+		_ = s.appendAudit(req.Meta, "feature_flag", req.FlagKey, "evaluate_flag", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		return &rgsv1.EvaluateFlagResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	value, ok, err := s.FeatureFlagSetting(ctx, req.FlagKey)
+	if err != nil {
+		return &rgsv1.EvaluateFlagResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if !ok {
+		return &rgsv1.EvaluateFlagResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Reason: "not_defined"}, nil
+	}
+	flag, ok := parseFeatureFlag(value)
+	if !ok {
+		return &rgsv1.EvaluateFlagResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "flag value is invalid")}, nil
+	}
+
+	subject := req.EquipmentId
+	if subject == "" {
+		subject = req.OperatorId
+	}
+	if subject == "" {
+		subject = req.Meta.Actor.ActorId
+	}
+	enabled, reason := evaluateFeatureFlag(req.FlagKey, flag, req.EquipmentId, req.OperatorId, subject)
+	return &rgsv1.EvaluateFlagResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Enabled: enabled, Reason: reason, Flag: flag}, nil
+}
@@ -0,0 +1,90 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func TestEvaluateFlagTargetsAndRollout(t *testing.T) {
+	svc := NewConfigService(ledgerFixedClock{now: time.Date(2026, 2, 12, 17, 0, 0, 0, time.UTC)})
+	ctx := context.Background()
+	evaluate := func(key, equipmentID, operatorID string) *rgsv1.EvaluateFlagResponse {
+		resp, err := svc.EvaluateFlag(ctx, &rgsv1.EvaluateFlagRequest{
+			Meta:        meta("game-server-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, ""),
+			FlagKey:     key,
+			EquipmentId: equipmentID,
+			OperatorId:  operatorID,
+		})
+		if err != nil || resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("evaluate %s: resp=%+v err=%v", key, resp, err)
+		}
+		return resp
+	}
+
+	invalid, _ := svc.ProposeConfigChange(ctx, &rgsv1.ProposeConfigChangeRequest{
+		Meta:            meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		ConfigNamespace: FeatureFlagConfigNamespace,
+		ConfigKey:       "fast_spin",
+		ProposedValue:   `{"enabled":true,"rollout_percent":150}`,
+	})
+	if invalid.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected out-of-range rollout to be invalid: %+v", invalid.Meta)
+	}
+
+	if resp := evaluate("fast_spin", "cab-1", ""); resp.Enabled || resp.Reason != "not_defined" {
+		t.Fatalf("expected undefined flag to be off: %+v", resp)
+	}
+	applyConfigValue(t, svc, FeatureFlagConfigNamespace, "fast_spin", `{"enabled":true,"equipment_ids":["cab-1"],"operator_ids":["op-9"]}`)
+	if resp := evaluate("fast_spin", "cab-1", ""); !resp.Enabled || resp.Reason != "equipment_target" {
+		t.Fatalf("expected targeted equipment to be on: %+v", resp)
+	}
+	if resp := evaluate("fast_spin", "cab-2", "op-9"); !resp.Enabled || resp.Reason != "operator_target" {
+		t.Fatalf("expected targeted operator to be on: %+v", resp)
+	}
+	if resp := evaluate("fast_spin", "cab-2", ""); resp.Enabled || resp.Reason != "not_in_rollout" {
+		t.Fatalf("expected untargeted equipment to be off at 0%%: %+v", resp)
+	}
+
+	applyConfigValue(t, svc, FeatureFlagConfigNamespace, "fast_spin", `{"enabled":true,"rollout_percent":30}`)
+	on := 0
+	for i := 0; i < 1000; i++ {
+		resp := evaluate("fast_spin", fmt.Sprintf("cab-%d", i), "")
+		if resp.Enabled != (evaluate("fast_spin", fmt.Sprintf("cab-%d", i), "").Enabled) {
+			t.Fatalf("expected stable rollout for cab-%d", i)
+		}
+		if resp.Enabled {
+			on++
+		}
+	}
+	if on < 250 || on > 350 {
+		t.Fatalf("expected about 30%% of equipment in rollout, got %d/1000", on)
+	}
+
+	applyConfigValue(t, svc, FeatureFlagConfigNamespace, "fast_spin", `{"enabled":false,"rollout_percent":100,"equipment_ids":["cab-1"]}`)
+	if resp := evaluate("fast_spin", "cab-1", ""); resp.Enabled || resp.Reason != "disabled" {
+		t.Fatalf("expected disabled flag to be off for targets: %+v", resp)
+	}
+}
+
+func TestFlagRolloutIsMonotonic(t *testing.T) {
+	flag := &rgsv1.FeatureFlag{Enabled: true}
+	for i := 0; i < 200; i++ {
+		subject := fmt.Sprintf("cab-%d", i)
+		wasOn := false
+		for pct := int32(0); pct <= 100; pct += 10 {
+			flag.RolloutPercent = pct
+			on, _ := evaluateFeatureFlag("fast_spin", flag, subject, "", subject)
+			if wasOn && !on {
+				t.Fatalf("%s dropped out of rollout at %d%%", subject, pct)
+			}
+			wasOn = on
+		}
+		if !wasOn {
+			t.Fatalf("%s not on at 100%%", subject)
+		}
+	}
+}
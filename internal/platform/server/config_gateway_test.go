@@ -86,6 +86,23 @@ func TestConfigGatewayParity_Workflow(t *testing.T) {
 	if len(history.Changes) == 0 {
 		t.Fatalf("expected at least one config change in history")
 	}
+
+	q.Set("configNamespace", "security")
+	q.Set("since", "2026-02-12T17:00:00Z")
+	q.Set("waitSeconds", "1")
+	pollReq := httptest.NewRequest(http.MethodGet, "/v1/config/watch?"+q.Encode(), nil)
+	pollRec := httptest.NewRecorder()
+	gwMux.ServeHTTP(pollRec, pollReq)
+	if pollRec.Result().StatusCode != http.StatusOK {
+		t.Fatalf("poll http status: got=%d want=%d body=%s", pollRec.Result().StatusCode, http.StatusOK, pollRec.Body.String())
+	}
+	var poll rgsv1.PollConfigChangesResponse
+	if err := protojson.Unmarshal(pollRec.Body.Bytes(), &poll); err != nil {
+		t.Fatalf("unmarshal poll response: %v", err)
+	}
+	if len(poll.Changes) != 1 || poll.Changes[0].ChangeId != proposeResp.Change.ChangeId || poll.Watermark == "" {
+		t.Fatalf("expected poll to return the applied change, got=%+v", &poll)
+	}
 }
 
 func TestConfigGatewayActorMismatchDenied(t *testing.T) {
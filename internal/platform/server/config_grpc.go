@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"crypto"
 	"crypto/hmac"
 	"crypto/sha256"
 	"database/sql"
@@ -16,6 +17,7 @@ import (
 	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
 	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
 	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/clock"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/money"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -23,7 +25,7 @@ type ConfigService struct {
 	rgsv1.UnimplementedConfigServiceServer
 
 	Clock      clock.Clock
-	AuditStore *audit.InMemoryStore
+	AuditStore audit.Store
 
 	mu sync.Mutex
 
@@ -32,6 +34,7 @@ type ConfigService struct {
 	nextChangeID int64
 
 	currentValues map[string]string
+	schemas       map[string]*rgsv1.ConfigSchema
 
 	downloadEntries      map[string]*rgsv1.DownloadLibraryEntry
 	downloadOrder        []string
@@ -39,6 +42,9 @@ type ConfigService struct {
 	nextAuditID          int64
 	db                   *sql.DB
 	downloadSigKeys      map[string][]byte
+	downloadPubKeys      map[string]crypto.PublicKey
+	approvalPolicy       map[string]int
+	watchers             *configWatchHub
 	disableInMemoryCache bool
 }
 
@@ -49,11 +55,13 @@ func NewConfigService(clk clock.Clock, db ...*sql.DB) *ConfigService {
 	}
 	return &ConfigService{
 		Clock:           clk,
-		AuditStore:      audit.NewInMemoryStore(),
+		AuditStore:      NewAuditStore(handle),
 		changes:         make(map[string]*rgsv1.ConfigChange),
 		currentValues:   make(map[string]string),
+		schemas:         make(map[string]*rgsv1.ConfigSchema),
 		downloadEntries: make(map[string]*rgsv1.DownloadLibraryEntry),
 		downloadSigKeys: make(map[string][]byte),
+		watchers:        newConfigWatchHub(),
 		db:              handle,
 	}
 }
@@ -152,38 +160,8 @@ func (s *ConfigService) nextAuditIDLocked() string {
 }
 
 func (s *ConfigService) appendAudit(meta *rgsv1.RequestMeta, objectType, objectID, action string, before, after []byte, result audit.Result, reason string) error {
-	if s.AuditStore == nil {
-		return audit.ErrCorruptChain
-	}
-	actorID := "system"
-	actorType := "service"
-	if meta != nil && meta.Actor != nil {
-		actorID = meta.Actor.ActorId
-		actorType = meta.Actor.ActorType.String()
-	}
-	now := s.now()
-	ev := audit.Event{
-		AuditID:      s.nextAuditIDLocked(),
-		OccurredAt:   now,
-		RecordedAt:   now,
-		ActorID:      actorID,
-		ActorType:    actorType,
-		ObjectType:   objectType,
-		ObjectID:     objectID,
-		Action:       action,
-		Before:       before,
-		After:        after,
-		Result:       result,
-		Reason:       reason,
-		PartitionDay: now.Format("2006-01-02"),
-	}
-	if s.db != nil {
-		if err := appendAuditEventToDB(context.Background(), s.db, ev); err != nil {
-			return err
-		}
-	}
-	_, err := s.AuditStore.Append(ev)
-	return err
+	ev := newAuditEvent(meta, s.nextAuditIDLocked(), s.now(), objectType, objectID, action, before, after, result, reason)
+	return appendAuditTo(context.Background(), s.AuditStore, ev)
 }
 
 func cloneChange(c *rgsv1.ConfigChange) *rgsv1.ConfigChange {
@@ -206,6 +184,130 @@ func keyFor(namespace, key string) string {
 	return namespace + "::" + key
 }
 
+// FXRateConfigNamespace holds exchange rates used by LedgerService.
+// Keys are "FROM/TO" currency pairs and values are decimal rates giving
+// units of TO per unit of FROM, e.g. USD/EUR = "0.9215".
+const FXRateConfigNamespace = "ledger.fx_rates"
+
+func fxRateKey(from, to string) string {
+	return from + "/" + to
+}
+
+func validFXRateChange(key, value string) bool {
+	from, to, ok := strings.Cut(key, "/")
+	if !ok || from == to || !money.ValidCurrency(from) || !money.ValidCurrency(to) {
+		return false
+	}
+	_, err := money.ParseRate(value)
+	return err == nil
+}
+
+// currentSetting returns the applied value of key in namespace and whether
+// one has been applied.
+func (s *ConfigService) currentSetting(ctx context.Context, namespace, key string) (string, bool, error) {
+	if s.db != nil {
+		v, err := s.getCurrentValue(ctx, namespace, key)
+		return v, v != "", err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, ok := s.currentValues[keyFor(namespace, key)]
+	return v, ok, nil
+}
+
+// FXRate returns the applied rate for converting from into to. Rates only
+// change through the propose/approve/apply flow.
+func (s *ConfigService) FXRate(ctx context.Context, from, to string) (string, bool, error) {
+	return s.currentSetting(ctx, FXRateConfigNamespace, fxRateKey(from, to))
+}
+
+// WageringConfigNamespace holds settings read by WageringService. Stake
+// limits are keyed "[game/<game_id>/|equipment/<equipment_id>/]{min,max}_stake/CCY"
+// with a decimal amount in that currency, e.g. game/blackjack/max_stake/USD
+// = "500.00". Unscoped keys are the jurisdiction-wide limits. A game's
+// theoretical RTP, used for performance reporting, is keyed
+// "game/<game_id>/theoretical_rtp_bps" and the share of its settled stakes
+// fed to the progressive pool "game/<game_id>/progressive_contribution_bps",
+// both in basis points.
+const WageringConfigNamespace = "wagering"
+
+func validWageringChange(key, value string) bool {
+	if !strings.Contains(key, "_stake") {
+		return true
+	}
+	limit, ok := parseStakeLimitKey(key)
+	if !ok {
+		return false
+	}
+	m, err := money.Parse(value + " " + limit.currency)
+	return err == nil && m.AmountMinor > 0
+}
+
+// validGameBasisPointsChange checks keys ending in setting, which must be
+// scoped to a game and hold basis points.
+func validGameBasisPointsChange(key, value, setting string) bool {
+	if !strings.HasSuffix(key, setting) {
+		return true
+	}
+	if _, ok := parseGameSettingKey(key, setting); !ok {
+		return false
+	}
+	_, ok := parseBasisPoints(value)
+	return ok
+}
+
+// WageringSetting returns the applied value of key in WageringConfigNamespace.
+func (s *ConfigService) WageringSetting(ctx context.Context, key string) (string, bool, error) {
+	return s.currentSetting(ctx, WageringConfigNamespace, key)
+}
+
+// BalanceCapConfigNamespace holds the maximum balances enforced by
+// LedgerService on deposits and transfers into an account. Caps are keyed
+// "<account_type>/max_balance/CCY" for every account of a type, or
+// "account/<account_id>/max_balance/CCY" for a single account, with a
+// decimal amount in that currency. "<account_type>/on_exceed" is "deny"
+// (the default) or "partial" to credit only up to the cap.
+const BalanceCapConfigNamespace = "ledger.balance_caps"
+
+func validBalanceCapChange(key, value string) bool {
+	if accountType, ok := strings.CutSuffix(key, "/on_exceed"); ok {
+		return validLedgerAccountType(accountType) && (value == "deny" || value == "partial")
+	}
+	k, ok := parseBalanceCapKey(key)
+	if !ok {
+		return false
+	}
+	m, err := money.Parse(value + " " + k.currency)
+	return err == nil && m.AmountMinor > 0
+}
+
+// BalanceCapSetting returns the applied value of key in
+// BalanceCapConfigNamespace.
+func (s *ConfigService) BalanceCapSetting(ctx context.Context, key string) (string, bool, error) {
+	return s.currentSetting(ctx, BalanceCapConfigNamespace, key)
+}
+
+// invalidProposedValue returns why value cannot be proposed for key in
+// namespace, or "" if the namespace's own rules accept it. Schemas are
+// checked separately.
+func invalidProposedValue(namespace, key, value string) string {
+	switch {
+	case namespace == FXRateConfigNamespace && !validFXRateChange(key, value):
+		return "fx rate key must be FROM/TO and value a positive decimal"
+	case namespace == WageringConfigNamespace && !validWageringChange(key, value):
+		return "stake limit key must be [game/<id>/|equipment/<id>/]{min,max}_stake/CCY and value a positive decimal"
+	case namespace == WageringConfigNamespace && !validGameBasisPointsChange(key, value, "theoretical_rtp_bps"):
+		return "theoretical rtp key must be game/<id>/theoretical_rtp_bps and value basis points in 1..10000"
+	case namespace == WageringConfigNamespace && !validGameBasisPointsChange(key, value, "progressive_contribution_bps"):
+		return "progressive contribution key must be game/<id>/progressive_contribution_bps and value basis points in 1..10000"
+	case namespace == FeatureFlagConfigNamespace && !validFeatureFlagChange(value):
+		return "feature flag value must be a FeatureFlag JSON object with rollout_percent in 0..100"
+	case namespace == BalanceCapConfigNamespace && !validBalanceCapChange(key, value):
+		return "balance cap key must be {<account_type>,account/<id>}/max_balance/CCY with a positive decimal, or <account_type>/on_exceed with deny or partial"
+	}
+	return ""
+}
+
 func (s *ConfigService) ProposeConfigChange(ctx context.Context, req *rgsv1.ProposeConfigChangeRequest) (*rgsv1.ProposeConfigChangeResponse, error) {
 	if req == nil || req.ConfigNamespace == "" || req.ConfigKey == "" || req.ProposedValue == "" {
 		return &rgsv1.ProposeConfigChangeResponse{Meta: s.responseMeta(nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "config_namespace, config_key and proposed_value are required")}, nil
@@ -214,10 +316,23 @@ func (s *ConfigService) ProposeConfigChange(ctx context.Context, req *rgsv1.Prop
 		_ = s.appendAudit(req.Meta, "config_change", "", "propose_config_change", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
 		return &rgsv1.ProposeConfigChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
 	}
+	if reason := invalidProposedValue(req.ConfigNamespace, req.ConfigKey, req.ProposedValue); reason != "" {
+		return &rgsv1.ProposeConfigChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, reason)}, nil
+	}
 
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	schema, err := s.configSchemaLocked(ctx, req.ConfigNamespace, req.ConfigKey)
+	if err != nil {
+		return &rgsv1.ProposeConfigChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if schema != nil {
+		if reason := validateConfigValue(schema, req.ProposedValue); reason != "" {
+			return &rgsv1.ProposeConfigChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "proposed_value does not match config schema: "+reason)}, nil
+		}
+	}
+
 	now := s.now().Format(time.RFC3339Nano)
 	id := s.nextChangeIDLocked()
 	curr := s.currentValues[keyFor(req.ConfigNamespace, req.ConfigKey)]
@@ -229,15 +344,16 @@ func (s *ConfigService) ProposeConfigChange(ctx context.Context, req *rgsv1.Prop
 		curr = dbCurr
 	}
 	change := &rgsv1.ConfigChange{
-		ChangeId:        id,
-		ConfigNamespace: req.ConfigNamespace,
-		ConfigKey:       req.ConfigKey,
-		ProposedValue:   req.ProposedValue,
-		PreviousValue:   curr,
-		Reason:          req.Reason,
-		Status:          rgsv1.ConfigChangeStatus_CONFIG_CHANGE_STATUS_PROPOSED,
-		ProposerId:      req.Meta.Actor.ActorId,
-		CreatedAt:       now,
+		ChangeId:          id,
+		ConfigNamespace:   req.ConfigNamespace,
+		ConfigKey:         req.ConfigKey,
+		ProposedValue:     req.ProposedValue,
+		PreviousValue:     curr,
+		Reason:            req.Reason,
+		Status:            rgsv1.ConfigChangeStatus_CONFIG_CHANGE_STATUS_PROPOSED,
+		ProposerId:        req.Meta.Actor.ActorId,
+		CreatedAt:         now,
+		RequiredApprovals: s.requiredApprovalsLocked(req.ConfigNamespace),
 	}
 
 	after, _ := json.Marshal(change)
@@ -283,16 +399,48 @@ func (s *ConfigService) ApproveConfigChange(ctx context.Context, req *rgsv1.Appr
 	if change.Status != rgsv1.ConfigChangeStatus_CONFIG_CHANGE_STATUS_PROPOSED {
 		return &rgsv1.ApproveConfigChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, "change is not in proposed state")}, nil
 	}
+	// Balance caps, and overrides of them in particular, and rollbacks of
+	// applied changes need a second operator's sign-off.
+	if (change.ConfigNamespace == BalanceCapConfigNamespace || change.RollbackOfChangeId != "") && change.ProposerId == req.Meta.GetActor().GetActorId() {
+		_ = s.appendAudit(req.Meta, "config_change", change.ChangeId, "approve_config_change", []byte(`{}`), []byte(`{}`), audit.ResultDenied, "approver must differ from proposer")
+		return &rgsv1.ApproveConfigChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, "approver must differ from proposer")}, nil
+	}
+	if hasApproved(change, req.Meta.GetActor().GetActorId()) {
+		_ = s.appendAudit(req.Meta, "config_change", change.ChangeId, "approve_config_change", []byte(`{}`), []byte(`{}`), audit.ResultDenied, "approver has already approved")
+		return &rgsv1.ApproveConfigChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, "approver has already approved")}, nil
+	}
+	var applyAt time.Time
+	if req.ApplyAt != "" {
+		t, err := time.Parse(time.RFC3339Nano, req.ApplyAt)
+		if err != nil || !t.After(s.now()) {
+			return &rgsv1.ApproveConfigChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "apply_at must be a future RFC3339 timestamp")}, nil
+		}
+		applyAt = t.UTC()
+	}
 
+	// Each approval is recorded; the change is approved once the
+	// namespace's threshold of distinct approvers is met.
 	before, _ := json.Marshal(change)
-	change.Status = rgsv1.ConfigChangeStatus_CONFIG_CHANGE_STATUS_APPROVED
-	change.ApproverId = req.Meta.Actor.ActorId
-	change.ApprovedAt = s.now().Format(time.RFC3339Nano)
+	approval := &rgsv1.ConfigChangeApproval{
+		ApproverId: req.Meta.Actor.ActorId,
+		ApprovedAt: s.now().Format(time.RFC3339Nano),
+		Reason:     req.Reason,
+	}
+	change.Approvals = append(change.Approvals, approval)
+	if !applyAt.IsZero() {
+		change.ScheduledApplyAt = applyAt.Format(time.RFC3339Nano)
+		change.ScheduledBy = approval.ApproverId
+	}
+	if configApprovalsMet(change) {
+		change.Status = rgsv1.ConfigChangeStatus_CONFIG_CHANGE_STATUS_APPROVED
+		change.ApproverId = approval.ApproverId
+		change.ApprovedAt = approval.ApprovedAt
+	}
 	after, _ := json.Marshal(change)
 	if err := s.appendAudit(req.Meta, "config_change", change.ChangeId, "approve_config_change", before, after, audit.ResultSuccess, req.Reason); err != nil {
 		return &rgsv1.ApproveConfigChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
 	}
-	if err := s.persistConfigChange(ctx, change); err != nil {
+	if err := s.persistConfigApproval(ctx, change, approval); err != nil {
 		return &rgsv1.ApproveConfigChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
 	}
 
@@ -324,29 +472,41 @@ func (s *ConfigService) ApplyConfigChange(ctx context.Context, req *rgsv1.ApplyC
 	if change == nil {
 		return &rgsv1.ApplyConfigChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "change not found")}, nil
 	}
-	if change.Status != rgsv1.ConfigChangeStatus_CONFIG_CHANGE_STATUS_APPROVED {
+	if change.Status != rgsv1.ConfigChangeStatus_CONFIG_CHANGE_STATUS_APPROVED || !configApprovalsMet(change) {
 		return &rgsv1.ApplyConfigChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, "change is not approved")}, nil
 	}
+	if change.ScheduledApplyAt != "" {
+		return &rgsv1.ApplyConfigChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, "change is scheduled; cancel the schedule to apply it now")}, nil
+	}
 
+	if failure := s.applyChangeLocked(ctx, req.Meta, change, req.Meta.Actor.ActorId, req.Reason); failure != "" {
+		return &rgsv1.ApplyConfigChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, failure)}, nil
+	}
+	return &rgsv1.ApplyConfigChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Change: cloneChange(change)}, nil
+}
+
+// applyChangeLocked makes an approved change the current value, audits it,
+// and persists it, returning why it failed. s.mu must be held.
+func (s *ConfigService) applyChangeLocked(ctx context.Context, meta *rgsv1.RequestMeta, change *rgsv1.ConfigChange, appliedBy, reason string) string {
 	before, _ := json.Marshal(change)
 	change.Status = rgsv1.ConfigChangeStatus_CONFIG_CHANGE_STATUS_APPLIED
-	change.AppliedBy = req.Meta.Actor.ActorId
+	change.AppliedBy = appliedBy
 	change.AppliedAt = s.now().Format(time.RFC3339Nano)
 	if !s.disableInMemoryCache {
 		s.currentValues[keyFor(change.ConfigNamespace, change.ConfigKey)] = change.ProposedValue
 	}
 	after, _ := json.Marshal(change)
-	if err := s.appendAudit(req.Meta, "config_change", change.ChangeId, "apply_config_change", before, after, audit.ResultSuccess, req.Reason); err != nil {
-		return &rgsv1.ApplyConfigChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	if err := s.appendAudit(meta, "config_change", change.ChangeId, "apply_config_change", before, after, audit.ResultSuccess, reason); err != nil {
+		return "audit unavailable"
 	}
 	if err := s.persistConfigChange(ctx, change); err != nil {
-		return &rgsv1.ApplyConfigChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		return "persistence unavailable"
 	}
 	if err := s.persistCurrentValue(ctx, change.ConfigNamespace, change.ConfigKey, change.ProposedValue, change.AppliedBy); err != nil {
-		return &rgsv1.ApplyConfigChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		return "persistence unavailable"
 	}
-
-	return &rgsv1.ApplyConfigChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Change: cloneChange(change)}, nil
+	s.watchers.publish(change)
+	return ""
 }
 
 func (s *ConfigService) ListConfigHistory(ctx context.Context, req *rgsv1.ListConfigHistoryRequest) (*rgsv1.ListConfigHistoryResponse, error) {
@@ -431,19 +591,27 @@ func (s *ConfigService) RecordDownloadLibraryChange(ctx context.Context, req *rg
 	if entry.OccurredAt == "" {
 		entry.OccurredAt = s.now().Format(time.RFC3339Nano)
 	}
-	if entry.Action == rgsv1.DownloadAction_DOWNLOAD_ACTION_ACTIVATE {
-		if entry.SignerKid == "" || entry.Signature == "" {
-			return &rgsv1.RecordDownloadLibraryChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "activation requires signer_kid and signature")}, nil
-		}
-		secret := s.downloadSigKeys[entry.SignerKid]
-		if !verifyDownloadSignature(entry, secret) {
-			_ = s.appendAudit(req.Meta, "download_library_entry", entry.EntryId, "record_download_library_change", []byte(`{}`), []byte(`{}`), audit.ResultDenied, "invalid download signature")
-			return &rgsv1.RecordDownloadLibraryChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, "invalid download signature")}, nil
+	if entry.Action == rgsv1.DownloadAction_DOWNLOAD_ACTION_ACTIVATE && (entry.SignerKid == "" || entry.Signature == "") {
+		return &rgsv1.RecordDownloadLibraryChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "activation requires signer_kid and signature")}, nil
+	}
+	entry.VerificationResult = downloadVerificationUnsigned
+	entry.VerificationFailure = ""
+	if entry.Signature != "" {
+		alg, failure := s.verifyDownloadEntryLocked(entry)
+		if alg != "" {
+			entry.SignatureAlg = alg
 		}
-		if entry.SignatureAlg == "" {
-			entry.SignatureAlg = "HMAC-SHA256"
+		entry.VerificationResult = downloadVerificationVerified
+		if failure != "" {
+			entry.VerificationResult = downloadVerificationFailed
+			entry.VerificationFailure = failure
 		}
 	}
+	if entry.Action == rgsv1.DownloadAction_DOWNLOAD_ACTION_ACTIVATE && entry.VerificationResult != downloadVerificationVerified {
+		reason := "invalid download signature: " + entry.VerificationFailure
+		_ = s.appendAudit(req.Meta, "download_library_entry", entry.EntryId, "record_download_library_change", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		return &rgsv1.RecordDownloadLibraryChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
 
 	after, _ := json.Marshal(entry)
 	if err := s.appendAudit(req.Meta, "download_library_entry", entry.EntryId, "record_download_library_change", []byte(`{}`), after, audit.ResultSuccess, entry.Reason); err != nil {
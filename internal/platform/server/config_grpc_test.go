@@ -291,7 +291,7 @@ func TestConfigActorMismatchDenied(t *testing.T) {
 		t.Fatalf("expected actor mismatch reason on list history, got=%q", history.Meta.GetDenialReason())
 	}
 
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 {
 		t.Fatalf("expected denied config audit events")
 	}
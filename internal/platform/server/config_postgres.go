@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"strings"
 	"time"
 
@@ -16,16 +17,19 @@ func (s *ConfigService) persistConfigChange(ctx context.Context, c *rgsv1.Config
 	const q = `
 INSERT INTO config_changes (
   change_id, config_namespace, config_key, proposed_value, previous_value, reason,
-  status, proposer_id, approver_id, applied_by, created_at, approved_at, applied_at
+  status, proposer_id, approver_id, applied_by, created_at, approved_at, applied_at,
+  rollback_of_change_id, required_approvals, scheduled_apply_at, scheduled_by
 )
-VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11::timestamptz,NULLIF($12,'')::timestamptz,NULLIF($13,'')::timestamptz)
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11::timestamptz,NULLIF($12,'')::timestamptz,NULLIF($13,'')::timestamptz,$14,$15,NULLIF($16,'')::timestamptz,$17)
 ON CONFLICT (change_id) DO UPDATE SET
   status = EXCLUDED.status,
   approver_id = EXCLUDED.approver_id,
   applied_by = EXCLUDED.applied_by,
   approved_at = EXCLUDED.approved_at,
   applied_at = EXCLUDED.applied_at,
-  reason = EXCLUDED.reason
+  reason = EXCLUDED.reason,
+  scheduled_apply_at = EXCLUDED.scheduled_apply_at,
+  scheduled_by = EXCLUDED.scheduled_by
 `
 	_, err := s.db.ExecContext(ctx, q,
 		c.ChangeId,
@@ -41,10 +45,31 @@ ON CONFLICT (change_id) DO UPDATE SET
 		nullIfEmpty(c.CreatedAt),
 		nullIfEmpty(c.ApprovedAt),
 		nullIfEmpty(c.AppliedAt),
+		c.RollbackOfChangeId,
+		max(c.RequiredApprovals, 1),
+		nullIfEmpty(c.ScheduledApplyAt),
+		c.ScheduledBy,
 	)
 	return err
 }
 
+// persistConfigApproval records one approval of c along with the change's
+// resulting status. The primary key rejects a second approval by the same
+// operator.
+func (s *ConfigService) persistConfigApproval(ctx context.Context, c *rgsv1.ConfigChange, a *rgsv1.ConfigChangeApproval) error {
+	if s == nil || s.db == nil || c == nil || a == nil {
+		return nil
+	}
+	const q = `
+INSERT INTO config_change_approvals (change_id, approver_id, approved_at, reason)
+VALUES ($1,$2,$3::timestamptz,$4)
+`
+	if _, err := s.db.ExecContext(ctx, q, c.ChangeId, a.ApproverId, a.ApprovedAt, a.Reason); err != nil {
+		return err
+	}
+	return s.persistConfigChange(ctx, c)
+}
+
 func (s *ConfigService) persistCurrentValue(ctx context.Context, namespace, key, value, updatedBy string) error {
 	if s == nil || s.db == nil {
 		return nil
@@ -67,8 +92,9 @@ func (s *ConfigService) persistDownloadEntry(ctx context.Context, e *rgsv1.Downl
 	}
 	const q = `
 INSERT INTO download_library_changes (
-  entry_id, library_path, checksum, version, action, changed_by, reason, occurred_at, signer_kid, signature, signature_alg
-) VALUES ($1,$2,$3,$4,$5,$6,$7,$8::timestamptz,$9,$10,$11)
+  entry_id, library_path, checksum, version, action, changed_by, reason, occurred_at, signer_kid, signature, signature_alg,
+  verification_result, verification_failure
+) VALUES ($1,$2,$3,$4,$5,$6,$7,$8::timestamptz,$9,$10,$11,$12,$13)
 ON CONFLICT (entry_id) DO UPDATE SET
   checksum = EXCLUDED.checksum,
   version = EXCLUDED.version,
@@ -78,7 +104,9 @@ ON CONFLICT (entry_id) DO UPDATE SET
   occurred_at = EXCLUDED.occurred_at,
   signer_kid = EXCLUDED.signer_kid,
   signature = EXCLUDED.signature,
-  signature_alg = EXCLUDED.signature_alg
+  signature_alg = EXCLUDED.signature_alg,
+  verification_result = EXCLUDED.verification_result,
+  verification_failure = EXCLUDED.verification_failure
 `
 	_, err := s.db.ExecContext(ctx, q,
 		e.EntryId,
@@ -92,6 +120,8 @@ ON CONFLICT (entry_id) DO UPDATE SET
 		e.SignerKid,
 		e.Signature,
 		e.SignatureAlg,
+		e.VerificationResult,
+		e.VerificationFailure,
 	)
 	return err
 }
@@ -120,56 +150,24 @@ func (s *ConfigService) getConfigChange(ctx context.Context, changeID string) (*
 	if s == nil || s.db == nil {
 		return nil, nil
 	}
-	const q = `
-SELECT change_id, config_namespace, config_key, proposed_value, previous_value, reason,
-       status::text, proposer_id, approver_id, applied_by, created_at, approved_at, applied_at
+	q := `
+SELECT ` + configChangeColumns + `
 FROM config_changes
 WHERE change_id = $1
 `
-	var (
-		changeIDVal, ns, key, proposed, previous, reason, status, proposer, approver, appliedBy string
-		createdAt                                                                               time.Time
-		approvedAt, appliedAt                                                                   sql.NullTime
-	)
-	err := s.db.QueryRowContext(ctx, q, changeID).Scan(
-		&changeIDVal, &ns, &key, &proposed, &previous, &reason,
-		&status, &proposer, &approver, &appliedBy, &createdAt, &approvedAt, &appliedAt,
-	)
+	c, err := scanConfigChange(s.db.QueryRowContext(ctx, q, changeID))
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
-	if err != nil {
-		return nil, err
-	}
-	c := &rgsv1.ConfigChange{
-		ChangeId:        changeIDVal,
-		ConfigNamespace: ns,
-		ConfigKey:       key,
-		ProposedValue:   proposed,
-		PreviousValue:   previous,
-		Reason:          reason,
-		Status:          configStatusFromDB(status),
-		ProposerId:      proposer,
-		ApproverId:      approver,
-		AppliedBy:       appliedBy,
-		CreatedAt:       createdAt.UTC().Format(time.RFC3339Nano),
-	}
-	if approvedAt.Valid {
-		c.ApprovedAt = approvedAt.Time.UTC().Format(time.RFC3339Nano)
-	}
-	if appliedAt.Valid {
-		c.AppliedAt = appliedAt.Time.UTC().Format(time.RFC3339Nano)
-	}
-	return c, nil
+	return c, err
 }
 
 func (s *ConfigService) listConfigHistoryFromDB(ctx context.Context, namespaceFilter string, limit, offset int) ([]*rgsv1.ConfigChange, error) {
 	if s == nil || s.db == nil {
 		return nil, nil
 	}
-	const q = `
-SELECT change_id, config_namespace, config_key, proposed_value, previous_value, reason,
-       status::text, proposer_id, approver_id, applied_by, created_at, approved_at, applied_at
+	q := `
+SELECT ` + configChangeColumns + `
 FROM config_changes
 WHERE ($1 = '' OR config_namespace = $1)
 ORDER BY created_at DESC, change_id DESC
@@ -183,36 +181,10 @@ LIMIT $2 OFFSET $3
 
 	out := make([]*rgsv1.ConfigChange, 0, limit)
 	for rows.Next() {
-		var (
-			changeIDVal, ns, key, proposed, previous, reason, status, proposer, approver, appliedBy string
-			createdAt                                                                               time.Time
-			approvedAt, appliedAt                                                                   sql.NullTime
-		)
-		if err := rows.Scan(
-			&changeIDVal, &ns, &key, &proposed, &previous, &reason,
-			&status, &proposer, &approver, &appliedBy, &createdAt, &approvedAt, &appliedAt,
-		); err != nil {
+		item, err := scanConfigChange(rows)
+		if err != nil {
 			return nil, err
 		}
-		item := &rgsv1.ConfigChange{
-			ChangeId:        changeIDVal,
-			ConfigNamespace: ns,
-			ConfigKey:       key,
-			ProposedValue:   proposed,
-			PreviousValue:   previous,
-			Reason:          reason,
-			Status:          configStatusFromDB(status),
-			ProposerId:      proposer,
-			ApproverId:      approver,
-			AppliedBy:       appliedBy,
-			CreatedAt:       createdAt.UTC().Format(time.RFC3339Nano),
-		}
-		if approvedAt.Valid {
-			item.ApprovedAt = approvedAt.Time.UTC().Format(time.RFC3339Nano)
-		}
-		if appliedAt.Valid {
-			item.AppliedAt = appliedAt.Time.UTC().Format(time.RFC3339Nano)
-		}
 		out = append(out, item)
 	}
 	return out, rows.Err()
@@ -223,7 +195,8 @@ func (s *ConfigService) listDownloadEntriesFromDB(ctx context.Context, limit, of
 		return nil, nil
 	}
 	const q = `
-SELECT entry_id, library_path, checksum, version, action::text, changed_by, reason, occurred_at, signer_kid, signature, signature_alg
+SELECT entry_id, library_path, checksum, version, action::text, changed_by, reason, occurred_at, signer_kid, signature, signature_alg,
+       verification_result, verification_failure
 FROM download_library_changes
 ORDER BY occurred_at DESC, entry_id DESC
 LIMIT $1 OFFSET $2
@@ -238,25 +211,305 @@ LIMIT $1 OFFSET $2
 	for rows.Next() {
 		var (
 			entryID, path, checksum, version, action, changedBy, reason, signerKid, signature, signatureAlg string
+			verificationResult, verificationFailure                                                         string
 			occurredAt                                                                                      time.Time
 		)
-		if err := rows.Scan(&entryID, &path, &checksum, &version, &action, &changedBy, &reason, &occurredAt, &signerKid, &signature, &signatureAlg); err != nil {
+		if err := rows.Scan(&entryID, &path, &checksum, &version, &action, &changedBy, &reason, &occurredAt, &signerKid, &signature, &signatureAlg, &verificationResult, &verificationFailure); err != nil {
 			return nil, err
 		}
 		out = append(out, &rgsv1.DownloadLibraryEntry{
-			EntryId:      entryID,
-			LibraryPath:  path,
-			Checksum:     checksum,
-			Version:      version,
-			Action:       downloadActionFromDB(action),
-			ChangedBy:    changedBy,
-			Reason:       reason,
-			OccurredAt:   occurredAt.UTC().Format(time.RFC3339Nano),
-			SignerKid:    signerKid,
-			Signature:    signature,
-			SignatureAlg: signatureAlg,
+			EntryId:             entryID,
+			LibraryPath:         path,
+			Checksum:            checksum,
+			Version:             version,
+			Action:              downloadActionFromDB(action),
+			ChangedBy:           changedBy,
+			Reason:              reason,
+			OccurredAt:          occurredAt.UTC().Format(time.RFC3339Nano),
+			SignerKid:           signerKid,
+			Signature:           signature,
+			SignatureAlg:        signatureAlg,
+			VerificationResult:  verificationResult,
+			VerificationFailure: verificationFailure,
+		})
+	}
+	return out, rows.Err()
+}
+
+const configChangeColumns = `
+change_id, config_namespace, config_key, proposed_value, previous_value, reason,
+status::text, proposer_id, approver_id, applied_by, created_at, approved_at, applied_at,
+rollback_of_change_id, required_approvals, scheduled_apply_at, scheduled_by,
+COALESCE((
+  SELECT json_agg(json_build_object('approver_id', a.approver_id, 'approved_at', a.approved_at, 'reason', a.reason)
+                  ORDER BY a.approved_at, a.approver_id)
+  FROM config_change_approvals a
+  WHERE a.change_id = config_changes.change_id
+), '[]')::text`
+
+type configChangeRow interface {
+	Scan(dest ...any) error
+}
+
+func scanConfigChange(row configChangeRow) (*rgsv1.ConfigChange, error) {
+	var (
+		changeIDVal, ns, key, proposed, previous, reason, status, proposer, approver, appliedBy, rollbackOf, scheduledBy string
+		createdAt                                                                                                        time.Time
+		approvedAt, appliedAt, scheduledApplyAt                                                                          sql.NullTime
+		requiredApprovals                                                                                                int32
+		approvalsJSON                                                                                                    string
+	)
+	if err := row.Scan(
+		&changeIDVal, &ns, &key, &proposed, &previous, &reason,
+		&status, &proposer, &approver, &appliedBy, &createdAt, &approvedAt, &appliedAt,
+		&rollbackOf, &requiredApprovals, &scheduledApplyAt, &scheduledBy, &approvalsJSON,
+	); err != nil {
+		return nil, err
+	}
+	var approvals []struct {
+		ApproverID string    `json:"approver_id"`
+		ApprovedAt time.Time `json:"approved_at"`
+		Reason     string    `json:"reason"`
+	}
+	if err := json.Unmarshal([]byte(approvalsJSON), &approvals); err != nil {
+		return nil, err
+	}
+	c := &rgsv1.ConfigChange{
+		ChangeId:           changeIDVal,
+		ConfigNamespace:    ns,
+		ConfigKey:          key,
+		ProposedValue:      proposed,
+		PreviousValue:      previous,
+		Reason:             reason,
+		Status:             configStatusFromDB(status),
+		ProposerId:         proposer,
+		ApproverId:         approver,
+		AppliedBy:          appliedBy,
+		CreatedAt:          createdAt.UTC().Format(time.RFC3339Nano),
+		RollbackOfChangeId: rollbackOf,
+		RequiredApprovals:  requiredApprovals,
+		ScheduledApplyAt:   formatNullTime(scheduledApplyAt),
+		ScheduledBy:        scheduledBy,
+	}
+	for _, a := range approvals {
+		c.Approvals = append(c.Approvals, &rgsv1.ConfigChangeApproval{
+			ApproverId: a.ApproverID,
+			ApprovedAt: a.ApprovedAt.UTC().Format(time.RFC3339Nano),
+			Reason:     a.Reason,
 		})
 	}
+	if approvedAt.Valid {
+		c.ApprovedAt = approvedAt.Time.UTC().Format(time.RFC3339Nano)
+	}
+	if appliedAt.Valid {
+		c.AppliedAt = appliedAt.Time.UTC().Format(time.RFC3339Nano)
+	}
+	return c, nil
+}
+
+func (s *ConfigService) appliedChangeAsOfFromDB(ctx context.Context, namespace, key string, at time.Time) (*rgsv1.ConfigChange, error) {
+	q := `
+SELECT ` + configChangeColumns + `
+FROM config_changes
+WHERE config_namespace = $1 AND config_key = $2 AND status = 'applied' AND applied_at <= $3
+ORDER BY applied_at DESC, change_id DESC
+LIMIT 1
+`
+	c, err := scanConfigChange(s.db.QueryRowContext(ctx, q, namespace, key, at.UTC()))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return c, err
+}
+
+func (s *ConfigService) appliedChangesAsOfFromDB(ctx context.Context, at time.Time) ([]*rgsv1.ConfigChange, error) {
+	q := `
+SELECT DISTINCT ON (config_namespace, config_key) ` + configChangeColumns + `
+FROM config_changes
+WHERE status = 'applied' AND applied_at <= $1
+ORDER BY config_namespace, config_key, applied_at DESC, change_id DESC
+`
+	rows, err := s.db.QueryContext(ctx, q, at.UTC())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]*rgsv1.ConfigChange, 0)
+	for rows.Next() {
+		c, err := scanConfigChange(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, c)
+	}
+	return out, rows.Err()
+}
+
+func (s *ConfigService) appliedChangesSinceFromDB(ctx context.Context, namespace string, since time.Time, limit int) ([]*rgsv1.ConfigChange, error) {
+	q := `
+SELECT ` + configChangeColumns + `
+FROM config_changes
+WHERE config_namespace = $1 AND status = 'applied' AND applied_at > $2
+ORDER BY applied_at, change_id
+LIMIT $3
+`
+	rows, err := s.db.QueryContext(ctx, q, namespace, since.UTC(), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]*rgsv1.ConfigChange, 0)
+	for rows.Next() {
+		c, err := scanConfigChange(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, c)
+	}
+	return out, rows.Err()
+}
+
+func (s *ConfigService) dueScheduledChangesFromDB(ctx context.Context, now time.Time, limit int) ([]*rgsv1.ConfigChange, error) {
+	q := `
+SELECT ` + configChangeColumns + `
+FROM config_changes
+WHERE status = 'approved' AND scheduled_apply_at IS NOT NULL AND scheduled_apply_at <= $1
+ORDER BY scheduled_apply_at, change_id
+LIMIT $2
+`
+	rows, err := s.db.QueryContext(ctx, q, now.UTC(), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]*rgsv1.ConfigChange, 0)
+	for rows.Next() {
+		c, err := scanConfigChange(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, c)
+	}
+	return out, rows.Err()
+}
+
+func (s *ConfigService) persistConfigSchema(ctx context.Context, c *rgsv1.ConfigSchema) error {
+	if s == nil || s.db == nil || c == nil {
+		return nil
+	}
+	allowed, err := json.Marshal(nonNilStrings(c.AllowedValues))
+	if err != nil {
+		return err
+	}
+	const q = `
+INSERT INTO config_schemas (
+  config_namespace, config_key, value_type, min_value, max_value, pattern, allowed_values, updated_by, updated_at
+)
+VALUES ($1,$2,$3,$4,$5,$6,$7::jsonb,$8,$9::timestamptz)
+ON CONFLICT (config_namespace, config_key) DO UPDATE SET
+  value_type = EXCLUDED.value_type,
+  min_value = EXCLUDED.min_value,
+  max_value = EXCLUDED.max_value,
+  pattern = EXCLUDED.pattern,
+  allowed_values = EXCLUDED.allowed_values,
+  updated_by = EXCLUDED.updated_by,
+  updated_at = EXCLUDED.updated_at
+`
+	_, err = s.db.ExecContext(ctx, q,
+		c.ConfigNamespace,
+		c.ConfigKey,
+		configValueTypeToDB(c.ValueType),
+		c.MinValue,
+		c.MaxValue,
+		c.Pattern,
+		string(allowed),
+		c.UpdatedBy,
+		c.UpdatedAt,
+	)
+	return err
+}
+
+const configSchemaColumns = `
+config_namespace, config_key, value_type, min_value, max_value, pattern, allowed_values::text, updated_by, updated_at
+`
+
+func scanConfigSchema(row configChangeRow) (*rgsv1.ConfigSchema, error) {
+	var (
+		c                  rgsv1.ConfigSchema
+		valueType, allowed string
+		updatedAt          time.Time
+	)
+	if err := row.Scan(&c.ConfigNamespace, &c.ConfigKey, &valueType, &c.MinValue, &c.MaxValue, &c.Pattern, &allowed, &c.UpdatedBy, &updatedAt); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(allowed), &c.AllowedValues); err != nil {
+		return nil, err
+	}
+	c.ValueType = configValueTypeFromDB(valueType)
+	c.UpdatedAt = updatedAt.UTC().Format(time.RFC3339Nano)
+	return &c, nil
+}
+
+func (s *ConfigService) getConfigSchema(ctx context.Context, namespace, key string) (*rgsv1.ConfigSchema, error) {
+	if s == nil || s.db == nil {
+		return nil, nil
+	}
+	q := `
+SELECT ` + configSchemaColumns + `
+FROM config_schemas
+WHERE config_namespace = $1 AND config_key = $2
+`
+	c, err := scanConfigSchema(s.db.QueryRowContext(ctx, q, namespace, key))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return c, err
+}
+
+func (s *ConfigService) listConfigSchemasFromDB(ctx context.Context, namespaceFilter string) ([]*rgsv1.ConfigSchema, error) {
+	q := `
+SELECT ` + configSchemaColumns + `
+FROM config_schemas
+WHERE ($1 = '' OR config_namespace = $1)
+ORDER BY config_namespace, config_key
+`
+	rows, err := s.db.QueryContext(ctx, q, namespaceFilter)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]*rgsv1.ConfigSchema, 0)
+	for rows.Next() {
+		c, err := scanConfigSchema(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, c)
+	}
+	return out, rows.Err()
+}
+
+// currentValuesWithPrefixFromDB returns the applied values of the keys in
+// namespace that start with prefix, keyed by config key.
+func (s *ConfigService) currentValuesWithPrefixFromDB(ctx context.Context, namespace, prefix string) (map[string]string, error) {
+	const q = `
+SELECT config_key, value
+FROM config_current_values
+WHERE config_namespace = $1 AND starts_with(config_key, $2)
+`
+	rows, err := s.db.QueryContext(ctx, q, namespace, prefix)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make(map[string]string)
+	for rows.Next() {
+		var key, value string
+		if err := rows.Scan(&key, &value); err != nil {
+			return nil, err
+		}
+		out[key] = value
+	}
 	return out, rows.Err()
 }
 
@@ -320,6 +573,36 @@ func downloadActionFromDB(v string) rgsv1.DownloadAction {
 	}
 }
 
+func configValueTypeToDB(v rgsv1.ConfigValueType) string {
+	switch v {
+	case rgsv1.ConfigValueType_CONFIG_VALUE_TYPE_STRING:
+		return "string"
+	case rgsv1.ConfigValueType_CONFIG_VALUE_TYPE_INTEGER:
+		return "integer"
+	case rgsv1.ConfigValueType_CONFIG_VALUE_TYPE_DECIMAL:
+		return "decimal"
+	case rgsv1.ConfigValueType_CONFIG_VALUE_TYPE_BOOLEAN:
+		return "boolean"
+	default:
+		return ""
+	}
+}
+
+func configValueTypeFromDB(v string) rgsv1.ConfigValueType {
+	switch v {
+	case "string":
+		return rgsv1.ConfigValueType_CONFIG_VALUE_TYPE_STRING
+	case "integer":
+		return rgsv1.ConfigValueType_CONFIG_VALUE_TYPE_INTEGER
+	case "decimal":
+		return rgsv1.ConfigValueType_CONFIG_VALUE_TYPE_DECIMAL
+	case "boolean":
+		return rgsv1.ConfigValueType_CONFIG_VALUE_TYPE_BOOLEAN
+	default:
+		return rgsv1.ConfigValueType_CONFIG_VALUE_TYPE_UNSPECIFIED
+	}
+}
+
 func nullIfEmpty(v string) any {
 	if strings.TrimSpace(v) == "" {
 		return sql.NullString{}
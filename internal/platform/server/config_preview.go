@@ -0,0 +1,95 @@
+package server
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+// equipmentOverridesLocked returns the equipment-scoped stake limits that
+// take precedence over the unscoped or game-scoped limit key, ordered by
+// equipment id. s.mu must be held.
+func (s *ConfigService) equipmentOverridesLocked(ctx context.Context, key string) ([]*rgsv1.ConfigOverridePreview, error) {
+	limit, ok := parseStakeLimitKey(key)
+	if !ok || limit.scope == "equipment" {
+		return nil, nil
+	}
+	values := make(map[string]string)
+	if s.db != nil {
+		dbValues, err := s.currentValuesWithPrefixFromDB(ctx, WageringConfigNamespace, "equipment/")
+		if err != nil {
+			return nil, err
+		}
+		values = dbValues
+	} else {
+		prefix := keyFor(WageringConfigNamespace, "equipment/")
+		for k, v := range s.currentValues {
+			if rest, ok := strings.CutPrefix(k, prefix); ok {
+				values["equipment/"+rest] = v
+			}
+		}
+	}
+	var out []*rgsv1.ConfigOverridePreview
+	for k, v := range values {
+		o, ok := parseStakeLimitKey(k)
+		if !ok || o.scope != "equipment" || o.bound != limit.bound || o.currency != limit.currency {
+			continue
+		}
+		out = append(out, &rgsv1.ConfigOverridePreview{EquipmentId: o.scopeID, ConfigKey: k, Value: v})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].EquipmentId < out[j].EquipmentId })
+	return out, nil
+}
+
+func (s *ConfigService) PreviewConfigChange(ctx context.Context, req *rgsv1.PreviewConfigChangeRequest) (*rgsv1.PreviewConfigChangeResponse, error) {
+	if req == nil || req.ConfigNamespace == "" || req.ConfigKey == "" || req.ProposedValue == "" {
+		return &rgsv1.PreviewConfigChangeResponse{Meta: s.responseMeta(nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "config_namespace, config_key and proposed_value are required")}, nil
+	}
+	if ok, reason := s.authorize(ctx, req.Meta); !ok {
+		_ = s.appendAudit(req.Meta, "config_change", "", "preview_config_change", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		return &rgsv1.PreviewConfigChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	if reason := invalidProposedValue(req.ConfigNamespace, req.ConfigKey, req.ProposedValue); reason != "" {
+		return &rgsv1.PreviewConfigChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, reason)}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	schema, err := s.configSchemaLocked(ctx, req.ConfigNamespace, req.ConfigKey)
+	if err != nil {
+		return &rgsv1.PreviewConfigChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if schema != nil {
+		if reason := validateConfigValue(schema, req.ProposedValue); reason != "" {
+			return &rgsv1.PreviewConfigChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "proposed_value does not match config schema: "+reason)}, nil
+		}
+	}
+
+	before, found := s.currentValues[keyFor(req.ConfigNamespace, req.ConfigKey)]
+	if s.db != nil {
+		dbBefore, err := s.getCurrentValue(ctx, req.ConfigNamespace, req.ConfigKey)
+		if err != nil {
+			return &rgsv1.PreviewConfigChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+		before, found = dbBefore, dbBefore != ""
+	}
+	resp := &rgsv1.PreviewConfigChangeResponse{
+		BeforeValue: before,
+		BeforeFound: found,
+		AfterValue:  req.ProposedValue,
+		Changed:     !found || before != req.ProposedValue,
+	}
+	if req.ConfigNamespace == WageringConfigNamespace {
+		overrides, err := s.equipmentOverridesLocked(ctx, req.ConfigKey)
+		if err != nil {
+			return &rgsv1.PreviewConfigChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+		resp.EquipmentOverrides = overrides
+	}
+	resp.Meta = s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, "")
+	return resp, nil
+}
@@ -0,0 +1,67 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func TestPreviewConfigChangeShowsEffectWithoutRecordingChange(t *testing.T) {
+	svc := NewConfigService(ledgerFixedClock{now: time.Date(2026, 2, 12, 18, 0, 0, 0, time.UTC)})
+	ctx := context.Background()
+	apply := func(key, value string) {
+		proposed, _ := svc.ProposeConfigChange(ctx, &rgsv1.ProposeConfigChangeRequest{
+			Meta:            meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+			ConfigNamespace: WageringConfigNamespace,
+			ConfigKey:       key,
+			ProposedValue:   value,
+		})
+		if proposed.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("propose %s: %+v", key, proposed.Meta)
+		}
+		approveAndApplyConfigChange(t, svc, proposed.Change.ChangeId, "op-2")
+	}
+	apply("game/blackjack/max_stake/USD", "500.00")
+	apply("equipment/cab-2/max_stake/USD", "100.00")
+	apply("equipment/cab-1/max_stake/USD", "250.00")
+	apply("equipment/cab-1/max_stake/EUR", "200.00")
+	apply("equipment/cab-1/min_stake/USD", "1.00")
+
+	preview := func(key, value string) *rgsv1.PreviewConfigChangeResponse {
+		resp, err := svc.PreviewConfigChange(ctx, &rgsv1.PreviewConfigChangeRequest{
+			Meta:            meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+			ConfigNamespace: WageringConfigNamespace,
+			ConfigKey:       key,
+			ProposedValue:   value,
+		})
+		if err != nil {
+			t.Fatalf("preview err: %v", err)
+		}
+		return resp
+	}
+
+	resp := preview("game/blackjack/max_stake/USD", "750.00")
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || !resp.BeforeFound || resp.BeforeValue != "500.00" || resp.AfterValue != "750.00" || !resp.Changed {
+		t.Fatalf("unexpected preview: %+v", resp)
+	}
+	if len(resp.EquipmentOverrides) != 2 || resp.EquipmentOverrides[0].EquipmentId != "cab-1" || resp.EquipmentOverrides[0].Value != "250.00" || resp.EquipmentOverrides[1].ConfigKey != "equipment/cab-2/max_stake/USD" {
+		t.Fatalf("expected the two USD max_stake overrides: %+v", resp.EquipmentOverrides)
+	}
+
+	if resp := preview("max_stake/GBP", "50.00"); resp.BeforeFound || !resp.Changed || len(resp.EquipmentOverrides) != 0 {
+		t.Fatalf("expected new key with no overrides: %+v", resp)
+	}
+	if resp := preview("equipment/cab-1/max_stake/USD", "250.00"); resp.Changed || len(resp.EquipmentOverrides) != 0 {
+		t.Fatalf("expected unchanged equipment key: %+v", resp)
+	}
+	if resp := preview("game/blackjack/max_stake/USD", "-1"); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected invalid value to be rejected: %+v", resp.Meta)
+	}
+
+	history, _ := svc.ListConfigHistory(ctx, &rgsv1.ListConfigHistoryRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")})
+	if len(history.Changes) != 5 {
+		t.Fatalf("expected preview not to record changes, got %d", len(history.Changes))
+	}
+}
@@ -0,0 +1,90 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+// RollbackConfigChange proposes restoring the value an applied change
+// replaced. The rollback is a new change linked by rollback_of_change_id; it
+// takes effect only once a second operator approves it and it is applied.
+// Only the change currently in effect for its key can be rolled back.
+func (s *ConfigService) RollbackConfigChange(ctx context.Context, req *rgsv1.RollbackConfigChangeRequest) (*rgsv1.RollbackConfigChangeResponse, error) {
+	if req == nil || req.ChangeId == "" {
+		return &rgsv1.RollbackConfigChangeResponse{Meta: s.responseMeta(nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "change_id is required")}, nil
+	}
+	if ok, reason := s.authorize(ctx, req.Meta); !ok {
+		_ = s.appendAudit(req.Meta, "config_change", req.ChangeId, "rollback_config_change", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		return &rgsv1.RollbackConfigChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	original := s.changes[req.ChangeId]
+	if original == nil && s.db != nil {
+		var err error
+		original, err = s.getConfigChange(ctx, req.ChangeId)
+		if err != nil {
+			return &rgsv1.RollbackConfigChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+	}
+	if original == nil {
+		return &rgsv1.RollbackConfigChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "change not found")}, nil
+	}
+	if original.Status != rgsv1.ConfigChangeStatus_CONFIG_CHANGE_STATUS_APPLIED {
+		return &rgsv1.RollbackConfigChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, "change is not applied")}, nil
+	}
+	effective, err := s.effectiveChangeLocked(ctx, original.ConfigNamespace, original.ConfigKey)
+	if err != nil {
+		return &rgsv1.RollbackConfigChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if effective == nil || effective.ChangeId != original.ChangeId {
+		_ = s.appendAudit(req.Meta, "config_change", original.ChangeId, "rollback_config_change", []byte(`{}`), []byte(`{}`), audit.ResultDenied, "change is no longer in effect")
+		return &rgsv1.RollbackConfigChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, "change is no longer in effect")}, nil
+	}
+	if original.PreviousValue == "" {
+		return &rgsv1.RollbackConfigChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "change has no previous value to restore")}, nil
+	}
+
+	id := s.nextChangeIDLocked()
+	change := &rgsv1.ConfigChange{
+		ChangeId:           id,
+		ConfigNamespace:    original.ConfigNamespace,
+		ConfigKey:          original.ConfigKey,
+		ProposedValue:      original.PreviousValue,
+		PreviousValue:      original.ProposedValue,
+		Reason:             req.Reason,
+		Status:             rgsv1.ConfigChangeStatus_CONFIG_CHANGE_STATUS_PROPOSED,
+		ProposerId:         req.Meta.Actor.ActorId,
+		CreatedAt:          s.now().Format(time.RFC3339Nano),
+		RollbackOfChangeId: original.ChangeId,
+		RequiredApprovals:  s.requiredApprovalsLocked(original.ConfigNamespace),
+	}
+	before, _ := json.Marshal(original)
+	after, _ := json.Marshal(change)
+	if err := s.appendAudit(req.Meta, "config_change", id, "rollback_config_change", before, after, audit.ResultSuccess, req.Reason); err != nil {
+		return &rgsv1.RollbackConfigChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	if err := s.persistConfigChange(ctx, change); err != nil {
+		return &rgsv1.RollbackConfigChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+
+	if !s.disableInMemoryCache {
+		s.changes[id] = change
+		s.changeOrder = append(s.changeOrder, id)
+	}
+	return &rgsv1.RollbackConfigChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Change: cloneChange(change)}, nil
+}
+
+// effectiveChangeLocked returns the applied change currently in effect for
+// namespace/key. s.mu must be held.
+func (s *ConfigService) effectiveChangeLocked(ctx context.Context, namespace, key string) (*rgsv1.ConfigChange, error) {
+	if s.db != nil {
+		return s.appliedChangeAsOfFromDB(ctx, namespace, key, s.now())
+	}
+	return s.effectiveChangesLocked(s.now())[keyFor(namespace, key)], nil
+}
@@ -0,0 +1,136 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func applyConfigChange(t *testing.T, svc *ConfigService, proposer, approver, value string) *rgsv1.ConfigChange {
+	t.Helper()
+	ctx := context.Background()
+	proposed, _ := svc.ProposeConfigChange(ctx, &rgsv1.ProposeConfigChangeRequest{
+		Meta:            meta(proposer, rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		ConfigNamespace: "security",
+		ConfigKey:       "session_timeout",
+		ProposedValue:   value,
+		Reason:          "tune timeout",
+	})
+	if proposed.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("propose: %+v", proposed.Meta)
+	}
+	return approveAndApplyConfigChange(t, svc, proposed.Change.ChangeId, approver)
+}
+
+func approveAndApplyConfigChange(t *testing.T, svc *ConfigService, changeID, approver string) *rgsv1.ConfigChange {
+	t.Helper()
+	ctx := context.Background()
+	approved, _ := svc.ApproveConfigChange(ctx, &rgsv1.ApproveConfigChangeRequest{Meta: meta(approver, rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), ChangeId: changeID})
+	if approved.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("approve: %+v", approved.Meta)
+	}
+	applied, _ := svc.ApplyConfigChange(ctx, &rgsv1.ApplyConfigChangeRequest{Meta: meta(approver, rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), ChangeId: changeID})
+	if applied.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("apply: %+v", applied.Meta)
+	}
+	return applied.Change
+}
+
+// applyConfigValue proposes, approves, and applies value for key in
+// namespace so tests can configure the services that read settings.
+func applyConfigValue(t *testing.T, cfg *ConfigService, namespace, key, value string) {
+	t.Helper()
+	proposed, _ := cfg.ProposeConfigChange(context.Background(), &rgsv1.ProposeConfigChangeRequest{
+		Meta:            meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		ConfigNamespace: namespace,
+		ConfigKey:       key,
+		ProposedValue:   value,
+		Reason:          "test configuration",
+	})
+	if proposed.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("propose %s/%s: %+v", namespace, key, proposed.Meta)
+	}
+	approveAndApplyConfigChange(t, cfg, proposed.Change.ChangeId, "op-2")
+}
+
+// newConfigTestLedger returns a ledger reading balance caps and FX rates
+// from a fresh config service.
+func newConfigTestLedger(t *testing.T) (*LedgerService, *ConfigService) {
+	t.Helper()
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 20, 12, 0, 0, 0, time.UTC)}
+	cfg := NewConfigService(clk)
+	svc := NewLedgerService(clk)
+	svc.SetBalanceCapSource(cfg)
+	svc.SetFXRateSource(cfg)
+	return svc, cfg
+}
+
+func TestRollbackConfigChangeRestoresPreviousValue(t *testing.T) {
+	svc := NewConfigService(ledgerFixedClock{now: time.Date(2026, 2, 12, 17, 0, 0, 0, time.UTC)})
+	ctx := context.Background()
+	first := applyConfigChange(t, svc, "op-1", "op-2", "900")
+	svc.Clock = ledgerFixedClock{now: time.Date(2026, 2, 12, 18, 0, 0, 0, time.UTC)}
+	second := applyConfigChange(t, svc, "op-1", "op-2", "300")
+
+	rollback := func(actor, changeID string) *rgsv1.RollbackConfigChangeResponse {
+		resp, err := svc.RollbackConfigChange(ctx, &rgsv1.RollbackConfigChangeRequest{
+			Meta:     meta(actor, rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+			ChangeId: changeID,
+			Reason:   "timeout too aggressive",
+		})
+		if err != nil {
+			t.Fatalf("rollback err: %v", err)
+		}
+		return resp
+	}
+	if resp := rollback("op-3", first.ChangeId); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED || resp.Meta.GetDenialReason() != "change is no longer in effect" {
+		t.Fatalf("expected superseded change rollback to be denied: %+v", resp.Meta)
+	}
+	if resp := rollback("op-3", "cfg-missing"); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected unknown change to be invalid: %+v", resp.Meta)
+	}
+
+	resp := rollback("op-3", second.ChangeId)
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("rollback: %+v", resp.Meta)
+	}
+	rb := resp.Change
+	if rb.ProposedValue != "900" || rb.PreviousValue != "300" || rb.RollbackOfChangeId != second.ChangeId || rb.Status != rgsv1.ConfigChangeStatus_CONFIG_CHANGE_STATUS_PROPOSED {
+		t.Fatalf("unexpected rollback change: %+v", rb)
+	}
+
+	self, _ := svc.ApproveConfigChange(ctx, &rgsv1.ApproveConfigChangeRequest{Meta: meta("op-3", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), ChangeId: rb.ChangeId})
+	if self.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED || self.Meta.GetDenialReason() != "approver must differ from proposer" {
+		t.Fatalf("expected self-approval of rollback to be denied: %+v", self.Meta)
+	}
+	svc.Clock = ledgerFixedClock{now: time.Date(2026, 2, 12, 19, 0, 0, 0, time.UTC)}
+	approveAndApplyConfigChange(t, svc, rb.ChangeId, "op-4")
+	effective, err := svc.ValueAsOf(ctx, "security", "session_timeout", svc.now())
+	if err != nil || effective.GetProposedValue() != "900" || effective.GetChangeId() != rb.ChangeId {
+		t.Fatalf("expected rollback to restore 900: change=%+v err=%v", effective, err)
+	}
+
+	var audited bool
+	for _, ev := range auditEvents(svc.AuditStore) {
+		if ev.Action == "rollback_config_change" && ev.ObjectID == rb.ChangeId {
+			audited = true
+		}
+	}
+	if !audited {
+		t.Fatalf("expected rollback to be audited")
+	}
+}
+
+func TestRollbackConfigChangeWithoutPreviousValue(t *testing.T) {
+	svc := NewConfigService(ledgerFixedClock{now: time.Date(2026, 2, 12, 17, 0, 0, 0, time.UTC)})
+	created := applyConfigChange(t, svc, "op-1", "op-2", "900")
+	resp, _ := svc.RollbackConfigChange(context.Background(), &rgsv1.RollbackConfigChangeRequest{
+		Meta:     meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		ChangeId: created.ChangeId,
+	})
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID || resp.Meta.GetDenialReason() != "change has no previous value to restore" {
+		t.Fatalf("expected rollback of a new key to be invalid: %+v", resp.Meta)
+	}
+}
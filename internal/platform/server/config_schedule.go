@@ -0,0 +1,116 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+// dueScheduledChangesLocked returns approved changes whose scheduled apply
+// time has passed, earliest first. s.mu must be held.
+func (s *ConfigService) dueScheduledChangesLocked(ctx context.Context, now time.Time, limit int) ([]*rgsv1.ConfigChange, error) {
+	if s.db != nil {
+		return s.dueScheduledChangesFromDB(ctx, now, limit)
+	}
+	var due []*rgsv1.ConfigChange
+	for _, id := range s.changeOrder {
+		c := s.changes[id]
+		if c == nil || c.Status != rgsv1.ConfigChangeStatus_CONFIG_CHANGE_STATUS_APPROVED || c.ScheduledApplyAt == "" {
+			continue
+		}
+		if at := parseTS(c.ScheduledApplyAt); !at.After(now) {
+			due = append(due, c)
+		}
+	}
+	sort.SliceStable(due, func(i, j int) bool {
+		return parseTS(due[i].ScheduledApplyAt).Before(parseTS(due[j].ScheduledApplyAt))
+	})
+	if len(due) > limit {
+		due = due[:limit]
+	}
+	return due, nil
+}
+
+// ScheduledApplyJob applies approved changes once their scheduled_apply_at
+// has passed. Each is applied and audited as the system actor, with
+// applied_at recording when it actually took effect.
+func (s *ConfigService) ScheduledApplyJob(batchSize int) JobFunc {
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+	return func(ctx context.Context, _ string) (string, error) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		due, err := s.dueScheduledChangesLocked(ctx, s.now(), batchSize)
+		if err != nil {
+			return "", err
+		}
+		applied := 0
+		for _, change := range due {
+			if !configApprovalsMet(change) {
+				continue
+			}
+			if failure := s.applyChangeLocked(ctx, nil, change, "system", "scheduled apply"); failure != "" {
+				return "", fmt.Errorf("apply scheduled config change %s: %s", change.ChangeId, failure)
+			}
+			if s.db != nil && !s.disableInMemoryCache {
+				s.changes[change.ChangeId] = change
+			}
+			applied++
+		}
+		if applied == 0 {
+			return "", nil
+		}
+		return fmt.Sprintf("applied %d scheduled config changes", applied), nil
+	}
+}
+
+// CancelScheduledChange clears a change's scheduled application. The change
+// keeps its approvals.
+func (s *ConfigService) CancelScheduledChange(ctx context.Context, req *rgsv1.CancelScheduledChangeRequest) (*rgsv1.CancelScheduledChangeResponse, error) {
+	if req == nil || req.ChangeId == "" {
+		return &rgsv1.CancelScheduledChangeResponse{Meta: s.responseMeta(nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "change_id is required")}, nil
+	}
+	if ok, reason := s.authorize(ctx, req.Meta); !ok {
+		_ = s.appendAudit(req.Meta, "config_change", req.ChangeId, "cancel_scheduled_config_change", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		return &rgsv1.CancelScheduledChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	change := s.changes[req.ChangeId]
+	if change == nil && s.db != nil {
+		var err error
+		change, err = s.getConfigChange(ctx, req.ChangeId)
+		if err != nil {
+			return &rgsv1.CancelScheduledChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+		if change != nil && !s.disableInMemoryCache {
+			s.changes[req.ChangeId] = change
+		}
+	}
+	if change == nil {
+		return &rgsv1.CancelScheduledChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "change not found")}, nil
+	}
+	pending := change.Status == rgsv1.ConfigChangeStatus_CONFIG_CHANGE_STATUS_PROPOSED || change.Status == rgsv1.ConfigChangeStatus_CONFIG_CHANGE_STATUS_APPROVED
+	if !pending || change.ScheduledApplyAt == "" {
+		return &rgsv1.CancelScheduledChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, "change is not scheduled")}, nil
+	}
+
+	before, _ := json.Marshal(change)
+	change.ScheduledApplyAt = ""
+	change.ScheduledBy = ""
+	after, _ := json.Marshal(change)
+	if err := s.appendAudit(req.Meta, "config_change", change.ChangeId, "cancel_scheduled_config_change", before, after, audit.ResultSuccess, req.Reason); err != nil {
+		return &rgsv1.CancelScheduledChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	if err := s.persistConfigChange(ctx, change); err != nil {
+		return &rgsv1.CancelScheduledChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	return &rgsv1.CancelScheduledChangeResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Change: cloneChange(change)}, nil
+}
@@ -0,0 +1,103 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func TestScheduledConfigChangeAppliedByJob(t *testing.T) {
+	svc := NewConfigService(ledgerFixedClock{now: time.Date(2026, 2, 12, 17, 0, 0, 0, time.UTC)})
+	ctx := context.Background()
+	proposed, _ := svc.ProposeConfigChange(ctx, &rgsv1.ProposeConfigChangeRequest{
+		Meta:            meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		ConfigNamespace: "security",
+		ConfigKey:       "session_timeout",
+		ProposedValue:   "600",
+		Reason:          "tune timeout",
+	})
+	changeID := proposed.Change.ChangeId
+
+	past, _ := svc.ApproveConfigChange(ctx, &rgsv1.ApproveConfigChangeRequest{Meta: meta("op-2", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), ChangeId: changeID, ApplyAt: "2026-02-12T16:00:00Z"})
+	if past.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected past apply_at to be invalid: %+v", past.Meta)
+	}
+	approved, _ := svc.ApproveConfigChange(ctx, &rgsv1.ApproveConfigChangeRequest{Meta: meta("op-2", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), ChangeId: changeID, ApplyAt: "2026-02-13T02:00:00Z"})
+	if approved.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("approve: %+v", approved.Meta)
+	}
+	if approved.Change.ScheduledApplyAt != "2026-02-13T02:00:00Z" || approved.Change.ScheduledBy != "op-2" {
+		t.Fatalf("unexpected schedule: %+v", approved.Change)
+	}
+
+	manual, _ := svc.ApplyConfigChange(ctx, &rgsv1.ApplyConfigChangeRequest{Meta: meta("op-2", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), ChangeId: changeID})
+	if manual.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected manual apply of scheduled change to be denied: %+v", manual.Meta)
+	}
+
+	job := svc.ScheduledApplyJob(10)
+	if summary, err := job(ctx, "run-1"); err != nil || summary != "" {
+		t.Fatalf("expected nothing due before the window: summary=%q err=%v", summary, err)
+	}
+
+	svc.Clock = ledgerFixedClock{now: time.Date(2026, 2, 13, 2, 0, 30, 0, time.UTC)}
+	if summary, err := job(ctx, "run-2"); err != nil || summary != "applied 1 scheduled config changes" {
+		t.Fatalf("unexpected job result: summary=%q err=%v", summary, err)
+	}
+	history, _ := svc.ListConfigHistory(ctx, &rgsv1.ListConfigHistoryRequest{Meta: meta("op-3", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")})
+	applied := history.Changes[0]
+	if applied.Status != rgsv1.ConfigChangeStatus_CONFIG_CHANGE_STATUS_APPLIED || applied.AppliedBy != "system" || applied.AppliedAt != "2026-02-13T02:00:30Z" {
+		t.Fatalf("unexpected applied change: %+v", applied)
+	}
+	if summary, err := job(ctx, "run-3"); err != nil || summary != "" {
+		t.Fatalf("expected applied change not to be reapplied: summary=%q err=%v", summary, err)
+	}
+}
+
+func TestCancelScheduledConfigChange(t *testing.T) {
+	svc := NewConfigService(ledgerFixedClock{now: time.Date(2026, 2, 12, 17, 0, 0, 0, time.UTC)})
+	ctx := context.Background()
+	proposed, _ := svc.ProposeConfigChange(ctx, &rgsv1.ProposeConfigChangeRequest{
+		Meta:            meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		ConfigNamespace: "security",
+		ConfigKey:       "session_timeout",
+		ProposedValue:   "600",
+		Reason:          "tune timeout",
+	})
+	changeID := proposed.Change.ChangeId
+
+	cancel := func() *rgsv1.CancelScheduledChangeResponse {
+		resp, err := svc.CancelScheduledChange(ctx, &rgsv1.CancelScheduledChangeRequest{
+			Meta:     meta("op-3", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+			ChangeId: changeID,
+			Reason:   "window moved",
+		})
+		if err != nil {
+			t.Fatalf("cancel err: %v", err)
+		}
+		return resp
+	}
+	if resp := cancel(); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED || resp.Meta.GetDenialReason() != "change is not scheduled" {
+		t.Fatalf("expected unscheduled cancel to be denied: %+v", resp.Meta)
+	}
+
+	_, _ = svc.ApproveConfigChange(ctx, &rgsv1.ApproveConfigChangeRequest{Meta: meta("op-2", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), ChangeId: changeID, ApplyAt: "2026-02-13T02:00:00Z"})
+	resp := cancel()
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("cancel: %+v", resp.Meta)
+	}
+	if resp.Change.ScheduledApplyAt != "" || resp.Change.Status != rgsv1.ConfigChangeStatus_CONFIG_CHANGE_STATUS_APPROVED {
+		t.Fatalf("unexpected cancelled change: %+v", resp.Change)
+	}
+
+	svc.Clock = ledgerFixedClock{now: time.Date(2026, 2, 13, 3, 0, 0, 0, time.UTC)}
+	if summary, err := svc.ScheduledApplyJob(10)(ctx, "run-1"); err != nil || summary != "" {
+		t.Fatalf("expected cancelled change not to be applied: summary=%q err=%v", summary, err)
+	}
+	applied, _ := svc.ApplyConfigChange(ctx, &rgsv1.ApplyConfigChangeRequest{Meta: meta("op-2", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), ChangeId: changeID})
+	if applied.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected manual apply after cancel: %+v", applied.Meta)
+	}
+}
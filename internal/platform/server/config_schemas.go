@@ -0,0 +1,209 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	"google.golang.org/protobuf/proto"
+)
+
+var configDecimalPattern = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?$`)
+
+func cloneSchema(c *rgsv1.ConfigSchema) *rgsv1.ConfigSchema {
+	if c == nil {
+		return nil
+	}
+	cp, _ := proto.Clone(c).(*rgsv1.ConfigSchema)
+	return cp
+}
+
+// parseConfigNumber parses v as a value of t, which must be integer or
+// decimal.
+func parseConfigNumber(t rgsv1.ConfigValueType, v string) (*big.Rat, bool) {
+	switch t {
+	case rgsv1.ConfigValueType_CONFIG_VALUE_TYPE_INTEGER:
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, false
+		}
+		return new(big.Rat).SetInt64(n), true
+	case rgsv1.ConfigValueType_CONFIG_VALUE_TYPE_DECIMAL:
+		if !configDecimalPattern.MatchString(v) {
+			return nil, false
+		}
+		return new(big.Rat).SetString(v)
+	default:
+		return nil, false
+	}
+}
+
+// checkConfigValue returns why value violates schema, ignoring
+// allowed_values, or "" if it conforms.
+func checkConfigValue(schema *rgsv1.ConfigSchema, value string) string {
+	switch schema.ValueType {
+	case rgsv1.ConfigValueType_CONFIG_VALUE_TYPE_INTEGER, rgsv1.ConfigValueType_CONFIG_VALUE_TYPE_DECIMAL:
+		n, ok := parseConfigNumber(schema.ValueType, value)
+		if !ok {
+			if schema.ValueType == rgsv1.ConfigValueType_CONFIG_VALUE_TYPE_INTEGER {
+				return "value must be an integer"
+			}
+			return "value must be a decimal"
+		}
+		if lo, ok := parseConfigNumber(schema.ValueType, schema.MinValue); ok && n.Cmp(lo) < 0 {
+			return "value must be at least " + schema.MinValue
+		}
+		if hi, ok := parseConfigNumber(schema.ValueType, schema.MaxValue); ok && n.Cmp(hi) > 0 {
+			return "value must be at most " + schema.MaxValue
+		}
+	case rgsv1.ConfigValueType_CONFIG_VALUE_TYPE_BOOLEAN:
+		if value != "true" && value != "false" {
+			return "value must be true or false"
+		}
+	}
+	if schema.Pattern != "" {
+		re, err := regexp.Compile(`^(?:` + schema.Pattern + `)$`)
+		if err != nil || !re.MatchString(value) {
+			return "value must match " + schema.Pattern
+		}
+	}
+	return ""
+}
+
+// validateConfigValue returns why value is not accepted by schema, or "".
+func validateConfigValue(schema *rgsv1.ConfigSchema, value string) string {
+	if reason := checkConfigValue(schema, value); reason != "" {
+		return reason
+	}
+	if len(schema.AllowedValues) > 0 && !slices.Contains(schema.AllowedValues, value) {
+		return "value must be one of " + strings.Join(schema.AllowedValues, ", ")
+	}
+	return ""
+}
+
+// invalidConfigSchema returns why schema cannot be registered, or "".
+func invalidConfigSchema(schema *rgsv1.ConfigSchema) string {
+	switch schema.ValueType {
+	case rgsv1.ConfigValueType_CONFIG_VALUE_TYPE_STRING, rgsv1.ConfigValueType_CONFIG_VALUE_TYPE_BOOLEAN:
+		if schema.MinValue != "" || schema.MaxValue != "" {
+			return "min_value and max_value apply only to integer and decimal values"
+		}
+	case rgsv1.ConfigValueType_CONFIG_VALUE_TYPE_INTEGER, rgsv1.ConfigValueType_CONFIG_VALUE_TYPE_DECIMAL:
+		lo, loOK := parseConfigNumber(schema.ValueType, schema.MinValue)
+		hi, hiOK := parseConfigNumber(schema.ValueType, schema.MaxValue)
+		if (schema.MinValue != "" && !loOK) || (schema.MaxValue != "" && !hiOK) {
+			return "min_value and max_value must be of the schema's value_type"
+		}
+		if loOK && hiOK && lo.Cmp(hi) > 0 {
+			return "min_value must not exceed max_value"
+		}
+	default:
+		return "value_type is required"
+	}
+	if schema.Pattern != "" {
+		if _, err := regexp.Compile(schema.Pattern); err != nil {
+			return "pattern is not a valid regular expression"
+		}
+	}
+	for _, v := range schema.AllowedValues {
+		if checkConfigValue(schema, v) != "" {
+			return "allowed value " + strconv.Quote(v) + " does not satisfy the schema"
+		}
+	}
+	return ""
+}
+
+// configSchemaLocked returns the schema registered for namespace and key,
+// or nil. s.mu must be held.
+func (s *ConfigService) configSchemaLocked(ctx context.Context, namespace, key string) (*rgsv1.ConfigSchema, error) {
+	if s.db != nil {
+		return s.getConfigSchema(ctx, namespace, key)
+	}
+	return s.schemas[keyFor(namespace, key)], nil
+}
+
+// SetConfigSchema registers the validation rules for a config key,
+// replacing any earlier schema for it.
+func (s *ConfigService) SetConfigSchema(ctx context.Context, req *rgsv1.SetConfigSchemaRequest) (*rgsv1.SetConfigSchemaResponse, error) {
+	if req == nil || req.Schema == nil || req.Schema.ConfigNamespace == "" || req.Schema.ConfigKey == "" {
+		return &rgsv1.SetConfigSchemaResponse{Meta: s.responseMeta(nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "schema config_namespace and config_key are required")}, nil
+	}
+	objectID := keyFor(req.Schema.ConfigNamespace, req.Schema.ConfigKey)
+	if ok, reason := s.authorize(ctx, req.Meta); !ok {
+		_ = s.appendAudit(req.Meta, "config_schema", objectID, "set_config_schema", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		return &rgsv1.SetConfigSchemaResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	if reason := invalidConfigSchema(req.Schema); reason != "" {
+		return &rgsv1.SetConfigSchemaResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, reason)}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	prev, err := s.configSchemaLocked(ctx, req.Schema.ConfigNamespace, req.Schema.ConfigKey)
+	if err != nil {
+		return &rgsv1.SetConfigSchemaResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	schema := cloneSchema(req.Schema)
+	schema.UpdatedBy = req.Meta.Actor.ActorId
+	schema.UpdatedAt = s.now().Format(time.RFC3339Nano)
+
+	before := []byte(`{}`)
+	if prev != nil {
+		before, _ = json.Marshal(prev)
+	}
+	after, _ := json.Marshal(schema)
+	if err := s.appendAudit(req.Meta, "config_schema", objectID, "set_config_schema", before, after, audit.ResultSuccess, req.Reason); err != nil {
+		return &rgsv1.SetConfigSchemaResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	if err := s.persistConfigSchema(ctx, schema); err != nil {
+		return &rgsv1.SetConfigSchemaResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if s.db == nil {
+		s.schemas[objectID] = schema
+	}
+	return &rgsv1.SetConfigSchemaResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Schema: cloneSchema(schema)}, nil
+}
+
+func (s *ConfigService) ListConfigSchemas(ctx context.Context, req *rgsv1.ListConfigSchemasRequest) (*rgsv1.ListConfigSchemasResponse, error) {
+	if req == nil {
+		req = &rgsv1.ListConfigSchemasRequest{}
+	}
+	if ok, reason := s.authorize(ctx, req.Meta); !ok {
+		_ = s.appendAudit(req.Meta, "config_schema", "", "list_config_schemas", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		return &rgsv1.ListConfigSchemasResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	if s.db != nil {
+		schemas, err := s.listConfigSchemasFromDB(ctx, req.ConfigNamespaceFilter)
+		if err != nil {
+			return &rgsv1.ListConfigSchemasResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+		return &rgsv1.ListConfigSchemasResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Schemas: schemas}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	schemas := make([]*rgsv1.ConfigSchema, 0, len(s.schemas))
+	for _, schema := range s.schemas {
+		if req.ConfigNamespaceFilter != "" && schema.ConfigNamespace != req.ConfigNamespaceFilter {
+			continue
+		}
+		schemas = append(schemas, cloneSchema(schema))
+	}
+	sort.Slice(schemas, func(i, j int) bool {
+		if schemas[i].ConfigNamespace != schemas[j].ConfigNamespace {
+			return schemas[i].ConfigNamespace < schemas[j].ConfigNamespace
+		}
+		return schemas[i].ConfigKey < schemas[j].ConfigKey
+	})
+	return &rgsv1.ListConfigSchemasResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Schemas: schemas}, nil
+}
@@ -0,0 +1,101 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func TestConfigSchemaRejectsInvalidProposals(t *testing.T) {
+	svc := NewConfigService(ledgerFixedClock{now: time.Date(2026, 2, 12, 17, 0, 0, 0, time.UTC)})
+	ctx := context.Background()
+	setSchema := func(schema *rgsv1.ConfigSchema) *rgsv1.SetConfigSchemaResponse {
+		resp, err := svc.SetConfigSchema(ctx, &rgsv1.SetConfigSchemaRequest{
+			Meta:   meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+			Schema: schema,
+			Reason: "bound session timeout",
+		})
+		if err != nil {
+			t.Fatalf("set schema err: %v", err)
+		}
+		return resp
+	}
+	propose := func(key, value string) *rgsv1.ProposeConfigChangeResponse {
+		resp, err := svc.ProposeConfigChange(ctx, &rgsv1.ProposeConfigChangeRequest{
+			Meta:            meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+			ConfigNamespace: "security",
+			ConfigKey:       key,
+			ProposedValue:   value,
+			Reason:          "tune",
+		})
+		if err != nil {
+			t.Fatalf("propose err: %v", err)
+		}
+		return resp
+	}
+
+	if resp := setSchema(&rgsv1.ConfigSchema{ConfigNamespace: "security", ConfigKey: "session_timeout", ValueType: rgsv1.ConfigValueType_CONFIG_VALUE_TYPE_INTEGER, MinValue: "600", MaxValue: "60"}); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected inverted range to be invalid: %+v", resp.Meta)
+	}
+	resp := setSchema(&rgsv1.ConfigSchema{ConfigNamespace: "security", ConfigKey: "session_timeout", ValueType: rgsv1.ConfigValueType_CONFIG_VALUE_TYPE_INTEGER, MinValue: "60", MaxValue: "3600"})
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || resp.Schema.UpdatedBy != "op-1" {
+		t.Fatalf("set schema: %+v", resp)
+	}
+	if resp := setSchema(&rgsv1.ConfigSchema{ConfigNamespace: "security", ConfigKey: "mfa_mode", ValueType: rgsv1.ConfigValueType_CONFIG_VALUE_TYPE_STRING, AllowedValues: []string{"totp", "webauthn"}}); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("set enum schema: %+v", resp.Meta)
+	}
+
+	cases := []struct {
+		key, value string
+		reason     string
+	}{
+		{"session_timeout", "fifteen minutes", "proposed_value does not match config schema: value must be an integer"},
+		{"session_timeout", "30", "proposed_value does not match config schema: value must be at least 60"},
+		{"session_timeout", "7200", "proposed_value does not match config schema: value must be at most 3600"},
+		{"mfa_mode", "sms", "proposed_value does not match config schema: value must be one of totp, webauthn"},
+	}
+	for _, tc := range cases {
+		resp := propose(tc.key, tc.value)
+		if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID || resp.Meta.GetDenialReason() != tc.reason {
+			t.Fatalf("%s=%q: expected %q, got %+v", tc.key, tc.value, tc.reason, resp.Meta)
+		}
+	}
+	if resp := propose("session_timeout", "900"); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected valid timeout to be proposed: %+v", resp.Meta)
+	}
+	if resp := propose("lockout_message", "anything goes"); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected key without schema to be proposed: %+v", resp.Meta)
+	}
+
+	list, _ := svc.ListConfigSchemas(ctx, &rgsv1.ListConfigSchemasRequest{Meta: meta("op-2", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), ConfigNamespaceFilter: "security"})
+	if len(list.Schemas) != 2 || list.Schemas[0].ConfigKey != "mfa_mode" || list.Schemas[1].ConfigKey != "session_timeout" {
+		t.Fatalf("unexpected schemas: %+v", list.Schemas)
+	}
+}
+
+func TestValidateConfigValue(t *testing.T) {
+	decimal := &rgsv1.ConfigSchema{ValueType: rgsv1.ConfigValueType_CONFIG_VALUE_TYPE_DECIMAL, MinValue: "0.5", MaxValue: "2"}
+	flag := &rgsv1.ConfigSchema{ValueType: rgsv1.ConfigValueType_CONFIG_VALUE_TYPE_BOOLEAN}
+	code := &rgsv1.ConfigSchema{ValueType: rgsv1.ConfigValueType_CONFIG_VALUE_TYPE_STRING, Pattern: `[A-Z]{3}`}
+	cases := []struct {
+		schema *rgsv1.ConfigSchema
+		value  string
+		ok     bool
+	}{
+		{decimal, "1.25", true},
+		{decimal, "2.00", true},
+		{decimal, "2.01", false},
+		{decimal, "1e0", false},
+		{flag, "true", true},
+		{flag, "yes", false},
+		{code, "USD", true},
+		{code, "USDT", false},
+	}
+	for _, tc := range cases {
+		if got := validateConfigValue(tc.schema, tc.value) == ""; got != tc.ok {
+			t.Fatalf("validate %q against %+v: got ok=%v", tc.value, tc.schema, got)
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+// configWatchBuffer bounds the changes queued for one watcher. A watcher that
+// falls further behind is disconnected rather than stalling config applies.
+const configWatchBuffer = 64
+
+const (
+	configPollDefaultWait = 30 * time.Second
+	configPollMaxWait     = 60 * time.Second
+	configPollBatchSize   = 100
+)
+
+type configWatchSubscription struct {
+	namespace string
+	changes   chan *rgsv1.ConfigChange
+	reason    string
+}
+
+// configWatchHub fans applied changes out to the watchers of their
+// namespace. It only sees changes applied by this process.
+type configWatchHub struct {
+	mu   sync.Mutex
+	subs map[*configWatchSubscription]struct{}
+}
+
+func newConfigWatchHub() *configWatchHub {
+	return &configWatchHub{subs: make(map[*configWatchSubscription]struct{})}
+}
+
+func (h *configWatchHub) subscribe(namespace string) *configWatchSubscription {
+	sub := &configWatchSubscription{namespace: namespace, changes: make(chan *rgsv1.ConfigChange, configWatchBuffer)}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.subs[sub] = struct{}{}
+	return sub
+}
+
+func (h *configWatchHub) unsubscribe(sub *configWatchSubscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.subs[sub]; ok {
+		delete(h.subs, sub)
+		close(sub.changes)
+	}
+}
+
+func (h *configWatchHub) publish(change *rgsv1.ConfigChange) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for sub := range h.subs {
+		if sub.namespace != change.ConfigNamespace {
+			continue
+		}
+		select {
+		case sub.changes <- cloneChange(change):
+		default:
+			sub.reason = "watch fell behind"
+			close(sub.changes)
+			delete(h.subs, sub)
+		}
+	}
+}
+
+func (h *configWatchHub) closeReason(sub *configWatchSubscription) string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return sub.reason
+}
+
+// appliedChangesSince returns up to limit changes in namespace applied after
+// since, oldest first.
+func (s *ConfigService) appliedChangesSince(ctx context.Context, namespace string, since time.Time, limit int) ([]*rgsv1.ConfigChange, error) {
+	if s.db != nil {
+		return s.appliedChangesSinceFromDB(ctx, namespace, since, limit)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var out []*rgsv1.ConfigChange
+	for _, id := range s.changeOrder {
+		c := s.changes[id]
+		if c == nil || c.ConfigNamespace != namespace || c.Status != rgsv1.ConfigChangeStatus_CONFIG_CHANGE_STATUS_APPLIED {
+			continue
+		}
+		if parseTS(c.AppliedAt).After(since) {
+			out = append(out, cloneChange(c))
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return parseTS(out[i].AppliedAt).Before(parseTS(out[j].AppliedAt))
+	})
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+// PollConfigChanges returns the changes applied in a namespace after since,
+// waiting up to wait_seconds for one when there are none yet.
+func (s *ConfigService) PollConfigChanges(ctx context.Context, req *rgsv1.PollConfigChangesRequest) (*rgsv1.PollConfigChangesResponse, error) {
+	if req == nil || req.ConfigNamespace == "" {
+		return &rgsv1.PollConfigChangesResponse{Meta: s.responseMeta(nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "config_namespace is required")}, nil
+	}
+	if ok, reason := s.authorize(ctx, req.Meta); !ok {
+		_ = s.appendAudit(req.Meta, "config_change", "", "poll_config_changes", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		return &rgsv1.PollConfigChangesResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	since := s.now()
+	if req.Since != "" {
+		t, err := time.Parse(time.RFC3339Nano, req.Since)
+		if err != nil {
+			return &rgsv1.PollConfigChangesResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "since must be an RFC3339 timestamp")}, nil
+		}
+		since = t.UTC()
+	}
+	wait := configPollDefaultWait
+	if req.WaitSeconds > 0 {
+		wait = min(time.Duration(req.WaitSeconds)*time.Second, configPollMaxWait)
+	}
+
+	// Subscribe before the first read so a change applied in between still
+	// wakes the poll.
+	sub := s.watchers.subscribe(req.ConfigNamespace)
+	defer s.watchers.unsubscribe(sub)
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	for done := false; ; {
+		changes, err := s.appliedChangesSince(ctx, req.ConfigNamespace, since, configPollBatchSize)
+		if err != nil {
+			return &rgsv1.PollConfigChangesResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+		if len(changes) > 0 {
+			return &rgsv1.PollConfigChangesResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Changes: changes, Watermark: changes[len(changes)-1].AppliedAt}, nil
+		}
+		if done {
+			return &rgsv1.PollConfigChangesResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Watermark: since.Format(time.RFC3339Nano)}, nil
+		}
+		// Only local applies wake the poll, so read once more when the
+		// wait ends to pick up changes applied by other instances.
+		select {
+		case <-ctx.Done():
+			done = true
+		case <-timer.C:
+			done = true
+		case _, ok := <-sub.changes:
+			done = !ok
+		}
+	}
+}
+
+// WatchConfig pushes every change applied in the namespace for as long as
+// the stream is open. The first message acknowledges the subscription; a
+// watcher that falls behind is ended with an ERROR message and should catch
+// up with PollConfigChanges or ListConfigHistory.
+func (s *ConfigService) WatchConfig(req *rgsv1.WatchConfigRequest, stream rgsv1.ConfigService_WatchConfigServer) error {
+	ctx := stream.Context()
+	if req == nil || req.ConfigNamespace == "" {
+		return stream.Send(&rgsv1.WatchConfigResponse{Meta: s.responseMeta(req.GetMeta(), rgsv1.ResultCode_RESULT_CODE_INVALID, "config_namespace is required")})
+	}
+	if ok, reason := s.authorize(ctx, req.Meta); !ok {
+		_ = s.appendAudit(req.Meta, "config_change", "", "watch_config", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		return stream.Send(&rgsv1.WatchConfigResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)})
+	}
+
+	sub := s.watchers.subscribe(req.ConfigNamespace)
+	defer s.watchers.unsubscribe(sub)
+	after, _ := json.Marshal(map[string]string{"config_namespace": req.ConfigNamespace})
+	s.mu.Lock()
+	err := s.appendAudit(req.Meta, "config_change", "", "watch_config", []byte(`{}`), after, audit.ResultSuccess, "")
+	s.mu.Unlock()
+	if err != nil {
+		return stream.Send(&rgsv1.WatchConfigResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")})
+	}
+	if err := stream.Send(&rgsv1.WatchConfigResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, "")}); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case change, ok := <-sub.changes:
+			if !ok {
+				return stream.Send(&rgsv1.WatchConfigResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, s.watchers.closeReason(sub))})
+			}
+			if err := stream.Send(&rgsv1.WatchConfigResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Change: change}); err != nil {
+				return err
+			}
+		}
+	}
+}
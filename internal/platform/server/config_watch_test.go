@@ -0,0 +1,103 @@
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func newConfigWatchClient(t *testing.T, svc *ConfigService) rgsv1.ConfigServiceClient {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	rgsv1.RegisterConfigServiceServer(srv, svc)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("dial bufconn: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return rgsv1.NewConfigServiceClient(conn)
+}
+
+func TestWatchConfigPushesAppliedChangesInNamespace(t *testing.T) {
+	svc := NewConfigService(ledgerFixedClock{now: time.Date(2026, 2, 12, 17, 0, 0, 0, time.UTC)})
+	client := newConfigWatchClient(t, svc)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if stream, err := client.WatchConfig(ctx, &rgsv1.WatchConfigRequest{Meta: meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""), ConfigNamespace: "security"}); err != nil {
+		t.Fatalf("open stream: %v", err)
+	} else if resp, err := stream.Recv(); err != nil || resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected player watch to be denied: resp=%+v err=%v", resp, err)
+	}
+
+	stream, err := client.WatchConfig(ctx, &rgsv1.WatchConfigRequest{Meta: meta("game-server-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, ""), ConfigNamespace: "security"})
+	if err != nil {
+		t.Fatalf("open stream: %v", err)
+	}
+	ack, err := stream.Recv()
+	if err != nil || ack.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || ack.Change != nil {
+		t.Fatalf("expected subscription ack: resp=%+v err=%v", ack, err)
+	}
+
+	applyConfigValue(t, svc, WageringConfigNamespace, "max_stake/USD", "100.00")
+	applied := applyConfigChange(t, svc, "op-1", "op-2", "900")
+	resp, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("receive change: %v", err)
+	}
+	if resp.Change.GetChangeId() != applied.ChangeId || resp.Change.ProposedValue != "900" {
+		t.Fatalf("expected only the security change, got=%+v", resp.Change)
+	}
+}
+
+func TestPollConfigChangesWaitsForNextChange(t *testing.T) {
+	svc := NewConfigService(ledgerFixedClock{now: time.Date(2026, 2, 12, 17, 0, 0, 0, time.UTC)})
+	ctx := context.Background()
+	poll := func(since string, wait int32) *rgsv1.PollConfigChangesResponse {
+		resp, err := svc.PollConfigChanges(ctx, &rgsv1.PollConfigChangesRequest{
+			Meta:            meta("game-server-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, ""),
+			ConfigNamespace: "security",
+			Since:           since,
+			WaitSeconds:     wait,
+		})
+		if err != nil || resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("poll: resp=%+v err=%v", resp, err)
+		}
+		return resp
+	}
+
+	first := applyConfigChange(t, svc, "op-1", "op-2", "900")
+	resp := poll("2026-02-12T16:00:00Z", 1)
+	if len(resp.Changes) != 1 || resp.Changes[0].ChangeId != first.ChangeId || resp.Watermark != first.AppliedAt {
+		t.Fatalf("expected change applied after since: %+v", resp)
+	}
+	if resp := poll(resp.Watermark, 1); len(resp.Changes) != 0 || resp.Watermark != first.AppliedAt {
+		t.Fatalf("expected timed-out poll to keep the watermark: %+v", resp)
+	}
+
+	svc.Clock = ledgerFixedClock{now: time.Date(2026, 2, 12, 18, 0, 0, 0, time.UTC)}
+	done := make(chan *rgsv1.PollConfigChangesResponse, 1)
+	go func() { done <- poll(first.AppliedAt, 30) }()
+	time.Sleep(50 * time.Millisecond)
+	second := applyConfigChange(t, svc, "op-1", "op-2", "600")
+	select {
+	case resp := <-done:
+		if len(resp.Changes) != 1 || resp.Changes[0].ChangeId != second.ChangeId {
+			t.Fatalf("expected waiting poll to return the new change: %+v", resp)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("poll did not wake on apply")
+	}
+}
@@ -0,0 +1,260 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"net/smtp"
+	"net/url"
+	"strings"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	"google.golang.org/protobuf/proto"
+)
+
+// alertActor is recorded as the actor of every alert delivery.
+var alertActor = &rgsv1.Actor{ActorId: "system", ActorType: rgsv1.ActorType_ACTOR_TYPE_SERVICE}
+
+// EventAlert is what a rule sends to its channels when it matches.
+type EventAlert struct {
+	RuleID   string                  `json:"rule_id"`
+	RuleName string                  `json:"rule_name"`
+	Event    *rgsv1.SignificantEvent `json:"event"`
+}
+
+func (a EventAlert) summary() string {
+	return fmt.Sprintf("open-rgs alert %s: %s on %s", a.RuleName, a.Event.GetEventCode(), a.Event.GetEquipmentId())
+}
+
+// EventAlertSender delivers an alert to one channel.
+type EventAlertSender interface {
+	SendEventAlert(ctx context.Context, channel *rgsv1.AlertChannel, alert EventAlert) error
+}
+
+// EventAlertDispatcher posts webhook alerts as JSON and mails email alerts
+// through the SMTP server at SMTPAddr (host:port). Without SMTPAddr, email
+// deliveries fail.
+type EventAlertDispatcher struct {
+	Client   *http.Client
+	SMTPAddr string
+	SMTPFrom string
+	SMTPAuth smtp.Auth
+
+	sendMail func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+func (d EventAlertDispatcher) SendEventAlert(ctx context.Context, channel *rgsv1.AlertChannel, alert EventAlert) error {
+	switch channel.GetType() {
+	case rgsv1.AlertChannelType_ALERT_CHANNEL_TYPE_WEBHOOK:
+		body, err := json.Marshal(alert)
+		if err != nil {
+			return err
+		}
+		return postNotificationJSON(ctx, d.Client, channel.Target, body)
+	case rgsv1.AlertChannelType_ALERT_CHANNEL_TYPE_EMAIL:
+		if d.SMTPAddr == "" || d.SMTPFrom == "" {
+			return errors.New("email alerts are not configured")
+		}
+		send := d.sendMail
+		if send == nil {
+			send = smtp.SendMail
+		}
+		e := alert.Event
+		var msg strings.Builder
+		fmt.Fprintf(&msg, "From: %s\r\n", d.SMTPFrom)
+		fmt.Fprintf(&msg, "To: %s\r\n", channel.Target)
+		fmt.Fprintf(&msg, "Subject: %s\r\n", alert.summary())
+		msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+		fmt.Fprintf(&msg, "Rule: %s (%s)\r\nEvent: %s\r\nEquipment: %s\r\nCode: %s\r\nSeverity: %s\r\nDescription: %s\r\nOccurred at: %s\r\n",
+			alert.RuleName, alert.RuleID, e.GetEventId(), e.GetEquipmentId(), e.GetEventCode(), e.GetSeverity(), e.GetLocalizedDescription(), e.GetOccurredAt())
+		return send(d.SMTPAddr, d.SMTPAuth, d.SMTPFrom, []string{channel.Target}, []byte(msg.String()))
+	default:
+		return fmt.Errorf("unsupported alert channel type %s", channel.GetType())
+	}
+}
+
+// SetAlertSender replaces the sender used for alert deliveries, each of
+// which gets timeout (10s when zero).
+func (s *EventsService) SetAlertSender(sender EventAlertSender, timeout time.Duration) {
+	if s == nil {
+		return
+	}
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.alertSender = sender
+	s.alertTimeout = timeout
+}
+
+func alertRuleMatches(rule *rgsv1.AlertRule, e *rgsv1.SignificantEvent) bool {
+	if rule.EventCode != "" && rule.EventCode != e.EventCode {
+		return false
+	}
+	if rule.MinSeverity != rgsv1.EventSeverity_EVENT_SEVERITY_UNSPECIFIED && e.Severity < rule.MinSeverity {
+		return false
+	}
+	return true
+}
+
+func invalidAlertRule(rule *rgsv1.AlertRule) string {
+	if strings.TrimSpace(rule.Name) == "" {
+		return "name is required"
+	}
+	if rule.EventCode == "" && rule.MinSeverity == rgsv1.EventSeverity_EVENT_SEVERITY_UNSPECIFIED {
+		return "event_code or min_severity is required"
+	}
+	if _, ok := rgsv1.EventSeverity_name[int32(rule.MinSeverity)]; !ok {
+		return "min_severity is invalid"
+	}
+	if len(rule.Channels) == 0 {
+		return "at least one channel is required"
+	}
+	for _, ch := range rule.Channels {
+		switch ch.GetType() {
+		case rgsv1.AlertChannelType_ALERT_CHANNEL_TYPE_WEBHOOK:
+			u, err := url.Parse(ch.Target)
+			if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+				return "webhook channel target must be an http or https URL"
+			}
+		case rgsv1.AlertChannelType_ALERT_CHANNEL_TYPE_EMAIL:
+			if addr, err := mail.ParseAddress(ch.Target); err != nil || addr.Address != ch.Target {
+				return "email channel target must be an email address"
+			}
+		default:
+			return "channel type must be webhook or email"
+		}
+	}
+	return ""
+}
+
+func (s *EventsService) alertRulesLocked(ctx context.Context) ([]*rgsv1.AlertRule, error) {
+	if s.db != nil {
+		return s.listAlertRulesFromDB(ctx)
+	}
+	out := make([]*rgsv1.AlertRule, 0, len(s.alertOrder))
+	for _, id := range s.alertOrder {
+		out = append(out, cloneAlertRule(s.alertRules[id]))
+	}
+	return out, nil
+}
+
+func cloneAlertRule(in *rgsv1.AlertRule) *rgsv1.AlertRule {
+	if in == nil {
+		return nil
+	}
+	cp, _ := proto.Clone(in).(*rgsv1.AlertRule)
+	return cp
+}
+
+// dispatchAlertsLocked sends e to the channels of every rule it matches.
+// Deliveries run in the background and each one is audited as
+// deliver_event_alert on its rule. While e's equipment is under a
+// maintenance window, matching rules are audited as suppress_event_alert
+// instead. s.mu must be held.
+func (s *EventsService) dispatchAlertsLocked(ctx context.Context, meta *rgsv1.RequestMeta, e *rgsv1.SignificantEvent) {
+	alertMeta := &rgsv1.RequestMeta{RequestId: requestID(meta), Actor: alertActor}
+	rules, err := s.alertRulesLocked(ctx)
+	if err != nil {
+		_ = s.appendAudit(alertMeta, "significant_event", e.EventId, "evaluate_event_alerts", []byte(`{}`), []byte(`{}`), audit.ResultError, "alert rules unavailable")
+		return
+	}
+	sender, timeout := s.alertSender, s.alertTimeout
+	var window *rgsv1.MaintenanceWindow
+	for _, rule := range rules {
+		if !alertRuleMatches(rule, e) {
+			continue
+		}
+		if window == nil {
+			if window, err = s.suppressAlertsLocked(ctx, e); err != nil {
+				_ = s.appendAudit(alertMeta, "significant_event", e.EventId, "evaluate_event_alerts", []byte(`{}`), []byte(`{}`), audit.ResultError, "maintenance windows unavailable")
+				return
+			}
+		}
+		if window != nil {
+			after, _ := json.Marshal(map[string]string{"event_id": e.EventId, "window_id": window.WindowId})
+			_ = s.appendAudit(alertMeta, "alert_rule", rule.RuleId, "suppress_event_alert", []byte(`{}`), after, audit.ResultSuccess, "equipment under maintenance")
+			continue
+		}
+		alert := EventAlert{RuleID: rule.RuleId, RuleName: rule.Name, Event: cloneEvent(e)}
+		for _, ch := range rule.Channels {
+			s.alerts.Add(1)
+			go func() {
+				defer s.alerts.Done()
+				dctx, cancel := context.WithTimeout(context.Background(), timeout)
+				defer cancel()
+				result, reason := audit.ResultSuccess, ""
+				if err := sender.SendEventAlert(dctx, ch, alert); err != nil {
+					result, reason = audit.ResultError, err.Error()
+				}
+				after, _ := json.Marshal(map[string]string{"event_id": alert.Event.EventId, "channel_type": ch.Type.String(), "target": ch.Target})
+				s.mu.Lock()
+				_ = s.appendAudit(alertMeta, "alert_rule", alert.RuleID, "deliver_event_alert", []byte(`{}`), after, result, reason)
+				s.mu.Unlock()
+			}()
+		}
+	}
+}
+
+func (s *EventsService) CreateAlertRule(ctx context.Context, req *rgsv1.CreateAlertRuleRequest) (*rgsv1.CreateAlertRuleResponse, error) {
+	if req == nil || req.Rule == nil {
+		return &rgsv1.CreateAlertRuleResponse{Meta: s.responseMeta(nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "rule is required")}, nil
+	}
+	if ok, reason := s.authorizeWrite(ctx, req.Meta); !ok {
+		s.submitBlocked(req.Meta, "alert_rule", "", "create_alert_rule", reason)
+		return &rgsv1.CreateAlertRuleResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	if reason := invalidAlertRule(req.Rule); reason != "" {
+		return &rgsv1.CreateAlertRuleResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, reason)}, nil
+	}
+	raw := make([]byte, 8)
+	if _, err := rand.Read(raw); err != nil {
+		return &rgsv1.CreateAlertRuleResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "rule id unavailable")}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rule := cloneAlertRule(req.Rule)
+	rule.RuleId = "alert-" + hex.EncodeToString(raw)
+	rule.Name = strings.TrimSpace(rule.Name)
+	rule.CreatedBy = req.Meta.GetActor().GetActorId()
+	rule.CreatedAt = s.now().Format(time.RFC3339Nano)
+	after, _ := json.Marshal(rule)
+	if err := s.appendAudit(req.Meta, "alert_rule", rule.RuleId, "create_alert_rule", []byte(`{}`), after, audit.ResultSuccess, ""); err != nil {
+		return &rgsv1.CreateAlertRuleResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	if err := s.persistAlertRule(ctx, rule); err != nil {
+		return &rgsv1.CreateAlertRuleResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if !s.disableInMemoryCache {
+		s.alertRules[rule.RuleId] = rule
+		s.alertOrder = append(s.alertOrder, rule.RuleId)
+	}
+	return &rgsv1.CreateAlertRuleResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Rule: cloneAlertRule(rule)}, nil
+}
+
+func (s *EventsService) ListAlertRules(ctx context.Context, req *rgsv1.ListAlertRulesRequest) (*rgsv1.ListAlertRulesResponse, error) {
+	if req == nil {
+		req = &rgsv1.ListAlertRulesRequest{}
+	}
+	if ok, reason := s.authorizeRead(ctx, req.Meta); !ok {
+		s.submitBlocked(req.Meta, "alert_rule", "", "list_alert_rules", reason)
+		return &rgsv1.ListAlertRulesResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rules, err := s.alertRulesLocked(ctx)
+	if err != nil {
+		return &rgsv1.ListAlertRulesResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	return &rgsv1.ListAlertRulesResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Rules: rules}, nil
+}
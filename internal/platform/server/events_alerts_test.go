@@ -0,0 +1,157 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/smtp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+type recordingAlertSender struct {
+	mu   sync.Mutex
+	sent []string
+}
+
+func (r *recordingAlertSender) SendEventAlert(_ context.Context, ch *rgsv1.AlertChannel, alert EventAlert) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sent = append(r.sent, alert.RuleName+"|"+ch.Target+"|"+alert.Event.EventId)
+	return nil
+}
+
+func TestAlertRulesMatchNewEventsOnce(t *testing.T) {
+	svc := NewEventsService(ledgerFixedClock{now: time.Date(2026, 2, 13, 9, 0, 0, 0, time.UTC)})
+	sender := &recordingAlertSender{}
+	svc.SetAlertSender(sender, time.Second)
+	ctx := context.Background()
+	op := &rgsv1.RequestMeta{Actor: &rgsv1.Actor{ActorId: "op-1", ActorType: rgsv1.ActorType_ACTOR_TYPE_OPERATOR}}
+
+	create := func(rule *rgsv1.AlertRule) *rgsv1.CreateAlertRuleResponse {
+		resp, err := svc.CreateAlertRule(ctx, &rgsv1.CreateAlertRuleRequest{Meta: op, Rule: rule})
+		if err != nil {
+			t.Fatalf("create alert rule: %v", err)
+		}
+		return resp
+	}
+	hook := &rgsv1.AlertChannel{Type: rgsv1.AlertChannelType_ALERT_CHANNEL_TYPE_WEBHOOK, Target: "https://alerts.example/hook"}
+	for _, bad := range []*rgsv1.AlertRule{
+		{Name: "no match", Channels: []*rgsv1.AlertChannel{hook}},
+		{Name: "no channel", EventCode: "DOOR_OPEN"},
+		{Name: "bad url", EventCode: "DOOR_OPEN", Channels: []*rgsv1.AlertChannel{{Type: rgsv1.AlertChannelType_ALERT_CHANNEL_TYPE_WEBHOOK, Target: "alerts.example"}}},
+		{Name: "bad email", EventCode: "DOOR_OPEN", Channels: []*rgsv1.AlertChannel{{Type: rgsv1.AlertChannelType_ALERT_CHANNEL_TYPE_EMAIL, Target: "Ops <ops@example.com>"}}},
+	} {
+		if resp := create(bad); resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_INVALID {
+			t.Fatalf("expected rule %q to be invalid, got %v", bad.Name, resp.Meta.ResultCode)
+		}
+	}
+	player := &rgsv1.RequestMeta{Actor: &rgsv1.Actor{ActorId: "player-1", ActorType: rgsv1.ActorType_ACTOR_TYPE_PLAYER}}
+	if resp, _ := svc.CreateAlertRule(ctx, &rgsv1.CreateAlertRuleRequest{Meta: player, Rule: &rgsv1.AlertRule{Name: "x", EventCode: "DOOR_OPEN", Channels: []*rgsv1.AlertChannel{hook}}}); resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected player to be denied, got %v", resp.Meta.ResultCode)
+	}
+
+	door := create(&rgsv1.AlertRule{Name: "door", EventCode: "DOOR_OPEN", Channels: []*rgsv1.AlertChannel{hook}})
+	if door.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK || !strings.HasPrefix(door.Rule.RuleId, "alert-") || door.Rule.CreatedBy != "op-1" {
+		t.Fatalf("unexpected door rule: %+v", door)
+	}
+	create(&rgsv1.AlertRule{Name: "critical", MinSeverity: rgsv1.EventSeverity_EVENT_SEVERITY_CRITICAL, Channels: []*rgsv1.AlertChannel{
+		{Type: rgsv1.AlertChannelType_ALERT_CHANNEL_TYPE_EMAIL, Target: "floor@example.com"},
+	}})
+	list, _ := svc.ListAlertRules(ctx, &rgsv1.ListAlertRulesRequest{Meta: op})
+	if len(list.Rules) != 2 || list.Rules[0].Name != "door" || list.Rules[1].Name != "critical" {
+		t.Fatalf("unexpected rules: %+v", list.Rules)
+	}
+
+	submit := func(id, code string, sev rgsv1.EventSeverity) {
+		resp, err := svc.SubmitSignificantEvent(ctx, &rgsv1.SubmitSignificantEventRequest{Meta: op, Event: &rgsv1.SignificantEvent{
+			EventId: id, EquipmentId: "cab-1", EventCode: code, Severity: sev, OccurredAt: "2026-02-13T08:59:00Z",
+		}})
+		if err != nil || resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("submit %s: %v %+v", id, err, resp)
+		}
+	}
+	submit("ev-1", "DOOR_OPEN", rgsv1.EventSeverity_EVENT_SEVERITY_WARN)
+	submit("ev-2", "TILT", rgsv1.EventSeverity_EVENT_SEVERITY_CRITICAL)
+	submit("ev-3", "TILT", rgsv1.EventSeverity_EVENT_SEVERITY_WARN)
+	submit("ev-1", "DOOR_OPEN", rgsv1.EventSeverity_EVENT_SEVERITY_WARN)
+	svc.alerts.Wait()
+
+	sender.mu.Lock()
+	got := strings.Join(sender.sent, ",")
+	sender.mu.Unlock()
+	for _, want := range []string{"door|https://alerts.example/hook|ev-1", "critical|floor@example.com|ev-2"} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("expected alert %s, got %s", want, got)
+		}
+	}
+	if len(strings.Split(got, ",")) != 2 {
+		t.Fatalf("expected exactly two alerts, got %s", got)
+	}
+
+	deliveries := 0
+	for _, ev := range auditEvents(svc.AuditStore) {
+		if ev.Action == "deliver_event_alert" {
+			deliveries++
+			if ev.ActorID != "system" || ev.Result != audit.ResultSuccess || ev.ObjectType != "alert_rule" {
+				t.Fatalf("unexpected delivery audit: %+v", ev)
+			}
+		}
+	}
+	if deliveries != 2 {
+		t.Fatalf("expected two delivery audits, got %d", deliveries)
+	}
+}
+
+func TestEventAlertDispatcherChannels(t *testing.T) {
+	var body []byte
+	hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer hook.Close()
+
+	var mailed string
+	d := EventAlertDispatcher{
+		Client:   hook.Client(),
+		SMTPAddr: "smtp.example:25",
+		SMTPFrom: "rgs@example.com",
+		sendMail: func(_ string, _ smtp.Auth, _ string, to []string, msg []byte) error {
+			mailed = strings.Join(to, ",") + "\n" + string(msg)
+			return nil
+		},
+	}
+	alert := EventAlert{RuleID: "alert-1", RuleName: "tilt", Event: &rgsv1.SignificantEvent{EventId: "ev-9", EquipmentId: "cab-2", EventCode: "TILT"}}
+
+	ctx := context.Background()
+	if err := d.SendEventAlert(ctx, &rgsv1.AlertChannel{Type: rgsv1.AlertChannelType_ALERT_CHANNEL_TYPE_WEBHOOK, Target: hook.URL}, alert); err != nil {
+		t.Fatalf("webhook: %v", err)
+	}
+	var posted struct {
+		RuleID string `json:"rule_id"`
+		Event  struct {
+			EventID string `json:"event_id"`
+		} `json:"event"`
+	}
+	if err := json.Unmarshal(body, &posted); err != nil || posted.RuleID != "alert-1" || posted.Event.EventID != "ev-9" {
+		t.Fatalf("unexpected webhook body %s: %v", body, err)
+	}
+
+	if err := d.SendEventAlert(ctx, &rgsv1.AlertChannel{Type: rgsv1.AlertChannelType_ALERT_CHANNEL_TYPE_EMAIL, Target: "floor@example.com"}, alert); err != nil {
+		t.Fatalf("email: %v", err)
+	}
+	if !strings.HasPrefix(mailed, "floor@example.com\n") || !strings.Contains(mailed, "Subject: open-rgs alert tilt: TILT on cab-2") {
+		t.Fatalf("unexpected mail: %s", mailed)
+	}
+
+	if err := (EventAlertDispatcher{}).SendEventAlert(ctx, &rgsv1.AlertChannel{Type: rgsv1.AlertChannelType_ALERT_CHANNEL_TYPE_EMAIL, Target: "floor@example.com"}, alert); err == nil {
+		t.Fatalf("expected email without SMTP configuration to fail")
+	}
+}
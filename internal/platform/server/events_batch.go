@@ -0,0 +1,152 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"net/http"
+	"strconv"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// SignificantEventsNDJSONPath is where SignificantEventsNDJSONHandler
+	// must be mounted.
+	SignificantEventsNDJSONPath = "/v1/events/significant:ndjson"
+
+	maxSignificantEventBatch = 1000
+	maxNDJSONLineBytes       = 64 << 10
+	maxNDJSONBodyBytes       = maxSignificantEventBatch * maxNDJSONLineBytes
+)
+
+// submitSignificantEventItems records each event on its own, so one
+// failure leaves the rest of the batch in place.
+func (s *EventsService) submitSignificantEventItems(ctx context.Context, meta *rgsv1.RequestMeta, events []*rgsv1.SignificantEvent) []*rgsv1.SignificantEventBatchResult {
+	out := make([]*rgsv1.SignificantEventBatchResult, 0, len(events))
+	for _, e := range events {
+		result := &rgsv1.SignificantEventBatchResult{EventId: e.GetEventId()}
+		resp, err := s.SubmitSignificantEvent(ctx, &rgsv1.SubmitSignificantEventRequest{Meta: meta, Event: e})
+		if err != nil {
+			result.ResultCode, result.DenialReason = rgsv1.ResultCode_RESULT_CODE_ERROR, err.Error()
+		} else {
+			result.ResultCode, result.DenialReason = resp.Meta.GetResultCode(), resp.Meta.GetDenialReason()
+			if result.ResultCode == rgsv1.ResultCode_RESULT_CODE_OK {
+				result.Event = resp.Event
+			}
+		}
+		out = append(out, result)
+	}
+	return out
+}
+
+func countBatchResults(results []*rgsv1.SignificantEventBatchResult) (accepted, failed int32) {
+	for _, r := range results {
+		if r.ResultCode == rgsv1.ResultCode_RESULT_CODE_OK {
+			accepted++
+		} else {
+			failed++
+		}
+	}
+	return accepted, failed
+}
+
+// SubmitSignificantEventsBatch records a site controller's buffered events
+// in one call. The batch as a whole is only rejected when it is empty, too
+// large, or the caller may not submit events; otherwise every event gets
+// its own result and the response is OK even if some of them failed.
+func (s *EventsService) SubmitSignificantEventsBatch(ctx context.Context, req *rgsv1.SubmitSignificantEventsBatchRequest) (*rgsv1.SubmitSignificantEventsBatchResponse, error) {
+	if req == nil || len(req.Events) == 0 {
+		return &rgsv1.SubmitSignificantEventsBatchResponse{Meta: s.responseMeta(req.GetMeta(), rgsv1.ResultCode_RESULT_CODE_INVALID, "events are required")}, nil
+	}
+	if len(req.Events) > maxSignificantEventBatch {
+		return &rgsv1.SubmitSignificantEventsBatchResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "at most 1000 events per batch")}, nil
+	}
+	if ok, reason := s.authorizeWrite(ctx, req.Meta); !ok {
+		s.submitBlocked(req.Meta, "significant_event", "", "submit_significant_events_batch", reason)
+		return &rgsv1.SubmitSignificantEventsBatchResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	results := s.submitSignificantEventItems(ctx, req.Meta, req.Events)
+	accepted, failed := countBatchResults(results)
+	return &rgsv1.SubmitSignificantEventsBatchResponse{
+		Meta:          s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
+		Results:       results,
+		AcceptedCount: accepted,
+		FailedCount:   failed,
+	}, nil
+}
+
+// SignificantEventsNDJSONHandler accepts up to 1000 significant events as
+// newline-delimited protobuf JSON and answers with one
+// SignificantEventBatchResult per line, in order, as NDJSON. Blank lines are
+// skipped; a line that is not a valid event gets an INVALID result without
+// affecting the others. The caller is authenticated like any other HTTP
+// request and must be an operator or service actor.
+func (s *EventsService) SignificantEventsNDJSONHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		ctx := r.Context()
+		meta := &rgsv1.RequestMeta{RequestId: "ndjson-" + strconv.FormatInt(s.now().UnixNano(), 10)}
+		if actor, reason := resolveActor(ctx, nil); reason == "" {
+			meta.Actor = actor
+		}
+		if ok, reason := s.authorizeWrite(ctx, meta); !ok {
+			s.submitBlocked(meta, "significant_event", "", "submit_significant_events_batch", reason)
+			http.Error(w, reason, http.StatusForbidden)
+			return
+		}
+
+		// Parse every line first so an oversized or truncated body is
+		// rejected before anything is recorded.
+		var (
+			results []*rgsv1.SignificantEventBatchResult
+			events  []*rgsv1.SignificantEvent
+			slots   []int
+		)
+		scanner := bufio.NewScanner(http.MaxBytesReader(w, r.Body, maxNDJSONBodyBytes))
+		scanner.Buffer(make([]byte, 0, 4096), maxNDJSONLineBytes)
+		for line := 1; scanner.Scan(); line++ {
+			raw := bytes.TrimSpace(scanner.Bytes())
+			if len(raw) == 0 {
+				continue
+			}
+			if len(results) == maxSignificantEventBatch {
+				http.Error(w, "at most 1000 events per batch", http.StatusBadRequest)
+				return
+			}
+			var e rgsv1.SignificantEvent
+			if err := protojson.Unmarshal(raw, &e); err != nil {
+				results = append(results, &rgsv1.SignificantEventBatchResult{ResultCode: rgsv1.ResultCode_RESULT_CODE_INVALID, DenialReason: "line " + strconv.Itoa(line) + ": malformed event"})
+				continue
+			}
+			slots = append(slots, len(results))
+			results = append(results, nil)
+			events = append(events, &e)
+		}
+		if err := scanner.Err(); err != nil {
+			http.Error(w, "malformed NDJSON body", http.StatusBadRequest)
+			return
+		}
+		if len(results) == 0 {
+			http.Error(w, "events are required", http.StatusBadRequest)
+			return
+		}
+		for i, result := range s.submitSignificantEventItems(ctx, meta, events) {
+			results[slots[i]] = result
+		}
+
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		for _, result := range results {
+			line, err := protojson.Marshal(result)
+			if err != nil {
+				return
+			}
+			_, _ = w.Write(append(line, '\n'))
+		}
+	})
+}
@@ -0,0 +1,121 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	platformauth "github.com/wizardbeardstudio/open-rgs-go/internal/platform/auth"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestSubmitSignificantEventsBatchReportsPerItemResults(t *testing.T) {
+	svc := NewEventsService(ledgerFixedClock{now: time.Date(2026, 2, 13, 11, 0, 0, 0, time.UTC)})
+	ctx := context.Background()
+	site := meta("site-controller-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, "")
+
+	if _, err := svc.SubmitSignificantEvent(ctx, &rgsv1.SubmitSignificantEventRequest{Meta: site, Event: &rgsv1.SignificantEvent{EventId: "ev-1", EquipmentId: "cab-1", EventCode: "DOOR_OPEN"}}); err != nil {
+		t.Fatalf("seed event: %v", err)
+	}
+	resp, err := svc.SubmitSignificantEventsBatch(ctx, &rgsv1.SubmitSignificantEventsBatchRequest{Meta: site, Events: []*rgsv1.SignificantEvent{
+		{EventId: "ev-1", EquipmentId: "cab-1", EventCode: "DOOR_OPEN"},
+		{EventId: "ev-2", EventCode: "TILT"},
+		{EventId: "ev-3", EquipmentId: "cab-2", EventCode: "RAM_CLEAR", Severity: rgsv1.EventSeverity_EVENT_SEVERITY_CRITICAL},
+	}})
+	if err != nil {
+		t.Fatalf("submit batch: %v", err)
+	}
+	if resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK || resp.AcceptedCount != 2 || resp.FailedCount != 1 || len(resp.Results) != 3 {
+		t.Fatalf("unexpected batch response: %+v", resp)
+	}
+	if r := resp.Results[1]; r.EventId != "ev-2" || r.ResultCode != rgsv1.ResultCode_RESULT_CODE_INVALID || r.Event != nil {
+		t.Fatalf("expected event without equipment to fail alone: %+v", r)
+	}
+	if r := resp.Results[2]; r.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK || r.Event.GetRecordedAt() == "" {
+		t.Fatalf("expected third event to be recorded: %+v", r)
+	}
+	list, _ := svc.ListEvents(ctx, &rgsv1.ListEventsRequest{Meta: site})
+	if len(list.Events) != 2 {
+		t.Fatalf("expected replayed ev-1 to be recorded once, got %d events", len(list.Events))
+	}
+
+	if resp, _ := svc.SubmitSignificantEventsBatch(ctx, &rgsv1.SubmitSignificantEventsBatchRequest{Meta: site}); resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected empty batch to be invalid, got %v", resp.Meta.ResultCode)
+	}
+	tooMany := make([]*rgsv1.SignificantEvent, maxSignificantEventBatch+1)
+	if resp, _ := svc.SubmitSignificantEventsBatch(ctx, &rgsv1.SubmitSignificantEventsBatchRequest{Meta: site, Events: tooMany}); resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected oversized batch to be invalid, got %v", resp.Meta.ResultCode)
+	}
+	player := meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "")
+	if resp, _ := svc.SubmitSignificantEventsBatch(ctx, &rgsv1.SubmitSignificantEventsBatchRequest{Meta: player, Events: []*rgsv1.SignificantEvent{{EventId: "ev-9", EquipmentId: "cab-1"}}}); resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_DENIED || len(resp.Results) != 0 {
+		t.Fatalf("expected player batch to be denied: %+v", resp)
+	}
+}
+
+func TestSignificantEventsNDJSONHandler(t *testing.T) {
+	svc := NewEventsService(ledgerFixedClock{now: time.Date(2026, 2, 13, 11, 0, 0, 0, time.UTC)})
+	handler := svc.SignificantEventsNDJSONHandler()
+	post := func(body string, actor platformauth.Actor) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, SignificantEventsNDJSONPath, strings.NewReader(body))
+		req = req.WithContext(platformauth.WithActor(req.Context(), actor))
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+	site := platformauth.Actor{ID: "site-controller-1", Type: "ACTOR_TYPE_SERVICE"}
+
+	body := `{"eventId":"ev-1","equipmentId":"cab-1","eventCode":"DOOR_OPEN","severity":"EVENT_SEVERITY_WARN"}
+
+{"eventId":
+{"eventId":"ev-2","equipmentId":"cab-1","eventCode":"TILT"}
+`
+	rec := post(body, site)
+	if rec.Code != http.StatusOK || rec.Header().Get("Content-Type") != "application/x-ndjson" {
+		t.Fatalf("expected NDJSON 200, got %d %q: %s", rec.Code, rec.Header().Get("Content-Type"), rec.Body.String())
+	}
+	var results []*rgsv1.SignificantEventBatchResult
+	scanner := bufio.NewScanner(rec.Body)
+	for scanner.Scan() {
+		var r rgsv1.SignificantEventBatchResult
+		if err := protojson.Unmarshal(scanner.Bytes(), &r); err != nil {
+			t.Fatalf("decode result line %q: %v", scanner.Text(), err)
+		}
+		results = append(results, &r)
+	}
+	if len(results) != 3 {
+		t.Fatalf("expected one result per event line, got %d", len(results))
+	}
+	if results[0].EventId != "ev-1" || results[0].ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("unexpected first result: %+v", results[0])
+	}
+	if results[1].ResultCode != rgsv1.ResultCode_RESULT_CODE_INVALID || results[1].DenialReason != "line 3: malformed event" {
+		t.Fatalf("unexpected malformed line result: %+v", results[1])
+	}
+	if results[2].EventId != "ev-2" || results[2].ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("unexpected last result: %+v", results[2])
+	}
+
+	if rec := post(`{"eventId":"ev-3","equipmentId":"cab-1"}`, platformauth.Actor{ID: "player-1", Type: "ACTOR_TYPE_PLAYER"}); rec.Code != http.StatusForbidden {
+		t.Fatalf("expected player to be forbidden, got %d", rec.Code)
+	}
+	if rec := post("\n\n", site); rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected empty body to be rejected, got %d", rec.Code)
+	}
+	var tooMany strings.Builder
+	for i := 0; i <= maxSignificantEventBatch; i++ {
+		fmt.Fprintf(&tooMany, "{\"eventId\":\"bulk-%d\",\"equipmentId\":\"cab-1\"}\n", i)
+	}
+	if rec := post(tooMany.String(), site); rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected oversized batch to be rejected, got %d", rec.Code)
+	}
+	list, _ := svc.ListEvents(context.Background(), &rgsv1.ListEventsRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")})
+	if len(list.Events) != 2 {
+		t.Fatalf("expected only the two valid lines to be recorded, got %d", len(list.Events))
+	}
+}
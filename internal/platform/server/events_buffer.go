@@ -0,0 +1,499 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// IngestionOverflowPolicy decides what happens to a record submitted while
+// the ingestion buffer is full.
+type IngestionOverflowPolicy string
+
+const (
+	// IngestionOverflowDisable denies the record and disables further
+	// ingress until restart.
+	IngestionOverflowDisable IngestionOverflowPolicy = "disable"
+	// IngestionOverflowReject denies the record so the device keeps it,
+	// and accepts new records again once retries free up room.
+	IngestionOverflowReject IngestionOverflowPolicy = "reject"
+	// IngestionOverflowDropLowest dead-letters the newest buffered record of
+	// the lowest priority when the incoming record outranks it, and
+	// otherwise rejects the incoming record.
+	IngestionOverflowDropLowest IngestionOverflowPolicy = "drop_lowest"
+)
+
+// Buffered records are retried highest priority first.
+const (
+	ingestionPriorityMeter = iota + 1
+	ingestionPriorityEvent
+	ingestionPriorityCritical
+)
+
+// DefaultIngestionRetryPolicy paces retries of records whose first write
+// failed.
+var DefaultIngestionRetryPolicy = RetryPolicy{MaxAttempts: 8, Backoff: 5 * time.Second, MaxBackoff: 5 * time.Minute}
+
+// IngestionBufferConfig bounds the buffer that holds events and meters
+// while their persistence is retried.
+type IngestionBufferConfig struct {
+	// Capacity caps the buffered records; it defaults to 1024.
+	Capacity int
+	// Overflow defaults to IngestionOverflowDisable.
+	Overflow IngestionOverflowPolicy
+	// Retry paces retries. MaxAttempts counts the first write; one or less
+	// turns buffering off and a failed write is answered with an error.
+	Retry RetryPolicy
+}
+
+func (s *EventsService) SetIngestionBuffer(cfg IngestionBufferConfig) error {
+	if s == nil {
+		return nil
+	}
+	switch cfg.Overflow {
+	case "":
+		cfg.Overflow = IngestionOverflowDisable
+	case IngestionOverflowDisable, IngestionOverflowReject, IngestionOverflowDropLowest:
+	default:
+		return fmt.Errorf("unknown ingestion overflow policy %q", cfg.Overflow)
+	}
+	if cfg.Capacity < 0 {
+		return fmt.Errorf("ingestion buffer capacity must not be negative")
+	}
+	if cfg.Capacity == 0 {
+		cfg.Capacity = 1024
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.bufferCap = cfg.Capacity
+	s.bufferOverflow = cfg.Overflow
+	s.bufferRetry = cfg.Retry
+	return nil
+}
+
+// SetIngestionBufferObservers reports the buffer depth after every change
+// and each record dropped from or refused by the buffer, by reason.
+func (s *EventsService) SetIngestionBufferObservers(onDepth func(depth int), onDrop func(reason string)) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.onBufferDepth = onDepth
+	s.onBufferDrop = onDrop
+}
+
+func eventIngestionPriority(e *rgsv1.SignificantEvent) int {
+	if e.GetSeverity() == rgsv1.EventSeverity_EVENT_SEVERITY_CRITICAL {
+		return ingestionPriorityCritical
+	}
+	return ingestionPriorityEvent
+}
+
+func (s *EventsService) observeBufferDepthLocked() {
+	if s.onBufferDepth != nil {
+		s.onBufferDepth(len(s.buffers))
+	}
+}
+
+func (s *EventsService) observeBufferDropLocked(reason string) {
+	if s.onBufferDrop != nil {
+		s.onBufferDrop(reason)
+	}
+}
+
+// queueBufferLocked takes a buffer slot for a record about to be written,
+// applying the overflow policy when none is free.
+func (s *EventsService) queueBufferLocked(ctx context.Context, kind, equipmentID, sourceRecordID, occurredAt string, priority int) (*ingestionBufferRecord, bool) {
+	if s.disabled {
+		s.observeBufferDropLocked("rejected")
+		return nil, false
+	}
+	if len(s.buffers) >= s.bufferCap {
+		victim := s.lowestBufferLocked()
+		switch {
+		case s.bufferOverflow == IngestionOverflowDropLowest && victim != nil && victim.priority < priority:
+			s.deadLetterBufferLocked(ctx, victim, "overflow", "evicted by higher priority record")
+		case s.bufferOverflow == IngestionOverflowReject || s.bufferOverflow == IngestionOverflowDropLowest:
+			s.observeBufferDropLocked("rejected")
+			return nil, false
+		default:
+			s.disabled = true
+			s.observeBufferDropLocked("rejected")
+			return nil, false
+		}
+	}
+	now := s.now().Format(time.RFC3339Nano)
+	record := &ingestionBufferRecord{
+		bufferID:       s.nextBufferIDLocked(),
+		recordKind:     kind,
+		equipmentID:    equipmentID,
+		sourceRecordID: sourceRecordID,
+		status:         bufferQueued,
+		occurredAt:     occurredAt,
+		receivedAt:     now,
+		recordedAt:     now,
+		priority:       priority,
+	}
+	s.buffers = append(s.buffers, record)
+	s.observeBufferDepthLocked()
+	return record, true
+}
+
+// lowestBufferLocked returns the newest record of the lowest priority.
+func (s *EventsService) lowestBufferLocked() *ingestionBufferRecord {
+	var lowest *ingestionBufferRecord
+	for _, b := range s.buffers {
+		if lowest == nil || b.priority <= lowest.priority {
+			lowest = b
+		}
+	}
+	return lowest
+}
+
+// pendingBufferLocked returns the buffered record awaiting retry for a
+// resubmitted event or meter, so the device is answered as before instead
+// of taking a second slot.
+func (s *EventsService) pendingBufferLocked(kind, sourceRecordID string) *ingestionBufferRecord {
+	for _, b := range s.buffers {
+		if b.recordKind == kind && b.sourceRecordID == sourceRecordID && b.attempts > 0 {
+			return b
+		}
+	}
+	return nil
+}
+
+func (s *EventsService) releaseBufferLocked(bufferID string) {
+	for i, b := range s.buffers {
+		if b.bufferID == bufferID {
+			s.buffers = append(s.buffers[:i], s.buffers[i+1:]...)
+			s.observeBufferDepthLocked()
+			return
+		}
+	}
+}
+
+func (s *EventsService) acknowledgeBufferLocked(bufferID string) {
+	s.releaseBufferLocked(bufferID)
+}
+
+// deferBufferLocked keeps b for IngestionRetryJob after its first write
+// failed, reporting false when retries are turned off.
+func (s *EventsService) deferBufferLocked(ctx context.Context, b *ingestionBufferRecord, cause error) bool {
+	if s.bufferRetry.MaxAttempts <= 1 {
+		s.releaseBufferLocked(b.bufferID)
+		return false
+	}
+	s.failBufferAttemptLocked(ctx, b, cause)
+	return true
+}
+
+// failBufferAttemptLocked schedules b's next attempt with exponential
+// backoff, or dead-letters it once MaxAttempts is reached.
+func (s *EventsService) failBufferAttemptLocked(ctx context.Context, b *ingestionBufferRecord, cause error) {
+	b.attempts++
+	b.failureReason = cause.Error()
+	if b.attempts >= s.bufferRetry.MaxAttempts {
+		s.deadLetterBufferLocked(ctx, b, "retries_exhausted", b.failureReason)
+		return
+	}
+	b.nextAttemptAt = s.now().Add(s.bufferRetry.delay(b.attempts))
+	s.storeBufferLocked(ctx, b)
+}
+
+// deadLetterBufferLocked removes b from the buffer for good and audits it
+// under the system actor.
+func (s *EventsService) deadLetterBufferLocked(ctx context.Context, b *ingestionBufferRecord, dropReason, detail string) {
+	s.releaseBufferLocked(b.bufferID)
+	b.status = bufferDeadLetter
+	b.failureReason = detail
+	s.storeBufferLocked(ctx, b)
+	s.observeBufferDropLocked(dropReason)
+	after, _ := json.Marshal(map[string]any{
+		"buffer_id":        b.bufferID,
+		"record_kind":      b.recordKind,
+		"equipment_id":     b.equipmentID,
+		"source_record_id": b.sourceRecordID,
+		"attempts":         b.attempts,
+		"drop_reason":      dropReason,
+	})
+	meta := &rgsv1.RequestMeta{RequestId: requestID(b.meta), Actor: alertActor}
+	_ = s.appendAudit(meta, "ingestion_buffer", b.sourceRecordID, "dead_letter_ingestion_record", []byte(`{}`), after, audit.ResultError, detail)
+}
+
+// IngestionRetryJob writes up to batch buffered records whose backoff has
+// elapsed, highest priority first. With a database, records left queued by
+// an earlier process are picked up on the first run.
+func (s *EventsService) IngestionRetryJob(batch int) JobFunc {
+	if batch <= 0 {
+		batch = 100
+	}
+	return func(ctx context.Context, _ string) (string, error) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if s.db != nil && !s.buffersRecovered {
+			if err := s.recoverBuffersLocked(ctx); err != nil {
+				return "", fmt.Errorf("ingestion buffer recovery failed: %w", err)
+			}
+			s.buffersRecovered = true
+		}
+		now := s.now()
+		due := make([]*ingestionBufferRecord, 0)
+		for _, b := range s.buffers {
+			if b.attempts > 0 && !b.nextAttemptAt.After(now) {
+				due = append(due, b)
+			}
+		}
+		sort.SliceStable(due, func(i, j int) bool { return due[i].priority > due[j].priority })
+		if len(due) > batch {
+			due = due[:batch]
+		}
+		written, deferred, deadLettered := 0, 0, 0
+		for _, b := range due {
+			if err := s.retryBufferLocked(ctx, b); err != nil {
+				s.failBufferAttemptLocked(ctx, b, err)
+				if b.status == bufferDeadLetter {
+					deadLettered++
+				} else {
+					deferred++
+				}
+				continue
+			}
+			written++
+		}
+		if len(due) == 0 {
+			return "", nil
+		}
+		return fmt.Sprintf("ingestion retries written=%d deferred=%d dead_lettered=%d", written, deferred, deadLettered), nil
+	}
+}
+
+func (s *EventsService) retryBufferLocked(ctx context.Context, b *ingestionBufferRecord) error {
+	switch {
+	case b.event != nil:
+		inserted, err := s.persistSignificantEvent(ctx, b.meta, b.event, *b, b.skew)
+		if err != nil {
+			return err
+		}
+		s.commitSignificantEventLocked(ctx, b.meta, b.event, b, b.skew, inserted)
+	case b.meter != nil:
+		inserted, err := s.persistMeterRecord(ctx, b.meta, b.meter, *b, b.skew, b.alteration)
+		if err != nil {
+			return err
+		}
+		s.commitMeterLocked(ctx, b.meta, b.meter, b.alteration, b, b.skew, inserted)
+	default:
+		return fmt.Errorf("buffered record has no payload")
+	}
+	return nil
+}
+
+// bufferPayload is what ingestion_buffers.payload holds for a record
+// awaiting retry, enough to write it after a restart.
+type bufferPayload struct {
+	Meta       json.RawMessage `json:"meta,omitempty"`
+	Event      json.RawMessage `json:"event,omitempty"`
+	Meter      json.RawMessage `json:"meter,omitempty"`
+	Alteration json.RawMessage `json:"alteration,omitempty"`
+}
+
+func marshalBufferPayload(b *ingestionBufferRecord) ([]byte, error) {
+	var p bufferPayload
+	var err error
+	if b.meta != nil {
+		if p.Meta, err = protojson.Marshal(b.meta); err != nil {
+			return nil, err
+		}
+	}
+	if b.event != nil {
+		if p.Event, err = protojson.Marshal(b.event); err != nil {
+			return nil, err
+		}
+	}
+	if b.meter != nil {
+		if p.Meter, err = protojson.Marshal(b.meter); err != nil {
+			return nil, err
+		}
+	}
+	if b.alteration != nil {
+		if p.Alteration, err = protojson.Marshal(b.alteration); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(p)
+}
+
+func unmarshalBufferPayload(raw []byte, b *ingestionBufferRecord) error {
+	var p bufferPayload
+	if err := json.Unmarshal(raw, &p); err != nil {
+		return err
+	}
+	if len(p.Meta) > 0 {
+		b.meta = &rgsv1.RequestMeta{}
+		if err := protojson.Unmarshal(p.Meta, b.meta); err != nil {
+			return err
+		}
+	}
+	if len(p.Event) > 0 {
+		b.event = &rgsv1.SignificantEvent{}
+		if err := protojson.Unmarshal(p.Event, b.event); err != nil {
+			return err
+		}
+	}
+	if len(p.Meter) > 0 {
+		b.meter = &rgsv1.MeterRecord{}
+		if err := protojson.Unmarshal(p.Meter, b.meter); err != nil {
+			return err
+		}
+	}
+	if len(p.Alteration) > 0 {
+		b.alteration = &rgsv1.SignificantEvent{}
+		if err := protojson.Unmarshal(p.Alteration, b.alteration); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func bufferRecordKindToDB(b *ingestionBufferRecord) string {
+	if b.meter != nil {
+		return meterKindToDB(b.meter.RecordType)
+	}
+	return "significant_event"
+}
+
+// storeBufferLocked keeps the database copy of a deferred or dead-lettered
+// record current. It is best effort: the database may be the reason the
+// record is buffered, and the in-memory record is retried regardless.
+func (s *EventsService) storeBufferLocked(ctx context.Context, b *ingestionBufferRecord) {
+	if s.db == nil {
+		return
+	}
+	payload, err := marshalBufferPayload(b)
+	if err != nil {
+		return
+	}
+	var next any
+	if b.status == bufferQueued {
+		next = b.nextAttemptAt.UTC()
+	}
+	if b.dbID != 0 {
+		const upd = `
+UPDATE ingestion_buffers
+SET status = $2::ingestion_buffer_status,
+    attempt_count = $3,
+    last_attempt_at = NOW(),
+    next_attempt_at = $4,
+    failure_reason = $5
+WHERE buffer_id = $1
+`
+		_, _ = s.db.ExecContext(ctx, upd, b.dbID, string(b.status), b.attempts, next, b.failureReason)
+		return
+	}
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return
+	}
+	defer func() { _ = tx.Rollback() }()
+	if err := s.ensureEquipmentRowTx(ctx, tx, b.equipmentID); err != nil {
+		return
+	}
+	const ins = `
+INSERT INTO ingestion_buffers (
+  record_kind, status, equipment_id, source_record_id, request_id,
+  occurred_at, received_at, queued_at, last_attempt_at, attempt_count,
+  next_attempt_at, failure_reason, payload, priority
+) VALUES (
+  $1::ingestion_record_kind, $2::ingestion_buffer_status, $3, $4, $5,
+  $6::timestamptz, $7::timestamptz, NOW(), NOW(), $8, $9, $10, $11::jsonb, $12
+)
+ON CONFLICT DO NOTHING
+RETURNING buffer_id
+`
+	var id int64
+	if err := tx.QueryRowContext(ctx, ins,
+		bufferRecordKindToDB(b),
+		string(b.status),
+		b.equipmentID,
+		b.sourceRecordID,
+		requestID(b.meta),
+		nonEmptyTS(b.occurredAt),
+		nonEmptyTS(b.receivedAt),
+		b.attempts,
+		next,
+		b.failureReason,
+		string(payload),
+		b.priority,
+	).Scan(&id); err != nil {
+		return
+	}
+	if tx.Commit() == nil {
+		b.dbID = id
+	}
+}
+
+// recoverBuffersLocked loads records a previous process left queued for
+// retry. They are held regardless of capacity, having been accepted once.
+func (s *EventsService) recoverBuffersLocked(ctx context.Context) error {
+	const q = `
+SELECT buffer_id, record_kind::text, equipment_id, source_record_id,
+       occurred_at, received_at, attempt_count, next_attempt_at,
+       failure_reason, payload, priority
+FROM ingestion_buffers
+WHERE status = 'queued' AND attempt_count > 0
+ORDER BY priority DESC, queued_at ASC, buffer_id ASC
+`
+	rows, err := s.db.QueryContext(ctx, q)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	known := make(map[int64]bool, len(s.buffers))
+	for _, b := range s.buffers {
+		if b.dbID != 0 {
+			known[b.dbID] = true
+		}
+	}
+	for rows.Next() {
+		var (
+			b                  ingestionBufferRecord
+			kind               string
+			occurred, received time.Time
+			next               sql.NullTime
+			payload            []byte
+		)
+		if err := rows.Scan(&b.dbID, &kind, &b.equipmentID, &b.sourceRecordID, &occurred, &received, &b.attempts, &next, &b.failureReason, &payload, &b.priority); err != nil {
+			return err
+		}
+		if known[b.dbID] {
+			continue
+		}
+		if err := unmarshalBufferPayload(payload, &b); err != nil {
+			return err
+		}
+		b.bufferID = s.nextBufferIDLocked()
+		b.recordKind = "significant_event"
+		if kind != "significant_event" {
+			b.recordKind = "meter"
+		}
+		b.status = bufferQueued
+		b.occurredAt = occurred.UTC().Format(time.RFC3339Nano)
+		b.receivedAt = received.UTC().Format(time.RFC3339Nano)
+		b.recordedAt = b.receivedAt
+		b.nextAttemptAt = next.Time
+		s.buffers = append(s.buffers, &b)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	s.observeBufferDepthLocked()
+	return nil
+}
@@ -0,0 +1,123 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func TestIngestionBufferOverflowRetryAndDeadLetter(t *testing.T) {
+	now := time.Date(2026, 3, 9, 14, 0, 0, 0, time.UTC)
+	svc := NewEventsService(ledgerFixedClock{now: now})
+	ctx := context.Background()
+	egm := meta("egm-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, "")
+	if err := svc.SetIngestionBuffer(IngestionBufferConfig{Overflow: "spill"}); err == nil {
+		t.Fatalf("expected unknown overflow policy to be rejected")
+	}
+	if err := svc.SetIngestionBuffer(IngestionBufferConfig{
+		Capacity: 2,
+		Overflow: IngestionOverflowDropLowest,
+		Retry:    RetryPolicy{MaxAttempts: 3, Backoff: time.Minute, MaxBackoff: 90 * time.Second},
+	}); err != nil {
+		t.Fatalf("set ingestion buffer: %v", err)
+	}
+	var depth int
+	drops := map[string]int{}
+	svc.SetIngestionBufferObservers(func(d int) { depth = d }, func(reason string) { drops[reason]++ })
+
+	// Persistence only fails against a database, so failed writes are
+	// simulated by deferring buffered records directly.
+	failMeter := func(id string) *ingestionBufferRecord {
+		t.Helper()
+		svc.mu.Lock()
+		defer svc.mu.Unlock()
+		b, ok := svc.queueBufferLocked(ctx, "meter", "cab-1", id, "", ingestionPriorityMeter)
+		if !ok {
+			t.Fatalf("expected a free buffer slot for %s", id)
+		}
+		b.meta = egm
+		b.meter = &rgsv1.MeterRecord{MeterId: id, EquipmentId: "cab-1", MeterLabel: "coin_in", MonetaryUnit: "USD", ValueMinor: 100, RecordType: rgsv1.MeterRecordType_METER_RECORD_TYPE_SNAPSHOT}
+		if !svc.deferBufferLocked(ctx, b, errors.New("connection refused")) {
+			t.Fatalf("expected %s to be kept for retry", id)
+		}
+		return b
+	}
+	first := failMeter("m-1")
+	failMeter("m-2")
+	if depth != 2 || !first.nextAttemptAt.Equal(now.Add(time.Minute)) {
+		t.Fatalf("unexpected buffer state: depth=%d next=%v", depth, first.nextAttemptAt)
+	}
+
+	denied, _ := svc.SubmitMeterSnapshot(ctx, &rgsv1.SubmitMeterSnapshotRequest{Meta: egm, Meter: &rgsv1.MeterRecord{MeterId: "m-3", EquipmentId: "cab-1", MeterLabel: "coin_in"}})
+	if denied.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_DENIED || denied.Meta.DenialReason != "ingestion buffer exhausted" || svc.disabled {
+		t.Fatalf("expected a meter to be rejected by a full buffer without disabling ingress, got %+v", denied.Meta)
+	}
+	critical, _ := svc.SubmitSignificantEvent(ctx, &rgsv1.SubmitSignificantEventRequest{Meta: egm, Event: &rgsv1.SignificantEvent{
+		EventId: "ev-1", EquipmentId: "cab-1", EventCode: "DOOR_OPEN", Severity: rgsv1.EventSeverity_EVENT_SEVERITY_CRITICAL,
+	}})
+	if critical.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK || drops["overflow"] != 1 || drops["rejected"] != 1 || depth != 1 {
+		t.Fatalf("expected a critical event to evict the newest meter: %v drops=%v depth=%d", critical.Meta.ResultCode, drops, depth)
+	}
+	if again, _ := svc.SubmitMeterSnapshot(ctx, &rgsv1.SubmitMeterSnapshotRequest{Meta: egm, Meter: &rgsv1.MeterRecord{MeterId: "m-1", EquipmentId: "cab-1", MeterLabel: "coin_in"}}); again.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK || again.Meter.ValueMinor != 100 || depth != 1 {
+		t.Fatalf("expected a resubmitted buffered meter to be answered from the buffer, got %+v depth=%d", again, depth)
+	}
+
+	job := svc.IngestionRetryJob(1)
+	if summary, err := job(ctx, ""); err != nil || summary != "" {
+		t.Fatalf("expected nothing due before the backoff elapsed, got %q %v", summary, err)
+	}
+	svc.mu.Lock()
+	warn, _ := svc.queueBufferLocked(ctx, "significant_event", "cab-2", "ev-2", "", ingestionPriorityEvent)
+	warn.meta = egm
+	warn.event = &rgsv1.SignificantEvent{EventId: "ev-2", EquipmentId: "cab-2", EventCode: "BILL_JAM", Severity: rgsv1.EventSeverity_EVENT_SEVERITY_WARN}
+	svc.deferBufferLocked(ctx, warn, errors.New("connection refused"))
+	svc.mu.Unlock()
+	svc.Clock = ledgerFixedClock{now: now.Add(time.Minute)}
+	if summary, err := job(ctx, ""); err != nil || summary != "ingestion retries written=1 deferred=0 dead_lettered=0" {
+		t.Fatalf("unexpected retry summary %q %v", summary, err)
+	}
+	events, _ := svc.ListEvents(ctx, &rgsv1.ListEventsRequest{Meta: egm, EquipmentId: "cab-2"})
+	meters, _ := svc.ListMeters(ctx, &rgsv1.ListMetersRequest{Meta: egm, EquipmentId: "cab-1"})
+	if len(events.Events) != 1 || len(meters.Meters) != 0 || depth != 1 {
+		t.Fatalf("expected the event to be retried before the meter: events=%d meters=%d depth=%d", len(events.Events), len(meters.Meters), depth)
+	}
+	if _, err := job(ctx, ""); err != nil {
+		t.Fatalf("retry meter: %v", err)
+	}
+	if meters, _ = svc.ListMeters(ctx, &rgsv1.ListMetersRequest{Meta: egm, EquipmentId: "cab-1"}); len(meters.Meters) != 1 || depth != 0 {
+		t.Fatalf("expected the meter to be written on the next run: meters=%d depth=%d", len(meters.Meters), depth)
+	}
+
+	last := failMeter("m-4")
+	svc.mu.Lock()
+	svc.failBufferAttemptLocked(ctx, last, errors.New("connection refused"))
+	retryAt := last.nextAttemptAt
+	svc.failBufferAttemptLocked(ctx, last, errors.New("connection refused"))
+	svc.mu.Unlock()
+	if !retryAt.Equal(now.Add(time.Minute+90*time.Second)) || last.status != bufferDeadLetter || drops["retries_exhausted"] != 1 || depth != 0 {
+		t.Fatalf("expected capped backoff then a dead letter: retry=%v status=%s drops=%v depth=%d", retryAt, last.status, drops, depth)
+	}
+	var deadLettered int
+	for _, ev := range auditEvents(svc.AuditStore) {
+		if ev.Action == "dead_letter_ingestion_record" {
+			deadLettered++
+		}
+	}
+	if deadLettered != 2 {
+		t.Fatalf("expected the eviction and the exhausted record to be audited, got %d", deadLettered)
+	}
+
+	if err := svc.SetIngestionBuffer(IngestionBufferConfig{Retry: RetryPolicy{MaxAttempts: 1}}); err != nil {
+		t.Fatalf("set ingestion buffer: %v", err)
+	}
+	svc.mu.Lock()
+	b, _ := svc.queueBufferLocked(ctx, "meter", "cab-1", "m-5", "", ingestionPriorityMeter)
+	kept := svc.deferBufferLocked(ctx, b, errors.New("connection refused"))
+	svc.mu.Unlock()
+	if kept || depth != 0 {
+		t.Fatalf("expected a failed write to be released when retries are off: kept=%v depth=%d", kept, depth)
+	}
+}
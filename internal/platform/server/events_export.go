@@ -0,0 +1,260 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// SignificantEventsExportPath is where ExportSignificantEventsHandler
+	// must be mounted.
+	SignificantEventsExportPath = "/v1/events/significant:export"
+
+	defaultEventExportLimit = 10000
+	maxEventExportLimit     = 100000
+	eventExportPageSize     = 500
+)
+
+// eventExportCursor is the position after the last exported event, in
+// (recorded_at, event_id) order.
+type eventExportCursor struct {
+	recordedAt time.Time
+	eventID    string
+}
+
+func (c eventExportCursor) String() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(c.recordedAt.UTC().Format(time.RFC3339Nano) + "|" + c.eventID))
+}
+
+func parseEventExportCursor(raw string) (eventExportCursor, bool) {
+	decoded, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return eventExportCursor{}, false
+	}
+	ts, id, ok := strings.Cut(string(decoded), "|")
+	if !ok || id == "" {
+		return eventExportCursor{}, false
+	}
+	at, err := time.Parse(time.RFC3339Nano, ts)
+	if err != nil {
+		return eventExportCursor{}, false
+	}
+	return eventExportCursor{recordedAt: at, eventID: id}, true
+}
+
+func (c eventExportCursor) before(e *rgsv1.SignificantEvent) bool {
+	at := parseTS(e.RecordedAt)
+	return at.After(c.recordedAt) || (at.Equal(c.recordedAt) && e.EventId > c.eventID)
+}
+
+// eventExportPageLocked returns up to limit events after the cursor that
+// were recorded before end. s.mu must be held.
+func (s *EventsService) eventExportPageLocked(ctx context.Context, after eventExportCursor, end time.Time, equipmentID string, group equipmentFilter, limit int) ([]*rgsv1.SignificantEvent, error) {
+	if s.db != nil {
+		return s.exportEventsFromDB(ctx, after, end, equipmentID, group, limit)
+	}
+	out := make([]*rgsv1.SignificantEvent, 0)
+	for _, id := range s.eventOrder {
+		e := s.events[id]
+		if equipmentID != "" && e.EquipmentId != equipmentID {
+			continue
+		}
+		if !group.allows(e.EquipmentId) || !after.before(e) || !parseTS(e.RecordedAt).Before(end) {
+			continue
+		}
+		out = append(out, e)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		ai, aj := parseTS(out[i].RecordedAt), parseTS(out[j].RecordedAt)
+		if !ai.Equal(aj) {
+			return ai.Before(aj)
+		}
+		return out[i].EventId < out[j].EventId
+	})
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	for i, e := range out {
+		out[i] = cloneEvent(e)
+	}
+	return out, nil
+}
+
+func (s *EventsService) exportEventsFromDB(ctx context.Context, after eventExportCursor, end time.Time, equipmentID string, group equipmentFilter, limit int) ([]*rgsv1.SignificantEvent, error) {
+	const q = `
+SELECT event_id, equipment_id, event_code, localized_description, severity,
+       occurred_at, received_at, recorded_at, clock_skew_ms
+FROM significant_events
+WHERE (recorded_at, event_id) > ($1::timestamptz, $2)
+  AND recorded_at < $3
+  AND ($4 = '' OR equipment_id = $4)
+  AND (NOT $5 OR equipment_id = ANY($6::text[]))
+ORDER BY recorded_at ASC, event_id ASC
+LIMIT $7
+`
+	rows, err := s.db.QueryContext(ctx, q, after.recordedAt.UTC(), after.eventID, end.UTC(), equipmentID, group != nil, group.ids(), limit)
+	if err != nil {
+		return nil, err
+	}
+	return scanSignificantEvents(rows)
+}
+
+type eventExportLine struct {
+	Cursor string          `json:"cursor"`
+	Event  json.RawMessage `json:"event"`
+}
+
+// eventExportTrailer ends every export. NextCursor is empty once the range
+// is exhausted; a stream without a trailer was cut short and resumes from
+// the cursor of its last line.
+type eventExportTrailer struct {
+	NextCursor string `json:"next_cursor"`
+	Exported   int    `json:"exported"`
+	Error      string `json:"error,omitempty"`
+}
+
+// ExportSignificantEventsHandler streams the significant events recorded
+// in [start, end) as NDJSON, in recorded_at order, for backfilling
+// analytics stores. Query parameters: start (RFC3339, required), end
+// (defaults to now), equipment_id, group_id, cursor (from an earlier
+// export), and limit (default 10000, at most 100000). Each line carries
+// the event and the cursor to resume after it; a final line carries
+// next_cursor when limit cut the export short. The caller must be an
+// operator or service actor, and each export is audited as
+// export_significant_events.
+func (s *EventsService) ExportSignificantEventsHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		ctx := r.Context()
+		meta := &rgsv1.RequestMeta{RequestId: "export-" + strconv.FormatInt(s.now().UnixNano(), 10)}
+		if actor, reason := resolveActor(ctx, nil); reason == "" {
+			meta.Actor = actor
+		}
+		if ok, reason := s.authorizeRead(ctx, meta); !ok {
+			s.submitBlocked(meta, "significant_event", "", "export_significant_events", reason)
+			http.Error(w, reason, http.StatusForbidden)
+			return
+		}
+
+		query := r.URL.Query()
+		start, err := time.Parse(time.RFC3339Nano, query.Get("start"))
+		if err != nil {
+			http.Error(w, "start must be an RFC3339 time", http.StatusBadRequest)
+			return
+		}
+		end := s.now()
+		if raw := query.Get("end"); raw != "" {
+			if end, err = time.Parse(time.RFC3339Nano, raw); err != nil {
+				http.Error(w, "end must be an RFC3339 time", http.StatusBadRequest)
+				return
+			}
+		}
+		if !end.After(start) {
+			http.Error(w, "end must be after start", http.StatusBadRequest)
+			return
+		}
+		limit := defaultEventExportLimit
+		if raw := query.Get("limit"); raw != "" {
+			if limit, err = strconv.Atoi(raw); err != nil || limit <= 0 || limit > maxEventExportLimit {
+				http.Error(w, "limit must be between 1 and 100000", http.StatusBadRequest)
+				return
+			}
+		}
+		// An event recorded exactly at start sorts after the empty id.
+		cursor := eventExportCursor{recordedAt: start}
+		if raw := query.Get("cursor"); raw != "" {
+			c, ok := parseEventExportCursor(raw)
+			if !ok {
+				http.Error(w, "malformed cursor", http.StatusBadRequest)
+				return
+			}
+			if !c.recordedAt.Before(start) {
+				cursor = c
+			}
+		}
+		equipmentID := query.Get("equipment_id")
+		group, code, reason := s.Registry.equipmentFilterForGroup(ctx, query.Get("group_id"))
+		switch code {
+		case rgsv1.ResultCode_RESULT_CODE_OK:
+		case rgsv1.ResultCode_RESULT_CODE_INVALID:
+			http.Error(w, reason, http.StatusBadRequest)
+			return
+		default:
+			http.Error(w, reason, http.StatusInternalServerError)
+			return
+		}
+
+		after, _ := json.Marshal(map[string]any{
+			"start":        start.UTC().Format(time.RFC3339Nano),
+			"end":          end.UTC().Format(time.RFC3339Nano),
+			"equipment_id": equipmentID,
+			"group_id":     query.Get("group_id"),
+			"cursor":       query.Get("cursor"),
+			"limit":        limit,
+		})
+		s.mu.Lock()
+		err = s.appendAudit(meta, "significant_event", "", "export_significant_events", []byte(`{}`), after, audit.ResultSuccess, "")
+		s.mu.Unlock()
+		if err != nil {
+			http.Error(w, "audit unavailable", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		flusher, _ := w.(http.Flusher)
+		writeLine := func(v any) bool {
+			line, err := json.Marshal(v)
+			if err != nil {
+				return false
+			}
+			_, err = w.Write(append(line, '\n'))
+			return err == nil
+		}
+		exported := 0
+		for exported < limit {
+			s.mu.Lock()
+			page, err := s.eventExportPageLocked(ctx, cursor, end, equipmentID, group, min(eventExportPageSize, limit-exported))
+			s.mu.Unlock()
+			if err != nil {
+				writeLine(eventExportTrailer{NextCursor: cursor.String(), Exported: exported, Error: "persistence unavailable"})
+				return
+			}
+			for _, e := range page {
+				raw, err := protojson.Marshal(e)
+				if err != nil {
+					return
+				}
+				cursor = eventExportCursor{recordedAt: parseTS(e.RecordedAt), eventID: e.EventId}
+				if !writeLine(eventExportLine{Cursor: cursor.String(), Event: raw}) {
+					return
+				}
+				exported++
+			}
+			if flusher != nil {
+				flusher.Flush()
+			}
+			if len(page) < eventExportPageSize || ctx.Err() != nil {
+				break
+			}
+		}
+		trailer := eventExportTrailer{Exported: exported}
+		if exported == limit {
+			trailer.NextCursor = cursor.String()
+		}
+		writeLine(trailer)
+	})
+}
@@ -0,0 +1,120 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	platformauth "github.com/wizardbeardstudio/open-rgs-go/internal/platform/auth"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestExportSignificantEventsResumesFromCursor(t *testing.T) {
+	base := time.Date(2026, 3, 10, 8, 0, 0, 0, time.UTC)
+	svc := NewEventsService(ledgerFixedClock{now: base})
+	svc.Registry = NewRegistryService(ledgerFixedClock{now: base})
+	ctx := context.Background()
+	site := meta("site-controller-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, "")
+	for i, e := range []*rgsv1.SignificantEvent{
+		{EventId: "ev-b", EquipmentId: "cab-1", EventCode: "DOOR_OPEN"},
+		{EventId: "ev-a", EquipmentId: "cab-2", EventCode: "TILT"},
+		{EventId: "ev-c", EquipmentId: "cab-1", EventCode: "DOOR_CLOSED"},
+		{EventId: "ev-d", EquipmentId: "cab-1", EventCode: "BILL_JAM"},
+	} {
+		// ev-b and ev-a share a recorded_at, so event_id breaks the tie.
+		svc.Clock = ledgerFixedClock{now: base.Add(time.Duration(i/2) * time.Minute)}
+		if resp, _ := svc.SubmitSignificantEvent(ctx, &rgsv1.SubmitSignificantEventRequest{Meta: site, Event: e}); resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("submit %s: %v", e.EventId, resp.Meta.ResultCode)
+		}
+	}
+	svc.Clock = ledgerFixedClock{now: base.Add(time.Hour)}
+
+	handler := svc.ExportSignificantEventsHandler()
+	export := func(params url.Values, actor platformauth.Actor) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, SignificantEventsExportPath+"?"+params.Encode(), nil)
+		req = req.WithContext(platformauth.WithActor(req.Context(), actor))
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+	operator := platformauth.Actor{ID: "op-1", Type: "ACTOR_TYPE_OPERATOR"}
+	type line struct {
+		Cursor     string          `json:"cursor"`
+		Event      json.RawMessage `json:"event"`
+		NextCursor *string         `json:"next_cursor"`
+		Exported   int             `json:"exported"`
+	}
+	read := func(rec *httptest.ResponseRecorder) (ids []string, cursors []string, trailer line) {
+		t.Helper()
+		if rec.Code != http.StatusOK || rec.Header().Get("Content-Type") != "application/x-ndjson" {
+			t.Fatalf("expected NDJSON 200, got %d: %s", rec.Code, rec.Body.String())
+		}
+		scanner := bufio.NewScanner(rec.Body)
+		for scanner.Scan() {
+			var l line
+			if err := json.Unmarshal(scanner.Bytes(), &l); err != nil {
+				t.Fatalf("decode line %q: %v", scanner.Text(), err)
+			}
+			if l.NextCursor != nil {
+				trailer = l
+				continue
+			}
+			var e rgsv1.SignificantEvent
+			if err := protojson.Unmarshal(l.Event, &e); err != nil {
+				t.Fatalf("decode event %s: %v", l.Event, err)
+			}
+			ids = append(ids, e.EventId)
+			cursors = append(cursors, l.Cursor)
+		}
+		return ids, cursors, trailer
+	}
+
+	first := url.Values{"start": {base.Format(time.RFC3339)}, "limit": {"3"}}
+	ids, cursors, trailer := read(export(first, operator))
+	if len(ids) != 3 || ids[0] != "ev-a" || ids[1] != "ev-b" || ids[2] != "ev-c" || trailer.Exported != 3 || *trailer.NextCursor != cursors[2] {
+		t.Fatalf("unexpected first page: ids=%v trailer=%+v", ids, trailer)
+	}
+	// A consumer cut off after two lines resumes from the last cursor it saw.
+	resumed := url.Values{"start": {base.Format(time.RFC3339)}, "cursor": {cursors[1]}}
+	if ids, _, trailer = read(export(resumed, operator)); len(ids) != 2 || ids[0] != "ev-c" || ids[1] != "ev-d" || *trailer.NextCursor != "" {
+		t.Fatalf("unexpected resumed export: ids=%v trailer=%+v", ids, trailer)
+	}
+	filtered := url.Values{
+		"start":        {base.Add(30 * time.Second).Format(time.RFC3339)},
+		"end":          {base.Add(time.Minute + time.Second).Format(time.RFC3339)},
+		"equipment_id": {"cab-1"},
+	}
+	if ids, _, _ = read(export(filtered, operator)); len(ids) != 2 || ids[0] != "ev-c" || ids[1] != "ev-d" {
+		t.Fatalf("unexpected filtered export: %v", ids)
+	}
+
+	for _, bad := range []url.Values{
+		{},
+		{"start": {base.Format(time.RFC3339)}, "end": {base.Format(time.RFC3339)}},
+		{"start": {base.Format(time.RFC3339)}, "cursor": {"not-a-cursor"}},
+		{"start": {base.Format(time.RFC3339)}, "limit": {"100001"}},
+		{"start": {base.Format(time.RFC3339)}, "group_id": {"zone-404"}},
+	} {
+		if rec := export(bad, operator); rec.Code != http.StatusBadRequest {
+			t.Fatalf("expected %v to be rejected, got %d", bad, rec.Code)
+		}
+	}
+	if rec := export(first, platformauth.Actor{ID: "player-1", Type: "ACTOR_TYPE_PLAYER"}); rec.Code != http.StatusForbidden {
+		t.Fatalf("expected player to be forbidden, got %d", rec.Code)
+	}
+	var audited int
+	for _, ev := range auditEvents(svc.AuditStore) {
+		if ev.Action == "export_significant_events" && ev.Result == "success" {
+			audited++
+		}
+	}
+	if audited != 3 {
+		t.Fatalf("expected each export to be audited, got %d", audited)
+	}
+}
@@ -0,0 +1,240 @@
+package server
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+const (
+	// G2SMessagesPath is where G2SHandler must be mounted.
+	G2SMessagesPath = "/g2s/v1/messages"
+
+	g2sNamespace       = "http://www.gamingstandards.com/g2s/schemas/v1.0.3"
+	g2sMaxMessageBytes = 1 << 20
+
+	// G2S currency meters count millicents; meter records hold minor units.
+	g2sMillicentsPerMinor = 1000
+)
+
+type g2sMessage struct {
+	XMLName xml.Name `xml:"g2sMessage"`
+	Body    g2sBody  `xml:"g2sBody"`
+}
+
+type g2sBody struct {
+	HostID        string            `xml:"hostId,attr"`
+	EgmID         string            `xml:"egmId,attr"`
+	EventHandlers []g2sEventHandler `xml:"eventHandler"`
+}
+
+type g2sEventHandler struct {
+	DeviceID    string          `xml:"deviceId,attr"`
+	CommandID   string          `xml:"commandId,attr"`
+	SessionID   string          `xml:"sessionId,attr"`
+	EventReport *g2sEventReport `xml:"eventReport"`
+}
+
+type g2sEventReport struct {
+	DeviceClass   string         `xml:"deviceClass,attr"`
+	DeviceID      string         `xml:"deviceId,attr"`
+	EventCode     string         `xml:"eventCode,attr"`
+	EventText     string         `xml:"eventText,attr"`
+	EventID       string         `xml:"eventId,attr"`
+	EventDateTime string         `xml:"eventDateTime,attr"`
+	TransactionID string         `xml:"transactionId,attr"`
+	MeterInfo     []g2sMeterInfo `xml:"meterList>meterInfo"`
+}
+
+type g2sMeterInfo struct {
+	MeterDateTime string            `xml:"meterDateTime,attr"`
+	DeviceMeters  []g2sDeviceMeters `xml:"deviceMeters"`
+}
+
+type g2sDeviceMeters struct {
+	DeviceClass    string     `xml:"deviceClass,attr"`
+	DeviceID       string     `xml:"deviceId,attr"`
+	CurrencyMeters []g2sMeter `xml:"currencyMeter"`
+	CountMeters    []g2sMeter `xml:"countMeter"`
+}
+
+type g2sMeter struct {
+	MeterName  string `xml:"meterName,attr"`
+	MeterValue int64  `xml:"meterValue,attr"`
+}
+
+type g2sAckMessage struct {
+	XMLName xml.Name   `xml:"g2sMessage"`
+	Xmlns   string     `xml:"xmlns,attr"`
+	Body    g2sAckBody `xml:"g2sBody"`
+}
+
+type g2sAckBody struct {
+	HostID        string               `xml:"hostId,attr"`
+	EgmID         string               `xml:"egmId,attr"`
+	DateTimeSent  string               `xml:"dateTimeSent,attr"`
+	EventHandlers []g2sEventAckHandler `xml:"eventHandler"`
+}
+
+type g2sEventAckHandler struct {
+	DeviceID    string      `xml:"deviceId,attr"`
+	DateTime    string      `xml:"dateTime,attr"`
+	CommandID   string      `xml:"commandId,attr"`
+	SessionType string      `xml:"sessionType,attr"`
+	SessionID   string      `xml:"sessionId,attr"`
+	EventAck    g2sEventAck `xml:"eventAck"`
+}
+
+type g2sEventAck struct {
+	EventID string `xml:"eventId,attr"`
+}
+
+// g2sTime normalizes a G2S dateTime to RFC3339 UTC, passing through values
+// it cannot parse so the record keeps what the EGM sent.
+func g2sTime(v string) string {
+	if t, err := time.Parse(time.RFC3339Nano, v); err == nil {
+		return t.UTC().Format(time.RFC3339Nano)
+	}
+	return v
+}
+
+func (s *EventsService) nextG2SCommandID() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nextG2SCommand++
+	return strconv.FormatInt(s.nextG2SCommand, 10)
+}
+
+// ingestG2SEventReport records report as a significant event plus a meter
+// snapshot for each meter it carries. It reports false unless every record
+// was stored, in which case the event must not be acknowledged.
+func (s *EventsService) ingestG2SEventReport(ctx context.Context, meta *rgsv1.RequestMeta, egmID, currency string, report *g2sEventReport) bool {
+	prefix := "g2s-" + egmID + "-" + report.EventID
+	tags := map[string]string{
+		"g2s_device_class": report.DeviceClass,
+		"g2s_device_id":    report.DeviceID,
+	}
+	if report.TransactionID != "" && report.TransactionID != "0" {
+		tags["g2s_transaction_id"] = report.TransactionID
+	}
+	ev, err := s.SubmitSignificantEvent(ctx, &rgsv1.SubmitSignificantEventRequest{Meta: meta, Event: &rgsv1.SignificantEvent{
+		EventId:              prefix,
+		EquipmentId:          egmID,
+		EventCode:            report.EventCode,
+		LocalizedDescription: report.EventText,
+		Severity:             rgsv1.EventSeverity_EVENT_SEVERITY_INFO,
+		OccurredAt:           g2sTime(report.EventDateTime),
+		Tags:                 tags,
+	}})
+	if err != nil || ev.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		return false
+	}
+
+	for _, info := range report.MeterInfo {
+		occurredAt := g2sTime(info.MeterDateTime)
+		if occurredAt == "" {
+			occurredAt = g2sTime(report.EventDateTime)
+		}
+		for _, dm := range info.DeviceMeters {
+			record := func(m g2sMeter, unit string, value int64) bool {
+				resp, err := s.SubmitMeterSnapshot(ctx, &rgsv1.SubmitMeterSnapshotRequest{Meta: meta, Meter: &rgsv1.MeterRecord{
+					MeterId:      prefix + "-" + dm.DeviceClass + "-" + dm.DeviceID + "-" + m.MeterName,
+					EquipmentId:  egmID,
+					MeterLabel:   m.MeterName,
+					MonetaryUnit: unit,
+					ValueMinor:   value,
+					OccurredAt:   occurredAt,
+					Tags: map[string]string{
+						"g2s_device_class": dm.DeviceClass,
+						"g2s_device_id":    dm.DeviceID,
+						"g2s_event_id":     report.EventID,
+					},
+				}})
+				return err == nil && resp.Meta.GetResultCode() == rgsv1.ResultCode_RESULT_CODE_OK
+			}
+			for _, m := range dm.CurrencyMeters {
+				if !record(m, currency, m.MeterValue/g2sMillicentsPerMinor) {
+					return false
+				}
+			}
+			for _, m := range dm.CountMeters {
+				if !record(m, "", m.MeterValue) {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// G2SHandler accepts G2S messages posted as XML by gaming machines. Each
+// eventReport in the eventHandler class is recorded as a significant event
+// for the egmId, with the meters it carries recorded as snapshots, currency
+// meters in currency. The response acknowledges every report that was
+// recorded with an eventAck in the same session; a report left unacknowledged
+// is resent by the EGM, and resends are recorded once. Other classes are
+// ignored. The caller is authenticated like any other HTTP request and must
+// be an operator or service actor.
+func (s *EventsService) G2SHandler(currency string) http.Handler {
+	currency = strings.ToUpper(currency)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var msg g2sMessage
+		if err := xml.NewDecoder(http.MaxBytesReader(w, r.Body, g2sMaxMessageBytes)).Decode(&msg); err != nil {
+			http.Error(w, "malformed g2sMessage", http.StatusBadRequest)
+			return
+		}
+		egmID := strings.TrimSpace(msg.Body.EgmID)
+		if egmID == "" {
+			http.Error(w, "g2sBody egmId is required", http.StatusBadRequest)
+			return
+		}
+		ctx := r.Context()
+		meta := &rgsv1.RequestMeta{RequestId: "g2s-" + egmID + "-" + strconv.FormatInt(s.now().UnixNano(), 10)}
+		if actor, reason := resolveActor(ctx, nil); reason == "" {
+			meta.Actor = actor
+		}
+		if ok, reason := s.authorizeWrite(ctx, meta); !ok {
+			s.submitBlocked(meta, "significant_event", "", "g2s_event_report", reason)
+			http.Error(w, reason, http.StatusForbidden)
+			return
+		}
+
+		ack := g2sAckMessage{Xmlns: g2sNamespace, Body: g2sAckBody{HostID: msg.Body.HostID, EgmID: egmID}}
+		for _, h := range msg.Body.EventHandlers {
+			report := h.EventReport
+			if report == nil || report.EventID == "" {
+				continue
+			}
+			if !s.ingestG2SEventReport(ctx, meta, egmID, currency, report) {
+				continue
+			}
+			ack.Body.EventHandlers = append(ack.Body.EventHandlers, g2sEventAckHandler{
+				DeviceID:    h.DeviceID,
+				DateTime:    s.now().Format(time.RFC3339Nano),
+				CommandID:   s.nextG2SCommandID(),
+				SessionType: "G2S_response",
+				SessionID:   h.SessionID,
+				EventAck:    g2sEventAck{EventID: report.EventID},
+			})
+		}
+		ack.Body.DateTimeSent = s.now().Format(time.RFC3339Nano)
+		out, err := xml.Marshal(ack)
+		if err != nil {
+			http.Error(w, "encode acknowledgement", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+		_, _ = w.Write([]byte(xml.Header))
+		_, _ = w.Write(out)
+	})
+}
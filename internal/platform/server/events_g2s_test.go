@@ -0,0 +1,103 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	platformauth "github.com/wizardbeardstudio/open-rgs-go/internal/platform/auth"
+)
+
+const g2sDoorOpenReport = `<?xml version="1.0" encoding="UTF-8"?>
+<g2s:g2sMessage xmlns:g2s="http://www.gamingstandards.com/g2s/schemas/v1.0.3">
+  <g2s:g2sBody g2s:hostId="1" g2s:egmId="EGM_0042" g2s:dateTimeSent="2026-02-12T18:59:59.000Z">
+    <g2s:eventHandler g2s:deviceId="1" g2s:dateTime="2026-02-12T18:59:59.000Z" g2s:commandId="88" g2s:sessionType="G2S_request" g2s:sessionId="501" g2s:timeToLive="30000">
+      <g2s:eventReport g2s:deviceClass="G2S_cabinet" g2s:deviceId="1" g2s:eventCode="G2S_CBE101" g2s:eventText="Main door opened" g2s:eventId="7001" g2s:eventDateTime="2026-02-12T13:59:58.500-05:00" g2s:transactionId="0">
+        <g2s:meterList>
+          <g2s:meterInfo g2s:meterDateTime="2026-02-12T18:59:58.500Z" g2s:meterInfoType="G2S_onEvent">
+            <g2s:deviceMeters g2s:deviceClass="G2S_cabinet" g2s:deviceId="1">
+              <g2s:currencyMeter g2s:meterName="G2S_playerCashableAmt" g2s:meterValue="1234500"/>
+              <g2s:countMeter g2s:meterName="G2S_gamesSinceDoorClosedCnt" g2s:meterValue="17"/>
+            </g2s:deviceMeters>
+          </g2s:meterInfo>
+        </g2s:meterList>
+      </g2s:eventReport>
+    </g2s:eventHandler>
+  </g2s:g2sBody>
+</g2s:g2sMessage>`
+
+func TestG2SHandlerRecordsEventReportsAndAcknowledges(t *testing.T) {
+	svc := NewEventsService(ledgerFixedClock{now: time.Date(2026, 2, 12, 19, 0, 0, 0, time.UTC)})
+	handler := svc.G2SHandler("usd")
+	post := func(body string, actor *platformauth.Actor) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, G2SMessagesPath, strings.NewReader(body))
+		if actor != nil {
+			req = req.WithContext(platformauth.WithActor(req.Context(), *actor))
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+	egm := &platformauth.Actor{ID: "egm-0042", Type: "ACTOR_TYPE_SERVICE"}
+
+	rec := post(g2sDoorOpenReport, egm)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	for _, want := range []string{`egmId="EGM_0042"`, `sessionType="G2S_response"`, `sessionId="501"`, `<eventAck eventId="7001">`} {
+		if !strings.Contains(rec.Body.String(), want) {
+			t.Fatalf("expected %s in acknowledgement: %s", want, rec.Body.String())
+		}
+	}
+
+	meta := &rgsv1.RequestMeta{Actor: &rgsv1.Actor{ActorId: "op-1", ActorType: rgsv1.ActorType_ACTOR_TYPE_OPERATOR}}
+	events, _ := svc.ListEvents(context.Background(), &rgsv1.ListEventsRequest{Meta: meta, EquipmentId: "EGM_0042"})
+	if len(events.Events) != 1 {
+		t.Fatalf("expected one event, got %+v", events.Events)
+	}
+	ev := events.Events[0]
+	if ev.EventId != "g2s-EGM_0042-7001" || ev.EventCode != "G2S_CBE101" || ev.LocalizedDescription != "Main door opened" || ev.OccurredAt != "2026-02-12T18:59:58.5Z" || ev.Tags["g2s_device_class"] != "G2S_cabinet" {
+		t.Fatalf("unexpected event: %+v", ev)
+	}
+	meters, _ := svc.ListMeters(context.Background(), &rgsv1.ListMetersRequest{Meta: meta, EquipmentId: "EGM_0042"})
+	if len(meters.Meters) != 2 {
+		t.Fatalf("expected two meters, got %+v", meters.Meters)
+	}
+	for _, m := range meters.Meters {
+		switch m.MeterLabel {
+		case "G2S_playerCashableAmt":
+			if m.MonetaryUnit != "USD" || m.ValueMinor != 1234 || m.RecordType != rgsv1.MeterRecordType_METER_RECORD_TYPE_SNAPSHOT {
+				t.Fatalf("unexpected currency meter: %+v", m)
+			}
+		case "G2S_gamesSinceDoorClosedCnt":
+			if m.MonetaryUnit != "" || m.ValueMinor != 17 {
+				t.Fatalf("unexpected count meter: %+v", m)
+			}
+		default:
+			t.Fatalf("unexpected meter %s", m.MeterLabel)
+		}
+	}
+
+	// A resent report is acknowledged again without a second record.
+	if rec := post(g2sDoorOpenReport, egm); rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `eventId="7001"`) {
+		t.Fatalf("expected resend to be acknowledged: %d %s", rec.Code, rec.Body.String())
+	}
+	events, _ = svc.ListEvents(context.Background(), &rgsv1.ListEventsRequest{Meta: meta, EquipmentId: "EGM_0042"})
+	if len(events.Events) != 1 {
+		t.Fatalf("expected resend to be recorded once, got %d", len(events.Events))
+	}
+
+	if rec := post(g2sDoorOpenReport, &platformauth.Actor{ID: "player-1", Type: "ACTOR_TYPE_PLAYER"}); rec.Code != http.StatusForbidden {
+		t.Fatalf("expected player to be forbidden, got %d", rec.Code)
+	}
+	if rec := post(`<g2sMessage><g2sBody>`, egm); rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected malformed message to be rejected, got %d", rec.Code)
+	}
+	if rec := post(`<g2sMessage><g2sBody hostId="1"/></g2sMessage>`, egm); rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected missing egmId to be rejected, got %d", rec.Code)
+	}
+}
@@ -20,6 +20,7 @@ type bufferStatus string
 const (
 	bufferQueued       bufferStatus = "queued"
 	bufferAcknowledged bufferStatus = "acknowledged"
+	bufferDeadLetter   bufferStatus = "dead_letter"
 )
 
 type ingestionBufferRecord struct {
@@ -31,13 +32,28 @@ type ingestionBufferRecord struct {
 	occurredAt     string
 	receivedAt     string
 	recordedAt     string
+	priority       int
+
+	// A record whose first write failed keeps what is needed to write it
+	// again, with its retry state.
+	attempts      int
+	nextAttemptAt time.Time
+	failureReason string
+	dbID          int64
+	meta          *rgsv1.RequestMeta
+	event         *rgsv1.SignificantEvent
+	meter         *rgsv1.MeterRecord
+	alteration    *rgsv1.SignificantEvent
+	skew          *clockSkewObservation
 }
 
 type EventsService struct {
 	rgsv1.UnimplementedEventsServiceServer
 
 	Clock      clock.Clock
-	AuditStore *audit.InMemoryStore
+	AuditStore audit.Store
+	// Registry resolves the group_id filter on listings and watches.
+	Registry *RegistryService
 
 	mu sync.Mutex
 
@@ -45,13 +61,34 @@ type EventsService struct {
 	meters               map[string]*rgsv1.MeterRecord
 	eventOrder           []string
 	meterOrder           []string
-	buffers              []ingestionBufferRecord
+	buffers              []*ingestionBufferRecord
 	bufferCap            int
+	bufferOverflow       IngestionOverflowPolicy
+	bufferRetry          RetryPolicy
+	buffersRecovered     bool
+	onBufferDepth        func(depth int)
+	onBufferDrop         func(reason string)
 	disabled             bool
 	nextAuditID          int64
 	nextBuffer           int64
+	nextG2SCommand       int64
 	db                   *sql.DB
 	disableInMemoryCache bool
+
+	skewThreshold    time.Duration
+	skewChronicAfter int
+	skewByDevice     map[string]*rgsv1.DeviceClockSkew
+
+	alertRules   map[string]*rgsv1.AlertRule
+	alertOrder   []string
+	alertSender  EventAlertSender
+	alertTimeout time.Duration
+	alerts       sync.WaitGroup
+
+	maintenance map[string]*rgsv1.MaintenanceWindow
+	ramClears   map[string]*rgsv1.RamClearIncident
+
+	watchers *eventWatchHub
 }
 
 func NewEventsService(clk clock.Clock, db ...*sql.DB) *EventsService {
@@ -61,11 +98,25 @@ func NewEventsService(clk clock.Clock, db ...*sql.DB) *EventsService {
 	}
 	return &EventsService{
 		Clock:      clk,
-		AuditStore: audit.NewInMemoryStore(),
+		AuditStore: NewAuditStore(handle),
 		events:     make(map[string]*rgsv1.SignificantEvent),
 		meters:     make(map[string]*rgsv1.MeterRecord),
 		bufferCap:  1024,
 		db:         handle,
+
+		bufferOverflow: IngestionOverflowDisable,
+		bufferRetry:    DefaultIngestionRetryPolicy,
+
+		skewThreshold:    defaultClockSkewThreshold,
+		skewChronicAfter: defaultClockSkewChronicAfter,
+		skewByDevice:     make(map[string]*rgsv1.DeviceClockSkew),
+
+		alertRules:   make(map[string]*rgsv1.AlertRule),
+		alertSender:  EventAlertDispatcher{},
+		alertTimeout: 10 * time.Second,
+		maintenance:  make(map[string]*rgsv1.MaintenanceWindow),
+		ramClears:    make(map[string]*rgsv1.RamClearIncident),
+		watchers:     newEventWatchHub(),
 	}
 }
 
@@ -122,38 +173,8 @@ func (s *EventsService) nextBufferIDLocked() string {
 }
 
 func (s *EventsService) appendAudit(meta *rgsv1.RequestMeta, objectType, objectID, action string, before, after []byte, result audit.Result, reason string) error {
-	if s.AuditStore == nil {
-		return audit.ErrCorruptChain
-	}
-	actorID := "system"
-	actorType := "service"
-	if meta != nil && meta.Actor != nil {
-		actorID = meta.Actor.ActorId
-		actorType = meta.Actor.ActorType.String()
-	}
-	now := s.now()
-	ev := audit.Event{
-		AuditID:      s.nextAuditIDLocked(),
-		OccurredAt:   now,
-		RecordedAt:   now,
-		ActorID:      actorID,
-		ActorType:    actorType,
-		ObjectType:   objectType,
-		ObjectID:     objectID,
-		Action:       action,
-		Before:       before,
-		After:        after,
-		Result:       result,
-		Reason:       reason,
-		PartitionDay: now.Format("2006-01-02"),
-	}
-	if s.db != nil {
-		if err := appendAuditEventToDB(context.Background(), s.db, ev); err != nil {
-			return err
-		}
-	}
-	_, err := s.AuditStore.Append(ev)
-	return err
+	ev := newAuditEvent(meta, s.nextAuditIDLocked(), s.now(), objectType, objectID, action, before, after, result, reason)
+	return appendAuditTo(context.Background(), s.AuditStore, ev)
 }
 
 func cloneEvent(in *rgsv1.SignificantEvent) *rgsv1.SignificantEvent {
@@ -176,44 +197,6 @@ func (s *EventsService) submitBlocked(meta *rgsv1.RequestMeta, objectType, objec
 	_ = s.appendAudit(meta, objectType, objectID, action, []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
 }
 
-func (s *EventsService) queueBufferLocked(kind, equipmentID, sourceRecordID, occurredAt string) (ingestionBufferRecord, bool) {
-	if s.disabled {
-		return ingestionBufferRecord{}, false
-	}
-	queued := 0
-	for _, b := range s.buffers {
-		if b.status == bufferQueued {
-			queued++
-		}
-	}
-	if queued >= s.bufferCap {
-		s.disabled = true
-		return ingestionBufferRecord{}, false
-	}
-	now := s.now().Format(time.RFC3339Nano)
-	record := ingestionBufferRecord{
-		bufferID:       s.nextBufferIDLocked(),
-		recordKind:     kind,
-		equipmentID:    equipmentID,
-		sourceRecordID: sourceRecordID,
-		status:         bufferQueued,
-		occurredAt:     occurredAt,
-		receivedAt:     now,
-		recordedAt:     now,
-	}
-	s.buffers = append(s.buffers, record)
-	return record, true
-}
-
-func (s *EventsService) acknowledgeBufferLocked(bufferID string) {
-	for i := range s.buffers {
-		if s.buffers[i].bufferID == bufferID {
-			s.buffers[i].status = bufferAcknowledged
-			return
-		}
-	}
-}
-
 func (s *EventsService) SubmitSignificantEvent(ctx context.Context, req *rgsv1.SubmitSignificantEventRequest) (*rgsv1.SubmitSignificantEventResponse, error) {
 	if req == nil || req.Event == nil || req.Event.EventId == "" || req.Event.EquipmentId == "" {
 		return &rgsv1.SubmitSignificantEventResponse{Meta: s.responseMeta(nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "event_id and equipment_id are required")}, nil
@@ -230,36 +213,69 @@ func (s *EventsService) SubmitSignificantEvent(ctx context.Context, req *rgsv1.S
 		return &rgsv1.SubmitSignificantEventResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Event: cloneEvent(s.events[req.Event.EventId])}, nil
 	}
 
-	buffer, ok := s.queueBufferLocked("significant_event", req.Event.EquipmentId, req.Event.EventId, req.Event.OccurredAt)
+	if pending := s.pendingBufferLocked("significant_event", req.Event.EventId); pending != nil {
+		return &rgsv1.SubmitSignificantEventResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Event: cloneEvent(pending.event)}, nil
+	}
+
+	buffer, ok := s.queueBufferLocked(ctx, "significant_event", req.Event.EquipmentId, req.Event.EventId, req.Event.OccurredAt, eventIngestionPriority(req.Event))
 	if !ok {
 		s.submitBlocked(req.Meta, "significant_event", req.Event.EventId, "submit_significant_event", "ingestion buffer exhausted")
 		return &rgsv1.SubmitSignificantEventResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, "ingestion buffer exhausted")}, nil
 	}
 
-	now := s.now().Format(time.RFC3339Nano)
+	received := s.now()
+	now := received.Format(time.RFC3339Nano)
 	e := cloneEvent(req.Event)
+	skewMs, skew, err := s.observeClockSkewLocked(ctx, req.Meta, e.EquipmentId, e.OccurredAt, received)
+	if err != nil {
+		return &rgsv1.SubmitSignificantEventResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
 	if e.OccurredAt == "" {
 		e.OccurredAt = now
 	}
 	e.ReceivedAt = now
 	e.RecordedAt = now
+	e.ClockSkewMs = skewMs
 
 	before := []byte(`{}`)
 	after, _ := json.Marshal(e)
 	if err := s.appendAudit(req.Meta, "significant_event", e.EventId, "submit_significant_event", before, after, audit.ResultSuccess, ""); err != nil {
 		return &rgsv1.SubmitSignificantEventResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
 	}
-	if err := s.persistSignificantEvent(ctx, req.Meta, e, buffer); err != nil {
-		return &rgsv1.SubmitSignificantEventResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	if incident := newRAMClearIncident(e); incident != nil {
+		if err := s.auditMeterBaselineResetLocked(req.Meta, incident); err != nil {
+			return &rgsv1.SubmitSignificantEventResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+		}
+	}
+	inserted, err := s.persistSignificantEvent(ctx, req.Meta, e, *buffer, skew)
+	if err != nil {
+		buffer.meta, buffer.event, buffer.skew = req.Meta, e, skew
+		if !s.deferBufferLocked(ctx, buffer, err) {
+			return &rgsv1.SubmitSignificantEventResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+		return &rgsv1.SubmitSignificantEventResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Event: cloneEvent(e)}, nil
 	}
+	s.commitSignificantEventLocked(ctx, req.Meta, e, buffer, skew, inserted)
 
+	return &rgsv1.SubmitSignificantEventResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Event: cloneEvent(e)}, nil
+}
+
+// commitSignificantEventLocked applies a written event to the cache,
+// watchers, and alert rules and frees its buffer slot.
+func (s *EventsService) commitSignificantEventLocked(ctx context.Context, meta *rgsv1.RequestMeta, e *rgsv1.SignificantEvent, buffer *ingestionBufferRecord, skew *clockSkewObservation, inserted bool) {
 	if !s.disableInMemoryCache {
 		s.events[e.EventId] = e
 		s.eventOrder = append(s.eventOrder, e.EventId)
+		if incident := newRAMClearIncident(e); incident != nil {
+			s.ramClears[e.EventId] = incident
+		}
 	}
+	s.commitClockSkewLocked(skew)
 	s.acknowledgeBufferLocked(buffer.bufferID)
-
-	return &rgsv1.SubmitSignificantEventResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Event: cloneEvent(e)}, nil
+	if inserted {
+		s.watchers.publish(e)
+		s.dispatchAlertsLocked(ctx, meta, e)
+	}
 }
 
 func (s *EventsService) SubmitMeterSnapshot(ctx context.Context, req *rgsv1.SubmitMeterSnapshotRequest) (*rgsv1.SubmitMeterSnapshotResponse, error) {
@@ -290,37 +306,70 @@ func (s *EventsService) submitMeter(ctx context.Context, meta *rgsv1.RequestMeta
 		return &rgsv1.SubmitMeterSnapshotResponse{Meta: s.responseMeta(meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Meter: cloneMeter(existing)}, nil
 	}
 
-	buffer, ok := s.queueBufferLocked("meter", meter.EquipmentId, meter.MeterId, meter.OccurredAt)
+	if pending := s.pendingBufferLocked("meter", meter.MeterId); pending != nil {
+		return &rgsv1.SubmitMeterSnapshotResponse{Meta: s.responseMeta(meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Meter: cloneMeter(pending.meter)}, nil
+	}
+
+	buffer, ok := s.queueBufferLocked(ctx, "meter", meter.EquipmentId, meter.MeterId, meter.OccurredAt, ingestionPriorityMeter)
 	if !ok {
 		s.submitBlocked(meta, "meter_record", meter.MeterId, "submit_meter", "ingestion buffer exhausted")
 		return &rgsv1.SubmitMeterSnapshotResponse{Meta: s.responseMeta(meta, rgsv1.ResultCode_RESULT_CODE_DENIED, "ingestion buffer exhausted")}, nil
 	}
 
-	now := s.now().Format(time.RFC3339Nano)
+	received := s.now()
+	now := received.Format(time.RFC3339Nano)
 	m := cloneMeter(meter)
+	skewMs, skew, err := s.observeClockSkewLocked(ctx, meta, m.EquipmentId, m.OccurredAt, received)
+	if err != nil {
+		return &rgsv1.SubmitMeterSnapshotResponse{Meta: s.responseMeta(meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
 	if m.OccurredAt == "" {
 		m.OccurredAt = now
 	}
 	m.RecordType = kind
 	m.ReceivedAt = now
 	m.RecordedAt = now
+	m.ClockSkewMs = skewMs
+	alteration, err := s.observeMeterAnomalyLocked(ctx, m)
+	if err != nil {
+		return &rgsv1.SubmitMeterSnapshotResponse{Meta: s.responseMeta(meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
 
 	before := []byte(`{}`)
 	after, _ := json.Marshal(m)
 	if err := s.appendAudit(meta, "meter_record", m.MeterId, "submit_meter", before, after, audit.ResultSuccess, ""); err != nil {
 		return &rgsv1.SubmitMeterSnapshotResponse{Meta: s.responseMeta(meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
 	}
-	if err := s.persistMeterRecord(ctx, meta, m, buffer); err != nil {
-		return &rgsv1.SubmitMeterSnapshotResponse{Meta: s.responseMeta(meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	inserted, err := s.persistMeterRecord(ctx, meta, m, *buffer, skew, alteration)
+	if err != nil {
+		buffer.meta, buffer.meter, buffer.alteration, buffer.skew = meta, m, alteration, skew
+		if !s.deferBufferLocked(ctx, buffer, err) {
+			return &rgsv1.SubmitMeterSnapshotResponse{Meta: s.responseMeta(meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+		return &rgsv1.SubmitMeterSnapshotResponse{Meta: s.responseMeta(meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Meter: cloneMeter(m)}, nil
 	}
+	s.commitMeterLocked(ctx, meta, m, alteration, buffer, skew, inserted)
 
+	return &rgsv1.SubmitMeterSnapshotResponse{Meta: s.responseMeta(meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Meter: cloneMeter(m)}, nil
+}
+
+// commitMeterLocked applies a written meter and its alteration event, if
+// any, and frees its buffer slot.
+func (s *EventsService) commitMeterLocked(ctx context.Context, meta *rgsv1.RequestMeta, m *rgsv1.MeterRecord, alteration *rgsv1.SignificantEvent, buffer *ingestionBufferRecord, skew *clockSkewObservation, inserted bool) {
 	if !s.disableInMemoryCache {
 		s.meters[m.MeterId] = m
 		s.meterOrder = append(s.meterOrder, m.MeterId)
+		if alteration != nil {
+			s.events[alteration.EventId] = alteration
+			s.eventOrder = append(s.eventOrder, alteration.EventId)
+		}
 	}
+	s.commitClockSkewLocked(skew)
 	s.acknowledgeBufferLocked(buffer.bufferID)
-
-	return &rgsv1.SubmitMeterSnapshotResponse{Meta: s.responseMeta(meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Meter: cloneMeter(m)}, nil
+	if inserted && alteration != nil {
+		s.watchers.publish(alteration)
+		s.dispatchAlertsLocked(ctx, meta, alteration)
+	}
 }
 
 func (s *EventsService) ListEvents(ctx context.Context, req *rgsv1.ListEventsRequest) (*rgsv1.ListEventsResponse, error) {
@@ -331,6 +380,10 @@ func (s *EventsService) ListEvents(ctx context.Context, req *rgsv1.ListEventsReq
 		s.submitBlocked(req.Meta, "significant_event", "", "list_events", reason)
 		return &rgsv1.ListEventsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
 	}
+	group, code, reason := s.Registry.equipmentFilterForGroup(ctx, req.GroupId)
+	if code != rgsv1.ResultCode_RESULT_CODE_OK {
+		return &rgsv1.ListEventsResponse{Meta: s.responseMeta(req.Meta, code, reason)}, nil
+	}
 
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -346,7 +399,7 @@ func (s *EventsService) ListEvents(ctx context.Context, req *rgsv1.ListEventsReq
 		if size <= 0 {
 			size = 100
 		}
-		dbItems, err := s.listEventsFromDB(ctx, req.EquipmentId, size, start)
+		dbItems, err := s.listEventsFromDB(ctx, req.EquipmentId, group, size, start)
 		if err != nil {
 			return &rgsv1.ListEventsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
 		}
@@ -366,6 +419,9 @@ func (s *EventsService) ListEvents(ctx context.Context, req *rgsv1.ListEventsReq
 		if req.EquipmentId != "" && e.EquipmentId != req.EquipmentId {
 			continue
 		}
+		if !group.allows(e.EquipmentId) {
+			continue
+		}
 		items = append(items, cloneEvent(e))
 	}
 
@@ -409,6 +465,10 @@ func (s *EventsService) ListMeters(ctx context.Context, req *rgsv1.ListMetersReq
 		s.submitBlocked(req.Meta, "meter_record", "", "list_meters", reason)
 		return &rgsv1.ListMetersResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
 	}
+	group, code, reason := s.Registry.equipmentFilterForGroup(ctx, req.GroupId)
+	if code != rgsv1.ResultCode_RESULT_CODE_OK {
+		return &rgsv1.ListMetersResponse{Meta: s.responseMeta(req.Meta, code, reason)}, nil
+	}
 
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -424,7 +484,7 @@ func (s *EventsService) ListMeters(ctx context.Context, req *rgsv1.ListMetersReq
 		if size <= 0 {
 			size = 100
 		}
-		dbItems, err := s.listMetersFromDB(ctx, req.EquipmentId, req.MeterLabel, size, start)
+		dbItems, err := s.listMetersFromDB(ctx, req.EquipmentId, group, req.MeterLabel, req.ExcludeAnomalies, size, start)
 		if err != nil {
 			return &rgsv1.ListMetersResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
 		}
@@ -444,9 +504,15 @@ func (s *EventsService) ListMeters(ctx context.Context, req *rgsv1.ListMetersReq
 		if req.EquipmentId != "" && m.EquipmentId != req.EquipmentId {
 			continue
 		}
+		if !group.allows(m.EquipmentId) {
+			continue
+		}
 		if req.MeterLabel != "" && m.MeterLabel != req.MeterLabel {
 			continue
 		}
+		if req.ExcludeAnomalies && m.Anomaly != rgsv1.MeterAnomaly_METER_ANOMALY_UNSPECIFIED {
+			continue
+		}
 		items = append(items, cloneMeter(m))
 	}
 
@@ -0,0 +1,147 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	"google.golang.org/protobuf/proto"
+)
+
+func cloneMaintenanceWindow(in *rgsv1.MaintenanceWindow) *rgsv1.MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	cp, _ := proto.Clone(in).(*rgsv1.MaintenanceWindow)
+	return cp
+}
+
+// maintenanceWindowCovers reports whether at falls in [starts_at, ends_at).
+func maintenanceWindowCovers(w *rgsv1.MaintenanceWindow, at time.Time) bool {
+	return !at.Before(parseTS(w.StartsAt)) && at.Before(parseTS(w.EndsAt))
+}
+
+// maintenanceWindowsLocked returns the windows for equipmentID (all
+// equipment when empty) ordered by start, keeping only those covering at
+// unless it is zero. s.mu must be held.
+func (s *EventsService) maintenanceWindowsLocked(ctx context.Context, equipmentID string, at time.Time) ([]*rgsv1.MaintenanceWindow, error) {
+	if s.db != nil {
+		return s.listMaintenanceWindowsFromDB(ctx, equipmentID, at)
+	}
+	out := make([]*rgsv1.MaintenanceWindow, 0)
+	for _, w := range s.maintenance {
+		if equipmentID != "" && w.EquipmentId != equipmentID {
+			continue
+		}
+		if !at.IsZero() && !maintenanceWindowCovers(w, at) {
+			continue
+		}
+		out = append(out, cloneMaintenanceWindow(w))
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].StartsAt != out[j].StartsAt {
+			return parseTS(out[i].StartsAt).Before(parseTS(out[j].StartsAt))
+		}
+		return out[i].WindowId < out[j].WindowId
+	})
+	return out, nil
+}
+
+// suppressAlertsLocked reports the maintenance window, if any, that e's
+// equipment was under when e occurred. s.mu must be held.
+func (s *EventsService) suppressAlertsLocked(ctx context.Context, e *rgsv1.SignificantEvent) (*rgsv1.MaintenanceWindow, error) {
+	at := parseTS(e.OccurredAt)
+	if at.IsZero() {
+		at = parseTS(e.RecordedAt)
+	}
+	windows, err := s.maintenanceWindowsLocked(ctx, e.EquipmentId, at)
+	if err != nil || len(windows) == 0 {
+		return nil, err
+	}
+	return windows[0], nil
+}
+
+func (s *EventsService) CreateMaintenanceWindow(ctx context.Context, req *rgsv1.CreateMaintenanceWindowRequest) (*rgsv1.CreateMaintenanceWindowResponse, error) {
+	if req == nil || req.Window == nil || strings.TrimSpace(req.Window.EquipmentId) == "" {
+		return &rgsv1.CreateMaintenanceWindowResponse{Meta: s.responseMeta(nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "window.equipment_id is required")}, nil
+	}
+	if ok, reason := s.authorizeWrite(ctx, req.Meta); !ok {
+		s.submitBlocked(req.Meta, "maintenance_window", "", "create_maintenance_window", reason)
+		return &rgsv1.CreateMaintenanceWindowResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	startsAt, err := time.Parse(time.RFC3339Nano, req.Window.StartsAt)
+	if err != nil {
+		return &rgsv1.CreateMaintenanceWindowResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "window.starts_at must be an RFC3339 time")}, nil
+	}
+	endsAt, err := time.Parse(time.RFC3339Nano, req.Window.EndsAt)
+	if err != nil {
+		return &rgsv1.CreateMaintenanceWindowResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "window.ends_at must be an RFC3339 time")}, nil
+	}
+	if !endsAt.After(startsAt) {
+		return &rgsv1.CreateMaintenanceWindowResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "window.ends_at must be after starts_at")}, nil
+	}
+	if !endsAt.After(s.now()) {
+		return &rgsv1.CreateMaintenanceWindowResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "window has already ended")}, nil
+	}
+	if strings.TrimSpace(req.Window.Reason) == "" {
+		return &rgsv1.CreateMaintenanceWindowResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "window.reason is required")}, nil
+	}
+	raw := make([]byte, 8)
+	if _, err := rand.Read(raw); err != nil {
+		return &rgsv1.CreateMaintenanceWindowResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "window id unavailable")}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w := &rgsv1.MaintenanceWindow{
+		WindowId:    "maint-" + hex.EncodeToString(raw),
+		EquipmentId: strings.TrimSpace(req.Window.EquipmentId),
+		StartsAt:    startsAt.UTC().Format(time.RFC3339Nano),
+		EndsAt:      endsAt.UTC().Format(time.RFC3339Nano),
+		Reason:      strings.TrimSpace(req.Window.Reason),
+		CreatedBy:   req.Meta.GetActor().GetActorId(),
+		CreatedAt:   s.now().Format(time.RFC3339Nano),
+	}
+	after, _ := json.Marshal(w)
+	if err := s.appendAudit(req.Meta, "maintenance_window", w.WindowId, "create_maintenance_window", []byte(`{}`), after, audit.ResultSuccess, w.Reason); err != nil {
+		return &rgsv1.CreateMaintenanceWindowResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	if err := s.persistMaintenanceWindow(ctx, w); err != nil {
+		return &rgsv1.CreateMaintenanceWindowResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if !s.disableInMemoryCache {
+		s.maintenance[w.WindowId] = w
+	}
+	return &rgsv1.CreateMaintenanceWindowResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Window: cloneMaintenanceWindow(w)}, nil
+}
+
+func (s *EventsService) ListMaintenanceWindows(ctx context.Context, req *rgsv1.ListMaintenanceWindowsRequest) (*rgsv1.ListMaintenanceWindowsResponse, error) {
+	if req == nil {
+		req = &rgsv1.ListMaintenanceWindowsRequest{}
+	}
+	if ok, reason := s.authorizeRead(ctx, req.Meta); !ok {
+		s.submitBlocked(req.Meta, "maintenance_window", "", "list_maintenance_windows", reason)
+		return &rgsv1.ListMaintenanceWindowsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	var at time.Time
+	if req.ActiveAt != "" {
+		var err error
+		if at, err = time.Parse(time.RFC3339Nano, req.ActiveAt); err != nil {
+			return &rgsv1.ListMaintenanceWindowsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "active_at must be an RFC3339 time")}, nil
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	windows, err := s.maintenanceWindowsLocked(ctx, req.EquipmentId, at)
+	if err != nil {
+		return &rgsv1.ListMaintenanceWindowsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	return &rgsv1.ListMaintenanceWindowsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Windows: windows}, nil
+}
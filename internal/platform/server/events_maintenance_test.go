@@ -0,0 +1,94 @@
+package server
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func TestMaintenanceWindowsSuppressAlerts(t *testing.T) {
+	now := time.Date(2026, 3, 5, 9, 0, 0, 0, time.UTC)
+	svc := NewEventsService(ledgerFixedClock{now: now})
+	sender := &recordingAlertSender{}
+	svc.SetAlertSender(sender, time.Second)
+	ctx := context.Background()
+	op := meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+	ts := func(d time.Duration) string { return now.Add(d).Format(time.RFC3339Nano) }
+
+	svc.CreateAlertRule(ctx, &rgsv1.CreateAlertRuleRequest{Meta: op, Rule: &rgsv1.AlertRule{
+		Name: "doors", EventCode: "DOOR_OPEN",
+		Channels: []*rgsv1.AlertChannel{{Type: rgsv1.AlertChannelType_ALERT_CHANNEL_TYPE_WEBHOOK, Target: "https://alerts.example/hook"}},
+	}})
+
+	for _, bad := range []*rgsv1.MaintenanceWindow{
+		{EquipmentId: "cab-1", StartsAt: ts(0), EndsAt: ts(time.Hour)},
+		{EquipmentId: "cab-1", StartsAt: "soon", EndsAt: ts(time.Hour), Reason: "bill validator swap"},
+		{EquipmentId: "cab-1", StartsAt: ts(time.Hour), EndsAt: ts(0), Reason: "bill validator swap"},
+		{EquipmentId: "cab-1", StartsAt: ts(-2 * time.Hour), EndsAt: ts(-time.Hour), Reason: "bill validator swap"},
+	} {
+		if resp, _ := svc.CreateMaintenanceWindow(ctx, &rgsv1.CreateMaintenanceWindowRequest{Meta: op, Window: bad}); resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_INVALID {
+			t.Fatalf("expected window %+v to be invalid, got %v", bad, resp.Meta.ResultCode)
+		}
+	}
+	player := meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "")
+	if resp, _ := svc.CreateMaintenanceWindow(ctx, &rgsv1.CreateMaintenanceWindowRequest{Meta: player, Window: &rgsv1.MaintenanceWindow{EquipmentId: "cab-1", StartsAt: ts(0), EndsAt: ts(time.Hour), Reason: "x"}}); resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected player to be denied, got %v", resp.Meta.ResultCode)
+	}
+	created, _ := svc.CreateMaintenanceWindow(ctx, &rgsv1.CreateMaintenanceWindowRequest{Meta: op, Window: &rgsv1.MaintenanceWindow{
+		EquipmentId: "cab-1", StartsAt: ts(-10 * time.Minute), EndsAt: ts(time.Hour), Reason: "bill validator swap",
+	}})
+	if created.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK || !strings.HasPrefix(created.Window.WindowId, "maint-") || created.Window.CreatedBy != "op-1" {
+		t.Fatalf("unexpected created window: %+v", created)
+	}
+	svc.CreateMaintenanceWindow(ctx, &rgsv1.CreateMaintenanceWindowRequest{Meta: op, Window: &rgsv1.MaintenanceWindow{
+		EquipmentId: "cab-1", StartsAt: ts(24 * time.Hour), EndsAt: ts(25 * time.Hour), Reason: "firmware update",
+	}})
+
+	all, _ := svc.ListMaintenanceWindows(ctx, &rgsv1.ListMaintenanceWindowsRequest{Meta: op, EquipmentId: "cab-1"})
+	if len(all.Windows) != 2 || all.Windows[0].WindowId != created.Window.WindowId {
+		t.Fatalf("expected two windows in start order, got %+v", all.Windows)
+	}
+	active, _ := svc.ListMaintenanceWindows(ctx, &rgsv1.ListMaintenanceWindowsRequest{Meta: op, ActiveAt: ts(0)})
+	if len(active.Windows) != 1 || active.Windows[0].WindowId != created.Window.WindowId {
+		t.Fatalf("expected only the current window to be active, got %+v", active.Windows)
+	}
+
+	submit := func(eventID, equipmentID string, occurred time.Duration) {
+		t.Helper()
+		resp, _ := svc.SubmitSignificantEvent(ctx, &rgsv1.SubmitSignificantEventRequest{Meta: op, Event: &rgsv1.SignificantEvent{
+			EventId: eventID, EquipmentId: equipmentID, EventCode: "DOOR_OPEN", Severity: rgsv1.EventSeverity_EVENT_SEVERITY_WARN, OccurredAt: ts(occurred),
+		}})
+		if resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("submit %s: %v", eventID, resp.Meta.ResultCode)
+		}
+	}
+	submit("ev-1", "cab-1", 0)
+	submit("ev-2", "cab-2", 0)
+	submit("ev-3", "cab-1", -20*time.Minute)
+	svc.alerts.Wait()
+
+	sender.mu.Lock()
+	sent := append([]string(nil), sender.sent...)
+	sender.mu.Unlock()
+	sort.Strings(sent)
+	if strings.Join(sent, ",") != "doors|https://alerts.example/hook|ev-2,doors|https://alerts.example/hook|ev-3" {
+		t.Fatalf("expected only events outside maintenance to alert, got %v", sent)
+	}
+	listed, _ := svc.ListEvents(ctx, &rgsv1.ListEventsRequest{Meta: op, EquipmentId: "cab-1"})
+	if len(listed.Events) != 2 {
+		t.Fatalf("expected suppressed events to still be recorded, got %+v", listed.Events)
+	}
+	var suppressed int
+	for _, ev := range auditEvents(svc.AuditStore) {
+		if ev.Action == "suppress_event_alert" && strings.Contains(string(ev.After), created.Window.WindowId) {
+			suppressed++
+		}
+	}
+	if suppressed != 1 {
+		t.Fatalf("expected one audited suppression, got %d", suppressed)
+	}
+}
@@ -0,0 +1,182 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"math"
+	"strconv"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+const (
+	// MeterRolloverEventCode and MeterRegressionEventCode mark the
+	// alteration events raised when a meter record is a rollover or a
+	// regression.
+	MeterRolloverEventCode   = "METER_ROLLOVER"
+	MeterRegressionEventCode = "METER_REGRESSION"
+
+	// A drop is a rollover when wrapping at the next power of ten above the
+	// previous value means the counter grew by at most a tenth of that
+	// power; a bigger implied jump is a regression.
+	meterRolloverMaxFraction = 10
+)
+
+// classifyMeterDrop compares a snapshot value with the one before it.
+func classifyMeterDrop(prev, value int64) rgsv1.MeterAnomaly {
+	if value < 0 {
+		return rgsv1.MeterAnomaly_METER_ANOMALY_REGRESSION
+	}
+	if value >= prev {
+		return rgsv1.MeterAnomaly_METER_ANOMALY_UNSPECIFIED
+	}
+	modulus := int64(10)
+	for modulus <= prev {
+		if modulus > math.MaxInt64/10 {
+			return rgsv1.MeterAnomaly_METER_ANOMALY_REGRESSION
+		}
+		modulus *= 10
+	}
+	if modulus-prev+value <= modulus/meterRolloverMaxFraction {
+		return rgsv1.MeterAnomaly_METER_ANOMALY_ROLLOVER
+	}
+	return rgsv1.MeterAnomaly_METER_ANOMALY_REGRESSION
+}
+
+// previousSnapshotLocked returns the value of the latest snapshot of the
+// same equipment meter that occurred no later than m, or zero if there is
+// none. Snapshots before the latest RAM clear baseline reset are ignored.
+func (s *EventsService) previousSnapshotLocked(ctx context.Context, m *rgsv1.MeterRecord) (int64, error) {
+	if s.db != nil {
+		return s.previousSnapshotFromDB(ctx, m)
+	}
+	at := parseTS(m.OccurredAt)
+	baseline := s.meterBaselineLocked(m.EquipmentId, at)
+	var (
+		prev     *rgsv1.MeterRecord
+		prevTime time.Time
+	)
+	for _, id := range s.meterOrder {
+		c := s.meters[id]
+		if c == nil || c.EquipmentId != m.EquipmentId || c.MeterLabel != m.MeterLabel || c.RecordType != rgsv1.MeterRecordType_METER_RECORD_TYPE_SNAPSHOT {
+			continue
+		}
+		ts := parseTS(c.OccurredAt)
+		if ts.After(at) || ts.Before(baseline) || (prev != nil && ts.Before(prevTime)) {
+			continue
+		}
+		prev, prevTime = c, ts
+	}
+	if prev == nil {
+		return 0, nil
+	}
+	return prev.ValueMinor, nil
+}
+
+func (s *EventsService) previousSnapshotFromDB(ctx context.Context, m *rgsv1.MeterRecord) (int64, error) {
+	const q = `
+SELECT value_minor
+FROM meter_records
+WHERE equipment_id = $1
+  AND meter_label = $2
+  AND record_kind = 'meter_snapshot'
+  AND occurred_at <= $3::timestamptz
+  AND occurred_at >= COALESCE((
+    SELECT MAX(baseline_reset_at)
+    FROM ram_clear_incidents
+    WHERE equipment_id = $1 AND baseline_reset_at <= $3::timestamptz
+  ), '-infinity'::timestamptz)
+ORDER BY occurred_at DESC, recorded_at DESC
+LIMIT 1
+`
+	var v int64
+	err := s.db.QueryRowContext(ctx, q, m.EquipmentId, m.MeterLabel, nonEmptyTS(m.OccurredAt)).Scan(&v)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	return v, err
+}
+
+// observeMeterAnomalyLocked flags m when it rolls over or regresses and
+// returns the alteration event to record with it. Snapshots are compared
+// with the previous snapshot of the same meter; deltas only with zero.
+func (s *EventsService) observeMeterAnomalyLocked(ctx context.Context, m *rgsv1.MeterRecord) (*rgsv1.SignificantEvent, error) {
+	var desc string
+	switch m.RecordType {
+	case rgsv1.MeterRecordType_METER_RECORD_TYPE_DELTA:
+		if m.DeltaMinor >= 0 {
+			return nil, nil
+		}
+		m.Anomaly = rgsv1.MeterAnomaly_METER_ANOMALY_REGRESSION
+		desc = "meter " + m.MeterLabel + " reported a negative delta of " + strconv.FormatInt(m.DeltaMinor, 10)
+	default:
+		prev, err := s.previousSnapshotLocked(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		m.Anomaly = classifyMeterDrop(prev, m.ValueMinor)
+		if m.Anomaly == rgsv1.MeterAnomaly_METER_ANOMALY_UNSPECIFIED {
+			return nil, nil
+		}
+		m.PreviousValueMinor = prev
+		verb := "went backwards"
+		if m.Anomaly == rgsv1.MeterAnomaly_METER_ANOMALY_ROLLOVER {
+			verb = "rolled over"
+		}
+		desc = "meter " + m.MeterLabel + " " + verb + " from " + strconv.FormatInt(prev, 10) + " to " + strconv.FormatInt(m.ValueMinor, 10)
+	}
+
+	code, severity := MeterRegressionEventCode, rgsv1.EventSeverity_EVENT_SEVERITY_CRITICAL
+	if m.Anomaly == rgsv1.MeterAnomaly_METER_ANOMALY_ROLLOVER {
+		code, severity = MeterRolloverEventCode, rgsv1.EventSeverity_EVENT_SEVERITY_WARN
+	}
+	e := &rgsv1.SignificantEvent{
+		EventId:              "meter-anomaly-" + m.MeterId,
+		EquipmentId:          m.EquipmentId,
+		EventCode:            code,
+		LocalizedDescription: desc,
+		Severity:             severity,
+		OccurredAt:           m.OccurredAt,
+		ReceivedAt:           m.ReceivedAt,
+		RecordedAt:           m.RecordedAt,
+		Tags: map[string]string{
+			"category":             "alteration",
+			"meter_id":             m.MeterId,
+			"meter_label":          m.MeterLabel,
+			"value_minor":          strconv.FormatInt(m.ValueMinor, 10),
+			"delta_minor":          strconv.FormatInt(m.DeltaMinor, 10),
+			"previous_value_minor": strconv.FormatInt(m.PreviousValueMinor, 10),
+		},
+	}
+	after, _ := json.Marshal(e)
+	if err := s.appendAudit(nil, "significant_event", e.EventId, "raise_meter_anomaly", []byte(`{}`), after, audit.ResultSuccess, m.Anomaly.String()); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func meterAnomalyToDB(v rgsv1.MeterAnomaly) string {
+	switch v {
+	case rgsv1.MeterAnomaly_METER_ANOMALY_ROLLOVER:
+		return "rollover"
+	case rgsv1.MeterAnomaly_METER_ANOMALY_REGRESSION:
+		return "regression"
+	default:
+		return ""
+	}
+}
+
+func meterAnomalyFromDB(v string) rgsv1.MeterAnomaly {
+	switch v {
+	case "rollover":
+		return rgsv1.MeterAnomaly_METER_ANOMALY_ROLLOVER
+	case "regression":
+		return rgsv1.MeterAnomaly_METER_ANOMALY_REGRESSION
+	default:
+		return rgsv1.MeterAnomaly_METER_ANOMALY_UNSPECIFIED
+	}
+}
@@ -0,0 +1,99 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func TestClassifyMeterDrop(t *testing.T) {
+	cases := []struct {
+		prev, value int64
+		want        rgsv1.MeterAnomaly
+	}{
+		{100, 150, rgsv1.MeterAnomaly_METER_ANOMALY_UNSPECIFIED},
+		{100, 100, rgsv1.MeterAnomaly_METER_ANOMALY_UNSPECIFIED},
+		{99_999_950, 25, rgsv1.MeterAnomaly_METER_ANOMALY_ROLLOVER},
+		{9_950, 40, rgsv1.MeterAnomaly_METER_ANOMALY_ROLLOVER},
+		{60_000_000, 100, rgsv1.MeterAnomaly_METER_ANOMALY_REGRESSION},
+		{500, 499, rgsv1.MeterAnomaly_METER_ANOMALY_REGRESSION},
+		{0, -1, rgsv1.MeterAnomaly_METER_ANOMALY_REGRESSION},
+		{9_000_000_000_000_000_000, 1, rgsv1.MeterAnomaly_METER_ANOMALY_REGRESSION},
+	}
+	for _, tc := range cases {
+		if got := classifyMeterDrop(tc.prev, tc.value); got != tc.want {
+			t.Fatalf("classifyMeterDrop(%d, %d) = %v, want %v", tc.prev, tc.value, got, tc.want)
+		}
+	}
+}
+
+func TestMeterAnomaliesRaiseAlterationEventsAndAreExcludable(t *testing.T) {
+	svc := NewEventsService(ledgerFixedClock{now: time.Date(2026, 2, 13, 12, 0, 0, 0, time.UTC)})
+	svc.SetClockSkewThreshold(2*time.Hour, 0)
+	ctx := context.Background()
+	egm := meta("egm-7", rgsv1.ActorType_ACTOR_TYPE_SERVICE, "")
+	snapshot := func(id string, value int64, at string) *rgsv1.MeterRecord {
+		t.Helper()
+		resp, err := svc.SubmitMeterSnapshot(ctx, &rgsv1.SubmitMeterSnapshotRequest{Meta: egm, Meter: &rgsv1.MeterRecord{
+			MeterId: id, EquipmentId: "cab-7", MeterLabel: "coin_in", MonetaryUnit: "USD", ValueMinor: value, OccurredAt: at,
+		}})
+		if err != nil || resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("submit %s: %v %+v", id, err, resp)
+		}
+		return resp.Meter
+	}
+
+	snapshot("m-1", 99_999_900, "2026-02-13T11:00:00Z")
+	rolled := snapshot("m-2", 50, "2026-02-13T11:10:00Z")
+	if rolled.Anomaly != rgsv1.MeterAnomaly_METER_ANOMALY_ROLLOVER || rolled.PreviousValueMinor != 99_999_900 {
+		t.Fatalf("expected rollover against the previous snapshot: %+v", rolled)
+	}
+	if m := snapshot("m-3", 80, "2026-02-13T11:20:00Z"); m.Anomaly != rgsv1.MeterAnomaly_METER_ANOMALY_UNSPECIFIED {
+		t.Fatalf("expected counting after a rollover to be normal: %+v", m)
+	}
+	// A late snapshot is compared with the one before it in time, not with
+	// the latest one received.
+	if m := snapshot("m-late", 99_999_950, "2026-02-13T11:05:00Z"); m.Anomaly != rgsv1.MeterAnomaly_METER_ANOMALY_UNSPECIFIED {
+		t.Fatalf("expected late snapshot to be normal: %+v", m)
+	}
+	if m := snapshot("m-4", 30, "2026-02-13T11:30:00Z"); m.Anomaly != rgsv1.MeterAnomaly_METER_ANOMALY_REGRESSION {
+		t.Fatalf("expected regression: %+v", m)
+	}
+	delta, _ := svc.SubmitMeterDelta(ctx, &rgsv1.SubmitMeterDeltaRequest{Meta: egm, Meter: &rgsv1.MeterRecord{
+		MeterId: "d-1", EquipmentId: "cab-7", MeterLabel: "coin_in", DeltaMinor: -500, OccurredAt: "2026-02-13T11:31:00Z",
+	}})
+	if delta.Meter.Anomaly != rgsv1.MeterAnomaly_METER_ANOMALY_REGRESSION {
+		t.Fatalf("expected negative delta to be a regression: %+v", delta.Meter)
+	}
+
+	events, _ := svc.ListEvents(ctx, &rgsv1.ListEventsRequest{Meta: egm, EquipmentId: "cab-7"})
+	codes := map[string]*rgsv1.SignificantEvent{}
+	for _, e := range events.Events {
+		codes[e.EventId] = e
+	}
+	if len(codes) != 3 {
+		t.Fatalf("expected three alteration events, got %+v", events.Events)
+	}
+	if e := codes["meter-anomaly-m-2"]; e == nil || e.EventCode != MeterRolloverEventCode || e.Severity != rgsv1.EventSeverity_EVENT_SEVERITY_WARN || e.Tags["category"] != "alteration" {
+		t.Fatalf("unexpected rollover event: %+v", e)
+	}
+	if e := codes["meter-anomaly-m-4"]; e == nil || e.EventCode != MeterRegressionEventCode || e.Severity != rgsv1.EventSeverity_EVENT_SEVERITY_CRITICAL {
+		t.Fatalf("unexpected regression event: %+v", e)
+	}
+	if codes["meter-anomaly-d-1"] == nil {
+		t.Fatalf("expected negative delta event")
+	}
+
+	all, _ := svc.ListMeters(ctx, &rgsv1.ListMetersRequest{Meta: egm, EquipmentId: "cab-7"})
+	clean, _ := svc.ListMeters(ctx, &rgsv1.ListMetersRequest{Meta: egm, EquipmentId: "cab-7", ExcludeAnomalies: true})
+	if len(all.Meters) != 6 || len(clean.Meters) != 3 {
+		t.Fatalf("expected 6 records with 3 excluded, got %d and %d", len(all.Meters), len(clean.Meters))
+	}
+	for _, m := range clean.Meters {
+		if m.Anomaly != rgsv1.MeterAnomaly_METER_ANOMALY_UNSPECIFIED {
+			t.Fatalf("unexpected anomalous meter in reconciliation list: %+v", m)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"strings"
 	"time"
 
@@ -22,37 +23,59 @@ ON CONFLICT (equipment_id) DO NOTHING
 	return err
 }
 
-func (s *EventsService) persistSignificantEvent(ctx context.Context, meta *rgsv1.RequestMeta, e *rgsv1.SignificantEvent, buffer ingestionBufferRecord) error {
+func (s *EventsService) persistSignificantEvent(ctx context.Context, meta *rgsv1.RequestMeta, e *rgsv1.SignificantEvent, buffer ingestionBufferRecord, skew *clockSkewObservation) (bool, error) {
 	if s == nil || s.db == nil || e == nil {
-		return nil
+		return true, nil
 	}
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
-		return err
+		return false, err
 	}
 	defer func() { _ = tx.Rollback() }()
 
 	if err := s.ensureEquipmentRowTx(ctx, tx, e.EquipmentId); err != nil {
-		return err
+		return false, err
 	}
+	inserted, err := s.insertSignificantEventTx(ctx, tx, meta, e)
+	if err != nil {
+		return false, err
+	}
+	if incident := newRAMClearIncident(e); inserted && incident != nil {
+		if err := s.insertRAMClearIncidentTx(ctx, tx, incident); err != nil {
+			return false, err
+		}
+	}
+	if err := s.persistClockSkewTx(ctx, tx, meta, skew); err != nil {
+		return false, err
+	}
+
+	if err := s.persistBufferTx(ctx, tx, "significant_event", buffer, requestID(meta)); err != nil {
+		return false, err
+	}
+
+	return inserted, tx.Commit()
+}
 
+// insertSignificantEventTx records e and queues it in the outbox, reporting
+// whether it was new; a replayed event id inserts nothing and is not queued
+// again.
+func (s *EventsService) insertSignificantEventTx(ctx context.Context, tx *sql.Tx, meta *rgsv1.RequestMeta, e *rgsv1.SignificantEvent) (bool, error) {
 	const insEvent = `
 INSERT INTO significant_events (
   event_id, equipment_id, event_code, localized_description, severity,
   occurred_at, received_at, recorded_at, source_event_id, request_id,
-  actor_id, actor_type, tags, payload
+  actor_id, actor_type, tags, payload, clock_skew_ms
 ) VALUES (
-  $1,$2,$3,$4,$5,$6::timestamptz,$7::timestamptz,$8::timestamptz,$9,$10,$11,$12,$13::jsonb,$14::jsonb
+  $1,$2,$3,$4,$5,$6::timestamptz,$7::timestamptz,$8::timestamptz,$9,$10,$11,$12,$13::jsonb,$14::jsonb,$15
 )
 ON CONFLICT (event_id) DO NOTHING
 `
-	requestID := requestID(meta)
 	actorID, actorType := "", ""
 	if meta != nil && meta.Actor != nil {
 		actorID = meta.Actor.ActorId
 		actorType = meta.Actor.ActorType.String()
 	}
-	_, err = tx.ExecContext(ctx, insEvent,
+	res, err := tx.ExecContext(ctx, insEvent,
 		e.EventId,
 		e.EquipmentId,
 		e.EventCode,
@@ -62,44 +85,47 @@ ON CONFLICT (event_id) DO NOTHING
 		nonEmptyTS(e.ReceivedAt),
 		nonEmptyTS(e.RecordedAt),
 		e.EventId,
-		requestID,
+		requestID(meta),
 		actorID,
 		actorType,
 		`{}`,
 		`{}`,
+		e.ClockSkewMs,
 	)
 	if err != nil {
-		return err
+		return false, err
 	}
-
-	if err := s.persistBufferTx(ctx, tx, "significant_event", buffer, requestID); err != nil {
-		return err
+	if inserted, err := res.RowsAffected(); err != nil || inserted == 0 {
+		return false, err
 	}
-
-	return tx.Commit()
+	return true, insertOutboxEventTx(ctx, tx, "significant_event", e.EventId, "events.significant_event", e)
 }
 
-func (s *EventsService) persistMeterRecord(ctx context.Context, meta *rgsv1.RequestMeta, m *rgsv1.MeterRecord, buffer ingestionBufferRecord) error {
+// persistMeterRecord stores m with its alteration event, if any, reporting
+// whether m was new.
+func (s *EventsService) persistMeterRecord(ctx context.Context, meta *rgsv1.RequestMeta, m *rgsv1.MeterRecord, buffer ingestionBufferRecord, skew *clockSkewObservation, alteration *rgsv1.SignificantEvent) (bool, error) {
 	if s == nil || s.db == nil || m == nil {
-		return nil
+		return true, nil
 	}
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
-		return err
+		return false, err
 	}
 	defer func() { _ = tx.Rollback() }()
 
 	if err := s.ensureEquipmentRowTx(ctx, tx, m.EquipmentId); err != nil {
-		return err
+		return false, err
 	}
 
 	const insMeter = `
 INSERT INTO meter_records (
   meter_id, equipment_id, meter_label, monetary_unit, record_kind,
   value_minor, delta_minor, occurred_at, received_at, recorded_at,
-  source_meter_id, request_id, actor_id, actor_type, tags, payload
+  source_meter_id, request_id, actor_id, actor_type, tags, payload,
+  clock_skew_ms, anomaly, previous_value_minor
 ) VALUES (
-  $1,$2,$3,$4,$5::ingestion_record_kind,$6,$7,$8::timestamptz,$9::timestamptz,$10::timestamptz,$11,$12,$13,$14,$15::jsonb,$16::jsonb
+  $1,$2,$3,$4,$5::ingestion_record_kind,$6,$7,$8::timestamptz,$9::timestamptz,$10::timestamptz,$11,$12,$13,$14,$15::jsonb,$16::jsonb,
+  $17,$18,$19
 )
 ON CONFLICT (meter_id) DO NOTHING
 `
@@ -109,7 +135,7 @@ ON CONFLICT (meter_id) DO NOTHING
 		actorID = meta.Actor.ActorId
 		actorType = meta.Actor.ActorType.String()
 	}
-	_, err = tx.ExecContext(ctx, insMeter,
+	res, err := tx.ExecContext(ctx, insMeter,
 		m.MeterId,
 		m.EquipmentId,
 		m.MeterLabel,
@@ -126,27 +152,56 @@ ON CONFLICT (meter_id) DO NOTHING
 		actorType,
 		`{}`,
 		`{}`,
+		m.ClockSkewMs,
+		meterAnomalyToDB(m.Anomaly),
+		m.PreviousValueMinor,
 	)
 	if err != nil {
-		return err
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if n > 0 && alteration != nil {
+		if _, err := s.insertSignificantEventTx(ctx, tx, meta, alteration); err != nil {
+			return false, err
+		}
+	}
+	if err := s.persistClockSkewTx(ctx, tx, meta, skew); err != nil {
+		return false, err
 	}
 
 	if err := s.persistBufferTx(ctx, tx, "meter_snapshot", buffer, requestID); err != nil {
-		return err
+		return false, err
 	}
 
-	return tx.Commit()
+	return n > 0, tx.Commit()
 }
 
 func (s *EventsService) persistBufferTx(ctx context.Context, tx *sql.Tx, kind string, buffer ingestionBufferRecord, requestID string) error {
+	if buffer.dbID != 0 {
+		const ackBuffer = `
+UPDATE ingestion_buffers
+SET status = 'acknowledged'::ingestion_buffer_status,
+    attempt_count = attempt_count + 1,
+    last_attempt_at = NOW(),
+    next_attempt_at = NULL,
+    failure_reason = ''
+WHERE buffer_id = $1
+`
+		_, err := tx.ExecContext(ctx, ackBuffer, buffer.dbID)
+		return err
+	}
 	const insBuffer = `
 INSERT INTO ingestion_buffers (
   record_kind, status, equipment_id, source_record_id, request_id,
-  occurred_at, received_at, queued_at, payload
+  occurred_at, received_at, queued_at, payload, priority
 ) VALUES (
   $1::ingestion_record_kind, $2::ingestion_buffer_status, $3, $4, $5,
-  $6::timestamptz, $7::timestamptz, NOW(), $8::jsonb
+  $6::timestamptz, $7::timestamptz, NOW(), $8::jsonb, $9
 )
+ON CONFLICT DO NOTHING
 `
 	_, err := tx.ExecContext(ctx, insBuffer,
 		kind,
@@ -157,33 +212,43 @@ INSERT INTO ingestion_buffers (
 		nonEmptyTS(buffer.occurredAt),
 		nonEmptyTS(buffer.receivedAt),
 		`{}`,
+		buffer.priority,
 	)
 	return err
 }
 
-func (s *EventsService) listEventsFromDB(ctx context.Context, equipmentID string, limit, offset int) ([]*rgsv1.SignificantEvent, error) {
+func (s *EventsService) listEventsFromDB(ctx context.Context, equipmentID string, group equipmentFilter, limit, offset int) ([]*rgsv1.SignificantEvent, error) {
 	if s == nil || s.db == nil {
 		return nil, nil
 	}
 	const q = `
 SELECT event_id, equipment_id, event_code, localized_description, severity,
-       occurred_at, received_at, recorded_at
+       occurred_at, received_at, recorded_at, clock_skew_ms
 FROM significant_events
 WHERE ($1 = '' OR equipment_id = $1)
+  AND (NOT $4 OR equipment_id = ANY($5::text[]))
 ORDER BY recorded_at ASC, event_id ASC
 LIMIT $2 OFFSET $3
 `
-	rows, err := s.db.QueryContext(ctx, q, equipmentID, limit, offset)
+	rows, err := s.db.QueryContext(ctx, q, equipmentID, limit, offset, group != nil, group.ids())
 	if err != nil {
 		return nil, err
 	}
+	return scanSignificantEvents(rows)
+}
+
+// scanSignificantEvents reads rows selecting event_id, equipment_id,
+// event_code, localized_description, severity, occurred_at, received_at,
+// recorded_at, and clock_skew_ms, and closes them.
+func scanSignificantEvents(rows *sql.Rows) ([]*rgsv1.SignificantEvent, error) {
 	defer rows.Close()
 
 	out := make([]*rgsv1.SignificantEvent, 0)
 	for rows.Next() {
 		var eventID, eqID, code, desc, sev string
 		var occurred, received, recorded time.Time
-		if err := rows.Scan(&eventID, &eqID, &code, &desc, &sev, &occurred, &received, &recorded); err != nil {
+		var skewMs int64
+		if err := rows.Scan(&eventID, &eqID, &code, &desc, &sev, &occurred, &received, &recorded, &skewMs); err != nil {
 			return nil, err
 		}
 		out = append(out, &rgsv1.SignificantEvent{
@@ -195,25 +260,29 @@ LIMIT $2 OFFSET $3
 			OccurredAt:           occurred.UTC().Format(time.RFC3339Nano),
 			ReceivedAt:           received.UTC().Format(time.RFC3339Nano),
 			RecordedAt:           recorded.UTC().Format(time.RFC3339Nano),
+			ClockSkewMs:          skewMs,
 		})
 	}
 	return out, rows.Err()
 }
 
-func (s *EventsService) listMetersFromDB(ctx context.Context, equipmentID, meterLabel string, limit, offset int) ([]*rgsv1.MeterRecord, error) {
+func (s *EventsService) listMetersFromDB(ctx context.Context, equipmentID string, group equipmentFilter, meterLabel string, excludeAnomalies bool, limit, offset int) ([]*rgsv1.MeterRecord, error) {
 	if s == nil || s.db == nil {
 		return nil, nil
 	}
 	const q = `
 SELECT meter_id, equipment_id, meter_label, monetary_unit, record_kind::text,
-       value_minor, delta_minor, occurred_at, received_at, recorded_at
+       value_minor, delta_minor, occurred_at, received_at, recorded_at, clock_skew_ms,
+       anomaly, previous_value_minor
 FROM meter_records
 WHERE ($1 = '' OR equipment_id = $1)
   AND ($2 = '' OR meter_label = $2)
+  AND (NOT $3 OR anomaly = '')
+  AND (NOT $6 OR equipment_id = ANY($7::text[]))
 ORDER BY recorded_at ASC, meter_id ASC
-LIMIT $3 OFFSET $4
+LIMIT $4 OFFSET $5
 `
-	rows, err := s.db.QueryContext(ctx, q, equipmentID, meterLabel, limit, offset)
+	rows, err := s.db.QueryContext(ctx, q, equipmentID, meterLabel, excludeAnomalies, limit, offset, group != nil, group.ids())
 	if err != nil {
 		return nil, err
 	}
@@ -221,10 +290,10 @@ LIMIT $3 OFFSET $4
 
 	out := make([]*rgsv1.MeterRecord, 0)
 	for rows.Next() {
-		var meterID, eqID, label, unit, kind string
-		var valueMinor, deltaMinor int64
+		var meterID, eqID, label, unit, kind, anomaly string
+		var valueMinor, deltaMinor, skewMs, previousMinor int64
 		var occurred, received, recorded time.Time
-		if err := rows.Scan(&meterID, &eqID, &label, &unit, &kind, &valueMinor, &deltaMinor, &occurred, &received, &recorded); err != nil {
+		if err := rows.Scan(&meterID, &eqID, &label, &unit, &kind, &valueMinor, &deltaMinor, &occurred, &received, &recorded, &skewMs, &anomaly, &previousMinor); err != nil {
 			return nil, err
 		}
 		out = append(out, &rgsv1.MeterRecord{
@@ -238,11 +307,85 @@ LIMIT $3 OFFSET $4
 			OccurredAt:   occurred.UTC().Format(time.RFC3339Nano),
 			ReceivedAt:   received.UTC().Format(time.RFC3339Nano),
 			RecordedAt:   recorded.UTC().Format(time.RFC3339Nano),
+			ClockSkewMs:  skewMs,
+
+			Anomaly:            meterAnomalyFromDB(anomaly),
+			PreviousValueMinor: previousMinor,
 		})
 	}
 	return out, rows.Err()
 }
 
+func (s *EventsService) persistAlertRule(ctx context.Context, r *rgsv1.AlertRule) error {
+	if s == nil || s.db == nil || r == nil {
+		return nil
+	}
+	channels, err := json.Marshal(alertChannelsToDB(r.Channels))
+	if err != nil {
+		return err
+	}
+	const q = `
+INSERT INTO event_alert_rules (
+  rule_id, name, event_code, min_severity, channels, created_by, created_at
+) VALUES ($1,$2,$3,$4,$5::jsonb,$6,$7::timestamptz)
+`
+	_, err = s.db.ExecContext(ctx, q, r.RuleId, r.Name, r.EventCode, r.MinSeverity.String(), string(channels), r.CreatedBy, nonEmptyTS(r.CreatedAt))
+	return err
+}
+
+func (s *EventsService) listAlertRulesFromDB(ctx context.Context) ([]*rgsv1.AlertRule, error) {
+	const q = `
+SELECT rule_id, name, event_code, min_severity, channels, created_by, created_at
+FROM event_alert_rules
+ORDER BY created_at ASC, rule_id ASC
+`
+	rows, err := s.db.QueryContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]*rgsv1.AlertRule, 0)
+	for rows.Next() {
+		var r rgsv1.AlertRule
+		var sev string
+		var channels []byte
+		var created time.Time
+		if err := rows.Scan(&r.RuleId, &r.Name, &r.EventCode, &sev, &channels, &r.CreatedBy, &created); err != nil {
+			return nil, err
+		}
+		var dbChannels []alertChannelRow
+		if err := json.Unmarshal(channels, &dbChannels); err != nil {
+			return nil, err
+		}
+		r.MinSeverity = eventSeverityFromDB(sev)
+		r.Channels = alertChannelsFromDB(dbChannels)
+		r.CreatedAt = created.UTC().Format(time.RFC3339Nano)
+		out = append(out, &r)
+	}
+	return out, rows.Err()
+}
+
+type alertChannelRow struct {
+	Type   string `json:"type"`
+	Target string `json:"target"`
+}
+
+func alertChannelsToDB(in []*rgsv1.AlertChannel) []alertChannelRow {
+	out := make([]alertChannelRow, 0, len(in))
+	for _, ch := range in {
+		out = append(out, alertChannelRow{Type: ch.Type.String(), Target: ch.Target})
+	}
+	return out
+}
+
+func alertChannelsFromDB(in []alertChannelRow) []*rgsv1.AlertChannel {
+	out := make([]*rgsv1.AlertChannel, 0, len(in))
+	for _, ch := range in {
+		out = append(out, &rgsv1.AlertChannel{Type: rgsv1.AlertChannelType(rgsv1.AlertChannelType_value[ch.Type]), Target: ch.Target})
+	}
+	return out
+}
+
 func meterKindToDB(v rgsv1.MeterRecordType) string {
 	switch v {
 	case rgsv1.MeterRecordType_METER_RECORD_TYPE_SNAPSHOT:
@@ -284,3 +427,44 @@ func nonEmptyTS(v string) string {
 	}
 	return v
 }
+
+func (s *EventsService) persistMaintenanceWindow(ctx context.Context, w *rgsv1.MaintenanceWindow) error {
+	if s == nil || s.db == nil || w == nil {
+		return nil
+	}
+	const q = `
+INSERT INTO event_maintenance_windows (
+  window_id, equipment_id, starts_at, ends_at, reason, created_by, created_at
+) VALUES ($1,$2,$3::timestamptz,$4::timestamptz,$5,$6,$7::timestamptz)
+`
+	_, err := s.db.ExecContext(ctx, q, w.WindowId, w.EquipmentId, w.StartsAt, w.EndsAt, w.Reason, w.CreatedBy, nonEmptyTS(w.CreatedAt))
+	return err
+}
+
+func (s *EventsService) listMaintenanceWindowsFromDB(ctx context.Context, equipmentID string, at time.Time) ([]*rgsv1.MaintenanceWindow, error) {
+	const q = `
+SELECT window_id, equipment_id, starts_at, ends_at, reason, created_by, created_at
+FROM event_maintenance_windows
+WHERE ($1 = '' OR equipment_id = $1)
+  AND (NOT $2 OR (starts_at <= $3 AND ends_at > $3))
+ORDER BY starts_at ASC, window_id ASC
+`
+	rows, err := s.db.QueryContext(ctx, q, equipmentID, !at.IsZero(), at.UTC())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]*rgsv1.MaintenanceWindow, 0)
+	for rows.Next() {
+		var w rgsv1.MaintenanceWindow
+		var starts, ends, created time.Time
+		if err := rows.Scan(&w.WindowId, &w.EquipmentId, &starts, &ends, &w.Reason, &w.CreatedBy, &created); err != nil {
+			return nil, err
+		}
+		w.StartsAt = starts.UTC().Format(time.RFC3339Nano)
+		w.EndsAt = ends.UTC().Format(time.RFC3339Nano)
+		w.CreatedAt = created.UTC().Format(time.RFC3339Nano)
+		out = append(out, &w)
+	}
+	return out, rows.Err()
+}
@@ -0,0 +1,261 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	"google.golang.org/protobuf/proto"
+)
+
+// RAMClearEventCode marks the significant event a device reports after its
+// non-volatile memory was cleared. Recording one opens a RAM clear incident
+// and resets the equipment's meter baseline.
+const RAMClearEventCode = "RAM_CLEAR"
+
+func cloneRAMClearIncident(in *rgsv1.RamClearIncident) *rgsv1.RamClearIncident {
+	if in == nil {
+		return nil
+	}
+	cp, _ := proto.Clone(in).(*rgsv1.RamClearIncident)
+	return cp
+}
+
+// newRAMClearIncident returns the incident e opens, or nil when e is not a
+// RAM clear. The meter baseline resets at the time of the clear.
+func newRAMClearIncident(e *rgsv1.SignificantEvent) *rgsv1.RamClearIncident {
+	if e.EventCode != RAMClearEventCode {
+		return nil
+	}
+	return &rgsv1.RamClearIncident{
+		EventId:         e.EventId,
+		EquipmentId:     e.EquipmentId,
+		OccurredAt:      e.OccurredAt,
+		BaselineResetAt: e.OccurredAt,
+	}
+}
+
+// auditMeterBaselineResetLocked records the baseline reset of a RAM clear
+// under the system actor. s.mu must be held.
+func (s *EventsService) auditMeterBaselineResetLocked(meta *rgsv1.RequestMeta, incident *rgsv1.RamClearIncident) error {
+	after, _ := json.Marshal(incident)
+	systemMeta := &rgsv1.RequestMeta{RequestId: requestID(meta), Actor: alertActor}
+	return s.appendAudit(systemMeta, "meter_baseline", incident.EquipmentId, "reset_meter_baseline", []byte(`{}`), after, audit.ResultSuccess, "ram clear "+incident.EventId)
+}
+
+// meterBaselineLocked returns the latest RAM clear baseline of equipmentID
+// at or before at, or the zero time. s.mu must be held.
+func (s *EventsService) meterBaselineLocked(equipmentID string, at time.Time) time.Time {
+	var baseline time.Time
+	for _, inc := range s.ramClears {
+		if inc.EquipmentId != equipmentID {
+			continue
+		}
+		ts := parseTS(inc.BaselineResetAt)
+		if !ts.After(at) && ts.After(baseline) {
+			baseline = ts
+		}
+	}
+	return baseline
+}
+
+func (s *EventsService) lookupRAMClearIncidentLocked(ctx context.Context, eventID string) (*rgsv1.RamClearIncident, error) {
+	if s.db != nil {
+		return s.getRAMClearIncidentFromDB(ctx, eventID)
+	}
+	return cloneRAMClearIncident(s.ramClears[eventID]), nil
+}
+
+// ramClearIncidentsLocked returns incidents by occurrence, optionally for
+// one equipment and only those still awaiting acknowledgment. s.mu must be
+// held.
+func (s *EventsService) ramClearIncidentsLocked(ctx context.Context, equipmentID string, unacknowledgedOnly bool) ([]*rgsv1.RamClearIncident, error) {
+	if s.db != nil {
+		return s.listRAMClearIncidentsFromDB(ctx, equipmentID, unacknowledgedOnly)
+	}
+	out := make([]*rgsv1.RamClearIncident, 0)
+	for _, inc := range s.ramClears {
+		if equipmentID != "" && inc.EquipmentId != equipmentID {
+			continue
+		}
+		if unacknowledgedOnly && inc.AcknowledgedAt != "" {
+			continue
+		}
+		out = append(out, cloneRAMClearIncident(inc))
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].OccurredAt != out[j].OccurredAt {
+			return parseTS(out[i].OccurredAt).Before(parseTS(out[j].OccurredAt))
+		}
+		return out[i].EventId < out[j].EventId
+	})
+	return out, nil
+}
+
+func (s *EventsService) AcknowledgeRamClear(ctx context.Context, req *rgsv1.AcknowledgeRamClearRequest) (*rgsv1.AcknowledgeRamClearResponse, error) {
+	if req == nil || req.EventId == "" {
+		return &rgsv1.AcknowledgeRamClearResponse{Meta: s.responseMeta(nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "event_id is required")}, nil
+	}
+	actor, reason := resolveActor(ctx, req.Meta)
+	if reason == "" && actor.ActorType != rgsv1.ActorType_ACTOR_TYPE_OPERATOR {
+		reason = "operator actor required"
+	}
+	if reason != "" {
+		s.submitBlocked(req.Meta, "ram_clear_incident", req.EventId, "acknowledge_ram_clear", reason)
+		return &rgsv1.AcknowledgeRamClearResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	if strings.TrimSpace(req.Reason) == "" {
+		return &rgsv1.AcknowledgeRamClearResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "reason is required")}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, err := s.lookupRAMClearIncidentLocked(ctx, req.EventId)
+	if err != nil {
+		return &rgsv1.AcknowledgeRamClearResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if existing == nil {
+		return &rgsv1.AcknowledgeRamClearResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "ram clear incident not found")}, nil
+	}
+	if existing.AcknowledgedAt != "" {
+		return &rgsv1.AcknowledgeRamClearResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "ram clear already acknowledged")}, nil
+	}
+	updated := cloneRAMClearIncident(existing)
+	updated.AcknowledgedBy = actor.ActorId
+	updated.AcknowledgedAt = s.now().Format(time.RFC3339Nano)
+	updated.AcknowledgmentReason = strings.TrimSpace(req.Reason)
+
+	before, _ := json.Marshal(existing)
+	after, _ := json.Marshal(updated)
+	if err := s.appendAudit(req.Meta, "ram_clear_incident", req.EventId, "acknowledge_ram_clear", before, after, audit.ResultSuccess, updated.AcknowledgmentReason); err != nil {
+		return &rgsv1.AcknowledgeRamClearResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	if s.db != nil {
+		ok, err := s.acknowledgeRAMClearIncidentDB(ctx, updated)
+		if err != nil {
+			return &rgsv1.AcknowledgeRamClearResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+		if !ok {
+			return &rgsv1.AcknowledgeRamClearResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "ram clear already acknowledged")}, nil
+		}
+	} else if !s.disableInMemoryCache {
+		s.ramClears[req.EventId] = updated
+	}
+	return &rgsv1.AcknowledgeRamClearResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Incident: cloneRAMClearIncident(updated)}, nil
+}
+
+func (s *EventsService) ListRamClearIncidents(ctx context.Context, req *rgsv1.ListRamClearIncidentsRequest) (*rgsv1.ListRamClearIncidentsResponse, error) {
+	if req == nil {
+		req = &rgsv1.ListRamClearIncidentsRequest{}
+	}
+	if ok, reason := s.authorizeRead(ctx, req.Meta); !ok {
+		s.submitBlocked(req.Meta, "ram_clear_incident", "", "list_ram_clear_incidents", reason)
+		return &rgsv1.ListRamClearIncidentsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	items, err := s.ramClearIncidentsLocked(ctx, req.EquipmentId, req.UnacknowledgedOnly)
+	if err != nil {
+		return &rgsv1.ListRamClearIncidentsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	return &rgsv1.ListRamClearIncidentsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Incidents: items}, nil
+}
+
+// ramClearReportRow is the report representation of an incident.
+func ramClearReportRow(inc *rgsv1.RamClearIncident) map[string]any {
+	status := "pending"
+	if inc.AcknowledgedAt != "" {
+		status = "acknowledged"
+	}
+	return map[string]any{
+		"event_id":              inc.EventId,
+		"equipment_id":          inc.EquipmentId,
+		"occurred_at":           inc.OccurredAt,
+		"baseline_reset_at":     inc.BaselineResetAt,
+		"status":                status,
+		"acknowledged_by":       inc.AcknowledgedBy,
+		"acknowledged_at":       inc.AcknowledgedAt,
+		"acknowledgment_reason": inc.AcknowledgmentReason,
+	}
+}
+
+func (s *EventsService) insertRAMClearIncidentTx(ctx context.Context, tx *sql.Tx, inc *rgsv1.RamClearIncident) error {
+	const q = `
+INSERT INTO ram_clear_incidents (event_id, equipment_id, occurred_at, baseline_reset_at)
+VALUES ($1,$2,$3::timestamptz,$4::timestamptz)
+ON CONFLICT (event_id) DO NOTHING
+`
+	_, err := tx.ExecContext(ctx, q, inc.EventId, inc.EquipmentId, nonEmptyTS(inc.OccurredAt), nonEmptyTS(inc.BaselineResetAt))
+	return err
+}
+
+// acknowledgeRAMClearIncidentDB reports false when the incident was already
+// acknowledged.
+func (s *EventsService) acknowledgeRAMClearIncidentDB(ctx context.Context, inc *rgsv1.RamClearIncident) (bool, error) {
+	const q = `
+UPDATE ram_clear_incidents
+SET acknowledged_by = $2, acknowledged_at = $3::timestamptz, acknowledgment_reason = $4
+WHERE event_id = $1 AND acknowledged_at IS NULL
+`
+	res, err := s.db.ExecContext(ctx, q, inc.EventId, inc.AcknowledgedBy, inc.AcknowledgedAt, inc.AcknowledgmentReason)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n == 1, err
+}
+
+const ramClearIncidentColumns = `event_id, equipment_id, occurred_at, baseline_reset_at, acknowledged_by, acknowledged_at, acknowledgment_reason`
+
+func scanRAMClearIncident(row interface{ Scan(...any) error }) (*rgsv1.RamClearIncident, error) {
+	var inc rgsv1.RamClearIncident
+	var occurred, baseline time.Time
+	var acknowledged sql.NullTime
+	if err := row.Scan(&inc.EventId, &inc.EquipmentId, &occurred, &baseline, &inc.AcknowledgedBy, &acknowledged, &inc.AcknowledgmentReason); err != nil {
+		return nil, err
+	}
+	inc.OccurredAt = occurred.UTC().Format(time.RFC3339Nano)
+	inc.BaselineResetAt = baseline.UTC().Format(time.RFC3339Nano)
+	if acknowledged.Valid {
+		inc.AcknowledgedAt = acknowledged.Time.UTC().Format(time.RFC3339Nano)
+	}
+	return &inc, nil
+}
+
+func (s *EventsService) getRAMClearIncidentFromDB(ctx context.Context, eventID string) (*rgsv1.RamClearIncident, error) {
+	inc, err := scanRAMClearIncident(s.db.QueryRowContext(ctx, `SELECT `+ramClearIncidentColumns+` FROM ram_clear_incidents WHERE event_id = $1`, eventID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return inc, err
+}
+
+func (s *EventsService) listRAMClearIncidentsFromDB(ctx context.Context, equipmentID string, unacknowledgedOnly bool) ([]*rgsv1.RamClearIncident, error) {
+	q := `SELECT ` + ramClearIncidentColumns + `
+FROM ram_clear_incidents
+WHERE ($1 = '' OR equipment_id = $1)
+  AND (NOT $2 OR acknowledged_at IS NULL)
+ORDER BY occurred_at ASC, event_id ASC
+`
+	rows, err := s.db.QueryContext(ctx, q, equipmentID, unacknowledgedOnly)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]*rgsv1.RamClearIncident, 0)
+	for rows.Next() {
+		inc, err := scanRAMClearIncident(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, inc)
+	}
+	return out, rows.Err()
+}
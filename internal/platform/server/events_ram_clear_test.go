@@ -0,0 +1,106 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func TestRAMClearResetsBaselineAndNeedsAcknowledgment(t *testing.T) {
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 13, 12, 0, 0, 0, time.UTC)}
+	svc := NewEventsService(clk)
+	svc.SetClockSkewThreshold(2*time.Hour, 0)
+	ctx := context.Background()
+	egm := meta("egm-7", rgsv1.ActorType_ACTOR_TYPE_SERVICE, "")
+	op := meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+	snapshot := func(id string, value int64, at string) *rgsv1.MeterRecord {
+		t.Helper()
+		resp, _ := svc.SubmitMeterSnapshot(ctx, &rgsv1.SubmitMeterSnapshotRequest{Meta: egm, Meter: &rgsv1.MeterRecord{
+			MeterId: id, EquipmentId: "cab-7", MeterLabel: "coin_in", MonetaryUnit: "USD", ValueMinor: value, OccurredAt: at,
+		}})
+		if resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("submit %s: %+v", id, resp.Meta)
+		}
+		return resp.Meter
+	}
+
+	snapshot("m-1", 500_000, "2026-02-13T11:00:00Z")
+	svc.SubmitSignificantEvent(ctx, &rgsv1.SubmitSignificantEventRequest{Meta: egm, Event: &rgsv1.SignificantEvent{
+		EventId: "ram-1", EquipmentId: "cab-7", EventCode: RAMClearEventCode, Severity: rgsv1.EventSeverity_EVENT_SEVERITY_CRITICAL, OccurredAt: "2026-02-13T11:05:00Z",
+	}})
+	if after := snapshot("m-2", 0, "2026-02-13T11:10:00Z"); after.Anomaly != rgsv1.MeterAnomaly_METER_ANOMALY_UNSPECIFIED {
+		t.Fatalf("expected the snapshot after a ram clear to start a new baseline, got %v", after.Anomaly)
+	}
+	if late := snapshot("m-0", 400_000, "2026-02-13T11:02:00Z"); late.Anomaly != rgsv1.MeterAnomaly_METER_ANOMALY_REGRESSION {
+		t.Fatalf("expected a late snapshot from before the clear to compare with the old baseline, got %v", late.Anomaly)
+	}
+
+	pending, _ := svc.ListRamClearIncidents(ctx, &rgsv1.ListRamClearIncidentsRequest{Meta: op, UnacknowledgedOnly: true})
+	if len(pending.Incidents) != 1 || pending.Incidents[0].BaselineResetAt != "2026-02-13T11:05:00Z" {
+		t.Fatalf("expected one pending incident, got %+v", pending.Incidents)
+	}
+	if resp, _ := svc.AcknowledgeRamClear(ctx, &rgsv1.AcknowledgeRamClearRequest{Meta: egm, EventId: "ram-1", Reason: "self"}); resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected service acknowledgment to be denied, got %v", resp.Meta.ResultCode)
+	}
+	if resp, _ := svc.AcknowledgeRamClear(ctx, &rgsv1.AcknowledgeRamClearRequest{Meta: op, EventId: "ram-1"}); resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected acknowledgment without reason to be invalid, got %v", resp.Meta.ResultCode)
+	}
+	if resp, _ := svc.AcknowledgeRamClear(ctx, &rgsv1.AcknowledgeRamClearRequest{Meta: op, EventId: "ev-404", Reason: "x"}); resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected unknown incident to be invalid, got %v", resp.Meta.ResultCode)
+	}
+
+	reporting := NewReportingService(clk, NewLedgerService(clk), svc)
+	report := func() map[string]any {
+		t.Helper()
+		resp, _ := reporting.GenerateReport(ctx, &rgsv1.GenerateReportRequest{
+			Meta:       op,
+			ReportType: rgsv1.ReportType_REPORT_TYPE_SIGNIFICANT_EVENTS_ALTERATIONS,
+			Interval:   rgsv1.ReportInterval_REPORT_INTERVAL_DTD,
+			Format:     rgsv1.ReportFormat_REPORT_FORMAT_JSON,
+		})
+		var payload map[string]any
+		if err := json.Unmarshal(resp.ReportRun.Content, &payload); err != nil {
+			t.Fatalf("unmarshal report content: %v", err)
+		}
+		return payload
+	}
+	if payload := report(); payload["ram_clears_pending"] != float64(1) {
+		t.Fatalf("expected a pending ram clear in the report, got %v", payload["ram_clears"])
+	}
+
+	acked, _ := svc.AcknowledgeRamClear(ctx, &rgsv1.AcknowledgeRamClearRequest{Meta: op, EventId: "ram-1", Reason: "logic door inspected, seals intact"})
+	if acked.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK || acked.Incident.AcknowledgedBy != "op-1" || acked.Incident.AcknowledgedAt == "" {
+		t.Fatalf("unexpected acknowledgment: %+v", acked)
+	}
+	if again, _ := svc.AcknowledgeRamClear(ctx, &rgsv1.AcknowledgeRamClearRequest{Meta: op, EventId: "ram-1", Reason: "again"}); again.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected a second acknowledgment to be invalid, got %v", again.Meta.ResultCode)
+	}
+	payload := report()
+	rows, _ := payload["ram_clears"].([]any)
+	if payload["ram_clears_pending"] != float64(0) || len(rows) != 1 {
+		t.Fatalf("unexpected ram clears in report: %v", payload)
+	}
+	if row, _ := rows[0].(map[string]any); row["status"] != "acknowledged" || row["acknowledgment_reason"] != "logic door inspected, seals intact" {
+		t.Fatalf("unexpected ram clear row: %v", row)
+	}
+
+	actions := map[string]int{}
+	for _, ev := range auditEvents(svc.AuditStore) {
+		actions[ev.Action]++
+	}
+	if actions["reset_meter_baseline"] != 1 || actions["acknowledge_ram_clear"] != 2 {
+		t.Fatalf("unexpected audit actions: %v", actions)
+	}
+
+	csv, err := payloadToCSV(rgsv1.ReportType_REPORT_TYPE_SIGNIFICANT_EVENTS_ALTERATIONS, map[string]any{
+		"rows":       []map[string]any{},
+		"ram_clears": []map[string]any{ramClearReportRow(acked.Incident)},
+	})
+	if err != nil || !strings.Contains(string(csv), "ram_clear_event_id") || !strings.Contains(string(csv), "ram-1,cab-7") {
+		t.Fatalf("expected ram clears in csv: %v %s", err, csv)
+	}
+}
@@ -0,0 +1,305 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+const (
+	sasSendMetersPoll  = 0x1C
+	sasGeneralPollFlag = 0x80
+	sasNoActivity      = 0x00
+
+	// sasMaxExceptionsPerPoll bounds how many queued exceptions one run
+	// drains from a machine, so a chattering machine cannot starve the rest.
+	sasMaxExceptionsPerPoll = 32
+	sasDefaultReadTimeout   = 2 * time.Second
+)
+
+// sasActor is recorded as the actor of every record the bridge submits.
+var sasActor = &rgsv1.Actor{ActorId: "sas-bridge", ActorType: rgsv1.ActorType_ACTOR_TYPE_SERVICE}
+
+// SASMachine is a legacy machine on the SAS bus behind the gateway.
+type SASMachine struct {
+	EquipmentID string
+	Address     byte
+}
+
+// ParseSASMachines parses comma-separated equipment_id=address entries,
+// where address is the machine's SAS address in 1..127.
+func ParseSASMachines(spec string) ([]SASMachine, error) {
+	var out []SASMachine
+	seen := make(map[byte]string)
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		id, addr, ok := strings.Cut(part, "=")
+		id = strings.TrimSpace(id)
+		n, err := strconv.Atoi(strings.TrimSpace(addr))
+		if !ok || id == "" || err != nil || n < 1 || n > 127 {
+			return nil, fmt.Errorf("invalid SAS machine %q: want equipment_id=address with address in 1..127", part)
+		}
+		if other, dup := seen[byte(n)]; dup {
+			return nil, fmt.Errorf("SAS address %d is assigned to both %s and %s", n, other, id)
+		}
+		seen[byte(n)] = id
+		out = append(out, SASMachine{EquipmentID: id, Address: byte(n)})
+	}
+	return out, nil
+}
+
+// sasMeter names one meter of the send meters (0x1C) long poll response, in
+// response order. Credit meters are scaled by the accounting denomination.
+type sasMeter struct {
+	label  string
+	credit bool
+}
+
+var sasSendMeters = []sasMeter{
+	{"SAS_totalCoinIn", true},
+	{"SAS_totalCoinOut", true},
+	{"SAS_totalDrop", true},
+	{"SAS_totalJackpot", true},
+	{"SAS_gamesPlayed", false},
+	{"SAS_gamesWon", false},
+	{"SAS_slotDoorOpened", false},
+	{"SAS_powerReset", false},
+}
+
+type sasException struct {
+	description string
+	severity    rgsv1.EventSeverity
+}
+
+var sasExceptions = map[byte]sasException{
+	0x11: {"Slot door was opened", rgsv1.EventSeverity_EVENT_SEVERITY_WARN},
+	0x12: {"Slot door was closed", rgsv1.EventSeverity_EVENT_SEVERITY_INFO},
+	0x13: {"Drop door was opened", rgsv1.EventSeverity_EVENT_SEVERITY_WARN},
+	0x14: {"Drop door was closed", rgsv1.EventSeverity_EVENT_SEVERITY_INFO},
+	0x15: {"Card cage was opened", rgsv1.EventSeverity_EVENT_SEVERITY_CRITICAL},
+	0x16: {"Card cage was closed", rgsv1.EventSeverity_EVENT_SEVERITY_INFO},
+	0x17: {"AC power was applied to gaming machine", rgsv1.EventSeverity_EVENT_SEVERITY_INFO},
+	0x18: {"AC power was lost from gaming machine", rgsv1.EventSeverity_EVENT_SEVERITY_WARN},
+	0x19: {"Cashbox door was opened", rgsv1.EventSeverity_EVENT_SEVERITY_WARN},
+	0x1A: {"Cashbox door was closed", rgsv1.EventSeverity_EVENT_SEVERITY_INFO},
+	0x1B: {"Cashbox was removed", rgsv1.EventSeverity_EVENT_SEVERITY_WARN},
+	0x1C: {"Cashbox was installed", rgsv1.EventSeverity_EVENT_SEVERITY_INFO},
+	0x1D: {"Belly door was opened", rgsv1.EventSeverity_EVENT_SEVERITY_WARN},
+	0x1E: {"Belly door was closed", rgsv1.EventSeverity_EVENT_SEVERITY_INFO},
+	0x20: {"General tilt", rgsv1.EventSeverity_EVENT_SEVERITY_CRITICAL},
+	0x21: {"Coin in tilt", rgsv1.EventSeverity_EVENT_SEVERITY_CRITICAL},
+	0x22: {"Coin out tilt", rgsv1.EventSeverity_EVENT_SEVERITY_CRITICAL},
+	0x27: {"Cashbox full detected", rgsv1.EventSeverity_EVENT_SEVERITY_WARN},
+	0x28: {"Bill jam", rgsv1.EventSeverity_EVENT_SEVERITY_WARN},
+	0x29: {"Bill acceptor hardware failure", rgsv1.EventSeverity_EVENT_SEVERITY_CRITICAL},
+	0x3D: {"A cash out ticket has been printed", rgsv1.EventSeverity_EVENT_SEVERITY_INFO},
+	0x51: {"Handpay is pending", rgsv1.EventSeverity_EVENT_SEVERITY_WARN},
+	0x52: {"Handpay was reset", rgsv1.EventSeverity_EVENT_SEVERITY_INFO},
+	0x60: {"Printer communication error", rgsv1.EventSeverity_EVENT_SEVERITY_WARN},
+	0x61: {"Printer paper out error", rgsv1.EventSeverity_EVENT_SEVERITY_WARN},
+}
+
+// sasCRC is the CRC-16/KERMIT checksum SAS appends, low byte first, to
+// long poll responses.
+func sasCRC(data []byte) uint16 {
+	var crc uint16
+	for _, b := range data {
+		q := (crc ^ uint16(b)) & 0x0F
+		crc = (crc >> 4) ^ (q * 0x1081)
+		q = (crc ^ uint16(b>>4)) & 0x0F
+		crc = (crc >> 4) ^ (q * 0x1081)
+	}
+	return crc
+}
+
+func sasBCD(b []byte) (int64, bool) {
+	var v int64
+	for _, x := range b {
+		hi, lo := x>>4, x&0x0F
+		if hi > 9 || lo > 9 {
+			return 0, false
+		}
+		v = v*100 + int64(hi)*10 + int64(lo)
+	}
+	return v, true
+}
+
+// SASBridge polls legacy machines over SAS through a TCP gateway to their
+// serial bus, recording exceptions as significant events and meters as
+// snapshots in EventsService. Every record is tagged source=sas.
+type SASBridge struct {
+	Events      *EventsService
+	GatewayAddr string
+	Machines    []SASMachine
+	Currency    string
+	// DenominationMinor is the accounting denomination in minor units of
+	// Currency: the value of one credit on the credit meters.
+	DenominationMinor int64
+	ReadTimeout       time.Duration
+	Dial              func(ctx context.Context, network, addr string) (net.Conn, error)
+
+	seq int64
+}
+
+func NewSASBridge(events *EventsService, gatewayAddr string, machines []SASMachine, currency string, denominationMinor int64) *SASBridge {
+	var d net.Dialer
+	return &SASBridge{
+		Events:            events,
+		GatewayAddr:       gatewayAddr,
+		Machines:          machines,
+		Currency:          strings.ToUpper(currency),
+		DenominationMinor: denominationMinor,
+		ReadTimeout:       sasDefaultReadTimeout,
+		Dial:              d.DialContext,
+	}
+}
+
+func (b *SASBridge) nextID(kind, equipmentID string) string {
+	b.seq++
+	return "sas-" + equipmentID + "-" + kind + "-" + strconv.FormatInt(b.Events.now().UnixNano(), 10) + "-" + strconv.FormatInt(b.seq, 10)
+}
+
+func (b *SASBridge) meta(equipmentID string) *rgsv1.RequestMeta {
+	return &rgsv1.RequestMeta{RequestId: b.nextID("poll", equipmentID), Actor: sasActor}
+}
+
+func (b *SASBridge) tags(m SASMachine) map[string]string {
+	return map[string]string{"source": "sas", "sas_address": strconv.Itoa(int(m.Address))}
+}
+
+func (b *SASBridge) exchange(conn net.Conn, request []byte, want int) ([]byte, error) {
+	if err := conn.SetDeadline(time.Now().Add(b.ReadTimeout)); err != nil {
+		return nil, err
+	}
+	if _, err := conn.Write(request); err != nil {
+		return nil, err
+	}
+	resp := make([]byte, want)
+	if _, err := io.ReadFull(conn, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// pollExceptions drains the machine's exception queue with general polls.
+func (b *SASBridge) pollExceptions(ctx context.Context, conn net.Conn, m SASMachine) (int, error) {
+	recorded := 0
+	for range sasMaxExceptionsPerPoll {
+		resp, err := b.exchange(conn, []byte{sasGeneralPollFlag | m.Address}, 1)
+		if err != nil {
+			return recorded, fmt.Errorf("general poll %s: %w", m.EquipmentID, err)
+		}
+		code := resp[0]
+		if code == sasNoActivity {
+			return recorded, nil
+		}
+		exc, ok := sasExceptions[code]
+		if !ok {
+			exc = sasException{description: fmt.Sprintf("SAS exception 0x%02X", code), severity: rgsv1.EventSeverity_EVENT_SEVERITY_INFO}
+		}
+		out, err := b.Events.SubmitSignificantEvent(ctx, &rgsv1.SubmitSignificantEventRequest{Meta: b.meta(m.EquipmentID), Event: &rgsv1.SignificantEvent{
+			EventId:              b.nextID("exception", m.EquipmentID),
+			EquipmentId:          m.EquipmentID,
+			EventCode:            fmt.Sprintf("SAS_EXCEPTION_%02X", code),
+			LocalizedDescription: exc.description,
+			Severity:             exc.severity,
+			Tags:                 b.tags(m),
+		}})
+		if err != nil {
+			return recorded, err
+		}
+		if out.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+			return recorded, fmt.Errorf("record SAS exception for %s: %s", m.EquipmentID, out.Meta.GetDenialReason())
+		}
+		recorded++
+	}
+	return recorded, nil
+}
+
+// pollMeters reads the send meters long poll and records each meter.
+func (b *SASBridge) pollMeters(ctx context.Context, conn net.Conn, m SASMachine) error {
+	resp, err := b.exchange(conn, []byte{m.Address, sasSendMetersPoll}, 2+4*len(sasSendMeters)+2)
+	if err != nil {
+		return fmt.Errorf("send meters poll %s: %w", m.EquipmentID, err)
+	}
+	body, crc := resp[:len(resp)-2], uint16(resp[len(resp)-2])|uint16(resp[len(resp)-1])<<8
+	if body[0] != m.Address || body[1] != sasSendMetersPoll || sasCRC(body) != crc {
+		return fmt.Errorf("send meters poll %s: malformed response", m.EquipmentID)
+	}
+	for i, meter := range sasSendMeters {
+		value, ok := sasBCD(body[2+4*i : 6+4*i])
+		if !ok {
+			return fmt.Errorf("send meters poll %s: %s is not BCD", m.EquipmentID, meter.label)
+		}
+		unit := ""
+		if meter.credit {
+			unit, value = b.Currency, value*b.DenominationMinor
+		}
+		out, err := b.Events.SubmitMeterSnapshot(ctx, &rgsv1.SubmitMeterSnapshotRequest{Meta: b.meta(m.EquipmentID), Meter: &rgsv1.MeterRecord{
+			MeterId:      b.nextID("meter", m.EquipmentID),
+			EquipmentId:  m.EquipmentID,
+			MeterLabel:   meter.label,
+			MonetaryUnit: unit,
+			ValueMinor:   value,
+			Tags:         b.tags(m),
+		}})
+		if err != nil {
+			return err
+		}
+		if out.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+			return fmt.Errorf("record SAS meter %s for %s: %s", meter.label, m.EquipmentID, out.Meta.GetDenialReason())
+		}
+	}
+	return nil
+}
+
+// PollJob returns a scheduler job that drains each machine's exceptions and
+// reads its meters through the gateway. A machine that fails does not stop
+// the others, but the connection is reopened after it so a late reply cannot
+// be read as the next machine's; the run fails if any machine did.
+func (b *SASBridge) PollJob() JobFunc {
+	return func(ctx context.Context, _ string) (string, error) {
+		var conn net.Conn
+		defer func() {
+			if conn != nil {
+				conn.Close()
+			}
+		}()
+		exceptions, polled := 0, 0
+		var errs []error
+		for _, m := range b.Machines {
+			if conn == nil {
+				c, err := b.Dial(ctx, "tcp", b.GatewayAddr)
+				if err != nil {
+					errs = append(errs, fmt.Errorf("dial SAS gateway: %w", err))
+					continue
+				}
+				conn = c
+			}
+			n, err := b.pollExceptions(ctx, conn, m)
+			exceptions += n
+			if err == nil {
+				err = b.pollMeters(ctx, conn, m)
+			}
+			if err != nil {
+				errs = append(errs, err)
+				conn.Close()
+				conn = nil
+				continue
+			}
+			polled++
+		}
+		summary := fmt.Sprintf("polled %d of %d SAS machines, recorded %d exceptions", polled, len(b.Machines), exceptions)
+		return summary, errors.Join(errs...)
+	}
+}
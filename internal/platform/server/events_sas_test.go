@@ -0,0 +1,108 @@
+package server
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+// fakeSASGateway answers for one machine at address 1 and stays silent for
+// every other address, like a machine that is powered off.
+func fakeSASGateway(conn net.Conn, exceptions []byte, meters []int64) {
+	defer conn.Close()
+	buf := make([]byte, 1)
+	for {
+		if _, err := conn.Read(buf); err != nil {
+			return
+		}
+		switch buf[0] {
+		case sasGeneralPollFlag | 1:
+			code := byte(sasNoActivity)
+			if len(exceptions) > 0 {
+				code, exceptions = exceptions[0], exceptions[1:]
+			}
+			_, _ = conn.Write([]byte{code})
+		case 1:
+			if _, err := conn.Read(buf); err != nil || buf[0] != sasSendMetersPoll {
+				return
+			}
+			resp := []byte{1, sasSendMetersPoll}
+			for _, v := range meters {
+				var bcd [4]byte
+				for i := 3; i >= 0; i-- {
+					bcd[i] = byte(v%10) | byte(v/10%10)<<4
+					v /= 100
+				}
+				resp = append(resp, bcd[:]...)
+			}
+			crc := sasCRC(resp)
+			_, _ = conn.Write(append(resp, byte(crc), byte(crc>>8)))
+		}
+	}
+}
+
+func TestSASBridgeRecordsExceptionsAndMeters(t *testing.T) {
+	svc := NewEventsService(ledgerFixedClock{now: time.Date(2026, 2, 12, 20, 0, 0, 0, time.UTC)})
+	machines, err := ParseSASMachines("cab-1=1, cab-2=2")
+	if err != nil {
+		t.Fatalf("parse machines: %v", err)
+	}
+	bridge := NewSASBridge(svc, "sas-gw:4000", machines, "usd", 5)
+	bridge.ReadTimeout = 50 * time.Millisecond
+	bridge.Dial = func(context.Context, string, string) (net.Conn, error) {
+		client, gateway := net.Pipe()
+		go fakeSASGateway(gateway, []byte{0x11, 0x99}, []int64{12345, 678, 9000, 0, 4321, 1234, 3, 2})
+		return client, nil
+	}
+
+	summary, err := bridge.PollJob()(context.Background(), "")
+	if err == nil || !strings.Contains(err.Error(), "general poll cab-2") {
+		t.Fatalf("expected the silent machine to fail the run, got %v", err)
+	}
+	if summary != "polled 1 of 2 SAS machines, recorded 2 exceptions" {
+		t.Fatalf("unexpected summary %q", summary)
+	}
+
+	meta := &rgsv1.RequestMeta{Actor: &rgsv1.Actor{ActorId: "op-1", ActorType: rgsv1.ActorType_ACTOR_TYPE_OPERATOR}}
+	events, _ := svc.ListEvents(context.Background(), &rgsv1.ListEventsRequest{Meta: meta, EquipmentId: "cab-1"})
+	if len(events.Events) != 2 {
+		t.Fatalf("expected two exceptions, got %+v", events.Events)
+	}
+	door, unknown := events.Events[0], events.Events[1]
+	if door.EventCode != "SAS_EXCEPTION_11" || door.LocalizedDescription != "Slot door was opened" || door.Severity != rgsv1.EventSeverity_EVENT_SEVERITY_WARN || door.Tags["source"] != "sas" || door.Tags["sas_address"] != "1" {
+		t.Fatalf("unexpected door event: %+v", door)
+	}
+	if unknown.EventCode != "SAS_EXCEPTION_99" || unknown.LocalizedDescription != "SAS exception 0x99" {
+		t.Fatalf("unexpected unknown exception: %+v", unknown)
+	}
+
+	meters, _ := svc.ListMeters(context.Background(), &rgsv1.ListMetersRequest{Meta: meta, EquipmentId: "cab-1"})
+	if len(meters.Meters) != len(sasSendMeters) {
+		t.Fatalf("expected %d meters, got %d", len(sasSendMeters), len(meters.Meters))
+	}
+	byLabel := make(map[string]*rgsv1.MeterRecord)
+	for _, m := range meters.Meters {
+		byLabel[m.MeterLabel] = m
+	}
+	if m := byLabel["SAS_totalCoinIn"]; m.ValueMinor != 61725 || m.MonetaryUnit != "USD" || m.Tags["source"] != "sas" {
+		t.Fatalf("expected coin in scaled by the denomination: %+v", m)
+	}
+	if m := byLabel["SAS_gamesPlayed"]; m.ValueMinor != 4321 || m.MonetaryUnit != "" {
+		t.Fatalf("expected unscaled games played: %+v", m)
+	}
+}
+
+func TestSASCRCAndMachineSpec(t *testing.T) {
+	if got := sasCRC([]byte("123456789")); got != 0x2189 {
+		t.Fatalf("expected CRC-16/KERMIT check value 0x2189, got %#04x", got)
+	}
+	for _, spec := range []string{"cab-1", "cab-1=0", "cab-1=128", "=4", "cab-1=1,cab-2=1"} {
+		if _, err := ParseSASMachines(spec); err == nil {
+			t.Fatalf("expected %q to be rejected", spec)
+		}
+	}
+}
@@ -0,0 +1,301 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strconv"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultClockSkewThreshold    = 30 * time.Second
+	defaultClockSkewChronicAfter = 5
+
+	// ClockSkewEventCode marks the maintenance event raised when a device
+	// keeps reporting occurred_at values outside the skew threshold.
+	ClockSkewEventCode = "DEVICE_CLOCK_SKEW"
+)
+
+// clockSkewObservation is the device skew state to persist alongside an
+// ingested record, plus the maintenance event to raise with it, if any.
+type clockSkewObservation struct {
+	device      *rgsv1.DeviceClockSkew
+	maintenance *rgsv1.SignificantEvent
+}
+
+// SetClockSkewThreshold sets how far a device's occurred_at may drift from
+// server receipt time before the sample is flagged, and how many consecutive
+// flagged samples mark the device as a chronic offender.
+func (s *EventsService) SetClockSkewThreshold(threshold time.Duration, chronicAfter int) {
+	if s == nil {
+		return
+	}
+	if threshold <= 0 {
+		threshold = defaultClockSkewThreshold
+	}
+	if chronicAfter <= 0 {
+		chronicAfter = defaultClockSkewChronicAfter
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.skewThreshold = threshold
+	s.skewChronicAfter = chronicAfter
+}
+
+// measureClockSkew returns receivedAt minus the device-reported occurredAt in
+// milliseconds. ok is false when the device did not supply a usable time.
+func measureClockSkew(occurredAt string, receivedAt time.Time) (int64, bool) {
+	if occurredAt == "" {
+		return 0, false
+	}
+	t, err := time.Parse(time.RFC3339Nano, occurredAt)
+	if err != nil {
+		return 0, false
+	}
+	return receivedAt.Sub(t).Milliseconds(), true
+}
+
+func absMillis(v int64) int64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+func (s *EventsService) skewFlagged(skewMs int64) bool {
+	return absMillis(skewMs) > s.skewThreshold.Milliseconds()
+}
+
+func cloneDeviceClockSkew(in *rgsv1.DeviceClockSkew) *rgsv1.DeviceClockSkew {
+	if in == nil {
+		return nil
+	}
+	cp, _ := proto.Clone(in).(*rgsv1.DeviceClockSkew)
+	return cp
+}
+
+// observeClockSkewLocked folds a new skew sample into the device's state and
+// returns the updated state without storing it; callers commit it once the
+// ingested record is persisted.
+func (s *EventsService) observeClockSkewLocked(ctx context.Context, meta *rgsv1.RequestMeta, equipmentID, occurredAt string, receivedAt time.Time) (int64, *clockSkewObservation, error) {
+	skewMs, ok := measureClockSkew(occurredAt, receivedAt)
+	if !ok {
+		return 0, nil, nil
+	}
+	var current *rgsv1.DeviceClockSkew
+	if s.db != nil {
+		var err error
+		if current, err = s.getDeviceClockSkewFromDB(ctx, equipmentID); err != nil {
+			return 0, nil, err
+		}
+	} else if !s.disableInMemoryCache {
+		current = cloneDeviceClockSkew(s.skewByDevice[equipmentID])
+	}
+	if current == nil {
+		current = &rgsv1.DeviceClockSkew{EquipmentId: equipmentID}
+	}
+
+	current.Samples++
+	current.LastSkewMs = skewMs
+	current.LastObservedAt = receivedAt.Format(time.RFC3339Nano)
+	if abs := absMillis(skewMs); abs > current.MaxAbsSkewMs {
+		current.MaxAbsSkewMs = abs
+	}
+	current.Flagged = s.skewFlagged(skewMs)
+	if !current.Flagged {
+		current.ConsecutiveFlagged = 0
+		current.Chronic = false
+		return skewMs, &clockSkewObservation{device: current}, nil
+	}
+	current.FlaggedSamples++
+	current.ConsecutiveFlagged++
+
+	obs := &clockSkewObservation{device: current}
+	if !current.Chronic && int(current.ConsecutiveFlagged) >= s.skewChronicAfter {
+		current.Chronic = true
+		now := receivedAt.Format(time.RFC3339Nano)
+		obs.maintenance = &rgsv1.SignificantEvent{
+			EventId:              "clock-skew-" + equipmentID + "-" + strconv.FormatInt(current.FlaggedSamples, 10),
+			EquipmentId:          equipmentID,
+			EventCode:            ClockSkewEventCode,
+			LocalizedDescription: "device clock skew exceeded threshold on consecutive reports; maintenance required",
+			Severity:             rgsv1.EventSeverity_EVENT_SEVERITY_WARN,
+			OccurredAt:           now,
+			ReceivedAt:           now,
+			RecordedAt:           now,
+			Tags: map[string]string{
+				"category":            "maintenance",
+				"skew_ms":             strconv.FormatInt(skewMs, 10),
+				"consecutive_flagged": strconv.Itoa(int(current.ConsecutiveFlagged)),
+			},
+		}
+		after, _ := json.Marshal(obs.maintenance)
+		if err := s.appendAudit(nil, "significant_event", obs.maintenance.EventId, "raise_clock_skew_maintenance", []byte(`{}`), after, audit.ResultSuccess, "chronic device clock skew"); err != nil {
+			return 0, nil, err
+		}
+	}
+	return skewMs, obs, nil
+}
+
+func (s *EventsService) commitClockSkewLocked(obs *clockSkewObservation) {
+	if obs == nil {
+		return
+	}
+	if obs.maintenance != nil {
+		s.watchers.publish(obs.maintenance)
+	}
+	if s.disableInMemoryCache {
+		return
+	}
+	s.skewByDevice[obs.device.EquipmentId] = obs.device
+	if obs.maintenance != nil {
+		s.events[obs.maintenance.EventId] = obs.maintenance
+		s.eventOrder = append(s.eventOrder, obs.maintenance.EventId)
+	}
+}
+
+func (s *EventsService) GetClockSkewReport(ctx context.Context, req *rgsv1.GetClockSkewReportRequest) (*rgsv1.GetClockSkewReportResponse, error) {
+	if req == nil {
+		req = &rgsv1.GetClockSkewReportRequest{}
+	}
+	if ok, reason := s.authorizeRead(ctx, req.Meta); !ok {
+		s.submitBlocked(req.Meta, "device_clock_skew", "", "get_clock_skew_report", reason)
+		return &rgsv1.GetClockSkewReportResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var devices []*rgsv1.DeviceClockSkew
+	if s.db != nil {
+		var err error
+		if devices, err = s.listDeviceClockSkewFromDB(ctx); err != nil {
+			return &rgsv1.GetClockSkewReportResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+	} else {
+		for _, d := range s.skewByDevice {
+			devices = append(devices, cloneDeviceClockSkew(d))
+		}
+	}
+
+	out := make([]*rgsv1.DeviceClockSkew, 0, len(devices))
+	for _, d := range devices {
+		d.Flagged = s.skewFlagged(d.LastSkewMs)
+		if req.FlaggedOnly && !d.Flagged && !d.Chronic {
+			continue
+		}
+		out = append(out, d)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].ConsecutiveFlagged != out[j].ConsecutiveFlagged {
+			return out[i].ConsecutiveFlagged > out[j].ConsecutiveFlagged
+		}
+		if out[i].MaxAbsSkewMs != out[j].MaxAbsSkewMs {
+			return out[i].MaxAbsSkewMs > out[j].MaxAbsSkewMs
+		}
+		return out[i].EquipmentId < out[j].EquipmentId
+	})
+	return &rgsv1.GetClockSkewReportResponse{
+		Meta:         s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
+		Devices:      out,
+		ThresholdMs:  s.skewThreshold.Milliseconds(),
+		ChronicAfter: int32(s.skewChronicAfter),
+	}, nil
+}
+
+func (s *EventsService) persistClockSkewTx(ctx context.Context, tx *sql.Tx, meta *rgsv1.RequestMeta, obs *clockSkewObservation) error {
+	if obs == nil {
+		return nil
+	}
+	const q = `
+INSERT INTO device_clock_skew (
+  equipment_id, samples, flagged_samples, consecutive_flagged,
+  last_skew_ms, max_abs_skew_ms, chronic, last_observed_at
+) VALUES ($1,$2,$3,$4,$5,$6,$7,$8::timestamptz)
+ON CONFLICT (equipment_id) DO UPDATE SET
+  samples = EXCLUDED.samples,
+  flagged_samples = EXCLUDED.flagged_samples,
+  consecutive_flagged = EXCLUDED.consecutive_flagged,
+  last_skew_ms = EXCLUDED.last_skew_ms,
+  max_abs_skew_ms = EXCLUDED.max_abs_skew_ms,
+  chronic = EXCLUDED.chronic,
+  last_observed_at = EXCLUDED.last_observed_at
+`
+	d := obs.device
+	if _, err := tx.ExecContext(ctx, q,
+		d.EquipmentId,
+		d.Samples,
+		d.FlaggedSamples,
+		d.ConsecutiveFlagged,
+		d.LastSkewMs,
+		d.MaxAbsSkewMs,
+		d.Chronic,
+		nonEmptyTS(d.LastObservedAt),
+	); err != nil {
+		return err
+	}
+	if obs.maintenance != nil {
+		_, err := s.insertSignificantEventTx(ctx, tx, meta, obs.maintenance)
+		return err
+	}
+	return nil
+}
+
+func (s *EventsService) getDeviceClockSkewFromDB(ctx context.Context, equipmentID string) (*rgsv1.DeviceClockSkew, error) {
+	const q = `
+SELECT equipment_id, samples, flagged_samples, consecutive_flagged,
+       last_skew_ms, max_abs_skew_ms, chronic, last_observed_at
+FROM device_clock_skew
+WHERE equipment_id = $1
+`
+	d, err := scanDeviceClockSkew(s.db.QueryRowContext(ctx, q, equipmentID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return d, err
+}
+
+func (s *EventsService) listDeviceClockSkewFromDB(ctx context.Context) ([]*rgsv1.DeviceClockSkew, error) {
+	const q = `
+SELECT equipment_id, samples, flagged_samples, consecutive_flagged,
+       last_skew_ms, max_abs_skew_ms, chronic, last_observed_at
+FROM device_clock_skew
+ORDER BY equipment_id ASC
+`
+	rows, err := s.db.QueryContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]*rgsv1.DeviceClockSkew, 0)
+	for rows.Next() {
+		d, err := scanDeviceClockSkew(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, d)
+	}
+	return out, rows.Err()
+}
+
+type deviceClockSkewScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanDeviceClockSkew(row deviceClockSkewScanner) (*rgsv1.DeviceClockSkew, error) {
+	var d rgsv1.DeviceClockSkew
+	var observed time.Time
+	if err := row.Scan(&d.EquipmentId, &d.Samples, &d.FlaggedSamples, &d.ConsecutiveFlagged,
+		&d.LastSkewMs, &d.MaxAbsSkewMs, &d.Chronic, &observed); err != nil {
+		return nil, err
+	}
+	d.LastObservedAt = observed.UTC().Format(time.RFC3339Nano)
+	return &d, nil
+}
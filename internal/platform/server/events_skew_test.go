@@ -0,0 +1,107 @@
+package server
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func submitSkewedMeter(t *testing.T, svc *EventsService, id, equipmentID string, occurredAt time.Time) *rgsv1.MeterRecord {
+	t.Helper()
+	resp, _ := svc.SubmitMeterSnapshot(context.Background(), &rgsv1.SubmitMeterSnapshotRequest{
+		Meta: meta("svc-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, ""),
+		Meter: &rgsv1.MeterRecord{
+			MeterId:      id,
+			EquipmentId:  equipmentID,
+			MeterLabel:   "coin_in",
+			MonetaryUnit: "USD",
+			OccurredAt:   occurredAt.Format(time.RFC3339Nano),
+		},
+	})
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("submit meter %s: %+v", id, resp.Meta)
+	}
+	return resp.Meter
+}
+
+func TestEventsAnnotateClockSkew(t *testing.T) {
+	now := time.Date(2026, 2, 21, 9, 0, 0, 0, time.UTC)
+	svc := NewEventsService(ledgerFixedClock{now: now})
+
+	ev, _ := svc.SubmitSignificantEvent(context.Background(), &rgsv1.SubmitSignificantEventRequest{
+		Meta: meta("svc-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, ""),
+		Event: &rgsv1.SignificantEvent{
+			EventId:     "ev-skew",
+			EquipmentId: "eq-skew",
+			EventCode:   "E100",
+			OccurredAt:  now.Add(-90 * time.Second).Format(time.RFC3339Nano),
+		},
+	})
+	if ev.Event.GetClockSkewMs() != 90000 {
+		t.Fatalf("expected event skew 90000ms, got=%d", ev.Event.GetClockSkewMs())
+	}
+	// A device clock running ahead of the server reports negative skew.
+	m := submitSkewedMeter(t, svc, "m-ahead", "eq-ahead", now.Add(2*time.Second))
+	if m.GetClockSkewMs() != -2000 {
+		t.Fatalf("expected meter skew -2000ms, got=%d", m.GetClockSkewMs())
+	}
+
+	report, _ := svc.GetClockSkewReport(context.Background(), &rgsv1.GetClockSkewReportRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")})
+	if len(report.Devices) != 2 || report.ThresholdMs != 30000 {
+		t.Fatalf("unexpected skew report: %+v", report)
+	}
+	if d := report.Devices[0]; d.EquipmentId != "eq-skew" || !d.Flagged || d.ConsecutiveFlagged != 1 || d.Chronic {
+		t.Fatalf("expected eq-skew flagged first, got=%+v", d)
+	}
+	if d := report.Devices[1]; d.EquipmentId != "eq-ahead" || d.Flagged {
+		t.Fatalf("expected eq-ahead within threshold, got=%+v", d)
+	}
+
+	flagged, _ := svc.GetClockSkewReport(context.Background(), &rgsv1.GetClockSkewReportRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), FlaggedOnly: true})
+	if len(flagged.Devices) != 1 || flagged.Devices[0].EquipmentId != "eq-skew" {
+		t.Fatalf("expected only flagged devices, got=%+v", flagged.Devices)
+	}
+}
+
+func TestEventsChronicClockSkewRaisesMaintenanceEvent(t *testing.T) {
+	now := time.Date(2026, 2, 21, 9, 0, 0, 0, time.UTC)
+	svc := NewEventsService(ledgerFixedClock{now: now})
+	svc.SetClockSkewThreshold(10*time.Second, 3)
+
+	for i := 0; i < 4; i++ {
+		submitSkewedMeter(t, svc, "m-"+strconv.Itoa(i), "eq-drift", now.Add(-time.Minute))
+	}
+	events, _ := svc.ListEvents(context.Background(), &rgsv1.ListEventsRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), EquipmentId: "eq-drift"})
+	if len(events.Events) != 1 {
+		t.Fatalf("expected a single maintenance event, got=%d", len(events.Events))
+	}
+	if e := events.Events[0]; e.EventCode != ClockSkewEventCode || e.Severity != rgsv1.EventSeverity_EVENT_SEVERITY_WARN || e.Tags["consecutive_flagged"] != "3" {
+		t.Fatalf("unexpected maintenance event: %+v", e)
+	}
+
+	// A report within threshold clears the chronic state, so a later run of
+	// skewed reports raises a fresh maintenance event.
+	submitSkewedMeter(t, svc, "m-ok", "eq-drift", now)
+	report, _ := svc.GetClockSkewReport(context.Background(), &rgsv1.GetClockSkewReportRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")})
+	if d := report.Devices[0]; d.Chronic || d.ConsecutiveFlagged != 0 || d.Samples != 5 || d.FlaggedSamples != 4 || d.MaxAbsSkewMs != 60000 {
+		t.Fatalf("unexpected device state after recovery: %+v", d)
+	}
+	for i := 0; i < 3; i++ {
+		submitSkewedMeter(t, svc, "m-again-"+strconv.Itoa(i), "eq-drift", now.Add(-time.Minute))
+	}
+	events, _ = svc.ListEvents(context.Background(), &rgsv1.ListEventsRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), EquipmentId: "eq-drift"})
+	if len(events.Events) != 2 || events.Events[1].EventId == events.Events[0].EventId {
+		t.Fatalf("expected maintenance event re-raised, got=%+v", events.Events)
+	}
+}
+
+func TestEventsClockSkewReportRequiresOperator(t *testing.T) {
+	svc := NewEventsService(ledgerFixedClock{now: time.Date(2026, 2, 21, 9, 0, 0, 0, time.UTC)})
+	resp, _ := svc.GetClockSkewReport(context.Background(), &rgsv1.GetClockSkewReportRequest{Meta: meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "")})
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected player denied, got=%v", resp.Meta.GetResultCode())
+	}
+}
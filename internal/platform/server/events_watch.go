@@ -0,0 +1,131 @@
+package server
+
+import (
+	"encoding/json"
+	"sync"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+// eventWatchBuffer bounds the events queued for one watcher. A watcher that
+// falls further behind is disconnected rather than stalling ingestion.
+const eventWatchBuffer = 256
+
+type eventWatchSubscription struct {
+	equipmentID string
+	group       equipmentFilter
+	minSeverity rgsv1.EventSeverity
+	events      chan *rgsv1.SignificantEvent
+	reason      string
+}
+
+func (sub *eventWatchSubscription) matches(e *rgsv1.SignificantEvent) bool {
+	if sub.equipmentID != "" && sub.equipmentID != e.EquipmentId {
+		return false
+	}
+	if !sub.group.allows(e.EquipmentId) {
+		return false
+	}
+	return e.Severity >= sub.minSeverity
+}
+
+// eventWatchHub fans recorded significant events out to the watchers whose
+// filter they match. It only sees events recorded by this process.
+type eventWatchHub struct {
+	mu   sync.Mutex
+	subs map[*eventWatchSubscription]struct{}
+}
+
+func newEventWatchHub() *eventWatchHub {
+	return &eventWatchHub{subs: make(map[*eventWatchSubscription]struct{})}
+}
+
+func (h *eventWatchHub) subscribe(equipmentID string, group equipmentFilter, minSeverity rgsv1.EventSeverity) *eventWatchSubscription {
+	sub := &eventWatchSubscription{equipmentID: equipmentID, group: group, minSeverity: minSeverity, events: make(chan *rgsv1.SignificantEvent, eventWatchBuffer)}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.subs[sub] = struct{}{}
+	return sub
+}
+
+func (h *eventWatchHub) unsubscribe(sub *eventWatchSubscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.subs[sub]; ok {
+		delete(h.subs, sub)
+		close(sub.events)
+	}
+}
+
+func (h *eventWatchHub) publish(e *rgsv1.SignificantEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for sub := range h.subs {
+		if !sub.matches(e) {
+			continue
+		}
+		select {
+		case sub.events <- cloneEvent(e):
+		default:
+			sub.reason = "watch fell behind"
+			close(sub.events)
+			delete(h.subs, sub)
+		}
+	}
+}
+
+func (h *eventWatchHub) closeReason(sub *eventWatchSubscription) string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return sub.reason
+}
+
+// WatchSignificantEvents pushes every significant event recorded for the
+// equipment at or above min_severity for as long as the stream is open. The
+// first message acknowledges the subscription; a watcher that falls behind
+// is ended with an ERROR message and should catch up with ListEvents.
+func (s *EventsService) WatchSignificantEvents(req *rgsv1.WatchSignificantEventsRequest, stream rgsv1.EventsService_WatchSignificantEventsServer) error {
+	ctx := stream.Context()
+	if req == nil {
+		req = &rgsv1.WatchSignificantEventsRequest{}
+	}
+	if _, ok := rgsv1.EventSeverity_name[int32(req.MinSeverity)]; !ok {
+		return stream.Send(&rgsv1.WatchSignificantEventsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "min_severity is invalid")})
+	}
+	if ok, reason := s.authorizeRead(ctx, req.Meta); !ok {
+		s.submitBlocked(req.Meta, "significant_event", "", "watch_significant_events", reason)
+		return stream.Send(&rgsv1.WatchSignificantEventsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)})
+	}
+
+	group, code, reason := s.Registry.equipmentFilterForGroup(ctx, req.GroupId)
+	if code != rgsv1.ResultCode_RESULT_CODE_OK {
+		return stream.Send(&rgsv1.WatchSignificantEventsResponse{Meta: s.responseMeta(req.Meta, code, reason)})
+	}
+
+	sub := s.watchers.subscribe(req.EquipmentId, group, req.MinSeverity)
+	defer s.watchers.unsubscribe(sub)
+	after, _ := json.Marshal(map[string]string{"equipment_id": req.EquipmentId, "group_id": req.GroupId, "min_severity": req.MinSeverity.String()})
+	s.mu.Lock()
+	err := s.appendAudit(req.Meta, "significant_event", "", "watch_significant_events", []byte(`{}`), after, audit.ResultSuccess, "")
+	s.mu.Unlock()
+	if err != nil {
+		return stream.Send(&rgsv1.WatchSignificantEventsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")})
+	}
+	if err := stream.Send(&rgsv1.WatchSignificantEventsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, "")}); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case e, ok := <-sub.events:
+			if !ok {
+				return stream.Send(&rgsv1.WatchSignificantEventsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, s.watchers.closeReason(sub))})
+			}
+			if err := stream.Send(&rgsv1.WatchSignificantEventsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Event: e}); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func newEventsWatchClient(t *testing.T, svc *EventsService) rgsv1.EventsServiceClient {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	rgsv1.RegisterEventsServiceServer(srv, svc)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("dial bufconn: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return rgsv1.NewEventsServiceClient(conn)
+}
+
+func TestWatchSignificantEventsFiltersByEquipmentAndSeverity(t *testing.T) {
+	svc := NewEventsService(ledgerFixedClock{now: time.Date(2026, 2, 13, 10, 0, 0, 0, time.UTC)})
+	client := newEventsWatchClient(t, svc)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if stream, err := client.WatchSignificantEvents(ctx, &rgsv1.WatchSignificantEventsRequest{Meta: meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "")}); err != nil {
+		t.Fatalf("open stream: %v", err)
+	} else if resp, err := stream.Recv(); err != nil || resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected player watch to be denied: resp=%+v err=%v", resp, err)
+	}
+
+	stream, err := client.WatchSignificantEvents(ctx, &rgsv1.WatchSignificantEventsRequest{
+		Meta:        meta("floor-dashboard", rgsv1.ActorType_ACTOR_TYPE_SERVICE, ""),
+		EquipmentId: "cab-1",
+		MinSeverity: rgsv1.EventSeverity_EVENT_SEVERITY_WARN,
+	})
+	if err != nil {
+		t.Fatalf("open stream: %v", err)
+	}
+	ack, err := stream.Recv()
+	if err != nil || ack.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || ack.Event != nil {
+		t.Fatalf("expected subscription ack: resp=%+v err=%v", ack, err)
+	}
+
+	submit := func(id, equipmentID, code string, sev rgsv1.EventSeverity) {
+		t.Helper()
+		resp, err := svc.SubmitSignificantEvent(context.Background(), &rgsv1.SubmitSignificantEventRequest{
+			Meta:  meta("cab-agent", rgsv1.ActorType_ACTOR_TYPE_SERVICE, ""),
+			Event: &rgsv1.SignificantEvent{EventId: id, EquipmentId: equipmentID, EventCode: code, Severity: sev, OccurredAt: "2026-02-13T09:59:59Z"},
+		})
+		if err != nil || resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("submit %s: %v %+v", id, err, resp)
+		}
+	}
+	submit("ev-1", "cab-2", "DOOR_OPEN", rgsv1.EventSeverity_EVENT_SEVERITY_WARN)
+	submit("ev-2", "cab-1", "BILL_STACKED", rgsv1.EventSeverity_EVENT_SEVERITY_INFO)
+	submit("ev-3", "cab-1", "DOOR_OPEN", rgsv1.EventSeverity_EVENT_SEVERITY_WARN)
+	submit("ev-3", "cab-1", "DOOR_OPEN", rgsv1.EventSeverity_EVENT_SEVERITY_WARN)
+	submit("ev-4", "cab-1", "RAM_CLEAR", rgsv1.EventSeverity_EVENT_SEVERITY_CRITICAL)
+
+	for _, want := range []string{"ev-3", "ev-4"} {
+		resp, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("receive event: %v", err)
+		}
+		if resp.Event.GetEventId() != want || resp.Event.RecordedAt == "" {
+			t.Fatalf("expected %s, got %+v", want, resp.Event)
+		}
+	}
+
+	var watched bool
+	for _, ev := range auditEvents(svc.AuditStore) {
+		if ev.Action == "watch_significant_events" && ev.ActorID == "floor-dashboard" {
+			watched = true
+		}
+	}
+	if !watched {
+		t.Fatalf("expected the subscription to be audited")
+	}
+}
+
+func TestEventWatchHubDisconnectsSlowWatcher(t *testing.T) {
+	hub := newEventWatchHub()
+	sub := hub.subscribe("", nil, rgsv1.EventSeverity_EVENT_SEVERITY_UNSPECIFIED)
+	for i := 0; i <= eventWatchBuffer; i++ {
+		hub.publish(&rgsv1.SignificantEvent{EventId: "ev", EquipmentId: "cab-1"})
+	}
+	n := 0
+	for range sub.events {
+		n++
+	}
+	if n != eventWatchBuffer || hub.closeReason(sub) != "watch fell behind" {
+		t.Fatalf("expected slow watcher to be closed after %d events, got %d (%q)", eventWatchBuffer, n, hub.closeReason(sub))
+	}
+	hub.unsubscribe(sub)
+}
@@ -1158,7 +1158,7 @@ func TestExtensionsGatewayParity_ValidationErrors(t *testing.T) {
 	}
 	assertGatewayMetaFields(t, oversizedUIPageSizeResp.GetMeta(), "")
 
-	promoEvents := promoSvc.AuditStore.Events()
+	promoEvents := auditEvents(promoSvc.AuditStore)
 	if !hasAuditEvent(promoEvents, "record_bonus_transaction", audit.ResultDenied) {
 		t.Fatalf("expected denied promo audit for invalid/unauthorized bonus path, got=%v", promoEvents)
 	}
@@ -1226,7 +1226,7 @@ func TestExtensionsGatewayParity_ValidationErrors(t *testing.T) {
 		t.Fatalf("expected promo audit reason invalid page_size, got=%v", promoEvents)
 	}
 
-	uiEvents := uiSvc.AuditStore.Events()
+	uiEvents := auditEvents(uiSvc.AuditStore)
 	if !hasAuditEvent(uiEvents, "submit_system_window_event", audit.ResultDenied) {
 		t.Fatalf("expected denied ui audit for invalid/unauthorized submit path, got=%v", uiEvents)
 	}
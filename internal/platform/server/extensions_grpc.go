@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"strconv"
 	"sync"
 	"time"
@@ -18,7 +19,7 @@ type PromotionsService struct {
 	rgsv1.UnimplementedPromotionsServiceServer
 
 	Clock      clock.Clock
-	AuditStore *audit.InMemoryStore
+	AuditStore audit.Store
 
 	mu                   sync.Mutex
 	bonusTx              map[string]*rgsv1.BonusTransaction
@@ -39,7 +40,7 @@ func NewPromotionsService(clk clock.Clock, db ...*sql.DB) *PromotionsService {
 	}
 	return &PromotionsService{
 		Clock:      clk,
-		AuditStore: audit.NewInMemoryStore(),
+		AuditStore: NewAuditStore(handle),
 		bonusTx:    make(map[string]*rgsv1.BonusTransaction),
 		awards:     make(map[string]*rgsv1.PromotionalAward),
 		db:         handle,
@@ -100,38 +101,8 @@ func (s *PromotionsService) nextAuditIDLocked() string {
 }
 
 func (s *PromotionsService) appendAudit(meta *rgsv1.RequestMeta, objectType, objectID, action string, before, after []byte, result audit.Result, reason string) error {
-	if s.AuditStore == nil {
-		return audit.ErrCorruptChain
-	}
-	actorID := "system"
-	actorType := "service"
-	if meta != nil && meta.Actor != nil {
-		actorID = meta.Actor.ActorId
-		actorType = meta.Actor.ActorType.String()
-	}
-	now := s.now()
-	ev := audit.Event{
-		AuditID:      s.nextAuditIDLocked(),
-		OccurredAt:   now,
-		RecordedAt:   now,
-		ActorID:      actorID,
-		ActorType:    actorType,
-		ObjectType:   objectType,
-		ObjectID:     objectID,
-		Action:       action,
-		Before:       before,
-		After:        after,
-		Result:       result,
-		Reason:       reason,
-		PartitionDay: now.Format("2006-01-02"),
-	}
-	if s.db != nil {
-		if err := appendAuditEventToDB(context.Background(), s.db, ev); err != nil {
-			return err
-		}
-	}
-	_, err := s.AuditStore.Append(ev)
-	return err
+	ev := newAuditEvent(meta, s.nextAuditIDLocked(), s.now(), objectType, objectID, action, before, after, result, reason)
+	return appendAuditTo(context.Background(), s.AuditStore, ev)
 }
 
 func cloneBonusTx(in *rgsv1.BonusTransaction) *rgsv1.BonusTransaction {
@@ -350,7 +321,12 @@ type UISystemOverlayService struct {
 	rgsv1.UnimplementedUISystemOverlayServiceServer
 
 	Clock      clock.Clock
-	AuditStore *audit.InMemoryStore
+	AuditStore audit.Store
+	// Registry resolves the group_id filter on ListSystemWindowEvents.
+	Registry *RegistryService
+	// Sessions, when set, is told when a device closes a reality check
+	// window so the player can wager again.
+	Sessions *SessionsService
 
 	mu                   sync.Mutex
 	events               map[string]*rgsv1.SystemWindowEvent
@@ -359,6 +335,7 @@ type UISystemOverlayService struct {
 	nextAuditID          int64
 	db                   *sql.DB
 	disableInMemoryCache bool
+	watchers             *systemWindowWatchHub
 }
 
 func NewUISystemOverlayService(clk clock.Clock, db ...*sql.DB) *UISystemOverlayService {
@@ -368,9 +345,10 @@ func NewUISystemOverlayService(clk clock.Clock, db ...*sql.DB) *UISystemOverlayS
 	}
 	return &UISystemOverlayService{
 		Clock:      clk,
-		AuditStore: audit.NewInMemoryStore(),
+		AuditStore: NewAuditStore(handle),
 		events:     make(map[string]*rgsv1.SystemWindowEvent),
 		db:         handle,
+		watchers:   newSystemWindowWatchHub(),
 	}
 }
 
@@ -423,38 +401,8 @@ func (s *UISystemOverlayService) nextAuditIDLocked() string {
 }
 
 func (s *UISystemOverlayService) appendAudit(meta *rgsv1.RequestMeta, objectID, action string, before, after []byte, result audit.Result, reason string) error {
-	if s.AuditStore == nil {
-		return audit.ErrCorruptChain
-	}
-	actorID := "system"
-	actorType := "service"
-	if meta != nil && meta.Actor != nil {
-		actorID = meta.Actor.ActorId
-		actorType = meta.Actor.ActorType.String()
-	}
-	now := s.now()
-	ev := audit.Event{
-		AuditID:      s.nextAuditIDLocked(),
-		OccurredAt:   now,
-		RecordedAt:   now,
-		ActorID:      actorID,
-		ActorType:    actorType,
-		ObjectType:   "system_window_event",
-		ObjectID:     objectID,
-		Action:       action,
-		Before:       before,
-		After:        after,
-		Result:       result,
-		Reason:       reason,
-		PartitionDay: now.Format("2006-01-02"),
-	}
-	if s.db != nil {
-		if err := appendAuditEventToDB(context.Background(), s.db, ev); err != nil {
-			return err
-		}
-	}
-	_, err := s.AuditStore.Append(ev)
-	return err
+	ev := newAuditEvent(meta, s.nextAuditIDLocked(), s.now(), "system_window_event", objectID, action, before, after, result, reason)
+	return appendAuditTo(context.Background(), s.AuditStore, ev)
 }
 
 func cloneSystemWindowEvent(in *rgsv1.SystemWindowEvent) *rgsv1.SystemWindowEvent {
@@ -525,9 +473,26 @@ func (s *UISystemOverlayService) SubmitSystemWindowEvent(ctx context.Context, re
 		return &rgsv1.SubmitSystemWindowEventResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
 	}
 	s.mu.Lock()
-	defer s.mu.Unlock()
+	ev, failure := s.recordSystemWindowEventLocked(ctx, req.Meta, cloneSystemWindowEvent(req.Event), "submit_system_window_event")
+	s.mu.Unlock()
+	if failure != "" {
+		return &rgsv1.SubmitSystemWindowEventResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, failure)}, nil
+	}
+	// Sessions is called without s.mu held; it pushes reality checks
+	// through this service while holding its own lock.
+	if ev.EventType == rgsv1.SystemWindowEventType_SYSTEM_WINDOW_EVENT_TYPE_CLOSED {
+		if err := s.Sessions.acknowledgeRealityCheck(ctx, req.Meta, ev); err != nil {
+			return &rgsv1.SubmitSystemWindowEventResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+	}
 
-	ev := cloneSystemWindowEvent(req.Event)
+	return &rgsv1.SubmitSystemWindowEventResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Event: ev}, nil
+}
+
+// recordSystemWindowEventLocked stores and audits ev under action, filling
+// in its id and time, and returns the failure reason when it could not.
+// s.mu must be held.
+func (s *UISystemOverlayService) recordSystemWindowEventLocked(ctx context.Context, meta *rgsv1.RequestMeta, ev *rgsv1.SystemWindowEvent, action string) (*rgsv1.SystemWindowEvent, string) {
 	if ev.EventId == "" {
 		ev.EventId = s.nextEventIDLocked()
 	}
@@ -539,14 +504,29 @@ func (s *UISystemOverlayService) SubmitSystemWindowEvent(ctx context.Context, re
 		s.eventOrder = append(s.eventOrder, ev.EventId)
 	}
 	if err := s.persistSystemWindowEvent(ctx, ev); err != nil {
-		return &rgsv1.SubmitSystemWindowEventResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		return nil, "persistence unavailable"
 	}
 	after, _ := json.Marshal(ev)
-	if err := s.appendAudit(req.Meta, ev.EventId, "submit_system_window_event", []byte(`{}`), after, audit.ResultSuccess, ""); err != nil {
-		return &rgsv1.SubmitSystemWindowEventResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	if err := s.appendAudit(meta, ev.EventId, action, []byte(`{}`), after, audit.ResultSuccess, ""); err != nil {
+		return nil, "audit unavailable"
 	}
+	return ev, ""
+}
 
-	return &rgsv1.SubmitSystemWindowEventResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Event: ev}, nil
+// pushSystemWindowEvent records a system window the RGS opens on a device
+// itself, such as a reality check, and pushes it to the device's
+// WatchSystemWindowEvents streams.
+func (s *UISystemOverlayService) pushSystemWindowEvent(ctx context.Context, ev *rgsv1.SystemWindowEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	recorded, failure := s.recordSystemWindowEventLocked(ctx, nil, ev, "push_system_window_event")
+	if failure != "" {
+		return errors.New(failure)
+	}
+	if s.watchers != nil {
+		s.watchers.publish(recorded)
+	}
+	return nil
 }
 
 func (s *UISystemOverlayService) ListSystemWindowEvents(ctx context.Context, req *rgsv1.ListSystemWindowEventsRequest) (*rgsv1.ListSystemWindowEventsResponse, error) {
@@ -592,11 +572,15 @@ func (s *UISystemOverlayService) ListSystemWindowEvents(ctx context.Context, req
 		_ = s.appendAudit(req.Meta, req.EquipmentId, "list_system_window_events", []byte(`{}`), []byte(`{}`), audit.ResultDenied, "invalid time range")
 		return &rgsv1.ListSystemWindowEventsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "from_time must be <= to_time")}, nil
 	}
+	group, code, reason := s.Registry.equipmentFilterForGroup(ctx, req.GroupId)
+	if code != rgsv1.ResultCode_RESULT_CODE_OK {
+		return &rgsv1.ListSystemWindowEventsResponse{Meta: s.responseMeta(req.Meta, code, reason)}, nil
+	}
 
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	if s.db != nil {
-		rows, next, err := s.listSystemWindowEventsFromDB(ctx, req.EquipmentId, fromTS, toTS, size, start)
+		rows, next, err := s.listSystemWindowEventsFromDB(ctx, req.EquipmentId, group, fromTS, toTS, size, start)
 		if err != nil {
 			return &rgsv1.ListSystemWindowEventsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
 		}
@@ -615,6 +599,9 @@ func (s *UISystemOverlayService) ListSystemWindowEvents(ctx context.Context, req
 		if req.EquipmentId != "" && ev.EquipmentId != req.EquipmentId {
 			continue
 		}
+		if !group.allows(ev.EquipmentId) {
+			continue
+		}
 		evTS := parseRFC3339OrZero(ev.EventTime)
 		if !fromTS.IsZero() && evTS.Before(fromTS) {
 			continue
@@ -70,7 +70,7 @@ func TestPromotionsRecordBonusTransactionNilRequest(t *testing.T) {
 		t.Fatalf("expected invalid-request denial reason, got=%q", resp.GetMeta().GetDenialReason())
 	}
 	assertMetaFields(t, resp.GetMeta(), "")
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "record_bonus_transaction" || events[len(events)-1].Reason != "invalid request" {
 		t.Fatalf("expected invalid-request audit event, got=%v", events)
 	}
@@ -99,7 +99,7 @@ func TestPromotionsRecordBonusTransactionMissingActorDenied(t *testing.T) {
 		t.Fatalf("expected denial reason actor is required, got=%q", resp.GetMeta().GetDenialReason())
 	}
 	assertMetaFields(t, resp.GetMeta(), "req-missing-actor-bonus-write")
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "record_bonus_transaction" || events[len(events)-1].Reason != "actor is required" {
 		t.Fatalf("expected denied audit event for missing actor bonus write, got=%v", events)
 	}
@@ -131,7 +131,7 @@ func TestPromotionsRecordBonusTransactionActorBindingRequired(t *testing.T) {
 		t.Fatalf("expected denial reason actor binding is required, got=%q", resp.GetMeta().GetDenialReason())
 	}
 	assertMetaFields(t, resp.GetMeta(), "req-actor-binding-bonus-write")
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "record_bonus_transaction" || events[len(events)-1].Reason != "actor binding is required" {
 		t.Fatalf("expected denied audit event for invalid actor binding, got=%v", events)
 	}
@@ -160,7 +160,7 @@ func TestPromotionsRecordBonusTransactionActorMismatchDenied(t *testing.T) {
 		t.Fatalf("expected denial reason actor mismatch with token, got=%q", resp.GetMeta().GetDenialReason())
 	}
 	assertMetaFields(t, resp.GetMeta(), "req-1")
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "record_bonus_transaction" || events[len(events)-1].Reason != "actor mismatch with token" {
 		t.Fatalf("expected denied audit event for actor mismatch, got=%v", events)
 	}
@@ -182,7 +182,7 @@ func TestPromotionsListRecentNilRequestDeniedActorRequired(t *testing.T) {
 		t.Fatalf("expected denial reason actor is required, got=%q", resp.GetMeta().GetDenialReason())
 	}
 	assertMetaFields(t, resp.GetMeta(), "")
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "list_recent_bonus_transactions" || events[len(events)-1].Reason != "actor is required" {
 		t.Fatalf("expected denied audit event for missing actor, got=%v", events)
 	}
@@ -206,7 +206,7 @@ func TestPromotionsListRecentMissingActorDenied(t *testing.T) {
 		t.Fatalf("expected denial reason actor is required, got=%q", resp.GetMeta().GetDenialReason())
 	}
 	assertMetaFields(t, resp.GetMeta(), "req-missing-actor-bonus-list")
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "list_recent_bonus_transactions" || events[len(events)-1].Reason != "actor is required" {
 		t.Fatalf("expected denied audit event for missing actor bonus list, got=%v", events)
 	}
@@ -233,7 +233,7 @@ func TestPromotionsListAwardsActorBindingRequired(t *testing.T) {
 		t.Fatalf("expected denial reason actor binding is required, got=%q", resp.GetMeta().GetDenialReason())
 	}
 	assertMetaFields(t, resp.GetMeta(), "req-actor-binding-awards-list")
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "list_promotional_awards" || events[len(events)-1].Reason != "actor binding is required" {
 		t.Fatalf("expected denied audit event for invalid actor binding, got=%v", events)
 	}
@@ -257,7 +257,7 @@ func TestPromotionsListRecentActorMismatchDenied(t *testing.T) {
 		t.Fatalf("expected denial reason actor mismatch with token, got=%q", resp.GetMeta().GetDenialReason())
 	}
 	assertMetaFields(t, resp.GetMeta(), "req-1")
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "list_recent_bonus_transactions" || events[len(events)-1].Reason != "actor mismatch with token" {
 		t.Fatalf("expected denied audit event for actor mismatch bonus list, got=%v", events)
 	}
@@ -281,7 +281,7 @@ func TestPromotionsListAwardsActorMismatchDenied(t *testing.T) {
 		t.Fatalf("expected denial reason actor mismatch with token, got=%q", resp.GetMeta().GetDenialReason())
 	}
 	assertMetaFields(t, resp.GetMeta(), "req-1")
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "list_promotional_awards" || events[len(events)-1].Reason != "actor mismatch with token" {
 		t.Fatalf("expected denied audit event for actor mismatch awards list, got=%v", events)
 	}
@@ -306,7 +306,7 @@ func TestPromotionsListRecentRejectsNegativeLimit(t *testing.T) {
 		t.Fatalf("expected denial reason invalid limit, got=%q", resp.GetMeta().GetDenialReason())
 	}
 	assertMetaFields(t, resp.GetMeta(), "req-1")
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "list_recent_bonus_transactions" || events[len(events)-1].Result != "denied" {
 		t.Fatalf("expected denied audit event for invalid bonus list request, got=%v", events)
 	}
@@ -333,7 +333,7 @@ func TestPromotionsListRecentRejectsOversizedLimit(t *testing.T) {
 	if resp.GetMeta().GetDenialReason() != "invalid limit" {
 		t.Fatalf("expected denial reason invalid limit, got=%q", resp.GetMeta().GetDenialReason())
 	}
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "list_recent_bonus_transactions" || events[len(events)-1].Result != "denied" {
 		t.Fatalf("expected denied audit event for oversized bonus list request, got=%v", events)
 	}
@@ -360,7 +360,7 @@ func TestPromotionsListRecentDeniedForPlayerActor(t *testing.T) {
 		t.Fatalf("expected denial reason unauthorized actor type, got=%q", resp.GetMeta().GetDenialReason())
 	}
 	assertMetaFields(t, resp.GetMeta(), "req-1")
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "list_recent_bonus_transactions" || events[len(events)-1].Result != "denied" {
 		t.Fatalf("expected denied audit event for bonus list access, got=%v", events)
 	}
@@ -392,7 +392,7 @@ func TestPromotionsRecordBonusTransactionRejectsInvalidOccurredAt(t *testing.T)
 	if resp.GetMeta().GetDenialReason() != "invalid occurred_at" {
 		t.Fatalf("expected denial reason invalid occurred_at, got=%q", resp.GetMeta().GetDenialReason())
 	}
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "record_bonus_transaction" || events[len(events)-1].Result != "denied" {
 		t.Fatalf("expected denied audit event for invalid bonus request, got=%v", events)
 	}
@@ -424,7 +424,7 @@ func TestPromotionsRecordBonusTransactionDeniedForPlayerActor(t *testing.T) {
 		t.Fatalf("expected denial reason unauthorized actor type, got=%q", resp.GetMeta().GetDenialReason())
 	}
 	assertMetaFields(t, resp.GetMeta(), "req-1")
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "record_bonus_transaction" || events[len(events)-1].Result != "denied" {
 		t.Fatalf("expected denied audit event for bonus write access, got=%v", events)
 	}
@@ -507,7 +507,7 @@ func TestPromotionsRecordPromotionalAwardMissingActorDenied(t *testing.T) {
 		t.Fatalf("expected denial reason actor is required, got=%q", resp.GetMeta().GetDenialReason())
 	}
 	assertMetaFields(t, resp.GetMeta(), "req-missing-actor-award-write")
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "record_promotional_award" || events[len(events)-1].Reason != "actor is required" {
 		t.Fatalf("expected denied audit event for missing actor award write, got=%v", events)
 	}
@@ -537,7 +537,7 @@ func TestPromotionsRecordPromotionalAwardActorMismatchDenied(t *testing.T) {
 		t.Fatalf("expected denial reason actor mismatch with token, got=%q", resp.GetMeta().GetDenialReason())
 	}
 	assertMetaFields(t, resp.GetMeta(), "req-1")
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "record_promotional_award" || events[len(events)-1].Reason != "actor mismatch with token" {
 		t.Fatalf("expected denied audit event for actor mismatch award write, got=%v", events)
 	}
@@ -561,7 +561,7 @@ func TestPromotionsListAwardsMissingActorDenied(t *testing.T) {
 		t.Fatalf("expected denial reason actor is required, got=%q", resp.GetMeta().GetDenialReason())
 	}
 	assertMetaFields(t, resp.GetMeta(), "req-missing-actor-awards-list")
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "list_promotional_awards" || events[len(events)-1].Reason != "actor is required" {
 		t.Fatalf("expected denied audit event for missing actor awards list, got=%v", events)
 	}
@@ -590,7 +590,7 @@ func TestUISystemOverlaySubmitMissingActorDenied(t *testing.T) {
 		t.Fatalf("expected denial reason actor is required, got=%q", resp.GetMeta().GetDenialReason())
 	}
 	assertMetaFields(t, resp.GetMeta(), "req-missing-actor-ui-write")
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "submit_system_window_event" || events[len(events)-1].Reason != "actor is required" {
 		t.Fatalf("expected denied audit event for missing actor ui write, got=%v", events)
 	}
@@ -622,7 +622,7 @@ func TestUISystemOverlaySubmitActorBindingRequired(t *testing.T) {
 		t.Fatalf("expected denial reason actor binding is required, got=%q", resp.GetMeta().GetDenialReason())
 	}
 	assertMetaFields(t, resp.GetMeta(), "req-actor-binding-ui-write")
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "submit_system_window_event" || events[len(events)-1].Reason != "actor binding is required" {
 		t.Fatalf("expected denied audit event for invalid actor binding, got=%v", events)
 	}
@@ -651,7 +651,7 @@ func TestUISystemOverlaySubmitActorMismatchDenied(t *testing.T) {
 		t.Fatalf("expected denial reason actor mismatch with token, got=%q", resp.GetMeta().GetDenialReason())
 	}
 	assertMetaFields(t, resp.GetMeta(), "req-1")
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "submit_system_window_event" || events[len(events)-1].Reason != "actor mismatch with token" {
 		t.Fatalf("expected denied audit event for actor mismatch ui write, got=%v", events)
 	}
@@ -673,7 +673,7 @@ func TestUISystemOverlaySubmitNilRequest(t *testing.T) {
 		t.Fatalf("expected invalid-request denial reason, got=%q", resp.GetMeta().GetDenialReason())
 	}
 	assertMetaFields(t, resp.GetMeta(), "")
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "submit_system_window_event" || events[len(events)-1].Reason != "invalid request" {
 		t.Fatalf("expected invalid-request audit event, got=%v", events)
 	}
@@ -695,7 +695,7 @@ func TestUISystemOverlayListNilRequestDeniedActorRequired(t *testing.T) {
 		t.Fatalf("expected denial reason actor is required, got=%q", resp.GetMeta().GetDenialReason())
 	}
 	assertMetaFields(t, resp.GetMeta(), "")
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "list_system_window_events" || events[len(events)-1].Reason != "actor is required" {
 		t.Fatalf("expected denied audit event for missing actor, got=%v", events)
 	}
@@ -719,7 +719,7 @@ func TestUISystemOverlayListMissingActorDenied(t *testing.T) {
 		t.Fatalf("expected denial reason actor is required, got=%q", resp.GetMeta().GetDenialReason())
 	}
 	assertMetaFields(t, resp.GetMeta(), "req-missing-actor-ui-list")
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "list_system_window_events" || events[len(events)-1].Reason != "actor is required" {
 		t.Fatalf("expected denied audit event for missing actor ui list, got=%v", events)
 	}
@@ -746,7 +746,7 @@ func TestUISystemOverlayListActorBindingRequired(t *testing.T) {
 		t.Fatalf("expected denial reason actor binding is required, got=%q", resp.GetMeta().GetDenialReason())
 	}
 	assertMetaFields(t, resp.GetMeta(), "req-actor-binding-ui-list")
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "list_system_window_events" || events[len(events)-1].Reason != "actor binding is required" {
 		t.Fatalf("expected denied audit event for invalid actor binding, got=%v", events)
 	}
@@ -770,7 +770,7 @@ func TestUISystemOverlayListActorMismatchDenied(t *testing.T) {
 		t.Fatalf("expected denial reason actor mismatch with token, got=%q", resp.GetMeta().GetDenialReason())
 	}
 	assertMetaFields(t, resp.GetMeta(), "req-1")
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "list_system_window_events" || events[len(events)-1].Reason != "actor mismatch with token" {
 		t.Fatalf("expected denied audit event for actor mismatch, got=%v", events)
 	}
@@ -906,7 +906,7 @@ func TestPromotionsListAwardsRejectsNegativePageSize(t *testing.T) {
 	if resp.GetMeta().GetDenialReason() != "invalid page_size" {
 		t.Fatalf("expected denial reason invalid page_size, got=%q", resp.GetMeta().GetDenialReason())
 	}
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "list_promotional_awards" || events[len(events)-1].Result != "denied" {
 		t.Fatalf("expected denied audit event for invalid awards list request, got=%v", events)
 	}
@@ -933,7 +933,7 @@ func TestPromotionsListAwardsRejectsOversizedPageSize(t *testing.T) {
 	if resp.GetMeta().GetDenialReason() != "invalid page_size" {
 		t.Fatalf("expected denial reason invalid page_size, got=%q", resp.GetMeta().GetDenialReason())
 	}
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "list_promotional_awards" || events[len(events)-1].Result != "denied" {
 		t.Fatalf("expected denied audit event for oversized awards list request, got=%v", events)
 	}
@@ -959,7 +959,7 @@ func TestPromotionsListAwardsDeniedForPlayerActor(t *testing.T) {
 	if resp.GetMeta().GetDenialReason() != "unauthorized actor type" {
 		t.Fatalf("expected denial reason unauthorized actor type, got=%q", resp.GetMeta().GetDenialReason())
 	}
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "list_promotional_awards" || events[len(events)-1].Result != "denied" {
 		t.Fatalf("expected denied audit event for awards list access, got=%v", events)
 	}
@@ -991,7 +991,7 @@ func TestPromotionsRecordPromotionalAwardRejectsUnknownAwardType(t *testing.T) {
 	if resp.GetMeta().GetDenialReason() != "award requires player_id, award_type, and positive amount" {
 		t.Fatalf("expected denial reason for invalid award request, got=%q", resp.GetMeta().GetDenialReason())
 	}
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "record_promotional_award" || events[len(events)-1].Result != "denied" {
 		t.Fatalf("expected denied audit event for invalid award request, got=%v", events)
 	}
@@ -1024,7 +1024,7 @@ func TestPromotionsRecordPromotionalAwardRejectsInvalidOccurredAt(t *testing.T)
 	if resp.GetMeta().GetDenialReason() != "invalid occurred_at" {
 		t.Fatalf("expected denial reason invalid occurred_at, got=%q", resp.GetMeta().GetDenialReason())
 	}
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "record_promotional_award" || events[len(events)-1].Result != "denied" {
 		t.Fatalf("expected denied audit event for invalid award timestamp, got=%v", events)
 	}
@@ -1056,7 +1056,7 @@ func TestPromotionsRecordPromotionalAwardDeniedForPlayerActor(t *testing.T) {
 	if resp.GetMeta().GetDenialReason() != "unauthorized actor type" {
 		t.Fatalf("expected denial reason unauthorized actor type, got=%q", resp.GetMeta().GetDenialReason())
 	}
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "record_promotional_award" || events[len(events)-1].Result != "denied" {
 		t.Fatalf("expected denied audit event for award write access, got=%v", events)
 	}
@@ -1120,7 +1120,7 @@ func TestUISystemOverlaySubmitRejectsUnknownEventType(t *testing.T) {
 	if resp.GetMeta().GetDenialReason() != "event requires equipment_id, window_id, and event_type" {
 		t.Fatalf("expected denial reason for invalid ui event request, got=%q", resp.GetMeta().GetDenialReason())
 	}
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "submit_system_window_event" || events[len(events)-1].Result != "denied" {
 		t.Fatalf("expected denied audit event for invalid ui event type, got=%v", events)
 	}
@@ -1153,7 +1153,7 @@ func TestUISystemOverlaySubmitRejectsInvalidEventTime(t *testing.T) {
 	if resp.GetMeta().GetDenialReason() != "invalid event_time" {
 		t.Fatalf("expected denial reason invalid event_time, got=%q", resp.GetMeta().GetDenialReason())
 	}
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "submit_system_window_event" || events[len(events)-1].Result != "denied" {
 		t.Fatalf("expected denied audit event for invalid ui submit request, got=%v", events)
 	}
@@ -1185,7 +1185,7 @@ func TestUISystemOverlaySubmitDeniedForPlayerActor(t *testing.T) {
 	if resp.GetMeta().GetDenialReason() != "unauthorized actor type" {
 		t.Fatalf("expected denial reason unauthorized actor type, got=%q", resp.GetMeta().GetDenialReason())
 	}
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "submit_system_window_event" || events[len(events)-1].Result != "denied" {
 		t.Fatalf("expected denied audit event for ui submit access, got=%v", events)
 	}
@@ -1212,7 +1212,7 @@ func TestUISystemOverlayListRejectsNegativePageSize(t *testing.T) {
 	if resp.GetMeta().GetDenialReason() != "invalid page_size" {
 		t.Fatalf("expected denial reason invalid page_size, got=%q", resp.GetMeta().GetDenialReason())
 	}
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "list_system_window_events" || events[len(events)-1].Result != "denied" {
 		t.Fatalf("expected denied audit event for negative ui page_size, got=%v", events)
 	}
@@ -1239,7 +1239,7 @@ func TestUISystemOverlayListRejectsOversizedPageSize(t *testing.T) {
 	if resp.GetMeta().GetDenialReason() != "invalid page_size" {
 		t.Fatalf("expected denial reason invalid page_size, got=%q", resp.GetMeta().GetDenialReason())
 	}
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "list_system_window_events" || events[len(events)-1].Result != "denied" {
 		t.Fatalf("expected denied audit event for oversized ui page_size, got=%v", events)
 	}
@@ -1265,7 +1265,7 @@ func TestUISystemOverlayListDeniedForPlayerActor(t *testing.T) {
 	if resp.GetMeta().GetDenialReason() != "unauthorized actor type" {
 		t.Fatalf("expected denial reason unauthorized actor type, got=%q", resp.GetMeta().GetDenialReason())
 	}
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "list_system_window_events" || events[len(events)-1].Result != "denied" {
 		t.Fatalf("expected denied audit event for ui list access, got=%v", events)
 	}
@@ -1293,7 +1293,7 @@ func TestUISystemOverlayListRejectsInvalidPageToken(t *testing.T) {
 		t.Fatalf("expected denial reason invalid page_token, got=%q", resp.GetMeta().GetDenialReason())
 	}
 	assertMetaFields(t, resp.GetMeta(), "req-1")
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "list_system_window_events" || events[len(events)-1].Result != "denied" {
 		t.Fatalf("expected denied audit event for invalid ui list request, got=%v", events)
 	}
@@ -1320,7 +1320,7 @@ func TestUISystemOverlayListRejectsNegativePageToken(t *testing.T) {
 	if resp.GetMeta().GetDenialReason() != "invalid page_token" {
 		t.Fatalf("expected denial reason invalid page_token, got=%q", resp.GetMeta().GetDenialReason())
 	}
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "list_system_window_events" || events[len(events)-1].Result != "denied" {
 		t.Fatalf("expected denied audit event for negative page token, got=%v", events)
 	}
@@ -1347,7 +1347,7 @@ func TestPromotionsListAwardsRejectsNegativePageToken(t *testing.T) {
 	if resp.GetMeta().GetDenialReason() != "invalid page_token" {
 		t.Fatalf("expected denial reason invalid page_token, got=%q", resp.GetMeta().GetDenialReason())
 	}
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "list_promotional_awards" || events[len(events)-1].Result != "denied" {
 		t.Fatalf("expected denied audit event for negative page token, got=%v", events)
 	}
@@ -1374,7 +1374,7 @@ func TestPromotionsListAwardsRejectsInvalidPageToken(t *testing.T) {
 	if resp.GetMeta().GetDenialReason() != "invalid page_token" {
 		t.Fatalf("expected denial reason invalid page_token, got=%q", resp.GetMeta().GetDenialReason())
 	}
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "list_promotional_awards" || events[len(events)-1].Result != "denied" {
 		t.Fatalf("expected denied audit event for invalid awards list request, got=%v", events)
 	}
@@ -1415,7 +1415,7 @@ func TestUISystemOverlayListRejectsInvalidTimeInputs(t *testing.T) {
 	if resp.GetMeta().GetDenialReason() != "invalid to_time" {
 		t.Fatalf("expected denial reason invalid to_time, got=%q", resp.GetMeta().GetDenialReason())
 	}
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) < 2 {
 		t.Fatalf("expected denied audit events for invalid time inputs, got=%v", events)
 	}
@@ -1448,7 +1448,7 @@ func TestUISystemOverlayListRejectsInvertedTimeRange(t *testing.T) {
 	if resp.GetMeta().GetDenialReason() != "from_time must be <= to_time" {
 		t.Fatalf("expected denial reason from_time must be <= to_time, got=%q", resp.GetMeta().GetDenialReason())
 	}
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "list_system_window_events" || events[len(events)-1].Result != "denied" {
 		t.Fatalf("expected denied audit event for inverted range, got=%v", events)
 	}
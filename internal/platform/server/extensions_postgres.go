@@ -2,8 +2,10 @@ package server
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
@@ -223,7 +225,7 @@ ON CONFLICT (event_id) DO UPDATE SET
 	return err
 }
 
-func (s *UISystemOverlayService) listSystemWindowEventsFromDB(ctx context.Context, equipmentID string, fromTS, toTS time.Time, limit, offset int) ([]*rgsv1.SystemWindowEvent, string, error) {
+func (s *UISystemOverlayService) listSystemWindowEventsFromDB(ctx context.Context, equipmentID string, group equipmentFilter, fromTS, toTS time.Time, limit, offset int) ([]*rgsv1.SystemWindowEvent, string, error) {
 	if s == nil || s.db == nil {
 		return nil, "", nil
 	}
@@ -233,10 +235,11 @@ FROM system_window_events
 WHERE ($1 = '' OR equipment_id = $1)
   AND ($2::timestamptz IS NULL OR event_time >= $2::timestamptz)
   AND ($3::timestamptz IS NULL OR event_time <= $3::timestamptz)
+  AND (NOT $6 OR equipment_id = ANY($7::text[]))
 ORDER BY event_time DESC, event_id DESC
 LIMIT $4 OFFSET $5
 `
-	rows, err := s.db.QueryContext(ctx, q, equipmentID, nullTime(fromTS), nullTime(toTS), limit, offset)
+	rows, err := s.db.QueryContext(ctx, q, equipmentID, nullTime(fromTS), nullTime(toTS), limit, offset, group != nil, group.ids())
 	if err != nil {
 		return nil, "", err
 	}
@@ -277,3 +280,46 @@ LIMIT $4 OFFSET $5
 	}
 	return out, next, nil
 }
+
+func campaignLiabilityFromDB(ctx context.Context, db *sql.DB, from, to time.Time) ([]*promoCampaignTotals, error) {
+	const q = `
+WITH issued AS (
+  SELECT campaign_id, currency_code,
+         COUNT(*) FILTER (WHERE $1::timestamptz IS NULL OR occurred_at >= $1::timestamptz) AS window_count,
+         COALESCE(SUM(amount_minor) FILTER (WHERE $1::timestamptz IS NULL OR occurred_at >= $1::timestamptz), 0) AS window_amount,
+         COALESCE(SUM(amount_minor), 0) AS total_amount
+  FROM promotional_awards
+  WHERE ($2::timestamptz IS NULL OR occurred_at <= $2::timestamptz)
+  GROUP BY campaign_id, currency_code
+), redeemed AS (
+  SELECT campaign_id, currency_code,
+         COUNT(*) FILTER (WHERE $1::timestamptz IS NULL OR occurred_at >= $1::timestamptz) AS window_count,
+         COALESCE(SUM(amount_minor) FILTER (WHERE $1::timestamptz IS NULL OR occurred_at >= $1::timestamptz), 0) AS window_amount,
+         COALESCE(SUM(amount_minor), 0) AS total_amount
+  FROM bonus_transactions
+  WHERE ($2::timestamptz IS NULL OR occurred_at <= $2::timestamptz)
+  GROUP BY campaign_id, currency_code
+)
+SELECT COALESCE(i.campaign_id, r.campaign_id), COALESCE(i.currency_code, r.currency_code),
+       COALESCE(i.window_count, 0), COALESCE(i.window_amount, 0),
+       COALESCE(r.window_count, 0), COALESCE(r.window_amount, 0),
+       COALESCE(i.total_amount, 0), COALESCE(r.total_amount, 0)
+FROM issued i
+FULL OUTER JOIN redeemed r ON r.campaign_id = i.campaign_id AND r.currency_code = i.currency_code
+ORDER BY 1, 2`
+	rows, err := db.QueryContext(ctx, q, nullTime(from), nullTime(to))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]*promoCampaignTotals, 0)
+	for rows.Next() {
+		t := &promoCampaignTotals{}
+		if err := rows.Scan(&t.campaignID, &t.currency, &t.awardsIssued, &t.issued, &t.redemptions, &t.redeemed, &t.totalIssued, &t.totalRedeemed); err != nil {
+			return nil, err
+		}
+		t.currency = strings.TrimSpace(t.currency)
+		out = append(out, t)
+	}
+	return out, rows.Err()
+}
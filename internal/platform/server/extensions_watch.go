@@ -0,0 +1,140 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+// systemWindowWatchBuffer bounds the windows queued for one watcher. A
+// watcher that falls further behind is disconnected rather than stalling the
+// sessions that push windows.
+const systemWindowWatchBuffer = 64
+
+type systemWindowWatchSubscription struct {
+	equipmentID string
+	events      chan *rgsv1.SystemWindowEvent
+	reason      string
+}
+
+// systemWindowWatchHub fans the system windows the RGS pushes out to the
+// watchers of the equipment they open on. It only sees windows pushed by
+// this process.
+type systemWindowWatchHub struct {
+	mu   sync.Mutex
+	subs map[*systemWindowWatchSubscription]struct{}
+}
+
+func newSystemWindowWatchHub() *systemWindowWatchHub {
+	return &systemWindowWatchHub{subs: make(map[*systemWindowWatchSubscription]struct{})}
+}
+
+func (h *systemWindowWatchHub) subscribe(equipmentID string) *systemWindowWatchSubscription {
+	sub := &systemWindowWatchSubscription{equipmentID: equipmentID, events: make(chan *rgsv1.SystemWindowEvent, systemWindowWatchBuffer)}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.subs[sub] = struct{}{}
+	return sub
+}
+
+func (h *systemWindowWatchHub) unsubscribe(sub *systemWindowWatchSubscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.subs[sub]; ok {
+		delete(h.subs, sub)
+		close(sub.events)
+	}
+}
+
+func (h *systemWindowWatchHub) publish(ev *rgsv1.SystemWindowEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for sub := range h.subs {
+		if sub.equipmentID != ev.EquipmentId {
+			continue
+		}
+		select {
+		case sub.events <- cloneSystemWindowEvent(ev):
+		default:
+			sub.reason = "watch fell behind"
+			close(sub.events)
+			delete(h.subs, sub)
+		}
+	}
+}
+
+func (h *systemWindowWatchHub) closeReason(sub *systemWindowWatchSubscription) string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return sub.reason
+}
+
+// authorizeWindowWatch admits operators for any equipment and service actors
+// only for the equipment they authenticate as.
+func (s *UISystemOverlayService) authorizeWindowWatch(ctx context.Context, meta *rgsv1.RequestMeta, equipmentID string) (bool, string) {
+	actor, reason := resolveActor(ctx, meta)
+	if reason != "" {
+		return false, reason
+	}
+	switch actor.ActorType {
+	case rgsv1.ActorType_ACTOR_TYPE_OPERATOR:
+		return true, ""
+	case rgsv1.ActorType_ACTOR_TYPE_SERVICE:
+		if actor.ActorId != equipmentID {
+			return false, "device cannot watch another device's windows"
+		}
+		return true, ""
+	default:
+		return false, "unauthorized actor type"
+	}
+}
+
+// WatchSystemWindowEvents pushes every system window the RGS opens on the
+// equipment, such as a reality check, for as long as the stream is open. The
+// first message acknowledges the subscription; a watcher that falls behind
+// is ended with an ERROR message. Windows pushed while no stream was open
+// are only listed by ListSystemWindowEvents.
+func (s *UISystemOverlayService) WatchSystemWindowEvents(req *rgsv1.WatchSystemWindowEventsRequest, stream rgsv1.UISystemOverlayService_WatchSystemWindowEventsServer) error {
+	ctx := stream.Context()
+	if req == nil || req.EquipmentId == "" {
+		return stream.Send(&rgsv1.WatchSystemWindowEventsResponse{Meta: s.responseMeta(req.GetMeta(), rgsv1.ResultCode_RESULT_CODE_INVALID, "equipment_id is required")})
+	}
+	if ok, reason := s.authorizeWindowWatch(ctx, req.Meta, req.EquipmentId); !ok {
+		s.mu.Lock()
+		_ = s.appendAudit(req.Meta, req.EquipmentId, "watch_system_window_events", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		s.mu.Unlock()
+		return stream.Send(&rgsv1.WatchSystemWindowEventsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)})
+	}
+	if s.watchers == nil {
+		return stream.Send(&rgsv1.WatchSystemWindowEventsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "watch unavailable")})
+	}
+
+	sub := s.watchers.subscribe(req.EquipmentId)
+	defer s.watchers.unsubscribe(sub)
+	after, _ := json.Marshal(map[string]string{"equipment_id": req.EquipmentId})
+	s.mu.Lock()
+	err := s.appendAudit(req.Meta, req.EquipmentId, "watch_system_window_events", []byte(`{}`), after, audit.ResultSuccess, "")
+	s.mu.Unlock()
+	if err != nil {
+		return stream.Send(&rgsv1.WatchSystemWindowEventsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")})
+	}
+	if err := stream.Send(&rgsv1.WatchSystemWindowEventsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, "")}); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case ev, ok := <-sub.events:
+			if !ok {
+				return stream.Send(&rgsv1.WatchSystemWindowEventsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, s.watchers.closeReason(sub))})
+			}
+			if err := stream.Send(&rgsv1.WatchSystemWindowEventsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Event: ev}); err != nil {
+				return err
+			}
+		}
+	}
+}
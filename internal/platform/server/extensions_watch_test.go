@@ -0,0 +1,119 @@
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func newOverlayWatchClient(t *testing.T, svc *UISystemOverlayService) rgsv1.UISystemOverlayServiceClient {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	rgsv1.RegisterUISystemOverlayServiceServer(srv, svc)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("dial bufconn: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return rgsv1.NewUISystemOverlayServiceClient(conn)
+}
+
+func TestWatchSystemWindowEventsDeliversRealityChecks(t *testing.T) {
+	now := time.Date(2026, 3, 14, 19, 0, 0, 0, time.UTC)
+	sessions := NewSessionsService(ledgerFixedClock{now: now})
+	overlay := NewUISystemOverlayService(ledgerFixedClock{now: now})
+	sessions.Overlay = overlay
+	overlay.Sessions = sessions
+	sessions.SetRealityCheckInterval(time.Hour)
+	wagering := NewWageringService(ledgerFixedClock{now: now})
+	wagering.Sessions = sessions
+	client := newOverlayWatchClient(t, overlay)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	for name, tc := range map[string]struct {
+		meta        *rgsv1.RequestMeta
+		equipmentID string
+		want        rgsv1.ResultCode
+	}{
+		"no equipment":  {meta("egm-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, ""), "", rgsv1.ResultCode_RESULT_CODE_INVALID},
+		"player":        {meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""), "egm-1", rgsv1.ResultCode_RESULT_CODE_DENIED},
+		"other device":  {meta("egm-2", rgsv1.ActorType_ACTOR_TYPE_SERVICE, ""), "egm-1", rgsv1.ResultCode_RESULT_CODE_DENIED},
+		"floor monitor": {meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), "egm-1", rgsv1.ResultCode_RESULT_CODE_OK},
+	} {
+		stream, err := client.WatchSystemWindowEvents(ctx, &rgsv1.WatchSystemWindowEventsRequest{Meta: tc.meta, EquipmentId: tc.equipmentID})
+		if err != nil {
+			t.Fatalf("%s: open stream: %v", name, err)
+		}
+		if resp, err := stream.Recv(); err != nil || resp.Meta.GetResultCode() != tc.want {
+			t.Fatalf("%s: expected %v, got resp=%+v err=%v", name, tc.want, resp, err)
+		}
+	}
+
+	device := meta("egm-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, "")
+	stream, err := client.WatchSystemWindowEvents(ctx, &rgsv1.WatchSystemWindowEventsRequest{Meta: device, EquipmentId: "egm-1"})
+	if err != nil {
+		t.Fatalf("open stream: %v", err)
+	}
+	if ack, err := stream.Recv(); err != nil || ack.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || ack.Event != nil {
+		t.Fatalf("expected subscription ack: resp=%+v err=%v", ack, err)
+	}
+
+	sessions.StartSession(ctx, &rgsv1.StartSessionRequest{Meta: meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""), PlayerId: "player-1", DeviceId: "egm-1", SessionTimeoutSeconds: 4 * 3600})
+	later := ledgerFixedClock{now: now.Add(time.Hour)}
+	sessions.Clock, overlay.Clock, wagering.Clock = later, later, later
+	if summary, err := sessions.RealityCheckJob(10)(ctx, ""); err != nil || summary != "pushed 1 reality checks" {
+		t.Fatalf("unexpected job summary %q %v", summary, err)
+	}
+
+	resp, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("receive window: %v", err)
+	}
+	pushed := resp.Event
+	if pushed.GetEquipmentId() != "egm-1" || pushed.PlayerId != "player-1" || pushed.EventType != rgsv1.SystemWindowEventType_SYSTEM_WINDOW_EVENT_TYPE_OPENED || pushed.EventId == "" {
+		t.Fatalf("expected the reality check pushed to the device, got %+v", resp)
+	}
+	placed, _ := wagering.PlaceWager(ctx, &rgsv1.PlaceWagerRequest{Meta: meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "w-1"), PlayerId: "player-1", GameId: "game-1", Stake: &rgsv1.Money{AmountMinor: 100, Currency: "USD"}})
+	if placed.Meta.DenialReason != "reality check acknowledgment required" {
+		t.Fatalf("expected wagering blocked until the pushed window is acknowledged, got %+v", placed.Meta)
+	}
+
+	closed, _ := overlay.SubmitSystemWindowEvent(ctx, &rgsv1.SubmitSystemWindowEventRequest{Meta: device, Event: &rgsv1.SystemWindowEvent{
+		EquipmentId: "egm-1", PlayerId: "player-1", WindowId: pushed.WindowId, EventType: rgsv1.SystemWindowEventType_SYSTEM_WINDOW_EVENT_TYPE_CLOSED,
+	}})
+	if closed.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("close window: %+v", closed.Meta)
+	}
+	placed, _ = wagering.PlaceWager(ctx, &rgsv1.PlaceWagerRequest{Meta: meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "w-2"), PlayerId: "player-1", GameId: "game-1", Stake: &rgsv1.Money{AmountMinor: 100, Currency: "USD"}})
+	if placed.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected wagering to resume after the device acknowledged, got %+v", placed.Meta)
+	}
+
+	var watched, denied int
+	for _, ev := range auditEvents(overlay.AuditStore) {
+		if ev.Action != "watch_system_window_events" {
+			continue
+		}
+		if ev.Result == "success" {
+			watched++
+		} else {
+			denied++
+		}
+	}
+	if watched != 2 || denied != 2 {
+		t.Fatalf("expected watches to be audited, got watched=%d denied=%d", watched, denied)
+	}
+}
@@ -0,0 +1,187 @@
+package server
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+// GamingCalendar places instants on a property's gaming days. A gaming day
+// begins DayStart after local midnight in Location (wall-clock, so it follows
+// DST) and is named by the local date on which it begins.
+type GamingCalendar struct {
+	Location *time.Location
+	DayStart time.Duration
+}
+
+func (c GamingCalendar) location() *time.Location {
+	if c.Location == nil {
+		return time.UTC
+	}
+	return c.Location
+}
+
+// Start returns the instant the gaming day named by day's date begins.
+func (c GamingCalendar) Start(day time.Time) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, int(c.DayStart), c.location()).UTC()
+}
+
+// day returns the gaming day containing t as a local midnight value.
+func (c GamingCalendar) day(t time.Time) time.Time {
+	local := t.In(c.location())
+	d := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, c.location())
+	if t.Before(c.Start(d)) {
+		d = d.AddDate(0, 0, -1)
+	}
+	return d
+}
+
+// GamingDay returns the YYYY-MM-DD gaming day containing t.
+func (c GamingCalendar) GamingDay(t time.Time) string {
+	return c.day(t).Format(gamingDayLayout)
+}
+
+// LastClosedGamingDay returns the most recent gaming day that has fully
+// elapsed at now.
+func (c GamingCalendar) LastClosedGamingDay(now time.Time) string {
+	return c.day(now).AddDate(0, 0, -1).Format(gamingDayLayout)
+}
+
+// Window returns the inclusive activity window of the gaming day named by
+// day's date. Windows span 23 or 25 hours across DST changes.
+func (c GamingCalendar) Window(day time.Time) reportWindow {
+	start := c.Start(day)
+	next := c.Start(time.Date(day.Year(), day.Month(), day.Day()+1, 0, 0, 0, 0, time.UTC))
+	return reportWindow{
+		interval: rgsv1.ReportInterval_REPORT_INTERVAL_DTD,
+		start:    start,
+		end:      next.Add(-time.Nanosecond),
+	}
+}
+
+// IntervalStart returns where a DTD/MTD/YTD interval containing now begins,
+// aligned to gaming days; LTD is unbounded.
+func (c GamingCalendar) IntervalStart(now time.Time, interval rgsv1.ReportInterval) time.Time {
+	d := c.day(now)
+	switch interval {
+	case rgsv1.ReportInterval_REPORT_INTERVAL_DTD:
+		return c.Start(d)
+	case rgsv1.ReportInterval_REPORT_INTERVAL_MTD:
+		return c.Start(time.Date(d.Year(), d.Month(), 1, 0, 0, 0, 0, time.UTC))
+	case rgsv1.ReportInterval_REPORT_INTERVAL_YTD:
+		return c.Start(time.Date(d.Year(), 1, 1, 0, 0, 0, 0, time.UTC))
+	default:
+		return time.Time{}
+	}
+}
+
+func gamingCalendarInfo(c GamingCalendar, now time.Time) *rgsv1.GamingCalendarInfo {
+	local := now.In(c.location())
+	_, offset := local.Zone()
+	return &rgsv1.GamingCalendarInfo{
+		TimeZone:         c.location().String(),
+		UtcOffsetSeconds: int32(offset),
+		GamingDayStart:   fmt.Sprintf("%02d:%02d", int(c.DayStart/time.Hour), int(c.DayStart%time.Hour/time.Minute)),
+		GamingDay:        c.GamingDay(now),
+		LocalTime:        local.Format(time.RFC3339),
+	}
+}
+
+// GamingCalendars holds the default property calendar and per-tenant
+// overrides keyed by operator id.
+type GamingCalendars struct {
+	Default  GamingCalendar
+	ByTenant map[string]GamingCalendar
+}
+
+// For returns the tenant's calendar, or Default if it has none.
+func (c GamingCalendars) For(tenantID string) GamingCalendar {
+	if cal, ok := c.ByTenant[tenantID]; ok && tenantID != "" {
+		return cal
+	}
+	return c.Default
+}
+
+var (
+	gamingCalendarsMu sync.RWMutex
+	gamingCalendars   GamingCalendars
+)
+
+// SetGamingCalendars installs the process-wide calendars used for audit
+// partition days, report intervals, daily packs, and session activity days.
+func SetGamingCalendars(c GamingCalendars) {
+	gamingCalendarsMu.Lock()
+	defer gamingCalendarsMu.Unlock()
+	gamingCalendars = c
+}
+
+func gamingCalendarFor(tenantID string) GamingCalendar {
+	gamingCalendarsMu.RLock()
+	defer gamingCalendarsMu.RUnlock()
+	return gamingCalendars.For(tenantID)
+}
+
+// auditPartitionDay returns the audit hash-chain partition for t. Audit
+// events are not tenant-scoped, so the default calendar applies; this keeps
+// a daily pack's audit export aligned with its gaming day.
+func auditPartitionDay(t time.Time) string {
+	return gamingCalendarFor("").GamingDay(t)
+}
+
+// ParseGamingCalendar builds a calendar from an IANA zone name and an HH:MM
+// gaming-day start.
+func ParseGamingCalendar(zone, dayStart string) (GamingCalendar, error) {
+	zone = strings.TrimSpace(zone)
+	if zone == "" {
+		zone = "UTC"
+	}
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		return GamingCalendar{}, fmt.Errorf("invalid time zone %q: %w", zone, err)
+	}
+	start, err := parseDayStart(dayStart)
+	if err != nil {
+		return GamingCalendar{}, err
+	}
+	return GamingCalendar{Location: loc, DayStart: start}, nil
+}
+
+func parseDayStart(raw string) (time.Duration, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return 0, nil
+	}
+	t, err := time.Parse("15:04", raw)
+	if err != nil {
+		return 0, fmt.Errorf("invalid gaming day start %q: want HH:MM", raw)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// ParseTenantGamingCalendars parses "tenant=Zone@HH:MM" entries separated by
+// commas, e.g. "op-lv=America/Los_Angeles@06:00,op-mt=Europe/Malta@00:00".
+// The @HH:MM part is optional and defaults to midnight.
+func ParseTenantGamingCalendars(spec string) (map[string]GamingCalendar, error) {
+	out := make(map[string]GamingCalendar)
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		tenant, rest, ok := strings.Cut(entry, "=")
+		tenant = strings.TrimSpace(tenant)
+		if !ok || tenant == "" {
+			return nil, fmt.Errorf("invalid tenant calendar %q: want tenant=Zone@HH:MM", entry)
+		}
+		zone, start, _ := strings.Cut(rest, "@")
+		cal, err := ParseGamingCalendar(zone, start)
+		if err != nil {
+			return nil, fmt.Errorf("tenant %s: %w", tenant, err)
+		}
+		out[tenant] = cal
+	}
+	return out, nil
+}
@@ -0,0 +1,138 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func mustGamingCalendar(t *testing.T, zone, start string) GamingCalendar {
+	t.Helper()
+	cal, err := ParseGamingCalendar(zone, start)
+	if err != nil {
+		t.Fatalf("parse calendar: %v", err)
+	}
+	return cal
+}
+
+func useGamingCalendars(t *testing.T, c GamingCalendars) {
+	t.Helper()
+	SetGamingCalendars(c)
+	t.Cleanup(func() { SetGamingCalendars(GamingCalendars{}) })
+}
+
+func TestGamingCalendarUsesLocalDayStart(t *testing.T) {
+	cal := mustGamingCalendar(t, "America/Los_Angeles", "06:00")
+
+	// 13:30 UTC is 05:30 PST, before the 06:00 open.
+	if got := cal.GamingDay(time.Date(2026, 2, 12, 13, 30, 0, 0, time.UTC)); got != "2026-02-11" {
+		t.Fatalf("expected previous gaming day before open, got=%s", got)
+	}
+	if got := cal.GamingDay(time.Date(2026, 2, 12, 14, 0, 0, 0, time.UTC)); got != "2026-02-12" {
+		t.Fatalf("expected gaming day at open, got=%s", got)
+	}
+	if got := cal.LastClosedGamingDay(time.Date(2026, 2, 12, 14, 0, 0, 0, time.UTC)); got != "2026-02-11" {
+		t.Fatalf("unexpected last closed gaming day: %s", got)
+	}
+}
+
+func TestGamingCalendarWindowFollowsDST(t *testing.T) {
+	cal := mustGamingCalendar(t, "America/Los_Angeles", "06:00")
+
+	// Clocks spring forward at 02:00 on 2026-03-08, inside the 03-07 gaming day.
+	w := cal.Window(time.Date(2026, 3, 7, 0, 0, 0, 0, time.UTC))
+	if !w.start.Equal(time.Date(2026, 3, 7, 14, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected window start: %s", w.start)
+	}
+	if got := w.end.Add(time.Nanosecond).Sub(w.start); got != 23*time.Hour {
+		t.Fatalf("expected 23h spring-forward gaming day, got=%s", got)
+	}
+	next := cal.Window(time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC))
+	if !next.start.Equal(w.end.Add(time.Nanosecond)) {
+		t.Fatalf("expected contiguous windows, end=%s next=%s", w.end, next.start)
+	}
+}
+
+func TestGamingCalendarIntervalStart(t *testing.T) {
+	cal := mustGamingCalendar(t, "Australia/Sydney", "04:00")
+	// 2026-03-01 02:00 AEDT is still gaming day 2026-02-28.
+	now := time.Date(2026, 2, 28, 15, 0, 0, 0, time.UTC)
+
+	cases := map[rgsv1.ReportInterval]time.Time{
+		rgsv1.ReportInterval_REPORT_INTERVAL_DTD: time.Date(2026, 2, 27, 17, 0, 0, 0, time.UTC),
+		rgsv1.ReportInterval_REPORT_INTERVAL_MTD: time.Date(2026, 1, 31, 17, 0, 0, 0, time.UTC),
+		rgsv1.ReportInterval_REPORT_INTERVAL_YTD: time.Date(2025, 12, 31, 17, 0, 0, 0, time.UTC),
+		rgsv1.ReportInterval_REPORT_INTERVAL_LTD: {},
+	}
+	for interval, want := range cases {
+		if got := cal.IntervalStart(now, interval); !got.Equal(want) {
+			t.Fatalf("%s: expected start=%s got=%s", interval, want, got)
+		}
+	}
+}
+
+func TestParseTenantGamingCalendars(t *testing.T) {
+	cals, err := ParseTenantGamingCalendars(" op-lv=America/Los_Angeles@06:00, op-mt=Europe/Malta ,")
+	if err != nil {
+		t.Fatalf("parse err: %v", err)
+	}
+	if len(cals) != 2 || cals["op-lv"].DayStart != 6*time.Hour || cals["op-mt"].DayStart != 0 {
+		t.Fatalf("unexpected calendars: %+v", cals)
+	}
+	if cals["op-mt"].Location.String() != "Europe/Malta" {
+		t.Fatalf("unexpected location: %s", cals["op-mt"].Location)
+	}
+	for _, bad := range []string{"op-a", "=UTC", "op-a=Mars/Olympus", "op-a=UTC@25:00"} {
+		if _, err := ParseTenantGamingCalendars(bad); err == nil {
+			t.Fatalf("expected error for %q", bad)
+		}
+	}
+}
+
+func TestGamingCalendarsApplyToAuditAndReports(t *testing.T) {
+	useGamingCalendars(t, GamingCalendars{
+		Default:  mustGamingCalendar(t, "UTC", "06:00"),
+		ByTenant: map[string]GamingCalendar{"op-lv": mustGamingCalendar(t, "America/Los_Angeles", "00:00")},
+	})
+	now := time.Date(2026, 2, 12, 5, 0, 0, 0, time.UTC)
+
+	if got := auditPartitionDay(now); got != "2026-02-11" {
+		t.Fatalf("expected audit partition on default gaming day, got=%s", got)
+	}
+	if got := intervalWindow(now, rgsv1.ReportInterval_REPORT_INTERVAL_DTD, "").start; !got.Equal(time.Date(2026, 2, 11, 6, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected default DTD start: %s", got)
+	}
+	if got := intervalWindow(now, rgsv1.ReportInterval_REPORT_INTERVAL_DTD, "op-lv").start; !got.Equal(time.Date(2026, 2, 11, 8, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected tenant DTD start: %s", got)
+	}
+	if got := intervalWindow(now, rgsv1.ReportInterval_REPORT_INTERVAL_DTD, "op-unknown").start; !got.Equal(time.Date(2026, 2, 11, 6, 0, 0, 0, time.UTC)) {
+		t.Fatalf("expected unknown tenant to fall back to default, got=%s", got)
+	}
+}
+
+func TestSystemStatusReportsGamingCalendar(t *testing.T) {
+	useGamingCalendars(t, GamingCalendars{
+		ByTenant: map[string]GamingCalendar{"op-lv": mustGamingCalendar(t, "America/Los_Angeles", "06:00")},
+	})
+	svc := newIncidentTestService()
+
+	resp, err := svc.GetSystemStatus(context.Background(), &rgsv1.GetSystemStatusRequest{OperatorId: "op-lv"})
+	if err != nil {
+		t.Fatalf("status err: %v", err)
+	}
+	cal := resp.GamingCalendar
+	if cal.TimeZone != "America/Los_Angeles" || cal.UtcOffsetSeconds != -8*3600 || cal.GamingDayStart != "06:00" {
+		t.Fatalf("unexpected calendar: %+v", cal)
+	}
+	// 09:00 UTC is 01:00 PST, before the 06:00 open.
+	if cal.GamingDay != "2026-02-11" || cal.LocalTime != "2026-02-12T01:00:00-08:00" {
+		t.Fatalf("unexpected local view: %+v", cal)
+	}
+
+	def, _ := svc.GetSystemStatus(context.Background(), &rgsv1.GetSystemStatusRequest{})
+	if def.GamingCalendar.TimeZone != "UTC" || def.GamingCalendar.GamingDay != "2026-02-12" {
+		t.Fatalf("unexpected default calendar: %+v", def.GamingCalendar)
+	}
+}
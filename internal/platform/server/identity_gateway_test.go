@@ -193,7 +193,7 @@ func TestIdentityGatewayRefreshLogoutActorMismatchDenied(t *testing.T) {
 		t.Fatalf("expected actor mismatch reason on logout, got=%q", logoutResp.GetMeta().GetDenialReason())
 	}
 
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	var sawRefreshDenied bool
 	var sawLogoutDenied bool
 	for _, ev := range events {
@@ -350,7 +350,7 @@ func TestIdentityGatewayAdminActorMismatchDenied(t *testing.T) {
 		t.Fatalf("expected actor mismatch with token reason on reset lockout, got=%q", resetResp.GetMeta().GetDenialReason())
 	}
 
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	var sawSetDenied bool
 	var sawDisableDenied bool
 	var sawEnableDenied bool
@@ -20,12 +20,23 @@ import (
 
 var errIdentityPersistenceRequired = errors.New("identity persistence required")
 
+// identitySession is one refresh token. Tokens issued by rotating a
+// session share its familyID, which is the refresh token first issued at
+// login; rotated marks a token that has been exchanged for its successor.
+// authStrength and authTime describe that login and are carried into every
+// access token the family mints, so refreshing never counts as
+// re-authentication.
 type identitySession struct {
 	refreshToken string
+	familyID     string
 	actorID      string
 	actorType    rgsv1.ActorType
+	authStrength string
+	authTime     time.Time
+	createdAt    time.Time
 	expiresAt    time.Time
 	revoked      bool
+	rotated      bool
 }
 
 type loginRateWindow struct {
@@ -37,7 +48,7 @@ type IdentityService struct {
 	rgsv1.UnimplementedIdentityServiceServer
 
 	Clock      clock.Clock
-	AuditStore *audit.InMemoryStore
+	AuditStore audit.Store
 
 	mu              sync.Mutex
 	refreshSessions map[string]*identitySession
@@ -52,9 +63,36 @@ type IdentityService struct {
 	loginRateMax    int
 	loginRateWindow time.Duration
 	loginRates      map[string]loginRateWindow
-	db              *sql.DB
-	onLogin         func(result rgsv1.ResultCode, actorType rgsv1.ActorType)
-	onLockout       func(actorType rgsv1.ActorType)
+	passwordPolicy  PasswordPolicy
+	// sessionLimits caps active refresh sessions per actor, by actor type.
+	sessionLimits      map[rgsv1.ActorType]int
+	sessionLimitPolicy SessionLimitPolicy
+	// playerIdentities holds KYC match hashes when no database is set.
+	playerIdentities map[string]*playerIdentity
+	webauthnRPID     string
+	webauthnRPName   string
+	webauthnOrigins  []string
+	// webauthnCredentials and webauthnChallenges are used when no database
+	// is set; credentials are keyed by credential id.
+	webauthnCredentials map[string]*webauthnCredential
+	webauthnChallenges  map[string]webauthnChallenge
+	oidcVerifier        *platformauth.OIDCVerifier
+	oidcMapping         OIDCClaimMapping
+	oidcRoles           externalRoleSyncer
+	// keyRotations is the keyset rotation history when no database is set.
+	keyRotations []*rgsv1.KeyRotation
+	// ipAllowlists holds per-actor allowlists when no database is set.
+	ipAllowlists map[string]*rgsv1.IPAllowlist
+	// loginHistory holds login attempts, oldest first, when no database is
+	// set.
+	loginHistory       []*rgsv1.LoginAttempt
+	nextLoginAttemptID int64
+	db                 *sql.DB
+	onLogin            func(result rgsv1.ResultCode, actorType rgsv1.ActorType)
+	onLockout          func(actorType rgsv1.ActorType)
+	lockoutNotifier    LockoutNotifier
+	onReuse            func(actorType rgsv1.ActorType)
+	selfExclusion      SelfExclusionChecker
 }
 
 func NewIdentityService(clk clock.Clock, signingSecret string, accessTTL, refreshTTL time.Duration, db ...*sql.DB) *IdentityService {
@@ -72,20 +110,24 @@ func NewIdentityService(clk clock.Clock, signingSecret string, accessTTL, refres
 		signingSecret = "dev-insecure-change-me"
 	}
 	return &IdentityService{
-		Clock:           clk,
-		AuditStore:      audit.NewInMemoryStore(),
-		refreshSessions: make(map[string]*identitySession),
-		failedAttempts:  make(map[string]int),
-		lockedUntil:     make(map[string]time.Time),
-		tokenSigner:     platformauth.NewJWTSigner(signingSecret),
-		accessTTL:       accessTTL,
-		refreshTTL:      refreshTTL,
-		lockoutTTL:      15 * time.Minute,
-		maxFailures:     5,
-		loginRateMax:    60,
-		loginRateWindow: time.Minute,
-		loginRates:      make(map[string]loginRateWindow),
-		db:              handle,
+		Clock:               clk,
+		AuditStore:          NewAuditStore(handle),
+		refreshSessions:     make(map[string]*identitySession),
+		failedAttempts:      make(map[string]int),
+		lockedUntil:         make(map[string]time.Time),
+		tokenSigner:         platformauth.NewJWTSigner(signingSecret),
+		accessTTL:           accessTTL,
+		refreshTTL:          refreshTTL,
+		lockoutTTL:          15 * time.Minute,
+		maxFailures:         5,
+		loginRateMax:        60,
+		loginRateWindow:     time.Minute,
+		loginRates:          make(map[string]loginRateWindow),
+		passwordPolicy:      DefaultPasswordPolicy,
+		playerIdentities:    make(map[string]*playerIdentity),
+		webauthnCredentials: make(map[string]*webauthnCredential),
+		webauthnChallenges:  make(map[string]webauthnChallenge),
+		db:                  handle,
 	}
 }
 
@@ -108,6 +150,54 @@ func (s *IdentityService) SetMetricsObservers(onLogin func(result rgsv1.ResultCo
 	s.onLockout = onLockout
 }
 
+// SetLockoutNotifier sets the notifier told when an actor becomes locked
+// out.
+func (s *IdentityService) SetLockoutNotifier(n LockoutNotifier) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lockoutNotifier = n
+}
+
+// lockoutActivated reports a lockout that recordFailure just activated.
+func (s *IdentityService) lockoutActivated(ctx context.Context, actorID string, actorType rgsv1.ActorType) {
+	if s.onLockout != nil {
+		s.onLockout(actorType)
+	}
+	if s.lockoutNotifier != nil {
+		now := s.now()
+		_ = s.lockoutNotifier.NotifyLockout(ctx, LockoutEvent{
+			Kind:        LockoutKindIdentity,
+			Subject:     actorID,
+			ActorType:   actorType.String(),
+			LockedUntil: now.Add(s.lockoutTTL),
+			OccurredAt:  now,
+		})
+	}
+}
+
+// SetSelfExclusionChecker sets the register consulted before a player
+// logs in; self-excluded players are denied with "player self-excluded".
+func (s *IdentityService) SetSelfExclusionChecker(c SelfExclusionChecker) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.selfExclusion = c
+}
+
+func (s *IdentityService) SetRefreshReuseObserver(onReuse func(actorType rgsv1.ActorType)) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.onReuse = onReuse
+}
+
 func (s *IdentityService) SetLockoutPolicy(maxFailures int, ttl time.Duration) {
 	if s == nil {
 		return
@@ -162,38 +252,8 @@ func (s *IdentityService) nextAuditIDLocked() string {
 }
 
 func (s *IdentityService) appendAudit(meta *rgsv1.RequestMeta, objectID, action string, before, after []byte, result audit.Result, reason string) error {
-	if s.AuditStore == nil {
-		return audit.ErrCorruptChain
-	}
-	actorID := "system"
-	actorType := "service"
-	if meta != nil && meta.Actor != nil {
-		actorID = meta.Actor.ActorId
-		actorType = meta.Actor.ActorType.String()
-	}
-	now := s.now()
-	ev := audit.Event{
-		AuditID:      s.nextAuditIDLocked(),
-		OccurredAt:   now,
-		RecordedAt:   now,
-		ActorID:      actorID,
-		ActorType:    actorType,
-		ObjectType:   "identity_session",
-		ObjectID:     objectID,
-		Action:       action,
-		Before:       before,
-		After:        after,
-		Result:       result,
-		Reason:       reason,
-		PartitionDay: now.Format("2006-01-02"),
-	}
-	if s.db != nil {
-		if err := appendAuditEventToDB(context.Background(), s.db, ev); err != nil {
-			return err
-		}
-	}
-	_, err := s.AuditStore.Append(ev)
-	return err
+	ev := newAuditEvent(meta, s.nextAuditIDLocked(), s.now(), "identity_session", objectID, action, before, after, result, reason)
+	return appendAuditTo(context.Background(), s.AuditStore, ev)
 }
 
 func (s *IdentityService) auditDenied(meta *rgsv1.RequestMeta, objectID, action, reason string) {
@@ -225,16 +285,26 @@ func (s *IdentityService) validateLoginRequest(req *rgsv1.LoginRequest) (string,
 			return "", rgsv1.ActorType_ACTOR_TYPE_UNSPECIFIED, "actor must match operator credentials"
 		}
 		return creds.Operator.OperatorId, rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""
+	case *rgsv1.LoginRequest_Oidc:
+		if creds.Oidc == nil || creds.Oidc.IdToken == "" {
+			return "", rgsv1.ActorType_ACTOR_TYPE_UNSPECIFIED, "id_token is required"
+		}
+		if req.Meta.Actor.ActorType != rgsv1.ActorType_ACTOR_TYPE_OPERATOR {
+			return "", rgsv1.ActorType_ACTOR_TYPE_UNSPECIFIED, "oidc login is for operators"
+		}
+		return req.Meta.Actor.ActorId, rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""
 	default:
 		return "", rgsv1.ActorType_ACTOR_TYPE_UNSPECIFIED, "credentials are required"
 	}
 }
 
-func (s *IdentityService) signAccessToken(actorID string, actorType rgsv1.ActorType) (string, string, error) {
+func (s *IdentityService) signAccessToken(sess *identitySession) (string, string, error) {
 	now := s.now()
 	signed, expiresAt, err := s.tokenSigner.SignActor(platformauth.Actor{
-		ID:   actorID,
-		Type: actorType.String(),
+		ID:           sess.actorID,
+		Type:         sess.actorType.String(),
+		AuthStrength: sess.authStrength,
+		AuthTime:     sess.authTime,
 	}, now, s.accessTTL)
 	if err != nil {
 		return "", "", err
@@ -392,7 +462,7 @@ func (s *IdentityService) verifyCredentials(ctx context.Context, actorID string,
 		const q = `
 SELECT password_hash, status
 FROM identity_credentials
-WHERE actor_id = $1 AND actor_type = $2
+WHERE actor_id = $1 AND actor_type = $2 AND credential_type = 'password'
 `
 		var hash, status string
 		err := s.db.QueryRowContext(ctx, q, actorID, actorType.String()).Scan(&hash, &status)
@@ -421,14 +491,15 @@ func (s *IdentityService) setCredentialHash(ctx context.Context, actorID string,
 		return errIdentityPersistenceRequired
 	}
 	const q = `
-INSERT INTO identity_credentials (actor_id, actor_type, password_hash, status, updated_at)
-VALUES ($1, $2, $3, 'active', NOW())
-ON CONFLICT (actor_id, actor_type) DO UPDATE
+INSERT INTO identity_credentials (actor_id, actor_type, credential_type, credential_id, password_hash, status, updated_at, password_changed_at)
+VALUES ($1, $2, 'password', '', $3, 'active', NOW(), $4)
+ON CONFLICT (actor_id, actor_type, credential_type, credential_id) DO UPDATE
 SET password_hash = EXCLUDED.password_hash,
     status = 'active',
-    updated_at = NOW()
+    updated_at = NOW(),
+    password_changed_at = EXCLUDED.password_changed_at
 `
-	_, err := s.db.ExecContext(ctx, q, actorID, actorType.String(), hash)
+	_, err := s.db.ExecContext(ctx, q, actorID, actorType.String(), hash, s.now())
 	return err
 }
 
@@ -443,7 +514,7 @@ func (s *IdentityService) authorizeIdentityAdmin(ctx context.Context, meta *rgsv
 	return true, ""
 }
 
-func (s *IdentityService) Login(ctx context.Context, req *rgsv1.LoginRequest) (*rgsv1.LoginResponse, error) {
+func (s *IdentityService) login(ctx context.Context, req *rgsv1.LoginRequest) (*rgsv1.LoginResponse, error) {
 	actorID, actorType, reason := s.validateLoginRequest(req)
 	if reason != "" {
 		var meta *rgsv1.RequestMeta
@@ -456,6 +527,25 @@ func (s *IdentityService) Login(ctx context.Context, req *rgsv1.LoginRequest) (*
 		}
 		return &rgsv1.LoginResponse{Meta: s.responseMeta(meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
 	}
+	s.mu.Lock()
+	allowed, err := s.ipAllowedLocked(ctx, actorID, actorType, clientIP(ctx))
+	if err == nil && !allowed {
+		s.auditDenied(req.Meta, "", "identity_login", ipNotAllowlisted)
+	}
+	s.mu.Unlock()
+	if err != nil || !allowed {
+		code, reason := rgsv1.ResultCode_RESULT_CODE_DENIED, ipNotAllowlisted
+		if err != nil {
+			code, reason = rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable"
+		}
+		if s.onLogin != nil {
+			s.onLogin(code, actorType)
+		}
+		return &rgsv1.LoginResponse{Meta: s.responseMeta(req.Meta, code, reason)}, nil
+	}
+	if creds, ok := req.Credentials.(*rgsv1.LoginRequest_Oidc); ok {
+		return s.loginOIDC(ctx, req.Meta, actorID, creds.Oidc.GetIdToken()), nil
+	}
 	secret := ""
 	switch creds := req.Credentials.(type) {
 	case *rgsv1.LoginRequest_Player:
@@ -506,8 +596,8 @@ func (s *IdentityService) Login(ctx context.Context, req *rgsv1.LoginRequest) (*
 	}
 	if !okCreds {
 		lockedNow, _ := s.recordFailure(ctx, actorID, actorType)
-		if lockedNow && s.onLockout != nil {
-			s.onLockout(actorType)
+		if lockedNow {
+			s.lockoutActivated(ctx, actorID, actorType)
 		}
 		s.auditDenied(req.Meta, "", "identity_login", "invalid credentials")
 		if s.onLogin != nil {
@@ -521,64 +611,108 @@ func (s *IdentityService) Login(ctx context.Context, req *rgsv1.LoginRequest) (*
 		}
 		return &rgsv1.LoginResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
 	}
-
-	accessToken, accessExpiry, err := s.signAccessToken(actorID, actorType)
+	expired, err := s.credentialExpiredLocked(ctx, actorID, actorType)
 	if err != nil {
 		if s.onLogin != nil {
 			s.onLogin(rgsv1.ResultCode_RESULT_CODE_ERROR, actorType)
 		}
-		return &rgsv1.LoginResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "failed to sign token")}, nil
+		return &rgsv1.LoginResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
 	}
-	refreshToken, err := randomToken()
-	if err != nil {
+	if expired {
+		s.auditDenied(req.Meta, "", "identity_login", "credential expired")
+		if s.onLogin != nil {
+			s.onLogin(rgsv1.ResultCode_RESULT_CODE_DENIED, actorType)
+		}
+		return &rgsv1.LoginResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, "credential expired")}, nil
+	}
+	if actorType == rgsv1.ActorType_ACTOR_TYPE_PLAYER {
+		blocked, err := s.playerIdentityLoginBlock(ctx, actorID)
+		if err == nil && blocked == "" && s.selfExclusion != nil {
+			var excluded bool
+			if excluded, err = s.selfExclusion.PlayerSelfExcluded(ctx, actorID); excluded {
+				blocked = selfExcludedReason
+			}
+		}
+		if err != nil {
+			if s.onLogin != nil {
+				s.onLogin(rgsv1.ResultCode_RESULT_CODE_ERROR, actorType)
+			}
+			return &rgsv1.LoginResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+		if blocked != "" {
+			s.auditDenied(req.Meta, "", "identity_login", blocked)
+			if s.onLogin != nil {
+				s.onLogin(rgsv1.ResultCode_RESULT_CODE_DENIED, actorType)
+			}
+			return &rgsv1.LoginResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, blocked)}, nil
+		}
+	}
+
+	token, meta := s.issueSessionLocked(ctx, req.Meta, actorID, actorType, platformauth.AuthStrengthPassword, "identity_login")
+	return &rgsv1.LoginResponse{Meta: meta, Token: token}, nil
+}
+
+// issueSessionLocked signs an access token and stores a refresh session for
+// an actor whose credentials have been verified with the given strength,
+// auditing it under action. The token is nil unless the returned meta is OK.
+func (s *IdentityService) issueSessionLocked(ctx context.Context, meta *rgsv1.RequestMeta, actorID string, actorType rgsv1.ActorType, strength, action string) (*rgsv1.SessionToken, *rgsv1.ResponseMeta) {
+	fail := func(reason string) (*rgsv1.SessionToken, *rgsv1.ResponseMeta) {
 		if s.onLogin != nil {
 			s.onLogin(rgsv1.ResultCode_RESULT_CODE_ERROR, actorType)
 		}
-		return &rgsv1.LoginResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "failed to create refresh token")}, nil
+		return nil, s.responseMeta(meta, rgsv1.ResultCode_RESULT_CODE_ERROR, reason)
+	}
+	if limited := s.enforceSessionLimitLocked(ctx, meta, actorID, actorType, action); limited != nil {
+		if s.onLogin != nil {
+			s.onLogin(limited.ResultCode, actorType)
+		}
+		return nil, limited
+	}
+	refreshToken, err := randomToken()
+	if err != nil {
+		return fail("failed to create refresh token")
 	}
 
 	expiresAt := s.now().Add(s.refreshTTL)
 	sess := &identitySession{
 		refreshToken: refreshToken,
+		familyID:     refreshToken,
 		actorID:      actorID,
 		actorType:    actorType,
+		authStrength: strength,
+		authTime:     s.now(),
+		createdAt:    s.now(),
 		expiresAt:    expiresAt,
 	}
+	accessToken, accessExpiry, err := s.signAccessToken(sess)
+	if err != nil {
+		return fail("failed to sign token")
+	}
 	if s.db != nil {
 		if err := s.storeSession(ctx, sess); err != nil {
-			if s.onLogin != nil {
-				s.onLogin(rgsv1.ResultCode_RESULT_CODE_ERROR, actorType)
-			}
-			return &rgsv1.LoginResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+			return fail("persistence unavailable")
 		}
 	} else {
 		s.refreshSessions[refreshToken] = sess
 	}
-	if err := s.appendAudit(req.Meta, refreshToken, "identity_login", []byte(`{}`), sessionSnapshot(refreshToken, actorID, actorType, expiresAt, false), audit.ResultSuccess, ""); err != nil {
+	if err := s.appendAudit(meta, refreshToken, action, []byte(`{}`), sessionSnapshot(refreshToken, actorID, actorType, expiresAt, false), audit.ResultSuccess, ""); err != nil {
 		if s.db != nil {
 			_ = s.revokeSession(ctx, refreshToken)
 		} else {
 			delete(s.refreshSessions, refreshToken)
 		}
-		if s.onLogin != nil {
-			s.onLogin(rgsv1.ResultCode_RESULT_CODE_ERROR, actorType)
-		}
-		return &rgsv1.LoginResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+		return fail("audit unavailable")
 	}
 	if s.onLogin != nil {
 		s.onLogin(rgsv1.ResultCode_RESULT_CODE_OK, actorType)
 	}
-
-	return &rgsv1.LoginResponse{
-		Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
-		Token: &rgsv1.SessionToken{
-			AccessToken:  accessToken,
-			RefreshToken: refreshToken,
-			TokenType:    "Bearer",
-			ExpiresAt:    accessExpiry,
-			Actor:        &rgsv1.Actor{ActorId: actorID, ActorType: actorType},
-		},
-	}, nil
+	return &rgsv1.SessionToken{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		TokenType:    "Bearer",
+		ExpiresAt:    accessExpiry,
+		Actor:        &rgsv1.Actor{ActorId: actorID, ActorType: actorType},
+	}, s.responseMeta(meta, rgsv1.ResultCode_RESULT_CODE_OK, "")
 }
 
 func (s *IdentityService) Logout(ctx context.Context, req *rgsv1.LogoutRequest) (*rgsv1.LogoutResponse, error) {
@@ -647,6 +781,9 @@ func (s *IdentityService) RefreshToken(ctx context.Context, req *rgsv1.RefreshTo
 	} else {
 		sess = s.refreshSessions[req.RefreshToken]
 	}
+	if sess != nil && sess.rotated {
+		return &rgsv1.RefreshTokenResponse{Meta: s.revokeSessionFamilyLocked(ctx, req.Meta, sess)}, nil
+	}
 	if sess == nil || sess.revoked || !sess.expiresAt.After(s.now()) {
 		s.auditDenied(req.Meta, req.RefreshToken, "identity_refresh", "invalid refresh token")
 		return &rgsv1.RefreshTokenResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, "invalid refresh token")}, nil
@@ -656,7 +793,7 @@ func (s *IdentityService) RefreshToken(ctx context.Context, req *rgsv1.RefreshTo
 		return &rgsv1.RefreshTokenResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, "actor mismatch with token")}, nil
 	}
 
-	accessToken, accessExpiry, err := s.signAccessToken(sess.actorID, sess.actorType)
+	accessToken, accessExpiry, err := s.signAccessToken(sess)
 	if err != nil {
 		return &rgsv1.RefreshTokenResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "failed to sign token")}, nil
 	}
@@ -669,8 +806,12 @@ func (s *IdentityService) RefreshToken(ctx context.Context, req *rgsv1.RefreshTo
 	newExpiry := s.now().Add(s.refreshTTL)
 	next := &identitySession{
 		refreshToken: newRefreshToken,
+		familyID:     sess.familyID,
 		actorID:      sess.actorID,
 		actorType:    sess.actorType,
+		authStrength: sess.authStrength,
+		authTime:     sess.authTime,
+		createdAt:    s.now(),
 		expiresAt:    newExpiry,
 	}
 	if s.db != nil {
@@ -678,12 +819,14 @@ func (s *IdentityService) RefreshToken(ctx context.Context, req *rgsv1.RefreshTo
 			return &rgsv1.RefreshTokenResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
 		}
 	} else {
-		delete(s.refreshSessions, req.RefreshToken)
+		// The rotated token is kept so a later replay of it is recognized.
+		sess.revoked, sess.rotated = true, true
 		s.refreshSessions[newRefreshToken] = next
 	}
 	after := sessionSnapshot(newRefreshToken, sess.actorID, sess.actorType, newExpiry, false)
 	if err := s.appendAudit(req.Meta, newRefreshToken, "identity_refresh", before, after, audit.ResultSuccess, ""); err != nil {
 		if s.db == nil {
+			sess.revoked, sess.rotated = false, false
 			delete(s.refreshSessions, newRefreshToken)
 		}
 		return &rgsv1.RefreshTokenResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
@@ -701,6 +844,48 @@ func (s *IdentityService) RefreshToken(ctx context.Context, req *rgsv1.RefreshTo
 	}, nil
 }
 
+// revokeSessionFamilyLocked handles a rotated refresh token presented
+// again. Either the holder or whoever copied the token has since used its
+// successor, so neither can be trusted: every session descended from the
+// same login is revoked and the actor must authenticate again.
+func (s *IdentityService) revokeSessionFamilyLocked(ctx context.Context, meta *rgsv1.RequestMeta, sess *identitySession) *rgsv1.ResponseMeta {
+	const reason = "refresh token reuse detected"
+	var revoked int64
+	if s.db != nil {
+		n, err := s.revokeSessionFamily(ctx, sess.familyID)
+		if err != nil {
+			return s.responseMeta(meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")
+		}
+		revoked = n
+	} else {
+		for token, member := range s.refreshSessions {
+			if member.familyID != sess.familyID {
+				continue
+			}
+			if !member.revoked {
+				revoked++
+			}
+			member.revoked = true
+			if !member.rotated {
+				delete(s.refreshSessions, token)
+			}
+		}
+	}
+	if s.onReuse != nil {
+		s.onReuse(sess.actorType)
+	}
+	after, _ := json.Marshal(map[string]any{
+		"family_id":        sess.familyID,
+		"actor_id":         sess.actorID,
+		"actor_type":       sess.actorType.String(),
+		"revoked_sessions": revoked,
+	})
+	if err := s.appendAudit(meta, sess.familyID, "identity_refresh_reuse", []byte(`{}`), after, audit.ResultDenied, reason); err != nil {
+		return s.responseMeta(meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")
+	}
+	return s.responseMeta(meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)
+}
+
 func (s *IdentityService) SetCredential(ctx context.Context, req *rgsv1.SetCredentialRequest) (*rgsv1.SetCredentialResponse, error) {
 	if req == nil || req.Actor == nil || req.Actor.ActorId == "" || req.Actor.ActorType == rgsv1.ActorType_ACTOR_TYPE_UNSPECIFIED || req.CredentialHash == "" {
 		return &rgsv1.SetCredentialResponse{Meta: s.responseMeta(nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "actor and credential hash are required")}, nil
@@ -9,6 +9,7 @@ import (
 
 	_ "github.com/jackc/pgx/v5/stdlib"
 	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
 	platformauth "github.com/wizardbeardstudio/open-rgs-go/internal/platform/auth"
 	"golang.org/x/crypto/bcrypt"
 )
@@ -71,6 +72,62 @@ func TestIdentityPlayerLoginRefreshLogout(t *testing.T) {
 	}
 }
 
+func TestIdentityRefreshReuseRevokesFamily(t *testing.T) {
+	svc := NewIdentityService(ledgerFixedClock{now: time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)}, "test-secret", 15*time.Minute, time.Hour)
+	var reused []rgsv1.ActorType
+	svc.SetRefreshReuseObserver(func(actorType rgsv1.ActorType) { reused = append(reused, actorType) })
+	ctx := context.Background()
+
+	login := func() *rgsv1.SessionToken {
+		resp, _ := svc.Login(ctx, &rgsv1.LoginRequest{
+			Meta: meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""),
+			Credentials: &rgsv1.LoginRequest_Player{
+				Player: &rgsv1.PlayerCredentials{PlayerId: "player-1", Pin: "1234"},
+			},
+		})
+		return resp.Token
+	}
+	refresh := func(token string) *rgsv1.RefreshTokenResponse {
+		resp, _ := svc.RefreshToken(ctx, &rgsv1.RefreshTokenRequest{
+			Meta:         meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""),
+			RefreshToken: token,
+		})
+		return resp
+	}
+
+	stolen := login()
+	other := login()
+	first := refresh(stolen.RefreshToken)
+	second := refresh(first.Token.GetRefreshToken())
+	if second.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected chained refresh ok, got=%+v", second.Meta)
+	}
+
+	replay := refresh(stolen.RefreshToken)
+	if replay.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED || replay.Meta.GetDenialReason() != "refresh token reuse detected" {
+		t.Fatalf("expected reuse detected, got=%+v", replay.Meta)
+	}
+	if len(reused) != 1 || reused[0] != rgsv1.ActorType_ACTOR_TYPE_PLAYER {
+		t.Fatalf("expected one reuse observation, got=%v", reused)
+	}
+	if resp := refresh(second.Token.GetRefreshToken()); resp.Meta.GetDenialReason() != "invalid refresh token" {
+		t.Fatalf("expected latest token in family revoked, got=%+v", resp.Meta)
+	}
+	if resp := refresh(other.RefreshToken); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected unrelated session unaffected, got=%+v", resp.Meta)
+	}
+
+	var audited bool
+	for _, ev := range auditEvents(svc.AuditStore) {
+		if ev.Action == "identity_refresh_reuse" && ev.ObjectID == stolen.RefreshToken && ev.Result == audit.ResultDenied {
+			audited = true
+		}
+	}
+	if !audited {
+		t.Fatalf("expected reuse to be audited against the token family")
+	}
+}
+
 func TestIdentityRefreshLogoutActorMismatchDenied(t *testing.T) {
 	svc := NewIdentityService(ledgerFixedClock{now: time.Date(2026, 2, 13, 12, 5, 0, 0, time.UTC)}, "test-secret", 15*time.Minute, time.Hour)
 	ctx := context.Background()
@@ -116,7 +173,7 @@ func TestIdentityRefreshLogoutActorMismatchDenied(t *testing.T) {
 		t.Fatalf("expected actor mismatch reason on logout, got=%q", logoutMismatch.Meta.GetDenialReason())
 	}
 
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) < 3 {
 		t.Fatalf("expected audit events for login and mismatch denials, got=%v", events)
 	}
@@ -555,7 +612,7 @@ func TestIdentityAdminActorMismatchDenied(t *testing.T) {
 		t.Fatalf("expected actor mismatch with token on reset lockout, got=%q", resetResp.Meta.GetDenialReason())
 	}
 
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	var sawSetDenied bool
 	var sawDisableDenied bool
 	var sawEnableDenied bool
@@ -0,0 +1,136 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	platformauth "github.com/wizardbeardstudio/open-rgs-go/internal/platform/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// maxImpersonationTTL bounds AssumeActor tokens, which cannot be refreshed
+// or revoked.
+const maxImpersonationTTL = 15 * time.Minute
+
+func (s *IdentityService) AssumeActor(ctx context.Context, req *rgsv1.AssumeActorRequest) (*rgsv1.AssumeActorResponse, error) {
+	if req == nil {
+		req = &rgsv1.AssumeActorRequest{}
+	}
+	invalid := func(reason string) (*rgsv1.AssumeActorResponse, error) {
+		return &rgsv1.AssumeActorResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, reason)}, nil
+	}
+	if req.Actor == nil || req.Actor.ActorId == "" || req.Actor.ActorType != rgsv1.ActorType_ACTOR_TYPE_PLAYER {
+		return invalid("player actor is required")
+	}
+	if req.Reason == "" {
+		return invalid("reason is required")
+	}
+	ttl := time.Duration(req.TtlSeconds) * time.Second
+	if req.TtlSeconds < 0 || ttl > maxImpersonationTTL {
+		return invalid("ttl_seconds must be between 0 and 900")
+	}
+	if ttl == 0 {
+		ttl = maxImpersonationTTL
+	}
+	caller, reason := resolveActor(ctx, req.Meta)
+	if reason == "" && caller.ActorType != rgsv1.ActorType_ACTOR_TYPE_OPERATOR {
+		reason = "operator actor required"
+	}
+	if reason == "" && req.Meta.GetImpersonator() != nil {
+		reason = "impersonated callers cannot assume another actor"
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if reason != "" {
+		s.auditDenied(req.Meta, req.Actor.ActorId, "identity_assume_actor", reason)
+		return &rgsv1.AssumeActorResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	// The token inherits the operator's login strength and time, so step-up
+	// checks see the operator's authentication rather than a fresh one.
+	authed, _ := platformauth.ActorFromContext(ctx)
+	token, expiresAt, err := s.tokenSigner.SignActor(platformauth.Actor{
+		ID:               req.Actor.ActorId,
+		Type:             req.Actor.ActorType.String(),
+		AuthStrength:     authed.AuthStrength,
+		AuthTime:         authed.AuthTime,
+		ImpersonatorID:   caller.ActorId,
+		ImpersonatorType: caller.ActorType.String(),
+	}, s.now(), ttl)
+	if err != nil {
+		return &rgsv1.AssumeActorResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "token signing unavailable")}, nil
+	}
+	after, _ := json.Marshal(map[string]any{
+		"actor_id":   req.Actor.ActorId,
+		"actor_type": req.Actor.ActorType.String(),
+		"expires_at": expiresAt.Format(time.RFC3339Nano),
+	})
+	if err := s.appendAudit(req.Meta, req.Actor.ActorId, "identity_assume_actor", []byte(`{}`), after, audit.ResultSuccess, req.Reason); err != nil {
+		return &rgsv1.AssumeActorResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	return &rgsv1.AssumeActorResponse{
+		Meta:        s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
+		AccessToken: token,
+		ExpiresAt:   expiresAt.Format(time.RFC3339Nano),
+	}, nil
+}
+
+// tagImpersonation sets ResponseMeta.impersonator on resp when the caller on
+// ctx is impersonated.
+func tagImpersonation(ctx context.Context, resp any) {
+	actor, ok := platformauth.ActorFromContext(ctx)
+	if !ok || !actor.Impersonated() {
+		return
+	}
+	withMeta, ok := resp.(interface{ GetMeta() *rgsv1.ResponseMeta })
+	if !ok || withMeta.GetMeta() == nil {
+		return
+	}
+	withMeta.GetMeta().Impersonator = &rgsv1.Actor{ActorId: actor.ImpersonatorID, ActorType: actorTypeFromString(actor.ImpersonatorType)}
+}
+
+// UnaryImpersonationInterceptor tags the responses of impersonated calls; it
+// must run after the JWT interceptor so the actor is on the context.
+func UnaryImpersonationInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err == nil {
+			tagImpersonation(ctx, resp)
+		}
+		return resp, err
+	}
+}
+
+// StreamImpersonationInterceptor tags every message an impersonated stream
+// sends, like UnaryImpersonationInterceptor; it must run after the JWT
+// interceptor so the actor is on the context.
+func StreamImpersonationInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if actor, ok := platformauth.ActorFromContext(ss.Context()); !ok || !actor.Impersonated() {
+			return handler(srv, ss)
+		}
+		return handler(srv, &impersonationServerStream{ServerStream: ss})
+	}
+}
+
+type impersonationServerStream struct {
+	grpc.ServerStream
+}
+
+func (s *impersonationServerStream) SendMsg(m any) error {
+	tagImpersonation(s.Context(), m)
+	return s.ServerStream.SendMsg(m)
+}
+
+// ImpersonationForwardResponseOption tags gateway responses the way
+// UnaryImpersonationInterceptor tags gRPC ones; pass it to
+// runtime.WithForwardResponseOption.
+func ImpersonationForwardResponseOption(ctx context.Context, _ http.ResponseWriter, resp proto.Message) error {
+	tagImpersonation(ctx, resp)
+	return nil
+}
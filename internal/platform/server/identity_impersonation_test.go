@@ -0,0 +1,148 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	platformauth "github.com/wizardbeardstudio/open-rgs-go/internal/platform/auth"
+	"google.golang.org/grpc"
+)
+
+func TestIdentityAssumeActorIssuesImpersonationToken(t *testing.T) {
+	// Access tokens are verified against the wall clock.
+	now := time.Now().UTC().Truncate(time.Second)
+	svc := NewIdentityService(ledgerFixedClock{now: now}, "test-secret", 15*time.Minute, time.Hour)
+	verifier := platformauth.NewJWTVerifier("test-secret")
+	operator := platformauth.Actor{ID: "op-1", Type: "ACTOR_TYPE_OPERATOR", AuthStrength: platformauth.AuthStrengthMFA, AuthTime: now.Add(-time.Minute)}
+	ctx := platformauth.WithActor(context.Background(), operator)
+	player := &rgsv1.Actor{ActorId: "player-1", ActorType: rgsv1.ActorType_ACTOR_TYPE_PLAYER}
+	opMeta := meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+
+	for _, tc := range []struct {
+		req  *rgsv1.AssumeActorRequest
+		want rgsv1.ResultCode
+	}{
+		{&rgsv1.AssumeActorRequest{Meta: opMeta, Actor: &rgsv1.Actor{ActorId: "op-2", ActorType: rgsv1.ActorType_ACTOR_TYPE_OPERATOR}, Reason: "r"}, rgsv1.ResultCode_RESULT_CODE_INVALID},
+		{&rgsv1.AssumeActorRequest{Meta: opMeta, Actor: player}, rgsv1.ResultCode_RESULT_CODE_INVALID},
+		{&rgsv1.AssumeActorRequest{Meta: opMeta, Actor: player, Reason: "r", TtlSeconds: 3600}, rgsv1.ResultCode_RESULT_CODE_INVALID},
+	} {
+		if resp, _ := svc.AssumeActor(ctx, tc.req); resp.Meta.GetResultCode() != tc.want {
+			t.Fatalf("%+v: got=%+v want=%v", tc.req, resp.Meta, tc.want)
+		}
+	}
+	playerCtx := platformauth.WithActor(context.Background(), platformauth.Actor{ID: "player-2", Type: "ACTOR_TYPE_PLAYER"})
+	if resp, _ := svc.AssumeActor(playerCtx, &rgsv1.AssumeActorRequest{Meta: meta("player-2", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""), Actor: player, Reason: "r"}); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected player caller denied, got=%+v", resp.Meta)
+	}
+
+	resp, _ := svc.AssumeActor(ctx, &rgsv1.AssumeActorRequest{Meta: opMeta, Actor: player, Reason: "ticket 4411: balance query", TtlSeconds: 300})
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || resp.ExpiresAt != now.Add(5*time.Minute).Format(time.RFC3339Nano) {
+		t.Fatalf("expected impersonation token, got=%+v", resp)
+	}
+	actor, err := verifier.ParseActor(resp.AccessToken)
+	if err != nil || actor.ID != "player-1" || actor.ImpersonatorID != "op-1" || actor.AuthStrength != platformauth.AuthStrengthMFA || !actor.AuthTime.Equal(operator.AuthTime) {
+		t.Fatalf("expected player token carrying operator, actor=%+v err=%v", actor, err)
+	}
+	var audited bool
+	for _, ev := range auditEvents(svc.AuditStore) {
+		if ev.Action == "identity_assume_actor" && ev.ActorID == "op-1" && ev.ObjectID == "player-1" && ev.Reason == "ticket 4411: balance query" {
+			audited = true
+		}
+	}
+	if !audited {
+		t.Fatalf("expected assume actor audited")
+	}
+
+	// An impersonated caller cannot chain into another identity.
+	chained := platformauth.WithActor(context.Background(), platformauth.Actor{ID: "op-3", Type: "ACTOR_TYPE_OPERATOR", ImpersonatorID: "op-1", ImpersonatorType: "ACTOR_TYPE_OPERATOR"})
+	if resp, _ := svc.AssumeActor(chained, &rgsv1.AssumeActorRequest{Meta: meta("op-3", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), Actor: player, Reason: "r"}); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected impersonated caller denied, got=%+v", resp.Meta)
+	}
+}
+
+func TestImpersonatedCallsAreTaggedAndAudited(t *testing.T) {
+	ledger := NewLedgerService(ledgerFixedClock{now: time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)})
+	impersonated := platformauth.Actor{ID: "player-1", Type: "ACTOR_TYPE_PLAYER", ImpersonatorID: "op-1", ImpersonatorType: "ACTOR_TYPE_OPERATOR"}
+	ctx := platformauth.WithActor(context.Background(), impersonated)
+
+	// A client-supplied impersonator is replaced by the token's.
+	req := &rgsv1.DepositRequest{
+		Meta:      meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "imp-dep-1"),
+		AccountId: "player-1",
+		Amount:    &rgsv1.Money{AmountMinor: 500, Currency: "USD"},
+	}
+	req.Meta.Impersonator = &rgsv1.Actor{ActorId: "someone-else", ActorType: rgsv1.ActorType_ACTOR_TYPE_OPERATOR}
+	resp, err := UnaryImpersonationInterceptor()(ctx, req, &grpc.UnaryServerInfo{FullMethod: "/rgs.v1.LedgerService/Deposit"}, func(ctx context.Context, req any) (any, error) {
+		return ledger.Deposit(ctx, req.(*rgsv1.DepositRequest))
+	})
+	if err != nil {
+		t.Fatalf("deposit: %v", err)
+	}
+	meta := resp.(*rgsv1.DepositResponse).Meta
+	if meta.GetImpersonator().GetActorId() != "op-1" {
+		t.Fatalf("expected response tagged with impersonator, got=%+v", meta)
+	}
+	events := auditEvents(ledger.AuditStore)
+	if len(events) == 0 {
+		t.Fatalf("expected deposit audited")
+	}
+	last := events[len(events)-1]
+	if last.ActorID != "player-1" || last.AuthContext != "impersonator=ACTOR_TYPE_OPERATOR:op-1" {
+		t.Fatalf("expected audit to record both identities, got actor=%s context=%q", last.ActorID, last.AuthContext)
+	}
+
+	svc := NewIdentityService(ledgerFixedClock{now: time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)}, "test-secret", 15*time.Minute, time.Hour)
+	gwMux := runtime.NewServeMux(runtime.WithForwardResponseOption(ImpersonationForwardResponseOption))
+	if err := rgsv1.RegisterIdentityServiceHandlerServer(context.Background(), gwMux, svc); err != nil {
+		t.Fatalf("register identity gateway handlers: %v", err)
+	}
+	httpReq := httptest.NewRequest(http.MethodGet, "/v1/identity/key-rotations", nil)
+	httpReq = httpReq.WithContext(platformauth.WithActor(httpReq.Context(), impersonated))
+	rec := httptest.NewRecorder()
+	gwMux.ServeHTTP(rec, httpReq)
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `"impersonator":{"actorId":"op-1"`) {
+		t.Fatalf("expected gateway response tagged with impersonator, status=%d body=%s", rec.Code, rec.Body.String())
+	}
+}
+
+// recordingServerStream is a grpc.ServerStream that records what is sent.
+type recordingServerStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []any
+}
+
+func (s *recordingServerStream) Context() context.Context { return s.ctx }
+
+func (s *recordingServerStream) SendMsg(m any) error {
+	s.sent = append(s.sent, m)
+	return nil
+}
+
+func TestImpersonatedStreamsAreTagged(t *testing.T) {
+	impersonated := platformauth.Actor{ID: "op-2", Type: "ACTOR_TYPE_OPERATOR", ImpersonatorID: "op-1", ImpersonatorType: "ACTOR_TYPE_OPERATOR"}
+	for name, tc := range map[string]struct {
+		actor platformauth.Actor
+		want  string
+	}{
+		"impersonated": {impersonated, "op-1"},
+		"direct":       {platformauth.Actor{ID: "op-2", Type: "ACTOR_TYPE_OPERATOR"}, ""},
+	} {
+		ss := &recordingServerStream{ctx: platformauth.WithActor(context.Background(), tc.actor)}
+		err := StreamImpersonationInterceptor()(nil, ss, &grpc.StreamServerInfo{FullMethod: "/rgs.v1.LedgerService/Deposit"}, func(_ any, stream grpc.ServerStream) error {
+			return stream.SendMsg(&rgsv1.DepositResponse{Meta: &rgsv1.ResponseMeta{}})
+		})
+		if err != nil || len(ss.sent) != 1 {
+			t.Fatalf("%s: expected one message sent, got %d: %v", name, len(ss.sent), err)
+		}
+		if got := ss.sent[0].(*rgsv1.DepositResponse).Meta.GetImpersonator().GetActorId(); got != tc.want {
+			t.Fatalf("%s: expected impersonator %q on the streamed message, got %q", name, tc.want, got)
+		}
+	}
+}
@@ -0,0 +1,238 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/netip"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	platformauth "github.com/wizardbeardstudio/open-rgs-go/internal/platform/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const ipNotAllowlisted = "client ip not allowlisted"
+
+// ipAllowlistApplies reports whether actors of actorType can be bound to an
+// allowlist. Players log in from arbitrary venues and devices and are not.
+func ipAllowlistApplies(actorType rgsv1.ActorType) bool {
+	return actorType == rgsv1.ActorType_ACTOR_TYPE_OPERATOR || actorType == rgsv1.ActorType_ACTOR_TYPE_SERVICE
+}
+
+// normalizeCIDRs parses, masks, dedupes, and sorts cidrs.
+func normalizeCIDRs(cidrs []string) ([]string, bool) {
+	seen := make(map[string]bool, len(cidrs))
+	out := make([]string, 0, len(cidrs))
+	for _, c := range cidrs {
+		p, err := netip.ParsePrefix(strings.TrimSpace(c))
+		if err != nil {
+			return nil, false
+		}
+		c = p.Masked().String()
+		if !seen[c] {
+			seen[c] = true
+			out = append(out, c)
+		}
+	}
+	sort.Strings(out)
+	return out, true
+}
+
+func (s *IdentityService) loadIPAllowlistLocked(ctx context.Context, actorID string, actorType rgsv1.ActorType) (*rgsv1.IPAllowlist, error) {
+	if s.db != nil {
+		return s.getIPAllowlistDB(ctx, actorID, actorType)
+	}
+	if a, ok := s.ipAllowlists[lockKey(actorID, actorType)]; ok {
+		return proto.Clone(a).(*rgsv1.IPAllowlist), nil
+	}
+	return &rgsv1.IPAllowlist{Actor: &rgsv1.Actor{ActorId: actorID, ActorType: actorType}, Cidrs: []string{}}, nil
+}
+
+// ipAllowedLocked reports whether ip may act for the actor. An actor with no
+// allowlist is unrestricted; one with an allowlist is refused when the
+// client address is unknown.
+func (s *IdentityService) ipAllowedLocked(ctx context.Context, actorID string, actorType rgsv1.ActorType, ip string) (bool, error) {
+	if !ipAllowlistApplies(actorType) {
+		return true, nil
+	}
+	list, err := s.loadIPAllowlistLocked(ctx, actorID, actorType)
+	if err != nil {
+		return false, err
+	}
+	if len(list.Cidrs) == 0 {
+		return true, nil
+	}
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false, nil
+	}
+	addr = addr.Unmap()
+	for _, c := range list.Cidrs {
+		if p, err := netip.ParsePrefix(c); err == nil && p.Contains(addr) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *IdentityService) GetIPAllowlist(ctx context.Context, req *rgsv1.GetIPAllowlistRequest) (*rgsv1.GetIPAllowlistResponse, error) {
+	if req == nil || req.Actor == nil || req.Actor.ActorId == "" || !ipAllowlistApplies(req.Actor.ActorType) {
+		return &rgsv1.GetIPAllowlistResponse{Meta: s.responseMeta(req.GetMeta(), rgsv1.ResultCode_RESULT_CODE_INVALID, "operator or service actor is required")}, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if ok, reason := s.authorizeIdentityAdmin(ctx, req.Meta); !ok {
+		s.auditDenied(req.Meta, req.Actor.ActorId, "identity_get_ip_allowlist", reason)
+		return &rgsv1.GetIPAllowlistResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	list, err := s.loadIPAllowlistLocked(ctx, req.Actor.ActorId, req.Actor.ActorType)
+	if err != nil {
+		return &rgsv1.GetIPAllowlistResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	return &rgsv1.GetIPAllowlistResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Allowlist: list}, nil
+}
+
+func (s *IdentityService) SetIPAllowlist(ctx context.Context, req *rgsv1.SetIPAllowlistRequest) (*rgsv1.SetIPAllowlistResponse, error) {
+	if req == nil || req.Actor == nil || req.Actor.ActorId == "" || !ipAllowlistApplies(req.Actor.ActorType) {
+		return &rgsv1.SetIPAllowlistResponse{Meta: s.responseMeta(req.GetMeta(), rgsv1.ResultCode_RESULT_CODE_INVALID, "operator or service actor is required")}, nil
+	}
+	if req.Reason == "" {
+		return &rgsv1.SetIPAllowlistResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "reason is required")}, nil
+	}
+	cidrs, ok := normalizeCIDRs(req.Cidrs)
+	if !ok {
+		return &rgsv1.SetIPAllowlistResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "invalid cidr")}, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if ok, reason := s.authorizeIdentityAdmin(ctx, req.Meta); !ok {
+		s.auditDenied(req.Meta, req.Actor.ActorId, "identity_set_ip_allowlist", reason)
+		return &rgsv1.SetIPAllowlistResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	before, err := s.loadIPAllowlistLocked(ctx, req.Actor.ActorId, req.Actor.ActorType)
+	if err != nil {
+		return &rgsv1.SetIPAllowlistResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	after := &rgsv1.IPAllowlist{
+		Actor:     &rgsv1.Actor{ActorId: req.Actor.ActorId, ActorType: req.Actor.ActorType},
+		Cidrs:     cidrs,
+		UpdatedAt: s.now().Format(time.RFC3339Nano),
+	}
+	if s.db != nil {
+		if err := s.replaceIPAllowlistDB(ctx, after); err != nil {
+			return &rgsv1.SetIPAllowlistResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+	} else {
+		if s.ipAllowlists == nil {
+			s.ipAllowlists = make(map[string]*rgsv1.IPAllowlist)
+		}
+		s.ipAllowlists[lockKey(after.Actor.ActorId, after.Actor.ActorType)] = proto.Clone(after).(*rgsv1.IPAllowlist)
+	}
+	beforeJSON, _ := json.Marshal(map[string]any{"cidrs": before.Cidrs})
+	afterJSON, _ := json.Marshal(map[string]any{"cidrs": after.Cidrs})
+	if err := s.appendAudit(req.Meta, req.Actor.ActorId, "identity_set_ip_allowlist", beforeJSON, afterJSON, audit.ResultSuccess, req.Reason); err != nil {
+		return &rgsv1.SetIPAllowlistResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	return &rgsv1.SetIPAllowlistResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Allowlist: after}, nil
+}
+
+// IPAllowlistChecker decides whether the actor on ctx may call target from
+// ip. IdentityService implements it.
+type IPAllowlistChecker interface {
+	CheckIPAllowlist(ctx context.Context, ip, target string) error
+}
+
+// IPAllowlistCheckerFunc adapts a function to IPAllowlistChecker, so the
+// interceptors can be built before the identity service exists.
+type IPAllowlistCheckerFunc func(ctx context.Context, ip, target string) error
+
+func (f IPAllowlistCheckerFunc) CheckIPAllowlist(ctx context.Context, ip, target string) error {
+	return f(ctx, ip, target)
+}
+
+// CheckIPAllowlist refuses an authenticated operator or service actor
+// calling from outside its allowlist, auditing the denial against target.
+func (s *IdentityService) CheckIPAllowlist(ctx context.Context, ip, target string) error {
+	if s == nil {
+		return nil
+	}
+	actor, ok := platformauth.ActorFromContext(ctx)
+	if !ok {
+		// Unauthenticated methods are vetted by the JWT interceptor.
+		return nil
+	}
+	actorType := actorTypeFromString(actor.Type)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	allowed, err := s.ipAllowedLocked(ctx, actor.ID, actorType, ip)
+	if err != nil {
+		return status.Error(codes.Unavailable, "ip allowlist unavailable")
+	}
+	if !allowed {
+		s.auditDenied(&rgsv1.RequestMeta{Actor: &rgsv1.Actor{ActorId: actor.ID, ActorType: actorType}}, target, "identity_ip_allowlist", ipNotAllowlisted)
+		return status.Error(codes.PermissionDenied, ipNotAllowlisted)
+	}
+	return nil
+}
+
+// UnaryIPAllowlistInterceptor enforces per-actor IP allowlists against the
+// gRPC peer address; it must run after the JWT interceptor so the actor is
+// on the context.
+func UnaryIPAllowlistInterceptor(checker IPAllowlistChecker) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if checker == nil {
+			return handler(ctx, req)
+		}
+		if err := checker.CheckIPAllowlist(ctx, peerIP(ctx), info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func StreamIPAllowlistInterceptor(checker IPAllowlistChecker) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if checker == nil {
+			return handler(srv, ss)
+		}
+		if err := checker.CheckIPAllowlist(ss.Context(), peerIP(ss.Context()), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// IPAllowlistGatewayMiddleware enforces IP allowlists on REST calls, which
+// bypass the gRPC interceptors, against the HTTP remote address.
+func IPAllowlistGatewayMiddleware(checker IPAllowlistChecker) runtime.Middleware {
+	return func(next runtime.HandlerFunc) runtime.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+			if checker == nil {
+				next(w, r, pathParams)
+				return
+			}
+			ip := r.RemoteAddr
+			if host, _, err := net.SplitHostPort(ip); err == nil {
+				ip = host
+			}
+			if err := checker.CheckIPAllowlist(r.Context(), ip, r.Method+" "+r.URL.Path); err != nil {
+				code := http.StatusForbidden
+				if status.Code(err) == codes.Unavailable {
+					code = http.StatusServiceUnavailable
+				}
+				http.Error(w, status.Convert(err).Message(), code)
+				return
+			}
+			next(w, r, pathParams)
+		}
+	}
+}
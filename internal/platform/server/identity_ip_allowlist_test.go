@@ -0,0 +1,163 @@
+package server
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	platformauth "github.com/wizardbeardstudio/open-rgs-go/internal/platform/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func peerContext(ip string) context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 4433}})
+}
+
+func TestIdentityIPAllowlistManagement(t *testing.T) {
+	svc := NewIdentityService(ledgerFixedClock{now: time.Date(2026, 3, 8, 9, 0, 0, 0, time.UTC)}, "test-secret", 15*time.Minute, time.Hour)
+	ctx := context.Background()
+	admin := meta("op-admin", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+	operator := &rgsv1.Actor{ActorId: "op-1", ActorType: rgsv1.ActorType_ACTOR_TYPE_OPERATOR}
+
+	for _, tc := range []struct {
+		req  *rgsv1.SetIPAllowlistRequest
+		want rgsv1.ResultCode
+	}{
+		{&rgsv1.SetIPAllowlistRequest{Meta: admin, Actor: &rgsv1.Actor{ActorId: "player-1", ActorType: rgsv1.ActorType_ACTOR_TYPE_PLAYER}, Cidrs: []string{"10.0.0.0/8"}, Reason: "r"}, rgsv1.ResultCode_RESULT_CODE_INVALID},
+		{&rgsv1.SetIPAllowlistRequest{Meta: admin, Actor: operator, Cidrs: []string{"10.0.0.0/8"}}, rgsv1.ResultCode_RESULT_CODE_INVALID},
+		{&rgsv1.SetIPAllowlistRequest{Meta: admin, Actor: operator, Cidrs: []string{"10.0.0.300/8"}, Reason: "r"}, rgsv1.ResultCode_RESULT_CODE_INVALID},
+		{&rgsv1.SetIPAllowlistRequest{Meta: meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""), Actor: operator, Cidrs: []string{"10.0.0.0/8"}, Reason: "r"}, rgsv1.ResultCode_RESULT_CODE_DENIED},
+	} {
+		resp, _ := svc.SetIPAllowlist(ctx, tc.req)
+		if resp.Meta.GetResultCode() != tc.want {
+			t.Fatalf("set %+v: got=%+v want=%v", tc.req, resp.Meta, tc.want)
+		}
+	}
+
+	set, _ := svc.SetIPAllowlist(ctx, &rgsv1.SetIPAllowlistRequest{Meta: admin, Actor: operator, Cidrs: []string{"10.1.2.3/8", "192.0.2.10/32", "10.0.0.0/8"}, Reason: "office network"})
+	if set.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected set ok, got=%+v", set.Meta)
+	}
+	got, _ := svc.GetIPAllowlist(ctx, &rgsv1.GetIPAllowlistRequest{Meta: admin, Actor: operator})
+	if cidrs := strings.Join(got.Allowlist.GetCidrs(), ","); cidrs != "10.0.0.0/8,192.0.2.10/32" {
+		t.Fatalf("expected masked, deduped cidrs, got=%q", cidrs)
+	}
+	var audited bool
+	for _, ev := range auditEvents(svc.AuditStore) {
+		if ev.Action == "identity_set_ip_allowlist" && ev.Result == audit.ResultSuccess && ev.Reason == "office network" {
+			audited = true
+		}
+	}
+	if !audited {
+		t.Fatalf("expected allowlist change audited")
+	}
+
+	cleared, _ := svc.SetIPAllowlist(ctx, &rgsv1.SetIPAllowlistRequest{Meta: admin, Actor: operator, Reason: "lift restriction"})
+	if cleared.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || len(cleared.Allowlist.GetCidrs()) != 0 {
+		t.Fatalf("expected empty allowlist, got=%+v", cleared)
+	}
+}
+
+func TestIdentityLoginRefusedOutsideIPAllowlist(t *testing.T) {
+	svc := NewIdentityService(ledgerFixedClock{now: time.Date(2026, 3, 8, 9, 0, 0, 0, time.UTC)}, "test-secret", 15*time.Minute, time.Hour)
+	operator := &rgsv1.Actor{ActorId: "op-1", ActorType: rgsv1.ActorType_ACTOR_TYPE_OPERATOR}
+	if resp, _ := svc.SetIPAllowlist(context.Background(), &rgsv1.SetIPAllowlistRequest{Meta: meta("op-admin", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), Actor: operator, Cidrs: []string{"10.0.0.0/8"}, Reason: "office network"}); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected set ok, got=%+v", resp.Meta)
+	}
+	login := func(ctx context.Context) *rgsv1.LoginResponse {
+		resp, _ := svc.Login(ctx, &rgsv1.LoginRequest{
+			Meta:        meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+			Credentials: &rgsv1.LoginRequest_Operator{Operator: &rgsv1.OperatorCredentials{OperatorId: "op-1", Password: "operator-pass"}},
+		})
+		return resp
+	}
+
+	if resp := login(peerContext("203.0.113.5")); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED || resp.Meta.GetDenialReason() != ipNotAllowlisted || resp.Token != nil {
+		t.Fatalf("expected login outside allowlist denied, got=%+v", resp)
+	}
+	if resp := login(context.Background()); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected login from unknown address denied, got=%+v", resp.Meta)
+	}
+	// Client-supplied forwarding headers do not override the gRPC peer.
+	spoofed := metadata.NewIncomingContext(peerContext("203.0.113.5"), metadata.Pairs("x-forwarded-for", "10.0.0.9"))
+	if resp := login(spoofed); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected spoofed forwarding header ignored, got=%+v", resp.Meta)
+	}
+	if resp := login(peerContext("10.20.30.40")); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected login inside allowlist ok, got=%+v", resp.Meta)
+	}
+	gateway := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-forwarded-for", "10.0.0.9"))
+	if resp := login(gateway); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected gateway login inside allowlist ok, got=%+v", resp.Meta)
+	}
+
+	// Players are never bound to an allowlist.
+	resp, _ := svc.Login(peerContext("203.0.113.5"), &rgsv1.LoginRequest{
+		Meta:        meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""),
+		Credentials: &rgsv1.LoginRequest_Player{Player: &rgsv1.PlayerCredentials{PlayerId: "player-1", Pin: "1234"}},
+	})
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected player login ok, got=%+v", resp.Meta)
+	}
+}
+
+func TestIPAllowlistInterceptorAndGateway(t *testing.T) {
+	svc := NewIdentityService(ledgerFixedClock{now: time.Date(2026, 3, 8, 9, 0, 0, 0, time.UTC)}, "test-secret", 15*time.Minute, time.Hour)
+	service := &rgsv1.Actor{ActorId: "svc-1", ActorType: rgsv1.ActorType_ACTOR_TYPE_SERVICE}
+	if resp, _ := svc.SetIPAllowlist(context.Background(), &rgsv1.SetIPAllowlistRequest{Meta: meta("op-admin", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), Actor: service, Cidrs: []string{"192.0.2.0/24"}, Reason: "data center"}); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected set ok, got=%+v", resp.Meta)
+	}
+	actor := platformauth.Actor{ID: "svc-1", Type: "ACTOR_TYPE_SERVICE"}
+
+	interceptor := UnaryIPAllowlistInterceptor(svc)
+	call := func(ip string) codes.Code {
+		ctx := platformauth.WithActor(peerContext(ip), actor)
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/rgs.v1.LedgerService/GetBalance"}, func(context.Context, any) (any, error) { return "ok", nil })
+		return status.Code(err)
+	}
+	if got := call("192.0.2.77"); got != codes.OK {
+		t.Fatalf("expected call inside allowlist ok, got=%v", got)
+	}
+	if got := call("198.51.100.1"); got != codes.PermissionDenied {
+		t.Fatalf("expected call outside allowlist denied, got=%v", got)
+	}
+	var audited bool
+	for _, ev := range auditEvents(svc.AuditStore) {
+		if ev.Action == "identity_ip_allowlist" && ev.Result == audit.ResultDenied && ev.ObjectID == "/rgs.v1.LedgerService/GetBalance" {
+			audited = true
+		}
+	}
+	if !audited {
+		t.Fatalf("expected denied call audited")
+	}
+
+	gwMux := runtime.NewServeMux(runtime.WithMiddlewares(IPAllowlistGatewayMiddleware(svc)))
+	if err := rgsv1.RegisterIdentityServiceHandlerServer(context.Background(), gwMux, svc); err != nil {
+		t.Fatalf("register identity gateway handlers: %v", err)
+	}
+	get := func(remoteAddr string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/v1/identity/key-rotations", nil)
+		req.RemoteAddr = remoteAddr
+		req = req.WithContext(platformauth.WithActor(req.Context(), actor))
+		rec := httptest.NewRecorder()
+		gwMux.ServeHTTP(rec, req)
+		return rec
+	}
+	if rec := get("198.51.100.1:5000"); rec.Code != http.StatusForbidden || !strings.Contains(rec.Body.String(), ipNotAllowlisted) {
+		t.Fatalf("expected gateway call outside allowlist refused, status=%d body=%s", rec.Code, rec.Body.String())
+	}
+	if rec := get("192.0.2.8:5000"); rec.Code != http.StatusOK {
+		t.Fatalf("expected gateway call inside allowlist accepted, status=%d body=%s", rec.Code, rec.Body.String())
+	}
+}
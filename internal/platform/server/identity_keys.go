@@ -0,0 +1,87 @@
+package server
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultKeyRotationListLimit = 50
+	maxKeyRotationListLimit     = 500
+)
+
+// RecordKeyRotation records that rot's keyset is now in effect on this
+// instance. The previous fingerprint is the latest one recorded by any
+// instance. TriggeredBy defaults to the system actor. The record is audited
+// as identity_key_rotation.
+func (s *IdentityService) RecordKeyRotation(ctx context.Context, rot *rgsv1.KeyRotation) (*rgsv1.KeyRotation, error) {
+	rec := proto.Clone(rot).(*rgsv1.KeyRotation)
+	var auditMeta *rgsv1.RequestMeta
+	if rec.TriggeredBy != nil {
+		auditMeta = &rgsv1.RequestMeta{Actor: rec.TriggeredBy}
+	} else {
+		rec.TriggeredBy = &rgsv1.Actor{ActorId: "system", ActorType: rgsv1.ActorType_ACTOR_TYPE_SERVICE}
+	}
+	rec.RotatedAt = s.now().Format(time.RFC3339Nano)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.db != nil {
+		if err := s.insertKeyRotationDB(ctx, rec); err != nil {
+			return nil, err
+		}
+	} else {
+		rec.PreviousFingerprint = ""
+		if n := len(s.keyRotations); n > 0 {
+			rec.PreviousFingerprint = s.keyRotations[n-1].Fingerprint
+		}
+		rec.RotationId = strconv.Itoa(len(s.keyRotations) + 1)
+		s.keyRotations = append(s.keyRotations, rec)
+	}
+	after, _ := protojson.Marshal(rec)
+	if err := s.appendAudit(auditMeta, rec.RotationId, "identity_key_rotation", []byte(`{}`), after, audit.ResultSuccess, rec.Trigger); err != nil {
+		return nil, err
+	}
+	return proto.Clone(rec).(*rgsv1.KeyRotation), nil
+}
+
+func (s *IdentityService) ListKeyRotations(ctx context.Context, req *rgsv1.ListKeyRotationsRequest) (*rgsv1.ListKeyRotationsResponse, error) {
+	if req == nil {
+		req = &rgsv1.ListKeyRotationsRequest{}
+	}
+	if ok, reason := s.authorizeIdentityAdmin(ctx, req.Meta); !ok {
+		s.mu.Lock()
+		s.auditDenied(req.Meta, "", "identity_list_key_rotations", reason)
+		s.mu.Unlock()
+		return &rgsv1.ListKeyRotationsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultKeyRotationListLimit
+	}
+	if limit > maxKeyRotationListLimit {
+		limit = maxKeyRotationListLimit
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var out []*rgsv1.KeyRotation
+	if s.db != nil {
+		var err error
+		out, err = s.listKeyRotationsDB(ctx, limit)
+		if err != nil {
+			return &rgsv1.ListKeyRotationsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+	} else {
+		for i := len(s.keyRotations) - 1; i >= 0 && len(out) < limit; i-- {
+			out = append(out, proto.Clone(s.keyRotations[i]).(*rgsv1.KeyRotation))
+		}
+	}
+	return &rgsv1.ListKeyRotationsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Rotations: out}, nil
+}
@@ -0,0 +1,54 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func TestIdentityRecordAndListKeyRotations(t *testing.T) {
+	ctx := context.Background()
+	svc := NewIdentityService(ledgerFixedClock{now: time.Date(2026, 2, 22, 8, 0, 0, 0, time.UTC)}, "test-secret", 15*time.Minute, time.Hour)
+
+	first, err := svc.RecordKeyRotation(ctx, &rgsv1.KeyRotation{Fingerprint: "fp-1", ActiveKid: "k1", KeyIds: []string{"k1"}, Source: "file", Trigger: "startup", InstanceId: "rgsd-1"})
+	if err != nil {
+		t.Fatalf("record startup rotation: %v", err)
+	}
+	if first.PreviousFingerprint != "" || first.TriggeredBy.GetActorId() != "system" || first.RotatedAt == "" {
+		t.Fatalf("unexpected startup rotation: %+v", first)
+	}
+	if _, err := svc.RecordKeyRotation(ctx, &rgsv1.KeyRotation{Fingerprint: "fp-2", ActiveKid: "k2", KeyIds: []string{"k1", "k2"}, Source: "file", Trigger: "refresh", InstanceId: "rgsd-1"}); err != nil {
+		t.Fatalf("record refresh rotation: %v", err)
+	}
+
+	resp, _ := svc.ListKeyRotations(ctx, &rgsv1.ListKeyRotationsRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")})
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || len(resp.Rotations) != 2 {
+		t.Fatalf("expected two rotations, got meta=%+v rotations=%d", resp.Meta, len(resp.Rotations))
+	}
+	latest := resp.Rotations[0]
+	if latest.Fingerprint != "fp-2" || latest.PreviousFingerprint != "fp-1" || latest.Trigger != "refresh" {
+		t.Fatalf("expected newest rotation first with previous fingerprint, got=%+v", latest)
+	}
+
+	limited, _ := svc.ListKeyRotations(ctx, &rgsv1.ListKeyRotationsRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), Limit: 1})
+	if len(limited.Rotations) != 1 || limited.Rotations[0].Fingerprint != "fp-2" {
+		t.Fatalf("expected limit to keep the newest rotation, got=%+v", limited.Rotations)
+	}
+
+	denied, _ := svc.ListKeyRotations(ctx, &rgsv1.ListKeyRotationsRequest{Meta: meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "")})
+	if denied.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected player to be denied, got=%+v", denied.Meta)
+	}
+
+	var audited int
+	for _, ev := range auditEvents(svc.AuditStore) {
+		if ev.Action == "identity_key_rotation" && ev.ActorID == "system" {
+			audited++
+		}
+	}
+	if audited != 2 {
+		t.Fatalf("expected two audited rotations, got=%d", audited)
+	}
+}
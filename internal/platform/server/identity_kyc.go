@@ -0,0 +1,335 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	identityMatchDocument          = "document"
+	identityMatchNameDOB           = "name_dob"
+	identityMatchNameDOBTransposed = "name_dob_transposed"
+)
+
+// playerIdentity is a registered player identity. Only hashes of the
+// normalized KYC attributes are kept.
+type playerIdentity struct {
+	record       *rgsv1.PlayerIdentityRecord
+	documentHash string
+	nameDOBHash  string
+	// nameDOBAltHash is the name hashed with the day and month of birth
+	// swapped, or "" when the swap is not a valid distinct date.
+	nameDOBAltHash string
+}
+
+func identityHash(parts ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(parts, "|")))
+	return hex.EncodeToString(sum[:])
+}
+
+// normalizePlayerName folds case, punctuation, token order and single-letter
+// initials so "O'Brien, Mary J." and "mary obrien" compare equal.
+func normalizePlayerName(name string) string {
+	tokens := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return unicode.IsSpace(r) || r == ',' || r == '-'
+	})
+	out := make([]string, 0, len(tokens))
+	for _, tok := range tokens {
+		tok = strings.Map(func(r rune) rune {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) {
+				return r
+			}
+			return -1
+		}, tok)
+		if len([]rune(tok)) > 1 {
+			out = append(out, tok)
+		}
+	}
+	sort.Strings(out)
+	return strings.Join(out, " ")
+}
+
+func normalizeDocumentField(v string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToUpper(r)
+		}
+		return -1
+	}, v)
+}
+
+// playerIdentityHashes derives the match hashes from KYC details, or returns
+// the reason the details are invalid.
+func (s *IdentityService) playerIdentityHashes(d *rgsv1.PlayerIdentityDetails) (*playerIdentity, string) {
+	name := normalizePlayerName(d.GetFullName())
+	if name == "" {
+		return nil, "full_name is required"
+	}
+	dob, err := time.Parse("2006-01-02", strings.TrimSpace(d.GetDateOfBirth()))
+	if err != nil || dob.After(s.now()) {
+		return nil, "date_of_birth must be YYYY-MM-DD"
+	}
+	p := &playerIdentity{nameDOBHash: identityHash("name_dob", name, dob.Format("2006-01-02"))}
+	if dob.Day() <= 12 && dob.Day() != int(dob.Month()) {
+		swapped := time.Date(dob.Year(), time.Month(dob.Day()), int(dob.Month()), 0, 0, 0, 0, time.UTC)
+		p.nameDOBAltHash = identityHash("name_dob", name, swapped.Format("2006-01-02"))
+	}
+	if number := normalizeDocumentField(d.GetDocumentNumber()); number != "" {
+		p.documentHash = identityHash("document", normalizeDocumentField(d.GetDocumentType()), number)
+	}
+	return p, ""
+}
+
+// identityMatchReasons lists why candidate looks like the same person as p.
+func identityMatchReasons(p, candidate *playerIdentity) []string {
+	var reasons []string
+	if p.documentHash != "" && p.documentHash == candidate.documentHash {
+		reasons = append(reasons, identityMatchDocument)
+	}
+	if p.nameDOBHash == candidate.nameDOBHash {
+		reasons = append(reasons, identityMatchNameDOB)
+	} else if p.nameDOBAltHash != "" && p.nameDOBAltHash == candidate.nameDOBHash {
+		reasons = append(reasons, identityMatchNameDOBTransposed)
+	}
+	return reasons
+}
+
+func playerIdentityStatusToDB(status rgsv1.PlayerIdentityStatus) string {
+	switch status {
+	case rgsv1.PlayerIdentityStatus_PLAYER_IDENTITY_STATUS_PENDING_REVIEW:
+		return "pending_review"
+	case rgsv1.PlayerIdentityStatus_PLAYER_IDENTITY_STATUS_APPROVED:
+		return "approved"
+	case rgsv1.PlayerIdentityStatus_PLAYER_IDENTITY_STATUS_REJECTED:
+		return "rejected"
+	default:
+		return "clear"
+	}
+}
+
+func playerIdentityStatusFromDB(raw string) rgsv1.PlayerIdentityStatus {
+	switch raw {
+	case "pending_review":
+		return rgsv1.PlayerIdentityStatus_PLAYER_IDENTITY_STATUS_PENDING_REVIEW
+	case "approved":
+		return rgsv1.PlayerIdentityStatus_PLAYER_IDENTITY_STATUS_APPROVED
+	case "rejected":
+		return rgsv1.PlayerIdentityStatus_PLAYER_IDENTITY_STATUS_REJECTED
+	case "clear":
+		return rgsv1.PlayerIdentityStatus_PLAYER_IDENTITY_STATUS_CLEAR
+	default:
+		return rgsv1.PlayerIdentityStatus_PLAYER_IDENTITY_STATUS_UNSPECIFIED
+	}
+}
+
+// loadPlayerIdentity returns the player's identity record, or nil when none
+// was registered. Callers hold s.mu.
+func (s *IdentityService) loadPlayerIdentity(ctx context.Context, playerID string) (*rgsv1.PlayerIdentityRecord, error) {
+	if s.db != nil {
+		return s.getPlayerIdentityFromDB(ctx, playerID)
+	}
+	if p, ok := s.playerIdentities[playerID]; ok {
+		return clonePlayerIdentityRecord(p.record), nil
+	}
+	return nil, nil
+}
+
+func clonePlayerIdentityRecord(in *rgsv1.PlayerIdentityRecord) *rgsv1.PlayerIdentityRecord {
+	if in == nil {
+		return nil
+	}
+	cp, _ := proto.Clone(in).(*rgsv1.PlayerIdentityRecord)
+	return cp
+}
+
+// playerIdentityLoginBlock returns the denial reason for a player whose
+// identity is held for duplicate review or was rejected. Callers hold s.mu.
+func (s *IdentityService) playerIdentityLoginBlock(ctx context.Context, playerID string) (string, error) {
+	rec, err := s.loadPlayerIdentity(ctx, playerID)
+	if err != nil || rec == nil {
+		return "", err
+	}
+	switch rec.Status {
+	case rgsv1.PlayerIdentityStatus_PLAYER_IDENTITY_STATUS_PENDING_REVIEW:
+		return "identity review pending", nil
+	case rgsv1.PlayerIdentityStatus_PLAYER_IDENTITY_STATUS_REJECTED:
+		return "identity rejected", nil
+	}
+	return "", nil
+}
+
+func (s *IdentityService) findPlayerIdentityMatches(ctx context.Context, playerID string, p *playerIdentity) ([]*rgsv1.PlayerIdentityMatch, error) {
+	var candidates map[string]*playerIdentity
+	if s.db != nil {
+		var err error
+		candidates, err = s.findPlayerIdentityCandidatesInDB(ctx, playerID, p)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		candidates = s.playerIdentities
+	}
+	ids := make([]string, 0, len(candidates))
+	for id := range candidates {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	var matches []*rgsv1.PlayerIdentityMatch
+	for _, id := range ids {
+		if id == playerID {
+			continue
+		}
+		if reasons := identityMatchReasons(p, candidates[id]); len(reasons) > 0 {
+			matches = append(matches, &rgsv1.PlayerIdentityMatch{PlayerId: id, Reasons: reasons})
+		}
+	}
+	return matches, nil
+}
+
+func (s *IdentityService) RegisterPlayerIdentity(ctx context.Context, req *rgsv1.RegisterPlayerIdentityRequest) (*rgsv1.RegisterPlayerIdentityResponse, error) {
+	if req == nil || req.PlayerId == "" || req.Details == nil {
+		return &rgsv1.RegisterPlayerIdentityResponse{Meta: s.responseMeta(nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "player_id and details are required")}, nil
+	}
+	if ok, reason := s.authorizeIdentityAdmin(ctx, req.Meta); !ok {
+		s.auditDenied(req.Meta, req.PlayerId, "identity_register_player", reason)
+		return &rgsv1.RegisterPlayerIdentityResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	p, reason := s.playerIdentityHashes(req.Details)
+	if reason != "" {
+		return &rgsv1.RegisterPlayerIdentityResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, reason)}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	existing, err := s.loadPlayerIdentity(ctx, req.PlayerId)
+	if err != nil {
+		return &rgsv1.RegisterPlayerIdentityResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if existing != nil {
+		return &rgsv1.RegisterPlayerIdentityResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "player identity already registered")}, nil
+	}
+	matches, err := s.findPlayerIdentityMatches(ctx, req.PlayerId, p)
+	if err != nil {
+		return &rgsv1.RegisterPlayerIdentityResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	status := rgsv1.PlayerIdentityStatus_PLAYER_IDENTITY_STATUS_CLEAR
+	if len(matches) > 0 {
+		status = rgsv1.PlayerIdentityStatus_PLAYER_IDENTITY_STATUS_PENDING_REVIEW
+	}
+	p.record = &rgsv1.PlayerIdentityRecord{
+		PlayerId:     req.PlayerId,
+		Status:       status,
+		Matches:      matches,
+		RegisteredAt: s.now().Format(time.RFC3339Nano),
+	}
+	if s.db != nil {
+		if err := s.insertPlayerIdentityInDB(ctx, p); err != nil {
+			return &rgsv1.RegisterPlayerIdentityResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+	} else {
+		s.playerIdentities[req.PlayerId] = p
+	}
+	afterJSON, _ := json.Marshal(p.record)
+	if err := s.appendAudit(req.Meta, req.PlayerId, "identity_register_player", []byte(`{}`), afterJSON, audit.ResultSuccess, ""); err != nil {
+		return &rgsv1.RegisterPlayerIdentityResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	return &rgsv1.RegisterPlayerIdentityResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Record: clonePlayerIdentityRecord(p.record)}, nil
+}
+
+func (s *IdentityService) ListPlayerIdentityReviews(ctx context.Context, req *rgsv1.ListPlayerIdentityReviewsRequest) (*rgsv1.ListPlayerIdentityReviewsResponse, error) {
+	if req == nil {
+		req = &rgsv1.ListPlayerIdentityReviewsRequest{}
+	}
+	if ok, reason := s.authorizeIdentityAdmin(ctx, req.Meta); !ok {
+		s.auditDenied(req.Meta, "", "identity_list_player_reviews", reason)
+		return &rgsv1.ListPlayerIdentityReviewsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	status := req.Status
+	if status == rgsv1.PlayerIdentityStatus_PLAYER_IDENTITY_STATUS_UNSPECIFIED {
+		status = rgsv1.PlayerIdentityStatus_PLAYER_IDENTITY_STATUS_PENDING_REVIEW
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var records []*rgsv1.PlayerIdentityRecord
+	if s.db != nil {
+		var err error
+		records, err = s.listPlayerIdentitiesFromDB(ctx, status)
+		if err != nil {
+			return &rgsv1.ListPlayerIdentityReviewsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+	} else {
+		for _, p := range s.playerIdentities {
+			if p.record.Status == status {
+				records = append(records, clonePlayerIdentityRecord(p.record))
+			}
+		}
+		sort.Slice(records, func(i, j int) bool {
+			if records[i].RegisteredAt != records[j].RegisteredAt {
+				return records[i].RegisteredAt < records[j].RegisteredAt
+			}
+			return records[i].PlayerId < records[j].PlayerId
+		})
+	}
+	return &rgsv1.ListPlayerIdentityReviewsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Records: records}, nil
+}
+
+func (s *IdentityService) ResolvePlayerIdentityReview(ctx context.Context, req *rgsv1.ResolvePlayerIdentityReviewRequest) (*rgsv1.ResolvePlayerIdentityReviewResponse, error) {
+	if req == nil || req.PlayerId == "" {
+		return &rgsv1.ResolvePlayerIdentityReviewResponse{Meta: s.responseMeta(nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "player_id is required")}, nil
+	}
+	actor, reason := resolveActor(ctx, req.Meta)
+	if reason == "" && actor.ActorType != rgsv1.ActorType_ACTOR_TYPE_OPERATOR {
+		reason = "operator actor required"
+	}
+	if reason != "" {
+		s.auditDenied(req.Meta, req.PlayerId, "identity_resolve_player_review", reason)
+		return &rgsv1.ResolvePlayerIdentityReviewResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	if strings.TrimSpace(req.Note) == "" {
+		return &rgsv1.ResolvePlayerIdentityReviewResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "note is required")}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec, err := s.loadPlayerIdentity(ctx, req.PlayerId)
+	if err != nil {
+		return &rgsv1.ResolvePlayerIdentityReviewResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if rec == nil {
+		return &rgsv1.ResolvePlayerIdentityReviewResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "player identity not found")}, nil
+	}
+	if rec.Status != rgsv1.PlayerIdentityStatus_PLAYER_IDENTITY_STATUS_PENDING_REVIEW {
+		return &rgsv1.ResolvePlayerIdentityReviewResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "player identity is not pending review")}, nil
+	}
+	beforeJSON, _ := json.Marshal(rec)
+	rec.Status = rgsv1.PlayerIdentityStatus_PLAYER_IDENTITY_STATUS_REJECTED
+	if req.Approve {
+		rec.Status = rgsv1.PlayerIdentityStatus_PLAYER_IDENTITY_STATUS_APPROVED
+	}
+	rec.ReviewedAt = s.now().Format(time.RFC3339Nano)
+	rec.ReviewedBy = actor.ActorId
+	rec.ReviewNote = req.Note
+	if s.db != nil {
+		if err := s.updatePlayerIdentityReviewInDB(ctx, rec); err != nil {
+			return &rgsv1.ResolvePlayerIdentityReviewResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+	} else {
+		s.playerIdentities[req.PlayerId].record = clonePlayerIdentityRecord(rec)
+	}
+	afterJSON, _ := json.Marshal(rec)
+	if err := s.appendAudit(req.Meta, req.PlayerId, "identity_resolve_player_review", beforeJSON, afterJSON, audit.ResultSuccess, req.Note); err != nil {
+		return &rgsv1.ResolvePlayerIdentityReviewResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	return &rgsv1.ResolvePlayerIdentityReviewResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Record: rec}, nil
+}
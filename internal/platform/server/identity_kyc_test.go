@@ -0,0 +1,160 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func registerTestPlayerIdentity(t *testing.T, svc *IdentityService, playerID string, details *rgsv1.PlayerIdentityDetails) *rgsv1.PlayerIdentityRecord {
+	t.Helper()
+	resp, err := svc.RegisterPlayerIdentity(context.Background(), &rgsv1.RegisterPlayerIdentityRequest{
+		Meta:     meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		PlayerId: playerID,
+		Details:  details,
+	})
+	if err != nil || resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("register identity %s failed: err=%v meta=%+v", playerID, err, resp.GetMeta())
+	}
+	return resp.Record
+}
+
+func playerLoginResult(t *testing.T, svc *IdentityService, playerID string) *rgsv1.ResponseMeta {
+	t.Helper()
+	resp, err := svc.Login(context.Background(), &rgsv1.LoginRequest{
+		Meta: meta(playerID, rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""),
+		Credentials: &rgsv1.LoginRequest_Player{
+			Player: &rgsv1.PlayerCredentials{PlayerId: playerID, Pin: "1234"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("login err: %v", err)
+	}
+	return resp.Meta
+}
+
+func TestIdentityDuplicatePlayerDetection(t *testing.T) {
+	svc := NewIdentityService(ledgerFixedClock{now: time.Date(2026, 2, 20, 9, 0, 0, 0, time.UTC)}, "test-secret", 15*time.Minute, time.Hour)
+
+	first := registerTestPlayerIdentity(t, svc, "player-1", &rgsv1.PlayerIdentityDetails{
+		FullName:       "Mary J. O'Brien",
+		DateOfBirth:    "1990-03-07",
+		DocumentType:   "passport",
+		DocumentNumber: "X123-4567",
+	})
+	if first.Status != rgsv1.PlayerIdentityStatus_PLAYER_IDENTITY_STATUS_CLEAR || len(first.Matches) != 0 {
+		t.Fatalf("expected first identity clear, got=%+v", first)
+	}
+
+	cases := []struct {
+		playerID string
+		details  *rgsv1.PlayerIdentityDetails
+		reasons  []string
+	}{
+		{"player-2", &rgsv1.PlayerIdentityDetails{FullName: "OBRIEN, mary", DateOfBirth: "1990-03-07"}, []string{identityMatchNameDOB}},
+		{"player-3", &rgsv1.PlayerIdentityDetails{FullName: "Mary OBrien", DateOfBirth: "1990-07-03"}, []string{identityMatchNameDOBTransposed}},
+		{"player-4", &rgsv1.PlayerIdentityDetails{FullName: "Someone Else", DateOfBirth: "1985-01-01", DocumentType: "PASSPORT", DocumentNumber: "x1234567"}, []string{identityMatchDocument}},
+	}
+	for _, tc := range cases {
+		rec := registerTestPlayerIdentity(t, svc, tc.playerID, tc.details)
+		if rec.Status != rgsv1.PlayerIdentityStatus_PLAYER_IDENTITY_STATUS_PENDING_REVIEW || len(rec.Matches) == 0 {
+			t.Fatalf("%s: expected pending review, got=%+v", tc.playerID, rec)
+		}
+		m := rec.Matches[0]
+		if m.PlayerId != "player-1" || len(m.Reasons) != len(tc.reasons) || m.Reasons[0] != tc.reasons[0] {
+			t.Fatalf("%s: unexpected match %+v", tc.playerID, rec.Matches)
+		}
+	}
+
+	unrelated := registerTestPlayerIdentity(t, svc, "player-5", &rgsv1.PlayerIdentityDetails{FullName: "Mary O'Brien", DateOfBirth: "1991-03-07"})
+	if unrelated.Status != rgsv1.PlayerIdentityStatus_PLAYER_IDENTITY_STATUS_CLEAR {
+		t.Fatalf("expected a different birth date to be clear, got=%+v", unrelated)
+	}
+
+	again, _ := svc.RegisterPlayerIdentity(context.Background(), &rgsv1.RegisterPlayerIdentityRequest{
+		Meta:     meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		PlayerId: "player-1",
+		Details:  &rgsv1.PlayerIdentityDetails{FullName: "Mary O'Brien", DateOfBirth: "1990-03-07"},
+	})
+	if again.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected re-registration rejected, got=%v", again.Meta.GetResultCode())
+	}
+}
+
+func TestIdentityDuplicateReviewGatesPlayerLogin(t *testing.T) {
+	svc := NewIdentityService(ledgerFixedClock{now: time.Date(2026, 2, 20, 9, 0, 0, 0, time.UTC)}, "test-secret", 15*time.Minute, time.Hour)
+	ctx := context.Background()
+	details := &rgsv1.PlayerIdentityDetails{FullName: "Alex Smith", DateOfBirth: "1988-11-20", DocumentType: "dl", DocumentNumber: "D-100"}
+	registerTestPlayerIdentity(t, svc, "player-1", details)
+	registerTestPlayerIdentity(t, svc, "player-2", details)
+	registerTestPlayerIdentity(t, svc, "player-3", details)
+
+	if m := playerLoginResult(t, svc, "player-1"); m.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected original player to log in, got=%+v", m)
+	}
+	if m := playerLoginResult(t, svc, "player-2"); m.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED || m.GetDenialReason() != "identity review pending" {
+		t.Fatalf("expected pending duplicate blocked, got=%+v", m)
+	}
+
+	list, _ := svc.ListPlayerIdentityReviews(ctx, &rgsv1.ListPlayerIdentityReviewsRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")})
+	if list.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || len(list.Records) != 2 || list.Records[0].PlayerId != "player-2" {
+		t.Fatalf("expected two pending reviews, got=%+v", list)
+	}
+	if m := list.Records[1].Matches; len(m) != 2 || m[0].PlayerId != "player-1" || m[1].PlayerId != "player-2" {
+		t.Fatalf("expected player-3 to match both earlier players, got=%+v", m)
+	}
+
+	resolve := func(playerID string, approve bool, note string, actor *rgsv1.RequestMeta) *rgsv1.ResolvePlayerIdentityReviewResponse {
+		resp, _ := svc.ResolvePlayerIdentityReview(ctx, &rgsv1.ResolvePlayerIdentityReviewRequest{Meta: actor, PlayerId: playerID, Approve: approve, Note: note})
+		return resp
+	}
+	if r := resolve("player-2", true, "twin", meta("svc-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, "")); r.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected service actor denied, got=%v", r.Meta.GetResultCode())
+	}
+	if r := resolve("player-2", true, "", meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")); r.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected note required, got=%v", r.Meta.GetResultCode())
+	}
+	approved := resolve("player-2", true, "shared household document checked", meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""))
+	if approved.Record.GetStatus() != rgsv1.PlayerIdentityStatus_PLAYER_IDENTITY_STATUS_APPROVED || approved.Record.GetReviewedBy() != "op-1" {
+		t.Fatalf("unexpected approval: %+v", approved)
+	}
+	rejected := resolve("player-3", false, "same person as player-1", meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""))
+	if rejected.Record.GetStatus() != rgsv1.PlayerIdentityStatus_PLAYER_IDENTITY_STATUS_REJECTED {
+		t.Fatalf("unexpected rejection: %+v", rejected)
+	}
+	if r := resolve("player-3", true, "changed mind", meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")); r.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected resolved review to be final, got=%v", r.Meta.GetResultCode())
+	}
+
+	if m := playerLoginResult(t, svc, "player-2"); m.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected approved player to log in, got=%+v", m)
+	}
+	if m := playerLoginResult(t, svc, "player-3"); m.GetDenialReason() != "identity rejected" {
+		t.Fatalf("expected rejected player blocked, got=%+v", m)
+	}
+}
+
+func TestIdentityRegisterPlayerValidation(t *testing.T) {
+	svc := NewIdentityService(ledgerFixedClock{now: time.Date(2026, 2, 20, 9, 0, 0, 0, time.UTC)}, "test-secret", 15*time.Minute, time.Hour)
+	ctx := context.Background()
+	for _, d := range []*rgsv1.PlayerIdentityDetails{
+		{FullName: "  .  ", DateOfBirth: "1990-01-01"},
+		{FullName: "Alex Smith", DateOfBirth: "01/02/1990"},
+		{FullName: "Alex Smith", DateOfBirth: "2030-01-01"},
+	} {
+		resp, _ := svc.RegisterPlayerIdentity(ctx, &rgsv1.RegisterPlayerIdentityRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), PlayerId: "player-1", Details: d})
+		if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+			t.Fatalf("expected %+v rejected, got=%v", d, resp.Meta.GetResultCode())
+		}
+	}
+	resp, _ := svc.RegisterPlayerIdentity(ctx, &rgsv1.RegisterPlayerIdentityRequest{
+		Meta:     meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""),
+		PlayerId: "player-1",
+		Details:  &rgsv1.PlayerIdentityDetails{FullName: "Alex Smith", DateOfBirth: "1990-01-01"},
+	})
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected player actor denied, got=%v", resp.Meta.GetResultCode())
+	}
+}
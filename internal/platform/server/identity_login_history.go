@@ -0,0 +1,218 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/proto"
+)
+
+// maxInMemoryLoginHistory bounds the login history kept when no database is
+// set; the oldest attempts are dropped first.
+const maxInMemoryLoginHistory = 10000
+
+// peerIP returns the host of the gRPC peer address, or "" when the call did
+// not arrive over gRPC.
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	ip := p.Addr.String()
+	if host, _, err := net.SplitHostPort(ip); err == nil {
+		ip = host
+	}
+	return ip
+}
+
+// clientIP returns the client address observed by the server. Calls made by
+// the in-process gateway have no gRPC peer; the gateway appends the HTTP
+// remote address to x-forwarded-for, so its last entry is the one the
+// server observed and earlier entries are client supplied.
+func clientIP(ctx context.Context) string {
+	if ip := peerIP(ctx); ip != "" {
+		return ip
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	if fwd := md.Get("x-forwarded-for"); len(fwd) > 0 {
+		hops := strings.Split(fwd[len(fwd)-1], ",")
+		return strings.TrimSpace(hops[len(hops)-1])
+	}
+	return ""
+}
+
+// loginSource returns the client address and user agent of a login. The
+// values in meta.source are used only when the transport reports none.
+func loginSource(ctx context.Context, meta *rgsv1.RequestMeta) (string, string) {
+	ip, userAgent := clientIP(ctx), ""
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, key := range []string{"grpcgateway-user-agent", "user-agent"} {
+		if ua := md.Get(key); len(ua) > 0 {
+			userAgent = ua[0]
+			break
+		}
+	}
+	if src := meta.GetSource(); src != nil {
+		if ip == "" {
+			ip = src.GetIp()
+		}
+		if userAgent == "" {
+			userAgent = src.GetUserAgent()
+		}
+	}
+	return ip, userAgent
+}
+
+func loginMethod(req *rgsv1.LoginRequest) string {
+	switch req.GetCredentials().(type) {
+	case *rgsv1.LoginRequest_Player:
+		return "pin"
+	case *rgsv1.LoginRequest_Oidc:
+		return "oidc"
+	default:
+		return "password"
+	}
+}
+
+func (s *IdentityService) Login(ctx context.Context, req *rgsv1.LoginRequest) (*rgsv1.LoginResponse, error) {
+	resp, err := s.login(ctx, req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+	resp.Meta = s.recordLoginAttempt(ctx, req.GetMeta(), loginMethod(req), resp.Token, resp.Meta)
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		resp.Token = nil
+	}
+	return resp, nil
+}
+
+// FinishWebAuthnLogin verifies an assertion and issues a session like Login.
+// Failed assertions count toward the operator's lockout.
+func (s *IdentityService) FinishWebAuthnLogin(ctx context.Context, req *rgsv1.FinishWebAuthnLoginRequest) (*rgsv1.FinishWebAuthnLoginResponse, error) {
+	resp, err := s.finishWebAuthnLogin(ctx, req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+	resp.Meta = s.recordLoginAttempt(ctx, req.GetMeta(), "webauthn", resp.Token, resp.Meta)
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		resp.Token = nil
+	}
+	return resp, nil
+}
+
+// recordLoginAttempt appends a login outcome to the login history and
+// returns the meta to respond with. A successful login whose attempt cannot
+// be recorded is undone, so no session exists without its history entry.
+func (s *IdentityService) recordLoginAttempt(ctx context.Context, meta *rgsv1.RequestMeta, method string, token *rgsv1.SessionToken, respMeta *rgsv1.ResponseMeta) *rgsv1.ResponseMeta {
+	actor := token.GetActor()
+	if actor == nil {
+		actor = meta.GetActor()
+	}
+	ip, userAgent := loginSource(ctx, meta)
+	attempt := &rgsv1.LoginAttempt{
+		Actor:        &rgsv1.Actor{ActorId: actor.GetActorId(), ActorType: actor.GetActorType()},
+		Method:       method,
+		Result:       respMeta.GetResultCode(),
+		DenialReason: respMeta.GetDenialReason(),
+		Ip:           ip,
+		UserAgent:    userAgent,
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	attempt.OccurredAt = s.now().Format(time.RFC3339Nano)
+	if s.db != nil {
+		if err := s.insertLoginAttemptDB(ctx, attempt); err != nil {
+			if respMeta.GetResultCode() == rgsv1.ResultCode_RESULT_CODE_OK {
+				_ = s.revokeSession(ctx, token.GetRefreshToken())
+				return s.responseMeta(meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")
+			}
+		}
+		return respMeta
+	}
+	s.nextLoginAttemptID++
+	attempt.AttemptId = strconv.FormatInt(s.nextLoginAttemptID, 10)
+	s.loginHistory = append(s.loginHistory, attempt)
+	if over := len(s.loginHistory) - maxInMemoryLoginHistory; over > 0 {
+		s.loginHistory = append([]*rgsv1.LoginAttempt(nil), s.loginHistory[over:]...)
+	}
+	return respMeta
+}
+
+func (s *IdentityService) ListLoginHistory(ctx context.Context, req *rgsv1.ListLoginHistoryRequest) (*rgsv1.ListLoginHistoryResponse, error) {
+	if req == nil {
+		req = &rgsv1.ListLoginHistoryRequest{}
+	}
+	invalid := func(reason string) (*rgsv1.ListLoginHistoryResponse, error) {
+		return &rgsv1.ListLoginHistoryResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, reason)}, nil
+	}
+	if req.Actor != nil && (req.Actor.ActorId == "" || req.Actor.ActorType == rgsv1.ActorType_ACTOR_TYPE_UNSPECIFIED) {
+		return invalid("actor filter requires actor_id and actor_type")
+	}
+	var since, until time.Time
+	if req.Since != "" {
+		t, err := time.Parse(time.RFC3339Nano, req.Since)
+		if err != nil {
+			return invalid("invalid since")
+		}
+		since = t
+	}
+	if req.Until != "" {
+		t, err := time.Parse(time.RFC3339Nano, req.Until)
+		if err != nil {
+			return invalid("invalid until")
+		}
+		until = t
+	}
+	if req.PageSize < 0 || req.PageSize > maxAuditPageSize {
+		return invalid("invalid page_size")
+	}
+	if err := validatePageToken(req.PageToken); err != nil {
+		return invalid("invalid page_token")
+	}
+	objectID := req.Actor.GetActorId()
+	if ok, reason := s.authorizeIdentityAdmin(ctx, req.Meta); !ok {
+		s.auditDenied(req.Meta, objectID, "identity_list_login_history", reason)
+		return &rgsv1.ListLoginHistoryResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var attempts []*rgsv1.LoginAttempt
+	if s.db != nil {
+		var err error
+		attempts, err = s.listLoginAttemptsDB(ctx, req.Actor, since, until)
+		if err != nil {
+			return &rgsv1.ListLoginHistoryResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+	} else {
+		for i := len(s.loginHistory) - 1; i >= 0; i-- {
+			a := s.loginHistory[i]
+			if req.Actor != nil && (a.Actor.GetActorId() != req.Actor.ActorId || a.Actor.GetActorType() != req.Actor.ActorType) {
+				continue
+			}
+			at, _ := time.Parse(time.RFC3339Nano, a.OccurredAt)
+			if (!since.IsZero() && at.Before(since)) || (!until.IsZero() && !at.Before(until)) {
+				continue
+			}
+			attempts = append(attempts, proto.Clone(a).(*rgsv1.LoginAttempt))
+		}
+	}
+	page, next, err := paginate(attempts, req.PageToken, req.PageSize)
+	if err != nil {
+		return invalid("invalid page_token")
+	}
+	after, _ := json.Marshal(map[string]any{"actor": req.Actor, "since": req.Since, "until": req.Until, "attempts": len(page)})
+	if err := s.appendAudit(req.Meta, objectID, "identity_list_login_history", []byte(`{}`), after, audit.ResultSuccess, ""); err != nil {
+		return &rgsv1.ListLoginHistoryResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	return &rgsv1.ListLoginHistoryResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Attempts: page, NextPageToken: next}, nil
+}
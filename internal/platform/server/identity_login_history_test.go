@@ -0,0 +1,95 @@
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestIdentityLoginHistoryRecordsAttempts(t *testing.T) {
+	svc := NewIdentityService(ledgerFixedClock{now: time.Date(2026, 3, 5, 9, 0, 0, 0, time.UTC)}, "test-secret", 15*time.Minute, time.Hour)
+	ctx := context.Background()
+	playerLogin := func(ctx context.Context, pin string) *rgsv1.LoginResponse {
+		resp, _ := svc.Login(ctx, &rgsv1.LoginRequest{
+			Meta:        meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""),
+			Credentials: &rgsv1.LoginRequest_Player{Player: &rgsv1.PlayerCredentials{PlayerId: "player-1", Pin: pin}},
+		})
+		return resp
+	}
+
+	grpcCtx := peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.7"), Port: 5123}})
+	grpcCtx = metadata.NewIncomingContext(grpcCtx, metadata.Pairs("user-agent", "kiosk/1.0"))
+	if resp := playerLogin(grpcCtx, "9999"); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected bad pin denied, got=%+v", resp.Meta)
+	}
+	gatewayCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(
+		"x-forwarded-for", "203.0.113.9, 192.0.2.4",
+		"grpcgateway-user-agent", "browser/2.0",
+	))
+	if resp := playerLogin(gatewayCtx, "1234"); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected login ok, got=%+v", resp.Meta)
+	}
+	if resp, _ := svc.Login(ctx, &rgsv1.LoginRequest{
+		Meta:        meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		Credentials: &rgsv1.LoginRequest_Operator{Operator: &rgsv1.OperatorCredentials{OperatorId: "op-1", Password: "operator-pass"}},
+	}); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected operator login ok, got=%+v", resp.Meta)
+	}
+
+	admin := meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+	denied, _ := svc.ListLoginHistory(ctx, &rgsv1.ListLoginHistoryRequest{Meta: meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "")})
+	if denied.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected player denied, got=%+v", denied.Meta)
+	}
+
+	player1 := &rgsv1.Actor{ActorId: "player-1", ActorType: rgsv1.ActorType_ACTOR_TYPE_PLAYER}
+	list, _ := svc.ListLoginHistory(ctx, &rgsv1.ListLoginHistoryRequest{Meta: admin, Actor: player1})
+	if list.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || len(list.Attempts) != 2 {
+		t.Fatalf("expected two player-1 attempts, got=%+v", list)
+	}
+	ok, bad := list.Attempts[0], list.Attempts[1]
+	if ok.Result != rgsv1.ResultCode_RESULT_CODE_OK || ok.Method != "pin" || ok.Ip != "192.0.2.4" || ok.UserAgent != "browser/2.0" {
+		t.Fatalf("unexpected successful attempt: %+v", ok)
+	}
+	if bad.Result != rgsv1.ResultCode_RESULT_CODE_DENIED || bad.DenialReason == "" || bad.Ip != "10.0.0.7" || bad.UserAgent != "kiosk/1.0" {
+		t.Fatalf("unexpected denied attempt: %+v", bad)
+	}
+
+	page, _ := svc.ListLoginHistory(ctx, &rgsv1.ListLoginHistoryRequest{Meta: admin, PageSize: 2})
+	if len(page.Attempts) != 2 || page.NextPageToken == "" || page.Attempts[0].Method != "password" {
+		t.Fatalf("expected first page of two newest first, got=%+v", page)
+	}
+	rest, _ := svc.ListLoginHistory(ctx, &rgsv1.ListLoginHistoryRequest{Meta: admin, PageSize: 2, PageToken: page.NextPageToken})
+	if len(rest.Attempts) != 1 || rest.NextPageToken != "" {
+		t.Fatalf("expected final page of one, got=%+v", rest)
+	}
+
+	none, _ := svc.ListLoginHistory(ctx, &rgsv1.ListLoginHistoryRequest{Meta: admin, Until: "2026-03-05T09:00:00Z"})
+	if none.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || len(none.Attempts) != 0 {
+		t.Fatalf("expected no attempts before until, got=%+v", none)
+	}
+	for _, req := range []*rgsv1.ListLoginHistoryRequest{
+		{Meta: admin, Since: "yesterday"},
+		{Meta: admin, PageSize: -1},
+		{Meta: admin, PageToken: "x"},
+	} {
+		resp, _ := svc.ListLoginHistory(ctx, req)
+		if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+			t.Fatalf("expected invalid for %+v, got=%+v", req, resp.Meta)
+		}
+	}
+}
+
+func TestLoginSourceFallsBackToRequestMeta(t *testing.T) {
+	m := meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "")
+	m.Source = &rgsv1.Source{Ip: "198.51.100.1", UserAgent: "terminal/3"}
+	ip, ua := loginSource(context.Background(), m)
+	if ip != "198.51.100.1" || ua != "terminal/3" {
+		t.Fatalf("expected meta source, got ip=%q ua=%q", ip, ua)
+	}
+}
@@ -0,0 +1,163 @@
+package server
+
+import (
+	"context"
+	"sort"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	platformauth "github.com/wizardbeardstudio/open-rgs-go/internal/platform/auth"
+)
+
+// OIDCClaimMapping maps ID token claims to an operator actor and roles.
+type OIDCClaimMapping struct {
+	// ActorClaim holds the operator id. Default: "sub".
+	ActorClaim string
+	// RolesClaim lists the operator's identity-provider groups, as a JSON
+	// array or a single string. Default: "groups".
+	RolesClaim string
+	// RoleMap maps identity-provider groups to RBAC role names. When set, an
+	// operator must hold at least one mapped group, and every login replaces
+	// the operator's role assignments with the mapped set.
+	RoleMap map[string]string
+}
+
+// externalRoleSyncer is implemented by RoleService; OIDC login and SCIM
+// provisioning use it to apply identity-provider roles.
+type externalRoleSyncer interface {
+	SyncExternalRoles(ctx context.Context, actor *rgsv1.Actor, roles []string, source string) error
+}
+
+// SetOIDC enables operator login with an external identity provider's ID
+// token. roles receives the mapped roles and may be nil when RoleMap is
+// empty.
+func (s *IdentityService) SetOIDC(verifier *platformauth.OIDCVerifier, mapping OIDCClaimMapping, roles externalRoleSyncer) {
+	if s == nil {
+		return
+	}
+	if mapping.ActorClaim == "" {
+		mapping.ActorClaim = "sub"
+	}
+	if mapping.RolesClaim == "" {
+		mapping.RolesClaim = "groups"
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.oidcVerifier = verifier
+	s.oidcMapping = mapping
+	s.oidcRoles = roles
+}
+
+// mappedRoles returns the sorted RBAC roles for the groups in claims.
+func (m OIDCClaimMapping) mappedRoles(claims map[string]any) []string {
+	var groups []string
+	switch v := claims[m.RolesClaim].(type) {
+	case string:
+		groups = []string{v}
+	case []any:
+		for _, g := range v {
+			if str, ok := g.(string); ok {
+				groups = append(groups, str)
+			}
+		}
+	}
+	seen := make(map[string]bool)
+	var roles []string
+	for _, g := range groups {
+		if role, ok := m.RoleMap[g]; ok && !seen[role] {
+			seen[role] = true
+			roles = append(roles, role)
+		}
+	}
+	sort.Strings(roles)
+	return roles
+}
+
+// oidcAuthStrength reads the RFC 8176 amr claim: a provider that reports
+// "mfa" (or more than one method) vouches for multi-factor authentication.
+func oidcAuthStrength(claims map[string]any) string {
+	methods, _ := claims["amr"].([]any)
+	for _, m := range methods {
+		if m == "mfa" {
+			return platformauth.AuthStrengthMFA
+		}
+	}
+	if len(methods) > 1 {
+		return platformauth.AuthStrengthMFA
+	}
+	return platformauth.AuthStrengthPassword
+}
+
+// loginOIDC finishes a Login carrying an ID token for the operator actorID.
+// The token is verified before the lock is taken because it may fetch the
+// provider's keys.
+func (s *IdentityService) loginOIDC(ctx context.Context, meta *rgsv1.RequestMeta, actorID, idToken string) *rgsv1.LoginResponse {
+	const action = "identity_oidc_login"
+	actorType := rgsv1.ActorType_ACTOR_TYPE_OPERATOR
+	respond := func(code rgsv1.ResultCode, reason string) *rgsv1.LoginResponse {
+		if code == rgsv1.ResultCode_RESULT_CODE_DENIED {
+			s.auditDenied(meta, "", action, reason)
+		}
+		if s.onLogin != nil {
+			s.onLogin(code, actorType)
+		}
+		return &rgsv1.LoginResponse{Meta: s.responseMeta(meta, code, reason)}
+	}
+
+	s.mu.Lock()
+	verifier, mapping, roleSyncer := s.oidcVerifier, s.oidcMapping, s.oidcRoles
+	s.mu.Unlock()
+	if verifier == nil {
+		return &rgsv1.LoginResponse{Meta: s.responseMeta(meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "oidc login is not configured")}
+	}
+	claims, verifyErr := verifier.Verify(ctx, idToken)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	exceeded, err := s.rateLimitExceeded(ctx, actorID, actorType)
+	if err != nil {
+		return respond(rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")
+	}
+	if exceeded {
+		return respond(rgsv1.ResultCode_RESULT_CODE_DENIED, "rate limit exceeded")
+	}
+	locked, err := s.checkLocked(ctx, actorID, actorType)
+	if err != nil {
+		return respond(rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")
+	}
+	if locked {
+		return respond(rgsv1.ResultCode_RESULT_CODE_DENIED, "account locked")
+	}
+	subject := ""
+	if verifyErr == nil {
+		subject, _ = claims[mapping.ActorClaim].(string)
+	}
+	if subject == "" {
+		lockedNow, _ := s.recordFailure(ctx, actorID, actorType)
+		if lockedNow {
+			s.lockoutActivated(ctx, actorID, actorType)
+		}
+		return respond(rgsv1.ResultCode_RESULT_CODE_DENIED, "invalid id token")
+	}
+	if subject != actorID {
+		return respond(rgsv1.ResultCode_RESULT_CODE_DENIED, "actor must match id token")
+	}
+	if len(mapping.RoleMap) > 0 {
+		roles := mapping.mappedRoles(claims)
+		if len(roles) == 0 {
+			return respond(rgsv1.ResultCode_RESULT_CODE_DENIED, "id token grants no mapped roles")
+		}
+		if roleSyncer != nil {
+			issuer, _ := claims["iss"].(string)
+			if err := roleSyncer.SyncExternalRoles(ctx, &rgsv1.Actor{ActorId: actorID, ActorType: actorType}, roles, "oidc:"+issuer); err != nil {
+				return respond(rgsv1.ResultCode_RESULT_CODE_ERROR, "role sync unavailable")
+			}
+		}
+	}
+	if err := s.resetFailures(ctx, actorID, actorType); err != nil {
+		return respond(rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")
+	}
+
+	token, respMeta := s.issueSessionLocked(ctx, meta, actorID, actorType, oidcAuthStrength(claims), action)
+	return &rgsv1.LoginResponse{Meta: respMeta, Token: token}
+}
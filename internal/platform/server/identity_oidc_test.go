@@ -0,0 +1,158 @@
+package server
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	platformauth "github.com/wizardbeardstudio/open-rgs-go/internal/platform/auth"
+)
+
+const testOIDCAudience = "rgs-console"
+
+// newTestOIDCProvider serves a single ES256 key and returns a verifier for it
+// and a function that signs ID tokens for sub with the given groups.
+func newTestOIDCProvider(t *testing.T) (*platformauth.OIDCVerifier, func(aud, sub string, groups ...string) string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate idp key: %v", err)
+	}
+	b64 := base64.RawURLEncoding.EncodeToString
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"keys": []map[string]string{{
+			"kty": "EC", "kid": "idp-1", "crv": "P-256",
+			"x": b64(key.X.FillBytes(make([]byte, 32))),
+			"y": b64(key.Y.FillBytes(make([]byte, 32))),
+		}}})
+	}))
+	t.Cleanup(srv.Close)
+	verifier, err := platformauth.NewOIDCVerifier(platformauth.OIDCConfig{Issuer: srv.URL, Audience: testOIDCAudience, JWKSURL: srv.URL})
+	if err != nil {
+		t.Fatalf("new oidc verifier: %v", err)
+	}
+	sign := func(aud, sub string, groups ...string) string {
+		tok := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+			"iss":    srv.URL,
+			"aud":    aud,
+			"sub":    sub,
+			"groups": groups,
+			"iat":    time.Now().Add(-time.Minute).Unix(),
+			"exp":    time.Now().Add(time.Hour).Unix(),
+		})
+		tok.Header["kid"] = "idp-1"
+		signed, err := tok.SignedString(key)
+		if err != nil {
+			t.Fatalf("sign id token: %v", err)
+		}
+		return signed
+	}
+	return verifier, sign
+}
+
+func oidcLoginRequest(actorID string, actorType rgsv1.ActorType, idToken string) *rgsv1.LoginRequest {
+	return &rgsv1.LoginRequest{
+		Meta:        meta(actorID, actorType, ""),
+		Credentials: &rgsv1.LoginRequest_Oidc{Oidc: &rgsv1.OIDCCredentials{IdToken: idToken}},
+	}
+}
+
+func TestIdentityOIDCLoginIssuesSessionAndSyncsRoles(t *testing.T) {
+	ctx := context.Background()
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 21, 9, 0, 0, 0, time.UTC)}
+	roles := NewRoleService(clk)
+	created, _ := roles.CreateRole(ctx, &rgsv1.CreateRoleRequest{
+		Meta:        meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		Name:        "cashier",
+		Permissions: []string{"rgs.v1.LedgerService/*"},
+	})
+	if created.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("create role failed: %+v", created.Meta)
+	}
+	verifier, sign := newTestOIDCProvider(t)
+	svc := NewIdentityService(clk, "test-secret", 15*time.Minute, time.Hour)
+	svc.SetOIDC(verifier, OIDCClaimMapping{RoleMap: map[string]string{"rgs-cashiers": "cashier"}}, roles)
+
+	resp, err := svc.Login(ctx, oidcLoginRequest("op-2", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, sign(testOIDCAudience, "op-2", "rgs-cashiers", "unrelated")))
+	if err != nil {
+		t.Fatalf("oidc login err: %v", err)
+	}
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || resp.Token.GetAccessToken() == "" || resp.Token.GetRefreshToken() == "" {
+		t.Fatalf("expected internal token pair, got meta=%+v", resp.Meta)
+	}
+	if resp.Token.Actor.GetActorId() != "op-2" || resp.Token.Actor.GetActorType() != rgsv1.ActorType_ACTOR_TYPE_OPERATOR {
+		t.Fatalf("unexpected session actor: %+v", resp.Token.Actor)
+	}
+
+	op2 := platformauth.Actor{ID: "op-2", Type: "ACTOR_TYPE_OPERATOR"}
+	if ok, _ := roles.Authorize(ctx, op2, "/rgs.v1.LedgerService/Deposit"); !ok {
+		t.Fatalf("expected mapped cashier role to allow deposits")
+	}
+	if ok, _ := roles.Authorize(ctx, op2, "/rgs.v1.ConfigService/ProposeConfigChange"); ok {
+		t.Fatalf("expected mapped role to replace the built-in operator role")
+	}
+
+	var loginAudited, syncAudited bool
+	for _, ev := range auditEvents(svc.AuditStore) {
+		loginAudited = loginAudited || (ev.Action == "identity_oidc_login" && ev.Result == "success")
+	}
+	for _, ev := range auditEvents(roles.AuditStore) {
+		syncAudited = syncAudited || (ev.Action == "rbac_sync_roles" && ev.ActorID == "system")
+	}
+	if !loginAudited || !syncAudited {
+		t.Fatalf("expected login and role sync audit events, login=%v sync=%v", loginAudited, syncAudited)
+	}
+
+	denied, _ := svc.Login(ctx, oidcLoginRequest("op-2", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, sign(testOIDCAudience, "op-2", "unrelated")))
+	if denied.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED || denied.Meta.GetDenialReason() != "id token grants no mapped roles" {
+		t.Fatalf("expected denial without mapped groups, got=%+v", denied.Meta)
+	}
+}
+
+func TestIdentityOIDCLoginDenials(t *testing.T) {
+	ctx := context.Background()
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 21, 9, 0, 0, 0, time.UTC)}
+	verifier, sign := newTestOIDCProvider(t)
+
+	unconfigured := NewIdentityService(clk, "test-secret", 15*time.Minute, time.Hour)
+	resp, _ := unconfigured.Login(ctx, oidcLoginRequest("op-2", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, sign(testOIDCAudience, "op-2")))
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected invalid when oidc is not configured, got=%+v", resp.Meta)
+	}
+
+	svc := NewIdentityService(clk, "test-secret", 15*time.Minute, time.Hour)
+	svc.SetOIDC(verifier, OIDCClaimMapping{}, nil)
+	cases := []struct {
+		name   string
+		req    *rgsv1.LoginRequest
+		reason string
+	}{
+		{"player actor", oidcLoginRequest("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, sign(testOIDCAudience, "player-1")), "oidc login is for operators"},
+		{"wrong audience", oidcLoginRequest("op-2", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, sign("other-app", "op-2")), "invalid id token"},
+		{"actor mismatch", oidcLoginRequest("op-3", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, sign(testOIDCAudience, "op-2")), "actor must match id token"},
+	}
+	for _, tc := range cases {
+		resp, err := svc.Login(ctx, tc.req)
+		if err != nil {
+			t.Fatalf("%s: login err: %v", tc.name, err)
+		}
+		if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED || resp.Meta.GetDenialReason() != tc.reason {
+			t.Fatalf("%s: expected denial %q, got=%+v", tc.name, tc.reason, resp.Meta)
+		}
+	}
+
+	// Without a role map the operator keeps their existing roles.
+	ok, _ := svc.Login(ctx, oidcLoginRequest("op-2", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, sign(testOIDCAudience, "op-2")))
+	if ok.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected login without role mapping, got=%+v", ok.Meta)
+	}
+}
@@ -0,0 +1,238 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+	"unicode"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// PasswordPolicy governs operator passwords. Player PINs are not subject to
+// it. A new password must differ from the current one and from the last
+// HistorySize passwords it replaced; MaxAge of zero disables expiry.
+type PasswordPolicy struct {
+	MinLength           int
+	MinCharacterClasses int
+	HistorySize         int
+	MaxAge              time.Duration
+}
+
+// DefaultPasswordPolicy is applied until SetPasswordPolicy is called.
+var DefaultPasswordPolicy = PasswordPolicy{MinLength: 12, MinCharacterClasses: 3, HistorySize: 5}
+
+func (s *IdentityService) SetPasswordPolicy(p PasswordPolicy) {
+	if s == nil {
+		return
+	}
+	if p.MinCharacterClasses > 4 {
+		p.MinCharacterClasses = 4
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.passwordPolicy = p
+}
+
+// passwordCharacterClasses counts the lower case, upper case, digit, and
+// other character classes present in password.
+func passwordCharacterClasses(password string) int {
+	var lower, upper, digit, other bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			other = true
+		}
+	}
+	n := 0
+	for _, present := range []bool{lower, upper, digit, other} {
+		if present {
+			n++
+		}
+	}
+	return n
+}
+
+// check returns the reason password does not meet the length and
+// complexity rules, or "" when it does.
+func (p PasswordPolicy) check(password string) string {
+	if len([]rune(password)) < p.MinLength {
+		return "password must be at least " + strconv.Itoa(p.MinLength) + " characters"
+	}
+	if passwordCharacterClasses(password) < p.MinCharacterClasses {
+		return "password must use at least " + strconv.Itoa(p.MinCharacterClasses) + " of lower case, upper case, digits, and symbols"
+	}
+	return ""
+}
+
+// Player PINs are 4 to 12 digits and may not be a single repeated digit or
+// a run of consecutive digits.
+const (
+	playerPINMinLength = 4
+	playerPINMaxLength = 12
+)
+
+// checkPlayerPIN returns the reason pin is not an acceptable player PIN, or
+// "" when it is.
+func checkPlayerPIN(pin string) string {
+	if len(pin) < playerPINMinLength || len(pin) > playerPINMaxLength {
+		return "pin must be " + strconv.Itoa(playerPINMinLength) + " to " + strconv.Itoa(playerPINMaxLength) + " digits"
+	}
+	for _, r := range pin {
+		if r < '0' || r > '9' {
+			return "pin must be " + strconv.Itoa(playerPINMinLength) + " to " + strconv.Itoa(playerPINMaxLength) + " digits"
+		}
+	}
+	repeated, ascending, descending := true, true, true
+	for i := 1; i < len(pin); i++ {
+		step := int(pin[i]) - int(pin[i-1])
+		repeated = repeated && step == 0
+		ascending = ascending && step == 1
+		descending = descending && step == -1
+	}
+	if repeated || ascending || descending {
+		return "pin is too easy to guess"
+	}
+	return ""
+}
+
+// expiresAt returns when a password changed at changedAt expires, or the
+// zero time when passwords do not expire.
+func (p PasswordPolicy) expiresAt(changedAt time.Time) time.Time {
+	if p.MaxAge <= 0 {
+		return time.Time{}
+	}
+	return changedAt.Add(p.MaxAge)
+}
+
+// credentialExpiredLocked reports whether the operator's password is past
+// the policy's max age. Without a database credentials are fixed and never
+// expire.
+func (s *IdentityService) credentialExpiredLocked(ctx context.Context, actorID string, actorType rgsv1.ActorType) (bool, error) {
+	if s.db == nil || actorType != rgsv1.ActorType_ACTOR_TYPE_OPERATOR || s.passwordPolicy.MaxAge <= 0 {
+		return false, nil
+	}
+	changedAt, ok, err := s.passwordChangedAtDB(ctx, actorID, actorType)
+	if err != nil || !ok {
+		return false, err
+	}
+	return !s.now().Before(s.passwordPolicy.expiresAt(changedAt)), nil
+}
+
+func (s *IdentityService) ChangeCredential(ctx context.Context, req *rgsv1.ChangeCredentialRequest) (*rgsv1.ChangeCredentialResponse, error) {
+	if req == nil || req.CurrentPassword == "" || req.NewPassword == "" {
+		var meta *rgsv1.RequestMeta
+		if req != nil {
+			meta = req.Meta
+		}
+		return &rgsv1.ChangeCredentialResponse{Meta: s.responseMeta(meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "current_password and new_password are required")}, nil
+	}
+	actor, reason := resolveActor(ctx, req.Meta)
+	if reason != "" {
+		s.auditDenied(req.Meta, "", "identity_change_credential", reason)
+		return &rgsv1.ChangeCredentialResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	isPlayer := actor.ActorType == rgsv1.ActorType_ACTOR_TYPE_PLAYER
+	if actor.ActorType != rgsv1.ActorType_ACTOR_TYPE_OPERATOR && !isPlayer {
+		return &rgsv1.ChangeCredentialResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "only operator passwords and player pins can be changed")}, nil
+	}
+	if req.NewPassword == req.CurrentPassword {
+		return &rgsv1.ChangeCredentialResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "password was used recently")}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// PINs share the history rule but not the complexity or expiry rules.
+	policy := s.passwordPolicy
+	weak := ""
+	if isPlayer {
+		weak = checkPlayerPIN(req.NewPassword)
+		policy.MaxAge = 0
+	} else {
+		weak = policy.check(req.NewPassword)
+	}
+	if weak != "" {
+		return &rgsv1.ChangeCredentialResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, weak)}, nil
+	}
+	if s.db == nil {
+		return &rgsv1.ChangeCredentialResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, "credential management requires database")}, nil
+	}
+
+	exceeded, err := s.rateLimitExceeded(ctx, actor.ActorId, actor.ActorType)
+	if err != nil {
+		return &rgsv1.ChangeCredentialResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if exceeded {
+		s.auditDenied(req.Meta, actor.ActorId, "identity_change_credential", "rate limit exceeded")
+		return &rgsv1.ChangeCredentialResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, "rate limit exceeded")}, nil
+	}
+	locked, err := s.checkLocked(ctx, actor.ActorId, actor.ActorType)
+	if err != nil {
+		return &rgsv1.ChangeCredentialResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if locked {
+		s.auditDenied(req.Meta, actor.ActorId, "identity_change_credential", "account locked")
+		return &rgsv1.ChangeCredentialResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, "account locked")}, nil
+	}
+	okCreds, err := s.verifyCredentials(ctx, actor.ActorId, actor.ActorType, req.CurrentPassword)
+	if err != nil {
+		return &rgsv1.ChangeCredentialResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if !okCreds {
+		lockedNow, _ := s.recordFailure(ctx, actor.ActorId, actor.ActorType)
+		if lockedNow {
+			s.lockoutActivated(ctx, actor.ActorId, actor.ActorType)
+		}
+		s.auditDenied(req.Meta, actor.ActorId, "identity_change_credential", "invalid credentials")
+		return &rgsv1.ChangeCredentialResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, "invalid credentials")}, nil
+	}
+
+	recent, err := s.passwordHistoryDB(ctx, actor.ActorId, actor.ActorType, policy.HistorySize)
+	if err != nil {
+		return &rgsv1.ChangeCredentialResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	for _, hash := range recent {
+		if bcrypt.CompareHashAndPassword([]byte(hash), []byte(req.NewPassword)) == nil {
+			s.auditDenied(req.Meta, actor.ActorId, "identity_change_credential", "password was used recently")
+			return &rgsv1.ChangeCredentialResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "password was used recently")}, nil
+		}
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(req.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return &rgsv1.ChangeCredentialResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "credential hashing unavailable")}, nil
+	}
+	now := s.now()
+	if err := s.replacePasswordDB(ctx, actor.ActorId, actor.ActorType, string(hash), now, policy.HistorySize); err != nil {
+		return &rgsv1.ChangeCredentialResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if err := s.resetFailures(ctx, actor.ActorId, actor.ActorType); err != nil {
+		return &rgsv1.ChangeCredentialResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+
+	expiresAt := ""
+	if exp := policy.expiresAt(now); !exp.IsZero() {
+		expiresAt = exp.Format(time.RFC3339Nano)
+	}
+	after, _ := json.Marshal(map[string]any{
+		"actor_id":   actor.ActorId,
+		"actor_type": actor.ActorType.String(),
+		"expires_at": expiresAt,
+	})
+	if err := s.appendAudit(req.Meta, actor.ActorId, "identity_change_credential", []byte(`{}`), after, audit.ResultSuccess, ""); err != nil {
+		return &rgsv1.ChangeCredentialResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	return &rgsv1.ChangeCredentialResponse{
+		Meta:      s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
+		ExpiresAt: expiresAt,
+	}, nil
+}
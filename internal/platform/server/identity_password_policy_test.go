@@ -0,0 +1,84 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func TestPasswordPolicyCheck(t *testing.T) {
+	p := DefaultPasswordPolicy
+	for _, tc := range []struct {
+		password string
+		reason   string
+	}{
+		{"Short1!", "password must be at least 12 characters"},
+		{"alllowercaseletters", "password must use at least 3 of lower case, upper case, digits, and symbols"},
+		{"lowerUPPERonly", "password must use at least 3 of lower case, upper case, digits, and symbols"},
+		{"lowerUPPER1234", ""},
+		{"lower-case-123", ""},
+	} {
+		if got := p.check(tc.password); got != tc.reason {
+			t.Fatalf("check(%q) = %q, want %q", tc.password, got, tc.reason)
+		}
+	}
+	if !p.expiresAt(time.Now()).IsZero() {
+		t.Fatalf("expected default policy to never expire passwords")
+	}
+}
+
+func TestIdentityChangeCredentialValidation(t *testing.T) {
+	svc := NewIdentityService(ledgerFixedClock{now: time.Date(2026, 3, 3, 9, 0, 0, 0, time.UTC)}, "test-secret", 15*time.Minute, time.Hour)
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		name   string
+		req    *rgsv1.ChangeCredentialRequest
+		code   rgsv1.ResultCode
+		reason string
+	}{
+		{"missing", &rgsv1.ChangeCredentialRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), CurrentPassword: "Current-Pass-1"}, rgsv1.ResultCode_RESULT_CODE_INVALID, "current_password and new_password are required"},
+		{"service", &rgsv1.ChangeCredentialRequest{Meta: meta("svc-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, ""), CurrentPassword: "Current-Pass-1", NewPassword: "Brand-New-Pass-1"}, rgsv1.ResultCode_RESULT_CODE_INVALID, "only operator passwords and player pins can be changed"},
+		{"player non-digit pin", &rgsv1.ChangeCredentialRequest{Meta: meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""), CurrentPassword: "1234", NewPassword: "Brand-New-Pass-1"}, rgsv1.ResultCode_RESULT_CODE_INVALID, "pin must be 4 to 12 digits"},
+		{"player guessable pin", &rgsv1.ChangeCredentialRequest{Meta: meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""), CurrentPassword: "1234", NewPassword: "3456"}, rgsv1.ResultCode_RESULT_CODE_INVALID, "pin is too easy to guess"},
+		{"player no database", &rgsv1.ChangeCredentialRequest{Meta: meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""), CurrentPassword: "1234", NewPassword: "8302"}, rgsv1.ResultCode_RESULT_CODE_DENIED, "credential management requires database"},
+		{"unchanged", &rgsv1.ChangeCredentialRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), CurrentPassword: "Current-Pass-1", NewPassword: "Current-Pass-1"}, rgsv1.ResultCode_RESULT_CODE_INVALID, "password was used recently"},
+		{"weak", &rgsv1.ChangeCredentialRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), CurrentPassword: "Current-Pass-1", NewPassword: "weak"}, rgsv1.ResultCode_RESULT_CODE_INVALID, "password must be at least 12 characters"},
+		{"no database", &rgsv1.ChangeCredentialRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), CurrentPassword: "Current-Pass-1", NewPassword: "Brand-New-Pass-1"}, rgsv1.ResultCode_RESULT_CODE_DENIED, "credential management requires database"},
+	} {
+		resp, err := svc.ChangeCredential(ctx, tc.req)
+		if err != nil {
+			t.Fatalf("%s: change credential err: %v", tc.name, err)
+		}
+		if resp.Meta.GetResultCode() != tc.code || resp.Meta.GetDenialReason() != tc.reason {
+			t.Fatalf("%s: expected %v %q, got=%+v", tc.name, tc.code, tc.reason, resp.Meta)
+		}
+	}
+}
+
+func TestCheckPlayerPIN(t *testing.T) {
+	for pin, want := range map[string]string{
+		"8302":          "",
+		"40917365":      "",
+		"123":           "pin must be 4 to 12 digits",
+		"1234567890123": "pin must be 4 to 12 digits",
+		"12a4":          "pin must be 4 to 12 digits",
+		"7777":          "pin is too easy to guess",
+		"4567":          "pin is too easy to guess",
+		"9876":          "pin is too easy to guess",
+	} {
+		if got := checkPlayerPIN(pin); got != want {
+			t.Fatalf("%q: got=%q want=%q", pin, got, want)
+		}
+	}
+}
+
+func TestIdentitySetPasswordPolicyCapsCharacterClasses(t *testing.T) {
+	svc := NewIdentityService(ledgerFixedClock{now: time.Date(2026, 3, 3, 9, 0, 0, 0, time.UTC)}, "test-secret", 15*time.Minute, time.Hour)
+	svc.SetPasswordPolicy(PasswordPolicy{MinLength: 8, MinCharacterClasses: 9})
+	if svc.passwordPolicy.MinCharacterClasses != 4 {
+		t.Fatalf("expected character classes capped at 4, got=%d", svc.passwordPolicy.MinCharacterClasses)
+	}
+}
@@ -3,9 +3,14 @@ package server
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
 	"time"
 
 	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
 )
 
 func (s *IdentityService) HasActiveCredentials(ctx context.Context) (bool, error) {
@@ -24,6 +29,25 @@ WHERE status = 'active'
 	return count > 0, nil
 }
 
+// ServiceActorRegistered implements ServiceActorDirectory: actorID is a
+// service actor with active credentials. Without a database there are no
+// service credentials.
+func (s *IdentityService) ServiceActorRegistered(ctx context.Context, actorID string) (bool, error) {
+	if s == nil || s.db == nil {
+		return false, nil
+	}
+	const q = `
+SELECT EXISTS (
+  SELECT 1
+  FROM identity_credentials
+  WHERE actor_id = $1 AND actor_type = $2 AND status = 'active'
+)
+`
+	var ok bool
+	err := s.db.QueryRowContext(ctx, q, actorID, rgsv1.ActorType_ACTOR_TYPE_SERVICE.String()).Scan(&ok)
+	return ok, err
+}
+
 func (s *IdentityService) setCredentialStatus(ctx context.Context, actorID string, actorType rgsv1.ActorType, status string) (bool, error) {
 	if s == nil || s.db == nil {
 		return false, errIdentityPersistenceRequired
@@ -74,8 +98,8 @@ func (s *IdentityService) storeSession(ctx context.Context, sess *identitySessio
 		return nil
 	}
 	const q = `
-INSERT INTO identity_sessions (refresh_token, actor_id, actor_type, expires_at, revoked)
-VALUES ($1, $2, $3, $4::timestamptz, $5)
+INSERT INTO identity_sessions (refresh_token, session_id, family_id, actor_id, actor_type, expires_at, revoked, auth_strength, auth_time)
+VALUES ($1, $2, $3, $4, $5, $6::timestamptz, $7, $8, $9)
 ON CONFLICT (refresh_token) DO UPDATE SET
   actor_id = EXCLUDED.actor_id,
   actor_type = EXCLUDED.actor_type,
@@ -83,7 +107,7 @@ ON CONFLICT (refresh_token) DO UPDATE SET
   revoked = EXCLUDED.revoked,
   updated_at = NOW()
 `
-	_, err := s.db.ExecContext(ctx, q, sess.refreshToken, sess.actorID, sess.actorType.String(), sess.expiresAt.UTC().Format(time.RFC3339Nano), sess.revoked)
+	_, err := s.db.ExecContext(ctx, q, sess.refreshToken, identitySessionID(sess.refreshToken), sess.familyID, sess.actorID, sess.actorType.String(), sess.expiresAt.UTC().Format(time.RFC3339Nano), sess.revoked, sess.authStrength, sess.authTime)
 	return err
 }
 
@@ -92,21 +116,86 @@ func (s *IdentityService) getSession(ctx context.Context, refreshToken string) (
 		return nil, nil
 	}
 	const q = `
-SELECT refresh_token, actor_id, actor_type, expires_at, revoked
+SELECT ` + identitySessionColumns + `
 FROM identity_sessions
 WHERE refresh_token = $1
 `
+	sess, err := scanIdentitySession(s.db.QueryRowContext(ctx, q, refreshToken))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return sess, err
+}
+
+const identitySessionColumns = `refresh_token, family_id, actor_id, actor_type, auth_strength, auth_time, created_at, expires_at, revoked, rotated`
+
+func scanIdentitySession(row interface{ Scan(...any) error }) (*identitySession, error) {
 	var sess identitySession
 	var actorType string
-	err := s.db.QueryRowContext(ctx, q, refreshToken).Scan(&sess.refreshToken, &sess.actorID, &actorType, &sess.expiresAt, &sess.revoked)
+	if err := row.Scan(&sess.refreshToken, &sess.familyID, &sess.actorID, &actorType, &sess.authStrength, &sess.authTime, &sess.createdAt, &sess.expiresAt, &sess.revoked, &sess.rotated); err != nil {
+		return nil, err
+	}
+	sess.actorType = actorTypeFromString(actorType)
+	return &sess, nil
+}
+
+func (s *IdentityService) getSessionByID(ctx context.Context, sessionID string) (*identitySession, error) {
+	const q = `
+SELECT ` + identitySessionColumns + `
+FROM identity_sessions
+WHERE session_id = $1
+`
+	sess, err := scanIdentitySession(s.db.QueryRowContext(ctx, q, sessionID))
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
+	return sess, err
+}
+
+// listActiveSessionsDB returns unrevoked, unexpired sessions, optionally
+// only those of one actor, oldest first.
+func (s *IdentityService) listActiveSessionsDB(ctx context.Context, actor *rgsv1.Actor) ([]*identitySession, error) {
+	q := `
+SELECT ` + identitySessionColumns + `
+FROM identity_sessions
+WHERE revoked = FALSE AND expires_at > $1
+`
+	args := []any{s.now()}
+	if actor != nil {
+		q += `  AND actor_id = $2 AND actor_type = $3
+`
+		args = append(args, actor.ActorId, actor.ActorType.String())
+	}
+	q += `ORDER BY created_at, session_id`
+	rows, err := s.db.QueryContext(ctx, q, args...)
 	if err != nil {
 		return nil, err
 	}
-	sess.actorType = actorTypeFromString(actorType)
-	return &sess, nil
+	defer rows.Close()
+	out := make([]*identitySession, 0)
+	for rows.Next() {
+		sess, err := scanIdentitySession(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, sess)
+	}
+	return out, rows.Err()
+}
+
+// revokeActorSessionsDB revokes every live session of an actor and returns
+// how many were still active.
+func (s *IdentityService) revokeActorSessionsDB(ctx context.Context, actorID string, actorType rgsv1.ActorType) (int64, error) {
+	const q = `
+UPDATE identity_sessions
+SET revoked = TRUE, updated_at = NOW()
+WHERE actor_id = $1 AND actor_type = $2 AND revoked = FALSE AND expires_at > $3
+`
+	res, err := s.db.ExecContext(ctx, q, actorID, actorType.String(), s.now())
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
 }
 
 func (s *IdentityService) revokeSession(ctx context.Context, refreshToken string) error {
@@ -134,26 +223,49 @@ func (s *IdentityService) rotateSession(ctx context.Context, oldRefreshToken str
 
 	const revokeQ = `
 UPDATE identity_sessions
-SET revoked = TRUE, updated_at = NOW()
+SET revoked = TRUE, rotated = TRUE, updated_at = NOW()
 WHERE refresh_token = $1
 `
 	if _, err := tx.ExecContext(ctx, revokeQ, oldRefreshToken); err != nil {
 		return err
 	}
 	const insertQ = `
-INSERT INTO identity_sessions (refresh_token, actor_id, actor_type, expires_at, revoked)
-VALUES ($1, $2, $3, $4::timestamptz, $5)
+INSERT INTO identity_sessions (refresh_token, session_id, family_id, actor_id, actor_type, expires_at, revoked, auth_strength, auth_time)
+VALUES ($1, $2, $3, $4, $5, $6::timestamptz, $7, $8, $9)
 `
-	if _, err := tx.ExecContext(ctx, insertQ, next.refreshToken, next.actorID, next.actorType.String(), next.expiresAt.UTC().Format(time.RFC3339Nano), next.revoked); err != nil {
+	if _, err := tx.ExecContext(ctx, insertQ, next.refreshToken, identitySessionID(next.refreshToken), next.familyID, next.actorID, next.actorType.String(), next.expiresAt.UTC().Format(time.RFC3339Nano), next.revoked, next.authStrength, next.authTime); err != nil {
 		return err
 	}
 	return tx.Commit()
 }
 
-func (s *IdentityService) CleanupExpiredSessions(ctx context.Context, batchSize int) (int64, error) {
+// revokeSessionFamily revokes every live session of a token family and
+// returns how many were still active.
+func (s *IdentityService) revokeSessionFamily(ctx context.Context, familyID string) (int64, error) {
 	if s == nil || s.db == nil {
 		return 0, nil
 	}
+	const q = `
+UPDATE identity_sessions
+SET revoked = TRUE, updated_at = NOW()
+WHERE family_id = $1 AND revoked = FALSE
+`
+	res, err := s.db.ExecContext(ctx, q, familyID)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+func (s *IdentityService) CleanupExpiredSessions(ctx context.Context, batchSize int) (int64, error) {
+	b, err := s.cleanupExpiredSessionsBatch(ctx, batchSize)
+	return b.affected, err
+}
+
+func (s *IdentityService) cleanupExpiredSessionsBatch(ctx context.Context, batchSize int) (workerBatch, error) {
+	if s == nil || s.db == nil {
+		return workerBatch{}, nil
+	}
 	if batchSize <= 0 {
 		batchSize = 500
 	}
@@ -164,51 +276,742 @@ WITH doomed AS (
   WHERE expires_at <= NOW()
   ORDER BY expires_at ASC
   LIMIT $1
+), deleted AS (
+  DELETE FROM identity_sessions
+  WHERE ctid IN (SELECT ctid FROM doomed)
+  RETURNING expires_at
 )
-DELETE FROM identity_sessions
-WHERE ctid IN (SELECT ctid FROM doomed)
+SELECT COUNT(*), COALESCE(MIN(expires_at), to_timestamp(0)), COALESCE(MAX(expires_at), to_timestamp(0))
+FROM deleted
 `
-	res, err := s.db.ExecContext(ctx, q, batchSize)
-	if err != nil {
-		return 0, err
+	var b workerBatch
+	if err := s.db.QueryRowContext(ctx, q, batchSize).Scan(&b.affected, &b.from, &b.to); err != nil {
+		return workerBatch{}, err
 	}
-	return res.RowsAffected()
+	return b, nil
 }
 
-func (s *IdentityService) StartSessionCleanupWorker(ctx context.Context, interval time.Duration, batchSize int, logger func(string, ...any)) {
-	if s == nil || s.db == nil || interval <= 0 {
-		return
-	}
+// SessionCleanupJob deletes expired sessions in batches until a short batch
+// shows none remain, and audits what it removed under the system actor.
+func (s *IdentityService) SessionCleanupJob(batchSize int) JobFunc {
 	if batchSize <= 0 {
 		batchSize = 500
 	}
-	go func() {
-		ticker := time.NewTicker(interval)
-		defer ticker.Stop()
+	return func(ctx context.Context, _ string) (string, error) {
+		summary := newWorkerAuditSummary("identity_session_cleanup", "expires_at")
 		for {
-			select {
-			case <-ctx.Done():
-				return
-			case <-ticker.C:
-				for {
-					deleted, err := s.CleanupExpiredSessions(ctx, batchSize)
-					if err != nil {
-						if logger != nil {
-							logger("identity session cleanup failed: %v", err)
-						}
-						break
-					}
-					if deleted == 0 {
-						break
-					}
-					if logger != nil {
-						logger("identity session cleanup removed %d expired sessions", deleted)
-					}
-					if deleted < int64(batchSize) {
-						break
-					}
+			b, err := s.cleanupExpiredSessionsBatch(ctx, batchSize)
+			if err != nil {
+				if auditErr := s.auditWorkerRun(summary); auditErr != nil {
+					return "", errors.Join(err, auditErr)
 				}
+				return "", err
+			}
+			summary.add(b)
+			if b.affected < int64(batchSize) {
+				break
 			}
 		}
-	}()
+		if err := s.auditWorkerRun(summary); err != nil {
+			return "", fmt.Errorf("audit unavailable: %w", err)
+		}
+		if summary.Affected == 0 {
+			return "", nil
+		}
+		return fmt.Sprintf("removed %d expired sessions", summary.Affected), nil
+	}
+}
+
+func (s *IdentityService) auditWorkerRun(summary *workerAuditSummary) error {
+	if summary.Affected == 0 {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.appendAudit(nil, "identity_sessions", summary.Job, []byte(`{}`), summary.snapshot(), audit.ResultSuccess, "")
+}
+
+type playerIdentityMatchRow struct {
+	PlayerID string   `json:"player_id"`
+	Reasons  []string `json:"reasons"`
+}
+
+const playerIdentityColumns = `player_id, status, matches, registered_at, reviewed_at, reviewed_by, review_note`
+
+func scanPlayerIdentityRecord(row interface{ Scan(...any) error }) (*rgsv1.PlayerIdentityRecord, error) {
+	var (
+		rec          rgsv1.PlayerIdentityRecord
+		statusRaw    string
+		matchesRaw   []byte
+		registeredAt time.Time
+		reviewedAt   *time.Time
+	)
+	if err := row.Scan(&rec.PlayerId, &statusRaw, &matchesRaw, &registeredAt, &reviewedAt, &rec.ReviewedBy, &rec.ReviewNote); err != nil {
+		return nil, err
+	}
+	var matches []playerIdentityMatchRow
+	if err := json.Unmarshal(matchesRaw, &matches); err != nil {
+		return nil, err
+	}
+	for _, m := range matches {
+		rec.Matches = append(rec.Matches, &rgsv1.PlayerIdentityMatch{PlayerId: m.PlayerID, Reasons: m.Reasons})
+	}
+	rec.Status = playerIdentityStatusFromDB(statusRaw)
+	rec.RegisteredAt = registeredAt.UTC().Format(time.RFC3339Nano)
+	if reviewedAt != nil {
+		rec.ReviewedAt = reviewedAt.UTC().Format(time.RFC3339Nano)
+	}
+	return &rec, nil
+}
+
+func (s *IdentityService) getPlayerIdentityFromDB(ctx context.Context, playerID string) (*rgsv1.PlayerIdentityRecord, error) {
+	q := `SELECT ` + playerIdentityColumns + ` FROM player_identities WHERE player_id = $1`
+	rec, err := scanPlayerIdentityRecord(s.db.QueryRowContext(ctx, q, playerID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return rec, err
+}
+
+// findPlayerIdentityCandidatesInDB loads other players sharing any match hash
+// with p. A transposed date of birth is symmetric, so comparing p's hash with
+// the stored alternate hash finds it from either side.
+func (s *IdentityService) findPlayerIdentityCandidatesInDB(ctx context.Context, playerID string, p *playerIdentity) (map[string]*playerIdentity, error) {
+	const q = `
+SELECT player_id, document_hash, name_dob_hash, name_dob_alt_hash
+FROM player_identities
+WHERE player_id <> $1
+  AND (($2 <> '' AND document_hash = $2) OR name_dob_hash = $3 OR name_dob_alt_hash = $3)
+`
+	rows, err := s.db.QueryContext(ctx, q, playerID, p.documentHash, p.nameDOBHash)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make(map[string]*playerIdentity)
+	for rows.Next() {
+		var id string
+		var c playerIdentity
+		if err := rows.Scan(&id, &c.documentHash, &c.nameDOBHash, &c.nameDOBAltHash); err != nil {
+			return nil, err
+		}
+		out[id] = &c
+	}
+	return out, rows.Err()
+}
+
+func (s *IdentityService) insertPlayerIdentityInDB(ctx context.Context, p *playerIdentity) error {
+	matches := make([]playerIdentityMatchRow, 0, len(p.record.Matches))
+	for _, m := range p.record.Matches {
+		matches = append(matches, playerIdentityMatchRow{PlayerID: m.PlayerId, Reasons: m.Reasons})
+	}
+	matchesJSON, _ := json.Marshal(matches)
+	const q = `
+INSERT INTO player_identities (
+  player_id, document_hash, name_dob_hash, name_dob_alt_hash, status, matches, registered_at
+)
+VALUES ($1, $2, $3, $4, $5, $6::jsonb, $7)
+`
+	_, err := s.db.ExecContext(ctx, q,
+		p.record.PlayerId,
+		p.documentHash,
+		p.nameDOBHash,
+		p.nameDOBAltHash,
+		playerIdentityStatusToDB(p.record.Status),
+		string(matchesJSON),
+		parseTS(p.record.RegisteredAt),
+	)
+	return err
+}
+
+func (s *IdentityService) listPlayerIdentitiesFromDB(ctx context.Context, status rgsv1.PlayerIdentityStatus) ([]*rgsv1.PlayerIdentityRecord, error) {
+	q := `SELECT ` + playerIdentityColumns + ` FROM player_identities WHERE status = $1 ORDER BY registered_at, player_id`
+	rows, err := s.db.QueryContext(ctx, q, playerIdentityStatusToDB(status))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]*rgsv1.PlayerIdentityRecord, 0)
+	for rows.Next() {
+		rec, err := scanPlayerIdentityRecord(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, rec)
+	}
+	return out, rows.Err()
+}
+
+func (s *IdentityService) updatePlayerIdentityReviewInDB(ctx context.Context, rec *rgsv1.PlayerIdentityRecord) error {
+	const q = `
+UPDATE player_identities
+SET status = $2, reviewed_at = $3, reviewed_by = $4, review_note = $5
+WHERE player_id = $1 AND status = 'pending_review'
+`
+	res, err := s.db.ExecContext(ctx, q, rec.PlayerId, playerIdentityStatusToDB(rec.Status), parseTS(rec.ReviewedAt), rec.ReviewedBy, rec.ReviewNote)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n != 1 {
+		return fmt.Errorf("player identity %s is no longer pending review", rec.PlayerId)
+	}
+	return nil
+}
+
+func (s *IdentityService) storeWebAuthnChallengeDB(ctx context.Context, challenge, actorID, purpose string, expiresAt time.Time) error {
+	const cleanupQ = `
+DELETE FROM identity_webauthn_challenges
+WHERE expires_at <= $1
+`
+	if _, err := s.db.ExecContext(ctx, cleanupQ, s.now()); err != nil {
+		return err
+	}
+	const q = `
+INSERT INTO identity_webauthn_challenges (challenge, actor_id, actor_type, purpose, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+`
+	_, err := s.db.ExecContext(ctx, q, challenge, actorID, rgsv1.ActorType_ACTOR_TYPE_OPERATOR.String(), purpose, expiresAt)
+	return err
+}
+
+func (s *IdentityService) consumeWebAuthnChallengeDB(ctx context.Context, challenge string) (webauthnChallenge, bool, error) {
+	const q = `
+DELETE FROM identity_webauthn_challenges
+WHERE challenge = $1
+RETURNING actor_id, purpose, expires_at
+`
+	var c webauthnChallenge
+	err := s.db.QueryRowContext(ctx, q, challenge).Scan(&c.actorID, &c.purpose, &c.expiresAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return webauthnChallenge{}, false, nil
+	}
+	if err != nil {
+		return webauthnChallenge{}, false, err
+	}
+	return c, true, nil
+}
+
+const webauthnCredentialColumns = `actor_id, credential_id, public_key, sign_count, label, status, created_at, last_used_at`
+
+func scanWebAuthnCredential(scan func(dest ...any) error) (*webauthnCredential, error) {
+	var (
+		c         webauthnCredential
+		signCount int64
+		lastUsed  sql.NullTime
+	)
+	if err := scan(&c.actorID, &c.credentialID, &c.publicKey, &signCount, &c.label, &c.status, &c.createdAt, &lastUsed); err != nil {
+		return nil, err
+	}
+	c.signCount = uint32(signCount)
+	if lastUsed.Valid {
+		c.lastUsedAt = lastUsed.Time
+	}
+	return &c, nil
+}
+
+func (s *IdentityService) insertWebAuthnCredentialDB(ctx context.Context, c *webauthnCredential) error {
+	const q = `
+INSERT INTO identity_credentials (
+  actor_id, actor_type, credential_type, credential_id, password_hash, public_key, sign_count, label, status, created_at, updated_at
+)
+VALUES ($1, $2, 'webauthn', $3, '', $4, $5, $6, $7, $8, $8)
+`
+	_, err := s.db.ExecContext(ctx, q, c.actorID, rgsv1.ActorType_ACTOR_TYPE_OPERATOR.String(), c.credentialID, c.publicKey, int64(c.signCount), c.label, c.status, c.createdAt)
+	return err
+}
+
+func (s *IdentityService) deleteWebAuthnCredentialDB(ctx context.Context, credentialID string) error {
+	const q = `
+DELETE FROM identity_credentials
+WHERE credential_type = 'webauthn' AND credential_id = $1
+`
+	_, err := s.db.ExecContext(ctx, q, credentialID)
+	return err
+}
+
+func (s *IdentityService) getWebAuthnCredentialDB(ctx context.Context, credentialID string) (*webauthnCredential, error) {
+	q := `
+SELECT ` + webauthnCredentialColumns + `
+FROM identity_credentials
+WHERE credential_type = 'webauthn' AND credential_id = $1
+`
+	c, err := scanWebAuthnCredential(s.db.QueryRowContext(ctx, q, credentialID).Scan)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return c, err
+}
+
+func (s *IdentityService) listWebAuthnCredentialsDB(ctx context.Context, actorID string) ([]*webauthnCredential, error) {
+	q := `
+SELECT ` + webauthnCredentialColumns + `
+FROM identity_credentials
+WHERE credential_type = 'webauthn' AND actor_id = $1 AND actor_type = $2 AND status = 'active'
+ORDER BY created_at, credential_id
+`
+	rows, err := s.db.QueryContext(ctx, q, actorID, rgsv1.ActorType_ACTOR_TYPE_OPERATOR.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []*webauthnCredential
+	for rows.Next() {
+		c, err := scanWebAuthnCredential(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, c)
+	}
+	return out, rows.Err()
+}
+
+func (s *IdentityService) updateWebAuthnCredentialUseDB(ctx context.Context, c *webauthnCredential) error {
+	const q = `
+UPDATE identity_credentials
+SET sign_count = $2, last_used_at = $3, updated_at = NOW()
+WHERE credential_type = 'webauthn' AND credential_id = $1
+`
+	_, err := s.db.ExecContext(ctx, q, c.credentialID, int64(c.signCount), c.lastUsedAt)
+	return err
+}
+
+func (s *IdentityService) passwordChangedAtDB(ctx context.Context, actorID string, actorType rgsv1.ActorType) (time.Time, bool, error) {
+	const q = `
+SELECT password_changed_at
+FROM identity_credentials
+WHERE actor_id = $1 AND actor_type = $2 AND credential_type = 'password'
+`
+	var changedAt time.Time
+	err := s.db.QueryRowContext(ctx, q, actorID, actorType.String()).Scan(&changedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return time.Time{}, false, nil
+	}
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	return changedAt, true, nil
+}
+
+// passwordHistoryDB returns the current password hash followed by the last
+// limit hashes it replaced.
+func (s *IdentityService) passwordHistoryDB(ctx context.Context, actorID string, actorType rgsv1.ActorType, limit int) ([]string, error) {
+	const q = `
+(SELECT password_hash FROM identity_credentials
+ WHERE actor_id = $1 AND actor_type = $2 AND credential_type = 'password')
+UNION ALL
+(SELECT password_hash FROM identity_credential_history
+ WHERE actor_id = $1 AND actor_type = $2
+ ORDER BY replaced_at DESC, history_id DESC
+ LIMIT $3)
+`
+	if limit < 0 {
+		limit = 0
+	}
+	rows, err := s.db.QueryContext(ctx, q, actorID, actorType.String(), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []string
+	for rows.Next() {
+		var hash string
+		if err := rows.Scan(&hash); err != nil {
+			return nil, err
+		}
+		out = append(out, hash)
+	}
+	return out, rows.Err()
+}
+
+// replacePasswordDB moves the current password hash into the history, keeps
+// the newest keep history entries, and stores hash as the new password.
+func (s *IdentityService) replacePasswordDB(ctx context.Context, actorID string, actorType rgsv1.ActorType, hash string, changedAt time.Time, keep int) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+	const archive = `
+INSERT INTO identity_credential_history (actor_id, actor_type, password_hash, replaced_at)
+SELECT actor_id, actor_type, password_hash, $3
+FROM identity_credentials
+WHERE actor_id = $1 AND actor_type = $2 AND credential_type = 'password'
+`
+	if _, err := tx.ExecContext(ctx, archive, actorID, actorType.String(), changedAt); err != nil {
+		return err
+	}
+	const prune = `
+DELETE FROM identity_credential_history
+WHERE actor_id = $1 AND actor_type = $2 AND history_id NOT IN (
+  SELECT history_id FROM identity_credential_history
+  WHERE actor_id = $1 AND actor_type = $2
+  ORDER BY replaced_at DESC, history_id DESC
+  LIMIT $3
+)
+`
+	if keep < 0 {
+		keep = 0
+	}
+	if _, err := tx.ExecContext(ctx, prune, actorID, actorType.String(), keep); err != nil {
+		return err
+	}
+	const update = `
+UPDATE identity_credentials
+SET password_hash = $3, password_changed_at = $4, updated_at = NOW()
+WHERE actor_id = $1 AND actor_type = $2 AND credential_type = 'password' AND status = 'active'
+`
+	res, err := tx.ExecContext(ctx, update, actorID, actorType.String(), hash, changedAt)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil || n == 0 {
+		if err == nil {
+			err = sql.ErrNoRows
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+func (s *IdentityService) insertKeyRotationDB(ctx context.Context, rec *rgsv1.KeyRotation) error {
+	keyIDs, err := json.Marshal(rec.KeyIds)
+	if err != nil {
+		return err
+	}
+	rotatedAt, err := time.Parse(time.RFC3339Nano, rec.RotatedAt)
+	if err != nil {
+		return err
+	}
+	const q = `
+INSERT INTO identity_key_rotations (
+  fingerprint, previous_fingerprint, active_kid, key_ids, source, trigger,
+  triggered_by_id, triggered_by_type, instance_id, rotated_at
+)
+VALUES (
+  $1,
+  COALESCE((SELECT fingerprint FROM identity_key_rotations ORDER BY rotation_id DESC LIMIT 1), ''),
+  $2, $3::jsonb, $4, $5, $6, $7, $8, $9
+)
+RETURNING rotation_id, previous_fingerprint
+`
+	var id int64
+	if err := s.db.QueryRowContext(ctx, q,
+		rec.Fingerprint, rec.ActiveKid, string(keyIDs), rec.Source, rec.Trigger,
+		rec.TriggeredBy.ActorId, rec.TriggeredBy.ActorType.String(), rec.InstanceId, rotatedAt,
+	).Scan(&id, &rec.PreviousFingerprint); err != nil {
+		return err
+	}
+	rec.RotationId = strconv.FormatInt(id, 10)
+	return nil
+}
+
+func (s *IdentityService) listKeyRotationsDB(ctx context.Context, limit int) ([]*rgsv1.KeyRotation, error) {
+	const q = `
+SELECT rotation_id, fingerprint, previous_fingerprint, active_kid, key_ids, source, trigger,
+       triggered_by_id, triggered_by_type, instance_id, rotated_at
+FROM identity_key_rotations
+ORDER BY rotation_id DESC
+LIMIT $1
+`
+	rows, err := s.db.QueryContext(ctx, q, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []*rgsv1.KeyRotation
+	for rows.Next() {
+		var (
+			rec       rgsv1.KeyRotation
+			id        int64
+			keyIDs    []byte
+			actorID   string
+			actorType string
+			rotatedAt time.Time
+		)
+		if err := rows.Scan(&id, &rec.Fingerprint, &rec.PreviousFingerprint, &rec.ActiveKid, &keyIDs, &rec.Source, &rec.Trigger,
+			&actorID, &actorType, &rec.InstanceId, &rotatedAt); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(keyIDs, &rec.KeyIds); err != nil {
+			return nil, err
+		}
+		rec.RotationId = strconv.FormatInt(id, 10)
+		rec.TriggeredBy = &rgsv1.Actor{ActorId: actorID, ActorType: actorTypeFromString(actorType)}
+		rec.RotatedAt = rotatedAt.UTC().Format(time.RFC3339Nano)
+		out = append(out, &rec)
+	}
+	return out, rows.Err()
+}
+
+func (s *IdentityService) insertLoginAttemptDB(ctx context.Context, a *rgsv1.LoginAttempt) error {
+	occurredAt, err := time.Parse(time.RFC3339Nano, a.OccurredAt)
+	if err != nil {
+		return err
+	}
+	const q = `
+INSERT INTO identity_login_history (actor_id, actor_type, method, result, denial_reason, ip, user_agent, occurred_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING attempt_id
+`
+	var id int64
+	if err := s.db.QueryRowContext(ctx, q,
+		a.Actor.GetActorId(), a.Actor.GetActorType().String(), a.Method, a.Result.String(), a.DenialReason, a.Ip, a.UserAgent, occurredAt,
+	).Scan(&id); err != nil {
+		return err
+	}
+	a.AttemptId = strconv.FormatInt(id, 10)
+	return nil
+}
+
+// listLoginAttemptsDB returns login attempts newest first, optionally
+// limited to one actor and to occurred_at in [since, until).
+func (s *IdentityService) listLoginAttemptsDB(ctx context.Context, actor *rgsv1.Actor, since, until time.Time) ([]*rgsv1.LoginAttempt, error) {
+	q := `
+SELECT attempt_id, actor_id, actor_type, method, result, denial_reason, ip, user_agent, occurred_at
+FROM identity_login_history
+WHERE TRUE
+`
+	var args []any
+	if actor != nil {
+		args = append(args, actor.ActorId, actor.ActorType.String())
+		q += `  AND actor_id = $1 AND actor_type = $2
+`
+	}
+	if !since.IsZero() {
+		args = append(args, since)
+		q += `  AND occurred_at >= $` + strconv.Itoa(len(args)) + `
+`
+	}
+	if !until.IsZero() {
+		args = append(args, until)
+		q += `  AND occurred_at < $` + strconv.Itoa(len(args)) + `
+`
+	}
+	q += `ORDER BY occurred_at DESC, attempt_id DESC`
+	rows, err := s.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]*rgsv1.LoginAttempt, 0)
+	for rows.Next() {
+		var (
+			a          rgsv1.LoginAttempt
+			id         int64
+			actorID    string
+			actorType  string
+			result     string
+			occurredAt time.Time
+		)
+		if err := rows.Scan(&id, &actorID, &actorType, &a.Method, &result, &a.DenialReason, &a.Ip, &a.UserAgent, &occurredAt); err != nil {
+			return nil, err
+		}
+		a.AttemptId = strconv.FormatInt(id, 10)
+		a.Actor = &rgsv1.Actor{ActorId: actorID, ActorType: actorTypeFromString(actorType)}
+		a.Result = rgsv1.ResultCode(rgsv1.ResultCode_value[result])
+		a.OccurredAt = occurredAt.UTC().Format(time.RFC3339Nano)
+		out = append(out, &a)
+	}
+	return out, rows.Err()
+}
+
+func (s *IdentityService) getIPAllowlistDB(ctx context.Context, actorID string, actorType rgsv1.ActorType) (*rgsv1.IPAllowlist, error) {
+	const q = `
+SELECT cidr::TEXT, updated_at
+FROM identity_ip_allowlists
+WHERE actor_id = $1 AND actor_type = $2
+ORDER BY cidr::TEXT
+`
+	rows, err := s.db.QueryContext(ctx, q, actorID, actorType.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	list := &rgsv1.IPAllowlist{Actor: &rgsv1.Actor{ActorId: actorID, ActorType: actorType}, Cidrs: []string{}}
+	for rows.Next() {
+		var (
+			cidr      string
+			updatedAt time.Time
+		)
+		if err := rows.Scan(&cidr, &updatedAt); err != nil {
+			return nil, err
+		}
+		list.Cidrs = append(list.Cidrs, cidr)
+		list.UpdatedAt = updatedAt.UTC().Format(time.RFC3339Nano)
+	}
+	return list, rows.Err()
+}
+
+// replaceIPAllowlistDB swaps the actor's allowlist for list in one
+// transaction.
+func (s *IdentityService) replaceIPAllowlistDB(ctx context.Context, list *rgsv1.IPAllowlist) error {
+	updatedAt, err := time.Parse(time.RFC3339Nano, list.UpdatedAt)
+	if err != nil {
+		return err
+	}
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+	actorID, actorType := list.Actor.ActorId, list.Actor.ActorType.String()
+	if _, err := tx.ExecContext(ctx, `DELETE FROM identity_ip_allowlists WHERE actor_id = $1 AND actor_type = $2`, actorID, actorType); err != nil {
+		return err
+	}
+	const insert = `
+INSERT INTO identity_ip_allowlists (actor_id, actor_type, cidr, updated_at)
+VALUES ($1, $2, $3::CIDR, $4)
+`
+	for _, cidr := range list.Cidrs {
+		if _, err := tx.ExecContext(ctx, insert, actorID, actorType, cidr, updatedAt); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+const scimUserColumns = `scim_id, actor_id, external_id, display_name, active, roles, created_at, updated_at`
+
+func scanSCIMUser(row interface{ Scan(...any) error }) (*scimUserRecord, error) {
+	var (
+		rec   scimUserRecord
+		roles []byte
+	)
+	if err := row.Scan(&rec.id, &rec.actorID, &rec.externalID, &rec.displayName, &rec.active, &roles, &rec.createdAt, &rec.updatedAt); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(roles, &rec.roles); err != nil {
+		return nil, err
+	}
+	return &rec, nil
+}
+
+func (s *IdentityService) getSCIMUserDB(ctx context.Context, id string) (*scimUserRecord, error) {
+	rec, err := scanSCIMUser(s.db.QueryRowContext(ctx, `SELECT `+scimUserColumns+` FROM identity_scim_users WHERE scim_id = $1`, id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return rec, err
+}
+
+// listSCIMUsersDB returns provisioned users oldest first, optionally those
+// whose userName or externalId (attr) equals value.
+func (s *IdentityService) listSCIMUsersDB(ctx context.Context, attr, value string) ([]*scimUserRecord, error) {
+	q := `SELECT ` + scimUserColumns + ` FROM identity_scim_users`
+	var args []any
+	switch attr {
+	case "userName":
+		q += ` WHERE actor_id = $1`
+		args = append(args, value)
+	case "externalId":
+		q += ` WHERE external_id = $1`
+		args = append(args, value)
+	}
+	q += ` ORDER BY created_at, scim_id`
+	rows, err := s.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []*scimUserRecord
+	for rows.Next() {
+		rec, err := scanSCIMUser(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, rec)
+	}
+	return out, rows.Err()
+}
+
+// saveSCIMUserDB writes a provisioned user and its operator credential in one
+// transaction, committing only when syncRoles succeeds. An empty hash keeps
+// the stored password; a new operator without one cannot log in with a
+// password. Deactivating a user disables all of the operator's credentials.
+func (s *IdentityService) saveSCIMUserDB(ctx context.Context, rec *scimUserRecord, hash string, create bool, syncRoles func() error) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+	roles, _ := json.Marshal(rec.roles)
+	if rec.roles == nil {
+		roles = []byte(`[]`)
+	}
+	if create {
+		const insert = `
+INSERT INTO identity_scim_users (` + scimUserColumns + `)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+`
+		if _, err := tx.ExecContext(ctx, insert, rec.id, rec.actorID, rec.externalID, rec.displayName, rec.active, roles, rec.createdAt, rec.updatedAt); err != nil {
+			return err
+		}
+	} else {
+		const update = `
+UPDATE identity_scim_users
+SET external_id = $2, display_name = $3, active = $4, roles = $5, updated_at = $6
+WHERE scim_id = $1
+`
+		if _, err := tx.ExecContext(ctx, update, rec.id, rec.externalID, rec.displayName, rec.active, roles, rec.updatedAt); err != nil {
+			return err
+		}
+	}
+	status := "disabled"
+	if rec.active {
+		status = "active"
+	}
+	const upsert = `
+INSERT INTO identity_credentials (actor_id, actor_type, credential_type, credential_id, password_hash, status, updated_at, password_changed_at)
+VALUES ($1, $2, 'password', '', $3, $4, NOW(), $5)
+ON CONFLICT (actor_id, actor_type, credential_type, credential_id) DO UPDATE
+SET password_hash = CASE WHEN $3 = '' THEN identity_credentials.password_hash ELSE EXCLUDED.password_hash END,
+    password_changed_at = CASE WHEN $3 = '' THEN identity_credentials.password_changed_at ELSE EXCLUDED.password_changed_at END,
+    status = EXCLUDED.status,
+    updated_at = NOW()
+`
+	actorType := rgsv1.ActorType_ACTOR_TYPE_OPERATOR.String()
+	if _, err := tx.ExecContext(ctx, upsert, rec.actorID, actorType, hash, status, rec.updatedAt); err != nil {
+		return err
+	}
+	const setStatus = `
+UPDATE identity_credentials
+SET status = $3, updated_at = NOW()
+WHERE actor_id = $1 AND actor_type = $2
+`
+	if _, err := tx.ExecContext(ctx, setStatus, rec.actorID, actorType, status); err != nil {
+		return err
+	}
+	if err := syncRoles(); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// deleteSCIMUserDB removes a provisioned user and disables the operator's
+// credentials, committing only when syncRoles succeeds.
+func (s *IdentityService) deleteSCIMUserDB(ctx context.Context, rec *scimUserRecord, syncRoles func() error) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+	if _, err := tx.ExecContext(ctx, `DELETE FROM identity_scim_users WHERE scim_id = $1`, rec.id); err != nil {
+		return err
+	}
+	const disable = `
+UPDATE identity_credentials
+SET status = 'disabled', updated_at = NOW()
+WHERE actor_id = $1 AND actor_type = $2
+`
+	if _, err := tx.ExecContext(ctx, disable, rec.actorID, rgsv1.ActorType_ACTOR_TYPE_OPERATOR.String()); err != nil {
+		return err
+	}
+	if err := syncRoles(); err != nil {
+		return err
+	}
+	return tx.Commit()
 }
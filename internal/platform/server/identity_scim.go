@@ -0,0 +1,518 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	scimUserSchema  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	scimListSchema  = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	scimPatchSchema = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	scimErrorSchema = "urn:ietf:params:scim:api:messages:2.0:Error"
+
+	// scimUsersPath is where SCIMHandler must be mounted.
+	scimUsersPath = "/scim/v2/Users"
+)
+
+// scimActor is recorded as the actor of every provisioning change.
+var scimActor = &rgsv1.Actor{ActorId: "scim", ActorType: rgsv1.ActorType_ACTOR_TYPE_SERVICE}
+
+// scimUserRecord is a provisioned operator. The SCIM userName is the
+// operator id and cannot change once created.
+type scimUserRecord struct {
+	id          string
+	actorID     string
+	externalID  string
+	displayName string
+	active      bool
+	roles       []string
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+type scimRole struct {
+	Value string `json:"value"`
+}
+
+type scimMeta struct {
+	ResourceType string `json:"resourceType"`
+	Created      string `json:"created"`
+	LastModified string `json:"lastModified"`
+	Location     string `json:"location"`
+}
+
+type scimUser struct {
+	Schemas     []string   `json:"schemas"`
+	ID          string     `json:"id,omitempty"`
+	ExternalID  string     `json:"externalId,omitempty"`
+	UserName    string     `json:"userName"`
+	DisplayName string     `json:"displayName,omitempty"`
+	Active      *bool      `json:"active,omitempty"`
+	Password    string     `json:"password,omitempty"`
+	Roles       []scimRole `json:"roles,omitempty"`
+	Meta        *scimMeta  `json:"meta,omitempty"`
+}
+
+type scimPatchRequest struct {
+	Schemas    []string `json:"schemas"`
+	Operations []struct {
+		Op    string          `json:"op"`
+		Path  string          `json:"path"`
+		Value json.RawMessage `json:"value"`
+	} `json:"Operations"`
+}
+
+// scimError is a SCIM error response (RFC 7644 section 3.12).
+type scimError struct {
+	status   int
+	scimType string
+	detail   string
+}
+
+func (e *scimError) Error() string { return e.detail }
+
+func (r *scimUserRecord) resource() scimUser {
+	active := r.active
+	roles := make([]scimRole, 0, len(r.roles))
+	for _, name := range r.roles {
+		roles = append(roles, scimRole{Value: name})
+	}
+	return scimUser{
+		Schemas:     []string{scimUserSchema},
+		ID:          r.id,
+		ExternalID:  r.externalID,
+		UserName:    r.actorID,
+		DisplayName: r.displayName,
+		Active:      &active,
+		Roles:       roles,
+		Meta: &scimMeta{
+			ResourceType: "User",
+			Created:      r.createdAt.UTC().Format(time.RFC3339Nano),
+			LastModified: r.updatedAt.UTC().Format(time.RFC3339Nano),
+			Location:     scimUsersPath + "/" + r.id,
+		},
+	}
+}
+
+func (r *scimUserRecord) snapshot() []byte {
+	b, _ := json.Marshal(map[string]any{
+		"user_name":    r.actorID,
+		"external_id":  r.externalID,
+		"display_name": r.displayName,
+		"active":       r.active,
+		"roles":        r.roles,
+	})
+	return b
+}
+
+func scimRoleNames(roles []scimRole) []string {
+	seen := make(map[string]bool, len(roles))
+	out := make([]string, 0, len(roles))
+	for _, r := range roles {
+		if r.Value != "" && !seen[r.Value] {
+			seen[r.Value] = true
+			out = append(out, r.Value)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// scimBool accepts true/false and the "True"/"False" strings some identity
+// providers send in PATCH values.
+func scimBool(raw json.RawMessage) (bool, bool) {
+	var b bool
+	if json.Unmarshal(raw, &b) == nil {
+		return b, true
+	}
+	var s string
+	if json.Unmarshal(raw, &s) == nil {
+		if v, err := strconv.ParseBool(s); err == nil {
+			return v, true
+		}
+	}
+	return false, false
+}
+
+func newSCIMID() (string, error) {
+	raw := make([]byte, 16)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(raw), nil
+}
+
+var scimFilterPattern = regexp.MustCompile(`^\s*(userName|externalId)\s+eq\s+"([^"]*)"\s*$`)
+
+// SCIMHandler serves the SCIM 2.0 Users endpoint (RFC 7644) at
+// /scim/v2/Users so an identity provider can provision and deprovision
+// operator accounts. Each user is an operator whose id is the SCIM userName;
+// its roles replace the operator's role assignments through roles, which
+// may be nil to ignore them. Requests must carry bearerToken. Provisioning
+// needs the database and every change is audited under the "scim" service
+// actor.
+func (s *IdentityService) SCIMHandler(bearerToken string, roles externalRoleSyncer) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+scimUsersPath, func(w http.ResponseWriter, r *http.Request) {
+		s.scimListUsers(w, r)
+	})
+	mux.HandleFunc("POST "+scimUsersPath, func(w http.ResponseWriter, r *http.Request) {
+		var in scimUser
+		if err := json.NewDecoder(r.Body).Decode(&in); err != nil {
+			writeSCIMError(w, &scimError{http.StatusBadRequest, "invalidSyntax", "malformed user"})
+			return
+		}
+		rec, err := s.scimCreateUser(r.Context(), in, roles)
+		if err != nil {
+			writeSCIMError(w, err)
+			return
+		}
+		writeSCIM(w, http.StatusCreated, rec.resource())
+	})
+	mux.HandleFunc("GET "+scimUsersPath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		rec, err := s.scimUserLocked(r.Context(), r.PathValue("id"))
+		s.mu.Unlock()
+		if err != nil {
+			writeSCIMError(w, err)
+			return
+		}
+		writeSCIM(w, http.StatusOK, rec.resource())
+	})
+	mux.HandleFunc("PUT "+scimUsersPath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		var in scimUser
+		if err := json.NewDecoder(r.Body).Decode(&in); err != nil {
+			writeSCIMError(w, &scimError{http.StatusBadRequest, "invalidSyntax", "malformed user"})
+			return
+		}
+		rec, err := s.scimUpdateUser(r.Context(), r.PathValue("id"), roles, func(rec *scimUserRecord) (string, *scimError) {
+			if in.UserName != rec.actorID {
+				return "", &scimError{http.StatusBadRequest, "mutability", "userName cannot change"}
+			}
+			rec.externalID = in.ExternalID
+			rec.displayName = in.DisplayName
+			rec.active = in.Active == nil || *in.Active
+			rec.roles = scimRoleNames(in.Roles)
+			return in.Password, nil
+		})
+		if err != nil {
+			writeSCIMError(w, err)
+			return
+		}
+		writeSCIM(w, http.StatusOK, rec.resource())
+	})
+	mux.HandleFunc("PATCH "+scimUsersPath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		var in scimPatchRequest
+		if err := json.NewDecoder(r.Body).Decode(&in); err != nil || len(in.Operations) == 0 {
+			writeSCIMError(w, &scimError{http.StatusBadRequest, "invalidSyntax", "malformed patch"})
+			return
+		}
+		rec, err := s.scimUpdateUser(r.Context(), r.PathValue("id"), roles, func(rec *scimUserRecord) (string, *scimError) {
+			password := ""
+			for _, op := range in.Operations {
+				attrs := map[string]json.RawMessage{op.Path: op.Value}
+				if op.Path == "" {
+					attrs = nil
+					if err := json.Unmarshal(op.Value, &attrs); err != nil {
+						return "", &scimError{http.StatusBadRequest, "invalidValue", "patch value must be an object when path is omitted"}
+					}
+				}
+				remove := strings.EqualFold(op.Op, "remove")
+				if !remove && !strings.EqualFold(op.Op, "add") && !strings.EqualFold(op.Op, "replace") {
+					return "", &scimError{http.StatusBadRequest, "invalidSyntax", "unsupported patch op"}
+				}
+				for path, value := range attrs {
+					switch {
+					case strings.EqualFold(path, "active") && !remove:
+						active, ok := scimBool(value)
+						if !ok {
+							return "", &scimError{http.StatusBadRequest, "invalidValue", "active must be a boolean"}
+						}
+						rec.active = active
+					case strings.EqualFold(path, "displayName"):
+						rec.displayName = ""
+						if !remove && json.Unmarshal(value, &rec.displayName) != nil {
+							return "", &scimError{http.StatusBadRequest, "invalidValue", "displayName must be a string"}
+						}
+					case strings.EqualFold(path, "externalId"):
+						rec.externalID = ""
+						if !remove && json.Unmarshal(value, &rec.externalID) != nil {
+							return "", &scimError{http.StatusBadRequest, "invalidValue", "externalId must be a string"}
+						}
+					case strings.EqualFold(path, "password") && !remove:
+						if json.Unmarshal(value, &password) != nil {
+							return "", &scimError{http.StatusBadRequest, "invalidValue", "password must be a string"}
+						}
+					case strings.EqualFold(path, "roles"):
+						var add []scimRole
+						if !remove && json.Unmarshal(value, &add) != nil {
+							return "", &scimError{http.StatusBadRequest, "invalidValue", "roles must be a list"}
+						}
+						if strings.EqualFold(op.Op, "add") {
+							for _, name := range rec.roles {
+								add = append(add, scimRole{Value: name})
+							}
+						}
+						rec.roles = scimRoleNames(add)
+					case strings.EqualFold(path, "userName"):
+						return "", &scimError{http.StatusBadRequest, "mutability", "userName cannot change"}
+					default:
+						return "", &scimError{http.StatusBadRequest, "invalidPath", "unsupported attribute " + path}
+					}
+				}
+			}
+			return password, nil
+		})
+		if err != nil {
+			writeSCIMError(w, err)
+			return
+		}
+		writeSCIM(w, http.StatusOK, rec.resource())
+	})
+	mux.HandleFunc("DELETE "+scimUsersPath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if err := s.scimDeleteUser(r.Context(), r.PathValue("id"), roles); err != nil {
+			writeSCIMError(w, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	want := []byte("Bearer " + bearerToken)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if bearerToken == "" || subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), want) != 1 {
+			writeSCIMError(w, &scimError{status: http.StatusUnauthorized, detail: "invalid bearer token"})
+			return
+		}
+		if s.db == nil {
+			writeSCIMError(w, &scimError{status: http.StatusNotImplemented, detail: "provisioning requires database"})
+			return
+		}
+		mux.ServeHTTP(w, r)
+	})
+}
+
+func writeSCIM(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeSCIMError(w http.ResponseWriter, err error) {
+	var se *scimError
+	if !errors.As(err, &se) {
+		se = &scimError{status: http.StatusServiceUnavailable, detail: "persistence unavailable"}
+	}
+	body := map[string]any{
+		"schemas": []string{scimErrorSchema},
+		"status":  strconv.Itoa(se.status),
+		"detail":  se.detail,
+	}
+	if se.scimType != "" {
+		body["scimType"] = se.scimType
+	}
+	writeSCIM(w, se.status, body)
+}
+
+func (s *IdentityService) scimUserLocked(ctx context.Context, id string) (*scimUserRecord, error) {
+	rec, err := s.getSCIMUserDB(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if rec == nil {
+		return nil, &scimError{status: http.StatusNotFound, detail: "user not found"}
+	}
+	return rec, nil
+}
+
+func (s *IdentityService) scimListUsers(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	var attr, value string
+	if f := q.Get("filter"); f != "" {
+		m := scimFilterPattern.FindStringSubmatch(f)
+		if m == nil {
+			writeSCIMError(w, &scimError{http.StatusBadRequest, "invalidFilter", "only userName eq and externalId eq filters are supported"})
+			return
+		}
+		attr, value = m[1], m[2]
+	}
+	startIndex, count := 1, 100
+	if v := q.Get("startIndex"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			writeSCIMError(w, &scimError{http.StatusBadRequest, "invalidValue", "invalid startIndex"})
+			return
+		}
+		startIndex = max(n, 1)
+	}
+	if v := q.Get("count"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			writeSCIMError(w, &scimError{http.StatusBadRequest, "invalidValue", "invalid count"})
+			return
+		}
+		count = min(max(n, 0), maxAuditPageSize)
+	}
+
+	s.mu.Lock()
+	recs, err := s.listSCIMUsersDB(r.Context(), attr, value)
+	s.mu.Unlock()
+	if err != nil {
+		writeSCIMError(w, err)
+		return
+	}
+	page := make([]scimUser, 0, count)
+	for i := startIndex - 1; i < len(recs) && len(page) < count; i++ {
+		page = append(page, recs[i].resource())
+	}
+	writeSCIM(w, http.StatusOK, map[string]any{
+		"schemas":      []string{scimListSchema},
+		"totalResults": len(recs),
+		"startIndex":   startIndex,
+		"itemsPerPage": len(page),
+		"Resources":    page,
+	})
+}
+
+// scimPasswordHash validates and hashes a provisioned password. An empty
+// password keeps the stored one.
+func (s *IdentityService) scimPasswordHash(password string) (string, error) {
+	if password == "" {
+		return "", nil
+	}
+	if reason := s.passwordPolicy.check(password); reason != "" {
+		return "", &scimError{http.StatusBadRequest, "invalidValue", reason}
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// scimSyncRoles replaces the operator's role assignments. Unknown roles are
+// reported to the identity provider as invalid values.
+func scimSyncRoles(ctx context.Context, roles externalRoleSyncer, rec *scimUserRecord) error {
+	if roles == nil {
+		return nil
+	}
+	err := roles.SyncExternalRoles(ctx, &rgsv1.Actor{ActorId: rec.actorID, ActorType: rgsv1.ActorType_ACTOR_TYPE_OPERATOR}, rec.roles, "scim")
+	if err != nil && strings.Contains(err.Error(), "not found") {
+		return &scimError{http.StatusBadRequest, "invalidValue", err.Error()}
+	}
+	return err
+}
+
+func (s *IdentityService) scimCreateUser(ctx context.Context, in scimUser, roles externalRoleSyncer) (*scimUserRecord, error) {
+	if strings.TrimSpace(in.UserName) == "" || in.UserName != strings.TrimSpace(in.UserName) {
+		return nil, &scimError{http.StatusBadRequest, "invalidValue", "userName is required"}
+	}
+	hash, err := s.scimPasswordHash(in.Password)
+	if err != nil {
+		return nil, err
+	}
+	id, err := newSCIMID()
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	rec := &scimUserRecord{
+		id:          id,
+		actorID:     in.UserName,
+		externalID:  in.ExternalID,
+		displayName: in.DisplayName,
+		active:      in.Active == nil || *in.Active,
+		roles:       scimRoleNames(in.Roles),
+		createdAt:   now,
+		updatedAt:   now,
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.saveSCIMUserDB(ctx, rec, hash, true, func() error { return scimSyncRoles(ctx, roles, rec) }); err != nil {
+		if isUniqueViolation(err) {
+			return nil, &scimError{http.StatusConflict, "uniqueness", "userName already provisioned"}
+		}
+		return nil, err
+	}
+	meta := &rgsv1.RequestMeta{Actor: scimActor}
+	if err := s.appendAudit(meta, rec.actorID, "identity_scim_create_user", []byte(`{}`), rec.snapshot(), audit.ResultSuccess, "scim provisioning"); err != nil {
+		return nil, &scimError{status: http.StatusServiceUnavailable, detail: "audit unavailable"}
+	}
+	return rec, nil
+}
+
+// scimUpdateUser applies mutate to the stored user and persists the result.
+// mutate returns a new password to set, or "" to keep the current one.
+func (s *IdentityService) scimUpdateUser(ctx context.Context, id string, roles externalRoleSyncer, mutate func(*scimUserRecord) (string, *scimError)) (*scimUserRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec, err := s.scimUserLocked(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	before := rec.snapshot()
+	wasActive := rec.active
+	password, serr := mutate(rec)
+	if serr != nil {
+		return nil, serr
+	}
+	hash, err := s.scimPasswordHash(password)
+	if err != nil {
+		return nil, err
+	}
+	rec.updatedAt = s.now()
+	if err := s.saveSCIMUserDB(ctx, rec, hash, false, func() error { return scimSyncRoles(ctx, roles, rec) }); err != nil {
+		return nil, err
+	}
+	if wasActive && !rec.active {
+		if _, err := s.revokeActorSessionsDB(ctx, rec.actorID, rgsv1.ActorType_ACTOR_TYPE_OPERATOR); err != nil {
+			return nil, err
+		}
+	}
+	meta := &rgsv1.RequestMeta{Actor: scimActor}
+	if err := s.appendAudit(meta, rec.actorID, "identity_scim_update_user", before, rec.snapshot(), audit.ResultSuccess, "scim provisioning"); err != nil {
+		return nil, &scimError{status: http.StatusServiceUnavailable, detail: "audit unavailable"}
+	}
+	return rec, nil
+}
+
+// scimDeleteUser deprovisions an operator: its credentials are disabled,
+// its sessions revoked, and its role assignments removed. The credential
+// rows are kept so the operator id stays attributable in the audit trail.
+func (s *IdentityService) scimDeleteUser(ctx context.Context, id string, roles externalRoleSyncer) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec, err := s.scimUserLocked(ctx, id)
+	if err != nil {
+		return err
+	}
+	before := rec.snapshot()
+	rec.active = false
+	rec.roles = nil
+	if err := s.deleteSCIMUserDB(ctx, rec, func() error { return scimSyncRoles(ctx, roles, rec) }); err != nil {
+		return err
+	}
+	if _, err := s.revokeActorSessionsDB(ctx, rec.actorID, rgsv1.ActorType_ACTOR_TYPE_OPERATOR); err != nil {
+		return err
+	}
+	meta := &rgsv1.RequestMeta{Actor: scimActor}
+	if err := s.appendAudit(meta, rec.actorID, "identity_scim_delete_user", before, []byte(`{}`), audit.ResultSuccess, "scim deprovisioning"); err != nil {
+		return &scimError{status: http.StatusServiceUnavailable, detail: "audit unavailable"}
+	}
+	return nil
+}
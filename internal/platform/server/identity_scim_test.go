@@ -0,0 +1,61 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSCIMHandlerRequiresBearerTokenAndDatabase(t *testing.T) {
+	svc := NewIdentityService(ledgerFixedClock{now: time.Date(2026, 3, 9, 9, 0, 0, 0, time.UTC)}, "test-secret", 15*time.Minute, time.Hour)
+	h := svc.SCIMHandler("scim-secret", nil)
+	get := func(authz string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/scim/v2/Users", nil)
+		if authz != "" {
+			req.Header.Set("Authorization", authz)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+
+	for _, authz := range []string{"", "Bearer wrong", "scim-secret"} {
+		rec := get(authz)
+		if rec.Code != http.StatusUnauthorized {
+			t.Fatalf("authorization %q: expected 401, got=%d", authz, rec.Code)
+		}
+		var body map[string]any
+		if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil || body["status"] != "401" || !strings.Contains(rec.Header().Get("Content-Type"), "scim+json") {
+			t.Fatalf("expected scim error body, got=%s err=%v", rec.Body.String(), err)
+		}
+	}
+	if rec := get("Bearer scim-secret"); rec.Code != http.StatusNotImplemented {
+		t.Fatalf("expected provisioning without database refused, got=%d", rec.Code)
+	}
+
+	rec := httptest.NewRecorder()
+	svc.SCIMHandler("", nil).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/scim/v2/Users", nil))
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected empty configured token to refuse every request, got=%d", rec.Code)
+	}
+}
+
+func TestSCIMFilterAndBooleanParsing(t *testing.T) {
+	if m := scimFilterPattern.FindStringSubmatch(`userName eq "op-7"`); m == nil || m[1] != "userName" || m[2] != "op-7" {
+		t.Fatalf("expected userName filter parsed, got=%v", m)
+	}
+	if m := scimFilterPattern.FindStringSubmatch(`displayName co "ops"`); m != nil {
+		t.Fatalf("expected unsupported filter rejected, got=%v", m)
+	}
+	for raw, want := range map[string]bool{`true`: true, `"False"`: false, `"True"`: true} {
+		if got, ok := scimBool(json.RawMessage(raw)); !ok || got != want {
+			t.Fatalf("scimBool(%s): got=%v ok=%v", raw, got, ok)
+		}
+	}
+	if _, ok := scimBool(json.RawMessage(`"maybe"`)); ok {
+		t.Fatalf("expected non-boolean rejected")
+	}
+}
@@ -0,0 +1,103 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+// SessionLimitPolicy decides what happens when a login would exceed an
+// actor type's concurrent session limit.
+type SessionLimitPolicy int
+
+const (
+	// SessionLimitDeny refuses the new login.
+	SessionLimitDeny SessionLimitPolicy = iota
+	// SessionLimitRevokeOldest admits the new login and revokes the
+	// actor's oldest sessions to make room.
+	SessionLimitRevokeOldest
+)
+
+// SetSessionLimits caps the active refresh sessions each actor may hold,
+// keyed by actor type. Actor types without a positive limit are unlimited.
+func (s *IdentityService) SetSessionLimits(limits map[rgsv1.ActorType]int, policy SessionLimitPolicy) {
+	if s == nil {
+		return
+	}
+	copied := make(map[rgsv1.ActorType]int, len(limits))
+	for actorType, max := range limits {
+		if max > 0 {
+			copied[actorType] = max
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sessionLimits = copied
+	s.sessionLimitPolicy = policy
+}
+
+// activeActorSessionsLocked returns an actor's unrevoked, unexpired
+// sessions, oldest first.
+func (s *IdentityService) activeActorSessionsLocked(ctx context.Context, actorID string, actorType rgsv1.ActorType) ([]*identitySession, error) {
+	if s.db != nil {
+		return s.listActiveSessionsDB(ctx, &rgsv1.Actor{ActorId: actorID, ActorType: actorType})
+	}
+	now := s.now()
+	var out []*identitySession
+	for _, sess := range s.refreshSessions {
+		if sess.actorID == actorID && sess.actorType == actorType && sess.active(now) {
+			out = append(out, sess)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if !out[i].createdAt.Equal(out[j].createdAt) {
+			return out[i].createdAt.Before(out[j].createdAt)
+		}
+		return identitySessionID(out[i].refreshToken) < identitySessionID(out[j].refreshToken)
+	})
+	return out, nil
+}
+
+// enforceSessionLimitLocked makes room for one more session for the actor
+// under the configured policy. It returns nil when the login may proceed,
+// otherwise the response meta to return; denials are audited under action.
+func (s *IdentityService) enforceSessionLimitLocked(ctx context.Context, meta *rgsv1.RequestMeta, actorID string, actorType rgsv1.ActorType, action string) *rgsv1.ResponseMeta {
+	max := s.sessionLimits[actorType]
+	if max <= 0 {
+		return nil
+	}
+	sessions, err := s.activeActorSessionsLocked(ctx, actorID, actorType)
+	if err != nil {
+		return s.responseMeta(meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")
+	}
+	excess := len(sessions) - max + 1
+	if excess <= 0 {
+		return nil
+	}
+	if s.sessionLimitPolicy != SessionLimitRevokeOldest {
+		const reason = "concurrent session limit reached"
+		s.auditDenied(meta, actorID, action, reason)
+		return s.responseMeta(meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)
+	}
+	for _, sess := range sessions[:excess] {
+		before, _ := json.Marshal(sess.toProto())
+		if s.db != nil {
+			if err := s.revokeSession(ctx, sess.refreshToken); err != nil {
+				return s.responseMeta(meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")
+			}
+		} else {
+			delete(s.refreshSessions, sess.refreshToken)
+		}
+		after, _ := json.Marshal(map[string]any{"session": sess.toProto(), "revoked": true, "limit": max})
+		if err := s.appendAudit(meta, identitySessionID(sess.refreshToken), "identity_session_limit_revoke", before, after, audit.ResultSuccess, "concurrent session limit reached"); err != nil {
+			if s.db == nil {
+				s.refreshSessions[sess.refreshToken] = sess
+			}
+			return s.responseMeta(meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func TestIdentitySessionLimitPolicies(t *testing.T) {
+	start := time.Date(2026, 3, 6, 8, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+	operatorLogin := func(svc *IdentityService, offset time.Duration) *rgsv1.LoginResponse {
+		svc.Clock = ledgerFixedClock{now: start.Add(offset)}
+		resp, _ := svc.Login(ctx, &rgsv1.LoginRequest{
+			Meta:        meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+			Credentials: &rgsv1.LoginRequest_Operator{Operator: &rgsv1.OperatorCredentials{OperatorId: "op-1", Password: "operator-pass"}},
+		})
+		return resp
+	}
+	refresh := func(svc *IdentityService, token string) rgsv1.ResultCode {
+		resp, _ := svc.RefreshToken(ctx, &rgsv1.RefreshTokenRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), RefreshToken: token})
+		return resp.Meta.GetResultCode()
+	}
+	limits := map[rgsv1.ActorType]int{rgsv1.ActorType_ACTOR_TYPE_OPERATOR: 2}
+
+	deny := NewIdentityService(ledgerFixedClock{now: start}, "test-secret", 15*time.Minute, time.Hour)
+	deny.SetSessionLimits(limits, SessionLimitDeny)
+	operatorLogin(deny, 0)
+	operatorLogin(deny, time.Minute)
+	third := operatorLogin(deny, 2*time.Minute)
+	if third.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED || third.Meta.GetDenialReason() != "concurrent session limit reached" {
+		t.Fatalf("expected login over limit denied, got=%+v", third.Meta)
+	}
+	player, _ := deny.Login(ctx, &rgsv1.LoginRequest{
+		Meta:        meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""),
+		Credentials: &rgsv1.LoginRequest_Player{Player: &rgsv1.PlayerCredentials{PlayerId: "player-1", Pin: "1234"}},
+	})
+	if player.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected unlimited actor type unaffected, got=%+v", player.Meta)
+	}
+
+	evict := NewIdentityService(ledgerFixedClock{now: start}, "test-secret", 15*time.Minute, time.Hour)
+	evict.SetSessionLimits(limits, SessionLimitRevokeOldest)
+	oldest := operatorLogin(evict, 0)
+	middle := operatorLogin(evict, time.Minute)
+	newest := operatorLogin(evict, 2*time.Minute)
+	if newest.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected login over limit admitted, got=%+v", newest.Meta)
+	}
+	if code := refresh(evict, oldest.Token.GetRefreshToken()); code != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected oldest session revoked, got=%v", code)
+	}
+	if code := refresh(evict, middle.Token.GetRefreshToken()); code != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected newer session kept, got=%v", code)
+	}
+	var audited bool
+	for _, ev := range auditEvents(evict.AuditStore) {
+		if ev.Action == "identity_session_limit_revoke" && ev.ObjectID == identitySessionID(oldest.Token.GetRefreshToken()) {
+			audited = true
+		}
+	}
+	if !audited {
+		t.Fatalf("expected eviction audited against the revoked session")
+	}
+}
@@ -0,0 +1,184 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+// identitySessionID is the public handle for a refresh session. Admin RPCs
+// and their audit events use it so refresh tokens never leave the service.
+func identitySessionID(refreshToken string) string {
+	sum := sha256.Sum256([]byte(refreshToken))
+	return hex.EncodeToString(sum[:])
+}
+
+func (sess *identitySession) active(now time.Time) bool {
+	return !sess.revoked && sess.expiresAt.After(now)
+}
+
+func (sess *identitySession) toProto() *rgsv1.IdentitySession {
+	out := &rgsv1.IdentitySession{
+		SessionId: identitySessionID(sess.refreshToken),
+		Actor:     &rgsv1.Actor{ActorId: sess.actorID, ActorType: sess.actorType},
+		FamilyId:  identitySessionID(sess.familyID),
+		ExpiresAt: sess.expiresAt.UTC().Format(time.RFC3339Nano),
+	}
+	if !sess.createdAt.IsZero() {
+		out.CreatedAt = sess.createdAt.UTC().Format(time.RFC3339Nano)
+	}
+	return out
+}
+
+func (s *IdentityService) ListSessions(ctx context.Context, req *rgsv1.ListSessionsRequest) (*rgsv1.ListSessionsResponse, error) {
+	if req == nil {
+		req = &rgsv1.ListSessionsRequest{}
+	}
+	if req.Actor != nil && (req.Actor.ActorId == "" || req.Actor.ActorType == rgsv1.ActorType_ACTOR_TYPE_UNSPECIFIED) {
+		return &rgsv1.ListSessionsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "actor filter requires actor_id and actor_type")}, nil
+	}
+	objectID := ""
+	if req.Actor != nil {
+		objectID = req.Actor.ActorId
+	}
+	if ok, reason := s.authorizeIdentityAdmin(ctx, req.Meta); !ok {
+		s.auditDenied(req.Meta, objectID, "identity_list_sessions", reason)
+		return &rgsv1.ListSessionsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var sessions []*identitySession
+	if s.db != nil {
+		var err error
+		sessions, err = s.listActiveSessionsDB(ctx, req.Actor)
+		if err != nil {
+			return &rgsv1.ListSessionsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+	} else {
+		now := s.now()
+		for _, sess := range s.refreshSessions {
+			if !sess.active(now) {
+				continue
+			}
+			if req.Actor != nil && (sess.actorID != req.Actor.ActorId || sess.actorType != req.Actor.ActorType) {
+				continue
+			}
+			sessions = append(sessions, sess)
+		}
+		sort.Slice(sessions, func(i, j int) bool {
+			if !sessions[i].createdAt.Equal(sessions[j].createdAt) {
+				return sessions[i].createdAt.Before(sessions[j].createdAt)
+			}
+			return identitySessionID(sessions[i].refreshToken) < identitySessionID(sessions[j].refreshToken)
+		})
+	}
+	out := make([]*rgsv1.IdentitySession, 0, len(sessions))
+	for _, sess := range sessions {
+		out = append(out, sess.toProto())
+	}
+	after, _ := json.Marshal(map[string]any{"actor": req.Actor, "sessions": len(out)})
+	if err := s.appendAudit(req.Meta, objectID, "identity_list_sessions", []byte(`{}`), after, audit.ResultSuccess, ""); err != nil {
+		return &rgsv1.ListSessionsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	return &rgsv1.ListSessionsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Sessions: out}, nil
+}
+
+func (s *IdentityService) RevokeSession(ctx context.Context, req *rgsv1.RevokeSessionRequest) (*rgsv1.RevokeSessionResponse, error) {
+	if req == nil || req.SessionId == "" {
+		return &rgsv1.RevokeSessionResponse{Meta: s.responseMeta(nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "session_id is required")}, nil
+	}
+	if ok, reason := s.authorizeIdentityAdmin(ctx, req.Meta); !ok {
+		s.auditDenied(req.Meta, req.SessionId, "identity_revoke_session", reason)
+		return &rgsv1.RevokeSessionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var sess *identitySession
+	if s.db != nil {
+		var err error
+		sess, err = s.getSessionByID(ctx, req.SessionId)
+		if err != nil {
+			return &rgsv1.RevokeSessionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+	} else {
+		for token, candidate := range s.refreshSessions {
+			if identitySessionID(token) == req.SessionId {
+				sess = candidate
+				break
+			}
+		}
+	}
+	if sess == nil || !sess.active(s.now()) {
+		return &rgsv1.RevokeSessionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "session not found")}, nil
+	}
+
+	before, _ := json.Marshal(sess.toProto())
+	if s.db != nil {
+		if err := s.revokeSession(ctx, sess.refreshToken); err != nil {
+			return &rgsv1.RevokeSessionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+	} else {
+		delete(s.refreshSessions, sess.refreshToken)
+	}
+	out := sess.toProto()
+	after, _ := json.Marshal(map[string]any{"session": out, "revoked": true})
+	if err := s.appendAudit(req.Meta, req.SessionId, "identity_revoke_session", before, after, audit.ResultSuccess, req.Reason); err != nil {
+		if s.db == nil {
+			s.refreshSessions[sess.refreshToken] = sess
+		}
+		return &rgsv1.RevokeSessionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	return &rgsv1.RevokeSessionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Session: out}, nil
+}
+
+func (s *IdentityService) RevokeAllSessionsForActor(ctx context.Context, req *rgsv1.RevokeAllSessionsForActorRequest) (*rgsv1.RevokeAllSessionsForActorResponse, error) {
+	if req == nil || req.Actor == nil || req.Actor.ActorId == "" || req.Actor.ActorType == rgsv1.ActorType_ACTOR_TYPE_UNSPECIFIED {
+		return &rgsv1.RevokeAllSessionsForActorResponse{Meta: s.responseMeta(nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "actor is required")}, nil
+	}
+	if ok, reason := s.authorizeIdentityAdmin(ctx, req.Meta); !ok {
+		s.auditDenied(req.Meta, req.Actor.ActorId, "identity_revoke_all_sessions", reason)
+		return &rgsv1.RevokeAllSessionsForActorResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var revoked int64
+	var removed []*identitySession
+	if s.db != nil {
+		n, err := s.revokeActorSessionsDB(ctx, req.Actor.ActorId, req.Actor.ActorType)
+		if err != nil {
+			return &rgsv1.RevokeAllSessionsForActorResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+		revoked = n
+	} else {
+		now := s.now()
+		for token, sess := range s.refreshSessions {
+			if sess.actorID != req.Actor.ActorId || sess.actorType != req.Actor.ActorType || !sess.active(now) {
+				continue
+			}
+			delete(s.refreshSessions, token)
+			removed = append(removed, sess)
+		}
+		revoked = int64(len(removed))
+	}
+	after, _ := json.Marshal(map[string]any{
+		"actor_id":         req.Actor.ActorId,
+		"actor_type":       req.Actor.ActorType.String(),
+		"revoked_sessions": revoked,
+	})
+	if err := s.appendAudit(req.Meta, req.Actor.ActorId, "identity_revoke_all_sessions", []byte(`{}`), after, audit.ResultSuccess, req.Reason); err != nil {
+		for _, sess := range removed {
+			s.refreshSessions[sess.refreshToken] = sess
+		}
+		return &rgsv1.RevokeAllSessionsForActorResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	return &rgsv1.RevokeAllSessionsForActorResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), RevokedCount: int32(revoked)}, nil
+}
@@ -0,0 +1,108 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+func TestIdentitySessionAdminListAndRevoke(t *testing.T) {
+	svc := NewIdentityService(ledgerFixedClock{now: time.Date(2026, 3, 5, 9, 0, 0, 0, time.UTC)}, "test-secret", 15*time.Minute, time.Hour)
+	ctx := context.Background()
+	login := func(playerID string) *rgsv1.SessionToken {
+		resp, _ := svc.Login(ctx, &rgsv1.LoginRequest{
+			Meta:        meta(playerID, rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""),
+			Credentials: &rgsv1.LoginRequest_Player{Player: &rgsv1.PlayerCredentials{PlayerId: playerID, Pin: "1234"}},
+		})
+		return resp.Token
+	}
+	p1a, p1b, p2 := login("player-1"), login("player-1"), login("player-2")
+	admin := meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+	player1 := &rgsv1.Actor{ActorId: "player-1", ActorType: rgsv1.ActorType_ACTOR_TYPE_PLAYER}
+
+	denied, _ := svc.ListSessions(ctx, &rgsv1.ListSessionsRequest{Meta: meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "")})
+	if denied.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected player denied, got=%+v", denied.Meta)
+	}
+
+	all, _ := svc.ListSessions(ctx, &rgsv1.ListSessionsRequest{Meta: admin})
+	if all.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || len(all.Sessions) != 3 {
+		t.Fatalf("expected three sessions, got=%+v", all)
+	}
+	filtered, _ := svc.ListSessions(ctx, &rgsv1.ListSessionsRequest{Meta: admin, Actor: player1})
+	if len(filtered.Sessions) != 2 {
+		t.Fatalf("expected two player-1 sessions, got=%d", len(filtered.Sessions))
+	}
+	for _, sess := range all.Sessions {
+		if strings.Contains(sess.String(), p1a.RefreshToken) || strings.Contains(sess.String(), p2.RefreshToken) {
+			t.Fatalf("session listing exposed a refresh token: %v", sess)
+		}
+	}
+
+	revoked, _ := svc.RevokeSession(ctx, &rgsv1.RevokeSessionRequest{Meta: admin, SessionId: identitySessionID(p1a.RefreshToken), Reason: "lost device"})
+	if revoked.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || revoked.Session.GetActor().GetActorId() != "player-1" {
+		t.Fatalf("expected session revoked, got=%+v", revoked)
+	}
+	again, _ := svc.RevokeSession(ctx, &rgsv1.RevokeSessionRequest{Meta: admin, SessionId: identitySessionID(p1a.RefreshToken)})
+	if again.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected revoked session not found, got=%+v", again.Meta)
+	}
+	refresh, _ := svc.RefreshToken(ctx, &rgsv1.RefreshTokenRequest{Meta: meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""), RefreshToken: p1a.RefreshToken})
+	if refresh.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected revoked token refused, got=%+v", refresh.Meta)
+	}
+
+	all2, _ := svc.RevokeAllSessionsForActor(ctx, &rgsv1.RevokeAllSessionsForActorRequest{Meta: admin, Actor: player1, Reason: "account compromise"})
+	if all2.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || all2.RevokedCount != 1 {
+		t.Fatalf("expected one remaining session revoked, got=%+v", all2)
+	}
+	refresh, _ = svc.RefreshToken(ctx, &rgsv1.RefreshTokenRequest{Meta: meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""), RefreshToken: p1b.RefreshToken})
+	if refresh.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected actor sessions refused, got=%+v", refresh.Meta)
+	}
+	left, _ := svc.ListSessions(ctx, &rgsv1.ListSessionsRequest{Meta: admin})
+	if len(left.Sessions) != 1 || left.Sessions[0].GetSessionId() != identitySessionID(p2.RefreshToken) {
+		t.Fatalf("expected only player-2 session left, got=%+v", left.Sessions)
+	}
+
+	actions := map[string]audit.Result{}
+	for _, ev := range auditEvents(svc.AuditStore) {
+		if strings.HasPrefix(ev.Action, "identity_") && strings.Contains(ev.Action, "session") {
+			if _, seen := actions[ev.Action]; !seen || ev.Result == audit.ResultSuccess {
+				actions[ev.Action] = ev.Result
+			}
+		}
+	}
+	for _, action := range []string{"identity_list_sessions", "identity_revoke_session", "identity_revoke_all_sessions"} {
+		if actions[action] != audit.ResultSuccess {
+			t.Fatalf("expected %s audited, got=%v", action, actions)
+		}
+	}
+}
+
+func TestIdentitySessionAdminValidation(t *testing.T) {
+	svc := NewIdentityService(ledgerFixedClock{now: time.Date(2026, 3, 5, 9, 0, 0, 0, time.UTC)}, "test-secret", 15*time.Minute, time.Hour)
+	ctx := context.Background()
+	admin := meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+
+	if resp, _ := svc.RevokeSession(ctx, &rgsv1.RevokeSessionRequest{Meta: admin}); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected missing session_id invalid, got=%+v", resp.Meta)
+	}
+	if resp, _ := svc.RevokeAllSessionsForActor(ctx, &rgsv1.RevokeAllSessionsForActorRequest{Meta: admin}); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected missing actor invalid, got=%+v", resp.Meta)
+	}
+	if resp, _ := svc.ListSessions(ctx, &rgsv1.ListSessionsRequest{Meta: admin, Actor: &rgsv1.Actor{ActorId: "player-1"}}); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected partial actor filter invalid, got=%+v", resp.Meta)
+	}
+	resp, _ := svc.RevokeAllSessionsForActor(ctx, &rgsv1.RevokeAllSessionsForActorRequest{
+		Meta:  meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""),
+		Actor: &rgsv1.Actor{ActorId: "player-2", ActorType: rgsv1.ActorType_ACTOR_TYPE_PLAYER},
+	})
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected player denied, got=%+v", resp.Meta)
+	}
+}
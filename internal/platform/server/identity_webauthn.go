@@ -0,0 +1,612 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"slices"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	platformauth "github.com/wizardbeardstudio/open-rgs-go/internal/platform/auth"
+)
+
+const (
+	webauthnChallengeTTL = 5 * time.Minute
+
+	webauthnPurposeRegister = "register"
+	webauthnPurposeLogin    = "login"
+
+	coseAlgES256 = -7
+	coseAlgEdDSA = -8
+
+	authDataFlagUserPresent  = 0x01
+	authDataFlagUserVerified = 0x04
+	authDataFlagAttested     = 0x40
+)
+
+var errWebAuthnMalformed = errors.New("malformed webauthn data")
+
+type webauthnCredential struct {
+	actorID      string
+	credentialID string
+	// publicKey is the authenticator's COSE_Key.
+	publicKey  []byte
+	signCount  uint32
+	label      string
+	status     string
+	createdAt  time.Time
+	lastUsedAt time.Time
+}
+
+func (c *webauthnCredential) toProto() *rgsv1.WebAuthnCredential {
+	out := &rgsv1.WebAuthnCredential{
+		CredentialId: c.credentialID,
+		Label:        c.label,
+		CreatedAt:    c.createdAt.UTC().Format(time.RFC3339Nano),
+	}
+	if !c.lastUsedAt.IsZero() {
+		out.LastUsedAt = c.lastUsedAt.UTC().Format(time.RFC3339Nano)
+	}
+	return out
+}
+
+type webauthnChallenge struct {
+	actorID   string
+	purpose   string
+	expiresAt time.Time
+}
+
+// SetWebAuthnRelyingParty enables operator WebAuthn. rpID is the console's
+// registrable domain and origins are the exact origins (scheme://host[:port])
+// the console is served from.
+func (s *IdentityService) SetWebAuthnRelyingParty(rpID, rpName string, origins []string) {
+	if s == nil {
+		return
+	}
+	if rpName == "" {
+		rpName = "open-rgs"
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.webauthnRPID = rpID
+	s.webauthnRPName = rpName
+	s.webauthnOrigins = append([]string(nil), origins...)
+}
+
+// webauthnUserID is the user handle for an operator; it is derived from the
+// operator id so it carries no personal data.
+func webauthnUserID(actorID string) string {
+	sum := sha256.Sum256([]byte("open-rgs-operator|" + actorID))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func (s *IdentityService) issueWebAuthnChallengeLocked(ctx context.Context, actorID, purpose string) (string, time.Time, error) {
+	challenge, err := randomToken()
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	expiresAt := s.now().Add(webauthnChallengeTTL)
+	if s.db != nil {
+		if err := s.storeWebAuthnChallengeDB(ctx, challenge, actorID, purpose, expiresAt); err != nil {
+			return "", time.Time{}, err
+		}
+		return challenge, expiresAt, nil
+	}
+	now := s.now()
+	for k, c := range s.webauthnChallenges {
+		if !c.expiresAt.After(now) {
+			delete(s.webauthnChallenges, k)
+		}
+	}
+	s.webauthnChallenges[challenge] = webauthnChallenge{actorID: actorID, purpose: purpose, expiresAt: expiresAt}
+	return challenge, expiresAt, nil
+}
+
+// consumeWebAuthnChallengeLocked removes the challenge and reports whether it
+// was issued to actorID for purpose and is still live. A challenge can only
+// be answered once.
+func (s *IdentityService) consumeWebAuthnChallengeLocked(ctx context.Context, challenge, actorID, purpose string) (bool, error) {
+	var c webauthnChallenge
+	if s.db != nil {
+		var (
+			found bool
+			err   error
+		)
+		c, found, err = s.consumeWebAuthnChallengeDB(ctx, challenge)
+		if err != nil || !found {
+			return false, err
+		}
+	} else {
+		var ok bool
+		if c, ok = s.webauthnChallenges[challenge]; !ok {
+			return false, nil
+		}
+		delete(s.webauthnChallenges, challenge)
+	}
+	return c.actorID == actorID && c.purpose == purpose && c.expiresAt.After(s.now()), nil
+}
+
+func (s *IdentityService) webauthnCredentialsForActorLocked(ctx context.Context, actorID string) ([]*webauthnCredential, error) {
+	if s.db != nil {
+		return s.listWebAuthnCredentialsDB(ctx, actorID)
+	}
+	var out []*webauthnCredential
+	for _, c := range s.webauthnCredentials {
+		if c.actorID == actorID && c.status == "active" {
+			out = append(out, c)
+		}
+	}
+	slices.SortFunc(out, func(a, b *webauthnCredential) int { return a.createdAt.Compare(b.createdAt) })
+	return out, nil
+}
+
+func (s *IdentityService) webauthnCredentialLocked(ctx context.Context, credentialID string) (*webauthnCredential, error) {
+	if s.db != nil {
+		return s.getWebAuthnCredentialDB(ctx, credentialID)
+	}
+	c, ok := s.webauthnCredentials[credentialID]
+	if !ok {
+		return nil, nil
+	}
+	cp := *c
+	return &cp, nil
+}
+
+type webauthnClientData struct {
+	Type        string `json:"type"`
+	Challenge   string `json:"challenge"`
+	Origin      string `json:"origin"`
+	CrossOrigin bool   `json:"crossOrigin"`
+}
+
+// checkClientData validates clientDataJSON for the ceremony type and returns
+// its challenge.
+func (s *IdentityService) checkClientData(raw []byte, ceremony string) (string, string) {
+	var cd webauthnClientData
+	if err := json.Unmarshal(raw, &cd); err != nil || cd.Challenge == "" {
+		return "", "invalid client data"
+	}
+	if cd.Type != ceremony {
+		return "", "invalid client data type"
+	}
+	if cd.CrossOrigin || !slices.Contains(s.webauthnOrigins, cd.Origin) {
+		return "", "origin not allowed"
+	}
+	return cd.Challenge, ""
+}
+
+type webauthnAuthData struct {
+	rpIDHash     []byte
+	flags        byte
+	signCount    uint32
+	credentialID []byte
+	publicKey    []byte
+}
+
+func parseWebAuthnAuthData(raw []byte) (*webauthnAuthData, error) {
+	if len(raw) < 37 {
+		return nil, errWebAuthnMalformed
+	}
+	ad := &webauthnAuthData{
+		rpIDHash:  raw[:32],
+		flags:     raw[32],
+		signCount: binary.BigEndian.Uint32(raw[33:37]),
+	}
+	if ad.flags&authDataFlagAttested == 0 {
+		return ad, nil
+	}
+	rest := raw[37:]
+	if len(rest) < 18 {
+		return nil, errWebAuthnMalformed
+	}
+	idLen := int(binary.BigEndian.Uint16(rest[16:18]))
+	rest = rest[18:]
+	if idLen == 0 || len(rest) < idLen {
+		return nil, errWebAuthnMalformed
+	}
+	ad.credentialID = rest[:idLen]
+	rest = rest[idLen:]
+	if _, tail, err := decodeCBOR(rest); err != nil {
+		return nil, err
+	} else {
+		ad.publicKey = rest[:len(rest)-len(tail)]
+	}
+	return ad, nil
+}
+
+// checkAuthData verifies the relying party hash and that the user was both
+// present and verified (PIN or biometric on the key).
+func (s *IdentityService) checkAuthData(ad *webauthnAuthData) string {
+	want := sha256.Sum256([]byte(s.webauthnRPID))
+	if !bytes.Equal(ad.rpIDHash, want[:]) {
+		return "relying party mismatch"
+	}
+	if ad.flags&authDataFlagUserPresent == 0 || ad.flags&authDataFlagUserVerified == 0 {
+		return "user verification required"
+	}
+	return ""
+}
+
+func coseInt(m map[any]any, k int64) (int64, bool) {
+	v, ok := m[k].(int64)
+	return v, ok
+}
+
+func coseBytes(m map[any]any, k int64) []byte {
+	v, _ := m[k].([]byte)
+	return v
+}
+
+// parseCOSEKey returns the public key for an ES256 or EdDSA COSE_Key.
+func parseCOSEKey(raw []byte) (any, int64, error) {
+	v, _, err := decodeCBOR(raw)
+	if err != nil {
+		return nil, 0, err
+	}
+	m, ok := v.(map[any]any)
+	if !ok {
+		return nil, 0, errWebAuthnMalformed
+	}
+	kty, _ := coseInt(m, 1)
+	alg, _ := coseInt(m, 3)
+	crv, _ := coseInt(m, -1)
+	switch {
+	case alg == coseAlgES256 && kty == 2 && crv == 1:
+		x, y := coseBytes(m, -2), coseBytes(m, -3)
+		if len(x) != 32 || len(y) != 32 {
+			return nil, 0, errWebAuthnMalformed
+		}
+		pub := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !pub.Curve.IsOnCurve(pub.X, pub.Y) {
+			return nil, 0, errWebAuthnMalformed
+		}
+		return pub, alg, nil
+	case alg == coseAlgEdDSA && kty == 1 && crv == 6:
+		x := coseBytes(m, -2)
+		if len(x) != ed25519.PublicKeySize {
+			return nil, 0, errWebAuthnMalformed
+		}
+		return ed25519.PublicKey(x), alg, nil
+	default:
+		return nil, 0, errors.New("unsupported public key algorithm")
+	}
+}
+
+func verifyWebAuthnSignature(coseKey, authData, clientDataJSON, sig []byte) bool {
+	pub, _, err := parseCOSEKey(coseKey)
+	if err != nil {
+		return false
+	}
+	clientHash := sha256.Sum256(clientDataJSON)
+	signed := append(append([]byte(nil), authData...), clientHash[:]...)
+	switch k := pub.(type) {
+	case *ecdsa.PublicKey:
+		digest := sha256.Sum256(signed)
+		return ecdsa.VerifyASN1(k, digest[:], sig)
+	case ed25519.PublicKey:
+		return ed25519.Verify(k, signed, sig)
+	}
+	return false
+}
+
+func (s *IdentityService) authorizeWebAuthnOperator(ctx context.Context, meta *rgsv1.RequestMeta) (*rgsv1.Actor, string) {
+	actor, reason := resolveActor(ctx, meta)
+	if reason != "" {
+		return nil, reason
+	}
+	if actor.ActorType != rgsv1.ActorType_ACTOR_TYPE_OPERATOR {
+		return nil, "operator actor required"
+	}
+	return actor, ""
+}
+
+func (s *IdentityService) BeginWebAuthnRegistration(ctx context.Context, req *rgsv1.BeginWebAuthnRegistrationRequest) (*rgsv1.BeginWebAuthnRegistrationResponse, error) {
+	if req == nil {
+		req = &rgsv1.BeginWebAuthnRegistrationRequest{}
+	}
+	actor, reason := s.authorizeWebAuthnOperator(ctx, req.Meta)
+	if reason != "" {
+		s.mu.Lock()
+		s.auditDenied(req.Meta, "", "identity_webauthn_register", reason)
+		s.mu.Unlock()
+		return &rgsv1.BeginWebAuthnRegistrationResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.webauthnRPID == "" {
+		return &rgsv1.BeginWebAuthnRegistrationResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, "webauthn not configured")}, nil
+	}
+	existing, err := s.webauthnCredentialsForActorLocked(ctx, actor.ActorId)
+	if err != nil {
+		return &rgsv1.BeginWebAuthnRegistrationResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	challenge, expiresAt, err := s.issueWebAuthnChallengeLocked(ctx, actor.ActorId, webauthnPurposeRegister)
+	if err != nil {
+		return &rgsv1.BeginWebAuthnRegistrationResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	resp := &rgsv1.BeginWebAuthnRegistrationResponse{
+		Meta:       s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
+		Challenge:  challenge,
+		RpId:       s.webauthnRPID,
+		RpName:     s.webauthnRPName,
+		UserId:     webauthnUserID(actor.ActorId),
+		Algorithms: []int32{coseAlgES256, coseAlgEdDSA},
+		ExpiresAt:  expiresAt.Format(time.RFC3339Nano),
+	}
+	for _, c := range existing {
+		resp.ExcludeCredentialIds = append(resp.ExcludeCredentialIds, c.credentialID)
+	}
+	return resp, nil
+}
+
+func (s *IdentityService) FinishWebAuthnRegistration(ctx context.Context, req *rgsv1.FinishWebAuthnRegistrationRequest) (*rgsv1.FinishWebAuthnRegistrationResponse, error) {
+	if req == nil {
+		req = &rgsv1.FinishWebAuthnRegistrationRequest{}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	deny := func(code rgsv1.ResultCode, reason string) (*rgsv1.FinishWebAuthnRegistrationResponse, error) {
+		s.auditDenied(req.Meta, req.CredentialId, "identity_webauthn_register", reason)
+		return &rgsv1.FinishWebAuthnRegistrationResponse{Meta: s.responseMeta(req.Meta, code, reason)}, nil
+	}
+	actor, reason := s.authorizeWebAuthnOperator(ctx, req.Meta)
+	if reason != "" {
+		return deny(rgsv1.ResultCode_RESULT_CODE_DENIED, reason)
+	}
+	if s.webauthnRPID == "" {
+		return deny(rgsv1.ResultCode_RESULT_CODE_DENIED, "webauthn not configured")
+	}
+	if req.CredentialId == "" || len(req.ClientDataJson) == 0 || len(req.AttestationObject) == 0 {
+		return deny(rgsv1.ResultCode_RESULT_CODE_INVALID, "credential_id, client_data_json, and attestation_object are required")
+	}
+	challenge, reason := s.checkClientData(req.ClientDataJson, "webauthn.create")
+	if reason != "" {
+		return deny(rgsv1.ResultCode_RESULT_CODE_DENIED, reason)
+	}
+	live, err := s.consumeWebAuthnChallengeLocked(ctx, challenge, actor.ActorId, webauthnPurposeRegister)
+	if err != nil {
+		return &rgsv1.FinishWebAuthnRegistrationResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if !live {
+		return deny(rgsv1.ResultCode_RESULT_CODE_DENIED, "challenge expired or unknown")
+	}
+
+	// Attestation is requested as "none", so the statement is not checked;
+	// the key is trusted because an authenticated operator registered it.
+	v, _, err := decodeCBOR(req.AttestationObject)
+	attObj, _ := v.(map[any]any)
+	rawAuthData, _ := attObj["authData"].([]byte)
+	if err != nil || rawAuthData == nil {
+		return deny(rgsv1.ResultCode_RESULT_CODE_INVALID, "invalid attestation object")
+	}
+	ad, err := parseWebAuthnAuthData(rawAuthData)
+	if err != nil || ad.credentialID == nil {
+		return deny(rgsv1.ResultCode_RESULT_CODE_INVALID, "invalid authenticator data")
+	}
+	if reason := s.checkAuthData(ad); reason != "" {
+		return deny(rgsv1.ResultCode_RESULT_CODE_DENIED, reason)
+	}
+	if base64.RawURLEncoding.EncodeToString(ad.credentialID) != req.CredentialId {
+		return deny(rgsv1.ResultCode_RESULT_CODE_INVALID, "credential_id does not match authenticator data")
+	}
+	_, alg, err := parseCOSEKey(ad.publicKey)
+	if err != nil {
+		return deny(rgsv1.ResultCode_RESULT_CODE_INVALID, "unsupported public key")
+	}
+	existing, err := s.webauthnCredentialLocked(ctx, req.CredentialId)
+	if err != nil {
+		return &rgsv1.FinishWebAuthnRegistrationResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if existing != nil {
+		return deny(rgsv1.ResultCode_RESULT_CODE_INVALID, "credential already registered")
+	}
+
+	cred := &webauthnCredential{
+		actorID:      actor.ActorId,
+		credentialID: req.CredentialId,
+		publicKey:    ad.publicKey,
+		signCount:    ad.signCount,
+		label:        req.Label,
+		status:       "active",
+		createdAt:    s.now(),
+	}
+	if s.db != nil {
+		if err := s.insertWebAuthnCredentialDB(ctx, cred); err != nil {
+			return &rgsv1.FinishWebAuthnRegistrationResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+	} else {
+		s.webauthnCredentials[cred.credentialID] = cred
+	}
+	attFmt, _ := attObj["fmt"].(string)
+	after, _ := json.Marshal(map[string]any{
+		"actor_id":      cred.actorID,
+		"credential_id": cred.credentialID,
+		"label":         cred.label,
+		"algorithm":     alg,
+		"fmt":           attFmt,
+	})
+	if err := s.appendAudit(req.Meta, cred.credentialID, "identity_webauthn_register", []byte(`{}`), after, audit.ResultSuccess, ""); err != nil {
+		if s.db != nil {
+			_ = s.deleteWebAuthnCredentialDB(ctx, cred.credentialID)
+		} else {
+			delete(s.webauthnCredentials, cred.credentialID)
+		}
+		return &rgsv1.FinishWebAuthnRegistrationResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	return &rgsv1.FinishWebAuthnRegistrationResponse{
+		Meta:       s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
+		Credential: cred.toProto(),
+	}, nil
+}
+
+func webauthnLoginActor(meta *rgsv1.RequestMeta) (string, string) {
+	if meta == nil || meta.Actor == nil || meta.Actor.ActorId == "" {
+		return "", "actor is required"
+	}
+	if meta.Actor.ActorType != rgsv1.ActorType_ACTOR_TYPE_OPERATOR {
+		return "", "operator actor required"
+	}
+	return meta.Actor.ActorId, ""
+}
+
+// BeginWebAuthnLogin issues a login challenge for the operator named in
+// meta.actor. It does not reveal whether the operator exists beyond the
+// (possibly empty) list of allowed credentials.
+func (s *IdentityService) BeginWebAuthnLogin(ctx context.Context, req *rgsv1.BeginWebAuthnLoginRequest) (*rgsv1.BeginWebAuthnLoginResponse, error) {
+	if req == nil {
+		req = &rgsv1.BeginWebAuthnLoginRequest{}
+	}
+	actorID, reason := webauthnLoginActor(req.Meta)
+	if reason != "" {
+		return &rgsv1.BeginWebAuthnLoginResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, reason)}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.webauthnRPID == "" {
+		return &rgsv1.BeginWebAuthnLoginResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, "webauthn not configured")}, nil
+	}
+	creds, err := s.webauthnCredentialsForActorLocked(ctx, actorID)
+	if err != nil {
+		return &rgsv1.BeginWebAuthnLoginResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	challenge, expiresAt, err := s.issueWebAuthnChallengeLocked(ctx, actorID, webauthnPurposeLogin)
+	if err != nil {
+		return &rgsv1.BeginWebAuthnLoginResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	resp := &rgsv1.BeginWebAuthnLoginResponse{
+		Meta:      s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
+		Challenge: challenge,
+		RpId:      s.webauthnRPID,
+		ExpiresAt: expiresAt.Format(time.RFC3339Nano),
+	}
+	for _, c := range creds {
+		resp.AllowCredentialIds = append(resp.AllowCredentialIds, c.credentialID)
+	}
+	return resp, nil
+}
+
+// finishWebAuthnLogin verifies an assertion and issues a session like login.
+// Failed assertions count toward the operator's lockout.
+func (s *IdentityService) finishWebAuthnLogin(ctx context.Context, req *rgsv1.FinishWebAuthnLoginRequest) (*rgsv1.FinishWebAuthnLoginResponse, error) {
+	if req == nil {
+		req = &rgsv1.FinishWebAuthnLoginRequest{}
+	}
+	actorType := rgsv1.ActorType_ACTOR_TYPE_OPERATOR
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	observe := func(code rgsv1.ResultCode) {
+		if s.onLogin != nil {
+			s.onLogin(code, actorType)
+		}
+	}
+	deny := func(code rgsv1.ResultCode, reason string) (*rgsv1.FinishWebAuthnLoginResponse, error) {
+		s.auditDenied(req.Meta, req.CredentialId, "identity_webauthn_login", reason)
+		observe(rgsv1.ResultCode_RESULT_CODE_DENIED)
+		return &rgsv1.FinishWebAuthnLoginResponse{Meta: s.responseMeta(req.Meta, code, reason)}, nil
+	}
+	unavailable := func() (*rgsv1.FinishWebAuthnLoginResponse, error) {
+		observe(rgsv1.ResultCode_RESULT_CODE_ERROR)
+		return &rgsv1.FinishWebAuthnLoginResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	actorID, reason := webauthnLoginActor(req.Meta)
+	if reason != "" {
+		return deny(rgsv1.ResultCode_RESULT_CODE_DENIED, reason)
+	}
+	if s.webauthnRPID == "" {
+		return deny(rgsv1.ResultCode_RESULT_CODE_DENIED, "webauthn not configured")
+	}
+	if req.CredentialId == "" || len(req.ClientDataJson) == 0 || len(req.AuthenticatorData) == 0 || len(req.Signature) == 0 {
+		return deny(rgsv1.ResultCode_RESULT_CODE_INVALID, "credential_id, client_data_json, authenticator_data, and signature are required")
+	}
+
+	exceeded, err := s.rateLimitExceeded(ctx, actorID, actorType)
+	if err != nil {
+		return unavailable()
+	}
+	if exceeded {
+		return deny(rgsv1.ResultCode_RESULT_CODE_DENIED, "rate limit exceeded")
+	}
+	locked, err := s.checkLocked(ctx, actorID, actorType)
+	if err != nil {
+		return unavailable()
+	}
+	if locked {
+		return deny(rgsv1.ResultCode_RESULT_CODE_DENIED, "account locked")
+	}
+	allowed, err := s.ipAllowedLocked(ctx, actorID, actorType, clientIP(ctx))
+	if err != nil {
+		return unavailable()
+	}
+	if !allowed {
+		return deny(rgsv1.ResultCode_RESULT_CODE_DENIED, ipNotAllowlisted)
+	}
+
+	challenge, reason := s.checkClientData(req.ClientDataJson, "webauthn.get")
+	if reason != "" {
+		return deny(rgsv1.ResultCode_RESULT_CODE_DENIED, reason)
+	}
+	live, err := s.consumeWebAuthnChallengeLocked(ctx, challenge, actorID, webauthnPurposeLogin)
+	if err != nil {
+		return unavailable()
+	}
+	if !live {
+		return deny(rgsv1.ResultCode_RESULT_CODE_DENIED, "challenge expired or unknown")
+	}
+
+	fail := func(reason string) (*rgsv1.FinishWebAuthnLoginResponse, error) {
+		lockedNow, _ := s.recordFailure(ctx, actorID, actorType)
+		if lockedNow {
+			s.lockoutActivated(ctx, actorID, actorType)
+		}
+		return deny(rgsv1.ResultCode_RESULT_CODE_DENIED, reason)
+	}
+	cred, err := s.webauthnCredentialLocked(ctx, req.CredentialId)
+	if err != nil {
+		return unavailable()
+	}
+	if cred == nil || cred.actorID != actorID || cred.status != "active" {
+		return fail("invalid credentials")
+	}
+	ad, err := parseWebAuthnAuthData(req.AuthenticatorData)
+	if err != nil {
+		return fail("invalid credentials")
+	}
+	if reason := s.checkAuthData(ad); reason != "" {
+		return fail(reason)
+	}
+	if !verifyWebAuthnSignature(cred.publicKey, req.AuthenticatorData, req.ClientDataJson, req.Signature) {
+		return fail("invalid credentials")
+	}
+	// Authenticators that keep a counter must advance it; a repeat or lower
+	// value suggests a cloned key.
+	if (cred.signCount != 0 || ad.signCount != 0) && ad.signCount <= cred.signCount {
+		return fail("authenticator sign count regressed")
+	}
+
+	cred.signCount = ad.signCount
+	cred.lastUsedAt = s.now()
+	if s.db != nil {
+		if err := s.updateWebAuthnCredentialUseDB(ctx, cred); err != nil {
+			return unavailable()
+		}
+	} else {
+		s.webauthnCredentials[cred.credentialID] = cred
+	}
+	if err := s.resetFailures(ctx, actorID, actorType); err != nil {
+		return unavailable()
+	}
+	token, meta := s.issueSessionLocked(ctx, req.Meta, actorID, actorType, platformauth.AuthStrengthMFA, "identity_webauthn_login")
+	return &rgsv1.FinishWebAuthnLoginResponse{Meta: meta, Token: token}, nil
+}
@@ -0,0 +1,106 @@
+package server
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+var errCBORMalformed = errors.New("malformed cbor")
+
+// decodeCBOR decodes the first CBOR item in b and returns it with the
+// remaining bytes. It covers the subset used by WebAuthn attestation objects
+// and COSE keys: integers, byte and text strings, arrays, maps, and simple
+// values. Integers decode to int64, maps to map[any]any.
+func decodeCBOR(b []byte) (any, []byte, error) {
+	return decodeCBORDepth(b, 0)
+}
+
+func decodeCBORDepth(b []byte, depth int) (any, []byte, error) {
+	if len(b) == 0 || depth > 16 {
+		return nil, nil, errCBORMalformed
+	}
+	major, info := b[0]>>5, b[0]&0x1f
+	b = b[1:]
+	var arg uint64
+	switch {
+	case info < 24:
+		arg = uint64(info)
+	case info == 24 && len(b) >= 1:
+		arg, b = uint64(b[0]), b[1:]
+	case info == 25 && len(b) >= 2:
+		arg, b = uint64(binary.BigEndian.Uint16(b)), b[2:]
+	case info == 26 && len(b) >= 4:
+		arg, b = uint64(binary.BigEndian.Uint32(b)), b[4:]
+	case info == 27 && len(b) >= 8:
+		arg, b = binary.BigEndian.Uint64(b), b[8:]
+	default:
+		// Indefinite lengths are not produced by authenticators.
+		return nil, nil, errCBORMalformed
+	}
+	switch major {
+	case 0:
+		if arg > 1<<63-1 {
+			return nil, nil, errCBORMalformed
+		}
+		return int64(arg), b, nil
+	case 1:
+		if arg > 1<<63-1 {
+			return nil, nil, errCBORMalformed
+		}
+		return -1 - int64(arg), b, nil
+	case 2, 3:
+		if uint64(len(b)) < arg {
+			return nil, nil, errCBORMalformed
+		}
+		if major == 3 {
+			return string(b[:arg]), b[arg:], nil
+		}
+		return append([]byte(nil), b[:arg]...), b[arg:], nil
+	case 4:
+		if arg > uint64(len(b)) {
+			return nil, nil, errCBORMalformed
+		}
+		out := make([]any, 0, arg)
+		for i := uint64(0); i < arg; i++ {
+			var v any
+			var err error
+			if v, b, err = decodeCBORDepth(b, depth+1); err != nil {
+				return nil, nil, err
+			}
+			out = append(out, v)
+		}
+		return out, b, nil
+	case 5:
+		if arg > uint64(len(b)) {
+			return nil, nil, errCBORMalformed
+		}
+		out := make(map[any]any, arg)
+		for i := uint64(0); i < arg; i++ {
+			var k, v any
+			var err error
+			if k, b, err = decodeCBORDepth(b, depth+1); err != nil {
+				return nil, nil, err
+			}
+			if _, ok := k.([]byte); ok {
+				return nil, nil, errCBORMalformed
+			}
+			if v, b, err = decodeCBORDepth(b, depth+1); err != nil {
+				return nil, nil, err
+			}
+			out[k] = v
+		}
+		return out, b, nil
+	case 7:
+		switch info {
+		case 20:
+			return false, b, nil
+		case 21:
+			return true, b, nil
+		case 22, 23:
+			return nil, b, nil
+		}
+		return nil, nil, errCBORMalformed
+	default:
+		return nil, nil, errCBORMalformed
+	}
+}
@@ -0,0 +1,261 @@
+package server
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+const (
+	testWebAuthnRPID   = "console.rgs.example"
+	testWebAuthnOrigin = "https://console.rgs.example"
+)
+
+func cborHead(major byte, n int) []byte {
+	switch {
+	case n < 24:
+		return []byte{major<<5 | byte(n)}
+	case n < 256:
+		return []byte{major<<5 | 24, byte(n)}
+	default:
+		return []byte{major<<5 | 25, byte(n >> 8), byte(n)}
+	}
+}
+
+func cborInt(v int) []byte {
+	if v < 0 {
+		return cborHead(1, -1-v)
+	}
+	return cborHead(0, v)
+}
+
+func cborBytes(b []byte) []byte { return append(cborHead(2, len(b)), b...) }
+
+func cborText(s string) []byte { return append(cborHead(3, len(s)), s...) }
+
+func cborMap(pairs ...[]byte) []byte {
+	out := cborHead(5, len(pairs)/2)
+	for _, p := range pairs {
+		out = append(out, p...)
+	}
+	return out
+}
+
+// testAuthenticator is a software ES256 security key.
+type testAuthenticator struct {
+	key       *ecdsa.PrivateKey
+	credID    []byte
+	signCount uint32
+	flags     byte
+}
+
+func newTestAuthenticator(t *testing.T) *testAuthenticator {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	credID := make([]byte, 16)
+	_, _ = rand.Read(credID)
+	return &testAuthenticator{key: key, credID: credID, flags: authDataFlagUserPresent | authDataFlagUserVerified}
+}
+
+func (a *testAuthenticator) credentialID() string {
+	return base64.RawURLEncoding.EncodeToString(a.credID)
+}
+
+func (a *testAuthenticator) authData(rpID string, attested bool) []byte {
+	rpHash := sha256.Sum256([]byte(rpID))
+	out := append([]byte(nil), rpHash[:]...)
+	flags := a.flags
+	if attested {
+		flags |= authDataFlagAttested
+	}
+	out = append(out, flags)
+	out = binary.BigEndian.AppendUint32(out, a.signCount)
+	if attested {
+		out = append(out, make([]byte, 16)...)
+		out = binary.BigEndian.AppendUint16(out, uint16(len(a.credID)))
+		out = append(out, a.credID...)
+		out = append(out, cborMap(
+			cborInt(1), cborInt(2),
+			cborInt(3), cborInt(coseAlgES256),
+			cborInt(-1), cborInt(1),
+			cborInt(-2), cborBytes(a.key.X.FillBytes(make([]byte, 32))),
+			cborInt(-3), cborBytes(a.key.Y.FillBytes(make([]byte, 32))),
+		)...)
+	}
+	return out
+}
+
+func testClientData(ceremony, challenge, origin string) []byte {
+	b, _ := json.Marshal(map[string]any{"type": ceremony, "challenge": challenge, "origin": origin})
+	return b
+}
+
+func (a *testAuthenticator) register(challenge string) *rgsv1.FinishWebAuthnRegistrationRequest {
+	return &rgsv1.FinishWebAuthnRegistrationRequest{
+		Meta:              meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		CredentialId:      a.credentialID(),
+		ClientDataJson:    testClientData("webauthn.create", challenge, testWebAuthnOrigin),
+		AttestationObject: cborMap(cborText("fmt"), cborText("none"), cborText("attStmt"), cborMap(), cborText("authData"), cborBytes(a.authData(testWebAuthnRPID, true))),
+		Label:             "yubikey",
+	}
+}
+
+func (a *testAuthenticator) assert(t *testing.T, challenge string) *rgsv1.FinishWebAuthnLoginRequest {
+	t.Helper()
+	a.signCount++
+	authData := a.authData(testWebAuthnRPID, false)
+	clientData := testClientData("webauthn.get", challenge, testWebAuthnOrigin)
+	clientHash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(append([]byte(nil), authData...), clientHash[:]...))
+	sig, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	if err != nil {
+		t.Fatalf("sign assertion: %v", err)
+	}
+	return &rgsv1.FinishWebAuthnLoginRequest{
+		Meta:              meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		CredentialId:      a.credentialID(),
+		ClientDataJson:    clientData,
+		AuthenticatorData: authData,
+		Signature:         sig,
+	}
+}
+
+func newTestWebAuthnIdentity(t *testing.T) (*IdentityService, *testAuthenticator) {
+	t.Helper()
+	svc := NewIdentityService(ledgerFixedClock{now: time.Date(2026, 2, 21, 9, 0, 0, 0, time.UTC)}, "test-secret", 15*time.Minute, time.Hour)
+	svc.SetWebAuthnRelyingParty(testWebAuthnRPID, "", []string{testWebAuthnOrigin})
+	key := newTestAuthenticator(t)
+	begin, _ := svc.BeginWebAuthnRegistration(context.Background(), &rgsv1.BeginWebAuthnRegistrationRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")})
+	if begin.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || begin.RpId != testWebAuthnRPID {
+		t.Fatalf("begin registration failed: %+v", begin)
+	}
+	finish, _ := svc.FinishWebAuthnRegistration(context.Background(), key.register(begin.Challenge))
+	if finish.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || finish.Credential.GetCredentialId() != key.credentialID() {
+		t.Fatalf("finish registration failed: %+v", finish)
+	}
+	return svc, key
+}
+
+func beginTestWebAuthnLogin(t *testing.T, svc *IdentityService) string {
+	t.Helper()
+	begin, _ := svc.BeginWebAuthnLogin(context.Background(), &rgsv1.BeginWebAuthnLoginRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")})
+	if begin.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("begin login failed: %+v", begin.Meta)
+	}
+	return begin.Challenge
+}
+
+func TestWebAuthnRegistrationAndLogin(t *testing.T) {
+	ctx := context.Background()
+	svc, key := newTestWebAuthnIdentity(t)
+
+	var registered bool
+	for _, ev := range auditEvents(svc.AuditStore) {
+		if ev.Action == "identity_webauthn_register" && ev.Result == audit.ResultSuccess && ev.ObjectID == key.credentialID() {
+			registered = true
+		}
+	}
+	if !registered {
+		t.Fatalf("expected registration to be audited")
+	}
+
+	begin, _ := svc.BeginWebAuthnLogin(ctx, &rgsv1.BeginWebAuthnLoginRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")})
+	if len(begin.AllowCredentialIds) != 1 || begin.AllowCredentialIds[0] != key.credentialID() {
+		t.Fatalf("expected registered key allowed, got=%+v", begin)
+	}
+	req := key.assert(t, begin.Challenge)
+	resp, _ := svc.FinishWebAuthnLogin(ctx, req)
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || resp.Token.GetAccessToken() == "" || resp.Token.GetActor().GetActorId() != "op-1" {
+		t.Fatalf("expected webauthn login, got=%+v", resp)
+	}
+
+	// A challenge can only be answered once.
+	replay, _ := svc.FinishWebAuthnLogin(ctx, req)
+	if replay.Meta.GetDenialReason() != "challenge expired or unknown" {
+		t.Fatalf("expected replay denied, got=%+v", replay.Meta)
+	}
+
+	// The registration challenge cannot be reused either.
+	again := newTestAuthenticator(t)
+	dup, _ := svc.FinishWebAuthnRegistration(ctx, again.register(begin.Challenge))
+	if dup.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected stale registration challenge denied, got=%+v", dup.Meta)
+	}
+}
+
+func TestWebAuthnLoginRejectsBadAssertions(t *testing.T) {
+	ctx := context.Background()
+	svc, key := newTestWebAuthnIdentity(t)
+
+	req := key.assert(t, beginTestWebAuthnLogin(t, svc))
+	req.Signature[len(req.Signature)-1] ^= 0xff
+	if resp, _ := svc.FinishWebAuthnLogin(ctx, req); resp.Meta.GetDenialReason() != "invalid credentials" {
+		t.Fatalf("expected bad signature denied, got=%+v", resp.Meta)
+	}
+
+	req = key.assert(t, beginTestWebAuthnLogin(t, svc))
+	req.ClientDataJson = testClientData("webauthn.get", beginTestWebAuthnLogin(t, svc), "https://evil.example")
+	if resp, _ := svc.FinishWebAuthnLogin(ctx, req); resp.Meta.GetDenialReason() != "origin not allowed" {
+		t.Fatalf("expected foreign origin denied, got=%+v", resp.Meta)
+	}
+
+	key.flags = authDataFlagUserPresent
+	req = key.assert(t, beginTestWebAuthnLogin(t, svc))
+	if resp, _ := svc.FinishWebAuthnLogin(ctx, req); resp.Meta.GetDenialReason() != "user verification required" {
+		t.Fatalf("expected unverified user denied, got=%+v", resp.Meta)
+	}
+
+	key.flags = authDataFlagUserPresent | authDataFlagUserVerified
+	if resp, _ := svc.FinishWebAuthnLogin(ctx, key.assert(t, beginTestWebAuthnLogin(t, svc))); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected valid assertion accepted, got=%+v", resp.Meta)
+	}
+	key.signCount -= 2
+	if resp, _ := svc.FinishWebAuthnLogin(ctx, key.assert(t, beginTestWebAuthnLogin(t, svc))); resp.Meta.GetDenialReason() != "authenticator sign count regressed" {
+		t.Fatalf("expected cloned key denied, got=%+v", resp.Meta)
+	}
+}
+
+func TestWebAuthnRegistrationRequiresOperator(t *testing.T) {
+	ctx := context.Background()
+	svc := NewIdentityService(ledgerFixedClock{now: time.Date(2026, 2, 21, 9, 0, 0, 0, time.UTC)}, "test-secret", 15*time.Minute, time.Hour)
+	begin, _ := svc.BeginWebAuthnRegistration(ctx, &rgsv1.BeginWebAuthnRegistrationRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")})
+	if begin.Meta.GetDenialReason() != "webauthn not configured" {
+		t.Fatalf("expected unconfigured relying party denied, got=%+v", begin.Meta)
+	}
+
+	svc.SetWebAuthnRelyingParty(testWebAuthnRPID, "", []string{testWebAuthnOrigin})
+	begin, _ = svc.BeginWebAuthnRegistration(ctx, &rgsv1.BeginWebAuthnRegistrationRequest{Meta: meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "")})
+	if begin.Meta.GetDenialReason() != "operator actor required" {
+		t.Fatalf("expected player denied, got=%+v", begin.Meta)
+	}
+	begin, _ = svc.BeginWebAuthnRegistration(ctx, &rgsv1.BeginWebAuthnRegistrationRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")})
+	key := newTestAuthenticator(t)
+	req := key.register(begin.Challenge)
+	req.CredentialId = "not-the-key"
+	finish, _ := svc.FinishWebAuthnRegistration(ctx, req)
+	if finish.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected mismatched credential id rejected, got=%+v", finish.Meta)
+	}
+	var denials int
+	for _, ev := range auditEvents(svc.AuditStore) {
+		if ev.Action == "identity_webauthn_register" && ev.Result == audit.ResultDenied {
+			denials++
+		}
+	}
+	if denials != 2 {
+		t.Fatalf("expected both registration denials audited, got=%d", denials)
+	}
+}
@@ -0,0 +1,388 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/money"
+)
+
+// balanceCapScanLimit bounds the accounts a single ListAccountsNearCap
+// considers; the highest balances are scanned first.
+const balanceCapScanLimit = 1000
+
+// defaultNearCapThresholdBps is the utilization ListAccountsNearCap reports
+// from when the request does not set one.
+const defaultNearCapThresholdBps = 9000
+
+// BalanceCapSource resolves applied balance cap settings. ConfigService
+// implements it over the change-controlled BalanceCapConfigNamespace, so
+// caps and per-account overrides only change with a second operator's
+// approval.
+type BalanceCapSource interface {
+	BalanceCapSetting(ctx context.Context, key string) (value string, ok bool, err error)
+}
+
+func (s *LedgerService) SetBalanceCapSource(src BalanceCapSource) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.balanceCaps = src
+}
+
+func (s *LedgerService) balanceCapSource() BalanceCapSource {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.balanceCaps
+}
+
+// SetSelfExclusionChecker sets the register consulted before deposits to
+// player accounts.
+func (s *LedgerService) SetSelfExclusionChecker(c SelfExclusionChecker) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.selfExclusion = c
+}
+
+// accountSelfExcluded reports whether accountID is a player account, or a
+// currency sub-account of one, whose player is self-excluded.
+func (s *LedgerService) accountSelfExcluded(ctx context.Context, accountID string) (bool, error) {
+	s.mu.Lock()
+	checker := s.selfExclusion
+	s.mu.Unlock()
+	if checker == nil {
+		return false, nil
+	}
+	if base, _, ok := splitCurrencySubAccount(accountID); ok {
+		accountID = base
+	}
+	if ledgerAccountType(accountID) != "player_cashless" {
+		return false, nil
+	}
+	return checker.PlayerSelfExcluded(ctx, accountID)
+}
+
+// SetPlayerLimitSource sets the responsible gaming limits enforced on
+// deposits to player accounts.
+func (s *LedgerService) SetPlayerLimitSource(src PlayerLimitSource) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.playerLimits = src
+}
+
+// checkDepositLimits returns the denial reason when a deposit or voucher
+// redemption to a player account, or a currency sub-account of one, would
+// exceed one of the player's deposit limits, or "" when it is allowed.
+func (s *LedgerService) checkDepositLimits(ctx context.Context, accountID string, amount *rgsv1.Money) (string, error) {
+	s.mu.Lock()
+	src := s.playerLimits
+	s.mu.Unlock()
+	playerID := accountID
+	if base, _, ok := splitCurrencySubAccount(accountID); ok {
+		playerID = base
+	}
+	if src == nil || ledgerAccountType(playerID) != "player_cashless" {
+		return "", nil
+	}
+	return checkPlayerLimits(ctx, src, playerID, rgsv1.PlayerLimitType_PLAYER_LIMIT_TYPE_DEPOSIT, amount, s.now(), func(_ string, since time.Time) (int64, error) {
+		return s.depositedSince(ctx, playerID, amount.GetCurrency(), since)
+	})
+}
+
+// depositLockAccounts returns the accounts a deposit to accountID locks: the
+// player's account and its sub-account in currency, whose deposits count
+// toward the same deposit limits. Holding both across the limit check and
+// the posting keeps concurrent deposits from passing the check together.
+func depositLockAccounts(accountID, currency string) []string {
+	playerID := accountID
+	if base, _, ok := splitCurrencySubAccount(accountID); ok {
+		playerID = base
+	}
+	return []string{playerID, playerID + ":" + currency}
+}
+
+// depositedSince sums the unvoided deposits and voucher redemptions in
+// currency to playerID's account and currency sub-account from since on.
+func (s *LedgerService) depositedSince(ctx context.Context, playerID, currency string, since time.Time) (int64, error) {
+	accounts := depositLockAccounts(playerID, currency)
+	if s.dbEnabled() {
+		const q = `
+SELECT COALESCE(SUM(t.amount_minor), 0)
+FROM ledger_transactions t
+WHERE t.account_id IN ($1, $2)
+  AND t.transaction_type IN ('deposit'::ledger_transaction_type, 'voucher_redemption'::ledger_transaction_type)
+  AND t.status = 'accepted'::ledger_transaction_status
+  AND t.currency_code = $3
+  AND t.occurred_at >= $4
+  AND NOT EXISTS (SELECT 1 FROM ledger_transactions v WHERE v.voids_transaction_id = t.transaction_id)
+`
+		var total int64
+		err := s.db.QueryRowContext(ctx, q, accounts[0], accounts[1], currency, since.UTC()).Scan(&total)
+		return total, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var total int64
+	for _, id := range accounts {
+		for _, tx := range s.transactionsByAcct[id] {
+			if !countsTowardDepositLimit(tx.TransactionType) || tx.GetAmount().GetCurrency() != currency ||
+				s.voidsByOriginal[tx.TransactionId] != nil || parseTS(tx.OccurredAt).Before(since) {
+				continue
+			}
+			total += tx.GetAmount().GetAmountMinor()
+		}
+	}
+	return total, nil
+}
+
+func countsTowardDepositLimit(t rgsv1.LedgerTransactionType) bool {
+	return t == rgsv1.LedgerTransactionType_LEDGER_TRANSACTION_TYPE_DEPOSIT || t == rgsv1.LedgerTransactionType_LEDGER_TRANSACTION_TYPE_VOUCHER_REDEMPTION
+}
+
+var ledgerAccountTypes = []string{"player_cashless", "operator_liability", "device_escrow", "system_settlement", "fx_gain_loss", "promotional_funding"}
+
+func validLedgerAccountType(t string) bool {
+	for _, known := range ledgerAccountTypes {
+		if t == known {
+			return true
+		}
+	}
+	return false
+}
+
+// ledgerAccountType derives an account's ledger_account_type from its id.
+func ledgerAccountType(accountID string) string {
+	switch {
+	case accountID == "operator_liability":
+		return "operator_liability"
+	case accountID == promotionalFundingAccount:
+		return "promotional_funding"
+	case strings.HasPrefix(accountID, "device_escrow"):
+		return "device_escrow"
+	case strings.HasPrefix(accountID, fxGainLossAccountPrefix):
+		return "fx_gain_loss"
+	default:
+		return "player_cashless"
+	}
+}
+
+type balanceCapKey struct {
+	accountType string // set for type-wide caps
+	accountID   string // set for per-account overrides
+	currency    string
+}
+
+func (k balanceCapKey) String() string {
+	if k.accountID != "" {
+		return "account/" + k.accountID + "/max_balance/" + k.currency
+	}
+	return k.accountType + "/max_balance/" + k.currency
+}
+
+func parseBalanceCapKey(key string) (balanceCapKey, bool) {
+	parts := strings.Split(key, "/")
+	var k balanceCapKey
+	switch {
+	case len(parts) == 3 && validLedgerAccountType(parts[0]):
+		k.accountType = parts[0]
+	case len(parts) == 4 && parts[0] == "account" && parts[1] != "":
+		k.accountID = parts[1]
+		parts = parts[1:]
+	default:
+		return balanceCapKey{}, false
+	}
+	if parts[1] != "max_balance" || !money.ValidCurrency(parts[2]) {
+		return balanceCapKey{}, false
+	}
+	k.currency = parts[2]
+	return k, true
+}
+
+// balanceCap is the limit on an account's available balance in one
+// currency. A zero max means the account is uncapped.
+type balanceCap struct {
+	max      int64
+	partial  bool
+	override bool
+}
+
+// admit returns how much of amount may be credited to an account holding
+// balance, and false when the credit must be refused outright.
+func (c balanceCap) admit(balance, amount int64) (int64, bool) {
+	if c.max == 0 || amount <= c.max-balance {
+		return amount, true
+	}
+	if room := c.max - balance; c.partial && room > 0 {
+		return room, true
+	}
+	return 0, false
+}
+
+// balanceCap returns the cap on accountID's balance in currency. An
+// account override wins over the cap for its account type; currency
+// sub-accounts share the override of the account they belong to.
+func (s *LedgerService) balanceCap(ctx context.Context, accountID, currency string) (balanceCap, error) {
+	src := s.balanceCapSource()
+	if src == nil {
+		return balanceCap{}, nil
+	}
+	ownerID := accountID
+	if base, _, ok := splitCurrencySubAccount(accountID); ok {
+		ownerID = base
+	}
+	accountType := ledgerAccountType(accountID)
+	var c balanceCap
+	for _, k := range []balanceCapKey{
+		{accountID: ownerID, currency: currency},
+		{accountType: accountType, currency: currency},
+	} {
+		v, ok, err := src.BalanceCapSetting(ctx, k.String())
+		if err != nil {
+			return balanceCap{}, err
+		}
+		if !ok {
+			continue
+		}
+		m, err := money.Parse(v + " " + currency)
+		if err != nil {
+			return balanceCap{}, err
+		}
+		c.max, c.override = m.AmountMinor, k.accountID != ""
+		break
+	}
+	if c.max == 0 {
+		return balanceCap{}, nil
+	}
+	mode, _, err := src.BalanceCapSetting(ctx, accountType+"/on_exceed")
+	if err != nil {
+		return balanceCap{}, err
+	}
+	c.partial = mode == "partial"
+	return c, nil
+}
+
+// capCandidates returns up to balanceCapScanLimit accounts with a positive
+// available balance, highest first.
+func (s *LedgerService) capCandidates(ctx context.Context) ([]ledgerAccount, error) {
+	if s.dbEnabled() {
+		return s.listPositiveBalancesFromDB(ctx, balanceCapScanLimit)
+	}
+	s.mu.Lock()
+	out := make([]ledgerAccount, 0, len(s.accounts))
+	for _, acct := range s.accounts {
+		if acct.available > 0 {
+			out = append(out, *acct)
+		}
+	}
+	s.mu.Unlock()
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].available != out[j].available {
+			return out[i].available > out[j].available
+		}
+		return out[i].id < out[j].id
+	})
+	if len(out) > balanceCapScanLimit {
+		out = out[:balanceCapScanLimit]
+	}
+	return out, nil
+}
+
+func utilizationBps(balance, max int64) int32 {
+	bps := float64(balance) * 10000 / float64(max)
+	if bps > math.MaxInt32 {
+		return math.MaxInt32
+	}
+	return int32(bps)
+}
+
+// accountsNearCap returns the capped accounts at or above thresholdBps of
+// their cap, fullest first.
+func (s *LedgerService) accountsNearCap(ctx context.Context, thresholdBps int32) ([]*rgsv1.AccountCapStatus, error) {
+	candidates, err := s.capCandidates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*rgsv1.AccountCapStatus, 0)
+	for _, acct := range candidates {
+		c, err := s.balanceCap(ctx, acct.id, acct.currency)
+		if err != nil {
+			return nil, err
+		}
+		if c.max == 0 {
+			continue
+		}
+		bps := utilizationBps(acct.available, c.max)
+		if bps < thresholdBps {
+			continue
+		}
+		out = append(out, &rgsv1.AccountCapStatus{
+			AccountId:        acct.id,
+			AccountType:      ledgerAccountType(acct.id),
+			AvailableBalance: money.New(acct.available, acct.currency),
+			MaxBalance:       money.New(c.max, acct.currency),
+			UtilizationBps:   bps,
+			Override:         c.override,
+		})
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].UtilizationBps != out[j].UtilizationBps {
+			return out[i].UtilizationBps > out[j].UtilizationBps
+		}
+		return out[i].AccountId < out[j].AccountId
+	})
+	return out, nil
+}
+
+func (s *LedgerService) ListAccountsNearCap(ctx context.Context, req *rgsv1.ListAccountsNearCapRequest) (*rgsv1.ListAccountsNearCapResponse, error) {
+	if req == nil {
+		req = &rgsv1.ListAccountsNearCapRequest{}
+	}
+	if ok, reason := s.authorizeOperator(ctx, req.Meta); !ok {
+		s.auditDenied(req.Meta, "ledger_balance_cap", "", "list_accounts_near_cap", reason)
+		return &rgsv1.ListAccountsNearCapResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	if req.ThresholdBps < 0 || req.ThresholdBps > 10000 {
+		return &rgsv1.ListAccountsNearCapResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "threshold_bps must be in 0..10000")}, nil
+	}
+	if req.PageSize < 0 || req.PageSize > maxAuditPageSize {
+		return &rgsv1.ListAccountsNearCapResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "invalid page_size")}, nil
+	}
+	if err := validatePageToken(req.PageToken); err != nil {
+		return &rgsv1.ListAccountsNearCapResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "invalid page_token")}, nil
+	}
+	threshold := req.ThresholdBps
+	if threshold == 0 {
+		threshold = defaultNearCapThresholdBps
+	}
+	items, err := s.accountsNearCap(ctx, threshold)
+	if err != nil {
+		return &rgsv1.ListAccountsNearCapResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	page, next, err := paginate(items, req.PageToken, req.PageSize)
+	if err != nil {
+		return &rgsv1.ListAccountsNearCapResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "invalid page_token")}, nil
+	}
+	after, _ := json.Marshal(map[string]any{"threshold_bps": threshold, "accounts": len(items)})
+	if err := s.appendAudit(req.Meta, "ledger_balance_cap", "", "list_accounts_near_cap", []byte(`{}`), after, audit.ResultSuccess, ""); err != nil {
+		return &rgsv1.ListAccountsNearCapResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	return &rgsv1.ListAccountsNearCapResponse{
+		Meta:          s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
+		Accounts:      page,
+		NextPageToken: next,
+	}, nil
+}
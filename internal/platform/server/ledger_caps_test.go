@@ -0,0 +1,161 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func capDeposit(svc *LedgerService, accountID, idem string, amount int64) *rgsv1.DepositResponse {
+	resp, _ := svc.Deposit(context.Background(), &rgsv1.DepositRequest{
+		Meta:      meta(accountID, rgsv1.ActorType_ACTOR_TYPE_PLAYER, idem),
+		AccountId: accountID,
+		Amount:    &rgsv1.Money{AmountMinor: amount, Currency: "USD"},
+	})
+	return resp
+}
+
+func TestLedgerBalanceCapDeniesDepositOverCap(t *testing.T) {
+	svc, cfg := newConfigTestLedger(t)
+	applyConfigValue(t, cfg, BalanceCapConfigNamespace, "player_cashless/max_balance/USD", "100.00")
+
+	if resp := capDeposit(svc, "acct-cap", "d-1", 8000); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected deposit under cap, got=%+v", resp.Meta)
+	}
+	denied := capDeposit(svc, "acct-cap", "d-2", 2001)
+	if denied.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED || denied.Meta.GetDenialReason() != "balance cap exceeded" {
+		t.Fatalf("expected cap denial, got=%+v", denied.Meta)
+	}
+	if denied.AvailableBalance.GetAmountMinor() != 8000 {
+		t.Fatalf("expected balance unchanged, got=%d", denied.AvailableBalance.GetAmountMinor())
+	}
+	if resp := capDeposit(svc, "acct-cap", "d-3", 2000); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || resp.AvailableBalance.GetAmountMinor() != 10000 {
+		t.Fatalf("expected deposit up to cap, got=%+v", resp)
+	}
+
+	// House accounts are not capped by the player cap.
+	if resp := capDeposit(svc, "device_escrow:cab-1", "d-4", 50000); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected uncapped account type, got=%+v", resp.Meta)
+	}
+}
+
+func TestLedgerBalanceCapPartialAcceptance(t *testing.T) {
+	svc, cfg := newConfigTestLedger(t)
+	applyConfigValue(t, cfg, BalanceCapConfigNamespace, "player_cashless/max_balance/USD", "100.00")
+	applyConfigValue(t, cfg, BalanceCapConfigNamespace, "player_cashless/on_exceed", "partial")
+	ctx := context.Background()
+
+	_ = capDeposit(svc, "acct-partial", "d-1", 9000)
+	resp := capDeposit(svc, "acct-partial", "d-2", 2500)
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected partial acceptance, got=%+v", resp.Meta)
+	}
+	if resp.Transaction.Amount.GetAmountMinor() != 1000 || resp.RefusedAmount.GetAmountMinor() != 1500 || resp.AvailableBalance.GetAmountMinor() != 10000 {
+		t.Fatalf("unexpected partial deposit: tx=%d refused=%d balance=%d", resp.Transaction.Amount.GetAmountMinor(), resp.RefusedAmount.GetAmountMinor(), resp.AvailableBalance.GetAmountMinor())
+	}
+	if replay := capDeposit(svc, "acct-partial", "d-2", 2500); replay.RefusedAmount.GetAmountMinor() != 1500 {
+		t.Fatalf("expected idempotent replay of partial deposit, got=%+v", replay)
+	}
+
+	full, _ := svc.TransferToAccount(ctx, &rgsv1.TransferToAccountRequest{
+		Meta:      meta("acct-partial", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "t-1"),
+		AccountId: "acct-partial",
+		Amount:    &rgsv1.Money{AmountMinor: 100, Currency: "USD"},
+	})
+	if full.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED || full.Meta.GetDenialReason() != "balance cap exceeded" {
+		t.Fatalf("expected denial once the cap is reached, got=%+v", full.Meta)
+	}
+}
+
+func TestLedgerBalanceCapAccountOverride(t *testing.T) {
+	svc, cfg := newConfigTestLedger(t)
+	applyConfigValue(t, cfg, BalanceCapConfigNamespace, "player_cashless/max_balance/USD", "100.00")
+	applyConfigValue(t, cfg, BalanceCapConfigNamespace, "account/acct-vip/max_balance/USD", "500.00")
+
+	if resp := capDeposit(svc, "acct-vip", "d-1", 40000); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected override to raise the cap, got=%+v", resp.Meta)
+	}
+	if resp := capDeposit(svc, "acct-other", "d-1", 40000); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected type cap for other accounts, got=%+v", resp.Meta)
+	}
+}
+
+func TestConfigBalanceCapOverrideRequiresSecondOperator(t *testing.T) {
+	_, cfg := newConfigTestLedger(t)
+	ctx := context.Background()
+	proposed, _ := cfg.ProposeConfigChange(ctx, &rgsv1.ProposeConfigChangeRequest{
+		Meta:            meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		ConfigNamespace: BalanceCapConfigNamespace,
+		ConfigKey:       "account/acct-vip/max_balance/USD",
+		ProposedValue:   "500.00",
+		Reason:          "vip limit",
+	})
+	selfApproved, _ := cfg.ApproveConfigChange(ctx, &rgsv1.ApproveConfigChangeRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), ChangeId: proposed.Change.ChangeId})
+	if selfApproved.Meta.GetResultCode() == rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected proposer to be unable to approve their own override")
+	}
+	if _, ok, _ := cfg.BalanceCapSetting(ctx, "account/acct-vip/max_balance/USD"); ok {
+		t.Fatalf("expected override to stay unapplied without approval")
+	}
+
+	for key, value := range map[string]string{
+		"player_cashless/max_balance/USD":   "0",
+		"player_cashless/max_balance/usd":   "100.00",
+		"unknown_type/max_balance/USD":      "100.00",
+		"account//max_balance/USD":          "100.00",
+		"player_cashless/on_exceed":         "clip",
+		"player_cashless/min_balance/USD":   "1.00",
+		"account/acct-1/max_balance/USD/x":  "1.00",
+		"operator_liability/on_exceed/more": "deny",
+	} {
+		resp, _ := cfg.ProposeConfigChange(ctx, &rgsv1.ProposeConfigChangeRequest{
+			Meta:            meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+			ConfigNamespace: BalanceCapConfigNamespace,
+			ConfigKey:       key,
+			ProposedValue:   value,
+			Reason:          "bad cap",
+		})
+		if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+			t.Fatalf("%s=%s: expected invalid, got=%+v", key, value, resp.Meta)
+		}
+	}
+}
+
+func TestLedgerListAccountsNearCap(t *testing.T) {
+	svc, cfg := newConfigTestLedger(t)
+	applyConfigValue(t, cfg, BalanceCapConfigNamespace, "player_cashless/max_balance/USD", "100.00")
+	applyConfigValue(t, cfg, BalanceCapConfigNamespace, "account/acct-vip/max_balance/USD", "200.00")
+	ctx := context.Background()
+	_ = capDeposit(svc, "acct-a", "d-1", 9500)
+	_ = capDeposit(svc, "acct-b", "d-1", 5000)
+	_ = capDeposit(svc, "acct-vip", "d-1", 19000)
+
+	resp, err := svc.ListAccountsNearCap(ctx, &rgsv1.ListAccountsNearCapRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")})
+	if err != nil || resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("list near cap failed: err=%v meta=%+v", err, resp.GetMeta())
+	}
+	if len(resp.Accounts) != 2 || resp.Accounts[0].AccountId != "acct-a" || resp.Accounts[1].AccountId != "acct-vip" {
+		t.Fatalf("unexpected near-cap accounts: %+v", resp.Accounts)
+	}
+	if got := resp.Accounts[0]; got.UtilizationBps != 9500 || got.MaxBalance.GetAmountMinor() != 10000 || got.Override || got.AccountType != "player_cashless" {
+		t.Fatalf("unexpected status: %+v", got)
+	}
+	if !resp.Accounts[1].Override {
+		t.Fatalf("expected override flag on acct-vip")
+	}
+
+	wide, _ := svc.ListAccountsNearCap(ctx, &rgsv1.ListAccountsNearCapRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), ThresholdBps: 5000})
+	if len(wide.Accounts) != 3 {
+		t.Fatalf("expected all capped accounts at 50%%, got=%d", len(wide.Accounts))
+	}
+
+	denied, _ := svc.ListAccountsNearCap(ctx, &rgsv1.ListAccountsNearCapRequest{Meta: meta("acct-a", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "")})
+	if denied.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected player denial, got=%+v", denied.Meta)
+	}
+	invalid, _ := svc.ListAccountsNearCap(ctx, &rgsv1.ListAccountsNearCapRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), ThresholdBps: 10001})
+	if invalid.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected invalid threshold, got=%+v", invalid.Meta)
+	}
+}
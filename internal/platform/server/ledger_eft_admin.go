@@ -0,0 +1,176 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+// eftLockoutScanLimit bounds a single ListEFTLockouts scan.
+const eftLockoutScanLimit = 1000
+
+func (s *LedgerService) authorizeOperator(ctx context.Context, meta *rgsv1.RequestMeta) (bool, string) {
+	actor, reason := resolveActor(ctx, meta)
+	if reason != "" {
+		return false, reason
+	}
+	if actor.ActorType != rgsv1.ActorType_ACTOR_TYPE_OPERATOR {
+		return false, "operator actor required"
+	}
+	return true, ""
+}
+
+// eftLockoutState returns the account's lockout state; accounts with no
+// recorded failures report a zero state rather than nil.
+func (s *LedgerService) eftLockoutState(ctx context.Context, accountID string) (*rgsv1.EFTLockout, error) {
+	if s.dbEnabled() {
+		l, err := s.getEFTLockoutFromDB(ctx, accountID)
+		if err != nil {
+			return nil, err
+		}
+		if l == nil {
+			l = &rgsv1.EFTLockout{AccountId: accountID}
+		}
+		return l, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.eftLockoutLocked(accountID), nil
+}
+
+func (s *LedgerService) eftLockoutLocked(accountID string) *rgsv1.EFTLockout {
+	l := &rgsv1.EFTLockout{
+		AccountId:      accountID,
+		FailedAttempts: int32(s.eftFraudFailures[accountID]),
+	}
+	if until, ok := s.eftFraudLockedUntil[accountID]; ok {
+		l.LockedUntil = until.UTC().Format(time.RFC3339Nano)
+		l.Locked = until.After(s.now())
+	}
+	return l
+}
+
+func (s *LedgerService) listEFTLockouts(ctx context.Context, lockedOnly bool) ([]*rgsv1.EFTLockout, error) {
+	if s.dbEnabled() {
+		return s.listEFTLockoutsFromDB(ctx, lockedOnly, eftLockoutScanLimit)
+	}
+	s.mu.Lock()
+	ids := make(map[string]struct{}, len(s.eftFraudFailures))
+	for id := range s.eftFraudFailures {
+		ids[id] = struct{}{}
+	}
+	for id := range s.eftFraudLockedUntil {
+		ids[id] = struct{}{}
+	}
+	out := make([]*rgsv1.EFTLockout, 0, len(ids))
+	for id := range ids {
+		l := s.eftLockoutLocked(id)
+		if lockedOnly && !l.Locked {
+			continue
+		}
+		out = append(out, l)
+	}
+	s.mu.Unlock()
+	sort.Slice(out, func(i, j int) bool { return out[i].AccountId < out[j].AccountId })
+	if len(out) > eftLockoutScanLimit {
+		out = out[:eftLockoutScanLimit]
+	}
+	return out, nil
+}
+
+func (s *LedgerService) GetEFTLockout(ctx context.Context, req *rgsv1.GetEFTLockoutRequest) (*rgsv1.GetEFTLockoutResponse, error) {
+	if req == nil || req.AccountId == "" {
+		return &rgsv1.GetEFTLockoutResponse{Meta: s.responseMeta(nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "account_id is required")}, nil
+	}
+	if ok, reason := s.authorizeOperator(ctx, req.Meta); !ok {
+		s.auditDenied(req.Meta, "eft_lockout", req.AccountId, "get_eft_lockout", reason)
+		return &rgsv1.GetEFTLockoutResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	l, err := s.eftLockoutState(ctx, req.AccountId)
+	if err != nil {
+		return &rgsv1.GetEFTLockoutResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	after, _ := json.Marshal(l)
+	if err := s.appendAudit(req.Meta, "eft_lockout", req.AccountId, "get_eft_lockout", []byte(`{}`), after, audit.ResultSuccess, ""); err != nil {
+		return &rgsv1.GetEFTLockoutResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	s.mu.Lock()
+	maxFailures, ttl := s.eftFraudMaxFailures, s.eftFraudLockoutTTL
+	s.mu.Unlock()
+	return &rgsv1.GetEFTLockoutResponse{
+		Meta:              s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
+		Lockout:           l,
+		MaxFailures:       int32(maxFailures),
+		LockoutTtlSeconds: int64(ttl / time.Second),
+	}, nil
+}
+
+func (s *LedgerService) ListEFTLockouts(ctx context.Context, req *rgsv1.ListEFTLockoutsRequest) (*rgsv1.ListEFTLockoutsResponse, error) {
+	if req == nil {
+		req = &rgsv1.ListEFTLockoutsRequest{}
+	}
+	if ok, reason := s.authorizeOperator(ctx, req.Meta); !ok {
+		s.auditDenied(req.Meta, "eft_lockout", "", "list_eft_lockouts", reason)
+		return &rgsv1.ListEFTLockoutsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	if req.PageSize < 0 || req.PageSize > maxAuditPageSize {
+		return &rgsv1.ListEFTLockoutsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "invalid page_size")}, nil
+	}
+	if err := validatePageToken(req.PageToken); err != nil {
+		return &rgsv1.ListEFTLockoutsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "invalid page_token")}, nil
+	}
+	items, err := s.listEFTLockouts(ctx, req.LockedOnly)
+	if err != nil {
+		return &rgsv1.ListEFTLockoutsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	page, next, err := paginate(items, req.PageToken, req.PageSize)
+	if err != nil {
+		return &rgsv1.ListEFTLockoutsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "invalid page_token")}, nil
+	}
+	if err := s.appendAudit(req.Meta, "eft_lockout", "", "list_eft_lockouts", []byte(`{}`), []byte(`{}`), audit.ResultSuccess, ""); err != nil {
+		return &rgsv1.ListEFTLockoutsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	return &rgsv1.ListEFTLockoutsResponse{
+		Meta:          s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
+		Lockouts:      page,
+		NextPageToken: next,
+	}, nil
+}
+
+func (s *LedgerService) ResetEFTLockout(ctx context.Context, req *rgsv1.ResetEFTLockoutRequest) (*rgsv1.ResetEFTLockoutResponse, error) {
+	if req == nil || req.AccountId == "" {
+		return &rgsv1.ResetEFTLockoutResponse{Meta: s.responseMeta(nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "account_id is required")}, nil
+	}
+	if ok, reason := s.authorizeOperator(ctx, req.Meta); !ok {
+		s.auditDenied(req.Meta, "eft_lockout", req.AccountId, "reset_eft_lockout", reason)
+		return &rgsv1.ResetEFTLockoutResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	if req.Reason == "" {
+		return &rgsv1.ResetEFTLockoutResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "reason is required")}, nil
+	}
+
+	// Serialize with in-flight transfers that record failures on the account.
+	unlock := s.acctLocks.lock(req.AccountId)
+	defer unlock()
+	prev, err := s.eftLockoutState(ctx, req.AccountId)
+	if err != nil {
+		return &rgsv1.ResetEFTLockoutResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	before, _ := json.Marshal(prev)
+	cleared := &rgsv1.EFTLockout{AccountId: req.AccountId, UpdatedAt: s.now().Format(time.RFC3339Nano)}
+	after, _ := json.Marshal(cleared)
+	if err := s.appendAudit(req.Meta, "eft_lockout", req.AccountId, "reset_eft_lockout", before, after, audit.ResultSuccess, req.Reason); err != nil {
+		return &rgsv1.ResetEFTLockoutResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	if err := s.resetEFTFailures(ctx, req.AccountId); err != nil {
+		return &rgsv1.ResetEFTLockoutResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	return &rgsv1.ResetEFTLockoutResponse{
+		Meta:    s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
+		Lockout: cleared,
+	}, nil
+}
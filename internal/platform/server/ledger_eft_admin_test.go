@@ -0,0 +1,95 @@
+package server
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+func lockEFTAccount(t *testing.T, svc *LedgerService, accountID string, failures int) {
+	t.Helper()
+	for i := 0; i < failures; i++ {
+		_, _ = svc.TransferToDevice(context.Background(), &rgsv1.TransferToDeviceRequest{
+			Meta:            meta(accountID, rgsv1.ActorType_ACTOR_TYPE_PLAYER, accountID+"-fail-"+strconv.Itoa(i)),
+			AccountId:       accountID,
+			DeviceId:        "device-1",
+			RequestedAmount: &rgsv1.Money{AmountMinor: 100, Currency: "USD"},
+		})
+	}
+}
+
+func TestLedgerEFTLockoutAdminInspectAndReset(t *testing.T) {
+	svc := NewLedgerService(ledgerFixedClock{now: time.Date(2026, 2, 18, 12, 0, 0, 0, time.UTC)})
+	svc.SetEFTFraudPolicy(2, 15*time.Minute)
+	ctx := context.Background()
+	lockEFTAccount(t, svc, "acct-locked", 2)
+	lockEFTAccount(t, svc, "acct-warn", 1)
+
+	got, _ := svc.GetEFTLockout(ctx, &rgsv1.GetEFTLockoutRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), AccountId: "acct-locked"})
+	if got.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("get lockout failed: %+v", got.Meta)
+	}
+	if !got.Lockout.GetLocked() || got.Lockout.GetFailedAttempts() != 2 || got.Lockout.GetLockedUntil() != "2026-02-18T12:15:00Z" {
+		t.Fatalf("unexpected lockout: %+v", got.Lockout)
+	}
+	if got.MaxFailures != 2 || got.LockoutTtlSeconds != 900 {
+		t.Fatalf("unexpected policy: max=%d ttl=%d", got.MaxFailures, got.LockoutTtlSeconds)
+	}
+
+	all, _ := svc.ListEFTLockouts(ctx, &rgsv1.ListEFTLockoutsRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")})
+	if len(all.Lockouts) != 2 {
+		t.Fatalf("expected 2 accounts with failures, got=%d", len(all.Lockouts))
+	}
+	locked, _ := svc.ListEFTLockouts(ctx, &rgsv1.ListEFTLockoutsRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), LockedOnly: true})
+	if len(locked.Lockouts) != 1 || locked.Lockouts[0].AccountId != "acct-locked" {
+		t.Fatalf("expected only acct-locked, got=%+v", locked.Lockouts)
+	}
+
+	missingReason, _ := svc.ResetEFTLockout(ctx, &rgsv1.ResetEFTLockoutRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), AccountId: "acct-locked"})
+	if missingReason.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected invalid without reason, got=%v", missingReason.Meta.GetResultCode())
+	}
+	reset, _ := svc.ResetEFTLockout(ctx, &rgsv1.ResetEFTLockoutRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), AccountId: "acct-locked", Reason: "player verified by support"})
+	if reset.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || reset.Lockout.GetLocked() {
+		t.Fatalf("reset failed: %+v", reset)
+	}
+	if isLocked, _ := svc.eftLocked(ctx, "acct-locked"); isLocked {
+		t.Fatalf("expected account unlocked after reset")
+	}
+
+	var found bool
+	for _, ev := range svc.AuditEvents() {
+		if ev.Action == "reset_eft_lockout" && ev.Result == audit.ResultSuccess && ev.ObjectID == "acct-locked" && ev.Reason == "player verified by support" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("expected reset_eft_lockout audit event")
+	}
+}
+
+func TestLedgerEFTLockoutAdminRequiresOperator(t *testing.T) {
+	svc := NewLedgerService(ledgerFixedClock{now: time.Date(2026, 2, 18, 12, 0, 0, 0, time.UTC)})
+	ctx := context.Background()
+	for _, actor := range []*rgsv1.RequestMeta{
+		meta("acct-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""),
+		meta("svc-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, ""),
+	} {
+		resp, _ := svc.ResetEFTLockout(ctx, &rgsv1.ResetEFTLockoutRequest{Meta: actor, AccountId: "acct-1", Reason: "self service"})
+		if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+			t.Fatalf("expected denied for %v, got=%v", actor.Actor.ActorType, resp.Meta.GetResultCode())
+		}
+		get, _ := svc.GetEFTLockout(ctx, &rgsv1.GetEFTLockoutRequest{Meta: actor, AccountId: "acct-1"})
+		if get.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+			t.Fatalf("expected get denied for %v, got=%v", actor.Actor.ActorType, get.Meta.GetResultCode())
+		}
+	}
+	events := svc.AuditEvents()
+	if len(events) != 4 || events[0].Result != audit.ResultDenied {
+		t.Fatalf("expected denied attempts audited, got=%d", len(events))
+	}
+}
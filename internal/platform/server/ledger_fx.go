@@ -0,0 +1,293 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/money"
+)
+
+// fxGainLossAccountPrefix names the per-currency house accounts that take
+// the other side of every exchange, e.g. "fx_gain_loss:EUR". Their combined
+// position, valued in one currency, is the realised FX gain or loss.
+const fxGainLossAccountPrefix = "fx_gain_loss:"
+
+// FXRateSource resolves the rate for converting one currency into another.
+// ConfigService implements it over the change-controlled FXRateConfigNamespace.
+type FXRateSource interface {
+	FXRate(ctx context.Context, from, to string) (rate string, ok bool, err error)
+}
+
+func (s *LedgerService) SetFXRateSource(src FXRateSource) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fxRates = src
+}
+
+func (s *LedgerService) fxRateSource() FXRateSource {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.fxRates
+}
+
+// currencySubAccountID names the account holding accountID's balance in a
+// currency other than its primary one.
+func currencySubAccountID(accountID, currency string) string {
+	return accountID + ":" + currency
+}
+
+// splitCurrencySubAccount reverses currencySubAccountID. House accounts
+// that use a colon for other purposes are not sub-accounts.
+func splitCurrencySubAccount(accountID string) (base, currency string, ok bool) {
+	i := strings.LastIndex(accountID, ":")
+	if i <= 0 || strings.HasPrefix(accountID, "device_escrow") || strings.HasPrefix(accountID, fxGainLossAccountPrefix) {
+		return "", "", false
+	}
+	base, currency = accountID[:i], accountID[i+1:]
+	if !money.ValidCurrency(currency) {
+		return "", "", false
+	}
+	return base, currency, true
+}
+
+// balanceAccountID returns the account holding accountID's balance in
+// currency: the account itself when currency is its primary currency,
+// otherwise the currency sub-account.
+func (s *LedgerService) balanceAccountID(ctx context.Context, accountID, currency string) (string, error) {
+	_, _, primary, ok := s.accountBalance(accountID)
+	if s.dbEnabled() {
+		var err error
+		if _, _, primary, ok, err = s.getBalanceFromDB(ctx, accountID); err != nil {
+			return "", err
+		}
+	}
+	if ok && strings.TrimSpace(primary) == currency {
+		return accountID, nil
+	}
+	return currencySubAccountID(accountID, currency), nil
+}
+
+// commitAccountState publishes a second account touched by a mutation that
+// commitMutation has already recorded.
+func (s *LedgerService) commitAccountState(acct *ledgerAccount) {
+	if !s.useInMemoryStateMirror() {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	committed := *acct
+	s.accounts[acct.id] = &committed
+}
+
+func (s *LedgerService) ExchangeCurrency(ctx context.Context, req *rgsv1.ExchangeCurrencyRequest) (*rgsv1.ExchangeCurrencyResponse, error) {
+	if req == nil || req.AccountId == "" {
+		return &rgsv1.ExchangeCurrencyResponse{Meta: s.responseMeta(nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "account_id is required")}, nil
+	}
+	if _, _, ok := splitCurrencySubAccount(req.AccountId); ok {
+		return &rgsv1.ExchangeCurrencyResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "account_id must be the base account")}, nil
+	}
+	if ok, reason := s.authorize(ctx, req.Meta, req.AccountId); !ok {
+		s.auditDenied(req.Meta, "ledger_account", req.AccountId, "exchange_currency", reason)
+		return &rgsv1.ExchangeCurrencyResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	if invalidAmount(req.Amount) {
+		return &rgsv1.ExchangeCurrencyResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "amount must be > 0 and currency provided")}, nil
+	}
+	fromCurrency, toCurrency := req.Amount.Currency, req.ToCurrency
+	if !money.ValidCurrency(toCurrency) || toCurrency == fromCurrency {
+		return &rgsv1.ExchangeCurrencyResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "to_currency must be a different ISO 4217 code")}, nil
+	}
+	idem := idempotency(req.Meta)
+	if idem == "" {
+		return &rgsv1.ExchangeCurrencyResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "idempotency_key is required")}, nil
+	}
+
+	fromID, err := s.balanceAccountID(ctx, req.AccountId, fromCurrency)
+	if err != nil {
+		return &rgsv1.ExchangeCurrencyResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	toID, err := s.balanceAccountID(ctx, req.AccountId, toCurrency)
+	if err != nil {
+		return &rgsv1.ExchangeCurrencyResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	unlock := s.acctLocks.lockAll(req.AccountId, fromID, toID)
+	defer unlock()
+	locked, err := s.eftLocked(ctx, req.AccountId)
+	if err != nil {
+		return &rgsv1.ExchangeCurrencyResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if locked {
+		s.auditDenied(req.Meta, "ledger_account", req.AccountId, "exchange_currency", "eft account locked")
+		return &rgsv1.ExchangeCurrencyResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, "eft account locked")}, nil
+	}
+
+	key := req.AccountId + "|exchange|" + idem
+	scope := idemScope(req.AccountId, "exchange")
+	requestHash := hashRequest(scope, fromCurrency, strconv.FormatInt(req.Amount.AmountMinor, 10), toCurrency)
+	if s.useInMemoryIdempotencyCache() {
+		if cp, ok := cachedLedgerResponse(s, s.exchangeByIdempotency, key); ok {
+			return cp, nil
+		}
+	}
+	if s.dbEnabled() {
+		var replay rgsv1.ExchangeCurrencyResponse
+		found, err := s.loadIdempotencyResponse(ctx, scope, idem, requestHash, &replay)
+		if err == errIdempotencyRequestMismatch {
+			return &rgsv1.ExchangeCurrencyResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "idempotency_key reused with different request")}, nil
+		}
+		if err != nil {
+			return &rgsv1.ExchangeCurrencyResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+		if found {
+			if s.useInMemoryIdempotencyCache() {
+				cacheLedgerResponse(s, s.exchangeByIdempotency, key, &replay)
+			}
+			return &replay, nil
+		}
+		resp, err := s.replayCurrencyExchangeFromDB(ctx, req.Meta, fromID, idem)
+		if err != nil {
+			return &rgsv1.ExchangeCurrencyResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+		if resp != nil {
+			if s.useInMemoryIdempotencyCache() {
+				cacheLedgerResponse(s, s.exchangeByIdempotency, key, resp)
+			}
+			return resp, nil
+		}
+	}
+
+	src := s.fxRateSource()
+	if src == nil {
+		return &rgsv1.ExchangeCurrencyResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "exchange rates unavailable")}, nil
+	}
+	rawRate, ok, err := src.FXRate(ctx, fromCurrency, toCurrency)
+	if err != nil {
+		return &rgsv1.ExchangeCurrencyResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "exchange rates unavailable")}, nil
+	}
+	if !ok {
+		return &rgsv1.ExchangeCurrencyResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "no exchange rate for "+fromCurrency+"/"+toCurrency)}, nil
+	}
+	rate, err := money.ParseRate(rawRate)
+	if err != nil {
+		return &rgsv1.ExchangeCurrencyResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "configured exchange rate is invalid")}, nil
+	}
+	credited, err := money.Convert(req.Amount, toCurrency, rate)
+	if err != nil {
+		return &rgsv1.ExchangeCurrencyResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "amount exceeds balance limit")}, nil
+	}
+	if credited.AmountMinor == 0 {
+		return &rgsv1.ExchangeCurrencyResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "amount too small to exchange")}, nil
+	}
+
+	fromAcct, err := s.mutationAccountState(ctx, fromID, fromCurrency)
+	if err != nil {
+		return &rgsv1.ExchangeCurrencyResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	toAcct, err := s.mutationAccountState(ctx, toID, toCurrency)
+	if err != nil {
+		return &rgsv1.ExchangeCurrencyResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if fromAcct.currency != fromCurrency || toAcct.currency != toCurrency {
+		return &rgsv1.ExchangeCurrencyResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "currency mismatch for account")}, nil
+	}
+	if fromAcct.available < req.Amount.AmountMinor {
+		s.auditDenied(req.Meta, "ledger_account", req.AccountId, "exchange_currency", "insufficient balance")
+		return &rgsv1.ExchangeCurrencyResponse{
+			Meta:        s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, "insufficient balance"),
+			FromBalance: money.New(fromAcct.available, fromAcct.currency),
+			ToBalance:   money.New(toAcct.available, toAcct.currency),
+		}, nil
+	}
+
+	before, _ := json.Marshal(map[string]json.RawMessage{"from": snapshotAccount(fromAcct), "to": snapshotAccount(toAcct)})
+	now := s.now()
+	postings := []ledgerPosting{
+		{accountID: fromID, direction: "debit", amount: req.Amount.AmountMinor, currency: fromCurrency, createdAt: now},
+		{accountID: fxGainLossAccountPrefix + fromCurrency, direction: "credit", amount: req.Amount.AmountMinor, currency: fromCurrency, createdAt: now},
+		{accountID: fxGainLossAccountPrefix + toCurrency, direction: "debit", amount: credited.AmountMinor, currency: toCurrency, createdAt: now},
+		{accountID: toID, direction: "credit", amount: credited.AmountMinor, currency: toCurrency, createdAt: now},
+	}
+	if !isBalanced(postings) {
+		return &rgsv1.ExchangeCurrencyResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "unbalanced postings")}, nil
+	}
+	fromAcct.available -= req.Amount.AmountMinor
+	if toAcct.available, err = money.AddMinor(toAcct.available, credited.AmountMinor); err != nil {
+		return &rgsv1.ExchangeCurrencyResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "amount exceeds balance limit")}, nil
+	}
+	tx := &rgsv1.LedgerTransaction{
+		TransactionId:   s.newTxID(),
+		AccountId:       fromID,
+		TransactionType: rgsv1.LedgerTransactionType_LEDGER_TRANSACTION_TYPE_CURRENCY_EXCHANGE,
+		Amount:          money.New(req.Amount.AmountMinor, fromCurrency),
+		OccurredAt:      now.Format(time.RFC3339Nano),
+		Description:     "exchange " + money.Format(req.Amount) + " to " + money.Format(credited) + " at " + rawRate,
+	}
+	ex := ledgerCurrencyExchange{fromAccountID: fromID, toAccountID: toID, from: tx.Amount, to: credited, rate: rawRate}
+
+	after, _ := json.Marshal(map[string]any{
+		"from": json.RawMessage(snapshotAccount(fromAcct)),
+		"to":   json.RawMessage(snapshotAccount(toAcct)),
+		"rate": rawRate,
+	})
+	if err := s.appendAudit(req.Meta, "ledger_account", req.AccountId, "exchange_currency", before, after, audit.ResultSuccess, ""); err != nil {
+		return &rgsv1.ExchangeCurrencyResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	if err := s.persistCurrencyExchange(ctx, tx, postings, idem, req.AccountId, ex); err != nil {
+		return &rgsv1.ExchangeCurrencyResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	s.commitMutation(fromAcct, tx, postings)
+	s.commitAccountState(toAcct)
+
+	resp := &rgsv1.ExchangeCurrencyResponse{
+		Meta:            s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
+		Transaction:     tx,
+		ExchangedAmount: credited,
+		Rate:            rawRate,
+		FromBalance:     money.New(fromAcct.available, fromAcct.currency),
+		ToBalance:       money.New(toAcct.available, toAcct.currency),
+	}
+	if err := s.persistIdempotencyResponse(ctx, scope, idem, requestHash, resp.Meta.GetResultCode(), resp); err != nil {
+		return &rgsv1.ExchangeCurrencyResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if s.useInMemoryIdempotencyCache() {
+		cacheLedgerResponse(s, s.exchangeByIdempotency, key, resp)
+	}
+	return resp, nil
+}
+
+// replayCurrencyExchangeFromDB rebuilds the response for an exchange that
+// committed but whose idempotency record was never written.
+func (s *LedgerService) replayCurrencyExchangeFromDB(ctx context.Context, meta *rgsv1.RequestMeta, fromID, idem string) (*rgsv1.ExchangeCurrencyResponse, error) {
+	tx, found, err := s.findTransactionByIdempotency(ctx, fromID, rgsv1.LedgerTransactionType_LEDGER_TRANSACTION_TYPE_CURRENCY_EXCHANGE, idem)
+	if err != nil || !found {
+		return nil, err
+	}
+	ex, err := s.getCurrencyExchangeFromDB(ctx, tx.TransactionId)
+	if err != nil || ex == nil {
+		return nil, err
+	}
+	fromAvailable, _, _, _, err := s.getBalanceFromDB(ctx, ex.fromAccountID)
+	if err != nil {
+		return nil, err
+	}
+	toAvailable, _, _, _, err := s.getBalanceFromDB(ctx, ex.toAccountID)
+	if err != nil {
+		return nil, err
+	}
+	return &rgsv1.ExchangeCurrencyResponse{
+		Meta:            s.responseMeta(meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
+		Transaction:     tx,
+		ExchangedAmount: ex.to,
+		Rate:            ex.rate,
+		FromBalance:     money.New(fromAvailable, ex.from.Currency),
+		ToBalance:       money.New(toAvailable, ex.to.Currency),
+	}, nil
+}
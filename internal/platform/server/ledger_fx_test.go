@@ -0,0 +1,130 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func TestLedgerExchangeCurrencyPostsBalancedEntries(t *testing.T) {
+	svc, cfg := newConfigTestLedger(t)
+	applyConfigValue(t, cfg, FXRateConfigNamespace, "USD/EUR", "0.9215")
+	ctx := context.Background()
+	_, _ = svc.Deposit(ctx, &rgsv1.DepositRequest{
+		Meta:      meta("acct-fx", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "seed"),
+		AccountId: "acct-fx",
+		Amount:    &rgsv1.Money{AmountMinor: 5000, Currency: "USD"},
+	})
+
+	req := &rgsv1.ExchangeCurrencyRequest{
+		Meta:       meta("acct-fx", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "fx-1"),
+		AccountId:  "acct-fx",
+		Amount:     &rgsv1.Money{AmountMinor: 1001, Currency: "USD"},
+		ToCurrency: "EUR",
+	}
+	resp, err := svc.ExchangeCurrency(ctx, req)
+	if err != nil || resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("exchange failed: err=%v meta=%+v", err, resp.GetMeta())
+	}
+	if resp.ExchangedAmount.GetAmountMinor() != 922 || resp.Rate != "0.9215" {
+		t.Fatalf("unexpected conversion: amount=%v rate=%s", resp.ExchangedAmount, resp.Rate)
+	}
+	if resp.FromBalance.GetAmountMinor() != 3999 || resp.ToBalance.GetAmountMinor() != 922 || resp.ToBalance.GetCurrency() != "EUR" {
+		t.Fatalf("unexpected balances: from=%v to=%v", resp.FromBalance, resp.ToBalance)
+	}
+
+	again, _ := svc.ExchangeCurrency(ctx, req)
+	if again.Transaction.GetTransactionId() != resp.Transaction.GetTransactionId() {
+		t.Fatalf("expected idempotent replay, got=%+v", again)
+	}
+	eur, _ := svc.GetBalance(ctx, &rgsv1.GetBalanceRequest{Meta: meta("acct-fx", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""), AccountId: "acct-fx:EUR"})
+	if eur.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || eur.AvailableBalance.GetAmountMinor() != 922 {
+		t.Fatalf("expected player to read own EUR sub-balance, got=%+v", eur)
+	}
+
+	svc.mu.Lock()
+	postings := svc.postingsByTx[resp.Transaction.TransactionId]
+	svc.mu.Unlock()
+	if len(postings) != 4 || !isBalanced(postings) {
+		t.Fatalf("expected four postings balanced per currency, got=%+v", postings)
+	}
+	var fxLegs int
+	for _, p := range postings {
+		if p.accountID == fxGainLossAccountPrefix+p.currency {
+			fxLegs++
+		}
+	}
+	if fxLegs != 2 {
+		t.Fatalf("expected both currencies to post against fx gain/loss, got=%+v", postings)
+	}
+
+	// Converting back draws from the EUR sub-account into the primary balance.
+	applyConfigValue(t, cfg, FXRateConfigNamespace, "EUR/USD", "1.08")
+	back, _ := svc.ExchangeCurrency(ctx, &rgsv1.ExchangeCurrencyRequest{
+		Meta:       meta("acct-fx", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "fx-2"),
+		AccountId:  "acct-fx",
+		Amount:     &rgsv1.Money{AmountMinor: 922, Currency: "EUR"},
+		ToCurrency: "USD",
+	})
+	if back.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || back.FromBalance.GetAmountMinor() != 0 || back.ToBalance.GetAmountMinor() != 4994 {
+		t.Fatalf("unexpected reverse exchange: %+v", back)
+	}
+}
+
+func TestLedgerExchangeCurrencyRejections(t *testing.T) {
+	svc, cfg := newConfigTestLedger(t)
+	ctx := context.Background()
+	_, _ = svc.Deposit(ctx, &rgsv1.DepositRequest{
+		Meta:      meta("acct-fx2", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "seed"),
+		AccountId: "acct-fx2",
+		Amount:    &rgsv1.Money{AmountMinor: 100, Currency: "USD"},
+	})
+	exchange := func(actor string, amount int64, to, idem string) *rgsv1.ExchangeCurrencyResponse {
+		resp, _ := svc.ExchangeCurrency(ctx, &rgsv1.ExchangeCurrencyRequest{
+			Meta:       meta(actor, rgsv1.ActorType_ACTOR_TYPE_PLAYER, idem),
+			AccountId:  "acct-fx2",
+			Amount:     &rgsv1.Money{AmountMinor: amount, Currency: "USD"},
+			ToCurrency: to,
+		})
+		return resp
+	}
+
+	if resp := exchange("acct-fx2", 50, "EUR", "r-1"); resp.Meta.GetDenialReason() != "no exchange rate for USD/EUR" {
+		t.Fatalf("expected missing rate rejected, got=%+v", resp.Meta)
+	}
+	applyConfigValue(t, cfg, FXRateConfigNamespace, "USD/EUR", "0.92")
+	if resp := exchange("acct-other", 50, "EUR", "r-2"); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected foreign player denied, got=%+v", resp.Meta)
+	}
+	if resp := exchange("acct-fx2", 50, "USD", "r-3"); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected same-currency exchange rejected, got=%+v", resp.Meta)
+	}
+	if resp := exchange("acct-fx2", 500, "EUR", "r-4"); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED || resp.FromBalance.GetAmountMinor() != 100 {
+		t.Fatalf("expected insufficient balance denied, got=%+v", resp)
+	}
+	if resp := exchange("acct-fx2", 1, "EUR", "r-5"); resp.Meta.GetDenialReason() != "amount too small to exchange" {
+		t.Fatalf("expected dust exchange rejected, got=%+v", resp.Meta)
+	}
+}
+
+func TestConfigRejectsMalformedFXRate(t *testing.T) {
+	cfg := NewConfigService(ledgerFixedClock{now: time.Date(2026, 2, 20, 12, 0, 0, 0, time.UTC)})
+	for _, tc := range []struct{ key, value string }{
+		{"USD/EUR", "-0.9"},
+		{"USD/EUR", "1/3"},
+		{"USD-EUR", "0.9"},
+		{"USD/USD", "1"},
+	} {
+		resp, _ := cfg.ProposeConfigChange(context.Background(), &rgsv1.ProposeConfigChangeRequest{
+			Meta:            meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+			ConfigNamespace: FXRateConfigNamespace,
+			ConfigKey:       tc.key,
+			ProposedValue:   tc.value,
+		})
+		if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+			t.Fatalf("expected %s=%s rejected, got=%v", tc.key, tc.value, resp.Meta.GetResultCode())
+		}
+	}
+}
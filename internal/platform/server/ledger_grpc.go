@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"math"
 	"strconv"
 	"sync"
 	"time"
@@ -11,6 +12,7 @@ import (
 	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
 	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
 	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/clock"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/money"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -33,9 +35,14 @@ type LedgerService struct {
 	rgsv1.UnimplementedLedgerServiceServer
 
 	Clock      clock.Clock
-	AuditStore *audit.InMemoryStore
+	AuditStore audit.Store
 
-	mu sync.Mutex
+	// acctLocks serializes mutations per account; mu only guards the
+	// in-memory maps and counters below and is never held across I/O.
+	acctLocks accountLocks
+	mu        sync.Mutex
+	// reconMu serializes bank statement imports and exception resolutions.
+	reconMu sync.Mutex
 
 	accounts               map[string]*ledgerAccount
 	transactionsByAcct     map[string][]*rgsv1.LedgerTransaction
@@ -44,13 +51,29 @@ type LedgerService struct {
 	withdrawByIdempotency  map[string]*rgsv1.WithdrawResponse
 	toDeviceByIdempotency  map[string]*rgsv1.TransferToDeviceResponse
 	toAccountByIdempotency map[string]*rgsv1.TransferToAccountResponse
+	unresolvedTransfers    map[string]*rgsv1.UnresolvedTransfer
+	voidsByOriginal        map[string]*rgsv1.LedgerTransaction
+	exchangeByIdempotency  map[string]*rgsv1.ExchangeCurrencyResponse
+	redeemByIdempotency    map[string]*rgsv1.RedeemVoucherResponse
+	vouchers               map[string]*rgsv1.Voucher
+	bankStatementsByHash   map[string]string
+	bankEntries            []*rgsv1.BankStatementEntry
+	reconMatches           map[string]string
+	reconExceptions        map[string]*rgsv1.ReconciliationException
+	fxRates                FXRateSource
+	balanceCaps            BalanceCapSource
+	selfExclusion          SelfExclusionChecker
+	playerLimits           PlayerLimitSource
+	transferAckTimeout     time.Duration
 	nextTransactionID      int64
 	nextTransferID         int64
+	nextVoucherID          int64
 	nextAuditID            int64
 	eftFraudFailures       map[string]int
 	eftFraudLockedUntil    map[string]time.Time
 	eftFraudMaxFailures    int
 	eftFraudLockoutTTL     time.Duration
+	lockoutNotifier        LockoutNotifier
 	db                     *sql.DB
 	idempotencyTTL         time.Duration
 	disableInMemIdemCache  bool
@@ -63,7 +86,7 @@ func NewLedgerService(clk clock.Clock, db ...*sql.DB) *LedgerService {
 	}
 	return &LedgerService{
 		Clock:                  clk,
-		AuditStore:             audit.NewInMemoryStore(),
+		AuditStore:             NewAuditStore(handle),
 		accounts:               make(map[string]*ledgerAccount),
 		transactionsByAcct:     make(map[string][]*rgsv1.LedgerTransaction),
 		postingsByTx:           make(map[string][]ledgerPosting),
@@ -71,6 +94,15 @@ func NewLedgerService(clk clock.Clock, db ...*sql.DB) *LedgerService {
 		withdrawByIdempotency:  make(map[string]*rgsv1.WithdrawResponse),
 		toDeviceByIdempotency:  make(map[string]*rgsv1.TransferToDeviceResponse),
 		toAccountByIdempotency: make(map[string]*rgsv1.TransferToAccountResponse),
+		unresolvedTransfers:    make(map[string]*rgsv1.UnresolvedTransfer),
+		voidsByOriginal:        make(map[string]*rgsv1.LedgerTransaction),
+		exchangeByIdempotency:  make(map[string]*rgsv1.ExchangeCurrencyResponse),
+		redeemByIdempotency:    make(map[string]*rgsv1.RedeemVoucherResponse),
+		vouchers:               make(map[string]*rgsv1.Voucher),
+		bankStatementsByHash:   make(map[string]string),
+		reconMatches:           make(map[string]string),
+		reconExceptions:        make(map[string]*rgsv1.ReconciliationException),
+		transferAckTimeout:     defaultTransferAckTimeout,
 		eftFraudFailures:       make(map[string]int),
 		eftFraudLockedUntil:    make(map[string]time.Time),
 		eftFraudMaxFailures:    5,
@@ -117,6 +149,38 @@ func (s *LedgerService) SetEFTFraudPolicy(maxFailures int, ttl time.Duration) {
 	s.eftFraudLockoutTTL = ttl
 }
 
+// SetLockoutNotifier sets the notifier told when an account's EFT lockout
+// activates.
+func (s *LedgerService) SetLockoutNotifier(n LockoutNotifier) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lockoutNotifier = n
+}
+
+// eftLockoutActivated records that accountID has just been locked out: the
+// lockout is audited as "eft_lockout" and the notifier, if any, is told.
+func (s *LedgerService) eftLockoutActivated(ctx context.Context, accountID string, lockoutTTL time.Duration) {
+	now := s.now()
+	after, _ := json.Marshal(map[string]string{"locked_until": now.Add(lockoutTTL).Format(time.RFC3339Nano)})
+	_ = s.appendAudit(nil, "ledger_account", accountID, "eft_lockout", []byte(`{}`), after, audit.ResultSuccess, "eft failure limit reached")
+
+	s.mu.Lock()
+	notifier := s.lockoutNotifier
+	s.mu.Unlock()
+	if notifier == nil {
+		return
+	}
+	_ = notifier.NotifyLockout(ctx, LockoutEvent{
+		Kind:        LockoutKindEFT,
+		Subject:     accountID,
+		LockedUntil: now.Add(lockoutTTL),
+		OccurredAt:  now,
+	})
+}
+
 func (s *LedgerService) SetDisableInMemoryIdempotencyCache(disable bool) {
 	if s == nil {
 		return
@@ -177,20 +241,12 @@ func (s *LedgerService) responseMeta(meta *rgsv1.RequestMeta, code rgsv1.ResultC
 }
 
 func invalidAmount(m *rgsv1.Money) bool {
-	if m == nil {
-		return true
-	}
-	if m.AmountMinor <= 0 {
-		return true
-	}
-	return m.Currency == ""
-}
-
-func money(amount int64, currency string) *rgsv1.Money {
-	return &rgsv1.Money{AmountMinor: amount, Currency: currency}
+	return money.ValidatePositive(m) != nil
 }
 
 func (s *LedgerService) accountBalance(accountID string) (available int64, pending int64, currency string, ok bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	acct, found := s.accounts[accountID]
 	if !found {
 		return 0, 0, "", false
@@ -198,17 +254,9 @@ func (s *LedgerService) accountBalance(accountID string) (available int64, pendi
 	return acct.available, acct.pending, acct.currency, true
 }
 
-func (s *LedgerService) getOrCreateAccount(accountID string, currency string) *ledgerAccount {
-	if acct, ok := s.accounts[accountID]; ok {
-		return acct
-	}
-	acct := &ledgerAccount{id: accountID, currency: currency}
-	if s.useInMemoryStateMirror() {
-		s.accounts[accountID] = acct
-	}
-	return acct
-}
-
+// mutationAccountState returns a working copy of the account for a mutation.
+// The caller must hold the account lock; changes to the copy become visible
+// only through commitMutation once the mutation is audited and persisted.
 func (s *LedgerService) mutationAccountState(ctx context.Context, accountID, defaultCurrency string) (*ledgerAccount, error) {
 	if s.dbEnabled() {
 		available, pending, currency, ok, err := s.getBalanceFromDB(ctx, accountID)
@@ -220,11 +268,24 @@ func (s *LedgerService) mutationAccountState(ctx context.Context, accountID, def
 		}
 		acct := &ledgerAccount{id: accountID, currency: currency, available: available, pending: pending}
 		if s.useInMemoryStateMirror() {
-			s.accounts[accountID] = acct
+			s.mu.Lock()
+			mirror := *acct
+			s.accounts[accountID] = &mirror
+			s.mu.Unlock()
 		}
 		return acct, nil
 	}
-	return s.getOrCreateAccount(accountID, defaultCurrency), nil
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	acct, ok := s.accounts[accountID]
+	if !ok {
+		acct = &ledgerAccount{id: accountID, currency: defaultCurrency}
+		if s.useInMemoryStateMirror() {
+			s.accounts[accountID] = acct
+		}
+	}
+	working := *acct
+	return &working, nil
 }
 
 func transactionCopy(in *rgsv1.LedgerTransaction) *rgsv1.LedgerTransaction {
@@ -235,62 +296,85 @@ func transactionCopy(in *rgsv1.LedgerTransaction) *rgsv1.LedgerTransaction {
 	return cp
 }
 
-func (s *LedgerService) appendTransaction(tx *rgsv1.LedgerTransaction) {
+// commitMutation publishes a completed mutation to the in-memory mirror.
+func (s *LedgerService) commitMutation(acct *ledgerAccount, tx *rgsv1.LedgerTransaction, postings []ledgerPosting) {
 	if !s.useInMemoryStateMirror() {
 		return
 	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	committed := *acct
+	s.accounts[acct.id] = &committed
 	s.transactionsByAcct[tx.AccountId] = append(s.transactionsByAcct[tx.AccountId], transactionCopy(tx))
+	s.postingsByTx[tx.TransactionId] = append(s.postingsByTx[tx.TransactionId], postings...)
 }
 
-func (s *LedgerService) rollbackLastTransaction(accountID string) {
-	if !s.useInMemoryStateMirror() {
-		return
-	}
-	txs := s.transactionsByAcct[accountID]
-	if len(txs) == 0 {
-		return
+func cachedLedgerResponse[T proto.Message](s *LedgerService, cache map[string]T, key string) (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	prev, ok := cache[key]
+	if !ok {
+		var zero T
+		return zero, false
 	}
-	s.transactionsByAcct[accountID] = txs[:len(txs)-1]
+	cp, _ := proto.Clone(prev).(T)
+	return cp, true
+}
+
+func cacheLedgerResponse[T proto.Message](s *LedgerService, cache map[string]T, key string, resp T) {
+	cp, _ := proto.Clone(resp).(T)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cache[key] = cp
 }
 
-func (s *LedgerService) nextTxIDLocked() string {
+func (s *LedgerService) newTxID() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	s.nextTransactionID++
 	return "tx-" + strconv.FormatInt(s.nextTransactionID, 10)
 }
 
-func (s *LedgerService) nextTransferIDLocked() string {
+func (s *LedgerService) newTransferID() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	s.nextTransferID++
-	return "tr-" + strconv.FormatInt(s.nextTransferID, 10)
+	return "tr-" + strconv.FormatInt(s.now().UnixNano(), 10) + "-" + strconv.FormatInt(s.nextTransferID, 10)
 }
 
-func (s *LedgerService) nextAuditIDLocked() string {
+func (s *LedgerService) newAuditID() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	s.nextAuditID++
 	return "audit-" + strconv.FormatInt(s.nextAuditID, 10)
 }
 
-func (s *LedgerService) addPostings(txID string, postings []ledgerPosting) bool {
-	if !isBalanced(postings) {
-		return false
-	}
-	if s.useInMemoryStateMirror() {
-		s.postingsByTx[txID] = append(s.postingsByTx[txID], postings...)
-	}
-	return true
-}
-
+// isBalanced reports whether credits equal debits in every currency.
 func isBalanced(postings []ledgerPosting) bool {
-	var total int64
+	totals := money.Totals{}
 	for _, p := range postings {
+		var err error
 		switch p.direction {
 		case "credit":
-			total += p.amount
+			err = totals.Add(p.currency, p.amount)
 		case "debit":
-			total -= p.amount
+			if p.amount == math.MinInt64 {
+				return false
+			}
+			err = totals.Add(p.currency, -p.amount)
 		default:
 			return false
 		}
+		if err != nil {
+			return false
+		}
 	}
-	return total == 0
+	for _, total := range totals {
+		if total != 0 {
+			return false
+		}
+	}
+	return true
 }
 
 func (s *LedgerService) authorize(ctx context.Context, meta *rgsv1.RequestMeta, accountID string) (bool, string) {
@@ -302,6 +386,9 @@ func (s *LedgerService) authorize(ctx context.Context, meta *rgsv1.RequestMeta,
 	case rgsv1.ActorType_ACTOR_TYPE_OPERATOR, rgsv1.ActorType_ACTOR_TYPE_SERVICE:
 		return true, ""
 	case rgsv1.ActorType_ACTOR_TYPE_PLAYER:
+		if base, _, ok := splitCurrencySubAccount(accountID); ok {
+			accountID = base
+		}
 		if accountID != actor.ActorId {
 			return false, "player cannot access another account"
 		}
@@ -326,39 +413,8 @@ func snapshotAccount(acct *ledgerAccount) []byte {
 }
 
 func (s *LedgerService) appendAudit(meta *rgsv1.RequestMeta, objectType, objectID, action string, before, after []byte, result audit.Result, reason string) error {
-	if s.AuditStore == nil {
-		return audit.ErrCorruptChain
-	}
-	actorID := "system"
-	actorType := "service"
-	if meta != nil && meta.Actor != nil {
-		actorID = meta.Actor.ActorId
-		actorType = meta.Actor.ActorType.String()
-	}
-
-	now := s.now()
-	ev := audit.Event{
-		AuditID:      s.nextAuditIDLocked(),
-		OccurredAt:   now,
-		RecordedAt:   now,
-		ActorID:      actorID,
-		ActorType:    actorType,
-		ObjectType:   objectType,
-		ObjectID:     objectID,
-		Action:       action,
-		Before:       before,
-		After:        after,
-		Result:       result,
-		Reason:       reason,
-		PartitionDay: now.Format("2006-01-02"),
-	}
-	if s.dbEnabled() {
-		if err := appendAuditEventToDB(context.Background(), s.db, ev); err != nil {
-			return err
-		}
-	}
-	_, err := s.AuditStore.Append(ev)
-	return err
+	ev := newAuditEvent(meta, s.newAuditID(), s.now(), objectType, objectID, action, before, after, result, reason)
+	return appendAuditTo(context.Background(), s.AuditStore, ev)
 }
 
 func (s *LedgerService) auditDenied(meta *rgsv1.RequestMeta, objectType, objectID, action, reason string) {
@@ -382,6 +438,8 @@ WHERE account_id = $1
 		}
 		return lockedUntil.After(s.now()), nil
 	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	return s.eftFraudLockedUntil[accountID].After(s.now()), nil
 }
 
@@ -389,7 +447,14 @@ func (s *LedgerService) recordEFTFailure(ctx context.Context, accountID string)
 	if accountID == "" {
 		return nil
 	}
+	s.mu.Lock()
+	maxFailures, lockoutTTL := s.eftFraudMaxFailures, s.eftFraudLockoutTTL
+	s.mu.Unlock()
 	if s.dbEnabled() {
+		wasLocked, err := s.eftLocked(ctx, accountID)
+		if err != nil {
+			return err
+		}
 		const q = `
 INSERT INTO ledger_eft_lockouts (account_id, failed_attempts, locked_until, updated_at)
 VALUES ($1, 1, NULL, NOW())
@@ -402,12 +467,29 @@ SET failed_attempts = ledger_eft_lockouts.failed_attempts + 1,
     END,
     updated_at = NOW()
 `
-		_, err := s.db.ExecContext(ctx, q, accountID, s.eftFraudMaxFailures, int(s.eftFraudLockoutTTL.Seconds()))
-		return err
+		if _, err := s.db.ExecContext(ctx, q, accountID, maxFailures, int(lockoutTTL.Seconds())); err != nil {
+			return err
+		}
+		nowLocked, err := s.eftLocked(ctx, accountID)
+		if err != nil {
+			return err
+		}
+		if !wasLocked && nowLocked {
+			s.eftLockoutActivated(ctx, accountID, lockoutTTL)
+		}
+		return nil
 	}
+	s.mu.Lock()
+	now := s.now()
+	wasLocked := s.eftFraudLockedUntil[accountID].After(now)
 	s.eftFraudFailures[accountID]++
-	if s.eftFraudFailures[accountID] >= s.eftFraudMaxFailures {
-		s.eftFraudLockedUntil[accountID] = s.now().Add(s.eftFraudLockoutTTL)
+	if s.eftFraudFailures[accountID] >= maxFailures {
+		s.eftFraudLockedUntil[accountID] = now.Add(lockoutTTL)
+	}
+	activated := !wasLocked && s.eftFraudLockedUntil[accountID].After(now)
+	s.mu.Unlock()
+	if activated {
+		s.eftLockoutActivated(ctx, accountID, lockoutTTL)
 	}
 	return nil
 }
@@ -428,16 +510,19 @@ SET failed_attempts = 0,
 		_, err := s.db.ExecContext(ctx, q, accountID)
 		return err
 	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	delete(s.eftFraudFailures, accountID)
 	delete(s.eftFraudLockedUntil, accountID)
 	return nil
 }
 
 func (s *LedgerService) AuditEvents() []audit.Event {
-	if s.AuditStore == nil {
+	mem := auditMirror(s.AuditStore)
+	if mem == nil {
 		return nil
 	}
-	return s.AuditStore.Events()
+	return mem.Events()
 }
 
 func (s *LedgerService) GetBalance(ctx context.Context, req *rgsv1.GetBalanceRequest) (*rgsv1.GetBalanceResponse, error) {
@@ -449,9 +534,6 @@ func (s *LedgerService) GetBalance(ctx context.Context, req *rgsv1.GetBalanceReq
 		return &rgsv1.GetBalanceResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
 	}
 
-	s.mu.Lock()
-	defer s.mu.Unlock()
-
 	available, pending, currency, ok := s.accountBalance(req.AccountId)
 	if s.dbEnabled() {
 		dbAvailable, dbPending, dbCurrency, dbOK, err := s.getBalanceFromDB(ctx, req.AccountId)
@@ -469,8 +551,8 @@ func (s *LedgerService) GetBalance(ctx context.Context, req *rgsv1.GetBalanceReq
 	return &rgsv1.GetBalanceResponse{
 		Meta:             s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
 		AccountId:        req.AccountId,
-		AvailableBalance: money(available, currency),
-		PendingBalance:   money(pending, currency),
+		AvailableBalance: money.New(available, currency),
+		PendingBalance:   money.New(pending, currency),
 	}, nil
 }
 
@@ -490,8 +572,8 @@ func (s *LedgerService) Deposit(ctx context.Context, req *rgsv1.DepositRequest)
 		return &rgsv1.DepositResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "idempotency_key is required")}, nil
 	}
 
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	unlock := s.acctLocks.lockAll(depositLockAccounts(req.AccountId, req.Amount.Currency)...)
+	defer unlock()
 	locked, err := s.eftLocked(ctx, req.AccountId)
 	if err != nil {
 		return &rgsv1.DepositResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
@@ -500,13 +582,20 @@ func (s *LedgerService) Deposit(ctx context.Context, req *rgsv1.DepositRequest)
 		s.auditDenied(req.Meta, "ledger_account", req.AccountId, "deposit", "eft account locked")
 		return &rgsv1.DepositResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, "eft account locked")}, nil
 	}
+	excluded, err := s.accountSelfExcluded(ctx, req.AccountId)
+	if err != nil {
+		return &rgsv1.DepositResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "self-exclusion unavailable")}, nil
+	}
+	if excluded {
+		s.auditDenied(req.Meta, "ledger_account", req.AccountId, "deposit", selfExcludedReason)
+		return &rgsv1.DepositResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, selfExcludedReason)}, nil
+	}
 
 	key := req.AccountId + "|deposit|" + idem
 	scope := idemScope(req.AccountId, "deposit")
 	requestHash := hashRequest(scope, req.Amount.GetCurrency(), strconv.FormatInt(req.Amount.GetAmountMinor(), 10), req.AuthorizationId)
 	if s.useInMemoryIdempotencyCache() {
-		if prev, ok := s.depositByIdempotency[key]; ok {
-			cp, _ := proto.Clone(prev).(*rgsv1.DepositResponse)
+		if cp, ok := cachedLedgerResponse(s, s.depositByIdempotency, key); ok {
 			return cp, nil
 		}
 	}
@@ -521,7 +610,7 @@ func (s *LedgerService) Deposit(ctx context.Context, req *rgsv1.DepositRequest)
 		}
 		if found {
 			if s.useInMemoryIdempotencyCache() {
-				s.depositByIdempotency[key], _ = proto.Clone(&replay).(*rgsv1.DepositResponse)
+				cacheLedgerResponse(s, s.depositByIdempotency, key, &replay)
 			}
 			return &replay, nil
 		}
@@ -542,15 +631,24 @@ func (s *LedgerService) Deposit(ctx context.Context, req *rgsv1.DepositRequest)
 			resp := &rgsv1.DepositResponse{
 				Meta:             s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
 				Transaction:      tx,
-				AvailableBalance: money(available, currency),
+				AvailableBalance: money.New(available, currency),
 			}
 			if s.useInMemoryIdempotencyCache() {
-				s.depositByIdempotency[key], _ = proto.Clone(resp).(*rgsv1.DepositResponse)
+				cacheLedgerResponse(s, s.depositByIdempotency, key, resp)
 			}
 			return resp, nil
 		}
 	}
 
+	limitReason, err := s.checkDepositLimits(ctx, req.AccountId, req.Amount)
+	if err != nil {
+		return &rgsv1.DepositResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "player limits unavailable")}, nil
+	}
+	if limitReason != "" {
+		s.auditDenied(req.Meta, "ledger_account", req.AccountId, "deposit", limitReason)
+		return &rgsv1.DepositResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, limitReason)}, nil
+	}
+
 	acct, err := s.mutationAccountState(ctx, req.AccountId, req.Amount.Currency)
 	if err != nil {
 		return &rgsv1.DepositResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
@@ -558,54 +656,76 @@ func (s *LedgerService) Deposit(ctx context.Context, req *rgsv1.DepositRequest)
 	if acct.currency != req.Amount.Currency {
 		return &rgsv1.DepositResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "currency mismatch for account")}, nil
 	}
+	limit, err := s.balanceCap(ctx, req.AccountId, req.Amount.Currency)
+	if err != nil {
+		return &rgsv1.DepositResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "balance caps unavailable")}, nil
+	}
+	credit, allowed := limit.admit(acct.available, req.Amount.AmountMinor)
+	if !allowed {
+		s.auditDenied(req.Meta, "ledger_account", req.AccountId, "deposit", "balance cap exceeded")
+		resp := &rgsv1.DepositResponse{
+			Meta:             s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, "balance cap exceeded"),
+			AvailableBalance: money.New(acct.available, acct.currency),
+		}
+		if err := s.persistIdempotencyResponse(ctx, scope, idem, requestHash, resp.Meta.GetResultCode(), resp); err != nil {
+			return &rgsv1.DepositResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+		if s.useInMemoryIdempotencyCache() {
+			cacheLedgerResponse(s, s.depositByIdempotency, key, resp)
+		}
+		return resp, nil
+	}
+	description := "deposit accepted"
+	if credit < req.Amount.AmountMinor {
+		description += " up to balance cap"
+	}
 
 	before := snapshotAccount(acct)
 	now := s.now()
-	txID := s.nextTxIDLocked()
+	txID := s.newTxID()
 	postings := []ledgerPosting{
-		{accountID: "operator_liability", direction: "debit", amount: req.Amount.AmountMinor, currency: req.Amount.Currency, createdAt: now},
-		{accountID: req.AccountId, direction: "credit", amount: req.Amount.AmountMinor, currency: req.Amount.Currency, createdAt: now},
+		{accountID: "operator_liability", direction: "debit", amount: credit, currency: req.Amount.Currency, createdAt: now},
+		{accountID: req.AccountId, direction: "credit", amount: credit, currency: req.Amount.Currency, createdAt: now},
 	}
-	if !s.addPostings(txID, postings) {
+	if !isBalanced(postings) {
 		return &rgsv1.DepositResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "unbalanced postings")}, nil
 	}
 
-	acct.available += req.Amount.AmountMinor
+	if acct.available, err = money.AddMinor(acct.available, credit); err != nil {
+		return &rgsv1.DepositResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "amount exceeds balance limit")}, nil
+	}
 	tx := &rgsv1.LedgerTransaction{
 		TransactionId:   txID,
 		AccountId:       req.AccountId,
 		TransactionType: rgsv1.LedgerTransactionType_LEDGER_TRANSACTION_TYPE_DEPOSIT,
-		Amount:          money(req.Amount.AmountMinor, req.Amount.Currency),
+		Amount:          money.New(credit, req.Amount.Currency),
 		OccurredAt:      now.Format(time.RFC3339Nano),
 		AuthorizationId: req.AuthorizationId,
-		Description:     "deposit accepted",
+		Description:     description,
 	}
-	s.appendTransaction(tx)
 
 	after := snapshotAccount(acct)
 	if err := s.appendAudit(req.Meta, "ledger_account", req.AccountId, "deposit", before, after, audit.ResultSuccess, ""); err != nil {
-		acct.available -= req.Amount.AmountMinor
-		delete(s.postingsByTx, txID)
-		s.rollbackLastTransaction(req.AccountId)
 		return &rgsv1.DepositResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
 	}
 	if err := s.persistLedgerMutation(ctx, tx, postings, "accepted", idem); err != nil {
-		acct.available -= req.Amount.AmountMinor
-		delete(s.postingsByTx, txID)
-		s.rollbackLastTransaction(req.AccountId)
 		return &rgsv1.DepositResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
 	}
+	s.commitMutation(acct, tx, postings)
 
 	resp := &rgsv1.DepositResponse{
 		Meta:             s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
 		Transaction:      tx,
-		AvailableBalance: money(acct.available, acct.currency),
+		AvailableBalance: money.New(acct.available, acct.currency),
+	}
+	if credit < req.Amount.AmountMinor {
+		resp.RefusedAmount = money.New(req.Amount.AmountMinor-credit, req.Amount.Currency)
 	}
 	if err := s.persistIdempotencyResponse(ctx, scope, idem, requestHash, resp.Meta.GetResultCode(), resp); err != nil {
 		return &rgsv1.DepositResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
 	}
 	if s.useInMemoryIdempotencyCache() {
-		s.depositByIdempotency[key], _ = proto.Clone(resp).(*rgsv1.DepositResponse)
+		cacheLedgerResponse(s, s.depositByIdempotency, key, resp)
 	}
 	_ = s.resetEFTFailures(ctx, req.AccountId)
 	return resp, nil
@@ -627,8 +747,8 @@ func (s *LedgerService) Withdraw(ctx context.Context, req *rgsv1.WithdrawRequest
 		return &rgsv1.WithdrawResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "idempotency_key is required")}, nil
 	}
 
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	unlock := s.acctLocks.lock(req.AccountId)
+	defer unlock()
 	locked, err := s.eftLocked(ctx, req.AccountId)
 	if err != nil {
 		return &rgsv1.WithdrawResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
@@ -642,8 +762,7 @@ func (s *LedgerService) Withdraw(ctx context.Context, req *rgsv1.WithdrawRequest
 	scope := idemScope(req.AccountId, "withdraw")
 	requestHash := hashRequest(scope, req.Amount.GetCurrency(), strconv.FormatInt(req.Amount.GetAmountMinor(), 10))
 	if s.useInMemoryIdempotencyCache() {
-		if prev, ok := s.withdrawByIdempotency[key]; ok {
-			cp, _ := proto.Clone(prev).(*rgsv1.WithdrawResponse)
+		if cp, ok := cachedLedgerResponse(s, s.withdrawByIdempotency, key); ok {
 			return cp, nil
 		}
 	}
@@ -658,7 +777,7 @@ func (s *LedgerService) Withdraw(ctx context.Context, req *rgsv1.WithdrawRequest
 		}
 		if found {
 			if s.useInMemoryIdempotencyCache() {
-				s.withdrawByIdempotency[key], _ = proto.Clone(&replay).(*rgsv1.WithdrawResponse)
+				cacheLedgerResponse(s, s.withdrawByIdempotency, key, &replay)
 			}
 			return &replay, nil
 		}
@@ -679,10 +798,10 @@ func (s *LedgerService) Withdraw(ctx context.Context, req *rgsv1.WithdrawRequest
 			resp := &rgsv1.WithdrawResponse{
 				Meta:             s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
 				Transaction:      tx,
-				AvailableBalance: money(available, currency),
+				AvailableBalance: money.New(available, currency),
 			}
 			if s.useInMemoryIdempotencyCache() {
-				s.withdrawByIdempotency[key], _ = proto.Clone(resp).(*rgsv1.WithdrawResponse)
+				cacheLedgerResponse(s, s.withdrawByIdempotency, key, resp)
 			}
 			return resp, nil
 		}
@@ -702,63 +821,59 @@ func (s *LedgerService) Withdraw(ctx context.Context, req *rgsv1.WithdrawRequest
 		s.auditDenied(req.Meta, "ledger_account", req.AccountId, "withdraw", "insufficient balance")
 		resp := &rgsv1.WithdrawResponse{
 			Meta:             s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, "insufficient balance"),
-			AvailableBalance: money(acct.available, acct.currency),
+			AvailableBalance: money.New(acct.available, acct.currency),
 		}
 		if err := s.persistIdempotencyResponse(ctx, scope, idem, requestHash, resp.Meta.GetResultCode(), resp); err != nil {
 			return &rgsv1.WithdrawResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
 		}
 		if s.useInMemoryIdempotencyCache() {
-			s.withdrawByIdempotency[key], _ = proto.Clone(resp).(*rgsv1.WithdrawResponse)
+			cacheLedgerResponse(s, s.withdrawByIdempotency, key, resp)
 		}
 		return resp, nil
 	}
 
 	before := snapshotAccount(acct)
 	now := s.now()
-	txID := s.nextTxIDLocked()
+	txID := s.newTxID()
 	postings := []ledgerPosting{
 		{accountID: req.AccountId, direction: "debit", amount: req.Amount.AmountMinor, currency: req.Amount.Currency, createdAt: now},
 		{accountID: "operator_liability", direction: "credit", amount: req.Amount.AmountMinor, currency: req.Amount.Currency, createdAt: now},
 	}
-	if !s.addPostings(txID, postings) {
+	if !isBalanced(postings) {
 		return &rgsv1.WithdrawResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "unbalanced postings")}, nil
 	}
 
-	acct.available -= req.Amount.AmountMinor
+	if acct.available, err = money.SubMinor(acct.available, req.Amount.AmountMinor); err != nil {
+		return &rgsv1.WithdrawResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "amount exceeds balance limit")}, nil
+	}
 	tx := &rgsv1.LedgerTransaction{
 		TransactionId:   txID,
 		AccountId:       req.AccountId,
 		TransactionType: rgsv1.LedgerTransactionType_LEDGER_TRANSACTION_TYPE_WITHDRAWAL,
-		Amount:          money(req.Amount.AmountMinor, req.Amount.Currency),
+		Amount:          money.New(req.Amount.AmountMinor, req.Amount.Currency),
 		OccurredAt:      now.Format(time.RFC3339Nano),
 		Description:     "withdrawal accepted",
 	}
-	s.appendTransaction(tx)
 
 	after := snapshotAccount(acct)
 	if err := s.appendAudit(req.Meta, "ledger_account", req.AccountId, "withdraw", before, after, audit.ResultSuccess, ""); err != nil {
-		acct.available += req.Amount.AmountMinor
-		delete(s.postingsByTx, txID)
-		s.rollbackLastTransaction(req.AccountId)
 		return &rgsv1.WithdrawResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
 	}
 	if err := s.persistLedgerMutation(ctx, tx, postings, "accepted", idem); err != nil {
-		acct.available += req.Amount.AmountMinor
-		delete(s.postingsByTx, txID)
-		s.rollbackLastTransaction(req.AccountId)
 		return &rgsv1.WithdrawResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
 	}
+	s.commitMutation(acct, tx, postings)
 
 	resp := &rgsv1.WithdrawResponse{
 		Meta:             s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
 		Transaction:      tx,
-		AvailableBalance: money(acct.available, acct.currency),
+		AvailableBalance: money.New(acct.available, acct.currency),
 	}
 	if err := s.persistIdempotencyResponse(ctx, scope, idem, requestHash, resp.Meta.GetResultCode(), resp); err != nil {
 		return &rgsv1.WithdrawResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
 	}
 	if s.useInMemoryIdempotencyCache() {
-		s.withdrawByIdempotency[key], _ = proto.Clone(resp).(*rgsv1.WithdrawResponse)
+		cacheLedgerResponse(s, s.withdrawByIdempotency, key, resp)
 	}
 	_ = s.resetEFTFailures(ctx, req.AccountId)
 	return resp, nil
@@ -780,8 +895,8 @@ func (s *LedgerService) TransferToDevice(ctx context.Context, req *rgsv1.Transfe
 		return &rgsv1.TransferToDeviceResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "idempotency_key is required")}, nil
 	}
 
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	unlock := s.acctLocks.lock(req.AccountId)
+	defer unlock()
 	locked, err := s.eftLocked(ctx, req.AccountId)
 	if err != nil {
 		return &rgsv1.TransferToDeviceResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
@@ -795,8 +910,7 @@ func (s *LedgerService) TransferToDevice(ctx context.Context, req *rgsv1.Transfe
 	scope := idemScope(req.AccountId, "transfer_to_device")
 	requestHash := hashRequest(scope, req.DeviceId, req.RequestedAmount.GetCurrency(), strconv.FormatInt(req.RequestedAmount.GetAmountMinor(), 10))
 	if s.useInMemoryIdempotencyCache() {
-		if prev, ok := s.toDeviceByIdempotency[key]; ok {
-			cp, _ := proto.Clone(prev).(*rgsv1.TransferToDeviceResponse)
+		if cp, ok := cachedLedgerResponse(s, s.toDeviceByIdempotency, key); ok {
 			return cp, nil
 		}
 	}
@@ -811,7 +925,7 @@ func (s *LedgerService) TransferToDevice(ctx context.Context, req *rgsv1.Transfe
 		}
 		if found {
 			if s.useInMemoryIdempotencyCache() {
-				s.toDeviceByIdempotency[key], _ = proto.Clone(&replay).(*rgsv1.TransferToDeviceResponse)
+				cacheLedgerResponse(s, s.toDeviceByIdempotency, key, &replay)
 			}
 			return &replay, nil
 		}
@@ -833,14 +947,14 @@ func (s *LedgerService) TransferToDevice(ctx context.Context, req *rgsv1.Transfe
 		resp := &rgsv1.TransferToDeviceResponse{
 			Meta:              s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, "insufficient balance"),
 			TransferStatus:    rgsv1.TransferStatus_TRANSFER_STATUS_DENIED,
-			TransferredAmount: money(0, acct.currency),
-			AvailableBalance:  money(acct.available, acct.currency),
+			TransferredAmount: money.New(0, acct.currency),
+			AvailableBalance:  money.New(acct.available, acct.currency),
 		}
 		if err := s.persistIdempotencyResponse(ctx, scope, idem, requestHash, resp.Meta.GetResultCode(), resp); err != nil {
 			return &rgsv1.TransferToDeviceResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
 		}
 		if s.useInMemoryIdempotencyCache() {
-			s.toDeviceByIdempotency[key], _ = proto.Clone(resp).(*rgsv1.TransferToDeviceResponse)
+			cacheLedgerResponse(s, s.toDeviceByIdempotency, key, resp)
 		}
 		return resp, nil
 	}
@@ -856,53 +970,51 @@ func (s *LedgerService) TransferToDevice(ctx context.Context, req *rgsv1.Transfe
 
 	before := snapshotAccount(acct)
 	now := s.now()
-	txID := s.nextTxIDLocked()
+	txID := s.newTxID()
 	postings := []ledgerPosting{
 		{accountID: req.AccountId, direction: "debit", amount: transfer, currency: req.RequestedAmount.Currency, createdAt: now},
 		{accountID: "device_escrow:" + req.DeviceId, direction: "credit", amount: transfer, currency: req.RequestedAmount.Currency, createdAt: now},
 	}
-	if !s.addPostings(txID, postings) {
+	if !isBalanced(postings) {
 		return &rgsv1.TransferToDeviceResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "unbalanced postings")}, nil
 	}
-	acct.available -= transfer
+	if acct.available, err = money.SubMinor(acct.available, transfer); err != nil {
+		return &rgsv1.TransferToDeviceResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "amount exceeds balance limit")}, nil
+	}
 
 	tx := &rgsv1.LedgerTransaction{
 		TransactionId:   txID,
 		AccountId:       req.AccountId,
 		TransactionType: rgsv1.LedgerTransactionType_LEDGER_TRANSACTION_TYPE_TRANSFER_TO_DEVICE,
-		Amount:          money(transfer, req.RequestedAmount.Currency),
+		Amount:          money.New(transfer, req.RequestedAmount.Currency),
 		OccurredAt:      now.Format(time.RFC3339Nano),
 		Description:     "transfer to device",
 	}
-	s.appendTransaction(tx)
+	pending := s.newPendingDeviceTransfer(req, tx, reason)
 
 	after := snapshotAccount(acct)
 	if err := s.appendAudit(req.Meta, "ledger_account", req.AccountId, "transfer_to_device", before, after, audit.ResultSuccess, reason); err != nil {
-		acct.available += transfer
-		delete(s.postingsByTx, txID)
-		s.rollbackLastTransaction(req.AccountId)
 		return &rgsv1.TransferToDeviceResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
 	}
-	if err := s.persistLedgerMutation(ctx, tx, postings, "accepted", idem); err != nil {
-		acct.available += transfer
-		delete(s.postingsByTx, txID)
-		s.rollbackLastTransaction(req.AccountId)
+	if err := s.persistDeviceTransfer(ctx, tx, postings, idem, pending); err != nil {
 		return &rgsv1.TransferToDeviceResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
 	}
+	s.commitMutation(acct, tx, postings)
+	s.storeUnresolvedTransfer(pending)
 
 	resp := &rgsv1.TransferToDeviceResponse{
 		Meta:              s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
-		TransferId:        s.nextTransferIDLocked(),
+		TransferId:        pending.TransferId,
 		TransferStatus:    status,
-		TransferredAmount: money(transfer, acct.currency),
-		AvailableBalance:  money(acct.available, acct.currency),
+		TransferredAmount: money.New(transfer, acct.currency),
+		AvailableBalance:  money.New(acct.available, acct.currency),
 		UnresolvedReason:  reason,
 	}
 	if err := s.persistIdempotencyResponse(ctx, scope, idem, requestHash, resp.Meta.GetResultCode(), resp); err != nil {
 		return &rgsv1.TransferToDeviceResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
 	}
 	if s.useInMemoryIdempotencyCache() {
-		s.toDeviceByIdempotency[key], _ = proto.Clone(resp).(*rgsv1.TransferToDeviceResponse)
+		cacheLedgerResponse(s, s.toDeviceByIdempotency, key, resp)
 	}
 	_ = s.resetEFTFailures(ctx, req.AccountId)
 	return resp, nil
@@ -924,8 +1036,8 @@ func (s *LedgerService) TransferToAccount(ctx context.Context, req *rgsv1.Transf
 		return &rgsv1.TransferToAccountResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "idempotency_key is required")}, nil
 	}
 
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	unlock := s.acctLocks.lock(req.AccountId)
+	defer unlock()
 	locked, err := s.eftLocked(ctx, req.AccountId)
 	if err != nil {
 		return &rgsv1.TransferToAccountResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
@@ -939,8 +1051,7 @@ func (s *LedgerService) TransferToAccount(ctx context.Context, req *rgsv1.Transf
 	scope := idemScope(req.AccountId, "transfer_to_account")
 	requestHash := hashRequest(scope, req.Amount.GetCurrency(), strconv.FormatInt(req.Amount.GetAmountMinor(), 10))
 	if s.useInMemoryIdempotencyCache() {
-		if prev, ok := s.toAccountByIdempotency[key]; ok {
-			cp, _ := proto.Clone(prev).(*rgsv1.TransferToAccountResponse)
+		if cp, ok := cachedLedgerResponse(s, s.toAccountByIdempotency, key); ok {
 			return cp, nil
 		}
 	}
@@ -955,7 +1066,7 @@ func (s *LedgerService) TransferToAccount(ctx context.Context, req *rgsv1.Transf
 		}
 		if found {
 			if s.useInMemoryIdempotencyCache() {
-				s.toAccountByIdempotency[key], _ = proto.Clone(&replay).(*rgsv1.TransferToAccountResponse)
+				cacheLedgerResponse(s, s.toAccountByIdempotency, key, &replay)
 			}
 			return &replay, nil
 		}
@@ -976,10 +1087,10 @@ func (s *LedgerService) TransferToAccount(ctx context.Context, req *rgsv1.Transf
 			resp := &rgsv1.TransferToAccountResponse{
 				Meta:             s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
 				Transaction:      tx,
-				AvailableBalance: money(available, currency),
+				AvailableBalance: money.New(available, currency),
 			}
 			if s.useInMemoryIdempotencyCache() {
-				s.toAccountByIdempotency[key], _ = proto.Clone(resp).(*rgsv1.TransferToAccountResponse)
+				cacheLedgerResponse(s, s.toAccountByIdempotency, key, resp)
 			}
 			return resp, nil
 		}
@@ -992,53 +1103,75 @@ func (s *LedgerService) TransferToAccount(ctx context.Context, req *rgsv1.Transf
 	if acct.currency != req.Amount.Currency {
 		return &rgsv1.TransferToAccountResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "currency mismatch for account")}, nil
 	}
+	limit, err := s.balanceCap(ctx, req.AccountId, req.Amount.Currency)
+	if err != nil {
+		return &rgsv1.TransferToAccountResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "balance caps unavailable")}, nil
+	}
+	credit, allowed := limit.admit(acct.available, req.Amount.AmountMinor)
+	if !allowed {
+		s.auditDenied(req.Meta, "ledger_account", req.AccountId, "transfer_to_account", "balance cap exceeded")
+		resp := &rgsv1.TransferToAccountResponse{
+			Meta:             s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, "balance cap exceeded"),
+			AvailableBalance: money.New(acct.available, acct.currency),
+		}
+		if err := s.persistIdempotencyResponse(ctx, scope, idem, requestHash, resp.Meta.GetResultCode(), resp); err != nil {
+			return &rgsv1.TransferToAccountResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+		if s.useInMemoryIdempotencyCache() {
+			cacheLedgerResponse(s, s.toAccountByIdempotency, key, resp)
+		}
+		return resp, nil
+	}
+	description := "transfer to account"
+	if credit < req.Amount.AmountMinor {
+		description += " up to balance cap"
+	}
 
 	before := snapshotAccount(acct)
 	now := s.now()
-	txID := s.nextTxIDLocked()
+	txID := s.newTxID()
 	postings := []ledgerPosting{
-		{accountID: "device_escrow", direction: "debit", amount: req.Amount.AmountMinor, currency: req.Amount.Currency, createdAt: now},
-		{accountID: req.AccountId, direction: "credit", amount: req.Amount.AmountMinor, currency: req.Amount.Currency, createdAt: now},
+		{accountID: "device_escrow", direction: "debit", amount: credit, currency: req.Amount.Currency, createdAt: now},
+		{accountID: req.AccountId, direction: "credit", amount: credit, currency: req.Amount.Currency, createdAt: now},
 	}
-	if !s.addPostings(txID, postings) {
+	if !isBalanced(postings) {
 		return &rgsv1.TransferToAccountResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "unbalanced postings")}, nil
 	}
-	acct.available += req.Amount.AmountMinor
+	if acct.available, err = money.AddMinor(acct.available, credit); err != nil {
+		return &rgsv1.TransferToAccountResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "amount exceeds balance limit")}, nil
+	}
 
 	tx := &rgsv1.LedgerTransaction{
 		TransactionId:   txID,
 		AccountId:       req.AccountId,
 		TransactionType: rgsv1.LedgerTransactionType_LEDGER_TRANSACTION_TYPE_TRANSFER_TO_ACCOUNT,
-		Amount:          money(req.Amount.AmountMinor, req.Amount.Currency),
+		Amount:          money.New(credit, req.Amount.Currency),
 		OccurredAt:      now.Format(time.RFC3339Nano),
-		Description:     "transfer to account",
+		Description:     description,
 	}
-	s.appendTransaction(tx)
 
 	after := snapshotAccount(acct)
 	if err := s.appendAudit(req.Meta, "ledger_account", req.AccountId, "transfer_to_account", before, after, audit.ResultSuccess, ""); err != nil {
-		acct.available -= req.Amount.AmountMinor
-		delete(s.postingsByTx, txID)
-		s.rollbackLastTransaction(req.AccountId)
 		return &rgsv1.TransferToAccountResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
 	}
 	if err := s.persistLedgerMutation(ctx, tx, postings, "accepted", idem); err != nil {
-		acct.available -= req.Amount.AmountMinor
-		delete(s.postingsByTx, txID)
-		s.rollbackLastTransaction(req.AccountId)
 		return &rgsv1.TransferToAccountResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
 	}
+	s.commitMutation(acct, tx, postings)
 
 	resp := &rgsv1.TransferToAccountResponse{
 		Meta:             s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
 		Transaction:      tx,
-		AvailableBalance: money(acct.available, acct.currency),
+		AvailableBalance: money.New(acct.available, acct.currency),
+	}
+	if credit < req.Amount.AmountMinor {
+		resp.RefusedAmount = money.New(req.Amount.AmountMinor-credit, req.Amount.Currency)
 	}
 	if err := s.persistIdempotencyResponse(ctx, scope, idem, requestHash, resp.Meta.GetResultCode(), resp); err != nil {
 		return &rgsv1.TransferToAccountResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
 	}
 	if s.useInMemoryIdempotencyCache() {
-		s.toAccountByIdempotency[key], _ = proto.Clone(resp).(*rgsv1.TransferToAccountResponse)
+		cacheLedgerResponse(s, s.toAccountByIdempotency, key, resp)
 	}
 	_ = s.resetEFTFailures(ctx, req.AccountId)
 	return resp, nil
@@ -1053,10 +1186,6 @@ func (s *LedgerService) ListTransactions(ctx context.Context, req *rgsv1.ListTra
 		return &rgsv1.ListTransactionsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
 	}
 
-	s.mu.Lock()
-	defer s.mu.Unlock()
-
-	txs := s.transactionsByAcct[req.AccountId]
 	if s.dbEnabled() {
 		start := 0
 		if req.PageToken != "" {
@@ -1084,6 +1213,9 @@ func (s *LedgerService) ListTransactions(ctx context.Context, req *rgsv1.ListTra
 			}, nil
 		}
 	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	txs := s.transactionsByAcct[req.AccountId]
 	start := 0
 	if req.PageToken != "" {
 		if parsed, err := strconv.Atoi(req.PageToken); err == nil && parsed >= 0 {
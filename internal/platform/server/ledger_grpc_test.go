@@ -2,7 +2,9 @@ package server
 
 import (
 	"context"
+	"math"
 	"strconv"
+	"sync"
 	"testing"
 	"time"
 
@@ -223,3 +225,110 @@ func TestLedgerEFTFraudLockoutAfterRepeatedFailures(t *testing.T) {
 		t.Fatalf("expected eft account locked reason, got=%q", locked.Meta.GetDenialReason())
 	}
 }
+
+func TestLedgerMutationOnOneAccountDoesNotBlockOthers(t *testing.T) {
+	svc := NewLedgerService(ledgerFixedClock{now: time.Date(2026, 2, 11, 15, 0, 0, 0, time.UTC)})
+	busy, free := "acct-busy", "acct-free"
+	for i := 0; svc.acctLocks.shard(busy) == svc.acctLocks.shard(free); i++ {
+		free = "acct-free-" + strconv.Itoa(i)
+	}
+
+	unlock := svc.acctLocks.lock(busy)
+	defer unlock()
+
+	done := make(chan *rgsv1.DepositResponse, 1)
+	go func() {
+		resp, _ := svc.Deposit(context.Background(), &rgsv1.DepositRequest{
+			Meta:      meta(free, rgsv1.ActorType_ACTOR_TYPE_PLAYER, "idem-free"),
+			AccountId: free,
+			Amount:    &rgsv1.Money{AmountMinor: 100, Currency: "USD"},
+		})
+		done <- resp
+	}()
+	select {
+	case resp := <-done:
+		if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("expected deposit ok, got=%v", resp.Meta.GetResultCode())
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatalf("deposit on %s blocked behind lock held for %s", free, busy)
+	}
+}
+
+func TestLedgerConcurrentMutationsKeepBalancesConsistent(t *testing.T) {
+	svc := NewLedgerService(ledgerFixedClock{now: time.Date(2026, 2, 11, 15, 0, 0, 0, time.UTC)})
+	ctx := context.Background()
+	const accounts, depositsPerAccount = 8, 25
+
+	var wg sync.WaitGroup
+	for a := 0; a < accounts; a++ {
+		accountID := "acct-par-" + strconv.Itoa(a)
+		for i := 0; i < depositsPerAccount; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				_, _ = svc.Deposit(ctx, &rgsv1.DepositRequest{
+					Meta:      meta(accountID, rgsv1.ActorType_ACTOR_TYPE_PLAYER, "idem-par-"+strconv.Itoa(i)),
+					AccountId: accountID,
+					Amount:    &rgsv1.Money{AmountMinor: 10, Currency: "USD"},
+				})
+			}(i)
+		}
+	}
+	wg.Wait()
+
+	for a := 0; a < accounts; a++ {
+		accountID := "acct-par-" + strconv.Itoa(a)
+		bal, _ := svc.GetBalance(ctx, &rgsv1.GetBalanceRequest{Meta: meta(accountID, rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""), AccountId: accountID})
+		if bal.AvailableBalance.GetAmountMinor() != 10*depositsPerAccount {
+			t.Fatalf("expected %s balance %d, got=%d", accountID, 10*depositsPerAccount, bal.AvailableBalance.GetAmountMinor())
+		}
+		txs, _ := svc.ListTransactions(ctx, &rgsv1.ListTransactionsRequest{Meta: meta(accountID, rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""), AccountId: accountID, PageSize: 100})
+		if len(txs.Transactions) != depositsPerAccount {
+			t.Fatalf("expected %d transactions for %s, got=%d", depositsPerAccount, accountID, len(txs.Transactions))
+		}
+	}
+}
+
+func TestLedgerDepositRejectsBalanceOverflow(t *testing.T) {
+	svc := NewLedgerService(ledgerFixedClock{now: time.Date(2026, 2, 11, 15, 0, 0, 0, time.UTC)})
+	ctx := context.Background()
+
+	if _, err := svc.Deposit(ctx, &rgsv1.DepositRequest{
+		Meta:      meta("acct-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "idem-overflow-1"),
+		AccountId: "acct-1",
+		Amount:    &rgsv1.Money{AmountMinor: math.MaxInt64 - 10, Currency: "USD"},
+	}); err != nil {
+		t.Fatalf("seed deposit err: %v", err)
+	}
+	resp, err := svc.Deposit(ctx, &rgsv1.DepositRequest{
+		Meta:      meta("acct-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "idem-overflow-2"),
+		AccountId: "acct-1",
+		Amount:    &rgsv1.Money{AmountMinor: 11, Currency: "USD"},
+	})
+	if err != nil {
+		t.Fatalf("overflow deposit err: %v", err)
+	}
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID || resp.Meta.GetDenialReason() != "amount exceeds balance limit" {
+		t.Fatalf("expected overflow rejected, got=%v reason=%q", resp.Meta.GetResultCode(), resp.Meta.GetDenialReason())
+	}
+	bal, _ := svc.GetBalance(ctx, &rgsv1.GetBalanceRequest{Meta: meta("acct-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""), AccountId: "acct-1"})
+	if bal.AvailableBalance.GetAmountMinor() != math.MaxInt64-10 {
+		t.Fatalf("expected balance unchanged, got=%d", bal.AvailableBalance.GetAmountMinor())
+	}
+}
+
+func TestLedgerDepositRejectsMalformedCurrency(t *testing.T) {
+	svc := NewLedgerService(ledgerFixedClock{now: time.Date(2026, 2, 11, 15, 0, 0, 0, time.UTC)})
+	resp, err := svc.Deposit(context.Background(), &rgsv1.DepositRequest{
+		Meta:      meta("acct-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "idem-currency-1"),
+		AccountId: "acct-1",
+		Amount:    &rgsv1.Money{AmountMinor: 100, Currency: "usd"},
+	})
+	if err != nil {
+		t.Fatalf("deposit err: %v", err)
+	}
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected invalid for lowercase currency, got=%v", resp.Meta.GetResultCode())
+	}
+}
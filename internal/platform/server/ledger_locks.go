@@ -0,0 +1,53 @@
+package server
+
+import (
+	"hash/fnv"
+	"sync"
+)
+
+const ledgerAccountLockShards = 64
+
+// accountLocks serializes mutations per account without a service-wide lock.
+// Accounts hash onto a fixed set of shards, so two accounts may share a shard
+// but a slow mutation on one shard never blocks the others.
+type accountLocks struct {
+	shards [ledgerAccountLockShards]sync.Mutex
+}
+
+func (l *accountLocks) shardIndex(accountID string) uint32 {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(accountID))
+	return h.Sum32() % ledgerAccountLockShards
+}
+
+func (l *accountLocks) shard(accountID string) *sync.Mutex {
+	return &l.shards[l.shardIndex(accountID)]
+}
+
+// lock acquires the shard for accountID and returns its release func.
+func (l *accountLocks) lock(accountID string) func() {
+	m := l.shard(accountID)
+	m.Lock()
+	return m.Unlock
+}
+
+// lockAll acquires the shards for several accounts in shard order, taking
+// each shard once, so multi-account mutations cannot deadlock each other.
+func (l *accountLocks) lockAll(accountIDs ...string) func() {
+	var want [ledgerAccountLockShards]bool
+	for _, id := range accountIDs {
+		want[l.shardIndex(id)] = true
+	}
+	held := make([]*sync.Mutex, 0, len(accountIDs))
+	for i, ok := range want {
+		if ok {
+			l.shards[i].Lock()
+			held = append(held, &l.shards[i])
+		}
+	}
+	return func() {
+		for i := len(held) - 1; i >= 0; i-- {
+			held[i].Unlock()
+		}
+	}
+}
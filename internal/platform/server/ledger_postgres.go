@@ -6,10 +6,13 @@ import (
 	"crypto/sha256"
 	"database/sql"
 	"errors"
+	"fmt"
 	"strings"
 	"time"
 
 	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/money"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 )
@@ -21,15 +24,13 @@ func (s *LedgerService) dbEnabled() bool {
 }
 
 func (s *LedgerService) ensureLedgerAccountTx(ctx context.Context, tx *sql.Tx, accountID, currency string) error {
-	accountType := "player_cashless"
-	playerID := accountID
-	if accountID == "operator_liability" {
-		accountType = "operator_liability"
-		playerID = ""
+	accountType := ledgerAccountType(accountID)
+	playerID := ""
+	if accountType == "player_cashless" {
+		playerID = accountID
 	}
-	if strings.HasPrefix(accountID, "device_escrow") {
-		accountType = "device_escrow"
-		playerID = ""
+	if base, _, ok := splitCurrencySubAccount(accountID); ok && accountType == "player_cashless" {
+		playerID = base
 	}
 	const q = `
 INSERT INTO ledger_accounts (account_id, player_id, account_type, status, currency_code)
@@ -55,7 +56,15 @@ func (s *LedgerService) persistLedgerMutation(ctx context.Context, txRecord *rgs
 	defer func() {
 		_ = dbtx.Rollback()
 	}()
+	if err := s.persistLedgerMutationTx(ctx, dbtx, txRecord, postings, status, idemKey); err != nil {
+		return err
+	}
+	return dbtx.Commit()
+}
 
+// persistLedgerMutationTx writes the transaction, its postings, balance
+// adjustments, and outbox event inside the caller's transaction.
+func (s *LedgerService) persistLedgerMutationTx(ctx context.Context, dbtx *sql.Tx, txRecord *rgsv1.LedgerTransaction, postings []ledgerPosting, status string, idemKey string) error {
 	for _, p := range postings {
 		if err := s.ensureLedgerAccountTx(ctx, dbtx, p.accountID, p.currency); err != nil {
 			return err
@@ -75,7 +84,7 @@ ON CONFLICT (transaction_id) DO NOTHING
 	if occurred == "" {
 		occurred = time.Now().UTC().Format(time.RFC3339Nano)
 	}
-	_, err = dbtx.ExecContext(ctx, insTx,
+	_, err := dbtx.ExecContext(ctx, insTx,
 		txRecord.TransactionId,
 		"", // request_id currently not materialized per-op
 		idemKey,
@@ -129,10 +138,7 @@ WHERE account_id = $1
 		}
 	}
 
-	if err := dbtx.Commit(); err != nil {
-		return err
-	}
-	return nil
+	return insertOutboxEventTx(ctx, dbtx, "ledger_transaction", txRecord.TransactionId, "ledger."+ledgerTxTypeToDB(txRecord.TransactionType), txRecord)
 }
 
 func (s *LedgerService) getBalanceFromDB(ctx context.Context, accountID string) (int64, int64, string, bool, error) {
@@ -161,7 +167,8 @@ func (s *LedgerService) listTransactionsFromDB(ctx context.Context, accountID st
 		return nil, nil
 	}
 	const q = `
-SELECT transaction_id, account_id, transaction_type::text, amount_minor, currency_code, occurred_at, authorization_id
+SELECT transaction_id, account_id, transaction_type::text, amount_minor, currency_code, occurred_at, authorization_id,
+       COALESCE(voids_transaction_id, '')
 FROM ledger_transactions
 WHERE account_id = $1
 ORDER BY recorded_at DESC
@@ -175,18 +182,19 @@ LIMIT $2 OFFSET $3
 
 	out := make([]*rgsv1.LedgerTransaction, 0)
 	for rows.Next() {
-		var txID, acctID, typ, currency, occurred, authID string
+		var txID, acctID, typ, currency, occurred, authID, voids string
 		var amount int64
-		if err := rows.Scan(&txID, &acctID, &typ, &amount, &currency, &occurred, &authID); err != nil {
+		if err := rows.Scan(&txID, &acctID, &typ, &amount, &currency, &occurred, &authID, &voids); err != nil {
 			return nil, err
 		}
 		out = append(out, &rgsv1.LedgerTransaction{
-			TransactionId:   txID,
-			AccountId:       acctID,
-			TransactionType: ledgerTxTypeFromDB(typ),
-			Amount:          money(amount, currency),
-			OccurredAt:      occurred,
-			AuthorizationId: authID,
+			TransactionId:      txID,
+			AccountId:          acctID,
+			TransactionType:    ledgerTxTypeFromDB(typ),
+			Amount:             money.New(amount, currency),
+			OccurredAt:         occurred,
+			AuthorizationId:    authID,
+			VoidsTransactionId: voids,
 		})
 	}
 	return out, rows.Err()
@@ -221,7 +229,7 @@ LIMIT 1
 		TransactionId:   txID,
 		AccountId:       acctID,
 		TransactionType: ledgerTxTypeFromDB(typ),
-		Amount:          money(amount, currency),
+		Amount:          money.New(amount, currency),
 		OccurredAt:      occurred.UTC().Format(time.RFC3339Nano),
 		AuthorizationId: authID,
 	}, true, nil
@@ -285,8 +293,13 @@ ON CONFLICT (scope, idempotency_key) DO NOTHING
 }
 
 func (s *LedgerService) CleanupExpiredIdempotencyKeys(ctx context.Context, batchSize int) (int64, error) {
+	b, err := s.cleanupExpiredIdempotencyKeysBatch(ctx, batchSize)
+	return b.affected, err
+}
+
+func (s *LedgerService) cleanupExpiredIdempotencyKeysBatch(ctx context.Context, batchSize int) (workerBatch, error) {
 	if !s.dbEnabled() {
-		return 0, nil
+		return workerBatch{}, nil
 	}
 	if batchSize <= 0 {
 		batchSize = 500
@@ -298,65 +311,61 @@ WITH doomed AS (
   WHERE expires_at <= NOW()
   ORDER BY expires_at ASC
   LIMIT $1
+), deleted AS (
+  DELETE FROM ledger_idempotency_keys
+  WHERE ctid IN (SELECT ctid FROM doomed)
+  RETURNING expires_at
 )
-DELETE FROM ledger_idempotency_keys
-WHERE ctid IN (SELECT ctid FROM doomed)
+SELECT COUNT(*), COALESCE(MIN(expires_at), to_timestamp(0)), COALESCE(MAX(expires_at), to_timestamp(0))
+FROM deleted
 `
-	res, err := s.db.ExecContext(ctx, q, batchSize)
-	if err != nil {
-		return 0, err
+	var b workerBatch
+	if err := s.db.QueryRowContext(ctx, q, batchSize).Scan(&b.affected, &b.from, &b.to); err != nil {
+		return workerBatch{}, err
 	}
-	return res.RowsAffected()
+	return b, nil
 }
 
-func (s *LedgerService) StartIdempotencyCleanupWorker(
-	ctx context.Context,
-	interval time.Duration,
-	batchSize int,
-	logger func(string, ...any),
-	observer func(deleted int64, err error),
-) {
-	if !s.dbEnabled() || interval <= 0 {
-		return
-	}
+// IdempotencyCleanupJob deletes expired idempotency keys in batches and
+// audits what it removed under the system actor. observer is called after
+// every batch.
+func (s *LedgerService) IdempotencyCleanupJob(batchSize int, observer func(deleted int64, err error)) JobFunc {
 	if batchSize <= 0 {
 		batchSize = 500
 	}
-	go func() {
-		ticker := time.NewTicker(interval)
-		defer ticker.Stop()
+	return func(ctx context.Context, _ string) (string, error) {
+		summary := newWorkerAuditSummary("ledger_idempotency_cleanup", "expires_at")
 		for {
-			select {
-			case <-ctx.Done():
-				return
-			case <-ticker.C:
-				for {
-					deleted, err := s.CleanupExpiredIdempotencyKeys(ctx, batchSize)
-					if err != nil {
-						if observer != nil {
-							observer(0, err)
-						}
-						if logger != nil {
-							logger("ledger idempotency cleanup failed: %v", err)
-						}
-						break
-					}
-					if observer != nil {
-						observer(deleted, nil)
-					}
-					if deleted == 0 {
-						break
-					}
-					if logger != nil {
-						logger("ledger idempotency cleanup removed %d expired keys", deleted)
-					}
-					if deleted < int64(batchSize) {
-						break
-					}
+			b, err := s.cleanupExpiredIdempotencyKeysBatch(ctx, batchSize)
+			if observer != nil {
+				observer(b.affected, err)
+			}
+			if err != nil {
+				if auditErr := s.auditWorkerRun(summary); auditErr != nil {
+					return "", errors.Join(err, auditErr)
 				}
+				return "", err
+			}
+			summary.add(b)
+			if b.affected < int64(batchSize) {
+				break
 			}
 		}
-	}()
+		if err := s.auditWorkerRun(summary); err != nil {
+			return "", fmt.Errorf("audit unavailable: %w", err)
+		}
+		if summary.Affected == 0 {
+			return "", nil
+		}
+		return fmt.Sprintf("removed %d expired keys", summary.Affected), nil
+	}
+}
+
+func (s *LedgerService) auditWorkerRun(summary *workerAuditSummary) error {
+	if summary.Affected == 0 {
+		return nil
+	}
+	return s.appendAudit(nil, "ledger_idempotency_key", "ledger_idempotency_keys", summary.Job, []byte(`{}`), summary.snapshot(), audit.ResultSuccess, "")
 }
 
 func ledgerTxTypeToDB(v rgsv1.LedgerTransactionType) string {
@@ -375,6 +384,12 @@ func ledgerTxTypeToDB(v rgsv1.LedgerTransactionType) string {
 		return "gameplay_credit"
 	case rgsv1.LedgerTransactionType_LEDGER_TRANSACTION_TYPE_MANUAL_ADJUSTMENT:
 		return "manual_adjustment"
+	case rgsv1.LedgerTransactionType_LEDGER_TRANSACTION_TYPE_VOID:
+		return "void"
+	case rgsv1.LedgerTransactionType_LEDGER_TRANSACTION_TYPE_CURRENCY_EXCHANGE:
+		return "currency_exchange"
+	case rgsv1.LedgerTransactionType_LEDGER_TRANSACTION_TYPE_VOUCHER_REDEMPTION:
+		return "voucher_redemption"
 	default:
 		return "manual_adjustment"
 	}
@@ -396,7 +411,546 @@ func ledgerTxTypeFromDB(v string) rgsv1.LedgerTransactionType {
 		return rgsv1.LedgerTransactionType_LEDGER_TRANSACTION_TYPE_GAMEPLAY_CREDIT
 	case "manual_adjustment":
 		return rgsv1.LedgerTransactionType_LEDGER_TRANSACTION_TYPE_MANUAL_ADJUSTMENT
+	case "void":
+		return rgsv1.LedgerTransactionType_LEDGER_TRANSACTION_TYPE_VOID
+	case "currency_exchange":
+		return rgsv1.LedgerTransactionType_LEDGER_TRANSACTION_TYPE_CURRENCY_EXCHANGE
+	case "voucher_redemption":
+		return rgsv1.LedgerTransactionType_LEDGER_TRANSACTION_TYPE_VOUCHER_REDEMPTION
 	default:
 		return rgsv1.LedgerTransactionType_LEDGER_TRANSACTION_TYPE_UNSPECIFIED
 	}
 }
+
+var errTransferNotOpen = errors.New("transfer is not open")
+
+func unresolvedTransferStatusToDB(v rgsv1.UnresolvedTransferStatus) string {
+	switch v {
+	case rgsv1.UnresolvedTransferStatus_UNRESOLVED_TRANSFER_STATUS_RESOLVED:
+		return "resolved"
+	case rgsv1.UnresolvedTransferStatus_UNRESOLVED_TRANSFER_STATUS_CANCELLED:
+		return "cancelled"
+	default:
+		return "open"
+	}
+}
+
+func unresolvedTransferStatusFromDB(v string) rgsv1.UnresolvedTransferStatus {
+	switch v {
+	case "open":
+		return rgsv1.UnresolvedTransferStatus_UNRESOLVED_TRANSFER_STATUS_OPEN
+	case "resolved":
+		return rgsv1.UnresolvedTransferStatus_UNRESOLVED_TRANSFER_STATUS_RESOLVED
+	case "cancelled":
+		return rgsv1.UnresolvedTransferStatus_UNRESOLVED_TRANSFER_STATUS_CANCELLED
+	default:
+		return rgsv1.UnresolvedTransferStatus_UNRESOLVED_TRANSFER_STATUS_UNSPECIFIED
+	}
+}
+
+// persistDeviceTransfer records a transfer to device and its open
+// acknowledgment record atomically.
+func (s *LedgerService) persistDeviceTransfer(ctx context.Context, txRecord *rgsv1.LedgerTransaction, postings []ledgerPosting, idemKey string, transfer *rgsv1.UnresolvedTransfer) error {
+	if !s.dbEnabled() {
+		return nil
+	}
+	dbtx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = dbtx.Rollback()
+	}()
+	if err := s.persistLedgerMutationTx(ctx, dbtx, txRecord, postings, "accepted", idemKey); err != nil {
+		return err
+	}
+	const q = `
+INSERT INTO cashless_unresolved_transfers (
+  transfer_id, account_id, device_id, requested_amount_minor, transferred_amount_minor, currency_code,
+  status, reason, transaction_id, created_at, ack_deadline_at, attempts
+)
+VALUES ($1,$2,$3,$4,$5,$6,'open'::unresolved_transfer_status,$7,$8,$9::timestamptz,$10::timestamptz,$11)
+`
+	_, err = dbtx.ExecContext(ctx, q,
+		transfer.TransferId,
+		transfer.AccountId,
+		transfer.DeviceId,
+		transfer.RequestedAmount.GetAmountMinor(),
+		transfer.TransferredAmount.GetAmountMinor(),
+		strings.ToUpper(transfer.TransferredAmount.GetCurrency()),
+		transfer.Reason,
+		transfer.TransactionId,
+		nonEmptyTime(transfer.CreatedAt),
+		nonEmptyTime(transfer.AckDeadlineAt),
+		transfer.Attempts,
+	)
+	if err != nil {
+		return err
+	}
+	return dbtx.Commit()
+}
+
+func updateUnresolvedTransferTx(ctx context.Context, dbtx *sql.Tx, transfer *rgsv1.UnresolvedTransfer) error {
+	const q = `
+UPDATE cashless_unresolved_transfers
+SET status = $2::unresolved_transfer_status,
+    ack_deadline_at = $3::timestamptz,
+    attempts = $4,
+    resolved_at = NULLIF($5,'')::timestamptz,
+    resolution = $6,
+    resolution_note = $7,
+    reversal_transaction_id = NULLIF($8,'')
+WHERE transfer_id = $1 AND status = 'open'::unresolved_transfer_status
+`
+	res, err := dbtx.ExecContext(ctx, q,
+		transfer.TransferId,
+		unresolvedTransferStatusToDB(transfer.Status),
+		nonEmptyTime(transfer.AckDeadlineAt),
+		transfer.Attempts,
+		transfer.ResolvedAt,
+		transfer.Resolution,
+		transfer.ResolutionNote,
+		transfer.ReversalTransactionId,
+	)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return errTransferNotOpen
+	}
+	return nil
+}
+
+// persistTransferResolution updates an open transfer. It fails with
+// errTransferNotOpen if another replica closed it first.
+func (s *LedgerService) persistTransferResolution(ctx context.Context, transfer *rgsv1.UnresolvedTransfer) error {
+	if !s.dbEnabled() {
+		return nil
+	}
+	dbtx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = dbtx.Rollback()
+	}()
+	if err := updateUnresolvedTransferTx(ctx, dbtx, transfer); err != nil {
+		return err
+	}
+	return dbtx.Commit()
+}
+
+// persistTransferReversal returns escrowed funds to the account, marks the
+// original transfer transaction reversed, and closes the transfer atomically.
+func (s *LedgerService) persistTransferReversal(ctx context.Context, txRecord *rgsv1.LedgerTransaction, postings []ledgerPosting, transfer *rgsv1.UnresolvedTransfer) error {
+	if !s.dbEnabled() {
+		return nil
+	}
+	dbtx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = dbtx.Rollback()
+	}()
+	if err := updateUnresolvedTransferTx(ctx, dbtx, transfer); err != nil {
+		return err
+	}
+	if err := s.persistLedgerMutationTx(ctx, dbtx, txRecord, postings, "accepted", "reversal:"+transfer.TransferId); err != nil {
+		return err
+	}
+	const markReversed = `
+UPDATE ledger_transactions
+SET status = 'reversed'::ledger_transaction_status
+WHERE transaction_id = $1
+`
+	if _, err := dbtx.ExecContext(ctx, markReversed, transfer.TransactionId); err != nil {
+		return err
+	}
+	return dbtx.Commit()
+}
+
+var errTransactionNotVoidable = errors.New("transaction is not voidable")
+
+const ledgerTransactionColumns = `
+transaction_id, account_id, transaction_type::text, status::text, amount_minor, currency_code,
+occurred_at, authorization_id, COALESCE(voids_transaction_id, '')
+`
+
+func scanLedgerTransaction(row wagerScanner) (*rgsv1.LedgerTransaction, string, error) {
+	var (
+		tx                    rgsv1.LedgerTransaction
+		typ, status, currency string
+		amount                int64
+		occurred              time.Time
+	)
+	if err := row.Scan(&tx.TransactionId, &tx.AccountId, &typ, &status, &amount, &currency, &occurred, &tx.AuthorizationId, &tx.VoidsTransactionId); err != nil {
+		return nil, "", err
+	}
+	tx.TransactionType = ledgerTxTypeFromDB(typ)
+	tx.Amount = money.New(amount, strings.TrimSpace(currency))
+	tx.OccurredAt = occurred.UTC().Format(time.RFC3339Nano)
+	return &tx, status, nil
+}
+
+// getLedgerTransactionFromDB returns a transaction, its status, and postings.
+func (s *LedgerService) getLedgerTransactionFromDB(ctx context.Context, txID string) (*rgsv1.LedgerTransaction, string, []ledgerPosting, error) {
+	if !s.dbEnabled() {
+		return nil, "", nil, nil
+	}
+	q := `SELECT ` + ledgerTransactionColumns + ` FROM ledger_transactions WHERE transaction_id = $1`
+	tx, status, err := scanLedgerTransaction(s.db.QueryRowContext(ctx, q, txID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, "", nil, nil
+	}
+	if err != nil {
+		return nil, "", nil, err
+	}
+	const postingsQ = `
+SELECT account_id, direction::text, amount_minor, currency_code, created_at
+FROM ledger_postings
+WHERE transaction_id = $1
+ORDER BY posting_id ASC
+`
+	rows, err := s.db.QueryContext(ctx, postingsQ, txID)
+	if err != nil {
+		return nil, "", nil, err
+	}
+	defer rows.Close()
+	postings := make([]ledgerPosting, 0, 2)
+	for rows.Next() {
+		var p ledgerPosting
+		if err := rows.Scan(&p.accountID, &p.direction, &p.amount, &p.currency, &p.createdAt); err != nil {
+			return nil, "", nil, err
+		}
+		p.currency = strings.TrimSpace(p.currency)
+		postings = append(postings, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", nil, err
+	}
+	return tx, status, postings, nil
+}
+
+func (s *LedgerService) getVoidTransactionFromDB(ctx context.Context, originalID string) (*rgsv1.LedgerTransaction, error) {
+	if !s.dbEnabled() {
+		return nil, nil
+	}
+	q := `SELECT ` + ledgerTransactionColumns + ` FROM ledger_transactions WHERE voids_transaction_id = $1`
+	tx, _, err := scanLedgerTransaction(s.db.QueryRowContext(ctx, q, originalID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return tx, err
+}
+
+func (s *LedgerService) hasOpenTransferForTransactionFromDB(ctx context.Context, txID string) (bool, error) {
+	if !s.dbEnabled() {
+		return false, nil
+	}
+	const q = `
+SELECT EXISTS (
+  SELECT 1 FROM cashless_unresolved_transfers
+  WHERE transaction_id = $1 AND status = 'open'::unresolved_transfer_status
+)
+`
+	var open bool
+	err := s.db.QueryRowContext(ctx, q, txID).Scan(&open)
+	return open, err
+}
+
+// persistTransactionVoid records the compensating transaction and marks the
+// original reversed atomically. It fails with errTransactionNotVoidable if the
+// original is no longer accepted, e.g. another replica voided it first.
+func (s *LedgerService) persistTransactionVoid(ctx context.Context, voidTx *rgsv1.LedgerTransaction, postings []ledgerPosting) error {
+	if !s.dbEnabled() {
+		return nil
+	}
+	dbtx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = dbtx.Rollback()
+	}()
+	const markReversed = `
+UPDATE ledger_transactions
+SET status = 'reversed'::ledger_transaction_status
+WHERE transaction_id = $1 AND status = 'accepted'::ledger_transaction_status
+`
+	res, err := dbtx.ExecContext(ctx, markReversed, voidTx.VoidsTransactionId)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return errTransactionNotVoidable
+	}
+	if err := s.persistLedgerMutationTx(ctx, dbtx, voidTx, postings, "accepted", "void:"+voidTx.VoidsTransactionId); err != nil {
+		return err
+	}
+	const linkVoid = `
+UPDATE ledger_transactions
+SET voids_transaction_id = $2
+WHERE transaction_id = $1
+`
+	if _, err := dbtx.ExecContext(ctx, linkVoid, voidTx.TransactionId, voidTx.VoidsTransactionId); err != nil {
+		return err
+	}
+	return dbtx.Commit()
+}
+
+const unresolvedTransferColumns = `
+transfer_id, account_id, device_id, requested_amount_minor, transferred_amount_minor, currency_code,
+status::text, reason, COALESCE(transaction_id, ''), created_at, ack_deadline_at, attempts,
+resolved_at, resolution, resolution_note, COALESCE(reversal_transaction_id, '')
+`
+
+func scanUnresolvedTransfer(row wagerScanner) (*rgsv1.UnresolvedTransfer, error) {
+	var (
+		t                    rgsv1.UnresolvedTransfer
+		requested, moved     int64
+		currency, status     string
+		createdAt            time.Time
+		deadline, resolvedAt sql.NullTime
+	)
+	if err := row.Scan(
+		&t.TransferId,
+		&t.AccountId,
+		&t.DeviceId,
+		&requested,
+		&moved,
+		&currency,
+		&status,
+		&t.Reason,
+		&t.TransactionId,
+		&createdAt,
+		&deadline,
+		&t.Attempts,
+		&resolvedAt,
+		&t.Resolution,
+		&t.ResolutionNote,
+		&t.ReversalTransactionId,
+	); err != nil {
+		return nil, err
+	}
+	t.RequestedAmount = money.New(requested, currency)
+	t.TransferredAmount = money.New(moved, currency)
+	t.Status = unresolvedTransferStatusFromDB(status)
+	t.CreatedAt = createdAt.UTC().Format(time.RFC3339Nano)
+	if deadline.Valid {
+		t.AckDeadlineAt = deadline.Time.UTC().Format(time.RFC3339Nano)
+	}
+	if resolvedAt.Valid {
+		t.ResolvedAt = resolvedAt.Time.UTC().Format(time.RFC3339Nano)
+	}
+	return &t, nil
+}
+
+func (s *LedgerService) getUnresolvedTransferFromDB(ctx context.Context, transferID string) (*rgsv1.UnresolvedTransfer, error) {
+	if !s.dbEnabled() {
+		return nil, nil
+	}
+	q := `SELECT ` + unresolvedTransferColumns + ` FROM cashless_unresolved_transfers WHERE transfer_id = $1`
+	t, err := scanUnresolvedTransfer(s.db.QueryRowContext(ctx, q, transferID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return t, err
+}
+
+// listUnresolvedTransfersFromDB returns transfers oldest first. Empty filters
+// match everything; a non-zero dueBy restricts to open transfers whose
+// acknowledgment deadline has passed.
+func (s *LedgerService) listUnresolvedTransfersFromDB(ctx context.Context, accountID, deviceID string, includeClosed bool, dueBy time.Time, limit int) ([]*rgsv1.UnresolvedTransfer, error) {
+	if !s.dbEnabled() {
+		return nil, nil
+	}
+	q := `SELECT ` + unresolvedTransferColumns + `
+FROM cashless_unresolved_transfers
+WHERE ($1 = '' OR account_id = $1)
+  AND ($2 = '' OR device_id = $2)
+  AND ($3 OR status = 'open'::unresolved_transfer_status)
+  AND ($4::timestamptz IS NULL OR (status = 'open'::unresolved_transfer_status AND ack_deadline_at <= $4::timestamptz))
+ORDER BY created_at ASC, transfer_id ASC
+LIMIT $5
+`
+	rows, err := s.db.QueryContext(ctx, q, accountID, deviceID, includeClosed, nullTime(dueBy), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]*rgsv1.UnresolvedTransfer, 0)
+	for rows.Next() {
+		t, err := scanUnresolvedTransfer(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, t)
+	}
+	return out, rows.Err()
+}
+
+func scanEFTLockout(row wagerScanner, now time.Time) (*rgsv1.EFTLockout, error) {
+	var (
+		l           rgsv1.EFTLockout
+		lockedUntil sql.NullTime
+		updatedAt   time.Time
+	)
+	if err := row.Scan(&l.AccountId, &l.FailedAttempts, &lockedUntil, &updatedAt); err != nil {
+		return nil, err
+	}
+	if lockedUntil.Valid {
+		l.LockedUntil = lockedUntil.Time.UTC().Format(time.RFC3339Nano)
+		l.Locked = lockedUntil.Time.After(now)
+	}
+	l.UpdatedAt = updatedAt.UTC().Format(time.RFC3339Nano)
+	return &l, nil
+}
+
+func (s *LedgerService) getEFTLockoutFromDB(ctx context.Context, accountID string) (*rgsv1.EFTLockout, error) {
+	if !s.dbEnabled() {
+		return nil, nil
+	}
+	const q = `
+SELECT account_id, failed_attempts, locked_until, updated_at
+FROM ledger_eft_lockouts
+WHERE account_id = $1
+`
+	l, err := scanEFTLockout(s.db.QueryRowContext(ctx, q, accountID), s.now())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return l, err
+}
+
+// listEFTLockoutsFromDB returns accounts with recorded failures or a lock,
+// most recently updated first.
+func (s *LedgerService) listEFTLockoutsFromDB(ctx context.Context, lockedOnly bool, limit int) ([]*rgsv1.EFTLockout, error) {
+	if !s.dbEnabled() {
+		return nil, nil
+	}
+	const q = `
+SELECT account_id, failed_attempts, locked_until, updated_at
+FROM ledger_eft_lockouts
+WHERE (failed_attempts > 0 OR locked_until IS NOT NULL)
+  AND (NOT $1 OR locked_until > $2::timestamptz)
+ORDER BY updated_at DESC, account_id ASC
+LIMIT $3
+`
+	now := s.now()
+	rows, err := s.db.QueryContext(ctx, q, lockedOnly, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]*rgsv1.EFTLockout, 0)
+	for rows.Next() {
+		l, err := scanEFTLockout(rows, now)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, l)
+	}
+	return out, rows.Err()
+}
+
+// listPositiveBalancesFromDB returns up to limit accounts with a positive
+// available balance, highest first.
+func (s *LedgerService) listPositiveBalancesFromDB(ctx context.Context, limit int) ([]ledgerAccount, error) {
+	if !s.dbEnabled() {
+		return nil, nil
+	}
+	const q = `
+SELECT account_id, currency_code, available_balance_minor, pending_balance_minor
+FROM ledger_accounts
+WHERE available_balance_minor > 0
+ORDER BY available_balance_minor DESC, account_id ASC
+LIMIT $1
+`
+	rows, err := s.db.QueryContext(ctx, q, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]ledgerAccount, 0)
+	for rows.Next() {
+		var acct ledgerAccount
+		if err := rows.Scan(&acct.id, &acct.currency, &acct.available, &acct.pending); err != nil {
+			return nil, err
+		}
+		acct.currency = strings.TrimSpace(acct.currency)
+		out = append(out, acct)
+	}
+	return out, rows.Err()
+}
+
+// ledgerCurrencyExchange is the rate record kept alongside an exchange
+// transaction.
+type ledgerCurrencyExchange struct {
+	fromAccountID string
+	toAccountID   string
+	from          *rgsv1.Money
+	to            *rgsv1.Money
+	rate          string
+}
+
+func (s *LedgerService) persistCurrencyExchange(ctx context.Context, txRecord *rgsv1.LedgerTransaction, postings []ledgerPosting, idemKey, accountID string, ex ledgerCurrencyExchange) error {
+	if !s.dbEnabled() {
+		return nil
+	}
+	dbtx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = dbtx.Rollback()
+	}()
+	if err := s.persistLedgerMutationTx(ctx, dbtx, txRecord, postings, "accepted", idemKey); err != nil {
+		return err
+	}
+	const q = `
+INSERT INTO ledger_currency_exchanges (
+  transaction_id, account_id, from_account_id, to_account_id,
+  from_currency, from_amount_minor, to_currency, to_amount_minor, rate
+) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9)
+`
+	if _, err := dbtx.ExecContext(ctx, q,
+		txRecord.TransactionId, accountID, ex.fromAccountID, ex.toAccountID,
+		ex.from.Currency, ex.from.AmountMinor, ex.to.Currency, ex.to.AmountMinor, ex.rate,
+	); err != nil {
+		return err
+	}
+	return dbtx.Commit()
+}
+
+func (s *LedgerService) getCurrencyExchangeFromDB(ctx context.Context, txID string) (*ledgerCurrencyExchange, error) {
+	if !s.dbEnabled() {
+		return nil, nil
+	}
+	const q = `
+SELECT from_account_id, to_account_id, from_currency, from_amount_minor, to_currency, to_amount_minor, rate
+FROM ledger_currency_exchanges
+WHERE transaction_id = $1
+`
+	var (
+		ex                   ledgerCurrencyExchange
+		fromCur, toCur       string
+		fromAmount, toAmount int64
+	)
+	err := s.db.QueryRowContext(ctx, q, txID).Scan(&ex.fromAccountID, &ex.toAccountID, &fromCur, &fromAmount, &toCur, &toAmount, &ex.rate)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	ex.from = money.New(fromAmount, strings.TrimSpace(fromCur))
+	ex.to = money.New(toAmount, strings.TrimSpace(toCur))
+	return &ex, nil
+}
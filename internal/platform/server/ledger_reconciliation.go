@@ -0,0 +1,821 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/money"
+	"google.golang.org/protobuf/proto"
+)
+
+var errTransactionReconciled = errors.New("transaction already reconciled")
+
+// bankStatementImport is everything one statement import commits: the
+// entries with their automatic matches, the exceptions it opens, and the
+// ledger exceptions its matches clear.
+type bankStatementImport struct {
+	statementID string
+	hash        []byte
+	format      rgsv1.BankStatementFormat
+	importedBy  string
+	importedAt  time.Time
+	entries     []*rgsv1.BankStatementEntry
+	opened      []*rgsv1.ReconciliationException
+	cleared     []string
+}
+
+// bankingDay names the banking day of a ledger instant. Banks book on
+// calendar dates, so this is the local date in the default gaming calendar's
+// zone rather than the gaming day.
+func bankingDay(t time.Time) string {
+	return t.In(gamingCalendarFor("").location()).Format(gamingDayLayout)
+}
+
+func bankingDayBounds(day string) (time.Time, time.Time, error) {
+	d, err := time.ParseInLocation(gamingDayLayout, day, gamingCalendarFor("").location())
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return d.UTC(), d.AddDate(0, 0, 1).UTC(), nil
+}
+
+func transactionBankingDay(tx *rgsv1.LedgerTransaction) string {
+	t, err := time.Parse(time.RFC3339Nano, tx.GetOccurredAt())
+	if err != nil {
+		return ""
+	}
+	return bankingDay(t)
+}
+
+func bankEntryDirectionFor(t rgsv1.LedgerTransactionType) rgsv1.BankEntryDirection {
+	switch t {
+	case rgsv1.LedgerTransactionType_LEDGER_TRANSACTION_TYPE_DEPOSIT:
+		return rgsv1.BankEntryDirection_BANK_ENTRY_DIRECTION_CREDIT
+	case rgsv1.LedgerTransactionType_LEDGER_TRANSACTION_TYPE_WITHDRAWAL:
+		return rgsv1.BankEntryDirection_BANK_ENTRY_DIRECTION_DEBIT
+	default:
+		return rgsv1.BankEntryDirection_BANK_ENTRY_DIRECTION_UNSPECIFIED
+	}
+}
+
+// bankEntryMatches reports whether tx settles entry: a deposit for a credit
+// or a withdrawal for a debit, for exactly the same amount and currency.
+func bankEntryMatches(entry *rgsv1.BankStatementEntry, tx *rgsv1.LedgerTransaction) bool {
+	return bankEntryDirectionFor(tx.TransactionType) == entry.Direction &&
+		tx.Amount.GetAmountMinor() == entry.Amount.GetAmountMinor() &&
+		tx.Amount.GetCurrency() == entry.Amount.GetCurrency()
+}
+
+func parseBankStatement(format rgsv1.BankStatementFormat, content []byte) ([]*rgsv1.BankStatementEntry, error) {
+	switch format {
+	case rgsv1.BankStatementFormat_BANK_STATEMENT_FORMAT_CSV:
+		return parseBankStatementCSV(content)
+	case rgsv1.BankStatementFormat_BANK_STATEMENT_FORMAT_CAMT053:
+		return parseBankStatementCAMT053(content)
+	default:
+		return nil, errors.New("format is required")
+	}
+}
+
+// parseBankStatementCSV reads a header row naming booking_date, amount,
+// currency, and reference columns, with optional direction and description.
+// Without a direction column the amount's sign gives the direction.
+func parseBankStatementCSV(content []byte) ([]*rgsv1.BankStatementEntry, error) {
+	r := csv.NewReader(bytes.NewReader(content))
+	r.TrimLeadingSpace = true
+	header, err := r.Read()
+	if err != nil {
+		return nil, errors.New("statement header is required")
+	}
+	cols := make(map[string]int, len(header))
+	for i, h := range header {
+		cols[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(h, "\ufeff")))] = i
+	}
+	for _, required := range []string{"booking_date", "amount", "currency", "reference"} {
+		if _, ok := cols[required]; !ok {
+			return nil, fmt.Errorf("statement is missing the %s column", required)
+		}
+	}
+	field := func(rec []string, name string) string {
+		i, ok := cols[name]
+		if !ok || i >= len(rec) {
+			return ""
+		}
+		return strings.TrimSpace(rec[i])
+	}
+
+	var entries []*rgsv1.BankStatementEntry
+	for line := 2; ; line++ {
+		rec, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %d: malformed csv", line)
+		}
+		day := field(rec, "booking_date")
+		if _, err := time.Parse(gamingDayLayout, day); err != nil {
+			return nil, fmt.Errorf("line %d: booking_date must be YYYY-MM-DD", line)
+		}
+		amount, err := money.Parse(field(rec, "amount") + " " + strings.ToUpper(field(rec, "currency")))
+		if err != nil || amount.AmountMinor == 0 {
+			return nil, fmt.Errorf("line %d: invalid amount", line)
+		}
+		var direction rgsv1.BankEntryDirection
+		switch strings.ToLower(field(rec, "direction")) {
+		case "":
+			direction = rgsv1.BankEntryDirection_BANK_ENTRY_DIRECTION_CREDIT
+			if amount.AmountMinor < 0 {
+				direction = rgsv1.BankEntryDirection_BANK_ENTRY_DIRECTION_DEBIT
+				amount.AmountMinor = -amount.AmountMinor
+			}
+		case "credit", "crdt", "c":
+			direction = rgsv1.BankEntryDirection_BANK_ENTRY_DIRECTION_CREDIT
+		case "debit", "dbit", "d":
+			direction = rgsv1.BankEntryDirection_BANK_ENTRY_DIRECTION_DEBIT
+		default:
+			return nil, fmt.Errorf("line %d: direction must be credit or debit", line)
+		}
+		if amount.AmountMinor < 0 {
+			return nil, fmt.Errorf("line %d: amount must be positive when direction is given", line)
+		}
+		entries = append(entries, &rgsv1.BankStatementEntry{
+			BankingDay:  day,
+			Direction:   direction,
+			Amount:      amount,
+			Reference:   field(rec, "reference"),
+			Description: field(rec, "description"),
+		})
+	}
+	return entries, nil
+}
+
+type camt053Document struct {
+	Statements []struct {
+		Entries []camt053Entry `xml:"Ntry"`
+	} `xml:"BkToCstmrStmt>Stmt"`
+}
+
+type camt053Entry struct {
+	Amount struct {
+		Value    string `xml:",chardata"`
+		Currency string `xml:"Ccy,attr"`
+	} `xml:"Amt"`
+	Indicator       string `xml:"CdtDbtInd"`
+	BookingDate     string `xml:"BookgDt>Dt"`
+	BookingDateTime string `xml:"BookgDt>DtTm"`
+	ServicerRef     string `xml:"AcctSvcrRef"`
+	AdditionalInfo  string `xml:"AddtlNtryInf"`
+	Details         []struct {
+		EndToEndID   string   `xml:"Refs>EndToEndId"`
+		Unstructured []string `xml:"RmtInf>Ustrd"`
+	} `xml:"NtryDtls>TxDtls"`
+}
+
+// reference prefers the end-to-end id the operator's payment carried, then
+// the remittance text, then the bank's own reference.
+func (e camt053Entry) reference() string {
+	for _, d := range e.Details {
+		if id := strings.TrimSpace(d.EndToEndID); id != "" && id != "NOTPROVIDED" {
+			return id
+		}
+	}
+	for _, d := range e.Details {
+		for _, u := range d.Unstructured {
+			if u = strings.TrimSpace(u); u != "" {
+				return u
+			}
+		}
+	}
+	return strings.TrimSpace(e.ServicerRef)
+}
+
+func (e camt053Entry) description() string {
+	var parts []string
+	for _, d := range e.Details {
+		for _, u := range d.Unstructured {
+			if u = strings.TrimSpace(u); u != "" {
+				parts = append(parts, u)
+			}
+		}
+	}
+	if len(parts) == 0 {
+		return strings.TrimSpace(e.AdditionalInfo)
+	}
+	return strings.Join(parts, " ")
+}
+
+func parseBankStatementCAMT053(content []byte) ([]*rgsv1.BankStatementEntry, error) {
+	var doc camt053Document
+	if err := xml.Unmarshal(content, &doc); err != nil {
+		return nil, errors.New("malformed camt.053 document")
+	}
+	var entries []*rgsv1.BankStatementEntry
+	n := 0
+	for _, stmt := range doc.Statements {
+		for _, ntry := range stmt.Entries {
+			n++
+			amount, err := money.Parse(strings.TrimSpace(ntry.Amount.Value) + " " + strings.ToUpper(strings.TrimSpace(ntry.Amount.Currency)))
+			if err != nil || amount.AmountMinor <= 0 {
+				return nil, fmt.Errorf("entry %d: invalid amount", n)
+			}
+			var direction rgsv1.BankEntryDirection
+			switch strings.TrimSpace(ntry.Indicator) {
+			case "CRDT":
+				direction = rgsv1.BankEntryDirection_BANK_ENTRY_DIRECTION_CREDIT
+			case "DBIT":
+				direction = rgsv1.BankEntryDirection_BANK_ENTRY_DIRECTION_DEBIT
+			default:
+				return nil, fmt.Errorf("entry %d: CdtDbtInd must be CRDT or DBIT", n)
+			}
+			day := strings.TrimSpace(ntry.BookingDate)
+			if day == "" && len(ntry.BookingDateTime) >= len(gamingDayLayout) {
+				day = strings.TrimSpace(ntry.BookingDateTime)[:len(gamingDayLayout)]
+			}
+			if _, err := time.Parse(gamingDayLayout, day); err != nil {
+				return nil, fmt.Errorf("entry %d: invalid booking date", n)
+			}
+			entries = append(entries, &rgsv1.BankStatementEntry{
+				BankingDay:  day,
+				Direction:   direction,
+				Amount:      amount,
+				Reference:   ntry.reference(),
+				Description: ntry.description(),
+			})
+		}
+	}
+	return entries, nil
+}
+
+func bankExceptionID(entryID string) string {
+	return "rexc-bank-" + entryID
+}
+
+func ledgerExceptionID(txID string) string {
+	return "rexc-ledger-" + txID
+}
+
+// reconciliationCandidates returns the unreconciled, unvoided deposits and
+// withdrawals whose authorization or transaction id equals reference.
+func (s *LedgerService) reconciliationCandidates(ctx context.Context, reference string) ([]*rgsv1.LedgerTransaction, error) {
+	if s.dbEnabled() {
+		return s.reconciliationCandidatesFromDB(ctx, reference)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var out []*rgsv1.LedgerTransaction
+	for _, txs := range s.transactionsByAcct {
+		for _, tx := range txs {
+			if tx.AuthorizationId != reference && tx.TransactionId != reference {
+				continue
+			}
+			if s.reconcilableLocked(tx) && s.reconMatches[tx.TransactionId] == "" {
+				out = append(out, transactionCopy(tx))
+			}
+		}
+	}
+	sortTransactionsByOccurrence(out)
+	return out, nil
+}
+
+// unreconciledTransactions returns the deposits and withdrawals booked on
+// day that no bank entry has matched.
+func (s *LedgerService) unreconciledTransactions(ctx context.Context, day string) ([]*rgsv1.LedgerTransaction, error) {
+	txs, err := s.bankingDayTransactions(ctx, day)
+	if err != nil {
+		return nil, err
+	}
+	out := txs[:0]
+	for _, tx := range txs {
+		matched, err := s.transactionReconciled(ctx, tx.TransactionId)
+		if err != nil {
+			return nil, err
+		}
+		if !matched {
+			out = append(out, tx)
+		}
+	}
+	return out, nil
+}
+
+// bankingDayTransactions returns the unvoided deposits and withdrawals
+// booked on day.
+func (s *LedgerService) bankingDayTransactions(ctx context.Context, day string) ([]*rgsv1.LedgerTransaction, error) {
+	if s.dbEnabled() {
+		return s.bankingDayTransactionsFromDB(ctx, day)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var out []*rgsv1.LedgerTransaction
+	for _, txs := range s.transactionsByAcct {
+		for _, tx := range txs {
+			if s.reconcilableLocked(tx) && transactionBankingDay(tx) == day {
+				out = append(out, transactionCopy(tx))
+			}
+		}
+	}
+	sortTransactionsByOccurrence(out)
+	return out, nil
+}
+
+func (s *LedgerService) reconcilableLocked(tx *rgsv1.LedgerTransaction) bool {
+	return bankEntryDirectionFor(tx.TransactionType) != rgsv1.BankEntryDirection_BANK_ENTRY_DIRECTION_UNSPECIFIED &&
+		s.voidsByOriginal[tx.TransactionId] == nil
+}
+
+func sortTransactionsByOccurrence(txs []*rgsv1.LedgerTransaction) {
+	sort.SliceStable(txs, func(i, j int) bool {
+		if txs[i].OccurredAt != txs[j].OccurredAt {
+			return txs[i].OccurredAt < txs[j].OccurredAt
+		}
+		return txs[i].TransactionId < txs[j].TransactionId
+	})
+}
+
+func (s *LedgerService) transactionReconciled(ctx context.Context, txID string) (bool, error) {
+	if s.dbEnabled() {
+		return s.transactionReconciledFromDB(ctx, txID)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.reconMatches[txID] != "", nil
+}
+
+func (s *LedgerService) bankStatementImported(ctx context.Context, hash []byte) (bool, error) {
+	if s.dbEnabled() {
+		return s.bankStatementImportedFromDB(ctx, hash)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.bankStatementsByHash[hex.EncodeToString(hash)]
+	return ok, nil
+}
+
+func (s *LedgerService) persistBankStatementImport(ctx context.Context, imp *bankStatementImport) error {
+	if s.dbEnabled() {
+		return s.persistBankStatementImportToDB(ctx, imp)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.bankStatementsByHash[hex.EncodeToString(imp.hash)] = imp.statementID
+	for _, e := range imp.entries {
+		s.bankEntries = append(s.bankEntries, proto.Clone(e).(*rgsv1.BankStatementEntry))
+		if e.MatchedTransactionId != "" {
+			s.reconMatches[e.MatchedTransactionId] = e.EntryId
+		}
+	}
+	for _, exc := range imp.opened {
+		if _, exists := s.reconExceptions[exc.ExceptionId]; !exists {
+			s.reconExceptions[exc.ExceptionId] = proto.Clone(exc).(*rgsv1.ReconciliationException)
+		}
+	}
+	s.clearReconciliationExceptionsLocked(imp.cleared, imp.importedAt)
+	return nil
+}
+
+func (s *LedgerService) clearReconciliationExceptionsLocked(ids []string, now time.Time) {
+	for _, id := range ids {
+		if exc := s.reconExceptions[id]; exc != nil && exc.Status == rgsv1.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_STATUS_OPEN {
+			exc.Status = rgsv1.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_STATUS_CLEARED
+			exc.ResolvedAt = now.Format(time.RFC3339Nano)
+		}
+	}
+}
+
+func (s *LedgerService) bankEntriesForDay(ctx context.Context, day string) ([]*rgsv1.BankStatementEntry, error) {
+	if s.dbEnabled() {
+		return s.bankEntriesForDayFromDB(ctx, day)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var out []*rgsv1.BankStatementEntry
+	for _, e := range s.bankEntries {
+		if e.BankingDay == day {
+			out = append(out, proto.Clone(e).(*rgsv1.BankStatementEntry))
+		}
+	}
+	return out, nil
+}
+
+func (s *LedgerService) getReconciliationException(ctx context.Context, id string) (*rgsv1.ReconciliationException, error) {
+	if s.dbEnabled() {
+		return s.getReconciliationExceptionFromDB(ctx, id)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	exc := s.reconExceptions[id]
+	if exc == nil {
+		return nil, nil
+	}
+	return proto.Clone(exc).(*rgsv1.ReconciliationException), nil
+}
+
+func (s *LedgerService) listReconciliationExceptions(ctx context.Context, day string, status rgsv1.ReconciliationExceptionStatus) ([]*rgsv1.ReconciliationException, error) {
+	if s.dbEnabled() {
+		return s.listReconciliationExceptionsFromDB(ctx, day, status)
+	}
+	s.mu.Lock()
+	out := make([]*rgsv1.ReconciliationException, 0, len(s.reconExceptions))
+	for _, exc := range s.reconExceptions {
+		if (day == "" || exc.BankingDay == day) && (status == rgsv1.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_STATUS_UNSPECIFIED || exc.Status == status) {
+			out = append(out, proto.Clone(exc).(*rgsv1.ReconciliationException))
+		}
+	}
+	s.mu.Unlock()
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].BankingDay != out[j].BankingDay {
+			return out[i].BankingDay < out[j].BankingDay
+		}
+		return out[i].ExceptionId < out[j].ExceptionId
+	})
+	return out, nil
+}
+
+// persistReconciliationResolution stores a resolved exception. A manual
+// match also records the entry's transaction and clears that transaction's
+// own exception.
+func (s *LedgerService) persistReconciliationResolution(ctx context.Context, exc *rgsv1.ReconciliationException) error {
+	if s.dbEnabled() {
+		return s.persistReconciliationResolutionToDB(ctx, exc)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if txID := exc.GetEntry().GetMatchedTransactionId(); txID != "" {
+		if s.reconMatches[txID] != "" {
+			return errTransactionReconciled
+		}
+		s.reconMatches[txID] = exc.Entry.EntryId
+		for i, e := range s.bankEntries {
+			if e.EntryId == exc.Entry.EntryId {
+				s.bankEntries[i] = proto.Clone(exc.Entry).(*rgsv1.BankStatementEntry)
+			}
+		}
+		resolvedAt, _ := time.Parse(time.RFC3339Nano, exc.ResolvedAt)
+		s.clearReconciliationExceptionsLocked([]string{ledgerExceptionID(txID)}, resolvedAt)
+	}
+	s.reconExceptions[exc.ExceptionId] = proto.Clone(exc).(*rgsv1.ReconciliationException)
+	return nil
+}
+
+// ImportBankStatement loads an operator bank statement, matches each entry
+// to a deposit or withdrawal by reference and amount, and re-reconciles
+// every banking day the statement covers. Unmatched entries and unmatched
+// ledger transactions on those days open exceptions; a later import that
+// matches an open ledger exception clears it.
+func (s *LedgerService) ImportBankStatement(ctx context.Context, req *rgsv1.ImportBankStatementRequest) (*rgsv1.ImportBankStatementResponse, error) {
+	if req == nil || len(req.Content) == 0 {
+		return &rgsv1.ImportBankStatementResponse{Meta: s.responseMeta(nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "content is required")}, nil
+	}
+	if ok, reason := s.authorizeOperator(ctx, req.Meta); !ok {
+		s.auditDenied(req.Meta, "bank_statement", "", "import_bank_statement", reason)
+		return &rgsv1.ImportBankStatementResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	entries, err := parseBankStatement(req.Format, req.Content)
+	if err != nil {
+		return &rgsv1.ImportBankStatementResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, err.Error())}, nil
+	}
+	if len(entries) == 0 {
+		return &rgsv1.ImportBankStatementResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "statement has no entries")}, nil
+	}
+	sum := sha256.Sum256(req.Content)
+	actor, _ := resolveActor(ctx, req.Meta)
+	imp := &bankStatementImport{
+		statementID: "bst-" + hex.EncodeToString(sum[:8]),
+		hash:        sum[:],
+		format:      req.Format,
+		importedBy:  actor.GetActorId(),
+		importedAt:  s.now(),
+		entries:     entries,
+	}
+	created := imp.importedAt.Format(time.RFC3339Nano)
+
+	// Runs are serialized so two imports never claim the same transaction.
+	s.reconMu.Lock()
+	defer s.reconMu.Unlock()
+	imported, err := s.bankStatementImported(ctx, imp.hash)
+	if err != nil {
+		return &rgsv1.ImportBankStatementResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if imported {
+		return &rgsv1.ImportBankStatementResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "statement already imported")}, nil
+	}
+
+	claimed := make(map[string]bool)
+	days := make(map[string]bool)
+	matched := 0
+	for i, e := range entries {
+		e.StatementId = imp.statementID
+		e.EntryId = imp.statementID + "-" + strconv.Itoa(i+1)
+		days[e.BankingDay] = true
+		if e.Reference != "" {
+			candidates, err := s.reconciliationCandidates(ctx, e.Reference)
+			if err != nil {
+				return &rgsv1.ImportBankStatementResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+			}
+			for _, tx := range candidates {
+				if !claimed[tx.TransactionId] && bankEntryMatches(e, tx) {
+					claimed[tx.TransactionId] = true
+					e.MatchedTransactionId = tx.TransactionId
+					imp.cleared = append(imp.cleared, ledgerExceptionID(tx.TransactionId))
+					matched++
+					break
+				}
+			}
+		}
+		if e.MatchedTransactionId == "" {
+			imp.opened = append(imp.opened, &rgsv1.ReconciliationException{
+				ExceptionId: bankExceptionID(e.EntryId),
+				BankingDay:  e.BankingDay,
+				Kind:        rgsv1.ReconciliationExceptionKind_RECONCILIATION_EXCEPTION_KIND_UNMATCHED_BANK_ENTRY,
+				Status:      rgsv1.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_STATUS_OPEN,
+				Entry:       e,
+				CreatedAt:   created,
+			})
+		}
+	}
+	bankingDays := make([]string, 0, len(days))
+	for day := range days {
+		bankingDays = append(bankingDays, day)
+	}
+	sort.Strings(bankingDays)
+	for _, day := range bankingDays {
+		txs, err := s.unreconciledTransactions(ctx, day)
+		if err != nil {
+			return &rgsv1.ImportBankStatementResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+		for _, tx := range txs {
+			if claimed[tx.TransactionId] {
+				continue
+			}
+			// Re-imports of an overlapping day keep the first exception,
+			// including any resolution already recorded against it.
+			existing, err := s.getReconciliationException(ctx, ledgerExceptionID(tx.TransactionId))
+			if err != nil {
+				return &rgsv1.ImportBankStatementResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+			}
+			if existing != nil {
+				continue
+			}
+			imp.opened = append(imp.opened, &rgsv1.ReconciliationException{
+				ExceptionId: ledgerExceptionID(tx.TransactionId),
+				BankingDay:  day,
+				Kind:        rgsv1.ReconciliationExceptionKind_RECONCILIATION_EXCEPTION_KIND_UNMATCHED_LEDGER_TRANSACTION,
+				Status:      rgsv1.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_STATUS_OPEN,
+				Transaction: tx,
+				CreatedAt:   created,
+			})
+		}
+	}
+
+	after, _ := json.Marshal(map[string]any{
+		"statement_id":      imp.statementID,
+		"format":            req.Format.String(),
+		"content_sha256":    hex.EncodeToString(imp.hash),
+		"entries_imported":  len(entries),
+		"entries_matched":   matched,
+		"exceptions_opened": len(imp.opened),
+		"banking_days":      bankingDays,
+	})
+	if err := s.appendAudit(req.Meta, "bank_statement", imp.statementID, "import_bank_statement", []byte(`{}`), after, audit.ResultSuccess, ""); err != nil {
+		return &rgsv1.ImportBankStatementResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	if err := s.persistBankStatementImport(ctx, imp); err != nil {
+		return &rgsv1.ImportBankStatementResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	return &rgsv1.ImportBankStatementResponse{
+		Meta:             s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
+		StatementId:      imp.statementID,
+		EntriesImported:  int32(len(entries)),
+		EntriesMatched:   int32(matched),
+		BankingDays:      bankingDays,
+		ExceptionsOpened: int32(len(imp.opened)),
+	}, nil
+}
+
+func (s *LedgerService) ListReconciliationExceptions(ctx context.Context, req *rgsv1.ListReconciliationExceptionsRequest) (*rgsv1.ListReconciliationExceptionsResponse, error) {
+	if req == nil {
+		req = &rgsv1.ListReconciliationExceptionsRequest{}
+	}
+	if ok, reason := s.authorizeOperator(ctx, req.Meta); !ok {
+		s.auditDenied(req.Meta, "reconciliation_exception", "", "list_reconciliation_exceptions", reason)
+		return &rgsv1.ListReconciliationExceptionsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	if req.BankingDay != "" {
+		if _, err := time.Parse(gamingDayLayout, req.BankingDay); err != nil {
+			return &rgsv1.ListReconciliationExceptionsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "banking_day must be YYYY-MM-DD")}, nil
+		}
+	}
+	if err := validatePageToken(req.PageToken); err != nil {
+		return &rgsv1.ListReconciliationExceptionsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, err.Error())}, nil
+	}
+	pageSize := req.PageSize
+	if pageSize > maxAuditPageSize {
+		pageSize = maxAuditPageSize
+	}
+	items, err := s.listReconciliationExceptions(ctx, req.BankingDay, req.Status)
+	if err != nil {
+		return &rgsv1.ListReconciliationExceptionsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	page, next, err := paginate(items, req.PageToken, pageSize)
+	if err != nil {
+		return &rgsv1.ListReconciliationExceptionsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, err.Error())}, nil
+	}
+	return &rgsv1.ListReconciliationExceptionsResponse{
+		Meta:          s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
+		Exceptions:    page,
+		NextPageToken: next,
+	}, nil
+}
+
+// ResolveReconciliationException closes an open exception with an operator
+// note. An unmatched bank entry may name the deposit or withdrawal it
+// settles, which must agree on direction, amount, and currency and not be
+// reconciled already.
+func (s *LedgerService) ResolveReconciliationException(ctx context.Context, req *rgsv1.ResolveReconciliationExceptionRequest) (*rgsv1.ResolveReconciliationExceptionResponse, error) {
+	if req == nil || req.ExceptionId == "" {
+		return &rgsv1.ResolveReconciliationExceptionResponse{Meta: s.responseMeta(nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "exception_id is required")}, nil
+	}
+	if ok, reason := s.authorizeOperator(ctx, req.Meta); !ok {
+		s.auditDenied(req.Meta, "reconciliation_exception", req.ExceptionId, "resolve_reconciliation_exception", reason)
+		return &rgsv1.ResolveReconciliationExceptionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	if strings.TrimSpace(req.Note) == "" {
+		return &rgsv1.ResolveReconciliationExceptionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "note is required")}, nil
+	}
+
+	s.reconMu.Lock()
+	defer s.reconMu.Unlock()
+	exc, err := s.getReconciliationException(ctx, req.ExceptionId)
+	if err != nil {
+		return &rgsv1.ResolveReconciliationExceptionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if exc == nil {
+		return &rgsv1.ResolveReconciliationExceptionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "exception not found")}, nil
+	}
+	if exc.Status != rgsv1.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_STATUS_OPEN {
+		return &rgsv1.ResolveReconciliationExceptionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "exception is not open")}, nil
+	}
+	before, _ := json.Marshal(exc)
+	resolved := proto.Clone(exc).(*rgsv1.ReconciliationException)
+	if req.TransactionId != "" {
+		if exc.Kind != rgsv1.ReconciliationExceptionKind_RECONCILIATION_EXCEPTION_KIND_UNMATCHED_BANK_ENTRY {
+			return &rgsv1.ResolveReconciliationExceptionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "transaction_id applies only to unmatched bank entries")}, nil
+		}
+		st, err := s.loadTransactionState(ctx, req.TransactionId)
+		if err != nil {
+			return &rgsv1.ResolveReconciliationExceptionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+		if st == nil {
+			return &rgsv1.ResolveReconciliationExceptionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "transaction not found")}, nil
+		}
+		if st.reversed {
+			return &rgsv1.ResolveReconciliationExceptionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "transaction has been voided")}, nil
+		}
+		if !bankEntryMatches(exc.Entry, st.tx) {
+			return &rgsv1.ResolveReconciliationExceptionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "transaction does not match bank entry direction and amount")}, nil
+		}
+		reconciled, err := s.transactionReconciled(ctx, req.TransactionId)
+		if err != nil {
+			return &rgsv1.ResolveReconciliationExceptionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+		if reconciled {
+			return &rgsv1.ResolveReconciliationExceptionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, errTransactionReconciled.Error())}, nil
+		}
+		resolved.Entry.MatchedTransactionId = req.TransactionId
+		resolved.Entry.ManuallyMatched = true
+	}
+	actor, _ := resolveActor(ctx, req.Meta)
+	resolved.Status = rgsv1.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_STATUS_RESOLVED
+	resolved.ResolvedAt = s.now().Format(time.RFC3339Nano)
+	resolved.ResolvedBy = actor.GetActorId()
+	resolved.ResolutionNote = req.Note
+	after, _ := json.Marshal(resolved)
+	if err := s.appendAudit(req.Meta, "reconciliation_exception", exc.ExceptionId, "resolve_reconciliation_exception", before, after, audit.ResultSuccess, req.Note); err != nil {
+		return &rgsv1.ResolveReconciliationExceptionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	if err := s.persistReconciliationResolution(ctx, resolved); err != nil {
+		if errors.Is(err, errTransactionReconciled) {
+			return &rgsv1.ResolveReconciliationExceptionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, err.Error())}, nil
+		}
+		return &rgsv1.ResolveReconciliationExceptionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	return &rgsv1.ResolveReconciliationExceptionResponse{
+		Meta:      s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
+		Exception: resolved,
+	}, nil
+}
+
+// GetReconciliationReport summarizes one banking day: bank and ledger
+// totals per currency and how many items are matched or still excepted. A
+// day with no imported statement entries reports NOT_IMPORTED.
+func (s *LedgerService) GetReconciliationReport(ctx context.Context, req *rgsv1.GetReconciliationReportRequest) (*rgsv1.GetReconciliationReportResponse, error) {
+	if req == nil || req.BankingDay == "" {
+		return &rgsv1.GetReconciliationReportResponse{Meta: s.responseMeta(nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "banking_day is required")}, nil
+	}
+	if ok, reason := s.authorizeOperator(ctx, req.Meta); !ok {
+		s.auditDenied(req.Meta, "reconciliation_report", req.BankingDay, "get_reconciliation_report", reason)
+		return &rgsv1.GetReconciliationReportResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	if _, err := time.Parse(gamingDayLayout, req.BankingDay); err != nil {
+		return &rgsv1.GetReconciliationReportResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "banking_day must be YYYY-MM-DD")}, nil
+	}
+	entries, err := s.bankEntriesForDay(ctx, req.BankingDay)
+	if err != nil {
+		return &rgsv1.GetReconciliationReportResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	txs, err := s.bankingDayTransactions(ctx, req.BankingDay)
+	if err != nil {
+		return &rgsv1.GetReconciliationReportResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	exceptions, err := s.listReconciliationExceptions(ctx, req.BankingDay, rgsv1.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_STATUS_UNSPECIFIED)
+	if err != nil {
+		return &rgsv1.GetReconciliationReportResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	report, err := buildReconciliationReport(req.BankingDay, entries, txs, exceptions)
+	if err != nil {
+		return &rgsv1.GetReconciliationReportResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "reconciliation totals overflow")}, nil
+	}
+	return &rgsv1.GetReconciliationReportResponse{
+		Meta:   s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
+		Report: report,
+	}, nil
+}
+
+func buildReconciliationReport(day string, entries []*rgsv1.BankStatementEntry, txs []*rgsv1.LedgerTransaction, exceptions []*rgsv1.ReconciliationException) (*rgsv1.ReconciliationDayReport, error) {
+	report := &rgsv1.ReconciliationDayReport{
+		BankingDay:         day,
+		StatementEntries:   int32(len(entries)),
+		LedgerTransactions: int32(len(txs)),
+	}
+	bankCredits, bankDebits := money.Totals{}, money.Totals{}
+	ledgerDeposits, ledgerWithdrawals := money.Totals{}, money.Totals{}
+	for _, e := range entries {
+		if e.MatchedTransactionId != "" {
+			report.MatchedEntries++
+		}
+		totals := bankCredits
+		if e.Direction == rgsv1.BankEntryDirection_BANK_ENTRY_DIRECTION_DEBIT {
+			totals = bankDebits
+		}
+		if err := totals.Add(e.Amount.GetCurrency(), e.Amount.GetAmountMinor()); err != nil {
+			return nil, err
+		}
+	}
+	for _, tx := range txs {
+		totals := ledgerDeposits
+		if tx.TransactionType == rgsv1.LedgerTransactionType_LEDGER_TRANSACTION_TYPE_WITHDRAWAL {
+			totals = ledgerWithdrawals
+		}
+		if err := totals.Add(tx.Amount.GetCurrency(), tx.Amount.GetAmountMinor()); err != nil {
+			return nil, err
+		}
+	}
+	for _, exc := range exceptions {
+		switch exc.Status {
+		case rgsv1.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_STATUS_OPEN:
+			report.OpenExceptions++
+		case rgsv1.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_STATUS_RESOLVED:
+			report.ResolvedExceptions++
+		}
+	}
+	currencies := make(map[string]bool)
+	for _, t := range []money.Totals{bankCredits, bankDebits, ledgerDeposits, ledgerWithdrawals} {
+		for c := range t {
+			currencies[c] = true
+		}
+	}
+	for c := range currencies {
+		report.Totals = append(report.Totals, &rgsv1.ReconciliationTotals{
+			Currency:               c,
+			BankCreditsMinor:       bankCredits[c],
+			BankDebitsMinor:        bankDebits[c],
+			LedgerDepositsMinor:    ledgerDeposits[c],
+			LedgerWithdrawalsMinor: ledgerWithdrawals[c],
+		})
+	}
+	sort.Slice(report.Totals, func(i, j int) bool { return report.Totals[i].Currency < report.Totals[j].Currency })
+
+	switch {
+	case len(entries) == 0:
+		report.Status = rgsv1.ReconciliationDayStatus_RECONCILIATION_DAY_STATUS_NOT_IMPORTED
+	case report.OpenExceptions > 0:
+		report.Status = rgsv1.ReconciliationDayStatus_RECONCILIATION_DAY_STATUS_EXCEPTIONS
+	default:
+		report.Status = rgsv1.ReconciliationDayStatus_RECONCILIATION_DAY_STATUS_RECONCILED
+	}
+	return report, nil
+}
@@ -0,0 +1,372 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/money"
+)
+
+func bankEntryDirectionToDB(v rgsv1.BankEntryDirection) string {
+	if v == rgsv1.BankEntryDirection_BANK_ENTRY_DIRECTION_DEBIT {
+		return "DEBIT"
+	}
+	return "CREDIT"
+}
+
+func bankEntryDirectionFromDB(v string) rgsv1.BankEntryDirection {
+	switch v {
+	case "CREDIT":
+		return rgsv1.BankEntryDirection_BANK_ENTRY_DIRECTION_CREDIT
+	case "DEBIT":
+		return rgsv1.BankEntryDirection_BANK_ENTRY_DIRECTION_DEBIT
+	default:
+		return rgsv1.BankEntryDirection_BANK_ENTRY_DIRECTION_UNSPECIFIED
+	}
+}
+
+func reconciliationExceptionKindToDB(v rgsv1.ReconciliationExceptionKind) string {
+	if v == rgsv1.ReconciliationExceptionKind_RECONCILIATION_EXCEPTION_KIND_UNMATCHED_LEDGER_TRANSACTION {
+		return "UNMATCHED_LEDGER_TRANSACTION"
+	}
+	return "UNMATCHED_BANK_ENTRY"
+}
+
+func reconciliationExceptionKindFromDB(v string) rgsv1.ReconciliationExceptionKind {
+	switch v {
+	case "UNMATCHED_BANK_ENTRY":
+		return rgsv1.ReconciliationExceptionKind_RECONCILIATION_EXCEPTION_KIND_UNMATCHED_BANK_ENTRY
+	case "UNMATCHED_LEDGER_TRANSACTION":
+		return rgsv1.ReconciliationExceptionKind_RECONCILIATION_EXCEPTION_KIND_UNMATCHED_LEDGER_TRANSACTION
+	default:
+		return rgsv1.ReconciliationExceptionKind_RECONCILIATION_EXCEPTION_KIND_UNSPECIFIED
+	}
+}
+
+func reconciliationExceptionStatusToDB(v rgsv1.ReconciliationExceptionStatus) string {
+	switch v {
+	case rgsv1.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_STATUS_RESOLVED:
+		return "RESOLVED"
+	case rgsv1.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_STATUS_CLEARED:
+		return "CLEARED"
+	default:
+		return "OPEN"
+	}
+}
+
+func reconciliationExceptionStatusFromDB(v string) rgsv1.ReconciliationExceptionStatus {
+	switch v {
+	case "OPEN":
+		return rgsv1.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_STATUS_OPEN
+	case "RESOLVED":
+		return rgsv1.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_STATUS_RESOLVED
+	case "CLEARED":
+		return rgsv1.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_STATUS_CLEARED
+	default:
+		return rgsv1.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_STATUS_UNSPECIFIED
+	}
+}
+
+// reconcilableTransactionFilter limits ledger_transactions to unvoided
+// deposits and withdrawals; voided originals are marked reversed.
+const reconcilableTransactionFilter = `
+transaction_type IN ('deposit'::ledger_transaction_type, 'withdrawal'::ledger_transaction_type)
+AND status = 'accepted'::ledger_transaction_status
+`
+
+func (s *LedgerService) queryLedgerTransactions(ctx context.Context, q string, args ...any) ([]*rgsv1.LedgerTransaction, error) {
+	rows, err := s.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []*rgsv1.LedgerTransaction
+	for rows.Next() {
+		tx, _, err := scanLedgerTransaction(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, tx)
+	}
+	return out, rows.Err()
+}
+
+func (s *LedgerService) reconciliationCandidatesFromDB(ctx context.Context, reference string) ([]*rgsv1.LedgerTransaction, error) {
+	q := `SELECT ` + ledgerTransactionColumns + ` FROM ledger_transactions
+WHERE (authorization_id = $1 OR transaction_id = $1) AND ` + reconcilableTransactionFilter + `
+AND NOT EXISTS (SELECT 1 FROM bank_statement_entries WHERE matched_transaction_id = ledger_transactions.transaction_id)
+ORDER BY occurred_at ASC, transaction_id ASC`
+	return s.queryLedgerTransactions(ctx, q, reference)
+}
+
+func (s *LedgerService) bankingDayTransactionsFromDB(ctx context.Context, day string) ([]*rgsv1.LedgerTransaction, error) {
+	from, to, err := bankingDayBounds(day)
+	if err != nil {
+		return nil, err
+	}
+	q := `SELECT ` + ledgerTransactionColumns + ` FROM ledger_transactions
+WHERE occurred_at >= $1 AND occurred_at < $2 AND ` + reconcilableTransactionFilter + `
+ORDER BY occurred_at ASC, transaction_id ASC`
+	return s.queryLedgerTransactions(ctx, q, from, to)
+}
+
+func (s *LedgerService) transactionReconciledFromDB(ctx context.Context, txID string) (bool, error) {
+	const q = `SELECT EXISTS (SELECT 1 FROM bank_statement_entries WHERE matched_transaction_id = $1)`
+	var ok bool
+	err := s.db.QueryRowContext(ctx, q, txID).Scan(&ok)
+	return ok, err
+}
+
+func (s *LedgerService) bankStatementImportedFromDB(ctx context.Context, hash []byte) (bool, error) {
+	const q = `SELECT EXISTS (SELECT 1 FROM bank_statements WHERE content_sha256 = $1)`
+	var ok bool
+	err := s.db.QueryRowContext(ctx, q, hash).Scan(&ok)
+	return ok, err
+}
+
+func insertReconciliationExceptionTx(ctx context.Context, dbtx *sql.Tx, exc *rgsv1.ReconciliationException) error {
+	const q = `
+INSERT INTO reconciliation_exceptions (
+  exception_id, banking_day, kind, status, entry_id, transaction_id, created_at
+)
+VALUES ($1,$2::date,$3,$4,$5,$6,$7::timestamptz)
+ON CONFLICT (exception_id) DO NOTHING
+`
+	_, err := dbtx.ExecContext(ctx, q,
+		exc.ExceptionId,
+		exc.BankingDay,
+		reconciliationExceptionKindToDB(exc.Kind),
+		reconciliationExceptionStatusToDB(exc.Status),
+		exc.GetEntry().GetEntryId(),
+		exc.GetTransaction().GetTransactionId(),
+		exc.CreatedAt,
+	)
+	return err
+}
+
+func clearReconciliationExceptionTx(ctx context.Context, dbtx *sql.Tx, exceptionID string, now time.Time) error {
+	const q = `
+UPDATE reconciliation_exceptions
+SET status = 'CLEARED', resolved_at = $2
+WHERE exception_id = $1 AND status = 'OPEN'
+`
+	_, err := dbtx.ExecContext(ctx, q, exceptionID, now)
+	return err
+}
+
+// isUniqueViolation reports whether err is a Postgres unique_violation; here
+// it means another instance reconciled the same transaction first.
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
+
+func (s *LedgerService) persistBankStatementImportToDB(ctx context.Context, imp *bankStatementImport) error {
+	dbtx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = dbtx.Rollback()
+	}()
+	const stmtQ = `
+INSERT INTO bank_statements (statement_id, content_sha256, format, entry_count, imported_by, imported_at)
+VALUES ($1,$2,$3,$4,$5,$6)
+`
+	if _, err := dbtx.ExecContext(ctx, stmtQ, imp.statementID, imp.hash, imp.format.String(), len(imp.entries), imp.importedBy, imp.importedAt); err != nil {
+		return err
+	}
+	const entryQ = `
+INSERT INTO bank_statement_entries (
+  entry_id, statement_id, line_no, banking_day, direction, amount_minor, currency_code,
+  reference, description, matched_transaction_id, manually_matched
+)
+VALUES ($1,$2,$3,$4::date,$5,$6,$7,$8,$9,$10,FALSE)
+`
+	for i, e := range imp.entries {
+		if _, err := dbtx.ExecContext(ctx, entryQ,
+			e.EntryId, e.StatementId, i+1, e.BankingDay, bankEntryDirectionToDB(e.Direction),
+			e.Amount.GetAmountMinor(), e.Amount.GetCurrency(), e.Reference, e.Description, e.MatchedTransactionId,
+		); err != nil {
+			if isUniqueViolation(err) {
+				return errTransactionReconciled
+			}
+			return err
+		}
+	}
+	for _, exc := range imp.opened {
+		if err := insertReconciliationExceptionTx(ctx, dbtx, exc); err != nil {
+			return err
+		}
+	}
+	for _, id := range imp.cleared {
+		if err := clearReconciliationExceptionTx(ctx, dbtx, id, imp.importedAt); err != nil {
+			return err
+		}
+	}
+	return dbtx.Commit()
+}
+
+const bankEntryColumns = `
+entry_id, statement_id, banking_day, direction, amount_minor, currency_code,
+reference, description, matched_transaction_id, manually_matched
+`
+
+func scanBankEntry(row wagerScanner) (*rgsv1.BankStatementEntry, error) {
+	var (
+		e                   rgsv1.BankStatementEntry
+		day                 time.Time
+		direction, currency string
+		amount              int64
+	)
+	if err := row.Scan(&e.EntryId, &e.StatementId, &day, &direction, &amount, &currency, &e.Reference, &e.Description, &e.MatchedTransactionId, &e.ManuallyMatched); err != nil {
+		return nil, err
+	}
+	e.BankingDay = day.Format(gamingDayLayout)
+	e.Direction = bankEntryDirectionFromDB(direction)
+	e.Amount = money.New(amount, strings.TrimSpace(currency))
+	return &e, nil
+}
+
+func (s *LedgerService) bankEntriesForDayFromDB(ctx context.Context, day string) ([]*rgsv1.BankStatementEntry, error) {
+	q := `SELECT ` + bankEntryColumns + ` FROM bank_statement_entries WHERE banking_day = $1::date ORDER BY statement_id, line_no`
+	rows, err := s.db.QueryContext(ctx, q, day)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []*rgsv1.BankStatementEntry
+	for rows.Next() {
+		e, err := scanBankEntry(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, e)
+	}
+	return out, rows.Err()
+}
+
+const reconciliationExceptionColumns = `
+exception_id, banking_day, kind, status, entry_id, transaction_id, created_at,
+resolved_at, resolved_by, resolution_note
+`
+
+// listReconciliationExceptionRows loads exceptions and then attaches the
+// bank entry or ledger transaction each one is about.
+func (s *LedgerService) listReconciliationExceptionRows(ctx context.Context, where string, args ...any) ([]*rgsv1.ReconciliationException, error) {
+	q := `SELECT ` + reconciliationExceptionColumns + ` FROM reconciliation_exceptions ` + where + ` ORDER BY banking_day ASC, exception_id ASC`
+	rows, err := s.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		out      []*rgsv1.ReconciliationException
+		entryIDs []string
+		txIDs    []string
+	)
+	for rows.Next() {
+		var (
+			exc           rgsv1.ReconciliationException
+			day, created  time.Time
+			resolved      sql.NullTime
+			kind, status  string
+			entryID, txID string
+		)
+		if err := rows.Scan(&exc.ExceptionId, &day, &kind, &status, &entryID, &txID, &created, &resolved, &exc.ResolvedBy, &exc.ResolutionNote); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		exc.BankingDay = day.Format(gamingDayLayout)
+		exc.Kind = reconciliationExceptionKindFromDB(kind)
+		exc.Status = reconciliationExceptionStatusFromDB(status)
+		exc.CreatedAt = created.UTC().Format(time.RFC3339Nano)
+		if resolved.Valid {
+			exc.ResolvedAt = resolved.Time.UTC().Format(time.RFC3339Nano)
+		}
+		out = append(out, &exc)
+		entryIDs = append(entryIDs, entryID)
+		txIDs = append(txIDs, txID)
+	}
+	if err := rows.Err(); err != nil {
+		rows.Close()
+		return nil, err
+	}
+	rows.Close()
+	for i, exc := range out {
+		if entryIDs[i] != "" {
+			q := `SELECT ` + bankEntryColumns + ` FROM bank_statement_entries WHERE entry_id = $1`
+			e, err := scanBankEntry(s.db.QueryRowContext(ctx, q, entryIDs[i]))
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				return nil, err
+			}
+			exc.Entry = e
+		}
+		if txIDs[i] != "" {
+			tx, _, _, err := s.getLedgerTransactionFromDB(ctx, txIDs[i])
+			if err != nil {
+				return nil, err
+			}
+			exc.Transaction = tx
+		}
+	}
+	return out, nil
+}
+
+func (s *LedgerService) getReconciliationExceptionFromDB(ctx context.Context, id string) (*rgsv1.ReconciliationException, error) {
+	out, err := s.listReconciliationExceptionRows(ctx, `WHERE exception_id = $1`, id)
+	if err != nil || len(out) == 0 {
+		return nil, err
+	}
+	return out[0], nil
+}
+
+func (s *LedgerService) listReconciliationExceptionsFromDB(ctx context.Context, day string, status rgsv1.ReconciliationExceptionStatus) ([]*rgsv1.ReconciliationException, error) {
+	statusFilter := ""
+	if status != rgsv1.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_STATUS_UNSPECIFIED {
+		statusFilter = reconciliationExceptionStatusToDB(status)
+	}
+	return s.listReconciliationExceptionRows(ctx,
+		`WHERE ($1 = '' OR banking_day = NULLIF($1,'')::date) AND ($2 = '' OR status = $2)`,
+		day, statusFilter)
+}
+
+func (s *LedgerService) persistReconciliationResolutionToDB(ctx context.Context, exc *rgsv1.ReconciliationException) error {
+	dbtx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = dbtx.Rollback()
+	}()
+	resolvedAt, _ := time.Parse(time.RFC3339Nano, exc.ResolvedAt)
+	const q = `
+UPDATE reconciliation_exceptions
+SET status = $2, resolved_at = $3, resolved_by = $4, resolution_note = $5
+WHERE exception_id = $1
+`
+	if _, err := dbtx.ExecContext(ctx, q, exc.ExceptionId, reconciliationExceptionStatusToDB(exc.Status), resolvedAt, exc.ResolvedBy, exc.ResolutionNote); err != nil {
+		return err
+	}
+	if txID := exc.GetEntry().GetMatchedTransactionId(); txID != "" {
+		const matchQ = `
+UPDATE bank_statement_entries
+SET matched_transaction_id = $2, manually_matched = TRUE
+WHERE entry_id = $1
+`
+		if _, err := dbtx.ExecContext(ctx, matchQ, exc.Entry.EntryId, txID); err != nil {
+			if isUniqueViolation(err) {
+				return errTransactionReconciled
+			}
+			return err
+		}
+		if err := clearReconciliationExceptionTx(ctx, dbtx, ledgerExceptionID(txID), resolvedAt); err != nil {
+			return err
+		}
+	}
+	return dbtx.Commit()
+}
@@ -0,0 +1,196 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func importStatement(svc *LedgerService, format rgsv1.BankStatementFormat, content string) *rgsv1.ImportBankStatementResponse {
+	resp, _ := svc.ImportBankStatement(context.Background(), &rgsv1.ImportBankStatementRequest{
+		Meta:    meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		Format:  format,
+		Content: []byte(content),
+	})
+	return resp
+}
+
+func reconciliationReport(t *testing.T, svc *LedgerService, day string) *rgsv1.ReconciliationDayReport {
+	t.Helper()
+	resp, _ := svc.GetReconciliationReport(context.Background(), &rgsv1.GetReconciliationReportRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), BankingDay: day})
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("report %s: %+v", day, resp.Meta)
+	}
+	return resp.Report
+}
+
+func TestLedgerBankStatementReconciliation(t *testing.T) {
+	svc := NewLedgerService(ledgerFixedClock{now: time.Date(2026, 2, 19, 10, 0, 0, 0, time.UTC)})
+	ctx := context.Background()
+	deposit := func(idem, auth string, amount int64) *rgsv1.LedgerTransaction {
+		resp, _ := svc.Deposit(ctx, &rgsv1.DepositRequest{Meta: meta("acct-r1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, idem), AccountId: "acct-r1", Amount: &rgsv1.Money{AmountMinor: amount, Currency: "USD"}, AuthorizationId: auth})
+		return resp.Transaction
+	}
+	deposit("dep-r1", "AUTH-1", 500)
+	late := deposit("dep-r2", "AUTH-2", 300)
+	wd, _ := svc.Withdraw(ctx, &rgsv1.WithdrawRequest{Meta: meta("acct-r1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "wd-r1"), AccountId: "acct-r1", Amount: &rgsv1.Money{AmountMinor: 200, Currency: "USD"}})
+	if wd.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("withdraw failed: %+v", wd.Meta)
+	}
+
+	// Withdrawals carry no authorization id, so the bank references the
+	// ledger transaction id.
+	csvStatement := "booking_date,amount,currency,reference,description\n" +
+		"2026-02-19,5.00,USD,AUTH-1,player top-up\n" +
+		"2026-02-19,-2.00,USD," + wd.Transaction.TransactionId + ",payout\n" +
+		"2026-02-19,9.99,USD,UNKNOWN-REF,stray credit\n"
+	first := importStatement(svc, rgsv1.BankStatementFormat_BANK_STATEMENT_FORMAT_CSV, csvStatement)
+	if first.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || first.EntriesImported != 3 || first.EntriesMatched != 2 || first.ExceptionsOpened != 2 {
+		t.Fatalf("unexpected import result: %+v", first)
+	}
+	if len(first.BankingDays) != 1 || first.BankingDays[0] != "2026-02-19" {
+		t.Fatalf("unexpected banking days: %v", first.BankingDays)
+	}
+	report := reconciliationReport(t, svc, "2026-02-19")
+	if report.Status != rgsv1.ReconciliationDayStatus_RECONCILIATION_DAY_STATUS_EXCEPTIONS || report.OpenExceptions != 2 || report.MatchedEntries != 2 || report.LedgerTransactions != 3 {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+	if tot := report.Totals; len(tot) != 1 || tot[0].BankCreditsMinor != 1499 || tot[0].BankDebitsMinor != 200 || tot[0].LedgerDepositsMinor != 800 || tot[0].LedgerWithdrawalsMinor != 200 {
+		t.Fatalf("unexpected totals: %+v", report.Totals)
+	}
+	if dup := importStatement(svc, rgsv1.BankStatementFormat_BANK_STATEMENT_FORMAT_CSV, csvStatement); dup.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID || dup.Meta.GetDenialReason() != "statement already imported" {
+		t.Fatalf("expected duplicate statement rejected, got=%+v", dup.Meta)
+	}
+
+	// The bank books the second deposit a day late; the match clears its
+	// ledger exception without opening anything on the new day.
+	const camt = `<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.02">
+  <BkToCstmrStmt><Stmt><Ntry>
+    <Amt Ccy="USD">3.00</Amt><CdtDbtInd>CRDT</CdtDbtInd>
+    <BookgDt><Dt>2026-02-20</Dt></BookgDt>
+    <AcctSvcrRef>BANK-778</AcctSvcrRef>
+    <NtryDtls><TxDtls><Refs><EndToEndId>AUTH-2</EndToEndId></Refs><RmtInf><Ustrd>casino top-up</Ustrd></RmtInf></TxDtls></NtryDtls>
+  </Ntry></Stmt></BkToCstmrStmt>
+</Document>`
+	second := importStatement(svc, rgsv1.BankStatementFormat_BANK_STATEMENT_FORMAT_CAMT053, camt)
+	if second.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || second.EntriesMatched != 1 || second.ExceptionsOpened != 0 {
+		t.Fatalf("unexpected camt import: %+v", second)
+	}
+	cleared, _ := svc.getReconciliationException(ctx, ledgerExceptionID(late.TransactionId))
+	if cleared.GetStatus() != rgsv1.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_STATUS_CLEARED {
+		t.Fatalf("expected late deposit exception cleared, got=%+v", cleared)
+	}
+
+	open, _ := svc.ListReconciliationExceptions(ctx, &rgsv1.ListReconciliationExceptionsRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), Status: rgsv1.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_STATUS_OPEN})
+	if len(open.Exceptions) != 1 || open.Exceptions[0].Entry.GetReference() != "UNKNOWN-REF" {
+		t.Fatalf("expected only the stray entry open, got=%+v", open.Exceptions)
+	}
+	resolved, _ := svc.ResolveReconciliationException(ctx, &rgsv1.ResolveReconciliationExceptionRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), ExceptionId: open.Exceptions[0].ExceptionId, Note: "bank fee refund, not player funds"})
+	if resolved.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || resolved.Exception.ResolvedBy != "op-1" || resolved.Exception.Status != rgsv1.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_STATUS_RESOLVED {
+		t.Fatalf("unexpected resolution: %+v", resolved)
+	}
+	again, _ := svc.ResolveReconciliationException(ctx, &rgsv1.ResolveReconciliationExceptionRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), ExceptionId: open.Exceptions[0].ExceptionId, Note: "again"})
+	if again.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected resolved exception to stay closed, got=%+v", again.Meta)
+	}
+	report = reconciliationReport(t, svc, "2026-02-19")
+	if report.Status != rgsv1.ReconciliationDayStatus_RECONCILIATION_DAY_STATUS_RECONCILED || report.OpenExceptions != 0 || report.ResolvedExceptions != 1 {
+		t.Fatalf("expected day reconciled, got=%+v", report)
+	}
+	if none := reconciliationReport(t, svc, "2026-02-18"); none.Status != rgsv1.ReconciliationDayStatus_RECONCILIATION_DAY_STATUS_NOT_IMPORTED {
+		t.Fatalf("expected day without statement not imported, got=%+v", none)
+	}
+	events := auditEvents(svc.AuditStore)
+	if last := events[len(events)-1]; last.Action != "resolve_reconciliation_exception" || last.Reason != "bank fee refund, not player funds" {
+		t.Fatalf("expected resolution audited, got=%+v", last)
+	}
+}
+
+func TestLedgerBankReconciliationManualMatch(t *testing.T) {
+	svc := NewLedgerService(ledgerFixedClock{now: time.Date(2026, 2, 19, 10, 0, 0, 0, time.UTC)})
+	ctx := context.Background()
+	dep, _ := svc.Deposit(ctx, &rgsv1.DepositRequest{Meta: meta("acct-r2", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "dep-m1"), AccountId: "acct-r2", Amount: &rgsv1.Money{AmountMinor: 1000, Currency: "USD"}})
+	voided, _ := svc.Deposit(ctx, &rgsv1.DepositRequest{Meta: meta("acct-r2", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "dep-m2"), AccountId: "acct-r2", Amount: &rgsv1.Money{AmountMinor: 1000, Currency: "USD"}, AuthorizationId: "AUTH-V"})
+	if v := voidTx(svc, rgsv1.ActorType_ACTOR_TYPE_OPERATOR, voided.Transaction.TransactionId); v.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("void failed: %+v", v.Meta)
+	}
+
+	resp := importStatement(svc, rgsv1.BankStatementFormat_BANK_STATEMENT_FORMAT_CSV,
+		"booking_date,direction,amount,currency,reference\n2026-02-19,credit,10.00,USD,bank-xyz\n2026-02-19,credit,10.00,USD,AUTH-V\n")
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || resp.EntriesMatched != 0 || resp.ExceptionsOpened != 3 {
+		t.Fatalf("expected both entries and the live deposit excepted, got=%+v", resp)
+	}
+	entryException := bankExceptionID(resp.StatementId + "-1")
+	resolve := func(txID string) *rgsv1.ResolveReconciliationExceptionResponse {
+		r, _ := svc.ResolveReconciliationException(ctx, &rgsv1.ResolveReconciliationExceptionRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), ExceptionId: entryException, Note: "matched by cashier slip", TransactionId: txID})
+		return r
+	}
+	for txID, reason := range map[string]string{
+		"tx-missing":                     "transaction not found",
+		voided.Transaction.TransactionId: "transaction has been voided",
+	} {
+		if r := resolve(txID); r.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID || r.Meta.GetDenialReason() != reason {
+			t.Fatalf("%s: expected %q, got=%+v", txID, reason, r.Meta)
+		}
+	}
+	matched := resolve(dep.Transaction.TransactionId)
+	if matched.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || !matched.Exception.Entry.GetManuallyMatched() || matched.Exception.Entry.GetMatchedTransactionId() != dep.Transaction.TransactionId {
+		t.Fatalf("unexpected manual match: %+v", matched)
+	}
+	ledgerExc, _ := svc.getReconciliationException(ctx, ledgerExceptionID(dep.Transaction.TransactionId))
+	if ledgerExc.GetStatus() != rgsv1.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_STATUS_CLEARED {
+		t.Fatalf("expected manual match to clear the deposit exception, got=%+v", ledgerExc)
+	}
+	other, _ := svc.ResolveReconciliationException(ctx, &rgsv1.ResolveReconciliationExceptionRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), ExceptionId: bankExceptionID(resp.StatementId + "-2"), Note: "n", TransactionId: dep.Transaction.TransactionId})
+	if other.Meta.GetDenialReason() != "transaction already reconciled" {
+		t.Fatalf("expected double match rejected, got=%+v", other.Meta)
+	}
+}
+
+func TestLedgerBankReconciliationValidation(t *testing.T) {
+	svc := NewLedgerService(ledgerFixedClock{now: time.Date(2026, 2, 19, 10, 0, 0, 0, time.UTC)})
+	ctx := context.Background()
+	for _, tc := range []struct {
+		format  rgsv1.BankStatementFormat
+		content string
+		reason  string
+	}{
+		{rgsv1.BankStatementFormat_BANK_STATEMENT_FORMAT_UNSPECIFIED, "x", "format is required"},
+		{rgsv1.BankStatementFormat_BANK_STATEMENT_FORMAT_CSV, "booking_date,amount,currency\n", "statement is missing the reference column"},
+		{rgsv1.BankStatementFormat_BANK_STATEMENT_FORMAT_CSV, "booking_date,amount,currency,reference\n19/02/2026,1.00,USD,r\n", "line 2: booking_date must be YYYY-MM-DD"},
+		{rgsv1.BankStatementFormat_BANK_STATEMENT_FORMAT_CSV, "booking_date,amount,currency,reference\n2026-02-19,1.001,USD,r\n", "line 2: invalid amount"},
+		{rgsv1.BankStatementFormat_BANK_STATEMENT_FORMAT_CSV, "booking_date,amount,currency,reference\n", "statement has no entries"},
+		{rgsv1.BankStatementFormat_BANK_STATEMENT_FORMAT_CAMT053, "<Document><BkToCstmrStmt><Stmt><Ntry><Amt Ccy=\"USD\">1.00</Amt><CdtDbtInd>X</CdtDbtInd></Ntry></Stmt></BkToCstmrStmt></Document>", "entry 1: CdtDbtInd must be CRDT or DBIT"},
+	} {
+		if resp := importStatement(svc, tc.format, tc.content); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID || resp.Meta.GetDenialReason() != tc.reason {
+			t.Fatalf("%q: expected %q, got=%+v", tc.content, tc.reason, resp.Meta)
+		}
+	}
+
+	entries, err := parseBankStatementCAMT053([]byte(`<Document><BkToCstmrStmt><Stmt><Ntry>
+<Amt Ccy="EUR">12.50</Amt><CdtDbtInd>DBIT</CdtDbtInd><BookgDt><DtTm>2026-02-19T16:00:00+01:00</DtTm></BookgDt>
+<NtryDtls><TxDtls><Refs><EndToEndId>NOTPROVIDED</EndToEndId></Refs><RmtInf><Ustrd>WD-77</Ustrd></RmtInf></TxDtls></NtryDtls>
+</Ntry></Stmt></BkToCstmrStmt></Document>`))
+	if err != nil || len(entries) != 1 || entries[0].Reference != "WD-77" || entries[0].BankingDay != "2026-02-19" ||
+		entries[0].Direction != rgsv1.BankEntryDirection_BANK_ENTRY_DIRECTION_DEBIT || entries[0].Amount.GetAmountMinor() != 1250 {
+		t.Fatalf("unexpected camt entry: %+v err=%v", entries, err)
+	}
+
+	denied, _ := svc.ImportBankStatement(ctx, &rgsv1.ImportBankStatementRequest{Meta: meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""), Format: rgsv1.BankStatementFormat_BANK_STATEMENT_FORMAT_CSV, Content: []byte("x")})
+	if denied.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected player import denied, got=%+v", denied.Meta)
+	}
+	for _, m := range []*rgsv1.RequestMeta{meta("svc-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, ""), meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "")} {
+		list, _ := svc.ListReconciliationExceptions(ctx, &rgsv1.ListReconciliationExceptionsRequest{Meta: m})
+		report, _ := svc.GetReconciliationReport(ctx, &rgsv1.GetReconciliationReportRequest{Meta: m, BankingDay: "2026-02-19"})
+		if list.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED || report.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+			t.Fatalf("expected non-operator denied, list=%+v report=%+v", list.Meta, report.Meta)
+		}
+	}
+	if bad, _ := svc.GetReconciliationReport(ctx, &rgsv1.GetReconciliationReportRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), BankingDay: "2026-2-19"}); bad.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected malformed banking day invalid, got=%+v", bad.Meta)
+	}
+}
@@ -0,0 +1,364 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/money"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultTransferAckTimeout = 5 * time.Minute
+	// unresolvedTransferScanLimit bounds a single listing or timeout sweep.
+	unresolvedTransferScanLimit = 1000
+	awaitingDeviceAckReason     = "awaiting device acknowledgment"
+)
+
+var errAuditUnavailable = errors.New("audit unavailable")
+
+// SetTransferAckTimeout sets how long a device has to acknowledge a transfer
+// before the timeout sweep reverses the escrowed funds.
+func (s *LedgerService) SetTransferAckTimeout(timeout time.Duration) {
+	if s == nil {
+		return
+	}
+	if timeout <= 0 {
+		timeout = defaultTransferAckTimeout
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.transferAckTimeout = timeout
+}
+
+func (s *LedgerService) getTransferAckTimeout() time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.transferAckTimeout
+}
+
+func cloneUnresolvedTransfer(in *rgsv1.UnresolvedTransfer) *rgsv1.UnresolvedTransfer {
+	if in == nil {
+		return nil
+	}
+	cp, _ := proto.Clone(in).(*rgsv1.UnresolvedTransfer)
+	return cp
+}
+
+func (s *LedgerService) newPendingDeviceTransfer(req *rgsv1.TransferToDeviceRequest, tx *rgsv1.LedgerTransaction, reason string) *rgsv1.UnresolvedTransfer {
+	if reason == "" {
+		reason = awaitingDeviceAckReason
+	}
+	occurred := parseTS(tx.OccurredAt)
+	return &rgsv1.UnresolvedTransfer{
+		TransferId:        s.newTransferID(),
+		AccountId:         req.AccountId,
+		DeviceId:          req.DeviceId,
+		RequestedAmount:   money.New(req.RequestedAmount.GetAmountMinor(), tx.Amount.GetCurrency()),
+		TransferredAmount: money.New(tx.Amount.GetAmountMinor(), tx.Amount.GetCurrency()),
+		Status:            rgsv1.UnresolvedTransferStatus_UNRESOLVED_TRANSFER_STATUS_OPEN,
+		Reason:            reason,
+		TransactionId:     tx.TransactionId,
+		CreatedAt:         tx.OccurredAt,
+		AckDeadlineAt:     occurred.Add(s.getTransferAckTimeout()).Format(time.RFC3339Nano),
+		Attempts:          1,
+	}
+}
+
+// storeUnresolvedTransfer keeps the in-memory copy used when no database is
+// configured; with a database cashless_unresolved_transfers is authoritative.
+func (s *LedgerService) storeUnresolvedTransfer(t *rgsv1.UnresolvedTransfer) {
+	if s.dbEnabled() || t == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.unresolvedTransfers[t.TransferId] = cloneUnresolvedTransfer(t)
+}
+
+func (s *LedgerService) loadUnresolvedTransfer(ctx context.Context, transferID string) (*rgsv1.UnresolvedTransfer, error) {
+	if s.dbEnabled() {
+		return s.getUnresolvedTransferFromDB(ctx, transferID)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return cloneUnresolvedTransfer(s.unresolvedTransfers[transferID]), nil
+}
+
+func (s *LedgerService) listUnresolvedTransfers(ctx context.Context, accountID, deviceID string, includeClosed bool, dueBy time.Time) ([]*rgsv1.UnresolvedTransfer, error) {
+	if s.dbEnabled() {
+		return s.listUnresolvedTransfersFromDB(ctx, accountID, deviceID, includeClosed, dueBy, unresolvedTransferScanLimit)
+	}
+	s.mu.Lock()
+	out := make([]*rgsv1.UnresolvedTransfer, 0)
+	for _, t := range s.unresolvedTransfers {
+		open := t.Status == rgsv1.UnresolvedTransferStatus_UNRESOLVED_TRANSFER_STATUS_OPEN
+		if (accountID != "" && t.AccountId != accountID) || (deviceID != "" && t.DeviceId != deviceID) || (!includeClosed && !open) {
+			continue
+		}
+		if !dueBy.IsZero() && (!open || parseTS(t.AckDeadlineAt).After(dueBy)) {
+			continue
+		}
+		out = append(out, cloneUnresolvedTransfer(t))
+	}
+	s.mu.Unlock()
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].CreatedAt == out[j].CreatedAt {
+			return out[i].TransferId < out[j].TransferId
+		}
+		return parseTS(out[i].CreatedAt).Before(parseTS(out[j].CreatedAt))
+	})
+	if len(out) > unresolvedTransferScanLimit {
+		out = out[:unresolvedTransferScanLimit]
+	}
+	return out, nil
+}
+
+// authorizeTransferResolution admits operators and the device-facing
+// services that relay device acknowledgments.
+func (s *LedgerService) authorizeTransferResolution(ctx context.Context, meta *rgsv1.RequestMeta) (bool, string) {
+	actor, reason := resolveActor(ctx, meta)
+	if reason != "" {
+		return false, reason
+	}
+	switch actor.ActorType {
+	case rgsv1.ActorType_ACTOR_TYPE_OPERATOR, rgsv1.ActorType_ACTOR_TYPE_SERVICE:
+		return true, ""
+	default:
+		return false, "unauthorized actor type"
+	}
+}
+
+func (s *LedgerService) ListUnresolvedTransfers(ctx context.Context, req *rgsv1.ListUnresolvedTransfersRequest) (*rgsv1.ListUnresolvedTransfersResponse, error) {
+	if req == nil {
+		req = &rgsv1.ListUnresolvedTransfersRequest{}
+	}
+	if ok, reason := s.authorizeTransferResolution(ctx, req.Meta); !ok {
+		s.auditDenied(req.Meta, "cashless_transfer", "", "list_unresolved_transfers", reason)
+		return &rgsv1.ListUnresolvedTransfersResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	if req.PageSize < 0 || req.PageSize > maxAuditPageSize {
+		return &rgsv1.ListUnresolvedTransfersResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "invalid page_size")}, nil
+	}
+	if err := validatePageToken(req.PageToken); err != nil {
+		return &rgsv1.ListUnresolvedTransfersResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "invalid page_token")}, nil
+	}
+	items, err := s.listUnresolvedTransfers(ctx, req.AccountId, req.DeviceId, req.IncludeClosed, time.Time{})
+	if err != nil {
+		return &rgsv1.ListUnresolvedTransfersResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	page, next, err := paginate(items, req.PageToken, req.PageSize)
+	if err != nil {
+		return &rgsv1.ListUnresolvedTransfersResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "invalid page_token")}, nil
+	}
+	return &rgsv1.ListUnresolvedTransfersResponse{
+		Meta:          s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
+		Transfers:     page,
+		NextPageToken: next,
+	}, nil
+}
+
+func transferResolutionFor(action rgsv1.TransferResolutionAction) string {
+	switch action {
+	case rgsv1.TransferResolutionAction_TRANSFER_RESOLUTION_ACTION_ACKNOWLEDGE:
+		return "acknowledged"
+	case rgsv1.TransferResolutionAction_TRANSFER_RESOLUTION_ACTION_REVERSE:
+		return "reversed"
+	default:
+		return ""
+	}
+}
+
+func (s *LedgerService) ResolveTransfer(ctx context.Context, req *rgsv1.ResolveTransferRequest) (*rgsv1.ResolveTransferResponse, error) {
+	if req == nil || req.TransferId == "" {
+		return &rgsv1.ResolveTransferResponse{Meta: s.responseMeta(nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "transfer_id is required")}, nil
+	}
+	if req.Action == rgsv1.TransferResolutionAction_TRANSFER_RESOLUTION_ACTION_UNSPECIFIED {
+		return &rgsv1.ResolveTransferResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "action is required")}, nil
+	}
+	if ok, reason := s.authorizeTransferResolution(ctx, req.Meta); !ok {
+		s.auditDenied(req.Meta, "cashless_transfer", req.TransferId, "resolve_transfer", reason)
+		return &rgsv1.ResolveTransferResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+
+	t, err := s.loadUnresolvedTransfer(ctx, req.TransferId)
+	if err != nil {
+		return &rgsv1.ResolveTransferResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if t == nil {
+		return &rgsv1.ResolveTransferResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "transfer not found")}, nil
+	}
+
+	// Reversal moves the account balance, so all resolutions take the
+	// account lock and re-read the transfer under it.
+	unlock := s.acctLocks.lock(t.AccountId)
+	defer unlock()
+	t, err = s.loadUnresolvedTransfer(ctx, req.TransferId)
+	if err != nil || t == nil {
+		return &rgsv1.ResolveTransferResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if t.Status != rgsv1.UnresolvedTransferStatus_UNRESOLVED_TRANSFER_STATUS_OPEN {
+		// Repeating the action that closed the transfer is a no-op.
+		if t.Resolution != "" && t.Resolution == transferResolutionFor(req.Action) {
+			return &rgsv1.ResolveTransferResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Transfer: t}, nil
+		}
+		return &rgsv1.ResolveTransferResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "transfer already closed")}, nil
+	}
+
+	now := s.now()
+	before, _ := json.Marshal(t)
+	updated := cloneUnresolvedTransfer(t)
+	updated.ResolutionNote = req.Note
+	switch req.Action {
+	case rgsv1.TransferResolutionAction_TRANSFER_RESOLUTION_ACTION_ACKNOWLEDGE:
+		updated.Status = rgsv1.UnresolvedTransferStatus_UNRESOLVED_TRANSFER_STATUS_RESOLVED
+		updated.Resolution = "acknowledged"
+		updated.ResolvedAt = now.Format(time.RFC3339Nano)
+	case rgsv1.TransferResolutionAction_TRANSFER_RESOLUTION_ACTION_RETRY:
+		updated.Attempts++
+		updated.AckDeadlineAt = now.Add(s.getTransferAckTimeout()).Format(time.RFC3339Nano)
+	case rgsv1.TransferResolutionAction_TRANSFER_RESOLUTION_ACTION_REVERSE:
+		reversal, acct, err := s.reverseTransferLocked(ctx, req.Meta, updated, "reversed", now)
+		if errors.Is(err, errTransferNotOpen) {
+			return &rgsv1.ResolveTransferResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "transfer already closed")}, nil
+		}
+		if errors.Is(err, errAuditUnavailable) {
+			return &rgsv1.ResolveTransferResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+		}
+		if errors.Is(err, money.ErrOverflow) {
+			return &rgsv1.ResolveTransferResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "amount exceeds balance limit")}, nil
+		}
+		if err != nil {
+			return &rgsv1.ResolveTransferResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+		return &rgsv1.ResolveTransferResponse{
+			Meta:                s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
+			Transfer:            updated,
+			ReversalTransaction: reversal,
+			AvailableBalance:    money.New(acct.available, acct.currency),
+		}, nil
+	default:
+		return &rgsv1.ResolveTransferResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "unsupported action")}, nil
+	}
+
+	after, _ := json.Marshal(updated)
+	action := "acknowledge_transfer"
+	if req.Action == rgsv1.TransferResolutionAction_TRANSFER_RESOLUTION_ACTION_RETRY {
+		action = "retry_transfer"
+	}
+	if err := s.appendAudit(req.Meta, "cashless_transfer", updated.TransferId, action, before, after, audit.ResultSuccess, req.Note); err != nil {
+		return &rgsv1.ResolveTransferResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	if err := s.persistTransferResolution(ctx, updated); err != nil {
+		if errors.Is(err, errTransferNotOpen) {
+			return &rgsv1.ResolveTransferResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "transfer already closed")}, nil
+		}
+		return &rgsv1.ResolveTransferResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	s.storeUnresolvedTransfer(updated)
+	return &rgsv1.ResolveTransferResponse{
+		Meta:     s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
+		Transfer: updated,
+	}, nil
+}
+
+// reverseTransferLocked returns a transfer's escrowed funds from the device to
+// the account and closes it as cancelled. t is updated in place. The caller
+// must hold the account lock.
+func (s *LedgerService) reverseTransferLocked(ctx context.Context, meta *rgsv1.RequestMeta, t *rgsv1.UnresolvedTransfer, resolution string, now time.Time) (*rgsv1.LedgerTransaction, *ledgerAccount, error) {
+	amount := t.TransferredAmount.GetAmountMinor()
+	currency := t.TransferredAmount.GetCurrency()
+	acct, err := s.mutationAccountState(ctx, t.AccountId, currency)
+	if err != nil {
+		return nil, nil, err
+	}
+	postings := []ledgerPosting{
+		{accountID: "device_escrow:" + t.DeviceId, direction: "debit", amount: amount, currency: currency, createdAt: now},
+		{accountID: t.AccountId, direction: "credit", amount: amount, currency: currency, createdAt: now},
+	}
+	before := snapshotAccount(acct)
+	if acct.available, err = money.AddMinor(acct.available, amount); err != nil {
+		return nil, nil, err
+	}
+	tx := &rgsv1.LedgerTransaction{
+		TransactionId:   s.newTxID(),
+		AccountId:       t.AccountId,
+		TransactionType: rgsv1.LedgerTransactionType_LEDGER_TRANSACTION_TYPE_TRANSFER_TO_ACCOUNT,
+		Amount:          money.New(amount, currency),
+		OccurredAt:      now.Format(time.RFC3339Nano),
+		Description:     "transfer to device reversed: " + t.TransferId,
+	}
+	t.Status = rgsv1.UnresolvedTransferStatus_UNRESOLVED_TRANSFER_STATUS_CANCELLED
+	t.Resolution = resolution
+	t.ResolvedAt = now.Format(time.RFC3339Nano)
+	t.ReversalTransactionId = tx.TransactionId
+
+	if err := s.appendAudit(meta, "ledger_account", t.AccountId, "reverse_transfer_to_device", before, snapshotAccount(acct), audit.ResultSuccess, resolution); err != nil {
+		return nil, nil, errAuditUnavailable
+	}
+	if err := s.persistTransferReversal(ctx, tx, postings, t); err != nil {
+		return nil, nil, err
+	}
+	s.commitMutation(acct, tx, postings)
+	s.storeUnresolvedTransfer(t)
+	return tx, acct, nil
+}
+
+// ReverseExpiredTransfers reverses open transfers whose device never
+// acknowledged them before the deadline.
+func (s *LedgerService) ReverseExpiredTransfers(ctx context.Context) (int, error) {
+	if s == nil {
+		return 0, nil
+	}
+	due, err := s.listUnresolvedTransfers(ctx, "", "", false, s.now())
+	if err != nil {
+		return 0, err
+	}
+	reversed := 0
+	for _, candidate := range due {
+		n, err := s.reverseExpiredTransfer(ctx, candidate)
+		if err != nil {
+			return reversed, err
+		}
+		reversed += n
+	}
+	return reversed, nil
+}
+
+func (s *LedgerService) reverseExpiredTransfer(ctx context.Context, candidate *rgsv1.UnresolvedTransfer) (int, error) {
+	unlock := s.acctLocks.lock(candidate.AccountId)
+	defer unlock()
+	t, err := s.loadUnresolvedTransfer(ctx, candidate.TransferId)
+	if err != nil {
+		return 0, err
+	}
+	now := s.now()
+	// Acknowledged or retried while the sweep was waiting for the lock.
+	if t == nil || t.Status != rgsv1.UnresolvedTransferStatus_UNRESOLVED_TRANSFER_STATUS_OPEN || parseTS(t.AckDeadlineAt).After(now) {
+		return 0, nil
+	}
+	if _, _, err := s.reverseTransferLocked(ctx, nil, t, "timeout_reversed", now); err != nil {
+		if errors.Is(err, errTransferNotOpen) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return 1, nil
+}
+
+// TransferTimeoutJob reverses transfers whose acknowledgement timed out.
+func (s *LedgerService) TransferTimeoutJob() JobFunc {
+	return func(ctx context.Context, _ string) (string, error) {
+		reversed, err := s.ReverseExpiredTransfers(ctx)
+		if err != nil || reversed == 0 {
+			return "", err
+		}
+		return fmt.Sprintf("reversed=%d", reversed), nil
+	}
+}
@@ -0,0 +1,166 @@
+package server
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func seedDeviceTransfer(t *testing.T, svc *LedgerService, accountID, deviceID, idem string, amount int64) *rgsv1.TransferToDeviceResponse {
+	t.Helper()
+	ctx := context.Background()
+	_, _ = svc.Deposit(ctx, &rgsv1.DepositRequest{
+		Meta:      meta(accountID, rgsv1.ActorType_ACTOR_TYPE_PLAYER, "seed-"+idem),
+		AccountId: accountID,
+		Amount:    &rgsv1.Money{AmountMinor: amount, Currency: "USD"},
+	})
+	resp, err := svc.TransferToDevice(ctx, &rgsv1.TransferToDeviceRequest{
+		Meta:            meta(accountID, rgsv1.ActorType_ACTOR_TYPE_PLAYER, idem),
+		AccountId:       accountID,
+		DeviceId:        deviceID,
+		RequestedAmount: &rgsv1.Money{AmountMinor: amount, Currency: "USD"},
+	})
+	if err != nil || resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("transfer to device failed: err=%v meta=%+v", err, resp.GetMeta())
+	}
+	return resp
+}
+
+func TestLedgerTransferToDeviceOpensUnresolvedTransfer(t *testing.T) {
+	now := time.Date(2026, 2, 18, 9, 0, 0, 0, time.UTC)
+	svc := NewLedgerService(ledgerFixedClock{now: now})
+	ctx := context.Background()
+	td := seedDeviceTransfer(t, svc, "acct-1", "device-1", "td-1", 500)
+	if want := "tr-" + strconv.FormatInt(now.UnixNano(), 10) + "-"; !strings.HasPrefix(td.TransferId, want) {
+		t.Fatalf("expected transfer id from the service clock %q, got %q", want, td.TransferId)
+	}
+
+	list, _ := svc.ListUnresolvedTransfers(ctx, &rgsv1.ListUnresolvedTransfersRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")})
+	if list.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || len(list.Transfers) != 1 {
+		t.Fatalf("expected one open transfer, got meta=%+v transfers=%d", list.Meta, len(list.Transfers))
+	}
+	got := list.Transfers[0]
+	if got.TransferId != td.TransferId || got.Status != rgsv1.UnresolvedTransferStatus_UNRESOLVED_TRANSFER_STATUS_OPEN || got.AckDeadlineAt != "2026-02-18T09:05:00Z" {
+		t.Fatalf("unexpected unresolved transfer: %+v", got)
+	}
+
+	ack, _ := svc.ResolveTransfer(ctx, &rgsv1.ResolveTransferRequest{
+		Meta:       meta("svc-g2s", rgsv1.ActorType_ACTOR_TYPE_SERVICE, ""),
+		TransferId: td.TransferId,
+		Action:     rgsv1.TransferResolutionAction_TRANSFER_RESOLUTION_ACTION_ACKNOWLEDGE,
+	})
+	if ack.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || ack.Transfer.GetStatus() != rgsv1.UnresolvedTransferStatus_UNRESOLVED_TRANSFER_STATUS_RESOLVED {
+		t.Fatalf("acknowledge failed: %+v", ack)
+	}
+	again, _ := svc.ResolveTransfer(ctx, &rgsv1.ResolveTransferRequest{
+		Meta:       meta("svc-g2s", rgsv1.ActorType_ACTOR_TYPE_SERVICE, ""),
+		TransferId: td.TransferId,
+		Action:     rgsv1.TransferResolutionAction_TRANSFER_RESOLUTION_ACTION_ACKNOWLEDGE,
+	})
+	if again.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected repeated acknowledge to be a no-op, got=%v", again.Meta.GetResultCode())
+	}
+	reverse, _ := svc.ResolveTransfer(ctx, &rgsv1.ResolveTransferRequest{
+		Meta:       meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		TransferId: td.TransferId,
+		Action:     rgsv1.TransferResolutionAction_TRANSFER_RESOLUTION_ACTION_REVERSE,
+	})
+	if reverse.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID || reverse.Meta.GetDenialReason() != "transfer already closed" {
+		t.Fatalf("expected reverse of acknowledged transfer to be rejected, got=%+v", reverse.Meta)
+	}
+
+	open, _ := svc.ListUnresolvedTransfers(ctx, &rgsv1.ListUnresolvedTransfersRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")})
+	if len(open.Transfers) != 0 {
+		t.Fatalf("expected no open transfers, got=%d", len(open.Transfers))
+	}
+	all, _ := svc.ListUnresolvedTransfers(ctx, &rgsv1.ListUnresolvedTransfersRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), IncludeClosed: true})
+	if len(all.Transfers) != 1 || all.Transfers[0].Resolution != "acknowledged" {
+		t.Fatalf("expected acknowledged transfer in history, got=%+v", all.Transfers)
+	}
+}
+
+func TestLedgerResolveTransferReverseRestoresBalance(t *testing.T) {
+	svc := NewLedgerService(ledgerFixedClock{now: time.Date(2026, 2, 18, 9, 0, 0, 0, time.UTC)})
+	ctx := context.Background()
+	td := seedDeviceTransfer(t, svc, "acct-2", "device-2", "td-2", 700)
+
+	resp, _ := svc.ResolveTransfer(ctx, &rgsv1.ResolveTransferRequest{
+		Meta:       meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		TransferId: td.TransferId,
+		Action:     rgsv1.TransferResolutionAction_TRANSFER_RESOLUTION_ACTION_REVERSE,
+		Note:       "device offline",
+	})
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("reverse failed: %+v", resp.Meta)
+	}
+	if resp.AvailableBalance.GetAmountMinor() != 700 || resp.ReversalTransaction.GetAmount().GetAmountMinor() != 700 {
+		t.Fatalf("expected 700 returned to account, got=%+v", resp)
+	}
+	if resp.Transfer.GetStatus() != rgsv1.UnresolvedTransferStatus_UNRESOLVED_TRANSFER_STATUS_CANCELLED || resp.Transfer.GetReversalTransactionId() == "" {
+		t.Fatalf("unexpected reversed transfer: %+v", resp.Transfer)
+	}
+
+	bal, _ := svc.GetBalance(ctx, &rgsv1.GetBalanceRequest{Meta: meta("acct-2", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""), AccountId: "acct-2"})
+	if bal.AvailableBalance.GetAmountMinor() != 700 {
+		t.Fatalf("expected restored balance 700, got=%d", bal.AvailableBalance.GetAmountMinor())
+	}
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+	for txID, postings := range svc.postingsByTx {
+		if !isBalanced(postings) {
+			t.Fatalf("transaction %s has unbalanced postings", txID)
+		}
+	}
+}
+
+func TestLedgerTransferTimeoutSweepReversesUnacknowledged(t *testing.T) {
+	start := time.Date(2026, 2, 18, 9, 0, 0, 0, time.UTC)
+	svc := NewLedgerService(ledgerFixedClock{now: start})
+	svc.SetTransferAckTimeout(2 * time.Minute)
+	ctx := context.Background()
+	stale := seedDeviceTransfer(t, svc, "acct-3", "device-3", "td-3", 300)
+	retried := seedDeviceTransfer(t, svc, "acct-4", "device-4", "td-4", 400)
+
+	svc.Clock = ledgerFixedClock{now: start.Add(time.Minute)}
+	retry, _ := svc.ResolveTransfer(ctx, &rgsv1.ResolveTransferRequest{
+		Meta:       meta("svc-g2s", rgsv1.ActorType_ACTOR_TYPE_SERVICE, ""),
+		TransferId: retried.TransferId,
+		Action:     rgsv1.TransferResolutionAction_TRANSFER_RESOLUTION_ACTION_RETRY,
+	})
+	if retry.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || retry.Transfer.GetAttempts() != 2 {
+		t.Fatalf("retry failed: %+v", retry)
+	}
+
+	svc.Clock = ledgerFixedClock{now: start.Add(150 * time.Second)}
+	reversed, err := svc.ReverseExpiredTransfers(ctx)
+	if err != nil || reversed != 1 {
+		t.Fatalf("expected one timeout reversal, got=%d err=%v", reversed, err)
+	}
+	got, _ := svc.loadUnresolvedTransfer(ctx, stale.TransferId)
+	if got.GetResolution() != "timeout_reversed" || got.GetStatus() != rgsv1.UnresolvedTransferStatus_UNRESOLVED_TRANSFER_STATUS_CANCELLED {
+		t.Fatalf("unexpected stale transfer after sweep: %+v", got)
+	}
+	if bal, _ := svc.GetBalance(ctx, &rgsv1.GetBalanceRequest{Meta: meta("acct-3", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""), AccountId: "acct-3"}); bal.AvailableBalance.GetAmountMinor() != 300 {
+		t.Fatalf("expected stale transfer refunded, got=%d", bal.AvailableBalance.GetAmountMinor())
+	}
+	if again, _ := svc.ReverseExpiredTransfers(ctx); again != 0 {
+		t.Fatalf("expected retried transfer to survive sweep, got=%d", again)
+	}
+}
+
+func TestLedgerResolveTransferDeniedForPlayer(t *testing.T) {
+	svc := NewLedgerService(ledgerFixedClock{now: time.Date(2026, 2, 18, 9, 0, 0, 0, time.UTC)})
+	td := seedDeviceTransfer(t, svc, "acct-5", "device-5", "td-5", 100)
+	resp, _ := svc.ResolveTransfer(context.Background(), &rgsv1.ResolveTransferRequest{
+		Meta:       meta("acct-5", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""),
+		TransferId: td.TransferId,
+		Action:     rgsv1.TransferResolutionAction_TRANSFER_RESOLUTION_ACTION_REVERSE,
+	})
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected denied, got=%v", resp.Meta.GetResultCode())
+	}
+}
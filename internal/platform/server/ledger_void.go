@@ -0,0 +1,251 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/money"
+)
+
+func voidableTransactionType(t rgsv1.LedgerTransactionType) bool {
+	switch t {
+	case rgsv1.LedgerTransactionType_LEDGER_TRANSACTION_TYPE_DEPOSIT,
+		rgsv1.LedgerTransactionType_LEDGER_TRANSACTION_TYPE_WITHDRAWAL,
+		rgsv1.LedgerTransactionType_LEDGER_TRANSACTION_TYPE_TRANSFER_TO_DEVICE,
+		rgsv1.LedgerTransactionType_LEDGER_TRANSACTION_TYPE_TRANSFER_TO_ACCOUNT:
+		return true
+	default:
+		return false
+	}
+}
+
+// ledgerTransactionState is a transaction as seen by VoidTransaction.
+type ledgerTransactionState struct {
+	tx       *rgsv1.LedgerTransaction
+	postings []ledgerPosting
+	reversed bool
+	voidTx   *rgsv1.LedgerTransaction
+	// openTransfer is set for transfers to device still awaiting
+	// acknowledgment; those are reversed through ResolveTransfer.
+	openTransfer bool
+}
+
+func (s *LedgerService) loadTransactionState(ctx context.Context, txID string) (*ledgerTransactionState, error) {
+	if s.dbEnabled() {
+		tx, status, postings, err := s.getLedgerTransactionFromDB(ctx, txID)
+		if err != nil || tx == nil {
+			return nil, err
+		}
+		st := &ledgerTransactionState{tx: tx, postings: postings, reversed: status == "reversed"}
+		if st.voidTx, err = s.getVoidTransactionFromDB(ctx, txID); err != nil {
+			return nil, err
+		}
+		if st.openTransfer, err = s.hasOpenTransferForTransactionFromDB(ctx, txID); err != nil {
+			return nil, err
+		}
+		return st, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var found *rgsv1.LedgerTransaction
+	for _, txs := range s.transactionsByAcct {
+		for _, tx := range txs {
+			if tx.TransactionId == txID {
+				found = tx
+				break
+			}
+		}
+	}
+	if found == nil {
+		return nil, nil
+	}
+	st := &ledgerTransactionState{
+		tx:       transactionCopy(found),
+		postings: append([]ledgerPosting(nil), s.postingsByTx[txID]...),
+		voidTx:   transactionCopy(s.voidsByOriginal[txID]),
+	}
+	st.reversed = st.voidTx != nil
+	for _, t := range s.unresolvedTransfers {
+		if t.TransactionId != txID {
+			continue
+		}
+		switch t.Status {
+		case rgsv1.UnresolvedTransferStatus_UNRESOLVED_TRANSFER_STATUS_OPEN:
+			st.openTransfer = true
+		case rgsv1.UnresolvedTransferStatus_UNRESOLVED_TRANSFER_STATUS_CANCELLED:
+			st.reversed = true
+		}
+	}
+	return st, nil
+}
+
+// compensatingPostings mirrors postings with directions swapped and returns
+// the net change they make to accountID's available balance.
+func compensatingPostings(postings []ledgerPosting, accountID string, now time.Time) ([]ledgerPosting, int64, error) {
+	out := make([]ledgerPosting, 0, len(postings))
+	var delta int64
+	for _, p := range postings {
+		c := p
+		c.createdAt = now
+		var err error
+		switch p.direction {
+		case "credit":
+			c.direction = "debit"
+			if p.accountID == accountID {
+				delta, err = money.SubMinor(delta, p.amount)
+			}
+		case "debit":
+			c.direction = "credit"
+			if p.accountID == accountID {
+				delta, err = money.AddMinor(delta, p.amount)
+			}
+		default:
+			return nil, 0, errTransactionNotVoidable
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		out = append(out, c)
+	}
+	return out, delta, nil
+}
+
+func postingsSnapshot(postings []ledgerPosting) []map[string]any {
+	out := make([]map[string]any, 0, len(postings))
+	for _, p := range postings {
+		out = append(out, map[string]any{
+			"account_id": p.accountID,
+			"direction":  p.direction,
+			"amount":     p.amount,
+			"currency":   p.currency,
+		})
+	}
+	return out
+}
+
+func (s *LedgerService) VoidTransaction(ctx context.Context, req *rgsv1.VoidTransactionRequest) (*rgsv1.VoidTransactionResponse, error) {
+	if req == nil || req.TransactionId == "" {
+		return &rgsv1.VoidTransactionResponse{Meta: s.responseMeta(nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "transaction_id is required")}, nil
+	}
+	if ok, reason := s.authorizeOperator(ctx, req.Meta); !ok {
+		s.auditDenied(req.Meta, "ledger_transaction", req.TransactionId, "void_transaction", reason)
+		return &rgsv1.VoidTransactionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	if req.Reason == "" {
+		return &rgsv1.VoidTransactionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "reason is required")}, nil
+	}
+
+	st, err := s.loadTransactionState(ctx, req.TransactionId)
+	if err != nil {
+		return &rgsv1.VoidTransactionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if st == nil {
+		return &rgsv1.VoidTransactionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "transaction not found")}, nil
+	}
+
+	// Re-read under the account lock so concurrent voids and mutations see
+	// a consistent balance and void state.
+	unlock := s.acctLocks.lock(st.tx.AccountId)
+	defer unlock()
+	st, err = s.loadTransactionState(ctx, req.TransactionId)
+	if err != nil || st == nil {
+		return &rgsv1.VoidTransactionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	currency := st.tx.Amount.GetCurrency()
+	if st.voidTx != nil {
+		// Retried voids return the original outcome.
+		available, _, acctCurrency, _ := s.accountBalance(st.tx.AccountId)
+		if s.dbEnabled() {
+			if available, _, acctCurrency, _, err = s.getBalanceFromDB(ctx, st.tx.AccountId); err != nil {
+				return &rgsv1.VoidTransactionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+			}
+		}
+		return &rgsv1.VoidTransactionResponse{
+			Meta:                s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
+			OriginalTransaction: st.tx,
+			VoidTransaction:     st.voidTx,
+			AvailableBalance:    money.New(available, acctCurrency),
+		}, nil
+	}
+	if st.reversed {
+		return &rgsv1.VoidTransactionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "transaction already reversed")}, nil
+	}
+	if !voidableTransactionType(st.tx.TransactionType) || len(st.postings) == 0 {
+		return &rgsv1.VoidTransactionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "transaction type cannot be voided")}, nil
+	}
+	if st.openTransfer {
+		return &rgsv1.VoidTransactionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "transfer is unresolved; use ResolveTransfer")}, nil
+	}
+
+	now := s.now()
+	postings, delta, err := compensatingPostings(st.postings, st.tx.AccountId, now)
+	if err != nil || !isBalanced(postings) {
+		return &rgsv1.VoidTransactionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "unbalanced postings")}, nil
+	}
+	acct, err := s.mutationAccountState(ctx, st.tx.AccountId, currency)
+	if err != nil {
+		return &rgsv1.VoidTransactionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	before, _ := json.Marshal(map[string]any{
+		"transaction": st.tx,
+		"postings":    postingsSnapshot(st.postings),
+		"account":     json.RawMessage(snapshotAccount(acct)),
+	})
+	available, err := money.AddMinor(acct.available, delta)
+	if err != nil {
+		return &rgsv1.VoidTransactionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "amount exceeds balance limit")}, nil
+	}
+	if available < 0 {
+		s.auditDenied(req.Meta, "ledger_transaction", req.TransactionId, "void_transaction", "insufficient balance")
+		return &rgsv1.VoidTransactionResponse{
+			Meta:             s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, "insufficient balance"),
+			AvailableBalance: money.New(acct.available, acct.currency),
+		}, nil
+	}
+	acct.available = available
+
+	voidTx := &rgsv1.LedgerTransaction{
+		TransactionId:      s.newTxID(),
+		AccountId:          st.tx.AccountId,
+		TransactionType:    rgsv1.LedgerTransactionType_LEDGER_TRANSACTION_TYPE_VOID,
+		Amount:             money.New(st.tx.Amount.GetAmountMinor(), currency),
+		OccurredAt:         now.Format(time.RFC3339Nano),
+		Description:        "void of " + st.tx.TransactionId,
+		VoidsTransactionId: st.tx.TransactionId,
+	}
+	after, _ := json.Marshal(map[string]any{
+		"transaction": voidTx,
+		"postings":    postingsSnapshot(postings),
+		"account":     json.RawMessage(snapshotAccount(acct)),
+	})
+	if err := s.appendAudit(req.Meta, "ledger_transaction", st.tx.TransactionId, "void_transaction", before, after, audit.ResultSuccess, req.Reason); err != nil {
+		return &rgsv1.VoidTransactionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	if err := s.persistTransactionVoid(ctx, voidTx, postings); err != nil {
+		if errors.Is(err, errTransactionNotVoidable) {
+			return &rgsv1.VoidTransactionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "transaction already reversed")}, nil
+		}
+		return &rgsv1.VoidTransactionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	s.commitMutation(acct, voidTx, postings)
+	s.recordVoid(voidTx)
+	return &rgsv1.VoidTransactionResponse{
+		Meta:                s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
+		OriginalTransaction: st.tx,
+		VoidTransaction:     voidTx,
+		AvailableBalance:    money.New(acct.available, acct.currency),
+	}, nil
+}
+
+func (s *LedgerService) recordVoid(voidTx *rgsv1.LedgerTransaction) {
+	if !s.useInMemoryStateMirror() {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.voidsByOriginal[voidTx.VoidsTransactionId] = transactionCopy(voidTx)
+}
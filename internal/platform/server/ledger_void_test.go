@@ -0,0 +1,144 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+func voidTx(svc *LedgerService, actorType rgsv1.ActorType, txID string) *rgsv1.VoidTransactionResponse {
+	resp, _ := svc.VoidTransaction(context.Background(), &rgsv1.VoidTransactionRequest{
+		Meta:          meta("op-1", actorType, ""),
+		TransactionId: txID,
+		Reason:        "posted to wrong account",
+	})
+	return resp
+}
+
+func TestLedgerVoidDepositRestoresBalanceAndIsIdempotent(t *testing.T) {
+	svc := NewLedgerService(ledgerFixedClock{now: time.Date(2026, 2, 19, 10, 0, 0, 0, time.UTC)})
+	ctx := context.Background()
+	dep, _ := svc.Deposit(ctx, &rgsv1.DepositRequest{
+		Meta:      meta("acct-v1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "dep-v1"),
+		AccountId: "acct-v1",
+		Amount:    &rgsv1.Money{AmountMinor: 700, Currency: "USD"},
+	})
+
+	first := voidTx(svc, rgsv1.ActorType_ACTOR_TYPE_OPERATOR, dep.Transaction.TransactionId)
+	if first.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("void failed: %+v", first.Meta)
+	}
+	if first.VoidTransaction.GetTransactionType() != rgsv1.LedgerTransactionType_LEDGER_TRANSACTION_TYPE_VOID ||
+		first.VoidTransaction.GetVoidsTransactionId() != dep.Transaction.TransactionId ||
+		first.VoidTransaction.GetAmount().GetAmountMinor() != 700 {
+		t.Fatalf("unexpected void transaction: %+v", first.VoidTransaction)
+	}
+	if first.AvailableBalance.GetAmountMinor() != 0 {
+		t.Fatalf("expected balance restored to 0, got=%d", first.AvailableBalance.GetAmountMinor())
+	}
+
+	second := voidTx(svc, rgsv1.ActorType_ACTOR_TYPE_OPERATOR, dep.Transaction.TransactionId)
+	if second.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || second.VoidTransaction.GetTransactionId() != first.VoidTransaction.GetTransactionId() {
+		t.Fatalf("expected repeated void to return original outcome, got=%+v", second)
+	}
+	bal, _ := svc.GetBalance(ctx, &rgsv1.GetBalanceRequest{Meta: meta("acct-v1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""), AccountId: "acct-v1"})
+	if bal.AvailableBalance.GetAmountMinor() != 0 {
+		t.Fatalf("expected void applied once, got=%d", bal.AvailableBalance.GetAmountMinor())
+	}
+
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+	if !isBalanced(svc.postingsByTx[first.VoidTransaction.TransactionId]) {
+		t.Fatalf("void postings are unbalanced")
+	}
+}
+
+func TestLedgerVoidWithdrawalCreditsAccount(t *testing.T) {
+	svc := NewLedgerService(ledgerFixedClock{now: time.Date(2026, 2, 19, 10, 0, 0, 0, time.UTC)})
+	ctx := context.Background()
+	_, _ = svc.Deposit(ctx, &rgsv1.DepositRequest{
+		Meta:      meta("acct-v2", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "seed"),
+		AccountId: "acct-v2",
+		Amount:    &rgsv1.Money{AmountMinor: 1000, Currency: "USD"},
+	})
+	wd, _ := svc.Withdraw(ctx, &rgsv1.WithdrawRequest{
+		Meta:      meta("acct-v2", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "wd"),
+		AccountId: "acct-v2",
+		Amount:    &rgsv1.Money{AmountMinor: 400, Currency: "USD"},
+	})
+
+	resp := voidTx(svc, rgsv1.ActorType_ACTOR_TYPE_OPERATOR, wd.Transaction.TransactionId)
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || resp.AvailableBalance.GetAmountMinor() != 1000 {
+		t.Fatalf("expected withdrawal void to restore 1000, got=%+v", resp)
+	}
+
+	events := svc.AuditEvents()
+	last := events[len(events)-1]
+	if last.Action != "void_transaction" || last.ObjectID != wd.Transaction.TransactionId || last.Result != audit.ResultSuccess || last.Reason != "posted to wrong account" {
+		t.Fatalf("unexpected void audit event: %+v", last)
+	}
+	var before struct {
+		Transaction *rgsv1.LedgerTransaction `json:"transaction"`
+		Postings    []map[string]any         `json:"postings"`
+	}
+	if err := json.Unmarshal(last.Before, &before); err != nil {
+		t.Fatalf("decode before snapshot: %v", err)
+	}
+	if before.Transaction.GetTransactionId() != wd.Transaction.TransactionId || len(before.Postings) != 2 {
+		t.Fatalf("expected original transaction snapshot in audit, got=%s", last.Before)
+	}
+}
+
+func TestLedgerVoidRequiresOperator(t *testing.T) {
+	svc := NewLedgerService(ledgerFixedClock{now: time.Date(2026, 2, 19, 10, 0, 0, 0, time.UTC)})
+	dep, _ := svc.Deposit(context.Background(), &rgsv1.DepositRequest{
+		Meta:      meta("acct-v3", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "dep"),
+		AccountId: "acct-v3",
+		Amount:    &rgsv1.Money{AmountMinor: 100, Currency: "USD"},
+	})
+
+	resp := voidTx(svc, rgsv1.ActorType_ACTOR_TYPE_PLAYER, dep.Transaction.TransactionId)
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected player void denied, got=%v", resp.Meta.GetResultCode())
+	}
+	events := svc.AuditEvents()
+	if last := events[len(events)-1]; last.Action != "void_transaction" || last.Result != audit.ResultDenied {
+		t.Fatalf("expected denied void audited, got=%+v", last)
+	}
+}
+
+func TestLedgerVoidRejectsSpentDepositAndOpenTransfer(t *testing.T) {
+	svc := NewLedgerService(ledgerFixedClock{now: time.Date(2026, 2, 19, 10, 0, 0, 0, time.UTC)})
+	ctx := context.Background()
+	dep, _ := svc.Deposit(ctx, &rgsv1.DepositRequest{
+		Meta:      meta("acct-v4", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "dep"),
+		AccountId: "acct-v4",
+		Amount:    &rgsv1.Money{AmountMinor: 500, Currency: "USD"},
+	})
+	_, _ = svc.Withdraw(ctx, &rgsv1.WithdrawRequest{
+		Meta:      meta("acct-v4", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "wd"),
+		AccountId: "acct-v4",
+		Amount:    &rgsv1.Money{AmountMinor: 300, Currency: "USD"},
+	})
+	if resp := voidTx(svc, rgsv1.ActorType_ACTOR_TYPE_OPERATOR, dep.Transaction.TransactionId); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected void of spent deposit denied, got=%+v", resp.Meta)
+	}
+
+	_ = seedDeviceTransfer(t, svc, "acct-v5", "device-v5", "td-v5", 200)
+	open, _ := svc.ListUnresolvedTransfers(ctx, &rgsv1.ListUnresolvedTransfersRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), AccountId: "acct-v5"})
+	if len(open.Transfers) != 1 {
+		t.Fatalf("expected one open transfer, got=%d", len(open.Transfers))
+	}
+	resp := voidTx(svc, rgsv1.ActorType_ACTOR_TYPE_OPERATOR, open.Transfers[0].TransactionId)
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID || resp.Meta.GetDenialReason() != "transfer is unresolved; use ResolveTransfer" {
+		t.Fatalf("expected open transfer void rejected, got=%+v", resp.Meta)
+	}
+
+	if resp := voidTx(svc, rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "tx-missing"); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected unknown transaction rejected, got=%+v", resp.Meta)
+	}
+}
@@ -0,0 +1,446 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/money"
+	"google.golang.org/protobuf/proto"
+)
+
+// promotionalFundingAccount is the house account that funds voucher
+// redemptions.
+const promotionalFundingAccount = "promotional_funding"
+
+// voucherCodeAlphabet omits characters that are easily misread on print.
+const voucherCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+var errVoucherAlreadyRedeemed = errors.New("voucher already redeemed")
+
+func normalizeVoucherCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// validVoucherCode accepts 6 to 64 letters, digits, and dashes.
+func validVoucherCode(code string) bool {
+	if len(code) < 6 || len(code) > 64 {
+		return false
+	}
+	for _, r := range code {
+		if (r < 'A' || r > 'Z') && (r < '0' || r > '9') && r != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+// newVoucherCode returns a random code formatted XXXX-XXXX-XXXX-XXXX.
+func newVoucherCode() (string, error) {
+	raw := make([]byte, 16)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	for i, c := range raw {
+		if i > 0 && i%4 == 0 {
+			b.WriteByte('-')
+		}
+		b.WriteByte(voucherCodeAlphabet[int(c)%len(voucherCodeAlphabet)])
+	}
+	return b.String(), nil
+}
+
+// voucherLockKey names the lock shard that serializes work on one code.
+func voucherLockKey(code string) string {
+	return "voucher:" + code
+}
+
+func (s *LedgerService) newVoucherID() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nextVoucherID++
+	return "vch-" + strconv.FormatInt(time.Now().UTC().UnixNano(), 10) + "-" + strconv.FormatInt(s.nextVoucherID, 10)
+}
+
+func voucherCopy(v *rgsv1.Voucher) *rgsv1.Voucher {
+	if v == nil {
+		return nil
+	}
+	cp, _ := proto.Clone(v).(*rgsv1.Voucher)
+	return cp
+}
+
+// withVoucherStatus returns a copy of v with its status as of now; stored
+// vouchers are never marked expired.
+func withVoucherStatus(v *rgsv1.Voucher, now time.Time) *rgsv1.Voucher {
+	cp := voucherCopy(v)
+	switch {
+	case cp.RedeemedAt != "":
+		cp.Status = rgsv1.VoucherStatus_VOUCHER_STATUS_REDEEMED
+	default:
+		cp.Status = rgsv1.VoucherStatus_VOUCHER_STATUS_ISSUED
+		if expiresAt, err := time.Parse(time.RFC3339Nano, cp.ExpiresAt); err == nil && !now.Before(expiresAt) {
+			cp.Status = rgsv1.VoucherStatus_VOUCHER_STATUS_EXPIRED
+		}
+	}
+	return cp
+}
+
+// voucherSnapshot is the audit payload for a voucher. Codes are bearer
+// credentials, so only their last four characters are recorded.
+func voucherSnapshot(v *rgsv1.Voucher) []byte {
+	if v == nil {
+		return []byte(`{}`)
+	}
+	code := v.Code
+	if len(code) > 4 {
+		code = "..." + code[len(code)-4:]
+	}
+	payload := map[string]any{
+		"voucher_id":                v.VoucherId,
+		"code":                      code,
+		"amount_minor":              v.Value.GetAmountMinor(),
+		"currency":                  v.Value.GetCurrency(),
+		"expires_at":                v.ExpiresAt,
+		"status":                    v.Status.String(),
+		"reference":                 v.Reference,
+		"redeemed_account_id":       v.RedeemedAccountId,
+		"redemption_transaction_id": v.RedemptionTransactionId,
+	}
+	b, _ := json.Marshal(payload)
+	return b
+}
+
+func (s *LedgerService) voucherByCode(ctx context.Context, code string) (*rgsv1.Voucher, error) {
+	if s.dbEnabled() {
+		return s.getVoucherByCodeFromDB(ctx, code)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return voucherCopy(s.vouchers[code]), nil
+}
+
+func (s *LedgerService) commitVoucher(v *rgsv1.Voucher) {
+	if !s.useInMemoryStateMirror() {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.vouchers[v.Code] = voucherCopy(v)
+}
+
+func (s *LedgerService) IssueVoucher(ctx context.Context, req *rgsv1.IssueVoucherRequest) (*rgsv1.IssueVoucherResponse, error) {
+	if req == nil {
+		return &rgsv1.IssueVoucherResponse{Meta: s.responseMeta(nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "request is required")}, nil
+	}
+	if ok, reason := s.authorizeOperator(ctx, req.Meta); !ok {
+		s.auditDenied(req.Meta, "ledger_voucher", "", "issue_voucher", reason)
+		return &rgsv1.IssueVoucherResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	if invalidAmount(req.Value) {
+		return &rgsv1.IssueVoucherResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "value must be > 0 and currency provided")}, nil
+	}
+	now := s.now()
+	expiresAt, err := time.Parse(time.RFC3339, req.ExpiresAt)
+	if err != nil || !expiresAt.After(now) {
+		return &rgsv1.IssueVoucherResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "expires_at must be a future RFC 3339 time")}, nil
+	}
+	code := normalizeVoucherCode(req.Code)
+	if code == "" {
+		if code, err = newVoucherCode(); err != nil {
+			return &rgsv1.IssueVoucherResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "voucher code generation failed")}, nil
+		}
+	} else if !validVoucherCode(code) {
+		return &rgsv1.IssueVoucherResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "code must be 6-64 letters, digits, or dashes")}, nil
+	}
+
+	unlock := s.acctLocks.lock(voucherLockKey(code))
+	defer unlock()
+	existing, err := s.voucherByCode(ctx, code)
+	if err != nil {
+		return &rgsv1.IssueVoucherResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if existing != nil {
+		return &rgsv1.IssueVoucherResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "voucher code already exists")}, nil
+	}
+
+	v := &rgsv1.Voucher{
+		VoucherId: s.newVoucherID(),
+		Code:      code,
+		Value:     money.New(req.Value.AmountMinor, req.Value.Currency),
+		ExpiresAt: expiresAt.UTC().Format(time.RFC3339Nano),
+		Status:    rgsv1.VoucherStatus_VOUCHER_STATUS_ISSUED,
+		IssuedBy:  req.Meta.GetActor().GetActorId(),
+		IssuedAt:  now.UTC().Format(time.RFC3339Nano),
+		Reference: strings.TrimSpace(req.Reference),
+	}
+	if err := s.appendAudit(req.Meta, "ledger_voucher", v.VoucherId, "issue_voucher", []byte(`{}`), voucherSnapshot(v), audit.ResultSuccess, ""); err != nil {
+		return &rgsv1.IssueVoucherResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	if err := s.insertVoucherDB(ctx, v); err != nil {
+		if errors.Is(err, errVoucherCodeTaken) {
+			return &rgsv1.IssueVoucherResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "voucher code already exists")}, nil
+		}
+		return &rgsv1.IssueVoucherResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	s.commitVoucher(v)
+	return &rgsv1.IssueVoucherResponse{
+		Meta:    s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
+		Voucher: voucherCopy(v),
+	}, nil
+}
+
+func (s *LedgerService) RedeemVoucher(ctx context.Context, req *rgsv1.RedeemVoucherRequest) (*rgsv1.RedeemVoucherResponse, error) {
+	if req == nil || req.AccountId == "" {
+		return &rgsv1.RedeemVoucherResponse{Meta: s.responseMeta(nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "account_id is required")}, nil
+	}
+	if ok, reason := s.authorize(ctx, req.Meta, req.AccountId); !ok {
+		s.auditDenied(req.Meta, "ledger_account", req.AccountId, "redeem_voucher", reason)
+		return &rgsv1.RedeemVoucherResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	code := normalizeVoucherCode(req.Code)
+	if code == "" {
+		return &rgsv1.RedeemVoucherResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "code is required")}, nil
+	}
+	idem := idempotency(req.Meta)
+	if idem == "" {
+		return &rgsv1.RedeemVoucherResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "idempotency_key is required")}, nil
+	}
+
+	// The player's base account is locked as well because every deposit and
+	// redemption toward the player's deposit limits holds it.
+	playerID := req.AccountId
+	if base, _, ok := splitCurrencySubAccount(req.AccountId); ok {
+		playerID = base
+	}
+	unlock := s.acctLocks.lockAll(playerID, req.AccountId, voucherLockKey(code))
+	defer unlock()
+	locked, err := s.eftLocked(ctx, req.AccountId)
+	if err != nil {
+		return &rgsv1.RedeemVoucherResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if locked {
+		s.auditDenied(req.Meta, "ledger_account", req.AccountId, "redeem_voucher", "eft account locked")
+		return &rgsv1.RedeemVoucherResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, "eft account locked")}, nil
+	}
+	excluded, err := s.accountSelfExcluded(ctx, req.AccountId)
+	if err != nil {
+		return &rgsv1.RedeemVoucherResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "self-exclusion unavailable")}, nil
+	}
+	if excluded {
+		s.auditDenied(req.Meta, "ledger_account", req.AccountId, "redeem_voucher", selfExcludedReason)
+		return &rgsv1.RedeemVoucherResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, selfExcludedReason)}, nil
+	}
+
+	key := req.AccountId + "|voucher|" + idem
+	scope := idemScope(req.AccountId, "redeem_voucher")
+	requestHash := hashRequest(scope, code)
+	if s.useInMemoryIdempotencyCache() {
+		if cp, ok := cachedLedgerResponse(s, s.redeemByIdempotency, key); ok {
+			return cp, nil
+		}
+	}
+	if s.dbEnabled() {
+		var replay rgsv1.RedeemVoucherResponse
+		found, err := s.loadIdempotencyResponse(ctx, scope, idem, requestHash, &replay)
+		if err == errIdempotencyRequestMismatch {
+			return &rgsv1.RedeemVoucherResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "idempotency_key reused with different request")}, nil
+		}
+		if err != nil {
+			return &rgsv1.RedeemVoucherResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+		if found {
+			if s.useInMemoryIdempotencyCache() {
+				cacheLedgerResponse(s, s.redeemByIdempotency, key, &replay)
+			}
+			return &replay, nil
+		}
+	}
+
+	// deny records a refused redemption so a retry with the same key
+	// replays the refusal.
+	deny := func(objectID, reason string) (*rgsv1.RedeemVoucherResponse, error) {
+		s.auditDenied(req.Meta, "ledger_voucher", objectID, "redeem_voucher", reason)
+		resp := &rgsv1.RedeemVoucherResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}
+		if err := s.persistIdempotencyResponse(ctx, scope, idem, requestHash, resp.Meta.GetResultCode(), resp); err != nil {
+			return &rgsv1.RedeemVoucherResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+		if s.useInMemoryIdempotencyCache() {
+			cacheLedgerResponse(s, s.redeemByIdempotency, key, resp)
+		}
+		return resp, nil
+	}
+
+	stored, err := s.voucherByCode(ctx, code)
+	if err != nil {
+		return &rgsv1.RedeemVoucherResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if stored == nil {
+		// Unknown codes count toward the EFT lockout to slow down guessing.
+		if err := s.recordEFTFailure(ctx, req.AccountId); err != nil {
+			return &rgsv1.RedeemVoucherResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+		return deny("", "voucher not found")
+	}
+	now := s.now()
+	v := withVoucherStatus(stored, now)
+	switch v.Status {
+	case rgsv1.VoucherStatus_VOUCHER_STATUS_REDEEMED:
+		return deny(v.VoucherId, "voucher already redeemed")
+	case rgsv1.VoucherStatus_VOUCHER_STATUS_EXPIRED:
+		return deny(v.VoucherId, "voucher expired")
+	}
+	limitReason, err := s.checkDepositLimits(ctx, req.AccountId, v.Value)
+	if err != nil {
+		return &rgsv1.RedeemVoucherResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "player limits unavailable")}, nil
+	}
+	if limitReason != "" {
+		s.auditDenied(req.Meta, "ledger_account", req.AccountId, "redeem_voucher", limitReason)
+		return &rgsv1.RedeemVoucherResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, limitReason)}, nil
+	}
+
+	acct, err := s.mutationAccountState(ctx, req.AccountId, v.Value.Currency)
+	if err != nil {
+		return &rgsv1.RedeemVoucherResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if acct.currency != v.Value.Currency {
+		return &rgsv1.RedeemVoucherResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "currency mismatch for account")}, nil
+	}
+	// Vouchers are single-use, so a cap that would clip one refuses it.
+	limit, err := s.balanceCap(ctx, req.AccountId, v.Value.Currency)
+	if err != nil {
+		return &rgsv1.RedeemVoucherResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "balance caps unavailable")}, nil
+	}
+	if credit, allowed := limit.admit(acct.available, v.Value.AmountMinor); !allowed || credit < v.Value.AmountMinor {
+		return deny(v.VoucherId, "balance cap exceeded")
+	}
+
+	before := snapshotAccount(acct)
+	txID := s.newTxID()
+	postings := []ledgerPosting{
+		{accountID: promotionalFundingAccount, direction: "debit", amount: v.Value.AmountMinor, currency: v.Value.Currency, createdAt: now},
+		{accountID: req.AccountId, direction: "credit", amount: v.Value.AmountMinor, currency: v.Value.Currency, createdAt: now},
+	}
+	if !isBalanced(postings) {
+		return &rgsv1.RedeemVoucherResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "unbalanced postings")}, nil
+	}
+	if acct.available, err = money.AddMinor(acct.available, v.Value.AmountMinor); err != nil {
+		return &rgsv1.RedeemVoucherResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "amount exceeds balance limit")}, nil
+	}
+	tx := &rgsv1.LedgerTransaction{
+		TransactionId:   txID,
+		AccountId:       req.AccountId,
+		TransactionType: rgsv1.LedgerTransactionType_LEDGER_TRANSACTION_TYPE_VOUCHER_REDEMPTION,
+		Amount:          money.New(v.Value.AmountMinor, v.Value.Currency),
+		OccurredAt:      now.Format(time.RFC3339Nano),
+		AuthorizationId: v.VoucherId,
+		Description:     "voucher redeemed",
+	}
+	redeemed := voucherCopy(v)
+	redeemed.Status = rgsv1.VoucherStatus_VOUCHER_STATUS_REDEEMED
+	redeemed.RedeemedAccountId = req.AccountId
+	redeemed.RedeemedAt = now.UTC().Format(time.RFC3339Nano)
+	redeemed.RedemptionTransactionId = txID
+
+	if err := s.appendAudit(req.Meta, "ledger_account", req.AccountId, "redeem_voucher", before, snapshotAccount(acct), audit.ResultSuccess, ""); err != nil {
+		return &rgsv1.RedeemVoucherResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	if err := s.appendAudit(req.Meta, "ledger_voucher", v.VoucherId, "redeem_voucher", voucherSnapshot(v), voucherSnapshot(redeemed), audit.ResultSuccess, ""); err != nil {
+		return &rgsv1.RedeemVoucherResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	if err := s.persistVoucherRedemption(ctx, tx, postings, idem, redeemed); err != nil {
+		if errors.Is(err, errVoucherAlreadyRedeemed) {
+			return deny(v.VoucherId, "voucher already redeemed")
+		}
+		return &rgsv1.RedeemVoucherResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	s.commitMutation(acct, tx, postings)
+	s.commitVoucher(redeemed)
+
+	resp := &rgsv1.RedeemVoucherResponse{
+		Meta:             s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
+		Voucher:          redeemed,
+		Transaction:      tx,
+		AvailableBalance: money.New(acct.available, acct.currency),
+	}
+	if err := s.persistIdempotencyResponse(ctx, scope, idem, requestHash, resp.Meta.GetResultCode(), resp); err != nil {
+		return &rgsv1.RedeemVoucherResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if s.useInMemoryIdempotencyCache() {
+		cacheLedgerResponse(s, s.redeemByIdempotency, key, resp)
+	}
+	_ = s.resetEFTFailures(ctx, req.AccountId)
+	return resp, nil
+}
+
+// voucherLiabilities totals vouchers per currency as of now.
+func (s *LedgerService) voucherLiabilities(ctx context.Context, now time.Time) ([]*rgsv1.VoucherLiability, error) {
+	if s.dbEnabled() {
+		return s.voucherLiabilitiesFromDB(ctx, now)
+	}
+	s.mu.Lock()
+	byCurrency := make(map[string]*rgsv1.VoucherLiability)
+	for _, stored := range s.vouchers {
+		v := withVoucherStatus(stored, now)
+		currency := v.Value.GetCurrency()
+		l, ok := byCurrency[currency]
+		if !ok {
+			l = &rgsv1.VoucherLiability{
+				Currency:          currency,
+				OutstandingAmount: money.New(0, currency),
+				ExpiredAmount:     money.New(0, currency),
+				RedeemedAmount:    money.New(0, currency),
+			}
+			byCurrency[currency] = l
+		}
+		switch v.Status {
+		case rgsv1.VoucherStatus_VOUCHER_STATUS_ISSUED:
+			l.OutstandingCount++
+			l.OutstandingAmount.AmountMinor += v.Value.GetAmountMinor()
+		case rgsv1.VoucherStatus_VOUCHER_STATUS_EXPIRED:
+			l.ExpiredCount++
+			l.ExpiredAmount.AmountMinor += v.Value.GetAmountMinor()
+		case rgsv1.VoucherStatus_VOUCHER_STATUS_REDEEMED:
+			l.RedeemedCount++
+			l.RedeemedAmount.AmountMinor += v.Value.GetAmountMinor()
+		}
+	}
+	s.mu.Unlock()
+	out := make([]*rgsv1.VoucherLiability, 0, len(byCurrency))
+	for _, l := range byCurrency {
+		out = append(out, l)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Currency < out[j].Currency })
+	return out, nil
+}
+
+func (s *LedgerService) GetVoucherLiability(ctx context.Context, req *rgsv1.GetVoucherLiabilityRequest) (*rgsv1.GetVoucherLiabilityResponse, error) {
+	if req == nil {
+		req = &rgsv1.GetVoucherLiabilityRequest{}
+	}
+	if ok, reason := s.authorizeOperator(ctx, req.Meta); !ok {
+		s.auditDenied(req.Meta, "ledger_voucher", "", "get_voucher_liability", reason)
+		return &rgsv1.GetVoucherLiabilityResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	now := s.now()
+	liabilities, err := s.voucherLiabilities(ctx, now)
+	if err != nil {
+		return &rgsv1.GetVoucherLiabilityResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if err := s.appendAudit(req.Meta, "ledger_voucher", "", "get_voucher_liability", []byte(`{}`), []byte(`{}`), audit.ResultSuccess, ""); err != nil {
+		return &rgsv1.GetVoucherLiabilityResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	return &rgsv1.GetVoucherLiabilityResponse{
+		Meta:        s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
+		Liabilities: liabilities,
+		AsOf:        now.UTC().Format(time.RFC3339Nano),
+	}, nil
+}
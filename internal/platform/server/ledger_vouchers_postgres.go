@@ -0,0 +1,142 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/money"
+)
+
+var errVoucherCodeTaken = errors.New("voucher code already exists")
+
+func (s *LedgerService) insertVoucherDB(ctx context.Context, v *rgsv1.Voucher) error {
+	if !s.dbEnabled() {
+		return nil
+	}
+	const q = `
+INSERT INTO ledger_vouchers (
+  voucher_id, code, amount_minor, currency_code, expires_at, reference, issued_by, issued_at
+) VALUES ($1,$2,$3,$4,$5::timestamptz,$6,$7,$8::timestamptz)
+ON CONFLICT (code) DO NOTHING
+`
+	res, err := s.db.ExecContext(ctx, q,
+		v.VoucherId, v.Code, v.Value.GetAmountMinor(), strings.ToUpper(v.Value.GetCurrency()),
+		v.ExpiresAt, v.Reference, v.IssuedBy, v.IssuedAt,
+	)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return errVoucherCodeTaken
+	}
+	return nil
+}
+
+func (s *LedgerService) getVoucherByCodeFromDB(ctx context.Context, code string) (*rgsv1.Voucher, error) {
+	const q = `
+SELECT voucher_id, code, amount_minor, currency_code, expires_at, reference, issued_by, issued_at,
+       COALESCE(redeemed_account_id, ''), redeemed_at, COALESCE(redemption_transaction_id, '')
+FROM ledger_vouchers
+WHERE code = $1
+`
+	var (
+		v                   rgsv1.Voucher
+		amount              int64
+		currency            string
+		expiresAt, issuedAt time.Time
+		redeemedAt          sql.NullTime
+	)
+	err := s.db.QueryRowContext(ctx, q, code).Scan(
+		&v.VoucherId, &v.Code, &amount, &currency, &expiresAt, &v.Reference, &v.IssuedBy, &issuedAt,
+		&v.RedeemedAccountId, &redeemedAt, &v.RedemptionTransactionId,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	v.Value = money.New(amount, strings.TrimSpace(currency))
+	v.ExpiresAt = expiresAt.UTC().Format(time.RFC3339Nano)
+	v.IssuedAt = issuedAt.UTC().Format(time.RFC3339Nano)
+	if redeemedAt.Valid {
+		v.RedeemedAt = redeemedAt.Time.UTC().Format(time.RFC3339Nano)
+	}
+	return &v, nil
+}
+
+// persistVoucherRedemption posts the redemption and marks the voucher
+// redeemed in one transaction. It returns errVoucherAlreadyRedeemed when
+// another instance redeemed the voucher first.
+func (s *LedgerService) persistVoucherRedemption(ctx context.Context, txRecord *rgsv1.LedgerTransaction, postings []ledgerPosting, idemKey string, v *rgsv1.Voucher) error {
+	if !s.dbEnabled() {
+		return nil
+	}
+	dbtx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = dbtx.Rollback()
+	}()
+	if err := s.persistLedgerMutationTx(ctx, dbtx, txRecord, postings, "accepted", idemKey); err != nil {
+		return err
+	}
+	const q = `
+UPDATE ledger_vouchers
+SET redeemed_account_id = $2, redeemed_at = $3::timestamptz, redemption_transaction_id = $4
+WHERE voucher_id = $1 AND redeemed_at IS NULL
+`
+	res, err := dbtx.ExecContext(ctx, q, v.VoucherId, v.RedeemedAccountId, v.RedeemedAt, v.RedemptionTransactionId)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return errVoucherAlreadyRedeemed
+	}
+	return dbtx.Commit()
+}
+
+func (s *LedgerService) voucherLiabilitiesFromDB(ctx context.Context, now time.Time) ([]*rgsv1.VoucherLiability, error) {
+	const q = `
+SELECT currency_code,
+       COUNT(*) FILTER (WHERE redeemed_at IS NULL AND expires_at > $1),
+       COALESCE(SUM(amount_minor) FILTER (WHERE redeemed_at IS NULL AND expires_at > $1), 0)::BIGINT,
+       COUNT(*) FILTER (WHERE redeemed_at IS NULL AND expires_at <= $1),
+       COALESCE(SUM(amount_minor) FILTER (WHERE redeemed_at IS NULL AND expires_at <= $1), 0)::BIGINT,
+       COUNT(*) FILTER (WHERE redeemed_at IS NOT NULL),
+       COALESCE(SUM(amount_minor) FILTER (WHERE redeemed_at IS NOT NULL), 0)::BIGINT
+FROM ledger_vouchers
+GROUP BY currency_code
+ORDER BY currency_code
+`
+	rows, err := s.db.QueryContext(ctx, q, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]*rgsv1.VoucherLiability, 0)
+	for rows.Next() {
+		var (
+			l                           rgsv1.VoucherLiability
+			outstanding, expired, spent int64
+		)
+		if err := rows.Scan(&l.Currency, &l.OutstandingCount, &outstanding, &l.ExpiredCount, &expired, &l.RedeemedCount, &spent); err != nil {
+			return nil, err
+		}
+		l.Currency = strings.TrimSpace(l.Currency)
+		l.OutstandingAmount = money.New(outstanding, l.Currency)
+		l.ExpiredAmount = money.New(expired, l.Currency)
+		l.RedeemedAmount = money.New(spent, l.Currency)
+		out = append(out, &l)
+	}
+	return out, rows.Err()
+}
@@ -0,0 +1,144 @@
+package server
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+// voucherTestClock lets a test move time past a voucher's expiry.
+type voucherTestClock struct{ now *time.Time }
+
+func (c voucherTestClock) Now() time.Time { return *c.now }
+
+func issueTestVoucher(t *testing.T, svc *LedgerService, code string, amount int64, expiresAt time.Time) *rgsv1.Voucher {
+	t.Helper()
+	resp, err := svc.IssueVoucher(context.Background(), &rgsv1.IssueVoucherRequest{
+		Meta:      meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		Value:     &rgsv1.Money{AmountMinor: amount, Currency: "USD"},
+		ExpiresAt: expiresAt.Format(time.RFC3339),
+		Code:      code,
+		Reference: "spring-promo",
+	})
+	if err != nil || resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("issue voucher failed: err=%v meta=%+v", err, resp.GetMeta())
+	}
+	return resp.Voucher
+}
+
+func redeemTestVoucher(svc *LedgerService, accountID, idem, code string) *rgsv1.RedeemVoucherResponse {
+	resp, _ := svc.RedeemVoucher(context.Background(), &rgsv1.RedeemVoucherRequest{
+		Meta:      meta(accountID, rgsv1.ActorType_ACTOR_TYPE_PLAYER, idem),
+		AccountId: accountID,
+		Code:      code,
+	})
+	return resp
+}
+
+func TestLedgerVoucherIssueAndRedeem(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	svc := NewLedgerService(voucherTestClock{now: &now})
+
+	v := issueTestVoucher(t, svc, "", 2500, now.Add(24*time.Hour))
+	if !regexp.MustCompile(`^[A-Z2-9]{4}(-[A-Z2-9]{4}){3}$`).MatchString(v.Code) || v.Status != rgsv1.VoucherStatus_VOUCHER_STATUS_ISSUED {
+		t.Fatalf("unexpected issued voucher: %+v", v)
+	}
+
+	resp := redeemTestVoucher(svc, "player-1", "r-1", " "+v.Code+" ")
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("redeem failed: %+v", resp.Meta)
+	}
+	if resp.AvailableBalance.GetAmountMinor() != 2500 || resp.Transaction.TransactionType != rgsv1.LedgerTransactionType_LEDGER_TRANSACTION_TYPE_VOUCHER_REDEMPTION || resp.Transaction.AuthorizationId != v.VoucherId {
+		t.Fatalf("unexpected redemption: %+v", resp)
+	}
+	if resp.Voucher.Status != rgsv1.VoucherStatus_VOUCHER_STATUS_REDEEMED || resp.Voucher.RedeemedAccountId != "player-1" || resp.Voucher.RedemptionTransactionId != resp.Transaction.TransactionId {
+		t.Fatalf("unexpected redeemed voucher: %+v", resp.Voucher)
+	}
+	postings := svc.postingsByTx[resp.Transaction.TransactionId]
+	if len(postings) != 2 || postings[0].accountID != promotionalFundingAccount || postings[0].direction != "debit" {
+		t.Fatalf("expected redemption funded from promotional account, got=%+v", postings)
+	}
+
+	if replay := redeemTestVoucher(svc, "player-1", "r-1", v.Code); replay.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || replay.Transaction.TransactionId != resp.Transaction.TransactionId {
+		t.Fatalf("expected idempotent replay, got=%+v", replay.Meta)
+	}
+	dup := redeemTestVoucher(svc, "player-2", "r-1", v.Code)
+	if dup.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED || dup.Meta.GetDenialReason() != "voucher already redeemed" {
+		t.Fatalf("expected duplicate redemption denial, got=%+v", dup.Meta)
+	}
+
+	var deniedAudited bool
+	for _, ev := range auditEvents(svc.AuditStore) {
+		deniedAudited = deniedAudited || (ev.Action == "redeem_voucher" && ev.ObjectID == v.VoucherId && ev.Result == "denied" && ev.ActorID == "player-2")
+	}
+	if !deniedAudited {
+		t.Fatalf("expected denied redemption to be audited")
+	}
+}
+
+func TestLedgerVoucherRedemptionDenials(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	svc := NewLedgerService(voucherTestClock{now: &now})
+	v := issueTestVoucher(t, svc, "spring-0001", 1000, now.Add(time.Hour))
+
+	dupIssue, _ := svc.IssueVoucher(context.Background(), &rgsv1.IssueVoucherRequest{
+		Meta:      meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		Value:     &rgsv1.Money{AmountMinor: 1000, Currency: "USD"},
+		ExpiresAt: now.Add(time.Hour).Format(time.RFC3339),
+		Code:      "SPRING-0001",
+	})
+	if dupIssue.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected duplicate code rejection, got=%+v", dupIssue.Meta)
+	}
+
+	if resp := redeemTestVoucher(svc, "player-1", "r-1", "NOPE-0000"); resp.Meta.GetDenialReason() != "voucher not found" {
+		t.Fatalf("expected unknown code denial, got=%+v", resp.Meta)
+	}
+	if svc.eftFraudFailures["player-1"] != 1 {
+		t.Fatalf("expected unknown code to count as an eft failure")
+	}
+
+	now = now.Add(2 * time.Hour)
+	if resp := redeemTestVoucher(svc, "player-1", "r-2", v.Code); resp.Meta.GetDenialReason() != "voucher expired" {
+		t.Fatalf("expected expired denial, got=%+v", resp.Meta)
+	}
+	if bal, _, _, _ := svc.accountBalance("player-1"); bal != 0 {
+		t.Fatalf("expected no credit for denied redemptions, got=%d", bal)
+	}
+}
+
+func TestLedgerVoucherLiabilityReport(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	svc := NewLedgerService(voucherTestClock{now: &now})
+	ctx := context.Background()
+	redeemed := issueTestVoucher(t, svc, "", 500, now.Add(48*time.Hour))
+	_ = issueTestVoucher(t, svc, "", 700, now.Add(48*time.Hour))
+	_ = issueTestVoucher(t, svc, "", 300, now.Add(time.Hour))
+	_ = redeemTestVoucher(svc, "player-1", "r-1", redeemed.Code)
+	now = now.Add(2 * time.Hour)
+
+	resp, err := svc.GetVoucherLiability(ctx, &rgsv1.GetVoucherLiabilityRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")})
+	if err != nil || resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || len(resp.Liabilities) != 1 {
+		t.Fatalf("liability report failed: err=%v resp=%+v", err, resp)
+	}
+	l := resp.Liabilities[0]
+	if l.OutstandingCount != 1 || l.OutstandingAmount.GetAmountMinor() != 700 || l.ExpiredCount != 1 || l.ExpiredAmount.GetAmountMinor() != 300 || l.RedeemedCount != 1 || l.RedeemedAmount.GetAmountMinor() != 500 {
+		t.Fatalf("unexpected liability: %+v", l)
+	}
+
+	denied, _ := svc.GetVoucherLiability(ctx, &rgsv1.GetVoucherLiabilityRequest{Meta: meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "")})
+	if denied.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected player denial, got=%+v", denied.Meta)
+	}
+	issue, _ := svc.IssueVoucher(ctx, &rgsv1.IssueVoucherRequest{
+		Meta:      meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""),
+		Value:     &rgsv1.Money{AmountMinor: 100, Currency: "USD"},
+		ExpiresAt: now.Add(time.Hour).Format(time.RFC3339),
+	})
+	if issue.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected players unable to issue vouchers, got=%+v", issue.Meta)
+	}
+}
@@ -0,0 +1,205 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/money"
+)
+
+var (
+	errWagerCurrencyMismatch    = errors.New("wager currency does not match account")
+	errWagerInsufficientBalance = errors.New("insufficient balance")
+)
+
+// wagerRefundIdempotencyKey ties a refund to its wager so a retried void
+// never credits the stake twice.
+func wagerRefundIdempotencyKey(wagerID string) string {
+	return "wager-refund:" + wagerID
+}
+
+// wagerLedgerMutation is a stake debit, payout credit, or stake refund that
+// the ledger has validated but not yet persisted. It holds the account lock
+// until finish or abort, so the wagering service can write the postings in
+// the same DB transaction as the wager itself.
+type wagerLedgerMutation struct {
+	ledger  *LedgerService
+	meta    *rgsv1.RequestMeta
+	action  string
+	wagerID string
+	acct    *ledgerAccount
+	before  []byte
+	tx      *rgsv1.LedgerTransaction
+	posts   []ledgerPosting
+	idemKey string
+	unlock  func()
+	// recorded is the audit event committed with the postings, once they
+	// have been written in a DB transaction.
+	recorded *audit.Event
+}
+
+// prepareWagerMutation locks accountID and builds the postings for a wager
+// money movement. When debitPlayer is set the player funds operator
+// liability, otherwise operator liability funds the player.
+func (s *LedgerService) prepareWagerMutation(ctx context.Context, meta *rgsv1.RequestMeta, action, wagerID, accountID string, amount *rgsv1.Money, debitPlayer bool, idemKey, description string) (*wagerLedgerMutation, error) {
+	if wagerID == "" || accountID == "" || invalidAmount(amount) {
+		return nil, errors.New("wager_id, account_id, and valid amount are required")
+	}
+	unlock := s.acctLocks.lock(accountID)
+	acct, err := s.mutationAccountState(ctx, accountID, amount.Currency)
+	if err != nil {
+		unlock()
+		return nil, err
+	}
+	if acct.currency != amount.Currency {
+		unlock()
+		return nil, errWagerCurrencyMismatch
+	}
+	before := snapshotAccount(acct)
+	txType := rgsv1.LedgerTransactionType_LEDGER_TRANSACTION_TYPE_GAMEPLAY_CREDIT
+	from, to := "operator_liability", accountID
+	if debitPlayer {
+		if acct.available < amount.AmountMinor {
+			unlock()
+			return nil, errWagerInsufficientBalance
+		}
+		acct.available, err = money.SubMinor(acct.available, amount.AmountMinor)
+		txType = rgsv1.LedgerTransactionType_LEDGER_TRANSACTION_TYPE_GAMEPLAY_DEBIT
+		from, to = accountID, "operator_liability"
+	} else {
+		acct.available, err = money.AddMinor(acct.available, amount.AmountMinor)
+	}
+	if err != nil {
+		unlock()
+		return nil, err
+	}
+	now := s.now()
+	return &wagerLedgerMutation{
+		ledger:  s,
+		meta:    meta,
+		action:  action,
+		wagerID: wagerID,
+		acct:    acct,
+		before:  before,
+		tx: &rgsv1.LedgerTransaction{
+			TransactionId:   s.newTxID(),
+			AccountId:       accountID,
+			TransactionType: txType,
+			Amount:          money.New(amount.AmountMinor, amount.Currency),
+			OccurredAt:      now.Format(time.RFC3339Nano),
+			Description:     description,
+		},
+		posts: []ledgerPosting{
+			{accountID: from, direction: "debit", amount: amount.AmountMinor, currency: amount.Currency, createdAt: now},
+			{accountID: to, direction: "credit", amount: amount.AmountMinor, currency: amount.Currency, createdAt: now},
+		},
+		idemKey: idemKey,
+		unlock:  unlock,
+	}, nil
+}
+
+// prepareWagerStakeDebit moves a wager's stake from the player's cashless
+// balance to operator liability.
+func (s *LedgerService) prepareWagerStakeDebit(ctx context.Context, meta *rgsv1.RequestMeta, wagerID, accountID string, stake *rgsv1.Money) (*wagerLedgerMutation, error) {
+	return s.prepareWagerMutation(ctx, meta, "wager_stake", wagerID, accountID, stake, true, "wager-stake:"+wagerID, "stake for wager "+wagerID)
+}
+
+// prepareWagerPayoutCredit moves a settled wager's payout from operator
+// liability to the player's cashless balance.
+func (s *LedgerService) prepareWagerPayoutCredit(ctx context.Context, meta *rgsv1.RequestMeta, wagerID, accountID string, payout *rgsv1.Money) (*wagerLedgerMutation, error) {
+	return s.prepareWagerMutation(ctx, meta, "wager_payout", wagerID, accountID, payout, false, "wager-payout:"+wagerID, "payout for wager "+wagerID)
+}
+
+// prepareWagerStakeRefund returns a canceled or voided wager's stake to the
+// player's cashless balance.
+func (s *LedgerService) prepareWagerStakeRefund(ctx context.Context, meta *rgsv1.RequestMeta, wagerID, accountID string, stake *rgsv1.Money) (*wagerLedgerMutation, error) {
+	return s.prepareWagerMutation(ctx, meta, "wager_refund", wagerID, accountID, stake, false, wagerRefundIdempotencyKey(wagerID), "stake refund for wager "+wagerID)
+}
+
+// persistTx writes the mutation and its audit event inside dbtx, so a money
+// movement never commits without its audit record.
+func (m *wagerLedgerMutation) persistTx(ctx context.Context, dbtx *sql.Tx) error {
+	if err := m.ledger.persistLedgerMutationTx(ctx, dbtx, m.tx, m.posts, "accepted", m.idemKey); err != nil {
+		return err
+	}
+	if m.ledger.AuditStore == nil {
+		return errWagerLedgerAudit
+	}
+	ev := signAuditEvent(m.auditEvent())
+	if err := appendAuditEventTx(ctx, dbtx, ev); err != nil {
+		return errWagerLedgerAudit
+	}
+	m.recorded = &ev
+	return nil
+}
+
+// persist writes the mutation in a DB transaction of its own when the ledger
+// is DB-backed.
+func (m *wagerLedgerMutation) persist(ctx context.Context) error {
+	if !m.ledger.dbEnabled() {
+		return nil
+	}
+	dbtx, err := m.ledger.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = dbtx.Rollback()
+	}()
+	if err := m.persistTx(ctx, dbtx); err != nil {
+		return err
+	}
+	return dbtx.Commit()
+}
+
+func (m *wagerLedgerMutation) auditEvent() audit.Event {
+	return newAuditEvent(m.meta, m.ledger.newAuditID(), m.ledger.now(), "ledger_account", m.acct.id, m.action, m.before, snapshotAccount(m.acct), audit.ResultSuccess, "wager "+m.wagerID)
+}
+
+// finish publishes a persisted mutation to the in-memory mirror and releases
+// the account lock. A mutation that was not written in a DB transaction is
+// audited first, and left unpublished if the audit fails.
+func (m *wagerLedgerMutation) finish() (*rgsv1.LedgerTransaction, error) {
+	defer m.unlock()
+	if m.recorded == nil {
+		if err := appendAuditTo(context.Background(), m.ledger.AuditStore, m.auditEvent()); err != nil {
+			return nil, err
+		}
+	} else if mem := auditMirror(m.ledger.AuditStore); mem != nil {
+		// The event already committed with the postings; the in-memory
+		// chain only mirrors it for in-process readers.
+		_, _ = mem.Append(*m.recorded)
+	}
+	m.ledger.commitMutation(m.acct, m.tx, m.posts)
+	return transactionCopy(m.tx), nil
+}
+
+// abort releases the account lock without applying the mutation.
+func (m *wagerLedgerMutation) abort() {
+	if m != nil {
+		m.unlock()
+	}
+}
+
+// availableBalance returns the account's available balance, or nil when the
+// account has never been posted to.
+func (s *LedgerService) availableBalance(ctx context.Context, accountID string) (*rgsv1.Money, error) {
+	available, _, currency, ok := s.accountBalance(accountID)
+	if s.dbEnabled() {
+		dbAvailable, _, dbCurrency, dbOK, err := s.getBalanceFromDB(ctx, accountID)
+		if err != nil {
+			return nil, err
+		}
+		if dbOK {
+			available, currency, ok = dbAvailable, dbCurrency, true
+		}
+	}
+	if !ok {
+		return nil, nil
+	}
+	return money.New(available, currency), nil
+}
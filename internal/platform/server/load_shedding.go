@@ -0,0 +1,252 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PriorityClass orders RPCs by how long they should survive overload.
+// Critical RPCs move money; low priority RPCs are reads and reporting.
+type PriorityClass int
+
+const (
+	PriorityLow PriorityClass = iota
+	PriorityStandard
+	PriorityCritical
+)
+
+func (c PriorityClass) String() string {
+	switch c {
+	case PriorityCritical:
+		return "critical"
+	case PriorityStandard:
+		return "standard"
+	default:
+		return "low"
+	}
+}
+
+// criticalRPCs are the money-moving RPCs that are shed last.
+var criticalRPCs = map[string]bool{
+	"/rgs.v1.LedgerService/Deposit":           true,
+	"/rgs.v1.LedgerService/Withdraw":          true,
+	"/rgs.v1.LedgerService/TransferToDevice":  true,
+	"/rgs.v1.LedgerService/TransferToAccount": true,
+	"/rgs.v1.LedgerService/ResolveTransfer":   true,
+	"/rgs.v1.LedgerService/VoidTransaction":   true,
+	"/rgs.v1.LedgerService/ExchangeCurrency":  true,
+	"/rgs.v1.LedgerService/RedeemVoucher":     true,
+	"/rgs.v1.WageringService/PlaceWager":      true,
+	"/rgs.v1.WageringService/SettleWager":     true,
+	"/rgs.v1.WageringService/CancelWager":     true,
+	"/rgs.v1.WageringService/VoidWager":       true,
+}
+
+// standardReadRPCs are reads players need in the course of play, so they are
+// not degraded with the other reads.
+var standardReadRPCs = map[string]bool{
+	"/rgs.v1.LedgerService/GetBalance":       true,
+	"/rgs.v1.PlayerSelfService/GetMyBalance": true,
+	"/rgs.v1.SystemService/GetSystemStatus":  true,
+	"/grpc.health.v1.Health/Check":           true,
+}
+
+// RPCPriority classifies a gRPC full method name.
+func RPCPriority(fullMethod string) PriorityClass {
+	if criticalRPCs[fullMethod] {
+		return PriorityCritical
+	}
+	if standardReadRPCs[fullMethod] {
+		return PriorityStandard
+	}
+	service, method := splitFullMethod(fullMethod)
+	switch service {
+	case "rgs.v1.ReportingService", "rgs.v1.AuditService":
+		return PriorityLow
+	}
+	if strings.HasPrefix(method, "List") || strings.HasPrefix(method, "Get") {
+		return PriorityLow
+	}
+	return PriorityStandard
+}
+
+func splitFullMethod(fullMethod string) (string, string) {
+	trimmed := strings.TrimPrefix(fullMethod, "/")
+	i := strings.LastIndex(trimmed, "/")
+	if i < 0 {
+		return "", trimmed
+	}
+	return trimmed[:i], trimmed[i+1:]
+}
+
+// HTTPPriority classifies a gateway request using the HTTP bindings of the
+// RPCs in RPCPriority.
+func HTTPPriority(r *http.Request) PriorityClass {
+	path := r.URL.Path
+	if r.Method == http.MethodPost {
+		switch path {
+		case "/v1/ledger/deposits",
+			"/v1/ledger/withdrawals",
+			"/v1/ledger/transfers/device",
+			"/v1/ledger/transfers/account",
+			"/v1/ledger/exchanges",
+			"/v1/ledger/vouchers/redeem",
+			"/v1/wagering/wagers":
+			return PriorityCritical
+		}
+		if strings.HasPrefix(path, "/v1/ledger/transfers/") && strings.HasSuffix(path, "/resolve") {
+			return PriorityCritical
+		}
+		if strings.HasPrefix(path, "/v1/ledger/transactions/") && strings.HasSuffix(path, "/void") {
+			return PriorityCritical
+		}
+		if strings.HasPrefix(path, "/v1/wagering/wagers/") &&
+			(strings.HasSuffix(path, ":settle") || strings.HasSuffix(path, ":cancel") || strings.HasSuffix(path, ":void")) {
+			return PriorityCritical
+		}
+	}
+	if strings.HasPrefix(path, "/v1/reporting/") || strings.HasPrefix(path, "/v1/audit/") {
+		return PriorityLow
+	}
+	if r.Method == http.MethodGet {
+		if path == "/v1/system/status" || path == "/v1/me/balance" ||
+			(strings.HasPrefix(path, "/v1/ledger/accounts/") && strings.HasSuffix(path, "/balance")) {
+			return PriorityStandard
+		}
+		return PriorityLow
+	}
+	return PriorityStandard
+}
+
+// LoadShedder admits requests while the number in flight is below the limit
+// for their priority class. Low priority limits sit below standard and
+// critical ones, so as the queue deepens reads are refused first and money
+// movement keeps its headroom. A zero limit disables shedding for the class.
+type LoadShedder struct {
+	mu       sync.Mutex
+	inFlight int
+	limits   map[PriorityClass]int
+	observer func(transport string, class string)
+}
+
+// NewLoadShedder sets the in-flight limit for each class. maxInFlight caps
+// critical traffic; standardLimit and lowLimit should be at or below it.
+func NewLoadShedder(maxInFlight, standardLimit, lowLimit int) *LoadShedder {
+	return &LoadShedder{limits: map[PriorityClass]int{
+		PriorityCritical: maxInFlight,
+		PriorityStandard: standardLimit,
+		PriorityLow:      lowLimit,
+	}}
+}
+
+// SetShedObserver registers a callback invoked for each shed request.
+func (l *LoadShedder) SetShedObserver(observer func(transport string, class string)) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.observer = observer
+}
+
+// acquire admits a request of class c and returns its release func, or false
+// when the request must be shed.
+func (l *LoadShedder) acquire(transport string, c PriorityClass) (func(), bool) {
+	l.mu.Lock()
+	limit := l.limits[c]
+	if limit > 0 && l.inFlight >= limit {
+		observer := l.observer
+		l.mu.Unlock()
+		if observer != nil {
+			observer(transport, c.String())
+		}
+		return nil, false
+	}
+	l.inFlight++
+	l.mu.Unlock()
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.mu.Lock()
+			l.inFlight--
+			l.mu.Unlock()
+		})
+	}, true
+}
+
+// InFlight returns the number of admitted requests still running.
+func (l *LoadShedder) InFlight() int {
+	if l == nil {
+		return 0
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.inFlight
+}
+
+func shedMessage(c PriorityClass) string {
+	return "server overloaded: " + c.String() + " priority request shed"
+}
+
+func UnaryLoadSheddingInterceptor(shedder *LoadShedder) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if shedder == nil {
+			return handler(ctx, req)
+		}
+		class := RPCPriority(info.FullMethod)
+		release, ok := shedder.acquire("grpc", class)
+		if !ok {
+			return nil, status.Error(codes.ResourceExhausted, shedMessage(class))
+		}
+		defer release()
+		return handler(ctx, req)
+	}
+}
+
+// StreamLoadSheddingInterceptor sheds streams when they are opened. An
+// admitted stream gives its slot back at once, so long-lived watches cannot
+// hold capacity away from unary calls.
+func StreamLoadSheddingInterceptor(shedder *LoadShedder) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if shedder == nil {
+			return handler(srv, ss)
+		}
+		class := RPCPriority(info.FullMethod)
+		release, ok := shedder.acquire("grpc", class)
+		if !ok {
+			return status.Error(codes.ResourceExhausted, shedMessage(class))
+		}
+		release()
+		return handler(srv, ss)
+	}
+}
+
+// HTTPLoadSheddingMiddleware answers shed gateway requests with 429 and a
+// Retry-After hint; 429 maps to RESOURCE_EXHAUSTED in the RPC metrics.
+func HTTPLoadSheddingMiddleware(shedder *LoadShedder, next http.Handler) http.Handler {
+	if shedder == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		class := HTTPPriority(r)
+		release, ok := shedder.acquire("rest", class)
+		if !ok {
+			w.Header().Set("Retry-After", "1")
+			http.Error(w, shedMessage(class), http.StatusTooManyRequests)
+			return
+		}
+		defer release()
+		next.ServeHTTP(w, r)
+	})
+}
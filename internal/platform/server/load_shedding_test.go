@@ -0,0 +1,147 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRPCAndHTTPPriorityClassification(t *testing.T) {
+	rpcCases := map[string]PriorityClass{
+		"/rgs.v1.LedgerService/Deposit":                PriorityCritical,
+		"/rgs.v1.WageringService/SettleWager":          PriorityCritical,
+		"/rgs.v1.LedgerService/RedeemVoucher":          PriorityCritical,
+		"/rgs.v1.LedgerService/IssueVoucher":           PriorityStandard,
+		"/rgs.v1.LedgerService/GetBalance":             PriorityStandard,
+		"/rgs.v1.PlayerSelfService/GetMyBalance":       PriorityStandard,
+		"/rgs.v1.PlayerSelfService/ListMySessions":     PriorityLow,
+		"/rgs.v1.IdentityService/Login":                PriorityStandard,
+		"/rgs.v1.WageringService/ListWagers":           PriorityLow,
+		"/rgs.v1.ReportingService/GenerateReport":      PriorityLow,
+		"/rgs.v1.AuditService/VerifyAuditChain":        PriorityLow,
+		"/rgs.v1.EventsService/SubmitSignificantEvent": PriorityStandard,
+	}
+	for method, want := range rpcCases {
+		if got := RPCPriority(method); got != want {
+			t.Fatalf("%s: got=%s want=%s", method, got, want)
+		}
+	}
+
+	httpCases := []struct {
+		method, path string
+		want         PriorityClass
+	}{
+		{http.MethodPost, "/v1/ledger/deposits", PriorityCritical},
+		{http.MethodPost, "/v1/wagering/wagers", PriorityCritical},
+		{http.MethodPost, "/v1/wagering/wagers/w-1:settle", PriorityCritical},
+		{http.MethodPost, "/v1/ledger/transactions/tx-1/void", PriorityCritical},
+		{http.MethodPost, "/v1/ledger/vouchers/redeem", PriorityCritical},
+		{http.MethodGet, "/v1/ledger/accounts/acct-1/balance", PriorityStandard},
+		{http.MethodGet, "/v1/me/balance", PriorityStandard},
+		{http.MethodGet, "/v1/me/transactions", PriorityLow},
+		{http.MethodGet, "/v1/wagering/wagers", PriorityLow},
+		{http.MethodPost, "/v1/reporting/runs", PriorityLow},
+		{http.MethodPost, "/v1/identity/login", PriorityStandard},
+	}
+	for _, tc := range httpCases {
+		if got := HTTPPriority(httptest.NewRequest(tc.method, tc.path, nil)); got != tc.want {
+			t.Fatalf("%s %s: got=%s want=%s", tc.method, tc.path, got, tc.want)
+		}
+	}
+}
+
+func TestUnaryLoadSheddingShedsLowPriorityFirst(t *testing.T) {
+	m := metricsForTest()
+	shedder := NewLoadShedder(3, 2, 1)
+	shedder.SetShedObserver(m.ObserveLoadShed)
+	interceptor := UnaryLoadSheddingInterceptor(shedder)
+	call := func(method string) error {
+		_, err := interceptor(context.Background(), "req", &grpc.UnaryServerInfo{FullMethod: method}, func(context.Context, interface{}) (interface{}, error) {
+			return "ok", nil
+		})
+		return err
+	}
+
+	// Hold one request in flight so the queue sits at the low limit.
+	release, ok := shedder.acquire("grpc", PriorityCritical)
+	if !ok {
+		t.Fatalf("expected first request admitted")
+	}
+	defer release()
+
+	before := counterValue(t, "open_rgs_load_shedding_shed_total", map[string]string{"transport": "grpc", "class": "low"})
+	err := call("/rgs.v1.WageringService/ListWagers")
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected low priority read shed, got=%v", err)
+	}
+	if after := counterValue(t, "open_rgs_load_shedding_shed_total", map[string]string{"transport": "grpc", "class": "low"}); after != before+1 {
+		t.Fatalf("expected low shed counter increment, before=%f after=%f", before, after)
+	}
+	if err := call("/rgs.v1.IdentityService/Login"); err != nil {
+		t.Fatalf("expected standard request admitted, got=%v", err)
+	}
+	if err := call("/rgs.v1.LedgerService/Deposit"); err != nil {
+		t.Fatalf("expected critical request admitted, got=%v", err)
+	}
+
+	release2, _ := shedder.acquire("grpc", PriorityCritical)
+	defer release2()
+	if err := call("/rgs.v1.IdentityService/Login"); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected standard request shed at its limit, got=%v", err)
+	}
+	if err := call("/rgs.v1.WageringService/SettleWager"); err != nil {
+		t.Fatalf("expected settlement admitted while reads are shed, got=%v", err)
+	}
+	if shedder.InFlight() != 2 {
+		t.Fatalf("expected admitted requests released, in_flight=%d", shedder.InFlight())
+	}
+}
+
+func TestStreamLoadSheddingShedsAtOpenOnly(t *testing.T) {
+	shedder := NewLoadShedder(2, 1, 1)
+	interceptor := StreamLoadSheddingInterceptor(shedder)
+	info := &grpc.StreamServerInfo{FullMethod: "/rgs.v1.EventsService/WatchSignificantEvents"}
+	var inFlight int
+	err := interceptor(nil, &recordingServerStream{ctx: context.Background()}, info, func(any, grpc.ServerStream) error {
+		inFlight = shedder.InFlight()
+		return nil
+	})
+	if err != nil || inFlight != 0 {
+		t.Fatalf("expected an admitted stream to hold no slot, err=%v in_flight=%d", err, inFlight)
+	}
+
+	release, _ := shedder.acquire("grpc", PriorityCritical)
+	defer release()
+	err = interceptor(nil, &recordingServerStream{ctx: context.Background()}, info, func(any, grpc.ServerStream) error {
+		t.Fatalf("expected the stream to be shed before its handler")
+		return nil
+	})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected the stream shed under load, got=%v", err)
+	}
+}
+
+func TestHTTPLoadSheddingReturnsTooManyRequests(t *testing.T) {
+	shedder := NewLoadShedder(2, 1, 1)
+	handler := HTTPLoadSheddingMiddleware(shedder, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	release, _ := shedder.acquire("rest", PriorityCritical)
+	defer release()
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/reporting/runs", nil))
+	if rec.Code != http.StatusTooManyRequests || rec.Header().Get("Retry-After") == "" {
+		t.Fatalf("expected 429 with Retry-After, got=%d headers=%v", rec.Code, rec.Header())
+	}
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/ledger/deposits", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected deposit admitted, got=%d", rec.Code)
+	}
+}
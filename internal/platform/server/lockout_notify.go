@@ -0,0 +1,214 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	LockoutKindIdentity = "identity"
+	LockoutKindEFT      = "eft"
+)
+
+// LockoutEvent describes a lockout that has just activated.
+type LockoutEvent struct {
+	// Kind is LockoutKindIdentity or LockoutKindEFT.
+	Kind string `json:"kind"`
+	// Subject is the locked actor id, or the ledger account id for EFT
+	// lockouts.
+	Subject string `json:"subject"`
+	// ActorType is set for identity lockouts only.
+	ActorType   string    `json:"actor_type,omitempty"`
+	LockedUntil time.Time `json:"locked_until"`
+	OccurredAt  time.Time `json:"occurred_at"`
+}
+
+func (ev LockoutEvent) summary() string {
+	if ev.Kind == LockoutKindEFT {
+		return fmt.Sprintf("open-rgs EFT lockout activated for account %s until %s", ev.Subject, ev.LockedUntil.UTC().Format(time.RFC3339))
+	}
+	return fmt.Sprintf("open-rgs identity lockout activated for %s %s until %s", ev.ActorType, ev.Subject, ev.LockedUntil.UTC().Format(time.RFC3339))
+}
+
+// LockoutNotifier tells security staff about a lockout. Services call it
+// while holding their lock, so implementations that do I/O should be wrapped
+// in an AsyncLockoutNotifier.
+type LockoutNotifier interface {
+	NotifyLockout(ctx context.Context, ev LockoutEvent) error
+}
+
+// WebhookLockoutNotifier posts each event as JSON to URL.
+type WebhookLockoutNotifier struct {
+	URL    string
+	Client *http.Client
+}
+
+func (n WebhookLockoutNotifier) NotifyLockout(ctx context.Context, ev LockoutEvent) error {
+	body, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	return postNotificationJSON(ctx, n.Client, n.URL, body)
+}
+
+// DefaultPagerDutyEventsURL is the PagerDuty Events API v2 enqueue endpoint.
+const DefaultPagerDutyEventsURL = "https://events.pagerduty.com/v2/enqueue"
+
+// PagerDutyLockoutNotifier triggers a PagerDuty alert per lockout. Alerts
+// are deduplicated per locked subject.
+type PagerDutyLockoutNotifier struct {
+	RoutingKey string
+	// URL defaults to DefaultPagerDutyEventsURL.
+	URL    string
+	Client *http.Client
+}
+
+func (n PagerDutyLockoutNotifier) NotifyLockout(ctx context.Context, ev LockoutEvent) error {
+	url := n.URL
+	if url == "" {
+		url = DefaultPagerDutyEventsURL
+	}
+	body, err := json.Marshal(map[string]any{
+		"routing_key":  n.RoutingKey,
+		"event_action": "trigger",
+		"dedup_key":    "open-rgs-lockout:" + ev.Kind + ":" + ev.ActorType + ":" + ev.Subject,
+		"payload": map[string]any{
+			"summary":        ev.summary(),
+			"source":         "open-rgs",
+			"severity":       "warning",
+			"timestamp":      ev.OccurredAt.UTC().Format(time.RFC3339Nano),
+			"component":      ev.Kind + "_lockout",
+			"custom_details": ev,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return postNotificationJSON(ctx, n.Client, url, body)
+}
+
+func postNotificationJSON(ctx context.Context, client *http.Client, url string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("notification rejected: status=%d", resp.StatusCode)
+	}
+	return nil
+}
+
+// EmailLockoutNotifier mails each event to To through the SMTP server at
+// Addr (host:port). Auth may be nil for unauthenticated relays.
+type EmailLockoutNotifier struct {
+	Addr string
+	From string
+	To   []string
+	Auth smtp.Auth
+
+	sendMail func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+func (n EmailLockoutNotifier) NotifyLockout(_ context.Context, ev LockoutEvent) error {
+	if n.Addr == "" || n.From == "" || len(n.To) == 0 {
+		return errors.New("email lockout notifier requires addr, from, and to")
+	}
+	send := n.sendMail
+	if send == nil {
+		send = smtp.SendMail
+	}
+	subject := ev.summary()
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "From: %s\r\n", n.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(n.To, ", "))
+	fmt.Fprintf(&msg, "Subject: %s\r\n", subject)
+	msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	fmt.Fprintf(&msg, "%s.\r\n\r\nKind: %s\r\nSubject: %s\r\n", subject, ev.Kind, ev.Subject)
+	if ev.ActorType != "" {
+		fmt.Fprintf(&msg, "Actor type: %s\r\n", ev.ActorType)
+	}
+	fmt.Fprintf(&msg, "Occurred at: %s\r\nLocked until: %s\r\n", ev.OccurredAt.UTC().Format(time.RFC3339), ev.LockedUntil.UTC().Format(time.RFC3339))
+	return send(n.Addr, n.Auth, n.From, n.To, []byte(msg.String()))
+}
+
+// NewSMTPPlainAuth returns PLAIN auth for addr's host, or nil when username
+// is empty.
+func NewSMTPPlainAuth(addr, username, password string) smtp.Auth {
+	if username == "" {
+		return nil
+	}
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		host = addr
+	}
+	return smtp.PlainAuth("", username, password, host)
+}
+
+// MultiLockoutNotifier notifies every notifier in turn and joins their
+// errors.
+type MultiLockoutNotifier []LockoutNotifier
+
+func (m MultiLockoutNotifier) NotifyLockout(ctx context.Context, ev LockoutEvent) error {
+	var errs []error
+	for _, n := range m {
+		if err := n.NotifyLockout(ctx, ev); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// AsyncLockoutNotifier delivers notifications in the background so a slow
+// webhook or mail server never holds up a login. Delivery failures are
+// logged rather than returned.
+type AsyncLockoutNotifier struct {
+	notifier LockoutNotifier
+	timeout  time.Duration
+	logf     func(string, ...any)
+	wg       sync.WaitGroup
+}
+
+// NewAsyncLockoutNotifier wraps notifier; each delivery gets timeout (10s
+// when zero) and failures go to logf when it is non-nil.
+func NewAsyncLockoutNotifier(notifier LockoutNotifier, timeout time.Duration, logf func(string, ...any)) *AsyncLockoutNotifier {
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	return &AsyncLockoutNotifier{notifier: notifier, timeout: timeout, logf: logf}
+}
+
+func (a *AsyncLockoutNotifier) NotifyLockout(_ context.Context, ev LockoutEvent) error {
+	a.wg.Add(1)
+	go func() {
+		defer a.wg.Done()
+		ctx, cancel := context.WithTimeout(context.Background(), a.timeout)
+		defer cancel()
+		if err := a.notifier.NotifyLockout(ctx, ev); err != nil && a.logf != nil {
+			a.logf("lockout notification failed kind=%s subject=%s: %v", ev.Kind, ev.Subject, err)
+		}
+	}()
+	return nil
+}
+
+// Wait blocks until in-flight notifications finish.
+func (a *AsyncLockoutNotifier) Wait() {
+	a.wg.Wait()
+}
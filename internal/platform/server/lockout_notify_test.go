@@ -0,0 +1,159 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+type recordingLockoutNotifier struct {
+	mu     sync.Mutex
+	events []LockoutEvent
+	err    error
+}
+
+func (r *recordingLockoutNotifier) NotifyLockout(_ context.Context, ev LockoutEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, ev)
+	return r.err
+}
+
+func TestIdentityLockoutActivationNotifiesOnce(t *testing.T) {
+	now := time.Date(2026, 3, 11, 8, 0, 0, 0, time.UTC)
+	svc := NewIdentityService(ledgerFixedClock{now: now}, "test-secret", 15*time.Minute, time.Hour)
+	svc.SetLockoutPolicy(2, 10*time.Minute)
+	notifier := &recordingLockoutNotifier{}
+	svc.SetLockoutNotifier(notifier)
+
+	for i := 0; i < 4; i++ {
+		_, _ = svc.Login(context.Background(), &rgsv1.LoginRequest{
+			Meta: meta("player-notify-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""),
+			Credentials: &rgsv1.LoginRequest_Player{
+				Player: &rgsv1.PlayerCredentials{PlayerId: "player-notify-1", Pin: "bad"},
+			},
+		})
+	}
+	want := LockoutEvent{
+		Kind:        LockoutKindIdentity,
+		Subject:     "player-notify-1",
+		ActorType:   "ACTOR_TYPE_PLAYER",
+		LockedUntil: now.Add(10 * time.Minute),
+		OccurredAt:  now,
+	}
+	if len(notifier.events) != 1 || notifier.events[0] != want {
+		t.Fatalf("expected one identity lockout notification, got=%+v", notifier.events)
+	}
+}
+
+func TestLedgerEFTLockoutActivationNotifiesOnce(t *testing.T) {
+	now := time.Date(2026, 3, 11, 8, 0, 0, 0, time.UTC)
+	svc := NewLedgerService(ledgerFixedClock{now: now})
+	svc.SetEFTFraudPolicy(2, 15*time.Minute)
+	notifier := &recordingLockoutNotifier{}
+	svc.SetLockoutNotifier(notifier)
+
+	for i := 0; i < 3; i++ {
+		_, _ = svc.Withdraw(context.Background(), &rgsv1.WithdrawRequest{
+			Meta:      meta("acct-notify-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "wd-notify-"+strconv.Itoa(i)),
+			AccountId: "acct-notify-1",
+			Amount:    &rgsv1.Money{AmountMinor: 200, Currency: "USD"},
+		})
+	}
+	want := LockoutEvent{Kind: LockoutKindEFT, Subject: "acct-notify-1", LockedUntil: now.Add(15 * time.Minute), OccurredAt: now}
+	if len(notifier.events) != 1 || notifier.events[0] != want {
+		t.Fatalf("expected one eft lockout notification, got=%+v", notifier.events)
+	}
+}
+
+func TestLockoutNotifierDeliveryChannels(t *testing.T) {
+	ev := LockoutEvent{
+		Kind:        LockoutKindIdentity,
+		Subject:     "op-1",
+		ActorType:   "ACTOR_TYPE_OPERATOR",
+		LockedUntil: time.Date(2026, 3, 11, 8, 15, 0, 0, time.UTC),
+		OccurredAt:  time.Date(2026, 3, 11, 8, 0, 0, 0, time.UTC),
+	}
+	var bodies []map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("decode notification: %v", err)
+		}
+		bodies = append(bodies, body)
+		if r.URL.Path == "/reject" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer srv.Close()
+
+	if err := (WebhookLockoutNotifier{URL: srv.URL + "/hook"}).NotifyLockout(context.Background(), ev); err != nil {
+		t.Fatalf("webhook: %v", err)
+	}
+	if bodies[0]["kind"] != "identity" || bodies[0]["subject"] != "op-1" || bodies[0]["actor_type"] != "ACTOR_TYPE_OPERATOR" {
+		t.Fatalf("unexpected webhook body: %+v", bodies[0])
+	}
+	if err := (PagerDutyLockoutNotifier{RoutingKey: "rk-1", URL: srv.URL + "/enqueue"}).NotifyLockout(context.Background(), ev); err != nil {
+		t.Fatalf("pagerduty: %v", err)
+	}
+	payload, _ := bodies[1]["payload"].(map[string]any)
+	if bodies[1]["routing_key"] != "rk-1" || bodies[1]["event_action"] != "trigger" || bodies[1]["dedup_key"] != "open-rgs-lockout:identity:ACTOR_TYPE_OPERATOR:op-1" || !strings.Contains(fmt.Sprint(payload["summary"]), "op-1") {
+		t.Fatalf("unexpected pagerduty body: %+v", bodies[1])
+	}
+	if err := (WebhookLockoutNotifier{URL: srv.URL + "/reject"}).NotifyLockout(context.Background(), ev); err == nil {
+		t.Fatalf("expected rejected webhook to fail")
+	}
+
+	var sent string
+	email := EmailLockoutNotifier{
+		Addr: "mail.example.test:25",
+		From: "rgs@example.test",
+		To:   []string{"soc@example.test"},
+		sendMail: func(addr string, _ smtp.Auth, from string, to []string, msg []byte) error {
+			if addr != "mail.example.test:25" || from != "rgs@example.test" || len(to) != 1 {
+				t.Errorf("unexpected envelope addr=%s from=%s to=%v", addr, from, to)
+			}
+			sent = string(msg)
+			return nil
+		},
+	}
+	if err := email.NotifyLockout(context.Background(), ev); err != nil {
+		t.Fatalf("email: %v", err)
+	}
+	if !strings.Contains(sent, "Subject: open-rgs identity lockout activated for ACTOR_TYPE_OPERATOR op-1") || !strings.Contains(sent, "Locked until: 2026-03-11T08:15:00Z") {
+		t.Fatalf("unexpected email:\n%s", sent)
+	}
+}
+
+func TestAsyncLockoutNotifierLogsFailures(t *testing.T) {
+	ok := &recordingLockoutNotifier{}
+	failing := &recordingLockoutNotifier{err: errors.New("smtp down")}
+	var mu sync.Mutex
+	var logged []string
+	async := NewAsyncLockoutNotifier(MultiLockoutNotifier{failing, ok}, time.Second, func(format string, args ...any) {
+		mu.Lock()
+		defer mu.Unlock()
+		logged = append(logged, fmt.Sprintf(format, args...))
+	})
+	if err := async.NotifyLockout(context.Background(), LockoutEvent{Kind: LockoutKindEFT, Subject: "acct-1"}); err != nil {
+		t.Fatalf("async notify returned error: %v", err)
+	}
+	async.Wait()
+	if len(ok.events) != 1 || len(failing.events) != 1 {
+		t.Fatalf("expected every notifier called, ok=%d failing=%d", len(ok.events), len(failing.events))
+	}
+	if len(logged) != 1 || !strings.Contains(logged[0], "smtp down") || !strings.Contains(logged[0], "subject=acct-1") {
+		t.Fatalf("expected failure logged, got=%v", logged)
+	}
+}
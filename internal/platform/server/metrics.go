@@ -7,7 +7,6 @@ import (
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
-	"github.com/prometheus/client_golang/prometheus/promauto"
 	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -23,6 +22,7 @@ type Metrics struct {
 	idempotencyKeysExpired  prometheus.Gauge
 	loginAttemptsTotal      *prometheus.CounterVec
 	lockoutActivations      *prometheus.CounterVec
+	refreshTokenReuse       *prometheus.CounterVec
 	identitySessionsActive  prometheus.Gauge
 	identitySessionsRevoked prometheus.Gauge
 	identitySessionsExpired prometheus.Gauge
@@ -33,11 +33,28 @@ type Metrics struct {
 	rpcRequestLatency       *prometheus.HistogramVec
 	httpRequestsTotal       *prometheus.CounterVec
 	httpRequestLatency      *prometheus.HistogramVec
+	wagerSettlementLatency  *prometheus.HistogramVec
+	wagersOverdue           prometheus.Gauge
+	wagerOverdueActions     *prometheus.CounterVec
+	loadShedTotal           *prometheus.CounterVec
+	latencyBudgetViolations *prometheus.CounterVec
+	schedulerJobRunsTotal   *prometheus.CounterVec
+	auditChainValid         prometheus.Gauge
+	auditChainVerifiedUnix  prometheus.Gauge
+	reportPurgeRunsTotal    *prometheus.CounterVec
+	reportPurgedTotal       prometheus.Counter
+	reportLastPurged        prometheus.Gauge
+	reportPurgeLastRunUnix  prometheus.Gauge
+	ingestionBufferDepth    prometheus.Gauge
+	ingestionBufferDropped  *prometheus.CounterVec
+
+	catalog *metricCatalog
 }
 
 func NewMetrics() *Metrics {
-	return &Metrics{
-		cleanupRunsTotal: promauto.NewCounterVec(
+	c := &metricCatalog{}
+	m := &Metrics{
+		cleanupRunsTotal: c.counterVec(
 			prometheus.CounterOpts{
 				Namespace: "open_rgs",
 				Subsystem: "ledger_idempotency",
@@ -46,7 +63,7 @@ func NewMetrics() *Metrics {
 			},
 			[]string{"result"},
 		),
-		cleanupDeletedTotal: promauto.NewCounter(
+		cleanupDeletedTotal: c.counter(
 			prometheus.CounterOpts{
 				Namespace: "open_rgs",
 				Subsystem: "ledger_idempotency",
@@ -54,7 +71,7 @@ func NewMetrics() *Metrics {
 				Help:      "Total number of expired idempotency keys deleted.",
 			},
 		),
-		cleanupLastDeleted: promauto.NewGauge(
+		cleanupLastDeleted: c.gauge(
 			prometheus.GaugeOpts{
 				Namespace: "open_rgs",
 				Subsystem: "ledger_idempotency",
@@ -62,7 +79,7 @@ func NewMetrics() *Metrics {
 				Help:      "Number of keys deleted in the most recent cleanup run.",
 			},
 		),
-		cleanupLastRunUnix: promauto.NewGauge(
+		cleanupLastRunUnix: c.gauge(
 			prometheus.GaugeOpts{
 				Namespace: "open_rgs",
 				Subsystem: "ledger_idempotency",
@@ -70,7 +87,7 @@ func NewMetrics() *Metrics {
 				Help:      "Unix time of the most recent cleanup run.",
 			},
 		),
-		idempotencyKeysTotal: promauto.NewGauge(
+		idempotencyKeysTotal: c.gauge(
 			prometheus.GaugeOpts{
 				Namespace: "open_rgs",
 				Subsystem: "ledger_idempotency",
@@ -78,7 +95,7 @@ func NewMetrics() *Metrics {
 				Help:      "Current count of all idempotency keys.",
 			},
 		),
-		idempotencyKeysExpired: promauto.NewGauge(
+		idempotencyKeysExpired: c.gauge(
 			prometheus.GaugeOpts{
 				Namespace: "open_rgs",
 				Subsystem: "ledger_idempotency",
@@ -86,7 +103,7 @@ func NewMetrics() *Metrics {
 				Help:      "Current count of expired idempotency keys.",
 			},
 		),
-		loginAttemptsTotal: promauto.NewCounterVec(
+		loginAttemptsTotal: c.counterVec(
 			prometheus.CounterOpts{
 				Namespace: "open_rgs",
 				Subsystem: "identity",
@@ -95,7 +112,7 @@ func NewMetrics() *Metrics {
 			},
 			[]string{"result", "actor_type"},
 		),
-		lockoutActivations: promauto.NewCounterVec(
+		lockoutActivations: c.counterVec(
 			prometheus.CounterOpts{
 				Namespace: "open_rgs",
 				Subsystem: "identity",
@@ -104,7 +121,16 @@ func NewMetrics() *Metrics {
 			},
 			[]string{"actor_type"},
 		),
-		identitySessionsActive: promauto.NewGauge(
+		refreshTokenReuse: c.counterVec(
+			prometheus.CounterOpts{
+				Namespace: "open_rgs",
+				Subsystem: "identity",
+				Name:      "refresh_token_reuse_total",
+				Help:      "Total rotated refresh tokens presented again, each revoking its token family, by actor type.",
+			},
+			[]string{"actor_type"},
+		),
+		identitySessionsActive: c.gauge(
 			prometheus.GaugeOpts{
 				Namespace: "open_rgs",
 				Subsystem: "identity",
@@ -112,7 +138,7 @@ func NewMetrics() *Metrics {
 				Help:      "Current count of active identity sessions.",
 			},
 		),
-		identitySessionsRevoked: promauto.NewGauge(
+		identitySessionsRevoked: c.gauge(
 			prometheus.GaugeOpts{
 				Namespace: "open_rgs",
 				Subsystem: "identity",
@@ -120,7 +146,7 @@ func NewMetrics() *Metrics {
 				Help:      "Current count of revoked identity sessions.",
 			},
 		),
-		identitySessionsExpired: promauto.NewGauge(
+		identitySessionsExpired: c.gauge(
 			prometheus.GaugeOpts{
 				Namespace: "open_rgs",
 				Subsystem: "identity",
@@ -128,7 +154,7 @@ func NewMetrics() *Metrics {
 				Help:      "Current count of expired identity sessions.",
 			},
 		),
-		remoteAccessDecisions: promauto.NewCounterVec(
+		remoteAccessDecisions: c.counterVec(
 			prometheus.CounterOpts{
 				Namespace: "open_rgs",
 				Subsystem: "remote_access",
@@ -137,7 +163,7 @@ func NewMetrics() *Metrics {
 			},
 			[]string{"outcome"},
 		),
-		remoteAccessLogEntries: promauto.NewGauge(
+		remoteAccessLogEntries: c.gauge(
 			prometheus.GaugeOpts{
 				Namespace: "open_rgs",
 				Subsystem: "remote_access",
@@ -145,7 +171,7 @@ func NewMetrics() *Metrics {
 				Help:      "Current in-memory remote-access activity log entry count.",
 			},
 		),
-		remoteAccessLogCap: promauto.NewGauge(
+		remoteAccessLogCap: c.gauge(
 			prometheus.GaugeOpts{
 				Namespace: "open_rgs",
 				Subsystem: "remote_access",
@@ -153,7 +179,7 @@ func NewMetrics() *Metrics {
 				Help:      "Configured in-memory remote-access activity log cap (0 means unlimited).",
 			},
 		),
-		rpcRequestsTotal: promauto.NewCounterVec(
+		rpcRequestsTotal: c.counterVec(
 			prometheus.CounterOpts{
 				Namespace: "open_rgs",
 				Subsystem: "rpc",
@@ -162,7 +188,7 @@ func NewMetrics() *Metrics {
 			},
 			[]string{"transport", "method", "result"},
 		),
-		rpcRequestLatency: promauto.NewHistogramVec(
+		rpcRequestLatency: c.histogramVec(
 			prometheus.HistogramOpts{
 				Namespace: "open_rgs",
 				Subsystem: "rpc",
@@ -172,7 +198,7 @@ func NewMetrics() *Metrics {
 			},
 			[]string{"transport", "method"},
 		),
-		httpRequestsTotal: promauto.NewCounterVec(
+		httpRequestsTotal: c.counterVec(
 			prometheus.CounterOpts{
 				Namespace: "open_rgs",
 				Subsystem: "http",
@@ -181,7 +207,7 @@ func NewMetrics() *Metrics {
 			},
 			[]string{"method", "path", "status"},
 		),
-		httpRequestLatency: promauto.NewHistogramVec(
+		httpRequestLatency: c.histogramVec(
 			prometheus.HistogramOpts{
 				Namespace: "open_rgs",
 				Subsystem: "http",
@@ -191,7 +217,129 @@ func NewMetrics() *Metrics {
 			},
 			[]string{"method", "path"},
 		),
+		wagerSettlementLatency: c.histogramVec(
+			prometheus.HistogramOpts{
+				Namespace: "open_rgs",
+				Subsystem: "wagering",
+				Name:      "settlement_latency_seconds",
+				Help:      "Time from wager placement to settlement, cancellation, or auto-void.",
+				Buckets:   []float64{1, 5, 15, 30, 60, 120, 300, 600, 1800, 3600, 7200, 21600, 86400},
+			},
+			[]string{"outcome"},
+		),
+		wagersOverdue: c.gauge(
+			prometheus.GaugeOpts{
+				Namespace: "open_rgs",
+				Subsystem: "wagering",
+				Name:      "overdue_wagers",
+				Help:      "Pending wagers past the settlement SLA at the last monitoring sweep.",
+			},
+		),
+		wagerOverdueActions: c.counterVec(
+			prometheus.CounterOpts{
+				Namespace: "open_rgs",
+				Subsystem: "wagering",
+				Name:      "overdue_actions_total",
+				Help:      "Overdue wager escalations, auto-voids and failed attempts by action.",
+			},
+			[]string{"action"},
+		),
+		loadShedTotal: c.counterVec(
+			prometheus.CounterOpts{
+				Namespace: "open_rgs",
+				Subsystem: "load_shedding",
+				Name:      "shed_total",
+				Help:      "Requests refused under overload partitioned by transport/priority class.",
+			},
+			[]string{"transport", "class"},
+		),
+		latencyBudgetViolations: c.counterVec(
+			prometheus.CounterOpts{
+				Namespace: "open_rgs",
+				Subsystem: "rpc",
+				Name:      "latency_budget_violations_total",
+				Help:      "RPCs that ran past their latency budget partitioned by transport/method.",
+			},
+			[]string{"transport", "method"},
+		),
+		schedulerJobRunsTotal: c.counterVec(
+			prometheus.CounterOpts{
+				Namespace: "open_rgs",
+				Subsystem: "scheduler",
+				Name:      "job_runs_total",
+				Help:      "Scheduled job runs partitioned by job/result.",
+			},
+			[]string{"job", "result"},
+		),
+		auditChainValid: c.gauge(
+			prometheus.GaugeOpts{
+				Namespace: "open_rgs",
+				Subsystem: "audit",
+				Name:      "chain_valid",
+				Help:      "1 when the recent audit partitions verified at the last background check, 0 when one did not.",
+			},
+		),
+		auditChainVerifiedUnix: c.gauge(
+			prometheus.GaugeOpts{
+				Namespace: "open_rgs",
+				Subsystem: "audit",
+				Name:      "chain_last_verified_unix",
+				Help:      "Unix time of the most recent background audit chain check.",
+			},
+		),
+		reportPurgeRunsTotal: c.counterVec(
+			prometheus.CounterOpts{
+				Namespace: "open_rgs",
+				Subsystem: "report_retention",
+				Name:      "purge_runs_total",
+				Help:      "Total report content purge runs partitioned by result.",
+			},
+			[]string{"result"},
+		),
+		reportPurgedTotal: c.counter(
+			prometheus.CounterOpts{
+				Namespace: "open_rgs",
+				Subsystem: "report_retention",
+				Name:      "purged_total",
+				Help:      "Total number of report runs whose content was purged.",
+			},
+		),
+		reportLastPurged: c.gauge(
+			prometheus.GaugeOpts{
+				Namespace: "open_rgs",
+				Subsystem: "report_retention",
+				Name:      "last_purged",
+				Help:      "Number of report runs purged in the most recent purge run.",
+			},
+		),
+		reportPurgeLastRunUnix: c.gauge(
+			prometheus.GaugeOpts{
+				Namespace: "open_rgs",
+				Subsystem: "report_retention",
+				Name:      "last_run_unix",
+				Help:      "Unix time of the most recent report content purge run.",
+			},
+		),
+		ingestionBufferDepth: c.gauge(
+			prometheus.GaugeOpts{
+				Namespace: "open_rgs",
+				Subsystem: "ingestion_buffer",
+				Name:      "depth",
+				Help:      "Events and meters held in the ingestion buffer awaiting a write.",
+			},
+		),
+		ingestionBufferDropped: c.counterVec(
+			prometheus.CounterOpts{
+				Namespace: "open_rgs",
+				Subsystem: "ingestion_buffer",
+				Name:      "dropped_total",
+				Help:      "Records refused by or dead-lettered from the ingestion buffer partitioned by reason.",
+			},
+			[]string{"reason"},
+		),
 	}
+	m.catalog = c
+	return m
 }
 
 func (m *Metrics) ObserveLedgerIdempotencyCleanup(deleted int64, err error) {
@@ -210,6 +358,22 @@ func (m *Metrics) ObserveLedgerIdempotencyCleanup(deleted int64, err error) {
 	}
 }
 
+func (m *Metrics) ObserveReportRetentionPurge(purged int64, err error) {
+	if m == nil {
+		return
+	}
+	m.reportPurgeLastRunUnix.Set(float64(time.Now().UTC().Unix()))
+	m.reportLastPurged.Set(float64(purged))
+	if err != nil {
+		m.reportPurgeRunsTotal.WithLabelValues("error").Inc()
+		return
+	}
+	m.reportPurgeRunsTotal.WithLabelValues("success").Inc()
+	if purged > 0 {
+		m.reportPurgedTotal.Add(float64(purged))
+	}
+}
+
 func (m *Metrics) RefreshLedgerIdempotencyCounts(ctx context.Context, db *sql.DB) {
 	if m == nil || db == nil {
 		return
@@ -254,6 +418,13 @@ func (m *Metrics) ObserveIdentityLockoutActivation(actorType rgsv1.ActorType) {
 	m.lockoutActivations.WithLabelValues(actorType.String()).Inc()
 }
 
+func (m *Metrics) ObserveIdentityRefreshTokenReuse(actorType rgsv1.ActorType) {
+	if m == nil {
+		return
+	}
+	m.refreshTokenReuse.WithLabelValues(actorType.String()).Inc()
+}
+
 func (m *Metrics) ObserveRemoteAccessDecision(outcome string) {
 	if m == nil {
 		return
@@ -325,6 +496,79 @@ func (m *Metrics) ObserveHTTPRequest(method, path string, statusCode int, elapse
 	m.httpRequestLatency.WithLabelValues(method, path).Observe(elapsed.Seconds())
 }
 
+func (m *Metrics) ObserveWagerSettlement(outcome string, latency time.Duration) {
+	if m == nil {
+		return
+	}
+	if latency < 0 {
+		latency = 0
+	}
+	m.wagerSettlementLatency.WithLabelValues(outcome).Observe(latency.Seconds())
+}
+
+func (m *Metrics) ObserveWagerSettlementSweep(overdue, escalated, voided, failed int) {
+	if m == nil {
+		return
+	}
+	m.wagersOverdue.Set(float64(overdue))
+	if escalated > 0 {
+		m.wagerOverdueActions.WithLabelValues("escalated").Add(float64(escalated))
+	}
+	if voided > 0 {
+		m.wagerOverdueActions.WithLabelValues("auto_voided").Add(float64(voided))
+	}
+	if failed > 0 {
+		m.wagerOverdueActions.WithLabelValues("failed").Add(float64(failed))
+	}
+}
+
+func (m *Metrics) ObserveAuditChainVerification(valid bool) {
+	if m == nil {
+		return
+	}
+	if valid {
+		m.auditChainValid.Set(1)
+	} else {
+		m.auditChainValid.Set(0)
+	}
+	m.auditChainVerifiedUnix.Set(float64(time.Now().Unix()))
+}
+
+func (m *Metrics) ObserveLoadShed(transport, class string) {
+	if m == nil {
+		return
+	}
+	m.loadShedTotal.WithLabelValues(transport, class).Inc()
+}
+
+func (m *Metrics) ObserveLatencyBudgetViolation(transport, method string) {
+	if m == nil {
+		return
+	}
+	m.latencyBudgetViolations.WithLabelValues(transport, method).Inc()
+}
+
+func (m *Metrics) ObserveSchedulerJobRun(job, result string) {
+	if m == nil {
+		return
+	}
+	m.schedulerJobRunsTotal.WithLabelValues(job, result).Inc()
+}
+
+func (m *Metrics) ObserveIngestionBufferDepth(depth int) {
+	if m == nil {
+		return
+	}
+	m.ingestionBufferDepth.Set(float64(depth))
+}
+
+func (m *Metrics) ObserveIngestionBufferDrop(reason string) {
+	if m == nil {
+		return
+	}
+	m.ingestionBufferDropped.WithLabelValues(reason).Inc()
+}
+
 func UnaryMetricsInterceptor(metrics *Metrics) grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
@@ -339,6 +583,17 @@ func UnaryMetricsInterceptor(metrics *Metrics) grpc.UnaryServerInterceptor {
 	}
 }
 
+// StreamMetricsInterceptor records a streaming RPC once it ends, with the
+// stream's whole lifetime as its latency.
+func StreamMetricsInterceptor(metrics *Metrics) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		started := time.Now()
+		err := handler(srv, ss)
+		metrics.ObserveRPCRequest("grpc", info.FullMethod, status.Code(err), time.Since(started))
+		return err
+	}
+}
+
 type metricsResponseWriter struct {
 	http.ResponseWriter
 	status int
@@ -0,0 +1,407 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	platformauth "github.com/wizardbeardstudio/open-rgs-go/internal/platform/auth"
+)
+
+// metricSpec describes a registered metric for the generated dashboard and
+// alert pack.
+type metricSpec struct {
+	Name      string
+	Subsystem string
+	Help      string
+	Type      string
+	Labels    []string
+}
+
+// metricCatalog registers metrics with the default registry and records
+// each one, so the monitoring pack is derived from what is actually
+// registered rather than maintained by hand.
+type metricCatalog struct {
+	specs []metricSpec
+}
+
+func (c *metricCatalog) add(ns, subsystem, name, help, typ string, labels []string) {
+	c.specs = append(c.specs, metricSpec{
+		Name:      prometheus.BuildFQName(ns, subsystem, name),
+		Subsystem: subsystem,
+		Help:      help,
+		Type:      typ,
+		Labels:    labels,
+	})
+}
+
+func (c *metricCatalog) counter(opts prometheus.CounterOpts) prometheus.Counter {
+	c.add(opts.Namespace, opts.Subsystem, opts.Name, opts.Help, "counter", nil)
+	return promauto.NewCounter(opts)
+}
+
+func (c *metricCatalog) counterVec(opts prometheus.CounterOpts, labels []string) *prometheus.CounterVec {
+	c.add(opts.Namespace, opts.Subsystem, opts.Name, opts.Help, "counter", labels)
+	return promauto.NewCounterVec(opts, labels)
+}
+
+func (c *metricCatalog) gauge(opts prometheus.GaugeOpts) prometheus.Gauge {
+	c.add(opts.Namespace, opts.Subsystem, opts.Name, opts.Help, "gauge", nil)
+	return promauto.NewGauge(opts)
+}
+
+func (c *metricCatalog) histogramVec(opts prometheus.HistogramOpts, labels []string) *prometheus.HistogramVec {
+	c.add(opts.Namespace, opts.Subsystem, opts.Name, opts.Help, "histogram", labels)
+	return promauto.NewHistogramVec(opts, labels)
+}
+
+// metricAlert is a baseline alert on one metric. Alerts on metrics that are
+// not registered are left out of the generated rule set.
+type metricAlert struct {
+	Metric   string
+	Alert    string
+	Expr     string
+	For      string
+	Severity string
+	Summary  string
+}
+
+// baselineAlerts mirrors docs/deployment/METRICS_ALERTING.md.
+var baselineAlerts = []metricAlert{
+	{
+		Metric:   "open_rgs_ledger_idempotency_cleanup_runs_total",
+		Alert:    "OpenRGSIdempotencyCleanupErrors",
+		Expr:     `increase(open_rgs_ledger_idempotency_cleanup_runs_total{result="error"}[15m]) > 0`,
+		For:      "5m",
+		Severity: "warning",
+		Summary:  "open-rgs idempotency cleanup errors detected",
+	},
+	{
+		Metric:   "open_rgs_ledger_idempotency_cleanup_last_run_unix",
+		Alert:    "OpenRGSIdempotencyCleanupStalled",
+		Expr:     `time() - open_rgs_ledger_idempotency_cleanup_last_run_unix > 1800`,
+		For:      "10m",
+		Severity: "critical",
+		Summary:  "open-rgs idempotency cleanup appears stalled",
+	},
+	{
+		Metric:   "open_rgs_ledger_idempotency_keys_expired",
+		Alert:    "OpenRGSIdempotencyExpiredBacklog",
+		Expr:     `open_rgs_ledger_idempotency_keys_expired > 1000`,
+		For:      "15m",
+		Severity: "warning",
+		Summary:  "open-rgs expired idempotency key backlog is high",
+	},
+	{
+		Metric:   "open_rgs_ledger_idempotency_keys_total",
+		Alert:    "OpenRGSIdempotencyKeyVolume",
+		Expr:     `open_rgs_ledger_idempotency_keys_total > 500000`,
+		For:      "15m",
+		Severity: "warning",
+		Summary:  "open-rgs idempotency key volume exceeds expected capacity",
+	},
+	{
+		Metric:   "open_rgs_identity_login_attempts_total",
+		Alert:    "OpenRGSIdentityDeniedLoginSpike",
+		Expr:     `sum(increase(open_rgs_identity_login_attempts_total{result=~"denied|invalid"}[15m])) / clamp_min(sum(increase(open_rgs_identity_login_attempts_total{result="ok"}[15m])), 1) > 3`,
+		For:      "10m",
+		Severity: "warning",
+		Summary:  "open-rgs identity denied login ratio is elevated",
+	},
+	{
+		Metric:   "open_rgs_identity_lockout_activations_total",
+		Alert:    "OpenRGSIdentityLockoutSurge",
+		Expr:     `sum(increase(open_rgs_identity_lockout_activations_total[15m])) > 10`,
+		For:      "10m",
+		Severity: "critical",
+		Summary:  "open-rgs identity lockout activations are surging",
+	},
+	{
+		Metric:   "open_rgs_identity_refresh_token_reuse_total",
+		Alert:    "OpenRGSIdentityRefreshTokenReuse",
+		Expr:     `sum(increase(open_rgs_identity_refresh_token_reuse_total[15m])) > 0`,
+		For:      "1m",
+		Severity: "critical",
+		Summary:  "open-rgs detected a replayed refresh token and revoked its session family",
+	},
+	{
+		Metric:   "open_rgs_audit_chain_valid",
+		Alert:    "OpenRGSAuditChainInvalid",
+		Expr:     `open_rgs_audit_chain_valid == 0`,
+		For:      "1m",
+		Severity: "critical",
+		Summary:  "open-rgs background audit chain verification failed",
+	},
+	{
+		Metric:   "open_rgs_identity_sessions_expired",
+		Alert:    "OpenRGSIdentityExpiredSessionsBacklog",
+		Expr:     `open_rgs_identity_sessions_expired > 5000`,
+		For:      "15m",
+		Severity: "warning",
+		Summary:  "open-rgs identity expired session backlog is high",
+	},
+	{
+		Metric:   "open_rgs_rpc_requests_total",
+		Alert:    "OpenRGSRequestFailureRate",
+		Expr:     `sum(increase(open_rgs_rpc_requests_total{result!="OK"}[15m])) / clamp_min(sum(increase(open_rgs_rpc_requests_total[15m])), 1) > 0.05`,
+		For:      "10m",
+		Severity: "warning",
+		Summary:  "open-rgs gRPC/REST non-OK ratio is above baseline",
+	},
+	{
+		Metric:   "open_rgs_rpc_request_duration_seconds",
+		Alert:    "OpenRGSRequestLatencyP95",
+		Expr:     `histogram_quantile(0.95, sum(rate(open_rgs_rpc_request_duration_seconds_bucket[5m])) by (transport, method, le)) > 0.5`,
+		For:      "10m",
+		Severity: "warning",
+		Summary:  "open-rgs p95 request latency exceeds 500ms",
+	},
+	{
+		Metric:   "open_rgs_rpc_latency_budget_violations_total",
+		Alert:    "OpenRGSLatencyBudgetViolations",
+		Expr:     `sum by (transport, method) (increase(open_rgs_rpc_latency_budget_violations_total[10m])) > 5`,
+		For:      "10m",
+		Severity: "warning",
+		Summary:  "open-rgs {{ $labels.method }} is running past its latency budget",
+	},
+	{
+		Metric:   "open_rgs_remote_access_decisions_total",
+		Alert:    "OpenRGSRemoteAccessLoggingUnavailable",
+		Expr:     `increase(open_rgs_remote_access_decisions_total{outcome="logging_unavailable"}[15m]) > 0`,
+		For:      "1m",
+		Severity: "critical",
+		Summary:  "open-rgs admin access failed closed because activity logging is unavailable",
+	},
+	{
+		Metric:   "open_rgs_remote_access_inmemory_log_entries",
+		Alert:    "OpenRGSRemoteAccessLogNearCapacity",
+		Expr:     `open_rgs_remote_access_inmemory_log_cap > 0 and open_rgs_remote_access_inmemory_log_entries / open_rgs_remote_access_inmemory_log_cap > 0.8`,
+		For:      "10m",
+		Severity: "warning",
+		Summary:  "open-rgs in-memory remote access log is near capacity",
+	},
+	{
+		Metric:   "open_rgs_wagering_overdue_wagers",
+		Alert:    "OpenRGSWagersOverdue",
+		Expr:     `open_rgs_wagering_overdue_wagers > 0`,
+		For:      "15m",
+		Severity: "warning",
+		Summary:  "open-rgs has pending wagers past the settlement SLA",
+	},
+	{
+		Metric:   "open_rgs_load_shedding_shed_total",
+		Alert:    "OpenRGSLoadShedding",
+		Expr:     `sum(increase(open_rgs_load_shedding_shed_total[5m])) > 0`,
+		For:      "5m",
+		Severity: "warning",
+		Summary:  "open-rgs is refusing requests under overload",
+	},
+	{
+		Metric:   "open_rgs_scheduler_job_runs_total",
+		Alert:    "OpenRGSScheduledJobFailures",
+		Expr:     `sum by (job) (increase(open_rgs_scheduler_job_runs_total{result="error"}[30m])) > 0`,
+		For:      "5m",
+		Severity: "warning",
+		Summary:  "open-rgs scheduled job {{ $labels.job }} is failing",
+	},
+	{
+		Metric:   "open_rgs_report_retention_purge_runs_total",
+		Alert:    "OpenRGSReportRetentionPurgeErrors",
+		Expr:     `increase(open_rgs_report_retention_purge_runs_total{result="error"}[12h]) > 0`,
+		For:      "5m",
+		Severity: "warning",
+		Summary:  "open-rgs report content purge errors detected",
+	},
+	{
+		Metric:   "open_rgs_report_retention_last_run_unix",
+		Alert:    "OpenRGSReportRetentionPurgeStalled",
+		Expr:     `time() - open_rgs_report_retention_last_run_unix > 43200`,
+		For:      "30m",
+		Severity: "warning",
+		Summary:  "open-rgs report content purge appears stalled",
+	},
+	{
+		Metric:   "open_rgs_ingestion_buffer_depth",
+		Alert:    "OpenRGSIngestionBacklog",
+		Expr:     `open_rgs_ingestion_buffer_depth > 0`,
+		For:      "15m",
+		Severity: "warning",
+		Summary:  "open-rgs is holding events or meters it could not write",
+	},
+	{
+		Metric:   "open_rgs_ingestion_buffer_dropped_total",
+		Alert:    "OpenRGSIngestionRecordsDropped",
+		Expr:     `sum by (reason) (increase(open_rgs_ingestion_buffer_dropped_total{reason!="rejected"}[15m])) > 0`,
+		For:      "1m",
+		Severity: "critical",
+		Summary:  "open-rgs dead-lettered buffered records ({{ $labels.reason }})",
+	},
+}
+
+func panelQuery(spec metricSpec) (expr, legend, unit string) {
+	by := ""
+	var legendParts []string
+	for _, l := range spec.Labels {
+		legendParts = append(legendParts, "{{"+l+"}}")
+	}
+	if len(spec.Labels) > 0 {
+		by = " by (" + strings.Join(spec.Labels, ", ") + ")"
+	}
+	legend = strings.Join(legendParts, " ")
+	switch spec.Type {
+	case "counter":
+		return "sum" + by + " (rate(" + spec.Name + "[5m]))", legend, "ops"
+	case "histogram":
+		labels := append([]string{"le"}, spec.Labels...)
+		if strings.HasSuffix(spec.Name, "_seconds") {
+			unit = "s"
+		}
+		return "histogram_quantile(0.95, sum by (" + strings.Join(labels, ", ") + ") (rate(" + spec.Name + "_bucket[5m])))", legend, unit
+	default:
+		if by == "" {
+			return spec.Name, legend, ""
+		}
+		return "sum" + by + " (" + spec.Name + ")", legend, ""
+	}
+}
+
+// GrafanaDashboard returns a Grafana dashboard model with one panel per
+// registered metric, grouped in rows by subsystem. Counters are shown as
+// per-second rates and histograms as p95.
+func (m *Metrics) GrafanaDashboard() ([]byte, error) {
+	var panels []map[string]any
+	id, y := 1, 0
+	var subsystem string
+	col := 0
+	for _, spec := range m.catalog.specs {
+		if spec.Subsystem != subsystem || len(panels) == 0 {
+			if col != 0 {
+				y += 8
+				col = 0
+			}
+			subsystem = spec.Subsystem
+			panels = append(panels, map[string]any{
+				"id":        id,
+				"type":      "row",
+				"title":     subsystem,
+				"collapsed": false,
+				"gridPos":   map[string]int{"h": 1, "w": 24, "x": 0, "y": y},
+			})
+			id++
+			y++
+		}
+		expr, legend, unit := panelQuery(spec)
+		panels = append(panels, map[string]any{
+			"id":          id,
+			"type":        "timeseries",
+			"title":       spec.Name,
+			"description": spec.Help,
+			"datasource":  map[string]string{"type": "prometheus", "uid": "${datasource}"},
+			"gridPos":     map[string]int{"h": 8, "w": 12, "x": col * 12, "y": y},
+			"fieldConfig": map[string]any{"defaults": map[string]string{"unit": unit}, "overrides": []any{}},
+			"targets": []map[string]string{{
+				"refId":        "A",
+				"expr":         expr,
+				"legendFormat": legend,
+			}},
+		})
+		id++
+		if col == 1 {
+			col = 0
+			y += 8
+		} else {
+			col = 1
+		}
+	}
+	dashboard := map[string]any{
+		"uid":           "open-rgs",
+		"title":         "open-rgs",
+		"tags":          []string{"open-rgs", "generated"},
+		"schemaVersion": 39,
+		"editable":      true,
+		"refresh":       "30s",
+		"time":          map[string]string{"from": "now-6h", "to": "now"},
+		"templating": map[string]any{"list": []map[string]any{{
+			"name":  "datasource",
+			"label": "Prometheus",
+			"type":  "datasource",
+			"query": "prometheus",
+		}}},
+		"panels": panels,
+	}
+	return json.MarshalIndent(dashboard, "", "  ")
+}
+
+// AlertRules returns a Prometheus rule file with the baseline alerts for the
+// registered metrics, one group per subsystem. The output is JSON, which
+// Prometheus loads as YAML.
+func (m *Metrics) AlertRules() ([]byte, error) {
+	subsystems := make(map[string]string, len(m.catalog.specs))
+	var order []string
+	for _, spec := range m.catalog.specs {
+		subsystems[spec.Name] = spec.Subsystem
+	}
+	rules := make(map[string][]map[string]any)
+	for _, a := range baselineAlerts {
+		subsystem, ok := subsystems[a.Metric]
+		if !ok {
+			continue
+		}
+		if _, seen := rules[subsystem]; !seen {
+			order = append(order, subsystem)
+		}
+		rules[subsystem] = append(rules[subsystem], map[string]any{
+			"alert":       a.Alert,
+			"expr":        a.Expr,
+			"for":         a.For,
+			"labels":      map[string]string{"severity": a.Severity},
+			"annotations": map[string]string{"summary": a.Summary},
+		})
+	}
+	groups := make([]map[string]any, 0, len(order))
+	for _, subsystem := range order {
+		groups = append(groups, map[string]any{
+			"name":  "open-rgs-" + strings.ReplaceAll(subsystem, "_", "-"),
+			"rules": rules[subsystem],
+		})
+	}
+	return json.MarshalIndent(map[string]any{"groups": groups}, "", "  ")
+}
+
+// MonitoringHandler serves the generated dashboard and alert rules to
+// operators at /v1/system/monitoring/grafana-dashboard and
+// /v1/system/monitoring/alert-rules. It expects the JWT middleware in front.
+func (m *Metrics) MonitoringHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		actor, ok := platformauth.ActorFromContext(r.Context())
+		if !ok || actor.Type != "ACTOR_TYPE_OPERATOR" {
+			http.Error(w, "operator actor required", http.StatusForbidden)
+			return
+		}
+		var (
+			body []byte
+			err  error
+		)
+		switch r.URL.Path {
+		case "/v1/system/monitoring/grafana-dashboard":
+			body, err = m.GrafanaDashboard()
+		case "/v1/system/monitoring/alert-rules":
+			body, err = m.AlertRules()
+		default:
+			http.NotFound(w, r)
+			return
+		}
+		if err != nil {
+			http.Error(w, "render failed", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(body)
+	})
+}
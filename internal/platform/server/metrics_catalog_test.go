@@ -0,0 +1,125 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	platformauth "github.com/wizardbeardstudio/open-rgs-go/internal/platform/auth"
+)
+
+func TestMetricCatalogCoversRegisteredMetrics(t *testing.T) {
+	m := metricsForTest()
+	var collectors int
+	v := reflect.ValueOf(m).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if v.Type().Field(i).Name != "catalog" {
+			collectors++
+		}
+	}
+	if len(m.catalog.specs) != collectors {
+		t.Fatalf("expected every metric registered through the catalog, got specs=%d fields=%d", len(m.catalog.specs), collectors)
+	}
+
+	known := make(map[string]bool, len(m.catalog.specs))
+	for _, spec := range m.catalog.specs {
+		known[spec.Name] = true
+	}
+	families, err := prometheus.DefaultGatherer.Gather()
+	if err != nil {
+		t.Fatalf("gather metrics: %v", err)
+	}
+	for _, fam := range families {
+		if strings.HasPrefix(fam.GetName(), "open_rgs_") && !known[fam.GetName()] {
+			t.Fatalf("metric %s is registered outside the catalog", fam.GetName())
+		}
+	}
+	for _, a := range baselineAlerts {
+		if !known[a.Metric] || !strings.Contains(a.Expr, a.Metric) {
+			t.Fatalf("alert %s references unregistered metric %s", a.Alert, a.Metric)
+		}
+	}
+}
+
+func TestMetricsGrafanaDashboardAndAlertRules(t *testing.T) {
+	m := metricsForTest()
+	raw, err := m.GrafanaDashboard()
+	if err != nil {
+		t.Fatalf("render dashboard: %v", err)
+	}
+	var dashboard struct {
+		Panels []struct {
+			Type    string `json:"type"`
+			Title   string `json:"title"`
+			Targets []struct {
+				Expr string `json:"expr"`
+			} `json:"targets"`
+		} `json:"panels"`
+	}
+	if err := json.Unmarshal(raw, &dashboard); err != nil {
+		t.Fatalf("decode dashboard: %v", err)
+	}
+	exprs := make(map[string]string)
+	for _, p := range dashboard.Panels {
+		if p.Type == "timeseries" {
+			exprs[p.Title] = p.Targets[0].Expr
+		}
+	}
+	for _, spec := range m.catalog.specs {
+		if !strings.Contains(exprs[spec.Name], spec.Name) {
+			t.Fatalf("expected a panel for %s, got expr=%q", spec.Name, exprs[spec.Name])
+		}
+	}
+	if got := exprs["open_rgs_rpc_request_duration_seconds"]; got != "histogram_quantile(0.95, sum by (le, transport, method) (rate(open_rgs_rpc_request_duration_seconds_bucket[5m])))" {
+		t.Fatalf("unexpected histogram panel expr: %s", got)
+	}
+
+	raw, err = m.AlertRules()
+	if err != nil {
+		t.Fatalf("render alert rules: %v", err)
+	}
+	var rules struct {
+		Groups []struct {
+			Name  string `json:"name"`
+			Rules []struct {
+				Alert string `json:"alert"`
+			} `json:"rules"`
+		} `json:"groups"`
+	}
+	if err := json.Unmarshal(raw, &rules); err != nil {
+		t.Fatalf("decode alert rules: %v", err)
+	}
+	var count int
+	for _, g := range rules.Groups {
+		count += len(g.Rules)
+	}
+	if count != len(baselineAlerts) || rules.Groups[0].Name != "open-rgs-ledger-idempotency" {
+		t.Fatalf("unexpected alert rule groups: %+v", rules.Groups)
+	}
+}
+
+func TestMetricsMonitoringHandlerRequiresOperator(t *testing.T) {
+	h := metricsForTest().MonitoringHandler()
+	for _, tc := range []struct {
+		actorType string
+		path      string
+		want      int
+	}{
+		{"ACTOR_TYPE_OPERATOR", "/v1/system/monitoring/grafana-dashboard", http.StatusOK},
+		{"ACTOR_TYPE_OPERATOR", "/v1/system/monitoring/alert-rules", http.StatusOK},
+		{"ACTOR_TYPE_OPERATOR", "/v1/system/monitoring/other", http.StatusNotFound},
+		{"ACTOR_TYPE_PLAYER", "/v1/system/monitoring/alert-rules", http.StatusForbidden},
+	} {
+		req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+		req = req.WithContext(platformauth.WithActor(req.Context(), platformauth.Actor{ID: "actor-1", Type: tc.actorType}))
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		if rec.Code != tc.want {
+			t.Fatalf("%s %s: got=%d want=%d", tc.actorType, tc.path, rec.Code, tc.want)
+		}
+	}
+}
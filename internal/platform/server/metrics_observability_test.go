@@ -126,6 +126,21 @@ func TestUnaryMetricsInterceptorPassesThroughError(t *testing.T) {
 	}
 }
 
+func TestStreamMetricsInterceptorRecordsStreams(t *testing.T) {
+	interceptor := StreamMetricsInterceptor(metricsForTest())
+	labels := map[string]string{"transport": "grpc", "method": "/rgs.v1.EventsService/WatchSignificantEvents", "result": "Canceled"}
+	before := counterValue(t, "open_rgs_rpc_requests_total", labels)
+	err := interceptor(nil, &recordingServerStream{ctx: context.Background()}, &grpc.StreamServerInfo{FullMethod: "/rgs.v1.EventsService/WatchSignificantEvents"}, func(any, grpc.ServerStream) error {
+		return status.Error(codes.Canceled, "client went away")
+	})
+	if status.Code(err) != codes.Canceled {
+		t.Fatalf("expected the stream error passed through, got=%v", err)
+	}
+	if after := counterValue(t, "open_rgs_rpc_requests_total", labels); after != before+1 {
+		t.Fatalf("expected the stream counted once, before=%f after=%f", before, after)
+	}
+}
+
 func TestMetricsObserveRemoteAccessDecision(t *testing.T) {
 	m := metricsForTest()
 	before := counterValue(t, "open_rgs_remote_access_decisions_total", map[string]string{"outcome": "allowed"})
@@ -149,3 +164,20 @@ func TestMetricsObserveRemoteAccessLogState(t *testing.T) {
 		t.Fatalf("expected cap gauge=50, got=%f", capacity)
 	}
 }
+
+func TestMetricsObserveWagerSettlementSweep(t *testing.T) {
+	m := metricsForTest()
+	before := counterValue(t, "open_rgs_wagering_overdue_actions_total", map[string]string{"action": "auto_voided"})
+	failedBefore := counterValue(t, "open_rgs_wagering_overdue_actions_total", map[string]string{"action": "failed"})
+	m.ObserveWagerSettlementSweep(3, 1, 2, 1)
+	after := counterValue(t, "open_rgs_wagering_overdue_actions_total", map[string]string{"action": "auto_voided"})
+	if after != before+2 {
+		t.Fatalf("expected auto_voided counter increment by 2, before=%f after=%f", before, after)
+	}
+	if failed := counterValue(t, "open_rgs_wagering_overdue_actions_total", map[string]string{"action": "failed"}); failed != failedBefore+1 {
+		t.Fatalf("expected failed counter increment by 1, before=%f after=%f", failedBefore, failed)
+	}
+	if overdue := gaugeValue(t, "open_rgs_wagering_overdue_wagers"); overdue != 3 {
+		t.Fatalf("expected overdue gauge=3, got=%f", overdue)
+	}
+}
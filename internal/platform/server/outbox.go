@@ -0,0 +1,202 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+// OutboxEvent is a committed domain mutation awaiting publication.
+type OutboxEvent struct {
+	EventID       int64
+	AggregateType string
+	AggregateID   string
+	EventType     string
+	Payload       []byte
+	CreatedAt     time.Time
+	Attempts      int
+}
+
+var auditOutboxEnabled atomic.Bool
+
+// SetAuditOutbox controls whether audit events written to Postgres are also
+// queued in outbox_events, in the same transaction, as aggregate type
+// "audit_event" so the dispatcher publishes them with the domain events.
+func SetAuditOutbox(enabled bool) {
+	auditOutboxEnabled.Store(enabled)
+}
+
+// OutboxPublisher hands a committed event to the message bus. Publish must
+// be safe to retry: delivery is at-least-once and consumers dedupe on
+// EventID.
+type OutboxPublisher interface {
+	Publish(ctx context.Context, ev OutboxEvent) error
+}
+
+// HTTPOutboxPublisher posts each event's JSON payload to a bus ingress
+// endpoint (for example a Kafka REST proxy or NATS HTTP bridge).
+type HTTPOutboxPublisher struct {
+	URL    string
+	Client *http.Client
+}
+
+func (p HTTPOutboxPublisher) Publish(ctx context.Context, ev OutboxEvent) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.URL, bytes.NewReader(ev.Payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-RGS-Event-ID", strconv.FormatInt(ev.EventID, 10))
+	req.Header.Set("X-RGS-Event-Type", ev.EventType)
+	req.Header.Set("X-RGS-Aggregate-Type", ev.AggregateType)
+	req.Header.Set("X-RGS-Aggregate-ID", ev.AggregateID)
+	req.Header.Set("X-RGS-Event-Time", ev.CreatedAt.UTC().Format(time.RFC3339Nano))
+	client := p.Client
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("publish rejected: status=%d", resp.StatusCode)
+	}
+	return nil
+}
+
+// defaultOutboxMaxAttempts is how many times an event is offered to the bus
+// before it is dead-lettered.
+const defaultOutboxMaxAttempts = 10
+
+// OutboxDispatcher drains outbox_events in commit order and publishes them.
+type OutboxDispatcher struct {
+	AuditStore audit.Store
+
+	db          *sql.DB
+	publisher   OutboxPublisher
+	maxBackoff  time.Duration
+	maxAttempts int
+
+	mu          sync.Mutex
+	nextAuditID int64
+}
+
+func NewOutboxDispatcher(db *sql.DB, publisher OutboxPublisher) *OutboxDispatcher {
+	return &OutboxDispatcher{
+		AuditStore:  NewAuditStore(db),
+		db:          db,
+		publisher:   publisher,
+		maxBackoff:  5 * time.Minute,
+		maxAttempts: defaultOutboxMaxAttempts,
+	}
+}
+
+// SetMaxAttempts sets how many failed publishes dead-letter an event. Zero
+// retries an event forever.
+func (d *OutboxDispatcher) SetMaxAttempts(n int) {
+	if d == nil || n < 0 {
+		return
+	}
+	d.maxAttempts = n
+}
+
+// outboxRetryDelay doubles from one second per failed attempt, capped at max.
+func outboxRetryDelay(attempts int, max time.Duration) time.Duration {
+	d := time.Second
+	for i := 1; i < attempts && d < max; i++ {
+		d *= 2
+	}
+	if d > max {
+		return max
+	}
+	return d
+}
+
+// DispatchJob publishes pending outbox events in batches until a short batch
+// shows the backlog is drained, and audits the published range and each
+// dead-lettered event under the system actor.
+func (d *OutboxDispatcher) DispatchJob(batchSize int) JobFunc {
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+	return func(ctx context.Context, _ string) (string, error) {
+		summary := newWorkerAuditSummary("outbox_dispatch", "created_at")
+		deadLettered := 0
+		for {
+			b, dead, err := d.dispatchBatch(ctx, batchSize)
+			summary.add(b)
+			deadLettered += len(dead)
+			auditErr := d.auditDeadLettered(ctx, dead)
+			if err != nil {
+				if auditErr == nil {
+					auditErr = d.auditWorkerRun(ctx, summary)
+				}
+				if auditErr != nil {
+					return "", errors.Join(err, auditErr)
+				}
+				return "", err
+			}
+			if auditErr != nil {
+				return "", fmt.Errorf("audit unavailable: %w", auditErr)
+			}
+			if b.affected+int64(len(dead)) < int64(batchSize) {
+				break
+			}
+		}
+		if err := d.auditWorkerRun(ctx, summary); err != nil {
+			return "", fmt.Errorf("audit unavailable: %w", err)
+		}
+		switch {
+		case deadLettered > 0:
+			return fmt.Sprintf("published=%d dead_lettered=%d", summary.Affected, deadLettered), nil
+		case summary.Affected > 0:
+			return fmt.Sprintf("published=%d", summary.Affected), nil
+		}
+		return "", nil
+	}
+}
+
+func (d *OutboxDispatcher) newAuditID() string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.nextAuditID++
+	return "outbox-audit-" + strconv.FormatInt(d.nextAuditID, 10)
+}
+
+func (d *OutboxDispatcher) auditWorkerRun(ctx context.Context, summary *workerAuditSummary) error {
+	if summary.Affected == 0 {
+		return nil
+	}
+	ev := newAuditEvent(nil, d.newAuditID(), time.Now().UTC(), "outbox_event", "outbox_events", summary.Job, []byte(`{}`), summary.snapshot(), audit.ResultSuccess, "")
+	return appendAuditTo(ctx, d.AuditStore, ev)
+}
+
+// auditDeadLettered records each event moved to the dead-letter state; the
+// last publish error stays on the outbox row.
+func (d *OutboxDispatcher) auditDeadLettered(ctx context.Context, events []OutboxEvent) error {
+	for _, ev := range events {
+		after, _ := json.Marshal(map[string]any{
+			"aggregate_type": ev.AggregateType,
+			"aggregate_id":   ev.AggregateID,
+			"event_type":     ev.EventType,
+			"attempts":       ev.Attempts,
+		})
+		rec := newAuditEvent(nil, d.newAuditID(), time.Now().UTC(), "outbox_event", strconv.FormatInt(ev.EventID, 10), "outbox_dead_letter", []byte(`{}`), after, audit.ResultSuccess, "max publish attempts exceeded")
+		if err := appendAuditTo(ctx, d.AuditStore, rec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
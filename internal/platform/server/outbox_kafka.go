@@ -0,0 +1,496 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// KafkaTopicRoutes maps outbox aggregate types to Kafka topics; the "*"
+// route catches aggregate types without their own.
+type KafkaTopicRoutes map[string]string
+
+// ParseKafkaTopicRoutes parses aggregate_type=topic pairs such as
+// "audit_event=rgs.audit,significant_event=rgs.events,*=rgs.domain".
+func ParseKafkaTopicRoutes(v string) (KafkaTopicRoutes, error) {
+	routes := KafkaTopicRoutes{}
+	for _, pair := range strings.Split(v, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		aggregateType, topic, ok := strings.Cut(pair, "=")
+		aggregateType, topic = strings.TrimSpace(aggregateType), strings.TrimSpace(topic)
+		if !ok || aggregateType == "" || topic == "" {
+			return nil, fmt.Errorf("invalid kafka topic route %q", pair)
+		}
+		routes[aggregateType] = topic
+	}
+	return routes, nil
+}
+
+func (r KafkaTopicRoutes) topicFor(aggregateType string) string {
+	if topic, ok := r[aggregateType]; ok {
+		return topic
+	}
+	return r["*"]
+}
+
+// KafkaOutboxPublisher produces outbox events to Kafka with acks=all, keyed
+// by aggregate id so an aggregate's events stay on one partition in order.
+// Keys are partitioned with murmur2 like the Java client's default
+// partitioner. Events whose aggregate type has no route are skipped.
+type KafkaOutboxPublisher struct {
+	Brokers   []string
+	Routes    KafkaTopicRoutes
+	TLSConfig *tls.Config
+	ClientID  string
+	Timeout   time.Duration
+
+	mu            sync.Mutex
+	conns         map[string]*kafkaConn
+	partitions    map[string][]string
+	correlationID int32
+}
+
+const (
+	kafkaAPIProduce  = 0
+	kafkaAPIMetadata = 3
+)
+
+type kafkaConn struct {
+	conn net.Conn
+	r    *bufio.Reader
+}
+
+func (p *KafkaOutboxPublisher) Publish(ctx context.Context, ev OutboxEvent) error {
+	topic := p.Routes.topicFor(ev.AggregateType)
+	if topic == "" {
+		return nil
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	err := p.produce(ctx, topic, ev)
+	if err != nil {
+		// Reconnect and refresh leaders on the retry.
+		p.reset()
+	}
+	return err
+}
+
+// Close drops broker connections.
+func (p *KafkaOutboxPublisher) Close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.reset()
+}
+
+func (p *KafkaOutboxPublisher) reset() {
+	for _, c := range p.conns {
+		c.conn.Close()
+	}
+	p.conns = nil
+	p.partitions = nil
+}
+
+func (p *KafkaOutboxPublisher) timeout() time.Duration {
+	if p.Timeout > 0 {
+		return p.Timeout
+	}
+	return 10 * time.Second
+}
+
+func (p *KafkaOutboxPublisher) produce(ctx context.Context, topic string, ev OutboxEvent) error {
+	leaders, err := p.leaders(ctx, topic)
+	if err != nil {
+		return err
+	}
+	key := []byte(ev.AggregateID)
+	partition := int32(kafkaMurmur2(key)&0x7fffffff) % int32(len(leaders))
+	if leaders[partition] == "" {
+		return fmt.Errorf("kafka topic %s partition %d has no leader", topic, partition)
+	}
+	// A null value would be read as a tombstone on compacted topics.
+	value := ev.Payload
+	if value == nil {
+		value = []byte{}
+	}
+	batch := kafkaRecordBatch(ev.CreatedAt, key, value, [][2]string{
+		{"rgs-event-id", strconv.FormatInt(ev.EventID, 10)},
+		{"rgs-event-type", ev.EventType},
+		{"rgs-aggregate-type", ev.AggregateType},
+		{"rgs-aggregate-id", ev.AggregateID},
+		{"rgs-event-time", ev.CreatedAt.UTC().Format(time.RFC3339Nano)},
+	})
+
+	var req kafkaEncoder
+	req.nullableString("")
+	req.int16(-1) // acks=all
+	req.int32(int32(p.timeout() / time.Millisecond))
+	req.int32(1)
+	req.string(topic)
+	req.int32(1)
+	req.int32(partition)
+	req.bytes(batch)
+	resp, err := p.roundTrip(ctx, leaders[partition], kafkaAPIProduce, 3, req.buf.Bytes())
+	if err != nil {
+		return err
+	}
+	d := kafkaDecoder{b: resp}
+	for topics := d.int32(); topics > 0; topics-- {
+		d.string()
+		for parts := d.int32(); parts > 0; parts-- {
+			d.int32()
+			code := d.int16()
+			d.int64()
+			d.int64()
+			if d.err == nil && code != 0 {
+				return fmt.Errorf("kafka produce to %s/%d failed: error_code=%d", topic, partition, code)
+			}
+		}
+	}
+	return d.err
+}
+
+// leaders returns the leader address of each partition of topic, indexed
+// by partition id, fetching metadata when it is not cached.
+func (p *KafkaOutboxPublisher) leaders(ctx context.Context, topic string) ([]string, error) {
+	if cached, ok := p.partitions[topic]; ok {
+		return cached, nil
+	}
+	var req kafkaEncoder
+	req.int32(1)
+	req.string(topic)
+	var lastErr error
+	for _, broker := range p.Brokers {
+		resp, err := p.roundTrip(ctx, broker, kafkaAPIMetadata, 1, req.buf.Bytes())
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		leaders, err := parseKafkaMetadata(resp, topic)
+		if err != nil {
+			return nil, err
+		}
+		if p.partitions == nil {
+			p.partitions = map[string][]string{}
+		}
+		p.partitions[topic] = leaders
+		return leaders, nil
+	}
+	if lastErr == nil {
+		lastErr = errors.New("no kafka brokers configured")
+	}
+	return nil, lastErr
+}
+
+func parseKafkaMetadata(resp []byte, topic string) ([]string, error) {
+	d := kafkaDecoder{b: resp}
+	brokers := map[int32]string{}
+	for n := d.int32(); n > 0 && d.err == nil; n-- {
+		id := d.int32()
+		host := d.string()
+		port := d.int32()
+		d.string() // rack
+		brokers[id] = net.JoinHostPort(host, strconv.Itoa(int(port)))
+	}
+	d.int32() // controller id
+	for n := d.int32(); n > 0 && d.err == nil; n-- {
+		code := d.int16()
+		name := d.string()
+		d.bool()
+		var leaders []string
+		for parts := d.int32(); parts > 0 && d.err == nil; parts-- {
+			d.int16()
+			index := d.int32()
+			leader := d.int32()
+			for r := d.int32(); r > 0; r-- {
+				d.int32()
+			}
+			for r := d.int32(); r > 0; r-- {
+				d.int32()
+			}
+			if index < 0 || index > 1<<16 {
+				return nil, errors.New("kafka metadata has invalid partition index")
+			}
+			for int(index) >= len(leaders) {
+				leaders = append(leaders, "")
+			}
+			leaders[index] = brokers[leader]
+		}
+		if name != topic {
+			continue
+		}
+		if d.err != nil {
+			return nil, d.err
+		}
+		if code != 0 || len(leaders) == 0 {
+			return nil, fmt.Errorf("kafka metadata for %s failed: error_code=%d", topic, code)
+		}
+		return leaders, nil
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	return nil, fmt.Errorf("kafka metadata missing topic %s", topic)
+}
+
+func (p *KafkaOutboxPublisher) conn(ctx context.Context, addr string) (*kafkaConn, error) {
+	if c, ok := p.conns[addr]; ok {
+		return c, nil
+	}
+	dialer := &net.Dialer{Timeout: p.timeout()}
+	var (
+		conn net.Conn
+		err  error
+	)
+	if p.TLSConfig != nil {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: p.TLSConfig}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if p.conns == nil {
+		p.conns = map[string]*kafkaConn{}
+	}
+	c := &kafkaConn{conn: conn, r: bufio.NewReader(conn)}
+	p.conns[addr] = c
+	return c, nil
+}
+
+// roundTrip sends one request with a v1 header and returns the response
+// body after the correlation id.
+func (p *KafkaOutboxPublisher) roundTrip(ctx context.Context, addr string, apiKey, apiVersion int16, body []byte) ([]byte, error) {
+	c, err := p.conn(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	p.correlationID++
+	clientID := p.ClientID
+	if clientID == "" {
+		clientID = "open-rgs"
+	}
+	var req kafkaEncoder
+	req.int32(0) // size, patched below
+	req.int16(apiKey)
+	req.int16(apiVersion)
+	req.int32(p.correlationID)
+	req.string(clientID)
+	req.buf.Write(body)
+	msg := req.buf.Bytes()
+	binary.BigEndian.PutUint32(msg, uint32(len(msg)-4))
+
+	deadline := time.Now().Add(p.timeout())
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	_ = c.conn.SetDeadline(deadline)
+	if _, err := c.conn.Write(msg); err != nil {
+		return nil, err
+	}
+	var size [4]byte
+	if _, err := io.ReadFull(c.r, size[:]); err != nil {
+		return nil, err
+	}
+	n := binary.BigEndian.Uint32(size[:])
+	if n < 4 || n > 64<<20 {
+		return nil, fmt.Errorf("kafka response size %d out of range", n)
+	}
+	resp := make([]byte, n)
+	if _, err := io.ReadFull(c.r, resp); err != nil {
+		return nil, err
+	}
+	if got := int32(binary.BigEndian.Uint32(resp)); got != p.correlationID {
+		return nil, fmt.Errorf("kafka correlation id mismatch: got=%d want=%d", got, p.correlationID)
+	}
+	return resp[4:], nil
+}
+
+// kafkaRecordBatch encodes a single-record v2 record batch.
+func kafkaRecordBatch(ts time.Time, key, value []byte, headers [][2]string) []byte {
+	var rec kafkaEncoder
+	rec.buf.WriteByte(0) // attributes
+	rec.varint(0)        // timestamp delta
+	rec.varint(0)        // offset delta
+	rec.varbytes(key)
+	rec.varbytes(value)
+	rec.varint(int64(len(headers)))
+	for _, h := range headers {
+		rec.varbytes([]byte(h[0]))
+		rec.varbytes([]byte(h[1]))
+	}
+
+	var tail kafkaEncoder // attributes through records, covered by the crc
+	tail.int16(0)
+	tail.int32(0) // last offset delta
+	tail.int64(ts.UnixMilli())
+	tail.int64(ts.UnixMilli())
+	tail.int64(-1) // producer id
+	tail.int16(-1) // producer epoch
+	tail.int32(-1) // base sequence
+	tail.int32(1)
+	tail.varint(int64(rec.buf.Len()))
+	tail.buf.Write(rec.buf.Bytes())
+
+	var batch kafkaEncoder
+	batch.int64(0)                                 // base offset
+	batch.int32(int32(4 + 1 + 4 + tail.buf.Len())) // length after this field
+	batch.int32(-1)                                // partition leader epoch
+	batch.buf.WriteByte(2)                         // magic
+	batch.int32(int32(crc32.Checksum(tail.buf.Bytes(), crc32.MakeTable(crc32.Castagnoli))))
+	batch.buf.Write(tail.buf.Bytes())
+	return batch.buf.Bytes()
+}
+
+// kafkaMurmur2 is the 32-bit murmur2 hash the Java client partitions keys
+// with.
+func kafkaMurmur2(data []byte) uint32 {
+	const (
+		seed = 0x9747b28c
+		m    = 0x5bd1e995
+		r    = 24
+	)
+	length := len(data)
+	h := uint32(seed) ^ uint32(length)
+	for i := 0; i+4 <= length; i += 4 {
+		k := binary.LittleEndian.Uint32(data[i:])
+		k *= m
+		k ^= k >> r
+		k *= m
+		h *= m
+		h ^= k
+	}
+	tail := data[length&^3:]
+	switch len(tail) {
+	case 3:
+		h ^= uint32(tail[2]) << 16
+		fallthrough
+	case 2:
+		h ^= uint32(tail[1]) << 8
+		fallthrough
+	case 1:
+		h ^= uint32(tail[0])
+		h *= m
+	}
+	h ^= h >> 13
+	h *= m
+	h ^= h >> 15
+	return h
+}
+
+type kafkaEncoder struct {
+	buf bytes.Buffer
+}
+
+func (e *kafkaEncoder) int16(v int16) {
+	_ = binary.Write(&e.buf, binary.BigEndian, v)
+}
+
+func (e *kafkaEncoder) int32(v int32) {
+	_ = binary.Write(&e.buf, binary.BigEndian, v)
+}
+
+func (e *kafkaEncoder) int64(v int64) {
+	_ = binary.Write(&e.buf, binary.BigEndian, v)
+}
+
+func (e *kafkaEncoder) string(v string) {
+	e.int16(int16(len(v)))
+	e.buf.WriteString(v)
+}
+
+// nullableString writes null for the empty string.
+func (e *kafkaEncoder) nullableString(v string) {
+	if v == "" {
+		e.int16(-1)
+		return
+	}
+	e.string(v)
+}
+
+func (e *kafkaEncoder) bytes(v []byte) {
+	e.int32(int32(len(v)))
+	e.buf.Write(v)
+}
+
+func (e *kafkaEncoder) varint(v int64) {
+	var tmp [binary.MaxVarintLen64]byte
+	e.buf.Write(tmp[:binary.PutVarint(tmp[:], v)])
+}
+
+func (e *kafkaEncoder) varbytes(v []byte) {
+	if v == nil {
+		e.varint(-1)
+		return
+	}
+	e.varint(int64(len(v)))
+	e.buf.Write(v)
+}
+
+type kafkaDecoder struct {
+	b   []byte
+	err error
+}
+
+func (d *kafkaDecoder) take(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if n < 0 || n > len(d.b) {
+		d.err = errors.New("kafka response truncated")
+		return nil
+	}
+	out := d.b[:n]
+	d.b = d.b[n:]
+	return out
+}
+
+func (d *kafkaDecoder) int16() int16 {
+	if b := d.take(2); b != nil {
+		return int16(binary.BigEndian.Uint16(b))
+	}
+	return 0
+}
+
+func (d *kafkaDecoder) int32() int32 {
+	if b := d.take(4); b != nil {
+		return int32(binary.BigEndian.Uint32(b))
+	}
+	return 0
+}
+
+func (d *kafkaDecoder) int64() int64 {
+	if b := d.take(8); b != nil {
+		return int64(binary.BigEndian.Uint64(b))
+	}
+	return 0
+}
+
+func (d *kafkaDecoder) bool() bool {
+	if b := d.take(1); b != nil {
+		return b[0] != 0
+	}
+	return false
+}
+
+// string reads a nullable string, returning "" for null.
+func (d *kafkaDecoder) string() string {
+	n := d.int16()
+	if n < 0 {
+		return ""
+	}
+	return string(d.take(int(n)))
+}
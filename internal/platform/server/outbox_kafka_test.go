@@ -0,0 +1,306 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+type producedKafkaRecord struct {
+	topic     string
+	partition int32
+	key       string
+	value     string
+	headers   map[string]string
+}
+
+// fakeKafkaBroker serves Metadata v1 and Produce v3 for a single broker
+// that leads every partition of the topics it knows.
+type fakeKafkaBroker struct {
+	t          *testing.T
+	ln         net.Listener
+	partitions map[string]int32
+	produceErr int16
+
+	mu       sync.Mutex
+	records  []producedKafkaRecord
+	metadata int
+}
+
+func newFakeKafkaBroker(t *testing.T, partitions map[string]int32) *fakeKafkaBroker {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	b := &fakeKafkaBroker{t: t, ln: ln, partitions: partitions}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go b.serve(conn)
+		}
+	}()
+	return b
+}
+
+func (b *fakeKafkaBroker) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	for {
+		var size [4]byte
+		if _, err := io.ReadFull(r, size[:]); err != nil {
+			return
+		}
+		msg := make([]byte, binary.BigEndian.Uint32(size[:]))
+		if _, err := io.ReadFull(r, msg); err != nil {
+			return
+		}
+		d := kafkaDecoder{b: msg}
+		apiKey, apiVersion, correlationID := d.int16(), d.int16(), d.int32()
+		d.string() // client id
+		var resp kafkaEncoder
+		resp.int32(0)
+		resp.int32(correlationID)
+		switch {
+		case apiKey == kafkaAPIMetadata && apiVersion == 1:
+			b.metadataResponse(&d, &resp)
+		case apiKey == kafkaAPIProduce && apiVersion == 3:
+			b.produceResponse(&d, &resp)
+		default:
+			b.t.Errorf("unexpected request api_key=%d version=%d", apiKey, apiVersion)
+			return
+		}
+		out := resp.buf.Bytes()
+		binary.BigEndian.PutUint32(out, uint32(len(out)-4))
+		if _, err := conn.Write(out); err != nil {
+			return
+		}
+	}
+}
+
+func (b *fakeKafkaBroker) metadataResponse(d *kafkaDecoder, resp *kafkaEncoder) {
+	b.mu.Lock()
+	b.metadata++
+	b.mu.Unlock()
+	host, portRaw, _ := net.SplitHostPort(b.ln.Addr().String())
+	port, _ := strconv.Atoi(portRaw)
+	resp.int32(1)
+	resp.int32(7)
+	resp.string(host)
+	resp.int32(int32(port))
+	resp.nullableString("")
+	resp.int32(7)
+	topics := d.int32()
+	resp.int32(topics)
+	for ; topics > 0; topics-- {
+		name := d.string()
+		n, ok := b.partitions[name]
+		if !ok {
+			resp.int16(3) // UNKNOWN_TOPIC_OR_PARTITION
+			resp.string(name)
+			resp.buf.WriteByte(0)
+			resp.int32(0)
+			continue
+		}
+		resp.int16(0)
+		resp.string(name)
+		resp.buf.WriteByte(0)
+		resp.int32(n)
+		for i := int32(0); i < n; i++ {
+			resp.int16(0)
+			resp.int32(i)
+			resp.int32(7)
+			resp.int32(1)
+			resp.int32(7)
+			resp.int32(1)
+			resp.int32(7)
+		}
+	}
+}
+
+func (b *fakeKafkaBroker) produceResponse(d *kafkaDecoder, resp *kafkaEncoder) {
+	d.string() // transactional id
+	if acks := d.int16(); acks != -1 {
+		b.t.Errorf("expected acks=all, got %d", acks)
+	}
+	d.int32() // timeout
+	d.int32() // topic count
+	topic := d.string()
+	d.int32() // partition count
+	partition := d.int32()
+	batch := d.take(int(d.int32()))
+	rec, ok := b.decodeBatch(batch)
+	if ok && b.produceErr == 0 {
+		rec.topic, rec.partition = topic, partition
+		b.mu.Lock()
+		b.records = append(b.records, rec)
+		b.mu.Unlock()
+	}
+	resp.int32(1)
+	resp.string(topic)
+	resp.int32(1)
+	resp.int32(partition)
+	resp.int16(b.produceErr)
+	resp.int64(0)
+	resp.int64(-1)
+	resp.int32(0)
+}
+
+func (b *fakeKafkaBroker) decodeBatch(batch []byte) (producedKafkaRecord, bool) {
+	var rec producedKafkaRecord
+	if len(batch) < 61 || batch[16] != 2 {
+		b.t.Errorf("unexpected record batch header")
+		return rec, false
+	}
+	if int(binary.BigEndian.Uint32(batch[8:])) != len(batch)-12 {
+		b.t.Errorf("record batch length mismatch")
+		return rec, false
+	}
+	if binary.BigEndian.Uint32(batch[17:]) != crc32.Checksum(batch[21:], crc32.MakeTable(crc32.Castagnoli)) {
+		b.t.Errorf("record batch crc mismatch")
+		return rec, false
+	}
+	if count := binary.BigEndian.Uint32(batch[57:]); count != 1 {
+		b.t.Errorf("expected one record, got %d", count)
+		return rec, false
+	}
+	p := batch[61:]
+	varint := func() int64 {
+		v, n := binary.Varint(p)
+		p = p[n:]
+		return v
+	}
+	varbytes := func() string {
+		n := varint()
+		if n < 0 {
+			return ""
+		}
+		out := string(p[:n])
+		p = p[n:]
+		return out
+	}
+	varint()  // record length
+	p = p[1:] // attributes
+	varint()  // timestamp delta
+	varint()  // offset delta
+	rec.key = varbytes()
+	rec.value = varbytes()
+	rec.headers = map[string]string{}
+	for n := varint(); n > 0; n-- {
+		k := varbytes()
+		rec.headers[k] = varbytes()
+	}
+	return rec, true
+}
+
+func (b *fakeKafkaBroker) produced() []producedKafkaRecord {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]producedKafkaRecord(nil), b.records...)
+}
+
+func TestKafkaOutboxPublisherRoutesAndPartitionsEvents(t *testing.T) {
+	broker := newFakeKafkaBroker(t, map[string]int32{"rgs.audit": 1, "rgs.domain-events": 4})
+	routes, err := ParseKafkaTopicRoutes("audit_event=rgs.audit, *=rgs.domain-events")
+	if err != nil {
+		t.Fatalf("parse routes: %v", err)
+	}
+	pub := &KafkaOutboxPublisher{Brokers: []string{broker.ln.Addr().String()}, Routes: routes}
+	defer pub.Close()
+	ctx := context.Background()
+	created := time.Date(2026, 3, 10, 10, 0, 0, 0, time.UTC)
+	events := []OutboxEvent{
+		{EventID: 1, AggregateType: "ledger_transaction", AggregateID: "tx-1", EventType: "ledger.deposit", Payload: []byte(`{"transactionId":"tx-1"}`), CreatedAt: created},
+		{EventID: 2, AggregateType: "audit_event", AggregateID: "audit-1", EventType: "audit.deposit", Payload: []byte(`{"audit_id":"audit-1"}`), CreatedAt: created},
+		{EventID: 3, AggregateType: "ledger_transaction", AggregateID: "tx-1", EventType: "ledger.withdrawal", Payload: []byte(`{}`), CreatedAt: created},
+	}
+	for _, ev := range events {
+		if err := pub.Publish(ctx, ev); err != nil {
+			t.Fatalf("publish %d: %v", ev.EventID, err)
+		}
+	}
+
+	got := broker.produced()
+	if len(got) != 3 {
+		t.Fatalf("expected 3 records, got %d", len(got))
+	}
+	wantPartition := int32(kafkaMurmur2([]byte("tx-1"))&0x7fffffff) % 4
+	if got[0].topic != "rgs.domain-events" || got[0].partition != wantPartition || got[2].partition != wantPartition {
+		t.Fatalf("expected tx-1 events on rgs.domain-events/%d, got %+v", wantPartition, got)
+	}
+	if got[1].topic != "rgs.audit" || got[1].key != "audit-1" || got[1].value != `{"audit_id":"audit-1"}` {
+		t.Fatalf("unexpected audit record: %+v", got[1])
+	}
+	if got[0].headers["rgs-event-id"] != "1" || got[0].headers["rgs-event-type"] != "ledger.deposit" || got[0].headers["rgs-aggregate-type"] != "ledger_transaction" {
+		t.Fatalf("unexpected headers: %+v", got[0].headers)
+	}
+	broker.mu.Lock()
+	metadata := broker.metadata
+	broker.mu.Unlock()
+	if metadata != 2 {
+		t.Fatalf("expected metadata cached per topic, got %d fetches", metadata)
+	}
+}
+
+func TestKafkaOutboxPublisherSkipsUnroutedAndReportsErrors(t *testing.T) {
+	broker := newFakeKafkaBroker(t, map[string]int32{"rgs.audit": 1})
+	pub := &KafkaOutboxPublisher{Brokers: []string{broker.ln.Addr().String()}, Routes: KafkaTopicRoutes{"audit_event": "rgs.audit", "wager": "rgs.missing"}}
+	defer pub.Close()
+	ctx := context.Background()
+
+	if err := pub.Publish(ctx, OutboxEvent{EventID: 1, AggregateType: "ledger_transaction", AggregateID: "tx-1"}); err != nil || len(broker.produced()) != 0 {
+		t.Fatalf("expected unrouted event to be skipped, err=%v", err)
+	}
+	if err := pub.Publish(ctx, OutboxEvent{EventID: 2, AggregateType: "wager", AggregateID: "w-1"}); err == nil {
+		t.Fatalf("expected unknown topic error")
+	}
+	broker.produceErr = 6 // NOT_LEADER_OR_FOLLOWER
+	if err := pub.Publish(ctx, OutboxEvent{EventID: 3, AggregateType: "audit_event", AggregateID: "audit-1"}); err == nil {
+		t.Fatalf("expected produce error")
+	}
+	broker.produceErr = 0
+	if err := pub.Publish(ctx, OutboxEvent{EventID: 3, AggregateType: "audit_event", AggregateID: "audit-1"}); err != nil {
+		t.Fatalf("expected retry to succeed after reconnect: %v", err)
+	}
+
+	down := &KafkaOutboxPublisher{Brokers: []string{"127.0.0.1:1"}, Routes: KafkaTopicRoutes{"*": "rgs.audit"}, Timeout: time.Second}
+	if err := down.Publish(ctx, OutboxEvent{EventID: 4, AggregateType: "audit_event", AggregateID: "audit-2"}); err == nil {
+		t.Fatalf("expected unreachable broker error")
+	}
+}
+
+func TestKafkaMurmur2MatchesJavaClient(t *testing.T) {
+	// Vectors from the Java client's UtilsTest.
+	cases := map[string]int32{
+		"21":                         -973932308,
+		"foobar":                     -790332482,
+		"a-little-bit-long-string":   -985981536,
+		"a-little-bit-longer-string": -1486304829,
+		"lkjh234lh9fiuh90y23oiuhsafujhadof229phr9h19h89h8": -58897971,
+		"abc": 479470107,
+	}
+	for in, want := range cases {
+		if got := int32(kafkaMurmur2([]byte(in))); got != want {
+			t.Fatalf("murmur2(%q) = %d, want %d", in, got, want)
+		}
+	}
+}
+
+func TestParseKafkaTopicRoutesRejectsMalformedPairs(t *testing.T) {
+	for _, bad := range []string{"audit_event", "=rgs.audit", "audit_event="} {
+		if _, err := ParseKafkaTopicRoutes(bad); err == nil {
+			t.Fatalf("expected error for %q", bad)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// insertOutboxEventTx records a domain event inside the caller's transaction
+// so it becomes visible to the dispatcher only if the mutation commits.
+func insertOutboxEventTx(ctx context.Context, tx *sql.Tx, aggregateType, aggregateID, eventType string, payload proto.Message) error {
+	body, err := protojson.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	return insertOutboxJSONTx(ctx, tx, aggregateType, aggregateID, eventType, body)
+}
+
+// insertOutboxJSONTx is insertOutboxEventTx for payloads already encoded as
+// JSON.
+func insertOutboxJSONTx(ctx context.Context, tx *sql.Tx, aggregateType, aggregateID, eventType string, body []byte) error {
+	const q = `
+INSERT INTO outbox_events (aggregate_type, aggregate_id, event_type, payload)
+VALUES ($1,$2,$3,$4::jsonb)
+`
+	_, err := tx.ExecContext(ctx, q, aggregateType, aggregateID, eventType, string(body))
+	return err
+}
+
+// outboxDispatchLockKey is the Postgres advisory lock that admits a single
+// dispatcher at a time across the deployment.
+const outboxDispatchLockKey int64 = 0x7267732d6f7574
+
+// DispatchBatch publishes up to batchSize due events in event_id order. It
+// stops at the first publish failure so later events never overtake an
+// earlier one, records the failure, and schedules a retry with backoff. An
+// event that fails maxAttempts times is dead-lettered instead, so it stops
+// holding up the queue. Only one dispatcher drains the outbox at a time;
+// while another holds the dispatch lock DispatchBatch publishes nothing.
+func (d *OutboxDispatcher) DispatchBatch(ctx context.Context, batchSize int) (int, error) {
+	b, _, err := d.dispatchBatch(ctx, batchSize)
+	return int(b.affected), err
+}
+
+// dispatchBatch is DispatchBatch reporting the created_at range of the
+// events it published, for the worker audit trail, and the events it
+// dead-lettered.
+func (d *OutboxDispatcher) dispatchBatch(ctx context.Context, batchSize int) (workerBatch, []OutboxEvent, error) {
+	var published workerBatch
+	if d == nil || d.db == nil || d.publisher == nil {
+		return published, nil, nil
+	}
+	dbtx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return published, nil, err
+	}
+	defer func() {
+		_ = dbtx.Rollback()
+	}()
+
+	// Events are published strictly in event_id order, which concurrent
+	// dispatchers skipping each other's locked rows would break.
+	var locked bool
+	if err := dbtx.QueryRowContext(ctx, `SELECT pg_try_advisory_xact_lock($1)`, outboxDispatchLockKey).Scan(&locked); err != nil {
+		return published, nil, err
+	}
+	if !locked {
+		return published, nil, nil
+	}
+
+	const sel = `
+SELECT event_id, aggregate_type, aggregate_id, event_type, payload, created_at, attempts,
+       next_attempt_at <= NOW()
+FROM outbox_events
+WHERE published_at IS NULL AND dead_lettered_at IS NULL
+ORDER BY event_id ASC
+LIMIT $1
+FOR UPDATE
+`
+	rows, err := dbtx.QueryContext(ctx, sel, batchSize)
+	if err != nil {
+		return published, nil, err
+	}
+	events := make([]OutboxEvent, 0, batchSize)
+	dueFlags := make([]bool, 0, batchSize)
+	for rows.Next() {
+		var (
+			ev    OutboxEvent
+			isDue bool
+		)
+		if err := rows.Scan(&ev.EventID, &ev.AggregateType, &ev.AggregateID, &ev.EventType, &ev.Payload, &ev.CreatedAt, &ev.Attempts, &isDue); err != nil {
+			_ = rows.Close()
+			return published, nil, err
+		}
+		events = append(events, ev)
+		dueFlags = append(dueFlags, isDue)
+	}
+	if err := rows.Err(); err != nil {
+		_ = rows.Close()
+		return published, nil, err
+	}
+	_ = rows.Close()
+
+	const markPublished = `
+UPDATE outbox_events
+SET published_at = NOW(), attempts = attempts + 1, last_error = ''
+WHERE event_id = $1
+`
+	const markFailed = `
+UPDATE outbox_events
+SET attempts = attempts + 1, last_error = $2, next_attempt_at = $3::timestamptz
+WHERE event_id = $1
+`
+	const markDeadLettered = `
+UPDATE outbox_events
+SET attempts = attempts + 1, last_error = $2, dead_lettered_at = NOW()
+WHERE event_id = $1
+`
+	var deadLettered []OutboxEvent
+	for i, ev := range events {
+		// A backed-off head event holds the queue to preserve ordering.
+		if !dueFlags[i] {
+			break
+		}
+		if err := d.publisher.Publish(ctx, ev); err != nil {
+			ev.Attempts++
+			if d.maxAttempts > 0 && ev.Attempts >= d.maxAttempts {
+				if _, uerr := dbtx.ExecContext(ctx, markDeadLettered, ev.EventID, err.Error()); uerr != nil {
+					return workerBatch{}, nil, uerr
+				}
+				deadLettered = append(deadLettered, ev)
+				continue
+			}
+			retryAt := time.Now().UTC().Add(outboxRetryDelay(ev.Attempts, d.maxBackoff))
+			if _, uerr := dbtx.ExecContext(ctx, markFailed, ev.EventID, err.Error(), retryAt); uerr != nil {
+				return workerBatch{}, nil, uerr
+			}
+			if cerr := dbtx.Commit(); cerr != nil {
+				return workerBatch{}, nil, cerr
+			}
+			return published, deadLettered, err
+		}
+		if _, err := dbtx.ExecContext(ctx, markPublished, ev.EventID); err != nil {
+			return workerBatch{}, nil, err
+		}
+		published.affected++
+		if published.from.IsZero() || ev.CreatedAt.Before(published.from) {
+			published.from = ev.CreatedAt
+		}
+		if ev.CreatedAt.After(published.to) {
+			published.to = ev.CreatedAt
+		}
+	}
+	if err := dbtx.Commit(); err != nil {
+		return workerBatch{}, nil, err
+	}
+	return published, deadLettered, nil
+}
@@ -0,0 +1,67 @@
+package server
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHTTPOutboxPublisherPostsEvent(t *testing.T) {
+	var gotBody, gotID, gotType string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		gotBody = string(b)
+		gotID = r.Header.Get("X-RGS-Event-ID")
+		gotType = r.Header.Get("X-RGS-Event-Type")
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	pub := HTTPOutboxPublisher{URL: srv.URL, Client: srv.Client()}
+	err := pub.Publish(context.Background(), OutboxEvent{
+		EventID:       42,
+		AggregateType: "wager",
+		AggregateID:   "wager-1",
+		EventType:     "wager.placed",
+		Payload:       []byte(`{"wagerId":"wager-1"}`),
+		CreatedAt:     time.Date(2026, 2, 16, 13, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("publish err: %v", err)
+	}
+	if gotBody != `{"wagerId":"wager-1"}` || gotID != "42" || gotType != "wager.placed" {
+		t.Fatalf("unexpected request: body=%q id=%q type=%q", gotBody, gotID, gotType)
+	}
+}
+
+func TestHTTPOutboxPublisherRejectsNon2xx(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	pub := HTTPOutboxPublisher{URL: srv.URL, Client: srv.Client()}
+	if err := pub.Publish(context.Background(), OutboxEvent{EventID: 1, Payload: []byte(`{}`)}); err == nil {
+		t.Fatalf("expected error for 503 response")
+	}
+}
+
+func TestOutboxRetryDelayBacksOffToCap(t *testing.T) {
+	cases := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, time.Second},
+		{2, 2 * time.Second},
+		{4, 8 * time.Second},
+		{20, time.Minute},
+	}
+	for _, tc := range cases {
+		if got := outboxRetryDelay(tc.attempts, time.Minute); got != tc.want {
+			t.Fatalf("attempts=%d: expected %s, got %s", tc.attempts, tc.want, got)
+		}
+	}
+}
@@ -0,0 +1,446 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/clock"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/money"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultPlayerLimitCoolingOff is how long a raised or removed limit waits
+// before it applies.
+const DefaultPlayerLimitCoolingOff = 24 * time.Hour
+
+// playerLimitCurrencyReason denies activity in a currency other than that of
+// a limit in force, since its usage cannot be measured against the limit.
+const playerLimitCurrencyReason = "currency does not match player limit"
+
+// errPlayerLimitCurrency is returned by a usage func whose recorded activity
+// is not in the limit's currency.
+var errPlayerLimitCurrency = errors.New("activity currency does not match player limit")
+
+// PlayerLimitSource resolves the responsible gaming limits in force for a
+// player. PlayerLimitsService implements it for the ledger and wagering
+// services.
+type PlayerLimitSource interface {
+	PlayerLimitsInForce(ctx context.Context, playerID string, limitType rgsv1.PlayerLimitType) ([]*rgsv1.PlayerLimit, error)
+}
+
+// PlayerLimitsService keeps per-player deposit, loss and wager limits. A
+// change that tightens a limit applies at once; one that loosens it is held
+// as pending until the cooling-off period has passed, and applies when it is
+// next read.
+type PlayerLimitsService struct {
+	rgsv1.UnimplementedPlayerLimitsServiceServer
+
+	Clock      clock.Clock
+	AuditStore audit.Store
+
+	mu          sync.Mutex
+	nextAuditID int64
+	coolingOff  time.Duration
+	limits      map[string]*rgsv1.PlayerLimit
+	db          *sql.DB
+}
+
+func NewPlayerLimitsService(clk clock.Clock, db ...*sql.DB) *PlayerLimitsService {
+	var handle *sql.DB
+	if len(db) > 0 {
+		handle = db[0]
+	}
+	return &PlayerLimitsService{
+		Clock:      clk,
+		AuditStore: NewAuditStore(handle),
+		coolingOff: DefaultPlayerLimitCoolingOff,
+		limits:     make(map[string]*rgsv1.PlayerLimit),
+		db:         handle,
+	}
+}
+
+// SetCoolingOff sets how long raises and removals wait before applying.
+// Non-positive values restore the default.
+func (s *PlayerLimitsService) SetCoolingOff(d time.Duration) {
+	if s == nil {
+		return
+	}
+	if d <= 0 {
+		d = DefaultPlayerLimitCoolingOff
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.coolingOff = d
+}
+
+func (s *PlayerLimitsService) now() time.Time {
+	if s.Clock == nil {
+		return time.Now().UTC()
+	}
+	return s.Clock.Now().UTC()
+}
+
+func (s *PlayerLimitsService) responseMeta(meta *rgsv1.RequestMeta, code rgsv1.ResultCode, denial string) *rgsv1.ResponseMeta {
+	return &rgsv1.ResponseMeta{
+		RequestId:    requestID(meta),
+		ResultCode:   code,
+		DenialReason: denial,
+		ServerTime:   s.now().Format(time.RFC3339Nano),
+	}
+}
+
+// appendAuditLocked records a player limit audit event. s.mu must be held.
+func (s *PlayerLimitsService) appendAuditLocked(meta *rgsv1.RequestMeta, playerID, action string, before, after []byte, result audit.Result, reason string) error {
+	s.nextAuditID++
+	auditID := "player-limits-audit-" + strconv.FormatInt(s.nextAuditID, 10)
+	ev := newAuditEvent(meta, auditID, s.now(), "player_limit", playerID, action, before, after, result, reason)
+	return appendAuditTo(context.Background(), s.AuditStore, ev)
+}
+
+func (s *PlayerLimitsService) auditDenied(meta *rgsv1.RequestMeta, playerID, action, reason string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_ = s.appendAuditLocked(meta, playerID, action, []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+}
+
+func playerLimitKey(playerID string, limitType rgsv1.PlayerLimitType, period rgsv1.PlayerLimitPeriod) string {
+	return playerID + "|" + limitType.String() + "|" + period.String()
+}
+
+// playerLimitPeriodDays is the number of gaming days a period covers,
+// including the current one, or 0 for an unknown period.
+func playerLimitPeriodDays(p rgsv1.PlayerLimitPeriod) int {
+	switch p {
+	case rgsv1.PlayerLimitPeriod_PLAYER_LIMIT_PERIOD_DAILY:
+		return 1
+	case rgsv1.PlayerLimitPeriod_PLAYER_LIMIT_PERIOD_WEEKLY:
+		return 7
+	case rgsv1.PlayerLimitPeriod_PLAYER_LIMIT_PERIOD_MONTHLY:
+		return 30
+	default:
+		return 0
+	}
+}
+
+// playerLimitWindow returns the first gaming day of the period ending at
+// now, and the time that day started.
+func playerLimitWindow(p rgsv1.PlayerLimitPeriod, now time.Time) (string, time.Time) {
+	cal := gamingCalendarFor("")
+	today, _ := time.Parse(activityDayLayout, cal.GamingDay(now))
+	from := today.AddDate(0, 0, -(playerLimitPeriodDays(p) - 1))
+	return from.Format(activityDayLayout), cal.Start(from)
+}
+
+// playerLimitDenial is the reason activity over a limit of this type is
+// denied with.
+func playerLimitDenial(t rgsv1.PlayerLimitType) string {
+	switch t {
+	case rgsv1.PlayerLimitType_PLAYER_LIMIT_TYPE_DEPOSIT:
+		return "deposit limit exceeded"
+	case rgsv1.PlayerLimitType_PLAYER_LIMIT_TYPE_LOSS:
+		return "loss limit exceeded"
+	default:
+		return "wager limit exceeded"
+	}
+}
+
+func clonePlayerLimit(in *rgsv1.PlayerLimit) *rgsv1.PlayerLimit {
+	if in == nil {
+		return nil
+	}
+	cp, _ := proto.Clone(in).(*rgsv1.PlayerLimit)
+	return cp
+}
+
+// applyDuePlayerLimit returns a copy of l with its pending change applied
+// once the cooling-off period has passed.
+func applyDuePlayerLimit(l *rgsv1.PlayerLimit, now time.Time) *rgsv1.PlayerLimit {
+	out := clonePlayerLimit(l)
+	if out == nil || out.PendingEffectiveAt == "" || parseTS(out.PendingEffectiveAt).After(now) {
+		return out
+	}
+	out.Amount = out.PendingAmount
+	if out.PendingRemoval {
+		out.Amount = nil
+	}
+	out.PendingAmount = nil
+	out.PendingRemoval = false
+	out.PendingEffectiveAt = ""
+	return out
+}
+
+// loadPlayerLimitsLocked returns the player's stored limits ordered by type
+// and period, without applying pending changes. s.mu must be held.
+func (s *PlayerLimitsService) loadPlayerLimitsLocked(ctx context.Context, playerID string) ([]*rgsv1.PlayerLimit, error) {
+	if s.db != nil {
+		return s.listPlayerLimitsFromDB(ctx, playerID)
+	}
+	out := make([]*rgsv1.PlayerLimit, 0)
+	for _, l := range s.limits {
+		if l.PlayerId == playerID {
+			out = append(out, clonePlayerLimit(l))
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].LimitType != out[j].LimitType {
+			return out[i].LimitType < out[j].LimitType
+		}
+		return out[i].Period < out[j].Period
+	})
+	return out, nil
+}
+
+// PlayerLimitsInForce implements PlayerLimitSource. A nil service has no
+// limits.
+func (s *PlayerLimitsService) PlayerLimitsInForce(ctx context.Context, playerID string, limitType rgsv1.PlayerLimitType) ([]*rgsv1.PlayerLimit, error) {
+	if s == nil || playerID == "" {
+		return nil, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored, err := s.loadPlayerLimitsLocked(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	var out []*rgsv1.PlayerLimit
+	for _, l := range stored {
+		if l.LimitType != limitType {
+			continue
+		}
+		if l = applyDuePlayerLimit(l, now); l.Amount != nil {
+			out = append(out, l)
+		}
+	}
+	return out, nil
+}
+
+// checkPlayerLimits returns the denial reason when adding amount to the
+// usage of any limit in force would exceed it. usage reports the player's
+// activity since the start of a limit's period, in the limit's currency, or
+// errPlayerLimitCurrency when it cannot. Limits fail closed: amounts in
+// another currency than a limit, and usage that cannot be measured, are
+// denied.
+func checkPlayerLimits(ctx context.Context, src PlayerLimitSource, playerID string, limitType rgsv1.PlayerLimitType, amount *rgsv1.Money, now time.Time, usage func(fromDay string, since time.Time) (int64, error)) (string, error) {
+	if src == nil {
+		return "", nil
+	}
+	limits, err := src.PlayerLimitsInForce(ctx, playerID, limitType)
+	if err != nil {
+		return "", err
+	}
+	for _, l := range limits {
+		if l.Amount.GetCurrency() != amount.GetCurrency() {
+			return playerLimitCurrencyReason, nil
+		}
+		used, err := usage(playerLimitWindow(l.Period, now))
+		if errors.Is(err, errPlayerLimitCurrency) {
+			return playerLimitCurrencyReason, nil
+		}
+		if err != nil {
+			return "", err
+		}
+		if used+amount.GetAmountMinor() > l.Amount.GetAmountMinor() {
+			return playerLimitDenial(limitType), nil
+		}
+	}
+	return "", nil
+}
+
+func (s *PlayerLimitsService) SetPlayerLimit(ctx context.Context, req *rgsv1.SetPlayerLimitRequest) (*rgsv1.SetPlayerLimitResponse, error) {
+	if req == nil || req.PlayerId == "" {
+		return &rgsv1.SetPlayerLimitResponse{Meta: s.responseMeta(req.GetMeta(), rgsv1.ResultCode_RESULT_CODE_INVALID, "player_id is required")}, nil
+	}
+	actor, reason := authorizePlayerSubject(ctx, req.Meta, req.PlayerId, false)
+	if reason != "" {
+		s.auditDenied(req.Meta, req.PlayerId, "set_player_limit", reason)
+		return &rgsv1.SetPlayerLimitResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	if req.LimitType == rgsv1.PlayerLimitType_PLAYER_LIMIT_TYPE_UNSPECIFIED || playerLimitPeriodDays(req.Period) == 0 {
+		return &rgsv1.SetPlayerLimitResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "limit_type and period are required")}, nil
+	}
+	if !req.Remove && invalidAmount(req.Amount) {
+		return &rgsv1.SetPlayerLimitResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "amount must be > 0 and currency provided")}, nil
+	}
+	note := strings.TrimSpace(req.Reason)
+	if actor.ActorType == rgsv1.ActorType_ACTOR_TYPE_OPERATOR && note == "" {
+		return &rgsv1.SetPlayerLimitResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "reason is required")}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	var current *rgsv1.PlayerLimit
+	if s.db != nil {
+		stored, err := s.getPlayerLimitFromDB(ctx, req.PlayerId, req.LimitType, req.Period)
+		if err != nil {
+			return &rgsv1.SetPlayerLimitResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+		current = applyDuePlayerLimit(stored, now)
+	} else {
+		current = applyDuePlayerLimit(s.limits[playerLimitKey(req.PlayerId, req.LimitType, req.Period)], now)
+	}
+	next := clonePlayerLimit(current)
+	if next == nil {
+		next = &rgsv1.PlayerLimit{PlayerId: req.PlayerId, LimitType: req.LimitType, Period: req.Period}
+	}
+	next.PendingAmount = nil
+	next.PendingRemoval = false
+	next.PendingEffectiveAt = ""
+	switch {
+	case next.Amount == nil && req.Remove:
+		// Nothing in force to remove; any pending change is dropped.
+	case next.Amount == nil,
+		!req.Remove && req.Amount.Currency == next.Amount.Currency && req.Amount.AmountMinor <= next.Amount.AmountMinor:
+		next.Amount = money.New(req.Amount.AmountMinor, req.Amount.Currency)
+	default:
+		if req.Remove {
+			next.PendingRemoval = true
+		} else {
+			next.PendingAmount = money.New(req.Amount.AmountMinor, req.Amount.Currency)
+		}
+		next.PendingEffectiveAt = now.Add(s.coolingOff).Format(time.RFC3339Nano)
+	}
+	next.UpdatedAt = now.Format(time.RFC3339Nano)
+	next.UpdatedBy = actor.ActorId
+	if s.db != nil {
+		if err := s.upsertPlayerLimitInDB(ctx, next); err != nil {
+			return &rgsv1.SetPlayerLimitResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+	} else {
+		s.limits[playerLimitKey(req.PlayerId, req.LimitType, req.Period)] = clonePlayerLimit(next)
+	}
+	beforeJSON := []byte(`{}`)
+	if current != nil {
+		beforeJSON, _ = json.Marshal(current)
+	}
+	afterJSON, _ := json.Marshal(next)
+	if err := s.appendAuditLocked(req.Meta, req.PlayerId, "set_player_limit", beforeJSON, afterJSON, audit.ResultSuccess, note); err != nil {
+		return &rgsv1.SetPlayerLimitResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	return &rgsv1.SetPlayerLimitResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Limit: next}, nil
+}
+
+func (s *PlayerLimitsService) ListPlayerLimits(ctx context.Context, req *rgsv1.ListPlayerLimitsRequest) (*rgsv1.ListPlayerLimitsResponse, error) {
+	if req == nil || req.PlayerId == "" {
+		return &rgsv1.ListPlayerLimitsResponse{Meta: s.responseMeta(req.GetMeta(), rgsv1.ResultCode_RESULT_CODE_INVALID, "player_id is required")}, nil
+	}
+	if _, reason := authorizePlayerSubject(ctx, req.Meta, req.PlayerId, true); reason != "" {
+		s.auditDenied(req.Meta, req.PlayerId, "list_player_limits", reason)
+		return &rgsv1.ListPlayerLimitsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored, err := s.loadPlayerLimitsLocked(ctx, req.PlayerId)
+	if err != nil {
+		return &rgsv1.ListPlayerLimitsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	now := s.now()
+	limits := make([]*rgsv1.PlayerLimit, 0, len(stored))
+	for _, l := range stored {
+		if l = applyDuePlayerLimit(l, now); l.Amount != nil || l.PendingEffectiveAt != "" {
+			limits = append(limits, l)
+		}
+	}
+	return &rgsv1.ListPlayerLimitsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Limits: limits}, nil
+}
+
+const playerLimitColumns = `player_id, limit_type, period, amount_minor, currency_code,
+       pending_amount_minor, pending_currency_code, pending_removal, pending_effective_at, updated_at, updated_by`
+
+func scanPlayerLimit(row interface{ Scan(...any) error }) (*rgsv1.PlayerLimit, error) {
+	var l rgsv1.PlayerLimit
+	var limitType, period, currency, pendingCurrency string
+	var amount, pendingAmount sql.NullInt64
+	var pendingAt sql.NullTime
+	var updated time.Time
+	if err := row.Scan(&l.PlayerId, &limitType, &period, &amount, &currency, &pendingAmount, &pendingCurrency, &l.PendingRemoval, &pendingAt, &updated, &l.UpdatedBy); err != nil {
+		return nil, err
+	}
+	l.LimitType = rgsv1.PlayerLimitType(rgsv1.PlayerLimitType_value[limitType])
+	l.Period = rgsv1.PlayerLimitPeriod(rgsv1.PlayerLimitPeriod_value[period])
+	if amount.Valid {
+		l.Amount = money.New(amount.Int64, currency)
+	}
+	if pendingAmount.Valid {
+		l.PendingAmount = money.New(pendingAmount.Int64, pendingCurrency)
+	}
+	if pendingAt.Valid {
+		l.PendingEffectiveAt = pendingAt.Time.UTC().Format(time.RFC3339Nano)
+	}
+	l.UpdatedAt = updated.UTC().Format(time.RFC3339Nano)
+	return &l, nil
+}
+
+func (s *PlayerLimitsService) getPlayerLimitFromDB(ctx context.Context, playerID string, limitType rgsv1.PlayerLimitType, period rgsv1.PlayerLimitPeriod) (*rgsv1.PlayerLimit, error) {
+	q := `SELECT ` + playerLimitColumns + ` FROM player_limits WHERE player_id = $1 AND limit_type = $2 AND period = $3`
+	l, err := scanPlayerLimit(s.db.QueryRowContext(ctx, q, playerID, limitType.String(), period.String()))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return l, err
+}
+
+func (s *PlayerLimitsService) listPlayerLimitsFromDB(ctx context.Context, playerID string) ([]*rgsv1.PlayerLimit, error) {
+	q := `SELECT ` + playerLimitColumns + ` FROM player_limits WHERE player_id = $1`
+	rows, err := s.db.QueryContext(ctx, q, playerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]*rgsv1.PlayerLimit, 0)
+	for rows.Next() {
+		l, err := scanPlayerLimit(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].LimitType != out[j].LimitType {
+			return out[i].LimitType < out[j].LimitType
+		}
+		return out[i].Period < out[j].Period
+	})
+	return out, nil
+}
+
+func (s *PlayerLimitsService) upsertPlayerLimitInDB(ctx context.Context, l *rgsv1.PlayerLimit) error {
+	const q = `
+INSERT INTO player_limits (
+  player_id, limit_type, period, amount_minor, currency_code,
+  pending_amount_minor, pending_currency_code, pending_removal, pending_effective_at, updated_at, updated_by
+) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,NULLIF($9,'')::timestamptz,$10::timestamptz,$11)
+ON CONFLICT (player_id, limit_type, period) DO UPDATE SET
+  amount_minor = EXCLUDED.amount_minor,
+  currency_code = EXCLUDED.currency_code,
+  pending_amount_minor = EXCLUDED.pending_amount_minor,
+  pending_currency_code = EXCLUDED.pending_currency_code,
+  pending_removal = EXCLUDED.pending_removal,
+  pending_effective_at = EXCLUDED.pending_effective_at,
+  updated_at = EXCLUDED.updated_at,
+  updated_by = EXCLUDED.updated_by
+`
+	var amount, pendingAmount sql.NullInt64
+	if l.Amount != nil {
+		amount = sql.NullInt64{Int64: l.Amount.AmountMinor, Valid: true}
+	}
+	if l.PendingAmount != nil {
+		pendingAmount = sql.NullInt64{Int64: l.PendingAmount.AmountMinor, Valid: true}
+	}
+	_, err := s.db.ExecContext(ctx, q, l.PlayerId, l.LimitType.String(), l.Period.String(), amount, l.Amount.GetCurrency(),
+		pendingAmount, l.PendingAmount.GetCurrency(), l.PendingRemoval, l.PendingEffectiveAt, l.UpdatedAt, l.UpdatedBy)
+	return err
+}
@@ -0,0 +1,206 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func TestPlayerLimitsTightenAtOnceAndLoosenAfterCoolingOff(t *testing.T) {
+	now := time.Date(2026, 3, 12, 18, 0, 0, 0, time.UTC)
+	svc := NewPlayerLimitsService(ledgerFixedClock{now: now})
+	ledger := NewLedgerService(ledgerFixedClock{now: now})
+	ledger.SetPlayerLimitSource(svc)
+	ctx := context.Background()
+	player := meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "")
+
+	set := func(m *rgsv1.RequestMeta, req *rgsv1.SetPlayerLimitRequest) *rgsv1.SetPlayerLimitResponse {
+		t.Helper()
+		req.Meta = m
+		if req.PlayerId == "" {
+			req.PlayerId = "player-1"
+		}
+		req.LimitType = rgsv1.PlayerLimitType_PLAYER_LIMIT_TYPE_DEPOSIT
+		req.Period = rgsv1.PlayerLimitPeriod_PLAYER_LIMIT_PERIOD_DAILY
+		resp, err := svc.SetPlayerLimit(ctx, req)
+		if err != nil {
+			t.Fatalf("set player limit: %v", err)
+		}
+		return resp
+	}
+	usd := func(minor int64) *rgsv1.Money { return &rgsv1.Money{AmountMinor: minor, Currency: "USD"} }
+	deposit := func(accountID, idem string, amount *rgsv1.Money) *rgsv1.ResponseMeta {
+		t.Helper()
+		resp, _ := ledger.Deposit(ctx, &rgsv1.DepositRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, idem), AccountId: accountID, Amount: amount})
+		return resp.Meta
+	}
+
+	for name, tc := range map[string]struct {
+		meta *rgsv1.RequestMeta
+		req  *rgsv1.SetPlayerLimitRequest
+		want rgsv1.ResultCode
+	}{
+		"other player":    {meta("player-2", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""), &rgsv1.SetPlayerLimitRequest{Amount: usd(5000)}, rgsv1.ResultCode_RESULT_CODE_DENIED},
+		"service":         {meta("svc-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, ""), &rgsv1.SetPlayerLimitRequest{Amount: usd(5000)}, rgsv1.ResultCode_RESULT_CODE_DENIED},
+		"no amount":       {player, &rgsv1.SetPlayerLimitRequest{}, rgsv1.ResultCode_RESULT_CODE_INVALID},
+		"operator reason": {meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), &rgsv1.SetPlayerLimitRequest{Amount: usd(5000)}, rgsv1.ResultCode_RESULT_CODE_INVALID},
+	} {
+		if resp := set(tc.meta, tc.req); resp.Meta.ResultCode != tc.want {
+			t.Fatalf("%s: expected %v, got %+v", name, tc.want, resp.Meta)
+		}
+	}
+
+	if resp := set(player, &rgsv1.SetPlayerLimitRequest{Amount: usd(5000)}); resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK || resp.Limit.Amount.AmountMinor != 5000 || resp.Limit.PendingEffectiveAt != "" {
+		t.Fatalf("expected a new limit to apply at once, got %+v", resp)
+	}
+	if m := deposit("player-1", "dep-1", usd(3000)); m.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected deposit within the limit, got %+v", m)
+	}
+	if m := deposit("player-1:USD", "dep-2", usd(2500)); m.ResultCode != rgsv1.ResultCode_RESULT_CODE_DENIED || m.DenialReason != "deposit limit exceeded" {
+		t.Fatalf("expected deposit over the limit to be denied, got %+v", m)
+	}
+	if m := deposit("player-1:EUR", "dep-3", &rgsv1.Money{AmountMinor: 9000, Currency: "EUR"}); m.ResultCode != rgsv1.ResultCode_RESULT_CODE_DENIED || m.DenialReason != "currency does not match player limit" {
+		t.Fatalf("expected deposits in another currency than the limit to be denied, got %+v", m)
+	}
+
+	raised := set(player, &rgsv1.SetPlayerLimitRequest{Amount: usd(10000)})
+	if raised.Limit.Amount.AmountMinor != 5000 || raised.Limit.PendingAmount.GetAmountMinor() != 10000 || raised.Limit.PendingEffectiveAt != now.Add(24*time.Hour).Format(time.RFC3339Nano) {
+		t.Fatalf("expected a raise to wait out the cooling-off, got %+v", raised.Limit)
+	}
+	if m := deposit("player-1", "dep-4", usd(2500)); m.ResultCode != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected the pending raise not to apply yet, got %+v", m)
+	}
+	if lowered := set(player, &rgsv1.SetPlayerLimitRequest{Amount: usd(4000)}); lowered.Limit.Amount.AmountMinor != 4000 || lowered.Limit.PendingAmount != nil {
+		t.Fatalf("expected a lower limit to apply at once and cancel the raise, got %+v", lowered.Limit)
+	}
+	set(player, &rgsv1.SetPlayerLimitRequest{Remove: true})
+
+	svc.Clock = ledgerFixedClock{now: now.Add(24 * time.Hour)}
+	list, _ := svc.ListPlayerLimits(ctx, &rgsv1.ListPlayerLimitsRequest{Meta: meta("svc-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, ""), PlayerId: "player-1"})
+	if list.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK || len(list.Limits) != 0 {
+		t.Fatalf("expected the removal to apply after the cooling-off, got %+v", list)
+	}
+
+	var changes, denied int
+	for _, ev := range auditEvents(svc.AuditStore) {
+		if ev.Action == "set_player_limit" && ev.Result == "success" {
+			changes++
+		}
+	}
+	for _, ev := range auditEvents(ledger.AuditStore) {
+		if ev.Action == "deposit" && ev.Reason == "deposit limit exceeded" {
+			denied++
+		}
+	}
+	if changes != 4 || denied != 2 {
+		t.Fatalf("expected limit changes and denials to be audited, got changes=%d denied=%d", changes, denied)
+	}
+}
+
+func TestPlayerLimitsApplyToVoucherRedemptions(t *testing.T) {
+	now := time.Date(2026, 3, 12, 18, 0, 0, 0, time.UTC)
+	svc := NewPlayerLimitsService(ledgerFixedClock{now: now})
+	ledger := NewLedgerService(ledgerFixedClock{now: now})
+	ledger.SetPlayerLimitSource(svc)
+	ctx := context.Background()
+	set, _ := svc.SetPlayerLimit(ctx, &rgsv1.SetPlayerLimitRequest{
+		Meta:      meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""),
+		PlayerId:  "player-1",
+		LimitType: rgsv1.PlayerLimitType_PLAYER_LIMIT_TYPE_DEPOSIT,
+		Period:    rgsv1.PlayerLimitPeriod_PLAYER_LIMIT_PERIOD_DAILY,
+		Amount:    &rgsv1.Money{AmountMinor: 5000, Currency: "USD"},
+	})
+	if set.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("set deposit limit: %+v", set.Meta)
+	}
+
+	first := issueTestVoucher(t, ledger, "LIMIT-0001", 3000, now.Add(time.Hour))
+	second := issueTestVoucher(t, ledger, "LIMIT-0002", 2500, now.Add(time.Hour))
+	if resp := redeemTestVoucher(ledger, "player-1", "redeem-1", first.Code); resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected redemption within the limit, got %+v", resp.Meta)
+	}
+	if resp := redeemTestVoucher(ledger, "player-1", "redeem-2", second.Code); resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_DENIED || resp.Meta.DenialReason != "deposit limit exceeded" {
+		t.Fatalf("expected redemption over the limit to be denied, got %+v", resp.Meta)
+	}
+	if v, _ := ledger.voucherByCode(ctx, second.Code); v.GetRedeemedAt() != "" {
+		t.Fatalf("expected the denied voucher to stay redeemable, got %+v", v)
+	}
+	dep, _ := ledger.Deposit(ctx, &rgsv1.DepositRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "dep-1"), AccountId: "player-1", Amount: &rgsv1.Money{AmountMinor: 2500, Currency: "USD"}})
+	if dep.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_DENIED || dep.Meta.DenialReason != "deposit limit exceeded" {
+		t.Fatalf("expected redeemed vouchers to count toward the deposit limit, got %+v", dep.Meta)
+	}
+
+	var denied int
+	for _, ev := range auditEvents(ledger.AuditStore) {
+		if ev.Action == "redeem_voucher" && ev.Reason == "deposit limit exceeded" {
+			denied++
+		}
+	}
+	if denied != 1 {
+		t.Fatalf("expected the refused redemption to be audited, got=%d", denied)
+	}
+}
+
+func TestPlayerLimitsDenyWagersOverWagerAndLossLimits(t *testing.T) {
+	clk := ledgerFixedClock{now: time.Date(2026, 3, 12, 18, 0, 0, 0, time.UTC)}
+	limits := NewPlayerLimitsService(clk)
+	wagering := NewWageringService(clk)
+	wagering.Sessions = NewSessionsService(clk)
+	wagering.PlayerLimits = limits
+	ctx := context.Background()
+	player := meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "")
+	for limitType, amount := range map[rgsv1.PlayerLimitType]int64{
+		rgsv1.PlayerLimitType_PLAYER_LIMIT_TYPE_WAGER: 500,
+		rgsv1.PlayerLimitType_PLAYER_LIMIT_TYPE_LOSS:  250,
+	} {
+		resp, _ := limits.SetPlayerLimit(ctx, &rgsv1.SetPlayerLimitRequest{Meta: player, PlayerId: "player-1", LimitType: limitType, Period: rgsv1.PlayerLimitPeriod_PLAYER_LIMIT_PERIOD_WEEKLY, Amount: &rgsv1.Money{AmountMinor: amount, Currency: "USD"}})
+		if resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("set %v limit: %+v", limitType, resp.Meta)
+		}
+	}
+
+	play := func(idem string, stake, payout int64) *rgsv1.ResponseMeta {
+		t.Helper()
+		placed, _ := wagering.PlaceWager(ctx, &rgsv1.PlaceWagerRequest{Meta: meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, idem), PlayerId: "player-1", GameId: "game-1", Stake: &rgsv1.Money{AmountMinor: stake, Currency: "USD"}})
+		if placed.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+			return placed.Meta
+		}
+		settled, _ := wagering.SettleWager(ctx, &rgsv1.SettleWagerRequest{Meta: meta("svc-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, idem+"-settle"), WagerId: placed.Wager.WagerId, Payout: &rgsv1.Money{AmountMinor: payout, Currency: "USD"}, OutcomeRef: "round-" + idem})
+		if settled.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("settle %s: %+v", idem, settled.Meta)
+		}
+		return placed.Meta
+	}
+	if m := play("w-1", 200, 200); m.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected first wager to be accepted, got %+v", m)
+	}
+	if m := play("w-2", 200, 1); m.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected second wager to be accepted, got %+v", m)
+	}
+	if m := play("w-3", 100, 0); m.ResultCode != rgsv1.ResultCode_RESULT_CODE_DENIED || m.DenialReason != "loss limit exceeded" {
+		t.Fatalf("expected a stake that could breach the loss limit to be denied, got %+v", m)
+	}
+	if m := play("w-4", 150, 0); m.ResultCode != rgsv1.ResultCode_RESULT_CODE_DENIED || m.DenialReason != "wager limit exceeded" {
+		t.Fatalf("expected a stake over the wager limit to be denied, got %+v", m)
+	}
+	if m := play("w-5", 50, 1); m.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected a stake within both limits to be accepted, got %+v", m)
+	}
+	eur, _ := wagering.PlaceWager(ctx, &rgsv1.PlaceWagerRequest{Meta: meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "w-6"), PlayerId: "player-1", GameId: "game-1", Stake: &rgsv1.Money{AmountMinor: 10, Currency: "EUR"}})
+	if eur.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_DENIED || eur.Meta.DenialReason != "currency does not match player limit" {
+		t.Fatalf("expected a stake in another currency than the limits to be denied, got %+v", eur.Meta)
+	}
+
+	// Activity recorded in another currency cannot be measured against the
+	// limit, so it fails closed too.
+	if err := wagering.Sessions.RecordWagerActivity(ctx, "player-2", "EUR", time.Time{}, 1, 100, 0); err != nil {
+		t.Fatalf("record activity: %v", err)
+	}
+	limits.SetPlayerLimit(ctx, &rgsv1.SetPlayerLimitRequest{Meta: meta("player-2", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""), PlayerId: "player-2", LimitType: rgsv1.PlayerLimitType_PLAYER_LIMIT_TYPE_WAGER, Period: rgsv1.PlayerLimitPeriod_PLAYER_LIMIT_PERIOD_DAILY, Amount: &rgsv1.Money{AmountMinor: 500, Currency: "USD"}})
+	mixed, _ := wagering.PlaceWager(ctx, &rgsv1.PlaceWagerRequest{Meta: meta("player-2", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "w-7"), PlayerId: "player-2", GameId: "game-1", Stake: &rgsv1.Money{AmountMinor: 10, Currency: "USD"}})
+	if mixed.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_DENIED || mixed.Meta.DenialReason != "currency does not match player limit" {
+		t.Fatalf("expected a stake against activity in another currency to be denied, got %+v", mixed.Meta)
+	}
+}
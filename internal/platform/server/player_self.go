@@ -0,0 +1,308 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"sync"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/clock"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/money"
+)
+
+const (
+	playerSelfDefaultPageSize = 20
+	playerSelfMaxPageSize     = 50
+)
+
+// PlayerSelfService serves a player's own balance, transactions, limits and
+// session history. It only accepts player actors, always scopes reads to the
+// authenticated player, and applies a per-player request budget on top of
+// the instance-wide load shedding.
+type PlayerSelfService struct {
+	rgsv1.UnimplementedPlayerSelfServiceServer
+
+	Clock      clock.Clock
+	AuditStore audit.Store
+	Ledger     *LedgerService
+	Wagering   *WageringService
+	Sessions   *SessionsService
+
+	mu          sync.Mutex
+	nextAuditID int64
+	rateMax     int
+	rateWindow  time.Duration
+	rates       map[string]loginRateWindow
+	db          *sql.DB
+}
+
+func NewPlayerSelfService(clk clock.Clock, db ...*sql.DB) *PlayerSelfService {
+	var handle *sql.DB
+	if len(db) > 0 {
+		handle = db[0]
+	}
+	return &PlayerSelfService{
+		Clock:      clk,
+		AuditStore: NewAuditStore(handle),
+		rateMax:    30,
+		rateWindow: time.Minute,
+		rates:      make(map[string]loginRateWindow),
+		db:         handle,
+	}
+}
+
+// SetRateLimit caps each player at maxRequests calls per window across all
+// PlayerSelfService RPCs. Zero disables the limit. Budgets are kept per
+// instance.
+func (s *PlayerSelfService) SetRateLimit(maxRequests int, window time.Duration) {
+	if s == nil {
+		return
+	}
+	if maxRequests < 0 {
+		maxRequests = 0
+	}
+	if window <= 0 {
+		window = time.Minute
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rateMax = maxRequests
+	s.rateWindow = window
+}
+
+func (s *PlayerSelfService) now() time.Time {
+	if s.Clock == nil {
+		return time.Now().UTC()
+	}
+	return s.Clock.Now().UTC()
+}
+
+func (s *PlayerSelfService) responseMeta(meta *rgsv1.RequestMeta, code rgsv1.ResultCode, denial string) *rgsv1.ResponseMeta {
+	return &rgsv1.ResponseMeta{
+		RequestId:    requestID(meta),
+		ResultCode:   code,
+		DenialReason: denial,
+		ServerTime:   s.now().Format(time.RFC3339Nano),
+	}
+}
+
+func (s *PlayerSelfService) appendAudit(meta *rgsv1.RequestMeta, objectID, action string, result audit.Result, reason string) error {
+	s.mu.Lock()
+	s.nextAuditID++
+	auditID := "player-self-audit-" + strconv.FormatInt(s.nextAuditID, 10)
+	s.mu.Unlock()
+	ev := newAuditEvent(meta, auditID, s.now(), "player_self_service", objectID, action, []byte(`{}`), []byte(`{}`), result, reason)
+	return appendAuditTo(context.Background(), s.AuditStore, ev)
+}
+
+// admit resolves the calling player and charges the call to their budget.
+// It returns the player ID, or the denial reason after auditing it.
+func (s *PlayerSelfService) admit(ctx context.Context, meta *rgsv1.RequestMeta, action string) (string, string) {
+	actor, reason := resolveActor(ctx, meta)
+	if reason == "" && actor.ActorType != rgsv1.ActorType_ACTOR_TYPE_PLAYER {
+		reason = "player actor required"
+	}
+	if reason != "" {
+		_ = s.appendAudit(meta, "", action, audit.ResultDenied, reason)
+		return "", reason
+	}
+	if s.rateLimitExceeded(actor.ActorId) {
+		reason = "rate limit exceeded"
+		_ = s.appendAudit(meta, actor.ActorId, action, audit.ResultDenied, reason)
+		return "", reason
+	}
+	return actor.ActorId, ""
+}
+
+func (s *PlayerSelfService) rateLimitExceeded(playerID string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.rateMax <= 0 {
+		return false
+	}
+	now := s.now()
+	window := s.rates[playerID]
+	if window.start.IsZero() || now.Sub(window.start) >= s.rateWindow {
+		window = loginRateWindow{start: now}
+	}
+	window.count++
+	s.rates[playerID] = window
+	return window.count > s.rateMax
+}
+
+// selfPage parses a page request, capping the size so a player app cannot
+// pull unbounded history in one call.
+func selfPage(pageSize int32, pageToken string) (int, int, bool) {
+	size := int(pageSize)
+	if size <= 0 {
+		size = playerSelfDefaultPageSize
+	}
+	if size > playerSelfMaxPageSize {
+		size = playerSelfMaxPageSize
+	}
+	offset := 0
+	if pageToken != "" {
+		parsed, err := strconv.Atoi(pageToken)
+		if err != nil || parsed < 0 {
+			return 0, 0, false
+		}
+		offset = parsed
+	}
+	return size, offset, true
+}
+
+func (s *PlayerSelfService) balance(ctx context.Context, meta *rgsv1.RequestMeta, playerID string) (*rgsv1.GetBalanceResponse, string) {
+	if s.Ledger == nil {
+		return nil, "ledger unavailable"
+	}
+	resp, err := s.Ledger.GetBalance(ctx, &rgsv1.GetBalanceRequest{Meta: meta, AccountId: playerID})
+	if err != nil || resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		return nil, "persistence unavailable"
+	}
+	return resp, ""
+}
+
+func (s *PlayerSelfService) GetMyBalance(ctx context.Context, req *rgsv1.GetMyBalanceRequest) (*rgsv1.GetMyBalanceResponse, error) {
+	if req == nil {
+		req = &rgsv1.GetMyBalanceRequest{}
+	}
+	playerID, reason := s.admit(ctx, req.Meta, "get_my_balance")
+	if reason != "" {
+		return &rgsv1.GetMyBalanceResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	bal, reason := s.balance(ctx, req.Meta, playerID)
+	if reason != "" {
+		return &rgsv1.GetMyBalanceResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, reason)}, nil
+	}
+	return &rgsv1.GetMyBalanceResponse{
+		Meta:             s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
+		AvailableBalance: bal.AvailableBalance,
+		PendingBalance:   bal.PendingBalance,
+	}, nil
+}
+
+func (s *PlayerSelfService) ListMyTransactions(ctx context.Context, req *rgsv1.ListMyTransactionsRequest) (*rgsv1.ListMyTransactionsResponse, error) {
+	if req == nil {
+		req = &rgsv1.ListMyTransactionsRequest{}
+	}
+	playerID, reason := s.admit(ctx, req.Meta, "list_my_transactions")
+	if reason != "" {
+		return &rgsv1.ListMyTransactionsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	size, offset, ok := selfPage(req.PageSize, req.PageToken)
+	if !ok {
+		return &rgsv1.ListMyTransactionsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "invalid page_token")}, nil
+	}
+	if s.Ledger == nil {
+		return &rgsv1.ListMyTransactionsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "ledger unavailable")}, nil
+	}
+	resp, err := s.Ledger.ListTransactions(ctx, &rgsv1.ListTransactionsRequest{
+		Meta:      req.Meta,
+		AccountId: playerID,
+		PageSize:  int32(size),
+		PageToken: strconv.Itoa(offset),
+	})
+	if err != nil || resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		return &rgsv1.ListMyTransactionsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	items := make([]*rgsv1.PlayerTransaction, 0, len(resp.Transactions))
+	for _, tx := range resp.Transactions {
+		items = append(items, &rgsv1.PlayerTransaction{
+			TransactionId:   tx.TransactionId,
+			TransactionType: tx.TransactionType,
+			Amount:          tx.Amount,
+			OccurredAt:      tx.OccurredAt,
+		})
+	}
+	return &rgsv1.ListMyTransactionsResponse{
+		Meta:          s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
+		Transactions:  items,
+		NextPageToken: resp.NextPageToken,
+	}, nil
+}
+
+func (s *PlayerSelfService) GetMyLimits(ctx context.Context, req *rgsv1.GetMyLimitsRequest) (*rgsv1.GetMyLimitsResponse, error) {
+	if req == nil {
+		req = &rgsv1.GetMyLimitsRequest{}
+	}
+	playerID, reason := s.admit(ctx, req.Meta, "get_my_limits")
+	if reason != "" {
+		return &rgsv1.GetMyLimitsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	bal, reason := s.balance(ctx, req.Meta, playerID)
+	if reason != "" {
+		return &rgsv1.GetMyLimitsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, reason)}, nil
+	}
+	currency := bal.AvailableBalance.GetCurrency()
+	out := &rgsv1.GetMyLimitsResponse{}
+	if s.Wagering != nil {
+		minStake, err := s.Wagering.stakeLimit(ctx, "min_stake", req.GameId, "", currency)
+		if err != nil {
+			return &rgsv1.GetMyLimitsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "stake limits unavailable")}, nil
+		}
+		maxStake, err := s.Wagering.stakeLimit(ctx, "max_stake", req.GameId, "", currency)
+		if err != nil {
+			return &rgsv1.GetMyLimitsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "stake limits unavailable")}, nil
+		}
+		if minStake > 0 {
+			out.MinStake = money.New(minStake, currency)
+		}
+		if maxStake > 0 {
+			out.MaxStake = money.New(maxStake, currency)
+		}
+	}
+	lockout, err := s.Ledger.eftLockoutState(ctx, playerID)
+	if err != nil {
+		return &rgsv1.GetMyLimitsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	out.TransfersLocked = lockout.Locked
+	if lockout.Locked {
+		out.TransfersLockedUntil = lockout.LockedUntil
+	}
+	out.Meta = s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, "")
+	return out, nil
+}
+
+func (s *PlayerSelfService) ListMySessions(ctx context.Context, req *rgsv1.ListMySessionsRequest) (*rgsv1.ListMySessionsResponse, error) {
+	if req == nil {
+		req = &rgsv1.ListMySessionsRequest{}
+	}
+	playerID, reason := s.admit(ctx, req.Meta, "list_my_sessions")
+	if reason != "" {
+		return &rgsv1.ListMySessionsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	size, offset, ok := selfPage(req.PageSize, req.PageToken)
+	if !ok {
+		return &rgsv1.ListMySessionsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "invalid page_token")}, nil
+	}
+	if s.Sessions == nil {
+		return &rgsv1.ListMySessionsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "sessions unavailable")}, nil
+	}
+	records, err := s.Sessions.playerSessionHistory(ctx, playerID, size, offset)
+	if err != nil {
+		return &rgsv1.ListMySessionsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	items := make([]*rgsv1.PlayerSessionHistoryItem, 0, len(records))
+	for _, rec := range records {
+		items = append(items, &rgsv1.PlayerSessionHistoryItem{
+			SessionId: rec.session.SessionId,
+			State:     rec.session.State,
+			StartedAt: rec.session.StartedAt,
+			EndedAt:   rec.session.EndedAt,
+			EndReason: rec.session.EndReason,
+			Totals:    rec.activity.totals(),
+		})
+	}
+	nextToken := ""
+	if len(records) == size {
+		nextToken = strconv.Itoa(offset + size)
+	}
+	return &rgsv1.ListMySessionsResponse{
+		Meta:          s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
+		Sessions:      items,
+		NextPageToken: nextToken,
+	}, nil
+}
@@ -0,0 +1,278 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/clock"
+	"google.golang.org/protobuf/proto"
+)
+
+// maxSelfExclusionDays bounds timed exclusions; longer ones are registered
+// as permanent.
+const maxSelfExclusionDays = 36500
+
+const selfExcludedReason = "player self-excluded"
+
+// SelfExclusionChecker reports whether a player is currently self-excluded.
+// SelfExclusionService implements it for the identity, ledger and wagering
+// services.
+type SelfExclusionChecker interface {
+	PlayerSelfExcluded(ctx context.Context, playerID string) (bool, error)
+}
+
+// SelfExclusionService keeps the player self-exclusion register. Every
+// registration is kept; a player is excluded while any registered period
+// covers the current time, and a new registration may extend but never
+// shorten the exclusion in effect.
+type SelfExclusionService struct {
+	rgsv1.UnimplementedSelfExclusionServiceServer
+
+	Clock      clock.Clock
+	AuditStore audit.Store
+
+	mu              sync.Mutex
+	nextAuditID     int64
+	nextExclusionID int64
+	exclusions      map[string][]*rgsv1.SelfExclusion
+	db              *sql.DB
+}
+
+func NewSelfExclusionService(clk clock.Clock, db ...*sql.DB) *SelfExclusionService {
+	var handle *sql.DB
+	if len(db) > 0 {
+		handle = db[0]
+	}
+	return &SelfExclusionService{
+		Clock:      clk,
+		AuditStore: NewAuditStore(handle),
+		exclusions: make(map[string][]*rgsv1.SelfExclusion),
+		db:         handle,
+	}
+}
+
+func (s *SelfExclusionService) now() time.Time {
+	if s.Clock == nil {
+		return time.Now().UTC()
+	}
+	return s.Clock.Now().UTC()
+}
+
+func (s *SelfExclusionService) responseMeta(meta *rgsv1.RequestMeta, code rgsv1.ResultCode, denial string) *rgsv1.ResponseMeta {
+	return &rgsv1.ResponseMeta{
+		RequestId:    requestID(meta),
+		ResultCode:   code,
+		DenialReason: denial,
+		ServerTime:   s.now().Format(time.RFC3339Nano),
+	}
+}
+
+// appendAuditLocked records a self-exclusion audit event. s.mu must be held.
+func (s *SelfExclusionService) appendAuditLocked(meta *rgsv1.RequestMeta, playerID, action string, before, after []byte, result audit.Result, reason string) error {
+	s.nextAuditID++
+	auditID := "self-exclusion-audit-" + strconv.FormatInt(s.nextAuditID, 10)
+	ev := newAuditEvent(meta, auditID, s.now(), "player_self_exclusion", playerID, action, before, after, result, reason)
+	return appendAuditTo(context.Background(), s.AuditStore, ev)
+}
+
+func (s *SelfExclusionService) auditDenied(meta *rgsv1.RequestMeta, playerID, action, reason string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_ = s.appendAuditLocked(meta, playerID, action, []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+}
+
+// authorizePlayerSubject lets players act for themselves and operators act
+// for any player. allowService also admits service actors, for read-only
+// calls.
+func authorizePlayerSubject(ctx context.Context, meta *rgsv1.RequestMeta, playerID string, allowService bool) (*rgsv1.Actor, string) {
+	actor, reason := resolveActor(ctx, meta)
+	if reason != "" {
+		return nil, reason
+	}
+	switch actor.ActorType {
+	case rgsv1.ActorType_ACTOR_TYPE_OPERATOR:
+		return actor, ""
+	case rgsv1.ActorType_ACTOR_TYPE_SERVICE:
+		if allowService {
+			return actor, ""
+		}
+	case rgsv1.ActorType_ACTOR_TYPE_PLAYER:
+		if actor.ActorId != playerID {
+			return nil, "player cannot access another player"
+		}
+		return actor, ""
+	}
+	return nil, "unauthorized actor type"
+}
+
+func cloneSelfExclusion(in *rgsv1.SelfExclusion) *rgsv1.SelfExclusion {
+	if in == nil {
+		return nil
+	}
+	cp, _ := proto.Clone(in).(*rgsv1.SelfExclusion)
+	return cp
+}
+
+// selfExclusionCovers reports whether e is in effect at now.
+func selfExclusionCovers(e *rgsv1.SelfExclusion, now time.Time) bool {
+	if parseTS(e.StartsAt).After(now) {
+		return false
+	}
+	return e.EndsAt == "" || parseTS(e.EndsAt).After(now)
+}
+
+// selfExclusionOutlasts reports whether a ends no earlier than b.
+func selfExclusionOutlasts(a, b *rgsv1.SelfExclusion) bool {
+	if a.EndsAt == "" {
+		return true
+	}
+	return b.EndsAt != "" && !parseTS(a.EndsAt).Before(parseTS(b.EndsAt))
+}
+
+// activeSelfExclusionLocked returns the exclusion in effect for playerID
+// that ends last, or nil when there is none. s.mu must be held.
+func (s *SelfExclusionService) activeSelfExclusionLocked(ctx context.Context, playerID string) (*rgsv1.SelfExclusion, error) {
+	now := s.now()
+	if s.db != nil {
+		return s.activeSelfExclusionFromDB(ctx, playerID, now)
+	}
+	var active *rgsv1.SelfExclusion
+	for _, e := range s.exclusions[playerID] {
+		if selfExclusionCovers(e, now) && (active == nil || selfExclusionOutlasts(e, active)) {
+			active = e
+		}
+	}
+	return cloneSelfExclusion(active), nil
+}
+
+// PlayerSelfExcluded implements SelfExclusionChecker. A nil service
+// excludes nobody.
+func (s *SelfExclusionService) PlayerSelfExcluded(ctx context.Context, playerID string) (bool, error) {
+	if s == nil || playerID == "" {
+		return false, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	active, err := s.activeSelfExclusionLocked(ctx, playerID)
+	return active != nil, err
+}
+
+func (s *SelfExclusionService) RegisterSelfExclusion(ctx context.Context, req *rgsv1.RegisterSelfExclusionRequest) (*rgsv1.RegisterSelfExclusionResponse, error) {
+	if req == nil || req.PlayerId == "" {
+		return &rgsv1.RegisterSelfExclusionResponse{Meta: s.responseMeta(req.GetMeta(), rgsv1.ResultCode_RESULT_CODE_INVALID, "player_id is required")}, nil
+	}
+	actor, reason := authorizePlayerSubject(ctx, req.Meta, req.PlayerId, false)
+	if reason != "" {
+		s.auditDenied(req.Meta, req.PlayerId, "register_self_exclusion", reason)
+		return &rgsv1.RegisterSelfExclusionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	if !req.Permanent && (req.DurationDays <= 0 || req.DurationDays > maxSelfExclusionDays) {
+		return &rgsv1.RegisterSelfExclusionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "duration_days must be between 1 and 36500 unless permanent")}, nil
+	}
+	note := strings.TrimSpace(req.Reason)
+	if actor.ActorType == rgsv1.ActorType_ACTOR_TYPE_OPERATOR && note == "" {
+		return &rgsv1.RegisterSelfExclusionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "reason is required")}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	exclusion := &rgsv1.SelfExclusion{
+		PlayerId:         req.PlayerId,
+		StartsAt:         now.Format(time.RFC3339Nano),
+		Reason:           note,
+		RegisteredBy:     actor.ActorId,
+		RegisteredByType: actor.ActorType,
+	}
+	if !req.Permanent {
+		exclusion.EndsAt = now.AddDate(0, 0, int(req.DurationDays)).Format(time.RFC3339Nano)
+	}
+	active, err := s.activeSelfExclusionLocked(ctx, req.PlayerId)
+	if err != nil {
+		return &rgsv1.RegisterSelfExclusionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if active != nil && !selfExclusionOutlasts(exclusion, active) {
+		_ = s.appendAuditLocked(req.Meta, req.PlayerId, "register_self_exclusion", []byte(`{}`), []byte(`{}`), audit.ResultDenied, "active self-exclusion cannot be shortened")
+		return &rgsv1.RegisterSelfExclusionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "active self-exclusion cannot be shortened")}, nil
+	}
+	s.nextExclusionID++
+	exclusion.ExclusionId = "self-exclusion-" + strconv.FormatInt(now.UnixNano(), 10) + "-" + strconv.FormatInt(s.nextExclusionID, 10)
+	if s.db != nil {
+		if err := s.insertSelfExclusionInDB(ctx, exclusion); err != nil {
+			return &rgsv1.RegisterSelfExclusionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+	} else {
+		s.exclusions[req.PlayerId] = append(s.exclusions[req.PlayerId], cloneSelfExclusion(exclusion))
+	}
+	beforeJSON := []byte(`{}`)
+	if active != nil {
+		beforeJSON, _ = json.Marshal(active)
+	}
+	afterJSON, _ := json.Marshal(exclusion)
+	if err := s.appendAuditLocked(req.Meta, req.PlayerId, "register_self_exclusion", beforeJSON, afterJSON, audit.ResultSuccess, note); err != nil {
+		return &rgsv1.RegisterSelfExclusionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	return &rgsv1.RegisterSelfExclusionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Exclusion: exclusion}, nil
+}
+
+func (s *SelfExclusionService) GetSelfExclusion(ctx context.Context, req *rgsv1.GetSelfExclusionRequest) (*rgsv1.GetSelfExclusionResponse, error) {
+	if req == nil || req.PlayerId == "" {
+		return &rgsv1.GetSelfExclusionResponse{Meta: s.responseMeta(req.GetMeta(), rgsv1.ResultCode_RESULT_CODE_INVALID, "player_id is required")}, nil
+	}
+	if _, reason := authorizePlayerSubject(ctx, req.Meta, req.PlayerId, true); reason != "" {
+		s.auditDenied(req.Meta, req.PlayerId, "get_self_exclusion", reason)
+		return &rgsv1.GetSelfExclusionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	active, err := s.activeSelfExclusionLocked(ctx, req.PlayerId)
+	if err != nil {
+		return &rgsv1.GetSelfExclusionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	return &rgsv1.GetSelfExclusionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Exclusion: active}, nil
+}
+
+func (s *SelfExclusionService) insertSelfExclusionInDB(ctx context.Context, e *rgsv1.SelfExclusion) error {
+	const q = `
+INSERT INTO player_self_exclusions (
+  exclusion_id, player_id, starts_at, ends_at, reason, registered_by, registered_by_type
+) VALUES ($1,$2,$3::timestamptz,NULLIF($4,'')::timestamptz,$5,$6,$7)
+`
+	_, err := s.db.ExecContext(ctx, q, e.ExclusionId, e.PlayerId, e.StartsAt, e.EndsAt, e.Reason, e.RegisteredBy, e.RegisteredByType.String())
+	return err
+}
+
+func (s *SelfExclusionService) activeSelfExclusionFromDB(ctx context.Context, playerID string, now time.Time) (*rgsv1.SelfExclusion, error) {
+	const q = `
+SELECT exclusion_id, player_id, starts_at, ends_at, reason, registered_by, registered_by_type
+FROM player_self_exclusions
+WHERE player_id = $1 AND starts_at <= $2 AND (ends_at IS NULL OR ends_at > $2)
+ORDER BY ends_at DESC NULLS FIRST, starts_at DESC
+LIMIT 1
+`
+	var e rgsv1.SelfExclusion
+	var starts time.Time
+	var ends sql.NullTime
+	var actorType string
+	err := s.db.QueryRowContext(ctx, q, playerID, now).Scan(&e.ExclusionId, &e.PlayerId, &starts, &ends, &e.Reason, &e.RegisteredBy, &actorType)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	e.StartsAt = starts.UTC().Format(time.RFC3339Nano)
+	if ends.Valid {
+		e.EndsAt = ends.Time.UTC().Format(time.RFC3339Nano)
+	}
+	e.RegisteredByType = rgsv1.ActorType(rgsv1.ActorType_value[actorType])
+	return &e, nil
+}
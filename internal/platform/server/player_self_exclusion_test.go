@@ -0,0 +1,133 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+func TestSelfExclusionBlocksLoginWagersAndDeposits(t *testing.T) {
+	now := time.Date(2026, 3, 12, 18, 0, 0, 0, time.UTC)
+	clk := ledgerFixedClock{now: now}
+	svc := NewSelfExclusionService(clk)
+	identity := NewIdentityService(clk, "test-secret", 15*time.Minute, time.Hour)
+	identity.SetSelfExclusionChecker(svc)
+	ledger := NewLedgerService(clk)
+	ledger.SetSelfExclusionChecker(svc)
+	wagering := NewWageringService(clk)
+	wagering.SelfExclusion = svc
+	ctx := context.Background()
+	player := meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "")
+	op := meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+
+	register := func(m *rgsv1.RequestMeta, req *rgsv1.RegisterSelfExclusionRequest) *rgsv1.RegisterSelfExclusionResponse {
+		t.Helper()
+		req.Meta = m
+		resp, err := svc.RegisterSelfExclusion(ctx, req)
+		if err != nil {
+			t.Fatalf("register self-exclusion: %v", err)
+		}
+		return resp
+	}
+	deposit := func(accountID, idem string) *rgsv1.ResponseMeta {
+		t.Helper()
+		resp, _ := ledger.Deposit(ctx, &rgsv1.DepositRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, idem), AccountId: accountID, Amount: &rgsv1.Money{AmountMinor: 1000, Currency: "USD"}})
+		return resp.Meta
+	}
+	wager := func(playerID, idem string) *rgsv1.ResponseMeta {
+		t.Helper()
+		resp, _ := wagering.PlaceWager(ctx, &rgsv1.PlaceWagerRequest{Meta: meta(playerID, rgsv1.ActorType_ACTOR_TYPE_PLAYER, idem), PlayerId: playerID, GameId: "game-1", Stake: &rgsv1.Money{AmountMinor: 100, Currency: "USD"}})
+		return resp.Meta
+	}
+
+	for name, tc := range map[string]struct {
+		meta *rgsv1.RequestMeta
+		req  *rgsv1.RegisterSelfExclusionRequest
+		want rgsv1.ResultCode
+	}{
+		"other player":    {meta("player-2", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""), &rgsv1.RegisterSelfExclusionRequest{PlayerId: "player-1", DurationDays: 30}, rgsv1.ResultCode_RESULT_CODE_DENIED},
+		"service":         {meta("svc-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, ""), &rgsv1.RegisterSelfExclusionRequest{PlayerId: "player-1", DurationDays: 30}, rgsv1.ResultCode_RESULT_CODE_DENIED},
+		"no duration":     {player, &rgsv1.RegisterSelfExclusionRequest{PlayerId: "player-1"}, rgsv1.ResultCode_RESULT_CODE_INVALID},
+		"operator reason": {op, &rgsv1.RegisterSelfExclusionRequest{PlayerId: "player-1", DurationDays: 30}, rgsv1.ResultCode_RESULT_CODE_INVALID},
+	} {
+		if resp := register(tc.meta, tc.req); resp.Meta.ResultCode != tc.want {
+			t.Fatalf("%s: expected %v, got %+v", name, tc.want, resp.Meta)
+		}
+	}
+	if m := deposit("player-1", "dep-1"); m.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected deposit before exclusion, got %+v", m)
+	}
+
+	excluded := register(player, &rgsv1.RegisterSelfExclusionRequest{PlayerId: "player-1", DurationDays: 30})
+	if excluded.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK || excluded.Exclusion.EndsAt != now.AddDate(0, 0, 30).Format(time.RFC3339Nano) || excluded.Exclusion.RegisteredBy != "player-1" {
+		t.Fatalf("unexpected registration: %+v", excluded)
+	}
+	if m := playerLoginResult(t, identity, "player-1"); m.ResultCode != rgsv1.ResultCode_RESULT_CODE_DENIED || m.DenialReason != "player self-excluded" {
+		t.Fatalf("expected login to be denied, got %+v", m)
+	}
+	if m := wager("player-1", "w-1"); m.ResultCode != rgsv1.ResultCode_RESULT_CODE_DENIED || m.DenialReason != "player self-excluded" {
+		t.Fatalf("expected wager to be denied, got %+v", m)
+	}
+	for _, account := range []string{"player-1", "player-1:EUR"} {
+		if m := deposit(account, "dep-2-"+account); m.ResultCode != rgsv1.ResultCode_RESULT_CODE_DENIED || m.DenialReason != "player self-excluded" {
+			t.Fatalf("expected deposit to %s to be denied, got %+v", account, m)
+		}
+	}
+	v := issueTestVoucher(t, ledger, "EXCL-0001", 500, now.Add(time.Hour))
+	if resp := redeemTestVoucher(ledger, "player-1", "redeem-1", v.Code); resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_DENIED || resp.Meta.DenialReason != "player self-excluded" {
+		t.Fatalf("expected voucher redemption to be denied, got %+v", resp.Meta)
+	}
+	if bal, _, _, _ := ledger.accountBalance("player-1"); bal != 1000 {
+		t.Fatalf("expected no voucher credit while excluded, got=%d", bal)
+	}
+	if m := wager("player-2", "w-2"); m.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected other players to be unaffected, got %+v", m)
+	}
+
+	if resp := register(op, &rgsv1.RegisterSelfExclusionRequest{PlayerId: "player-1", DurationDays: 7, Reason: "player call"}); resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected a shorter exclusion to be refused, got %+v", resp.Meta)
+	}
+	extended := register(op, &rgsv1.RegisterSelfExclusionRequest{PlayerId: "player-1", Permanent: true, Reason: "player asked by phone"})
+	if extended.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK || extended.Exclusion.EndsAt != "" {
+		t.Fatalf("expected a permanent extension, got %+v", extended)
+	}
+	got, _ := svc.GetSelfExclusion(ctx, &rgsv1.GetSelfExclusionRequest{Meta: player, PlayerId: "player-1"})
+	if got.Exclusion.GetExclusionId() != extended.Exclusion.ExclusionId {
+		t.Fatalf("expected the permanent exclusion to be in effect, got %+v", got.Exclusion)
+	}
+
+	timed := register(meta("player-3", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""), &rgsv1.RegisterSelfExclusionRequest{PlayerId: "player-3", DurationDays: 1})
+	if timed.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("register player-3: %+v", timed.Meta)
+	}
+	svc.Clock = ledgerFixedClock{now: now.Add(25 * time.Hour)}
+	if lapsed, _ := svc.PlayerSelfExcluded(ctx, "player-3"); lapsed {
+		t.Fatalf("expected the exclusion to lapse after its duration")
+	}
+
+	actions := map[string]int{}
+	for _, ev := range auditEvents(svc.AuditStore) {
+		actions[ev.Action+"/"+string(ev.Result)]++
+	}
+	if actions["register_self_exclusion/success"] != 3 || actions["register_self_exclusion/denied"] != 3 {
+		t.Fatalf("unexpected self-exclusion audit: %v", actions)
+	}
+	for action, events := range map[string][]audit.Event{
+		"identity_login": auditEvents(identity.AuditStore),
+		"place_wager":    auditEvents(wagering.AuditStore),
+		"deposit":        auditEvents(ledger.AuditStore),
+	} {
+		var denied int
+		for _, ev := range events {
+			if ev.Action == action && ev.Reason == "player self-excluded" {
+				denied++
+			}
+		}
+		if denied == 0 {
+			t.Fatalf("expected %s denials to be audited", action)
+		}
+	}
+}
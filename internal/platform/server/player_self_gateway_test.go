@@ -0,0 +1,55 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	platformauth "github.com/wizardbeardstudio/open-rgs-go/internal/platform/auth"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestPlayerSelfGatewayUsesTokenActor(t *testing.T) {
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 19, 10, 0, 0, 0, time.UTC)}
+	svc := newTestPlayerSelfService(t, clk)
+	gwMux := runtime.NewServeMux()
+	if err := rgsv1.RegisterPlayerSelfServiceHandlerServer(context.Background(), gwMux, svc); err != nil {
+		t.Fatalf("register player gateway handlers: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/me/transactions", nil)
+	req = req.WithContext(platformauth.WithActor(req.Context(), platformauth.Actor{ID: "player-1", Type: "ACTOR_TYPE_PLAYER"}))
+	rec := httptest.NewRecorder()
+	gwMux.ServeHTTP(rec, req)
+	if rec.Result().StatusCode != http.StatusOK {
+		t.Fatalf("transactions status: got=%d body=%s", rec.Result().StatusCode, rec.Body.String())
+	}
+	var resp rgsv1.ListMyTransactionsResponse
+	if err := protojson.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("unmarshal transactions response: %v", err)
+	}
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || len(resp.Transactions) != 2 {
+		t.Fatalf("expected the token player's transactions, got=%+v", &resp)
+	}
+	if body := rec.Body.String(); strings.Contains(body, "accountId") || strings.Contains(body, "authorizationId") {
+		t.Fatalf("expected redacted transactions, got=%s", body)
+	}
+
+	// The request meta cannot widen the scope beyond the token's player.
+	req = httptest.NewRequest(http.MethodGet, "/v1/me/balance?meta.actor.actorId=player-2&meta.actor.actorType=ACTOR_TYPE_PLAYER", nil)
+	req = req.WithContext(platformauth.WithActor(req.Context(), platformauth.Actor{ID: "player-1", Type: "ACTOR_TYPE_PLAYER"}))
+	rec = httptest.NewRecorder()
+	gwMux.ServeHTTP(rec, req)
+	var bal rgsv1.GetMyBalanceResponse
+	if err := protojson.Unmarshal(rec.Body.Bytes(), &bal); err != nil {
+		t.Fatalf("unmarshal balance response: %v", err)
+	}
+	if bal.Meta.GetDenialReason() != "actor mismatch with token" {
+		t.Fatalf("expected actor mismatch, got=%+v", bal.Meta)
+	}
+}
@@ -0,0 +1,121 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+func newTestPlayerSelfService(t *testing.T, clk ledgerFixedClock) *PlayerSelfService {
+	t.Helper()
+	ledger := NewLedgerService(clk)
+	for i, amount := range []int64{1000, 250} {
+		resp, _ := ledger.Deposit(context.Background(), &rgsv1.DepositRequest{
+			Meta:      meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "self-deposit-"+string(rune('a'+i))),
+			AccountId: "player-1",
+			Amount:    &rgsv1.Money{AmountMinor: amount, Currency: "USD"},
+		})
+		if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("deposit failed: %+v", resp.Meta)
+		}
+	}
+	svc := NewPlayerSelfService(clk)
+	svc.Ledger = ledger
+	svc.Wagering = NewWageringService(clk)
+	svc.Sessions = NewSessionsService(clk)
+	return svc
+}
+
+func TestPlayerSelfServiceScopesAndRedacts(t *testing.T) {
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 19, 10, 0, 0, 0, time.UTC)}
+	svc := newTestPlayerSelfService(t, clk)
+	cfg := NewConfigService(clk)
+	applyConfigValue(t, cfg, WageringConfigNamespace, "max_stake/USD", "50.00")
+	applyConfigValue(t, cfg, WageringConfigNamespace, "game/slots-1/max_stake/USD", "20.00")
+	svc.Wagering.Settings = cfg
+	ctx := context.Background()
+	player := meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "")
+
+	bal, _ := svc.GetMyBalance(ctx, &rgsv1.GetMyBalanceRequest{Meta: player})
+	if bal.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || bal.AvailableBalance.GetAmountMinor() != 1250 {
+		t.Fatalf("unexpected balance: %+v", bal)
+	}
+
+	txs, _ := svc.ListMyTransactions(ctx, &rgsv1.ListMyTransactionsRequest{Meta: player, PageSize: 1})
+	if txs.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || len(txs.Transactions) != 1 || txs.NextPageToken == "" {
+		t.Fatalf("expected one transaction and a next page, got=%+v", txs)
+	}
+	if txs.Transactions[0].TransactionType != rgsv1.LedgerTransactionType_LEDGER_TRANSACTION_TYPE_DEPOSIT || txs.Transactions[0].Amount.GetAmountMinor() != 1000 {
+		t.Fatalf("unexpected transaction: %+v", txs.Transactions[0])
+	}
+	next, _ := svc.ListMyTransactions(ctx, &rgsv1.ListMyTransactionsRequest{Meta: player, PageToken: txs.NextPageToken})
+	if len(next.Transactions) != 1 || next.Transactions[0].Amount.GetAmountMinor() != 250 || next.NextPageToken != "" {
+		t.Fatalf("unexpected second page: %+v", next)
+	}
+
+	limits, _ := svc.GetMyLimits(ctx, &rgsv1.GetMyLimitsRequest{Meta: player})
+	if limits.MaxStake.GetAmountMinor() != 5000 || limits.MinStake != nil || limits.TransfersLocked {
+		t.Fatalf("unexpected default limits: %+v", limits)
+	}
+	limits, _ = svc.GetMyLimits(ctx, &rgsv1.GetMyLimitsRequest{Meta: player, GameId: "slots-1"})
+	if limits.MaxStake.GetAmountMinor() != 2000 {
+		t.Fatalf("expected game limit, got=%+v", limits.MaxStake)
+	}
+
+	started, _ := svc.Sessions.StartSession(ctx, &rgsv1.StartSessionRequest{Meta: player, PlayerId: "player-1", DeviceId: "device-secret"})
+	_, _ = svc.Sessions.StartSession(ctx, &rgsv1.StartSessionRequest{Meta: meta("player-2", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""), PlayerId: "player-2", DeviceId: "device-b"})
+	sessions, _ := svc.ListMySessions(ctx, &rgsv1.ListMySessionsRequest{Meta: player})
+	if sessions.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || len(sessions.Sessions) != 1 {
+		t.Fatalf("expected only the player's session, got=%+v", sessions)
+	}
+	if got := sessions.Sessions[0]; got.SessionId != started.Session.GetSessionId() || got.State != rgsv1.SessionState_SESSION_STATE_ACTIVE || got.Totals == nil {
+		t.Fatalf("unexpected session history item: %+v", got)
+	}
+
+	operator, _ := svc.GetMyBalance(ctx, &rgsv1.GetMyBalanceRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")})
+	if operator.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED || operator.Meta.GetDenialReason() != "player actor required" {
+		t.Fatalf("expected operator denied, got=%+v", operator.Meta)
+	}
+	badToken, _ := svc.ListMySessions(ctx, &rgsv1.ListMySessionsRequest{Meta: player, PageToken: "next"})
+	if badToken.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected invalid page token, got=%v", badToken.Meta.GetResultCode())
+	}
+}
+
+func TestPlayerSelfServiceRateLimitsPerPlayer(t *testing.T) {
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 19, 10, 0, 0, 0, time.UTC)}
+	svc := NewPlayerSelfService(clk)
+	svc.Ledger = NewLedgerService(clk)
+	svc.SetRateLimit(2, time.Minute)
+	ctx := context.Background()
+	call := func(playerID string) *rgsv1.ResponseMeta {
+		resp, _ := svc.GetMyBalance(ctx, &rgsv1.GetMyBalanceRequest{Meta: meta(playerID, rgsv1.ActorType_ACTOR_TYPE_PLAYER, "")})
+		return resp.Meta
+	}
+
+	for i := 0; i < 2; i++ {
+		if m := call("player-1"); m.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("call %d: expected ok, got=%+v", i, m)
+		}
+	}
+	if m := call("player-1"); m.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED || m.GetDenialReason() != "rate limit exceeded" {
+		t.Fatalf("expected rate limit, got=%+v", m)
+	}
+	if m := call("player-2"); m.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected other player unaffected, got=%+v", m)
+	}
+
+	events := auditEvents(svc.AuditStore)
+	last := events[len(events)-1]
+	if last.ObjectID != "player-1" || last.Result != audit.ResultDenied || last.Reason != "rate limit exceeded" {
+		t.Fatalf("expected rate limit denial audited, got=%+v", last)
+	}
+
+	svc.Clock = ledgerFixedClock{now: clk.now.Add(time.Minute)}
+	if m := call("player-1"); m.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected budget reset after window, got=%+v", m)
+	}
+}
@@ -2,18 +2,25 @@ package server
 
 import (
 	"context"
+	"crypto/ed25519"
 	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	_ "github.com/jackc/pgx/v5/stdlib"
 	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
 	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	platformauth "github.com/wizardbeardstudio/open-rgs-go/internal/platform/auth"
 )
 
 func openPostgresIntegrationDB(t *testing.T) *sql.DB {
@@ -39,9 +46,38 @@ func resetPostgresIntegrationState(t *testing.T, db *sql.DB) {
 	t.Helper()
 	const q = `
 TRUNCATE TABLE
+  player_limits,
+  player_self_exclusions,
+  event_alert_rules,
+  software_manifests,
+  equipment_software_verifications,
+  equipment_groups,
+  event_maintenance_windows,
+  ram_clear_incidents,
+  identity_credential_history,
+  registry_client_certificates,
+  ledger_vouchers,
+  identity_key_rotations,
+  identity_login_history,
+  identity_ip_allowlists,
+  identity_scim_users,
+  audit_partition_exports,
+  audit_chain_anchors,
+  audit_partition_seals,
+  audit_partition_archives,
+  identity_webauthn_challenges,
+  rbac_role_assignments,
+  player_identities,
+  jackpot_contributions,
+  jackpot_pools,
+  reconciliation_exceptions,
+  bank_statement_entries,
+  bank_statements,
+  outbox_events,
   wagering_idempotency_keys,
   wagers,
   cashless_unresolved_transfers,
+  ledger_currency_exchanges,
   ledger_postings,
   ledger_transactions,
   ledger_accounts,
@@ -49,18 +85,30 @@ TRUNCATE TABLE
   ledger_idempotency_keys,
   ingestion_buffer_audit,
   ingestion_buffers,
+  device_clock_skew,
   meter_records,
   significant_events,
   equipment_registry,
+  report_daily_packs,
+  report_run_deliveries,
   report_runs,
   config_current_values,
+  config_schemas,
+  config_change_approvals,
   config_changes,
   download_library_changes,
   identity_sessions,
   identity_login_rate_limits,
   identity_lockouts,
   identity_credentials,
+  player_activity_daily,
   player_sessions,
+  system_incident_updates,
+  system_incidents,
+  scheduler_job_runs,
+  scheduler_one_shot_jobs,
+  scheduler_jobs,
+  scheduler_leases,
   remote_access_activity,
   system_window_events,
   promotional_awards,
@@ -71,6 +119,10 @@ RESTART IDENTITY CASCADE
 	if _, err := db.Exec(q); err != nil {
 		t.Fatalf("truncate integration tables: %v", err)
 	}
+	// Built-in roles are seeded by migration and must survive the reset.
+	if _, err := db.Exec(`DELETE FROM rbac_roles WHERE created_by <> 'system'`); err != nil {
+		t.Fatalf("reset rbac roles: %v", err)
+	}
 }
 
 func TestPostgresLedgerIdempotencyReplayAcrossRestart(t *testing.T) {
@@ -271,6 +323,76 @@ func TestPostgresSessionsPersistAcrossRestart(t *testing.T) {
 	}
 }
 
+func TestPostgresPlayerSelfSessionHistory(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	ctx := context.Background()
+	sessions := NewSessionsService(ledgerFixedClock{now: time.Date(2026, 2, 19, 9, 0, 0, 0, time.UTC)}, db)
+	for i, player := range []string{"player-self-pg", "player-self-pg", "player-other-pg"} {
+		sessions.Clock = ledgerFixedClock{now: time.Date(2026, 2, 19, 9, i, 0, 0, time.UTC)}
+		resp, _ := sessions.StartSession(ctx, &rgsv1.StartSessionRequest{
+			Meta:     meta(player, rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""),
+			PlayerId: player,
+			DeviceId: "device-self-pg",
+		})
+		if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("start session code=%v reason=%q", resp.Meta.GetResultCode(), resp.Meta.GetDenialReason())
+		}
+	}
+
+	svc := NewPlayerSelfService(ledgerFixedClock{now: time.Date(2026, 2, 19, 10, 0, 0, 0, time.UTC)}, db)
+	svc.Sessions = NewSessionsService(ledgerFixedClock{now: time.Date(2026, 2, 19, 10, 0, 0, 0, time.UTC)}, db)
+	first, _ := svc.ListMySessions(ctx, &rgsv1.ListMySessionsRequest{Meta: meta("player-self-pg", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""), PageSize: 1})
+	if first.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || len(first.Sessions) != 1 || first.NextPageToken == "" {
+		t.Fatalf("expected one session and a next page, got=%+v", first)
+	}
+	if first.Sessions[0].StartedAt != "2026-02-19T09:01:00Z" || first.Sessions[0].Totals.GetTimePlayedSeconds() != 59*60 {
+		t.Fatalf("expected newest session first, got=%+v", first.Sessions[0])
+	}
+	second, _ := svc.ListMySessions(ctx, &rgsv1.ListMySessionsRequest{Meta: meta("player-self-pg", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""), PageSize: 1, PageToken: first.NextPageToken})
+	if len(second.Sessions) != 1 || second.Sessions[0].StartedAt != "2026-02-19T09:00:00Z" {
+		t.Fatalf("unexpected second page: %+v", second.Sessions)
+	}
+}
+
+func TestPostgresIdentityDuplicatePlayerDetection(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 20, 9, 0, 0, 0, time.UTC)}
+	ctx := context.Background()
+	svcA := NewIdentityService(clk, "test-secret", 15*time.Minute, time.Hour, db)
+	registerTestPlayerIdentity(t, svcA, "player-kyc-pg-1", &rgsv1.PlayerIdentityDetails{FullName: "Jordan Lee", DateOfBirth: "1992-04-09", DocumentType: "passport", DocumentNumber: "P-555"})
+
+	// A second instance sees identities registered by the first.
+	svcB := NewIdentityService(clk, "test-secret", 15*time.Minute, time.Hour, db)
+	dup := registerTestPlayerIdentity(t, svcB, "player-kyc-pg-2", &rgsv1.PlayerIdentityDetails{FullName: "lee jordan", DateOfBirth: "1992-09-04"})
+	if dup.Status != rgsv1.PlayerIdentityStatus_PLAYER_IDENTITY_STATUS_PENDING_REVIEW || len(dup.Matches) != 1 || dup.Matches[0].Reasons[0] != identityMatchNameDOBTransposed {
+		t.Fatalf("expected transposed match pending review, got=%+v", dup)
+	}
+	if blocked, err := svcA.playerIdentityLoginBlock(ctx, "player-kyc-pg-2"); err != nil || blocked != "identity review pending" {
+		t.Fatalf("expected login block, got=%q err=%v", blocked, err)
+	}
+
+	list, _ := svcA.ListPlayerIdentityReviews(ctx, &rgsv1.ListPlayerIdentityReviewsRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")})
+	if len(list.Records) != 1 || list.Records[0].PlayerId != "player-kyc-pg-2" || list.Records[0].Matches[0].PlayerId != "player-kyc-pg-1" {
+		t.Fatalf("unexpected pending reviews: %+v", list.Records)
+	}
+	resolved, _ := svcA.ResolvePlayerIdentityReview(ctx, &rgsv1.ResolvePlayerIdentityReviewRequest{
+		Meta:     meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		PlayerId: "player-kyc-pg-2",
+		Note:     "duplicate of player-kyc-pg-1",
+	})
+	if resolved.Record.GetStatus() != rgsv1.PlayerIdentityStatus_PLAYER_IDENTITY_STATUS_REJECTED {
+		t.Fatalf("unexpected resolution: %+v", resolved)
+	}
+	rec, err := svcB.getPlayerIdentityFromDB(ctx, "player-kyc-pg-2")
+	if err != nil || rec.Status != rgsv1.PlayerIdentityStatus_PLAYER_IDENTITY_STATUS_REJECTED || rec.ReviewedBy != "op-1" || rec.ReviewedAt == "" {
+		t.Fatalf("expected persisted rejection, got=%+v err=%v", rec, err)
+	}
+}
+
 func TestPostgresAuditServiceListsPersistedAuditEvents(t *testing.T) {
 	db := openPostgresIntegrationDB(t)
 	resetPostgresIntegrationState(t, db)
@@ -305,6 +427,77 @@ func TestPostgresAuditServiceListsPersistedAuditEvents(t *testing.T) {
 	}
 }
 
+func TestPostgresAuditStorePersistsAndMirrors(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	mem := audit.NewInMemoryStore()
+	store := PostgresAuditStore{DB: db, Mirror: mem}
+	now := time.Date(2026, 3, 10, 10, 0, 0, 0, time.UTC)
+	for _, id := range []string{"store-pg-1", "store-pg-2"} {
+		ev := newAuditEvent(meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), id, now, "config_change", "c1", "propose", []byte(`{}`), []byte(`{"v":1}`), audit.ResultSuccess, "")
+		if err := appendAuditTo(context.Background(), store, ev); err != nil {
+			t.Fatalf("append: %v", err)
+		}
+	}
+	if len(mem.Events()) != 2 {
+		t.Fatalf("expected events mirrored in memory, got %d", len(mem.Events()))
+	}
+	var n int
+	if err := db.QueryRowContext(context.Background(), `SELECT COUNT(*) FROM audit_events WHERE audit_id LIKE 'store-pg-%'`).Scan(&n); err != nil || n != 2 {
+		t.Fatalf("expected persisted events, n=%d err=%v", n, err)
+	}
+	if err := verifyAuditChainFromDB(context.Background(), db, auditPartitionDay(now)); err != nil {
+		t.Fatalf("persisted chain: %v", err)
+	}
+}
+
+func TestPostgresAuditEventSignaturesPersistAndExport(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	SetAuditEventSigner("audit-event-k1", priv)
+	t.Cleanup(func() { SetAuditEventSigner("", nil) })
+
+	ctx := context.Background()
+	now := time.Date(2026, 3, 10, 10, 0, 0, 123456789, time.UTC)
+	ev := newAuditEvent(meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), "sig-pg-1", now, "config_change", "c1", "propose", []byte(`{"b":2,"a":[1,2.50]}`), []byte(`{"v":1}`), audit.ResultSuccess, "")
+	if err := appendAuditTo(ctx, PostgresAuditStore{DB: db}, ev); err != nil {
+		t.Fatalf("append: %v", err)
+	}
+
+	svc := NewAuditService(ledgerFixedClock{now: now}, nil)
+	svc.SetDB(db)
+	list, _ := svc.ListAuditEvents(ctx, &rgsv1.ListAuditEventsRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")})
+	if len(list.GetEvents()) != 1 || list.Events[0].GetSignerKid() != "audit-event-k1" || list.Events[0].GetSignature() == "" {
+		t.Fatalf("expected persisted signature, got %+v", list)
+	}
+
+	rows, err := exportAuditPartitionFromDB(ctx, db, auditPartitionDay(now))
+	if err != nil || len(rows) != 1 {
+		t.Fatalf("export rows=%d err=%v", len(rows), err)
+	}
+	payload, _ := base64.StdEncoding.DecodeString(rows[0].SignedPayload)
+	sig, _ := hex.DecodeString(rows[0].Signature)
+	if !ed25519.Verify(pub, payload, sig) {
+		t.Fatalf("signature does not verify against the payload rebuilt from the stored row")
+	}
+}
+
+func TestPostgresListAuditEventsFilters(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	for _, e := range auditFilterFixture() {
+		if err := appendAuditEventToDB(context.Background(), db, e); err != nil {
+			t.Fatalf("append: %v", err)
+		}
+	}
+	svc := NewAuditService(ledgerFixedClock{now: time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC)}, nil)
+	svc.SetDB(db)
+	assertAuditFilterResults(t, svc)
+}
+
 func TestPostgresAuditServiceListsPersistedConfigAuditEvents(t *testing.T) {
 	db := openPostgresIntegrationDB(t)
 	resetPostgresIntegrationState(t, db)
@@ -480,6 +673,8 @@ func TestPostgresLedgerEFTLockoutPersistsAcrossRestart(t *testing.T) {
 
 	svcA := NewLedgerService(clk, db)
 	svcA.SetEFTFraudPolicy(2, 15*time.Minute)
+	notifier := &recordingLockoutNotifier{}
+	svcA.SetLockoutNotifier(notifier)
 	_, err := svcA.Deposit(ctx, &rgsv1.DepositRequest{
 		Meta:      meta("acct-eft-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "idem-eft-dep-1"),
 		AccountId: "acct-eft-1",
@@ -502,6 +697,9 @@ func TestPostgresLedgerEFTLockoutPersistsAcrossRestart(t *testing.T) {
 			t.Fatalf("expected denied withdraw %d, got=%v", i+1, resp.Meta.GetResultCode())
 		}
 	}
+	if len(notifier.events) != 1 || notifier.events[0].Kind != LockoutKindEFT || notifier.events[0].Subject != "acct-eft-1" {
+		t.Fatalf("expected one eft lockout notification, got=%+v", notifier.events)
+	}
 
 	svcB := NewLedgerService(clk, db)
 	svcB.SetEFTFraudPolicy(2, 15*time.Minute)
@@ -579,6 +777,145 @@ func TestPostgresConfigApproveApplyAcrossRestart(t *testing.T) {
 	}
 }
 
+func TestPostgresConfigValueAsOfAcrossRestart(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	ctx := context.Background()
+	svcA := NewConfigService(ledgerFixedClock{now: time.Date(2026, 2, 10, 9, 0, 0, 0, time.UTC)}, db)
+	applyConfigValue(t, svcA, WageringConfigNamespace, "max_stake/USD", "100.00")
+	svcA.Clock = ledgerFixedClock{now: time.Date(2026, 2, 12, 9, 0, 0, 0, time.UTC)}
+	applyConfigValue(t, svcA, WageringConfigNamespace, "max_stake/USD", "250.00")
+
+	svcB := NewConfigService(ledgerFixedClock{now: time.Date(2026, 2, 13, 9, 0, 0, 0, time.UTC)}, db)
+	change, err := svcB.ValueAsOf(ctx, WageringConfigNamespace, "max_stake/USD", time.Date(2026, 2, 11, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("value as of err: %v", err)
+	}
+	if change.GetProposedValue() != "100.00" {
+		t.Fatalf("expected limit 100.00 on 2026-02-11, got=%+v", change)
+	}
+	values, err := svcB.ValuesAsOf(ctx, time.Date(2026, 2, 13, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("values as of err: %v", err)
+	}
+	if len(values) != 1 || values[0].GetProposedValue() != "250.00" {
+		t.Fatalf("expected latest applied limit, got=%+v", values)
+	}
+}
+
+func TestPostgresConfigRollbackAcrossRestart(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	ctx := context.Background()
+	svcA := NewConfigService(ledgerFixedClock{now: time.Date(2026, 2, 10, 9, 0, 0, 0, time.UTC)}, db)
+	applyConfigValue(t, svcA, WageringConfigNamespace, "max_stake/USD", "100.00")
+	svcA.Clock = ledgerFixedClock{now: time.Date(2026, 2, 12, 9, 0, 0, 0, time.UTC)}
+	applyConfigValue(t, svcA, WageringConfigNamespace, "max_stake/USD", "250.00")
+	latest, err := svcA.ValueAsOf(ctx, WageringConfigNamespace, "max_stake/USD", time.Date(2026, 2, 12, 9, 0, 0, 0, time.UTC))
+	if err != nil || latest == nil {
+		t.Fatalf("latest change: change=%+v err=%v", latest, err)
+	}
+
+	svcB := NewConfigService(ledgerFixedClock{now: time.Date(2026, 2, 13, 9, 0, 0, 0, time.UTC)}, db)
+	svcB.nextChangeID = 100
+	rollback, err := svcB.RollbackConfigChange(ctx, &rgsv1.RollbackConfigChangeRequest{
+		Meta:     meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		ChangeId: latest.ChangeId,
+		Reason:   "limit raised in error",
+	})
+	if err != nil || rollback.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("rollback: resp=%+v err=%v", rollback, err)
+	}
+	stored, err := svcB.getConfigChange(ctx, rollback.Change.ChangeId)
+	if err != nil || stored.GetRollbackOfChangeId() != latest.ChangeId || stored.GetProposedValue() != "100.00" {
+		t.Fatalf("unexpected persisted rollback: change=%+v err=%v", stored, err)
+	}
+	if _, err := svcB.ApproveConfigChange(ctx, &rgsv1.ApproveConfigChangeRequest{Meta: meta("op-2", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), ChangeId: stored.ChangeId}); err != nil {
+		t.Fatalf("approve rollback: %v", err)
+	}
+	applied, err := svcB.ApplyConfigChange(ctx, &rgsv1.ApplyConfigChangeRequest{Meta: meta("op-2", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), ChangeId: stored.ChangeId})
+	if err != nil || applied.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("apply rollback: resp=%+v err=%v", applied, err)
+	}
+	if v, ok, err := svcB.WageringSetting(ctx, "max_stake/USD"); err != nil || !ok || v != "100.00" {
+		t.Fatalf("expected rollback to restore 100.00, got=%q ok=%v err=%v", v, ok, err)
+	}
+}
+
+func TestPostgresConfigApprovalsAcrossRestart(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	ctx := context.Background()
+	svcA := NewConfigService(ledgerFixedClock{now: time.Date(2026, 2, 10, 9, 0, 0, 0, time.UTC)}, db)
+	svcA.SetApprovalPolicy(map[string]int{WageringConfigNamespace: 2})
+	proposed, _ := svcA.ProposeConfigChange(ctx, &rgsv1.ProposeConfigChangeRequest{
+		Meta:            meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		ConfigNamespace: WageringConfigNamespace,
+		ConfigKey:       "max_stake/USD",
+		ProposedValue:   "100.00",
+	})
+	changeID := proposed.Change.GetChangeId()
+	if resp, _ := svcA.ApproveConfigChange(ctx, &rgsv1.ApproveConfigChangeRequest{Meta: meta("op-2", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), ChangeId: changeID, Reason: "first"}); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("first approval: %+v", resp.Meta)
+	}
+
+	svcB := NewConfigService(ledgerFixedClock{now: time.Date(2026, 2, 10, 10, 0, 0, 0, time.UTC)}, db)
+	stored, err := svcB.getConfigChange(ctx, changeID)
+	if err != nil || stored.GetRequiredApprovals() != 2 || len(stored.GetApprovals()) != 1 || stored.Approvals[0].ApproverId != "op-2" || stored.Approvals[0].Reason != "first" {
+		t.Fatalf("unexpected persisted approvals: change=%+v err=%v", stored, err)
+	}
+	if resp, _ := svcB.ApproveConfigChange(ctx, &rgsv1.ApproveConfigChangeRequest{Meta: meta("op-2", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), ChangeId: changeID}); resp.Meta.GetDenialReason() != "approver has already approved" {
+		t.Fatalf("expected repeat approval after restart to be denied: %+v", resp.Meta)
+	}
+	if resp, _ := svcB.ApproveConfigChange(ctx, &rgsv1.ApproveConfigChangeRequest{Meta: meta("op-3", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), ChangeId: changeID}); resp.Change.GetStatus() != rgsv1.ConfigChangeStatus_CONFIG_CHANGE_STATUS_APPROVED {
+		t.Fatalf("expected second approval to approve: %+v", resp)
+	}
+	history, err := svcB.listConfigHistoryFromDB(ctx, WageringConfigNamespace, 10, 0)
+	if err != nil || len(history) != 1 || len(history[0].Approvals) != 2 || history[0].Status != rgsv1.ConfigChangeStatus_CONFIG_CHANGE_STATUS_APPROVED {
+		t.Fatalf("unexpected history: changes=%+v err=%v", history, err)
+	}
+}
+
+func TestPostgresConfigSchemaAcrossRestart(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	ctx := context.Background()
+	svcA := NewConfigService(ledgerFixedClock{now: time.Date(2026, 2, 10, 9, 0, 0, 0, time.UTC)}, db)
+	set, err := svcA.SetConfigSchema(ctx, &rgsv1.SetConfigSchemaRequest{
+		Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		Schema: &rgsv1.ConfigSchema{
+			ConfigNamespace: "security",
+			ConfigKey:       "session_timeout",
+			ValueType:       rgsv1.ConfigValueType_CONFIG_VALUE_TYPE_INTEGER,
+			MinValue:        "60",
+			AllowedValues:   []string{"300", "900"},
+		},
+	})
+	if err != nil || set.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("set schema: resp=%+v err=%v", set, err)
+	}
+
+	svcB := NewConfigService(ledgerFixedClock{now: time.Date(2026, 2, 11, 9, 0, 0, 0, time.UTC)}, db)
+	list, err := svcB.ListConfigSchemas(ctx, &rgsv1.ListConfigSchemasRequest{Meta: meta("op-2", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")})
+	if err != nil || len(list.Schemas) != 1 || len(list.Schemas[0].AllowedValues) != 2 || list.Schemas[0].ValueType != rgsv1.ConfigValueType_CONFIG_VALUE_TYPE_INTEGER {
+		t.Fatalf("unexpected persisted schemas: resp=%+v err=%v", list, err)
+	}
+	proposed, err := svcB.ProposeConfigChange(ctx, &rgsv1.ProposeConfigChangeRequest{
+		Meta:            meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		ConfigNamespace: "security",
+		ConfigKey:       "session_timeout",
+		ProposedValue:   "fifteen",
+		Reason:          "tune timeout",
+	})
+	if err != nil || proposed.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected persisted schema to reject value: resp=%+v err=%v", proposed, err)
+	}
+}
+
 func TestPostgresReportingPayloadsFromDatabase(t *testing.T) {
 	db := openPostgresIntegrationDB(t)
 	resetPostgresIntegrationState(t, db)
@@ -657,6 +994,81 @@ VALUES ('acct-pg-rpt-1', 'acct-pg-rpt-1', 'player_cashless', 'active', 'USD', 90
 	}
 }
 
+func TestPostgresRoleAssignmentsAcrossInstances(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 20, 10, 0, 0, 0, time.UTC)}
+	ctx := context.Background()
+	svcA := NewRoleService(clk)
+	svcA.SetDB(db)
+	newTestCashierRole(t, svcA)
+
+	svcB := NewRoleService(clk)
+	svcB.SetDB(db)
+	op2 := platformauth.Actor{ID: "op-2", Type: "ACTOR_TYPE_OPERATOR"}
+	if ok, err := svcB.Authorize(ctx, op2, "/rgs.v1.LedgerService/Deposit"); err != nil || !ok {
+		t.Fatalf("expected persisted cashier role to allow deposits, ok=%v err=%v", ok, err)
+	}
+	if ok, err := svcB.Authorize(ctx, op2, "/rgs.v1.ConfigService/ProposeConfigChange"); err != nil || ok {
+		t.Fatalf("expected persisted cashier role to deny config changes, ok=%v err=%v", ok, err)
+	}
+
+	// Built-in roles can be assigned alongside custom ones.
+	assigned, _ := svcB.AssignRole(ctx, &rgsv1.AssignRoleRequest{
+		Meta:     meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		Actor:    &rgsv1.Actor{ActorId: "op-2", ActorType: rgsv1.ActorType_ACTOR_TYPE_OPERATOR},
+		RoleName: "operator",
+	})
+	if assigned.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("assign built-in role failed: %+v", assigned.Meta)
+	}
+	list, _ := svcB.ListPermissions(ctx, &rgsv1.ListPermissionsRequest{
+		Meta:  meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		Actor: &rgsv1.Actor{ActorId: "op-2", ActorType: rgsv1.ActorType_ACTOR_TYPE_OPERATOR},
+	})
+	if len(list.Roles) != 4 || len(list.ActorRoles) != 2 || len(list.EffectivePermissions) != len(list.Permissions) {
+		t.Fatalf("unexpected permission listing: roles=%d actor_roles=%v", len(list.Roles), list.ActorRoles)
+	}
+}
+
+func TestPostgresWebAuthnCredentialAcrossInstances(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 21, 9, 0, 0, 0, time.UTC)}
+	ctx := context.Background()
+	svcA := NewIdentityService(clk, "test-secret", 15*time.Minute, time.Hour, db)
+	svcA.SetWebAuthnRelyingParty(testWebAuthnRPID, "", []string{testWebAuthnOrigin})
+	key := newTestAuthenticator(t)
+	begin, _ := svcA.BeginWebAuthnRegistration(ctx, &rgsv1.BeginWebAuthnRegistrationRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")})
+	if finish, _ := svcA.FinishWebAuthnRegistration(ctx, key.register(begin.Challenge)); finish.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("finish registration failed: %+v", finish.Meta)
+	}
+
+	// The login challenge is issued by one instance and answered on another.
+	svcB := NewIdentityService(clk, "test-secret", 15*time.Minute, time.Hour, db)
+	svcB.SetWebAuthnRelyingParty(testWebAuthnRPID, "", []string{testWebAuthnOrigin})
+	challenge := beginTestWebAuthnLogin(t, svcA)
+	resp, _ := svcB.FinishWebAuthnLogin(ctx, key.assert(t, challenge))
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || resp.Token.GetAccessToken() == "" {
+		t.Fatalf("expected webauthn login on second instance, got=%+v", resp.Meta)
+	}
+	key.signCount--
+	if resp, _ := svcA.FinishWebAuthnLogin(ctx, key.assert(t, beginTestWebAuthnLogin(t, svcB))); resp.Meta.GetDenialReason() != "authenticator sign count regressed" {
+		t.Fatalf("expected persisted sign count enforced, got=%+v", resp.Meta)
+	}
+
+	// Disabling the operator's credentials disables the key too.
+	if ok, err := svcA.setCredentialStatus(ctx, "op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "disabled"); err != nil || !ok {
+		t.Fatalf("disable credentials: ok=%v err=%v", ok, err)
+	}
+	key.signCount += 5
+	if resp, _ := svcB.FinishWebAuthnLogin(ctx, key.assert(t, beginTestWebAuthnLogin(t, svcB))); resp.Meta.GetDenialReason() != "invalid credentials" {
+		t.Fatalf("expected disabled key denied, got=%+v", resp.Meta)
+	}
+}
+
 func TestPostgresIdentitySessionPersistenceAcrossRestart(t *testing.T) {
 	db := openPostgresIntegrationDB(t)
 	resetPostgresIntegrationState(t, db)
@@ -726,15 +1138,130 @@ func TestPostgresIdentitySessionPersistenceAcrossRestart(t *testing.T) {
 	}
 }
 
+func TestPostgresIdentityRefreshReuseRevokesFamily(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+	ctx := context.Background()
+
+	clk := ledgerFixedClock{now: time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)}
+	svcA := NewIdentityService(clk, "test-secret", 15*time.Minute, time.Hour, db)
+	respSet, _ := svcA.SetCredential(ctx, &rgsv1.SetCredentialRequest{
+		Meta:           meta("op-seed", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		Actor:          &rgsv1.Actor{ActorId: "player-reuse-1", ActorType: rgsv1.ActorType_ACTOR_TYPE_PLAYER},
+		CredentialHash: mustBcryptHash(t, "player-secret"),
+		Reason:         "seed reuse user",
+	})
+	if respSet.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected set credential ok, got=%+v", respSet.Meta)
+	}
+	login, _ := svcA.Login(ctx, &rgsv1.LoginRequest{
+		Meta: meta("player-reuse-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""),
+		Credentials: &rgsv1.LoginRequest_Player{
+			Player: &rgsv1.PlayerCredentials{PlayerId: "player-reuse-1", Pin: "player-secret"},
+		},
+	})
+	if login.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected login ok, got=%+v", login.Meta)
+	}
+	refreshed, _ := svcA.RefreshToken(ctx, &rgsv1.RefreshTokenRequest{
+		Meta:         meta("player-reuse-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""),
+		RefreshToken: login.Token.GetRefreshToken(),
+	})
+	if refreshed.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected refresh ok, got=%+v", refreshed.Meta)
+	}
+
+	svcB := NewIdentityService(clk, "test-secret", 15*time.Minute, time.Hour, db)
+	replay, _ := svcB.RefreshToken(ctx, &rgsv1.RefreshTokenRequest{
+		Meta:         meta("player-reuse-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""),
+		RefreshToken: login.Token.GetRefreshToken(),
+	})
+	if replay.Meta.GetDenialReason() != "refresh token reuse detected" {
+		t.Fatalf("expected reuse detected, got=%+v", replay.Meta)
+	}
+	var active int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM identity_sessions WHERE family_id = $1 AND revoked = FALSE`, login.Token.GetRefreshToken()).Scan(&active); err != nil {
+		t.Fatalf("count active family sessions: %v", err)
+	}
+	if active != 0 {
+		t.Fatalf("expected whole family revoked, %d sessions still active", active)
+	}
+	current, _ := svcB.RefreshToken(ctx, &rgsv1.RefreshTokenRequest{
+		Meta:         meta("player-reuse-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""),
+		RefreshToken: refreshed.Token.GetRefreshToken(),
+	})
+	if current.Meta.GetDenialReason() != "invalid refresh token" {
+		t.Fatalf("expected successor token revoked, got=%+v", current.Meta)
+	}
+}
+
+func TestPostgresIdentitySessionAdmin(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+	ctx := context.Background()
+
+	clk := ledgerFixedClock{now: time.Date(2026, 3, 5, 9, 0, 0, 0, time.UTC)}
+	svc := NewIdentityService(clk, "test-secret", 15*time.Minute, time.Hour, db)
+	admin := meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+	player := &rgsv1.Actor{ActorId: "player-sess-1", ActorType: rgsv1.ActorType_ACTOR_TYPE_PLAYER}
+	respSet, _ := svc.SetCredential(ctx, &rgsv1.SetCredentialRequest{
+		Meta:           meta("op-seed", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		Actor:          player,
+		CredentialHash: mustBcryptHash(t, "player-secret"),
+		Reason:         "seed session user",
+	})
+	if respSet.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected set credential ok, got=%+v", respSet.Meta)
+	}
+	var tokens []string
+	for i := 0; i < 3; i++ {
+		login, _ := svc.Login(ctx, &rgsv1.LoginRequest{
+			Meta:        meta(player.ActorId, player.ActorType, ""),
+			Credentials: &rgsv1.LoginRequest_Player{Player: &rgsv1.PlayerCredentials{PlayerId: player.ActorId, Pin: "player-secret"}},
+		})
+		if login.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("expected login ok, got=%+v", login.Meta)
+		}
+		tokens = append(tokens, login.Token.GetRefreshToken())
+	}
+
+	list, _ := svc.ListSessions(ctx, &rgsv1.ListSessionsRequest{Meta: admin, Actor: player})
+	if list.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || len(list.Sessions) != 3 {
+		t.Fatalf("expected three sessions, got=%+v", list)
+	}
+	revoked, _ := svc.RevokeSession(ctx, &rgsv1.RevokeSessionRequest{Meta: admin, SessionId: identitySessionID(tokens[0]), Reason: "lost device"})
+	if revoked.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected revoke ok, got=%+v", revoked.Meta)
+	}
+	all, _ := svc.RevokeAllSessionsForActor(ctx, &rgsv1.RevokeAllSessionsForActorRequest{Meta: admin, Actor: player, Reason: "account compromise"})
+	if all.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || all.RevokedCount != 2 {
+		t.Fatalf("expected two sessions revoked, got=%+v", all)
+	}
+	var active int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM identity_sessions WHERE actor_id = $1 AND revoked = FALSE`, player.ActorId).Scan(&active); err != nil {
+		t.Fatalf("count active sessions: %v", err)
+	}
+	if active != 0 {
+		t.Fatalf("expected no active sessions, got=%d", active)
+	}
+	var audited int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM audit_events WHERE action IN ('identity_revoke_session', 'identity_revoke_all_sessions') AND result = 'success'`).Scan(&audited); err != nil {
+		t.Fatalf("count audit events: %v", err)
+	}
+	if audited != 2 {
+		t.Fatalf("expected revocations audited, got=%d", audited)
+	}
+}
+
 func TestPostgresIdentitySessionCleanupExpiredRows(t *testing.T) {
 	db := openPostgresIntegrationDB(t)
 	resetPostgresIntegrationState(t, db)
 
 	if _, err := db.Exec(`
-INSERT INTO identity_sessions (refresh_token, actor_id, actor_type, expires_at, revoked)
+INSERT INTO identity_sessions (refresh_token, session_id, family_id, actor_id, actor_type, expires_at, revoked)
 VALUES
-  ('sess-expired', 'player-1', 'ACTOR_TYPE_PLAYER', NOW() - INTERVAL '1 hour', FALSE),
-  ('sess-active', 'player-1', 'ACTOR_TYPE_PLAYER', NOW() + INTERVAL '1 hour', FALSE)
+  ('sess-expired', 'sess-expired', 'sess-expired', 'player-1', 'ACTOR_TYPE_PLAYER', NOW() - INTERVAL '1 hour', FALSE),
+  ('sess-active', 'sess-active', 'sess-active', 'player-1', 'ACTOR_TYPE_PLAYER', NOW() + INTERVAL '1 hour', FALSE)
 `); err != nil {
 		t.Fatalf("seed identity sessions: %v", err)
 	}
@@ -749,6 +1276,115 @@ VALUES
 	}
 }
 
+func TestPostgresWorkerCleanupJobsWriteAuditEvents(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	if _, err := db.Exec(`
+INSERT INTO identity_sessions (refresh_token, session_id, family_id, actor_id, actor_type, expires_at, revoked)
+VALUES
+  ('sess-expired-1', 'sess-expired-1', 'sess-expired-1', 'player-1', 'ACTOR_TYPE_PLAYER', NOW() - INTERVAL '2 hours', FALSE),
+  ('sess-expired-2', 'sess-expired-2', 'sess-expired-2', 'player-1', 'ACTOR_TYPE_PLAYER', NOW() - INTERVAL '1 hour', FALSE),
+  ('sess-expired-3', 'sess-expired-3', 'sess-expired-3', 'player-2', 'ACTOR_TYPE_PLAYER', NOW() - INTERVAL '30 minutes', FALSE)
+`); err != nil {
+		t.Fatalf("seed identity sessions: %v", err)
+	}
+	if _, err := db.Exec(`
+INSERT INTO ledger_idempotency_keys (scope, idempotency_key, request_hash, response_payload, result_code, expires_at)
+VALUES ('acct-cleanup|deposit', 'k-expired', '\x01', '{}'::jsonb, 'RESULT_CODE_OK', NOW() - INTERVAL '1 hour')
+`); err != nil {
+		t.Fatalf("seed idempotency keys: %v", err)
+	}
+
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 13, 15, 20, 0, 0, time.UTC)}
+	identitySvc := NewIdentityService(clk, "test-secret", 15*time.Minute, time.Hour, db)
+	if _, err := identitySvc.SessionCleanupJob(2)(context.Background(), ""); err != nil {
+		t.Fatalf("session cleanup job err: %v", err)
+	}
+	ledgerSvc := NewLedgerService(clk, db)
+	if _, err := ledgerSvc.IdempotencyCleanupJob(100, nil)(context.Background(), ""); err != nil {
+		t.Fatalf("idempotency cleanup job err: %v", err)
+	}
+	// A run with nothing to delete leaves no audit event.
+	if _, err := identitySvc.SessionCleanupJob(2)(context.Background(), ""); err != nil {
+		t.Fatalf("empty session cleanup job err: %v", err)
+	}
+
+	var (
+		count     int
+		actorID   string
+		afterJSON []byte
+	)
+	if err := db.QueryRow(`
+SELECT COUNT(*) OVER (), actor_id, after_state
+FROM audit_events
+WHERE action = 'identity_session_cleanup'
+`).Scan(&count, &actorID, &afterJSON); err != nil {
+		t.Fatalf("query session cleanup audit: %v", err)
+	}
+	if count != 1 || actorID != "system" {
+		t.Fatalf("expected one system session cleanup event, got count=%d actor=%s", count, actorID)
+	}
+	var summary workerAuditSummary
+	if err := json.Unmarshal(afterJSON, &summary); err != nil {
+		t.Fatalf("decode session cleanup summary: %v", err)
+	}
+	if summary.Affected != 3 || summary.Batches != 2 || summary.RangeField != "expires_at" || summary.RangeFrom == "" || summary.RangeFrom == summary.RangeTo {
+		t.Fatalf("unexpected session cleanup summary: %+v", summary)
+	}
+
+	if err := db.QueryRow(`
+SELECT after_state
+FROM audit_events
+WHERE action = 'ledger_idempotency_cleanup' AND actor_id = 'system'
+`).Scan(&afterJSON); err != nil {
+		t.Fatalf("query idempotency cleanup audit: %v", err)
+	}
+	summary = workerAuditSummary{}
+	if err := json.Unmarshal(afterJSON, &summary); err != nil {
+		t.Fatalf("decode idempotency cleanup summary: %v", err)
+	}
+	if summary.Affected != 1 || summary.Batches != 1 || summary.RangeFrom != summary.RangeTo {
+		t.Fatalf("unexpected idempotency cleanup summary: %+v", summary)
+	}
+}
+
+func TestPostgresIdentityKeyRotationsPersistAcrossInstances(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	ctx := context.Background()
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 22, 8, 0, 0, 0, time.UTC)}
+	svcA := NewIdentityService(clk, "test-secret", 15*time.Minute, time.Hour, db)
+	if _, err := svcA.RecordKeyRotation(ctx, &rgsv1.KeyRotation{Fingerprint: "fp-1", ActiveKid: "k1", KeyIds: []string{"k1"}, Source: "command", Trigger: "startup", InstanceId: "rgsd-a"}); err != nil {
+		t.Fatalf("record rotation on instance a: %v", err)
+	}
+	svcB := NewIdentityService(clk, "test-secret", 15*time.Minute, time.Hour, db)
+	if _, err := svcB.RecordKeyRotation(ctx, &rgsv1.KeyRotation{Fingerprint: "fp-2", ActiveKid: "k2", KeyIds: []string{"k1", "k2"}, Source: "command", Trigger: "refresh", InstanceId: "rgsd-b"}); err != nil {
+		t.Fatalf("record rotation on instance b: %v", err)
+	}
+
+	resp, _ := svcA.ListKeyRotations(ctx, &rgsv1.ListKeyRotationsRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")})
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || len(resp.Rotations) != 2 {
+		t.Fatalf("expected two persisted rotations, got meta=%+v rotations=%d", resp.Meta, len(resp.Rotations))
+	}
+	latest := resp.Rotations[0]
+	if latest.Fingerprint != "fp-2" || latest.PreviousFingerprint != "fp-1" || latest.InstanceId != "rgsd-b" || len(latest.KeyIds) != 2 {
+		t.Fatalf("unexpected latest rotation: %+v", latest)
+	}
+	if latest.TriggeredBy.GetActorType() != rgsv1.ActorType_ACTOR_TYPE_SERVICE {
+		t.Fatalf("expected system trigger, got=%+v", latest.TriggeredBy)
+	}
+
+	var audited int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM audit_events WHERE action = 'identity_key_rotation'`).Scan(&audited); err != nil {
+		t.Fatalf("count rotation audit events: %v", err)
+	}
+	if audited != 2 {
+		t.Fatalf("expected two rotation audit events, got=%d", audited)
+	}
+}
+
 func TestPostgresIdentityHasActiveCredentials(t *testing.T) {
 	db := openPostgresIntegrationDB(t)
 	resetPostgresIntegrationState(t, db)
@@ -777,19 +1413,135 @@ VALUES ('op-bootstrap-1', 'ACTOR_TYPE_OPERATOR', '$2a$10$7jvnYQ5lzu4iAfDdc0AGJOh
 	}
 }
 
-func TestPostgresIdentityLoginRateLimitAcrossRestart(t *testing.T) {
+func TestPostgresIdentityPasswordPolicy(t *testing.T) {
 	db := openPostgresIntegrationDB(t)
 	resetPostgresIntegrationState(t, db)
+	ctx := context.Background()
 
-	svcSeed := NewIdentityService(ledgerFixedClock{now: time.Date(2026, 2, 13, 15, 40, 0, 0, time.UTC)}, "test-secret", 15*time.Minute, time.Hour, db)
-	respSet, err := svcSeed.SetCredential(context.Background(), &rgsv1.SetCredentialRequest{
-		Meta:           meta("op-seed", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
-		Actor:          &rgsv1.Actor{ActorId: "player-rate-1", ActorType: rgsv1.ActorType_ACTOR_TYPE_PLAYER},
-		CredentialHash: mustBcryptHash(t, "player-secret"),
-		Reason:         "seed rate limit user",
+	start := time.Date(2026, 3, 3, 9, 0, 0, 0, time.UTC)
+	svc := NewIdentityService(ledgerFixedClock{now: start}, "test-secret", 15*time.Minute, time.Hour, db)
+	svc.SetPasswordPolicy(PasswordPolicy{MinLength: 12, MinCharacterClasses: 3, HistorySize: 2, MaxAge: 90 * 24 * time.Hour})
+	respSet, err := svc.SetCredential(ctx, &rgsv1.SetCredentialRequest{
+		Meta:           meta("op-admin", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		Actor:          &rgsv1.Actor{ActorId: "op-policy-1", ActorType: rgsv1.ActorType_ACTOR_TYPE_OPERATOR},
+		CredentialHash: mustBcryptHash(t, "Initial-Pass-1"),
+		Reason:         "seed operator",
 	})
-	if err != nil {
-		t.Fatalf("set credential err: %v", err)
+	if err != nil || respSet.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("set credential failed: resp=%+v err=%v", respSet, err)
+	}
+
+	change := func(svc *IdentityService, current, next string) *rgsv1.ChangeCredentialResponse {
+		t.Helper()
+		resp, err := svc.ChangeCredential(ctx, &rgsv1.ChangeCredentialRequest{
+			Meta:            meta("op-policy-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+			CurrentPassword: current,
+			NewPassword:     next,
+		})
+		if err != nil {
+			t.Fatalf("change credential err: %v", err)
+		}
+		return resp
+	}
+	login := func(svc *IdentityService, password string) *rgsv1.LoginResponse {
+		t.Helper()
+		resp, err := svc.Login(ctx, &rgsv1.LoginRequest{
+			Meta: meta("op-policy-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+			Credentials: &rgsv1.LoginRequest_Operator{
+				Operator: &rgsv1.OperatorCredentials{OperatorId: "op-policy-1", Password: password},
+			},
+		})
+		if err != nil {
+			t.Fatalf("login err: %v", err)
+		}
+		return resp
+	}
+
+	if resp := change(svc, "wrong-password", "Second-Pass-22"); resp.Meta.GetDenialReason() != "invalid credentials" {
+		t.Fatalf("expected wrong current password denied, got=%+v", resp.Meta)
+	}
+	resp := change(svc, "Initial-Pass-1", "Second-Pass-22")
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || resp.ExpiresAt != start.Add(90*24*time.Hour).Format(time.RFC3339Nano) {
+		t.Fatalf("expected change ok with expiry, got=%+v", resp)
+	}
+	if resp := change(svc, "Second-Pass-22", "Initial-Pass-1"); resp.Meta.GetDenialReason() != "password was used recently" {
+		t.Fatalf("expected reuse of previous password rejected, got=%+v", resp.Meta)
+	}
+	if resp := login(svc, "Second-Pass-22"); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected login with new password, got=%+v", resp.Meta)
+	}
+
+	expired := NewIdentityService(ledgerFixedClock{now: start.Add(91 * 24 * time.Hour)}, "test-secret", 15*time.Minute, time.Hour, db)
+	expired.SetPasswordPolicy(PasswordPolicy{MinLength: 12, MinCharacterClasses: 3, HistorySize: 2, MaxAge: 90 * 24 * time.Hour})
+	if resp := login(expired, "Second-Pass-22"); resp.Meta.GetDenialReason() != "credential expired" {
+		t.Fatalf("expected expired credential denied, got=%+v", resp.Meta)
+	}
+	if resp := change(expired, "Second-Pass-22", "Third-Pass-333"); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected expired password still changeable, got=%+v", resp.Meta)
+	}
+	if resp := login(expired, "Third-Pass-333"); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected login after change, got=%+v", resp.Meta)
+	}
+
+	var history int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM identity_credential_history WHERE actor_id = 'op-policy-1'`).Scan(&history); err != nil {
+		t.Fatalf("count history: %v", err)
+	}
+	if history != 2 {
+		t.Fatalf("expected history pruned to 2 entries, got=%d", history)
+	}
+}
+
+func TestPostgresIdentityPlayerChangesOwnPIN(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+	ctx := context.Background()
+
+	start := time.Date(2026, 3, 3, 9, 0, 0, 0, time.UTC)
+	svc := NewIdentityService(ledgerFixedClock{now: start}, "test-secret", 15*time.Minute, time.Hour, db)
+	svc.SetPasswordPolicy(PasswordPolicy{MinLength: 12, MinCharacterClasses: 3, HistorySize: 2, MaxAge: 90 * 24 * time.Hour})
+	player := meta("player-pin-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "")
+	respSet, _ := svc.SetCredential(ctx, &rgsv1.SetCredentialRequest{
+		Meta:           meta("op-admin", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		Actor:          player.Actor,
+		CredentialHash: mustBcryptHash(t, "8302"),
+		Reason:         "seed player",
+	})
+	if respSet.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected set credential ok, got=%+v", respSet.Meta)
+	}
+
+	resp, _ := svc.ChangeCredential(ctx, &rgsv1.ChangeCredentialRequest{Meta: player, CurrentPassword: "8302", NewPassword: "40917"})
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || resp.ExpiresAt != "" {
+		t.Fatalf("expected pin change ok without expiry, got=%+v", resp)
+	}
+	if resp, _ := svc.ChangeCredential(ctx, &rgsv1.ChangeCredentialRequest{Meta: player, CurrentPassword: "40917", NewPassword: "8302"}); resp.Meta.GetDenialReason() != "password was used recently" {
+		t.Fatalf("expected previous pin rejected, got=%+v", resp.Meta)
+	}
+	for pin, want := range map[string]rgsv1.ResultCode{"8302": rgsv1.ResultCode_RESULT_CODE_DENIED, "40917": rgsv1.ResultCode_RESULT_CODE_OK} {
+		login, _ := svc.Login(ctx, &rgsv1.LoginRequest{
+			Meta:        player,
+			Credentials: &rgsv1.LoginRequest_Player{Player: &rgsv1.PlayerCredentials{PlayerId: "player-pin-1", Pin: pin}},
+		})
+		if login.Meta.GetResultCode() != want {
+			t.Fatalf("login with pin %s: expected %v, got=%+v", pin, want, login.Meta)
+		}
+	}
+}
+
+func TestPostgresIdentityLoginRateLimitAcrossRestart(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	svcSeed := NewIdentityService(ledgerFixedClock{now: time.Date(2026, 2, 13, 15, 40, 0, 0, time.UTC)}, "test-secret", 15*time.Minute, time.Hour, db)
+	respSet, err := svcSeed.SetCredential(context.Background(), &rgsv1.SetCredentialRequest{
+		Meta:           meta("op-seed", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		Actor:          &rgsv1.Actor{ActorId: "player-rate-1", ActorType: rgsv1.ActorType_ACTOR_TYPE_PLAYER},
+		CredentialHash: mustBcryptHash(t, "player-secret"),
+		Reason:         "seed rate limit user",
+	})
+	if err != nil {
+		t.Fatalf("set credential err: %v", err)
 	}
 	if respSet.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
 		t.Fatalf("expected set credential ok, got=%v", respSet.Meta.GetResultCode())
@@ -838,7 +1590,7 @@ func TestPostgresRemoteAccessActivityPersistenceAcrossRestart(t *testing.T) {
 	resetPostgresIntegrationState(t, db)
 
 	clk := ledgerFixedClock{now: time.Date(2026, 2, 13, 15, 45, 0, 0, time.UTC)}
-	guardA, err := NewRemoteAccessGuard(clk, audit.NewInMemoryStore(), []string{"127.0.0.1/32"})
+	guardA, err := NewRemoteAccessGuard(clk, NewAuditStore(db), []string{"127.0.0.1/32"})
 	if err != nil {
 		t.Fatalf("new remote access guard err: %v", err)
 	}
@@ -855,7 +1607,7 @@ func TestPostgresRemoteAccessActivityPersistenceAcrossRestart(t *testing.T) {
 		t.Fatalf("expected trusted request to pass, got code=%d", rr.Code)
 	}
 
-	guardB, err := NewRemoteAccessGuard(clk, audit.NewInMemoryStore(), []string{"127.0.0.1/32"})
+	guardB, err := NewRemoteAccessGuard(clk, NewAuditStore(db), []string{"127.0.0.1/32"})
 	if err != nil {
 		t.Fatalf("new remote access guard err: %v", err)
 	}
@@ -944,3 +1696,1709 @@ func TestPostgresWageringPersistenceAcrossRestart(t *testing.T) {
 		t.Fatalf("expected settled status after replay, got=%v", replayedSettle.Wager.GetStatus())
 	}
 }
+
+type recordingOutboxPublisher struct {
+	fail   bool
+	events []OutboxEvent
+}
+
+func (r *recordingOutboxPublisher) Publish(_ context.Context, ev OutboxEvent) error {
+	if r.fail {
+		return errors.New("bus unavailable")
+	}
+	r.events = append(r.events, ev)
+	return nil
+}
+
+func TestPostgresOutboxRecordsAndDispatchesDomainEvents(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 16, 14, 0, 0, 0, time.UTC)}
+	ctx := context.Background()
+
+	ledgerSvc := NewLedgerService(clk, db)
+	dep, err := ledgerSvc.Deposit(ctx, &rgsv1.DepositRequest{
+		Meta:      meta("acct-outbox", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "idem-pg-outbox-dep-1"),
+		AccountId: "acct-outbox",
+		Amount:    &rgsv1.Money{AmountMinor: 500, Currency: "USD"},
+	})
+	if err != nil || dep.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("deposit failed: err=%v meta=%+v", err, dep.GetMeta())
+	}
+	wageringSvc := NewWageringService(clk, db)
+	placeReq := &rgsv1.PlaceWagerRequest{
+		Meta:     meta("player-outbox", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "idem-pg-outbox-wager-1"),
+		PlayerId: "player-outbox",
+		GameId:   "game-1",
+		Stake:    &rgsv1.Money{AmountMinor: 100, Currency: "USD"},
+	}
+	placed, err := wageringSvc.PlaceWager(ctx, placeReq)
+	if err != nil || placed.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("place wager failed: err=%v meta=%+v", err, placed.GetMeta())
+	}
+	if _, err := NewWageringService(clk, db).PlaceWager(ctx, placeReq); err != nil {
+		t.Fatalf("replay place wager err: %v", err)
+	}
+
+	failing := &recordingOutboxPublisher{fail: true}
+	if _, err := NewOutboxDispatcher(db, failing).DispatchBatch(ctx, 10); err == nil {
+		t.Fatalf("expected dispatch error from failing publisher")
+	}
+	var attempts int
+	var lastError string
+	if err := db.QueryRowContext(ctx, `SELECT attempts, last_error FROM outbox_events ORDER BY event_id ASC LIMIT 1`).Scan(&attempts, &lastError); err != nil {
+		t.Fatalf("query failed outbox row: %v", err)
+	}
+	if attempts != 1 || lastError != "bus unavailable" {
+		t.Fatalf("expected recorded failure, got attempts=%d last_error=%q", attempts, lastError)
+	}
+	if _, err := db.ExecContext(ctx, `UPDATE outbox_events SET next_attempt_at = NOW()`); err != nil {
+		t.Fatalf("reset retry schedule: %v", err)
+	}
+
+	pub := &recordingOutboxPublisher{}
+	published, err := NewOutboxDispatcher(db, pub).DispatchBatch(ctx, 10)
+	if err != nil {
+		t.Fatalf("dispatch err: %v", err)
+	}
+	if published != 2 || len(pub.events) != 2 {
+		t.Fatalf("expected deposit and wager events once each, got=%d", published)
+	}
+	if pub.events[0].EventType != "ledger.deposit" || pub.events[1].EventType != "wager.placed" {
+		t.Fatalf("unexpected event order: %s, %s", pub.events[0].EventType, pub.events[1].EventType)
+	}
+	if pub.events[1].AggregateID != placed.Wager.GetWagerId() {
+		t.Fatalf("expected wager aggregate id %s, got=%s", placed.Wager.GetWagerId(), pub.events[1].AggregateID)
+	}
+
+	again, err := NewOutboxDispatcher(db, pub).DispatchBatch(ctx, 10)
+	if err != nil || again != 0 {
+		t.Fatalf("expected no pending events after dispatch, got=%d err=%v", again, err)
+	}
+
+	if _, err := ledgerSvc.Deposit(ctx, &rgsv1.DepositRequest{
+		Meta:      meta("acct-outbox", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "idem-pg-outbox-dep-2"),
+		AccountId: "acct-outbox",
+		Amount:    &rgsv1.Money{AmountMinor: 100, Currency: "USD"},
+	}); err != nil {
+		t.Fatalf("second deposit err: %v", err)
+	}
+	dispatcher := NewOutboxDispatcher(db, pub)
+	if _, err := dispatcher.DispatchJob(10)(ctx, ""); err != nil {
+		t.Fatalf("dispatch job err: %v", err)
+	}
+	events := auditEvents(dispatcher.AuditStore)
+	if len(events) != 1 || events[0].Action != "outbox_dispatch" || events[0].ActorID != "system" {
+		t.Fatalf("expected one system outbox_dispatch audit event, got=%+v", events)
+	}
+	var summary workerAuditSummary
+	if err := json.Unmarshal(events[0].After, &summary); err != nil {
+		t.Fatalf("decode outbox summary: %v", err)
+	}
+	if summary.Affected != 1 || summary.RangeField != "created_at" || summary.RangeFrom == "" {
+		t.Fatalf("unexpected outbox summary: %+v", summary)
+	}
+}
+
+func TestPostgresOutboxDeadLettersAndAdmitsOneDispatcher(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+	ctx := context.Background()
+
+	if _, err := db.ExecContext(ctx, `
+INSERT INTO outbox_events (aggregate_type, aggregate_id, event_type, payload)
+VALUES ('wager', 'wager-1', 'wager.placed', '{}'::jsonb), ('wager', 'wager-1', 'wager.settled', '{}'::jsonb)
+`); err != nil {
+		t.Fatalf("seed outbox events: %v", err)
+	}
+
+	// A dispatcher holding the dispatch lock keeps any other from running.
+	holder, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatalf("begin: %v", err)
+	}
+	if _, err := holder.ExecContext(ctx, `SELECT pg_advisory_xact_lock($1)`, outboxDispatchLockKey); err != nil {
+		t.Fatalf("take dispatch lock: %v", err)
+	}
+	pub := &recordingOutboxPublisher{}
+	if n, err := NewOutboxDispatcher(db, pub).DispatchBatch(ctx, 10); n != 0 || err != nil || len(pub.events) != 0 {
+		t.Fatalf("expected a second dispatcher to publish nothing, got=%d err=%v", n, err)
+	}
+	_ = holder.Rollback()
+
+	failing := NewOutboxDispatcher(db, &recordingOutboxPublisher{fail: true})
+	failing.SetMaxAttempts(2)
+	if _, err := failing.DispatchBatch(ctx, 10); err == nil {
+		t.Fatalf("expected the first failure to be retried")
+	}
+	if _, err := db.ExecContext(ctx, `UPDATE outbox_events SET next_attempt_at = NOW()`); err != nil {
+		t.Fatalf("reset retry schedule: %v", err)
+	}
+	// The head event reaches its last attempt and is dead-lettered, and the
+	// event behind it gets its first failure.
+	if _, err := failing.DispatchBatch(ctx, 10); err == nil {
+		t.Fatalf("expected the event behind the dead letter to be tried")
+	}
+	var dead int
+	if err := db.QueryRowContext(ctx, `SELECT COUNT(*) FROM outbox_events WHERE dead_lettered_at IS NOT NULL AND event_type = 'wager.placed' AND attempts = 2 AND last_error = 'bus unavailable'`).Scan(&dead); err != nil {
+		t.Fatalf("count dead letters: %v", err)
+	}
+	if dead != 1 {
+		t.Fatalf("expected the head event dead-lettered, got=%d", dead)
+	}
+
+	if _, err := db.ExecContext(ctx, `UPDATE outbox_events SET next_attempt_at = NOW()`); err != nil {
+		t.Fatalf("reset retry schedule: %v", err)
+	}
+	dispatcher := NewOutboxDispatcher(db, pub)
+	if _, err := dispatcher.DispatchJob(10)(ctx, ""); err != nil {
+		t.Fatalf("dispatch job err: %v", err)
+	}
+	if len(pub.events) != 1 || pub.events[0].EventType != "wager.settled" {
+		t.Fatalf("expected only the live event published, got=%+v", pub.events)
+	}
+}
+
+func TestPostgresWagerSettlementSweepPersistsEscalation(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	start := time.Date(2026, 2, 16, 15, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+	svcA := NewWageringService(ledgerFixedClock{now: start}, db)
+	w := placeTestWager(t, svcA, "player-sla", "idem-pg-sla-1")
+
+	svcB := NewWageringService(ledgerFixedClock{now: start.Add(20 * time.Minute)}, db)
+	svcB.SetSettlementPolicy(WagerSettlementPolicy{SLA: 10 * time.Minute})
+	escalated, _, err := svcB.SweepOverdueWagers(ctx)
+	if err != nil || escalated != 1 {
+		t.Fatalf("expected one escalation, got=%d err=%v", escalated, err)
+	}
+
+	svcC := NewWageringService(ledgerFixedClock{now: start.Add(25 * time.Minute)}, db)
+	svcC.SetSettlementPolicy(WagerSettlementPolicy{SLA: 10 * time.Minute})
+	listed, _ := svcC.ListOverdueWagers(ctx, &rgsv1.ListOverdueWagersRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")})
+	if len(listed.Wagers) != 1 || listed.Wagers[0].Wager.GetWagerId() != w.WagerId || !listed.Wagers[0].Escalated {
+		t.Fatalf("expected persisted escalation after restart, got=%+v", listed.Wagers)
+	}
+	if again, _, _ := svcC.SweepOverdueWagers(ctx); again != 0 {
+		t.Fatalf("expected no repeat escalation after restart, got=%d", again)
+	}
+
+	var events int
+	if err := db.QueryRowContext(ctx, `SELECT COUNT(*) FROM outbox_events WHERE event_type = 'wager.settlement_overdue' AND aggregate_id = $1`, w.WagerId).Scan(&events); err != nil {
+		t.Fatalf("count outbox events: %v", err)
+	}
+	if events != 1 {
+		t.Fatalf("expected one settlement_overdue outbox event, got=%d", events)
+	}
+}
+
+func TestPostgresSessionSummaryPersistsActivityAcrossRestart(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	start := time.Date(2026, 2, 17, 9, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+	sessionsA := NewSessionsService(ledgerFixedClock{now: start}, db)
+	wagering := NewWageringService(ledgerFixedClock{now: start}, db)
+	wagering.Sessions = sessionsA
+	sess, _ := sessionsA.StartSession(ctx, &rgsv1.StartSessionRequest{
+		Meta:     meta("player-rg", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""),
+		PlayerId: "player-rg",
+		DeviceId: "device-rg",
+	})
+	placeTestWager(t, wagering, "player-rg", "idem-pg-rg-1")
+	placeTestWager(t, wagering, "player-rg", "idem-pg-rg-2")
+
+	sessionsB := NewSessionsService(ledgerFixedClock{now: start.Add(10 * time.Minute)}, db)
+	resp, _ := sessionsB.GetSessionSummary(ctx, &rgsv1.GetSessionSummaryRequest{
+		Meta:      meta("player-rg", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""),
+		SessionId: sess.Session.GetSessionId(),
+	})
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("summary failed: %+v", resp.Meta)
+	}
+	if resp.CurrentSession.GetWagerCount() != 2 || resp.CurrentSession.GetTotalWagered().GetAmountMinor() != 200 || resp.CurrentSession.GetTimePlayedSeconds() != 600 {
+		t.Fatalf("unexpected current session totals after restart: %+v", resp.CurrentSession)
+	}
+	if got := resp.TrailingPeriods[0].Totals; got.GetWagerCount() != 2 || got.GetTimePlayedSeconds() != 600 {
+		t.Fatalf("unexpected 1d totals after restart: %+v", got)
+	}
+}
+
+func TestPostgresUnresolvedTransferTimeoutReversal(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	start := time.Date(2026, 2, 18, 9, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+	svcA := NewLedgerService(ledgerFixedClock{now: start}, db)
+	svcA.SetTransferAckTimeout(time.Minute)
+	td := seedDeviceTransfer(t, svcA, "acct-pg-td", "device-pg-td", "idem-pg-td-1", 600)
+
+	svcB := NewLedgerService(ledgerFixedClock{now: start.Add(2 * time.Minute)}, db)
+	list, _ := svcB.ListUnresolvedTransfers(ctx, &rgsv1.ListUnresolvedTransfersRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), AccountId: "acct-pg-td"})
+	if len(list.Transfers) != 1 || list.Transfers[0].TransferId != td.TransferId {
+		t.Fatalf("expected persisted open transfer, got=%+v", list.Transfers)
+	}
+	reversed, err := svcB.ReverseExpiredTransfers(ctx)
+	if err != nil || reversed != 1 {
+		t.Fatalf("expected one timeout reversal, got=%d err=%v", reversed, err)
+	}
+	bal, _ := svcB.GetBalance(ctx, &rgsv1.GetBalanceRequest{Meta: meta("acct-pg-td", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""), AccountId: "acct-pg-td"})
+	if bal.AvailableBalance.GetAmountMinor() != 600 {
+		t.Fatalf("expected escrow returned to account, got=%d", bal.AvailableBalance.GetAmountMinor())
+	}
+	var status string
+	if err := db.QueryRowContext(ctx, `SELECT status::text FROM ledger_transactions WHERE transaction_id = (SELECT transaction_id FROM cashless_unresolved_transfers WHERE transfer_id = $1)`, td.TransferId).Scan(&status); err != nil {
+		t.Fatalf("load original transaction status: %v", err)
+	}
+	if status != "reversed" {
+		t.Fatalf("expected original transfer transaction reversed, got=%s", status)
+	}
+	resp, _ := svcB.ResolveTransfer(ctx, &rgsv1.ResolveTransferRequest{
+		Meta:       meta("svc-g2s", rgsv1.ActorType_ACTOR_TYPE_SERVICE, ""),
+		TransferId: td.TransferId,
+		Action:     rgsv1.TransferResolutionAction_TRANSFER_RESOLUTION_ACTION_ACKNOWLEDGE,
+	})
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected late acknowledgment rejected, got=%v", resp.Meta.GetResultCode())
+	}
+}
+
+func TestPostgresLedgerVoidTransactionAcrossRestart(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	ctx := context.Background()
+	now := time.Date(2026, 2, 19, 10, 0, 0, 0, time.UTC)
+	svcA := NewLedgerService(ledgerFixedClock{now: now}, db)
+	dep, _ := svcA.Deposit(ctx, &rgsv1.DepositRequest{
+		Meta:      meta("acct-pg-void", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "dep-pg-void"),
+		AccountId: "acct-pg-void",
+		Amount:    &rgsv1.Money{AmountMinor: 900, Currency: "USD"},
+	})
+	if dep.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("seed deposit failed: %+v", dep.Meta)
+	}
+
+	svcB := NewLedgerService(ledgerFixedClock{now: now.Add(time.Minute)}, db)
+	first := voidTx(svcB, rgsv1.ActorType_ACTOR_TYPE_OPERATOR, dep.Transaction.TransactionId)
+	if first.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || first.AvailableBalance.GetAmountMinor() != 0 {
+		t.Fatalf("void failed: %+v", first)
+	}
+	again := voidTx(svcA, rgsv1.ActorType_ACTOR_TYPE_OPERATOR, dep.Transaction.TransactionId)
+	if again.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || again.VoidTransaction.GetTransactionId() != first.VoidTransaction.GetTransactionId() {
+		t.Fatalf("expected other replica to return persisted void, got=%+v", again)
+	}
+
+	var status, voids string
+	if err := db.QueryRowContext(ctx, `SELECT status::text FROM ledger_transactions WHERE transaction_id = $1`, dep.Transaction.TransactionId).Scan(&status); err != nil {
+		t.Fatalf("load original status: %v", err)
+	}
+	if err := db.QueryRowContext(ctx, `SELECT voids_transaction_id FROM ledger_transactions WHERE transaction_id = $1`, first.VoidTransaction.TransactionId).Scan(&voids); err != nil {
+		t.Fatalf("load void reference: %v", err)
+	}
+	if status != "reversed" || voids != dep.Transaction.TransactionId {
+		t.Fatalf("unexpected persisted void state: status=%s voids=%s", status, voids)
+	}
+}
+
+func TestPostgresLedgerBankReconciliationAcrossRestart(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	ctx := context.Background()
+	now := time.Date(2026, 2, 19, 10, 0, 0, 0, time.UTC)
+	svcA := NewLedgerService(ledgerFixedClock{now: now}, db)
+	for _, auth := range []string{"AUTH-PG-1", "AUTH-PG-2"} {
+		dep, _ := svcA.Deposit(ctx, &rgsv1.DepositRequest{
+			Meta:            meta("acct-pg-recon", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "dep-"+auth),
+			AccountId:       "acct-pg-recon",
+			Amount:          &rgsv1.Money{AmountMinor: 400, Currency: "USD"},
+			AuthorizationId: auth,
+		})
+		if dep.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("seed deposit failed: %+v", dep.Meta)
+		}
+	}
+	const statement = "booking_date,amount,currency,reference\n2026-02-19,4.00,USD,AUTH-PG-1\n2026-02-19,7.00,USD,STRAY\n"
+	first := importStatement(svcA, rgsv1.BankStatementFormat_BANK_STATEMENT_FORMAT_CSV, statement)
+	if first.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || first.EntriesMatched != 1 || first.ExceptionsOpened != 2 {
+		t.Fatalf("unexpected import: %+v", first)
+	}
+
+	svcB := NewLedgerService(ledgerFixedClock{now: now.Add(time.Hour)}, db)
+	if dup := importStatement(svcB, rgsv1.BankStatementFormat_BANK_STATEMENT_FORMAT_CSV, statement); dup.Meta.GetDenialReason() != "statement already imported" {
+		t.Fatalf("expected duplicate rejected by other replica, got=%+v", dup.Meta)
+	}
+	second := importStatement(svcB, rgsv1.BankStatementFormat_BANK_STATEMENT_FORMAT_CSV, "booking_date,amount,currency,reference\n2026-02-20,4.00,USD,AUTH-PG-2\n")
+	if second.EntriesMatched != 1 || second.ExceptionsOpened != 0 {
+		t.Fatalf("unexpected second import: %+v", second)
+	}
+	open, _ := svcB.ListReconciliationExceptions(ctx, &rgsv1.ListReconciliationExceptionsRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), BankingDay: "2026-02-19", Status: rgsv1.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_STATUS_OPEN})
+	if len(open.Exceptions) != 1 || open.Exceptions[0].Entry.GetReference() != "STRAY" {
+		t.Fatalf("expected only the stray entry open, got=%+v", open)
+	}
+	resolved, _ := svcB.ResolveReconciliationException(ctx, &rgsv1.ResolveReconciliationExceptionRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), ExceptionId: open.Exceptions[0].ExceptionId, Note: "bank error"})
+	if resolved.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("resolve failed: %+v", resolved.Meta)
+	}
+	report := reconciliationReport(t, svcA, "2026-02-19")
+	if report.Status != rgsv1.ReconciliationDayStatus_RECONCILIATION_DAY_STATUS_RECONCILED || report.StatementEntries != 2 || report.LedgerTransactions != 2 || report.ResolvedExceptions != 1 {
+		t.Fatalf("unexpected persisted report: %+v", report)
+	}
+}
+
+func TestPostgresLedgerCurrencyExchange(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	ctx := context.Background()
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 20, 12, 0, 0, 0, time.UTC)}
+	cfg := NewConfigService(clk, db)
+	applyConfigValue(t, cfg, FXRateConfigNamespace, "USD/EUR", "0.9215")
+	svcA := NewLedgerService(clk, db)
+	svcA.SetFXRateSource(cfg)
+	_, _ = svcA.Deposit(ctx, &rgsv1.DepositRequest{
+		Meta:      meta("acct-pg-fx", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "seed-pg-fx"),
+		AccountId: "acct-pg-fx",
+		Amount:    &rgsv1.Money{AmountMinor: 5000, Currency: "USD"},
+	})
+	req := &rgsv1.ExchangeCurrencyRequest{
+		Meta:       meta("acct-pg-fx", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "fx-pg-1"),
+		AccountId:  "acct-pg-fx",
+		Amount:     &rgsv1.Money{AmountMinor: 1001, Currency: "USD"},
+		ToCurrency: "EUR",
+	}
+	resp, _ := svcA.ExchangeCurrency(ctx, req)
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || resp.ExchangedAmount.GetAmountMinor() != 922 {
+		t.Fatalf("exchange failed: %+v", resp)
+	}
+
+	svcB := NewLedgerService(clk, db)
+	svcB.SetFXRateSource(cfg)
+	replay, _ := svcB.ExchangeCurrency(ctx, req)
+	if replay.Transaction.GetTransactionId() != resp.Transaction.GetTransactionId() {
+		t.Fatalf("expected persisted idempotent replay, got=%+v", replay)
+	}
+	bal, _ := svcB.GetBalance(ctx, &rgsv1.GetBalanceRequest{Meta: meta("acct-pg-fx", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""), AccountId: "acct-pg-fx:EUR"})
+	if bal.AvailableBalance.GetAmountMinor() != 922 || bal.AvailableBalance.GetCurrency() != "EUR" {
+		t.Fatalf("unexpected EUR sub-balance: %+v", bal.AvailableBalance)
+	}
+	var fxUSD, fxEUR int64
+	if err := db.QueryRowContext(ctx, `SELECT available_balance_minor FROM ledger_accounts WHERE account_id = 'fx_gain_loss:USD'`).Scan(&fxUSD); err != nil {
+		t.Fatalf("load fx USD account: %v", err)
+	}
+	if err := db.QueryRowContext(ctx, `SELECT available_balance_minor FROM ledger_accounts WHERE account_id = 'fx_gain_loss:EUR'`).Scan(&fxEUR); err != nil {
+		t.Fatalf("load fx EUR account: %v", err)
+	}
+	if fxUSD != 1001 || fxEUR != -922 {
+		t.Fatalf("unexpected fx gain/loss positions: usd=%d eur=%d", fxUSD, fxEUR)
+	}
+}
+
+func TestPostgresEFTLockoutAdminResetAcrossRestart(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	ctx := context.Background()
+	now := time.Now().UTC()
+	svcA := NewLedgerService(ledgerFixedClock{now: now}, db)
+	svcA.SetEFTFraudPolicy(2, 15*time.Minute)
+	_, _ = svcA.Deposit(ctx, &rgsv1.DepositRequest{
+		Meta:      meta("acct-pg-eft", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "seed-pg-eft"),
+		AccountId: "acct-pg-eft",
+		Amount:    &rgsv1.Money{AmountMinor: 1, Currency: "USD"},
+	})
+	_, _ = svcA.Withdraw(ctx, &rgsv1.WithdrawRequest{
+		Meta:      meta("acct-pg-eft", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "drain-pg-eft"),
+		AccountId: "acct-pg-eft",
+		Amount:    &rgsv1.Money{AmountMinor: 1, Currency: "USD"},
+	})
+	lockEFTAccount(t, svcA, "acct-pg-eft", 2)
+
+	svcB := NewLedgerService(ledgerFixedClock{now: now}, db)
+	list, _ := svcB.ListEFTLockouts(ctx, &rgsv1.ListEFTLockoutsRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), LockedOnly: true})
+	if len(list.Lockouts) != 1 || list.Lockouts[0].AccountId != "acct-pg-eft" || list.Lockouts[0].FailedAttempts != 2 {
+		t.Fatalf("expected persisted lockout, got=%+v", list.Lockouts)
+	}
+	reset, _ := svcB.ResetEFTLockout(ctx, &rgsv1.ResetEFTLockoutRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), AccountId: "acct-pg-eft", Reason: "support override"})
+	if reset.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("reset failed: %+v", reset.Meta)
+	}
+	got, _ := svcA.GetEFTLockout(ctx, &rgsv1.GetEFTLockoutRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), AccountId: "acct-pg-eft"})
+	if got.Lockout.GetLocked() || got.Lockout.GetFailedAttempts() != 0 {
+		t.Fatalf("expected cleared lockout visible to other replica, got=%+v", got.Lockout)
+	}
+}
+
+func TestPostgresEventsClockSkewAcrossRestart(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	now := time.Date(2026, 2, 21, 9, 0, 0, 0, time.UTC)
+	clk := ledgerFixedClock{now: now}
+	ctx := context.Background()
+
+	svcA := NewEventsService(clk, db)
+	svcA.SetClockSkewThreshold(10*time.Second, 2)
+	submitSkewedMeter(t, svcA, "m-pg-skew-1", "eq-pg-skew", now.Add(-time.Minute))
+
+	svcB := NewEventsService(clk, db)
+	svcB.SetClockSkewThreshold(10*time.Second, 2)
+	m := submitSkewedMeter(t, svcB, "m-pg-skew-2", "eq-pg-skew", now.Add(-time.Minute))
+	if m.GetClockSkewMs() != 60000 {
+		t.Fatalf("expected skew annotated on meter, got=%d", m.GetClockSkewMs())
+	}
+
+	report, _ := svcA.GetClockSkewReport(ctx, &rgsv1.GetClockSkewReportRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")})
+	if len(report.Devices) != 1 || report.Devices[0].Samples != 2 || !report.Devices[0].Chronic {
+		t.Fatalf("expected durable chronic skew state, got=%+v", report.Devices)
+	}
+	events, _ := svcA.ListEvents(ctx, &rgsv1.ListEventsRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), EquipmentId: "eq-pg-skew"})
+	if len(events.Events) != 1 || events.Events[0].EventCode != ClockSkewEventCode {
+		t.Fatalf("expected persisted maintenance event, got=%+v", events.Events)
+	}
+	meters, _ := svcA.ListMeters(ctx, &rgsv1.ListMetersRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), EquipmentId: "eq-pg-skew"})
+	if len(meters.Meters) != 2 || meters.Meters[0].GetClockSkewMs() != 60000 {
+		t.Fatalf("expected skew persisted on meter rows, got=%+v", meters.Meters)
+	}
+}
+
+func TestPostgresWageringVoidRefundAcrossRestart(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 22, 14, 0, 0, 0, time.UTC)}
+	ctx := context.Background()
+
+	ledgerA := NewLedgerService(clk, db)
+	svcA := NewWageringService(clk, db)
+	svcA.Ledger = ledgerA
+	svcA.SetLedgerIntegration(true)
+	if dep, _ := ledgerA.Deposit(ctx, &rgsv1.DepositRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "seed-pg-void"), AccountId: "player-pg-void", Amount: &rgsv1.Money{AmountMinor: 1000, Currency: "USD"}}); dep.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("seed deposit: %+v", dep.Meta)
+	}
+	placed, _ := svcA.PlaceWager(ctx, &rgsv1.PlaceWagerRequest{
+		Meta:     meta("player-pg-void", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "place-pg-void"),
+		PlayerId: "player-pg-void",
+		GameId:   "game-1",
+		Stake:    &rgsv1.Money{AmountMinor: 450, Currency: "USD"},
+	})
+	req := &rgsv1.VoidWagerRequest{
+		Meta:    meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "void-pg"),
+		WagerId: placed.Wager.GetWagerId(),
+		Reason:  "game malfunction",
+	}
+	first, _ := svcA.VoidWager(ctx, req)
+	if first.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("void wager failed: %+v", first.Meta)
+	}
+
+	ledgerB := NewLedgerService(clk, db)
+	svcB := NewWageringService(clk, db)
+	svcB.Ledger = ledgerB
+	replay, _ := svcB.VoidWager(ctx, req)
+	if replay.RefundTransaction.GetTransactionId() != first.RefundTransaction.GetTransactionId() || replay.Wager.GetStatus() != rgsv1.WagerStatus_WAGER_STATUS_VOIDED {
+		t.Fatalf("expected durable void replay, got=%+v", replay)
+	}
+	// A void under a new idempotency key finds the wager already voided.
+	again, _ := svcB.VoidWager(ctx, &rgsv1.VoidWagerRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "void-pg-2"), WagerId: placed.Wager.GetWagerId(), Reason: "retry"})
+	if again.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected second void rejected, got=%+v", again.Meta)
+	}
+	bal, _ := ledgerB.GetBalance(ctx, &rgsv1.GetBalanceRequest{Meta: meta("player-pg-void", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""), AccountId: "player-pg-void"})
+	if bal.AvailableBalance.GetAmountMinor() != 1000 {
+		t.Fatalf("expected single refund credited, got=%d", bal.AvailableBalance.GetAmountMinor())
+	}
+	var status, refundTx string
+	if err := db.QueryRowContext(ctx, `SELECT status, refund_transaction_id FROM wagers WHERE wager_id = $1`, placed.Wager.GetWagerId()).Scan(&status, &refundTx); err != nil {
+		t.Fatalf("read voided wager: %v", err)
+	}
+	if status != "voided" || refundTx != first.RefundTransaction.GetTransactionId() {
+		t.Fatalf("unexpected persisted wager: status=%s refund=%s", status, refundTx)
+	}
+}
+
+func TestPostgresWageringListWagersFromDB(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	start := time.Date(2026, 2, 22, 9, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+	svcA := NewWageringService(ledgerFixedClock{now: start}, db)
+	seeded := seedListWagers(t, svcA, start)
+
+	svcB := NewWageringService(ledgerFixedClock{now: start}, db)
+	op := meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+	page1, _ := svcB.ListWagers(ctx, &rgsv1.ListWagersRequest{Meta: op, GameId: "game-a", PageSize: 1})
+	if page1.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || len(page1.Wagers) != 1 || page1.Wagers[0].WagerId != seeded[2].WagerId || page1.NextPageToken != "1" {
+		t.Fatalf("unexpected first db page: %+v", page1)
+	}
+	page2, _ := svcB.ListWagers(ctx, &rgsv1.ListWagersRequest{Meta: op, GameId: "game-a", PageSize: 1, PageToken: page1.NextPageToken})
+	if len(page2.Wagers) != 1 || page2.Wagers[0].WagerId != seeded[0].WagerId {
+		t.Fatalf("unexpected second db page: %+v", page2)
+	}
+	settled, _ := svcB.ListWagers(ctx, &rgsv1.ListWagersRequest{
+		Meta:     op,
+		Status:   rgsv1.WagerStatus_WAGER_STATUS_SETTLED,
+		FromTime: start.Add(30 * time.Minute).Format(time.RFC3339),
+	})
+	if len(settled.Wagers) != 1 || settled.Wagers[0].WagerId != seeded[1].WagerId {
+		t.Fatalf("expected settled wager in range, got=%+v", settled)
+	}
+}
+
+func TestPostgresWageringLedgerIntegrationAcrossRestart(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 22, 15, 0, 0, 0, time.UTC)}
+	ctx := context.Background()
+
+	ledgerA := NewLedgerService(clk, db)
+	dep, _ := ledgerA.Deposit(ctx, &rgsv1.DepositRequest{
+		Meta:      meta("player-pg-li", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "dep-pg-li"),
+		AccountId: "player-pg-li",
+		Amount:    &rgsv1.Money{AmountMinor: 1000, Currency: "USD"},
+	})
+	if dep.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("deposit failed: %+v", dep.Meta)
+	}
+	svcA := NewWageringService(clk, db)
+	svcA.Ledger = ledgerA
+	svcA.SetLedgerIntegration(true)
+	placed, _ := svcA.PlaceWager(ctx, &rgsv1.PlaceWagerRequest{
+		Meta:     meta("player-pg-li", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "place-pg-li"),
+		PlayerId: "player-pg-li",
+		GameId:   "game-1",
+		Stake:    &rgsv1.Money{AmountMinor: 400, Currency: "USD"},
+	})
+	if placed.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("place wager failed: %+v", placed.Meta)
+	}
+	settled, _ := svcA.SettleWager(ctx, &rgsv1.SettleWagerRequest{
+		Meta:       meta("svc-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, "settle-pg-li"),
+		WagerId:    placed.Wager.GetWagerId(),
+		Payout:     &rgsv1.Money{AmountMinor: 900, Currency: "USD"},
+		OutcomeRef: "outcome-pg-li",
+	})
+	if settled.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("settle wager failed: %+v", settled.Meta)
+	}
+
+	ledgerB := NewLedgerService(clk, db)
+	bal, _ := ledgerB.GetBalance(ctx, &rgsv1.GetBalanceRequest{Meta: meta("player-pg-li", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""), AccountId: "player-pg-li"})
+	if bal.AvailableBalance.GetAmountMinor() != 1500 {
+		t.Fatalf("expected stake debited and payout credited, got=%d", bal.AvailableBalance.GetAmountMinor())
+	}
+	var stakeTx, payoutTx string
+	if err := db.QueryRowContext(ctx, `SELECT stake_transaction_id, payout_transaction_id FROM wagers WHERE wager_id = $1`, placed.Wager.GetWagerId()).Scan(&stakeTx, &payoutTx); err != nil {
+		t.Fatalf("read settled wager: %v", err)
+	}
+	if stakeTx != placed.Wager.GetStakeTransactionId() || payoutTx != settled.Wager.GetPayoutTransactionId() {
+		t.Fatalf("unexpected persisted ledger references: stake=%s payout=%s", stakeTx, payoutTx)
+	}
+	var postings int
+	if err := db.QueryRowContext(ctx, `SELECT COUNT(*) FROM ledger_postings WHERE transaction_id IN ($1, $2)`, stakeTx, payoutTx).Scan(&postings); err != nil {
+		t.Fatalf("count wager postings: %v", err)
+	}
+	if postings != 4 {
+		t.Fatalf("expected balanced postings for stake and payout, got=%d", postings)
+	}
+}
+
+func TestPostgresJobSchedulerLeaderElectionAndHistory(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	start := time.Date(2026, 2, 22, 16, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+	calls := map[string]int{}
+	newScheduler := func(instance string, now time.Time) *JobScheduler {
+		s := NewJobScheduler(ledgerFixedClock{now: now}, instance, db)
+		s.SetLeaseTTL(time.Minute)
+		if err := s.Register(JobSpec{Name: "cleanup", Schedule: Every(10 * time.Minute), Run: func(context.Context, string) (string, error) {
+			calls[instance]++
+			return "cleaned", nil
+		}}); err != nil {
+			t.Fatalf("register job: %v", err)
+		}
+		return s
+	}
+
+	nodeA := newScheduler("node-a", start)
+	nodeB := newScheduler("node-b", start)
+	due := start.Add(10 * time.Minute)
+	nodeA.Clock = ledgerFixedClock{now: due}
+	nodeB.Clock = ledgerFixedClock{now: due}
+	if ran, err := nodeA.RunDue(ctx); ran != 1 || err != nil {
+		t.Fatalf("expected leader to run job, ran=%d err=%v", ran, err)
+	}
+	if ran, err := nodeB.RunDue(ctx); ran != 0 || err != nil {
+		t.Fatalf("expected follower to skip, ran=%d err=%v", ran, err)
+	}
+	if _, err := nodeB.ScheduleOnce(ctx, "cleanup", due, "adhoc"); err != nil {
+		t.Fatalf("queue one-shot job: %v", err)
+	}
+
+	// Once node-a's lease lapses node-b takes over, resuming from the stored
+	// next run rather than rerunning the job immediately.
+	takeover := due.Add(2 * time.Minute)
+	nodeB.Clock = ledgerFixedClock{now: takeover}
+	if ran, err := nodeB.RunDue(ctx); ran != 1 || err != nil {
+		t.Fatalf("expected new leader to run only the one-shot job, ran=%d err=%v", ran, err)
+	}
+	if calls["node-a"] != 1 || calls["node-b"] != 1 {
+		t.Fatalf("unexpected executions: %v", calls)
+	}
+
+	restarted := newScheduler("node-c", takeover)
+	jobs, err := restarted.listJobs(ctx)
+	if err != nil || len(jobs) != 1 || jobs[0].NextRunAt != due.Add(10*time.Minute).Format(time.RFC3339Nano) || jobs[0].LastStatus != rgsv1.JobRunStatus_JOB_RUN_STATUS_SUCCEEDED {
+		t.Fatalf("expected persisted job state, jobs=%+v err=%v", jobs, err)
+	}
+	runs, next, err := restarted.listRuns(ctx, "cleanup", 10, 0)
+	if err != nil || len(runs) != 2 || next != "" || runs[0].InstanceId != "node-b" || runs[0].JobId == "" || runs[1].InstanceId != "node-a" {
+		t.Fatalf("unexpected persisted run history: runs=%+v err=%v", runs, err)
+	}
+}
+
+func TestPostgresJobSchedulerRenewsLeaseWhileJobRuns(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	ctx := context.Background()
+	started := make(chan struct{})
+	var jobErr error
+	nodeA := NewJobScheduler(nil, "node-a", db)
+	nodeA.SetLeaseTTL(300 * time.Millisecond)
+	if err := nodeA.Register(JobSpec{Name: "long", Run: func(runCtx context.Context, _ string) (string, error) {
+		close(started)
+		select {
+		case <-runCtx.Done():
+			jobErr = runCtx.Err()
+			return "", jobErr
+		case <-time.After(5 * time.Second):
+			return "finished", nil
+		}
+	}}); err != nil {
+		t.Fatalf("register job: %v", err)
+	}
+	nodeB := NewJobScheduler(nil, "node-b", db)
+	nodeB.SetLeaseTTL(300 * time.Millisecond)
+	if _, err := nodeA.ScheduleOnce(ctx, "long", time.Time{}, ""); err != nil {
+		t.Fatalf("queue one-shot job: %v", err)
+	}
+
+	result := make(chan error, 1)
+	go func() {
+		_, err := nodeA.RunDue(ctx)
+		result <- err
+	}()
+	<-started
+	time.Sleep(time.Second)
+	if leader, err := nodeB.acquireLeadership(ctx); err != nil || leader {
+		t.Fatalf("expected the running job to keep node-a's lease past its ttl, leader=%v err=%v", leader, err)
+	}
+
+	if _, err := db.ExecContext(ctx, `UPDATE scheduler_leases SET holder_id = 'node-b', expires_at = NOW() + INTERVAL '1 hour' WHERE lease_name = $1`, schedulerLeaseName); err != nil {
+		t.Fatalf("take over lease: %v", err)
+	}
+	select {
+	case err := <-result:
+		if !errors.Is(err, errSchedulerLeaseLost) || jobErr == nil {
+			t.Fatalf("expected the job canceled and its run refused, err=%v jobErr=%v", err, jobErr)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatalf("expected losing the lease to cancel the running job")
+	}
+	var runs int
+	if err := db.QueryRowContext(ctx, `SELECT COUNT(*) FROM scheduler_job_runs WHERE job_name = 'long'`).Scan(&runs); err != nil {
+		t.Fatalf("count runs: %v", err)
+	}
+	if runs != 0 {
+		t.Fatalf("expected no run recorded after the lease was lost, got=%d", runs)
+	}
+}
+
+func TestPostgresWageringGamePerformance(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	start := time.Date(2026, 2, 15, 9, 0, 0, 0, time.UTC)
+	svc := NewWageringService(ledgerFixedClock{now: start}, db)
+	seedListWagers(t, svc, start)
+
+	games, err := NewWageringService(ledgerFixedClock{now: start}, db).gamePerformance(context.Background(), nil, "", time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatalf("game performance err: %v", err)
+	}
+	if len(games) != 2 || games[0].OpenCount != 2 || games[1].ActualRtpBps != "15000" {
+		t.Fatalf("unexpected persisted game performance: %+v", games)
+	}
+}
+
+func TestPostgresPromoLiabilityByCampaign(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	start := time.Date(2026, 2, 15, 9, 0, 0, 0, time.UTC)
+	seedPromoLiability(t, NewPromotionsService(ledgerFixedClock{now: start}, db), start)
+
+	campaigns, err := NewPromotionsService(ledgerFixedClock{now: start}, db).campaignLiability(context.Background(), nil, start.Add(-time.Hour), start.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("campaign liability err: %v", err)
+	}
+	want := []promoCampaignTotals{
+		{campaignID: "spring", currency: "USD", awardsIssued: 1, issued: 300, redemptions: 1, redeemed: 100, totalIssued: 800, totalRedeemed: 300},
+		{campaignID: "winter", currency: "USD", totalIssued: 400, totalRedeemed: 400},
+	}
+	if len(campaigns) != len(want) {
+		t.Fatalf("unexpected persisted campaign liability: %+v", campaigns)
+	}
+	for i := range want {
+		if *campaigns[i] != want[i] {
+			t.Fatalf("campaign %d: got=%+v want=%+v", i, *campaigns[i], want[i])
+		}
+	}
+}
+
+func TestPostgresEFTActivityFromLedgerAndAudit(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 18, 12, 0, 0, 0, time.UTC)}
+	seedEFTActivity(t, NewLedgerService(clk, db))
+
+	svc := NewReportingService(clk, nil, nil, db)
+	entries, err := svc.eftActivityEntries(context.Background(), intervalWindow(clk.now, rgsv1.ReportInterval_REPORT_INTERVAL_LTD, "casino-1"))
+	if err != nil {
+		t.Fatalf("eft activity entries err: %v", err)
+	}
+	counts := map[string]int{}
+	for _, e := range entries {
+		counts[e.accountID+"/"+e.entryType]++
+	}
+	want := map[string]int{
+		"acct-1/deposit": 1, "acct-1/withdrawal": 1, "acct-1/transfer_to_device": 1, "acct-1/transfer_to_account": 1,
+		"acct-2/denial": 3, "acct-2/lockout": 1,
+	}
+	if len(counts) != len(want) {
+		t.Fatalf("unexpected persisted eft activity: %v", counts)
+	}
+	for k, n := range want {
+		if counts[k] != n {
+			t.Fatalf("unexpected persisted eft activity: %v", counts)
+		}
+	}
+}
+
+func TestPostgresWageringProgressivePoolSharedAcrossInstances(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 16, 10, 0, 0, 0, time.UTC)}
+	cfg := NewConfigService(clk)
+	applyConfigValue(t, cfg, WageringConfigNamespace, progressiveContributionKey("game-1"), "1000")
+
+	svcA := NewWageringService(clk, db)
+	svcA.Settings = cfg
+	svcB := NewWageringService(clk, db)
+	svcB.Settings = cfg
+	first := placeTestWager(t, svcA, "player-1", "pg-jp-place-1")
+	second := placeTestWager(t, svcA, "player-2", "pg-jp-place-2")
+
+	if resp := settleTestWager(t, svcA, first.WagerId, "pg-jp-settle-1"); resp.JackpotPool.GetAmountMinor() != 10 {
+		t.Fatalf("expected pool of 10, got=%+v", resp.JackpotPool)
+	}
+	if resp := settleTestWager(t, svcB, second.WagerId, "pg-jp-settle-2"); resp.JackpotPool.GetAmountMinor() != 20 {
+		t.Fatalf("expected pool of 20 across instances, got=%+v", resp.JackpotPool)
+	}
+	var contributions int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM jackpot_contributions WHERE game_id = 'game-1'`).Scan(&contributions); err != nil {
+		t.Fatalf("count contributions: %v", err)
+	}
+	if contributions != 2 {
+		t.Fatalf("expected 2 contribution rows, got=%d", contributions)
+	}
+}
+
+func TestPostgresLedgerVoucherRedemptionAcrossReplicas(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	svcA := NewLedgerService(ledgerFixedClock{now: now}, db)
+	svcB := NewLedgerService(ledgerFixedClock{now: now.Add(time.Minute)}, db)
+	v := issueTestVoucher(t, svcA, "PG-VOUCHER-1", 1200, now.Add(24*time.Hour))
+
+	first := redeemTestVoucher(svcB, "acct-pg-vch", "vch-pg-1", v.Code)
+	if first.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || first.AvailableBalance.GetAmountMinor() != 1200 {
+		t.Fatalf("redeem failed: %+v", first)
+	}
+	dup := redeemTestVoucher(svcA, "acct-pg-other", "vch-pg-2", v.Code)
+	if dup.Meta.GetDenialReason() != "voucher already redeemed" {
+		t.Fatalf("expected other replica to deny duplicate redemption, got=%+v", dup.Meta)
+	}
+
+	var funding int64
+	if err := db.QueryRowContext(ctx, `SELECT available_balance_minor FROM ledger_accounts WHERE account_id = 'promotional_funding'`).Scan(&funding); err != nil {
+		t.Fatalf("load promotional funding balance: %v", err)
+	}
+	if funding != -1200 {
+		t.Fatalf("expected promotional funding debited, got=%d", funding)
+	}
+	liability, _ := svcA.GetVoucherLiability(ctx, &rgsv1.GetVoucherLiabilityRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")})
+	if len(liability.Liabilities) != 1 || liability.Liabilities[0].RedeemedCount != 1 || liability.Liabilities[0].OutstandingCount != 0 {
+		t.Fatalf("unexpected persisted liability: %+v", liability.Liabilities)
+	}
+}
+
+func TestPostgresRegistryClientCertificateBindings(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	ctx := context.Background()
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	svcA := NewRegistryService(ledgerFixedClock{now: now}, db)
+	svcB := NewRegistryService(ledgerFixedClock{now: now.Add(time.Minute)}, db)
+	cert := testClientCertificate(t, "pg-bridge.internal")
+
+	bound := registerTestClientCertificate(svcA, &rgsv1.ClientCertificateBinding{San: "pg-bridge.internal", ActorId: "pg-bridge"})
+	if bound.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("register failed: %+v", bound.Meta)
+	}
+	if dup := registerTestClientCertificate(svcB, &rgsv1.ClientCertificateBinding{San: "pg-bridge.internal", ActorId: "other"}); dup.Meta.GetDenialReason() != "client certificate already bound" {
+		t.Fatalf("expected duplicate san rejected across replicas, got=%+v", dup.Meta)
+	}
+	if actor, ok, err := svcB.ActorForCertificate(ctx, cert); err != nil || !ok || actor.ID != "pg-bridge" {
+		t.Fatalf("expected binding visible on other replica, got actor=%+v ok=%v err=%v", actor, ok, err)
+	}
+
+	revoked, _ := svcB.RevokeClientCertificate(ctx, &rgsv1.RevokeClientCertificateRequest{
+		Meta:      meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		BindingId: bound.Binding.BindingId,
+		Reason:    "rotated",
+	})
+	if revoked.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("revoke failed: %+v", revoked.Meta)
+	}
+	if _, ok, _ := svcA.ActorForCertificate(ctx, cert); ok {
+		t.Fatalf("expected revoked binding to stop authenticating")
+	}
+	if rebound := registerTestClientCertificate(svcA, &rgsv1.ClientCertificateBinding{San: "pg-bridge.internal", ActorId: "pg-bridge"}); rebound.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected san reusable after revocation, got=%+v", rebound.Meta)
+	}
+	list, _ := svcA.ListClientCertificates(ctx, &rgsv1.ListClientCertificatesRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), ActorId: "pg-bridge", IncludeRevoked: true})
+	if len(list.Bindings) != 2 || list.Bindings[0].Status != rgsv1.ClientCertificateBindingStatus_CLIENT_CERTIFICATE_BINDING_STATUS_REVOKED {
+		t.Fatalf("unexpected bindings: %+v", list.Bindings)
+	}
+}
+
+func TestPostgresIdentityLoginHistory(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+	ctx := context.Background()
+
+	clk := ledgerFixedClock{now: time.Date(2026, 3, 5, 9, 0, 0, 0, time.UTC)}
+	svc := NewIdentityService(clk, "test-secret", 15*time.Minute, time.Hour, db)
+	player := &rgsv1.Actor{ActorId: "player-history-1", ActorType: rgsv1.ActorType_ACTOR_TYPE_PLAYER}
+	respSet, _ := svc.SetCredential(ctx, &rgsv1.SetCredentialRequest{
+		Meta:           meta("op-seed", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		Actor:          player,
+		CredentialHash: mustBcryptHash(t, "player-secret"),
+		Reason:         "seed history user",
+	})
+	if respSet.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected set credential ok, got=%+v", respSet.Meta)
+	}
+	for _, pin := range []string{"wrong", "player-secret"} {
+		svc.Login(ctx, &rgsv1.LoginRequest{
+			Meta:        meta(player.ActorId, player.ActorType, ""),
+			Credentials: &rgsv1.LoginRequest_Player{Player: &rgsv1.PlayerCredentials{PlayerId: player.ActorId, Pin: pin}},
+		})
+	}
+
+	list, _ := svc.ListLoginHistory(ctx, &rgsv1.ListLoginHistoryRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), Actor: player, PageSize: 1})
+	if list.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || len(list.Attempts) != 1 || list.NextPageToken == "" {
+		t.Fatalf("expected first page of one attempt, got=%+v", list)
+	}
+	if a := list.Attempts[0]; a.Result != rgsv1.ResultCode_RESULT_CODE_OK || a.Method != "pin" || a.AttemptId == "" {
+		t.Fatalf("expected newest attempt to be the successful login, got=%+v", a)
+	}
+	var rows int
+	if err := db.QueryRowContext(ctx, `SELECT COUNT(*) FROM identity_login_history WHERE actor_id = $1 AND result = 'RESULT_CODE_DENIED'`, player.ActorId).Scan(&rows); err != nil || rows != 1 {
+		t.Fatalf("expected one denied attempt row, got=%d err=%v", rows, err)
+	}
+}
+
+func TestPostgresIdentityIPAllowlist(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+	ctx := context.Background()
+
+	clk := ledgerFixedClock{now: time.Date(2026, 3, 8, 9, 0, 0, 0, time.UTC)}
+	svc := NewIdentityService(clk, "test-secret", 15*time.Minute, time.Hour, db)
+	admin := meta("op-admin", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+	operator := &rgsv1.Actor{ActorId: "op-allow-1", ActorType: rgsv1.ActorType_ACTOR_TYPE_OPERATOR}
+	respSet, _ := svc.SetCredential(ctx, &rgsv1.SetCredentialRequest{
+		Meta:           admin,
+		Actor:          operator,
+		CredentialHash: mustBcryptHash(t, "operator-secret"),
+		Reason:         "seed allowlisted operator",
+	})
+	if respSet.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected set credential ok, got=%+v", respSet.Meta)
+	}
+	set, _ := svc.SetIPAllowlist(ctx, &rgsv1.SetIPAllowlistRequest{Meta: admin, Actor: operator, Cidrs: []string{"10.0.0.0/8", "192.0.2.10/32"}, Reason: "office network"})
+	if set.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected set allowlist ok, got=%+v", set.Meta)
+	}
+	got, _ := svc.GetIPAllowlist(ctx, &rgsv1.GetIPAllowlistRequest{Meta: admin, Actor: operator})
+	if cidrs := strings.Join(got.Allowlist.GetCidrs(), ","); cidrs != "10.0.0.0/8,192.0.2.10/32" {
+		t.Fatalf("expected stored cidrs, got=%q", cidrs)
+	}
+
+	login := func(ip string) rgsv1.ResultCode {
+		resp, _ := svc.Login(peerContext(ip), &rgsv1.LoginRequest{
+			Meta:        meta(operator.ActorId, operator.ActorType, ""),
+			Credentials: &rgsv1.LoginRequest_Operator{Operator: &rgsv1.OperatorCredentials{OperatorId: operator.ActorId, Password: "operator-secret"}},
+		})
+		return resp.Meta.GetResultCode()
+	}
+	if code := login("203.0.113.5"); code != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected login outside allowlist denied, got=%v", code)
+	}
+	if code := login("192.0.2.10"); code != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected login inside allowlist ok, got=%v", code)
+	}
+}
+
+func TestPostgresSCIMProvisioningLifecycle(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+	ctx := context.Background()
+
+	clk := ledgerFixedClock{now: time.Date(2026, 3, 9, 9, 0, 0, 0, time.UTC)}
+	roles := NewRoleService(clk)
+	roles.SetDB(db)
+	newTestCashierRole(t, roles)
+	svc := NewIdentityService(clk, "test-secret", 15*time.Minute, time.Hour, db)
+	h := svc.SCIMHandler("scim-secret", roles)
+	do := func(method, path, body string) (int, map[string]any) {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer scim-secret")
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		var out map[string]any
+		_ = json.Unmarshal(rec.Body.Bytes(), &out)
+		return rec.Code, out
+	}
+	login := func() rgsv1.ResultCode {
+		resp, _ := svc.Login(ctx, &rgsv1.LoginRequest{
+			Meta:        meta("op-scim-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+			Credentials: &rgsv1.LoginRequest_Operator{Operator: &rgsv1.OperatorCredentials{OperatorId: "op-scim-1", Password: "Correct-Horse-42!"}},
+		})
+		return resp.Meta.GetResultCode()
+	}
+	actorRoles := func() []string {
+		names, err := roles.actorRoleNamesFromDB(ctx, "op-scim-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR)
+		if err != nil {
+			t.Fatalf("load roles: %v", err)
+		}
+		return names
+	}
+
+	code, created := do(http.MethodPost, "/scim/v2/Users", `{"schemas":["urn:ietf:params:scim:schemas:core:2.0:User"],"userName":"op-scim-1","externalId":"okta-1","displayName":"Pat","password":"Correct-Horse-42!","roles":[{"value":"cashier"}]}`)
+	if code != http.StatusCreated || created["id"] == "" || created["password"] != nil {
+		t.Fatalf("expected user created without echoing password, code=%d body=%v", code, created)
+	}
+	id, _ := created["id"].(string)
+	if code, _ := do(http.MethodPost, "/scim/v2/Users", `{"userName":"op-scim-1"}`); code != http.StatusConflict {
+		t.Fatalf("expected duplicate userName conflict, got=%d", code)
+	}
+	if code, body := do(http.MethodPost, "/scim/v2/Users", `{"userName":"op-scim-2","roles":[{"value":"pit-boss"}]}`); code != http.StatusBadRequest || body["scimType"] != "invalidValue" {
+		t.Fatalf("expected unknown role rejected, code=%d body=%v", code, body)
+	}
+	if got := login(); got != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected provisioned operator login ok, got=%v", got)
+	}
+	if names := actorRoles(); len(names) != 1 || names[0] != "cashier" {
+		t.Fatalf("expected cashier role synced, got=%v", names)
+	}
+
+	code, list := do(http.MethodGet, `/scim/v2/Users?filter=externalId%20eq%20%22okta-1%22`, "")
+	if code != http.StatusOK || list["totalResults"] != float64(1) {
+		t.Fatalf("expected one filtered user, code=%d body=%v", code, list)
+	}
+
+	code, patched := do(http.MethodPatch, "/scim/v2/Users/"+id, `{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"replace","value":{"active":"False"}}]}`)
+	if code != http.StatusOK || patched["active"] != false {
+		t.Fatalf("expected user deactivated, code=%d body=%v", code, patched)
+	}
+	if got := login(); got != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected deactivated operator login denied, got=%v", got)
+	}
+	if code, _ := do(http.MethodPatch, "/scim/v2/Users/"+id, `{"Operations":[{"op":"replace","path":"active","value":true}]}`); code != http.StatusOK {
+		t.Fatalf("expected user reactivated, got=%d", code)
+	}
+	if got := login(); got != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected reactivated operator login ok, got=%v", got)
+	}
+
+	if code, _ := do(http.MethodDelete, "/scim/v2/Users/"+id, ""); code != http.StatusNoContent {
+		t.Fatalf("expected user deleted, got=%d", code)
+	}
+	if code, _ := do(http.MethodGet, "/scim/v2/Users/"+id, ""); code != http.StatusNotFound {
+		t.Fatalf("expected deleted user not found, got=%d", code)
+	}
+	if got := login(); got != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected deprovisioned operator login denied, got=%v", got)
+	}
+	if names := actorRoles(); len(names) != 0 {
+		t.Fatalf("expected roles removed on deprovisioning, got=%v", names)
+	}
+}
+
+func TestPostgresAuditPartitionExportRecordedAcrossRestart(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	ctx := context.Background()
+	day := time.Date(2026, 3, 10, 10, 0, 0, 0, time.UTC)
+	ledger := NewLedgerService(ledgerFixedClock{now: day}, db)
+	if resp, _ := ledger.Deposit(ctx, &rgsv1.DepositRequest{
+		Meta:      meta("player-exp-pg", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "exp-pg-dep-1"),
+		AccountId: "player-exp-pg",
+		Amount:    &rgsv1.Money{AmountMinor: 100, Currency: "USD"},
+	}); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("seed deposit: %+v", resp.Meta)
+	}
+
+	_, priv, _ := ed25519.GenerateKey(nil)
+	cfg := AuditExportConfig{SignerKID: "audit-k1", SigningKey: priv, Sinks: []AuditExportSink{DirectoryAuditExportSink{Dir: t.TempDir()}}}
+	clk := ledgerFixedClock{now: time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC)}
+	svcA := NewAuditService(clk, nil)
+	svcA.SetDB(db)
+	svcA.SetExportConfig(cfg)
+	first, _ := svcA.ExportAuditPartition(ctx, &rgsv1.ExportAuditPartitionRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), PartitionDay: "2026-03-10"})
+	if first.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || first.Export.EventCount == 0 || !first.Export.ChainVerified {
+		t.Fatalf("unexpected export: %+v", first)
+	}
+
+	svcB := NewAuditService(clk, nil)
+	svcB.SetDB(db)
+	svcB.SetExportConfig(cfg)
+	if summary, err := svcB.ExportJob()(ctx, ""); err != nil || summary != "" {
+		t.Fatalf("expected recorded export to be skipped after restart, summary=%q err=%v", summary, err)
+	}
+	again, _ := svcB.ExportAuditPartition(ctx, &rgsv1.ExportAuditPartitionRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), PartitionDay: "2026-03-10"})
+	if again.Export.GetManifestSha256() != first.Export.ManifestSha256 || again.Export.GetExportedAt() != first.Export.ExportedAt || len(again.Export.GetSinks()) != 1 {
+		t.Fatalf("expected recorded export after restart, got=%+v", again.Export)
+	}
+}
+
+func TestPostgresTamperEvidenceReportDetectsTamper(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	ctx := context.Background()
+	ledger := NewLedgerService(ledgerFixedClock{now: time.Date(2026, 3, 10, 10, 0, 0, 0, time.UTC)}, db)
+	for _, idem := range []string{"ter-pg-dep-1", "ter-pg-dep-2"} {
+		if resp, _ := ledger.Deposit(ctx, &rgsv1.DepositRequest{
+			Meta:      meta("player-ter-pg", rgsv1.ActorType_ACTOR_TYPE_PLAYER, idem),
+			AccountId: "player-ter-pg",
+			Amount:    &rgsv1.Money{AmountMinor: 100, Currency: "USD"},
+		}); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("seed deposit: %+v", resp.Meta)
+		}
+	}
+
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	svc := NewAuditService(ledgerFixedClock{now: time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC)}, nil)
+	svc.SetDB(db)
+	svc.SetReportSigner("report-k1", priv)
+	req := &rgsv1.GenerateTamperEvidenceReportRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), ToDay: "2026-03-10"}
+	resp, _ := svc.GenerateTamperEvidenceReport(ctx, req)
+	sig, _ := hex.DecodeString(resp.Signature)
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || !resp.Report.Verified || resp.Report.EventsChecked == 0 || !ed25519.Verify(pub, resp.ReportJson, sig) {
+		t.Fatalf("unexpected report: %+v", resp)
+	}
+
+	if _, err := db.ExecContext(ctx, `
+INSERT INTO audit_events (
+  audit_id, occurred_at, recorded_at, actor_id, actor_type, auth_context,
+  object_type, object_id, action, before_state, after_state, result, reason,
+  partition_day, hash_prev, hash_curr
+)
+VALUES (
+  'tampered-report-row', '2026-03-10T11:00:00Z'::timestamptz, '2026-03-10T11:00:00Z'::timestamptz, 'tamper', 'service', '{}'::jsonb,
+  'tamper_object', 'tamper_id', 'tamper_action', '{}'::jsonb, '{}'::jsonb, 'success', 'tamper',
+  '2026-03-10'::date, 'bad-prev', 'bad-curr'
+)
+`); err != nil {
+		t.Fatalf("insert tampered audit row err: %v", err)
+	}
+	tampered, _ := svc.GenerateTamperEvidenceReport(ctx, req)
+	if tampered.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || tampered.Report.Verified || tampered.Report.Partitions[0].FailureReason != "audit chain verification failed" {
+		t.Fatalf("expected failed verification in report, got %+v", tampered.Report)
+	}
+}
+
+func TestPostgresAuditProofAgainstRecordedSeal(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	ctx := context.Background()
+	ledger := NewLedgerService(ledgerFixedClock{now: time.Date(2026, 3, 10, 10, 0, 0, 0, time.UTC)}, db)
+	for _, idem := range []string{"seal-pg-dep-1", "seal-pg-dep-2", "seal-pg-dep-3"} {
+		if resp, _ := ledger.Deposit(ctx, &rgsv1.DepositRequest{
+			Meta:      meta("player-seal-pg", rgsv1.ActorType_ACTOR_TYPE_PLAYER, idem),
+			AccountId: "player-seal-pg",
+			Amount:    &rgsv1.Money{AmountMinor: 100, Currency: "USD"},
+		}); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("seed deposit: %+v", resp.Meta)
+		}
+	}
+
+	clk := ledgerFixedClock{now: time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC)}
+	svcA := NewAuditService(clk, nil)
+	svcA.SetDB(db)
+	if summary, err := svcA.SealJob()(ctx, ""); err != nil || summary == "" {
+		t.Fatalf("expected seal, summary=%q err=%v", summary, err)
+	}
+
+	var auditID string
+	if err := db.QueryRowContext(ctx, `SELECT audit_id FROM audit_events WHERE partition_day = '2026-03-10' ORDER BY recorded_at ASC, audit_id ASC LIMIT 1`).Scan(&auditID); err != nil {
+		t.Fatalf("pick audit event: %v", err)
+	}
+	svcB := NewAuditService(clk, nil)
+	svcB.SetDB(db)
+	resp, _ := svcB.GetAuditProof(ctx, &rgsv1.GetAuditProofRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), AuditId: auditID})
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || resp.Proof.LeafIndex != 0 {
+		t.Fatalf("unexpected proof: %+v", resp)
+	}
+	leaf, _ := hex.DecodeString(resp.Proof.LeafHash)
+	root, _ := hex.DecodeString(resp.Proof.Seal.MerkleRoot)
+	path := make([][]byte, 0, len(resp.Proof.AuditPath))
+	for _, step := range resp.Proof.AuditPath {
+		b, _ := hex.DecodeString(step)
+		path = append(path, b)
+	}
+	if !audit.VerifyMerkleProof(leaf, int(resp.Proof.LeafIndex), int(resp.Proof.TreeSize), path, root) {
+		t.Fatalf("inclusion proof does not verify against recorded seal")
+	}
+}
+
+func TestPostgresAuditChainAnchorRecordedAndVerified(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	ctx := context.Background()
+	ledger := NewLedgerService(ledgerFixedClock{now: time.Date(2026, 3, 10, 10, 0, 0, 0, time.UTC)}, db)
+	if resp, _ := ledger.Deposit(ctx, &rgsv1.DepositRequest{
+		Meta:      meta("player-anchor-pg", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "anchor-pg-dep-1"),
+		AccountId: "player-anchor-pg",
+		Amount:    &rgsv1.Money{AmountMinor: 100, Currency: "USD"},
+	}); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("seed deposit: %+v", resp.Meta)
+	}
+
+	tsa, calls := newFakeTSA(t, time.Date(2026, 3, 11, 9, 0, 1, 0, time.UTC), nil)
+	clk := ledgerFixedClock{now: time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC)}
+	opMeta := meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+	svcA := NewAuditService(clk, nil)
+	svcA.SetDB(db)
+	svcA.SetTimestamper(RFC3161Timestamper{URL: tsa.URL})
+	first, _ := svcA.AnchorAuditPartition(ctx, &rgsv1.AnchorAuditPartitionRequest{Meta: opMeta, PartitionDay: "2026-03-10"})
+	if first.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || first.Anchor.EventCount == 0 || first.Anchor.ChainHead == "" {
+		t.Fatalf("unexpected anchor: %+v", first)
+	}
+
+	svcB := NewAuditService(clk, nil)
+	svcB.SetDB(db)
+	svcB.SetTimestamper(RFC3161Timestamper{URL: tsa.URL})
+	if summary, err := svcB.AnchorJob()(ctx, ""); err != nil || summary != "" || calls.Load() != 1 {
+		t.Fatalf("expected recorded anchor to be skipped after restart, summary=%q err=%v calls=%d", summary, err, calls.Load())
+	}
+	again, _ := svcB.AnchorAuditPartition(ctx, &rgsv1.AnchorAuditPartitionRequest{Meta: opMeta, PartitionDay: "2026-03-10"})
+	if again.Anchor.GetChainHead() != first.Anchor.ChainHead || string(again.Anchor.GetTimestampToken()) != string(first.Anchor.TimestampToken) {
+		t.Fatalf("expected recorded anchor after restart, got=%+v", again.Anchor)
+	}
+	verify, _ := svcB.VerifyAuditChain(ctx, &rgsv1.VerifyAuditChainRequest{Meta: opMeta, PartitionDay: "2026-03-10"})
+	if !verify.Valid {
+		t.Fatalf("expected anchored chain to verify, got %+v", verify.Meta)
+	}
+
+	if _, err := db.ExecContext(ctx, `UPDATE audit_chain_anchors SET chain_head = $1 WHERE partition_day = '2026-03-10'`, sha256Hex([]byte("forged"))); err != nil {
+		t.Fatalf("tamper anchor: %v", err)
+	}
+	verify, _ = svcB.VerifyAuditChain(ctx, &rgsv1.VerifyAuditChainRequest{Meta: opMeta})
+	if verify.Valid || verify.Meta.GetDenialReason() != "audit chain does not match anchored head" {
+		t.Fatalf("expected anchored head mismatch, got valid=%v meta=%+v", verify.Valid, verify.Meta)
+	}
+}
+
+func TestPostgresAuditRetentionArchivesAndPrunesVerifiedPartitions(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	ctx := context.Background()
+	for i, day := range []time.Time{time.Date(2026, 3, 10, 10, 0, 0, 0, time.UTC), time.Date(2026, 3, 23, 10, 0, 0, 0, time.UTC)} {
+		ledger := NewLedgerService(ledgerFixedClock{now: day}, db)
+		if resp, _ := ledger.Deposit(ctx, &rgsv1.DepositRequest{
+			Meta:      meta("player-retain-pg", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "retain-pg-dep-"+strconv.Itoa(i)),
+			AccountId: "player-retain-pg",
+			Amount:    &rgsv1.Money{AmountMinor: 100, Currency: "USD"},
+		}); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("seed deposit: %+v", resp.Meta)
+		}
+	}
+	if _, err := db.ExecContext(ctx, `DELETE FROM audit_events WHERE partition_day = '2026-03-10'`); err == nil {
+		t.Fatalf("expected append-only trigger to block deleting an unarchived day")
+	}
+
+	tsa, _ := newFakeTSA(t, time.Date(2026, 3, 11, 9, 0, 1, 0, time.UTC), nil)
+	opMeta := meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+	anchorSvc := NewAuditService(ledgerFixedClock{now: time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC)}, nil)
+	anchorSvc.SetDB(db)
+	anchorSvc.SetTimestamper(RFC3161Timestamper{URL: tsa.URL})
+	anchored, _ := anchorSvc.AnchorAuditPartition(ctx, &rgsv1.AnchorAuditPartitionRequest{Meta: opMeta, PartitionDay: "2026-03-10"})
+	if anchored.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("anchor: %+v", anchored.Meta)
+	}
+
+	_, priv, _ := ed25519.GenerateKey(nil)
+	dir := t.TempDir()
+	svc := NewAuditService(ledgerFixedClock{now: time.Date(2026, 3, 25, 9, 0, 0, 0, time.UTC)}, nil)
+	svc.SetDB(db)
+	svc.SetExportConfig(AuditExportConfig{SignerKID: "audit-k1", SigningKey: priv, Sinks: []AuditExportSink{DirectoryAuditExportSink{Dir: dir}}})
+	svc.SetRetentionDays(3)
+	countDay := func(day string) int {
+		var n int
+		if err := db.QueryRowContext(ctx, `SELECT COUNT(*) FROM audit_events WHERE partition_day = $1::date`, day).Scan(&n); err != nil {
+			t.Fatalf("count audit rows: %v", err)
+		}
+		return n
+	}
+	before10 := countDay("2026-03-10")
+
+	if _, err := db.ExecContext(ctx, `UPDATE audit_chain_anchors SET chain_head = $1 WHERE partition_day = '2026-03-10'`, sha256Hex([]byte("forged"))); err != nil {
+		t.Fatalf("tamper anchor: %v", err)
+	}
+	if _, err := svc.RetentionJob()(ctx, ""); err == nil {
+		t.Fatalf("expected retention to refuse a partition that fails verification")
+	}
+	if countDay("2026-03-10") != before10 {
+		t.Fatalf("refused partition must not be pruned")
+	}
+	var refused int
+	if err := db.QueryRowContext(ctx, `SELECT COUNT(*) FROM audit_events WHERE action = 'archive_audit_partition' AND result = 'error' AND object_id = '2026-03-10'`).Scan(&refused); err != nil || refused != 1 {
+		t.Fatalf("expected refused archive to be audited, count=%d err=%v", refused, err)
+	}
+
+	if _, err := db.ExecContext(ctx, `UPDATE audit_chain_anchors SET chain_head = $1 WHERE partition_day = '2026-03-10'`, anchored.Anchor.ChainHead); err != nil {
+		t.Fatalf("restore anchor: %v", err)
+	}
+	summary, err := svc.RetentionJob()(ctx, "")
+	if err != nil || !strings.Contains(summary, "2026-03-10") {
+		t.Fatalf("expected 2026-03-10 archived, summary=%q err=%v", summary, err)
+	}
+	if countDay("2026-03-10") != 0 || countDay("2026-03-23") == 0 {
+		t.Fatalf("expected only the expired day pruned")
+	}
+	var archivedCount int64
+	if err := db.QueryRowContext(ctx, `SELECT event_count FROM audit_partition_archives WHERE partition_day = '2026-03-10'`).Scan(&archivedCount); err != nil || archivedCount != int64(before10) {
+		t.Fatalf("unexpected archive record count=%d err=%v", archivedCount, err)
+	}
+	raw, err := os.ReadFile(filepath.Join(dir, "audit", "2026-03-10", "archive", "events.ndjson"))
+	if err != nil || strings.Count(string(raw), "\n") != before10 {
+		t.Fatalf("unexpected archived events err=%v: %s", err, raw)
+	}
+	verify, _ := svc.VerifyAuditChain(ctx, &rgsv1.VerifyAuditChainRequest{Meta: opMeta})
+	if !verify.Valid {
+		t.Fatalf("expected chain with archived anchored day to verify, got %+v", verify.Meta)
+	}
+	exported, _ := svc.ExportAuditPartition(ctx, &rgsv1.ExportAuditPartitionRequest{Meta: opMeta, PartitionDay: "2026-03-10"})
+	if exported.Meta.GetDenialReason() != "partition day is archived" {
+		t.Fatalf("expected archived day export refused, got %+v", exported.Meta)
+	}
+	if summary, err := svc.RetentionJob()(ctx, ""); err != nil || summary != "" {
+		t.Fatalf("expected nothing left to archive, summary=%q err=%v", summary, err)
+	}
+}
+
+type recordingCorruptionNotifier struct {
+	events []AuditCorruptionEvent
+}
+
+func (n *recordingCorruptionNotifier) NotifyAuditCorruption(_ context.Context, ev AuditCorruptionEvent) error {
+	n.events = append(n.events, ev)
+	return nil
+}
+
+func TestPostgresAuditChainVerifyJobAlertsOncePerCorruptDay(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	ctx := context.Background()
+	ledger := NewLedgerService(ledgerFixedClock{now: time.Date(2026, 3, 10, 10, 0, 0, 0, time.UTC)}, db)
+	if resp, _ := ledger.Deposit(ctx, &rgsv1.DepositRequest{
+		Meta:      meta("player-chainmon-pg", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "chainmon-pg-dep-1"),
+		AccountId: "player-chainmon-pg",
+		Amount:    &rgsv1.Money{AmountMinor: 100, Currency: "USD"},
+	}); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("seed deposit: %+v", resp.Meta)
+	}
+	tsa, _ := newFakeTSA(t, time.Date(2026, 3, 11, 9, 0, 1, 0, time.UTC), nil)
+	svc := NewAuditService(ledgerFixedClock{now: time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC)}, nil)
+	svc.SetDB(db)
+	svc.SetTimestamper(RFC3161Timestamper{URL: tsa.URL})
+	if anchored, _ := svc.AnchorAuditPartition(ctx, &rgsv1.AnchorAuditPartitionRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), PartitionDay: "2026-03-10"}); anchored.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("anchor: %+v", anchored.Meta)
+	}
+
+	var results []bool
+	notifier := &recordingCorruptionNotifier{}
+	svc.SetChainMonitor(2, func(valid bool) { results = append(results, valid) }, notifier)
+	job := svc.ChainVerifyJob()
+	if summary, err := job(ctx, ""); err != nil || summary == "" {
+		t.Fatalf("expected clean run, summary=%q err=%v", summary, err)
+	}
+
+	if _, err := db.ExecContext(ctx, `UPDATE audit_chain_anchors SET chain_head = $1 WHERE partition_day = '2026-03-10'`, sha256Hex([]byte("forged"))); err != nil {
+		t.Fatalf("tamper anchor: %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := job(ctx, ""); err == nil || !strings.Contains(err.Error(), "2026-03-10") {
+			t.Fatalf("run %d: expected corruption error, got %v", i, err)
+		}
+	}
+	if len(results) != 3 || !results[0] || results[1] || results[2] {
+		t.Fatalf("unexpected observed results: %v", results)
+	}
+	if len(notifier.events) != 1 || notifier.events[0].PartitionDay != "2026-03-10" || notifier.events[0].Reason != "audit chain does not match anchored head" {
+		t.Fatalf("expected one notification, got %+v", notifier.events)
+	}
+	var detected int
+	if err := db.QueryRowContext(ctx, `SELECT COUNT(*) FROM audit_events WHERE action = 'audit_chain_corruption_detected' AND object_id = '2026-03-10'`).Scan(&detected); err != nil || detected != 1 {
+		t.Fatalf("expected one corruption audit event, count=%d err=%v", detected, err)
+	}
+}
+
+func TestPostgresOutboxQueuesSignificantAndAuditEvents(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+	SetAuditOutbox(true)
+	t.Cleanup(func() { SetAuditOutbox(false) })
+
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 16, 14, 0, 0, 0, time.UTC)}
+	ctx := context.Background()
+	eventsSvc := NewEventsService(clk, db)
+	submit := &rgsv1.SubmitSignificantEventRequest{
+		Meta:  meta("svc-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, ""),
+		Event: &rgsv1.SignificantEvent{EventId: "ev-outbox-1", EquipmentId: "eq-outbox", EventCode: "DOOR_OPEN", LocalizedDescription: "door open", OccurredAt: "2026-02-16T13:59:00Z"},
+	}
+	for i := 0; i < 2; i++ {
+		if resp, err := eventsSvc.SubmitSignificantEvent(ctx, submit); err != nil || resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("submit significant event: err=%v meta=%+v", err, resp.GetMeta())
+		}
+	}
+	ledgerSvc := NewLedgerService(clk, db)
+	if dep, err := ledgerSvc.Deposit(ctx, &rgsv1.DepositRequest{
+		Meta:      meta("acct-outbox-audit", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "idem-pg-outbox-audit-1"),
+		AccountId: "acct-outbox-audit",
+		Amount:    &rgsv1.Money{AmountMinor: 500, Currency: "USD"},
+	}); err != nil || dep.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("deposit failed: err=%v meta=%+v", err, dep.GetMeta())
+	}
+
+	pub := &recordingOutboxPublisher{}
+	if _, err := NewOutboxDispatcher(db, pub).DispatchBatch(ctx, 100); err != nil {
+		t.Fatalf("dispatch err: %v", err)
+	}
+	var significant, audits, ledger int
+	for _, ev := range pub.events {
+		switch ev.AggregateType {
+		case "significant_event":
+			significant++
+			if ev.AggregateID != "ev-outbox-1" || ev.EventType != "events.significant_event" {
+				t.Fatalf("unexpected significant event: %+v", ev)
+			}
+		case "audit_event":
+			audits++
+			var row auditExportRow
+			if err := json.Unmarshal(ev.Payload, &row); err != nil || row.AuditID != ev.AggregateID || row.HashCurr == "" || ev.EventType != "audit."+row.Action {
+				t.Fatalf("unexpected audit payload: %s err=%v", ev.Payload, err)
+			}
+		case "ledger_transaction":
+			ledger++
+		}
+	}
+	var auditRows int
+	if err := db.QueryRowContext(ctx, `SELECT COUNT(*) FROM audit_events`).Scan(&auditRows); err != nil {
+		t.Fatalf("count audit events: %v", err)
+	}
+	if significant != 1 || ledger != 1 || audits != auditRows || audits == 0 {
+		t.Fatalf("expected one significant event, one ledger event, and every audit event once; got significant=%d ledger=%d audits=%d audit_rows=%d", significant, ledger, audits, auditRows)
+	}
+}
+
+func TestPostgresReportDeliveriesPersist(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 13, 12, 0, 0, 0, time.UTC)}
+	svc := NewReportingService(clk, nil, nil, db)
+	target := &recordingReportTarget{name: "test:pg", failures: 1}
+	svc.SetReportDeliveryConfig(ReportDeliveryConfig{Targets: []ReportDeliveryTarget{target}})
+	ctx := context.Background()
+	opMeta := meta("op-r1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+
+	gen, err := svc.GenerateReport(ctx, &rgsv1.GenerateReportRequest{
+		Meta:       opMeta,
+		ReportType: rgsv1.ReportType_REPORT_TYPE_CASHLESS_LIABILITY_SUMMARY,
+		Interval:   rgsv1.ReportInterval_REPORT_INTERVAL_DTD,
+		Format:     rgsv1.ReportFormat_REPORT_FORMAT_JSON,
+	})
+	if err != nil || gen.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("generate report: err=%v meta=%+v", err, gen.GetMeta())
+	}
+	runID := gen.ReportRun.GetReportRunId()
+
+	job := svc.DeliveryJob(10)
+	for i := 0; i < 2; i++ {
+		if _, err := job(ctx, ""); err != nil {
+			t.Fatalf("delivery job run %d: %v", i, err)
+		}
+	}
+
+	restarted := NewReportingService(clk, nil, nil, db)
+	got, err := restarted.GetReportRun(ctx, &rgsv1.GetReportRunRequest{Meta: opMeta, ReportRunId: runID})
+	if err != nil || got.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("get report run: err=%v meta=%+v", err, got.GetMeta())
+	}
+	d := got.ReportRun.GetDeliveries()
+	if len(d) != 1 || d[0].Target != "test:pg" || !d[0].Delivered || d[0].Attempts != 2 || d[0].DeliveredAt == "" || d[0].LastAttemptedAt == "" {
+		t.Fatalf("unexpected persisted deliveries: %+v", d)
+	}
+	if len(target.delivered) != 1 || target.delivered[0] != runID {
+		t.Fatalf("unexpected target deliveries: %v", target.delivered)
+	}
+}
+
+func TestPostgresReportRunPeriodsPersist(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 13, 12, 0, 0, 0, time.UTC)}
+	svc := NewReportingService(clk, nil, nil, db)
+	ctx := context.Background()
+	opMeta := meta("op-r1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+
+	want := map[rgsv1.ReportInterval]string{
+		rgsv1.ReportInterval_REPORT_INTERVAL_MTD: "2026-02-01T00:00:00Z",
+		rgsv1.ReportInterval_REPORT_INTERVAL_LTD: "",
+	}
+	for interval, start := range want {
+		gen, err := svc.GenerateReport(ctx, &rgsv1.GenerateReportRequest{
+			Meta:       opMeta,
+			ReportType: rgsv1.ReportType_REPORT_TYPE_SIGNIFICANT_EVENTS_ALTERATIONS,
+			Interval:   interval,
+			Format:     rgsv1.ReportFormat_REPORT_FORMAT_JSON,
+		})
+		if err != nil || gen.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("%s: generate report: err=%v meta=%+v", interval, err, gen.GetMeta())
+		}
+		got, err := NewReportingService(clk, nil, nil, db).getReportRunFromDB(ctx, gen.ReportRun.ReportRunId)
+		if err != nil || got == nil {
+			t.Fatalf("%s: get report run: run=%+v err=%v", interval, got, err)
+		}
+		if got.PeriodStart != start || got.PeriodEnd != "2026-02-13T12:00:00Z" {
+			t.Fatalf("%s: unexpected persisted period %q..%q", interval, got.PeriodStart, got.PeriodEnd)
+		}
+	}
+}
+
+func TestPostgresReportRunSignaturePersists(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 13, 12, 0, 0, 0, time.UTC)}
+	_, priv, _ := ed25519.GenerateKey(nil)
+	svc := NewReportingService(clk, nil, nil, db)
+	svc.SetReportRunSigner("report-k1", priv)
+	ctx := context.Background()
+	opMeta := meta("op-r1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+
+	gen, err := svc.GenerateReport(ctx, &rgsv1.GenerateReportRequest{
+		Meta:       opMeta,
+		ReportType: rgsv1.ReportType_REPORT_TYPE_SIGNIFICANT_EVENTS_ALTERATIONS,
+		Interval:   rgsv1.ReportInterval_REPORT_INTERVAL_DTD,
+		Format:     rgsv1.ReportFormat_REPORT_FORMAT_PDF,
+	})
+	if err != nil || gen.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("generate report: err=%v meta=%+v", err, gen.GetMeta())
+	}
+	got, err := svc.getReportRunFromDB(ctx, gen.ReportRun.ReportRunId)
+	if err != nil || got == nil {
+		t.Fatalf("get report run: run=%+v err=%v", got, err)
+	}
+	if got.ContentSha256 != gen.ReportRun.ContentSha256 || got.SignerKid != "report-k1" || got.Signature != gen.ReportRun.Signature || got.SignatureAlg != "ed25519" {
+		t.Fatalf("unexpected persisted signature: %+v", got)
+	}
+
+	verify, err := svc.VerifyReportRun(ctx, &rgsv1.VerifyReportRunRequest{Meta: opMeta, ReportRunId: got.ReportRunId})
+	if err != nil || !verify.GetVerified() {
+		t.Fatalf("expected stored run to verify: resp=%+v err=%v", verify, err)
+	}
+	if _, err := db.ExecContext(ctx, `UPDATE report_runs SET content = content || 'x'::bytea WHERE report_run_id = $1`, got.ReportRunId); err != nil {
+		t.Fatalf("tamper report run: %v", err)
+	}
+	verify, err = svc.VerifyReportRun(ctx, &rgsv1.VerifyReportRunRequest{Meta: opMeta, ReportRunId: got.ReportRunId})
+	if err != nil || verify.GetVerified() || verify.GetFailureReason() != "content does not match the signed hash" {
+		t.Fatalf("expected tampered run to fail verification: resp=%+v err=%v", verify, err)
+	}
+}
+
+func TestPostgresReportRunListScopedToOperator(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 13, 12, 0, 0, 0, time.UTC)}
+	svc := NewReportingService(clk, nil, nil, db)
+	svc.SetOperatorScopes(map[string][]string{"op-lv": {"casino-lv"}})
+	ctx := context.Background()
+	for _, operatorID := range []string{"casino-lv", "casino-mt"} {
+		gen, err := svc.GenerateReport(ctx, &rgsv1.GenerateReportRequest{
+			Meta:       meta("op-admin", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+			ReportType: rgsv1.ReportType_REPORT_TYPE_SIGNIFICANT_EVENTS_ALTERATIONS,
+			Interval:   rgsv1.ReportInterval_REPORT_INTERVAL_DTD,
+			Format:     rgsv1.ReportFormat_REPORT_FORMAT_JSON,
+			OperatorId: operatorID,
+		})
+		if err != nil || gen.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("generate %s report: err=%v meta=%+v", operatorID, err, gen.GetMeta())
+		}
+	}
+
+	scoped, err := svc.ListReportRuns(ctx, &rgsv1.ListReportRunsRequest{Meta: meta("op-lv", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")})
+	if err != nil || scoped.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || len(scoped.ReportRuns) != 1 || scoped.ReportRuns[0].OperatorId != "casino-lv" {
+		t.Fatalf("expected only casino-lv runs: resp=%+v err=%v", scoped, err)
+	}
+	all, err := svc.ListReportRuns(ctx, &rgsv1.ListReportRunsRequest{Meta: meta("op-admin", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")})
+	if err != nil || len(all.ReportRuns) != 2 {
+		t.Fatalf("expected unscoped actor to list every run: resp=%+v err=%v", all, err)
+	}
+}
+
+func TestPostgresReportingReadsFromReadDB(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	replica, err := sql.Open("pgx", os.Getenv("RGS_TEST_DATABASE_URL"))
+	if err != nil {
+		t.Fatalf("open read db: %v", err)
+	}
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 13, 12, 0, 0, 0, time.UTC)}
+	svc := NewReportingService(clk, nil, nil, db)
+	svc.SetReadDB(replica)
+	ctx := context.Background()
+	generateNote := func() string {
+		t.Helper()
+		gen, err := svc.GenerateReport(ctx, &rgsv1.GenerateReportRequest{
+			Meta:       meta("op-r1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+			ReportType: rgsv1.ReportType_REPORT_TYPE_EFT_ACTIVITY,
+			Interval:   rgsv1.ReportInterval_REPORT_INTERVAL_DTD,
+			Format:     rgsv1.ReportFormat_REPORT_FORMAT_JSON,
+		})
+		if err != nil || gen.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("generate report: err=%v meta=%+v", err, gen.GetMeta())
+		}
+		if got, err := svc.getReportRunFromDB(ctx, gen.ReportRun.ReportRunId); err != nil || got == nil {
+			t.Fatalf("expected run stored on the primary: run=%+v err=%v", got, err)
+		}
+		var payload struct {
+			Note string `json:"note"`
+		}
+		if err := json.Unmarshal(gen.ReportRun.Content, &payload); err != nil {
+			t.Fatalf("decode report: %v", err)
+		}
+		return payload.Note
+	}
+
+	if note := generateNote(); note != "No Activity" {
+		t.Fatalf("expected report read from the read db, note=%q", note)
+	}
+	// With the read db gone, report data is unavailable even though the
+	// primary still stores the run.
+	_ = replica.Close()
+	if note := generateNote(); note != "EFT data unavailable" {
+		t.Fatalf("expected report data to come from the read db, note=%q", note)
+	}
+}
+
+func TestPostgresReportRetentionPurgesContent(t *testing.T) {
+	db := openPostgresIntegrationDB(t)
+	resetPostgresIntegrationState(t, db)
+
+	start := time.Date(2026, 1, 5, 12, 0, 0, 0, time.UTC)
+	svc := NewReportingService(ledgerFixedClock{now: start}, nil, nil, db)
+	svc.deliveryCfg.MaxAttempts = 5
+	ctx := context.Background()
+	opMeta := meta("op-r1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+	generate := func() *rgsv1.ReportRun {
+		t.Helper()
+		gen, err := svc.GenerateReport(ctx, &rgsv1.GenerateReportRequest{
+			Meta:       opMeta,
+			ReportType: rgsv1.ReportType_REPORT_TYPE_SIGNIFICANT_EVENTS_ALTERATIONS,
+			Interval:   rgsv1.ReportInterval_REPORT_INTERVAL_DTD,
+			Format:     rgsv1.ReportFormat_REPORT_FORMAT_JSON,
+		})
+		if err != nil || gen.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("generate report: err=%v meta=%+v", err, gen.GetMeta())
+		}
+		return gen.ReportRun
+	}
+	oldRun := generate()
+	pendingRun := generate()
+	if _, err := db.ExecContext(ctx, `INSERT INTO report_run_deliveries (report_run_id, target, attempts) VALUES ($1, 's3', 1)`, pendingRun.ReportRunId); err != nil {
+		t.Fatalf("insert pending delivery: %v", err)
+	}
+	svc.Clock = ledgerFixedClock{now: start.Add(40 * 24 * time.Hour)}
+	newRun := generate()
+
+	svc.SetReportRetention(30 * 24 * time.Hour)
+	var observed int64
+	detail, err := svc.ReportRetentionJob(1, func(purged int64, err error) {
+		if err != nil {
+			t.Fatalf("unexpected purge error: %v", err)
+		}
+		observed += purged
+	})(ctx, "")
+	if err != nil || detail != "purged content of 1 report runs" || observed != 1 {
+		t.Fatalf("unexpected purge result: detail=%q err=%v observed=%d", detail, err, observed)
+	}
+
+	for _, tc := range []struct {
+		run    *rgsv1.ReportRun
+		purged bool
+	}{{oldRun, true}, {pendingRun, false}, {newRun, false}} {
+		got, err := svc.getReportRunFromDB(ctx, tc.run.ReportRunId)
+		if err != nil || got == nil {
+			t.Fatalf("get report run %s: run=%+v err=%v", tc.run.ReportRunId, got, err)
+		}
+		if (got.ContentPurgedAt != "") != tc.purged || (len(got.Content) == 0) != tc.purged || got.ContentSha256 != tc.run.ContentSha256 {
+			t.Fatalf("unexpected retention state for %s (want purged=%v): %+v", tc.run.ReportRunId, tc.purged, got)
+		}
+	}
+	verify, err := svc.VerifyReportRun(ctx, &rgsv1.VerifyReportRunRequest{Meta: opMeta, ReportRunId: oldRun.ReportRunId, Content: oldRun.Content})
+	if err != nil || verify.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || verify.GetFailureReason() != "report run is not signed" {
+		t.Fatalf("expected purged run to remain verifiable from an export: resp=%+v err=%v", verify, err)
+	}
+}
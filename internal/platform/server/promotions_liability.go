@@ -0,0 +1,150 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+// promoCampaignTotals accumulates promotional awards (issued) and bonus
+// transactions (redeemed) for one campaign and currency. The window fields
+// cover activity inside the report window; the totals cover everything up
+// to its end, so outstanding is the liability at the close of the window.
+type promoCampaignTotals struct {
+	campaignID    string
+	currency      string
+	awardsIssued  int64
+	issued        int64
+	redemptions   int64
+	redeemed      int64
+	totalIssued   int64
+	totalRedeemed int64
+}
+
+func (t *promoCampaignTotals) outstanding() int64 {
+	return t.totalIssued - t.totalRedeemed
+}
+
+// campaignLiability aggregates promotional activity per campaign and
+// currency up to to, counting activity from from onward in the window
+// fields, ordered by campaign then currency. Zero bounds are open. With a
+// database, it reads from reader when set and from s.db otherwise.
+func (s *PromotionsService) campaignLiability(ctx context.Context, reader *sql.DB, from, to time.Time) ([]*promoCampaignTotals, error) {
+	if s.db != nil {
+		if reader == nil {
+			reader = s.db
+		}
+		return campaignLiabilityFromDB(ctx, reader, from, to)
+	}
+	byKey := make(map[string]*promoCampaignTotals)
+	totals := func(campaignID, currency string) *promoCampaignTotals {
+		k := campaignID + "|" + currency
+		t := byKey[k]
+		if t == nil {
+			t = &promoCampaignTotals{campaignID: campaignID, currency: currency}
+			byKey[k] = t
+		}
+		return t
+	}
+	inWindow := func(occurredAt string) (bool, bool) {
+		ts := parseTS(occurredAt)
+		if !to.IsZero() && ts.After(to) {
+			return false, false
+		}
+		return true, from.IsZero() || !ts.Before(from)
+	}
+
+	s.mu.Lock()
+	for _, id := range s.awardOrder {
+		a := s.awards[id]
+		counted, windowed := inWindow(a.OccurredAt)
+		if !counted {
+			continue
+		}
+		t := totals(a.CampaignId, a.Amount.GetCurrency())
+		t.totalIssued += a.Amount.GetAmountMinor()
+		if windowed {
+			t.awardsIssued++
+			t.issued += a.Amount.GetAmountMinor()
+		}
+	}
+	for _, id := range s.bonusOrder {
+		tx := s.bonusTx[id]
+		counted, windowed := inWindow(tx.OccurredAt)
+		if !counted {
+			continue
+		}
+		t := totals(tx.CampaignId, tx.Amount.GetCurrency())
+		t.totalRedeemed += tx.Amount.GetAmountMinor()
+		if windowed {
+			t.redemptions++
+			t.redeemed += tx.Amount.GetAmountMinor()
+		}
+	}
+	s.mu.Unlock()
+
+	out := make([]*promoCampaignTotals, 0, len(byKey))
+	for _, t := range byKey {
+		out = append(out, t)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].campaignID != out[j].campaignID {
+			return out[i].campaignID < out[j].campaignID
+		}
+		return out[i].currency < out[j].currency
+	})
+	return out, nil
+}
+
+// buildPromoLiabilityPayload reports, per campaign and currency, the
+// promotional credit issued and redeemed in the window and the balance
+// still outstanding at its end.
+func (s *ReportingService) buildPromoLiabilityPayload(ctx context.Context, w reportWindow, operatorID string) (map[string]any, bool) {
+	rows := make([]map[string]any, 0)
+	var loadErr error
+	activity := false
+	if s.Promotions != nil {
+		campaigns, err := s.Promotions.campaignLiability(ctx, s.readDB, w.start, w.end)
+		loadErr = err
+		for _, c := range campaigns {
+			if c.awardsIssued > 0 || c.redemptions > 0 {
+				activity = true
+			}
+			if c.awardsIssued == 0 && c.redemptions == 0 && c.outstanding() == 0 {
+				continue
+			}
+			rows = append(rows, map[string]any{
+				"campaign_id":    c.campaignID,
+				"currency":       c.currency,
+				"awards_issued":  c.awardsIssued,
+				"issued":         c.issued,
+				"redemptions":    c.redemptions,
+				"redeemed":       c.redeemed,
+				"total_issued":   c.totalIssued,
+				"total_redeemed": c.totalRedeemed,
+				"outstanding":    c.outstanding(),
+			})
+		}
+	}
+
+	noActivity := !activity
+	payload := map[string]any{
+		"operator_id":       operatorID,
+		"report_title":      reportTitle(rgsv1.ReportType_REPORT_TYPE_PROMO_LIABILITY),
+		"selected_interval": w.interval.String(),
+		"generated_at":      s.now().Format(time.RFC3339Nano),
+		"no_activity":       noActivity,
+		"row_count":         len(rows),
+		"rows":              rows,
+	}
+	if noActivity {
+		payload["note"] = "No Activity"
+	}
+	if loadErr != nil {
+		payload["note"] = "Promotions data unavailable"
+	}
+	return payload, noActivity
+}
@@ -0,0 +1,156 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+// seedPromoLiability records awards and bonus redemptions for two campaigns:
+// spring has 500 issued and 200 redeemed on the previous day plus 300 issued
+// and 100 redeemed on start's day; winter has 400 issued and fully redeemed
+// on the previous day.
+func seedPromoLiability(t *testing.T, svc *PromotionsService, start time.Time) {
+	t.Helper()
+	ctx := context.Background()
+	earlier := start.Add(-24 * time.Hour)
+	awards := []struct {
+		id, campaign string
+		awardType    rgsv1.PromotionalAwardType
+		amount       int64
+		at           time.Time
+	}{
+		{"award-1", "spring", rgsv1.PromotionalAwardType_PROMOTIONAL_AWARD_TYPE_FREEPLAY, 500, earlier},
+		{"award-2", "spring", rgsv1.PromotionalAwardType_PROMOTIONAL_AWARD_TYPE_MATCH_BONUS, 300, start},
+		{"award-3", "winter", rgsv1.PromotionalAwardType_PROMOTIONAL_AWARD_TYPE_NON_CASHABLE_CREDIT, 400, earlier},
+	}
+	for _, a := range awards {
+		resp, err := svc.RecordPromotionalAward(ctx, &rgsv1.RecordPromotionalAwardRequest{
+			Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+			Award: &rgsv1.PromotionalAward{
+				PromotionalAwardId: a.id,
+				PlayerId:           "player-1",
+				AwardType:          a.awardType,
+				CampaignId:         a.campaign,
+				Amount:             &rgsv1.Money{AmountMinor: a.amount, Currency: "USD"},
+				OccurredAt:         a.at.Format(time.RFC3339Nano),
+			},
+		})
+		if err != nil || resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("record award %s: resp=%+v err=%v", a.id, resp, err)
+		}
+	}
+	bonuses := []struct {
+		id, campaign string
+		amount       int64
+		at           time.Time
+	}{
+		{"bonus-1", "spring", 200, earlier},
+		{"bonus-2", "spring", 100, start},
+		{"bonus-3", "winter", 400, earlier},
+	}
+	for _, b := range bonuses {
+		resp, err := svc.RecordBonusTransaction(ctx, &rgsv1.RecordBonusTransactionRequest{
+			Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+			Transaction: &rgsv1.BonusTransaction{
+				BonusTransactionId: b.id,
+				EquipmentId:        "egm-1",
+				PlayerId:           "player-1",
+				CampaignId:         b.campaign,
+				Amount:             &rgsv1.Money{AmountMinor: b.amount, Currency: "USD"},
+				OccurredAt:         b.at.Format(time.RFC3339Nano),
+			},
+		})
+		if err != nil || resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("record bonus %s: resp=%+v err=%v", b.id, resp, err)
+		}
+	}
+}
+
+type promoLiabilityRow struct {
+	CampaignID    string `json:"campaign_id"`
+	AwardsIssued  int64  `json:"awards_issued"`
+	Issued        int64  `json:"issued"`
+	Redemptions   int64  `json:"redemptions"`
+	Redeemed      int64  `json:"redeemed"`
+	TotalIssued   int64  `json:"total_issued"`
+	TotalRedeemed int64  `json:"total_redeemed"`
+	Outstanding   int64  `json:"outstanding"`
+}
+
+func generatePromoLiabilityReport(t *testing.T, svc *ReportingService, interval rgsv1.ReportInterval, format rgsv1.ReportFormat) *rgsv1.ReportRun {
+	t.Helper()
+	resp, err := svc.GenerateReport(context.Background(), &rgsv1.GenerateReportRequest{
+		Meta:       meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		ReportType: rgsv1.ReportType_REPORT_TYPE_PROMO_LIABILITY,
+		Interval:   interval,
+		Format:     format,
+		OperatorId: "casino-1",
+	})
+	if err != nil || resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("generate promo liability report: resp=%+v err=%v", resp, err)
+	}
+	return resp.ReportRun
+}
+
+func TestPromoLiabilityReportGroupsByCampaign(t *testing.T) {
+	start := time.Date(2026, 2, 15, 9, 0, 0, 0, time.UTC)
+	clk := ledgerFixedClock{now: start.Add(2 * time.Hour)}
+	promotions := NewPromotionsService(clk)
+	seedPromoLiability(t, promotions, start)
+	reportingSvc := NewReportingService(clk, NewLedgerService(clk), NewEventsService(clk))
+	reportingSvc.Promotions = promotions
+
+	run := generatePromoLiabilityReport(t, reportingSvc, rgsv1.ReportInterval_REPORT_INTERVAL_DTD, rgsv1.ReportFormat_REPORT_FORMAT_JSON)
+	if run.NoActivity || run.ReportTitle != "Promotional Liability Summary" {
+		t.Fatalf("unexpected run: %+v", run)
+	}
+	var payload struct {
+		Rows []promoLiabilityRow `json:"rows"`
+	}
+	if err := json.Unmarshal(run.Content, &payload); err != nil {
+		t.Fatalf("decode report: %v", err)
+	}
+	// winter had no activity today and nothing outstanding, so only spring
+	// is listed.
+	want := promoLiabilityRow{CampaignID: "spring", AwardsIssued: 1, Issued: 300, Redemptions: 1, Redeemed: 100, TotalIssued: 800, TotalRedeemed: 300, Outstanding: 500}
+	if len(payload.Rows) != 1 || payload.Rows[0] != want {
+		t.Fatalf("unexpected DTD rows: %+v", payload.Rows)
+	}
+
+	run = generatePromoLiabilityReport(t, reportingSvc, rgsv1.ReportInterval_REPORT_INTERVAL_LTD, rgsv1.ReportFormat_REPORT_FORMAT_CSV)
+	csv := string(run.Content)
+	if !strings.Contains(csv, "campaign_id,currency,awards_issued,issued,redemptions,redeemed,total_issued,total_redeemed,outstanding") ||
+		!strings.Contains(csv, "spring,USD,2,800,2,300,800,300,500") ||
+		!strings.Contains(csv, "winter,USD,1,400,1,400,400,400,0") {
+		t.Fatalf("unexpected LTD csv:\n%s", csv)
+	}
+}
+
+func TestPromoLiabilityReportListsOutstandingWithoutActivity(t *testing.T) {
+	start := time.Date(2026, 2, 15, 9, 0, 0, 0, time.UTC)
+	promotions := NewPromotionsService(ledgerFixedClock{now: start})
+	seedPromoLiability(t, promotions, start)
+	clk := ledgerFixedClock{now: start.Add(24 * time.Hour)}
+	reportingSvc := NewReportingService(clk, NewLedgerService(clk), NewEventsService(clk))
+	reportingSvc.Promotions = promotions
+
+	run := generatePromoLiabilityReport(t, reportingSvc, rgsv1.ReportInterval_REPORT_INTERVAL_DTD, rgsv1.ReportFormat_REPORT_FORMAT_JSON)
+	if !run.NoActivity {
+		t.Fatalf("expected no activity on a day without awards or redemptions: %+v", run)
+	}
+	var payload struct {
+		Note string              `json:"note"`
+		Rows []promoLiabilityRow `json:"rows"`
+	}
+	if err := json.Unmarshal(run.Content, &payload); err != nil {
+		t.Fatalf("decode report: %v", err)
+	}
+	if payload.Note != "No Activity" || len(payload.Rows) != 1 || payload.Rows[0].CampaignID != "spring" || payload.Rows[0].Issued != 0 || payload.Rows[0].Outstanding != 500 {
+		t.Fatalf("expected spring's outstanding balance carried forward: %+v", payload)
+	}
+}
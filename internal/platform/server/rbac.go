@@ -0,0 +1,690 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	platformauth "github.com/wizardbeardstudio/open-rgs-go/internal/platform/auth"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/clock"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const rbacPackage = "rgs.v1"
+
+// builtInRoles are held by every actor of the matching type that has no
+// explicit assignment, so enabling RBAC changes nothing until an operator
+// narrows an actor to specific roles.
+var builtInRoles = map[string]rgsv1.ActorType{
+	"player":   rgsv1.ActorType_ACTOR_TYPE_PLAYER,
+	"operator": rgsv1.ActorType_ACTOR_TYPE_OPERATOR,
+	"service":  rgsv1.ActorType_ACTOR_TYPE_SERVICE,
+}
+
+var roleNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,63}$`)
+
+// httpPathVar matches a path template variable without an explicit pattern,
+// which grpc-gateway renders as "{name=*}".
+var httpPathVar = regexp.MustCompile(`\{([^}=]+)\}`)
+
+// rbacCatalog lists the permission for every RPC in the API package and maps
+// gateway routes ("POST /v1/ledger/deposits") back to their RPC.
+func rbacCatalog() ([]string, map[string]string) {
+	var perms []string
+	routes := make(map[string]string)
+	protoregistry.GlobalFiles.RangeFilesByPackage(rbacPackage, func(fd protoreflect.FileDescriptor) bool {
+		for i := 0; i < fd.Services().Len(); i++ {
+			svc := fd.Services().Get(i)
+			for j := 0; j < svc.Methods().Len(); j++ {
+				m := svc.Methods().Get(j)
+				perm := string(svc.FullName()) + "/" + string(m.Name())
+				perms = append(perms, perm)
+				rule, _ := proto.GetExtension(m.Options(), annotations.E_Http).(*annotations.HttpRule)
+				if rule == nil {
+					continue
+				}
+				for _, r := range append([]*annotations.HttpRule{rule}, rule.AdditionalBindings...) {
+					if method, path := httpRuleRoute(r); path != "" {
+						routes[method+" "+httpPathVar.ReplaceAllString(path, "{$1=*}")] = perm
+					}
+				}
+			}
+		}
+		return true
+	})
+	sort.Strings(perms)
+	return perms, routes
+}
+
+func httpRuleRoute(r *annotations.HttpRule) (string, string) {
+	switch p := r.Pattern.(type) {
+	case *annotations.HttpRule_Get:
+		return http.MethodGet, p.Get
+	case *annotations.HttpRule_Post:
+		return http.MethodPost, p.Post
+	case *annotations.HttpRule_Put:
+		return http.MethodPut, p.Put
+	case *annotations.HttpRule_Delete:
+		return http.MethodDelete, p.Delete
+	case *annotations.HttpRule_Patch:
+		return http.MethodPatch, p.Patch
+	default:
+		return "", ""
+	}
+}
+
+// permissionGrants reports whether a granted permission covers perm.
+func permissionGrants(granted, perm string) bool {
+	if granted == "*" || granted == perm {
+		return true
+	}
+	svc, ok := strings.CutSuffix(granted, "/*")
+	return ok && strings.HasPrefix(perm, svc+"/")
+}
+
+type rbacCacheEntry struct {
+	roles    []string
+	perms    []string
+	loadedAt time.Time
+}
+
+// RoleService manages roles and assignments and decides, for the shared
+// interceptors, whether an authenticated actor may call an RPC. Services
+// keep their own actor-type and ownership checks; RBAC narrows who may reach
+// them at all.
+type RoleService struct {
+	rgsv1.UnimplementedRoleServiceServer
+
+	Clock      clock.Clock
+	AuditStore audit.Store
+
+	mu          sync.Mutex
+	nextAuditID int64
+	roles       map[string]*rgsv1.Role
+	assignments map[string]map[string]*rgsv1.RoleAssignment
+	catalog     []string
+	routes      map[string]string
+	cacheTTL    time.Duration
+	cache       map[string]rbacCacheEntry
+	db          *sql.DB
+}
+
+func NewRoleService(clk clock.Clock) *RoleService {
+	catalog, routes := rbacCatalog()
+	return &RoleService{
+		Clock:       clk,
+		AuditStore:  audit.NewInMemoryStore(),
+		roles:       make(map[string]*rgsv1.Role),
+		assignments: make(map[string]map[string]*rgsv1.RoleAssignment),
+		catalog:     catalog,
+		routes:      routes,
+		cacheTTL:    5 * time.Second,
+		cache:       make(map[string]rbacCacheEntry),
+	}
+}
+
+// SetDB persists roles and assignments to db and records audit events there
+// too, replacing AuditStore.
+func (s *RoleService) SetDB(db *sql.DB) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.db = db
+	s.AuditStore = NewAuditStore(db)
+	s.cache = make(map[string]rbacCacheEntry)
+}
+
+// SetCacheTTL bounds how long a DB-backed instance keeps an actor's
+// permissions before reloading them, and so how long an assignment made on
+// another instance takes to apply here. Zero disables caching.
+func (s *RoleService) SetCacheTTL(ttl time.Duration) {
+	if s == nil || ttl < 0 {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cacheTTL = ttl
+	s.cache = make(map[string]rbacCacheEntry)
+}
+
+func (s *RoleService) now() time.Time {
+	if s.Clock == nil {
+		return time.Now().UTC()
+	}
+	return s.Clock.Now().UTC()
+}
+
+func (s *RoleService) responseMeta(meta *rgsv1.RequestMeta, code rgsv1.ResultCode, denial string) *rgsv1.ResponseMeta {
+	return &rgsv1.ResponseMeta{
+		RequestId:    requestID(meta),
+		ResultCode:   code,
+		DenialReason: denial,
+		ServerTime:   s.now().Format(time.RFC3339Nano),
+	}
+}
+
+func (s *RoleService) nextAuditIDLocked() string {
+	s.nextAuditID++
+	return "rbac-audit-" + strconv.FormatInt(s.nextAuditID, 10)
+}
+
+func (s *RoleService) appendAuditLocked(actor *rgsv1.Actor, objectType, objectID, action string, before, after []byte, result audit.Result, reason string) error {
+	ev := newAuditEvent(&rgsv1.RequestMeta{Actor: actor}, s.nextAuditIDLocked(), s.now(), objectType, objectID, action, before, after, result, reason)
+	return appendAuditTo(context.Background(), s.AuditStore, ev)
+}
+
+func (s *RoleService) auditDenied(meta *rgsv1.RequestMeta, objectType, objectID, action, reason string) {
+	var actor *rgsv1.Actor
+	if meta != nil {
+		actor = meta.Actor
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_ = s.appendAuditLocked(actor, objectType, objectID, action, []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+}
+
+func rbacActorKey(actorID string, actorType rgsv1.ActorType) string {
+	return actorType.String() + "|" + actorID
+}
+
+func builtInRole(name string) *rgsv1.Role {
+	if _, ok := builtInRoles[name]; !ok {
+		return nil
+	}
+	return &rgsv1.Role{Name: name, Description: "default for " + name + " actors", Permissions: []string{"*"}, BuiltIn: true}
+}
+
+func (s *RoleService) loadRoleLocked(ctx context.Context, name string) (*rgsv1.Role, error) {
+	if r := builtInRole(name); r != nil {
+		return r, nil
+	}
+	if s.db != nil {
+		return s.getRoleFromDB(ctx, name)
+	}
+	if r, ok := s.roles[name]; ok {
+		return proto.Clone(r).(*rgsv1.Role), nil
+	}
+	return nil, nil
+}
+
+// actorGrantsLocked returns the actor's role names and granted permissions,
+// falling back to the built-in role for the actor type when the actor has no
+// explicit assignment.
+func (s *RoleService) actorGrantsLocked(ctx context.Context, actorID string, actorType rgsv1.ActorType) ([]string, []string, error) {
+	key := rbacActorKey(actorID, actorType)
+	if s.db != nil && s.cacheTTL > 0 {
+		if e, ok := s.cache[key]; ok && s.now().Sub(e.loadedAt) < s.cacheTTL {
+			return e.roles, e.perms, nil
+		}
+	}
+	var names []string
+	if s.db != nil {
+		var err error
+		names, err = s.actorRoleNamesFromDB(ctx, actorID, actorType)
+		if err != nil {
+			return nil, nil, err
+		}
+	} else {
+		for name := range s.assignments[key] {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		for name, t := range builtInRoles {
+			if t == actorType {
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+	var perms []string
+	for _, name := range names {
+		r, err := s.loadRoleLocked(ctx, name)
+		if err != nil {
+			return nil, nil, err
+		}
+		if r != nil {
+			perms = append(perms, r.Permissions...)
+		}
+	}
+	if s.db != nil && s.cacheTTL > 0 {
+		s.cache[key] = rbacCacheEntry{roles: names, perms: perms, loadedAt: s.now()}
+	}
+	return names, perms, nil
+}
+
+// Authorize reports whether the actor holds a role granting perm, a gRPC
+// full method name with or without the leading slash. Denials are audited.
+func (s *RoleService) Authorize(ctx context.Context, actor platformauth.Actor, perm string) (bool, error) {
+	perm = strings.TrimPrefix(perm, "/")
+	actorType := actorTypeFromString(actor.Type)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, granted, err := s.actorGrantsLocked(ctx, actor.ID, actorType)
+	if err != nil {
+		return false, err
+	}
+	for _, g := range granted {
+		if permissionGrants(g, perm) {
+			return true, nil
+		}
+	}
+	_ = s.appendAuditLocked(&rgsv1.Actor{ActorId: actor.ID, ActorType: actorType}, "rbac_permission", perm, "rbac_authorize", []byte(`{}`), []byte(`{}`), audit.ResultDenied, "permission denied")
+	return false, nil
+}
+
+func (s *RoleService) check(ctx context.Context, fullMethod string) error {
+	actor, ok := platformauth.ActorFromContext(ctx)
+	if !ok {
+		// Unauthenticated methods are vetted by the JWT interceptor.
+		return nil
+	}
+	allowed, err := s.Authorize(ctx, actor, fullMethod)
+	if err != nil {
+		return status.Error(codes.Unavailable, "authorization unavailable")
+	}
+	if !allowed {
+		return status.Error(codes.PermissionDenied, "permission denied: "+strings.TrimPrefix(fullMethod, "/"))
+	}
+	return nil
+}
+
+// UnaryRBACInterceptor enforces role permissions; it must run after the JWT
+// interceptor so the actor is on the context.
+func UnaryRBACInterceptor(roles *RoleService) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if roles == nil {
+			return handler(ctx, req)
+		}
+		if err := roles.check(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func StreamRBACInterceptor(roles *RoleService) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if roles == nil {
+			return handler(srv, ss)
+		}
+		if err := roles.check(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// GatewayMiddleware applies the same checks to REST calls, which the
+// gateway dispatches to the services without passing through the gRPC
+// interceptors. Routes are resolved to RPCs from the proto HTTP annotations.
+func (s *RoleService) GatewayMiddleware() runtime.Middleware {
+	return func(next runtime.HandlerFunc) runtime.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+			if _, ok := platformauth.ActorFromContext(r.Context()); !ok {
+				next(w, r, pathParams)
+				return
+			}
+			pattern, _ := runtime.HTTPPattern(r.Context())
+			method, ok := s.routes[r.Method+" "+pattern.String()]
+			if !ok {
+				http.Error(w, "permission denied: unknown route", http.StatusForbidden)
+				return
+			}
+			if err := s.check(r.Context(), method); err != nil {
+				code := http.StatusForbidden
+				if status.Code(err) == codes.Unavailable {
+					code = http.StatusServiceUnavailable
+				}
+				http.Error(w, status.Convert(err).Message(), code)
+				return
+			}
+			next(w, r, pathParams)
+		}
+	}
+}
+
+func (s *RoleService) authorizeAdmin(ctx context.Context, meta *rgsv1.RequestMeta) (*rgsv1.Actor, string) {
+	actor, reason := resolveActor(ctx, meta)
+	if reason != "" {
+		return nil, reason
+	}
+	if actor.ActorType != rgsv1.ActorType_ACTOR_TYPE_OPERATOR {
+		return nil, "operator actor required"
+	}
+	return actor, ""
+}
+
+// validPermission accepts "*", "<service>/*" for a known service, or a known
+// method.
+func (s *RoleService) validPermission(perm string) bool {
+	if perm == "*" {
+		return true
+	}
+	svc, wildcard := strings.CutSuffix(perm, "/*")
+	for _, known := range s.catalog {
+		if known == perm || (wildcard && strings.HasPrefix(known, svc+"/")) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *RoleService) CreateRole(ctx context.Context, req *rgsv1.CreateRoleRequest) (*rgsv1.CreateRoleResponse, error) {
+	if req == nil {
+		req = &rgsv1.CreateRoleRequest{}
+	}
+	actor, reason := s.authorizeAdmin(ctx, req.Meta)
+	if reason != "" {
+		s.auditDenied(req.Meta, "rbac_role", req.Name, "rbac_create_role", reason)
+		return &rgsv1.CreateRoleResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	if !roleNamePattern.MatchString(req.Name) {
+		return &rgsv1.CreateRoleResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "name must be lowercase letters, digits, '-' or '_'")}, nil
+	}
+	if builtInRole(req.Name) != nil {
+		return &rgsv1.CreateRoleResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "built-in roles cannot be redefined")}, nil
+	}
+	if len(req.Permissions) == 0 {
+		return &rgsv1.CreateRoleResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "permissions are required")}, nil
+	}
+	seen := make(map[string]bool, len(req.Permissions))
+	perms := make([]string, 0, len(req.Permissions))
+	for _, p := range req.Permissions {
+		p = strings.TrimSpace(p)
+		if !s.validPermission(p) {
+			return &rgsv1.CreateRoleResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "unknown permission: "+p)}, nil
+		}
+		if !seen[p] {
+			seen[p] = true
+			perms = append(perms, p)
+		}
+	}
+	sort.Strings(perms)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	existing, err := s.loadRoleLocked(ctx, req.Name)
+	if err != nil {
+		return &rgsv1.CreateRoleResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if existing != nil {
+		return &rgsv1.CreateRoleResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "role already exists")}, nil
+	}
+	role := &rgsv1.Role{
+		Name:        req.Name,
+		Description: req.Description,
+		Permissions: perms,
+		CreatedAt:   s.now().Format(time.RFC3339Nano),
+		CreatedBy:   actor.ActorId,
+	}
+	if s.db != nil {
+		if err := s.insertRoleInDB(ctx, role); err != nil {
+			return &rgsv1.CreateRoleResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+	} else {
+		s.roles[role.Name] = proto.Clone(role).(*rgsv1.Role)
+	}
+	after, _ := json.Marshal(role)
+	if err := s.appendAuditLocked(actor, "rbac_role", role.Name, "rbac_create_role", []byte(`{}`), after, audit.ResultSuccess, req.Reason); err != nil {
+		return &rgsv1.CreateRoleResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	return &rgsv1.CreateRoleResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Role: role}, nil
+}
+
+// validateAssignment checks an assign or revoke request, returning the
+// calling operator or the result code and reason to answer with.
+func (s *RoleService) validateAssignment(ctx context.Context, meta *rgsv1.RequestMeta, target *rgsv1.Actor, roleName, action string) (*rgsv1.Actor, rgsv1.ResultCode, string) {
+	actor, reason := s.authorizeAdmin(ctx, meta)
+	if reason != "" {
+		s.auditDenied(meta, "rbac_assignment", roleName, action, reason)
+		return nil, rgsv1.ResultCode_RESULT_CODE_DENIED, reason
+	}
+	if target == nil || target.ActorId == "" || target.ActorType == rgsv1.ActorType_ACTOR_TYPE_UNSPECIFIED || roleName == "" {
+		return nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "actor and role_name are required"
+	}
+	if target.ActorId == actor.ActorId && target.ActorType == actor.ActorType {
+		reason = "cannot change own roles"
+		s.auditDenied(meta, "rbac_assignment", roleName, action, reason)
+		return nil, rgsv1.ResultCode_RESULT_CODE_DENIED, reason
+	}
+	return actor, rgsv1.ResultCode_RESULT_CODE_OK, ""
+}
+
+func (s *RoleService) AssignRole(ctx context.Context, req *rgsv1.AssignRoleRequest) (*rgsv1.AssignRoleResponse, error) {
+	if req == nil {
+		req = &rgsv1.AssignRoleRequest{}
+	}
+	actor, code, reason := s.validateAssignment(ctx, req.Meta, req.Actor, req.RoleName, "rbac_assign_role")
+	if code != rgsv1.ResultCode_RESULT_CODE_OK {
+		return &rgsv1.AssignRoleResponse{Meta: s.responseMeta(req.Meta, code, reason)}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	role, err := s.loadRoleLocked(ctx, req.RoleName)
+	if err != nil {
+		return &rgsv1.AssignRoleResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if role == nil {
+		return &rgsv1.AssignRoleResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "role not found")}, nil
+	}
+	assignment := &rgsv1.RoleAssignment{
+		Actor:      &rgsv1.Actor{ActorId: req.Actor.ActorId, ActorType: req.Actor.ActorType},
+		RoleName:   req.RoleName,
+		AssignedAt: s.now().Format(time.RFC3339Nano),
+		AssignedBy: actor.ActorId,
+	}
+	key := rbacActorKey(req.Actor.ActorId, req.Actor.ActorType)
+	if s.db != nil {
+		if err := s.insertAssignmentInDB(ctx, assignment); err != nil {
+			return &rgsv1.AssignRoleResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+		delete(s.cache, key)
+	} else {
+		if s.assignments[key] == nil {
+			s.assignments[key] = make(map[string]*rgsv1.RoleAssignment)
+		}
+		if prior, ok := s.assignments[key][req.RoleName]; ok {
+			assignment = prior
+		} else {
+			s.assignments[key][req.RoleName] = assignment
+		}
+	}
+	after, _ := json.Marshal(assignment)
+	if err := s.appendAuditLocked(actor, "rbac_assignment", key+"|"+req.RoleName, "rbac_assign_role", []byte(`{}`), after, audit.ResultSuccess, req.Reason); err != nil {
+		return &rgsv1.AssignRoleResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	return &rgsv1.AssignRoleResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Assignment: assignment}, nil
+}
+
+func (s *RoleService) RevokeRole(ctx context.Context, req *rgsv1.RevokeRoleRequest) (*rgsv1.RevokeRoleResponse, error) {
+	if req == nil {
+		req = &rgsv1.RevokeRoleRequest{}
+	}
+	actor, code, reason := s.validateAssignment(ctx, req.Meta, req.Actor, req.RoleName, "rbac_revoke_role")
+	if code != rgsv1.ResultCode_RESULT_CODE_OK {
+		return &rgsv1.RevokeRoleResponse{Meta: s.responseMeta(req.Meta, code, reason)}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := rbacActorKey(req.Actor.ActorId, req.Actor.ActorType)
+	var found bool
+	if s.db != nil {
+		var err error
+		found, err = s.deleteAssignmentInDB(ctx, req.Actor, req.RoleName)
+		if err != nil {
+			return &rgsv1.RevokeRoleResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+		delete(s.cache, key)
+	} else if _, found = s.assignments[key][req.RoleName]; found {
+		delete(s.assignments[key], req.RoleName)
+	}
+	if !found {
+		return &rgsv1.RevokeRoleResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "role assignment not found")}, nil
+	}
+	if err := s.appendAuditLocked(actor, "rbac_assignment", key+"|"+req.RoleName, "rbac_revoke_role", []byte(`{}`), []byte(`{}`), audit.ResultSuccess, req.Reason); err != nil {
+		return &rgsv1.RevokeRoleResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	return &rgsv1.RevokeRoleResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, "")}, nil
+}
+
+// SyncExternalRoles replaces the actor's role assignments with roles, as
+// asserted by the external identity provider named by source. Every role
+// must already exist. A change is audited under the system actor.
+func (s *RoleService) SyncExternalRoles(ctx context.Context, actor *rgsv1.Actor, roles []string, source string) error {
+	if s == nil || actor == nil || actor.ActorId == "" {
+		return errors.New("actor is required")
+	}
+	want := make(map[string]bool, len(roles))
+	for _, name := range roles {
+		want[name] = true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for name := range want {
+		role, err := s.loadRoleLocked(ctx, name)
+		if err != nil {
+			return err
+		}
+		if role == nil {
+			return fmt.Errorf("role %q not found", name)
+		}
+	}
+	key := rbacActorKey(actor.ActorId, actor.ActorType)
+	var current []string
+	if s.db != nil {
+		var err error
+		if current, err = s.actorRoleNamesFromDB(ctx, actor.ActorId, actor.ActorType); err != nil {
+			return err
+		}
+	} else {
+		for name := range s.assignments[key] {
+			current = append(current, name)
+		}
+	}
+	sort.Strings(current)
+	have := make(map[string]bool, len(current))
+	changed := false
+	for _, name := range current {
+		have[name] = true
+		if want[name] {
+			continue
+		}
+		changed = true
+		if s.db != nil {
+			if _, err := s.deleteAssignmentInDB(ctx, actor, name); err != nil {
+				return err
+			}
+		} else {
+			delete(s.assignments[key], name)
+		}
+	}
+	now := s.now().Format(time.RFC3339Nano)
+	var synced []string
+	for name := range want {
+		synced = append(synced, name)
+		if have[name] {
+			continue
+		}
+		changed = true
+		assignment := &rgsv1.RoleAssignment{
+			Actor:      &rgsv1.Actor{ActorId: actor.ActorId, ActorType: actor.ActorType},
+			RoleName:   name,
+			AssignedAt: now,
+			AssignedBy: source,
+		}
+		if s.db != nil {
+			if err := s.insertAssignmentInDB(ctx, assignment); err != nil {
+				return err
+			}
+		} else {
+			if s.assignments[key] == nil {
+				s.assignments[key] = make(map[string]*rgsv1.RoleAssignment)
+			}
+			s.assignments[key][name] = assignment
+		}
+	}
+	delete(s.cache, key)
+	if !changed {
+		return nil
+	}
+	sort.Strings(synced)
+	before, _ := json.Marshal(map[string][]string{"roles": current})
+	after, _ := json.Marshal(map[string][]string{"roles": synced})
+	return s.appendAuditLocked(nil, "rbac_assignment", key, "rbac_sync_roles", before, after, audit.ResultSuccess, source)
+}
+
+func (s *RoleService) ListPermissions(ctx context.Context, req *rgsv1.ListPermissionsRequest) (*rgsv1.ListPermissionsResponse, error) {
+	if req == nil {
+		req = &rgsv1.ListPermissionsRequest{}
+	}
+	actor, reason := resolveActor(ctx, req.Meta)
+	if reason == "" && actor.ActorType != rgsv1.ActorType_ACTOR_TYPE_OPERATOR && actor.ActorType != rgsv1.ActorType_ACTOR_TYPE_SERVICE {
+		reason = "unauthorized actor type"
+	}
+	if reason != "" {
+		s.auditDenied(req.Meta, "rbac_permission", "", "rbac_list_permissions", reason)
+		return &rgsv1.ListPermissionsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := &rgsv1.ListPermissionsResponse{Permissions: append([]string(nil), s.catalog...)}
+	for _, name := range []string{"operator", "player", "service"} {
+		out.Roles = append(out.Roles, builtInRole(name))
+	}
+	var custom []*rgsv1.Role
+	if s.db != nil {
+		var err error
+		custom, err = s.listRolesFromDB(ctx)
+		if err != nil {
+			return &rgsv1.ListPermissionsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+	} else {
+		for _, r := range s.roles {
+			custom = append(custom, proto.Clone(r).(*rgsv1.Role))
+		}
+		sort.Slice(custom, func(i, j int) bool { return custom[i].Name < custom[j].Name })
+	}
+	out.Roles = append(out.Roles, custom...)
+	if req.Actor != nil && req.Actor.ActorId != "" {
+		names, granted, err := s.actorGrantsLocked(ctx, req.Actor.ActorId, req.Actor.ActorType)
+		if err != nil {
+			return &rgsv1.ListPermissionsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+		out.ActorRoles = names
+		for _, perm := range s.catalog {
+			for _, g := range granted {
+				if permissionGrants(g, perm) {
+					out.EffectivePermissions = append(out.EffectivePermissions, perm)
+					break
+				}
+			}
+		}
+	}
+	out.Meta = s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, "")
+	return out, nil
+}
@@ -0,0 +1,140 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func (s *RoleService) insertRoleInDB(ctx context.Context, role *rgsv1.Role) error {
+	perms, err := json.Marshal(role.Permissions)
+	if err != nil {
+		return err
+	}
+	createdAt, err := time.Parse(time.RFC3339Nano, role.CreatedAt)
+	if err != nil {
+		return err
+	}
+	const q = `
+INSERT INTO rbac_roles (role_name, description, permissions, created_at, created_by)
+VALUES ($1, $2, $3::jsonb, $4, $5)
+`
+	_, err = s.db.ExecContext(ctx, q, role.Name, role.Description, string(perms), createdAt, role.CreatedBy)
+	return err
+}
+
+func scanRole(scan func(dest ...any) error) (*rgsv1.Role, error) {
+	var (
+		role      rgsv1.Role
+		perms     []byte
+		createdAt time.Time
+	)
+	if err := scan(&role.Name, &role.Description, &perms, &createdAt, &role.CreatedBy); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(perms, &role.Permissions); err != nil {
+		return nil, err
+	}
+	role.CreatedAt = createdAt.UTC().Format(time.RFC3339Nano)
+	return &role, nil
+}
+
+func (s *RoleService) getRoleFromDB(ctx context.Context, name string) (*rgsv1.Role, error) {
+	const q = `
+SELECT role_name, description, permissions, created_at, created_by
+FROM rbac_roles
+WHERE role_name = $1
+`
+	role, err := scanRole(s.db.QueryRowContext(ctx, q, name).Scan)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return role, err
+}
+
+// listRolesFromDB returns custom roles; built-in rows are seeded by the
+// migration only so assignments can reference them.
+func (s *RoleService) listRolesFromDB(ctx context.Context) ([]*rgsv1.Role, error) {
+	const q = `
+SELECT role_name, description, permissions, created_at, created_by
+FROM rbac_roles
+WHERE role_name NOT IN ('operator', 'player', 'service')
+ORDER BY role_name
+`
+	rows, err := s.db.QueryContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []*rgsv1.Role
+	for rows.Next() {
+		role, err := scanRole(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, role)
+	}
+	return out, rows.Err()
+}
+
+func (s *RoleService) insertAssignmentInDB(ctx context.Context, a *rgsv1.RoleAssignment) error {
+	assignedAt, err := time.Parse(time.RFC3339Nano, a.AssignedAt)
+	if err != nil {
+		return err
+	}
+	const q = `
+INSERT INTO rbac_role_assignments (actor_id, actor_type, role_name, assigned_at, assigned_by)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (actor_id, actor_type, role_name) DO UPDATE SET role_name = EXCLUDED.role_name
+RETURNING assigned_at, assigned_by
+`
+	var at time.Time
+	if err := s.db.QueryRowContext(ctx, q, a.Actor.ActorId, a.Actor.ActorType.String(), a.RoleName, assignedAt, a.AssignedBy).Scan(&at, &a.AssignedBy); err != nil {
+		return err
+	}
+	a.AssignedAt = at.UTC().Format(time.RFC3339Nano)
+	return nil
+}
+
+func (s *RoleService) deleteAssignmentInDB(ctx context.Context, actor *rgsv1.Actor, roleName string) (bool, error) {
+	const q = `
+DELETE FROM rbac_role_assignments
+WHERE actor_id = $1 AND actor_type = $2 AND role_name = $3
+`
+	res, err := s.db.ExecContext(ctx, q, actor.ActorId, actor.ActorType.String(), roleName)
+	if err != nil {
+		return false, err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+func (s *RoleService) actorRoleNamesFromDB(ctx context.Context, actorID string, actorType rgsv1.ActorType) ([]string, error) {
+	const q = `
+SELECT role_name
+FROM rbac_role_assignments
+WHERE actor_id = $1 AND actor_type = $2
+ORDER BY role_name
+`
+	rows, err := s.db.QueryContext(ctx, q, actorID, actorType.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		out = append(out, name)
+	}
+	return out, rows.Err()
+}
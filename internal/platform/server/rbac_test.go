@@ -0,0 +1,190 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	platformauth "github.com/wizardbeardstudio/open-rgs-go/internal/platform/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newTestCashierRole(t *testing.T, svc *RoleService) {
+	t.Helper()
+	ctx := context.Background()
+	created, _ := svc.CreateRole(ctx, &rgsv1.CreateRoleRequest{
+		Meta:        meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		Name:        "cashier",
+		Permissions: []string{"rgs.v1.LedgerService/*", "rgs.v1.SessionsService/GetSession"},
+		Reason:      "cage staff",
+	})
+	if created.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("create role failed: %+v", created.Meta)
+	}
+	assigned, _ := svc.AssignRole(ctx, &rgsv1.AssignRoleRequest{
+		Meta:     meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		Actor:    &rgsv1.Actor{ActorId: "op-2", ActorType: rgsv1.ActorType_ACTOR_TYPE_OPERATOR},
+		RoleName: "cashier",
+		Reason:   "shift start",
+	})
+	if assigned.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("assign role failed: %+v", assigned.Meta)
+	}
+}
+
+func TestRoleServiceAssignmentReplacesBuiltInRole(t *testing.T) {
+	ctx := context.Background()
+	svc := NewRoleService(ledgerFixedClock{now: time.Date(2026, 2, 20, 10, 0, 0, 0, time.UTC)})
+	op2 := platformauth.Actor{ID: "op-2", Type: "ACTOR_TYPE_OPERATOR"}
+
+	if ok, _ := svc.Authorize(ctx, op2, "/rgs.v1.ConfigService/ProposeConfigChange"); !ok {
+		t.Fatalf("expected built-in operator role to allow config changes")
+	}
+	newTestCashierRole(t, svc)
+	for perm, want := range map[string]bool{
+		"/rgs.v1.LedgerService/Deposit":             true,
+		"/rgs.v1.SessionsService/GetSession":        true,
+		"/rgs.v1.SessionsService/StartSession":      false,
+		"/rgs.v1.ConfigService/ProposeConfigChange": false,
+	} {
+		if ok, err := svc.Authorize(ctx, op2, perm); err != nil || ok != want {
+			t.Fatalf("authorize %s: got=%v want=%v err=%v", perm, ok, want, err)
+		}
+	}
+
+	list, _ := svc.ListPermissions(ctx, &rgsv1.ListPermissionsRequest{
+		Meta:  meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		Actor: &rgsv1.Actor{ActorId: "op-2", ActorType: rgsv1.ActorType_ACTOR_TYPE_OPERATOR},
+	})
+	if list.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || len(list.ActorRoles) != 1 || list.ActorRoles[0] != "cashier" {
+		t.Fatalf("expected cashier role listed, got=%+v", list)
+	}
+	for _, perm := range list.EffectivePermissions {
+		if !strings.HasPrefix(perm, "rgs.v1.LedgerService/") && perm != "rgs.v1.SessionsService/GetSession" {
+			t.Fatalf("unexpected effective permission %q", perm)
+		}
+	}
+	if len(list.Roles) != 4 || len(list.Permissions) <= len(list.EffectivePermissions) {
+		t.Fatalf("expected built-in and custom roles with full catalog, got roles=%d perms=%d", len(list.Roles), len(list.Permissions))
+	}
+
+	revoked, _ := svc.RevokeRole(ctx, &rgsv1.RevokeRoleRequest{
+		Meta:     meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		Actor:    &rgsv1.Actor{ActorId: "op-2", ActorType: rgsv1.ActorType_ACTOR_TYPE_OPERATOR},
+		RoleName: "cashier",
+	})
+	if revoked.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("revoke role failed: %+v", revoked.Meta)
+	}
+	if ok, _ := svc.Authorize(ctx, op2, "/rgs.v1.ConfigService/ProposeConfigChange"); !ok {
+		t.Fatalf("expected revoke to restore the built-in operator role")
+	}
+}
+
+func TestRoleServiceRejectsInvalidChanges(t *testing.T) {
+	ctx := context.Background()
+	svc := NewRoleService(ledgerFixedClock{now: time.Date(2026, 2, 20, 10, 0, 0, 0, time.UTC)})
+	for _, tc := range []struct {
+		name string
+		req  *rgsv1.CreateRoleRequest
+		code rgsv1.ResultCode
+	}{
+		{"player", &rgsv1.CreateRoleRequest{Meta: meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""), Name: "vip", Permissions: []string{"*"}}, rgsv1.ResultCode_RESULT_CODE_DENIED},
+		{"built-in", &rgsv1.CreateRoleRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), Name: "operator", Permissions: []string{"*"}}, rgsv1.ResultCode_RESULT_CODE_INVALID},
+		{"bad name", &rgsv1.CreateRoleRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), Name: "Floor Staff", Permissions: []string{"*"}}, rgsv1.ResultCode_RESULT_CODE_INVALID},
+		{"unknown permission", &rgsv1.CreateRoleRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), Name: "floor", Permissions: []string{"rgs.v1.LedgerService/Nope"}}, rgsv1.ResultCode_RESULT_CODE_INVALID},
+		{"unknown service", &rgsv1.CreateRoleRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), Name: "floor", Permissions: []string{"rgs.v1.Nope/*"}}, rgsv1.ResultCode_RESULT_CODE_INVALID},
+	} {
+		resp, _ := svc.CreateRole(ctx, tc.req)
+		if resp.Meta.GetResultCode() != tc.code {
+			t.Fatalf("%s: got=%v want=%v", tc.name, resp.Meta.GetResultCode(), tc.code)
+		}
+	}
+
+	self, _ := svc.AssignRole(ctx, &rgsv1.AssignRoleRequest{
+		Meta:     meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		Actor:    &rgsv1.Actor{ActorId: "op-1", ActorType: rgsv1.ActorType_ACTOR_TYPE_OPERATOR},
+		RoleName: "service",
+	})
+	if self.Meta.GetDenialReason() != "cannot change own roles" {
+		t.Fatalf("expected self-assignment denied, got=%+v", self.Meta)
+	}
+	missing, _ := svc.AssignRole(ctx, &rgsv1.AssignRoleRequest{
+		Meta:     meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		Actor:    &rgsv1.Actor{ActorId: "op-2", ActorType: rgsv1.ActorType_ACTOR_TYPE_OPERATOR},
+		RoleName: "auditor",
+	})
+	if missing.Meta.GetDenialReason() != "role not found" {
+		t.Fatalf("expected unknown role rejected, got=%+v", missing.Meta)
+	}
+	list, _ := svc.ListPermissions(ctx, &rgsv1.ListPermissionsRequest{Meta: meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "")})
+	if list.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected player denied permission listing, got=%+v", list.Meta)
+	}
+}
+
+func TestUnaryRBACInterceptor(t *testing.T) {
+	svc := NewRoleService(ledgerFixedClock{now: time.Date(2026, 2, 20, 10, 0, 0, 0, time.UTC)})
+	newTestCashierRole(t, svc)
+	interceptor := UnaryRBACInterceptor(svc)
+	handler := func(context.Context, any) (any, error) { return "ok", nil }
+
+	ctx := platformauth.WithActor(context.Background(), platformauth.Actor{ID: "op-2", Type: "ACTOR_TYPE_OPERATOR"})
+	if _, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/rgs.v1.LedgerService/Deposit"}, handler); err != nil {
+		t.Fatalf("expected ledger call allowed, got=%v", err)
+	}
+	_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/rgs.v1.ConfigService/ProposeConfigChange"}, handler)
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected permission denied, got=%v", err)
+	}
+	// Public methods carry no actor and are left to the JWT interceptor.
+	if _, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/rgs.v1.IdentityService/Login"}, handler); err != nil {
+		t.Fatalf("expected unauthenticated call passed through, got=%v", err)
+	}
+	var denied bool
+	for _, ev := range auditEvents(svc.AuditStore) {
+		if ev.Action == "rbac_authorize" && ev.ObjectID == "rgs.v1.ConfigService/ProposeConfigChange" {
+			denied = true
+		}
+	}
+	if !denied {
+		t.Fatalf("expected denied call to be audited")
+	}
+}
+
+func TestRBACGatewayMiddlewareResolvesRoutes(t *testing.T) {
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 20, 10, 0, 0, 0, time.UTC)}
+	svc := NewRoleService(clk)
+	newTestCashierRole(t, svc)
+	gwMux := runtime.NewServeMux(runtime.WithMiddlewares(svc.GatewayMiddleware()))
+	if err := rgsv1.RegisterLedgerServiceHandlerServer(context.Background(), gwMux, NewLedgerService(clk)); err != nil {
+		t.Fatalf("register ledger gateway handlers: %v", err)
+	}
+	if err := rgsv1.RegisterSessionsServiceHandlerServer(context.Background(), gwMux, NewSessionsService(clk)); err != nil {
+		t.Fatalf("register sessions gateway handlers: %v", err)
+	}
+
+	for _, tc := range []struct {
+		method, path string
+		denied       bool
+	}{
+		{http.MethodGet, "/v1/ledger/accounts/acct-1/transactions", false},
+		{http.MethodGet, "/v1/sessions/sess-1", false},
+		{http.MethodPost, "/v1/sessions:start", true},
+	} {
+		req := httptest.NewRequest(tc.method, tc.path, strings.NewReader("{}"))
+		req = req.WithContext(platformauth.WithActor(req.Context(), platformauth.Actor{ID: "op-2", Type: "ACTOR_TYPE_OPERATOR"}))
+		rec := httptest.NewRecorder()
+		gwMux.ServeHTTP(rec, req)
+		if got := rec.Result().StatusCode == http.StatusForbidden; got != tc.denied {
+			t.Fatalf("%s %s: status=%d body=%s", tc.method, tc.path, rec.Result().StatusCode, rec.Body.String())
+		}
+	}
+}
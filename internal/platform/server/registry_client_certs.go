@@ -0,0 +1,330 @@
+package server
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	platformauth "github.com/wizardbeardstudio/open-rgs-go/internal/platform/auth"
+	"google.golang.org/protobuf/proto"
+)
+
+var errClientCertificateBound = errors.New("client certificate already bound")
+
+// ServiceActorDirectory reports whether an actor holds service credentials.
+// IdentityService implements it so the registry only binds certificates to
+// service actors it knows.
+type ServiceActorDirectory interface {
+	ServiceActorRegistered(ctx context.Context, actorID string) (bool, error)
+}
+
+// SetServiceActorDirectory sets the directory consulted before a
+// certificate is bound to an actor that is not registered equipment.
+func (s *RegistryService) SetServiceActorDirectory(d ServiceActorDirectory) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.serviceActors = d
+}
+
+// serviceActorRegistered reports whether actorID is a service actor a
+// certificate may be bound to: equipment in the registry that is still in
+// service, or an actor with service credentials in the directory.
+func (s *RegistryService) serviceActorRegistered(ctx context.Context, actorID string) (bool, error) {
+	eq, err := s.lookupEquipment(ctx, actorID)
+	if err != nil {
+		return false, err
+	}
+	if eq != nil && !equipmentStatusFinal(eq.Status) {
+		return true, nil
+	}
+	s.mu.Lock()
+	directory := s.serviceActors
+	s.mu.Unlock()
+	if directory == nil {
+		return false, nil
+	}
+	return directory.ServiceActorRegistered(ctx, actorID)
+}
+
+func (s *RegistryService) authorizeOperator(ctx context.Context, meta *rgsv1.RequestMeta) (bool, string) {
+	actor, reason := resolveActor(ctx, meta)
+	if reason != "" {
+		return false, reason
+	}
+	if actor.ActorType != rgsv1.ActorType_ACTOR_TYPE_OPERATOR {
+		return false, "operator actor required"
+	}
+	return true, ""
+}
+
+func cloneClientCertificateBinding(b *rgsv1.ClientCertificateBinding) *rgsv1.ClientCertificateBinding {
+	if b == nil {
+		return nil
+	}
+	cp, _ := proto.Clone(b).(*rgsv1.ClientCertificateBinding)
+	return cp
+}
+
+func clientCertificateSnapshot(b *rgsv1.ClientCertificateBinding) []byte {
+	if b == nil {
+		return []byte(`{}`)
+	}
+	out, _ := json.Marshal(b)
+	return out
+}
+
+// normalizeFingerprint accepts the hex SHA-256 with or without colon
+// separators, in either case.
+func normalizeFingerprint(v string) (string, bool) {
+	fp := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(v), ":", ""))
+	if raw, err := hex.DecodeString(fp); err != nil || len(raw) != 32 {
+		return "", false
+	}
+	return fp, true
+}
+
+func (s *RegistryService) newClientCertificateBindingID() string {
+	s.nextClientCertID++
+	return "ccb-" + strconv.FormatInt(time.Now().UTC().UnixNano(), 10) + "-" + strconv.FormatInt(s.nextClientCertID, 10)
+}
+
+// bindClientCertificateLocked stores binding and then audits it as action.
+// A store that fails is audited as such, and a binding whose audit fails is
+// removed again, so no certificate maps to an actor without a record of who
+// bound it. s.mu must be held.
+func (s *RegistryService) bindClientCertificateLocked(ctx context.Context, meta *rgsv1.RequestMeta, binding *rgsv1.ClientCertificateBinding, action, reason string) (rgsv1.ResultCode, string) {
+	var err error
+	switch {
+	case s.db != nil:
+		err = s.insertClientCertificateDB(ctx, binding)
+	case s.activeBindingLocked(binding.FingerprintSha256, binding.San) != nil:
+		err = errClientCertificateBound
+	case !s.disableInMemoryCache:
+		s.clientCerts[binding.BindingId] = binding
+	}
+	if err != nil {
+		code, result, failure := rgsv1.ResultCode_RESULT_CODE_ERROR, audit.ResultError, "persistence unavailable"
+		if errors.Is(err, errClientCertificateBound) {
+			code, result, failure = rgsv1.ResultCode_RESULT_CODE_INVALID, audit.ResultDenied, "client certificate already bound"
+		}
+		_ = s.appendAudit(meta, "client_certificate", binding.BindingId, action, []byte(`{}`), clientCertificateSnapshot(binding), result, failure)
+		return code, failure
+	}
+	if err := s.appendAudit(meta, "client_certificate", binding.BindingId, action, []byte(`{}`), clientCertificateSnapshot(binding), audit.ResultSuccess, reason); err != nil {
+		if s.db != nil {
+			_ = s.deleteClientCertificateDB(ctx, binding.BindingId)
+		} else {
+			delete(s.clientCerts, binding.BindingId)
+		}
+		return rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable"
+	}
+	return rgsv1.ResultCode_RESULT_CODE_OK, ""
+}
+
+// activeBindingLocked returns the active binding for fingerprint or san,
+// whichever is set.
+func (s *RegistryService) activeBindingLocked(fingerprint, san string) *rgsv1.ClientCertificateBinding {
+	for _, b := range s.clientCerts {
+		if b.Status != rgsv1.ClientCertificateBindingStatus_CLIENT_CERTIFICATE_BINDING_STATUS_ACTIVE {
+			continue
+		}
+		if (fingerprint != "" && b.FingerprintSha256 == fingerprint) || (san != "" && b.San == san) {
+			return b
+		}
+	}
+	return nil
+}
+
+func (s *RegistryService) RegisterClientCertificate(ctx context.Context, req *rgsv1.RegisterClientCertificateRequest) (*rgsv1.RegisterClientCertificateResponse, error) {
+	if req == nil || req.Binding == nil {
+		return &rgsv1.RegisterClientCertificateResponse{Meta: s.responseMeta(nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "binding is required")}, nil
+	}
+	if ok, reason := s.authorizeOperator(ctx, req.Meta); !ok {
+		_ = s.appendAudit(req.Meta, "client_certificate", "", "register_client_certificate", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		return &rgsv1.RegisterClientCertificateResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	actorID := strings.TrimSpace(req.Binding.ActorId)
+	if actorID == "" {
+		return &rgsv1.RegisterClientCertificateResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "binding.actor_id is required")}, nil
+	}
+	san := strings.TrimSpace(req.Binding.San)
+	fingerprint := ""
+	if strings.TrimSpace(req.Binding.FingerprintSha256) != "" {
+		fp, ok := normalizeFingerprint(req.Binding.FingerprintSha256)
+		if !ok {
+			return &rgsv1.RegisterClientCertificateResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "binding.fingerprint_sha256 must be a hex sha-256 digest")}, nil
+		}
+		fingerprint = fp
+	}
+	if (fingerprint == "") == (san == "") {
+		return &rgsv1.RegisterClientCertificateResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "exactly one of binding.fingerprint_sha256 and binding.san is required")}, nil
+	}
+	registered, err := s.serviceActorRegistered(ctx, actorID)
+	if err != nil {
+		return &rgsv1.RegisterClientCertificateResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "service actors unavailable")}, nil
+	}
+	if !registered {
+		return &rgsv1.RegisterClientCertificateResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "binding.actor_id is not a registered service actor")}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	binding := &rgsv1.ClientCertificateBinding{
+		BindingId:         s.newClientCertificateBindingID(),
+		FingerprintSha256: fingerprint,
+		San:               san,
+		ActorId:           actorID,
+		Status:            rgsv1.ClientCertificateBindingStatus_CLIENT_CERTIFICATE_BINDING_STATUS_ACTIVE,
+		CreatedBy:         req.Meta.GetActor().GetActorId(),
+		CreatedAt:         s.now().Format(time.RFC3339Nano),
+	}
+	if code, reason := s.bindClientCertificateLocked(ctx, req.Meta, binding, "register_client_certificate", req.Reason); code != rgsv1.ResultCode_RESULT_CODE_OK {
+		return &rgsv1.RegisterClientCertificateResponse{Meta: s.responseMeta(req.Meta, code, reason)}, nil
+	}
+	return &rgsv1.RegisterClientCertificateResponse{
+		Meta:    s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
+		Binding: cloneClientCertificateBinding(binding),
+	}, nil
+}
+
+func (s *RegistryService) RevokeClientCertificate(ctx context.Context, req *rgsv1.RevokeClientCertificateRequest) (*rgsv1.RevokeClientCertificateResponse, error) {
+	if req == nil || req.BindingId == "" {
+		return &rgsv1.RevokeClientCertificateResponse{Meta: s.responseMeta(nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "binding_id is required")}, nil
+	}
+	if ok, reason := s.authorizeOperator(ctx, req.Meta); !ok {
+		_ = s.appendAudit(req.Meta, "client_certificate", req.BindingId, "revoke_client_certificate", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		return &rgsv1.RevokeClientCertificateResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	if strings.TrimSpace(req.Reason) == "" {
+		return &rgsv1.RevokeClientCertificateResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "reason is required")}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing := cloneClientCertificateBinding(s.clientCerts[req.BindingId])
+	if s.db != nil {
+		var err error
+		existing, err = s.getClientCertificateFromDB(ctx, req.BindingId)
+		if err != nil {
+			return &rgsv1.RevokeClientCertificateResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+	}
+	if existing == nil {
+		return &rgsv1.RevokeClientCertificateResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "binding not found")}, nil
+	}
+	if existing.Status == rgsv1.ClientCertificateBindingStatus_CLIENT_CERTIFICATE_BINDING_STATUS_REVOKED {
+		return &rgsv1.RevokeClientCertificateResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "binding already revoked")}, nil
+	}
+	revoked := cloneClientCertificateBinding(existing)
+	revoked.Status = rgsv1.ClientCertificateBindingStatus_CLIENT_CERTIFICATE_BINDING_STATUS_REVOKED
+	revoked.RevokedAt = s.now().Format(time.RFC3339Nano)
+	revoked.RevokeReason = req.Reason
+	if err := s.appendAudit(req.Meta, "client_certificate", revoked.BindingId, "revoke_client_certificate", clientCertificateSnapshot(existing), clientCertificateSnapshot(revoked), audit.ResultSuccess, req.Reason); err != nil {
+		return &rgsv1.RevokeClientCertificateResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	if s.db != nil {
+		if err := s.revokeClientCertificateDB(ctx, revoked); err != nil {
+			return &rgsv1.RevokeClientCertificateResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+	} else {
+		s.clientCerts[revoked.BindingId] = revoked
+	}
+	return &rgsv1.RevokeClientCertificateResponse{
+		Meta:    s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
+		Binding: cloneClientCertificateBinding(revoked),
+	}, nil
+}
+
+func (s *RegistryService) ListClientCertificates(ctx context.Context, req *rgsv1.ListClientCertificatesRequest) (*rgsv1.ListClientCertificatesResponse, error) {
+	if req == nil {
+		req = &rgsv1.ListClientCertificatesRequest{}
+	}
+	if ok, reason := s.authorizeOperator(ctx, req.Meta); !ok {
+		_ = s.appendAudit(req.Meta, "client_certificate", "", "list_client_certificates", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		return &rgsv1.ListClientCertificatesResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	if req.PageSize < 0 || req.PageSize > maxAuditPageSize {
+		return &rgsv1.ListClientCertificatesResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "invalid page_size")}, nil
+	}
+	if err := validatePageToken(req.PageToken); err != nil {
+		return &rgsv1.ListClientCertificatesResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "invalid page_token")}, nil
+	}
+
+	var items []*rgsv1.ClientCertificateBinding
+	if s.db != nil {
+		var err error
+		items, err = s.listClientCertificatesFromDB(ctx, req.ActorId, req.IncludeRevoked)
+		if err != nil {
+			return &rgsv1.ListClientCertificatesResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+	} else {
+		s.mu.Lock()
+		for _, b := range s.clientCerts {
+			if req.ActorId != "" && b.ActorId != req.ActorId {
+				continue
+			}
+			if !req.IncludeRevoked && b.Status != rgsv1.ClientCertificateBindingStatus_CLIENT_CERTIFICATE_BINDING_STATUS_ACTIVE {
+				continue
+			}
+			items = append(items, cloneClientCertificateBinding(b))
+		}
+		s.mu.Unlock()
+		sort.Slice(items, func(i, j int) bool {
+			if items[i].CreatedAt != items[j].CreatedAt {
+				return items[i].CreatedAt < items[j].CreatedAt
+			}
+			return items[i].BindingId < items[j].BindingId
+		})
+	}
+	page, next, err := paginate(items, req.PageToken, req.PageSize)
+	if err != nil {
+		return &rgsv1.ListClientCertificatesResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "invalid page_token")}, nil
+	}
+	return &rgsv1.ListClientCertificatesResponse{
+		Meta:          s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
+		Bindings:      page,
+		NextPageToken: next,
+	}, nil
+}
+
+// ActorForCertificate implements platformauth.CertificateActorResolver over
+// the active bindings. A fingerprint binding wins over a SAN binding, so a
+// single certificate can be pinned to a different actor than the rest of
+// its SAN.
+func (s *RegistryService) ActorForCertificate(ctx context.Context, cert *x509.Certificate) (platformauth.Actor, bool, error) {
+	if s == nil || cert == nil {
+		return platformauth.Actor{}, false, nil
+	}
+	fingerprint := platformauth.CertificateFingerprint(cert)
+	sans := platformauth.CertificateSANs(cert)
+	if s.db != nil {
+		actorID, ok, err := s.activeClientCertificateActorFromDB(ctx, fingerprint, sans)
+		if err != nil || !ok {
+			return platformauth.Actor{}, false, err
+		}
+		return platformauth.Actor{ID: actorID, Type: rgsv1.ActorType_ACTOR_TYPE_SERVICE.String()}, true, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b := s.activeBindingLocked(fingerprint, "")
+	for i := 0; b == nil && i < len(sans); i++ {
+		b = s.activeBindingLocked("", sans[i])
+	}
+	if b == nil {
+		return platformauth.Actor{}, false, nil
+	}
+	return platformauth.Actor{ID: b.ActorId, Type: rgsv1.ActorType_ACTOR_TYPE_SERVICE.String()}, true, nil
+}
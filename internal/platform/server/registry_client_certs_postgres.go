@@ -0,0 +1,134 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+const clientCertificateColumns = `
+binding_id, fingerprint_sha256, san, actor_id, created_by, created_at, revoked_at, revoke_reason`
+
+func scanClientCertificate(row interface{ Scan(...any) error }) (*rgsv1.ClientCertificateBinding, error) {
+	var (
+		b         rgsv1.ClientCertificateBinding
+		createdAt time.Time
+		revokedAt sql.NullTime
+	)
+	if err := row.Scan(&b.BindingId, &b.FingerprintSha256, &b.San, &b.ActorId, &b.CreatedBy, &createdAt, &revokedAt, &b.RevokeReason); err != nil {
+		return nil, err
+	}
+	b.CreatedAt = createdAt.UTC().Format(time.RFC3339Nano)
+	b.Status = rgsv1.ClientCertificateBindingStatus_CLIENT_CERTIFICATE_BINDING_STATUS_ACTIVE
+	if revokedAt.Valid {
+		b.Status = rgsv1.ClientCertificateBindingStatus_CLIENT_CERTIFICATE_BINDING_STATUS_REVOKED
+		b.RevokedAt = revokedAt.Time.UTC().Format(time.RFC3339Nano)
+	}
+	return &b, nil
+}
+
+// insertClientCertificateDB returns errClientCertificateBound when another
+// active binding already claims the fingerprint or SAN.
+func (s *RegistryService) insertClientCertificateDB(ctx context.Context, b *rgsv1.ClientCertificateBinding) error {
+	const q = `
+INSERT INTO registry_client_certificates (
+  binding_id, fingerprint_sha256, san, actor_id, created_by, created_at
+) VALUES ($1,$2,$3,$4,$5,$6::timestamptz)
+ON CONFLICT DO NOTHING
+`
+	res, err := s.db.ExecContext(ctx, q, b.BindingId, b.FingerprintSha256, b.San, b.ActorId, b.CreatedBy, b.CreatedAt)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return errClientCertificateBound
+	}
+	return nil
+}
+
+// deleteClientCertificateDB removes a binding whose registration could not
+// be audited.
+func (s *RegistryService) deleteClientCertificateDB(ctx context.Context, bindingID string) error {
+	const q = `
+DELETE FROM registry_client_certificates
+WHERE binding_id = $1
+`
+	_, err := s.db.ExecContext(ctx, q, bindingID)
+	return err
+}
+
+func (s *RegistryService) getClientCertificateFromDB(ctx context.Context, bindingID string) (*rgsv1.ClientCertificateBinding, error) {
+	q := `SELECT` + clientCertificateColumns + `
+FROM registry_client_certificates
+WHERE binding_id = $1
+`
+	b, err := scanClientCertificate(s.db.QueryRowContext(ctx, q, bindingID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return b, err
+}
+
+func (s *RegistryService) revokeClientCertificateDB(ctx context.Context, b *rgsv1.ClientCertificateBinding) error {
+	const q = `
+UPDATE registry_client_certificates
+SET revoked_at = $2::timestamptz, revoke_reason = $3
+WHERE binding_id = $1 AND revoked_at IS NULL
+`
+	_, err := s.db.ExecContext(ctx, q, b.BindingId, b.RevokedAt, b.RevokeReason)
+	return err
+}
+
+func (s *RegistryService) listClientCertificatesFromDB(ctx context.Context, actorID string, includeRevoked bool) ([]*rgsv1.ClientCertificateBinding, error) {
+	q := `SELECT` + clientCertificateColumns + `
+FROM registry_client_certificates
+WHERE ($1 = '' OR actor_id = $1)
+  AND ($2 OR revoked_at IS NULL)
+ORDER BY created_at, binding_id
+`
+	rows, err := s.db.QueryContext(ctx, q, actorID, includeRevoked)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]*rgsv1.ClientCertificateBinding, 0)
+	for rows.Next() {
+		b, err := scanClientCertificate(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, b)
+	}
+	return out, rows.Err()
+}
+
+// activeClientCertificateActorFromDB resolves the fingerprint first, then
+// each SAN in certificate order.
+func (s *RegistryService) activeClientCertificateActorFromDB(ctx context.Context, fingerprint string, sans []string) (string, bool, error) {
+	const byFingerprint = `
+SELECT actor_id FROM registry_client_certificates
+WHERE fingerprint_sha256 = $1 AND fingerprint_sha256 <> '' AND revoked_at IS NULL
+`
+	const bySAN = `
+SELECT actor_id FROM registry_client_certificates
+WHERE san = $1 AND san <> '' AND revoked_at IS NULL
+`
+	lookups := []struct{ q, v string }{{byFingerprint, fingerprint}}
+	for _, san := range sans {
+		lookups = append(lookups, struct{ q, v string }{bySAN, san})
+	}
+	for _, l := range lookups {
+		var actorID string
+		err := s.db.QueryRowContext(ctx, l.q, l.v).Scan(&actorID)
+		if err == sql.ErrNoRows {
+			continue
+		}
+		if err != nil {
+			return "", false, err
+		}
+		return actorID, true, nil
+	}
+	return "", false, nil
+}
@@ -0,0 +1,173 @@
+package server
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	platformauth "github.com/wizardbeardstudio/open-rgs-go/internal/platform/auth"
+)
+
+func testClientCertificate(t *testing.T, dnsName string) *x509.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: dnsName},
+		DNSNames:     []string{dnsName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("parse certificate: %v", err)
+	}
+	return cert
+}
+
+// serviceActorSet is a ServiceActorDirectory over a fixed set of actors.
+type serviceActorSet map[string]bool
+
+func (s serviceActorSet) ServiceActorRegistered(_ context.Context, actorID string) (bool, error) {
+	return s[actorID], nil
+}
+
+func registerTestClientCertificate(svc *RegistryService, binding *rgsv1.ClientCertificateBinding) *rgsv1.RegisterClientCertificateResponse {
+	resp, _ := svc.RegisterClientCertificate(context.Background(), &rgsv1.RegisterClientCertificateRequest{
+		Meta:    meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		Binding: binding,
+		Reason:  "onboard service",
+	})
+	return resp
+}
+
+func TestRegistryClientCertificateBindings(t *testing.T) {
+	svc := NewRegistryService(ledgerFixedClock{now: time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)})
+	svc.SetServiceActorDirectory(serviceActorSet{"cabinet-bridge": true, "bridge-canary": true, "someone-else": true, "svc": true})
+	ctx := context.Background()
+	pinned := testClientCertificate(t, "bridge.internal")
+	other := testClientCertificate(t, "bridge.internal")
+
+	bySAN := registerTestClientCertificate(svc, &rgsv1.ClientCertificateBinding{San: "bridge.internal", ActorId: "cabinet-bridge"})
+	if bySAN.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || bySAN.Binding.Status != rgsv1.ClientCertificateBindingStatus_CLIENT_CERTIFICATE_BINDING_STATUS_ACTIVE || bySAN.Binding.CreatedBy != "op-1" {
+		t.Fatalf("register san binding failed: %+v", bySAN)
+	}
+	colonFingerprint := strings.ToUpper(platformauth.CertificateFingerprint(pinned))
+	colonFingerprint = colonFingerprint[:2] + ":" + colonFingerprint[2:]
+	byFP := registerTestClientCertificate(svc, &rgsv1.ClientCertificateBinding{FingerprintSha256: colonFingerprint, ActorId: "bridge-canary"})
+	if byFP.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || byFP.Binding.FingerprintSha256 != platformauth.CertificateFingerprint(pinned) {
+		t.Fatalf("register fingerprint binding failed: %+v", byFP)
+	}
+
+	if actor, ok, err := svc.ActorForCertificate(ctx, pinned); err != nil || !ok || actor.ID != "bridge-canary" || actor.Type != "ACTOR_TYPE_SERVICE" {
+		t.Fatalf("expected fingerprint binding to win, got actor=%+v ok=%v err=%v", actor, ok, err)
+	}
+	if actor, ok, _ := svc.ActorForCertificate(ctx, other); !ok || actor.ID != "cabinet-bridge" {
+		t.Fatalf("expected san binding, got actor=%+v ok=%v", actor, ok)
+	}
+
+	for _, tc := range []struct {
+		binding *rgsv1.ClientCertificateBinding
+		reason  string
+	}{
+		{&rgsv1.ClientCertificateBinding{San: "bridge.internal", ActorId: "someone-else"}, "client certificate already bound"},
+		{&rgsv1.ClientCertificateBinding{San: "x.internal", FingerprintSha256: platformauth.CertificateFingerprint(other), ActorId: "svc"}, "exactly one of binding.fingerprint_sha256 and binding.san is required"},
+		{&rgsv1.ClientCertificateBinding{FingerprintSha256: "abc", ActorId: "svc"}, "binding.fingerprint_sha256 must be a hex sha-256 digest"},
+		{&rgsv1.ClientCertificateBinding{San: "x.internal"}, "binding.actor_id is required"},
+		{&rgsv1.ClientCertificateBinding{San: "x.internal", ActorId: "unknown-svc"}, "binding.actor_id is not a registered service actor"},
+	} {
+		if resp := registerTestClientCertificate(svc, tc.binding); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID || resp.Meta.GetDenialReason() != tc.reason {
+			t.Fatalf("expected %q, got=%+v", tc.reason, resp.Meta)
+		}
+	}
+
+	revoked, _ := svc.RevokeClientCertificate(ctx, &rgsv1.RevokeClientCertificateRequest{
+		Meta:      meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		BindingId: bySAN.Binding.BindingId,
+		Reason:    "key compromised",
+	})
+	if revoked.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || revoked.Binding.Status != rgsv1.ClientCertificateBindingStatus_CLIENT_CERTIFICATE_BINDING_STATUS_REVOKED {
+		t.Fatalf("revoke failed: %+v", revoked)
+	}
+	if _, ok, _ := svc.ActorForCertificate(ctx, other); ok {
+		t.Fatalf("expected revoked binding to stop authenticating")
+	}
+
+	list, _ := svc.ListClientCertificates(ctx, &rgsv1.ListClientCertificatesRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")})
+	if len(list.Bindings) != 1 || list.Bindings[0].ActorId != "bridge-canary" {
+		t.Fatalf("expected only active bindings by default, got=%+v", list.Bindings)
+	}
+	all, _ := svc.ListClientCertificates(ctx, &rgsv1.ListClientCertificatesRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), IncludeRevoked: true})
+	if len(all.Bindings) != 2 {
+		t.Fatalf("expected revoked bindings when requested, got=%+v", all.Bindings)
+	}
+
+	var audited int
+	for _, ev := range auditEvents(svc.AuditStore) {
+		if ev.ObjectType == "client_certificate" && ev.Result == "success" {
+			audited++
+		}
+	}
+	if audited != 3 {
+		t.Fatalf("expected register and revoke to be audited, got %d events", audited)
+	}
+}
+
+func TestRegistryClientCertificateRequiresOperator(t *testing.T) {
+	svc := NewRegistryService(ledgerFixedClock{now: time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)})
+	resp, _ := svc.RegisterClientCertificate(context.Background(), &rgsv1.RegisterClientCertificateRequest{
+		Meta:    meta("svc-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, ""),
+		Binding: &rgsv1.ClientCertificateBinding{San: "svc-1.internal", ActorId: "svc-1"},
+	})
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED || resp.Meta.GetDenialReason() != "operator actor required" {
+		t.Fatalf("expected service actors unable to bind certificates, got=%+v", resp.Meta)
+	}
+}
+
+func TestRegistryClientCertificateBindsEquipmentAndAuditsFailures(t *testing.T) {
+	svc := NewRegistryService(ledgerFixedClock{now: time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)})
+	ctx := context.Background()
+	op := meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+	if resp := registerTestClientCertificate(svc, &rgsv1.ClientCertificateBinding{San: "cab-1.internal", ActorId: "cab-1"}); resp.Meta.GetDenialReason() != "binding.actor_id is not a registered service actor" {
+		t.Fatalf("expected unregistered equipment to be refused, got=%+v", resp.Meta)
+	}
+	svc.UpsertEquipment(ctx, &rgsv1.UpsertEquipmentRequest{Meta: op, Equipment: &rgsv1.Equipment{EquipmentId: "cab-1"}})
+	if resp := registerTestClientCertificate(svc, &rgsv1.ClientCertificateBinding{San: "cab-1.internal", ActorId: "cab-1"}); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected registered equipment to be bound, got=%+v", resp.Meta)
+	}
+	if resp := registerTestClientCertificate(svc, &rgsv1.ClientCertificateBinding{San: "cab-1.internal", ActorId: "cab-1"}); resp.Meta.GetDenialReason() != "client certificate already bound" {
+		t.Fatalf("expected a duplicate binding to be refused, got=%+v", resp.Meta)
+	}
+	var failed int
+	for _, ev := range auditEvents(svc.AuditStore) {
+		if ev.Action == "register_client_certificate" && ev.Result == "denied" && ev.Reason == "client certificate already bound" {
+			failed++
+		}
+	}
+	if failed != 1 {
+		t.Fatalf("expected the failed binding to be audited, got %d events", failed)
+	}
+
+	svc.AuditStore = nil
+	if resp := registerTestClientCertificate(svc, &rgsv1.ClientCertificateBinding{San: "cab-1-alt.internal", ActorId: "cab-1"}); resp.Meta.GetDenialReason() != "audit unavailable" {
+		t.Fatalf("expected registration to fail without an audit, got=%+v", resp.Meta)
+	}
+	if _, ok, _ := svc.ActorForCertificate(ctx, testClientCertificate(t, "cab-1-alt.internal")); ok {
+		t.Fatalf("expected an unaudited binding to be removed")
+	}
+}
@@ -0,0 +1,191 @@
+package server
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	platformauth "github.com/wizardbeardstudio/open-rgs-go/internal/platform/auth"
+)
+
+const defaultEnrollmentCertValidity = 365 * 24 * time.Hour
+
+// EnrollmentCA signs the client certificates issued to enrolling equipment.
+// Its certificate must be in the mTLS client CA bundle for issued
+// certificates to authenticate.
+type EnrollmentCA struct {
+	Certificate *x509.Certificate
+	Signer      crypto.Signer
+	Validity    time.Duration
+}
+
+// LoadEnrollmentCA reads a PEM certificate and key pair. A zero validity
+// issues certificates for a year.
+func LoadEnrollmentCA(certFile, keyFile string, validity time.Duration) (*EnrollmentCA, error) {
+	pair, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("load enrollment ca keypair: %w", err)
+	}
+	cert, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return nil, fmt.Errorf("parse enrollment ca certificate: %w", err)
+	}
+	if !cert.IsCA {
+		return nil, fmt.Errorf("enrollment ca certificate is not a ca")
+	}
+	signer, ok := pair.PrivateKey.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("enrollment ca key cannot sign")
+	}
+	return &EnrollmentCA{Certificate: cert, Signer: signer, Validity: validity}, nil
+}
+
+// issue signs a client certificate for equipmentID over the CSR's key.
+func (ca *EnrollmentCA) issue(csr *x509.CertificateRequest, equipmentID string, now time.Time) (*x509.Certificate, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, err
+	}
+	validity := ca.Validity
+	if validity <= 0 {
+		validity = defaultEnrollmentCertValidity
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: equipmentID},
+		NotBefore:    now,
+		NotAfter:     now.Add(validity),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.Certificate, csr.PublicKey, ca.Signer)
+	if err != nil {
+		return nil, err
+	}
+	return x509.ParseCertificate(der)
+}
+
+func encodeCertificatePEM(cert *x509.Certificate) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}))
+}
+
+func decodePEMBlock(v, blockType string) ([]byte, error) {
+	block, _ := pem.Decode([]byte(strings.TrimSpace(v)))
+	if block == nil || block.Type != blockType {
+		return nil, errors.New("expected a PEM " + strings.ToLower(blockType))
+	}
+	return block.Bytes, nil
+}
+
+// authorizeEnrollment admits operators, and service actors enrolling the
+// equipment they authenticate as.
+func (s *RegistryService) authorizeEnrollment(ctx context.Context, meta *rgsv1.RequestMeta, equipmentID string) (*rgsv1.Actor, string) {
+	actor, reason := resolveActor(ctx, meta)
+	if reason != "" {
+		return nil, reason
+	}
+	switch actor.ActorType {
+	case rgsv1.ActorType_ACTOR_TYPE_OPERATOR:
+		return actor, ""
+	case rgsv1.ActorType_ACTOR_TYPE_SERVICE:
+		if actor.ActorId == equipmentID {
+			return actor, ""
+		}
+		return nil, "service actor may only enroll itself"
+	default:
+		return nil, "unauthorized actor type"
+	}
+}
+
+func (s *RegistryService) EnrollEquipment(ctx context.Context, req *rgsv1.EnrollEquipmentRequest) (*rgsv1.EnrollEquipmentResponse, error) {
+	if req == nil || req.EquipmentId == "" {
+		return &rgsv1.EnrollEquipmentResponse{Meta: s.responseMeta(nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "equipment_id is required")}, nil
+	}
+	actor, reason := s.authorizeEnrollment(ctx, req.Meta, req.EquipmentId)
+	if reason != "" {
+		_ = s.appendAudit(req.Meta, "client_certificate", "", "enroll_equipment", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		return &rgsv1.EnrollEquipmentResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	hasCSR, hasCert := strings.TrimSpace(req.CsrPem) != "", strings.TrimSpace(req.CertificatePem) != ""
+	if hasCSR == hasCert {
+		return &rgsv1.EnrollEquipmentResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "exactly one of csr_pem and certificate_pem is required")}, nil
+	}
+
+	eq, err := s.lookupEquipment(ctx, req.EquipmentId)
+	if err != nil {
+		return &rgsv1.EnrollEquipmentResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if eq == nil {
+		return &rgsv1.EnrollEquipmentResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "equipment not found")}, nil
+	}
+	if equipmentStatusFinal(eq.Status) {
+		return &rgsv1.EnrollEquipmentResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "equipment is "+eq.Status.String())}, nil
+	}
+
+	var cert *x509.Certificate
+	caPEM := ""
+	if hasCSR {
+		if s.EnrollmentCA == nil {
+			return &rgsv1.EnrollEquipmentResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "certificate issuance is not configured")}, nil
+		}
+		der, err := decodePEMBlock(req.CsrPem, "CERTIFICATE REQUEST")
+		if err != nil {
+			return &rgsv1.EnrollEquipmentResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "csr_pem: "+err.Error())}, nil
+		}
+		csr, err := x509.ParseCertificateRequest(der)
+		if err != nil || csr.CheckSignature() != nil {
+			return &rgsv1.EnrollEquipmentResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "csr_pem is not a valid signed request")}, nil
+		}
+		if cn := csr.Subject.CommonName; cn != "" && cn != req.EquipmentId {
+			return &rgsv1.EnrollEquipmentResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "csr common name must match equipment_id")}, nil
+		}
+		cert, err = s.EnrollmentCA.issue(csr, req.EquipmentId, s.now())
+		if err != nil {
+			return &rgsv1.EnrollEquipmentResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "certificate issuance failed")}, nil
+		}
+		caPEM = encodeCertificatePEM(s.EnrollmentCA.Certificate)
+	} else {
+		der, err := decodePEMBlock(req.CertificatePem, "CERTIFICATE")
+		if err != nil {
+			return &rgsv1.EnrollEquipmentResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "certificate_pem: "+err.Error())}, nil
+		}
+		if cert, err = x509.ParseCertificate(der); err != nil {
+			return &rgsv1.EnrollEquipmentResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "certificate_pem is not a valid certificate")}, nil
+		}
+		if s.now().After(cert.NotAfter) {
+			return &rgsv1.EnrollEquipmentResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "certificate has expired")}, nil
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	binding := &rgsv1.ClientCertificateBinding{
+		BindingId:         s.newClientCertificateBindingID(),
+		FingerprintSha256: platformauth.CertificateFingerprint(cert),
+		ActorId:           req.EquipmentId,
+		Status:            rgsv1.ClientCertificateBindingStatus_CLIENT_CERTIFICATE_BINDING_STATUS_ACTIVE,
+		CreatedBy:         actor.ActorId,
+		CreatedAt:         s.now().Format(time.RFC3339Nano),
+	}
+	if code, reason := s.bindClientCertificateLocked(ctx, req.Meta, binding, "enroll_equipment", req.Reason); code != rgsv1.ResultCode_RESULT_CODE_OK {
+		return &rgsv1.EnrollEquipmentResponse{Meta: s.responseMeta(req.Meta, code, reason)}, nil
+	}
+	return &rgsv1.EnrollEquipmentResponse{
+		Meta:             s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
+		Binding:          cloneClientCertificateBinding(binding),
+		CertificatePem:   encodeCertificatePEM(cert),
+		CaCertificatePem: caPEM,
+	}, nil
+}
@@ -0,0 +1,129 @@
+package server
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func testEnrollmentCA(t *testing.T) *EnrollmentCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate ca key: %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "equipment enrollment ca"},
+		NotBefore:             time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		NotAfter:              time.Date(2036, 1, 1, 0, 0, 0, 0, time.UTC),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create ca certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("parse ca certificate: %v", err)
+	}
+	return &EnrollmentCA{Certificate: cert, Signer: key, Validity: 24 * time.Hour}
+}
+
+func testCSR(t *testing.T, commonName string) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate device key: %v", err)
+	}
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{Subject: pkix.Name{CommonName: commonName}}, key)
+	if err != nil {
+		t.Fatalf("create csr: %v", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}))
+}
+
+func TestEnrollEquipmentIssuesAndBindsCertificates(t *testing.T) {
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	svc := NewRegistryService(ledgerFixedClock{now: now})
+	ctx := context.Background()
+	op := meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+	svc.UpsertEquipment(ctx, &rgsv1.UpsertEquipmentRequest{Meta: op, Equipment: &rgsv1.Equipment{EquipmentId: "cab-3"}})
+
+	if resp, _ := svc.EnrollEquipment(ctx, &rgsv1.EnrollEquipmentRequest{Meta: op, EquipmentId: "cab-3", CsrPem: testCSR(t, "cab-3")}); resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected csr enrollment without a ca to be invalid, got %v", resp.Meta.ResultCode)
+	}
+	ca := testEnrollmentCA(t)
+	svc.EnrollmentCA = ca
+
+	self := meta("cab-3", rgsv1.ActorType_ACTOR_TYPE_SERVICE, "")
+	resp, _ := svc.EnrollEquipment(ctx, &rgsv1.EnrollEquipmentRequest{Meta: self, EquipmentId: "cab-3", CsrPem: testCSR(t, ""), Reason: "first boot"})
+	if resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK || resp.Binding.ActorId != "cab-3" || resp.Binding.CreatedBy != "cab-3" || resp.CaCertificatePem == "" {
+		t.Fatalf("unexpected enrollment response: %+v", resp)
+	}
+	block, _ := pem.Decode([]byte(resp.CertificatePem))
+	issued, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatalf("parse issued certificate: %v", err)
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.Certificate)
+	if _, err := issued.Verify(x509.VerifyOptions{Roots: roots, CurrentTime: now.Add(time.Hour), KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}}); err != nil {
+		t.Fatalf("issued certificate does not chain to the enrollment ca: %v", err)
+	}
+	if issued.Subject.CommonName != "cab-3" || !issued.NotAfter.Equal(now.Add(24*time.Hour)) {
+		t.Fatalf("unexpected issued certificate subject or validity: %s %s", issued.Subject, issued.NotAfter)
+	}
+	actor, ok, err := svc.ActorForCertificate(ctx, issued)
+	if err != nil || !ok || actor.ID != "cab-3" || actor.Type != rgsv1.ActorType_ACTOR_TYPE_SERVICE.String() {
+		t.Fatalf("expected issued certificate to map to the equipment: %+v %v %v", actor, ok, err)
+	}
+
+	existing := testClientCertificate(t, "cab-3.floor")
+	existingPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: existing.Raw}))
+	registered, _ := svc.EnrollEquipment(ctx, &rgsv1.EnrollEquipmentRequest{Meta: op, EquipmentId: "cab-3", CertificatePem: existingPEM, Reason: "vendor cert"})
+	if registered.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK || registered.CaCertificatePem != "" {
+		t.Fatalf("unexpected registration response: %+v", registered)
+	}
+	if actor, ok, _ := svc.ActorForCertificate(ctx, existing); !ok || actor.ID != "cab-3" {
+		t.Fatalf("expected registered certificate to map to the equipment: %+v", actor)
+	}
+	if again, _ := svc.EnrollEquipment(ctx, &rgsv1.EnrollEquipmentRequest{Meta: op, EquipmentId: "cab-3", CertificatePem: existingPEM}); again.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected a bound certificate not to enroll twice, got %v", again.Meta.ResultCode)
+	}
+
+	cases := []struct {
+		name string
+		req  *rgsv1.EnrollEquipmentRequest
+		want rgsv1.ResultCode
+	}{
+		{"other equipment", &rgsv1.EnrollEquipmentRequest{Meta: meta("cab-4", rgsv1.ActorType_ACTOR_TYPE_SERVICE, ""), EquipmentId: "cab-3", CsrPem: testCSR(t, "")}, rgsv1.ResultCode_RESULT_CODE_DENIED},
+		{"player", &rgsv1.EnrollEquipmentRequest{Meta: meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""), EquipmentId: "cab-3", CsrPem: testCSR(t, "")}, rgsv1.ResultCode_RESULT_CODE_DENIED},
+		{"neither", &rgsv1.EnrollEquipmentRequest{Meta: op, EquipmentId: "cab-3"}, rgsv1.ResultCode_RESULT_CODE_INVALID},
+		{"both", &rgsv1.EnrollEquipmentRequest{Meta: op, EquipmentId: "cab-3", CsrPem: testCSR(t, ""), CertificatePem: existingPEM}, rgsv1.ResultCode_RESULT_CODE_INVALID},
+		{"common name", &rgsv1.EnrollEquipmentRequest{Meta: op, EquipmentId: "cab-3", CsrPem: testCSR(t, "cab-9")}, rgsv1.ResultCode_RESULT_CODE_INVALID},
+		{"malformed", &rgsv1.EnrollEquipmentRequest{Meta: op, EquipmentId: "cab-3", CsrPem: "not pem"}, rgsv1.ResultCode_RESULT_CODE_INVALID},
+		{"unknown", &rgsv1.EnrollEquipmentRequest{Meta: op, EquipmentId: "cab-404", CsrPem: testCSR(t, "")}, rgsv1.ResultCode_RESULT_CODE_INVALID},
+	}
+	for _, tc := range cases {
+		if resp, _ := svc.EnrollEquipment(ctx, tc.req); resp.Meta.ResultCode != tc.want {
+			t.Fatalf("%s: expected %v, got %v (%s)", tc.name, tc.want, resp.Meta.ResultCode, resp.Meta.DenialReason)
+		}
+	}
+
+	svc.DecommissionEquipment(ctx, &rgsv1.DecommissionEquipmentRequest{Meta: op, EquipmentId: "cab-3", Reason: "removed"})
+	if resp, _ := svc.EnrollEquipment(ctx, &rgsv1.EnrollEquipmentRequest{Meta: op, EquipmentId: "cab-3", CsrPem: testCSR(t, "")}); resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected decommissioned equipment not to enroll, got %v", resp.Meta.ResultCode)
+	}
+}
@@ -0,0 +1,368 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	"google.golang.org/protobuf/proto"
+)
+
+// equipmentFilter restricts a listing to a set of equipment ids. A nil
+// filter allows all equipment; an empty one allows none.
+type equipmentFilter map[string]bool
+
+func (f equipmentFilter) allows(equipmentID string) bool {
+	return f == nil || f[equipmentID]
+}
+
+// ids returns the filter's equipment ids, sorted, for a query parameter.
+func (f equipmentFilter) ids() []string {
+	out := make([]string, 0, len(f))
+	for id := range f {
+		out = append(out, id)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func equipmentInGroup(eq *rgsv1.Equipment, groupID string) bool {
+	return eq.ZoneId == groupID || eq.BankId == groupID
+}
+
+func cloneEquipmentGroup(g *rgsv1.EquipmentGroup) *rgsv1.EquipmentGroup {
+	if g == nil {
+		return nil
+	}
+	cp, _ := proto.Clone(g).(*rgsv1.EquipmentGroup)
+	return cp
+}
+
+func equipmentGroupSnapshot(g *rgsv1.EquipmentGroup) []byte {
+	if g == nil {
+		return []byte(`{}`)
+	}
+	b, _ := json.Marshal(g)
+	return b
+}
+
+// equipmentFilterForGroup resolves groupID to the equipment assigned to it
+// now, for services that filter by group. An empty groupID does not
+// filter.
+func (s *RegistryService) equipmentFilterForGroup(ctx context.Context, groupID string) (equipmentFilter, rgsv1.ResultCode, string) {
+	if groupID == "" {
+		return nil, rgsv1.ResultCode_RESULT_CODE_OK, ""
+	}
+	if s == nil {
+		return nil, rgsv1.ResultCode_RESULT_CODE_ERROR, "equipment groups unavailable"
+	}
+	if s.db != nil {
+		g, err := s.getEquipmentGroupFromDB(ctx, groupID)
+		if err != nil {
+			return nil, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable"
+		}
+		if g == nil {
+			return nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "group not found"
+		}
+		ids, err := s.equipmentIDsInGroupFromDB(ctx, groupID)
+		if err != nil {
+			return nil, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable"
+		}
+		f := make(equipmentFilter, len(ids))
+		for _, id := range ids {
+			f[id] = true
+		}
+		return f, rgsv1.ResultCode_RESULT_CODE_OK, ""
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.groups[groupID] == nil {
+		return nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "group not found"
+	}
+	f := make(equipmentFilter)
+	for id, eq := range s.equipment {
+		if equipmentInGroup(eq, groupID) {
+			f[id] = true
+		}
+	}
+	return f, rgsv1.ResultCode_RESULT_CODE_OK, ""
+}
+
+func (s *RegistryService) lookupEquipmentGroupLocked(ctx context.Context, groupID string) (*rgsv1.EquipmentGroup, error) {
+	if s.db != nil {
+		return s.getEquipmentGroupFromDB(ctx, groupID)
+	}
+	return cloneEquipmentGroup(s.groups[groupID]), nil
+}
+
+func (s *RegistryService) UpsertEquipmentGroup(ctx context.Context, req *rgsv1.UpsertEquipmentGroupRequest) (*rgsv1.UpsertEquipmentGroupResponse, error) {
+	if req == nil || req.Group == nil || strings.TrimSpace(req.Group.GroupId) == "" {
+		return &rgsv1.UpsertEquipmentGroupResponse{Meta: s.responseMeta(nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "group.group_id is required")}, nil
+	}
+	groupID := strings.TrimSpace(req.Group.GroupId)
+	if ok, reason := s.authorize(ctx, req.Meta); !ok {
+		_ = s.appendAudit(req.Meta, "equipment_group", groupID, "upsert_equipment_group", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		return &rgsv1.UpsertEquipmentGroupResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	name := strings.TrimSpace(req.Group.Name)
+	if name == "" {
+		return &rgsv1.UpsertEquipmentGroupResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "group.name is required")}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, err := s.lookupEquipmentGroupLocked(ctx, groupID)
+	if err != nil {
+		return &rgsv1.UpsertEquipmentGroupResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	now := s.now().Format(time.RFC3339Nano)
+	group := &rgsv1.EquipmentGroup{
+		GroupId:   groupID,
+		Kind:      req.Group.Kind,
+		Name:      name,
+		ZoneId:    strings.TrimSpace(req.Group.ZoneId),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if existing != nil {
+		if group.Kind == rgsv1.EquipmentGroupKind_EQUIPMENT_GROUP_KIND_UNSPECIFIED {
+			group.Kind = existing.Kind
+		}
+		if group.ZoneId == "" {
+			group.ZoneId = existing.ZoneId
+		}
+		if group.Kind != existing.Kind || group.ZoneId != existing.ZoneId {
+			return &rgsv1.UpsertEquipmentGroupResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "group kind and zone_id cannot change")}, nil
+		}
+		group.CreatedAt = existing.CreatedAt
+	} else {
+		switch group.Kind {
+		case rgsv1.EquipmentGroupKind_EQUIPMENT_GROUP_KIND_ZONE:
+			if group.ZoneId != "" {
+				return &rgsv1.UpsertEquipmentGroupResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "a zone cannot belong to a zone")}, nil
+			}
+		case rgsv1.EquipmentGroupKind_EQUIPMENT_GROUP_KIND_BANK:
+			zone, err := s.lookupEquipmentGroupLocked(ctx, group.ZoneId)
+			if err != nil {
+				return &rgsv1.UpsertEquipmentGroupResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+			}
+			if zone == nil || zone.Kind != rgsv1.EquipmentGroupKind_EQUIPMENT_GROUP_KIND_ZONE {
+				return &rgsv1.UpsertEquipmentGroupResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "a bank requires an existing zone_id")}, nil
+			}
+		default:
+			return &rgsv1.UpsertEquipmentGroupResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "group.kind is required")}, nil
+		}
+	}
+
+	if err := s.appendAudit(req.Meta, "equipment_group", groupID, "upsert_equipment_group", equipmentGroupSnapshot(existing), equipmentGroupSnapshot(group), audit.ResultSuccess, req.Reason); err != nil {
+		return &rgsv1.UpsertEquipmentGroupResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	if s.db != nil {
+		if err := s.upsertEquipmentGroupDB(ctx, group); err != nil {
+			return &rgsv1.UpsertEquipmentGroupResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+	} else if !s.disableInMemoryCache {
+		s.groups[groupID] = group
+	}
+	return &rgsv1.UpsertEquipmentGroupResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Group: cloneEquipmentGroup(group)}, nil
+}
+
+func (s *RegistryService) ListEquipmentGroups(ctx context.Context, req *rgsv1.ListEquipmentGroupsRequest) (*rgsv1.ListEquipmentGroupsResponse, error) {
+	if req == nil {
+		req = &rgsv1.ListEquipmentGroupsRequest{}
+	}
+	if ok, reason := s.authorize(ctx, req.Meta); !ok {
+		_ = s.appendAudit(req.Meta, "equipment_group", "", "list_equipment_groups", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		return &rgsv1.ListEquipmentGroupsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	if s.db != nil {
+		items, err := s.listEquipmentGroupsFromDB(ctx, req.Kind, req.ZoneId)
+		if err != nil {
+			return &rgsv1.ListEquipmentGroupsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+		return &rgsv1.ListEquipmentGroupsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Groups: items}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	items := make([]*rgsv1.EquipmentGroup, 0, len(s.groups))
+	for _, g := range s.groups {
+		if req.Kind != rgsv1.EquipmentGroupKind_EQUIPMENT_GROUP_KIND_UNSPECIFIED && g.Kind != req.Kind {
+			continue
+		}
+		if req.ZoneId != "" && g.ZoneId != req.ZoneId {
+			continue
+		}
+		items = append(items, cloneEquipmentGroup(g))
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].GroupId < items[j].GroupId })
+	return &rgsv1.ListEquipmentGroupsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Groups: items}, nil
+}
+
+func (s *RegistryService) AssignEquipmentGroup(ctx context.Context, req *rgsv1.AssignEquipmentGroupRequest) (*rgsv1.AssignEquipmentGroupResponse, error) {
+	if req == nil || req.EquipmentId == "" {
+		return &rgsv1.AssignEquipmentGroupResponse{Meta: s.responseMeta(nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "equipment_id is required")}, nil
+	}
+	if ok, reason := s.authorize(ctx, req.Meta); !ok {
+		_ = s.appendAudit(req.Meta, "equipment", req.EquipmentId, "assign_equipment_group", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		return &rgsv1.AssignEquipmentGroupResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing := cloneEquipment(s.equipment[req.EquipmentId])
+	if s.db != nil {
+		var err error
+		existing, err = s.getEquipmentFromDB(ctx, req.EquipmentId)
+		if err != nil {
+			return &rgsv1.AssignEquipmentGroupResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+	}
+	if existing == nil {
+		return &rgsv1.AssignEquipmentGroupResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "equipment not found")}, nil
+	}
+
+	updated := cloneEquipment(existing)
+	updated.ZoneId, updated.BankId = "", ""
+	if req.GroupId != "" {
+		group, err := s.lookupEquipmentGroupLocked(ctx, req.GroupId)
+		if err != nil {
+			return &rgsv1.AssignEquipmentGroupResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+		if group == nil {
+			return &rgsv1.AssignEquipmentGroupResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "group not found")}, nil
+		}
+		if group.Kind == rgsv1.EquipmentGroupKind_EQUIPMENT_GROUP_KIND_BANK {
+			updated.ZoneId, updated.BankId = group.ZoneId, group.GroupId
+		} else {
+			updated.ZoneId = group.GroupId
+		}
+	}
+	updated.UpdatedAt = s.now().Format(time.RFC3339Nano)
+
+	if err := s.appendAudit(req.Meta, "equipment", req.EquipmentId, "assign_equipment_group", equipmentSnapshot(existing), equipmentSnapshot(updated), audit.ResultSuccess, req.Reason); err != nil {
+		return &rgsv1.AssignEquipmentGroupResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	if s.db != nil {
+		if err := s.upsertEquipmentInDB(ctx, updated); err != nil {
+			return &rgsv1.AssignEquipmentGroupResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+	} else if !s.disableInMemoryCache {
+		s.equipment[req.EquipmentId] = updated
+	}
+	return &rgsv1.AssignEquipmentGroupResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Equipment: cloneEquipment(updated)}, nil
+}
+
+func equipmentGroupKindToDB(v rgsv1.EquipmentGroupKind) string {
+	if v == rgsv1.EquipmentGroupKind_EQUIPMENT_GROUP_KIND_BANK {
+		return "bank"
+	}
+	return "zone"
+}
+
+func equipmentGroupKindFromDB(v string) rgsv1.EquipmentGroupKind {
+	if v == "bank" {
+		return rgsv1.EquipmentGroupKind_EQUIPMENT_GROUP_KIND_BANK
+	}
+	return rgsv1.EquipmentGroupKind_EQUIPMENT_GROUP_KIND_ZONE
+}
+
+func (s *RegistryService) upsertEquipmentGroupDB(ctx context.Context, g *rgsv1.EquipmentGroup) error {
+	const q = `
+INSERT INTO equipment_groups (group_id, kind, name, zone_id, created_at, updated_at)
+VALUES ($1,$2,$3,$4,$5::timestamptz,$6::timestamptz)
+ON CONFLICT (group_id) DO UPDATE SET
+  name = EXCLUDED.name,
+  updated_at = EXCLUDED.updated_at
+`
+	_, err := s.db.ExecContext(ctx, q, g.GroupId, equipmentGroupKindToDB(g.Kind), g.Name, g.ZoneId, g.CreatedAt, g.UpdatedAt)
+	return err
+}
+
+func scanEquipmentGroup(row interface{ Scan(...any) error }) (*rgsv1.EquipmentGroup, error) {
+	var (
+		g                    rgsv1.EquipmentGroup
+		kind                 string
+		createdAt, updatedAt time.Time
+	)
+	if err := row.Scan(&g.GroupId, &kind, &g.Name, &g.ZoneId, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+	g.Kind = equipmentGroupKindFromDB(kind)
+	g.CreatedAt = createdAt.UTC().Format(time.RFC3339Nano)
+	g.UpdatedAt = updatedAt.UTC().Format(time.RFC3339Nano)
+	return &g, nil
+}
+
+func (s *RegistryService) getEquipmentGroupFromDB(ctx context.Context, groupID string) (*rgsv1.EquipmentGroup, error) {
+	const q = `
+SELECT group_id, kind, name, zone_id, created_at, updated_at
+FROM equipment_groups
+WHERE group_id = $1
+`
+	g, err := scanEquipmentGroup(s.db.QueryRowContext(ctx, q, groupID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return g, err
+}
+
+func (s *RegistryService) listEquipmentGroupsFromDB(ctx context.Context, kind rgsv1.EquipmentGroupKind, zoneID string) ([]*rgsv1.EquipmentGroup, error) {
+	dbKind := ""
+	if kind != rgsv1.EquipmentGroupKind_EQUIPMENT_GROUP_KIND_UNSPECIFIED {
+		dbKind = equipmentGroupKindToDB(kind)
+	}
+	const q = `
+SELECT group_id, kind, name, zone_id, created_at, updated_at
+FROM equipment_groups
+WHERE ($1 = '' OR kind = $1)
+  AND ($2 = '' OR zone_id = $2)
+ORDER BY group_id
+`
+	rows, err := s.db.QueryContext(ctx, q, dbKind, zoneID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]*rgsv1.EquipmentGroup, 0)
+	for rows.Next() {
+		g, err := scanEquipmentGroup(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, g)
+	}
+	return out, rows.Err()
+}
+
+func (s *RegistryService) equipmentIDsInGroupFromDB(ctx context.Context, groupID string) ([]string, error) {
+	const q = `
+SELECT equipment_id
+FROM equipment_registry
+WHERE zone_id = $1 OR bank_id = $1
+ORDER BY equipment_id
+`
+	rows, err := s.db.QueryContext(ctx, q, groupID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		out = append(out, id)
+	}
+	return out, rows.Err()
+}
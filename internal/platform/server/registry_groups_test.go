@@ -0,0 +1,177 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func TestEquipmentGroupsAssignAndFilter(t *testing.T) {
+	clk := ledgerFixedClock{now: time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)}
+	svc := NewRegistryService(clk)
+	ctx := context.Background()
+	op := meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+	zone := rgsv1.EquipmentGroupKind_EQUIPMENT_GROUP_KIND_ZONE
+	bank := rgsv1.EquipmentGroupKind_EQUIPMENT_GROUP_KIND_BANK
+
+	groups := []*rgsv1.EquipmentGroup{
+		{GroupId: "zone-a", Kind: zone, Name: "High limit"},
+		{GroupId: "zone-b", Kind: zone, Name: "Main floor"},
+		{GroupId: "bank-a1", Kind: bank, Name: "Bank A1", ZoneId: "zone-a"},
+	}
+	for _, g := range groups {
+		if resp, _ := svc.UpsertEquipmentGroup(ctx, &rgsv1.UpsertEquipmentGroupRequest{Meta: op, Group: g, Reason: "floor plan"}); resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("upsert %s: %v %s", g.GroupId, resp.Meta.ResultCode, resp.Meta.DenialReason)
+		}
+	}
+	invalid := []*rgsv1.EquipmentGroup{
+		{GroupId: "bank-x", Kind: bank, Name: "No zone"},
+		{GroupId: "bank-y", Kind: bank, Name: "Bank zone", ZoneId: "bank-a1"},
+		{GroupId: "zone-c", Kind: zone, Name: "Nested", ZoneId: "zone-a"},
+		{GroupId: "zone-d", Name: "No kind"},
+		{GroupId: "bank-a1", Kind: bank, Name: "Moved", ZoneId: "zone-b"},
+		{GroupId: "zone-a", Kind: bank, Name: "Changed kind", ZoneId: "zone-b"},
+	}
+	for _, g := range invalid {
+		if resp, _ := svc.UpsertEquipmentGroup(ctx, &rgsv1.UpsertEquipmentGroupRequest{Meta: op, Group: g}); resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_INVALID {
+			t.Fatalf("expected %q to be invalid, got %v", g.Name, resp.Meta.ResultCode)
+		}
+	}
+	renamed, _ := svc.UpsertEquipmentGroup(ctx, &rgsv1.UpsertEquipmentGroupRequest{Meta: op, Group: &rgsv1.EquipmentGroup{GroupId: "bank-a1", Name: "Bank A1 (window)"}})
+	if renamed.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK || renamed.Group.Kind != bank || renamed.Group.ZoneId != "zone-a" {
+		t.Fatalf("expected rename to keep kind and zone: %+v", renamed)
+	}
+	if resp, _ := svc.UpsertEquipmentGroup(ctx, &rgsv1.UpsertEquipmentGroupRequest{Meta: meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""), Group: groups[0]}); resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected player upsert to be denied, got %v", resp.Meta.ResultCode)
+	}
+	banks, _ := svc.ListEquipmentGroups(ctx, &rgsv1.ListEquipmentGroupsRequest{Meta: op, Kind: bank, ZoneId: "zone-a"})
+	if len(banks.Groups) != 1 || banks.Groups[0].GroupId != "bank-a1" {
+		t.Fatalf("unexpected bank listing: %+v", banks.Groups)
+	}
+
+	for _, id := range []string{"cab-1", "cab-2", "cab-3"} {
+		svc.UpsertEquipment(ctx, &rgsv1.UpsertEquipmentRequest{Meta: op, Equipment: &rgsv1.Equipment{EquipmentId: id}})
+	}
+	inBank, _ := svc.AssignEquipmentGroup(ctx, &rgsv1.AssignEquipmentGroupRequest{Meta: op, EquipmentId: "cab-1", GroupId: "bank-a1", Reason: "installed"})
+	if inBank.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK || inBank.Equipment.ZoneId != "zone-a" || inBank.Equipment.BankId != "bank-a1" {
+		t.Fatalf("expected bank assignment to set the zone too: %+v", inBank)
+	}
+	svc.AssignEquipmentGroup(ctx, &rgsv1.AssignEquipmentGroupRequest{Meta: op, EquipmentId: "cab-2", GroupId: "zone-a"})
+	svc.AssignEquipmentGroup(ctx, &rgsv1.AssignEquipmentGroupRequest{Meta: op, EquipmentId: "cab-3", GroupId: "zone-b"})
+	if resp, _ := svc.AssignEquipmentGroup(ctx, &rgsv1.AssignEquipmentGroupRequest{Meta: op, EquipmentId: "cab-3", GroupId: "zone-z"}); resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected unknown group assignment to be invalid, got %v", resp.Meta.ResultCode)
+	}
+	kept, _ := svc.UpsertEquipment(ctx, &rgsv1.UpsertEquipmentRequest{Meta: op, Equipment: &rgsv1.Equipment{EquipmentId: "cab-1", ZoneId: "zone-b"}})
+	if kept.Equipment.ZoneId != "zone-a" || kept.Equipment.BankId != "bank-a1" {
+		t.Fatalf("expected upsert to keep the group assignment: %+v", kept.Equipment)
+	}
+
+	listed := func(groupID string) []string {
+		t.Helper()
+		resp, _ := svc.ListEquipment(ctx, &rgsv1.ListEquipmentRequest{Meta: op, GroupId: groupID})
+		if resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("list equipment in %q: %v", groupID, resp.Meta.ResultCode)
+		}
+		ids := make([]string, 0, len(resp.Equipment))
+		for _, eq := range resp.Equipment {
+			ids = append(ids, eq.EquipmentId)
+		}
+		return ids
+	}
+	if got := listed("zone-a"); len(got) != 2 {
+		t.Fatalf("expected zone to include its bank's equipment, got %v", got)
+	}
+	if got := listed("bank-a1"); len(got) != 1 || got[0] != "cab-1" {
+		t.Fatalf("unexpected bank listing: %v", got)
+	}
+	if resp, _ := svc.ListEquipment(ctx, &rgsv1.ListEquipmentRequest{Meta: op, GroupId: "zone-z"}); resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected unknown group filter to be invalid, got %v", resp.Meta.ResultCode)
+	}
+
+	cleared, _ := svc.AssignEquipmentGroup(ctx, &rgsv1.AssignEquipmentGroupRequest{Meta: op, EquipmentId: "cab-2", Reason: "moved to storage"})
+	if cleared.Equipment.ZoneId != "" || cleared.Equipment.BankId != "" {
+		t.Fatalf("expected empty group_id to clear the assignment: %+v", cleared.Equipment)
+	}
+	if got := listed("zone-a"); len(got) != 1 {
+		t.Fatalf("expected cleared equipment to leave the zone, got %v", got)
+	}
+
+	var audited int
+	for _, ev := range auditEvents(svc.AuditStore) {
+		if ev.Action == "assign_equipment_group" {
+			audited++
+		}
+	}
+	if audited != 4 {
+		t.Fatalf("expected 4 audited assignments, got %d", audited)
+	}
+}
+
+func TestEquipmentGroupFiltersAcrossServices(t *testing.T) {
+	clk := ledgerFixedClock{now: time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)}
+	registry := NewRegistryService(clk)
+	events := NewEventsService(clk)
+	events.Registry = registry
+	overlay := NewUISystemOverlayService(clk)
+	overlay.Registry = registry
+	reporting := NewReportingService(clk, NewLedgerService(clk), events)
+	reporting.Registry = registry
+	ctx := context.Background()
+	op := meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+
+	registry.UpsertEquipmentGroup(ctx, &rgsv1.UpsertEquipmentGroupRequest{Meta: op, Group: &rgsv1.EquipmentGroup{GroupId: "zone-a", Kind: rgsv1.EquipmentGroupKind_EQUIPMENT_GROUP_KIND_ZONE, Name: "High limit"}})
+	for _, id := range []string{"cab-1", "cab-2"} {
+		registry.UpsertEquipment(ctx, &rgsv1.UpsertEquipmentRequest{Meta: op, Equipment: &rgsv1.Equipment{EquipmentId: id}})
+		events.SubmitSignificantEvent(ctx, &rgsv1.SubmitSignificantEventRequest{Meta: op, Event: &rgsv1.SignificantEvent{
+			EventId: "ev-" + id, EquipmentId: id, EventCode: "DOOR_OPEN", Severity: rgsv1.EventSeverity_EVENT_SEVERITY_WARN,
+			OccurredAt: clk.now.Add(-time.Minute).Format(time.RFC3339Nano),
+		}})
+		overlay.SubmitSystemWindowEvent(ctx, &rgsv1.SubmitSystemWindowEventRequest{Meta: op, Event: &rgsv1.SystemWindowEvent{
+			EquipmentId: id, WindowId: "sys-menu", EventType: rgsv1.SystemWindowEventType_SYSTEM_WINDOW_EVENT_TYPE_OPENED,
+		}})
+	}
+	registry.AssignEquipmentGroup(ctx, &rgsv1.AssignEquipmentGroupRequest{Meta: op, EquipmentId: "cab-1", GroupId: "zone-a"})
+
+	evs, _ := events.ListEvents(ctx, &rgsv1.ListEventsRequest{Meta: op, GroupId: "zone-a"})
+	if evs.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK || len(evs.Events) != 1 || evs.Events[0].EquipmentId != "cab-1" {
+		t.Fatalf("unexpected grouped events: %+v", evs)
+	}
+	if resp, _ := events.ListEvents(ctx, &rgsv1.ListEventsRequest{Meta: op, GroupId: "zone-z"}); resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected unknown group to be invalid, got %v", resp.Meta.ResultCode)
+	}
+	windows, _ := overlay.ListSystemWindowEvents(ctx, &rgsv1.ListSystemWindowEventsRequest{Meta: op, GroupId: "zone-a"})
+	if windows.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK || len(windows.Events) != 1 || windows.Events[0].EquipmentId != "cab-1" {
+		t.Fatalf("unexpected grouped window events: %+v", windows)
+	}
+
+	report, _ := reporting.GenerateReport(ctx, &rgsv1.GenerateReportRequest{
+		Meta:       op,
+		ReportType: rgsv1.ReportType_REPORT_TYPE_SIGNIFICANT_EVENTS_ALTERATIONS,
+		Interval:   rgsv1.ReportInterval_REPORT_INTERVAL_DTD,
+		Format:     rgsv1.ReportFormat_REPORT_FORMAT_JSON,
+		GroupId:    "zone-a",
+	})
+	if report.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("grouped report: %v %s", report.Meta.ResultCode, report.Meta.DenialReason)
+	}
+	var payload map[string]any
+	if err := json.Unmarshal(report.ReportRun.Content, &payload); err != nil {
+		t.Fatalf("unmarshal report content: %v", err)
+	}
+	if payload["group_id"] != "zone-a" || payload["row_count"] != float64(1) {
+		t.Fatalf("unexpected grouped report payload: %v", payload)
+	}
+	other, _ := reporting.GenerateReport(ctx, &rgsv1.GenerateReportRequest{
+		Meta:       op,
+		ReportType: rgsv1.ReportType_REPORT_TYPE_CASHLESS_LIABILITY_SUMMARY,
+		Interval:   rgsv1.ReportInterval_REPORT_INTERVAL_DTD,
+		Format:     rgsv1.ReportFormat_REPORT_FORMAT_JSON,
+		GroupId:    "zone-a",
+	})
+	if other.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected group_id on other reports to be invalid, got %v", other.Meta.ResultCode)
+	}
+}
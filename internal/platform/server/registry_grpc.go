@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"sort"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -19,13 +20,25 @@ type RegistryService struct {
 	rgsv1.UnimplementedRegistryServiceServer
 
 	Clock      clock.Clock
-	AuditStore *audit.InMemoryStore
-
-	mu                   sync.Mutex
-	equipment            map[string]*rgsv1.Equipment
-	nextAuditID          int64
-	db                   *sql.DB
-	disableInMemoryCache bool
+	AuditStore audit.Store
+	// Events, when set, records software verification failures as
+	// significant events.
+	Events *EventsService
+	// EnrollmentCA, when set, signs the certificates EnrollEquipment issues
+	// from device CSRs.
+	EnrollmentCA *EnrollmentCA
+
+	mu                    sync.Mutex
+	equipment             map[string]*rgsv1.Equipment
+	groups                map[string]*rgsv1.EquipmentGroup
+	softwareManifests     map[string]*rgsv1.SoftwareManifest
+	softwareVerifications map[string]*rgsv1.SoftwareVerification
+	clientCerts           map[string]*rgsv1.ClientCertificateBinding
+	serviceActors         ServiceActorDirectory
+	nextClientCertID      int64
+	nextAuditID           int64
+	db                    *sql.DB
+	disableInMemoryCache  bool
 }
 
 func NewRegistryService(clk clock.Clock, db ...*sql.DB) *RegistryService {
@@ -34,10 +47,15 @@ func NewRegistryService(clk clock.Clock, db ...*sql.DB) *RegistryService {
 		handle = db[0]
 	}
 	return &RegistryService{
-		Clock:      clk,
-		AuditStore: audit.NewInMemoryStore(),
-		equipment:  make(map[string]*rgsv1.Equipment),
-		db:         handle,
+		Clock:       clk,
+		AuditStore:  NewAuditStore(handle),
+		equipment:   make(map[string]*rgsv1.Equipment),
+		clientCerts: make(map[string]*rgsv1.ClientCertificateBinding),
+		db:          handle,
+
+		groups:                make(map[string]*rgsv1.EquipmentGroup),
+		softwareManifests:     make(map[string]*rgsv1.SoftwareManifest),
+		softwareVerifications: make(map[string]*rgsv1.SoftwareVerification),
 	}
 }
 
@@ -92,39 +110,9 @@ func (s *RegistryService) nextAuditIDLocked() string {
 	return "registry-audit-" + strconv.FormatInt(s.nextAuditID, 10)
 }
 
-func (s *RegistryService) appendAudit(meta *rgsv1.RequestMeta, objectID, action string, before, after []byte, result audit.Result, reason string) error {
-	if s.AuditStore == nil {
-		return audit.ErrCorruptChain
-	}
-	actorID := "system"
-	actorType := "service"
-	if meta != nil && meta.Actor != nil {
-		actorID = meta.Actor.ActorId
-		actorType = meta.Actor.ActorType.String()
-	}
-	now := s.now()
-	ev := audit.Event{
-		AuditID:      s.nextAuditIDLocked(),
-		OccurredAt:   now,
-		RecordedAt:   now,
-		ActorID:      actorID,
-		ActorType:    actorType,
-		ObjectType:   "equipment",
-		ObjectID:     objectID,
-		Action:       action,
-		Before:       before,
-		After:        after,
-		Result:       result,
-		Reason:       reason,
-		PartitionDay: now.Format("2006-01-02"),
-	}
-	if s.db != nil {
-		if err := appendAuditEventToDB(context.Background(), s.db, ev); err != nil {
-			return err
-		}
-	}
-	_, err := s.AuditStore.Append(ev)
-	return err
+func (s *RegistryService) appendAudit(meta *rgsv1.RequestMeta, objectType, objectID, action string, before, after []byte, result audit.Result, reason string) error {
+	ev := newAuditEvent(meta, s.nextAuditIDLocked(), s.now(), objectType, objectID, action, before, after, result, reason)
+	return appendAuditTo(context.Background(), s.AuditStore, ev)
 }
 
 func cloneEquipment(eq *rgsv1.Equipment) *rgsv1.Equipment {
@@ -140,7 +128,7 @@ func (s *RegistryService) UpsertEquipment(ctx context.Context, req *rgsv1.Upsert
 		return &rgsv1.UpsertEquipmentResponse{Meta: s.responseMeta(nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "equipment.equipment_id is required")}, nil
 	}
 	if ok, reason := s.authorize(ctx, req.Meta); !ok {
-		_ = s.appendAudit(req.Meta, req.Equipment.EquipmentId, "upsert_equipment", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		_ = s.appendAudit(req.Meta, "equipment", req.Equipment.EquipmentId, "upsert_equipment", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
 		return &rgsv1.UpsertEquipmentResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
 	}
 
@@ -159,6 +147,19 @@ func (s *RegistryService) UpsertEquipment(ctx context.Context, req *rgsv1.Upsert
 
 	now := s.now().Format(time.RFC3339Nano)
 	upsert := cloneEquipment(req.Equipment)
+	switch {
+	case existing == nil && upsert.Status == rgsv1.EquipmentStatus_EQUIPMENT_STATUS_UNSPECIFIED:
+		upsert.Status = rgsv1.EquipmentStatus_EQUIPMENT_STATUS_REGISTERED
+	case existing != nil && upsert.Status == rgsv1.EquipmentStatus_EQUIPMENT_STATUS_UNSPECIFIED:
+		upsert.Status = existing.Status
+	case existing != nil && upsert.Status != existing.Status:
+		return &rgsv1.UpsertEquipmentResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "status changes must use the equipment lifecycle transitions")}, nil
+	}
+	// Zone and bank membership only changes through AssignEquipmentGroup.
+	upsert.ZoneId, upsert.BankId = "", ""
+	if existing != nil {
+		upsert.ZoneId, upsert.BankId = existing.ZoneId, existing.BankId
+	}
 	if upsert.CreatedAt == "" {
 		if existing != nil && existing.CreatedAt != "" {
 			upsert.CreatedAt = existing.CreatedAt
@@ -169,7 +170,7 @@ func (s *RegistryService) UpsertEquipment(ctx context.Context, req *rgsv1.Upsert
 	upsert.UpdatedAt = now
 
 	after := equipmentSnapshot(upsert)
-	if err := s.appendAudit(req.Meta, upsert.EquipmentId, "upsert_equipment", before, after, audit.ResultSuccess, req.Reason); err != nil {
+	if err := s.appendAudit(req.Meta, "equipment", upsert.EquipmentId, "upsert_equipment", before, after, audit.ResultSuccess, req.Reason); err != nil {
 		return &rgsv1.UpsertEquipmentResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
 	}
 
@@ -194,7 +195,7 @@ func (s *RegistryService) GetEquipment(ctx context.Context, req *rgsv1.GetEquipm
 		return &rgsv1.GetEquipmentResponse{Meta: s.responseMeta(nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "equipment_id is required")}, nil
 	}
 	if ok, reason := s.authorize(ctx, req.Meta); !ok {
-		_ = s.appendAudit(req.Meta, req.EquipmentId, "get_equipment", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		_ = s.appendAudit(req.Meta, "equipment", req.EquipmentId, "get_equipment", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
 		return &rgsv1.GetEquipmentResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
 	}
 
@@ -223,12 +224,75 @@ func (s *RegistryService) GetEquipment(ctx context.Context, req *rgsv1.GetEquipm
 	return &rgsv1.GetEquipmentResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Equipment: eq}, nil
 }
 
+// lookupEquipment returns the registered equipment, or nil when it is unknown.
+func (s *RegistryService) lookupEquipment(ctx context.Context, equipmentID string) (*rgsv1.Equipment, error) {
+	if s.db != nil {
+		return s.getEquipmentFromDB(ctx, equipmentID)
+	}
+	if s.disableInMemoryCache {
+		return nil, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return cloneEquipment(s.equipment[equipmentID]), nil
+}
+
+// maxEquipmentPageSize caps ListEquipment pages so large floors are read
+// in pages rather than all at once.
+const maxEquipmentPageSize = 500
+
+// equipmentListFilter holds the ListEquipment filters. Zero values match
+// everything.
+type equipmentListFilter struct {
+	status                rgsv1.EquipmentStatus
+	groupID               string
+	location              string
+	model                 string
+	controlProgramVersion string
+	search                string
+}
+
+func newEquipmentListFilter(req *rgsv1.ListEquipmentRequest) equipmentListFilter {
+	return equipmentListFilter{
+		status:                req.StatusFilter,
+		groupID:               req.GroupId,
+		location:              strings.TrimSpace(req.Location),
+		model:                 strings.TrimSpace(req.Model),
+		controlProgramVersion: strings.TrimSpace(req.ControlProgramVersion),
+		search:                strings.TrimSpace(req.Search),
+	}
+}
+
+func (f equipmentListFilter) matches(eq *rgsv1.Equipment) bool {
+	if f.status != rgsv1.EquipmentStatus_EQUIPMENT_STATUS_UNSPECIFIED && eq.Status != f.status {
+		return false
+	}
+	if f.groupID != "" && !equipmentInGroup(eq, f.groupID) {
+		return false
+	}
+	if (f.location != "" && eq.Location != f.location) ||
+		(f.model != "" && eq.Model != f.model) ||
+		(f.controlProgramVersion != "" && eq.ControlProgramVersion != f.controlProgramVersion) {
+		return false
+	}
+	if f.search == "" {
+		return true
+	}
+	needle := strings.ToLower(f.search)
+	for _, field := range []string{eq.EquipmentId, eq.ExternalReference, eq.Location, eq.Model, eq.ControlProgramVersion} {
+		if strings.Contains(strings.ToLower(field), needle) {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *RegistryService) ListEquipment(ctx context.Context, req *rgsv1.ListEquipmentRequest) (*rgsv1.ListEquipmentResponse, error) {
 	if req == nil {
 		req = &rgsv1.ListEquipmentRequest{}
 	}
 	if ok, reason := s.authorize(ctx, req.Meta); !ok {
-		_ = s.appendAudit(req.Meta, "", "list_equipment", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		_ = s.appendAudit(req.Meta, "equipment", "", "list_equipment", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
 		return &rgsv1.ListEquipmentResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
 	}
 
@@ -242,8 +306,17 @@ func (s *RegistryService) ListEquipment(ctx context.Context, req *rgsv1.ListEqui
 	if pageSize <= 0 {
 		pageSize = 50
 	}
+	if pageSize > maxEquipmentPageSize {
+		pageSize = maxEquipmentPageSize
+	}
+	if req.GroupId != "" {
+		if _, code, reason := s.equipmentFilterForGroup(ctx, req.GroupId); code != rgsv1.ResultCode_RESULT_CODE_OK {
+			return &rgsv1.ListEquipmentResponse{Meta: s.responseMeta(req.Meta, code, reason)}, nil
+		}
+	}
+	filter := newEquipmentListFilter(req)
 	if s.db != nil {
-		items, err := s.listEquipmentFromDB(ctx, req.StatusFilter, pageSize, start)
+		items, err := s.listEquipmentFromDB(ctx, filter, pageSize, start)
 		if err != nil {
 			return &rgsv1.ListEquipmentResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
 		}
@@ -277,7 +350,7 @@ func (s *RegistryService) ListEquipment(ctx context.Context, req *rgsv1.ListEqui
 	filtered := make([]*rgsv1.Equipment, 0, len(ids))
 	for _, id := range ids {
 		eq := s.equipment[id]
-		if req.StatusFilter != rgsv1.EquipmentStatus_EQUIPMENT_STATUS_UNSPECIFIED && eq.Status != req.StatusFilter {
+		if !filter.matches(eq) {
 			continue
 		}
 		filtered = append(filtered, cloneEquipment(eq))
@@ -0,0 +1,134 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+// equipmentTransitions lists the states each lifecycle transition may start
+// from. Decommissioning is allowed from anything but a final state.
+var equipmentTransitions = map[rgsv1.EquipmentStatus][]rgsv1.EquipmentStatus{
+	rgsv1.EquipmentStatus_EQUIPMENT_STATUS_COMMISSIONED: {
+		rgsv1.EquipmentStatus_EQUIPMENT_STATUS_REGISTERED,
+	},
+	rgsv1.EquipmentStatus_EQUIPMENT_STATUS_ACTIVE: {
+		rgsv1.EquipmentStatus_EQUIPMENT_STATUS_COMMISSIONED,
+		rgsv1.EquipmentStatus_EQUIPMENT_STATUS_MAINTENANCE,
+		rgsv1.EquipmentStatus_EQUIPMENT_STATUS_INACTIVE,
+		rgsv1.EquipmentStatus_EQUIPMENT_STATUS_DISABLED,
+	},
+	rgsv1.EquipmentStatus_EQUIPMENT_STATUS_MAINTENANCE: {
+		rgsv1.EquipmentStatus_EQUIPMENT_STATUS_ACTIVE,
+		rgsv1.EquipmentStatus_EQUIPMENT_STATUS_INACTIVE,
+		rgsv1.EquipmentStatus_EQUIPMENT_STATUS_DISABLED,
+	},
+}
+
+func equipmentStatusFinal(v rgsv1.EquipmentStatus) bool {
+	return v == rgsv1.EquipmentStatus_EQUIPMENT_STATUS_DECOMMISSIONED || v == rgsv1.EquipmentStatus_EQUIPMENT_STATUS_RETIRED
+}
+
+func equipmentTransitionAllowed(from, to rgsv1.EquipmentStatus) bool {
+	if to == rgsv1.EquipmentStatus_EQUIPMENT_STATUS_DECOMMISSIONED {
+		return !equipmentStatusFinal(from)
+	}
+	for _, v := range equipmentTransitions[to] {
+		if v == from {
+			return true
+		}
+	}
+	return false
+}
+
+type equipmentTransitionResult struct {
+	meta     *rgsv1.ResponseMeta
+	eq       *rgsv1.Equipment
+	previous rgsv1.EquipmentStatus
+}
+
+// transitionEquipment moves equipmentID to status to and audits the move
+// under action with its reason. Rejected moves are audited as denied.
+func (s *RegistryService) transitionEquipment(ctx context.Context, meta *rgsv1.RequestMeta, equipmentID, reason string, to rgsv1.EquipmentStatus, action string) equipmentTransitionResult {
+	if equipmentID == "" {
+		return equipmentTransitionResult{meta: s.responseMeta(meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "equipment_id is required")}
+	}
+	if ok, denial := s.authorize(ctx, meta); !ok {
+		_ = s.appendAudit(meta, "equipment", equipmentID, action, []byte(`{}`), []byte(`{}`), audit.ResultDenied, denial)
+		return equipmentTransitionResult{meta: s.responseMeta(meta, rgsv1.ResultCode_RESULT_CODE_DENIED, denial)}
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return equipmentTransitionResult{meta: s.responseMeta(meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "reason is required")}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing := cloneEquipment(s.equipment[equipmentID])
+	if s.db != nil {
+		var err error
+		existing, err = s.getEquipmentFromDB(ctx, equipmentID)
+		if err != nil {
+			return equipmentTransitionResult{meta: s.responseMeta(meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}
+		}
+	}
+	if existing == nil {
+		return equipmentTransitionResult{meta: s.responseMeta(meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "equipment not found")}
+	}
+	before := equipmentSnapshot(existing)
+	if !equipmentTransitionAllowed(existing.Status, to) {
+		denial := "cannot move equipment from " + existing.Status.String() + " to " + to.String()
+		_ = s.appendAudit(meta, "equipment", equipmentID, action, before, before, audit.ResultDenied, denial)
+		return equipmentTransitionResult{meta: s.responseMeta(meta, rgsv1.ResultCode_RESULT_CODE_INVALID, denial), previous: existing.Status}
+	}
+	if to == rgsv1.EquipmentStatus_EQUIPMENT_STATUS_ACTIVE {
+		failed, err := s.softwareVerificationFailedLocked(ctx, equipmentID)
+		if err != nil {
+			return equipmentTransitionResult{meta: s.responseMeta(meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}
+		}
+		if failed {
+			const denial = "software verification failed"
+			_ = s.appendAudit(meta, "equipment", equipmentID, action, before, before, audit.ResultDenied, denial)
+			return equipmentTransitionResult{meta: s.responseMeta(meta, rgsv1.ResultCode_RESULT_CODE_DENIED, denial), previous: existing.Status}
+		}
+	}
+
+	updated := cloneEquipment(existing)
+	updated.Status = to
+	updated.UpdatedAt = s.now().Format(time.RFC3339Nano)
+	if err := s.appendAudit(meta, "equipment", equipmentID, action, before, equipmentSnapshot(updated), audit.ResultSuccess, reason); err != nil {
+		return equipmentTransitionResult{meta: s.responseMeta(meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}
+	}
+	if s.db != nil {
+		if err := s.upsertEquipmentInDB(ctx, updated); err != nil {
+			return equipmentTransitionResult{meta: s.responseMeta(meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}
+		}
+	} else if !s.disableInMemoryCache {
+		s.equipment[equipmentID] = updated
+	}
+	return equipmentTransitionResult{meta: s.responseMeta(meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), eq: cloneEquipment(updated), previous: existing.Status}
+}
+
+func (s *RegistryService) CommissionEquipment(ctx context.Context, req *rgsv1.CommissionEquipmentRequest) (*rgsv1.CommissionEquipmentResponse, error) {
+	r := s.transitionEquipment(ctx, req.GetMeta(), req.GetEquipmentId(), req.GetReason(), rgsv1.EquipmentStatus_EQUIPMENT_STATUS_COMMISSIONED, "commission_equipment")
+	return &rgsv1.CommissionEquipmentResponse{Meta: r.meta, Equipment: r.eq, PreviousStatus: r.previous}, nil
+}
+
+func (s *RegistryService) ActivateEquipment(ctx context.Context, req *rgsv1.ActivateEquipmentRequest) (*rgsv1.ActivateEquipmentResponse, error) {
+	r := s.transitionEquipment(ctx, req.GetMeta(), req.GetEquipmentId(), req.GetReason(), rgsv1.EquipmentStatus_EQUIPMENT_STATUS_ACTIVE, "activate_equipment")
+	return &rgsv1.ActivateEquipmentResponse{Meta: r.meta, Equipment: r.eq, PreviousStatus: r.previous}, nil
+}
+
+func (s *RegistryService) StartEquipmentMaintenance(ctx context.Context, req *rgsv1.StartEquipmentMaintenanceRequest) (*rgsv1.StartEquipmentMaintenanceResponse, error) {
+	r := s.transitionEquipment(ctx, req.GetMeta(), req.GetEquipmentId(), req.GetReason(), rgsv1.EquipmentStatus_EQUIPMENT_STATUS_MAINTENANCE, "start_equipment_maintenance")
+	return &rgsv1.StartEquipmentMaintenanceResponse{Meta: r.meta, Equipment: r.eq, PreviousStatus: r.previous}, nil
+}
+
+func (s *RegistryService) DecommissionEquipment(ctx context.Context, req *rgsv1.DecommissionEquipmentRequest) (*rgsv1.DecommissionEquipmentResponse, error) {
+	r := s.transitionEquipment(ctx, req.GetMeta(), req.GetEquipmentId(), req.GetReason(), rgsv1.EquipmentStatus_EQUIPMENT_STATUS_DECOMMISSIONED, "decommission_equipment")
+	return &rgsv1.DecommissionEquipmentResponse{Meta: r.meta, Equipment: r.eq, PreviousStatus: r.previous}, nil
+}
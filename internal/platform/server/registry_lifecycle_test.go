@@ -0,0 +1,82 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+func TestEquipmentLifecycleTransitions(t *testing.T) {
+	svc := NewRegistryService(ledgerFixedClock{now: time.Date(2026, 2, 13, 14, 0, 0, 0, time.UTC)})
+	ctx := context.Background()
+	op := meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+
+	created, _ := svc.UpsertEquipment(ctx, &rgsv1.UpsertEquipmentRequest{Meta: op, Equipment: &rgsv1.Equipment{EquipmentId: "cab-9", Location: "floor-2"}})
+	if created.Equipment.GetStatus() != rgsv1.EquipmentStatus_EQUIPMENT_STATUS_REGISTERED {
+		t.Fatalf("expected new equipment to start registered: %+v", created.Equipment)
+	}
+
+	if resp, _ := svc.ActivateEquipment(ctx, &rgsv1.ActivateEquipmentRequest{Meta: op, EquipmentId: "cab-9", Reason: "skip commissioning"}); resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_INVALID || resp.Equipment != nil {
+		t.Fatalf("expected registered equipment not to activate: %+v", resp)
+	}
+	if resp, _ := svc.CommissionEquipment(ctx, &rgsv1.CommissionEquipmentRequest{Meta: op, EquipmentId: "cab-9"}); resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected a reason to be required, got %v", resp.Meta.ResultCode)
+	}
+	player := meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "")
+	if resp, _ := svc.CommissionEquipment(ctx, &rgsv1.CommissionEquipmentRequest{Meta: player, EquipmentId: "cab-9", Reason: "x"}); resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected player to be denied, got %v", resp.Meta.ResultCode)
+	}
+
+	commission, _ := svc.CommissionEquipment(ctx, &rgsv1.CommissionEquipmentRequest{Meta: op, EquipmentId: "cab-9", Reason: "installed and verified by field tech"})
+	if commission.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK || commission.PreviousStatus != rgsv1.EquipmentStatus_EQUIPMENT_STATUS_REGISTERED || commission.Equipment.Status != rgsv1.EquipmentStatus_EQUIPMENT_STATUS_COMMISSIONED {
+		t.Fatalf("unexpected commission response: %+v", commission)
+	}
+	if resp, _ := svc.ActivateEquipment(ctx, &rgsv1.ActivateEquipmentRequest{Meta: op, EquipmentId: "cab-9", Reason: "opened for play"}); resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("activate: %+v", resp.Meta)
+	}
+	if resp, _ := svc.StartEquipmentMaintenance(ctx, &rgsv1.StartEquipmentMaintenanceRequest{Meta: op, EquipmentId: "cab-9", Reason: "bill validator jam"}); resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK || resp.PreviousStatus != rgsv1.EquipmentStatus_EQUIPMENT_STATUS_ACTIVE {
+		t.Fatalf("start maintenance: %+v", resp)
+	}
+	if resp, _ := svc.StartEquipmentMaintenance(ctx, &rgsv1.StartEquipmentMaintenanceRequest{Meta: op, EquipmentId: "cab-9", Reason: "again"}); resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected maintenance to maintenance to be invalid, got %v", resp.Meta.ResultCode)
+	}
+	if resp, _ := svc.ActivateEquipment(ctx, &rgsv1.ActivateEquipmentRequest{Meta: op, EquipmentId: "cab-9", Reason: "jam cleared"}); resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("reactivate: %+v", resp.Meta)
+	}
+
+	if resp, _ := svc.UpsertEquipment(ctx, &rgsv1.UpsertEquipmentRequest{Meta: op, Equipment: &rgsv1.Equipment{EquipmentId: "cab-9", Status: rgsv1.EquipmentStatus_EQUIPMENT_STATUS_RETIRED}}); resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected upsert not to change status, got %v", resp.Meta.ResultCode)
+	}
+	if resp, _ := svc.UpsertEquipment(ctx, &rgsv1.UpsertEquipmentRequest{Meta: op, Equipment: &rgsv1.Equipment{EquipmentId: "cab-9", Location: "floor-3"}}); resp.Equipment.GetStatus() != rgsv1.EquipmentStatus_EQUIPMENT_STATUS_ACTIVE {
+		t.Fatalf("expected upsert without status to keep it: %+v", resp)
+	}
+
+	if resp, _ := svc.DecommissionEquipment(ctx, &rgsv1.DecommissionEquipmentRequest{Meta: op, EquipmentId: "cab-9", Reason: "moved to another property"}); resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("decommission: %+v", resp.Meta)
+	}
+	if resp, _ := svc.ActivateEquipment(ctx, &rgsv1.ActivateEquipmentRequest{Meta: op, EquipmentId: "cab-9", Reason: "undo"}); resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected decommissioned equipment to stay decommissioned, got %v", resp.Meta.ResultCode)
+	}
+
+	var transitions, rejected int
+	for _, ev := range auditEvents(svc.AuditStore) {
+		if ev.ObjectID != "cab-9" || ev.Action == "upsert_equipment" {
+			continue
+		}
+		switch ev.Result {
+		case audit.ResultSuccess:
+			transitions++
+			if ev.Reason == "" {
+				t.Fatalf("expected transition audit to carry its reason: %+v", ev)
+			}
+		case audit.ResultDenied:
+			rejected++
+		}
+	}
+	if transitions != 5 || rejected != 4 {
+		t.Fatalf("expected 5 audited transitions and 4 rejections, got %d and %d", transitions, rejected)
+	}
+}
@@ -27,9 +27,11 @@ func (s *RegistryService) upsertEquipmentInDB(ctx context.Context, eq *rgsv1.Equ
 	const q = `
 INSERT INTO equipment_registry (
   equipment_id, external_reference, location, status, theoretical_rtp_bps,
-  control_program_version, config_version, attributes, created_at, updated_at
+  control_program_version, config_version, attributes, created_at, updated_at,
+  model, zone_id, bank_id
 ) VALUES (
-  $1,$2,$3,$4::equipment_status,$5,$6,$7,$8::jsonb,$9::timestamptz,$10::timestamptz
+  $1,$2,$3,$4::equipment_status,$5,$6,$7,$8::jsonb,$9::timestamptz,$10::timestamptz,
+  $11,$12,$13
 )
 ON CONFLICT (equipment_id) DO UPDATE SET
   external_reference = EXCLUDED.external_reference,
@@ -39,7 +41,10 @@ ON CONFLICT (equipment_id) DO UPDATE SET
   control_program_version = EXCLUDED.control_program_version,
   config_version = EXCLUDED.config_version,
   attributes = EXCLUDED.attributes,
-  updated_at = EXCLUDED.updated_at
+  updated_at = EXCLUDED.updated_at,
+  model = EXCLUDED.model,
+  zone_id = EXCLUDED.zone_id,
+  bank_id = EXCLUDED.bank_id
 `
 	var rtpValue any
 	if hasRTP {
@@ -56,6 +61,9 @@ ON CONFLICT (equipment_id) DO UPDATE SET
 		string(attrs),
 		nonEmptyTimestamp(eq.CreatedAt),
 		nonEmptyTimestamp(eq.UpdatedAt),
+		eq.Model,
+		eq.ZoneId,
+		eq.BankId,
 	)
 	if err != nil {
 		return err
@@ -70,19 +78,22 @@ func (s *RegistryService) getEquipmentFromDB(ctx context.Context, equipmentID st
 	}
 	const q = `
 SELECT equipment_id, external_reference, location, status::text, theoretical_rtp_bps,
-       control_program_version, config_version, attributes, created_at, updated_at
+       control_program_version, config_version, attributes, created_at, updated_at,
+       model, zone_id, bank_id
 FROM equipment_registry
 WHERE equipment_id = $1
 `
 	var (
-		id, extRef, location, status, controlProgramVersion, configVersion string
-		attrJSON                                                           []byte
-		rtp                                                                sql.NullInt32
-		createdAt, updatedAt                                               time.Time
+		id, extRef, location, status, controlProgramVersion, configVersion, model string
+		zoneID, bankID                                                            string
+		attrJSON                                                                  []byte
+		rtp                                                                       sql.NullInt32
+		createdAt, updatedAt                                                      time.Time
 	)
 	err := s.db.QueryRowContext(ctx, q, equipmentID).Scan(
 		&id, &extRef, &location, &status, &rtp,
 		&controlProgramVersion, &configVersion, &attrJSON, &createdAt, &updatedAt,
+		&model, &zoneID, &bankID,
 	)
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -104,6 +115,9 @@ WHERE equipment_id = $1
 		CreatedAt:             createdAt.UTC().Format(time.RFC3339Nano),
 		UpdatedAt:             updatedAt.UTC().Format(time.RFC3339Nano),
 		Attributes:            attrs,
+		Model:                 model,
+		ZoneId:                zoneID,
+		BankId:                bankID,
 	}
 	if rtp.Valid {
 		eq.TheoreticalRtpBps = strconv.FormatInt(int64(rtp.Int32), 10)
@@ -111,23 +125,34 @@ WHERE equipment_id = $1
 	return eq, nil
 }
 
-func (s *RegistryService) listEquipmentFromDB(ctx context.Context, filter rgsv1.EquipmentStatus, limit, offset int) ([]*rgsv1.Equipment, error) {
+func (s *RegistryService) listEquipmentFromDB(ctx context.Context, filter equipmentListFilter, limit, offset int) ([]*rgsv1.Equipment, error) {
 	if s == nil || s.db == nil {
 		return nil, nil
 	}
-	status := equipmentStatusToDB(filter)
-	if filter == rgsv1.EquipmentStatus_EQUIPMENT_STATUS_UNSPECIFIED {
+	status := equipmentStatusToDB(filter.status)
+	if filter.status == rgsv1.EquipmentStatus_EQUIPMENT_STATUS_UNSPECIFIED {
 		status = ""
 	}
+	search := ""
+	if filter.search != "" {
+		search = "%" + auditLikeEscaper.Replace(filter.search) + "%"
+	}
+	// The search expression matches idx_equipment_registry_search_trgm.
 	const q = `
 SELECT equipment_id, external_reference, location, status::text, theoretical_rtp_bps,
-       control_program_version, config_version, attributes, created_at, updated_at
+       control_program_version, config_version, attributes, created_at, updated_at,
+       model, zone_id, bank_id
 FROM equipment_registry
 WHERE ($1 = '' OR status::text = $1)
+  AND ($4 = '' OR zone_id = $4 OR bank_id = $4)
+  AND ($5 = '' OR location = $5)
+  AND ($6 = '' OR model = $6)
+  AND ($7 = '' OR control_program_version = $7)
+  AND ($8 = '' OR (equipment_id || ' ' || external_reference || ' ' || location || ' ' || model || ' ' || control_program_version) ILIKE $8)
 ORDER BY equipment_id ASC
 LIMIT $2 OFFSET $3
 `
-	rows, err := s.db.QueryContext(ctx, q, status, limit, offset)
+	rows, err := s.db.QueryContext(ctx, q, status, limit, offset, filter.groupID, filter.location, filter.model, filter.controlProgramVersion, search)
 	if err != nil {
 		return nil, err
 	}
@@ -136,14 +161,16 @@ LIMIT $2 OFFSET $3
 	out := make([]*rgsv1.Equipment, 0, limit)
 	for rows.Next() {
 		var (
-			id, extRef, location, dbStatus, controlProgramVersion, configVersion string
-			attrJSON                                                             []byte
-			rtp                                                                  sql.NullInt32
-			createdAt, updatedAt                                                 time.Time
+			id, extRef, location, dbStatus, controlProgramVersion, configVersion, model string
+			zoneID, bankID                                                              string
+			attrJSON                                                                    []byte
+			rtp                                                                         sql.NullInt32
+			createdAt, updatedAt                                                        time.Time
 		)
 		if err := rows.Scan(
 			&id, &extRef, &location, &dbStatus, &rtp,
 			&controlProgramVersion, &configVersion, &attrJSON, &createdAt, &updatedAt,
+			&model, &zoneID, &bankID,
 		); err != nil {
 			return nil, err
 		}
@@ -161,6 +188,9 @@ LIMIT $2 OFFSET $3
 			CreatedAt:             createdAt.UTC().Format(time.RFC3339Nano),
 			UpdatedAt:             updatedAt.UTC().Format(time.RFC3339Nano),
 			Attributes:            attrs,
+			Model:                 model,
+			ZoneId:                zoneID,
+			BankId:                bankID,
 		}
 		if rtp.Valid {
 			item.TheoreticalRtpBps = strconv.FormatInt(int64(rtp.Int32), 10)
@@ -182,6 +212,12 @@ func equipmentStatusToDB(v rgsv1.EquipmentStatus) string {
 		return "disabled"
 	case rgsv1.EquipmentStatus_EQUIPMENT_STATUS_RETIRED:
 		return "retired"
+	case rgsv1.EquipmentStatus_EQUIPMENT_STATUS_REGISTERED:
+		return "registered"
+	case rgsv1.EquipmentStatus_EQUIPMENT_STATUS_COMMISSIONED:
+		return "commissioned"
+	case rgsv1.EquipmentStatus_EQUIPMENT_STATUS_DECOMMISSIONED:
+		return "decommissioned"
 	default:
 		return "active"
 	}
@@ -199,6 +235,12 @@ func equipmentStatusFromDB(v string) rgsv1.EquipmentStatus {
 		return rgsv1.EquipmentStatus_EQUIPMENT_STATUS_DISABLED
 	case "retired":
 		return rgsv1.EquipmentStatus_EQUIPMENT_STATUS_RETIRED
+	case "registered":
+		return rgsv1.EquipmentStatus_EQUIPMENT_STATUS_REGISTERED
+	case "commissioned":
+		return rgsv1.EquipmentStatus_EQUIPMENT_STATUS_COMMISSIONED
+	case "decommissioned":
+		return rgsv1.EquipmentStatus_EQUIPMENT_STATUS_DECOMMISSIONED
 	default:
 		return rgsv1.EquipmentStatus_EQUIPMENT_STATUS_UNSPECIFIED
 	}
@@ -0,0 +1,64 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func TestListEquipmentFiltersAndSearch(t *testing.T) {
+	clk := ledgerFixedClock{now: time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC)}
+	svc := NewRegistryService(clk)
+	ctx := context.Background()
+	op := meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+	for _, eq := range []*rgsv1.Equipment{
+		{EquipmentId: "cab-1", ExternalReference: "SN-1001", Location: "Floor 1", Model: "Apex 27", ControlProgramVersion: "4.2.0"},
+		{EquipmentId: "cab-2", ExternalReference: "SN-1002", Location: "Floor 1", Model: "Apex 32", ControlProgramVersion: "4.2.0"},
+		{EquipmentId: "cab-3", ExternalReference: "SN-2001", Location: "Floor 2", Model: "Apex 27", ControlProgramVersion: "4.1.3"},
+		{EquipmentId: "kiosk-1", ExternalReference: "K-50%", Location: "Cage", Model: "Teller"},
+	} {
+		if resp, _ := svc.UpsertEquipment(ctx, &rgsv1.UpsertEquipmentRequest{Meta: op, Equipment: eq}); resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("upsert %s: %v %s", eq.EquipmentId, resp.Meta.ResultCode, resp.Meta.DenialReason)
+		}
+	}
+	list := func(req *rgsv1.ListEquipmentRequest) []string {
+		t.Helper()
+		req.Meta = op
+		resp, _ := svc.ListEquipment(ctx, req)
+		if resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("list %+v: %v %s", req, resp.Meta.ResultCode, resp.Meta.DenialReason)
+		}
+		ids := make([]string, 0, len(resp.Equipment))
+		for _, eq := range resp.Equipment {
+			ids = append(ids, eq.EquipmentId)
+		}
+		return ids
+	}
+	for name, tc := range map[string]struct {
+		req  *rgsv1.ListEquipmentRequest
+		want []string
+	}{
+		"location":         {&rgsv1.ListEquipmentRequest{Location: "Floor 1"}, []string{"cab-1", "cab-2"}},
+		"model":            {&rgsv1.ListEquipmentRequest{Model: "Apex 27"}, []string{"cab-1", "cab-3"}},
+		"firmware":         {&rgsv1.ListEquipmentRequest{ControlProgramVersion: "4.2.0", Model: "Apex 32"}, []string{"cab-2"}},
+		"search reference": {&rgsv1.ListEquipmentRequest{Search: "sn-100"}, []string{"cab-1", "cab-2"}},
+		"search version":   {&rgsv1.ListEquipmentRequest{Search: "4.1"}, []string{"cab-3"}},
+		"search literal":   {&rgsv1.ListEquipmentRequest{Search: "50%"}, []string{"kiosk-1"}},
+		"no match":         {&rgsv1.ListEquipmentRequest{Location: "floor 1"}, []string{}},
+	} {
+		if got := list(tc.req); len(got) != len(tc.want) || (len(got) > 0 && (got[0] != tc.want[0] || got[len(got)-1] != tc.want[len(tc.want)-1])) {
+			t.Fatalf("%s: expected %v, got %v", name, tc.want, got)
+		}
+	}
+
+	page, _ := svc.ListEquipment(ctx, &rgsv1.ListEquipmentRequest{Meta: op, Search: "apex", PageSize: 2})
+	if len(page.Equipment) != 2 || page.NextPageToken == "" {
+		t.Fatalf("expected a first page of two with a next token, got %+v", page)
+	}
+	rest, _ := svc.ListEquipment(ctx, &rgsv1.ListEquipmentRequest{Meta: op, Search: "apex", PageSize: 2, PageToken: page.NextPageToken})
+	if len(rest.Equipment) != 1 || rest.Equipment[0].EquipmentId != "cab-3" || rest.NextPageToken != "" {
+		t.Fatalf("expected the last filtered page, got %+v", rest)
+	}
+}
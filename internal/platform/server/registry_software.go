@@ -0,0 +1,406 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	"google.golang.org/protobuf/proto"
+)
+
+// SoftwareVerificationFailedEventCode marks the significant event raised
+// when a device reports software that does not match an approved manifest.
+const SoftwareVerificationFailedEventCode = "SOFTWARE_VERIFICATION_FAILED"
+
+func softwareManifestKey(model, version string) string {
+	return model + "/" + version
+}
+
+func cloneSoftwareManifest(m *rgsv1.SoftwareManifest) *rgsv1.SoftwareManifest {
+	if m == nil {
+		return nil
+	}
+	cp, _ := proto.Clone(m).(*rgsv1.SoftwareManifest)
+	return cp
+}
+
+// normalizeSoftwareComponents trims names, lowercases hashes and sorts the
+// components by name. It rejects empty names, duplicates and hashes that
+// are not a hex SHA-256 digest.
+func normalizeSoftwareComponents(in []*rgsv1.SoftwareComponent) ([]*rgsv1.SoftwareComponent, string) {
+	out := make([]*rgsv1.SoftwareComponent, 0, len(in))
+	seen := make(map[string]bool, len(in))
+	for _, c := range in {
+		name := strings.TrimSpace(c.GetName())
+		if name == "" {
+			return nil, "component name is required"
+		}
+		if seen[name] {
+			return nil, "duplicate component " + name
+		}
+		seen[name] = true
+		sum := strings.ToLower(strings.TrimSpace(c.GetSha256()))
+		if raw, err := hex.DecodeString(sum); err != nil || len(raw) != 32 {
+			return nil, "component " + name + " sha256 must be a hex sha-256 digest"
+		}
+		out = append(out, &rgsv1.SoftwareComponent{Name: name, Sha256: sum})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out, ""
+}
+
+// mismatchedSoftwareComponents lists, by name, every reported component
+// whose hash differs from the manifest or that the manifest does not list,
+// and every manifest component that was not reported. Without a manifest
+// every reported component is a mismatch.
+func mismatchedSoftwareComponents(manifest *rgsv1.SoftwareManifest, reported []*rgsv1.SoftwareComponent) []string {
+	approved := make(map[string]string)
+	for _, c := range manifest.GetComponents() {
+		approved[c.Name] = c.Sha256
+	}
+	var out []string
+	for _, c := range reported {
+		if sum, ok := approved[c.Name]; !ok || sum != c.Sha256 {
+			out = append(out, c.Name)
+		}
+		delete(approved, c.Name)
+	}
+	for name := range approved {
+		out = append(out, name)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func (s *RegistryService) ApproveSoftwareManifest(ctx context.Context, req *rgsv1.ApproveSoftwareManifestRequest) (*rgsv1.ApproveSoftwareManifestResponse, error) {
+	if req == nil || req.Manifest == nil {
+		return &rgsv1.ApproveSoftwareManifestResponse{Meta: s.responseMeta(nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "manifest is required")}, nil
+	}
+	model := strings.TrimSpace(req.Manifest.Model)
+	version := strings.TrimSpace(req.Manifest.Version)
+	if ok, reason := s.authorizeOperator(ctx, req.Meta); !ok {
+		_ = s.appendAudit(req.Meta, "software_manifest", softwareManifestKey(model, version), "approve_software_manifest", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		return &rgsv1.ApproveSoftwareManifestResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	if model == "" || version == "" {
+		return &rgsv1.ApproveSoftwareManifestResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "manifest.model and manifest.version are required")}, nil
+	}
+	if len(req.Manifest.Components) == 0 {
+		return &rgsv1.ApproveSoftwareManifestResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "manifest.components is required")}, nil
+	}
+	components, invalid := normalizeSoftwareComponents(req.Manifest.Components)
+	if invalid != "" {
+		return &rgsv1.ApproveSoftwareManifestResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, invalid)}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	manifest := &rgsv1.SoftwareManifest{
+		Model:      model,
+		Version:    version,
+		Components: components,
+		ApprovedBy: req.Meta.GetActor().GetActorId(),
+		ApprovedAt: s.now().Format(time.RFC3339Nano),
+	}
+	key := softwareManifestKey(model, version)
+	before := []byte(`{}`)
+	if prev := s.softwareManifests[key]; prev != nil {
+		before, _ = json.Marshal(prev)
+	}
+	after, _ := json.Marshal(manifest)
+	if err := s.appendAudit(req.Meta, "software_manifest", key, "approve_software_manifest", before, after, audit.ResultSuccess, req.Reason); err != nil {
+		return &rgsv1.ApproveSoftwareManifestResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	if s.db != nil {
+		if err := s.upsertSoftwareManifestDB(ctx, manifest); err != nil {
+			return &rgsv1.ApproveSoftwareManifestResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+	} else if !s.disableInMemoryCache {
+		s.softwareManifests[key] = manifest
+	}
+	return &rgsv1.ApproveSoftwareManifestResponse{
+		Meta:     s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
+		Manifest: cloneSoftwareManifest(manifest),
+	}, nil
+}
+
+func (s *RegistryService) ListSoftwareManifests(ctx context.Context, req *rgsv1.ListSoftwareManifestsRequest) (*rgsv1.ListSoftwareManifestsResponse, error) {
+	if req == nil {
+		req = &rgsv1.ListSoftwareManifestsRequest{}
+	}
+	if ok, reason := s.authorize(ctx, req.Meta); !ok {
+		_ = s.appendAudit(req.Meta, "software_manifest", "", "list_software_manifests", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		return &rgsv1.ListSoftwareManifestsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	model := strings.TrimSpace(req.Model)
+	if s.db != nil {
+		items, err := s.listSoftwareManifestsFromDB(ctx, model)
+		if err != nil {
+			return &rgsv1.ListSoftwareManifestsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+		return &rgsv1.ListSoftwareManifestsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Manifests: items}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	keys := make([]string, 0, len(s.softwareManifests))
+	for key, m := range s.softwareManifests {
+		if model == "" || m.Model == model {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	out := make([]*rgsv1.SoftwareManifest, 0, len(keys))
+	for _, key := range keys {
+		out = append(out, cloneSoftwareManifest(s.softwareManifests[key]))
+	}
+	return &rgsv1.ListSoftwareManifestsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Manifests: out}, nil
+}
+
+func (s *RegistryService) VerifySoftware(ctx context.Context, req *rgsv1.VerifySoftwareRequest) (*rgsv1.VerifySoftwareResponse, error) {
+	if req == nil || req.EquipmentId == "" {
+		return &rgsv1.VerifySoftwareResponse{Meta: s.responseMeta(nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "equipment_id is required")}, nil
+	}
+	actor, reason := resolveActor(ctx, req.Meta)
+	if reason == "" && actor.ActorType != rgsv1.ActorType_ACTOR_TYPE_OPERATOR && actor.ActorType != rgsv1.ActorType_ACTOR_TYPE_SERVICE {
+		reason = "unauthorized actor type"
+	}
+	if reason != "" {
+		_ = s.appendAudit(req.Meta, "equipment", req.EquipmentId, "verify_software", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		return &rgsv1.VerifySoftwareResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	version := strings.TrimSpace(req.Version)
+	if version == "" {
+		return &rgsv1.VerifySoftwareResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "version is required")}, nil
+	}
+	reported, invalid := normalizeSoftwareComponents(req.Components)
+	if invalid != "" {
+		return &rgsv1.VerifySoftwareResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, invalid)}, nil
+	}
+
+	s.mu.Lock()
+	eq := cloneEquipment(s.equipment[req.EquipmentId])
+	var manifest *rgsv1.SoftwareManifest
+	var err error
+	if s.db != nil {
+		eq, err = s.getEquipmentFromDB(ctx, req.EquipmentId)
+	}
+	if err != nil {
+		s.mu.Unlock()
+		return &rgsv1.VerifySoftwareResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if eq == nil {
+		s.mu.Unlock()
+		return &rgsv1.VerifySoftwareResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "equipment not found")}, nil
+	}
+	if eq.Model == "" {
+		s.mu.Unlock()
+		return &rgsv1.VerifySoftwareResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "equipment model is not registered")}, nil
+	}
+	if s.db != nil {
+		manifest, err = s.getSoftwareManifestFromDB(ctx, eq.Model, version)
+		if err != nil {
+			s.mu.Unlock()
+			return &rgsv1.VerifySoftwareResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+	} else {
+		manifest = s.softwareManifests[softwareManifestKey(eq.Model, version)]
+	}
+
+	verification := &rgsv1.SoftwareVerification{
+		EquipmentId: eq.EquipmentId,
+		Model:       eq.Model,
+		Version:     version,
+		Status:      rgsv1.SoftwareVerificationStatus_SOFTWARE_VERIFICATION_STATUS_VERIFIED,
+		VerifiedAt:  s.now().Format(time.RFC3339Nano),
+	}
+	verification.MismatchedComponents = mismatchedSoftwareComponents(manifest, reported)
+	auditReason := ""
+	switch {
+	case manifest == nil:
+		verification.Status = rgsv1.SoftwareVerificationStatus_SOFTWARE_VERIFICATION_STATUS_MISMATCH
+		auditReason = "no approved manifest for " + softwareManifestKey(eq.Model, version)
+	case len(verification.MismatchedComponents) > 0:
+		verification.Status = rgsv1.SoftwareVerificationStatus_SOFTWARE_VERIFICATION_STATUS_MISMATCH
+		auditReason = "software mismatch: " + strings.Join(verification.MismatchedComponents, ",")
+	}
+	after, _ := json.Marshal(verification)
+	if err := s.appendAudit(req.Meta, "equipment", eq.EquipmentId, "verify_software", []byte(`{}`), after, audit.ResultSuccess, auditReason); err != nil {
+		s.mu.Unlock()
+		return &rgsv1.VerifySoftwareResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	if s.db != nil {
+		if err := s.upsertSoftwareVerificationDB(ctx, verification); err != nil {
+			s.mu.Unlock()
+			return &rgsv1.VerifySoftwareResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+	} else if !s.disableInMemoryCache {
+		s.softwareVerifications[eq.EquipmentId] = verification
+	}
+	s.mu.Unlock()
+
+	if verification.Status == rgsv1.SoftwareVerificationStatus_SOFTWARE_VERIFICATION_STATUS_MISMATCH && s.Events != nil {
+		s.raiseSoftwareMismatch(ctx, actor, verification, auditReason)
+	}
+	cp, _ := proto.Clone(verification).(*rgsv1.SoftwareVerification)
+	return &rgsv1.VerifySoftwareResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Verification: cp}, nil
+}
+
+// raiseSoftwareMismatch records a critical significant event for a failed
+// verification so it reaches alert rules and event watchers.
+func (s *RegistryService) raiseSoftwareMismatch(ctx context.Context, actor *rgsv1.Actor, v *rgsv1.SoftwareVerification, desc string) {
+	eventID := "software-verify-" + v.EquipmentId + "-" + strconv.FormatInt(s.now().UnixNano(), 10)
+	_, _ = s.Events.SubmitSignificantEvent(ctx, &rgsv1.SubmitSignificantEventRequest{
+		Meta: &rgsv1.RequestMeta{RequestId: eventID, Actor: actor},
+		Event: &rgsv1.SignificantEvent{
+			EventId:              eventID,
+			EquipmentId:          v.EquipmentId,
+			EventCode:            SoftwareVerificationFailedEventCode,
+			LocalizedDescription: desc,
+			Severity:             rgsv1.EventSeverity_EVENT_SEVERITY_CRITICAL,
+			OccurredAt:           v.VerifiedAt,
+			Tags: map[string]string{
+				"category":              "software",
+				"model":                 v.Model,
+				"version":               v.Version,
+				"mismatched_components": strings.Join(v.MismatchedComponents, ","),
+			},
+		},
+	})
+}
+
+// softwareVerificationFailedLocked reports whether the latest software
+// verification of equipmentID was a mismatch.
+func (s *RegistryService) softwareVerificationFailedLocked(ctx context.Context, equipmentID string) (bool, error) {
+	if s.db != nil {
+		status, err := s.softwareVerificationStatusFromDB(ctx, equipmentID)
+		return status == rgsv1.SoftwareVerificationStatus_SOFTWARE_VERIFICATION_STATUS_MISMATCH, err
+	}
+	v := s.softwareVerifications[equipmentID]
+	return v != nil && v.Status == rgsv1.SoftwareVerificationStatus_SOFTWARE_VERIFICATION_STATUS_MISMATCH, nil
+}
+
+func softwareVerificationStatusToDB(v rgsv1.SoftwareVerificationStatus) string {
+	if v == rgsv1.SoftwareVerificationStatus_SOFTWARE_VERIFICATION_STATUS_MISMATCH {
+		return "mismatch"
+	}
+	return "verified"
+}
+
+func (s *RegistryService) upsertSoftwareManifestDB(ctx context.Context, m *rgsv1.SoftwareManifest) error {
+	const q = `
+INSERT INTO software_manifests (model, version, components, approved_by, approved_at)
+VALUES ($1,$2,$3::jsonb,$4,$5::timestamptz)
+ON CONFLICT (model, version) DO UPDATE SET
+  components = EXCLUDED.components,
+  approved_by = EXCLUDED.approved_by,
+  approved_at = EXCLUDED.approved_at
+`
+	components, err := json.Marshal(m.Components)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx, q, m.Model, m.Version, string(components), m.ApprovedBy, m.ApprovedAt)
+	return err
+}
+
+func scanSoftwareManifest(row interface{ Scan(...any) error }) (*rgsv1.SoftwareManifest, error) {
+	var (
+		m          rgsv1.SoftwareManifest
+		components []byte
+		approvedAt time.Time
+	)
+	if err := row.Scan(&m.Model, &m.Version, &components, &m.ApprovedBy, &approvedAt); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(components, &m.Components); err != nil {
+		return nil, err
+	}
+	m.ApprovedAt = approvedAt.UTC().Format(time.RFC3339Nano)
+	return &m, nil
+}
+
+func (s *RegistryService) getSoftwareManifestFromDB(ctx context.Context, model, version string) (*rgsv1.SoftwareManifest, error) {
+	const q = `
+SELECT model, version, components, approved_by, approved_at
+FROM software_manifests
+WHERE model = $1 AND version = $2
+`
+	m, err := scanSoftwareManifest(s.db.QueryRowContext(ctx, q, model, version))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return m, err
+}
+
+func (s *RegistryService) listSoftwareManifestsFromDB(ctx context.Context, model string) ([]*rgsv1.SoftwareManifest, error) {
+	const q = `
+SELECT model, version, components, approved_by, approved_at
+FROM software_manifests
+WHERE ($1 = '' OR model = $1)
+ORDER BY model, version
+`
+	rows, err := s.db.QueryContext(ctx, q, model)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]*rgsv1.SoftwareManifest, 0)
+	for rows.Next() {
+		m, err := scanSoftwareManifest(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, m)
+	}
+	return out, rows.Err()
+}
+
+func (s *RegistryService) upsertSoftwareVerificationDB(ctx context.Context, v *rgsv1.SoftwareVerification) error {
+	const q = `
+INSERT INTO equipment_software_verifications (
+  equipment_id, model, version, status, mismatched_components, verified_at
+) VALUES ($1,$2,$3,$4,$5::jsonb,$6::timestamptz)
+ON CONFLICT (equipment_id) DO UPDATE SET
+  model = EXCLUDED.model,
+  version = EXCLUDED.version,
+  status = EXCLUDED.status,
+  mismatched_components = EXCLUDED.mismatched_components,
+  verified_at = EXCLUDED.verified_at
+`
+	mismatched := v.MismatchedComponents
+	if mismatched == nil {
+		mismatched = []string{}
+	}
+	b, err := json.Marshal(mismatched)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx, q, v.EquipmentId, v.Model, v.Version, softwareVerificationStatusToDB(v.Status), string(b), v.VerifiedAt)
+	return err
+}
+
+func (s *RegistryService) softwareVerificationStatusFromDB(ctx context.Context, equipmentID string) (rgsv1.SoftwareVerificationStatus, error) {
+	const q = `SELECT status FROM equipment_software_verifications WHERE equipment_id = $1`
+	var status string
+	err := s.db.QueryRowContext(ctx, q, equipmentID).Scan(&status)
+	switch {
+	case err == sql.ErrNoRows:
+		return rgsv1.SoftwareVerificationStatus_SOFTWARE_VERIFICATION_STATUS_UNSPECIFIED, nil
+	case err != nil:
+		return rgsv1.SoftwareVerificationStatus_SOFTWARE_VERIFICATION_STATUS_UNSPECIFIED, err
+	case status == "mismatch":
+		return rgsv1.SoftwareVerificationStatus_SOFTWARE_VERIFICATION_STATUS_MISMATCH, nil
+	default:
+		return rgsv1.SoftwareVerificationStatus_SOFTWARE_VERIFICATION_STATUS_VERIFIED, nil
+	}
+}
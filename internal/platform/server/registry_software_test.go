@@ -0,0 +1,88 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func TestSoftwareVerificationAgainstApprovedManifest(t *testing.T) {
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 13, 15, 0, 0, 0, time.UTC)}
+	svc := NewRegistryService(clk)
+	events := NewEventsService(clk)
+	svc.Events = events
+	ctx := context.Background()
+	op := meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+	device := meta("cab-5", rgsv1.ActorType_ACTOR_TYPE_SERVICE, "")
+	boot := strings.Repeat("ab", 32)
+	game := strings.Repeat("cd", 32)
+
+	svc.UpsertEquipment(ctx, &rgsv1.UpsertEquipmentRequest{Meta: op, Equipment: &rgsv1.Equipment{EquipmentId: "cab-5", Model: "vlt-200"}})
+	svc.CommissionEquipment(ctx, &rgsv1.CommissionEquipmentRequest{Meta: op, EquipmentId: "cab-5", Reason: "installed"})
+
+	if resp, _ := svc.ApproveSoftwareManifest(ctx, &rgsv1.ApproveSoftwareManifestRequest{Meta: device, Manifest: &rgsv1.SoftwareManifest{Model: "vlt-200", Version: "1.0"}}); resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected service actor not to approve manifests, got %v", resp.Meta.ResultCode)
+	}
+	if resp, _ := svc.ApproveSoftwareManifest(ctx, &rgsv1.ApproveSoftwareManifestRequest{Meta: op, Manifest: &rgsv1.SoftwareManifest{Model: "vlt-200", Version: "1.0", Components: []*rgsv1.SoftwareComponent{{Name: "boot", Sha256: "abc"}}}}); resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected malformed hash to be invalid, got %v", resp.Meta.ResultCode)
+	}
+	approved, _ := svc.ApproveSoftwareManifest(ctx, &rgsv1.ApproveSoftwareManifestRequest{Meta: op, Reason: "lab cert GLI-123", Manifest: &rgsv1.SoftwareManifest{
+		Model: "vlt-200", Version: "1.0",
+		Components: []*rgsv1.SoftwareComponent{{Name: "game", Sha256: strings.ToUpper(game)}, {Name: "boot", Sha256: boot}},
+	}})
+	if approved.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK || approved.Manifest.Components[0].Name != "boot" || approved.Manifest.Components[1].Sha256 != game || approved.Manifest.ApprovedBy != "op-1" {
+		t.Fatalf("unexpected approved manifest: %+v", approved)
+	}
+	list, _ := svc.ListSoftwareManifests(ctx, &rgsv1.ListSoftwareManifestsRequest{Meta: device, Model: "vlt-200"})
+	if len(list.Manifests) != 1 {
+		t.Fatalf("expected one manifest, got %+v", list.Manifests)
+	}
+
+	bad, _ := svc.VerifySoftware(ctx, &rgsv1.VerifySoftwareRequest{Meta: device, EquipmentId: "cab-5", Version: "1.0", Components: []*rgsv1.SoftwareComponent{
+		{Name: "boot", Sha256: boot}, {Name: "game", Sha256: boot}, {Name: "debug", Sha256: game},
+	}})
+	v := bad.Verification
+	if bad.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK || v.Status != rgsv1.SoftwareVerificationStatus_SOFTWARE_VERIFICATION_STATUS_MISMATCH || strings.Join(v.MismatchedComponents, ",") != "debug,game" {
+		t.Fatalf("unexpected mismatch result: %+v", bad)
+	}
+	evs, _ := events.ListEvents(ctx, &rgsv1.ListEventsRequest{Meta: op, EquipmentId: "cab-5"})
+	if len(evs.Events) != 1 || evs.Events[0].EventCode != SoftwareVerificationFailedEventCode || evs.Events[0].Severity != rgsv1.EventSeverity_EVENT_SEVERITY_CRITICAL {
+		t.Fatalf("expected a critical verification event, got %+v", evs.Events)
+	}
+	if resp, _ := svc.ActivateEquipment(ctx, &rgsv1.ActivateEquipmentRequest{Meta: op, EquipmentId: "cab-5", Reason: "open"}); resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_DENIED || resp.Meta.DenialReason != "software verification failed" {
+		t.Fatalf("expected activation to be blocked: %+v", resp.Meta)
+	}
+
+	unknown, _ := svc.VerifySoftware(ctx, &rgsv1.VerifySoftwareRequest{Meta: device, EquipmentId: "cab-5", Version: "2.0", Components: []*rgsv1.SoftwareComponent{{Name: "boot", Sha256: boot}}})
+	if unknown.Verification.Status != rgsv1.SoftwareVerificationStatus_SOFTWARE_VERIFICATION_STATUS_MISMATCH {
+		t.Fatalf("expected unapproved version to mismatch: %+v", unknown.Verification)
+	}
+
+	good, _ := svc.VerifySoftware(ctx, &rgsv1.VerifySoftwareRequest{Meta: device, EquipmentId: "cab-5", Version: "1.0", Components: []*rgsv1.SoftwareComponent{
+		{Name: "boot", Sha256: boot}, {Name: "game", Sha256: game},
+	}})
+	if good.Verification.Status != rgsv1.SoftwareVerificationStatus_SOFTWARE_VERIFICATION_STATUS_VERIFIED || len(good.Verification.MismatchedComponents) != 0 {
+		t.Fatalf("expected verified result: %+v", good.Verification)
+	}
+	if resp, _ := svc.ActivateEquipment(ctx, &rgsv1.ActivateEquipmentRequest{Meta: op, EquipmentId: "cab-5", Reason: "open"}); resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected activation after verification: %+v", resp.Meta)
+	}
+
+	svc.UpsertEquipment(ctx, &rgsv1.UpsertEquipmentRequest{Meta: op, Equipment: &rgsv1.Equipment{EquipmentId: "cab-6"}})
+	if resp, _ := svc.VerifySoftware(ctx, &rgsv1.VerifySoftwareRequest{Meta: device, EquipmentId: "cab-6", Version: "1.0"}); resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected equipment without a model to be invalid, got %v", resp.Meta.ResultCode)
+	}
+
+	var audited int
+	for _, ev := range auditEvents(svc.AuditStore) {
+		if ev.Action == "verify_software" {
+			audited++
+		}
+	}
+	if audited != 3 {
+		t.Fatalf("expected 3 audited verifications, got %d", audited)
+	}
+}
@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net"
@@ -30,7 +31,7 @@ type RemoteAccessActivity struct {
 
 type RemoteAccessGuard struct {
 	Clock      clock.Clock
-	AuditStore *audit.InMemoryStore
+	AuditStore audit.Store
 
 	trusted              []*net.IPNet
 	mu                   sync.Mutex
@@ -42,12 +43,25 @@ type RemoteAccessGuard struct {
 	inMemoryLogCap       int
 	onDecision           func(outcome string)
 	onLogState           func(entries int, cap int)
+
+	authFailureMax       int
+	authFailureWindow    time.Duration
+	authFailureSamples   map[string]*authFailureSample
+	authFailureNextPrune time.Time
+}
+
+// authFailureSample counts auth failures for one source and reason within
+// the current sampling window.
+type authFailureSample struct {
+	windowStart time.Time
+	recorded    int
+	suppressed  int
 }
 
 var errRemoteAccessLogCapacityExceeded = errors.New("remote access activity log capacity exceeded")
 var errRemoteAccessAuditUnavailable = errors.New("remote access audit unavailable")
 
-func NewRemoteAccessGuard(clk clock.Clock, store *audit.InMemoryStore, cidrs []string) (*RemoteAccessGuard, error) {
+func NewRemoteAccessGuard(clk clock.Clock, store audit.Store, cidrs []string) (*RemoteAccessGuard, error) {
 	trusted := make([]*net.IPNet, 0, len(cidrs))
 	for _, c := range cidrs {
 		c = strings.TrimSpace(c)
@@ -69,7 +83,14 @@ func NewRemoteAccessGuard(clk clock.Clock, store *audit.InMemoryStore, cidrs []s
 	if store == nil {
 		store = audit.NewInMemoryStore()
 	}
-	return &RemoteAccessGuard{Clock: clk, AuditStore: store, trusted: trusted}, nil
+	return &RemoteAccessGuard{
+		Clock:              clk,
+		AuditStore:         store,
+		trusted:            trusted,
+		authFailureMax:     20,
+		authFailureWindow:  time.Minute,
+		authFailureSamples: make(map[string]*authFailureSample),
+	}, nil
 }
 
 func (g *RemoteAccessGuard) now() time.Time {
@@ -144,8 +165,27 @@ func (g *RemoteAccessGuard) SetDecisionObserver(observer func(outcome string)) {
 	g.onDecision = observer
 }
 
+// SetAuthFailureSampling limits how many gateway auth failures are recorded
+// per source IP and reason in each window; the rest are counted and reported
+// on the next recorded event for that source.
+func (g *RemoteAccessGuard) SetAuthFailureSampling(maxPerWindow int, window time.Duration) {
+	if g == nil {
+		return
+	}
+	if maxPerWindow <= 0 {
+		maxPerWindow = 20
+	}
+	if window <= 0 {
+		window = time.Minute
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.authFailureMax = maxPerWindow
+	g.authFailureWindow = window
+}
+
 func (g *RemoteAccessGuard) isAdminPath(path string) bool {
-	return strings.HasPrefix(path, "/v1/config") || strings.HasPrefix(path, "/v1/reporting") || strings.HasPrefix(path, "/v1/audit")
+	return strings.HasPrefix(path, "/v1/config") || strings.HasPrefix(path, "/v1/reporting") || strings.HasPrefix(path, "/v1/audit") || strings.HasPrefix(path, "/v1/system/incidents") || strings.HasPrefix(path, "/v1/system/monitoring")
 }
 
 func (g *RemoteAccessGuard) extractSourceIP(r *http.Request) (string, string) {
@@ -176,6 +216,27 @@ func (g *RemoteAccessGuard) isTrusted(ipStr string) bool {
 }
 
 func (g *RemoteAccessGuard) appendAudit(path, sourceIP, outcome, reason string) error {
+	res := audit.ResultSuccess
+	if outcome != "allowed" {
+		res = audit.ResultDenied
+	}
+	return g.appendAuditEvent(audit.Event{
+		ActorID:     sourceIP,
+		ActorType:   "remote",
+		AuthContext: "path=" + path,
+		ObjectType:  "remote_access",
+		ObjectID:    path,
+		Action:      outcome,
+		Before:      []byte(`{}`),
+		After:       []byte(`{}`),
+		Result:      res,
+		Reason:      reason,
+	})
+}
+
+// appendAuditEvent stamps ev with an ID and timestamps and writes it to the
+// audit store.
+func (g *RemoteAccessGuard) appendAuditEvent(ev audit.Event) error {
 	if g.AuditStore == nil {
 		return errRemoteAccessAuditUnavailable
 	}
@@ -183,37 +244,12 @@ func (g *RemoteAccessGuard) appendAudit(path, sourceIP, outcome, reason string)
 	g.mu.Lock()
 	g.nextID++
 	id := g.nextID
-	db := g.db
 	g.mu.Unlock()
-	res := audit.ResultSuccess
-	if outcome != "allowed" {
-		res = audit.ResultDenied
-	}
-	ev := audit.Event{
-		AuditID:      "remote-access-" + strconv.FormatInt(id, 10),
-		OccurredAt:   now,
-		RecordedAt:   now,
-		ActorID:      sourceIP,
-		ActorType:    "remote",
-		AuthContext:  "path=" + path,
-		ObjectType:   "remote_access",
-		ObjectID:     path,
-		Action:       outcome,
-		Before:       []byte(`{}`),
-		After:        []byte(`{}`),
-		Result:       res,
-		Reason:       reason,
-		PartitionDay: now.Format("2006-01-02"),
-	}
-	if db != nil {
-		if err := appendAuditEventToDB(context.Background(), db, ev); err != nil {
-			return err
-		}
-	}
-	if _, err := g.AuditStore.Append(ev); err != nil {
-		return err
-	}
-	return nil
+	ev.AuditID = "remote-access-" + strconv.FormatInt(id, 10)
+	ev.OccurredAt = now
+	ev.RecordedAt = now
+	ev.PartitionDay = auditPartitionDay(now)
+	return appendAuditTo(context.Background(), g.AuditStore, ev)
 }
 
 func (g *RemoteAccessGuard) logActivity(r *http.Request, sourceIP, sourcePort string, allowed bool, reason string) error {
@@ -422,3 +458,93 @@ func (g *RemoteAccessGuard) Wrap(next http.Handler) http.Handler {
 		next.ServeHTTP(w, r)
 	})
 }
+
+// sampleAuthFailure reports whether a failure from sourceIP for reason
+// should be recorded, and how many were suppressed since the last one.
+func (g *RemoteAccessGuard) sampleAuthFailure(sourceIP, reason string, now time.Time) (bool, int) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.authFailureSamples == nil {
+		g.authFailureSamples = make(map[string]*authFailureSample)
+	}
+	if now.After(g.authFailureNextPrune) {
+		for k, sample := range g.authFailureSamples {
+			if now.Sub(sample.windowStart) >= g.authFailureWindow && sample.suppressed == 0 {
+				delete(g.authFailureSamples, k)
+			}
+		}
+		g.authFailureNextPrune = now.Add(g.authFailureWindow)
+	}
+	key := sourceIP + "|" + reason
+	sample := g.authFailureSamples[key]
+	if sample == nil || now.Sub(sample.windowStart) >= g.authFailureWindow {
+		suppressed := 0
+		if sample != nil {
+			suppressed = sample.suppressed
+		}
+		g.authFailureSamples[key] = &authFailureSample{windowStart: now, recorded: 1}
+		return true, suppressed
+	}
+	if sample.recorded >= g.authFailureMax {
+		sample.suppressed++
+		return false, 0
+	}
+	sample.recorded++
+	return true, 0
+}
+
+// RecordAuthFailure records a request the gateway rejected before it reached
+// a service, e.g. for a missing or invalid bearer token. Its signature
+// matches auth.AuthFailureObserver. Recording is best effort: the request is
+// already rejected, so persistence failures are only surfaced via metrics.
+func (g *RemoteAccessGuard) RecordAuthFailure(r *http.Request, reason, kid string) {
+	if g == nil || r == nil {
+		return
+	}
+	sourceIP, sourcePort := g.extractSourceIP(r)
+	now := g.now()
+	record, suppressed := g.sampleAuthFailure(sourceIP, reason, now)
+	g.mu.Lock()
+	observer := g.onDecision
+	g.mu.Unlock()
+	if observer != nil {
+		observer("auth_failed")
+	}
+	if !record {
+		return
+	}
+
+	authContext := "path=" + r.URL.Path + ";method=" + r.Method
+	if kid != "" {
+		authContext += ";kid=" + kid
+	}
+	if suppressed > 0 {
+		authContext += ";suppressed=" + strconv.Itoa(suppressed)
+	}
+	after, _ := json.Marshal(map[string]any{
+		"path":        r.URL.Path,
+		"method":      r.Method,
+		"source_ip":   sourceIP,
+		"source_port": sourcePort,
+		"kid":         kid,
+		"suppressed":  suppressed,
+	})
+	persistErr := g.appendAuditEvent(audit.Event{
+		ActorID:     sourceIP,
+		ActorType:   "remote",
+		AuthContext: authContext,
+		ObjectType:  "gateway_auth",
+		ObjectID:    r.URL.Path,
+		Action:      "auth_failed",
+		Before:      []byte(`{}`),
+		After:       after,
+		Result:      audit.ResultDenied,
+		Reason:      reason,
+	})
+	if err := g.logActivity(r, sourceIP, sourcePort, false, "auth failed: "+reason); err != nil && persistErr == nil {
+		persistErr = err
+	}
+	if persistErr != nil && observer != nil {
+		observer("logging_unavailable")
+	}
+}
@@ -4,10 +4,12 @@ import (
 	"database/sql"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
 )
 
 func TestRemoteAccessGuardDeniesUntrustedAdminPath(t *testing.T) {
@@ -393,3 +395,69 @@ func TestRemoteAccessGuardFailOpenOnAuditFailure(t *testing.T) {
 		t.Fatalf("expected ok on audit failure in fail-open mode, got=%d", rec.Result().StatusCode)
 	}
 }
+
+func TestRemoteAccessGuardRecordAuthFailure(t *testing.T) {
+	store := audit.NewInMemoryStore()
+	guard, err := NewRemoteAccessGuard(ledgerFixedClock{now: time.Date(2026, 2, 12, 18, 0, 0, 0, time.UTC)}, store, []string{"127.0.0.1/32"})
+	if err != nil {
+		t.Fatalf("new guard err: %v", err)
+	}
+	var outcomes []string
+	guard.SetDecisionObserver(func(outcome string) {
+		outcomes = append(outcomes, outcome)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/ledger/accounts/acct-1/balance", nil)
+	req.RemoteAddr = "203.0.113.9:41000"
+	guard.RecordAuthFailure(req, "invalid token", "old-kid")
+
+	events := store.Events()
+	if len(events) != 1 {
+		t.Fatalf("expected one audit event, got=%d", len(events))
+	}
+	ev := events[0]
+	if ev.ObjectType != "gateway_auth" || ev.Action != "auth_failed" || ev.Result != audit.ResultDenied || ev.Reason != "invalid token" {
+		t.Fatalf("unexpected auth failure event: %+v", ev)
+	}
+	if ev.ActorID != "203.0.113.9" || ev.ObjectID != "/v1/ledger/accounts/acct-1/balance" || !strings.Contains(ev.AuthContext, "kid=old-kid") {
+		t.Fatalf("expected source, path and kid captured, got=%+v", ev)
+	}
+	acts := guard.Activities()
+	if len(acts) != 1 || acts[0].Allowed || acts[0].Reason != "auth failed: invalid token" {
+		t.Fatalf("unexpected activity log: %+v", acts)
+	}
+	if len(outcomes) != 1 || outcomes[0] != "auth_failed" {
+		t.Fatalf("unexpected observer outcomes: %v", outcomes)
+	}
+}
+
+func TestRemoteAccessGuardAuthFailureSampling(t *testing.T) {
+	clk := &ledgerFixedClock{now: time.Date(2026, 2, 12, 18, 0, 0, 0, time.UTC)}
+	store := audit.NewInMemoryStore()
+	guard, err := NewRemoteAccessGuard(clk, store, nil)
+	if err != nil {
+		t.Fatalf("new guard err: %v", err)
+	}
+	guard.SetAuthFailureSampling(2, time.Minute)
+
+	fail := func(ip string) {
+		req := httptest.NewRequest(http.MethodGet, "/v1/ledger/deposits", nil)
+		req.RemoteAddr = ip + ":40000"
+		guard.RecordAuthFailure(req, "missing bearer token", "")
+	}
+	for i := 0; i < 5; i++ {
+		fail("198.51.100.1")
+	}
+	fail("198.51.100.2")
+	if got := len(store.Events()); got != 3 {
+		t.Fatalf("expected two sampled events plus one from a second source, got=%d", got)
+	}
+
+	clk.now = clk.now.Add(time.Minute)
+	fail("198.51.100.1")
+	events := store.Events()
+	last := events[len(events)-1]
+	if len(events) != 4 || !strings.Contains(last.AuthContext, "suppressed=3") {
+		t.Fatalf("expected next window to report suppressed count, got=%+v", last)
+	}
+}
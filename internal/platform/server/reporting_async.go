@@ -0,0 +1,208 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	"google.golang.org/protobuf/proto"
+)
+
+// reportJob is an async report run waiting for a worker. The window is
+// fixed when the run is requested, so a run that waits in the queue still
+// covers the period the caller asked for.
+type reportJob struct {
+	run    *rgsv1.ReportRun
+	meta   *rgsv1.RequestMeta
+	window reportWindow
+}
+
+// StartReportWorkers starts workers that execute GenerateReportAsync runs
+// from a queue of queueSize until ctx is done. Runs still queued then are
+// marked failed.
+func (s *ReportingService) StartReportWorkers(ctx context.Context, workers, queueSize int) {
+	if s == nil || workers <= 0 {
+		return
+	}
+	if queueSize <= 0 {
+		queueSize = 64
+	}
+	queue := make(chan reportJob, queueSize)
+	s.mu.Lock()
+	s.reportQueue = queue
+	s.mu.Unlock()
+
+	var running sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		running.Add(1)
+		go func() {
+			defer running.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case job := <-queue:
+					s.executeReportJob(ctx, job)
+				}
+			}
+		}()
+	}
+	s.reportWorkers.Add(1)
+	go func() {
+		defer s.reportWorkers.Done()
+		<-ctx.Done()
+		running.Wait()
+		s.mu.Lock()
+		s.reportQueue = nil
+		s.mu.Unlock()
+		for {
+			select {
+			case job := <-queue:
+				s.finishReportJob(job, nil, "", false, "report workers stopped")
+			default:
+				return
+			}
+		}
+	}()
+}
+
+// WaitReportWorkers blocks until report workers started with
+// StartReportWorkers have exited and failed any runs left queued.
+func (s *ReportingService) WaitReportWorkers() {
+	if s == nil {
+		return
+	}
+	s.reportWorkers.Wait()
+}
+
+// enqueueReportJob hands a run to the workers, or says why it cannot. The
+// queue is only sent on and torn down under s.mu, so a run is never left in
+// a queue that will not be drained.
+func (s *ReportingService) enqueueReportJob(job reportJob) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.reportQueue == nil {
+		return "report workers stopped"
+	}
+	select {
+	case s.reportQueue <- job:
+		return ""
+	default:
+		return "report queue full"
+	}
+}
+
+func (s *ReportingService) storeRun(run *rgsv1.ReportRun) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.disableInMemoryCache {
+		return
+	}
+	if _, ok := s.runs[run.ReportRunId]; !ok {
+		s.runOrder = append(s.runOrder, run.ReportRunId)
+	}
+	s.runs[run.ReportRunId] = cloneRun(run)
+}
+
+func (s *ReportingService) executeReportJob(ctx context.Context, job reportJob) {
+	job.run.Status = rgsv1.ReportRunStatus_REPORT_RUN_STATUS_RUNNING
+	s.storeRun(job.run)
+	_ = s.persistReportRun(context.Background(), job.meta, job.run)
+
+	content, contentType, noActivity, code, reason := s.renderReport(ctx, job.run.ReportType, job.window, job.run.Format, job.run.OperatorId)
+	if code != rgsv1.ResultCode_RESULT_CODE_OK {
+		s.finishReportJob(job, nil, "", false, reason)
+		return
+	}
+	s.finishReportJob(job, content, contentType, noActivity, "")
+}
+
+// finishReportJob records a run's outcome; a non-empty failureReason fails
+// it. Completion is audited as generate_report, as for synchronous runs.
+func (s *ReportingService) finishReportJob(job reportJob, content []byte, contentType string, noActivity bool, failureReason string) {
+	run := job.run
+	run.GeneratedAt = s.now().Format(time.RFC3339Nano)
+	run.Status = rgsv1.ReportRunStatus_REPORT_RUN_STATUS_COMPLETED
+	result := audit.ResultSuccess
+	if failureReason != "" {
+		run.Status = rgsv1.ReportRunStatus_REPORT_RUN_STATUS_FAILED
+		run.FailureReason = failureReason
+		result = audit.ResultError
+	} else {
+		run.Content = content
+		run.ContentType = contentType
+		run.NoActivity = noActivity
+		s.mu.Lock()
+		s.signRunLocked(run)
+		s.mu.Unlock()
+	}
+	s.storeRun(run)
+	after, _ := json.Marshal(run)
+	_ = s.appendAudit(job.meta, run.ReportRunId, "generate_report", []byte(`{}`), after, result, failureReason)
+	if err := s.persistReportRun(context.Background(), job.meta, run); err == nil {
+		_ = s.scheduleReportDeliveries(context.Background(), run)
+	}
+}
+
+func (s *ReportingService) GenerateReportAsync(ctx context.Context, req *rgsv1.GenerateReportAsyncRequest) (*rgsv1.GenerateReportAsyncResponse, error) {
+	if req == nil {
+		return &rgsv1.GenerateReportAsyncResponse{Meta: s.responseMeta(nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "request is required")}, nil
+	}
+	if ok, reason := s.authorize(ctx, req.Meta); !ok {
+		_ = s.appendAudit(req.Meta, "", "generate_report_async", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		return &rgsv1.GenerateReportAsyncResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	if ok, reason := s.authorizeOperator(ctx, req.Meta, req.OperatorId); !ok {
+		_ = s.appendAudit(req.Meta, "", "generate_report_async", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		return &rgsv1.GenerateReportAsyncResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	if reason := validateReportRequest(req.ReportType, req.Interval, req.Format); reason != "" {
+		return &rgsv1.GenerateReportAsyncResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, reason)}, nil
+	}
+
+	s.mu.Lock()
+	started := s.reportQueue != nil
+	runID := s.nextRunIDLocked()
+	s.mu.Unlock()
+	if !started {
+		return &rgsv1.GenerateReportAsyncResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "report workers unavailable")}, nil
+	}
+
+	now := s.now()
+	run := &rgsv1.ReportRun{
+		ReportRunId: runID,
+		ReportType:  req.ReportType,
+		Interval:    req.Interval,
+		Format:      req.Format,
+		Status:      rgsv1.ReportRunStatus_REPORT_RUN_STATUS_PENDING,
+		OperatorId:  req.OperatorId,
+		ReportTitle: reportTitle(req.ReportType),
+		GeneratedAt: now.Format(time.RFC3339Nano),
+	}
+	window := intervalWindow(now, req.Interval, req.OperatorId)
+	if code, reason := s.limitWindowToGroup(ctx, &window, req.ReportType, req.GroupId); code != rgsv1.ResultCode_RESULT_CODE_OK {
+		return &rgsv1.GenerateReportAsyncResponse{Meta: s.responseMeta(req.Meta, code, reason)}, nil
+	}
+	run.PeriodStart, run.PeriodEnd = window.period()
+	after, _ := json.Marshal(run)
+	if err := s.appendAudit(req.Meta, runID, "generate_report_async", []byte(`{}`), after, audit.ResultSuccess, ""); err != nil {
+		return &rgsv1.GenerateReportAsyncResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	if err := s.persistReportRun(ctx, req.Meta, run); err != nil {
+		return &rgsv1.GenerateReportAsyncResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	s.storeRun(run)
+	resp := cloneRun(run)
+
+	// The worker outlives this call; give it its own copy of the caller's meta.
+	jobMeta, _ := proto.Clone(req.Meta).(*rgsv1.RequestMeta)
+	job := reportJob{run: run, meta: jobMeta, window: window}
+	if reason := s.enqueueReportJob(job); reason != "" {
+		s.finishReportJob(job, nil, "", false, reason)
+		return &rgsv1.GenerateReportAsyncResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, reason), ReportRunId: runID}, nil
+	}
+	return &rgsv1.GenerateReportAsyncResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), ReportRunId: runID, ReportRun: resp}, nil
+}
@@ -0,0 +1,114 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func TestReportingGenerateReportAsyncCompletes(t *testing.T) {
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 12, 15, 0, 0, 0, time.UTC)}
+	reportingSvc := NewReportingService(clk, NewLedgerService(clk), NewEventsService(clk))
+	ctx, cancel := context.WithCancel(context.Background())
+	reportingSvc.StartReportWorkers(ctx, 1, 4)
+	defer func() {
+		cancel()
+		reportingSvc.WaitReportWorkers()
+	}()
+
+	opMeta := meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+	resp, err := reportingSvc.GenerateReportAsync(context.Background(), &rgsv1.GenerateReportAsyncRequest{
+		Meta:       opMeta,
+		ReportType: rgsv1.ReportType_REPORT_TYPE_CASHLESS_LIABILITY_SUMMARY,
+		Interval:   rgsv1.ReportInterval_REPORT_INTERVAL_DTD,
+		Format:     rgsv1.ReportFormat_REPORT_FORMAT_JSON,
+	})
+	if err != nil {
+		t.Fatalf("generate async err: %v", err)
+	}
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || resp.ReportRunId == "" {
+		t.Fatalf("unexpected async response: %+v", resp)
+	}
+	if resp.ReportRun.GetStatus() != rgsv1.ReportRunStatus_REPORT_RUN_STATUS_PENDING {
+		t.Fatalf("expected pending run, got=%v", resp.ReportRun.GetStatus())
+	}
+
+	var run *rgsv1.ReportRun
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		got, _ := reportingSvc.GetReportRun(context.Background(), &rgsv1.GetReportRunRequest{Meta: opMeta, ReportRunId: resp.ReportRunId})
+		if got.ReportRun.GetStatus() == rgsv1.ReportRunStatus_REPORT_RUN_STATUS_COMPLETED {
+			run = got.ReportRun
+			break
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	if run == nil {
+		t.Fatalf("async run did not complete")
+	}
+	var payload map[string]any
+	if err := json.Unmarshal(run.Content, &payload); err != nil {
+		t.Fatalf("unmarshal report content: %v", err)
+	}
+	if run.ContentType != "application/json" || run.FailureReason != "" {
+		t.Fatalf("unexpected completed run: %+v", run)
+	}
+}
+
+func TestReportingGenerateReportAsyncFailures(t *testing.T) {
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 12, 15, 0, 0, 0, time.UTC)}
+	reportingSvc := NewReportingService(clk, NewLedgerService(clk), NewEventsService(clk))
+	opMeta := meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+	req := &rgsv1.GenerateReportAsyncRequest{
+		Meta:       opMeta,
+		ReportType: rgsv1.ReportType_REPORT_TYPE_CASHLESS_LIABILITY_SUMMARY,
+		Interval:   rgsv1.ReportInterval_REPORT_INTERVAL_DTD,
+		Format:     rgsv1.ReportFormat_REPORT_FORMAT_JSON,
+	}
+
+	resp, _ := reportingSvc.GenerateReportAsync(context.Background(), req)
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_ERROR || resp.Meta.GetDenialReason() != "report workers unavailable" {
+		t.Fatalf("expected workers unavailable, got %+v", resp.Meta)
+	}
+
+	invalid, _ := reportingSvc.GenerateReportAsync(context.Background(), &rgsv1.GenerateReportAsyncRequest{Meta: opMeta})
+	if invalid.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected invalid request, got %+v", invalid.Meta)
+	}
+
+	// An unbuffered queue with no workers is always full.
+	reportingSvc.reportQueue = make(chan reportJob)
+	full, _ := reportingSvc.GenerateReportAsync(context.Background(), req)
+	if full.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_ERROR || full.Meta.GetDenialReason() != "report queue full" {
+		t.Fatalf("expected queue full, got %+v", full.Meta)
+	}
+	got, _ := reportingSvc.GetReportRun(context.Background(), &rgsv1.GetReportRunRequest{Meta: opMeta, ReportRunId: full.ReportRunId})
+	if got.ReportRun.GetStatus() != rgsv1.ReportRunStatus_REPORT_RUN_STATUS_FAILED || got.ReportRun.GetFailureReason() != "report queue full" {
+		t.Fatalf("expected failed run, got %+v", got.ReportRun)
+	}
+}
+
+func TestReportingStoppedWorkersRefuseAsyncRuns(t *testing.T) {
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 12, 15, 0, 0, 0, time.UTC)}
+	reportingSvc := NewReportingService(clk, NewLedgerService(clk), NewEventsService(clk))
+	ctx, cancel := context.WithCancel(context.Background())
+	reportingSvc.StartReportWorkers(ctx, 2, 4)
+	cancel()
+	reportingSvc.WaitReportWorkers()
+
+	if reason := reportingSvc.enqueueReportJob(reportJob{run: &rgsv1.ReportRun{ReportRunId: "report-late"}}); reason != "report workers stopped" {
+		t.Fatalf("expected stopped queue, got %q", reason)
+	}
+	resp, _ := reportingSvc.GenerateReportAsync(context.Background(), &rgsv1.GenerateReportAsyncRequest{
+		Meta:       meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		ReportType: rgsv1.ReportType_REPORT_TYPE_CASHLESS_LIABILITY_SUMMARY,
+		Interval:   rgsv1.ReportInterval_REPORT_INTERVAL_DTD,
+		Format:     rgsv1.ReportFormat_REPORT_FORMAT_JSON,
+	})
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_ERROR {
+		t.Fatalf("expected error after shutdown, got %+v", resp.Meta)
+	}
+}
@@ -0,0 +1,536 @@
+package server
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/money"
+	"google.golang.org/protobuf/proto"
+)
+
+const gamingDayLayout = "2006-01-02"
+
+// DailyPackSink receives the signed bundle of a generated daily pack.
+type DailyPackSink interface {
+	Name() string
+	Deliver(ctx context.Context, pack *rgsv1.DailyPack, bundle []byte) error
+}
+
+// DirectoryDailyPackSink writes each bundle to <Dir>/<pack_id><Ext>, where
+// Ext defaults to ".json".
+type DirectoryDailyPackSink struct {
+	Dir string
+	Ext string
+}
+
+func (d DirectoryDailyPackSink) Name() string {
+	return "dir:" + d.Dir
+}
+
+func (d DirectoryDailyPackSink) Deliver(_ context.Context, pack *rgsv1.DailyPack, bundle []byte) error {
+	if strings.TrimSpace(d.Dir) == "" {
+		return errors.New("sink directory is not configured")
+	}
+	if err := os.MkdirAll(d.Dir, 0o750); err != nil {
+		return err
+	}
+	ext := d.Ext
+	if ext == "" {
+		ext = ".json"
+	}
+	path := filepath.Join(d.Dir, pack.PackId+ext)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, bundle, 0o640); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// DailyPackConfig controls which reports make up the end-of-day pack, how
+// the manifest is signed, and where packs go. Gaming-day boundaries come from
+// the OperatorID's gaming calendar.
+type DailyPackConfig struct {
+	OperatorID  string
+	ReportTypes []rgsv1.ReportType
+	Format      rgsv1.ReportFormat
+	SignerKID   string
+	SigningKey  []byte
+	Sinks       []DailyPackSink
+}
+
+func defaultDailyPackConfig() DailyPackConfig {
+	return DailyPackConfig{
+		ReportTypes: []rgsv1.ReportType{
+			rgsv1.ReportType_REPORT_TYPE_SIGNIFICANT_EVENTS_ALTERATIONS,
+			rgsv1.ReportType_REPORT_TYPE_CASHLESS_LIABILITY_SUMMARY,
+			rgsv1.ReportType_REPORT_TYPE_ACCOUNT_TRANSACTION_STATEMENT,
+		},
+		Format: rgsv1.ReportFormat_REPORT_FORMAT_JSON,
+	}
+}
+
+func (s *ReportingService) SetDailyPackConfig(cfg DailyPackConfig) {
+	if s == nil {
+		return
+	}
+	def := defaultDailyPackConfig()
+	if len(cfg.ReportTypes) == 0 {
+		cfg.ReportTypes = def.ReportTypes
+	}
+	if cfg.Format == rgsv1.ReportFormat_REPORT_FORMAT_UNSPECIFIED {
+		cfg.Format = def.Format
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.packCfg = cfg
+}
+
+func (s *ReportingService) dailyPackConfig() DailyPackConfig {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.packCfg
+}
+
+func dailyPackID(gamingDay string) string {
+	return "daily-pack-" + gamingDay
+}
+
+func cloneDailyPack(in *rgsv1.DailyPack) *rgsv1.DailyPack {
+	if in == nil {
+		return nil
+	}
+	cp, _ := proto.Clone(in).(*rgsv1.DailyPack)
+	return cp
+}
+
+func sha256Hex(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+type dailyPackManifest struct {
+	PackID             string                         `json:"pack_id"`
+	GamingDay          string                         `json:"gaming_day"`
+	OperatorID         string                         `json:"operator_id"`
+	GeneratedAt        string                         `json:"generated_at"`
+	WindowStart        string                         `json:"window_start"`
+	WindowEnd          string                         `json:"window_end"`
+	Artifacts          []*rgsv1.DailyPackArtifact     `json:"artifacts"`
+	AuditEventCount    int64                          `json:"audit_event_count"`
+	AuditChainVerified bool                           `json:"audit_chain_verified"`
+	Reconciliation     *rgsv1.DailyPackReconciliation `json:"reconciliation"`
+}
+
+// dailyPackConfigValue is one entry of config_snapshot.json: the value a key
+// held at the close of the gaming day, and the change that set it.
+type dailyPackConfigValue struct {
+	Namespace string `json:"config_namespace"`
+	Key       string `json:"config_key"`
+	Value     string `json:"value"`
+	ChangeID  string `json:"change_id"`
+	AppliedAt string `json:"applied_at"`
+}
+
+type dailyPackBundleArtifact struct {
+	Name        string `json:"name"`
+	ContentType string `json:"content_type"`
+	Content     []byte `json:"content"`
+}
+
+type dailyPackBundle struct {
+	Manifest       json.RawMessage           `json:"manifest"`
+	ManifestSHA256 string                    `json:"manifest_sha256"`
+	SignerKID      string                    `json:"signer_kid,omitempty"`
+	Signature      string                    `json:"signature,omitempty"`
+	SignatureAlg   string                    `json:"signature_alg,omitempty"`
+	Artifacts      []dailyPackBundleArtifact `json:"artifacts"`
+}
+
+func reportFileExtension(format rgsv1.ReportFormat) string {
+	switch format {
+	case rgsv1.ReportFormat_REPORT_FORMAT_CSV:
+		return "csv"
+	case rgsv1.ReportFormat_REPORT_FORMAT_PDF:
+		return "pdf"
+	}
+	return "json"
+}
+
+func (s *ReportingService) loadDailyPack(ctx context.Context, gamingDay string) (*rgsv1.DailyPack, error) {
+	if s.db != nil {
+		return s.getDailyPackFromDB(ctx, gamingDay)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return cloneDailyPack(s.packs[gamingDay]), nil
+}
+
+func (s *ReportingService) storeDailyPack(ctx context.Context, meta *rgsv1.RequestMeta, pack *rgsv1.DailyPack) error {
+	s.mu.Lock()
+	if !s.disableInMemoryCache {
+		s.packs[pack.GamingDay] = cloneDailyPack(pack)
+	}
+	s.mu.Unlock()
+	return s.persistDailyPack(ctx, meta, pack)
+}
+
+// reconcileWindow checks that every ledger posting in the window balances and
+// snapshots outstanding cashless liability.
+func (s *ReportingService) reconcileWindow(ctx context.Context, w reportWindow) (*rgsv1.DailyPackReconciliation, error) {
+	rec := &rgsv1.DailyPackReconciliation{}
+	if s.db != nil {
+		if err := s.fetchReconciliationFromDB(ctx, w, rec); err != nil {
+			return nil, err
+		}
+	} else if s.Ledger != nil && s.useInMemoryCache() {
+		s.Ledger.mu.Lock()
+		seen := make(map[string]struct{})
+		for _, txs := range s.Ledger.transactionsByAcct {
+			for _, tx := range txs {
+				if tx == nil || !w.contains(parseTS(tx.OccurredAt)) {
+					continue
+				}
+				if _, ok := seen[tx.TransactionId]; ok {
+					continue
+				}
+				seen[tx.TransactionId] = struct{}{}
+				rec.TransactionCount++
+				postings := s.Ledger.postingsByTx[tx.TransactionId]
+				if len(postings) == 0 {
+					rec.Discrepancies = append(rec.Discrepancies, "transaction "+tx.TransactionId+" has no postings")
+				}
+				for _, p := range postings {
+					total := &rec.TotalCreditsMinor
+					if p.direction == "debit" {
+						total = &rec.TotalDebitsMinor
+					} else if p.direction != "credit" {
+						continue
+					}
+					sum, err := money.AddMinor(*total, p.amount)
+					if err != nil {
+						rec.Discrepancies = append(rec.Discrepancies, "transaction "+tx.TransactionId+" overflows posting totals")
+						continue
+					}
+					*total = sum
+				}
+			}
+		}
+		liability := newLiabilityTotals()
+		for id, acct := range s.Ledger.accounts {
+			if acct == nil {
+				continue
+			}
+			if _, err := liability.add(id, acct.currency, acct.available, acct.pending); err != nil {
+				rec.Discrepancies = append(rec.Discrepancies, "account "+id+" overflows liability totals")
+			}
+			if acct.available < 0 || acct.pending < 0 {
+				rec.Discrepancies = append(rec.Discrepancies, "account "+id+" has a negative balance")
+			}
+		}
+		s.Ledger.mu.Unlock()
+		liability.reconcile(rec)
+	}
+	if rec.TotalCreditsMinor != rec.TotalDebitsMinor {
+		rec.Discrepancies = append(rec.Discrepancies, fmt.Sprintf("postings unbalanced: credits=%d debits=%d", rec.TotalCreditsMinor, rec.TotalDebitsMinor))
+	}
+	sort.Strings(rec.Discrepancies)
+	rec.Balanced = len(rec.Discrepancies) == 0
+	return rec, nil
+}
+
+// reconcile records the liability per currency. Like the cashless liability
+// report, the flat totals are only set for a single-currency property.
+func (t *liabilityTotals) reconcile(rec *rgsv1.DailyPackReconciliation) {
+	currencies := make([]string, 0, len(t.available))
+	for c := range t.available {
+		currencies = append(currencies, c)
+	}
+	sort.Strings(currencies)
+	for _, c := range currencies {
+		rec.LiabilityByCurrency = append(rec.LiabilityByCurrency, &rgsv1.DailyPackCurrencyLiability{
+			Currency:       c,
+			AvailableMinor: t.available[c],
+			PendingMinor:   t.pending[c],
+		})
+	}
+	if len(rec.LiabilityByCurrency) == 1 {
+		rec.LiabilityAvailableMinor = rec.LiabilityByCurrency[0].AvailableMinor
+		rec.LiabilityPendingMinor = rec.LiabilityByCurrency[0].PendingMinor
+	}
+}
+
+// buildDailyPack generates, signs, delivers, and records the pack for a
+// closed gaming day. Pack builds are serialized so the worker and manual
+// triggers never race on the same day.
+func (s *ReportingService) buildDailyPack(ctx context.Context, meta *rgsv1.RequestMeta, gamingDay string, force bool) (*rgsv1.DailyPack, rgsv1.ResultCode, string) {
+	day, err := time.Parse(gamingDayLayout, gamingDay)
+	if err != nil {
+		return nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "gaming_day must be YYYY-MM-DD"
+	}
+	cfg := s.dailyPackConfig()
+	w := gamingCalendarFor(cfg.OperatorID).Window(day)
+	if !s.now().After(w.end) {
+		return nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "gaming day is not closed"
+	}
+
+	s.packMu.Lock()
+	defer s.packMu.Unlock()
+
+	existing, err := s.loadDailyPack(ctx, gamingDay)
+	if err != nil {
+		return nil, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable"
+	}
+	if existing != nil && existing.Status == rgsv1.DailyPackStatus_DAILY_PACK_STATUS_COMPLETED && !force {
+		return existing, rgsv1.ResultCode_RESULT_CODE_OK, ""
+	}
+	before := []byte(`{}`)
+	if existing != nil {
+		before, _ = json.Marshal(existing)
+	}
+
+	pack := &rgsv1.DailyPack{
+		PackId:      dailyPackID(gamingDay),
+		GamingDay:   gamingDay,
+		OperatorId:  cfg.OperatorID,
+		GeneratedAt: s.now().Format(time.RFC3339Nano),
+	}
+	fail := func(reason string) (*rgsv1.DailyPack, rgsv1.ResultCode, string) {
+		pack.Status = rgsv1.DailyPackStatus_DAILY_PACK_STATUS_FAILED
+		pack.FailureReason = reason
+		after, _ := json.Marshal(pack)
+		if err := s.storeDailyPack(ctx, meta, pack); err != nil {
+			return nil, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable"
+		}
+		_ = s.appendAuditObject(meta, "daily_pack", pack.PackId, "generate_daily_pack", before, after, audit.ResultError, reason)
+		return cloneDailyPack(pack), rgsv1.ResultCode_RESULT_CODE_ERROR, reason
+	}
+
+	bundleArtifacts := make([]dailyPackBundleArtifact, 0, len(cfg.ReportTypes)+3)
+	addArtifact := func(name, contentType, runID string, content []byte) {
+		pack.Artifacts = append(pack.Artifacts, &rgsv1.DailyPackArtifact{
+			Name:        name,
+			ContentType: contentType,
+			Sha256:      sha256Hex(content),
+			SizeBytes:   int64(len(content)),
+			ReportRunId: runID,
+		})
+		bundleArtifacts = append(bundleArtifacts, dailyPackBundleArtifact{Name: name, ContentType: contentType, Content: content})
+	}
+
+	for _, reportType := range cfg.ReportTypes {
+		run, code, reason := s.generateRun(ctx, meta, reportType, w, cfg.Format, cfg.OperatorID)
+		if code != rgsv1.ResultCode_RESULT_CODE_OK {
+			return fail("report generation failed: " + reason)
+		}
+		pack.ReportRunIds = append(pack.ReportRunIds, run.ReportRunId)
+		addArtifact("reports/"+run.ReportRunId+"."+reportFileExtension(run.Format), run.ContentType, run.ReportRunId, run.Content)
+	}
+
+	auditRows, chainVerified, err := s.Audit.exportPartitionDay(ctx, gamingDay)
+	if err != nil {
+		return fail("audit export unavailable")
+	}
+	if auditRows == nil {
+		auditRows = []auditExportRow{}
+	}
+	auditContent, err := json.Marshal(auditRows)
+	if err != nil {
+		return fail("audit export unavailable")
+	}
+	pack.AuditEventCount = int64(len(auditRows))
+	pack.AuditChainVerified = chainVerified
+	addArtifact("audit_export.json", "application/json", "", auditContent)
+
+	rec, err := s.reconcileWindow(ctx, w)
+	if err != nil {
+		return fail("reconciliation unavailable")
+	}
+	pack.Reconciliation = rec
+	recContent, _ := json.Marshal(rec)
+	addArtifact("reconciliation.json", "application/json", "", recContent)
+
+	if s.Config != nil {
+		// Values are taken as of the window end rather than now, so a
+		// regenerated pack reflects the limits that applied on the day.
+		changes, err := s.Config.ValuesAsOf(ctx, w.end)
+		if err != nil {
+			return fail("config snapshot unavailable")
+		}
+		values := make([]dailyPackConfigValue, 0, len(changes))
+		for _, c := range changes {
+			values = append(values, dailyPackConfigValue{
+				Namespace: c.ConfigNamespace,
+				Key:       c.ConfigKey,
+				Value:     c.ProposedValue,
+				ChangeID:  c.ChangeId,
+				AppliedAt: c.AppliedAt,
+			})
+		}
+		cfgContent, _ := json.Marshal(values)
+		addArtifact("config_snapshot.json", "application/json", "", cfgContent)
+	}
+
+	manifest, err := json.Marshal(dailyPackManifest{
+		PackID:             pack.PackId,
+		GamingDay:          pack.GamingDay,
+		OperatorID:         pack.OperatorId,
+		GeneratedAt:        pack.GeneratedAt,
+		WindowStart:        w.start.Format(time.RFC3339Nano),
+		WindowEnd:          w.end.Format(time.RFC3339Nano),
+		Artifacts:          pack.Artifacts,
+		AuditEventCount:    pack.AuditEventCount,
+		AuditChainVerified: pack.AuditChainVerified,
+		Reconciliation:     rec,
+	})
+	if err != nil {
+		return fail("failed to serialize manifest")
+	}
+	pack.ManifestSha256 = sha256Hex(manifest)
+	if len(cfg.SigningKey) > 0 {
+		mac := hmac.New(sha256.New, cfg.SigningKey)
+		_, _ = mac.Write(manifest)
+		pack.SignerKid = cfg.SignerKID
+		pack.Signature = hex.EncodeToString(mac.Sum(nil))
+		pack.SignatureAlg = "HMAC-SHA256"
+	}
+
+	bundle, err := json.Marshal(dailyPackBundle{
+		Manifest:       manifest,
+		ManifestSHA256: pack.ManifestSha256,
+		SignerKID:      pack.SignerKid,
+		Signature:      pack.Signature,
+		SignatureAlg:   pack.SignatureAlg,
+		Artifacts:      bundleArtifacts,
+	})
+	if err != nil {
+		return fail("failed to serialize bundle")
+	}
+
+	pack.Status = rgsv1.DailyPackStatus_DAILY_PACK_STATUS_COMPLETED
+	for _, sink := range cfg.Sinks {
+		d := &rgsv1.DailyPackDelivery{Sink: sink.Name(), AttemptedAt: s.now().Format(time.RFC3339Nano)}
+		if rs, ok := sink.(RecipientDailyPackSink); ok {
+			d.Encrypted = true
+			d.RecipientFingerprints = rs.RecipientFingerprints()
+		}
+		if err := sink.Deliver(ctx, pack, bundle); err != nil {
+			d.Error = err.Error()
+			pack.Status = rgsv1.DailyPackStatus_DAILY_PACK_STATUS_PARTIAL
+		} else {
+			d.Delivered = true
+		}
+		pack.Deliveries = append(pack.Deliveries, d)
+	}
+
+	if err := s.storeDailyPack(ctx, meta, pack); err != nil {
+		return nil, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable"
+	}
+	after, _ := json.Marshal(pack)
+	if err := s.appendAuditObject(meta, "daily_pack", pack.PackId, "generate_daily_pack", before, after, audit.ResultSuccess, ""); err != nil {
+		return nil, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable"
+	}
+	return cloneDailyPack(pack), rgsv1.ResultCode_RESULT_CODE_OK, ""
+}
+
+// DailyPackJob generates the pack for each gaming day once it closes. Days
+// that already have a completed or partially delivered pack are skipped;
+// failed packs are retried on the next run.
+func (s *ReportingService) DailyPackJob() JobFunc {
+	return func(ctx context.Context, _ string) (string, error) {
+		day := gamingCalendarFor(s.dailyPackConfig().OperatorID).LastClosedGamingDay(s.now())
+		existing, err := s.loadDailyPack(ctx, day)
+		if err != nil {
+			return "", fmt.Errorf("daily pack lookup failed gaming_day=%s: %w", day, err)
+		}
+		if existing != nil && existing.Status != rgsv1.DailyPackStatus_DAILY_PACK_STATUS_FAILED {
+			return "", nil
+		}
+		pack, code, reason := s.buildDailyPack(ctx, nil, day, existing != nil)
+		if code != rgsv1.ResultCode_RESULT_CODE_OK {
+			return "", fmt.Errorf("daily pack generation failed gaming_day=%s: %s", day, reason)
+		}
+		return fmt.Sprintf("daily pack generated gaming_day=%s status=%s", day, pack.Status), nil
+	}
+}
+
+func (s *ReportingService) GenerateDailyPack(ctx context.Context, req *rgsv1.GenerateDailyPackRequest) (*rgsv1.GenerateDailyPackResponse, error) {
+	if req == nil {
+		req = &rgsv1.GenerateDailyPackRequest{}
+	}
+	if ok, reason := s.authorize(ctx, req.Meta); !ok {
+		_ = s.appendAuditObject(req.Meta, "daily_pack", "", "generate_daily_pack", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		return &rgsv1.GenerateDailyPackResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	gamingDay := strings.TrimSpace(req.GamingDay)
+	if gamingDay == "" {
+		gamingDay = gamingCalendarFor(s.dailyPackConfig().OperatorID).LastClosedGamingDay(s.now())
+	}
+	pack, code, reason := s.buildDailyPack(ctx, req.Meta, gamingDay, req.Force)
+	return &rgsv1.GenerateDailyPackResponse{Meta: s.responseMeta(req.Meta, code, reason), DailyPack: pack}, nil
+}
+
+func (s *ReportingService) ListDailyPacks(ctx context.Context, req *rgsv1.ListDailyPacksRequest) (*rgsv1.ListDailyPacksResponse, error) {
+	if req == nil {
+		req = &rgsv1.ListDailyPacksRequest{}
+	}
+	if ok, reason := s.authorize(ctx, req.Meta); !ok {
+		return &rgsv1.ListDailyPacksResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	if req.PageSize < 0 || req.PageSize > maxAuditPageSize {
+		return &rgsv1.ListDailyPacksResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "invalid page_size")}, nil
+	}
+	if err := validatePageToken(req.PageToken); err != nil {
+		return &rgsv1.ListDailyPacksResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "invalid page_token")}, nil
+	}
+
+	var items []*rgsv1.DailyPack
+	if s.db != nil {
+		var err error
+		items, err = s.listDailyPacksFromDB(ctx)
+		if err != nil {
+			return &rgsv1.ListDailyPacksResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+	} else {
+		s.mu.Lock()
+		for _, p := range s.packs {
+			items = append(items, cloneDailyPack(p))
+		}
+		s.mu.Unlock()
+		sort.Slice(items, func(i, j int) bool { return items[i].GamingDay > items[j].GamingDay })
+	}
+
+	page, next, err := paginate(items, req.PageToken, req.PageSize)
+	if err != nil {
+		return &rgsv1.ListDailyPacksResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "invalid page_token")}, nil
+	}
+	return &rgsv1.ListDailyPacksResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), DailyPacks: page, NextPageToken: next}, nil
+}
+
+func (s *ReportingService) GetDailyPack(ctx context.Context, req *rgsv1.GetDailyPackRequest) (*rgsv1.GetDailyPackResponse, error) {
+	if req == nil || req.GamingDay == "" {
+		return &rgsv1.GetDailyPackResponse{Meta: s.responseMeta(req.GetMeta(), rgsv1.ResultCode_RESULT_CODE_INVALID, "gaming_day is required")}, nil
+	}
+	if ok, reason := s.authorize(ctx, req.Meta); !ok {
+		return &rgsv1.GetDailyPackResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	pack, err := s.loadDailyPack(ctx, req.GamingDay)
+	if err != nil {
+		return &rgsv1.GetDailyPackResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if pack == nil {
+		return &rgsv1.GetDailyPackResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "daily pack not found")}, nil
+	}
+	return &rgsv1.GetDailyPackResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), DailyPack: pack}, nil
+}
@@ -0,0 +1,109 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/armor"
+	// Keys that state no hash preference fall back to RIPEMD-160, which
+	// openpgp refuses to encrypt to unless the hash is linked in.
+	_ "golang.org/x/crypto/ripemd160"
+)
+
+// RecipientDailyPackSink is a sink that encrypts bundles to a fixed set of
+// recipients. The fingerprints are recorded on each delivery receipt.
+type RecipientDailyPackSink interface {
+	DailyPackSink
+	RecipientFingerprints() []string
+}
+
+// EncryptedDailyPackSink encrypts each bundle to the OpenPGP public keys of
+// the sink's recipients before handing it to Sink, so only the holders of
+// the matching private keys can open a delivered pack.
+type EncryptedDailyPackSink struct {
+	Sink       DailyPackSink
+	Recipients openpgp.EntityList
+}
+
+// NewEncryptedDailyPackSink wraps sink with encryption to the keys in
+// keyrings, each an armored or binary OpenPGP public keyring.
+func NewEncryptedDailyPackSink(sink DailyPackSink, keyrings ...[]byte) (*EncryptedDailyPackSink, error) {
+	if sink == nil {
+		return nil, errors.New("sink is required")
+	}
+	var recipients openpgp.EntityList
+	for _, raw := range keyrings {
+		entities, err := readPublicKeyring(raw)
+		if err != nil {
+			return nil, err
+		}
+		recipients = append(recipients, entities...)
+	}
+	if len(recipients) == 0 {
+		return nil, errors.New("at least one recipient key is required")
+	}
+	for _, e := range recipients {
+		// Encrypting an empty message rejects keys without a usable
+		// encryption subkey at startup rather than at the first delivery.
+		w, err := openpgp.Encrypt(io.Discard, openpgp.EntityList{e}, nil, nil, nil)
+		if err == nil {
+			err = w.Close()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("recipient %s: %w", openPGPFingerprint(e), err)
+		}
+	}
+	return &EncryptedDailyPackSink{Sink: sink, Recipients: recipients}, nil
+}
+
+func readPublicKeyring(raw []byte) (openpgp.EntityList, error) {
+	if strings.HasPrefix(strings.TrimSpace(string(raw)), "-----BEGIN") {
+		block, err := armor.Decode(bytes.NewReader(raw))
+		if err != nil {
+			return nil, fmt.Errorf("decode armored keyring: %w", err)
+		}
+		if block.Type != openpgp.PublicKeyType {
+			return nil, fmt.Errorf("expected %s, got %s", openpgp.PublicKeyType, block.Type)
+		}
+		return openpgp.ReadKeyRing(block.Body)
+	}
+	return openpgp.ReadKeyRing(bytes.NewReader(raw))
+}
+
+func openPGPFingerprint(e *openpgp.Entity) string {
+	return strings.ToUpper(hex.EncodeToString(e.PrimaryKey.Fingerprint[:]))
+}
+
+func (e *EncryptedDailyPackSink) Name() string {
+	return e.Sink.Name()
+}
+
+func (e *EncryptedDailyPackSink) RecipientFingerprints() []string {
+	out := make([]string, 0, len(e.Recipients))
+	for _, r := range e.Recipients {
+		out = append(out, openPGPFingerprint(r))
+	}
+	return out
+}
+
+func (e *EncryptedDailyPackSink) Deliver(ctx context.Context, pack *rgsv1.DailyPack, bundle []byte) error {
+	var buf bytes.Buffer
+	w, err := openpgp.Encrypt(&buf, e.Recipients, nil, &openpgp.FileHints{IsBinary: true, FileName: pack.PackId + ".json"}, nil)
+	if err != nil {
+		return fmt.Errorf("encrypt bundle: %w", err)
+	}
+	if _, err := w.Write(bundle); err != nil {
+		return fmt.Errorf("encrypt bundle: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("encrypt bundle: %w", err)
+	}
+	return e.Sink.Deliver(ctx, pack, buf.Bytes())
+}
@@ -0,0 +1,95 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/armor"
+)
+
+func testRecipientKey(t *testing.T, name string) (*openpgp.Entity, []byte) {
+	t.Helper()
+	entity, err := openpgp.NewEntity(name, "", name+"@regulator.test", nil)
+	if err != nil {
+		t.Fatalf("generate recipient key: %v", err)
+	}
+	var buf bytes.Buffer
+	w, err := armor.Encode(&buf, openpgp.PublicKeyType, nil)
+	if err != nil {
+		t.Fatalf("armor public key: %v", err)
+	}
+	if err := entity.Serialize(w); err != nil {
+		t.Fatalf("serialize public key: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("close armor: %v", err)
+	}
+	return entity, buf.Bytes()
+}
+
+func TestDailyPackEncryptedSinkDelivery(t *testing.T) {
+	regulator, regulatorPub := testRecipientKey(t, "regulator")
+	_, auditorPub := testRecipientKey(t, "auditor")
+	inner := &recordingPackSink{name: "dir:/outbound/regulator"}
+	sink, err := NewEncryptedDailyPackSink(inner, regulatorPub, auditorPub)
+	if err != nil {
+		t.Fatalf("new encrypted sink: %v", err)
+	}
+	plain := &recordingPackSink{name: "dir:/archive"}
+
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 12, 15, 0, 0, 0, time.UTC)}
+	reportingSvc := NewReportingService(clk, NewLedgerService(clk), NewEventsService(clk))
+	reportingSvc.SetDailyPackConfig(DailyPackConfig{
+		ReportTypes: []rgsv1.ReportType{rgsv1.ReportType_REPORT_TYPE_CASHLESS_LIABILITY_SUMMARY},
+		Sinks:       []DailyPackSink{sink, plain},
+	})
+	resp, _ := reportingSvc.GenerateDailyPack(context.Background(), &rgsv1.GenerateDailyPackRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")})
+	if resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK || resp.DailyPack.Status != rgsv1.DailyPackStatus_DAILY_PACK_STATUS_COMPLETED {
+		t.Fatalf("expected completed pack, got=%+v", resp)
+	}
+
+	encrypted, clear := resp.DailyPack.Deliveries[0], resp.DailyPack.Deliveries[1]
+	if !encrypted.Delivered || !encrypted.Encrypted || encrypted.Sink != "dir:/outbound/regulator" {
+		t.Fatalf("expected encrypted delivery receipt, got=%+v", encrypted)
+	}
+	want := sink.RecipientFingerprints()
+	if len(encrypted.RecipientFingerprints) != 2 || encrypted.RecipientFingerprints[0] != want[0] || encrypted.RecipientFingerprints[1] != want[1] {
+		t.Fatalf("expected recipient fingerprints %v, got=%v", want, encrypted.RecipientFingerprints)
+	}
+	if clear.Encrypted || len(clear.RecipientFingerprints) != 0 {
+		t.Fatalf("expected plain sink receipt without recipients, got=%+v", clear)
+	}
+
+	if bytes.Equal(inner.bundles[0], plain.bundles[0]) {
+		t.Fatalf("expected encrypted sink to receive ciphertext")
+	}
+	md, err := openpgp.ReadMessage(bytes.NewReader(inner.bundles[0]), openpgp.EntityList{regulator}, nil, nil)
+	if err != nil {
+		t.Fatalf("decrypt bundle: %v", err)
+	}
+	opened, err := io.ReadAll(md.UnverifiedBody)
+	if err != nil {
+		t.Fatalf("read decrypted bundle: %v", err)
+	}
+	if !bytes.Equal(opened, plain.bundles[0]) {
+		t.Fatalf("decrypted bundle does not match plaintext delivery")
+	}
+}
+
+func TestNewEncryptedDailyPackSinkRejectsBadKeys(t *testing.T) {
+	inner := &recordingPackSink{name: "memory"}
+	if _, err := NewEncryptedDailyPackSink(inner); err == nil {
+		t.Fatalf("expected missing recipients to be rejected")
+	}
+	if _, err := NewEncryptedDailyPackSink(inner, []byte("not a key")); err == nil {
+		t.Fatalf("expected malformed keyring to be rejected")
+	}
+	if _, err := NewEncryptedDailyPackSink(inner, []byte("-----BEGIN PGP SIGNATURE-----\n\n-----END PGP SIGNATURE-----\n")); err == nil {
+		t.Fatalf("expected non public key block to be rejected")
+	}
+}
@@ -0,0 +1,296 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func dailyPackStatusToDB(v rgsv1.DailyPackStatus) string {
+	switch v {
+	case rgsv1.DailyPackStatus_DAILY_PACK_STATUS_COMPLETED:
+		return "COMPLETED"
+	case rgsv1.DailyPackStatus_DAILY_PACK_STATUS_PARTIAL:
+		return "PARTIAL"
+	case rgsv1.DailyPackStatus_DAILY_PACK_STATUS_FAILED:
+		return "FAILED"
+	default:
+		return "UNSPECIFIED"
+	}
+}
+
+func dailyPackStatusFromDB(v string) rgsv1.DailyPackStatus {
+	switch v {
+	case "COMPLETED":
+		return rgsv1.DailyPackStatus_DAILY_PACK_STATUS_COMPLETED
+	case "PARTIAL":
+		return rgsv1.DailyPackStatus_DAILY_PACK_STATUS_PARTIAL
+	case "FAILED":
+		return rgsv1.DailyPackStatus_DAILY_PACK_STATUS_FAILED
+	default:
+		return rgsv1.DailyPackStatus_DAILY_PACK_STATUS_UNSPECIFIED
+	}
+}
+
+func (s *ReportingService) persistDailyPack(ctx context.Context, meta *rgsv1.RequestMeta, p *rgsv1.DailyPack) error {
+	if s == nil || s.db == nil || p == nil {
+		return nil
+	}
+	actorID, actorType := "", ""
+	if meta != nil && meta.Actor != nil {
+		actorID = meta.Actor.ActorId
+		actorType = meta.Actor.ActorType.String()
+	}
+	runIDs, _ := json.Marshal(nonNilStrings(p.ReportRunIds))
+	artifacts, _ := json.Marshal(p.Artifacts)
+	reconciliation, _ := json.Marshal(p.Reconciliation)
+	deliveries, _ := json.Marshal(p.Deliveries)
+	const q = `
+INSERT INTO report_daily_packs (
+  gaming_day, pack_id, status, operator_id, report_run_ids, artifacts, audit_event_count,
+  audit_chain_verified, reconciliation, manifest_sha256, signer_kid, signature, signature_alg,
+  deliveries, generated_at, failure_reason, request_id, actor_id, actor_type, updated_at
+)
+VALUES ($1::date,$2,$3,$4,$5::jsonb,$6::jsonb,$7,$8,$9::jsonb,$10,$11,$12,$13,$14::jsonb,$15::timestamptz,$16,$17,$18,$19,NOW())
+ON CONFLICT (gaming_day) DO UPDATE SET
+  status = EXCLUDED.status,
+  operator_id = EXCLUDED.operator_id,
+  report_run_ids = EXCLUDED.report_run_ids,
+  artifacts = EXCLUDED.artifacts,
+  audit_event_count = EXCLUDED.audit_event_count,
+  audit_chain_verified = EXCLUDED.audit_chain_verified,
+  reconciliation = EXCLUDED.reconciliation,
+  manifest_sha256 = EXCLUDED.manifest_sha256,
+  signer_kid = EXCLUDED.signer_kid,
+  signature = EXCLUDED.signature,
+  signature_alg = EXCLUDED.signature_alg,
+  deliveries = EXCLUDED.deliveries,
+  generated_at = EXCLUDED.generated_at,
+  failure_reason = EXCLUDED.failure_reason,
+  request_id = EXCLUDED.request_id,
+  actor_id = EXCLUDED.actor_id,
+  actor_type = EXCLUDED.actor_type,
+  updated_at = NOW()
+`
+	_, err := s.db.ExecContext(ctx, q,
+		p.GamingDay,
+		p.PackId,
+		dailyPackStatusToDB(p.Status),
+		p.OperatorId,
+		string(runIDs),
+		string(nullJSONArray(artifacts)),
+		p.AuditEventCount,
+		p.AuditChainVerified,
+		string(nullJSONObject(reconciliation)),
+		p.ManifestSha256,
+		p.SignerKid,
+		p.Signature,
+		p.SignatureAlg,
+		string(nullJSONArray(deliveries)),
+		nonEmptyTime(p.GeneratedAt),
+		p.FailureReason,
+		requestID(meta),
+		actorID,
+		actorType,
+	)
+	return err
+}
+
+const dailyPackColumns = `
+gaming_day, pack_id, status, operator_id, report_run_ids, artifacts, audit_event_count,
+audit_chain_verified, reconciliation, manifest_sha256, signer_kid, signature, signature_alg,
+deliveries, generated_at, failure_reason
+`
+
+func (s *ReportingService) getDailyPackFromDB(ctx context.Context, gamingDay string) (*rgsv1.DailyPack, error) {
+	if s == nil || s.db == nil {
+		return nil, nil
+	}
+	q := `SELECT ` + dailyPackColumns + ` FROM report_daily_packs WHERE gaming_day = $1::date`
+	p, err := scanDailyPack(s.db.QueryRowContext(ctx, q, gamingDay))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return p, nil
+}
+
+func (s *ReportingService) listDailyPacksFromDB(ctx context.Context) ([]*rgsv1.DailyPack, error) {
+	if s == nil || s.db == nil {
+		return nil, nil
+	}
+	q := `SELECT ` + dailyPackColumns + ` FROM report_daily_packs ORDER BY gaming_day DESC`
+	rows, err := s.db.QueryContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]*rgsv1.DailyPack, 0)
+	for rows.Next() {
+		p, err := scanDailyPack(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, p)
+	}
+	return out, rows.Err()
+}
+
+type dailyPackScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanDailyPack(row dailyPackScanner) (*rgsv1.DailyPack, error) {
+	var (
+		p                                                       rgsv1.DailyPack
+		gamingDay, generatedAt                                  time.Time
+		statusRaw                                               string
+		runIDsRaw, artifactsRaw, reconciliationRaw, deliveryRaw []byte
+	)
+	if err := row.Scan(
+		&gamingDay,
+		&p.PackId,
+		&statusRaw,
+		&p.OperatorId,
+		&runIDsRaw,
+		&artifactsRaw,
+		&p.AuditEventCount,
+		&p.AuditChainVerified,
+		&reconciliationRaw,
+		&p.ManifestSha256,
+		&p.SignerKid,
+		&p.Signature,
+		&p.SignatureAlg,
+		&deliveryRaw,
+		&generatedAt,
+		&p.FailureReason,
+	); err != nil {
+		return nil, err
+	}
+	p.GamingDay = gamingDay.UTC().Format(gamingDayLayout)
+	p.Status = dailyPackStatusFromDB(statusRaw)
+	p.GeneratedAt = generatedAt.UTC().Format(time.RFC3339Nano)
+	if err := json.Unmarshal(runIDsRaw, &p.ReportRunIds); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(artifactsRaw, &p.Artifacts); err != nil {
+		return nil, err
+	}
+	p.Reconciliation = &rgsv1.DailyPackReconciliation{}
+	if err := json.Unmarshal(reconciliationRaw, p.Reconciliation); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(deliveryRaw, &p.Deliveries); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+func (s *ReportingService) fetchReconciliationFromDB(ctx context.Context, w reportWindow, rec *rgsv1.DailyPackReconciliation) error {
+	const totalsQ = `
+SELECT COUNT(DISTINCT t.transaction_id),
+       COALESCE(SUM(p.amount_minor) FILTER (WHERE p.direction = 'credit'), 0),
+       COALESCE(SUM(p.amount_minor) FILTER (WHERE p.direction = 'debit'), 0)
+FROM ledger_transactions t
+LEFT JOIN ledger_postings p ON p.transaction_id = t.transaction_id
+WHERE t.status = 'accepted'
+  AND t.occurred_at >= $1::timestamptz
+  AND t.occurred_at <= $2::timestamptz
+`
+	if err := s.reportDB().QueryRowContext(ctx, totalsQ, w.start, w.end).Scan(&rec.TransactionCount, &rec.TotalCreditsMinor, &rec.TotalDebitsMinor); err != nil {
+		return err
+	}
+
+	const missingQ = `
+SELECT t.transaction_id
+FROM ledger_transactions t
+WHERE t.status = 'accepted'
+  AND t.occurred_at >= $1::timestamptz
+  AND t.occurred_at <= $2::timestamptz
+  AND NOT EXISTS (SELECT 1 FROM ledger_postings p WHERE p.transaction_id = t.transaction_id)
+ORDER BY t.transaction_id ASC
+LIMIT 100
+`
+	rows, err := s.reportDB().QueryContext(ctx, missingQ, w.start, w.end)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var txID string
+		if err := rows.Scan(&txID); err != nil {
+			return err
+		}
+		rec.Discrepancies = append(rec.Discrepancies, "transaction "+txID+" has no postings")
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	const liabilityQ = `
+SELECT currency_code,
+       COALESCE(SUM(available_balance_minor), 0),
+       COALESCE(SUM(pending_balance_minor), 0),
+       COUNT(*) FILTER (WHERE available_balance_minor < 0 OR pending_balance_minor < 0)
+FROM ledger_accounts
+GROUP BY currency_code
+`
+	liabilityRows, err := s.reportDB().QueryContext(ctx, liabilityQ)
+	if err != nil {
+		return err
+	}
+	defer liabilityRows.Close()
+	liability := newLiabilityTotals()
+	var negative int64
+	for liabilityRows.Next() {
+		var currency string
+		var available, pending, negativeInCurrency int64
+		if err := liabilityRows.Scan(&currency, &available, &pending, &negativeInCurrency); err != nil {
+			return err
+		}
+		currency = strings.TrimSpace(currency)
+		if err := liability.available.Add(currency, available); err != nil {
+			return err
+		}
+		if err := liability.pending.Add(currency, pending); err != nil {
+			return err
+		}
+		negative += negativeInCurrency
+	}
+	if err := liabilityRows.Err(); err != nil {
+		return err
+	}
+	liability.reconcile(rec)
+	if negative > 0 {
+		rec.Discrepancies = append(rec.Discrepancies, "ledger has accounts with negative balances")
+	}
+	return nil
+}
+
+func nonNilStrings(v []string) []string {
+	if v == nil {
+		return []string{}
+	}
+	return v
+}
+
+func nullJSONArray(b []byte) []byte {
+	if len(b) == 0 || string(b) == "null" {
+		return []byte(`[]`)
+	}
+	return b
+}
+
+func nullJSONObject(b []byte) []byte {
+	if len(b) == 0 || string(b) == "null" {
+		return []byte(`{}`)
+	}
+	return b
+}
@@ -0,0 +1,193 @@
+package server
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+type recordingPackSink struct {
+	name    string
+	err     error
+	bundles [][]byte
+}
+
+func (r *recordingPackSink) Name() string { return r.name }
+
+func (r *recordingPackSink) Deliver(_ context.Context, _ *rgsv1.DailyPack, bundle []byte) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.bundles = append(r.bundles, bundle)
+	return nil
+}
+
+func TestDailyPackGeneratesSignedBundle(t *testing.T) {
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 12, 15, 0, 0, 0, time.UTC)}
+	eventsSvc := NewEventsService(clk)
+	reportingSvc := NewReportingService(clk, NewLedgerService(clk), eventsSvc)
+	reportingSvc.Audit = NewAuditService(clk, nil, reportingSvc.AuditStore, eventsSvc.AuditStore)
+	sink := &recordingPackSink{name: "memory"}
+	reportingSvc.SetDailyPackConfig(DailyPackConfig{
+		OperatorID: "casino-1",
+		SignerKID:  "pack-k1",
+		SigningKey: []byte("pack-secret"),
+		Sinks:      []DailyPackSink{sink},
+	})
+	ctx := context.Background()
+
+	for _, ev := range []struct{ id, at string }{{"ev-yesterday", "2026-02-11T10:00:00Z"}, {"ev-today", "2026-02-12T14:00:00Z"}} {
+		_, _ = eventsSvc.SubmitSignificantEvent(ctx, &rgsv1.SubmitSignificantEventRequest{
+			Meta:  meta("svc-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, ""),
+			Event: &rgsv1.SignificantEvent{EventId: ev.id, EquipmentId: "eq-1", EventCode: "E1", OccurredAt: ev.at},
+		})
+	}
+
+	resp, err := reportingSvc.GenerateDailyPack(ctx, &rgsv1.GenerateDailyPackRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")})
+	if err != nil {
+		t.Fatalf("generate daily pack err: %v", err)
+	}
+	if resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected ok, got=%v reason=%q", resp.Meta.ResultCode, resp.Meta.DenialReason)
+	}
+	pack := resp.DailyPack
+	if pack.GamingDay != "2026-02-11" || pack.Status != rgsv1.DailyPackStatus_DAILY_PACK_STATUS_COMPLETED {
+		t.Fatalf("unexpected pack: day=%s status=%v", pack.GamingDay, pack.Status)
+	}
+	if len(pack.ReportRunIds) != 3 || len(pack.Artifacts) != 5 {
+		t.Fatalf("expected 3 reports and 5 artifacts, got=%d/%d", len(pack.ReportRunIds), len(pack.Artifacts))
+	}
+	if !pack.Reconciliation.GetBalanced() {
+		t.Fatalf("expected balanced reconciliation: %+v", pack.Reconciliation)
+	}
+	if len(sink.bundles) != 1 || len(pack.Deliveries) != 1 || !pack.Deliveries[0].Delivered {
+		t.Fatalf("expected one successful delivery, got=%+v", pack.Deliveries)
+	}
+
+	var bundle struct {
+		Manifest  json.RawMessage `json:"manifest"`
+		Signature string          `json:"signature"`
+		Artifacts []struct {
+			Name    string `json:"name"`
+			Content []byte `json:"content"`
+		} `json:"artifacts"`
+	}
+	if err := json.Unmarshal(sink.bundles[0], &bundle); err != nil {
+		t.Fatalf("unmarshal bundle: %v", err)
+	}
+	mac := hmac.New(sha256.New, []byte("pack-secret"))
+	_, _ = mac.Write(bundle.Manifest)
+	if bundle.Signature != hex.EncodeToString(mac.Sum(nil)) || bundle.Signature != pack.Signature {
+		t.Fatalf("bundle signature does not verify")
+	}
+	var events struct {
+		RowCount int `json:"row_count"`
+	}
+	if err := json.Unmarshal(bundle.Artifacts[0].Content, &events); err != nil {
+		t.Fatalf("unmarshal significant events report: %v", err)
+	}
+	if events.RowCount != 1 {
+		t.Fatalf("expected only the gaming day's event, got=%d", events.RowCount)
+	}
+
+	again, _ := reportingSvc.GenerateDailyPack(ctx, &rgsv1.GenerateDailyPackRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), GamingDay: "2026-02-11"})
+	if again.DailyPack.GeneratedAt != pack.GeneratedAt || len(sink.bundles) != 1 {
+		t.Fatalf("expected completed pack to be returned without regeneration")
+	}
+
+	got, _ := reportingSvc.GetDailyPack(ctx, &rgsv1.GetDailyPackRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), GamingDay: "2026-02-11"})
+	if got.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK || got.DailyPack.ManifestSha256 != pack.ManifestSha256 {
+		t.Fatalf("expected stored pack on get, got=%+v", got.Meta)
+	}
+}
+
+func TestDailyPackSinkFailureMarksPartial(t *testing.T) {
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 12, 15, 0, 0, 0, time.UTC)}
+	reportingSvc := NewReportingService(clk, NewLedgerService(clk), NewEventsService(clk))
+	reportingSvc.SetDailyPackConfig(DailyPackConfig{
+		ReportTypes: []rgsv1.ReportType{rgsv1.ReportType_REPORT_TYPE_CASHLESS_LIABILITY_SUMMARY},
+		Sinks:       []DailyPackSink{&recordingPackSink{name: "broken", err: errors.New("unreachable")}},
+	})
+
+	resp, _ := reportingSvc.GenerateDailyPack(context.Background(), &rgsv1.GenerateDailyPackRequest{Meta: meta("svc-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, "")})
+	if resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected ok, got=%v", resp.Meta.ResultCode)
+	}
+	if resp.DailyPack.Status != rgsv1.DailyPackStatus_DAILY_PACK_STATUS_PARTIAL || resp.DailyPack.Deliveries[0].Error != "unreachable" {
+		t.Fatalf("expected partial pack with delivery error, got=%+v", resp.DailyPack)
+	}
+	if resp.DailyPack.Signature != "" {
+		t.Fatalf("expected unsigned pack without signing key")
+	}
+}
+
+func TestDailyPackRejectsOpenGamingDayAndPlayers(t *testing.T) {
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 12, 15, 0, 0, 0, time.UTC)}
+	reportingSvc := NewReportingService(clk, NewLedgerService(clk), NewEventsService(clk))
+	ctx := context.Background()
+
+	open, _ := reportingSvc.GenerateDailyPack(ctx, &rgsv1.GenerateDailyPackRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), GamingDay: "2026-02-12"})
+	if open.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected invalid for open gaming day, got=%v", open.Meta.ResultCode)
+	}
+
+	denied, _ := reportingSvc.GenerateDailyPack(ctx, &rgsv1.GenerateDailyPackRequest{Meta: meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "")})
+	if denied.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected denied, got=%v", denied.Meta.ResultCode)
+	}
+	events := auditEvents(reportingSvc.AuditStore)
+	if len(events) != 1 || events[0].ObjectType != "daily_pack" || events[0].Result != audit.ResultDenied {
+		t.Fatalf("expected denied daily pack audit event, got=%+v", events)
+	}
+}
+
+func TestLastClosedGamingDayHonorsDayStart(t *testing.T) {
+	now := time.Date(2026, 2, 12, 5, 0, 0, 0, time.UTC)
+	if got := (GamingCalendar{}).LastClosedGamingDay(now); got != "2026-02-11" {
+		t.Fatalf("unexpected gaming day without offset: %s", got)
+	}
+	if got := (GamingCalendar{DayStart: 6 * time.Hour}).LastClosedGamingDay(now); got != "2026-02-10" {
+		t.Fatalf("unexpected gaming day with 6h offset: %s", got)
+	}
+}
+
+func TestDailyPackReconciliationGroupsLiabilityByCurrency(t *testing.T) {
+	now := time.Date(2026, 2, 12, 15, 0, 0, 0, time.UTC)
+	clk := ledgerFixedClock{now: now}
+	ledger := NewLedgerService(clk)
+	reportingSvc := NewReportingService(clk, ledger, NewEventsService(clk))
+	ctx := context.Background()
+	for _, dep := range []struct {
+		account, currency string
+		amount            int64
+	}{{"player-1", "USD", 1000}, {"player-2", "USD", 250}, {"player-1:EUR", "EUR", 500}} {
+		resp, _ := ledger.Deposit(ctx, &rgsv1.DepositRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "dep-"+dep.account), AccountId: dep.account, Amount: &rgsv1.Money{AmountMinor: dep.amount, Currency: dep.currency}})
+		if resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("deposit to %s: %+v", dep.account, resp.Meta)
+		}
+	}
+
+	day, _ := time.Parse(gamingDayLayout, gamingCalendarFor("").GamingDay(now))
+	rec, err := reportingSvc.reconcileWindow(ctx, gamingCalendarFor("").Window(day))
+	if err != nil {
+		t.Fatalf("reconcile: %v", err)
+	}
+	got := map[string]int64{}
+	for _, l := range rec.LiabilityByCurrency {
+		got[l.Currency] += l.AvailableMinor
+	}
+	if len(rec.LiabilityByCurrency) != 2 || got["USD"] != 1250 || got["EUR"] != 500 {
+		t.Fatalf("expected liability per currency, got %+v", rec.LiabilityByCurrency)
+	}
+	if rec.LiabilityAvailableMinor != 0 || rec.LiabilityPendingMinor != 0 {
+		t.Fatalf("expected no flat liability across currencies, got available=%d pending=%d", rec.LiabilityAvailableMinor, rec.LiabilityPendingMinor)
+	}
+}
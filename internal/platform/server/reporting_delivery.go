@@ -0,0 +1,319 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/smtp"
+	"strings"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	"google.golang.org/protobuf/proto"
+)
+
+// ReportDeliveryTarget receives completed report runs. Deliveries are
+// retried, so a target must accept the same run more than once.
+type ReportDeliveryTarget interface {
+	Name() string
+	DeliverReport(ctx context.Context, run *rgsv1.ReportRun) error
+}
+
+// ReportDeliveryConfig lists where completed report runs are pushed.
+type ReportDeliveryConfig struct {
+	Targets []ReportDeliveryTarget
+	// ReportTypes limits delivery to these report types; empty delivers
+	// every type.
+	ReportTypes []rgsv1.ReportType
+	// MaxAttempts caps attempts per run and target; it defaults to 5.
+	MaxAttempts int
+}
+
+func (s *ReportingService) SetReportDeliveryConfig(cfg ReportDeliveryConfig) {
+	if s == nil {
+		return
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 5
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.deliveryCfg = cfg
+}
+
+func (s *ReportingService) reportDeliveryConfig() ReportDeliveryConfig {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.deliveryCfg
+}
+
+// reportFileName names a run's content when it is delivered as a file.
+func reportFileName(run *rgsv1.ReportRun) string {
+	return run.ReportRunId + "." + reportFileExtension(run.Format)
+}
+
+func cloneReportRunDelivery(in *rgsv1.ReportRunDelivery) *rgsv1.ReportRunDelivery {
+	if in == nil {
+		return nil
+	}
+	cp, _ := proto.Clone(in).(*rgsv1.ReportRunDelivery)
+	return cp
+}
+
+// scheduleReportDeliveries queues a completed run for every configured
+// target. A run is queued for a target once.
+func (s *ReportingService) scheduleReportDeliveries(ctx context.Context, run *rgsv1.ReportRun) error {
+	cfg := s.reportDeliveryConfig()
+	if len(cfg.Targets) == 0 || run == nil || run.Status != rgsv1.ReportRunStatus_REPORT_RUN_STATUS_COMPLETED {
+		return nil
+	}
+	if len(cfg.ReportTypes) > 0 {
+		matched := false
+		for _, t := range cfg.ReportTypes {
+			if t == run.ReportType {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return nil
+		}
+	}
+	for _, target := range cfg.Targets {
+		if s.db != nil {
+			if err := s.insertReportRunDeliveryDB(ctx, run.ReportRunId, target.Name()); err != nil {
+				return err
+			}
+			continue
+		}
+		s.mu.Lock()
+		if _, ok := s.deliveries[run.ReportRunId][target.Name()]; !ok {
+			if s.deliveries[run.ReportRunId] == nil {
+				s.deliveries[run.ReportRunId] = map[string]*rgsv1.ReportRunDelivery{}
+			}
+			s.deliveries[run.ReportRunId][target.Name()] = &rgsv1.ReportRunDelivery{Target: target.Name()}
+			s.deliveryOrder = append(s.deliveryOrder, reportDeliveryKey{runID: run.ReportRunId, target: target.Name()})
+		}
+		s.mu.Unlock()
+	}
+	return nil
+}
+
+type reportDeliveryKey struct {
+	runID  string
+	target string
+}
+
+// pendingReportDeliveries returns up to limit undelivered entries with
+// attempts left, oldest first.
+func (s *ReportingService) pendingReportDeliveries(ctx context.Context, maxAttempts, limit int) ([]reportDeliveryKey, error) {
+	if s.db != nil {
+		return s.pendingReportDeliveriesFromDB(ctx, maxAttempts, limit)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]reportDeliveryKey, 0)
+	for _, key := range s.deliveryOrder {
+		d := s.deliveries[key.runID][key.target]
+		if d == nil || d.Delivered || int(d.Attempts) >= maxAttempts {
+			continue
+		}
+		out = append(out, key)
+		if len(out) == limit {
+			break
+		}
+	}
+	return out, nil
+}
+
+func (s *ReportingService) loadReportRunDeliveries(ctx context.Context, runID string) ([]*rgsv1.ReportRunDelivery, error) {
+	if s.db != nil {
+		return s.listReportRunDeliveriesFromDB(ctx, runID)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]*rgsv1.ReportRunDelivery, 0)
+	for _, key := range s.deliveryOrder {
+		if key.runID != runID {
+			continue
+		}
+		out = append(out, cloneReportRunDelivery(s.deliveries[key.runID][key.target]))
+	}
+	return out, nil
+}
+
+func (s *ReportingService) loadReportRunDelivery(ctx context.Context, key reportDeliveryKey) (*rgsv1.ReportRunDelivery, error) {
+	if s.db != nil {
+		return s.getReportRunDeliveryFromDB(ctx, key.runID, key.target)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return cloneReportRunDelivery(s.deliveries[key.runID][key.target]), nil
+}
+
+func (s *ReportingService) storeReportRunDelivery(ctx context.Context, runID string, d *rgsv1.ReportRunDelivery) error {
+	if s.db != nil {
+		return s.updateReportRunDeliveryDB(ctx, runID, d)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.deliveries[runID] != nil {
+		s.deliveries[runID][d.Target] = cloneReportRunDelivery(d)
+	}
+	return nil
+}
+
+func (s *ReportingService) loadRunForDelivery(ctx context.Context, runID string) (*rgsv1.ReportRun, error) {
+	if s.db != nil {
+		return s.getReportRunFromDB(ctx, runID)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return cloneRun(s.runs[runID]), nil
+}
+
+// deliverReportRun makes one attempt to push a run to a target and records
+// and audits the outcome.
+func (s *ReportingService) deliverReportRun(ctx context.Context, target ReportDeliveryTarget, key reportDeliveryKey) (bool, error) {
+	d, err := s.loadReportRunDelivery(ctx, key)
+	if err != nil {
+		return false, err
+	}
+	if d == nil || d.Delivered {
+		return d != nil, nil
+	}
+	before, _ := json.Marshal(d)
+
+	run, err := s.loadRunForDelivery(ctx, key.runID)
+	if err != nil {
+		return false, err
+	}
+	deliverErr := errors.New("report run not found")
+	if run != nil {
+		deliverErr = target.DeliverReport(ctx, run)
+	}
+
+	now := s.now().Format(time.RFC3339Nano)
+	d.Attempts++
+	d.LastAttemptedAt = now
+	result, reason := audit.ResultSuccess, ""
+	if deliverErr != nil {
+		d.LastError = deliverErr.Error()
+		result, reason = audit.ResultError, d.LastError
+	} else {
+		d.Delivered = true
+		d.LastError = ""
+		d.DeliveredAt = now
+	}
+	if err := s.storeReportRunDelivery(ctx, key.runID, d); err != nil {
+		return false, err
+	}
+	after, _ := json.Marshal(d)
+	_ = s.appendAudit(nil, key.runID, "deliver_report", before, after, result, reason)
+	return d.Delivered, nil
+}
+
+// DeliveryJob pushes up to batch queued report runs to their targets. A
+// failed delivery is retried on later runs until it reaches MaxAttempts.
+// Entries for targets no longer configured stay queued.
+func (s *ReportingService) DeliveryJob(batch int) JobFunc {
+	if batch <= 0 {
+		batch = 50
+	}
+	return func(ctx context.Context, _ string) (string, error) {
+		cfg := s.reportDeliveryConfig()
+		if len(cfg.Targets) == 0 {
+			return "", nil
+		}
+		targets := make(map[string]ReportDeliveryTarget, len(cfg.Targets))
+		for _, t := range cfg.Targets {
+			targets[t.Name()] = t
+		}
+		pending, err := s.pendingReportDeliveries(ctx, cfg.MaxAttempts, batch)
+		if err != nil {
+			return "", fmt.Errorf("report delivery lookup failed: %w", err)
+		}
+		attempted, delivered := 0, 0
+		for _, key := range pending {
+			target, ok := targets[key.target]
+			if !ok {
+				continue
+			}
+			done, err := s.deliverReportRun(ctx, target, key)
+			if err != nil {
+				return "", fmt.Errorf("report delivery failed report_run_id=%s target=%s: %w", key.runID, key.target, err)
+			}
+			attempted++
+			if done {
+				delivered++
+			}
+		}
+		if attempted == 0 {
+			return "", nil
+		}
+		return fmt.Sprintf("report deliveries attempted=%d delivered=%d failed=%d", attempted, delivered, attempted-delivered), nil
+	}
+}
+
+// S3ReportDeliveryTarget puts each run's content at <Prefix><file> in an
+// S3 bucket, through the same write-once client as audit exports.
+type S3ReportDeliveryTarget struct {
+	S3AuditExportSink
+}
+
+func (t S3ReportDeliveryTarget) DeliverReport(ctx context.Context, run *rgsv1.ReportRun) error {
+	return t.PutObject(ctx, reportFileName(run), run.ContentType, run.Content)
+}
+
+// EmailReportDeliveryTarget mails each run as an attachment to To through
+// the SMTP server at Addr (host:port). Auth may be nil for unauthenticated
+// relays.
+type EmailReportDeliveryTarget struct {
+	Addr string
+	From string
+	To   []string
+	Auth smtp.Auth
+
+	sendMail func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+func (t EmailReportDeliveryTarget) Name() string {
+	return "email:" + strings.Join(t.To, ",")
+}
+
+func (t EmailReportDeliveryTarget) DeliverReport(_ context.Context, run *rgsv1.ReportRun) error {
+	if t.Addr == "" || t.From == "" || len(t.To) == 0 {
+		return errors.New("email report target requires addr, from, and to")
+	}
+	send := t.sendMail
+	if send == nil {
+		send = smtp.SendMail
+	}
+	const boundary = "open-rgs-report"
+	subject := fmt.Sprintf("%s (%s)", run.ReportTitle, run.Interval)
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "From: %s\r\n", t.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(t.To, ", "))
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&msg, "Content-Type: multipart/mixed; boundary=%q\r\n\r\n", boundary)
+	fmt.Fprintf(&msg, "--%s\r\nContent-Type: text/plain; charset=utf-8\r\n\r\n", boundary)
+	fmt.Fprintf(&msg, "%s.\r\n\r\nReport run: %s\r\nOperator: %s\r\nGenerated at: %s\r\n", subject, run.ReportRunId, run.OperatorId, run.GeneratedAt)
+	if run.NoActivity {
+		msg.WriteString("No Activity\r\n")
+	}
+	fmt.Fprintf(&msg, "--%s\r\nContent-Type: %s\r\nContent-Transfer-Encoding: base64\r\n", boundary, run.ContentType)
+	fmt.Fprintf(&msg, "Content-Disposition: attachment; filename=%q\r\n\r\n", reportFileName(run))
+	encoded := base64.StdEncoding.EncodeToString(run.Content)
+	for len(encoded) > 76 {
+		msg.WriteString(encoded[:76] + "\r\n")
+		encoded = encoded[76:]
+	}
+	msg.WriteString(encoded + "\r\n")
+	fmt.Fprintf(&msg, "--%s--\r\n", boundary)
+	return send(t.Addr, t.Auth, t.From, t.To, []byte(msg.String()))
+}
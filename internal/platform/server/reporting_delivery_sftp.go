@@ -0,0 +1,268 @@
+package server
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"path"
+	"strings"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"golang.org/x/crypto/ssh"
+)
+
+// SFTPReportDeliveryTarget uploads each run's content to <Dir>/<file> on an
+// SFTP server. The file is written under a temporary name and renamed into
+// place, so readers never see a partial report.
+type SFTPReportDeliveryTarget struct {
+	Addr   string
+	Dir    string
+	Config *ssh.ClientConfig
+}
+
+// NewSFTPReportDeliveryTarget builds a target that logs in as user with a
+// private key (PEM) and/or password. hostKey is the server's public key in
+// authorized_keys format; it is required, since an unverified host would
+// receive the reports.
+func NewSFTPReportDeliveryTarget(addr, dir, user, password string, privateKey []byte, hostKey string) (*SFTPReportDeliveryTarget, error) {
+	if addr == "" || user == "" {
+		return nil, errors.New("sftp report target requires addr and user")
+	}
+	if strings.TrimSpace(hostKey) == "" {
+		return nil, errors.New("sftp report target requires a host key")
+	}
+	pub, _, _, _, err := ssh.ParseAuthorizedKey([]byte(hostKey))
+	if err != nil {
+		return nil, fmt.Errorf("parse sftp host key: %w", err)
+	}
+	auth := make([]ssh.AuthMethod, 0, 2)
+	if len(privateKey) > 0 {
+		signer, err := ssh.ParsePrivateKey(privateKey)
+		if err != nil {
+			return nil, fmt.Errorf("parse sftp private key: %w", err)
+		}
+		auth = append(auth, ssh.PublicKeys(signer))
+	}
+	if password != "" {
+		auth = append(auth, ssh.Password(password))
+	}
+	if len(auth) == 0 {
+		return nil, errors.New("sftp report target requires a private key or password")
+	}
+	return &SFTPReportDeliveryTarget{
+		Addr: addr,
+		Dir:  dir,
+		Config: &ssh.ClientConfig{
+			User:            user,
+			Auth:            auth,
+			HostKeyCallback: ssh.FixedHostKey(pub),
+			Timeout:         30 * time.Second,
+		},
+	}, nil
+}
+
+func (t *SFTPReportDeliveryTarget) Name() string {
+	return "sftp:" + t.Addr + ":" + t.Dir
+}
+
+func (t *SFTPReportDeliveryTarget) DeliverReport(ctx context.Context, run *rgsv1.ReportRun) error {
+	if t.Config == nil {
+		return errors.New("sftp report target is not configured")
+	}
+	conn, err := (&net.Dialer{Timeout: 30 * time.Second}).DialContext(ctx, "tcp", t.Addr)
+	if err != nil {
+		return err
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(2 * time.Minute)
+	}
+	_ = conn.SetDeadline(deadline)
+	c, chans, reqs, err := ssh.NewClientConn(conn, t.Addr, t.Config)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	client := ssh.NewClient(c, chans, reqs)
+	defer client.Close()
+	session, err := client.NewSession()
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+	w, err := session.StdinPipe()
+	if err != nil {
+		return err
+	}
+	r, err := session.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	if err := session.RequestSubsystem("sftp"); err != nil {
+		return err
+	}
+	return sftpPutFile(r, w, path.Join(t.Dir, reportFileName(run)), run.Content)
+}
+
+// SFTP version 3 packet types and open flags
+// (draft-ietf-secsh-filexfer-02).
+const (
+	sftpInit    = 1
+	sftpVersion = 2
+	sftpOpen    = 3
+	sftpClose   = 4
+	sftpWrite   = 6
+	sftpRemove  = 13
+	sftpRename  = 18
+	sftpStatus  = 101
+	sftpHandle  = 102
+
+	sftpFlagWrite  = 0x02
+	sftpFlagCreate = 0x08
+	sftpFlagTrunc  = 0x10
+
+	sftpChunkSize     = 32 * 1024
+	sftpMaxPacketSize = 256 * 1024
+)
+
+// sftpConn is the client half of an SFTP session: requests are sent one at
+// a time and each reply is read before the next request.
+type sftpConn struct {
+	r      io.Reader
+	w      io.Writer
+	nextID uint32
+}
+
+func sftpString(b []byte, s []byte) []byte {
+	b = binary.BigEndian.AppendUint32(b, uint32(len(s)))
+	return append(b, s...)
+}
+
+func (c *sftpConn) send(typ byte, payload []byte) error {
+	pkt := binary.BigEndian.AppendUint32(nil, uint32(len(payload)+1))
+	pkt = append(pkt, typ)
+	_, err := c.w.Write(append(pkt, payload...))
+	return err
+}
+
+func (c *sftpConn) recv() (byte, []byte, error) {
+	var hdr [4]byte
+	if _, err := io.ReadFull(c.r, hdr[:]); err != nil {
+		return 0, nil, err
+	}
+	n := binary.BigEndian.Uint32(hdr[:])
+	if n == 0 || n > sftpMaxPacketSize {
+		return 0, nil, fmt.Errorf("sftp packet length %d out of range", n)
+	}
+	buf := make([]byte, n)
+	if _, err := io.ReadFull(c.r, buf); err != nil {
+		return 0, nil, err
+	}
+	return buf[0], buf[1:], nil
+}
+
+// request sends a packet carrying a fresh request id and returns the reply
+// type and the reply body after its id.
+func (c *sftpConn) request(typ byte, body []byte) (byte, []byte, error) {
+	c.nextID++
+	id := c.nextID
+	if err := c.send(typ, append(binary.BigEndian.AppendUint32(nil, id), body...)); err != nil {
+		return 0, nil, err
+	}
+	rtyp, reply, err := c.recv()
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(reply) < 4 || binary.BigEndian.Uint32(reply) != id {
+		return 0, nil, errors.New("sftp reply does not match request")
+	}
+	return rtyp, reply[4:], nil
+}
+
+// sftpStatusError returns the failure carried by an SSH_FXP_STATUS reply,
+// or nil for status 0.
+func sftpStatusError(op, name string, typ byte, body []byte) error {
+	if typ != sftpStatus || len(body) < 4 {
+		return fmt.Errorf("sftp %s %s: unexpected reply type %d", op, name, typ)
+	}
+	code := binary.BigEndian.Uint32(body)
+	if code == 0 {
+		return nil
+	}
+	msg := ""
+	if len(body) >= 8 {
+		if n := binary.BigEndian.Uint32(body[4:]); int(n) <= len(body)-8 {
+			msg = string(body[8 : 8+n])
+		}
+	}
+	return fmt.Errorf("sftp %s %s: %s (status %d)", op, name, msg, code)
+}
+
+func sftpPutFile(r io.Reader, w io.Writer, remotePath string, data []byte) error {
+	c := &sftpConn{r: r, w: w}
+	if err := c.send(sftpInit, binary.BigEndian.AppendUint32(nil, 3)); err != nil {
+		return err
+	}
+	typ, _, err := c.recv()
+	if err != nil {
+		return err
+	}
+	if typ != sftpVersion {
+		return fmt.Errorf("sftp init: unexpected reply type %d", typ)
+	}
+
+	tmp := remotePath + ".tmp"
+	open := sftpString(nil, []byte(tmp))
+	open = binary.BigEndian.AppendUint32(open, sftpFlagWrite|sftpFlagCreate|sftpFlagTrunc)
+	open = binary.BigEndian.AppendUint32(open, 0) // no attributes
+	typ, body, err := c.request(sftpOpen, open)
+	if err != nil {
+		return err
+	}
+	if typ != sftpHandle {
+		return sftpStatusError("open", tmp, typ, body)
+	}
+	if len(body) < 4 || int(binary.BigEndian.Uint32(body)) > len(body)-4 {
+		return errors.New("sftp open: malformed handle")
+	}
+	handle := body[4 : 4+binary.BigEndian.Uint32(body)]
+
+	for off := 0; ; {
+		end := min(off+sftpChunkSize, len(data))
+		req := sftpString(nil, handle)
+		req = binary.BigEndian.AppendUint64(req, uint64(off))
+		req = sftpString(req, data[off:end])
+		typ, body, err := c.request(sftpWrite, req)
+		if err != nil {
+			return err
+		}
+		if err := sftpStatusError("write", tmp, typ, body); err != nil {
+			return err
+		}
+		if off = end; off >= len(data) {
+			break
+		}
+	}
+	typ, body, err = c.request(sftpClose, sftpString(nil, handle))
+	if err != nil {
+		return err
+	}
+	if err := sftpStatusError("close", tmp, typ, body); err != nil {
+		return err
+	}
+
+	// Version 3 RENAME fails when the target exists, as it does when an
+	// earlier attempt got this far; a missing target is not an error.
+	if _, _, err := c.request(sftpRemove, sftpString(nil, []byte(remotePath))); err != nil {
+		return err
+	}
+	typ, body, err = c.request(sftpRename, sftpString(sftpString(nil, []byte(tmp)), []byte(remotePath)))
+	if err != nil {
+		return err
+	}
+	return sftpStatusError("rename", remotePath, typ, body)
+}
@@ -0,0 +1,347 @@
+package server
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"net/smtp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"golang.org/x/crypto/ssh"
+)
+
+type recordingReportTarget struct {
+	name     string
+	failures int
+
+	mu        sync.Mutex
+	delivered []string
+}
+
+func (t *recordingReportTarget) Name() string { return t.name }
+
+func (t *recordingReportTarget) DeliverReport(_ context.Context, run *rgsv1.ReportRun) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.failures > 0 {
+		t.failures--
+		return errors.New("target unavailable")
+	}
+	t.delivered = append(t.delivered, run.ReportRunId)
+	return nil
+}
+
+func TestReportDeliveryJobRetriesAndAudits(t *testing.T) {
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 12, 15, 0, 0, 0, time.UTC)}
+	reportingSvc := NewReportingService(clk, NewLedgerService(clk), NewEventsService(clk))
+	target := &recordingReportTarget{name: "test:ops", failures: 1}
+	reportingSvc.SetReportDeliveryConfig(ReportDeliveryConfig{
+		Targets:     []ReportDeliveryTarget{target},
+		ReportTypes: []rgsv1.ReportType{rgsv1.ReportType_REPORT_TYPE_CASHLESS_LIABILITY_SUMMARY},
+	})
+	ctx := context.Background()
+	opMeta := meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+
+	gen, _ := reportingSvc.GenerateReport(ctx, &rgsv1.GenerateReportRequest{
+		Meta:       opMeta,
+		ReportType: rgsv1.ReportType_REPORT_TYPE_CASHLESS_LIABILITY_SUMMARY,
+		Interval:   rgsv1.ReportInterval_REPORT_INTERVAL_DTD,
+		Format:     rgsv1.ReportFormat_REPORT_FORMAT_CSV,
+	})
+	skipped, _ := reportingSvc.GenerateReport(ctx, &rgsv1.GenerateReportRequest{
+		Meta:       opMeta,
+		ReportType: rgsv1.ReportType_REPORT_TYPE_SIGNIFICANT_EVENTS_ALTERATIONS,
+		Interval:   rgsv1.ReportInterval_REPORT_INTERVAL_DTD,
+		Format:     rgsv1.ReportFormat_REPORT_FORMAT_CSV,
+	})
+	runID := gen.ReportRun.GetReportRunId()
+
+	job := reportingSvc.DeliveryJob(10)
+	summary, err := job(ctx, "")
+	if err != nil || summary != "report deliveries attempted=1 delivered=0 failed=1" {
+		t.Fatalf("first run: summary=%q err=%v", summary, err)
+	}
+	got, _ := reportingSvc.GetReportRun(ctx, &rgsv1.GetReportRunRequest{Meta: opMeta, ReportRunId: runID})
+	if d := got.ReportRun.GetDeliveries(); len(d) != 1 || d[0].Delivered || d[0].Attempts != 1 || d[0].LastError != "target unavailable" {
+		t.Fatalf("unexpected deliveries after failure: %+v", d)
+	}
+
+	if summary, err := job(ctx, ""); err != nil || summary != "report deliveries attempted=1 delivered=1 failed=0" {
+		t.Fatalf("second run: summary=%q err=%v", summary, err)
+	}
+	if summary, err := job(ctx, ""); err != nil || summary != "" {
+		t.Fatalf("expected nothing left to deliver, summary=%q err=%v", summary, err)
+	}
+	got, _ = reportingSvc.GetReportRun(ctx, &rgsv1.GetReportRunRequest{Meta: opMeta, ReportRunId: runID})
+	if d := got.ReportRun.GetDeliveries(); len(d) != 1 || !d[0].Delivered || d[0].Attempts != 2 || d[0].DeliveredAt == "" || d[0].LastError != "" {
+		t.Fatalf("unexpected deliveries after success: %+v", d)
+	}
+	if len(target.delivered) != 1 || target.delivered[0] != runID {
+		t.Fatalf("unexpected target deliveries: %v", target.delivered)
+	}
+	other, _ := reportingSvc.GetReportRun(ctx, &rgsv1.GetReportRunRequest{Meta: opMeta, ReportRunId: skipped.ReportRun.GetReportRunId()})
+	if len(other.ReportRun.GetDeliveries()) != 0 {
+		t.Fatalf("expected unlisted report type not to be delivered: %+v", other.ReportRun.GetDeliveries())
+	}
+
+	var results []string
+	for _, ev := range auditEvents(reportingSvc.AuditStore) {
+		if ev.Action == "deliver_report" && ev.ObjectID == runID {
+			results = append(results, string(ev.Result))
+		}
+	}
+	if strings.Join(results, ",") != "error,success" {
+		t.Fatalf("unexpected delivery audit results: %v", results)
+	}
+}
+
+func TestReportDeliveryJobStopsAtMaxAttempts(t *testing.T) {
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 12, 15, 0, 0, 0, time.UTC)}
+	reportingSvc := NewReportingService(clk, NewLedgerService(clk), NewEventsService(clk))
+	reportingSvc.SetReportDeliveryConfig(ReportDeliveryConfig{
+		Targets:     []ReportDeliveryTarget{&recordingReportTarget{name: "test:down", failures: 10}},
+		MaxAttempts: 2,
+	})
+	ctx := context.Background()
+	opMeta := meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+	gen, _ := reportingSvc.GenerateReport(ctx, &rgsv1.GenerateReportRequest{
+		Meta:       opMeta,
+		ReportType: rgsv1.ReportType_REPORT_TYPE_CASHLESS_LIABILITY_SUMMARY,
+		Interval:   rgsv1.ReportInterval_REPORT_INTERVAL_DTD,
+		Format:     rgsv1.ReportFormat_REPORT_FORMAT_JSON,
+	})
+
+	job := reportingSvc.DeliveryJob(10)
+	for i := 0; i < 3; i++ {
+		if _, err := job(ctx, ""); err != nil {
+			t.Fatalf("job run %d: %v", i, err)
+		}
+	}
+	got, _ := reportingSvc.GetReportRun(ctx, &rgsv1.GetReportRunRequest{Meta: opMeta, ReportRunId: gen.ReportRun.GetReportRunId()})
+	if d := got.ReportRun.GetDeliveries(); len(d) != 1 || d[0].Delivered || d[0].Attempts != 2 {
+		t.Fatalf("expected delivery to stop after two attempts: %+v", d)
+	}
+}
+
+func TestEmailReportDeliveryTargetAttachesContent(t *testing.T) {
+	var sent []byte
+	target := EmailReportDeliveryTarget{
+		Addr: "smtp.example.test:25",
+		From: "rgs@example.test",
+		To:   []string{"finance@example.test", "compliance@example.test"},
+		sendMail: func(_ string, _ smtp.Auth, _ string, to []string, msg []byte) error {
+			if len(to) != 2 {
+				t.Fatalf("unexpected recipients: %v", to)
+			}
+			sent = msg
+			return nil
+		},
+	}
+	run := &rgsv1.ReportRun{
+		ReportRunId: "report-7",
+		ReportTitle: "Cashless Liability Summary",
+		Interval:    rgsv1.ReportInterval_REPORT_INTERVAL_DTD,
+		Format:      rgsv1.ReportFormat_REPORT_FORMAT_CSV,
+		ContentType: "text/csv",
+		Content:     []byte("account_id,currency\nacct-1,USD\n"),
+	}
+	if err := target.DeliverReport(context.Background(), run); err != nil {
+		t.Fatalf("deliver: %v", err)
+	}
+	msg := string(sent)
+	for _, want := range []string{
+		"To: finance@example.test, compliance@example.test\r\n",
+		"Content-Type: text/csv\r\n",
+		`filename="report-7.csv"`,
+		base64.StdEncoding.EncodeToString(run.Content),
+	} {
+		if !strings.Contains(msg, want) {
+			t.Fatalf("message missing %q:\n%s", want, msg)
+		}
+	}
+	if target.Name() != "email:finance@example.test,compliance@example.test" {
+		t.Fatalf("unexpected name %q", target.Name())
+	}
+}
+
+func TestSFTPReportDeliveryTargetUploads(t *testing.T) {
+	_, hostPriv, _ := ed25519.GenerateKey(rand.Reader)
+	hostSigner, _ := ssh.NewSignerFromKey(hostPriv)
+	files := &fakeSFTPFiles{data: map[string][]byte{"/reports/report-9.json": []byte("stale")}}
+	addr := startFakeSFTPServer(t, hostSigner, "reports", "secret", files)
+
+	hostKey := string(ssh.MarshalAuthorizedKey(hostSigner.PublicKey()))
+	target, err := NewSFTPReportDeliveryTarget(addr, "/reports", "reports", "secret", nil, hostKey)
+	if err != nil {
+		t.Fatalf("new target: %v", err)
+	}
+	content := []byte(strings.Repeat("x", sftpChunkSize+10))
+	run := &rgsv1.ReportRun{ReportRunId: "report-9", Format: rgsv1.ReportFormat_REPORT_FORMAT_JSON, Content: content}
+	if err := target.DeliverReport(context.Background(), run); err != nil {
+		t.Fatalf("deliver: %v", err)
+	}
+	if got := files.get("/reports/report-9.json"); string(got) != string(content) {
+		t.Fatalf("unexpected uploaded content: %d bytes", len(got))
+	}
+	if files.get("/reports/report-9.json.tmp") != nil {
+		t.Fatalf("temporary file left behind")
+	}
+
+	_, otherPriv, _ := ed25519.GenerateKey(rand.Reader)
+	otherSigner, _ := ssh.NewSignerFromKey(otherPriv)
+	wrongHost, _ := NewSFTPReportDeliveryTarget(addr, "/reports", "reports", "secret", nil, string(ssh.MarshalAuthorizedKey(otherSigner.PublicKey())))
+	if err := wrongHost.DeliverReport(context.Background(), run); err == nil {
+		t.Fatalf("expected an unrecognized host key to be refused")
+	}
+	if _, err := NewSFTPReportDeliveryTarget(addr, "/reports", "reports", "secret", nil, ""); err == nil {
+		t.Fatalf("expected a host key to be required")
+	}
+}
+
+type fakeSFTPFiles struct {
+	mu   sync.Mutex
+	data map[string][]byte
+}
+
+func (f *fakeSFTPFiles) get(name string) []byte {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.data[name]
+}
+
+// startFakeSFTPServer accepts password logins on a loopback SSH server and
+// serves the sftp subsystem from files.
+func startFakeSFTPServer(t *testing.T, hostKey ssh.Signer, user, password string, files *fakeSFTPFiles) string {
+	t.Helper()
+	cfg := &ssh.ServerConfig{
+		PasswordCallback: func(c ssh.ConnMetadata, pass []byte) (*ssh.Permissions, error) {
+			if c.User() == user && string(pass) == password {
+				return nil, nil
+			}
+			return nil, errors.New("denied")
+		},
+	}
+	cfg.AddHostKey(hostKey)
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				_, chans, reqs, err := ssh.NewServerConn(conn, cfg)
+				if err != nil {
+					conn.Close()
+					return
+				}
+				go ssh.DiscardRequests(reqs)
+				for nc := range chans {
+					ch, chReqs, err := nc.Accept()
+					if err != nil {
+						continue
+					}
+					go func() {
+						for req := range chReqs {
+							ok := req.Type == "subsystem" && len(req.Payload) > 4 && string(req.Payload[4:]) == "sftp"
+							_ = req.Reply(ok, nil)
+							if ok {
+								go func() {
+									serveFakeSFTP(ch, files)
+									ch.Close()
+								}()
+							}
+						}
+					}()
+				}
+			}()
+		}
+	}()
+	return ln.Addr().String()
+}
+
+// serveFakeSFTP answers the requests sftpPutFile sends.
+func serveFakeSFTP(rw io.ReadWriter, files *fakeSFTPFiles) {
+	c := &sftpConn{r: rw, w: rw}
+	handles := map[string]string{}
+	readString := func(b []byte) (string, []byte) {
+		n := binary.BigEndian.Uint32(b)
+		return string(b[4 : 4+n]), b[4+n:]
+	}
+	status := func(id uint32, code uint32) {
+		body := binary.BigEndian.AppendUint32(binary.BigEndian.AppendUint32(nil, id), code)
+		body = sftpString(sftpString(body, []byte("status")), nil)
+		_ = c.send(sftpStatus, body)
+	}
+	for {
+		typ, body, err := c.recv()
+		if err != nil {
+			return
+		}
+		if typ == sftpInit {
+			_ = c.send(sftpVersion, binary.BigEndian.AppendUint32(nil, 3))
+			continue
+		}
+		id := binary.BigEndian.Uint32(body)
+		body = body[4:]
+		files.mu.Lock()
+		switch typ {
+		case sftpOpen:
+			name, _ := readString(body)
+			files.data[name] = []byte{}
+			handle := "h-" + name
+			handles[handle] = name
+			_ = c.send(sftpHandle, sftpString(binary.BigEndian.AppendUint32(nil, id), []byte(handle)))
+		case sftpWrite:
+			handle, rest := readString(body)
+			off := binary.BigEndian.Uint64(rest)
+			data, _ := readString(rest[8:])
+			name := handles[handle]
+			buf := files.data[name]
+			if int(off) > len(buf) {
+				status(id, 4)
+				break
+			}
+			files.data[name] = append(buf[:off], data...)
+			status(id, 0)
+		case sftpClose:
+			handle, _ := readString(body)
+			delete(handles, handle)
+			status(id, 0)
+		case sftpRemove:
+			name, _ := readString(body)
+			if _, ok := files.data[name]; !ok {
+				status(id, 2)
+				break
+			}
+			delete(files.data, name)
+			status(id, 0)
+		case sftpRename:
+			from, rest := readString(body)
+			to, _ := readString(rest)
+			if _, exists := files.data[to]; exists {
+				status(id, 4)
+				break
+			}
+			files.data[to] = files.data[from]
+			delete(files.data, from)
+			status(id, 0)
+		default:
+			status(id, 8)
+		}
+		files.mu.Unlock()
+	}
+}
@@ -0,0 +1,233 @@
+package server
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+// EFT activity entry types. The first four are ledger transactions; denials
+// and lockouts come from the ledger's audit trail.
+const (
+	eftEntryDeposit           = "deposit"
+	eftEntryWithdrawal        = "withdrawal"
+	eftEntryTransferToDevice  = "transfer_to_device"
+	eftEntryTransferToAccount = "transfer_to_account"
+	eftEntryDenial            = "denial"
+	eftEntryLockout           = "lockout"
+)
+
+// eftAuditActions are the ledger audit actions whose denials count as EFT
+// denials.
+var eftAuditActions = []string{"deposit", "withdraw", "transfer_to_device", "transfer_to_account"}
+
+// eftActivityEntry is one drill-down line of the EFT activity report.
+type eftActivityEntry struct {
+	accountID  string
+	entryType  string
+	reference  string
+	amount     int64
+	currency   string
+	occurredAt time.Time
+	detail     string
+}
+
+func eftEntryTypeForTransaction(t rgsv1.LedgerTransactionType) (string, bool) {
+	switch t {
+	case rgsv1.LedgerTransactionType_LEDGER_TRANSACTION_TYPE_DEPOSIT:
+		return eftEntryDeposit, true
+	case rgsv1.LedgerTransactionType_LEDGER_TRANSACTION_TYPE_WITHDRAWAL:
+		return eftEntryWithdrawal, true
+	case rgsv1.LedgerTransactionType_LEDGER_TRANSACTION_TYPE_TRANSFER_TO_DEVICE:
+		return eftEntryTransferToDevice, true
+	case rgsv1.LedgerTransactionType_LEDGER_TRANSACTION_TYPE_TRANSFER_TO_ACCOUNT:
+		return eftEntryTransferToAccount, true
+	}
+	return "", false
+}
+
+// eftAuditEntry maps a ledger audit event to a denial or lockout entry.
+func eftAuditEntry(e audit.Event) (eftActivityEntry, bool) {
+	if e.ObjectType != "ledger_account" || e.ObjectID == "" {
+		return eftActivityEntry{}, false
+	}
+	entry := eftActivityEntry{accountID: e.ObjectID, reference: e.AuditID, occurredAt: e.OccurredAt.UTC()}
+	switch {
+	case e.Action == "eft_lockout":
+		entry.entryType = eftEntryLockout
+		entry.detail = e.Reason
+	case e.Result == audit.ResultDenied && slices.Contains(eftAuditActions, e.Action):
+		entry.entryType = eftEntryDenial
+		entry.detail = e.Action + ": " + e.Reason
+	default:
+		return eftActivityEntry{}, false
+	}
+	return entry, true
+}
+
+// eftActivityEntries returns the EFT transactions, denials, and lockouts in
+// the window, ordered by time.
+func (s *ReportingService) eftActivityEntries(ctx context.Context, w reportWindow) ([]eftActivityEntry, error) {
+	if s.db != nil {
+		return s.fetchEFTActivityEntries(ctx, w)
+	}
+	out := make([]eftActivityEntry, 0)
+	if s.Ledger == nil {
+		return out, nil
+	}
+	s.Ledger.mu.Lock()
+	for _, txs := range s.Ledger.transactionsByAcct {
+		for _, tx := range txs {
+			if tx == nil {
+				continue
+			}
+			entryType, ok := eftEntryTypeForTransaction(tx.TransactionType)
+			ts := parseTS(tx.OccurredAt)
+			if !ok || !w.contains(ts) {
+				continue
+			}
+			out = append(out, eftActivityEntry{
+				accountID:  tx.AccountId,
+				entryType:  entryType,
+				reference:  tx.TransactionId,
+				amount:     tx.Amount.GetAmountMinor(),
+				currency:   tx.Amount.GetCurrency(),
+				occurredAt: ts,
+				detail:     tx.AuthorizationId,
+			})
+		}
+	}
+	s.Ledger.mu.Unlock()
+	for _, e := range s.Ledger.AuditEvents() {
+		entry, ok := eftAuditEntry(e)
+		if ok && w.contains(entry.occurredAt) {
+			out = append(out, entry)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if !out[i].occurredAt.Equal(out[j].occurredAt) {
+			return out[i].occurredAt.Before(out[j].occurredAt)
+		}
+		return out[i].reference < out[j].reference
+	})
+	return out, nil
+}
+
+// eftActivityDay totals one account's EFT activity on one gaming day.
+type eftActivityDay struct {
+	accountID        string
+	gamingDay        string
+	currency         string
+	deposits         int64
+	depositAmount    int64
+	withdrawals      int64
+	withdrawalAmount int64
+	toDevice         int64
+	toDeviceAmount   int64
+	toAccount        int64
+	toAccountAmount  int64
+	denials          int64
+	lockouts         int64
+	entries          []map[string]any
+}
+
+func (d *eftActivityDay) add(e eftActivityEntry) {
+	if d.currency == "" {
+		d.currency = e.currency
+	}
+	switch e.entryType {
+	case eftEntryDeposit:
+		d.deposits++
+		d.depositAmount += e.amount
+	case eftEntryWithdrawal:
+		d.withdrawals++
+		d.withdrawalAmount += e.amount
+	case eftEntryTransferToDevice:
+		d.toDevice++
+		d.toDeviceAmount += e.amount
+	case eftEntryTransferToAccount:
+		d.toAccount++
+		d.toAccountAmount += e.amount
+	case eftEntryDenial:
+		d.denials++
+	case eftEntryLockout:
+		d.lockouts++
+	}
+	d.entries = append(d.entries, map[string]any{
+		"entry_type":   e.entryType,
+		"reference":    e.reference,
+		"amount_minor": e.amount,
+		"currency":     e.currency,
+		"occurred_at":  e.occurredAt.Format(time.RFC3339Nano),
+		"detail":       e.detail,
+	})
+}
+
+// buildEFTActivityPayload totals deposits, withdrawals, device transfers,
+// denials, and lockouts per account and gaming day. Each row carries the
+// entries behind its totals.
+func (s *ReportingService) buildEFTActivityPayload(ctx context.Context, w reportWindow, operatorID string) (map[string]any, bool) {
+	entries, loadErr := s.eftActivityEntries(ctx, w)
+	calendar := gamingCalendarFor(operatorID)
+	byKey := make(map[string]*eftActivityDay)
+	days := make([]*eftActivityDay, 0)
+	for _, e := range entries {
+		day := calendar.GamingDay(e.occurredAt)
+		k := e.accountID + "|" + day
+		d := byKey[k]
+		if d == nil {
+			d = &eftActivityDay{accountID: e.accountID, gamingDay: day}
+			byKey[k] = d
+			days = append(days, d)
+		}
+		d.add(e)
+	}
+	sort.SliceStable(days, func(i, j int) bool {
+		if days[i].accountID != days[j].accountID {
+			return days[i].accountID < days[j].accountID
+		}
+		return days[i].gamingDay < days[j].gamingDay
+	})
+
+	rows := make([]map[string]any, 0, len(days))
+	for _, d := range days {
+		rows = append(rows, map[string]any{
+			"account_id":                 d.accountID,
+			"gaming_day":                 d.gamingDay,
+			"currency":                   d.currency,
+			"deposits":                   d.deposits,
+			"deposit_amount":             d.depositAmount,
+			"withdrawals":                d.withdrawals,
+			"withdrawal_amount":          d.withdrawalAmount,
+			"transfers_to_device":        d.toDevice,
+			"transfer_to_device_amount":  d.toDeviceAmount,
+			"transfers_to_account":       d.toAccount,
+			"transfer_to_account_amount": d.toAccountAmount,
+			"denials":                    d.denials,
+			"lockouts":                   d.lockouts,
+			"entries":                    d.entries,
+		})
+	}
+
+	noActivity := len(rows) == 0
+	payload := map[string]any{
+		"operator_id":       operatorID,
+		"report_title":      reportTitle(rgsv1.ReportType_REPORT_TYPE_EFT_ACTIVITY),
+		"selected_interval": w.interval.String(),
+		"generated_at":      s.now().Format(time.RFC3339Nano),
+		"no_activity":       noActivity,
+		"row_count":         len(rows),
+		"rows":              rows,
+	}
+	if noActivity {
+		payload["note"] = "No Activity"
+	}
+	if loadErr != nil {
+		payload["note"] = "EFT data unavailable"
+	}
+	return payload, noActivity
+}
@@ -0,0 +1,154 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+// seedEFTActivity funds acct-1 and moves money through every EFT
+// transaction type, then fails acct-2 into a lockout and retries a deposit
+// against the lock.
+func seedEFTActivity(t *testing.T, ledger *LedgerService) {
+	t.Helper()
+	ctx := context.Background()
+	ledger.SetEFTFraudPolicy(2, 15*time.Minute)
+	player := func(idem string) *rgsv1.RequestMeta {
+		return meta("acct-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, idem)
+	}
+	usd := func(amount int64) *rgsv1.Money { return &rgsv1.Money{AmountMinor: amount, Currency: "USD"} }
+
+	if resp, _ := ledger.Deposit(ctx, &rgsv1.DepositRequest{Meta: player("eft-dep-1"), AccountId: "acct-1", Amount: usd(1000)}); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("deposit failed: %+v", resp.Meta)
+	}
+	if resp, _ := ledger.TransferToDevice(ctx, &rgsv1.TransferToDeviceRequest{Meta: player("eft-dev-1"), AccountId: "acct-1", DeviceId: "device-1", RequestedAmount: usd(300)}); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("transfer to device failed: %+v", resp.Meta)
+	}
+	if resp, _ := ledger.TransferToAccount(ctx, &rgsv1.TransferToAccountRequest{Meta: player("eft-acct-1"), AccountId: "acct-1", Amount: usd(100)}); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("transfer to account failed: %+v", resp.Meta)
+	}
+	if resp, _ := ledger.Withdraw(ctx, &rgsv1.WithdrawRequest{Meta: player("eft-wd-1"), AccountId: "acct-1", Amount: usd(200)}); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("withdraw failed: %+v", resp.Meta)
+	}
+
+	lockEFTAccount(t, ledger, "acct-2", 2)
+	resp, _ := ledger.Deposit(ctx, &rgsv1.DepositRequest{Meta: meta("acct-2", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "eft-dep-2"), AccountId: "acct-2", Amount: usd(500)})
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected deposit to locked account to be denied: %+v", resp.Meta)
+	}
+}
+
+type eftActivityRow struct {
+	AccountID          string `json:"account_id"`
+	GamingDay          string `json:"gaming_day"`
+	Currency           string `json:"currency"`
+	Deposits           int64  `json:"deposits"`
+	DepositAmount      int64  `json:"deposit_amount"`
+	Withdrawals        int64  `json:"withdrawals"`
+	WithdrawalAmount   int64  `json:"withdrawal_amount"`
+	TransfersToDevice  int64  `json:"transfers_to_device"`
+	ToDeviceAmount     int64  `json:"transfer_to_device_amount"`
+	TransfersToAccount int64  `json:"transfers_to_account"`
+	ToAccountAmount    int64  `json:"transfer_to_account_amount"`
+	Denials            int64  `json:"denials"`
+	Lockouts           int64  `json:"lockouts"`
+	Entries            []struct {
+		EntryType string `json:"entry_type"`
+		Reference string `json:"reference"`
+		Amount    int64  `json:"amount_minor"`
+		Detail    string `json:"detail"`
+	} `json:"entries"`
+}
+
+func TestEFTActivityReportTotalsPerAccountAndDay(t *testing.T) {
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 18, 12, 0, 0, 0, time.UTC)}
+	ledger := NewLedgerService(clk)
+	seedEFTActivity(t, ledger)
+	svc := NewReportingService(clk, ledger, NewEventsService(clk))
+
+	resp, err := svc.GenerateReport(context.Background(), &rgsv1.GenerateReportRequest{
+		Meta:       meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		ReportType: rgsv1.ReportType_REPORT_TYPE_EFT_ACTIVITY,
+		Interval:   rgsv1.ReportInterval_REPORT_INTERVAL_DTD,
+		Format:     rgsv1.ReportFormat_REPORT_FORMAT_JSON,
+		OperatorId: "casino-1",
+	})
+	if err != nil || resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || resp.ReportRun.NoActivity {
+		t.Fatalf("generate eft activity report: resp=%+v err=%v", resp, err)
+	}
+	var payload struct {
+		Rows []eftActivityRow `json:"rows"`
+	}
+	if err := json.Unmarshal(resp.ReportRun.Content, &payload); err != nil {
+		t.Fatalf("decode report: %v", err)
+	}
+	if len(payload.Rows) != 2 {
+		t.Fatalf("expected one row per account, got=%+v", payload.Rows)
+	}
+	a, b := payload.Rows[0], payload.Rows[1]
+	if a.AccountID != "acct-1" || a.GamingDay != "2026-02-18" || a.Currency != "USD" ||
+		a.Deposits != 1 || a.DepositAmount != 1000 || a.Withdrawals != 1 || a.WithdrawalAmount != 200 ||
+		a.TransfersToDevice != 1 || a.ToDeviceAmount != 300 || a.TransfersToAccount != 1 || a.ToAccountAmount != 100 ||
+		a.Denials != 0 || a.Lockouts != 0 || len(a.Entries) != 4 {
+		t.Fatalf("unexpected acct-1 row: %+v", a)
+	}
+	if b.AccountID != "acct-2" || b.Deposits != 0 || b.Denials != 3 || b.Lockouts != 1 || len(b.Entries) != 4 {
+		t.Fatalf("unexpected acct-2 row: %+v", b)
+	}
+	var lockout, lockedDenial bool
+	for _, e := range b.Entries {
+		lockout = lockout || (e.EntryType == "lockout" && e.Reference != "")
+		lockedDenial = lockedDenial || (e.EntryType == "denial" && e.Detail == "deposit: eft account locked")
+	}
+	if !lockout || !lockedDenial {
+		t.Fatalf("expected lockout and locked-account denial entries: %+v", b.Entries)
+	}
+}
+
+func TestEFTActivityReportCSVIncludesDrillDown(t *testing.T) {
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 18, 12, 0, 0, 0, time.UTC)}
+	ledger := NewLedgerService(clk)
+	seedEFTActivity(t, ledger)
+	svc := NewReportingService(clk, ledger, NewEventsService(clk))
+
+	resp, _ := svc.GenerateReport(context.Background(), &rgsv1.GenerateReportRequest{
+		Meta:       meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		ReportType: rgsv1.ReportType_REPORT_TYPE_EFT_ACTIVITY,
+		Interval:   rgsv1.ReportInterval_REPORT_INTERVAL_LTD,
+		Format:     rgsv1.ReportFormat_REPORT_FORMAT_CSV,
+		OperatorId: "casino-1",
+	})
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("generate eft activity csv: %+v", resp.Meta)
+	}
+	csv := string(resp.ReportRun.Content)
+	for _, want := range []string{
+		"acct-1,2026-02-18,USD,1,1000,1,200,1,300,1,100,0,0",
+		"account_id,gaming_day,entry_type,reference,amount_minor,currency,occurred_at,detail",
+		"acct-2,2026-02-18,lockout,",
+	} {
+		if !strings.Contains(csv, want) {
+			t.Fatalf("expected %q in csv:\n%s", want, csv)
+		}
+	}
+}
+
+func TestEFTActivityReportNoActivity(t *testing.T) {
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 18, 12, 0, 0, 0, time.UTC)}
+	svc := NewReportingService(clk, NewLedgerService(clk), NewEventsService(clk))
+
+	resp, _ := svc.GenerateReport(context.Background(), &rgsv1.GenerateReportRequest{
+		Meta:       meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		ReportType: rgsv1.ReportType_REPORT_TYPE_EFT_ACTIVITY,
+		Interval:   rgsv1.ReportInterval_REPORT_INTERVAL_DTD,
+		Format:     rgsv1.ReportFormat_REPORT_FORMAT_PDF,
+		OperatorId: "casino-1",
+	})
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || !resp.ReportRun.NoActivity || resp.ReportRun.ContentType != "application/pdf" {
+		t.Fatalf("expected empty pdf report: %+v", resp)
+	}
+}
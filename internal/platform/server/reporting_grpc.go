@@ -3,9 +3,11 @@ package server
 import (
 	"bytes"
 	"context"
+	"crypto/ed25519"
 	"database/sql"
 	"encoding/csv"
 	"encoding/json"
+	"slices"
 	"sort"
 	"strconv"
 	"sync"
@@ -14,6 +16,7 @@ import (
 	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
 	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
 	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/clock"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/money"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -21,17 +24,43 @@ type ReportingService struct {
 	rgsv1.UnimplementedReportingServiceServer
 
 	Clock      clock.Clock
-	AuditStore *audit.InMemoryStore
+	AuditStore audit.Store
 
 	Ledger *LedgerService
 	Events *EventsService
+	Audit  *AuditService
+	// Wagering supplies per-game wager totals for the RTP summary.
+	Wagering *WageringService
+	// Promotions supplies awards and bonus redemptions for the promotional
+	// liability report.
+	Promotions *PromotionsService
+	// Config, when set, lets daily packs record the configuration that was
+	// in effect at the close of the gaming day.
+	Config *ConfigService
+	// Registry resolves the group_id a significant events report is
+	// limited to.
+	Registry *RegistryService
 
 	mu                   sync.Mutex
 	runs                 map[string]*rgsv1.ReportRun
+	reportQueue          chan reportJob
+	reportWorkers        sync.WaitGroup
 	runOrder             []string
 	nextRunID            int64
+	packs                map[string]*rgsv1.DailyPack
+	packMu               sync.Mutex
+	packCfg              DailyPackConfig
+	runSignerKID         string
+	runSigningKey        ed25519.PrivateKey
+	runVerifyKeys        map[string]ed25519.PublicKey
+	operatorScopes       map[string][]string
+	retention            time.Duration
+	deliveryCfg          ReportDeliveryConfig
+	deliveries           map[string]map[string]*rgsv1.ReportRunDelivery
+	deliveryOrder        []reportDeliveryKey
 	nextAuditID          int64
 	db                   *sql.DB
+	readDB               *sql.DB
 	disableInMemoryCache bool
 }
 
@@ -42,10 +71,13 @@ func NewReportingService(clk clock.Clock, ledger *LedgerService, events *EventsS
 	}
 	return &ReportingService{
 		Clock:      clk,
-		AuditStore: audit.NewInMemoryStore(),
+		AuditStore: NewAuditStore(handle),
 		Ledger:     ledger,
 		Events:     events,
 		runs:       make(map[string]*rgsv1.ReportRun),
+		packs:      make(map[string]*rgsv1.DailyPack),
+		deliveries: make(map[string]map[string]*rgsv1.ReportRunDelivery),
+		packCfg:    defaultDailyPackConfig(),
 		db:         handle,
 	}
 }
@@ -59,6 +91,25 @@ func (s *ReportingService) SetDisableInMemoryCache(disable bool) {
 	s.disableInMemoryCache = disable
 }
 
+// SetReadDB sends report data queries to a read-only database, typically a
+// replica of the primary, so large reports do not contend with ledger
+// traffic. Report runs, daily packs, and deliveries are still written and
+// read on the primary. Call before serving.
+func (s *ReportingService) SetReadDB(db *sql.DB) {
+	if s == nil {
+		return
+	}
+	s.readDB = db
+}
+
+// reportDB is the database report data is read from.
+func (s *ReportingService) reportDB() *sql.DB {
+	if s.readDB != nil {
+		return s.readDB
+	}
+	return s.db
+}
+
 func (s *ReportingService) useInMemoryCache() bool {
 	if s == nil {
 		return false
@@ -108,38 +159,15 @@ func (s *ReportingService) nextAuditIDLocked() string {
 }
 
 func (s *ReportingService) appendAudit(meta *rgsv1.RequestMeta, objectID, action string, before, after []byte, result audit.Result, reason string) error {
-	if s.AuditStore == nil {
-		return audit.ErrCorruptChain
-	}
-	actorID := "system"
-	actorType := "service"
-	if meta != nil && meta.Actor != nil {
-		actorID = meta.Actor.ActorId
-		actorType = meta.Actor.ActorType.String()
-	}
-	now := s.now()
-	ev := audit.Event{
-		AuditID:      s.nextAuditIDLocked(),
-		OccurredAt:   now,
-		RecordedAt:   now,
-		ActorID:      actorID,
-		ActorType:    actorType,
-		ObjectType:   "report_run",
-		ObjectID:     objectID,
-		Action:       action,
-		Before:       before,
-		After:        after,
-		Result:       result,
-		Reason:       reason,
-		PartitionDay: now.Format("2006-01-02"),
-	}
-	if s.db != nil {
-		if err := appendAuditEventToDB(context.Background(), s.db, ev); err != nil {
-			return err
-		}
-	}
-	_, err := s.AuditStore.Append(ev)
-	return err
+	return s.appendAuditObject(meta, "report_run", objectID, action, before, after, result, reason)
+}
+
+func (s *ReportingService) appendAuditObject(meta *rgsv1.RequestMeta, objectType, objectID, action string, before, after []byte, result audit.Result, reason string) error {
+	s.mu.Lock()
+	auditID := s.nextAuditIDLocked()
+	s.mu.Unlock()
+	ev := newAuditEvent(meta, auditID, s.now(), objectType, objectID, action, before, after, result, reason)
+	return appendAuditTo(context.Background(), s.AuditStore, ev)
 }
 
 func cloneRun(in *rgsv1.ReportRun) *rgsv1.ReportRun {
@@ -150,31 +178,46 @@ func cloneRun(in *rgsv1.ReportRun) *rgsv1.ReportRun {
 	return cp
 }
 
-func intervalStart(now time.Time, interval rgsv1.ReportInterval) time.Time {
-	now = now.UTC()
-	switch interval {
-	case rgsv1.ReportInterval_REPORT_INTERVAL_DTD:
-		return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
-	case rgsv1.ReportInterval_REPORT_INTERVAL_MTD:
-		return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
-	case rgsv1.ReportInterval_REPORT_INTERVAL_YTD:
-		return time.Date(now.Year(), 1, 1, 0, 0, 0, 0, time.UTC)
-	case rgsv1.ReportInterval_REPORT_INTERVAL_LTD:
-		return time.Time{}
-	default:
-		return time.Time{}
+// reportWindow bounds the activity included in a report. The end is
+// inclusive; a zero start means unbounded.
+type reportWindow struct {
+	interval rgsv1.ReportInterval
+	start    time.Time
+	end      time.Time
+	// groupID and equipment limit equipment-level reports to a registry
+	// zone or bank, resolved when the report was requested.
+	groupID   string
+	equipment equipmentFilter
+}
+
+// intervalWindow bounds an interval-to-date report on the operator's gaming
+// calendar: DTD starts at the current gaming day's open, MTD and YTD at the
+// open of the first gaming day of the month or year in the operator's time
+// zone, and LTD is unbounded. Every interval ends at now.
+func intervalWindow(now time.Time, interval rgsv1.ReportInterval, operatorID string) reportWindow {
+	return reportWindow{interval: interval, start: gamingCalendarFor(operatorID).IntervalStart(now, interval), end: now.UTC()}
+}
+
+// period returns the window's bounds as reported on a run: RFC 3339 UTC
+// timestamps, with an empty start for an unbounded window.
+func (w reportWindow) period() (string, string) {
+	start := ""
+	if !w.start.IsZero() {
+		start = w.start.UTC().Format(time.RFC3339Nano)
 	}
+	return start, w.end.UTC().Format(time.RFC3339Nano)
 }
 
-func inInterval(ts time.Time, interval rgsv1.ReportInterval, now time.Time) bool {
-	if interval == rgsv1.ReportInterval_REPORT_INTERVAL_LTD {
-		return true
+// contains reports whether ts falls in the window. LTD also keeps records
+// with no timestamp, but like every interval stops at the window end.
+func (w reportWindow) contains(ts time.Time) bool {
+	if w.interval == rgsv1.ReportInterval_REPORT_INTERVAL_LTD {
+		return ts.IsZero() || !ts.After(w.end)
 	}
-	start := intervalStart(now, interval)
 	if ts.IsZero() {
 		return false
 	}
-	return !ts.Before(start) && !ts.After(now)
+	return !ts.Before(w.start) && !ts.After(w.end)
 }
 
 func parseTS(v string) time.Time {
@@ -196,16 +239,22 @@ func reportTitle(t rgsv1.ReportType) string {
 		return "Cashless Liability Summary"
 	case rgsv1.ReportType_REPORT_TYPE_ACCOUNT_TRANSACTION_STATEMENT:
 		return "Account Transaction Statement"
+	case rgsv1.ReportType_REPORT_TYPE_RTP_SUMMARY:
+		return "Game RTP and Exposure Summary"
+	case rgsv1.ReportType_REPORT_TYPE_PROMO_LIABILITY:
+		return "Promotional Liability Summary"
+	case rgsv1.ReportType_REPORT_TYPE_EFT_ACTIVITY:
+		return "EFT Activity Summary"
 	default:
 		return "Unknown Report"
 	}
 }
 
-func (s *ReportingService) buildSignificantEventsPayload(interval rgsv1.ReportInterval, operatorID string) (map[string]any, bool) {
+func (s *ReportingService) buildSignificantEventsPayload(w reportWindow, operatorID string) (map[string]any, bool) {
 	now := s.now()
 	rows := make([]map[string]any, 0)
 	if s.db != nil {
-		dbRows, err := s.fetchSignificantEventsRows(now, interval)
+		dbRows, err := s.fetchSignificantEventsRows(w)
 		if err == nil {
 			rows = dbRows
 		}
@@ -222,7 +271,7 @@ func (s *ReportingService) buildSignificantEventsPayload(interval rgsv1.ReportIn
 			if ts.IsZero() {
 				ts = parseTS(e.RecordedAt)
 			}
-			if !inInterval(ts, interval, now) {
+			if !w.contains(ts) || !w.equipment.allows(e.EquipmentId) {
 				continue
 			}
 			rows = append(rows, map[string]any{
@@ -242,30 +291,114 @@ func (s *ReportingService) buildSignificantEventsPayload(interval rgsv1.ReportIn
 	payload := map[string]any{
 		"operator_id":       operatorID,
 		"report_title":      reportTitle(rgsv1.ReportType_REPORT_TYPE_SIGNIFICANT_EVENTS_ALTERATIONS),
-		"selected_interval": interval.String(),
+		"selected_interval": w.interval.String(),
 		"generated_at":      now.Format(time.RFC3339Nano),
 		"no_activity":       noActivity,
 		"row_count":         len(rows),
 		"rows":              rows,
 	}
+	if w.groupID != "" {
+		payload["group_id"] = w.groupID
+	}
+	if ramClears := s.ramClearRows(w); len(ramClears) > 0 {
+		pending := 0
+		for _, r := range ramClears {
+			if r["status"] == "pending" {
+				pending++
+			}
+		}
+		payload["ram_clears"] = ramClears
+		payload["ram_clears_pending"] = pending
+	}
 	if noActivity {
 		payload["note"] = "No Activity"
 	}
 	return payload, noActivity
 }
 
-func (s *ReportingService) buildCashlessLiabilityPayload(interval rgsv1.ReportInterval, operatorID string) (map[string]any, bool) {
+// ramClearRows lists the RAM clear incidents that occurred in w, with their
+// acknowledgment status.
+func (s *ReportingService) ramClearRows(w reportWindow) []map[string]any {
+	var incidents []*rgsv1.RamClearIncident
+	if s.db != nil {
+		if dbRows, err := s.fetchRAMClearIncidents(w); err == nil {
+			incidents = dbRows
+		}
+	} else if s.Events != nil && s.useInMemoryCache() {
+		s.Events.mu.Lock()
+		incidents, _ = s.Events.ramClearIncidentsLocked(context.Background(), "", false)
+		s.Events.mu.Unlock()
+	}
+	rows := make([]map[string]any, 0, len(incidents))
+	for _, inc := range incidents {
+		if !w.contains(parseTS(inc.OccurredAt)) || !w.equipment.allows(inc.EquipmentId) {
+			continue
+		}
+		rows = append(rows, ramClearReportRow(inc))
+	}
+	return rows
+}
+
+// liabilityTotals sums account balances per currency; balances in different
+// currencies are never added together.
+type liabilityTotals struct {
+	available money.Totals
+	pending   money.Totals
+}
+
+func newLiabilityTotals() *liabilityTotals {
+	return &liabilityTotals{available: money.Totals{}, pending: money.Totals{}}
+}
+
+// add folds one account into the totals and returns its report row.
+func (t *liabilityTotals) add(accountID, currency string, available, pending int64) (map[string]any, error) {
+	total, err := money.AddMinor(available, pending)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.available.Add(currency, available); err != nil {
+		return nil, err
+	}
+	if err := t.pending.Add(currency, pending); err != nil {
+		return nil, err
+	}
+	return map[string]any{
+		"account_id": accountID,
+		"currency":   currency,
+		"available":  available,
+		"pending":    pending,
+		"total":      total,
+	}, nil
+}
+
+func (t *liabilityTotals) byCurrency() []map[string]any {
+	currencies := make([]string, 0, len(t.available))
+	for c := range t.available {
+		currencies = append(currencies, c)
+	}
+	sort.Strings(currencies)
+	out := make([]map[string]any, 0, len(currencies))
+	for _, c := range currencies {
+		out = append(out, map[string]any{
+			"currency":  c,
+			"available": t.available[c],
+			"pending":   t.pending[c],
+		})
+	}
+	return out
+}
+
+func (s *ReportingService) buildCashlessLiabilityPayload(w reportWindow, operatorID string) (map[string]any, bool) {
 	now := s.now()
 	rows := make([]map[string]any, 0)
-	var totalAvailable int64
-	var totalPending int64
+	totals := newLiabilityTotals()
+	var totalsErr error
 
 	if s.db != nil {
-		dbRows, avail, pending, err := s.fetchCashlessLiabilityRows()
+		dbRows, dbTotals, err := s.fetchCashlessLiabilityRows()
 		if err == nil {
 			rows = dbRows
-			totalAvailable = avail
-			totalPending = pending
+			totals = dbTotals
 		}
 	}
 
@@ -281,15 +414,12 @@ func (s *ReportingService) buildCashlessLiabilityPayload(interval rgsv1.ReportIn
 			if acct == nil {
 				continue
 			}
-			rows = append(rows, map[string]any{
-				"account_id": id,
-				"currency":   acct.currency,
-				"available":  acct.available,
-				"pending":    acct.pending,
-				"total":      acct.available + acct.pending,
-			})
-			totalAvailable += acct.available
-			totalPending += acct.pending
+			row, err := totals.add(id, acct.currency, acct.available, acct.pending)
+			if err != nil {
+				totalsErr = err
+				break
+			}
+			rows = append(rows, row)
 		}
 		s.Ledger.mu.Unlock()
 	}
@@ -298,26 +428,42 @@ func (s *ReportingService) buildCashlessLiabilityPayload(interval rgsv1.ReportIn
 	payload := map[string]any{
 		"operator_id":       operatorID,
 		"report_title":      reportTitle(rgsv1.ReportType_REPORT_TYPE_CASHLESS_LIABILITY_SUMMARY),
-		"selected_interval": interval.String(),
+		"selected_interval": w.interval.String(),
 		"generated_at":      now.Format(time.RFC3339Nano),
 		"no_activity":       noActivity,
 		"row_count":         len(rows),
-		"total_available":   totalAvailable,
-		"total_pending":     totalPending,
+		"currency_totals":   totals.byCurrency(),
 		"rows":              rows,
 	}
+	// Single-currency properties keep the flat totals; a grand total across
+	// currencies would be meaningless.
+	switch len(totals.available) {
+	case 0:
+		payload["total_available"] = int64(0)
+		payload["total_pending"] = int64(0)
+	case 1:
+		for c := range totals.available {
+			payload["total_available"] = totals.available[c]
+			payload["total_pending"] = totals.pending[c]
+		}
+	default:
+		payload["note"] = "Multiple currencies; see currency_totals"
+	}
+	if totalsErr != nil {
+		payload["note"] = "Totals incomplete: " + totalsErr.Error()
+	}
 	if noActivity {
 		payload["note"] = "No Activity"
 	}
 	return payload, noActivity
 }
 
-func (s *ReportingService) buildAccountTransactionStatementPayload(interval rgsv1.ReportInterval, operatorID string) (map[string]any, bool) {
+func (s *ReportingService) buildAccountTransactionStatementPayload(w reportWindow, operatorID string) (map[string]any, bool) {
 	now := s.now()
 	rows := make([]map[string]any, 0)
 
 	if s.db != nil {
-		dbRows, err := s.fetchAccountTransactionStatementRows(now, interval)
+		dbRows, err := s.fetchAccountTransactionStatementRows(w)
 		if err == nil {
 			rows = dbRows
 		}
@@ -337,7 +483,7 @@ func (s *ReportingService) buildAccountTransactionStatementPayload(interval rgsv
 					continue
 				}
 				ts := parseTS(tx.OccurredAt)
-				if !inInterval(ts, interval, now) {
+				if !w.contains(ts) {
 					continue
 				}
 				rows = append(rows, map[string]any{
@@ -358,7 +504,7 @@ func (s *ReportingService) buildAccountTransactionStatementPayload(interval rgsv
 	payload := map[string]any{
 		"operator_id":       operatorID,
 		"report_title":      reportTitle(rgsv1.ReportType_REPORT_TYPE_ACCOUNT_TRANSACTION_STATEMENT),
-		"selected_interval": interval.String(),
+		"selected_interval": w.interval.String(),
 		"generated_at":      now.Format(time.RFC3339Nano),
 		"no_activity":       noActivity,
 		"row_count":         len(rows),
@@ -370,14 +516,58 @@ func (s *ReportingService) buildAccountTransactionStatementPayload(interval rgsv
 	return payload, noActivity
 }
 
+// buildRTPSummaryPayload compares actual with theoretical RTP per game and
+// currency for wagers placed in the window, alongside the stake still at
+// risk on pending wagers.
+func (s *ReportingService) buildRTPSummaryPayload(ctx context.Context, w reportWindow, operatorID string) (map[string]any, bool) {
+	rows := make([]map[string]any, 0)
+	var loadErr error
+	if s.Wagering != nil {
+		games, err := s.Wagering.gamePerformance(ctx, s.readDB, "", w.start, w.end)
+		loadErr = err
+		for _, g := range games {
+			rows = append(rows, map[string]any{
+				"game_id":             g.GameId,
+				"currency":            g.Currency,
+				"settled_count":       g.SettledCount,
+				"settled_stake":       g.SettledStake.GetAmountMinor(),
+				"payout":              g.Payout.GetAmountMinor(),
+				"actual_rtp_bps":      g.ActualRtpBps,
+				"theoretical_rtp_bps": g.TheoreticalRtpBps,
+				"expected_payout":     g.ExpectedPayout.GetAmountMinor(),
+				"open_count":          g.OpenCount,
+				"open_stake":          g.OpenStake.GetAmountMinor(),
+			})
+		}
+	}
+
+	noActivity := len(rows) == 0
+	payload := map[string]any{
+		"operator_id":       operatorID,
+		"report_title":      reportTitle(rgsv1.ReportType_REPORT_TYPE_RTP_SUMMARY),
+		"selected_interval": w.interval.String(),
+		"generated_at":      s.now().Format(time.RFC3339Nano),
+		"no_activity":       noActivity,
+		"row_count":         len(rows),
+		"rows":              rows,
+	}
+	if noActivity {
+		payload["note"] = "No Activity"
+	}
+	if loadErr != nil {
+		payload["note"] = "Wager data unavailable"
+	}
+	return payload, noActivity
+}
+
 func payloadToCSV(reportType rgsv1.ReportType, payload map[string]any) ([]byte, error) {
 	buf := &bytes.Buffer{}
 	w := csv.NewWriter(buf)
 
 	switch reportType {
 	case rgsv1.ReportType_REPORT_TYPE_SIGNIFICANT_EVENTS_ALTERATIONS:
-		_ = w.Write([]string{"operator_id", "report_title", "selected_interval", "generated_at"})
-		_ = w.Write([]string{toString(payload["operator_id"]), toString(payload["report_title"]), toString(payload["selected_interval"]), toString(payload["generated_at"])})
+		_ = w.Write([]string{"operator_id", "report_title", "selected_interval", "period_start", "period_end", "time_zone", "generated_at"})
+		_ = w.Write([]string{toString(payload["operator_id"]), toString(payload["report_title"]), toString(payload["selected_interval"]), toString(payload["period_start"]), toString(payload["period_end"]), toString(payload["time_zone"]), toString(payload["generated_at"])})
 		_ = w.Write([]string{"event_id", "equipment_id", "event_code", "localized_description", "severity", "occurred_at", "received_at", "recorded_at"})
 		rows, _ := payload["rows"].([]map[string]any)
 		if len(rows) == 0 {
@@ -386,9 +576,15 @@ func payloadToCSV(reportType rgsv1.ReportType, payload map[string]any) ([]byte,
 		for _, r := range rows {
 			_ = w.Write([]string{toString(r["event_id"]), toString(r["equipment_id"]), toString(r["event_code"]), toString(r["localized_description"]), toString(r["severity"]), toString(r["occurred_at"]), toString(r["received_at"]), toString(r["recorded_at"])})
 		}
+		if ramClears, _ := payload["ram_clears"].([]map[string]any); len(ramClears) > 0 {
+			_ = w.Write([]string{"ram_clear_event_id", "equipment_id", "occurred_at", "baseline_reset_at", "status", "acknowledged_by", "acknowledged_at", "acknowledgment_reason"})
+			for _, r := range ramClears {
+				_ = w.Write([]string{toString(r["event_id"]), toString(r["equipment_id"]), toString(r["occurred_at"]), toString(r["baseline_reset_at"]), toString(r["status"]), toString(r["acknowledged_by"]), toString(r["acknowledged_at"]), toString(r["acknowledgment_reason"])})
+			}
+		}
 	case rgsv1.ReportType_REPORT_TYPE_CASHLESS_LIABILITY_SUMMARY:
-		_ = w.Write([]string{"operator_id", "report_title", "selected_interval", "generated_at", "total_available", "total_pending"})
-		_ = w.Write([]string{toString(payload["operator_id"]), toString(payload["report_title"]), toString(payload["selected_interval"]), toString(payload["generated_at"]), toString(payload["total_available"]), toString(payload["total_pending"])})
+		_ = w.Write([]string{"operator_id", "report_title", "selected_interval", "period_start", "period_end", "time_zone", "generated_at", "total_available", "total_pending"})
+		_ = w.Write([]string{toString(payload["operator_id"]), toString(payload["report_title"]), toString(payload["selected_interval"]), toString(payload["period_start"]), toString(payload["period_end"]), toString(payload["time_zone"]), toString(payload["generated_at"]), toString(payload["total_available"]), toString(payload["total_pending"])})
 		_ = w.Write([]string{"account_id", "currency", "available", "pending", "total"})
 		rows, _ := payload["rows"].([]map[string]any)
 		if len(rows) == 0 {
@@ -398,8 +594,8 @@ func payloadToCSV(reportType rgsv1.ReportType, payload map[string]any) ([]byte,
 			_ = w.Write([]string{toString(r["account_id"]), toString(r["currency"]), toString(r["available"]), toString(r["pending"]), toString(r["total"])})
 		}
 	case rgsv1.ReportType_REPORT_TYPE_ACCOUNT_TRANSACTION_STATEMENT:
-		_ = w.Write([]string{"operator_id", "report_title", "selected_interval", "generated_at"})
-		_ = w.Write([]string{toString(payload["operator_id"]), toString(payload["report_title"]), toString(payload["selected_interval"]), toString(payload["generated_at"])})
+		_ = w.Write([]string{"operator_id", "report_title", "selected_interval", "period_start", "period_end", "time_zone", "generated_at"})
+		_ = w.Write([]string{toString(payload["operator_id"]), toString(payload["report_title"]), toString(payload["selected_interval"]), toString(payload["period_start"]), toString(payload["period_end"]), toString(payload["time_zone"]), toString(payload["generated_at"])})
 		_ = w.Write([]string{"transaction_id", "account_id", "transaction_type", "amount_minor", "currency", "occurred_at", "authorization_id"})
 		rows, _ := payload["rows"].([]map[string]any)
 		if len(rows) == 0 {
@@ -408,6 +604,48 @@ func payloadToCSV(reportType rgsv1.ReportType, payload map[string]any) ([]byte,
 		for _, r := range rows {
 			_ = w.Write([]string{toString(r["transaction_id"]), toString(r["account_id"]), toString(r["transaction_type"]), toString(r["amount_minor"]), toString(r["currency"]), toString(r["occurred_at"]), toString(r["authorization_id"])})
 		}
+	case rgsv1.ReportType_REPORT_TYPE_RTP_SUMMARY:
+		_ = w.Write([]string{"operator_id", "report_title", "selected_interval", "period_start", "period_end", "time_zone", "generated_at"})
+		_ = w.Write([]string{toString(payload["operator_id"]), toString(payload["report_title"]), toString(payload["selected_interval"]), toString(payload["period_start"]), toString(payload["period_end"]), toString(payload["time_zone"]), toString(payload["generated_at"])})
+		_ = w.Write([]string{"game_id", "currency", "settled_count", "settled_stake", "payout", "actual_rtp_bps", "theoretical_rtp_bps", "expected_payout", "open_count", "open_stake"})
+		rows, _ := payload["rows"].([]map[string]any)
+		if len(rows) == 0 {
+			_ = w.Write([]string{"No Activity"})
+		}
+		for _, r := range rows {
+			_ = w.Write([]string{toString(r["game_id"]), toString(r["currency"]), toString(r["settled_count"]), toString(r["settled_stake"]), toString(r["payout"]), toString(r["actual_rtp_bps"]), toString(r["theoretical_rtp_bps"]), toString(r["expected_payout"]), toString(r["open_count"]), toString(r["open_stake"])})
+		}
+	case rgsv1.ReportType_REPORT_TYPE_PROMO_LIABILITY:
+		_ = w.Write([]string{"operator_id", "report_title", "selected_interval", "period_start", "period_end", "time_zone", "generated_at"})
+		_ = w.Write([]string{toString(payload["operator_id"]), toString(payload["report_title"]), toString(payload["selected_interval"]), toString(payload["period_start"]), toString(payload["period_end"]), toString(payload["time_zone"]), toString(payload["generated_at"])})
+		_ = w.Write([]string{"campaign_id", "currency", "awards_issued", "issued", "redemptions", "redeemed", "total_issued", "total_redeemed", "outstanding"})
+		rows, _ := payload["rows"].([]map[string]any)
+		if len(rows) == 0 {
+			_ = w.Write([]string{"No Activity"})
+		}
+		for _, r := range rows {
+			_ = w.Write([]string{toString(r["campaign_id"]), toString(r["currency"]), toString(r["awards_issued"]), toString(r["issued"]), toString(r["redemptions"]), toString(r["redeemed"]), toString(r["total_issued"]), toString(r["total_redeemed"]), toString(r["outstanding"])})
+		}
+	case rgsv1.ReportType_REPORT_TYPE_EFT_ACTIVITY:
+		_ = w.Write([]string{"operator_id", "report_title", "selected_interval", "period_start", "period_end", "time_zone", "generated_at"})
+		_ = w.Write([]string{toString(payload["operator_id"]), toString(payload["report_title"]), toString(payload["selected_interval"]), toString(payload["period_start"]), toString(payload["period_end"]), toString(payload["time_zone"]), toString(payload["generated_at"])})
+		_ = w.Write([]string{"account_id", "gaming_day", "currency", "deposits", "deposit_amount", "withdrawals", "withdrawal_amount", "transfers_to_device", "transfer_to_device_amount", "transfers_to_account", "transfer_to_account_amount", "denials", "lockouts"})
+		rows, _ := payload["rows"].([]map[string]any)
+		if len(rows) == 0 {
+			_ = w.Write([]string{"No Activity"})
+		}
+		for _, r := range rows {
+			_ = w.Write([]string{toString(r["account_id"]), toString(r["gaming_day"]), toString(r["currency"]), toString(r["deposits"]), toString(r["deposit_amount"]), toString(r["withdrawals"]), toString(r["withdrawal_amount"]), toString(r["transfers_to_device"]), toString(r["transfer_to_device_amount"]), toString(r["transfers_to_account"]), toString(r["transfer_to_account_amount"]), toString(r["denials"]), toString(r["lockouts"])})
+		}
+		if len(rows) > 0 {
+			_ = w.Write([]string{"account_id", "gaming_day", "entry_type", "reference", "amount_minor", "currency", "occurred_at", "detail"})
+		}
+		for _, r := range rows {
+			entries, _ := r["entries"].([]map[string]any)
+			for _, e := range entries {
+				_ = w.Write([]string{toString(r["account_id"]), toString(r["gaming_day"]), toString(e["entry_type"]), toString(e["reference"]), toString(e["amount_minor"]), toString(e["currency"]), toString(e["occurred_at"]), toString(e["detail"])})
+			}
+		}
 	default:
 		_ = w.Write([]string{"No Activity"})
 	}
@@ -438,66 +676,75 @@ func toString(v any) string {
 	}
 }
 
-func (s *ReportingService) GenerateReport(ctx context.Context, req *rgsv1.GenerateReportRequest) (*rgsv1.GenerateReportResponse, error) {
-	if req == nil {
-		return &rgsv1.GenerateReportResponse{Meta: s.responseMeta(nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "request is required")}, nil
-	}
-	if ok, reason := s.authorize(ctx, req.Meta); !ok {
-		_ = s.appendAudit(req.Meta, "", "generate_report", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
-		return &rgsv1.GenerateReportResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
-	}
-	if req.ReportType == rgsv1.ReportType_REPORT_TYPE_UNSPECIFIED {
-		return &rgsv1.GenerateReportResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "report_type is required")}, nil
-	}
-	if req.Interval == rgsv1.ReportInterval_REPORT_INTERVAL_UNSPECIFIED {
-		return &rgsv1.GenerateReportResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "interval is required")}, nil
-	}
-	if req.Format == rgsv1.ReportFormat_REPORT_FORMAT_UNSPECIFIED {
-		return &rgsv1.GenerateReportResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "format is required")}, nil
-	}
-
+// renderReport builds a report over the given window and serializes it in
+// format.
+func (s *ReportingService) renderReport(ctx context.Context, reportType rgsv1.ReportType, w reportWindow, format rgsv1.ReportFormat, operatorID string) ([]byte, string, bool, rgsv1.ResultCode, string) {
 	var payload map[string]any
 	var noActivity bool
-	switch req.ReportType {
+	switch reportType {
 	case rgsv1.ReportType_REPORT_TYPE_SIGNIFICANT_EVENTS_ALTERATIONS:
-		payload, noActivity = s.buildSignificantEventsPayload(req.Interval, req.OperatorId)
+		payload, noActivity = s.buildSignificantEventsPayload(w, operatorID)
 	case rgsv1.ReportType_REPORT_TYPE_CASHLESS_LIABILITY_SUMMARY:
-		payload, noActivity = s.buildCashlessLiabilityPayload(req.Interval, req.OperatorId)
+		payload, noActivity = s.buildCashlessLiabilityPayload(w, operatorID)
 	case rgsv1.ReportType_REPORT_TYPE_ACCOUNT_TRANSACTION_STATEMENT:
-		payload, noActivity = s.buildAccountTransactionStatementPayload(req.Interval, req.OperatorId)
+		payload, noActivity = s.buildAccountTransactionStatementPayload(w, operatorID)
+	case rgsv1.ReportType_REPORT_TYPE_RTP_SUMMARY:
+		payload, noActivity = s.buildRTPSummaryPayload(ctx, w, operatorID)
+	case rgsv1.ReportType_REPORT_TYPE_PROMO_LIABILITY:
+		payload, noActivity = s.buildPromoLiabilityPayload(ctx, w, operatorID)
+	case rgsv1.ReportType_REPORT_TYPE_EFT_ACTIVITY:
+		payload, noActivity = s.buildEFTActivityPayload(ctx, w, operatorID)
 	default:
-		return &rgsv1.GenerateReportResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "unsupported report_type")}, nil
+		return nil, "", false, rgsv1.ResultCode_RESULT_CODE_INVALID, "unsupported report_type"
 	}
+	payload["period_start"], payload["period_end"] = w.period()
+	payload["time_zone"] = gamingCalendarFor(operatorID).location().String()
 
 	var content []byte
 	var contentType string
 	var err error
-	if req.Format == rgsv1.ReportFormat_REPORT_FORMAT_JSON {
+	switch format {
+	case rgsv1.ReportFormat_REPORT_FORMAT_JSON:
 		content, err = json.Marshal(payload)
 		contentType = "application/json"
-	} else {
-		content, err = payloadToCSV(req.ReportType, payload)
+	case rgsv1.ReportFormat_REPORT_FORMAT_PDF:
+		content, err = payloadToPDF(reportType, payload)
+		contentType = "application/pdf"
+	default:
+		content, err = payloadToCSV(reportType, payload)
 		contentType = "text/csv"
 	}
 	if err != nil {
-		return &rgsv1.GenerateReportResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "failed to serialize report")}, nil
+		return nil, "", false, rgsv1.ResultCode_RESULT_CODE_ERROR, "failed to serialize report"
+	}
+	return content, contentType, noActivity, rgsv1.ResultCode_RESULT_CODE_OK, ""
+}
+
+// generateRun builds, records, and audits a single report run over the given
+// window. It is shared by on-demand generation and the daily pack job.
+func (s *ReportingService) generateRun(ctx context.Context, meta *rgsv1.RequestMeta, reportType rgsv1.ReportType, w reportWindow, format rgsv1.ReportFormat, operatorID string) (*rgsv1.ReportRun, rgsv1.ResultCode, string) {
+	content, contentType, noActivity, code, reason := s.renderReport(ctx, reportType, w, format, operatorID)
+	if code != rgsv1.ResultCode_RESULT_CODE_OK {
+		return nil, code, reason
 	}
 
 	s.mu.Lock()
 	runID := s.nextRunIDLocked()
 	run := &rgsv1.ReportRun{
 		ReportRunId: runID,
-		ReportType:  req.ReportType,
-		Interval:    req.Interval,
-		Format:      req.Format,
+		ReportType:  reportType,
+		Interval:    w.interval,
+		Format:      format,
 		Status:      rgsv1.ReportRunStatus_REPORT_RUN_STATUS_COMPLETED,
-		OperatorId:  req.OperatorId,
-		ReportTitle: reportTitle(req.ReportType),
+		OperatorId:  operatorID,
+		ReportTitle: reportTitle(reportType),
 		GeneratedAt: s.now().Format(time.RFC3339Nano),
 		NoActivity:  noActivity,
 		ContentType: contentType,
 		Content:     content,
 	}
+	run.PeriodStart, run.PeriodEnd = w.period()
+	s.signRunLocked(run)
 	if !s.disableInMemoryCache {
 		s.runs[runID] = run
 		s.runOrder = append(s.runOrder, runID)
@@ -505,10 +752,86 @@ func (s *ReportingService) GenerateReport(ctx context.Context, req *rgsv1.Genera
 	s.mu.Unlock()
 
 	after, _ := json.Marshal(run)
-	if err := s.appendAudit(req.Meta, runID, "generate_report", []byte(`{}`), after, audit.ResultSuccess, ""); err != nil {
-		return &rgsv1.GenerateReportResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	if err := s.appendAudit(meta, runID, "generate_report", []byte(`{}`), after, audit.ResultSuccess, ""); err != nil {
+		return nil, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable"
+	}
+	if err := s.persistReportRun(ctx, meta, run); err != nil {
+		return nil, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable"
+	}
+
+	return run, rgsv1.ResultCode_RESULT_CODE_OK, ""
+}
+
+// limitWindowToGroup restricts w to the equipment in groupID. Only the
+// significant events report is kept per equipment, so a group on any other
+// report is rejected.
+func (s *ReportingService) limitWindowToGroup(ctx context.Context, w *reportWindow, reportType rgsv1.ReportType, groupID string) (rgsv1.ResultCode, string) {
+	if groupID == "" {
+		return rgsv1.ResultCode_RESULT_CODE_OK, ""
+	}
+	if reportType != rgsv1.ReportType_REPORT_TYPE_SIGNIFICANT_EVENTS_ALTERATIONS {
+		return rgsv1.ResultCode_RESULT_CODE_INVALID, "group_id only applies to the significant events report"
+	}
+	group, code, reason := s.Registry.equipmentFilterForGroup(ctx, groupID)
+	if code != rgsv1.ResultCode_RESULT_CODE_OK {
+		return code, reason
+	}
+	w.groupID, w.equipment = groupID, group
+	return rgsv1.ResultCode_RESULT_CODE_OK, ""
+}
+
+func validateReportRequest(reportType rgsv1.ReportType, interval rgsv1.ReportInterval, format rgsv1.ReportFormat) string {
+	switch {
+	case reportType == rgsv1.ReportType_REPORT_TYPE_UNSPECIFIED:
+		return "report_type is required"
+	case interval == rgsv1.ReportInterval_REPORT_INTERVAL_UNSPECIFIED:
+		return "interval is required"
+	case format == rgsv1.ReportFormat_REPORT_FORMAT_UNSPECIFIED:
+		return "format is required"
+	case !supportedReportType(reportType):
+		return "unsupported report_type"
+	}
+	return ""
+}
+
+func supportedReportType(t rgsv1.ReportType) bool {
+	switch t {
+	case rgsv1.ReportType_REPORT_TYPE_SIGNIFICANT_EVENTS_ALTERATIONS,
+		rgsv1.ReportType_REPORT_TYPE_CASHLESS_LIABILITY_SUMMARY,
+		rgsv1.ReportType_REPORT_TYPE_ACCOUNT_TRANSACTION_STATEMENT,
+		rgsv1.ReportType_REPORT_TYPE_RTP_SUMMARY,
+		rgsv1.ReportType_REPORT_TYPE_PROMO_LIABILITY,
+		rgsv1.ReportType_REPORT_TYPE_EFT_ACTIVITY:
+		return true
+	}
+	return false
+}
+
+func (s *ReportingService) GenerateReport(ctx context.Context, req *rgsv1.GenerateReportRequest) (*rgsv1.GenerateReportResponse, error) {
+	if req == nil {
+		return &rgsv1.GenerateReportResponse{Meta: s.responseMeta(nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "request is required")}, nil
+	}
+	if ok, reason := s.authorize(ctx, req.Meta); !ok {
+		_ = s.appendAudit(req.Meta, "", "generate_report", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		return &rgsv1.GenerateReportResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	if ok, reason := s.authorizeOperator(ctx, req.Meta, req.OperatorId); !ok {
+		_ = s.appendAudit(req.Meta, "", "generate_report", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		return &rgsv1.GenerateReportResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	if reason := validateReportRequest(req.ReportType, req.Interval, req.Format); reason != "" {
+		return &rgsv1.GenerateReportResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, reason)}, nil
+	}
+
+	w := intervalWindow(s.now(), req.Interval, req.OperatorId)
+	if code, reason := s.limitWindowToGroup(ctx, &w, req.ReportType, req.GroupId); code != rgsv1.ResultCode_RESULT_CODE_OK {
+		return &rgsv1.GenerateReportResponse{Meta: s.responseMeta(req.Meta, code, reason)}, nil
 	}
-	if err := s.persistReportRun(ctx, req.Meta, run); err != nil {
+	run, code, reason := s.generateRun(ctx, req.Meta, req.ReportType, w, req.Format, req.OperatorId)
+	if code != rgsv1.ResultCode_RESULT_CODE_OK {
+		return &rgsv1.GenerateReportResponse{Meta: s.responseMeta(req.Meta, code, reason)}, nil
+	}
+	if err := s.scheduleReportDeliveries(ctx, run); err != nil {
 		return &rgsv1.GenerateReportResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
 	}
 
@@ -523,6 +846,14 @@ func (s *ReportingService) ListReportRuns(ctx context.Context, req *rgsv1.ListRe
 		_ = s.appendAudit(req.Meta, "", "list_report_runs", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
 		return &rgsv1.ListReportRunsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
 	}
+	operators, scoped := s.operatorScope(ctx, req.Meta)
+	if req.OperatorId != "" {
+		if ok, reason := s.authorizeOperator(ctx, req.Meta, req.OperatorId); !ok {
+			_ = s.appendAudit(req.Meta, "", "list_report_runs", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+			return &rgsv1.ListReportRunsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+		}
+		operators, scoped = []string{req.OperatorId}, true
+	}
 
 	start := 0
 	if req.PageToken != "" {
@@ -535,7 +866,7 @@ func (s *ReportingService) ListReportRuns(ctx context.Context, req *rgsv1.ListRe
 		size = 50
 	}
 	if s.db != nil {
-		items, err := s.listReportRunsFromDB(ctx, req.ReportTypeFilter, size, start)
+		items, err := s.listReportRunsFromDB(ctx, req.ReportTypeFilter, operators, scoped, size, start)
 		if err != nil {
 			return &rgsv1.ListReportRunsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
 		}
@@ -558,6 +889,9 @@ func (s *ReportingService) ListReportRuns(ctx context.Context, req *rgsv1.ListRe
 		if req.ReportTypeFilter != rgsv1.ReportType_REPORT_TYPE_UNSPECIFIED && r.ReportType != req.ReportTypeFilter {
 			continue
 		}
+		if scoped && !slices.Contains(operators, r.OperatorId) {
+			continue
+		}
 		items = append(items, cloneRun(r))
 	}
 
@@ -585,23 +919,25 @@ func (s *ReportingService) GetReportRun(ctx context.Context, req *rgsv1.GetRepor
 		return &rgsv1.GetReportRunResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
 	}
 
+	run, err := s.loadReportRun(ctx, req.ReportRunId)
+	if err != nil {
+		return &rgsv1.GetReportRunResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if run == nil {
+		return &rgsv1.GetReportRunResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "report run not found")}, nil
+	}
+	if ok, reason := s.authorizeOperator(ctx, req.Meta, run.OperatorId); !ok {
+		_ = s.appendAudit(req.Meta, req.ReportRunId, "get_report_run", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		return &rgsv1.GetReportRunResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
 	if s.db != nil {
-		run, err := s.getReportRunFromDB(ctx, req.ReportRunId)
+		deliveries, err := s.listReportRunDeliveriesFromDB(ctx, run.ReportRunId)
 		if err != nil {
 			return &rgsv1.GetReportRunResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
 		}
-		if run == nil {
-			return &rgsv1.GetReportRunResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "report run not found")}, nil
-		}
+		run.Deliveries = deliveries
 		return &rgsv1.GetReportRunResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), ReportRun: run}, nil
 	}
-
-	s.mu.Lock()
-	defer s.mu.Unlock()
-
-	run := s.runs[req.ReportRunId]
-	if run == nil {
-		return &rgsv1.GetReportRunResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "report run not found")}, nil
-	}
-	return &rgsv1.GetReportRunResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), ReportRun: cloneRun(run)}, nil
+	run.Deliveries, _ = s.loadReportRunDeliveries(ctx, run.ReportRunId)
+	return &rgsv1.GetReportRunResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), ReportRun: run}, nil
 }
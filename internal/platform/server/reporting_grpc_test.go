@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"encoding/json"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -276,7 +277,7 @@ func TestReportingActorMismatchDenied(t *testing.T) {
 		t.Fatalf("expected actor mismatch reason on get, got=%q", getResp.GetMeta().GetDenialReason())
 	}
 
-	events := reportingSvc.AuditStore.Events()
+	events := auditEvents(reportingSvc.AuditStore)
 	if len(events) == 0 {
 		t.Fatalf("expected denied reporting audit events")
 	}
@@ -285,3 +286,126 @@ func TestReportingActorMismatchDenied(t *testing.T) {
 		t.Fatalf("expected denied get_report_run audit with actor mismatch reason, got=%+v", last)
 	}
 }
+
+func TestReportingCashlessLiabilityTotalsPerCurrency(t *testing.T) {
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 12, 16, 0, 0, 0, time.UTC)}
+	ledgerSvc := NewLedgerService(clk)
+	reportingSvc := NewReportingService(clk, ledgerSvc, NewEventsService(clk))
+	ctx := context.Background()
+
+	for i, dep := range []struct {
+		account  string
+		amount   int64
+		currency string
+	}{
+		{"player-1", 500, "USD"},
+		{"player-2", 700, "EUR"},
+		{"player-3", 250, "USD"},
+	} {
+		resp, err := ledgerSvc.Deposit(ctx, &rgsv1.DepositRequest{
+			Meta:      meta(dep.account, rgsv1.ActorType_ACTOR_TYPE_PLAYER, "idem-rpt-ccy-"+strconv.Itoa(i)),
+			AccountId: dep.account,
+			Amount:    &rgsv1.Money{AmountMinor: dep.amount, Currency: dep.currency},
+		})
+		if err != nil || resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("deposit %s err=%v resp=%v", dep.account, err, resp.GetMeta())
+		}
+	}
+
+	resp, err := reportingSvc.GenerateReport(ctx, &rgsv1.GenerateReportRequest{
+		Meta:       meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		ReportType: rgsv1.ReportType_REPORT_TYPE_CASHLESS_LIABILITY_SUMMARY,
+		Interval:   rgsv1.ReportInterval_REPORT_INTERVAL_DTD,
+		Format:     rgsv1.ReportFormat_REPORT_FORMAT_JSON,
+		OperatorId: "casino-1",
+	})
+	if err != nil {
+		t.Fatalf("generate report err: %v", err)
+	}
+	var payload struct {
+		TotalAvailable *int64 `json:"total_available"`
+		CurrencyTotals []struct {
+			Currency  string `json:"currency"`
+			Available int64  `json:"available"`
+		} `json:"currency_totals"`
+	}
+	if err := json.Unmarshal(resp.ReportRun.Content, &payload); err != nil {
+		t.Fatalf("unmarshal report content: %v", err)
+	}
+	if payload.TotalAvailable != nil {
+		t.Fatalf("expected no cross-currency grand total, got=%d", *payload.TotalAvailable)
+	}
+	if len(payload.CurrencyTotals) != 2 ||
+		payload.CurrencyTotals[0].Currency != "EUR" || payload.CurrencyTotals[0].Available != 700 ||
+		payload.CurrencyTotals[1].Currency != "USD" || payload.CurrencyTotals[1].Available != 750 {
+		t.Fatalf("unexpected currency totals: %+v", payload.CurrencyTotals)
+	}
+}
+
+func TestReportingIntervalsFollowOperatorTimeZone(t *testing.T) {
+	useGamingCalendars(t, GamingCalendars{ByTenant: map[string]GamingCalendar{
+		"op-lv": mustGamingCalendar(t, "America/Los_Angeles", "06:00"),
+	}})
+	// 05:00 PST on 2026-03-01 is still gaming day 2026-02-28.
+	clk := ledgerFixedClock{now: time.Date(2026, 3, 1, 13, 0, 0, 0, time.UTC)}
+	eventsSvc := NewEventsService(clk)
+	reportingSvc := NewReportingService(clk, NewLedgerService(clk), eventsSvc)
+	ctx := context.Background()
+
+	// One device per event, so the old timestamps do not build up into a
+	// chronic clock-skew event of their own.
+	for id, occurredAt := range map[string]string{
+		"ev-2025":    "2025-12-31T20:00:00Z",
+		"ev-jan-pre": "2026-01-01T13:00:00Z", // 05:00 PST, gaming day 2025-12-31
+		"ev-jan":     "2026-01-15T00:00:00Z",
+		"ev-feb-pre": "2026-02-01T13:59:00Z", // 05:59 PST, gaming day 2026-01-31
+		"ev-feb":     "2026-02-10T00:00:00Z",
+		"ev-future":  "2026-03-02T00:00:00Z",
+	} {
+		resp, _ := eventsSvc.SubmitSignificantEvent(ctx, &rgsv1.SubmitSignificantEventRequest{
+			Meta:  meta("svc-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, ""),
+			Event: &rgsv1.SignificantEvent{EventId: id, EquipmentId: "eq-" + id, EventCode: "E1", OccurredAt: occurredAt},
+		})
+		if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("submit %s: %+v", id, resp.Meta)
+		}
+	}
+
+	cases := []struct {
+		interval    rgsv1.ReportInterval
+		periodStart string
+		rows        int
+	}{
+		{rgsv1.ReportInterval_REPORT_INTERVAL_DTD, "2026-02-28T14:00:00Z", 0},
+		{rgsv1.ReportInterval_REPORT_INTERVAL_MTD, "2026-02-01T14:00:00Z", 1},
+		{rgsv1.ReportInterval_REPORT_INTERVAL_YTD, "2026-01-01T14:00:00Z", 3},
+		{rgsv1.ReportInterval_REPORT_INTERVAL_LTD, "", 5},
+	}
+	for _, tc := range cases {
+		resp, err := reportingSvc.GenerateReport(ctx, &rgsv1.GenerateReportRequest{
+			Meta:       meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+			ReportType: rgsv1.ReportType_REPORT_TYPE_SIGNIFICANT_EVENTS_ALTERATIONS,
+			Interval:   tc.interval,
+			Format:     rgsv1.ReportFormat_REPORT_FORMAT_JSON,
+			OperatorId: "op-lv",
+		})
+		if err != nil || resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("%s: generate report: resp=%+v err=%v", tc.interval, resp, err)
+		}
+		run := resp.ReportRun
+		if run.PeriodStart != tc.periodStart || run.PeriodEnd != "2026-03-01T13:00:00Z" {
+			t.Fatalf("%s: unexpected period %q..%q", tc.interval, run.PeriodStart, run.PeriodEnd)
+		}
+		var payload struct {
+			RowCount    int    `json:"row_count"`
+			PeriodStart string `json:"period_start"`
+			TimeZone    string `json:"time_zone"`
+		}
+		if err := json.Unmarshal(run.Content, &payload); err != nil {
+			t.Fatalf("%s: decode report: %v", tc.interval, err)
+		}
+		if payload.RowCount != tc.rows || payload.PeriodStart != tc.periodStart || payload.TimeZone != "America/Los_Angeles" {
+			t.Fatalf("%s: unexpected payload %+v", tc.interval, payload)
+		}
+	}
+}
@@ -0,0 +1,380 @@
+package server
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+// reportPDFColumn is one table column of a PDF report: the row key it shows
+// and its share of the table width.
+type reportPDFColumn struct {
+	Key    string
+	Label  string
+	Weight int
+}
+
+// reportPDFField is a labelled summary value printed above the table.
+type reportPDFField struct {
+	Key   string
+	Label string
+}
+
+// reportPDFTemplate lays out one report type as a PDF. Reports are rendered
+// from the same payload as their JSON and CSV forms.
+type reportPDFTemplate struct {
+	Summary []reportPDFField
+	Columns []reportPDFColumn
+}
+
+var reportPDFTemplates = map[rgsv1.ReportType]reportPDFTemplate{
+	rgsv1.ReportType_REPORT_TYPE_SIGNIFICANT_EVENTS_ALTERATIONS: {
+		Columns: []reportPDFColumn{
+			{Key: "event_id", Label: "Event", Weight: 3},
+			{Key: "equipment_id", Label: "Equipment", Weight: 3},
+			{Key: "event_code", Label: "Code", Weight: 2},
+			{Key: "localized_description", Label: "Description", Weight: 6},
+			{Key: "severity", Label: "Severity", Weight: 3},
+			{Key: "occurred_at", Label: "Occurred", Weight: 4},
+			{Key: "recorded_at", Label: "Recorded", Weight: 4},
+		},
+	},
+	rgsv1.ReportType_REPORT_TYPE_CASHLESS_LIABILITY_SUMMARY: {
+		Summary: []reportPDFField{
+			{Key: "total_available", Label: "Total available"},
+			{Key: "total_pending", Label: "Total pending"},
+		},
+		Columns: []reportPDFColumn{
+			{Key: "account_id", Label: "Account", Weight: 5},
+			{Key: "currency", Label: "Currency", Weight: 2},
+			{Key: "available", Label: "Available", Weight: 3},
+			{Key: "pending", Label: "Pending", Weight: 3},
+			{Key: "total", Label: "Total", Weight: 3},
+		},
+	},
+	rgsv1.ReportType_REPORT_TYPE_ACCOUNT_TRANSACTION_STATEMENT: {
+		Columns: []reportPDFColumn{
+			{Key: "transaction_id", Label: "Transaction", Weight: 4},
+			{Key: "account_id", Label: "Account", Weight: 4},
+			{Key: "transaction_type", Label: "Type", Weight: 5},
+			{Key: "amount_minor", Label: "Amount", Weight: 3},
+			{Key: "currency", Label: "Currency", Weight: 2},
+			{Key: "occurred_at", Label: "Occurred", Weight: 4},
+			{Key: "authorization_id", Label: "Authorization", Weight: 4},
+		},
+	},
+	rgsv1.ReportType_REPORT_TYPE_RTP_SUMMARY: {
+		Columns: []reportPDFColumn{
+			{Key: "game_id", Label: "Game", Weight: 4},
+			{Key: "currency", Label: "Currency", Weight: 2},
+			{Key: "settled_count", Label: "Settled", Weight: 2},
+			{Key: "settled_stake", Label: "Settled stake", Weight: 3},
+			{Key: "payout", Label: "Payout", Weight: 3},
+			{Key: "actual_rtp_bps", Label: "Actual RTP", Weight: 2},
+			{Key: "theoretical_rtp_bps", Label: "Theo. RTP", Weight: 2},
+			{Key: "expected_payout", Label: "Expected payout", Weight: 3},
+			{Key: "open_count", Label: "Open", Weight: 2},
+			{Key: "open_stake", Label: "Open stake", Weight: 3},
+		},
+	},
+	rgsv1.ReportType_REPORT_TYPE_PROMO_LIABILITY: {
+		Columns: []reportPDFColumn{
+			{Key: "campaign_id", Label: "Campaign", Weight: 4},
+			{Key: "currency", Label: "Currency", Weight: 2},
+			{Key: "awards_issued", Label: "Awards", Weight: 2},
+			{Key: "issued", Label: "Issued", Weight: 3},
+			{Key: "redemptions", Label: "Redemptions", Weight: 2},
+			{Key: "redeemed", Label: "Redeemed", Weight: 3},
+			{Key: "total_issued", Label: "Total issued", Weight: 3},
+			{Key: "total_redeemed", Label: "Total redeemed", Weight: 3},
+			{Key: "outstanding", Label: "Outstanding", Weight: 3},
+		},
+	},
+	rgsv1.ReportType_REPORT_TYPE_EFT_ACTIVITY: {
+		Columns: []reportPDFColumn{
+			{Key: "account_id", Label: "Account", Weight: 4},
+			{Key: "gaming_day", Label: "Gaming day", Weight: 3},
+			{Key: "currency", Label: "Currency", Weight: 2},
+			{Key: "deposits", Label: "Deposits", Weight: 2},
+			{Key: "deposit_amount", Label: "Deposited", Weight: 3},
+			{Key: "withdrawals", Label: "Withdrawals", Weight: 2},
+			{Key: "withdrawal_amount", Label: "Withdrawn", Weight: 3},
+			{Key: "transfers_to_device", Label: "To device", Weight: 2},
+			{Key: "transfer_to_device_amount", Label: "To device amt", Weight: 3},
+			{Key: "transfers_to_account", Label: "To account", Weight: 2},
+			{Key: "transfer_to_account_amount", Label: "To account amt", Weight: 3},
+			{Key: "denials", Label: "Denials", Weight: 2},
+			{Key: "lockouts", Label: "Lockouts", Weight: 2},
+		},
+	},
+}
+
+// pdfPeriod describes the report window for the PDF header.
+func pdfPeriod(payload map[string]any) string {
+	start, end := toString(payload["period_start"]), toString(payload["period_end"])
+	if start == "" {
+		start = "inception"
+	}
+	return fmt.Sprintf("%s to %s (%s)", start, end, toString(payload["time_zone"]))
+}
+
+// Letter landscape, in points.
+const (
+	pdfPageWidth    = 792
+	pdfPageHeight   = 612
+	pdfMargin       = 40
+	pdfTableSize    = 8
+	pdfTableLeading = 11
+	pdfFooterY      = 24
+)
+
+// reportContentHash is the verification hash printed in a PDF report's
+// footer: the SHA-256 of the report payload serialized as JSON, the same
+// bytes a JSON run of that payload returns.
+func reportContentHash(payload map[string]any) (string, error) {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func payloadToPDF(reportType rgsv1.ReportType, payload map[string]any) ([]byte, error) {
+	tmpl, ok := reportPDFTemplates[reportType]
+	if !ok {
+		return nil, fmt.Errorf("no pdf template for %s", reportType)
+	}
+	hash, err := reportContentHash(payload)
+	if err != nil {
+		return nil, err
+	}
+	title := toString(payload["report_title"])
+	generatedAt := toString(payload["generated_at"])
+
+	header := []pdfLine{
+		{Font: "F2", Size: 16, Text: title},
+		{Font: "F1", Size: 9, Text: "Operator: " + toString(payload["operator_id"])},
+		{Font: "F1", Size: 9, Text: "Interval: " + toString(payload["selected_interval"])},
+		{Font: "F1", Size: 9, Text: "Period: " + pdfPeriod(payload)},
+		{Font: "F1", Size: 9, Text: "Generated at: " + generatedAt},
+		{Font: "F1", Size: 9, Text: "Rows: " + toString(payload["row_count"])},
+	}
+	for _, f := range tmpl.Summary {
+		if v, ok := payload[f.Key]; ok {
+			header = append(header, pdfLine{Font: "F1", Size: 9, Text: f.Label + ": " + toString(v)})
+		}
+	}
+	if totals, ok := payload["currency_totals"].([]map[string]any); ok && len(totals) > 1 {
+		for _, t := range totals {
+			header = append(header, pdfLine{Font: "F1", Size: 9, Text: fmt.Sprintf("Totals %s: available %s, pending %s", toString(t["currency"]), toString(t["available"]), toString(t["pending"]))})
+		}
+	}
+	if ramClears, ok := payload["ram_clears"].([]map[string]any); ok {
+		for _, r := range ramClears {
+			status := "pending acknowledgment"
+			if r["status"] == "acknowledged" {
+				status = fmt.Sprintf("acknowledged by %s at %s: %s", toString(r["acknowledged_by"]), toString(r["acknowledged_at"]), toString(r["acknowledgment_reason"]))
+			}
+			header = append(header, pdfLine{Font: "F1", Size: 9, Text: fmt.Sprintf("RAM clear %s on %s at %s, %s", toString(r["event_id"]), toString(r["equipment_id"]), toString(r["occurred_at"]), status)})
+		}
+	}
+	if note := toString(payload["note"]); note != "" {
+		header = append(header, pdfLine{Font: "F2", Size: 9, Text: note})
+	}
+
+	rows, _ := payload["rows"].([]map[string]any)
+	cells := make([][]string, 0, len(rows))
+	for _, r := range rows {
+		line := make([]string, len(tmpl.Columns))
+		for i, c := range tmpl.Columns {
+			line[i] = toString(r[c.Key])
+		}
+		cells = append(cells, line)
+	}
+
+	doc := &pdfDocument{Title: title, CreatedAt: generatedAt}
+	layoutPDFReport(doc, header, tmpl.Columns, cells)
+	footer := fmt.Sprintf("%s | generated %s | SHA-256 %s", title, generatedAt, hash)
+	for i := range doc.pages {
+		doc.pages[i].text(pdfMargin, pdfFooterY, "F1", 7, footer)
+		doc.pages[i].text(pdfPageWidth-pdfMargin-50, pdfFooterY, "F1", 7, fmt.Sprintf("Page %d of %d", i+1, len(doc.pages)))
+	}
+	return doc.bytes(), nil
+}
+
+// layoutPDFReport writes the header block on the first page and flows the
+// table over as many pages as it needs, repeating the column headings.
+func layoutPDFReport(doc *pdfDocument, header []pdfLine, columns []reportPDFColumn, cells [][]string) {
+	tableWidth := float64(pdfPageWidth - 2*pdfMargin)
+	totalWeight := 0
+	for _, c := range columns {
+		totalWeight += c.Weight
+	}
+	xs := make([]float64, len(columns))
+	widths := make([]float64, len(columns))
+	x := float64(pdfMargin)
+	for i, c := range columns {
+		xs[i] = x
+		widths[i] = tableWidth * float64(c.Weight) / float64(totalWeight)
+		x += widths[i]
+	}
+
+	page := doc.newPage()
+	y := float64(pdfPageHeight - pdfMargin)
+	for _, l := range header {
+		y -= l.Size + 4
+		page.text(pdfMargin, y, l.Font, l.Size, l.Text)
+	}
+	y -= 10
+
+	tableHeader := func() {
+		y -= pdfTableLeading
+		for i, c := range columns {
+			page.text(xs[i], y, "F2", pdfTableSize, fitPDFText(c.Label, widths[i], pdfTableSize))
+		}
+		page.rule(pdfMargin, y-3, pdfPageWidth-pdfMargin, y-3)
+		y -= 3
+	}
+	tableHeader()
+	if len(cells) == 0 {
+		y -= pdfTableLeading
+		page.text(pdfMargin, y, "F1", pdfTableSize, "No Activity")
+		return
+	}
+	for _, row := range cells {
+		if y-pdfTableLeading < pdfFooterY+2*pdfTableLeading {
+			page = doc.newPage()
+			y = float64(pdfPageHeight - pdfMargin)
+			tableHeader()
+		}
+		y -= pdfTableLeading
+		for i, v := range row {
+			page.text(xs[i], y, "F1", pdfTableSize, fitPDFText(v, widths[i], pdfTableSize))
+		}
+	}
+}
+
+// fitPDFText truncates s to fit width at the given size, estimating
+// Helvetica's average glyph width as 0.55 em.
+func fitPDFText(s string, width, size float64) string {
+	maxChars := int((width - 4) / (size * 0.55))
+	if maxChars < 1 {
+		maxChars = 1
+	}
+	r := []rune(s)
+	if len(r) <= maxChars {
+		return s
+	}
+	if maxChars <= 3 {
+		return string(r[:maxChars])
+	}
+	return string(r[:maxChars-3]) + "..."
+}
+
+type pdfLine struct {
+	Font string
+	Size float64
+	Text string
+}
+
+// pdfDocument is a minimal PDF 1.4 writer: text in the standard Helvetica
+// fonts and horizontal rules, which is all the report layouts need. Output
+// is deterministic for the same content.
+type pdfDocument struct {
+	Title     string
+	CreatedAt string
+	pages     []*pdfPage
+}
+
+type pdfPage struct {
+	content bytes.Buffer
+}
+
+func (d *pdfDocument) newPage() *pdfPage {
+	p := &pdfPage{}
+	d.pages = append(d.pages, p)
+	return p
+}
+
+func (p *pdfPage) text(x, y float64, font string, size float64, s string) {
+	fmt.Fprintf(&p.content, "BT /%s %g Tf %.2f %.2f Td (%s) Tj ET\n", font, size, x, y, pdfEscape(s))
+}
+
+func (p *pdfPage) rule(x1, y1, x2, y2 float64) {
+	fmt.Fprintf(&p.content, "0.5 w %.2f %.2f m %.2f %.2f l S\n", x1, y1, x2, y2)
+}
+
+// pdfEscape encodes s as a PDF literal string body. Characters outside
+// printable ASCII are replaced, since the standard fonts carry no other
+// glyphs reliably.
+func pdfEscape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '\\' || r == '(' || r == ')':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r < 0x20 || r > 0x7e:
+			b.WriteByte('?')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// pdfDate formats an RFC 3339 timestamp as a PDF date string, or returns
+// "" when it does not parse.
+func pdfDate(ts string) string {
+	t, err := time.Parse(time.RFC3339Nano, ts)
+	if err != nil {
+		return ""
+	}
+	return t.UTC().Format("D:20060102150405Z")
+}
+
+func (d *pdfDocument) bytes() []byte {
+	if len(d.pages) == 0 {
+		d.newPage()
+	}
+	var buf bytes.Buffer
+	offsets := []int{}
+	obj := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 6+2*i)
+	}
+	obj("<< /Type /Catalog /Pages 2 0 R >>")
+	obj(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	obj("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	obj("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+	info := fmt.Sprintf("<< /Title (%s) /Producer (open-rgs-go)", pdfEscape(d.Title))
+	if date := pdfDate(d.CreatedAt); date != "" {
+		info += fmt.Sprintf(" /CreationDate (%s)", date)
+	}
+	obj(info + " >>")
+	for i, p := range d.pages {
+		obj(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>", pdfPageWidth, pdfPageHeight, 7+2*i))
+		obj(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", p.content.Len(), p.content.String()))
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R /Info 5 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+	return buf.Bytes()
+}
@@ -0,0 +1,112 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func TestReportingGeneratePDF(t *testing.T) {
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 12, 15, 0, 0, 0, time.UTC)}
+	reportingSvc := NewReportingService(clk, NewLedgerService(clk), NewEventsService(clk))
+
+	resp, err := reportingSvc.GenerateReport(context.Background(), &rgsv1.GenerateReportRequest{
+		Meta:       meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		ReportType: rgsv1.ReportType_REPORT_TYPE_CASHLESS_LIABILITY_SUMMARY,
+		Interval:   rgsv1.ReportInterval_REPORT_INTERVAL_DTD,
+		Format:     rgsv1.ReportFormat_REPORT_FORMAT_PDF,
+		OperatorId: "casino-1",
+	})
+	if err != nil {
+		t.Fatalf("generate report err: %v", err)
+	}
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || resp.ReportRun.ContentType != "application/pdf" {
+		t.Fatalf("unexpected response: meta=%+v content_type=%q", resp.Meta, resp.ReportRun.GetContentType())
+	}
+	content := resp.ReportRun.Content
+	if !bytes.HasPrefix(content, []byte("%PDF-1.4")) || !bytes.HasSuffix(content, []byte("%%EOF\n")) {
+		t.Fatalf("content is not a pdf document")
+	}
+	for _, want := range []string{"(Cashless Liability Summary)", "(Operator: casino-1)", "(No Activity)", "(Page 1 of 1)", "/CreationDate (D:20260212150000Z)"} {
+		if !bytes.Contains(content, []byte(want)) {
+			t.Fatalf("pdf missing %q", want)
+		}
+	}
+	assertPDFXref(t, content)
+}
+
+func TestPayloadToPDFPaginatesAndHashesContent(t *testing.T) {
+	rows := make([]map[string]any, 0, 120)
+	for i := 0; i < 120; i++ {
+		rows = append(rows, map[string]any{
+			"event_id":              fmt.Sprintf("ev-%03d", i),
+			"equipment_id":          "eq-1",
+			"event_code":            "E1",
+			"localized_description": "door (main) opened \\ reset",
+			"occurred_at":           "2026-02-12T14:00:00Z",
+		})
+	}
+	payload := map[string]any{
+		"operator_id":       "casino-1",
+		"report_title":      reportTitle(rgsv1.ReportType_REPORT_TYPE_SIGNIFICANT_EVENTS_ALTERATIONS),
+		"selected_interval": rgsv1.ReportInterval_REPORT_INTERVAL_DTD.String(),
+		"generated_at":      "2026-02-12T15:00:00Z",
+		"row_count":         len(rows),
+		"rows":              rows,
+	}
+	content, err := payloadToPDF(rgsv1.ReportType_REPORT_TYPE_SIGNIFICANT_EVENTS_ALTERATIONS, payload)
+	if err != nil {
+		t.Fatalf("render pdf: %v", err)
+	}
+	hash, _ := reportContentHash(payload)
+
+	pages := regexp.MustCompile(`\(Page (\d+) of (\d+)\)`).FindAllSubmatch(content, -1)
+	if len(pages) < 2 {
+		t.Fatalf("expected the table to span pages, got %d", len(pages))
+	}
+	if got := bytes.Count(content, []byte("SHA-256 "+hash)); got != len(pages) {
+		t.Fatalf("expected the verification hash on every page, got %d of %d", got, len(pages))
+	}
+	if !bytes.Contains(content, []byte("/Count "+strconv.Itoa(len(pages)))) {
+		t.Fatalf("page tree count does not match footers")
+	}
+	if !bytes.Contains(content, []byte("(ev-119)")) || !bytes.Contains(content, []byte(`door \(main\) opened \\`)) {
+		t.Fatalf("pdf missing escaped rows")
+	}
+	assertPDFXref(t, content)
+
+	if _, err := payloadToPDF(rgsv1.ReportType_REPORT_TYPE_UNSPECIFIED, payload); err == nil {
+		t.Fatalf("expected an error for a report type without a template")
+	}
+}
+
+// assertPDFXref checks that startxref and every xref entry point at the
+// objects they name.
+func assertPDFXref(t *testing.T, content []byte) {
+	t.Helper()
+	m := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(content)
+	if m == nil {
+		t.Fatalf("missing startxref")
+	}
+	xref, _ := strconv.Atoi(string(m[1]))
+	if !bytes.HasPrefix(content[xref:], []byte("xref\n")) {
+		t.Fatalf("startxref does not point at the xref table")
+	}
+	lines := strings.Split(string(content[xref:]), "\n")
+	for i, line := range lines[3:] {
+		if !strings.HasSuffix(line, " n ") {
+			break
+		}
+		off, _ := strconv.Atoi(line[:10])
+		if want := fmt.Sprintf("%d 0 obj", i+1); !bytes.HasPrefix(content[off:], []byte(want)) {
+			t.Fatalf("xref entry %d points at %q", i+1, content[off:off+10])
+		}
+	}
+}
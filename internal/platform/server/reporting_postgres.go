@@ -21,10 +21,12 @@ func (s *ReportingService) persistReportRun(ctx context.Context, meta *rgsv1.Req
 	const q = `
 INSERT INTO report_runs (
   report_run_id, report_type, report_interval, report_format, status, operator_id,
-  report_title, generated_at, no_activity, content_type, content, request_id, actor_id, actor_type
+  report_title, generated_at, no_activity, content_type, content, request_id, actor_id, actor_type,
+  failure_reason, period_start, period_end, content_sha256, signer_kid, signature, signature_alg
 )
 VALUES (
-  $1,$2,$3,$4,$5::report_run_status,$6,$7,$8::timestamptz,$9,$10,$11,$12,$13,$14
+  $1,$2,$3,$4,$5::report_run_status,$6,$7,$8::timestamptz,$9,$10,$11,$12,$13,$14,$15,
+  NULLIF($16, '')::timestamptz,NULLIF($17, '')::timestamptz,$18,$19,$20,$21
 )
 ON CONFLICT (report_run_id) DO UPDATE SET
   status = EXCLUDED.status,
@@ -36,8 +38,20 @@ ON CONFLICT (report_run_id) DO UPDATE SET
   content = EXCLUDED.content,
   request_id = EXCLUDED.request_id,
   actor_id = EXCLUDED.actor_id,
-  actor_type = EXCLUDED.actor_type
+  actor_type = EXCLUDED.actor_type,
+  failure_reason = EXCLUDED.failure_reason,
+  period_start = EXCLUDED.period_start,
+  period_end = EXCLUDED.period_end,
+  content_sha256 = EXCLUDED.content_sha256,
+  signer_kid = EXCLUDED.signer_kid,
+  signature = EXCLUDED.signature,
+  signature_alg = EXCLUDED.signature_alg
 `
+	content := run.Content
+	if content == nil {
+		// Pending and failed runs have no content yet; the column is NOT NULL.
+		content = []byte{}
+	}
 	_, err := s.db.ExecContext(ctx, q,
 		run.ReportRunId,
 		reportTypeToDB(run.ReportType),
@@ -49,15 +63,22 @@ ON CONFLICT (report_run_id) DO UPDATE SET
 		nonEmptyTime(run.GeneratedAt),
 		run.NoActivity,
 		run.ContentType,
-		run.Content,
+		content,
 		requestID(meta),
 		actorID,
 		actorType,
+		run.FailureReason,
+		run.PeriodStart,
+		run.PeriodEnd,
+		run.ContentSha256,
+		run.SignerKid,
+		run.Signature,
+		run.SignatureAlg,
 	)
 	return err
 }
 
-func (s *ReportingService) listReportRunsFromDB(ctx context.Context, filter rgsv1.ReportType, limit, offset int) ([]*rgsv1.ReportRun, error) {
+func (s *ReportingService) listReportRunsFromDB(ctx context.Context, filter rgsv1.ReportType, operators []string, scoped bool, limit, offset int) ([]*rgsv1.ReportRun, error) {
 	if s == nil || s.db == nil {
 		return nil, nil
 	}
@@ -67,13 +88,18 @@ func (s *ReportingService) listReportRunsFromDB(ctx context.Context, filter rgsv
 	}
 	const q = `
 SELECT report_run_id, report_type, report_interval, report_format, status::text,
-       operator_id, report_title, generated_at, no_activity, content_type, content
+       operator_id, report_title, generated_at, no_activity, content_type, content, failure_reason,
+       period_start, period_end, content_sha256, signer_kid, signature, signature_alg, content_purged_at
 FROM report_runs
 WHERE ($1 = '' OR report_type = $1)
+  AND (NOT $4 OR operator_id = ANY($5::text[]))
 ORDER BY generated_at DESC, report_run_id DESC
 LIMIT $2 OFFSET $3
 `
-	rows, err := s.db.QueryContext(ctx, q, reportType, limit, offset)
+	if operators == nil {
+		operators = []string{}
+	}
+	rows, err := s.db.QueryContext(ctx, q, reportType, limit, offset, scoped, operators)
 	if err != nil {
 		return nil, err
 	}
@@ -82,29 +108,40 @@ LIMIT $2 OFFSET $3
 	out := make([]*rgsv1.ReportRun, 0, limit)
 	for rows.Next() {
 		var (
-			runID, typ, interval, format, status, operatorID, title, contentType string
-			generatedAt                                                          time.Time
-			noActivity                                                           bool
-			content                                                              []byte
+			runID, typ, interval, format, status, operatorID, title, contentType, failureReason string
+			contentSHA256, signerKID, signature, signatureAlg                                   string
+			generatedAt                                                                         time.Time
+			periodStart, periodEnd, contentPurgedAt                                             sql.NullTime
+			noActivity                                                                          bool
+			content                                                                             []byte
 		)
 		if err := rows.Scan(
 			&runID, &typ, &interval, &format, &status,
-			&operatorID, &title, &generatedAt, &noActivity, &contentType, &content,
+			&operatorID, &title, &generatedAt, &noActivity, &contentType, &content, &failureReason,
+			&periodStart, &periodEnd, &contentSHA256, &signerKID, &signature, &signatureAlg, &contentPurgedAt,
 		); err != nil {
 			return nil, err
 		}
 		out = append(out, &rgsv1.ReportRun{
-			ReportRunId: runID,
-			ReportType:  reportTypeFromDB(typ),
-			Interval:    reportIntervalFromDB(interval),
-			Format:      reportFormatFromDB(format),
-			Status:      reportStatusFromDB(status),
-			OperatorId:  operatorID,
-			ReportTitle: title,
-			GeneratedAt: generatedAt.UTC().Format(time.RFC3339Nano),
-			NoActivity:  noActivity,
-			ContentType: contentType,
-			Content:     content,
+			ReportRunId:     runID,
+			ReportType:      reportTypeFromDB(typ),
+			Interval:        reportIntervalFromDB(interval),
+			Format:          reportFormatFromDB(format),
+			Status:          reportStatusFromDB(status),
+			OperatorId:      operatorID,
+			ReportTitle:     title,
+			GeneratedAt:     generatedAt.UTC().Format(time.RFC3339Nano),
+			NoActivity:      noActivity,
+			ContentType:     contentType,
+			Content:         content,
+			FailureReason:   failureReason,
+			PeriodStart:     formatNullTime(periodStart),
+			PeriodEnd:       formatNullTime(periodEnd),
+			ContentSha256:   contentSHA256,
+			SignerKid:       signerKID,
+			Signature:       signature,
+			SignatureAlg:    signatureAlg,
+			ContentPurgedAt: formatNullTime(contentPurgedAt),
 		})
 	}
 	return out, rows.Err()
@@ -116,19 +153,23 @@ func (s *ReportingService) getReportRunFromDB(ctx context.Context, reportRunID s
 	}
 	const q = `
 SELECT report_run_id, report_type, report_interval, report_format, status::text,
-       operator_id, report_title, generated_at, no_activity, content_type, content
+       operator_id, report_title, generated_at, no_activity, content_type, content, failure_reason,
+       period_start, period_end, content_sha256, signer_kid, signature, signature_alg, content_purged_at
 FROM report_runs
 WHERE report_run_id = $1
 `
 	var (
-		runID, typ, interval, format, status, operatorID, title, contentType string
-		generatedAt                                                          time.Time
-		noActivity                                                           bool
-		content                                                              []byte
+		runID, typ, interval, format, status, operatorID, title, contentType, failureReason string
+		contentSHA256, signerKID, signature, signatureAlg                                   string
+		generatedAt                                                                         time.Time
+		periodStart, periodEnd, contentPurgedAt                                             sql.NullTime
+		noActivity                                                                          bool
+		content                                                                             []byte
 	)
 	err := s.db.QueryRowContext(ctx, q, reportRunID).Scan(
 		&runID, &typ, &interval, &format, &status,
-		&operatorID, &title, &generatedAt, &noActivity, &contentType, &content,
+		&operatorID, &title, &generatedAt, &noActivity, &contentType, &content, &failureReason,
+		&periodStart, &periodEnd, &contentSHA256, &signerKID, &signature, &signatureAlg, &contentPurgedAt,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -137,34 +178,42 @@ WHERE report_run_id = $1
 		return nil, err
 	}
 	return &rgsv1.ReportRun{
-		ReportRunId: runID,
-		ReportType:  reportTypeFromDB(typ),
-		Interval:    reportIntervalFromDB(interval),
-		Format:      reportFormatFromDB(format),
-		Status:      reportStatusFromDB(status),
-		OperatorId:  operatorID,
-		ReportTitle: title,
-		GeneratedAt: generatedAt.UTC().Format(time.RFC3339Nano),
-		NoActivity:  noActivity,
-		ContentType: contentType,
-		Content:     content,
+		ReportRunId:     runID,
+		ReportType:      reportTypeFromDB(typ),
+		Interval:        reportIntervalFromDB(interval),
+		Format:          reportFormatFromDB(format),
+		Status:          reportStatusFromDB(status),
+		OperatorId:      operatorID,
+		ReportTitle:     title,
+		GeneratedAt:     generatedAt.UTC().Format(time.RFC3339Nano),
+		NoActivity:      noActivity,
+		ContentType:     contentType,
+		Content:         content,
+		FailureReason:   failureReason,
+		PeriodStart:     formatNullTime(periodStart),
+		PeriodEnd:       formatNullTime(periodEnd),
+		ContentSha256:   contentSHA256,
+		SignerKid:       signerKID,
+		Signature:       signature,
+		SignatureAlg:    signatureAlg,
+		ContentPurgedAt: formatNullTime(contentPurgedAt),
 	}, nil
 }
 
-func (s *ReportingService) fetchSignificantEventsRows(now time.Time, interval rgsv1.ReportInterval) ([]map[string]any, error) {
+func (s *ReportingService) fetchSignificantEventsRows(w reportWindow) ([]map[string]any, error) {
 	if s == nil || s.db == nil {
 		return nil, nil
 	}
-	start := intervalStart(now, interval)
 	const q = `
 SELECT event_id, equipment_id, event_code, localized_description, severity,
        occurred_at, received_at, recorded_at
 FROM significant_events
 WHERE ($1::timestamptz IS NULL OR occurred_at >= $1::timestamptz)
   AND ($2::timestamptz IS NULL OR occurred_at <= $2::timestamptz)
+  AND (NOT $3 OR equipment_id = ANY($4::text[]))
 ORDER BY occurred_at ASC, event_id ASC
 `
-	rows, err := s.db.QueryContext(context.Background(), q, nullTime(start), now.UTC())
+	rows, err := s.reportDB().QueryContext(context.Background(), q, nullTime(w.start), w.end.UTC(), w.equipment != nil, w.equipment.ids())
 	if err != nil {
 		return nil, err
 	}
@@ -191,51 +240,46 @@ ORDER BY occurred_at ASC, event_id ASC
 	return out, rows.Err()
 }
 
-func (s *ReportingService) fetchCashlessLiabilityRows() ([]map[string]any, int64, int64, error) {
+func (s *ReportingService) fetchCashlessLiabilityRows() ([]map[string]any, *liabilityTotals, error) {
 	if s == nil || s.db == nil {
-		return nil, 0, 0, nil
+		return nil, nil, nil
 	}
 	const q = `
 SELECT account_id, currency_code, available_balance_minor, pending_balance_minor
 FROM ledger_accounts
+WHERE account_type = 'player_cashless'
 ORDER BY account_id ASC
 `
-	rows, err := s.db.QueryContext(context.Background(), q)
+	rows, err := s.reportDB().QueryContext(context.Background(), q)
 	if err != nil {
-		return nil, 0, 0, err
+		return nil, nil, err
 	}
 	defer rows.Close()
 
 	out := make([]map[string]any, 0)
-	var totalAvailable int64
-	var totalPending int64
+	totals := newLiabilityTotals()
 	for rows.Next() {
 		var accountID, currency string
 		var available, pending int64
 		if err := rows.Scan(&accountID, &currency, &available, &pending); err != nil {
-			return nil, 0, 0, err
+			return nil, nil, err
 		}
-		out = append(out, map[string]any{
-			"account_id": accountID,
-			"currency":   currency,
-			"available":  available,
-			"pending":    pending,
-			"total":      available + pending,
-		})
-		totalAvailable += available
-		totalPending += pending
+		row, err := totals.add(accountID, strings.TrimSpace(currency), available, pending)
+		if err != nil {
+			return nil, nil, err
+		}
+		out = append(out, row)
 	}
 	if err := rows.Err(); err != nil {
-		return nil, 0, 0, err
+		return nil, nil, err
 	}
-	return out, totalAvailable, totalPending, nil
+	return out, totals, nil
 }
 
-func (s *ReportingService) fetchAccountTransactionStatementRows(now time.Time, interval rgsv1.ReportInterval) ([]map[string]any, error) {
+func (s *ReportingService) fetchAccountTransactionStatementRows(w reportWindow) ([]map[string]any, error) {
 	if s == nil || s.db == nil {
 		return nil, nil
 	}
-	start := intervalStart(now, interval)
 	const q = `
 SELECT transaction_id, account_id, transaction_type::text, amount_minor, currency_code, occurred_at, authorization_id
 FROM ledger_transactions
@@ -243,7 +287,7 @@ WHERE ($1::timestamptz IS NULL OR occurred_at >= $1::timestamptz)
   AND ($2::timestamptz IS NULL OR occurred_at <= $2::timestamptz)
 ORDER BY occurred_at ASC, transaction_id ASC
 `
-	rows, err := s.db.QueryContext(context.Background(), q, nullTime(start), now.UTC())
+	rows, err := s.reportDB().QueryContext(context.Background(), q, nullTime(w.start), w.end.UTC())
 	if err != nil {
 		return nil, err
 	}
@@ -278,6 +322,12 @@ func reportTypeToDB(v rgsv1.ReportType) string {
 		return "cashless_liability_summary"
 	case rgsv1.ReportType_REPORT_TYPE_ACCOUNT_TRANSACTION_STATEMENT:
 		return "account_transaction_statement"
+	case rgsv1.ReportType_REPORT_TYPE_RTP_SUMMARY:
+		return "rtp_summary"
+	case rgsv1.ReportType_REPORT_TYPE_PROMO_LIABILITY:
+		return "promo_liability"
+	case rgsv1.ReportType_REPORT_TYPE_EFT_ACTIVITY:
+		return "eft_activity"
 	default:
 		return "unknown"
 	}
@@ -291,6 +341,12 @@ func reportTypeFromDB(v string) rgsv1.ReportType {
 		return rgsv1.ReportType_REPORT_TYPE_CASHLESS_LIABILITY_SUMMARY
 	case "account_transaction_statement":
 		return rgsv1.ReportType_REPORT_TYPE_ACCOUNT_TRANSACTION_STATEMENT
+	case "rtp_summary":
+		return rgsv1.ReportType_REPORT_TYPE_RTP_SUMMARY
+	case "promo_liability":
+		return rgsv1.ReportType_REPORT_TYPE_PROMO_LIABILITY
+	case "eft_activity":
+		return rgsv1.ReportType_REPORT_TYPE_EFT_ACTIVITY
 	default:
 		return rgsv1.ReportType_REPORT_TYPE_UNSPECIFIED
 	}
@@ -332,6 +388,8 @@ func reportFormatToDB(v rgsv1.ReportFormat) string {
 		return "json"
 	case rgsv1.ReportFormat_REPORT_FORMAT_CSV:
 		return "csv"
+	case rgsv1.ReportFormat_REPORT_FORMAT_PDF:
+		return "pdf"
 	default:
 		return "json"
 	}
@@ -343,6 +401,8 @@ func reportFormatFromDB(v string) rgsv1.ReportFormat {
 		return rgsv1.ReportFormat_REPORT_FORMAT_JSON
 	case "csv":
 		return rgsv1.ReportFormat_REPORT_FORMAT_CSV
+	case "pdf":
+		return rgsv1.ReportFormat_REPORT_FORMAT_PDF
 	default:
 		return rgsv1.ReportFormat_REPORT_FORMAT_UNSPECIFIED
 	}
@@ -354,6 +414,10 @@ func reportStatusToDB(v rgsv1.ReportRunStatus) string {
 		return "completed"
 	case rgsv1.ReportRunStatus_REPORT_RUN_STATUS_FAILED:
 		return "failed"
+	case rgsv1.ReportRunStatus_REPORT_RUN_STATUS_PENDING:
+		return "pending"
+	case rgsv1.ReportRunStatus_REPORT_RUN_STATUS_RUNNING:
+		return "running"
 	default:
 		return "completed"
 	}
@@ -365,6 +429,10 @@ func reportStatusFromDB(v string) rgsv1.ReportRunStatus {
 		return rgsv1.ReportRunStatus_REPORT_RUN_STATUS_COMPLETED
 	case "failed":
 		return rgsv1.ReportRunStatus_REPORT_RUN_STATUS_FAILED
+	case "pending":
+		return rgsv1.ReportRunStatus_REPORT_RUN_STATUS_PENDING
+	case "running":
+		return rgsv1.ReportRunStatus_REPORT_RUN_STATUS_RUNNING
 	default:
 		return rgsv1.ReportRunStatus_REPORT_RUN_STATUS_UNSPECIFIED
 	}
@@ -383,3 +451,167 @@ func nullTime(v time.Time) any {
 	}
 	return v.UTC()
 }
+
+func formatNullTime(v sql.NullTime) string {
+	if !v.Valid {
+		return ""
+	}
+	return v.Time.UTC().Format(time.RFC3339Nano)
+}
+
+func (s *ReportingService) insertReportRunDeliveryDB(ctx context.Context, runID, target string) error {
+	const q = `
+INSERT INTO report_run_deliveries (report_run_id, target)
+VALUES ($1, $2)
+ON CONFLICT (report_run_id, target) DO NOTHING
+`
+	_, err := s.db.ExecContext(ctx, q, runID, target)
+	return err
+}
+
+func (s *ReportingService) updateReportRunDeliveryDB(ctx context.Context, runID string, d *rgsv1.ReportRunDelivery) error {
+	const q = `
+UPDATE report_run_deliveries
+SET delivered = $3,
+    attempts = $4,
+    last_attempted_at = NULLIF($5, '')::timestamptz,
+    last_error = $6,
+    delivered_at = NULLIF($7, '')::timestamptz
+WHERE report_run_id = $1 AND target = $2
+`
+	_, err := s.db.ExecContext(ctx, q, runID, d.Target, d.Delivered, d.Attempts, d.LastAttemptedAt, d.LastError, d.DeliveredAt)
+	return err
+}
+
+func (s *ReportingService) pendingReportDeliveriesFromDB(ctx context.Context, maxAttempts, limit int) ([]reportDeliveryKey, error) {
+	const q = `
+SELECT report_run_id, target
+FROM report_run_deliveries
+WHERE NOT delivered AND attempts < $1
+ORDER BY created_at ASC, report_run_id ASC, target ASC
+LIMIT $2
+`
+	rows, err := s.db.QueryContext(ctx, q, maxAttempts, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]reportDeliveryKey, 0)
+	for rows.Next() {
+		var key reportDeliveryKey
+		if err := rows.Scan(&key.runID, &key.target); err != nil {
+			return nil, err
+		}
+		out = append(out, key)
+	}
+	return out, rows.Err()
+}
+
+const reportRunDeliveryColumns = `target, delivered, attempts, last_attempted_at, last_error, delivered_at`
+
+func scanReportRunDelivery(row interface{ Scan(...any) error }) (*rgsv1.ReportRunDelivery, error) {
+	var (
+		d                        rgsv1.ReportRunDelivery
+		lastAttempt, deliveredAt sql.NullTime
+	)
+	if err := row.Scan(&d.Target, &d.Delivered, &d.Attempts, &lastAttempt, &d.LastError, &deliveredAt); err != nil {
+		return nil, err
+	}
+	if lastAttempt.Valid {
+		d.LastAttemptedAt = lastAttempt.Time.UTC().Format(time.RFC3339Nano)
+	}
+	if deliveredAt.Valid {
+		d.DeliveredAt = deliveredAt.Time.UTC().Format(time.RFC3339Nano)
+	}
+	return &d, nil
+}
+
+func (s *ReportingService) getReportRunDeliveryFromDB(ctx context.Context, runID, target string) (*rgsv1.ReportRunDelivery, error) {
+	q := `SELECT ` + reportRunDeliveryColumns + ` FROM report_run_deliveries WHERE report_run_id = $1 AND target = $2`
+	d, err := scanReportRunDelivery(s.db.QueryRowContext(ctx, q, runID, target))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return d, err
+}
+
+func (s *ReportingService) listReportRunDeliveriesFromDB(ctx context.Context, runID string) ([]*rgsv1.ReportRunDelivery, error) {
+	q := `SELECT ` + reportRunDeliveryColumns + ` FROM report_run_deliveries WHERE report_run_id = $1 ORDER BY created_at ASC, target ASC`
+	rows, err := s.db.QueryContext(ctx, q, runID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]*rgsv1.ReportRunDelivery, 0)
+	for rows.Next() {
+		d, err := scanReportRunDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, d)
+	}
+	return out, rows.Err()
+}
+
+func (s *ReportingService) fetchEFTActivityEntries(ctx context.Context, w reportWindow) ([]eftActivityEntry, error) {
+	const q = `
+SELECT account_id, transaction_type::text, transaction_id, amount_minor, currency_code, occurred_at, authorization_id
+FROM ledger_transactions
+WHERE transaction_type IN ('deposit', 'withdrawal', 'transfer_to_device', 'transfer_to_account')
+  AND ($1::timestamptz IS NULL OR occurred_at >= $1::timestamptz)
+  AND ($2::timestamptz IS NULL OR occurred_at <= $2::timestamptz)
+UNION ALL
+SELECT object_id,
+       CASE WHEN action = 'eft_lockout' THEN 'lockout' ELSE 'denial' END,
+       audit_id, 0, '', occurred_at,
+       CASE WHEN action = 'eft_lockout' THEN reason ELSE action || ': ' || reason END
+FROM audit_events
+WHERE object_type = 'ledger_account' AND object_id <> ''
+  AND (action = 'eft_lockout'
+       OR (result = 'denied' AND action IN ('deposit', 'withdraw', 'transfer_to_device', 'transfer_to_account')))
+  AND ($1::timestamptz IS NULL OR occurred_at >= $1::timestamptz)
+  AND ($2::timestamptz IS NULL OR occurred_at <= $2::timestamptz)
+ORDER BY 6, 3
+`
+	rows, err := s.reportDB().QueryContext(ctx, q, nullTime(w.start), w.end.UTC())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]eftActivityEntry, 0)
+	for rows.Next() {
+		var e eftActivityEntry
+		if err := rows.Scan(&e.accountID, &e.entryType, &e.reference, &e.amount, &e.currency, &e.occurredAt, &e.detail); err != nil {
+			return nil, err
+		}
+		e.currency = strings.TrimSpace(e.currency)
+		e.occurredAt = e.occurredAt.UTC()
+		out = append(out, e)
+	}
+	return out, rows.Err()
+}
+
+func (s *ReportingService) fetchRAMClearIncidents(w reportWindow) ([]*rgsv1.RamClearIncident, error) {
+	q := `SELECT ` + ramClearIncidentColumns + `
+FROM ram_clear_incidents
+WHERE ($1::timestamptz IS NULL OR occurred_at >= $1::timestamptz)
+  AND occurred_at <= $2::timestamptz
+  AND (NOT $3 OR equipment_id = ANY($4::text[]))
+ORDER BY occurred_at ASC, event_id ASC
+`
+	rows, err := s.reportDB().QueryContext(context.Background(), q, nullTime(w.start), w.end.UTC(), w.equipment != nil, w.equipment.ids())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]*rgsv1.RamClearIncident, 0)
+	for rows.Next() {
+		inc, err := scanRAMClearIncident(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, inc)
+	}
+	return out, rows.Err()
+}
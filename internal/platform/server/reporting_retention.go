@@ -0,0 +1,157 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+// SetReportRetention sets how long completed report runs keep their content.
+// ReportRetentionJob purges the content of older runs; their metadata,
+// content hash, and signature are kept. Zero keeps content forever.
+func (s *ReportingService) SetReportRetention(d time.Duration) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.retention = d
+}
+
+// reportDeliveryPendingLocked reports whether a run still has a delivery
+// that will be retried. s.mu must be held.
+func (s *ReportingService) reportDeliveryPendingLocked(runID string) bool {
+	for _, d := range s.deliveries[runID] {
+		if !d.Delivered && int(d.Attempts) < s.deliveryCfg.MaxAttempts {
+			return true
+		}
+	}
+	return false
+}
+
+// purgeInMemoryReportContent drops the content of cached runs generated
+// before cutoff.
+func (s *ReportingService) purgeInMemoryReportContent(cutoff time.Time) workerBatch {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var b workerBatch
+	purgedAt := s.now().Format(time.RFC3339Nano)
+	for _, id := range s.runOrder {
+		run := s.runs[id]
+		if run == nil || run.Status != rgsv1.ReportRunStatus_REPORT_RUN_STATUS_COMPLETED || run.ContentPurgedAt != "" {
+			continue
+		}
+		generatedAt := parseTS(run.GeneratedAt)
+		if !generatedAt.Before(cutoff) || s.reportDeliveryPendingLocked(id) {
+			continue
+		}
+		run.Content = nil
+		run.ContentPurgedAt = purgedAt
+		b.affected++
+		if b.from.IsZero() || generatedAt.Before(b.from) {
+			b.from = generatedAt
+		}
+		if generatedAt.After(b.to) {
+			b.to = generatedAt
+		}
+	}
+	return b
+}
+
+func (s *ReportingService) purgeReportContentBatch(ctx context.Context, cutoff time.Time, batchSize int) (workerBatch, error) {
+	if s.db == nil {
+		return workerBatch{}, nil
+	}
+	s.mu.Lock()
+	maxAttempts := s.deliveryCfg.MaxAttempts
+	s.mu.Unlock()
+	const q = `
+WITH doomed AS (
+  SELECT r.report_run_id
+  FROM report_runs r
+  WHERE r.status = 'completed'
+    AND r.content_purged_at IS NULL
+    AND r.generated_at < $1
+    AND NOT EXISTS (
+      SELECT 1 FROM report_run_deliveries d
+      WHERE d.report_run_id = r.report_run_id AND NOT d.delivered AND d.attempts < $3
+    )
+  ORDER BY r.generated_at ASC
+  LIMIT $2
+  FOR UPDATE SKIP LOCKED
+), purged AS (
+  UPDATE report_runs
+  SET content = ''::bytea, content_purged_at = $4
+  WHERE report_run_id IN (SELECT report_run_id FROM doomed)
+  RETURNING generated_at
+)
+SELECT COUNT(*), COALESCE(MIN(generated_at), to_timestamp(0)), COALESCE(MAX(generated_at), to_timestamp(0))
+FROM purged
+`
+	var b workerBatch
+	if err := s.db.QueryRowContext(ctx, q, cutoff.UTC(), batchSize, maxAttempts, s.now().UTC()).Scan(&b.affected, &b.from, &b.to); err != nil {
+		return workerBatch{}, err
+	}
+	return b, nil
+}
+
+// ReportRetentionJob purges the content of completed report runs older than
+// the retention period, in batches, and audits what it purged under the
+// system actor. Runs with deliveries still being retried are kept until
+// those finish. observer is called after every batch.
+func (s *ReportingService) ReportRetentionJob(batchSize int, observer func(purged int64, err error)) JobFunc {
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	return func(ctx context.Context, _ string) (string, error) {
+		s.mu.Lock()
+		retention := s.retention
+		s.mu.Unlock()
+		if retention <= 0 {
+			return "", nil
+		}
+		cutoff := s.now().Add(-retention)
+		summary := newWorkerAuditSummary("report_retention_purge", "generated_at")
+		cached := s.purgeInMemoryReportContent(cutoff)
+		if s.db == nil {
+			if observer != nil {
+				observer(cached.affected, nil)
+			}
+			summary.add(cached)
+		}
+		for s.db != nil {
+			b, err := s.purgeReportContentBatch(ctx, cutoff, batchSize)
+			if observer != nil {
+				observer(b.affected, err)
+			}
+			if err != nil {
+				if auditErr := s.auditRetentionRun(summary); auditErr != nil {
+					return "", errors.Join(err, auditErr)
+				}
+				return "", err
+			}
+			summary.add(b)
+			if b.affected < int64(batchSize) {
+				break
+			}
+		}
+		if err := s.auditRetentionRun(summary); err != nil {
+			return "", fmt.Errorf("audit unavailable: %w", err)
+		}
+		if summary.Affected == 0 {
+			return "", nil
+		}
+		return fmt.Sprintf("purged content of %d report runs", summary.Affected), nil
+	}
+}
+
+func (s *ReportingService) auditRetentionRun(summary *workerAuditSummary) error {
+	if summary.Affected == 0 {
+		return nil
+	}
+	return s.appendAudit(nil, "report_runs", summary.Job, []byte(`{}`), summary.snapshot(), audit.ResultSuccess, "")
+}
@@ -0,0 +1,67 @@
+package server
+
+import (
+	"context"
+	"crypto/ed25519"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func TestReportRetentionPurgesOldContent(t *testing.T) {
+	start := time.Date(2026, 1, 5, 12, 0, 0, 0, time.UTC)
+	svc := NewReportingService(ledgerFixedClock{now: start}, NewLedgerService(ledgerFixedClock{now: start}), NewEventsService(ledgerFixedClock{now: start}))
+	_, priv, _ := ed25519.GenerateKey(nil)
+	svc.SetReportRunSigner("report-k1", priv)
+	oldRun := generateSignedRun(t, svc, rgsv1.ReportFormat_REPORT_FORMAT_CSV)
+	svc.Clock = ledgerFixedClock{now: start.Add(40 * 24 * time.Hour)}
+	newRun := generateSignedRun(t, svc, rgsv1.ReportFormat_REPORT_FORMAT_CSV)
+
+	var observed int64
+	job := svc.ReportRetentionJob(100, func(purged int64, err error) {
+		if err != nil {
+			t.Fatalf("unexpected purge error: %v", err)
+		}
+		observed += purged
+	})
+	if detail, err := job(context.Background(), ""); err != nil || detail != "" {
+		t.Fatalf("expected no purge without retention: detail=%q err=%v", detail, err)
+	}
+	svc.SetReportRetention(30 * 24 * time.Hour)
+	detail, err := job(context.Background(), "")
+	if err != nil || detail != "purged content of 1 report runs" || observed != 1 {
+		t.Fatalf("unexpected purge result: detail=%q err=%v observed=%d", detail, err, observed)
+	}
+
+	got, _ := svc.GetReportRun(context.Background(), &rgsv1.GetReportRunRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), ReportRunId: oldRun.ReportRunId})
+	purged := got.GetReportRun()
+	if len(purged.GetContent()) != 0 || purged.GetContentPurgedAt() == "" || purged.GetContentSha256() != oldRun.ContentSha256 || purged.GetSignature() != oldRun.Signature {
+		t.Fatalf("expected content purged with metadata kept: %+v", purged)
+	}
+	got, _ = svc.GetReportRun(context.Background(), &rgsv1.GetReportRunRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), ReportRunId: newRun.ReportRunId})
+	if len(got.GetReportRun().GetContent()) == 0 || got.GetReportRun().GetContentPurgedAt() != "" {
+		t.Fatalf("expected recent run to keep its content: %+v", got.GetReportRun())
+	}
+
+	resp, _ := svc.VerifyReportRun(context.Background(), &rgsv1.VerifyReportRunRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), ReportRunId: oldRun.ReportRunId})
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID || resp.Meta.GetDenialReason() != "report content purged; supply the exported content" {
+		t.Fatalf("expected purged run without content to be rejected: %+v", resp.Meta)
+	}
+	if exported := verifyRun(t, svc, oldRun.ReportRunId, oldRun.Content); !exported.Verified {
+		t.Fatalf("expected exported copy of purged run to verify: %+v", exported)
+	}
+
+	var audited bool
+	for _, ev := range auditEvents(svc.AuditStore) {
+		if ev.Action == "report_retention_purge" && ev.ObjectID == "report_runs" {
+			audited = true
+		}
+	}
+	if !audited {
+		t.Fatalf("expected purge run to be audited")
+	}
+	if detail, err := job(context.Background(), ""); err != nil || detail != "" {
+		t.Fatalf("expected purge to be idempotent: detail=%q err=%v", detail, err)
+	}
+}
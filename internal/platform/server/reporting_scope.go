@@ -0,0 +1,70 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+// ParseReportOperatorScopes parses "actor_id=operator_id|operator_id"
+// entries separated by commas, e.g. "op-lv-1=casino-lv,op-group=casino-lv|casino-mt".
+func ParseReportOperatorScopes(spec string) (map[string][]string, error) {
+	out := make(map[string][]string)
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		actorID, rest, ok := strings.Cut(entry, "=")
+		actorID = strings.TrimSpace(actorID)
+		if !ok || actorID == "" {
+			return nil, fmt.Errorf("invalid report operator scope %q: want actor_id=operator_id|operator_id", entry)
+		}
+		for _, operatorID := range strings.Split(rest, "|") {
+			if operatorID = strings.TrimSpace(operatorID); operatorID != "" {
+				out[actorID] = append(out[actorID], operatorID)
+			}
+		}
+		if len(out[actorID]) == 0 {
+			return nil, fmt.Errorf("report operator scope for %s lists no operators", actorID)
+		}
+	}
+	return out, nil
+}
+
+// SetOperatorScopes limits the listed actors to report runs for their
+// operators. Actors without an entry may report on any operator.
+func (s *ReportingService) SetOperatorScopes(scopes map[string][]string) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.operatorScopes = scopes
+}
+
+// operatorScope returns the operators the caller may report on; scoped is
+// false when the caller is not limited.
+func (s *ReportingService) operatorScope(ctx context.Context, meta *rgsv1.RequestMeta) (operators []string, scoped bool) {
+	actor, reason := resolveActor(ctx, meta)
+	if reason != "" {
+		return nil, true
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	operators, scoped = s.operatorScopes[actor.ActorId]
+	return operators, scoped
+}
+
+// authorizeOperator reports whether the caller may generate or read report
+// runs for operatorID.
+func (s *ReportingService) authorizeOperator(ctx context.Context, meta *rgsv1.RequestMeta, operatorID string) (bool, string) {
+	operators, scoped := s.operatorScope(ctx, meta)
+	if scoped && !slices.Contains(operators, operatorID) {
+		return false, "operator outside report scope"
+	}
+	return true, ""
+}
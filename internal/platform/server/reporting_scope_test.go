@@ -0,0 +1,93 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+func TestParseReportOperatorScopes(t *testing.T) {
+	scopes, err := ParseReportOperatorScopes(" op-lv-1=casino-lv, op-group=casino-lv|casino-mt ,")
+	if err != nil {
+		t.Fatalf("parse scopes: %v", err)
+	}
+	if len(scopes) != 2 || len(scopes["op-lv-1"]) != 1 || scopes["op-group"][1] != "casino-mt" {
+		t.Fatalf("unexpected scopes: %+v", scopes)
+	}
+	for _, bad := range []string{"casino-lv", "=casino-lv", "op-1=", "op-1=|"} {
+		if _, err := ParseReportOperatorScopes(bad); err == nil {
+			t.Fatalf("expected %q to be rejected", bad)
+		}
+	}
+}
+
+func countDeniedAudits(svc *ReportingService, action string) int {
+	n := 0
+	for _, ev := range auditEvents(svc.AuditStore) {
+		if ev.Action == action && ev.Result == audit.ResultDenied {
+			n++
+		}
+	}
+	return n
+}
+
+func TestReportRunsScopedToOperator(t *testing.T) {
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 18, 12, 0, 0, 0, time.UTC)}
+	svc := NewReportingService(clk, NewLedgerService(clk), NewEventsService(clk))
+	svc.SetOperatorScopes(map[string][]string{"op-lv": {"casino-lv"}})
+	ctx := context.Background()
+	lvMeta := meta("op-lv", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+	adminMeta := meta("op-admin", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+
+	generate := func(m *rgsv1.RequestMeta, operatorID string) *rgsv1.GenerateReportResponse {
+		resp, _ := svc.GenerateReport(ctx, &rgsv1.GenerateReportRequest{
+			Meta:       m,
+			ReportType: rgsv1.ReportType_REPORT_TYPE_CASHLESS_LIABILITY_SUMMARY,
+			Interval:   rgsv1.ReportInterval_REPORT_INTERVAL_DTD,
+			Format:     rgsv1.ReportFormat_REPORT_FORMAT_JSON,
+			OperatorId: operatorID,
+		})
+		return resp
+	}
+	lvRun := generate(lvMeta, "casino-lv").GetReportRun()
+	mtRun := generate(adminMeta, "casino-mt").GetReportRun()
+	if lvRun == nil || mtRun == nil {
+		t.Fatalf("expected in-scope and unscoped runs to generate")
+	}
+	if resp := generate(lvMeta, "casino-mt"); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED || resp.Meta.GetDenialReason() != "operator outside report scope" {
+		t.Fatalf("expected out-of-scope generate to be denied: %+v", resp.Meta)
+	}
+	if resp := generate(lvMeta, ""); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected scoped actor to need one of its operators: %+v", resp.Meta)
+	}
+
+	list, _ := svc.ListReportRuns(ctx, &rgsv1.ListReportRunsRequest{Meta: lvMeta})
+	if list.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || len(list.ReportRuns) != 1 || list.ReportRuns[0].ReportRunId != lvRun.ReportRunId {
+		t.Fatalf("expected scoped list to hold only casino-lv runs: %+v", list)
+	}
+	if list, _ := svc.ListReportRuns(ctx, &rgsv1.ListReportRunsRequest{Meta: lvMeta, OperatorId: "casino-mt"}); list.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected out-of-scope list filter to be denied: %+v", list.Meta)
+	}
+	if list, _ := svc.ListReportRuns(ctx, &rgsv1.ListReportRunsRequest{Meta: adminMeta, OperatorId: "casino-mt"}); len(list.ReportRuns) != 1 || list.ReportRuns[0].ReportRunId != mtRun.ReportRunId {
+		t.Fatalf("expected operator filter to narrow the unscoped list: %+v", list)
+	}
+
+	if got, _ := svc.GetReportRun(ctx, &rgsv1.GetReportRunRequest{Meta: lvMeta, ReportRunId: mtRun.ReportRunId}); got.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED || got.ReportRun != nil {
+		t.Fatalf("expected another operator's run to be withheld: %+v", got)
+	}
+	if got, _ := svc.GetReportRun(ctx, &rgsv1.GetReportRunRequest{Meta: lvMeta, ReportRunId: lvRun.ReportRunId}); got.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected own run to be readable: %+v", got.Meta)
+	}
+	if v, _ := svc.VerifyReportRun(ctx, &rgsv1.VerifyReportRunRequest{Meta: lvMeta, ReportRunId: mtRun.ReportRunId}); v.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected verify of another operator's run to be denied: %+v", v.Meta)
+	}
+
+	for action, want := range map[string]int{"generate_report": 2, "list_report_runs": 1, "get_report_run": 1, "verify_report_run": 1} {
+		if got := countDeniedAudits(svc, action); got != want {
+			t.Fatalf("expected %d denied %s audits, got %d", want, action, got)
+		}
+	}
+}
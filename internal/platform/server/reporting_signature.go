@@ -0,0 +1,160 @@
+package server
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+const reportRunSignatureAlg = "ed25519"
+
+// reportRunSignedDocument is what a report run's signature covers. Binding
+// the run id and operator stops a signature being replayed onto another
+// run's content.
+type reportRunSignedDocument struct {
+	ReportRunID   string `json:"report_run_id"`
+	OperatorID    string `json:"operator_id"`
+	ContentSHA256 string `json:"content_sha256"`
+}
+
+func reportRunSigningBytes(runID, operatorID, contentSHA256 string) []byte {
+	b, _ := json.Marshal(reportRunSignedDocument{ReportRunID: runID, OperatorID: operatorID, ContentSHA256: contentSHA256})
+	return b
+}
+
+// SetReportRunSigner sets the key that signs completed report runs. Without
+// one, runs record their content hash but are not signed.
+func (s *ReportingService) SetReportRunSigner(kid string, key ed25519.PrivateKey) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.runSignerKID = kid
+	s.runSigningKey = key
+}
+
+// SetReportRunVerifyKeys sets the public keys of earlier signers, so runs
+// signed before a key rotation still verify. The current signer is always
+// trusted.
+func (s *ReportingService) SetReportRunVerifyKeys(keys map[string]ed25519.PublicKey) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.runVerifyKeys = keys
+}
+
+// signRunLocked records the content hash of a completed run and, with a
+// signer configured, signs it. s.mu must be held.
+func (s *ReportingService) signRunLocked(run *rgsv1.ReportRun) {
+	run.ContentSha256 = sha256Hex(run.Content)
+	if len(s.runSigningKey) == 0 {
+		return
+	}
+	run.SignerKid = s.runSignerKID
+	run.SignatureAlg = reportRunSignatureAlg
+	run.Signature = hex.EncodeToString(ed25519.Sign(s.runSigningKey, reportRunSigningBytes(run.ReportRunId, run.OperatorId, run.ContentSha256)))
+}
+
+func (s *ReportingService) reportRunVerifyKey(kid string) ed25519.PublicKey {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if kid == s.runSignerKID && len(s.runSigningKey) > 0 {
+		pub, _ := s.runSigningKey.Public().(ed25519.PublicKey)
+		return pub
+	}
+	return s.runVerifyKeys[kid]
+}
+
+// verifyRunSignature checks content against the run's recorded hash and
+// signature, returning why it does not verify.
+func (s *ReportingService) verifyRunSignature(run *rgsv1.ReportRun, contentSHA256 string) string {
+	switch {
+	case run.Signature == "":
+		return "report run is not signed"
+	case run.SignatureAlg != reportRunSignatureAlg:
+		return "unsupported signature_alg"
+	case contentSHA256 != run.ContentSha256:
+		return "content does not match the signed hash"
+	}
+	pub := s.reportRunVerifyKey(run.SignerKid)
+	if len(pub) != ed25519.PublicKeySize {
+		return "signer key not trusted"
+	}
+	sig, err := hex.DecodeString(run.Signature)
+	if err != nil || !ed25519.Verify(pub, reportRunSigningBytes(run.ReportRunId, run.OperatorId, run.ContentSha256), sig) {
+		return "signature does not verify"
+	}
+	return ""
+}
+
+func (s *ReportingService) loadReportRun(ctx context.Context, reportRunID string) (*rgsv1.ReportRun, error) {
+	if s.db != nil {
+		return s.getReportRunFromDB(ctx, reportRunID)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return cloneRun(s.runs[reportRunID]), nil
+}
+
+// VerifyReportRun checks that a report is the one a run produced: the
+// supplied export, or the stored content when none is given, must hash to
+// the run's signed content hash under a trusted signer.
+func (s *ReportingService) VerifyReportRun(ctx context.Context, req *rgsv1.VerifyReportRunRequest) (*rgsv1.VerifyReportRunResponse, error) {
+	if req == nil || req.ReportRunId == "" {
+		return &rgsv1.VerifyReportRunResponse{Meta: s.responseMeta(nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "report_run_id is required")}, nil
+	}
+	if ok, reason := s.authorize(ctx, req.Meta); !ok {
+		_ = s.appendAudit(req.Meta, req.ReportRunId, "verify_report_run", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		return &rgsv1.VerifyReportRunResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+
+	run, err := s.loadReportRun(ctx, req.ReportRunId)
+	if err != nil {
+		return &rgsv1.VerifyReportRunResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if run == nil {
+		return &rgsv1.VerifyReportRunResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "report run not found")}, nil
+	}
+	if ok, reason := s.authorizeOperator(ctx, req.Meta, run.OperatorId); !ok {
+		_ = s.appendAudit(req.Meta, req.ReportRunId, "verify_report_run", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		return &rgsv1.VerifyReportRunResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	if run.Status != rgsv1.ReportRunStatus_REPORT_RUN_STATUS_COMPLETED {
+		return &rgsv1.VerifyReportRunResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "report run is not completed")}, nil
+	}
+
+	content := req.Content
+	if len(content) == 0 {
+		if run.ContentPurgedAt != "" {
+			return &rgsv1.VerifyReportRunResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "report content purged; supply the exported content")}, nil
+		}
+		content = run.Content
+	}
+	resp := &rgsv1.VerifyReportRunResponse{
+		ReportRunId:   run.ReportRunId,
+		ContentSha256: sha256Hex(content),
+		SignerKid:     run.SignerKid,
+		SignatureAlg:  run.SignatureAlg,
+	}
+	resp.FailureReason = s.verifyRunSignature(run, resp.ContentSha256)
+	resp.Verified = resp.FailureReason == ""
+
+	after, _ := json.Marshal(map[string]any{
+		"verified":       resp.Verified,
+		"content_sha256": resp.ContentSha256,
+		"signer_kid":     resp.SignerKid,
+		"failure_reason": resp.FailureReason,
+	})
+	if err := s.appendAudit(req.Meta, run.ReportRunId, "verify_report_run", []byte(`{}`), after, audit.ResultSuccess, ""); err != nil {
+		return &rgsv1.VerifyReportRunResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	resp.Meta = s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, "")
+	return resp, nil
+}
@@ -0,0 +1,161 @@
+package server
+
+import (
+	"context"
+	"crypto/ed25519"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func generateSignedRun(t *testing.T, svc *ReportingService, format rgsv1.ReportFormat) *rgsv1.ReportRun {
+	t.Helper()
+	resp, err := svc.GenerateReport(context.Background(), &rgsv1.GenerateReportRequest{
+		Meta:       meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		ReportType: rgsv1.ReportType_REPORT_TYPE_CASHLESS_LIABILITY_SUMMARY,
+		Interval:   rgsv1.ReportInterval_REPORT_INTERVAL_DTD,
+		Format:     format,
+		OperatorId: "casino-1",
+	})
+	if err != nil || resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("generate report: resp=%+v err=%v", resp, err)
+	}
+	return resp.ReportRun
+}
+
+func verifyRun(t *testing.T, svc *ReportingService, runID string, content []byte) *rgsv1.VerifyReportRunResponse {
+	t.Helper()
+	resp, err := svc.VerifyReportRun(context.Background(), &rgsv1.VerifyReportRunRequest{
+		Meta:        meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		ReportRunId: runID,
+		Content:     content,
+	})
+	if err != nil || resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("verify report run: resp=%+v err=%v", resp, err)
+	}
+	return resp
+}
+
+func TestReportRunSignedAndVerified(t *testing.T) {
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 18, 12, 0, 0, 0, time.UTC)}
+	svc := NewReportingService(clk, NewLedgerService(clk), NewEventsService(clk))
+	_, priv, _ := ed25519.GenerateKey(nil)
+	svc.SetReportRunSigner("report-k1", priv)
+
+	run := generateSignedRun(t, svc, rgsv1.ReportFormat_REPORT_FORMAT_PDF)
+	if run.ContentSha256 != sha256Hex(run.Content) || run.SignerKid != "report-k1" || run.SignatureAlg != "ed25519" || run.Signature == "" {
+		t.Fatalf("expected signed run: %+v", run)
+	}
+
+	stored := verifyRun(t, svc, run.ReportRunId, nil)
+	if !stored.Verified || stored.ContentSha256 != run.ContentSha256 || stored.SignerKid != "report-k1" {
+		t.Fatalf("expected stored content to verify: %+v", stored)
+	}
+	exported := verifyRun(t, svc, run.ReportRunId, run.Content)
+	if !exported.Verified {
+		t.Fatalf("expected exported copy to verify: %+v", exported)
+	}
+
+	tampered := append([]byte(nil), run.Content...)
+	tampered[len(tampered)/2] ^= 0xff
+	bad := verifyRun(t, svc, run.ReportRunId, tampered)
+	if bad.Verified || bad.FailureReason != "content does not match the signed hash" {
+		t.Fatalf("expected tampered copy to fail: %+v", bad)
+	}
+
+	// A forged hash on the stored run no longer matches its signature.
+	svc.mu.Lock()
+	svc.runs[run.ReportRunId].Content = tampered
+	svc.runs[run.ReportRunId].ContentSha256 = sha256Hex(tampered)
+	svc.mu.Unlock()
+	forged := verifyRun(t, svc, run.ReportRunId, nil)
+	if forged.Verified || forged.FailureReason != "signature does not verify" {
+		t.Fatalf("expected forged hash to fail: %+v", forged)
+	}
+}
+
+func TestReportRunVerifiesAfterKeyRotation(t *testing.T) {
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 18, 12, 0, 0, 0, time.UTC)}
+	svc := NewReportingService(clk, NewLedgerService(clk), NewEventsService(clk))
+	oldPub, oldPriv, _ := ed25519.GenerateKey(nil)
+	svc.SetReportRunSigner("report-k1", oldPriv)
+	run := generateSignedRun(t, svc, rgsv1.ReportFormat_REPORT_FORMAT_JSON)
+
+	_, newPriv, _ := ed25519.GenerateKey(nil)
+	svc.SetReportRunSigner("report-k2", newPriv)
+	if resp := verifyRun(t, svc, run.ReportRunId, nil); resp.Verified || resp.FailureReason != "signer key not trusted" {
+		t.Fatalf("expected retired key to be untrusted: %+v", resp)
+	}
+	svc.SetReportRunVerifyKeys(map[string]ed25519.PublicKey{"report-k1": oldPub})
+	if resp := verifyRun(t, svc, run.ReportRunId, nil); !resp.Verified {
+		t.Fatalf("expected run signed by retired key to verify: %+v", resp)
+	}
+}
+
+func TestReportRunWithoutSignerIsUnsigned(t *testing.T) {
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 18, 12, 0, 0, 0, time.UTC)}
+	svc := NewReportingService(clk, NewLedgerService(clk), NewEventsService(clk))
+
+	run := generateSignedRun(t, svc, rgsv1.ReportFormat_REPORT_FORMAT_CSV)
+	if run.ContentSha256 == "" || run.Signature != "" {
+		t.Fatalf("expected hashed but unsigned run: %+v", run)
+	}
+	if resp := verifyRun(t, svc, run.ReportRunId, nil); resp.Verified || resp.FailureReason != "report run is not signed" {
+		t.Fatalf("expected unsigned run not to verify: %+v", resp)
+	}
+
+	missing, _ := svc.VerifyReportRun(context.Background(), &rgsv1.VerifyReportRunRequest{
+		Meta:        meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		ReportRunId: "missing",
+	})
+	if missing.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected missing run to be invalid: %+v", missing.Meta)
+	}
+	denied, _ := svc.VerifyReportRun(context.Background(), &rgsv1.VerifyReportRunRequest{
+		Meta:        meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""),
+		ReportRunId: run.ReportRunId,
+	})
+	if denied.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected player to be denied: %+v", denied.Meta)
+	}
+}
+
+func TestReportRunAsyncIsSigned(t *testing.T) {
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 18, 12, 0, 0, 0, time.UTC)}
+	svc := NewReportingService(clk, NewLedgerService(clk), NewEventsService(clk))
+	_, priv, _ := ed25519.GenerateKey(nil)
+	svc.SetReportRunSigner("report-k1", priv)
+	ctx, cancel := context.WithCancel(context.Background())
+	svc.StartReportWorkers(ctx, 1, 4)
+	defer func() {
+		cancel()
+		svc.WaitReportWorkers()
+	}()
+
+	opMeta := meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+	resp, _ := svc.GenerateReportAsync(context.Background(), &rgsv1.GenerateReportAsyncRequest{
+		Meta:       opMeta,
+		ReportType: rgsv1.ReportType_REPORT_TYPE_CASHLESS_LIABILITY_SUMMARY,
+		Interval:   rgsv1.ReportInterval_REPORT_INTERVAL_DTD,
+		Format:     rgsv1.ReportFormat_REPORT_FORMAT_JSON,
+	})
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || resp.ReportRun.GetSignature() != "" {
+		t.Fatalf("expected unsigned pending run: %+v", resp)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		got, _ := svc.GetReportRun(context.Background(), &rgsv1.GetReportRunRequest{Meta: opMeta, ReportRunId: resp.ReportRunId})
+		if got.ReportRun.GetStatus() == rgsv1.ReportRunStatus_REPORT_RUN_STATUS_COMPLETED {
+			if got.ReportRun.Signature == "" {
+				t.Fatalf("expected completed async run to be signed: %+v", got.ReportRun)
+			}
+			if v := verifyRun(t, svc, resp.ReportRunId, got.ReportRun.Content); !v.Verified {
+				t.Fatalf("expected async run to verify: %+v", v)
+			}
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("async run did not complete")
+}
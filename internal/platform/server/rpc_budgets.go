@@ -0,0 +1,188 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+)
+
+// defaultClassBudgets is the latency budget of an RPC with no entry of its
+// own, by priority class: money movement must answer quickly, reads and
+// reporting may take longer.
+var defaultClassBudgets = map[PriorityClass]time.Duration{
+	PriorityCritical: 500 * time.Millisecond,
+	PriorityStandard: 2 * time.Second,
+	PriorityLow:      5 * time.Second,
+}
+
+// defaultMethodBudgets are the RPCs whose work does not fit their class
+// budget.
+var defaultMethodBudgets = map[string]time.Duration{
+	"/rgs.v1.ReportingService/GenerateReport":    30 * time.Second,
+	"/rgs.v1.ReportingService/GenerateDailyPack": 60 * time.Second,
+	"/rgs.v1.AuditService/VerifyAuditChain":      30 * time.Second,
+	"/rgs.v1.LedgerService/ImportBankStatement":  10 * time.Second,
+}
+
+// LatencyBudgets is the registry of server-side deadlines for every unary
+// RPC. A call is given its budget as a context deadline, so database work
+// is cancelled once the budget is spent, and calls that run past it are
+// reported as budget violations.
+type LatencyBudgets struct {
+	methods  map[string]time.Duration
+	routes   map[string]string
+	mu       sync.Mutex
+	observer func(transport, method string)
+}
+
+// NewLatencyBudgets builds the registry from the defaults and the given
+// per-method overrides, keyed by full method name
+// ("/rgs.v1.LedgerService/Deposit").
+func NewLatencyBudgets(overrides map[string]time.Duration) (*LatencyBudgets, error) {
+	perms, routes := rbacCatalog()
+	known := make(map[string]bool, len(perms))
+	for _, p := range perms {
+		known["/"+p] = true
+	}
+	b := &LatencyBudgets{methods: make(map[string]time.Duration, len(perms)), routes: routes}
+	for _, p := range perms {
+		method := "/" + p
+		budget, ok := defaultMethodBudgets[method]
+		if !ok {
+			budget = defaultClassBudgets[RPCPriority(method)]
+		}
+		b.methods[method] = budget
+	}
+	for method, budget := range overrides {
+		if !known[method] {
+			return nil, fmt.Errorf("unknown rpc %q", method)
+		}
+		if budget <= 0 {
+			return nil, fmt.Errorf("budget for %s must be positive", method)
+		}
+		b.methods[method] = budget
+	}
+	return b, nil
+}
+
+// SetViolationObserver registers a callback invoked for each call that runs
+// past its budget.
+func (b *LatencyBudgets) SetViolationObserver(observer func(transport, method string)) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.observer = observer
+}
+
+// Budget returns the latency budget of a full method name, falling back to
+// its priority class for methods outside the API package.
+func (b *LatencyBudgets) Budget(fullMethod string) time.Duration {
+	if budget, ok := b.methods[fullMethod]; ok {
+		return budget
+	}
+	return defaultClassBudgets[RPCPriority(fullMethod)]
+}
+
+// Shortest returns the tightest budget in the registry and its method.
+func (b *LatencyBudgets) Shortest() (string, time.Duration) {
+	methods := make([]string, 0, len(b.methods))
+	for m := range b.methods {
+		methods = append(methods, m)
+	}
+	sort.Strings(methods)
+	var (
+		shortest string
+		budget   time.Duration
+	)
+	for _, m := range methods {
+		if shortest == "" || b.methods[m] < budget {
+			shortest, budget = m, b.methods[m]
+		}
+	}
+	return shortest, budget
+}
+
+// ValidateDatabaseTimeout rejects a database timeout longer than the
+// shortest RPC budget: a query allowed to outlive the call that issued it
+// holds locks and connections for a response nobody is waiting for.
+func (b *LatencyBudgets) ValidateDatabaseTimeout(name string, timeout time.Duration) error {
+	if timeout <= 0 {
+		return nil
+	}
+	method, budget := b.Shortest()
+	if timeout > budget {
+		return fmt.Errorf("%s %s exceeds the %s latency budget of %s", name, timeout, method, budget)
+	}
+	return nil
+}
+
+func (b *LatencyBudgets) observe(ctx context.Context, transport, method string, elapsed, budget time.Duration) {
+	if elapsed <= budget && !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return
+	}
+	b.mu.Lock()
+	observer := b.observer
+	b.mu.Unlock()
+	if observer != nil {
+		observer(transport, method)
+	}
+}
+
+// UnaryLatencyBudgetInterceptor runs each unary RPC under its budget. A
+// caller deadline shorter than the budget still applies. Streams are
+// long-lived and are left without a deadline.
+func UnaryLatencyBudgetInterceptor(budgets *LatencyBudgets) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if budgets == nil {
+			return handler(ctx, req)
+		}
+		budget := budgets.Budget(info.FullMethod)
+		ctx, cancel := context.WithTimeout(ctx, budget)
+		defer cancel()
+		started := time.Now()
+		resp, err := handler(ctx, req)
+		budgets.observe(ctx, "grpc", info.FullMethod, time.Since(started), budget)
+		return resp, err
+	}
+}
+
+// GatewayMiddleware applies the budget of the RPC behind each gateway
+// route. Routes that map to no RPC pass through unchanged.
+func (b *LatencyBudgets) GatewayMiddleware() runtime.Middleware {
+	return func(next runtime.HandlerFunc) runtime.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+			if b == nil {
+				next(w, r, pathParams)
+				return
+			}
+			pattern, _ := runtime.HTTPPattern(r.Context())
+			perm, ok := b.routes[r.Method+" "+pattern.String()]
+			if !ok {
+				next(w, r, pathParams)
+				return
+			}
+			method := "/" + strings.TrimPrefix(perm, "/")
+			budget := b.Budget(method)
+			ctx, cancel := context.WithTimeout(r.Context(), budget)
+			defer cancel()
+			started := time.Now()
+			next(w, r.WithContext(ctx), pathParams)
+			b.observe(ctx, "rest", method, time.Since(started), budget)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+)
+
+func TestLatencyBudgetsDefaultsAndOverrides(t *testing.T) {
+	budgets, err := NewLatencyBudgets(map[string]time.Duration{"/rgs.v1.LedgerService/Withdraw": 750 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("new budgets: %v", err)
+	}
+	for method, want := range map[string]time.Duration{
+		"/rgs.v1.LedgerService/Deposit":           500 * time.Millisecond,
+		"/rgs.v1.LedgerService/Withdraw":          750 * time.Millisecond,
+		"/rgs.v1.IdentityService/Login":           2 * time.Second,
+		"/rgs.v1.WageringService/ListWagers":      5 * time.Second,
+		"/rgs.v1.ReportingService/GenerateReport": 30 * time.Second,
+	} {
+		if got := budgets.Budget(method); got != want {
+			t.Fatalf("%s: got=%s want=%s", method, got, want)
+		}
+	}
+
+	if err := budgets.ValidateDatabaseTimeout("statement_timeout", 500*time.Millisecond); err != nil {
+		t.Fatalf("expected timeout within the shortest budget to pass: %v", err)
+	}
+	err = budgets.ValidateDatabaseTimeout("statement_timeout", 5*time.Second)
+	if err == nil || !strings.Contains(err.Error(), "latency budget of 500ms") {
+		t.Fatalf("expected timeout past the shortest budget to be rejected, got %v", err)
+	}
+
+	if _, err := NewLatencyBudgets(map[string]time.Duration{"/rgs.v1.LedgerService/Nope": time.Second}); err == nil {
+		t.Fatalf("expected unknown rpc to be rejected")
+	}
+	if _, err := NewLatencyBudgets(map[string]time.Duration{"/rgs.v1.LedgerService/Deposit": 0}); err == nil {
+		t.Fatalf("expected non-positive budget to be rejected")
+	}
+}
+
+func TestUnaryLatencyBudgetInterceptorEnforcesDeadline(t *testing.T) {
+	budgets, err := NewLatencyBudgets(map[string]time.Duration{"/rgs.v1.LedgerService/Deposit": 20 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("new budgets: %v", err)
+	}
+	var violations []string
+	budgets.SetViolationObserver(func(transport, method string) {
+		violations = append(violations, transport+" "+method)
+	})
+	interceptor := UnaryLatencyBudgetInterceptor(budgets)
+
+	slow := func(ctx context.Context, _ any) (any, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	if _, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/rgs.v1.LedgerService/Deposit"}, slow); err != context.DeadlineExceeded {
+		t.Fatalf("expected handler context to expire at the budget, got %v", err)
+	}
+	fast := func(ctx context.Context, _ any) (any, error) {
+		if _, ok := ctx.Deadline(); !ok {
+			t.Fatalf("expected a deadline on the handler context")
+		}
+		return "ok", nil
+	}
+	if _, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/rgs.v1.LedgerService/GetBalance"}, fast); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(violations) != 1 || violations[0] != "grpc /rgs.v1.LedgerService/Deposit" {
+		t.Fatalf("expected one budget violation, got %v", violations)
+	}
+}
+
+func TestLatencyBudgetGatewayMiddleware(t *testing.T) {
+	budgets, err := NewLatencyBudgets(nil)
+	if err != nil {
+		t.Fatalf("new budgets: %v", err)
+	}
+	var deadline time.Duration
+	mux := runtime.NewServeMux(runtime.WithMiddlewares(budgets.GatewayMiddleware()))
+	if err := mux.HandlePath(http.MethodPost, "/v1/ledger/deposits", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		if d, ok := r.Context().Deadline(); ok {
+			deadline = time.Until(d)
+		}
+		w.WriteHeader(http.StatusOK)
+	}); err != nil {
+		t.Fatalf("handle path: %v", err)
+	}
+	mux.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/v1/ledger/deposits", nil))
+	if deadline <= 0 || deadline > 500*time.Millisecond {
+		t.Fatalf("expected the deposit budget as the request deadline, got %s", deadline)
+	}
+}
@@ -0,0 +1,659 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/clock"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	schedulerLeaseName       = "job_scheduler"
+	defaultSchedulerLeaseTTL = 30 * time.Second
+	maxInMemoryJobRuns       = 1000
+	oneShotJobBatchSize      = 50
+)
+
+// errSchedulerLeaseLost reports that another instance holds the scheduler
+// lease, so this instance must not record the run it was executing.
+var errSchedulerLeaseLost = errors.New("scheduler lease lost")
+
+// JobFunc runs one execution of a scheduled job. payload is empty for
+// recurring runs. A non-empty summary is kept in the run history and logged.
+type JobFunc func(ctx context.Context, payload string) (string, error)
+
+// RetryPolicy bounds how a failed run is retried. Recurring jobs fall back to
+// their regular schedule once MaxAttempts is reached; one-shot jobs are
+// marked failed. Backoff doubles per attempt up to MaxBackoff.
+type RetryPolicy struct {
+	MaxAttempts int
+	Backoff     time.Duration
+	MaxBackoff  time.Duration
+}
+
+// DefaultRetryPolicy applies to jobs registered without their own policy.
+var DefaultRetryPolicy = RetryPolicy{MaxAttempts: 3, Backoff: 30 * time.Second, MaxBackoff: 10 * time.Minute}
+
+func (p RetryPolicy) delay(failedAttempts int) time.Duration {
+	d := p.Backoff
+	for i := 1; i < failedAttempts && (p.MaxBackoff <= 0 || d < p.MaxBackoff); i++ {
+		d *= 2
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	return d
+}
+
+// JobSpec registers a job with the scheduler. Schedule is a cron expression
+// or "@every <duration>"; an empty Schedule registers a handler that only
+// runs for one-shot jobs queued with ScheduleOnce.
+type JobSpec struct {
+	Name     string
+	Schedule string
+	Retry    RetryPolicy
+	Run      JobFunc
+}
+
+type scheduledJob struct {
+	spec           JobSpec
+	schedule       jobSchedule
+	nextRunAt      time.Time
+	lastRunAt      time.Time
+	lastStatus     rgsv1.JobRunStatus
+	failedAttempts int
+}
+
+type oneShotJob struct {
+	jobID          string
+	name           string
+	payload        string
+	runAt          time.Time
+	failedAttempts int
+	status         string
+}
+
+// JobScheduler runs the background jobs of every service from one loop.
+// With a database, job state, one-shot jobs, and run history are stored in
+// Postgres and only the replica holding the scheduler lease executes jobs,
+// so cleanup and report jobs run once per deployment rather than once per
+// replica. Without a database the process is always the leader.
+type JobScheduler struct {
+	Clock clock.Clock
+
+	runMu      sync.Mutex
+	mu         sync.Mutex
+	db         *sql.DB
+	instanceID string
+	leaseTTL   time.Duration
+	retry      RetryPolicy
+	jobs       map[string]*scheduledJob
+	order      []string
+	oneShots   map[string]*oneShotJob
+	runs       []*rgsv1.JobRun
+	nextRunID  int64
+	nextJobID  int64
+	logger     func(string, ...any)
+	onRun      func(job string, status string)
+}
+
+func NewJobScheduler(clk clock.Clock, instanceID string, db ...*sql.DB) *JobScheduler {
+	var handle *sql.DB
+	if len(db) > 0 {
+		handle = db[0]
+	}
+	return &JobScheduler{
+		Clock:      clk,
+		db:         handle,
+		instanceID: instanceID,
+		leaseTTL:   defaultSchedulerLeaseTTL,
+		retry:      DefaultRetryPolicy,
+		jobs:       make(map[string]*scheduledJob),
+		oneShots:   make(map[string]*oneShotJob),
+	}
+}
+
+// SetLeaseTTL sets how long a leader keeps the scheduler lease without
+// renewing it. It should be several poll intervals long; a running job
+// renews the lease every third of the TTL.
+func (s *JobScheduler) SetLeaseTTL(ttl time.Duration) {
+	if s == nil || ttl <= 0 {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.leaseTTL = ttl
+}
+
+// SetDefaultRetryPolicy replaces the policy used by jobs without their own.
+func (s *JobScheduler) SetDefaultRetryPolicy(p RetryPolicy) {
+	if s == nil || p.MaxAttempts <= 0 {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.retry = p
+}
+
+func (s *JobScheduler) SetLogger(logger func(string, ...any)) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.logger = logger
+}
+
+// SetRunObserver registers a callback invoked with the job name and result
+// ("succeeded" or "failed") of every run.
+func (s *JobScheduler) SetRunObserver(observer func(job string, status string)) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.onRun = observer
+}
+
+func (s *JobScheduler) now() time.Time {
+	if s.Clock == nil {
+		return time.Now().UTC()
+	}
+	return s.Clock.Now().UTC()
+}
+
+func (s *JobScheduler) dbEnabled() bool {
+	return s != nil && s.db != nil
+}
+
+// Register adds a job. Registering the same name twice replaces the spec.
+func (s *JobScheduler) Register(spec JobSpec) error {
+	if s == nil {
+		return errors.New("scheduler unavailable")
+	}
+	if spec.Name == "" || spec.Run == nil {
+		return errors.New("job name and run func are required")
+	}
+	var sched jobSchedule
+	if spec.Schedule != "" {
+		parsed, err := parseJobSchedule(spec.Schedule)
+		if err != nil {
+			return errors.New("job " + spec.Name + ": " + err.Error())
+		}
+		sched = parsed
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job := &scheduledJob{spec: spec, schedule: sched}
+	if sched != nil {
+		job.nextRunAt = sched.Next(s.now())
+	}
+	if _, ok := s.jobs[spec.Name]; !ok {
+		s.order = append(s.order, spec.Name)
+	}
+	s.jobs[spec.Name] = job
+	return nil
+}
+
+func (s *JobScheduler) retryPolicy(spec JobSpec) RetryPolicy {
+	if spec.Retry.MaxAttempts > 0 {
+		return spec.Retry
+	}
+	return s.retry
+}
+
+func (s *JobScheduler) nextIDLocked(prefix string, counter *int64) string {
+	*counter++
+	return prefix + strconv.FormatInt(s.now().UnixNano(), 10) + "-" + strconv.FormatInt(*counter, 10)
+}
+
+// ScheduleOnce queues a single run of a registered job at runAt, or as soon
+// as possible when runAt is zero, and returns the one-shot job id.
+func (s *JobScheduler) ScheduleOnce(ctx context.Context, name string, runAt time.Time, payload string) (string, error) {
+	if s == nil {
+		return "", errors.New("scheduler unavailable")
+	}
+	s.mu.Lock()
+	if _, ok := s.jobs[name]; !ok {
+		s.mu.Unlock()
+		return "", errors.New("job " + name + " is not registered")
+	}
+	now := s.now()
+	if runAt.IsZero() {
+		runAt = now
+	}
+	shot := &oneShotJob{
+		jobID:   s.nextIDLocked("job-", &s.nextJobID),
+		name:    name,
+		payload: payload,
+		runAt:   runAt.UTC(),
+		status:  "pending",
+	}
+	if !s.dbEnabled() {
+		s.oneShots[shot.jobID] = shot
+	}
+	s.mu.Unlock()
+	if s.dbEnabled() {
+		if err := s.insertOneShotJobInDB(ctx, shot, now); err != nil {
+			return "", err
+		}
+	}
+	return shot.jobID, nil
+}
+
+// Start polls for due jobs every pollInterval until ctx is done, then gives
+// up the scheduler lease so another replica can take over immediately.
+func (s *JobScheduler) Start(ctx context.Context, pollInterval time.Duration) {
+	if s == nil || pollInterval <= 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(pollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				if s.dbEnabled() {
+					_ = s.releaseLeaseInDB(context.Background())
+				}
+				return
+			case <-ticker.C:
+				if _, err := s.RunDue(ctx); err != nil {
+					s.logf("job scheduler poll failed: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+func (s *JobScheduler) logf(format string, args ...any) {
+	s.mu.Lock()
+	logger := s.logger
+	s.mu.Unlock()
+	if logger != nil {
+		logger(format, args...)
+	}
+}
+
+func (s *JobScheduler) acquireLeadership(ctx context.Context) (bool, error) {
+	if !s.dbEnabled() {
+		return true, nil
+	}
+	s.mu.Lock()
+	ttl := s.leaseTTL
+	s.mu.Unlock()
+	return s.acquireLeaseInDB(ctx, s.now(), ttl)
+}
+
+// whileHoldingLease runs fn while renewing the scheduler lease every third
+// of its TTL, so a job that outlives the TTL keeps its lease. When renewal
+// fails the context passed to fn is canceled and the loss is returned in
+// place of fn's result. Recording the run also checks the lease, so a run
+// that finishes just as the lease lapses is not recorded either.
+func (s *JobScheduler) whileHoldingLease(ctx context.Context, fn func(context.Context) error) error {
+	if !s.dbEnabled() {
+		return fn(ctx)
+	}
+	s.mu.Lock()
+	interval := s.leaseTTL / 3
+	s.mu.Unlock()
+	if interval <= 0 {
+		interval = defaultSchedulerLeaseTTL / 3
+	}
+	runCtx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	done := make(chan struct{})
+	renewed := make(chan struct{})
+	go func() {
+		defer close(renewed)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-runCtx.Done():
+				return
+			case <-ticker.C:
+				leader, err := s.acquireLeadership(runCtx)
+				if runCtx.Err() != nil {
+					return
+				}
+				switch {
+				case err != nil:
+					cancel(fmt.Errorf("%w: %w", errSchedulerLeaseLost, err))
+					return
+				case !leader:
+					cancel(errSchedulerLeaseLost)
+					return
+				}
+			}
+		}
+	}()
+	err := fn(runCtx)
+	close(done)
+	<-renewed
+	if cause := context.Cause(runCtx); errors.Is(cause, errSchedulerLeaseLost) {
+		return cause
+	}
+	return err
+}
+
+// RunDue executes every recurring job whose next run has passed and every
+// pending one-shot job that is due, provided this instance holds the
+// scheduler lease. Jobs run one at a time; it returns the number of runs.
+func (s *JobScheduler) RunDue(ctx context.Context) (int, error) {
+	if s == nil {
+		return 0, nil
+	}
+	s.runMu.Lock()
+	defer s.runMu.Unlock()
+
+	leader, err := s.acquireLeadership(ctx)
+	if err != nil || !leader {
+		return 0, err
+	}
+	if s.dbEnabled() {
+		if err := s.syncJobStatesFromDB(ctx); err != nil {
+			return 0, err
+		}
+	}
+
+	now := s.now()
+	s.mu.Lock()
+	due := make([]*scheduledJob, 0)
+	for _, name := range s.order {
+		job := s.jobs[name]
+		if job.schedule != nil && !job.nextRunAt.After(now) {
+			due = append(due, job)
+		}
+	}
+	s.mu.Unlock()
+
+	ran := 0
+	for _, job := range due {
+		if ran > 0 {
+			if leader, err := s.acquireLeadership(ctx); err != nil || !leader {
+				return ran, err
+			}
+		}
+		if err := s.runRecurring(ctx, job); err != nil {
+			return ran, err
+		}
+		ran++
+	}
+
+	shots, err := s.dueOneShots(ctx, s.now())
+	if err != nil {
+		return ran, err
+	}
+	for _, shot := range shots {
+		if leader, err := s.acquireLeadership(ctx); err != nil || !leader {
+			return ran, err
+		}
+		if err := s.runOneShot(ctx, shot); err != nil {
+			return ran, err
+		}
+		ran++
+	}
+	return ran, nil
+}
+
+func (s *JobScheduler) execute(ctx context.Context, name, jobID string, attempt int, payload string, run JobFunc) *rgsv1.JobRun {
+	s.mu.Lock()
+	runID := s.nextIDLocked("job-run-", &s.nextRunID)
+	s.mu.Unlock()
+	started := s.now()
+	var summary string
+	err := s.whileHoldingLease(ctx, func(runCtx context.Context) error {
+		var runErr error
+		summary, runErr = run(runCtx, payload)
+		return runErr
+	})
+	rec := &rgsv1.JobRun{
+		RunId:      runID,
+		JobName:    name,
+		JobId:      jobID,
+		Attempt:    int32(attempt),
+		Status:     rgsv1.JobRunStatus_JOB_RUN_STATUS_SUCCEEDED,
+		StartedAt:  started.Format(time.RFC3339Nano),
+		FinishedAt: s.now().Format(time.RFC3339Nano),
+		Summary:    summary,
+		InstanceId: s.instanceID,
+	}
+	if err != nil {
+		rec.Status = rgsv1.JobRunStatus_JOB_RUN_STATUS_FAILED
+		rec.Error = err.Error()
+	}
+	return rec
+}
+
+func (s *JobScheduler) afterRun(rec *rgsv1.JobRun) {
+	s.mu.Lock()
+	observer := s.onRun
+	s.mu.Unlock()
+	if observer != nil {
+		observer(rec.JobName, jobRunStatusLabel(rec.Status))
+	}
+	if rec.Status == rgsv1.JobRunStatus_JOB_RUN_STATUS_FAILED {
+		s.logf("scheduled job %s failed attempt=%d retry_scheduled=%t: %s", rec.JobName, rec.Attempt, rec.RetryScheduled, rec.Error)
+		return
+	}
+	if rec.Summary != "" {
+		s.logf("scheduled job %s: %s", rec.JobName, rec.Summary)
+	}
+}
+
+func (s *JobScheduler) runRecurring(ctx context.Context, job *scheduledJob) error {
+	s.mu.Lock()
+	spec := job.spec
+	attempt := job.failedAttempts + 1
+	policy := s.retryPolicy(spec)
+	s.mu.Unlock()
+
+	rec := s.execute(ctx, spec.Name, "", attempt, "", spec.Run)
+	now := s.now()
+
+	s.mu.Lock()
+	job.lastRunAt = now
+	job.lastStatus = rec.Status
+	regular := job.schedule.Next(now)
+	if rec.Status == rgsv1.JobRunStatus_JOB_RUN_STATUS_FAILED && attempt < policy.MaxAttempts {
+		job.failedAttempts = attempt
+		job.nextRunAt = now.Add(policy.delay(attempt))
+		if regular.Before(job.nextRunAt) {
+			job.nextRunAt = regular
+		}
+		rec.RetryScheduled = true
+	} else {
+		job.failedAttempts = 0
+		job.nextRunAt = regular
+	}
+	state := *job
+	if !s.dbEnabled() {
+		s.appendRunLocked(rec)
+	}
+	s.mu.Unlock()
+
+	s.afterRun(rec)
+	if s.dbEnabled() {
+		return s.recordRecurringRunInDB(ctx, rec, &state)
+	}
+	return nil
+}
+
+func (s *JobScheduler) dueOneShots(ctx context.Context, now time.Time) ([]*oneShotJob, error) {
+	if s.dbEnabled() {
+		return s.listDueOneShotJobsFromDB(ctx, now, oneShotJobBatchSize)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]*oneShotJob, 0)
+	for _, shot := range s.oneShots {
+		if shot.status == "pending" && !shot.runAt.After(now) {
+			cp := *shot
+			out = append(out, &cp)
+		}
+	}
+	sortOneShots(out)
+	if len(out) > oneShotJobBatchSize {
+		out = out[:oneShotJobBatchSize]
+	}
+	return out, nil
+}
+
+func sortOneShots(items []*oneShotJob) {
+	sort.Slice(items, func(i, j int) bool {
+		if !items[i].runAt.Equal(items[j].runAt) {
+			return items[i].runAt.Before(items[j].runAt)
+		}
+		return items[i].jobID < items[j].jobID
+	})
+}
+
+func (s *JobScheduler) runOneShot(ctx context.Context, shot *oneShotJob) error {
+	s.mu.Lock()
+	job := s.jobs[shot.name]
+	s.mu.Unlock()
+
+	attempt := shot.failedAttempts + 1
+	var (
+		rec    *rgsv1.JobRun
+		policy RetryPolicy
+	)
+	if job == nil {
+		rec = s.execute(ctx, shot.name, shot.jobID, attempt, shot.payload, func(context.Context, string) (string, error) {
+			return "", errors.New("no handler registered")
+		})
+		policy = RetryPolicy{MaxAttempts: 1}
+	} else {
+		s.mu.Lock()
+		policy = s.retryPolicy(job.spec)
+		s.mu.Unlock()
+		rec = s.execute(ctx, shot.name, shot.jobID, attempt, shot.payload, job.spec.Run)
+	}
+
+	now := s.now()
+	switch {
+	case rec.Status == rgsv1.JobRunStatus_JOB_RUN_STATUS_SUCCEEDED:
+		shot.status = "succeeded"
+	case attempt < policy.MaxAttempts:
+		shot.failedAttempts = attempt
+		shot.runAt = now.Add(policy.delay(attempt))
+		rec.RetryScheduled = true
+	default:
+		shot.failedAttempts = attempt
+		shot.status = "failed"
+	}
+
+	if !s.dbEnabled() {
+		s.mu.Lock()
+		s.oneShots[shot.jobID] = shot
+		s.appendRunLocked(rec)
+		s.mu.Unlock()
+	}
+	s.afterRun(rec)
+	if s.dbEnabled() {
+		return s.recordOneShotRunInDB(ctx, rec, shot, now)
+	}
+	return nil
+}
+
+func (s *JobScheduler) appendRunLocked(rec *rgsv1.JobRun) {
+	s.runs = append(s.runs, rec)
+	if len(s.runs) > maxInMemoryJobRuns {
+		s.runs = s.runs[len(s.runs)-maxInMemoryJobRuns:]
+	}
+}
+
+// listJobs returns the registered recurring jobs in registration order.
+func (s *JobScheduler) listJobs(ctx context.Context) ([]*rgsv1.ScheduledJob, error) {
+	if s.dbEnabled() {
+		if err := s.syncJobStatesFromDB(ctx); err != nil {
+			return nil, err
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]*rgsv1.ScheduledJob, 0, len(s.order))
+	for _, name := range s.order {
+		job := s.jobs[name]
+		if job.schedule == nil {
+			continue
+		}
+		item := &rgsv1.ScheduledJob{
+			JobName:        name,
+			Schedule:       job.spec.Schedule,
+			NextRunAt:      job.nextRunAt.Format(time.RFC3339Nano),
+			LastStatus:     job.lastStatus,
+			FailedAttempts: int32(job.failedAttempts),
+		}
+		if !job.lastRunAt.IsZero() {
+			item.LastRunAt = job.lastRunAt.Format(time.RFC3339Nano)
+		}
+		out = append(out, item)
+	}
+	return out, nil
+}
+
+// listRuns returns runs newest first, optionally for one job.
+func (s *JobScheduler) listRuns(ctx context.Context, jobName string, limit, offset int) ([]*rgsv1.JobRun, string, error) {
+	if s.dbEnabled() {
+		return s.listJobRunsFromDB(ctx, jobName, limit, offset)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	filtered := make([]*rgsv1.JobRun, 0)
+	for i := len(s.runs) - 1; i >= 0; i-- {
+		if jobName == "" || s.runs[i].JobName == jobName {
+			filtered = append(filtered, s.runs[i])
+		}
+	}
+	if offset > len(filtered) {
+		offset = len(filtered)
+	}
+	end := offset + limit
+	if end > len(filtered) {
+		end = len(filtered)
+	}
+	next := ""
+	if end < len(filtered) {
+		next = strconv.Itoa(end)
+	}
+	out := make([]*rgsv1.JobRun, 0, end-offset)
+	for _, rec := range filtered[offset:end] {
+		cp, _ := proto.Clone(rec).(*rgsv1.JobRun)
+		out = append(out, cp)
+	}
+	return out, next, nil
+}
+
+func jobRunStatusLabel(v rgsv1.JobRunStatus) string {
+	switch v {
+	case rgsv1.JobRunStatus_JOB_RUN_STATUS_SUCCEEDED:
+		return "succeeded"
+	case rgsv1.JobRunStatus_JOB_RUN_STATUS_FAILED:
+		return "failed"
+	default:
+		return ""
+	}
+}
+
+func jobRunStatusFromLabel(raw string) rgsv1.JobRunStatus {
+	switch raw {
+	case "succeeded":
+		return rgsv1.JobRunStatus_JOB_RUN_STATUS_SUCCEEDED
+	case "failed":
+		return rgsv1.JobRunStatus_JOB_RUN_STATUS_FAILED
+	default:
+		return rgsv1.JobRunStatus_JOB_RUN_STATUS_UNSPECIFIED
+	}
+}
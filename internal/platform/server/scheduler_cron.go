@@ -0,0 +1,169 @@
+package server
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// jobSchedule yields the next run time strictly after a given instant.
+type jobSchedule interface {
+	Next(after time.Time) time.Time
+}
+
+type everySchedule struct {
+	interval time.Duration
+}
+
+func (e everySchedule) Next(after time.Time) time.Time {
+	return after.Add(e.interval)
+}
+
+// cronSchedule is a standard five-field cron expression (minute, hour, day of
+// month, month, day of week) evaluated in UTC.
+type cronSchedule struct {
+	minute, hour, dom, month, dow uint64
+	domStar, dowStar              bool
+}
+
+// Every formats an interval as a schedule spec accepted by JobSpec.
+func Every(d time.Duration) string {
+	return "@every " + d.String()
+}
+
+// parseJobSchedule accepts "@every <duration>", the @hourly/@daily/@weekly/
+// @monthly shorthands, or a five-field cron expression.
+func parseJobSchedule(spec string) (jobSchedule, error) {
+	spec = strings.TrimSpace(spec)
+	if rest, ok := strings.CutPrefix(spec, "@every "); ok {
+		d, err := time.ParseDuration(strings.TrimSpace(rest))
+		if err != nil || d <= 0 {
+			return nil, errors.New("invalid @every interval")
+		}
+		return everySchedule{interval: d}, nil
+	}
+	switch spec {
+	case "@hourly":
+		spec = "0 * * * *"
+	case "@daily", "@midnight":
+		spec = "0 0 * * *"
+	case "@weekly":
+		spec = "0 0 * * 0"
+	case "@monthly":
+		spec = "0 0 1 * *"
+	}
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, errors.New("cron expression must have five fields")
+	}
+	var (
+		c   cronSchedule
+		err error
+	)
+	if c.minute, _, err = parseCronField(fields[0], 0, 59); err != nil {
+		return nil, err
+	}
+	if c.hour, _, err = parseCronField(fields[1], 0, 23); err != nil {
+		return nil, err
+	}
+	if c.dom, c.domStar, err = parseCronField(fields[2], 1, 31); err != nil {
+		return nil, err
+	}
+	if c.month, _, err = parseCronField(fields[3], 1, 12); err != nil {
+		return nil, err
+	}
+	if c.dow, c.dowStar, err = parseCronField(fields[4], 0, 7); err != nil {
+		return nil, err
+	}
+	// Both 0 and 7 mean Sunday.
+	if c.dow&(1<<7) != 0 {
+		c.dow |= 1
+	}
+	if c.Next(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)).IsZero() {
+		return nil, errors.New("cron expression never matches")
+	}
+	return c, nil
+}
+
+// parseCronField returns the bitset of values matched by a comma separated
+// list of *, n, a-b, and */s or a-b/s terms.
+func parseCronField(field string, lo, hi int) (uint64, bool, error) {
+	var bits uint64
+	star := field == "*"
+	for _, term := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(term, "/")
+		step := 1
+		if hasStep {
+			s, err := strconv.Atoi(stepPart)
+			if err != nil || s <= 0 {
+				return 0, false, errors.New("invalid cron step: " + term)
+			}
+			step = s
+		}
+		start, end := lo, hi
+		switch {
+		case rangePart == "*":
+		case strings.Contains(rangePart, "-"):
+			a, b, _ := strings.Cut(rangePart, "-")
+			var errA, errB error
+			start, errA = strconv.Atoi(a)
+			end, errB = strconv.Atoi(b)
+			if errA != nil || errB != nil {
+				return 0, false, errors.New("invalid cron range: " + term)
+			}
+		default:
+			v, err := strconv.Atoi(rangePart)
+			if err != nil {
+				return 0, false, errors.New("invalid cron value: " + term)
+			}
+			start = v
+			if !hasStep {
+				end = v
+			}
+		}
+		if start < lo || end > hi || start > end {
+			return 0, false, errors.New("cron value out of range: " + term)
+		}
+		for v := start; v <= end; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, star, nil
+}
+
+func (c cronSchedule) dayMatches(t time.Time) bool {
+	domOK := c.dom&(1<<uint(t.Day())) != 0
+	dowOK := c.dow&(1<<uint(t.Weekday())) != 0
+	// As in cron(8), a restricted day of month and day of week match either.
+	if c.domStar || c.dowStar {
+		return domOK && dowOK
+	}
+	return domOK || dowOK
+}
+
+func (c cronSchedule) Next(after time.Time) time.Time {
+	t := after.UTC().Truncate(time.Minute).Add(time.Minute)
+	// Every valid expression matches within five years (Feb 29 included).
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		if c.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if !c.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if c.hour&(1<<uint(t.Hour())) == 0 {
+			t = t.Truncate(time.Hour).Add(time.Hour)
+			continue
+		}
+		if c.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
@@ -0,0 +1,292 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+// acquireLeaseInDB takes or renews the scheduler lease. A lease held by
+// another instance is only taken over once it has expired.
+func (s *JobScheduler) acquireLeaseInDB(ctx context.Context, now time.Time, ttl time.Duration) (bool, error) {
+	const q = `
+INSERT INTO scheduler_leases (lease_name, holder_id, expires_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (lease_name) DO UPDATE
+SET holder_id = EXCLUDED.holder_id,
+    expires_at = EXCLUDED.expires_at
+WHERE scheduler_leases.holder_id = EXCLUDED.holder_id
+   OR scheduler_leases.expires_at <= $4
+RETURNING holder_id
+`
+	var holder string
+	err := s.db.QueryRowContext(ctx, q, schedulerLeaseName, s.instanceID, now.Add(ttl), now).Scan(&holder)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return holder == s.instanceID, nil
+}
+
+func (s *JobScheduler) releaseLeaseInDB(ctx context.Context) error {
+	const q = `DELETE FROM scheduler_leases WHERE lease_name = $1 AND holder_id = $2`
+	_, err := s.db.ExecContext(ctx, q, schedulerLeaseName, s.instanceID)
+	return err
+}
+
+// checkLeaseHeldTx fails with errSchedulerLeaseLost unless this instance
+// still holds an unexpired scheduler lease. The row stays share-locked until
+// tx ends, so no other instance can take the lease over before tx commits.
+func (s *JobScheduler) checkLeaseHeldTx(ctx context.Context, tx *sql.Tx) error {
+	const q = `SELECT holder_id, expires_at FROM scheduler_leases WHERE lease_name = $1 FOR SHARE`
+	var (
+		holder    string
+		expiresAt time.Time
+	)
+	err := tx.QueryRowContext(ctx, q, schedulerLeaseName).Scan(&holder, &expiresAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return errSchedulerLeaseLost
+	}
+	if err != nil {
+		return err
+	}
+	if holder != s.instanceID || !expiresAt.After(s.now()) {
+		return errSchedulerLeaseLost
+	}
+	return nil
+}
+
+// syncJobStatesFromDB loads the stored state of every registered recurring
+// job so a new leader resumes where the previous one stopped. Jobs with no
+// row, or whose schedule changed, start from their next regular run.
+func (s *JobScheduler) syncJobStatesFromDB(ctx context.Context) error {
+	const q = `
+SELECT job_name, schedule, next_run_at, last_run_at, last_status, failed_attempts
+FROM scheduler_jobs
+`
+	rows, err := s.db.QueryContext(ctx, q)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	type storedJob struct {
+		schedule       string
+		nextRunAt      time.Time
+		lastRunAt      sql.NullTime
+		lastStatus     string
+		failedAttempts int
+	}
+	stored := make(map[string]storedJob)
+	for rows.Next() {
+		var (
+			name string
+			row  storedJob
+		)
+		if err := rows.Scan(&name, &row.schedule, &row.nextRunAt, &row.lastRunAt, &row.lastStatus, &row.failedAttempts); err != nil {
+			return err
+		}
+		stored[name] = row
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	missing := make([]scheduledJob, 0)
+	for _, name := range s.order {
+		job := s.jobs[name]
+		if job.schedule == nil {
+			continue
+		}
+		row, ok := stored[name]
+		if !ok || row.schedule != job.spec.Schedule {
+			missing = append(missing, *job)
+			continue
+		}
+		job.nextRunAt = row.nextRunAt.UTC()
+		job.lastRunAt = time.Time{}
+		if row.lastRunAt.Valid {
+			job.lastRunAt = row.lastRunAt.Time.UTC()
+		}
+		job.lastStatus = jobRunStatusFromLabel(row.lastStatus)
+		job.failedAttempts = row.failedAttempts
+	}
+	s.mu.Unlock()
+
+	for i := range missing {
+		if err := upsertJobStateInDB(ctx, s.db, &missing[i], s.now()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type dbExecer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+func upsertJobStateInDB(ctx context.Context, db dbExecer, job *scheduledJob, now time.Time) error {
+	const q = `
+INSERT INTO scheduler_jobs (job_name, schedule, next_run_at, last_run_at, last_status, failed_attempts, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (job_name) DO UPDATE
+SET schedule = EXCLUDED.schedule,
+    next_run_at = EXCLUDED.next_run_at,
+    last_run_at = EXCLUDED.last_run_at,
+    last_status = EXCLUDED.last_status,
+    failed_attempts = EXCLUDED.failed_attempts,
+    updated_at = EXCLUDED.updated_at
+`
+	var lastRunAt sql.NullTime
+	if !job.lastRunAt.IsZero() {
+		lastRunAt = sql.NullTime{Time: job.lastRunAt, Valid: true}
+	}
+	_, err := db.ExecContext(ctx, q, job.spec.Name, job.spec.Schedule, job.nextRunAt, lastRunAt, jobRunStatusLabel(job.lastStatus), job.failedAttempts, now)
+	return err
+}
+
+func insertJobRunInDB(ctx context.Context, db dbExecer, rec *rgsv1.JobRun) error {
+	const q = `
+INSERT INTO scheduler_job_runs (
+  run_id, job_name, job_id, attempt, status, started_at, finished_at, summary, error, instance_id, retry_scheduled
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+`
+	started, err := time.Parse(time.RFC3339Nano, rec.StartedAt)
+	if err != nil {
+		return err
+	}
+	finished, err := time.Parse(time.RFC3339Nano, rec.FinishedAt)
+	if err != nil {
+		return err
+	}
+	_, err = db.ExecContext(ctx, q, rec.RunId, rec.JobName, rec.JobId, rec.Attempt, jobRunStatusLabel(rec.Status), started, finished, rec.Summary, rec.Error, rec.InstanceId, rec.RetryScheduled)
+	return err
+}
+
+// recordRecurringRunInDB stores a run and the job state it produced together,
+// provided this instance still holds the scheduler lease.
+func (s *JobScheduler) recordRecurringRunInDB(ctx context.Context, rec *rgsv1.JobRun, job *scheduledJob) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+	if err := s.checkLeaseHeldTx(ctx, tx); err != nil {
+		return err
+	}
+	if err := insertJobRunInDB(ctx, tx, rec); err != nil {
+		return err
+	}
+	if err := upsertJobStateInDB(ctx, tx, job, s.now()); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (s *JobScheduler) insertOneShotJobInDB(ctx context.Context, shot *oneShotJob, now time.Time) error {
+	const q = `
+INSERT INTO scheduler_one_shot_jobs (job_id, job_name, payload, run_at, status, failed_attempts, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, 0, $6, $6)
+`
+	_, err := s.db.ExecContext(ctx, q, shot.jobID, shot.name, shot.payload, shot.runAt, shot.status, now)
+	return err
+}
+
+func (s *JobScheduler) listDueOneShotJobsFromDB(ctx context.Context, now time.Time, limit int) ([]*oneShotJob, error) {
+	const q = `
+SELECT job_id, job_name, payload, run_at, failed_attempts, status
+FROM scheduler_one_shot_jobs
+WHERE status = 'pending' AND run_at <= $1
+ORDER BY run_at ASC, job_id ASC
+LIMIT $2
+`
+	rows, err := s.db.QueryContext(ctx, q, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]*oneShotJob, 0)
+	for rows.Next() {
+		shot := &oneShotJob{}
+		if err := rows.Scan(&shot.jobID, &shot.name, &shot.payload, &shot.runAt, &shot.failedAttempts, &shot.status); err != nil {
+			return nil, err
+		}
+		shot.runAt = shot.runAt.UTC()
+		out = append(out, shot)
+	}
+	return out, rows.Err()
+}
+
+// recordOneShotRunInDB stores a run and the one-shot job's new status together,
+// provided this instance still holds the scheduler lease.
+func (s *JobScheduler) recordOneShotRunInDB(ctx context.Context, rec *rgsv1.JobRun, shot *oneShotJob, now time.Time) error {
+	const q = `
+UPDATE scheduler_one_shot_jobs
+SET status = $2, run_at = $3, failed_attempts = $4, updated_at = $5
+WHERE job_id = $1
+`
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+	if err := s.checkLeaseHeldTx(ctx, tx); err != nil {
+		return err
+	}
+	if err := insertJobRunInDB(ctx, tx, rec); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, q, shot.jobID, shot.status, shot.runAt, shot.failedAttempts, now); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (s *JobScheduler) listJobRunsFromDB(ctx context.Context, jobName string, limit, offset int) ([]*rgsv1.JobRun, string, error) {
+	const q = `
+SELECT run_id, job_name, job_id, attempt, status, started_at, finished_at, summary, error, instance_id, retry_scheduled
+FROM scheduler_job_runs
+WHERE ($1 = '' OR job_name = $1)
+ORDER BY started_at DESC, run_id DESC
+LIMIT $2 OFFSET $3
+`
+	rows, err := s.db.QueryContext(ctx, q, jobName, limit, offset)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+	out := make([]*rgsv1.JobRun, 0)
+	for rows.Next() {
+		var (
+			rec      rgsv1.JobRun
+			status   string
+			started  time.Time
+			finished time.Time
+		)
+		if err := rows.Scan(&rec.RunId, &rec.JobName, &rec.JobId, &rec.Attempt, &status, &started, &finished, &rec.Summary, &rec.Error, &rec.InstanceId, &rec.RetryScheduled); err != nil {
+			return nil, "", err
+		}
+		rec.Status = jobRunStatusFromLabel(status)
+		rec.StartedAt = started.UTC().Format(time.RFC3339Nano)
+		rec.FinishedAt = finished.UTC().Format(time.RFC3339Nano)
+		out = append(out, &rec)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+	next := ""
+	if len(out) == limit {
+		next = strconv.Itoa(offset + len(out))
+	}
+	return out, next, nil
+}
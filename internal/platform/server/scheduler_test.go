@@ -0,0 +1,183 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func TestJobScheduleParsing(t *testing.T) {
+	base := time.Date(2026, 2, 23, 10, 17, 30, 0, time.UTC) // Monday
+	cases := []struct {
+		spec string
+		want time.Time
+	}{
+		{"@every 90s", base.Add(90 * time.Second)},
+		{"*/15 * * * *", time.Date(2026, 2, 23, 10, 30, 0, 0, time.UTC)},
+		{"0 7 * * *", time.Date(2026, 2, 24, 7, 0, 0, 0, time.UTC)},
+		{"30 6 * * 6,7", time.Date(2026, 2, 28, 6, 30, 0, 0, time.UTC)},
+		{"0 0 1 3 *", time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2026, 2, 23, 11, 0, 0, 0, time.UTC)},
+		{"0 12 15 * 5", time.Date(2026, 2, 27, 12, 0, 0, 0, time.UTC)},
+	}
+	for _, tc := range cases {
+		sched, err := parseJobSchedule(tc.spec)
+		if err != nil {
+			t.Fatalf("%s: parse failed: %v", tc.spec, err)
+		}
+		if got := sched.Next(base); !got.Equal(tc.want) {
+			t.Fatalf("%s: got=%s want=%s", tc.spec, got, tc.want)
+		}
+	}
+	for _, bad := range []string{"", "@every -1s", "* * * *", "61 * * * *", "0 0 30 2 *", "*/0 * * * *"} {
+		if _, err := parseJobSchedule(bad); err == nil {
+			t.Fatalf("%q: expected parse error", bad)
+		}
+	}
+}
+
+func TestJobSchedulerRetriesFailedRunsWithBackoff(t *testing.T) {
+	start := time.Date(2026, 2, 23, 10, 0, 0, 0, time.UTC)
+	s := NewJobScheduler(ledgerFixedClock{now: start}, "node-a")
+	var results []string
+	s.SetRunObserver(func(job, result string) { results = append(results, job+":"+result) })
+	calls := 0
+	if err := s.Register(JobSpec{
+		Name:     "flaky",
+		Schedule: Every(time.Hour),
+		Retry:    RetryPolicy{MaxAttempts: 3, Backoff: time.Minute, MaxBackoff: 90 * time.Second},
+		Run: func(context.Context, string) (string, error) {
+			calls++
+			return "", errors.New("downstream unavailable")
+		},
+	}); err != nil {
+		t.Fatalf("register job: %v", err)
+	}
+	ctx := context.Background()
+
+	if ran, _ := s.RunDue(ctx); ran != 0 {
+		t.Fatalf("expected nothing due before the first interval, ran=%d", ran)
+	}
+	at := func(d time.Duration) { s.Clock = ledgerFixedClock{now: start.Add(d)} }
+	at(time.Hour)
+	if ran, err := s.RunDue(ctx); ran != 1 || err != nil {
+		t.Fatalf("expected first run, ran=%d err=%v", ran, err)
+	}
+	// First retry waits the base backoff, the second is capped at MaxBackoff.
+	at(time.Hour + 59*time.Second)
+	if ran, _ := s.RunDue(ctx); ran != 0 {
+		t.Fatalf("expected retry to wait for backoff")
+	}
+	at(time.Hour + time.Minute)
+	_, _ = s.RunDue(ctx)
+	at(time.Hour + time.Minute + 90*time.Second)
+	_, _ = s.RunDue(ctx)
+	if calls != 3 {
+		t.Fatalf("expected three attempts, got=%d", calls)
+	}
+	jobs, _ := s.listJobs(ctx)
+	if len(jobs) != 1 || jobs[0].FailedAttempts != 0 || jobs[0].LastStatus != rgsv1.JobRunStatus_JOB_RUN_STATUS_FAILED {
+		t.Fatalf("expected retries exhausted and job back on schedule, got=%+v", jobs)
+	}
+	if want := start.Add(2*time.Hour + time.Minute + 90*time.Second).Format(time.RFC3339Nano); jobs[0].NextRunAt != want {
+		t.Fatalf("expected next regular run %s, got=%s", want, jobs[0].NextRunAt)
+	}
+
+	runs, _, _ := s.listRuns(ctx, "flaky", 10, 0)
+	if len(runs) != 3 || runs[0].Attempt != 3 || runs[0].RetryScheduled || !runs[2].RetryScheduled || runs[0].Error != "downstream unavailable" {
+		t.Fatalf("unexpected run history: %+v", runs)
+	}
+	if len(results) != 3 || results[0] != "flaky:failed" {
+		t.Fatalf("expected observer per run, got=%v", results)
+	}
+}
+
+func TestJobSchedulerOneShotJobs(t *testing.T) {
+	start := time.Date(2026, 2, 23, 10, 0, 0, 0, time.UTC)
+	s := NewJobScheduler(ledgerFixedClock{now: start}, "node-a")
+	var payloads []string
+	if err := s.Register(JobSpec{
+		Name:  "export",
+		Retry: RetryPolicy{MaxAttempts: 2, Backoff: time.Minute},
+		Run: func(_ context.Context, payload string) (string, error) {
+			payloads = append(payloads, payload)
+			if payload == "bad" {
+				return "", errors.New("export failed")
+			}
+			return "exported " + payload, nil
+		},
+	}); err != nil {
+		t.Fatalf("register handler: %v", err)
+	}
+	ctx := context.Background()
+	if _, err := s.ScheduleOnce(ctx, "unknown", time.Time{}, ""); err == nil {
+		t.Fatalf("expected unregistered job to be rejected")
+	}
+	okID, _ := s.ScheduleOnce(ctx, "export", start.Add(time.Minute), "day-1")
+	badID, _ := s.ScheduleOnce(ctx, "export", time.Time{}, "bad")
+
+	if ran, _ := s.RunDue(ctx); ran != 1 {
+		t.Fatalf("expected only the immediate job to run, ran=%d", ran)
+	}
+	s.Clock = ledgerFixedClock{now: start.Add(time.Minute)}
+	if ran, _ := s.RunDue(ctx); ran != 2 {
+		t.Fatalf("expected scheduled job and retry to run, ran=%d", ran)
+	}
+	s.Clock = ledgerFixedClock{now: start.Add(time.Hour)}
+	if ran, _ := s.RunDue(ctx); ran != 0 {
+		t.Fatalf("expected finished one-shot jobs not to rerun, ran=%d", ran)
+	}
+	if s.oneShots[okID].status != "succeeded" || s.oneShots[badID].status != "failed" {
+		t.Fatalf("unexpected one-shot states ok=%s bad=%s", s.oneShots[okID].status, s.oneShots[badID].status)
+	}
+	if len(payloads) != 3 {
+		t.Fatalf("expected three executions, got=%v", payloads)
+	}
+	jobs, _ := s.listJobs(ctx)
+	if len(jobs) != 0 {
+		t.Fatalf("expected one-shot handlers not listed as recurring jobs, got=%+v", jobs)
+	}
+}
+
+func TestSystemServiceListsJobsAndRuns(t *testing.T) {
+	start := time.Date(2026, 2, 23, 10, 0, 0, 0, time.UTC)
+	clk := ledgerFixedClock{now: start}
+	s := NewJobScheduler(clk, "node-a")
+	for _, name := range []string{"alpha", "beta"} {
+		if err := s.Register(JobSpec{Name: name, Schedule: "@every 1m", Run: func(context.Context, string) (string, error) {
+			return "ok", nil
+		}}); err != nil {
+			t.Fatalf("register %s: %v", name, err)
+		}
+	}
+	for i := 1; i <= 3; i++ {
+		s.Clock = ledgerFixedClock{now: start.Add(time.Duration(i) * time.Minute)}
+		_, _ = s.RunDue(context.Background())
+	}
+	svc := SystemService{StartedAt: start, Clock: clk, Scheduler: s}
+	ctx := context.Background()
+
+	jobs, _ := svc.ListScheduledJobs(ctx, &rgsv1.ListScheduledJobsRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")})
+	if jobs.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || len(jobs.Jobs) != 2 || jobs.Jobs[0].JobName != "alpha" || jobs.Jobs[0].LastStatus != rgsv1.JobRunStatus_JOB_RUN_STATUS_SUCCEEDED {
+		t.Fatalf("unexpected scheduled jobs: %+v", jobs)
+	}
+	page, _ := svc.ListJobRuns(ctx, &rgsv1.ListJobRunsRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), JobName: "beta", PageSize: 2})
+	if page.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || len(page.Runs) != 2 || page.NextPageToken != "2" || page.Runs[0].JobName != "beta" || page.Runs[0].InstanceId != "node-a" {
+		t.Fatalf("unexpected first page: %+v", page)
+	}
+	rest, _ := svc.ListJobRuns(ctx, &rgsv1.ListJobRunsRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), JobName: "beta", PageSize: 2, PageToken: page.NextPageToken})
+	if len(rest.Runs) != 1 || rest.NextPageToken != "" {
+		t.Fatalf("unexpected second page: %+v", rest)
+	}
+	denied, _ := svc.ListJobRuns(ctx, &rgsv1.ListJobRunsRequest{Meta: meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "")})
+	if denied.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected player denied, got=%+v", denied.Meta)
+	}
+	invalid, _ := svc.ListJobRuns(ctx, &rgsv1.ListJobRunsRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), PageToken: "x"})
+	if invalid.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected invalid page token, got=%+v", invalid.Meta)
+	}
+}
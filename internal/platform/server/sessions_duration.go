@@ -0,0 +1,195 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+const (
+	sessionMaxDurationReason = "max session duration reached"
+	sessionCoolOffReason     = "session cool-off in effect"
+)
+
+// SessionDurationPolicy caps how long a player session may run. A session
+// that reaches MaxDuration is terminated, and the player cannot start
+// another until CoolOff has passed since it ended. A zero MaxDuration
+// disables both.
+type SessionDurationPolicy struct {
+	MaxDuration time.Duration
+	CoolOff     time.Duration
+}
+
+func (s *SessionsService) SetDurationPolicy(policy SessionDurationPolicy) {
+	if s == nil {
+		return
+	}
+	if policy.MaxDuration < 0 {
+		policy.MaxDuration = 0
+	}
+	if policy.CoolOff < 0 {
+		policy.CoolOff = 0
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.durationPolicy = policy
+}
+
+// sessionMaxEnd returns when sess reaches the maximum duration, or the zero
+// time when no maximum is configured.
+func (s *SessionsService) sessionMaxEnd(sess *rgsv1.PlayerSession) time.Time {
+	started := parseTS(sess.StartedAt)
+	if s.durationPolicy.MaxDuration <= 0 || started.IsZero() {
+		return time.Time{}
+	}
+	return started.Add(s.durationPolicy.MaxDuration)
+}
+
+// expireSession returns a copy of sess moved to EXPIRED when it has timed
+// out or reached the maximum duration by now, or sess itself otherwise. A
+// session cut off by the maximum duration ends at the moment it was reached.
+func (s *SessionsService) expireSession(sess *rgsv1.PlayerSession, now time.Time) *rgsv1.PlayerSession {
+	if sess.State != rgsv1.SessionState_SESSION_STATE_ACTIVE {
+		return sess
+	}
+	expiresAt, err := time.Parse(time.RFC3339Nano, sess.ExpiresAt)
+	if err != nil {
+		expiresAt = now
+	}
+	maxEnd := s.sessionMaxEnd(sess)
+	overrun := !maxEnd.IsZero() && !now.Before(maxEnd)
+	if !overrun && !now.After(expiresAt) {
+		return sess
+	}
+	updated := cloneSession(sess)
+	updated.State = rgsv1.SessionState_SESSION_STATE_EXPIRED
+	updated.EndedAt = now.Format(time.RFC3339Nano)
+	if overrun {
+		updated.EndedAt = maxEnd.Format(time.RFC3339Nano)
+		if parseTS(updated.LastSeenAt).After(maxEnd) {
+			updated.LastSeenAt = updated.EndedAt
+		}
+		updated.EndReason = sessionMaxDurationReason
+	} else if updated.EndReason == "" {
+		updated.EndReason = "session timeout"
+	}
+	return updated
+}
+
+// overrunSessionsLocked lists up to limit active sessions started at or
+// before startedBefore, for playerID or, when it is empty, for every
+// player. s.mu must be held.
+func (s *SessionsService) overrunSessionsLocked(ctx context.Context, playerID string, startedBefore time.Time, limit int) ([]*rgsv1.PlayerSession, error) {
+	if s.db != nil {
+		return s.listOverrunSessionsFromDB(ctx, playerID, startedBefore, limit)
+	}
+	out := make([]*rgsv1.PlayerSession, 0)
+	for _, sess := range s.sessions {
+		if len(out) >= limit {
+			break
+		}
+		if sess.State != rgsv1.SessionState_SESSION_STATE_ACTIVE || (playerID != "" && sess.PlayerId != playerID) {
+			continue
+		}
+		if started := parseTS(sess.StartedAt); started.IsZero() || started.After(startedBefore) {
+			continue
+		}
+		out = append(out, cloneSession(sess))
+	}
+	return out, nil
+}
+
+// terminateOverrunSessionsLocked expires up to limit of playerID's, or any
+// player's, sessions that have reached the maximum duration and returns how
+// many it expired. s.mu must be held.
+func (s *SessionsService) terminateOverrunSessionsLocked(ctx context.Context, playerID string, limit int) (int, error) {
+	if s.durationPolicy.MaxDuration <= 0 {
+		return 0, nil
+	}
+	now := s.now()
+	items, err := s.overrunSessionsLocked(ctx, playerID, now.Add(-s.durationPolicy.MaxDuration), limit)
+	if err != nil {
+		return 0, err
+	}
+	terminated := 0
+	for _, sess := range items {
+		updated := s.expireSession(sess, now)
+		if updated == sess {
+			continue
+		}
+		if err := s.persistSession(ctx, updated); err != nil {
+			return terminated, err
+		}
+		if err := s.recordSessionClosed(ctx, updated); err != nil {
+			return terminated, err
+		}
+		if err := s.appendAudit(nil, updated.SessionId, "terminate_session", playerSessionSnapshot(sess), playerSessionSnapshot(updated), audit.ResultSuccess, updated.EndReason); err != nil {
+			return terminated, err
+		}
+		terminated++
+	}
+	return terminated, nil
+}
+
+// coolOffUntilLocked returns when playerID's cool-off after their last
+// session cut off by the maximum duration ends, or the zero time when there
+// is none. s.mu must be held.
+func (s *SessionsService) coolOffUntilLocked(ctx context.Context, playerID string) (time.Time, error) {
+	if s.durationPolicy.MaxDuration <= 0 || s.durationPolicy.CoolOff <= 0 {
+		return time.Time{}, nil
+	}
+	var last time.Time
+	if s.db != nil {
+		var err error
+		if last, err = s.lastOverrunEndFromDB(ctx, playerID); err != nil {
+			return time.Time{}, err
+		}
+	} else {
+		for _, sess := range s.sessions {
+			if sess.PlayerId != playerID || sess.EndReason != sessionMaxDurationReason {
+				continue
+			}
+			if ended := parseTS(sess.EndedAt); ended.After(last) {
+				last = ended
+			}
+		}
+	}
+	if last.IsZero() {
+		return time.Time{}, nil
+	}
+	return last.Add(s.durationPolicy.CoolOff), nil
+}
+
+// MaxDurationJob terminates sessions that have reached the maximum session
+// duration, batchSize at a time. It does nothing when sessions are neither
+// stored in the database nor cached in memory.
+func (s *SessionsService) MaxDurationJob(batchSize int) JobFunc {
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	return func(ctx context.Context, _ string) (string, error) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if s.db == nil && s.disableInMemoryCache {
+			return "", nil
+		}
+		total := 0
+		for {
+			n, err := s.terminateOverrunSessionsLocked(ctx, "", batchSize)
+			total += n
+			if err != nil {
+				return "", err
+			}
+			if n < batchSize {
+				break
+			}
+		}
+		if total == 0 {
+			return "", nil
+		}
+		return fmt.Sprintf("terminated %d sessions", total), nil
+	}
+}
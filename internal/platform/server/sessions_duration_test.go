@@ -0,0 +1,93 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func TestSessionMaxDurationTerminatesAndEnforcesCoolOff(t *testing.T) {
+	now := time.Date(2026, 3, 13, 20, 0, 0, 0, time.UTC)
+	svc := NewSessionsService(ledgerFixedClock{now: now})
+	svc.SetDurationPolicy(SessionDurationPolicy{MaxDuration: 2 * time.Hour, CoolOff: 30 * time.Minute})
+	ctx := context.Background()
+	start := func(playerID string) *rgsv1.StartSessionResponse {
+		t.Helper()
+		resp, err := svc.StartSession(ctx, &rgsv1.StartSessionRequest{Meta: meta(playerID, rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""), PlayerId: playerID, DeviceId: "device-a", SessionTimeoutSeconds: 4 * 3600})
+		if err != nil {
+			t.Fatalf("start session: %v", err)
+		}
+		return resp
+	}
+
+	first := start("player-1")
+	if first.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK || first.Session.ExpiresAt != now.Add(2*time.Hour).Format(time.RFC3339Nano) {
+		t.Fatalf("expected the session timeout to be capped at the max duration, got %+v", first)
+	}
+	other := start("player-2")
+
+	job := svc.MaxDurationJob(1)
+	svc.Clock = ledgerFixedClock{now: now.Add(time.Hour)}
+	if summary, err := job(ctx, ""); err != nil || summary != "" {
+		t.Fatalf("expected nothing to terminate before the max duration, got %q %v", summary, err)
+	}
+	svc.Clock = ledgerFixedClock{now: now.Add(2*time.Hour + 5*time.Minute)}
+	if summary, err := job(ctx, ""); err != nil || summary != "terminated 2 sessions" {
+		t.Fatalf("unexpected job summary %q %v", summary, err)
+	}
+	got, _ := svc.GetSession(ctx, &rgsv1.GetSessionRequest{Meta: meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""), SessionId: first.Session.SessionId})
+	if got.Session.State != rgsv1.SessionState_SESSION_STATE_EXPIRED || got.Session.EndReason != "max session duration reached" || got.Session.EndedAt != now.Add(2*time.Hour).Format(time.RFC3339Nano) {
+		t.Fatalf("expected the session to end when it reached the max duration, got %+v", got.Session)
+	}
+
+	if denied := start("player-1"); denied.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_DENIED || denied.Meta.DenialReason != "session cool-off in effect" {
+		t.Fatalf("expected a start during the cool-off to be denied, got %+v", denied.Meta)
+	}
+	svc.Clock = ledgerFixedClock{now: now.Add(2*time.Hour + 30*time.Minute)}
+	second := start("player-1")
+	if second.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected a start once the cool-off lapsed, got %+v", second.Meta)
+	}
+
+	// A session the worker has not reached yet still starts the cool-off.
+	svc.Clock = ledgerFixedClock{now: now.Add(4*time.Hour + 40*time.Minute)}
+	if denied := start("player-1"); denied.Meta.DenialReason != "session cool-off in effect" {
+		t.Fatalf("expected an overrun session to be cut off and start the cool-off, got %+v", denied.Meta)
+	}
+	if ended, _ := svc.EndSession(ctx, &rgsv1.EndSessionRequest{Meta: meta("player-2", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""), SessionId: other.Session.SessionId}); ended.Session.State != rgsv1.SessionState_SESSION_STATE_EXPIRED {
+		t.Fatalf("expected the terminated session to stay expired, got %+v", ended.Session)
+	}
+
+	var terminated, deniedStarts int
+	for _, ev := range auditEvents(svc.AuditStore) {
+		switch {
+		case ev.Action == "terminate_session" && ev.Result == "success":
+			terminated++
+		case ev.Action == "start_session" && ev.Reason == "session cool-off in effect":
+			deniedStarts++
+		}
+	}
+	if terminated != 3 || deniedStarts != 2 {
+		t.Fatalf("expected terminations and cool-off denials to be audited, got terminated=%d denied=%d", terminated, deniedStarts)
+	}
+}
+
+func TestSessionMaxDurationJobSkipsWithoutPersistence(t *testing.T) {
+	now := time.Date(2026, 3, 13, 20, 0, 0, 0, time.UTC)
+	svc := NewSessionsService(ledgerFixedClock{now: now})
+	svc.SetDurationPolicy(SessionDurationPolicy{MaxDuration: time.Hour})
+	svc.sessions["sess-stale"] = &rgsv1.PlayerSession{SessionId: "sess-stale", PlayerId: "player-1", State: rgsv1.SessionState_SESSION_STATE_ACTIVE, StartedAt: now.Add(-2 * time.Hour).Format(time.RFC3339Nano), ExpiresAt: now.Add(time.Hour).Format(time.RFC3339Nano)}
+	svc.SetDisableInMemoryCache(true)
+
+	if summary, err := svc.MaxDurationJob(1)(context.Background(), ""); err != nil || summary != "" {
+		t.Fatalf("expected the job to do nothing without a database or in-memory cache, got %q %v", summary, err)
+	}
+	if got := svc.sessions["sess-stale"]; got.State != rgsv1.SessionState_SESSION_STATE_ACTIVE {
+		t.Fatalf("expected the stale cache entry to be left alone, got %+v", got)
+	}
+	if events := auditEvents(svc.AuditStore); len(events) != 0 {
+		t.Fatalf("expected nothing audited, got %+v", events)
+	}
+}
@@ -18,12 +18,19 @@ type SessionsService struct {
 	rgsv1.UnimplementedSessionsServiceServer
 
 	Clock      clock.Clock
-	AuditStore *audit.InMemoryStore
+	AuditStore audit.Store
+	// Overlay, when set with a reality check interval, receives the
+	// reality check windows pushed to players' devices.
+	Overlay *UISystemOverlayService
 
 	mu                   sync.Mutex
 	sessions             map[string]*rgsv1.PlayerSession
+	activity             map[string]*sessionActivity
+	dailyActivity        map[string]*sessionActivity
 	nextAuditID          int64
 	defaultTimeout       time.Duration
+	durationPolicy       SessionDurationPolicy
+	realityCheckInterval time.Duration
 	db                   *sql.DB
 	disableInMemoryCache bool
 }
@@ -35,8 +42,10 @@ func NewSessionsService(clk clock.Clock, db ...*sql.DB) *SessionsService {
 	}
 	return &SessionsService{
 		Clock:          clk,
-		AuditStore:     audit.NewInMemoryStore(),
+		AuditStore:     NewAuditStore(handle),
 		sessions:       make(map[string]*rgsv1.PlayerSession),
+		activity:       make(map[string]*sessionActivity),
+		dailyActivity:  make(map[string]*sessionActivity),
 		defaultTimeout: time.Hour,
 		db:             handle,
 	}
@@ -82,38 +91,8 @@ func (s *SessionsService) nextAuditIDLocked() string {
 }
 
 func (s *SessionsService) appendAudit(meta *rgsv1.RequestMeta, objectID, action string, before, after []byte, result audit.Result, reason string) error {
-	if s.AuditStore == nil {
-		return audit.ErrCorruptChain
-	}
-	actorID := "system"
-	actorType := "service"
-	if meta != nil && meta.Actor != nil {
-		actorID = meta.Actor.ActorId
-		actorType = meta.Actor.ActorType.String()
-	}
-	now := s.now()
-	ev := audit.Event{
-		AuditID:      s.nextAuditIDLocked(),
-		OccurredAt:   now,
-		RecordedAt:   now,
-		ActorID:      actorID,
-		ActorType:    actorType,
-		ObjectType:   "player_session",
-		ObjectID:     objectID,
-		Action:       action,
-		Before:       before,
-		After:        after,
-		Result:       result,
-		Reason:       reason,
-		PartitionDay: now.Format("2006-01-02"),
-	}
-	if s.db != nil {
-		if err := appendAuditEventToDB(context.Background(), s.db, ev); err != nil {
-			return err
-		}
-	}
-	_, err := s.AuditStore.Append(ev)
-	return err
+	ev := newAuditEvent(meta, s.nextAuditIDLocked(), s.now(), "player_session", objectID, action, before, after, result, reason)
+	return appendAuditTo(context.Background(), s.AuditStore, ev)
 }
 
 func cloneSession(in *rgsv1.PlayerSession) *rgsv1.PlayerSession {
@@ -208,18 +187,7 @@ func (s *SessionsService) touchAndExpireSessionIfNeeded(ctx context.Context, ses
 		return nil, nil
 	}
 	now := s.now()
-	expiresAt, err := time.Parse(time.RFC3339Nano, sess.ExpiresAt)
-	if err != nil {
-		expiresAt = now
-	}
-	updated := cloneSession(sess)
-	if updated.State == rgsv1.SessionState_SESSION_STATE_ACTIVE && now.After(expiresAt) {
-		updated.State = rgsv1.SessionState_SESSION_STATE_EXPIRED
-		updated.EndedAt = now.Format(time.RFC3339Nano)
-		if updated.EndReason == "" {
-			updated.EndReason = "session timeout"
-		}
-	}
+	updated := cloneSession(s.expireSession(sess, now))
 	if updated.State == rgsv1.SessionState_SESSION_STATE_ACTIVE {
 		updated.LastSeenAt = now.Format(time.RFC3339Nano)
 	}
@@ -227,6 +195,11 @@ func (s *SessionsService) touchAndExpireSessionIfNeeded(ctx context.Context, ses
 		if err := s.persistSession(ctx, updated); err != nil {
 			return nil, err
 		}
+		if updated.State != sess.State {
+			if err := s.recordSessionClosed(ctx, updated); err != nil {
+				return nil, err
+			}
+		}
 	}
 	return updated, nil
 }
@@ -247,12 +220,28 @@ func (s *SessionsService) StartSession(ctx context.Context, req *rgsv1.StartSess
 		return &rgsv1.StartSessionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
 	}
 
+	// Sessions the worker has not yet cut off still start the cool-off.
+	if _, err := s.terminateOverrunSessionsLocked(ctx, req.PlayerId, 100); err != nil {
+		return &rgsv1.StartSessionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	now := s.now()
+	coolOffUntil, err := s.coolOffUntilLocked(ctx, req.PlayerId)
+	if err != nil {
+		return &rgsv1.StartSessionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if now.Before(coolOffUntil) {
+		_ = s.appendAudit(req.Meta, "", "start_session", []byte(`{}`), []byte(`{}`), audit.ResultDenied, sessionCoolOffReason)
+		return &rgsv1.StartSessionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, sessionCoolOffReason)}, nil
+	}
+
 	rawToken, err := randomToken()
 	if err != nil {
 		return &rgsv1.StartSessionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "failed to create session id")}, nil
 	}
-	now := s.now()
 	timeout := s.timeoutForRequest(req)
+	if maxDuration := s.durationPolicy.MaxDuration; maxDuration > 0 && timeout > maxDuration {
+		timeout = maxDuration
+	}
 	sess := &rgsv1.PlayerSession{
 		SessionId:  "sess-" + rawToken,
 		PlayerId:   req.PlayerId,
@@ -304,6 +293,9 @@ func (s *SessionsService) EndSession(ctx context.Context, req *rgsv1.EndSessionR
 		if err := s.persistSession(ctx, updated); err != nil {
 			return &rgsv1.EndSessionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
 		}
+		if err := s.recordSessionClosed(ctx, updated); err != nil {
+			return &rgsv1.EndSessionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
 	}
 	if err := s.appendAudit(req.Meta, req.SessionId, "end_session", before, playerSessionSnapshot(updated), audit.ResultSuccess, req.Reason); err != nil {
 		return &rgsv1.EndSessionResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
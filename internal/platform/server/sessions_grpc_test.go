@@ -182,7 +182,7 @@ func TestSessionsActorMismatchDenied(t *testing.T) {
 		t.Fatalf("expected actor mismatch denial on end, got=%q", ended.Meta.GetDenialReason())
 	}
 
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) < 3 {
 		t.Fatalf("expected denied session audit events, got=%v", events)
 	}
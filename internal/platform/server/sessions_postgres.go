@@ -41,9 +41,10 @@ func (s *SessionsService) upsertSessionInDB(ctx context.Context, sess *rgsv1.Pla
 	}
 	const q = `
 INSERT INTO player_sessions (
-  session_id, player_id, device_id, state, started_at, last_seen_at, ended_at, expires_at, end_reason, created_at, updated_at
+  session_id, player_id, device_id, state, started_at, last_seen_at, ended_at, expires_at, end_reason,
+  reality_check_window_id, reality_check_acknowledged_at, created_at, updated_at
 )
-VALUES ($1,$2,$3,$4,$5::timestamptz,$6::timestamptz,NULLIF($7,'')::timestamptz,$8::timestamptz,$9,NOW(),NOW())
+VALUES ($1,$2,$3,$4,$5::timestamptz,$6::timestamptz,NULLIF($7,'')::timestamptz,$8::timestamptz,$9,$10,NULLIF($11,'')::timestamptz,NOW(),NOW())
 ON CONFLICT (session_id) DO UPDATE SET
   player_id = EXCLUDED.player_id,
   device_id = EXCLUDED.device_id,
@@ -53,6 +54,8 @@ ON CONFLICT (session_id) DO UPDATE SET
   ended_at = EXCLUDED.ended_at,
   expires_at = EXCLUDED.expires_at,
   end_reason = EXCLUDED.end_reason,
+  reality_check_window_id = EXCLUDED.reality_check_window_id,
+  reality_check_acknowledged_at = EXCLUDED.reality_check_acknowledged_at,
   updated_at = NOW()
 `
 	_, err := s.db.ExecContext(ctx, q,
@@ -65,6 +68,8 @@ ON CONFLICT (session_id) DO UPDATE SET
 		sess.EndedAt,
 		nonEmptyTime(sess.ExpiresAt),
 		sess.EndReason,
+		sess.RealityCheckWindowId,
+		sess.RealityCheckAcknowledgedAt,
 	)
 	return err
 }
@@ -74,17 +79,28 @@ func (s *SessionsService) getSessionFromDB(ctx context.Context, sessionID string
 		return nil, nil
 	}
 	const q = `
-SELECT session_id, player_id, device_id, state, started_at, last_seen_at, ended_at, expires_at, end_reason
+SELECT ` + playerSessionColumns + `
 FROM player_sessions
 WHERE session_id = $1
 `
+	sess, err := scanPlayerSession(s.db.QueryRowContext(ctx, q, sessionID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return sess, err
+}
+
+const playerSessionColumns = `session_id, player_id, device_id, state, started_at, last_seen_at, ended_at, expires_at, end_reason,
+       reality_check_window_id, reality_check_acknowledged_at`
+
+func scanPlayerSession(row interface{ Scan(...any) error }) (*rgsv1.PlayerSession, error) {
 	var (
 		sess                             rgsv1.PlayerSession
 		stateRaw                         string
 		startedAt, lastSeenAt, expiresAt time.Time
-		endedAt                          *time.Time
+		endedAt, acknowledgedAt          *time.Time
 	)
-	err := s.db.QueryRowContext(ctx, q, sessionID).Scan(
+	if err := row.Scan(
 		&sess.SessionId,
 		&sess.PlayerId,
 		&sess.DeviceId,
@@ -94,11 +110,9 @@ WHERE session_id = $1
 		&endedAt,
 		&expiresAt,
 		&sess.EndReason,
-	)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, nil
-		}
+		&sess.RealityCheckWindowId,
+		&acknowledgedAt,
+	); err != nil {
 		return nil, err
 	}
 	sess.State = sessionStateFromDB(stateRaw)
@@ -108,5 +122,238 @@ WHERE session_id = $1
 		sess.EndedAt = endedAt.UTC().Format(time.RFC3339Nano)
 	}
 	sess.ExpiresAt = expiresAt.UTC().Format(time.RFC3339Nano)
+	if acknowledgedAt != nil {
+		sess.RealityCheckAcknowledgedAt = acknowledgedAt.UTC().Format(time.RFC3339Nano)
+	}
 	return &sess, nil
 }
+
+// recordActivityInDB applies delta to the player's newest active session and
+// to the day's rollup in one transaction.
+func (s *SessionsService) recordActivityInDB(ctx context.Context, playerID string, at time.Time, delta sessionActivity) error {
+	if s == nil || s.db == nil {
+		return nil
+	}
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if delta.wagerCount != 0 || delta.wageredMinor != 0 || delta.wonMinor != 0 {
+		const updateSession = `
+UPDATE player_sessions
+SET activity_currency = CASE WHEN activity_currency = '' THEN $2 ELSE activity_currency END,
+    wager_count = wager_count + $3,
+    wagered_minor = wagered_minor + $4,
+    won_minor = won_minor + $5,
+    updated_at = NOW()
+WHERE session_id = (
+  SELECT session_id FROM player_sessions
+  WHERE player_id = $1 AND started_at <= $6::timestamptz
+    AND COALESCE(ended_at, expires_at) >= $6::timestamptz
+  ORDER BY started_at DESC
+  LIMIT 1
+)
+`
+		if _, err := tx.ExecContext(ctx, updateSession, playerID, delta.currency, delta.wagerCount, delta.wageredMinor, delta.wonMinor, at); err != nil {
+			return err
+		}
+	}
+	const upsertDaily = `
+INSERT INTO player_activity_daily (
+  player_id, activity_day, currency, wager_count, wagered_minor, won_minor, time_played_seconds, updated_at
+)
+VALUES ($1,$2::date,$3,$4,$5,$6,$7,NOW())
+ON CONFLICT (player_id, activity_day) DO UPDATE SET
+  currency = CASE WHEN player_activity_daily.currency = '' THEN EXCLUDED.currency ELSE player_activity_daily.currency END,
+  wager_count = player_activity_daily.wager_count + EXCLUDED.wager_count,
+  wagered_minor = player_activity_daily.wagered_minor + EXCLUDED.wagered_minor,
+  won_minor = player_activity_daily.won_minor + EXCLUDED.won_minor,
+  time_played_seconds = player_activity_daily.time_played_seconds + EXCLUDED.time_played_seconds,
+  updated_at = NOW()
+`
+	if _, err := tx.ExecContext(ctx, upsertDaily, playerID, gamingCalendarFor("").GamingDay(at), delta.currency, delta.wagerCount, delta.wageredMinor, delta.wonMinor, delta.timePlayedSeconds); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (s *SessionsService) getSessionActivityFromDB(ctx context.Context, sessionID string) (sessionActivity, error) {
+	var out sessionActivity
+	if s == nil || s.db == nil {
+		return out, nil
+	}
+	const q = `
+SELECT TRIM(activity_currency), wager_count, wagered_minor, won_minor
+FROM player_sessions
+WHERE session_id = $1
+`
+	err := s.db.QueryRowContext(ctx, q, sessionID).Scan(&out.currency, &out.wagerCount, &out.wageredMinor, &out.wonMinor)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return sessionActivity{}, err
+	}
+	return out, nil
+}
+
+func (s *SessionsService) getPlayerActivitySinceFromDB(ctx context.Context, playerID, fromDay string) (sessionActivity, error) {
+	var out sessionActivity
+	if s == nil || s.db == nil {
+		return out, nil
+	}
+	const q = `
+SELECT COALESCE(MAX(NULLIF(TRIM(currency), '')), ''),
+       COALESCE(SUM(wager_count), 0),
+       COALESCE(SUM(wagered_minor), 0),
+       COALESCE(SUM(won_minor), 0),
+       COALESCE(SUM(time_played_seconds), 0)
+FROM player_activity_daily
+WHERE player_id = $1 AND activity_day >= $2::date
+`
+	err := s.db.QueryRowContext(ctx, q, playerID, fromDay).Scan(&out.currency, &out.wagerCount, &out.wageredMinor, &out.wonMinor, &out.timePlayedSeconds)
+	if err != nil {
+		return sessionActivity{}, err
+	}
+	return out, nil
+}
+
+// listPlayerSessionsFromDB returns the player's sessions newest first with
+// their activity counters.
+func (s *SessionsService) listPlayerSessionsFromDB(ctx context.Context, playerID string, limit, offset int) ([]playerSessionRecord, error) {
+	if s == nil || s.db == nil {
+		return nil, nil
+	}
+	const q = `
+SELECT session_id, player_id, device_id, state, started_at, last_seen_at, ended_at, expires_at, end_reason,
+       TRIM(activity_currency), wager_count, wagered_minor, won_minor
+FROM player_sessions
+WHERE player_id = $1
+ORDER BY started_at DESC, session_id DESC
+LIMIT $2 OFFSET $3
+`
+	rows, err := s.db.QueryContext(ctx, q, playerID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]playerSessionRecord, 0)
+	for rows.Next() {
+		var (
+			sess                             rgsv1.PlayerSession
+			activity                         sessionActivity
+			stateRaw                         string
+			startedAt, lastSeenAt, expiresAt time.Time
+			endedAt                          *time.Time
+		)
+		if err := rows.Scan(
+			&sess.SessionId,
+			&sess.PlayerId,
+			&sess.DeviceId,
+			&stateRaw,
+			&startedAt,
+			&lastSeenAt,
+			&endedAt,
+			&expiresAt,
+			&sess.EndReason,
+			&activity.currency,
+			&activity.wagerCount,
+			&activity.wageredMinor,
+			&activity.wonMinor,
+		); err != nil {
+			return nil, err
+		}
+		sess.State = sessionStateFromDB(stateRaw)
+		sess.StartedAt = startedAt.UTC().Format(time.RFC3339Nano)
+		sess.LastSeenAt = lastSeenAt.UTC().Format(time.RFC3339Nano)
+		if endedAt != nil {
+			sess.EndedAt = endedAt.UTC().Format(time.RFC3339Nano)
+		}
+		sess.ExpiresAt = expiresAt.UTC().Format(time.RFC3339Nano)
+		out = append(out, playerSessionRecord{session: &sess, activity: activity})
+	}
+	return out, rows.Err()
+}
+
+func (s *SessionsService) listOverrunSessionsFromDB(ctx context.Context, playerID string, startedBefore time.Time, limit int) ([]*rgsv1.PlayerSession, error) {
+	const q = `
+SELECT ` + playerSessionColumns + `
+FROM player_sessions
+WHERE state = 'ACTIVE'
+  AND started_at <= $1
+  AND ($2 = '' OR player_id = $2)
+ORDER BY started_at ASC
+LIMIT $3
+`
+	rows, err := s.db.QueryContext(ctx, q, startedBefore.UTC(), playerID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]*rgsv1.PlayerSession, 0)
+	for rows.Next() {
+		sess, err := scanPlayerSession(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, sess)
+	}
+	return out, rows.Err()
+}
+
+func (s *SessionsService) lastOverrunEndFromDB(ctx context.Context, playerID string) (time.Time, error) {
+	const q = `
+SELECT MAX(ended_at)
+FROM player_sessions
+WHERE player_id = $1
+  AND end_reason = $2
+`
+	var last sql.NullTime
+	if err := s.db.QueryRowContext(ctx, q, playerID, sessionMaxDurationReason).Scan(&last); err != nil {
+		return time.Time{}, err
+	}
+	return last.Time, nil
+}
+
+func (s *SessionsService) getActiveSessionForPlayerFromDB(ctx context.Context, playerID string, now time.Time) (*rgsv1.PlayerSession, error) {
+	const q = `
+SELECT ` + playerSessionColumns + `
+FROM player_sessions
+WHERE player_id = $1 AND state = 'ACTIVE' AND expires_at >= $2
+ORDER BY started_at DESC
+LIMIT 1
+`
+	sess, err := scanPlayerSession(s.db.QueryRowContext(ctx, q, playerID, now.UTC()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return sess, err
+}
+
+func (s *SessionsService) listRealityChecksDueFromDB(ctx context.Context, playedSince, now time.Time, limit int) ([]*rgsv1.PlayerSession, error) {
+	const q = `
+SELECT ` + playerSessionColumns + `
+FROM player_sessions
+WHERE state = 'ACTIVE'
+  AND expires_at >= $2
+  AND reality_check_window_id = ''
+  AND COALESCE(reality_check_acknowledged_at, started_at) <= $1
+ORDER BY started_at ASC
+LIMIT $3
+`
+	rows, err := s.db.QueryContext(ctx, q, playedSince.UTC(), now.UTC(), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]*rgsv1.PlayerSession, 0)
+	for rows.Next() {
+		sess, err := scanPlayerSession(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, sess)
+	}
+	return out, rows.Err()
+}
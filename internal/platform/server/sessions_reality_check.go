@@ -0,0 +1,224 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+const (
+	realityCheckWindowPrefix  = "reality-check:"
+	realityCheckPendingReason = "reality check acknowledgment required"
+)
+
+// SetRealityCheckInterval sets how long a player may play in a session
+// before a reality check is pushed to their device through Overlay.
+// Non-positive values disable reality checks.
+func (s *SessionsService) SetRealityCheckInterval(d time.Duration) {
+	if s == nil {
+		return
+	}
+	if d < 0 {
+		d = 0
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.realityCheckInterval = d
+}
+
+// realityCheckWindowID names the system window of a reality check so an
+// acknowledgment can be traced back to its session.
+func realityCheckWindowID(sessionID string, at time.Time) string {
+	return realityCheckWindowPrefix + sessionID + ":" + strconv.FormatInt(at.Unix(), 10)
+}
+
+func realityCheckSessionID(windowID string) (string, bool) {
+	rest, ok := strings.CutPrefix(windowID, realityCheckWindowPrefix)
+	if !ok {
+		return "", false
+	}
+	sessionID, _, ok := strings.Cut(rest, ":")
+	return sessionID, ok && sessionID != ""
+}
+
+func (s *SessionsService) realityCheckEnabled() bool {
+	return s.realityCheckInterval > 0 && s.Overlay != nil
+}
+
+// realityCheckDue reports whether sess has been played for the reality
+// check interval since it started or since its last acknowledged check.
+func (s *SessionsService) realityCheckDue(sess *rgsv1.PlayerSession, now time.Time) bool {
+	if sess.State != rgsv1.SessionState_SESSION_STATE_ACTIVE || sess.RealityCheckWindowId != "" {
+		return false
+	}
+	anchor := parseTS(sess.RealityCheckAcknowledgedAt)
+	if anchor.IsZero() {
+		anchor = parseTS(sess.StartedAt)
+	}
+	return !anchor.IsZero() && !now.Before(anchor.Add(s.realityCheckInterval))
+}
+
+// triggerRealityCheckLocked pushes a reality check window to the session's
+// device when one is due and marks the session as waiting for it to be
+// acknowledged. The window is pushed first so a session never blocks
+// wagering on a check the device was not shown. s.mu must be held.
+func (s *SessionsService) triggerRealityCheckLocked(ctx context.Context, sess *rgsv1.PlayerSession, now time.Time) (*rgsv1.PlayerSession, error) {
+	if !s.realityCheckEnabled() || !s.realityCheckDue(sess, now) {
+		return sess, nil
+	}
+	activity, err := s.loadSessionActivity(ctx, sess.SessionId)
+	if err != nil {
+		return nil, err
+	}
+	details, _ := json.Marshal(map[string]any{
+		"session_id":     sess.SessionId,
+		"minutes_played": int64(now.Sub(parseTS(sess.StartedAt)) / time.Minute),
+		"wager_count":    activity.wagerCount,
+		"wagered_minor":  activity.wageredMinor,
+		"won_minor":      activity.wonMinor,
+		"currency":       activity.currency,
+	})
+	updated := cloneSession(sess)
+	updated.RealityCheckWindowId = realityCheckWindowID(sess.SessionId, now)
+	if err := s.Overlay.pushSystemWindowEvent(ctx, &rgsv1.SystemWindowEvent{
+		EquipmentId: sess.DeviceId,
+		PlayerId:    sess.PlayerId,
+		WindowId:    updated.RealityCheckWindowId,
+		EventType:   rgsv1.SystemWindowEventType_SYSTEM_WINDOW_EVENT_TYPE_OPENED,
+		EventTime:   now.Format(time.RFC3339Nano),
+		Details:     string(details),
+	}); err != nil {
+		return nil, err
+	}
+	if err := s.persistSession(ctx, updated); err != nil {
+		return nil, err
+	}
+	if err := s.appendAudit(nil, sess.SessionId, "trigger_reality_check", playerSessionSnapshot(sess), playerSessionSnapshot(updated), audit.ResultSuccess, ""); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// realityCheckPending reports whether playerID must acknowledge a reality
+// check before wagering again, pushing one first if it has fallen due. A
+// nil service never blocks.
+func (s *SessionsService) realityCheckPending(ctx context.Context, playerID string) (bool, error) {
+	if s == nil {
+		return false, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.realityCheckEnabled() {
+		return false, nil
+	}
+	now := s.now()
+	var sess *rgsv1.PlayerSession
+	if s.db != nil {
+		var err error
+		if sess, err = s.getActiveSessionForPlayerFromDB(ctx, playerID, now); err != nil {
+			return false, err
+		}
+	} else {
+		sess = cloneSession(s.activeSessionForPlayerLocked(playerID, now))
+	}
+	if sess == nil {
+		return false, nil
+	}
+	sess, err := s.triggerRealityCheckLocked(ctx, sess, now)
+	if err != nil {
+		return false, err
+	}
+	return sess.RealityCheckWindowId != "", nil
+}
+
+// acknowledgeRealityCheck clears the pending reality check that ev closes,
+// if any, and restarts the play time counted toward the next one. Closes
+// of other windows, or of a reality check no longer pending, are ignored.
+func (s *SessionsService) acknowledgeRealityCheck(ctx context.Context, meta *rgsv1.RequestMeta, ev *rgsv1.SystemWindowEvent) error {
+	if s == nil {
+		return nil
+	}
+	sessionID, ok := realityCheckSessionID(ev.WindowId)
+	if !ok {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sess, err := s.loadSession(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+	if sess == nil || sess.RealityCheckWindowId != ev.WindowId || sess.DeviceId != ev.EquipmentId {
+		return nil
+	}
+	updated := cloneSession(sess)
+	updated.RealityCheckWindowId = ""
+	updated.RealityCheckAcknowledgedAt = s.now().Format(time.RFC3339Nano)
+	if err := s.persistSession(ctx, updated); err != nil {
+		return err
+	}
+	return s.appendAudit(meta, sessionID, "acknowledge_reality_check", playerSessionSnapshot(sess), playerSessionSnapshot(updated), audit.ResultSuccess, "")
+}
+
+// realityChecksDueLocked lists up to limit active sessions due a reality
+// check. s.mu must be held.
+func (s *SessionsService) realityChecksDueLocked(ctx context.Context, now time.Time, limit int) ([]*rgsv1.PlayerSession, error) {
+	if s.db != nil {
+		return s.listRealityChecksDueFromDB(ctx, now.Add(-s.realityCheckInterval), now, limit)
+	}
+	out := make([]*rgsv1.PlayerSession, 0)
+	for _, sess := range s.sessions {
+		if len(out) >= limit {
+			break
+		}
+		if expires := parseTS(sess.ExpiresAt); !expires.IsZero() && now.After(expires) {
+			continue
+		}
+		if s.realityCheckDue(sess, now) {
+			out = append(out, cloneSession(sess))
+		}
+	}
+	return out, nil
+}
+
+// RealityCheckJob pushes reality checks to the devices of sessions that
+// have been played for the reality check interval, batchSize at a time.
+func (s *SessionsService) RealityCheckJob(batchSize int) JobFunc {
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	return func(ctx context.Context, _ string) (string, error) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if !s.realityCheckEnabled() {
+			return "", nil
+		}
+		now := s.now()
+		pushed := 0
+		for {
+			items, err := s.realityChecksDueLocked(ctx, now, batchSize)
+			if err != nil {
+				return "", err
+			}
+			for _, sess := range items {
+				if _, err := s.triggerRealityCheckLocked(ctx, sess, now); err != nil {
+					return "", err
+				}
+				pushed++
+			}
+			if len(items) < batchSize {
+				break
+			}
+		}
+		if pushed == 0 {
+			return "", nil
+		}
+		return fmt.Sprintf("pushed %d reality checks", pushed), nil
+	}
+}
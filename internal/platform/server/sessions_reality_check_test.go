@@ -0,0 +1,114 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func TestRealityCheckBlocksWageringUntilAcknowledged(t *testing.T) {
+	now := time.Date(2026, 3, 14, 19, 0, 0, 0, time.UTC)
+	clk := ledgerFixedClock{now: now}
+	sessions := NewSessionsService(clk)
+	overlay := NewUISystemOverlayService(clk)
+	sessions.Overlay = overlay
+	overlay.Sessions = sessions
+	sessions.SetRealityCheckInterval(time.Hour)
+	wagering := NewWageringService(clk)
+	wagering.Sessions = sessions
+	ctx := context.Background()
+	device := meta("egm-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, "")
+
+	setNow := func(at time.Time) {
+		sessions.Clock = ledgerFixedClock{now: at}
+		overlay.Clock = ledgerFixedClock{now: at}
+		wagering.Clock = ledgerFixedClock{now: at}
+	}
+	wager := func(idem string) *rgsv1.ResponseMeta {
+		t.Helper()
+		resp, _ := wagering.PlaceWager(ctx, &rgsv1.PlaceWagerRequest{Meta: meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, idem), PlayerId: "player-1", GameId: "game-1", Stake: &rgsv1.Money{AmountMinor: 100, Currency: "USD"}})
+		return resp.Meta
+	}
+	windows := func() []*rgsv1.SystemWindowEvent {
+		t.Helper()
+		resp, _ := overlay.ListSystemWindowEvents(ctx, &rgsv1.ListSystemWindowEventsRequest{Meta: device, EquipmentId: "egm-1"})
+		return resp.Events
+	}
+	closeWindow := func(windowID string) {
+		t.Helper()
+		resp, _ := overlay.SubmitSystemWindowEvent(ctx, &rgsv1.SubmitSystemWindowEventRequest{Meta: device, Event: &rgsv1.SystemWindowEvent{
+			EquipmentId: "egm-1", PlayerId: "player-1", WindowId: windowID, EventType: rgsv1.SystemWindowEventType_SYSTEM_WINDOW_EVENT_TYPE_CLOSED,
+		}})
+		if resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("close window %s: %+v", windowID, resp.Meta)
+		}
+	}
+
+	start, _ := sessions.StartSession(ctx, &rgsv1.StartSessionRequest{Meta: meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""), PlayerId: "player-1", DeviceId: "egm-1", SessionTimeoutSeconds: 4 * 3600})
+	if m := wager("w-1"); m.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected a wager before the reality check, got %+v", m)
+	}
+
+	job := sessions.RealityCheckJob(10)
+	setNow(now.Add(59 * time.Minute))
+	if summary, err := job(ctx, ""); err != nil || summary != "" {
+		t.Fatalf("expected no reality check before the interval, got %q %v", summary, err)
+	}
+	setNow(now.Add(time.Hour))
+	if summary, err := job(ctx, ""); err != nil || summary != "pushed 1 reality checks" {
+		t.Fatalf("unexpected job summary %q %v", summary, err)
+	}
+	pushed := windows()
+	if len(pushed) != 1 || pushed[0].EventType != rgsv1.SystemWindowEventType_SYSTEM_WINDOW_EVENT_TYPE_OPENED || pushed[0].PlayerId != "player-1" {
+		t.Fatalf("expected a reality check window on the device, got %+v", pushed)
+	}
+	var details struct {
+		MinutesPlayed int64 `json:"minutes_played"`
+		WageredMinor  int64 `json:"wagered_minor"`
+	}
+	if err := json.Unmarshal([]byte(pushed[0].Details), &details); err != nil || details.MinutesPlayed != 60 || details.WageredMinor != 100 {
+		t.Fatalf("unexpected reality check details %q: %v", pushed[0].Details, err)
+	}
+	if summary, _ := job(ctx, ""); summary != "" {
+		t.Fatalf("expected a pending reality check not to be pushed again, got %q", summary)
+	}
+
+	if m := wager("w-2"); m.ResultCode != rgsv1.ResultCode_RESULT_CODE_DENIED || m.DenialReason != "reality check acknowledgment required" {
+		t.Fatalf("expected wagering to be blocked until the check is acknowledged, got %+v", m)
+	}
+	closeWindow("reality-check:other-session:1")
+	if m := wager("w-3"); m.ResultCode != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected closing another window to leave wagering blocked, got %+v", m)
+	}
+	setNow(now.Add(time.Hour + 2*time.Minute))
+	closeWindow(pushed[0].WindowId)
+	if m := wager("w-4"); m.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected wagering to resume after the acknowledgment, got %+v", m)
+	}
+	got, _ := sessions.GetSession(ctx, &rgsv1.GetSessionRequest{Meta: meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""), SessionId: start.Session.SessionId})
+	if got.Session.RealityCheckWindowId != "" || got.Session.RealityCheckAcknowledgedAt != now.Add(time.Hour+2*time.Minute).Format(time.RFC3339Nano) {
+		t.Fatalf("unexpected session after acknowledgment: %+v", got.Session)
+	}
+
+	// Without the worker, the next wager past the interval raises the check.
+	setNow(now.Add(2*time.Hour + 2*time.Minute))
+	if m := wager("w-5"); m.DenialReason != "reality check acknowledgment required" || len(windows()) != 4 {
+		t.Fatalf("expected the wager to push the next reality check, got %+v", m)
+	}
+
+	var triggered, acknowledged int
+	for _, ev := range auditEvents(sessions.AuditStore) {
+		switch ev.Action {
+		case "trigger_reality_check":
+			triggered++
+		case "acknowledge_reality_check":
+			acknowledged++
+		}
+	}
+	if triggered != 2 || acknowledged != 1 {
+		t.Fatalf("expected reality checks to be audited, got triggered=%d acknowledged=%d", triggered, acknowledged)
+	}
+}
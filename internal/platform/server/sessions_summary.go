@@ -0,0 +1,341 @@
+package server
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/money"
+)
+
+const activityDayLayout = "2006-01-02"
+
+// sessionSummaryPeriods are the trailing windows reported alongside the
+// current session, in gaming days including today.
+var sessionSummaryPeriods = []struct {
+	name string
+	days int
+}{
+	{name: "1d", days: 1},
+	{name: "7d", days: 7},
+	{name: "30d", days: 30},
+}
+
+// sessionActivity is an incrementally maintained set of counters for either a
+// single session or one player-day bucket.
+type sessionActivity struct {
+	currency          string
+	wagerCount        int64
+	wageredMinor      int64
+	wonMinor          int64
+	timePlayedSeconds int64
+}
+
+func (a *sessionActivity) add(delta sessionActivity) {
+	if a.currency == "" {
+		a.currency = delta.currency
+	}
+	a.wagerCount += delta.wagerCount
+	a.wageredMinor += delta.wageredMinor
+	a.wonMinor += delta.wonMinor
+	a.timePlayedSeconds += delta.timePlayedSeconds
+}
+
+func (a sessionActivity) totals() *rgsv1.SessionActivityTotals {
+	currency := a.currency
+	if currency == "" {
+		currency = "USD"
+	}
+	return &rgsv1.SessionActivityTotals{
+		TimePlayedSeconds: a.timePlayedSeconds,
+		WagerCount:        a.wagerCount,
+		TotalWagered:      money.New(a.wageredMinor, currency),
+		TotalWon:          money.New(a.wonMinor, currency),
+		NetWinLoss:        money.New(a.wonMinor-a.wageredMinor, currency),
+	}
+}
+
+func playerDayKey(playerID, day string) string {
+	return playerID + "|" + day
+}
+
+// sessionTimePlayed measures a session up to now while active, to its end
+// when ended, and to its last activity when it expired.
+func sessionTimePlayed(sess *rgsv1.PlayerSession, now time.Time) int64 {
+	started := parseTS(sess.StartedAt)
+	if started.IsZero() {
+		return 0
+	}
+	until := now
+	switch sess.State {
+	case rgsv1.SessionState_SESSION_STATE_ENDED:
+		until = parseTS(sess.EndedAt)
+	case rgsv1.SessionState_SESSION_STATE_EXPIRED:
+		until = parseTS(sess.LastSeenAt)
+	}
+	if until.Before(started) {
+		return 0
+	}
+	return int64(until.Sub(started) / time.Second)
+}
+
+// RecordWagerActivity applies wager deltas to the session the player was in
+// when the wager was placed and to the daily rollup for that gaming day.
+// Wagering calls it on place (+1, +stake), settle (+payout), and cancel or
+// void (-1, -stake) so summaries never rescan wager history; a zero placedAt
+// means now.
+func (s *SessionsService) RecordWagerActivity(ctx context.Context, playerID, currency string, placedAt time.Time, wagerDelta, wageredDelta, wonDelta int64) error {
+	if s == nil || playerID == "" {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if placedAt.IsZero() {
+		placedAt = s.now()
+	}
+	delta := sessionActivity{currency: currency, wagerCount: wagerDelta, wageredMinor: wageredDelta, wonMinor: wonDelta}
+	if s.db != nil {
+		return s.recordActivityInDB(ctx, playerID, placedAt, delta)
+	}
+	if s.disableInMemoryCache {
+		return nil
+	}
+	if sess := s.sessionAtLocked(playerID, placedAt); sess != nil {
+		s.sessionActivityLocked(sess.SessionId).add(delta)
+	}
+	s.dailyActivityLocked(playerID, gamingCalendarFor("").GamingDay(placedAt)).add(delta)
+	return nil
+}
+
+func (s *SessionsService) sessionActivityLocked(sessionID string) *sessionActivity {
+	a, ok := s.activity[sessionID]
+	if !ok {
+		a = &sessionActivity{}
+		s.activity[sessionID] = a
+	}
+	return a
+}
+
+func (s *SessionsService) dailyActivityLocked(playerID, day string) *sessionActivity {
+	key := playerDayKey(playerID, day)
+	a, ok := s.dailyActivity[key]
+	if !ok {
+		a = &sessionActivity{}
+		s.dailyActivity[key] = a
+	}
+	return a
+}
+
+func (s *SessionsService) activeSessionForPlayerLocked(playerID string, now time.Time) *rgsv1.PlayerSession {
+	var latest *rgsv1.PlayerSession
+	for _, sess := range s.sessions {
+		if sess.PlayerId != playerID || sess.State != rgsv1.SessionState_SESSION_STATE_ACTIVE {
+			continue
+		}
+		if expires := parseTS(sess.ExpiresAt); !expires.IsZero() && now.After(expires) {
+			continue
+		}
+		if latest == nil || parseTS(sess.StartedAt).After(parseTS(latest.StartedAt)) {
+			latest = sess
+		}
+	}
+	return latest
+}
+
+// sessionAtLocked returns the player's latest session that was open at the
+// given time: started by then and not yet ended or expired.
+func (s *SessionsService) sessionAtLocked(playerID string, at time.Time) *rgsv1.PlayerSession {
+	var latest *rgsv1.PlayerSession
+	for _, sess := range s.sessions {
+		if sess.PlayerId != playerID || parseTS(sess.StartedAt).After(at) {
+			continue
+		}
+		closes := parseTS(sess.EndedAt)
+		if closes.IsZero() {
+			closes = parseTS(sess.ExpiresAt)
+		}
+		if !closes.IsZero() && at.After(closes) {
+			continue
+		}
+		if latest == nil || parseTS(sess.StartedAt).After(parseTS(latest.StartedAt)) {
+			latest = sess
+		}
+	}
+	return latest
+}
+
+// recordSessionClosed folds a finished session's time played into the daily
+// rollup for the day it closed.
+func (s *SessionsService) recordSessionClosed(ctx context.Context, sess *rgsv1.PlayerSession) error {
+	seconds := sessionTimePlayed(sess, s.now())
+	if seconds <= 0 {
+		return nil
+	}
+	now := s.now()
+	delta := sessionActivity{timePlayedSeconds: seconds}
+	if s.db != nil {
+		return s.recordActivityInDB(ctx, sess.PlayerId, now, delta)
+	}
+	if s.disableInMemoryCache {
+		return nil
+	}
+	s.dailyActivityLocked(sess.PlayerId, gamingCalendarFor("").GamingDay(now)).add(delta)
+	return nil
+}
+
+func (s *SessionsService) loadSessionActivity(ctx context.Context, sessionID string) (sessionActivity, error) {
+	if s.db != nil {
+		return s.getSessionActivityFromDB(ctx, sessionID)
+	}
+	if a, ok := s.activity[sessionID]; ok {
+		return *a, nil
+	}
+	return sessionActivity{}, nil
+}
+
+func (s *SessionsService) loadPlayerActivitySince(ctx context.Context, playerID, fromDay string) (sessionActivity, error) {
+	if s.db != nil {
+		return s.getPlayerActivitySinceFromDB(ctx, playerID, fromDay)
+	}
+	var out sessionActivity
+	prefix := playerDayKey(playerID, "")
+	for key, a := range s.dailyActivity {
+		day, ok := strings.CutPrefix(key, prefix)
+		if !ok || day < fromDay {
+			continue
+		}
+		out.add(*a)
+	}
+	return out, nil
+}
+
+// playerActivitySince returns the player's activity rolled up from fromDay
+// on. A nil service has none.
+func (s *SessionsService) playerActivitySince(ctx context.Context, playerID, fromDay string) (sessionActivity, error) {
+	if s == nil {
+		return sessionActivity{}, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.loadPlayerActivitySince(ctx, playerID, fromDay)
+}
+
+// playerSessionRecord is a session with its activity counters.
+type playerSessionRecord struct {
+	session  *rgsv1.PlayerSession
+	activity sessionActivity
+}
+
+// playerSessionHistory returns a page of the player's sessions, newest
+// first. Time played is measured as in GetSessionSummary; it does not touch
+// the sessions.
+func (s *SessionsService) playerSessionHistory(ctx context.Context, playerID string, limit, offset int) ([]playerSessionRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var records []playerSessionRecord
+	if s.db != nil {
+		var err error
+		records, err = s.listPlayerSessionsFromDB(ctx, playerID, limit, offset)
+		if err != nil {
+			return nil, err
+		}
+	} else if !s.disableInMemoryCache {
+		all := make([]*rgsv1.PlayerSession, 0)
+		for _, sess := range s.sessions {
+			if sess.PlayerId == playerID {
+				all = append(all, sess)
+			}
+		}
+		sort.Slice(all, func(i, j int) bool {
+			si, sj := parseTS(all[i].StartedAt), parseTS(all[j].StartedAt)
+			if !si.Equal(sj) {
+				return si.After(sj)
+			}
+			return all[i].SessionId > all[j].SessionId
+		})
+		if offset > len(all) {
+			offset = len(all)
+		}
+		end := offset + limit
+		if end > len(all) {
+			end = len(all)
+		}
+		for _, sess := range all[offset:end] {
+			rec := playerSessionRecord{session: cloneSession(sess)}
+			if a, ok := s.activity[sess.SessionId]; ok {
+				rec.activity = *a
+			}
+			records = append(records, rec)
+		}
+	}
+	now := s.now()
+	for i := range records {
+		records[i].activity.timePlayedSeconds = sessionTimePlayed(records[i].session, now)
+	}
+	return records, nil
+}
+
+func (s *SessionsService) GetSessionSummary(ctx context.Context, req *rgsv1.GetSessionSummaryRequest) (*rgsv1.GetSessionSummaryResponse, error) {
+	if req == nil || req.SessionId == "" {
+		return &rgsv1.GetSessionSummaryResponse{Meta: s.responseMeta(nil, rgsv1.ResultCode_RESULT_CODE_INVALID, "session_id is required")}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sess, err := s.loadSession(ctx, req.SessionId)
+	if err != nil {
+		return &rgsv1.GetSessionSummaryResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if sess == nil {
+		return &rgsv1.GetSessionSummaryResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "session not found")}, nil
+	}
+	if ok, reason := s.authorizeAccess(ctx, req.Meta, sess); !ok {
+		_ = s.appendAudit(req.Meta, req.SessionId, "get_session_summary", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		return &rgsv1.GetSessionSummaryResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+
+	updated, err := s.touchAndExpireSessionIfNeeded(ctx, sess)
+	if err != nil {
+		return &rgsv1.GetSessionSummaryResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	now := s.now()
+	current, err := s.loadSessionActivity(ctx, updated.SessionId)
+	if err != nil {
+		return &rgsv1.GetSessionSummaryResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	current.timePlayedSeconds = sessionTimePlayed(updated, now)
+
+	cal := gamingCalendarFor("")
+	today, _ := time.Parse(activityDayLayout, cal.GamingDay(now))
+	periods := make([]*rgsv1.SessionSummaryPeriod, 0, len(sessionSummaryPeriods))
+	for _, p := range sessionSummaryPeriods {
+		fromDay := today.AddDate(0, 0, -(p.days - 1))
+		agg, err := s.loadPlayerActivitySince(ctx, updated.PlayerId, fromDay.Format(activityDayLayout))
+		if err != nil {
+			return &rgsv1.GetSessionSummaryResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+		// Rollups only receive time played when a session closes.
+		if updated.State == rgsv1.SessionState_SESSION_STATE_ACTIVE {
+			agg.timePlayedSeconds += current.timePlayedSeconds
+		}
+		if agg.currency == "" {
+			agg.currency = current.currency
+		}
+		periods = append(periods, &rgsv1.SessionSummaryPeriod{
+			Period:      p.name,
+			WindowStart: cal.Start(fromDay).Format(time.RFC3339Nano),
+			Totals:      agg.totals(),
+		})
+	}
+	return &rgsv1.GetSessionSummaryResponse{
+		Meta:            s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
+		Session:         updated,
+		CurrentSession:  current.totals(),
+		TrailingPeriods: periods,
+	}, nil
+}
@@ -0,0 +1,165 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func TestSessionSummaryTracksWagersAndTrailingPeriods(t *testing.T) {
+	start := time.Date(2026, 2, 17, 10, 0, 0, 0, time.UTC)
+	sessions := NewSessionsService(ledgerFixedClock{now: start.Add(-48 * time.Hour)})
+	wagering := NewWageringService(ledgerFixedClock{now: start.Add(-48 * time.Hour)})
+	wagering.Sessions = sessions
+	ctx := context.Background()
+
+	// A session two days ago contributes to 7d/30d but not 1d.
+	prior, _ := sessions.StartSession(ctx, &rgsv1.StartSessionRequest{
+		Meta:     meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""),
+		PlayerId: "player-1",
+		DeviceId: "device-a",
+	})
+	placeTestWager(t, wagering, "player-1", "idem-prior")
+	sessions.Clock = ledgerFixedClock{now: start.Add(-48*time.Hour + 20*time.Minute)}
+	if _, err := sessions.EndSession(ctx, &rgsv1.EndSessionRequest{
+		Meta:      meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""),
+		SessionId: prior.Session.GetSessionId(),
+	}); err != nil {
+		t.Fatalf("end prior session err: %v", err)
+	}
+
+	sessions.Clock = ledgerFixedClock{now: start}
+	wagering.Clock = ledgerFixedClock{now: start}
+	current, _ := sessions.StartSession(ctx, &rgsv1.StartSessionRequest{
+		Meta:     meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""),
+		PlayerId: "player-1",
+		DeviceId: "device-a",
+	})
+	won := placeTestWager(t, wagering, "player-1", "idem-1")
+	canceled := placeTestWager(t, wagering, "player-1", "idem-2")
+	placeTestWager(t, wagering, "player-1", "idem-3")
+	if resp, _ := wagering.SettleWager(ctx, &rgsv1.SettleWagerRequest{
+		Meta:       meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "idem-settle"),
+		WagerId:    won.WagerId,
+		Payout:     &rgsv1.Money{AmountMinor: 250, Currency: "USD"},
+		OutcomeRef: "outcome-1",
+	}); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("settle failed: %+v", resp.Meta)
+	}
+	if resp, _ := wagering.CancelWager(ctx, &rgsv1.CancelWagerRequest{
+		Meta:    meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "idem-cancel"),
+		WagerId: canceled.WagerId,
+		Reason:  "void",
+	}); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("cancel failed: %+v", resp.Meta)
+	}
+
+	sessions.Clock = ledgerFixedClock{now: start.Add(15 * time.Minute)}
+	resp, err := sessions.GetSessionSummary(ctx, &rgsv1.GetSessionSummaryRequest{
+		Meta:      meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""),
+		SessionId: current.Session.GetSessionId(),
+	})
+	if err != nil || resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("summary failed: err=%v meta=%+v", err, resp.GetMeta())
+	}
+	cur := resp.CurrentSession
+	if cur.GetTimePlayedSeconds() != 900 || cur.GetWagerCount() != 2 {
+		t.Fatalf("unexpected current session totals: %+v", cur)
+	}
+	if cur.GetTotalWagered().GetAmountMinor() != 200 || cur.GetTotalWon().GetAmountMinor() != 250 || cur.GetNetWinLoss().GetAmountMinor() != 50 {
+		t.Fatalf("unexpected current session money: %+v", cur)
+	}
+	if len(resp.TrailingPeriods) != 3 {
+		t.Fatalf("expected 3 trailing periods, got=%d", len(resp.TrailingPeriods))
+	}
+	day, week := resp.TrailingPeriods[0], resp.TrailingPeriods[1]
+	if day.GetPeriod() != "1d" || day.Totals.GetWagerCount() != 2 || day.Totals.GetTimePlayedSeconds() != 900 {
+		t.Fatalf("unexpected 1d totals: %+v", day)
+	}
+	if week.GetPeriod() != "7d" || week.Totals.GetWagerCount() != 3 || week.Totals.GetTimePlayedSeconds() != 900+1200 {
+		t.Fatalf("unexpected 7d totals: %+v", week)
+	}
+	if week.Totals.GetNetWinLoss().GetAmountMinor() != -50 {
+		t.Fatalf("unexpected 7d net win/loss: %+v", week.Totals.GetNetWinLoss())
+	}
+}
+
+func TestSessionSummaryDeniedForOtherPlayer(t *testing.T) {
+	svc := NewSessionsService(ledgerFixedClock{now: time.Date(2026, 2, 17, 10, 0, 0, 0, time.UTC)})
+	ctx := context.Background()
+	start, _ := svc.StartSession(ctx, &rgsv1.StartSessionRequest{
+		Meta:     meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""),
+		PlayerId: "player-1",
+		DeviceId: "device-a",
+	})
+	resp, err := svc.GetSessionSummary(ctx, &rgsv1.GetSessionSummaryRequest{
+		Meta:      meta("player-2", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""),
+		SessionId: start.Session.GetSessionId(),
+	})
+	if err != nil {
+		t.Fatalf("summary err: %v", err)
+	}
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected denied, got=%v", resp.Meta.GetResultCode())
+	}
+}
+
+func TestSessionSummaryRecordsOutcomesAgainstThePlacingSession(t *testing.T) {
+	placed := time.Date(2026, 2, 16, 10, 0, 0, 0, time.UTC)
+	sessions := NewSessionsService(ledgerFixedClock{now: placed})
+	wagering := NewWageringService(ledgerFixedClock{now: placed})
+	wagering.Sessions = sessions
+	ctx := context.Background()
+	player := meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "")
+
+	prior, _ := sessions.StartSession(ctx, &rgsv1.StartSessionRequest{Meta: player, PlayerId: "player-1", DeviceId: "device-a"})
+	won := placeTestWager(t, wagering, "player-1", "idem-won")
+	voided := placeTestWager(t, wagering, "player-1", "idem-voided")
+	sessions.EndSession(ctx, &rgsv1.EndSessionRequest{Meta: player, SessionId: prior.Session.GetSessionId()})
+
+	// The outcomes arrive the next gaming day, in a new session.
+	later := ledgerFixedClock{now: placed.Add(24 * time.Hour)}
+	sessions.Clock, wagering.Clock = later, later
+	current, _ := sessions.StartSession(ctx, &rgsv1.StartSessionRequest{Meta: player, PlayerId: "player-1", DeviceId: "device-a"})
+	if resp, _ := wagering.SettleWager(ctx, &rgsv1.SettleWagerRequest{
+		Meta:       meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "idem-settle"),
+		WagerId:    won.WagerId,
+		Payout:     &rgsv1.Money{AmountMinor: 250, Currency: "USD"},
+		OutcomeRef: "outcome-1",
+	}); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("settle failed: %+v", resp.Meta)
+	}
+	if resp, _ := wagering.CancelWager(ctx, &rgsv1.CancelWagerRequest{
+		Meta:    meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "idem-cancel"),
+		WagerId: voided.WagerId,
+		Reason:  "void",
+	}); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("cancel failed: %+v", resp.Meta)
+	}
+
+	summary := func(sessionID string) *rgsv1.GetSessionSummaryResponse {
+		t.Helper()
+		resp, err := sessions.GetSessionSummary(ctx, &rgsv1.GetSessionSummaryRequest{Meta: player, SessionId: sessionID})
+		if err != nil || resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("summary failed: err=%v meta=%+v", err, resp.GetMeta())
+		}
+		return resp
+	}
+	old := summary(prior.Session.GetSessionId()).CurrentSession
+	if old.GetWagerCount() != 1 || old.GetTotalWagered().GetAmountMinor() != 100 || old.GetTotalWon().GetAmountMinor() != 250 {
+		t.Fatalf("expected the outcomes on the placing session, got %+v", old)
+	}
+	resp := summary(current.Session.GetSessionId())
+	if cur := resp.CurrentSession; cur.GetWagerCount() != 0 || cur.GetTotalWagered().GetAmountMinor() != 0 || cur.GetTotalWon().GetAmountMinor() != 0 {
+		t.Fatalf("expected nothing on the settling session, got %+v", cur)
+	}
+	day, week := resp.TrailingPeriods[0].Totals, resp.TrailingPeriods[1].Totals
+	if day.GetWagerCount() != 0 || day.GetTotalWon().GetAmountMinor() != 0 {
+		t.Fatalf("expected nothing on the settling day, got %+v", day)
+	}
+	if week.GetWagerCount() != 1 || week.GetNetWinLoss().GetAmountMinor() != 150 {
+		t.Fatalf("expected the outcomes on the placing day, got %+v", week)
+	}
+}
@@ -0,0 +1,134 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	platformauth "github.com/wizardbeardstudio/open-rgs-go/internal/platform/auth"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/clock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultStepUpMethods are the RPCs that change money, configuration, or
+// another actor's access and so demand a fresh login.
+var DefaultStepUpMethods = []string{
+	"/rgs.v1.ConfigService/ApplyConfigChange",
+	"/rgs.v1.LedgerService/VoidTransaction",
+	"/rgs.v1.LedgerService/ResetEFTLockout",
+	"/rgs.v1.IdentityService/ResetLockout",
+	"/rgs.v1.IdentityService/AssumeActor",
+}
+
+const stepUpDenial = "step-up authentication required"
+
+// StepUpPolicy requires operators calling sensitive RPCs to hold an access
+// token from a login no older than MaxAge and at least MinStrength strong.
+// Refreshing a token keeps its original auth_time, so satisfying the policy
+// means logging in again. Service actors authenticate without an
+// interactive login and are not subject to it.
+type StepUpPolicy struct {
+	Clock       clock.Clock
+	MaxAge      time.Duration
+	MinStrength string
+
+	methods map[string]bool
+	routes  map[string]string
+}
+
+// NewStepUpPolicy builds a policy over the given full method names
+// ("/rgs.v1.ConfigService/ApplyConfigChange"). A zero maxAge disables it.
+func NewStepUpPolicy(clk clock.Clock, methods []string, maxAge time.Duration, minStrength string) (*StepUpPolicy, error) {
+	if maxAge < 0 {
+		return nil, fmt.Errorf("max age must not be negative")
+	}
+	if !platformauth.AuthStrengthSatisfies(minStrength, minStrength) {
+		return nil, fmt.Errorf("unknown auth strength %q", minStrength)
+	}
+	perms, routes := rbacCatalog()
+	known := make(map[string]bool, len(perms))
+	for _, p := range perms {
+		known["/"+p] = true
+	}
+	p := &StepUpPolicy{Clock: clk, MaxAge: maxAge, MinStrength: minStrength, methods: make(map[string]bool, len(methods)), routes: routes}
+	for _, m := range methods {
+		if !known[m] {
+			return nil, fmt.Errorf("unknown rpc %q", m)
+		}
+		p.methods[m] = true
+	}
+	return p, nil
+}
+
+func (p *StepUpPolicy) now() time.Time {
+	if p.Clock == nil {
+		return time.Now().UTC()
+	}
+	return p.Clock.Now().UTC()
+}
+
+// Requires reports whether fullMethod is subject to step-up.
+func (p *StepUpPolicy) Requires(fullMethod string) bool {
+	return p != nil && p.MaxAge > 0 && p.methods[fullMethod]
+}
+
+func (p *StepUpPolicy) check(ctx context.Context, fullMethod string) error {
+	if !p.Requires(fullMethod) {
+		return nil
+	}
+	actor, ok := platformauth.ActorFromContext(ctx)
+	if !ok || actor.Type == "ACTOR_TYPE_SERVICE" {
+		// Unauthenticated methods are vetted by the JWT interceptor.
+		return nil
+	}
+	if actor.AuthTime.IsZero() || p.now().Sub(actor.AuthTime) > p.MaxAge || !platformauth.AuthStrengthSatisfies(actor.AuthStrength, p.MinStrength) {
+		return status.Error(codes.PermissionDenied, stepUpDenial)
+	}
+	return nil
+}
+
+// UnaryStepUpInterceptor enforces the policy; it must run after the JWT
+// interceptor so the actor is on the context.
+func UnaryStepUpInterceptor(policy *StepUpPolicy) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := policy.check(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamStepUpInterceptor is UnaryStepUpInterceptor for streaming RPCs.
+func StreamStepUpInterceptor(policy *StepUpPolicy) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := policy.check(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// GatewayMiddleware applies the policy to the RPC behind each gateway
+// route. Routes that map to no RPC pass through unchanged.
+func (p *StepUpPolicy) GatewayMiddleware() runtime.Middleware {
+	return func(next runtime.HandlerFunc) runtime.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+			if p == nil {
+				next(w, r, pathParams)
+				return
+			}
+			pattern, _ := runtime.HTTPPattern(r.Context())
+			if perm, ok := p.routes[r.Method+" "+pattern.String()]; ok {
+				if err := p.check(r.Context(), "/"+perm); err != nil {
+					http.Error(w, status.Convert(err).Message(), http.StatusForbidden)
+					return
+				}
+			}
+			next(w, r, pathParams)
+		}
+	}
+}
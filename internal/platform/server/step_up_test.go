@@ -0,0 +1,162 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	platformauth "github.com/wizardbeardstudio/open-rgs-go/internal/platform/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStepUpPolicyRequiresRecentLogin(t *testing.T) {
+	now := time.Date(2026, 3, 7, 12, 0, 0, 0, time.UTC)
+	policy, err := NewStepUpPolicy(ledgerFixedClock{now: now}, DefaultStepUpMethods, 5*time.Minute, platformauth.AuthStrengthPassword)
+	if err != nil {
+		t.Fatalf("new step-up policy: %v", err)
+	}
+	interceptor := UnaryStepUpInterceptor(policy)
+	call := func(method string, actor platformauth.Actor) codes.Code {
+		ctx := platformauth.WithActor(context.Background(), actor)
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(context.Context, any) (any, error) { return "ok", nil })
+		return status.Code(err)
+	}
+	operator := func(strength string, age time.Duration) platformauth.Actor {
+		return platformauth.Actor{ID: "op-1", Type: "ACTOR_TYPE_OPERATOR", AuthStrength: strength, AuthTime: now.Add(-age)}
+	}
+
+	for _, tc := range []struct {
+		name   string
+		method string
+		actor  platformauth.Actor
+		want   codes.Code
+	}{
+		{"fresh login", "/rgs.v1.ConfigService/ApplyConfigChange", operator(platformauth.AuthStrengthPassword, time.Minute), codes.OK},
+		{"stale login", "/rgs.v1.ConfigService/ApplyConfigChange", operator(platformauth.AuthStrengthPassword, 10*time.Minute), codes.PermissionDenied},
+		{"no auth claims", "/rgs.v1.IdentityService/ResetLockout", platformauth.Actor{ID: "op-1", Type: "ACTOR_TYPE_OPERATOR"}, codes.PermissionDenied},
+		{"insensitive rpc", "/rgs.v1.LedgerService/GetBalance", operator(platformauth.AuthStrengthPassword, time.Hour), codes.OK},
+		{"service actor", "/rgs.v1.LedgerService/VoidTransaction", platformauth.Actor{ID: "svc-1", Type: "ACTOR_TYPE_SERVICE"}, codes.OK},
+	} {
+		if got := call(tc.method, tc.actor); got != tc.want {
+			t.Fatalf("%s: got=%v want=%v", tc.name, got, tc.want)
+		}
+	}
+
+	policy.MinStrength = platformauth.AuthStrengthMFA
+	if got := call("/rgs.v1.ConfigService/ApplyConfigChange", operator(platformauth.AuthStrengthPassword, time.Minute)); got != codes.PermissionDenied {
+		t.Fatalf("expected password login refused when mfa required, got=%v", got)
+	}
+	if got := call("/rgs.v1.ConfigService/ApplyConfigChange", operator(platformauth.AuthStrengthMFA, time.Minute)); got != codes.OK {
+		t.Fatalf("expected mfa login accepted, got=%v", got)
+	}
+
+	policy.MaxAge = 0
+	if got := call("/rgs.v1.ConfigService/ApplyConfigChange", platformauth.Actor{ID: "op-1", Type: "ACTOR_TYPE_OPERATOR"}); got != codes.OK {
+		t.Fatalf("expected disabled policy to pass, got=%v", got)
+	}
+
+	if _, err := NewStepUpPolicy(nil, []string{"/rgs.v1.LedgerService/AdjustEverything"}, time.Minute, platformauth.AuthStrengthPassword); err == nil {
+		t.Fatalf("expected unknown rpc rejected")
+	}
+	if _, err := NewStepUpPolicy(nil, DefaultStepUpMethods, time.Minute, "retina"); err == nil {
+		t.Fatalf("expected unknown strength rejected")
+	}
+}
+
+func TestStreamStepUpInterceptor(t *testing.T) {
+	now := time.Date(2026, 3, 7, 12, 0, 0, 0, time.UTC)
+	policy, err := NewStepUpPolicy(ledgerFixedClock{now: now}, []string{"/rgs.v1.EventsService/WatchSignificantEvents"}, 5*time.Minute, platformauth.AuthStrengthPassword)
+	if err != nil {
+		t.Fatalf("new step-up policy: %v", err)
+	}
+	interceptor := StreamStepUpInterceptor(policy)
+	for name, tc := range map[string]struct {
+		age  time.Duration
+		want codes.Code
+	}{
+		"fresh login": {time.Minute, codes.OK},
+		"stale login": {10 * time.Minute, codes.PermissionDenied},
+	} {
+		ctx := platformauth.WithActor(context.Background(), platformauth.Actor{ID: "op-1", Type: "ACTOR_TYPE_OPERATOR", AuthStrength: platformauth.AuthStrengthPassword, AuthTime: now.Add(-tc.age)})
+		err := interceptor(nil, &recordingServerStream{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: "/rgs.v1.EventsService/WatchSignificantEvents"}, func(any, grpc.ServerStream) error { return nil })
+		if got := status.Code(err); got != tc.want {
+			t.Fatalf("%s: got=%v want=%v", name, got, tc.want)
+		}
+	}
+}
+
+func TestStepUpGatewayMiddleware(t *testing.T) {
+	now := time.Date(2026, 3, 7, 12, 0, 0, 0, time.UTC)
+	policy, err := NewStepUpPolicy(ledgerFixedClock{now: now}, DefaultStepUpMethods, 5*time.Minute, platformauth.AuthStrengthPassword)
+	if err != nil {
+		t.Fatalf("new step-up policy: %v", err)
+	}
+	svc := NewIdentityService(ledgerFixedClock{now: now}, "test-secret", 15*time.Minute, time.Hour)
+	gwMux := runtime.NewServeMux(runtime.WithMiddlewares(policy.GatewayMiddleware()))
+	if err := rgsv1.RegisterIdentityServiceHandlerServer(context.Background(), gwMux, svc); err != nil {
+		t.Fatalf("register identity gateway handlers: %v", err)
+	}
+	post := func(authTime time.Time) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/v1/identity/lockouts:reset", strings.NewReader(`{"meta":{"actor":{"actor_id":"op-1","actor_type":"ACTOR_TYPE_OPERATOR"}},"actor":{"actor_id":"player-1","actor_type":"ACTOR_TYPE_PLAYER"}}`))
+		req = req.WithContext(platformauth.WithActor(req.Context(), platformauth.Actor{ID: "op-1", Type: "ACTOR_TYPE_OPERATOR", AuthStrength: platformauth.AuthStrengthPassword, AuthTime: authTime}))
+		rec := httptest.NewRecorder()
+		gwMux.ServeHTTP(rec, req)
+		return rec
+	}
+	if rec := post(now.Add(-time.Hour)); rec.Code != http.StatusForbidden || !strings.Contains(rec.Body.String(), "step-up authentication required") {
+		t.Fatalf("expected stale login refused, status=%d body=%s", rec.Code, rec.Body.String())
+	}
+	if rec := post(now.Add(-time.Minute)); rec.Code != http.StatusOK {
+		t.Fatalf("expected fresh login accepted, status=%d body=%s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestIdentityTokensKeepLoginAuthTimeAcrossRefresh(t *testing.T) {
+	// Access tokens are verified against the wall clock.
+	login := time.Now().UTC().Truncate(time.Second)
+	svc := NewIdentityService(ledgerFixedClock{now: login}, "test-secret", 15*time.Minute, time.Hour)
+	verifier := platformauth.NewJWTVerifier("test-secret")
+	ctx := context.Background()
+
+	resp, _ := svc.Login(ctx, &rgsv1.LoginRequest{
+		Meta:        meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		Credentials: &rgsv1.LoginRequest_Operator{Operator: &rgsv1.OperatorCredentials{OperatorId: "op-1", Password: "operator-pass"}},
+	})
+	actor, err := verifier.ParseActor(resp.Token.GetAccessToken())
+	if err != nil || actor.AuthStrength != platformauth.AuthStrengthPassword || !actor.AuthTime.Equal(login) {
+		t.Fatalf("expected login auth claims, actor=%+v err=%v", actor, err)
+	}
+
+	svc.Clock = ledgerFixedClock{now: login.Add(5 * time.Second)}
+	refreshed, _ := svc.RefreshToken(ctx, &rgsv1.RefreshTokenRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), RefreshToken: resp.Token.GetRefreshToken()})
+	if refreshed.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected refresh ok, got=%+v", refreshed.Meta)
+	}
+	actor, err = verifier.ParseActor(refreshed.Token.GetAccessToken())
+	if err != nil || !actor.AuthTime.Equal(login) {
+		t.Fatalf("expected refresh to keep login auth_time, actor=%+v err=%v", actor, err)
+	}
+}
+
+func TestOIDCAuthStrength(t *testing.T) {
+	for _, tc := range []struct {
+		claims map[string]any
+		want   string
+	}{
+		{map[string]any{}, platformauth.AuthStrengthPassword},
+		{map[string]any{"amr": []any{"pwd"}}, platformauth.AuthStrengthPassword},
+		{map[string]any{"amr": []any{"mfa"}}, platformauth.AuthStrengthMFA},
+		{map[string]any{"amr": []any{"pwd", "otp"}}, platformauth.AuthStrengthMFA},
+	} {
+		if got := oidcAuthStrength(tc.claims); got != tc.want {
+			t.Fatalf("amr %v: got=%s want=%s", tc.claims["amr"], got, tc.want)
+		}
+	}
+}
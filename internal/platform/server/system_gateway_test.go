@@ -64,3 +64,81 @@ func TestSystemStatusGateway(t *testing.T) {
 		t.Fatalf("missing/invalid response meta: %+v", got.Meta)
 	}
 }
+
+func TestSystemStatusPageGateway(t *testing.T) {
+	startedAt := time.Date(2026, 2, 11, 12, 0, 0, 0, time.UTC)
+	clk := fixedClock{now: startedAt.Add(5 * time.Minute)}
+	svc := SystemService{
+		StartedAt: startedAt,
+		Clock:     clk,
+		Version:   "test-version",
+		Incidents: NewIncidentBoard(clk),
+	}
+	created, _ := svc.CreateIncident(context.Background(), &rgsv1.CreateIncidentRequest{
+		Meta:             meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		Title:            "Reporting delayed",
+		Severity:         rgsv1.IncidentSeverity_INCIDENT_SEVERITY_MINOR,
+		AffectedServices: []string{"reporting"},
+	})
+	if created.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("create incident failed: %+v", created.Meta)
+	}
+
+	gwMux := runtime.NewServeMux()
+	if err := rgsv1.RegisterSystemServiceHandlerServer(context.Background(), gwMux, svc); err != nil {
+		t.Fatalf("register gateway handlers: %v", err)
+	}
+
+	req := httptest.NewRequest("GET", "/v1/system/status-page?resolvedLimit=5", nil)
+	rec := httptest.NewRecorder()
+	gwMux.ServeHTTP(rec, req)
+	if rec.Code != 200 {
+		t.Fatalf("unexpected status code: got=%d want=%d", rec.Code, 200)
+	}
+
+	var got rgsv1.GetStatusPageResponse
+	if err := protojson.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatalf("unmarshal response: %v; body=%s", err, rec.Body.String())
+	}
+	if got.OverallSeverity != rgsv1.IncidentSeverity_INCIDENT_SEVERITY_MINOR {
+		t.Fatalf("overall severity mismatch: got=%v", got.OverallSeverity)
+	}
+	if len(got.ActiveIncidents) != 1 || got.ActiveIncidents[0].Title != "Reporting delayed" {
+		t.Fatalf("unexpected active incidents: %+v", got.ActiveIncidents)
+	}
+}
+
+func TestSystemJobRunsGateway(t *testing.T) {
+	startedAt := time.Date(2026, 2, 11, 12, 0, 0, 0, time.UTC)
+	scheduler := NewJobScheduler(fixedClock{now: startedAt}, "node-a")
+	if err := scheduler.Register(JobSpec{Name: "nightly", Schedule: "@daily", Run: func(context.Context, string) (string, error) {
+		return "done", nil
+	}}); err != nil {
+		t.Fatalf("register job: %v", err)
+	}
+	if _, err := scheduler.ScheduleOnce(context.Background(), "nightly", time.Time{}, ""); err != nil {
+		t.Fatalf("schedule once: %v", err)
+	}
+	if ran, _ := scheduler.RunDue(context.Background()); ran != 1 {
+		t.Fatalf("expected one-shot run, ran=%d", ran)
+	}
+	svc := SystemService{StartedAt: startedAt, Clock: fixedClock{now: startedAt}, Scheduler: scheduler}
+
+	gwMux := runtime.NewServeMux()
+	if err := rgsv1.RegisterSystemServiceHandlerServer(context.Background(), gwMux, svc); err != nil {
+		t.Fatalf("register gateway handlers: %v", err)
+	}
+	req := httptest.NewRequest("GET", "/v1/system/jobs/runs?job_name=nightly&meta.actor.actorId=op-1&meta.actor.actorType=ACTOR_TYPE_OPERATOR", nil)
+	rec := httptest.NewRecorder()
+	gwMux.ServeHTTP(rec, req)
+	if rec.Code != 200 {
+		t.Fatalf("unexpected status code: got=%d body=%s", rec.Code, rec.Body.String())
+	}
+	var got rgsv1.ListJobRunsResponse
+	if err := protojson.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatalf("unmarshal response: %v; body=%s", err, rec.Body.String())
+	}
+	if got.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || len(got.Runs) != 1 || got.Runs[0].JobId == "" || got.Runs[0].Summary != "done" {
+		t.Fatalf("unexpected job runs: %+v", &got)
+	}
+}
@@ -14,15 +14,34 @@ type SystemService struct {
 	StartedAt time.Time
 	Clock     clock.Clock
 	Version   string
+	Incidents *IncidentBoard
+	Scheduler *JobScheduler
+	Smoke     *SmokeChecker
 }
 
-func (s SystemService) GetSystemStatus(_ context.Context, req *rgsv1.GetSystemStatusRequest) (*rgsv1.GetSystemStatusResponse, error) {
+func (s SystemService) responseMeta(meta *rgsv1.RequestMeta, code rgsv1.ResultCode, denial string) *rgsv1.ResponseMeta {
+	var requestID string
+	if meta != nil {
+		requestID = meta.RequestId
+	}
+	return &rgsv1.ResponseMeta{
+		RequestId:    requestID,
+		ResultCode:   code,
+		DenialReason: denial,
+		ServerTime:   s.Clock.Now().UTC().Format(time.RFC3339Nano),
+	}
+}
+
+func (s SystemService) GetSystemStatus(ctx context.Context, req *rgsv1.GetSystemStatusRequest) (*rgsv1.GetSystemStatusResponse, error) {
 	var requestID string
 	if req != nil && req.Meta != nil {
 		requestID = req.Meta.RequestId
 	}
 
 	now := s.Clock.Now().UTC()
+	// Incident banners are best-effort: a persistence failure must not take
+	// down the public status endpoint itself.
+	active, _ := s.Incidents.activeIncidents(ctx)
 	return &rgsv1.GetSystemStatusResponse{
 		Meta: &rgsv1.ResponseMeta{
 			RequestId:    requestID,
@@ -30,8 +49,10 @@ func (s SystemService) GetSystemStatus(_ context.Context, req *rgsv1.GetSystemSt
 			DenialReason: "",
 			ServerTime:   now.Format(time.RFC3339Nano),
 		},
-		ServiceName: "open-rgs-go",
-		Version:     s.Version,
-		Uptime:      now.Sub(s.StartedAt).String(),
+		ServiceName:     "open-rgs-go",
+		Version:         s.Version,
+		Uptime:          now.Sub(s.StartedAt).String(),
+		ActiveIncidents: active,
+		GamingCalendar:  gamingCalendarInfo(gamingCalendarFor(req.GetOperatorId()), now),
 	}, nil
 }
@@ -0,0 +1,456 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/clock"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultStatusPageResolvedLimit = 10
+	maxStatusPageResolvedLimit     = 50
+)
+
+// IncidentBoard tracks operator-managed outage incidents surfaced as banners
+// in system status responses and on the public status page feed.
+type IncidentBoard struct {
+	Clock      clock.Clock
+	AuditStore audit.Store
+
+	mu                   sync.Mutex
+	incidents            map[string]*rgsv1.Incident
+	order                []string
+	nextIncidentID       int64
+	nextAuditID          int64
+	db                   *sql.DB
+	disableInMemoryCache bool
+}
+
+func NewIncidentBoard(clk clock.Clock, db ...*sql.DB) *IncidentBoard {
+	var handle *sql.DB
+	if len(db) > 0 {
+		handle = db[0]
+	}
+	return &IncidentBoard{
+		Clock:      clk,
+		AuditStore: NewAuditStore(handle),
+		incidents:  make(map[string]*rgsv1.Incident),
+		db:         handle,
+	}
+}
+
+func (b *IncidentBoard) SetDisableInMemoryCache(disable bool) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.disableInMemoryCache = disable
+}
+
+func (b *IncidentBoard) now() time.Time {
+	if b.Clock == nil {
+		return time.Now().UTC()
+	}
+	return b.Clock.Now().UTC()
+}
+
+func (b *IncidentBoard) nextIncidentIDLocked() string {
+	b.nextIncidentID++
+	return "incident-" + strconv.FormatInt(b.now().UnixNano(), 10) + "-" + strconv.FormatInt(b.nextIncidentID, 10)
+}
+
+func (b *IncidentBoard) nextAuditIDLocked() string {
+	b.nextAuditID++
+	return "system-audit-" + strconv.FormatInt(b.nextAuditID, 10)
+}
+
+func (b *IncidentBoard) appendAudit(meta *rgsv1.RequestMeta, objectID, action string, before, after []byte, result audit.Result, reason string) error {
+	ev := newAuditEvent(meta, b.nextAuditIDLocked(), b.now(), "system_incident", objectID, action, before, after, result, reason)
+	return appendAuditTo(context.Background(), b.AuditStore, ev)
+}
+
+func cloneIncident(in *rgsv1.Incident) *rgsv1.Incident {
+	if in == nil {
+		return nil
+	}
+	cp, _ := proto.Clone(in).(*rgsv1.Incident)
+	return cp
+}
+
+func incidentSnapshot(inc *rgsv1.Incident) []byte {
+	if inc == nil {
+		return []byte(`{}`)
+	}
+	b, _ := json.Marshal(inc)
+	return b
+}
+
+func validIncidentSeverity(v rgsv1.IncidentSeverity) bool {
+	switch v {
+	case rgsv1.IncidentSeverity_INCIDENT_SEVERITY_INFO,
+		rgsv1.IncidentSeverity_INCIDENT_SEVERITY_MINOR,
+		rgsv1.IncidentSeverity_INCIDENT_SEVERITY_MAJOR,
+		rgsv1.IncidentSeverity_INCIDENT_SEVERITY_CRITICAL:
+		return true
+	default:
+		return false
+	}
+}
+
+func normalizeAffectedServices(in []string) []string {
+	out := make([]string, 0, len(in))
+	seen := make(map[string]struct{}, len(in))
+	for _, svc := range in {
+		svc = strings.TrimSpace(svc)
+		if svc == "" {
+			continue
+		}
+		if _, ok := seen[svc]; ok {
+			continue
+		}
+		seen[svc] = struct{}{}
+		out = append(out, svc)
+	}
+	return out
+}
+
+func actorIDFromMeta(meta *rgsv1.RequestMeta) string {
+	if meta == nil || meta.Actor == nil {
+		return "system"
+	}
+	return meta.Actor.ActorId
+}
+
+func (b *IncidentBoard) loadIncident(ctx context.Context, incidentID string) (*rgsv1.Incident, error) {
+	if b.db != nil {
+		return b.getIncidentFromDB(ctx, incidentID)
+	}
+	if b.disableInMemoryCache {
+		return nil, nil
+	}
+	return cloneIncident(b.incidents[incidentID]), nil
+}
+
+func (b *IncidentBoard) persistIncident(ctx context.Context, inc *rgsv1.Incident) error {
+	if b.db != nil {
+		return b.upsertIncidentInDB(ctx, inc)
+	}
+	if b.disableInMemoryCache {
+		return sql.ErrConnDone
+	}
+	if _, ok := b.incidents[inc.IncidentId]; !ok {
+		b.order = append(b.order, inc.IncidentId)
+	}
+	b.incidents[inc.IncidentId] = cloneIncident(inc)
+	return nil
+}
+
+// listIncidents returns incidents ordered by start time, newest first.
+func (b *IncidentBoard) listIncidents(ctx context.Context, includeResolved bool) ([]*rgsv1.Incident, error) {
+	if b.db != nil {
+		return b.listIncidentsFromDB(ctx, includeResolved)
+	}
+	if b.disableInMemoryCache {
+		return nil, nil
+	}
+	out := make([]*rgsv1.Incident, 0, len(b.order))
+	for i := len(b.order) - 1; i >= 0; i-- {
+		inc := b.incidents[b.order[i]]
+		if inc == nil {
+			continue
+		}
+		if !includeResolved && inc.Status == rgsv1.IncidentStatus_INCIDENT_STATUS_RESOLVED {
+			continue
+		}
+		out = append(out, cloneIncident(inc))
+	}
+	return out, nil
+}
+
+func (b *IncidentBoard) activeIncidents(ctx context.Context) ([]*rgsv1.Incident, error) {
+	if b == nil {
+		return nil, nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.listIncidents(ctx, false)
+}
+
+func overallIncidentSeverity(active []*rgsv1.Incident) rgsv1.IncidentSeverity {
+	worst := rgsv1.IncidentSeverity_INCIDENT_SEVERITY_UNSPECIFIED
+	for _, inc := range active {
+		if inc.Severity > worst {
+			worst = inc.Severity
+		}
+	}
+	return worst
+}
+
+func (s SystemService) authorizeSystemAdmin(ctx context.Context, meta *rgsv1.RequestMeta) (bool, string) {
+	actor, reason := resolveActor(ctx, meta)
+	if reason != "" {
+		return false, reason
+	}
+	switch actor.ActorType {
+	case rgsv1.ActorType_ACTOR_TYPE_OPERATOR, rgsv1.ActorType_ACTOR_TYPE_SERVICE:
+		return true, ""
+	default:
+		return false, "unauthorized actor type"
+	}
+}
+
+func (s SystemService) GetStatusPage(ctx context.Context, req *rgsv1.GetStatusPageRequest) (*rgsv1.GetStatusPageResponse, error) {
+	if req == nil {
+		req = &rgsv1.GetStatusPageRequest{}
+	}
+	if req.ResolvedLimit < 0 || req.ResolvedLimit > maxStatusPageResolvedLimit {
+		return &rgsv1.GetStatusPageResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "invalid resolved_limit")}, nil
+	}
+	if s.Incidents == nil {
+		return &rgsv1.GetStatusPageResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, "")}, nil
+	}
+	limit := int(req.ResolvedLimit)
+	if limit == 0 {
+		limit = defaultStatusPageResolvedLimit
+	}
+
+	b := s.Incidents
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	all, err := b.listIncidents(ctx, true)
+	if err != nil {
+		return &rgsv1.GetStatusPageResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	active := make([]*rgsv1.Incident, 0)
+	resolved := make([]*rgsv1.Incident, 0)
+	for _, inc := range all {
+		if inc.Status != rgsv1.IncidentStatus_INCIDENT_STATUS_RESOLVED {
+			active = append(active, inc)
+			continue
+		}
+		resolved = append(resolved, inc)
+	}
+	sort.SliceStable(resolved, func(i, j int) bool {
+		return resolved[i].ResolvedAt > resolved[j].ResolvedAt
+	})
+	if len(resolved) > limit {
+		resolved = resolved[:limit]
+	}
+	return &rgsv1.GetStatusPageResponse{
+		Meta:             s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
+		OverallSeverity:  overallIncidentSeverity(active),
+		ActiveIncidents:  active,
+		RecentlyResolved: resolved,
+	}, nil
+}
+
+func (s SystemService) CreateIncident(ctx context.Context, req *rgsv1.CreateIncidentRequest) (*rgsv1.CreateIncidentResponse, error) {
+	if s.Incidents == nil {
+		return &rgsv1.CreateIncidentResponse{Meta: s.responseMeta(req.GetMeta(), rgsv1.ResultCode_RESULT_CODE_ERROR, "incident board unavailable")}, nil
+	}
+	b := s.Incidents
+	if ok, reason := s.authorizeSystemAdmin(ctx, req.GetMeta()); !ok {
+		b.mu.Lock()
+		_ = b.appendAudit(req.GetMeta(), "", "create_incident", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		b.mu.Unlock()
+		return &rgsv1.CreateIncidentResponse{Meta: s.responseMeta(req.GetMeta(), rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	if req == nil || strings.TrimSpace(req.Title) == "" || !validIncidentSeverity(req.Severity) {
+		b.mu.Lock()
+		_ = b.appendAudit(req.GetMeta(), "", "create_incident", []byte(`{}`), []byte(`{}`), audit.ResultDenied, "invalid request")
+		b.mu.Unlock()
+		return &rgsv1.CreateIncidentResponse{Meta: s.responseMeta(req.GetMeta(), rgsv1.ResultCode_RESULT_CODE_INVALID, "title and severity are required")}, nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now().Format(time.RFC3339Nano)
+	inc := &rgsv1.Incident{
+		IncidentId:       b.nextIncidentIDLocked(),
+		Title:            strings.TrimSpace(req.Title),
+		Message:          req.Message,
+		Severity:         req.Severity,
+		Status:           rgsv1.IncidentStatus_INCIDENT_STATUS_INVESTIGATING,
+		AffectedServices: normalizeAffectedServices(req.AffectedServices),
+		StartedAt:        now,
+		UpdatedAt:        now,
+		Updates: []*rgsv1.IncidentUpdate{{
+			Status:     rgsv1.IncidentStatus_INCIDENT_STATUS_INVESTIGATING,
+			Severity:   req.Severity,
+			Message:    req.Message,
+			ActorId:    actorIDFromMeta(req.Meta),
+			RecordedAt: now,
+		}},
+	}
+	if err := b.persistIncident(ctx, inc); err != nil {
+		return &rgsv1.CreateIncidentResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if err := b.appendAudit(req.Meta, inc.IncidentId, "create_incident", []byte(`{}`), incidentSnapshot(inc), audit.ResultSuccess, ""); err != nil {
+		return &rgsv1.CreateIncidentResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	return &rgsv1.CreateIncidentResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Incident: cloneIncident(inc)}, nil
+}
+
+func (s SystemService) UpdateIncident(ctx context.Context, req *rgsv1.UpdateIncidentRequest) (*rgsv1.UpdateIncidentResponse, error) {
+	if s.Incidents == nil {
+		return &rgsv1.UpdateIncidentResponse{Meta: s.responseMeta(req.GetMeta(), rgsv1.ResultCode_RESULT_CODE_ERROR, "incident board unavailable")}, nil
+	}
+	b := s.Incidents
+	if req == nil || req.IncidentId == "" || strings.TrimSpace(req.Message) == "" {
+		return &rgsv1.UpdateIncidentResponse{Meta: s.responseMeta(req.GetMeta(), rgsv1.ResultCode_RESULT_CODE_INVALID, "incident_id and message are required")}, nil
+	}
+	if req.Severity != rgsv1.IncidentSeverity_INCIDENT_SEVERITY_UNSPECIFIED && !validIncidentSeverity(req.Severity) {
+		return &rgsv1.UpdateIncidentResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "invalid severity")}, nil
+	}
+	if req.Status == rgsv1.IncidentStatus_INCIDENT_STATUS_RESOLVED {
+		return &rgsv1.UpdateIncidentResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "use ResolveIncident to resolve")}, nil
+	}
+	if ok, reason := s.authorizeSystemAdmin(ctx, req.Meta); !ok {
+		b.mu.Lock()
+		_ = b.appendAudit(req.Meta, req.IncidentId, "update_incident", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		b.mu.Unlock()
+		return &rgsv1.UpdateIncidentResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	inc, err := b.loadIncident(ctx, req.IncidentId)
+	if err != nil {
+		return &rgsv1.UpdateIncidentResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if inc == nil {
+		return &rgsv1.UpdateIncidentResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "incident not found")}, nil
+	}
+	if inc.Status == rgsv1.IncidentStatus_INCIDENT_STATUS_RESOLVED {
+		return &rgsv1.UpdateIncidentResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "incident is resolved")}, nil
+	}
+
+	before := incidentSnapshot(inc)
+	now := b.now().Format(time.RFC3339Nano)
+	if req.Severity != rgsv1.IncidentSeverity_INCIDENT_SEVERITY_UNSPECIFIED {
+		inc.Severity = req.Severity
+	}
+	if req.Status != rgsv1.IncidentStatus_INCIDENT_STATUS_UNSPECIFIED {
+		inc.Status = req.Status
+	}
+	if len(req.AffectedServices) > 0 {
+		inc.AffectedServices = normalizeAffectedServices(req.AffectedServices)
+	}
+	inc.Message = req.Message
+	inc.UpdatedAt = now
+	inc.Updates = append(inc.Updates, &rgsv1.IncidentUpdate{
+		Status:     inc.Status,
+		Severity:   inc.Severity,
+		Message:    req.Message,
+		ActorId:    actorIDFromMeta(req.Meta),
+		RecordedAt: now,
+	})
+	if err := b.persistIncident(ctx, inc); err != nil {
+		return &rgsv1.UpdateIncidentResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if err := b.appendAudit(req.Meta, inc.IncidentId, "update_incident", before, incidentSnapshot(inc), audit.ResultSuccess, ""); err != nil {
+		return &rgsv1.UpdateIncidentResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	return &rgsv1.UpdateIncidentResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Incident: cloneIncident(inc)}, nil
+}
+
+func (s SystemService) ResolveIncident(ctx context.Context, req *rgsv1.ResolveIncidentRequest) (*rgsv1.ResolveIncidentResponse, error) {
+	if s.Incidents == nil {
+		return &rgsv1.ResolveIncidentResponse{Meta: s.responseMeta(req.GetMeta(), rgsv1.ResultCode_RESULT_CODE_ERROR, "incident board unavailable")}, nil
+	}
+	b := s.Incidents
+	if req == nil || req.IncidentId == "" {
+		return &rgsv1.ResolveIncidentResponse{Meta: s.responseMeta(req.GetMeta(), rgsv1.ResultCode_RESULT_CODE_INVALID, "incident_id is required")}, nil
+	}
+	if ok, reason := s.authorizeSystemAdmin(ctx, req.Meta); !ok {
+		b.mu.Lock()
+		_ = b.appendAudit(req.Meta, req.IncidentId, "resolve_incident", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		b.mu.Unlock()
+		return &rgsv1.ResolveIncidentResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	inc, err := b.loadIncident(ctx, req.IncidentId)
+	if err != nil {
+		return &rgsv1.ResolveIncidentResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if inc == nil {
+		return &rgsv1.ResolveIncidentResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "incident not found")}, nil
+	}
+	if inc.Status == rgsv1.IncidentStatus_INCIDENT_STATUS_RESOLVED {
+		return &rgsv1.ResolveIncidentResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Incident: inc}, nil
+	}
+
+	before := incidentSnapshot(inc)
+	now := b.now().Format(time.RFC3339Nano)
+	message := req.Message
+	if message == "" {
+		message = "resolved"
+	}
+	inc.Status = rgsv1.IncidentStatus_INCIDENT_STATUS_RESOLVED
+	inc.Message = message
+	inc.UpdatedAt = now
+	inc.ResolvedAt = now
+	inc.Updates = append(inc.Updates, &rgsv1.IncidentUpdate{
+		Status:     inc.Status,
+		Severity:   inc.Severity,
+		Message:    message,
+		ActorId:    actorIDFromMeta(req.Meta),
+		RecordedAt: now,
+	})
+	if err := b.persistIncident(ctx, inc); err != nil {
+		return &rgsv1.ResolveIncidentResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if err := b.appendAudit(req.Meta, inc.IncidentId, "resolve_incident", before, incidentSnapshot(inc), audit.ResultSuccess, ""); err != nil {
+		return &rgsv1.ResolveIncidentResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	return &rgsv1.ResolveIncidentResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Incident: cloneIncident(inc)}, nil
+}
+
+func (s SystemService) ListIncidents(ctx context.Context, req *rgsv1.ListIncidentsRequest) (*rgsv1.ListIncidentsResponse, error) {
+	if req == nil {
+		req = &rgsv1.ListIncidentsRequest{}
+	}
+	if ok, reason := s.authorizeSystemAdmin(ctx, req.Meta); !ok {
+		return &rgsv1.ListIncidentsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	if req.PageSize < 0 || req.PageSize > maxAuditPageSize {
+		return &rgsv1.ListIncidentsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "invalid page_size")}, nil
+	}
+	if err := validatePageToken(req.PageToken); err != nil {
+		return &rgsv1.ListIncidentsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "invalid page_token")}, nil
+	}
+	if s.Incidents == nil {
+		return &rgsv1.ListIncidentsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, "")}, nil
+	}
+
+	b := s.Incidents
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	items, err := b.listIncidents(ctx, req.IncludeResolved)
+	if err != nil {
+		return &rgsv1.ListIncidentsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	page, next, err := paginate(items, req.PageToken, req.PageSize)
+	if err != nil {
+		return &rgsv1.ListIncidentsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "invalid page_token")}, nil
+	}
+	return &rgsv1.ListIncidentsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Incidents: page, NextPageToken: next}, nil
+}
@@ -0,0 +1,261 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func incidentSeverityToDB(v rgsv1.IncidentSeverity) string {
+	switch v {
+	case rgsv1.IncidentSeverity_INCIDENT_SEVERITY_INFO:
+		return "INFO"
+	case rgsv1.IncidentSeverity_INCIDENT_SEVERITY_MINOR:
+		return "MINOR"
+	case rgsv1.IncidentSeverity_INCIDENT_SEVERITY_MAJOR:
+		return "MAJOR"
+	case rgsv1.IncidentSeverity_INCIDENT_SEVERITY_CRITICAL:
+		return "CRITICAL"
+	default:
+		return "UNSPECIFIED"
+	}
+}
+
+func incidentSeverityFromDB(raw string) rgsv1.IncidentSeverity {
+	switch raw {
+	case "INFO":
+		return rgsv1.IncidentSeverity_INCIDENT_SEVERITY_INFO
+	case "MINOR":
+		return rgsv1.IncidentSeverity_INCIDENT_SEVERITY_MINOR
+	case "MAJOR":
+		return rgsv1.IncidentSeverity_INCIDENT_SEVERITY_MAJOR
+	case "CRITICAL":
+		return rgsv1.IncidentSeverity_INCIDENT_SEVERITY_CRITICAL
+	default:
+		return rgsv1.IncidentSeverity_INCIDENT_SEVERITY_UNSPECIFIED
+	}
+}
+
+func incidentStatusToDB(v rgsv1.IncidentStatus) string {
+	switch v {
+	case rgsv1.IncidentStatus_INCIDENT_STATUS_INVESTIGATING:
+		return "INVESTIGATING"
+	case rgsv1.IncidentStatus_INCIDENT_STATUS_IDENTIFIED:
+		return "IDENTIFIED"
+	case rgsv1.IncidentStatus_INCIDENT_STATUS_MONITORING:
+		return "MONITORING"
+	case rgsv1.IncidentStatus_INCIDENT_STATUS_RESOLVED:
+		return "RESOLVED"
+	default:
+		return "UNSPECIFIED"
+	}
+}
+
+func incidentStatusFromDB(raw string) rgsv1.IncidentStatus {
+	switch raw {
+	case "INVESTIGATING":
+		return rgsv1.IncidentStatus_INCIDENT_STATUS_INVESTIGATING
+	case "IDENTIFIED":
+		return rgsv1.IncidentStatus_INCIDENT_STATUS_IDENTIFIED
+	case "MONITORING":
+		return rgsv1.IncidentStatus_INCIDENT_STATUS_MONITORING
+	case "RESOLVED":
+		return rgsv1.IncidentStatus_INCIDENT_STATUS_RESOLVED
+	default:
+		return rgsv1.IncidentStatus_INCIDENT_STATUS_UNSPECIFIED
+	}
+}
+
+// upsertIncidentInDB writes the incident row and appends any update entries
+// not yet stored. Update history is append-only and keyed by position.
+func (b *IncidentBoard) upsertIncidentInDB(ctx context.Context, inc *rgsv1.Incident) error {
+	if b == nil || b.db == nil || inc == nil {
+		return nil
+	}
+	services, err := json.Marshal(inc.AffectedServices)
+	if err != nil {
+		return err
+	}
+	tx, err := b.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	const q = `
+INSERT INTO system_incidents (
+  incident_id, title, message, severity, status, affected_services, started_at, updated_at, resolved_at
+)
+VALUES ($1,$2,$3,$4,$5,$6::jsonb,$7::timestamptz,$8::timestamptz,NULLIF($9,'')::timestamptz)
+ON CONFLICT (incident_id) DO UPDATE SET
+  title = EXCLUDED.title,
+  message = EXCLUDED.message,
+  severity = EXCLUDED.severity,
+  status = EXCLUDED.status,
+  affected_services = EXCLUDED.affected_services,
+  updated_at = EXCLUDED.updated_at,
+  resolved_at = EXCLUDED.resolved_at
+`
+	if _, err := tx.ExecContext(ctx, q,
+		inc.IncidentId,
+		inc.Title,
+		inc.Message,
+		incidentSeverityToDB(inc.Severity),
+		incidentStatusToDB(inc.Status),
+		string(services),
+		nonEmptyTime(inc.StartedAt),
+		nonEmptyTime(inc.UpdatedAt),
+		inc.ResolvedAt,
+	); err != nil {
+		return err
+	}
+
+	const uq = `
+INSERT INTO system_incident_updates (incident_id, seq, status, severity, message, actor_id, recorded_at)
+VALUES ($1,$2,$3,$4,$5,$6,$7::timestamptz)
+ON CONFLICT (incident_id, seq) DO NOTHING
+`
+	for i, u := range inc.Updates {
+		if _, err := tx.ExecContext(ctx, uq,
+			inc.IncidentId,
+			i+1,
+			incidentStatusToDB(u.Status),
+			incidentSeverityToDB(u.Severity),
+			u.Message,
+			u.ActorId,
+			nonEmptyTime(u.RecordedAt),
+		); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (b *IncidentBoard) getIncidentFromDB(ctx context.Context, incidentID string) (*rgsv1.Incident, error) {
+	if b == nil || b.db == nil {
+		return nil, nil
+	}
+	const q = `
+SELECT incident_id, title, message, severity, status, affected_services, started_at, updated_at, resolved_at
+FROM system_incidents
+WHERE incident_id = $1
+`
+	inc, err := scanIncident(b.db.QueryRowContext(ctx, q, incidentID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if err := b.loadIncidentUpdatesFromDB(ctx, inc); err != nil {
+		return nil, err
+	}
+	return inc, nil
+}
+
+func (b *IncidentBoard) listIncidentsFromDB(ctx context.Context, includeResolved bool) ([]*rgsv1.Incident, error) {
+	if b == nil || b.db == nil {
+		return nil, nil
+	}
+	const q = `
+SELECT incident_id, title, message, severity, status, affected_services, started_at, updated_at, resolved_at
+FROM system_incidents
+WHERE $1 OR status <> 'RESOLVED'
+ORDER BY started_at DESC, incident_id DESC
+`
+	rows, err := b.db.QueryContext(ctx, q, includeResolved)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]*rgsv1.Incident, 0)
+	for rows.Next() {
+		inc, err := scanIncident(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, inc)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	for _, inc := range out {
+		if err := b.loadIncidentUpdatesFromDB(ctx, inc); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func (b *IncidentBoard) loadIncidentUpdatesFromDB(ctx context.Context, inc *rgsv1.Incident) error {
+	const q = `
+SELECT status, severity, message, actor_id, recorded_at
+FROM system_incident_updates
+WHERE incident_id = $1
+ORDER BY seq ASC
+`
+	rows, err := b.db.QueryContext(ctx, q, inc.IncidentId)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			u                 rgsv1.IncidentUpdate
+			statusRaw, sevRaw string
+			recordedAt        time.Time
+		)
+		if err := rows.Scan(&statusRaw, &sevRaw, &u.Message, &u.ActorId, &recordedAt); err != nil {
+			return err
+		}
+		u.Status = incidentStatusFromDB(statusRaw)
+		u.Severity = incidentSeverityFromDB(sevRaw)
+		u.RecordedAt = recordedAt.UTC().Format(time.RFC3339Nano)
+		inc.Updates = append(inc.Updates, &u)
+	}
+	return rows.Err()
+}
+
+type incidentScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanIncident(row incidentScanner) (*rgsv1.Incident, error) {
+	var (
+		inc                  rgsv1.Incident
+		sevRaw, statusRaw    string
+		servicesRaw          []byte
+		startedAt, updatedAt time.Time
+		resolvedAt           *time.Time
+	)
+	if err := row.Scan(
+		&inc.IncidentId,
+		&inc.Title,
+		&inc.Message,
+		&sevRaw,
+		&statusRaw,
+		&servicesRaw,
+		&startedAt,
+		&updatedAt,
+		&resolvedAt,
+	); err != nil {
+		return nil, err
+	}
+	if len(servicesRaw) > 0 {
+		if err := json.Unmarshal(servicesRaw, &inc.AffectedServices); err != nil {
+			return nil, err
+		}
+	}
+	inc.Severity = incidentSeverityFromDB(sevRaw)
+	inc.Status = incidentStatusFromDB(statusRaw)
+	inc.StartedAt = startedAt.UTC().Format(time.RFC3339Nano)
+	inc.UpdatedAt = updatedAt.UTC().Format(time.RFC3339Nano)
+	if resolvedAt != nil {
+		inc.ResolvedAt = resolvedAt.UTC().Format(time.RFC3339Nano)
+	}
+	return &inc, nil
+}
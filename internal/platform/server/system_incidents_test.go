@@ -0,0 +1,132 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func newIncidentTestService() SystemService {
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 12, 9, 0, 0, 0, time.UTC)}
+	return SystemService{
+		StartedAt: clk.now.Add(-time.Hour),
+		Clock:     clk,
+		Version:   "test",
+		Incidents: NewIncidentBoard(clk),
+	}
+}
+
+func TestIncidentLifecycleSurfacesInStatus(t *testing.T) {
+	svc := newIncidentTestService()
+	ctx := context.Background()
+	op := meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+
+	created, err := svc.CreateIncident(ctx, &rgsv1.CreateIncidentRequest{
+		Meta:             op,
+		Title:            "Wallet degraded",
+		Message:          "Deposits delayed",
+		Severity:         rgsv1.IncidentSeverity_INCIDENT_SEVERITY_MAJOR,
+		AffectedServices: []string{"ledger", "ledger", " wagering "},
+	})
+	if err != nil {
+		t.Fatalf("create err: %v", err)
+	}
+	if created.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected ok, got=%v reason=%q", created.Meta.ResultCode, created.Meta.DenialReason)
+	}
+	inc := created.Incident
+	if inc.Status != rgsv1.IncidentStatus_INCIDENT_STATUS_INVESTIGATING {
+		t.Fatalf("expected investigating, got=%v", inc.Status)
+	}
+	if len(inc.AffectedServices) != 2 || inc.AffectedServices[1] != "wagering" {
+		t.Fatalf("unexpected affected services: %v", inc.AffectedServices)
+	}
+
+	status, _ := svc.GetSystemStatus(ctx, &rgsv1.GetSystemStatusRequest{})
+	if len(status.ActiveIncidents) != 1 || status.ActiveIncidents[0].IncidentId != inc.IncidentId {
+		t.Fatalf("expected active incident in status, got=%v", status.ActiveIncidents)
+	}
+
+	updated, _ := svc.UpdateIncident(ctx, &rgsv1.UpdateIncidentRequest{
+		Meta:       op,
+		IncidentId: inc.IncidentId,
+		Message:    "Root cause found",
+		Status:     rgsv1.IncidentStatus_INCIDENT_STATUS_IDENTIFIED,
+		Severity:   rgsv1.IncidentSeverity_INCIDENT_SEVERITY_CRITICAL,
+	})
+	if updated.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected update ok, got=%v reason=%q", updated.Meta.ResultCode, updated.Meta.DenialReason)
+	}
+
+	page, _ := svc.GetStatusPage(ctx, &rgsv1.GetStatusPageRequest{})
+	if page.OverallSeverity != rgsv1.IncidentSeverity_INCIDENT_SEVERITY_CRITICAL {
+		t.Fatalf("expected critical overall severity, got=%v", page.OverallSeverity)
+	}
+
+	resolved, _ := svc.ResolveIncident(ctx, &rgsv1.ResolveIncidentRequest{Meta: op, IncidentId: inc.IncidentId, Message: "Recovered"})
+	if resolved.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected resolve ok, got=%v", resolved.Meta.ResultCode)
+	}
+	if resolved.Incident.ResolvedAt == "" || len(resolved.Incident.Updates) != 3 {
+		t.Fatalf("expected resolved incident with full history, got=%+v", resolved.Incident)
+	}
+
+	status, _ = svc.GetSystemStatus(ctx, &rgsv1.GetSystemStatusRequest{})
+	if len(status.ActiveIncidents) != 0 {
+		t.Fatalf("expected no active incidents after resolve, got=%d", len(status.ActiveIncidents))
+	}
+	page, _ = svc.GetStatusPage(ctx, &rgsv1.GetStatusPageRequest{})
+	if len(page.RecentlyResolved) != 1 || page.OverallSeverity != rgsv1.IncidentSeverity_INCIDENT_SEVERITY_UNSPECIFIED {
+		t.Fatalf("expected resolved history on status page, got=%+v", page)
+	}
+
+	again, _ := svc.UpdateIncident(ctx, &rgsv1.UpdateIncidentRequest{Meta: op, IncidentId: inc.IncidentId, Message: "late"})
+	if again.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected invalid update on resolved incident, got=%v", again.Meta.ResultCode)
+	}
+
+	list, _ := svc.ListIncidents(ctx, &rgsv1.ListIncidentsRequest{Meta: op, IncludeResolved: true})
+	if len(list.Incidents) != 1 {
+		t.Fatalf("expected resolved incident in history list, got=%d", len(list.Incidents))
+	}
+}
+
+func TestIncidentManagementDeniesPlayer(t *testing.T) {
+	svc := newIncidentTestService()
+	ctx := context.Background()
+	player := meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "")
+
+	resp, _ := svc.CreateIncident(ctx, &rgsv1.CreateIncidentRequest{
+		Meta:     player,
+		Title:    "fake outage",
+		Severity: rgsv1.IncidentSeverity_INCIDENT_SEVERITY_INFO,
+	})
+	if resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected denied, got=%v", resp.Meta.ResultCode)
+	}
+	list, _ := svc.ListIncidents(ctx, &rgsv1.ListIncidentsRequest{Meta: player})
+	if list.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected list denied, got=%v", list.Meta.ResultCode)
+	}
+	invalid, _ := svc.CreateIncident(ctx, &rgsv1.CreateIncidentRequest{Meta: player})
+	if invalid.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected an invalid request from a player to be denied, got=%v", invalid.Meta.ResultCode)
+	}
+	events := auditEvents(svc.Incidents.AuditStore)
+	if len(events) != 2 || events[0].Action != "create_incident" || events[1].Reason == "invalid request" {
+		t.Fatalf("expected denied creates to be audited, got=%+v", events)
+	}
+}
+
+func TestIncidentCreateRequiresSeverity(t *testing.T) {
+	svc := newIncidentTestService()
+	resp, _ := svc.CreateIncident(context.Background(), &rgsv1.CreateIncidentRequest{
+		Meta:  meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		Title: "missing severity",
+	})
+	if resp.Meta.ResultCode != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected invalid, got=%v", resp.Meta.ResultCode)
+	}
+}
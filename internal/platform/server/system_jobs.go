@@ -0,0 +1,58 @@
+package server
+
+import (
+	"context"
+	"strconv"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func (s SystemService) ListScheduledJobs(ctx context.Context, req *rgsv1.ListScheduledJobsRequest) (*rgsv1.ListScheduledJobsResponse, error) {
+	if req == nil {
+		req = &rgsv1.ListScheduledJobsRequest{}
+	}
+	if ok, reason := s.authorizeSystemAdmin(ctx, req.Meta); !ok {
+		return &rgsv1.ListScheduledJobsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	if s.Scheduler == nil {
+		return &rgsv1.ListScheduledJobsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, "")}, nil
+	}
+	jobs, err := s.Scheduler.listJobs(ctx)
+	if err != nil {
+		return &rgsv1.ListScheduledJobsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	return &rgsv1.ListScheduledJobsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Jobs: jobs}, nil
+}
+
+// ListJobRuns returns the run history of scheduled and one-shot jobs, newest
+// first.
+func (s SystemService) ListJobRuns(ctx context.Context, req *rgsv1.ListJobRunsRequest) (*rgsv1.ListJobRunsResponse, error) {
+	if req == nil {
+		req = &rgsv1.ListJobRunsRequest{}
+	}
+	if ok, reason := s.authorizeSystemAdmin(ctx, req.Meta); !ok {
+		return &rgsv1.ListJobRunsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	if req.PageSize < 0 || req.PageSize > maxAuditPageSize {
+		return &rgsv1.ListJobRunsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "invalid page_size")}, nil
+	}
+	if err := validatePageToken(req.PageToken); err != nil {
+		return &rgsv1.ListJobRunsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "invalid page_token")}, nil
+	}
+	if s.Scheduler == nil {
+		return &rgsv1.ListJobRunsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, "")}, nil
+	}
+	limit := int(req.PageSize)
+	if limit == 0 {
+		limit = 100
+	}
+	offset := 0
+	if req.PageToken != "" {
+		offset, _ = strconv.Atoi(req.PageToken)
+	}
+	runs, next, err := s.Scheduler.listRuns(ctx, req.JobName, limit, offset)
+	if err != nil {
+		return &rgsv1.ListJobRunsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	return &rgsv1.ListJobRunsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Runs: runs, NextPageToken: next}, nil
+}
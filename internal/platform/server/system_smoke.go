@@ -0,0 +1,257 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+	platformauth "github.com/wizardbeardstudio/open-rgs-go/internal/platform/auth"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/clock"
+)
+
+const (
+	smokeSandboxAccountID = "smoke-sandbox"
+	smokeSandboxCurrency  = "USD"
+)
+
+// SmokeChecker runs the post-deploy verification battery behind
+// RunSmokeChecks. Checks exercise the live services but keep their side
+// effects to a sandbox ledger account whose balance nets to zero, smoke
+// audit events, and a report run.
+type SmokeChecker struct {
+	Clock      clock.Clock
+	AuditStore audit.Store
+
+	Ledger    *LedgerService
+	Audit     *AuditService
+	Reporting *ReportingService
+	Signer    *platformauth.JWTSigner
+	Verifier  *platformauth.JWTVerifier
+
+	mu          sync.Mutex
+	db          *sql.DB
+	nextRunID   int64
+	nextAuditID int64
+}
+
+func NewSmokeChecker(clk clock.Clock, db ...*sql.DB) *SmokeChecker {
+	var handle *sql.DB
+	if len(db) > 0 {
+		handle = db[0]
+	}
+	return &SmokeChecker{Clock: clk, AuditStore: NewAuditStore(handle), db: handle}
+}
+
+// smokeCheck is one entry of the battery. run reports SKIPPED when the
+// dependency it exercises is not configured.
+type smokeCheck struct {
+	name string
+	run  func(c *SmokeChecker, ctx context.Context, meta *rgsv1.RequestMeta, runID string) (rgsv1.SmokeCheckStatus, string)
+}
+
+var smokeChecks = []smokeCheck{
+	{name: "ledger_round_trip", run: (*SmokeChecker).checkLedgerRoundTrip},
+	{name: "audit_chain", run: (*SmokeChecker).checkAuditChain},
+	{name: "report_generation", run: (*SmokeChecker).checkReportGeneration},
+	{name: "jwt_keyset", run: (*SmokeChecker).checkJWTKeyset},
+}
+
+func (c *SmokeChecker) now() time.Time {
+	if c.Clock == nil {
+		return time.Now().UTC()
+	}
+	return c.Clock.Now().UTC()
+}
+
+func (c *SmokeChecker) nextRunIDLocked() string {
+	c.nextRunID++
+	return "smoke-" + strconv.FormatInt(c.now().UnixNano(), 10) + "-" + strconv.FormatInt(c.nextRunID, 10)
+}
+
+func (c *SmokeChecker) appendAudit(ctx context.Context, meta *rgsv1.RequestMeta, objectID, action string, after []byte, result audit.Result, reason string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.nextAuditID++
+	now := c.now()
+	auditID := "smoke-audit-" + strconv.FormatInt(now.UnixNano(), 10) + "-" + strconv.FormatInt(c.nextAuditID, 10)
+	ev := newAuditEvent(meta, auditID, now, "smoke_check", objectID, action, []byte(`{}`), after, result, reason)
+	return appendAuditTo(ctx, c.AuditStore, ev)
+}
+
+func smokeSubMeta(meta *rgsv1.RequestMeta, runID, step string) *rgsv1.RequestMeta {
+	out := &rgsv1.RequestMeta{RequestId: runID + "-" + step, IdempotencyKey: runID + "-" + step}
+	if meta != nil {
+		out.Actor = meta.Actor
+		out.Source = meta.Source
+	}
+	return out
+}
+
+// checkLedgerRoundTrip deposits and withdraws one minor unit on the sandbox
+// account and expects the balance to return to where it started.
+func (c *SmokeChecker) checkLedgerRoundTrip(ctx context.Context, meta *rgsv1.RequestMeta, runID string) (rgsv1.SmokeCheckStatus, string) {
+	if c.Ledger == nil {
+		return rgsv1.SmokeCheckStatus_SMOKE_CHECK_STATUS_SKIPPED, "ledger service not configured"
+	}
+	amount := &rgsv1.Money{AmountMinor: 1, Currency: smokeSandboxCurrency}
+	dep, _ := c.Ledger.Deposit(ctx, &rgsv1.DepositRequest{Meta: smokeSubMeta(meta, runID, "deposit"), AccountId: smokeSandboxAccountID, Amount: amount})
+	if code := dep.Meta.GetResultCode(); code != rgsv1.ResultCode_RESULT_CODE_OK {
+		return rgsv1.SmokeCheckStatus_SMOKE_CHECK_STATUS_FAILED, "deposit " + code.String() + ": " + dep.Meta.GetDenialReason()
+	}
+	wd, _ := c.Ledger.Withdraw(ctx, &rgsv1.WithdrawRequest{Meta: smokeSubMeta(meta, runID, "withdraw"), AccountId: smokeSandboxAccountID, Amount: amount})
+	if code := wd.Meta.GetResultCode(); code != rgsv1.ResultCode_RESULT_CODE_OK {
+		return rgsv1.SmokeCheckStatus_SMOKE_CHECK_STATUS_FAILED, "withdraw " + code.String() + ": " + wd.Meta.GetDenialReason()
+	}
+	afterDeposit := dep.AvailableBalance.GetAmountMinor()
+	if got := wd.AvailableBalance.GetAmountMinor(); got != afterDeposit-amount.AmountMinor {
+		return rgsv1.SmokeCheckStatus_SMOKE_CHECK_STATUS_FAILED, fmt.Sprintf("sandbox balance %d after withdrawal, expected %d", got, afterDeposit-amount.AmountMinor)
+	}
+	return rgsv1.SmokeCheckStatus_SMOKE_CHECK_STATUS_PASSED, "deposit " + dep.Transaction.GetTransactionId() + " and withdrawal " + wd.Transaction.GetTransactionId() + " posted"
+}
+
+// checkAuditChain appends a probe event and verifies the hash chains of the
+// partition day it landed in, expecting the probe to be exported.
+func (c *SmokeChecker) checkAuditChain(ctx context.Context, meta *rgsv1.RequestMeta, runID string) (rgsv1.SmokeCheckStatus, string) {
+	if c.Audit == nil {
+		return rgsv1.SmokeCheckStatus_SMOKE_CHECK_STATUS_SKIPPED, "audit service not configured"
+	}
+	if err := c.appendAudit(ctx, meta, runID, "audit_probe", []byte(`{}`), audit.ResultSuccess, ""); err != nil {
+		return rgsv1.SmokeCheckStatus_SMOKE_CHECK_STATUS_FAILED, "append probe: " + err.Error()
+	}
+	day := auditPartitionDay(c.now())
+	rows, verified, err := c.Audit.exportPartitionDay(ctx, day)
+	if err != nil {
+		return rgsv1.SmokeCheckStatus_SMOKE_CHECK_STATUS_FAILED, "read audit partition: " + err.Error()
+	}
+	if !verified {
+		return rgsv1.SmokeCheckStatus_SMOKE_CHECK_STATUS_FAILED, "audit chain verification failed for " + day
+	}
+	for _, row := range rows {
+		if row.ObjectType == "smoke_check" && row.ObjectID == runID && row.Action == "audit_probe" {
+			return rgsv1.SmokeCheckStatus_SMOKE_CHECK_STATUS_PASSED, fmt.Sprintf("probe appended; %d events in %s verified", len(rows), day)
+		}
+	}
+	return rgsv1.SmokeCheckStatus_SMOKE_CHECK_STATUS_FAILED, "probe event not found in audit partition " + day
+}
+
+// checkReportGeneration renders the day-to-date cashless liability report.
+func (c *SmokeChecker) checkReportGeneration(ctx context.Context, meta *rgsv1.RequestMeta, runID string) (rgsv1.SmokeCheckStatus, string) {
+	if c.Reporting == nil {
+		return rgsv1.SmokeCheckStatus_SMOKE_CHECK_STATUS_SKIPPED, "reporting service not configured"
+	}
+	resp, _ := c.Reporting.GenerateReport(ctx, &rgsv1.GenerateReportRequest{
+		Meta:       smokeSubMeta(meta, runID, "report"),
+		ReportType: rgsv1.ReportType_REPORT_TYPE_CASHLESS_LIABILITY_SUMMARY,
+		Interval:   rgsv1.ReportInterval_REPORT_INTERVAL_DTD,
+		Format:     rgsv1.ReportFormat_REPORT_FORMAT_JSON,
+	})
+	if code := resp.Meta.GetResultCode(); code != rgsv1.ResultCode_RESULT_CODE_OK {
+		return rgsv1.SmokeCheckStatus_SMOKE_CHECK_STATUS_FAILED, "generate report " + code.String() + ": " + resp.Meta.GetDenialReason()
+	}
+	if len(resp.ReportRun.GetContent()) == 0 {
+		return rgsv1.SmokeCheckStatus_SMOKE_CHECK_STATUS_FAILED, "report " + resp.ReportRun.GetReportRunId() + " has no content"
+	}
+	return rgsv1.SmokeCheckStatus_SMOKE_CHECK_STATUS_PASSED, "report " + resp.ReportRun.GetReportRunId() + " generated"
+}
+
+// checkJWTKeyset signs a short-lived token with the active key and verifies
+// it with the verifier keyset.
+func (c *SmokeChecker) checkJWTKeyset(_ context.Context, _ *rgsv1.RequestMeta, runID string) (rgsv1.SmokeCheckStatus, string) {
+	if c.Signer == nil || c.Verifier == nil {
+		return rgsv1.SmokeCheckStatus_SMOKE_CHECK_STATUS_SKIPPED, "jwt keyset not configured"
+	}
+	actor := platformauth.Actor{ID: runID, Type: rgsv1.ActorType_ACTOR_TYPE_SERVICE.String()}
+	token, _, err := c.Signer.SignActor(actor, c.now(), time.Minute)
+	if err != nil {
+		return rgsv1.SmokeCheckStatus_SMOKE_CHECK_STATUS_FAILED, "sign: " + err.Error()
+	}
+	kid := platformauth.TokenKID(token)
+	parsed, err := c.Verifier.ParseActor(token)
+	if err != nil {
+		return rgsv1.SmokeCheckStatus_SMOKE_CHECK_STATUS_FAILED, "verify kid " + kid + ": " + err.Error()
+	}
+	if parsed != actor {
+		return rgsv1.SmokeCheckStatus_SMOKE_CHECK_STATUS_FAILED, "verified token carries a different actor"
+	}
+	return rgsv1.SmokeCheckStatus_SMOKE_CHECK_STATUS_PASSED, "token signed and verified with kid " + kid
+}
+
+func (s SystemService) authorizeSmokeChecks(ctx context.Context, meta *rgsv1.RequestMeta) (bool, string) {
+	actor, reason := resolveActor(ctx, meta)
+	if reason != "" {
+		return false, reason
+	}
+	if actor.ActorType != rgsv1.ActorType_ACTOR_TYPE_OPERATOR {
+		return false, "unauthorized actor type"
+	}
+	return true, ""
+}
+
+// RunSmokeChecks runs the selected smoke checks, or all of them, in order
+// and reports each result. The run is audited with its outcome so
+// deployment pipelines leave a trace of what was verified.
+func (s SystemService) RunSmokeChecks(ctx context.Context, req *rgsv1.RunSmokeChecksRequest) (*rgsv1.RunSmokeChecksResponse, error) {
+	if req == nil {
+		req = &rgsv1.RunSmokeChecksRequest{}
+	}
+	c := s.Smoke
+	if ok, reason := s.authorizeSmokeChecks(ctx, req.Meta); !ok {
+		if c != nil {
+			_ = c.appendAudit(ctx, req.Meta, "", "run_smoke_checks", []byte(`{}`), audit.ResultDenied, reason)
+		}
+		return &rgsv1.RunSmokeChecksResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	if c == nil {
+		return &rgsv1.RunSmokeChecksResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "smoke checks unavailable")}, nil
+	}
+	selected := smokeChecks
+	if len(req.Checks) > 0 {
+		byName := make(map[string]smokeCheck, len(smokeChecks))
+		for _, check := range smokeChecks {
+			byName[check.name] = check
+		}
+		selected = make([]smokeCheck, 0, len(req.Checks))
+		for _, name := range req.Checks {
+			check, ok := byName[name]
+			if !ok {
+				return &rgsv1.RunSmokeChecksResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "unknown check: "+name)}, nil
+			}
+			selected = append(selected, check)
+		}
+	}
+
+	c.mu.Lock()
+	runID := c.nextRunIDLocked()
+	c.mu.Unlock()
+	resp := &rgsv1.RunSmokeChecksResponse{RunId: runID, Passed: true}
+	for _, check := range selected {
+		started := c.now()
+		status, detail := check.run(c, ctx, req.Meta, runID)
+		resp.Results = append(resp.Results, &rgsv1.SmokeCheckResult{
+			Name:       check.name,
+			Status:     status,
+			Detail:     detail,
+			DurationMs: c.now().Sub(started).Milliseconds(),
+		})
+		if status == rgsv1.SmokeCheckStatus_SMOKE_CHECK_STATUS_FAILED {
+			resp.Passed = false
+		}
+	}
+
+	result, reason := audit.ResultSuccess, ""
+	if !resp.Passed {
+		result, reason = audit.ResultDenied, "smoke checks failed"
+	}
+	after, _ := json.Marshal(resp.Results)
+	if err := c.appendAudit(ctx, req.Meta, runID, "run_smoke_checks", after, result, reason); err != nil {
+		return &rgsv1.RunSmokeChecksResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	resp.Meta = s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, "")
+	return resp, nil
+}
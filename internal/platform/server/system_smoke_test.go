@@ -0,0 +1,87 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	platformauth "github.com/wizardbeardstudio/open-rgs-go/internal/platform/auth"
+)
+
+func TestSystemRunSmokeChecksAllPass(t *testing.T) {
+	// The JWT verifier checks expiry against wall time.
+	clk := ledgerFixedClock{now: time.Now().UTC()}
+	ledgerSvc := NewLedgerService(clk)
+	smoke := NewSmokeChecker(clk)
+	smoke.Ledger = ledgerSvc
+	smoke.Reporting = NewReportingService(clk, ledgerSvc, NewEventsService(clk))
+	smoke.Audit = NewAuditService(clk, nil, ledgerSvc.AuditStore, smoke.AuditStore)
+	keyset := platformauth.HMACKeyset{ActiveKID: "k1", Keys: map[string][]byte{"k1": []byte("smoke-secret")}}
+	smoke.Signer = platformauth.NewJWTSignerWithKeyset(keyset)
+	smoke.Verifier = platformauth.NewJWTVerifierWithKeyset(keyset)
+	svc := SystemService{StartedAt: clk.now, Clock: clk, Smoke: smoke}
+
+	resp, _ := svc.RunSmokeChecks(context.Background(), &rgsv1.RunSmokeChecksRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")})
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || !resp.Passed || resp.RunId == "" {
+		t.Fatalf("expected passing smoke run, got=%+v", resp)
+	}
+	if len(resp.Results) != 4 {
+		t.Fatalf("expected four checks, got=%+v", resp.Results)
+	}
+	for _, r := range resp.Results {
+		if r.Status != rgsv1.SmokeCheckStatus_SMOKE_CHECK_STATUS_PASSED {
+			t.Fatalf("expected %s to pass, got=%s (%s)", r.Name, r.Status, r.Detail)
+		}
+	}
+	bal, _ := ledgerSvc.GetBalance(context.Background(), &rgsv1.GetBalanceRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), AccountId: smokeSandboxAccountID})
+	if bal.AvailableBalance.GetAmountMinor() != 0 {
+		t.Fatalf("expected sandbox balance to net to zero, got=%d", bal.AvailableBalance.GetAmountMinor())
+	}
+	events := auditEvents(smoke.AuditStore)
+	if last := events[len(events)-1]; last.Action != "run_smoke_checks" || last.ObjectID != resp.RunId {
+		t.Fatalf("expected smoke run audited, got=%+v", last)
+	}
+
+	again, _ := svc.RunSmokeChecks(context.Background(), &rgsv1.RunSmokeChecksRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), Checks: []string{"ledger_round_trip"}})
+	if !again.Passed || len(again.Results) != 1 || again.RunId == resp.RunId {
+		t.Fatalf("expected repeat ledger round trip to pass with a new run id, got=%+v", again)
+	}
+}
+
+func TestSystemRunSmokeChecksSkipsAndRejects(t *testing.T) {
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 23, 10, 0, 0, 0, time.UTC)}
+	smoke := NewSmokeChecker(clk)
+	svc := SystemService{StartedAt: clk.now, Clock: clk, Smoke: smoke}
+	ctx := context.Background()
+
+	resp, _ := svc.RunSmokeChecks(ctx, &rgsv1.RunSmokeChecksRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")})
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || !resp.Passed {
+		t.Fatalf("expected skipped checks not to fail the run, got=%+v", resp)
+	}
+	for _, r := range resp.Results {
+		if r.Status != rgsv1.SmokeCheckStatus_SMOKE_CHECK_STATUS_SKIPPED {
+			t.Fatalf("expected %s skipped without dependencies, got=%s", r.Name, r.Status)
+		}
+	}
+
+	invalid, _ := svc.RunSmokeChecks(ctx, &rgsv1.RunSmokeChecksRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), Checks: []string{"drop_tables"}})
+	if invalid.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected unknown check invalid, got=%+v", invalid.Meta)
+	}
+	for _, actorType := range []rgsv1.ActorType{rgsv1.ActorType_ACTOR_TYPE_PLAYER, rgsv1.ActorType_ACTOR_TYPE_SERVICE} {
+		denied, _ := svc.RunSmokeChecks(ctx, &rgsv1.RunSmokeChecksRequest{Meta: meta("actor-1", actorType, "")})
+		if denied.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+			t.Fatalf("expected %s denied, got=%+v", actorType, denied.Meta)
+		}
+	}
+	events := auditEvents(smoke.AuditStore)
+	if last := events[len(events)-1]; last.Result != "denied" || last.Reason != "unauthorized actor type" {
+		t.Fatalf("expected denial audited, got=%+v", last)
+	}
+
+	unconfigured, _ := SystemService{StartedAt: clk.now, Clock: clk}.RunSmokeChecks(ctx, &rgsv1.RunSmokeChecksRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")})
+	if unconfigured.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_ERROR {
+		t.Fatalf("expected error without smoke checker, got=%+v", unconfigured.Meta)
+	}
+}
@@ -119,3 +119,49 @@ func TestWageringGatewayActorMismatchDenied(t *testing.T) {
 		t.Fatalf("expected actor mismatch denial for cancel, got=%q", cancelResp.GetMeta().GetDenialReason())
 	}
 }
+
+func TestWageringGatewayListWagersParity(t *testing.T) {
+	start := time.Date(2026, 2, 15, 9, 0, 0, 0, time.UTC)
+	svc := NewWageringService(ledgerFixedClock{now: start})
+	seedListWagers(t, svc, start)
+	gwMux := runtime.NewServeMux()
+	if err := rgsv1.RegisterWageringServiceHandlerServer(context.Background(), gwMux, svc); err != nil {
+		t.Fatalf("register wagering gateway handlers: %v", err)
+	}
+
+	grpcResp, err := svc.ListWagers(context.Background(), &rgsv1.ListWagersRequest{
+		Meta:     meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		GameId:   "game-a",
+		PageSize: 1,
+	})
+	if err != nil || grpcResp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("grpc list wagers failed: err=%v resp=%+v", err, grpcResp)
+	}
+
+	path := "/v1/wagering/wagers?meta.actor.actorId=op-1&meta.actor.actorType=ACTOR_TYPE_OPERATOR&gameId=game-a&pageSize=1"
+	rec := httptest.NewRecorder()
+	gwMux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	if rec.Result().StatusCode != http.StatusOK {
+		t.Fatalf("list wagers status: got=%d body=%s", rec.Result().StatusCode, rec.Body.String())
+	}
+	var httpResp rgsv1.ListWagersResponse
+	if err := protojson.Unmarshal(rec.Body.Bytes(), &httpResp); err != nil {
+		t.Fatalf("unmarshal list wagers response: %v body=%s", err, rec.Body.String())
+	}
+	if len(httpResp.Wagers) != 1 || httpResp.Wagers[0].GetWagerId() != grpcResp.Wagers[0].GetWagerId() {
+		t.Fatalf("gateway/grpc page mismatch: http=%v grpc=%v", httpResp.Wagers, grpcResp.Wagers)
+	}
+	if httpResp.NextPageToken == "" || httpResp.NextPageToken != grpcResp.NextPageToken {
+		t.Fatalf("gateway/grpc next_page_token mismatch: http=%q grpc=%q", httpResp.NextPageToken, grpcResp.NextPageToken)
+	}
+
+	rec = httptest.NewRecorder()
+	gwMux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path+"&pageToken="+httpResp.NextPageToken+"&status=WAGER_STATUS_PENDING", nil))
+	var page2 rgsv1.ListWagersResponse
+	if err := protojson.Unmarshal(rec.Body.Bytes(), &page2); err != nil {
+		t.Fatalf("unmarshal second page: %v body=%s", err, rec.Body.String())
+	}
+	if len(page2.Wagers) != 1 || page2.NextPageToken != "" || page2.Wagers[0].GetGameId() != "game-a" {
+		t.Fatalf("unexpected second gateway page: %+v", &page2)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
 	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
 	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/clock"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/money"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -18,17 +19,39 @@ type WageringService struct {
 	rgsv1.UnimplementedWageringServiceServer
 
 	Clock      clock.Clock
-	AuditStore *audit.InMemoryStore
+	AuditStore audit.Store
+	// Sessions, when set, receives wager activity for responsible gaming
+	// session summaries.
+	Sessions *SessionsService
+	// Ledger refunds stakes of wagers voided through VoidWager and, with
+	// ledger integration enabled, carries stakes and payouts.
+	Ledger *LedgerService
+	// Registry identifies the devices allowed to stream wager results.
+	Registry *RegistryService
+	// Settings, when set, supplies the stake limits PlaceWager enforces.
+	Settings WageringSettingsSource
+	// SelfExclusion, when set, denies PlaceWager for self-excluded players.
+	SelfExclusion SelfExclusionChecker
+	// PlayerLimits, when set, supplies the loss and wager limits PlaceWager
+	// enforces against the activity recorded in Sessions.
+	PlayerLimits PlayerLimitSource
 
 	mu                  sync.Mutex
 	wagers              map[string]*rgsv1.Wager
 	placeByIdempotency  map[string]*rgsv1.PlaceWagerResponse
 	settleByIdempotency map[string]*rgsv1.SettleWagerResponse
 	cancelByIdempotency map[string]*rgsv1.CancelWagerResponse
+	voidByIdempotency   map[string]*rgsv1.VoidWagerResponse
 	nextWagerID         int64
 	nextAuditID         int64
 	db                  *sql.DB
 	disableInMemCache   bool
+	ledgerIntegration   bool
+	settlementPolicy    WagerSettlementPolicy
+	onSettlement        func(outcome string, latency time.Duration)
+	onSweep             func(overdue, escalated, voided, failed int)
+	results             *wagerResultHub
+	jackpotPools        map[string]int64
 }
 
 func NewWageringService(clk clock.Clock, db ...*sql.DB) *WageringService {
@@ -38,12 +61,15 @@ func NewWageringService(clk clock.Clock, db ...*sql.DB) *WageringService {
 	}
 	return &WageringService{
 		Clock:               clk,
-		AuditStore:          audit.NewInMemoryStore(),
+		AuditStore:          NewAuditStore(handle),
 		wagers:              make(map[string]*rgsv1.Wager),
 		placeByIdempotency:  make(map[string]*rgsv1.PlaceWagerResponse),
 		settleByIdempotency: make(map[string]*rgsv1.SettleWagerResponse),
 		cancelByIdempotency: make(map[string]*rgsv1.CancelWagerResponse),
+		voidByIdempotency:   make(map[string]*rgsv1.VoidWagerResponse),
 		db:                  handle,
+		results:             newWagerResultHub(),
+		jackpotPools:        make(map[string]int64),
 	}
 }
 
@@ -63,6 +89,16 @@ func (s *WageringService) responseMeta(meta *rgsv1.RequestMeta, code rgsv1.Resul
 	}
 }
 
+// recordSessionActivity forwards wager deltas to session summaries, against
+// the session and gaming day the wager was placed in. It is best-effort:
+// summaries are informational and never block a wager.
+func (s *WageringService) recordSessionActivity(ctx context.Context, w *rgsv1.Wager, wagerDelta, wageredDelta, wonDelta int64) {
+	if s.Sessions == nil || w == nil {
+		return
+	}
+	_ = s.Sessions.RecordWagerActivity(ctx, w.PlayerId, w.GetStake().GetCurrency(), parseTS(w.PlacedAt), wagerDelta, wageredDelta, wonDelta)
+}
+
 func (s *WageringService) nextWagerIDLocked() string {
 	s.nextWagerID++
 	return "wager-" + strconv.FormatInt(time.Now().UTC().UnixNano(), 10) + "-" + strconv.FormatInt(s.nextWagerID, 10)
@@ -103,38 +139,8 @@ func (s *WageringService) useInMemoryWagerMirror() bool {
 }
 
 func (s *WageringService) appendAudit(meta *rgsv1.RequestMeta, objectID, action string, before, after []byte, result audit.Result, reason string) error {
-	if s.AuditStore == nil {
-		return audit.ErrCorruptChain
-	}
-	actorID := "system"
-	actorType := "service"
-	if meta != nil && meta.Actor != nil {
-		actorID = meta.Actor.ActorId
-		actorType = meta.Actor.ActorType.String()
-	}
-	now := s.now()
-	ev := audit.Event{
-		AuditID:      s.nextAuditIDLocked(),
-		OccurredAt:   now,
-		RecordedAt:   now,
-		ActorID:      actorID,
-		ActorType:    actorType,
-		ObjectType:   "wager",
-		ObjectID:     objectID,
-		Action:       action,
-		Before:       before,
-		After:        after,
-		Result:       result,
-		Reason:       reason,
-		PartitionDay: now.Format("2006-01-02"),
-	}
-	if s.dbEnabled() {
-		if err := appendAuditEventToDB(context.Background(), s.db, ev); err != nil {
-			return err
-		}
-	}
-	_, err := s.AuditStore.Append(ev)
-	return err
+	ev := newAuditEvent(meta, s.nextAuditIDLocked(), s.now(), "wager", objectID, action, before, after, result, reason)
+	return appendAuditTo(context.Background(), s.AuditStore, ev)
 }
 
 func (s *WageringService) authorizePlace(ctx context.Context, meta *rgsv1.RequestMeta, playerID string) (bool, string) {
@@ -200,6 +206,14 @@ func cloneCancelResponse(in *rgsv1.CancelWagerResponse) *rgsv1.CancelWagerRespon
 	return cp
 }
 
+func cloneVoidResponse(in *rgsv1.VoidWagerResponse) *rgsv1.VoidWagerResponse {
+	if in == nil {
+		return nil
+	}
+	cp, _ := proto.Clone(in).(*rgsv1.VoidWagerResponse)
+	return cp
+}
+
 func (s *WageringService) PlaceWager(ctx context.Context, req *rgsv1.PlaceWagerRequest) (*rgsv1.PlaceWagerResponse, error) {
 	if req == nil || req.PlayerId == "" || req.GameId == "" || invalidAmount(req.Stake) {
 		return &rgsv1.PlaceWagerResponse{Meta: s.responseMeta(req.GetMeta(), rgsv1.ResultCode_RESULT_CODE_INVALID, "player_id, game_id, and valid stake are required")}, nil
@@ -243,6 +257,43 @@ func (s *WageringService) PlaceWager(ctx context.Context, req *rgsv1.PlaceWagerR
 		}
 	}
 
+	if s.SelfExclusion != nil {
+		excluded, err := s.SelfExclusion.PlayerSelfExcluded(ctx, req.PlayerId)
+		if err != nil {
+			return &rgsv1.PlaceWagerResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "self-exclusion unavailable")}, nil
+		}
+		if excluded {
+			_ = s.appendAudit(req.Meta, "", "place_wager", []byte(`{}`), []byte(`{}`), audit.ResultDenied, selfExcludedReason)
+			return &rgsv1.PlaceWagerResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, selfExcludedReason)}, nil
+		}
+	}
+	pending, err := s.Sessions.realityCheckPending(ctx, req.PlayerId)
+	if err != nil {
+		return &rgsv1.PlaceWagerResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "sessions unavailable")}, nil
+	}
+	if pending {
+		_ = s.appendAudit(req.Meta, "", "place_wager", []byte(`{}`), []byte(`{}`), audit.ResultDenied, realityCheckPendingReason)
+		return &rgsv1.PlaceWagerResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, realityCheckPendingReason)}, nil
+	}
+
+	deviceID := req.Meta.GetSource().GetDeviceId()
+	limitReason, err := s.checkStakeLimits(ctx, req.GameId, deviceID, req.Stake)
+	if err != nil {
+		return &rgsv1.PlaceWagerResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "stake limits unavailable")}, nil
+	}
+	if limitReason != "" {
+		_ = s.appendAudit(req.Meta, "", "place_wager", []byte(`{}`), []byte(`{}`), audit.ResultDenied, limitReason)
+		return &rgsv1.PlaceWagerResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, limitReason)}, nil
+	}
+	limitReason, err = s.checkPlayerLimits(ctx, req.PlayerId, req.Stake)
+	if err != nil {
+		return &rgsv1.PlaceWagerResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "player limits unavailable")}, nil
+	}
+	if limitReason != "" {
+		_ = s.appendAudit(req.Meta, "", "place_wager", []byte(`{}`), []byte(`{}`), audit.ResultDenied, limitReason)
+		return &rgsv1.PlaceWagerResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, limitReason)}, nil
+	}
+
 	now := s.now().Format(time.RFC3339Nano)
 	wager := &rgsv1.Wager{
 		WagerId:    s.nextWagerIDLocked(),
@@ -252,6 +303,26 @@ func (s *WageringService) PlaceWager(ctx context.Context, req *rgsv1.PlaceWagerR
 		Status:     rgsv1.WagerStatus_WAGER_STATUS_PENDING,
 		PlacedAt:   now,
 		OutcomeRef: "",
+		DeviceId:   deviceID,
+	}
+	var stakeDebit *wagerLedgerMutation
+	if s.ledgerIntegration {
+		if s.Ledger == nil {
+			err = errWagerLedgerUnavailable
+		} else {
+			stakeDebit, err = s.Ledger.prepareWagerStakeDebit(ctx, req.Meta, wager.WagerId, wager.PlayerId, wager.Stake)
+		}
+		if err != nil {
+			code, reason := wagerLedgerFailure(err)
+			if code == rgsv1.ResultCode_RESULT_CODE_DENIED {
+				_ = s.appendAudit(req.Meta, wager.WagerId, "place_wager", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+			}
+			return &rgsv1.PlaceWagerResponse{Meta: s.responseMeta(req.Meta, code, reason)}, nil
+		}
+		wager.StakeTransactionId = stakeDebit.tx.TransactionId
+	}
+	if err := s.persistWagerWithLedger(ctx, wager, "wager.placed", stakeDebit); err != nil {
+		return &rgsv1.PlaceWagerResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, wagerPersistFailure(err))}, nil
 	}
 	if s.useInMemoryWagerMirror() {
 		s.wagers[wager.WagerId] = wager
@@ -264,9 +335,6 @@ func (s *WageringService) PlaceWager(ctx context.Context, req *rgsv1.PlaceWagerR
 	if s.useInMemoryCache() {
 		s.placeByIdempotency[idemKey] = clonePlaceResponse(resp)
 	}
-	if err := s.persistWager(ctx, wager); err != nil {
-		return &rgsv1.PlaceWagerResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
-	}
 	if err := s.persistIdempotencyResponse(ctx, "place", req.PlayerId, idem, requestHash, resp); err != nil {
 		return &rgsv1.PlaceWagerResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
 	}
@@ -274,6 +342,7 @@ func (s *WageringService) PlaceWager(ctx context.Context, req *rgsv1.PlaceWagerR
 	if err := s.appendAudit(req.Meta, wager.WagerId, "place_wager", []byte(`{}`), after, audit.ResultSuccess, ""); err != nil {
 		return &rgsv1.PlaceWagerResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
 	}
+	s.recordSessionActivity(ctx, wager, 1, wager.GetStake().GetAmountMinor(), 0)
 	return resp, nil
 }
 
@@ -340,28 +409,72 @@ func (s *WageringService) SettleWager(ctx context.Context, req *rgsv1.SettleWage
 	if wager.Status != rgsv1.WagerStatus_WAGER_STATUS_PENDING {
 		return &rgsv1.SettleWagerResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "wager is not pending")}, nil
 	}
+	if err := money.SameCurrency(wager.GetStake(), req.Payout); err != nil {
+		return &rgsv1.SettleWagerResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "payout currency must match stake")}, nil
+	}
+	jackpot, err := s.prepareJackpotIncrement(ctx, wager)
+	if err != nil {
+		return &rgsv1.SettleWagerResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "jackpot settings unavailable")}, nil
+	}
+	var payoutCredit *wagerLedgerMutation
+	if s.ledgerIntegration {
+		if s.Ledger == nil {
+			err = errWagerLedgerUnavailable
+		} else {
+			payoutCredit, err = s.Ledger.prepareWagerPayoutCredit(ctx, req.Meta, wager.WagerId, wager.PlayerId, req.Payout)
+		}
+		if err != nil {
+			code, reason := wagerLedgerFailure(err)
+			return &rgsv1.SettleWagerResponse{Meta: s.responseMeta(req.Meta, code, reason)}, nil
+		}
+	}
 	before, _ := json.Marshal(wager)
+	settledAt := s.now()
+	// The mirror keeps the pending wager until the settlement persists.
+	wager = cloneWager(wager)
 	wager.Status = rgsv1.WagerStatus_WAGER_STATUS_SETTLED
 	wager.Payout = req.Payout
 	wager.OutcomeRef = req.OutcomeRef
-	wager.SettledAt = s.now().Format(time.RFC3339Nano)
+	wager.SettledAt = settledAt.Format(time.RFC3339Nano)
+	if payoutCredit != nil {
+		wager.PayoutTransactionId = payoutCredit.tx.TransactionId
+	}
 	after, _ := json.Marshal(wager)
 	resp := &rgsv1.SettleWagerResponse{
 		Meta:  s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
 		Wager: cloneWager(wager),
 	}
+	var applyJackpot func(*sql.Tx) error
+	if jackpot != nil {
+		applyJackpot = func(dbtx *sql.Tx) error {
+			return applyJackpotIncrementTx(ctx, dbtx, jackpot, settledAt)
+		}
+	}
+	if err := s.persistWagerWith(ctx, wager, "wager.settled", payoutCredit, applyJackpot); err != nil {
+		return &rgsv1.SettleWagerResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, wagerPersistFailure(err))}, nil
+	}
+	if s.useInMemoryWagerMirror() {
+		s.wagers[wager.WagerId] = cloneWager(wager)
+	}
+	if jackpot != nil {
+		if !s.dbEnabled() {
+			s.applyJackpotIncrementLocked(jackpot)
+		}
+		resp.JackpotContribution = &rgsv1.Money{AmountMinor: jackpot.amount, Currency: jackpot.currency}
+		resp.JackpotPool = &rgsv1.Money{AmountMinor: jackpot.pool, Currency: jackpot.currency}
+	}
 	if s.useInMemoryCache() {
 		s.settleByIdempotency[idemKey] = cloneSettleResponse(resp)
 	}
-	if err := s.persistWager(ctx, wager); err != nil {
-		return &rgsv1.SettleWagerResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
-	}
 	if err := s.persistIdempotencyResponse(ctx, "settle", req.WagerId, idem, requestHash, resp); err != nil {
 		return &rgsv1.SettleWagerResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
 	}
 	if err := s.appendAudit(req.Meta, req.WagerId, "settle_wager", before, after, audit.ResultSuccess, ""); err != nil {
 		return &rgsv1.SettleWagerResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
 	}
+	s.observeSettlementLocked(wager, "settled", settledAt)
+	s.recordSessionActivity(ctx, wager, 0, 0, wager.GetPayout().GetAmountMinor())
+	s.publishResult(ctx, wager)
 	return resp, nil
 }
 
@@ -428,26 +541,43 @@ func (s *WageringService) CancelWager(ctx context.Context, req *rgsv1.CancelWage
 	if wager.Status != rgsv1.WagerStatus_WAGER_STATUS_PENDING {
 		return &rgsv1.CancelWagerResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "wager is not pending")}, nil
 	}
+	refund, err := s.prepareStakeRefundLocked(ctx, req.Meta, wager)
+	if err != nil {
+		code, reason := wagerLedgerFailure(err)
+		return &rgsv1.CancelWagerResponse{Meta: s.responseMeta(req.Meta, code, reason)}, nil
+	}
 	before, _ := json.Marshal(wager)
+	canceledAt := s.now()
+	// The mirror keeps the pending wager until the cancellation persists.
+	wager = cloneWager(wager)
 	wager.Status = rgsv1.WagerStatus_WAGER_STATUS_CANCELED
 	wager.CancelReason = req.Reason
-	wager.CanceledAt = s.now().Format(time.RFC3339Nano)
+	wager.CanceledAt = canceledAt.Format(time.RFC3339Nano)
+	if refund != nil {
+		wager.RefundTransactionId = refund.tx.TransactionId
+	}
 	after, _ := json.Marshal(wager)
 	resp := &rgsv1.CancelWagerResponse{
 		Meta:  s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
 		Wager: cloneWager(wager),
 	}
+	if err := s.persistWagerWithLedger(ctx, wager, "wager.canceled", refund); err != nil {
+		return &rgsv1.CancelWagerResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, wagerPersistFailure(err))}, nil
+	}
+	if s.useInMemoryWagerMirror() {
+		s.wagers[wager.WagerId] = cloneWager(wager)
+	}
 	if s.useInMemoryCache() {
 		s.cancelByIdempotency[idemKey] = cloneCancelResponse(resp)
 	}
-	if err := s.persistWager(ctx, wager); err != nil {
-		return &rgsv1.CancelWagerResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
-	}
 	if err := s.persistIdempotencyResponse(ctx, "cancel", req.WagerId, idem, requestHash, resp); err != nil {
 		return &rgsv1.CancelWagerResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
 	}
 	if err := s.appendAudit(req.Meta, req.WagerId, "cancel_wager", before, after, audit.ResultSuccess, ""); err != nil {
 		return &rgsv1.CancelWagerResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
 	}
+	s.observeSettlementLocked(wager, "canceled", canceledAt)
+	s.recordSessionActivity(ctx, wager, -1, -wager.GetStake().GetAmountMinor(), 0)
+	s.publishResult(ctx, wager)
 	return resp, nil
 }
@@ -130,7 +130,7 @@ func TestWageringActorMismatchDenied(t *testing.T) {
 	if place.Meta.GetDenialReason() != "actor mismatch with token" {
 		t.Fatalf("expected actor mismatch denial on place, got=%q", place.Meta.GetDenialReason())
 	}
-	events := svc.AuditStore.Events()
+	events := auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "place_wager" || events[len(events)-1].Reason != "actor mismatch with token" {
 		t.Fatalf("expected denied place audit for actor mismatch, got=%v", events)
 	}
@@ -174,8 +174,35 @@ func TestWageringActorMismatchDenied(t *testing.T) {
 	if cancel.Meta.GetDenialReason() != "actor mismatch with token" {
 		t.Fatalf("expected actor mismatch denial on cancel, got=%q", cancel.Meta.GetDenialReason())
 	}
-	events = svc.AuditStore.Events()
+	events = auditEvents(svc.AuditStore)
 	if len(events) == 0 || events[len(events)-1].Action != "cancel_wager" || events[len(events)-1].Reason != "actor mismatch with token" {
 		t.Fatalf("expected denied cancel audit for actor mismatch, got=%v", events)
 	}
 }
+
+func TestWageringSettleRejectsPayoutCurrencyMismatch(t *testing.T) {
+	svc := NewWageringService(ledgerFixedClock{now: time.Date(2026, 2, 15, 10, 0, 0, 0, time.UTC)})
+	ctx := context.Background()
+
+	placed, err := svc.PlaceWager(ctx, &rgsv1.PlaceWagerRequest{
+		Meta:     meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "idem-wager-ccy-place"),
+		PlayerId: "player-1",
+		GameId:   "game-1",
+		Stake:    &rgsv1.Money{AmountMinor: 250, Currency: "USD"},
+	})
+	if err != nil {
+		t.Fatalf("place wager err: %v", err)
+	}
+	settled, err := svc.SettleWager(ctx, &rgsv1.SettleWagerRequest{
+		Meta:       meta("svc-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, "idem-wager-ccy-settle"),
+		WagerId:    placed.Wager.GetWagerId(),
+		Payout:     &rgsv1.Money{AmountMinor: 400, Currency: "EUR"},
+		OutcomeRef: "outcome-ccy",
+	})
+	if err != nil {
+		t.Fatalf("settle wager err: %v", err)
+	}
+	if settled.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID || settled.Meta.GetDenialReason() != "payout currency must match stake" {
+		t.Fatalf("expected currency mismatch rejected, got=%v reason=%q", settled.Meta.GetResultCode(), settled.Meta.GetDenialReason())
+	}
+}
@@ -0,0 +1,88 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+// progressiveContributionKey is the WageringConfigNamespace key holding the
+// share of each settled stake on gameID added to the game's progressive
+// pool, in basis points.
+func progressiveContributionKey(gameID string) string {
+	return "game/" + gameID + "/progressive_contribution_bps"
+}
+
+func jackpotPoolKey(gameID, currency string) string {
+	return gameID + "|" + currency
+}
+
+// jackpotIncrement is a progressive contribution applied with a settlement.
+type jackpotIncrement struct {
+	wagerID  string
+	gameID   string
+	currency string
+	bps      int64
+	amount   int64
+	// pool is the pool after the increment, set once it is applied.
+	pool int64
+}
+
+// prepareJackpotIncrement returns the contribution a settling wager makes to
+// its game's progressive pool, or nil when the game has none configured.
+func (s *WageringService) prepareJackpotIncrement(ctx context.Context, w *rgsv1.Wager) (*jackpotIncrement, error) {
+	if s.Settings == nil {
+		return nil, nil
+	}
+	v, ok, err := s.Settings.WageringSetting(ctx, progressiveContributionKey(w.GameId))
+	if err != nil || !ok {
+		return nil, err
+	}
+	bps, ok := parseBasisPoints(v)
+	if !ok {
+		return nil, nil
+	}
+	return &jackpotIncrement{
+		wagerID:  w.WagerId,
+		gameID:   w.GameId,
+		currency: w.Stake.GetCurrency(),
+		bps:      bps,
+		amount:   w.Stake.GetAmountMinor() * bps / 10000,
+	}, nil
+}
+
+// applyJackpotIncrementLocked adds inc to the in-memory pool. It is only
+// used when wagers are not DB-backed; otherwise applyJackpotIncrementTx
+// updates the pool with the wager write.
+func (s *WageringService) applyJackpotIncrementLocked(inc *jackpotIncrement) {
+	k := jackpotPoolKey(inc.gameID, inc.currency)
+	s.jackpotPools[k] += inc.amount
+	inc.pool = s.jackpotPools[k]
+}
+
+// applyJackpotIncrementTx adds inc to the pool inside the settlement's
+// transaction. The contribution row is keyed by wager, so a wager can only
+// ever feed the pool once.
+func applyJackpotIncrementTx(ctx context.Context, dbtx *sql.Tx, inc *jackpotIncrement, at time.Time) error {
+	const poolQ = `
+INSERT INTO jackpot_pools (game_id, currency_code, amount_minor, updated_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (game_id, currency_code) DO UPDATE SET
+  amount_minor = jackpot_pools.amount_minor + EXCLUDED.amount_minor,
+  updated_at = EXCLUDED.updated_at
+RETURNING amount_minor
+`
+	if err := dbtx.QueryRowContext(ctx, poolQ, inc.gameID, inc.currency, inc.amount, at).Scan(&inc.pool); err != nil {
+		return err
+	}
+	const contribQ = `
+INSERT INTO jackpot_contributions (
+  wager_id, game_id, currency_code, contribution_bps, amount_minor, pool_after_minor, contributed_at
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+`
+	_, err := dbtx.ExecContext(ctx, contribQ, inc.wagerID, inc.gameID, inc.currency, inc.bps, inc.amount, inc.pool, at)
+	return err
+}
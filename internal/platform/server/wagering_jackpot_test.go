@@ -0,0 +1,84 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func settleTestWager(t *testing.T, svc *WageringService, wagerID, idem string) *rgsv1.SettleWagerResponse {
+	t.Helper()
+	resp, err := svc.SettleWager(context.Background(), &rgsv1.SettleWagerRequest{
+		Meta:       meta("svc-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, idem),
+		WagerId:    wagerID,
+		Payout:     &rgsv1.Money{AmountMinor: 50, Currency: "USD"},
+		OutcomeRef: "outcome-" + wagerID,
+	})
+	if err != nil || resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("settle wager failed: err=%v meta=%+v", err, resp.GetMeta())
+	}
+	return resp
+}
+
+func TestWageringProgressiveContributionOnSettlement(t *testing.T) {
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 16, 10, 0, 0, 0, time.UTC)}
+	cfg := NewConfigService(clk)
+	applyConfigValue(t, cfg, WageringConfigNamespace, progressiveContributionKey("game-1"), "150")
+	svc := NewWageringService(clk)
+	svc.Settings = cfg
+
+	first := placeTestWager(t, svc, "player-1", "jp-place-1")
+	second := placeTestWager(t, svc, "player-2", "jp-place-2")
+
+	resp := settleTestWager(t, svc, first.WagerId, "jp-settle-1")
+	if resp.JackpotContribution.GetAmountMinor() != 1 || resp.JackpotPool.GetAmountMinor() != 1 || resp.JackpotPool.GetCurrency() != "USD" {
+		t.Fatalf("expected 1.5%% of 100 truncated to 1 in the pool, got contribution=%+v pool=%+v", resp.JackpotContribution, resp.JackpotPool)
+	}
+	replay := settleTestWager(t, svc, first.WagerId, "jp-settle-1")
+	if replay.JackpotPool.GetAmountMinor() != 1 {
+		t.Fatalf("expected replay to report the original pool, got=%+v", replay.JackpotPool)
+	}
+
+	// A larger contribution rate applies from the next settlement on.
+	applyConfigValue(t, cfg, WageringConfigNamespace, progressiveContributionKey("game-1"), "500")
+	resp = settleTestWager(t, svc, second.WagerId, "jp-settle-2")
+	if resp.JackpotContribution.GetAmountMinor() != 5 || resp.JackpotPool.GetAmountMinor() != 6 {
+		t.Fatalf("expected pool to grow to 6, got contribution=%+v pool=%+v", resp.JackpotContribution, resp.JackpotPool)
+	}
+}
+
+func TestWageringSettlementWithoutProgressive(t *testing.T) {
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 16, 10, 0, 0, 0, time.UTC)}
+	cfg := NewConfigService(clk)
+	applyConfigValue(t, cfg, WageringConfigNamespace, progressiveContributionKey("other-game"), "150")
+	svc := NewWageringService(clk)
+	svc.Settings = cfg
+
+	w := placeTestWager(t, svc, "player-1", "jp-none-place")
+	resp := settleTestWager(t, svc, w.WagerId, "jp-none-settle")
+	if resp.JackpotContribution != nil || resp.JackpotPool != nil {
+		t.Fatalf("expected no jackpot for game-1, got contribution=%+v pool=%+v", resp.JackpotContribution, resp.JackpotPool)
+	}
+}
+
+func TestConfigRejectsInvalidProgressiveContribution(t *testing.T) {
+	cfg := NewConfigService(ledgerFixedClock{now: time.Date(2026, 2, 16, 10, 0, 0, 0, time.UTC)})
+	for _, tc := range []struct{ key, value string }{
+		{"game/game-1/progressive_contribution_bps", "0"},
+		{"game/game-1/progressive_contribution_bps", "1.5"},
+		{"progressive_contribution_bps", "150"},
+	} {
+		resp, _ := cfg.ProposeConfigChange(context.Background(), &rgsv1.ProposeConfigChangeRequest{
+			Meta:            meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+			ConfigNamespace: WageringConfigNamespace,
+			ConfigKey:       tc.key,
+			ProposedValue:   tc.value,
+			Reason:          "progressive",
+		})
+		if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+			t.Fatalf("expected %s=%s rejected, got=%v", tc.key, tc.value, resp.Meta.GetResultCode())
+		}
+	}
+}
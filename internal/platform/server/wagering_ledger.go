@@ -0,0 +1,123 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+var (
+	errWagerLedgerUnavailable = errors.New("ledger unavailable")
+	errWagerLedgerAudit       = errors.New("ledger audit unavailable")
+)
+
+// SetLedgerIntegration makes PlaceWager debit the stake from the player's
+// cashless balance and SettleWager credit the payout, with the ledger
+// postings written in the same DB transaction as the wager. Cancellations
+// and auto-voids of wagers whose stake was debited refund it the same way.
+// Requires Ledger to be set.
+func (s *WageringService) SetLedgerIntegration(enabled bool) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ledgerIntegration = enabled
+}
+
+// prepareStakeRefundLocked prepares the refund for a wager whose stake was
+// debited through the ledger; it returns nil for wagers placed without
+// ledger integration.
+func (s *WageringService) prepareStakeRefundLocked(ctx context.Context, meta *rgsv1.RequestMeta, w *rgsv1.Wager) (*wagerLedgerMutation, error) {
+	if w.StakeTransactionId == "" {
+		return nil, nil
+	}
+	if s.Ledger == nil {
+		return nil, errWagerLedgerUnavailable
+	}
+	return s.Ledger.prepareWagerStakeRefund(ctx, meta, w.WagerId, w.PlayerId, w.Stake)
+}
+
+// persistWagerWithLedger writes the wager and the prepared ledger mutation,
+// if any, in one DB transaction, then publishes the mutation to the ledger.
+// The mutation is released on every path.
+func (s *WageringService) persistWagerWithLedger(ctx context.Context, w *rgsv1.Wager, eventType string, m *wagerLedgerMutation) error {
+	return s.persistWagerWith(ctx, w, eventType, m, nil)
+}
+
+// persistWagerWith is persistWagerWithLedger with an extra write, run in the
+// same DB transaction as the wager when wagers are DB-backed.
+func (s *WageringService) persistWagerWith(ctx context.Context, w *rgsv1.Wager, eventType string, m *wagerLedgerMutation, extra func(*sql.Tx) error) error {
+	if m == nil && (extra == nil || !s.dbEnabled()) {
+		return s.persistWager(ctx, w, eventType)
+	}
+	var err error
+	switch {
+	case s.dbEnabled():
+		err = s.persistWagerAndLedgerTx(ctx, w, eventType, m, extra)
+	case m.ledger.dbEnabled():
+		err = m.persist(ctx)
+	}
+	if err != nil {
+		if m != nil {
+			m.abort()
+		}
+		return err
+	}
+	if m == nil {
+		return nil
+	}
+	if _, err := m.finish(); err != nil {
+		return errWagerLedgerAudit
+	}
+	return nil
+}
+
+func (s *WageringService) persistWagerAndLedgerTx(ctx context.Context, w *rgsv1.Wager, eventType string, m *wagerLedgerMutation, extra func(*sql.Tx) error) error {
+	dbtx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = dbtx.Rollback()
+	}()
+	if m != nil {
+		if err := m.persistTx(ctx, dbtx); err != nil {
+			return err
+		}
+	}
+	if err := s.persistWagerTx(ctx, dbtx, w, eventType); err != nil {
+		return err
+	}
+	if extra != nil {
+		if err := extra(dbtx); err != nil {
+			return err
+		}
+	}
+	return dbtx.Commit()
+}
+
+// wagerLedgerFailure maps a ledger preparation error to a result code and
+// denial reason.
+func wagerLedgerFailure(err error) (rgsv1.ResultCode, string) {
+	switch {
+	case errors.Is(err, errWagerInsufficientBalance):
+		return rgsv1.ResultCode_RESULT_CODE_DENIED, "insufficient balance"
+	case errors.Is(err, errWagerCurrencyMismatch):
+		return rgsv1.ResultCode_RESULT_CODE_INVALID, "wager currency does not match player account"
+	case errors.Is(err, errWagerLedgerUnavailable):
+		return rgsv1.ResultCode_RESULT_CODE_ERROR, "ledger unavailable"
+	default:
+		return rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable"
+	}
+}
+
+// wagerPersistFailure returns the denial reason for a failed wager write.
+func wagerPersistFailure(err error) string {
+	if errors.Is(err, errWagerLedgerAudit) {
+		return "audit unavailable"
+	}
+	return "persistence unavailable"
+}
@@ -0,0 +1,210 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func newLedgerIntegratedWagering(t *testing.T, playerID string, deposit int64) (*WageringService, *LedgerService) {
+	t.Helper()
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 23, 10, 0, 0, 0, time.UTC)}
+	ledger := NewLedgerService(clk)
+	if deposit > 0 {
+		resp, _ := ledger.Deposit(context.Background(), &rgsv1.DepositRequest{
+			Meta:      meta(playerID, rgsv1.ActorType_ACTOR_TYPE_PLAYER, "seed-"+playerID),
+			AccountId: playerID,
+			Amount:    &rgsv1.Money{AmountMinor: deposit, Currency: "USD"},
+		})
+		if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("seed deposit failed: %+v", resp.Meta)
+		}
+	}
+	svc := NewWageringService(clk)
+	svc.Ledger = ledger
+	svc.SetLedgerIntegration(true)
+	return svc, ledger
+}
+
+func ledgerAvailable(t *testing.T, ledger *LedgerService, accountID string) int64 {
+	t.Helper()
+	available, _, _, _ := ledger.accountBalance(accountID)
+	return available
+}
+
+func TestWageringLedgerIntegrationDebitsStakeAndCreditsPayout(t *testing.T) {
+	svc, ledger := newLedgerIntegratedWagering(t, "player-li", 1000)
+	ctx := context.Background()
+
+	placed, _ := svc.PlaceWager(ctx, &rgsv1.PlaceWagerRequest{
+		Meta:     meta("player-li", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "li-place"),
+		PlayerId: "player-li",
+		GameId:   "game-1",
+		Stake:    &rgsv1.Money{AmountMinor: 300, Currency: "USD"},
+	})
+	if placed.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || placed.Wager.GetStakeTransactionId() == "" {
+		t.Fatalf("expected placed wager with stake transaction, got=%+v", placed)
+	}
+	if got := ledgerAvailable(t, ledger, "player-li"); got != 700 {
+		t.Fatalf("expected stake debited, balance=%d", got)
+	}
+
+	settled, _ := svc.SettleWager(ctx, &rgsv1.SettleWagerRequest{
+		Meta:       meta("svc-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, "li-settle"),
+		WagerId:    placed.Wager.WagerId,
+		Payout:     &rgsv1.Money{AmountMinor: 500, Currency: "USD"},
+		OutcomeRef: "outcome-li",
+	})
+	if settled.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || settled.Wager.GetPayoutTransactionId() == "" {
+		t.Fatalf("expected settled wager with payout transaction, got=%+v", settled)
+	}
+	if got := ledgerAvailable(t, ledger, "player-li"); got != 1200 {
+		t.Fatalf("expected payout credited, balance=%d", got)
+	}
+
+	ledger.mu.Lock()
+	stakePostings := ledger.postingsByTx[placed.Wager.StakeTransactionId]
+	payoutPostings := ledger.postingsByTx[settled.Wager.PayoutTransactionId]
+	ledger.mu.Unlock()
+	if len(stakePostings) != 2 || !isBalanced(stakePostings) || len(payoutPostings) != 2 || !isBalanced(payoutPostings) {
+		t.Fatalf("expected balanced stake and payout postings, stake=%+v payout=%+v", stakePostings, payoutPostings)
+	}
+
+	replay, _ := svc.PlaceWager(ctx, &rgsv1.PlaceWagerRequest{
+		Meta:     meta("player-li", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "li-place"),
+		PlayerId: "player-li",
+		GameId:   "game-1",
+		Stake:    &rgsv1.Money{AmountMinor: 300, Currency: "USD"},
+	})
+	if replay.Wager.GetWagerId() != placed.Wager.WagerId || ledgerAvailable(t, ledger, "player-li") != 1200 {
+		t.Fatalf("expected idempotent replay without a second debit, got=%+v", replay)
+	}
+}
+
+func TestWageringLedgerIntegrationRejectsInsufficientBalance(t *testing.T) {
+	svc, ledger := newLedgerIntegratedWagering(t, "player-poor", 100)
+	resp, _ := svc.PlaceWager(context.Background(), &rgsv1.PlaceWagerRequest{
+		Meta:     meta("player-poor", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "poor-place"),
+		PlayerId: "player-poor",
+		GameId:   "game-1",
+		Stake:    &rgsv1.Money{AmountMinor: 250, Currency: "USD"},
+	})
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED || resp.Meta.GetDenialReason() != "insufficient balance" {
+		t.Fatalf("expected insufficient balance denial, got=%+v", resp.Meta)
+	}
+	if len(svc.wagers) != 0 || ledgerAvailable(t, ledger, "player-poor") != 100 {
+		t.Fatalf("expected no wager and untouched balance, wagers=%d", len(svc.wagers))
+	}
+
+	svc.Ledger = nil
+	resp, _ = svc.PlaceWager(context.Background(), &rgsv1.PlaceWagerRequest{
+		Meta:     meta("player-poor", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "poor-place-2"),
+		PlayerId: "player-poor",
+		GameId:   "game-1",
+		Stake:    &rgsv1.Money{AmountMinor: 50, Currency: "USD"},
+	})
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_ERROR || resp.Meta.GetDenialReason() != "ledger unavailable" {
+		t.Fatalf("expected ledger unavailable error, got=%+v", resp.Meta)
+	}
+}
+
+func TestWageringLedgerIntegrationRefundsCanceledAndAutoVoidedStakes(t *testing.T) {
+	svc, ledger := newLedgerIntegratedWagering(t, "player-rf", 1000)
+	ctx := context.Background()
+	place := func(idem string) *rgsv1.Wager {
+		resp, _ := svc.PlaceWager(ctx, &rgsv1.PlaceWagerRequest{
+			Meta:     meta("player-rf", rgsv1.ActorType_ACTOR_TYPE_PLAYER, idem),
+			PlayerId: "player-rf",
+			GameId:   "game-1",
+			Stake:    &rgsv1.Money{AmountMinor: 200, Currency: "USD"},
+		})
+		if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("place failed: %+v", resp.Meta)
+		}
+		return resp.Wager
+	}
+
+	first := place("rf-1")
+	canceled, _ := svc.CancelWager(ctx, &rgsv1.CancelWagerRequest{
+		Meta:    meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "rf-cancel"),
+		WagerId: first.WagerId,
+		Reason:  "player request",
+	})
+	if canceled.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || canceled.Wager.GetRefundTransactionId() == "" {
+		t.Fatalf("expected cancel with refund, got=%+v", canceled)
+	}
+	if got := ledgerAvailable(t, ledger, "player-rf"); got != 1000 {
+		t.Fatalf("expected stake refunded on cancel, balance=%d", got)
+	}
+
+	svc.SetSettlementPolicy(WagerSettlementPolicy{SLA: time.Minute, AutoVoidAfter: time.Hour})
+	second := place("rf-2")
+	svc.Clock = ledgerFixedClock{now: time.Date(2026, 2, 23, 12, 0, 0, 0, time.UTC)}
+	if _, voided, err := svc.SweepOverdueWagers(ctx); err != nil || voided != 1 {
+		t.Fatalf("expected one auto-void, voided=%d err=%v", voided, err)
+	}
+	if got := ledgerAvailable(t, ledger, "player-rf"); got != 1000 {
+		t.Fatalf("expected stake refunded on auto-void, balance=%d", got)
+	}
+	if w := svc.wagers[second.WagerId]; w.GetRefundTransactionId() == "" {
+		t.Fatalf("expected auto-voided wager to reference its refund, got=%+v", w)
+	}
+}
+
+func TestWageringLedgerIntegrationKeepsWagerPendingWhenSettlementFails(t *testing.T) {
+	svc, ledger := newLedgerIntegratedWagering(t, "player-lf", 1000)
+	ctx := context.Background()
+	placed, _ := svc.PlaceWager(ctx, &rgsv1.PlaceWagerRequest{
+		Meta:     meta("player-lf", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "lf-place"),
+		PlayerId: "player-lf",
+		GameId:   "game-1",
+		Stake:    &rgsv1.Money{AmountMinor: 300, Currency: "USD"},
+	})
+	if placed.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected placed wager, got=%+v", placed.Meta)
+	}
+
+	store := ledger.AuditStore
+	ledger.AuditStore = nil
+	settled, _ := svc.SettleWager(ctx, &rgsv1.SettleWagerRequest{
+		Meta:       meta("svc-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, "lf-settle"),
+		WagerId:    placed.Wager.WagerId,
+		Payout:     &rgsv1.Money{AmountMinor: 500, Currency: "USD"},
+		OutcomeRef: "outcome-lf",
+	})
+	if settled.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_ERROR || settled.Meta.GetDenialReason() != "audit unavailable" {
+		t.Fatalf("expected settlement to fail without a ledger audit, got=%+v", settled.Meta)
+	}
+	canceled, _ := svc.CancelWager(ctx, &rgsv1.CancelWagerRequest{
+		Meta:    meta("svc-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, "lf-cancel"),
+		WagerId: placed.Wager.WagerId,
+		Reason:  "game fault",
+	})
+	if canceled.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_ERROR {
+		t.Fatalf("expected cancellation to fail without a ledger audit, got=%+v", canceled.Meta)
+	}
+	svc.mu.Lock()
+	mirrored := cloneWager(svc.wagers[placed.Wager.WagerId])
+	svc.mu.Unlock()
+	if mirrored.GetStatus() != rgsv1.WagerStatus_WAGER_STATUS_PENDING || mirrored.GetPayout() != nil {
+		t.Fatalf("expected the wager to stay pending after failed writes, got=%+v", mirrored)
+	}
+	if balance := ledgerAvailable(t, ledger, "player-lf"); balance != 700 {
+		t.Fatalf("expected no payout or refund, balance=%d", balance)
+	}
+
+	ledger.AuditStore = store
+	canceled, _ = svc.CancelWager(ctx, &rgsv1.CancelWagerRequest{
+		Meta:    meta("svc-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, "lf-cancel"),
+		WagerId: placed.Wager.WagerId,
+		Reason:  "game fault",
+	})
+	if canceled.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || canceled.Wager.GetStatus() != rgsv1.WagerStatus_WAGER_STATUS_CANCELED {
+		t.Fatalf("expected a retried cancellation to succeed, got=%+v", canceled)
+	}
+	if balance := ledgerAvailable(t, ledger, "player-lf"); balance != 1000 {
+		t.Fatalf("expected the stake refunded, balance=%d", balance)
+	}
+}
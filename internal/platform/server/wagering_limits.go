@@ -0,0 +1,130 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/money"
+)
+
+// WageringSettingsSource resolves applied wagering settings. ConfigService
+// implements it over the change-controlled WageringConfigNamespace.
+type WageringSettingsSource interface {
+	WageringSetting(ctx context.Context, key string) (value string, ok bool, err error)
+}
+
+type stakeLimitKey struct {
+	scope    string // "", "game", or "equipment"
+	scopeID  string
+	bound    string // "min_stake" or "max_stake"
+	currency string
+}
+
+func (k stakeLimitKey) String() string {
+	if k.scope == "" {
+		return k.bound + "/" + k.currency
+	}
+	return k.scope + "/" + k.scopeID + "/" + k.bound + "/" + k.currency
+}
+
+func parseStakeLimitKey(key string) (stakeLimitKey, bool) {
+	parts := strings.Split(key, "/")
+	var k stakeLimitKey
+	switch len(parts) {
+	case 2:
+		k.bound, k.currency = parts[0], parts[1]
+	case 4:
+		k.scope, k.scopeID, k.bound, k.currency = parts[0], parts[1], parts[2], parts[3]
+		if (k.scope != "game" && k.scope != "equipment") || k.scopeID == "" {
+			return stakeLimitKey{}, false
+		}
+	default:
+		return stakeLimitKey{}, false
+	}
+	if (k.bound != "min_stake" && k.bound != "max_stake") || !money.ValidCurrency(k.currency) {
+		return stakeLimitKey{}, false
+	}
+	return k, true
+}
+
+// stakeLimit returns the bound that applies to a stake in currency on gameID
+// from equipmentID, in minor units, or 0 when none is configured. The most
+// specific scope wins: equipment, then game, then the jurisdiction default.
+func (s *WageringService) stakeLimit(ctx context.Context, bound, gameID, equipmentID, currency string) (int64, error) {
+	if s.Settings == nil {
+		return 0, nil
+	}
+	keys := make([]stakeLimitKey, 0, 3)
+	if equipmentID != "" {
+		keys = append(keys, stakeLimitKey{scope: "equipment", scopeID: equipmentID, bound: bound, currency: currency})
+	}
+	keys = append(keys,
+		stakeLimitKey{scope: "game", scopeID: gameID, bound: bound, currency: currency},
+		stakeLimitKey{bound: bound, currency: currency},
+	)
+	for _, k := range keys {
+		v, ok, err := s.Settings.WageringSetting(ctx, k.String())
+		if err != nil {
+			return 0, err
+		}
+		if !ok {
+			continue
+		}
+		m, err := money.Parse(v + " " + currency)
+		if err != nil {
+			return 0, err
+		}
+		return m.AmountMinor, nil
+	}
+	return 0, nil
+}
+
+// checkStakeLimits returns the denial reason for a stake outside the limits
+// configured for its game and equipment, or "" when it is allowed.
+func (s *WageringService) checkStakeLimits(ctx context.Context, gameID, equipmentID string, stake *rgsv1.Money) (string, error) {
+	maxStake, err := s.stakeLimit(ctx, "max_stake", gameID, equipmentID, stake.GetCurrency())
+	if err != nil {
+		return "", err
+	}
+	if maxStake > 0 && stake.GetAmountMinor() > maxStake {
+		return "stake exceeds limit", nil
+	}
+	minStake, err := s.stakeLimit(ctx, "min_stake", gameID, equipmentID, stake.GetCurrency())
+	if err != nil {
+		return "", err
+	}
+	if minStake > 0 && stake.GetAmountMinor() < minStake {
+		return "stake below minimum", nil
+	}
+	return "", nil
+}
+
+// checkPlayerLimits returns the denial reason for a stake that would take
+// the player over a wager limit, or over a loss limit should it be lost, or
+// "" when it is allowed. Usage comes from the session activity rollup.
+func (s *WageringService) checkPlayerLimits(ctx context.Context, playerID string, stake *rgsv1.Money) (string, error) {
+	if s.PlayerLimits == nil {
+		return "", nil
+	}
+	for _, limitType := range []rgsv1.PlayerLimitType{rgsv1.PlayerLimitType_PLAYER_LIMIT_TYPE_WAGER, rgsv1.PlayerLimitType_PLAYER_LIMIT_TYPE_LOSS} {
+		reason, err := checkPlayerLimits(ctx, s.PlayerLimits, playerID, limitType, stake, s.now(), func(fromDay string, _ time.Time) (int64, error) {
+			activity, err := s.Sessions.playerActivitySince(ctx, playerID, fromDay)
+			if err != nil {
+				return 0, err
+			}
+			if activity.currency != "" && activity.currency != stake.GetCurrency() {
+				return 0, errPlayerLimitCurrency
+			}
+			if limitType == rgsv1.PlayerLimitType_PLAYER_LIMIT_TYPE_LOSS {
+				return activity.wageredMinor - activity.wonMinor, nil
+			}
+			return activity.wageredMinor, nil
+		})
+		if err != nil || reason != "" {
+			return reason, err
+		}
+	}
+	return "", nil
+}
@@ -0,0 +1,70 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func TestWageringStakeLimitsByGameAndEquipment(t *testing.T) {
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 23, 10, 0, 0, 0, time.UTC)}
+	cfg := NewConfigService(clk)
+	svc := NewWageringService(clk)
+	svc.Settings = cfg
+	applyConfigValue(t, cfg, WageringConfigNamespace, "max_stake/USD", "100.00")
+	applyConfigValue(t, cfg, WageringConfigNamespace, "min_stake/USD", "1.00")
+	applyConfigValue(t, cfg, WageringConfigNamespace, "game/roulette/max_stake/USD", "20.00")
+	applyConfigValue(t, cfg, WageringConfigNamespace, "equipment/cab-7/max_stake/USD", "50.00")
+
+	cases := []struct {
+		game, device string
+		stake        int64
+		currency     string
+		want         rgsv1.ResultCode
+		reason       string
+	}{
+		{"slots", "", 10000, "USD", rgsv1.ResultCode_RESULT_CODE_OK, ""},
+		{"slots", "", 10001, "USD", rgsv1.ResultCode_RESULT_CODE_DENIED, "stake exceeds limit"},
+		{"slots", "", 99, "USD", rgsv1.ResultCode_RESULT_CODE_DENIED, "stake below minimum"},
+		{"roulette", "", 2001, "USD", rgsv1.ResultCode_RESULT_CODE_DENIED, "stake exceeds limit"},
+		{"roulette", "cab-7", 5000, "USD", rgsv1.ResultCode_RESULT_CODE_OK, ""},
+		{"roulette", "cab-7", 5001, "USD", rgsv1.ResultCode_RESULT_CODE_DENIED, "stake exceeds limit"},
+		{"roulette", "", 50000, "EUR", rgsv1.ResultCode_RESULT_CODE_OK, ""},
+	}
+	for i, tc := range cases {
+		m := meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "limit-"+string(rune('a'+i)))
+		m.Source = &rgsv1.Source{DeviceId: tc.device}
+		resp, _ := svc.PlaceWager(context.Background(), &rgsv1.PlaceWagerRequest{Meta: m, PlayerId: "player-1", GameId: tc.game, Stake: &rgsv1.Money{AmountMinor: tc.stake, Currency: tc.currency}})
+		if resp.Meta.GetResultCode() != tc.want || resp.Meta.GetDenialReason() != tc.reason {
+			t.Fatalf("%s/%s stake %d %s: expected %s %q, got=%+v", tc.game, tc.device, tc.stake, tc.currency, tc.want, tc.reason, resp.Meta)
+		}
+	}
+	events := auditEvents(svc.AuditStore)
+	if last := events[len(events)-2]; last.Action != "place_wager" || last.Result != "denied" || last.Reason != "stake exceeds limit" {
+		t.Fatalf("expected limit denial audited, got=%+v", last)
+	}
+}
+
+func TestConfigRejectsMalformedStakeLimits(t *testing.T) {
+	cfg := NewConfigService(ledgerFixedClock{now: time.Date(2026, 2, 23, 10, 0, 0, 0, time.UTC)})
+	for _, tc := range []struct{ key, value string }{
+		{"max_stake/USD", "-5.00"},
+		{"max_stake/USD", "1.001"},
+		{"max_stake/XYZ1", "5.00"},
+		{"table/t1/max_stake/USD", "5.00"},
+		{"game//max_stake/USD", "5.00"},
+		{"avg_stake/USD", "5.00"},
+	} {
+		resp, _ := cfg.ProposeConfigChange(context.Background(), &rgsv1.ProposeConfigChangeRequest{
+			Meta:            meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+			ConfigNamespace: WageringConfigNamespace,
+			ConfigKey:       tc.key,
+			ProposedValue:   tc.value,
+		})
+		if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+			t.Fatalf("%s=%s: expected invalid, got=%+v", tc.key, tc.value, resp.Meta)
+		}
+	}
+}
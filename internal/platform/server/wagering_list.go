@@ -0,0 +1,155 @@
+package server
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+// wagerListFilter holds the validated ListWagers filters. Zero values match
+// everything.
+type wagerListFilter struct {
+	playerID string
+	gameID   string
+	status   rgsv1.WagerStatus
+	from     time.Time
+	to       time.Time
+}
+
+func (f wagerListFilter) matches(w *rgsv1.Wager) bool {
+	if f.playerID != "" && w.PlayerId != f.playerID {
+		return false
+	}
+	if f.gameID != "" && w.GameId != f.gameID {
+		return false
+	}
+	if f.status != rgsv1.WagerStatus_WAGER_STATUS_UNSPECIFIED && w.Status != f.status {
+		return false
+	}
+	placed := parseTS(w.PlacedAt)
+	if !f.from.IsZero() && placed.Before(f.from) {
+		return false
+	}
+	if !f.to.IsZero() && placed.After(f.to) {
+		return false
+	}
+	return true
+}
+
+// authorizeList lets operators and services list any wagers and players list
+// only their own. It returns the player_id filter to apply, which is forced
+// to the player's own ID when a player leaves it empty.
+func (s *WageringService) authorizeList(ctx context.Context, meta *rgsv1.RequestMeta, playerID string) (string, bool, string) {
+	actor, reason := resolveActor(ctx, meta)
+	if reason != "" {
+		return "", false, reason
+	}
+	switch actor.ActorType {
+	case rgsv1.ActorType_ACTOR_TYPE_OPERATOR, rgsv1.ActorType_ACTOR_TYPE_SERVICE:
+		return playerID, true, ""
+	case rgsv1.ActorType_ACTOR_TYPE_PLAYER:
+		if playerID == "" {
+			return actor.ActorId, true, ""
+		}
+		if actor.ActorId != playerID {
+			return "", false, "player cannot list wagers for another player"
+		}
+		return playerID, true, ""
+	default:
+		return "", false, "unauthorized actor type"
+	}
+}
+
+func (s *WageringService) ListWagers(ctx context.Context, req *rgsv1.ListWagersRequest) (*rgsv1.ListWagersResponse, error) {
+	if req == nil {
+		req = &rgsv1.ListWagersRequest{}
+	}
+	playerID, ok, reason := s.authorizeList(ctx, req.Meta, req.PlayerId)
+	if !ok {
+		_ = s.appendAudit(req.Meta, "", "list_wagers", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		return &rgsv1.ListWagersResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	invalid := func(reason string) (*rgsv1.ListWagersResponse, error) {
+		_ = s.appendAudit(req.Meta, "", "list_wagers", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		return &rgsv1.ListWagersResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, reason)}, nil
+	}
+	if req.PageSize < 0 || req.PageSize > 100 {
+		return invalid("invalid page_size")
+	}
+	size := int(req.PageSize)
+	if size == 0 {
+		size = 25
+	}
+	start := 0
+	if req.PageToken != "" {
+		n, err := strconv.Atoi(req.PageToken)
+		if err != nil || n < 0 {
+			return invalid("invalid page_token")
+		}
+		start = n
+	}
+	if _, ok := rgsv1.WagerStatus_name[int32(req.Status)]; !ok {
+		return invalid("invalid status")
+	}
+	from, ok := parseRFC3339Strict(req.FromTime)
+	if !ok {
+		return invalid("invalid from_time")
+	}
+	to, ok := parseRFC3339Strict(req.ToTime)
+	if !ok {
+		return invalid("invalid to_time")
+	}
+	if !from.IsZero() && !to.IsZero() && from.After(to) {
+		return invalid("from_time must not be after to_time")
+	}
+	filter := wagerListFilter{
+		playerID: playerID,
+		gameID:   req.GameId,
+		status:   req.Status,
+		from:     from,
+		to:       to,
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.dbEnabled() {
+		rows, next, err := s.listWagersFromDB(ctx, filter, size, start)
+		if err != nil {
+			return &rgsv1.ListWagersResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+		return &rgsv1.ListWagersResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Wagers: rows, NextPageToken: next}, nil
+	}
+	if !s.useInMemoryWagerMirror() {
+		return &rgsv1.ListWagersResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, "")}, nil
+	}
+
+	items := make([]*rgsv1.Wager, 0)
+	for _, w := range s.wagers {
+		if filter.matches(w) {
+			items = append(items, cloneWager(w))
+		}
+	}
+	sort.Slice(items, func(i, j int) bool {
+		pi, pj := parseTS(items[i].PlacedAt), parseTS(items[j].PlacedAt)
+		if !pi.Equal(pj) {
+			return pi.After(pj)
+		}
+		return items[i].WagerId > items[j].WagerId
+	})
+	if start > len(items) {
+		start = len(items)
+	}
+	end := start + size
+	if end > len(items) {
+		end = len(items)
+	}
+	next := ""
+	if end < len(items) {
+		next = strconv.Itoa(end)
+	}
+	return &rgsv1.ListWagersResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Wagers: items[start:end], NextPageToken: next}, nil
+}
@@ -0,0 +1,130 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+// seedListWagers places three wagers an hour apart: player-1 on game-a and
+// game-b, then player-2 on game-a, and settles the game-b wager.
+func seedListWagers(t *testing.T, svc *WageringService, start time.Time) []*rgsv1.Wager {
+	t.Helper()
+	ctx := context.Background()
+	seeds := []struct{ player, game string }{
+		{"player-1", "game-a"},
+		{"player-1", "game-b"},
+		{"player-2", "game-a"},
+	}
+	out := make([]*rgsv1.Wager, 0, len(seeds))
+	for i, sd := range seeds {
+		svc.Clock = ledgerFixedClock{now: start.Add(time.Duration(i) * time.Hour)}
+		resp, _ := svc.PlaceWager(ctx, &rgsv1.PlaceWagerRequest{
+			Meta:     meta(sd.player, rgsv1.ActorType_ACTOR_TYPE_PLAYER, "list-seed-"+sd.player+"-"+sd.game),
+			PlayerId: sd.player,
+			GameId:   sd.game,
+			Stake:    &rgsv1.Money{AmountMinor: 100, Currency: "USD"},
+		})
+		if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+			t.Fatalf("seed place failed: %+v", resp.Meta)
+		}
+		out = append(out, resp.Wager)
+	}
+	settled, _ := svc.SettleWager(ctx, &rgsv1.SettleWagerRequest{
+		Meta:       meta("svc-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, "list-seed-settle"),
+		WagerId:    out[1].WagerId,
+		Payout:     &rgsv1.Money{AmountMinor: 150, Currency: "USD"},
+		OutcomeRef: "outcome-list",
+	})
+	if settled.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("seed settle failed: %+v", settled.Meta)
+	}
+	return out
+}
+
+func TestWageringListWagersFiltersAndPaginates(t *testing.T) {
+	start := time.Date(2026, 2, 15, 9, 0, 0, 0, time.UTC)
+	svc := NewWageringService(ledgerFixedClock{now: start})
+	seeded := seedListWagers(t, svc, start)
+	ctx := context.Background()
+	op := meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+
+	all, _ := svc.ListWagers(ctx, &rgsv1.ListWagersRequest{Meta: op})
+	if all.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || len(all.Wagers) != 3 {
+		t.Fatalf("expected all wagers listed, got=%+v", all)
+	}
+	if all.Wagers[0].WagerId != seeded[2].WagerId || all.Wagers[2].WagerId != seeded[0].WagerId {
+		t.Fatalf("expected newest first, got=%v", all.Wagers)
+	}
+
+	for _, tc := range []struct {
+		name string
+		req  *rgsv1.ListWagersRequest
+		want []string
+	}{
+		{"player", &rgsv1.ListWagersRequest{PlayerId: "player-1"}, []string{seeded[1].WagerId, seeded[0].WagerId}},
+		{"game", &rgsv1.ListWagersRequest{GameId: "game-a"}, []string{seeded[2].WagerId, seeded[0].WagerId}},
+		{"status", &rgsv1.ListWagersRequest{Status: rgsv1.WagerStatus_WAGER_STATUS_SETTLED}, []string{seeded[1].WagerId}},
+		{"range", &rgsv1.ListWagersRequest{
+			FromTime: start.Add(30 * time.Minute).Format(time.RFC3339),
+			ToTime:   start.Add(2 * time.Hour).Format(time.RFC3339),
+		}, []string{seeded[2].WagerId, seeded[1].WagerId}},
+	} {
+		tc.req.Meta = op
+		resp, _ := svc.ListWagers(ctx, tc.req)
+		if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || len(resp.Wagers) != len(tc.want) {
+			t.Fatalf("%s: unexpected result %+v", tc.name, resp)
+		}
+		for i, id := range tc.want {
+			if resp.Wagers[i].WagerId != id {
+				t.Fatalf("%s: expected %s at %d, got=%s", tc.name, id, i, resp.Wagers[i].WagerId)
+			}
+		}
+	}
+
+	page1, _ := svc.ListWagers(ctx, &rgsv1.ListWagersRequest{Meta: op, PageSize: 2})
+	if len(page1.Wagers) != 2 || page1.NextPageToken != "2" {
+		t.Fatalf("unexpected first page: %+v", page1)
+	}
+	page2, _ := svc.ListWagers(ctx, &rgsv1.ListWagersRequest{Meta: op, PageSize: 2, PageToken: page1.NextPageToken})
+	if len(page2.Wagers) != 1 || page2.NextPageToken != "" || page2.Wagers[0].WagerId != seeded[0].WagerId {
+		t.Fatalf("unexpected second page: %+v", page2)
+	}
+}
+
+func TestWageringListWagersPlayerScopeAndValidation(t *testing.T) {
+	start := time.Date(2026, 2, 15, 9, 0, 0, 0, time.UTC)
+	svc := NewWageringService(ledgerFixedClock{now: start})
+	seedListWagers(t, svc, start)
+	ctx := context.Background()
+
+	own, _ := svc.ListWagers(ctx, &rgsv1.ListWagersRequest{Meta: meta("player-2", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "")})
+	if own.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || len(own.Wagers) != 1 || own.Wagers[0].PlayerId != "player-2" {
+		t.Fatalf("expected player scoped to own wagers, got=%+v", own)
+	}
+	other, _ := svc.ListWagers(ctx, &rgsv1.ListWagersRequest{Meta: meta("player-2", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""), PlayerId: "player-1"})
+	if other.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected listing another player's wagers denied, got=%+v", other.Meta)
+	}
+
+	op := meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")
+	for _, tc := range []struct {
+		req    *rgsv1.ListWagersRequest
+		reason string
+	}{
+		{&rgsv1.ListWagersRequest{PageSize: -1}, "invalid page_size"},
+		{&rgsv1.ListWagersRequest{PageSize: 101}, "invalid page_size"},
+		{&rgsv1.ListWagersRequest{PageToken: "bad"}, "invalid page_token"},
+		{&rgsv1.ListWagersRequest{Status: rgsv1.WagerStatus(99)}, "invalid status"},
+		{&rgsv1.ListWagersRequest{FromTime: "yesterday"}, "invalid from_time"},
+		{&rgsv1.ListWagersRequest{FromTime: "2026-02-16T00:00:00Z", ToTime: "2026-02-15T00:00:00Z"}, "from_time must not be after to_time"},
+	} {
+		tc.req.Meta = op
+		resp, _ := svc.ListWagers(ctx, tc.req)
+		if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID || resp.Meta.GetDenialReason() != tc.reason {
+			t.Fatalf("expected %q, got=%+v", tc.reason, resp.Meta)
+		}
+	}
+}
@@ -0,0 +1,171 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+// theoreticalRTPKey is the WageringConfigNamespace key holding a game's
+// theoretical return to player in basis points, e.g. "9650" for 96.5%.
+func theoreticalRTPKey(gameID string) string {
+	return "game/" + gameID + "/theoretical_rtp_bps"
+}
+
+// parseGameSettingKey returns the game ID of a "game/<game_id>/<setting>"
+// key.
+func parseGameSettingKey(key, setting string) (string, bool) {
+	parts := strings.Split(key, "/")
+	if len(parts) != 3 || parts[0] != "game" || parts[1] == "" || parts[2] != setting {
+		return "", false
+	}
+	return parts[1], true
+}
+
+// parseBasisPoints parses a setting in basis points of 100%.
+func parseBasisPoints(v string) (int64, bool) {
+	bps, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+	if err != nil || bps <= 0 || bps > 10000 {
+		return 0, false
+	}
+	return bps, true
+}
+
+// gamePerformanceTotals accumulates wagers for one game and stake currency.
+type gamePerformanceTotals struct {
+	gameID       string
+	currency     string
+	settledCount int64
+	settledStake int64
+	payout       int64
+	openCount    int64
+	openStake    int64
+}
+
+func (t *gamePerformanceTotals) add(w *rgsv1.Wager) {
+	switch w.Status {
+	case rgsv1.WagerStatus_WAGER_STATUS_SETTLED:
+		t.settledCount++
+		t.settledStake += w.Stake.GetAmountMinor()
+		t.payout += w.Payout.GetAmountMinor()
+	case rgsv1.WagerStatus_WAGER_STATUS_PENDING:
+		t.openCount++
+		t.openStake += w.Stake.GetAmountMinor()
+	}
+}
+
+func (t *gamePerformanceTotals) toProto(theoreticalBps int64) *rgsv1.GamePerformance {
+	p := &rgsv1.GamePerformance{
+		GameId:       t.gameID,
+		Currency:     t.currency,
+		SettledCount: t.settledCount,
+		SettledStake: &rgsv1.Money{AmountMinor: t.settledStake, Currency: t.currency},
+		Payout:       &rgsv1.Money{AmountMinor: t.payout, Currency: t.currency},
+		OpenCount:    t.openCount,
+		OpenStake:    &rgsv1.Money{AmountMinor: t.openStake, Currency: t.currency},
+	}
+	if t.settledStake > 0 {
+		p.ActualRtpBps = strconv.FormatInt(t.payout*10000/t.settledStake, 10)
+	}
+	if theoreticalBps > 0 {
+		p.TheoreticalRtpBps = strconv.FormatInt(theoreticalBps, 10)
+		p.ExpectedPayout = &rgsv1.Money{AmountMinor: t.settledStake * theoreticalBps / 10000, Currency: t.currency}
+	}
+	return p
+}
+
+// gamePerformance aggregates settled and pending wagers placed within
+// [from, to] per game and currency, ordered by game then currency. Zero
+// bounds are open. With a database, totals are read from reader when it is
+// set, so reports can query a replica, and from s.db otherwise.
+func (s *WageringService) gamePerformance(ctx context.Context, reader *sql.DB, gameID string, from, to time.Time) ([]*rgsv1.GamePerformance, error) {
+	var totals []*gamePerformanceTotals
+	if s.dbEnabled() {
+		if reader == nil {
+			reader = s.db
+		}
+		var err error
+		totals, err = gamePerformanceFromDB(ctx, reader, gameID, from, to)
+		if err != nil {
+			return nil, err
+		}
+	} else if s.useInMemoryWagerMirror() {
+		filter := wagerListFilter{gameID: gameID, from: from, to: to}
+		byKey := make(map[string]*gamePerformanceTotals)
+		s.mu.Lock()
+		for _, w := range s.wagers {
+			if !filter.matches(w) {
+				continue
+			}
+			k := w.GameId + "|" + w.Stake.GetCurrency()
+			t := byKey[k]
+			if t == nil {
+				t = &gamePerformanceTotals{gameID: w.GameId, currency: w.Stake.GetCurrency()}
+				byKey[k] = t
+			}
+			t.add(w)
+		}
+		s.mu.Unlock()
+		for _, t := range byKey {
+			if t.settledCount > 0 || t.openCount > 0 {
+				totals = append(totals, t)
+			}
+		}
+		sort.Slice(totals, func(i, j int) bool {
+			if totals[i].gameID != totals[j].gameID {
+				return totals[i].gameID < totals[j].gameID
+			}
+			return totals[i].currency < totals[j].currency
+		})
+	}
+
+	out := make([]*rgsv1.GamePerformance, 0, len(totals))
+	theoretical := make(map[string]int64)
+	for _, t := range totals {
+		bps, seen := theoretical[t.gameID]
+		if !seen && s.Settings != nil {
+			v, ok, err := s.Settings.WageringSetting(ctx, theoreticalRTPKey(t.gameID))
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				bps, _ = parseBasisPoints(v)
+			}
+			theoretical[t.gameID] = bps
+		}
+		out = append(out, t.toProto(bps))
+	}
+	return out, nil
+}
+
+func (s *WageringService) GetGamePerformance(ctx context.Context, req *rgsv1.GetGamePerformanceRequest) (*rgsv1.GetGamePerformanceResponse, error) {
+	if req == nil {
+		req = &rgsv1.GetGamePerformanceRequest{}
+	}
+	if ok, reason := s.authorizeSettlement(ctx, req.Meta); !ok {
+		_ = s.appendAudit(req.Meta, "", "get_game_performance", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		return &rgsv1.GetGamePerformanceResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	from, ok := parseRFC3339Strict(req.FromTime)
+	if !ok {
+		return &rgsv1.GetGamePerformanceResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "invalid from_time")}, nil
+	}
+	to, ok := parseRFC3339Strict(req.ToTime)
+	if !ok {
+		return &rgsv1.GetGamePerformanceResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "invalid to_time")}, nil
+	}
+	if !from.IsZero() && !to.IsZero() && from.After(to) {
+		return &rgsv1.GetGamePerformanceResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "from_time must not be after to_time")}, nil
+	}
+	games, err := s.gamePerformance(ctx, nil, req.GameId, from, to)
+	if err != nil {
+		return &rgsv1.GetGamePerformanceResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	return &rgsv1.GetGamePerformanceResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Games: games}, nil
+}
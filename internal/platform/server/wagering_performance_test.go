@@ -0,0 +1,124 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+)
+
+func TestWageringGamePerformanceComparesActualWithTheoretical(t *testing.T) {
+	start := time.Date(2026, 2, 15, 9, 0, 0, 0, time.UTC)
+	cfg := NewConfigService(ledgerFixedClock{now: start})
+	applyConfigValue(t, cfg, WageringConfigNamespace, theoreticalRTPKey("game-b"), "9500")
+	svc := NewWageringService(ledgerFixedClock{now: start})
+	svc.Settings = cfg
+	seedListWagers(t, svc, start)
+	ctx := context.Background()
+
+	resp, err := svc.GetGamePerformance(ctx, &rgsv1.GetGamePerformanceRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")})
+	if err != nil {
+		t.Fatalf("get game performance err: %v", err)
+	}
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || len(resp.Games) != 2 {
+		t.Fatalf("expected two games, got=%+v", resp)
+	}
+	a, b := resp.Games[0], resp.Games[1]
+	if a.GameId != "game-a" || a.SettledCount != 0 || a.ActualRtpBps != "" || a.OpenCount != 2 || a.OpenStake.GetAmountMinor() != 200 {
+		t.Fatalf("unexpected game-a performance: %+v", a)
+	}
+	if a.TheoreticalRtpBps != "" || a.ExpectedPayout != nil {
+		t.Fatalf("expected no theoretical RTP for game-a, got=%+v", a)
+	}
+	if b.GameId != "game-b" || b.SettledCount != 1 || b.SettledStake.GetAmountMinor() != 100 || b.Payout.GetAmountMinor() != 150 {
+		t.Fatalf("unexpected game-b totals: %+v", b)
+	}
+	if b.ActualRtpBps != "15000" || b.TheoreticalRtpBps != "9500" || b.ExpectedPayout.GetAmountMinor() != 95 || b.OpenCount != 0 {
+		t.Fatalf("unexpected game-b rtp: %+v", b)
+	}
+
+	windowed, _ := svc.GetGamePerformance(ctx, &rgsv1.GetGamePerformanceRequest{
+		Meta:     meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		GameId:   "game-a",
+		FromTime: start.Add(30 * time.Minute).Format(time.RFC3339),
+	})
+	if len(windowed.Games) != 1 || windowed.Games[0].OpenCount != 1 {
+		t.Fatalf("expected one game-a wager after from_time, got=%+v", windowed.Games)
+	}
+
+	denied, _ := svc.GetGamePerformance(ctx, &rgsv1.GetGamePerformanceRequest{Meta: meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "")})
+	if denied.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected player denied, got=%v", denied.Meta.GetResultCode())
+	}
+	invalid, _ := svc.GetGamePerformance(ctx, &rgsv1.GetGamePerformanceRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""), ToTime: "noon"})
+	if invalid.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected invalid to_time, got=%v", invalid.Meta.GetResultCode())
+	}
+}
+
+func TestConfigRejectsInvalidTheoreticalRTP(t *testing.T) {
+	cfg := NewConfigService(ledgerFixedClock{now: time.Date(2026, 2, 15, 9, 0, 0, 0, time.UTC)})
+	for _, tc := range []struct{ key, value string }{
+		{"game/slots-1/theoretical_rtp_bps", "0"},
+		{"game/slots-1/theoretical_rtp_bps", "10001"},
+		{"game/slots-1/theoretical_rtp_bps", "96.5"},
+		{"equipment/eq-1/theoretical_rtp_bps", "9650"},
+	} {
+		resp, _ := cfg.ProposeConfigChange(context.Background(), &rgsv1.ProposeConfigChangeRequest{
+			Meta:            meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+			ConfigNamespace: WageringConfigNamespace,
+			ConfigKey:       tc.key,
+			ProposedValue:   tc.value,
+			Reason:          "rtp",
+		})
+		if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+			t.Fatalf("expected %s=%s rejected, got=%v", tc.key, tc.value, resp.Meta.GetResultCode())
+		}
+	}
+}
+
+func TestReportingRTPSummary(t *testing.T) {
+	start := time.Date(2026, 2, 15, 9, 0, 0, 0, time.UTC)
+	cfg := NewConfigService(ledgerFixedClock{now: start})
+	applyConfigValue(t, cfg, WageringConfigNamespace, theoreticalRTPKey("game-b"), "9500")
+	wagering := NewWageringService(ledgerFixedClock{now: start})
+	wagering.Settings = cfg
+	seedListWagers(t, wagering, start)
+
+	clk := ledgerFixedClock{now: start.Add(4 * time.Hour)}
+	reportingSvc := NewReportingService(clk, NewLedgerService(clk), NewEventsService(clk))
+	reportingSvc.Wagering = wagering
+	resp, err := reportingSvc.GenerateReport(context.Background(), &rgsv1.GenerateReportRequest{
+		Meta:       meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		ReportType: rgsv1.ReportType_REPORT_TYPE_RTP_SUMMARY,
+		Interval:   rgsv1.ReportInterval_REPORT_INTERVAL_DTD,
+		Format:     rgsv1.ReportFormat_REPORT_FORMAT_JSON,
+		OperatorId: "casino-1",
+	})
+	if err != nil {
+		t.Fatalf("generate report err: %v", err)
+	}
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || resp.ReportRun.NoActivity {
+		t.Fatalf("expected rtp summary with activity, got=%+v", resp.Meta)
+	}
+	var payload struct {
+		Rows []struct {
+			GameID            string `json:"game_id"`
+			ActualRTPBps      string `json:"actual_rtp_bps"`
+			TheoreticalRTPBps string `json:"theoretical_rtp_bps"`
+			ExpectedPayout    int64  `json:"expected_payout"`
+			OpenStake         int64  `json:"open_stake"`
+		} `json:"rows"`
+	}
+	if err := json.Unmarshal(resp.ReportRun.Content, &payload); err != nil {
+		t.Fatalf("decode report: %v", err)
+	}
+	if len(payload.Rows) != 2 || payload.Rows[0].OpenStake != 200 {
+		t.Fatalf("unexpected rows: %+v", payload.Rows)
+	}
+	if payload.Rows[1].ActualRTPBps != "15000" || payload.Rows[1].TheoreticalRTPBps != "9500" || payload.Rows[1].ExpectedPayout != 95 {
+		t.Fatalf("unexpected game-b row: %+v", payload.Rows[1])
+	}
+}
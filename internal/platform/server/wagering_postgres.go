@@ -5,6 +5,7 @@ import (
 	"crypto/sha256"
 	"database/sql"
 	"encoding/hex"
+	"strconv"
 	"strings"
 	"time"
 
@@ -25,6 +26,8 @@ func wageringStatusToDB(v rgsv1.WagerStatus) string {
 		return "settled"
 	case rgsv1.WagerStatus_WAGER_STATUS_CANCELED:
 		return "canceled"
+	case rgsv1.WagerStatus_WAGER_STATUS_VOIDED:
+		return "voided"
 	default:
 		return "pending"
 	}
@@ -38,6 +41,8 @@ func wageringStatusFromDB(v string) rgsv1.WagerStatus {
 		return rgsv1.WagerStatus_WAGER_STATUS_SETTLED
 	case "canceled":
 		return rgsv1.WagerStatus_WAGER_STATUS_CANCELED
+	case "voided":
+		return rgsv1.WagerStatus_WAGER_STATUS_VOIDED
 	default:
 		return rgsv1.WagerStatus_WAGER_STATUS_UNSPECIFIED
 	}
@@ -48,18 +53,40 @@ func hashWageringRequest(parts ...string) string {
 	return hex.EncodeToString(sum[:])
 }
 
-func (s *WageringService) persistWager(ctx context.Context, w *rgsv1.Wager) error {
+// persistWager upserts the wager and records eventType in the outbox within
+// the same transaction.
+func (s *WageringService) persistWager(ctx context.Context, w *rgsv1.Wager, eventType string) error {
 	if !s.dbEnabled() || w == nil {
 		return nil
 	}
+	dbtx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = dbtx.Rollback()
+	}()
+	if err := s.persistWagerTx(ctx, dbtx, w, eventType); err != nil {
+		return err
+	}
+	return dbtx.Commit()
+}
+
+// persistWagerTx upserts the wager and its outbox event inside the caller's
+// transaction.
+func (s *WageringService) persistWagerTx(ctx context.Context, dbtx *sql.Tx, w *rgsv1.Wager, eventType string) error {
 	const q = `
 INSERT INTO wagers (
   wager_id, player_id, game_id, stake_amount_minor, stake_currency, status,
   payout_amount_minor, payout_currency, outcome_ref, placed_at, settled_at, canceled_at, cancel_reason,
+  settlement_escalated_at, voided_at, void_reason, refund_transaction_id,
+  stake_transaction_id, payout_transaction_id, device_id,
   occurred_at, received_at, recorded_at
 )
 VALUES (
   $1,$2,$3,$4,$5,$6,$7,$8,$9,$10::timestamptz,NULLIF($11,'')::timestamptz,NULLIF($12,'')::timestamptz,$13,
+  NULLIF($15,'')::timestamptz,NULLIF($16,'')::timestamptz,$17,$18,
+  $19,$20,$21,
   $14::timestamptz,NOW(),NOW()
 )
 ON CONFLICT (wager_id) DO UPDATE SET
@@ -75,6 +102,13 @@ ON CONFLICT (wager_id) DO UPDATE SET
   settled_at = EXCLUDED.settled_at,
   canceled_at = EXCLUDED.canceled_at,
   cancel_reason = EXCLUDED.cancel_reason,
+  settlement_escalated_at = EXCLUDED.settlement_escalated_at,
+  voided_at = EXCLUDED.voided_at,
+  void_reason = EXCLUDED.void_reason,
+  refund_transaction_id = EXCLUDED.refund_transaction_id,
+  stake_transaction_id = EXCLUDED.stake_transaction_id,
+  payout_transaction_id = EXCLUDED.payout_transaction_id,
+  device_id = EXCLUDED.device_id,
   occurred_at = EXCLUDED.occurred_at,
   received_at = NOW(),
   recorded_at = NOW()
@@ -89,7 +123,7 @@ ON CONFLICT (wager_id) DO UPDATE SET
 	if occurred == "" {
 		occurred = time.Now().UTC().Format(time.RFC3339Nano)
 	}
-	_, err := s.db.ExecContext(ctx, q,
+	_, err := dbtx.ExecContext(ctx, q,
 		w.WagerId,
 		w.PlayerId,
 		w.GameId,
@@ -104,29 +138,132 @@ ON CONFLICT (wager_id) DO UPDATE SET
 		w.CanceledAt,
 		w.CancelReason,
 		occurred,
+		w.SettlementEscalatedAt,
+		w.VoidedAt,
+		w.VoidReason,
+		w.RefundTransactionId,
+		w.StakeTransactionId,
+		w.PayoutTransactionId,
+		w.DeviceId,
 	)
-	return err
+	if err != nil {
+		return err
+	}
+	return insertOutboxEventTx(ctx, dbtx, "wager", w.WagerId, eventType, w)
 }
 
+const wagerColumns = `
+wager_id, player_id, game_id, stake_amount_minor, stake_currency, status,
+payout_amount_minor, payout_currency, outcome_ref, placed_at, settled_at, canceled_at, cancel_reason,
+settlement_escalated_at, voided_at, void_reason, refund_transaction_id,
+stake_transaction_id, payout_transaction_id, device_id
+`
+
 func (s *WageringService) getWager(ctx context.Context, wagerID string) (*rgsv1.Wager, error) {
 	if !s.dbEnabled() {
 		return nil, nil
 	}
-	const q = `
-SELECT wager_id, player_id, game_id, stake_amount_minor, stake_currency, status,
-       payout_amount_minor, payout_currency, outcome_ref, placed_at, settled_at, canceled_at, cancel_reason
+	q := `SELECT ` + wagerColumns + ` FROM wagers WHERE wager_id = $1`
+	w, err := scanWager(s.db.QueryRowContext(ctx, q, wagerID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// listOverdueWagersFromDB returns pending wagers placed at or before cutoff,
+// oldest first.
+func (s *WageringService) listOverdueWagersFromDB(ctx context.Context, cutoff time.Time, limit int) ([]*rgsv1.Wager, error) {
+	if !s.dbEnabled() {
+		return nil, nil
+	}
+	q := `SELECT ` + wagerColumns + `
 FROM wagers
-WHERE wager_id = $1
-`
+WHERE status = 'pending'
+  AND placed_at <= $1::timestamptz
+ORDER BY placed_at ASC, wager_id ASC
+LIMIT $2`
+	rows, err := s.db.QueryContext(ctx, q, cutoff.UTC(), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]*rgsv1.Wager, 0)
+	for rows.Next() {
+		w, err := scanWager(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, w)
+	}
+	return out, rows.Err()
+}
+
+// listWagersFromDB returns one page of wagers matching filter, newest first.
+func (s *WageringService) listWagersFromDB(ctx context.Context, filter wagerListFilter, limit, offset int) ([]*rgsv1.Wager, string, error) {
+	if !s.dbEnabled() {
+		return nil, "", nil
+	}
+	status := ""
+	if filter.status != rgsv1.WagerStatus_WAGER_STATUS_UNSPECIFIED {
+		status = wageringStatusToDB(filter.status)
+	}
+	var from, to sql.NullTime
+	if !filter.from.IsZero() {
+		from = sql.NullTime{Time: filter.from, Valid: true}
+	}
+	if !filter.to.IsZero() {
+		to = sql.NullTime{Time: filter.to, Valid: true}
+	}
+	q := `SELECT ` + wagerColumns + `
+FROM wagers
+WHERE ($1 = '' OR player_id = $1)
+  AND ($2 = '' OR game_id = $2)
+  AND ($3 = '' OR status = $3)
+  AND ($4::timestamptz IS NULL OR placed_at >= $4::timestamptz)
+  AND ($5::timestamptz IS NULL OR placed_at <= $5::timestamptz)
+ORDER BY placed_at DESC, wager_id DESC
+LIMIT $6 OFFSET $7`
+	rows, err := s.db.QueryContext(ctx, q, filter.playerID, filter.gameID, status, from, to, limit, offset)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+	out := make([]*rgsv1.Wager, 0, limit)
+	for rows.Next() {
+		w, err := scanWager(rows)
+		if err != nil {
+			return nil, "", err
+		}
+		out = append(out, w)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+	next := ""
+	if len(out) == limit {
+		next = strconv.Itoa(offset + len(out))
+	}
+	return out, next, nil
+}
+
+type wagerScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanWager(row wagerScanner) (*rgsv1.Wager, error) {
 	var (
 		w                                                 rgsv1.Wager
 		stakeAmount, payoutAmount                         int64
 		stakeCurrency, status, payoutCurrency, outcomeRef string
 		placedAt                                          time.Time
-		settledAt, canceledAt                             sql.NullTime
+		settledAt, canceledAt, escalatedAt, voidedAt      sql.NullTime
 		cancelReason                                      string
 	)
-	err := s.db.QueryRowContext(ctx, q, wagerID).Scan(
+	if err := row.Scan(
 		&w.WagerId,
 		&w.PlayerId,
 		&w.GameId,
@@ -140,11 +277,14 @@ WHERE wager_id = $1
 		&settledAt,
 		&canceledAt,
 		&cancelReason,
-	)
-	if err == sql.ErrNoRows {
-		return nil, nil
-	}
-	if err != nil {
+		&escalatedAt,
+		&voidedAt,
+		&w.VoidReason,
+		&w.RefundTransactionId,
+		&w.StakeTransactionId,
+		&w.PayoutTransactionId,
+		&w.DeviceId,
+	); err != nil {
 		return nil, err
 	}
 	w.Stake = &rgsv1.Money{AmountMinor: stakeAmount, Currency: stakeCurrency}
@@ -161,6 +301,12 @@ WHERE wager_id = $1
 		w.CanceledAt = canceledAt.Time.UTC().Format(time.RFC3339Nano)
 	}
 	w.CancelReason = cancelReason
+	if escalatedAt.Valid {
+		w.SettlementEscalatedAt = escalatedAt.Time.UTC().Format(time.RFC3339Nano)
+	}
+	if voidedAt.Valid {
+		w.VoidedAt = voidedAt.Time.UTC().Format(time.RFC3339Nano)
+	}
 	return &w, nil
 }
 
@@ -212,3 +358,41 @@ ON CONFLICT (operation, scope_id, idempotency_key) DO UPDATE SET
 	_, err = s.db.ExecContext(ctx, q, operation, scopeID, idempotencyKey, requestHash, payload)
 	return err
 }
+
+func gamePerformanceFromDB(ctx context.Context, db *sql.DB, gameID string, from, to time.Time) ([]*gamePerformanceTotals, error) {
+	var fromTS, toTS sql.NullTime
+	if !from.IsZero() {
+		fromTS = sql.NullTime{Time: from, Valid: true}
+	}
+	if !to.IsZero() {
+		toTS = sql.NullTime{Time: to, Valid: true}
+	}
+	const q = `
+SELECT game_id, stake_currency,
+       COUNT(*) FILTER (WHERE status = 'settled'),
+       COALESCE(SUM(stake_amount_minor) FILTER (WHERE status = 'settled'), 0),
+       COALESCE(SUM(payout_amount_minor) FILTER (WHERE status = 'settled'), 0),
+       COUNT(*) FILTER (WHERE status = 'pending'),
+       COALESCE(SUM(stake_amount_minor) FILTER (WHERE status = 'pending'), 0)
+FROM wagers
+WHERE status IN ('settled', 'pending')
+  AND ($1 = '' OR game_id = $1)
+  AND ($2::timestamptz IS NULL OR placed_at >= $2::timestamptz)
+  AND ($3::timestamptz IS NULL OR placed_at <= $3::timestamptz)
+GROUP BY game_id, stake_currency
+ORDER BY game_id, stake_currency`
+	rows, err := db.QueryContext(ctx, q, gameID, fromTS, toTS)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]*gamePerformanceTotals, 0)
+	for rows.Next() {
+		t := &gamePerformanceTotals{}
+		if err := rows.Scan(&t.gameID, &t.currency, &t.settledCount, &t.settledStake, &t.payout, &t.openCount, &t.openStake); err != nil {
+			return nil, err
+		}
+		out = append(out, t)
+	}
+	return out, rows.Err()
+}
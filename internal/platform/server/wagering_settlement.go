@@ -0,0 +1,311 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+// overdueWagerScanLimit bounds how many overdue wagers a single listing or
+// sweep loads; the oldest wagers are always returned first.
+const overdueWagerScanLimit = 1000
+
+// WagerSettlementPolicy controls monitoring of wagers left pending. A wager is
+// overdue once it has been pending for SLA and is escalated once; when
+// AutoVoidAfter is set it is voided, and a debited stake refunded, once it has been
+// pending that long. With EscalateOnTimeout such wagers are instead escalated
+// again and left pending for manual settlement. A zero SLA disables
+// monitoring.
+type WagerSettlementPolicy struct {
+	SLA               time.Duration
+	AutoVoidAfter     time.Duration
+	EscalateOnTimeout bool
+}
+
+func (s *WageringService) SetSettlementPolicy(policy WagerSettlementPolicy) {
+	if s == nil {
+		return
+	}
+	if policy.SLA < 0 {
+		policy.SLA = 0
+	}
+	if policy.AutoVoidAfter < 0 || (policy.AutoVoidAfter > 0 && policy.AutoVoidAfter < policy.SLA) {
+		policy.AutoVoidAfter = policy.SLA
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.settlementPolicy = policy
+}
+
+// SetMetricsObservers registers callbacks for settlement latency (outcome is
+// settled, canceled, or auto_voided) and for each monitoring sweep, including
+// the wagers the sweep failed to escalate or void.
+func (s *WageringService) SetMetricsObservers(onSettlement func(outcome string, latency time.Duration), onSweep func(overdue, escalated, voided, failed int)) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.onSettlement = onSettlement
+	s.onSweep = onSweep
+}
+
+func (s *WageringService) observeSettlementLocked(w *rgsv1.Wager, outcome string, at time.Time) {
+	if s.onSettlement == nil || w == nil {
+		return
+	}
+	placed := parseTS(w.PlacedAt)
+	if placed.IsZero() {
+		return
+	}
+	s.onSettlement(outcome, at.Sub(placed))
+}
+
+// overdueWagersLocked returns pending wagers placed at or before cutoff,
+// oldest first. The caller must hold s.mu.
+func (s *WageringService) overdueWagersLocked(ctx context.Context, cutoff time.Time) ([]*rgsv1.Wager, error) {
+	if s.dbEnabled() {
+		items, err := s.listOverdueWagersFromDB(ctx, cutoff, overdueWagerScanLimit)
+		if err != nil {
+			return nil, err
+		}
+		if s.useInMemoryWagerMirror() {
+			for _, w := range items {
+				s.wagers[w.WagerId] = cloneWager(w)
+			}
+		}
+		return items, nil
+	}
+	out := make([]*rgsv1.Wager, 0)
+	for _, w := range s.wagers {
+		if w.Status != rgsv1.WagerStatus_WAGER_STATUS_PENDING {
+			continue
+		}
+		if placed := parseTS(w.PlacedAt); !placed.IsZero() && !placed.After(cutoff) {
+			out = append(out, cloneWager(w))
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].PlacedAt == out[j].PlacedAt {
+			return out[i].WagerId < out[j].WagerId
+		}
+		return parseTS(out[i].PlacedAt).Before(parseTS(out[j].PlacedAt))
+	})
+	if len(out) > overdueWagerScanLimit {
+		out = out[:overdueWagerScanLimit]
+	}
+	return out, nil
+}
+
+func (s *WageringService) ListOverdueWagers(ctx context.Context, req *rgsv1.ListOverdueWagersRequest) (*rgsv1.ListOverdueWagersResponse, error) {
+	if req == nil {
+		req = &rgsv1.ListOverdueWagersRequest{}
+	}
+	if ok, reason := s.authorizeSettlement(ctx, req.Meta); !ok {
+		_ = s.appendAudit(req.Meta, "", "list_overdue_wagers", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		return &rgsv1.ListOverdueWagersResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+	if req.PageSize < 0 || req.PageSize > maxAuditPageSize {
+		return &rgsv1.ListOverdueWagersResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "invalid page_size")}, nil
+	}
+	if err := validatePageToken(req.PageToken); err != nil {
+		return &rgsv1.ListOverdueWagersResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "invalid page_token")}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	policy := s.settlementPolicy
+	resp := &rgsv1.ListOverdueWagersResponse{
+		Meta:                 s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""),
+		SettlementSlaSeconds: int64(policy.SLA / time.Second),
+	}
+	if policy.SLA <= 0 {
+		return resp, nil
+	}
+	now := s.now()
+	items, err := s.overdueWagersLocked(ctx, now.Add(-policy.SLA))
+	if err != nil {
+		return &rgsv1.ListOverdueWagersResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	overdue := make([]*rgsv1.OverdueWager, 0, len(items))
+	for _, w := range items {
+		placed := parseTS(w.PlacedAt)
+		ow := &rgsv1.OverdueWager{
+			Wager:          w,
+			PendingSeconds: int64(now.Sub(placed) / time.Second),
+			Escalated:      w.SettlementEscalatedAt != "",
+		}
+		if policy.AutoVoidAfter > 0 && !policy.EscalateOnTimeout {
+			ow.AutoVoidAt = placed.Add(policy.AutoVoidAfter).Format(time.RFC3339Nano)
+		}
+		overdue = append(overdue, ow)
+	}
+	page, next, err := paginate(overdue, req.PageToken, req.PageSize)
+	if err != nil {
+		return &rgsv1.ListOverdueWagersResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "invalid page_token")}, nil
+	}
+	resp.Wagers = page
+	resp.NextPageToken = next
+	return resp, nil
+}
+
+// SweepOverdueWagers escalates wagers that have breached the settlement SLA
+// and, when auto-void is enabled, voids those past the hard deadline or, with
+// EscalateOnTimeout, escalates them as wager.settlement_timed_out.
+// Escalations and voids are audited and, with a database, published through
+// the outbox as wager.settlement_overdue and wager.voided events; a voided
+// wager whose stake was debited through the ledger is refunded in the same
+// transaction and published as wager.void_refunded instead.
+//
+// Each wager is handled under the service lock on its own, so a wager that
+// fails to persist or audit is counted and reported in err without stopping
+// the rest of the sweep.
+func (s *WageringService) SweepOverdueWagers(ctx context.Context) (escalated int, voided int, err error) {
+	if s == nil {
+		return 0, 0, nil
+	}
+	s.mu.Lock()
+	policy := s.settlementPolicy
+	onSweep := s.onSweep
+	s.mu.Unlock()
+	if policy.SLA <= 0 {
+		return 0, 0, nil
+	}
+	now := s.now()
+	s.mu.Lock()
+	items, err := s.overdueWagersLocked(ctx, now.Add(-policy.SLA))
+	s.mu.Unlock()
+	if err != nil {
+		return 0, 0, err
+	}
+	var failures []error
+	for _, candidate := range items {
+		action, err := s.sweepOverdueWager(ctx, candidate.WagerId, policy, now)
+		switch {
+		case err != nil:
+			failures = append(failures, fmt.Errorf("wager %s: %w", candidate.WagerId, err))
+		case action == "escalated":
+			escalated++
+		case action == "auto_voided":
+			voided++
+		}
+	}
+	if onSweep != nil {
+		onSweep(len(items)-voided, escalated, voided, len(failures))
+	}
+	if len(failures) > 0 {
+		return escalated, voided, fmt.Errorf("%d of %d overdue wagers failed: %w", len(failures), len(items), errors.Join(failures...))
+	}
+	return escalated, voided, nil
+}
+
+// sweepOverdueWager re-reads one overdue wager under s.mu and escalates or
+// voids it as policy requires. It returns the action taken, which is empty
+// when the wager was settled meanwhile or needs nothing more.
+func (s *WageringService) sweepOverdueWager(ctx context.Context, wagerID string, policy WagerSettlementPolicy, now time.Time) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var w *rgsv1.Wager
+	if s.useInMemoryWagerMirror() {
+		w = s.wagers[wagerID]
+	}
+	if w == nil && s.dbEnabled() {
+		var err error
+		if w, err = s.getWager(ctx, wagerID); err != nil {
+			return "", err
+		}
+	}
+	if w == nil || w.Status != rgsv1.WagerStatus_WAGER_STATUS_PENDING {
+		return "", nil
+	}
+	// The mirror keeps the wager as it was until the change persists.
+	w = cloneWager(w)
+	before, _ := json.Marshal(w)
+	placed := parseTS(w.PlacedAt)
+	age := now.Sub(placed)
+	if policy.AutoVoidAfter > 0 && age >= policy.AutoVoidAfter && policy.EscalateOnTimeout {
+		// An escalation stamped after the deadline means the timeout
+		// escalation has already been raised.
+		deadline := placed.Add(policy.AutoVoidAfter)
+		if escalatedAt := parseTS(w.SettlementEscalatedAt); !escalatedAt.IsZero() && !escalatedAt.Before(deadline) {
+			return "", nil
+		}
+		w.SettlementEscalatedAt = now.Format(time.RFC3339Nano)
+		if err := s.persistWager(ctx, w, "wager.settlement_timed_out"); err != nil {
+			return "", err
+		}
+		if s.useInMemoryWagerMirror() {
+			s.wagers[w.WagerId] = cloneWager(w)
+		}
+		after, _ := json.Marshal(w)
+		if err := s.appendAudit(nil, w.WagerId, "escalate_settlement_timeout", before, after, audit.ResultSuccess, "settlement timeout exceeded"); err != nil {
+			return "", err
+		}
+		return "escalated", nil
+	}
+	if policy.AutoVoidAfter > 0 && age >= policy.AutoVoidAfter {
+		refund, err := s.prepareStakeRefundLocked(ctx, nil, w)
+		if err != nil {
+			return "", err
+		}
+		eventType := "wager.voided"
+		w.Status = rgsv1.WagerStatus_WAGER_STATUS_VOIDED
+		w.VoidReason = "settlement deadline exceeded"
+		w.VoidedAt = now.Format(time.RFC3339Nano)
+		if refund != nil {
+			w.RefundTransactionId = refund.tx.TransactionId
+			w.VoidReason += "; stake refunded"
+			eventType = "wager.void_refunded"
+		}
+		if err := s.persistWagerWithLedger(ctx, w, eventType, refund); err != nil {
+			return "", err
+		}
+		if s.useInMemoryWagerMirror() {
+			s.wagers[w.WagerId] = cloneWager(w)
+		}
+		after, _ := json.Marshal(w)
+		if err := s.appendAudit(nil, w.WagerId, "auto_void_wager", before, after, audit.ResultSuccess, w.VoidReason); err != nil {
+			return "", err
+		}
+		s.observeSettlementLocked(w, "auto_voided", now)
+		s.recordSessionActivity(ctx, w, -1, -w.GetStake().GetAmountMinor(), 0)
+		s.publishResult(ctx, w)
+		return "auto_voided", nil
+	}
+	if w.SettlementEscalatedAt != "" {
+		return "", nil
+	}
+	w.SettlementEscalatedAt = now.Format(time.RFC3339Nano)
+	if err := s.persistWager(ctx, w, "wager.settlement_overdue"); err != nil {
+		return "", err
+	}
+	if s.useInMemoryWagerMirror() {
+		s.wagers[w.WagerId] = cloneWager(w)
+	}
+	after, _ := json.Marshal(w)
+	if err := s.appendAudit(nil, w.WagerId, "escalate_settlement", before, after, audit.ResultSuccess, "settlement sla exceeded"); err != nil {
+		return "", err
+	}
+	return "escalated", nil
+}
+
+// SettlementMonitorJob escalates overdue wagers and auto-voids those past the
+// auto-void deadline. A run with per-wager failures fails after the rest of
+// the sweep completes.
+func (s *WageringService) SettlementMonitorJob() JobFunc {
+	return func(ctx context.Context, _ string) (string, error) {
+		escalated, voided, err := s.SweepOverdueWagers(ctx)
+		if escalated == 0 && voided == 0 {
+			return "", err
+		}
+		return fmt.Sprintf("escalated=%d voided=%d", escalated, voided), err
+	}
+}
@@ -0,0 +1,219 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+func placeTestWager(t *testing.T, svc *WageringService, playerID, idem string) *rgsv1.Wager {
+	t.Helper()
+	resp, err := svc.PlaceWager(context.Background(), &rgsv1.PlaceWagerRequest{
+		Meta:     meta(playerID, rgsv1.ActorType_ACTOR_TYPE_PLAYER, idem),
+		PlayerId: playerID,
+		GameId:   "game-1",
+		Stake:    &rgsv1.Money{AmountMinor: 100, Currency: "USD"},
+	})
+	if err != nil || resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("place wager failed: err=%v meta=%+v", err, resp.GetMeta())
+	}
+	return resp.Wager
+}
+
+func TestWageringOverdueEscalationAndAutoVoid(t *testing.T) {
+	start := time.Date(2026, 2, 15, 10, 0, 0, 0, time.UTC)
+	svc := NewWageringService(ledgerFixedClock{now: start})
+	svc.SetSettlementPolicy(WagerSettlementPolicy{SLA: 10 * time.Minute, AutoVoidAfter: time.Hour})
+	var outcomes []string
+	var sweeps [][4]int
+	svc.SetMetricsObservers(
+		func(outcome string, _ time.Duration) { outcomes = append(outcomes, outcome) },
+		func(overdue, escalated, voided, failed int) {
+			sweeps = append(sweeps, [4]int{overdue, escalated, voided, failed})
+		},
+	)
+	ctx := context.Background()
+
+	old := placeTestWager(t, svc, "player-1", "idem-old")
+	svc.Clock = ledgerFixedClock{now: start.Add(55 * time.Minute)}
+	fresh := placeTestWager(t, svc, "player-2", "idem-fresh")
+
+	svc.Clock = ledgerFixedClock{now: start.Add(15 * time.Minute)}
+	listed, _ := svc.ListOverdueWagers(ctx, &rgsv1.ListOverdueWagersRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")})
+	if listed.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || len(listed.Wagers) != 1 {
+		t.Fatalf("expected one overdue wager, got=%+v", listed)
+	}
+	if listed.Wagers[0].Wager.GetWagerId() != old.WagerId || listed.Wagers[0].PendingSeconds != 900 || listed.SettlementSlaSeconds != 600 {
+		t.Fatalf("unexpected overdue entry: %+v", listed.Wagers[0])
+	}
+
+	escalated, voided, err := svc.SweepOverdueWagers(ctx)
+	if err != nil || escalated != 1 || voided != 0 {
+		t.Fatalf("expected one escalation, got escalated=%d voided=%d err=%v", escalated, voided, err)
+	}
+	if escalated, _, _ := svc.SweepOverdueWagers(ctx); escalated != 0 {
+		t.Fatalf("expected escalation to happen once, got=%d", escalated)
+	}
+
+	svc.Clock = ledgerFixedClock{now: start.Add(70 * time.Minute)}
+	escalated, voided, err = svc.SweepOverdueWagers(ctx)
+	if err != nil || escalated != 1 || voided != 1 {
+		t.Fatalf("expected fresh escalation and old auto-void, got escalated=%d voided=%d err=%v", escalated, voided, err)
+	}
+	if w := svc.wagers[old.WagerId]; w.GetStatus() != rgsv1.WagerStatus_WAGER_STATUS_VOIDED || w.GetVoidedAt() == "" || w.GetVoidReason() != "settlement deadline exceeded" || w.GetCanceledAt() != "" {
+		t.Fatalf("expected the overdue wager voided without a refund, got=%+v", w)
+	}
+	listed, _ = svc.ListOverdueWagers(ctx, &rgsv1.ListOverdueWagersRequest{Meta: meta("svc-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, "")})
+	if len(listed.Wagers) != 1 || listed.Wagers[0].Wager.GetWagerId() != fresh.WagerId || !listed.Wagers[0].Escalated {
+		t.Fatalf("expected only escalated fresh wager to remain overdue, got=%+v", listed.Wagers)
+	}
+
+	settle, _ := svc.SettleWager(ctx, &rgsv1.SettleWagerRequest{
+		Meta:       meta("svc-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, "idem-settle-voided"),
+		WagerId:    old.WagerId,
+		Payout:     &rgsv1.Money{AmountMinor: 50, Currency: "USD"},
+		OutcomeRef: "late-outcome",
+	})
+	if settle.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected voided wager to reject settlement, got=%v", settle.Meta.GetResultCode())
+	}
+	if len(outcomes) != 1 || outcomes[0] != "auto_voided" {
+		t.Fatalf("expected auto_voided latency observation, got=%v", outcomes)
+	}
+	if len(sweeps) != 3 || sweeps[2] != [4]int{1, 1, 1, 0} {
+		t.Fatalf("unexpected sweep observations: %v", sweeps)
+	}
+
+	actions := map[string]int{}
+	for _, ev := range auditEvents(svc.AuditStore) {
+		actions[ev.Action]++
+	}
+	if actions["escalate_settlement"] != 2 || actions["auto_void_wager"] != 1 {
+		t.Fatalf("expected escalation and auto-void audit events, got=%v", actions)
+	}
+}
+
+func TestWageringSettlementTimeoutEscalatesInsteadOfVoiding(t *testing.T) {
+	start := time.Date(2026, 2, 15, 10, 0, 0, 0, time.UTC)
+	svc := NewWageringService(ledgerFixedClock{now: start})
+	svc.SetSettlementPolicy(WagerSettlementPolicy{SLA: 10 * time.Minute, AutoVoidAfter: time.Hour, EscalateOnTimeout: true})
+	ctx := context.Background()
+	w := placeTestWager(t, svc, "player-1", "idem-timeout")
+
+	svc.Clock = ledgerFixedClock{now: start.Add(15 * time.Minute)}
+	if escalated, _, _ := svc.SweepOverdueWagers(ctx); escalated != 1 {
+		t.Fatalf("expected sla escalation, got=%d", escalated)
+	}
+	svc.Clock = ledgerFixedClock{now: start.Add(61 * time.Minute)}
+	escalated, voided, err := svc.SweepOverdueWagers(ctx)
+	if err != nil || escalated != 1 || voided != 0 {
+		t.Fatalf("expected timeout escalation without void, got escalated=%d voided=%d err=%v", escalated, voided, err)
+	}
+	if escalated, _, _ := svc.SweepOverdueWagers(ctx); escalated != 0 {
+		t.Fatalf("expected timeout escalation to happen once, got=%d", escalated)
+	}
+	listed, _ := svc.ListOverdueWagers(ctx, &rgsv1.ListOverdueWagersRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "")})
+	if len(listed.Wagers) != 1 || listed.Wagers[0].Wager.GetStatus() != rgsv1.WagerStatus_WAGER_STATUS_PENDING || listed.Wagers[0].AutoVoidAt != "" {
+		t.Fatalf("expected wager left pending without auto-void deadline, got=%+v", listed.Wagers)
+	}
+	if got := listed.Wagers[0].Wager.GetSettlementEscalatedAt(); got != start.Add(61*time.Minute).Format(time.RFC3339Nano) {
+		t.Fatalf("expected escalation restamped at timeout, got=%s", got)
+	}
+	settle, _ := svc.SettleWager(ctx, &rgsv1.SettleWagerRequest{
+		Meta:       meta("svc-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, "idem-timeout-settle"),
+		WagerId:    w.WagerId,
+		Payout:     &rgsv1.Money{AmountMinor: 40, Currency: "USD"},
+		OutcomeRef: "manual-review",
+	})
+	if settle.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected escalated wager to remain settleable, got=%+v", settle.Meta)
+	}
+	actions := map[string]int{}
+	for _, ev := range auditEvents(svc.AuditStore) {
+		actions[ev.Action]++
+	}
+	if actions["escalate_settlement"] != 1 || actions["escalate_settlement_timeout"] != 1 || actions["auto_void_wager"] != 0 {
+		t.Fatalf("unexpected audit actions: %v", actions)
+	}
+}
+
+func TestWageringSettlementLatencyObserved(t *testing.T) {
+	start := time.Date(2026, 2, 15, 10, 0, 0, 0, time.UTC)
+	svc := NewWageringService(ledgerFixedClock{now: start})
+	var latency time.Duration
+	svc.SetMetricsObservers(func(outcome string, d time.Duration) {
+		if outcome == "settled" {
+			latency = d
+		}
+	}, nil)
+	w := placeTestWager(t, svc, "player-1", "idem-latency")
+	svc.Clock = ledgerFixedClock{now: start.Add(42 * time.Second)}
+	_, _ = svc.SettleWager(context.Background(), &rgsv1.SettleWagerRequest{
+		Meta:       meta("svc-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, "idem-latency-settle"),
+		WagerId:    w.WagerId,
+		Payout:     &rgsv1.Money{AmountMinor: 10, Currency: "USD"},
+		OutcomeRef: "outcome-1",
+	})
+	if latency != 42*time.Second {
+		t.Fatalf("expected 42s settlement latency, got=%s", latency)
+	}
+}
+
+func TestWageringListOverdueDeniedForPlayer(t *testing.T) {
+	svc := NewWageringService(ledgerFixedClock{now: time.Date(2026, 2, 15, 10, 0, 0, 0, time.UTC)})
+	resp, _ := svc.ListOverdueWagers(context.Background(), &rgsv1.ListOverdueWagersRequest{Meta: meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "")})
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected denied, got=%v", resp.Meta.GetResultCode())
+	}
+	events := auditEvents(svc.AuditStore)
+	if len(events) != 1 || events[0].Result != audit.ResultDenied {
+		t.Fatalf("expected denied audit event, got=%+v", events)
+	}
+}
+
+// failingAuditStore rejects audit events for one object and records the rest.
+type failingAuditStore struct {
+	objectID string
+	events   []audit.Event
+}
+
+func (f *failingAuditStore) Append(ev audit.Event) (audit.Event, error) {
+	if ev.ObjectID == f.objectID {
+		return audit.Event{}, errors.New("audit store unavailable")
+	}
+	f.events = append(f.events, ev)
+	return ev, nil
+}
+
+func TestWageringSweepContinuesPastFailedWager(t *testing.T) {
+	start := time.Date(2026, 2, 15, 10, 0, 0, 0, time.UTC)
+	svc := NewWageringService(ledgerFixedClock{now: start})
+	svc.SetSettlementPolicy(WagerSettlementPolicy{SLA: 10 * time.Minute, AutoVoidAfter: time.Hour})
+	var failedObserved int
+	svc.SetMetricsObservers(nil, func(_, _, _, failed int) { failedObserved = failed })
+	ctx := context.Background()
+	first := placeTestWager(t, svc, "player-1", "idem-first")
+	second := placeTestWager(t, svc, "player-2", "idem-second")
+	store := &failingAuditStore{objectID: first.WagerId}
+	svc.AuditStore = store
+
+	svc.Clock = ledgerFixedClock{now: start.Add(2 * time.Hour)}
+	_, voided, err := svc.SweepOverdueWagers(ctx)
+	if err == nil || !strings.Contains(err.Error(), first.WagerId) {
+		t.Fatalf("expected the failed wager reported, got err=%v", err)
+	}
+	if voided != 1 || failedObserved != 1 {
+		t.Fatalf("expected the sweep to continue past the failure, voided=%d failed=%d", voided, failedObserved)
+	}
+	if w := svc.wagers[second.WagerId]; w.GetStatus() != rgsv1.WagerStatus_WAGER_STATUS_VOIDED {
+		t.Fatalf("expected the second wager voided, got=%+v", w)
+	}
+	if len(store.events) != 1 || store.events[0].ObjectID != second.WagerId || store.events[0].Action != "auto_void_wager" {
+		t.Fatalf("expected the second void audited, got=%+v", store.events)
+	}
+}
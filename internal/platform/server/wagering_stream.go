@@ -0,0 +1,181 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+// wagerResultStreamBuffer bounds the updates queued for one device stream. A
+// device that falls further behind is disconnected rather than stalling
+// settlement.
+const wagerResultStreamBuffer = 64
+
+type wagerResultSubscription struct {
+	updates chan *rgsv1.WagerResultUpdate
+	reason  string
+}
+
+// wagerResultHub holds one result stream per device. A new registration for
+// a device replaces the previous one, so a reconnecting cabinet never
+// receives outcomes twice.
+type wagerResultHub struct {
+	mu   sync.Mutex
+	subs map[string]*wagerResultSubscription
+}
+
+func newWagerResultHub() *wagerResultHub {
+	return &wagerResultHub{subs: make(map[string]*wagerResultSubscription)}
+}
+
+func (h *wagerResultHub) register(deviceID string) *wagerResultSubscription {
+	sub := &wagerResultSubscription{updates: make(chan *rgsv1.WagerResultUpdate, wagerResultStreamBuffer)}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if prev := h.subs[deviceID]; prev != nil {
+		h.dropLocked(deviceID, prev, "stream replaced by a newer registration")
+	}
+	h.subs[deviceID] = sub
+	return sub
+}
+
+func (h *wagerResultHub) unregister(deviceID string, sub *wagerResultSubscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.subs[deviceID] == sub {
+		delete(h.subs, deviceID)
+		close(sub.updates)
+	}
+}
+
+func (h *wagerResultHub) dropLocked(deviceID string, sub *wagerResultSubscription, reason string) {
+	sub.reason = reason
+	close(sub.updates)
+	delete(h.subs, deviceID)
+}
+
+func (h *wagerResultHub) registered(deviceID string) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.subs[deviceID] != nil
+}
+
+func (h *wagerResultHub) publish(deviceID string, update *rgsv1.WagerResultUpdate) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	sub := h.subs[deviceID]
+	if sub == nil {
+		return
+	}
+	select {
+	case sub.updates <- update:
+	default:
+		h.dropLocked(deviceID, sub, "stream fell behind")
+	}
+}
+
+func (h *wagerResultHub) closeReason(sub *wagerResultSubscription) string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return sub.reason
+}
+
+// publishResult pushes a wager outcome to the stream registered by the device
+// that placed the wager. Delivery is best-effort: a device reconciles
+// outcomes it missed while disconnected through ListWagers.
+func (s *WageringService) publishResult(ctx context.Context, w *rgsv1.Wager) {
+	if s.results == nil || w == nil || w.DeviceId == "" || !s.results.registered(w.DeviceId) {
+		return
+	}
+	update := &rgsv1.WagerResultUpdate{
+		Wager:       cloneWager(w),
+		PublishedAt: s.now().Format(time.RFC3339Nano),
+	}
+	if s.Ledger != nil {
+		if balance, err := s.Ledger.availableBalance(ctx, w.PlayerId); err == nil {
+			update.AvailableBalance = balance
+		}
+	}
+	s.results.publish(w.DeviceId, update)
+}
+
+// authorizeResultStream admits operators for any device and service actors
+// only for the device they authenticate as.
+func (s *WageringService) authorizeResultStream(ctx context.Context, meta *rgsv1.RequestMeta, deviceID string) (bool, string) {
+	actor, reason := resolveActor(ctx, meta)
+	if reason != "" {
+		return false, reason
+	}
+	switch actor.ActorType {
+	case rgsv1.ActorType_ACTOR_TYPE_OPERATOR:
+		return true, ""
+	case rgsv1.ActorType_ACTOR_TYPE_SERVICE:
+		if actor.ActorId != deviceID {
+			return false, "device cannot stream results for another device"
+		}
+		return true, ""
+	default:
+		return false, "unauthorized actor type"
+	}
+}
+
+// StreamWagerResults registers the device's result stream and pushes the
+// outcome of every wager it placed as soon as the outcome commits. The device
+// must be ACTIVE in the registry. The first message acknowledges the
+// registration; a stream that is replaced or falls behind ends with an ERROR
+// message.
+func (s *WageringService) StreamWagerResults(req *rgsv1.StreamWagerResultsRequest, stream rgsv1.WageringService_StreamWagerResultsServer) error {
+	ctx := stream.Context()
+	if req == nil || req.DeviceId == "" {
+		return stream.Send(&rgsv1.StreamWagerResultsResponse{Meta: s.responseMeta(req.GetMeta(), rgsv1.ResultCode_RESULT_CODE_INVALID, "device_id is required")})
+	}
+	if ok, reason := s.authorizeResultStream(ctx, req.Meta, req.DeviceId); !ok {
+		_ = s.appendAudit(req.Meta, req.DeviceId, "stream_wager_results", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		return stream.Send(&rgsv1.StreamWagerResultsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)})
+	}
+	if s.Registry == nil || s.results == nil {
+		return stream.Send(&rgsv1.StreamWagerResultsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "registry unavailable")})
+	}
+	eq, err := s.Registry.lookupEquipment(ctx, req.DeviceId)
+	if err != nil {
+		return stream.Send(&rgsv1.StreamWagerResultsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")})
+	}
+	if eq == nil {
+		return stream.Send(&rgsv1.StreamWagerResultsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "device not registered")})
+	}
+	if eq.Status != rgsv1.EquipmentStatus_EQUIPMENT_STATUS_ACTIVE {
+		reason := "device is not active"
+		_ = s.appendAudit(req.Meta, req.DeviceId, "stream_wager_results", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		return stream.Send(&rgsv1.StreamWagerResultsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)})
+	}
+
+	sub := s.results.register(req.DeviceId)
+	defer s.results.unregister(req.DeviceId, sub)
+	after, _ := json.Marshal(map[string]string{"device_id": req.DeviceId})
+	s.mu.Lock()
+	err = s.appendAudit(req.Meta, req.DeviceId, "stream_wager_results", []byte(`{}`), after, audit.ResultSuccess, "")
+	s.mu.Unlock()
+	if err != nil {
+		return stream.Send(&rgsv1.StreamWagerResultsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")})
+	}
+	if err := stream.Send(&rgsv1.StreamWagerResultsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, "")}); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case update, ok := <-sub.updates:
+			if !ok {
+				return stream.Send(&rgsv1.StreamWagerResultsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, s.results.closeReason(sub))})
+			}
+			if err := stream.Send(&rgsv1.StreamWagerResultsResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, ""), Update: update}); err != nil {
+				return err
+			}
+		}
+	}
+}
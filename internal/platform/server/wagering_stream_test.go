@@ -0,0 +1,140 @@
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func newWagerResultStreamClient(t *testing.T, svc *WageringService) rgsv1.WageringServiceClient {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	rgsv1.RegisterWageringServiceServer(srv, svc)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("dial bufconn: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return rgsv1.NewWageringServiceClient(conn)
+}
+
+func registerTestEquipment(t *testing.T, registry *RegistryService, id string, status rgsv1.EquipmentStatus) {
+	t.Helper()
+	resp, _ := registry.UpsertEquipment(context.Background(), &rgsv1.UpsertEquipmentRequest{
+		Meta:      meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, ""),
+		Equipment: &rgsv1.Equipment{EquipmentId: id, Location: "floor-1", Status: status},
+	})
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("register equipment %s: %+v", id, resp.Meta)
+	}
+}
+
+func openResultStream(t *testing.T, ctx context.Context, client rgsv1.WageringServiceClient, actorID string, actorType rgsv1.ActorType, deviceID string) (rgsv1.WageringService_StreamWagerResultsClient, *rgsv1.StreamWagerResultsResponse) {
+	t.Helper()
+	stream, err := client.StreamWagerResults(ctx, &rgsv1.StreamWagerResultsRequest{Meta: meta(actorID, actorType, ""), DeviceId: deviceID})
+	if err != nil {
+		t.Fatalf("open stream: %v", err)
+	}
+	first, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("receive first message: %v", err)
+	}
+	return stream, first
+}
+
+func TestWagerResultStreamPushesOutcomesToOriginatingDevice(t *testing.T) {
+	svc, ledger := newLedgerIntegratedWagering(t, "player-1", 1000)
+	registry := NewRegistryService(svc.Clock)
+	registerTestEquipment(t, registry, "cab-1", rgsv1.EquipmentStatus_EQUIPMENT_STATUS_ACTIVE)
+	registerTestEquipment(t, registry, "cab-2", rgsv1.EquipmentStatus_EQUIPMENT_STATUS_ACTIVE)
+	svc.Registry = registry
+	client := newWagerResultStreamClient(t, svc)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, ack := openResultStream(t, ctx, client, "cab-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, "cab-1")
+	if ack.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || ack.Update != nil {
+		t.Fatalf("expected registration ack, got=%+v", ack)
+	}
+
+	place := func(deviceID, idem string) *rgsv1.Wager {
+		m := meta("player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, idem)
+		m.Source = &rgsv1.Source{DeviceId: deviceID}
+		resp, _ := svc.PlaceWager(ctx, &rgsv1.PlaceWagerRequest{Meta: m, PlayerId: "player-1", GameId: "game-1", Stake: &rgsv1.Money{AmountMinor: 100, Currency: "USD"}})
+		if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || resp.Wager.GetDeviceId() != deviceID {
+			t.Fatalf("place wager from %s: %+v", deviceID, resp)
+		}
+		return resp.Wager
+	}
+	other := place("cab-2", "stream-place-other")
+	mine := place("cab-1", "stream-place-mine")
+	_, _ = svc.CancelWager(ctx, &rgsv1.CancelWagerRequest{Meta: meta("svc-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, "stream-cancel-other"), WagerId: other.WagerId, Reason: "game fault"})
+	_, _ = svc.SettleWager(ctx, &rgsv1.SettleWagerRequest{Meta: meta("svc-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, "stream-settle-mine"), WagerId: mine.WagerId, Payout: &rgsv1.Money{AmountMinor: 250, Currency: "USD"}, OutcomeRef: "win"})
+
+	got, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("receive update: %v", err)
+	}
+	update := got.GetUpdate()
+	if update.GetWager().GetWagerId() != mine.WagerId || update.GetWager().GetStatus() != rgsv1.WagerStatus_WAGER_STATUS_SETTLED {
+		t.Fatalf("expected only the settlement of this device's wager, got=%+v", got)
+	}
+	if want := ledgerAvailable(t, ledger, "player-1"); update.GetAvailableBalance().GetAmountMinor() != want || want != 1150 {
+		t.Fatalf("expected post-settlement balance 1150, got=%+v ledger=%d", update.GetAvailableBalance(), want)
+	}
+
+	_, ack = openResultStream(t, ctx, client, "op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "cab-1")
+	if ack.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("expected operator registration, got=%+v", ack.Meta)
+	}
+	closed, err := stream.Recv()
+	if err != nil || closed.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_ERROR || closed.Meta.GetDenialReason() != "stream replaced by a newer registration" {
+		t.Fatalf("expected replaced stream to be closed, got=%+v err=%v", closed, err)
+	}
+}
+
+func TestWagerResultStreamRegistrationChecks(t *testing.T) {
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 23, 10, 0, 0, 0, time.UTC)}
+	svc := NewWageringService(clk)
+	client := newWagerResultStreamClient(t, svc)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if _, resp := openResultStream(t, ctx, client, "cab-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, "cab-1"); resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_ERROR {
+		t.Fatalf("expected error without registry, got=%+v", resp.Meta)
+	}
+	registry := NewRegistryService(clk)
+	registerTestEquipment(t, registry, "cab-1", rgsv1.EquipmentStatus_EQUIPMENT_STATUS_ACTIVE)
+	registerTestEquipment(t, registry, "cab-3", rgsv1.EquipmentStatus_EQUIPMENT_STATUS_MAINTENANCE)
+	svc.Registry = registry
+
+	cases := []struct {
+		actorID   string
+		actorType rgsv1.ActorType
+		deviceID  string
+		want      rgsv1.ResultCode
+	}{
+		{"cab-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, "", rgsv1.ResultCode_RESULT_CODE_INVALID},
+		{"cab-2", rgsv1.ActorType_ACTOR_TYPE_SERVICE, "cab-1", rgsv1.ResultCode_RESULT_CODE_DENIED},
+		{"player-1", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "cab-1", rgsv1.ResultCode_RESULT_CODE_DENIED},
+		{"cab-9", rgsv1.ActorType_ACTOR_TYPE_SERVICE, "cab-9", rgsv1.ResultCode_RESULT_CODE_INVALID},
+		{"cab-3", rgsv1.ActorType_ACTOR_TYPE_SERVICE, "cab-3", rgsv1.ResultCode_RESULT_CODE_DENIED},
+	}
+	for _, tc := range cases {
+		if _, resp := openResultStream(t, ctx, client, tc.actorID, tc.actorType, tc.deviceID); resp.Meta.GetResultCode() != tc.want {
+			t.Fatalf("%s streaming %q: expected %s, got=%+v", tc.actorID, tc.deviceID, tc.want, resp.Meta)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+// VoidWager voids a pending wager and, when its stake was debited through
+// the ledger, refunds it in the same transaction as the wager. The outbox
+// event is wager.void_refunded for a refunded wager and wager.voided
+// otherwise.
+func (s *WageringService) VoidWager(ctx context.Context, req *rgsv1.VoidWagerRequest) (*rgsv1.VoidWagerResponse, error) {
+	if req == nil || req.WagerId == "" || req.Reason == "" {
+		return &rgsv1.VoidWagerResponse{Meta: s.responseMeta(req.GetMeta(), rgsv1.ResultCode_RESULT_CODE_INVALID, "wager_id and reason are required")}, nil
+	}
+	if idempotency(req.Meta) == "" {
+		return &rgsv1.VoidWagerResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "idempotency_key is required")}, nil
+	}
+	if ok, reason := s.authorizeSettlement(ctx, req.Meta); !ok {
+		_ = s.appendAudit(req.Meta, req.WagerId, "void_wager", []byte(`{}`), []byte(`{}`), audit.ResultDenied, reason)
+		return &rgsv1.VoidWagerResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_DENIED, reason)}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	idem := idempotency(req.Meta)
+	idemKey := req.WagerId + "|void|" + idem
+	requestHash := hashWageringRequest("void", req.WagerId, req.Reason)
+	if s.useInMemoryCache() {
+		if prev := s.voidByIdempotency[idemKey]; prev != nil {
+			return cloneVoidResponse(prev), nil
+		}
+	}
+	if s.dbEnabled() {
+		var replay rgsv1.VoidWagerResponse
+		found, err := s.loadIdempotencyResponse(ctx, "void", req.WagerId, idem, requestHash, &replay)
+		if err == errIdempotencyRequestMismatch {
+			return &rgsv1.VoidWagerResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "idempotency_key reused with different request")}, nil
+		}
+		if err != nil {
+			return &rgsv1.VoidWagerResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+		if found {
+			if s.useInMemoryCache() {
+				s.voidByIdempotency[idemKey] = cloneVoidResponse(&replay)
+			}
+			if replay.Wager != nil && s.useInMemoryWagerMirror() {
+				s.wagers[replay.Wager.WagerId] = cloneWager(replay.Wager)
+			}
+			return &replay, nil
+		}
+	}
+
+	var wager *rgsv1.Wager
+	if s.useInMemoryWagerMirror() {
+		wager = s.wagers[req.WagerId]
+	}
+	if wager == nil && s.dbEnabled() {
+		var err error
+		wager, err = s.getWager(ctx, req.WagerId)
+		if err != nil {
+			return &rgsv1.VoidWagerResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+		}
+		if wager != nil && s.useInMemoryWagerMirror() {
+			s.wagers[wager.WagerId] = cloneWager(wager)
+		}
+	}
+	if wager == nil {
+		return &rgsv1.VoidWagerResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "wager not found")}, nil
+	}
+	if wager.Status != rgsv1.WagerStatus_WAGER_STATUS_PENDING {
+		return &rgsv1.VoidWagerResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_INVALID, "wager is not pending")}, nil
+	}
+
+	refund, err := s.prepareStakeRefundLocked(ctx, req.Meta, wager)
+	if err != nil {
+		code, reason := wagerLedgerFailure(err)
+		return &rgsv1.VoidWagerResponse{Meta: s.responseMeta(req.Meta, code, reason)}, nil
+	}
+
+	before, _ := json.Marshal(wager)
+	voidedAt := s.now()
+	voided := cloneWager(wager)
+	voided.Status = rgsv1.WagerStatus_WAGER_STATUS_VOIDED
+	voided.VoidReason = req.Reason
+	voided.VoidedAt = voidedAt.Format(time.RFC3339Nano)
+	eventType := "wager.voided"
+	resp := &rgsv1.VoidWagerResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_OK, "")}
+	if refund != nil {
+		voided.RefundTransactionId = refund.tx.TransactionId
+		resp.RefundTransaction = transactionCopy(refund.tx)
+		eventType = "wager.void_refunded"
+	}
+	after, _ := json.Marshal(voided)
+	resp.Wager = cloneWager(voided)
+	if err := s.persistWagerWithLedger(ctx, voided, eventType, refund); err != nil {
+		return &rgsv1.VoidWagerResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, wagerPersistFailure(err))}, nil
+	}
+	if err := s.persistIdempotencyResponse(ctx, "void", req.WagerId, idem, requestHash, resp); err != nil {
+		return &rgsv1.VoidWagerResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "persistence unavailable")}, nil
+	}
+	if s.useInMemoryWagerMirror() {
+		s.wagers[voided.WagerId] = voided
+	}
+	if s.useInMemoryCache() {
+		s.voidByIdempotency[idemKey] = cloneVoidResponse(resp)
+	}
+	if err := s.appendAudit(req.Meta, req.WagerId, "void_wager", before, after, audit.ResultSuccess, req.Reason); err != nil {
+		return &rgsv1.VoidWagerResponse{Meta: s.responseMeta(req.Meta, rgsv1.ResultCode_RESULT_CODE_ERROR, "audit unavailable")}, nil
+	}
+	s.observeSettlementLocked(voided, "voided", voidedAt)
+	s.recordSessionActivity(ctx, voided, -1, -voided.GetStake().GetAmountMinor(), 0)
+	s.publishResult(ctx, voided)
+	return resp, nil
+}
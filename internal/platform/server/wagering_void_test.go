@@ -0,0 +1,146 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rgsv1 "github.com/wizardbeardstudio/open-rgs-go/gen/rgs/v1"
+	"github.com/wizardbeardstudio/open-rgs-go/internal/platform/audit"
+)
+
+func newVoidTestWagering(t *testing.T, ledgerIntegration bool) (*WageringService, *LedgerService, *rgsv1.Wager) {
+	t.Helper()
+	clk := ledgerFixedClock{now: time.Date(2026, 2, 22, 14, 0, 0, 0, time.UTC)}
+	ledger := NewLedgerService(clk)
+	svc := NewWageringService(clk)
+	svc.Ledger = ledger
+	svc.SetLedgerIntegration(ledgerIntegration)
+	ctx := context.Background()
+	_, _ = ledger.Deposit(ctx, &rgsv1.DepositRequest{
+		Meta:      meta("player-void", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "seed"),
+		AccountId: "player-void",
+		Amount:    &rgsv1.Money{AmountMinor: 1000, Currency: "USD"},
+	})
+	placed, _ := svc.PlaceWager(ctx, &rgsv1.PlaceWagerRequest{
+		Meta:     meta("player-void", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "place-void"),
+		PlayerId: "player-void",
+		GameId:   "game-1",
+		Stake:    &rgsv1.Money{AmountMinor: 300, Currency: "USD"},
+	})
+	if placed.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("place wager failed: %+v", placed.Meta)
+	}
+	return svc, ledger, placed.Wager
+}
+
+func TestWageringVoidRefundsStakeThroughLedger(t *testing.T) {
+	svc, ledger, wager := newVoidTestWagering(t, true)
+	ctx := context.Background()
+	req := &rgsv1.VoidWagerRequest{
+		Meta:    meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "void-1"),
+		WagerId: wager.WagerId,
+		Reason:  "game malfunction",
+	}
+	resp, err := svc.VoidWager(ctx, req)
+	if err != nil || resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("void wager failed: err=%v meta=%+v", err, resp.GetMeta())
+	}
+	if resp.Wager.GetStatus() != rgsv1.WagerStatus_WAGER_STATUS_VOIDED || resp.Wager.GetVoidReason() != "game malfunction" || resp.Wager.GetVoidedAt() == "" {
+		t.Fatalf("unexpected voided wager: %+v", resp.Wager)
+	}
+	refund := resp.RefundTransaction
+	if refund.GetTransactionType() != rgsv1.LedgerTransactionType_LEDGER_TRANSACTION_TYPE_GAMEPLAY_CREDIT ||
+		refund.GetAmount().GetAmountMinor() != 300 || resp.Wager.GetRefundTransactionId() != refund.GetTransactionId() {
+		t.Fatalf("unexpected refund transaction: %+v", refund)
+	}
+
+	again, _ := svc.VoidWager(ctx, req)
+	if again.RefundTransaction.GetTransactionId() != refund.GetTransactionId() {
+		t.Fatalf("expected idempotent replay, got=%+v", again)
+	}
+	bal, _ := ledger.GetBalance(ctx, &rgsv1.GetBalanceRequest{Meta: meta("player-void", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""), AccountId: "player-void"})
+	if bal.AvailableBalance.GetAmountMinor() != 1000 {
+		t.Fatalf("expected stake refunded once, got=%d", bal.AvailableBalance.GetAmountMinor())
+	}
+
+	// A fresh idempotency key on a voided wager is rejected rather than
+	// refunding again.
+	other, _ := svc.VoidWager(ctx, &rgsv1.VoidWagerRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "void-2"), WagerId: wager.WagerId, Reason: "retry"})
+	if other.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected void of voided wager rejected, got=%+v", other.Meta)
+	}
+
+	events := auditEvents(svc.AuditStore)
+	last := events[len(events)-1]
+	if last.Action != "void_wager" || last.ObjectID != wager.WagerId || last.Result != audit.ResultSuccess || last.Reason != "game malfunction" {
+		t.Fatalf("unexpected void audit event: %+v", last)
+	}
+	ledgerEvents := ledger.AuditEvents()
+	if le := ledgerEvents[len(ledgerEvents)-1]; le.Action != "wager_refund" || le.ObjectID != "player-void" {
+		t.Fatalf("expected ledger refund audited, got=%+v", le)
+	}
+}
+
+func TestWageringVoidWithoutDebitedStakeLeavesBalancesAlone(t *testing.T) {
+	svc, ledger, wager := newVoidTestWagering(t, false)
+	ctx := context.Background()
+	if wager.StakeTransactionId != "" {
+		t.Fatalf("expected no stake debit without ledger integration, got %+v", wager)
+	}
+	resp, _ := svc.VoidWager(ctx, &rgsv1.VoidWagerRequest{Meta: meta("op-1", rgsv1.ActorType_ACTOR_TYPE_OPERATOR, "void-1"), WagerId: wager.WagerId, Reason: "game malfunction"})
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK || resp.Wager.GetStatus() != rgsv1.WagerStatus_WAGER_STATUS_VOIDED {
+		t.Fatalf("void wager failed: %+v", resp)
+	}
+	if resp.RefundTransaction != nil || resp.Wager.GetRefundTransactionId() != "" {
+		t.Fatalf("expected no refund for a stake that was never debited, got %+v", resp)
+	}
+	player, _ := ledger.GetBalance(ctx, &rgsv1.GetBalanceRequest{Meta: meta("player-void", rgsv1.ActorType_ACTOR_TYPE_PLAYER, ""), AccountId: "player-void"})
+	if player.AvailableBalance.GetAmountMinor() != 1000 {
+		t.Fatalf("expected the player balance unchanged, got=%d", player.AvailableBalance.GetAmountMinor())
+	}
+	if available, _, _, ok := ledger.accountBalance("operator_liability"); ok && available != 0 {
+		t.Fatalf("expected operator liability untouched, got=%d", available)
+	}
+}
+
+func TestWageringVoidRequiresOperatorOrService(t *testing.T) {
+	svc, _, wager := newVoidTestWagering(t, true)
+	resp, _ := svc.VoidWager(context.Background(), &rgsv1.VoidWagerRequest{
+		Meta:    meta("player-void", rgsv1.ActorType_ACTOR_TYPE_PLAYER, "void-p"),
+		WagerId: wager.WagerId,
+		Reason:  "changed my mind",
+	})
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_DENIED {
+		t.Fatalf("expected player denied, got=%v", resp.Meta.GetResultCode())
+	}
+	events := auditEvents(svc.AuditStore)
+	if last := events[len(events)-1]; last.Action != "void_wager" || last.Result != audit.ResultDenied {
+		t.Fatalf("expected denied void audited, got=%+v", last)
+	}
+}
+
+func TestWageringVoidRejectsSettledWager(t *testing.T) {
+	svc, _, wager := newVoidTestWagering(t, true)
+	ctx := context.Background()
+	settled, _ := svc.SettleWager(ctx, &rgsv1.SettleWagerRequest{
+		Meta:       meta("svc-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, "settle"),
+		WagerId:    wager.WagerId,
+		Payout:     &rgsv1.Money{AmountMinor: 600, Currency: "USD"},
+		OutcomeRef: "win",
+	})
+	if settled.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_OK {
+		t.Fatalf("settle wager failed: %+v", settled.Meta)
+	}
+	resp, _ := svc.VoidWager(ctx, &rgsv1.VoidWagerRequest{
+		Meta:    meta("svc-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, "void-s"),
+		WagerId: wager.WagerId,
+		Reason:  "late void",
+	})
+	if resp.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID || resp.Meta.GetDenialReason() != "wager is not pending" {
+		t.Fatalf("expected settled wager void rejected, got=%+v", resp.Meta)
+	}
+	if missing, _ := svc.VoidWager(ctx, &rgsv1.VoidWagerRequest{Meta: meta("svc-1", rgsv1.ActorType_ACTOR_TYPE_SERVICE, "void-m"), WagerId: wager.WagerId}); missing.Meta.GetResultCode() != rgsv1.ResultCode_RESULT_CODE_INVALID {
+		t.Fatalf("expected missing reason rejected, got=%+v", missing.Meta)
+	}
+}
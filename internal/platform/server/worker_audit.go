@@ -0,0 +1,54 @@
+package server
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// workerBatch is what one batch of a background job changed. from and to
+// bound the job's range field across the affected rows.
+type workerBatch struct {
+	affected int64
+	from     time.Time
+	to       time.Time
+}
+
+// workerAuditSummary is the After payload of the system-actor audit event a
+// background job writes for a run that changed data.
+type workerAuditSummary struct {
+	Job        string `json:"job"`
+	Batches    int    `json:"batches"`
+	Affected   int64  `json:"affected"`
+	RangeField string `json:"range_field"`
+	RangeFrom  string `json:"range_from,omitempty"`
+	RangeTo    string `json:"range_to,omitempty"`
+
+	from, to time.Time
+}
+
+func newWorkerAuditSummary(job, rangeField string) *workerAuditSummary {
+	return &workerAuditSummary{Job: job, RangeField: rangeField}
+}
+
+func (w *workerAuditSummary) add(b workerBatch) {
+	w.Batches++
+	if b.affected == 0 {
+		return
+	}
+	w.Affected += b.affected
+	if w.from.IsZero() || b.from.Before(w.from) {
+		w.from = b.from
+	}
+	if b.to.After(w.to) {
+		w.to = b.to
+	}
+}
+
+func (w *workerAuditSummary) snapshot() []byte {
+	if !w.from.IsZero() {
+		w.RangeFrom = w.from.UTC().Format(time.RFC3339Nano)
+		w.RangeTo = w.to.UTC().Format(time.RFC3339Nano)
+	}
+	b, _ := json.Marshal(w)
+	return b
+}
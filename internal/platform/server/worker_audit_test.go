@@ -0,0 +1,42 @@
+package server
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestWorkerAuditSummaryAggregatesBatches(t *testing.T) {
+	base := time.Date(2026, 2, 13, 10, 0, 0, 0, time.UTC)
+	summary := newWorkerAuditSummary("identity_session_cleanup", "expires_at")
+	summary.add(workerBatch{affected: 2, from: base.Add(time.Hour), to: base.Add(2 * time.Hour)})
+	summary.add(workerBatch{affected: 1, from: base, to: base})
+	summary.add(workerBatch{})
+
+	var got workerAuditSummary
+	if err := json.Unmarshal(summary.snapshot(), &got); err != nil {
+		t.Fatalf("decode summary: %v", err)
+	}
+	if got.Job != "identity_session_cleanup" || got.Batches != 3 || got.Affected != 3 {
+		t.Fatalf("unexpected summary counts: %+v", got)
+	}
+	if got.RangeField != "expires_at" || got.RangeFrom != "2026-02-13T10:00:00Z" || got.RangeTo != "2026-02-13T12:00:00Z" {
+		t.Fatalf("unexpected summary range: %+v", got)
+	}
+}
+
+func TestWorkerAuditSummaryOmitsRangeWhenNothingChanged(t *testing.T) {
+	summary := newWorkerAuditSummary("outbox_dispatch", "created_at")
+	summary.add(workerBatch{})
+
+	var got map[string]any
+	if err := json.Unmarshal(summary.snapshot(), &got); err != nil {
+		t.Fatalf("decode summary: %v", err)
+	}
+	if _, ok := got["range_from"]; ok {
+		t.Fatalf("expected no range for an empty run, got=%v", got)
+	}
+	if got["affected"] != float64(0) || got["batches"] != float64(1) {
+		t.Fatalf("unexpected empty summary: %v", got)
+	}
+}
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS system_incident_updates;
+DROP TABLE IF EXISTS system_incidents;
@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS system_incidents (
+    incident_id TEXT PRIMARY KEY,
+    title TEXT NOT NULL,
+    message TEXT NOT NULL DEFAULT '',
+    severity TEXT NOT NULL,
+    status TEXT NOT NULL,
+    affected_services JSONB NOT NULL DEFAULT '[]'::jsonb,
+    started_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    resolved_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_system_incidents_status_started
+    ON system_incidents(status, started_at DESC);
+
+CREATE TABLE IF NOT EXISTS system_incident_updates (
+    incident_id TEXT NOT NULL REFERENCES system_incidents(incident_id),
+    seq INTEGER NOT NULL,
+    status TEXT NOT NULL,
+    severity TEXT NOT NULL,
+    message TEXT NOT NULL DEFAULT '',
+    actor_id TEXT NOT NULL,
+    recorded_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (incident_id, seq)
+);
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS report_daily_packs (
+    gaming_day DATE PRIMARY KEY,
+    pack_id TEXT NOT NULL UNIQUE,
+    status TEXT NOT NULL,
+    operator_id TEXT NOT NULL DEFAULT '',
+    report_run_ids JSONB NOT NULL DEFAULT '[]'::jsonb,
+    artifacts JSONB NOT NULL DEFAULT '[]'::jsonb,
+    audit_event_count BIGINT NOT NULL DEFAULT 0,
+    audit_chain_verified BOOLEAN NOT NULL DEFAULT FALSE,
+    reconciliation JSONB NOT NULL DEFAULT '{}'::jsonb,
+    manifest_sha256 TEXT NOT NULL DEFAULT '',
+    signer_kid TEXT NOT NULL DEFAULT '',
+    signature TEXT NOT NULL DEFAULT '',
+    signature_alg TEXT NOT NULL DEFAULT '',
+    deliveries JSONB NOT NULL DEFAULT '[]'::jsonb,
+    generated_at TIMESTAMPTZ NOT NULL,
+    failure_reason TEXT NOT NULL DEFAULT '',
+    request_id TEXT NOT NULL DEFAULT '',
+    actor_id TEXT NOT NULL DEFAULT '',
+    actor_type TEXT NOT NULL DEFAULT '',
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,18 @@
+-- Transactional outbox: rows are written in the same transaction as the
+-- ledger/wagering mutation they describe and drained by the dispatcher.
+CREATE TABLE IF NOT EXISTS outbox_events (
+    event_id BIGSERIAL PRIMARY KEY,
+    aggregate_type TEXT NOT NULL,
+    aggregate_id TEXT NOT NULL,
+    event_type TEXT NOT NULL,
+    payload JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    published_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_events_unpublished
+    ON outbox_events(event_id)
+    WHERE published_at IS NULL;
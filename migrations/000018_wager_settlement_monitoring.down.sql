@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_wagers_pending_placed;
+
+ALTER TABLE wagers
+    DROP COLUMN IF EXISTS settlement_escalated_at;
@@ -0,0 +1,6 @@
+ALTER TABLE wagers
+    ADD COLUMN IF NOT EXISTS settlement_escalated_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_wagers_pending_placed
+    ON wagers(placed_at)
+    WHERE status = 'pending';
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS player_activity_daily;
+
+ALTER TABLE player_sessions
+    DROP COLUMN IF EXISTS won_minor,
+    DROP COLUMN IF EXISTS wagered_minor,
+    DROP COLUMN IF EXISTS wager_count,
+    DROP COLUMN IF EXISTS activity_currency;
@@ -0,0 +1,20 @@
+ALTER TABLE player_sessions
+    ADD COLUMN IF NOT EXISTS activity_currency CHAR(3) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS wager_count BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS wagered_minor BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS won_minor BIGINT NOT NULL DEFAULT 0;
+
+-- Per-player daily rollups maintained incrementally as wagers are placed,
+-- settled, or canceled and as sessions close, so trailing-period summaries
+-- read at most one row per day.
+CREATE TABLE IF NOT EXISTS player_activity_daily (
+    player_id TEXT NOT NULL,
+    activity_day DATE NOT NULL,
+    currency CHAR(3) NOT NULL DEFAULT '',
+    wager_count BIGINT NOT NULL DEFAULT 0,
+    wagered_minor BIGINT NOT NULL DEFAULT 0,
+    won_minor BIGINT NOT NULL DEFAULT 0,
+    time_played_seconds BIGINT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (player_id, activity_day)
+);
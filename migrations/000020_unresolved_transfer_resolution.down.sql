@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_cashless_unresolved_transfers_open_deadline;
+
+ALTER TABLE cashless_unresolved_transfers
+    DROP COLUMN IF EXISTS reversal_transaction_id,
+    DROP COLUMN IF EXISTS resolution_note,
+    DROP COLUMN IF EXISTS resolution,
+    DROP COLUMN IF EXISTS attempts,
+    DROP COLUMN IF EXISTS ack_deadline_at;
@@ -0,0 +1,11 @@
+ALTER TABLE cashless_unresolved_transfers
+    ADD COLUMN IF NOT EXISTS ack_deadline_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS attempts INTEGER NOT NULL DEFAULT 1,
+    ADD COLUMN IF NOT EXISTS resolution TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS resolution_note TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS reversal_transaction_id TEXT REFERENCES ledger_transactions(transaction_id);
+
+-- Supports the timeout sweep that reverses transfers devices never acknowledged.
+CREATE INDEX IF NOT EXISTS idx_cashless_unresolved_transfers_open_deadline
+    ON cashless_unresolved_transfers(ack_deadline_at)
+    WHERE status = 'open';
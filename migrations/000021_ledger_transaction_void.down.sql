@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS ux_ledger_transactions_voids;
+
+ALTER TABLE ledger_transactions
+    DROP COLUMN IF EXISTS voids_transaction_id;
+
+-- PostgreSQL cannot drop enum values; 'void' stays on ledger_transaction_type.
@@ -0,0 +1,9 @@
+ALTER TYPE ledger_transaction_type ADD VALUE IF NOT EXISTS 'void';
+
+ALTER TABLE ledger_transactions
+    ADD COLUMN IF NOT EXISTS voids_transaction_id TEXT REFERENCES ledger_transactions(transaction_id);
+
+-- A transaction can be voided at most once, even across replicas.
+CREATE UNIQUE INDEX IF NOT EXISTS ux_ledger_transactions_voids
+    ON ledger_transactions(voids_transaction_id)
+    WHERE voids_transaction_id IS NOT NULL;
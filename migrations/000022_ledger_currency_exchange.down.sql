@@ -0,0 +1,10 @@
+ALTER TABLE ledger_accounts
+    DROP CONSTRAINT IF EXISTS ck_ledger_accounts_player_available_nonnegative;
+ALTER TABLE ledger_accounts
+    ADD CONSTRAINT ledger_accounts_available_balance_minor_check
+    CHECK (available_balance_minor >= 0) NOT VALID;
+
+DROP INDEX IF EXISTS idx_ledger_currency_exchanges_account;
+DROP TABLE IF EXISTS ledger_currency_exchanges;
+
+-- PostgreSQL cannot drop enum values; 'currency_exchange' and 'fx_gain_loss' stay.
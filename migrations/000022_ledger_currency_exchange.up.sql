@@ -0,0 +1,28 @@
+ALTER TYPE ledger_transaction_type ADD VALUE IF NOT EXISTS 'currency_exchange';
+ALTER TYPE ledger_account_type ADD VALUE IF NOT EXISTS 'fx_gain_loss';
+
+-- One row per ExchangeCurrency transaction, recording the rate applied so the
+-- conversion can be re-derived from the books alone.
+CREATE TABLE IF NOT EXISTS ledger_currency_exchanges (
+    transaction_id TEXT PRIMARY KEY REFERENCES ledger_transactions(transaction_id),
+    account_id TEXT NOT NULL,
+    from_account_id TEXT NOT NULL,
+    to_account_id TEXT NOT NULL,
+    from_currency CHAR(3) NOT NULL,
+    from_amount_minor BIGINT NOT NULL CHECK (from_amount_minor > 0),
+    to_currency CHAR(3) NOT NULL,
+    to_amount_minor BIGINT NOT NULL CHECK (to_amount_minor > 0),
+    rate TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_ledger_currency_exchanges_account
+    ON ledger_currency_exchanges(account_id, created_at DESC);
+
+-- House accounts (operator liability, escrow, FX gain/loss) carry positions
+-- in both directions; only player balances must stay non-negative.
+ALTER TABLE ledger_accounts
+    DROP CONSTRAINT IF EXISTS ledger_accounts_available_balance_minor_check;
+ALTER TABLE ledger_accounts
+    ADD CONSTRAINT ck_ledger_accounts_player_available_nonnegative
+    CHECK (account_type <> 'player_cashless' OR available_balance_minor >= 0);
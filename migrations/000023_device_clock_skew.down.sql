@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_device_clock_skew_flagged;
+DROP TABLE IF EXISTS device_clock_skew;
+
+ALTER TABLE meter_records
+    DROP COLUMN IF EXISTS clock_skew_ms;
+
+ALTER TABLE significant_events
+    DROP COLUMN IF EXISTS clock_skew_ms;
@@ -0,0 +1,22 @@
+ALTER TABLE significant_events
+    ADD COLUMN IF NOT EXISTS clock_skew_ms BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE meter_records
+    ADD COLUMN IF NOT EXISTS clock_skew_ms BIGINT NOT NULL DEFAULT 0;
+
+-- Rolling clock skew per device, updated on every ingested event or meter
+-- whose occurred_at was supplied by the device.
+CREATE TABLE IF NOT EXISTS device_clock_skew (
+    equipment_id TEXT PRIMARY KEY REFERENCES equipment_registry(equipment_id),
+    samples BIGINT NOT NULL DEFAULT 0,
+    flagged_samples BIGINT NOT NULL DEFAULT 0,
+    consecutive_flagged INTEGER NOT NULL DEFAULT 0,
+    last_skew_ms BIGINT NOT NULL DEFAULT 0,
+    max_abs_skew_ms BIGINT NOT NULL DEFAULT 0,
+    chronic BOOLEAN NOT NULL DEFAULT FALSE,
+    last_observed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_device_clock_skew_flagged
+    ON device_clock_skew(consecutive_flagged DESC)
+    WHERE consecutive_flagged > 0;
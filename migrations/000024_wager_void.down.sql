@@ -0,0 +1,15 @@
+ALTER TABLE wagers
+    DROP CONSTRAINT IF EXISTS wagers_status_check;
+
+-- Voided wagers keep their refund on the ledger; record them as canceled.
+UPDATE wagers SET status = 'canceled', canceled_at = COALESCE(canceled_at, voided_at), cancel_reason = void_reason
+WHERE status = 'voided';
+
+ALTER TABLE wagers
+    ADD CONSTRAINT wagers_status_check
+    CHECK (status IN ('pending', 'settled', 'canceled'));
+
+ALTER TABLE wagers
+    DROP COLUMN IF EXISTS refund_transaction_id,
+    DROP COLUMN IF EXISTS void_reason,
+    DROP COLUMN IF EXISTS voided_at;
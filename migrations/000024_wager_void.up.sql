@@ -0,0 +1,11 @@
+ALTER TABLE wagers
+    ADD COLUMN IF NOT EXISTS voided_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS void_reason TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS refund_transaction_id TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE wagers
+    DROP CONSTRAINT IF EXISTS wagers_status_check;
+
+ALTER TABLE wagers
+    ADD CONSTRAINT wagers_status_check
+    CHECK (status IN ('pending', 'settled', 'canceled', 'voided'));
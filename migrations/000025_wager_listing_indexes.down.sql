@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_wagers_placed;
+DROP INDEX IF EXISTS idx_wagers_game_placed;
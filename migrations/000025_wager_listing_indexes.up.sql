@@ -0,0 +1,5 @@
+CREATE INDEX IF NOT EXISTS idx_wagers_game_placed
+    ON wagers(game_id, placed_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_wagers_placed
+    ON wagers(placed_at DESC, wager_id DESC);
@@ -0,0 +1,3 @@
+ALTER TABLE wagers
+    DROP COLUMN IF EXISTS payout_transaction_id,
+    DROP COLUMN IF EXISTS stake_transaction_id;
@@ -0,0 +1,3 @@
+ALTER TABLE wagers
+    ADD COLUMN IF NOT EXISTS stake_transaction_id TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS payout_transaction_id TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS scheduler_job_runs;
+DROP TABLE IF EXISTS scheduler_one_shot_jobs;
+DROP TABLE IF EXISTS scheduler_jobs;
+DROP TABLE IF EXISTS scheduler_leases;
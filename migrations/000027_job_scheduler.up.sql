@@ -0,0 +1,50 @@
+CREATE TABLE IF NOT EXISTS scheduler_leases (
+    lease_name TEXT PRIMARY KEY,
+    holder_id TEXT NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS scheduler_jobs (
+    job_name TEXT PRIMARY KEY,
+    schedule TEXT NOT NULL,
+    next_run_at TIMESTAMPTZ NOT NULL,
+    last_run_at TIMESTAMPTZ,
+    last_status TEXT NOT NULL DEFAULT '',
+    failed_attempts INTEGER NOT NULL DEFAULT 0,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS scheduler_one_shot_jobs (
+    job_id TEXT PRIMARY KEY,
+    job_name TEXT NOT NULL,
+    payload TEXT NOT NULL DEFAULT '',
+    run_at TIMESTAMPTZ NOT NULL,
+    status TEXT NOT NULL,
+    failed_attempts INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_scheduler_one_shot_jobs_pending
+    ON scheduler_one_shot_jobs(run_at)
+    WHERE status = 'pending';
+
+CREATE TABLE IF NOT EXISTS scheduler_job_runs (
+    run_id TEXT PRIMARY KEY,
+    job_name TEXT NOT NULL,
+    job_id TEXT NOT NULL DEFAULT '',
+    attempt INTEGER NOT NULL,
+    status TEXT NOT NULL,
+    started_at TIMESTAMPTZ NOT NULL,
+    finished_at TIMESTAMPTZ NOT NULL,
+    summary TEXT NOT NULL DEFAULT '',
+    error TEXT NOT NULL DEFAULT '',
+    instance_id TEXT NOT NULL DEFAULT '',
+    retry_scheduled BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE INDEX IF NOT EXISTS idx_scheduler_job_runs_started
+    ON scheduler_job_runs(started_at DESC, run_id DESC);
+
+CREATE INDEX IF NOT EXISTS idx_scheduler_job_runs_job_started
+    ON scheduler_job_runs(job_name, started_at DESC, run_id DESC);
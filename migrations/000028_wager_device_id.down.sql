@@ -0,0 +1,2 @@
+ALTER TABLE wagers
+    DROP COLUMN IF EXISTS device_id;
@@ -0,0 +1,2 @@
+ALTER TABLE wagers
+    ADD COLUMN IF NOT EXISTS device_id TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS reconciliation_exceptions;
+DROP TABLE IF EXISTS bank_statement_entries;
+DROP TABLE IF EXISTS bank_statements;
@@ -0,0 +1,46 @@
+CREATE TABLE IF NOT EXISTS bank_statements (
+    statement_id TEXT PRIMARY KEY,
+    content_sha256 BYTEA NOT NULL UNIQUE,
+    format TEXT NOT NULL,
+    entry_count INTEGER NOT NULL,
+    imported_by TEXT NOT NULL,
+    imported_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS bank_statement_entries (
+    entry_id TEXT PRIMARY KEY,
+    statement_id TEXT NOT NULL REFERENCES bank_statements(statement_id),
+    line_no INTEGER NOT NULL,
+    banking_day DATE NOT NULL,
+    direction TEXT NOT NULL CHECK (direction IN ('CREDIT', 'DEBIT')),
+    amount_minor BIGINT NOT NULL CHECK (amount_minor > 0),
+    currency_code CHAR(3) NOT NULL,
+    reference TEXT NOT NULL DEFAULT '',
+    description TEXT NOT NULL DEFAULT '',
+    matched_transaction_id TEXT NOT NULL DEFAULT '',
+    manually_matched BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE INDEX IF NOT EXISTS idx_bank_statement_entries_day
+    ON bank_statement_entries(banking_day);
+
+-- A ledger transaction is settled by at most one bank entry.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_bank_statement_entries_matched_tx
+    ON bank_statement_entries(matched_transaction_id)
+    WHERE matched_transaction_id <> '';
+
+CREATE TABLE IF NOT EXISTS reconciliation_exceptions (
+    exception_id TEXT PRIMARY KEY,
+    banking_day DATE NOT NULL,
+    kind TEXT NOT NULL,
+    status TEXT NOT NULL,
+    entry_id TEXT NOT NULL DEFAULT '',
+    transaction_id TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL,
+    resolved_at TIMESTAMPTZ,
+    resolved_by TEXT NOT NULL DEFAULT '',
+    resolution_note TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS idx_reconciliation_exceptions_day_status
+    ON reconciliation_exceptions(banking_day, status);
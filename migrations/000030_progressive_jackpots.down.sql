@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS jackpot_contributions;
+DROP TABLE IF EXISTS jackpot_pools;
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS jackpot_pools (
+    game_id TEXT NOT NULL,
+    currency_code CHAR(3) NOT NULL,
+    amount_minor BIGINT NOT NULL CHECK (amount_minor >= 0),
+    updated_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (game_id, currency_code)
+);
+
+CREATE TABLE IF NOT EXISTS jackpot_contributions (
+    wager_id TEXT PRIMARY KEY REFERENCES wagers(wager_id),
+    game_id TEXT NOT NULL,
+    currency_code CHAR(3) NOT NULL,
+    contribution_bps INTEGER NOT NULL CHECK (contribution_bps > 0),
+    amount_minor BIGINT NOT NULL CHECK (amount_minor >= 0),
+    pool_after_minor BIGINT NOT NULL,
+    contributed_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_jackpot_contributions_game_time
+    ON jackpot_contributions(game_id, contributed_at DESC);
@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS player_identities (
+    player_id TEXT PRIMARY KEY,
+    document_hash TEXT NOT NULL,
+    name_dob_hash TEXT NOT NULL,
+    name_dob_alt_hash TEXT NOT NULL,
+    status TEXT NOT NULL CHECK (status IN ('clear', 'pending_review', 'approved', 'rejected')),
+    matches JSONB NOT NULL DEFAULT '[]'::jsonb,
+    registered_at TIMESTAMPTZ NOT NULL,
+    reviewed_at TIMESTAMPTZ,
+    reviewed_by TEXT NOT NULL DEFAULT '',
+    review_note TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS idx_player_identities_document
+    ON player_identities(document_hash);
+
+CREATE INDEX IF NOT EXISTS idx_player_identities_name_dob
+    ON player_identities(name_dob_hash);
+
+CREATE INDEX IF NOT EXISTS idx_player_identities_name_dob_alt
+    ON player_identities(name_dob_alt_hash);
+
+CREATE INDEX IF NOT EXISTS idx_player_identities_status
+    ON player_identities(status, registered_at);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS rbac_role_assignments;
+DROP TABLE IF EXISTS rbac_roles;
@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS rbac_roles (
+    role_name TEXT PRIMARY KEY,
+    description TEXT NOT NULL DEFAULT '',
+    permissions JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    created_by TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS rbac_role_assignments (
+    actor_id TEXT NOT NULL,
+    actor_type TEXT NOT NULL,
+    role_name TEXT NOT NULL REFERENCES rbac_roles(role_name),
+    assigned_at TIMESTAMPTZ NOT NULL,
+    assigned_by TEXT NOT NULL,
+    PRIMARY KEY (actor_id, actor_type, role_name)
+);
+
+-- Built-in roles are resolved in code; the rows exist so they can be assigned.
+INSERT INTO rbac_roles (role_name, description, permissions, created_at, created_by)
+VALUES
+    ('operator', 'default for operator actors', '["*"]', NOW(), 'system'),
+    ('player', 'default for player actors', '["*"]', NOW(), 'system'),
+    ('service', 'default for service actors', '["*"]', NOW(), 'system')
+ON CONFLICT (role_name) DO NOTHING;
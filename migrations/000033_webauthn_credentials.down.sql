@@ -0,0 +1,16 @@
+DROP INDEX IF EXISTS idx_identity_webauthn_challenges_expires_at;
+DROP TABLE IF EXISTS identity_webauthn_challenges;
+DROP INDEX IF EXISTS idx_identity_credentials_webauthn_id;
+
+DELETE FROM identity_credentials WHERE credential_type <> 'password';
+ALTER TABLE identity_credentials DROP CONSTRAINT IF EXISTS identity_credentials_pkey;
+ALTER TABLE identity_credentials ADD PRIMARY KEY (actor_id, actor_type);
+
+ALTER TABLE identity_credentials ALTER COLUMN password_hash DROP DEFAULT;
+ALTER TABLE identity_credentials DROP COLUMN IF EXISTS last_used_at;
+ALTER TABLE identity_credentials DROP COLUMN IF EXISTS created_at;
+ALTER TABLE identity_credentials DROP COLUMN IF EXISTS label;
+ALTER TABLE identity_credentials DROP COLUMN IF EXISTS sign_count;
+ALTER TABLE identity_credentials DROP COLUMN IF EXISTS public_key;
+ALTER TABLE identity_credentials DROP COLUMN IF EXISTS credential_id;
+ALTER TABLE identity_credentials DROP COLUMN IF EXISTS credential_type;
@@ -0,0 +1,28 @@
+-- Operator WebAuthn keys live alongside password hashes; an actor keeps one
+-- password row and any number of WebAuthn rows keyed by credential id.
+ALTER TABLE identity_credentials ADD COLUMN IF NOT EXISTS credential_type TEXT NOT NULL DEFAULT 'password';
+ALTER TABLE identity_credentials ADD COLUMN IF NOT EXISTS credential_id TEXT NOT NULL DEFAULT '';
+ALTER TABLE identity_credentials ADD COLUMN IF NOT EXISTS public_key BYTEA;
+ALTER TABLE identity_credentials ADD COLUMN IF NOT EXISTS sign_count BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE identity_credentials ADD COLUMN IF NOT EXISTS label TEXT NOT NULL DEFAULT '';
+ALTER TABLE identity_credentials ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+ALTER TABLE identity_credentials ADD COLUMN IF NOT EXISTS last_used_at TIMESTAMPTZ;
+ALTER TABLE identity_credentials ALTER COLUMN password_hash SET DEFAULT '';
+
+ALTER TABLE identity_credentials DROP CONSTRAINT IF EXISTS identity_credentials_pkey;
+ALTER TABLE identity_credentials ADD PRIMARY KEY (actor_id, actor_type, credential_type, credential_id);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_identity_credentials_webauthn_id
+    ON identity_credentials(credential_id)
+    WHERE credential_type = 'webauthn';
+
+CREATE TABLE IF NOT EXISTS identity_webauthn_challenges (
+    challenge TEXT PRIMARY KEY,
+    actor_id TEXT NOT NULL,
+    actor_type TEXT NOT NULL,
+    purpose TEXT NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_identity_webauthn_challenges_expires_at
+    ON identity_webauthn_challenges(expires_at);
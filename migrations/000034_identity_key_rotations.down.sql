@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_identity_key_rotations_rotated_at;
+DROP TABLE IF EXISTS identity_key_rotations;
@@ -0,0 +1,18 @@
+-- Every JWT keyset an instance starts with or reloads, for key custody
+-- review. Only fingerprints and key ids are stored, never key material.
+CREATE TABLE IF NOT EXISTS identity_key_rotations (
+    rotation_id BIGSERIAL PRIMARY KEY,
+    fingerprint TEXT NOT NULL,
+    previous_fingerprint TEXT NOT NULL DEFAULT '',
+    active_kid TEXT NOT NULL,
+    key_ids JSONB NOT NULL DEFAULT '[]'::jsonb,
+    source TEXT NOT NULL,
+    trigger TEXT NOT NULL,
+    triggered_by_id TEXT NOT NULL,
+    triggered_by_type TEXT NOT NULL,
+    instance_id TEXT NOT NULL DEFAULT '',
+    rotated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_identity_key_rotations_rotated_at
+    ON identity_key_rotations(rotated_at DESC);
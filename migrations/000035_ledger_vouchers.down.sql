@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_ledger_vouchers_currency_expiry;
+DROP TABLE IF EXISTS ledger_vouchers;
+
+-- PostgreSQL cannot drop enum values; 'voucher_redemption' and 'promotional_funding' stay.
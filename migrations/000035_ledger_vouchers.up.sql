@@ -0,0 +1,22 @@
+ALTER TYPE ledger_transaction_type ADD VALUE IF NOT EXISTS 'voucher_redemption';
+ALTER TYPE ledger_account_type ADD VALUE IF NOT EXISTS 'promotional_funding';
+
+-- Single-use deposit vouchers. A voucher is outstanding until redeemed or
+-- past expires_at; redemption links the deposit transaction it funded.
+CREATE TABLE IF NOT EXISTS ledger_vouchers (
+    voucher_id TEXT PRIMARY KEY,
+    code TEXT NOT NULL UNIQUE,
+    amount_minor BIGINT NOT NULL CHECK (amount_minor > 0),
+    currency_code CHAR(3) NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    reference TEXT NOT NULL DEFAULT '',
+    issued_by TEXT NOT NULL,
+    issued_at TIMESTAMPTZ NOT NULL,
+    redeemed_account_id TEXT,
+    redeemed_at TIMESTAMPTZ,
+    redemption_transaction_id TEXT REFERENCES ledger_transactions(transaction_id),
+    CHECK ((redeemed_at IS NULL) = (redemption_transaction_id IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS idx_ledger_vouchers_currency_expiry
+    ON ledger_vouchers(currency_code, expires_at);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_registry_client_certificates_actor;
+DROP INDEX IF EXISTS idx_registry_client_certificates_active_san;
+DROP INDEX IF EXISTS idx_registry_client_certificates_active_fingerprint;
+DROP TABLE IF EXISTS registry_client_certificates;
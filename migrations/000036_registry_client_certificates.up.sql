@@ -0,0 +1,25 @@
+-- mTLS client certificate bindings. A verified client certificate whose
+-- fingerprint or subject alternative name matches an active binding
+-- authenticates as the bound service actor without a bearer token.
+CREATE TABLE IF NOT EXISTS registry_client_certificates (
+    binding_id TEXT PRIMARY KEY,
+    fingerprint_sha256 TEXT NOT NULL DEFAULT '',
+    san TEXT NOT NULL DEFAULT '',
+    actor_id TEXT NOT NULL,
+    created_by TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    revoked_at TIMESTAMPTZ,
+    revoke_reason TEXT NOT NULL DEFAULT '',
+    CHECK ((fingerprint_sha256 = '') <> (san = ''))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_registry_client_certificates_active_fingerprint
+    ON registry_client_certificates(fingerprint_sha256)
+    WHERE revoked_at IS NULL AND fingerprint_sha256 <> '';
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_registry_client_certificates_active_san
+    ON registry_client_certificates(san)
+    WHERE revoked_at IS NULL AND san <> '';
+
+CREATE INDEX IF NOT EXISTS idx_registry_client_certificates_actor
+    ON registry_client_certificates(actor_id, created_at);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_identity_credential_history_actor;
+DROP TABLE IF EXISTS identity_credential_history;
+ALTER TABLE identity_credentials DROP COLUMN IF EXISTS password_changed_at;
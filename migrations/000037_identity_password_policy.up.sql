@@ -0,0 +1,16 @@
+-- Password age for the max-age policy. Existing passwords start their age
+-- when the migration runs rather than expiring at once.
+ALTER TABLE identity_credentials ADD COLUMN IF NOT EXISTS password_changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+-- Hashes of replaced passwords, checked so a change cannot reuse a recent
+-- password.
+CREATE TABLE IF NOT EXISTS identity_credential_history (
+    history_id BIGSERIAL PRIMARY KEY,
+    actor_id TEXT NOT NULL,
+    actor_type TEXT NOT NULL,
+    password_hash TEXT NOT NULL,
+    replaced_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_identity_credential_history_actor
+    ON identity_credential_history(actor_id, actor_type, replaced_at DESC);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_identity_sessions_family;
+ALTER TABLE identity_sessions
+    DROP COLUMN IF EXISTS rotated,
+    DROP COLUMN IF EXISTS family_id;
@@ -0,0 +1,10 @@
+ALTER TABLE identity_sessions
+    ADD COLUMN IF NOT EXISTS family_id TEXT,
+    ADD COLUMN IF NOT EXISTS rotated BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE identity_sessions SET family_id = refresh_token WHERE family_id IS NULL;
+
+ALTER TABLE identity_sessions ALTER COLUMN family_id SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_identity_sessions_family
+    ON identity_sessions(family_id);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_identity_sessions_session_id;
+ALTER TABLE identity_sessions
+    DROP COLUMN IF EXISTS session_id;
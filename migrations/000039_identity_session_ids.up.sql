@@ -0,0 +1,11 @@
+ALTER TABLE identity_sessions
+    ADD COLUMN IF NOT EXISTS session_id TEXT;
+
+UPDATE identity_sessions
+SET session_id = encode(sha256(convert_to(refresh_token, 'UTF8')), 'hex')
+WHERE session_id IS NULL;
+
+ALTER TABLE identity_sessions ALTER COLUMN session_id SET NOT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_identity_sessions_session_id
+    ON identity_sessions(session_id);
@@ -0,0 +1,3 @@
+ALTER TABLE identity_sessions
+    DROP COLUMN IF EXISTS auth_time,
+    DROP COLUMN IF EXISTS auth_strength;
@@ -0,0 +1,9 @@
+ALTER TABLE identity_sessions
+    ADD COLUMN IF NOT EXISTS auth_strength TEXT NOT NULL DEFAULT 'password',
+    ADD COLUMN IF NOT EXISTS auth_time TIMESTAMPTZ;
+
+UPDATE identity_sessions SET auth_time = created_at WHERE auth_time IS NULL;
+
+ALTER TABLE identity_sessions
+    ALTER COLUMN auth_time SET DEFAULT NOW(),
+    ALTER COLUMN auth_time SET NOT NULL;
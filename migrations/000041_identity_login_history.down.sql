@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_identity_login_history_occurred;
+DROP INDEX IF EXISTS idx_identity_login_history_actor;
+DROP TABLE IF EXISTS identity_login_history;
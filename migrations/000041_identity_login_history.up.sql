@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS identity_login_history (
+    attempt_id BIGSERIAL PRIMARY KEY,
+    actor_id TEXT NOT NULL,
+    actor_type TEXT NOT NULL,
+    method TEXT NOT NULL,
+    result TEXT NOT NULL,
+    denial_reason TEXT NOT NULL DEFAULT '',
+    ip TEXT NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    occurred_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_identity_login_history_actor
+    ON identity_login_history(actor_id, actor_type, occurred_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_identity_login_history_occurred
+    ON identity_login_history(occurred_at DESC);
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS identity_ip_allowlists (
+    actor_id TEXT NOT NULL,
+    actor_type TEXT NOT NULL,
+    cidr CIDR NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (actor_id, actor_type, cidr)
+);
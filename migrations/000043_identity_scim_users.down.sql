@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_identity_scim_users_external;
+DROP TABLE IF EXISTS identity_scim_users;
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS identity_scim_users (
+    scim_id TEXT PRIMARY KEY,
+    actor_id TEXT NOT NULL UNIQUE,
+    external_id TEXT NOT NULL DEFAULT '',
+    display_name TEXT NOT NULL DEFAULT '',
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    roles JSONB NOT NULL DEFAULT '[]'::jsonb,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_identity_scim_users_external
+    ON identity_scim_users(external_id)
+    WHERE external_id <> '';
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS audit_partition_exports (
+    partition_day DATE PRIMARY KEY,
+    event_count BIGINT NOT NULL,
+    chain_verified BOOLEAN NOT NULL,
+    events_sha256 TEXT NOT NULL,
+    manifest_sha256 TEXT NOT NULL,
+    signer_kid TEXT NOT NULL,
+    signature_alg TEXT NOT NULL,
+    signature TEXT NOT NULL,
+    sinks JSONB NOT NULL DEFAULT '[]'::jsonb,
+    exported_at TIMESTAMPTZ NOT NULL
+);
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS audit_chain_anchors (
+    partition_day DATE PRIMARY KEY,
+    event_count BIGINT NOT NULL,
+    chain_head TEXT NOT NULL,
+    hash_alg TEXT NOT NULL,
+    tsa_url TEXT NOT NULL,
+    timestamp_token BYTEA NOT NULL,
+    tsa_gen_time TIMESTAMPTZ NOT NULL,
+    tsa_serial_number TEXT NOT NULL,
+    anchored_at TIMESTAMPTZ NOT NULL
+);
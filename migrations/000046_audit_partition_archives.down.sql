@@ -0,0 +1,8 @@
+CREATE OR REPLACE FUNCTION prevent_audit_mutation()
+RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_events are append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TABLE IF EXISTS audit_partition_archives;
@@ -0,0 +1,29 @@
+-- Partition days archived to cold storage by the retention worker and
+-- pruned from audit_events.
+CREATE TABLE IF NOT EXISTS audit_partition_archives (
+    partition_day DATE PRIMARY KEY,
+    event_count BIGINT NOT NULL,
+    first_hash_prev TEXT NOT NULL,
+    last_hash_curr TEXT NOT NULL,
+    events_sha256 TEXT NOT NULL,
+    manifest_sha256 TEXT NOT NULL,
+    signer_kid TEXT NOT NULL,
+    signature TEXT NOT NULL,
+    sinks JSONB NOT NULL DEFAULT '[]'::jsonb,
+    archived_at TIMESTAMPTZ NOT NULL
+);
+
+-- Audit rows stay append-only except that the retention worker may delete
+-- an archived partition day, inside a transaction that names that day in
+-- rgs.audit_prune_partition.
+CREATE OR REPLACE FUNCTION prevent_audit_mutation()
+RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'DELETE'
+       AND current_setting('rgs.audit_prune_partition', true) = OLD.partition_day::text
+       AND EXISTS (SELECT 1 FROM audit_partition_archives a WHERE a.partition_day = OLD.partition_day) THEN
+        RETURN OLD;
+    END IF;
+    RAISE EXCEPTION 'audit_events are append-only';
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_audit_events_reason_trgm;
+DROP INDEX IF EXISTS idx_audit_events_result_recorded;
+DROP INDEX IF EXISTS idx_audit_events_action_recorded;
+DROP INDEX IF EXISTS idx_audit_events_actor_recorded;
+DROP INDEX IF EXISTS idx_audit_events_object_type_recorded;
+DROP INDEX IF EXISTS idx_audit_events_recorded;
@@ -0,0 +1,23 @@
+-- Indexes backing the ListAuditEvents filters. Each pairs a filter column
+-- with the recorded_at DESC order the listing pages in.
+CREATE INDEX IF NOT EXISTS idx_audit_events_recorded
+    ON audit_events(recorded_at DESC, audit_id DESC);
+
+CREATE INDEX IF NOT EXISTS idx_audit_events_object_type_recorded
+    ON audit_events(object_type, recorded_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_audit_events_actor_recorded
+    ON audit_events(actor_id, recorded_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_audit_events_action_recorded
+    ON audit_events(action, recorded_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_audit_events_result_recorded
+    ON audit_events(result, recorded_at DESC);
+
+-- reason_contains is an ILIKE '%...%' match, which only a trigram index
+-- can serve.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS idx_audit_events_reason_trgm
+    ON audit_events USING gin (reason gin_trgm_ops);
@@ -0,0 +1,3 @@
+ALTER TABLE audit_events
+    DROP COLUMN IF EXISTS signature,
+    DROP COLUMN IF EXISTS signer_kid;
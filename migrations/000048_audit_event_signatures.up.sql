@@ -0,0 +1,4 @@
+-- Optional per-event ed25519 signatures over each event's canonical form.
+ALTER TABLE audit_events
+    ADD COLUMN IF NOT EXISTS signer_kid TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS signature TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,9 @@
+-- Merkle roots over the event hashes of closed audit partition days, so a
+-- single event can be proven part of its day.
+CREATE TABLE IF NOT EXISTS audit_partition_seals (
+    partition_day DATE PRIMARY KEY,
+    event_count BIGINT NOT NULL,
+    merkle_root TEXT NOT NULL,
+    hash_alg TEXT NOT NULL,
+    sealed_at TIMESTAMPTZ NOT NULL
+);
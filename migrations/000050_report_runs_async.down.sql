@@ -0,0 +1,4 @@
+ALTER TABLE report_runs
+    DROP COLUMN IF EXISTS failure_reason;
+
+-- PostgreSQL cannot drop enum values; 'pending' and 'running' stay on report_run_status.
@@ -0,0 +1,7 @@
+-- Runs requested with GenerateReportAsync are recorded as pending, then
+-- running, before a report worker completes or fails them.
+ALTER TYPE report_run_status ADD VALUE IF NOT EXISTS 'pending';
+ALTER TYPE report_run_status ADD VALUE IF NOT EXISTS 'running';
+
+ALTER TABLE report_runs
+    ADD COLUMN IF NOT EXISTS failure_reason TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,16 @@
+-- Push deliveries of completed report runs, one row per run and target.
+CREATE TABLE IF NOT EXISTS report_run_deliveries (
+    report_run_id TEXT NOT NULL REFERENCES report_runs(report_run_id),
+    target TEXT NOT NULL,
+    delivered BOOLEAN NOT NULL DEFAULT FALSE,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_attempted_at TIMESTAMPTZ,
+    last_error TEXT NOT NULL DEFAULT '',
+    delivered_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (report_run_id, target)
+);
+
+CREATE INDEX IF NOT EXISTS idx_report_run_deliveries_pending
+    ON report_run_deliveries(created_at)
+    WHERE NOT delivered;
@@ -0,0 +1,3 @@
+ALTER TABLE report_runs
+    DROP COLUMN IF EXISTS period_end,
+    DROP COLUMN IF EXISTS period_start;
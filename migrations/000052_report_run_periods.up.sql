@@ -0,0 +1,5 @@
+-- The window each report run covers. period_start is NULL for life-to-date
+-- runs and for runs recorded before this migration.
+ALTER TABLE report_runs
+    ADD COLUMN IF NOT EXISTS period_start TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS period_end TIMESTAMPTZ;
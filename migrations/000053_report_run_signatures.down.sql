@@ -0,0 +1,5 @@
+ALTER TABLE report_runs
+    DROP COLUMN IF EXISTS signature_alg,
+    DROP COLUMN IF EXISTS signature,
+    DROP COLUMN IF EXISTS signer_kid,
+    DROP COLUMN IF EXISTS content_sha256;
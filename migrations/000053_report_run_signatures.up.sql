@@ -0,0 +1,7 @@
+-- Content hash and ed25519 signature of completed report runs. Runs recorded
+-- before this migration, and pending or failed runs, are unsigned.
+ALTER TABLE report_runs
+    ADD COLUMN IF NOT EXISTS content_sha256 TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS signer_kid TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS signature TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS signature_alg TEXT NOT NULL DEFAULT '';
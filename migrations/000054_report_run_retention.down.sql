@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_report_runs_retention;
+
+ALTER TABLE report_runs
+    DROP COLUMN IF EXISTS content_purged_at;
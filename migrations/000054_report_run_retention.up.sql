@@ -0,0 +1,8 @@
+-- When a report run's content was purged by the retention job. The run's
+-- metadata, content hash, and signature are kept.
+ALTER TABLE report_runs
+    ADD COLUMN IF NOT EXISTS content_purged_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_report_runs_retention
+    ON report_runs(generated_at)
+    WHERE content_purged_at IS NULL AND status = 'completed';
@@ -0,0 +1,2 @@
+ALTER TABLE config_changes
+    DROP COLUMN IF EXISTS rollback_of_change_id;
@@ -0,0 +1,4 @@
+-- Rollback changes record the applied change whose previous value they
+-- restore. Rollbacks go through the same propose/approve/apply flow.
+ALTER TABLE config_changes
+    ADD COLUMN IF NOT EXISTS rollback_of_change_id TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS config_change_approvals;
+
+ALTER TABLE config_changes
+    DROP COLUMN IF EXISTS required_approvals;
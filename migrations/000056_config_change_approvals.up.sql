@@ -0,0 +1,19 @@
+-- Individual approvals of a config change. A change moves to approved once
+-- required_approvals distinct operators have approved it.
+ALTER TABLE config_changes
+    ADD COLUMN IF NOT EXISTS required_approvals INTEGER NOT NULL DEFAULT 1;
+
+CREATE TABLE IF NOT EXISTS config_change_approvals (
+    change_id TEXT NOT NULL REFERENCES config_changes(change_id),
+    approver_id TEXT NOT NULL,
+    approved_at TIMESTAMPTZ NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY (change_id, approver_id)
+);
+
+-- Changes approved before this migration had a single approver.
+INSERT INTO config_change_approvals (change_id, approver_id, approved_at, reason)
+SELECT change_id, approver_id, approved_at, ''
+FROM config_changes
+WHERE approver_id <> '' AND approved_at IS NOT NULL
+ON CONFLICT (change_id, approver_id) DO NOTHING;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_config_changes_scheduled;
+
+ALTER TABLE config_changes
+    DROP COLUMN IF EXISTS scheduled_by,
+    DROP COLUMN IF EXISTS scheduled_apply_at;
@@ -0,0 +1,9 @@
+-- Approved changes can be scheduled for application in a maintenance
+-- window by the config_scheduled_apply job.
+ALTER TABLE config_changes
+    ADD COLUMN IF NOT EXISTS scheduled_apply_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS scheduled_by TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS idx_config_changes_scheduled
+    ON config_changes(scheduled_apply_at)
+    WHERE status = 'approved' AND scheduled_apply_at IS NOT NULL;
@@ -0,0 +1,13 @@
+-- Per-key validation rules checked by ProposeConfigChange.
+CREATE TABLE IF NOT EXISTS config_schemas (
+    config_namespace TEXT NOT NULL,
+    config_key TEXT NOT NULL,
+    value_type TEXT NOT NULL,
+    min_value TEXT NOT NULL DEFAULT '',
+    max_value TEXT NOT NULL DEFAULT '',
+    pattern TEXT NOT NULL DEFAULT '',
+    allowed_values JSONB NOT NULL DEFAULT '[]'::JSONB,
+    updated_by TEXT NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (config_namespace, config_key)
+);
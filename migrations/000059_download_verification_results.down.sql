@@ -0,0 +1,3 @@
+ALTER TABLE download_library_changes
+    DROP COLUMN IF EXISTS verification_failure,
+    DROP COLUMN IF EXISTS verification_result;
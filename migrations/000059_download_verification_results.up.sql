@@ -0,0 +1,10 @@
+-- Outcome of checking a download library entry's signature against the
+-- HMAC secrets and public key ring.
+ALTER TABLE download_library_changes
+    ADD COLUMN IF NOT EXISTS verification_result TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS verification_failure TEXT NOT NULL DEFAULT '';
+
+-- Activations were only recorded after their HMAC signature verified.
+UPDATE download_library_changes
+SET verification_result = CASE WHEN action = 'activate' AND signature <> '' THEN 'verified' WHEN signature = '' THEN 'unsigned' ELSE '' END
+WHERE verification_result = '';
@@ -0,0 +1,10 @@
+-- Rules that send matching significant events to webhook and email channels.
+CREATE TABLE IF NOT EXISTS event_alert_rules (
+    rule_id TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    event_code TEXT NOT NULL DEFAULT '',
+    min_severity TEXT NOT NULL DEFAULT 'EVENT_SEVERITY_UNSPECIFIED',
+    channels JSONB NOT NULL DEFAULT '[]'::JSONB,
+    created_by TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
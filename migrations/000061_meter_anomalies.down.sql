@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_meter_records_snapshot_lookup;
+
+ALTER TABLE meter_records
+    DROP COLUMN IF EXISTS previous_value_minor,
+    DROP COLUMN IF EXISTS anomaly;
@@ -0,0 +1,10 @@
+-- Rollover and regression flags on meter records. Flagged records are kept
+-- for audit but left out of reconciliation.
+ALTER TABLE meter_records
+    ADD COLUMN IF NOT EXISTS anomaly TEXT NOT NULL DEFAULT ''
+        CHECK (anomaly IN ('', 'rollover', 'regression')),
+    ADD COLUMN IF NOT EXISTS previous_value_minor BIGINT NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_meter_records_snapshot_lookup
+    ON meter_records(equipment_id, meter_label, occurred_at DESC)
+    WHERE record_kind = 'meter_snapshot';
@@ -0,0 +1,16 @@
+-- Postgres cannot drop enum values; map lifecycle states back onto the
+-- original ones so the type can be rebuilt without them.
+ALTER TABLE equipment_registry ALTER COLUMN status DROP DEFAULT;
+ALTER TABLE equipment_registry ALTER COLUMN status TYPE TEXT;
+UPDATE equipment_registry SET status = 'inactive' WHERE status IN ('registered', 'commissioned');
+UPDATE equipment_registry SET status = 'retired' WHERE status = 'decommissioned';
+DROP TYPE equipment_status;
+CREATE TYPE equipment_status AS ENUM (
+    'active',
+    'inactive',
+    'maintenance',
+    'disabled',
+    'retired'
+);
+ALTER TABLE equipment_registry ALTER COLUMN status TYPE equipment_status USING status::equipment_status;
+ALTER TABLE equipment_registry ALTER COLUMN status SET DEFAULT 'active';
@@ -0,0 +1,5 @@
+-- Lifecycle states for equipment commissioning. Existing rows keep their
+-- status; new equipment starts out registered.
+ALTER TYPE equipment_status ADD VALUE IF NOT EXISTS 'registered';
+ALTER TYPE equipment_status ADD VALUE IF NOT EXISTS 'commissioned';
+ALTER TYPE equipment_status ADD VALUE IF NOT EXISTS 'decommissioned';
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS equipment_software_verifications;
+DROP TABLE IF EXISTS software_manifests;
+ALTER TABLE equipment_registry DROP COLUMN IF EXISTS model;
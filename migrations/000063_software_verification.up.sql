@@ -0,0 +1,21 @@
+-- Approved software manifests per equipment model, and the latest
+-- verification result reported by each device.
+ALTER TABLE equipment_registry ADD COLUMN IF NOT EXISTS model TEXT NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS software_manifests (
+    model TEXT NOT NULL,
+    version TEXT NOT NULL,
+    components JSONB NOT NULL DEFAULT '[]'::JSONB,
+    approved_by TEXT NOT NULL,
+    approved_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (model, version)
+);
+
+CREATE TABLE IF NOT EXISTS equipment_software_verifications (
+    equipment_id TEXT PRIMARY KEY REFERENCES equipment_registry(equipment_id),
+    model TEXT NOT NULL,
+    version TEXT NOT NULL,
+    status TEXT NOT NULL,
+    mismatched_components JSONB NOT NULL DEFAULT '[]'::JSONB,
+    verified_at TIMESTAMPTZ NOT NULL
+);
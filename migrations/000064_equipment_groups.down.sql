@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_equipment_registry_bank;
+DROP INDEX IF EXISTS idx_equipment_registry_zone;
+ALTER TABLE equipment_registry DROP COLUMN IF EXISTS bank_id;
+ALTER TABLE equipment_registry DROP COLUMN IF EXISTS zone_id;
+DROP TABLE IF EXISTS equipment_groups;
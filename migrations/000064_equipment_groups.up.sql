@@ -0,0 +1,15 @@
+-- Zones of the floor and banks of equipment within them.
+CREATE TABLE IF NOT EXISTS equipment_groups (
+    group_id TEXT PRIMARY KEY,
+    kind TEXT NOT NULL CHECK (kind IN ('zone', 'bank')),
+    name TEXT NOT NULL,
+    zone_id TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+ALTER TABLE equipment_registry ADD COLUMN IF NOT EXISTS zone_id TEXT NOT NULL DEFAULT '';
+ALTER TABLE equipment_registry ADD COLUMN IF NOT EXISTS bank_id TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS idx_equipment_registry_zone ON equipment_registry(zone_id) WHERE zone_id <> '';
+CREATE INDEX IF NOT EXISTS idx_equipment_registry_bank ON equipment_registry(bank_id) WHERE bank_id <> '';
@@ -0,0 +1,14 @@
+-- Scheduled maintenance windows that suppress alert deliveries per equipment.
+CREATE TABLE IF NOT EXISTS event_maintenance_windows (
+    window_id TEXT PRIMARY KEY,
+    equipment_id TEXT NOT NULL,
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL,
+    reason TEXT NOT NULL,
+    created_by TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    CHECK (ends_at > starts_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_maintenance_windows_equipment
+    ON event_maintenance_windows(equipment_id, starts_at);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS ram_clear_incidents;
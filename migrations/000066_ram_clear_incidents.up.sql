@@ -0,0 +1,16 @@
+-- RAM clear incidents awaiting or carrying operator acknowledgment. Each
+-- resets the equipment's meter baseline at baseline_reset_at.
+CREATE TABLE IF NOT EXISTS ram_clear_incidents (
+    event_id TEXT PRIMARY KEY REFERENCES significant_events(event_id),
+    equipment_id TEXT NOT NULL,
+    occurred_at TIMESTAMPTZ NOT NULL,
+    baseline_reset_at TIMESTAMPTZ NOT NULL,
+    acknowledged_by TEXT NOT NULL DEFAULT '',
+    acknowledged_at TIMESTAMPTZ,
+    acknowledgment_reason TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS idx_ram_clear_incidents_equipment
+    ON ram_clear_incidents(equipment_id, baseline_reset_at DESC);
+CREATE INDEX IF NOT EXISTS idx_ram_clear_incidents_pending
+    ON ram_clear_incidents(occurred_at) WHERE acknowledged_at IS NULL;
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_ingestion_buffers_queued_priority;
+DROP INDEX IF EXISTS ux_ingestion_buffers_request_record;
+CREATE UNIQUE INDEX IF NOT EXISTS ux_ingestion_buffers_request_kind
+    ON ingestion_buffers(request_id, record_kind)
+    WHERE request_id <> '';
+ALTER TABLE ingestion_buffers DROP COLUMN IF EXISTS priority;
@@ -0,0 +1,14 @@
+-- Records held for persistence retry are replayed highest priority first.
+ALTER TABLE ingestion_buffers
+    ADD COLUMN IF NOT EXISTS priority SMALLINT NOT NULL DEFAULT 0;
+
+-- Batches share one request id across their records, so uniqueness has to
+-- include the record itself.
+DROP INDEX IF EXISTS ux_ingestion_buffers_request_kind;
+CREATE UNIQUE INDEX IF NOT EXISTS ux_ingestion_buffers_request_record
+    ON ingestion_buffers(request_id, record_kind, source_record_id)
+    WHERE request_id <> '';
+
+CREATE INDEX IF NOT EXISTS idx_ingestion_buffers_queued_priority
+    ON ingestion_buffers(priority DESC, queued_at)
+    WHERE status = 'queued';
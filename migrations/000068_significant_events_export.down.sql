@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_significant_events_recorded;
@@ -0,0 +1,3 @@
+-- Keyset order for resumable significant event exports.
+CREATE INDEX IF NOT EXISTS idx_significant_events_recorded
+    ON significant_events(recorded_at, event_id);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_equipment_registry_search_trgm;
+DROP INDEX IF EXISTS idx_equipment_registry_control_program_version;
+DROP INDEX IF EXISTS idx_equipment_registry_model;
+DROP INDEX IF EXISTS idx_equipment_registry_location;
@@ -0,0 +1,18 @@
+-- Indexes backing the ListEquipment filters.
+CREATE INDEX IF NOT EXISTS idx_equipment_registry_location
+    ON equipment_registry(location);
+
+CREATE INDEX IF NOT EXISTS idx_equipment_registry_model
+    ON equipment_registry(model);
+
+CREATE INDEX IF NOT EXISTS idx_equipment_registry_control_program_version
+    ON equipment_registry(control_program_version);
+
+-- search is an ILIKE '%...%' match over this expression, which only a
+-- trigram index can serve.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS idx_equipment_registry_search_trgm
+    ON equipment_registry USING GIN (
+        (equipment_id || ' ' || external_reference || ' ' || location || ' ' || model || ' ' || control_program_version) gin_trgm_ops
+    );
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS player_self_exclusions (
+    exclusion_id TEXT PRIMARY KEY,
+    player_id TEXT NOT NULL,
+    starts_at TIMESTAMPTZ NOT NULL,
+    -- NULL for a permanent exclusion.
+    ends_at TIMESTAMPTZ,
+    reason TEXT NOT NULL DEFAULT '',
+    registered_by TEXT NOT NULL,
+    registered_by_type TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_player_self_exclusions_player
+    ON player_self_exclusions(player_id, ends_at DESC NULLS FIRST);
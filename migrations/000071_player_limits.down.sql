@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_ledger_transactions_account_type_occurred;
+DROP TABLE IF EXISTS player_limits;
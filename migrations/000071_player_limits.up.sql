@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS player_limits (
+    player_id TEXT NOT NULL,
+    limit_type TEXT NOT NULL,
+    period TEXT NOT NULL,
+    -- NULL when no limit is in force.
+    amount_minor BIGINT CHECK (amount_minor > 0),
+    currency_code TEXT NOT NULL DEFAULT '',
+    -- A raise or removal waiting out the cooling-off period.
+    pending_amount_minor BIGINT CHECK (pending_amount_minor > 0),
+    pending_currency_code TEXT NOT NULL DEFAULT '',
+    pending_removal BOOLEAN NOT NULL DEFAULT FALSE,
+    pending_effective_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ NOT NULL,
+    updated_by TEXT NOT NULL,
+    PRIMARY KEY (player_id, limit_type, period)
+);
+
+-- Deposit limits sum a player's recent deposits.
+CREATE INDEX IF NOT EXISTS idx_ledger_transactions_account_type_occurred
+    ON ledger_transactions(account_id, transaction_type, occurred_at);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_player_sessions_player_end_reason;
+DROP INDEX IF EXISTS idx_player_sessions_active_started;
@@ -0,0 +1,8 @@
+-- The max duration worker scans active sessions by start time.
+CREATE INDEX IF NOT EXISTS idx_player_sessions_active_started
+    ON player_sessions(started_at)
+    WHERE state = 'ACTIVE';
+
+-- Cool-off checks look up a player's sessions cut off by the max duration.
+CREATE INDEX IF NOT EXISTS idx_player_sessions_player_end_reason
+    ON player_sessions(player_id, end_reason, ended_at DESC);
@@ -0,0 +1,3 @@
+ALTER TABLE player_sessions
+    DROP COLUMN IF EXISTS reality_check_acknowledged_at,
+    DROP COLUMN IF EXISTS reality_check_window_id;
@@ -0,0 +1,3 @@
+ALTER TABLE player_sessions
+    ADD COLUMN IF NOT EXISTS reality_check_window_id TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS reality_check_acknowledged_at TIMESTAMPTZ;
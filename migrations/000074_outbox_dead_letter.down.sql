@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_outbox_events_dead_lettered;
+DROP INDEX IF EXISTS idx_outbox_events_unpublished;
+ALTER TABLE outbox_events
+    DROP COLUMN IF EXISTS dead_lettered_at;
+CREATE INDEX IF NOT EXISTS idx_outbox_events_unpublished
+    ON outbox_events(event_id)
+    WHERE published_at IS NULL;
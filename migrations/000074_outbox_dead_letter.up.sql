@@ -0,0 +1,13 @@
+-- Events that exhaust their publish attempts are dead-lettered so they stop
+-- holding up the events queued behind them.
+ALTER TABLE outbox_events
+    ADD COLUMN IF NOT EXISTS dead_lettered_at TIMESTAMPTZ;
+
+DROP INDEX IF EXISTS idx_outbox_events_unpublished;
+CREATE INDEX IF NOT EXISTS idx_outbox_events_unpublished
+    ON outbox_events(event_id)
+    WHERE published_at IS NULL AND dead_lettered_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_outbox_events_dead_lettered
+    ON outbox_events(dead_lettered_at)
+    WHERE dead_lettered_at IS NOT NULL;
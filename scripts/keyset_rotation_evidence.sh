@@ -4,6 +4,7 @@ set -euo pipefail
 # Generates auditable keyset-rotation evidence from file or command source.
 # Input must be a JSON payload:
 # {"active_kid":"k2","keys":{"k1":"...","k2":"..."}}
+# Asymmetric signing keys go under "private_keys" as PEM strings.
 
 source_mode=""
 payload=""
@@ -28,7 +29,7 @@ if ! command -v jq >/dev/null 2>&1; then
 fi
 
 active_kid="$(printf '%s' "${payload}" | jq -r '.active_kid // "default"')"
-keys_count="$(printf '%s' "${payload}" | jq -r '.keys | length')"
+keys_count="$(printf '%s' "${payload}" | jq -r '(.keys // {} | length) + (.private_keys // {} | length)')"
 if [[ "${keys_count}" == "0" || "${keys_count}" == "null" ]]; then
   echo "keyset payload contains no keys" >&2
   exit 1